@@ -18,6 +18,7 @@ package main
 
 import (
 	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctlserver"
 )
 
@@ -26,5 +27,8 @@ func init() {
 		if servenv.GRPCCheckServiceMap("vtctl") {
 			grpcvtctlserver.StartServer(servenv.GRPCServer, ts)
 		}
+		if servenv.GRPCCheckServiceMap("vtctld") {
+			grpcvtctldserver.StartServer(servenv.GRPCServer, ts)
+		}
 	})
 }
@@ -20,6 +20,9 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
 
 	"vitess.io/vitess/go/exit"
 	"vitess.io/vitess/go/vt/log"
@@ -36,17 +39,20 @@ var (
 	vschemaFlag     = flag.String("vschema", "", "Identifies the VTGate routing schema")
 	vschemaFileFlag = flag.String("vschema-file", "", "Identifies the VTGate routing schema file")
 	numShards       = flag.Int("shards", 2, "Number of shards per keyspace")
+	keyspaceShards  = flag.String("keyspace-shards", "", "Comma-separated list of keyspace:shards, overriding -shards for the given keyspaces")
 	executionMode   = flag.String("execution-mode", "multi", "The execution mode to simulate -- must be set to multi, legacy-autocommit, or twopc")
 	replicationMode = flag.String("replication-mode", "ROW", "The replication mode to simulate -- must be set to either ROW or STATEMENT")
 	normalize       = flag.Bool("normalize", false, "Whether to enable vtgate normalization")
 	outputMode      = flag.String("output-mode", "text", "Output in human-friendly text or json")
 	dbName          = flag.String("dbname", "", "Optional database target to override normal routing")
+	httpPort        = flag.Int("http-port", 0, "If set, serve explain requests posted as json to /explain on this port, instead of analyzing the sql flags")
 
 	// vtexplainFlags lists all the flags that should show in usage
 	vtexplainFlags = []string{
 		"output-mode",
 		"normalize",
 		"shards",
+		"keyspace-shards",
 		"replication-mode",
 		"schema",
 		"schema-file",
@@ -55,6 +61,7 @@ var (
 		"vschema",
 		"vschema-file",
 		"dbname",
+		"http-port",
 		"queryserver-config-passthrough-dmls",
 	}
 )
@@ -136,7 +143,33 @@ func main() {
 	}
 }
 
+// parseKeyspaceShards parses a list of keyspace:shards.
+func parseKeyspaceShards(value string) (map[string]int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	result := make(map[string]int)
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid keyspace-shards entry %q: want keyspace:shards", entry)
+		}
+		n, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyspace-shards entry %q: %v", entry, err)
+		}
+		result[parts[0]] = n
+	}
+	return result, nil
+}
+
 func parseAndRun() error {
+	if *httpPort != 0 {
+		http.Handle("/explain", vtexplain.NewHandler())
+		log.Infof("serving explain requests on port %d", *httpPort)
+		return http.ListenAndServe(fmt.Sprintf(":%d", *httpPort), nil)
+	}
+
 	sql, err := getFileParam(*sqlFlag, *sqlFileFlag, "sql")
 	if err != nil {
 		return err
@@ -152,10 +185,16 @@ func parseAndRun() error {
 		return err
 	}
 
+	ksShards, err := parseKeyspaceShards(*keyspaceShards)
+	if err != nil {
+		return err
+	}
+
 	opts := &vtexplain.Options{
 		ExecutionMode:   *executionMode,
 		ReplicationMode: *replicationMode,
 		NumShards:       *numShards,
+		KeyspaceShards:  ksShards,
 		Normalize:       *normalize,
 		Target:          *dbName,
 	}
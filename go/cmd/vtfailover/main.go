@@ -0,0 +1,110 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+vtfailover watches the masters of the shards through their health checks,
+and runs an EmergencyReparentShard when a master is dead.
+
+A master is dead when its health check has been failing for
+-failure_duration, and at least -quorum REPLICA or RDONLY tablets report
+they lost their replication connection to it. The tablets taking a backup,
+restoring or drained don't count. The new master is the healthy replica with
+the lowest replication lag, in the first of -preferred_cells that has one.
+Two recoveries of a shard are at least -cooldown apart.
+
+Every recovery is recorded as JSON in the global topology, under
+recoveries/<keyspace>/<shard>. Run a single vtfailover per shard.
+*/
+package main
+
+import (
+	"flag"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/failover"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var (
+	checkInterval       = flag.Duration("check_interval", 5*time.Second, "how often the masters are checked")
+	failureDuration     = flag.Duration("failure_duration", 30*time.Second, "how long the health check of a master must fail before it's considered dead")
+	quorum              = flag.Int("quorum", failover.DefaultQuorum, "how many REPLICA or RDONLY tablets with a working health check must confirm that they lost their replication connection to a failing master before it's reparented")
+	cooldown            = flag.Duration("cooldown", time.Hour, "minimum time between two recoveries of a shard")
+	waitReplicasTimeout = flag.Duration("wait_replicas_timeout", 30*time.Second, "how long the reparent waits for the replicas to catch up")
+
+	healthCheckRetryDelay      = flag.Duration("healthcheck_retry_delay", 2*time.Second, "delay before retrying a failed health check")
+	healthCheckTimeout         = flag.Duration("healthcheck_timeout", time.Minute, "the health check timeout period")
+	healthCheckTopologyRefresh = flag.Duration("healthcheck_topology_refresh", 30*time.Second, "refresh interval for re-reading the topology")
+
+	preferredCells flagutil.StringListValue
+	keyspaces      flagutil.StringListValue
+)
+
+func init() {
+	flag.Var(&preferredCells, "preferred_cells", "comma-separated list of cells where the new master is picked first, in order")
+	flag.Var(&keyspaces, "keyspaces", "comma-separated list of keyspaces to watch, all of them if empty")
+	servenv.RegisterDefaultFlags()
+}
+
+func main() {
+	servenv.ParseFlags("vtfailover")
+	servenv.Init()
+	defer servenv.Close()
+
+	ts := topo.Open()
+	defer ts.Close()
+
+	tmc := tmclient.NewTabletManagerClient()
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmc)
+	orchestrator := failover.NewOrchestrator(ts, tmc, wr, failover.Policy{
+		FailureDuration:     *failureDuration,
+		Quorum:              *quorum,
+		Cooldown:            *cooldown,
+		PreferredCells:      preferredCells,
+		WaitReplicasTimeout: *waitReplicasTimeout,
+	}, keyspaces)
+
+	// sendDownEvents is set to true, to forget the removed tablets.
+	hc := discovery.NewHealthCheck(*healthCheckRetryDelay, *healthCheckTimeout)
+	hc.SetListener(orchestrator, true /* sendDownEvents */)
+	defer hc.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cells, err := ts.GetKnownCells(ctx)
+	if err != nil {
+		log.Exitf("Cannot get the cells: %v", err)
+	}
+	for _, cell := range cells {
+		watcher := discovery.NewCellTabletsWatcher(ctx, ts, hc, cell, *healthCheckTopologyRefresh, true /* refreshKnownTablets */, discovery.DefaultTopoReadConcurrency)
+		defer watcher.Stop()
+	}
+
+	servenv.OnRun(func() {
+		go orchestrator.Run(ctx, *checkInterval)
+	})
+	servenv.OnTerm(cancel)
+	servenv.RunDefault()
+}
@@ -410,7 +410,7 @@ func testEchoSplitQuery(t *testing.T, conn *vtgateconn.VTGateConn) {
 		},
 		KeyRangePart: &vtgatepb.SplitQueryResponse_KeyRangePart{Keyspace: keyspace},
 	}
-	got, err := conn.SplitQuery(context.Background(), keyspace, echoPrefix+query, bindVars, []string{"split_column1,split_column2"}, 123, 1000, querypb.SplitQueryRequest_FULL_SCAN)
+	got, err := conn.SplitQuery(context.Background(), keyspace, echoPrefix+query, bindVars, []string{"split_column1,split_column2"}, 123, 1000, querypb.SplitQueryRequest_FULL_SCAN, "")
 	if err != nil {
 		t.Fatalf("SplitQuery error: %v", err)
 	}
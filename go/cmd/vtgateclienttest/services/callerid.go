@@ -189,7 +189,8 @@ func (c *callerIDClient) SplitQuery(
 	splitColumns []string,
 	splitCount int64,
 	numRowsPerQueryPart int64,
-	algorithm querypb.SplitQueryRequest_Algorithm) ([]*vtgatepb.SplitQueryResponse_Part, error) {
+	algorithm querypb.SplitQueryRequest_Algorithm,
+	cell string) ([]*vtgatepb.SplitQueryResponse_Part, error) {
 	if ok, err := c.checkCallerID(ctx, sql); ok {
 		return nil, err
 	}
@@ -201,7 +202,8 @@ func (c *callerIDClient) SplitQuery(
 		splitColumns,
 		splitCount,
 		numRowsPerQueryPart,
-		algorithm)
+		algorithm,
+		cell)
 }
 
 func (c *callerIDClient) UpdateStream(ctx context.Context, keyspace string, shard string, keyRange *topodatapb.KeyRange, tabletType topodatapb.TabletType, timestamp int64, event *querypb.EventToken, callback func(*querypb.StreamEvent, int64) error) error {
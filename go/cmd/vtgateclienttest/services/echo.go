@@ -348,7 +348,8 @@ func (c *echoClient) SplitQuery(
 	splitColumns []string,
 	splitCount int64,
 	numRowsPerQueryPart int64,
-	algorithm querypb.SplitQueryRequest_Algorithm) ([]*vtgatepb.SplitQueryResponse_Part, error) {
+	algorithm querypb.SplitQueryRequest_Algorithm,
+	cell string) ([]*vtgatepb.SplitQueryResponse_Part, error) {
 
 	if strings.HasPrefix(sql, EchoPrefix) {
 		return []*vtgatepb.SplitQueryResponse_Part{
@@ -372,7 +373,8 @@ func (c *echoClient) SplitQuery(
 		splitColumns,
 		splitCount,
 		numRowsPerQueryPart,
-		algorithm)
+		algorithm,
+		cell)
 }
 
 func (c *echoClient) UpdateStream(ctx context.Context, keyspace string, shard string, keyRange *topodatapb.KeyRange, tabletType topodatapb.TabletType, timestamp int64, event *querypb.EventToken, callback func(*querypb.StreamEvent, int64) error) error {
@@ -298,7 +298,8 @@ func (c *errorClient) SplitQuery(
 	splitColumns []string,
 	splitCount int64,
 	numRowsPerQueryPart int64,
-	algorithm querypb.SplitQueryRequest_Algorithm) ([]*vtgatepb.SplitQueryResponse_Part, error) {
+	algorithm querypb.SplitQueryRequest_Algorithm,
+	cell string) ([]*vtgatepb.SplitQueryResponse_Part, error) {
 
 	if err := requestToError(sql); err != nil {
 		return nil, err
@@ -311,7 +312,8 @@ func (c *errorClient) SplitQuery(
 		splitColumns,
 		splitCount,
 		numRowsPerQueryPart,
-		algorithm)
+		algorithm,
+		cell)
 }
 
 func (c *errorClient) GetSrvKeyspace(ctx context.Context, keyspace string) (*topodatapb.SrvKeyspace, error) {
@@ -125,9 +125,10 @@ func (c fallbackClient) SplitQuery(
 	splitCount int64,
 	numRowsPerQueryPart int64,
 	algorithm querypb.SplitQueryRequest_Algorithm,
+	cell string,
 ) ([]*vtgatepb.SplitQueryResponse_Part, error) {
 	return c.fallback.SplitQuery(
-		ctx, sql, keyspace, bindVariables, splitColumns, splitCount, numRowsPerQueryPart, algorithm)
+		ctx, sql, keyspace, bindVariables, splitColumns, splitCount, numRowsPerQueryPart, algorithm, cell)
 }
 
 func (c fallbackClient) GetSrvKeyspace(ctx context.Context, keyspace string) (*topodatapb.SrvKeyspace, error) {
@@ -142,6 +143,10 @@ func (c fallbackClient) UpdateStream(ctx context.Context, keyspace string, shard
 	return c.fallback.UpdateStream(ctx, keyspace, shard, keyRange, tabletType, timestamp, event, callback)
 }
 
+func (c fallbackClient) SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*vtgatepb.RuntimeFlagChange, error) {
+	return c.fallback.SetRuntimeFlags(ctx, flags)
+}
+
 func (c fallbackClient) HandlePanic(err *error) {
 	c.fallback.HandlePanic(err)
 }
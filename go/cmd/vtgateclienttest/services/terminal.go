@@ -134,6 +134,7 @@ func (c *terminalClient) SplitQuery(
 	splitCount int64,
 	numRowsPerQueryPart int64,
 	algorithm querypb.SplitQueryRequest_Algorithm,
+	cell string,
 ) ([]*vtgatepb.SplitQueryResponse_Part, error) {
 	return nil, errTerminal
 }
@@ -150,6 +151,10 @@ func (c *terminalClient) UpdateStream(ctx context.Context, keyspace string, shar
 	return errTerminal
 }
 
+func (c *terminalClient) SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*vtgatepb.RuntimeFlagChange, error) {
+	return nil, errTerminal
+}
+
 func (c *terminalClient) HandlePanic(err *error) {
 	if x := recover(); x != nil {
 		log.Errorf("Uncaught panic:\n%v\n%s", x, tb.Stack(4))
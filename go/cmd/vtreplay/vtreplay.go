@@ -0,0 +1,149 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"vitess.io/vitess/go/exit"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+	"vitess.io/vitess/go/vtreplay"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+
+	// Import and register the gRPC vtgateconn client
+	_ "vitess.io/vitess/go/vt/vtgate/grpcvtgateconn"
+)
+
+/*
+
+  Vtreplay replays a vtgate query log against a vtgate, and reports the
+  queries whose errors, results or latencies differ from the reference.
+
+  The log must be captured in the JSON format with the full bind
+  variables, for example with:
+  curl 'http://vtgate-host:15001/debug/querylog?full' > querylog.json
+  from a vtgate started with -querylog-format json.
+
+  Without -baseline_server, the log is the reference:
+  vtreplay \
+        -server vtgate-test:15991 \
+        -target commerce@replica \
+        -speed 2 \
+        querylog.json
+
+  With -baseline_server, the queries are executed by both vtgates, and
+  their full results are compared:
+  vtreplay \
+        -server vtgate-candidate:15991 \
+        -baseline_server vtgate-stable:15991 \
+        -target commerce@replica \
+        querylog.json
+
+  The exit status is 1 if a difference was found.
+
+*/
+
+var (
+	server         = flag.String("server", "", "vtgate server the queries are replayed against")
+	baselineServer = flag.String("baseline_server", "", "vtgate server that also executes the queries, as the reference. The query log is the reference if empty.")
+	target         = flag.String("target", "", "target of the replayed queries, like keyspace@replica")
+	speed          = flag.Float64("speed", 1, "speed multiplier of the replay, relative to the query log. The queries are replayed as fast as possible if 0.")
+	concurrency    = flag.Int("concurrency", 10, "maximum number of queries in flight")
+	includeDML     = flag.Bool("dml", false, "replay the DMLs as well as the selects")
+	timeout        = flag.Duration("timeout", 30*time.Second, "timeout of each query")
+	slowdownFactor = flag.Float64("slowdown_factor", 2, "report the queries that are that many times slower than their reference, 0 to disable")
+	maxDiffs       = flag.Int("max_diffs", 100, "maximum number of differences that are printed")
+)
+
+// connExecutor executes every query in its own session, as the
+// sessions can't be used concurrently.
+type connExecutor struct {
+	conn   *vtgateconn.VTGateConn
+	target string
+}
+
+func (c connExecutor) Execute(ctx context.Context, sql string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return c.conn.Session(c.target, nil).Execute(ctx, sql, bindVars)
+}
+
+func main() {
+	defer exit.Recover()
+	defer logutil.Flush()
+
+	flag.Parse()
+	if *server == "" {
+		log.Exitf("-server is required")
+	}
+
+	ctx := context.Background()
+	conn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		log.Exitf("cannot connect to %v: %v", *server, err)
+	}
+	defer conn.Close()
+	var baseline vtreplay.Executor
+	if *baselineServer != "" {
+		baselineConn, err := vtgateconn.Dial(ctx, *baselineServer)
+		if err != nil {
+			log.Exitf("cannot connect to %v: %v", *baselineServer, err)
+		}
+		defer baselineConn.Close()
+		baseline = connExecutor{conn: baselineConn, target: *target}
+	}
+
+	rp := vtreplay.NewReplayer(connExecutor{conn: conn, target: *target}, baseline, vtreplay.Options{
+		Speed:          *speed,
+		Concurrency:    *concurrency,
+		IncludeDML:     *includeDML,
+		Timeout:        *timeout,
+		SlowdownFactor: *slowdownFactor,
+		MaxDiffs:       *maxDiffs,
+	})
+
+	var r io.Reader = os.Stdin
+	if flag.NArg() > 1 {
+		log.Exitf("vtreplay takes at most one query log file")
+	}
+	if flag.NArg() == 1 {
+		f, err := os.Open(flag.Arg(0))
+		if err != nil {
+			log.Exitf("cannot open the query log: %v", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	report, err := rp.Run(ctx, r)
+	report.Print(os.Stdout)
+	if err != nil {
+		log.Errorf("replay failed: %v", err)
+		exit.Return(1)
+	}
+	if report.ErrorDiffs+report.ResultDiffs+report.Slowdowns > 0 {
+		fmt.Fprintf(os.Stderr, "differences found\n")
+		exit.Return(1)
+	}
+}
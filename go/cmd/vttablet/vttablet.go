@@ -37,6 +37,7 @@ import (
 var (
 	enforceTableACLConfig = flag.Bool("enforce-tableacl-config", false, "if this flag is true, vttablet will fail to start if a valid tableacl config does not exist")
 	tableACLConfig        = flag.String("table-acl-config", "", "path to table access checker config file; send SIGHUP to reload this file")
+	tableACLKeyspace      = flag.String("table-acl-config-keyspace", "", "keyspace whose table access checker config is loaded from the topo, and reloaded when it changes; can't be used with table-acl-config")
 	tabletPath            = flag.String("tablet-path", "", "tablet alias")
 
 	agent *tabletmanager.ActionAgent
@@ -75,6 +76,9 @@ func main() {
 	// we assume that the mysql is not local, and we skip loading mycnf.
 	// This also means that backup and restore will not be allowed.
 	if !dbconfigs.HasConnectionParams() {
+		if tabletmanager.IsUnmanaged() {
+			log.Exit("-unmanaged requires the connection parameters of the external MySQL: -db_host and -db_port, or -db_socket")
+		}
 		var err error
 		if mycnf, err = mysqlctl.NewMycnfFromFlags(tabletAlias.Uid); err != nil {
 			log.Exitf("mycnf read failed: %v", err)
@@ -92,11 +96,14 @@ func main() {
 		log.Warning(err)
 	}
 
-	if *tableACLConfig != "" {
+	if *tableACLConfig != "" && *tableACLKeyspace != "" {
+		log.Exit("table-acl-config and table-acl-config-keyspace cannot be used together.")
+	}
+	if *tableACLConfig != "" || *tableACLKeyspace != "" {
 		// To override default simpleacl, other ACL plugins must set themselves to be default ACL factory
 		tableacl.Register("simpleacl", &simpleacl.Factory{})
 	} else if *enforceTableACLConfig {
-		log.Exit("table acl config has to be specified with table-acl-config flag because enforce-tableacl-config is set.")
+		log.Exit("table acl config has to be specified with table-acl-config or table-acl-config-keyspace flag because enforce-tableacl-config is set.")
 	}
 
 	// creates and registers the query service
@@ -112,7 +119,12 @@ func main() {
 		qsc.StopService()
 	})
 
-	qsc.InitACL(*tableACLConfig, *enforceTableACLConfig)
+	if *tableACLKeyspace != "" {
+		qsc.InitACLFromTopo(*tableACLKeyspace, *enforceTableACLConfig)
+	} else {
+		qsc.InitACL(*tableACLConfig, *enforceTableACLConfig)
+		qsc.RuntimeFlags().Register("table-acl-config", qsc.ReloadACL)
+	}
 
 	// Create mysqld and register the health reporter (needs to be done
 	// before initializing the agent, so the initial health check
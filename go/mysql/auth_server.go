@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"crypto/rand"
 	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/hex"
 	"net"
 	"strings"
@@ -79,6 +80,22 @@ type AuthServer interface {
 	Negotiate(c *Conn, user string, remoteAddr net.Addr) (Getter, error)
 }
 
+// CachingSha2AuthServer is an optional interface for the AuthServers
+// that return MysqlCachingSha2Password as their AuthMethod. It performs
+// the fast authentication of caching_sha2_password, where the client
+// sends a scramble of its password computed with the salt. If an
+// AuthServer doesn't implement it, or cannot validate the scramble,
+// the full authentication is performed instead: the client sends its
+// password in the clear, and Negotiate() is called to validate it.
+type CachingSha2AuthServer interface {
+	// ValidateCachingSha2Hash validates the scramble sent by the client.
+	// It returns false if the scramble cannot be validated, for instance
+	// if the server doesn't know the password of the user, in which case
+	// the full authentication is performed. Otherwise, it returns the
+	// user data, or an error if the scramble doesn't match.
+	ValidateCachingSha2Hash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, bool, error)
+}
+
 // authServers is a registry of AuthServer implementations.
 var authServers = make(map[string]AuthServer)
 
@@ -189,6 +206,69 @@ func isPassScrambleMysqlNativePassword(reply, salt []byte, mysqlNativePassword s
 	return bytes.Equal(candidateHash2, hash)
 }
 
+// isPassMysqlNativePassword returns true if the hash of the clear text
+// password matches the mysqlNativePassword hash, as stored by MySQL.
+func isPassMysqlNativePassword(password, mysqlNativePassword string) bool {
+	if password == "" || mysqlNativePassword == "" {
+		return false
+	}
+
+	hash, err := hex.DecodeString(strings.TrimPrefix(mysqlNativePassword, "*"))
+	if err != nil {
+		return false
+	}
+
+	// hash = SHA1(SHA1(password))
+	crypt := sha1.New()
+	crypt.Write([]byte(password))
+	stage1 := crypt.Sum(nil)
+	crypt.Reset()
+	crypt.Write(stage1)
+	return bytes.Equal(crypt.Sum(nil), hash)
+}
+
+// ScrambleCachingSha2Password computes the hash of the password using
+// the caching_sha2_password method.
+func ScrambleCachingSha2Password(salt, password []byte) []byte {
+	if len(password) == 0 {
+		return nil
+	}
+
+	// stage1Hash = SHA256(password)
+	crypt := sha256.New()
+	crypt.Write(password)
+	stage1 := crypt.Sum(nil)
+
+	// scrambleHash = SHA256(SHA256(stage1Hash) + salt)
+	// inner Hash
+	crypt.Reset()
+	crypt.Write(stage1)
+	hash := crypt.Sum(nil)
+	// outer Hash
+	crypt.Reset()
+	crypt.Write(hash)
+	crypt.Write(salt)
+	scramble := crypt.Sum(nil)
+
+	// token = stage1Hash XOR scrambleHash
+	for i := range stage1 {
+		stage1[i] ^= scramble[i]
+	}
+	return stage1
+}
+
+// Constants for the caching_sha2_password plugin. They are sent in an
+// AuthMoreData packet after the client sent its scramble.
+const (
+	// cachingSha2FastAuthSuccess tells the client that its scramble
+	// was validated. It is followed by the OK packet.
+	cachingSha2FastAuthSuccess = 0x03
+
+	// cachingSha2PerformFullAuth asks the client to send its
+	// password in the clear.
+	cachingSha2PerformFullAuth = 0x04
+)
+
 // Constants for the dialog plugin.
 const (
 	mysqlDialogMessage = "Enter password: "
@@ -231,7 +311,8 @@ func AuthServerReadPacketString(c *Conn) (string, error) {
 
 // AuthServerNegotiateClearOrDialog will finish a negotiation based on
 // the method type for the connection. Only supports
-// MysqlClearPassword and MysqlDialog.
+// MysqlClearPassword, MysqlDialog and the full authentication of
+// MysqlCachingSha2Password.
 func AuthServerNegotiateClearOrDialog(c *Conn, method string) (string, error) {
 	switch method {
 	case MysqlClearPassword:
@@ -241,6 +322,11 @@ func AuthServerNegotiateClearOrDialog(c *Conn, method string) (string, error) {
 	case MysqlDialog:
 		return AuthServerReadPacketString(c)
 
+	case MysqlCachingSha2Password:
+		// The full authentication was requested: the password
+		// is the next packet in plain text too.
+		return AuthServerReadPacketString(c)
+
 	default:
 		return "", vterrors.Errorf(vtrpc.Code_INTERNAL, "unrecognized method: %v", method)
 	}
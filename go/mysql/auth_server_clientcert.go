@@ -17,17 +17,37 @@ limitations under the License.
 package mysql
 
 import (
+	"crypto/x509"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net"
 
 	"vitess.io/vitess/go/vt/log"
 )
 
-var clientcertAuthMethod = flag.String("mysql_clientcert_auth_method", MysqlClearPassword, "client-side authentication method to use. Supported values: mysql_clear_password, dialog.")
+var (
+	clientcertAuthMethod  = flag.String("mysql_clientcert_auth_method", MysqlClearPassword, "client-side authentication method to use. Supported values: mysql_clear_password, dialog, caching_sha2_password.")
+	clientcertUserMapFile = flag.String("mysql_clientcert_user_map_file", "", "JSON file mapping the identities of the client certs to users. The keys are the common names, DNS names or URIs of the certs, the values are objects with a Username and Groups. If not set, the username must match the common name of the cert.")
+)
 
+// AuthServerClientCert implements AuthServer by validating the TLS
+// client certificates of the connections.
 type AuthServerClientCert struct {
 	Method string
+
+	// UserMap maps the identities of the client certs to users.
+	// The identity is the common name, or one of the DNS names or
+	// URIs of the cert. If UserMap is nil, the username must match
+	// the common name of the cert, and the DNS names are the groups.
+	UserMap map[string]*ClientCertUser
+}
+
+// ClientCertUser is the user a client cert identity is mapped to.
+type ClientCertUser struct {
+	Username string
+	Groups   []string
 }
 
 // Init is public so it can be called from plugin_auth_clientcert.go (go/cmd/vtgate)
@@ -36,15 +56,41 @@ func InitAuthServerClientCert() {
 		log.Info("Not configuring AuthServerClientCert because mysql_server_ssl_ca is empty")
 		return
 	}
-	if *clientcertAuthMethod != MysqlClearPassword && *clientcertAuthMethod != MysqlDialog {
-		log.Exitf("Invalid mysql_clientcert_auth_method value: only support mysql_clear_password or dialog")
+	switch *clientcertAuthMethod {
+	case MysqlClearPassword, MysqlDialog, MysqlCachingSha2Password:
+	default:
+		log.Exitf("Invalid mysql_clientcert_auth_method value: only support mysql_clear_password, dialog or caching_sha2_password")
 	}
 	ascc := &AuthServerClientCert{
 		Method: *clientcertAuthMethod,
 	}
+	if *clientcertUserMapFile != "" {
+		userMap, err := loadClientCertUserMap(*clientcertUserMapFile)
+		if err != nil {
+			log.Exitf("Failed to load mysql_clientcert_user_map_file: %v", err)
+		}
+		ascc.UserMap = userMap
+	}
 	RegisterAuthServerImpl("clientcert", ascc)
 }
 
+func loadClientCertUserMap(file string) (map[string]*ClientCertUser, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	userMap := make(map[string]*ClientCertUser)
+	if err := json.Unmarshal(data, &userMap); err != nil {
+		return nil, err
+	}
+	for identity, user := range userMap {
+		if user == nil || user.Username == "" {
+			return nil, fmt.Errorf("no Username for client cert identity '%v'", identity)
+		}
+	}
+	return userMap, nil
+}
+
 // AuthMethod is part of the AuthServer interface.
 func (ascc *AuthServerClientCert) AuthMethod(user string) (string, error) {
 	return ascc.Method, nil
@@ -61,6 +107,8 @@ func (ascc *AuthServerClientCert) ValidateHash(salt []byte, user string, authRes
 }
 
 // Negotiate is part of the AuthServer interface.
+// With MysqlCachingSha2Password, it is called for the full authentication,
+// since the fast authentication has no access to the client certs.
 func (ascc *AuthServerClientCert) Negotiate(c *Conn, user string, remoteAddr net.Addr) (Getter, error) {
 	// This code depends on the fact that golang's tls server enforces client cert verification.
 	// Note that the -mysql_server_ssl_ca flag must be set in order for the vtgate to accept client certs.
@@ -71,10 +119,14 @@ func (ascc *AuthServerClientCert) Negotiate(c *Conn, user string, remoteAddr net
 		return nil, fmt.Errorf("no client certs for connection ID %v", c.ConnectionID)
 	}
 
-	if _, err := AuthServerReadPacketString(c); err != nil {
+	if _, err := AuthServerNegotiateClearOrDialog(c, ascc.Method); err != nil {
 		return nil, err
 	}
 
+	if ascc.UserMap != nil {
+		return ascc.mapUser(certs[0], user)
+	}
+
 	commonName := certs[0].Subject.CommonName
 
 	if user != commonName {
@@ -86,3 +138,27 @@ func (ascc *AuthServerClientCert) Negotiate(c *Conn, user string, remoteAddr net
 		groups:   certs[0].DNSNames,
 	}, nil
 }
+
+// mapUser returns the user the client cert is mapped to. The common
+// name is tried first, then the DNS names and URIs.
+func (ascc *AuthServerClientCert) mapUser(cert *x509.Certificate, user string) (Getter, error) {
+	identities := []string{cert.Subject.CommonName}
+	identities = append(identities, cert.DNSNames...)
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+	for _, identity := range identities {
+		mapped, ok := ascc.UserMap[identity]
+		if !ok {
+			continue
+		}
+		if user != mapped.Username {
+			return nil, fmt.Errorf("MySQL connection username '%v' does not match user '%v' of client cert '%v'", user, mapped.Username, identity)
+		}
+		return &StaticUserData{
+			username: mapped.Username,
+			groups:   mapped.Groups,
+		}, nil
+	}
+	return nil, fmt.Errorf("client cert '%v' is not mapped to a user", cert.Subject.CommonName)
+}
@@ -172,3 +172,98 @@ func TestNoCert(t *testing.T) {
 		conn.Close()
 	}
 }
+
+func TestCertUserMap(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := &AuthServerClientCert{
+		Method: MysqlCachingSha2Password,
+		UserMap: map[string]*ClientCertUser{
+			clientCertUsername: {
+				Username: "mapped_user",
+				Groups:   []string{"mapped_group"},
+			},
+		},
+	}
+
+	// Create the listener, so we can get its host.
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	host := l.Addr().(*net.TCPAddr).IP.String()
+	port := l.Addr().(*net.TCPAddr).Port
+
+	// Create the certs.
+	root, err := ioutil.TempDir("", "TestSSLConnection")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	tlstest.CreateCA(root)
+	tlstest.CreateSignedCert(root, tlstest.CA, "01", "server", "server.example.com")
+	tlstest.CreateSignedCert(root, tlstest.CA, "02", "client", clientCertUsername)
+	tlstest.CreateSignedCert(root, tlstest.CA, "03", "unmapped", "Unmapped Cert")
+
+	// Create the server with TLS config.
+	serverConfig, err := vttls.ServerConfig(
+		path.Join(root, "server-cert.pem"),
+		path.Join(root, "server-key.pem"),
+		path.Join(root, "ca-cert.pem"))
+	if err != nil {
+		t.Fatalf("TLSServerConfig failed: %v", err)
+	}
+	l.TLSConfig = serverConfig
+	go func() {
+		l.Accept()
+	}()
+
+	// Setup the right parameters.
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "mapped_user",
+		Pass:  "",
+		// SSL flags.
+		Flags:      CapabilityClientSSL,
+		SslCa:      path.Join(root, "ca-cert.pem"),
+		SslCert:    path.Join(root, "client-cert.pem"),
+		SslKey:     path.Join(root, "client-key.pem"),
+		ServerName: "server.example.com",
+	}
+
+	ctx := context.Background()
+	conn, err := Connect(ctx, params)
+	if err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+
+	userData := th.lastConn.UserData.Get()
+	if userData.Username != "mapped_user" {
+		t.Errorf("userdata username is %v, expected mapped_user", userData.Username)
+	}
+	if !reflect.DeepEqual(userData.Groups, []string{"mapped_group"}) {
+		t.Errorf("userdata groups is %v, expected [mapped_group]", userData.Groups)
+	}
+
+	// Send a ComQuit to avoid the error message on the server side.
+	conn.writeComQuit()
+	conn.Close()
+
+	// The username must match the mapped user.
+	params.Uname = clientCertUsername
+	if conn, err := Connect(ctx, params); err == nil {
+		conn.Close()
+		t.Errorf("Connect() should have errored due to the wrong username")
+	}
+
+	// The cert must be mapped.
+	params.Uname = "mapped_user"
+	params.SslCert = path.Join(root, "unmapped-cert.pem")
+	params.SslKey = path.Join(root, "unmapped-key.pem")
+	if conn, err := Connect(ctx, params); err == nil {
+		conn.Close()
+		t.Errorf("Connect() should have errored due to the unmapped cert")
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net"
+
+	"vitess.io/vitess/go/vt/log"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var mysqlAuthGroupMappingFile = flag.String("mysql_auth_group_mapping_file", "", "JSON File mapping the groups returned by the auth server, like LDAP groups or token groups, to the groups used in the table ACLs. The keys are the external groups, the values are lists of groups.")
+
+// AuthServerGroupMapping wraps an AuthServer, and adds the groups
+// mapped from the groups of the authenticated users. This lets the
+// groups of a central identity provider be reused in the ACLs.
+type AuthServerGroupMapping struct {
+	AuthServer
+	// Mapping maps an external group to the groups it grants.
+	Mapping map[string][]string
+}
+
+// InitAuthServerGroupMapping returns the AuthServer wrapped with the
+// group mapping of mysql_auth_group_mapping_file, or the AuthServer
+// itself if the flag is not set.
+func InitAuthServerGroupMapping(authServer AuthServer) AuthServer {
+	if *mysqlAuthGroupMappingFile == "" {
+		return authServer
+	}
+	data, err := ioutil.ReadFile(*mysqlAuthGroupMappingFile)
+	if err != nil {
+		log.Exitf("Failed to read mysql_auth_group_mapping_file: %v", err)
+	}
+	mapping := make(map[string][]string)
+	if err := json.Unmarshal(data, &mapping); err != nil {
+		log.Exitf("Failed to parse mysql_auth_group_mapping_file: %v", err)
+	}
+	return NewAuthServerGroupMapping(authServer, mapping)
+}
+
+// NewAuthServerGroupMapping returns a new AuthServerGroupMapping.
+func NewAuthServerGroupMapping(authServer AuthServer, mapping map[string][]string) *AuthServerGroupMapping {
+	return &AuthServerGroupMapping{
+		AuthServer: authServer,
+		Mapping:    mapping,
+	}
+}
+
+// ValidateHash is part of the AuthServer interface.
+func (a *AuthServerGroupMapping) ValidateHash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, error) {
+	getter, err := a.AuthServer.ValidateHash(salt, user, authResponse, remoteAddr)
+	return a.wrap(getter), err
+}
+
+// ValidateCachingSha2Hash is part of the CachingSha2AuthServer interface.
+// If the wrapped AuthServer doesn't implement it, the full
+// authentication is performed.
+func (a *AuthServerGroupMapping) ValidateCachingSha2Hash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, bool, error) {
+	cs, ok := a.AuthServer.(CachingSha2AuthServer)
+	if !ok {
+		return nil, false, nil
+	}
+	getter, ok, err := cs.ValidateCachingSha2Hash(salt, user, authResponse, remoteAddr)
+	return a.wrap(getter), ok, err
+}
+
+// Negotiate is part of the AuthServer interface.
+func (a *AuthServerGroupMapping) Negotiate(c *Conn, user string, remoteAddr net.Addr) (Getter, error) {
+	getter, err := a.AuthServer.Negotiate(c, user, remoteAddr)
+	return a.wrap(getter), err
+}
+
+func (a *AuthServerGroupMapping) wrap(getter Getter) Getter {
+	if getter == nil {
+		return nil
+	}
+	return &groupMappingUserData{
+		Getter:  getter,
+		mapping: a.Mapping,
+	}
+}
+
+// groupMappingUserData maps the groups when they're fetched, so the
+// groups refreshed by the wrapped Getter are mapped too.
+type groupMappingUserData struct {
+	Getter
+	mapping map[string][]string
+}
+
+// Get returns the wrapped user data, with the mapped groups added
+// after the original ones.
+func (gud *groupMappingUserData) Get() *querypb.VTGateCallerID {
+	callerID := gud.Getter.Get()
+	if callerID == nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	groups := make([]string, 0, len(callerID.Groups))
+	for _, group := range callerID.Groups {
+		if !seen[group] {
+			seen[group] = true
+			groups = append(groups, group)
+		}
+	}
+	for _, group := range callerID.Groups {
+		for _, mapped := range gud.mapping[group] {
+			if !seen[mapped] {
+				seen[mapped] = true
+				groups = append(groups, mapped)
+			}
+		}
+	}
+	return &querypb.VTGateCallerID{
+		Username: callerID.Username,
+		Groups:   groups,
+	}
+}
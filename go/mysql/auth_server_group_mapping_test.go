@@ -0,0 +1,75 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestGroupMapping(t *testing.T) {
+	static := NewAuthServerStatic()
+	static.Entries["user1"] = []*AuthServerStaticEntry{
+		{Password: "password1", UserData: "user1", Groups: []string{"ldap-dba", "ldap-dev", "readers"}},
+	}
+	auth := NewAuthServerGroupMapping(static, map[string][]string{
+		"ldap-dba": {"admins", "readers"},
+		"ldap-dev": {"writers", "readers"},
+		"unused":   {"other"},
+	})
+
+	salt, err := NewSalt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	scrambled := ScramblePassword(salt, []byte("password1"))
+	addr := &net.IPAddr{IP: net.ParseIP("127.0.0.1"), Zone: ""}
+
+	getter, err := auth.ValidateHash(salt, "user1", scrambled, addr)
+	if err != nil {
+		t.Fatalf("ValidateHash failed: %v", err)
+	}
+	want := &querypb.VTGateCallerID{
+		Username: "user1",
+		Groups:   []string{"ldap-dba", "ldap-dev", "readers", "admins", "writers"},
+	}
+	if got := getter.Get(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Get: %v, want %v", got, want)
+	}
+
+	// The caching_sha2_password fast authentication is
+	// delegated to the wrapped server.
+	scrambled = ScrambleCachingSha2Password(salt, []byte("password1"))
+	getter, ok, err := auth.ValidateCachingSha2Hash(salt, "user1", scrambled, addr)
+	if err != nil || !ok {
+		t.Fatalf("ValidateCachingSha2Hash: %v, %v, want true, nil", ok, err)
+	}
+	if got := getter.Get(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Get: %v, want %v", got, want)
+	}
+
+	// Servers that don't support the fast authentication
+	// require the full authentication.
+	auth = NewAuthServerGroupMapping(NewAuthServerToken(), nil)
+	getter, ok, err = auth.ValidateCachingSha2Hash(salt, "user1", scrambled, addr)
+	if getter != nil || ok || err != nil {
+		t.Errorf("ValidateCachingSha2Hash: %v, %v, %v, want nil, false, nil", getter, ok, err)
+	}
+}
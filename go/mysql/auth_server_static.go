@@ -38,6 +38,7 @@ var (
 	mysqlAuthServerStaticFile           = flag.String("mysql_auth_server_static_file", "", "JSON File to read the users/passwords from.")
 	mysqlAuthServerStaticString         = flag.String("mysql_auth_server_static_string", "", "JSON representation of the users/passwords config.")
 	mysqlAuthServerStaticReloadInterval = flag.Duration("mysql_auth_static_reload_interval", 0, "Ticker to reload credentials")
+	mysqlAuthServerStaticMethod         = flag.String("mysql_auth_server_static_method", MysqlNativePassword, "Authentication method used for the users/passwords config. Supported values: mysql_native_password, mysql_clear_password, dialog, caching_sha2_password.")
 )
 
 const (
@@ -50,6 +51,7 @@ type AuthServerStatic struct {
 	// - MysqlNativePassword
 	// - MysqlClearPassword
 	// - MysqlDialog
+	// - MysqlCachingSha2Password
 	// It defaults to MysqlNativePassword.
 	Method string
 	// This mutex helps us prevent data races between the multiple updates of Entries.
@@ -91,6 +93,12 @@ func InitAuthServerStatic() {
 		log.Exitf("Both mysql_auth_server_static_file and mysql_auth_server_static_string specified, can only use one.")
 	}
 
+	switch *mysqlAuthServerStaticMethod {
+	case MysqlNativePassword, MysqlClearPassword, MysqlDialog, MysqlCachingSha2Password:
+	default:
+		log.Exitf("Invalid mysql_auth_server_static_method value: %v", *mysqlAuthServerStaticMethod)
+	}
+
 	// Create and register auth server.
 	RegisterAuthServerStaticFromParams(*mysqlAuthServerStaticFile, *mysqlAuthServerStaticString)
 }
@@ -109,6 +117,7 @@ func NewAuthServerStatic() *AuthServerStatic {
 // of error.
 func RegisterAuthServerStaticFromParams(file, str string) {
 	authServerStatic := NewAuthServerStatic()
+	authServerStatic.Method = *mysqlAuthServerStaticMethod
 
 	authServerStatic.loadConfigFromParams(file, str)
 
@@ -247,9 +256,38 @@ func (a *AuthServerStatic) ValidateHash(salt []byte, user string, authResponse [
 	return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
 }
 
+// ValidateCachingSha2Hash is part of the CachingSha2AuthServer interface.
+// The scramble can only be validated if the clear text passwords of the
+// user are known. If an entry of the user only has a MysqlNativePassword
+// hash, the full authentication is requested.
+func (a *AuthServerStatic) ValidateCachingSha2Hash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, bool, error) {
+	a.mu.Lock()
+	entries, ok := a.Entries[user]
+	a.mu.Unlock()
+
+	if !ok {
+		return &StaticUserData{}, true, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+
+	for _, entry := range entries {
+		if entry.MysqlNativePassword != "" {
+			return nil, false, nil
+		}
+	}
+	for _, entry := range entries {
+		computedAuthResponse := ScrambleCachingSha2Password(salt, []byte(entry.Password))
+		// Validate the password.
+		if matchSourceHost(remoteAddr, entry.SourceHost) && bytes.Equal(authResponse, computedAuthResponse) {
+			return &StaticUserData{entry.UserData, entry.Groups}, true, nil
+		}
+	}
+	return &StaticUserData{}, true, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+}
+
 // Negotiate is part of the AuthServer interface.
 // It will be called if Method is anything else than MysqlNativePassword.
-// We only recognize MysqlClearPassword and MysqlDialog here.
+// We only recognize MysqlClearPassword, MysqlDialog and the full
+// authentication of MysqlCachingSha2Password here.
 func (a *AuthServerStatic) Negotiate(c *Conn, user string, remoteAddr net.Addr) (Getter, error) {
 	// Finish the negotiation.
 	password, err := AuthServerNegotiateClearOrDialog(c, a.Method)
@@ -265,8 +303,15 @@ func (a *AuthServerStatic) Negotiate(c *Conn, user string, remoteAddr net.Addr)
 		return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
 	}
 	for _, entry := range entries {
+		if !matchSourceHost(remoteAddr, entry.SourceHost) {
+			continue
+		}
 		// Validate the password.
-		if matchSourceHost(remoteAddr, entry.SourceHost) && entry.Password == password {
+		if entry.MysqlNativePassword != "" {
+			if isPassMysqlNativePassword(password, entry.MysqlNativePassword) {
+				return &StaticUserData{entry.UserData, entry.Groups}, nil
+			}
+		} else if entry.Password == password {
 			return &StaticUserData{entry.UserData, entry.Groups}, nil
 		}
 	}
@@ -292,3 +292,79 @@ func TestStaticPasswords(t *testing.T) {
 		})
 	}
 }
+
+func TestStaticCachingSha2Passwords(t *testing.T) {
+	jsonConfig := `
+{
+	"user01": [{ "Password": "user01" }],
+	"user02": [{
+		"MysqlNativePassword": "*B3AD996B12F211BEA47A7C666CC136FB26DC96AF"
+	}],
+	"user04": [
+		{ "MysqlNativePassword": "*668425423DB5193AF921380129F465A6425216D0" },
+		{ "Password": "password2" }
+	]
+}`
+
+	tests := []struct {
+		user      string
+		password  string
+		validated bool
+		success   bool
+	}{
+		{"user01", "user01", true, true},
+		{"user01", "password", true, false},
+		{"user01", "", true, false},
+		// The scrambles of users with a MysqlNativePassword
+		// cannot be validated.
+		{"user02", "user02", false, false},
+		{"user04", "password2", false, false},
+		{"userXX", "", true, false},
+	}
+
+	auth := NewAuthServerStatic()
+	auth.loadConfigFromParams("", jsonConfig)
+	ip := net.ParseIP("127.0.0.1")
+	addr := &net.IPAddr{IP: ip, Zone: ""}
+
+	for _, c := range tests {
+		t.Run(fmt.Sprintf("%s-%s", c.user, c.password), func(t *testing.T) {
+			salt, err := NewSalt()
+			if err != nil {
+				t.Fatalf("error generating salt: %v", err)
+			}
+
+			scrambled := ScrambleCachingSha2Password(salt, []byte(c.password))
+			_, validated, err := auth.ValidateCachingSha2Hash(salt, c.user, scrambled, addr)
+			if validated != c.validated {
+				t.Fatalf("ValidateCachingSha2Hash validated: %v, want %v", validated, c.validated)
+			}
+			if c.success && err != nil {
+				t.Fatalf("authentication should have succeeded: %v", err)
+			}
+			if !c.success && validated && err == nil {
+				t.Fatalf("authentication should have failed")
+			}
+		})
+	}
+}
+
+func TestIsPassMysqlNativePassword(t *testing.T) {
+	tests := []struct {
+		password string
+		hash     string
+		success  bool
+	}{
+		{"password1", "*668425423DB5193AF921380129F465A6425216D0", true},
+		{"password1", "668425423DB5193AF921380129F465A6425216D0", true},
+		{"password2", "*668425423DB5193AF921380129F465A6425216D0", false},
+		{"", "*668425423DB5193AF921380129F465A6425216D0", false},
+		{"password1", "", false},
+		{"password1", "*not hex", false},
+	}
+	for _, c := range tests {
+		if got := isPassMysqlNativePassword(c.password, c.hash); got != c.success {
+			t.Errorf("isPassMysqlNativePassword(%v, %v): %v, want %v", c.password, c.hash, got, c.success)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	mysqlAuthServerTokenFile   = flag.String("mysql_auth_server_token_file", "", "JSON File to read the tokens from. The keys are the hex encoded SHA256 hashes of the tokens, the values are objects with a Username, Groups and an optional Expiration. The file is reloaded on SIGHUP.")
+	mysqlAuthServerTokenMethod = flag.String("mysql_auth_server_token_method", MysqlClearPassword, "client-side authentication method to use to send the tokens. Supported values: mysql_clear_password, dialog, caching_sha2_password.")
+)
+
+// AuthServerToken implements AuthServer for clients that authenticate
+// with a token, like an OAuth access token issued by a central identity
+// provider, sent as their password. The tokens are sent in the clear,
+// so they should only be used over SSL.
+// Only the SHA256 hashes of the tokens are stored.
+type AuthServerToken struct {
+	// Method can be set to:
+	// - MysqlClearPassword
+	// - MysqlDialog
+	// - MysqlCachingSha2Password
+	// It defaults to MysqlClearPassword.
+	Method string
+	// This mutex helps us prevent data races between the multiple updates of Entries.
+	mu sync.Mutex
+	// Entries maps the hex encoded SHA256 hashes of the tokens
+	// to their user.
+	Entries map[string]*AuthServerTokenEntry
+}
+
+// AuthServerTokenEntry stores the user of a token.
+type AuthServerTokenEntry struct {
+	// Username is the user the token was issued for. The
+	// connection username must match it.
+	Username string
+	Groups   []string
+	// Expiration is the time after which the token is rejected.
+	// If zero, the token doesn't expire.
+	Expiration time.Time
+}
+
+// InitAuthServerToken handles initializing the AuthServerToken if necessary.
+func InitAuthServerToken() {
+	if *mysqlAuthServerTokenFile == "" {
+		log.Infof("Not configuring AuthServerToken, as mysql_auth_server_token_file is empty")
+		return
+	}
+	switch *mysqlAuthServerTokenMethod {
+	case MysqlClearPassword, MysqlDialog, MysqlCachingSha2Password:
+	default:
+		log.Exitf("Invalid mysql_auth_server_token_method value: only support mysql_clear_password, dialog or caching_sha2_password")
+	}
+
+	authServerToken := NewAuthServerToken()
+	authServerToken.Method = *mysqlAuthServerTokenMethod
+	if err := authServerToken.loadConfig(*mysqlAuthServerTokenFile); err != nil {
+		log.Exitf("Failed to load mysql_auth_server_token_file: %v", err)
+	}
+	authServerToken.installSignalHandlers(*mysqlAuthServerTokenFile)
+
+	RegisterAuthServerImpl("token", authServerToken)
+}
+
+// NewAuthServerToken returns a new empty AuthServerToken.
+func NewAuthServerToken() *AuthServerToken {
+	return &AuthServerToken{
+		Method:  MysqlClearPassword,
+		Entries: make(map[string]*AuthServerTokenEntry),
+	}
+}
+
+// HashToken returns the hex encoded SHA256 hash of a token, as used
+// for the keys of the AuthServerToken entries.
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func (a *AuthServerToken) loadConfig(file string) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	return a.parseConfig(data)
+}
+
+func (a *AuthServerToken) parseConfig(data []byte) error {
+	entries := make(map[string]*AuthServerTokenEntry)
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	for hash, entry := range entries {
+		if entry == nil || entry.Username == "" {
+			return NewSQLError(ERUnknownError, SSUnknownSQLState, "no Username for token hash %v", hash)
+		}
+	}
+
+	a.mu.Lock()
+	a.Entries = entries
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *AuthServerToken) installSignalHandlers(file string) {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGHUP)
+	go func() {
+		for range sigChan {
+			if err := a.loadConfig(file); err != nil {
+				log.Errorf("Failed to reload mysql_auth_server_token_file: %v", err)
+			}
+		}
+	}()
+}
+
+// AuthMethod is part of the AuthServer interface.
+func (a *AuthServerToken) AuthMethod(user string) (string, error) {
+	return a.Method, nil
+}
+
+// Salt is part of the AuthServer interface.
+func (a *AuthServerToken) Salt() ([]byte, error) {
+	return NewSalt()
+}
+
+// ValidateHash is unimplemented for AuthServerToken.
+func (a *AuthServerToken) ValidateHash(salt []byte, user string, authResponse []byte, remoteAddr net.Addr) (Getter, error) {
+	panic("unimplemented")
+}
+
+// Negotiate is part of the AuthServer interface.
+func (a *AuthServerToken) Negotiate(c *Conn, user string, remoteAddr net.Addr) (Getter, error) {
+	// Finish the negotiation.
+	token, err := AuthServerNegotiateClearOrDialog(c, a.Method)
+	if err != nil {
+		return nil, err
+	}
+	return a.validate(user, token, time.Now())
+}
+
+func (a *AuthServerToken) validate(user, token string, now time.Time) (Getter, error) {
+	a.mu.Lock()
+	entry, ok := a.Entries[HashToken(token)]
+	a.mu.Unlock()
+
+	if !ok || token == "" || entry.Username != user {
+		return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+	if !entry.Expiration.IsZero() && now.After(entry.Expiration) {
+		return &StaticUserData{}, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v': token expired", user)
+	}
+	return &StaticUserData{entry.Username, entry.Groups}, nil
+}
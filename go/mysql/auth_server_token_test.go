@@ -0,0 +1,160 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestTokenParseConfig(t *testing.T) {
+	auth := NewAuthServerToken()
+	config := fmt.Sprintf(`{"%v": {"Username": "user1", "Groups": ["group1"], "Expiration": "2019-06-01T00:00:00Z"}}`, HashToken("token1"))
+	if err := auth.parseConfig([]byte(config)); err != nil {
+		t.Fatalf("parseConfig failed: %v", err)
+	}
+	want := map[string]*AuthServerTokenEntry{
+		HashToken("token1"): {
+			Username:   "user1",
+			Groups:     []string{"group1"},
+			Expiration: time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	if !reflect.DeepEqual(auth.Entries, want) {
+		t.Errorf("Entries: %+v, want %+v", auth.Entries, want)
+	}
+
+	// An entry without a Username is invalid, and the
+	// previous entries are kept.
+	err := auth.parseConfig([]byte(`{"abc": {"Groups": ["group1"]}}`))
+	if err == nil || !strings.Contains(err.Error(), "no Username for token hash abc") {
+		t.Errorf("parseConfig err: %v, want no Username", err)
+	}
+	if !reflect.DeepEqual(auth.Entries, want) {
+		t.Errorf("Entries: %+v, want %+v", auth.Entries, want)
+	}
+}
+
+func TestTokenValidate(t *testing.T) {
+	now := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	auth := NewAuthServerToken()
+	auth.Entries[HashToken("token1")] = &AuthServerTokenEntry{
+		Username: "user1",
+		Groups:   []string{"group1"},
+	}
+	auth.Entries[HashToken("token2")] = &AuthServerTokenEntry{
+		Username:   "user2",
+		Expiration: now.Add(-time.Second),
+	}
+	auth.Entries[HashToken("")] = &AuthServerTokenEntry{
+		Username: "user3",
+	}
+
+	getter, err := auth.validate("user1", "token1", now)
+	if err != nil {
+		t.Fatalf("validate failed: %v", err)
+	}
+	want := &querypb.VTGateCallerID{Username: "user1", Groups: []string{"group1"}}
+	if got := getter.Get(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Get: %v, want %v", got, want)
+	}
+
+	testcases := []struct {
+		user, token, err string
+	}{{
+		user:  "user2",
+		token: "token1",
+		err:   "Access denied for user 'user2'",
+	}, {
+		user:  "user1",
+		token: "unknown",
+		err:   "Access denied for user 'user1'",
+	}, {
+		user:  "user2",
+		token: "token2",
+		err:   "Access denied for user 'user2': token expired",
+	}, {
+		user:  "user3",
+		token: "",
+		err:   "Access denied for user 'user3'",
+	}}
+	for _, tc := range testcases {
+		_, err := auth.validate(tc.user, tc.token, now)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("validate(%v, %v) err: %v, want %v", tc.user, tc.token, err, tc.err)
+		}
+	}
+}
+
+func TestTokenClientAuth(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerToken()
+	authServer.Entries[HashToken("token1")] = &AuthServerTokenEntry{
+		Username: "user1",
+	}
+
+	// Create the listener.
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	l.AllowClearTextWithoutTLS = true
+	host := l.Addr().(*net.TCPAddr).IP.String()
+	port := l.Addr().(*net.TCPAddr).Port
+	go func() {
+		l.Accept()
+	}()
+
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "token1",
+	}
+	ctx := context.Background()
+	conn, err := Connect(ctx, params)
+	if err != nil {
+		t.Fatalf("unexpected connection error: %v", err)
+	}
+	defer conn.Close()
+
+	result, err := conn.ExecuteFetch("userData echo", 10000, true)
+	if err != nil {
+		t.Fatalf("ExecuteFetch failed: %v", err)
+	}
+	if got := result.Rows[0][1].ToString(); got != "user1" {
+		t.Errorf("Got wrong user data: %v, want user1", got)
+	}
+	conn.writeComQuit()
+
+	// A wrong token is rejected.
+	params.Pass = "token2"
+	_, err = Connect(ctx, params)
+	if err == nil || !strings.Contains(err.Error(), "Access denied for user 'user1'") {
+		t.Errorf("unexpected connection error: %v", err)
+	}
+}
@@ -302,24 +302,29 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 		c.User = params.Uname
 	case AuthSwitchRequestPacket:
 		// Server is asking to use a different auth method. We
-		// only support cleartext plugin.
-		pluginName, _, err := parseAuthSwitchRequest(response)
+		// only support cleartext and caching_sha2_password plugins.
+		pluginName, pluginData, err := parseAuthSwitchRequest(response)
 		if err != nil {
 			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "cannot parse auth switch request: %v", err)
 		}
-		if pluginName != MysqlClearPassword {
-			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "server asked for unsupported auth method: %v", pluginName)
-		}
-
-		// Write the password packet.
-		if err := c.writeClearTextPassword(params); err != nil {
-			return err
-		}
+		switch pluginName {
+		case MysqlClearPassword:
+			// Write the password packet.
+			if err := c.writeClearTextPassword(params); err != nil {
+				return err
+			}
 
-		// Wait for OK packet.
-		response, err = c.readPacket()
-		if err != nil {
-			return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+			// Wait for OK packet.
+			response, err = c.readPacket()
+			if err != nil {
+				return NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+			}
+		case MysqlCachingSha2Password:
+			if response, err = c.clientCachingSha2Password(params, pluginData); err != nil {
+				return err
+			}
+		default:
+			return NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "server asked for unsupported auth method: %v", pluginName)
 		}
 		switch response[0] {
 		case OKPacket:
@@ -492,8 +497,11 @@ func (c *Conn) parseInitialHandshakePacket(data []byte) (uint32, []byte, error)
 			authPluginName = string(data[pos : len(data)-1])
 		}
 
-		if authPluginName != MysqlNativePassword {
-			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: only support %v auth plugin name, but got %v", MysqlNativePassword, authPluginName)
+		// We always answer with MysqlNativePassword. A server
+		// defaulting to MysqlCachingSha2Password then sends an
+		// AuthSwitchRequest if the user needs it.
+		if authPluginName != MysqlNativePassword && authPluginName != MysqlCachingSha2Password {
+			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: only support %v and %v auth plugin names, but got %v", MysqlNativePassword, MysqlCachingSha2Password, authPluginName)
 		}
 	}
 
@@ -652,6 +660,63 @@ func parseAuthSwitchRequest(data []byte) (string, []byte, error) {
 	return pluginName, data[pos:], nil
 }
 
+// clientCachingSha2Password handles the client side of the
+// caching_sha2_password authentication, once the server switched to it.
+// It returns the last packet sent by the server.
+// Returns a SQLError.
+func (c *Conn) clientCachingSha2Password(params *ConnParams, pluginData []byte) ([]byte, error) {
+	// The salt is 0 terminated.
+	salt := pluginData
+	if len(salt) > 0 && salt[len(salt)-1] == 0 {
+		salt = salt[:len(salt)-1]
+	}
+	if err := c.writeScrambledPassword(ScrambleCachingSha2Password(salt, []byte(params.Pass))); err != nil {
+		return nil, err
+	}
+
+	response, err := c.readPacket()
+	if err != nil {
+		return nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+	}
+	if response[0] != AuthMoreDataPacket || len(response) != 2 {
+		return response, nil
+	}
+	switch response[1] {
+	case cachingSha2FastAuthSuccess:
+		// Our scramble was validated, the OK packet follows.
+	case cachingSha2PerformFullAuth:
+		// The server wants our password in clear text. We don't
+		// support the RSA encryption of the password, so we only
+		// send it over SSL.
+		if c.Capabilities&CapabilityClientSSL == 0 {
+			return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "server asked for caching_sha2_password full authentication over a non-SSL connection")
+		}
+		if err := c.writeClearTextPassword(params); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "unexpected caching_sha2_password status: %v", response[1])
+	}
+
+	response, err = c.readPacket()
+	if err != nil {
+		return nil, NewSQLError(CRServerLost, SSUnknownSQLState, "%v", err)
+	}
+	return response, nil
+}
+
+// writeScrambledPassword writes the scrambled password, in
+// response to an AuthSwitchRequest.
+// Returns a SQLError.
+func (c *Conn) writeScrambledPassword(scrambledPassword []byte) error {
+	data := c.startEphemeralPacket(len(scrambledPassword))
+	copy(data, scrambledPassword)
+	if err := c.writeEphemeralPacket(); err != nil {
+		return NewSQLError(CRServerLost, SSUnknownSQLState, "cannot send scrambled password: %v", err)
+	}
+	return nil
+}
+
 // writeClearTextPassword writes the clear text password.
 // Returns a SQLError.
 func (c *Conn) writeClearTextPassword(params *ConnParams) error {
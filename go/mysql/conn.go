@@ -175,6 +175,14 @@ type PrepareData struct {
 	ParamsType  []int32
 	ColumnNames []string
 	BindVars    map[string]*querypb.BindVariable
+
+	// Cursor is set when the statement is executed with a read only
+	// cursor. Its results are fetched by the client in batches, so
+	// the Handler should stream them instead of buffering them.
+	Cursor bool
+
+	// cursor is the open cursor of the statement, if any.
+	cursor *cursor
 }
 
 // bufPool is used to allocate and free buffers in an efficient way.
@@ -726,6 +734,14 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 		return err
 	}
 
+	// The Handler is not called concurrently for a connection, so the
+	// open cursors are closed before the commands that call it.
+	switch data[0] {
+	case ComPing, ComStmtSendLongData, ComStmtClose, ComStmtReset, ComStmtFetch:
+	default:
+		c.closeCursors()
+	}
+
 	switch data[0] {
 	case ComQuit:
 		c.recycleReadPacket()
@@ -763,14 +779,26 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 		} else {
 			queries = []string{query}
 		}
-		for index, sql := range queries {
-			more := false
-			if index != len(queries)-1 {
-				more = true
-			}
-			if err := c.execQuery(sql, handler, more); err != nil {
+		if batchHandler, ok := handler.(BatchHandler); ok && len(queries) > 1 && batchHandler.UseBatch(c) {
+			if err := c.execQueryBatch(queries, batchHandler, handler); err != nil {
 				return err
 			}
+		} else {
+			for index, sql := range queries {
+				more := false
+				if index != len(queries)-1 {
+					more = true
+				}
+				ok, err := c.execQuery(sql, handler, more)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					// Like MySQL, the statements after a failed one
+					// are not executed.
+					break
+				}
+			}
 		}
 
 		timings.Record(queryTimingKey, queryStart)
@@ -892,7 +920,7 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 
 	case ComStmtExecute:
 		queryStart := time.Now()
-		stmtID, _, err := c.parseComStmtExecute(c.PrepareData, data)
+		stmtID, cursorType, err := c.parseComStmtExecute(c.PrepareData, data)
 		c.recycleReadPacket()
 
 		if stmtID != uint32(0) {
@@ -912,10 +940,19 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 			return nil
 		}
 
+		prepare := c.PrepareData[stmtID]
+		if cursorType&CursorTypeReadOnly != 0 {
+			if err := c.execCursor(handler, prepare); err != nil {
+				log.Errorf("Error writing cursor result to %s: %v", c, err)
+				return err
+			}
+			timings.Record(queryTimingKey, queryStart)
+			return nil
+		}
+
 		fieldSent := false
 		// sendFinished is set if the response should just be an OK packet.
 		sendFinished := false
-		prepare := c.PrepareData[stmtID]
 		err = handler.ComStmtExecute(c, prepare, func(qr *sqltypes.Result) error {
 			if sendFinished {
 				// Failsafe: Unreachable if server is well-behaved.
@@ -1005,7 +1042,8 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 	case ComStmtClose:
 		stmtID, ok := c.parseComStmtClose(data)
 		c.recycleReadPacket()
-		if ok {
+		if prepare, found := c.PrepareData[stmtID]; ok && found {
+			c.closeCursor(prepare)
 			delete(c.PrepareData, stmtID)
 		}
 	case ComStmtReset:
@@ -1028,6 +1066,7 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 			}
 		}
 
+		c.closeCursor(prepare)
 		if prepare.BindVars != nil {
 			for k := range prepare.BindVars {
 				prepare.BindVars[k] = nil
@@ -1039,6 +1078,22 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 			return err
 		}
 
+	case ComStmtFetch:
+		stmtID, numRows, ok := c.parseComStmtFetch(data)
+		c.recycleReadPacket()
+		if !ok {
+			log.Errorf("Got unhandled packet from %s, returning error: %v", c, data)
+			if err := c.writeErrorPacket(ERUnknownComError, SSUnknownComError, "error handling packet: %v", data); err != nil {
+				log.Errorf("Error writing error packet to %s: %v", c, err)
+				return err
+			}
+			return nil
+		}
+		if err := c.fetchCursor(stmtID, numRows); err != nil {
+			log.Errorf("Error writing fetched rows to %s: %v", c, err)
+			return err
+		}
+
 	case ComResetConnection:
 		// Clean up and reset the connection
 		c.recycleReadPacket()
@@ -1062,7 +1117,9 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 	return nil
 }
 
-func (c *Conn) execQuery(query string, handler Handler, more bool) error {
+// execQuery executes one query, and sends its result to the client.
+// It returns false if an error was sent to the client instead.
+func (c *Conn) execQuery(query string, handler Handler, more bool) (bool, error) {
 	fieldSent := false
 	// sendFinished is set if the response should just be an OK packet.
 	sendFinished := false
@@ -1108,27 +1165,78 @@ func (c *Conn) execQuery(query string, handler Handler, more bool) error {
 		if werr := c.writeErrorPacketFromError(err); werr != nil {
 			// If we can't even write the error, we're done.
 			log.Errorf("Error writing query error to %s: %v", c, werr)
-			return werr
+			return false, werr
 		}
-	} else {
-		if err != nil {
-			// We can't send an error in the middle of a stream.
-			// All we can do is abort the send, which will cause a 2013.
-			log.Errorf("Error in the middle of a stream to %s: %v", c, err)
-			return err
+		return false, nil
+	}
+	if err != nil {
+		// We can't send an error in the middle of a stream.
+		// All we can do is abort the send, which will cause a 2013.
+		log.Errorf("Error in the middle of a stream to %s: %v", c, err)
+		return false, err
+	}
+
+	// Send the end packet only sendFinished is false (results were streamed).
+	// In this case the affectedRows and lastInsertID are always 0 since it
+	// was a read operation.
+	if !sendFinished {
+		if err := c.writeEndResult(more, 0, 0, handler.WarningCount(c)); err != nil {
+			log.Errorf("Error writing result to %s: %v", c, err)
+			return false, err
 		}
+	}
 
-		// Send the end packet only sendFinished is false (results were streamed).
-		// In this case the affectedRows and lastInsertID are always 0 since it
-		// was a read operation.
-		if !sendFinished {
-			if err := c.writeEndResult(more, 0, 0, handler.WarningCount(c)); err != nil {
-				log.Errorf("Error writing result to %s: %v", c, err)
-				return err
+	return true, nil
+}
+
+// execQueryBatch executes the queries of a multi-statement query with
+// one call to the BatchHandler, and sends their results to the client.
+// If a query fails, its error is sent after the results of the queries
+// before it, and the queries after it are not executed.
+func (c *Conn) execQueryBatch(queries []string, batchHandler BatchHandler, handler Handler) error {
+	results, err := batchHandler.ComQueryBatch(c, queries)
+	if err == nil && len(results) != len(queries) {
+		// This is just a failsafe. Should never happen.
+		err = NewSQLErrorFromError(fmt.Errorf("unexpected: %v results for %v queries", len(results), len(queries)))
+		if len(results) > len(queries) {
+			results = results[:len(queries)]
+		}
+	}
+
+	for index, qr := range results {
+		more := index != len(queries)-1
+		if len(qr.Fields) == 0 {
+			flag := c.StatusFlags
+			if more {
+				flag |= ServerMoreResultsExists
 			}
+			if werr := c.writeOKPacket(qr.RowsAffected, qr.InsertID, flag, handler.WarningCount(c)); werr != nil {
+				log.Errorf("Error writing result to %s: %v", c, werr)
+				return werr
+			}
+			continue
+		}
+		if werr := c.writeFields(qr); werr != nil {
+			log.Errorf("Error writing fields to %s: %v", c, werr)
+			return werr
+		}
+		if werr := c.writeRows(qr); werr != nil {
+			log.Errorf("Error writing rows to %s: %v", c, werr)
+			return werr
+		}
+		if werr := c.writeEndResult(more, 0, 0, handler.WarningCount(c)); werr != nil {
+			log.Errorf("Error writing result to %s: %v", c, werr)
+			return werr
 		}
 	}
 
+	if err != nil {
+		if werr := c.writeErrorPacketFromError(err); werr != nil {
+			// If we can't even write the error, we're done.
+			log.Errorf("Error writing query error to %s: %v", c, werr)
+			return werr
+		}
+	}
 	return nil
 }
 
@@ -37,6 +37,12 @@ const (
 	// MysqlDialog uses the dialog plugin on the client side.
 	// It transmits data in the clear.
 	MysqlDialog = "dialog"
+
+	// MysqlCachingSha2Password uses a salt and transmits a SHA256 hash
+	// on the wire. If the server cannot validate the hash, the password
+	// is transmitted in the clear, which requires a secure connection.
+	// It is the default in MySQL 8.0.
+	MysqlCachingSha2Password = "caching_sha2_password"
 )
 
 // Capability flags.
@@ -189,6 +195,10 @@ const (
 	// AuthSwitchRequestPacket is used to switch auth method.
 	AuthSwitchRequestPacket = 0xfe
 
+	// AuthMoreDataPacket is used by the server to send more data
+	// during the authentication, like the caching_sha2_password status.
+	AuthMoreDataPacket = 0x01
+
 	// ErrPacket is the header of the error packet.
 	ErrPacket = 0xff
 
@@ -252,6 +262,9 @@ const (
 const (
 	// ERVitessMaxRowsExceeded is when a user tries to select more rows than the max rows as enforced by vitess.
 	ERVitessMaxRowsExceeded = 10001
+
+	// ERVitessQueryTrace is the code of the warnings that contain the timings of a traced query.
+	ERVitessQueryTrace = 10002
 )
 
 // Error codes for server-side errors.
@@ -443,6 +456,7 @@ const (
 	ERQueryInterrupted             = 1317
 	ERTruncatedWrongValueForField  = 1366
 	ERDataTooLong                  = 1406
+	ERStmtHasNoOpenCursor          = 1421
 	ERDataOutOfRange               = 1690
 )
 
@@ -499,6 +513,19 @@ const (
 
 	// ServerMoreResultsExists is SERVER_MORE_RESULTS_EXISTS
 	ServerMoreResultsExists = 0x0008
+
+	// ServerStatusCursorExists is SERVER_STATUS_CURSOR_EXISTS
+	ServerStatusCursorExists = 0x0040
+
+	// ServerStatusLastRowSent is SERVER_STATUS_LAST_ROW_SENT
+	ServerStatusLastRowSent = 0x0080
+)
+
+// Cursor type flags of COM_STMT_EXECUTE.
+// Originally found in include/mysql/mysql_com.h
+const (
+	// CursorTypeReadOnly is CURSOR_TYPE_READ_ONLY.
+	CursorTypeReadOnly = 0x01
 )
 
 // A few interesting character set values.
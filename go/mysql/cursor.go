@@ -0,0 +1,233 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"errors"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// cursor streams the results of a prepared statement executed with a
+// read only cursor. The Handler executes the statement in its own
+// goroutine, and its callback blocks until the client fetches the
+// rows, so that the results are never buffered entirely.
+//
+// The Handler is not called concurrently for a connection: the open
+// cursors are closed before the commands that call it.
+type cursor struct {
+	fields []*querypb.Field
+	// results receives the results of the statement. It is closed
+	// when the Handler returns, after err is set.
+	results chan *sqltypes.Result
+	err     error
+	// done is closed to stop the statement, and ended is closed when
+	// the Handler returns.
+	done  chan struct{}
+	ended chan struct{}
+	// rows are the rows of the last result that were not fetched yet.
+	rows [][]sqltypes.Value
+}
+
+func newCursor(c *Conn, handler Handler, prepare *PrepareData) *cursor {
+	cur := &cursor{
+		results: make(chan *sqltypes.Result),
+		done:    make(chan struct{}),
+		ended:   make(chan struct{}),
+	}
+	go func() {
+		cur.err = handler.ComStmtExecute(c, prepare, func(qr *sqltypes.Result) error {
+			select {
+			case cur.results <- qr:
+				return nil
+			case <-cur.done:
+				return io.EOF
+			}
+		})
+		close(cur.results)
+		close(cur.ended)
+	}()
+	return cur
+}
+
+// next returns the next result of the statement, or nil once the
+// statement ended.
+func (cur *cursor) next() (*sqltypes.Result, error) {
+	qr, ok := <-cur.results
+	if !ok {
+		return nil, cur.err
+	}
+	return qr, nil
+}
+
+// fetch returns the next count rows, or less if the statement ended.
+// finished is set once all the rows were returned.
+func (cur *cursor) fetch(count int) (rows [][]sqltypes.Value, finished bool, err error) {
+	for len(rows) < count {
+		if len(cur.rows) == 0 {
+			qr, err := cur.next()
+			if err != nil {
+				return nil, false, err
+			}
+			if qr == nil {
+				return rows, true, nil
+			}
+			cur.rows = qr.Rows
+			continue
+		}
+		n := count - len(rows)
+		if n > len(cur.rows) {
+			n = len(cur.rows)
+		}
+		rows = append(rows, cur.rows[:n]...)
+		cur.rows = cur.rows[n:]
+	}
+	return rows, false, nil
+}
+
+// close stops the statement, and waits for the Handler to return.
+func (cur *cursor) close() {
+	close(cur.done)
+	<-cur.ended
+}
+
+// execCursor executes a prepared statement with a read only cursor. It
+// only sends the fields of the result, the rows are sent when the
+// client fetches them. The statements that don't return rows are
+// executed as if they had no cursor.
+func (c *Conn) execCursor(handler Handler, prepare *PrepareData) error {
+	c.closeCursor(prepare)
+
+	// The Handler may still use the bind variables after the
+	// statement is reset for its next execution.
+	cursorPrepare := *prepare
+	cursorPrepare.BindVars = copyBindVars(prepare.BindVars)
+	cursorPrepare.Cursor = true
+	cur := newCursor(c, handler, &cursorPrepare)
+	qr, err := cur.next()
+	if err != nil || qr == nil || len(qr.Fields) == 0 {
+		cur.close()
+		if err != nil {
+			return c.writeErrorPacketFromError(err)
+		}
+		if qr == nil {
+			// This is just a failsafe. Should never happen.
+			return c.writeErrorPacketFromError(NewSQLErrorFromError(errors.New("unexpected: query ended without no results and no error")))
+		}
+		return c.writeOKPacket(qr.RowsAffected, qr.InsertID, c.StatusFlags, 0)
+	}
+	cur.fields = qr.Fields
+	cur.rows = qr.Rows
+	prepare.cursor = cur
+
+	c.startWriterBuffering()
+	if err := c.sendColumnCount(uint64(len(cur.fields))); err != nil {
+		c.flush()
+		return err
+	}
+	for _, field := range cur.fields {
+		if err := c.writeColumnDefinition(field); err != nil {
+			c.flush()
+			return err
+		}
+	}
+	if err := c.writeCursorStatus(0); err != nil {
+		c.flush()
+		return err
+	}
+	return c.flush()
+}
+
+// fetchCursor sends the next rows of the cursor of a statement for
+// COM_STMT_FETCH. The cursor is closed once all the rows were sent.
+func (c *Conn) fetchCursor(stmtID, numRows uint32) error {
+	prepare, ok := c.PrepareData[stmtID]
+	if !ok || prepare.cursor == nil {
+		return c.writeErrorPacket(ERStmtHasNoOpenCursor, SSUnknownSQLState, "The statement (%v) has no open cursor.", stmtID)
+	}
+	cur := prepare.cursor
+	rows, finished, err := cur.fetch(int(numRows))
+	if err != nil {
+		c.closeCursor(prepare)
+		return c.writeErrorPacketFromError(err)
+	}
+
+	c.startWriterBuffering()
+	for _, row := range rows {
+		if err := c.writeBinaryRow(cur.fields, row); err != nil {
+			c.flush()
+			return err
+		}
+	}
+	var flags uint16
+	if finished {
+		c.closeCursor(prepare)
+		flags = ServerStatusLastRowSent
+	}
+	if err := c.writeCursorStatus(flags); err != nil {
+		c.flush()
+		return err
+	}
+	return c.flush()
+}
+
+// writeCursorStatus ends the fields and each batch of rows sent for a
+// cursor with the status flags of the cursor. It is an EOF packet, or
+// an OK packet with an EOF header with CapabilityClientDeprecateEOF.
+func (c *Conn) writeCursorStatus(flags uint16) error {
+	flags |= c.StatusFlags | ServerStatusCursorExists
+	if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
+		return c.writeEOFPacket(flags, 0)
+	}
+	return c.writeOKPacketWithEOFHeader(0, 0, flags, 0)
+}
+
+// closeCursor closes the cursor of a statement, if it is open.
+func (c *Conn) closeCursor(prepare *PrepareData) {
+	if prepare.cursor == nil {
+		return
+	}
+	prepare.cursor.close()
+	prepare.cursor = nil
+}
+
+// closeCursors closes all the open cursors of the connection.
+func (c *Conn) closeCursors() {
+	for _, prepare := range c.PrepareData {
+		c.closeCursor(prepare)
+	}
+}
+
+// copyBindVars returns a deep copy of the bind variables of a prepared
+// statement. The values are modified in place by the long data of its
+// next executions.
+func copyBindVars(bindVars map[string]*querypb.BindVariable) map[string]*querypb.BindVariable {
+	result := make(map[string]*querypb.BindVariable, len(bindVars))
+	for key, bv := range bindVars {
+		if bv == nil {
+			result[key] = nil
+			continue
+		}
+		result[key] = proto.Clone(bv).(*querypb.BindVariable)
+	}
+	return result
+}
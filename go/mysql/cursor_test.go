@@ -0,0 +1,215 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// cursorTestHandler streams its results for ComStmtExecute.
+type cursorTestHandler struct {
+	testHandler
+	results []*sqltypes.Result
+	cursor  sync2.AtomicBool
+	stopped sync2.AtomicBool
+}
+
+func (th *cursorTestHandler) ComStmtExecute(c *Conn, prepare *PrepareData, callback func(*sqltypes.Result) error) error {
+	th.cursor.Set(prepare.Cursor)
+	for _, qr := range th.results {
+		if err := callback(qr); err != nil {
+			th.stopped.Set(true)
+			return err
+		}
+	}
+	return nil
+}
+
+func readCursorStatus(t *testing.T, cConn *Conn) uint16 {
+	t.Helper()
+	data, err := cConn.ReadPacket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isEOFPacket(data) {
+		t.Fatalf("got packet %v, want EOF packet", data)
+	}
+	flags, _, _ := readUint16(data, 3)
+	if flags&ServerStatusCursorExists == 0 {
+		t.Errorf("got status flags %x, want the cursor flag", flags)
+	}
+	return flags
+}
+
+func readCursorRows(t *testing.T, cConn *Conn, count int) {
+	t.Helper()
+	for i := 0; i < count; i++ {
+		data, err := cConn.ReadPacket()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data[0] != 0x00 {
+			t.Fatalf("got packet %v, want a binary row", data)
+		}
+	}
+}
+
+func TestCursor(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	fields := []*querypb.Field{{Name: "id", Type: querypb.Type_INT64}}
+	row := func(id int64) []sqltypes.Value {
+		return []sqltypes.Value{sqltypes.NewInt64(id)}
+	}
+	th := &cursorTestHandler{
+		results: []*sqltypes.Result{
+			{Fields: fields, Rows: [][]sqltypes.Value{row(1), row(2)}},
+			{Rows: [][]sqltypes.Value{row(3)}},
+			{Rows: [][]sqltypes.Value{row(4), row(5)}},
+		},
+	}
+	prepare := &PrepareData{StatementID: 18, PrepareStmt: "select id from t"}
+	sConn.PrepareData = map[uint32]*PrepareData{18: prepare}
+
+	// The execution only sends the fields.
+	if err := sConn.execCursor(th, prepare); err != nil {
+		t.Fatal(err)
+	}
+	if !th.cursor.Get() {
+		t.Errorf("PrepareData.Cursor not set for the Handler")
+	}
+	data, err := cConn.ReadPacket()
+	if err != nil || len(data) != 1 || data[0] != 1 {
+		t.Fatalf("got column count %v, %v, want 1", data, err)
+	}
+	if _, err := cConn.ReadPacket(); err != nil {
+		t.Fatal(err)
+	}
+	readCursorStatus(t, cConn)
+
+	// The rows are fetched across the results.
+	if err := sConn.fetchCursor(18, 3); err != nil {
+		t.Fatal(err)
+	}
+	readCursorRows(t, cConn, 3)
+	if flags := readCursorStatus(t, cConn); flags&ServerStatusLastRowSent != 0 {
+		t.Errorf("got status flags %x after 3 rows, want no last row flag", flags)
+	}
+	if err := sConn.fetchCursor(18, 10); err != nil {
+		t.Fatal(err)
+	}
+	readCursorRows(t, cConn, 2)
+	if flags := readCursorStatus(t, cConn); flags&ServerStatusLastRowSent == 0 {
+		t.Errorf("got status flags %x after all the rows, want the last row flag", flags)
+	}
+	if prepare.cursor != nil {
+		t.Errorf("cursor still open after all the rows were fetched")
+	}
+
+	// The cursor is closed now.
+	if err := sConn.fetchCursor(18, 1); err != nil {
+		t.Fatal(err)
+	}
+	data, err = cConn.ReadPacket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data[0] != ErrPacket {
+		t.Fatalf("got packet %v, want an error", data)
+	}
+	if err := ParseErrorPacket(data); err.(*SQLError).Number() != ERStmtHasNoOpenCursor {
+		t.Errorf("got error %v, want ERStmtHasNoOpenCursor", err)
+	}
+
+	// Closing the cursor stops the statement.
+	if err := sConn.execCursor(th, prepare); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := cConn.ReadPacket(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sConn.closeCursors()
+	if !th.stopped.Get() {
+		t.Errorf("statement not stopped by closeCursors")
+	}
+	if prepare.cursor != nil {
+		t.Errorf("cursor still open after closeCursors")
+	}
+}
+
+func TestCursorWithoutRows(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	th := &cursorTestHandler{
+		results: []*sqltypes.Result{{RowsAffected: 2}},
+	}
+	prepare := &PrepareData{StatementID: 18, PrepareStmt: "update t set id = 1"}
+	sConn.PrepareData = map[uint32]*PrepareData{18: prepare}
+
+	if err := sConn.execCursor(th, prepare); err != nil {
+		t.Fatal(err)
+	}
+	data, err := cConn.ReadPacket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data[0] != OKPacket {
+		t.Fatalf("got packet %v, want OK packet", data)
+	}
+	if affectedRows, _, _, _, _ := parseOKPacket(data); affectedRows != 2 {
+		t.Errorf("got %v affected rows, want 2", affectedRows)
+	}
+	if prepare.cursor != nil {
+		t.Errorf("cursor open for a statement without rows")
+	}
+}
+
+func TestCopyBindVars(t *testing.T) {
+	bindVars := map[string]*querypb.BindVariable{
+		"v1": sqltypes.BytesBindVariable([]byte("long")),
+		"v2": nil,
+	}
+	got := copyBindVars(bindVars)
+	// The long data of the next execution is appended in place.
+	bindVars["v1"].Value = append(bindVars["v1"].Value, " data"...)
+	bindVars["v2"] = sqltypes.Int64BindVariable(1)
+
+	want := map[string]*querypb.BindVariable{
+		"v1": sqltypes.BytesBindVariable([]byte("long")),
+		"v2": nil,
+	}
+	if !sqltypes.BindVariablesEqual(got, want) {
+		t.Errorf("copyBindVars: %v, want %v", got, want)
+	}
+}
@@ -90,6 +90,81 @@ func TestClearTextClientAuth(t *testing.T) {
 	conn.writeComQuit()
 }
 
+// TestCachingSha2ClientAuth tests the fast and full authentications
+// of caching_sha2_password.
+func TestCachingSha2ClientAuth(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic()
+	authServer.Method = MysqlCachingSha2Password
+	authServer.Entries["user1"] = []*AuthServerStaticEntry{
+		{Password: "password1"},
+	}
+	// The password of user2 is only known by its hash: the
+	// full authentication is required.
+	authServer.Entries["user2"] = []*AuthServerStaticEntry{
+		{MysqlNativePassword: "*668425423DB5193AF921380129F465A6425216D0"},
+	}
+
+	// Create the listener.
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	defer l.Close()
+	host := l.Addr().(*net.TCPAddr).IP.String()
+	port := l.Addr().(*net.TCPAddr).Port
+	go func() {
+		l.Accept()
+	}()
+
+	// The fast authentication doesn't need SSL.
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+	ctx := context.Background()
+	conn, err := Connect(ctx, params)
+	if err != nil {
+		t.Fatalf("unexpected connection error: %v", err)
+	}
+	result, err := conn.ExecuteFetch("select rows", 10000, true)
+	if err != nil {
+		t.Fatalf("ExecuteFetch failed: %v", err)
+	}
+	if !reflect.DeepEqual(result, selectRowsResult) {
+		t.Errorf("Got wrong result from ExecuteFetch(select rows): %v", result)
+	}
+	conn.writeComQuit()
+	conn.Close()
+
+	// A wrong password is rejected.
+	params.Pass = "password2"
+	_, err = Connect(ctx, params)
+	if err == nil || !strings.Contains(err.Error(), "Access denied for user 'user1'") {
+		t.Fatalf("unexpected connection error: %v", err)
+	}
+
+	// The full authentication sends the password in clear text,
+	// which requires SSL.
+	params.Uname = "user2"
+	params.Pass = "password1"
+	_, err = Connect(ctx, params)
+	if err == nil || !strings.Contains(err.Error(), "Cannot use clear text authentication over non-SSL connections") {
+		t.Fatalf("unexpected connection error: %v", err)
+	}
+
+	// Change server side to allow clear text without auth. The
+	// client still refuses to send its password.
+	l.AllowClearTextWithoutTLS = true
+	_, err = Connect(ctx, params)
+	if err == nil || !strings.Contains(err.Error(), "caching_sha2_password full authentication over a non-SSL connection") {
+		t.Fatalf("unexpected connection error: %v", err)
+	}
+}
+
 // TestSSLConnection creates a server with TLS support, a client that
 // also has SSL support, and connects them.
 func TestSSLConnection(t *testing.T) {
@@ -155,6 +230,18 @@ func TestSSLConnection(t *testing.T) {
 		authServer.Method = MysqlClearPassword
 		testSSLConnectionClearText(t, params)
 	})
+
+	// Make sure caching_sha2_password auth works over SSL, with
+	// the fast and the full authentications.
+	t.Run("CachingSha2", func(t *testing.T) {
+		authServer.Method = MysqlCachingSha2Password
+		testSSLConnectionClearText(t, params)
+
+		authServer.Entries["user1"] = []*AuthServerStaticEntry{
+			{MysqlNativePassword: "*668425423DB5193AF921380129F465A6425216D0"},
+		}
+		testSSLConnectionClearText(t, params)
+	})
 }
 
 func testSSLConnectionClearText(t *testing.T, params *ConnParams) {
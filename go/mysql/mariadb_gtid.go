@@ -18,6 +18,7 @@ package mysql
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -61,15 +62,22 @@ func parseMariadbGTID(s string) (GTID, error) {
 }
 
 // parseMariadbGTIDSet is registered as a GTIDSet parser.
+// The set has at most one GTID per domain: if a domain appears more than
+// once, like in gtid_binlog_state, the highest sequence number is kept.
+// An empty string, which is what a server that never wrote a transaction
+// reports, is an empty set.
 func parseMariadbGTIDSet(s string) (GTIDSet, error) {
-	gtidStrings := strings.Split(s, ",")
-	gtidSet := make(MariadbGTIDSet, len(gtidStrings))
-	for i, gtidString := range gtidStrings {
+	gtidSet := MariadbGTIDSet{}
+	for _, gtidString := range strings.Split(s, ",") {
+		gtidString = strings.TrimSpace(gtidString)
+		if gtidString == "" {
+			continue
+		}
 		gtid, err := parseMariadbGTID(gtidString)
 		if err != nil {
 			return nil, err
 		}
-		gtidSet[i] = gtid.(MariadbGTID)
+		gtidSet = gtidSet.addGTID(gtid.(MariadbGTID))
 	}
 	return gtidSet, nil
 }
@@ -84,7 +92,9 @@ type MariadbGTID struct {
 	Sequence uint64
 }
 
-// MariadbGTIDSet implements GTIDSet
+// MariadbGTIDSet implements GTIDSet. It has one GTID per domain:
+// the last transaction seen in that domain. Sets built by this
+// package are sorted by domain.
 type MariadbGTIDSet []MariadbGTID
 
 // String implements GTID.String().
@@ -167,6 +177,7 @@ func (gtidSet MariadbGTIDSet) Contains(other GTIDSet) bool {
 }
 
 // Equal implements GTIDSet.Equal().
+// The order of the domains does not matter.
 func (gtidSet MariadbGTIDSet) Equal(other GTIDSet) bool {
 	mdbOther, ok := other.(MariadbGTIDSet)
 	if !ok {
@@ -175,8 +186,12 @@ func (gtidSet MariadbGTIDSet) Equal(other GTIDSet) bool {
 	if len(gtidSet) != len(mdbOther) {
 		return false
 	}
-	for i, gtid := range gtidSet {
-		if gtid != mdbOther[i] {
+	byDomain := make(map[uint32]MariadbGTID, len(gtidSet))
+	for _, gtid := range gtidSet {
+		byDomain[gtid.Domain] = gtid
+	}
+	for _, gtid := range mdbOther {
+		if mine, ok := byDomain[gtid.Domain]; !ok || mine != gtid {
 			return false
 		}
 	}
@@ -184,20 +199,38 @@ func (gtidSet MariadbGTIDSet) Equal(other GTIDSet) bool {
 }
 
 // AddGTID implements GTIDSet.AddGTID().
+// It returns a new set, and leaves the receiver unchanged, as
+// positions are shared between goroutines.
 func (gtidSet MariadbGTIDSet) AddGTID(other GTID) GTIDSet {
 	mdbOther, ok := other.(MariadbGTID)
 	if !ok || other == nil {
 		return gtidSet
 	}
+	for _, gtid := range gtidSet {
+		if gtid.Domain == mdbOther.Domain && gtid.Sequence >= mdbOther.Sequence {
+			return gtidSet
+		}
+	}
+	newSet := make(MariadbGTIDSet, len(gtidSet), len(gtidSet)+1)
+	copy(newSet, gtidSet)
+	return newSet.addGTID(mdbOther)
+}
+
+// addGTID adds the GTID to the set in place, keeping the set sorted by domain.
+func (gtidSet MariadbGTIDSet) addGTID(other MariadbGTID) MariadbGTIDSet {
 	for i, gtid := range gtidSet {
-		if mdbOther.Domain == gtid.Domain {
-			if mdbOther.Sequence > gtid.Sequence {
-				gtidSet[i] = mdbOther
+		if gtid.Domain == other.Domain {
+			if other.Sequence > gtid.Sequence {
+				gtidSet[i] = other
 			}
 			return gtidSet
 		}
 	}
-	return append(gtidSet, mdbOther)
+	i := sort.Search(len(gtidSet), func(i int) bool { return gtidSet[i].Domain > other.Domain })
+	gtidSet = append(gtidSet, MariadbGTID{})
+	copy(gtidSet[i+1:], gtidSet[i:])
+	gtidSet[i] = other
+	return gtidSet
 }
 
 func init() {
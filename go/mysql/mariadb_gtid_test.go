@@ -99,6 +99,35 @@ func TestParseMariaGTIDSet(t *testing.T) {
 	}
 }
 
+func TestParseMariaGTIDSetNormalizes(t *testing.T) {
+	testcases := []struct {
+		input string
+		want  string
+	}{{
+		input: "",
+		want:  "",
+	}, {
+		input: "12-34-5678,11-22-3333",
+		want:  "11-22-3333,12-34-5678",
+	}, {
+		input: "0-1-10, 0-2-12,\n1-1-5",
+		want:  "0-2-12,1-1-5",
+	}, {
+		input: "0-2-12,0-1-10",
+		want:  "0-2-12",
+	}}
+	for _, tcase := range testcases {
+		got, err := parseMariadbGTIDSet(tcase.input)
+		if err != nil {
+			t.Errorf("parseMariadbGTIDSet(%#v): %v", tcase.input, err)
+			continue
+		}
+		if got.String() != tcase.want {
+			t.Errorf("parseMariadbGTIDSet(%#v) = %v, want %v", tcase.input, got, tcase.want)
+		}
+	}
+}
+
 func TestParseInvalidMariaGTIDSet(t *testing.T) {
 	input := "12-34-5678,11-22-33e33"
 	want := "invalid MariaDB GTID Sequence number"
@@ -472,3 +501,28 @@ func TestMariaGTIDSetAddGTIDDifferentDomain(t *testing.T) {
 		t.Errorf("%#v.AddGTID(%#v) = %v, want %v", input1, input2, got, want)
 	}
 }
+
+func TestMariaGTIDSetEqualDomainOrder(t *testing.T) {
+	input1 := MariadbGTIDSet{MariadbGTID{Domain: 3, Server: 5555, Sequence: 1234}, MariadbGTID{Domain: 5, Server: 5555, Sequence: 5234}}
+	input2 := MariadbGTIDSet{MariadbGTID{Domain: 5, Server: 5555, Sequence: 5234}, MariadbGTID{Domain: 3, Server: 5555, Sequence: 1234}}
+	want := true
+
+	if got := input1.Equal(input2); got != want {
+		t.Errorf("%#v.Equal(%#v) = %v, want %v", input1, input2, got, want)
+	}
+}
+
+func TestMariaGTIDSetAddGTIDDoesNotModifySet(t *testing.T) {
+	input1 := MariadbGTIDSet{MariadbGTID{Domain: 3, Server: 5555, Sequence: 1234}, MariadbGTID{Domain: 7, Server: 5555, Sequence: 1}}
+	input2 := MariadbGTID{Domain: 3, Server: 4444, Sequence: 5234}
+	input3 := MariadbGTID{Domain: 5, Server: 4444, Sequence: 10}
+	want := "3-4444-5234,5-4444-10,7-5555-1"
+
+	got := input1.AddGTID(input2).AddGTID(input3)
+	if got.String() != want {
+		t.Errorf("AddGTID() = %v, want %v", got, want)
+	}
+	if input1.String() != "3-5555-1234,7-5555-1" {
+		t.Errorf("AddGTID() modified its receiver: %v", input1)
+	}
+}
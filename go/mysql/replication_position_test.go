@@ -287,6 +287,20 @@ func TestDecodePositionZero(t *testing.T) {
 	}
 }
 
+func TestDecodePositionMariadbEmpty(t *testing.T) {
+	// A backup of a MariaDB server that never wrote a transaction
+	// has an empty GTID set.
+	input := "MariaDB/"
+
+	got, err := DecodePosition(input)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !got.AtLeast(Position{GTIDSet: MariadbGTIDSet{}}) || got.GTIDSet.Flavor() != mariadbFlavorID {
+		t.Errorf("DecodePosition(%#v) = %#v", input, got)
+	}
+}
+
 func TestDecodePositionNoFlavor(t *testing.T) {
 	gtidSetParsers[""] = func(s string) (GTIDSet, error) {
 		return fakeGTID{value: s}, nil
@@ -327,6 +327,31 @@ func BaseShowTablesForTable(table string) string {
 	return fmt.Sprintf("%s and table_name = '%s'", BaseShowTables, table)
 }
 
+// BaseShowGeneratedColumns lists the expressions of the generated columns.
+const BaseShowGeneratedColumns = "SELECT column_name, generation_expression FROM information_schema.columns WHERE table_schema = database() and generation_expression != ''"
+
+// BaseShowGeneratedColumnsForTable specializes BaseShowGeneratedColumns for a single table.
+func BaseShowGeneratedColumnsForTable(table string) string {
+	return fmt.Sprintf("%s and table_name = '%s'", BaseShowGeneratedColumns, table)
+}
+
+// BaseShowPartitions lists the partitions of the partitioned tables.
+// A subpartitioned partition is only listed once.
+const BaseShowPartitions = "SELECT table_name, partition_name, partition_ordinal_position, partition_method, partition_expression, partition_description FROM information_schema.partitions WHERE table_schema = database() and partition_name is not null and (subpartition_ordinal_position is null or subpartition_ordinal_position = 1)"
+
+// BaseShowPartitionsForTable specializes BaseShowPartitions for a single table.
+func BaseShowPartitionsForTable(table string) string {
+	return fmt.Sprintf("%s and table_name = '%s'", BaseShowPartitions, table)
+}
+
+// BaseShowViews lists the definitions of the views, and their database.
+const BaseShowViews = "SELECT table_name, view_definition, table_schema FROM information_schema.views WHERE table_schema = database()"
+
+// BaseShowViewsForTable specializes BaseShowViews for a single view.
+func BaseShowViewsForTable(table string) string {
+	return fmt.Sprintf("%s and table_name = '%s'", BaseShowViews, table)
+}
+
 // BaseShowTablesFields contains the fields returned by a BaseShowTables or a BaseShowTablesForTable command.
 // They are validated by the
 // testBaseShowTables test.
@@ -116,6 +116,22 @@ type Handler interface {
 	ComResetConnection(c *Conn)
 }
 
+// BatchHandler can be implemented by a Handler to execute the statements
+// of a multi-statement query together, instead of one ComQuery call per
+// statement.
+type BatchHandler interface {
+	// UseBatch returns true if the multi-statement queries of the
+	// connection are executed with ComQueryBatch. Otherwise, their
+	// statements are executed with ComQuery.
+	UseBatch(c *Conn) bool
+
+	// ComQueryBatch is called when a connection receives a query
+	// with more than one statement. It executes them in order,
+	// and stops at the first one that fails. It returns the results
+	// of the statements before it, and its error.
+	ComQueryBatch(c *Conn, queries []string) ([]*sqltypes.Result, error)
+}
+
 // Listener is the MySQL server protocol listener.
 type Listener struct {
 	// Construction parameters, set by NewListener.
@@ -282,6 +298,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	// Tell the handler about the connection coming and going.
 	l.handler.NewConnection(c)
 	defer l.handler.ConnectionClosed(c)
+	defer c.closeCursors()
 
 	// Adjust the count of open connections
 	defer connCount.Add(-1)
@@ -399,6 +416,19 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		c.User = user
 		c.UserData = userData
 
+	case authServerMethod == MysqlCachingSha2Password:
+		userData, err := l.negotiateCachingSha2Password(c, salt, user, authMethod, authResponse)
+		if err != nil {
+			c.writeErrorPacketFromError(err)
+			return
+		}
+		if userData == nil {
+			// The error was already sent or logged.
+			return
+		}
+		c.User = user
+		c.UserData = userData
+
 	default:
 		// The server wants to use something else, re-negotiate.
 
@@ -462,6 +492,64 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	}
 }
 
+// negotiateCachingSha2Password performs the caching_sha2_password
+// authentication. The client sends a scramble of its password, which
+// the AuthServer validates if it can (fast authentication). Otherwise,
+// the client is asked to send its password in the clear, which is
+// validated by Negotiate() (full authentication).
+// It returns nil user data and no error if the connection should just
+// be closed.
+func (l *Listener) negotiateCachingSha2Password(c *Conn, salt []byte, user, authMethod string, authResponse []byte) (Getter, error) {
+	if authMethod != MysqlCachingSha2Password {
+		// The client returned a result for something else,
+		// switch to caching_sha2_password.
+		var err error
+		salt, err = l.authServer.Salt()
+		if err != nil {
+			return nil, nil
+		}
+		data := append(salt, byte(0x00))
+		if err := c.writeAuthSwitchRequest(MysqlCachingSha2Password, data); err != nil {
+			log.Errorf("Error writing auth switch packet for %s: %v", c, err)
+			return nil, nil
+		}
+
+		response, err := c.readEphemeralPacket()
+		if err != nil {
+			log.Errorf("Error reading auth switch response for %s: %v", c, err)
+			return nil, nil
+		}
+		authResponse = append([]byte(nil), response...)
+		c.recycleReadPacket()
+	}
+
+	// Fast authentication.
+	if cachingSha2, ok := l.authServer.(CachingSha2AuthServer); ok {
+		userData, validated, err := cachingSha2.ValidateCachingSha2Hash(salt, user, authResponse, c.RemoteAddr())
+		if validated {
+			if err != nil {
+				log.Warningf("Error authenticating user using MySQL caching_sha2_password: %v", err)
+				return nil, err
+			}
+			if err := c.writeAuthMoreData([]byte{cachingSha2FastAuthSuccess}); err != nil {
+				log.Errorf("Error writing auth more data packet for %s: %v", c, err)
+				return nil, nil
+			}
+			return userData, nil
+		}
+	}
+
+	// Full authentication: the password is sent in clear text.
+	if !l.AllowClearTextWithoutTLS && c.Capabilities&CapabilityClientSSL == 0 {
+		return nil, NewSQLError(CRServerHandshakeErr, SSUnknownSQLState, "Cannot use clear text authentication over non-SSL connections.")
+	}
+	if err := c.writeAuthMoreData([]byte{cachingSha2PerformFullAuth}); err != nil {
+		log.Errorf("Error writing auth more data packet for %s: %v", c, err)
+		return nil, nil
+	}
+	return l.authServer.Negotiate(c, user, c.RemoteAddr())
+}
+
 // Close stops the listener, which prevents accept of any new connections. Existing connections won't be closed.
 func (l *Listener) Close() {
 	l.listener.Close()
@@ -779,6 +867,28 @@ func (c *Conn) writeAuthSwitchRequest(pluginName string, pluginData []byte) erro
 	return c.writeEphemeralPacket()
 }
 
+// writeAuthMoreData writes the AuthMoreData packet, with the
+// provided data.
+func (c *Conn) writeAuthMoreData(pluginData []byte) error {
+	length := 1 + // AuthMoreDataPacket
+		len(pluginData)
+
+	data := c.startEphemeralPacket(length)
+	pos := 0
+
+	// Packet header.
+	pos = writeByte(data, pos, AuthMoreDataPacket)
+
+	// Copy auth data.
+	pos += copy(data[pos:], pluginData)
+
+	// Sanity check.
+	if pos != len(data) {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "error building AuthMoreDataPacket packet: got %v bytes expected %v", pos, len(data))
+	}
+	return c.writeEphemeralPacket()
+}
+
 // Whenever we move to a new version of go, we will need add any new supported TLS versions here
 func tlsVersionToString(version uint16) string {
 	switch version {
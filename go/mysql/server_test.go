@@ -23,6 +23,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -1285,6 +1286,139 @@ func TestListenerShutdown(t *testing.T) {
 	}
 }
 
+// testBatchHandler is a testHandler that also implements BatchHandler.
+type testBatchHandler struct {
+	testHandler
+	batches [][]string
+}
+
+func (th *testBatchHandler) UseBatch(c *Conn) bool {
+	return true
+}
+
+func (th *testBatchHandler) ComQueryBatch(c *Conn, queries []string) ([]*sqltypes.Result, error) {
+	th.batches = append(th.batches, queries)
+	var results []*sqltypes.Result
+	for _, query := range queries {
+		switch query {
+		case "error":
+			return results, th.err
+		case "select rows":
+			results = append(results, selectRowsResult)
+		case "insert":
+			results = append(results, &sqltypes.Result{
+				RowsAffected: 123,
+				InsertID:     123456789,
+			})
+		default:
+			results = append(results, &sqltypes.Result{})
+		}
+	}
+	return results, nil
+}
+
+// connectMultiStatements starts a listener for the handler, and returns
+// a connection to it.
+func connectMultiStatements(t *testing.T, handler Handler) (*Listener, *Conn) {
+	authServer := NewAuthServerStatic()
+	authServer.Entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+		UserData: "userData1",
+	}}
+	l, err := NewListener("tcp", ":0", authServer, handler, 0, 0)
+	if err != nil {
+		t.Fatalf("NewListener failed: %v", err)
+	}
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+	conn, err := Connect(context.Background(), params)
+	if err != nil {
+		l.Close()
+		t.Fatalf("Can't connect to listener: %v", err)
+	}
+	return l, conn
+}
+
+func TestMultiStatementsStopAtError(t *testing.T) {
+	th := &testHandler{
+		err: NewSQLError(ERUnknownComError, SSUnknownComError, "forced query error"),
+	}
+	l, conn := connectMultiStatements(t, th)
+	defer l.Close()
+	defer conn.Close()
+
+	result, more, err := conn.ExecuteFetchMulti("insert;error;insert", 10, true)
+	if err != nil {
+		t.Fatalf("ExecuteFetchMulti failed: %v", err)
+	}
+	if result.RowsAffected != 123 || !more {
+		t.Errorf("ExecuteFetchMulti: %v, more %v, want 123 rows affected and more", result, more)
+	}
+	_, more, _, err = conn.ReadQueryResult(10, true)
+	if err == nil || !strings.Contains(err.Error(), "forced query error") {
+		t.Fatalf("ReadQueryResult: %v, want forced query error", err)
+	}
+	if more {
+		t.Errorf("ReadQueryResult: more is set after an error")
+	}
+
+	// The last insert must not have been executed: the next query
+	// gets its own result.
+	result, err = conn.ExecuteFetch("select rows", 10, true)
+	if err != nil {
+		t.Fatalf("ExecuteFetch failed: %v", err)
+	}
+	if len(result.Rows) != 2 {
+		t.Errorf("ExecuteFetch: %v, want 2 rows", result)
+	}
+}
+
+func TestMultiStatementsBatchHandler(t *testing.T) {
+	th := &testBatchHandler{
+		testHandler: testHandler{
+			err: NewSQLError(ERUnknownComError, SSUnknownComError, "forced query error"),
+		},
+	}
+	l, conn := connectMultiStatements(t, th)
+	defer l.Close()
+	defer conn.Close()
+
+	result, more, err := conn.ExecuteFetchMulti("insert;select rows;error;insert", 10, true)
+	if err != nil {
+		t.Fatalf("ExecuteFetchMulti failed: %v", err)
+	}
+	if result.RowsAffected != 123 || !more {
+		t.Errorf("ExecuteFetchMulti: %v, more %v, want 123 rows affected and more", result, more)
+	}
+	result, more, _, err = conn.ReadQueryResult(10, true)
+	if err != nil {
+		t.Fatalf("ReadQueryResult failed: %v", err)
+	}
+	if len(result.Rows) != 2 || !more {
+		t.Errorf("ReadQueryResult: %v, more %v, want 2 rows and more", result, more)
+	}
+	_, _, _, err = conn.ReadQueryResult(10, true)
+	if err == nil || !strings.Contains(err.Error(), "forced query error") {
+		t.Fatalf("ReadQueryResult: %v, want forced query error", err)
+	}
+
+	// A single statement doesn't go through the batch handler.
+	if _, err := conn.ExecuteFetch("insert", 10, true); err != nil {
+		t.Fatalf("ExecuteFetch failed: %v", err)
+	}
+	want := [][]string{{"insert", "select rows", "error", "insert"}}
+	if !reflect.DeepEqual(th.batches, want) {
+		t.Errorf("batches: %v, want %v", th.batches, want)
+	}
+}
+
 func TestParseConnAttrs(t *testing.T) {
 	expected := map[string]string{
 		"_client_version": "8.0.11",
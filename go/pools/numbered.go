@@ -140,6 +140,17 @@ func (nu *Numbered) Put(id int64) {
 	}
 }
 
+// PutIdle unlocks a resource locked by GetIdle without marking it as
+// used, so it keeps its idle time.
+func (nu *Numbered) PutIdle(id int64) {
+	nu.mu.Lock()
+	defer nu.mu.Unlock()
+	if nw, ok := nu.resources[id]; ok {
+		nw.inUse = false
+		nw.purpose = ""
+	}
+}
+
 // GetAll returns the list of all resources in the pool.
 func (nu *Numbered) GetAll() (vals []interface{}) {
 	nu.mu.Lock()
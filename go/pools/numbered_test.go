@@ -91,6 +91,12 @@ func TestNumbered(t *testing.T) {
 	if vals[0].(int64) != 3 {
 		t.Errorf("want 3, got %v", vals[0])
 	}
+	// PutIdle doesn't reset the idle time.
+	p.PutIdle(vals[0].(int64))
+	vals = p.GetIdle(200*time.Millisecond, "by idle")
+	if len(vals) != 1 || vals[0].(int64) != 3 {
+		t.Errorf("want [3], got %v", vals)
+	}
 	p.Unregister(vals[0].(int64), "test")
 
 	// p has 0, 1, and 2
@@ -34,6 +34,7 @@ type numeric struct {
 	ival int64
 	uval uint64
 	fval float64
+	dval decimal
 }
 
 var zeroBytes = []byte("0")
@@ -331,6 +332,8 @@ func ToFloat64(v Value) (float64, error) {
 		return float64(num.ival), nil
 	case Uint64:
 		return float64(num.uval), nil
+	case Decimal:
+		return num.dval.float64(), nil
 	case Float64:
 		return num.fval, nil
 	}
@@ -359,7 +362,7 @@ func ToNative(v Value) (interface{}, error) {
 	return out, err
 }
 
-// newNumeric parses a value and produces an Int64, Uint64 or Float64.
+// newNumeric parses a value and produces an Int64, Uint64, Decimal or Float64.
 func newNumeric(v Value) (numeric, error) {
 	str := v.ToString()
 	switch {
@@ -381,6 +384,11 @@ func newNumeric(v Value) (numeric, error) {
 			return numeric{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 		}
 		return numeric{fval: fval, typ: Float64}, nil
+	case v.Type() == Decimal:
+		dval, err := parseDecimal(str)
+		if err == nil {
+			return numeric{dval: dval, typ: Decimal}, nil
+		}
 	}
 
 	// For other types, do best effort.
@@ -433,6 +441,8 @@ func addNumeric(v1, v2 numeric) numeric {
 		case Uint64:
 			return uintPlusUint(v1.uval, v2.uval)
 		}
+	case Decimal:
+		return numeric{typ: Decimal, dval: v1.dval.add(toDecimal(v2))}
 	case Float64:
 		return floatPlusAny(v1.fval, v2)
 	}
@@ -451,6 +461,8 @@ func addNumericWithError(v1, v2 numeric) (numeric, error) {
 		case Uint64:
 			return uintPlusUintWithError(v1.uval, v2.uval)
 		}
+	case Decimal:
+		return numeric{typ: Decimal, dval: v1.dval.add(toDecimal(v2))}, nil
 	case Float64:
 		return floatPlusAny(v1.fval, v2), nil
 	}
@@ -465,6 +477,8 @@ func subtractNumericWithError(v1, v2 numeric) (numeric, error) {
 			return intMinusIntWithError(v1.ival, v2.ival)
 		case Uint64:
 			return intMinusUintWithError(v1.ival, v2.uval)
+		case Decimal:
+			return numeric{typ: Decimal, dval: toDecimal(v1).sub(v2.dval)}, nil
 		case Float64:
 			return anyMinusFloat(v1, v2.fval), nil
 		}
@@ -474,9 +488,16 @@ func subtractNumericWithError(v1, v2 numeric) (numeric, error) {
 			return uintMinusIntWithError(v1.uval, v2.ival)
 		case Uint64:
 			return uintMinusUintWithError(v1.uval, v2.uval)
+		case Decimal:
+			return numeric{typ: Decimal, dval: toDecimal(v1).sub(v2.dval)}, nil
 		case Float64:
 			return anyMinusFloat(v1, v2.fval), nil
 		}
+	case Decimal:
+		if v2.typ == Float64 {
+			return anyMinusFloat(v1, v2.fval), nil
+		}
+		return numeric{typ: Decimal, dval: v1.dval.sub(toDecimal(v2))}, nil
 	case Float64:
 		return floatMinusAny(v1.fval, v2), nil
 	}
@@ -495,6 +516,8 @@ func multiplyNumericWithError(v1, v2 numeric) (numeric, error) {
 		case Uint64:
 			return uintTimesUintWithError(v1.uval, v2.uval)
 		}
+	case Decimal:
+		return numeric{typ: Decimal, dval: v1.dval.mul(toDecimal(v2))}, nil
 	case Float64:
 		return floatTimesAny(v1.fval, v2), nil
 	}
@@ -502,6 +525,10 @@ func multiplyNumericWithError(v1, v2 numeric) (numeric, error) {
 }
 
 func divideNumericWithError(v1, v2 numeric) (numeric, error) {
+	// A division involving a decimal is exact, unless it also involves a float.
+	if (v1.typ == Decimal || v2.typ == Decimal) && v1.typ != Float64 && v2.typ != Float64 {
+		return numeric{typ: Decimal, dval: toDecimal(v1).div(toDecimal(v2))}, nil
+	}
 	switch v1.typ {
 	case Int64:
 		return floatDivideAnyWithError(float64(v1.ival), v2)
@@ -509,6 +536,8 @@ func divideNumericWithError(v1, v2 numeric) (numeric, error) {
 	case Uint64:
 		return floatDivideAnyWithError(float64(v1.uval), v2)
 
+	case Decimal:
+		return floatDivideAnyWithError(v1.dval.float64(), v2)
 	case Float64:
 		return floatDivideAnyWithError(v1.fval, v2)
 	}
@@ -516,14 +545,18 @@ func divideNumericWithError(v1, v2 numeric) (numeric, error) {
 }
 
 // prioritize reorders the input parameters
-// to be Float64, Uint64, Int64.
+// to be Float64, Decimal, Uint64, Int64.
 func prioritize(v1, v2 numeric) (altv1, altv2 numeric) {
 	switch v1.typ {
 	case Int64:
-		if v2.typ == Uint64 || v2.typ == Float64 {
+		if v2.typ == Uint64 || v2.typ == Decimal || v2.typ == Float64 {
 			return v2, v1
 		}
 	case Uint64:
+		if v2.typ == Decimal || v2.typ == Float64 {
+			return v2, v1
+		}
+	case Decimal:
 		if v2.typ == Float64 {
 			return v2, v1
 		}
@@ -531,6 +564,17 @@ func prioritize(v1, v2 numeric) (altv1, altv2 numeric) {
 	return v1, v2
 }
 
+// toDecimal converts an integral or decimal numeric to a decimal.
+func toDecimal(v numeric) decimal {
+	switch v.typ {
+	case Int64:
+		return decimalFromInt64(v.ival)
+	case Uint64:
+		return decimalFromUint64(v.uval)
+	}
+	return v.dval
+}
+
 func intPlusInt(v1, v2 int64) numeric {
 	result := v1 + v2
 	if v1 > 0 && v2 > 0 && result < 0 {
@@ -648,6 +692,8 @@ func floatPlusAny(v1 float64, v2 numeric) numeric {
 		v2.fval = float64(v2.ival)
 	case Uint64:
 		v2.fval = float64(v2.uval)
+	case Decimal:
+		v2.fval = v2.dval.float64()
 	}
 	return numeric{typ: Float64, fval: v1 + v2.fval}
 }
@@ -658,6 +704,8 @@ func floatMinusAny(v1 float64, v2 numeric) numeric {
 		v2.fval = float64(v2.ival)
 	case Uint64:
 		v2.fval = float64(v2.uval)
+	case Decimal:
+		v2.fval = v2.dval.float64()
 	}
 	return numeric{typ: Float64, fval: v1 - v2.fval}
 }
@@ -668,6 +716,8 @@ func floatTimesAny(v1 float64, v2 numeric) numeric {
 		v2.fval = float64(v2.ival)
 	case Uint64:
 		v2.fval = float64(v2.uval)
+	case Decimal:
+		v2.fval = v2.dval.float64()
 	}
 	return numeric{typ: Float64, fval: v1 * v2.fval}
 }
@@ -678,6 +728,8 @@ func floatDivideAnyWithError(v1 float64, v2 numeric) (numeric, error) {
 		v2.fval = float64(v2.ival)
 	case Uint64:
 		v2.fval = float64(v2.uval)
+	case Decimal:
+		v2.fval = v2.dval.float64()
 	}
 	result := v1 / v2.fval
 	divisorLessThanOne := v2.fval < 1
@@ -696,6 +748,8 @@ func anyMinusFloat(v1 numeric, v2 float64) numeric {
 		v1.fval = float64(v1.ival)
 	case Uint64:
 		v1.fval = float64(v1.uval)
+	case Decimal:
+		v1.fval = v1.dval.float64()
 	}
 	return numeric{typ: Float64, fval: v1.fval - v2}
 }
@@ -710,7 +764,8 @@ func castFromNumeric(v numeric, resultType querypb.Type) Value {
 			return MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.uval), 10))
 		case Float64:
 			return MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.fval), 10))
-
+		case Decimal:
+			return MakeTrusted(resultType, []byte(v.dval.truncated().String()))
 		}
 	case IsUnsigned(resultType):
 		switch v.typ {
@@ -720,7 +775,8 @@ func castFromNumeric(v numeric, resultType querypb.Type) Value {
 			return MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.ival), 10))
 		case Float64:
 			return MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.fval), 10))
-
+		case Decimal:
+			return MakeTrusted(resultType, []byte(v.dval.truncated().String()))
 		}
 	case IsFloat(resultType) || resultType == Decimal:
 		switch v.typ {
@@ -734,6 +790,11 @@ func castFromNumeric(v numeric, resultType querypb.Type) Value {
 				format = 'f'
 			}
 			return MakeTrusted(resultType, strconv.AppendFloat(nil, v.fval, format, -1, 64))
+		case Decimal:
+			if resultType == Decimal {
+				return MakeTrusted(resultType, []byte(v.dval.String()))
+			}
+			return MakeTrusted(resultType, strconv.AppendFloat(nil, v.dval.float64(), 'g', -1, 64))
 		}
 	}
 	return NULL
@@ -749,6 +810,8 @@ func compareNumeric(v1, v2 numeric) int {
 				return -1
 			}
 			v1 = numeric{typ: Uint64, uval: uint64(v1.ival)}
+		case Decimal:
+			v1 = numeric{typ: Decimal, dval: toDecimal(v1)}
 		case Float64:
 			v1 = numeric{typ: Float64, fval: float64(v1.ival)}
 		}
@@ -759,15 +822,26 @@ func compareNumeric(v1, v2 numeric) int {
 				return 1
 			}
 			v2 = numeric{typ: Uint64, uval: uint64(v2.ival)}
+		case Decimal:
+			v1 = numeric{typ: Decimal, dval: toDecimal(v1)}
 		case Float64:
 			v1 = numeric{typ: Float64, fval: float64(v1.uval)}
 		}
+	case Decimal:
+		switch v2.typ {
+		case Int64, Uint64:
+			v2 = numeric{typ: Decimal, dval: toDecimal(v2)}
+		case Float64:
+			v1 = numeric{typ: Float64, fval: v1.dval.float64()}
+		}
 	case Float64:
 		switch v2.typ {
 		case Int64:
 			v2 = numeric{typ: Float64, fval: float64(v2.ival)}
 		case Uint64:
 			v2 = numeric{typ: Float64, fval: float64(v2.uval)}
+		case Decimal:
+			v2 = numeric{typ: Float64, fval: v2.dval.float64()}
 		}
 	}
 
@@ -787,6 +861,8 @@ func compareNumeric(v1, v2 numeric) int {
 		case v1.uval < v2.uval:
 			return -1
 		}
+	case Decimal:
+		return v1.dval.cmp(v2.dval)
 	case Float64:
 		switch {
 		case v1.fval == v2.fval:
@@ -30,6 +30,35 @@ import (
 // NullBindVariable is a bindvar with NULL value.
 var NullBindVariable = &querypb.BindVariable{Type: querypb.Type_NULL_TYPE}
 
+const (
+	// BvSchemaName is the bind variable that vtgate substitutes for
+	// the schema names compared in the queries of the system tables.
+	BvSchemaName = "__vtschemaname"
+	// BvSchemaNames is the list bind variable that vtgate substitutes
+	// for the lists of schema names of the IN comparisons.
+	BvSchemaNames = "__vtschemanames"
+	// BvReplaceSchemaName is set when the schema name is a keyspace:
+	// the tablet then sets BvSchemaName and BvSchemaNames to the name
+	// of its database, and returns the keyspace name in the schema
+	// name columns of the result.
+	BvReplaceSchemaName = "__replacevtschemaname"
+)
+
+// SchemaNameColumns are the lower case columns of the system tables that
+// contain schema names.
+var SchemaNameColumns = map[string]bool{
+	"table_schema":             true,
+	"schema_name":              true,
+	"constraint_schema":        true,
+	"referenced_table_schema":  true,
+	"unique_constraint_schema": true,
+	"index_schema":             true,
+	"trigger_schema":           true,
+	"event_object_schema":      true,
+	"event_schema":             true,
+	"routine_schema":           true,
+}
+
 // ValueToProto converts Value to a *querypb.Value.
 func ValueToProto(v Value) *querypb.Value {
 	return &querypb.Value{Type: v.typ, Value: v.val}
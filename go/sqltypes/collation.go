@@ -0,0 +1,170 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqltypes
+
+import (
+	"bytes"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Collation ids of the collations Vitess can compare with. MySQL
+// sends them as the charset of the fields of a result.
+const (
+	CollationUtf8GeneralCI    = 33
+	CollationUtf8mb4GeneralCI = 45
+	CollationUtf8mb4Bin       = 46
+	CollationBinary           = 63
+	CollationUtf8Bin          = 83
+	CollationUtf8UnicodeCI    = 192
+	CollationUtf8mb4UnicodeCI = 224
+	CollationUtf8mb40900AICI  = 255
+)
+
+// Collation compares strings the way a MySQL collation does.
+type Collation struct {
+	id   uint32
+	name string
+	// pad is set for the PAD SPACE collations, which
+	// ignore trailing spaces.
+	pad     bool
+	weights func(dst, src []byte) []byte
+}
+
+var collations = map[uint32]*Collation{
+	CollationUtf8GeneralCI:    {id: CollationUtf8GeneralCI, name: "utf8_general_ci", pad: true, weights: generalWeights},
+	CollationUtf8mb4GeneralCI: {id: CollationUtf8mb4GeneralCI, name: "utf8mb4_general_ci", pad: true, weights: generalWeights},
+	CollationUtf8mb4Bin:       {id: CollationUtf8mb4Bin, name: "utf8mb4_bin", pad: true, weights: binaryWeights},
+	CollationBinary:           {id: CollationBinary, name: "binary", weights: binaryWeights},
+	CollationUtf8Bin:          {id: CollationUtf8Bin, name: "utf8_bin", pad: true, weights: binaryWeights},
+	CollationUtf8UnicodeCI:    {id: CollationUtf8UnicodeCI, name: "utf8_unicode_ci", pad: true, weights: unicodeWeights},
+	CollationUtf8mb4UnicodeCI: {id: CollationUtf8mb4UnicodeCI, name: "utf8mb4_unicode_ci", pad: true, weights: unicodeWeights},
+	CollationUtf8mb40900AICI:  {id: CollationUtf8mb40900AICI, name: "utf8mb4_0900_ai_ci", weights: unicodeWeights},
+}
+
+// CollationByID returns the collation with the MySQL id,
+// or nil if Vitess doesn't support it.
+func CollationByID(id uint32) *Collation {
+	return collations[id]
+}
+
+// CollationByName returns the collation with the MySQL name,
+// or nil if Vitess doesn't support it.
+func CollationByName(name string) *Collation {
+	for _, coll := range collations {
+		if coll.name == name {
+			return coll
+		}
+	}
+	return nil
+}
+
+// ID returns the MySQL id of the collation.
+func (coll *Collation) ID() uint32 {
+	return coll.id
+}
+
+// Name returns the MySQL name of the collation.
+func (coll *Collation) Name() string {
+	return coll.name
+}
+
+// WeightString appends the sort key of src to dst. Two strings compare
+// in the collation like their sort keys compare with bytes.Compare, which
+// also makes the sort key suitable for hashing.
+func (coll *Collation) WeightString(dst, src []byte) []byte {
+	if coll.pad {
+		src = bytes.TrimRight(src, " ")
+	}
+	return coll.weights(dst, src)
+}
+
+// Compare returns -1, 0 or 1 if a is smaller, equal or greater than b.
+func (coll *Collation) Compare(a, b []byte) int {
+	return bytes.Compare(coll.WeightString(nil, a), coll.WeightString(nil, b))
+}
+
+// NullsafeCompareCollated is like NullsafeCompare, but compares two
+// text values with the collation of the MySQL id. It falls back to
+// NullsafeCompare for the other values and the unsupported collations.
+func NullsafeCompareCollated(v1, v2 Value, collationID uint32) (int, error) {
+	if v1.IsText() && v2.IsText() {
+		if coll := CollationByID(collationID); coll != nil {
+			return coll.Compare(v1.val, v2.val), nil
+		}
+	}
+	return NullsafeCompare(v1, v2)
+}
+
+func binaryWeights(dst, src []byte) []byte {
+	return append(dst, src...)
+}
+
+// generalWeights implements the *_general_ci collations: characters are
+// compared without accents and case, one character at a time. All the
+// characters outside of the BMP weigh the same.
+func generalWeights(dst, src []byte) []byte {
+	for len(src) > 0 {
+		r, size := utf8.DecodeRune(src)
+		src = src[size:]
+		w := generalWeight(r)
+		dst = append(dst, byte(w>>8), byte(w))
+	}
+	return dst
+}
+
+func generalWeight(r rune) rune {
+	if r > 0xFFFF {
+		return utf8.RuneError
+	}
+	if r == 'ß' {
+		return 'S'
+	}
+	// Compare the accented characters as their base character.
+	if d := norm.NFD.PropertiesString(string(r)).Decomposition(); len(d) != 0 {
+		r, _ = utf8.DecodeRune(d)
+	}
+	return unicode.ToUpper(r)
+}
+
+// unicodeCollator pairs a collator and its buffer, which
+// can't be used concurrently.
+type unicodeCollator struct {
+	col *collate.Collator
+	buf collate.Buffer
+}
+
+var unicodeCollators = sync.Pool{New: func() interface{} {
+	// Loose compares the characters at the primary level of the
+	// Unicode Collation Algorithm, like the *_unicode_ci collations.
+	return &unicodeCollator{col: collate.New(language.Und, collate.Loose)}
+}}
+
+// unicodeWeights implements the *_unicode_ci and *_ai_ci collations
+// with the Unicode Collation Algorithm.
+func unicodeWeights(dst, src []byte) []byte {
+	uc := unicodeCollators.Get().(*unicodeCollator)
+	defer unicodeCollators.Put(uc)
+	dst = append(dst, uc.col.Key(&uc.buf, src)...)
+	uc.buf.Reset()
+	return dst
+}
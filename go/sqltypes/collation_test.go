@@ -0,0 +1,146 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqltypes
+
+import (
+	"testing"
+)
+
+func TestCollationCompare(t *testing.T) {
+	testcases := []struct {
+		collation uint32
+		a, b      string
+		out       int
+	}{{
+		collation: CollationUtf8mb4GeneralCI,
+		a:         "abc",
+		b:         "ABC",
+		out:       0,
+	}, {
+		collation: CollationUtf8mb4GeneralCI,
+		a:         "abc ",
+		b:         "abc",
+		out:       0,
+	}, {
+		collation: CollationUtf8mb4GeneralCI,
+		a:         "résumé",
+		b:         "RESUME",
+		out:       0,
+	}, {
+		collation: CollationUtf8mb4GeneralCI,
+		a:         "a",
+		b:         "B",
+		out:       -1,
+	}, {
+		collation: CollationUtf8mb4GeneralCI,
+		a:         "Z",
+		b:         "a",
+		out:       1,
+	}, {
+		collation: CollationUtf8mb4Bin,
+		a:         "abc",
+		b:         "ABC",
+		out:       1,
+	}, {
+		collation: CollationUtf8mb4Bin,
+		a:         "abc ",
+		b:         "abc",
+		out:       0,
+	}, {
+		collation: CollationBinary,
+		a:         "abc ",
+		b:         "abc",
+		out:       1,
+	}, {
+		collation: CollationUtf8mb4UnicodeCI,
+		a:         "Straße",
+		b:         "STRASSE",
+		out:       0,
+	}, {
+		collation: CollationUtf8mb4UnicodeCI,
+		a:         "b",
+		b:         "Á",
+		out:       1,
+	}, {
+		collation: CollationUtf8mb40900AICI,
+		a:         "abc ",
+		b:         "abc",
+		out:       1,
+	}}
+	for _, tcase := range testcases {
+		coll := CollationByID(tcase.collation)
+		if got := coll.Compare([]byte(tcase.a), []byte(tcase.b)); got != tcase.out {
+			t.Errorf("%s.Compare(%q, %q): %d, want %d", coll.Name(), tcase.a, tcase.b, got, tcase.out)
+		}
+	}
+}
+
+func TestCollationByName(t *testing.T) {
+	coll := CollationByName("utf8mb4_general_ci")
+	if coll == nil || coll.ID() != CollationUtf8mb4GeneralCI {
+		t.Errorf("CollationByName(utf8mb4_general_ci): %v, want id %d", coll, CollationUtf8mb4GeneralCI)
+	}
+	if coll := CollationByName("latin1_swedish_ci"); coll != nil {
+		t.Errorf("CollationByName(latin1_swedish_ci): %v, want nil", coll)
+	}
+}
+
+func TestNullsafeCompareCollated(t *testing.T) {
+	testcases := []struct {
+		v1, v2    Value
+		collation uint32
+		out       int
+		err       string
+	}{{
+		v1:        NewVarChar("abc"),
+		v2:        NewVarChar("ABD"),
+		collation: CollationUtf8mb4GeneralCI,
+		out:       -1,
+	}, {
+		v1:        NULL,
+		v2:        NewVarChar("abc"),
+		collation: CollationUtf8mb4GeneralCI,
+		out:       -1,
+	}, {
+		v1:        NewInt64(10),
+		v2:        NewInt64(9),
+		collation: CollationBinary,
+		out:       1,
+	}, {
+		// Unsupported collation.
+		v1:        NewVarChar("abc"),
+		v2:        NewVarChar("abc"),
+		collation: 8,
+		err:       "types are not comparable: VARCHAR vs VARCHAR",
+	}}
+	for _, tcase := range testcases {
+		got, err := NullsafeCompareCollated(tcase.v1, tcase.v2, tcase.collation)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("NullsafeCompareCollated(%v, %v): %v, want %s", tcase.v1, tcase.v2, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("NullsafeCompareCollated(%v, %v): %v", tcase.v1, tcase.v2, err)
+			continue
+		}
+		if got != tcase.out {
+			t.Errorf("NullsafeCompareCollated(%v, %v): %d, want %d", tcase.v1, tcase.v2, got, tcase.out)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqltypes
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// divisionScale is the number of digits a division adds to the scale
+// of the dividend, like the default div_precision_increment of MySQL.
+const divisionScale = 4
+
+var (
+	bigTen = big.NewInt(10)
+	bigOne = big.NewInt(1)
+)
+
+// decimal is an exact fixed-point number, whose value is
+// unscaled * 10^-scale. It's used for the arithmetic on
+// DECIMAL values, which would lose precision as float64.
+type decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+// parseDecimal parses a number in the format MySQL uses for DECIMAL
+// values, like -123.45. Exponents are not supported.
+func parseDecimal(s string) (decimal, error) {
+	digits, scale := s, 0
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		digits, scale = s[:i]+s[i+1:], len(s)-i-1
+	}
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return decimal{}, fmt.Errorf("invalid decimal value: '%s'", s)
+	}
+	return decimal{unscaled: unscaled, scale: scale}, nil
+}
+
+func decimalFromInt64(v int64) decimal {
+	return decimal{unscaled: big.NewInt(v)}
+}
+
+func decimalFromUint64(v uint64) decimal {
+	return decimal{unscaled: new(big.Int).SetUint64(v)}
+}
+
+// rescaled returns the unscaled value of d for a scale
+// that is not smaller than the scale of d.
+func (d decimal) rescaled(scale int) *big.Int {
+	if scale == d.scale {
+		return d.unscaled
+	}
+	return new(big.Int).Mul(d.unscaled, pow10(scale-d.scale))
+}
+
+func (d decimal) add(d2 decimal) decimal {
+	scale := maxScale(d, d2)
+	return decimal{unscaled: new(big.Int).Add(d.rescaled(scale), d2.rescaled(scale)), scale: scale}
+}
+
+func (d decimal) sub(d2 decimal) decimal {
+	scale := maxScale(d, d2)
+	return decimal{unscaled: new(big.Int).Sub(d.rescaled(scale), d2.rescaled(scale)), scale: scale}
+}
+
+func (d decimal) mul(d2 decimal) decimal {
+	return decimal{unscaled: new(big.Int).Mul(d.unscaled, d2.unscaled), scale: d.scale + d2.scale}
+}
+
+// div divides d by a non-zero d2. Like in MySQL, the scale of
+// the quotient is the scale of d plus divisionScale, and the
+// last digit is rounded half away from zero.
+func (d decimal) div(d2 decimal) decimal {
+	scale := d.scale + divisionScale
+	// unscaled = d.unscaled * 10^(scale - d.scale + d2.scale) / d2.unscaled,
+	// computed with one more digit for the rounding.
+	num := new(big.Int).Mul(d.unscaled, pow10(divisionScale+d2.scale+1))
+	quo := num.Quo(num, d2.unscaled)
+	quo, rem := quo.QuoRem(quo, bigTen, new(big.Int))
+	switch rem.Int64() {
+	case 5, 6, 7, 8, 9:
+		quo.Add(quo, bigOne)
+	case -5, -6, -7, -8, -9:
+		quo.Sub(quo, bigOne)
+	}
+	return decimal{unscaled: quo, scale: scale}
+}
+
+func (d decimal) cmp(d2 decimal) int {
+	scale := maxScale(d, d2)
+	return d.rescaled(scale).Cmp(d2.rescaled(scale))
+}
+
+// truncated returns the integral part of d.
+func (d decimal) truncated() *big.Int {
+	return new(big.Int).Quo(d.unscaled, pow10(d.scale))
+}
+
+func (d decimal) float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String formats d with all the digits of its scale.
+func (d decimal) String() string {
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if d.scale > 0 {
+		if pad := d.scale + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+	if d.unscaled.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+func maxScale(d, d2 decimal) int {
+	if d.scale > d2.scale {
+		return d.scale
+	}
+	return d2.scale
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqltypes
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecimalArithmetic(t *testing.T) {
+	testcases := []struct {
+		op     func(v1, v2 Value) (Value, error)
+		v1, v2 Value
+		out    Value
+	}{{
+		op:  Add,
+		v1:  TestValue(Decimal, "0.1"),
+		v2:  TestValue(Decimal, "0.2"),
+		out: TestValue(Decimal, "0.3"),
+	}, {
+		op:  Add,
+		v1:  TestValue(Decimal, "99999999999999999999.99"),
+		v2:  NewInt64(1),
+		out: TestValue(Decimal, "100000000000000000000.99"),
+	}, {
+		op:  Add,
+		v1:  TestValue(Decimal, "1.5"),
+		v2:  NewFloat64(0.25),
+		out: NewFloat64(1.75),
+	}, {
+		op:  Subtract,
+		v1:  NewInt64(1),
+		v2:  TestValue(Decimal, "1.01"),
+		out: TestValue(Decimal, "-0.01"),
+	}, {
+		op:  Multiply,
+		v1:  TestValue(Decimal, "-1.5"),
+		v2:  TestValue(Decimal, "0.25"),
+		out: TestValue(Decimal, "-0.375"),
+	}, {
+		op:  Divide,
+		v1:  TestValue(Decimal, "10.00"),
+		v2:  NewInt64(3),
+		out: TestValue(Decimal, "3.333333"),
+	}, {
+		op:  Divide,
+		v1:  TestValue(Decimal, "-2"),
+		v2:  TestValue(Decimal, "3"),
+		out: TestValue(Decimal, "-0.6667"),
+	}}
+	for _, tcase := range testcases {
+		got, err := tcase.op(tcase.v1, tcase.v2)
+		if err != nil {
+			t.Errorf("(%v, %v): %v", tcase.v1, tcase.v2, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tcase.out) {
+			t.Errorf("(%v, %v): %v, want %v", tcase.v1, tcase.v2, printValue(got), printValue(tcase.out))
+		}
+	}
+}
+
+func TestDecimalNullsafeAdd(t *testing.T) {
+	got := NullsafeAdd(TestValue(Decimal, "12345678901234567890.1"), TestValue(Decimal, "0.02"), Decimal)
+	want := TestValue(Decimal, "12345678901234567890.12")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NullsafeAdd: %v, want %v", printValue(got), printValue(want))
+	}
+	got = NullsafeAdd(NULL, TestValue(Decimal, "1.5"), Decimal)
+	want = TestValue(Decimal, "1.5")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NullsafeAdd: %v, want %v", printValue(got), printValue(want))
+	}
+	got = NullsafeAdd(TestValue(Decimal, "1.5"), NewInt64(1), Int64)
+	want = NewInt64(2)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NullsafeAdd: %v, want %v", printValue(got), printValue(want))
+	}
+}
+
+func TestDecimalCompare(t *testing.T) {
+	testcases := []struct {
+		v1, v2 Value
+		out    int
+	}{{
+		v1:  TestValue(Decimal, "1.10"),
+		v2:  TestValue(Decimal, "1.1"),
+		out: 0,
+	}, {
+		v1:  TestValue(Decimal, "18446744073709551615.1"),
+		v2:  TestValue(Decimal, "18446744073709551615.01"),
+		out: 1,
+	}, {
+		v1:  NewInt64(-1),
+		v2:  TestValue(Decimal, "-0.5"),
+		out: -1,
+	}, {
+		v1:  TestValue(Decimal, "0.5"),
+		v2:  NewFloat64(0.25),
+		out: 1,
+	}}
+	for _, tcase := range testcases {
+		got, err := NullsafeCompare(tcase.v1, tcase.v2)
+		if err != nil {
+			t.Errorf("NullsafeCompare(%v, %v): %v", tcase.v1, tcase.v2, err)
+			continue
+		}
+		if got != tcase.out {
+			t.Errorf("NullsafeCompare(%v, %v): %d, want %d", tcase.v1, tcase.v2, got, tcase.out)
+		}
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{
+		{"0", "0"},
+		{"-12.340", "-12.340"},
+		{".5", "0.5"},
+		{"-.05", "-0.05"},
+		{"1.", "1"},
+	}
+	for _, tcase := range testcases {
+		d, err := parseDecimal(tcase.in)
+		if err != nil {
+			t.Errorf("parseDecimal(%s): %v", tcase.in, err)
+			continue
+		}
+		if got := d.String(); got != tcase.out {
+			t.Errorf("parseDecimal(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+	if _, err := parseDecimal("1e5"); err == nil {
+		t.Errorf("parseDecimal(1e5): nil, want error")
+	}
+}
@@ -85,6 +85,9 @@ func (result *Result) Copy() *Result {
 				Position:  result.Extras.EventToken.Position,
 			}
 		}
+		if result.Extras.Trace != nil {
+			out.Extras.Trace = proto.Clone(result.Extras.Trace).(*querypb.QueryTrace)
+		}
 	}
 	return out
 }
@@ -74,6 +74,11 @@ func NewValue(typ querypb.Type, val []byte) (v Value, err error) {
 			return NULL, err
 		}
 		return MakeTrusted(typ, val), nil
+	case typ == TypeJSON:
+		if !json.Valid(val) {
+			return NULL, fmt.Errorf("invalid JSON value: %q", val)
+		}
+		return MakeTrusted(typ, val), nil
 	case IsQuoted(typ) || typ == Bit || typ == Null:
 		return MakeTrusted(typ, val), nil
 	}
@@ -228,6 +233,12 @@ func (v Value) EncodeSQL(b BinWriter) {
 	switch {
 	case v.typ == Null:
 		b.Write(nullstr)
+	case v.typ == TypeJSON:
+		// A JSON value is sent as a JSON document, otherwise MySQL
+		// compares and stores it as a plain string.
+		b.Write([]byte("cast("))
+		encodeBytesSQL(v.val, b)
+		b.Write([]byte(" as json)"))
 	case v.IsQuoted():
 		encodeBytesSQL(v.val, b)
 	case v.typ == Bit:
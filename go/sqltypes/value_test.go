@@ -138,6 +138,10 @@ func TestNewValue(t *testing.T) {
 		inType: Bit,
 		inVal:  "1",
 		outVal: TestValue(Bit, "1"),
+	}, {
+		inType: TypeJSON,
+		inVal:  `{"a": [1, 2]}`,
+		outVal: TestValue(TypeJSON, `{"a": [1, 2]}`),
 	}, {
 		inType: Enum,
 		inVal:  "a",
@@ -174,6 +178,10 @@ func TestNewValue(t *testing.T) {
 		inType: Expression,
 		inVal:  "a",
 		outErr: "invalid type specified for MakeValue: EXPRESSION",
+	}, {
+		inType: TypeJSON,
+		inVal:  "{a",
+		outErr: "invalid JSON value",
 	}}
 	for _, tcase := range testcases {
 		v, err := NewValue(tcase.inType, []byte(tcase.inVal))
@@ -386,6 +394,10 @@ func TestEncode(t *testing.T) {
 		in:       TestValue(Bit, "a"),
 		outSQL:   "b'01100001'",
 		outASCII: "'YQ=='",
+	}, {
+		in:       TestValue(TypeJSON, `{"a": "b'c"}`),
+		outSQL:   `cast('{\"a\": \"b\'c\"}' as json)`,
+		outASCII: "'eyJhIjogImInYyJ9'",
 	}}
 	for _, tcase := range testcases {
 		buf := &bytes.Buffer{}
@@ -82,7 +82,7 @@ func (be PromBackend) publishPrometheusMetric(name string, v expvar.Var) {
 		newMultiTimingsCollector(st, be.buildPromName(name))
 	case *stats.Histogram:
 		newHistogramCollector(st, be.buildPromName(name))
-	case *stats.String, stats.StringFunc, stats.StringMapFunc, *stats.Rates:
+	case *stats.String, stats.StringFunc, stats.StringMapFunc, stats.JSONFunc, *stats.Rates:
 		// Silently ignore these types since they don't make sense to
 		// export to Prometheus' data model.
 	default:
@@ -340,6 +340,21 @@ func TestPrometheusHistogram(t *testing.T) {
 	}
 }
 
+func TestPrometheusIgnoredTypes(t *testing.T) {
+	// The string based variables don't fit the Prometheus data model,
+	// they are not exported but publishing them must not fail.
+	stats.NewString("blah_string").Set("value")
+	stats.Publish("blah_stringfunc", stats.StringFunc(func() string { return "value" }))
+	stats.PublishJSONFunc("blah_jsonfunc", func() string { return `{"a": 1}` })
+
+	response := testMetricsHandler(t)
+	for _, name := range []string{"blah_string", "blah_stringfunc", "blah_jsonfunc"} {
+		if strings.Contains(response.Body.String(), fmt.Sprintf("%s_%s", namespace, name)) {
+			t.Errorf("Expected %s not to be exported, got %s", name, response.Body.String())
+		}
+	}
+}
+
 func testMetricsHandler(t *testing.T) *httptest.ResponseRecorder {
 	req, _ := http.NewRequest("GET", "/metrics", nil)
 	response := httptest.NewRecorder()
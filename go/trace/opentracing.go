@@ -16,6 +16,7 @@ limitations under the License.
 package trace
 
 import (
+	"io"
 	"strings"
 
 	otgrpc "github.com/opentracing-contrib/go-grpc"
@@ -44,6 +45,36 @@ func (js openTracingSpan) Annotate(key string, value interface{}) {
 
 var _ tracingService = (*openTracingService)(nil)
 
+// OpenTracingFactory creates an OpenTracing tracer for the service
+// provided. The io.Closer flushes the spans to the backend.
+type OpenTracingFactory func(serviceName string) (opentracing.Tracer, io.Closer, error)
+
+// RegisterOpenTracingFactory makes an OpenTracing compatible tracer
+// available as a -tracer option. Plugins use it to add exporters without
+// changing this package, for instance an OpenTelemetry SDK exporting its
+// spans with OTLP, through the OpenTelemetry OpenTracing bridge.
+func RegisterOpenTracingFactory(name string, factory OpenTracingFactory) {
+	tracingBackendFactories[name] = func(serviceName string) (tracingService, io.Closer, error) {
+		actual, closer, err := factory(serviceName)
+		if err != nil {
+			return nil, nil, err
+		}
+		opentracing.SetGlobalTracer(actual)
+		return openTracingService{Tracer: &genericTracer{actual: actual}}, closer, nil
+	}
+}
+
+var _ tracer = (*genericTracer)(nil)
+
+// genericTracer wraps the tracers registered with RegisterOpenTracingFactory.
+type genericTracer struct {
+	actual opentracing.Tracer
+}
+
+func (gt *genericTracer) GetOpenTracingTracer() opentracing.Tracer {
+	return gt.actual
+}
+
 type tracer interface {
 	GetOpenTracingTracer() opentracing.Tracer
 }
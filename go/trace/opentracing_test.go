@@ -17,10 +17,14 @@ limitations under the License.
 package trace
 
 import (
+	"io"
+	"io/ioutil"
 	"testing"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
 )
 
 func TestExtractMapFromString(t *testing.T) {
@@ -39,3 +43,32 @@ func TestErrorConditions(t *testing.T) {
 	_, err = extractMapFromString("key=value:keywithnovalue")
 	assert.Error(t, err)
 }
+
+func TestRegisterOpenTracingFactory(t *testing.T) {
+	mock := mocktracer.New()
+	RegisterOpenTracingFactory("mock", func(serviceName string) (opentracing.Tracer, io.Closer, error) {
+		return mock, ioutil.NopCloser(nil), nil
+	})
+	defer func(saved tracingService, name *string) {
+		currentTracer = saved
+		tracingServer = name
+	}(currentTracer, tracingServer)
+
+	name := "mock"
+	tracingServer = &name
+	StartTracing("vtservice")
+
+	parent, ctx := NewSpan(context.Background(), "parent")
+	child, _ := NewSpan(ctx, "child")
+	child.Annotate("keyspace", "ks")
+	child.Finish()
+	parent.Finish()
+
+	spans := mock.FinishedSpans()
+	if !assert.Len(t, spans, 2) {
+		return
+	}
+	assert.Equal(t, "child", spans[0].OperationName)
+	assert.Equal(t, "ks", spans[0].Tag("keyspace"))
+	assert.Equal(t, spans[1].SpanContext.SpanID, spans[0].ParentID)
+}
@@ -32,8 +32,9 @@ included but nothing Jaeger specific.
 */
 
 var (
-	agentHost    = flag.String("jaeger-agent-host", "", "host and port to send spans to. if empty, no tracing will be done")
-	samplingRate = flag.Float64("tracing-sampling-rate", 0.1, "sampling rate for the probabilistic jaeger sampler")
+	agentHost         = flag.String("jaeger-agent-host", "", "host and port to send spans to. if empty, no tracing will be done")
+	collectorEndpoint = flag.String("jaeger-collector-endpoint", "", "if set, the spans are sent over HTTP to this collector endpoint, like http://collector:14268/api/traces, instead of the agent. OpenTelemetry collectors accept them with their jaeger receiver, and can export them with OTLP")
+	samplingRate      = flag.Float64("tracing-sampling-rate", 0.1, "sampling rate for the probabilistic jaeger sampler")
 )
 
 // newJagerTracerFromEnv will instantiate a tracingService implemented by Jaeger,
@@ -67,7 +68,14 @@ func newJagerTracerFromEnv(serviceName string) (tracingService, io.Closer, error
 	if *agentHost != "" {
 		cfg.Reporter.LocalAgentHostPort = *agentHost
 	}
-	log.Infof("Tracing to: %v as %v", cfg.Reporter.LocalAgentHostPort, cfg.ServiceName)
+	if *collectorEndpoint != "" {
+		cfg.Reporter.CollectorEndpoint = *collectorEndpoint
+	}
+	if cfg.Reporter.CollectorEndpoint != "" {
+		log.Infof("Tracing to collector: %v as %v", cfg.Reporter.CollectorEndpoint, cfg.ServiceName)
+	} else {
+		log.Infof("Tracing to: %v as %v", cfg.Reporter.LocalAgentHostPort, cfg.ServiceName)
+	}
 	cfg.Sampler = &config.SamplerConfig{
 		Type:  jaeger.SamplerTypeConst,
 		Param: *samplingRate,
@@ -0,0 +1,236 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package authz allows vtgate and vttablet to delegate query
+// authorization to an external policy service.
+//
+// Implementations register themselves with RegisterAuthorizer, and
+// are selected with the -authz_implementation flag. Decisions are
+// cached per caller and query fingerprint. When the service cannot
+// be reached, queries are denied unless -authz_fail_open is set.
+package authz
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	authzpb "vitess.io/vitess/go/vt/proto/authz"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	authzImplementation = flag.String("authz_implementation", "", "the external authorization implementation to use, e.g. grpc. If empty, no external authorization is done.")
+	authzTimeout        = flag.Duration("authz_timeout", 1*time.Second, "timeout for calls to the external authorization service")
+	authzCacheTTL       = flag.Duration("authz_cache_ttl", 30*time.Second, "how long authorization decisions are cached, unless the service returns its own TTL. 0 disables the cache.")
+	authzCacheSize      = flag.Int("authz_cache_size", 10000, "maximum number of authorization decisions kept in the cache")
+	authzFailOpen       = flag.Bool("authz_fail_open", false, "if true, queries are allowed when the external authorization service fails. Otherwise they are denied.")
+
+	decisions = stats.NewCountersWithSingleLabel("AuthzDecisions", "External authorization decisions", "Decision", "Allowed", "Denied", "CacheHit", "FailOpen", "FailClosed")
+	latency   = stats.NewTimings("AuthzLatency", "External authorization service call latency", "Implementation")
+)
+
+// Authorizer is the interface implemented by external authorization
+// services.
+type Authorizer interface {
+	// Authorize decides if the query described by request can be executed.
+	Authorize(ctx context.Context, request *authzpb.AuthorizeRequest) (*authzpb.AuthorizeResponse, error)
+
+	// Close releases the resources held by the Authorizer.
+	Close()
+}
+
+// Factory creates an Authorizer.
+type Factory func() (Authorizer, error)
+
+var (
+	mu        sync.Mutex
+	factories = make(map[string]Factory)
+)
+
+// RegisterAuthorizer registers an Authorizer implementation under a
+// name. It is meant to be called from init functions.
+func RegisterAuthorizer(name string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := factories[name]; ok {
+		log.Fatalf("authorizer %v already registered", name)
+	}
+	factories[name] = factory
+}
+
+// Checker authorizes queries through an Authorizer, caching its
+// decisions. A nil *Checker allows all queries.
+type Checker struct {
+	component  string
+	name       string
+	authorizer Authorizer
+	timeout    time.Duration
+	ttl        time.Duration
+	failOpen   bool
+
+	// decisions is nil if the cache is disabled.
+	decisions *cache.LRUCache
+}
+
+// NewChecker returns a Checker for the implementation selected with
+// -authz_implementation. It returns nil if external authorization is
+// not enabled. component identifies the caller, e.g. vtgate.
+func NewChecker(component string) (*Checker, error) {
+	if *authzImplementation == "" {
+		return nil, nil
+	}
+	mu.Lock()
+	factory, ok := factories[*authzImplementation]
+	mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no authorizer registered with name %v", *authzImplementation)
+	}
+	authorizer, err := factory()
+	if err != nil {
+		return nil, err
+	}
+	return newChecker(component, *authzImplementation, authorizer, *authzTimeout, *authzCacheTTL, *authzCacheSize, *authzFailOpen), nil
+}
+
+func newChecker(component, name string, authorizer Authorizer, timeout, ttl time.Duration, cacheSize int, failOpen bool) *Checker {
+	c := &Checker{
+		component:  component,
+		name:       name,
+		authorizer: authorizer,
+		timeout:    timeout,
+		ttl:        ttl,
+		failOpen:   failOpen,
+	}
+	if ttl > 0 && cacheSize > 0 {
+		c.decisions = cache.NewLRUCache(int64(cacheSize))
+	}
+	return c
+}
+
+// cachedDecision is a decision stored in the cache.
+type cachedDecision struct {
+	allowed bool
+	reason  string
+	expires time.Time
+}
+
+// Size is part of the cache.Value interface.
+func (cd *cachedDecision) Size() int {
+	return 1
+}
+
+// Check returns a PERMISSION_DENIED error if the query is not allowed.
+// The caller ids are read from ctx, and the Component field of request
+// is filled in by Check.
+func (c *Checker) Check(ctx context.Context, request *authzpb.AuthorizeRequest) error {
+	if c == nil {
+		return nil
+	}
+	request.Component = c.component
+	request.EffectiveCallerId = callerid.EffectiveCallerIDFromContext(ctx)
+	request.ImmediateCallerId = callerid.ImmediateCallerIDFromContext(ctx)
+
+	key := cacheKey(request)
+	now := time.Now()
+	if c.decisions != nil {
+		if v, ok := c.decisions.Get(key); ok {
+			cd := v.(*cachedDecision)
+			if now.Before(cd.expires) {
+				decisions.Add("CacheHit", 1)
+				return c.result(request, cd.allowed, cd.reason)
+			}
+			c.decisions.Delete(key)
+		}
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	response, err := c.authorizer.Authorize(callCtx, request)
+	latency.Record(c.name, now)
+	if err != nil {
+		if c.failOpen {
+			decisions.Add("FailOpen", 1)
+			log.Warningf("external authorization failed, allowing query: %v", err)
+			return nil
+		}
+		decisions.Add("FailClosed", 1)
+		return vterrors.Wrapf(err, "external authorization failed for %v", c.principal(request))
+	}
+
+	if c.decisions != nil {
+		ttl := c.ttl
+		if response.CacheTtlSeconds > 0 {
+			ttl = time.Duration(response.CacheTtlSeconds) * time.Second
+		}
+		c.decisions.Set(key, &cachedDecision{
+			allowed: response.Allowed,
+			reason:  response.Reason,
+			expires: now.Add(ttl),
+		})
+	}
+	return c.result(request, response.Allowed, response.Reason)
+}
+
+// Close releases the resources held by the Checker.
+func (c *Checker) Close() {
+	if c == nil {
+		return
+	}
+	c.authorizer.Close()
+}
+
+func (c *Checker) result(request *authzpb.AuthorizeRequest, allowed bool, reason string) error {
+	if allowed {
+		decisions.Add("Allowed", 1)
+		return nil
+	}
+	decisions.Add("Denied", 1)
+	if reason == "" {
+		reason = "denied by external authorization"
+	}
+	return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "%v: %v, statement type %v, tables %v", reason, c.principal(request), request.StatementType, request.Tables)
+}
+
+func (c *Checker) principal(request *authzpb.AuthorizeRequest) string {
+	return fmt.Sprintf("user %v", request.ImmediateCallerId.GetUsername())
+}
+
+// cacheKey returns the key under which the decision for request is
+// cached. Everything sent to the service is part of the key.
+func cacheKey(request *authzpb.AuthorizeRequest) string {
+	return strings.Join([]string{
+		request.EffectiveCallerId.GetPrincipal(),
+		request.EffectiveCallerId.GetComponent(),
+		request.EffectiveCallerId.GetSubcomponent(),
+		request.ImmediateCallerId.GetUsername(),
+		strings.Join(request.ImmediateCallerId.GetGroups(), ","),
+		request.Keyspace,
+		request.StatementType,
+		strings.Join(request.Tables, ","),
+		request.Fingerprint,
+	}, "\x00")
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authz
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	authzpb "vitess.io/vitess/go/vt/proto/authz"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+type fakeAuthorizer struct {
+	calls    int
+	response *authzpb.AuthorizeResponse
+	err      error
+	last     *authzpb.AuthorizeRequest
+}
+
+func (fa *fakeAuthorizer) Authorize(ctx context.Context, request *authzpb.AuthorizeRequest) (*authzpb.AuthorizeResponse, error) {
+	fa.calls++
+	fa.last = request
+	return fa.response, fa.err
+}
+
+func (fa *fakeAuthorizer) Close() {}
+
+func testContext(username string) context.Context {
+	return callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("principal", "", ""), callerid.NewImmediateCallerID(username))
+}
+
+func TestNilChecker(t *testing.T) {
+	var c *Checker
+	if err := c.Check(context.Background(), &authzpb.AuthorizeRequest{}); err != nil {
+		t.Errorf("Check on nil checker: %v, want nil", err)
+	}
+	c.Close()
+}
+
+func TestCheckerCache(t *testing.T) {
+	fa := &fakeAuthorizer{response: &authzpb.AuthorizeResponse{Allowed: true}}
+	c := newChecker("vtgate", "fake", fa, time.Second, time.Hour, 10, false)
+
+	for i := 0; i < 3; i++ {
+		if err := c.Check(testContext("user1"), &authzpb.AuthorizeRequest{Fingerprint: "select 1 from t"}); err != nil {
+			t.Fatalf("Check: %v", err)
+		}
+	}
+	if fa.calls != 1 {
+		t.Errorf("calls: %v, want 1", fa.calls)
+	}
+	if fa.last.Component != "vtgate" || fa.last.ImmediateCallerId.GetUsername() != "user1" || fa.last.EffectiveCallerId.GetPrincipal() != "principal" {
+		t.Errorf("unexpected request: %v", fa.last)
+	}
+
+	// A different user is a different cache entry.
+	if err := c.Check(testContext("user2"), &authzpb.AuthorizeRequest{Fingerprint: "select 1 from t"}); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if fa.calls != 2 {
+		t.Errorf("calls: %v, want 2", fa.calls)
+	}
+}
+
+func TestCheckerCacheExpiry(t *testing.T) {
+	fa := &fakeAuthorizer{response: &authzpb.AuthorizeResponse{Allowed: true}}
+	c := newChecker("vttablet", "fake", fa, time.Second, time.Nanosecond, 10, false)
+
+	for i := 0; i < 2; i++ {
+		if err := c.Check(testContext("user1"), &authzpb.AuthorizeRequest{}); err != nil {
+			t.Fatalf("Check: %v", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if fa.calls != 2 {
+		t.Errorf("calls: %v, want 2", fa.calls)
+	}
+
+	// The TTL returned by the service overrides the default.
+	fa.response.CacheTtlSeconds = 3600
+	for i := 0; i < 2; i++ {
+		if err := c.Check(testContext("user1"), &authzpb.AuthorizeRequest{}); err != nil {
+			t.Fatalf("Check: %v", err)
+		}
+	}
+	if fa.calls != 3 {
+		t.Errorf("calls: %v, want 3", fa.calls)
+	}
+}
+
+func TestCheckerDenied(t *testing.T) {
+	fa := &fakeAuthorizer{response: &authzpb.AuthorizeResponse{Allowed: false, Reason: "no access to t"}}
+	c := newChecker("vtgate", "fake", fa, time.Second, 0, 0, false)
+
+	err := c.Check(testContext("user1"), &authzpb.AuthorizeRequest{StatementType: "SELECT", Tables: []string{"t"}})
+	if got, want := vterrors.Code(err), vtrpcpb.Code_PERMISSION_DENIED; got != want {
+		t.Errorf("code: %v, want %v", got, want)
+	}
+	want := "no access to t: user user1, statement type SELECT, tables [t]"
+	if err == nil || err.Error() != want {
+		t.Errorf("err: %v, want %v", err, want)
+	}
+}
+
+func TestCheckerFailure(t *testing.T) {
+	fa := &fakeAuthorizer{err: errors.New("connection refused")}
+
+	c := newChecker("vtgate", "fake", fa, time.Second, time.Hour, 10, false)
+	if err := c.Check(testContext("user1"), &authzpb.AuthorizeRequest{}); err == nil {
+		t.Errorf("Check with fail closed: nil, want error")
+	}
+
+	c = newChecker("vtgate", "fake", fa, time.Second, time.Hour, 10, true)
+	if err := c.Check(testContext("user1"), &authzpb.AuthorizeRequest{}); err != nil {
+		t.Errorf("Check with fail open: %v, want nil", err)
+	}
+
+	// Failures are not cached.
+	fa.calls = 0
+	for i := 0; i < 2; i++ {
+		c.Check(testContext("user1"), &authzpb.AuthorizeRequest{})
+	}
+	if fa.calls != 2 {
+		t.Errorf("calls: %v, want 2", fa.calls)
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	testcases := []struct {
+		sql         string
+		fingerprint string
+		tables      []string
+	}{{
+		sql:         "select a.id from t1 as a join ks.t2 on a.id = t2.id where a.b = 'foo'",
+		fingerprint: "select a.id from t1 as a join ks.t2 on a.id = t2.id where a.b = :authz1",
+		tables:      []string{"ks.t2", "t1"},
+	}, {
+		sql:         "insert into t1(id) values (1), (2)",
+		fingerprint: "insert into t1(id) values (:authz1), (:authz2)",
+		tables:      []string{"t1"},
+	}, {
+		sql:         "update t1 set a = 1 where id in (select id from t2)",
+		fingerprint: "update t1 set a = :authz1 where id in (select id from t2)",
+		tables:      []string{"t1", "t2"},
+	}}
+	for _, tc := range testcases {
+		stmt, err := sqlparser.Parse(tc.sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		request := NewRequest(stmt, sqlparser.Preview(tc.sql), "ks")
+		if request.Fingerprint != tc.fingerprint {
+			t.Errorf("NewRequest(%s).Fingerprint: %s, want %s", tc.sql, request.Fingerprint, tc.fingerprint)
+		}
+		if !reflect.DeepEqual(request.Tables, tc.tables) {
+			t.Errorf("NewRequest(%s).Tables: %v, want %v", tc.sql, request.Tables, tc.tables)
+		}
+		if request.Keyspace != "ks" {
+			t.Errorf("NewRequest(%s).Keyspace: %s, want ks", tc.sql, request.Keyspace)
+		}
+	}
+}
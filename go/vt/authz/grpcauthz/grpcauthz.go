@@ -0,0 +1,82 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpcauthz contains the gRPC implementation of the external
+// authorization client.
+package grpcauthz
+
+import (
+	"flag"
+	"fmt"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/authz"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	authzpb "vitess.io/vitess/go/vt/proto/authz"
+)
+
+var (
+	server = flag.String("authz_grpc_server", "", "the address of the gRPC external authorization server")
+	cert   = flag.String("authz_grpc_cert", "", "the cert to use to connect")
+	key    = flag.String("authz_grpc_key", "", "the key to use to connect")
+	ca     = flag.String("authz_grpc_ca", "", "the server ca to use to validate servers when connecting")
+	name   = flag.String("authz_grpc_server_name", "", "the server name to use to validate server certificate, or the SPIFFE ID or trust domain of the server (like spiffe://example.org/vttablet), which requires a ca")
+)
+
+type client struct {
+	conn       *grpc.ClientConn
+	gRPCClient authzpb.AuthorizerClient
+}
+
+func factory() (authz.Authorizer, error) {
+	if *server == "" {
+		return nil, fmt.Errorf("-authz_grpc_server must be set")
+	}
+	opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *name)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpcclient.Dial(*server, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, err
+	}
+	return &client{
+		conn:       conn,
+		gRPCClient: authzpb.NewAuthorizerClient(conn),
+	}, nil
+}
+
+// Authorize is part of the authz.Authorizer interface.
+func (c *client) Authorize(ctx context.Context, request *authzpb.AuthorizeRequest) (*authzpb.AuthorizeResponse, error) {
+	response, err := c.gRPCClient.Authorize(ctx, request)
+	if err != nil {
+		return nil, vterrors.FromGRPC(err)
+	}
+	return response, nil
+}
+
+// Close is part of the authz.Authorizer interface.
+func (c *client) Close() {
+	c.conn.Close()
+}
+
+func init() {
+	authz.RegisterAuthorizer("grpc", factory)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authz
+
+import (
+	"sort"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	authzpb "vitess.io/vitess/go/vt/proto/authz"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// NewRequest builds the AuthorizeRequest for a parsed statement.
+// The statement is normalized in place to compute its fingerprint.
+func NewRequest(stmt sqlparser.Statement, stmtType sqlparser.StatementType, keyspace string) *authzpb.AuthorizeRequest {
+	tables := Tables(stmt)
+	sqlparser.Normalize(stmt, make(map[string]*querypb.BindVariable), "authz")
+	return &authzpb.AuthorizeRequest{
+		Fingerprint:   sqlparser.String(stmt),
+		StatementType: stmtType.String(),
+		Keyspace:      keyspace,
+		Tables:        tables,
+	}
+}
+
+// Tables returns the sorted list of distinct tables referenced by
+// a statement. Qualified tables are returned as keyspace.table.
+func Tables(stmt sqlparser.Statement) []string {
+	seen := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			// The qualifier of a column is not necessarily a table name.
+			return false, nil
+		case sqlparser.TableName:
+			if !node.IsEmpty() {
+				seen[sqlparser.String(node)] = true
+			}
+			return false, nil
+		}
+		return true, nil
+	}, stmt)
+	tables := make([]string, 0, len(seen))
+	for table := range seen {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	return tables
+}
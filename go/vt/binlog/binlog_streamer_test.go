@@ -1037,6 +1037,90 @@ func TestStreamerParseEventsMariadbStandaloneGTID(t *testing.T) {
 	}
 }
 
+// TestStreamerParseEventsMariadbDomains tests a MariaDB server with
+// transactions in several replication domains: the position sent with
+// each transaction must keep the other domains.
+func TestStreamerParseEventsMariadbDomains(t *testing.T) {
+	f := mysql.NewMariaDBBinlogFormat()
+	s := mysql.NewFakeBinlogStream()
+	s.ServerID = 62344
+	s.Timestamp = 1409892744
+
+	input := []mysql.BinlogEvent{
+		mysql.NewRotateEvent(f, s, 4, "filename.0001"),
+		mysql.NewFormatDescriptionEvent(f, s),
+		mysql.NewMariaDBGTIDEvent(f, s, mysql.MariadbGTID{Domain: 1, Sequence: 5}, true /* hasBegin */),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Charset: &binlogdatapb.Charset{Client: 33, Conn: 33, Server: 33},
+			SQL:     "insert into vt_insert_test(msg) values ('test 0') /* _stream vt_insert_test (id ) (null ); */",
+		}),
+		mysql.NewXIDEvent(f, s),
+		mysql.NewMariaDBGTIDEvent(f, s, mysql.MariadbGTID{Domain: 0, Sequence: 10}, true /* hasBegin */),
+		mysql.NewQueryEvent(f, s, mysql.Query{
+			Charset: &binlogdatapb.Charset{Client: 33, Conn: 33, Server: 33},
+			SQL:     "insert into vt_insert_test(msg) values ('test 1') /* _stream vt_insert_test (id ) (null ); */",
+		}),
+		mysql.NewXIDEvent(f, s),
+	}
+
+	events := make(chan mysql.BinlogEvent)
+
+	startPos := mysql.Position{
+		GTIDSet: mysql.MariadbGTIDSet{
+			mysql.MariadbGTID{Domain: 2, Server: 62344, Sequence: 3},
+		},
+	}
+	want := []binlogdatapb.BinlogTransaction{
+		{
+			Statements: []*binlogdatapb.BinlogTransaction_Statement{
+				{
+					Category: binlogdatapb.BinlogTransaction_Statement_BL_SET,
+					Charset:  &binlogdatapb.Charset{Client: 33, Conn: 33, Server: 33},
+					Sql:      []byte("SET TIMESTAMP=1409892744"),
+				},
+				{
+					Category: binlogdatapb.BinlogTransaction_Statement_BL_INSERT,
+					Charset:  &binlogdatapb.Charset{Client: 33, Conn: 33, Server: 33},
+					Sql:      []byte("insert into vt_insert_test(msg) values ('test 0') /* _stream vt_insert_test (id ) (null ); */"),
+				},
+			},
+			EventToken: &querypb.EventToken{
+				Timestamp: 1409892744,
+				Position:  "MariaDB/1-62344-5,2-62344-3",
+			},
+		},
+		{
+			Statements: []*binlogdatapb.BinlogTransaction_Statement{
+				{
+					Category: binlogdatapb.BinlogTransaction_Statement_BL_SET,
+					Charset:  &binlogdatapb.Charset{Client: 33, Conn: 33, Server: 33},
+					Sql:      []byte("SET TIMESTAMP=1409892744"),
+				},
+				{
+					Category: binlogdatapb.BinlogTransaction_Statement_BL_INSERT,
+					Charset:  &binlogdatapb.Charset{Client: 33, Conn: 33, Server: 33},
+					Sql:      []byte("insert into vt_insert_test(msg) values ('test 1') /* _stream vt_insert_test (id ) (null ); */"),
+				},
+			},
+			EventToken: &querypb.EventToken{
+				Timestamp: 1409892744,
+				Position:  "MariaDB/0-62344-10,1-62344-5,2-62344-3",
+			},
+		},
+	}
+	var got binlogStatements
+	bls := NewStreamer(&mysql.ConnParams{DbName: "vt_test_keyspace"}, nil, nil, startPos, 0, (&got).sendTransaction)
+
+	go sendTestEvents(events, input)
+	if _, err := bls.parseEvents(context.Background(), events); err != ErrServerEOF {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if !got.equal(want) {
+		t.Errorf("binlogConnStreamer.parseEvents(): got:\n%v\nwant:\n%v", got, want)
+	}
+}
+
 func TestGetStatementCategory(t *testing.T) {
 	table := map[string]binlogdatapb.BinlogTransaction_Statement_Category{
 		"":  binlogdatapb.BinlogTransaction_Statement_BL_UNRECOGNIZED,
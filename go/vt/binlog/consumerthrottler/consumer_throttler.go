@@ -0,0 +1,188 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package consumerthrottler throttles the consumers of the binlog
+// streams of a tablet, like filtered replication or change data capture
+// clients. Each consumer gets its own rate limits, shared by all its
+// streams, and all the consumers are paused while the tablet lags too
+// much in its own replication, to let it catch up.
+package consumerthrottler
+
+import (
+	"flag"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/callerid"
+)
+
+var (
+	maxEventsPerSecond = flag.Int("binlog_consumer_max_events_per_second", 0, "Maximum rate of binlog events a consumer (filtered replication, vstream, update stream) may receive, across all its streams. 0 means unlimited.")
+	maxBytesPerSecond  = flag.Int("binlog_consumer_max_bytes_per_second", 0, "Maximum rate of bytes of binlog events a consumer may receive, across all its streams. 0 means unlimited.")
+	maxReplicationLag  = flag.Duration("binlog_consumer_max_replication_lag", 0, "Pause the binlog consumers while the replication lag of the tablet is above this value, to let the replication catch up. 0 disables the pause.")
+)
+
+var (
+	consumerStreams   = stats.NewGaugesWithSingleLabel("BinlogConsumerStreams", "Open binlog streams by consumer", "Consumer")
+	consumerEvents    = stats.NewCountersWithSingleLabel("BinlogConsumerEvents", "Binlog events sent by consumer", "Consumer")
+	consumerBytes     = stats.NewCountersWithSingleLabel("BinlogConsumerBytes", "Bytes of binlog events sent by consumer", "Consumer")
+	consumerThrottled = stats.NewMultiTimings("BinlogConsumerThrottled", "Time the binlog consumers were throttled, by consumer and reason", []string{"Consumer", "Reason"})
+)
+
+// lagCheckInterval is how often a paused consumer checks the
+// replication lag again.
+var lagCheckInterval = 1 * time.Second
+
+// replicationLag is the last replication lag reported by the tablet.
+var replicationLag sync2.AtomicDuration
+
+// SetReplicationLag records the current replication lag of the tablet.
+func SetReplicationLag(lag time.Duration) {
+	replicationLag.Set(lag)
+}
+
+// consumer holds the rate limits of a consumer.
+type consumer struct {
+	name    string
+	streams int
+	events  *rate.Limiter
+	bytes   *rate.Limiter
+}
+
+var (
+	mu        sync.Mutex
+	consumers = make(map[string]*consumer)
+)
+
+// newLimiter returns a limiter of limit per second, with a burst of a
+// second. It returns nil if limit is 0.
+func newLimiter(limit int) *rate.Limiter {
+	if limit <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(limit), limit)
+}
+
+// ConsumerName returns the name identifying the consumer of a stream:
+// the effective caller if any, then the immediate caller, and defaultName
+// otherwise.
+func ConsumerName(ctx context.Context, defaultName string) string {
+	if ef := callerid.EffectiveCallerIDFromContext(ctx); ef != nil && ef.Principal != "" {
+		return ef.Principal
+	}
+	if im := callerid.ImmediateCallerIDFromContext(ctx); im != nil && im.Username != "" {
+		return im.Username
+	}
+	return defaultName
+}
+
+// Throttler throttles one stream of a consumer.
+type Throttler struct {
+	c *consumer
+}
+
+// Open returns the Throttler of a new stream of the consumer. It must
+// be closed at the end of the stream.
+func Open(name string) *Throttler {
+	mu.Lock()
+	defer mu.Unlock()
+	c, ok := consumers[name]
+	if !ok {
+		c = &consumer{
+			name:   name,
+			events: newLimiter(*maxEventsPerSecond),
+			bytes:  newLimiter(*maxBytesPerSecond),
+		}
+		consumers[name] = c
+	}
+	c.streams++
+	consumerStreams.Add(name, 1)
+	return &Throttler{c: c}
+}
+
+// Close releases the stream.
+func (t *Throttler) Close() {
+	mu.Lock()
+	defer mu.Unlock()
+	t.c.streams--
+	consumerStreams.Add(t.c.name, -1)
+	if t.c.streams == 0 {
+		delete(consumers, t.c.name)
+	}
+}
+
+// Wait blocks until the stream may send events totalling the bytes, and
+// accounts them to the consumer. It returns the error of the context if
+// it's done first.
+func (t *Throttler) Wait(ctx context.Context, events, bytes int) error {
+	if *maxReplicationLag > 0 && replicationLag.Get() > *maxReplicationLag {
+		start := time.Now()
+		for replicationLag.Get() > *maxReplicationLag {
+			if err := sleep(ctx, lagCheckInterval); err != nil {
+				return err
+			}
+		}
+		consumerThrottled.Record([]string{t.c.name, "ReplicationLag"}, start)
+	}
+
+	var delay time.Duration
+	now := time.Now()
+	if d := reserve(t.c.events, now, events); d > delay {
+		delay = d
+	}
+	if d := reserve(t.c.bytes, now, bytes); d > delay {
+		delay = d
+	}
+	if delay > 0 {
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+		consumerThrottled.Add([]string{t.c.name, "Rate"}, delay)
+	}
+
+	consumerEvents.Add(t.c.name, int64(events))
+	consumerBytes.Add(t.c.name, int64(bytes))
+	return nil
+}
+
+// reserve takes n tokens from the limiter, and returns how long to wait
+// before using them. A request bigger than the burst takes the whole
+// burst, so that big events still go through at the limited rate.
+func reserve(limiter *rate.Limiter, now time.Time, n int) time.Duration {
+	if limiter == nil || n <= 0 {
+		return 0
+	}
+	if n > limiter.Burst() {
+		n = limiter.Burst()
+	}
+	return limiter.ReserveN(now, n).DelayFrom(now)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
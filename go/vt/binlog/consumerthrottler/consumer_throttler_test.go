@@ -0,0 +1,157 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consumerthrottler
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/callerid"
+)
+
+func TestRateLimits(t *testing.T) {
+	defer func(events, bytes int) {
+		*maxEventsPerSecond = events
+		*maxBytesPerSecond = bytes
+	}(*maxEventsPerSecond, *maxBytesPerSecond)
+	*maxEventsPerSecond = 100
+	*maxBytesPerSecond = 0
+
+	ctx := context.Background()
+	t1 := Open("rate")
+	// The second stream shares the limits of the consumer.
+	t2 := Open("rate")
+	if got := consumerStreams.Counts()["rate"]; got != 2 {
+		t.Errorf("streams: %v, want 2", got)
+	}
+
+	// The burst goes through.
+	start := time.Now()
+	if err := t1.Wait(ctx, 100, 1000); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("burst waited %v", elapsed)
+	}
+	// The next events wait for the rate.
+	if err := t2.Wait(ctx, 20, 1000); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("throttled events waited %v, want about 200ms", elapsed)
+	}
+	if got := consumerEvents.Counts()["rate"]; got != 120 {
+		t.Errorf("events: %v, want 120", got)
+	}
+	if got := consumerBytes.Counts()["rate"]; got != 2000 {
+		t.Errorf("bytes: %v, want 2000", got)
+	}
+	if got := consumerThrottled.Counts()["rate.Rate"]; got != 1 {
+		t.Errorf("throttled: %v, want 1", got)
+	}
+
+	// The wait is interrupted by the context.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := t1.Wait(cancelCtx, 100, 0); err != context.Canceled {
+		t.Errorf("Wait with a canceled context: %v, want %v", err, context.Canceled)
+	}
+
+	t1.Close()
+	t2.Close()
+	if got := consumerStreams.Counts()["rate"]; got != 0 {
+		t.Errorf("streams: %v, want 0", got)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := consumers["rate"]; ok {
+		t.Errorf("consumer not released")
+	}
+}
+
+func TestBigEvent(t *testing.T) {
+	defer func(bytes int) { *maxBytesPerSecond = bytes }(*maxBytesPerSecond)
+	*maxBytesPerSecond = 1000
+
+	// An event bigger than the burst is not rejected.
+	th := Open("big")
+	defer th.Close()
+	if err := th.Wait(context.Background(), 1, 5000); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReplicationLag(t *testing.T) {
+	defer func(lag, interval time.Duration) {
+		*maxReplicationLag = lag
+		lagCheckInterval = interval
+		SetReplicationLag(0)
+	}(*maxReplicationLag, lagCheckInterval)
+	*maxReplicationLag = 10 * time.Second
+	lagCheckInterval = 5 * time.Millisecond
+
+	th := Open("lag")
+	defer th.Close()
+	SetReplicationLag(20 * time.Second)
+
+	done := make(chan error)
+	go func() {
+		done <- th.Wait(context.Background(), 1, 10)
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("Wait returned while lagging: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	SetReplicationLag(5 * time.Second)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Wait didn't return once the lag was low")
+	}
+	if got := consumerThrottled.Counts()["lag.ReplicationLag"]; got != 1 {
+		t.Errorf("throttled: %v, want 1", got)
+	}
+
+	// A paused consumer stops with its context.
+	SetReplicationLag(20 * time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := th.Wait(ctx, 1, 10); err != context.DeadlineExceeded {
+		t.Errorf("Wait: %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestConsumerName(t *testing.T) {
+	ctx := context.Background()
+	if got := ConsumerName(ctx, "VStream"); got != "VStream" {
+		t.Errorf("ConsumerName: %v, want VStream", got)
+	}
+	ctx = callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("user"))
+	if got := ConsumerName(ctx, "VStream"); got != "user" {
+		t.Errorf("ConsumerName: %v, want user", got)
+	}
+	ctx = callerid.NewContext(ctx, callerid.NewEffectiveCallerID("cdc", "", ""), callerid.NewImmediateCallerID("user"))
+	if got := ConsumerName(ctx, "VStream"); got != "cdc" {
+		t.Errorf("ConsumerName: %v, want cdc", got)
+	}
+}
@@ -20,12 +20,14 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/tb"
+	"vitess.io/vitess/go/vt/binlog/consumerthrottler"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
@@ -254,10 +256,22 @@ func (updateStream *UpdateStreamImpl) StreamKeyRange(ctx context.Context, positi
 	defer streamCount.Add("KeyRange", -1)
 	log.Infof("ServeUpdateStream starting @ %#v", pos)
 
+	streamCtx, cancel := context.WithCancel(ctx)
+	i := updateStream.streams.Add(cancel)
+	defer updateStream.streams.Delete(i)
+
+	// The throttler waits on the stream context, so that disabling the
+	// service interrupts the throttled streams.
+	throttler := consumerthrottler.Open(consumerthrottler.ConsumerName(ctx, "UpdateStreamKeyRange"))
+	defer throttler.Close()
+
 	// Calls cascade like this: binlog.Streamer->KeyRangeFilterFunc->func(*binlogdatapb.BinlogTransaction)->callback
 	f := KeyRangeFilterFunc(keyRange, func(trans *binlogdatapb.BinlogTransaction) error {
 		keyrangeStatements.Add(int64(len(trans.Statements)))
 		keyrangeTransactions.Add(1)
+		if err := throttler.Wait(streamCtx, len(trans.Statements), proto.Size(trans)); err != nil {
+			return err
+		}
 		return callback(trans)
 	})
 	bls := NewStreamer(updateStream.cp, updateStream.se, charset, pos, 0, f)
@@ -266,10 +280,6 @@ func (updateStream *UpdateStreamImpl) StreamKeyRange(ctx context.Context, positi
 		return fmt.Errorf("newKeyspaceIDResolverFactory failed: %v", err)
 	}
 
-	streamCtx, cancel := context.WithCancel(ctx)
-	i := updateStream.streams.Add(cancel)
-	defer updateStream.streams.Delete(i)
-
 	return bls.Stream(streamCtx)
 }
 
@@ -294,18 +304,26 @@ func (updateStream *UpdateStreamImpl) StreamTables(ctx context.Context, position
 	defer streamCount.Add("Tables", -1)
 	log.Infof("ServeUpdateStream starting @ %#v", pos)
 
+	streamCtx, cancel := context.WithCancel(ctx)
+	i := updateStream.streams.Add(cancel)
+	defer updateStream.streams.Delete(i)
+
+	// The throttler waits on the stream context, so that disabling the
+	// service interrupts the throttled streams.
+	throttler := consumerthrottler.Open(consumerthrottler.ConsumerName(ctx, "UpdateStreamTables"))
+	defer throttler.Close()
+
 	// Calls cascade like this: binlog.Streamer->TablesFilterFunc->func(*binlogdatapb.BinlogTransaction)->callback
 	f := TablesFilterFunc(tables, func(trans *binlogdatapb.BinlogTransaction) error {
 		tablesStatements.Add(int64(len(trans.Statements)))
 		tablesTransactions.Add(1)
+		if err := throttler.Wait(streamCtx, len(trans.Statements), proto.Size(trans)); err != nil {
+			return err
+		}
 		return callback(trans)
 	})
 	bls := NewStreamer(updateStream.cp, updateStream.se, charset, pos, 0, f)
 
-	streamCtx, cancel := context.WithCancel(ctx)
-	i := updateStream.streams.Add(cancel)
-	defer updateStream.streams.Delete(i)
-
 	return bls.Stream(streamCtx)
 }
 
@@ -221,6 +221,23 @@ func (fhc *FakeHealthCheck) AddTestTablet(cell, host string, port int32, keyspac
 	return conn.(*sandboxconn.SandboxConn)
 }
 
+// SetReplicationLag sets the replication lag of a fake tablet added by
+// AddFakeTablet. The Listener is called, as if the tablet had reported it.
+func (fhc *FakeHealthCheck) SetReplicationLag(tablet *topodatapb.Tablet, secondsBehindMaster uint32) {
+	fhc.mu.Lock()
+	defer fhc.mu.Unlock()
+	item := fhc.items[TabletToMapKey(tablet)]
+	if item == nil {
+		return
+	}
+	stats := *item.ts
+	stats.Stats = &querypb.RealtimeStats{SecondsBehindMaster: secondsBehindMaster}
+	item.ts = &stats
+	if fhc.listener != nil {
+		fhc.listener.StatsUpdate(item.ts)
+	}
+}
+
 // GetAllTablets returns all the tablets we have.
 func (fhc *FakeHealthCheck) GetAllTablets() map[string]*topodatapb.Tablet {
 	res := make(map[string]*topodatapb.Tablet)
@@ -220,11 +220,17 @@ func mean(tabletStatsList []*TabletStats, idxExclude int) (uint64, error) {
 // TrivialStatsUpdate returns true iff the old and new TabletStats
 // haven't changed enough to warrant re-calling FilterByReplicationLag.
 func TrivialStatsUpdate(o, n *TabletStats) bool {
+	return trivialLagUpdate(o.Stats.SecondsBehindMaster, n.Stats.SecondsBehindMaster)
+}
+
+// trivialLagUpdate returns true iff the replication lag hasn't changed
+// enough since oldLag to warrant re-calling FilterByReplicationLag.
+func trivialLagUpdate(oldLag, newLag uint32) bool {
 	// Skip replag filter when replag remains in the low rep lag range,
 	// which should be the case majority of the time.
 	lowRepLag := lowReplicationLag.Seconds()
-	oldRepLag := float64(o.Stats.SecondsBehindMaster)
-	newRepLag := float64(n.Stats.SecondsBehindMaster)
+	oldRepLag := float64(oldLag)
+	newRepLag := float64(newLag)
 	if oldRepLag <= lowRepLag && newRepLag <= lowRepLag {
 		return true
 	}
@@ -233,8 +239,8 @@ func TrivialStatsUpdate(o, n *TabletStats) bool {
 	// and did not change beyond +/- 10%.
 	// when there is a high rep lag, it takes a long time for it to reduce,
 	// so it is not necessary to re-calculate every time.
-	// In that case, we won't save the new record, so we still
-	// remember the original replication lag.
+	// The callers compare with the replication lag of the last
+	// calculation, so a lag that slowly grows is not missed.
 	if oldRepLag > lowRepLag && newRepLag > lowRepLag && newRepLag < oldRepLag*1.1 && newRepLag > oldRepLag*0.9 {
 		return true
 	}
@@ -39,8 +39,8 @@ import (
 // Note the healthy tablet computation is done when we receive a tablet
 // update only, not at serving time.
 // Also note the cache may not have the last entry received by the tablet.
-// For instance, if a tablet was healthy, and is still healthy, we do not
-// keep its new update.
+// For instance, if a tablet was healthy, and is still healthy, we only
+// keep the stats of its new update.
 type TabletStatsCache struct {
 	// cell is the cell we are keeping all tablets for.
 	// Note we keep track of all master tablets in all cells.
@@ -70,6 +70,10 @@ type tabletStatsCacheEntry struct {
 	all map[string]*TabletStats
 	// healthy only has the healthy ones.
 	healthy []*TabletStats
+	// filteredLag has the replication lag of the tablets of all
+	// when healthy was last computed. The updates are trivial
+	// compared to it, so a lag that slowly grows is not missed.
+	filteredLag map[string]uint32
 	// aggregates has the per-alias aggregates.
 	aggregates map[string]*querypb.AggregateStats
 }
@@ -188,8 +192,9 @@ func (tc *TabletStatsCache) getOrCreateEntry(target *querypb.Target) *tabletStat
 	e, ok := t[target.TabletType]
 	if !ok {
 		e = &tabletStatsCacheEntry{
-			all:        make(map[string]*TabletStats),
-			aggregates: make(map[string]*querypb.AggregateStats),
+			all:         make(map[string]*TabletStats),
+			filteredLag: make(map[string]uint32),
+			aggregates:  make(map[string]*querypb.AggregateStats),
 		}
 		t[target.TabletType] = e
 	}
@@ -229,17 +234,23 @@ func (tc *TabletStatsCache) StatsUpdate(ts *TabletStats) {
 		if ts.Up {
 			// We have an existing entry, and a new entry.
 			// Remember if they are both good (most common case).
-			trivialNonMasterUpdate = existing.LastError == nil && existing.Serving && ts.LastError == nil && ts.Serving && ts.Target.TabletType != topodatapb.TabletType_MASTER && TrivialStatsUpdate(existing, ts)
+			trivialNonMasterUpdate = existing.LastError == nil && existing.Serving && ts.LastError == nil && ts.Serving && ts.Target.TabletType != topodatapb.TabletType_MASTER && trivialLagUpdate(e.filteredLag[ts.Key], ts.Stats.GetSecondsBehindMaster())
 
 			// We already have the entry, update the
 			// values if necessary.  (will update both
 			// 'all' and 'healthy' as they use pointers).
 			if !trivialNonMasterUpdate {
 				*existing = *ts
+			} else {
+				// The healthy list doesn't change, but the lag
+				// is kept current, for the reads with a maximum
+				// staleness.
+				existing.Stats = ts.Stats
 			}
 		} else {
 			// We have an entry which we shouldn't. Remove it.
 			delete(e.all, ts.Key)
+			delete(e.filteredLag, ts.Key)
 		}
 	} else {
 		if ts.Up {
@@ -271,8 +282,9 @@ func (tc *TabletStatsCache) StatsUpdate(ts *TabletStats) {
 
 		// Now we need to do some work. Recompute our healthy list.
 		allArray = make([]*TabletStats, 0, len(e.all))
-		for _, s := range e.all {
+		for key, s := range e.all {
 			allArray = append(allArray, s)
+			e.filteredLag[key] = s.Stats.GetSecondsBehindMaster()
 		}
 		e.healthy = FilterByReplicationLag(allArray)
 	}
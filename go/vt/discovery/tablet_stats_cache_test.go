@@ -96,7 +96,8 @@ func TestTabletStatsCache(t *testing.T) {
 	}
 	tsc.StatsUpdate(stillHealthyTs1)
 
-	// check the previous ts1 is still there, as the new one is ignored.
+	// check the previous ts1 is still there, as the new one is ignored,
+	// except for its stats.
 	a = tsc.GetTabletStats("k", "s", topodatapb.TabletType_REPLICA)
 	if len(a) != 1 || !ts1.DeepEqual(&a[0]) {
 		t.Errorf("unexpected result: %v", a)
@@ -105,6 +106,9 @@ func TestTabletStatsCache(t *testing.T) {
 	if len(a) != 1 || !ts1.DeepEqual(&a[0]) {
 		t.Errorf("unexpected result: %v", a)
 	}
+	if got := a[0].Stats.SecondsBehindMaster; got != 2 {
+		t.Errorf("replication lag: %v, want 2", got)
+	}
 
 	// update stats with a change that will change arrays
 	notHealthyTs1 := &TabletStats{
@@ -272,3 +276,49 @@ func TestTabletStatsCache(t *testing.T) {
 		t.Errorf("unexpected result: %v", a)
 	}
 }
+
+// TestTabletStatsCacheLag tests the replication lag of the tablets is
+// always current, and the healthy list follows a lag that slowly grows.
+func TestTabletStatsCacheLag(t *testing.T) {
+	tsc := &TabletStatsCache{
+		cell:        "cell",
+		ts:          memorytopo.NewServer("cell"),
+		entries:     make(map[string]map[string]map[topodatapb.TabletType]*tabletStatsCacheEntry),
+		cellAliases: make(map[string]string),
+	}
+	tablet := topo.NewTablet(10, "cell", "host1")
+	update := func(lag uint32) {
+		tsc.StatsUpdate(&TabletStats{
+			Key:     "t1",
+			Tablet:  tablet,
+			Target:  &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA},
+			Up:      true,
+			Serving: true,
+			Stats:   &querypb.RealtimeStats{SecondsBehindMaster: lag},
+		})
+	}
+	target := &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA}
+
+	// Each update is within 10% of the previous one, but the lag of the
+	// healthy list is the one of the last computation.
+	testcases := []struct {
+		lag         uint32
+		filteredLag uint32
+	}{
+		{60, 60},
+		{65, 60},
+		{70, 70},
+		{1, 1},
+		{2, 1},
+	}
+	for _, tcase := range testcases {
+		update(tcase.lag)
+		a := tsc.GetTabletStats("k", "s", topodatapb.TabletType_REPLICA)
+		if len(a) != 1 || a[0].Stats.SecondsBehindMaster != tcase.lag {
+			t.Errorf("after an update with a lag of %v: %v", tcase.lag, a)
+		}
+		if got := tsc.getOrCreateEntry(target).filteredLag["t1"]; got != tcase.filteredLag {
+			t.Errorf("after an update with a lag of %v: filteredLag %v, want %v", tcase.lag, got, tcase.filteredLag)
+		}
+	}
+}
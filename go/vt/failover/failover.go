@@ -0,0 +1,398 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package failover watches the masters of the shards, and reparents a
+// shard when its master is dead.
+//
+// A master is considered dead when its health check has been failing for
+// a while, and enough replicas confirm they lost their replication
+// connection to it. The new master is the freshest healthy replica, in
+// the preferred cells first. Every recovery is recorded in the global
+// topology, and the recoveries of a shard are spaced by a cooldown.
+//
+// The orchestrator doesn't coordinate with other orchestrators: a shard
+// must be watched by a single one.
+package failover
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	// operationTimeout is the timeout of the topology reads and of the
+	// replication status calls.
+	operationTimeout = 30 * time.Second
+
+	// DefaultQuorum is the quorum used when Policy.Quorum is not set.
+	// A single replica can lose its replication connection for its own
+	// reasons, like a network partition with the master.
+	DefaultQuorum = 2
+)
+
+var (
+	recoveries        = stats.NewCountersWithMultiLabels("FailoverRecoveries", "Recoveries run by the failover orchestrator", []string{"Keyspace", "ShardName", "Result"})
+	skippedRecoveries = stats.NewCountersWithMultiLabels("FailoverSkippedRecoveries", "Recoveries of dead masters skipped by the failover orchestrator", []string{"Keyspace", "ShardName", "Reason"})
+)
+
+// Policy configures when and how the shards are recovered.
+type Policy struct {
+	// FailureDuration is how long the health check of a master must
+	// fail before the master is considered dead.
+	FailureDuration time.Duration
+	// Quorum is the number of replicas that must confirm the master
+	// failure, by reporting their replication IO thread as stopped.
+	// DefaultQuorum is used if it's not positive.
+	Quorum int
+	// Cooldown is the minimum time between two recoveries of a shard.
+	Cooldown time.Duration
+	// PreferredCells are the cells where the new master is picked
+	// first, in this order.
+	PreferredCells []string
+	// WaitReplicasTimeout is how long the reparent waits for the
+	// replicas to catch up.
+	WaitReplicasTimeout time.Duration
+}
+
+// Reparenter runs the emergency reparents. It is implemented by
+// wrangler.Wrangler.
+type Reparenter interface {
+	EmergencyReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, force bool, waitReplicasTimeout time.Duration) error
+}
+
+// Orchestrator watches the masters and recovers the shards.
+// It is a discovery.HealthCheckStatsListener.
+type Orchestrator struct {
+	ts         *topo.Server
+	tmc        tmclient.TabletManagerClient
+	reparenter Reparenter
+	policy     Policy
+	// keyspaces are the watched keyspaces, all of them if empty.
+	keyspaces []string
+
+	mu sync.Mutex
+	// tablets are the last health stats, by discovery key.
+	tablets map[string]*discovery.TabletStats
+	// failing is when the master of a shard was first seen failing,
+	// by keyspace/shard.
+	failing map[string]time.Time
+}
+
+// NewOrchestrator returns an Orchestrator. It must be set as the
+// listener of a health check watching all the tablets.
+func NewOrchestrator(ts *topo.Server, tmc tmclient.TabletManagerClient, reparenter Reparenter, policy Policy, keyspaces []string) *Orchestrator {
+	if policy.Quorum <= 0 {
+		policy.Quorum = DefaultQuorum
+	}
+	return &Orchestrator{
+		ts:         ts,
+		tmc:        tmc,
+		reparenter: reparenter,
+		policy:     policy,
+		keyspaces:  keyspaces,
+		tablets:    make(map[string]*discovery.TabletStats),
+		failing:    make(map[string]time.Time),
+	}
+}
+
+// StatsUpdate is part of the discovery.HealthCheckStatsListener interface.
+func (o *Orchestrator) StatsUpdate(ts *discovery.TabletStats) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if !ts.Up {
+		delete(o.tablets, ts.Key)
+		return
+	}
+	o.tablets[ts.Key] = ts.Copy()
+}
+
+// Run checks the shards every interval, until the context is done.
+func (o *Orchestrator) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := o.CheckShards(ctx); err != nil {
+			log.Warningf("Cannot check the shards: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckShards checks the master of every watched shard, and recovers
+// the shards whose master is dead.
+func (o *Orchestrator) CheckShards(ctx context.Context) error {
+	keyspaces := o.keyspaces
+	if len(keyspaces) == 0 {
+		readCtx, cancel := context.WithTimeout(ctx, operationTimeout)
+		var err error
+		keyspaces, err = o.ts.GetKeyspaces(readCtx)
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	rec := concurrency.AllErrorRecorder{}
+	for _, keyspace := range keyspaces {
+		readCtx, cancel := context.WithTimeout(ctx, operationTimeout)
+		shards, err := o.ts.FindAllShardsInKeyspace(readCtx, keyspace)
+		cancel()
+		if err != nil {
+			rec.RecordError(err)
+			continue
+		}
+		for _, si := range shards {
+			rec.RecordError(o.checkShard(ctx, si))
+		}
+	}
+	return rec.Error()
+}
+
+// checkShard recovers the shard if its master is dead.
+func (o *Orchestrator) checkShard(ctx context.Context, si *topo.ShardInfo) error {
+	if si.MasterAlias == nil {
+		return nil
+	}
+	key := si.Keyspace() + "/" + si.ShardName()
+	master := o.tabletStats(si.MasterAlias)
+	if master == nil {
+		// The master is not discovered yet, we know nothing about it.
+		return nil
+	}
+	reason := healthError(master)
+	o.mu.Lock()
+	if reason == "" {
+		delete(o.failing, key)
+		o.mu.Unlock()
+		return nil
+	}
+	since, ok := o.failing[key]
+	if !ok {
+		since = time.Now()
+		o.failing[key] = since
+	}
+	o.mu.Unlock()
+	if time.Since(since) < o.policy.FailureDuration {
+		return nil
+	}
+	return o.recoverShard(ctx, si, reason)
+}
+
+// recoverShard checks the quorum and the cooldown, then reparents the
+// shard to the best replica.
+func (o *Orchestrator) recoverShard(ctx context.Context, si *topo.ShardInfo, reason string) error {
+	keyspace, shard := si.Keyspace(), si.ShardName()
+	statsKey := []string{keyspace, shard}
+	masterAlias := topoproto.TabletAliasString(si.MasterAlias)
+
+	observers, err := o.confirmFailure(ctx, si)
+	if err != nil {
+		return err
+	}
+	if len(observers) < o.policy.Quorum {
+		log.Infof("Master %v of %v/%v is failing (%v), but only %v replicas confirmed it, %v are needed", masterAlias, keyspace, shard, reason, len(observers), o.policy.Quorum)
+		skippedRecoveries.Add(append(statsKey, "NoQuorum"), 1)
+		return nil
+	}
+
+	readCtx, cancel := context.WithTimeout(ctx, operationTimeout)
+	last, err := ListRecoveries(readCtx, o.ts, keyspace, shard, 1)
+	cancel()
+	if err != nil {
+		return err
+	}
+	if len(last) > 0 && time.Since(last[0].StartTime) < o.policy.Cooldown {
+		log.Warningf("Master %v of %v/%v is dead (%v), but the last recovery was at %v, less than %v ago", masterAlias, keyspace, shard, reason, last[0].StartTime, o.policy.Cooldown)
+		skippedRecoveries.Add(append(statsKey, "Cooldown"), 1)
+		return nil
+	}
+
+	candidate := o.pickCandidate(keyspace, shard)
+	if candidate == nil {
+		log.Warningf("Master %v of %v/%v is dead (%v), but there is no healthy replica to promote", masterAlias, keyspace, shard, reason)
+		skippedRecoveries.Add(append(statsKey, "NoCandidate"), 1)
+		return nil
+	}
+
+	r := &Recovery{
+		Keyspace:     keyspace,
+		Shard:        shard,
+		StartTime:    time.Now(),
+		FailedMaster: masterAlias,
+		NewMaster:    topoproto.TabletAliasString(candidate),
+		Reason:       reason,
+		Observers:    observers,
+	}
+	// The recovery is recorded before it starts, so that the
+	// cooldown applies even if we crash while reparenting.
+	if err := writeRecovery(ctx, o.ts, r); err != nil {
+		return fmt.Errorf("cannot record the recovery of %v/%v: %v", keyspace, shard, err)
+	}
+	log.Infof("Master %v of %v/%v is dead (%v), confirmed by %v: reparenting to %v", masterAlias, keyspace, shard, reason, observers, r.NewMaster)
+	err = o.reparenter.EmergencyReparentShard(ctx, keyspace, shard, candidate, false /* force */, o.policy.WaitReplicasTimeout)
+	r.EndTime = time.Now()
+	result := "Success"
+	if err != nil {
+		r.Error = err.Error()
+		result = "Failure"
+		log.Errorf("Recovery of %v/%v failed: %v", keyspace, shard, err)
+	}
+	recoveries.Add(append(statsKey, result), 1)
+
+	o.mu.Lock()
+	delete(o.failing, keyspace+"/"+shard)
+	o.mu.Unlock()
+	if werr := writeRecovery(ctx, o.ts, r); werr != nil {
+		log.Errorf("Cannot record the end of the recovery of %v/%v: %v", keyspace, shard, werr)
+	}
+	return err
+}
+
+// confirmFailure asks the replicas of the shard if they can still
+// replicate from the master, and returns the ones which can't. Only
+// the REPLICA and RDONLY tablets whose health check works are asked:
+// the tablets taking a backup, restoring or drained may have stopped
+// replicating on purpose. Their health check is expected to report the
+// replication error, so it's not required to be empty.
+func (o *Orchestrator) confirmFailure(ctx context.Context, si *topo.ShardInfo) ([]string, error) {
+	readCtx, cancel := context.WithTimeout(ctx, operationTimeout)
+	defer cancel()
+	tablets, err := o.ts.GetTabletMapForShard(readCtx, si.Keyspace(), si.ShardName())
+	if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+		return nil, err
+	}
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		observers []string
+	)
+	for alias, ti := range tablets {
+		if topoproto.TabletAliasEqual(ti.Alias, si.MasterAlias) || !o.canObserve(ti.Tablet) {
+			continue
+		}
+		wg.Add(1)
+		go func(alias string, tablet *topodatapb.Tablet) {
+			defer wg.Done()
+			status, err := o.tmc.SlaveStatus(readCtx, tablet)
+			if err != nil || status.SlaveIoRunning {
+				// A replica we can't reach doesn't count,
+				// in either direction.
+				return
+			}
+			mu.Lock()
+			observers = append(observers, alias)
+			mu.Unlock()
+		}(alias, ti.Tablet)
+	}
+	wg.Wait()
+	sort.Strings(observers)
+	return observers, nil
+}
+
+// canObserve returns true if the tablet can confirm a master failure:
+// it's a REPLICA or an RDONLY, both in the topology and in its health
+// stream, and its health stream works.
+func (o *Orchestrator) canObserve(tablet *topodatapb.Tablet) bool {
+	if tablet.Type != topodatapb.TabletType_REPLICA && tablet.Type != topodatapb.TabletType_RDONLY {
+		return false
+	}
+	ts := o.tabletStats(tablet.Alias)
+	return ts != nil && ts.LastError == nil && ts.Target.TabletType == tablet.Type
+}
+
+// pickCandidate returns the healthy REPLICA of the shard to promote:
+// the one in the first preferred cell, with the lowest replication lag.
+func (o *Orchestrator) pickCandidate(keyspace, shard string) *topodatapb.TabletAlias {
+	cellRank := func(cell string) int {
+		for i, c := range o.policy.PreferredCells {
+			if c == cell {
+				return i
+			}
+		}
+		return len(o.policy.PreferredCells)
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var candidates []*discovery.TabletStats
+	for _, ts := range o.tablets {
+		if ts.Target.Keyspace != keyspace || ts.Target.Shard != shard || ts.Target.TabletType != topodatapb.TabletType_REPLICA {
+			continue
+		}
+		if !ts.Serving || healthError(ts) != "" {
+			continue
+		}
+		candidates = append(candidates, ts)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		ri, rj := cellRank(candidates[i].Tablet.Alias.Cell), cellRank(candidates[j].Tablet.Alias.Cell)
+		if ri != rj {
+			return ri < rj
+		}
+		li, lj := candidates[i].Stats.GetSecondsBehindMaster(), candidates[j].Stats.GetSecondsBehindMaster()
+		if li != lj {
+			return li < lj
+		}
+		// Keep the choice deterministic.
+		return topoproto.TabletAliasString(candidates[i].Tablet.Alias) < topoproto.TabletAliasString(candidates[j].Tablet.Alias)
+	})
+	return candidates[0].Tablet.Alias
+}
+
+// tabletStats returns the health stats of a tablet, or nil.
+func (o *Orchestrator) tabletStats(alias *topodatapb.TabletAlias) *discovery.TabletStats {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, ts := range o.tablets {
+		if topoproto.TabletAliasEqual(ts.Tablet.Alias, alias) {
+			return ts
+		}
+	}
+	return nil
+}
+
+// healthError returns why a tablet is unhealthy, or "" if it's healthy.
+// A master which is not serving is not unhealthy: it's usually on
+// purpose, during a migration.
+func healthError(ts *discovery.TabletStats) string {
+	switch {
+	case ts.LastError != nil:
+		return ts.LastError.Error()
+	case ts.Stats != nil && ts.Stats.HealthError != "":
+		return ts.Stats.HealthError
+	}
+	return ""
+}
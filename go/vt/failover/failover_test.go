@@ -0,0 +1,255 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failover
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeTMClient returns the replication IO thread state of the replicas.
+type fakeTMClient struct {
+	tmclient.TabletManagerClient
+
+	mu        sync.Mutex
+	ioRunning map[string]bool
+}
+
+func (c *fakeTMClient) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	running, ok := c.ioRunning[topoproto.TabletAliasString(tablet.Alias)]
+	if !ok {
+		return nil, errors.New("unreachable")
+	}
+	return &replicationdatapb.Status{SlaveIoRunning: running}, nil
+}
+
+func (c *fakeTMClient) setIORunning(alias string, running bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ioRunning[alias] = running
+}
+
+// fakeReparenter records the reparents.
+type fakeReparenter struct {
+	reparents []string
+	err       error
+}
+
+func (r *fakeReparenter) EmergencyReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, force bool, waitReplicasTimeout time.Duration) error {
+	r.reparents = append(r.reparents, keyspace+"/"+shard+":"+topoproto.TabletAliasString(masterElectTabletAlias))
+	return r.err
+}
+
+func newTestTablet(t *testing.T, ts *topo.Server, cell string, uid uint32, tabletType topodatapb.TabletType) *topodatapb.Tablet {
+	tablet := topo.NewTablet(uid, cell, "host")
+	tablet.Keyspace = "ks"
+	tablet.Shard = "0"
+	tablet.Type = tabletType
+	if err := ts.CreateTablet(context.Background(), tablet); err != nil {
+		t.Fatal(err)
+	}
+	return tablet
+}
+
+func tabletStats(tablet *topodatapb.Tablet, lag uint32, healthErr error) *discovery.TabletStats {
+	return &discovery.TabletStats{
+		Key:       topoproto.TabletAliasString(tablet.Alias),
+		Tablet:    tablet,
+		Target:    &querypb.Target{Keyspace: tablet.Keyspace, Shard: tablet.Shard, TabletType: tablet.Type},
+		Up:        true,
+		Serving:   healthErr == nil,
+		Stats:     &querypb.RealtimeStats{SecondsBehindMaster: lag},
+		LastError: healthErr,
+	}
+}
+
+func TestOrchestrator(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatal(err)
+	}
+	master := newTestTablet(t, ts, "cell1", 100, topodatapb.TabletType_MASTER)
+	replica1 := newTestTablet(t, ts, "cell1", 101, topodatapb.TabletType_REPLICA)
+	replica2 := newTestTablet(t, ts, "cell2", 102, topodatapb.TabletType_REPLICA)
+	rdonly := newTestTablet(t, ts, "cell2", 103, topodatapb.TabletType_RDONLY)
+	backup := newTestTablet(t, ts, "cell1", 104, topodatapb.TabletType_BACKUP)
+	newTestTablet(t, ts, "cell1", 105, topodatapb.TabletType_REPLICA)
+	if _, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = master.Alias
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tmc := &fakeTMClient{
+		ioRunning: map[string]bool{
+			"cell1-0000000101": true,
+			"cell2-0000000102": true,
+			// The backup and the replica which is not in the health
+			// check lost their replication connection, but they
+			// don't count.
+			"cell1-0000000104": false,
+			"cell1-0000000105": false,
+		},
+	}
+	reparenter := &fakeReparenter{}
+	o := NewOrchestrator(ts, tmc, reparenter, Policy{
+		Quorum:         2,
+		Cooldown:       time.Hour,
+		PreferredCells: []string{"cell2"},
+	}, nil)
+	o.StatsUpdate(tabletStats(master, 0, nil))
+	o.StatsUpdate(tabletStats(replica1, 0, nil))
+	o.StatsUpdate(tabletStats(replica2, 5, nil))
+	o.StatsUpdate(tabletStats(rdonly, 0, nil))
+	o.StatsUpdate(tabletStats(backup, 0, nil))
+
+	// A healthy master is left alone.
+	if err := o.CheckShards(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(reparenter.reparents) != 0 {
+		t.Fatalf("reparents: %v, want none", reparenter.reparents)
+	}
+
+	// The master fails, but the replicas still replicate from it.
+	o.StatsUpdate(tabletStats(master, 0, errors.New("health stream failed")))
+	if err := o.CheckShards(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(reparenter.reparents) != 0 {
+		t.Fatalf("reparents without quorum: %v, want none", reparenter.reparents)
+	}
+
+	// One confirmation is not enough, the rdonly is unreachable.
+	tmc.setIORunning("cell1-0000000101", false)
+	if err := o.CheckShards(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(reparenter.reparents) != 0 {
+		t.Fatalf("reparents without quorum: %v, want none", reparenter.reparents)
+	}
+
+	// With the quorum, the replica of the preferred cell is promoted
+	// even though it's lagging.
+	tmc.setIORunning("cell2-0000000102", false)
+	if err := o.CheckShards(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"ks/0:cell2-0000000102"}; !reflect.DeepEqual(reparenter.reparents, want) {
+		t.Fatalf("reparents: %v, want %v", reparenter.reparents, want)
+	}
+	recoveries, err := ListRecoveries(ctx, ts, "ks", "0", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recoveries) != 1 {
+		t.Fatalf("recoveries: %v, want 1", recoveries)
+	}
+	r := recoveries[0]
+	if r.FailedMaster != "cell1-0000000100" || r.NewMaster != "cell2-0000000102" || r.Reason != "health stream failed" || r.EndTime.IsZero() || r.Error != "" {
+		t.Errorf("recovery: %+v", r)
+	}
+	if want := []string{"cell1-0000000101", "cell2-0000000102"}; !reflect.DeepEqual(r.Observers, want) {
+		t.Errorf("observers: %v, want %v", r.Observers, want)
+	}
+
+	// The cooldown prevents another recovery.
+	if err := o.CheckShards(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(reparenter.reparents) != 1 {
+		t.Fatalf("reparents during the cooldown: %v, want 1", reparenter.reparents)
+	}
+}
+
+func TestOrchestratorDefaultQuorum(t *testing.T) {
+	o := NewOrchestrator(nil, nil, nil, Policy{}, nil)
+	if o.policy.Quorum != DefaultQuorum {
+		t.Errorf("quorum: %v, want %v", o.policy.Quorum, DefaultQuorum)
+	}
+}
+
+func TestOrchestratorFailureDuration(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatal(err)
+	}
+	master := newTestTablet(t, ts, "cell1", 100, topodatapb.TabletType_MASTER)
+	replica := newTestTablet(t, ts, "cell1", 101, topodatapb.TabletType_REPLICA)
+	if _, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = master.Alias
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	tmc := &fakeTMClient{
+		ioRunning: map[string]bool{"cell1-0000000101": false},
+	}
+	reparenter := &fakeReparenter{err: errors.New("reparent failed")}
+	o := NewOrchestrator(ts, tmc, reparenter, Policy{
+		FailureDuration: 100 * time.Millisecond,
+		Quorum:          1,
+	}, []string{"ks"})
+
+	o.StatsUpdate(tabletStats(master, 0, errors.New("mysql is down")))
+	o.StatsUpdate(tabletStats(replica, 0, nil))
+
+	// The master must fail for FailureDuration first.
+	if err := o.CheckShards(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(reparenter.reparents) != 0 {
+		t.Fatalf("reparents: %v, want none", reparenter.reparents)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if err := o.CheckShards(ctx); err == nil || err.Error() != "reparent failed" {
+		t.Fatalf("CheckShards(): %v, want reparent failed", err)
+	}
+	recoveries, err := ListRecoveries(ctx, ts, "ks", "0", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recoveries) != 1 || recoveries[0].Error != "reparent failed" {
+		t.Errorf("recoveries: %+v, want one failed recovery", recoveries)
+	}
+}
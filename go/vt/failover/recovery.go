@@ -0,0 +1,105 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package failover
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// RecoveriesPath is the directory of the global topology where the
+// recoveries are recorded, as recoveries/<keyspace>/<shard>/<time>.
+const RecoveriesPath = "recoveries"
+
+// Recovery is the audit record of a recovery, stored as JSON in the
+// global topology.
+type Recovery struct {
+	Keyspace string
+	Shard    string
+	// StartTime is when the recovery was decided, and EndTime when
+	// the reparent returned. EndTime is zero while it's running.
+	StartTime time.Time
+	EndTime   time.Time `json:",omitempty"`
+	// FailedMaster and NewMaster are tablet aliases.
+	FailedMaster string
+	NewMaster    string
+	// Reason is the health error of the failed master.
+	Reason string
+	// Observers lists the replicas that confirmed the master failure.
+	Observers []string
+	// Error is the reparent error, if it failed.
+	Error string `json:",omitempty"`
+}
+
+// recoveryPath returns the topology path of a recovery. The file names
+// sort in chronological order.
+func recoveryPath(keyspace, shard string, startTime time.Time) string {
+	return path.Join(RecoveriesPath, keyspace, shard, fmt.Sprintf("%020d", startTime.UnixNano()))
+}
+
+// writeRecovery creates or updates the record of a recovery.
+func writeRecovery(ctx context.Context, ts *topo.Server, r *Recovery) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Update(ctx, recoveryPath(r.Keyspace, r.Shard, r.StartTime), data, nil)
+	return err
+}
+
+// ListRecoveries returns the recoveries of a shard, the most recent
+// first. At most limit recoveries are returned if limit is positive.
+func ListRecoveries(ctx context.Context, ts *topo.Server, keyspace, shard string, limit int) ([]*Recovery, error) {
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return nil, err
+	}
+	dir := path.Join(RecoveriesPath, keyspace, shard)
+	entries, err := conn.ListDir(ctx, dir, false /* full */)
+	if topo.IsErrType(err, topo.NoNode) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var recoveries []*Recovery
+	for i := len(entries) - 1; i >= 0; i-- {
+		if limit > 0 && len(recoveries) == limit {
+			break
+		}
+		data, _, err := conn.Get(ctx, path.Join(dir, entries[i].Name))
+		if err != nil {
+			return nil, err
+		}
+		r := &Recovery{}
+		if err := json.Unmarshal(data, r); err != nil {
+			return nil, fmt.Errorf("bad recovery record %v: %v", entries[i].Name, err)
+		}
+		recoveries = append(recoveries, r)
+	}
+	return recoveries, nil
+}
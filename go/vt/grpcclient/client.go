@@ -20,9 +20,12 @@ package grpcclient
 
 import (
 	"flag"
+	"net"
+	"strings"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
@@ -110,7 +113,9 @@ func interceptors() []grpc.DialOption {
 
 // SecureDialOption returns the gRPC dial option to use for the
 // given client connection. It is either using TLS, or Insecure if
-// nothing is set.
+// nothing is set. name can be a SPIFFE ID or trust domain, to verify
+// the server by its SPIFFE ID instead of its host name. The server is
+// verified against the current ca, even after it's reloaded.
 func SecureDialOption(cert, key, ca, name string) (grpc.DialOption, error) {
 	// No security options set, just return.
 	if (cert == "" || key == "") && ca == "" {
@@ -125,9 +130,51 @@ func SecureDialOption(cert, key, ca, name string) (grpc.DialOption, error) {
 
 	// Create the creds server options.
 	creds := credentials.NewTLS(config)
+	if name == "" && ca != "" {
+		creds = &hostCredentials{TransportCredentials: creds, cert: cert, key: key, ca: ca}
+	}
 	return grpc.WithTransportCredentials(creds), nil
 }
 
+// hostCredentials are TLS credentials that create the config of each
+// handshake for the host it connects to, so that the host name is
+// verified against the current ca (see vttls.ClientConfig).
+type hostCredentials struct {
+	credentials.TransportCredentials
+	cert, key, ca string
+	// serverName replaces the host if set.
+	serverName string
+}
+
+// ClientHandshake is part of the credentials.TransportCredentials interface.
+func (c *hostCredentials) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	host := c.serverName
+	if host == "" {
+		host = authority
+		if i := strings.LastIndex(authority, ":"); i != -1 {
+			host = authority[:i]
+		}
+	}
+	config, err := vttls.ClientConfig(c.cert, c.key, c.ca, host)
+	if err != nil {
+		return nil, nil, err
+	}
+	return credentials.NewTLS(config).ClientHandshake(ctx, authority, rawConn)
+}
+
+// Clone is part of the credentials.TransportCredentials interface.
+func (c *hostCredentials) Clone() credentials.TransportCredentials {
+	clone := *c
+	clone.TransportCredentials = c.TransportCredentials.Clone()
+	return &clone
+}
+
+// OverrideServerName is part of the credentials.TransportCredentials interface.
+func (c *hostCredentials) OverrideServerName(serverName string) error {
+	c.serverName = serverName
+	return c.TransportCredentials.OverrideServerName(serverName)
+}
+
 // Allows for building a chain of interceptors without knowing the total size up front
 type clientInterceptorBuilder struct {
 	unaryInterceptors  []grpc.UnaryClientInterceptor
@@ -23,6 +23,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -102,15 +103,25 @@ func Backup(ctx context.Context, params BackupParams) error {
 		return vterrors.Wrap(err, "unable to get backup storage")
 	}
 	defer bs.Close()
-	bh, err := bs.StartBackup(ctx, backupDir, name)
-	if err != nil {
-		return vterrors.Wrap(err, "StartBackup failed")
-	}
 
 	be, err := GetBackupEngine()
 	if err != nil {
 		return vterrors.Wrap(err, "failed to find backup engine")
 	}
+	fromPosition, err := incrementalBackupPosition(ctx, bs, backupDir)
+	if err != nil {
+		return vterrors.Wrap(err, "can't check for incremental backup")
+	}
+	if !fromPosition.IsZero() {
+		params.Logger.Infof("taking an incremental backup from position %v", fromPosition)
+		params.IncrementalFromPosition = fromPosition
+		be = BackupRestoreEngineMap[binlogBackupEngineName]
+	}
+
+	bh, err := bs.StartBackup(ctx, backupDir, name)
+	if err != nil {
+		return vterrors.Wrap(err, "StartBackup failed")
+	}
 
 	// Take the backup, and either AbortBackup or EndBackup.
 	usable, err := be.ExecuteBackup(ctx, params, bh)
@@ -136,6 +147,48 @@ func Backup(ctx context.Context, params BackupParams) error {
 	return finishErr
 }
 
+// incrementalBackupPosition returns the position a new backup should be
+// taken from incrementally, or a zero Position if a full backup is
+// required: incremental backups are disabled, there is no full backup
+// yet, or the chain of incremental backups is already at its maximum length.
+func incrementalBackupPosition(ctx context.Context, bs backupstorage.BackupStorage, backupDir string) (mysql.Position, error) {
+	if *backupIncrementalMaxChain <= 0 {
+		return mysql.Position{}, nil
+	}
+	bhs, err := bs.ListBackups(ctx, backupDir)
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "ListBackups failed")
+	}
+
+	// Walk back to the most recent full backup.
+	var base *BackupManifest
+	var manifests []*BackupManifest
+	for i := len(bhs) - 1; i >= 0; i-- {
+		bm, err := GetBackupManifest(ctx, bhs[i])
+		if err != nil {
+			// Incomplete backup.
+			continue
+		}
+		if !bm.Incremental {
+			base = bm
+			break
+		}
+		manifests = append([]*BackupManifest{bm}, manifests...)
+	}
+	if base == nil {
+		return mysql.Position{}, nil
+	}
+
+	chain := incrementalChain(base, manifests, time.Time{})
+	if len(chain) >= *backupIncrementalMaxChain {
+		return mysql.Position{}, nil
+	}
+	if len(chain) == 0 {
+		return base.Position, nil
+	}
+	return manifests[chain[len(chain)-1]].Position, nil
+}
+
 // checkNoDB makes sure there is no user data already there.
 // Used by Restore, as we do not want to destroy an existing DB.
 // The user's database name must be given since we ignore all others.
@@ -283,11 +336,29 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, ErrNoBackup
 	}
 
+	// The backups of a keyspace backup are taken after its
+	// BackupTime, so they are looked up by name.
+	if !params.StartTime.IsZero() {
+		kbh, err := keyspaceBackupToRestore(ctx, bs, params, bhs)
+		if err != nil {
+			return nil, err
+		}
+		if kbh != nil {
+			bhs = []backupstorage.BackupHandle{kbh}
+			params.StartTime = time.Time{}
+		}
+	}
+
 	bh, err := FindBackupToRestore(ctx, params, bhs)
 	if err != nil {
 		return nil, err
 	}
 
+	incrementals, err := FindIncrementalBackupsToRestore(ctx, params, bhs, bh)
+	if err != nil {
+		return nil, err
+	}
+
 	re, err := GetRestoreEngine(ctx, bh)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "Failed to find restore engine")
@@ -317,6 +388,12 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, vterrors.Wrap(err, "mysql_upgrade failed")
 	}
 
+	if len(incrementals) > 0 {
+		if manifest, err = restoreIncrementals(ctx, params, incrementals); err != nil {
+			return nil, err
+		}
+	}
+
 	// Add backupTime and restorePosition to LocalMetadata
 	params.LocalMetadata["RestoredBackupTime"] = manifest.BackupTime
 	params.LocalMetadata["RestorePosition"] = mysql.EncodePosition(manifest.Position)
@@ -347,3 +424,30 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 
 	return manifest, nil
 }
+
+// restoreIncrementals applies a chain of incremental backups on top of
+// a restored full backup. mysqld must be running. It returns the
+// manifest of the last incremental backup, with the position mysqld
+// actually reached.
+func restoreIncrementals(ctx context.Context, params RestoreParams, incrementals []backupstorage.BackupHandle) (*BackupManifest, error) {
+	var manifest *BackupManifest
+	for _, bh := range incrementals {
+		params.Logger.Infof("Restore: applying incremental backup %v", bh.Name())
+		re, err := GetRestoreEngine(ctx, bh)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "Failed to find restore engine")
+		}
+		if manifest, err = re.ExecuteRestore(ctx, params, bh); err != nil {
+			return nil, err
+		}
+	}
+
+	// Binlogs may contain transactions past the position recorded
+	// in the manifest, so use what mysqld has executed.
+	pos, err := params.Mysqld.MasterPosition()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't get position after applying incremental backups")
+	}
+	manifest.Position = pos
+	return manifest, nil
+}
@@ -23,6 +23,9 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
 )
 
 func TestFindFilesToBackup(t *testing.T) {
@@ -122,3 +125,83 @@ type forTest []FileEntry
 func (f forTest) Len() int           { return len(f) }
 func (f forTest) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
 func (f forTest) Less(i, j int) bool { return f[i].Base+f[i].Name < f[j].Base+f[j].Name }
+
+func TestIncrementalChain(t *testing.T) {
+	pos := func(s string) mysql.Position {
+		return mysql.MustParsePosition("MySQL56", s)
+	}
+	base := &BackupManifest{Position: pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10")}
+	manifests := []*BackupManifest{{
+		// Incremental on top of base.
+		Incremental:  true,
+		FromPosition: pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10"),
+		Position:     pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20"),
+		BackupTime:   "2019-10-01T10:00:00Z",
+	}, {
+		// Incremental that brings nothing new.
+		Incremental:  true,
+		FromPosition: pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10"),
+		Position:     pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-15"),
+		BackupTime:   "2019-10-01T11:00:00Z",
+	}, {
+		// Full backups are not part of the chain.
+		Position:   pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-25"),
+		BackupTime: "2019-10-01T12:00:00Z",
+	}, {
+		// Incremental on top of the first one.
+		Incremental:  true,
+		FromPosition: pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-18"),
+		Position:     pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-30"),
+		BackupTime:   "2019-10-01T13:00:00Z",
+	}, {
+		// Gap: the chain stops here.
+		Incremental:  true,
+		FromPosition: pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-35"),
+		Position:     pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-40"),
+		BackupTime:   "2019-10-01T14:00:00Z",
+	}, {
+		Incremental:  true,
+		FromPosition: pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-30"),
+		Position:     pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-50"),
+		BackupTime:   "2019-10-01T15:00:00Z",
+	}}
+
+	got := incrementalChain(base, manifests, time.Time{})
+	want := []int{0, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("incrementalChain() = %v, want %v", got, want)
+	}
+
+	startTime, _ := time.Parse(time.RFC3339, "2019-10-01T12:30:00Z")
+	got = incrementalChain(base, manifests, startTime)
+	want = []int{0}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("incrementalChain(%v) = %v, want %v", startTime, got, want)
+	}
+}
+
+func TestFirstBinlogToBackup(t *testing.T) {
+	pos := func(s string) mysql.Position {
+		return mysql.MustParsePosition("MySQL56", s)
+	}
+	previousGTIDs := []mysql.Position{
+		pos(""),
+		pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10"),
+		pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20"),
+		pos("16b1039f-22b6-11ed-b765-0a43f95f28a3:1-30"),
+	}
+	testcases := []struct {
+		from string
+		want int
+	}{
+		{"16b1039f-22b6-11ed-b765-0a43f95f28a3:1-5", 0},
+		{"16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10", 1},
+		{"16b1039f-22b6-11ed-b765-0a43f95f28a3:1-25", 2},
+		{"16b1039f-22b6-11ed-b765-0a43f95f28a3:1-40", 3},
+	}
+	for _, tcase := range testcases {
+		if got := firstBinlogToBackup(previousGTIDs, pos(tcase.from)); got != tcase.want {
+			t.Errorf("firstBinlogToBackup(%v) = %v, want %v", tcase.from, got, tcase.want)
+		}
+	}
+}
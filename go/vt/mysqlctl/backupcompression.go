@@ -0,0 +1,177 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/klauspost/pgzip"
+
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const gzipCompressorName = "gzip"
+
+var (
+	// backupCompressionEngine is the compressor used for new backups.
+	backupCompressionEngine = flag.String("backup_storage_compression_engine", gzipCompressorName, "if backup_storage_compress is true, the compression engine used by the builtin backup engine: gzip (built-in), zstd or lz4. zstd and lz4 require the corresponding command line tool to be installed.")
+)
+
+// Compressor compresses and decompresses the files of a backup.
+type Compressor interface {
+	// NewWriter returns a writer that compresses into w. Closing
+	// the returned writer flushes all the data to w, but doesn't
+	// close w.
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+
+	// NewReader returns a reader that decompresses r.
+	NewReader(r io.Reader) (io.ReadCloser, error)
+}
+
+// CompressorMap contains the registered compressors, by name.
+// The name is stored in the backup manifest, so a compressor
+// must keep the same name as long as backups using it exist.
+var CompressorMap = make(map[string]Compressor)
+
+// getCompressor returns the compressor registered with name.
+// An empty name means gzip, which was the only compressor when
+// the compression engine was not stored in the manifest.
+func getCompressor(name string) (Compressor, error) {
+	if name == "" {
+		name = gzipCompressorName
+	}
+	c, ok := CompressorMap[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown backup compression engine %v", name)
+	}
+	return c, nil
+}
+
+// pgzipCompressor is the built-in gzip compressor. It compresses
+// blocks in parallel.
+type pgzipCompressor struct{}
+
+// NewWriter is part of the Compressor interface.
+func (pgzipCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	gzip, err := pgzip.NewWriterLevel(w, pgzip.BestSpeed)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create gziper")
+	}
+	gzip.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks)
+	return gzip, nil
+}
+
+// NewReader is part of the Compressor interface.
+func (pgzipCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	gz, err := pgzip.NewReader(r)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't open gzip decompressor")
+	}
+	return gz, nil
+}
+
+// externalCompressor compresses and decompresses by piping the data
+// through external commands that read stdin and write to stdout.
+type externalCompressor struct {
+	compress   []string
+	decompress []string
+}
+
+// NewWriter is part of the Compressor interface.
+func (ec *externalCompressor) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	cmd := exec.Command(ec.compress[0], ec.compress[1:]...)
+	cmd.Stdout = w
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot create stdin pipe for %v", ec.compress[0])
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot start %v", ec.compress[0])
+	}
+	return &commandWriter{WriteCloser: stdin, cmd: cmd, stderr: stderr}, nil
+}
+
+// NewReader is part of the Compressor interface.
+func (ec *externalCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	cmd := exec.Command(ec.decompress[0], ec.decompress[1:]...)
+	cmd.Stdin = r
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot create stdout pipe for %v", ec.decompress[0])
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot start %v", ec.decompress[0])
+	}
+	return &commandReader{ReadCloser: stdout, cmd: cmd, stderr: stderr}, nil
+}
+
+// commandWriter writes to the stdin of a command.
+type commandWriter struct {
+	io.WriteCloser
+	cmd    *exec.Cmd
+	stderr *bytes.Buffer
+}
+
+// Close closes stdin and waits for the command to exit.
+func (cw *commandWriter) Close() error {
+	if err := cw.WriteCloser.Close(); err != nil {
+		return err
+	}
+	return waitCommand(cw.cmd, cw.stderr)
+}
+
+// commandReader reads the stdout of a command.
+type commandReader struct {
+	io.ReadCloser
+	cmd    *exec.Cmd
+	stderr *bytes.Buffer
+}
+
+// Close closes stdout and waits for the command to exit.
+func (cr *commandReader) Close() error {
+	cr.ReadCloser.Close()
+	return waitCommand(cr.cmd, cr.stderr)
+}
+
+func waitCommand(cmd *exec.Cmd, stderr *bytes.Buffer) error {
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("%v failed: %v, stderr: %v", strings.Join(cmd.Args, " "), err, stderr.String())
+	}
+	return nil
+}
+
+func init() {
+	CompressorMap[gzipCompressorName] = pgzipCompressor{}
+	CompressorMap["zstd"] = &externalCompressor{
+		compress:   []string{"zstd", "-q", "-c", "-T0"},
+		decompress: []string{"zstd", "-q", "-d", "-c"},
+	}
+	CompressorMap["lz4"] = &externalCompressor{
+		compress:   []string{"lz4", "-q", "-c"},
+		decompress: []string{"lz4", "-q", "-d", "-c"},
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+func TestCompressors(t *testing.T) {
+	// cat is a valid, if not very efficient, compressor.
+	CompressorMap["cat"] = &externalCompressor{
+		compress:   []string{"cat"},
+		decompress: []string{"cat"},
+	}
+	defer delete(CompressorMap, "cat")
+
+	data := make([]byte, 1000000)
+	for i := range data {
+		data[i] = byte(i % 7)
+	}
+	for _, name := range []string{"", "gzip", "cat"} {
+		c, err := getCompressor(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf := &bytes.Buffer{}
+		w, err := c.NewWriter(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(data); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		r, err := c.NewReader(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := r.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("compressor %v: round trip returned different data", name)
+		}
+	}
+
+	if _, err := getCompressor("unknown"); err == nil {
+		t.Errorf("getCompressor(unknown): nil, want error")
+	}
+}
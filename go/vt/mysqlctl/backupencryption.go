@@ -0,0 +1,269 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// encryptionSaltSize is the size of the random salt at the start
+	// of each encrypted file. The key of each file is derived from
+	// the data key of the backup and the salt.
+	encryptionSaltSize = 32
+
+	// encryptionSegmentSize is the size of the plaintext of each
+	// encrypted segment of a file.
+	encryptionSegmentSize = 64 * 1024
+)
+
+var (
+	// backupEncryptionKeyHook is the hook that provides the data
+	// keys used to encrypt backups.
+	backupEncryptionKeyHook = flag.String("backup_encryption_key_hook", "", "if set, the files of builtin backups are encrypted with AES-GCM using a data key provided by this hook. The hook is called with '-operation generate' and must print a JSON object with the base64-encoded 'key' and 'encrypted_key', as returned by a KMS. The encrypted key is stored in the backup manifest. On restore, the hook is called with '-operation decrypt -encrypted_key <encrypted_key>' and must print a JSON object with the base64-encoded 'key'.")
+)
+
+// dataKey is the output of the encryption key hook.
+type dataKey struct {
+	Key          string `json:"key"`
+	EncryptedKey string `json:"encrypted_key"`
+}
+
+// generateDataKey returns a new data key and its encrypted form.
+func generateDataKey(hookName string, env map[string]string) (key []byte, encryptedKey string, err error) {
+	dk, err := runKeyHook(hookName, env, []string{"-operation", "generate"})
+	if err != nil {
+		return nil, "", err
+	}
+	if dk.EncryptedKey == "" {
+		return nil, "", vterrors.Errorf(vtrpc.Code_INTERNAL, "'%v' hook returned no encrypted_key", hookName)
+	}
+	key, err = decodeDataKey(hookName, dk)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, dk.EncryptedKey, nil
+}
+
+// decryptDataKey returns the data key for an encrypted key.
+func decryptDataKey(hookName string, env map[string]string, encryptedKey string) ([]byte, error) {
+	dk, err := runKeyHook(hookName, env, []string{"-operation", "decrypt", "-encrypted_key", encryptedKey})
+	if err != nil {
+		return nil, err
+	}
+	return decodeDataKey(hookName, dk)
+}
+
+func runKeyHook(hookName string, env map[string]string, params []string) (*dataKey, error) {
+	h := hook.NewHookWithEnv(hookName, params, env)
+	hr := h.Execute()
+	if hr.ExitStatus != hook.HOOK_SUCCESS {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "'%v' hook failed: %v", hookName, hr.String())
+	}
+	dk := &dataKey{}
+	if err := json.Unmarshal([]byte(hr.Stdout), dk); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot parse output of '%v' hook", hookName)
+	}
+	return dk, nil
+}
+
+func decodeDataKey(hookName string, dk *dataKey) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(dk.Key)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot decode key returned by '%v' hook", hookName)
+	}
+	if len(key) != 16 && len(key) != 24 && len(key) != 32 {
+		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "'%v' hook returned a key of %v bytes, expected 16, 24 or 32", hookName, len(key))
+	}
+	return key, nil
+}
+
+// fileAEAD returns the AES-GCM cipher for a file, using a key derived
+// from the data key and the salt of the file. Deriving a key per file
+// allows using a simple counter as nonce.
+func fileAEAD(key, salt []byte) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(salt)
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// segmentNonce returns the nonce of a segment. The last segment of a
+// file uses a different nonce, so that truncated files are detected.
+func segmentNonce(aead cipher.AEAD, counter uint64, last bool) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce, counter)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// encryptingWriter encrypts a file. The output is the salt of the
+// file, followed by segments that each contain the length of the
+// sealed data as a 4 bytes big-endian integer and the sealed data.
+type encryptingWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	buf     []byte
+	counter uint64
+}
+
+func newEncryptingWriter(w io.Writer, key []byte) (io.WriteCloser, error) {
+	salt := make([]byte, encryptionSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, vterrors.Wrap(err, "cannot generate salt")
+	}
+	aead, err := fileAEAD(key, salt)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create cipher")
+	}
+	if _, err := w.Write(salt); err != nil {
+		return nil, err
+	}
+	return &encryptingWriter{
+		w:    w,
+		aead: aead,
+		buf:  make([]byte, 0, encryptionSegmentSize),
+	}, nil
+}
+
+// Write is part of the io.Writer interface.
+func (ew *encryptingWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		// Keep a full segment buffered, so that Close
+		// always has data for the last segment.
+		if len(ew.buf) == encryptionSegmentSize {
+			if err := ew.seal(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(ew.buf[len(ew.buf):encryptionSegmentSize], p)
+		ew.buf = ew.buf[:len(ew.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+// Close writes the last segment. It doesn't close the underlying writer.
+func (ew *encryptingWriter) Close() error {
+	return ew.seal(true)
+}
+
+func (ew *encryptingWriter) seal(last bool) error {
+	sealed := ew.aead.Seal(nil, segmentNonce(ew.aead, ew.counter, last), ew.buf, nil)
+	ew.counter++
+	ew.buf = ew.buf[:0]
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(sealed)))
+	if _, err := ew.w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := ew.w.Write(sealed)
+	return err
+}
+
+// decryptingReader decrypts a file written by encryptingWriter.
+type decryptingReader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	buf     []byte
+	counter uint64
+	done    bool
+}
+
+func newDecryptingReader(r io.Reader, key []byte) (io.Reader, error) {
+	salt := make([]byte, encryptionSaltSize)
+	if _, err := io.ReadFull(r, salt); err != nil {
+		return nil, vterrors.Wrap(err, "cannot read salt")
+	}
+	aead, err := fileAEAD(key, salt)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create cipher")
+	}
+	return &decryptingReader{
+		r:    r,
+		aead: aead,
+	}, nil
+}
+
+// Read is part of the io.Reader interface.
+func (dr *decryptingReader) Read(p []byte) (int, error) {
+	for len(dr.buf) == 0 {
+		if dr.done {
+			return 0, io.EOF
+		}
+		if err := dr.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, dr.buf)
+	dr.buf = dr.buf[n:]
+	return n, nil
+}
+
+func (dr *decryptingReader) open() error {
+	var header [4]byte
+	if _, err := io.ReadFull(dr.r, header[:]); err != nil {
+		if err == io.EOF {
+			return fmt.Errorf("encrypted file is truncated")
+		}
+		return err
+	}
+	size := binary.BigEndian.Uint32(header[:])
+	if size > encryptionSegmentSize+uint32(dr.aead.Overhead()) {
+		return fmt.Errorf("invalid encrypted segment size %v", size)
+	}
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(dr.r, sealed); err != nil {
+		return err
+	}
+	buf, err := dr.aead.Open(nil, segmentNonce(dr.aead, dr.counter, false), sealed, nil)
+	if err != nil {
+		buf, err = dr.aead.Open(nil, segmentNonce(dr.aead, dr.counter, true), sealed, nil)
+		if err != nil {
+			return fmt.Errorf("cannot decrypt segment %v: %v", dr.counter, err)
+		}
+		// This was the last segment, nothing can follow it.
+		if _, err := io.ReadFull(dr.r, header[:1]); err != io.EOF {
+			return fmt.Errorf("unexpected data after the last encrypted segment")
+		}
+		dr.done = true
+	}
+	dr.counter++
+	dr.buf = buf
+	return nil
+}
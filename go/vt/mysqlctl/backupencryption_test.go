@@ -0,0 +1,124 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func encrypt(t *testing.T, key, data []byte) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	w, err := newEncryptingWriter(buf, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Write in small chunks to exercise the buffering.
+	for len(data) > 0 {
+		n := 1000
+		if n > len(data) {
+			n = len(data)
+		}
+		if _, err := w.Write(data[:n]); err != nil {
+			t.Fatal(err)
+		}
+		data = data[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func decrypt(key, data []byte) ([]byte, error) {
+	r, err := newDecryptingReader(bytes.NewReader(data), key)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	key := make([]byte, 32)
+	rand.Read(key)
+	for _, size := range []int{0, 1, encryptionSegmentSize - 1, encryptionSegmentSize, encryptionSegmentSize + 1, 3*encryptionSegmentSize + 17} {
+		data := make([]byte, size)
+		rand.Read(data)
+		encrypted := encrypt(t, key, data)
+		got, err := decrypt(key, encrypted)
+		if err != nil {
+			t.Errorf("decrypt of %v bytes: %v", size, err)
+			continue
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("decrypt of %v bytes returned different data", size)
+		}
+	}
+}
+
+func TestEncryptionErrors(t *testing.T) {
+	key := make([]byte, 32)
+	rand.Read(key)
+	data := make([]byte, 2*encryptionSegmentSize+100)
+	rand.Read(data)
+	encrypted := encrypt(t, key, data)
+
+	// Wrong key.
+	otherKey := make([]byte, 32)
+	rand.Read(otherKey)
+	if _, err := decrypt(otherKey, encrypted); err == nil || !strings.Contains(err.Error(), "cannot decrypt segment 0") {
+		t.Errorf("decrypt with wrong key: %v, want cannot decrypt segment 0", err)
+	}
+
+	// Tampered data.
+	tampered := append([]byte(nil), encrypted...)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := decrypt(key, tampered); err == nil || !strings.Contains(err.Error(), "cannot decrypt segment 2") {
+		t.Errorf("decrypt of tampered data: %v, want cannot decrypt segment 2", err)
+	}
+
+	// Truncated after a full segment: the last segment is missing.
+	truncated := encrypted[:encryptionSaltSize+2*(4+encryptionSegmentSize+16)]
+	if _, err := decrypt(key, truncated); err == nil || err.Error() != "encrypted file is truncated" {
+		t.Errorf("decrypt of truncated data: %v, want encrypted file is truncated", err)
+	}
+
+	// Trailing data.
+	trailing := append(append([]byte(nil), encrypted...), 0)
+	if _, err := decrypt(key, trailing); err == nil || err.Error() != "unexpected data after the last encrypted segment" {
+		t.Errorf("decrypt with trailing data: %v, want unexpected data after the last encrypted segment", err)
+	}
+}
+
+func TestDecodeDataKey(t *testing.T) {
+	key := make([]byte, 32)
+	if _, err := decodeDataKey("kms", &dataKey{Key: base64.StdEncoding.EncodeToString(key)}); err != nil {
+		t.Errorf("decodeDataKey: %v", err)
+	}
+	want := "'kms' hook returned a key of 10 bytes, expected 16, 24 or 32"
+	if _, err := decodeDataKey("kms", &dataKey{Key: base64.StdEncoding.EncodeToString(key[:10])}); err == nil || err.Error() != want {
+		t.Errorf("decodeDataKey: %v, want %v", err, want)
+	}
+	if _, err := decodeDataKey("kms", &dataKey{Key: "not base64!"}); err == nil {
+		t.Errorf("decodeDataKey: nil, want error")
+	}
+}
@@ -66,6 +66,9 @@ type BackupParams struct {
 	TabletAlias string
 	// BackupTime is the time at which the backup is being started
 	BackupTime time.Time
+	// IncrementalFromPosition is the position of the previous backup
+	// an incremental backup is taken on top of. It is set by Backup().
+	IncrementalFromPosition mysql.Position
 }
 
 // RestoreParams is the struct that holds all params passed to ExecuteRestore
@@ -188,6 +191,13 @@ type BackupManifest struct {
 	// FinishedTime is the time (in RFC 3339 format, UTC) at which the backup finished, if known.
 	// Some backups may not set this field if they were created before the field was added.
 	FinishedTime string
+
+	// Incremental is true if this backup only contains the changes made
+	// since the backup at FromPosition, and must be restored on top of it.
+	Incremental bool
+
+	// FromPosition is the position an incremental backup starts from.
+	FromPosition mysql.Position
 }
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
@@ -208,6 +218,11 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 			params.Logger.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage: can't read MANIFEST: %v)", bh.Name(), backupDir, err)
 			continue
 		}
+		if bm.Incremental {
+			// Incremental backups are applied on top of a full backup
+			// by FindIncrementalBackupsToRestore.
+			continue
+		}
 
 		var backupTime time.Time
 		if checkBackupTime {
@@ -235,6 +250,71 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 	return bh, nil
 }
 
+// FindIncrementalBackupsToRestore returns the chain of incremental
+// backups that can be applied, in order, on top of the full backup base.
+// The chain stops at the first gap in positions, and honors
+// params.StartTime if set.
+func FindIncrementalBackupsToRestore(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle, base backupstorage.BackupHandle) ([]backupstorage.BackupHandle, error) {
+	baseManifest, err := GetBackupManifest(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []*BackupManifest
+	var handles []backupstorage.BackupHandle
+	found := false
+	for _, bh := range bhs {
+		if !found {
+			found = bh.Name() == base.Name()
+			continue
+		}
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil {
+			params.Logger.Warningf("Restore: skipping possibly incomplete backup %v: can't read MANIFEST: %v", bh.Name(), err)
+			continue
+		}
+		manifests = append(manifests, bm)
+		handles = append(handles, bh)
+	}
+
+	var chain []backupstorage.BackupHandle
+	for _, i := range incrementalChain(baseManifest, manifests, params.StartTime) {
+		params.Logger.Infof("Restore: found incremental backup %v %v to restore", handles[i].Directory(), handles[i].Name())
+		chain = append(chain, handles[i])
+	}
+	return chain, nil
+}
+
+// incrementalChain returns the indexes of the incremental backups in
+// manifests, sorted by backup time, that can be applied in order on
+// top of base. If startTime is not zero, backups taken after it are ignored.
+func incrementalChain(base *BackupManifest, manifests []*BackupManifest, startTime time.Time) []int {
+	var chain []int
+	position := base.Position
+	for i, bm := range manifests {
+		if !bm.Incremental {
+			continue
+		}
+		if !startTime.IsZero() {
+			backupTime, err := time.Parse(time.RFC3339, bm.BackupTime)
+			if err != nil || backupTime.After(startTime) {
+				break
+			}
+		}
+		if !position.AtLeast(bm.FromPosition) {
+			// There is a gap between this backup and the chain.
+			break
+		}
+		if position.AtLeast(bm.Position) {
+			// This backup doesn't bring anything new.
+			continue
+		}
+		chain = append(chain, i)
+		position = bm.Position
+	}
+	return chain
+}
+
 func prepareToRestore(ctx context.Context, cnf *Mycnf, mysqld MysqlDaemon, logger logutil.Logger) error {
 	// shutdown mysqld if it is running
 	logger.Infof("Restore: shutdown mysqld")
@@ -0,0 +1,287 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	binlogBackupEngineName = "binlog"
+
+	// backupBinlog is the base for binlog files captured by an
+	// incremental backup.
+	backupBinlog = "BinLog"
+
+	// incrementalRestoreDir is the directory, relative to Mycnf.TmpDir,
+	// where binlogs of an incremental backup are staged before being
+	// applied.
+	incrementalRestoreDir = "incremental_restore"
+)
+
+var (
+	// backupIncrementalMaxChain is the maximum number of incremental
+	// backups that can be taken on top of a full backup.
+	backupIncrementalMaxChain = flag.Int("backup_incremental_max_chain", 0, "if greater than 0, backups are taken incrementally by archiving the binlogs written since the previous backup, until this many incremental backups exist on top of the most recent full backup. A full backup is then taken with -backup_engine_implementation. Incremental backups require GTID-based replication.")
+)
+
+// BinlogBackupEngine takes incremental backups by archiving the binlogs
+// written since a previous backup, and restores them by replaying
+// those binlogs on top of an already restored backup.
+type BinlogBackupEngine struct {
+}
+
+// binlogBackupManifest represents an incremental backup. It lists the
+// binlog files in the order they need to be applied.
+type binlogBackupManifest struct {
+	// BackupManifest is an anonymous embedding of the base manifest struct.
+	BackupManifest
+
+	// FileEntries contains the binlog files in the backup, in order.
+	FileEntries []FileEntry
+
+	// fileTransforms is an anonymous embedding of the transforms
+	// applied to the files.
+	fileTransforms
+}
+
+// ExecuteBackup is part of the BackupEngine interface.
+// It archives all the closed binlogs that contain transactions
+// not already included in params.IncrementalFromPosition.
+func (be *BinlogBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
+	fromPosition := params.IncrementalFromPosition
+	if fromPosition.IsZero() {
+		return false, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the %v backup engine can only take incremental backups on top of an existing backup", binlogBackupEngineName)
+	}
+
+	// Read the position before rotating the binlogs, so that every
+	// transaction up to it is guaranteed to be in a closed binlog.
+	replicationPosition, err := params.Mysqld.MasterPosition()
+	if err != nil {
+		return false, vterrors.Wrap(err, "can't get replication position")
+	}
+	if err := checkBinlogsNotPurged(ctx, params.Mysqld, fromPosition); err != nil {
+		return false, err
+	}
+	if err := params.Mysqld.ExecuteSuperQueryList(ctx, []string{"FLUSH BINARY LOGS"}); err != nil {
+		return false, vterrors.Wrap(err, "can't rotate binlogs")
+	}
+
+	binlogs, err := closedBinlogs(ctx, params.Mysqld)
+	if err != nil {
+		return false, err
+	}
+	previousGTIDs := make([]mysql.Position, len(binlogs))
+	for i, binlog := range binlogs {
+		previousGTIDs[i], err = binlogPreviousGTIDs(ctx, params.Mysqld, binlog, fromPosition.GTIDSet.Flavor())
+		if err != nil {
+			return false, err
+		}
+	}
+	binlogs = binlogs[firstBinlogToBackup(previousGTIDs, fromPosition):]
+	params.Logger.Infof("taking incremental backup from position %v to %v with %v binlogs", fromPosition, replicationPosition, len(binlogs))
+
+	fes := make([]FileEntry, len(binlogs))
+	for i, binlog := range binlogs {
+		fes[i] = FileEntry{
+			Base: backupBinlog,
+			Name: binlog,
+		}
+	}
+
+	ft, err := newFileTransforms(params.HookExtraEnv)
+	if err != nil {
+		return false, err
+	}
+
+	// The binlogs are closed files, so they can be copied while mysqld
+	// keeps on running.
+	bbe := &BuiltinBackupEngine{}
+	for i := range fes {
+		if err := bbe.backupFile(ctx, params, bh, &fes[i], ft, fmt.Sprintf("%v", i)); err != nil {
+			return false, err
+		}
+	}
+
+	bm := &binlogBackupManifest{
+		BackupManifest: BackupManifest{
+			BackupMethod: binlogBackupEngineName,
+			Position:     replicationPosition,
+			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+			Incremental:  true,
+			FromPosition: fromPosition,
+		},
+		FileEntries:    fes,
+		fileTransforms: *ft,
+	}
+	if err := writeManifest(ctx, bh, bm); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ShouldDrainForBackup is part of the BackupEngine interface.
+// Copying closed binlogs doesn't require stopping the query service.
+func (be *BinlogBackupEngine) ShouldDrainForBackup() bool {
+	return false
+}
+
+// ExecuteRestore is part of the RestoreEngine interface.
+// It expects mysqld to be running with the previous backup of the
+// chain already restored, and replays the archived binlogs on it.
+func (be *BinlogBackupEngine) ExecuteRestore(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle) (*BackupManifest, error) {
+	var bm binlogBackupManifest
+	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+		return nil, err
+	}
+
+	if err := bm.loadKey(params.HookExtraEnv); err != nil {
+		return nil, err
+	}
+
+	stagingDir := path.Join(params.Cnf.TmpDir, incrementalRestoreDir)
+	defer os.RemoveAll(stagingDir)
+
+	bbe := &BuiltinBackupEngine{}
+	for i := range bm.FileEntries {
+		fe := &bm.FileEntries[i]
+		name := fmt.Sprintf("%v", i)
+		params.Logger.Infof("Restore: copying binlog %v: %v", name, fe.Name)
+		if err := bbe.restoreFile(ctx, params, bh, fe, &bm.fileTransforms, name); err != nil {
+			return nil, vterrors.Wrapf(err, "can't restore binlog %v to %v", name, fe.Name)
+		}
+		params.Logger.Infof("Restore: applying binlog %v", fe.Name)
+		if err := params.Mysqld.ApplyBinlogFile(ctx, path.Join(stagingDir, fe.Name)); err != nil {
+			return nil, vterrors.Wrapf(err, "can't apply binlog %v", fe.Name)
+		}
+	}
+	return &bm.BackupManifest, nil
+}
+
+// writeManifest JSON-encodes a manifest into the MANIFEST file of a backup.
+func writeManifest(ctx context.Context, bh backupstorage.BackupHandle, manifest interface{}) (finalErr error) {
+	wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot add %v to backup", backupManifestFileName)
+	}
+	defer func() {
+		if closeErr := wc.Close(); finalErr == nil {
+			finalErr = closeErr
+		}
+	}()
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	if _, err := wc.Write([]byte(data)); err != nil {
+		return vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	return nil
+}
+
+// checkBinlogsNotPurged returns an error if some transactions that
+// are not part of fromPosition have already been purged from the binlogs.
+func checkBinlogsNotPurged(ctx context.Context, mysqld MysqlDaemon, fromPosition mysql.Position) error {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SELECT @@global.gtid_purged")
+	if err != nil {
+		return vterrors.Wrap(err, "can't get purged GTIDs")
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "unexpected result for gtid_purged: %v", qr.Rows)
+	}
+	purged, err := mysql.ParsePosition(fromPosition.GTIDSet.Flavor(), qr.Rows[0][0].ToString())
+	if err != nil {
+		return vterrors.Wrap(err, "can't parse purged GTIDs")
+	}
+	if !fromPosition.AtLeast(purged) {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "binlogs needed for an incremental backup from %v have been purged (gtid_purged=%v), a full backup is required", fromPosition, purged)
+	}
+	return nil
+}
+
+// closedBinlogs returns the names of all binlogs but the one mysqld
+// is currently writing to, oldest first.
+func closedBinlogs(ctx context.Context, mysqld MysqlDaemon) ([]string, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't list binlogs")
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	binlogs := make([]string, 0, len(qr.Rows)-1)
+	for _, row := range qr.Rows[:len(qr.Rows)-1] {
+		binlogs = append(binlogs, row[0].ToString())
+	}
+	return binlogs, nil
+}
+
+// binlogPreviousGTIDs returns the GTID set recorded in the
+// Previous_gtids event of a binlog, i.e. all the transactions that
+// were written before it. It returns a zero Position if there is no
+// such event.
+func binlogPreviousGTIDs(ctx context.Context, mysqld MysqlDaemon, binlog, flavor string) (mysql.Position, error) {
+	queryBuf := bytes.Buffer{}
+	queryBuf.WriteString("SHOW BINLOG EVENTS IN ")
+	sqltypes.NewVarBinary(binlog).EncodeSQL(&queryBuf)
+	queryBuf.WriteString(" LIMIT 2")
+	qr, err := mysqld.FetchSuperQuery(ctx, queryBuf.String())
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrapf(err, "can't read events of binlog %v", binlog)
+	}
+	for _, row := range qr.Rows {
+		// Columns are: Log_name, Pos, Event_type, Server_id, End_log_pos, Info.
+		if len(row) < 6 || row[2].ToString() != "Previous_gtids" {
+			continue
+		}
+		return mysql.ParsePosition(flavor, row[5].ToString())
+	}
+	return mysql.Position{}, nil
+}
+
+// firstBinlogToBackup returns the index of the first binlog that may
+// contain transactions after fromPosition. previousGTIDs contains the
+// Previous_gtids of each binlog: when it is covered by fromPosition,
+// none of the binlogs before it are needed.
+func firstBinlogToBackup(previousGTIDs []mysql.Position, fromPosition mysql.Position) int {
+	first := 0
+	for i, pos := range previousGTIDs {
+		if !pos.IsZero() && fromPosition.AtLeast(pos) {
+			first = i
+		}
+	}
+	return first
+}
+
+func init() {
+	BackupRestoreEngineMap[binlogBackupEngineName] = &BinlogBackupEngine{}
+}
@@ -27,7 +27,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -64,14 +63,76 @@ type builtinBackupManifest struct {
 	// FileEntries contains all the files in the backup
 	FileEntries []FileEntry
 
+	// fileTransforms is an anonymous embedding of the transforms
+	// applied to the files.
+	fileTransforms
+}
+
+// fileTransforms describes how the files of a backup are transformed
+// between their source and the backup storage. Files are compressed,
+// then encrypted, then sent through the transform hook.
+type fileTransforms struct {
 	// TransformHook that was used on the files, if any.
 	TransformHook string
 
-	// SkipCompress is true if the backup files were NOT run through gzip.
+	// SkipCompress is true if the backup files were NOT compressed.
 	// The field is expressed as a negative because it will come through as
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine is the name of the Compressor used on the
+	// files. Empty means gzip.
+	CompressionEngine string `json:",omitempty"`
+
+	// EncryptionKeyHook is the hook that provided the data key the
+	// files are encrypted with, if any.
+	EncryptionKeyHook string `json:",omitempty"`
+
+	// EncryptedKey is the data key, as encrypted by EncryptionKeyHook.
+	EncryptedKey string `json:",omitempty"`
+
+	// key is the decrypted data key.
+	key []byte
+}
+
+// newFileTransforms returns the transforms to use for a new backup,
+// as configured by flags. It generates a new data key if encryption
+// is enabled.
+func newFileTransforms(hookExtraEnv map[string]string) (*fileTransforms, error) {
+	ft := &fileTransforms{
+		TransformHook: *backupStorageHook,
+		SkipCompress:  !*backupStorageCompress,
+	}
+	if *backupStorageCompress {
+		if _, err := getCompressor(*backupCompressionEngine); err != nil {
+			return nil, err
+		}
+		ft.CompressionEngine = *backupCompressionEngine
+	}
+	if *backupEncryptionKeyHook != "" {
+		key, encryptedKey, err := generateDataKey(*backupEncryptionKeyHook, hookExtraEnv)
+		if err != nil {
+			return nil, err
+		}
+		ft.EncryptionKeyHook = *backupEncryptionKeyHook
+		ft.EncryptedKey = encryptedKey
+		ft.key = key
+	}
+	return ft, nil
+}
+
+// loadKey decrypts the data key of an encrypted backup.
+func (ft *fileTransforms) loadKey(hookExtraEnv map[string]string) error {
+	if ft.EncryptedKey == "" || ft.key != nil {
+		return nil
+	}
+	key, err := decryptDataKey(ft.EncryptionKeyHook, hookExtraEnv, ft.EncryptedKey)
+	if err != nil {
+		return err
+	}
+	ft.key = key
+	return nil
 }
 
 // FileEntry is one file to backup
@@ -80,6 +141,7 @@ type FileEntry struct {
 	// - backupInnodbDataHomeDir for files that go into Mycnf.InnodbDataHomeDir
 	// - backupInnodbLogGroupHomeDir for files that go into Mycnf.InnodbLogGroupHomeDir
 	// - backupData for files that go into Mycnf.DataDir
+	// - backupBinlog for binlog files of an incremental backup
 	Base string
 
 	// Name is the file name, relative to Base
@@ -100,6 +162,14 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 		root = cnf.InnodbLogGroupHomeDir
 	case backupData:
 		root = cnf.DataDir
+	case backupBinlog:
+		// Binlogs are read from where mysqld writes them, but are
+		// restored to a staging directory, from which they are applied.
+		if readOnly {
+			root = path.Dir(cnf.BinLogPath)
+		} else {
+			root = path.Join(cnf.TmpDir, incrementalRestoreDir)
+		}
 	default:
 		return nil, vterrors.Errorf(vtrpc.Code_UNKNOWN, "unknown base: %v", fe.Base)
 	}
@@ -128,7 +198,7 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 // and an overall error.
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
-	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	params.Logger.Infof("Hook: %v, Compress: %v, Compression engine: %v, Encryption key hook: %v", *backupStorageHook, *backupStorageCompress, *backupCompressionEngine, *backupEncryptionKeyHook)
 
 	// Save initial state so we can restore.
 	slaveStartRequired := false
@@ -228,8 +298,9 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 		// Wait for a reliable value for SecondsBehindMaster from SlaveStatus()
 
 		// We know that we stopped at replicationPosition.
-		// If MasterPosition is the same, that means no writes
-		// have happened to master, so we are up-to-date.
+		// If it contains MasterPosition, that means no writes
+		// have happened to master, so we are up-to-date (with MariaDB,
+		// it can also contain domains the master doesn't report).
 		// Otherwise, we wait for replica's Position to change from
 		// the saved replicationPosition before proceeding
 		tmc := tmclient.NewTabletManagerClient()
@@ -242,7 +313,7 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 		if err != nil {
 			return usable, err
 		}
-		if !replicationPosition.Equal(masterPos) {
+		if !replicationPosition.AtLeast(masterPos) {
 			for {
 				if err := ctx.Err(); err != nil {
 					return usable, err
@@ -274,6 +345,11 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 	}
 	params.Logger.Infof("found %v files to backup", len(fes))
 
+	ft, err := newFileTransforms(params.HookExtraEnv)
+	if err != nil {
+		return err
+	}
+
 	// Backup with the provided concurrency.
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
@@ -293,7 +369,7 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 
 			// Backup the individual file.
 			name := fmt.Sprintf("%v", i)
-			rec.RecordError(be.backupFile(ctx, params, bh, &fes[i], name))
+			rec.RecordError(be.backupFile(ctx, params, bh, &fes[i], ft, name))
 		}(i)
 	}
 
@@ -324,9 +400,8 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 		},
 
 		// Builtin-specific fields
-		FileEntries:   fes,
-		TransformHook: *backupStorageHook,
-		SkipCompress:  !*backupStorageCompress,
+		FileEntries:    fes,
+		fileTransforms: *ft,
 	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
@@ -340,7 +415,7 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 }
 
 // backupFile backs up an individual file.
-func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, ft *fileTransforms, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := fe.open(params.Cnf, true)
 	if err != nil {
@@ -378,38 +453,58 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 	// Create the external write pipe, if any.
 	var pipe io.WriteCloser
 	var wait hook.WaitFunc
-	if *backupStorageHook != "" {
-		h := hook.NewHook(*backupStorageHook, []string{"-operation", "write"})
+	if ft.TransformHook != "" {
+		h := hook.NewHook(ft.TransformHook, []string{"-operation", "write"})
 		h.ExtraEnv = params.HookExtraEnv
 		pipe, wait, _, err = h.ExecuteAsWritePipe(writer)
 		if err != nil {
-			return vterrors.Wrapf(err, "'%v' hook returned error", *backupStorageHook)
+			return vterrors.Wrapf(err, "'%v' hook returned error", ft.TransformHook)
 		}
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pgzip.Writer
-	if *backupStorageCompress {
-		gzip, err = pgzip.NewWriterLevel(writer, pgzip.BestSpeed)
+	// Create the encryption pipe, if necessary.
+	var encrypter io.WriteCloser
+	if ft.key != nil {
+		encrypter, err = newEncryptingWriter(writer, ft.key)
+		if err != nil {
+			return vterrors.Wrap(err, "cannot create encrypter")
+		}
+		writer = encrypter
+	}
+
+	// Create the compression pipe, if necessary.
+	var compressor io.WriteCloser
+	if !ft.SkipCompress {
+		c, err := getCompressor(ft.CompressionEngine)
+		if err != nil {
+			return err
+		}
+		compressor, err = c.NewWriter(writer)
 		if err != nil {
-			return vterrors.Wrap(err, "cannot create gziper")
+			return err
 		}
-		gzip.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks)
-		writer = gzip
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
-	// optional pipe, tee, output file and hasher).
+	// Copy from the source file to writer (optional compressor,
+	// optional encrypter, optional pipe, tee, output file and hasher).
 	_, err = io.Copy(writer, source)
 	if err != nil {
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close compressor")
+		}
+	}
+
+	// Close the encrypter to write the last segment.
+	if encrypter != nil {
+		if err = encrypter.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close encrypter")
 		}
 	}
 
@@ -420,10 +515,10 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		}
 		stderr, err := wait()
 		if stderr != "" {
-			params.Logger.Infof("'%v' hook returned stderr: %v", *backupStorageHook, stderr)
+			params.Logger.Infof("'%v' hook returned stderr: %v", ft.TransformHook, stderr)
 		}
 		if err != nil {
-			return vterrors.Wrapf(err, "'%v' returned error", *backupStorageHook)
+			return vterrors.Wrapf(err, "'%v' returned error", ft.TransformHook)
 		}
 	}
 
@@ -472,6 +567,9 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 // right place.
 func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest) error {
 	fes := bm.FileEntries
+	if err := bm.loadKey(params.HookExtraEnv); err != nil {
+		return err
+	}
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
 	wg := sync.WaitGroup{}
@@ -491,7 +589,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
 			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			err := be.restoreFile(ctx, params, bh, &fes[i], &bm.fileTransforms, name)
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
 			}
@@ -502,7 +600,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 }
 
 // restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, ft *fileTransforms, name string) (finalErr error) {
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -533,37 +631,49 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	hasher := newHasher()
 
 	// Create a Tee: we split the input into the hasher
-	// and into the gunziper.
+	// and into the decompressor.
 	reader := io.TeeReader(source, hasher)
 
 	// Create the external read pipe, if any.
 	var wait hook.WaitFunc
-	if transformHook != "" {
-		h := hook.NewHook(transformHook, []string{"-operation", "read"})
+	if ft.TransformHook != "" {
+		h := hook.NewHook(ft.TransformHook, []string{"-operation", "read"})
 		h.ExtraEnv = params.HookExtraEnv
 		reader, wait, _, err = h.ExecuteAsReadPipe(reader)
 		if err != nil {
-			return vterrors.Wrapf(err, "'%v' hook returned error", transformHook)
+			return vterrors.Wrapf(err, "'%v' hook returned error", ft.TransformHook)
+		}
+	}
+
+	// Create the decrypter if needed.
+	if ft.key != nil {
+		reader, err = newDecryptingReader(reader, ft.key)
+		if err != nil {
+			return vterrors.Wrap(err, "can't open decrypter")
 		}
 	}
 
 	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
+	if !ft.SkipCompress {
+		c, err := getCompressor(ft.CompressionEngine)
+		if err != nil {
+			return err
+		}
+		decompressor, err := c.NewReader(reader)
 		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
+			return err
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
+			if cerr := decompressor.Close(); cerr != nil {
 				if finalErr != nil {
 					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
+					log.Errorf("failed to close decompressor %v: %v", name, cerr)
 				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
+					finalErr = vterrors.Wrap(cerr, "failed to close decompressor")
 				}
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
 	// Copy the data. Will also write to the hasher.
@@ -575,10 +685,10 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	if wait != nil {
 		stderr, err := wait()
 		if stderr != "" {
-			log.Infof("'%v' hook returned stderr: %v", transformHook, stderr)
+			log.Infof("'%v' hook returned stderr: %v", ft.TransformHook, stderr)
 		}
 		if err != nil {
-			return vterrors.Wrapf(err, "'%v' returned error", transformHook)
+			return vterrors.Wrapf(err, "'%v' returned error", ft.TransformHook)
 		}
 	}
 
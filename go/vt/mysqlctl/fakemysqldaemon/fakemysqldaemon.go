@@ -136,6 +136,8 @@ type FakeMysqlDaemon struct {
 	SemiSyncMasterEnabled bool
 	// SemiSyncSlaveEnabled represents the state of rpl_semi_sync_slave_enabled.
 	SemiSyncSlaveEnabled bool
+	// SemiSyncAckers represents the state of rpl_semi_sync_master_wait_for_slave_count.
+	SemiSyncAckers int
 
 	// TimeoutHook is a func that can be called at the beginning of any method to fake a timeout.
 	// all a test needs to do is make it { return context.DeadlineExceeded }
@@ -373,6 +375,11 @@ func (fmd *FakeMysqlDaemon) FetchSuperQuery(ctx context.Context, query string) (
 	return qr, nil
 }
 
+// ApplyBinlogFile is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ApplyBinlogFile(ctx context.Context, binlogFile string) error {
+	return nil
+}
+
 // EnableBinlogPlayback is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) EnableBinlogPlayback() error {
 	fmd.BinlogPlayerEnabled.Set(true)
@@ -460,6 +467,12 @@ func (fmd *FakeMysqlDaemon) SetSemiSyncEnabled(master, slave bool) error {
 	return nil
 }
 
+// SetSemiSyncAckers is part of the MysqlDaemon interface.
+func (fmd *FakeMysqlDaemon) SetSemiSyncAckers(ackers int) error {
+	fmd.SemiSyncAckers = ackers
+	return nil
+}
+
 // SemiSyncEnabled is part of the MysqlDaemon interface.
 func (fmd *FakeMysqlDaemon) SemiSyncEnabled() (master, slave bool) {
 	return fmd.SemiSyncMasterEnabled, fmd.SemiSyncSlaveEnabled
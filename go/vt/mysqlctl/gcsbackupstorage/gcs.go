@@ -16,6 +16,11 @@ limitations under the License.
 
 // Package gcsbackupstorage implements the BackupStorage interface
 // for Google Cloud Storage.
+//
+// Files are streamed to GCS with resumable uploads, so no local copy
+// of the backup is needed: each file buffers one chunk in memory, and
+// the chunks that fail are retried by the client. The files of a
+// backup are uploaded in parallel, up to the backup concurrency.
 package gcsbackupstorage
 
 import (
@@ -29,6 +34,7 @@ import (
 	"cloud.google.com/go/storage"
 	"golang.org/x/net/context"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 
@@ -44,6 +50,12 @@ var (
 
 	// root is a prefix added to all object names.
 	root = flag.String("gcs_backup_storage_root", "", "root prefix for all backup-related object names")
+
+	// uploadChunkSize is the size of the chunks of resumable uploads.
+	uploadChunkSize = flag.Int("gcs_backup_upload_chunk_size", googleapi.DefaultUploadChunkSize, "size in bytes of the chunks each file is uploaded in. Each file being uploaded buffers one chunk in memory.")
+
+	// kmsKeyName is the Cloud KMS key the backup files are encrypted with.
+	kmsKeyName = flag.String("gcs_backup_kms_key_name", "", "Cloud KMS key to encrypt the backup files with, if not the default key of the bucket")
 )
 
 // GCSBackupHandle implements BackupHandle for Google Cloud Storage.
@@ -70,8 +82,19 @@ func (bh *GCSBackupHandle) AddFile(ctx context.Context, filename string, filesiz
 	if bh.readOnly {
 		return nil, fmt.Errorf("AddFile cannot be called on read-only backup")
 	}
-	object := objName(bh.dir, bh.name, filename)
-	return bh.client.Bucket(*bucket).Object(object).NewWriter(ctx), nil
+	return bh.newWriter(ctx, objName(bh.dir, bh.name, filename)), nil
+}
+
+// newWriter returns the writer that uploads an object in chunks of
+// -gcs_backup_upload_chunk_size bytes.
+func (bh *GCSBackupHandle) newWriter(ctx context.Context, object string) *storage.Writer {
+	w := bh.client.Bucket(*bucket).Object(object).NewWriter(ctx)
+	w.ChunkSize = *uploadChunkSize
+	if w.ChunkSize < googleapi.MinUploadChunkSize {
+		w.ChunkSize = googleapi.MinUploadChunkSize
+	}
+	w.KMSKeyName = *kmsKeyName
+	return w
 }
 
 // EndBackup implements BackupHandle.
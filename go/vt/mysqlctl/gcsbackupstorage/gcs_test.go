@@ -0,0 +1,67 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcsbackupstorage
+
+import (
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"golang.org/x/net/context"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+func TestNewWriter(t *testing.T) {
+	defer func(chunkSize int, keyName string) {
+		*uploadChunkSize, *kmsKeyName = chunkSize, keyName
+	}(*uploadChunkSize, *kmsKeyName)
+
+	ctx := context.Background()
+	client, err := storage.NewClient(ctx, option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	bh := &GCSBackupHandle{client: client}
+
+	testcases := []struct {
+		chunkSize int
+		keyName   string
+		want      int
+	}{{
+		chunkSize: googleapi.DefaultUploadChunkSize,
+		want:      googleapi.DefaultUploadChunkSize,
+	}, {
+		// Chunks can't be smaller than the GCS minimum.
+		chunkSize: 1024,
+		want:      googleapi.MinUploadChunkSize,
+	}, {
+		chunkSize: 64 * 1024 * 1024,
+		keyName:   "projects/p/locations/l/keyRings/r/cryptoKeys/k",
+		want:      64 * 1024 * 1024,
+	}}
+	for _, tc := range testcases {
+		*uploadChunkSize, *kmsKeyName = tc.chunkSize, tc.keyName
+		w := bh.newWriter(ctx, "dir/name/file")
+		if w.ChunkSize != tc.want {
+			t.Errorf("ChunkSize with -gcs_backup_upload_chunk_size=%v: %v, want %v", tc.chunkSize, w.ChunkSize, tc.want)
+		}
+		if w.KMSKeyName != tc.keyName {
+			t.Errorf("KMSKeyName: %q, want %q", w.KMSKeyName, tc.keyName)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// keyspaceBackupDir is the directory, within the directory of a
+// keyspace, where the keyspace backups are stored. The leading
+// underscore keeps it apart from the directories of the shards.
+const keyspaceBackupDir = "_keyspace"
+
+// KeyspaceBackupManifest is the MANIFEST of a keyspace backup. A
+// keyspace backup doesn't contain any data, it refers to one backup
+// per shard. All these backups were taken at the replication
+// positions of the masters recorded at BackupTime, so restoring them
+// yields a consistent state of the keyspace.
+type KeyspaceBackupManifest struct {
+	// Keyspace is the name of the keyspace.
+	Keyspace string
+
+	// BackupTime is the time at which the positions of the
+	// masters were recorded, in UTC (RFC 3339 format).
+	BackupTime string
+
+	// Shards contains the backup of each shard.
+	Shards []*ShardBackupManifest
+}
+
+// ShardBackupManifest describes the backup of a shard within a
+// keyspace backup.
+type ShardBackupManifest struct {
+	Shard       string
+	TabletAlias string
+	BackupName  string
+	Position    mysql.Position
+}
+
+// GetKeyspaceBackupDir returns the directory of the keyspace backups.
+func GetKeyspaceBackupDir(keyspace string) string {
+	return keyspace + "/" + keyspaceBackupDir
+}
+
+// KeyspaceBackupName returns the name of a keyspace backup taken at
+// backupTime. Restoring a SNAPSHOT keyspace whose snapshot time is
+// backupTime restores this keyspace backup.
+func KeyspaceBackupName(backupTime time.Time) string {
+	return backupTime.UTC().Format(BackupTimestampFormat)
+}
+
+// WriteKeyspaceBackupManifest stores a keyspace backup, and returns
+// its name.
+func WriteKeyspaceBackupManifest(ctx context.Context, bs backupstorage.BackupStorage, manifest *KeyspaceBackupManifest) (string, error) {
+	backupTime, err := time.Parse(time.RFC3339, manifest.BackupTime)
+	if err != nil {
+		return "", vterrors.Wrapf(err, "invalid BackupTime %v", manifest.BackupTime)
+	}
+	name := KeyspaceBackupName(backupTime)
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+
+	bh, err := bs.StartBackup(ctx, GetKeyspaceBackupDir(manifest.Keyspace), name)
+	if err != nil {
+		return "", vterrors.Wrap(err, "StartBackup failed")
+	}
+	wc, err := bh.AddFile(ctx, backupManifestFileName, int64(len(data)))
+	if err != nil {
+		bh.AbortBackup(ctx)
+		return "", vterrors.Wrapf(err, "cannot add %v to backup", backupManifestFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		bh.AbortBackup(ctx)
+		return "", vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	if err := wc.Close(); err != nil {
+		bh.AbortBackup(ctx)
+		return "", vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// ListKeyspaceBackups returns the names of the keyspace backups of
+// a keyspace, oldest first.
+func ListKeyspaceBackups(ctx context.Context, bs backupstorage.BackupStorage, keyspace string) ([]string, error) {
+	bhs, err := bs.ListBackups(ctx, GetKeyspaceBackupDir(keyspace))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(bhs))
+	for _, bh := range bhs {
+		names = append(names, bh.Name())
+	}
+	return names, nil
+}
+
+// ReadKeyspaceBackupManifest returns the MANIFEST of a keyspace
+// backup. It returns ErrNoBackup if there is no such backup.
+func ReadKeyspaceBackupManifest(ctx context.Context, bs backupstorage.BackupStorage, keyspace, name string) (*KeyspaceBackupManifest, error) {
+	bhs, err := bs.ListBackups(ctx, GetKeyspaceBackupDir(keyspace))
+	if err != nil {
+		return nil, err
+	}
+	for _, bh := range bhs {
+		if bh.Name() != name {
+			continue
+		}
+		manifest := &KeyspaceBackupManifest{}
+		if err := getBackupManifestInto(ctx, bh, manifest); err != nil {
+			return nil, err
+		}
+		return manifest, nil
+	}
+	return nil, ErrNoBackup
+}
+
+// FindShardBackup returns the handle of the most recent backup of
+// a shard that was taken by a tablet at a replication position.
+func FindShardBackup(ctx context.Context, bs backupstorage.BackupStorage, keyspace, shard, tabletAlias string, pos mysql.Position) (backupstorage.BackupHandle, error) {
+	bhs, err := bs.ListBackups(ctx, GetBackupDir(keyspace, shard))
+	if err != nil {
+		return nil, err
+	}
+	for i := len(bhs) - 1; i >= 0; i-- {
+		if !strings.HasSuffix(bhs[i].Name(), "."+tabletAlias) {
+			continue
+		}
+		bm, err := GetBackupManifest(ctx, bhs[i])
+		if err != nil {
+			// Incomplete backup.
+			continue
+		}
+		if bm.Position.Equal(pos) {
+			return bhs[i], nil
+		}
+	}
+	return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no backup of %v/%v taken by %v at %v", keyspace, shard, tabletAlias, pos)
+}
+
+// keyspaceBackupToRestore returns the backup of a shard to restore if
+// a keyspace backup was taken at params.StartTime, or nil if there is
+// no such keyspace backup.
+func keyspaceBackupToRestore(ctx context.Context, bs backupstorage.BackupStorage, params RestoreParams, bhs []backupstorage.BackupHandle) (backupstorage.BackupHandle, error) {
+	manifest, err := ReadKeyspaceBackupManifest(ctx, bs, params.Keyspace, KeyspaceBackupName(params.StartTime))
+	switch err {
+	case nil:
+	case ErrNoBackup:
+		return nil, nil
+	default:
+		return nil, err
+	}
+	for _, sbm := range manifest.Shards {
+		if sbm.Shard != params.Shard {
+			continue
+		}
+		for _, bh := range bhs {
+			if bh.Name() == sbm.BackupName {
+				params.Logger.Infof("Restore: restoring backup %v of keyspace backup %v", bh.Name(), KeyspaceBackupName(params.StartTime))
+				return bh, nil
+			}
+		}
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "backup %v of keyspace backup %v not found in %v", sbm.BackupName, KeyspaceBackupName(params.StartTime), GetBackupDir(params.Keyspace, params.Shard))
+	}
+	return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "keyspace backup %v has no backup of shard %v", KeyspaceBackupName(params.StartTime), params.Shard)
+}
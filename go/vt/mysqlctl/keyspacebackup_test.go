@@ -0,0 +1,164 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+// addTestBackup stores a backup that only contains a MANIFEST.
+func addTestBackup(t *testing.T, bs backupstorage.BackupStorage, dir, name string, manifest interface{}) {
+	ctx := context.Background()
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wc, err := bh.AddFile(ctx, backupManifestFileName, int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wc.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := wc.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKeyspaceBackup(t *testing.T) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "keyspacebackuptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+	bs := &filebackupstorage.FileBackupStorage{}
+
+	pos1, err := mysql.DecodePosition("MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pos2, err := mysql.DecodePosition("MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-12")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two backups of -80 taken by the same tablet: the keyspace
+	// backup refers to the first one.
+	addTestBackup(t, bs, "ks/-80", "2019-10-01.120001.cell1-0000000101", &BackupManifest{Position: pos1, BackupTime: "2019-10-01T12:00:01Z"})
+	addTestBackup(t, bs, "ks/-80", "2019-10-01.120101.cell1-0000000101", &BackupManifest{Position: pos2, BackupTime: "2019-10-01T12:01:01Z"})
+	addTestBackup(t, bs, "ks/80-", "2019-10-01.120002.cell1-0000000201", &BackupManifest{Position: pos1, BackupTime: "2019-10-01T12:00:02Z"})
+
+	bh, err := FindShardBackup(ctx, bs, "ks", "-80", "cell1-0000000101", pos1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := bh.Name(), "2019-10-01.120001.cell1-0000000101"; got != want {
+		t.Errorf("FindShardBackup: %v, want %v", got, want)
+	}
+	if _, err := FindShardBackup(ctx, bs, "ks", "-80", "cell1-0000000102", pos1); err == nil {
+		t.Errorf("FindShardBackup for another tablet succeeded")
+	}
+
+	manifest := &KeyspaceBackupManifest{
+		Keyspace:   "ks",
+		BackupTime: "2019-10-01T12:00:00Z",
+		Shards: []*ShardBackupManifest{{
+			Shard:       "-80",
+			TabletAlias: "cell1-0000000101",
+			BackupName:  "2019-10-01.120001.cell1-0000000101",
+			Position:    pos1,
+		}, {
+			Shard:       "80-",
+			TabletAlias: "cell1-0000000201",
+			BackupName:  "2019-10-01.120002.cell1-0000000201",
+			Position:    pos1,
+		}},
+	}
+	name, err := WriteKeyspaceBackupManifest(ctx, bs, manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2019-10-01.120000"; name != want {
+		t.Errorf("WriteKeyspaceBackupManifest: %v, want %v", name, want)
+	}
+
+	names, err := ListKeyspaceBackups(ctx, bs, "ks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{name}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListKeyspaceBackups: %v, want %v", names, want)
+	}
+	got, err := ReadKeyspaceBackupManifest(ctx, bs, "ks", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, manifest) {
+		t.Errorf("ReadKeyspaceBackupManifest: %+v, want %+v", got, manifest)
+	}
+	if _, err := ReadKeyspaceBackupManifest(ctx, bs, "ks", "2019-10-01.130000"); err != ErrNoBackup {
+		t.Errorf("ReadKeyspaceBackupManifest: %v, want %v", err, ErrNoBackup)
+	}
+
+	// Restoring at the time of the keyspace backup selects the
+	// backup it refers to, even though it was taken later.
+	bhs, err := bs.ListBackups(ctx, "ks/-80")
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := RestoreParams{
+		Logger:    logutil.NewMemoryLogger(),
+		Keyspace:  "ks",
+		Shard:     "-80",
+		StartTime: time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+	bh, err = keyspaceBackupToRestore(ctx, bs, params, bhs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bh == nil || bh.Name() != "2019-10-01.120001.cell1-0000000101" {
+		t.Errorf("keyspaceBackupToRestore: %v, want 2019-10-01.120001.cell1-0000000101", bh)
+	}
+
+	// Without a keyspace backup at that time, nothing is selected.
+	params.StartTime = time.Date(2019, 10, 1, 13, 0, 0, 0, time.UTC)
+	bh, err = keyspaceBackupToRestore(ctx, bs, params, bhs)
+	if err != nil || bh != nil {
+		t.Errorf("keyspaceBackupToRestore: %v, %v, want nil", bh, err)
+	}
+}
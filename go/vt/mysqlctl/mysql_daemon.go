@@ -46,6 +46,7 @@ type MysqlDaemon interface {
 	StopSlave(hookExtraEnv map[string]string) error
 	SlaveStatus() (mysql.SlaveStatus, error)
 	SetSemiSyncEnabled(master, slave bool) error
+	SetSemiSyncAckers(ackers int) error
 	SemiSyncEnabled() (master, slave bool)
 	SemiSyncSlaveStatus() (bool, error)
 
@@ -88,6 +89,9 @@ type MysqlDaemon interface {
 	// FetchSuperQuery executes one query, returns the result
 	FetchSuperQuery(ctx context.Context, query string) (*sqltypes.Result, error)
 
+	// ApplyBinlogFile replays all the events of a binlog file
+	ApplyBinlogFile(ctx context.Context, binlogFile string) error
+
 	// EnableBinlogPlayback enables playback of binlog events
 	EnableBinlogPlayback() error
 
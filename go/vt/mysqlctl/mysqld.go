@@ -1036,6 +1036,39 @@ func (mysqld *Mysqld) executeMysqlScript(connParams *mysql.ConnParams, sql io.Re
 	return nil
 }
 
+// ApplyBinlogFile is part of the MysqlDaemon interface.
+// It pipes the output of the mysqlbinlog tool into the mysql command
+// line tool, connected as the dba user.
+func (mysqld *Mysqld) ApplyBinlogFile(ctx context.Context, binlogFile string) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	name, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, name, binlogFile)
+	cmd.Env = []string{
+		"LD_LIBRARY_PATH=" + path.Join(dir, "lib/mysql"),
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	log.Infof("ApplyBinlogFile: %v %v", name, binlogFile)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	scriptErr := mysqld.executeMysqlScript(mysqld.dbcfgs.Dba(), stdout)
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("%v: %v, output: %v", name, err, stderr.String())
+	}
+	return scriptErr
+}
+
 // defaultsExtraFile returns the filename for a temporary config file
 // that contains the user, password and socket file to connect to
 // mysqld.  We write a temporary config file so the password is never
@@ -408,7 +408,24 @@ func (mysqld *Mysqld) SetSemiSyncEnabled(master, slave bool) error {
 		"SET GLOBAL rpl_semi_sync_master_enabled = %v, GLOBAL rpl_semi_sync_slave_enabled = %v",
 		m, s))
 	if err != nil {
-		return fmt.Errorf("can't set semi-sync mode: %v; make sure plugins are loaded in my.cnf", err)
+		return fmt.Errorf("can't set semi-sync mode: %v; make sure semi-sync is available: plugins loaded in my.cnf, or built into MariaDB 10.3.3 and later", err)
+	}
+	return nil
+}
+
+// SetSemiSyncAckers sets the number of slave acks the master waits for
+// before committing. MariaDB doesn't have rpl_semi_sync_master_wait_for_slave_count,
+// it always waits for a single ack.
+func (mysqld *Mysqld) SetSemiSyncAckers(ackers int) error {
+	if mysqld.capabilities.isMariaDB() {
+		if ackers > 1 {
+			return fmt.Errorf("can't wait for %v semi-sync acks: MariaDB only waits for one", ackers)
+		}
+		return nil
+	}
+	log.Infof("Setting semi-sync ackers: %v", ackers)
+	if err := mysqld.ExecuteSuperQuery(context.TODO(), fmt.Sprintf("SET GLOBAL rpl_semi_sync_master_wait_for_slave_count = %v", ackers)); err != nil {
+		return fmt.Errorf("can't set the number of semi-sync ackers: %v", err)
 	}
 	return nil
 }
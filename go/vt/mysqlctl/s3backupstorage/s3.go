@@ -65,6 +65,10 @@ var (
 	// forcePath is used to ensure that the certificate and path used match the endpoint + region
 	forcePath = flag.Bool("s3_backup_force_path_style", false, "force the s3 path style")
 
+	// disableSSL lets S3-compatible object stores, like minio, be used
+	// over plain HTTP.
+	disableSSL = flag.Bool("s3_backup_aws_disable_ssl", false, "connect to -s3_backup_aws_endpoint over HTTP instead of HTTPS, for S3-compatible stores such as minio")
+
 	tlsSkipVerifyCert = flag.Bool("s3_backup_tls_skip_verify_cert", false, "skip the 'certificate is valid' check for SSL connections")
 
 	// verboseLogging provides more verbose logging of AWS actions
@@ -73,6 +77,15 @@ var (
 	// sse is the server-side encryption algorithm used when storing this object in S3
 	sse = flag.String("s3_backup_server_side_encryption", "", "server-side encryption algorithm (e.g., AES256, aws:kms)")
 
+	// sseKMSKeyID is the KMS key used when sse is aws:kms
+	sseKMSKeyID = flag.String("s3_backup_server_side_encryption_kms_key_id", "", "KMS key ID to use with -s3_backup_server_side_encryption=aws:kms, if not the default key of the bucket")
+
+	// uploadPartSize is the size of the parts of multipart uploads
+	uploadPartSize = flag.Int64("s3_backup_upload_part_size", s3manager.DefaultUploadPartSize, "minimum size in bytes of each part of a multipart upload. It is increased for files too large to fit in the maximum number of parts.")
+
+	// uploadConcurrency is the number of parts of a file uploaded in parallel
+	uploadConcurrency = flag.Int("s3_backup_upload_concurrency", s3manager.DefaultUploadConcurrency, "number of parts of each file uploaded in parallel. Each of them buffers one part in memory.")
+
 	// path component delimiter
 	delimiter = "/"
 )
@@ -108,17 +121,7 @@ func (bh *S3BackupHandle) AddFile(ctx context.Context, filename string, filesize
 		return nil, fmt.Errorf("AddFile cannot be called on read-only backup")
 	}
 
-	// Calculate s3 upload part size using the source filesize
-	partSizeBytes := s3manager.DefaultUploadPartSize
-	if filesize > 0 {
-		minimumPartSize := float64(filesize) / float64(s3manager.MaxUploadParts)
-		// Round up to ensure large enough partsize
-		calculatedPartSizeBytes := int64(math.Ceil(minimumPartSize))
-		if calculatedPartSizeBytes > partSizeBytes {
-			partSizeBytes = calculatedPartSizeBytes
-		}
-	}
-
+	partSizeBytes := partSize(filesize)
 	reader, writer := io.Pipe()
 	bh.waitGroup.Add(1)
 
@@ -126,19 +129,24 @@ func (bh *S3BackupHandle) AddFile(ctx context.Context, filename string, filesize
 		defer bh.waitGroup.Done()
 		uploader := s3manager.NewUploaderWithClient(bh.client, func(u *s3manager.Uploader) {
 			u.PartSize = partSizeBytes
+			u.Concurrency = *uploadConcurrency
 		})
 		object := objName(bh.dir, bh.name, filename)
 
-		var sseOption *string
+		input := &s3manager.UploadInput{
+			Bucket: bucket,
+			Key:    object,
+			Body:   reader,
+		}
 		if *sse != "" {
-			sseOption = sse
+			input.ServerSideEncryption = sse
+			if *sseKMSKeyID != "" {
+				input.SSEKMSKeyId = sseKMSKeyID
+			}
 		}
-		_, err := uploader.Upload(&s3manager.UploadInput{
-			Bucket:               bucket,
-			Key:                  object,
-			Body:                 reader,
-			ServerSideEncryption: sseOption,
-		})
+		// The uploader aborts the multipart upload on failure, so no
+		// incomplete parts are left behind in the bucket.
+		_, err := uploader.Upload(input)
 		if err != nil {
 			reader.CloseWithError(err)
 			bh.errors.RecordError(err)
@@ -148,6 +156,25 @@ func (bh *S3BackupHandle) AddFile(ctx context.Context, filename string, filesize
 	return writer, nil
 }
 
+// partSize returns the part size to use for the multipart upload of a
+// file. Files of unknown size use the configured part size, and larger
+// parts are used for files that wouldn't fit in s3manager.MaxUploadParts.
+func partSize(filesize int64) int64 {
+	partSizeBytes := *uploadPartSize
+	if partSizeBytes < s3manager.MinUploadPartSize {
+		partSizeBytes = s3manager.MinUploadPartSize
+	}
+	if filesize > 0 {
+		minimumPartSize := float64(filesize) / float64(s3manager.MaxUploadParts)
+		// Round up to ensure large enough partsize
+		calculatedPartSizeBytes := int64(math.Ceil(minimumPartSize))
+		if calculatedPartSizeBytes > partSizeBytes {
+			partSizeBytes = calculatedPartSizeBytes
+		}
+	}
+	return partSizeBytes
+}
+
 // EndBackup is part of the backupstorage.BackupHandle interface.
 func (bh *S3BackupHandle) EndBackup(ctx context.Context) error {
 	if bh.readOnly {
@@ -350,6 +377,7 @@ func (bs *S3BackupStorage) client() (*s3.S3, error) {
 			Endpoint:         aws.String(*endpoint),
 			Region:           aws.String(*region),
 			S3ForcePathStyle: aws.Bool(*forcePath),
+			DisableSSL:       aws.Bool(*disableSSL),
 		}
 
 		if *retryCount >= 0 {
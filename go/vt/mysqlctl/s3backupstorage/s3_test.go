@@ -0,0 +1,57 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3backupstorage
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+func TestPartSize(t *testing.T) {
+	defer func(saved int64) { *uploadPartSize = saved }(*uploadPartSize)
+
+	testcases := []struct {
+		configured int64
+		filesize   int64
+		want       int64
+	}{{
+		configured: s3manager.DefaultUploadPartSize,
+		filesize:   -1,
+		want:       s3manager.DefaultUploadPartSize,
+	}, {
+		// Parts can't be smaller than the S3 minimum.
+		configured: 1024,
+		filesize:   1024 * 1024,
+		want:       s3manager.MinUploadPartSize,
+	}, {
+		configured: 64 * 1024 * 1024,
+		filesize:   1024 * 1024 * 1024,
+		want:       64 * 1024 * 1024,
+	}, {
+		// Files that don't fit in MaxUploadParts parts get larger parts.
+		configured: s3manager.DefaultUploadPartSize,
+		filesize:   s3manager.MaxUploadParts*s3manager.DefaultUploadPartSize + 1,
+		want:       s3manager.DefaultUploadPartSize + 1,
+	}}
+	for _, tc := range testcases {
+		*uploadPartSize = tc.configured
+		if got := partSize(tc.filesize); got != tc.want {
+			t.Errorf("partSize(%v) with -s3_backup_upload_part_size=%v: %v, want %v", tc.filesize, tc.configured, got, tc.want)
+		}
+	}
+}
@@ -51,6 +51,22 @@ func TestFindReplicationPosition(t *testing.T) {
 	}
 }
 
+func TestFindReplicationPositionMariaDB(t *testing.T) {
+	// mariabackup reports all the domains of gtid_binlog_pos, in any order.
+	input := `MySQL binlog position: filename 'vt-0476396352-bin.000005', position '310088991', GTID of the last change '1-2-5,
+	0-1-10'
+	191016 00:16:14 completed OK!`
+	want := "0-1-10,1-2-5"
+
+	pos, err := findReplicationPosition(input, "MariaDB", logutil.NewConsoleLogger())
+	if err != nil {
+		t.Fatalf("findReplicationPosition error: %v", err)
+	}
+	if got := pos.String(); got != want {
+		t.Errorf("findReplicationPosition() = %v; want %v", got, want)
+	}
+}
+
 func TestFindReplicationPositionNoMatch(t *testing.T) {
 	// Make sure failure to find a match triggers an error.
 	input := `nothing`
@@ -604,7 +604,10 @@ type Rule struct {
 	// filter can be an empty string or keyrange if the match
 	// is a regular expression. Otherwise, it must be a select
 	// query.
-	Filter               string   `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// history is set if the target table keeps all the versions
+	// of the rows of the source table.
+	History              bool     `protobuf:"varint,3,opt,name=history,proto3" json:"history,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -649,6 +652,13 @@ func (m *Rule) GetFilter() string {
 	return ""
 }
 
+func (m *Rule) GetHistory() bool {
+	if m != nil {
+		return m.History
+	}
+	return false
+}
+
 // Filter represents a list of ordered rules. First match
 // wins.
 type Filter struct {
@@ -1685,108 +1695,108 @@ func init() {
 func init() { proto.RegisterFile("binlogdata.proto", fileDescriptor_5fd02bcb2e350dad) }
 
 var fileDescriptor_5fd02bcb2e350dad = []byte{
-	// 1648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x73, 0xe3, 0x4a,
-	0x15, 0x8e, 0x6c, 0xf9, 0x75, 0x94, 0x38, 0x4a, 0xe7, 0x81, 0x49, 0x71, 0xa9, 0x5c, 0x15, 0x97,
-	0xe4, 0xa6, 0x0a, 0x07, 0x0c, 0x0c, 0xab, 0xcb, 0xc5, 0x0f, 0x25, 0x71, 0x22, 0xdb, 0x99, 0xb6,
-	0x92, 0xa1, 0x66, 0xa3, 0x52, 0xec, 0x76, 0x22, 0x22, 0x4b, 0x1e, 0xa9, 0x9d, 0x90, 0x1f, 0x40,
-	0xf1, 0x03, 0xd8, 0xf2, 0x07, 0x58, 0xb3, 0x85, 0x2d, 0x7b, 0xf6, 0x54, 0xb1, 0xe2, 0x7f, 0x50,
-	0xfd, 0x90, 0x6c, 0x25, 0xc3, 0x4c, 0x66, 0xaa, 0x58, 0xc0, 0xc6, 0x75, 0xfa, 0xf4, 0x39, 0xa7,
-	0xcf, 0xf9, 0xce, 0xa3, 0xd5, 0x06, 0xfd, 0xda, 0x0b, 0xfc, 0xf0, 0x66, 0xec, 0x52, 0xb7, 0x3e,
-	0x8b, 0x42, 0x1a, 0x22, 0x58, 0x70, 0x76, 0xb5, 0x7b, 0x1a, 0xcd, 0x46, 0x62, 0x63, 0x57, 0x7b,
-	0x37, 0x27, 0xd1, 0xa3, 0x5c, 0x54, 0x69, 0x38, 0x0b, 0x17, 0x5a, 0x46, 0x0f, 0x4a, 0xed, 0x5b,
-	0x37, 0x8a, 0x09, 0x45, 0x3b, 0x50, 0x1c, 0xf9, 0x1e, 0x09, 0x68, 0x4d, 0xd9, 0x53, 0x0e, 0x0a,
-	0x58, 0xae, 0x10, 0x02, 0x75, 0x14, 0x06, 0x41, 0x2d, 0xc7, 0xb9, 0x9c, 0x66, 0xb2, 0x31, 0x89,
-	0xee, 0x49, 0x54, 0xcb, 0x0b, 0x59, 0xb1, 0x32, 0xfe, 0x95, 0x87, 0x8d, 0x16, 0xf7, 0xc3, 0x8e,
-	0xdc, 0x20, 0x76, 0x47, 0xd4, 0x0b, 0x03, 0x74, 0x02, 0x10, 0x53, 0x97, 0x92, 0x29, 0x09, 0x68,
-	0x5c, 0x53, 0xf6, 0xf2, 0x07, 0x5a, 0x63, 0xbf, 0xbe, 0x14, 0xc1, 0x33, 0x95, 0xfa, 0x30, 0x91,
-	0xc7, 0x4b, 0xaa, 0xa8, 0x01, 0x1a, 0xb9, 0x27, 0x01, 0x75, 0x68, 0x78, 0x47, 0x82, 0x9a, 0xba,
-	0xa7, 0x1c, 0x68, 0x8d, 0x8d, 0xba, 0x08, 0xd0, 0x64, 0x3b, 0x36, 0xdb, 0xc0, 0x40, 0x52, 0x7a,
-	0xf7, 0x6f, 0x39, 0xa8, 0xa4, 0xd6, 0x90, 0x05, 0xe5, 0x91, 0x4b, 0xc9, 0x4d, 0x18, 0x3d, 0xf2,
-	0x30, 0xab, 0x8d, 0x1f, 0xbf, 0xd0, 0x91, 0x7a, 0x5b, 0xea, 0xe1, 0xd4, 0x02, 0xfa, 0x11, 0x94,
-	0x46, 0x02, 0x3d, 0x8e, 0x8e, 0xd6, 0xd8, 0x5c, 0x36, 0x26, 0x81, 0xc5, 0x89, 0x0c, 0xd2, 0x21,
-	0x1f, 0xbf, 0xf3, 0x39, 0x64, 0xab, 0x98, 0x91, 0xc6, 0x9f, 0x14, 0x28, 0x27, 0x76, 0xd1, 0x26,
-	0xac, 0xb7, 0x2c, 0xe7, 0xb2, 0x8f, 0xcd, 0xf6, 0xe0, 0xa4, 0xdf, 0x7d, 0x6b, 0x76, 0xf4, 0x15,
-	0xb4, 0x0a, 0xe5, 0x96, 0xe5, 0xb4, 0xcc, 0x93, 0x6e, 0x5f, 0x57, 0xd0, 0x1a, 0x54, 0x5a, 0x96,
-	0xd3, 0x1e, 0xf4, 0x7a, 0x5d, 0x5b, 0xcf, 0xa1, 0x75, 0xd0, 0x5a, 0x96, 0x83, 0x07, 0x96, 0xd5,
-	0x6a, 0xb6, 0xcf, 0xf5, 0x3c, 0xda, 0x86, 0x8d, 0x96, 0xe5, 0x74, 0x7a, 0x96, 0xd3, 0x31, 0x2f,
-	0xb0, 0xd9, 0x6e, 0xda, 0x66, 0x47, 0x57, 0x11, 0x40, 0x91, 0xb1, 0x3b, 0x96, 0x5e, 0x90, 0xf4,
-	0xd0, 0xb4, 0xf5, 0xa2, 0x34, 0xd7, 0xed, 0x0f, 0x4d, 0x6c, 0xeb, 0x25, 0xb9, 0xbc, 0xbc, 0xe8,
-	0x34, 0x6d, 0x53, 0x2f, 0xcb, 0x65, 0xc7, 0xb4, 0x4c, 0xdb, 0xd4, 0x2b, 0x67, 0x6a, 0x39, 0xa7,
-	0xe7, 0xcf, 0xd4, 0x72, 0x5e, 0x57, 0x8d, 0x3f, 0x28, 0xb0, 0x3d, 0xa4, 0x11, 0x71, 0xa7, 0xe7,
-	0xe4, 0x11, 0xbb, 0xc1, 0x0d, 0xc1, 0xe4, 0xdd, 0x9c, 0xc4, 0x14, 0xed, 0x42, 0x79, 0x16, 0xc6,
-	0x1e, 0xc3, 0x8e, 0x03, 0x5c, 0xc1, 0xe9, 0x1a, 0x1d, 0x41, 0xe5, 0x8e, 0x3c, 0x3a, 0x11, 0x93,
-	0x97, 0x80, 0xa1, 0x7a, 0x5a, 0x90, 0xa9, 0xa5, 0xf2, 0x9d, 0xa4, 0x96, 0xf1, 0xcd, 0x7f, 0x1c,
-	0x5f, 0x63, 0x02, 0x3b, 0x4f, 0x9d, 0x8a, 0x67, 0x61, 0x10, 0x13, 0x64, 0x01, 0x12, 0x8a, 0x0e,
-	0x5d, 0xe4, 0x96, 0xfb, 0xa7, 0x35, 0xbe, 0xf8, 0x60, 0x01, 0xe0, 0x8d, 0xeb, 0xa7, 0x2c, 0xe3,
-	0xb7, 0xb0, 0x29, 0xce, 0xb1, 0xdd, 0x6b, 0x9f, 0xc4, 0x2f, 0x09, 0x7d, 0x07, 0x8a, 0x94, 0x0b,
-	0xd7, 0x72, 0x7b, 0xf9, 0x83, 0x0a, 0x96, 0xab, 0x4f, 0x8d, 0x70, 0x0c, 0x5b, 0xd9, 0x93, 0xff,
-	0x2b, 0xf1, 0xfd, 0x0c, 0x54, 0x3c, 0xf7, 0x09, 0xda, 0x82, 0xc2, 0xd4, 0xa5, 0xa3, 0x5b, 0x19,
-	0x8d, 0x58, 0xb0, 0x50, 0x26, 0x9e, 0x4f, 0x49, 0xc4, 0x53, 0x58, 0xc1, 0x72, 0x65, 0xfc, 0x59,
-	0x81, 0xe2, 0x31, 0x27, 0xd1, 0x0f, 0xa1, 0x10, 0xcd, 0x59, 0xb0, 0xa2, 0xd7, 0xf5, 0x65, 0x0f,
-	0x98, 0x65, 0x2c, 0xb6, 0x51, 0x17, 0xaa, 0x13, 0x8f, 0xf8, 0x63, 0xde, 0xba, 0xbd, 0x70, 0x2c,
-	0xaa, 0xa2, 0xda, 0xf8, 0x72, 0x59, 0x41, 0xd8, 0xac, 0x1f, 0x67, 0x04, 0xf1, 0x13, 0x45, 0xe3,
-	0x15, 0x54, 0xb3, 0x12, 0xac, 0x9d, 0x4c, 0x8c, 0x9d, 0x41, 0xdf, 0xe9, 0x75, 0x87, 0xbd, 0xa6,
-	0xdd, 0x3e, 0xd5, 0x57, 0x78, 0xc7, 0x98, 0x43, 0xdb, 0x31, 0x8f, 0x8f, 0x07, 0xd8, 0xd6, 0x15,
-	0xe3, 0x8f, 0x39, 0x58, 0x15, 0xa0, 0x0c, 0xc3, 0x79, 0x34, 0x22, 0x2c, 0x8b, 0x77, 0xe4, 0x31,
-	0x9e, 0xb9, 0x23, 0x92, 0x64, 0x31, 0x59, 0x33, 0x40, 0xe2, 0x5b, 0x37, 0x1a, 0xcb, 0xc8, 0xc5,
-	0x02, 0xfd, 0x1c, 0x34, 0x9e, 0x4d, 0xea, 0xd0, 0xc7, 0x19, 0xe1, 0x79, 0xac, 0x36, 0xb6, 0x16,
-	0x85, 0xcd, 0x73, 0x45, 0xed, 0xc7, 0x19, 0xc1, 0x40, 0x53, 0x3a, 0xdb, 0x0d, 0xea, 0x0b, 0xba,
-	0x61, 0x51, 0x43, 0x85, 0x4c, 0x0d, 0x1d, 0xa6, 0x09, 0x29, 0x4a, 0x2b, 0xcf, 0xd0, 0x4b, 0x92,
-	0x84, 0xea, 0x50, 0x0c, 0x03, 0x67, 0x3c, 0xf6, 0x6b, 0x25, 0xee, 0xe6, 0x77, 0x96, 0x65, 0x07,
-	0x41, 0xa7, 0x63, 0x35, 0x45, 0x59, 0x14, 0xc2, 0xa0, 0x33, 0xf6, 0x8d, 0xd7, 0x50, 0xc1, 0xe1,
-	0x43, 0xfb, 0x96, 0x3b, 0x60, 0x40, 0xf1, 0x9a, 0x4c, 0xc2, 0x88, 0xc8, 0xca, 0x02, 0x39, 0x79,
-	0x71, 0xf8, 0x80, 0xe5, 0x0e, 0xda, 0x83, 0x82, 0x3b, 0x49, 0x8a, 0x23, 0x2b, 0x22, 0x36, 0x0c,
-	0x17, 0xca, 0x38, 0x7c, 0xe0, 0x79, 0x42, 0x5f, 0x80, 0x40, 0xc4, 0x09, 0xdc, 0x69, 0x02, 0x77,
-	0x85, 0x73, 0xfa, 0xee, 0x94, 0xa0, 0x57, 0xa0, 0x45, 0xe1, 0x83, 0x33, 0xe2, 0xc7, 0x8b, 0xd6,
-	0xd1, 0x1a, 0xdb, 0x99, 0x6a, 0x4a, 0x9c, 0xc3, 0x10, 0x25, 0x64, 0x6c, 0xbc, 0x06, 0x58, 0x14,
-	0xc3, 0xc7, 0x0e, 0xf9, 0x01, 0x83, 0x8f, 0xf8, 0xe3, 0xc4, 0xfe, 0xaa, 0x74, 0x99, 0x5b, 0xc0,
-	0x72, 0x8f, 0x01, 0x31, 0x64, 0xd9, 0x3e, 0xa1, 0xde, 0xf8, 0x33, 0x6a, 0x04, 0x81, 0x7a, 0x43,
-	0xbd, 0x31, 0x2f, 0x8e, 0x0a, 0xe6, 0xb4, 0xf1, 0x2d, 0x14, 0xae, 0xb8, 0xb9, 0x57, 0xa0, 0x71,
-	0x29, 0x87, 0xb1, 0x93, 0xa6, 0xc9, 0x84, 0x99, 0x1e, 0x8d, 0x21, 0x4e, 0xc8, 0xd8, 0x68, 0xc2,
-	0xda, 0xb9, 0x3c, 0x96, 0x0b, 0x7c, 0xba, 0x5f, 0xc6, 0x5f, 0x72, 0x50, 0x3a, 0x0b, 0xe7, 0x51,
-	0xe0, 0xfa, 0xa8, 0x0a, 0x39, 0x6f, 0xcc, 0xf5, 0xf2, 0x38, 0xe7, 0x8d, 0xd1, 0xaf, 0xa0, 0x3a,
-	0xf5, 0x6e, 0x22, 0x97, 0xd5, 0x83, 0x28, 0x6d, 0xd1, 0x9d, 0xdf, 0x5d, 0xf6, 0xac, 0x97, 0x48,
-	0xf0, 0xfa, 0x5e, 0x9b, 0x2e, 0x2f, 0x97, 0x2a, 0x36, 0x9f, 0xa9, 0xd8, 0xaf, 0xa0, 0xea, 0x87,
-	0x23, 0xd7, 0x77, 0xd2, 0x79, 0xa9, 0x72, 0xa7, 0xd6, 0x38, 0xf7, 0x22, 0x19, 0x9a, 0x4f, 0x70,
-	0x29, 0xbc, 0x10, 0x17, 0xf4, 0x0d, 0xac, 0xce, 0xdc, 0x88, 0x7a, 0x23, 0x6f, 0xe6, 0xb2, 0x2f,
-	0x8e, 0x22, 0x57, 0xcc, 0xb8, 0x9d, 0xc1, 0x0d, 0x67, 0xc4, 0xd1, 0xd7, 0xa0, 0xc7, 0x7c, 0x16,
-	0x38, 0x0f, 0x61, 0x74, 0x37, 0xf1, 0xc3, 0x87, 0xb8, 0x56, 0xe2, 0xfe, 0xaf, 0x0b, 0xfe, 0x9b,
-	0x84, 0x6d, 0xfc, 0x33, 0x07, 0xc5, 0x2b, 0x51, 0x65, 0x87, 0xa0, 0x72, 0x8c, 0xc4, 0x57, 0xc5,
-	0xce, 0xf2, 0x61, 0x42, 0x82, 0x03, 0xc4, 0x65, 0xd0, 0xf7, 0xa0, 0x42, 0xbd, 0x29, 0x89, 0xa9,
-	0x3b, 0x9d, 0x71, 0x50, 0xf3, 0x78, 0xc1, 0x78, 0x5f, 0xad, 0xb0, 0x4f, 0x07, 0xd6, 0xb4, 0x02,
-	0x26, 0x46, 0xa2, 0x9f, 0x40, 0x85, 0xf5, 0x06, 0xff, 0xd2, 0xa9, 0x15, 0x78, 0xb3, 0x6d, 0x3d,
-	0xe9, 0x0c, 0x7e, 0x2c, 0x2e, 0x47, 0x49, 0xb7, 0xfd, 0x02, 0x34, 0x5e, 0xcd, 0x52, 0x49, 0x4c,
-	0x8b, 0x9d, 0xec, 0xb4, 0x48, 0xba, 0x06, 0xc3, 0x62, 0xc0, 0xa2, 0x7d, 0x28, 0xdc, 0x73, 0x97,
-	0x4a, 0xf2, 0x8b, 0x6b, 0x39, 0x38, 0x0e, 0xbf, 0xd8, 0x67, 0xd7, 0xd9, 0x6f, 0x44, 0x35, 0xd5,
-	0xca, 0xcf, 0xaf, 0x33, 0x59, 0x68, 0x38, 0x91, 0x41, 0x5f, 0xc2, 0xea, 0x68, 0x1e, 0x45, 0xfc,
-	0x8b, 0xce, 0x9b, 0x92, 0xda, 0x16, 0x87, 0x42, 0x93, 0x3c, 0xdb, 0x9b, 0x12, 0xe3, 0xf7, 0x39,
-	0xa8, 0x5e, 0x89, 0x3b, 0x2f, 0xb9, 0x67, 0xbf, 0x85, 0x4d, 0x32, 0x99, 0x90, 0x11, 0xf5, 0xee,
-	0x89, 0x33, 0x72, 0x7d, 0x9f, 0x44, 0x8e, 0x2c, 0x5c, 0xad, 0xb1, 0x5e, 0x17, 0xdf, 0xbe, 0x6d,
-	0xce, 0xef, 0x76, 0xf0, 0x46, 0x2a, 0x2b, 0x59, 0x63, 0x64, 0xc2, 0xa6, 0x37, 0x9d, 0x92, 0xb1,
-	0xe7, 0xd2, 0x65, 0x03, 0x62, 0x62, 0x6d, 0xcb, 0xf6, 0xbf, 0xb2, 0x4f, 0x5c, 0x4a, 0x16, 0x66,
-	0x52, 0x8d, 0xd4, 0xcc, 0x57, 0xac, 0xba, 0xa3, 0x9b, 0xf4, 0xea, 0x5e, 0x93, 0x9a, 0x36, 0x67,
-	0x62, 0xb9, 0x99, 0xf9, 0x2c, 0x50, 0x9f, 0x7c, 0x16, 0x2c, 0x46, 0x77, 0xe1, 0x63, 0xa3, 0xdb,
-	0xf8, 0x06, 0xd6, 0x53, 0x20, 0xe4, 0xb5, 0x7f, 0x08, 0x45, 0x9e, 0xca, 0x64, 0x66, 0xa0, 0xe7,
-	0x55, 0x87, 0xa5, 0x84, 0xf1, 0xbb, 0x1c, 0xa0, 0x44, 0x3f, 0x7c, 0x88, 0xff, 0x47, 0xc1, 0xdc,
-	0x82, 0x02, 0xe7, 0x4b, 0x24, 0xc5, 0x82, 0xe1, 0xe0, 0xbb, 0x31, 0x9d, 0xdd, 0xa5, 0x30, 0x0a,
-	0xe5, 0xd7, 0xec, 0x17, 0x93, 0x78, 0xee, 0x53, 0x2c, 0x25, 0x8c, 0xbf, 0x2a, 0xb0, 0x99, 0xc1,
-	0x41, 0x62, 0xb9, 0xb8, 0x06, 0x94, 0xff, 0x7c, 0x0d, 0xa0, 0x03, 0x28, 0xcf, 0xee, 0x3e, 0x70,
-	0x5d, 0xa4, 0xbb, 0xef, 0xed, 0xe2, 0xef, 0x83, 0x1a, 0xb1, 0x69, 0xa2, 0x72, 0xcd, 0xe5, 0xbb,
-	0x91, 0xf3, 0xd9, 0x05, 0x9b, 0x89, 0x23, 0x73, 0xc1, 0x4a, 0xff, 0xff, 0xa1, 0xc0, 0xf6, 0xa2,
-	0x0e, 0xe6, 0x3e, 0xfd, 0xbf, 0x4a, 0xa5, 0x11, 0xc1, 0xce, 0xd3, 0xe8, 0x3e, 0x29, 0x41, 0x9f,
-	0x01, 0xfb, 0xe1, 0x2f, 0x41, 0x5b, 0xfa, 0xf4, 0x61, 0x2f, 0xa4, 0xee, 0x49, 0x7f, 0x80, 0x4d,
-	0x7d, 0x05, 0x95, 0x41, 0x1d, 0xda, 0x83, 0x0b, 0x5d, 0x61, 0x94, 0xf9, 0x6b, 0xb3, 0x2d, 0x5e,
-	0x5d, 0x8c, 0x72, 0xa4, 0x50, 0xfe, 0xf0, 0xef, 0x0a, 0xc0, 0x62, 0xc6, 0x23, 0x0d, 0x4a, 0x97,
-	0xfd, 0xf3, 0xfe, 0xe0, 0x4d, 0x5f, 0x18, 0x38, 0xb1, 0xbb, 0x1d, 0x5d, 0x41, 0x15, 0x28, 0x88,
-	0x67, 0x5c, 0x8e, 0x9d, 0x20, 0xdf, 0x70, 0x79, 0xf6, 0xc0, 0x4b, 0x1f, 0x70, 0x2a, 0x2a, 0x41,
-	0x3e, 0x7d, 0xa6, 0xc9, 0x77, 0x59, 0x91, 0x19, 0xc4, 0xe6, 0x85, 0xd5, 0x6c, 0x9b, 0x7a, 0x89,
-	0x6d, 0xa4, 0x2f, 0x34, 0x80, 0x62, 0xf2, 0x3c, 0x63, 0x9a, 0xec, 0x51, 0x07, 0xec, 0x9c, 0x81,
-	0x7d, 0x6a, 0x62, 0x5d, 0x63, 0x3c, 0x3c, 0x78, 0xa3, 0xaf, 0x32, 0xde, 0x71, 0xd7, 0xb4, 0x3a,
-	0xfa, 0x1a, 0x7b, 0xd5, 0x9d, 0x9a, 0x4d, 0x6c, 0xb7, 0xcc, 0xa6, 0xad, 0x57, 0xd9, 0xce, 0x15,
-	0x77, 0x70, 0x9d, 0x1d, 0x73, 0x36, 0xb8, 0xc4, 0xfd, 0xa6, 0xa5, 0xeb, 0x87, 0xfb, 0xb0, 0x96,
-	0xb9, 0xda, 0xd9, 0x59, 0x76, 0xb3, 0x65, 0x99, 0x43, 0x7d, 0x85, 0xd1, 0xc3, 0xd3, 0x26, 0xee,
-	0x0c, 0x75, 0xa5, 0xf5, 0xf5, 0xdb, 0xfd, 0x7b, 0x8f, 0x92, 0x38, 0xae, 0x7b, 0xe1, 0x91, 0xa0,
-	0x8e, 0x6e, 0xc2, 0xa3, 0x7b, 0x7a, 0xc4, 0xff, 0x61, 0x38, 0x5a, 0x4c, 0xa4, 0xeb, 0x22, 0xe7,
-	0xfc, 0xf4, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x09, 0xf3, 0xd7, 0xbd, 0x10, 0x00, 0x00,
+	// 1636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdd, 0x58, 0xcd, 0x72, 0x1b, 0x45,
+	0x10, 0x46, 0xff, 0x52, 0xaf, 0x2d, 0xaf, 0xc7, 0x3f, 0x08, 0x17, 0xa1, 0x92, 0x2d, 0x82, 0x13,
+	0x57, 0x21, 0x83, 0x28, 0xc2, 0x29, 0x04, 0x59, 0x5a, 0xdb, 0x8a, 0x65, 0xc9, 0x19, 0xad, 0x1d,
+	0x2a, 0x97, 0xad, 0xb5, 0xb4, 0x72, 0x16, 0x4b, 0x5a, 0x65, 0x77, 0x6d, 0xe3, 0x07, 0xa0, 0x78,
+	0x00, 0xae, 0xbc, 0x00, 0x67, 0xae, 0x70, 0xe5, 0xce, 0x9d, 0x2a, 0x4e, 0xbc, 0x07, 0x3d, 0x3f,
+	0xbb, 0xda, 0xb5, 0x43, 0xe2, 0xa4, 0x8a, 0x03, 0x1c, 0x24, 0xf5, 0xf4, 0x74, 0xf7, 0x74, 0x7f,
+	0xd3, 0xdd, 0x33, 0x23, 0x50, 0x8f, 0x9d, 0xc9, 0xc8, 0x3d, 0x19, 0x58, 0x81, 0x55, 0x9d, 0x7a,
+	0x6e, 0xe0, 0x12, 0x98, 0x71, 0xd6, 0x94, 0xf3, 0xc0, 0x9b, 0xf6, 0xc5, 0xc4, 0x9a, 0xf2, 0xe2,
+	0xcc, 0xf6, 0x2e, 0xe5, 0xa0, 0x1c, 0xb8, 0x53, 0x77, 0xa6, 0xa5, 0xed, 0x43, 0xa1, 0xf1, 0xdc,
+	0xf2, 0x7c, 0x3b, 0x20, 0xab, 0x90, 0xef, 0x8f, 0x1c, 0x7b, 0x12, 0x54, 0x52, 0xb7, 0x53, 0xf7,
+	0x72, 0x54, 0x8e, 0x08, 0x81, 0x6c, 0xdf, 0x9d, 0x4c, 0x2a, 0x69, 0xce, 0xe5, 0x34, 0x93, 0xf5,
+	0x6d, 0xef, 0xdc, 0xf6, 0x2a, 0x19, 0x21, 0x2b, 0x46, 0xda, 0x5f, 0x19, 0x58, 0xdc, 0xe2, 0x7e,
+	0x18, 0x9e, 0x35, 0xf1, 0xad, 0x7e, 0xe0, 0xb8, 0x13, 0xb2, 0x03, 0xe0, 0x07, 0x56, 0x60, 0x8f,
+	0xd1, 0x9c, 0x8f, 0xd6, 0x33, 0xf7, 0x94, 0xda, 0x7a, 0x35, 0x16, 0xc1, 0x35, 0x95, 0x6a, 0x2f,
+	0x94, 0xa7, 0x31, 0x55, 0x52, 0x03, 0xc5, 0x3e, 0x47, 0xca, 0x0c, 0xdc, 0x53, 0x7b, 0x52, 0xc9,
+	0xe2, 0xda, 0x4a, 0x6d, 0xb1, 0x2a, 0x02, 0xd4, 0xd9, 0x8c, 0xc1, 0x26, 0x28, 0xd8, 0x11, 0xbd,
+	0xf6, 0x5b, 0x1a, 0x4a, 0x91, 0x35, 0xd2, 0x86, 0x62, 0x1f, 0xe9, 0x13, 0xd7, 0xbb, 0xe4, 0x61,
+	0x96, 0x6b, 0x9f, 0xdc, 0xd0, 0x91, 0x6a, 0x43, 0xea, 0xd1, 0xc8, 0x02, 0xf9, 0x18, 0x0a, 0x7d,
+	0x81, 0x1e, 0x47, 0x47, 0xa9, 0x2d, 0xc5, 0x8d, 0x49, 0x60, 0x69, 0x28, 0x43, 0x54, 0xc8, 0xf8,
+	0x2f, 0x46, 0x1c, 0xb2, 0x39, 0xca, 0x48, 0xed, 0xa7, 0x14, 0x14, 0x43, 0xbb, 0x64, 0x09, 0x16,
+	0xb6, 0xda, 0xe6, 0x61, 0x87, 0xea, 0x8d, 0xee, 0x4e, 0xa7, 0xf5, 0x4c, 0x6f, 0xaa, 0xef, 0x90,
+	0x39, 0x28, 0x22, 0x73, 0x4b, 0xdf, 0x69, 0x75, 0xd4, 0x14, 0x99, 0x87, 0x12, 0x8e, 0x1a, 0xdd,
+	0xfd, 0xfd, 0x96, 0xa1, 0xa6, 0xc9, 0x02, 0x28, 0x38, 0xa4, 0xdd, 0x76, 0x7b, 0xab, 0xde, 0xd8,
+	0x53, 0x33, 0x64, 0x05, 0xe1, 0x6f, 0x9b, 0xcd, 0x7d, 0xfc, 0xe8, 0x07, 0x68, 0xa7, 0x6e, 0xa0,
+	0x91, 0x2c, 0x01, 0xc8, 0x33, 0x76, 0xb3, 0xad, 0xe6, 0x24, 0xdd, 0xd3, 0x0d, 0x35, 0x2f, 0xcd,
+	0xb5, 0x3a, 0x3d, 0x9d, 0x1a, 0x6a, 0x41, 0x0e, 0x0f, 0x0f, 0x9a, 0xa8, 0xa6, 0x16, 0xe5, 0xb0,
+	0xa9, 0xb7, 0x75, 0x1c, 0x96, 0x1e, 0x67, 0x8b, 0x69, 0x35, 0x83, 0xdf, 0x19, 0x35, 0xab, 0xfd,
+	0x90, 0x82, 0x95, 0x5e, 0xe0, 0xd9, 0xd6, 0x78, 0xcf, 0xbe, 0xa4, 0xd6, 0xe4, 0xc4, 0xa6, 0x36,
+	0xee, 0x82, 0x1f, 0x90, 0x35, 0x28, 0x4e, 0x5d, 0xdf, 0x61, 0xd8, 0x71, 0x80, 0x4b, 0x34, 0x1a,
+	0x93, 0x4d, 0x28, 0x9d, 0xda, 0x97, 0xa6, 0xc7, 0xe4, 0x25, 0x60, 0xa4, 0x1a, 0x25, 0x64, 0x64,
+	0xa9, 0x78, 0x2a, 0xa9, 0x38, 0xbe, 0x99, 0xd7, 0xe3, 0xab, 0x0d, 0x61, 0xf5, 0xaa, 0x53, 0xfe,
+	0xd4, 0x9d, 0xf8, 0x36, 0x6e, 0x3b, 0x11, 0x8a, 0x66, 0x30, 0xdb, 0x5b, 0xee, 0x9f, 0x52, 0xbb,
+	0xf5, 0xca, 0x04, 0xa0, 0x8b, 0xc7, 0x57, 0x59, 0xda, 0xb7, 0xb0, 0x24, 0xd6, 0x31, 0xac, 0xe3,
+	0x91, 0xed, 0xdf, 0x24, 0x74, 0x2c, 0x98, 0x80, 0x0b, 0x63, 0xdc, 0x19, 0x9c, 0x91, 0xa3, 0x37,
+	0x8d, 0x70, 0x00, 0xcb, 0xc9, 0x95, 0xff, 0x95, 0xf8, 0x3a, 0x90, 0xa5, 0x67, 0x23, 0x9b, 0x2c,
+	0x43, 0x6e, 0x6c, 0x05, 0xfd, 0xe7, 0x32, 0x1a, 0x31, 0x60, 0xa1, 0x0c, 0x9d, 0x51, 0x80, 0xb5,
+	0x9f, 0xe6, 0x6c, 0x39, 0x22, 0x15, 0x28, 0x3c, 0x77, 0xfc, 0x80, 0x55, 0x16, 0x0b, 0xa5, 0x48,
+	0xc3, 0xa1, 0xf6, 0x73, 0x0a, 0xf2, 0xdb, 0x42, 0xe8, 0x23, 0xc8, 0x79, 0x67, 0x0c, 0x06, 0xd1,
+	0x05, 0xd4, 0xb8, 0x6f, 0x6c, 0x4d, 0x2a, 0xa6, 0x49, 0x0b, 0xca, 0x43, 0xc7, 0x1e, 0x0d, 0x78,
+	0x51, 0xef, 0xbb, 0x03, 0x91, 0x2f, 0xe5, 0xda, 0x9d, 0xb8, 0x82, 0xb0, 0x89, 0x3f, 0x71, 0x41,
+	0x7a, 0x45, 0x51, 0x7b, 0x00, 0xe5, 0xa4, 0x04, 0x2b, 0x34, 0x9d, 0x52, 0xb3, 0xdb, 0x31, 0xf7,
+	0x5b, 0xbd, 0xfd, 0xba, 0xd1, 0xd8, 0xc5, 0x42, 0x63, 0xb5, 0xa4, 0xf7, 0x0c, 0x53, 0xdf, 0xde,
+	0xee, 0x62, 0x35, 0xa4, 0xb4, 0x1f, 0xd3, 0x30, 0x27, 0xe0, 0xea, 0xb9, 0x67, 0x5e, 0xdf, 0x66,
+	0xfb, 0x8b, 0x99, 0xe9, 0x4f, 0xad, 0xbe, 0x1d, 0xee, 0x6f, 0x38, 0x66, 0x50, 0xf9, 0xb8, 0x47,
+	0x03, 0x89, 0x89, 0x18, 0x90, 0xcf, 0x41, 0xe1, 0xfb, 0x8c, 0x0d, 0xeb, 0x72, 0x6a, 0x73, 0x58,
+	0xca, 0xb5, 0xe5, 0x59, 0xca, 0xf3, 0x5d, 0x0c, 0x0c, 0x9c, 0xa3, 0x10, 0x44, 0x74, 0xb2, 0x4e,
+	0xb2, 0x37, 0xa8, 0x93, 0x59, 0x76, 0xe5, 0x12, 0xd9, 0xb5, 0x11, 0x6d, 0x55, 0x5e, 0x5a, 0xb9,
+	0x86, 0x5e, 0xb4, 0x7d, 0x55, 0xc8, 0xbb, 0x13, 0x73, 0x30, 0x18, 0x55, 0x0a, 0xdc, 0xcd, 0x77,
+	0xe3, 0xb2, 0xdd, 0x09, 0x36, 0x8f, 0xba, 0x48, 0x98, 0x9c, 0x3b, 0x69, 0x0e, 0x46, 0xda, 0x13,
+	0x28, 0x51, 0xf7, 0x02, 0x33, 0x94, 0x39, 0xa0, 0x41, 0xfe, 0xd8, 0x1e, 0xba, 0x9e, 0x2d, 0x73,
+	0x0e, 0x64, 0x4f, 0x46, 0x09, 0x2a, 0x67, 0xc8, 0x6d, 0xc8, 0x59, 0xc3, 0x30, 0x6d, 0x92, 0x22,
+	0x62, 0x42, 0xb3, 0xa0, 0x88, 0x23, 0xbe, 0x4f, 0xe4, 0x16, 0x08, 0x44, 0xcc, 0x89, 0x35, 0x0e,
+	0xe1, 0x2e, 0x71, 0x4e, 0x07, 0x19, 0xe4, 0x01, 0x28, 0x9e, 0x7b, 0x61, 0xf6, 0xf9, 0xf2, 0xa2,
+	0xa8, 0x94, 0xda, 0x4a, 0x22, 0x9b, 0x42, 0xe7, 0x28, 0x78, 0x21, 0xe9, 0xa3, 0xd7, 0x30, 0x4b,
+	0x86, 0xd7, 0x2d, 0xf2, 0x21, 0x83, 0x0f, 0x85, 0x43, 0xfb, 0x73, 0xd2, 0x65, 0x6e, 0x81, 0xca,
+	0x39, 0x06, 0x44, 0x8f, 0xed, 0xf6, 0x4e, 0xe0, 0x0c, 0xde, 0x22, 0x47, 0xf0, 0x78, 0x3d, 0x41,
+	0x4d, 0x9e, 0x1c, 0x25, 0xca, 0x69, 0xed, 0x11, 0xe4, 0x8e, 0xb8, 0x39, 0x0c, 0x93, 0x4b, 0x99,
+	0x8c, 0x1d, 0x16, 0x4d, 0x22, 0xcc, 0x68, 0x69, 0x3c, 0x28, 0x43, 0xd2, 0xd7, 0xea, 0x30, 0xbf,
+	0x27, 0x97, 0xe5, 0x02, 0x6f, 0xee, 0x97, 0xf6, 0x4b, 0x1a, 0x0a, 0x8f, 0x31, 0xf1, 0x27, 0xd6,
+	0x88, 0x94, 0x21, 0x8d, 0x1e, 0x32, 0xbd, 0x0c, 0x45, 0x8a, 0x7c, 0x05, 0xe5, 0xb1, 0x73, 0xe2,
+	0x59, 0x2c, 0x1f, 0x44, 0x6a, 0x8b, 0xea, 0x7c, 0x2f, 0xee, 0xd9, 0x7e, 0x28, 0xc1, 0xf3, 0x7b,
+	0x7e, 0x1c, 0x1f, 0xc6, 0x32, 0x36, 0x93, 0xc8, 0xd8, 0xbb, 0x50, 0x1e, 0xb9, 0x7d, 0x6b, 0x64,
+	0x46, 0x9d, 0x34, 0xcb, 0x9d, 0x9a, 0xe7, 0xdc, 0x83, 0xb0, 0x9d, 0x5e, 0xc1, 0x25, 0x77, 0x43,
+	0x5c, 0xc8, 0x43, 0x98, 0x9b, 0x5a, 0x5e, 0xe0, 0xf4, 0x9d, 0xa9, 0xc5, 0xee, 0x22, 0x79, 0xae,
+	0x98, 0x70, 0x3b, 0x81, 0x1b, 0x4d, 0x88, 0x93, 0xfb, 0xa0, 0xfa, 0xbc, 0x17, 0x98, 0x17, 0xae,
+	0x77, 0x3a, 0x1c, 0xb9, 0x17, 0x3e, 0x56, 0x0b, 0xf3, 0x7f, 0x41, 0xf0, 0x9f, 0x86, 0x6c, 0xed,
+	0xcf, 0x34, 0xe4, 0x8f, 0x44, 0x96, 0x6d, 0x40, 0x96, 0x63, 0x24, 0xee, 0x1b, 0xab, 0xf1, 0xc5,
+	0x84, 0x04, 0x07, 0x88, 0xcb, 0x90, 0xf7, 0xa1, 0x14, 0x38, 0x63, 0x3c, 0x4d, 0xac, 0xf1, 0x94,
+	0x83, 0x9a, 0xa1, 0x33, 0xc6, 0xcb, 0x72, 0x85, 0x5d, 0x2a, 0x58, 0xd1, 0x0a, 0x98, 0x18, 0x49,
+	0x3e, 0x85, 0x12, 0xab, 0x0d, 0x7e, 0x07, 0x42, 0x68, 0x58, 0xb1, 0x2d, 0x5f, 0xa9, 0x0c, 0xbe,
+	0x2c, 0x2d, 0x7a, 0x61, 0xb5, 0x7d, 0x01, 0x0a, 0xcf, 0x66, 0xa9, 0x24, 0xba, 0xc5, 0x6a, 0xb2,
+	0x5b, 0x84, 0x55, 0x43, 0x61, 0xd6, 0x60, 0xc9, 0x3a, 0xe4, 0xce, 0xb9, 0x4b, 0x05, 0x79, 0x17,
+	0x8b, 0x07, 0xc7, 0xe1, 0x17, 0xf3, 0xec, 0xa0, 0xfb, 0x46, 0x64, 0x53, 0xa5, 0x78, 0xfd, 0xa0,
+	0x93, 0x89, 0x46, 0x43, 0x19, 0x72, 0x07, 0xe6, 0xfa, 0x67, 0x9e, 0xc7, 0xef, 0x7a, 0x18, 0x7e,
+	0x65, 0x99, 0x43, 0xa1, 0x48, 0x9e, 0x81, 0x2c, 0xed, 0xfb, 0x34, 0x94, 0x8f, 0xc4, 0x69, 0x18,
+	0x9e, 0xc0, 0x8f, 0x60, 0xc9, 0x1e, 0x0e, 0x6d, 0xec, 0x53, 0xe7, 0xb6, 0x89, 0xf9, 0x32, 0xb2,
+	0x3d, 0x53, 0x26, 0xae, 0x52, 0x5b, 0xa8, 0x8a, 0x5b, 0x71, 0x83, 0xf3, 0x5b, 0x4d, 0xba, 0x18,
+	0xc9, 0x4a, 0xd6, 0x80, 0xe8, 0xb0, 0xe4, 0x8c, 0xc7, 0xf6, 0xc0, 0xc1, 0x3b, 0x59, 0xcc, 0x80,
+	0xe8, 0x58, 0x2b, 0xb2, 0xfc, 0x8f, 0x8c, 0x1d, 0x9c, 0x9e, 0x99, 0x89, 0x34, 0x22, 0x33, 0x77,
+	0x59, 0x76, 0x7b, 0x27, 0xd1, 0xa1, 0x3e, 0x2f, 0x35, 0x0d, 0xce, 0xa4, 0x72, 0x32, 0x71, 0x61,
+	0xc8, 0x5e, 0xb9, 0x30, 0xcc, 0x5a, 0x77, 0xee, 0x75, 0xad, 0x5b, 0x7b, 0x08, 0x0b, 0x11, 0x10,
+	0xf2, 0x42, 0x80, 0xea, 0x7c, 0x2b, 0xc3, 0x9e, 0x41, 0xae, 0x67, 0x1d, 0x95, 0x12, 0xda, 0x77,
+	0x69, 0x20, 0xa1, 0x3e, 0xa6, 0xee, 0x7f, 0x14, 0x4c, 0xec, 0x62, 0x9c, 0x2f, 0x91, 0x14, 0x03,
+	0x86, 0xc3, 0xc8, 0xf2, 0x83, 0xe9, 0x69, 0x04, 0xa3, 0x50, 0x7e, 0xc2, 0xbe, 0x11, 0xad, 0xb3,
+	0x11, 0x5a, 0x10, 0x12, 0xda, 0xaf, 0x29, 0x58, 0x4a, 0xe0, 0x20, 0xb1, 0x9c, 0x1d, 0x03, 0xa9,
+	0x7f, 0x3e, 0x06, 0xc8, 0x3d, 0xdc, 0xcc, 0xd3, 0x57, 0x1c, 0x17, 0xd1, 0xec, 0x4b, 0xab, 0xf8,
+	0x03, 0xc8, 0x7a, 0xac, 0x9b, 0x64, 0xb9, 0x66, 0xfc, 0x6c, 0xe4, 0x7c, 0x76, 0xc0, 0x26, 0xe2,
+	0x48, 0x1c, 0xb0, 0xd2, 0xff, 0x3f, 0xf0, 0x52, 0x3e, 0xcb, 0x03, 0x8c, 0xec, 0x7f, 0xb5, 0x95,
+	0x9a, 0x07, 0xab, 0x57, 0xa3, 0x7b, 0xa3, 0x0d, 0x7a, 0x0b, 0xd8, 0x37, 0xbe, 0x04, 0x25, 0x76,
+	0xf5, 0x61, 0x6f, 0xa7, 0xd6, 0x4e, 0xa7, 0x4b, 0x75, 0xbc, 0x2f, 0x16, 0x21, 0xdb, 0x33, 0xba,
+	0x07, 0xf8, 0x28, 0x43, 0x4a, 0xff, 0x5a, 0x6f, 0x88, 0xf7, 0x18, 0xa3, 0x4c, 0x29, 0x94, 0xd9,
+	0xf8, 0x3d, 0x05, 0x30, 0xeb, 0xf1, 0x44, 0x81, 0xc2, 0x61, 0x67, 0xaf, 0xd3, 0x7d, 0xda, 0x11,
+	0x06, 0x76, 0x8c, 0x56, 0x13, 0x0d, 0x94, 0x20, 0x27, 0x1e, 0x78, 0x69, 0xb6, 0x82, 0x7c, 0xdd,
+	0x65, 0xd8, 0xd3, 0x2f, 0x7a, 0xda, 0x65, 0x49, 0x01, 0x32, 0xd1, 0x03, 0x4e, 0xbe, 0xd8, 0xf2,
+	0xcc, 0x20, 0xd5, 0x0f, 0xda, 0xf5, 0x86, 0x8e, 0xcf, 0x37, 0x9c, 0x88, 0xde, 0x6e, 0x48, 0x87,
+	0x0f, 0x37, 0xa6, 0xc9, 0x9e, 0x7b, 0xc0, 0xd6, 0xe9, 0x1a, 0xbb, 0x3a, 0x55, 0x15, 0xc6, 0xa3,
+	0xdd, 0xa7, 0xea, 0x1c, 0xe3, 0x6d, 0xb7, 0xf4, 0x76, 0x53, 0x9d, 0x67, 0xef, 0xbd, 0x5d, 0xbd,
+	0x4e, 0x8d, 0x2d, 0xbd, 0x6e, 0xa8, 0x65, 0x36, 0x73, 0xc4, 0x1d, 0x5c, 0x60, 0xcb, 0x3c, 0xee,
+	0x1e, 0xd2, 0x4e, 0xbd, 0xad, 0xaa, 0x1b, 0xeb, 0x30, 0x9f, 0x38, 0xda, 0xd9, 0x5a, 0x46, 0x7d,
+	0xab, 0xad, 0xf7, 0x30, 0x28, 0xa4, 0x7b, 0xbb, 0x75, 0xda, 0xec, 0xa9, 0xa9, 0xad, 0xfb, 0xcf,
+	0xd6, 0xcf, 0x9d, 0xc0, 0xf6, 0xfd, 0xaa, 0xe3, 0x6e, 0x0a, 0x6a, 0xf3, 0x04, 0xa9, 0x60, 0x93,
+	0xff, 0xf7, 0xb0, 0x39, 0xeb, 0x48, 0xc7, 0x79, 0xce, 0xf9, 0xec, 0x6f, 0x85, 0xe6, 0xb6, 0x98,
+	0xd7, 0x10, 0x00, 0x00,
 }
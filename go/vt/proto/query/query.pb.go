@@ -462,6 +462,40 @@ func (ExecuteOptions_TransactionIsolation) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5c6ac9b241082464, []int{6, 2}
 }
 
+// Priority selects the query pool admission queue of a query.
+type ExecuteOptions_Priority int32
+
+const (
+	// NORMAL is the default priority.
+	ExecuteOptions_NORMAL ExecuteOptions_Priority = 0
+	// HIGH queries are not queued behind the queries of the other
+	// priorities.
+	ExecuteOptions_HIGH ExecuteOptions_Priority = 1
+	// LOW queries are rejected while queries of a higher priority
+	// are waiting for a connection.
+	ExecuteOptions_LOW ExecuteOptions_Priority = 2
+)
+
+var ExecuteOptions_Priority_name = map[int32]string{
+	0: "NORMAL",
+	1: "HIGH",
+	2: "LOW",
+}
+
+var ExecuteOptions_Priority_value = map[string]int32{
+	"NORMAL": 0,
+	"HIGH":   1,
+	"LOW":    2,
+}
+
+func (x ExecuteOptions_Priority) String() string {
+	return proto.EnumName(ExecuteOptions_Priority_name, int32(x))
+}
+
+func (ExecuteOptions_Priority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{6, 3}
+}
+
 // The category of one statement.
 type StreamEvent_Statement_Category int32
 
@@ -888,10 +922,52 @@ type ExecuteOptions struct {
 	TransactionIsolation ExecuteOptions_TransactionIsolation `protobuf:"varint,9,opt,name=transaction_isolation,json=transactionIsolation,proto3,enum=query.ExecuteOptions_TransactionIsolation" json:"transaction_isolation,omitempty"`
 	// skip_query_plan_cache specifies if the query plan should be cached by vitess.
 	// By default all query plans are cached.
-	SkipQueryPlanCache   bool     `protobuf:"varint,10,opt,name=skip_query_plan_cache,json=skipQueryPlanCache,proto3" json:"skip_query_plan_cache,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SkipQueryPlanCache bool `protobuf:"varint,10,opt,name=skip_query_plan_cache,json=skipQueryPlanCache,proto3" json:"skip_query_plan_cache,omitempty"`
+	// trace requests the timings of the query, which are returned
+	// in the trace of the result extras.
+	Trace bool `protobuf:"varint,11,opt,name=trace,proto3" json:"trace,omitempty"`
+	// timeout_ms is the timeout of the query in milliseconds. If set, it
+	// replaces the query timeout of the tablet, and the selects are sent
+	// to MySQL with a MAX_EXECUTION_TIME hint.
+	TimeoutMs int64 `protobuf:"varint,12,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	// priority selects the admission queue of the query for the
+	// connections of the tablet query pool.
+	Priority ExecuteOptions_Priority `protobuf:"varint,13,opt,name=priority,proto3,enum=query.ExecuteOptions_Priority" json:"priority,omitempty"`
+	// target_cell restricts vtgate to the tablets of that cell. It's
+	// used to execute the query parts of SplitQuery in the cell the
+	// splits were computed in. It's not used by the tablets.
+	TargetCell string `protobuf:"bytes,14,opt,name=target_cell,json=targetCell,proto3" json:"target_cell,omitempty"`
+	// reserve_connection makes Begin reserve a connection for the
+	// session instead of beginning a transaction. The returned ID is
+	// used like a transaction ID to execute statements on the
+	// connection, and a Rollback outside of a transaction releases it.
+	ReserveConnection bool `protobuf:"varint,15,opt,name=reserve_connection,json=reserveConnection,proto3" json:"reserve_connection,omitempty"`
+	// reserved_id makes Begin and BeginExecute begin the transaction on
+	// that reserved connection. The reserved ID is returned as the
+	// transaction ID, and the connection stays reserved after the
+	// transaction is committed or rolled back.
+	ReservedId int64 `protobuf:"varint,16,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	// wait_for_position makes the tablet execute the query only after
+	// its mysqld has applied that replication position. The query fails
+	// if the position isn't reached before its deadline.
+	WaitForPosition string `protobuf:"bytes,17,opt,name=wait_for_position,json=waitForPosition,proto3" json:"wait_for_position,omitempty"`
+	// spill_to_disk lets vtgate spill the sorts of an OLAP query that
+	// exceed its memory budget to temporary files, instead of failing
+	// the query.
+	SpillToDisk bool `protobuf:"varint,18,opt,name=spill_to_disk,json=spillToDisk,proto3" json:"spill_to_disk,omitempty"`
+	// max_staleness_ms restricts vtgate to the replica and rdonly tablets
+	// whose replication lag is at most that many milliseconds. The lag
+	// reported by the tablets is in seconds. If no tablet qualifies, the
+	// query fails, or is sent to the master if
+	// max_staleness_fallback_to_master is set. It's not used by the
+	// tablets.
+	MaxStalenessMs int64 `protobuf:"varint,19,opt,name=max_staleness_ms,json=maxStalenessMs,proto3" json:"max_staleness_ms,omitempty"`
+	// max_staleness_fallback_to_master sends the reads to the master
+	// when no tablet has a replication lag under max_staleness_ms.
+	MaxStalenessFallbackToMaster bool     `protobuf:"varint,20,opt,name=max_staleness_fallback_to_master,json=maxStalenessFallbackToMaster,proto3" json:"max_staleness_fallback_to_master,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
 }
 
 func (m *ExecuteOptions) Reset()         { *m = ExecuteOptions{} }
@@ -975,6 +1051,76 @@ func (m *ExecuteOptions) GetSkipQueryPlanCache() bool {
 	return false
 }
 
+func (m *ExecuteOptions) GetTrace() bool {
+	if m != nil {
+		return m.Trace
+	}
+	return false
+}
+
+func (m *ExecuteOptions) GetTimeoutMs() int64 {
+	if m != nil {
+		return m.TimeoutMs
+	}
+	return 0
+}
+
+func (m *ExecuteOptions) GetPriority() ExecuteOptions_Priority {
+	if m != nil {
+		return m.Priority
+	}
+	return ExecuteOptions_NORMAL
+}
+
+func (m *ExecuteOptions) GetTargetCell() string {
+	if m != nil {
+		return m.TargetCell
+	}
+	return ""
+}
+
+func (m *ExecuteOptions) GetReserveConnection() bool {
+	if m != nil {
+		return m.ReserveConnection
+	}
+	return false
+}
+
+func (m *ExecuteOptions) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
+func (m *ExecuteOptions) GetWaitForPosition() string {
+	if m != nil {
+		return m.WaitForPosition
+	}
+	return ""
+}
+
+func (m *ExecuteOptions) GetSpillToDisk() bool {
+	if m != nil {
+		return m.SpillToDisk
+	}
+	return false
+}
+
+func (m *ExecuteOptions) GetMaxStalenessMs() int64 {
+	if m != nil {
+		return m.MaxStalenessMs
+	}
+	return 0
+}
+
+func (m *ExecuteOptions) GetMaxStalenessFallbackToMaster() bool {
+	if m != nil {
+		return m.MaxStalenessFallbackToMaster
+	}
+	return false
+}
+
 // Field describes a single column returned by a query
 type Field struct {
 	// name of the field as returned by mysql C API
@@ -1157,10 +1303,12 @@ type ResultExtras struct {
 	EventToken *EventToken `protobuf:"bytes,1,opt,name=event_token,json=eventToken,proto3" json:"event_token,omitempty"`
 	// If set, it means the data returned with this result is fresher
 	// than the compare_token passed in the ExecuteOptions.
-	Fresher              bool     `protobuf:"varint,2,opt,name=fresher,proto3" json:"fresher,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Fresher bool `protobuf:"varint,2,opt,name=fresher,proto3" json:"fresher,omitempty"`
+	// trace is populated if the trace flag is set in ExecuteOptions.
+	Trace                *QueryTrace `protobuf:"bytes,3,opt,name=trace,proto3" json:"trace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *ResultExtras) Reset()         { *m = ResultExtras{} }
@@ -1202,6 +1350,13 @@ func (m *ResultExtras) GetFresher() bool {
 	return false
 }
 
+func (m *ResultExtras) GetTrace() *QueryTrace {
+	if m != nil {
+		return m.Trace
+	}
+	return nil
+}
+
 // QueryResult is returned by Execute and ExecuteStream.
 //
 // As returned by Execute, len(fields) is always equal to len(row)
@@ -3733,7 +3888,17 @@ type RealtimeStats struct {
 	CpuUsage float64 `protobuf:"fixed64,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
 	// qps is the average QPS (queries per second) rate in the last XX seconds
 	// where XX is usually 60 (See query_service_stats.go).
-	Qps                  float64  `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	Qps float64 `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	// read_only is true if the MySQL instance of the tablet is read-only.
+	ReadOnly bool `protobuf:"varint,7,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// tx_throttled is true if the transaction throttler of the tablet
+	// recently throttled transactions because of the replication lag
+	// of the shard.
+	TxThrottled bool `protobuf:"varint,8,opt,name=tx_throttled,json=txThrottled,proto3" json:"tx_throttled,omitempty"`
+	// table_schema_changed are the tables whose schema changed since the
+	// previous health broadcast of the tablet: they were created, altered
+	// or dropped.
+	TableSchemaChanged   []string `protobuf:"bytes,9,rep,name=table_schema_changed,json=tableSchemaChanged,proto3" json:"table_schema_changed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3806,6 +3971,27 @@ func (m *RealtimeStats) GetQps() float64 {
 	return 0
 }
 
+func (m *RealtimeStats) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *RealtimeStats) GetTxThrottled() bool {
+	if m != nil {
+		return m.TxThrottled
+	}
+	return false
+}
+
+func (m *RealtimeStats) GetTableSchemaChanged() []string {
+	if m != nil {
+		return m.TableSchemaChanged
+	}
+	return nil
+}
+
 // AggregateStats contains information about the health of a group of
 // tablets for a Target.  It is used to propagate stats from a vtgate
 // to another, or from the Gateway layer of a vtgate to the routing
@@ -4190,6 +4376,187 @@ func (m *TransactionMetadata) GetParticipants() []*Target {
 	return nil
 }
 
+// QueryTrace contains the timings of a query on a tablet.
+type QueryTrace struct {
+	TabletAlias *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	// plan_type is the type of the plan of the query.
+	PlanType string `protobuf:"bytes,2,opt,name=plan_type,json=planType,proto3" json:"plan_type,omitempty"`
+	// plan_nanos is the time spent getting the plan of the query.
+	PlanNanos int64 `protobuf:"varint,3,opt,name=plan_nanos,json=planNanos,proto3" json:"plan_nanos,omitempty"`
+	// pool_wait_nanos is the time spent waiting for a connection.
+	PoolWaitNanos int64 `protobuf:"varint,4,opt,name=pool_wait_nanos,json=poolWaitNanos,proto3" json:"pool_wait_nanos,omitempty"`
+	// mysql_nanos is the time spent executing the query in MySQL.
+	MysqlNanos int64 `protobuf:"varint,5,opt,name=mysql_nanos,json=mysqlNanos,proto3" json:"mysql_nanos,omitempty"`
+	// total_nanos is the total time spent in the tablet.
+	TotalNanos           int64    `protobuf:"varint,6,opt,name=total_nanos,json=totalNanos,proto3" json:"total_nanos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryTrace) Reset()         { *m = QueryTrace{} }
+func (m *QueryTrace) String() string { return proto.CompactTextString(m) }
+func (*QueryTrace) ProtoMessage()    {}
+func (*QueryTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{60}
+}
+
+func (m *QueryTrace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryTrace.Unmarshal(m, b)
+}
+func (m *QueryTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryTrace.Marshal(b, m, deterministic)
+}
+func (m *QueryTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrace.Merge(m, src)
+}
+func (m *QueryTrace) XXX_Size() int {
+	return xxx_messageInfo_QueryTrace.Size(m)
+}
+func (m *QueryTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrace proto.InternalMessageInfo
+
+func (m *QueryTrace) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *QueryTrace) GetPlanType() string {
+	if m != nil {
+		return m.PlanType
+	}
+	return ""
+}
+
+func (m *QueryTrace) GetPlanNanos() int64 {
+	if m != nil {
+		return m.PlanNanos
+	}
+	return 0
+}
+
+func (m *QueryTrace) GetPoolWaitNanos() int64 {
+	if m != nil {
+		return m.PoolWaitNanos
+	}
+	return 0
+}
+
+func (m *QueryTrace) GetMysqlNanos() int64 {
+	if m != nil {
+		return m.MysqlNanos
+	}
+	return 0
+}
+
+func (m *QueryTrace) GetTotalNanos() int64 {
+	if m != nil {
+		return m.TotalNanos
+	}
+	return 0
+}
+
+// ReleaseRequest is the payload to Release
+type ReleaseRequest struct {
+	EffectiveCallerId    *vtrpc.CallerID `protobuf:"bytes,1,opt,name=effective_caller_id,json=effectiveCallerId,proto3" json:"effective_caller_id,omitempty"`
+	ImmediateCallerId    *VTGateCallerID `protobuf:"bytes,2,opt,name=immediate_caller_id,json=immediateCallerId,proto3" json:"immediate_caller_id,omitempty"`
+	Target               *Target         `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	ReservedId           int64           `protobuf:"varint,4,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReleaseRequest) Reset()         { *m = ReleaseRequest{} }
+func (m *ReleaseRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseRequest) ProtoMessage()    {}
+func (*ReleaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{61}
+}
+
+func (m *ReleaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseRequest.Unmarshal(m, b)
+}
+func (m *ReleaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseRequest.Merge(m, src)
+}
+func (m *ReleaseRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseRequest.Size(m)
+}
+func (m *ReleaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseRequest proto.InternalMessageInfo
+
+func (m *ReleaseRequest) GetEffectiveCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.EffectiveCallerId
+	}
+	return nil
+}
+
+func (m *ReleaseRequest) GetImmediateCallerId() *VTGateCallerID {
+	if m != nil {
+		return m.ImmediateCallerId
+	}
+	return nil
+}
+
+func (m *ReleaseRequest) GetTarget() *Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *ReleaseRequest) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
+// ReleaseResponse is the returned value from Release
+type ReleaseResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseResponse) Reset()         { *m = ReleaseResponse{} }
+func (m *ReleaseResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseResponse) ProtoMessage()    {}
+func (*ReleaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6ac9b241082464, []int{62}
+}
+
+func (m *ReleaseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseResponse.Unmarshal(m, b)
+}
+func (m *ReleaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseResponse.Marshal(b, m, deterministic)
+}
+func (m *ReleaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseResponse.Merge(m, src)
+}
+func (m *ReleaseResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseResponse.Size(m)
+}
+func (m *ReleaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("query.MySqlFlag", MySqlFlag_name, MySqlFlag_value)
 	proto.RegisterEnum("query.Flag", Flag_name, Flag_value)
@@ -4198,6 +4565,7 @@ func init() {
 	proto.RegisterEnum("query.ExecuteOptions_IncludedFields", ExecuteOptions_IncludedFields_name, ExecuteOptions_IncludedFields_value)
 	proto.RegisterEnum("query.ExecuteOptions_Workload", ExecuteOptions_Workload_name, ExecuteOptions_Workload_value)
 	proto.RegisterEnum("query.ExecuteOptions_TransactionIsolation", ExecuteOptions_TransactionIsolation_name, ExecuteOptions_TransactionIsolation_value)
+	proto.RegisterEnum("query.ExecuteOptions_Priority", ExecuteOptions_Priority_name, ExecuteOptions_Priority_value)
 	proto.RegisterEnum("query.StreamEvent_Statement_Category", StreamEvent_Statement_Category_name, StreamEvent_Statement_Category_value)
 	proto.RegisterEnum("query.SplitQueryRequest_Algorithm", SplitQueryRequest_Algorithm_name, SplitQueryRequest_Algorithm_value)
 	proto.RegisterType((*Target)(nil), "query.Target")
@@ -4262,215 +4630,242 @@ func init() {
 	proto.RegisterType((*UpdateStreamRequest)(nil), "query.UpdateStreamRequest")
 	proto.RegisterType((*UpdateStreamResponse)(nil), "query.UpdateStreamResponse")
 	proto.RegisterType((*TransactionMetadata)(nil), "query.TransactionMetadata")
+	proto.RegisterType((*QueryTrace)(nil), "query.QueryTrace")
+	proto.RegisterType((*ReleaseRequest)(nil), "query.ReleaseRequest")
+	proto.RegisterType((*ReleaseResponse)(nil), "query.ReleaseResponse")
 }
 
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x73, 0x1b, 0xc9,
-	0x79, 0xd7, 0xe0, 0x45, 0xe0, 0x03, 0x01, 0x36, 0x1b, 0xa4, 0x84, 0xe5, 0xbe, 0xe8, 0xb1, 0xd7,
-	0x66, 0x68, 0x87, 0xd2, 0x72, 0x65, 0x45, 0x59, 0x3b, 0x8e, 0x86, 0xe0, 0x50, 0x0b, 0x0b, 0x18,
-	0x40, 0x8d, 0x81, 0x64, 0x6d, 0xb9, 0x6a, 0x6a, 0x08, 0xb4, 0xc0, 0x29, 0x0e, 0x66, 0xa0, 0x99,
-	0x01, 0x29, 0xde, 0x94, 0x38, 0xce, 0xfb, 0xb1, 0x79, 0x6e, 0x9c, 0x54, 0x36, 0xa9, 0xca, 0x21,
-	0xb7, 0xfc, 0x0d, 0xa9, 0x1c, 0x72, 0xcc, 0x2d, 0x87, 0x24, 0x87, 0x1c, 0x52, 0xa9, 0xdc, 0x5c,
-	0x39, 0xe5, 0x90, 0x43, 0x2a, 0xd5, 0x8f, 0x19, 0x0c, 0x48, 0xec, 0x4a, 0x56, 0x7c, 0xa1, 0x76,
-	0x6f, 0xdd, 0xdf, 0xf7, 0xf5, 0xe3, 0xf7, 0xfb, 0xbe, 0xf9, 0xba, 0xa7, 0xbb, 0xa1, 0xfc, 0x64,
-	0x4a, 0x83, 0xb3, 0x9d, 0x49, 0xe0, 0x47, 0x3e, 0xce, 0xf3, 0xca, 0x46, 0x35, 0xf2, 0x27, 0xfe,
-	0xd0, 0x8e, 0x6c, 0x21, 0xde, 0x28, 0x9f, 0x44, 0xc1, 0x64, 0x20, 0x2a, 0xea, 0x0f, 0x15, 0x28,
-	0x98, 0x76, 0x30, 0xa2, 0x11, 0xde, 0x80, 0xe2, 0x31, 0x3d, 0x0b, 0x27, 0xf6, 0x80, 0xd6, 0x95,
-	0x4d, 0x65, 0xab, 0x44, 0x92, 0x3a, 0x5e, 0x83, 0x7c, 0x78, 0x64, 0x07, 0xc3, 0x7a, 0x86, 0x2b,
-	0x44, 0x05, 0x7f, 0x13, 0xca, 0x91, 0x7d, 0xe8, 0xd2, 0xc8, 0x8a, 0xce, 0x26, 0xb4, 0x9e, 0xdd,
-	0x54, 0xb6, 0xaa, 0xbb, 0x6b, 0x3b, 0xc9, 0x78, 0x26, 0x57, 0x9a, 0x67, 0x13, 0x4a, 0x20, 0x4a,
-	0xca, 0x18, 0x43, 0x6e, 0x40, 0x5d, 0xb7, 0x9e, 0xe3, 0x7d, 0xf1, 0xb2, 0xba, 0x0f, 0xd5, 0x07,
-	0xe6, 0x5d, 0x3b, 0xa2, 0x0d, 0xdb, 0x75, 0x69, 0xd0, 0xdc, 0x67, 0xd3, 0x99, 0x86, 0x34, 0xf0,
-	0xec, 0x71, 0x32, 0x9d, 0xb8, 0x8e, 0xaf, 0x42, 0x61, 0x14, 0xf8, 0xd3, 0x49, 0x58, 0xcf, 0x6c,
-	0x66, 0xb7, 0x4a, 0x44, 0xd6, 0xd4, 0xef, 0x03, 0xe8, 0x27, 0xd4, 0x8b, 0x4c, 0xff, 0x98, 0x7a,
-	0xf8, 0x0d, 0x28, 0x45, 0xce, 0x98, 0x86, 0x91, 0x3d, 0x9e, 0xf0, 0x2e, 0xb2, 0x64, 0x26, 0xf8,
-	0x14, 0x48, 0x1b, 0x50, 0x9c, 0xf8, 0xa1, 0x13, 0x39, 0xbe, 0xc7, 0xf1, 0x94, 0x48, 0x52, 0x57,
-	0xbf, 0x03, 0xf9, 0x07, 0xb6, 0x3b, 0xa5, 0xf8, 0x6d, 0xc8, 0x71, 0xc0, 0x0a, 0x07, 0x5c, 0xde,
-	0x11, 0xa4, 0x73, 0x9c, 0x5c, 0xc1, 0xfa, 0x3e, 0x61, 0x96, 0xbc, 0xef, 0x65, 0x22, 0x2a, 0xea,
-	0x31, 0x2c, 0xef, 0x39, 0xde, 0xf0, 0x81, 0x1d, 0x38, 0x8c, 0x8c, 0x97, 0xec, 0x06, 0x7f, 0x05,
-	0x0a, 0xbc, 0x10, 0xd6, 0xb3, 0x9b, 0xd9, 0xad, 0xf2, 0xee, 0xb2, 0x6c, 0xc8, 0xe7, 0x46, 0xa4,
-	0x4e, 0xfd, 0x7b, 0x05, 0x60, 0xcf, 0x9f, 0x7a, 0xc3, 0xfb, 0x4c, 0x89, 0x11, 0x64, 0xc3, 0x27,
-	0xae, 0x24, 0x92, 0x15, 0xf1, 0x3d, 0xa8, 0x1e, 0x3a, 0xde, 0xd0, 0x3a, 0x91, 0xd3, 0x11, 0x5c,
-	0x96, 0x77, 0xbf, 0x22, 0xbb, 0x9b, 0x35, 0xde, 0x49, 0xcf, 0x3a, 0xd4, 0xbd, 0x28, 0x38, 0x23,
-	0x95, 0xc3, 0xb4, 0x6c, 0xa3, 0x0f, 0xf8, 0xa2, 0x11, 0x1b, 0xf4, 0x98, 0x9e, 0xc5, 0x83, 0x1e,
-	0xd3, 0x33, 0xfc, 0x33, 0x69, 0x44, 0xe5, 0xdd, 0x5a, 0x3c, 0x56, 0xaa, 0xad, 0x84, 0xf9, 0x7e,
-	0xe6, 0xb6, 0xa2, 0xfe, 0x65, 0x01, 0xaa, 0xfa, 0x53, 0x3a, 0x98, 0x46, 0xb4, 0x33, 0x61, 0x3e,
-	0x08, 0xf1, 0x0e, 0xd4, 0x1c, 0x6f, 0xe0, 0x4e, 0x87, 0xd4, 0xa2, 0xcc, 0xd5, 0x56, 0xc4, 0x7c,
-	0xcd, 0xfb, 0x2b, 0x92, 0x55, 0xa9, 0x4a, 0x05, 0x81, 0x06, 0xb5, 0x81, 0x3f, 0x9e, 0xd8, 0xc1,
-	0xbc, 0x7d, 0x96, 0x8f, 0xbf, 0x2a, 0xc7, 0x9f, 0xd9, 0x93, 0x55, 0x69, 0x9d, 0xea, 0xa2, 0x0d,
-	0x2b, 0xb2, 0xdf, 0xa1, 0xf5, 0xd8, 0xa1, 0xee, 0x30, 0xe4, 0xa1, 0x5b, 0x4d, 0xa8, 0x9a, 0x9f,
-	0xe2, 0x4e, 0x53, 0x1a, 0x1f, 0x70, 0x5b, 0x52, 0x75, 0xe6, 0xea, 0x78, 0x1b, 0x56, 0x07, 0xae,
-	0xc3, 0xa6, 0xf2, 0x98, 0x51, 0x6c, 0x05, 0xfe, 0x69, 0x58, 0xcf, 0xf3, 0xf9, 0xaf, 0x08, 0xc5,
-	0x01, 0x93, 0x13, 0xff, 0x34, 0xc4, 0xef, 0x43, 0xf1, 0xd4, 0x0f, 0x8e, 0x5d, 0xdf, 0x1e, 0xd6,
-	0x0b, 0x7c, 0xcc, 0xb7, 0x16, 0x8f, 0xf9, 0x50, 0x5a, 0x91, 0xc4, 0x1e, 0x6f, 0x01, 0x0a, 0x9f,
-	0xb8, 0x56, 0x48, 0x5d, 0x3a, 0x88, 0x2c, 0xd7, 0x19, 0x3b, 0x51, 0xbd, 0xc8, 0xbf, 0x82, 0x6a,
-	0xf8, 0xc4, 0xed, 0x71, 0x71, 0x8b, 0x49, 0xb1, 0x05, 0xeb, 0x51, 0x60, 0x7b, 0xa1, 0x3d, 0x60,
-	0x9d, 0x59, 0x4e, 0xe8, 0xbb, 0x36, 0xff, 0x02, 0x4a, 0x7c, 0xc8, 0xed, 0xc5, 0x43, 0x9a, 0xb3,
-	0x26, 0xcd, 0xb8, 0x05, 0x59, 0x8b, 0x16, 0x48, 0xf1, 0xbb, 0xb0, 0x1e, 0x1e, 0x3b, 0x13, 0x8b,
-	0xf7, 0x63, 0x4d, 0x5c, 0xdb, 0xb3, 0x06, 0xf6, 0xe0, 0x88, 0xd6, 0x81, 0xc3, 0xc6, 0x4c, 0xc9,
-	0x43, 0xad, 0xeb, 0xda, 0x5e, 0x83, 0x69, 0xd4, 0x6f, 0x41, 0x75, 0x9e, 0x47, 0xbc, 0x0a, 0x15,
-	0xf3, 0x51, 0x57, 0xb7, 0x34, 0x63, 0xdf, 0x32, 0xb4, 0xb6, 0x8e, 0xae, 0xe0, 0x0a, 0x94, 0xb8,
-	0xa8, 0x63, 0xb4, 0x1e, 0x21, 0x05, 0x2f, 0x41, 0x56, 0x6b, 0xb5, 0x50, 0x46, 0xbd, 0x0d, 0xc5,
-	0x98, 0x10, 0xbc, 0x02, 0xe5, 0xbe, 0xd1, 0xeb, 0xea, 0x8d, 0xe6, 0x41, 0x53, 0xdf, 0x47, 0x57,
-	0x70, 0x11, 0x72, 0x9d, 0x96, 0xd9, 0x45, 0x8a, 0x28, 0x69, 0x5d, 0x94, 0x61, 0x2d, 0xf7, 0xf7,
-	0x34, 0x94, 0x55, 0xff, 0x46, 0x81, 0xb5, 0x45, 0xc0, 0x70, 0x19, 0x96, 0xf6, 0xf5, 0x03, 0xad,
-	0xdf, 0x32, 0xd1, 0x15, 0x5c, 0x83, 0x15, 0xa2, 0x77, 0x75, 0xcd, 0xd4, 0xf6, 0x5a, 0xba, 0x45,
-	0x74, 0x6d, 0x1f, 0x29, 0x18, 0x43, 0x95, 0x95, 0xac, 0x46, 0xa7, 0xdd, 0x6e, 0x9a, 0xa6, 0xbe,
-	0x8f, 0x32, 0x78, 0x0d, 0x10, 0x97, 0xf5, 0x8d, 0x99, 0x34, 0x8b, 0x11, 0x2c, 0xf7, 0x74, 0xd2,
-	0xd4, 0x5a, 0xcd, 0x0f, 0x59, 0x07, 0x28, 0x87, 0xbf, 0x04, 0x6f, 0x36, 0x3a, 0x46, 0xaf, 0xd9,
-	0x33, 0x75, 0xc3, 0xb4, 0x7a, 0x86, 0xd6, 0xed, 0x7d, 0xd0, 0x31, 0x79, 0xcf, 0x02, 0x5c, 0x1e,
-	0x57, 0x01, 0xb4, 0xbe, 0xd9, 0x11, 0xfd, 0xa0, 0xc2, 0x77, 0x73, 0x45, 0x05, 0x65, 0xd4, 0x8f,
-	0x33, 0x90, 0xe7, 0xfc, 0xb0, 0xac, 0x9a, 0xca, 0x95, 0xbc, 0x9c, 0x64, 0x98, 0xcc, 0x67, 0x64,
-	0x18, 0x9e, 0x98, 0x65, 0xae, 0x13, 0x15, 0xfc, 0x3a, 0x94, 0xfc, 0x60, 0x64, 0x09, 0x8d, 0xc8,
-	0xd2, 0x45, 0x3f, 0x18, 0xf1, 0x74, 0xce, 0x32, 0x24, 0x4b, 0xee, 0x87, 0x76, 0x48, 0x79, 0xd4,
-	0x96, 0x48, 0x52, 0xc7, 0xaf, 0x01, 0xb3, 0xb3, 0xf8, 0x3c, 0x0a, 0x5c, 0xb7, 0xe4, 0x07, 0x23,
-	0x83, 0x4d, 0xe5, 0xcb, 0x50, 0x19, 0xf8, 0xee, 0x74, 0xec, 0x59, 0x2e, 0xf5, 0x46, 0xd1, 0x51,
-	0x7d, 0x69, 0x53, 0xd9, 0xaa, 0x90, 0x65, 0x21, 0x6c, 0x71, 0x19, 0xae, 0xc3, 0xd2, 0xe0, 0xc8,
-	0x0e, 0x42, 0x2a, 0x22, 0xb5, 0x42, 0xe2, 0x2a, 0x1f, 0x95, 0x0e, 0x9c, 0xb1, 0xed, 0x86, 0x3c,
-	0x2a, 0x2b, 0x24, 0xa9, 0x33, 0x10, 0x8f, 0x5d, 0x7b, 0x14, 0xf2, 0x68, 0xaa, 0x10, 0x51, 0x51,
-	0x7f, 0x0e, 0xb2, 0xc4, 0x3f, 0x65, 0x5d, 0x8a, 0x01, 0xc3, 0xba, 0xb2, 0x99, 0xdd, 0xc2, 0x24,
-	0xae, 0xb2, 0x45, 0x44, 0xe6, 0x51, 0x91, 0x5e, 0xe3, 0xcc, 0xf9, 0x7d, 0x58, 0x26, 0x34, 0x9c,
-	0xba, 0x91, 0xfe, 0x34, 0x0a, 0xec, 0x10, 0xef, 0x42, 0x39, 0x9d, 0x39, 0x94, 0x4f, 0xcb, 0x1c,
-	0x40, 0x67, 0x29, 0xa3, 0x0e, 0x4b, 0x8f, 0x03, 0x1a, 0x1e, 0xd1, 0x40, 0x66, 0xa6, 0xb8, 0xca,
-	0xf2, 0x72, 0x99, 0x87, 0xba, 0x18, 0x83, 0x65, 0x73, 0x99, 0x53, 0x94, 0xb9, 0x6c, 0xce, 0x9d,
-	0x4a, 0xa4, 0x8e, 0xb1, 0xc7, 0xd2, 0x84, 0x65, 0x3f, 0x7e, 0x4c, 0x07, 0x11, 0x15, 0x8b, 0x56,
-	0x8e, 0x2c, 0x33, 0xa1, 0x26, 0x65, 0xcc, 0x6d, 0x8e, 0x17, 0xd2, 0x20, 0xb2, 0x9c, 0x21, 0x77,
-	0x68, 0x8e, 0x14, 0x85, 0xa0, 0x39, 0xc4, 0x6f, 0x41, 0x8e, 0x27, 0x9a, 0x1c, 0x1f, 0x05, 0xe4,
-	0x28, 0xc4, 0x3f, 0x25, 0x5c, 0x8e, 0xbf, 0x0e, 0x05, 0xca, 0xf1, 0x72, 0xa7, 0xce, 0x52, 0x73,
-	0x9a, 0x0a, 0x22, 0x4d, 0xd4, 0x6f, 0xc3, 0x32, 0xc7, 0xf0, 0xd0, 0x0e, 0x3c, 0xc7, 0x1b, 0xf1,
-	0x15, 0xdd, 0x1f, 0x8a, 0xd8, 0xab, 0x10, 0x5e, 0x66, 0x14, 0x8c, 0x69, 0x18, 0xda, 0x23, 0x2a,
-	0x57, 0xd8, 0xb8, 0xaa, 0xfe, 0x55, 0x16, 0xca, 0xbd, 0x28, 0xa0, 0xf6, 0x98, 0xb3, 0x87, 0xbf,
-	0x0d, 0x10, 0x46, 0x76, 0x44, 0xc7, 0xd4, 0x8b, 0x62, 0x1a, 0xde, 0x90, 0xc3, 0xa7, 0xec, 0x76,
-	0x7a, 0xb1, 0x11, 0x49, 0xd9, 0x9f, 0x77, 0x4f, 0xe6, 0x05, 0xdc, 0xb3, 0xf1, 0x49, 0x06, 0x4a,
-	0x49, 0x6f, 0x58, 0x83, 0xe2, 0xc0, 0x8e, 0xe8, 0xc8, 0x0f, 0xce, 0xe4, 0x5a, 0xfc, 0xce, 0x67,
-	0x8d, 0xbe, 0xd3, 0x90, 0xc6, 0x24, 0x69, 0x86, 0xdf, 0x04, 0xb1, 0xc1, 0x11, 0xa1, 0x2f, 0xf0,
-	0x96, 0xb8, 0x84, 0x07, 0xff, 0xfb, 0x80, 0x27, 0x81, 0x33, 0xb6, 0x83, 0x33, 0xeb, 0x98, 0x9e,
-	0xc5, 0x8b, 0x48, 0x76, 0x81, 0xc3, 0x91, 0xb4, 0xbb, 0x47, 0xcf, 0x64, 0xda, 0xbb, 0x3d, 0xdf,
-	0x56, 0x86, 0xec, 0x45, 0x37, 0xa6, 0x5a, 0xf2, 0x9d, 0x40, 0x18, 0xaf, 0xf9, 0x79, 0x1e, 0xdd,
-	0xac, 0xa8, 0x7e, 0x0d, 0x8a, 0xf1, 0xe4, 0x71, 0x09, 0xf2, 0x7a, 0x10, 0xf8, 0x01, 0xba, 0xc2,
-	0xb3, 0x5f, 0xbb, 0x25, 0x12, 0xe8, 0xfe, 0x3e, 0x4b, 0xa0, 0x7f, 0x97, 0x49, 0x16, 0x5e, 0x42,
-	0x9f, 0x4c, 0x69, 0x18, 0xe1, 0x5f, 0x84, 0x1a, 0xe5, 0x91, 0xe6, 0x9c, 0x50, 0x6b, 0xc0, 0x77,
-	0x69, 0x2c, 0xce, 0xc4, 0xe7, 0xb0, 0xb2, 0x23, 0x36, 0x95, 0xf1, 0xee, 0x8d, 0xac, 0x26, 0xb6,
-	0x52, 0x34, 0xc4, 0x3a, 0xd4, 0x9c, 0xf1, 0x98, 0x0e, 0x1d, 0x3b, 0x4a, 0x77, 0x20, 0x1c, 0xb6,
-	0x1e, 0x6f, 0x62, 0xe6, 0x36, 0x81, 0x64, 0x35, 0x69, 0x91, 0x74, 0xf3, 0x0e, 0x14, 0x22, 0xbe,
-	0x61, 0x95, 0x6b, 0x78, 0x25, 0xce, 0x6a, 0x5c, 0x48, 0xa4, 0x12, 0x7f, 0x0d, 0xc4, 0xf6, 0x97,
-	0xe7, 0xaf, 0x59, 0x40, 0xcc, 0x76, 0x35, 0x44, 0xe8, 0xf1, 0x3b, 0x50, 0x9d, 0x5b, 0xfc, 0x86,
-	0x9c, 0xb0, 0x2c, 0xa9, 0xa4, 0x57, 0xb2, 0x21, 0xbe, 0x0e, 0x4b, 0xbe, 0x58, 0xf8, 0x78, 0x66,
-	0x9b, 0xcd, 0x78, 0x7e, 0x55, 0x24, 0xb1, 0x95, 0xfa, 0x0b, 0xb0, 0x92, 0x30, 0x18, 0x4e, 0x7c,
-	0x2f, 0xa4, 0x78, 0x1b, 0x0a, 0x01, 0xff, 0x9c, 0x24, 0x6b, 0x58, 0x76, 0x91, 0xca, 0x07, 0x44,
-	0x5a, 0xa8, 0x43, 0x58, 0x11, 0x92, 0x87, 0x4e, 0x74, 0xc4, 0x1d, 0x85, 0xdf, 0x81, 0x3c, 0x65,
-	0x85, 0x73, 0x9c, 0x93, 0x6e, 0x83, 0xeb, 0x89, 0xd0, 0xa6, 0x46, 0xc9, 0x3c, 0x77, 0x94, 0xff,
-	0xca, 0x40, 0x4d, 0xce, 0x72, 0xcf, 0x8e, 0x06, 0x47, 0x97, 0xd4, 0xd9, 0x5f, 0x87, 0x25, 0x26,
-	0x77, 0x92, 0x0f, 0x63, 0x81, 0xbb, 0x63, 0x0b, 0xe6, 0x70, 0x3b, 0xb4, 0x52, 0xde, 0x95, 0x9b,
-	0xaf, 0x8a, 0x1d, 0xa6, 0x56, 0xfe, 0x05, 0x71, 0x51, 0x78, 0x4e, 0x5c, 0x2c, 0xbd, 0x50, 0x5c,
-	0xec, 0xc3, 0xda, 0x3c, 0xe3, 0x32, 0x38, 0xbe, 0x01, 0x4b, 0xc2, 0x29, 0x71, 0x0a, 0x5c, 0xe4,
-	0xb7, 0xd8, 0x44, 0xfd, 0x87, 0x0c, 0xac, 0xc9, 0xec, 0xf4, 0xf9, 0xf8, 0x4c, 0x53, 0x3c, 0xe7,
-	0x5f, 0x84, 0xe7, 0x17, 0xf4, 0x9f, 0xda, 0x80, 0xf5, 0x73, 0x3c, 0xbe, 0xc4, 0xc7, 0xfa, 0x63,
-	0x05, 0x96, 0xf7, 0xe8, 0xc8, 0xf1, 0x2e, 0xa9, 0x17, 0x52, 0xe4, 0xe6, 0x5e, 0x28, 0x88, 0x6f,
-	0x41, 0x45, 0xe2, 0x95, 0x6c, 0x5d, 0x64, 0x5b, 0x59, 0xc4, 0xf6, 0x7f, 0x28, 0x50, 0x69, 0xf8,
-	0xe3, 0xb1, 0x13, 0x5d, 0x52, 0xa6, 0x2e, 0xe2, 0xcc, 0x2d, 0xc2, 0x89, 0xa0, 0x1a, 0xc3, 0x14,
-	0x04, 0xa9, 0xff, 0xa9, 0xc0, 0x0a, 0xf1, 0x5d, 0xf7, 0xd0, 0x1e, 0x1c, 0xbf, 0xda, 0xd8, 0x31,
-	0xa0, 0x19, 0x50, 0x89, 0xfe, 0x7f, 0x14, 0xa8, 0x76, 0x03, 0xca, 0x7e, 0xac, 0x5f, 0x69, 0xf0,
-	0x6c, 0x27, 0x3c, 0x8c, 0xe4, 0x1e, 0xa2, 0x44, 0x78, 0x59, 0x5d, 0x85, 0x95, 0x04, 0xbb, 0xe4,
-	0xe3, 0x5f, 0x14, 0x58, 0x17, 0x01, 0x22, 0x35, 0xc3, 0x4b, 0x4a, 0x4b, 0x8c, 0x37, 0x97, 0xc2,
-	0x5b, 0x87, 0xab, 0xe7, 0xb1, 0x49, 0xd8, 0x3f, 0xc8, 0xc0, 0xb5, 0x38, 0x36, 0x2e, 0x39, 0xf0,
-	0xff, 0x47, 0x3c, 0x6c, 0x40, 0xfd, 0x22, 0x09, 0x92, 0xa1, 0x8f, 0x32, 0x50, 0x6f, 0x04, 0xd4,
-	0x8e, 0x68, 0x6a, 0x2f, 0xf2, 0xea, 0xc4, 0x06, 0x7e, 0x17, 0x96, 0x27, 0x76, 0x10, 0x39, 0x03,
-	0x67, 0x62, 0xb3, 0xbf, 0xbd, 0x3c, 0xdf, 0xea, 0x9c, 0xeb, 0x60, 0xce, 0x44, 0x7d, 0x1d, 0x5e,
-	0x5b, 0xc0, 0x88, 0xe4, 0xeb, 0x7f, 0x15, 0xc0, 0xbd, 0xc8, 0x0e, 0xa2, 0xcf, 0xc1, 0xaa, 0xb2,
-	0x30, 0x98, 0xd6, 0xa1, 0x36, 0x87, 0x3f, 0xcd, 0x0b, 0x8d, 0x3e, 0x17, 0x2b, 0xce, 0xa7, 0xf2,
-	0x92, 0xc6, 0x2f, 0x79, 0xf9, 0x37, 0x05, 0x36, 0x1a, 0xbe, 0x38, 0x58, 0x7c, 0x25, 0xbf, 0x30,
-	0xf5, 0x4d, 0x78, 0x7d, 0x21, 0x40, 0x49, 0xc0, 0xbf, 0x2a, 0x70, 0x95, 0x50, 0x7b, 0xf8, 0x6a,
-	0x82, 0xbf, 0x0f, 0xd7, 0x2e, 0x80, 0x93, 0x3b, 0xd4, 0x5b, 0x50, 0x1c, 0xd3, 0xc8, 0x1e, 0xda,
-	0x91, 0x2d, 0x21, 0x6d, 0xc4, 0xfd, 0xce, 0xac, 0xdb, 0xd2, 0x82, 0x24, 0xb6, 0xea, 0x27, 0x19,
-	0xa8, 0xf1, 0xbd, 0xee, 0x17, 0x3f, 0x5a, 0x8b, 0xff, 0x05, 0x3e, 0x52, 0x60, 0x6d, 0x9e, 0xa0,
-	0xe4, 0x9f, 0xe0, 0xa7, 0x7d, 0x5e, 0xb1, 0x20, 0x21, 0x64, 0x17, 0x6d, 0x41, 0xff, 0x31, 0x03,
-	0xf5, 0xf4, 0x94, 0xbe, 0x38, 0xdb, 0x98, 0x3f, 0xdb, 0xf8, 0x89, 0x0f, 0xb3, 0x3e, 0x56, 0xe0,
-	0xb5, 0x05, 0x84, 0xfe, 0x64, 0x8e, 0x4e, 0x9d, 0x70, 0x64, 0x9e, 0x7b, 0xc2, 0xf1, 0xa2, 0xae,
-	0xfe, 0x67, 0x05, 0xd6, 0xda, 0xe2, 0x60, 0x59, 0xfc, 0xc7, 0x5f, 0xde, 0x6c, 0xc6, 0xcf, 0x8e,
-	0x73, 0xb3, 0xeb, 0x1b, 0xb5, 0x01, 0xeb, 0xe7, 0xa0, 0xbd, 0xc4, 0xd9, 0xc4, 0x7f, 0x2b, 0xb0,
-	0x2a, 0x7b, 0xd1, 0x2e, 0xed, 0x46, 0x60, 0x01, 0x3b, 0xf8, 0x2d, 0xc8, 0x3a, 0xc3, 0x78, 0x07,
-	0x39, 0x7f, 0x09, 0xce, 0x14, 0xea, 0x1d, 0xc0, 0x69, 0xdc, 0x2f, 0x41, 0xdd, 0x3f, 0x65, 0x61,
-	0xb5, 0x37, 0x71, 0x9d, 0x48, 0x2a, 0x5f, 0xed, 0xc4, 0xff, 0x25, 0x58, 0x0e, 0x19, 0x58, 0x4b,
-	0x5c, 0xc9, 0x71, 0x62, 0x4b, 0xa4, 0xcc, 0x65, 0x0d, 0x2e, 0xc2, 0x6f, 0x43, 0x39, 0x36, 0x99,
-	0x7a, 0x91, 0x3c, 0x50, 0x03, 0x69, 0x31, 0xf5, 0x22, 0x7c, 0x13, 0xae, 0x79, 0xd3, 0x31, 0xbf,
-	0xd2, 0xb6, 0x26, 0x34, 0x88, 0x2f, 0x7c, 0xed, 0x20, 0xbe, 0x7a, 0xae, 0x79, 0xd3, 0x31, 0xf1,
-	0x4f, 0xc3, 0x2e, 0x0d, 0xc4, 0x85, 0xaf, 0x1d, 0x44, 0xf8, 0x0e, 0x94, 0x6c, 0x77, 0xe4, 0x07,
-	0x4e, 0x74, 0x34, 0x96, 0x77, 0xce, 0x6a, 0x7c, 0x03, 0x73, 0x9e, 0xfe, 0x1d, 0x2d, 0xb6, 0x24,
-	0xb3, 0x46, 0xea, 0x37, 0xa0, 0x94, 0xc8, 0x31, 0x82, 0x65, 0xfd, 0x7e, 0x5f, 0x6b, 0x59, 0xbd,
-	0x6e, 0xab, 0x69, 0xf6, 0xc4, 0x3d, 0xf1, 0x41, 0xbf, 0xd5, 0xb2, 0x7a, 0x0d, 0xcd, 0x40, 0x8a,
-	0x4a, 0x00, 0x78, 0x97, 0xbc, 0xf3, 0x19, 0x41, 0xca, 0x73, 0x08, 0x7a, 0x1d, 0x4a, 0x81, 0x7f,
-	0x2a, 0xb1, 0x67, 0x38, 0x9c, 0x62, 0xe0, 0x9f, 0x72, 0xe4, 0xaa, 0x06, 0x38, 0x3d, 0x57, 0x19,
-	0x6d, 0xa9, 0xe4, 0xad, 0xcc, 0x25, 0xef, 0xd9, 0xf8, 0x49, 0xf2, 0x16, 0x5b, 0x79, 0xf6, 0x9d,
-	0x7f, 0x40, 0x6d, 0x37, 0x8a, 0xd7, 0x2b, 0xf5, 0xaf, 0x33, 0x50, 0x21, 0x4c, 0xe2, 0x8c, 0x69,
-	0x2f, 0xb2, 0xa3, 0x90, 0x79, 0xea, 0x88, 0x9b, 0x58, 0xb3, 0xb4, 0x5b, 0x22, 0x65, 0x21, 0x13,
-	0x77, 0x05, 0xbb, 0xb0, 0x1e, 0xd2, 0x81, 0xef, 0x0d, 0x43, 0xeb, 0x90, 0x1e, 0x39, 0xde, 0xd0,
-	0x1a, 0xdb, 0x61, 0x24, 0xaf, 0x23, 0x2b, 0xa4, 0x26, 0x95, 0x7b, 0x5c, 0xd7, 0xe6, 0x2a, 0x7c,
-	0x03, 0xd6, 0x0e, 0x1d, 0xcf, 0xf5, 0x47, 0xd6, 0xc4, 0xb5, 0xcf, 0x68, 0x10, 0x4a, 0xa8, 0x2c,
-	0xbc, 0xf2, 0x04, 0x0b, 0x5d, 0x57, 0xa8, 0x84, 0xbb, 0x3f, 0x84, 0xed, 0x85, 0xa3, 0x58, 0x8f,
-	0x1d, 0x37, 0xa2, 0x01, 0x1d, 0x5a, 0x01, 0x9d, 0xb8, 0xce, 0x40, 0xbc, 0x26, 0x10, 0x7b, 0xf7,
-	0xaf, 0x2e, 0x18, 0xfa, 0x40, 0x9a, 0x93, 0x99, 0x35, 0x63, 0x7b, 0x30, 0x99, 0x5a, 0x53, 0x7e,
-	0x83, 0xc8, 0x56, 0x31, 0x85, 0x14, 0x07, 0x93, 0x69, 0x9f, 0xd5, 0x31, 0x82, 0xec, 0x93, 0x89,
-	0x58, 0xbc, 0x14, 0xc2, 0x8a, 0xea, 0x8f, 0x15, 0xa8, 0x6a, 0xa3, 0x51, 0x40, 0x47, 0x76, 0x24,
-	0x69, 0xba, 0x01, 0x6b, 0x82, 0x92, 0x33, 0x4b, 0x3e, 0x53, 0x12, 0x78, 0x14, 0x81, 0x47, 0xea,
-	0xc4, 0x23, 0xa5, 0x38, 0x7c, 0xaf, 0x4e, 0xbd, 0x85, 0x6d, 0x32, 0xbc, 0xcd, 0x5a, 0xa2, 0x4d,
-	0xb7, 0xfa, 0x79, 0x78, 0x6d, 0x31, 0x0b, 0x63, 0x47, 0x3c, 0x34, 0xa9, 0x90, 0xab, 0x0b, 0x40,
-	0xb7, 0x1d, 0xef, 0x33, 0x9a, 0xda, 0x4f, 0x39, 0x5f, 0x9f, 0xd2, 0xd4, 0x7e, 0xaa, 0xfe, 0x7b,
-	0x72, 0x03, 0x10, 0x87, 0x4b, 0xb2, 0x1a, 0xc7, 0x79, 0x41, 0xf9, 0xac, 0xbc, 0x50, 0x87, 0xa5,
-	0x90, 0x06, 0x27, 0x8e, 0x37, 0x8a, 0xaf, 0xa8, 0x65, 0x15, 0xf7, 0xe0, 0xab, 0x12, 0x3b, 0x7d,
-	0x1a, 0xd1, 0xc0, 0xb3, 0x5d, 0xf7, 0xcc, 0x12, 0x07, 0x15, 0x5e, 0x44, 0x87, 0xd6, 0xec, 0x51,
-	0x95, 0x58, 0x91, 0xbf, 0x2c, 0xac, 0xf5, 0xc4, 0x98, 0x24, 0xb6, 0x66, 0xf2, 0xdc, 0xea, 0x5b,
-	0x50, 0x0d, 0x64, 0x10, 0x5b, 0x21, 0x73, 0x8f, 0xcc, 0x47, 0x6b, 0xc9, 0x3d, 0x73, 0x2a, 0xc2,
-	0x49, 0x25, 0x98, 0x0b, 0xf8, 0xef, 0xc0, 0x8a, 0x1d, 0xfb, 0x56, 0xb6, 0x9e, 0xdf, 0xb7, 0xcc,
-	0x7b, 0x9e, 0x54, 0xed, 0xf9, 0x48, 0xb8, 0x0d, 0xcb, 0x12, 0x91, 0xed, 0x3a, 0xf6, 0x6c, 0x63,
-	0x7b, 0xee, 0xa5, 0x9a, 0xc6, 0x94, 0x44, 0xbe, 0x69, 0xe3, 0x15, 0xf6, 0x1f, 0x5d, 0xeb, 0x4f,
-	0x86, 0xbc, 0xa7, 0x4b, 0xbc, 0xbb, 0x48, 0x3f, 0x6b, 0xcb, 0xcd, 0x3f, 0x6b, 0x9b, 0x7f, 0x26,
-	0x97, 0x3f, 0xf7, 0x4c, 0x4e, 0xbd, 0x03, 0x6b, 0xf3, 0xf8, 0x65, 0x94, 0x6d, 0x41, 0x9e, 0x5f,
-	0xa8, 0x9f, 0x5b, 0x46, 0x53, 0x37, 0xe6, 0x44, 0x18, 0xa8, 0x7f, 0xab, 0x40, 0x6d, 0xc1, 0x2f,
-	0x56, 0xf2, 0xff, 0xa6, 0xa4, 0x8e, 0x87, 0x7e, 0x16, 0xf2, 0xfc, 0x6a, 0x5f, 0xbe, 0x58, 0xb9,
-	0x76, 0xf1, 0x0f, 0x8d, 0x5f, 0xc3, 0x13, 0x61, 0xc5, 0x12, 0x21, 0x0f, 0xa8, 0x01, 0x3f, 0x1f,
-	0x8a, 0x77, 0x88, 0x65, 0x26, 0x13, 0x47, 0x46, 0x17, 0x0f, 0x9c, 0x72, 0xcf, 0x3d, 0x70, 0xda,
-	0xfe, 0x83, 0x2c, 0x94, 0xda, 0x67, 0xbd, 0x27, 0xee, 0x81, 0x6b, 0x8f, 0xf8, 0x3d, 0x79, 0xbb,
-	0x6b, 0x3e, 0x42, 0x57, 0xf0, 0x2a, 0x54, 0x8c, 0x8e, 0x69, 0x19, 0x6c, 0x29, 0x39, 0x68, 0x69,
-	0x77, 0x91, 0xc2, 0xd6, 0x9a, 0x2e, 0x69, 0x5a, 0xf7, 0xf4, 0x47, 0x42, 0x92, 0xc1, 0x35, 0x58,
-	0xe9, 0x1b, 0xcd, 0xfb, 0x7d, 0x7d, 0x26, 0xcc, 0xe1, 0x75, 0x58, 0x6d, 0xf7, 0x5b, 0x66, 0xb3,
-	0xdb, 0x4a, 0x89, 0x8b, 0x6c, 0x5d, 0xda, 0x6b, 0x75, 0xf6, 0x44, 0x15, 0xb1, 0xfe, 0xfb, 0x46,
-	0xaf, 0x79, 0xd7, 0xd0, 0xf7, 0x85, 0x68, 0x93, 0x89, 0x3e, 0xd4, 0x49, 0xe7, 0xa0, 0x19, 0x0f,
-	0x79, 0x07, 0x23, 0x28, 0xef, 0x35, 0x0d, 0x8d, 0xc8, 0x5e, 0x9e, 0x29, 0xb8, 0x0a, 0x25, 0xdd,
-	0xe8, 0xb7, 0x65, 0x3d, 0x83, 0xeb, 0x50, 0xd3, 0xfa, 0x66, 0xc7, 0x6a, 0x1a, 0x0d, 0xa2, 0xb7,
-	0x75, 0xc3, 0x94, 0x9a, 0x1c, 0xae, 0x41, 0xd5, 0x6c, 0xb6, 0xf5, 0x9e, 0xa9, 0xb5, 0xbb, 0x52,
-	0xc8, 0x66, 0x51, 0xec, 0xe9, 0xb1, 0x0d, 0xc2, 0x1b, 0xb0, 0x6e, 0x74, 0x2c, 0xf9, 0xd8, 0xc9,
-	0x7a, 0xa0, 0xb5, 0xfa, 0xba, 0xd4, 0x6d, 0xe2, 0x6b, 0x80, 0x3b, 0x86, 0xd5, 0xef, 0xee, 0x6b,
-	0xa6, 0x6e, 0x19, 0x9d, 0x87, 0x52, 0x71, 0x07, 0x57, 0xa1, 0x38, 0x9b, 0xc1, 0x33, 0xc6, 0x42,
-	0xa5, 0xab, 0x11, 0x73, 0x06, 0xf6, 0xd9, 0x33, 0x46, 0x16, 0xdc, 0x25, 0x9d, 0x7e, 0x77, 0x66,
-	0xb6, 0x0a, 0x65, 0x49, 0x96, 0x14, 0xe5, 0x98, 0x68, 0xaf, 0x69, 0x34, 0x92, 0xf9, 0x3d, 0x2b,
-	0x6e, 0x64, 0x90, 0xb2, 0x7d, 0x0c, 0x39, 0xee, 0x8e, 0x22, 0xe4, 0x8c, 0x8e, 0xa1, 0xa3, 0x2b,
-	0x78, 0x05, 0xa0, 0xd9, 0x6b, 0x1a, 0xa6, 0x7e, 0x97, 0x68, 0x2d, 0x06, 0x9b, 0x0b, 0x62, 0x02,
-	0x19, 0xda, 0x65, 0x58, 0x6a, 0xf6, 0x0e, 0x5a, 0x1d, 0xcd, 0x94, 0x30, 0x9b, 0xbd, 0xfb, 0xfd,
-	0x8e, 0xc9, 0x94, 0x08, 0x97, 0xa1, 0xd0, 0xec, 0x99, 0xfa, 0xf7, 0x4c, 0x86, 0x8b, 0xeb, 0x04,
-	0xab, 0xe8, 0xd9, 0x9d, 0xed, 0x1f, 0x65, 0x21, 0xc7, 0x9f, 0xaa, 0x56, 0xa0, 0xc4, 0xbd, 0x6d,
-	0x3e, 0xea, 0xb2, 0x21, 0x4b, 0x90, 0x6b, 0x1a, 0xe6, 0x6d, 0xf4, 0x4b, 0x19, 0x0c, 0x90, 0xef,
-	0xf3, 0xf2, 0x2f, 0x17, 0x58, 0xb9, 0x69, 0x98, 0xef, 0xde, 0x42, 0x3f, 0xc8, 0xb0, 0x6e, 0xfb,
-	0xa2, 0xf2, 0x2b, 0xb1, 0x62, 0xf7, 0x26, 0xfa, 0x61, 0xa2, 0xd8, 0xbd, 0x89, 0x7e, 0x35, 0x56,
-	0xbc, 0xb7, 0x8b, 0x7e, 0x2d, 0x51, 0xbc, 0xb7, 0x8b, 0x7e, 0x3d, 0x56, 0xdc, 0xba, 0x89, 0x7e,
-	0x23, 0x51, 0xdc, 0xba, 0x89, 0x7e, 0xb3, 0xc0, 0xb0, 0x70, 0x24, 0xef, 0xed, 0xa2, 0xdf, 0x2a,
-	0x26, 0xb5, 0x5b, 0x37, 0xd1, 0x6f, 0x17, 0x99, 0xff, 0x13, 0xaf, 0xa2, 0xdf, 0x41, 0x6c, 0x9a,
-	0xcc, 0x41, 0xe8, 0x77, 0x79, 0x91, 0xa9, 0xd0, 0xef, 0x21, 0x86, 0x91, 0x49, 0x79, 0xf5, 0x23,
-	0xae, 0x79, 0xa4, 0x6b, 0x04, 0xfd, 0x7e, 0x41, 0xbc, 0x6d, 0x6b, 0x34, 0xdb, 0x5a, 0x0b, 0x61,
-	0xde, 0x82, 0xb1, 0xf2, 0x87, 0x37, 0x58, 0x91, 0x85, 0x27, 0xfa, 0xa3, 0x2e, 0x1b, 0xf0, 0x81,
-	0x46, 0x1a, 0x1f, 0x68, 0x04, 0xfd, 0xf1, 0x0d, 0x36, 0xe0, 0x03, 0x8d, 0x48, 0xbe, 0xfe, 0xa4,
-	0xcb, 0x0c, 0xb9, 0xea, 0xe3, 0x1b, 0x6c, 0xd2, 0x52, 0xfe, 0xa7, 0x5d, 0x5c, 0x84, 0xec, 0x5e,
-	0xd3, 0x44, 0x3f, 0xe2, 0xa3, 0xb1, 0x10, 0x45, 0x7f, 0x86, 0x98, 0xb0, 0xa7, 0x9b, 0xe8, 0xcf,
-	0x99, 0x30, 0x6f, 0xf6, 0xbb, 0x2d, 0x1d, 0xbd, 0xc1, 0x26, 0x77, 0x57, 0xef, 0xb4, 0x75, 0x93,
-	0x3c, 0x42, 0x7f, 0xc1, 0xcd, 0xbf, 0xdb, 0xeb, 0x18, 0xe8, 0x13, 0x84, 0xab, 0x00, 0xfa, 0xf7,
-	0xba, 0x44, 0xef, 0xf5, 0x9a, 0x1d, 0x03, 0xbd, 0xbd, 0x7d, 0x00, 0xe8, 0x7c, 0x3a, 0x60, 0x00,
-	0xfa, 0xc6, 0x3d, 0xa3, 0xf3, 0xd0, 0x40, 0x57, 0x58, 0xa5, 0x4b, 0xf4, 0xae, 0x46, 0x74, 0xa4,
-	0x60, 0x80, 0x82, 0x7c, 0x31, 0x97, 0xc1, 0xcb, 0x50, 0x24, 0x9d, 0x56, 0x6b, 0x4f, 0x6b, 0xdc,
-	0x43, 0xd9, 0xbd, 0x6f, 0xc2, 0x8a, 0xe3, 0xef, 0x9c, 0x38, 0x11, 0x0d, 0x43, 0xf1, 0x18, 0xfa,
-	0x43, 0x55, 0xd6, 0x1c, 0xff, 0xba, 0x28, 0x5d, 0x1f, 0xf9, 0xd7, 0x4f, 0xa2, 0xeb, 0x5c, 0x7b,
-	0x9d, 0x67, 0x8c, 0xc3, 0x02, 0xaf, 0xbc, 0xf7, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc5, 0x5b,
-	0xfa, 0xe7, 0x6a, 0x2d, 0x00, 0x00,
+	// 3649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x3b, 0x4d, 0x93, 0x1b, 0xd7,
+	0x71, 0x19, 0x00, 0x8b, 0x05, 0x1a, 0x0b, 0xec, 0xec, 0xec, 0x2e, 0x05, 0xad, 0x3e, 0x2c, 0x8f,
+	0x6d, 0x99, 0xa6, 0xed, 0xa5, 0x44, 0xcb, 0x0a, 0x23, 0x3b, 0x8e, 0xb0, 0xd8, 0x59, 0x12, 0x26,
+	0xbe, 0x38, 0x18, 0x90, 0xa6, 0x2a, 0x55, 0x53, 0x43, 0x60, 0x88, 0x9d, 0xe2, 0x00, 0x03, 0xcd,
+	0xcc, 0x52, 0xdc, 0x1b, 0x13, 0xc7, 0xf9, 0xfe, 0x50, 0x3e, 0x1c, 0xc5, 0x49, 0x45, 0x95, 0x5b,
+	0x6e, 0xf9, 0x0d, 0xa9, 0x1c, 0x72, 0xcc, 0x2d, 0x87, 0x24, 0x87, 0xa4, 0x2a, 0x95, 0xca, 0x25,
+	0xe5, 0xca, 0x29, 0x87, 0x1c, 0x52, 0xe9, 0xee, 0xf7, 0x66, 0x30, 0x58, 0x42, 0x24, 0xad, 0xe4,
+	0xb2, 0x94, 0x4f, 0x78, 0xaf, 0xbb, 0xdf, 0x47, 0x7f, 0xbc, 0xee, 0x9e, 0xf7, 0x1a, 0x50, 0x79,
+	0xff, 0xc4, 0x0d, 0x4f, 0xf7, 0xe7, 0x61, 0x10, 0x07, 0xda, 0x1a, 0x77, 0xf6, 0x6a, 0x71, 0x30,
+	0x0f, 0xc6, 0x4e, 0xec, 0x08, 0xf0, 0x5e, 0xe5, 0x41, 0x1c, 0xce, 0x47, 0xa2, 0xa3, 0xff, 0x40,
+	0x81, 0xa2, 0xe5, 0x84, 0x13, 0x37, 0xd6, 0xf6, 0xa0, 0x74, 0xdf, 0x3d, 0x8d, 0xe6, 0xce, 0xc8,
+	0xad, 0x2b, 0xaf, 0x29, 0x17, 0xcb, 0x66, 0xda, 0xd7, 0x76, 0x60, 0x2d, 0x3a, 0x76, 0xc2, 0x71,
+	0x3d, 0xc7, 0x08, 0xd1, 0xd1, 0xbe, 0x09, 0x95, 0xd8, 0xb9, 0xeb, 0xbb, 0xb1, 0x1d, 0x9f, 0xce,
+	0xdd, 0x7a, 0x1e, 0x71, 0xb5, 0x2b, 0x3b, 0xfb, 0xe9, 0x7a, 0x16, 0x23, 0x2d, 0xc4, 0x99, 0x10,
+	0xa7, 0x6d, 0x4d, 0x83, 0xc2, 0xc8, 0xf5, 0xfd, 0x7a, 0x81, 0xe7, 0xe2, 0xb6, 0x7e, 0x08, 0xb5,
+	0x5b, 0xd6, 0x35, 0x27, 0x76, 0x9b, 0x8e, 0xef, 0xbb, 0x61, 0xeb, 0x90, 0xb6, 0x73, 0x12, 0xb9,
+	0xe1, 0xcc, 0x99, 0xa6, 0xdb, 0x49, 0xfa, 0xda, 0x05, 0x28, 0x4e, 0xc2, 0xe0, 0x64, 0x1e, 0xe1,
+	0x7e, 0xf2, 0x88, 0x91, 0x3d, 0xfd, 0x17, 0x01, 0x8c, 0x07, 0xee, 0x2c, 0xb6, 0x82, 0xfb, 0xee,
+	0x4c, 0x7b, 0x19, 0xca, 0xb1, 0x37, 0x75, 0xa3, 0xd8, 0x99, 0xce, 0x79, 0x8a, 0xbc, 0xb9, 0x00,
+	0x7c, 0x02, 0x4b, 0xb8, 0xea, 0x3c, 0x88, 0xbc, 0xd8, 0x0b, 0x66, 0xcc, 0x0f, 0xae, 0x9a, 0xf4,
+	0xf5, 0xef, 0xc0, 0xda, 0x2d, 0xc7, 0x3f, 0x71, 0xb5, 0xcf, 0x41, 0x81, 0x19, 0x56, 0x98, 0xe1,
+	0xca, 0xbe, 0x10, 0x3a, 0xf3, 0xc9, 0x08, 0x9a, 0xfb, 0x01, 0x51, 0xf2, 0xdc, 0x1b, 0xa6, 0xe8,
+	0xe8, 0xf7, 0x61, 0xe3, 0xc0, 0x9b, 0x8d, 0x6f, 0x39, 0xa1, 0x47, 0xc2, 0xf8, 0x94, 0xd3, 0x68,
+	0x5f, 0x84, 0x22, 0x37, 0x22, 0xdc, 0x60, 0xfe, 0x62, 0xe5, 0xca, 0x86, 0x1c, 0xc8, 0x7b, 0x33,
+	0x25, 0x4e, 0xff, 0x1b, 0x05, 0xe0, 0x20, 0x38, 0x99, 0x8d, 0x6f, 0x12, 0x52, 0x53, 0x21, 0x1f,
+	0xbd, 0xef, 0x4b, 0x41, 0x52, 0x53, 0xbb, 0x01, 0xb5, 0xbb, 0xb8, 0x1b, 0xfb, 0x81, 0xdc, 0x8e,
+	0x90, 0x65, 0xe5, 0xca, 0x17, 0xe5, 0x74, 0x8b, 0xc1, 0xfb, 0xd9, 0x5d, 0x47, 0xc6, 0x2c, 0x0e,
+	0x4f, 0xcd, 0xea, 0xdd, 0x2c, 0x6c, 0x6f, 0x08, 0xda, 0xe3, 0x44, 0xb4, 0x28, 0x5a, 0x50, 0xb2,
+	0x28, 0x36, 0xb5, 0xaf, 0x64, 0x39, 0xaa, 0x5c, 0xd9, 0x4e, 0xd6, 0xca, 0x8c, 0x95, 0x6c, 0xbe,
+	0x93, 0xbb, 0xaa, 0xe8, 0x3f, 0x2e, 0x43, 0xcd, 0x78, 0xe8, 0x8e, 0x4e, 0x62, 0xb7, 0x37, 0x27,
+	0x1d, 0x44, 0xda, 0x3e, 0x6c, 0x7b, 0xb3, 0x91, 0x7f, 0x32, 0x76, 0x6d, 0x97, 0x54, 0x6d, 0xc7,
+	0xa4, 0x6b, 0x9e, 0xaf, 0x64, 0x6e, 0x49, 0x54, 0xc6, 0x08, 0x1a, 0xb0, 0x3d, 0x0a, 0xa6, 0x73,
+	0x27, 0x5c, 0xa6, 0xcf, 0xf3, 0xfa, 0x5b, 0x72, 0xfd, 0x05, 0xbd, 0xb9, 0x25, 0xa9, 0x33, 0x53,
+	0x74, 0x60, 0x53, 0xce, 0x3b, 0xb6, 0xef, 0x79, 0xae, 0x3f, 0x8e, 0xd8, 0x74, 0x6b, 0xa9, 0xa8,
+	0x96, 0xb7, 0xb8, 0xdf, 0x92, 0xc4, 0x47, 0x4c, 0x6b, 0xd6, 0xbc, 0xa5, 0xbe, 0x76, 0x09, 0xb6,
+	0x46, 0xbe, 0x47, 0x5b, 0xb9, 0x47, 0x22, 0xb6, 0xc3, 0xe0, 0x83, 0xa8, 0xbe, 0xc6, 0xfb, 0xdf,
+	0x14, 0x88, 0x23, 0x82, 0x9b, 0x08, 0xd6, 0xde, 0x81, 0xd2, 0x07, 0x41, 0x78, 0xdf, 0x0f, 0x9c,
+	0x71, 0xbd, 0xc8, 0x6b, 0xbe, 0xba, 0x7a, 0xcd, 0xdb, 0x92, 0xca, 0x4c, 0xe9, 0xb5, 0x8b, 0xa0,
+	0xa2, 0x9e, 0xed, 0xc8, 0xf5, 0xdd, 0x51, 0x6c, 0xfb, 0xde, 0xd4, 0x8b, 0xeb, 0x25, 0x3e, 0x05,
+	0x35, 0x84, 0x0f, 0x18, 0xdc, 0x26, 0xa8, 0x66, 0xc3, 0x6e, 0x1c, 0x3a, 0xb3, 0xc8, 0x19, 0xd1,
+	0x64, 0xb6, 0x17, 0x05, 0xbe, 0xc3, 0x27, 0xa0, 0xcc, 0x4b, 0x5e, 0x5a, 0xbd, 0xa4, 0xb5, 0x18,
+	0xd2, 0x4a, 0x46, 0x98, 0x3b, 0xf1, 0x0a, 0xa8, 0xf6, 0x26, 0xec, 0x46, 0xf7, 0xbd, 0xb9, 0xcd,
+	0xf3, 0xd8, 0x73, 0xdf, 0x99, 0xd9, 0x23, 0x67, 0x74, 0xec, 0xd6, 0x81, 0xd9, 0xd6, 0x08, 0xc9,
+	0xa6, 0xd6, 0x47, 0x54, 0x93, 0x30, 0x64, 0xfb, 0x38, 0x15, 0xba, 0xa2, 0x0a, 0x93, 0x88, 0x8e,
+	0xf6, 0x0a, 0x00, 0x9d, 0xe0, 0xe0, 0x24, 0xb6, 0xa7, 0x51, 0x7d, 0x63, 0x71, 0xa6, 0x11, 0xd2,
+	0x61, 0x71, 0xcd, 0x43, 0x2f, 0x08, 0xbd, 0xf8, 0xb4, 0x5e, 0x7d, 0x92, 0xb8, 0xfa, 0x92, 0xca,
+	0x4c, 0xe9, 0xf1, 0x34, 0xa2, 0x33, 0x23, 0x47, 0x68, 0xb3, 0x73, 0xaa, 0xb1, 0xd1, 0x82, 0x00,
+	0x35, 0x11, 0xa2, 0x7d, 0x1d, 0xb4, 0xd0, 0x45, 0x0f, 0xf4, 0xc0, 0xb5, 0x47, 0xc1, 0x6c, 0xe6,
+	0x32, 0x8f, 0xf5, 0x4d, 0x61, 0x78, 0x12, 0xd3, 0x4c, 0x11, 0x34, 0x9f, 0x04, 0x8e, 0x6d, 0x6f,
+	0x5c, 0x57, 0x79, 0xaf, 0x90, 0x80, 0x5a, 0x63, 0xb2, 0x83, 0x0f, 0x1c, 0x8f, 0xac, 0x20, 0xb4,
+	0x53, 0x9f, 0xb3, 0xc5, 0xcb, 0x6e, 0x12, 0xe2, 0x28, 0x08, 0xfb, 0x12, 0xac, 0xe9, 0x50, 0x8d,
+	0xe6, 0x9e, 0xef, 0xa3, 0xf5, 0xda, 0x63, 0x2f, 0xba, 0x5f, 0xd7, 0x78, 0xd9, 0x0a, 0x03, 0xad,
+	0xe0, 0x10, 0x41, 0xa4, 0xef, 0xa9, 0xf3, 0xd0, 0x46, 0xef, 0xe6, 0xbb, 0x33, 0x37, 0x8a, 0x48,
+	0x42, 0xdb, 0x42, 0xdf, 0x08, 0x1f, 0x24, 0x60, 0x14, 0xd3, 0x11, 0xbc, 0xb6, 0x4c, 0x79, 0x0f,
+	0x9d, 0xee, 0x5d, 0x67, 0x74, 0x9f, 0xa6, 0x9f, 0x3a, 0x51, 0xec, 0x86, 0xf5, 0x1d, 0x5e, 0xe0,
+	0xe5, 0xec, 0xc8, 0x23, 0x49, 0x65, 0x05, 0x1d, 0xa6, 0xd1, 0xbf, 0x05, 0xb5, 0x65, 0x5b, 0xd7,
+	0xb6, 0xa0, 0x6a, 0xdd, 0xe9, 0x1b, 0x76, 0xa3, 0x7b, 0x68, 0x77, 0x1b, 0x1d, 0x43, 0xfd, 0x19,
+	0xad, 0x0a, 0x65, 0x06, 0xf5, 0xba, 0xed, 0x3b, 0xaa, 0xa2, 0xad, 0x43, 0xbe, 0xd1, 0x6e, 0xab,
+	0x39, 0xfd, 0x2a, 0x94, 0x12, 0xa3, 0xd5, 0x36, 0xa1, 0x32, 0xec, 0x0e, 0xfa, 0x46, 0xb3, 0x75,
+	0xd4, 0x32, 0x0e, 0x71, 0x50, 0x09, 0x0a, 0xbd, 0xb6, 0xd5, 0x47, 0x7a, 0x6e, 0x35, 0xfa, 0x6a,
+	0x8e, 0x46, 0x1e, 0x1e, 0x34, 0xd4, 0xbc, 0xfe, 0x97, 0x0a, 0xec, 0xac, 0x32, 0x3e, 0xad, 0x02,
+	0xeb, 0x87, 0xc6, 0x51, 0x63, 0xd8, 0xb6, 0x70, 0x8a, 0x6d, 0xd8, 0x34, 0x8d, 0xbe, 0xd1, 0xb0,
+	0x1a, 0x07, 0x6d, 0xc3, 0x36, 0x8d, 0xc6, 0x21, 0xce, 0xa6, 0x41, 0x8d, 0x5a, 0x76, 0xb3, 0xd7,
+	0xe9, 0xb4, 0x2c, 0x0b, 0xd7, 0xca, 0xa1, 0xa5, 0xa9, 0x0c, 0x1b, 0x76, 0x17, 0xd0, 0x3c, 0xfa,
+	0xae, 0x8d, 0x81, 0x61, 0xb6, 0x1a, 0xed, 0xd6, 0x7b, 0x34, 0x81, 0x5a, 0xd0, 0x3e, 0x0f, 0xaf,
+	0x34, 0x7b, 0xdd, 0x41, 0x6b, 0x60, 0x19, 0x5d, 0xcb, 0x1e, 0x74, 0x1b, 0xfd, 0xc1, 0xf5, 0x9e,
+	0xc5, 0x33, 0x0b, 0xe6, 0xd6, 0xb4, 0x1a, 0x40, 0x63, 0x68, 0xf5, 0xc4, 0x3c, 0x6a, 0x51, 0xff,
+	0x0a, 0x94, 0x12, 0x4b, 0xd3, 0x00, 0x8a, 0xdd, 0x9e, 0xd9, 0x69, 0xb4, 0x05, 0x7b, 0xd7, 0x5b,
+	0xd7, 0xae, 0x0b, 0x71, 0xb4, 0x7b, 0xb7, 0xd5, 0xdc, 0x77, 0x0b, 0x25, 0x05, 0x85, 0xf2, 0x51,
+	0x0e, 0xd6, 0x58, 0x94, 0x14, 0x24, 0x33, 0xa1, 0x8f, 0xdb, 0x69, 0xc0, 0xc8, 0x3d, 0x21, 0x60,
+	0x70, 0x9c, 0x95, 0xa1, 0x4b, 0x74, 0xb4, 0x97, 0xa0, 0x1c, 0x84, 0x13, 0x5b, 0x60, 0x44, 0xd0,
+	0x2d, 0x21, 0x80, 0xa3, 0x33, 0x05, 0x3c, 0x8a, 0xd5, 0x77, 0x9d, 0xc8, 0x65, 0x27, 0x84, 0xb8,
+	0xa4, 0xaf, 0xbd, 0x08, 0x44, 0x67, 0xf3, 0x3e, 0x8a, 0x8c, 0x5b, 0xc7, 0x7e, 0x97, 0xb6, 0xf2,
+	0x05, 0xa8, 0x8e, 0x02, 0xff, 0x64, 0x3a, 0xb3, 0xd1, 0x36, 0x26, 0xf1, 0x71, 0x7d, 0x1d, 0xf1,
+	0x55, 0x73, 0x43, 0x00, 0xdb, 0x0c, 0xd3, 0xea, 0xb0, 0x3e, 0xc2, 0xa8, 0x1a, 0xb9, 0xc2, 0xf1,
+	0x54, 0xcd, 0xa4, 0xcb, 0xab, 0xba, 0x23, 0x6f, 0xea, 0xf8, 0x11, 0x3b, 0x99, 0xaa, 0x99, 0xf6,
+	0x89, 0x89, 0x7b, 0xbe, 0x33, 0x89, 0xd8, 0x39, 0x54, 0x4d, 0xd1, 0xd1, 0x7f, 0x16, 0xf2, 0xe8,
+	0x11, 0x69, 0x4a, 0xb1, 0x60, 0x84, 0x92, 0xc9, 0x5f, 0xd4, 0xcc, 0xa4, 0x4b, 0x39, 0x81, 0x0c,
+	0x8b, 0x22, 0x5a, 0x26, 0x81, 0x10, 0x33, 0x9c, 0x0d, 0xd3, 0x8d, 0x4e, 0xfc, 0xd8, 0x78, 0x88,
+	0x4e, 0x24, 0xd2, 0xae, 0x40, 0x25, 0x1b, 0x09, 0x94, 0x4f, 0x8a, 0x04, 0xe0, 0x2e, 0x42, 0x00,
+	0x2e, 0x7b, 0x0f, 0x8f, 0xee, 0x31, 0x1e, 0x0c, 0x11, 0x69, 0x92, 0xae, 0xf6, 0xe5, 0xc4, 0x4f,
+	0x2d, 0x47, 0x14, 0xf6, 0x66, 0x16, 0x21, 0xa4, 0xeb, 0xa2, 0x80, 0x5c, 0x61, 0xa8, 0xd8, 0x0c,
+	0x85, 0x71, 0x19, 0x4c, 0x94, 0xa5, 0x30, 0xce, 0xea, 0x37, 0x25, 0x8e, 0xe4, 0x4c, 0xf1, 0xc1,
+	0x76, 0xee, 0xdd, 0x43, 0xbf, 0xe2, 0x8a, 0x6c, 0xa5, 0x60, 0x6e, 0x10, 0xb0, 0x21, 0x61, 0xa4,
+	0x60, 0x6f, 0x86, 0x7e, 0x25, 0x26, 0x47, 0x93, 0x67, 0x82, 0x92, 0x00, 0xa0, 0x9b, 0x79, 0x15,
+	0x0a, 0x1c, 0x61, 0x0a, 0xbc, 0x0a, 0xc8, 0x55, 0x50, 0x96, 0x26, 0xc3, 0xb5, 0xaf, 0x42, 0xd1,
+	0x65, 0xc1, 0xb0, 0xfa, 0x17, 0x31, 0x39, 0x2b, 0x33, 0x53, 0x92, 0xe8, 0xdf, 0x86, 0x0d, 0xe6,
+	0xe1, 0xb6, 0x13, 0xce, 0xbc, 0xd9, 0x84, 0x53, 0xb9, 0x60, 0x2c, 0xac, 0xb4, 0x6a, 0x72, 0x9b,
+	0x64, 0x85, 0x39, 0x56, 0xe4, 0x4c, 0x5c, 0x99, 0x5a, 0x25, 0x5d, 0xfd, 0x2f, 0xf2, 0x50, 0x19,
+	0xc4, 0xa1, 0xeb, 0x4c, 0x59, 0xcc, 0xda, 0xb7, 0x01, 0xd0, 0x07, 0xc5, 0xee, 0x14, 0x3b, 0x89,
+	0x18, 0x5e, 0x96, 0xcb, 0x67, 0xe8, 0xb0, 0x2d, 0x89, 0xcc, 0x0c, 0xfd, 0x59, 0x3d, 0xe6, 0x9e,
+	0x41, 0x8f, 0x7b, 0x1f, 0xe7, 0xa0, 0x9c, 0xce, 0x86, 0xb9, 0x41, 0x69, 0x84, 0xed, 0x49, 0x10,
+	0x9e, 0xca, 0x24, 0xec, 0x4b, 0x4f, 0x5a, 0x7d, 0xbf, 0x29, 0x89, 0xcd, 0x74, 0x18, 0x07, 0x24,
+	0x3a, 0x47, 0xe2, 0x90, 0x08, 0x7e, 0xcb, 0x0c, 0xe1, 0x63, 0xf2, 0x0e, 0x68, 0x18, 0x60, 0xa6,
+	0x0e, 0x46, 0x3d, 0x4c, 0x7f, 0x92, 0xec, 0x21, 0xbf, 0x42, 0xe1, 0xaa, 0xa4, 0xbb, 0xe1, 0x9e,
+	0x4a, 0x5f, 0x7a, 0x75, 0x79, 0xac, 0x34, 0xee, 0xc7, 0xd5, 0x98, 0x19, 0xc9, 0x29, 0x60, 0x94,
+	0x24, 0x7b, 0x6b, 0x7c, 0x0e, 0xa8, 0xa9, 0x7f, 0x19, 0x4a, 0xc9, 0xe6, 0xb5, 0x32, 0xac, 0x19,
+	0x61, 0x18, 0x84, 0xe8, 0x87, 0xc8, 0xa5, 0x76, 0xda, 0xc2, 0x0d, 0x1d, 0x1e, 0x92, 0x57, 0xfe,
+	0xeb, 0x5c, 0x9a, 0x71, 0x99, 0x2e, 0xae, 0x11, 0xc5, 0xda, 0x2f, 0xc0, 0xb6, 0xcb, 0x96, 0xe6,
+	0x51, 0xe4, 0xe3, 0xf4, 0x9c, 0xec, 0x4c, 0x9c, 0x9b, 0xcd, 0x7d, 0xf1, 0x35, 0x91, 0xa4, 0xed,
+	0xe6, 0x56, 0x4a, 0x2b, 0x41, 0x63, 0xcd, 0xc0, 0x94, 0x6d, 0x3a, 0x75, 0xc7, 0x1e, 0xee, 0x20,
+	0x33, 0x81, 0x50, 0xd8, 0x6e, 0x92, 0xbd, 0x2e, 0x65, 0xff, 0x98, 0xc9, 0x25, 0x23, 0xd2, 0x69,
+	0xbe, 0x04, 0x45, 0x11, 0x8d, 0xe5, 0x51, 0xab, 0x26, 0xfe, 0x8f, 0x81, 0xa6, 0x44, 0xd2, 0x81,
+	0x64, 0x38, 0x7b, 0xba, 0x85, 0x41, 0x2c, 0xd2, 0x59, 0x53, 0xe0, 0x71, 0xbe, 0xda, 0x52, 0xd6,
+	0x33, 0x66, 0x81, 0xe5, 0xcd, 0x6a, 0x36, 0x85, 0x19, 0x6b, 0x97, 0x61, 0x3d, 0x10, 0x59, 0x03,
+	0xfb, 0xc0, 0xc5, 0x8e, 0x97, 0x53, 0x0a, 0x33, 0xa1, 0xd2, 0x7f, 0x1e, 0x36, 0x53, 0x09, 0x46,
+	0x73, 0x84, 0xb8, 0x18, 0xea, 0x8b, 0x21, 0x1f, 0x27, 0x29, 0x35, 0x2d, 0xeb, 0x25, 0xc4, 0x41,
+	0x33, 0x25, 0x85, 0x3e, 0xc6, 0xb8, 0xc5, 0xad, 0xdb, 0x5e, 0x7c, 0xcc, 0x8a, 0xc2, 0x9d, 0xae,
+	0xb9, 0xd4, 0x38, 0x23, 0x73, 0xb3, 0xdf, 0x64, 0xbc, 0x29, 0xb0, 0x99, 0x55, 0x72, 0x4f, 0x5d,
+	0xe5, 0x3f, 0x73, 0xb0, 0x2d, 0x77, 0x79, 0xe0, 0xc4, 0xa3, 0xe3, 0x73, 0xaa, 0xec, 0xaf, 0xc2,
+	0x3a, 0xc1, 0xbd, 0xf4, 0x60, 0xac, 0x50, 0x77, 0x42, 0x41, 0x0a, 0x77, 0x22, 0x3b, 0xa3, 0x5d,
+	0x99, 0x75, 0x57, 0x9d, 0x28, 0x93, 0x4e, 0xac, 0xb0, 0x8b, 0xe2, 0x53, 0xec, 0x62, 0xfd, 0x99,
+	0xec, 0xe2, 0x10, 0x76, 0x96, 0x25, 0x2e, 0x8d, 0xe3, 0x6b, 0xb0, 0x2e, 0x94, 0x92, 0xb8, 0xc0,
+	0x55, 0x7a, 0x4b, 0x48, 0xf4, 0xbf, 0xcd, 0xc1, 0x8e, 0xf4, 0x4e, 0x9f, 0x8d, 0x63, 0x9a, 0x91,
+	0xf3, 0xda, 0xb3, 0xc8, 0xf9, 0x19, 0xf5, 0xa7, 0x37, 0x61, 0xf7, 0x8c, 0x1c, 0x3f, 0xc5, 0x61,
+	0xfd, 0x31, 0x26, 0x17, 0x07, 0xee, 0xc4, 0x9b, 0x9d, 0x53, 0x2d, 0x64, 0x84, 0x5b, 0x78, 0x26,
+	0x23, 0x7e, 0x1b, 0xaa, 0x92, 0x5f, 0x29, 0xad, 0xc7, 0xa5, 0xad, 0xac, 0x92, 0xf6, 0xbf, 0x29,
+	0x50, 0x6d, 0x06, 0x53, 0xfc, 0xda, 0x3c, 0xa7, 0x92, 0x7a, 0x9c, 0xcf, 0xc2, 0x2a, 0x3e, 0x55,
+	0xa8, 0x25, 0x6c, 0x0a, 0x01, 0xe9, 0xff, 0xae, 0xa0, 0x43, 0x0f, 0xc4, 0x97, 0xd3, 0xf3, 0xcd,
+	0xbb, 0x86, 0x1f, 0x52, 0x29, 0xa3, 0x92, 0xfb, 0xff, 0x56, 0xa0, 0xd6, 0x0f, 0x5d, 0xba, 0x51,
+	0x79, 0xae, 0x99, 0xa7, 0x4c, 0x78, 0x1c, 0xcb, 0x1c, 0x02, 0xbf, 0xd7, 0xa8, 0xad, 0x6f, 0xc1,
+	0x66, 0xca, 0xbb, 0x94, 0xc7, 0x3f, 0x2a, 0xb0, 0x2b, 0x0c, 0x44, 0x62, 0xc6, 0xe7, 0x54, 0x2c,
+	0x09, 0xbf, 0x85, 0x0c, 0xbf, 0x75, 0xb8, 0x70, 0x96, 0x37, 0xc9, 0xf6, 0xf7, 0x73, 0xf0, 0x42,
+	0x62, 0x1b, 0xe7, 0x9c, 0xf1, 0xff, 0x83, 0x3d, 0xec, 0x41, 0xfd, 0x71, 0x21, 0x48, 0x09, 0x7d,
+	0x98, 0x83, 0x7a, 0x13, 0xc3, 0x51, 0xec, 0x66, 0x72, 0x91, 0xe7, 0xc7, 0x36, 0xb4, 0x37, 0x61,
+	0x03, 0x19, 0x8e, 0xbd, 0x91, 0x37, 0x77, 0xe8, 0x6b, 0x6f, 0x8d, 0x53, 0x9d, 0x33, 0x13, 0x2c,
+	0x91, 0xe8, 0x2f, 0xc1, 0x8b, 0x2b, 0x24, 0x22, 0xe5, 0xf5, 0x3f, 0x0a, 0x68, 0xf8, 0x65, 0x16,
+	0xc6, 0x9f, 0x81, 0xa8, 0xb2, 0xd2, 0x98, 0x76, 0x61, 0x7b, 0x89, 0xff, 0xac, 0x5c, 0x70, 0x85,
+	0xcf, 0x42, 0xc4, 0xf9, 0x44, 0xb9, 0x64, 0xf9, 0x97, 0x72, 0xf9, 0x67, 0x05, 0xf6, 0x9a, 0x81,
+	0xb8, 0xad, 0x7c, 0x2e, 0x4f, 0x98, 0xfe, 0x0a, 0xbc, 0xb4, 0x92, 0x41, 0x29, 0x80, 0x7f, 0x52,
+	0xe0, 0x82, 0xe9, 0x3a, 0xe3, 0xe7, 0x93, 0xf9, 0x9b, 0x18, 0x5f, 0xce, 0x32, 0x27, 0x33, 0xd4,
+	0xb7, 0xa1, 0x34, 0x75, 0x63, 0x87, 0x6e, 0x35, 0x25, 0x4b, 0x7b, 0xc9, 0xbc, 0x0b, 0xea, 0x8e,
+	0xa4, 0x30, 0x53, 0x5a, 0xfd, 0x63, 0xfc, 0x44, 0xe6, 0x5c, 0xf7, 0xa7, 0x1f, 0x5a, 0xab, 0xbf,
+	0x05, 0x3e, 0x54, 0x60, 0x67, 0x59, 0x40, 0xe9, 0x37, 0xc1, 0xff, 0xf7, 0x7d, 0xc5, 0x0a, 0x87,
+	0x90, 0x5f, 0x95, 0x82, 0xfe, 0x1d, 0x46, 0xd1, 0xec, 0x96, 0x7e, 0x7a, 0xb7, 0xb1, 0x7c, 0xb7,
+	0xf1, 0x13, 0x5f, 0x66, 0x7d, 0xa4, 0xc0, 0x8b, 0x2b, 0x04, 0xfa, 0x93, 0x29, 0x3a, 0x73, 0xc3,
+	0x91, 0x7b, 0xea, 0x0d, 0xc7, 0xb3, 0xaa, 0xfa, 0x1f, 0xd0, 0xfa, 0x3a, 0xe2, 0x62, 0x59, 0x7c,
+	0xc7, 0x9f, 0x5f, 0x6f, 0xc6, 0x77, 0xc7, 0x85, 0xc5, 0x43, 0x0f, 0xdd, 0x4d, 0x9c, 0x61, 0xed,
+	0x53, 0xdc, 0x4d, 0xfc, 0x97, 0x02, 0x5b, 0x72, 0x96, 0xc6, 0xb9, 0x4d, 0x04, 0x56, 0x48, 0x47,
+	0x7b, 0x15, 0xf2, 0xde, 0x38, 0xc9, 0x20, 0x97, 0xab, 0x1f, 0x08, 0xa1, 0xbf, 0x0b, 0x5a, 0x96,
+	0xef, 0x4f, 0x21, 0xba, 0xbf, 0xcf, 0xc3, 0xd6, 0x60, 0xee, 0x7b, 0xb1, 0x44, 0x3e, 0xdf, 0x8e,
+	0xff, 0xf3, 0xb0, 0x11, 0x11, 0xb3, 0xb6, 0x78, 0xbc, 0x63, 0xc1, 0x96, 0xe9, 0x6d, 0x19, 0x61,
+	0x4d, 0x06, 0xd1, 0x63, 0x76, 0x42, 0x72, 0x32, 0x8b, 0xe5, 0x85, 0x1a, 0x48, 0x0a, 0x84, 0x68,
+	0x6f, 0xc1, 0x0b, 0xb3, 0x93, 0x29, 0xd7, 0x32, 0xd8, 0x73, 0x64, 0x4b, 0xbe, 0xf4, 0x63, 0x7e,
+	0x2a, 0x6b, 0x0e, 0xb6, 0x11, 0x4d, 0x25, 0x0d, 0x7d, 0x37, 0x14, 0x2f, 0xfd, 0x88, 0xd2, 0xde,
+	0x85, 0xb2, 0xe3, 0x4f, 0xe8, 0x7d, 0xf4, 0x78, 0x2a, 0x8b, 0x0d, 0xf4, 0xe4, 0x05, 0xe6, 0xac,
+	0xf8, 0xf7, 0x1b, 0x09, 0xa5, 0xb9, 0x18, 0xa4, 0x7f, 0x0d, 0xca, 0x29, 0x9c, 0xde, 0x6c, 0x8d,
+	0x9b, 0xc3, 0x46, 0xdb, 0x1e, 0xf4, 0xdb, 0x2d, 0x6b, 0x20, 0x1e, 0x9f, 0x8f, 0x86, 0x6d, 0x04,
+	0x34, 0x1b, 0x5d, 0x55, 0xd1, 0x4d, 0x00, 0x9e, 0x92, 0x27, 0x5f, 0x08, 0x48, 0x79, 0x8a, 0x80,
+	0x5e, 0x82, 0x32, 0x32, 0x26, 0x79, 0xcf, 0x31, 0x3b, 0x25, 0x04, 0x30, 0xe7, 0x7a, 0x03, 0xf3,
+	0xed, 0xcc, 0x5e, 0xa5, 0xb5, 0x65, 0x9c, 0xb7, 0xb2, 0xe4, 0xbc, 0x17, 0xeb, 0xa7, 0xce, 0x5b,
+	0xa4, 0xf2, 0x74, 0xce, 0xaf, 0xbb, 0x8e, 0x1f, 0x27, 0xf1, 0x4a, 0xff, 0x61, 0x1e, 0xaa, 0x26,
+	0x41, 0xbc, 0xa9, 0x4b, 0x8f, 0x50, 0x11, 0x69, 0xea, 0x98, 0x49, 0xec, 0x85, 0xdb, 0x45, 0x4d,
+	0x09, 0x98, 0x78, 0x2b, 0xb8, 0x02, 0xbb, 0x91, 0x3b, 0x0a, 0x66, 0xe3, 0xc8, 0xbe, 0xeb, 0x1e,
+	0x53, 0x81, 0x8f, 0x7c, 0xd0, 0xcf, 0xf1, 0x13, 0xdd, 0xb6, 0x44, 0x1e, 0x30, 0x4e, 0xbc, 0xe3,
+	0x6b, 0x6f, 0xc0, 0xce, 0x5d, 0x6f, 0xe6, 0x07, 0x13, 0x2a, 0xcd, 0x38, 0x75, 0xc3, 0x48, 0xb2,
+	0x4a, 0xe6, 0xb5, 0x66, 0x6a, 0x02, 0xd7, 0x17, 0x28, 0xa1, 0xee, 0xf7, 0xe0, 0xd2, 0xca, 0x55,
+	0xec, 0x7b, 0x9e, 0x8f, 0x3f, 0xee, 0xd8, 0xc6, 0xef, 0x5b, 0xdf, 0x1b, 0x89, 0x32, 0x12, 0x91,
+	0xbb, 0xbf, 0xbe, 0x62, 0xe9, 0x23, 0x49, 0x6e, 0x2e, 0xa8, 0x49, 0xda, 0xa3, 0xf9, 0x89, 0x7d,
+	0xc2, 0x2f, 0x88, 0x14, 0xc5, 0x14, 0xb3, 0x84, 0x80, 0x21, 0xf5, 0xe9, 0x69, 0xeb, 0xfd, 0xb9,
+	0x08, 0x5e, 0x8a, 0x49, 0x4d, 0x56, 0x0e, 0x66, 0x7e, 0x76, 0x30, 0xf3, 0x4f, 0xf9, 0x26, 0xbe,
+	0x84, 0xca, 0x41, 0x40, 0x0f, 0xfb, 0x24, 0xb0, 0xf8, 0xa1, 0x1d, 0x1f, 0x87, 0x41, 0x1c, 0xfb,
+	0xee, 0x98, 0x6d, 0xb1, 0x64, 0x56, 0xe2, 0x87, 0x56, 0x02, 0x22, 0xe6, 0xc5, 0x0b, 0x5e, 0x34,
+	0x3a, 0x76, 0xa7, 0x8e, 0x3d, 0x3a, 0x76, 0x66, 0x13, 0x24, 0x2d, 0xf3, 0x29, 0xd0, 0x18, 0x37,
+	0x60, 0x54, 0x53, 0x60, 0xe8, 0xd2, 0xb7, 0xd6, 0x98, 0x4c, 0x42, 0x77, 0x82, 0xa7, 0x52, 0x28,
+	0x06, 0x27, 0x11, 0x4a, 0x38, 0xb5, 0x65, 0x45, 0x9c, 0x90, 0xa0, 0x22, 0x24, 0x28, 0x71, 0xa2,
+	0x1e, 0x2e, 0x39, 0x30, 0x17, 0x4e, 0x66, 0x2b, 0xc7, 0xe4, 0x78, 0xcc, 0x4e, 0x8a, 0xcd, 0x8e,
+	0xfa, 0x39, 0x78, 0x71, 0xb5, 0xdc, 0xa7, 0x9e, 0xa8, 0x69, 0xaa, 0x9a, 0x17, 0x56, 0x88, 0xb9,
+	0xe3, 0xcd, 0x9e, 0x30, 0xd4, 0x79, 0xc8, 0x1a, 0xfa, 0x84, 0xa1, 0xce, 0x43, 0xfd, 0x5f, 0xd3,
+	0x37, 0x87, 0xc4, 0x40, 0xd3, 0xf8, 0x9f, 0x78, 0x22, 0xe5, 0x49, 0x9e, 0xa8, 0x0e, 0xeb, 0x54,
+	0xf5, 0xe2, 0xcd, 0x26, 0xc9, 0xeb, 0xb9, 0xec, 0x6a, 0x03, 0x78, 0x5d, 0xf2, 0xee, 0x3e, 0x8c,
+	0xa9, 0xb8, 0xcf, 0xf7, 0x4f, 0x6d, 0x71, 0x35, 0x32, 0x8b, 0xd1, 0x8a, 0x16, 0xf5, 0x7b, 0x22,
+	0x07, 0xf8, 0x82, 0xa0, 0x36, 0x52, 0x62, 0x33, 0xa5, 0xb5, 0xd2, 0xca, 0xbe, 0x6f, 0x41, 0x2d,
+	0x94, 0xc7, 0x86, 0x6a, 0x5c, 0xe2, 0xe4, 0x6e, 0x7b, 0x27, 0x7d, 0xd9, 0xce, 0x9c, 0x29, 0xb3,
+	0x1a, 0x2e, 0x1d, 0xb1, 0xef, 0xc0, 0xa6, 0x93, 0xe8, 0x56, 0x8e, 0x5e, 0xce, 0x94, 0x96, 0x35,
+	0x6f, 0xd6, 0x9c, 0x65, 0x4b, 0xb8, 0x8a, 0x16, 0x27, 0x38, 0x72, 0x7c, 0xcf, 0x59, 0xa4, 0xd2,
+	0x67, 0x8a, 0x22, 0x1b, 0x84, 0x34, 0x65, 0xf9, 0x24, 0x77, 0xe8, 0xcb, 0x7d, 0x7b, 0x38, 0x1f,
+	0xf3, 0x4c, 0xe7, 0x38, 0x9f, 0xc9, 0x56, 0x50, 0x16, 0x96, 0x2b, 0x28, 0x97, 0x2b, 0x32, 0xd7,
+	0xce, 0x54, 0x64, 0x62, 0xdc, 0xde, 0x59, 0xe6, 0x5f, 0x5a, 0xd9, 0x45, 0xcc, 0x32, 0xe9, 0x25,
+	0xfe, 0x4c, 0xe0, 0xce, 0xbc, 0xd1, 0x9b, 0x82, 0x40, 0xff, 0x2b, 0x14, 0xe1, 0x8a, 0x8f, 0xba,
+	0xf4, 0x8b, 0x51, 0xc9, 0x5c, 0x48, 0x7d, 0x1d, 0xd6, 0xb8, 0x98, 0x40, 0x56, 0xd3, 0xbc, 0xf0,
+	0xf8, 0x37, 0x21, 0x3f, 0xfc, 0x9b, 0x82, 0x8a, 0x3d, 0x09, 0x19, 0xd4, 0x88, 0x6f, 0xa4, 0x92,
+	0x9c, 0xb4, 0x42, 0x30, 0x71, 0x49, 0xf5, 0xf8, 0x15, 0x57, 0xe1, 0xe9, 0x57, 0x5c, 0xff, 0xa1,
+	0xc8, 0x88, 0xc4, 0xa5, 0x22, 0x8f, 0x19, 0x8f, 0xf2, 0xac, 0xc6, 0x43, 0x5e, 0x90, 0xcb, 0xea,
+	0xd2, 0xfa, 0x20, 0x12, 0x3b, 0x02, 0xb8, 0xe0, 0xf6, 0x15, 0x00, 0x46, 0xce, 0x9c, 0x59, 0x10,
+	0xc9, 0x9d, 0x33, 0x79, 0x97, 0x00, 0xda, 0xeb, 0xb0, 0x39, 0x0f, 0x02, 0xdf, 0xe6, 0x6a, 0x34,
+	0x41, 0x23, 0x6f, 0x5b, 0x08, 0x7c, 0x1b, 0xa1, 0x82, 0x0e, 0x93, 0x80, 0xe9, 0x29, 0x95, 0x14,
+	0x0a, 0x1a, 0xa1, 0x3f, 0x60, 0x50, 0x4a, 0x10, 0x07, 0xb1, 0x93, 0x10, 0xc8, 0x2c, 0x81, 0x41,
+	0x4c, 0xa0, 0xff, 0x0b, 0x7a, 0x4e, 0xd3, 0xf5, 0x5d, 0x27, 0x3a, 0xaf, 0x5f, 0xd3, 0x67, 0xaa,
+	0xfa, 0x0a, 0x67, 0xab, 0xfa, 0xe8, 0xce, 0x3f, 0xe5, 0x50, 0xd8, 0xef, 0xa5, 0x3f, 0xc8, 0x43,
+	0xb9, 0x73, 0x3a, 0x78, 0xdf, 0x3f, 0xf2, 0x9d, 0x09, 0x97, 0x5f, 0x74, 0xfa, 0xd6, 0x1d, 0xcc,
+	0x4e, 0xb6, 0xa0, 0xda, 0xed, 0x59, 0x76, 0x97, 0x32, 0x94, 0xa3, 0x76, 0xe3, 0x9a, 0xaa, 0x50,
+	0x0a, 0xd3, 0x37, 0x5b, 0xf6, 0x0d, 0xe3, 0x8e, 0x80, 0xe4, 0xa8, 0x8e, 0x6d, 0xd8, 0x6d, 0xdd,
+	0x1c, 0x1a, 0x0b, 0x60, 0x41, 0xdb, 0xc5, 0xd4, 0x7e, 0xd8, 0xb6, 0x5a, 0xfd, 0x76, 0x06, 0x5c,
+	0xa2, 0x74, 0xe7, 0xa0, 0xdd, 0x3b, 0x10, 0x5d, 0x95, 0xe6, 0x1f, 0x76, 0x07, 0xad, 0x6b, 0x5d,
+	0xe3, 0x50, 0x80, 0x5e, 0x23, 0xd0, 0x7b, 0x86, 0xd9, 0x3b, 0x6a, 0x25, 0x4b, 0xbe, 0x8b, 0x4b,
+	0x56, 0x0e, 0x5a, 0xdd, 0x86, 0x29, 0x67, 0x79, 0xa4, 0x68, 0x35, 0x28, 0x1b, 0xdd, 0x61, 0x47,
+	0xf6, 0x73, 0xe8, 0xbf, 0xb7, 0xa9, 0xac, 0xcd, 0x6e, 0x75, 0x9b, 0xa6, 0xd1, 0xa1, 0xea, 0x37,
+	0x81, 0x29, 0xe0, 0xe6, 0x6a, 0x56, 0xab, 0x63, 0x0c, 0xac, 0x46, 0xa7, 0x2f, 0x81, 0xb4, 0x8b,
+	0xd2, 0xc0, 0x48, 0x68, 0x54, 0x74, 0x08, 0xbb, 0xdd, 0x9e, 0x2d, 0x0b, 0xf3, 0xec, 0x5b, 0x8d,
+	0x36, 0xb2, 0x22, 0x70, 0xaf, 0x69, 0x2f, 0x80, 0xd6, 0xeb, 0xda, 0xc3, 0xfe, 0x61, 0xc3, 0x32,
+	0xec, 0x6e, 0xef, 0xb6, 0x44, 0xbc, 0x8b, 0x5b, 0x28, 0x2d, 0x76, 0xf0, 0x88, 0xa4, 0x50, 0xed,
+	0x37, 0x4c, 0x6b, 0xc1, 0xec, 0xa3, 0x47, 0x24, 0x2c, 0xb8, 0x66, 0xf6, 0x86, 0xfd, 0x05, 0xd9,
+	0x16, 0x15, 0x12, 0xb2, 0xb0, 0x24, 0xa8, 0x40, 0x20, 0x64, 0xaf, 0x99, 0xee, 0xef, 0x51, 0x69,
+	0x2f, 0xa7, 0x2a, 0x97, 0xee, 0x43, 0x81, 0xd5, 0x51, 0x82, 0x42, 0xb7, 0xd7, 0xa5, 0x42, 0xc5,
+	0x4d, 0x80, 0xd6, 0xa0, 0xd5, 0xb5, 0x8c, 0x6b, 0x66, 0xa3, 0x4d, 0x6c, 0x33, 0x20, 0x11, 0x20,
+	0x71, 0xbb, 0x01, 0xeb, 0xad, 0xc1, 0x51, 0xbb, 0xd7, 0xb0, 0x24, 0x9b, 0xad, 0xc1, 0xcd, 0x61,
+	0x8f, 0xea, 0x05, 0x91, 0xcd, 0x0a, 0x14, 0xa9, 0x34, 0xf0, 0x7b, 0x16, 0xf1, 0xc5, 0x38, 0x21,
+	0x55, 0xe4, 0xe6, 0xd2, 0x8f, 0xf2, 0x50, 0xe0, 0x93, 0x88, 0x0a, 0x62, 0x6d, 0x53, 0x45, 0x24,
+	0x2e, 0x59, 0x86, 0x02, 0x2e, 0x78, 0x55, 0xfd, 0xa5, 0x9c, 0x06, 0xb0, 0x36, 0xe4, 0xf6, 0x2f,
+	0x17, 0xa9, 0x8d, 0xcd, 0x37, 0xdf, 0x56, 0xbf, 0x9f, 0xa3, 0x69, 0x87, 0xa2, 0xf3, 0x2b, 0x09,
+	0xe2, 0xca, 0x5b, 0xea, 0x0f, 0x52, 0x04, 0x76, 0x7e, 0x35, 0x41, 0x7c, 0xe3, 0x8a, 0xfa, 0x6b,
+	0x29, 0x02, 0x3b, 0xbf, 0x9e, 0x20, 0xde, 0x7e, 0x4b, 0xfd, 0x8d, 0x14, 0x81, 0x9d, 0xdf, 0x2c,
+	0x12, 0x2f, 0xcc, 0x09, 0x92, 0xfd, 0x56, 0x29, 0xed, 0x21, 0xee, 0xb7, 0x4b, 0xa4, 0xff, 0x54,
+	0xab, 0xea, 0xef, 0xa8, 0xb4, 0x4d, 0x52, 0x90, 0xfa, 0xbb, 0xdc, 0x24, 0x94, 0xfa, 0x7b, 0x2a,
+	0xf1, 0x48, 0x50, 0xee, 0x7e, 0xc8, 0x98, 0x3b, 0x46, 0xc3, 0x54, 0x7f, 0xbf, 0x28, 0xea, 0x30,
+	0x9b, 0x2d, 0xaa, 0x75, 0xd4, 0x78, 0x04, 0x49, 0xe5, 0x0f, 0xdf, 0xa0, 0x26, 0x99, 0xa7, 0xfa,
+	0x47, 0x7d, 0x5a, 0xf0, 0x56, 0xc3, 0x6c, 0x5e, 0xc7, 0x01, 0x3f, 0x7c, 0x83, 0x16, 0xc4, 0x9e,
+	0x94, 0xd7, 0x1f, 0xf7, 0x89, 0x90, 0x51, 0x1f, 0xbd, 0x41, 0x9b, 0x96, 0xf0, 0x3f, 0xe9, 0xa3,
+	0xb2, 0xf2, 0x07, 0x2d, 0x4b, 0xfd, 0x11, 0xaf, 0x46, 0x26, 0xaa, 0xfe, 0xa9, 0x4a, 0x40, 0x34,
+	0x37, 0xf5, 0xcf, 0x08, 0xb8, 0x66, 0x0d, 0xf1, 0x48, 0xa8, 0x2f, 0xd3, 0xe6, 0xae, 0x19, 0xbd,
+	0x8e, 0x61, 0xe1, 0xc0, 0x3f, 0x67, 0xf2, 0xef, 0x0e, 0x7a, 0x5d, 0xf5, 0x63, 0x95, 0x6a, 0x34,
+	0x8d, 0xef, 0xf5, 0x4d, 0x63, 0x30, 0x68, 0x21, 0xe0, 0x73, 0x97, 0x8e, 0x40, 0x3d, 0xeb, 0xf3,
+	0x89, 0x81, 0x61, 0xf7, 0x06, 0xda, 0x63, 0x17, 0x95, 0x84, 0x1d, 0x24, 0x47, 0xeb, 0x33, 0xf0,
+	0x7c, 0x02, 0x14, 0x65, 0x75, 0x67, 0x0e, 0x79, 0x28, 0x99, 0xbd, 0x76, 0xfb, 0xa0, 0xd1, 0xbc,
+	0xa1, 0xe6, 0x0f, 0xbe, 0x09, 0x9b, 0x5e, 0xb0, 0xff, 0xc0, 0x8b, 0xf1, 0xd3, 0x53, 0xfc, 0xb9,
+	0xe2, 0x3d, 0x5d, 0xf6, 0xbc, 0xe0, 0xb2, 0x68, 0x5d, 0x9e, 0x60, 0x2b, 0xbe, 0xcc, 0xd8, 0xcb,
+	0xec, 0x5f, 0xee, 0x16, 0xb9, 0xf3, 0x8d, 0xff, 0x05, 0x33, 0x06, 0xb5, 0x71, 0xba, 0x31, 0x00,
+	0x00,
 }
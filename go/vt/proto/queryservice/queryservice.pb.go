@@ -30,44 +30,44 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("queryservice.proto", fileDescriptor_4bd2dde8711f22e3) }
 
 var fileDescriptor_4bd2dde8711f22e3 = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xdf, 0x6f, 0xd3, 0x30,
-	0x10, 0xc7, 0xe1, 0x61, 0x2b, 0xba, 0x96, 0x32, 0x3c, 0x06, 0x2c, 0x1b, 0xdd, 0x8f, 0x37, 0x84,
-	0xd4, 0x22, 0x40, 0x42, 0x9a, 0xc4, 0xc3, 0x5a, 0x31, 0x81, 0x26, 0x7e, 0xb5, 0x6c, 0x42, 0x20,
-	0x21, 0xb9, 0xa9, 0x55, 0xa2, 0xa5, 0x71, 0x16, 0x3b, 0x1d, 0xfc, 0x37, 0xfc, 0xa9, 0xd3, 0xe2,
-	0xdc, 0xc5, 0x76, 0x93, 0xbd, 0xcd, 0xdf, 0xef, 0xdd, 0x67, 0x17, 0x5f, 0xef, 0x0c, 0xec, 0x32,
-	0x17, 0xd9, 0x3f, 0x25, 0xb2, 0x65, 0x14, 0x8a, 0x7e, 0x9a, 0x49, 0x2d, 0x59, 0xc7, 0xd6, 0x82,
-	0x76, 0x71, 0x32, 0x56, 0xb0, 0x31, 0x8d, 0x92, 0x58, 0xce, 0x67, 0x5c, 0x73, 0xa3, 0xbc, 0xfa,
-	0xdf, 0x85, 0xb5, 0x6f, 0x37, 0x11, 0xec, 0x08, 0x5a, 0xef, 0xff, 0x8a, 0x30, 0xd7, 0x82, 0x6d,
-	0xf5, 0x4d, 0x52, 0x79, 0x1e, 0x8b, 0xcb, 0x5c, 0x28, 0x1d, 0x3c, 0xf6, 0x65, 0x95, 0xca, 0x44,
-	0x89, 0xc3, 0x3b, 0xec, 0x23, 0x74, 0x4a, 0x71, 0xc8, 0x75, 0xf8, 0x87, 0x05, 0x6e, 0x64, 0x21,
-	0x22, 0x65, 0xa7, 0xd6, 0x23, 0xd4, 0x67, 0xb8, 0x3f, 0xd1, 0x99, 0xe0, 0x0b, 0x2c, 0x06, 0xe3,
-	0x1d, 0x15, 0x61, 0xbb, 0xf5, 0x26, 0xd2, 0x5e, 0xde, 0x65, 0x6f, 0x60, 0x6d, 0x28, 0xe6, 0x51,
-	0xc2, 0x36, 0xcb, 0xd0, 0xe2, 0x84, 0xf9, 0x8f, 0x5c, 0x91, 0xaa, 0x78, 0x0b, 0xeb, 0x23, 0xb9,
-	0x58, 0x44, 0x9a, 0x61, 0x84, 0x39, 0x62, 0xde, 0x96, 0xa7, 0x52, 0xe2, 0x3b, 0xb8, 0x37, 0x96,
-	0x71, 0x3c, 0xe5, 0xe1, 0x05, 0xc3, 0xfb, 0x42, 0x01, 0x93, 0x9f, 0xac, 0xe8, 0x94, 0x7e, 0x04,
-	0xad, 0xaf, 0x99, 0x48, 0x79, 0x56, 0x35, 0xa1, 0x3c, 0xfb, 0x4d, 0x20, 0x99, 0x72, 0xbf, 0x40,
-	0xd7, 0x94, 0x53, 0x5a, 0x33, 0xb6, 0xeb, 0x54, 0x89, 0x32, 0x92, 0x9e, 0x35, 0xb8, 0x04, 0x3c,
-	0x83, 0x0d, 0x2c, 0x91, 0x90, 0x3d, 0xaf, 0x76, 0x1f, 0xba, 0xd7, 0xe8, 0x13, 0xf6, 0x07, 0x3c,
-	0x1c, 0x65, 0x82, 0x6b, 0xf1, 0x3d, 0xe3, 0x89, 0xe2, 0xa1, 0x8e, 0x64, 0xc2, 0x30, 0x6f, 0xc5,
-	0x41, 0xf0, 0x7e, 0x73, 0x00, 0x91, 0x4f, 0xa0, 0x3d, 0xd1, 0x3c, 0xd3, 0x65, 0xeb, 0xb6, 0xe9,
-	0xc7, 0x41, 0x1a, 0xd2, 0x82, 0x3a, 0xcb, 0xe1, 0x08, 0x4d, 0x7d, 0x24, 0x4e, 0xa5, 0xad, 0x70,
-	0x6c, 0x8b, 0x38, 0xbf, 0x61, 0x73, 0x24, 0x93, 0x30, 0xce, 0x67, 0xce, 0xb7, 0x1e, 0xd0, 0xc5,
-	0xaf, 0x78, 0xc8, 0x3d, 0xbc, 0x2d, 0x84, 0xf8, 0x63, 0x78, 0x30, 0x16, 0x7c, 0x66, 0xb3, 0xb1,
-	0xa9, 0x9e, 0x8e, 0xdc, 0x5e, 0x93, 0x6d, 0x8f, 0x72, 0x31, 0x0c, 0x38, 0x7e, 0x81, 0x3d, 0x21,
-	0xde, 0xf4, 0xed, 0xd4, 0x7a, 0x76, 0xa3, 0x6d, 0xc7, 0xac, 0x86, 0xbd, 0x9a, 0x1c, 0x67, 0x3f,
-	0xec, 0x37, 0x07, 0xd8, 0x4b, 0xe2, 0x93, 0x50, 0x8a, 0xcf, 0x85, 0x19, 0x7c, 0x5a, 0x12, 0x8e,
-	0xea, 0x2f, 0x09, 0xcf, 0xb4, 0x96, 0xc4, 0x08, 0xa0, 0x34, 0x8f, 0xc3, 0x0b, 0xf6, 0xd4, 0x8d,
-	0x3f, 0xae, 0xda, 0xbd, 0x5d, 0xe3, 0x50, 0x51, 0x23, 0x80, 0x49, 0x1a, 0x47, 0xda, 0xac, 0x53,
-	0x84, 0x54, 0x92, 0x0f, 0xb1, 0x1d, 0x82, 0x9c, 0x42, 0xc7, 0xd4, 0xf7, 0x41, 0xf0, 0x58, 0x57,
-	0x9b, 0xd4, 0x16, 0xfd, 0xeb, 0x77, 0x3d, 0xeb, 0xb3, 0x4e, 0xa1, 0x73, 0x96, 0xce, 0xb8, 0xc6,
-	0x5b, 0x42, 0x98, 0x2d, 0xfa, 0x30, 0xd7, 0xb3, 0x60, 0x27, 0xd0, 0x3a, 0x27, 0x8e, 0xf5, 0x8e,
-	0x9c, 0xfb, 0x9c, 0x3a, 0xcf, 0xe2, 0x8c, 0xa1, 0x8d, 0xb2, 0xbc, 0x52, 0xac, 0x57, 0x17, 0x2f,
-	0xaf, 0x54, 0xb5, 0x50, 0x9a, 0x7c, 0x8b, 0xf9, 0x0b, 0xba, 0xd5, 0xbf, 0xca, 0x63, 0xad, 0xd8,
-	0x41, 0x7d, 0x19, 0x37, 0x5e, 0x35, 0x63, 0xb7, 0x84, 0x54, 0xf0, 0xe1, 0x8b, 0x9f, 0xcf, 0x97,
-	0x91, 0x16, 0x4a, 0xf5, 0x23, 0x39, 0x30, 0x7f, 0x0d, 0xe6, 0x72, 0xb0, 0xd4, 0x83, 0xe2, 0x09,
-	0x1d, 0xd8, 0xcf, 0xed, 0x74, 0xbd, 0xd0, 0x5e, 0x5f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xb4,
-	0xc0, 0x34, 0x99, 0x07, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7d, 0x95, 0x6d, 0x6f, 0xd3, 0x30,
+	0x10, 0xc7, 0xe1, 0xc5, 0x36, 0x74, 0x2d, 0x65, 0x78, 0x0c, 0x58, 0xf6, 0xc8, 0xde, 0x21, 0xa4,
+	0x16, 0x01, 0x12, 0x12, 0x12, 0x2f, 0xd6, 0x8a, 0x09, 0x84, 0x78, 0x4a, 0xd9, 0x84, 0x40, 0x42,
+	0x72, 0x53, 0xab, 0x44, 0xa4, 0x71, 0x49, 0xdc, 0x01, 0xdf, 0x95, 0x0f, 0x83, 0x17, 0xe7, 0xce,
+	0x0f, 0x4d, 0xf6, 0xae, 0xf7, 0xff, 0xdf, 0xfd, 0x6a, 0xfb, 0xe2, 0x33, 0xb0, 0x5f, 0x4b, 0x51,
+	0xfc, 0x2d, 0x45, 0x71, 0x91, 0x26, 0xa2, 0xbf, 0x28, 0xa4, 0x92, 0xac, 0xeb, 0x6a, 0x51, 0xa7,
+	0x8a, 0x8c, 0x15, 0x6d, 0x4e, 0xd2, 0x3c, 0x93, 0xb3, 0x29, 0x57, 0xdc, 0x28, 0x4f, 0xfe, 0xf5,
+	0x60, 0xed, 0xd3, 0x65, 0x06, 0x7b, 0x01, 0x1b, 0xaf, 0xfe, 0x88, 0x64, 0xa9, 0x04, 0xdb, 0xee,
+	0x9b, 0xa2, 0x3a, 0x8e, 0x85, 0x0e, 0x4b, 0x15, 0xdd, 0x0d, 0xe5, 0x72, 0x21, 0xf3, 0x52, 0x1c,
+	0x5f, 0x63, 0x6f, 0xa0, 0x5b, 0x8b, 0x43, 0xae, 0x92, 0x1f, 0x2c, 0xf2, 0x33, 0x2b, 0x11, 0x29,
+	0xbb, 0x8d, 0x1e, 0xa1, 0xde, 0xc3, 0xcd, 0xb1, 0x2a, 0x04, 0x9f, 0xe3, 0x62, 0x30, 0xdf, 0x53,
+	0x11, 0xb6, 0xd7, 0x6c, 0x22, 0xed, 0xf1, 0x75, 0xf6, 0x0c, 0xd6, 0x86, 0x62, 0x96, 0xe6, 0x6c,
+	0xab, 0x4e, 0xad, 0x22, 0xac, 0xbf, 0xe3, 0x8b, 0xb4, 0x8a, 0xe7, 0xb0, 0x3e, 0x92, 0xf3, 0x79,
+	0xaa, 0x18, 0x66, 0x98, 0x10, 0xeb, 0xb6, 0x03, 0x95, 0x0a, 0x5f, 0xc2, 0x8d, 0x58, 0x66, 0xd9,
+	0x84, 0x27, 0x3f, 0x19, 0x9e, 0x17, 0x0a, 0x58, 0x7c, 0x6f, 0x45, 0xa7, 0x72, 0xdd, 0x84, 0x58,
+	0x64, 0x82, 0x97, 0xb6, 0x09, 0x75, 0x1c, 0x36, 0x81, 0x64, 0xb7, 0xf6, 0x63, 0x21, 0x16, 0xbc,
+	0xb0, 0xb5, 0x75, 0x1c, 0xd6, 0x92, 0x4c, 0xb5, 0x1f, 0xa0, 0x67, 0xb6, 0x52, 0x5b, 0x53, 0xb6,
+	0xe7, 0xed, 0x10, 0x65, 0x24, 0xed, 0xb7, 0xb8, 0x04, 0x3c, 0x83, 0x4d, 0xdc, 0x1e, 0x21, 0x0f,
+	0x82, 0x7d, 0x87, 0xd0, 0xc3, 0x56, 0x9f, 0xb0, 0x5f, 0xe0, 0xf6, 0x48, 0x77, 0x5a, 0x89, 0xcf,
+	0x05, 0xcf, 0x4b, 0x9e, 0xa8, 0x54, 0xe6, 0x0c, 0xeb, 0x56, 0x1c, 0x04, 0x1f, 0xb5, 0x27, 0x10,
+	0xf9, 0x14, 0x3a, 0x63, 0xc5, 0x0b, 0x55, 0xb7, 0x7d, 0x87, 0x3e, 0x2c, 0xd2, 0x90, 0x16, 0x35,
+	0x59, 0x1e, 0x47, 0x28, 0xfa, 0x06, 0x88, 0x63, 0xb5, 0x15, 0x8e, 0x6b, 0x11, 0xe7, 0x3b, 0x6c,
+	0x8d, 0x64, 0x9e, 0x64, 0xcb, 0xa9, 0xb7, 0xd7, 0x07, 0x74, 0xf0, 0x2b, 0x1e, 0x72, 0x8f, 0xaf,
+	0x4a, 0x21, 0x7e, 0x0c, 0xb7, 0x62, 0xc1, 0xa7, 0x2e, 0x7b, 0x9f, 0x3e, 0x2d, 0x4f, 0x47, 0xee,
+	0x41, 0x9b, 0xed, 0x8e, 0x81, 0xea, 0x22, 0xe1, 0xd5, 0x8d, 0xdc, 0xdb, 0x15, 0xdc, 0xdc, 0xdd,
+	0x46, 0xcf, 0x6d, 0xb4, 0xeb, 0x98, 0xb1, 0x72, 0xd8, 0x50, 0xe3, 0xcd, 0x96, 0xa3, 0xf6, 0x04,
+	0x77, 0xc0, 0xbc, 0x13, 0x65, 0xc9, 0x67, 0xc2, 0x0c, 0x0d, 0x1a, 0x30, 0x9e, 0x1a, 0x0e, 0x98,
+	0xc0, 0x74, 0x06, 0xcc, 0x08, 0xa0, 0x36, 0x4f, 0x74, 0xbf, 0xef, 0xfb, 0xf9, 0x27, 0xb6, 0xdd,
+	0x3b, 0x0d, 0x0e, 0x2d, 0x4a, 0x43, 0xc6, 0x8b, 0x2c, 0x55, 0x66, 0x14, 0x23, 0xc4, 0x4a, 0x21,
+	0xc4, 0x75, 0x08, 0xf2, 0x16, 0xba, 0x66, 0x7d, 0xaf, 0x05, 0xcf, 0x94, 0x9d, 0xc2, 0xae, 0x18,
+	0x1e, 0xbf, 0xef, 0x39, 0xdb, 0xd2, 0xb0, 0xb3, 0x85, 0x7e, 0x28, 0xf0, 0x94, 0x10, 0xe6, 0x8a,
+	0x21, 0xcc, 0xf7, 0x1c, 0xd8, 0x29, 0x6c, 0x9c, 0x13, 0xc7, 0x79, 0x83, 0xce, 0x43, 0x4e, 0x93,
+	0xe7, 0x70, 0x62, 0xe8, 0xa0, 0x2c, 0x7f, 0x97, 0x7a, 0xa0, 0x34, 0xe4, 0x6b, 0xc3, 0x0e, 0x94,
+	0x36, 0xdf, 0x61, 0x7e, 0x83, 0x9e, 0xfd, 0xab, 0x65, 0xa6, 0x4a, 0x7d, 0xc7, 0x1a, 0x97, 0x71,
+	0xe9, 0xd9, 0x3b, 0x76, 0x45, 0x8a, 0x85, 0x0f, 0x1f, 0x7d, 0x7d, 0x78, 0x91, 0x2a, 0xdd, 0xf1,
+	0x7e, 0x2a, 0x07, 0xe6, 0xd7, 0x60, 0xa6, 0x7f, 0xa9, 0x41, 0xf5, 0xfc, 0x0e, 0xdc, 0xa7, 0x7a,
+	0xb2, 0x5e, 0x69, 0x4f, 0xff, 0x03, 0x6e, 0x96, 0x1e, 0x62, 0xd5, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -99,6 +99,9 @@ type QueryClient interface {
 	Commit(ctx context.Context, in *query.CommitRequest, opts ...grpc.CallOption) (*query.CommitResponse, error)
 	// Rollback a transaction.
 	Rollback(ctx context.Context, in *query.RollbackRequest, opts ...grpc.CallOption) (*query.RollbackResponse, error)
+	// Release rolls back the transaction of a reserved connection, if
+	// any, and releases the connection.
+	Release(ctx context.Context, in *query.ReleaseRequest, opts ...grpc.CallOption) (*query.ReleaseResponse, error)
 	// Prepare preares a transaction.
 	Prepare(ctx context.Context, in *query.PrepareRequest, opts ...grpc.CallOption) (*query.PrepareResponse, error)
 	// CommitPrepared commits a prepared transaction.
@@ -224,6 +227,15 @@ func (c *queryClient) Rollback(ctx context.Context, in *query.RollbackRequest, o
 	return out, nil
 }
 
+func (c *queryClient) Release(ctx context.Context, in *query.ReleaseRequest, opts ...grpc.CallOption) (*query.ReleaseResponse, error) {
+	out := new(query.ReleaseResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Prepare(ctx context.Context, in *query.PrepareRequest, opts ...grpc.CallOption) (*query.PrepareResponse, error) {
 	out := new(query.PrepareResponse)
 	err := c.cc.Invoke(ctx, "/queryservice.Query/Prepare", in, out, opts...)
@@ -543,6 +555,9 @@ type QueryServer interface {
 	Commit(context.Context, *query.CommitRequest) (*query.CommitResponse, error)
 	// Rollback a transaction.
 	Rollback(context.Context, *query.RollbackRequest) (*query.RollbackResponse, error)
+	// Release rolls back the transaction of a reserved connection, if
+	// any, and releases the connection.
+	Release(context.Context, *query.ReleaseRequest) (*query.ReleaseResponse, error)
 	// Prepare preares a transaction.
 	Prepare(context.Context, *query.PrepareRequest) (*query.PrepareResponse, error)
 	// CommitPrepared commits a prepared transaction.
@@ -605,6 +620,9 @@ func (*UnimplementedQueryServer) Commit(ctx context.Context, req *query.CommitRe
 func (*UnimplementedQueryServer) Rollback(ctx context.Context, req *query.RollbackRequest) (*query.RollbackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
 }
+func (*UnimplementedQueryServer) Release(ctx context.Context, req *query.ReleaseRequest) (*query.ReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
 func (*UnimplementedQueryServer) Prepare(ctx context.Context, req *query.PrepareRequest) (*query.PrepareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prepare not implemented")
 }
@@ -775,6 +793,24 @@ func _Query_Rollback_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.ReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Release(ctx, req.(*query.ReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Prepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(query.PrepareRequest)
 	if err := dec(in); err != nil {
@@ -1141,6 +1177,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Rollback",
 			Handler:    _Query_Rollback_Handler,
 		},
+		{
+			MethodName: "Release",
+			Handler:    _Query_Release_Handler,
+		},
 		{
 			MethodName: "Prepare",
 			Handler:    _Query_Prepare_Handler,
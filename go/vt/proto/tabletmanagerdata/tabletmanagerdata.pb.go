@@ -3820,6 +3820,1213 @@ func (m *RestoreFromBackupResponse) GetEvent() *logutil.Event {
 	return nil
 }
 
+type PoolInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity             int64    `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	MaxCapacity          int64    `protobuf:"varint,3,opt,name=max_capacity,json=maxCapacity,proto3" json:"max_capacity,omitempty"`
+	IdleTimeoutMs        int64    `protobuf:"varint,4,opt,name=idle_timeout_ms,json=idleTimeoutMs,proto3" json:"idle_timeout_ms,omitempty"`
+	PrefillParallelism   int64    `protobuf:"varint,5,opt,name=prefill_parallelism,json=prefillParallelism,proto3" json:"prefill_parallelism,omitempty"`
+	Available            int64    `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`
+	Active               int64    `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	InUse                int64    `protobuf:"varint,8,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+	WaitCount            int64    `protobuf:"varint,9,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	WaitTimeMs           int64    `protobuf:"varint,10,opt,name=wait_time_ms,json=waitTimeMs,proto3" json:"wait_time_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PoolInfo) Reset()         { *m = PoolInfo{} }
+func (m *PoolInfo) String() string { return proto.CompactTextString(m) }
+func (*PoolInfo) ProtoMessage()    {}
+func (*PoolInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{96}
+}
+
+func (m *PoolInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolInfo.Unmarshal(m, b)
+}
+func (m *PoolInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolInfo.Marshal(b, m, deterministic)
+}
+func (m *PoolInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolInfo.Merge(m, src)
+}
+func (m *PoolInfo) XXX_Size() int {
+	return xxx_messageInfo_PoolInfo.Size(m)
+}
+func (m *PoolInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolInfo proto.InternalMessageInfo
+
+func (m *PoolInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PoolInfo) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *PoolInfo) GetMaxCapacity() int64 {
+	if m != nil {
+		return m.MaxCapacity
+	}
+	return 0
+}
+
+func (m *PoolInfo) GetIdleTimeoutMs() int64 {
+	if m != nil {
+		return m.IdleTimeoutMs
+	}
+	return 0
+}
+
+func (m *PoolInfo) GetPrefillParallelism() int64 {
+	if m != nil {
+		return m.PrefillParallelism
+	}
+	return 0
+}
+
+func (m *PoolInfo) GetAvailable() int64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
+}
+
+func (m *PoolInfo) GetActive() int64 {
+	if m != nil {
+		return m.Active
+	}
+	return 0
+}
+
+func (m *PoolInfo) GetInUse() int64 {
+	if m != nil {
+		return m.InUse
+	}
+	return 0
+}
+
+func (m *PoolInfo) GetWaitCount() int64 {
+	if m != nil {
+		return m.WaitCount
+	}
+	return 0
+}
+
+func (m *PoolInfo) GetWaitTimeMs() int64 {
+	if m != nil {
+		return m.WaitTimeMs
+	}
+	return 0
+}
+
+type GetPoolsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPoolsRequest) Reset()         { *m = GetPoolsRequest{} }
+func (m *GetPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPoolsRequest) ProtoMessage()    {}
+func (*GetPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{97}
+}
+
+func (m *GetPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPoolsRequest.Unmarshal(m, b)
+}
+func (m *GetPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPoolsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPoolsRequest.Merge(m, src)
+}
+func (m *GetPoolsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPoolsRequest.Size(m)
+}
+func (m *GetPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPoolsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPoolsRequest proto.InternalMessageInfo
+
+type GetPoolsResponse struct {
+	Pools                []*PoolInfo `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetPoolsResponse) Reset()         { *m = GetPoolsResponse{} }
+func (m *GetPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPoolsResponse) ProtoMessage()    {}
+func (*GetPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{98}
+}
+
+func (m *GetPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPoolsResponse.Unmarshal(m, b)
+}
+func (m *GetPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPoolsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPoolsResponse.Merge(m, src)
+}
+func (m *GetPoolsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPoolsResponse.Size(m)
+}
+func (m *GetPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPoolsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPoolsResponse proto.InternalMessageInfo
+
+func (m *GetPoolsResponse) GetPools() []*PoolInfo {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+type ResizePoolRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity             int64    `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	IdleTimeoutMs        int64    `protobuf:"varint,3,opt,name=idle_timeout_ms,json=idleTimeoutMs,proto3" json:"idle_timeout_ms,omitempty"`
+	PrefillParallelism   int64    `protobuf:"varint,4,opt,name=prefill_parallelism,json=prefillParallelism,proto3" json:"prefill_parallelism,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResizePoolRequest) Reset()         { *m = ResizePoolRequest{} }
+func (m *ResizePoolRequest) String() string { return proto.CompactTextString(m) }
+func (*ResizePoolRequest) ProtoMessage()    {}
+func (*ResizePoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{99}
+}
+
+func (m *ResizePoolRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResizePoolRequest.Unmarshal(m, b)
+}
+func (m *ResizePoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResizePoolRequest.Marshal(b, m, deterministic)
+}
+func (m *ResizePoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizePoolRequest.Merge(m, src)
+}
+func (m *ResizePoolRequest) XXX_Size() int {
+	return xxx_messageInfo_ResizePoolRequest.Size(m)
+}
+func (m *ResizePoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizePoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizePoolRequest proto.InternalMessageInfo
+
+func (m *ResizePoolRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResizePoolRequest) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ResizePoolRequest) GetIdleTimeoutMs() int64 {
+	if m != nil {
+		return m.IdleTimeoutMs
+	}
+	return 0
+}
+
+func (m *ResizePoolRequest) GetPrefillParallelism() int64 {
+	if m != nil {
+		return m.PrefillParallelism
+	}
+	return 0
+}
+
+type ResizePoolResponse struct {
+	Pool                 *PoolInfo `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ResizePoolResponse) Reset()         { *m = ResizePoolResponse{} }
+func (m *ResizePoolResponse) String() string { return proto.CompactTextString(m) }
+func (*ResizePoolResponse) ProtoMessage()    {}
+func (*ResizePoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{100}
+}
+
+func (m *ResizePoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResizePoolResponse.Unmarshal(m, b)
+}
+func (m *ResizePoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResizePoolResponse.Marshal(b, m, deterministic)
+}
+func (m *ResizePoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizePoolResponse.Merge(m, src)
+}
+func (m *ResizePoolResponse) XXX_Size() int {
+	return xxx_messageInfo_ResizePoolResponse.Size(m)
+}
+func (m *ResizePoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizePoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizePoolResponse proto.InternalMessageInfo
+
+func (m *ResizePoolResponse) GetPool() *PoolInfo {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+type LockWait struct {
+	WaitingThreadId      int64    `protobuf:"varint,1,opt,name=waiting_thread_id,json=waitingThreadId,proto3" json:"waiting_thread_id,omitempty"`
+	WaitingQuery         string   `protobuf:"bytes,2,opt,name=waiting_query,json=waitingQuery,proto3" json:"waiting_query,omitempty"`
+	WaitingFingerprint   string   `protobuf:"bytes,3,opt,name=waiting_fingerprint,json=waitingFingerprint,proto3" json:"waiting_fingerprint,omitempty"`
+	WaitTimeSeconds      int64    `protobuf:"varint,4,opt,name=wait_time_seconds,json=waitTimeSeconds,proto3" json:"wait_time_seconds,omitempty"`
+	BlockingThreadId     int64    `protobuf:"varint,5,opt,name=blocking_thread_id,json=blockingThreadId,proto3" json:"blocking_thread_id,omitempty"`
+	BlockingQuery        string   `protobuf:"bytes,6,opt,name=blocking_query,json=blockingQuery,proto3" json:"blocking_query,omitempty"`
+	BlockingFingerprint  string   `protobuf:"bytes,7,opt,name=blocking_fingerprint,json=blockingFingerprint,proto3" json:"blocking_fingerprint,omitempty"`
+	LockedTable          string   `protobuf:"bytes,8,opt,name=locked_table,json=lockedTable,proto3" json:"locked_table,omitempty"`
+	LockedIndex          string   `protobuf:"bytes,9,opt,name=locked_index,json=lockedIndex,proto3" json:"locked_index,omitempty"`
+	LockMode             string   `protobuf:"bytes,10,opt,name=lock_mode,json=lockMode,proto3" json:"lock_mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockWait) Reset()         { *m = LockWait{} }
+func (m *LockWait) String() string { return proto.CompactTextString(m) }
+func (*LockWait) ProtoMessage()    {}
+func (*LockWait) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{101}
+}
+
+func (m *LockWait) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockWait.Unmarshal(m, b)
+}
+func (m *LockWait) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockWait.Marshal(b, m, deterministic)
+}
+func (m *LockWait) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockWait.Merge(m, src)
+}
+func (m *LockWait) XXX_Size() int {
+	return xxx_messageInfo_LockWait.Size(m)
+}
+func (m *LockWait) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockWait.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockWait proto.InternalMessageInfo
+
+func (m *LockWait) GetWaitingThreadId() int64 {
+	if m != nil {
+		return m.WaitingThreadId
+	}
+	return 0
+}
+
+func (m *LockWait) GetWaitingQuery() string {
+	if m != nil {
+		return m.WaitingQuery
+	}
+	return ""
+}
+
+func (m *LockWait) GetWaitingFingerprint() string {
+	if m != nil {
+		return m.WaitingFingerprint
+	}
+	return ""
+}
+
+func (m *LockWait) GetWaitTimeSeconds() int64 {
+	if m != nil {
+		return m.WaitTimeSeconds
+	}
+	return 0
+}
+
+func (m *LockWait) GetBlockingThreadId() int64 {
+	if m != nil {
+		return m.BlockingThreadId
+	}
+	return 0
+}
+
+func (m *LockWait) GetBlockingQuery() string {
+	if m != nil {
+		return m.BlockingQuery
+	}
+	return ""
+}
+
+func (m *LockWait) GetBlockingFingerprint() string {
+	if m != nil {
+		return m.BlockingFingerprint
+	}
+	return ""
+}
+
+func (m *LockWait) GetLockedTable() string {
+	if m != nil {
+		return m.LockedTable
+	}
+	return ""
+}
+
+func (m *LockWait) GetLockedIndex() string {
+	if m != nil {
+		return m.LockedIndex
+	}
+	return ""
+}
+
+func (m *LockWait) GetLockMode() string {
+	if m != nil {
+		return m.LockMode
+	}
+	return ""
+}
+
+type LockDiagnostics struct {
+	CaptureTime          int64       `protobuf:"varint,1,opt,name=capture_time,json=captureTime,proto3" json:"capture_time,omitempty"`
+	Automatic            bool        `protobuf:"varint,2,opt,name=automatic,proto3" json:"automatic,omitempty"`
+	LockWaits            []*LockWait `protobuf:"bytes,3,rep,name=lock_waits,json=lockWaits,proto3" json:"lock_waits,omitempty"`
+	LatestDeadlock       string      `protobuf:"bytes,4,opt,name=latest_deadlock,json=latestDeadlock,proto3" json:"latest_deadlock,omitempty"`
+	InnodbStatus         string      `protobuf:"bytes,5,opt,name=innodb_status,json=innodbStatus,proto3" json:"innodb_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LockDiagnostics) Reset()         { *m = LockDiagnostics{} }
+func (m *LockDiagnostics) String() string { return proto.CompactTextString(m) }
+func (*LockDiagnostics) ProtoMessage()    {}
+func (*LockDiagnostics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{102}
+}
+
+func (m *LockDiagnostics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockDiagnostics.Unmarshal(m, b)
+}
+func (m *LockDiagnostics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockDiagnostics.Marshal(b, m, deterministic)
+}
+func (m *LockDiagnostics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockDiagnostics.Merge(m, src)
+}
+func (m *LockDiagnostics) XXX_Size() int {
+	return xxx_messageInfo_LockDiagnostics.Size(m)
+}
+func (m *LockDiagnostics) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockDiagnostics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockDiagnostics proto.InternalMessageInfo
+
+func (m *LockDiagnostics) GetCaptureTime() int64 {
+	if m != nil {
+		return m.CaptureTime
+	}
+	return 0
+}
+
+func (m *LockDiagnostics) GetAutomatic() bool {
+	if m != nil {
+		return m.Automatic
+	}
+	return false
+}
+
+func (m *LockDiagnostics) GetLockWaits() []*LockWait {
+	if m != nil {
+		return m.LockWaits
+	}
+	return nil
+}
+
+func (m *LockDiagnostics) GetLatestDeadlock() string {
+	if m != nil {
+		return m.LatestDeadlock
+	}
+	return ""
+}
+
+func (m *LockDiagnostics) GetInnodbStatus() string {
+	if m != nil {
+		return m.InnodbStatus
+	}
+	return ""
+}
+
+type GetLockDiagnosticsRequest struct {
+	LastAutomatic        bool     `protobuf:"varint,1,opt,name=last_automatic,json=lastAutomatic,proto3" json:"last_automatic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLockDiagnosticsRequest) Reset()         { *m = GetLockDiagnosticsRequest{} }
+func (m *GetLockDiagnosticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLockDiagnosticsRequest) ProtoMessage()    {}
+func (*GetLockDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{103}
+}
+
+func (m *GetLockDiagnosticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLockDiagnosticsRequest.Unmarshal(m, b)
+}
+func (m *GetLockDiagnosticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLockDiagnosticsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLockDiagnosticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLockDiagnosticsRequest.Merge(m, src)
+}
+func (m *GetLockDiagnosticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLockDiagnosticsRequest.Size(m)
+}
+func (m *GetLockDiagnosticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLockDiagnosticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLockDiagnosticsRequest proto.InternalMessageInfo
+
+func (m *GetLockDiagnosticsRequest) GetLastAutomatic() bool {
+	if m != nil {
+		return m.LastAutomatic
+	}
+	return false
+}
+
+type GetLockDiagnosticsResponse struct {
+	Diagnostics          *LockDiagnostics `protobuf:"bytes,1,opt,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetLockDiagnosticsResponse) Reset()         { *m = GetLockDiagnosticsResponse{} }
+func (m *GetLockDiagnosticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLockDiagnosticsResponse) ProtoMessage()    {}
+func (*GetLockDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{104}
+}
+
+func (m *GetLockDiagnosticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLockDiagnosticsResponse.Unmarshal(m, b)
+}
+func (m *GetLockDiagnosticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLockDiagnosticsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLockDiagnosticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLockDiagnosticsResponse.Merge(m, src)
+}
+func (m *GetLockDiagnosticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLockDiagnosticsResponse.Size(m)
+}
+func (m *GetLockDiagnosticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLockDiagnosticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLockDiagnosticsResponse proto.InternalMessageInfo
+
+func (m *GetLockDiagnosticsResponse) GetDiagnostics() *LockDiagnostics {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+// RuntimeFlag is the value of a flag that can be changed without
+// restarting the tablet.
+type RuntimeFlag struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimeFlag) Reset()         { *m = RuntimeFlag{} }
+func (m *RuntimeFlag) String() string { return proto.CompactTextString(m) }
+func (*RuntimeFlag) ProtoMessage()    {}
+func (*RuntimeFlag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{105}
+}
+
+func (m *RuntimeFlag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuntimeFlag.Unmarshal(m, b)
+}
+func (m *RuntimeFlag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuntimeFlag.Marshal(b, m, deterministic)
+}
+func (m *RuntimeFlag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeFlag.Merge(m, src)
+}
+func (m *RuntimeFlag) XXX_Size() int {
+	return xxx_messageInfo_RuntimeFlag.Size(m)
+}
+func (m *RuntimeFlag) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeFlag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeFlag proto.InternalMessageInfo
+
+func (m *RuntimeFlag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RuntimeFlag) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// RuntimeFlagChange is a change of a flag at runtime.
+type RuntimeFlagChange struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OldValue             string   `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue             string   `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimeFlagChange) Reset()         { *m = RuntimeFlagChange{} }
+func (m *RuntimeFlagChange) String() string { return proto.CompactTextString(m) }
+func (*RuntimeFlagChange) ProtoMessage()    {}
+func (*RuntimeFlagChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{106}
+}
+
+func (m *RuntimeFlagChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuntimeFlagChange.Unmarshal(m, b)
+}
+func (m *RuntimeFlagChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuntimeFlagChange.Marshal(b, m, deterministic)
+}
+func (m *RuntimeFlagChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeFlagChange.Merge(m, src)
+}
+func (m *RuntimeFlagChange) XXX_Size() int {
+	return xxx_messageInfo_RuntimeFlagChange.Size(m)
+}
+func (m *RuntimeFlagChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeFlagChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeFlagChange proto.InternalMessageInfo
+
+func (m *RuntimeFlagChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RuntimeFlagChange) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *RuntimeFlagChange) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+// SetRuntimeFlagsRequest changes the flags all together: if one of
+// them can't be changed, none of them is.
+type SetRuntimeFlagsRequest struct {
+	Flags                []*RuntimeFlag `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetRuntimeFlagsRequest) Reset()         { *m = SetRuntimeFlagsRequest{} }
+func (m *SetRuntimeFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRuntimeFlagsRequest) ProtoMessage()    {}
+func (*SetRuntimeFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{107}
+}
+
+func (m *SetRuntimeFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRuntimeFlagsRequest.Unmarshal(m, b)
+}
+func (m *SetRuntimeFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRuntimeFlagsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetRuntimeFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRuntimeFlagsRequest.Merge(m, src)
+}
+func (m *SetRuntimeFlagsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRuntimeFlagsRequest.Size(m)
+}
+func (m *SetRuntimeFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRuntimeFlagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRuntimeFlagsRequest proto.InternalMessageInfo
+
+func (m *SetRuntimeFlagsRequest) GetFlags() []*RuntimeFlag {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+type SetRuntimeFlagsResponse struct {
+	Changes              []*RuntimeFlagChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SetRuntimeFlagsResponse) Reset()         { *m = SetRuntimeFlagsResponse{} }
+func (m *SetRuntimeFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetRuntimeFlagsResponse) ProtoMessage()    {}
+func (*SetRuntimeFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{108}
+}
+
+func (m *SetRuntimeFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRuntimeFlagsResponse.Unmarshal(m, b)
+}
+func (m *SetRuntimeFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRuntimeFlagsResponse.Marshal(b, m, deterministic)
+}
+func (m *SetRuntimeFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRuntimeFlagsResponse.Merge(m, src)
+}
+func (m *SetRuntimeFlagsResponse) XXX_Size() int {
+	return xxx_messageInfo_SetRuntimeFlagsResponse.Size(m)
+}
+func (m *SetRuntimeFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRuntimeFlagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRuntimeFlagsResponse proto.InternalMessageInfo
+
+func (m *SetRuntimeFlagsResponse) GetChanges() []*RuntimeFlagChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// QueryPlanStats are the statistics of a query of the plan cache of the
+// tablet. The queries are normalized by vtgate, and the fingerprint is
+// the one used by the query blocklist. The latency percentiles are
+// estimated from a histogram, their precision is the width of its
+// buckets.
+type QueryPlanStats struct {
+	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Fingerprint          string   `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Table                string   `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	Plan                 string   `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`
+	QueryCount           int64    `protobuf:"varint,5,opt,name=query_count,json=queryCount,proto3" json:"query_count,omitempty"`
+	RowCount             int64    `protobuf:"varint,6,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	ErrorCount           int64    `protobuf:"varint,7,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	TimeNs               int64    `protobuf:"varint,8,opt,name=time_ns,json=timeNs,proto3" json:"time_ns,omitempty"`
+	MysqlTimeNs          int64    `protobuf:"varint,9,opt,name=mysql_time_ns,json=mysqlTimeNs,proto3" json:"mysql_time_ns,omitempty"`
+	P50LatencyNs         int64    `protobuf:"varint,10,opt,name=p50_latency_ns,json=p50LatencyNs,proto3" json:"p50_latency_ns,omitempty"`
+	P95LatencyNs         int64    `protobuf:"varint,11,opt,name=p95_latency_ns,json=p95LatencyNs,proto3" json:"p95_latency_ns,omitempty"`
+	P99LatencyNs         int64    `protobuf:"varint,12,opt,name=p99_latency_ns,json=p99LatencyNs,proto3" json:"p99_latency_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryPlanStats) Reset()         { *m = QueryPlanStats{} }
+func (m *QueryPlanStats) String() string { return proto.CompactTextString(m) }
+func (*QueryPlanStats) ProtoMessage()    {}
+func (*QueryPlanStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{109}
+}
+
+func (m *QueryPlanStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryPlanStats.Unmarshal(m, b)
+}
+func (m *QueryPlanStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryPlanStats.Marshal(b, m, deterministic)
+}
+func (m *QueryPlanStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPlanStats.Merge(m, src)
+}
+func (m *QueryPlanStats) XXX_Size() int {
+	return xxx_messageInfo_QueryPlanStats.Size(m)
+}
+func (m *QueryPlanStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPlanStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPlanStats proto.InternalMessageInfo
+
+func (m *QueryPlanStats) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *QueryPlanStats) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *QueryPlanStats) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *QueryPlanStats) GetPlan() string {
+	if m != nil {
+		return m.Plan
+	}
+	return ""
+}
+
+func (m *QueryPlanStats) GetQueryCount() int64 {
+	if m != nil {
+		return m.QueryCount
+	}
+	return 0
+}
+
+func (m *QueryPlanStats) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *QueryPlanStats) GetErrorCount() int64 {
+	if m != nil {
+		return m.ErrorCount
+	}
+	return 0
+}
+
+func (m *QueryPlanStats) GetTimeNs() int64 {
+	if m != nil {
+		return m.TimeNs
+	}
+	return 0
+}
+
+func (m *QueryPlanStats) GetMysqlTimeNs() int64 {
+	if m != nil {
+		return m.MysqlTimeNs
+	}
+	return 0
+}
+
+func (m *QueryPlanStats) GetP50LatencyNs() int64 {
+	if m != nil {
+		return m.P50LatencyNs
+	}
+	return 0
+}
+
+func (m *QueryPlanStats) GetP95LatencyNs() int64 {
+	if m != nil {
+		return m.P95LatencyNs
+	}
+	return 0
+}
+
+func (m *QueryPlanStats) GetP99LatencyNs() int64 {
+	if m != nil {
+		return m.P99LatencyNs
+	}
+	return 0
+}
+
+type GetQueryPlanStatsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetQueryPlanStatsRequest) Reset()         { *m = GetQueryPlanStatsRequest{} }
+func (m *GetQueryPlanStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQueryPlanStatsRequest) ProtoMessage()    {}
+func (*GetQueryPlanStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{110}
+}
+
+func (m *GetQueryPlanStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQueryPlanStatsRequest.Unmarshal(m, b)
+}
+func (m *GetQueryPlanStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQueryPlanStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQueryPlanStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueryPlanStatsRequest.Merge(m, src)
+}
+func (m *GetQueryPlanStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQueryPlanStatsRequest.Size(m)
+}
+func (m *GetQueryPlanStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueryPlanStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQueryPlanStatsRequest proto.InternalMessageInfo
+
+type GetQueryPlanStatsResponse struct {
+	Stats                []*QueryPlanStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetQueryPlanStatsResponse) Reset()         { *m = GetQueryPlanStatsResponse{} }
+func (m *GetQueryPlanStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQueryPlanStatsResponse) ProtoMessage()    {}
+func (*GetQueryPlanStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{111}
+}
+
+func (m *GetQueryPlanStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQueryPlanStatsResponse.Unmarshal(m, b)
+}
+func (m *GetQueryPlanStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQueryPlanStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetQueryPlanStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueryPlanStatsResponse.Merge(m, src)
+}
+func (m *GetQueryPlanStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetQueryPlanStatsResponse.Size(m)
+}
+func (m *GetQueryPlanStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueryPlanStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQueryPlanStatsResponse proto.InternalMessageInfo
+
+func (m *GetQueryPlanStatsResponse) GetStats() []*QueryPlanStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// QueryBlocklistEntry is a query fingerprint that is blocked by the query
+// blocklist of the tablet, or pending the approval of an operator.
+type QueryBlocklistEntry struct {
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Query       string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	State       string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// manual is true if the entry was added by an operator.
+	Manual bool `protobuf:"varint,4,opt,name=manual,proto3" json:"manual,omitempty"`
+	// mysql_time_ns and rows are the load of the fingerprint during the
+	// window when it was detected.
+	MysqlTimeNs int64  `protobuf:"varint,5,opt,name=mysql_time_ns,json=mysqlTimeNs,proto3" json:"mysql_time_ns,omitempty"`
+	Rows        int64  `protobuf:"varint,6,opt,name=rows,proto3" json:"rows,omitempty"`
+	Reason      string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// since and expires are unix timestamps in seconds. expires is 0 if
+	// the entry doesn't expire.
+	Since                int64    `protobuf:"varint,8,opt,name=since,proto3" json:"since,omitempty"`
+	Expires              int64    `protobuf:"varint,9,opt,name=expires,proto3" json:"expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryBlocklistEntry) Reset()         { *m = QueryBlocklistEntry{} }
+func (m *QueryBlocklistEntry) String() string { return proto.CompactTextString(m) }
+func (*QueryBlocklistEntry) ProtoMessage()    {}
+func (*QueryBlocklistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{112}
+}
+
+func (m *QueryBlocklistEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryBlocklistEntry.Unmarshal(m, b)
+}
+func (m *QueryBlocklistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryBlocklistEntry.Marshal(b, m, deterministic)
+}
+func (m *QueryBlocklistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlocklistEntry.Merge(m, src)
+}
+func (m *QueryBlocklistEntry) XXX_Size() int {
+	return xxx_messageInfo_QueryBlocklistEntry.Size(m)
+}
+func (m *QueryBlocklistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlocklistEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlocklistEntry proto.InternalMessageInfo
+
+func (m *QueryBlocklistEntry) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *QueryBlocklistEntry) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *QueryBlocklistEntry) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *QueryBlocklistEntry) GetManual() bool {
+	if m != nil {
+		return m.Manual
+	}
+	return false
+}
+
+func (m *QueryBlocklistEntry) GetMysqlTimeNs() int64 {
+	if m != nil {
+		return m.MysqlTimeNs
+	}
+	return 0
+}
+
+func (m *QueryBlocklistEntry) GetRows() int64 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *QueryBlocklistEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *QueryBlocklistEntry) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *QueryBlocklistEntry) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+type GetQueryBlocklistRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetQueryBlocklistRequest) Reset()         { *m = GetQueryBlocklistRequest{} }
+func (m *GetQueryBlocklistRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQueryBlocklistRequest) ProtoMessage()    {}
+func (*GetQueryBlocklistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{113}
+}
+
+func (m *GetQueryBlocklistRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQueryBlocklistRequest.Unmarshal(m, b)
+}
+func (m *GetQueryBlocklistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQueryBlocklistRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQueryBlocklistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueryBlocklistRequest.Merge(m, src)
+}
+func (m *GetQueryBlocklistRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQueryBlocklistRequest.Size(m)
+}
+func (m *GetQueryBlocklistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueryBlocklistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQueryBlocklistRequest proto.InternalMessageInfo
+
+type GetQueryBlocklistResponse struct {
+	Entries              []*QueryBlocklistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetQueryBlocklistResponse) Reset()         { *m = GetQueryBlocklistResponse{} }
+func (m *GetQueryBlocklistResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQueryBlocklistResponse) ProtoMessage()    {}
+func (*GetQueryBlocklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{114}
+}
+
+func (m *GetQueryBlocklistResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQueryBlocklistResponse.Unmarshal(m, b)
+}
+func (m *GetQueryBlocklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQueryBlocklistResponse.Marshal(b, m, deterministic)
+}
+func (m *GetQueryBlocklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueryBlocklistResponse.Merge(m, src)
+}
+func (m *GetQueryBlocklistResponse) XXX_Size() int {
+	return xxx_messageInfo_GetQueryBlocklistResponse.Size(m)
+}
+func (m *GetQueryBlocklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueryBlocklistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQueryBlocklistResponse proto.InternalMessageInfo
+
+func (m *GetQueryBlocklistResponse) GetEntries() []*QueryBlocklistEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// UpdateQueryBlocklistRequest approves, blocks or removes a query
+// fingerprint. action is "approve", "block" or "remove", and reason is
+// only used to block.
+type UpdateQueryBlocklistRequest struct {
+	Action               string   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Fingerprint          string   `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateQueryBlocklistRequest) Reset()         { *m = UpdateQueryBlocklistRequest{} }
+func (m *UpdateQueryBlocklistRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateQueryBlocklistRequest) ProtoMessage()    {}
+func (*UpdateQueryBlocklistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{115}
+}
+
+func (m *UpdateQueryBlocklistRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateQueryBlocklistRequest.Unmarshal(m, b)
+}
+func (m *UpdateQueryBlocklistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateQueryBlocklistRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateQueryBlocklistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateQueryBlocklistRequest.Merge(m, src)
+}
+func (m *UpdateQueryBlocklistRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateQueryBlocklistRequest.Size(m)
+}
+func (m *UpdateQueryBlocklistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateQueryBlocklistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateQueryBlocklistRequest proto.InternalMessageInfo
+
+func (m *UpdateQueryBlocklistRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *UpdateQueryBlocklistRequest) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+func (m *UpdateQueryBlocklistRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type UpdateQueryBlocklistResponse struct {
+	Entries              []*QueryBlocklistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UpdateQueryBlocklistResponse) Reset()         { *m = UpdateQueryBlocklistResponse{} }
+func (m *UpdateQueryBlocklistResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateQueryBlocklistResponse) ProtoMessage()    {}
+func (*UpdateQueryBlocklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff9ac4f89e61ffa4, []int{116}
+}
+
+func (m *UpdateQueryBlocklistResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateQueryBlocklistResponse.Unmarshal(m, b)
+}
+func (m *UpdateQueryBlocklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateQueryBlocklistResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateQueryBlocklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateQueryBlocklistResponse.Merge(m, src)
+}
+func (m *UpdateQueryBlocklistResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateQueryBlocklistResponse.Size(m)
+}
+func (m *UpdateQueryBlocklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateQueryBlocklistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateQueryBlocklistResponse proto.InternalMessageInfo
+
+func (m *UpdateQueryBlocklistResponse) GetEntries() []*QueryBlocklistEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TableDefinition)(nil), "tabletmanagerdata.TableDefinition")
 	proto.RegisterType((*SchemaDefinition)(nil), "tabletmanagerdata.SchemaDefinition")
@@ -3920,142 +5127,222 @@ func init() {
 	proto.RegisterType((*BackupResponse)(nil), "tabletmanagerdata.BackupResponse")
 	proto.RegisterType((*RestoreFromBackupRequest)(nil), "tabletmanagerdata.RestoreFromBackupRequest")
 	proto.RegisterType((*RestoreFromBackupResponse)(nil), "tabletmanagerdata.RestoreFromBackupResponse")
+	proto.RegisterType((*PoolInfo)(nil), "tabletmanagerdata.PoolInfo")
+	proto.RegisterType((*GetPoolsRequest)(nil), "tabletmanagerdata.GetPoolsRequest")
+	proto.RegisterType((*GetPoolsResponse)(nil), "tabletmanagerdata.GetPoolsResponse")
+	proto.RegisterType((*ResizePoolRequest)(nil), "tabletmanagerdata.ResizePoolRequest")
+	proto.RegisterType((*ResizePoolResponse)(nil), "tabletmanagerdata.ResizePoolResponse")
+	proto.RegisterType((*LockWait)(nil), "tabletmanagerdata.LockWait")
+	proto.RegisterType((*LockDiagnostics)(nil), "tabletmanagerdata.LockDiagnostics")
+	proto.RegisterType((*GetLockDiagnosticsRequest)(nil), "tabletmanagerdata.GetLockDiagnosticsRequest")
+	proto.RegisterType((*GetLockDiagnosticsResponse)(nil), "tabletmanagerdata.GetLockDiagnosticsResponse")
+	proto.RegisterType((*RuntimeFlag)(nil), "tabletmanagerdata.RuntimeFlag")
+	proto.RegisterType((*RuntimeFlagChange)(nil), "tabletmanagerdata.RuntimeFlagChange")
+	proto.RegisterType((*SetRuntimeFlagsRequest)(nil), "tabletmanagerdata.SetRuntimeFlagsRequest")
+	proto.RegisterType((*SetRuntimeFlagsResponse)(nil), "tabletmanagerdata.SetRuntimeFlagsResponse")
+	proto.RegisterType((*QueryPlanStats)(nil), "tabletmanagerdata.QueryPlanStats")
+	proto.RegisterType((*GetQueryPlanStatsRequest)(nil), "tabletmanagerdata.GetQueryPlanStatsRequest")
+	proto.RegisterType((*GetQueryPlanStatsResponse)(nil), "tabletmanagerdata.GetQueryPlanStatsResponse")
+	proto.RegisterType((*QueryBlocklistEntry)(nil), "tabletmanagerdata.QueryBlocklistEntry")
+	proto.RegisterType((*GetQueryBlocklistRequest)(nil), "tabletmanagerdata.GetQueryBlocklistRequest")
+	proto.RegisterType((*GetQueryBlocklistResponse)(nil), "tabletmanagerdata.GetQueryBlocklistResponse")
+	proto.RegisterType((*UpdateQueryBlocklistRequest)(nil), "tabletmanagerdata.UpdateQueryBlocklistRequest")
+	proto.RegisterType((*UpdateQueryBlocklistResponse)(nil), "tabletmanagerdata.UpdateQueryBlocklistResponse")
 }
 
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor_ff9ac4f89e61ffa4) }
 
 var fileDescriptor_ff9ac4f89e61ffa4 = []byte{
-	// 2108 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0x06, 0x49, 0x49, 0xa6, 0x0e, 0x2f, 0x22, 0x97, 0x94, 0x48, 0xc9, 0x8d, 0x2e, 0x6b, 0xa7,
-	0x51, 0x5d, 0x94, 0x4a, 0x94, 0x34, 0x08, 0x52, 0xa4, 0xa8, 0xac, 0x8b, 0xed, 0x44, 0x89, 0x95,
-	0x95, 0x65, 0x17, 0x41, 0x81, 0xc5, 0x90, 0x3b, 0x22, 0x17, 0x5a, 0xee, 0xac, 0x67, 0x66, 0x29,
-	0xf1, 0x4f, 0xf4, 0x17, 0xf4, 0xad, 0x40, 0xfb, 0xde, 0xc7, 0xfe, 0x10, 0xf7, 0xa7, 0xf4, 0xa1,
-	0x0f, 0x2d, 0xe6, 0xb2, 0xe4, 0x2c, 0x49, 0xc9, 0x92, 0xe0, 0x02, 0x79, 0x11, 0x38, 0xdf, 0x39,
-	0x73, 0x6e, 0x73, 0x6e, 0x0b, 0x41, 0x83, 0xa3, 0x76, 0x80, 0x79, 0x1f, 0x85, 0xa8, 0x8b, 0xa9,
-	0x87, 0x38, 0x6a, 0x45, 0x94, 0x70, 0x62, 0x55, 0xa7, 0x08, 0x6b, 0x85, 0xb7, 0x31, 0xa6, 0x43,
-	0x45, 0x5f, 0x2b, 0x73, 0x12, 0x91, 0x31, 0xff, 0xda, 0x32, 0xc5, 0x51, 0xe0, 0x77, 0x10, 0xf7,
-	0x49, 0x68, 0xc0, 0xa5, 0x80, 0x74, 0x63, 0xee, 0x07, 0xea, 0x68, 0xff, 0x37, 0x03, 0x4b, 0xaf,
-	0x84, 0xe0, 0x03, 0x7c, 0xee, 0x87, 0xbe, 0x60, 0xb6, 0x2c, 0x98, 0x0b, 0x51, 0x1f, 0x37, 0x33,
-	0x9b, 0x99, 0xed, 0x45, 0x47, 0xfe, 0xb6, 0x56, 0x60, 0x81, 0x75, 0x7a, 0xb8, 0x8f, 0x9a, 0x59,
-	0x89, 0xea, 0x93, 0xd5, 0x84, 0x07, 0x1d, 0x12, 0xc4, 0xfd, 0x90, 0x35, 0x73, 0x9b, 0xb9, 0xed,
-	0x45, 0x27, 0x39, 0x5a, 0x2d, 0xa8, 0x45, 0xd4, 0xef, 0x23, 0x3a, 0x74, 0x2f, 0xf0, 0xd0, 0x4d,
-	0xb8, 0xe6, 0x24, 0x57, 0x55, 0x93, 0xbe, 0xc3, 0xc3, 0x7d, 0xcd, 0x6f, 0xc1, 0x1c, 0x1f, 0x46,
-	0xb8, 0x39, 0xaf, 0xb4, 0x8a, 0xdf, 0xd6, 0x06, 0x14, 0x84, 0xe9, 0x6e, 0x80, 0xc3, 0x2e, 0xef,
-	0x35, 0x17, 0x36, 0x33, 0xdb, 0x73, 0x0e, 0x08, 0xe8, 0x58, 0x22, 0xd6, 0x43, 0x58, 0xa4, 0xe4,
-	0xd2, 0xed, 0x90, 0x38, 0xe4, 0xcd, 0x07, 0x92, 0x9c, 0xa7, 0xe4, 0x72, 0x5f, 0x9c, 0xad, 0xc7,
-	0xb0, 0x70, 0xee, 0xe3, 0xc0, 0x63, 0xcd, 0xfc, 0x66, 0x6e, 0xbb, 0xb0, 0x5b, 0x6c, 0xa9, 0x78,
-	0x1d, 0x09, 0xd0, 0xd1, 0x34, 0xfb, 0x6f, 0x19, 0xa8, 0x9c, 0x4a, 0x67, 0x8c, 0x10, 0x7c, 0x02,
-	0x4b, 0x42, 0x4b, 0x1b, 0x31, 0xec, 0x6a, 0xbf, 0x55, 0x34, 0xca, 0x09, 0xac, 0xae, 0x58, 0x2f,
-	0x41, 0xbd, 0x8b, 0xeb, 0x8d, 0x2e, 0xb3, 0x66, 0x56, 0xaa, 0xb3, 0x5b, 0xd3, 0x4f, 0x39, 0x11,
-	0x6a, 0xa7, 0xc2, 0xd3, 0x00, 0x13, 0x01, 0x1d, 0x60, 0xca, 0x7c, 0x12, 0x36, 0x73, 0x52, 0x63,
-	0x72, 0x14, 0x86, 0x5a, 0x4a, 0xeb, 0x7e, 0x0f, 0x85, 0x5d, 0xec, 0x60, 0x16, 0x07, 0xdc, 0x7a,
-	0x0e, 0xa5, 0x36, 0x3e, 0x27, 0x34, 0x65, 0x68, 0x61, 0xf7, 0xd1, 0x0c, 0xed, 0x93, 0x6e, 0x3a,
-	0x45, 0x75, 0x53, 0xfb, 0x72, 0x04, 0x45, 0x74, 0xce, 0x31, 0x75, 0x8d, 0x97, 0xbe, 0xa5, 0xa0,
-	0x82, 0xbc, 0xa8, 0x60, 0xfb, 0xdf, 0x19, 0x28, 0x9f, 0x31, 0x4c, 0x4f, 0x30, 0xed, 0xfb, 0x8c,
-	0xe9, 0x94, 0xea, 0x11, 0xc6, 0x93, 0x94, 0x12, 0xbf, 0x05, 0x16, 0x33, 0x4c, 0x75, 0x42, 0xc9,
-	0xdf, 0xd6, 0xaf, 0xa1, 0x1a, 0x21, 0xc6, 0x2e, 0x09, 0xf5, 0xdc, 0x4e, 0x0f, 0x77, 0x2e, 0x58,
-	0xdc, 0x97, 0x71, 0x98, 0x73, 0x2a, 0x09, 0x61, 0x5f, 0xe3, 0xd6, 0x8f, 0x00, 0x11, 0xf5, 0x07,
-	0x7e, 0x80, 0xbb, 0x58, 0x25, 0x56, 0x61, 0xf7, 0xb3, 0x19, 0xd6, 0xa6, 0x6d, 0x69, 0x9d, 0x8c,
-	0xee, 0x1c, 0x86, 0x9c, 0x0e, 0x1d, 0x43, 0xc8, 0xda, 0x37, 0xb0, 0x34, 0x41, 0xb6, 0x2a, 0x90,
-	0xbb, 0xc0, 0x43, 0x6d, 0xb9, 0xf8, 0x69, 0xd5, 0x61, 0x7e, 0x80, 0x82, 0x18, 0x6b, 0xcb, 0xd5,
-	0xe1, 0xeb, 0xec, 0x57, 0x19, 0xfb, 0x5d, 0x06, 0x8a, 0x07, 0xed, 0xf7, 0xf8, 0x5d, 0x86, 0xac,
-	0xd7, 0xd6, 0x77, 0xb3, 0x5e, 0x7b, 0x14, 0x87, 0x9c, 0x11, 0x87, 0x97, 0x33, 0x5c, 0xdb, 0x99,
-	0xe1, 0x9a, 0xa9, 0xec, 0xff, 0xe9, 0xd8, 0x5f, 0x33, 0x50, 0x18, 0x6b, 0x62, 0xd6, 0x31, 0x54,
-	0x84, 0x9d, 0x6e, 0x34, 0xc6, 0x9a, 0x19, 0x69, 0xe5, 0xd6, 0x7b, 0x1f, 0xc0, 0x59, 0x8a, 0x53,
-	0x67, 0x66, 0x1d, 0x41, 0xd9, 0x6b, 0xa7, 0x64, 0xa9, 0x0a, 0xda, 0x78, 0x8f, 0xc7, 0x4e, 0xc9,
-	0x33, 0x4e, 0xcc, 0xfe, 0x04, 0x0a, 0x27, 0x7e, 0xd8, 0x75, 0xf0, 0xdb, 0x18, 0x33, 0x2e, 0x4a,
-	0x29, 0x42, 0xc3, 0x80, 0x20, 0x4f, 0x3b, 0x99, 0x1c, 0xed, 0x6d, 0x28, 0x2a, 0x46, 0x16, 0x91,
-	0x90, 0xe1, 0x1b, 0x38, 0x9f, 0x40, 0xf1, 0x34, 0xc0, 0x38, 0x4a, 0x64, 0xae, 0x41, 0xde, 0x8b,
-	0xa9, 0x6c, 0xaa, 0x92, 0x35, 0xe7, 0x8c, 0xce, 0xf6, 0x12, 0x94, 0x34, 0xaf, 0x12, 0x6b, 0xff,
-	0x2b, 0x03, 0xd6, 0xe1, 0x15, 0xee, 0xc4, 0x1c, 0x3f, 0x27, 0xe4, 0x22, 0x91, 0x31, 0xab, 0xbf,
-	0xae, 0x03, 0x44, 0x88, 0xa2, 0x3e, 0xe6, 0x98, 0x2a, 0xf7, 0x17, 0x1d, 0x03, 0xb1, 0x4e, 0x60,
-	0x11, 0x5f, 0x71, 0x8a, 0x5c, 0x1c, 0x0e, 0x64, 0xa7, 0x2d, 0xec, 0x7e, 0x3e, 0x23, 0x3a, 0xd3,
-	0xda, 0x5a, 0x87, 0xe2, 0xda, 0x61, 0x38, 0x50, 0x39, 0x91, 0xc7, 0xfa, 0xb8, 0xf6, 0x3b, 0x28,
-	0xa5, 0x48, 0x77, 0xca, 0x87, 0x73, 0xa8, 0xa5, 0x54, 0xe9, 0x38, 0x6e, 0x40, 0x01, 0x5f, 0xf9,
-	0xdc, 0x65, 0x1c, 0xf1, 0x98, 0xe9, 0x00, 0x81, 0x80, 0x4e, 0x25, 0x22, 0xc7, 0x08, 0xf7, 0x48,
-	0xcc, 0x47, 0x63, 0x44, 0x9e, 0x34, 0x8e, 0x69, 0x52, 0x05, 0xfa, 0x64, 0x0f, 0xa0, 0xf2, 0x0c,
-	0x73, 0xd5, 0x57, 0x92, 0xf0, 0xad, 0xc0, 0x82, 0x74, 0x5c, 0x65, 0xdc, 0xa2, 0xa3, 0x4f, 0xd6,
-	0x23, 0x28, 0xf9, 0x61, 0x27, 0x88, 0x3d, 0xec, 0x0e, 0x7c, 0x7c, 0xc9, 0xa4, 0x8a, 0xbc, 0x53,
-	0xd4, 0xe0, 0x6b, 0x81, 0x59, 0x1f, 0x43, 0x19, 0x5f, 0x29, 0x26, 0x2d, 0x44, 0x8d, 0xad, 0x92,
-	0x46, 0x65, 0x83, 0x66, 0x36, 0x86, 0xaa, 0xa1, 0x57, 0x7b, 0x77, 0x02, 0x55, 0xd5, 0x19, 0x8d,
-	0x66, 0x7f, 0x97, 0x6e, 0x5b, 0x61, 0x13, 0x88, 0xdd, 0x80, 0xe5, 0x67, 0x98, 0x1b, 0x29, 0xac,
-	0x7d, 0xb4, 0x7f, 0x82, 0x95, 0x49, 0x82, 0x36, 0xe2, 0x0f, 0x50, 0x48, 0x17, 0x9d, 0x50, 0xbf,
-	0x3e, 0x43, 0xbd, 0x79, 0xd9, 0xbc, 0x62, 0xd7, 0xc1, 0x3a, 0xc5, 0xdc, 0xc1, 0xc8, 0x7b, 0x19,
-	0x06, 0xc3, 0x44, 0xe3, 0x32, 0xd4, 0x52, 0xa8, 0x4e, 0xe1, 0x31, 0xfc, 0x86, 0xfa, 0x1c, 0x27,
-	0xdc, 0x2b, 0x50, 0x4f, 0xc3, 0x9a, 0xfd, 0x5b, 0xa8, 0xaa, 0xe1, 0xf4, 0x6a, 0x18, 0x25, 0xcc,
-	0xd6, 0x6f, 0xa1, 0xa0, 0xcc, 0x73, 0xe5, 0x80, 0x17, 0x26, 0x97, 0x77, 0xeb, 0xad, 0xd1, 0xbe,
-	0x22, 0x63, 0xce, 0xe5, 0x0d, 0xe0, 0xa3, 0xdf, 0xc2, 0x4e, 0x53, 0xd6, 0xd8, 0x20, 0x07, 0x9f,
-	0x53, 0xcc, 0x7a, 0x22, 0xa5, 0x4c, 0x83, 0xd2, 0xb0, 0x66, 0x6f, 0xc0, 0xb2, 0x13, 0x87, 0xcf,
-	0x31, 0x0a, 0x78, 0x4f, 0x0e, 0x8e, 0xe4, 0x42, 0x13, 0x56, 0x26, 0x09, 0xfa, 0xca, 0x17, 0xd0,
-	0x7c, 0xd1, 0x0d, 0x09, 0xc5, 0x8a, 0x78, 0x48, 0x29, 0xa1, 0xa9, 0x96, 0xc2, 0x39, 0xa6, 0xe1,
-	0xb8, 0x51, 0xc8, 0xa3, 0xfd, 0x10, 0x56, 0x67, 0xdc, 0xd2, 0x22, 0xbf, 0x16, 0x46, 0x8b, 0x7e,
-	0x92, 0xce, 0xe4, 0x47, 0x50, 0xba, 0x44, 0x3e, 0x77, 0x23, 0xc2, 0xc6, 0xc9, 0xb4, 0xe8, 0x14,
-	0x05, 0x78, 0xa2, 0x31, 0xe5, 0x99, 0x79, 0x57, 0xcb, 0xdc, 0x85, 0x95, 0x13, 0x8a, 0xcf, 0x03,
-	0xbf, 0xdb, 0x9b, 0x28, 0x10, 0xb1, 0x93, 0xc9, 0xc0, 0x25, 0x15, 0x92, 0x1c, 0xed, 0x2e, 0x34,
-	0xa6, 0xee, 0xe8, 0xbc, 0x3a, 0x86, 0xb2, 0xe2, 0x72, 0xa9, 0xdc, 0x2b, 0x92, 0x7e, 0xfe, 0xf1,
-	0xb5, 0x99, 0x6d, 0x6e, 0x21, 0x4e, 0xa9, 0x63, 0x9c, 0x98, 0xfd, 0x9f, 0x0c, 0x58, 0x7b, 0x51,
-	0x14, 0x0c, 0xd3, 0x96, 0x55, 0x20, 0xc7, 0xde, 0x06, 0x49, 0x8b, 0x61, 0x6f, 0x03, 0xd1, 0x62,
-	0xce, 0x09, 0xed, 0x60, 0x5d, 0xac, 0xea, 0x20, 0xd6, 0x00, 0x14, 0x04, 0xe4, 0xd2, 0x35, 0x76,
-	0x58, 0xd9, 0x19, 0xf2, 0x4e, 0x45, 0x12, 0x9c, 0x31, 0x3e, 0xbd, 0x00, 0xcd, 0x7d, 0xa8, 0x05,
-	0x68, 0xfe, 0x9e, 0x0b, 0xd0, 0xdf, 0x33, 0x50, 0x4b, 0x79, 0xaf, 0x63, 0xfc, 0xf3, 0x5b, 0xd5,
-	0x6a, 0x50, 0x3d, 0x26, 0x9d, 0x0b, 0xd5, 0xf5, 0x92, 0xd2, 0xa8, 0x83, 0x65, 0x82, 0xe3, 0xc2,
-	0x3b, 0x0b, 0x83, 0x29, 0xe6, 0x15, 0xa8, 0xa7, 0x61, 0xcd, 0xfe, 0x8f, 0x0c, 0x34, 0xf5, 0x88,
-	0x38, 0xc2, 0xbc, 0xd3, 0xdb, 0x63, 0x07, 0xed, 0x51, 0x1e, 0xd4, 0x61, 0x5e, 0xae, 0xe2, 0x32,
-	0x00, 0x45, 0x47, 0x1d, 0xac, 0x06, 0x3c, 0xf0, 0xda, 0xae, 0x1c, 0x8d, 0x7a, 0x3a, 0x78, 0xed,
-	0x1f, 0xc4, 0x70, 0x5c, 0x85, 0x7c, 0x1f, 0x5d, 0xb9, 0x94, 0x5c, 0x32, 0xbd, 0x0c, 0x3e, 0xe8,
-	0xa3, 0x2b, 0x87, 0x5c, 0x32, 0xb9, 0xa8, 0xfb, 0x4c, 0x6e, 0xe0, 0x6d, 0x3f, 0x0c, 0x48, 0x97,
-	0xc9, 0xe7, 0xcf, 0x3b, 0x65, 0x0d, 0x3f, 0x55, 0xa8, 0xa8, 0x35, 0x2a, 0xcb, 0xc8, 0x7c, 0xdc,
-	0xbc, 0x53, 0xa4, 0x46, 0x6d, 0xd9, 0xcf, 0x60, 0x75, 0x86, 0xcd, 0xfa, 0xf5, 0x9e, 0xc0, 0x82,
-	0x2a, 0x0d, 0xfd, 0x6c, 0x96, 0xfe, 0x9c, 0xf8, 0x51, 0xfc, 0xd5, 0x65, 0xa0, 0x39, 0xec, 0x3f,
-	0x67, 0xe0, 0xa3, 0xb4, 0xa4, 0xbd, 0x20, 0x10, 0x0b, 0x18, 0xfb, 0xf0, 0x21, 0x98, 0xf2, 0x6c,
-	0x6e, 0x86, 0x67, 0xc7, 0xb0, 0x7e, 0x9d, 0x3d, 0xf7, 0x70, 0xef, 0xbb, 0xc9, 0xb7, 0xdd, 0x8b,
-	0xa2, 0x9b, 0x1d, 0x33, 0xed, 0xcf, 0xa6, 0xec, 0x9f, 0x0e, 0xba, 0x14, 0x76, 0x0f, 0xab, 0xc4,
-	0x60, 0x0b, 0xd0, 0x00, 0xab, 0x5d, 0x23, 0x49, 0xd0, 0x23, 0xa8, 0xa5, 0x50, 0x2d, 0x78, 0x47,
-	0x6c, 0x1c, 0xa3, 0x2d, 0xa5, 0xb0, 0xdb, 0x68, 0x4d, 0x7e, 0x2f, 0xeb, 0x0b, 0x9a, 0x4d, 0x4c,
-	0x92, 0xef, 0x11, 0xe3, 0x98, 0x26, 0x9d, 0x39, 0x51, 0xf0, 0x05, 0xac, 0x4c, 0x12, 0xb4, 0x8e,
-	0x35, 0xc8, 0x4f, 0xb4, 0xf6, 0xd1, 0x59, 0xdc, 0x7a, 0x83, 0x7c, 0x7e, 0x44, 0x26, 0xe5, 0xdd,
-	0x78, 0x6b, 0x15, 0x1a, 0x53, 0xb7, 0x74, 0xc1, 0x59, 0x50, 0x39, 0xe5, 0x24, 0x92, 0xbe, 0x26,
-	0xa6, 0xd5, 0xa0, 0x6a, 0x60, 0x9a, 0xf1, 0x8f, 0xd0, 0x18, 0x81, 0xdf, 0xfb, 0xa1, 0xdf, 0x8f,
-	0xfb, 0xb7, 0x50, 0x6d, 0x6d, 0x81, 0x9c, 0x4b, 0x2e, 0xf7, 0xfb, 0x38, 0x59, 0xe0, 0x72, 0x4e,
-	0x41, 0x60, 0xaf, 0x14, 0x64, 0x7f, 0x09, 0xcd, 0x69, 0xc9, 0xb7, 0x88, 0x85, 0x34, 0x13, 0x51,
-	0x9e, 0xb2, 0x5d, 0xbc, 0xa6, 0x01, 0x6a, 0xe3, 0xff, 0x04, 0x0f, 0xc7, 0xe8, 0x59, 0xc8, 0xfd,
-	0x60, 0x4f, 0xb4, 0xb3, 0x0f, 0xe4, 0xc0, 0x3a, 0xfc, 0x62, 0xb6, 0x74, 0xad, 0xfd, 0x00, 0xb6,
-	0xd4, 0xb2, 0x72, 0x78, 0x25, 0x86, 0x3e, 0x0a, 0xc4, 0xa6, 0x14, 0x21, 0x8a, 0x43, 0x8e, 0xbd,
-	0xc4, 0x06, 0xb9, 0x04, 0x2b, 0xb2, 0xeb, 0x27, 0x1f, 0x14, 0x90, 0x40, 0x2f, 0x3c, 0xfb, 0x31,
-	0xd8, 0x37, 0x49, 0xd1, 0xba, 0x36, 0x61, 0x7d, 0x92, 0xeb, 0x30, 0xc0, 0x9d, 0xb1, 0x22, 0x7b,
-	0x0b, 0x36, 0xae, 0xe5, 0x18, 0x27, 0x85, 0xd8, 0x63, 0x85, 0x3b, 0xa3, 0x82, 0xf8, 0x95, 0xda,
-	0x6d, 0x35, 0xa6, 0x9f, 0xa7, 0x0e, 0xf3, 0xc8, 0xf3, 0x68, 0xb2, 0x31, 0xa8, 0x83, 0x48, 0x37,
-	0x07, 0x33, 0xb1, 0xe8, 0x8d, 0x4a, 0x23, 0x91, 0xb2, 0x06, 0xcd, 0x69, 0x92, 0xd6, 0xba, 0x03,
-	0x8d, 0xd7, 0x06, 0x2e, 0xaa, 0x7b, 0x66, 0x77, 0x58, 0xd4, 0xdd, 0xc1, 0x3e, 0x82, 0xe6, 0xf4,
-	0x85, 0x7b, 0xf5, 0xa5, 0x8f, 0x4c, 0x39, 0xe3, 0x52, 0x49, 0xd4, 0x97, 0x21, 0xab, 0x9f, 0x24,
-	0xe7, 0x64, 0x7d, 0x2f, 0x95, 0x2f, 0xd9, 0x89, 0xac, 0xdc, 0x84, 0xf5, 0xeb, 0x84, 0x69, 0x3f,
-	0x6b, 0x50, 0x7d, 0x11, 0xfa, 0x5c, 0x55, 0x7f, 0x12, 0x98, 0x4f, 0xc1, 0x32, 0xc1, 0x5b, 0xa4,
-	0xff, 0xbb, 0x0c, 0xac, 0x9f, 0x90, 0x28, 0x0e, 0xe4, 0xe2, 0xaa, 0x12, 0xe1, 0x5b, 0x12, 0x8b,
-	0x17, 0x4d, 0xec, 0xfe, 0x25, 0x2c, 0x89, 0xb4, 0x75, 0x3b, 0x14, 0x23, 0x8e, 0x3d, 0x37, 0x4c,
-	0x3e, 0xae, 0x4a, 0x02, 0xde, 0x57, 0xe8, 0x0f, 0x4c, 0xe4, 0x1e, 0xea, 0x08, 0xa1, 0xe6, 0x0c,
-	0x01, 0x05, 0xc9, 0x39, 0xf2, 0x15, 0x14, 0xfb, 0xd2, 0x32, 0x17, 0x05, 0x3e, 0x52, 0xb3, 0xa4,
-	0xb0, 0xbb, 0x3c, 0xb9, 0x8c, 0xef, 0x09, 0xa2, 0x53, 0x50, 0xac, 0xf2, 0x60, 0x7d, 0x06, 0x75,
-	0xa3, 0x43, 0x8e, 0x77, 0xd6, 0x39, 0xa9, 0xa3, 0x66, 0xd0, 0x46, 0xab, 0xeb, 0x16, 0x6c, 0x5c,
-	0xeb, 0x97, 0x0e, 0xe1, 0x5f, 0x32, 0x50, 0x11, 0xe1, 0x32, 0x4b, 0xdf, 0xfa, 0x0d, 0x2c, 0x28,
-	0x6e, 0xfd, 0xe4, 0xd7, 0x98, 0xa7, 0x99, 0xae, 0xb5, 0x2c, 0x7b, 0xad, 0x65, 0xb3, 0xe2, 0x99,
-	0x9b, 0x11, 0xcf, 0xe4, 0x85, 0xd3, 0x3d, 0x68, 0x19, 0x6a, 0x07, 0xb8, 0x4f, 0x38, 0x4e, 0x3f,
-	0xfc, 0x2e, 0xd4, 0xd3, 0xf0, 0x2d, 0x9e, 0x7e, 0x15, 0x1a, 0x67, 0xa1, 0x47, 0x66, 0x89, 0x5b,
-	0x83, 0xe6, 0x34, 0x49, 0x5b, 0xf0, 0x0d, 0x6c, 0x9c, 0x50, 0x22, 0x08, 0xd2, 0xb2, 0x37, 0x3d,
-	0x1c, 0xee, 0xa3, 0xb8, 0xdb, 0xe3, 0x67, 0xd1, 0x6d, 0xa6, 0xc8, 0xef, 0x61, 0xf3, 0xfa, 0xeb,
-	0xb7, 0xb3, 0x5a, 0x5d, 0x44, 0x4c, 0xcb, 0xf1, 0x0c, 0xab, 0xa7, 0x49, 0xda, 0xea, 0x7f, 0x66,
-	0xa0, 0x72, 0x8a, 0xd3, 0xe5, 0x72, 0xd7, 0xb7, 0x9e, 0xf1, 0x70, 0xd9, 0x59, 0x85, 0x30, 0xf5,
-	0x69, 0x35, 0x37, 0xfd, 0x69, 0x65, 0x3d, 0x81, 0xaa, 0xfc, 0xde, 0x70, 0x99, 0x68, 0xfa, 0x2e,
-	0x13, 0x86, 0xeb, 0xcf, 0x8c, 0x25, 0x49, 0x18, 0x0f, 0x03, 0x39, 0xa3, 0xf0, 0x44, 0x55, 0xdb,
-	0x2f, 0xc6, 0xde, 0x3a, 0x58, 0x0a, 0x19, 0x8f, 0x81, 0xbb, 0x39, 0x26, 0xbe, 0x1f, 0x67, 0x88,
-	0xd2, 0x7a, 0x1e, 0x83, 0x2d, 0x06, 0xab, 0xd1, 0x8d, 0xf6, 0x42, 0x4f, 0x34, 0xf1, 0xd4, 0xa6,
-	0xf3, 0x1a, 0x1e, 0xdd, 0xc8, 0x75, 0xdf, 0xcd, 0x67, 0x19, 0x6a, 0x66, 0xba, 0x18, 0xf9, 0x9e,
-	0x86, 0x6f, 0x91, 0x39, 0xa7, 0x50, 0x7a, 0x8a, 0x3a, 0x17, 0xf1, 0x28, 0x4d, 0x37, 0xa1, 0xd0,
-	0x21, 0x61, 0x27, 0xa6, 0x14, 0x87, 0x9d, 0xa1, 0x6e, 0x6a, 0x26, 0x24, 0x38, 0xe4, 0x27, 0x9f,
-	0x0a, 0xbd, 0xfe, 0x4e, 0x34, 0x21, 0xfb, 0x4b, 0x28, 0x27, 0x42, 0xb5, 0x09, 0x8f, 0x61, 0x1e,
-	0x0f, 0xc6, 0xa1, 0x2f, 0xb7, 0x92, 0x7f, 0x7a, 0x1c, 0x0a, 0xd4, 0x51, 0x44, 0x3d, 0xc2, 0x38,
-	0xa1, 0xf8, 0x88, 0x92, 0x7e, 0xca, 0x2e, 0x7b, 0x0f, 0x56, 0x67, 0xd0, 0xee, 0x22, 0xfe, 0xe9,
-	0xa7, 0x3f, 0xb5, 0x06, 0x3e, 0xc7, 0x8c, 0xb5, 0x7c, 0xb2, 0xa3, 0x7e, 0xed, 0x74, 0xc9, 0xce,
-	0x80, 0xef, 0xc8, 0x7f, 0xbd, 0xec, 0x4c, 0x7d, 0xab, 0xb5, 0x17, 0x24, 0xe1, 0xf3, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x05, 0x80, 0x1f, 0x06, 0x04, 0x1a, 0x00, 0x00,
+	// 3054 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x5a, 0x4b, 0x73, 0xdc, 0xc6,
+	0x11, 0xae, 0xe5, 0xf2, 0xb1, 0xec, 0x25, 0x97, 0x24, 0x48, 0x91, 0x2b, 0xca, 0x96, 0x25, 0x58,
+	0x7e, 0xc4, 0x49, 0x48, 0x9b, 0xb6, 0x62, 0xcb, 0x29, 0xbb, 0x42, 0xf1, 0x61, 0xd3, 0xd6, 0x83,
+	0x86, 0x44, 0x39, 0x71, 0x25, 0x85, 0x80, 0x8b, 0xe1, 0x12, 0x25, 0x2c, 0xb0, 0x06, 0xb0, 0x14,
+	0x99, 0x53, 0x7e, 0x41, 0x7e, 0x41, 0x6e, 0xae, 0x4a, 0xee, 0x39, 0xe6, 0x9c, 0xdf, 0x60, 0x5f,
+	0xf2, 0x3f, 0x72, 0xc8, 0x21, 0xe9, 0x9e, 0xe9, 0x01, 0x06, 0xbb, 0x20, 0x4d, 0xa9, 0x94, 0xaa,
+	0x5c, 0x58, 0x98, 0xaf, 0x7b, 0x66, 0x7a, 0xfa, 0x3d, 0xb3, 0x84, 0x95, 0xcc, 0x3b, 0x0c, 0x45,
+	0xd6, 0xf3, 0x22, 0xaf, 0x2b, 0x12, 0xdf, 0xcb, 0xbc, 0xb5, 0x7e, 0x12, 0x67, 0xb1, 0xb5, 0x30,
+	0x42, 0x58, 0x6d, 0x7e, 0x3b, 0x10, 0xc9, 0x99, 0xa2, 0xaf, 0xb6, 0xb2, 0xb8, 0x1f, 0x17, 0xfc,
+	0xab, 0x57, 0x12, 0xd1, 0x0f, 0x83, 0x8e, 0x97, 0x05, 0x71, 0x64, 0xc0, 0xb3, 0x61, 0xdc, 0x1d,
+	0x64, 0x41, 0xa8, 0x86, 0xf6, 0x7f, 0x6a, 0x30, 0xf7, 0x98, 0x16, 0xde, 0x16, 0x47, 0x41, 0x14,
+	0x10, 0xb3, 0x65, 0xc1, 0x78, 0xe4, 0xf5, 0x44, 0xbb, 0x76, 0xa3, 0xf6, 0xf6, 0xb4, 0x23, 0xbf,
+	0xad, 0x65, 0x98, 0x4c, 0x3b, 0xc7, 0xa2, 0xe7, 0xb5, 0xc7, 0x24, 0xca, 0x23, 0xab, 0x0d, 0x53,
+	0x9d, 0x38, 0x1c, 0xf4, 0xa2, 0xb4, 0x5d, 0xbf, 0x51, 0x47, 0x82, 0x1e, 0x5a, 0x6b, 0xb0, 0xd8,
+	0x4f, 0x82, 0x9e, 0x97, 0x9c, 0xb9, 0x4f, 0xc5, 0x99, 0xab, 0xb9, 0xc6, 0x25, 0xd7, 0x02, 0x93,
+	0xbe, 0x14, 0x67, 0x5b, 0xcc, 0x8f, 0xbb, 0x66, 0x67, 0x7d, 0xd1, 0x9e, 0x50, 0xbb, 0xd2, 0xb7,
+	0xf5, 0x1a, 0x34, 0x49, 0x74, 0x37, 0x14, 0x51, 0x37, 0x3b, 0x6e, 0x4f, 0x22, 0x69, 0xdc, 0x01,
+	0x82, 0xee, 0x49, 0xc4, 0xba, 0x06, 0xd3, 0x49, 0xfc, 0x0c, 0x17, 0x1f, 0x44, 0x59, 0x7b, 0x4a,
+	0x92, 0x1b, 0x08, 0x6c, 0xd1, 0xd8, 0xba, 0x05, 0x93, 0x47, 0x81, 0x08, 0xfd, 0xb4, 0xdd, 0xc0,
+	0x4d, 0x9b, 0x1b, 0x33, 0x6b, 0x4a, 0x5f, 0xbb, 0x04, 0x3a, 0x4c, 0xb3, 0xff, 0x52, 0x83, 0xf9,
+	0x47, 0xf2, 0x30, 0x86, 0x0a, 0xde, 0x82, 0x39, 0xda, 0xe5, 0xd0, 0x4b, 0x85, 0xcb, 0xe7, 0x56,
+	0xda, 0x68, 0x69, 0x58, 0x4d, 0xb1, 0x1e, 0x82, 0xb2, 0x8b, 0xeb, 0xe7, 0x93, 0x53, 0x54, 0x11,
+	0x6d, 0x67, 0xaf, 0x8d, 0x9a, 0x72, 0x48, 0xd5, 0xce, 0x7c, 0x56, 0x06, 0x52, 0x52, 0xe8, 0x89,
+	0x48, 0x52, 0xfc, 0x46, 0x85, 0xd2, 0x8e, 0x7a, 0x48, 0x82, 0x5a, 0x6a, 0xd7, 0xad, 0x63, 0x2f,
+	0xea, 0x0a, 0x47, 0xa4, 0x83, 0x30, 0xb3, 0x3e, 0x87, 0xd9, 0x43, 0x71, 0x14, 0x27, 0x25, 0x41,
+	0x9b, 0x1b, 0xaf, 0x57, 0xec, 0x3e, 0x7c, 0x4c, 0x67, 0x46, 0xcd, 0xe4, 0xb3, 0xec, 0xc2, 0x8c,
+	0x77, 0x94, 0x89, 0xc4, 0x35, 0x2c, 0x7d, 0xc9, 0x85, 0x9a, 0x72, 0xa2, 0x82, 0xed, 0x7f, 0xd5,
+	0xa0, 0x75, 0x90, 0x8a, 0x64, 0x5f, 0x24, 0xbd, 0x20, 0x4d, 0xd9, 0xa5, 0x8e, 0xe3, 0x34, 0xd3,
+	0x2e, 0x45, 0xdf, 0x84, 0x0d, 0x90, 0x8b, 0x1d, 0x4a, 0x7e, 0x5b, 0x3f, 0x85, 0x85, 0xbe, 0x97,
+	0xa6, 0xcf, 0xe2, 0xc4, 0x77, 0x71, 0xb1, 0xce, 0xd3, 0x74, 0xd0, 0x93, 0x7a, 0x18, 0x77, 0xe6,
+	0x35, 0x61, 0x8b, 0x71, 0xeb, 0x2b, 0x00, 0x74, 0xa3, 0x93, 0x20, 0x14, 0x5d, 0xa1, 0x1c, 0xab,
+	0xb9, 0xf1, 0x5e, 0x85, 0xb4, 0x65, 0x59, 0xd6, 0xf6, 0xf3, 0x39, 0x3b, 0x51, 0x96, 0x9c, 0x39,
+	0xc6, 0x22, 0xab, 0x9f, 0xc0, 0xdc, 0x10, 0xd9, 0x9a, 0x87, 0x3a, 0xfa, 0x2f, 0x4b, 0x4e, 0x9f,
+	0xd6, 0x12, 0x4c, 0x9c, 0x78, 0xe1, 0x40, 0xb0, 0xe4, 0x6a, 0xf0, 0xf1, 0xd8, 0x47, 0x35, 0xfb,
+	0xfb, 0x1a, 0xcc, 0x6c, 0x1f, 0xfe, 0xc8, 0xb9, 0x5b, 0x30, 0xe6, 0x1f, 0xf2, 0x5c, 0xfc, 0xca,
+	0xf5, 0x50, 0x37, 0xf4, 0xf0, 0xb0, 0xe2, 0x68, 0xeb, 0x15, 0x47, 0x33, 0x37, 0xfb, 0x5f, 0x1e,
+	0xec, 0xbb, 0x1a, 0x34, 0x8b, 0x9d, 0x52, 0xeb, 0x1e, 0xcc, 0x93, 0x9c, 0x6e, 0xbf, 0xc0, 0x70,
+	0x21, 0x92, 0xf2, 0xe6, 0x8f, 0x1a, 0xc0, 0x99, 0x1b, 0x94, 0xc6, 0x29, 0x3a, 0x5e, 0xcb, 0x3f,
+	0x2c, 0xad, 0xa5, 0x22, 0xe8, 0xb5, 0x1f, 0x39, 0xb1, 0x33, 0xeb, 0x1b, 0xa3, 0xd4, 0x7e, 0x0b,
+	0x85, 0x0c, 0xa2, 0xae, 0x23, 0x30, 0xce, 0x51, 0xd1, 0x18, 0x4a, 0x7d, 0xef, 0x2c, 0x8c, 0x3d,
+	0x9f, 0x0f, 0xa9, 0x87, 0xf6, 0xdb, 0x30, 0xa3, 0x18, 0xd3, 0x3e, 0xce, 0x13, 0x17, 0x70, 0xbe,
+	0x03, 0x33, 0x8f, 0x42, 0x21, 0xfa, 0x7a, 0xcd, 0x55, 0x68, 0xf8, 0x83, 0x44, 0x26, 0x55, 0xc9,
+	0x5a, 0x77, 0xf2, 0xb1, 0x3d, 0x07, 0xb3, 0xcc, 0xab, 0x96, 0xb5, 0x7f, 0xc0, 0x88, 0xdd, 0x39,
+	0x15, 0x9d, 0x41, 0x26, 0x3e, 0x8f, 0xe3, 0xa7, 0x7a, 0x8d, 0xaa, 0xfc, 0x7a, 0x1d, 0x0d, 0xee,
+	0x25, 0xf8, 0x85, 0x61, 0xa4, 0x8e, 0x3f, 0xed, 0x18, 0x88, 0xb5, 0x0f, 0xd3, 0xe2, 0x34, 0x4b,
+	0x3c, 0x57, 0x44, 0x27, 0x32, 0xd3, 0x36, 0x37, 0xde, 0xaf, 0xd0, 0xce, 0xe8, 0x6e, 0x08, 0xe1,
+	0xb4, 0x9d, 0xe8, 0x44, 0xf9, 0x44, 0x43, 0xf0, 0x70, 0xf5, 0x97, 0x30, 0x5b, 0x22, 0x3d, 0x97,
+	0x3f, 0x1c, 0xc1, 0x62, 0x69, 0x2b, 0xd6, 0x23, 0xe6, 0x6b, 0x71, 0x1a, 0x64, 0x6e, 0x9a, 0x79,
+	0xd9, 0x20, 0x65, 0x05, 0x01, 0x41, 0x8f, 0x24, 0x22, 0xcb, 0x48, 0xe6, 0xc7, 0x83, 0x2c, 0x2f,
+	0x23, 0x72, 0xc4, 0xb8, 0x48, 0x74, 0x14, 0xf0, 0xc8, 0x3e, 0x81, 0xf9, 0xcf, 0x44, 0xa6, 0xf2,
+	0x8a, 0x56, 0x1f, 0xf2, 0xca, 0x83, 0x2b, 0x8f, 0x43, 0x5e, 0x35, 0xb2, 0x5e, 0x87, 0xd9, 0x20,
+	0xea, 0x84, 0x03, 0x5f, 0xb8, 0x27, 0x81, 0x78, 0x96, 0xca, 0x2d, 0x1a, 0xce, 0x0c, 0x83, 0x4f,
+	0x08, 0xb3, 0xde, 0x80, 0x96, 0x38, 0x55, 0x4c, 0xbc, 0x88, 0x2a, 0x5b, 0xb3, 0x8c, 0xca, 0x04,
+	0x9d, 0xda, 0x02, 0x16, 0x8c, 0x7d, 0xf9, 0x74, 0xfb, 0xb0, 0xa0, 0x32, 0xa3, 0x91, 0xec, 0x9f,
+	0x27, 0xdb, 0xce, 0xa7, 0x43, 0x88, 0xbd, 0x02, 0x57, 0x70, 0x1b, 0xc3, 0x85, 0xf9, 0x8c, 0xf6,
+	0x37, 0xb0, 0x3c, 0x4c, 0x60, 0x21, 0x7e, 0x05, 0xcd, 0x72, 0xd0, 0xd1, 0xf6, 0xd7, 0x2b, 0xb6,
+	0x37, 0x27, 0x9b, 0x53, 0xec, 0x25, 0x2c, 0x23, 0x22, 0x73, 0x84, 0xe7, 0x3f, 0x8c, 0xc2, 0x33,
+	0xbd, 0xe3, 0x15, 0x58, 0x2c, 0xa1, 0xec, 0xc2, 0x05, 0xfc, 0x75, 0x12, 0x64, 0x42, 0x73, 0x2f,
+	0xc3, 0x52, 0x19, 0x66, 0xf6, 0x2f, 0x60, 0x41, 0x15, 0xa7, 0xc7, 0x58, 0xbe, 0xb5, 0xc1, 0x6e,
+	0x43, 0x53, 0x89, 0xe7, 0xca, 0x02, 0x4f, 0x22, 0xb7, 0x36, 0x96, 0xd6, 0xf2, 0x7e, 0x45, 0xea,
+	0x3c, 0x93, 0x33, 0x20, 0xcb, 0xbf, 0x49, 0x4e, 0x73, 0xad, 0x42, 0x20, 0x47, 0x1c, 0x25, 0x22,
+	0x3d, 0x26, 0x97, 0x32, 0x05, 0x2a, 0xc3, 0xcc, 0x8e, 0x1a, 0x76, 0x06, 0xd1, 0xe7, 0xc2, 0x0b,
+	0xb3, 0x63, 0x59, 0x38, 0xf4, 0x84, 0x36, 0x2c, 0x0f, 0x13, 0x78, 0xca, 0x07, 0xd0, 0xde, 0xeb,
+	0x46, 0x58, 0x16, 0x15, 0x71, 0x27, 0x49, 0xe2, 0xa4, 0x94, 0x52, 0x32, 0x8c, 0xc8, 0xa8, 0x48,
+	0x14, 0x72, 0x68, 0x5f, 0x83, 0xab, 0x15, 0xb3, 0x78, 0xc9, 0x8f, 0x49, 0x68, 0xca, 0x27, 0x65,
+	0x4f, 0x46, 0x8f, 0x7d, 0xe6, 0x61, 0xb8, 0xf4, 0xe3, 0xb4, 0x70, 0xa6, 0x69, 0x67, 0x86, 0xc0,
+	0x7d, 0xc6, 0xd4, 0xc9, 0xcc, 0xb9, 0xbc, 0xe6, 0x06, 0x2c, 0xef, 0x27, 0xe2, 0x28, 0x0c, 0xba,
+	0xc7, 0x43, 0x01, 0x42, 0x3d, 0x99, 0x54, 0x9c, 0x8e, 0x10, 0x3d, 0xb4, 0xbb, 0xb0, 0x32, 0x32,
+	0x87, 0xfd, 0xea, 0x1e, 0xb4, 0x14, 0x97, 0x9b, 0xc8, 0xbe, 0x42, 0xe7, 0xf3, 0x37, 0xce, 0xf5,
+	0x6c, 0xb3, 0x0b, 0x71, 0x66, 0x3b, 0xc6, 0x28, 0xb5, 0xff, 0x8d, 0x99, 0x6f, 0xb3, 0xdf, 0x0f,
+	0xcf, 0xca, 0x92, 0x61, 0x8a, 0x49, 0xbf, 0x0d, 0x75, 0x8a, 0xc1, 0x4f, 0x4a, 0x31, 0xd8, 0x81,
+	0x74, 0x04, 0x07, 0xab, 0x1a, 0x50, 0x1b, 0xe0, 0x85, 0x21, 0x36, 0x76, 0x46, 0x0f, 0x2b, 0x33,
+	0x43, 0xc3, 0x99, 0x97, 0x04, 0xa7, 0xc0, 0x47, 0x1b, 0xa0, 0xf1, 0x97, 0xd5, 0x00, 0x4d, 0xbc,
+	0x60, 0x03, 0xf4, 0xd7, 0x1a, 0x2c, 0x96, 0x4e, 0xcf, 0x3a, 0xfe, 0xff, 0x6b, 0xd5, 0x16, 0x61,
+	0xe1, 0x5e, 0xdc, 0x79, 0xaa, 0xb2, 0x9e, 0x0e, 0x0d, 0x0c, 0x3c, 0x13, 0x2c, 0x02, 0xef, 0x20,
+	0x0a, 0x47, 0x98, 0xd1, 0x3d, 0xcb, 0x30, 0xb3, 0xff, 0xad, 0x06, 0x6d, 0x2e, 0x11, 0xbb, 0x22,
+	0xeb, 0x1c, 0x6f, 0xa6, 0xdb, 0x87, 0xb9, 0x1f, 0xa0, 0xd5, 0x65, 0x2b, 0x2e, 0x15, 0x30, 0xe3,
+	0xa8, 0x81, 0xb5, 0x02, 0x53, 0xd8, 0x06, 0xc8, 0xd2, 0xc8, 0xd5, 0xc1, 0x3f, 0x7c, 0x40, 0xc5,
+	0xf1, 0x2a, 0x34, 0x7a, 0xde, 0xa9, 0x8b, 0x8d, 0x7d, 0xca, 0xcd, 0xe0, 0x14, 0x8e, 0x1d, 0x1c,
+	0xca, 0x46, 0x3d, 0x48, 0x65, 0x07, 0x7e, 0x18, 0xa0, 0x1c, 0xdd, 0x54, 0x9a, 0xbf, 0x81, 0x8d,
+	0xba, 0x82, 0xef, 0x2a, 0x94, 0x62, 0x2d, 0x91, 0x61, 0x64, 0x1a, 0x17, 0xab, 0x43, 0x62, 0xc4,
+	0x96, 0xfd, 0x19, 0x5c, 0xad, 0x90, 0x99, 0xad, 0xf7, 0x0e, 0x4c, 0xaa, 0xd0, 0x60, 0xb3, 0x59,
+	0x7c, 0x9d, 0xf8, 0x8a, 0xfe, 0x72, 0x18, 0x30, 0x87, 0xfd, 0xa7, 0x1a, 0xbc, 0x5a, 0x5e, 0x69,
+	0x33, 0x0c, 0xa9, 0x01, 0x4b, 0x5f, 0xbe, 0x0a, 0x46, 0x4e, 0x36, 0x5e, 0x71, 0xb2, 0x7b, 0x70,
+	0xfd, 0x3c, 0x79, 0x5e, 0xe0, 0x78, 0x5f, 0x0e, 0xdb, 0x16, 0xbd, 0xfd, 0xe2, 0x83, 0x99, 0xf2,
+	0x8f, 0x95, 0xe4, 0x1f, 0x55, 0xba, 0x5c, 0xec, 0x05, 0xa4, 0xa2, 0xc2, 0x16, 0x7a, 0x27, 0x42,
+	0xf5, 0x1a, 0xda, 0x41, 0x77, 0xb1, 0x82, 0x99, 0x28, 0x2f, 0xbc, 0x4e, 0x1d, 0x47, 0xde, 0xa5,
+	0x34, 0x37, 0x56, 0xd6, 0x86, 0xef, 0xcb, 0x3c, 0x81, 0xd9, 0xa8, 0x92, 0xdc, 0xf7, 0x52, 0x0c,
+	0x1d, 0x9d, 0x99, 0xf5, 0x06, 0x1f, 0xc0, 0xf2, 0x30, 0x81, 0xf7, 0xc0, 0x66, 0x71, 0x28, 0xb5,
+	0xe7, 0x63, 0x9a, 0xf5, 0x35, 0xa6, 0xf9, 0xdd, 0x78, 0x78, 0xbd, 0x0b, 0x67, 0x5d, 0x85, 0x95,
+	0x91, 0x59, 0x1c, 0x70, 0x16, 0x5e, 0x63, 0xb1, 0xa4, 0xca, 0xb3, 0x6a, 0xd1, 0x30, 0xbc, 0x0d,
+	0x8c, 0x19, 0x7f, 0x0d, 0x2b, 0x39, 0x78, 0x1f, 0xb3, 0x42, 0x6f, 0xd0, 0xbb, 0xc4, 0xd6, 0xd6,
+	0x4d, 0x90, 0x75, 0xc9, 0xcd, 0x82, 0x9e, 0xd0, 0x0d, 0x5c, 0xdd, 0x69, 0x12, 0xf6, 0x58, 0x41,
+	0xf6, 0x2f, 0xa0, 0x3d, 0xba, 0xf2, 0x25, 0x74, 0x21, 0xc5, 0xf4, 0x92, 0xac, 0x24, 0x3b, 0x59,
+	0xd3, 0x00, 0x59, 0xf8, 0xdf, 0xc2, 0xb5, 0x02, 0x3d, 0x88, 0xb2, 0x20, 0xdc, 0xa4, 0x74, 0xf6,
+	0x92, 0x0e, 0x70, 0x1d, 0x5e, 0xa9, 0x5e, 0x9d, 0x77, 0xdf, 0x86, 0x9b, 0xaa, 0x59, 0xc1, 0xce,
+	0x19, 0x8b, 0x3e, 0x96, 0x22, 0xf4, 0x41, 0xec, 0xd2, 0x45, 0x94, 0x09, 0x5f, 0xcb, 0x20, 0x9b,
+	0x60, 0x45, 0x76, 0x03, 0x7d, 0xa1, 0x00, 0x0d, 0xed, 0xf9, 0xf6, 0x2d, 0xb0, 0x2f, 0x5a, 0x85,
+	0xf7, 0xba, 0x01, 0xd7, 0x87, 0xb9, 0x76, 0x42, 0xd1, 0x29, 0x36, 0xb2, 0x6f, 0xc2, 0x6b, 0xe7,
+	0x72, 0x14, 0x4e, 0x41, 0x7d, 0x2c, 0x1d, 0x27, 0x0f, 0x88, 0x9f, 0xa8, 0xde, 0x96, 0x31, 0x36,
+	0x0f, 0x46, 0xad, 0xe7, 0xfb, 0x89, 0xee, 0x18, 0xd4, 0x80, 0xdc, 0x0d, 0x39, 0xa8, 0xd1, 0xcb,
+	0x43, 0x43, 0xaf, 0xb2, 0x0a, 0xed, 0x51, 0x12, 0xef, 0xba, 0x0e, 0x2b, 0x4f, 0x0c, 0x9c, 0xa2,
+	0xbb, 0x32, 0x3b, 0x4c, 0x73, 0x76, 0xc0, 0x18, 0x6d, 0x8f, 0x4e, 0x78, 0xa1, 0xbc, 0xf4, 0xaa,
+	0xb9, 0x4e, 0x11, 0x2a, 0x7a, 0x7b, 0xbc, 0x7b, 0xb3, 0x49, 0xea, 0x0e, 0x7e, 0x95, 0xfc, 0x65,
+	0x6c, 0xc8, 0x2b, 0xd1, 0x00, 0xe7, 0x2d, 0xc6, 0xe7, 0x44, 0xbf, 0xdd, 0xc3, 0xaa, 0xaa, 0xa2,
+	0x5f, 0x2b, 0xe6, 0x5d, 0xb0, 0x4c, 0xf0, 0x12, 0xee, 0xff, 0x7d, 0x0d, 0xae, 0xef, 0xc7, 0xfd,
+	0x41, 0x28, 0x1b, 0x57, 0xe5, 0x08, 0x5f, 0xc4, 0x03, 0xb2, 0xa8, 0x96, 0xfb, 0x4d, 0x98, 0x23,
+	0xb7, 0x75, 0x3b, 0x89, 0x40, 0x26, 0xdf, 0x8d, 0xf4, 0xe5, 0x6a, 0x96, 0xe0, 0x2d, 0x85, 0x3e,
+	0x48, 0xc9, 0xf7, 0xbc, 0x0e, 0x2d, 0x6a, 0xd6, 0x10, 0x50, 0x90, 0xac, 0x23, 0x1f, 0xc1, 0x4c,
+	0x4f, 0x4a, 0xe6, 0x7a, 0x61, 0xe0, 0xa9, 0x5a, 0xd2, 0xdc, 0xb8, 0x32, 0xdc, 0x8c, 0x6f, 0x12,
+	0xd1, 0x69, 0x2a, 0x56, 0x39, 0xb0, 0xde, 0x83, 0x25, 0x23, 0x43, 0x16, 0x3d, 0xeb, 0xb8, 0xdc,
+	0x63, 0xd1, 0xa0, 0xe5, 0xad, 0x2b, 0x3a, 0xe8, 0xb9, 0xe7, 0x62, 0x15, 0xfe, 0xb9, 0x06, 0xf3,
+	0xa4, 0x2e, 0x33, 0xf4, 0xad, 0x9f, 0xc3, 0xa4, 0xe2, 0x66, 0x93, 0x9f, 0x23, 0x1e, 0x33, 0x9d,
+	0x2b, 0xd9, 0xd8, 0xb9, 0x92, 0x55, 0xe9, 0xb3, 0x5e, 0xa1, 0x4f, 0x6d, 0xe1, 0x72, 0x0e, 0xc2,
+	0x4e, 0x68, 0x5b, 0xf4, 0xe2, 0x4c, 0x94, 0x0d, 0xbf, 0x01, 0x4b, 0x65, 0xf8, 0x12, 0xa6, 0xc7,
+	0x00, 0x3b, 0x88, 0xfc, 0xb8, 0x6a, 0x39, 0x0c, 0xb0, 0x51, 0x12, 0x4b, 0xf0, 0x09, 0x2a, 0x36,
+	0x89, 0x89, 0x20, 0x25, 0xfb, 0xfa, 0x58, 0x44, 0x5b, 0xde, 0x00, 0x9b, 0xfa, 0x83, 0xfe, 0x65,
+	0xaa, 0xc8, 0xa7, 0x70, 0xe3, 0xfc, 0xe9, 0x97, 0x93, 0x5a, 0x4d, 0xf4, 0x52, 0x5e, 0xc7, 0x37,
+	0xa4, 0x1e, 0x25, 0xb1, 0xd4, 0x7f, 0xa7, 0x97, 0x56, 0x51, 0x0e, 0x97, 0xe7, 0xb5, 0x75, 0x85,
+	0xe1, 0xc6, 0xaa, 0x02, 0x61, 0xe4, 0x6a, 0x35, 0x3e, 0x7a, 0xb5, 0xc2, 0xd4, 0xb2, 0x20, 0xef,
+	0x1b, 0xf4, 0x5e, 0x91, 0x64, 0x6e, 0x4a, 0x82, 0xf3, 0x35, 0x63, 0x4e, 0x12, 0x8a, 0x62, 0x20,
+	0x6b, 0x94, 0x18, 0x8a, 0x6a, 0x7b, 0xaf, 0x38, 0x2d, 0x62, 0xc4, 0x5c, 0x94, 0x81, 0xe7, 0x3b,
+	0x18, 0xdd, 0x1f, 0x2b, 0x96, 0xe2, 0x7d, 0xb0, 0x62, 0x50, 0x61, 0x35, 0xb2, 0xd1, 0x66, 0xe4,
+	0x53, 0x12, 0x2f, 0x75, 0x3a, 0x4f, 0xe0, 0xf5, 0x0b, 0xb9, 0x5e, 0xb4, 0xf3, 0x41, 0x7f, 0x37,
+	0xdd, 0xc5, 0xf0, 0xf7, 0x32, 0x7c, 0x09, 0xcf, 0x79, 0x04, 0xb3, 0x77, 0xbd, 0xce, 0xd3, 0x41,
+	0xee, 0xa6, 0x37, 0xa0, 0xd9, 0x89, 0xa3, 0xce, 0x20, 0x41, 0x25, 0x74, 0xce, 0x38, 0xa9, 0x99,
+	0x10, 0x71, 0xc8, 0x2b, 0x9f, 0x52, 0x3d, 0xdf, 0x13, 0x4d, 0x08, 0xdb, 0x8e, 0x96, 0x5e, 0x94,
+	0x45, 0xb8, 0x05, 0x13, 0xe2, 0xa4, 0x50, 0x7d, 0x6b, 0x4d, 0xff, 0xe8, 0xb1, 0x43, 0xa8, 0xa3,
+	0x88, 0x5c, 0xc2, 0x32, 0xbc, 0x55, 0xed, 0xe2, 0x39, 0x4a, 0x72, 0xd9, 0x9b, 0x70, 0xb5, 0x82,
+	0xf6, 0x5c, 0xcb, 0xff, 0x63, 0x0c, 0x1a, 0xfb, 0x71, 0x1c, 0xee, 0x45, 0x47, 0x71, 0xe5, 0x9b,
+	0x1f, 0x2a, 0xaa, 0xe3, 0xf5, 0xbd, 0x4e, 0x90, 0x9d, 0xb1, 0x13, 0xe7, 0x63, 0x6a, 0x56, 0xa8,
+	0x5f, 0xce, 0xe9, 0x2a, 0x3b, 0x61, 0x42, 0x3e, 0xdd, 0xd2, 0x2c, 0x18, 0x0a, 0x81, 0x8f, 0xf7,
+	0x1e, 0xee, 0x67, 0xdc, 0x9e, 0xba, 0xfa, 0x60, 0x28, 0x10, 0xcc, 0x2d, 0xcd, 0xfd, 0x14, 0xed,
+	0xbd, 0xd8, 0xc7, 0x4b, 0x7f, 0x10, 0x86, 0x2e, 0x3d, 0x28, 0x86, 0xa1, 0x08, 0x83, 0xb4, 0x27,
+	0xef, 0x3f, 0x75, 0xc7, 0x62, 0xd2, 0x7e, 0x41, 0xb1, 0x5e, 0x81, 0x69, 0xef, 0xc4, 0x0b, 0x42,
+	0xf2, 0x52, 0xf9, 0x9b, 0x4b, 0xdd, 0x29, 0x00, 0x7a, 0x7e, 0xa3, 0x7a, 0x82, 0x91, 0x32, 0x25,
+	0x49, 0x3c, 0xb2, 0xae, 0xc0, 0x64, 0x10, 0xb9, 0x83, 0x54, 0xb4, 0x1b, 0x12, 0x9f, 0x08, 0xa2,
+	0x03, 0xd4, 0xd5, 0xab, 0x00, 0x32, 0x10, 0xd5, 0x4f, 0x34, 0xd3, 0x6a, 0x35, 0x42, 0xd4, 0x6f,
+	0x34, 0x37, 0x8c, 0xa6, 0x8c, 0x4e, 0x00, 0xea, 0xc9, 0x50, 0x37, 0x65, 0xf7, 0x53, 0x7b, 0x01,
+	0xe6, 0xe8, 0x29, 0x0c, 0x15, 0x99, 0x3b, 0xfa, 0x8e, 0xec, 0x6a, 0x18, 0x62, 0x9b, 0xbc, 0x07,
+	0x13, 0x7d, 0x02, 0xf8, 0xd9, 0xe2, 0x5a, 0xd5, 0x8b, 0x18, 0x1b, 0xc3, 0x51, 0x9c, 0x54, 0x7b,
+	0x16, 0x70, 0x7e, 0xf0, 0x07, 0x41, 0x94, 0x8b, 0x5e, 0x67, 0x2f, 0xb2, 0x54, 0x85, 0x19, 0xea,
+	0xcf, 0x61, 0x86, 0xf1, 0xf3, 0xcc, 0x80, 0xa7, 0xb4, 0x4c, 0xe9, 0xf2, 0xe8, 0x1d, 0x27, 0xe9,
+	0xd9, 0xf5, 0x2e, 0x3c, 0xa6, 0x64, 0xb4, 0xbf, 0xab, 0x43, 0x83, 0xae, 0xf3, 0xd4, 0xbf, 0x50,
+	0xc6, 0x23, 0xd5, 0x06, 0x51, 0xd7, 0xcd, 0x8e, 0x31, 0x59, 0xfa, 0x6e, 0xde, 0x0e, 0xcd, 0x31,
+	0xe1, 0xb1, 0xc4, 0xf7, 0x7c, 0x9d, 0x42, 0x89, 0x57, 0xb5, 0x6c, 0x63, 0x45, 0x0a, 0x45, 0x50,
+	0xf6, 0x5f, 0x74, 0x2a, 0xcd, 0x74, 0x84, 0x7f, 0x44, 0xd2, 0x4f, 0x02, 0xb4, 0xb3, 0x7a, 0xc5,
+	0xb5, 0x98, 0xb4, 0x5b, 0x50, 0xb4, 0x04, 0xca, 0xe0, 0xa9, 0xc0, 0x48, 0xf7, 0xb5, 0xdf, 0xce,
+	0x69, 0xab, 0x3f, 0x52, 0xb0, 0xf5, 0x33, 0xb0, 0x0e, 0xe9, 0x69, 0xa1, 0x2c, 0xae, 0x72, 0xdc,
+	0x79, 0x4d, 0xc9, 0xe5, 0x7d, 0x03, 0x5a, 0x39, 0xb7, 0x12, 0x78, 0x52, 0x4a, 0x31, 0xab, 0x51,
+	0x25, 0x31, 0x76, 0x0b, 0x39, 0x9b, 0x29, 0xf2, 0x94, 0xea, 0x16, 0x34, 0xcd, 0x94, 0x19, 0x83,
+	0x91, 0x50, 0x2c, 0x37, 0x52, 0xdb, 0xd2, 0xc1, 0xa7, 0x9d, 0xa6, 0xc2, 0x64, 0x32, 0x37, 0x58,
+	0x82, 0xc8, 0x17, 0xa7, 0xd2, 0xd1, 0x73, 0x96, 0x3d, 0x82, 0xe8, 0xb7, 0x4a, 0x1a, 0xba, 0xbd,
+	0xd8, 0x17, 0xd2, 0xcf, 0x31, 0x31, 0x12, 0x70, 0x1f, 0xc7, 0xf6, 0x3f, 0x6b, 0x30, 0x47, 0x56,
+	0xda, 0x0e, 0xbc, 0x6e, 0x14, 0xa7, 0x59, 0xd0, 0x49, 0x69, 0x4d, 0xf4, 0xb2, 0x6c, 0x90, 0x28,
+	0xe7, 0xca, 0x93, 0xa3, 0xc2, 0x48, 0x51, 0x32, 0x54, 0x07, 0x59, 0xdc, 0xc3, 0xdc, 0xdd, 0xe1,
+	0xd4, 0x58, 0x00, 0xd6, 0xc7, 0x00, 0x72, 0x47, 0xd2, 0x6b, 0xca, 0xbf, 0x1a, 0x54, 0x79, 0x8c,
+	0x76, 0x0f, 0x47, 0x0a, 0x48, 0x5f, 0xf2, 0x61, 0x85, 0xfa, 0xb6, 0x34, 0x73, 0x7d, 0x54, 0x2f,
+	0xe1, 0x5c, 0x42, 0x5b, 0x0a, 0xde, 0x66, 0x54, 0x3d, 0xbb, 0x47, 0xb1, 0x7f, 0xa8, 0x5f, 0xfd,
+	0xd5, 0x0f, 0xb8, 0x33, 0x0a, 0x54, 0xa5, 0xc4, 0xbe, 0x0b, 0x57, 0x31, 0x62, 0x87, 0x0e, 0xa8,
+	0x23, 0x0e, 0x0d, 0x17, 0x62, 0x26, 0x77, 0x8b, 0x93, 0xd4, 0xe4, 0x49, 0x66, 0x09, 0xdd, 0xd4,
+	0xa0, 0x7d, 0x08, 0xab, 0x55, 0x6b, 0x70, 0x5c, 0x6c, 0x43, 0xd3, 0x2f, 0x60, 0x0e, 0x0f, 0xfb,
+	0x9c, 0xc3, 0x9a, 0x0b, 0x98, 0xd3, 0xec, 0x0f, 0xa1, 0xe9, 0x60, 0x5a, 0x42, 0xd5, 0xee, 0x86,
+	0x5e, 0xb7, 0x32, 0x17, 0x54, 0xfe, 0x28, 0x62, 0x7b, 0x98, 0x4a, 0x8a, 0x89, 0xea, 0x69, 0xb4,
+	0x72, 0x3a, 0x7a, 0x41, 0x1c, 0xfa, 0xae, 0xb9, 0x44, 0x03, 0x81, 0x27, 0x34, 0x26, 0x62, 0x24,
+	0x9e, 0x31, 0x51, 0xc5, 0x50, 0x03, 0x01, 0x49, 0xb4, 0x1f, 0xc0, 0x32, 0xbd, 0xb9, 0x17, 0xbb,
+	0xe4, 0x0a, 0xfc, 0x00, 0x26, 0x8e, 0x68, 0xcc, 0xb9, 0xaf, 0xea, 0xd7, 0x00, 0x63, 0x9a, 0xa3,
+	0x98, 0xed, 0xdf, 0x60, 0x17, 0x37, 0xbc, 0x1e, 0x2b, 0xf3, 0xd3, 0xf2, 0x0b, 0x72, 0x73, 0xe3,
+	0xd6, 0xc5, 0x4b, 0xf2, 0x53, 0x70, 0xfe, 0xce, 0xfc, 0xc7, 0x3a, 0xb4, 0x64, 0xb4, 0xed, 0x87,
+	0x5e, 0x44, 0x2e, 0x90, 0x56, 0x5f, 0xfc, 0xa8, 0xb8, 0x9b, 0x31, 0xa8, 0xf4, 0x61, 0x42, 0x34,
+	0x4f, 0x05, 0x9d, 0x52, 0x87, 0x1a, 0x90, 0x66, 0xfb, 0xb8, 0x34, 0xbb, 0xa4, 0xfc, 0xa6, 0xbb,
+	0x8f, 0x5c, 0x94, 0x4b, 0x8d, 0x4a, 0x13, 0x20, 0x21, 0x55, 0x6b, 0x4a, 0xff, 0x2c, 0xa0, 0xea,
+	0x5a, 0xf1, 0xcf, 0x02, 0x74, 0x6b, 0xa7, 0x37, 0x7b, 0xe3, 0x7f, 0x09, 0xe8, 0xa7, 0x2b, 0x82,
+	0x14, 0xc3, 0x0a, 0x4c, 0xc9, 0x9c, 0x15, 0xa5, 0x5c, 0xe0, 0x26, 0x69, 0x88, 0xad, 0xa6, 0x0d,
+	0xb3, 0xbd, 0xb3, 0xf4, 0xdb, 0xd0, 0xd5, 0xe4, 0x69, 0xae, 0xd5, 0x04, 0x3e, 0x56, 0x3c, 0xb7,
+	0xa0, 0xd5, 0xbf, 0xfd, 0xae, 0x4b, 0xa1, 0x83, 0x3d, 0x0d, 0x31, 0xa9, 0x42, 0x37, 0x83, 0xe8,
+	0x3d, 0x05, 0x32, 0xd7, 0x9d, 0xdb, 0x26, 0x57, 0x93, 0xb9, 0xee, 0xdc, 0x1e, 0xe2, 0xba, 0x63,
+	0x72, 0xcd, 0x68, 0xae, 0x3b, 0x39, 0x17, 0x35, 0x37, 0x18, 0x2d, 0x65, 0x23, 0xe8, 0xfa, 0xf9,
+	0x58, 0x46, 0xe3, 0x30, 0x8d, 0x6d, 0xff, 0x21, 0x4c, 0x50, 0x20, 0x5f, 0xf4, 0x7b, 0xee, 0xd0,
+	0x4c, 0xc5, 0x4f, 0x6f, 0xfe, 0x8b, 0x92, 0x72, 0x97, 0xf2, 0x02, 0x96, 0xb0, 0x4c, 0xfd, 0xae,
+	0x38, 0x64, 0xe3, 0x5a, 0xa5, 0x8d, 0xcd, 0x0a, 0xc3, 0xbe, 0xb1, 0xa4, 0x04, 0xc9, 0x2d, 0x2f,
+	0x07, 0xd4, 0x7e, 0xa0, 0x28, 0x03, 0x2f, 0xe4, 0x67, 0x4e, 0x1e, 0x8d, 0x5a, 0x61, 0x62, 0xd4,
+	0x0a, 0xe8, 0x35, 0xf2, 0x01, 0x52, 0xd9, 0x5e, 0x7e, 0xd3, 0x7a, 0x58, 0x3f, 0x52, 0xec, 0x55,
+	0x55, 0x01, 0xe0, 0x91, 0xdc, 0x3d, 0x88, 0x3a, 0x79, 0x37, 0x23, 0x07, 0xf4, 0xd3, 0x8a, 0x38,
+	0xed, 0x07, 0x89, 0xd0, 0x56, 0xd6, 0x43, 0x53, 0xdf, 0xf9, 0xf9, 0xb5, 0xbe, 0x7f, 0x57, 0xe8,
+	0xdb, 0xa0, 0xe5, 0x3f, 0xe8, 0x4d, 0x61, 0xb7, 0x98, 0x04, 0x79, 0xac, 0xbd, 0x79, 0x9e, 0xc6,
+	0xcb, 0x7a, 0x75, 0xf4, 0x34, 0x3b, 0x86, 0x6b, 0x07, 0x7d, 0x64, 0x13, 0x95, 0xbb, 0xeb, 0x86,
+	0x2d, 0xef, 0xc6, 0x79, 0x74, 0x89, 0xd8, 0x2b, 0x74, 0x53, 0x37, 0x75, 0x63, 0xff, 0x1e, 0x5e,
+	0xa9, 0xde, 0xf0, 0x65, 0x1d, 0xe9, 0xee, 0xbb, 0xdf, 0xac, 0x9d, 0x04, 0x58, 0x66, 0xd2, 0xb5,
+	0x20, 0x5e, 0x57, 0x5f, 0xeb, 0x5d, 0xfc, 0xca, 0xd6, 0xe5, 0xbf, 0x2d, 0xad, 0x8f, 0x2c, 0x77,
+	0x38, 0x29, 0x09, 0xef, 0xff, 0x17, 0x67, 0xce, 0xf7, 0xb8, 0x40, 0x25, 0x00, 0x00,
 }
@@ -29,73 +29,81 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("tabletmanagerservice.proto", fileDescriptor_9ee75fe63cfd9360) }
 
 var fileDescriptor_9ee75fe63cfd9360 = []byte{
-	// 1041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x6d, 0x6f, 0x1b, 0x45,
-	0x10, 0xc7, 0xb1, 0x04, 0x95, 0x58, 0x1e, 0xbb, 0xaa, 0x28, 0x0a, 0x12, 0x4f, 0x6d, 0x78, 0x48,
-	0x51, 0xdc, 0x34, 0x94, 0xf7, 0x6e, 0x9a, 0xb4, 0x41, 0x8d, 0x30, 0x76, 0x43, 0x10, 0x48, 0x48,
-	0x1b, 0x7b, 0x62, 0x1f, 0x39, 0xef, 0x1e, 0xbb, 0x7b, 0x56, 0xf3, 0x0a, 0x09, 0x89, 0x57, 0x48,
-	0x7c, 0x11, 0xbe, 0x24, 0xba, 0x87, 0xdd, 0x9b, 0x3d, 0xcf, 0xad, 0xed, 0x77, 0x91, 0xff, 0xbf,
-	0x99, 0xd9, 0x87, 0x99, 0xd9, 0xc9, 0xb1, 0x1d, 0x2b, 0x2e, 0x53, 0xb0, 0x0b, 0x21, 0xc5, 0x0c,
-	0xb4, 0x01, 0xbd, 0x4c, 0x26, 0xb0, 0x9f, 0x69, 0x65, 0x15, 0xbf, 0x43, 0x69, 0x3b, 0x77, 0x83,
-	0x5f, 0xa7, 0xc2, 0x8a, 0x0a, 0x7f, 0xf4, 0xdf, 0x2e, 0x7b, 0xe7, 0x65, 0xa9, 0x9d, 0x55, 0x1a,
-	0x3f, 0x65, 0xaf, 0x0f, 0x13, 0x39, 0xe3, 0x1f, 0xef, 0xaf, 0xda, 0x14, 0xc2, 0x08, 0xfe, 0xc8,
-	0xc1, 0xd8, 0x9d, 0x4f, 0x3a, 0x75, 0x93, 0x29, 0x69, 0xe0, 0xf3, 0xd7, 0xf8, 0x0b, 0xf6, 0xc6,
-	0x38, 0x05, 0xc8, 0x38, 0xc5, 0x96, 0x8a, 0x73, 0xf6, 0x69, 0x37, 0xe0, 0xbd, 0xfd, 0xc6, 0xde,
-	0x3a, 0x7e, 0x05, 0x93, 0xdc, 0xc2, 0x73, 0xa5, 0xae, 0xf9, 0x2e, 0x61, 0x82, 0x74, 0xe7, 0xf9,
-	0x8b, 0x75, 0x98, 0xf7, 0xff, 0x33, 0x7b, 0xf3, 0x19, 0xd8, 0xf1, 0x64, 0x0e, 0x0b, 0xc1, 0xef,
-	0x11, 0x66, 0x5e, 0x75, 0xbe, 0xef, 0xc7, 0x21, 0xef, 0x79, 0xc6, 0xde, 0x7d, 0x06, 0x76, 0x08,
-	0x7a, 0x91, 0x18, 0x93, 0x28, 0x69, 0xf8, 0x57, 0xb4, 0x25, 0x42, 0x5c, 0x8c, 0xaf, 0x37, 0x20,
-	0xf1, 0x11, 0x8d, 0xc1, 0x8e, 0x40, 0x4c, 0x7f, 0x90, 0xe9, 0x0d, 0x79, 0x44, 0x48, 0x8f, 0x1d,
-	0x51, 0x80, 0x79, 0xff, 0x82, 0xbd, 0x5d, 0x0b, 0x17, 0x3a, 0xb1, 0xc0, 0x23, 0x96, 0x25, 0xe0,
-	0x22, 0x7c, 0xb9, 0x96, 0xf3, 0x21, 0x7e, 0x65, 0xec, 0x68, 0x2e, 0xe4, 0x0c, 0x5e, 0xde, 0x64,
-	0xc0, 0xa9, 0x13, 0x6e, 0x64, 0xe7, 0x7e, 0x77, 0x0d, 0x85, 0xd7, 0x3f, 0x82, 0x2b, 0x0d, 0x66,
-	0x3e, 0xb6, 0xa2, 0x63, 0xfd, 0x18, 0x88, 0xad, 0x3f, 0xe4, 0xf0, 0x5d, 0x8f, 0x72, 0xf9, 0x1c,
-	0x44, 0x6a, 0xe7, 0x47, 0x73, 0x98, 0x5c, 0x93, 0x77, 0x1d, 0x22, 0xb1, 0xbb, 0x6e, 0x93, 0x3e,
-	0x50, 0xc6, 0x6e, 0x9f, 0xce, 0xa4, 0xd2, 0x50, 0xc9, 0xc7, 0x5a, 0x2b, 0xcd, 0x1f, 0x10, 0x1e,
-	0x56, 0x28, 0x17, 0xee, 0x9b, 0xcd, 0xe0, 0xf0, 0xf4, 0x52, 0x25, 0xa6, 0x75, 0x8d, 0xd0, 0xa7,
-	0xd7, 0x00, 0xf1, 0xd3, 0xc3, 0x9c, 0x0f, 0xf1, 0x3b, 0x7b, 0x6f, 0xa8, 0xe1, 0x2a, 0x4d, 0x66,
-	0x73, 0x57, 0x89, 0xd4, 0xa1, 0xb4, 0x18, 0x17, 0x68, 0x6f, 0x13, 0x14, 0x17, 0xcb, 0x20, 0xcb,
-	0xd2, 0x9b, 0x3a, 0x0e, 0x95, 0x44, 0x48, 0x8f, 0x15, 0x4b, 0x80, 0xe1, 0x4c, 0x7e, 0xa1, 0x26,
-	0xd7, 0x65, 0x77, 0x35, 0x64, 0x26, 0x37, 0x72, 0x2c, 0x93, 0x31, 0x85, 0xef, 0xe2, 0x5c, 0xa6,
-	0x8d, 0x7b, 0x6a, 0x59, 0x18, 0x88, 0xdd, 0x45, 0xc8, 0xe1, 0x04, 0xab, 0x1b, 0xe5, 0x09, 0xd8,
-	0xc9, 0x7c, 0x60, 0x9e, 0x5e, 0x0a, 0x32, 0xc1, 0x56, 0xa8, 0x58, 0x82, 0x11, 0xb0, 0x8f, 0xf8,
-	0x27, 0xfb, 0x20, 0x94, 0x07, 0x69, 0x3a, 0xd4, 0xc9, 0xd2, 0xf0, 0x87, 0x6b, 0x3d, 0x39, 0xd4,
-	0xc5, 0x3e, 0xd8, 0xc2, 0xa2, 0x7b, 0xcb, 0x83, 0x2c, 0xdb, 0x60, 0xcb, 0x83, 0x2c, 0xdb, 0x7c,
-	0xcb, 0x25, 0x1c, 0x74, 0xec, 0x54, 0x2c, 0xa1, 0x68, 0x23, 0xb9, 0xa1, 0x3b, 0x76, 0xa3, 0x47,
-	0x3b, 0x36, 0xc6, 0x70, 0x3b, 0x3a, 0x13, 0xc6, 0x82, 0x1e, 0x2a, 0x93, 0xd8, 0x44, 0x49, 0xb2,
-	0x1d, 0x85, 0x48, 0xac, 0x1d, 0xb5, 0x49, 0x5c, 0xb9, 0x17, 0x22, 0xb1, 0x27, 0xaa, 0x89, 0x44,
-	0xd9, 0xb7, 0x98, 0x58, 0xe5, 0xae, 0xa0, 0xf8, 0xa5, 0x1e, 0x5b, 0x95, 0x95, 0x3b, 0x26, 0x5f,
-	0x6a, 0xaf, 0xc6, 0x5e, 0x6a, 0x04, 0x79, 0xcf, 0x0b, 0xf6, 0xbe, 0xff, 0xf9, 0x2c, 0x91, 0xc9,
-	0x22, 0x5f, 0xf0, 0xbd, 0x98, 0x6d, 0x0d, 0xb9, 0x38, 0x0f, 0x36, 0x62, 0x71, 0x8b, 0x18, 0x5b,
-	0xa1, 0x6d, 0xb5, 0x13, 0x7a, 0x91, 0x4e, 0x8e, 0xb5, 0x08, 0x4c, 0x79, 0xe7, 0x37, 0xec, 0x4e,
-	0xf3, 0xfb, 0xb9, 0xb4, 0x49, 0x3a, 0xb8, 0xb2, 0xa0, 0xf9, 0x7e, 0xd4, 0x41, 0x03, 0xba, 0x80,
-	0xfd, 0x8d, 0x79, 0x1f, 0xfa, 0x9f, 0x1e, 0xdb, 0xa9, 0xa6, 0xca, 0xe3, 0x57, 0x16, 0xb4, 0x14,
-	0x69, 0x31, 0x46, 0x64, 0x42, 0x83, 0xb4, 0x30, 0xe5, 0xdf, 0x12, 0x1e, 0xbb, 0x71, 0xb7, 0x8e,
-	0xc7, 0x5b, 0x5a, 0xf9, 0xd5, 0xfc, 0xd5, 0x63, 0x77, 0xdb, 0xe0, 0x71, 0x0a, 0x93, 0x62, 0x29,
-	0x07, 0x1b, 0x38, 0xad, 0x59, 0xb7, 0x8e, 0x47, 0xdb, 0x98, 0xb4, 0xa7, 0xcb, 0xe2, 0xc8, 0x4c,
-	0xe7, 0x74, 0x59, 0xaa, 0xeb, 0xa6, 0xcb, 0x1a, 0xc2, 0x39, 0xfb, 0xd3, 0x08, 0xb2, 0x34, 0x99,
-	0x88, 0xa2, 0x4e, 0x8a, 0x6e, 0x43, 0xe6, 0x6c, 0x1b, 0x8a, 0xe5, 0xec, 0x2a, 0x8b, 0x9b, 0x34,
-	0x56, 0x9b, 0x2a, 0x25, 0x9b, 0x34, 0x8d, 0xc6, 0x9a, 0x74, 0x97, 0x05, 0xde, 0xef, 0x08, 0x4c,
-	0x31, 0x3d, 0x7a, 0x8e, 0xdc, 0x6f, 0x1b, 0x8a, 0xed, 0x77, 0x95, 0xc5, 0x35, 0x7a, 0x2a, 0x13,
-	0x5b, 0x35, 0x3e, 0xb2, 0x46, 0x1b, 0x39, 0x56, 0xa3, 0x98, 0x0a, 0x52, 0x73, 0xa8, 0xb2, 0x3c,
-	0x2d, 0x87, 0xc8, 0x2a, 0x77, 0xbf, 0x57, 0x79, 0x91, 0x44, 0x64, 0x6a, 0x76, 0xb0, 0xb1, 0xd4,
-	0xec, 0x34, 0xc1, 0xa9, 0x59, 0x2c, 0xae, 0xbb, 0x9d, 0x7a, 0x35, 0x96, 0x9a, 0x08, 0xc2, 0x53,
-	0xca, 0x53, 0x58, 0x28, 0x0b, 0xf5, 0xe9, 0x51, 0xef, 0x16, 0x06, 0x62, 0x53, 0x4a, 0xc8, 0xe1,
-	0x6c, 0x38, 0x97, 0x53, 0x15, 0x84, 0xd9, 0x23, 0x87, 0x9c, 0x10, 0x8a, 0x65, 0xc3, 0x2a, 0xeb,
-	0xc3, 0xfd, 0xdd, 0x63, 0x1f, 0x0e, 0xb5, 0x2a, 0xb4, 0x72, 0xb3, 0x17, 0x73, 0x90, 0x47, 0x22,
-	0x9f, 0xcd, 0xed, 0x79, 0xc6, 0xc9, 0xe3, 0xef, 0x80, 0x5d, 0xfc, 0xc3, 0xad, 0x6c, 0x82, 0x87,
-	0xaa, 0x94, 0x85, 0xa9, 0xe9, 0x29, 0xfd, 0x50, 0xb5, 0xa0, 0xe8, 0x43, 0xb5, 0xc2, 0x06, 0x2f,
-	0x2e, 0xb8, 0x1a, 0xb8, 0x47, 0xff, 0x37, 0x17, 0x9e, 0xeb, 0xfd, 0x38, 0x84, 0x47, 0x2e, 0x17,
-	0x77, 0x04, 0xa6, 0x78, 0x56, 0x60, 0xca, 0x63, 0xab, 0xf3, 0x54, 0x6c, 0xe4, 0x22, 0x60, 0x1f,
-	0xf1, 0xdf, 0x1e, 0xfb, 0xa8, 0x78, 0x93, 0x51, 0xb9, 0x0f, 0xe4, 0xb4, 0xe8, 0xac, 0xd5, 0x0c,
-	0xf6, 0xb8, 0xe3, 0x0d, 0xef, 0xe0, 0xdd, 0x32, 0xbe, 0xdb, 0xd6, 0x0c, 0x57, 0x09, 0xbe, 0x71,
-	0xb2, 0x4a, 0x30, 0x10, 0xab, 0x92, 0x90, 0xf3, 0x21, 0x7e, 0x64, 0xb7, 0x9e, 0x88, 0xc9, 0x75,
-	0x9e, 0x71, 0xea, 0x4b, 0x4b, 0x25, 0x39, 0xb7, 0x9f, 0x45, 0x08, 0xe7, 0xf0, 0x61, 0x8f, 0x6b,
-	0x76, 0xbb, 0x38, 0x5d, 0xa5, 0xe1, 0x44, 0xab, 0x45, 0xed, 0xbd, 0xa3, 0xb7, 0x86, 0x54, 0xec,
-	0xe2, 0x08, 0xb8, 0x89, 0xf9, 0xe4, 0xf0, 0x97, 0x83, 0x65, 0x62, 0xc1, 0x98, 0xfd, 0x44, 0xf5,
-	0xab, 0xbf, 0xfa, 0x33, 0xd5, 0x5f, 0xda, 0x7e, 0xf9, 0x35, 0xab, 0x4f, 0x7d, 0xfb, 0xba, 0xbc,
-	0x55, 0x6a, 0x87, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xa2, 0x81, 0x89, 0x36, 0x13, 0x00,
-	0x00,
+	// 1179 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x98, 0x5b, 0x6f, 0x1c, 0x35,
+	0x14, 0xc7, 0x59, 0x09, 0x2a, 0x30, 0xd7, 0x5a, 0x15, 0x45, 0x41, 0xe2, 0xd2, 0xb4, 0x40, 0x93,
+	0x92, 0x6d, 0x1a, 0xca, 0xfb, 0xe6, 0x46, 0x83, 0x1a, 0xb1, 0xcd, 0x76, 0x09, 0x02, 0x09, 0xc9,
+	0x99, 0x75, 0x76, 0x87, 0x78, 0x67, 0x86, 0xb1, 0x37, 0xea, 0xf2, 0x82, 0x84, 0xc4, 0x13, 0x12,
+	0x5f, 0x81, 0xaf, 0x8a, 0x3d, 0x33, 0xf6, 0x1c, 0xcf, 0x9e, 0xf1, 0x4e, 0xde, 0x92, 0xf9, 0xff,
+	0x7c, 0x8e, 0x2f, 0xc7, 0xe7, 0x1c, 0x2f, 0xd9, 0x50, 0xec, 0x42, 0x70, 0x35, 0x67, 0x09, 0x9b,
+	0xf2, 0x5c, 0xf2, 0xfc, 0x3a, 0x8e, 0xf8, 0x4e, 0x96, 0xa7, 0x2a, 0xa5, 0x77, 0x30, 0x6d, 0xe3,
+	0xae, 0xf7, 0x75, 0xc2, 0x14, 0x2b, 0xf1, 0x27, 0xff, 0x6d, 0x93, 0x77, 0x5f, 0x16, 0xda, 0x69,
+	0xa9, 0xd1, 0x13, 0xf2, 0xfa, 0x30, 0x4e, 0xa6, 0xf4, 0x93, 0x9d, 0xd5, 0x31, 0x46, 0x38, 0xe3,
+	0xbf, 0x2f, 0xb8, 0x54, 0x1b, 0x9f, 0xb6, 0xea, 0x32, 0x4b, 0x13, 0xc9, 0xef, 0xbd, 0x46, 0x9f,
+	0x93, 0x37, 0x46, 0x82, 0xf3, 0x8c, 0x62, 0x6c, 0xa1, 0x58, 0x63, 0x9f, 0xb5, 0x03, 0xce, 0xda,
+	0xaf, 0xe4, 0xed, 0xa3, 0x57, 0x3c, 0x5a, 0x28, 0xfe, 0x2c, 0x4d, 0xaf, 0xe8, 0x03, 0x64, 0x08,
+	0xd0, 0xad, 0xe5, 0x2f, 0xd6, 0x61, 0xce, 0xfe, 0x4f, 0xe4, 0xad, 0xef, 0xb8, 0x1a, 0x45, 0x33,
+	0x3e, 0x67, 0x74, 0x13, 0x19, 0xe6, 0x54, 0x6b, 0xfb, 0x7e, 0x18, 0x72, 0x96, 0xa7, 0xe4, 0x3d,
+	0xfd, 0x79, 0xc8, 0xf3, 0x79, 0x2c, 0x65, 0xac, 0x3f, 0xd2, 0xaf, 0xf0, 0x91, 0x00, 0xb1, 0x3e,
+	0x1e, 0x76, 0x20, 0xe1, 0x16, 0x8d, 0xb8, 0x3a, 0xe3, 0x6c, 0xf2, 0x43, 0x22, 0x96, 0xe8, 0x16,
+	0x01, 0x3d, 0xb4, 0x45, 0x1e, 0xe6, 0xec, 0x33, 0xf2, 0x4e, 0x25, 0x9c, 0xe7, 0xb1, 0xe2, 0x34,
+	0x30, 0xb2, 0x00, 0xac, 0x87, 0x2f, 0xd7, 0x72, 0xce, 0xc5, 0x2f, 0x84, 0x1c, 0xcc, 0x58, 0x32,
+	0xe5, 0x2f, 0x97, 0x19, 0xa7, 0xd8, 0x0e, 0xd7, 0xb2, 0x35, 0xff, 0x60, 0x0d, 0x05, 0xe7, 0x7f,
+	0xc6, 0x2f, 0x73, 0x2e, 0x67, 0x23, 0xc5, 0x5a, 0xe6, 0x0f, 0x81, 0xd0, 0xfc, 0x7d, 0xce, 0xb9,
+	0x18, 0x93, 0x37, 0xcd, 0xf1, 0xa4, 0xa9, 0x90, 0xf4, 0x5e, 0xcb, 0xd9, 0x19, 0xd1, 0x9a, 0xde,
+	0x0c, 0x32, 0x70, 0x5b, 0xf4, 0x7f, 0xf1, 0x1f, 0xdc, 0x08, 0xe8, 0xb6, 0xd4, 0x72, 0x68, 0x5b,
+	0x20, 0xe5, 0x8c, 0x4b, 0x42, 0xb5, 0xcb, 0xe7, 0x69, 0x74, 0x75, 0x18, 0xb3, 0x69, 0x92, 0x4a,
+	0x15, 0x47, 0x92, 0x3e, 0xc2, 0x67, 0xd6, 0xc0, 0xac, 0xb3, 0xaf, 0x3b, 0xd2, 0xce, 0x69, 0x46,
+	0x6e, 0x6b, 0xfd, 0xc5, 0x82, 0xe7, 0xcb, 0xa1, 0x60, 0x89, 0xd9, 0x47, 0x49, 0xb7, 0x71, 0x2b,
+	0x3e, 0x65, 0x5d, 0x3e, 0xea, 0x06, 0x63, 0x1e, 0xf7, 0x85, 0x9e, 0x97, 0x88, 0xa5, 0x0a, 0x7a,
+	0x74, 0x54, 0x17, 0x8f, 0x00, 0x76, 0x1e, 0x97, 0xe4, 0xce, 0x38, 0xd3, 0x14, 0x6f, 0x38, 0xdd,
+	0x41, 0xec, 0x60, 0xa0, 0xf5, 0xdb, 0xef, 0xcc, 0x3b, 0xd7, 0xbf, 0x91, 0xf7, 0xcd, 0x0d, 0x5b,
+	0x24, 0x2a, 0x9e, 0xf3, 0x63, 0xc1, 0xa6, 0x92, 0x3e, 0x6c, 0xb9, 0x85, 0x80, 0xb1, 0x0e, 0xb7,
+	0xba, 0xa0, 0x30, 0xbf, 0x69, 0xe5, 0x19, 0x67, 0x42, 0xcd, 0x0e, 0x66, 0x3c, 0xba, 0x42, 0xf3,
+	0x9b, 0x8f, 0x84, 0xf2, 0x5b, 0x93, 0x84, 0x27, 0x78, 0xa2, 0x43, 0x29, 0xe7, 0xa5, 0x7c, 0x94,
+	0xe7, 0x69, 0x8e, 0x9e, 0xe0, 0x0a, 0x15, 0x3a, 0x41, 0x04, 0xf6, 0x33, 0x86, 0x48, 0xd9, 0xa4,
+	0xaa, 0x0b, 0x78, 0xc6, 0xa8, 0x81, 0x70, 0xc6, 0x80, 0x1c, 0x3c, 0xa9, 0x61, 0xce, 0x2f, 0x45,
+	0x3c, 0x9d, 0xd9, 0xea, 0x83, 0x6d, 0x4a, 0x83, 0x09, 0x9d, 0xd4, 0x0a, 0x0a, 0x0b, 0xc4, 0x20,
+	0xcb, 0xc4, 0xb2, 0xf2, 0x83, 0x65, 0x08, 0xa0, 0x87, 0x0a, 0x84, 0x87, 0xc1, 0x34, 0x65, 0x6e,
+	0x7c, 0xd1, 0x51, 0x48, 0x34, 0x4d, 0xd5, 0x72, 0x28, 0x4d, 0x41, 0x0a, 0x9e, 0xc5, 0x38, 0x11,
+	0xb5, 0x79, 0x6c, 0x5a, 0x10, 0x08, 0x9d, 0x85, 0xcf, 0xc1, 0x00, 0xab, 0x9a, 0x83, 0x63, 0xae,
+	0xa2, 0xd9, 0x40, 0x1e, 0x5e, 0x30, 0x34, 0xc0, 0x56, 0xa8, 0x50, 0x80, 0x21, 0xb0, 0xf3, 0xf8,
+	0x27, 0xf9, 0xd0, 0x97, 0x07, 0x42, 0x0c, 0xf3, 0xf8, 0x5a, 0xd2, 0xc7, 0x6b, 0x2d, 0x59, 0xd4,
+	0xfa, 0xde, 0xbd, 0xc1, 0x88, 0xf6, 0x25, 0xeb, 0x93, 0xed, 0xb0, 0x64, 0x4d, 0x75, 0x5f, 0x72,
+	0x01, 0x7b, 0x5d, 0x8a, 0x60, 0xd7, 0xdc, 0xe4, 0xe7, 0x85, 0xc4, 0xbb, 0x94, 0x5a, 0x0f, 0x76,
+	0x29, 0x10, 0x83, 0xe9, 0xe8, 0x94, 0x49, 0xc5, 0xf3, 0x61, 0x2a, 0x63, 0xa5, 0x5b, 0x24, 0x34,
+	0x1d, 0xf9, 0x48, 0x28, 0x1d, 0x35, 0x49, 0x78, 0x73, 0xcf, 0x59, 0xac, 0x8e, 0xd3, 0xda, 0x13,
+	0x36, 0xbe, 0xc1, 0x84, 0x6e, 0xee, 0x0a, 0x0a, 0xbb, 0xd3, 0x91, 0x4a, 0xb3, 0x62, 0xc5, 0x68,
+	0x77, 0xea, 0xd4, 0x50, 0x77, 0x0a, 0x20, 0x67, 0x79, 0x4e, 0x3e, 0x70, 0x9f, 0x4f, 0xe3, 0x24,
+	0x9e, 0x2f, 0xe6, 0x74, 0x2b, 0x34, 0xb6, 0x82, 0xac, 0x9f, 0xed, 0x4e, 0x2c, 0x4c, 0x11, 0xfa,
+	0xc4, 0x72, 0x55, 0xae, 0x04, 0x9f, 0xa4, 0x95, 0x43, 0x29, 0x02, 0x52, 0xb0, 0xe0, 0xd6, 0xdf,
+	0xc7, 0xba, 0x58, 0x89, 0xc1, 0xa5, 0x3e, 0x3b, 0xb4, 0xe0, 0x62, 0x60, 0xa8, 0xe0, 0xe2, 0xbc,
+	0x73, 0xfd, 0x4f, 0x8f, 0x6c, 0x94, 0x2f, 0xa9, 0xa3, 0x57, 0x5a, 0x49, 0x98, 0x30, 0xad, 0x73,
+	0xc6, 0x72, 0x9e, 0x28, 0x3e, 0xa1, 0xdf, 0x20, 0x16, 0xdb, 0x71, 0x3b, 0x8f, 0xa7, 0x37, 0x1c,
+	0xe5, 0x66, 0xf3, 0x57, 0x8f, 0xdc, 0x6d, 0x82, 0x47, 0x82, 0x47, 0x66, 0x2a, 0xbb, 0x1d, 0x8c,
+	0x56, 0xac, 0x9d, 0xc7, 0x93, 0x9b, 0x0c, 0x69, 0xbe, 0xa8, 0xcc, 0x96, 0xc9, 0xd6, 0x17, 0x55,
+	0xa1, 0xae, 0x7b, 0x51, 0x55, 0x10, 0x8c, 0xd9, 0x1f, 0xf5, 0xba, 0x45, 0x1c, 0x31, 0x73, 0x4f,
+	0x4c, 0xb6, 0x41, 0x63, 0xb6, 0x09, 0x85, 0x62, 0x76, 0x95, 0x85, 0x49, 0x1a, 0xaa, 0xf5, 0x2d,
+	0x45, 0x93, 0x34, 0x8e, 0x86, 0x92, 0x74, 0xdb, 0x08, 0xb8, 0x5e, 0xfd, 0x9f, 0x79, 0x31, 0x39,
+	0x0e, 0x5d, 0x6f, 0x13, 0x0a, 0xad, 0x77, 0x95, 0x85, 0x77, 0xf4, 0x24, 0x89, 0x55, 0x99, 0xf8,
+	0xd0, 0x3b, 0x5a, 0xcb, 0xa1, 0x3b, 0x0a, 0x29, 0x2f, 0x34, 0x87, 0x69, 0xb6, 0x10, 0xc5, 0xc3,
+	0xa9, 0x8c, 0xdd, 0xef, 0xd3, 0x85, 0x09, 0x22, 0x34, 0x34, 0x5b, 0xd8, 0x50, 0x68, 0xb6, 0x0e,
+	0x81, 0xa1, 0x69, 0x26, 0xd7, 0x9e, 0x4e, 0x9d, 0x1a, 0x0a, 0x4d, 0x00, 0xc1, 0x2e, 0xe5, 0x90,
+	0xcf, 0x53, 0xc5, 0xab, 0xdd, 0xc3, 0xea, 0x16, 0x04, 0x42, 0x5d, 0x8a, 0xcf, 0xc1, 0x68, 0x18,
+	0x27, 0x93, 0xd4, 0x73, 0xb3, 0x85, 0x36, 0x39, 0x3e, 0x14, 0x8a, 0x86, 0x55, 0xd6, 0xb9, 0xfb,
+	0xbb, 0x47, 0x3e, 0x1a, 0xe6, 0xa9, 0xd1, 0x8a, 0xc5, 0x9e, 0xcf, 0x78, 0x72, 0xc0, 0x16, 0xba,
+	0xbf, 0x1c, 0x67, 0x14, 0xdd, 0xfe, 0x16, 0xd8, 0xfa, 0xdf, 0xbb, 0xd1, 0x18, 0xaf, 0x50, 0x15,
+	0x32, 0x93, 0x15, 0x3d, 0xc1, 0x0b, 0x55, 0x03, 0x0a, 0x16, 0xaa, 0x15, 0xd6, 0xab, 0xb8, 0xdc,
+	0xde, 0x81, 0x4d, 0xfc, 0x41, 0xe4, 0xef, 0xeb, 0xfd, 0x30, 0x04, 0x5b, 0x2e, 0xeb, 0x57, 0x7f,
+	0x35, 0x65, 0x45, 0xaf, 0x24, 0x34, 0x3b, 0x47, 0x85, 0x5a, 0x2e, 0x04, 0x76, 0x1e, 0xff, 0xed,
+	0x91, 0x8f, 0x4d, 0x4d, 0x06, 0xd7, 0x7d, 0x90, 0x4c, 0x4c, 0x66, 0x2d, 0x7b, 0xb0, 0xa7, 0x2d,
+	0x35, 0xbc, 0x85, 0xb7, 0xd3, 0xf8, 0xf6, 0xa6, 0xc3, 0xe0, 0x2d, 0x81, 0x27, 0x8e, 0xde, 0x12,
+	0x08, 0x84, 0x6e, 0x89, 0xcf, 0x39, 0x17, 0x2f, 0xc8, 0xad, 0x7d, 0x16, 0x5d, 0x2d, 0x32, 0x8a,
+	0xfd, 0xba, 0x58, 0x4a, 0xd6, 0xec, 0xe7, 0x01, 0xc2, 0x1a, 0x7c, 0xdc, 0xa3, 0x39, 0xb9, 0x6d,
+	0x76, 0x57, 0xbf, 0x16, 0x8f, 0xb5, 0xcf, 0xca, 0x7a, 0x4b, 0x6e, 0xf5, 0xa9, 0xd0, 0xc1, 0x21,
+	0x70, 0xed, 0x73, 0x7f, 0xef, 0xe7, 0xdd, 0xeb, 0x58, 0x71, 0x29, 0x77, 0xe2, 0xb4, 0x5f, 0xfe,
+	0xd5, 0x9f, 0xea, 0xbf, 0x54, 0xbf, 0xf8, 0x05, 0xb7, 0x8f, 0xfd, 0xde, 0x7b, 0x71, 0xab, 0xd0,
+	0xf6, 0xfe, 0x07, 0x71, 0x79, 0xb3, 0xd0, 0x2a, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -125,6 +133,26 @@ type TabletManagerClient interface {
 	// ChangeType asks the remote tablet to change its type
 	ChangeType(ctx context.Context, in *tabletmanagerdata.ChangeTypeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ChangeTypeResponse, error)
 	RefreshState(ctx context.Context, in *tabletmanagerdata.RefreshStateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RefreshStateResponse, error)
+	// GetPools returns the connection pools of the tablet server
+	GetPools(ctx context.Context, in *tabletmanagerdata.GetPoolsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPoolsResponse, error)
+	// ResizePool changes the capacity, idle timeout or prefill of a
+	// connection pool of the tablet server
+	ResizePool(ctx context.Context, in *tabletmanagerdata.ResizePoolRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResizePoolResponse, error)
+	// GetLockDiagnostics returns the lock waits and the latest deadlock of
+	// the MySQL server, correlated with the query fingerprints
+	GetLockDiagnostics(ctx context.Context, in *tabletmanagerdata.GetLockDiagnosticsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetLockDiagnosticsResponse, error)
+	// GetQueryPlanStats returns the statistics of the queries of the plan
+	// cache of the tablet, with their latency percentiles
+	GetQueryPlanStats(ctx context.Context, in *tabletmanagerdata.GetQueryPlanStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetQueryPlanStatsResponse, error)
+	// GetQueryBlocklist returns the entries of the query blocklist of the
+	// tablet
+	GetQueryBlocklist(ctx context.Context, in *tabletmanagerdata.GetQueryBlocklistRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetQueryBlocklistResponse, error)
+	// UpdateQueryBlocklist approves, blocks or removes a query fingerprint
+	// of the query blocklist of the tablet
+	UpdateQueryBlocklist(ctx context.Context, in *tabletmanagerdata.UpdateQueryBlocklistRequest, opts ...grpc.CallOption) (*tabletmanagerdata.UpdateQueryBlocklistResponse, error)
+	// SetRuntimeFlags changes flags of the tablet, like the pool sizes and
+	// the timeouts, without restarting it
+	SetRuntimeFlags(ctx context.Context, in *tabletmanagerdata.SetRuntimeFlagsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetRuntimeFlagsResponse, error)
 	RunHealthCheck(ctx context.Context, in *tabletmanagerdata.RunHealthCheckRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RunHealthCheckResponse, error)
 	IgnoreHealthError(ctx context.Context, in *tabletmanagerdata.IgnoreHealthErrorRequest, opts ...grpc.CallOption) (*tabletmanagerdata.IgnoreHealthErrorResponse, error)
 	ReloadSchema(ctx context.Context, in *tabletmanagerdata.ReloadSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReloadSchemaResponse, error)
@@ -303,6 +331,69 @@ func (c *tabletManagerClient) RefreshState(ctx context.Context, in *tabletmanage
 	return out, nil
 }
 
+func (c *tabletManagerClient) GetPools(ctx context.Context, in *tabletmanagerdata.GetPoolsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetPoolsResponse, error) {
+	out := new(tabletmanagerdata.GetPoolsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) ResizePool(ctx context.Context, in *tabletmanagerdata.ResizePoolRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ResizePoolResponse, error) {
+	out := new(tabletmanagerdata.ResizePoolResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ResizePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetLockDiagnostics(ctx context.Context, in *tabletmanagerdata.GetLockDiagnosticsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetLockDiagnosticsResponse, error) {
+	out := new(tabletmanagerdata.GetLockDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetLockDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetQueryPlanStats(ctx context.Context, in *tabletmanagerdata.GetQueryPlanStatsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetQueryPlanStatsResponse, error) {
+	out := new(tabletmanagerdata.GetQueryPlanStatsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetQueryPlanStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetQueryBlocklist(ctx context.Context, in *tabletmanagerdata.GetQueryBlocklistRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetQueryBlocklistResponse, error) {
+	out := new(tabletmanagerdata.GetQueryBlocklistResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetQueryBlocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) UpdateQueryBlocklist(ctx context.Context, in *tabletmanagerdata.UpdateQueryBlocklistRequest, opts ...grpc.CallOption) (*tabletmanagerdata.UpdateQueryBlocklistResponse, error) {
+	out := new(tabletmanagerdata.UpdateQueryBlocklistResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/UpdateQueryBlocklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) SetRuntimeFlags(ctx context.Context, in *tabletmanagerdata.SetRuntimeFlagsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetRuntimeFlagsResponse, error) {
+	out := new(tabletmanagerdata.SetRuntimeFlagsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetRuntimeFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) RunHealthCheck(ctx context.Context, in *tabletmanagerdata.RunHealthCheckRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RunHealthCheckResponse, error) {
 	out := new(tabletmanagerdata.RunHealthCheckResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RunHealthCheck", in, out, opts...)
@@ -690,6 +781,26 @@ type TabletManagerServer interface {
 	// ChangeType asks the remote tablet to change its type
 	ChangeType(context.Context, *tabletmanagerdata.ChangeTypeRequest) (*tabletmanagerdata.ChangeTypeResponse, error)
 	RefreshState(context.Context, *tabletmanagerdata.RefreshStateRequest) (*tabletmanagerdata.RefreshStateResponse, error)
+	// GetPools returns the connection pools of the tablet server
+	GetPools(context.Context, *tabletmanagerdata.GetPoolsRequest) (*tabletmanagerdata.GetPoolsResponse, error)
+	// ResizePool changes the capacity, idle timeout or prefill of a
+	// connection pool of the tablet server
+	ResizePool(context.Context, *tabletmanagerdata.ResizePoolRequest) (*tabletmanagerdata.ResizePoolResponse, error)
+	// GetLockDiagnostics returns the lock waits and the latest deadlock of
+	// the MySQL server, correlated with the query fingerprints
+	GetLockDiagnostics(context.Context, *tabletmanagerdata.GetLockDiagnosticsRequest) (*tabletmanagerdata.GetLockDiagnosticsResponse, error)
+	// GetQueryPlanStats returns the statistics of the queries of the plan
+	// cache of the tablet, with their latency percentiles
+	GetQueryPlanStats(context.Context, *tabletmanagerdata.GetQueryPlanStatsRequest) (*tabletmanagerdata.GetQueryPlanStatsResponse, error)
+	// GetQueryBlocklist returns the entries of the query blocklist of the
+	// tablet
+	GetQueryBlocklist(context.Context, *tabletmanagerdata.GetQueryBlocklistRequest) (*tabletmanagerdata.GetQueryBlocklistResponse, error)
+	// UpdateQueryBlocklist approves, blocks or removes a query fingerprint
+	// of the query blocklist of the tablet
+	UpdateQueryBlocklist(context.Context, *tabletmanagerdata.UpdateQueryBlocklistRequest) (*tabletmanagerdata.UpdateQueryBlocklistResponse, error)
+	// SetRuntimeFlags changes flags of the tablet, like the pool sizes and
+	// the timeouts, without restarting it
+	SetRuntimeFlags(context.Context, *tabletmanagerdata.SetRuntimeFlagsRequest) (*tabletmanagerdata.SetRuntimeFlagsResponse, error)
 	RunHealthCheck(context.Context, *tabletmanagerdata.RunHealthCheckRequest) (*tabletmanagerdata.RunHealthCheckResponse, error)
 	IgnoreHealthError(context.Context, *tabletmanagerdata.IgnoreHealthErrorRequest) (*tabletmanagerdata.IgnoreHealthErrorResponse, error)
 	ReloadSchema(context.Context, *tabletmanagerdata.ReloadSchemaRequest) (*tabletmanagerdata.ReloadSchemaResponse, error)
@@ -810,6 +921,27 @@ func (*UnimplementedTabletManagerServer) ChangeType(ctx context.Context, req *ta
 func (*UnimplementedTabletManagerServer) RefreshState(ctx context.Context, req *tabletmanagerdata.RefreshStateRequest) (*tabletmanagerdata.RefreshStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshState not implemented")
 }
+func (*UnimplementedTabletManagerServer) GetPools(ctx context.Context, req *tabletmanagerdata.GetPoolsRequest) (*tabletmanagerdata.GetPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPools not implemented")
+}
+func (*UnimplementedTabletManagerServer) ResizePool(ctx context.Context, req *tabletmanagerdata.ResizePoolRequest) (*tabletmanagerdata.ResizePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizePool not implemented")
+}
+func (*UnimplementedTabletManagerServer) GetLockDiagnostics(ctx context.Context, req *tabletmanagerdata.GetLockDiagnosticsRequest) (*tabletmanagerdata.GetLockDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLockDiagnostics not implemented")
+}
+func (*UnimplementedTabletManagerServer) GetQueryPlanStats(ctx context.Context, req *tabletmanagerdata.GetQueryPlanStatsRequest) (*tabletmanagerdata.GetQueryPlanStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryPlanStats not implemented")
+}
+func (*UnimplementedTabletManagerServer) GetQueryBlocklist(ctx context.Context, req *tabletmanagerdata.GetQueryBlocklistRequest) (*tabletmanagerdata.GetQueryBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryBlocklist not implemented")
+}
+func (*UnimplementedTabletManagerServer) UpdateQueryBlocklist(ctx context.Context, req *tabletmanagerdata.UpdateQueryBlocklistRequest) (*tabletmanagerdata.UpdateQueryBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueryBlocklist not implemented")
+}
+func (*UnimplementedTabletManagerServer) SetRuntimeFlags(ctx context.Context, req *tabletmanagerdata.SetRuntimeFlagsRequest) (*tabletmanagerdata.SetRuntimeFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRuntimeFlags not implemented")
+}
 func (*UnimplementedTabletManagerServer) RunHealthCheck(ctx context.Context, req *tabletmanagerdata.RunHealthCheckRequest) (*tabletmanagerdata.RunHealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunHealthCheck not implemented")
 }
@@ -1085,6 +1217,132 @@ func _TabletManager_RefreshState_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_GetPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetPools(ctx, req.(*tabletmanagerdata.GetPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_ResizePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ResizePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ResizePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ResizePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ResizePool(ctx, req.(*tabletmanagerdata.ResizePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetLockDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetLockDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetLockDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetLockDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetLockDiagnostics(ctx, req.(*tabletmanagerdata.GetLockDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetQueryPlanStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetQueryPlanStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetQueryPlanStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetQueryPlanStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetQueryPlanStats(ctx, req.(*tabletmanagerdata.GetQueryPlanStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetQueryBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetQueryBlocklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetQueryBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetQueryBlocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetQueryBlocklist(ctx, req.(*tabletmanagerdata.GetQueryBlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_UpdateQueryBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.UpdateQueryBlocklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).UpdateQueryBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/UpdateQueryBlocklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).UpdateQueryBlocklist(ctx, req.(*tabletmanagerdata.UpdateQueryBlocklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_SetRuntimeFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetRuntimeFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetRuntimeFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetRuntimeFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetRuntimeFlags(ctx, req.(*tabletmanagerdata.SetRuntimeFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_RunHealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.RunHealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -1779,6 +2037,34 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshState",
 			Handler:    _TabletManager_RefreshState_Handler,
 		},
+		{
+			MethodName: "GetPools",
+			Handler:    _TabletManager_GetPools_Handler,
+		},
+		{
+			MethodName: "ResizePool",
+			Handler:    _TabletManager_ResizePool_Handler,
+		},
+		{
+			MethodName: "GetLockDiagnostics",
+			Handler:    _TabletManager_GetLockDiagnostics_Handler,
+		},
+		{
+			MethodName: "GetQueryPlanStats",
+			Handler:    _TabletManager_GetQueryPlanStats_Handler,
+		},
+		{
+			MethodName: "GetQueryBlocklist",
+			Handler:    _TabletManager_GetQueryBlocklist_Handler,
+		},
+		{
+			MethodName: "UpdateQueryBlocklist",
+			Handler:    _TabletManager_UpdateQueryBlocklist_Handler,
+		},
+		{
+			MethodName: "SetRuntimeFlags",
+			Handler:    _TabletManager_SetRuntimeFlags_Handler,
+		},
 		{
 			MethodName: "RunHealthCheck",
 			Handler:    _TabletManager_RunHealthCheck_Handler,
@@ -752,10 +752,16 @@ type Keyspace struct {
 	// snapshot_time (in UTC) is a property of snapshot
 	// keyspaces which tells us what point in time
 	// the snapshot is of
-	SnapshotTime         *vttime.Time `protobuf:"bytes,7,opt,name=snapshot_time,json=snapshotTime,proto3" json:"snapshot_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	SnapshotTime *vttime.Time `protobuf:"bytes,7,opt,name=snapshot_time,json=snapshotTime,proto3" json:"snapshot_time,omitempty"`
+	// durability_policy is the name of the policy that decides which
+	// replicas ack the transactions of the masters of the keyspace
+	// with semi-sync, and how many acks they wait for. See
+	// go/vt/topotools/durability.go for the supported values. Empty
+	// means vttablet follows its -enable_semi_sync flag.
+	DurabilityPolicy     string   `protobuf:"bytes,8,opt,name=durability_policy,json=durabilityPolicy,proto3" json:"durability_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Keyspace) Reset()         { *m = Keyspace{} }
@@ -825,6 +831,13 @@ func (m *Keyspace) GetSnapshotTime() *vttime.Time {
 	return nil
 }
 
+func (m *Keyspace) GetDurabilityPolicy() string {
+	if m != nil {
+		return m.DurabilityPolicy
+	}
+	return ""
+}
+
 // ServedFrom indicates a relationship between a TabletType and the
 // keyspace name that's serving it.
 type Keyspace_ServedFrom struct {
@@ -1376,90 +1389,91 @@ func init() {
 func init() { proto.RegisterFile("topodata.proto", fileDescriptor_52c350cb619f972e) }
 
 var fileDescriptor_52c350cb619f972e = []byte{
-	// 1349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x0f, 0xf5, 0xcf, 0xd4, 0x88, 0x92, 0x99, 0x8d, 0x63, 0x10, 0xfa, 0xbe, 0xa0, 0x86, 0x8a,
-	0xa0, 0x82, 0x8b, 0xca, 0xad, 0x93, 0xb4, 0x46, 0x8a, 0x02, 0x51, 0x64, 0xa5, 0x71, 0x6c, 0xcb,
-	0xc2, 0x4a, 0x46, 0x9b, 0x5e, 0x08, 0x5a, 0x5a, 0x3b, 0x84, 0x25, 0x52, 0xd9, 0x5d, 0x0b, 0x50,
-	0x5f, 0xa1, 0x87, 0xf6, 0xdc, 0x37, 0xe8, 0xfb, 0xf4, 0xd8, 0x4b, 0xfb, 0x1c, 0x3d, 0x14, 0x3b,
-	0x4b, 0x52, 0x94, 0x14, 0xa7, 0x4e, 0xe1, 0xdb, 0xcc, 0xec, 0xcc, 0x70, 0xe6, 0xb7, 0xbf, 0x99,
-	0x95, 0xa0, 0x22, 0xc3, 0x49, 0x38, 0xf4, 0xa4, 0xd7, 0x98, 0xf0, 0x50, 0x86, 0xc4, 0x8c, 0xf5,
-	0x2a, 0x48, 0x7f, 0xcc, 0xb4, 0xb5, 0xb6, 0x0b, 0xe6, 0x21, 0x9b, 0x51, 0x2f, 0xb8, 0x60, 0x64,
-	0x03, 0xf2, 0x42, 0x7a, 0x5c, 0x3a, 0xc6, 0x96, 0x51, 0xb7, 0xa8, 0x56, 0x88, 0x0d, 0x59, 0x16,
-	0x0c, 0x9d, 0x0c, 0xda, 0x94, 0x58, 0x7b, 0x04, 0xa5, 0xbe, 0x77, 0x36, 0x62, 0xb2, 0x39, 0xf2,
-	0x3d, 0x41, 0x08, 0xe4, 0x06, 0x6c, 0x34, 0xc2, 0xa8, 0x22, 0x45, 0x59, 0x05, 0x5d, 0xf9, 0x3a,
-	0xa8, 0x4c, 0x95, 0x58, 0xfb, 0x3b, 0x07, 0x05, 0x1d, 0x45, 0x3e, 0x85, 0xbc, 0xa7, 0x22, 0x31,
-	0xa2, 0xb4, 0x7b, 0xbf, 0x91, 0x54, 0x9a, 0x4a, 0x4b, 0xb5, 0x0f, 0xa9, 0x82, 0xf9, 0x26, 0x14,
-	0x32, 0xf0, 0xc6, 0x0c, 0xd3, 0x15, 0x69, 0xa2, 0x93, 0x3d, 0x30, 0x27, 0x21, 0x97, 0xee, 0xd8,
-	0x9b, 0x38, 0xb9, 0xad, 0x6c, 0xbd, 0xb4, 0xfb, 0x60, 0x39, 0x57, 0xa3, 0x1b, 0x72, 0x79, 0xec,
-	0x4d, 0xda, 0x81, 0xe4, 0x33, 0xba, 0x36, 0xd1, 0x9a, 0xca, 0x7a, 0xc9, 0x66, 0x62, 0xe2, 0x0d,
-	0x98, 0x93, 0xd7, 0x59, 0x63, 0x1d, 0x61, 0x78, 0xe3, 0xf1, 0xa1, 0x53, 0xc0, 0x03, 0xad, 0x90,
-	0x1d, 0x28, 0x5e, 0xb2, 0x99, 0xcb, 0x15, 0x52, 0xce, 0x1a, 0x16, 0x4e, 0xe6, 0x1f, 0x8b, 0x31,
-	0xc4, 0x34, 0x1a, 0xcd, 0x3a, 0xe4, 0xe4, 0x6c, 0xc2, 0x1c, 0x73, 0xcb, 0xa8, 0x57, 0x76, 0x37,
-	0x96, 0x0b, 0xeb, 0xcf, 0x26, 0x8c, 0xa2, 0x07, 0xa9, 0x83, 0x3d, 0x3c, 0x73, 0x55, 0x47, 0x6e,
-	0x38, 0x65, 0x9c, 0xfb, 0x43, 0xe6, 0x14, 0xf1, 0xdb, 0x95, 0xe1, 0x59, 0xc7, 0x1b, 0xb3, 0x93,
-	0xc8, 0x4a, 0x1a, 0x90, 0x93, 0xde, 0x85, 0x70, 0x00, 0x9b, 0xad, 0xae, 0x34, 0xdb, 0xf7, 0x2e,
-	0x84, 0xee, 0x14, 0xfd, 0xc8, 0x43, 0xa8, 0x8c, 0x67, 0xe2, 0xed, 0xc8, 0x4d, 0x20, 0xb4, 0x30,
-	0x6f, 0x19, 0xad, 0x2f, 0x63, 0x1c, 0x1f, 0x00, 0x68, 0x37, 0x05, 0x8f, 0x53, 0xde, 0x32, 0xea,
-	0x79, 0x5a, 0x44, 0x8b, 0x42, 0x8f, 0x34, 0x61, 0x73, 0xec, 0x09, 0xc9, 0xb8, 0x2b, 0x19, 0x1f,
-	0xbb, 0x48, 0x0b, 0x57, 0x71, 0xc8, 0xa9, 0x20, 0x0e, 0x56, 0x63, 0x2a, 0x91, 0x52, 0x7d, 0x7f,
-	0xcc, 0xe8, 0x3d, 0xed, 0xdb, 0x67, 0x7c, 0xdc, 0x53, 0x9e, 0xca, 0x58, 0x7d, 0x0a, 0x56, 0xfa,
-	0x22, 0x14, 0x3f, 0x2e, 0xd9, 0x2c, 0xa2, 0x8c, 0x12, 0x15, 0xea, 0x53, 0x6f, 0x74, 0xa5, 0x2f,
-	0x39, 0x4f, 0xb5, 0xf2, 0x34, 0xb3, 0x67, 0x54, 0xbf, 0x82, 0x62, 0xd2, 0xd7, 0xbf, 0x05, 0x16,
-	0x53, 0x81, 0xaf, 0x72, 0x66, 0xd6, 0xce, 0xbd, 0xca, 0x99, 0x25, 0xdb, 0xaa, 0xfd, 0x5e, 0x80,
-	0x7c, 0x0f, 0x2f, 0x72, 0x0f, 0xac, 0xa8, 0x9b, 0x1b, 0x90, 0xb0, 0xa4, 0x5d, 0x35, 0xd1, 0xaf,
-	0xc7, 0xc1, 0xbc, 0x21, 0x0e, 0x8b, 0x2c, 0xca, 0xdc, 0x80, 0x45, 0xdf, 0x80, 0x25, 0x18, 0x9f,
-	0xb2, 0xa1, 0xab, 0xa8, 0x22, 0x9c, 0xec, 0xf2, 0xcd, 0x63, 0x53, 0x8d, 0x1e, 0xfa, 0x20, 0xa7,
-	0x4a, 0x22, 0x91, 0x05, 0x79, 0x06, 0x65, 0x11, 0x5e, 0xf1, 0x01, 0x73, 0x91, 0xc5, 0x22, 0x1a,
-	0x93, 0xff, 0xad, 0xc4, 0xa3, 0x13, 0xca, 0xd4, 0x12, 0x73, 0x45, 0x90, 0x17, 0xb0, 0x2e, 0x11,
-	0x10, 0x77, 0x10, 0x06, 0x92, 0x87, 0x23, 0xe1, 0x14, 0x96, 0x47, 0x4d, 0xe7, 0xd0, 0xb8, 0xb5,
-	0xb4, 0x17, 0xad, 0xc8, 0xb4, 0x2a, 0xc8, 0x36, 0xdc, 0xf5, 0x85, 0x1b, 0xe1, 0xa7, 0x4a, 0xf4,
-	0x83, 0x0b, 0x9c, 0x23, 0x93, 0xae, 0xfb, 0xe2, 0x18, 0xed, 0x3d, 0x6d, 0xae, 0xbe, 0x06, 0x98,
-	0x37, 0x44, 0x9e, 0x40, 0x29, 0xaa, 0x00, 0xe7, 0xc9, 0x78, 0xcf, 0x3c, 0x81, 0x4c, 0x64, 0xc5,
-	0x0b, 0xb5, 0x8a, 0x84, 0x93, 0xd9, 0xca, 0x2a, 0x5e, 0xa0, 0x52, 0xfd, 0xd5, 0x80, 0x52, 0xaa,
-	0xd9, 0x78, 0x51, 0x19, 0xc9, 0xa2, 0x5a, 0x58, 0x0d, 0x99, 0xeb, 0x56, 0x43, 0xf6, 0xda, 0xd5,
-	0x90, 0xbb, 0xc1, 0xa5, 0x6e, 0x42, 0x01, 0x0b, 0x15, 0x4e, 0x1e, 0x6b, 0x8b, 0xb4, 0xea, 0x6f,
-	0x06, 0x94, 0x17, 0x50, 0xbc, 0xd5, 0xde, 0xc9, 0x67, 0x40, 0xce, 0x46, 0xde, 0xe0, 0x72, 0xe4,
-	0x0b, 0xa9, 0x08, 0xa5, 0x4b, 0xc8, 0xa1, 0xcb, 0xdd, 0xd4, 0x09, 0x26, 0x15, 0xaa, 0xca, 0x73,
-	0x1e, 0xfe, 0xc8, 0x02, 0xdc, 0x90, 0x26, 0x8d, 0xb4, 0x64, 0xac, 0xf2, 0x76, 0xa1, 0xf6, 0x47,
-	0x16, 0xdf, 0x0f, 0x8d, 0xce, 0xe7, 0xb0, 0x81, 0x80, 0xf8, 0xc1, 0x85, 0x3b, 0x08, 0x47, 0x57,
-	0xe3, 0x00, 0x97, 0x5a, 0x34, 0xac, 0x24, 0x3e, 0x6b, 0xe1, 0x91, 0xda, 0x6b, 0xe4, 0xd5, 0x6a,
-	0x04, 0xf6, 0x99, 0xc1, 0x3e, 0x9d, 0x05, 0x10, 0xf1, 0x1b, 0x07, 0x9a, 0xe3, 0x4b, 0xb9, 0xb0,
-	0xe7, 0x67, 0xc9, 0xa4, 0x9c, 0xf3, 0x70, 0x2c, 0x56, 0x1f, 0x84, 0x38, 0x47, 0x34, 0x2c, 0x2f,
-	0x78, 0x38, 0x8e, 0x87, 0x45, 0xc9, 0x82, 0x7c, 0x0d, 0xe5, 0xf8, 0xa6, 0x75, 0x19, 0x79, 0x2c,
-	0x63, 0x73, 0x35, 0x05, 0x16, 0x61, 0x5d, 0xa6, 0x34, 0xf2, 0x31, 0x94, 0xcf, 0x3c, 0xc1, 0xdc,
-	0x84, 0x3b, 0xfa, 0xf5, 0xb0, 0x94, 0x31, 0x41, 0xe8, 0x0b, 0x28, 0x8b, 0xc0, 0x9b, 0x88, 0x37,
-	0x61, 0xb4, 0x38, 0xd6, 0xde, 0xb1, 0x38, 0xac, 0xd8, 0x05, 0x37, 0xe7, 0x55, 0x3c, 0x0b, 0xaa,
-	0xc6, 0xdb, 0xe5, 0x43, 0x9a, 0xe9, 0xd9, 0x45, 0xa6, 0xeb, 0x4b, 0xae, 0xfd, 0x64, 0x80, 0xad,
-	0x97, 0x02, 0x9b, 0x8c, 0xfc, 0x81, 0x27, 0xfd, 0x30, 0x20, 0x4f, 0x20, 0x1f, 0x84, 0x43, 0xa6,
-	0x36, 0xa7, 0x42, 0xf8, 0xa3, 0xa5, 0x3d, 0x90, 0x72, 0x6d, 0x74, 0xc2, 0x21, 0xa3, 0xda, 0xbb,
-	0xfa, 0x0c, 0x72, 0x4a, 0x55, 0xfb, 0x37, 0x6a, 0xe1, 0x26, 0xfb, 0x57, 0xce, 0x95, 0xda, 0x29,
-	0x54, 0xa2, 0x2f, 0x9c, 0x33, 0xce, 0x82, 0x01, 0x53, 0x3f, 0x3d, 0x52, 0x0c, 0x43, 0xf9, 0x83,
-	0x57, 0x6c, 0xed, 0x67, 0x03, 0x08, 0xe6, 0x5d, 0x1c, 0xbd, 0xdb, 0xc8, 0x4d, 0x1e, 0xc3, 0xe6,
-	0xdb, 0x2b, 0xc6, 0x67, 0x7a, 0xe3, 0x0d, 0x98, 0x3b, 0xf4, 0x85, 0xfa, 0x8a, 0xde, 0x20, 0x26,
-	0xdd, 0xc0, 0xd3, 0x9e, 0x3e, 0xdc, 0x8f, 0xce, 0x6a, 0x7f, 0xe5, 0xa0, 0xd4, 0xe3, 0xd3, 0x84,
-	0x36, 0xdf, 0x02, 0x4c, 0x3c, 0x2e, 0x7d, 0x85, 0x69, 0x0c, 0xfb, 0x27, 0x29, 0xd8, 0xe7, 0xae,
-	0x09, 0x43, 0xbb, 0xb1, 0x3f, 0x4d, 0x85, 0x5e, 0x3b, 0xa1, 0x99, 0x0f, 0x9e, 0xd0, 0xec, 0x7f,
-	0x98, 0xd0, 0x26, 0x94, 0x52, 0x13, 0x1a, 0x0d, 0xe8, 0xd6, 0xbb, 0xfb, 0x48, 0xcd, 0x28, 0xcc,
-	0x67, 0xb4, 0xfa, 0xa7, 0x01, 0x77, 0x57, 0x5a, 0x54, 0x53, 0x91, 0x7a, 0x24, 0xdf, 0x3f, 0x15,
-	0xf3, 0xd7, 0x91, 0xb4, 0xc0, 0xc6, 0x2a, 0x5d, 0x1e, 0x13, 0x4a, 0x0f, 0x48, 0x29, 0xdd, 0xd7,
-	0x22, 0xe3, 0xe8, 0xba, 0x58, 0xd0, 0x05, 0xe9, 0xc2, 0x7d, 0x9d, 0x64, 0xf9, 0x95, 0xd4, 0x2f,
-	0xf5, 0xff, 0x97, 0x32, 0x2d, 0x3e, 0x92, 0xf7, 0xc4, 0x8a, 0x4d, 0x54, 0xdd, 0xdb, 0x98, 0xf8,
-	0xf7, 0xbc, 0x62, 0xd1, 0xea, 0x3e, 0x04, 0xb3, 0xc5, 0x46, 0xa3, 0x83, 0xe0, 0x3c, 0x54, 0xbf,
-	0x13, 0x11, 0x17, 0xee, 0x7a, 0xc3, 0x21, 0x67, 0x42, 0x44, 0xac, 0x2f, 0x6b, 0x6b, 0x53, 0x1b,
-	0xd5, 0x48, 0xf0, 0x30, 0x94, 0x51, 0x42, 0x94, 0xa3, 0x45, 0x51, 0x03, 0x50, 0xc9, 0x84, 0xfe,
-	0xa1, 0xf4, 0xce, 0x75, 0xb3, 0x5d, 0x07, 0x2b, 0xbd, 0x3f, 0x09, 0x40, 0xa1, 0x73, 0x42, 0x8f,
-	0x9b, 0x47, 0xf6, 0x1d, 0x62, 0x81, 0xd9, 0xeb, 0x34, 0xbb, 0xbd, 0x97, 0x27, 0x7d, 0xdb, 0xd8,
-	0xde, 0x85, 0xca, 0x22, 0x9d, 0x48, 0x11, 0xf2, 0xa7, 0x9d, 0x5e, 0xbb, 0x6f, 0xdf, 0x51, 0x61,
-	0xa7, 0x07, 0x9d, 0xfe, 0x97, 0x8f, 0x6d, 0x43, 0x99, 0x9f, 0xbf, 0xee, 0xb7, 0x7b, 0x76, 0x66,
-	0xfb, 0x17, 0x03, 0x60, 0x8e, 0x05, 0x29, 0xc1, 0xda, 0x69, 0xe7, 0xb0, 0x73, 0xf2, 0x5d, 0x47,
-	0x87, 0x1c, 0x37, 0x7b, 0xfd, 0x36, 0xb5, 0x0d, 0x75, 0x40, 0xdb, 0xdd, 0xa3, 0x83, 0x56, 0xd3,
-	0xce, 0xa8, 0x03, 0xba, 0x7f, 0xd2, 0x39, 0x7a, 0x6d, 0x67, 0x31, 0x57, 0xb3, 0xdf, 0x7a, 0xa9,
-	0xc5, 0x5e, 0xb7, 0x49, 0xdb, 0x76, 0x8e, 0xd8, 0x60, 0xb5, 0xbf, 0xef, 0xb6, 0xe9, 0xc1, 0x71,
-	0xbb, 0xd3, 0x6f, 0x1e, 0xd9, 0x79, 0x15, 0xf3, 0xbc, 0xd9, 0x3a, 0x3c, 0xed, 0xda, 0x05, 0x9d,
-	0xac, 0xd7, 0x3f, 0xa1, 0x6d, 0x7b, 0x4d, 0x29, 0xfb, 0xb4, 0x79, 0xd0, 0x69, 0xef, 0xdb, 0x66,
-	0x35, 0x63, 0x1b, 0xcf, 0xf7, 0x60, 0xdd, 0x0f, 0x1b, 0x53, 0x5f, 0x32, 0x21, 0xf4, 0xdf, 0xad,
-	0x1f, 0x1e, 0x46, 0x9a, 0x1f, 0xee, 0x68, 0x69, 0xe7, 0x22, 0xdc, 0x99, 0xca, 0x1d, 0x3c, 0xdd,
-	0x89, 0x2f, 0xf5, 0xac, 0x80, 0xfa, 0xa3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xab, 0xbe,
-	0xeb, 0xc4, 0x0d, 0x00, 0x00,
+	// 1362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x57, 0x5f, 0x6f, 0xe3, 0x44,
+	0x10, 0xc7, 0xf9, 0x57, 0x67, 0xe2, 0xa4, 0xee, 0x5e, 0xaf, 0xb2, 0x0c, 0x27, 0xaa, 0xa0, 0x13,
+	0xa7, 0x9e, 0x48, 0xa1, 0x77, 0x40, 0x75, 0x08, 0xa9, 0xb9, 0x34, 0x47, 0x7b, 0xbd, 0x26, 0xd1,
+	0xc6, 0x15, 0x1c, 0x2f, 0x96, 0xd3, 0xb8, 0xad, 0xd5, 0x24, 0xce, 0x79, 0x9d, 0x4a, 0xe1, 0x1b,
+	0x20, 0x1e, 0xe0, 0x99, 0x6f, 0xc0, 0xf7, 0xe1, 0x0b, 0xc0, 0xe7, 0xe0, 0x81, 0xdd, 0x59, 0xdb,
+	0x71, 0x92, 0xb6, 0xf4, 0x50, 0x1f, 0x1a, 0xed, 0xcc, 0xce, 0x8c, 0x67, 0x7e, 0x3b, 0xf3, 0xdb,
+	0x2d, 0x54, 0x42, 0x7f, 0xec, 0xf7, 0x9d, 0xd0, 0xa9, 0x8d, 0x03, 0x3f, 0xf4, 0x89, 0x1a, 0xcb,
+	0x26, 0x84, 0xde, 0xd0, 0x95, 0xda, 0xea, 0x0e, 0xa8, 0x47, 0xee, 0x94, 0x3a, 0xa3, 0x73, 0x97,
+	0xac, 0x43, 0x9e, 0x85, 0x4e, 0x10, 0x1a, 0xca, 0xa6, 0xf2, 0x44, 0xa3, 0x52, 0x20, 0x3a, 0x64,
+	0xdd, 0x51, 0xdf, 0xc8, 0xa0, 0x4e, 0x2c, 0xab, 0xcf, 0xa0, 0x64, 0x39, 0xbd, 0x81, 0x1b, 0xd6,
+	0x07, 0x9e, 0xc3, 0x08, 0x81, 0xdc, 0xa9, 0x3b, 0x18, 0xa0, 0x57, 0x91, 0xe2, 0x5a, 0x38, 0x4d,
+	0x3c, 0xe9, 0x54, 0xa6, 0x62, 0x59, 0xfd, 0x27, 0x07, 0x05, 0xe9, 0x45, 0x9e, 0x42, 0xde, 0x11,
+	0x9e, 0xe8, 0x51, 0xda, 0x79, 0x58, 0x4b, 0x32, 0x4d, 0x85, 0xa5, 0xd2, 0x86, 0x98, 0xa0, 0x5e,
+	0xf8, 0x2c, 0x1c, 0x39, 0x43, 0x17, 0xc3, 0x15, 0x69, 0x22, 0x93, 0x5d, 0x50, 0xc7, 0x7e, 0x10,
+	0xda, 0x43, 0x67, 0x6c, 0xe4, 0x36, 0xb3, 0x3c, 0xd6, 0xa3, 0xc5, 0x58, 0xb5, 0x0e, 0x37, 0x38,
+	0x76, 0xc6, 0xcd, 0x51, 0x18, 0x4c, 0xe9, 0xca, 0x58, 0x4a, 0x22, 0xea, 0xa5, 0x3b, 0x65, 0x63,
+	0xe7, 0xd4, 0x35, 0xf2, 0x32, 0x6a, 0x2c, 0x23, 0x0c, 0x17, 0x4e, 0xd0, 0x37, 0x0a, 0xb8, 0x21,
+	0x05, 0xb2, 0x0d, 0x45, 0x6e, 0x61, 0x07, 0x02, 0x29, 0x63, 0x05, 0x13, 0x27, 0xb3, 0x8f, 0xc5,
+	0x18, 0x62, 0x18, 0x89, 0xe6, 0x13, 0xc8, 0x85, 0xd3, 0xb1, 0x6b, 0xa8, 0xdc, 0xb6, 0xb2, 0xb3,
+	0xbe, 0x98, 0x98, 0xc5, 0xf7, 0x28, 0x5a, 0x70, 0x4b, 0xbd, 0xdf, 0xb3, 0x45, 0x45, 0xb6, 0x7f,
+	0xe5, 0x06, 0x81, 0xd7, 0x77, 0x8d, 0x22, 0x7e, 0xbb, 0xd2, 0xef, 0xb5, 0xb8, 0xba, 0x1d, 0x69,
+	0x49, 0x8d, 0xc7, 0x74, 0xce, 0x99, 0x01, 0x58, 0xac, 0xb9, 0x54, 0xac, 0xc5, 0x37, 0x65, 0xa5,
+	0x68, 0x47, 0x1e, 0x43, 0x65, 0x38, 0x65, 0xef, 0x06, 0x76, 0x02, 0xa1, 0x86, 0x71, 0xcb, 0xa8,
+	0x3d, 0x88, 0x71, 0x7c, 0x04, 0x20, 0xcd, 0x04, 0x3c, 0x46, 0x99, 0x9b, 0xe4, 0x69, 0x11, 0x35,
+	0x02, 0x3d, 0x52, 0x87, 0x8d, 0xa1, 0xc3, 0x42, 0x37, 0xb0, 0xf9, 0xdf, 0xd0, 0xc6, 0xb6, 0xb0,
+	0x45, 0x0f, 0x19, 0x15, 0xc4, 0x41, 0xab, 0x5d, 0x85, 0xd8, 0x52, 0x16, 0xff, 0xa1, 0x0f, 0xa4,
+	0xad, 0xc5, 0x4d, 0xbb, 0xc2, 0x52, 0x28, 0xcd, 0x17, 0xa0, 0xa5, 0x0f, 0x42, 0xf4, 0x07, 0x07,
+	0x2a, 0x6a, 0x19, 0xb1, 0x14, 0xa8, 0x5f, 0x39, 0x83, 0x89, 0x3c, 0xe4, 0x3c, 0x95, 0xc2, 0x8b,
+	0xcc, 0xae, 0x62, 0x7e, 0x0d, 0xc5, 0xa4, 0xae, 0xff, 0x72, 0x2c, 0xa6, 0x1c, 0x5f, 0xe7, 0xd4,
+	0xac, 0x9e, 0xe3, 0xbf, 0x25, 0x5d, 0xab, 0xfe, 0x59, 0x80, 0x7c, 0x17, 0x0f, 0x72, 0x17, 0xb4,
+	0xa8, 0x9a, 0x3b, 0x34, 0x61, 0x49, 0x9a, 0xca, 0x46, 0xbf, 0x19, 0x07, 0xf5, 0x8e, 0x38, 0xcc,
+	0x77, 0x51, 0xe6, 0x0e, 0x5d, 0xf4, 0x2d, 0x68, 0xcc, 0x0d, 0xae, 0xdc, 0xbe, 0x2d, 0x5a, 0x85,
+	0x19, 0xd9, 0xc5, 0x93, 0xc7, 0xa2, 0x6a, 0x5d, 0xb4, 0xc1, 0x9e, 0x2a, 0xb1, 0x64, 0xcd, 0xc8,
+	0x1e, 0x94, 0x99, 0x3f, 0x09, 0x4e, 0x5d, 0x1b, 0xbb, 0x98, 0x45, 0x63, 0xf2, 0xe1, 0x92, 0x3f,
+	0x1a, 0xe1, 0x9a, 0x6a, 0x6c, 0x26, 0x30, 0xf2, 0x0a, 0x56, 0x43, 0x04, 0xc4, 0x3e, 0xf5, 0xf9,
+	0x09, 0xf8, 0x03, 0xc6, 0xe7, 0x62, 0x61, 0xd4, 0x64, 0x0c, 0x89, 0x5b, 0x43, 0x5a, 0xd1, 0x4a,
+	0x98, 0x16, 0x19, 0xd9, 0x82, 0x35, 0x8f, 0xd9, 0x11, 0x7e, 0x22, 0x45, 0x6f, 0x74, 0x8e, 0x73,
+	0xa4, 0xd2, 0x55, 0x8f, 0x1d, 0xa3, 0xbe, 0x2b, 0xd5, 0xe6, 0x5b, 0x80, 0x59, 0x41, 0xe4, 0x4b,
+	0x28, 0x45, 0x19, 0xe0, 0x3c, 0x29, 0xb7, 0xcc, 0x13, 0x84, 0xc9, 0x5a, 0xf4, 0x85, 0xa0, 0x22,
+	0xc6, 0x61, 0xce, 0x8a, 0xbe, 0x40, 0xc1, 0xfc, 0x5d, 0x81, 0x52, 0xaa, 0xd8, 0x98, 0xa8, 0x94,
+	0x84, 0xa8, 0xe6, 0xa8, 0x21, 0x73, 0x13, 0x35, 0x64, 0x6f, 0xa4, 0x86, 0xdc, 0x1d, 0x0e, 0x75,
+	0x03, 0x0a, 0x98, 0x28, 0xe3, 0xdc, 0x23, 0x72, 0x8b, 0x24, 0xf3, 0x0f, 0x05, 0xca, 0x73, 0x28,
+	0xde, 0x6b, 0xed, 0xe4, 0x33, 0x20, 0xbd, 0x81, 0x73, 0x7a, 0x39, 0xf0, 0x38, 0xd8, 0xbc, 0xa1,
+	0x64, 0x0a, 0x39, 0x34, 0x59, 0x4b, 0xed, 0x60, 0x50, 0x26, 0xb2, 0x3c, 0x0b, 0xfc, 0x9f, 0xdc,
+	0x11, 0x32, 0xa4, 0x4a, 0x23, 0x29, 0x19, 0xab, 0xbc, 0x5e, 0xa8, 0xfe, 0x9c, 0xc3, 0xfb, 0x43,
+	0xa2, 0xf3, 0x39, 0xac, 0x23, 0x20, 0xfc, 0x08, 0x79, 0xb3, 0x0c, 0x26, 0xc3, 0x11, 0x92, 0x5a,
+	0x34, 0xac, 0x24, 0xde, 0x6b, 0xe0, 0x96, 0xe0, 0x35, 0xf2, 0x7a, 0xd9, 0x03, 0xeb, 0xcc, 0x60,
+	0x9d, 0xc6, 0x1c, 0x88, 0xf8, 0x8d, 0x43, 0xd9, 0xe3, 0x0b, 0xb1, 0xb0, 0xe6, 0xbd, 0x64, 0x52,
+	0x78, 0x9e, 0x43, 0xb6, 0x7c, 0x21, 0xc4, 0x31, 0xa2, 0x61, 0x79, 0xc5, 0xad, 0xe2, 0x61, 0x11,
+	0x6b, 0x46, 0xbe, 0x81, 0x72, 0x7c, 0xd2, 0x32, 0x8d, 0x3c, 0xa6, 0xb1, 0xb1, 0x1c, 0x02, 0x93,
+	0xd0, 0x2e, 0x53, 0x12, 0xf9, 0x04, 0xca, 0x3d, 0x87, 0xb9, 0x76, 0xd2, 0x3b, 0xf2, 0xf6, 0xd0,
+	0x84, 0x32, 0x41, 0xe8, 0x0b, 0x3e, 0x8e, 0x23, 0x67, 0xcc, 0x2e, 0xfc, 0x88, 0x38, 0x56, 0xae,
+	0x21, 0x0e, 0x2d, 0x36, 0x41, 0xc6, 0x78, 0x0a, 0x6b, 0xfd, 0x49, 0xe0, 0xf4, 0xbc, 0x81, 0x17,
+	0x4e, 0x39, 0x41, 0x0f, 0xbc, 0xd3, 0x29, 0xf2, 0x4d, 0x91, 0xea, 0xb3, 0x8d, 0x0e, 0xea, 0xcd,
+	0x49, 0x3c, 0x38, 0xa2, 0xa0, 0xfb, 0x6d, 0x9e, 0xf4, 0x58, 0x64, 0xe7, 0xc7, 0x42, 0x76, 0x44,
+	0xf5, 0x17, 0x05, 0x74, 0xc9, 0x20, 0xee, 0x98, 0x67, 0xe3, 0x84, 0x9e, 0x3f, 0xe2, 0x39, 0xe4,
+	0x47, 0x7e, 0xdf, 0x15, 0x34, 0x2b, 0x8e, 0xe3, 0xe3, 0x05, 0xd2, 0x48, 0x99, 0xd6, 0x5a, 0xdc,
+	0x8e, 0x4a, 0x6b, 0x73, 0x0f, 0x72, 0x42, 0x14, 0x64, 0x1d, 0x95, 0x70, 0x17, 0xb2, 0x0e, 0x67,
+	0x42, 0xf5, 0x04, 0x2a, 0xd1, 0x17, 0xce, 0xdc, 0xc0, 0x1d, 0x71, 0xf0, 0xf9, 0x3b, 0x25, 0xd5,
+	0x8e, 0xb8, 0x7e, 0x6f, 0x3e, 0xae, 0xfe, 0xaa, 0x00, 0xc1, 0xb8, 0xf3, 0x73, 0x7a, 0x1f, 0xb1,
+	0xc9, 0x73, 0xd8, 0x78, 0x37, 0x71, 0x83, 0xa9, 0xa4, 0x47, 0xde, 0x84, 0x7d, 0x8f, 0x89, 0xaf,
+	0x48, 0xba, 0x51, 0xe9, 0x3a, 0xee, 0x76, 0xe5, 0xe6, 0x7e, 0xb4, 0x57, 0xfd, 0x3b, 0xc7, 0x19,
+	0x2d, 0xb8, 0x4a, 0x7a, 0xec, 0x3b, 0x80, 0x31, 0xbf, 0x6e, 0x3c, 0x81, 0x69, 0x0c, 0xfb, 0xa7,
+	0x29, 0xd8, 0x67, 0xa6, 0x49, 0x3b, 0x77, 0x62, 0x7b, 0x9a, 0x72, 0xbd, 0x71, 0x9c, 0x33, 0xef,
+	0x3d, 0xce, 0xd9, 0xff, 0x31, 0xce, 0x75, 0x28, 0xa5, 0xc6, 0x39, 0x9a, 0xe6, 0xcd, 0xeb, 0xeb,
+	0x48, 0x0d, 0x34, 0xcc, 0x06, 0xda, 0xfc, 0x4b, 0x81, 0xb5, 0xa5, 0x12, 0xc5, 0x54, 0xa4, 0x6e,
+	0xd4, 0xdb, 0xa7, 0x62, 0x76, 0x95, 0x92, 0x06, 0xe8, 0x98, 0xa5, 0x1d, 0xc4, 0x0d, 0x25, 0x07,
+	0xa4, 0x94, 0xae, 0x6b, 0xbe, 0xe3, 0xe8, 0x2a, 0x9b, 0x93, 0x19, 0xe9, 0xc0, 0x43, 0x19, 0x64,
+	0xf1, 0x4a, 0x95, 0xd7, 0xfa, 0x47, 0x0b, 0x91, 0xe6, 0x6f, 0xd4, 0x07, 0x6c, 0x49, 0xc7, 0x4c,
+	0xfb, 0x3e, 0x26, 0xfe, 0x96, 0x2b, 0x2f, 0xe2, 0xf9, 0x23, 0x50, 0x1b, 0x9c, 0x06, 0x0e, 0x47,
+	0x67, 0xbe, 0x78, 0x54, 0x22, 0x2e, 0xfc, 0x01, 0xd5, 0xef, 0x07, 0x2e, 0x63, 0x51, 0xd7, 0x97,
+	0xa5, 0xb6, 0x2e, 0x95, 0x62, 0x24, 0x02, 0xdf, 0x0f, 0xa3, 0x80, 0xb8, 0x8e, 0x88, 0xa2, 0x0a,
+	0x20, 0x82, 0x31, 0xf9, 0xaa, 0xba, 0x96, 0x6e, 0xb6, 0x9e, 0x80, 0x96, 0x26, 0x5b, 0x02, 0x50,
+	0x68, 0xb5, 0xe9, 0x71, 0xfd, 0x8d, 0xfe, 0x01, 0xd1, 0x40, 0xed, 0xb6, 0xea, 0x9d, 0xee, 0x41,
+	0xdb, 0xd2, 0x95, 0xad, 0x1d, 0xa8, 0xcc, 0xb7, 0x13, 0x29, 0x42, 0xfe, 0xa4, 0xd5, 0x6d, 0x5a,
+	0xdc, 0x94, 0xbb, 0x9d, 0x1c, 0xb6, 0xac, 0xaf, 0x9e, 0xeb, 0x8a, 0x50, 0xbf, 0x7c, 0x6b, 0x35,
+	0xbb, 0x7a, 0x66, 0xeb, 0x37, 0x05, 0x60, 0x86, 0x05, 0x29, 0xc1, 0xca, 0x49, 0xeb, 0xa8, 0xd5,
+	0xfe, 0xbe, 0x25, 0x5d, 0x8e, 0xeb, 0x5d, 0xab, 0x49, 0xb9, 0x0b, 0xdf, 0xa0, 0xcd, 0xce, 0x9b,
+	0xc3, 0x46, 0x5d, 0xcf, 0x88, 0x0d, 0xba, 0xdf, 0x6e, 0xbd, 0x79, 0xab, 0x67, 0x31, 0x56, 0xdd,
+	0x6a, 0x1c, 0xc8, 0x65, 0xb7, 0x53, 0xa7, 0x4d, 0x3d, 0xc7, 0x1f, 0x13, 0x5a, 0xf3, 0x87, 0x4e,
+	0x93, 0x1e, 0x1e, 0x37, 0x5b, 0x16, 0x4f, 0x35, 0x2f, 0x7c, 0x5e, 0xd6, 0x1b, 0x47, 0x27, 0x1d,
+	0xbd, 0x20, 0x83, 0x75, 0xad, 0x36, 0x37, 0x5d, 0x11, 0xc2, 0x3e, 0xad, 0x1f, 0xb6, 0x9a, 0xfb,
+	0xba, 0x6a, 0x66, 0x74, 0xe5, 0xe5, 0x2e, 0xac, 0x7a, 0x7e, 0xed, 0xca, 0x0b, 0x39, 0x76, 0xf2,
+	0x7f, 0xb3, 0x1f, 0x1f, 0x47, 0x92, 0xe7, 0x6f, 0xcb, 0xd5, 0xf6, 0x39, 0x5f, 0x85, 0xdb, 0xb8,
+	0xbb, 0x1d, 0x1f, 0x6a, 0xaf, 0x80, 0xf2, 0xb3, 0x7f, 0x01, 0x10, 0xf9, 0x19, 0xc5, 0xf1, 0x0d,
+	0x00, 0x00,
 }
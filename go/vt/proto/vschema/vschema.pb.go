@@ -9,6 +9,7 @@ import (
 
 	proto "github.com/golang/protobuf/proto"
 	query "vitess.io/vitess/go/vt/proto/query"
+	quota "vitess.io/vitess/go/vt/proto/quota"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -497,11 +498,14 @@ func (m *Column) GetType() query.Type {
 // SrvVSchema is the roll-up of all the Keyspace schema for a cell.
 type SrvVSchema struct {
 	// keyspaces is a map of keyspace name -> Keyspace object.
-	Keyspaces            map[string]*Keyspace `protobuf:"bytes,1,rep,name=keyspaces,proto3" json:"keyspaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	RoutingRules         *RoutingRules        `protobuf:"bytes,2,opt,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Keyspaces    map[string]*Keyspace `protobuf:"bytes,1,rep,name=keyspaces,proto3" json:"keyspaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RoutingRules *RoutingRules        `protobuf:"bytes,2,opt,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
+	// quotas is a map of keyspace name -> query quotas, for the
+	// keyspaces that have quotas.
+	Quotas               map[string]*quota.Config `protobuf:"bytes,3,rep,name=quotas,proto3" json:"quotas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *SrvVSchema) Reset()         { *m = SrvVSchema{} }
@@ -543,6 +547,13 @@ func (m *SrvVSchema) GetRoutingRules() *RoutingRules {
 	return nil
 }
 
+func (m *SrvVSchema) GetQuotas() map[string]*quota.Config {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RoutingRules)(nil), "vschema.RoutingRules")
 	proto.RegisterType((*RoutingRule)(nil), "vschema.RoutingRule")
@@ -557,53 +568,56 @@ func init() {
 	proto.RegisterType((*Column)(nil), "vschema.Column")
 	proto.RegisterType((*SrvVSchema)(nil), "vschema.SrvVSchema")
 	proto.RegisterMapType((map[string]*Keyspace)(nil), "vschema.SrvVSchema.KeyspacesEntry")
+	proto.RegisterMapType((map[string]*quota.Config)(nil), "vschema.SrvVSchema.QuotasEntry")
 }
 
 func init() { proto.RegisterFile("vschema.proto", fileDescriptor_3f6849254fea3e77) }
 
 var fileDescriptor_3f6849254fea3e77 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0x96, 0x13, 0x62, 0x92, 0x31, 0x09, 0xbf, 0xdf, 0x0a, 0xa8, 0x1b, 0x84, 0x88, 0x2c, 0xda,
-	0xa6, 0x3d, 0x24, 0x52, 0x50, 0x25, 0x9a, 0x8a, 0xaa, 0x14, 0x71, 0x40, 0x45, 0x6a, 0x65, 0x10,
-	0x87, 0x5e, 0x2c, 0xe3, 0x6c, 0x61, 0x45, 0xe2, 0x35, 0xbb, 0x6b, 0x97, 0x3c, 0x4a, 0xaf, 0x7d,
-	0xad, 0x3e, 0x42, 0x5f, 0xa2, 0xf2, 0xfe, 0x31, 0x1b, 0x48, 0x6f, 0x3b, 0x3b, 0xf3, 0x7d, 0xf3,
-	0xed, 0xec, 0xcc, 0x40, 0xbb, 0xe0, 0xc9, 0x0d, 0x9e, 0xc5, 0x83, 0x8c, 0x51, 0x41, 0xd1, 0xaa,
-	0x36, 0xbb, 0xde, 0x5d, 0x8e, 0xd9, 0x5c, 0xdd, 0x06, 0x63, 0x58, 0x0b, 0x69, 0x2e, 0x48, 0x7a,
-	0x1d, 0xe6, 0x53, 0xcc, 0xd1, 0x1b, 0x68, 0xb0, 0xf2, 0xe0, 0x3b, 0xbd, 0x7a, 0xdf, 0x1b, 0x6d,
-	0x0c, 0x0c, 0x89, 0x15, 0x15, 0xaa, 0x90, 0xe0, 0x14, 0x3c, 0xeb, 0x16, 0xed, 0x00, 0x7c, 0x67,
-	0x74, 0x16, 0x89, 0xf8, 0x6a, 0x8a, 0x7d, 0xa7, 0xe7, 0xf4, 0x5b, 0x61, 0xab, 0xbc, 0xb9, 0x28,
-	0x2f, 0xd0, 0x36, 0xb4, 0x04, 0x55, 0x4e, 0xee, 0xd7, 0x7a, 0xf5, 0x7e, 0x2b, 0x6c, 0x0a, 0x2a,
-	0x7d, 0x3c, 0xf8, 0x53, 0x83, 0xe6, 0x67, 0x3c, 0xe7, 0x59, 0x9c, 0x60, 0xe4, 0xc3, 0x2a, 0xbf,
-	0x89, 0xd9, 0x04, 0x4f, 0x24, 0x4b, 0x33, 0x34, 0x26, 0x7a, 0x0f, 0xcd, 0x82, 0xa4, 0x13, 0x7c,
-	0xaf, 0x29, 0xbc, 0xd1, 0x6e, 0x25, 0xd0, 0xc0, 0x07, 0x97, 0x3a, 0xe2, 0x24, 0x15, 0x6c, 0x1e,
-	0x56, 0x00, 0xf4, 0x16, 0x5c, 0x9d, 0xbd, 0x2e, 0xa1, 0x3b, 0x4f, 0xa1, 0x4a, 0x8d, 0x02, 0xea,
-	0x60, 0x74, 0x00, 0x3e, 0xc3, 0x77, 0x39, 0x61, 0x38, 0xc2, 0xf7, 0xd9, 0x94, 0x24, 0x44, 0x44,
-	0x4c, 0x3d, 0xdb, 0x5f, 0x91, 0xf2, 0xb6, 0xb4, 0xff, 0x44, 0xbb, 0x75, 0x51, 0xba, 0x67, 0xd0,
-	0x5e, 0xd0, 0x82, 0xfe, 0x83, 0xfa, 0x2d, 0x9e, 0xeb, 0xd2, 0x94, 0x47, 0xf4, 0x02, 0x1a, 0x45,
-	0x3c, 0xcd, 0xb1, 0x5f, 0xeb, 0x39, 0x7d, 0x6f, 0xb4, 0x5e, 0x49, 0x52, 0xc0, 0x50, 0x79, 0xc7,
-	0xb5, 0x03, 0xa7, 0x7b, 0x0a, 0x9e, 0x25, 0x6f, 0x09, 0xd7, 0xde, 0x22, 0x57, 0xa7, 0xe2, 0x92,
-	0x30, 0x8b, 0x2a, 0xf8, 0xe5, 0x80, 0xab, 0x12, 0x20, 0x04, 0x2b, 0x62, 0x9e, 0x99, 0xef, 0x92,
-	0x67, 0xb4, 0x0f, 0x6e, 0x16, 0xb3, 0x78, 0x66, 0x6a, 0xbc, 0xfd, 0x48, 0xd5, 0xe0, 0xab, 0xf4,
-	0xea, 0x32, 0xa9, 0x50, 0xb4, 0x01, 0x0d, 0xfa, 0x23, 0xc5, 0xcc, 0xaf, 0x4b, 0x26, 0x65, 0x74,
-	0xdf, 0x81, 0x67, 0x05, 0x2f, 0x11, 0xbd, 0x61, 0x8b, 0x6e, 0xd9, 0x22, 0x7f, 0xd6, 0xa0, 0xa1,
-	0x3a, 0x67, 0x99, 0xc6, 0x0f, 0xb0, 0x9e, 0xd0, 0x69, 0x3e, 0x4b, 0xa3, 0x47, 0x0d, 0xb1, 0x59,
-	0x89, 0x3d, 0x96, 0x7e, 0x5d, 0xc8, 0x4e, 0x62, 0x59, 0x98, 0xa3, 0x43, 0xe8, 0xc4, 0xb9, 0xa0,
-	0x11, 0x49, 0x13, 0x86, 0x67, 0x38, 0x15, 0x52, 0xb7, 0x37, 0xda, 0xaa, 0xe0, 0x47, 0xb9, 0xa0,
-	0xa7, 0xc6, 0x1b, 0xb6, 0x63, 0xdb, 0x44, 0xaf, 0x61, 0x55, 0x11, 0x72, 0x7f, 0x45, 0xa6, 0x5d,
-	0x7f, 0x94, 0x36, 0x34, 0x7e, 0xb4, 0x05, 0x6e, 0x46, 0xd2, 0x14, 0x4f, 0xfc, 0x86, 0xd4, 0xaf,
-	0x2d, 0x34, 0x86, 0xe7, 0xfa, 0x05, 0x53, 0xc2, 0x45, 0x14, 0xe7, 0xe2, 0x86, 0x32, 0x22, 0x62,
-	0x41, 0x0a, 0xec, 0xbb, 0xb2, 0xb1, 0x9e, 0xa9, 0x80, 0x33, 0xc2, 0xc5, 0x91, 0xed, 0x0e, 0x2e,
-	0x60, 0xcd, 0x7e, 0x5d, 0x99, 0x43, 0x85, 0xea, 0x1a, 0x69, 0xab, 0xac, 0x5c, 0x1a, 0xcf, 0x4c,
-	0x71, 0xe5, 0xb9, 0x9c, 0x2e, 0x23, 0xbd, 0x2e, 0xa7, 0xd0, 0x98, 0xc1, 0x31, 0xb4, 0x17, 0x1e,
-	0xfd, 0x4f, 0xda, 0x2e, 0x34, 0x39, 0xbe, 0xcb, 0x71, 0x9a, 0x18, 0xea, 0xca, 0x0e, 0x0e, 0xc1,
-	0x3d, 0x5e, 0x4c, 0xee, 0x58, 0xc9, 0x77, 0xf5, 0x57, 0x96, 0xa8, 0xce, 0xc8, 0x1b, 0xa8, 0x55,
-	0x74, 0x31, 0xcf, 0xb0, 0xfa, 0xd7, 0xe0, 0xb7, 0x03, 0x70, 0xce, 0x8a, 0xcb, 0x73, 0x59, 0x4c,
-	0xf4, 0x11, 0x5a, 0xb7, 0x7a, 0x38, 0xcd, 0x4a, 0x0a, 0xaa, 0x4a, 0x3f, 0xc4, 0x55, 0x13, 0xac,
-	0x9b, 0xf2, 0x01, 0x84, 0xc6, 0xd0, 0xd6, 0xd3, 0x1a, 0xa9, 0xc5, 0xa6, 0xa6, 0x63, 0x73, 0xd9,
-	0x62, 0xe3, 0xe1, 0x1a, 0xb3, 0xac, 0xee, 0x17, 0xe8, 0x2c, 0x12, 0x2f, 0x69, 0xe0, 0x57, 0x8b,
-	0x53, 0xf7, 0xff, 0x93, 0xa5, 0x62, 0xf5, 0xf4, 0xa7, 0x97, 0xdf, 0xf6, 0x0a, 0x22, 0x30, 0xe7,
-	0x03, 0x42, 0x87, 0xea, 0x34, 0xbc, 0xa6, 0xc3, 0x42, 0x0c, 0xe5, 0x36, 0x1e, 0x6a, 0xec, 0x95,
-	0x2b, 0xcd, 0xfd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xa7, 0x99, 0x19, 0xc3, 0x05, 0x00,
-	0x00,
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x75, 0x55, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0x56, 0x12, 0x62, 0x92, 0x31, 0x09, 0xed, 0x2a, 0xa4, 0x69, 0x10, 0xa2, 0x72, 0x69, 0x4b,
+	0x7b, 0x70, 0xa4, 0xa0, 0xaa, 0x94, 0x8a, 0xaa, 0x34, 0x42, 0x2a, 0x2a, 0x52, 0x5b, 0x13, 0x71,
+	0xe0, 0x12, 0x19, 0x67, 0x81, 0x15, 0x89, 0x1d, 0xd6, 0xeb, 0x94, 0x3c, 0x4a, 0xaf, 0x7d, 0xb0,
+	0xbe, 0x40, 0x5f, 0xa2, 0xeb, 0xfd, 0x31, 0x6b, 0x70, 0x6f, 0x3b, 0x9e, 0x99, 0x6f, 0xbe, 0xfd,
+	0x76, 0x66, 0x0c, 0x8d, 0x79, 0x1c, 0x5c, 0xe1, 0xa9, 0xef, 0xce, 0x68, 0xc4, 0x22, 0xb4, 0xac,
+	0xcc, 0xae, 0x7d, 0x93, 0x60, 0xba, 0x90, 0x5f, 0x53, 0x23, 0x62, 0x2a, 0xc4, 0xd9, 0x83, 0x15,
+	0x2f, 0x4a, 0x18, 0x09, 0x2f, 0xbd, 0x64, 0x82, 0x63, 0xf4, 0x06, 0xaa, 0x34, 0x3d, 0x74, 0x4a,
+	0xcf, 0x2a, 0xdb, 0x76, 0xbf, 0xe5, 0x6a, 0x44, 0x23, 0xca, 0x93, 0x21, 0xce, 0x11, 0xd8, 0xc6,
+	0x57, 0xb4, 0x01, 0x70, 0x41, 0xa3, 0xe9, 0x88, 0xf9, 0xe7, 0x13, 0xcc, 0xf3, 0x4b, 0xdb, 0x75,
+	0xaf, 0x9e, 0x7e, 0x19, 0xa6, 0x1f, 0xd0, 0x3a, 0xd4, 0x59, 0x24, 0x9d, 0x71, 0xa7, 0xcc, 0xd1,
+	0xeb, 0x5e, 0x8d, 0x45, 0xc2, 0x17, 0x3b, 0x7f, 0xcb, 0x50, 0xfb, 0x8a, 0x17, 0xf1, 0xcc, 0x0f,
+	0x30, 0xea, 0xc0, 0x72, 0x7c, 0xe5, 0xd3, 0x31, 0x1e, 0x0b, 0x94, 0x9a, 0xa7, 0x4d, 0xf4, 0x01,
+	0x6a, 0x73, 0x12, 0x8e, 0xf1, 0xad, 0x82, 0xb0, 0xfb, 0x9b, 0x19, 0x41, 0x9d, 0xee, 0x9e, 0xaa,
+	0x88, 0xc3, 0x90, 0xd1, 0x85, 0x97, 0x25, 0xa0, 0xb7, 0x60, 0xa9, 0xea, 0x15, 0x91, 0xba, 0xf1,
+	0x30, 0x55, 0xb2, 0x91, 0x89, 0x2a, 0x18, 0xed, 0x42, 0x87, 0xe2, 0x9b, 0x84, 0x50, 0x3c, 0xc2,
+	0xb7, 0xb3, 0x09, 0x09, 0x08, 0x1b, 0x51, 0x79, 0xed, 0xce, 0x92, 0xa0, 0xd7, 0x56, 0xfe, 0x43,
+	0xe5, 0x56, 0xa2, 0x74, 0x8f, 0xa1, 0x91, 0xe3, 0x82, 0x1e, 0x41, 0xe5, 0x1a, 0x2f, 0x94, 0x34,
+	0xe9, 0x11, 0xbd, 0x80, 0xea, 0xdc, 0x9f, 0x24, 0x98, 0xdf, 0xa6, 0xc4, 0x29, 0xad, 0x66, 0x94,
+	0x64, 0xa2, 0x27, 0xbd, 0x7b, 0xe5, 0xdd, 0x52, 0x97, 0xab, 0x6d, 0xd0, 0x2b, 0xc0, 0xda, 0xca,
+	0x63, 0x35, 0x33, 0x2c, 0x91, 0x66, 0x40, 0x39, 0xbf, 0x4b, 0x60, 0xc9, 0x02, 0x08, 0xc1, 0x12,
+	0x5b, 0xcc, 0xf4, 0x73, 0x89, 0x33, 0xda, 0x01, 0x6b, 0xe6, 0x53, 0x7f, 0xaa, 0x35, 0x5e, 0xbf,
+	0xc7, 0xca, 0xfd, 0x2e, 0xbc, 0x4a, 0x26, 0x19, 0x8a, 0x5a, 0x50, 0x8d, 0x7e, 0x86, 0x98, 0x72,
+	0x71, 0x53, 0x24, 0x69, 0x74, 0xdf, 0x83, 0x6d, 0x04, 0x17, 0x90, 0x6e, 0x99, 0xa4, 0xeb, 0x26,
+	0xc9, 0x5f, 0x65, 0xa8, 0xca, 0xce, 0x29, 0xe2, 0xf8, 0x11, 0x56, 0x83, 0x68, 0x92, 0x4c, 0xc3,
+	0xd1, 0xbd, 0x86, 0x58, 0xcb, 0xc8, 0x0e, 0x84, 0x5f, 0x09, 0xd9, 0x0c, 0x0c, 0x8b, 0xbf, 0xea,
+	0x3e, 0x34, 0xfd, 0x84, 0xf7, 0x23, 0x09, 0x03, 0x8a, 0xa7, 0x38, 0x64, 0x82, 0xb7, 0xdd, 0x6f,
+	0x67, 0xe9, 0x07, 0xdc, 0x7d, 0xa4, 0xbd, 0x5e, 0xc3, 0x37, 0x4d, 0xf4, 0x1a, 0x96, 0x25, 0x60,
+	0xcc, 0x7b, 0xa0, 0x92, 0x7b, 0x39, 0x59, 0xd6, 0xd3, 0x7e, 0xd4, 0xe6, 0x6a, 0x92, 0x30, 0xe4,
+	0xcd, 0x5c, 0x15, 0xfc, 0x95, 0x85, 0xf6, 0xe0, 0xa9, 0xba, 0xc1, 0x84, 0xc4, 0x6c, 0xc4, 0xf1,
+	0xaf, 0x22, 0x4a, 0x98, 0xcf, 0xc8, 0x1c, 0x77, 0x2c, 0xd1, 0x58, 0x4f, 0x64, 0xc0, 0x31, 0xf7,
+	0x1f, 0x98, 0x6e, 0x67, 0x08, 0x2b, 0xe6, 0xed, 0xd2, 0x1a, 0x32, 0x54, 0x69, 0xa4, 0xac, 0x54,
+	0xb9, 0xd0, 0x9f, 0x6a, 0x71, 0xc5, 0x39, 0x9d, 0x2e, 0x4d, 0xbd, 0x22, 0xa6, 0x50, 0x9b, 0xce,
+	0x00, 0x1a, 0xb9, 0x4b, 0xff, 0x17, 0xb6, 0x0b, 0xb5, 0x98, 0xb7, 0x3c, 0x0e, 0x03, 0x0d, 0x9d,
+	0xd9, 0xce, 0x3e, 0x58, 0x83, 0x7c, 0xf1, 0x92, 0x51, 0x7c, 0x53, 0x3d, 0x65, 0x9a, 0xd5, 0xec,
+	0xdb, 0xae, 0xdc, 0x4b, 0x43, 0xfe, 0x49, 0xbe, 0xab, 0xf3, 0xa7, 0x0c, 0x70, 0x42, 0xe7, 0xa7,
+	0x27, 0x42, 0x4c, 0xf4, 0x09, 0xea, 0xd7, 0x6a, 0x38, 0xf5, 0x4a, 0x72, 0x32, 0xa5, 0xef, 0xe2,
+	0xb2, 0x09, 0x56, 0x4d, 0x79, 0x97, 0xc4, 0x65, 0x6e, 0xa8, 0x69, 0x1d, 0xc9, 0xc5, 0x26, 0xa7,
+	0x63, 0xad, 0x68, 0xb1, 0xc5, 0xde, 0x0a, 0x35, 0x97, 0xe1, 0x3b, 0xb0, 0xc4, 0xae, 0xd4, 0x1b,
+	0x63, 0xb3, 0xa8, 0xf4, 0x0f, 0x11, 0xa1, 0x86, 0x41, 0x86, 0x77, 0xbf, 0x41, 0x33, 0xcf, 0xa8,
+	0xa0, 0xf3, 0x5f, 0xe5, 0xc7, 0xf5, 0xf1, 0x83, 0x6d, 0x64, 0x0e, 0xff, 0x17, 0xb0, 0x8d, 0x3a,
+	0x05, 0x68, 0xcf, 0xf3, 0x68, 0x0d, 0x57, 0x2e, 0xf9, 0x41, 0x14, 0x5e, 0x90, 0x4b, 0x03, 0xe9,
+	0xf3, 0xcb, 0xb3, 0xad, 0x39, 0x61, 0x38, 0x8e, 0x5d, 0x12, 0xf5, 0xe4, 0xa9, 0x77, 0xc9, 0x4f,
+	0xac, 0x27, 0x7e, 0x08, 0x3d, 0xc5, 0xe2, 0xdc, 0x12, 0xe6, 0xce, 0x3f, 0x98, 0xa3, 0xdd, 0x92,
+	0x53, 0x06, 0x00, 0x00,
 }
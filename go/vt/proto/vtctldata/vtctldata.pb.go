@@ -9,6 +9,8 @@ import (
 
 	proto "github.com/golang/protobuf/proto"
 	logutil "vitess.io/vitess/go/vt/proto/logutil"
+	topodata "vitess.io/vitess/go/vt/proto/topodata"
+	vschema "vitess.io/vitess/go/vt/proto/vschema"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -111,26 +113,1890 @@ func (m *ExecuteVtctlCommandResponse) GetEvent() *logutil.Event {
 	return nil
 }
 
+// Keyspace is a keyspace record with its name.
+type Keyspace struct {
+	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Keyspace             *topodata.Keyspace `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Keyspace) Reset()         { *m = Keyspace{} }
+func (m *Keyspace) String() string { return proto.CompactTextString(m) }
+func (*Keyspace) ProtoMessage()    {}
+func (*Keyspace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{2}
+}
+
+func (m *Keyspace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Keyspace.Unmarshal(m, b)
+}
+func (m *Keyspace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Keyspace.Marshal(b, m, deterministic)
+}
+func (m *Keyspace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Keyspace.Merge(m, src)
+}
+func (m *Keyspace) XXX_Size() int {
+	return xxx_messageInfo_Keyspace.Size(m)
+}
+func (m *Keyspace) XXX_DiscardUnknown() {
+	xxx_messageInfo_Keyspace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Keyspace proto.InternalMessageInfo
+
+func (m *Keyspace) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Keyspace) GetKeyspace() *topodata.Keyspace {
+	if m != nil {
+		return m.Keyspace
+	}
+	return nil
+}
+
+// Shard is a shard record with its keyspace and its name.
+type Shard struct {
+	Keyspace             string          `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Name                 string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Shard                *topodata.Shard `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Shard) Reset()         { *m = Shard{} }
+func (m *Shard) String() string { return proto.CompactTextString(m) }
+func (*Shard) ProtoMessage()    {}
+func (*Shard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{3}
+}
+
+func (m *Shard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Shard.Unmarshal(m, b)
+}
+func (m *Shard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Shard.Marshal(b, m, deterministic)
+}
+func (m *Shard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Shard.Merge(m, src)
+}
+func (m *Shard) XXX_Size() int {
+	return xxx_messageInfo_Shard.Size(m)
+}
+func (m *Shard) XXX_DiscardUnknown() {
+	xxx_messageInfo_Shard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Shard proto.InternalMessageInfo
+
+func (m *Shard) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *Shard) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Shard) GetShard() *topodata.Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+type GetKeyspacesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetKeyspacesRequest) Reset()         { *m = GetKeyspacesRequest{} }
+func (m *GetKeyspacesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKeyspacesRequest) ProtoMessage()    {}
+func (*GetKeyspacesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{4}
+}
+
+func (m *GetKeyspacesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeyspacesRequest.Unmarshal(m, b)
+}
+func (m *GetKeyspacesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeyspacesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetKeyspacesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeyspacesRequest.Merge(m, src)
+}
+func (m *GetKeyspacesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetKeyspacesRequest.Size(m)
+}
+func (m *GetKeyspacesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeyspacesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeyspacesRequest proto.InternalMessageInfo
+
+type GetKeyspacesResponse struct {
+	Keyspaces            []*Keyspace `protobuf:"bytes,1,rep,name=keyspaces,proto3" json:"keyspaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetKeyspacesResponse) Reset()         { *m = GetKeyspacesResponse{} }
+func (m *GetKeyspacesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetKeyspacesResponse) ProtoMessage()    {}
+func (*GetKeyspacesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{5}
+}
+
+func (m *GetKeyspacesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeyspacesResponse.Unmarshal(m, b)
+}
+func (m *GetKeyspacesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeyspacesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetKeyspacesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeyspacesResponse.Merge(m, src)
+}
+func (m *GetKeyspacesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetKeyspacesResponse.Size(m)
+}
+func (m *GetKeyspacesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeyspacesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeyspacesResponse proto.InternalMessageInfo
+
+func (m *GetKeyspacesResponse) GetKeyspaces() []*Keyspace {
+	if m != nil {
+		return m.Keyspaces
+	}
+	return nil
+}
+
+type GetKeyspaceRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetKeyspaceRequest) Reset()         { *m = GetKeyspaceRequest{} }
+func (m *GetKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKeyspaceRequest) ProtoMessage()    {}
+func (*GetKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{6}
+}
+
+func (m *GetKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *GetKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeyspaceRequest.Merge(m, src)
+}
+func (m *GetKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetKeyspaceRequest.Size(m)
+}
+func (m *GetKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeyspaceRequest proto.InternalMessageInfo
+
+func (m *GetKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type GetKeyspaceResponse struct {
+	Keyspace             *Keyspace `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetKeyspaceResponse) Reset()         { *m = GetKeyspaceResponse{} }
+func (m *GetKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetKeyspaceResponse) ProtoMessage()    {}
+func (*GetKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{7}
+}
+
+func (m *GetKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *GetKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *GetKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKeyspaceResponse.Merge(m, src)
+}
+func (m *GetKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_GetKeyspaceResponse.Size(m)
+}
+func (m *GetKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetKeyspaceResponse proto.InternalMessageInfo
+
+func (m *GetKeyspaceResponse) GetKeyspace() *Keyspace {
+	if m != nil {
+		return m.Keyspace
+	}
+	return nil
+}
+
+type CreateKeyspaceRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// force succeeds even if the keyspace already exists.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// allow_empty_v_schema doesn't create an empty vschema for the keyspace.
+	AllowEmptyVSchema    bool                    `protobuf:"varint,3,opt,name=allow_empty_v_schema,json=allowEmptyVSchema,proto3" json:"allow_empty_v_schema,omitempty"`
+	ShardingColumnName   string                  `protobuf:"bytes,4,opt,name=sharding_column_name,json=shardingColumnName,proto3" json:"sharding_column_name,omitempty"`
+	ShardingColumnType   topodata.KeyspaceIdType `protobuf:"varint,5,opt,name=sharding_column_type,json=shardingColumnType,proto3,enum=topodata.KeyspaceIdType" json:"sharding_column_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CreateKeyspaceRequest) Reset()         { *m = CreateKeyspaceRequest{} }
+func (m *CreateKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateKeyspaceRequest) ProtoMessage()    {}
+func (*CreateKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{8}
+}
+
+func (m *CreateKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *CreateKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateKeyspaceRequest.Merge(m, src)
+}
+func (m *CreateKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateKeyspaceRequest.Size(m)
+}
+func (m *CreateKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateKeyspaceRequest proto.InternalMessageInfo
+
+func (m *CreateKeyspaceRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateKeyspaceRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *CreateKeyspaceRequest) GetAllowEmptyVSchema() bool {
+	if m != nil {
+		return m.AllowEmptyVSchema
+	}
+	return false
+}
+
+func (m *CreateKeyspaceRequest) GetShardingColumnName() string {
+	if m != nil {
+		return m.ShardingColumnName
+	}
+	return ""
+}
+
+func (m *CreateKeyspaceRequest) GetShardingColumnType() topodata.KeyspaceIdType {
+	if m != nil {
+		return m.ShardingColumnType
+	}
+	return topodata.KeyspaceIdType_UNSET
+}
+
+type CreateKeyspaceResponse struct {
+	Keyspace             *Keyspace `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateKeyspaceResponse) Reset()         { *m = CreateKeyspaceResponse{} }
+func (m *CreateKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateKeyspaceResponse) ProtoMessage()    {}
+func (*CreateKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{9}
+}
+
+func (m *CreateKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *CreateKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateKeyspaceResponse.Merge(m, src)
+}
+func (m *CreateKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateKeyspaceResponse.Size(m)
+}
+func (m *CreateKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateKeyspaceResponse proto.InternalMessageInfo
+
+func (m *CreateKeyspaceResponse) GetKeyspace() *Keyspace {
+	if m != nil {
+		return m.Keyspace
+	}
+	return nil
+}
+
+type DeleteKeyspaceRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// recursive also deletes the shards and the tablets of the keyspace.
+	Recursive            bool     `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteKeyspaceRequest) Reset()         { *m = DeleteKeyspaceRequest{} }
+func (m *DeleteKeyspaceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteKeyspaceRequest) ProtoMessage()    {}
+func (*DeleteKeyspaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{10}
+}
+
+func (m *DeleteKeyspaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteKeyspaceRequest.Unmarshal(m, b)
+}
+func (m *DeleteKeyspaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteKeyspaceRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteKeyspaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteKeyspaceRequest.Merge(m, src)
+}
+func (m *DeleteKeyspaceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteKeyspaceRequest.Size(m)
+}
+func (m *DeleteKeyspaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteKeyspaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteKeyspaceRequest proto.InternalMessageInfo
+
+func (m *DeleteKeyspaceRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *DeleteKeyspaceRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+type DeleteKeyspaceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteKeyspaceResponse) Reset()         { *m = DeleteKeyspaceResponse{} }
+func (m *DeleteKeyspaceResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteKeyspaceResponse) ProtoMessage()    {}
+func (*DeleteKeyspaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{11}
+}
+
+func (m *DeleteKeyspaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteKeyspaceResponse.Unmarshal(m, b)
+}
+func (m *DeleteKeyspaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteKeyspaceResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteKeyspaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteKeyspaceResponse.Merge(m, src)
+}
+func (m *DeleteKeyspaceResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteKeyspaceResponse.Size(m)
+}
+func (m *DeleteKeyspaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteKeyspaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteKeyspaceResponse proto.InternalMessageInfo
+
+type GetShardRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	ShardName            string   `protobuf:"bytes,2,opt,name=shard_name,json=shardName,proto3" json:"shard_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShardRequest) Reset()         { *m = GetShardRequest{} }
+func (m *GetShardRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShardRequest) ProtoMessage()    {}
+func (*GetShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{12}
+}
+
+func (m *GetShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardRequest.Unmarshal(m, b)
+}
+func (m *GetShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardRequest.Merge(m, src)
+}
+func (m *GetShardRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShardRequest.Size(m)
+}
+func (m *GetShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardRequest proto.InternalMessageInfo
+
+func (m *GetShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GetShardRequest) GetShardName() string {
+	if m != nil {
+		return m.ShardName
+	}
+	return ""
+}
+
+type GetShardResponse struct {
+	Shard                *Shard   `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShardResponse) Reset()         { *m = GetShardResponse{} }
+func (m *GetShardResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShardResponse) ProtoMessage()    {}
+func (*GetShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{13}
+}
+
+func (m *GetShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardResponse.Unmarshal(m, b)
+}
+func (m *GetShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardResponse.Marshal(b, m, deterministic)
+}
+func (m *GetShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardResponse.Merge(m, src)
+}
+func (m *GetShardResponse) XXX_Size() int {
+	return xxx_messageInfo_GetShardResponse.Size(m)
+}
+func (m *GetShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardResponse proto.InternalMessageInfo
+
+func (m *GetShardResponse) GetShard() *Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+type CreateShardRequest struct {
+	Keyspace  string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	ShardName string `protobuf:"bytes,2,opt,name=shard_name,json=shardName,proto3" json:"shard_name,omitempty"`
+	// force succeeds even if the shard already exists.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// include_parent creates the keyspace if it doesn't exist.
+	IncludeParent        bool     `protobuf:"varint,4,opt,name=include_parent,json=includeParent,proto3" json:"include_parent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateShardRequest) Reset()         { *m = CreateShardRequest{} }
+func (m *CreateShardRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateShardRequest) ProtoMessage()    {}
+func (*CreateShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{14}
+}
+
+func (m *CreateShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateShardRequest.Unmarshal(m, b)
+}
+func (m *CreateShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateShardRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateShardRequest.Merge(m, src)
+}
+func (m *CreateShardRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateShardRequest.Size(m)
+}
+func (m *CreateShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateShardRequest proto.InternalMessageInfo
+
+func (m *CreateShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *CreateShardRequest) GetShardName() string {
+	if m != nil {
+		return m.ShardName
+	}
+	return ""
+}
+
+func (m *CreateShardRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *CreateShardRequest) GetIncludeParent() bool {
+	if m != nil {
+		return m.IncludeParent
+	}
+	return false
+}
+
+type CreateShardResponse struct {
+	Shard                *Shard   `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateShardResponse) Reset()         { *m = CreateShardResponse{} }
+func (m *CreateShardResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateShardResponse) ProtoMessage()    {}
+func (*CreateShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{15}
+}
+
+func (m *CreateShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateShardResponse.Unmarshal(m, b)
+}
+func (m *CreateShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateShardResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateShardResponse.Merge(m, src)
+}
+func (m *CreateShardResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateShardResponse.Size(m)
+}
+func (m *CreateShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateShardResponse proto.InternalMessageInfo
+
+func (m *CreateShardResponse) GetShard() *Shard {
+	if m != nil {
+		return m.Shard
+	}
+	return nil
+}
+
+type DeleteShardRequest struct {
+	Keyspace  string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	ShardName string `protobuf:"bytes,2,opt,name=shard_name,json=shardName,proto3" json:"shard_name,omitempty"`
+	// recursive also deletes the tablets of the shard.
+	Recursive            bool     `protobuf:"varint,3,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	EvenIfServing        bool     `protobuf:"varint,4,opt,name=even_if_serving,json=evenIfServing,proto3" json:"even_if_serving,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteShardRequest) Reset()         { *m = DeleteShardRequest{} }
+func (m *DeleteShardRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteShardRequest) ProtoMessage()    {}
+func (*DeleteShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{16}
+}
+
+func (m *DeleteShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteShardRequest.Unmarshal(m, b)
+}
+func (m *DeleteShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteShardRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteShardRequest.Merge(m, src)
+}
+func (m *DeleteShardRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteShardRequest.Size(m)
+}
+func (m *DeleteShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteShardRequest proto.InternalMessageInfo
+
+func (m *DeleteShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *DeleteShardRequest) GetShardName() string {
+	if m != nil {
+		return m.ShardName
+	}
+	return ""
+}
+
+func (m *DeleteShardRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+func (m *DeleteShardRequest) GetEvenIfServing() bool {
+	if m != nil {
+		return m.EvenIfServing
+	}
+	return false
+}
+
+type DeleteShardResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteShardResponse) Reset()         { *m = DeleteShardResponse{} }
+func (m *DeleteShardResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteShardResponse) ProtoMessage()    {}
+func (*DeleteShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{17}
+}
+
+func (m *DeleteShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteShardResponse.Unmarshal(m, b)
+}
+func (m *DeleteShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteShardResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteShardResponse.Merge(m, src)
+}
+func (m *DeleteShardResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteShardResponse.Size(m)
+}
+func (m *DeleteShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteShardResponse proto.InternalMessageInfo
+
+type GetTabletRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetTabletRequest) Reset()         { *m = GetTabletRequest{} }
+func (m *GetTabletRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTabletRequest) ProtoMessage()    {}
+func (*GetTabletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{18}
+}
+
+func (m *GetTabletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTabletRequest.Unmarshal(m, b)
+}
+func (m *GetTabletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTabletRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTabletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTabletRequest.Merge(m, src)
+}
+func (m *GetTabletRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTabletRequest.Size(m)
+}
+func (m *GetTabletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTabletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTabletRequest proto.InternalMessageInfo
+
+func (m *GetTabletRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+type GetTabletResponse struct {
+	Tablet               *topodata.Tablet `protobuf:"bytes,1,opt,name=tablet,proto3" json:"tablet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetTabletResponse) Reset()         { *m = GetTabletResponse{} }
+func (m *GetTabletResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTabletResponse) ProtoMessage()    {}
+func (*GetTabletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{19}
+}
+
+func (m *GetTabletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTabletResponse.Unmarshal(m, b)
+}
+func (m *GetTabletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTabletResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTabletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTabletResponse.Merge(m, src)
+}
+func (m *GetTabletResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTabletResponse.Size(m)
+}
+func (m *GetTabletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTabletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTabletResponse proto.InternalMessageInfo
+
+func (m *GetTabletResponse) GetTablet() *topodata.Tablet {
+	if m != nil {
+		return m.Tablet
+	}
+	return nil
+}
+
+// GetTabletsRequest returns the tablets of a shard if shard is set, of
+// a keyspace otherwise. The tablets are limited to the cells if any.
+type GetTabletsRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Cells                []string `protobuf:"bytes,3,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTabletsRequest) Reset()         { *m = GetTabletsRequest{} }
+func (m *GetTabletsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTabletsRequest) ProtoMessage()    {}
+func (*GetTabletsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{20}
+}
+
+func (m *GetTabletsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTabletsRequest.Unmarshal(m, b)
+}
+func (m *GetTabletsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTabletsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTabletsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTabletsRequest.Merge(m, src)
+}
+func (m *GetTabletsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTabletsRequest.Size(m)
+}
+func (m *GetTabletsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTabletsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTabletsRequest proto.InternalMessageInfo
+
+func (m *GetTabletsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GetTabletsRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *GetTabletsRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type GetTabletsResponse struct {
+	Tablets              []*topodata.Tablet `protobuf:"bytes,1,rep,name=tablets,proto3" json:"tablets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetTabletsResponse) Reset()         { *m = GetTabletsResponse{} }
+func (m *GetTabletsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTabletsResponse) ProtoMessage()    {}
+func (*GetTabletsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{21}
+}
+
+func (m *GetTabletsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTabletsResponse.Unmarshal(m, b)
+}
+func (m *GetTabletsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTabletsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTabletsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTabletsResponse.Merge(m, src)
+}
+func (m *GetTabletsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTabletsResponse.Size(m)
+}
+func (m *GetTabletsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTabletsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTabletsResponse proto.InternalMessageInfo
+
+func (m *GetTabletsResponse) GetTablets() []*topodata.Tablet {
+	if m != nil {
+		return m.Tablets
+	}
+	return nil
+}
+
+type ChangeTabletTypeRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	DbType               topodata.TabletType   `protobuf:"varint,2,opt,name=db_type,json=dbType,proto3,enum=topodata.TabletType" json:"db_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ChangeTabletTypeRequest) Reset()         { *m = ChangeTabletTypeRequest{} }
+func (m *ChangeTabletTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeTabletTypeRequest) ProtoMessage()    {}
+func (*ChangeTabletTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{22}
+}
+
+func (m *ChangeTabletTypeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeTabletTypeRequest.Unmarshal(m, b)
+}
+func (m *ChangeTabletTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeTabletTypeRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangeTabletTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeTabletTypeRequest.Merge(m, src)
+}
+func (m *ChangeTabletTypeRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeTabletTypeRequest.Size(m)
+}
+func (m *ChangeTabletTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeTabletTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeTabletTypeRequest proto.InternalMessageInfo
+
+func (m *ChangeTabletTypeRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *ChangeTabletTypeRequest) GetDbType() topodata.TabletType {
+	if m != nil {
+		return m.DbType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+type ChangeTabletTypeResponse struct {
+	BeforeTablet         *topodata.Tablet `protobuf:"bytes,1,opt,name=before_tablet,json=beforeTablet,proto3" json:"before_tablet,omitempty"`
+	AfterTablet          *topodata.Tablet `protobuf:"bytes,2,opt,name=after_tablet,json=afterTablet,proto3" json:"after_tablet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ChangeTabletTypeResponse) Reset()         { *m = ChangeTabletTypeResponse{} }
+func (m *ChangeTabletTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangeTabletTypeResponse) ProtoMessage()    {}
+func (*ChangeTabletTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{23}
+}
+
+func (m *ChangeTabletTypeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeTabletTypeResponse.Unmarshal(m, b)
+}
+func (m *ChangeTabletTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeTabletTypeResponse.Marshal(b, m, deterministic)
+}
+func (m *ChangeTabletTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeTabletTypeResponse.Merge(m, src)
+}
+func (m *ChangeTabletTypeResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangeTabletTypeResponse.Size(m)
+}
+func (m *ChangeTabletTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeTabletTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeTabletTypeResponse proto.InternalMessageInfo
+
+func (m *ChangeTabletTypeResponse) GetBeforeTablet() *topodata.Tablet {
+	if m != nil {
+		return m.BeforeTablet
+	}
+	return nil
+}
+
+func (m *ChangeTabletTypeResponse) GetAfterTablet() *topodata.Tablet {
+	if m != nil {
+		return m.AfterTablet
+	}
+	return nil
+}
+
+type DeleteTabletRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	AllowMaster          bool                  `protobuf:"varint,2,opt,name=allow_master,json=allowMaster,proto3" json:"allow_master,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DeleteTabletRequest) Reset()         { *m = DeleteTabletRequest{} }
+func (m *DeleteTabletRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTabletRequest) ProtoMessage()    {}
+func (*DeleteTabletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{24}
+}
+
+func (m *DeleteTabletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTabletRequest.Unmarshal(m, b)
+}
+func (m *DeleteTabletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTabletRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteTabletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTabletRequest.Merge(m, src)
+}
+func (m *DeleteTabletRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTabletRequest.Size(m)
+}
+func (m *DeleteTabletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTabletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTabletRequest proto.InternalMessageInfo
+
+func (m *DeleteTabletRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *DeleteTabletRequest) GetAllowMaster() bool {
+	if m != nil {
+		return m.AllowMaster
+	}
+	return false
+}
+
+type DeleteTabletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTabletResponse) Reset()         { *m = DeleteTabletResponse{} }
+func (m *DeleteTabletResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTabletResponse) ProtoMessage()    {}
+func (*DeleteTabletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{25}
+}
+
+func (m *DeleteTabletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTabletResponse.Unmarshal(m, b)
+}
+func (m *DeleteTabletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTabletResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteTabletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTabletResponse.Merge(m, src)
+}
+func (m *DeleteTabletResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTabletResponse.Size(m)
+}
+func (m *DeleteTabletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTabletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTabletResponse proto.InternalMessageInfo
+
+type PlannedReparentShardRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	// new_master is the tablet to promote. If it's not set, the replica
+	// with the most advanced position is chosen, except avoid_master.
+	NewMaster             *topodata.TabletAlias `protobuf:"bytes,3,opt,name=new_master,json=newMaster,proto3" json:"new_master,omitempty"`
+	AvoidMaster           *topodata.TabletAlias `protobuf:"bytes,4,opt,name=avoid_master,json=avoidMaster,proto3" json:"avoid_master,omitempty"`
+	WaitReplicasTimeoutMs int64                 `protobuf:"varint,5,opt,name=wait_replicas_timeout_ms,json=waitReplicasTimeoutMs,proto3" json:"wait_replicas_timeout_ms,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
+	XXX_unrecognized      []byte                `json:"-"`
+	XXX_sizecache         int32                 `json:"-"`
+}
+
+func (m *PlannedReparentShardRequest) Reset()         { *m = PlannedReparentShardRequest{} }
+func (m *PlannedReparentShardRequest) String() string { return proto.CompactTextString(m) }
+func (*PlannedReparentShardRequest) ProtoMessage()    {}
+func (*PlannedReparentShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{26}
+}
+
+func (m *PlannedReparentShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlannedReparentShardRequest.Unmarshal(m, b)
+}
+func (m *PlannedReparentShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlannedReparentShardRequest.Marshal(b, m, deterministic)
+}
+func (m *PlannedReparentShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlannedReparentShardRequest.Merge(m, src)
+}
+func (m *PlannedReparentShardRequest) XXX_Size() int {
+	return xxx_messageInfo_PlannedReparentShardRequest.Size(m)
+}
+func (m *PlannedReparentShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlannedReparentShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlannedReparentShardRequest proto.InternalMessageInfo
+
+func (m *PlannedReparentShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *PlannedReparentShardRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *PlannedReparentShardRequest) GetNewMaster() *topodata.TabletAlias {
+	if m != nil {
+		return m.NewMaster
+	}
+	return nil
+}
+
+func (m *PlannedReparentShardRequest) GetAvoidMaster() *topodata.TabletAlias {
+	if m != nil {
+		return m.AvoidMaster
+	}
+	return nil
+}
+
+func (m *PlannedReparentShardRequest) GetWaitReplicasTimeoutMs() int64 {
+	if m != nil {
+		return m.WaitReplicasTimeoutMs
+	}
+	return 0
+}
+
+type PlannedReparentShardResponse struct {
+	PromotedMaster       *topodata.TabletAlias `protobuf:"bytes,1,opt,name=promoted_master,json=promotedMaster,proto3" json:"promoted_master,omitempty"`
+	Events               []*logutil.Event      `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PlannedReparentShardResponse) Reset()         { *m = PlannedReparentShardResponse{} }
+func (m *PlannedReparentShardResponse) String() string { return proto.CompactTextString(m) }
+func (*PlannedReparentShardResponse) ProtoMessage()    {}
+func (*PlannedReparentShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{27}
+}
+
+func (m *PlannedReparentShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlannedReparentShardResponse.Unmarshal(m, b)
+}
+func (m *PlannedReparentShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlannedReparentShardResponse.Marshal(b, m, deterministic)
+}
+func (m *PlannedReparentShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlannedReparentShardResponse.Merge(m, src)
+}
+func (m *PlannedReparentShardResponse) XXX_Size() int {
+	return xxx_messageInfo_PlannedReparentShardResponse.Size(m)
+}
+func (m *PlannedReparentShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlannedReparentShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlannedReparentShardResponse proto.InternalMessageInfo
+
+func (m *PlannedReparentShardResponse) GetPromotedMaster() *topodata.TabletAlias {
+	if m != nil {
+		return m.PromotedMaster
+	}
+	return nil
+}
+
+func (m *PlannedReparentShardResponse) GetEvents() []*logutil.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type EmergencyReparentShardRequest struct {
+	Keyspace              string                `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                 string                `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	NewMaster             *topodata.TabletAlias `protobuf:"bytes,3,opt,name=new_master,json=newMaster,proto3" json:"new_master,omitempty"`
+	WaitReplicasTimeoutMs int64                 `protobuf:"varint,4,opt,name=wait_replicas_timeout_ms,json=waitReplicasTimeoutMs,proto3" json:"wait_replicas_timeout_ms,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
+	XXX_unrecognized      []byte                `json:"-"`
+	XXX_sizecache         int32                 `json:"-"`
+}
+
+func (m *EmergencyReparentShardRequest) Reset()         { *m = EmergencyReparentShardRequest{} }
+func (m *EmergencyReparentShardRequest) String() string { return proto.CompactTextString(m) }
+func (*EmergencyReparentShardRequest) ProtoMessage()    {}
+func (*EmergencyReparentShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{28}
+}
+
+func (m *EmergencyReparentShardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmergencyReparentShardRequest.Unmarshal(m, b)
+}
+func (m *EmergencyReparentShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmergencyReparentShardRequest.Marshal(b, m, deterministic)
+}
+func (m *EmergencyReparentShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyReparentShardRequest.Merge(m, src)
+}
+func (m *EmergencyReparentShardRequest) XXX_Size() int {
+	return xxx_messageInfo_EmergencyReparentShardRequest.Size(m)
+}
+func (m *EmergencyReparentShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyReparentShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencyReparentShardRequest proto.InternalMessageInfo
+
+func (m *EmergencyReparentShardRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *EmergencyReparentShardRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *EmergencyReparentShardRequest) GetNewMaster() *topodata.TabletAlias {
+	if m != nil {
+		return m.NewMaster
+	}
+	return nil
+}
+
+func (m *EmergencyReparentShardRequest) GetWaitReplicasTimeoutMs() int64 {
+	if m != nil {
+		return m.WaitReplicasTimeoutMs
+	}
+	return 0
+}
+
+type EmergencyReparentShardResponse struct {
+	PromotedMaster       *topodata.TabletAlias `protobuf:"bytes,1,opt,name=promoted_master,json=promotedMaster,proto3" json:"promoted_master,omitempty"`
+	Events               []*logutil.Event      `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *EmergencyReparentShardResponse) Reset()         { *m = EmergencyReparentShardResponse{} }
+func (m *EmergencyReparentShardResponse) String() string { return proto.CompactTextString(m) }
+func (*EmergencyReparentShardResponse) ProtoMessage()    {}
+func (*EmergencyReparentShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{29}
+}
+
+func (m *EmergencyReparentShardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmergencyReparentShardResponse.Unmarshal(m, b)
+}
+func (m *EmergencyReparentShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmergencyReparentShardResponse.Marshal(b, m, deterministic)
+}
+func (m *EmergencyReparentShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyReparentShardResponse.Merge(m, src)
+}
+func (m *EmergencyReparentShardResponse) XXX_Size() int {
+	return xxx_messageInfo_EmergencyReparentShardResponse.Size(m)
+}
+func (m *EmergencyReparentShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyReparentShardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencyReparentShardResponse proto.InternalMessageInfo
+
+func (m *EmergencyReparentShardResponse) GetPromotedMaster() *topodata.TabletAlias {
+	if m != nil {
+		return m.PromotedMaster
+	}
+	return nil
+}
+
+func (m *EmergencyReparentShardResponse) GetEvents() []*logutil.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type BackupRequest struct {
+	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+	Concurrency          int64                 `protobuf:"varint,2,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	AllowMaster          bool                  `protobuf:"varint,3,opt,name=allow_master,json=allowMaster,proto3" json:"allow_master,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
+func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()    {}
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{30}
+}
+
+func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupRequest.Unmarshal(m, b)
+}
+func (m *BackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupRequest.Marshal(b, m, deterministic)
+}
+func (m *BackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupRequest.Merge(m, src)
+}
+func (m *BackupRequest) XXX_Size() int {
+	return xxx_messageInfo_BackupRequest.Size(m)
+}
+func (m *BackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupRequest proto.InternalMessageInfo
+
+func (m *BackupRequest) GetTabletAlias() *topodata.TabletAlias {
+	if m != nil {
+		return m.TabletAlias
+	}
+	return nil
+}
+
+func (m *BackupRequest) GetConcurrency() int64 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+func (m *BackupRequest) GetAllowMaster() bool {
+	if m != nil {
+		return m.AllowMaster
+	}
+	return false
+}
+
+type BackupResponse struct {
+	Events               []*logutil.Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
+func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupResponse) ProtoMessage()    {}
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{31}
+}
+
+func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupResponse.Unmarshal(m, b)
+}
+func (m *BackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupResponse.Marshal(b, m, deterministic)
+}
+func (m *BackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupResponse.Merge(m, src)
+}
+func (m *BackupResponse) XXX_Size() int {
+	return xxx_messageInfo_BackupResponse.Size(m)
+}
+func (m *BackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupResponse proto.InternalMessageInfo
+
+func (m *BackupResponse) GetEvents() []*logutil.Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type GetBackupsRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBackupsRequest) Reset()         { *m = GetBackupsRequest{} }
+func (m *GetBackupsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBackupsRequest) ProtoMessage()    {}
+func (*GetBackupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{32}
+}
+
+func (m *GetBackupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBackupsRequest.Unmarshal(m, b)
+}
+func (m *GetBackupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBackupsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBackupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBackupsRequest.Merge(m, src)
+}
+func (m *GetBackupsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBackupsRequest.Size(m)
+}
+func (m *GetBackupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBackupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBackupsRequest proto.InternalMessageInfo
+
+func (m *GetBackupsRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GetBackupsRequest) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+type GetBackupsResponse struct {
+	// names are the names of the backups, oldest first.
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBackupsResponse) Reset()         { *m = GetBackupsResponse{} }
+func (m *GetBackupsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBackupsResponse) ProtoMessage()    {}
+func (*GetBackupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{33}
+}
+
+func (m *GetBackupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBackupsResponse.Unmarshal(m, b)
+}
+func (m *GetBackupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBackupsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBackupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBackupsResponse.Merge(m, src)
+}
+func (m *GetBackupsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBackupsResponse.Size(m)
+}
+func (m *GetBackupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBackupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBackupsResponse proto.InternalMessageInfo
+
+func (m *GetBackupsResponse) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+// WorkflowActionRequest stops, starts or deletes the streams of a
+// vreplication workflow of a keyspace.
+type WorkflowActionRequest struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// action is stop, start or delete.
+	Action               string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowActionRequest) Reset()         { *m = WorkflowActionRequest{} }
+func (m *WorkflowActionRequest) String() string { return proto.CompactTextString(m) }
+func (*WorkflowActionRequest) ProtoMessage()    {}
+func (*WorkflowActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{34}
+}
+
+func (m *WorkflowActionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowActionRequest.Unmarshal(m, b)
+}
+func (m *WorkflowActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowActionRequest.Marshal(b, m, deterministic)
+}
+func (m *WorkflowActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowActionRequest.Merge(m, src)
+}
+func (m *WorkflowActionRequest) XXX_Size() int {
+	return xxx_messageInfo_WorkflowActionRequest.Size(m)
+}
+func (m *WorkflowActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowActionRequest proto.InternalMessageInfo
+
+func (m *WorkflowActionRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *WorkflowActionRequest) GetWorkflow() string {
+	if m != nil {
+		return m.Workflow
+	}
+	return ""
+}
+
+func (m *WorkflowActionRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type WorkflowActionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WorkflowActionResponse) Reset()         { *m = WorkflowActionResponse{} }
+func (m *WorkflowActionResponse) String() string { return proto.CompactTextString(m) }
+func (*WorkflowActionResponse) ProtoMessage()    {}
+func (*WorkflowActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{35}
+}
+
+func (m *WorkflowActionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WorkflowActionResponse.Unmarshal(m, b)
+}
+func (m *WorkflowActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WorkflowActionResponse.Marshal(b, m, deterministic)
+}
+func (m *WorkflowActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowActionResponse.Merge(m, src)
+}
+func (m *WorkflowActionResponse) XXX_Size() int {
+	return xxx_messageInfo_WorkflowActionResponse.Size(m)
+}
+func (m *WorkflowActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowActionResponse proto.InternalMessageInfo
+
+type GetVSchemaRequest struct {
+	Keyspace             string   `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVSchemaRequest) Reset()         { *m = GetVSchemaRequest{} }
+func (m *GetVSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVSchemaRequest) ProtoMessage()    {}
+func (*GetVSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{36}
+}
+
+func (m *GetVSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVSchemaRequest.Unmarshal(m, b)
+}
+func (m *GetVSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetVSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVSchemaRequest.Merge(m, src)
+}
+func (m *GetVSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetVSchemaRequest.Size(m)
+}
+func (m *GetVSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVSchemaRequest proto.InternalMessageInfo
+
+func (m *GetVSchemaRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+type GetVSchemaResponse struct {
+	VSchema              *vschema.Keyspace `protobuf:"bytes,1,opt,name=v_schema,json=vSchema,proto3" json:"v_schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetVSchemaResponse) Reset()         { *m = GetVSchemaResponse{} }
+func (m *GetVSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetVSchemaResponse) ProtoMessage()    {}
+func (*GetVSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{37}
+}
+
+func (m *GetVSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVSchemaResponse.Unmarshal(m, b)
+}
+func (m *GetVSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVSchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *GetVSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVSchemaResponse.Merge(m, src)
+}
+func (m *GetVSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetVSchemaResponse.Size(m)
+}
+func (m *GetVSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVSchemaResponse proto.InternalMessageInfo
+
+func (m *GetVSchemaResponse) GetVSchema() *vschema.Keyspace {
+	if m != nil {
+		return m.VSchema
+	}
+	return nil
+}
+
+type ApplyVSchemaRequest struct {
+	Keyspace string            `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	VSchema  *vschema.Keyspace `protobuf:"bytes,2,opt,name=v_schema,json=vSchema,proto3" json:"v_schema,omitempty"`
+	// dry_run validates the vschema without saving it.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// skip_rebuild doesn't rebuild the SrvVSchema of the cells.
+	SkipRebuild          bool     `protobuf:"varint,4,opt,name=skip_rebuild,json=skipRebuild,proto3" json:"skip_rebuild,omitempty"`
+	Cells                []string `protobuf:"bytes,5,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyVSchemaRequest) Reset()         { *m = ApplyVSchemaRequest{} }
+func (m *ApplyVSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyVSchemaRequest) ProtoMessage()    {}
+func (*ApplyVSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{38}
+}
+
+func (m *ApplyVSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyVSchemaRequest.Unmarshal(m, b)
+}
+func (m *ApplyVSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyVSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplyVSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyVSchemaRequest.Merge(m, src)
+}
+func (m *ApplyVSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyVSchemaRequest.Size(m)
+}
+func (m *ApplyVSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyVSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyVSchemaRequest proto.InternalMessageInfo
+
+func (m *ApplyVSchemaRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ApplyVSchemaRequest) GetVSchema() *vschema.Keyspace {
+	if m != nil {
+		return m.VSchema
+	}
+	return nil
+}
+
+func (m *ApplyVSchemaRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ApplyVSchemaRequest) GetSkipRebuild() bool {
+	if m != nil {
+		return m.SkipRebuild
+	}
+	return false
+}
+
+func (m *ApplyVSchemaRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type ApplyVSchemaResponse struct {
+	VSchema              *vschema.Keyspace `protobuf:"bytes,1,opt,name=v_schema,json=vSchema,proto3" json:"v_schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ApplyVSchemaResponse) Reset()         { *m = ApplyVSchemaResponse{} }
+func (m *ApplyVSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplyVSchemaResponse) ProtoMessage()    {}
+func (*ApplyVSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{39}
+}
+
+func (m *ApplyVSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyVSchemaResponse.Unmarshal(m, b)
+}
+func (m *ApplyVSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyVSchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *ApplyVSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyVSchemaResponse.Merge(m, src)
+}
+func (m *ApplyVSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_ApplyVSchemaResponse.Size(m)
+}
+func (m *ApplyVSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyVSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyVSchemaResponse proto.InternalMessageInfo
+
+func (m *ApplyVSchemaResponse) GetVSchema() *vschema.Keyspace {
+	if m != nil {
+		return m.VSchema
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ExecuteVtctlCommandRequest)(nil), "vtctldata.ExecuteVtctlCommandRequest")
 	proto.RegisterType((*ExecuteVtctlCommandResponse)(nil), "vtctldata.ExecuteVtctlCommandResponse")
+	proto.RegisterType((*Keyspace)(nil), "vtctldata.Keyspace")
+	proto.RegisterType((*Shard)(nil), "vtctldata.Shard")
+	proto.RegisterType((*GetKeyspacesRequest)(nil), "vtctldata.GetKeyspacesRequest")
+	proto.RegisterType((*GetKeyspacesResponse)(nil), "vtctldata.GetKeyspacesResponse")
+	proto.RegisterType((*GetKeyspaceRequest)(nil), "vtctldata.GetKeyspaceRequest")
+	proto.RegisterType((*GetKeyspaceResponse)(nil), "vtctldata.GetKeyspaceResponse")
+	proto.RegisterType((*CreateKeyspaceRequest)(nil), "vtctldata.CreateKeyspaceRequest")
+	proto.RegisterType((*CreateKeyspaceResponse)(nil), "vtctldata.CreateKeyspaceResponse")
+	proto.RegisterType((*DeleteKeyspaceRequest)(nil), "vtctldata.DeleteKeyspaceRequest")
+	proto.RegisterType((*DeleteKeyspaceResponse)(nil), "vtctldata.DeleteKeyspaceResponse")
+	proto.RegisterType((*GetShardRequest)(nil), "vtctldata.GetShardRequest")
+	proto.RegisterType((*GetShardResponse)(nil), "vtctldata.GetShardResponse")
+	proto.RegisterType((*CreateShardRequest)(nil), "vtctldata.CreateShardRequest")
+	proto.RegisterType((*CreateShardResponse)(nil), "vtctldata.CreateShardResponse")
+	proto.RegisterType((*DeleteShardRequest)(nil), "vtctldata.DeleteShardRequest")
+	proto.RegisterType((*DeleteShardResponse)(nil), "vtctldata.DeleteShardResponse")
+	proto.RegisterType((*GetTabletRequest)(nil), "vtctldata.GetTabletRequest")
+	proto.RegisterType((*GetTabletResponse)(nil), "vtctldata.GetTabletResponse")
+	proto.RegisterType((*GetTabletsRequest)(nil), "vtctldata.GetTabletsRequest")
+	proto.RegisterType((*GetTabletsResponse)(nil), "vtctldata.GetTabletsResponse")
+	proto.RegisterType((*ChangeTabletTypeRequest)(nil), "vtctldata.ChangeTabletTypeRequest")
+	proto.RegisterType((*ChangeTabletTypeResponse)(nil), "vtctldata.ChangeTabletTypeResponse")
+	proto.RegisterType((*DeleteTabletRequest)(nil), "vtctldata.DeleteTabletRequest")
+	proto.RegisterType((*DeleteTabletResponse)(nil), "vtctldata.DeleteTabletResponse")
+	proto.RegisterType((*PlannedReparentShardRequest)(nil), "vtctldata.PlannedReparentShardRequest")
+	proto.RegisterType((*PlannedReparentShardResponse)(nil), "vtctldata.PlannedReparentShardResponse")
+	proto.RegisterType((*EmergencyReparentShardRequest)(nil), "vtctldata.EmergencyReparentShardRequest")
+	proto.RegisterType((*EmergencyReparentShardResponse)(nil), "vtctldata.EmergencyReparentShardResponse")
+	proto.RegisterType((*BackupRequest)(nil), "vtctldata.BackupRequest")
+	proto.RegisterType((*BackupResponse)(nil), "vtctldata.BackupResponse")
+	proto.RegisterType((*GetBackupsRequest)(nil), "vtctldata.GetBackupsRequest")
+	proto.RegisterType((*GetBackupsResponse)(nil), "vtctldata.GetBackupsResponse")
+	proto.RegisterType((*WorkflowActionRequest)(nil), "vtctldata.WorkflowActionRequest")
+	proto.RegisterType((*WorkflowActionResponse)(nil), "vtctldata.WorkflowActionResponse")
+	proto.RegisterType((*GetVSchemaRequest)(nil), "vtctldata.GetVSchemaRequest")
+	proto.RegisterType((*GetVSchemaResponse)(nil), "vtctldata.GetVSchemaResponse")
+	proto.RegisterType((*ApplyVSchemaRequest)(nil), "vtctldata.ApplyVSchemaRequest")
+	proto.RegisterType((*ApplyVSchemaResponse)(nil), "vtctldata.ApplyVSchemaResponse")
 }
 
 func init() { proto.RegisterFile("vtctldata.proto", fileDescriptor_f41247b323a1ab2e) }
 
 var fileDescriptor_f41247b323a1ab2e = []byte{
-	// 200 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xcf, 0xd1, 0x4a, 0x87, 0x30,
-	0x14, 0x06, 0x70, 0xd6, 0xbf, 0x82, 0xff, 0x42, 0x83, 0x5d, 0x89, 0xdd, 0x88, 0x54, 0xec, 0xca,
-	0x41, 0xbd, 0x41, 0xe2, 0x0b, 0x8c, 0x28, 0xe8, 0x26, 0x96, 0x1e, 0x64, 0xa0, 0x3b, 0xe6, 0xce,
-	0x46, 0x8f, 0x1f, 0x3a, 0xf2, 0xaa, 0xbb, 0x8f, 0xdf, 0x37, 0xc6, 0x77, 0xf8, 0x6d, 0xa4, 0x9e,
-	0xa6, 0xc1, 0x90, 0x69, 0x96, 0x15, 0x09, 0xc5, 0xf9, 0x80, 0x32, 0x9b, 0x70, 0x0c, 0x64, 0xa7,
-	0xd4, 0xd4, 0xef, 0xbc, 0xec, 0x7e, 0xa0, 0x0f, 0x04, 0x6f, 0xdb, 0x93, 0x16, 0xe7, 0xd9, 0xb8,
-	0x41, 0xc3, 0x77, 0x00, 0x4f, 0x42, 0xf0, 0x4b, 0xb3, 0x8e, 0xbe, 0x60, 0xd5, 0x49, 0x9e, 0xf5,
-	0x9e, 0xc5, 0x03, 0xcf, 0x4d, 0x4f, 0x16, 0xdd, 0x27, 0xd9, 0x19, 0x30, 0x50, 0x71, 0x51, 0x31,
-	0x79, 0xd2, 0x59, 0xd2, 0xd7, 0x84, 0x75, 0xcb, 0xef, 0xfe, 0xfd, 0xd8, 0x2f, 0xe8, 0x3c, 0x88,
-	0x7b, 0x7e, 0x05, 0x11, 0x1c, 0x15, 0xac, 0x62, 0xf2, 0xe6, 0x29, 0x6f, 0xfe, 0x66, 0x75, 0x9b,
-	0xea, 0x54, 0xbe, 0xc8, 0x8f, 0xc7, 0x68, 0x09, 0xbc, 0x6f, 0x2c, 0xaa, 0x94, 0xd4, 0x88, 0x2a,
-	0x92, 0xda, 0xd7, 0xab, 0xe3, 0xac, 0xaf, 0xeb, 0x1d, 0x9e, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xa9, 0xd5, 0x57, 0x1c, 0xfb, 0x00, 0x00, 0x00,
+	// 1187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc5, 0x57, 0xdd, 0x6f, 0x1b, 0x45,
+	0x10, 0xd7, 0xc5, 0xb1, 0x13, 0x8f, 0x63, 0xa7, 0xb9, 0xd8, 0xa9, 0x95, 0xb6, 0x28, 0xac, 0x68,
+	0x14, 0x55, 0x60, 0x97, 0x14, 0x44, 0x85, 0x04, 0x22, 0x49, 0x03, 0x0a, 0xd0, 0xaa, 0x5c, 0xa2,
+	0x56, 0x6a, 0x25, 0x4e, 0xeb, 0xf3, 0xc6, 0x39, 0xe5, 0xbe, 0xb8, 0x5d, 0x3b, 0xf8, 0x95, 0x07,
+	0xc4, 0x03, 0xe2, 0x8d, 0x3f, 0x84, 0xff, 0x80, 0x3f, 0x89, 0x47, 0x1e, 0xd9, 0xcf, 0x3b, 0x7f,
+	0x24, 0xae, 0x49, 0x23, 0x78, 0xbb, 0x99, 0xd9, 0xf9, 0xf8, 0xcd, 0xcc, 0xce, 0xce, 0xc1, 0xea,
+	0x80, 0x79, 0x2c, 0xe8, 0x62, 0x86, 0x5b, 0x49, 0x1a, 0xb3, 0xd8, 0x2e, 0x67, 0x8c, 0xcd, 0x6a,
+	0x10, 0xf7, 0xfa, 0xcc, 0x0f, 0x94, 0x64, 0xb3, 0xc6, 0xe2, 0x24, 0xce, 0x4f, 0x6e, 0x56, 0x07,
+	0xd4, 0x3b, 0x23, 0xa1, 0x26, 0xd1, 0x4b, 0xd8, 0x3c, 0xfc, 0x91, 0x78, 0x7d, 0x46, 0x5e, 0x08,
+	0x0b, 0x07, 0x71, 0x18, 0xe2, 0xa8, 0xeb, 0x90, 0x1f, 0xfa, 0x84, 0x32, 0xdb, 0x86, 0x45, 0x9c,
+	0xf6, 0x68, 0xd3, 0xda, 0x2a, 0xec, 0x94, 0x1d, 0xf9, 0x6d, 0xdf, 0x87, 0x1a, 0xf6, 0x98, 0x1f,
+	0x47, 0x2e, 0xf3, 0x43, 0x12, 0xf7, 0x59, 0x73, 0x61, 0xcb, 0xda, 0x29, 0x38, 0x55, 0xc5, 0x3d,
+	0x51, 0x4c, 0x74, 0x00, 0x77, 0x2e, 0x35, 0x4c, 0x93, 0x38, 0xa2, 0xc4, 0x7e, 0x0f, 0x8a, 0x64,
+	0x40, 0x22, 0xc6, 0x4d, 0x5b, 0x3b, 0x95, 0xdd, 0x5a, 0xcb, 0x44, 0x7d, 0x28, 0xb8, 0x8e, 0x12,
+	0xa2, 0x67, 0xb0, 0xfc, 0x0d, 0x19, 0xd2, 0x04, 0x7b, 0x44, 0xc4, 0x12, 0xe1, 0x90, 0x48, 0x05,
+	0x1e, 0x8b, 0xf8, 0xb6, 0x5b, 0xb0, 0x7c, 0xae, 0xe5, 0x32, 0x8a, 0xca, 0xae, 0xdd, 0xca, 0xf0,
+	0x1a, 0x4d, 0x27, 0x3b, 0x83, 0xbe, 0x87, 0xe2, 0xf1, 0x19, 0x4e, 0xbb, 0xf6, 0xe6, 0x88, 0xa2,
+	0x32, 0x98, 0xd1, 0x99, 0xa3, 0x85, 0x11, 0x47, 0xf7, 0xa1, 0x48, 0x85, 0x62, 0xb3, 0x20, 0xbd,
+	0xac, 0xe6, 0x5e, 0xa4, 0x3d, 0x47, 0x49, 0x51, 0x03, 0xd6, 0xbf, 0x22, 0xcc, 0x38, 0xa6, 0x3a,
+	0x8d, 0xe8, 0x08, 0xea, 0xe3, 0x6c, 0x9d, 0x84, 0x0f, 0xa1, 0x6c, 0xbc, 0xaa, 0x1c, 0x57, 0x76,
+	0xd7, 0x5b, 0x79, 0x69, 0x33, 0x00, 0xf9, 0x29, 0xf4, 0x10, 0xec, 0x11, 0x53, 0xa6, 0x4e, 0x33,
+	0xe0, 0xa0, 0x2f, 0xc7, 0x62, 0xca, 0x7c, 0xb7, 0x27, 0x54, 0xae, 0x70, 0x9d, 0xdb, 0xf9, 0xcb,
+	0x82, 0xc6, 0x41, 0x4a, 0x30, 0x23, 0x93, 0xde, 0x2f, 0xab, 0x4c, 0x1d, 0x8a, 0xa7, 0x71, 0xaa,
+	0xcb, 0xb2, 0xec, 0x28, 0x82, 0x3b, 0xad, 0xe3, 0x20, 0x88, 0x2f, 0x5c, 0x12, 0x26, 0x6c, 0xe8,
+	0x0e, 0x5c, 0xd5, 0x8b, 0x32, 0xab, 0xcb, 0xce, 0x9a, 0x94, 0x1d, 0x0a, 0xd1, 0x8b, 0x63, 0x29,
+	0xb0, 0x1f, 0x42, 0x5d, 0x66, 0xd6, 0x8f, 0x7a, 0xae, 0x17, 0x07, 0xfd, 0x30, 0x72, 0xa5, 0xab,
+	0x45, 0xe9, 0xca, 0x36, 0xb2, 0x03, 0x29, 0x7a, 0x26, 0x1c, 0x7f, 0x3d, 0xad, 0xc1, 0x86, 0x09,
+	0x69, 0x16, 0xb9, 0x46, 0x6d, 0xb7, 0x39, 0xdd, 0x1e, 0x47, 0xdd, 0x13, 0x2e, 0x9f, 0xb4, 0x25,
+	0x78, 0xbc, 0x6e, 0x1b, 0x93, 0x88, 0xaf, 0x9b, 0xbd, 0xef, 0xa0, 0xf1, 0x84, 0x04, 0x64, 0x3a,
+	0x79, 0xb3, 0x3a, 0xf1, 0x2e, 0x94, 0x53, 0x7e, 0x85, 0x52, 0xea, 0x0f, 0x4c, 0x22, 0x73, 0x06,
+	0x6a, 0xc2, 0xc6, 0xa4, 0x49, 0x15, 0x1d, 0xfa, 0x16, 0x56, 0x79, 0xc9, 0x55, 0x67, 0xce, 0xe1,
+	0xe6, 0x1e, 0x80, 0x04, 0xef, 0x8e, 0xb4, 0x7d, 0x59, 0x72, 0x44, 0x46, 0xd1, 0xa7, 0x70, 0x2b,
+	0xb7, 0xa6, 0xf1, 0x6f, 0x9b, 0xfb, 0xa0, 0xc0, 0xdf, 0x1a, 0x01, 0x3f, 0x76, 0x21, 0x7e, 0xb5,
+	0xc0, 0x56, 0x29, 0xbc, 0xa1, 0x68, 0xf2, 0xc6, 0x2a, 0x8c, 0x36, 0x16, 0x1f, 0x4a, 0x7e, 0xe4,
+	0x05, 0xfd, 0x2e, 0x71, 0x13, 0x9c, 0x8a, 0xb9, 0xb2, 0x28, 0xc5, 0x55, 0xcd, 0x7d, 0x2e, 0x99,
+	0xe8, 0x33, 0x58, 0x1f, 0x8b, 0xe6, 0x5f, 0xa2, 0xf9, 0x9d, 0xa3, 0x51, 0x29, 0xbf, 0x29, 0x34,
+	0x63, 0x15, 0x2e, 0x4c, 0x54, 0x98, 0xc7, 0xb5, 0x2a, 0xe6, 0xa0, 0xeb, 0x9f, 0xba, 0x94, 0xa4,
+	0x03, 0xde, 0x9c, 0x06, 0x96, 0x60, 0x1f, 0x9d, 0x1e, 0x2b, 0xa6, 0x18, 0x3b, 0x63, 0x61, 0x65,
+	0x6d, 0x20, 0x0a, 0x77, 0x82, 0x3b, 0x5c, 0x64, 0x62, 0x7d, 0x0c, 0x2b, 0x4c, 0x32, 0x5c, 0x1c,
+	0xf8, 0x98, 0x6a, 0xc4, 0x8d, 0xfc, 0x5a, 0xa8, 0xe3, 0x7b, 0x42, 0xe8, 0x54, 0x58, 0x4e, 0xf0,
+	0xdc, 0xad, 0x8d, 0x58, 0xd3, 0x99, 0xdb, 0x81, 0x92, 0x3a, 0x93, 0xa5, 0x6e, 0xc2, 0x90, 0xa3,
+	0xe5, 0xe8, 0xf5, 0x88, 0x3a, 0x9d, 0x27, 0x73, 0x75, 0x53, 0x14, 0x95, 0x34, 0x45, 0x08, 0xae,
+	0x47, 0x82, 0x80, 0xf2, 0x64, 0x89, 0x27, 0x49, 0x11, 0xe8, 0x0b, 0x39, 0x15, 0x33, 0xe3, 0x3a,
+	0xb8, 0x07, 0xb0, 0xa4, 0x9c, 0x9b, 0xe1, 0x3a, 0x1d, 0x9d, 0x39, 0x80, 0x7e, 0xb2, 0xe0, 0xf6,
+	0xc1, 0x19, 0x8e, 0x7a, 0x44, 0x49, 0xe4, 0x4c, 0x78, 0xdb, 0x9c, 0xd9, 0x1f, 0xc0, 0x52, 0xb7,
+	0xa3, 0xe6, 0xcf, 0x82, 0x9c, 0x3f, 0xf5, 0x49, 0x25, 0xe9, 0xa7, 0xd4, 0xed, 0xc8, 0x79, 0xf3,
+	0xb3, 0x05, 0xcd, 0xe9, 0x20, 0x34, 0x9a, 0x8f, 0xa1, 0xda, 0x21, 0xbc, 0xdb, 0x89, 0xfb, 0x86,
+	0x8c, 0xaf, 0xa8, 0x63, 0x8a, 0xb2, 0x1f, 0xc1, 0x0a, 0x3e, 0x65, 0x24, 0x35, 0x5a, 0x0b, 0x57,
+	0x68, 0x55, 0xe4, 0x29, 0x45, 0xa0, 0xd4, 0x34, 0xd4, 0x0d, 0x35, 0x8f, 0xfd, 0x2e, 0x8f, 0x42,
+	0x0e, 0xfe, 0x10, 0x53, 0xee, 0x46, 0x0f, 0xb3, 0x8a, 0xe4, 0x3d, 0x95, 0x2c, 0xb4, 0x01, 0xf5,
+	0x71, 0x9f, 0xba, 0x8b, 0xff, 0xb6, 0xe0, 0xce, 0xf3, 0x00, 0x47, 0x11, 0xe1, 0x9d, 0xad, 0x2e,
+	0xf7, 0xdc, 0xb7, 0xef, 0xf2, 0x1e, 0xfa, 0x08, 0x20, 0x22, 0x59, 0x28, 0x85, 0x59, 0x20, 0xca,
+	0xfc, 0xa0, 0x8a, 0x4f, 0x80, 0xc7, 0x83, 0xd8, 0xef, 0x1a, 0xbd, 0xc5, 0x99, 0xe0, 0xe5, 0x51,
+	0xad, 0xf9, 0x09, 0x34, 0x2f, 0xb0, 0xcf, 0xdc, 0x94, 0x24, 0x81, 0xef, 0x61, 0x6a, 0x16, 0x27,
+	0x37, 0xa4, 0xf2, 0x59, 0x2a, 0x38, 0x0d, 0x21, 0x77, 0xb4, 0x58, 0x6f, 0x50, 0x4f, 0xa9, 0xe8,
+	0x87, 0xbb, 0x97, 0x43, 0xd7, 0x3d, 0xf1, 0x39, 0xac, 0xf2, 0x35, 0x2e, 0x8c, 0x19, 0xc9, 0xc2,
+	0x9a, 0x59, 0x93, 0x9a, 0x39, 0xad, 0x23, 0xdb, 0x86, 0x92, 0x5c, 0xb4, 0x28, 0x4f, 0x50, 0xe1,
+	0x92, 0x35, 0x4c, 0x4b, 0xd1, 0x9f, 0x16, 0xdc, 0x3b, 0x0c, 0x49, 0xda, 0x23, 0x91, 0x37, 0xfc,
+	0x5f, 0xab, 0x30, 0x2b, 0x97, 0x8b, 0xb3, 0x72, 0xf9, 0x8b, 0x05, 0xef, 0x5c, 0x05, 0xe1, 0x3f,
+	0xce, 0xe6, 0x6f, 0x16, 0x54, 0xf7, 0xb1, 0x77, 0xde, 0x4f, 0xde, 0xfe, 0x62, 0x6d, 0x41, 0xc5,
+	0x8b, 0x23, 0xfe, 0x60, 0xa4, 0x02, 0x97, 0x5e, 0xc5, 0x47, 0x59, 0x53, 0x57, 0xaf, 0x30, 0x7d,
+	0xf5, 0x1e, 0x43, 0xcd, 0xc4, 0x93, 0xbd, 0x88, 0x06, 0x8a, 0x35, 0x13, 0xca, 0xa1, 0x9c, 0xea,
+	0x4a, 0xf9, 0xfa, 0x53, 0x1d, 0x3d, 0x90, 0xf3, 0x3b, 0x33, 0xa3, 0x83, 0xe0, 0x67, 0xc5, 0xab,
+	0x69, 0x7e, 0x3f, 0x14, 0x81, 0x7a, 0xd0, 0x78, 0x19, 0xa7, 0xe7, 0xa7, 0x3c, 0xfc, 0x3d, 0xf9,
+	0xc7, 0x31, 0x8f, 0x5b, 0x2e, 0xbb, 0xd0, 0x4a, 0xda, 0x73, 0x46, 0xdb, 0x1b, 0x50, 0x52, 0xbf,
+	0x2e, 0x32, 0x35, 0x65, 0x47, 0x53, 0x62, 0xbf, 0x9a, 0x74, 0xa4, 0x47, 0x52, 0x5b, 0xa2, 0xd6,
+	0x3b, 0xea, 0x3c, 0x3b, 0xf8, 0xbe, 0xc4, 0x97, 0x29, 0x68, 0x7c, 0xef, 0xc3, 0x72, 0xb6, 0x01,
+	0xab, 0x8a, 0xaf, 0xb5, 0xcc, 0xdf, 0x59, 0xb6, 0xd3, 0x2d, 0x0d, 0x94, 0x16, 0xfa, 0xc3, 0x82,
+	0xf5, 0xbd, 0x24, 0x09, 0x86, 0xf3, 0xfb, 0x1d, 0xf3, 0xb0, 0xf0, 0x26, 0x0f, 0xf6, 0x6d, 0xfe,
+	0x5a, 0xa5, 0x43, 0x37, 0xed, 0x47, 0xba, 0x49, 0x4a, 0x9c, 0x74, 0xfa, 0x91, 0x68, 0x21, 0x7a,
+	0xee, 0x27, 0xfc, 0xd2, 0x75, 0xfa, 0x7e, 0xd0, 0xd5, 0x4b, 0x48, 0x45, 0xf0, 0x1c, 0xc5, 0xca,
+	0xdf, 0xe5, 0xe2, 0xe8, 0xbb, 0xfc, 0x04, 0xea, 0xe3, 0x21, 0x5f, 0x07, 0xf9, 0xfe, 0xce, 0xab,
+	0xed, 0x81, 0xcf, 0x08, 0xa5, 0x2d, 0x3f, 0x6e, 0xab, 0xaf, 0x76, 0x8f, 0x7f, 0xb1, 0xb6, 0xfc,
+	0x87, 0x6d, 0x67, 0xdb, 0x5a, 0xa7, 0x24, 0x19, 0x8f, 0xfe, 0x01, 0x45, 0x55, 0x9b, 0xff, 0x20,
+	0x0f, 0x00, 0x00,
 }
@@ -135,7 +135,26 @@ type Session struct {
 	// pre_sessions contains sessions that have to be committed first.
 	PreSessions []*Session_ShardSession `protobuf:"bytes,9,rep,name=pre_sessions,json=preSessions,proto3" json:"pre_sessions,omitempty"`
 	// post_sessions contains sessions that have to be committed last.
-	PostSessions         []*Session_ShardSession `protobuf:"bytes,10,rep,name=post_sessions,json=postSessions,proto3" json:"post_sessions,omitempty"`
+	PostSessions []*Session_ShardSession `protobuf:"bytes,10,rep,name=post_sessions,json=postSessions,proto3" json:"post_sessions,omitempty"`
+	// savepoints lists the names of the savepoints of the transaction,
+	// oldest first. They're created on the shards as they join the
+	// transaction.
+	Savepoints []string `protobuf:"bytes,11,rep,name=savepoints,proto3" json:"savepoints,omitempty"`
+	// reserved_statements are the statements that changed the state of
+	// the MySQL session, like SET sql_mode. They are run on the reserved
+	// connections as they're reserved.
+	ReservedStatements []string `protobuf:"bytes,12,rep,name=reserved_statements,json=reservedStatements,proto3" json:"reserved_statements,omitempty"`
+	// reserved_sessions keep track of the reserved connections.
+	ReservedSessions []*Session_ShardSession `protobuf:"bytes,13,rep,name=reserved_sessions,json=reservedSessions,proto3" json:"reserved_sessions,omitempty"`
+	// reserved is set once the session changed the state of the MySQL
+	// session, with reserved_statements or temporary tables. All its
+	// queries are then executed on reserved connections.
+	Reserved bool `protobuf:"varint,14,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	// read_after_positions make the queries of the next Execute,
+	// StreamExecute or ExecuteBatch of the session wait until the tablets
+	// they're sent to have applied the replication position of their
+	// shard. vtgate clears them once it received the request.
+	ReadAfterPositions   []*binlogdata.ShardGtid `protobuf:"bytes,15,rep,name=read_after_positions,json=readAfterPositions,proto3" json:"read_after_positions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -236,6 +255,41 @@ func (m *Session) GetPostSessions() []*Session_ShardSession {
 	return nil
 }
 
+func (m *Session) GetSavepoints() []string {
+	if m != nil {
+		return m.Savepoints
+	}
+	return nil
+}
+
+func (m *Session) GetReservedStatements() []string {
+	if m != nil {
+		return m.ReservedStatements
+	}
+	return nil
+}
+
+func (m *Session) GetReservedSessions() []*Session_ShardSession {
+	if m != nil {
+		return m.ReservedSessions
+	}
+	return nil
+}
+
+func (m *Session) GetReserved() bool {
+	if m != nil {
+		return m.Reserved
+	}
+	return false
+}
+
+func (m *Session) GetReadAfterPositions() []*binlogdata.ShardGtid {
+	if m != nil {
+		return m.ReadAfterPositions
+	}
+	return nil
+}
+
 type Session_ShardSession struct {
 	Target               *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	TransactionId        int64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -2928,8 +2982,9 @@ type SplitQueryRequest struct {
 	// SELECT <cols> FROM <table> WHERE <filter>.
 	// It must not contain subqueries nor any of the keywords
 	// JOIN, GROUP BY, ORDER BY, LIMIT, DISTINCT.
-	// Furthermore, <table> must be a single "concrete" table.
-	// It cannot be a view.
+	// Furthermore, <table> must be a single "concrete" table, or a view or a
+	// derived table selecting columns of a single table without aggregation,
+	// which is then split on the keys of that table.
 	Query *query.BoundQuery `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
 	// Each generated query-part will be restricted to rows whose values
 	// in the columns listed in this field are in a particular range.
@@ -2963,7 +3018,7 @@ type SplitQueryRequest struct {
 	//    is used (or the first primary key column if the 'split_column' field is
 	//    empty). In the rest of this algorithm's description, we refer to
 	//    this column as "the split column".
-	//    The split column must have numeric type (integral or floating point).
+	//    The split column must have numeric type (integral, decimal or floating point).
 	//    The algorithm works by taking the interval [min, max], where min and
 	//    max are the minimum and maximum values of the split column in
 	//    the table-shard, respectively, and partitioning it into 'split_count'
@@ -2988,7 +3043,11 @@ type SplitQueryRequest struct {
 	// Remove this field after this new server code is released to prod.
 	// We must keep it for now, so that clients can still send it to the old
 	// server code currently in production.
-	UseSplitQueryV2      bool     `protobuf:"varint,8,opt,name=use_split_query_v2,json=useSplitQueryV2,proto3" json:"use_split_query_v2,omitempty"`
+	UseSplitQueryV2 bool `protobuf:"varint,8,opt,name=use_split_query_v2,json=useSplitQueryV2,proto3" json:"use_split_query_v2,omitempty"`
+	// cell restricts the split computation to the rdonly tablets of
+	// that cell. The query parts must then be executed in that cell,
+	// by setting the target_cell of the ExecuteOptions.
+	Cell                 string   `protobuf:"bytes,9,opt,name=cell,proto3" json:"cell,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3075,6 +3134,13 @@ func (m *SplitQueryRequest) GetUseSplitQueryV2() bool {
 	return false
 }
 
+func (m *SplitQueryRequest) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
 // SplitQueryResponse is the returned value from SplitQuery.
 type SplitQueryResponse struct {
 	// splits contains the queries to run to fetch the entire data set.
@@ -3223,7 +3289,12 @@ type SplitQueryResponse_Part struct {
 	// shard_part is set if the query should be executed by ExecuteShards.
 	ShardPart *SplitQueryResponse_ShardPart `protobuf:"bytes,3,opt,name=shard_part,json=shardPart,proto3" json:"shard_part,omitempty"`
 	// size is the approximate number of rows this query will return.
-	Size                 int64    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// tablet_type is the type of the tablets to execute the query on.
+	TabletType topodata.TabletType `protobuf:"varint,5,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
+	// cell is the cell to execute the query in, if the split was
+	// restricted to a cell. It's the target_cell of the ExecuteOptions.
+	Cell                 string   `protobuf:"bytes,6,opt,name=cell,proto3" json:"cell,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3282,6 +3353,20 @@ func (m *SplitQueryResponse_Part) GetSize() int64 {
 	return 0
 }
 
+func (m *SplitQueryResponse_Part) GetTabletType() topodata.TabletType {
+	if m != nil {
+		return m.TabletType
+	}
+	return topodata.TabletType_UNKNOWN
+}
+
+func (m *SplitQueryResponse_Part) GetCell() string {
+	if m != nil {
+		return m.Cell
+	}
+	return ""
+}
+
 // GetSrvKeyspaceRequest is the payload to GetSrvKeyspace.
 type GetSrvKeyspaceRequest struct {
 	// keyspace name to fetch.
@@ -3626,6 +3711,205 @@ func (m *UpdateStreamResponse) GetResumeTimestamp() int64 {
 	return 0
 }
 
+// RuntimeFlag is the value of a flag that can be changed without
+// restarting vtgate.
+type RuntimeFlag struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimeFlag) Reset()         { *m = RuntimeFlag{} }
+func (m *RuntimeFlag) String() string { return proto.CompactTextString(m) }
+func (*RuntimeFlag) ProtoMessage()    {}
+func (*RuntimeFlag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{47}
+}
+
+func (m *RuntimeFlag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuntimeFlag.Unmarshal(m, b)
+}
+func (m *RuntimeFlag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuntimeFlag.Marshal(b, m, deterministic)
+}
+func (m *RuntimeFlag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeFlag.Merge(m, src)
+}
+func (m *RuntimeFlag) XXX_Size() int {
+	return xxx_messageInfo_RuntimeFlag.Size(m)
+}
+func (m *RuntimeFlag) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeFlag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeFlag proto.InternalMessageInfo
+
+func (m *RuntimeFlag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RuntimeFlag) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// RuntimeFlagChange is a change of a flag at runtime.
+type RuntimeFlagChange struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OldValue             string   `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue             string   `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuntimeFlagChange) Reset()         { *m = RuntimeFlagChange{} }
+func (m *RuntimeFlagChange) String() string { return proto.CompactTextString(m) }
+func (*RuntimeFlagChange) ProtoMessage()    {}
+func (*RuntimeFlagChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{48}
+}
+
+func (m *RuntimeFlagChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuntimeFlagChange.Unmarshal(m, b)
+}
+func (m *RuntimeFlagChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuntimeFlagChange.Marshal(b, m, deterministic)
+}
+func (m *RuntimeFlagChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuntimeFlagChange.Merge(m, src)
+}
+func (m *RuntimeFlagChange) XXX_Size() int {
+	return xxx_messageInfo_RuntimeFlagChange.Size(m)
+}
+func (m *RuntimeFlagChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuntimeFlagChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuntimeFlagChange proto.InternalMessageInfo
+
+func (m *RuntimeFlagChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RuntimeFlagChange) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *RuntimeFlagChange) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+// SetRuntimeFlagsRequest is the payload to SetRuntimeFlags. The flags
+// are changed all together: if one of them can't be changed, none of
+// them is.
+type SetRuntimeFlagsRequest struct {
+	// caller_id identifies the caller. This is the effective caller ID,
+	// set by the application to further identify the caller.
+	CallerId *vtrpc.CallerID `protobuf:"bytes,1,opt,name=caller_id,json=callerId,proto3" json:"caller_id,omitempty"`
+	// flags are the new values of the flags.
+	Flags                []*RuntimeFlag `protobuf:"bytes,2,rep,name=flags,proto3" json:"flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetRuntimeFlagsRequest) Reset()         { *m = SetRuntimeFlagsRequest{} }
+func (m *SetRuntimeFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRuntimeFlagsRequest) ProtoMessage()    {}
+func (*SetRuntimeFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{49}
+}
+
+func (m *SetRuntimeFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRuntimeFlagsRequest.Unmarshal(m, b)
+}
+func (m *SetRuntimeFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRuntimeFlagsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetRuntimeFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRuntimeFlagsRequest.Merge(m, src)
+}
+func (m *SetRuntimeFlagsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRuntimeFlagsRequest.Size(m)
+}
+func (m *SetRuntimeFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRuntimeFlagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRuntimeFlagsRequest proto.InternalMessageInfo
+
+func (m *SetRuntimeFlagsRequest) GetCallerId() *vtrpc.CallerID {
+	if m != nil {
+		return m.CallerId
+	}
+	return nil
+}
+
+func (m *SetRuntimeFlagsRequest) GetFlags() []*RuntimeFlag {
+	if m != nil {
+		return m.Flags
+	}
+	return nil
+}
+
+// SetRuntimeFlagsResponse is the returned value from SetRuntimeFlags.
+type SetRuntimeFlagsResponse struct {
+	// changes are the old and new values of the flags.
+	Changes              []*RuntimeFlagChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SetRuntimeFlagsResponse) Reset()         { *m = SetRuntimeFlagsResponse{} }
+func (m *SetRuntimeFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetRuntimeFlagsResponse) ProtoMessage()    {}
+func (*SetRuntimeFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab96496ceaf1ebb, []int{50}
+}
+
+func (m *SetRuntimeFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRuntimeFlagsResponse.Unmarshal(m, b)
+}
+func (m *SetRuntimeFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRuntimeFlagsResponse.Marshal(b, m, deterministic)
+}
+func (m *SetRuntimeFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRuntimeFlagsResponse.Merge(m, src)
+}
+func (m *SetRuntimeFlagsResponse) XXX_Size() int {
+	return xxx_messageInfo_SetRuntimeFlagsResponse.Size(m)
+}
+func (m *SetRuntimeFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRuntimeFlagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRuntimeFlagsResponse proto.InternalMessageInfo
+
+func (m *SetRuntimeFlagsResponse) GetChanges() []*RuntimeFlagChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("vtgate.TransactionMode", TransactionMode_name, TransactionMode_value)
 	proto.RegisterEnum("vtgate.CommitOrder", CommitOrder_name, CommitOrder_value)
@@ -3681,138 +3965,155 @@ func init() {
 	proto.RegisterType((*VStreamResponse)(nil), "vtgate.VStreamResponse")
 	proto.RegisterType((*UpdateStreamRequest)(nil), "vtgate.UpdateStreamRequest")
 	proto.RegisterType((*UpdateStreamResponse)(nil), "vtgate.UpdateStreamResponse")
+	proto.RegisterType((*RuntimeFlag)(nil), "vtgate.RuntimeFlag")
+	proto.RegisterType((*RuntimeFlagChange)(nil), "vtgate.RuntimeFlagChange")
+	proto.RegisterType((*SetRuntimeFlagsRequest)(nil), "vtgate.SetRuntimeFlagsRequest")
+	proto.RegisterType((*SetRuntimeFlagsResponse)(nil), "vtgate.SetRuntimeFlagsResponse")
 }
 
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 2041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x8f, 0x23, 0x47,
-	0x15, 0x4f, 0x77, 0xfb, 0xf3, 0xf9, 0x73, 0x6b, 0xbc, 0x1b, 0xc7, 0x19, 0x76, 0x26, 0x1d, 0x46,
-	0xeb, 0x6c, 0x56, 0x1e, 0xe2, 0x40, 0x40, 0x28, 0x28, 0xcc, 0x78, 0x27, 0x2b, 0x2b, 0x3b, 0x1f,
-	0x94, 0xbd, 0xb3, 0x80, 0x88, 0x5a, 0x3d, 0x76, 0xe1, 0x6d, 0xc6, 0xee, 0x76, 0xba, 0xca, 0x5e,
-	0x86, 0x03, 0xca, 0x7f, 0x10, 0x71, 0x40, 0x42, 0x11, 0x12, 0x42, 0x42, 0xe2, 0xc4, 0x15, 0x09,
-	0xb8, 0x70, 0x43, 0xe2, 0x82, 0x38, 0x71, 0x44, 0xe2, 0x1f, 0x40, 0xe2, 0x2f, 0x40, 0x5d, 0x55,
-	0xfd, 0xe1, 0x9e, 0x2f, 0x8f, 0x67, 0x67, 0xe5, 0xbd, 0x58, 0x5d, 0xf5, 0x5e, 0x55, 0xbd, 0xf7,
-	0x7b, 0xbf, 0x7a, 0xf5, 0xba, 0xdc, 0x90, 0x9f, 0xb2, 0x81, 0xc9, 0x48, 0x63, 0xec, 0x3a, 0xcc,
-	0x41, 0x29, 0xd1, 0xaa, 0x95, 0x8f, 0x2c, 0x7b, 0xe8, 0x0c, 0xfa, 0x26, 0x33, 0x85, 0xa4, 0x96,
-	0xfb, 0x6c, 0x42, 0xdc, 0x13, 0xd9, 0x28, 0x32, 0x67, 0xec, 0x44, 0x85, 0x53, 0xe6, 0x8e, 0x7b,
-	0xa2, 0xa1, 0xff, 0x3b, 0x01, 0xe9, 0x0e, 0xa1, 0xd4, 0x72, 0x6c, 0xb4, 0x01, 0x45, 0xcb, 0x36,
-	0x98, 0x6b, 0xda, 0xd4, 0xec, 0x31, 0xcb, 0xb1, 0xab, 0xca, 0xba, 0x52, 0xcf, 0xe0, 0x82, 0x65,
-	0x77, 0xc3, 0x4e, 0xd4, 0x82, 0x22, 0x7d, 0x66, 0xba, 0x7d, 0x83, 0x8a, 0x71, 0xb4, 0xaa, 0xae,
-	0x6b, 0xf5, 0x5c, 0x73, 0xb5, 0x21, 0xad, 0x93, 0xf3, 0x35, 0x3a, 0x9e, 0x96, 0x6c, 0xe0, 0x02,
-	0x8d, 0xb4, 0x28, 0x7a, 0x13, 0xb2, 0xd4, 0xb2, 0x07, 0x43, 0x62, 0xf4, 0x8f, 0xaa, 0x1a, 0x5f,
-	0x26, 0x23, 0x3a, 0x1e, 0x1e, 0xa1, 0xbb, 0x00, 0xe6, 0x84, 0x39, 0x3d, 0x67, 0x34, 0xb2, 0x58,
-	0x35, 0xc1, 0xa5, 0x91, 0x1e, 0xf4, 0x36, 0x14, 0x98, 0xe9, 0x0e, 0x08, 0x33, 0x28, 0x73, 0x2d,
-	0x7b, 0x50, 0x4d, 0xae, 0x2b, 0xf5, 0x2c, 0xce, 0x8b, 0xce, 0x0e, 0xef, 0x43, 0x9b, 0x90, 0x76,
-	0xc6, 0x8c, 0xdb, 0x97, 0x5a, 0x57, 0xea, 0xb9, 0xe6, 0xed, 0x86, 0x40, 0x65, 0xe7, 0xa7, 0xa4,
-	0x37, 0x61, 0x64, 0x5f, 0x08, 0xb1, 0xaf, 0x85, 0xb6, 0xa1, 0x1c, 0xf1, 0xdd, 0x18, 0x39, 0x7d,
-	0x52, 0x4d, 0xaf, 0x2b, 0xf5, 0x62, 0xf3, 0x75, 0xdf, 0xb3, 0x08, 0x0c, 0xbb, 0x4e, 0x9f, 0xe0,
-	0x12, 0x9b, 0xed, 0x40, 0x9b, 0x90, 0x79, 0x6e, 0xba, 0xb6, 0x65, 0x0f, 0x68, 0x35, 0xc3, 0x51,
-	0x59, 0x91, 0xab, 0x7e, 0xcf, 0xfb, 0x7d, 0x2a, 0x64, 0x38, 0x50, 0x42, 0x1f, 0x41, 0x7e, 0xec,
-	0x92, 0x10, 0xca, 0xec, 0x1c, 0x50, 0xe6, 0xc6, 0x2e, 0x09, 0x80, 0xdc, 0x82, 0xc2, 0xd8, 0xa1,
-	0x2c, 0x9c, 0x01, 0xe6, 0x98, 0x21, 0xef, 0x0d, 0xf1, 0xa7, 0xa8, 0xfd, 0x08, 0xf2, 0x51, 0x29,
-	0xda, 0x80, 0x94, 0x40, 0x92, 0xc7, 0x3f, 0xd7, 0x2c, 0x48, 0x17, 0xba, 0xbc, 0x13, 0x4b, 0xa1,
-	0x47, 0x97, 0x28, 0x5e, 0x56, 0xbf, 0xaa, 0xae, 0x2b, 0x75, 0x0d, 0x17, 0x22, 0xbd, 0xed, 0xbe,
-	0xfe, 0x0f, 0x15, 0x8a, 0x12, 0x72, 0x4c, 0x3e, 0x9b, 0x10, 0xca, 0xd0, 0x03, 0xc8, 0xf6, 0xcc,
-	0xe1, 0x90, 0xb8, 0xde, 0x20, 0xb1, 0x46, 0xa9, 0x21, 0x58, 0xd9, 0xe2, 0xfd, 0xed, 0x87, 0x38,
-	0x23, 0x34, 0xda, 0x7d, 0xf4, 0x0e, 0xa4, 0xa5, 0x73, 0x7c, 0x01, 0xa1, 0x1b, 0xf5, 0x0d, 0xfb,
-	0x72, 0x74, 0x0f, 0x92, 0xdc, 0x54, 0xce, 0xa8, 0x5c, 0xf3, 0x96, 0x34, 0x7c, 0xdb, 0x99, 0xd8,
-	0x7d, 0x1e, 0x00, 0x2c, 0xe4, 0xe8, 0x1b, 0x90, 0x63, 0xe6, 0xd1, 0x90, 0x30, 0x83, 0x9d, 0x8c,
-	0x09, 0xa7, 0x58, 0xb1, 0x59, 0x69, 0x04, 0x3b, 0xa5, 0xcb, 0x85, 0xdd, 0x93, 0x31, 0xc1, 0xc0,
-	0x82, 0x67, 0xf4, 0x00, 0x90, 0xed, 0x30, 0x23, 0xb6, 0x4b, 0x92, 0x9c, 0xa0, 0x65, 0xdb, 0x61,
-	0xed, 0x99, 0x8d, 0xb2, 0x01, 0xc5, 0x63, 0x72, 0x42, 0xc7, 0x66, 0x8f, 0x18, 0x9c, 0xfd, 0x9c,
-	0x88, 0x59, 0x5c, 0xf0, 0x7b, 0x39, 0xea, 0x51, 0xa2, 0xa6, 0xe7, 0x21, 0xaa, 0xfe, 0x85, 0x02,
-	0xa5, 0x00, 0x51, 0x3a, 0x76, 0x6c, 0x4a, 0xd0, 0x06, 0x24, 0x89, 0xeb, 0x3a, 0x6e, 0x0c, 0x4e,
-	0x7c, 0xd0, 0xda, 0xf1, 0xba, 0xb1, 0x90, 0x5e, 0x05, 0xcb, 0xfb, 0x90, 0x72, 0x09, 0x9d, 0x0c,
-	0x99, 0x04, 0x13, 0x45, 0x89, 0x8c, 0xb9, 0x04, 0x4b, 0x0d, 0xfd, 0x3f, 0x2a, 0x54, 0xa4, 0x45,
-	0xdc, 0x27, 0xba, 0x3c, 0x91, 0xae, 0x41, 0xc6, 0x87, 0x9b, 0x87, 0x39, 0x8b, 0x83, 0x36, 0xba,
-	0x03, 0x29, 0x1e, 0x17, 0x5a, 0x4d, 0xae, 0x6b, 0xf5, 0x2c, 0x96, 0xad, 0x38, 0x3b, 0x52, 0xd7,
-	0x62, 0x47, 0xfa, 0x1c, 0x76, 0x44, 0xc2, 0x9e, 0x99, 0x2b, 0xec, 0xbf, 0x54, 0xe0, 0x76, 0x0c,
-	0xe4, 0xa5, 0x08, 0xfe, 0xff, 0x54, 0x78, 0x43, 0xda, 0xf5, 0x89, 0x44, 0xb6, 0xfd, 0xaa, 0x30,
-	0xe0, 0x2d, 0xc8, 0x07, 0x5b, 0xd4, 0x92, 0x3c, 0xc8, 0xe3, 0xdc, 0x71, 0xe8, 0xc7, 0x92, 0x92,
-	0xe1, 0x4b, 0x05, 0x6a, 0x67, 0x81, 0xbe, 0x14, 0x8c, 0xf8, 0x5c, 0x83, 0xd7, 0x43, 0xe3, 0xb0,
-	0x69, 0x0f, 0xc8, 0x2b, 0xc2, 0x87, 0xf7, 0x00, 0x8e, 0xc9, 0x89, 0xe1, 0x72, 0x93, 0x39, 0x1b,
-	0x3c, 0x4f, 0x83, 0x58, 0xfb, 0xde, 0xe0, 0xec, 0xb1, 0xef, 0xd7, 0x92, 0xf2, 0xe3, 0x57, 0x0a,
-	0x54, 0x4f, 0x87, 0x60, 0x29, 0xd8, 0xf1, 0xa7, 0x44, 0xc0, 0x8e, 0x1d, 0x9b, 0x59, 0xec, 0xe4,
-	0x95, 0xc9, 0x16, 0x0f, 0x00, 0x11, 0x6e, 0xb1, 0xd1, 0x73, 0x86, 0x93, 0x91, 0x6d, 0xd8, 0xe6,
-	0x88, 0xc8, 0xe2, 0xb3, 0x2c, 0x24, 0x2d, 0x2e, 0xd8, 0x33, 0x47, 0x04, 0x7d, 0x1f, 0x56, 0xa4,
-	0xf6, 0x4c, 0x8a, 0x49, 0x71, 0x52, 0xd5, 0x7d, 0x4b, 0xcf, 0x41, 0xa2, 0xe1, 0x77, 0xe0, 0x5b,
-	0x62, 0x92, 0x4f, 0xce, 0x4f, 0x49, 0xe9, 0x6b, 0x51, 0x2e, 0x73, 0x39, 0xe5, 0xb2, 0xf3, 0x50,
-	0xae, 0x76, 0x04, 0x19, 0xdf, 0x68, 0xb4, 0x06, 0x09, 0x6e, 0x9a, 0xc2, 0x4d, 0xcb, 0xf9, 0x05,
-	0xa4, 0x67, 0x11, 0x17, 0xa0, 0x0a, 0x24, 0xa7, 0xe6, 0x70, 0x42, 0x78, 0xe0, 0xf2, 0x58, 0x34,
-	0xd0, 0x1a, 0xe4, 0x22, 0x58, 0xf1, 0x58, 0xe5, 0x31, 0x84, 0xd9, 0x38, 0x4a, 0xeb, 0x08, 0x62,
-	0x4b, 0x41, 0xeb, 0x7f, 0xaa, 0xb0, 0x22, 0x4d, 0xdb, 0x36, 0x59, 0xef, 0xd9, 0x8d, 0x53, 0xfa,
-	0x5d, 0x48, 0x7b, 0xd6, 0x58, 0x84, 0x56, 0x35, 0xce, 0xa9, 0x33, 0x48, 0xed, 0x6b, 0x2c, 0x5a,
-	0xf0, 0x6e, 0x40, 0xd1, 0xa4, 0x67, 0x14, 0xbb, 0x05, 0x93, 0xbe, 0x8c, 0x4a, 0xf7, 0x4b, 0x25,
-	0xa8, 0x2b, 0x25, 0xa6, 0x37, 0x16, 0xea, 0xaf, 0x41, 0x5a, 0x04, 0xd2, 0x47, 0xf3, 0x8e, 0xb4,
-	0x4d, 0x84, 0xf9, 0xa9, 0xc5, 0x9e, 0x89, 0xa9, 0x7d, 0x35, 0xdd, 0x86, 0x12, 0x47, 0x9a, 0xfb,
-	0xc6, 0xe1, 0x0e, 0xb3, 0x8c, 0x72, 0x85, 0x2c, 0xa3, 0x9e, 0x5b, 0x95, 0x6a, 0xd1, 0xaa, 0x54,
-	0xff, 0x63, 0x58, 0x67, 0x71, 0x30, 0x5e, 0x52, 0xa5, 0xfd, 0x5e, 0x9c, 0x66, 0xc1, 0xdb, 0x70,
-	0xcc, 0xfb, 0x97, 0x45, 0xb6, 0xab, 0xbe, 0xd8, 0xeb, 0xbf, 0x0e, 0x6b, 0xa5, 0x19, 0xe0, 0x6e,
-	0x8c, 0x4b, 0x0f, 0xe2, 0x5c, 0x3a, 0x2b, 0x6f, 0x04, 0x3c, 0xfa, 0x39, 0x54, 0x38, 0x92, 0x61,
-	0x86, 0x7f, 0x81, 0x64, 0x8a, 0x17, 0xb8, 0xda, 0xa9, 0x02, 0x57, 0xff, 0xab, 0x0a, 0x77, 0xa3,
-	0xf0, 0xbc, 0xcc, 0x22, 0xfe, 0x83, 0x38, 0xb9, 0x56, 0x67, 0xc8, 0x15, 0x83, 0x64, 0x69, 0x19,
-	0xf6, 0x5b, 0x05, 0xd6, 0xce, 0x85, 0x70, 0x49, 0x68, 0xf6, 0x7b, 0x15, 0x2a, 0x1d, 0xe6, 0x12,
-	0x73, 0x74, 0xad, 0xdb, 0x98, 0x80, 0x95, 0xea, 0xd5, 0xae, 0x58, 0xb4, 0xf9, 0x43, 0x14, 0x3b,
-	0x4a, 0x12, 0x97, 0x1c, 0x25, 0xc9, 0xb9, 0x6e, 0xf7, 0x22, 0xb8, 0xa6, 0x2e, 0xc6, 0x55, 0x6f,
-	0xc1, 0xed, 0x18, 0x50, 0x32, 0x84, 0x61, 0x39, 0xa0, 0x5c, 0x5a, 0x0e, 0x7c, 0xa1, 0x42, 0x6d,
-	0x66, 0x96, 0xeb, 0xa4, 0xeb, 0xb9, 0x41, 0x8f, 0xa6, 0x02, 0xed, 0xdc, 0x73, 0x25, 0x71, 0xd1,
-	0x6d, 0x47, 0x72, 0xce, 0x40, 0x5d, 0x79, 0x93, 0xb4, 0xe1, 0xcd, 0x33, 0x01, 0x59, 0x00, 0xdc,
-	0xdf, 0xa8, 0xb0, 0x36, 0x33, 0xd7, 0xb5, 0x73, 0xd6, 0x0b, 0x41, 0x38, 0x9e, 0x6c, 0x13, 0x97,
-	0xde, 0x26, 0xdc, 0x18, 0xd8, 0x7b, 0xb0, 0x7e, 0x3e, 0x40, 0x0b, 0x20, 0xfe, 0x07, 0x15, 0xbe,
-	0x12, 0x9f, 0xf0, 0x3a, 0x2f, 0xf6, 0x2f, 0x04, 0xef, 0xd9, 0xb7, 0xf5, 0xc4, 0x02, 0x6f, 0xeb,
-	0x37, 0x86, 0xff, 0x63, 0xb8, 0x7b, 0x1e, 0x5c, 0x0b, 0xa0, 0xff, 0x03, 0xc8, 0x6f, 0x93, 0x81,
-	0x65, 0x2f, 0x86, 0xf5, 0xcc, 0x7f, 0x2d, 0xea, 0xec, 0x7f, 0x2d, 0xfa, 0xb7, 0xa1, 0x20, 0xa7,
-	0x96, 0x76, 0x45, 0x12, 0xa5, 0x72, 0x49, 0xa2, 0xfc, 0x5c, 0x81, 0x42, 0x8b, 0xff, 0x25, 0x73,
-	0xe3, 0x85, 0xc2, 0x1d, 0x48, 0x99, 0xcc, 0x19, 0x59, 0x3d, 0xf9, 0x67, 0x91, 0x6c, 0xe9, 0x65,
-	0x28, 0xfa, 0x16, 0x08, 0xfb, 0xf5, 0x9f, 0x40, 0x09, 0x3b, 0xc3, 0xe1, 0x91, 0xd9, 0x3b, 0xbe,
-	0x69, 0xab, 0x74, 0x04, 0xe5, 0x70, 0x2d, 0xb9, 0xfe, 0xa7, 0xf0, 0x06, 0x26, 0xd4, 0x19, 0x4e,
-	0x49, 0xa4, 0xa4, 0x58, 0xcc, 0x12, 0x04, 0x89, 0x3e, 0x93, 0xff, 0xab, 0x64, 0x31, 0x7f, 0xd6,
-	0xff, 0xa2, 0x40, 0x65, 0x97, 0x50, 0x6a, 0x0e, 0x88, 0x20, 0xd8, 0x62, 0x53, 0x5f, 0x54, 0x33,
-	0x56, 0x20, 0x29, 0x4e, 0x5e, 0xb1, 0xdf, 0x44, 0x03, 0x6d, 0x42, 0x36, 0xd8, 0x6c, 0xfc, 0x4c,
-	0x3e, 0x7b, 0xaf, 0x65, 0xfc, 0xbd, 0xe6, 0x59, 0x1f, 0xb9, 0x1f, 0xe1, 0xcf, 0xfa, 0x2f, 0x14,
-	0xb8, 0x25, 0xad, 0xdf, 0x5a, 0x34, 0x3e, 0x17, 0x99, 0xee, 0xaf, 0xa9, 0x85, 0x6b, 0xa2, 0xbb,
-	0xa0, 0xf9, 0xc9, 0x38, 0xd7, 0xcc, 0xcb, 0x5d, 0x76, 0x68, 0x0e, 0x27, 0x04, 0x7b, 0x02, 0x7d,
-	0x17, 0xf2, 0xed, 0x48, 0xa5, 0x89, 0x56, 0x41, 0x0d, 0xcc, 0x98, 0x55, 0x57, 0xad, 0x7e, 0xfc,
-	0x8a, 0x42, 0x3d, 0x75, 0x45, 0xf1, 0x67, 0x05, 0x56, 0x43, 0x17, 0xaf, 0x7d, 0x30, 0x5d, 0xd5,
-	0xdb, 0x0f, 0xa1, 0x64, 0xf5, 0x8d, 0x53, 0xc7, 0x50, 0xae, 0x59, 0xf1, 0x59, 0x1c, 0x75, 0x16,
-	0x17, 0xac, 0x48, 0x8b, 0xea, 0xab, 0x50, 0x3b, 0x8b, 0xbc, 0x92, 0xda, 0xff, 0x55, 0xe1, 0x56,
-	0x67, 0x3c, 0xb4, 0x98, 0xcc, 0x51, 0x2f, 0xda, 0x9f, 0xb9, 0x2f, 0xe9, 0xde, 0x82, 0x3c, 0xf5,
-	0xec, 0x90, 0xf7, 0x70, 0xb2, 0xa0, 0xc9, 0xf1, 0x3e, 0x71, 0x03, 0xe7, 0xc5, 0xc9, 0x57, 0x99,
-	0xd8, 0x8c, 0x93, 0x50, 0xc3, 0x20, 0x35, 0x26, 0x36, 0x43, 0x5f, 0x87, 0xd7, 0xed, 0xc9, 0xc8,
-	0x70, 0x9d, 0xe7, 0xd4, 0x18, 0x13, 0xd7, 0xe0, 0x33, 0x1b, 0x63, 0xd3, 0x65, 0x3c, 0xc5, 0x6b,
-	0x78, 0xc5, 0x9e, 0x8c, 0xb0, 0xf3, 0x9c, 0x1e, 0x10, 0x97, 0x2f, 0x7e, 0x60, 0xba, 0x0c, 0x7d,
-	0x17, 0xb2, 0xe6, 0x70, 0xe0, 0xb8, 0x16, 0x7b, 0x36, 0x92, 0x17, 0x6f, 0xba, 0x34, 0xf3, 0x14,
-	0x32, 0x8d, 0x2d, 0x5f, 0x13, 0x87, 0x83, 0xd0, 0xbb, 0x80, 0x26, 0x94, 0x18, 0xc2, 0x38, 0xb1,
-	0xe8, 0xb4, 0x29, 0x6f, 0xe1, 0x4a, 0x13, 0x4a, 0xc2, 0x69, 0x0e, 0x9b, 0xfa, 0xdf, 0x34, 0x40,
-	0xd1, 0x79, 0x65, 0x8e, 0xfe, 0x26, 0xa4, 0xf8, 0x78, 0x5a, 0x55, 0x78, 0x6c, 0xd7, 0x82, 0x0c,
-	0x75, 0x4a, 0xb7, 0xe1, 0x99, 0x8d, 0xa5, 0x7a, 0xed, 0x53, 0xc8, 0xfb, 0x3b, 0x95, 0xbb, 0x13,
-	0x8d, 0x86, 0x72, 0xe1, 0xe9, 0xaa, 0xce, 0x71, 0xba, 0xd6, 0x3e, 0x82, 0x2c, 0xaf, 0xea, 0x2e,
-	0x9d, 0x3b, 0xac, 0x45, 0xd5, 0x68, 0x2d, 0x5a, 0xfb, 0x97, 0x02, 0x09, 0x3e, 0x78, 0xee, 0x97,
-	0xdf, 0x5d, 0xfe, 0xbe, 0x20, 0xac, 0x14, 0xd1, 0x13, 0x49, 0xfb, 0xde, 0x05, 0x90, 0x44, 0x21,
-	0xc0, 0xf9, 0xe3, 0x28, 0x20, 0x2d, 0x00, 0xf1, 0x71, 0x03, 0x9f, 0x4a, 0xf0, 0xf0, 0xab, 0x17,
-	0x4c, 0x15, 0xb8, 0x8b, 0xb3, 0x34, 0xf0, 0x1c, 0x41, 0x82, 0x5a, 0x3f, 0x13, 0x59, 0x52, 0xc3,
-	0xfc, 0x59, 0x7f, 0x1f, 0x6e, 0x3f, 0x22, 0xac, 0xe3, 0x4e, 0xfd, 0xed, 0xe6, 0x6f, 0x9f, 0x0b,
-	0x60, 0xd2, 0x31, 0xdc, 0x89, 0x0f, 0x92, 0x0c, 0xf8, 0x16, 0xe4, 0xa9, 0x3b, 0x35, 0x66, 0x46,
-	0x7a, 0x55, 0x49, 0x10, 0x9e, 0xe8, 0xa0, 0x1c, 0x0d, 0x1b, 0xfa, 0xdf, 0x15, 0x28, 0x1e, 0x5e,
-	0xe7, 0xe8, 0x88, 0x95, 0x50, 0xea, 0x9c, 0x25, 0xd4, 0x3d, 0x48, 0x4e, 0x07, 0x4c, 0xde, 0xea,
-	0x7a, 0x11, 0x8d, 0x7c, 0xb5, 0x72, 0xf8, 0x88, 0x59, 0x7d, 0x2c, 0xe4, 0x5e, 0x61, 0xf4, 0x63,
-	0x6b, 0xc8, 0x88, 0x1b, 0x9c, 0x32, 0x11, 0xcd, 0x8f, 0xb9, 0x04, 0x4b, 0x0d, 0xfd, 0x3b, 0x50,
-	0x0a, 0x7c, 0x09, 0xeb, 0x2a, 0x32, 0x25, 0x76, 0xb0, 0x37, 0x66, 0x86, 0x1f, 0xee, 0x78, 0x22,
-	0x2c, 0x35, 0xf4, 0xdf, 0xa9, 0xb0, 0xf2, 0x64, 0xdc, 0x37, 0xd9, 0xb2, 0x9f, 0xa5, 0x0b, 0x96,
-	0xad, 0xab, 0x90, 0x65, 0xd6, 0x88, 0x50, 0x66, 0x8e, 0xc6, 0x32, 0xab, 0x85, 0x1d, 0x5e, 0x44,
-	0x38, 0x0e, 0xf2, 0x32, 0xd6, 0xdf, 0x63, 0x1c, 0xa2, 0xae, 0x73, 0x4c, 0x6c, 0x2c, 0xe4, 0xfa,
-	0x31, 0x54, 0x66, 0x51, 0x92, 0x50, 0xd7, 0xfd, 0x09, 0x66, 0x2b, 0x58, 0x59, 0xf8, 0x72, 0xa4,
-	0x85, 0x02, 0x7a, 0x07, 0xca, 0x5e, 0x29, 0x3b, 0x22, 0x46, 0x68, 0x8f, 0xf8, 0x5a, 0xa4, 0x24,
-	0xfa, 0xbb, 0x7e, 0xf7, 0xfd, 0x87, 0x50, 0x8a, 0x7d, 0x66, 0x83, 0x4a, 0x90, 0x7b, 0xb2, 0xd7,
-	0x39, 0xd8, 0x69, 0xb5, 0x3f, 0x6e, 0xef, 0x3c, 0x2c, 0xbf, 0x86, 0x00, 0x52, 0x9d, 0xf6, 0xde,
-	0xa3, 0xc7, 0x3b, 0x65, 0x05, 0x65, 0x21, 0xb9, 0xfb, 0xe4, 0x71, 0xb7, 0x5d, 0x56, 0xbd, 0xc7,
-	0xee, 0xd3, 0xfd, 0x83, 0x56, 0x59, 0xbb, 0xff, 0x21, 0xe4, 0x44, 0x5d, 0xb8, 0xef, 0xf6, 0x89,
-	0xeb, 0x0d, 0xd8, 0xdb, 0xc7, 0xbb, 0x5b, 0x8f, 0xcb, 0xaf, 0xa1, 0x34, 0x68, 0x07, 0xd8, 0x1b,
-	0x99, 0x81, 0xc4, 0xc1, 0x7e, 0xa7, 0x5b, 0x56, 0x51, 0x11, 0x60, 0xeb, 0x49, 0x77, 0xbf, 0xb5,
-	0xbf, 0xbb, 0xdb, 0xee, 0x96, 0xb5, 0xed, 0x0f, 0xa0, 0x64, 0x39, 0x8d, 0xa9, 0xc5, 0x08, 0xa5,
-	0xe2, 0x43, 0xa9, 0x1f, 0xbe, 0x2d, 0x5b, 0x96, 0xb3, 0x29, 0x9e, 0x36, 0x07, 0xce, 0xe6, 0x94,
-	0x6d, 0x72, 0xe9, 0xa6, 0x48, 0x10, 0x47, 0x29, 0xde, 0x7a, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x4b, 0xc0, 0xed, 0xac, 0xa8, 0x25, 0x00, 0x00,
+	// 2253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x5a, 0x5b, 0x8f, 0x1b, 0x49,
+	0x15, 0xa6, 0xdb, 0x1e, 0x5f, 0x8e, 0xaf, 0xd3, 0x33, 0x93, 0x4c, 0xbc, 0x43, 0x12, 0x7a, 0x41,
+	0x3b, 0x64, 0x57, 0x1e, 0x76, 0x02, 0xbb, 0x08, 0x2d, 0x5a, 0x26, 0xce, 0x24, 0xb2, 0x36, 0x73,
+	0xa1, 0xec, 0x24, 0x80, 0x58, 0x59, 0x3d, 0x76, 0xc5, 0xd3, 0x8c, 0xdd, 0xed, 0xed, 0x2e, 0x3b,
+	0x84, 0x07, 0xb4, 0x12, 0x3f, 0x60, 0x05, 0x12, 0x12, 0x42, 0x48, 0x08, 0x84, 0xb4, 0x4f, 0xbc,
+	0x22, 0x01, 0x2f, 0x3c, 0xef, 0xcb, 0x8a, 0x7f, 0x80, 0xf8, 0x09, 0xfc, 0x02, 0x4e, 0x57, 0x55,
+	0x77, 0x97, 0x3d, 0x37, 0x8f, 0x27, 0x13, 0x39, 0x2f, 0x56, 0xd7, 0x39, 0x75, 0x39, 0xf5, 0x9d,
+	0xef, 0x9c, 0x3a, 0x5d, 0x6e, 0xc8, 0x8f, 0x58, 0xd7, 0x62, 0xb4, 0x3a, 0xf0, 0x5c, 0xe6, 0x1a,
+	0x29, 0xd1, 0xaa, 0x94, 0x0f, 0x6c, 0xa7, 0xe7, 0x76, 0x3b, 0x16, 0xb3, 0x84, 0xa6, 0x92, 0xfb,
+	0x64, 0x48, 0xbd, 0x17, 0xb2, 0x51, 0x64, 0xee, 0xc0, 0x55, 0x95, 0x23, 0xe6, 0x0d, 0xda, 0xa2,
+	0x61, 0xfe, 0x27, 0x05, 0xe9, 0x06, 0xf5, 0x7d, 0xdb, 0x75, 0x8c, 0x6f, 0x40, 0xd1, 0x76, 0x5a,
+	0xcc, 0xb3, 0x1c, 0xdf, 0x6a, 0x33, 0x94, 0xac, 0x6a, 0xb7, 0xb5, 0xf5, 0x0c, 0x29, 0xd8, 0x4e,
+	0x33, 0x16, 0x1a, 0x35, 0x28, 0xfa, 0x87, 0x96, 0xd7, 0x69, 0xf9, 0x62, 0x9c, 0xbf, 0xaa, 0xdf,
+	0x4e, 0xac, 0xe7, 0x36, 0xd7, 0xaa, 0xd2, 0x3a, 0x39, 0x5f, 0xb5, 0x11, 0xf4, 0x92, 0x0d, 0x52,
+	0xf0, 0x95, 0x96, 0x6f, 0xbc, 0x01, 0x59, 0xdf, 0x76, 0xba, 0x3d, 0xda, 0xea, 0x1c, 0xac, 0x26,
+	0xf8, 0x32, 0x19, 0x21, 0xb8, 0x7f, 0x60, 0xdc, 0x04, 0xb0, 0x86, 0xcc, 0x6d, 0xbb, 0xfd, 0xbe,
+	0xcd, 0x56, 0x93, 0x5c, 0xab, 0x48, 0x8c, 0x37, 0xa1, 0xc0, 0x2c, 0xaf, 0x4b, 0x59, 0xcb, 0x67,
+	0x1e, 0x0e, 0x5a, 0x5d, 0xc0, 0x2e, 0x59, 0x92, 0x17, 0xc2, 0x06, 0x97, 0x19, 0x1b, 0x90, 0x76,
+	0x07, 0x8c, 0xdb, 0x97, 0x42, 0x75, 0x6e, 0x73, 0xa5, 0x2a, 0x50, 0xd9, 0xfe, 0x39, 0x6d, 0x0f,
+	0x19, 0xdd, 0x13, 0x4a, 0x12, 0xf6, 0x32, 0xee, 0x41, 0x59, 0xd9, 0x7b, 0xab, 0xef, 0x76, 0xe8,
+	0x6a, 0x1a, 0x47, 0x16, 0x37, 0xaf, 0x87, 0x3b, 0x53, 0x60, 0xd8, 0x41, 0x35, 0x29, 0xb1, 0x71,
+	0x01, 0x2e, 0x9a, 0x79, 0x6e, 0x79, 0x0e, 0xae, 0xef, 0xaf, 0x66, 0x38, 0x2a, 0x4b, 0x72, 0xd5,
+	0x1f, 0x06, 0xbf, 0x4f, 0x85, 0x8e, 0x44, 0x9d, 0x8c, 0x0f, 0x21, 0x3f, 0xf0, 0x68, 0x0c, 0x65,
+	0x76, 0x0a, 0x28, 0x73, 0x38, 0x22, 0x02, 0x72, 0x0b, 0x0a, 0x03, 0xd7, 0x67, 0xf1, 0x0c, 0x30,
+	0xc5, 0x0c, 0xf9, 0x60, 0x48, 0x34, 0x05, 0xc2, 0xed, 0x5b, 0x23, 0x3a, 0x70, 0x6d, 0x87, 0xf9,
+	0xab, 0x39, 0x1c, 0x9f, 0x25, 0x8a, 0x04, 0x37, 0xb5, 0xe4, 0x51, 0x9f, 0x7a, 0x23, 0x8a, 0x3e,
+	0x67, 0x38, 0x69, 0x9f, 0x06, 0x1d, 0xf3, 0xbc, 0xa3, 0x11, 0xaa, 0x1a, 0x91, 0xc6, 0xa8, 0xc3,
+	0x62, 0x3c, 0x20, 0xb4, 0xab, 0x30, 0x85, 0x5d, 0xe5, 0x68, 0xb2, 0xd0, 0xb6, 0x0a, 0x64, 0x42,
+	0xd9, 0x6a, 0x51, 0xd0, 0x24, 0x6c, 0x1b, 0x0f, 0x61, 0xd9, 0xa3, 0x56, 0xa7, 0x65, 0x3d, 0x63,
+	0xd4, 0x6b, 0xe1, 0x96, 0x6c, 0xe1, 0xee, 0x12, 0x5f, 0x69, 0xa5, 0xaa, 0x84, 0x05, 0x5f, 0xe5,
+	0x21, 0xb3, 0x3b, 0x81, 0xbd, 0x56, 0x67, 0x2b, 0x18, 0xb1, 0x1f, 0x0e, 0xa8, 0xfc, 0x14, 0xf2,
+	0xaa, 0x19, 0x18, 0x08, 0x29, 0x41, 0x25, 0x1e, 0x00, 0xb9, 0xcd, 0x82, 0xf4, 0x61, 0x93, 0x0b,
+	0x89, 0x54, 0x06, 0xf1, 0xa2, 0x12, 0xc6, 0xee, 0x60, 0x20, 0x68, 0xeb, 0x09, 0x52, 0x50, 0xa4,
+	0xf5, 0x8e, 0xf9, 0xa5, 0x0e, 0x45, 0xc9, 0x39, 0x42, 0x71, 0x22, 0x9f, 0x19, 0xef, 0x40, 0xb6,
+	0x6d, 0xf5, 0x7a, 0x68, 0x35, 0x0e, 0x12, 0x6b, 0x94, 0xaa, 0x22, 0x2c, 0x6b, 0x5c, 0x5e, 0xbf,
+	0x4f, 0x32, 0xa2, 0x47, 0xbd, 0x63, 0x7c, 0x13, 0xd2, 0x12, 0x45, 0xbe, 0x80, 0xe8, 0xab, 0x82,
+	0x48, 0x42, 0xbd, 0xf1, 0x16, 0x2c, 0x70, 0x53, 0x79, 0x48, 0xe5, 0x36, 0x17, 0xa5, 0xe1, 0xf7,
+	0xdc, 0xa1, 0xd3, 0xe1, 0x0c, 0x24, 0x42, 0x6f, 0x7c, 0x07, 0x72, 0xcc, 0x3a, 0xe8, 0x61, 0x08,
+	0xb1, 0x17, 0x03, 0xca, 0x63, 0xac, 0xb8, 0xb9, 0x5c, 0x8d, 0x52, 0x45, 0x93, 0x2b, 0x9b, 0xa8,
+	0x23, 0xc0, 0xa2, 0x67, 0x34, 0xdc, 0x70, 0x5c, 0xd6, 0x9a, 0x48, 0x13, 0x0b, 0xdc, 0x31, 0x65,
+	0xd4, 0xd4, 0xc7, 0x32, 0x05, 0x02, 0x74, 0x44, 0x5f, 0xf8, 0x03, 0xab, 0x8d, 0x0c, 0x0f, 0x00,
+	0xe6, 0x91, 0x98, 0x25, 0x85, 0x50, 0xca, 0x51, 0x57, 0x23, 0x35, 0x3d, 0x4d, 0xa4, 0x9a, 0x9f,
+	0x69, 0x50, 0x8a, 0x10, 0xf5, 0x07, 0x28, 0xa2, 0xb8, 0xd6, 0x02, 0xf5, 0x3c, 0xd7, 0x9b, 0x80,
+	0x93, 0xec, 0xd7, 0xb6, 0x03, 0x31, 0x11, 0xda, 0x8b, 0x60, 0x79, 0x07, 0x52, 0x48, 0xb5, 0x61,
+	0x8f, 0x49, 0x30, 0x0d, 0x35, 0x92, 0x09, 0xd7, 0x10, 0xd9, 0xc3, 0xfc, 0xaf, 0x0e, 0xcb, 0xd2,
+	0x22, 0xbe, 0x27, 0x7f, 0x7e, 0x3c, 0x8d, 0x11, 0x14, 0xc2, 0xcd, 0xdd, 0x9c, 0x25, 0x51, 0xdb,
+	0xb8, 0x06, 0x29, 0xee, 0x17, 0x1f, 0x5d, 0x18, 0x04, 0xb3, 0x6c, 0x4d, 0xb2, 0x23, 0x75, 0x29,
+	0x76, 0xa4, 0x4f, 0x61, 0x87, 0xe2, 0xf6, 0xcc, 0x54, 0x6e, 0xff, 0xad, 0x06, 0x2b, 0x13, 0x20,
+	0xcf, 0x85, 0xf3, 0xff, 0xa7, 0xc3, 0x0d, 0x69, 0xd7, 0x47, 0x12, 0xd9, 0xfa, 0xeb, 0xc2, 0x80,
+	0xaf, 0x41, 0x3e, 0x0a, 0x51, 0x5b, 0xf2, 0x20, 0x4f, 0x72, 0x47, 0xf1, 0x3e, 0xe6, 0x94, 0x0c,
+	0xbf, 0xd7, 0xa0, 0x72, 0x12, 0xe8, 0x73, 0xc1, 0x88, 0x4f, 0x13, 0x70, 0x3d, 0x36, 0x8e, 0x58,
+	0x4e, 0x97, 0xbe, 0x26, 0x7c, 0x78, 0x17, 0x00, 0x9f, 0x5b, 0x1e, 0x37, 0x99, 0xb3, 0x21, 0xd8,
+	0x69, 0xe4, 0xeb, 0x70, 0x37, 0x24, 0x7b, 0x14, 0xee, 0x6b, 0x4e, 0xf9, 0xf1, 0x3b, 0x0d, 0x56,
+	0x8f, 0xbb, 0x60, 0x2e, 0xd8, 0xf1, 0xf7, 0x64, 0xc4, 0x8e, 0x6d, 0x87, 0xd9, 0xec, 0xc5, 0x6b,
+	0x93, 0x2d, 0xd0, 0x67, 0x94, 0x5b, 0xdc, 0x6a, 0xbb, 0xbd, 0x61, 0xdf, 0x69, 0x39, 0x56, 0x9f,
+	0xca, 0xea, 0xbb, 0x2c, 0x34, 0x35, 0xae, 0xd8, 0x45, 0xb9, 0xf1, 0x23, 0x58, 0x92, 0xbd, 0xc7,
+	0x52, 0x4c, 0x8a, 0x93, 0x6a, 0x3d, 0xb4, 0xf4, 0x14, 0x24, 0xaa, 0xa1, 0x80, 0x2c, 0x8a, 0x49,
+	0x3e, 0x3a, 0x3d, 0x25, 0xa5, 0x2f, 0x45, 0xb9, 0xcc, 0xf9, 0x94, 0xcb, 0x4e, 0x43, 0xb9, 0xca,
+	0x01, 0x64, 0x42, 0xa3, 0x8d, 0x5b, 0x90, 0xe4, 0xa6, 0x69, 0xdc, 0xb4, 0x5c, 0x58, 0x40, 0x06,
+	0x16, 0x71, 0x85, 0xb1, 0x0c, 0x0b, 0x23, 0xab, 0x37, 0xa4, 0xdc, 0x71, 0x79, 0x22, 0x1a, 0x38,
+	0x2c, 0xa7, 0x60, 0xc5, 0x7d, 0x95, 0x27, 0x10, 0x67, 0x63, 0x95, 0xd6, 0x0a, 0x62, 0x73, 0x41,
+	0xeb, 0x7f, 0xeb, 0xb0, 0x24, 0x4d, 0xbb, 0x67, 0xb1, 0xf6, 0xe1, 0x95, 0x53, 0xfa, 0x6d, 0x48,
+	0x07, 0xd6, 0xd8, 0x98, 0xa8, 0x12, 0x9c, 0x53, 0x27, 0x90, 0x3a, 0xec, 0x31, 0x6b, 0xc1, 0x8b,
+	0x25, 0xac, 0xe5, 0x9f, 0x50, 0xec, 0x16, 0x2c, 0xff, 0x55, 0x54, 0xba, 0x78, 0xca, 0x2d, 0x8f,
+	0x63, 0x7a, 0x65, 0xae, 0xfe, 0x16, 0xa4, 0x85, 0x23, 0x43, 0x34, 0xaf, 0x49, 0xdb, 0x84, 0x9b,
+	0x9f, 0xda, 0xec, 0x50, 0x4c, 0x1d, 0x76, 0x33, 0x1d, 0x28, 0x71, 0xa4, 0xf9, 0xde, 0x38, 0xdc,
+	0x71, 0x96, 0xd1, 0x2e, 0x90, 0x65, 0xf4, 0x53, 0xab, 0xd2, 0x84, 0x5a, 0x95, 0x9a, 0x7f, 0x8b,
+	0xeb, 0x2c, 0x0e, 0xc6, 0x2b, 0xaa, 0xb4, 0xdf, 0x9d, 0xa4, 0x59, 0x74, 0x1d, 0x30, 0xb1, 0xfb,
+	0x57, 0x45, 0xb6, 0x8b, 0xde, 0x6c, 0x98, 0x7f, 0x88, 0x6b, 0xa5, 0x31, 0xe0, 0xae, 0x8c, 0x4b,
+	0xef, 0x4c, 0x72, 0xe9, 0xa4, 0xbc, 0x11, 0xf1, 0xe8, 0x97, 0xb0, 0xcc, 0x91, 0x8c, 0x33, 0xfc,
+	0x4b, 0x24, 0xd3, 0x64, 0x81, 0x9b, 0x38, 0x56, 0xe0, 0x9a, 0xff, 0xd2, 0xe1, 0xa6, 0x0a, 0xcf,
+	0xab, 0x2c, 0xe2, 0xdf, 0x9b, 0x24, 0xd7, 0xda, 0x18, 0xb9, 0x26, 0x20, 0x99, 0x5b, 0x86, 0xfd,
+	0x49, 0x83, 0x5b, 0xa7, 0x42, 0x38, 0x27, 0x34, 0xfb, 0x1c, 0xdf, 0xd1, 0x1b, 0xcc, 0xa3, 0x56,
+	0xff, 0x52, 0xb7, 0x31, 0x11, 0x2b, 0xf5, 0x8b, 0x5d, 0xb1, 0x24, 0xa6, 0x77, 0xd1, 0xc4, 0x51,
+	0x92, 0x3c, 0xe7, 0x28, 0x59, 0x98, 0xea, 0x7a, 0x53, 0xc1, 0x35, 0x75, 0x36, 0xae, 0x66, 0x0d,
+	0x56, 0x26, 0x80, 0x92, 0x2e, 0x8c, 0xcb, 0x01, 0xed, 0xdc, 0x72, 0xe0, 0x33, 0x1d, 0x2a, 0x63,
+	0xb3, 0x5c, 0x26, 0x5d, 0x4f, 0x0d, 0xba, 0x9a, 0x0a, 0x12, 0xa7, 0x9e, 0x2b, 0xc9, 0xb3, 0x6e,
+	0x3b, 0x16, 0xa6, 0x74, 0xd4, 0x85, 0x83, 0xa4, 0x0e, 0x6f, 0x9c, 0x08, 0xc8, 0x0c, 0xe0, 0xfe,
+	0x51, 0x87, 0x5b, 0x63, 0x73, 0x5d, 0x3a, 0x67, 0xbd, 0x14, 0x84, 0x27, 0x93, 0x6d, 0xf2, 0xdc,
+	0xdb, 0x84, 0x2b, 0x03, 0x7b, 0x17, 0x6e, 0x9f, 0x0e, 0xd0, 0x0c, 0x88, 0xff, 0x55, 0x87, 0xaf,
+	0x4e, 0x4e, 0x78, 0x99, 0x17, 0xfb, 0x97, 0x82, 0xf7, 0xf8, 0xdb, 0x7a, 0x72, 0x86, 0xb7, 0xf5,
+	0x2b, 0xc3, 0xff, 0x11, 0xdc, 0x3c, 0x0d, 0xae, 0x19, 0xd0, 0xff, 0x31, 0xe4, 0xef, 0xd1, 0xae,
+	0xed, 0xcc, 0x86, 0xf5, 0xd8, 0x9f, 0x4d, 0xfa, 0xf8, 0x9f, 0x4d, 0xe6, 0xf7, 0xa0, 0x20, 0xa7,
+	0x96, 0x76, 0x29, 0x89, 0x52, 0x3b, 0x27, 0x51, 0x7e, 0xaa, 0x41, 0xa1, 0xc6, 0xff, 0x93, 0xba,
+	0xf2, 0x42, 0x01, 0x93, 0x97, 0xc5, 0xdc, 0xbe, 0xdd, 0x96, 0xff, 0x96, 0xc9, 0x96, 0x59, 0x86,
+	0x62, 0x68, 0x81, 0xb0, 0xdf, 0xfc, 0x19, 0x94, 0x88, 0xdb, 0xeb, 0x1d, 0x58, 0xed, 0xa3, 0xab,
+	0xb6, 0xca, 0x34, 0xa0, 0x1c, 0xaf, 0x25, 0xd7, 0xff, 0x18, 0x6e, 0xe0, 0xb3, 0xdb, 0x1b, 0x51,
+	0xa5, 0xa4, 0x98, 0xcd, 0x12, 0x03, 0x92, 0x1d, 0x26, 0xff, 0x57, 0xc9, 0x12, 0xfe, 0x6c, 0xfe,
+	0x13, 0x5f, 0x89, 0x76, 0x70, 0x79, 0xab, 0x4b, 0x05, 0xc1, 0x66, 0x9b, 0xfa, 0xac, 0x9a, 0x11,
+	0xdf, 0xcd, 0xc5, 0xc9, 0x2b, 0xe2, 0x4d, 0x34, 0x30, 0x04, 0xb2, 0x51, 0xb0, 0xf1, 0x33, 0xf9,
+	0xe4, 0x58, 0xcb, 0x84, 0xb1, 0x16, 0x58, 0xaf, 0xdc, 0x8f, 0xf0, 0x67, 0xf3, 0xd7, 0x1a, 0x2c,
+	0x4a, 0xeb, 0xb7, 0x66, 0xf5, 0xcf, 0x59, 0xa6, 0x87, 0x6b, 0x26, 0xe2, 0x35, 0x8d, 0x9b, 0x90,
+	0x08, 0x93, 0x71, 0x6e, 0x33, 0x2f, 0xa3, 0xec, 0x49, 0x70, 0xdf, 0x40, 0x02, 0x85, 0xb9, 0x03,
+	0xf9, 0xba, 0x52, 0x69, 0x1a, 0x6b, 0xa0, 0x47, 0x66, 0x8c, 0x77, 0x47, 0xf9, 0xe4, 0x15, 0x85,
+	0x7e, 0xec, 0x8a, 0xe2, 0x1f, 0x1a, 0xac, 0xc5, 0x5b, 0xbc, 0xf4, 0xc1, 0x74, 0xd1, 0xdd, 0x7e,
+	0x00, 0x25, 0xbb, 0xd3, 0x3a, 0x76, 0x0c, 0xe5, 0x30, 0xc9, 0x49, 0x16, 0xab, 0x9b, 0x25, 0x05,
+	0x5b, 0x69, 0xf9, 0xe6, 0x1a, 0x54, 0x4e, 0x22, 0xaf, 0xa4, 0xf6, 0xaf, 0x12, 0xb0, 0xd8, 0x18,
+	0xf4, 0x6c, 0x26, 0x73, 0xd4, 0xcb, 0xde, 0xcf, 0xd4, 0x97, 0x74, 0x78, 0xd0, 0xfa, 0x81, 0x1d,
+	0xf2, 0x1e, 0x4e, 0x16, 0x34, 0x39, 0x2e, 0x13, 0x37, 0x70, 0x81, 0x9f, 0xc2, 0x2e, 0x43, 0x87,
+	0x71, 0x12, 0x26, 0x08, 0xc8, 0x1e, 0x28, 0x31, 0xbe, 0x0d, 0xd7, 0x9d, 0x61, 0xbf, 0xe5, 0xb9,
+	0xcf, 0xfd, 0xd6, 0x00, 0x8d, 0xe7, 0x33, 0xb7, 0x06, 0x96, 0xc7, 0x78, 0x8a, 0x4f, 0x90, 0x25,
+	0x54, 0x13, 0xd4, 0xee, 0x53, 0x8f, 0x2f, 0xbe, 0x8f, 0x2a, 0xe3, 0x07, 0x90, 0xb5, 0x7a, 0x5d,
+	0xd7, 0xb3, 0xd9, 0x61, 0x5f, 0x5e, 0xbc, 0x99, 0xd2, 0xcc, 0x63, 0xc8, 0x54, 0xb7, 0xc2, 0x9e,
+	0x24, 0x1e, 0x64, 0xbc, 0x0d, 0xc6, 0xd0, 0xc7, 0xda, 0x96, 0x1b, 0x27, 0x16, 0x1d, 0x6d, 0xca,
+	0x5b, 0xb8, 0x12, 0x6a, 0xe2, 0x69, 0x9e, 0x6c, 0x06, 0x1e, 0x6e, 0xd3, 0x5e, 0x8f, 0xdf, 0xc0,
+	0xa1, 0x87, 0x83, 0x67, 0xf3, 0x37, 0x49, 0x30, 0xd4, 0xb5, 0x64, 0xde, 0x7e, 0x1f, 0xcb, 0xbb,
+	0x40, 0xea, 0xa3, 0x0f, 0x02, 0x7f, 0xdf, 0x8a, 0xb2, 0xd6, 0xb1, 0xbe, 0xd5, 0x60, 0x2b, 0x44,
+	0x76, 0xaf, 0x7c, 0x0c, 0xf9, 0x30, 0x7a, 0xf9, 0x16, 0x55, 0x0f, 0x69, 0x67, 0x9e, 0xb8, 0xfa,
+	0x14, 0x27, 0x6e, 0xe5, 0x43, 0xc8, 0xf2, 0x4a, 0xef, 0xdc, 0xb9, 0xe3, 0xfa, 0x54, 0x57, 0xeb,
+	0xd3, 0xca, 0x9f, 0x75, 0x48, 0xf2, 0xc1, 0x53, 0xbf, 0x10, 0xef, 0xf0, 0x77, 0x08, 0x61, 0xa5,
+	0xf0, 0xa8, 0x48, 0xe4, 0x6f, 0x9d, 0x01, 0x89, 0x0a, 0x01, 0xc9, 0x1f, 0xa9, 0x80, 0xd4, 0x00,
+	0xc4, 0x17, 0x1f, 0x7c, 0x2a, 0xc1, 0xcd, 0xaf, 0x9f, 0x31, 0x55, 0xb4, 0x5d, 0x92, 0xf5, 0xa3,
+	0x9d, 0xa3, 0x27, 0x7d, 0xfb, 0x17, 0x22, 0x73, 0x26, 0x08, 0x7f, 0x9e, 0xb5, 0x18, 0x09, 0x49,
+	0x91, 0x52, 0x48, 0x71, 0x17, 0x56, 0x1e, 0x52, 0xd6, 0xf0, 0x46, 0x61, 0x34, 0x87, 0xd1, 0x79,
+	0x06, 0xe2, 0x26, 0x81, 0x6b, 0x93, 0x83, 0x24, 0x99, 0xbe, 0x8b, 0x01, 0xe6, 0x8d, 0x5a, 0x63,
+	0x23, 0x83, 0xa2, 0x27, 0x32, 0x4d, 0x1d, 0x94, 0xf3, 0xe3, 0x86, 0xf9, 0x85, 0x06, 0xc5, 0x27,
+	0x97, 0x39, 0x99, 0x26, 0x40, 0xd1, 0xa7, 0x04, 0x05, 0xc9, 0x31, 0xea, 0x32, 0x79, 0x69, 0x1c,
+	0x90, 0x43, 0xf9, 0xfc, 0xe1, 0x09, 0xff, 0xf4, 0x41, 0xe8, 0x83, 0xba, 0xeb, 0x99, 0xdd, 0x63,
+	0xd4, 0x8b, 0x0e, 0x31, 0xa5, 0xe7, 0x03, 0xae, 0x21, 0xb2, 0x87, 0xf9, 0x7d, 0x28, 0x45, 0x7b,
+	0x89, 0xcb, 0x36, 0x3a, 0xe2, 0x1f, 0x80, 0x68, 0x92, 0xfd, 0xea, 0x42, 0xdb, 0x81, 0x8a, 0xc8,
+	0x1e, 0xe6, 0x5f, 0x74, 0x58, 0x7a, 0x3c, 0x40, 0xcd, 0xbc, 0x1f, 0xd5, 0x33, 0x12, 0x71, 0x0d,
+	0xb2, 0xcc, 0xee, 0xe3, 0x8e, 0xac, 0xfe, 0x40, 0x26, 0xcd, 0x58, 0x10, 0x78, 0x84, 0xe3, 0x20,
+	0xef, 0x7a, 0xc3, 0x70, 0xe5, 0x10, 0x35, 0xdd, 0x23, 0xea, 0x10, 0xa1, 0x37, 0x8f, 0x60, 0x79,
+	0x1c, 0x25, 0x09, 0xf5, 0x7a, 0x38, 0xc1, 0x78, 0x81, 0x2c, 0xeb, 0x6a, 0x8e, 0xb4, 0xe8, 0x80,
+	0x25, 0x5b, 0xf0, 0xe9, 0xcc, 0xb0, 0x4f, 0x5b, 0xb1, 0x3d, 0xe2, 0x63, 0x94, 0x92, 0x90, 0x37,
+	0x43, 0xb1, 0xf9, 0x3e, 0xe4, 0x08, 0xa6, 0x7f, 0x6c, 0x3f, 0xe8, 0x59, 0xdd, 0xe8, 0x08, 0xd5,
+	0x94, 0x23, 0x74, 0xec, 0xbf, 0x89, 0xac, 0xfc, 0x6f, 0xc2, 0xb4, 0x60, 0x51, 0x19, 0x58, 0x3b,
+	0x1c, 0xab, 0x71, 0xd4, 0xe1, 0x58, 0x6e, 0xbb, 0xbd, 0x4e, 0x4b, 0x9d, 0x22, 0x83, 0x02, 0x5e,
+	0x42, 0x04, 0x4a, 0x87, 0x3e, 0x97, 0x4a, 0xf9, 0x3e, 0x83, 0x02, 0xae, 0x34, 0x3f, 0x81, 0x6b,
+	0x0d, 0xca, 0x94, 0x55, 0x66, 0xbe, 0x80, 0x5b, 0x78, 0x16, 0x8c, 0x96, 0x09, 0x7a, 0x29, 0xcc,
+	0x55, 0xca, 0xcc, 0x44, 0xf4, 0xc0, 0xf7, 0xc4, 0xeb, 0xc7, 0x96, 0x94, 0xf0, 0xdf, 0x85, 0x74,
+	0xfb, 0x50, 0x24, 0x7a, 0x41, 0xf5, 0x1b, 0x27, 0xcc, 0x23, 0x70, 0x20, 0x61, 0xcf, 0x3b, 0xf7,
+	0xa1, 0x34, 0xf1, 0x95, 0x98, 0x51, 0x82, 0xdc, 0xe3, 0xdd, 0xc6, 0xfe, 0x76, 0xad, 0xfe, 0xa0,
+	0xbe, 0x7d, 0xbf, 0xfc, 0x15, 0x03, 0x20, 0xd5, 0xa8, 0xef, 0x3e, 0x7c, 0xb4, 0x5d, 0xd6, 0x8c,
+	0x2c, 0x2c, 0xec, 0x3c, 0x7e, 0xd4, 0xac, 0x97, 0xf5, 0xe0, 0xb1, 0xf9, 0x74, 0x6f, 0xbf, 0x56,
+	0x4e, 0xdc, 0xf9, 0x00, 0x72, 0xa2, 0xaa, 0xdf, 0xf3, 0x3a, 0xd4, 0x0b, 0x06, 0xec, 0xee, 0x91,
+	0x9d, 0xad, 0x47, 0x38, 0x38, 0x0d, 0x89, 0x7d, 0x12, 0x8c, 0xcc, 0xe0, 0xa9, 0xb0, 0xd7, 0x68,
+	0xe2, 0xc0, 0x22, 0xc0, 0xd6, 0xe3, 0xe6, 0x5e, 0x6d, 0x6f, 0x67, 0xa7, 0xde, 0x2c, 0x27, 0xee,
+	0xbd, 0x87, 0x25, 0x90, 0x5b, 0x1d, 0xd9, 0x0c, 0xab, 0x30, 0xf1, 0x9d, 0xdf, 0x4f, 0xde, 0x94,
+	0x2d, 0xdb, 0xdd, 0x10, 0x4f, 0x1b, 0x5d, 0x7c, 0x62, 0x1b, 0x5c, 0xbb, 0x21, 0xb6, 0x75, 0x90,
+	0xe2, 0xad, 0xbb, 0xff, 0x07, 0x45, 0x78, 0xa5, 0x45, 0x67, 0x28, 0x00, 0x00,
 }
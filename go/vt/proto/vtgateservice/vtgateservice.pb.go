@@ -30,45 +30,46 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("vtgateservice.proto", fileDescriptor_601ae27c95081e0f) }
 
 var fileDescriptor_601ae27c95081e0f = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0x5f, 0x6f, 0xd3, 0x30,
-	0x14, 0xc5, 0xe1, 0x81, 0x0e, 0x5d, 0x5a, 0x40, 0xde, 0xd6, 0x6e, 0xe5, 0xef, 0x0a, 0x6c, 0x88,
-	0x87, 0x16, 0x81, 0x84, 0x84, 0x84, 0x40, 0x2d, 0x54, 0x08, 0x4d, 0x03, 0xd6, 0x42, 0x91, 0x26,
-	0xf1, 0xe0, 0xa6, 0x57, 0x59, 0xd4, 0x36, 0x49, 0x63, 0x37, 0xa2, 0x5f, 0x80, 0xcf, 0x3d, 0x2d,
-	0xb1, 0x1d, 0xdb, 0x71, 0xdb, 0xb7, 0xe4, 0x9c, 0xe3, 0x9f, 0xed, 0xeb, 0x2b, 0x1b, 0x76, 0x53,
-	0xee, 0x53, 0x8e, 0x0c, 0x93, 0x34, 0xf0, 0xb0, 0x1d, 0x27, 0x11, 0x8f, 0x48, 0xcd, 0x10, 0x9b,
-	0xd5, 0xfc, 0x37, 0x37, 0x9b, 0x77, 0x16, 0x4b, 0x4c, 0x56, 0xf9, 0xcf, 0x9b, 0xff, 0xf7, 0xa0,
-	0x32, 0x0a, 0x38, 0x32, 0x46, 0x3e, 0xc0, 0x4e, 0xff, 0x1f, 0x7a, 0x4b, 0x8e, 0xa4, 0xde, 0x16,
-	0x23, 0x84, 0x30, 0xc0, 0xc5, 0x12, 0x19, 0x6f, 0x36, 0x4a, 0x3a, 0x8b, 0xa3, 0x90, 0x61, 0xeb,
-	0x06, 0x39, 0x85, 0xaa, 0x10, 0x7b, 0x94, 0x7b, 0x97, 0xe4, 0x81, 0x15, 0xcd, 0x54, 0xc9, 0x79,
-	0xe8, 0x36, 0x15, 0xec, 0x27, 0xd4, 0x86, 0x3c, 0x41, 0x3a, 0x97, 0x0b, 0x52, 0x03, 0x0c, 0x59,
-	0xe2, 0x1e, 0xad, 0x71, 0x25, 0xef, 0xf5, 0x4d, 0xf2, 0x1d, 0x6a, 0x42, 0x1e, 0x5e, 0xd2, 0x64,
-	0xc2, 0x88, 0xbd, 0x84, 0x5c, 0x2e, 0x11, 0x2d, 0x57, 0xad, 0xf0, 0x2f, 0x10, 0x61, 0x9d, 0xe2,
-	0x8a, 0xc5, 0xd4, 0xc3, 0x6f, 0x13, 0x46, 0x8e, 0xac, 0x61, 0x9a, 0x27, 0xc9, 0xad, 0x4d, 0x11,
-	0x85, 0xff, 0x03, 0xf7, 0x0b, 0x7f, 0x40, 0x43, 0x1f, 0x19, 0x79, 0x52, 0x1e, 0x99, 0x3b, 0x12,
-	0xfd, 0x74, 0x7d, 0xc0, 0x01, 0xee, 0x87, 0x3c, 0xe0, 0xab, 0xeb, 0x55, 0xdb, 0x60, 0xe5, 0xac,
-	0x03, 0x6b, 0x01, 0x47, 0x41, 0xb2, 0xc3, 0x14, 0x55, 0x3e, 0x72, 0x1d, 0xb4, 0x59, 0xea, 0xd6,
-	0xa6, 0x88, 0xc2, 0xcf, 0xa0, 0xa1, 0xfb, 0x7a, 0xd1, 0x8f, 0x5d, 0x00, 0x47, 0xe5, 0x4f, 0xb6,
-	0xe6, 0xd4, 0x6c, 0x63, 0xd8, 0x35, 0x5a, 0x49, 0xec, 0xa6, 0xe5, 0xec, 0x33, 0x73, 0x3b, 0xcf,
-	0x36, 0x66, 0xb4, 0x8e, 0x5c, 0xc0, 0x81, 0x11, 0xd1, 0xb7, 0x74, 0xe2, 0x84, 0x38, 0xf6, 0xf4,
-	0x72, 0x7b, 0x50, 0x9b, 0x72, 0x0a, 0x75, 0x3b, 0x27, 0x7a, 0xeb, 0xc5, 0x3a, 0x8e, 0xd9, 0x61,
-	0xc7, 0xdb, 0x62, 0xda, 0x64, 0xef, 0xe0, 0x56, 0x0f, 0xfd, 0x20, 0x24, 0x7b, 0x72, 0x50, 0xf6,
-	0x2b, 0x51, 0xfb, 0x96, 0xaa, 0x6a, 0xff, 0x1e, 0x2a, 0x9f, 0xa3, 0xf9, 0x3c, 0xe0, 0x44, 0x45,
-	0xf2, 0x7f, 0x39, 0xb2, 0x6e, 0xcb, 0x6a, 0xe8, 0x27, 0xb8, 0x3d, 0x88, 0x66, 0xb3, 0x31, 0xf5,
-	0xa6, 0x44, 0x5d, 0x55, 0x52, 0x91, 0xc3, 0x0f, 0xca, 0x86, 0xde, 0xc4, 0x03, 0x64, 0xd1, 0x2c,
-	0xc5, 0x5f, 0x09, 0x0d, 0x19, 0xf5, 0x78, 0x10, 0x85, 0x45, 0x13, 0x97, 0xbd, 0x52, 0x13, 0xbb,
-	0x22, 0x0a, 0xff, 0x03, 0x6a, 0x67, 0xc8, 0x18, 0xf5, 0x31, 0xaf, 0x5f, 0x71, 0x09, 0x19, 0x72,
-	0x71, 0x4b, 0xe6, 0x37, 0xb5, 0x65, 0x6a, 0x35, 0xfe, 0x02, 0x20, 0xcc, 0xae, 0x37, 0x25, 0x87,
-	0x16, 0xad, 0x5b, 0x6c, 0xfa, 0xd0, 0x44, 0x75, 0x8d, 0x5d, 0x5f, 0xc0, 0x7e, 0xa1, 0xeb, 0x6d,
-	0xf8, 0xbc, 0x0c, 0x74, 0xf4, 0xe0, 0x46, 0x76, 0x1f, 0x60, 0x18, 0xcf, 0x02, 0x7e, 0x7e, 0x1d,
-	0x29, 0x56, 0x58, 0x68, 0x92, 0xd2, 0x74, 0x59, 0x0a, 0x73, 0x0e, 0x77, 0xbf, 0x22, 0x1f, 0x26,
-	0xa9, 0x9c, 0x9f, 0xa8, 0x1b, 0xda, 0xd4, 0x25, 0xee, 0xf1, 0x3a, 0x5b, 0x21, 0x3f, 0xc2, 0xce,
-	0x48, 0x1c, 0x83, 0xea, 0xa8, 0x91, 0x79, 0x00, 0x8d, 0x92, 0xae, 0xd5, 0xfe, 0x0c, 0xaa, 0xbf,
-	0xe3, 0x09, 0xe5, 0xf2, 0x2c, 0xd5, 0x83, 0xa7, 0xab, 0xa5, 0x07, 0xcf, 0x34, 0x0b, 0x5c, 0xaf,
-	0x07, 0x7b, 0x41, 0xd4, 0x4e, 0xb3, 0xa7, 0x38, 0x7f, 0x9b, 0xdb, 0x7e, 0x12, 0x7b, 0x17, 0xaf,
-	0x84, 0x14, 0x44, 0x9d, 0xfc, 0xab, 0xe3, 0x47, 0x9d, 0x94, 0x77, 0xb2, 0x48, 0xc7, 0x78, 0xe7,
-	0xc7, 0x95, 0x4c, 0x7c, 0x7b, 0x15, 0x00, 0x00, 0xff, 0xff, 0x98, 0xf7, 0xf0, 0x85, 0x14, 0x08,
-	0x00, 0x00,
+	// 614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x85, 0x56, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0x85, 0x07, 0x3a, 0x74, 0xd7, 0x32, 0xe4, 0x6d, 0xed, 0x56, 0x3e, 0xc6, 0x0a, 0x6c, 0x13,
+	0x0f, 0x2d, 0x02, 0x09, 0x09, 0x09, 0x81, 0x5a, 0x28, 0x08, 0x4d, 0x03, 0xd6, 0x6e, 0x45, 0x9a,
+	0xc4, 0x83, 0x9b, 0x59, 0x59, 0xb4, 0x34, 0xc9, 0x12, 0x37, 0xa2, 0xff, 0x95, 0x1f, 0x43, 0x16,
+	0x7f, 0xc4, 0x76, 0x9c, 0xf6, 0x2d, 0x3e, 0xe7, 0xdc, 0x63, 0xdf, 0xeb, 0xab, 0xeb, 0xc0, 0x66,
+	0x4a, 0x5d, 0x4c, 0x49, 0x42, 0xe2, 0xd4, 0x73, 0x48, 0x37, 0x8a, 0x43, 0x1a, 0xa2, 0x86, 0x06,
+	0xb6, 0xeb, 0x6c, 0xc9, 0xc8, 0xf6, 0xfa, 0xcd, 0x9c, 0xc4, 0x0b, 0xb6, 0x78, 0xf3, 0x6f, 0x03,
+	0x6a, 0x13, 0x2f, 0x93, 0x26, 0xe8, 0x03, 0xac, 0x0d, 0xff, 0x12, 0x67, 0x4e, 0x09, 0x6a, 0x76,
+	0x79, 0x04, 0x07, 0x46, 0x24, 0x8b, 0x49, 0x68, 0xbb, 0x55, 0xc2, 0x93, 0x28, 0x0c, 0x12, 0xd2,
+	0xb9, 0x83, 0x8e, 0xa1, 0xce, 0xc1, 0x01, 0xa6, 0xce, 0x15, 0x7a, 0x64, 0x48, 0x73, 0x54, 0xf8,
+	0x3c, 0xb6, 0x93, 0xd2, 0xec, 0x17, 0x34, 0xc6, 0x34, 0x26, 0x78, 0x26, 0x0e, 0x24, 0x03, 0x34,
+	0x58, 0xd8, 0x3d, 0xa9, 0x60, 0x85, 0xdf, 0xeb, 0xbb, 0xe8, 0x07, 0x34, 0x38, 0x3c, 0xbe, 0xc2,
+	0xf1, 0x65, 0x82, 0xcc, 0x23, 0x30, 0xb8, 0xe4, 0x68, 0xb0, 0xf2, 0x84, 0x7f, 0x00, 0x71, 0xea,
+	0x98, 0x2c, 0x92, 0x08, 0x3b, 0xe4, 0x7b, 0x66, 0xba, 0x6f, 0x84, 0x29, 0x9c, 0x70, 0xee, 0x2c,
+	0x93, 0x48, 0xfb, 0xdf, 0xf0, 0xb0, 0xe0, 0x47, 0x38, 0x70, 0x49, 0x82, 0xf6, 0xca, 0x91, 0x8c,
+	0x11, 0xd6, 0xcf, 0xaa, 0x05, 0x16, 0xe3, 0x61, 0x40, 0x3d, 0xba, 0xb8, 0x3d, 0xb5, 0x69, 0x2c,
+	0x99, 0x2a, 0x63, 0x45, 0x60, 0x29, 0x48, 0x7e, 0x99, 0xbc, 0xca, 0xfb, 0xb6, 0x8b, 0xd6, 0x4b,
+	0xdd, 0x59, 0x26, 0x91, 0xf6, 0x3e, 0xb4, 0x54, 0x5e, 0x2d, 0xfa, 0x81, 0xcd, 0xc0, 0x52, 0xf9,
+	0xc3, 0x95, 0x3a, 0xb9, 0xdb, 0x14, 0x36, 0xb5, 0x56, 0xe2, 0xd9, 0x74, 0xac, 0x7d, 0xa6, 0xa7,
+	0xf3, 0x7c, 0xa9, 0x46, 0xe9, 0xc8, 0x1b, 0xd8, 0xd1, 0x24, 0x6a, 0x4a, 0x87, 0x56, 0x13, 0x4b,
+	0x4e, 0x47, 0xab, 0x85, 0xca, 0x96, 0xd7, 0xd0, 0x34, 0x75, 0xbc, 0xb7, 0x5e, 0x56, 0xf9, 0xe8,
+	0x1d, 0x76, 0xb0, 0x4a, 0xa6, 0x6c, 0xf6, 0x0e, 0xee, 0x0d, 0x88, 0xeb, 0x05, 0x68, 0x4b, 0x04,
+	0xe5, 0x4b, 0x61, 0xb5, 0x6d, 0xa0, 0xb2, 0xf6, 0xef, 0xa1, 0xf6, 0x39, 0x9c, 0xcd, 0x3c, 0x8a,
+	0xa4, 0x84, 0xad, 0x45, 0x64, 0xd3, 0x84, 0x65, 0xe8, 0x27, 0xb8, 0x3f, 0x0a, 0x7d, 0x7f, 0x8a,
+	0x9d, 0x6b, 0x24, 0x47, 0x95, 0x40, 0x44, 0xf8, 0x4e, 0x99, 0x50, 0x9b, 0x38, 0x5b, 0x85, 0x7e,
+	0x4a, 0xce, 0x62, 0x1c, 0x24, 0xd8, 0xa1, 0x5e, 0x18, 0x14, 0x4d, 0x5c, 0xe6, 0x4a, 0x4d, 0x6c,
+	0x93, 0x48, 0xfb, 0x9f, 0xd0, 0x38, 0xc9, 0x26, 0x2d, 0x76, 0x09, 0xab, 0x5f, 0x31, 0x84, 0x34,
+	0xb8, 0x98, 0x92, 0x6c, 0x52, 0x1b, 0xa4, 0x52, 0xe3, 0x2f, 0x00, 0x9c, 0xec, 0x67, 0x29, 0xef,
+	0x1a, 0x6e, 0xfd, 0x22, 0xe9, 0x5d, 0xdd, 0xaa, 0xaf, 0x65, 0x7d, 0x01, 0xdb, 0x05, 0xae, 0xb6,
+	0xe1, 0x8b, 0xb2, 0xa1, 0xa5, 0x07, 0x97, 0x7a, 0x0f, 0x01, 0xc6, 0x91, 0xef, 0xd1, 0xd3, 0x5b,
+	0x49, 0x71, 0xc2, 0x02, 0x13, 0x2e, 0x6d, 0x1b, 0x25, 0x6d, 0x4e, 0xe1, 0xc1, 0x37, 0x42, 0xc7,
+	0x71, 0x2a, 0xf6, 0x47, 0x72, 0x42, 0xeb, 0xb8, 0xb0, 0x7b, 0x5a, 0x45, 0x4b, 0xcb, 0x8f, 0xb0,
+	0x36, 0xe1, 0xd7, 0x20, 0x3b, 0x6a, 0xa2, 0x5f, 0x40, 0xab, 0x84, 0x2b, 0xb5, 0x3f, 0x81, 0xfa,
+	0x79, 0x74, 0x99, 0xb1, 0xdc, 0x44, 0x3e, 0x78, 0x2a, 0x5a, 0x7a, 0xf0, 0x74, 0x52, 0xb1, 0x3b,
+	0x83, 0x8d, 0x31, 0xa1, 0xa3, 0x79, 0x36, 0x5a, 0x67, 0xe4, 0xab, 0x8f, 0xdd, 0x04, 0xc9, 0x1c,
+	0x0c, 0x42, 0x98, 0xee, 0x55, 0xf2, 0xc2, 0x77, 0x30, 0x80, 0x2d, 0x2f, 0xec, 0xa6, 0xf9, 0x03,
+	0xcf, 0x5e, 0xfc, 0xae, 0x1b, 0x47, 0xce, 0xc5, 0x2b, 0x0e, 0x79, 0x61, 0x8f, 0x7d, 0xf5, 0xdc,
+	0xec, 0x8b, 0xf6, 0x72, 0x49, 0x4f, 0xfb, 0x7b, 0x98, 0xd6, 0x72, 0xf0, 0xed, 0x7f, 0x1b, 0x4b,
+	0xa7, 0xc1, 0x6a, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -165,6 +166,10 @@ type VitessClient interface {
 	// UpdateStream asks the server for a stream of StreamEvent objects.
 	// API group: Update Stream
 	UpdateStream(ctx context.Context, in *vtgate.UpdateStreamRequest, opts ...grpc.CallOption) (Vitess_UpdateStreamClient, error)
+	// SetRuntimeFlags changes flags of vtgate without restarting it,
+	// like the memory limits of the queries.
+	// API group: Admin
+	SetRuntimeFlags(ctx context.Context, in *vtgate.SetRuntimeFlagsRequest, opts ...grpc.CallOption) (*vtgate.SetRuntimeFlagsResponse, error)
 }
 
 type vitessClient struct {
@@ -543,6 +548,15 @@ func (x *vitessUpdateStreamClient) Recv() (*vtgate.UpdateStreamResponse, error)
 	return m, nil
 }
 
+func (c *vitessClient) SetRuntimeFlags(ctx context.Context, in *vtgate.SetRuntimeFlagsRequest, opts ...grpc.CallOption) (*vtgate.SetRuntimeFlagsResponse, error) {
+	out := new(vtgate.SetRuntimeFlagsResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/SetRuntimeFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VitessServer is the server API for Vitess service.
 type VitessServer interface {
 	// Execute tries to route the query to the right shard.
@@ -627,6 +641,10 @@ type VitessServer interface {
 	// UpdateStream asks the server for a stream of StreamEvent objects.
 	// API group: Update Stream
 	UpdateStream(*vtgate.UpdateStreamRequest, Vitess_UpdateStreamServer) error
+	// SetRuntimeFlags changes flags of vtgate without restarting it,
+	// like the memory limits of the queries.
+	// API group: Admin
+	SetRuntimeFlags(context.Context, *vtgate.SetRuntimeFlagsRequest) (*vtgate.SetRuntimeFlagsResponse, error)
 }
 
 // UnimplementedVitessServer can be embedded to have forward compatible implementations.
@@ -702,6 +720,9 @@ func (*UnimplementedVitessServer) VStream(req *vtgate.VStreamRequest, srv Vitess
 func (*UnimplementedVitessServer) UpdateStream(req *vtgate.UpdateStreamRequest, srv Vitess_UpdateStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method UpdateStream not implemented")
 }
+func (*UnimplementedVitessServer) SetRuntimeFlags(ctx context.Context, req *vtgate.SetRuntimeFlagsRequest) (*vtgate.SetRuntimeFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRuntimeFlags not implemented")
+}
 
 func RegisterVitessServer(s *grpc.Server, srv VitessServer) {
 	s.RegisterService(&_Vitess_serviceDesc, srv)
@@ -1142,6 +1163,24 @@ func (x *vitessUpdateStreamServer) Send(m *vtgate.UpdateStreamResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Vitess_SetRuntimeFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.SetRuntimeFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).SetRuntimeFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/SetRuntimeFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).SetRuntimeFlags(ctx, req.(*vtgate.SetRuntimeFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Vitess_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "vtgateservice.Vitess",
 	HandlerType: (*VitessServer)(nil),
@@ -1210,6 +1249,10 @@ var _Vitess_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSrvKeyspace",
 			Handler:    _Vitess_GetSrvKeyspace_Handler,
 		},
+		{
+			MethodName: "SetRuntimeFlags",
+			Handler:    _Vitess_SetRuntimeFlags_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
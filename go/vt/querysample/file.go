@@ -0,0 +1,82 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package querysample
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"golang.org/x/net/context"
+
+	querysamplepb "vitess.io/vitess/go/vt/proto/querysample"
+)
+
+var sampleFile = flag.String("query_sample_file", "", "the file the file query sample sink appends the samples to, one JSON object per line")
+
+// fileSink appends the samples to a file, as JSON lines.
+type fileSink struct {
+	file *os.File
+	w    *bufio.Writer
+}
+
+func newFileSink() (Sink, error) {
+	if *sampleFile == "" {
+		return nil, fmt.Errorf("-query_sample_file must be set")
+	}
+	return openFileSink(*sampleFile)
+}
+
+func openFileSink(name string) (*fileSink, error) {
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{
+		file: file,
+		w:    bufio.NewWriter(file),
+	}, nil
+}
+
+// Record is part of the Sink interface. The batch is written to the
+// file before Record returns.
+func (fs *fileSink) Record(ctx context.Context, samples []*querysamplepb.QuerySample) error {
+	for _, sample := range samples {
+		data, err := json.Marshal(sample)
+		if err != nil {
+			return err
+		}
+		fs.w.Write(data)
+		fs.w.WriteByte('\n')
+	}
+	return fs.w.Flush()
+}
+
+// Close is part of the Sink interface.
+func (fs *fileSink) Close() error {
+	if err := fs.w.Flush(); err != nil {
+		fs.file.Close()
+		return err
+	}
+	return fs.file.Close()
+}
+
+func init() {
+	RegisterSink("file", newFileSink)
+}
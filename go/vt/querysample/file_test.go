@@ -0,0 +1,104 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package querysample
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	querysamplepb "vitess.io/vitess/go/vt/proto/querysample"
+)
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "querysample")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	*sampleFile = path.Join(dir, "samples.json")
+	defer func() { *sampleFile = "" }()
+
+	want := []*querysamplepb.QuerySample{{
+		Time:          1,
+		Method:        "Execute",
+		StatementType: "SELECT",
+		NormalizedSql: "select * from t where id = :redacted1",
+		ShardQueries:  2,
+		TotalTime:     1000,
+		Rows:          3,
+	}, {
+		Time:          2,
+		Method:        "Execute",
+		StatementType: "INSERT",
+		NormalizedSql: "insert into t values (:redacted1)",
+		Error:         "duplicate key",
+	}}
+
+	// The samples of a second sink are appended to the file.
+	for _, sample := range want {
+		sink, err := NewSink("file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := sink.Record(context.Background(), []*querysamplepb.QuerySample{sample}); err != nil {
+			t.Fatal(err)
+		}
+		if err := sink.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	file, err := os.Open(*sampleFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	var got []*querysamplepb.QuerySample
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		sample := &querysamplepb.QuerySample{}
+		if err := json.Unmarshal(scanner.Bytes(), sample); err != nil {
+			t.Fatalf("json.Unmarshal(%s) failed: %v", scanner.Text(), err)
+		}
+		got = append(got, sample)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v samples, want %v", len(got), len(want))
+	}
+	for i := range want {
+		if !proto.Equal(got[i], want[i]) {
+			t.Errorf("sample %v: %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestNewSinkErrors(t *testing.T) {
+	if _, err := NewSink("unknown"); err == nil || err.Error() != "no query sample sink registered with name unknown" {
+		t.Errorf("NewSink(unknown): %v", err)
+	}
+	if _, err := NewSink("file"); err == nil || err.Error() != "-query_sample_file must be set" {
+		t.Errorf("NewSink(file) without -query_sample_file: %v", err)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpcquerysample contains the gRPC query sample sink, which
+// sends the sampled queries to a QuerySampleSink server.
+package grpcquerysample
+
+import (
+	"flag"
+	"fmt"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/querysample"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querysamplepb "vitess.io/vitess/go/vt/proto/querysample"
+	querysampleservicepb "vitess.io/vitess/go/vt/proto/querysampleservice"
+)
+
+var (
+	server = flag.String("query_sample_grpc_server", "", "the address of the gRPC query sample server")
+	cert   = flag.String("query_sample_grpc_cert", "", "the cert to use to connect")
+	key    = flag.String("query_sample_grpc_key", "", "the key to use to connect")
+	ca     = flag.String("query_sample_grpc_ca", "", "the server ca to use to validate servers when connecting")
+	name   = flag.String("query_sample_grpc_server_name", "", "the server name to use to validate server certificate, or the SPIFFE ID or trust domain of the server (like spiffe://example.org/vttablet), which requires a ca")
+)
+
+type sink struct {
+	conn       *grpc.ClientConn
+	gRPCClient querysampleservicepb.QuerySampleSinkClient
+}
+
+func factory() (querysample.Sink, error) {
+	if *server == "" {
+		return nil, fmt.Errorf("-query_sample_grpc_server must be set")
+	}
+	opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *name)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpcclient.Dial(*server, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, err
+	}
+	return &sink{
+		conn:       conn,
+		gRPCClient: querysampleservicepb.NewQuerySampleSinkClient(conn),
+	}, nil
+}
+
+// Record is part of the querysample.Sink interface.
+func (s *sink) Record(ctx context.Context, samples []*querysamplepb.QuerySample) error {
+	_, err := s.gRPCClient.Record(ctx, &querysamplepb.RecordRequest{Samples: samples})
+	return vterrors.FromGRPC(err)
+}
+
+// Close is part of the querysample.Sink interface.
+func (s *sink) Close() error {
+	return s.conn.Close()
+}
+
+func init() {
+	querysample.RegisterSink("grpc", factory)
+}
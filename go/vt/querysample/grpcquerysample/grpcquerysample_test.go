@@ -0,0 +1,98 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcquerysample
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/querysample"
+
+	querysamplepb "vitess.io/vitess/go/vt/proto/querysample"
+	querysampleservicepb "vitess.io/vitess/go/vt/proto/querysampleservice"
+)
+
+// fakeServer keeps the samples it receives.
+type fakeServer struct {
+	mu      sync.Mutex
+	samples []*querysamplepb.QuerySample
+}
+
+func (fs *fakeServer) Record(ctx context.Context, request *querysamplepb.RecordRequest) (*querysamplepb.RecordResponse, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.samples = append(fs.samples, request.Samples...)
+	return &querysamplepb.RecordResponse{}, nil
+}
+
+func TestSink(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	s := grpc.NewServer()
+	fs := &fakeServer{}
+	querysampleservicepb.RegisterQuerySampleSinkServer(s, fs)
+	go s.Serve(listener)
+	defer s.Stop()
+
+	*server = listener.Addr().String()
+	defer func() { *server = "" }()
+	sink, err := querysample.NewSink("grpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+
+	want := []*querysamplepb.QuerySample{{
+		Method:        "Execute",
+		StatementType: "SELECT",
+		NormalizedSql: "select * from t where id = :redacted1",
+		ShardQueries:  4,
+	}, {
+		Method:        "StreamExecute",
+		StatementType: "SELECT",
+		NormalizedSql: "select * from t",
+		Rows:          100,
+	}}
+	if err := sink.Record(context.Background(), want); err != nil {
+		t.Fatal(err)
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if len(fs.samples) != len(want) {
+		t.Fatalf("server got %v samples, want %v", len(fs.samples), len(want))
+	}
+	for i := range want {
+		if !proto.Equal(fs.samples[i], want[i]) {
+			t.Errorf("sample %v: %v, want %v", i, fs.samples[i], want[i])
+		}
+	}
+}
+
+func TestSinkWithoutServer(t *testing.T) {
+	if _, err := querysample.NewSink("grpc"); err == nil || err.Error() != "-query_sample_grpc_server must be set" {
+		t.Errorf("NewSink(grpc) without -query_sample_grpc_server: %v", err)
+	}
+}
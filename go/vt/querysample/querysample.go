@@ -0,0 +1,74 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package querysample contains the sinks of the queries sampled by
+// vtgate for workload capture (see go/vt/vtgate/query_sampler.go).
+//
+// Implementations register themselves with RegisterSink, and are
+// selected with the -query_sample_sink flag of vtgate. The file sink
+// is always available.
+package querysample
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+
+	querysamplepb "vitess.io/vitess/go/vt/proto/querysample"
+)
+
+// Sink records the sampled queries.
+type Sink interface {
+	// Record records a batch of samples. It is called by a single
+	// goroutine, and must not keep samples after it returns.
+	Record(ctx context.Context, samples []*querysamplepb.QuerySample) error
+
+	// Close flushes and releases the resources held by the Sink.
+	Close() error
+}
+
+// Factory creates a Sink.
+type Factory func() (Sink, error)
+
+var (
+	mu        sync.Mutex
+	factories = make(map[string]Factory)
+)
+
+// RegisterSink registers a Sink implementation under a name. It is
+// meant to be called from init functions.
+func RegisterSink(name string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := factories[name]; ok {
+		log.Fatalf("query sample sink %v already registered", name)
+	}
+	factories[name] = factory
+}
+
+// NewSink creates the Sink registered under name.
+func NewSink(name string) (Sink, error) {
+	mu.Lock()
+	factory, ok := factories[name]
+	mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("no query sample sink registered with name %v", name)
+	}
+	return factory()
+}
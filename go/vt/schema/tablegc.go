@@ -0,0 +1,94 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schema has the helpers shared by the components that manage
+// the tables of a keyspace on behalf of the users.
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	gouuid "github.com/pborman/uuid"
+)
+
+// TableGCState is a stage of the lifecycle of a dropped table.
+type TableGCState string
+
+const (
+	// HoldTableGCState is the first state of a dropped table. The table
+	// is kept as is, so it can be renamed back if the drop was a mistake.
+	HoldTableGCState TableGCState = "HOLD"
+	// PurgeTableGCState is the state of a table whose rows are being
+	// deleted in small batches.
+	PurgeTableGCState TableGCState = "PURGE"
+	// EvacTableGCState is the state of an empty table, waiting for its
+	// pages to be evicted from the buffer pool.
+	EvacTableGCState TableGCState = "EVAC"
+	// DropTableGCState is the state of a table that is ready to be
+	// dropped.
+	DropTableGCState TableGCState = "DROP"
+)
+
+// gcTableTimestampFormat is the format of the time a table entered
+// its state, at the end of its name.
+const gcTableTimestampFormat = "20060102150405"
+
+var gcTableNameRegexp = regexp.MustCompile(`^_vt_(HOLD|PURGE|EVAC|DROP)_([0-9a-f]{32})_([0-9]{14})$`)
+
+// GenerateGCTableName returns a unique table name for a table that
+// enters the given state at time t. The name fits in the 64 characters
+// MySQL allows.
+func GenerateGCTableName(state TableGCState, t time.Time) (string, error) {
+	switch state {
+	case HoldTableGCState, PurgeTableGCState, EvacTableGCState, DropTableGCState:
+	default:
+		return "", fmt.Errorf("unknown table GC state: %v", state)
+	}
+	uuid := strings.Replace(gouuid.NewRandom().String(), "-", "", -1)
+	return fmt.Sprintf("_vt_%s_%s_%s", state, uuid, t.UTC().Format(gcTableTimestampFormat)), nil
+}
+
+// RenameGCTableName returns the name of a GC table when it enters
+// the given state at time t. The unique part of the name is kept.
+func RenameGCTableName(name string, state TableGCState, t time.Time) (string, error) {
+	submatch := gcTableNameRegexp.FindStringSubmatch(name)
+	if submatch == nil {
+		return "", fmt.Errorf("not a GC table name: %v", name)
+	}
+	return fmt.Sprintf("_vt_%s_%s_%s", state, submatch[2], t.UTC().Format(gcTableTimestampFormat)), nil
+}
+
+// AnalyzeGCTableName returns the state of a GC table and the time it
+// entered that state. ok is false if the name is not a GC table name.
+func AnalyzeGCTableName(name string) (state TableGCState, t time.Time, ok bool) {
+	submatch := gcTableNameRegexp.FindStringSubmatch(name)
+	if submatch == nil {
+		return "", time.Time{}, false
+	}
+	t, err := time.Parse(gcTableTimestampFormat, submatch[3])
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return TableGCState(submatch[1]), t, true
+}
+
+// IsGCTableName returns true if name is the name of a GC table.
+func IsGCTableName(name string) bool {
+	return gcTableNameRegexp.MatchString(name)
+}
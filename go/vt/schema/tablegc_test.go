@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGCTableNames(t *testing.T) {
+	now := time.Date(2019, 10, 4, 13, 5, 59, 0, time.UTC)
+	name, err := GenerateGCTableName(HoldTableGCState, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(name) > 64 {
+		t.Errorf("GenerateGCTableName: %v is longer than 64 characters", name)
+	}
+	state, ts, ok := AnalyzeGCTableName(name)
+	if !ok || state != HoldTableGCState || !ts.Equal(now) {
+		t.Errorf("AnalyzeGCTableName(%v): %v, %v, %v, want %v, %v, true", name, state, ts, ok, HoldTableGCState, now)
+	}
+
+	later := now.Add(time.Hour)
+	renamed, err := RenameGCTableName(name, PurgeTableGCState, later)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renamed[len("_vt_PURGE_"):len("_vt_PURGE_")+32] != name[len("_vt_HOLD_"):len("_vt_HOLD_")+32] {
+		t.Errorf("RenameGCTableName(%v): %v, the unique part changed", name, renamed)
+	}
+	state, ts, ok = AnalyzeGCTableName(renamed)
+	if !ok || state != PurgeTableGCState || !ts.Equal(later) {
+		t.Errorf("AnalyzeGCTableName(%v): %v, %v, %v, want %v, %v, true", renamed, state, ts, ok, PurgeTableGCState, later)
+	}
+
+	if _, err := GenerateGCTableName("KEEP", now); err == nil {
+		t.Errorf("GenerateGCTableName(KEEP): nil, want error")
+	}
+	for _, name := range []string{"t1", "_vt_HOLD_t1_20191004130559", "_vt_KEEP_0123456789abcdef0123456789abcdef_20191004130559"} {
+		if IsGCTableName(name) {
+			t.Errorf("IsGCTableName(%v): true, want false", name)
+		}
+		if _, err := RenameGCTableName(name, DropTableGCState, now); err == nil {
+			t.Errorf("RenameGCTableName(%v): nil, want error", name)
+		}
+	}
+}
@@ -234,9 +234,10 @@ func newFakeExecutor(t *testing.T) *TabletExecutor {
 
 func newFakeTabletManagerClient() *fakeTabletManagerClient {
 	return &fakeTabletManagerClient{
-		TabletManagerClient: faketmclient.NewFakeTabletManagerClient(),
-		preflightSchemas:    make(map[string]*tabletmanagerdatapb.SchemaChangeResult),
-		schemaDefinitions:   make(map[string]*tabletmanagerdatapb.SchemaDefinition),
+		TabletManagerClient:     faketmclient.NewFakeTabletManagerClient(),
+		preflightSchemas:        make(map[string]*tabletmanagerdatapb.SchemaChangeResult),
+		schemaDefinitions:       make(map[string]*tabletmanagerdatapb.SchemaDefinition),
+		tabletSchemaDefinitions: make(map[string]*tabletmanagerdatapb.SchemaDefinition),
 	}
 }
 
@@ -245,6 +246,9 @@ type fakeTabletManagerClient struct {
 	EnableExecuteFetchAsDbaError bool
 	preflightSchemas             map[string]*tabletmanagerdatapb.SchemaChangeResult
 	schemaDefinitions            map[string]*tabletmanagerdatapb.SchemaDefinition
+	// tabletSchemaDefinitions override the schema definitions for
+	// some tablets, by alias.
+	tabletSchemaDefinitions map[string]*tabletmanagerdatapb.SchemaDefinition
 }
 
 func (client *fakeTabletManagerClient) AddSchemaChange(sql string, schemaResult *tabletmanagerdatapb.SchemaChangeResult) {
@@ -270,6 +274,9 @@ func (client *fakeTabletManagerClient) PreflightSchema(ctx context.Context, tabl
 }
 
 func (client *fakeTabletManagerClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	if result, ok := client.tabletSchemaDefinitions[topoproto.TabletAliasString(tablet.Alias)]; ok {
+		return result, nil
+	}
 	result, ok := client.schemaDefinitions[topoproto.TabletDbName(tablet)]
 	if !ok {
 		return nil, fmt.Errorf("unknown database: %s", topoproto.TabletDbName(tablet))
@@ -1300,7 +1300,8 @@ func (shardSwap *shardSchemaSwap) reparentFromMaster(masterTablet *topodatapb.Ta
 			shardSwap.shardName,
 			nil,                /* masterElectTabletAlias */
 			masterTablet.Alias, /* avoidMasterAlias */
-			*reparentTimeout)
+			*reparentTimeout,
+			nil /* checks */)
 		if err != nil {
 			return err
 		}
@@ -24,7 +24,9 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -36,6 +38,7 @@ type TabletExecutor struct {
 	tablets              []*topodatapb.Tablet
 	isClosed             bool
 	allowBigSchemaChange bool
+	tableGC              bool
 	keyspace             string
 	waitSlaveTimeout     time.Duration
 }
@@ -62,6 +65,13 @@ func (exec *TabletExecutor) DisallowBigSchemaChange() {
 	exec.allowBigSchemaChange = false
 }
 
+// EnableTableGC changes TabletExecutor such that the dropped tables are
+// renamed into the table GC lifecycle instead of being dropped right away.
+// The tablets then purge and drop them in the background.
+func (exec *TabletExecutor) EnableTableGC() {
+	exec.tableGC = true
+}
+
 // Open opens a connection to the master for every shard.
 func (exec *TabletExecutor) Open(ctx context.Context, keyspace string) error {
 	if !exec.isClosed {
@@ -204,6 +214,16 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 		}
 	}()
 
+	if exec.tableGC {
+		var err error
+		sqls, err = exec.gcDropStatements(ctx, sqls)
+		if err != nil {
+			execResult.ExecutorErr = err.Error()
+			return &execResult
+		}
+		execResult.Sqls = sqls
+	}
+
 	// Make sure the schema changes introduce a table definition change.
 	if err := exec.preflightSchemaChanges(ctx, sqls); err != nil {
 		execResult.ExecutorErr = err.Error()
@@ -220,6 +240,66 @@ func (exec *TabletExecutor) Execute(ctx context.Context, sqls []string) *Execute
 	return &execResult
 }
 
+// gcDropStatements rewrites the DROP TABLE statements into RENAME TABLE
+// statements, which move the tables into the HOLD state of the table GC.
+// Only the base tables that exist on all the tablets are renamed: the
+// drops of views, or of tables that don't exist without IF EXISTS, are
+// kept as is. A table that exists on some of the tablets only can't be
+// renamed on all of them, so it's an error.
+func (exec *TabletExecutor) gcDropStatements(ctx context.Context, sqls []string) ([]string, error) {
+	// tables counts the tablets that have each table.
+	var tables map[string]int
+	now := time.Now()
+	result := make([]string, 0, len(sqls))
+	for _, sql := range sqls {
+		stat, err := sqlparser.Parse(sql)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sql: %s, got error: %v", sql, err)
+		}
+		ddl, ok := stat.(*sqlparser.DDL)
+		if !ok || ddl.Action != sqlparser.DropStr {
+			result = append(result, sql)
+			continue
+		}
+		if tables == nil {
+			tables = make(map[string]int)
+			for _, tablet := range exec.tablets {
+				dbSchema, err := exec.wr.TabletManagerClient().GetSchema(ctx, tablet, []string{}, []string{}, false)
+				if err != nil {
+					return nil, fmt.Errorf("unable to get database schema of %v, error: %v", topoproto.TabletAliasString(tablet.Alias), err)
+				}
+				for _, td := range dbSchema.TableDefinitions {
+					tables[td.Name]++
+				}
+			}
+		}
+		rename := &sqlparser.DDL{Action: sqlparser.RenameStr}
+		missing := false
+		for _, table := range ddl.FromTables {
+			if !table.Qualifier.IsEmpty() || tables[table.Name.String()] == 0 {
+				missing = true
+				continue
+			}
+			if tables[table.Name.String()] != len(exec.tablets) {
+				return nil, fmt.Errorf("table %v exists on %d of the %d tablets, it can't be moved into the table GC: %s", table.Name.String(), tables[table.Name.String()], len(exec.tablets), sql)
+			}
+			gcName, err := schema.GenerateGCTableName(schema.HoldTableGCState, now)
+			if err != nil {
+				return nil, err
+			}
+			delete(tables, table.Name.String())
+			rename.FromTables = append(rename.FromTables, table)
+			rename.ToTables = append(rename.ToTables, sqlparser.TableName{Name: sqlparser.NewTableIdent(gcName)})
+		}
+		if len(rename.FromTables) == 0 || (missing && !ddl.IfExists) {
+			result = append(result, sql)
+			continue
+		}
+		result = append(result, sqlparser.String(rename))
+	}
+	return result, nil
+}
+
 func (exec *TabletExecutor) executeOnAllTablets(ctx context.Context, execResult *ExecuteResult, sql string) {
 	var wg sync.WaitGroup
 	numOfMasterTablets := len(exec.tablets)
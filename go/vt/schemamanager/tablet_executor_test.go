@@ -17,6 +17,7 @@ limitations under the License.
 package schemamanager
 
 import (
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -214,3 +215,81 @@ func TestTabletExecutorExecute(t *testing.T) {
 		t.Fatalf("execute should fail, call execute.Open first")
 	}
 }
+
+func TestTabletExecutorGCDropStatements(t *testing.T) {
+	fakeTmc := newFakeTabletManagerClient()
+	fakeTmc.AddSchemaDefinition("vt_test_keyspace", &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: "CREATE DATABASE `{{.DatabaseName}}` /*!40100 DEFAULT CHARACTER SET utf8 */",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:   "test_table",
+				Schema: "table schema",
+				Type:   tmutils.TableBaseTable,
+			},
+			{
+				Name:   "test_table_02",
+				Schema: "table schema",
+				Type:   tmutils.TableBaseTable,
+			},
+		},
+	})
+	wr := wrangler.New(logutil.NewConsoleLogger(), newFakeTopo(t), fakeTmc)
+	executor := NewTabletExecutor(wr, testWaitSlaveTimeout)
+	executor.EnableTableGC()
+	ctx := context.Background()
+	if err := executor.Open(ctx, "test_keyspace"); err != nil {
+		t.Fatalf("executor.Open failed: %v", err)
+	}
+	defer executor.Close()
+
+	gcName := `_vt_HOLD_[0-9a-f]{32}_[0-9]{14}`
+	testcases := []struct {
+		sql  string
+		want string
+	}{{
+		sql:  "CREATE TABLE test_table_03 (pk int)",
+		want: `^CREATE TABLE test_table_03 \(pk int\)$`,
+	}, {
+		sql:  "DROP TABLE test_table",
+		want: "^rename table test_table to " + gcName + "$",
+	}, {
+		sql:  "DROP TABLE IF EXISTS test_table, test_table_02",
+		want: "^rename table test_table_02 to " + gcName + "$",
+	}, {
+		sql:  "DROP TABLE unknown_table",
+		want: "^DROP TABLE unknown_table$",
+	}, {
+		sql:  "DROP VIEW test_view",
+		want: "^DROP VIEW test_view$",
+	}}
+	var sqls []string
+	for _, tcase := range testcases {
+		sqls = append(sqls, tcase.sql)
+	}
+	got, err := executor.gcDropStatements(ctx, sqls)
+	if err != nil {
+		t.Fatalf("gcDropStatements failed: %v", err)
+	}
+	if len(got) != len(testcases) {
+		t.Fatalf("gcDropStatements: %v, want %d statements", got, len(testcases))
+	}
+	for i, tcase := range testcases {
+		if !regexp.MustCompile(tcase.want).MatchString(got[i]) {
+			t.Errorf("gcDropStatements(%v): %v, want match of %v", tcase.sql, got[i], tcase.want)
+		}
+	}
+
+	// A table that is missing on one of the tablets can't be renamed.
+	fakeTmc.tabletSchemaDefinitions["test_cell-0000000002"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "test_table_02",
+			Schema: "table schema",
+			Type:   tmutils.TableBaseTable,
+		}},
+	}
+	_, err = executor.gcDropStatements(ctx, []string{"DROP TABLE IF EXISTS test_table"})
+	want := "table test_table exists on 2 of the 3 tablets"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("gcDropStatements: %v, want %s", err, want)
+	}
+}
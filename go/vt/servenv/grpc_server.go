@@ -21,10 +21,12 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"strings"
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
 
 	"google.golang.org/grpc"
@@ -62,6 +64,9 @@ var (
 	// GRPCCA is the CA to use if TLS is enabled
 	GRPCCA = flag.String("grpc_ca", "", "ca to use, requires TLS, and enforces client cert check")
 
+	// GRPCSPIFFEIDs are the SPIFFE IDs or trust domains the client certs must have
+	GRPCSPIFFEIDs = flag.String("grpc_spiffe_ids", "", "comma separated list of SPIFFE IDs or trust domains (like spiffe://example.org/vtgate or spiffe://example.org) the client certs must have, requires grpc_ca")
+
 	// GRPCAuth which auth plugin to use (at the moment now only static is supported)
 	GRPCAuth = flag.String("grpc_auth_mode", "", "Which auth plugin implementation to use (eg: static)")
 
@@ -93,7 +98,19 @@ var (
 	// there are no active streams, server will send GOAWAY and close the connection.
 	GRPCKeepAliveEnforcementPolicyPermitWithoutStream = flag.Bool("grpc_server_keepalive_enforcement_policy_permit_without_stream", false, "grpc server permit client keepalive pings even when there are no active streams (RPCs)")
 
+	// GRPCKeepAliveTime is how long the server waits without activity
+	// on a connection before it pings the client.
+	GRPCKeepAliveTime = flag.Duration("grpc_server_keepalive_time", 0, "After a duration of this time if the server doesn't see any activity on a connection it pings the client to see if the transport is still alive. 0 means the gRPC default.")
+
+	// GRPCKeepAliveTimeout is how long the server waits for the answer
+	// to a keepalive ping before it closes the connection.
+	GRPCKeepAliveTimeout = flag.Duration("grpc_server_keepalive_timeout", 0, "After having pinged for keepalive check, the server waits for a duration of Timeout and if no activity is seen even after that the connection is closed. 0 means the gRPC default.")
+
 	authPlugin Authenticator
+
+	// grpcInFlight is the number of RPCs being served. It's the
+	// in-flight work the gRPC server drains in lameduck mode.
+	grpcInFlight sync2.AtomicInt64
 )
 
 // isGRPCEnabled returns true if gRPC server is set
@@ -127,6 +144,16 @@ func createGRPCServer() {
 		if err != nil {
 			log.Exitf("Failed to log gRPC cert/key/ca: %v", err)
 		}
+		// The config is cloned for each handshake, to use the reloaded
+		// cert and ca, so it needs the gRPC protocol too.
+		config.NextProtos = []string{"h2"}
+
+		if *GRPCSPIFFEIDs != "" {
+			if *GRPCCA == "" {
+				log.Exitf("-grpc_spiffe_ids requires -grpc_ca")
+			}
+			config.VerifyPeerCertificate = vttls.VerifyPeerSPIFFEID(strings.Split(*GRPCSPIFFEIDs, ","))
+		}
 
 		// create the creds server options
 		creds := credentials.NewTLS(config)
@@ -162,6 +189,8 @@ func createGRPCServer() {
 	if GRPCMaxConnectionAge != nil {
 		ka := keepalive.ServerParameters{
 			MaxConnectionAge: *GRPCMaxConnectionAge,
+			Time:             *GRPCKeepAliveTime,
+			Timeout:          *GRPCKeepAliveTimeout,
 		}
 		if GRPCMaxConnectionAgeGrace != nil {
 			ka.MaxConnectionAgeGrace = *GRPCMaxConnectionAgeGrace
@@ -177,6 +206,7 @@ func createGRPCServer() {
 // We can only set a ServerInterceptor once, so we chain multiple interceptors into one
 func interceptors() []grpc.ServerOption {
 	interceptors := &serverInterceptorBuilder{}
+	interceptors.Add(inFlightStreamInterceptor, inFlightUnaryInterceptor)
 
 	if *GRPCAuth != "" {
 		log.Infof("enabling auth plugin %v", *GRPCAuth)
@@ -228,13 +258,42 @@ func serveGRPC() {
 		}
 	}()
 
+	RegisterDrain("gRPC", grpcInFlight.Get)
 	OnTermSync(func() {
+		deadline := drainDeadline()
+		// The sessions, like the transactions of a tablet, need new
+		// RPCs to finish, so they are drained first.
+		waitForSessions(deadline)
 		log.Info("Initiated graceful stop of gRPC server")
-		GRPCServer.GracefulStop()
+		stopped := make(chan struct{})
+		go func() {
+			GRPCServer.GracefulStop()
+			close(stopped)
+		}()
+		// GracefulStop doesn't accept new connections and RPCs, and
+		// waits for the RPCs being served. The ones that are still
+		// running after the drain grace period, like the streams that
+		// never end, are cancelled.
+		if !waitForDrain("gRPC", deadline) {
+			GRPCServer.Stop()
+		}
+		<-stopped
 		log.Info("gRPC server stopped")
 	})
 }
 
+func inFlightStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	grpcInFlight.Add(1)
+	defer grpcInFlight.Add(-1)
+	return handler(srv, stream)
+}
+
+func inFlightUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	grpcInFlight.Add(1)
+	defer grpcInFlight.Add(-1)
+	return handler(ctx, req)
+}
+
 // GRPCCheckServiceMap returns if we should register a gRPC service
 // (and also logs how to enable / disable it)
 func GRPCCheckServiceMap(name string) bool {
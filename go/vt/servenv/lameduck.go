@@ -0,0 +1,166 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servenv
+
+import (
+	"flag"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// In lameduck mode, the servers stop accepting new connections and
+// wait for their in-flight work to drain, for at most the drain grace
+// period. The servers register their in-flight work with RegisterDrain
+// so that the progress of the drain is exported and logged.
+//
+// The sessions that span several requests, like the transactions of a
+// tablet, are registered with RegisterSessionDrain: the gRPC server
+// keeps serving the requests of the sessions until they are drained,
+// and only then stops accepting new requests.
+
+var (
+	drainGracePeriod = flag.Duration("drain_grace_period", 0, "In lameduck mode, how long the servers wait for their in-flight queries and transactions to finish before they close the remaining connections. 0 means they wait until onterm_timeout.")
+
+	// drainProgressInterval is how often the progress of a drain is
+	// logged.
+	drainProgressInterval = 2 * time.Second
+
+	lameduck sync2.AtomicBool
+
+	drainMu  sync.Mutex
+	drainers = make(map[string]func() int64)
+	sessions = make(map[string]bool)
+
+	_ = stats.NewGaugeFunc("Lameduck", "1 if the process is in lameduck mode", func() int64 {
+		if lameduck.Get() {
+			return 1
+		}
+		return 0
+	})
+	_ = stats.NewGaugesFuncWithMultiLabels("DrainInFlight", "In-flight work of the servers that drain in lameduck mode", []string{"Server"}, drainInFlight)
+)
+
+// IsLameduck returns true once the process has entered lameduck mode.
+func IsLameduck() bool {
+	return lameduck.Get()
+}
+
+// enterLameduck is called by Run when the process receives SIGTERM,
+// before the OnTerm hooks are fired.
+func enterLameduck() {
+	lameduck.Set(true)
+}
+
+// RegisterDrain registers the function that returns the in-flight work
+// of a server, like its in-flight requests or its connections in a
+// transaction. It's exported in the DrainInFlight variable.
+func RegisterDrain(server string, inFlight func() int64) {
+	drainMu.Lock()
+	defer drainMu.Unlock()
+	drainers[server] = inFlight
+}
+
+// RegisterSessionDrain registers the function that returns the open
+// sessions of a server, like the transactions of a tablet, whose
+// requests are still served in lameduck mode until they are drained.
+func RegisterSessionDrain(server string, inFlight func() int64) {
+	drainMu.Lock()
+	defer drainMu.Unlock()
+	drainers[server] = inFlight
+	sessions[server] = true
+}
+
+func drainInFlight() map[string]int64 {
+	drainMu.Lock()
+	defer drainMu.Unlock()
+	counts := make(map[string]int64, len(drainers))
+	for server, inFlight := range drainers {
+		counts[server] = inFlight()
+	}
+	return counts
+}
+
+// drainDeadline returns a channel that is closed when the drain grace
+// period expires, or nil if there is no grace period. Unlike a timer,
+// it can be waited on by several drains in a row.
+func drainDeadline() <-chan struct{} {
+	if *drainGracePeriod == 0 {
+		return nil
+	}
+	deadline := make(chan struct{})
+	time.AfterFunc(*drainGracePeriod, func() { close(deadline) })
+	return deadline
+}
+
+// WaitForDrain waits until the in-flight work registered for the server
+// is done, and logs its progress. It returns false if the drain grace
+// period expired first.
+func WaitForDrain(server string) bool {
+	return waitForDrain(server, drainDeadline())
+}
+
+// waitForSessions waits until the sessions registered with
+// RegisterSessionDrain are done. It returns false if the deadline
+// expired first.
+func waitForSessions(deadline <-chan struct{}) bool {
+	drainMu.Lock()
+	var names []string
+	for name := range sessions {
+		names = append(names, name)
+	}
+	drainMu.Unlock()
+	sort.Strings(names)
+	for _, name := range names {
+		if !waitForDrain(name, deadline) {
+			return false
+		}
+	}
+	return true
+}
+
+func waitForDrain(server string, deadline <-chan struct{}) bool {
+	drainMu.Lock()
+	inFlight := drainers[server]
+	drainMu.Unlock()
+	if inFlight == nil || inFlight() == 0 {
+		return true
+	}
+
+	log.Infof("Waiting for the in-flight work of %v to drain (%d in flight)...", server, inFlight())
+	ticker := time.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	reported := time.Now()
+	for inFlight() != 0 {
+		select {
+		case <-deadline:
+			log.Warningf("Drain grace period of %v expired with %d in flight for %v", *drainGracePeriod, inFlight(), server)
+			return false
+		case <-ticker.C:
+		}
+		if time.Since(reported) > drainProgressInterval {
+			log.Infof("Still waiting for the in-flight work of %v to drain (%d in flight)...", server, inFlight())
+			reported = time.Now()
+		}
+	}
+	log.Infof("The in-flight work of %v is drained", server)
+	return true
+}
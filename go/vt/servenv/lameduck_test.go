@@ -0,0 +1,141 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servenv
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/sync2"
+)
+
+func TestWaitForDrain(t *testing.T) {
+	defer func() {
+		drainers = make(map[string]func() int64)
+	}()
+
+	var inFlight sync2.AtomicInt64
+	inFlight.Set(2)
+	RegisterDrain("test", inFlight.Get)
+	if got, want := drainInFlight(), map[string]int64{"test": 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("drainInFlight: %v, want %v", got, want)
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		inFlight.Add(-1)
+		time.Sleep(10 * time.Millisecond)
+		inFlight.Add(-1)
+	}()
+	if !WaitForDrain("test") {
+		t.Error("WaitForDrain: false, want true")
+	}
+
+	// Nothing to wait for.
+	if !WaitForDrain("unknown") {
+		t.Error("WaitForDrain(unknown): false, want true")
+	}
+}
+
+func TestWaitForDrainGracePeriod(t *testing.T) {
+	defer func() {
+		drainers = make(map[string]func() int64)
+	}()
+	save := *drainGracePeriod
+	*drainGracePeriod = 20 * time.Millisecond
+	defer func() { *drainGracePeriod = save }()
+
+	RegisterDrain("test", func() int64 { return 1 })
+	start := time.Now()
+	if WaitForDrain("test") {
+		t.Error("WaitForDrain: true, want false")
+	}
+	if elapsed := time.Since(start); elapsed < *drainGracePeriod {
+		t.Errorf("WaitForDrain returned after %v, want at least %v", elapsed, *drainGracePeriod)
+	}
+}
+
+func TestWaitForSessions(t *testing.T) {
+	defer func() {
+		drainers = make(map[string]func() int64)
+		sessions = make(map[string]bool)
+	}()
+	save := *drainGracePeriod
+	*drainGracePeriod = 20 * time.Millisecond
+	defer func() { *drainGracePeriod = save }()
+
+	var transactions sync2.AtomicInt64
+	transactions.Set(1)
+	RegisterSessionDrain("transactions", transactions.Get)
+	RegisterDrain("test", func() int64 { return 1 })
+	if got, want := drainInFlight(), map[string]int64{"transactions": 1, "test": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("drainInFlight: %v, want %v", got, want)
+	}
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		transactions.Set(0)
+	}()
+	start := time.Now()
+	deadline := drainDeadline()
+	if !waitForSessions(deadline) {
+		t.Error("waitForSessions: false, want true")
+	}
+	// The sessions and the server share the grace period.
+	if waitForDrain("test", deadline) {
+		t.Error("waitForDrain: true, want false")
+	}
+	if elapsed := time.Since(start); elapsed > 10*(*drainGracePeriod) {
+		t.Errorf("the drains took %v, want about %v", elapsed, *drainGracePeriod)
+	}
+
+	// The sessions that don't finish stop at the deadline.
+	transactions.Set(1)
+	if waitForSessions(drainDeadline()) {
+		t.Error("waitForSessions: true, want false")
+	}
+}
+
+func TestLameduck(t *testing.T) {
+	defer lameduck.Set(false)
+
+	if IsLameduck() {
+		t.Error("IsLameduck: true, want false")
+	}
+	enterLameduck()
+	if !IsLameduck() {
+		t.Error("IsLameduck: false, want true")
+	}
+}
+
+func TestInFlightInterceptor(t *testing.T) {
+	var during int64
+	_, err := inFlightUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		during = grpcInFlight.Get()
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if during != 1 || grpcInFlight.Get() != 0 {
+		t.Errorf("in flight during the RPC: %d, after: %d, want 1 and 0", during, grpcInFlight.Get())
+	}
+}
@@ -50,6 +50,7 @@ func Run(port int) {
 	l.Close()
 
 	startTime := time.Now()
+	enterLameduck()
 	log.Infof("Entering lameduck mode for at least %v", *lameduckPeriod)
 	log.Infof("Firing asynchronous OnTerm hooks")
 	go onTermHooks.Fire()
@@ -0,0 +1,297 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servenv
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// Some flags, like the pool sizes and the timeouts, can be changed on a
+// running process. The servers register them in a RuntimeFlagSet, with
+// the function that applies a new value. The flag variables themselves
+// are never written once the flags are parsed, since the servers read
+// them without synchronization: the new values are applied through
+// setters or atomics. Each server has its own set, so the tablet
+// servers of vtcombo are changed independently. The flags are changed
+// by the SetRuntimeFlags RPCs of the tablets and of vtgate, or by a
+// POST to /debug/runtime_flags, and every change is logged and kept in
+// the history of the changes.
+
+// runtimeFlagHistorySize is the number of changes kept in the history.
+const runtimeFlagHistorySize = 100
+
+var (
+	// RuntimeFlags are the runtime flags of the process, like the ones
+	// of vtgate.
+	RuntimeFlags = NewRuntimeFlagSet()
+
+	servedRuntimeFlagsMu sync.Mutex
+	servedRuntimeFlags   = RuntimeFlags
+
+	runtimeFlagChanges = stats.NewCountersWithSingleLabel("RuntimeFlagChanges", "Changes of the flags at runtime", "Flag")
+)
+
+// RuntimeFlagChange is a change of a flag at runtime.
+type RuntimeFlagChange struct {
+	Time     time.Time
+	Caller   string
+	Name     string
+	OldValue string
+	NewValue string
+}
+
+// RuntimeFlagSet is a set of flags that can be changed at runtime.
+type RuntimeFlagSet struct {
+	mu    sync.Mutex
+	flags map[string]*runtimeFlag
+	// history is the ring of the last changes.
+	history     [runtimeFlagHistorySize]RuntimeFlagChange
+	historyNext int
+	historyLen  int
+}
+
+type runtimeFlag struct {
+	value string
+	set   func(value string) error
+}
+
+// NewRuntimeFlagSet creates an empty RuntimeFlagSet.
+func NewRuntimeFlagSet() *RuntimeFlagSet {
+	return &RuntimeFlagSet{flags: make(map[string]*runtimeFlag)}
+}
+
+// Register allows the flag name to be changed at runtime. The flag must
+// be defined and parsed: its value is the initial value. set parses a
+// new value and applies it to the server; it must not change the flag
+// variable. If set fails, the flag keeps its previous value.
+func (rf *RuntimeFlagSet) Register(name string, set func(value string) error) {
+	f := flag.Lookup(name)
+	if f == nil {
+		panic(fmt.Sprintf("runtime flag %v is not defined", name))
+	}
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	rf.flags[name] = &runtimeFlag{value: f.Value.String(), set: set}
+}
+
+// Values returns the current values of the flags.
+func (rf *RuntimeFlagSet) Values() map[string]string {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	values := make(map[string]string, len(rf.flags))
+	for name, f := range rf.flags {
+		values[name] = f.value
+	}
+	return values
+}
+
+// Set changes the flags to the new values, on behalf of caller. The
+// flags are changed all together: if a flag can't be changed, the flags
+// changed before it are set back to their previous values and the error
+// is returned. It returns the changes, in the order of the flag names.
+func (rf *RuntimeFlagSet) Set(caller string, values map[string]string) ([]RuntimeFlagChange, error) {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		if _, ok := rf.flags[name]; !ok {
+			return nil, fmt.Errorf("flag %v cannot be changed at runtime", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	now := time.Now()
+	changes := make([]RuntimeFlagChange, 0, len(names))
+	for _, name := range names {
+		f := rf.flags[name]
+		if err := f.set(values[name]); err != nil {
+			rf.rollback(changes)
+			return nil, fmt.Errorf("cannot set flag %v to %q: %v", name, values[name], err)
+		}
+		changes = append(changes, RuntimeFlagChange{
+			Time:     now,
+			Caller:   caller,
+			Name:     name,
+			OldValue: f.value,
+			NewValue: values[name],
+		})
+		f.value = values[name]
+	}
+
+	for _, change := range changes {
+		log.Infof("Flag %v changed from %q to %q by %v", change.Name, change.OldValue, change.NewValue, change.Caller)
+		runtimeFlagChanges.Add(change.Name, 1)
+		rf.history[rf.historyNext] = change
+		rf.historyNext = (rf.historyNext + 1) % runtimeFlagHistorySize
+		if rf.historyLen < runtimeFlagHistorySize {
+			rf.historyLen++
+		}
+	}
+	return changes, nil
+}
+
+// rollback sets back the flags of the changes to their previous values.
+func (rf *RuntimeFlagSet) rollback(changes []RuntimeFlagChange) {
+	for i := len(changes) - 1; i >= 0; i-- {
+		f := rf.flags[changes[i].Name]
+		if err := f.set(changes[i].OldValue); err != nil {
+			log.Errorf("Cannot set back flag %v: %v", changes[i].Name, err)
+			continue
+		}
+		f.value = changes[i].OldValue
+	}
+}
+
+// History returns the last changes of the flags, oldest first.
+func (rf *RuntimeFlagSet) History() []RuntimeFlagChange {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	history := make([]RuntimeFlagChange, 0, rf.historyLen)
+	for i := 0; i < rf.historyLen; i++ {
+		history = append(history, rf.history[(rf.historyNext-rf.historyLen+i+runtimeFlagHistorySize)%runtimeFlagHistorySize])
+	}
+	return history
+}
+
+// IntRuntimeFlag returns the function that parses an int value for
+// RuntimeFlagSet.Register, and applies it with set.
+func IntRuntimeFlag(set func(int) error) func(string) error {
+	return func(value string) error {
+		val, err := strconv.ParseInt(value, 0, strconv.IntSize)
+		if err != nil {
+			return err
+		}
+		return set(int(val))
+	}
+}
+
+// Int64RuntimeFlag returns the function that parses an int64 value for
+// RuntimeFlagSet.Register, and applies it with set.
+func Int64RuntimeFlag(set func(int64) error) func(string) error {
+	return func(value string) error {
+		val, err := strconv.ParseInt(value, 0, 64)
+		if err != nil {
+			return err
+		}
+		return set(val)
+	}
+}
+
+// FloatRuntimeFlag returns the function that parses a float64 value for
+// RuntimeFlagSet.Register, and applies it with set.
+func FloatRuntimeFlag(set func(float64) error) func(string) error {
+	return func(value string) error {
+		val, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		return set(val)
+	}
+}
+
+// DurationRuntimeFlag returns the function that parses a duration value
+// for RuntimeFlagSet.Register, and applies it with set.
+func DurationRuntimeFlag(set func(time.Duration) error) func(string) error {
+	return func(value string) error {
+		val, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		return set(val)
+	}
+}
+
+// HandleRuntimeFlags serves set on /debug/runtime_flags instead of
+// RuntimeFlags. It's called by the processes whose flags belong to a
+// server, like the tablet server of vttablet.
+func HandleRuntimeFlags(set *RuntimeFlagSet) {
+	servedRuntimeFlagsMu.Lock()
+	defer servedRuntimeFlagsMu.Unlock()
+	servedRuntimeFlags = set
+}
+
+// runtimeFlagsHandler returns the flags that can be changed at runtime
+// and the history of their changes. A POST changes the flags to the
+// values of its form, and returns the changes.
+func runtimeFlagsHandler(w http.ResponseWriter, r *http.Request) {
+	servedRuntimeFlagsMu.Lock()
+	rf := servedRuntimeFlags
+	servedRuntimeFlagsMu.Unlock()
+
+	if r.Method == http.MethodPost {
+		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		values := make(map[string]string, len(r.PostForm))
+		for name := range r.PostForm {
+			values[name] = r.PostForm.Get(name)
+		}
+		changes, err := rf.Set(r.RemoteAddr, values)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		writeRuntimeFlagsJSON(w, changes)
+		return
+	}
+
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	writeRuntimeFlagsJSON(w, struct {
+		Flags   map[string]string
+		History []RuntimeFlagChange
+	}{
+		Flags:   rf.Values(),
+		History: rf.History(),
+	})
+}
+
+func writeRuntimeFlagsJSON(w http.ResponseWriter, v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(data)
+}
+
+func init() {
+	OnInit(func() {
+		http.HandleFunc("/debug/runtime_flags", runtimeFlagsHandler)
+	})
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servenv
+
+import (
+	"errors"
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSetRuntimeFlags(t *testing.T) {
+	fs := flag.CommandLine
+	fs.Int("test_runtime_pool_size", 10, "")
+	timeoutFlag := fs.Duration("test_runtime_timeout", 0, "")
+	fs.Bool("test_runtime_static", false, "")
+
+	rf := NewRuntimeFlagSet()
+	var poolSize int
+	var timeout time.Duration
+	rf.Register("test_runtime_pool_size", IntRuntimeFlag(func(val int) error {
+		if val <= 0 {
+			return errors.New("pool size must be positive")
+		}
+		poolSize = val
+		return nil
+	}))
+	rf.Register("test_runtime_timeout", DurationRuntimeFlag(func(val time.Duration) error {
+		timeout = val
+		return nil
+	}))
+
+	changes, err := rf.Set("admin", map[string]string{
+		"test_runtime_pool_size": "20",
+		"test_runtime_timeout":   "5s",
+	})
+	if err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if poolSize != 20 || timeout != 5*time.Second {
+		t.Errorf("applied: %v, %v, want 20, 5s", poolSize, timeout)
+	}
+	// The flag variables are not changed.
+	if *timeoutFlag != 0 {
+		t.Errorf("test_runtime_timeout: %v, want 0", *timeoutFlag)
+	}
+	if len(changes) != 2 || changes[0].Name != "test_runtime_pool_size" || changes[0].OldValue != "10" || changes[0].NewValue != "20" || changes[0].Caller != "admin" {
+		t.Errorf("changes: %+v", changes)
+	}
+
+	// An invalid value or a value that can't be applied leaves all the
+	// flags unchanged.
+	for _, values := range []map[string]string{
+		{"test_runtime_pool_size": "30", "test_runtime_timeout": "soon"},
+		{"test_runtime_pool_size": "0", "test_runtime_timeout": "1s"},
+		{"test_runtime_pool_size": "30", "test_runtime_static": "true"},
+	} {
+		if _, err := rf.Set("admin", values); err == nil {
+			t.Errorf("Set(%v) succeeded, want error", values)
+		}
+		want := map[string]string{
+			"test_runtime_pool_size": "20",
+			"test_runtime_timeout":   "5s",
+		}
+		if got := rf.Values(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Values after Set(%v): %v, want %v", values, got, want)
+		}
+		if poolSize != 20 || timeout != 5*time.Second {
+			t.Errorf("applied after Set(%v): %v, %v, want 20, 5s", values, poolSize, timeout)
+		}
+	}
+
+	_, err = rf.Set("admin", map[string]string{"test_runtime_static": "true"})
+	if err == nil || !strings.Contains(err.Error(), "cannot be changed at runtime") {
+		t.Errorf("Set(test_runtime_static): %v, want cannot be changed at runtime", err)
+	}
+
+	history := rf.History()
+	if len(history) != 2 {
+		t.Fatalf("History: %v, want 2 changes", history)
+	}
+	if last := history[len(history)-1]; last.Name != "test_runtime_timeout" || last.NewValue != "5s" {
+		t.Errorf("last change: %+v", last)
+	}
+
+	// The sets are independent.
+	other := NewRuntimeFlagSet()
+	other.Register("test_runtime_pool_size", IntRuntimeFlag(func(int) error { return nil }))
+	if got, want := other.Values(), map[string]string{"test_runtime_pool_size": "10"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values of another set: %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servenv
+
+import (
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttls"
+)
+
+var tlsReloadInterval = flag.Duration("tls_reload_interval", 0, "how often the TLS certificates, keys and CAs are reloaded if their files changed. 0 disables it. They are also reloaded on SIGHUP.")
+
+func init() {
+	OnInit(func() {
+		sigChan := make(chan os.Signal, 1)
+		signal.Notify(sigChan, syscall.SIGHUP)
+		go func() {
+			for range sigChan {
+				if err := vttls.Reload(true); err != nil {
+					log.Errorf("Cannot reload the TLS files: %v", err)
+				} else {
+					log.Infof("Reloaded the TLS files")
+				}
+			}
+		}()
+
+		if *tlsReloadInterval > 0 {
+			go func() {
+				for range time.Tick(*tlsReloadInterval) {
+					if err := vttls.Reload(false); err != nil {
+						log.Errorf("Cannot reload the TLS files: %v", err)
+					}
+				}
+			}()
+		}
+	})
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sqlnormalizer normalizes and fingerprints SQL queries the way
+// vtgate does: the literals are replaced by bind variables, and the
+// margin comments are stripped. Queries that only differ by their values
+// or comments have the same normalized text and the same fingerprint.
+//
+// The normalization is deterministic: the same query always gives the
+// same result, so log pipelines and external tools can use this package
+// to group queries like vtgate does when -normalize_queries is set, its
+// default. Without it, vtgate sends the queries as they are.
+//
+// vttablet fingerprints the query it receives (see FingerprintNormalized)
+// in its query blocklist and plan stats. That's the query vtgate
+// rewrote for each shard: it has the fingerprint of the application
+// query when vtgate sends it unchanged to the shards, but not when vtgate
+// rewrites it, for instance to split a join or the values of an IN list.
+package sqlnormalizer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// DefaultPrefix is the prefix of the bind variables replacing the
+// literals. It's the one used by vtgate.
+const DefaultPrefix = "vtg"
+
+// Redaction is how the values of a normalized query are shown.
+type Redaction int
+
+const (
+	// RedactBindVars replaces the values by bind variables, like
+	// ":vtg1". The values are returned in Result.BindVars.
+	RedactBindVars = Redaction(iota)
+	// RedactPlaceholders replaces the values by "?", and the lists of
+	// values by "(?)", like many log pipelines do.
+	RedactPlaceholders
+	// RedactNone keeps the values in the query.
+	RedactNone
+)
+
+// Options configure a Normalizer.
+type Options struct {
+	// Prefix is the prefix of the bind variables replacing the
+	// literals. DefaultPrefix is used if it's empty.
+	Prefix string
+	// Redaction is how the values are shown in Result.Query.
+	Redaction Redaction
+	// KeepComments keeps the margin comments in Result.Query.
+	KeepComments bool
+}
+
+// Result is a normalized query.
+type Result struct {
+	// Query is the normalized query, redacted as configured.
+	Query string
+	// BindVars are the values extracted from the query. They are
+	// nil with RedactNone.
+	BindVars map[string]*querypb.BindVariable
+	// Comments are the margin comments of the query.
+	Comments sqlparser.MarginComments
+	// Fingerprint identifies the normalized query. It doesn't depend
+	// on the options.
+	Fingerprint string
+}
+
+// Normalizer normalizes queries with its options. It is safe for
+// concurrent use.
+type Normalizer struct {
+	opts Options
+}
+
+// NewNormalizer creates a Normalizer.
+func NewNormalizer(opts Options) *Normalizer {
+	if opts.Prefix == "" {
+		opts.Prefix = DefaultPrefix
+	}
+	return &Normalizer{opts: opts}
+}
+
+var defaultNormalizer = NewNormalizer(Options{})
+
+// Normalize parses and normalizes a query.
+func (n *Normalizer) Normalize(sql string) (*Result, error) {
+	query, comments := sqlparser.SplitMarginComments(sql)
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Comments: comments}
+
+	var original string
+	if n.opts.Redaction == RedactNone {
+		original = sqlparser.String(stmt)
+	}
+	bindVars := make(map[string]*querypb.BindVariable)
+	normalized := normalizeStatement(stmt, bindVars, n.opts.Prefix)
+	if n.opts.Prefix == DefaultPrefix {
+		result.Fingerprint = FingerprintNormalized(normalized)
+	} else {
+		// The fingerprint uses the bind variable names of vtgate.
+		fingerprintStmt, err := sqlparser.Parse(query)
+		if err != nil {
+			return nil, err
+		}
+		result.Fingerprint = FingerprintNormalized(NormalizeStatement(fingerprintStmt, make(map[string]*querypb.BindVariable)))
+	}
+
+	switch n.opts.Redaction {
+	case RedactBindVars:
+		result.Query = normalized
+		result.BindVars = bindVars
+	case RedactPlaceholders:
+		result.Query = n.placeholders(stmt, bindVars)
+		result.BindVars = bindVars
+	default:
+		result.Query = original
+	}
+	if n.opts.KeepComments {
+		result.Query = comments.Leading + result.Query + comments.Trailing
+	}
+	return result, nil
+}
+
+// Fingerprint returns the fingerprint of a query.
+func (n *Normalizer) Fingerprint(sql string) (string, error) {
+	result, err := n.Normalize(sql)
+	if err != nil {
+		return "", err
+	}
+	return result.Fingerprint, nil
+}
+
+// placeholders formats the normalized statement with "?" instead of
+// the bind variables of the values.
+func (n *Normalizer) placeholders(stmt sqlparser.Statement, bindVars map[string]*querypb.BindVariable) string {
+	buf := sqlparser.NewTrackedBuffer(func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		switch node := node.(type) {
+		case *sqlparser.SQLVal:
+			if node.Type == sqlparser.ValArg {
+				if _, ok := bindVars[strings.TrimPrefix(string(node.Val), ":")]; ok {
+					buf.WriteString("?")
+					return
+				}
+			}
+		case sqlparser.ListArg:
+			if _, ok := bindVars[strings.TrimPrefix(string(node), "::")]; ok {
+				buf.WriteString("(?)")
+				return
+			}
+		}
+		node.Format(buf)
+	})
+	buf.Myprintf("%v", stmt)
+	return buf.String()
+}
+
+// Normalize normalizes a query with the default options: the values
+// are replaced by bind variables, and the comments are stripped.
+func Normalize(sql string) (*Result, error) {
+	return defaultNormalizer.Normalize(sql)
+}
+
+// Fingerprint returns the fingerprint of a query.
+func Fingerprint(sql string) (string, error) {
+	return defaultNormalizer.Fingerprint(sql)
+}
+
+// NormalizeStatement normalizes a parsed statement, without its
+// comments, in place like vtgate does, and returns its text. The values
+// are added to bindVars.
+func NormalizeStatement(stmt sqlparser.Statement, bindVars map[string]*querypb.BindVariable) string {
+	return normalizeStatement(stmt, bindVars, DefaultPrefix)
+}
+
+func normalizeStatement(stmt sqlparser.Statement, bindVars map[string]*querypb.BindVariable, prefix string) string {
+	sqlparser.Normalize(stmt, bindVars, prefix)
+	return sqlparser.String(stmt)
+}
+
+// FingerprintNormalized returns the fingerprint of a query that is
+// already normalized, without its comments, like the queries vtgate
+// sends to vttablet.
+func FingerprintNormalized(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:8])
+}
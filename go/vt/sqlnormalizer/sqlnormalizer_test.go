@@ -0,0 +1,134 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlnormalizer
+
+import (
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestNormalize(t *testing.T) {
+	testcases := []struct {
+		opts     Options
+		sql      string
+		query    string
+		bindVars map[string]*querypb.BindVariable
+	}{{
+		sql:   "/* leading */ select a from t where id = 1 and name = 'x' /* trailing */",
+		query: "select a from t where id = :vtg1 and name = :vtg2",
+		bindVars: map[string]*querypb.BindVariable{
+			"vtg1": sqltypes.Int64BindVariable(1),
+			"vtg2": sqltypes.BytesBindVariable([]byte("x")),
+		},
+	}, {
+		opts:  Options{KeepComments: true},
+		sql:   "/* leading */ select a from t where id = 1 /* trailing */",
+		query: "/* leading */ select a from t where id = :vtg1 /* trailing */",
+		bindVars: map[string]*querypb.BindVariable{
+			"vtg1": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		opts:  Options{Prefix: "p"},
+		sql:   "select a from t where id = 1",
+		query: "select a from t where id = :p1",
+		bindVars: map[string]*querypb.BindVariable{
+			"p1": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		// The bind variables of the query are kept.
+		opts:  Options{Redaction: RedactPlaceholders},
+		sql:   "select a from t where id = 1 and b in (2, 3) and c = :c",
+		query: "select a from t where id = ? and b in (?) and c = :c",
+		bindVars: map[string]*querypb.BindVariable{
+			"vtg1": sqltypes.Int64BindVariable(1),
+			"vtg2": {
+				Type: querypb.Type_TUPLE,
+				Values: []*querypb.Value{
+					{Type: querypb.Type_INT64, Value: []byte("2")},
+					{Type: querypb.Type_INT64, Value: []byte("3")},
+				},
+			},
+		},
+	}, {
+		opts:  Options{Redaction: RedactNone},
+		sql:   "select a from t where id = 1 /* trailing */",
+		query: "select a from t where id = 1",
+	}, {
+		opts:  Options{Redaction: RedactPlaceholders},
+		sql:   "insert into t(a, b) values (1, 'x'), (2, 'y')",
+		query: "insert into t(a, b) values (?, ?), (?, ?)",
+		bindVars: map[string]*querypb.BindVariable{
+			"vtg1": sqltypes.Int64BindVariable(1),
+			"vtg2": sqltypes.BytesBindVariable([]byte("x")),
+			"vtg3": sqltypes.Int64BindVariable(2),
+			"vtg4": sqltypes.BytesBindVariable([]byte("y")),
+		},
+	}}
+	for _, tc := range testcases {
+		result, err := NewNormalizer(tc.opts).Normalize(tc.sql)
+		if err != nil {
+			t.Errorf("Normalize(%v): %v", tc.sql, err)
+			continue
+		}
+		if result.Query != tc.query {
+			t.Errorf("Normalize(%v).Query: %v, want %v", tc.sql, result.Query, tc.query)
+		}
+		if !reflect.DeepEqual(result.BindVars, tc.bindVars) {
+			t.Errorf("Normalize(%v).BindVars: %v, want %v", tc.sql, result.BindVars, tc.bindVars)
+		}
+	}
+
+	if _, err := Normalize("select from"); err == nil {
+		t.Errorf("Normalize(select from) succeeded")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	want, err := Fingerprint("select a from t where id = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(want) != 16 {
+		t.Errorf("Fingerprint: %v, want 16 hex digits", want)
+	}
+	// Only the values, the comments, the spacing and the options
+	// differ.
+	for _, sql := range []string{
+		"select a from t where id = 2",
+		"/* comment */ SELECT a FROM t WHERE id=3 /* trailing */",
+	} {
+		if got, err := Fingerprint(sql); err != nil || got != want {
+			t.Errorf("Fingerprint(%v): %v, %v, want %v", sql, got, err, want)
+		}
+	}
+	for _, opts := range []Options{{Prefix: "p"}, {Redaction: RedactPlaceholders}, {Redaction: RedactNone, KeepComments: true}} {
+		if got, err := NewNormalizer(opts).Fingerprint("select a from t where id = 4"); err != nil || got != want {
+			t.Errorf("Fingerprint with %+v: %v, %v, want %v", opts, got, err, want)
+		}
+	}
+	if got := FingerprintNormalized("select a from t where id = :vtg1"); got != want {
+		t.Errorf("FingerprintNormalized: %v, want %v", got, want)
+	}
+
+	if got, _ := Fingerprint("select b from t where id = 1"); got == want {
+		t.Errorf("different queries have the same fingerprint %v", got)
+	}
+}
@@ -52,6 +52,9 @@ const (
 	StmtOther
 	StmtUnknown
 	StmtComment
+	StmtSavepoint
+	StmtSRollback
+	StmtRelease
 )
 
 // Preview analyzes the beginning of the query using a simpler and faster
@@ -72,7 +75,7 @@ func Preview(sql string) StatementType {
 	// Comparison is done in order of priority.
 	loweredFirstWord := strings.ToLower(firstWord)
 	switch loweredFirstWord {
-	case "select":
+	case "select", "with":
 		return StmtSelect
 	case "stream":
 		return StmtStream
@@ -110,6 +113,13 @@ func Preview(sql string) StatementType {
 		return StmtUse
 	case "analyze", "describe", "desc", "explain", "repair", "optimize":
 		return StmtOther
+	case "savepoint":
+		return StmtSavepoint
+	case "rollback":
+		// A plain rollback was matched above.
+		return StmtSRollback
+	case "release":
+		return StmtRelease
 	}
 	return StmtUnknown
 }
@@ -144,6 +154,12 @@ func (s StatementType) String() string {
 		return "USE"
 	case StmtOther:
 		return "OTHER"
+	case StmtSavepoint:
+		return "SAVEPOINT"
+	case StmtSRollback:
+		return "SAVEPOINT_ROLLBACK"
+	case StmtRelease:
+		return "RELEASE"
 	default:
 		return "UNKNOWN"
 	}
@@ -158,6 +174,57 @@ func IsDML(sql string) bool {
 	return false
 }
 
+// ExtractSavepointName returns the savepoint name of a SAVEPOINT,
+// ROLLBACK TO SAVEPOINT or RELEASE SAVEPOINT statement. Those statements
+// are not in the grammar, and are identified by Preview.
+func ExtractSavepointName(sql string) (string, error) {
+	query, _ := SplitMarginComments(StripLeadingComments(sql))
+	words := strings.Fields(strings.TrimSuffix(query, ";"))
+	lowered := make([]string, len(words))
+	for i, word := range words {
+		lowered[i] = strings.ToLower(word)
+	}
+	var name []string
+	switch {
+	case len(words) == 2 && lowered[0] == "savepoint":
+		name = words[1:]
+	case len(words) >= 3 && lowered[0] == "rollback":
+		rest, lrest := words[1:], lowered[1:]
+		if lrest[0] == "work" {
+			rest, lrest = rest[1:], lrest[1:]
+		}
+		if len(lrest) > 0 && lrest[0] == "to" {
+			rest, lrest = rest[1:], lrest[1:]
+			if len(lrest) == 2 && lrest[0] == "savepoint" {
+				rest = rest[1:]
+			}
+			if len(rest) == 1 {
+				name = rest
+			}
+		}
+	case len(words) == 3 && lowered[0] == "release" && lowered[1] == "savepoint":
+		name = words[2:]
+	}
+	if len(name) != 1 {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid savepoint statement: %s", sql)
+	}
+	if n := name[0]; len(n) >= 2 && n[0] == '`' && n[len(n)-1] == '`' {
+		return strings.Replace(n[1:len(n)-1], "``", "`", -1), nil
+	}
+	return name[0], nil
+}
+
+// IsTemporaryTableDDL returns true if the statement creates or drops a
+// temporary table. Those statements are not in the grammar.
+func IsTemporaryTableDDL(sql string) bool {
+	query, _ := SplitMarginComments(StripLeadingComments(sql))
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) < 3 || words[1] != "temporary" || words[2] != "table" {
+		return false
+	}
+	return words[0] == "create" || words[0] == "drop"
+}
+
 // SplitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters. Outer parenthesis are removed. Precedence
 // should be taken into account if expressions are recombined.
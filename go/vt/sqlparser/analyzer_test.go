@@ -33,6 +33,7 @@ func TestPreview(t *testing.T) {
 		{"select ...", StmtSelect},
 		{"    select ...", StmtSelect},
 		{"(select ...", StmtSelect},
+		{"with t as (select ...) select ...", StmtSelect},
 		{"( select ...", StmtSelect},
 		{"insert ...", StmtInsert},
 		{"replace ....", StmtReplace},
@@ -55,6 +56,10 @@ func TestPreview(t *testing.T) {
 		{"commit /*...*/", StmtCommit},
 		{"rollback", StmtRollback},
 		{"rollback /*...*/", StmtRollback},
+		{"savepoint a", StmtSavepoint},
+		{"rollback to savepoint a", StmtSRollback},
+		{"rollback work to a", StmtSRollback},
+		{"release savepoint a", StmtRelease},
 		{"create", StmtDDL},
 		{"alter", StmtDDL},
 		{"rename", StmtDDL},
@@ -112,6 +117,51 @@ func TestIsDML(t *testing.T) {
 	}
 }
 
+func TestExtractSavepointName(t *testing.T) {
+	testcases := []struct {
+		sql  string
+		want string
+		err  string
+	}{
+		{sql: "savepoint a", want: "a"},
+		{sql: "SAVEPOINT `a``b`;", want: "a`b"},
+		{sql: "/* comment */ rollback to savepoint a", want: "a"},
+		{sql: "rollback work to a", want: "a"},
+		{sql: "rollback to `savepoint`", want: "savepoint"},
+		{sql: "release savepoint a", want: "a"},
+		{sql: "savepoint", err: "invalid savepoint statement: savepoint"},
+		{sql: "rollback to", err: "invalid savepoint statement: rollback to"},
+		{sql: "release a", err: "invalid savepoint statement: release a"},
+	}
+	for _, tcase := range testcases {
+		got, err := ExtractSavepointName(tcase.sql)
+		if tcase.err != "" {
+			assert.EqualError(t, err, tcase.err, tcase.sql)
+			continue
+		}
+		assert.NoError(t, err, tcase.sql)
+		assert.Equal(t, tcase.want, got, tcase.sql)
+	}
+}
+
+func TestIsTemporaryTableDDL(t *testing.T) {
+	testcases := []struct {
+		sql  string
+		want bool
+	}{
+		{"create temporary table t(id int)", true},
+		{"/* comment */ DROP TEMPORARY TABLE t", true},
+		{"create table t(id int)", false},
+		{"drop table temporary", false},
+		{"select temporary table", false},
+	}
+	for _, tcase := range testcases {
+		if got := IsTemporaryTableDDL(tcase.sql); got != tcase.want {
+			t.Errorf("IsTemporaryTableDDL(%s): %v, want %v", tcase.sql, got, tcase.want)
+		}
+	}
+}
+
 func TestSplitAndExpression(t *testing.T) {
 	testcases := []struct {
 		sql string
@@ -315,6 +315,7 @@ func (*ParenSelect) iSelectStatement() {}
 
 // Select represents a SELECT statement.
 type Select struct {
+	With        *With
 	Cache       string
 	Comments    Comments
 	Distinct    string
@@ -359,8 +360,8 @@ func (node *Select) SetLimit(limit *Limit) {
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s",
-		node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
+	buf.Myprintf("%vselect %v%s%s%s%v from %v%v%v%v%v%v%s",
+		node.With, node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
 		node.From, node.Where,
 		node.GroupBy, node.Having, node.OrderBy,
 		node.Limit, node.Lock)
@@ -372,6 +373,7 @@ func (node *Select) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Comments,
 		node.SelectExprs,
 		node.From,
@@ -459,6 +461,7 @@ func (node *ParenSelect) walkSubtree(visit Visit) error {
 
 // Union represents a UNION statement.
 type Union struct {
+	With        *With
 	Type        string
 	Left, Right SelectStatement
 	OrderBy     OrderBy
@@ -485,7 +488,7 @@ func (node *Union) SetLimit(limit *Limit) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v%v%v%s", node.Left, node.Type, node.Right,
+	buf.Myprintf("%v%v %s %v%v%v%s", node.With, node.Left, node.Type, node.Right,
 		node.OrderBy, node.Limit, node.Lock)
 }
 
@@ -495,11 +498,70 @@ func (node *Union) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Left,
 		node.Right,
 	)
 }
 
+// With represents the WITH clause of a SELECT or UNION.
+type With struct {
+	Recursive bool
+	CTEs      []*CommonTableExpr
+}
+
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	prefix := "with "
+	if node.Recursive {
+		prefix = "with recursive "
+	}
+	for _, n := range node.CTEs {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+	buf.WriteString(" ")
+}
+
+func (node *With) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	for _, n := range node.CTEs {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CommonTableExpr represents a common table expression of a WITH clause.
+type CommonTableExpr struct {
+	Name     TableIdent
+	Columns  Columns
+	Subquery *Subquery
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
+func (node *CommonTableExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+		node.Columns,
+		node.Subquery,
+	)
+}
+
 // Stream represents a SELECT statement.
 type Stream struct {
 	Comments   Comments
@@ -2837,6 +2899,12 @@ func (node *BinaryExpr) walkSubtree(visit Visit) error {
 	)
 }
 
+// IsJSONExtract returns true if the expression extracts a value of a
+// JSON column with the -> or ->> operators. Its path is a string literal.
+func (node *BinaryExpr) IsJSONExtract() bool {
+	return node.Operator == JSONExtractOp || node.Operator == JSONUnquoteExtractOp
+}
+
 func (node *BinaryExpr) replace(from, to Expr) bool {
 	return replaceExprs(from, to, &node.Left, &node.Right)
 }
@@ -2997,6 +3065,7 @@ type FuncExpr struct {
 	Name      ColIdent
 	Distinct  bool
 	Exprs     SelectExprs
+	Over      *OverClause
 }
 
 // Format formats the node.
@@ -3011,7 +3080,7 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	// Function names should not be back-quoted even
 	// if they match a reserved word. So, print the
 	// name as is.
-	buf.Myprintf("%s(%s%v)", node.Name.String(), distinct, node.Exprs)
+	buf.Myprintf("%s(%s%v)%v", node.Name.String(), distinct, node.Exprs, node.Over)
 }
 
 func (node *FuncExpr) walkSubtree(visit Visit) error {
@@ -3023,6 +3092,7 @@ func (node *FuncExpr) walkSubtree(visit Visit) error {
 		node.Qualifier,
 		node.Name,
 		node.Exprs,
+		node.Over,
 	)
 }
 
@@ -3036,6 +3106,19 @@ func (node *FuncExpr) replace(from, to Expr) bool {
 			return true
 		}
 	}
+	if node.Over == nil {
+		return false
+	}
+	for i := range node.Over.PartitionBy {
+		if replaceExprs(from, to, &node.Over.PartitionBy[i]) {
+			return true
+		}
+	}
+	for _, order := range node.Over.OrderBy {
+		if replaceExprs(from, to, &order.Expr) {
+			return true
+		}
+	}
 	return false
 }
 
@@ -3060,8 +3143,119 @@ var Aggregates = map[string]bool{
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// An aggregate used as a window function does not group rows,
+// and is therefore not considered an aggregate.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
+}
+
+// OverClause represents the window of a window function call.
+type OverClause struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString(" over (")
+	var sep string
+	if len(node.PartitionBy) != 0 {
+		buf.Myprintf("partition by %v", node.PartitionBy)
+		sep = " "
+	}
+	prefix := sep + "order by "
+	for _, n := range node.OrderBy {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+		sep = " "
+	}
+	if node.Frame != nil {
+		buf.Myprintf("%s%v", sep, node.Frame)
+	}
+	buf.WriteString(")")
+}
+
+func (node *OverClause) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.PartitionBy,
+		node.OrderBy,
+		node.Frame,
+	)
+}
+
+// FrameClause represents the frame of a window.
+type FrameClause struct {
+	Unit  string
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameClause.Unit
+const (
+	RowsStr  = "rows"
+	RangeStr = "range"
+)
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.Myprintf("%s %v", node.Unit, node.Start)
+		return
+	}
+	buf.Myprintf("%s between %v and %v", node.Unit, node.Start, node.End)
+}
+
+func (node *FrameClause) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Start,
+		node.End,
+	)
+}
+
+// FramePoint represents a boundary of a window frame.
+type FramePoint struct {
+	Type string
+	Expr Expr
+}
+
+// FramePoint.Type
+const (
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
+)
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr == nil {
+		buf.Myprintf("%s", node.Type)
+		return
+	}
+	buf.Myprintf("%v %s", node.Expr, node.Type)
+}
+
+func (node *FramePoint) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Expr,
+	)
 }
 
 // GroupConcatExpr represents a call to GROUP_CONCAT
@@ -17,6 +17,7 @@ limitations under the License.
 package sqlparser
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"unicode"
@@ -28,10 +29,41 @@ const (
 	DirectiveMultiShardAutocommit = "MULTI_SHARD_AUTOCOMMIT"
 	// DirectiveSkipQueryPlanCache skips query plan cache when set.
 	DirectiveSkipQueryPlanCache = "SKIP_QUERY_PLAN_CACHE"
-	// DirectiveQueryTimeout sets a query timeout in vtgate. Only supported for SELECTS.
+	// DirectiveQueryTimeout sets a query timeout in vtgate, and in the options
+	// sent to the tablets.
 	DirectiveQueryTimeout = "QUERY_TIMEOUT_MS"
 	// DirectiveScatterErrorsAsWarnings enables partial success scatter select queries
 	DirectiveScatterErrorsAsWarnings = "SCATTER_ERRORS_AS_WARNINGS"
+	// DirectiveAsOf reads the versions of the rows that were current at the
+	// specified time from history tables. Only supported for SELECTS.
+	DirectiveAsOf = "AS_OF"
+	// DirectiveTrace returns the timings of the query as warnings.
+	DirectiveTrace = "TRACE"
+	// DirectiveForceVindex routes a query with the named vindex of its
+	// tables instead of their cheapest one. Only supported for SELECTS.
+	DirectiveForceVindex = "FORCE_VINDEX"
+	// DirectiveWorkload sets the workload of the query, OLTP, OLAP or DBA,
+	// in the options sent to the tablets.
+	DirectiveWorkload = "WORKLOAD"
+	// DirectivePriority sets the priority of the query, HIGH, NORMAL or LOW,
+	// in the options sent to the tablets.
+	DirectivePriority = "PRIORITY"
+	// DirectiveSkipThrottler executes a query part generated by SplitQuery
+	// without waiting for the replication lag to go down.
+	DirectiveSkipThrottler = "SKIP_THROTTLER"
+	// DirectiveSpillToDisk lets vtgate spill the sorts of the query that
+	// exceed its memory budget to disk.
+	DirectiveSpillToDisk = "SPILL_TO_DISK"
+	// DirectiveHashJoin executes the cross-shard joins of a SELECT as
+	// hash joins when their ON clause only compares columns of both
+	// sides for equality.
+	DirectiveHashJoin = "HASH_JOIN"
+	// DirectiveMaxStaleness routes a read only to the replicas whose
+	// replication lag is at most this many milliseconds.
+	DirectiveMaxStaleness = "MAX_STALENESS_MS"
+	// DirectiveMaxStalenessFallbackToMaster sends a read with a maximum
+	// staleness to the master when no replica qualifies.
+	DirectiveMaxStalenessFallbackToMaster = "MAX_STALENESS_FALLBACK_TO_MASTER"
 )
 
 func isNonSpace(r rune) bool {
@@ -287,6 +319,19 @@ func (d CommentDirectives) IsSet(key string) bool {
 	return false
 }
 
+// GetString returns the value of the named directive as a string, and
+// whether it is set.
+func (d CommentDirectives) GetString(key string) (string, bool) {
+	val, ok := d[key]
+	if !ok {
+		return "", false
+	}
+	if strVal, ok := val.(string); ok {
+		return strVal, true
+	}
+	return fmt.Sprint(val), true
+}
+
 // SkipQueryPlanCacheDirective returns true if skip query plan cache directive is set to true in query.
 func SkipQueryPlanCacheDirective(stmt Statement) bool {
 	switch stmt := stmt.(type) {
@@ -315,3 +360,31 @@ func SkipQueryPlanCacheDirective(stmt Statement) bool {
 	}
 	return false
 }
+
+// StatementDirectives returns the comment directives of a SELECT, of the
+// first SELECT of a UNION, or of a DML statement.
+func StatementDirectives(stmt Statement) CommentDirectives {
+	var comments Comments
+	switch stmt := stmt.(type) {
+	case *Select:
+		comments = stmt.Comments
+	case *Union:
+		if sel, ok := stmt.Left.(*Select); ok {
+			comments = sel.Comments
+		}
+	case *Insert:
+		comments = stmt.Comments
+	case *Update:
+		comments = stmt.Comments
+	case *Delete:
+		comments = stmt.Comments
+	default:
+		return nil
+	}
+	return ExtractCommentDirectives(comments)
+}
+
+// TraceDirective returns true if the trace directive is set in the query.
+func TraceDirective(stmt Statement) bool {
+	return StatementDirectives(stmt).IsSet(DirectiveTrace)
+}
@@ -426,6 +426,61 @@ func TestExtractCommentDirectives(t *testing.T) {
 	}
 }
 
+func TestGetStringDirective(t *testing.T) {
+	d := CommentDirectives{
+		"FORCE_VINDEX": "name_user_map",
+		"NUM":          12,
+		"FLAG":         true,
+	}
+	testcases := []struct {
+		key  string
+		want string
+		ok   bool
+	}{
+		{key: "FORCE_VINDEX", want: "name_user_map", ok: true},
+		{key: "NUM", want: "12", ok: true},
+		{key: "FLAG", want: "true", ok: true},
+		{key: "MISSING", want: "", ok: false},
+	}
+	for _, tc := range testcases {
+		got, ok := d.GetString(tc.key)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("GetString(%s): %v, %v, want %v, %v", tc.key, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestStatementDirectives(t *testing.T) {
+	testcases := []struct {
+		sql  string
+		want CommentDirectives
+	}{{
+		sql:  "select /*vt+ FORCE_VINDEX=name_user_map */ * from user where name = 'a'",
+		want: CommentDirectives{"FORCE_VINDEX": "name_user_map"},
+	}, {
+		sql:  "select /*vt+ WORKLOAD=olap */ a from t1 union select b from t2",
+		want: CommentDirectives{"WORKLOAD": "olap"},
+	}, {
+		sql:  "update /*vt+ WORKLOAD=dba */ t1 set a = 1",
+		want: CommentDirectives{"WORKLOAD": "dba"},
+	}, {
+		sql:  "select * from t1",
+		want: nil,
+	}, {
+		sql:  "set /*vt+ WORKLOAD=dba */ a = 1",
+		want: nil,
+	}}
+	for _, tc := range testcases {
+		stmt, err := Parse(tc.sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := StatementDirectives(stmt); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("StatementDirectives(%s): %v, want %v", tc.sql, got, tc.want)
+		}
+	}
+}
+
 func TestSkipQueryPlanCacheDirective(t *testing.T) {
 	stmt, _ := Parse("insert /*vt+ SKIP_QUERY_PLAN_CACHE=1 */ into user(id) values (1), (2)")
 	if !SkipQueryPlanCacheDirective(stmt) {
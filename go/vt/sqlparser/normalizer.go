@@ -69,6 +69,11 @@ func (nz *normalizer) WalkStatement(node SQLNode) (bool, error) {
 		nz.convertSQLVal(node)
 	case *ComparisonExpr:
 		nz.convertComparison(node)
+	case *BinaryExpr:
+		// MySQL only accepts a literal as the path of a JSON extraction.
+		if node.IsJSONExtract() {
+			return false, nil
+		}
 	case *ColName, TableName:
 		// Common node types that never contain SQLVals or ListArgs but create a lot of object
 		// allocations.
@@ -84,6 +89,10 @@ func (nz *normalizer) WalkSelect(node SQLNode) (bool, error) {
 		nz.convertSQLValDedup(node)
 	case *ComparisonExpr:
 		nz.convertComparison(node)
+	case *BinaryExpr:
+		if node.IsJSONExtract() {
+			return false, nil
+		}
 	case *ColName, TableName:
 		// Common node types that never contain SQLVals or ListArgs but create a lot of object
 		// allocations.
@@ -188,6 +188,24 @@ func TestNormalize(t *testing.T) {
 		outbv: map[string]*querypb.BindVariable{
 			"bv1": sqltypes.TestBindVariable([]interface{}{1, []byte("2")}),
 		},
+	}, {
+		// JSON path
+		in:      "select doc->'$.a' from t where doc->>'$.b' = 'x' and json_extract(doc, '$.c') = 1",
+		outstmt: "select doc -> '$.a' from t where doc ->> '$.b' = :bv1 and json_extract(doc, :bv2) = :bv3",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.BytesBindVariable([]byte("x")),
+			"bv2": sqltypes.BytesBindVariable([]byte("$.c")),
+			"bv3": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		// JSON path in DML
+		in:      "update t set doc = json_set(doc, '$.a', 2) where doc->'$.b' = cast('{}' as json)",
+		outstmt: "update t set doc = json_set(doc, :bv1, :bv2) where doc -> '$.b' = convert(:bv3, json)",
+		outbv: map[string]*querypb.BindVariable{
+			"bv1": sqltypes.BytesBindVariable([]byte("$.a")),
+			"bv2": sqltypes.Int64BindVariable(2),
+			"bv3": sqltypes.BytesBindVariable([]byte("{}")),
+		},
 	}}
 	for _, tc := range testcases {
 		stmt, err := Parse(tc.in)
@@ -417,6 +417,15 @@ var (
 		input: "select /* -> */ a.b -> 'ab' from t",
 	}, {
 		input: "select /* -> */ a.b ->> 'ab' from t",
+	}, {
+		input:  "select /* -> */ a.b->\"$.c[0]\" from t",
+		output: "select /* -> */ a.b -> '$.c[0]' from t",
+	}, {
+		input:  "select /* json_extract */ json_extract(a, '$.b', '$.c') from t where a->>'$.d' = 1",
+		output: "select /* json_extract */ json_extract(a, '$.b', '$.c') from t where a ->> '$.d' = 1",
+	}, {
+		input:  "select /* json literal */ cast('{\"a\": [1, 2]}' as json) from t",
+		output: "select /* json literal */ convert('{\\\"a\\\": [1, 2]}', json) from t",
 	}, {
 		input: "select /* empty function */ 1 from t where a = b()",
 	}, {
@@ -425,6 +434,35 @@ var (
 		input: "select /* function with many params */ 1 from t where a = b(c, d)",
 	}, {
 		input: "select /* function with distinct */ count(distinct a) from t",
+	}, {
+		input: "select /* window function */ row_number() over (partition by a order by b desc) from t",
+	}, {
+		input:  "select /* window function with empty window */ sum(a) over ( ) from t",
+		output: "select /* window function with empty window */ sum(a) over () from t",
+	}, {
+		input: "select /* window function with order by */ rank() over (order by a asc, b desc) from t",
+	}, {
+		input: "select /* window function with rows frame */ sum(a) over (partition by b, c order by d asc rows between unbounded preceding and current row) from t",
+	}, {
+		input: "select /* window function with offset frame */ avg(a) over (order by b asc rows between 2 preceding and :c following) from t",
+	}, {
+		input: "select /* window function with range frame */ count(distinct a) over (order by b asc range between interval 1 day preceding and unbounded following) from t",
+	}, {
+		input: "select /* window function with frame start */ sum(a) over (rows current row) from t",
+	}, {
+		input: "select /* window function in order by */ a from t order by lag(a, 1) over (order by b asc) desc",
+	}, {
+		input: "with t1 as (select a from t) select /* cte */ * from t1",
+	}, {
+		input: "with t1(a, b) as (select c, d from t), t2 as (select * from t1) select * from t2",
+	}, {
+		input: "with recursive t1(n) as (select 1 from dual union all select n + 1 from t1 where n < 10) select n from t1",
+	}, {
+		input: "with t1 as (select a from t) select a from t1 union select a from t2 order by a asc limit 1",
+	}, {
+		input: "select /* cte in subquery */ * from (with t1 as (select a from t) select a from t1) as t2",
+	}, {
+		input: "insert /* cte in insert */ into a(b) with t1 as (select c from t) select c from t1",
 	}, {
 		input: "select /* if as func */ 1 from t where a = if(b)",
 	}, {
@@ -1715,6 +1753,9 @@ func TestKeywords(t *testing.T) {
 	}, {
 		input:  "select /* non-reserved keywords as unqualified cols */ date, view, offset from t",
 		output: "select /* non-reserved keywords as unqualified cols */ `date`, `view`, `offset` from t",
+	}, {
+		input:  "select /* window keywords as cols */ rows, current, preceding from t",
+		output: "select /* window keywords as cols */ `rows`, `current`, `preceding` from t",
 	}, {
 		input:  "select /* share and mode as cols */ share, mode from t where share = 'foo'",
 		output: "select /* share and mode as cols */ `share`, `mode` from t where `share` = 'foo'",
@@ -2385,6 +2426,15 @@ var (
 		output       string
 		excludeMulti bool // Don't use in the ParseNext multi-statement parsing tests.
 	}{{
+		input:  "select sum(a) over (rows a preceding) from t",
+		output: "syntax error at position 27 near 'a'",
+	}, {
+		input:  "select a from t1 union with t2 as (select a from t) select a from t2",
+		output: "syntax error at position 28 near 'with'",
+	}, {
+		input:  "select a->1 from t",
+		output: "syntax error at position 12 near '1'",
+	}, {
 		input:  "select $ from t",
 		output: "syntax error at position 9 near '$'",
 	}, {
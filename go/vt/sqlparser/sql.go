@@ -105,6 +105,12 @@ type yySymType struct {
 	vindexParams         []VindexParam
 	showFilter           *ShowFilter
 	optLike              *OptLike
+	with                 *With
+	ctes                 []*CommonTableExpr
+	cte                  *CommonTableExpr
+	overClause           *OverClause
+	frameClause          *FrameClause
+	framePoint           *FramePoint
 }
 
 const LEX_ERROR = 57346
@@ -351,88 +357,92 @@ const LANGUAGE = 57586
 const WITH = 57587
 const QUERY = 57588
 const EXPANSION = 57589
-const UNUSED = 57590
-const ARRAY = 57591
-const CUME_DIST = 57592
-const DESCRIPTION = 57593
-const DENSE_RANK = 57594
-const EMPTY = 57595
-const EXCEPT = 57596
-const FIRST_VALUE = 57597
-const GROUPING = 57598
-const GROUPS = 57599
-const JSON_TABLE = 57600
-const LAG = 57601
-const LAST_VALUE = 57602
-const LATERAL = 57603
-const LEAD = 57604
-const MEMBER = 57605
-const NTH_VALUE = 57606
-const NTILE = 57607
-const OF = 57608
-const OVER = 57609
-const PERCENT_RANK = 57610
-const RANK = 57611
-const RECURSIVE = 57612
-const ROW_NUMBER = 57613
-const SYSTEM = 57614
-const WINDOW = 57615
-const ACTIVE = 57616
-const ADMIN = 57617
-const BUCKETS = 57618
-const CLONE = 57619
-const COMPONENT = 57620
-const DEFINITION = 57621
-const ENFORCED = 57622
-const EXCLUDE = 57623
-const FOLLOWING = 57624
-const GEOMCOLLECTION = 57625
-const GET_MASTER_PUBLIC_KEY = 57626
-const HISTOGRAM = 57627
-const HISTORY = 57628
-const INACTIVE = 57629
-const INVISIBLE = 57630
-const LOCKED = 57631
-const MASTER_COMPRESSION_ALGORITHMS = 57632
-const MASTER_PUBLIC_KEY_PATH = 57633
-const MASTER_TLS_CIPHERSUITES = 57634
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57635
-const NESTED = 57636
-const NETWORK_NAMESPACE = 57637
-const NOWAIT = 57638
-const NULLS = 57639
-const OJ = 57640
-const OLD = 57641
-const OPTIONAL = 57642
-const ORDINALITY = 57643
-const ORGANIZATION = 57644
-const OTHERS = 57645
-const PATH = 57646
-const PERSIST = 57647
-const PERSIST_ONLY = 57648
-const PRECEDING = 57649
-const PRIVILEGE_CHECKS_USER = 57650
-const PROCESS = 57651
-const RANDOM = 57652
-const REFERENCE = 57653
-const REQUIRE_ROW_FORMAT = 57654
-const RESOURCE = 57655
-const RESPECT = 57656
-const RESTART = 57657
-const RETAIN = 57658
-const REUSE = 57659
-const ROLE = 57660
-const SECONDARY = 57661
-const SECONDARY_ENGINE = 57662
-const SECONDARY_LOAD = 57663
-const SECONDARY_UNLOAD = 57664
-const SKIP = 57665
-const SRID = 57666
-const THREAD_PRIORITY = 57667
-const TIES = 57668
-const UNBOUNDED = 57669
-const VCPU = 57670
-const VISIBLE = 57671
+const ROWS = 57590
+const RANGE = 57591
+const CURRENT = 57592
+const ROW = 57593
+const UNUSED = 57594
+const ARRAY = 57595
+const CUME_DIST = 57596
+const DESCRIPTION = 57597
+const DENSE_RANK = 57598
+const EMPTY = 57599
+const EXCEPT = 57600
+const FIRST_VALUE = 57601
+const GROUPING = 57602
+const GROUPS = 57603
+const JSON_TABLE = 57604
+const LAG = 57605
+const LAST_VALUE = 57606
+const LATERAL = 57607
+const LEAD = 57608
+const MEMBER = 57609
+const NTH_VALUE = 57610
+const NTILE = 57611
+const OF = 57612
+const OVER = 57613
+const PERCENT_RANK = 57614
+const RANK = 57615
+const RECURSIVE = 57616
+const ROW_NUMBER = 57617
+const SYSTEM = 57618
+const WINDOW = 57619
+const ACTIVE = 57620
+const ADMIN = 57621
+const BUCKETS = 57622
+const CLONE = 57623
+const COMPONENT = 57624
+const DEFINITION = 57625
+const ENFORCED = 57626
+const EXCLUDE = 57627
+const FOLLOWING = 57628
+const GEOMCOLLECTION = 57629
+const GET_MASTER_PUBLIC_KEY = 57630
+const HISTOGRAM = 57631
+const HISTORY = 57632
+const INACTIVE = 57633
+const INVISIBLE = 57634
+const LOCKED = 57635
+const MASTER_COMPRESSION_ALGORITHMS = 57636
+const MASTER_PUBLIC_KEY_PATH = 57637
+const MASTER_TLS_CIPHERSUITES = 57638
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57639
+const NESTED = 57640
+const NETWORK_NAMESPACE = 57641
+const NOWAIT = 57642
+const NULLS = 57643
+const OJ = 57644
+const OLD = 57645
+const OPTIONAL = 57646
+const ORDINALITY = 57647
+const ORGANIZATION = 57648
+const OTHERS = 57649
+const PATH = 57650
+const PERSIST = 57651
+const PERSIST_ONLY = 57652
+const PRECEDING = 57653
+const PRIVILEGE_CHECKS_USER = 57654
+const PROCESS = 57655
+const RANDOM = 57656
+const REFERENCE = 57657
+const REQUIRE_ROW_FORMAT = 57658
+const RESOURCE = 57659
+const RESPECT = 57660
+const RESTART = 57661
+const RETAIN = 57662
+const REUSE = 57663
+const ROLE = 57664
+const SECONDARY = 57665
+const SECONDARY_ENGINE = 57666
+const SECONDARY_LOAD = 57667
+const SECONDARY_UNLOAD = 57668
+const SKIP = 57669
+const SRID = 57670
+const THREAD_PRIORITY = 57671
+const TIES = 57672
+const UNBOUNDED = 57673
+const VCPU = 57674
+const VISIBLE = 57675
 
 var yyToknames = [...]string{
 	"$end",
@@ -699,6 +709,10 @@ var yyToknames = [...]string{
 	"WITH",
 	"QUERY",
 	"EXPANSION",
+	"ROWS",
+	"RANGE",
+	"CURRENT",
+	"ROW",
 	"UNUSED",
 	"ARRAY",
 	"CUME_DIST",
@@ -794,1756 +808,1780 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 3,
-	5, 29,
-	-2, 4,
-	-1, 37,
-	160, 300,
-	161, 300,
-	-2, 288,
-	-1, 320,
-	112, 640,
-	-2, 636,
-	-1, 321,
-	112, 641,
-	-2, 637,
-	-1, 389,
-	82, 888,
-	-2, 63,
-	-1, 390,
-	82, 806,
-	-2, 64,
-	-1, 395,
-	82, 775,
-	-2, 602,
-	-1, 397,
+	-1, 22,
+	5, 39,
+	-2, 24,
+	-1, 36,
+	160, 310,
+	161, 310,
+	-2, 298,
+	-1, 60,
+	5, 39,
+	-2, 25,
+	-1, 315,
+	112, 667,
+	-2, 663,
+	-1, 316,
+	112, 668,
+	-2, 664,
+	-1, 385,
+	82, 920,
+	-2, 73,
+	-1, 386,
 	82, 836,
-	-2, 604,
+	-2, 74,
+	-1, 391,
+	82, 804,
+	-2, 629,
+	-1, 393,
+	82, 866,
+	-2, 631,
 	-1, 689,
-	1, 352,
-	5, 352,
-	12, 352,
-	13, 352,
-	14, 352,
-	15, 352,
-	17, 352,
-	19, 352,
-	30, 352,
-	31, 352,
-	42, 352,
-	43, 352,
-	44, 352,
-	45, 352,
-	46, 352,
-	48, 352,
-	49, 352,
-	52, 352,
-	53, 352,
-	55, 352,
-	56, 352,
-	347, 352,
-	-2, 370,
+	1, 362,
+	5, 362,
+	12, 362,
+	13, 362,
+	14, 362,
+	15, 362,
+	17, 362,
+	19, 362,
+	30, 362,
+	31, 362,
+	42, 362,
+	43, 362,
+	44, 362,
+	45, 362,
+	46, 362,
+	48, 362,
+	49, 362,
+	52, 362,
+	53, 362,
+	55, 362,
+	56, 362,
+	351, 362,
+	-2, 380,
 	-1, 692,
-	53, 44,
-	55, 44,
-	-2, 48,
-	-1, 840,
-	112, 643,
-	-2, 639,
-	-1, 1069,
-	5, 30,
-	-2, 437,
-	-1, 1099,
-	5, 29,
-	-2, 576,
-	-1, 1344,
-	5, 30,
-	-2, 577,
-	-1, 1397,
-	5, 29,
-	-2, 579,
-	-1, 1475,
-	5, 30,
-	-2, 580,
+	53, 54,
+	55, 54,
+	-2, 58,
+	-1, 838,
+	112, 670,
+	-2, 666,
+	-1, 1078,
+	5, 40,
+	-2, 447,
+	-1, 1370,
+	5, 40,
+	-2, 604,
+	-1, 1507,
+	5, 40,
+	-2, 607,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 16596
+const yyLast = 16864
 
 var yyAct = [...]int{
 
-	321, 1509, 1499, 1306, 1463, 1102, 1194, 645, 1377, 1364,
-	953, 1120, 1280, 351, 1246, 1409, 338, 926, 299, 982,
-	546, 1243, 1247, 996, 1103, 325, 924, 57, 1033, 1147,
-	962, 952, 81, 1253, 1218, 1259, 264, 865, 789, 264,
-	1061, 872, 803, 1126, 1173, 875, 686, 1164, 966, 705,
-	842, 992, 394, 290, 928, 644, 3, 913, 893, 577,
-	388, 352, 51, 704, 583, 517, 589, 264, 81, 597,
-	323, 685, 264, 383, 264, 385, 694, 659, 906, 949,
-	56, 308, 1502, 1486, 660, 1497, 1473, 1494, 380, 1307,
-	1485, 1015, 691, 1472, 1235, 1336, 61, 535, 291, 292,
-	293, 294, 522, 1274, 297, 1014, 943, 298, 1135, 312,
-	1155, 1134, 550, 51, 1136, 571, 976, 259, 255, 256,
-	257, 304, 63, 64, 65, 66, 67, 296, 261, 1275,
-	1276, 944, 945, 1019, 706, 566, 707, 548, 295, 567,
-	564, 565, 1013, 1438, 610, 609, 619, 620, 612, 613,
-	614, 615, 616, 617, 618, 611, 975, 1196, 621, 382,
-	1367, 251, 983, 253, 519, 363, 521, 369, 370, 367,
-	368, 366, 365, 364, 570, 1384, 1327, 1325, 552, 289,
-	554, 371, 372, 778, 559, 560, 569, 777, 1198, 775,
-	1496, 1493, 1010, 1007, 1008, 1464, 1006, 1193, 907, 1456,
-	967, 1517, 1410, 536, 253, 391, 1219, 1513, 524, 1199,
-	1197, 551, 553, 1121, 1123, 1412, 782, 768, 1269, 1418,
-	1190, 1268, 776, 779, 1267, 520, 1192, 969, 1017, 1020,
-	527, 969, 266, 254, 1027, 1445, 258, 1026, 532, 1347,
-	1078, 633, 634, 1205, 1221, 1131, 1088, 1055, 814, 1075,
-	700, 601, 264, 542, 950, 264, 252, 1292, 621, 611,
-	1148, 264, 621, 939, 811, 1012, 808, 264, 596, 518,
-	81, 1454, 81, 804, 81, 81, 1427, 81, 1223, 81,
-	1227, 969, 1222, 1411, 1220, 81, 1257, 1011, 708, 1225,
-	1122, 1237, 555, 894, 556, 557, 549, 558, 1224, 561,
-	594, 529, 516, 530, 1471, 572, 531, 983, 1293, 70,
-	518, 1226, 1228, 1439, 1511, 81, 596, 1512, 770, 1510,
-	1419, 1417, 1191, 968, 1189, 1035, 1016, 968, 1153, 318,
-	585, 547, 894, 547, 1085, 547, 547, 1459, 547, 591,
-	547, 1018, 633, 634, 528, 71, 547, 534, 849, 573,
-	574, 633, 634, 541, 805, 538, 539, 540, 1477, 543,
-	586, 972, 847, 848, 846, 1373, 51, 973, 614, 615,
-	616, 617, 618, 611, 1518, 1181, 621, 968, 264, 264,
-	264, 630, 965, 963, 632, 964, 1372, 81, 523, 595,
-	594, 961, 967, 81, 595, 594, 1239, 1052, 1053, 1054,
-	54, 1168, 587, 1034, 1179, 684, 596, 22, 817, 818,
-	845, 596, 643, 1519, 647, 648, 649, 650, 651, 652,
-	653, 654, 655, 1167, 658, 661, 661, 661, 667, 661,
-	661, 667, 661, 675, 676, 677, 678, 679, 680, 866,
-	690, 867, 662, 664, 666, 668, 670, 672, 673, 663,
-	665, 1156, 669, 671, 1479, 674, 595, 594, 698, 1137,
-	250, 1138, 702, 1455, 813, 525, 526, 303, 693, 1391,
-	683, 1180, 692, 596, 1370, 1202, 1185, 1182, 1175, 1183,
-	1178, 1074, 1174, 1165, 1038, 1176, 1177, 1415, 1495, 350,
-	612, 613, 614, 615, 616, 617, 618, 611, 1452, 1184,
-	621, 812, 610, 609, 619, 620, 612, 613, 614, 615,
-	616, 617, 618, 611, 1309, 1148, 621, 264, 595, 594,
-	1143, 79, 81, 868, 391, 377, 378, 264, 264, 81,
-	788, 595, 594, 264, 787, 596, 264, 1481, 576, 264,
-	1415, 1467, 767, 264, 771, 81, 81, 769, 596, 774,
-	81, 81, 81, 264, 81, 81, 1062, 393, 1415, 576,
-	81, 81, 832, 834, 835, 792, 793, 1073, 833, 1072,
-	794, 795, 796, 766, 798, 799, 1415, 1446, 1415, 1414,
-	800, 801, 544, 547, 1362, 1361, 595, 594, 537, 81,
-	547, 1349, 576, 264, 576, 791, 1346, 576, 1424, 81,
-	1299, 1298, 1423, 596, 819, 1244, 547, 547, 1256, 716,
-	1289, 547, 547, 547, 970, 547, 547, 1256, 843, 772,
-	773, 547, 547, 898, 783, 780, 1295, 1296, 382, 1195,
-	877, 786, 341, 340, 343, 344, 345, 346, 1295, 1294,
-	838, 342, 347, 81, 1127, 797, 915, 918, 919, 920,
-	916, 1342, 917, 921, 840, 1127, 1260, 1261, 635, 636,
-	637, 638, 639, 640, 641, 642, 1067, 576, 836, 821,
-	910, 576, 877, 576, 715, 714, 81, 81, 884, 887,
-	1208, 1426, 909, 264, 895, 828, 910, 910, 54, 576,
-	24, 264, 264, 24, 51, 264, 264, 1297, 1256, 264,
-	264, 264, 81, 879, 869, 870, 58, 910, 933, 647,
-	695, 1139, 942, 696, 1097, 81, 1091, 24, 1098, 1090,
-	903, 934, 891, 1067, 1067, 936, 610, 609, 619, 620,
-	612, 613, 614, 615, 616, 617, 618, 611, 54, 839,
-	621, 54, 1504, 695, 701, 1396, 1067, 815, 781, 984,
-	985, 986, 925, 791, 696, 697, 690, 699, 1487, 393,
-	690, 393, 940, 393, 393, 54, 393, 941, 393, 264,
-	81, 932, 81, 937, 393, 908, 264, 264, 264, 264,
-	264, 957, 264, 264, 305, 1379, 264, 81, 935, 998,
-	1002, 977, 1004, 1354, 997, 1285, 697, 1380, 695, 1260,
-	1261, 1500, 1142, 264, 599, 264, 264, 1031, 993, 988,
-	264, 987, 1000, 1287, 994, 995, 609, 619, 620, 612,
-	613, 614, 615, 616, 617, 618, 611, 314, 1263, 621,
-	827, 547, 54, 547, 1244, 1169, 809, 785, 1266, 391,
-	1114, 978, 979, 980, 981, 1115, 1112, 1116, 547, 919,
-	920, 1113, 954, 1043, 1265, 1111, 1110, 989, 990, 991,
-	843, 1001, 309, 310, 1491, 1484, 1204, 840, 1021, 1022,
-	1023, 1024, 1025, 1040, 1028, 1029, 393, 1489, 1030, 1045,
-	1044, 1050, 710, 590, 619, 620, 612, 613, 614, 615,
-	616, 617, 618, 611, 1049, 1032, 621, 1057, 588, 1160,
-	713, 1056, 1039, 545, 578, 1152, 327, 1461, 1460, 1394,
-	1150, 264, 264, 264, 264, 264, 579, 1144, 874, 1340,
-	1375, 1003, 575, 264, 784, 923, 264, 306, 307, 590,
-	264, 300, 1432, 841, 264, 1104, 850, 851, 852, 853,
-	854, 855, 856, 857, 858, 859, 860, 861, 862, 863,
-	864, 1084, 839, 81, 301, 58, 1048, 1431, 1106, 1107,
-	1128, 1109, 1099, 1140, 1047, 1382, 1127, 568, 1100, 1101,
-	1079, 1076, 690, 690, 690, 690, 690, 802, 1129, 1117,
-	1130, 879, 1105, 592, 1125, 1108, 1506, 925, 1442, 1124,
-	1368, 899, 1132, 1506, 1505, 690, 1149, 810, 1157, 1158,
-	60, 81, 81, 915, 918, 919, 920, 916, 62, 917,
-	921, 393, 55, 1145, 1146, 1, 1498, 1308, 393, 1376,
-	1009, 1462, 1171, 1408, 1279, 960, 951, 69, 515, 68,
-	1453, 81, 959, 958, 393, 393, 1166, 1416, 1366, 393,
-	393, 393, 971, 393, 393, 1154, 974, 264, 1286, 393,
-	393, 1200, 1186, 1151, 1458, 721, 81, 719, 720, 1172,
-	718, 723, 1159, 547, 1161, 1162, 1163, 722, 717, 277,
-	386, 922, 709, 999, 593, 1201, 72, 1188, 823, 1187,
-	1005, 807, 562, 563, 279, 629, 1046, 1133, 599, 392,
-	954, 393, 547, 1251, 816, 582, 1430, 1381, 1211, 1212,
-	1083, 81, 81, 1245, 656, 892, 326, 831, 339, 1230,
-	1236, 1229, 1248, 1217, 336, 337, 822, 1096, 603, 324,
-	316, 688, 681, 1104, 914, 81, 912, 1043, 580, 584,
-	911, 381, 871, 1262, 1258, 687, 1207, 1335, 1437, 1206,
-	81, 840, 81, 81, 1264, 602, 1255, 826, 896, 26,
-	59, 311, 1278, 19, 1271, 18, 1250, 17, 1270, 20,
-	1249, 16, 51, 15, 14, 900, 901, 533, 30, 21,
-	264, 1277, 1273, 1339, 1282, 1283, 1284, 1058, 1059, 1060,
-	646, 13, 12, 11, 10, 9, 8, 7, 264, 657,
-	6, 393, 5, 1210, 81, 4, 302, 81, 81, 81,
-	264, 23, 2, 0, 393, 0, 81, 0, 0, 264,
-	820, 610, 609, 619, 620, 612, 613, 614, 615, 616,
-	617, 618, 611, 0, 0, 621, 1240, 1301, 0, 631,
-	1314, 0, 0, 0, 0, 1316, 0, 1290, 1291, 0,
-	1302, 0, 1304, 0, 0, 0, 0, 1323, 0, 0,
-	0, 1315, 0, 0, 0, 0, 0, 0, 0, 393,
-	0, 393, 1300, 0, 0, 0, 0, 1341, 876, 878,
-	690, 0, 0, 0, 0, 0, 393, 954, 81, 954,
-	1303, 1351, 1104, 1350, 0, 689, 81, 0, 1140, 0,
-	1360, 0, 1313, 0, 0, 0, 0, 1334, 0, 0,
-	0, 81, 0, 0, 393, 0, 0, 0, 81, 0,
-	0, 0, 0, 0, 1369, 0, 1371, 0, 0, 0,
-	0, 1374, 0, 0, 0, 0, 0, 0, 0, 1356,
-	1357, 1358, 0, 0, 0, 0, 1320, 1321, 0, 1322,
-	0, 1383, 1324, 1210, 1326, 0, 0, 81, 81, 0,
-	81, 0, 0, 0, 0, 81, 1248, 81, 81, 81,
-	264, 0, 547, 81, 1403, 1395, 1404, 1405, 1406, 1402,
-	0, 0, 0, 0, 0, 1413, 0, 1407, 0, 0,
-	81, 264, 0, 0, 0, 1420, 0, 0, 0, 1428,
-	0, 0, 1214, 1215, 0, 0, 806, 0, 1363, 896,
-	0, 1397, 0, 0, 1249, 1231, 1232, 1398, 1233, 1234,
-	1248, 1443, 0, 0, 0, 954, 81, 0, 0, 1450,
-	1241, 1242, 829, 830, 1451, 0, 0, 81, 81, 0,
-	0, 0, 0, 0, 0, 1465, 0, 1425, 1466, 0,
-	1469, 0, 393, 0, 0, 1378, 81, 0, 1474, 1421,
-	0, 1422, 0, 0, 1444, 0, 0, 264, 1249, 0,
-	51, 0, 0, 0, 0, 81, 0, 0, 1104, 0,
-	0, 0, 0, 1429, 1483, 646, 0, 0, 882, 883,
-	0, 0, 1288, 0, 0, 0, 1488, 1490, 81, 0,
-	1170, 393, 1064, 0, 0, 0, 1065, 1492, 0, 0,
-	0, 1503, 0, 1069, 1070, 1071, 0, 0, 1514, 0,
-	1077, 844, 0, 1080, 1081, 0, 0, 0, 0, 1087,
-	393, 581, 0, 1089, 0, 0, 1092, 1093, 1094, 1095,
-	0, 0, 0, 0, 0, 0, 948, 0, 0, 0,
-	0, 0, 0, 1318, 0, 393, 0, 0, 1119, 1478,
-	0, 0, 0, 0, 0, 274, 0, 262, 1501, 0,
-	288, 0, 0, 0, 1378, 954, 0, 880, 881, 0,
-	0, 886, 889, 890, 0, 0, 0, 0, 393, 284,
-	0, 0, 0, 0, 0, 315, 0, 896, 384, 0,
-	1252, 1254, 0, 262, 0, 262, 902, 689, 904, 905,
-	0, 689, 0, 0, 0, 689, 0, 0, 0, 0,
-	0, 0, 0, 0, 1254, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
-	267, 393, 1281, 0, 0, 0, 0, 270, 0, 0,
-	1041, 1042, 0, 584, 0, 278, 273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1385, 1386, 1387, 1388, 1389, 0, 0, 0, 1392, 1393,
-	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
-	0, 0, 0, 1305, 283, 0, 1310, 1311, 1312, 0,
-	1216, 0, 0, 0, 0, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1068, 0, 605, 0, 608,
-	0, 268, 0, 0, 0, 622, 623, 624, 625, 626,
-	627, 628, 1086, 606, 607, 604, 610, 609, 619, 620,
-	612, 613, 614, 615, 616, 617, 618, 611, 280, 271,
-	621, 281, 282, 287, 0, 1051, 896, 272, 275, 0,
-	269, 286, 285, 844, 0, 0, 0, 0, 0, 0,
-	1338, 0, 0, 0, 0, 0, 0, 393, 0, 0,
-	0, 0, 0, 262, 0, 1365, 262, 0, 0, 0,
-	0, 0, 262, 0, 0, 0, 0, 0, 262, 0,
-	393, 0, 1066, 0, 0, 0, 0, 393, 610, 609,
-	619, 620, 612, 613, 614, 615, 616, 617, 618, 611,
-	1082, 0, 621, 0, 0, 0, 0, 689, 689, 689,
-	689, 689, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1507, 689, 0, 0, 1317, 1399, 1400, 0, 1401,
-	689, 0, 1319, 0, 1365, 0, 1365, 1365, 1365, 0,
-	0, 0, 1281, 1328, 1329, 0, 0, 0, 0, 0,
-	0, 0, 1333, 1203, 0, 0, 0, 0, 0, 1365,
-	0, 0, 0, 1343, 1344, 1345, 0, 1348, 0, 0,
-	0, 0, 0, 24, 25, 52, 27, 28, 1332, 0,
-	0, 0, 0, 0, 1359, 0, 0, 0, 0, 262,
-	262, 262, 43, 0, 0, 1457, 0, 29, 48, 49,
-	0, 0, 0, 1238, 0, 0, 393, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 38, 0, 0,
-	0, 54, 896, 0, 0, 1476, 610, 609, 619, 620,
-	612, 613, 614, 615, 616, 617, 618, 611, 0, 0,
-	621, 0, 0, 0, 1482, 1272, 0, 0, 0, 1390,
-	0, 0, 610, 609, 619, 620, 612, 613, 614, 615,
-	616, 617, 618, 611, 0, 0, 621, 1365, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 31, 32, 34, 33, 36, 0, 50, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1433,
-	1434, 1435, 1436, 0, 0, 0, 1440, 1441, 1331, 0,
-	37, 44, 45, 0, 0, 46, 47, 35, 1447, 1448,
-	1449, 0, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 39, 40, 0, 41, 42, 0, 0, 262, 262,
-	0, 0, 0, 0, 262, 0, 0, 262, 0, 1330,
-	262, 1470, 0, 0, 790, 0, 0, 1337, 1475, 0,
-	0, 0, 0, 0, 262, 0, 0, 646, 0, 0,
-	0, 0, 0, 0, 0, 1352, 1480, 0, 1353, 0,
-	0, 1355, 610, 609, 619, 620, 612, 613, 614, 615,
-	616, 617, 618, 611, 0, 0, 621, 0, 0, 0,
-	0, 0, 0, 0, 262, 689, 0, 0, 0, 0,
-	0, 0, 0, 790, 0, 0, 53, 0, 1213, 0,
-	0, 1515, 1516, 610, 609, 619, 620, 612, 613, 614,
-	615, 616, 617, 618, 611, 0, 0, 621, 610, 609,
-	619, 620, 612, 613, 614, 615, 616, 617, 618, 611,
-	0, 1063, 621, 0, 0, 315, 0, 0, 0, 0,
-	315, 315, 0, 0, 315, 315, 315, 0, 0, 0,
-	897, 610, 609, 619, 620, 612, 613, 614, 615, 616,
-	617, 618, 611, 0, 0, 621, 0, 0, 0, 315,
-	315, 315, 315, 0, 262, 0, 0, 0, 0, 0,
-	0, 0, 262, 930, 0, 0, 262, 262, 0, 0,
-	262, 938, 790, 610, 609, 619, 620, 612, 613, 614,
-	615, 616, 617, 618, 611, 0, 0, 621, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1468,
-	646, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	262, 0, 0, 0, 0, 0, 0, 262, 262, 262,
-	262, 262, 0, 262, 262, 0, 0, 262, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 738, 0, 0, 262, 0, 1036, 1037, 0, 0,
-	0, 262, 0, 0, 0, 0, 790, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 726,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 897, 262, 262, 262, 262, 262, 739, 0, 0,
-	0, 0, 0, 0, 1118, 0, 0, 262, 0, 0,
-	0, 930, 0, 0, 0, 262, 0, 0, 0, 0,
-	752, 755, 756, 757, 758, 759, 760, 0, 761, 762,
-	763, 764, 765, 740, 741, 742, 743, 724, 725, 753,
-	0, 727, 0, 728, 729, 730, 731, 732, 733, 734,
-	735, 736, 737, 744, 745, 746, 747, 748, 749, 750,
-	751, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 754, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
+	316, 1562, 1552, 1519, 1327, 1489, 644, 1203, 1402, 1111,
+	1436, 320, 1058, 1129, 1267, 1301, 333, 925, 1268, 346,
+	1112, 952, 1032, 995, 1235, 299, 948, 923, 1264, 951,
+	573, 80, 322, 1070, 961, 265, 1274, 390, 265, 1280,
+	1156, 789, 1239, 874, 1182, 291, 810, 871, 1173, 815,
+	643, 3, 965, 927, 705, 912, 686, 892, 841, 864,
+	586, 704, 582, 803, 991, 515, 265, 80, 685, 384,
+	905, 265, 61, 265, 821, 379, 595, 376, 381, 694,
+	59, 1539, 1524, 981, 658, 1525, 309, 1135, 1537, 292,
+	293, 294, 295, 1524, 50, 298, 1525, 305, 63, 64,
+	65, 66, 659, 1236, 1535, 307, 1538, 306, 1555, 1520,
+	1014, 1529, 975, 1536, 1499, 1500, 52, 52, 1550, 1505,
+	1546, 1328, 52, 1528, 1013, 303, 1504, 1256, 1362, 520,
+	1295, 533, 358, 1526, 364, 365, 362, 363, 361, 360,
+	359, 1106, 548, 942, 1526, 1107, 1296, 1297, 366, 367,
+	1423, 706, 1018, 707, 52, 24, 54, 26, 27, 943,
+	944, 1012, 297, 296, 57, 57, 260, 256, 257, 258,
+	57, 1144, 1164, 42, 1143, 569, 974, 1145, 28, 47,
+	48, 564, 252, 1205, 254, 565, 562, 563, 1392, 1411,
+	982, 1353, 1351, 546, 290, 778, 557, 558, 37, 567,
+	1207, 775, 57, 777, 1548, 1543, 1490, 1409, 550, 1483,
+	552, 1009, 1006, 1007, 1202, 1005, 1465, 609, 608, 618,
+	619, 611, 612, 613, 614, 615, 616, 617, 610, 968,
+	906, 620, 966, 1570, 568, 779, 1206, 534, 776, 1437,
+	522, 549, 551, 1208, 1199, 1130, 1132, 1016, 1019, 1566,
+	1201, 782, 1439, 265, 254, 768, 265, 1290, 516, 1289,
+	387, 1288, 265, 30, 31, 33, 32, 35, 265, 49,
+	968, 80, 968, 80, 518, 80, 80, 253, 80, 1240,
+	80, 525, 267, 255, 1011, 259, 80, 897, 1472, 1521,
+	1373, 36, 43, 44, 1230, 530, 45, 46, 34, 1444,
+	1521, 1157, 1087, 1140, 1026, 265, 1010, 1025, 1097, 1084,
+	80, 1063, 38, 39, 839, 40, 41, 1242, 1190, 700,
+	1438, 982, 1131, 632, 633, 967, 547, 599, 540, 1313,
+	964, 962, 949, 963, 620, 738, 938, 1503, 610, 960,
+	966, 620, 571, 572, 1040, 1015, 1200, 1188, 1198, 804,
+	630, 1244, 808, 1248, 1034, 1243, 1564, 1241, 527, 1565,
+	528, 1563, 1246, 529, 1017, 516, 967, 69, 967, 594,
+	1522, 1245, 53, 53, 265, 265, 265, 1481, 53, 848,
+	1314, 1522, 1453, 80, 1247, 1249, 1466, 1083, 1278, 80,
+	536, 537, 538, 846, 847, 845, 577, 55, 514, 600,
+	1445, 1443, 684, 70, 632, 633, 708, 689, 592, 1258,
+	53, 632, 633, 726, 1189, 893, 1082, 1094, 1081, 1194,
+	1191, 1184, 1192, 1187, 594, 1183, 893, 971, 1185, 1186,
+	805, 770, 1033, 972, 645, 593, 592, 593, 592, 593,
+	592, 1544, 1193, 656, 661, 663, 665, 667, 669, 671,
+	672, 739, 594, 693, 594, 1571, 594, 698, 830, 832,
+	833, 702, 662, 664, 831, 668, 670, 1162, 673, 521,
+	1045, 1046, 1485, 680, 752, 755, 756, 757, 758, 759,
+	760, 1509, 761, 762, 763, 764, 765, 740, 741, 742,
+	743, 724, 725, 753, 1572, 727, 251, 728, 729, 730,
+	731, 732, 733, 734, 735, 736, 737, 744, 745, 746,
+	747, 748, 749, 750, 751, 265, 593, 592, 593, 592,
+	80, 1398, 57, 1260, 1397, 265, 265, 80, 1060, 1061,
+	1062, 265, 844, 594, 265, 594, 1177, 265, 1042, 1176,
+	865, 265, 866, 80, 80, 523, 524, 1165, 80, 80,
+	80, 265, 80, 80, 1146, 1511, 1147, 1482, 80, 80,
+	373, 374, 1418, 1395, 1211, 754, 1174, 611, 612, 613,
+	614, 615, 616, 617, 610, 1041, 387, 620, 791, 613,
+	614, 615, 616, 617, 610, 1037, 869, 620, 345, 868,
+	1479, 265, 593, 592, 588, 1226, 1547, 80, 1513, 589,
+	1226, 1493, 817, 1226, 589, 1226, 1473, 1226, 1441, 594,
+	1330, 783, 1388, 1387, 914, 917, 918, 919, 915, 78,
+	916, 920, 1157, 842, 1281, 1282, 1375, 589, 696, 818,
+	1372, 589, 1320, 1319, 1316, 1317, 843, 838, 1316, 1315,
+	696, 836, 80, 1076, 589, 1339, 1152, 909, 589, 876,
+	589, 589, 819, 806, 867, 389, 788, 787, 771, 769,
+	766, 813, 816, 883, 886, 715, 714, 876, 542, 894,
+	697, 535, 699, 834, 1450, 80, 80, 1449, 1265, 827,
+	828, 1277, 697, 265, 695, 1136, 1310, 1136, 969, 1076,
+	1277, 265, 265, 1368, 300, 265, 265, 878, 909, 265,
+	265, 265, 80, 608, 618, 619, 611, 612, 613, 614,
+	615, 616, 617, 610, 908, 80, 620, 347, 56, 932,
+	1452, 695, 933, 689, 1318, 902, 935, 689, 909, 890,
+	1277, 689, 1148, 645, 1076, 941, 881, 882, 791, 909,
+	1100, 56, 609, 608, 618, 619, 611, 612, 613, 614,
+	615, 616, 617, 610, 1099, 1076, 620, 1043, 695, 701,
+	823, 781, 57, 931, 579, 940, 1530, 1404, 976, 265,
+	80, 939, 80, 1380, 56, 936, 265, 265, 265, 265,
+	265, 956, 265, 265, 52, 996, 265, 80, 873, 997,
+	1306, 1281, 1282, 837, 1151, 947, 1071, 992, 987, 986,
+	1204, 1405, 999, 265, 1557, 265, 265, 1553, 1308, 1284,
+	265, 1265, 57, 983, 984, 985, 914, 917, 918, 919,
+	915, 80, 916, 920, 265, 1178, 80, 993, 994, 809,
+	1123, 785, 57, 1213, 1055, 1124, 1121, 977, 978, 979,
+	980, 1122, 1125, 1287, 918, 919, 1286, 1120, 1047, 1119,
+	583, 584, 1541, 988, 989, 990, 1527, 1336, 822, 389,
+	1532, 389, 1222, 389, 389, 842, 389, 1221, 389, 1169,
+	713, 811, 543, 820, 389, 1161, 1487, 838, 843, 1486,
+	1366, 1065, 1049, 812, 1056, 618, 619, 611, 612, 613,
+	614, 615, 616, 617, 610, 387, 1421, 620, 597, 1159,
+	1153, 1400, 22, 1002, 1066, 784, 1057, 922, 953, 822,
+	265, 265, 265, 265, 265, 580, 581, 574, 1496, 1220,
+	1113, 1459, 265, 575, 300, 265, 60, 1219, 1495, 265,
+	1457, 875, 877, 265, 1136, 566, 1559, 1558, 302, 1088,
+	1085, 802, 689, 689, 689, 689, 689, 590, 1559, 1093,
+	1469, 1393, 80, 1039, 62, 58, 1108, 689, 1, 1551,
+	1137, 1329, 1401, 1077, 1359, 689, 1008, 1115, 1116, 1488,
+	1118, 389, 1435, 1149, 1300, 878, 1126, 710, 1114, 959,
+	1095, 1117, 1134, 950, 68, 513, 67, 313, 545, 1480,
+	545, 958, 545, 545, 957, 545, 1141, 545, 1442, 1391,
+	80, 80, 970, 545, 1163, 973, 1158, 1307, 1168, 1160,
+	1170, 1171, 1172, 1484, 721, 719, 1154, 1155, 720, 578,
+	718, 1138, 723, 1139, 722, 717, 278, 382, 921, 709,
+	80, 998, 629, 837, 591, 631, 1175, 71, 609, 608,
+	618, 619, 611, 612, 613, 614, 615, 616, 617, 610,
+	1181, 1195, 620, 1197, 1196, 1004, 807, 560, 561, 280,
+	628, 1166, 1167, 642, 80, 646, 647, 648, 649, 650,
+	651, 652, 653, 654, 1218, 657, 660, 660, 660, 666,
+	660, 660, 666, 660, 674, 675, 676, 677, 678, 679,
+	1224, 1142, 690, 1216, 1217, 1210, 388, 1272, 1044, 814,
+	1494, 1231, 1456, 1092, 655, 891, 1048, 321, 389, 829,
+	80, 80, 1229, 334, 331, 389, 1266, 1257, 1113, 332,
+	1238, 1212, 1050, 318, 1105, 602, 1251, 1269, 1214, 1215,
+	816, 389, 389, 319, 80, 838, 389, 389, 389, 1065,
+	389, 389, 1250, 311, 688, 953, 389, 389, 681, 80,
+	913, 80, 80, 911, 910, 1073, 377, 1285, 1283, 1074,
+	1271, 1292, 1279, 687, 1338, 1361, 1078, 1079, 1080, 1464,
+	1291, 1054, 1299, 1086, 25, 301, 1089, 1090, 1298, 265,
+	372, 1259, 1096, 19, 18, 597, 1098, 1303, 389, 1101,
+	1102, 1103, 1104, 1311, 1312, 1304, 1305, 265, 17, 1276,
+	20, 16, 15, 80, 14, 531, 80, 80, 80, 265,
+	29, 1128, 21, 13, 12, 11, 10, 9, 8, 265,
+	7, 6, 5, 1293, 4, 1294, 1523, 80, 1322, 1498,
+	870, 80, 1497, 1335, 1408, 824, 304, 545, 585, 23,
+	576, 1323, 51, 1325, 545, 2, 895, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1228, 0, 0,
+	545, 545, 1344, 899, 900, 545, 545, 545, 1349, 545,
+	545, 0, 0, 0, 0, 545, 545, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1113,
+	389, 1261, 0, 0, 0, 1367, 56, 0, 1377, 80,
+	0, 0, 1376, 389, 0, 825, 0, 80, 0, 0,
+	0, 634, 635, 636, 637, 638, 639, 640, 641, 1390,
+	1149, 0, 80, 1386, 0, 0, 0, 0, 0, 80,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	265, 0, 953, 0, 953, 0, 1225, 1363, 0, 56,
+	0, 0, 0, 1346, 1347, 0, 1348, 645, 389, 1350,
+	389, 1352, 0, 1237, 646, 1378, 0, 1407, 1379, 0,
+	0, 1381, 689, 80, 80, 389, 80, 0, 0, 0,
+	0, 80, 0, 80, 80, 80, 265, 1422, 1269, 80,
+	0, 0, 0, 1430, 0, 1431, 1432, 1433, 691, 1394,
+	0, 1396, 0, 1434, 1440, 80, 265, 1429, 924, 1051,
+	1446, 0, 690, 0, 1059, 1389, 690, 1454, 0, 0,
+	0, 1447, 1424, 1448, 1228, 0, 1406, 0, 389, 0,
+	0, 1410, 0, 262, 1458, 0, 879, 880, 1470, 0,
+	885, 888, 889, 1269, 0, 0, 1478, 0, 1477, 0,
+	0, 0, 0, 80, 80, 0, 0, 0, 0, 0,
+	0, 1491, 0, 0, 378, 901, 1501, 903, 904, 517,
+	0, 519, 0, 80, 0, 1492, 1471, 0, 0, 1506,
+	0, 1113, 0, 0, 265, 544, 0, 545, 0, 545,
+	0, 80, 953, 0, 0, 0, 0, 895, 0, 0,
+	0, 1515, 0, 1517, 545, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1341, 0, 1531,
+	1533, 0, 1403, 0, 1534, 0, 589, 1345, 0, 0,
+	0, 80, 0, 0, 0, 0, 0, 0, 1354, 1355,
+	389, 80, 0, 1542, 0, 0, 645, 0, 1549, 0,
+	0, 0, 0, 0, 1556, 1064, 0, 0, 1369, 1370,
+	1371, 1567, 1374, 609, 608, 618, 619, 611, 612, 613,
+	614, 615, 616, 617, 610, 0, 0, 620, 0, 1385,
+	0, 0, 1516, 645, 0, 0, 0, 0, 1179, 389,
+	840, 0, 0, 849, 850, 851, 852, 853, 854, 855,
+	856, 857, 858, 859, 860, 861, 862, 863, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 389, 0,
+	0, 601, 0, 1109, 1110, 0, 0, 690, 690, 690,
+	690, 690, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 924, 0, 1133, 0, 1403, 953, 898, 1417,
+	690, 526, 389, 0, 532, 0, 263, 0, 0, 289,
+	539, 1075, 0, 0, 0, 0, 541, 609, 608, 618,
+	619, 611, 612, 613, 614, 615, 616, 617, 610, 1091,
+	0, 620, 0, 310, 0, 0, 389, 380, 0, 0,
+	0, 0, 263, 0, 263, 895, 0, 0, 1273, 1275,
+	1460, 1461, 1462, 1463, 1232, 0, 0, 1467, 1468, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 545, 1474,
+	1475, 1476, 1275, 0, 609, 608, 618, 619, 611, 612,
+	613, 614, 615, 616, 617, 610, 0, 389, 620, 389,
+	1302, 0, 0, 0, 0, 0, 0, 545, 0, 0,
+	0, 0, 1502, 0, 0, 275, 0, 0, 553, 1507,
+	554, 555, 1365, 556, 0, 559, 0, 0, 0, 0,
+	0, 570, 683, 0, 692, 631, 1512, 0, 0, 285,
+	0, 0, 0, 0, 0, 1518, 0, 0, 0, 0,
+	0, 1326, 0, 0, 1331, 1332, 1333, 0, 0, 0,
+	609, 608, 618, 619, 611, 612, 613, 614, 615, 616,
+	617, 610, 1364, 0, 620, 1340, 0, 0, 0, 389,
+	0, 0, 0, 0, 1358, 1270, 0, 56, 0, 0,
+	268, 0, 0, 0, 1067, 1068, 1069, 271, 0, 0,
+	0, 0, 0, 1223, 0, 279, 274, 0, 1568, 1569,
+	609, 608, 618, 619, 611, 612, 613, 614, 615, 616,
+	617, 610, 0, 0, 620, 0, 895, 0, 0, 0,
+	0, 0, 0, 0, 263, 0, 0, 263, 277, 0,
+	0, 0, 0, 263, 284, 0, 0, 389, 0, 263,
+	0, 0, 0, 0, 0, 1059, 0, 0, 609, 608,
+	618, 619, 611, 612, 613, 614, 615, 616, 617, 610,
+	389, 269, 620, 716, 0, 0, 0, 389, 0, 0,
+	0, 0, 0, 772, 773, 0, 587, 0, 0, 780,
+	0, 0, 378, 0, 0, 786, 0, 0, 281, 272,
+	0, 282, 283, 288, 0, 0, 0, 273, 276, 797,
+	270, 287, 286, 0, 1343, 0, 0, 0, 0, 0,
+	0, 1426, 1427, 0, 1428, 0, 0, 0, 0, 1059,
+	0, 1059, 1059, 1059, 1360, 0, 0, 1302, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 826,
+	0, 0, 0, 1059, 0, 263, 263, 263, 0, 0,
+	0, 0, 0, 0, 0, 767, 1382, 1383, 1384, 0,
+	0, 0, 774, 0, 0, 0, 0, 0, 0, 0,
+	0, 1357, 0, 0, 0, 0, 0, 0, 792, 793,
+	0, 0, 0, 794, 795, 796, 0, 798, 799, 545,
+	0, 389, 389, 800, 801, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 690, 895, 1233,
+	1234, 1508, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1252, 1253, 0, 1254, 1255, 0, 0, 1514,
+	0, 907, 0, 0, 0, 0, 1270, 1262, 1263, 1425,
+	0, 0, 0, 0, 934, 609, 608, 618, 619, 611,
+	612, 613, 614, 615, 616, 617, 610, 0, 0, 620,
+	0, 1356, 0, 0, 0, 0, 0, 0, 1451, 1059,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1545,
+	0, 0, 0, 0, 0, 0, 263, 0, 0, 0,
+	0, 1270, 0, 56, 0, 0, 263, 263, 0, 1309,
+	0, 0, 263, 0, 0, 263, 0, 0, 263, 0,
+	0, 0, 790, 0, 0, 0, 0, 1000, 0, 0,
+	0, 0, 263, 0, 1020, 1021, 1022, 1023, 1024, 0,
+	1027, 1028, 0, 0, 1029, 609, 608, 618, 619, 611,
+	612, 613, 614, 615, 616, 617, 610, 0, 0, 620,
+	0, 1031, 0, 0, 0, 0, 0, 0, 1038, 0,
+	0, 0, 263, 0, 604, 0, 607, 0, 0, 0,
+	1342, 790, 621, 622, 623, 624, 625, 626, 627, 0,
+	605, 606, 603, 609, 608, 618, 619, 611, 612, 613,
+	614, 615, 616, 617, 610, 0, 0, 620, 0, 0,
+	0, 0, 0, 0, 0, 1001, 0, 1003, 0, 0,
+	1072, 0, 0, 0, 310, 0, 1554, 0, 0, 310,
+	310, 0, 1030, 310, 310, 310, 0, 0, 0, 896,
+	609, 608, 618, 619, 611, 612, 613, 614, 615, 616,
+	617, 610, 0, 0, 620, 0, 0, 0, 310, 310,
+	310, 310, 0, 0, 263, 0, 0, 0, 0, 0,
+	0, 0, 263, 929, 0, 0, 263, 263, 0, 0,
+	263, 937, 790, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1412, 1413, 1414, 1415, 1416, 0,
+	0, 0, 1419, 1420, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 0, 0, 0, 0, 0, 263, 263, 263,
+	263, 263, 0, 263, 263, 0, 0, 263, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 263, 0, 1035, 1036, 0, 0,
+	0, 263, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 587, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 790, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	790, 0, 0, 0, 0, 0, 0, 0, 0, 897,
+	0, 0, 0, 0, 0, 0, 1180, 0, 0, 0,
+	0, 0, 0, 0, 310, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 310, 0, 1540, 1209, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	896, 263, 263, 263, 263, 263, 0, 0, 0, 0,
+	0, 0, 1560, 1127, 0, 0, 263, 0, 0, 0,
+	929, 0, 0, 0, 263, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1321, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1324, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1334, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1337, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 262, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 262, 0, 0, 0, 0, 0, 0, 0, 0,
-	262, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 310, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 310, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 310, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 790,
+	0, 0, 0, 0, 0, 0, 0, 0, 896, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 897, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 0, 0, 1455, 0, 0, 1399, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 930, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1510, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 896,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 262, 0, 0, 0, 0, 0, 502, 490,
-	0, 447, 505, 421, 437, 513, 438, 441, 478, 406,
-	460, 165, 435, 0, 425, 401, 431, 402, 423, 449,
-	111, 453, 420, 492, 463, 504, 137, 511, 139, 469,
-	0, 211, 153, 0, 0, 451, 494, 458, 487, 446,
-	479, 411, 468, 506, 436, 476, 507, 0, 0, 0,
-	80, 0, 955, 956, 897, 0, 0, 0, 0, 101,
-	0, 473, 501, 433, 475, 477, 400, 470, 262, 404,
-	407, 512, 497, 428, 429, 1141, 0, 0, 0, 0,
-	0, 0, 450, 459, 484, 444, 0, 0, 0, 0,
-	0, 0, 0, 0, 426, 0, 467, 0, 0, 0,
-	408, 405, 0, 0, 448, 0, 0, 0, 410, 0,
-	427, 485, 0, 398, 119, 489, 496, 445, 265, 500,
-	443, 442, 503, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 493, 424, 432, 105,
-	430, 193, 172, 231, 466, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 403, 0, 212, 234, 249, 99,
-	419, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 415, 418, 413, 414, 461, 462, 508,
-	509, 510, 486, 409, 0, 416, 417, 0, 491, 498,
-	499, 465, 82, 91, 138, 246, 186, 116, 235, 399,
-	412, 109, 422, 0, 0, 434, 439, 440, 452, 454,
-	455, 456, 457, 464, 471, 472, 474, 480, 481, 482,
-	483, 488, 495, 514, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	502, 490, 0, 447, 505, 421, 437, 513, 438, 441,
-	478, 406, 460, 165, 435, 0, 425, 401, 431, 402,
-	423, 449, 111, 453, 420, 492, 463, 504, 137, 511,
-	139, 469, 0, 211, 153, 0, 0, 451, 494, 458,
-	487, 446, 479, 411, 468, 506, 436, 476, 507, 0,
-	0, 0, 80, 0, 955, 956, 0, 0, 0, 0,
-	0, 101, 0, 473, 501, 433, 475, 477, 400, 470,
-	0, 404, 407, 512, 497, 428, 429, 0, 0, 0,
-	0, 0, 0, 0, 450, 459, 484, 444, 0, 0,
-	0, 0, 0, 0, 0, 0, 426, 0, 467, 0,
-	0, 0, 408, 405, 0, 0, 448, 0, 0, 0,
-	410, 0, 427, 485, 0, 398, 119, 489, 496, 445,
-	265, 500, 443, 442, 503, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 493, 424,
-	432, 105, 430, 193, 172, 231, 466, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 403, 0, 212, 234,
-	249, 99, 419, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 415, 418, 413, 414, 461,
-	462, 508, 509, 510, 486, 409, 0, 416, 417, 0,
-	491, 498, 499, 465, 82, 91, 138, 246, 186, 116,
-	235, 399, 412, 109, 422, 0, 0, 434, 439, 440,
-	452, 454, 455, 456, 457, 464, 471, 472, 474, 480,
-	481, 482, 483, 488, 495, 514, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 502, 490, 0, 447, 505, 421, 437, 513,
-	438, 441, 478, 406, 460, 165, 435, 0, 425, 401,
-	431, 402, 423, 449, 111, 453, 420, 492, 463, 504,
-	137, 511, 139, 469, 0, 211, 153, 0, 0, 451,
-	494, 458, 487, 446, 479, 411, 468, 506, 436, 476,
-	507, 54, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 473, 501, 433, 475, 477,
-	400, 470, 0, 404, 407, 512, 497, 428, 429, 0,
-	0, 0, 0, 0, 0, 0, 450, 459, 484, 444,
-	0, 0, 0, 0, 0, 0, 0, 0, 426, 0,
-	467, 0, 0, 0, 408, 405, 0, 0, 448, 0,
-	0, 0, 410, 0, 427, 485, 0, 398, 119, 489,
-	496, 445, 265, 500, 443, 442, 503, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	493, 424, 432, 105, 430, 193, 172, 231, 466, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 403, 0,
-	212, 234, 249, 99, 419, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 415, 418, 413,
-	414, 461, 462, 508, 509, 510, 486, 409, 0, 416,
-	417, 0, 491, 498, 499, 465, 82, 91, 138, 246,
-	186, 116, 235, 399, 412, 109, 422, 0, 0, 434,
-	439, 440, 452, 454, 455, 456, 457, 464, 471, 472,
-	474, 480, 481, 482, 483, 488, 495, 514, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 263, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 929, 0, 0,
+	0, 0, 499, 487, 0, 443, 502, 417, 433, 510,
+	434, 437, 474, 402, 456, 165, 431, 263, 421, 397,
+	427, 398, 419, 445, 111, 449, 416, 489, 459, 501,
+	137, 508, 139, 465, 0, 213, 153, 0, 0, 447,
+	491, 454, 484, 442, 475, 407, 464, 503, 432, 472,
+	504, 0, 0, 0, 79, 0, 954, 955, 0, 0,
+	0, 0, 0, 100, 0, 469, 498, 429, 471, 473,
+	396, 466, 0, 400, 403, 509, 494, 424, 425, 1150,
+	0, 896, 0, 0, 0, 0, 446, 455, 481, 440,
+	0, 0, 0, 0, 0, 263, 0, 0, 422, 0,
+	463, 0, 0, 0, 404, 401, 0, 0, 444, 0,
+	0, 0, 406, 0, 423, 482, 0, 394, 119, 486,
+	493, 441, 266, 497, 439, 438, 500, 184, 0, 217,
+	122, 136, 96, 82, 92, 0, 121, 162, 191, 195,
+	490, 420, 428, 105, 426, 193, 172, 233, 462, 174,
+	192, 140, 223, 185, 232, 242, 243, 220, 240, 247,
+	210, 85, 219, 231, 101, 203, 87, 229, 216, 151,
+	131, 132, 86, 0, 189, 110, 117, 107, 164, 226,
+	227, 106, 249, 93, 239, 89, 94, 238, 158, 222,
+	230, 152, 145, 88, 228, 150, 144, 135, 114, 124,
+	182, 142, 183, 125, 155, 154, 156, 0, 399, 0,
+	214, 236, 250, 98, 415, 221, 245, 246, 0, 0,
+	99, 118, 113, 181, 157, 95, 127, 211, 134, 141,
+	188, 248, 171, 194, 102, 235, 212, 411, 414, 409,
+	410, 457, 458, 505, 506, 507, 483, 405, 0, 412,
+	413, 0, 488, 495, 496, 461, 81, 90, 138, 512,
+	186, 116, 205, 478, 104, 204, 237, 395, 408, 109,
+	418, 0, 0, 430, 435, 436, 448, 450, 451, 452,
+	453, 460, 467, 468, 470, 476, 477, 479, 480, 485,
+	492, 511, 83, 84, 91, 97, 103, 108, 112, 115,
+	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
+	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
+	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
+	196, 197, 198, 199, 200, 201, 202, 206, 207, 208,
+	209, 215, 218, 224, 225, 234, 241, 244, 499, 487,
+	0, 443, 502, 417, 433, 510, 434, 437, 474, 402,
+	456, 165, 431, 0, 421, 397, 427, 398, 419, 445,
+	111, 449, 416, 489, 459, 501, 137, 508, 139, 465,
+	0, 213, 153, 0, 0, 447, 491, 454, 484, 442,
+	475, 407, 464, 503, 432, 472, 504, 0, 0, 0,
+	79, 0, 954, 955, 0, 0, 0, 0, 0, 100,
+	0, 469, 498, 429, 471, 473, 396, 466, 0, 400,
+	403, 509, 494, 424, 425, 0, 0, 0, 0, 0,
+	0, 0, 446, 455, 481, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 422, 0, 463, 0, 0, 0,
+	404, 401, 0, 0, 444, 0, 0, 0, 406, 0,
+	423, 482, 0, 394, 119, 486, 493, 441, 266, 497,
+	439, 438, 500, 184, 0, 217, 122, 136, 96, 82,
+	92, 0, 121, 162, 191, 195, 490, 420, 428, 105,
+	426, 193, 172, 233, 462, 174, 192, 140, 223, 185,
+	232, 242, 243, 220, 240, 247, 210, 85, 219, 231,
+	101, 203, 87, 229, 216, 151, 131, 132, 86, 0,
+	189, 110, 117, 107, 164, 226, 227, 106, 249, 93,
+	239, 89, 94, 238, 158, 222, 230, 152, 145, 88,
+	228, 150, 144, 135, 114, 124, 182, 142, 183, 125,
+	155, 154, 156, 0, 399, 0, 214, 236, 250, 98,
+	415, 221, 245, 246, 0, 0, 99, 118, 113, 181,
+	157, 95, 127, 211, 134, 141, 188, 248, 171, 194,
+	102, 235, 212, 411, 414, 409, 410, 457, 458, 505,
+	506, 507, 483, 405, 0, 412, 413, 0, 488, 495,
+	496, 461, 81, 90, 138, 512, 186, 116, 205, 478,
+	104, 204, 237, 395, 408, 109, 418, 0, 0, 430,
+	435, 436, 448, 450, 451, 452, 453, 460, 467, 468,
+	470, 476, 477, 479, 480, 485, 492, 511, 83, 84,
+	91, 97, 103, 108, 112, 115, 120, 123, 126, 128,
 	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
 	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
 	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 502, 490, 0, 447, 505, 421,
-	437, 513, 438, 441, 478, 406, 460, 165, 435, 0,
-	425, 401, 431, 402, 423, 449, 111, 453, 420, 492,
-	463, 504, 137, 511, 139, 469, 0, 211, 153, 0,
-	0, 451, 494, 458, 487, 446, 479, 411, 468, 506,
-	436, 476, 507, 0, 0, 0, 80, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 0, 473, 501, 433,
-	475, 477, 400, 470, 0, 404, 407, 512, 497, 428,
-	429, 0, 0, 0, 0, 0, 0, 0, 450, 459,
-	484, 444, 0, 0, 0, 0, 0, 0, 1209, 0,
-	426, 0, 467, 0, 0, 0, 408, 405, 0, 0,
-	448, 0, 0, 0, 410, 0, 427, 485, 0, 398,
-	119, 489, 496, 445, 265, 500, 443, 442, 503, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 493, 424, 432, 105, 430, 193, 172, 231,
-	466, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
+	200, 201, 202, 206, 207, 208, 209, 215, 218, 224,
+	225, 234, 241, 244, 499, 487, 0, 443, 502, 417,
+	433, 510, 434, 437, 474, 402, 456, 165, 431, 0,
+	421, 397, 427, 398, 419, 445, 111, 449, 416, 489,
+	459, 501, 137, 508, 139, 465, 0, 213, 153, 0,
+	0, 447, 491, 454, 484, 442, 475, 407, 464, 503,
+	432, 472, 504, 57, 0, 0, 79, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 0, 469, 498, 429,
+	471, 473, 396, 466, 0, 400, 403, 509, 494, 424,
+	425, 0, 0, 0, 0, 0, 0, 0, 446, 455,
+	481, 440, 0, 0, 0, 0, 0, 0, 0, 0,
+	422, 0, 463, 0, 0, 0, 404, 401, 0, 0,
+	444, 0, 0, 0, 406, 0, 423, 482, 0, 394,
+	119, 486, 493, 441, 266, 497, 439, 438, 500, 184,
+	0, 217, 122, 136, 96, 82, 92, 0, 121, 162,
+	191, 195, 490, 420, 428, 105, 426, 193, 172, 233,
+	462, 174, 192, 140, 223, 185, 232, 242, 243, 220,
+	240, 247, 210, 85, 219, 231, 101, 203, 87, 229,
+	216, 151, 131, 132, 86, 0, 189, 110, 117, 107,
+	164, 226, 227, 106, 249, 93, 239, 89, 94, 238,
+	158, 222, 230, 152, 145, 88, 228, 150, 144, 135,
 	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	403, 0, 212, 234, 249, 99, 419, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 415,
-	418, 413, 414, 461, 462, 508, 509, 510, 486, 409,
-	0, 416, 417, 0, 491, 498, 499, 465, 82, 91,
-	138, 246, 186, 116, 235, 399, 412, 109, 422, 0,
-	0, 434, 439, 440, 452, 454, 455, 456, 457, 464,
-	471, 472, 474, 480, 481, 482, 483, 488, 495, 514,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
+	399, 0, 214, 236, 250, 98, 415, 221, 245, 246,
+	0, 0, 99, 118, 113, 181, 157, 95, 127, 211,
+	134, 141, 188, 248, 171, 194, 102, 235, 212, 411,
+	414, 409, 410, 457, 458, 505, 506, 507, 483, 405,
+	0, 412, 413, 0, 488, 495, 496, 461, 81, 90,
+	138, 512, 186, 116, 205, 478, 104, 204, 237, 395,
+	408, 109, 418, 0, 0, 430, 435, 436, 448, 450,
+	451, 452, 453, 460, 467, 468, 470, 476, 477, 479,
+	480, 485, 492, 511, 83, 84, 91, 97, 103, 108,
+	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
+	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
+	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
+	187, 190, 196, 197, 198, 199, 200, 201, 202, 206,
+	207, 208, 209, 215, 218, 224, 225, 234, 241, 244,
+	499, 487, 0, 443, 502, 417, 433, 510, 434, 437,
+	474, 402, 456, 165, 431, 0, 421, 397, 427, 398,
+	419, 445, 111, 449, 416, 489, 459, 501, 137, 508,
+	139, 465, 0, 213, 153, 0, 0, 447, 491, 454,
+	484, 442, 475, 407, 464, 503, 432, 472, 504, 0,
+	0, 0, 79, 0, 0, 0, 0, 0, 0, 0,
+	0, 100, 0, 469, 498, 429, 471, 473, 396, 466,
+	0, 400, 403, 509, 494, 424, 425, 0, 0, 0,
+	0, 0, 0, 0, 446, 455, 481, 440, 0, 0,
+	0, 0, 0, 0, 1227, 0, 422, 0, 463, 0,
+	0, 0, 404, 401, 0, 0, 444, 0, 0, 0,
+	406, 0, 423, 482, 0, 394, 119, 486, 493, 441,
+	266, 497, 439, 438, 500, 184, 0, 217, 122, 136,
+	96, 82, 92, 0, 121, 162, 191, 195, 490, 420,
+	428, 105, 426, 193, 172, 233, 462, 174, 192, 140,
+	223, 185, 232, 242, 243, 220, 240, 247, 210, 85,
+	219, 231, 101, 203, 87, 229, 216, 151, 131, 132,
+	86, 0, 189, 110, 117, 107, 164, 226, 227, 106,
+	249, 93, 239, 89, 94, 238, 158, 222, 230, 152,
+	145, 88, 228, 150, 144, 135, 114, 124, 182, 142,
+	183, 125, 155, 154, 156, 0, 399, 0, 214, 236,
+	250, 98, 415, 221, 245, 246, 0, 0, 99, 118,
+	113, 181, 157, 95, 127, 211, 134, 141, 188, 248,
+	171, 194, 102, 235, 212, 411, 414, 409, 410, 457,
+	458, 505, 506, 507, 483, 405, 0, 412, 413, 0,
+	488, 495, 496, 461, 81, 90, 138, 512, 186, 116,
+	205, 478, 104, 204, 237, 395, 408, 109, 418, 0,
+	0, 430, 435, 436, 448, 450, 451, 452, 453, 460,
+	467, 468, 470, 476, 477, 479, 480, 485, 492, 511,
+	83, 84, 91, 97, 103, 108, 112, 115, 120, 123,
 	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
 	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
 	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 502, 490, 0, 447,
-	505, 421, 437, 513, 438, 441, 478, 406, 460, 165,
-	435, 0, 425, 401, 431, 402, 423, 449, 111, 453,
-	420, 492, 463, 504, 137, 511, 139, 469, 0, 211,
-	153, 0, 0, 451, 494, 458, 487, 446, 479, 411,
-	468, 506, 436, 476, 507, 0, 0, 0, 263, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 473,
-	501, 433, 475, 477, 400, 470, 0, 404, 407, 512,
-	497, 428, 429, 0, 0, 0, 0, 0, 0, 0,
-	450, 459, 484, 444, 0, 0, 0, 0, 0, 0,
-	939, 0, 426, 0, 467, 0, 0, 0, 408, 405,
-	0, 0, 448, 0, 0, 0, 410, 0, 427, 485,
-	0, 398, 119, 489, 496, 445, 265, 500, 443, 442,
-	503, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 493, 424, 432, 105, 430, 193,
-	172, 231, 466, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
+	198, 199, 200, 201, 202, 206, 207, 208, 209, 215,
+	218, 224, 225, 234, 241, 244, 499, 487, 0, 443,
+	502, 417, 433, 510, 434, 437, 474, 402, 456, 165,
+	431, 0, 421, 397, 427, 398, 419, 445, 111, 449,
+	416, 489, 459, 501, 137, 508, 139, 465, 0, 213,
+	153, 0, 0, 447, 491, 454, 484, 442, 475, 407,
+	464, 503, 432, 472, 504, 0, 0, 0, 264, 0,
+	0, 0, 0, 0, 0, 0, 0, 100, 0, 469,
+	498, 429, 471, 473, 396, 466, 0, 400, 403, 509,
+	494, 424, 425, 0, 0, 0, 0, 0, 0, 0,
+	446, 455, 481, 440, 0, 0, 0, 0, 0, 0,
+	938, 0, 422, 0, 463, 0, 0, 0, 404, 401,
+	0, 0, 444, 0, 0, 0, 406, 0, 423, 482,
+	0, 394, 119, 486, 493, 441, 266, 497, 439, 438,
+	500, 184, 0, 217, 122, 136, 96, 82, 92, 0,
+	121, 162, 191, 195, 490, 420, 428, 105, 426, 193,
+	172, 233, 462, 174, 192, 140, 223, 185, 232, 242,
+	243, 220, 240, 247, 210, 85, 219, 231, 101, 203,
+	87, 229, 216, 151, 131, 132, 86, 0, 189, 110,
+	117, 107, 164, 226, 227, 106, 249, 93, 239, 89,
+	94, 238, 158, 222, 230, 152, 145, 88, 228, 150,
 	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 403, 0, 212, 234, 249, 99, 419, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 415, 418, 413, 414, 461, 462, 508, 509, 510,
-	486, 409, 0, 416, 417, 0, 491, 498, 499, 465,
-	82, 91, 138, 246, 186, 116, 235, 399, 412, 109,
-	422, 0, 0, 434, 439, 440, 452, 454, 455, 456,
-	457, 464, 471, 472, 474, 480, 481, 482, 483, 488,
-	495, 514, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 502, 490,
-	0, 447, 505, 421, 437, 513, 438, 441, 478, 406,
-	460, 165, 435, 0, 425, 401, 431, 402, 423, 449,
-	111, 453, 420, 492, 463, 504, 137, 511, 139, 469,
-	0, 211, 153, 0, 0, 451, 494, 458, 487, 446,
-	479, 411, 468, 506, 436, 476, 507, 0, 0, 0,
-	320, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 473, 501, 433, 475, 477, 400, 470, 0, 404,
-	407, 512, 497, 428, 429, 0, 0, 0, 0, 0,
-	0, 0, 450, 459, 484, 444, 0, 0, 0, 0,
-	0, 0, 837, 0, 426, 0, 467, 0, 0, 0,
-	408, 405, 0, 0, 448, 0, 0, 0, 410, 0,
-	427, 485, 0, 398, 119, 489, 496, 445, 265, 500,
-	443, 442, 503, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 493, 424, 432, 105,
-	430, 193, 172, 231, 466, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 403, 0, 212, 234, 249, 99,
-	419, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 415, 418, 413, 414, 461, 462, 508,
-	509, 510, 486, 409, 0, 416, 417, 0, 491, 498,
-	499, 465, 82, 91, 138, 246, 186, 116, 235, 399,
-	412, 109, 422, 0, 0, 434, 439, 440, 452, 454,
-	455, 456, 457, 464, 471, 472, 474, 480, 481, 482,
-	483, 488, 495, 514, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	502, 490, 0, 447, 505, 421, 437, 513, 438, 441,
-	478, 406, 460, 165, 435, 0, 425, 401, 431, 402,
-	423, 449, 111, 453, 420, 492, 463, 504, 137, 511,
-	139, 469, 0, 211, 153, 0, 0, 451, 494, 458,
-	487, 446, 479, 411, 468, 506, 436, 476, 507, 0,
-	0, 0, 80, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 473, 501, 433, 475, 477, 400, 470,
-	0, 404, 407, 512, 497, 428, 429, 0, 0, 0,
-	0, 0, 0, 0, 450, 459, 484, 444, 0, 0,
-	0, 0, 0, 0, 0, 0, 426, 0, 467, 0,
-	0, 0, 408, 405, 0, 0, 448, 0, 0, 0,
-	410, 0, 427, 485, 0, 398, 119, 489, 496, 445,
-	265, 500, 443, 442, 503, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 493, 424,
-	432, 105, 430, 193, 172, 231, 466, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 403, 0, 212, 234,
-	249, 99, 419, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 415, 418, 413, 414, 461,
-	462, 508, 509, 510, 486, 409, 0, 416, 417, 0,
-	491, 498, 499, 465, 82, 91, 138, 246, 186, 116,
-	235, 399, 412, 109, 422, 0, 0, 434, 439, 440,
-	452, 454, 455, 456, 457, 464, 471, 472, 474, 480,
-	481, 482, 483, 488, 495, 514, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
+	156, 0, 399, 0, 214, 236, 250, 98, 415, 221,
+	245, 246, 0, 0, 99, 118, 113, 181, 157, 95,
+	127, 211, 134, 141, 188, 248, 171, 194, 102, 235,
+	212, 411, 414, 409, 410, 457, 458, 505, 506, 507,
+	483, 405, 0, 412, 413, 0, 488, 495, 496, 461,
+	81, 90, 138, 512, 186, 116, 205, 478, 104, 204,
+	237, 395, 408, 109, 418, 0, 0, 430, 435, 436,
+	448, 450, 451, 452, 453, 460, 467, 468, 470, 476,
+	477, 479, 480, 485, 492, 511, 83, 84, 91, 97,
+	103, 108, 112, 115, 120, 123, 126, 128, 129, 130,
 	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
 	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
 	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 502, 490, 0, 447, 505, 421, 437, 513,
-	438, 441, 478, 406, 460, 165, 435, 0, 425, 401,
-	431, 402, 423, 449, 111, 453, 420, 492, 463, 504,
-	137, 511, 139, 469, 0, 211, 153, 0, 0, 451,
-	494, 458, 487, 446, 479, 411, 468, 506, 436, 476,
-	507, 0, 0, 0, 320, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 473, 501, 433, 475, 477,
-	400, 470, 0, 404, 407, 512, 497, 428, 429, 0,
-	0, 0, 0, 0, 0, 0, 450, 459, 484, 444,
-	0, 0, 0, 0, 0, 0, 0, 0, 426, 0,
-	467, 0, 0, 0, 408, 405, 0, 0, 448, 0,
-	0, 0, 410, 0, 427, 485, 0, 398, 119, 489,
-	496, 445, 265, 500, 443, 442, 503, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	493, 424, 432, 105, 430, 193, 172, 231, 466, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 403, 0,
-	212, 234, 249, 99, 419, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 415, 418, 413,
-	414, 461, 462, 508, 509, 510, 486, 409, 0, 416,
-	417, 0, 491, 498, 499, 465, 82, 91, 138, 246,
-	186, 116, 235, 399, 412, 109, 422, 0, 0, 434,
-	439, 440, 452, 454, 455, 456, 457, 464, 471, 472,
-	474, 480, 481, 482, 483, 488, 495, 514, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
+	202, 206, 207, 208, 209, 215, 218, 224, 225, 234,
+	241, 244, 499, 487, 0, 443, 502, 417, 433, 510,
+	434, 437, 474, 402, 456, 165, 431, 0, 421, 397,
+	427, 398, 419, 445, 111, 449, 416, 489, 459, 501,
+	137, 508, 139, 465, 0, 213, 153, 0, 0, 447,
+	491, 454, 484, 442, 475, 407, 464, 503, 432, 472,
+	504, 0, 0, 0, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 100, 0, 469, 498, 429, 471, 473,
+	396, 466, 0, 400, 403, 509, 494, 424, 425, 0,
+	0, 0, 0, 0, 0, 0, 446, 455, 481, 440,
+	0, 0, 0, 0, 0, 0, 835, 0, 422, 0,
+	463, 0, 0, 0, 404, 401, 0, 0, 444, 0,
+	0, 0, 406, 0, 423, 482, 0, 394, 119, 486,
+	493, 441, 266, 497, 439, 438, 500, 184, 0, 217,
+	122, 136, 96, 82, 92, 0, 121, 162, 191, 195,
+	490, 420, 428, 105, 426, 193, 172, 233, 462, 174,
+	192, 140, 223, 185, 232, 242, 243, 220, 240, 247,
+	210, 85, 219, 231, 101, 203, 87, 229, 216, 151,
+	131, 132, 86, 0, 189, 110, 117, 107, 164, 226,
+	227, 106, 249, 93, 239, 89, 94, 238, 158, 222,
+	230, 152, 145, 88, 228, 150, 144, 135, 114, 124,
+	182, 142, 183, 125, 155, 154, 156, 0, 399, 0,
+	214, 236, 250, 98, 415, 221, 245, 246, 0, 0,
+	99, 118, 113, 181, 157, 95, 127, 211, 134, 141,
+	188, 248, 171, 194, 102, 235, 212, 411, 414, 409,
+	410, 457, 458, 505, 506, 507, 483, 405, 0, 412,
+	413, 0, 488, 495, 496, 461, 81, 90, 138, 512,
+	186, 116, 205, 478, 104, 204, 237, 395, 408, 109,
+	418, 0, 0, 430, 435, 436, 448, 450, 451, 452,
+	453, 460, 467, 468, 470, 476, 477, 479, 480, 485,
+	492, 511, 83, 84, 91, 97, 103, 108, 112, 115,
+	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
+	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
+	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
+	196, 197, 198, 199, 200, 201, 202, 206, 207, 208,
+	209, 215, 218, 224, 225, 234, 241, 244, 499, 487,
+	0, 443, 502, 417, 433, 510, 434, 437, 474, 402,
+	456, 165, 431, 0, 421, 397, 427, 398, 419, 445,
+	111, 449, 416, 489, 459, 501, 137, 508, 139, 465,
+	0, 213, 153, 0, 0, 447, 491, 454, 484, 442,
+	475, 407, 464, 503, 432, 472, 504, 0, 0, 0,
+	79, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	0, 469, 498, 429, 471, 473, 396, 466, 0, 400,
+	403, 509, 494, 424, 425, 0, 0, 0, 0, 0,
+	0, 0, 446, 455, 481, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 422, 0, 463, 0, 0, 0,
+	404, 401, 0, 0, 444, 0, 0, 0, 406, 0,
+	423, 482, 0, 394, 119, 486, 493, 441, 266, 497,
+	439, 438, 500, 184, 0, 217, 122, 136, 96, 82,
+	92, 0, 121, 162, 191, 195, 490, 420, 428, 105,
+	426, 193, 172, 233, 462, 174, 192, 140, 223, 185,
+	232, 242, 243, 220, 240, 247, 210, 85, 219, 231,
+	101, 203, 87, 229, 216, 151, 131, 132, 86, 0,
+	189, 110, 117, 107, 164, 226, 227, 106, 249, 93,
+	239, 89, 94, 238, 158, 222, 230, 152, 145, 88,
+	228, 150, 144, 135, 114, 124, 182, 142, 183, 125,
+	155, 154, 156, 0, 399, 0, 214, 236, 250, 98,
+	415, 221, 245, 246, 0, 0, 99, 118, 113, 181,
+	157, 95, 127, 211, 134, 141, 188, 248, 171, 194,
+	102, 235, 212, 411, 414, 409, 410, 457, 458, 505,
+	506, 507, 483, 405, 0, 412, 413, 0, 488, 495,
+	496, 461, 81, 90, 138, 512, 186, 116, 205, 478,
+	104, 204, 237, 395, 408, 109, 418, 0, 0, 430,
+	435, 436, 448, 450, 451, 452, 453, 460, 467, 468,
+	470, 476, 477, 479, 480, 485, 492, 511, 83, 84,
+	91, 97, 103, 108, 112, 115, 120, 123, 126, 128,
 	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
 	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
 	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 502, 490, 0, 447, 505, 421,
-	437, 513, 438, 441, 478, 406, 460, 165, 435, 0,
-	425, 401, 431, 402, 423, 449, 111, 453, 420, 492,
-	463, 504, 137, 511, 139, 469, 0, 211, 153, 0,
-	0, 451, 494, 458, 487, 446, 479, 411, 468, 506,
-	436, 476, 507, 0, 0, 0, 80, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 0, 473, 501, 433,
-	475, 477, 400, 470, 0, 404, 407, 512, 497, 428,
-	429, 0, 0, 0, 0, 0, 0, 0, 450, 459,
-	484, 444, 0, 0, 0, 0, 0, 0, 0, 0,
-	426, 0, 467, 0, 0, 0, 408, 405, 0, 0,
-	448, 0, 0, 0, 410, 0, 427, 485, 0, 398,
-	119, 489, 496, 445, 265, 500, 443, 442, 503, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 493, 424, 432, 105, 430, 193, 172, 231,
-	466, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 396, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
+	200, 201, 202, 206, 207, 208, 209, 215, 218, 224,
+	225, 234, 241, 244, 499, 487, 0, 443, 502, 417,
+	433, 510, 434, 437, 474, 402, 456, 165, 431, 0,
+	421, 397, 427, 398, 419, 445, 111, 449, 416, 489,
+	459, 501, 137, 508, 139, 465, 0, 213, 153, 0,
+	0, 447, 491, 454, 484, 442, 475, 407, 464, 503,
+	432, 472, 504, 0, 0, 0, 315, 0, 0, 0,
+	0, 0, 0, 0, 0, 100, 0, 469, 498, 429,
+	471, 473, 396, 466, 0, 400, 403, 509, 494, 424,
+	425, 0, 0, 0, 0, 0, 0, 0, 446, 455,
+	481, 440, 0, 0, 0, 0, 0, 0, 0, 0,
+	422, 0, 463, 0, 0, 0, 404, 401, 0, 0,
+	444, 0, 0, 0, 406, 0, 423, 482, 0, 394,
+	119, 486, 493, 441, 266, 497, 439, 438, 500, 184,
+	0, 217, 122, 136, 96, 82, 92, 0, 121, 162,
+	191, 195, 490, 420, 428, 105, 426, 193, 172, 233,
+	462, 174, 192, 140, 223, 185, 232, 242, 243, 220,
+	240, 247, 210, 85, 219, 231, 101, 203, 87, 229,
+	216, 151, 131, 132, 86, 0, 189, 110, 117, 107,
+	164, 226, 227, 106, 249, 93, 239, 89, 94, 238,
+	158, 222, 230, 152, 145, 88, 228, 150, 144, 135,
 	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	403, 0, 212, 234, 249, 99, 419, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 397, 395, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 415,
-	418, 413, 414, 461, 462, 508, 509, 510, 486, 409,
-	0, 416, 417, 0, 491, 498, 499, 465, 82, 91,
-	138, 246, 186, 116, 235, 399, 412, 109, 422, 0,
-	0, 434, 439, 440, 452, 454, 455, 456, 457, 464,
-	471, 472, 474, 480, 481, 482, 483, 488, 495, 514,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
+	399, 0, 214, 236, 250, 98, 415, 221, 245, 246,
+	0, 0, 99, 118, 113, 181, 157, 95, 127, 211,
+	134, 141, 188, 248, 171, 194, 102, 235, 212, 411,
+	414, 409, 410, 457, 458, 505, 506, 507, 483, 405,
+	0, 412, 413, 0, 488, 495, 496, 461, 81, 90,
+	138, 512, 186, 116, 205, 478, 104, 204, 237, 395,
+	408, 109, 418, 0, 0, 430, 435, 436, 448, 450,
+	451, 452, 453, 460, 467, 468, 470, 476, 477, 479,
+	480, 485, 492, 511, 83, 84, 91, 97, 103, 108,
+	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
+	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
+	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
+	187, 190, 196, 197, 198, 199, 200, 201, 202, 206,
+	207, 208, 209, 215, 218, 224, 225, 234, 241, 244,
+	499, 487, 0, 443, 502, 417, 433, 510, 434, 437,
+	474, 402, 456, 165, 431, 0, 421, 397, 427, 398,
+	419, 445, 111, 449, 416, 489, 459, 501, 137, 508,
+	139, 465, 0, 213, 153, 0, 0, 447, 491, 454,
+	484, 442, 475, 407, 464, 503, 432, 472, 504, 0,
+	0, 0, 79, 0, 0, 0, 0, 0, 0, 0,
+	0, 100, 0, 469, 498, 429, 471, 473, 396, 466,
+	0, 400, 403, 509, 494, 424, 425, 0, 0, 0,
+	0, 0, 0, 0, 446, 455, 481, 440, 0, 0,
+	0, 0, 0, 0, 0, 0, 422, 0, 463, 0,
+	0, 0, 404, 401, 0, 0, 444, 0, 0, 0,
+	406, 0, 423, 482, 0, 394, 119, 486, 493, 441,
+	266, 497, 439, 438, 500, 184, 0, 217, 122, 136,
+	96, 82, 92, 0, 121, 162, 191, 195, 490, 420,
+	428, 105, 426, 193, 172, 233, 462, 174, 192, 140,
+	223, 185, 232, 242, 243, 220, 240, 247, 210, 85,
+	219, 231, 101, 203, 87, 229, 216, 151, 131, 132,
+	86, 0, 189, 110, 117, 107, 164, 226, 227, 106,
+	249, 93, 239, 89, 392, 238, 158, 222, 230, 152,
+	145, 88, 228, 150, 144, 135, 114, 124, 182, 142,
+	183, 125, 155, 154, 156, 0, 399, 0, 214, 236,
+	250, 98, 415, 221, 245, 246, 0, 0, 99, 118,
+	113, 181, 393, 391, 127, 211, 134, 141, 188, 248,
+	171, 194, 102, 235, 212, 411, 414, 409, 410, 457,
+	458, 505, 506, 507, 483, 405, 0, 412, 413, 0,
+	488, 495, 496, 461, 81, 90, 138, 512, 186, 116,
+	205, 478, 104, 204, 237, 395, 408, 109, 418, 0,
+	0, 430, 435, 436, 448, 450, 451, 452, 453, 460,
+	467, 468, 470, 476, 477, 479, 480, 485, 492, 511,
+	83, 84, 91, 97, 103, 108, 112, 115, 120, 123,
 	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
 	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
 	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 502, 490, 0, 447,
-	505, 421, 437, 513, 438, 441, 478, 406, 460, 165,
-	435, 0, 425, 401, 431, 402, 423, 449, 111, 453,
-	420, 492, 463, 504, 137, 511, 139, 469, 0, 211,
-	153, 0, 0, 451, 494, 458, 487, 446, 479, 411,
-	468, 506, 436, 476, 507, 0, 0, 0, 263, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 473,
-	501, 433, 475, 477, 400, 470, 0, 404, 407, 512,
-	497, 428, 429, 0, 0, 0, 0, 0, 0, 0,
-	450, 459, 484, 444, 0, 0, 0, 0, 0, 0,
-	0, 0, 426, 0, 467, 0, 0, 0, 408, 405,
-	0, 0, 448, 0, 0, 0, 410, 0, 427, 485,
-	0, 398, 119, 489, 496, 445, 265, 500, 443, 442,
-	503, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 493, 424, 432, 105, 430, 193,
-	172, 231, 466, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
+	198, 199, 200, 201, 202, 206, 207, 208, 209, 215,
+	218, 224, 225, 234, 241, 244, 499, 487, 0, 443,
+	502, 417, 433, 510, 434, 437, 474, 402, 456, 165,
+	431, 0, 421, 397, 427, 398, 419, 445, 111, 449,
+	416, 489, 459, 501, 137, 508, 139, 465, 0, 213,
+	153, 0, 0, 447, 491, 454, 484, 442, 475, 407,
+	464, 503, 432, 472, 504, 0, 0, 0, 264, 0,
+	0, 0, 0, 0, 0, 0, 0, 100, 0, 469,
+	498, 429, 471, 473, 396, 466, 0, 400, 403, 509,
+	494, 424, 425, 0, 0, 0, 0, 0, 0, 0,
+	446, 455, 481, 440, 0, 0, 0, 0, 0, 0,
+	0, 0, 422, 0, 463, 0, 0, 0, 404, 401,
+	0, 0, 444, 0, 0, 0, 406, 0, 423, 482,
+	0, 394, 119, 486, 493, 441, 266, 497, 439, 438,
+	500, 184, 0, 217, 122, 136, 96, 82, 92, 0,
+	121, 162, 191, 195, 490, 420, 428, 105, 426, 193,
+	172, 233, 462, 174, 192, 140, 223, 185, 232, 242,
+	243, 220, 240, 247, 210, 85, 219, 231, 101, 203,
+	87, 229, 216, 151, 131, 132, 86, 0, 189, 110,
+	117, 107, 164, 226, 227, 106, 249, 93, 239, 89,
+	94, 238, 158, 222, 230, 152, 145, 88, 228, 150,
 	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 403, 0, 212, 234, 249, 99, 419, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 415, 418, 413, 414, 461, 462, 508, 509, 510,
-	486, 409, 0, 416, 417, 0, 491, 498, 499, 465,
-	82, 91, 138, 246, 186, 116, 235, 399, 412, 109,
-	422, 0, 0, 434, 439, 440, 452, 454, 455, 456,
-	457, 464, 471, 472, 474, 480, 481, 482, 483, 488,
-	495, 514, 84, 85, 92, 98, 104, 108, 112, 115,
+	156, 0, 399, 0, 214, 236, 250, 98, 415, 221,
+	245, 246, 0, 0, 99, 118, 113, 181, 157, 95,
+	127, 211, 134, 141, 188, 248, 171, 194, 102, 235,
+	212, 411, 414, 409, 410, 457, 458, 505, 506, 507,
+	483, 405, 0, 412, 413, 0, 488, 495, 496, 461,
+	81, 90, 138, 512, 186, 116, 205, 478, 104, 204,
+	237, 395, 408, 109, 418, 0, 0, 430, 435, 436,
+	448, 450, 451, 452, 453, 460, 467, 468, 470, 476,
+	477, 479, 480, 485, 492, 511, 83, 84, 91, 97,
+	103, 108, 112, 115, 120, 123, 126, 128, 129, 130,
+	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
+	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
+	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
+	202, 206, 207, 208, 209, 215, 218, 224, 225, 234,
+	241, 244, 499, 487, 0, 443, 502, 417, 433, 510,
+	434, 437, 474, 402, 456, 165, 431, 0, 421, 397,
+	427, 398, 419, 445, 111, 449, 416, 489, 459, 501,
+	137, 508, 139, 465, 0, 213, 153, 0, 0, 447,
+	491, 454, 484, 442, 475, 407, 464, 503, 432, 472,
+	504, 0, 0, 0, 79, 0, 0, 0, 0, 0,
+	0, 0, 0, 100, 0, 469, 498, 429, 471, 473,
+	396, 466, 0, 400, 403, 509, 494, 424, 425, 0,
+	0, 0, 0, 0, 0, 0, 446, 455, 481, 440,
+	0, 0, 0, 0, 0, 0, 0, 0, 422, 0,
+	463, 0, 0, 0, 404, 401, 0, 0, 444, 0,
+	0, 0, 406, 0, 423, 482, 0, 394, 119, 486,
+	493, 441, 266, 497, 439, 438, 500, 184, 0, 217,
+	122, 136, 96, 82, 92, 0, 121, 162, 191, 195,
+	490, 420, 428, 105, 426, 193, 172, 233, 462, 174,
+	192, 140, 223, 185, 232, 242, 243, 220, 240, 247,
+	210, 85, 219, 703, 101, 203, 87, 229, 216, 151,
+	131, 132, 86, 0, 189, 110, 117, 107, 164, 226,
+	227, 106, 249, 93, 239, 89, 392, 238, 158, 222,
+	230, 152, 145, 88, 228, 150, 144, 135, 114, 124,
+	182, 142, 183, 125, 155, 154, 156, 0, 399, 0,
+	214, 236, 250, 98, 415, 221, 245, 246, 0, 0,
+	99, 118, 113, 181, 393, 391, 127, 211, 134, 141,
+	188, 248, 171, 194, 102, 235, 212, 411, 414, 409,
+	410, 457, 458, 505, 506, 507, 483, 405, 0, 412,
+	413, 0, 488, 495, 496, 461, 81, 90, 138, 512,
+	186, 116, 205, 478, 104, 204, 237, 395, 408, 109,
+	418, 0, 0, 430, 435, 436, 448, 450, 451, 452,
+	453, 460, 467, 468, 470, 476, 477, 479, 480, 485,
+	492, 511, 83, 84, 91, 97, 103, 108, 112, 115,
 	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
 	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
 	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 502, 490,
-	0, 447, 505, 421, 437, 513, 438, 441, 478, 406,
-	460, 165, 435, 0, 425, 401, 431, 402, 423, 449,
-	111, 453, 420, 492, 463, 504, 137, 511, 139, 469,
-	0, 211, 153, 0, 0, 451, 494, 458, 487, 446,
-	479, 411, 468, 506, 436, 476, 507, 0, 0, 0,
-	80, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 473, 501, 433, 475, 477, 400, 470, 0, 404,
-	407, 512, 497, 428, 429, 0, 0, 0, 0, 0,
-	0, 0, 450, 459, 484, 444, 0, 0, 0, 0,
-	0, 0, 0, 0, 426, 0, 467, 0, 0, 0,
-	408, 405, 0, 0, 448, 0, 0, 0, 410, 0,
-	427, 485, 0, 398, 119, 489, 496, 445, 265, 500,
-	443, 442, 503, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 493, 424, 432, 105,
-	430, 193, 172, 231, 466, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 703,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 396, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 403, 0, 212, 234, 249, 99,
-	419, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	397, 395, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 415, 418, 413, 414, 461, 462, 508,
-	509, 510, 486, 409, 0, 416, 417, 0, 491, 498,
-	499, 465, 82, 91, 138, 246, 186, 116, 235, 399,
-	412, 109, 422, 0, 0, 434, 439, 440, 452, 454,
-	455, 456, 457, 464, 471, 472, 474, 480, 481, 482,
-	483, 488, 495, 514, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	502, 490, 0, 447, 505, 421, 437, 513, 438, 441,
-	478, 406, 460, 165, 435, 0, 425, 401, 431, 402,
-	423, 449, 111, 453, 420, 492, 463, 504, 137, 511,
-	139, 469, 0, 211, 153, 0, 0, 451, 494, 458,
-	487, 446, 479, 411, 468, 506, 436, 476, 507, 0,
-	0, 0, 80, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 473, 501, 433, 475, 477, 400, 470,
-	0, 404, 407, 512, 497, 428, 429, 0, 0, 0,
-	0, 0, 0, 0, 450, 459, 484, 444, 0, 0,
-	0, 0, 0, 0, 0, 0, 426, 0, 467, 0,
-	0, 0, 408, 405, 0, 0, 448, 0, 0, 0,
-	410, 0, 427, 485, 0, 398, 119, 489, 496, 445,
-	265, 500, 443, 442, 503, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 493, 424,
-	432, 105, 430, 193, 172, 231, 466, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 387, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 396, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 403, 0, 212, 234,
-	249, 99, 419, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 397, 395, 390, 389, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 415, 418, 413, 414, 461,
-	462, 508, 509, 510, 486, 409, 0, 416, 417, 0,
-	491, 498, 499, 465, 82, 91, 138, 246, 186, 116,
-	235, 399, 412, 109, 422, 0, 0, 434, 439, 440,
-	452, 454, 455, 456, 457, 464, 471, 472, 474, 480,
-	481, 482, 483, 488, 495, 514, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
+	196, 197, 198, 199, 200, 201, 202, 206, 207, 208,
+	209, 215, 218, 224, 225, 234, 241, 244, 499, 487,
+	0, 443, 502, 417, 433, 510, 434, 437, 474, 402,
+	456, 165, 431, 0, 421, 397, 427, 398, 419, 445,
+	111, 449, 416, 489, 459, 501, 137, 508, 139, 465,
+	0, 213, 153, 0, 0, 447, 491, 454, 484, 442,
+	475, 407, 464, 503, 432, 472, 504, 0, 0, 0,
+	79, 0, 0, 0, 0, 0, 0, 0, 0, 100,
+	0, 469, 498, 429, 471, 473, 396, 466, 0, 400,
+	403, 509, 494, 424, 425, 0, 0, 0, 0, 0,
+	0, 0, 446, 455, 481, 440, 0, 0, 0, 0,
+	0, 0, 0, 0, 422, 0, 463, 0, 0, 0,
+	404, 401, 0, 0, 444, 0, 0, 0, 406, 0,
+	423, 482, 0, 394, 119, 486, 493, 441, 266, 497,
+	439, 438, 500, 184, 0, 217, 122, 136, 96, 82,
+	92, 0, 121, 162, 191, 195, 490, 420, 428, 105,
+	426, 193, 172, 233, 462, 174, 192, 140, 223, 185,
+	232, 242, 243, 220, 240, 247, 210, 85, 219, 383,
+	101, 203, 87, 229, 216, 151, 131, 132, 86, 0,
+	189, 110, 117, 107, 164, 226, 227, 106, 249, 93,
+	239, 89, 392, 238, 158, 222, 230, 152, 145, 88,
+	228, 150, 144, 135, 114, 124, 182, 142, 183, 125,
+	155, 154, 156, 0, 399, 0, 214, 236, 250, 98,
+	415, 221, 245, 246, 0, 0, 99, 118, 113, 181,
+	393, 391, 386, 385, 134, 141, 188, 248, 171, 194,
+	102, 235, 212, 411, 414, 409, 410, 457, 458, 505,
+	506, 507, 483, 405, 0, 412, 413, 0, 488, 495,
+	496, 461, 81, 90, 138, 512, 186, 116, 205, 478,
+	104, 204, 237, 395, 408, 109, 418, 0, 0, 430,
+	435, 436, 448, 450, 451, 452, 453, 460, 467, 468,
+	470, 476, 477, 479, 480, 485, 492, 511, 83, 84,
+	91, 97, 103, 108, 112, 115, 120, 123, 126, 128,
+	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
+	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
+	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
+	200, 201, 202, 206, 207, 208, 209, 215, 218, 224,
+	225, 234, 241, 244, 165, 0, 0, 0, 0, 317,
+	0, 0, 0, 111, 0, 314, 0, 0, 0, 137,
+	357, 139, 0, 0, 213, 153, 0, 0, 0, 0,
+	348, 349, 0, 0, 0, 0, 0, 0, 945, 0,
+	57, 0, 0, 315, 336, 335, 338, 339, 340, 341,
+	0, 0, 100, 337, 342, 343, 344, 946, 0, 0,
+	312, 329, 0, 356, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 327, 0, 0, 0, 0, 370,
+	0, 328, 0, 0, 323, 324, 325, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
+	0, 266, 0, 0, 368, 0, 184, 0, 217, 122,
+	136, 96, 82, 92, 0, 121, 162, 191, 195, 0,
+	0, 0, 105, 0, 193, 172, 233, 0, 174, 192,
+	140, 223, 185, 232, 242, 243, 220, 240, 247, 210,
+	85, 219, 231, 101, 203, 87, 229, 216, 151, 131,
+	132, 86, 0, 189, 110, 117, 107, 164, 226, 227,
+	106, 249, 93, 239, 89, 94, 238, 158, 222, 230,
+	152, 145, 88, 228, 150, 144, 135, 114, 124, 182,
+	142, 183, 125, 155, 154, 156, 0, 0, 0, 214,
+	236, 250, 98, 0, 221, 245, 246, 0, 0, 99,
+	118, 113, 181, 157, 95, 127, 211, 134, 141, 188,
+	248, 171, 194, 102, 235, 212, 358, 369, 364, 365,
+	362, 363, 361, 360, 359, 371, 350, 351, 352, 353,
+	355, 0, 366, 367, 354, 81, 90, 138, 0, 186,
+	116, 205, 0, 104, 204, 237, 0, 0, 109, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 84, 91, 97, 103, 108, 112, 115, 120,
+	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
+	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
+	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
+	197, 198, 199, 200, 201, 202, 206, 207, 208, 209,
+	215, 218, 224, 225, 234, 241, 244, 52, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 165,
+	0, 0, 0, 0, 317, 0, 0, 0, 111, 0,
+	314, 0, 0, 0, 137, 357, 139, 0, 0, 213,
+	153, 0, 0, 0, 0, 348, 349, 0, 0, 0,
+	0, 0, 0, 0, 0, 57, 0, 0, 315, 336,
+	335, 338, 339, 340, 341, 0, 0, 100, 337, 342,
+	343, 344, 0, 0, 0, 312, 329, 0, 356, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 326, 327,
+	0, 0, 0, 0, 370, 0, 328, 0, 0, 323,
+	324, 325, 330, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 119, 0, 0, 0, 266, 0, 0, 368,
+	0, 184, 0, 217, 122, 136, 96, 82, 92, 0,
+	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
+	172, 233, 0, 174, 192, 140, 223, 185, 232, 242,
+	243, 220, 240, 247, 210, 85, 219, 231, 101, 203,
+	87, 229, 216, 151, 131, 132, 86, 0, 189, 110,
+	117, 107, 164, 226, 227, 106, 249, 93, 239, 89,
+	94, 238, 158, 222, 230, 152, 145, 88, 228, 150,
+	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
+	156, 0, 0, 0, 214, 236, 250, 98, 0, 221,
+	245, 246, 0, 0, 99, 118, 113, 181, 157, 95,
+	127, 211, 134, 141, 188, 248, 171, 194, 102, 235,
+	212, 358, 369, 364, 365, 362, 363, 361, 360, 359,
+	371, 350, 351, 352, 353, 355, 0, 366, 367, 354,
+	81, 90, 138, 53, 186, 116, 205, 0, 104, 204,
+	237, 0, 0, 109, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 83, 84, 91, 97,
+	103, 108, 112, 115, 120, 123, 126, 128, 129, 130,
 	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
 	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
 	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 322, 0, 0,
-	0, 111, 0, 319, 0, 0, 0, 137, 362, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 353, 354,
-	0, 0, 0, 0, 0, 0, 946, 0, 54, 0,
-	0, 320, 341, 340, 343, 344, 345, 346, 0, 0,
-	101, 342, 347, 348, 349, 947, 0, 0, 317, 334,
-	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 331, 332, 0, 0, 0, 0, 375, 0, 333,
-	0, 0, 328, 329, 330, 335, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 265,
-	0, 0, 373, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 363, 374, 369, 370, 367, 368,
-	366, 365, 364, 376, 355, 356, 357, 358, 360, 0,
-	371, 372, 359, 82, 91, 138, 246, 186, 116, 235,
+	202, 206, 207, 208, 209, 215, 218, 224, 225, 234,
+	241, 244, 165, 0, 0, 872, 0, 317, 0, 0,
+	0, 111, 0, 314, 0, 0, 0, 137, 357, 139,
+	0, 0, 213, 153, 0, 0, 0, 0, 348, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 57, 0,
+	0, 315, 336, 335, 338, 339, 340, 341, 0, 0,
+	100, 337, 342, 343, 344, 0, 0, 0, 312, 329,
+	0, 356, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 326, 327, 308, 0, 0, 0, 370, 0, 328,
+	0, 0, 323, 324, 325, 330, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 0, 0, 0, 266,
+	0, 0, 368, 0, 184, 0, 217, 122, 136, 96,
+	82, 92, 0, 121, 162, 191, 195, 0, 0, 0,
+	105, 0, 193, 172, 233, 0, 174, 192, 140, 223,
+	185, 232, 242, 243, 220, 240, 247, 210, 85, 219,
+	231, 101, 203, 87, 229, 216, 151, 131, 132, 86,
+	0, 189, 110, 117, 107, 164, 226, 227, 106, 249,
+	93, 239, 89, 94, 238, 158, 222, 230, 152, 145,
+	88, 228, 150, 144, 135, 114, 124, 182, 142, 183,
+	125, 155, 154, 156, 0, 0, 0, 214, 236, 250,
+	98, 0, 221, 245, 246, 0, 0, 99, 118, 113,
+	181, 157, 95, 127, 211, 134, 141, 188, 248, 171,
+	194, 102, 235, 212, 358, 369, 364, 365, 362, 363,
+	361, 360, 359, 371, 350, 351, 352, 353, 355, 0,
+	366, 367, 354, 81, 90, 138, 0, 186, 116, 205,
+	0, 104, 204, 237, 0, 0, 109, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	84, 91, 97, 103, 108, 112, 115, 120, 123, 126,
+	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
+	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
+	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
+	199, 200, 201, 202, 206, 207, 208, 209, 215, 218,
+	224, 225, 234, 241, 244, 165, 0, 0, 0, 0,
+	317, 0, 0, 0, 111, 0, 314, 0, 0, 0,
+	137, 357, 139, 0, 0, 213, 153, 0, 0, 0,
+	0, 348, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 57, 0, 589, 315, 336, 335, 338, 339, 340,
+	341, 0, 0, 100, 337, 342, 343, 344, 0, 0,
+	0, 312, 329, 0, 356, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 326, 327, 0, 0, 0, 0,
+	370, 0, 328, 0, 0, 323, 324, 325, 330, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
+	0, 0, 266, 0, 0, 368, 0, 184, 0, 217,
+	122, 136, 96, 82, 92, 0, 121, 162, 191, 195,
+	0, 0, 0, 105, 0, 193, 172, 233, 0, 174,
+	192, 140, 223, 185, 232, 242, 243, 220, 240, 247,
+	210, 85, 219, 231, 101, 203, 87, 229, 216, 151,
+	131, 132, 86, 0, 189, 110, 117, 107, 164, 226,
+	227, 106, 249, 93, 239, 89, 94, 238, 158, 222,
+	230, 152, 145, 88, 228, 150, 144, 135, 114, 124,
+	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
+	214, 236, 250, 98, 0, 221, 245, 246, 0, 0,
+	99, 118, 113, 181, 157, 95, 127, 211, 134, 141,
+	188, 248, 171, 194, 102, 235, 212, 358, 369, 364,
+	365, 362, 363, 361, 360, 359, 371, 350, 351, 352,
+	353, 355, 0, 366, 367, 354, 81, 90, 138, 0,
+	186, 116, 205, 0, 104, 204, 237, 0, 0, 109,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 83, 84, 91, 97, 103, 108, 112, 115,
+	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
+	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
+	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
+	196, 197, 198, 199, 200, 201, 202, 206, 207, 208,
+	209, 215, 218, 224, 225, 234, 241, 244, 165, 0,
+	0, 0, 0, 317, 0, 0, 0, 111, 0, 314,
+	0, 0, 0, 137, 357, 139, 0, 0, 213, 153,
+	0, 0, 0, 0, 348, 349, 0, 0, 0, 0,
+	0, 0, 0, 0, 57, 0, 0, 315, 336, 335,
+	338, 339, 340, 341, 0, 0, 100, 337, 342, 343,
+	344, 0, 0, 0, 312, 329, 0, 356, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 326, 327, 308,
+	0, 0, 0, 370, 0, 328, 0, 0, 323, 324,
+	325, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 119, 0, 0, 0, 266, 0, 0, 368, 0,
+	184, 0, 217, 122, 136, 96, 82, 92, 0, 121,
+	162, 191, 195, 0, 0, 0, 105, 0, 193, 172,
+	233, 0, 174, 192, 140, 223, 185, 232, 242, 243,
+	220, 240, 247, 210, 85, 219, 231, 101, 203, 87,
+	229, 216, 151, 131, 132, 86, 0, 189, 110, 117,
+	107, 164, 226, 227, 106, 249, 93, 239, 89, 94,
+	238, 158, 222, 230, 152, 145, 88, 228, 150, 144,
+	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
+	0, 0, 0, 214, 236, 250, 98, 0, 221, 245,
+	246, 0, 0, 99, 118, 113, 181, 157, 95, 127,
+	211, 134, 141, 188, 248, 171, 194, 102, 235, 212,
+	358, 369, 364, 365, 362, 363, 361, 360, 359, 371,
+	350, 351, 352, 353, 355, 0, 366, 367, 354, 81,
+	90, 138, 0, 186, 116, 205, 0, 104, 204, 237,
 	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
+	0, 0, 0, 0, 0, 83, 84, 91, 97, 103,
 	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
 	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
 	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
 	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 873, 0, 322, 0, 0, 0,
-	111, 0, 319, 0, 0, 0, 137, 362, 139, 0,
-	0, 211, 153, 0, 0, 0, 0, 353, 354, 0,
-	0, 0, 0, 0, 0, 0, 0, 54, 0, 0,
-	320, 341, 340, 343, 344, 345, 346, 0, 0, 101,
-	342, 347, 348, 349, 0, 0, 0, 317, 334, 0,
-	361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	331, 332, 313, 0, 0, 0, 375, 0, 333, 0,
-	0, 328, 329, 330, 335, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 0, 0, 265, 0,
-	0, 373, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 363, 374, 369, 370, 367, 368, 366,
-	365, 364, 376, 355, 356, 357, 358, 360, 0, 371,
-	372, 359, 82, 91, 138, 246, 186, 116, 235, 0,
+	206, 207, 208, 209, 215, 218, 224, 225, 234, 241,
+	244, 165, 0, 0, 0, 0, 317, 0, 0, 0,
+	111, 0, 314, 0, 0, 0, 137, 357, 139, 0,
+	0, 213, 153, 0, 0, 0, 0, 348, 349, 0,
+	0, 0, 0, 0, 0, 0, 0, 57, 0, 0,
+	315, 336, 887, 338, 339, 340, 341, 0, 0, 100,
+	337, 342, 343, 344, 0, 0, 0, 312, 329, 0,
+	356, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	326, 327, 308, 0, 0, 0, 370, 0, 328, 0,
+	0, 323, 324, 325, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 119, 0, 0, 0, 266, 0,
+	0, 368, 0, 184, 0, 217, 122, 136, 96, 82,
+	92, 0, 121, 162, 191, 195, 0, 0, 0, 105,
+	0, 193, 172, 233, 0, 174, 192, 140, 223, 185,
+	232, 242, 243, 220, 240, 247, 210, 85, 219, 231,
+	101, 203, 87, 229, 216, 151, 131, 132, 86, 0,
+	189, 110, 117, 107, 164, 226, 227, 106, 249, 93,
+	239, 89, 94, 238, 158, 222, 230, 152, 145, 88,
+	228, 150, 144, 135, 114, 124, 182, 142, 183, 125,
+	155, 154, 156, 0, 0, 0, 214, 236, 250, 98,
+	0, 221, 245, 246, 0, 0, 99, 118, 113, 181,
+	157, 95, 127, 211, 134, 141, 188, 248, 171, 194,
+	102, 235, 212, 358, 369, 364, 365, 362, 363, 361,
+	360, 359, 371, 350, 351, 352, 353, 355, 0, 366,
+	367, 354, 81, 90, 138, 0, 186, 116, 205, 0,
+	104, 204, 237, 0, 0, 109, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 84,
+	91, 97, 103, 108, 112, 115, 120, 123, 126, 128,
+	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
+	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
+	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
+	200, 201, 202, 206, 207, 208, 209, 215, 218, 224,
+	225, 234, 241, 244, 165, 0, 0, 0, 0, 317,
+	0, 0, 0, 111, 0, 314, 0, 0, 0, 137,
+	357, 139, 0, 0, 213, 153, 0, 0, 0, 0,
+	348, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	57, 0, 0, 315, 336, 884, 338, 339, 340, 341,
+	0, 0, 100, 337, 342, 343, 344, 0, 0, 0,
+	312, 329, 0, 356, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 326, 327, 308, 0, 0, 0, 370,
+	0, 328, 0, 0, 323, 324, 325, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
+	0, 266, 0, 0, 368, 0, 184, 0, 217, 122,
+	136, 96, 82, 92, 0, 121, 162, 191, 195, 0,
+	0, 0, 105, 0, 193, 172, 233, 0, 174, 192,
+	140, 223, 185, 232, 242, 243, 220, 240, 247, 210,
+	85, 219, 231, 101, 203, 87, 229, 216, 151, 131,
+	132, 86, 0, 189, 110, 117, 107, 164, 226, 227,
+	106, 249, 93, 239, 89, 94, 238, 158, 222, 230,
+	152, 145, 88, 228, 150, 144, 135, 114, 124, 182,
+	142, 183, 125, 155, 154, 156, 0, 0, 0, 214,
+	236, 250, 98, 0, 221, 245, 246, 0, 0, 99,
+	118, 113, 181, 157, 95, 127, 211, 134, 141, 188,
+	248, 171, 194, 102, 235, 212, 358, 369, 364, 365,
+	362, 363, 361, 360, 359, 371, 350, 351, 352, 353,
+	355, 0, 366, 367, 354, 81, 90, 138, 0, 186,
+	116, 205, 0, 104, 204, 237, 0, 0, 109, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 84, 91, 97, 103, 108, 112, 115, 120,
+	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
+	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
+	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
+	197, 198, 199, 200, 201, 202, 206, 207, 208, 209,
+	215, 218, 224, 225, 234, 241, 244, 165, 0, 0,
+	0, 0, 317, 0, 0, 0, 111, 0, 314, 0,
+	0, 0, 137, 357, 139, 0, 0, 213, 153, 0,
+	0, 0, 0, 348, 349, 0, 0, 0, 0, 0,
+	0, 0, 0, 57, 0, 0, 315, 336, 335, 338,
+	339, 340, 341, 0, 0, 100, 337, 342, 343, 344,
+	0, 0, 0, 312, 329, 0, 356, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 326, 327, 0, 0,
+	0, 0, 370, 0, 328, 0, 0, 323, 324, 325,
+	330, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	119, 0, 0, 0, 266, 0, 0, 368, 0, 184,
+	0, 217, 122, 136, 96, 82, 92, 0, 121, 162,
+	191, 195, 0, 0, 0, 105, 0, 193, 172, 233,
+	0, 174, 192, 140, 223, 185, 232, 242, 243, 220,
+	240, 247, 210, 85, 219, 231, 101, 203, 87, 229,
+	216, 151, 131, 132, 86, 0, 189, 110, 117, 107,
+	164, 226, 227, 106, 249, 93, 239, 89, 94, 238,
+	158, 222, 230, 152, 145, 88, 228, 150, 144, 135,
+	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
+	0, 0, 214, 236, 250, 98, 0, 221, 245, 246,
+	0, 0, 99, 118, 113, 181, 157, 95, 127, 211,
+	134, 141, 188, 248, 171, 194, 102, 235, 212, 358,
+	369, 364, 365, 362, 363, 361, 360, 359, 371, 350,
+	351, 352, 353, 355, 0, 366, 367, 354, 81, 90,
+	138, 0, 186, 116, 205, 0, 104, 204, 237, 0,
 	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 85, 92, 98, 104, 108,
+	0, 0, 0, 0, 83, 84, 91, 97, 103, 108,
 	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
 	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
 	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	165, 0, 0, 0, 0, 322, 0, 0, 0, 111,
-	0, 319, 0, 0, 0, 137, 362, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 353, 354, 0, 0,
-	0, 0, 0, 0, 0, 0, 54, 0, 576, 320,
-	341, 340, 343, 344, 345, 346, 0, 0, 101, 342,
-	347, 348, 349, 0, 0, 0, 317, 334, 0, 361,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 331,
-	332, 0, 0, 0, 0, 375, 0, 333, 0, 0,
-	328, 329, 330, 335, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 265, 0, 0,
-	373, 0, 184, 0, 215, 122, 136, 97, 83, 93,
+	187, 190, 196, 197, 198, 199, 200, 201, 202, 206,
+	207, 208, 209, 215, 218, 224, 225, 234, 241, 244,
+	165, 0, 0, 0, 0, 0, 0, 0, 0, 111,
+	0, 0, 0, 0, 0, 137, 357, 139, 0, 0,
+	213, 153, 0, 0, 0, 0, 348, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 57, 0, 0, 315,
+	336, 335, 338, 339, 340, 341, 0, 0, 100, 337,
+	342, 343, 344, 0, 0, 0, 0, 329, 0, 356,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	327, 0, 0, 0, 0, 370, 0, 328, 0, 0,
+	323, 324, 325, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 119, 0, 0, 0, 266, 0, 0,
+	368, 0, 184, 0, 217, 122, 136, 96, 82, 92,
 	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
+	193, 172, 233, 1561, 174, 192, 140, 223, 185, 232,
+	242, 243, 220, 240, 247, 210, 85, 219, 231, 101,
+	203, 87, 229, 216, 151, 131, 132, 86, 0, 189,
+	110, 117, 107, 164, 226, 227, 106, 249, 93, 239,
+	89, 94, 238, 158, 222, 230, 152, 145, 88, 228,
 	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 363, 374, 369, 370, 367, 368, 366, 365,
-	364, 376, 355, 356, 357, 358, 360, 0, 371, 372,
-	359, 82, 91, 138, 246, 186, 116, 235, 0, 0,
+	154, 156, 0, 0, 0, 214, 236, 250, 98, 0,
+	221, 245, 246, 0, 0, 99, 118, 113, 181, 157,
+	95, 127, 211, 134, 141, 188, 248, 171, 194, 102,
+	235, 212, 358, 369, 364, 365, 362, 363, 361, 360,
+	359, 371, 350, 351, 352, 353, 355, 0, 366, 367,
+	354, 81, 90, 138, 0, 186, 116, 205, 0, 104,
+	204, 237, 0, 0, 109, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 83, 84, 91,
+	97, 103, 108, 112, 115, 120, 123, 126, 128, 129,
+	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
+	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
+	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
+	201, 202, 206, 207, 208, 209, 215, 218, 224, 225,
+	234, 241, 244, 165, 0, 0, 0, 0, 0, 0,
+	0, 0, 111, 0, 0, 0, 0, 0, 137, 357,
+	139, 0, 0, 213, 153, 0, 0, 0, 0, 348,
+	349, 0, 0, 0, 0, 0, 0, 0, 0, 57,
+	0, 589, 315, 336, 335, 338, 339, 340, 341, 0,
+	0, 100, 337, 342, 343, 344, 0, 0, 0, 0,
+	329, 0, 356, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 326, 327, 0, 0, 0, 0, 370, 0,
+	328, 0, 0, 323, 324, 325, 330, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
+	266, 0, 0, 368, 0, 184, 0, 217, 122, 136,
+	96, 82, 92, 0, 121, 162, 191, 195, 0, 0,
+	0, 105, 0, 193, 172, 233, 0, 174, 192, 140,
+	223, 185, 232, 242, 243, 220, 240, 247, 210, 85,
+	219, 231, 101, 203, 87, 229, 216, 151, 131, 132,
+	86, 0, 189, 110, 117, 107, 164, 226, 227, 106,
+	249, 93, 239, 89, 94, 238, 158, 222, 230, 152,
+	145, 88, 228, 150, 144, 135, 114, 124, 182, 142,
+	183, 125, 155, 154, 156, 0, 0, 0, 214, 236,
+	250, 98, 0, 221, 245, 246, 0, 0, 99, 118,
+	113, 181, 157, 95, 127, 211, 134, 141, 188, 248,
+	171, 194, 102, 235, 212, 358, 369, 364, 365, 362,
+	363, 361, 360, 359, 371, 350, 351, 352, 353, 355,
+	0, 366, 367, 354, 81, 90, 138, 0, 186, 116,
+	205, 0, 104, 204, 237, 0, 0, 109, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	83, 84, 91, 97, 103, 108, 112, 115, 120, 123,
+	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
+	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
+	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
+	198, 199, 200, 201, 202, 206, 207, 208, 209, 215,
+	218, 224, 225, 234, 241, 244, 165, 0, 0, 0,
+	0, 0, 0, 0, 0, 111, 0, 0, 0, 0,
+	0, 137, 357, 139, 0, 0, 213, 153, 0, 0,
+	0, 0, 348, 349, 0, 0, 0, 0, 0, 0,
+	0, 0, 57, 0, 0, 315, 336, 335, 338, 339,
+	340, 341, 0, 0, 100, 337, 342, 343, 344, 0,
+	0, 0, 0, 329, 0, 356, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 326, 327, 0, 0, 0,
+	0, 370, 0, 328, 0, 0, 323, 324, 325, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
+	0, 0, 0, 266, 0, 0, 368, 0, 184, 0,
+	217, 122, 136, 96, 82, 92, 0, 121, 162, 191,
+	195, 0, 0, 0, 105, 0, 193, 172, 233, 0,
+	174, 192, 140, 223, 185, 232, 242, 243, 220, 240,
+	247, 210, 85, 219, 231, 101, 203, 87, 229, 216,
+	151, 131, 132, 86, 0, 189, 110, 117, 107, 164,
+	226, 227, 106, 249, 93, 239, 89, 94, 238, 158,
+	222, 230, 152, 145, 88, 228, 150, 144, 135, 114,
+	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
+	0, 214, 236, 250, 98, 0, 221, 245, 246, 0,
+	0, 99, 118, 113, 181, 157, 95, 127, 211, 134,
+	141, 188, 248, 171, 194, 102, 235, 212, 358, 369,
+	364, 365, 362, 363, 361, 360, 359, 371, 350, 351,
+	352, 353, 355, 0, 366, 367, 354, 81, 90, 138,
+	0, 186, 116, 205, 0, 104, 204, 237, 0, 0,
 	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
+	0, 0, 0, 83, 84, 91, 97, 103, 108, 112,
 	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
 	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
 	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 322, 0, 0, 0, 111, 0,
-	319, 0, 0, 0, 137, 362, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 353, 354, 0, 0, 0,
-	0, 0, 0, 0, 0, 54, 0, 0, 320, 341,
-	340, 343, 344, 345, 346, 0, 0, 101, 342, 347,
-	348, 349, 0, 0, 0, 317, 334, 0, 361, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 331, 332,
-	313, 0, 0, 0, 375, 0, 333, 0, 0, 328,
-	329, 330, 335, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 265, 0, 0, 373,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 363, 374, 369, 370, 367, 368, 366, 365, 364,
-	376, 355, 356, 357, 358, 360, 0, 371, 372, 359,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
+	190, 196, 197, 198, 199, 200, 201, 202, 206, 207,
+	208, 209, 215, 218, 224, 225, 234, 241, 244, 165,
+	0, 0, 0, 0, 0, 0, 0, 0, 111, 0,
+	0, 0, 0, 0, 137, 0, 139, 0, 0, 213,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
+	0, 0, 0, 0, 0, 0, 0, 100, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 322, 0, 0, 0, 111, 0, 319,
-	0, 0, 0, 137, 362, 139, 0, 0, 211, 153,
-	0, 0, 0, 0, 353, 354, 0, 0, 0, 0,
-	0, 0, 0, 0, 54, 0, 0, 320, 341, 888,
-	343, 344, 345, 346, 0, 0, 101, 342, 347, 348,
-	349, 0, 0, 0, 317, 334, 0, 361, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 331, 332, 313,
-	0, 0, 0, 375, 0, 333, 0, 0, 328, 329,
-	330, 335, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 265, 0, 0, 373, 0,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
-	162, 191, 195, 0, 0, 0, 105, 0, 193, 172,
-	231, 0, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 229, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 95,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
-	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
-	0, 0, 0, 212, 234, 249, 99, 0, 219, 243,
-	244, 0, 0, 100, 118, 113, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	363, 374, 369, 370, 367, 368, 366, 365, 364, 376,
-	355, 356, 357, 358, 360, 0, 371, 372, 359, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
+	0, 0, 0, 0, 609, 608, 618, 619, 611, 612,
+	613, 614, 615, 616, 617, 610, 0, 0, 620, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 119, 0, 0, 0, 266, 0, 0, 0,
+	0, 184, 0, 217, 122, 136, 96, 82, 92, 0,
+	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
+	172, 233, 0, 174, 192, 140, 223, 185, 232, 242,
+	243, 220, 240, 247, 210, 85, 219, 231, 101, 203,
+	87, 229, 216, 151, 131, 132, 86, 0, 189, 110,
+	117, 107, 164, 226, 227, 106, 249, 93, 239, 89,
+	94, 238, 158, 222, 230, 152, 145, 88, 228, 150,
+	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
+	156, 0, 0, 0, 214, 236, 250, 98, 0, 221,
+	245, 246, 0, 0, 99, 118, 113, 181, 157, 95,
+	127, 211, 134, 141, 188, 248, 171, 194, 102, 235,
+	212, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 322, 0, 0, 0, 111, 0, 319, 0,
-	0, 0, 137, 362, 139, 0, 0, 211, 153, 0,
-	0, 0, 0, 353, 354, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 320, 341, 885, 343,
-	344, 345, 346, 0, 0, 101, 342, 347, 348, 349,
-	0, 0, 0, 317, 334, 0, 361, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 331, 332, 313, 0,
-	0, 0, 375, 0, 333, 0, 0, 328, 329, 330,
-	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 0, 0, 265, 0, 0, 373, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 363,
-	374, 369, 370, 367, 368, 366, 365, 364, 376, 355,
-	356, 357, 358, 360, 0, 371, 372, 359, 82, 91,
-	138, 246, 186, 116, 235, 0, 0, 109, 0, 0,
+	81, 90, 138, 0, 186, 116, 205, 0, 104, 204,
+	237, 0, 0, 109, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 83, 84, 91, 97,
+	103, 108, 112, 115, 120, 123, 126, 128, 129, 130,
+	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
+	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
+	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
+	202, 206, 207, 208, 209, 215, 218, 224, 225, 234,
+	241, 244, 165, 0, 0, 0, 596, 0, 0, 0,
+	0, 111, 0, 0, 0, 0, 0, 137, 0, 139,
+	0, 0, 213, 153, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 24, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 165, 0,
-	0, 0, 0, 322, 0, 0, 0, 111, 0, 319,
-	0, 0, 0, 137, 362, 139, 0, 0, 211, 153,
-	0, 0, 0, 0, 353, 354, 0, 0, 0, 0,
-	0, 0, 0, 0, 54, 0, 0, 320, 341, 340,
-	343, 344, 345, 346, 0, 0, 101, 342, 347, 348,
-	349, 0, 0, 0, 317, 334, 0, 361, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 331, 332, 0,
-	0, 0, 0, 375, 0, 333, 0, 0, 328, 329,
-	330, 335, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 265, 0, 0, 373, 0,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
-	162, 191, 195, 0, 0, 0, 105, 0, 193, 172,
-	231, 0, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 229, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 95,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
-	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
-	0, 0, 0, 212, 234, 249, 99, 0, 219, 243,
-	244, 0, 0, 100, 118, 113, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	363, 374, 369, 370, 367, 368, 366, 365, 364, 376,
-	355, 356, 357, 358, 360, 0, 371, 372, 359, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
+	0, 79, 0, 598, 0, 0, 0, 0, 0, 0,
+	100, 0, 0, 0, 0, 0, 593, 592, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 594, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 322, 0, 0, 0, 111, 0, 319, 0,
-	0, 0, 137, 362, 139, 0, 0, 211, 153, 0,
-	0, 0, 0, 353, 354, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 320, 341, 340, 343,
-	344, 345, 346, 0, 0, 101, 342, 347, 348, 349,
-	0, 0, 0, 317, 334, 0, 361, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 331, 332, 0, 0,
-	0, 0, 375, 0, 333, 0, 0, 328, 329, 330,
-	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 0, 0, 265, 0, 0, 373, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 363,
-	374, 369, 370, 367, 368, 366, 365, 364, 376, 355,
-	356, 357, 358, 360, 0, 371, 372, 359, 82, 91,
-	138, 246, 186, 116, 235, 0, 0, 109, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 0, 0, 0, 266,
+	0, 0, 0, 0, 184, 0, 217, 122, 136, 96,
+	82, 92, 0, 121, 162, 191, 195, 0, 0, 0,
+	105, 0, 193, 172, 233, 0, 174, 192, 140, 223,
+	185, 232, 242, 243, 220, 240, 247, 210, 85, 219,
+	231, 101, 203, 87, 229, 216, 151, 131, 132, 86,
+	0, 189, 110, 117, 107, 164, 226, 227, 106, 249,
+	93, 239, 89, 94, 238, 158, 222, 230, 152, 145,
+	88, 228, 150, 144, 135, 114, 124, 182, 142, 183,
+	125, 155, 154, 156, 0, 0, 0, 214, 236, 250,
+	98, 0, 221, 245, 246, 0, 0, 99, 118, 113,
+	181, 157, 95, 127, 211, 134, 141, 188, 248, 171,
+	194, 102, 235, 212, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 165, 0, 0, 0,
-	0, 0, 0, 0, 0, 111, 0, 0, 0, 0,
-	0, 137, 362, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 353, 354, 0, 0, 0, 0, 0, 0,
-	0, 0, 54, 0, 0, 320, 341, 340, 343, 344,
-	345, 346, 0, 0, 101, 342, 347, 348, 349, 0,
-	0, 0, 0, 334, 0, 361, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 331, 332, 0, 0, 0,
-	0, 375, 0, 333, 0, 0, 328, 329, 330, 335,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 265, 0, 0, 373, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 1508,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 363, 374,
-	369, 370, 367, 368, 366, 365, 364, 376, 355, 356,
-	357, 358, 360, 0, 371, 372, 359, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
+	0, 0, 0, 81, 90, 138, 0, 186, 116, 205,
+	0, 104, 204, 237, 0, 0, 109, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	84, 91, 97, 103, 108, 112, 115, 120, 123, 126,
 	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
 	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
 	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
+	199, 200, 201, 202, 206, 207, 208, 209, 215, 218,
+	224, 225, 234, 241, 244, 165, 0, 0, 0, 0,
 	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 362, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 353, 354, 0, 0, 0, 0, 0, 0, 0,
-	0, 54, 0, 576, 320, 341, 340, 343, 344, 345,
-	346, 0, 0, 101, 342, 347, 348, 349, 0, 0,
-	0, 0, 334, 0, 361, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 331, 332, 0, 0, 0, 0,
-	375, 0, 333, 0, 0, 328, 329, 330, 335, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	0, 0, 265, 0, 0, 373, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 363, 374, 369,
-	370, 367, 368, 366, 365, 364, 376, 355, 356, 357,
-	358, 360, 0, 371, 372, 359, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 165, 0, 0, 0, 0, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	362, 139, 0, 0, 211, 153, 0, 0, 0, 0,
-	353, 354, 0, 0, 0, 0, 0, 0, 0, 0,
-	54, 0, 0, 320, 341, 340, 343, 344, 345, 346,
-	0, 0, 101, 342, 347, 348, 349, 0, 0, 0,
-	0, 334, 0, 361, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 331, 332, 0, 0, 0, 0, 375,
-	0, 333, 0, 0, 328, 329, 330, 335, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
-	0, 265, 0, 0, 373, 0, 184, 0, 215, 122,
-	136, 97, 83, 93, 0, 121, 162, 191, 195, 0,
-	0, 0, 105, 0, 193, 172, 231, 0, 174, 192,
-	140, 221, 185, 230, 240, 241, 218, 238, 245, 208,
-	86, 217, 229, 102, 203, 88, 227, 214, 151, 131,
-	132, 87, 0, 189, 110, 117, 107, 164, 224, 225,
-	106, 248, 94, 237, 90, 95, 236, 158, 220, 228,
-	152, 145, 89, 226, 150, 144, 135, 114, 124, 182,
-	142, 183, 125, 155, 154, 156, 0, 0, 0, 212,
-	234, 249, 99, 0, 219, 243, 244, 0, 0, 100,
-	118, 113, 181, 157, 96, 127, 209, 134, 141, 188,
-	247, 171, 194, 103, 233, 210, 363, 374, 369, 370,
-	367, 368, 366, 365, 364, 376, 355, 356, 357, 358,
-	360, 0, 371, 372, 359, 82, 91, 138, 246, 186,
-	116, 235, 0, 0, 109, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 85, 92,
-	98, 104, 108, 112, 115, 120, 123, 126, 128, 129,
-	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
-	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
-	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
-	201, 202, 204, 205, 206, 207, 213, 216, 222, 223,
-	232, 239, 242, 165, 0, 0, 0, 0, 0, 0,
-	0, 0, 111, 0, 0, 0, 0, 0, 137, 0,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 80, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 610, 609,
-	619, 620, 612, 613, 614, 615, 616, 617, 618, 611,
-	0, 0, 621, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
-	265, 0, 0, 0, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 598, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 80, 0, 600, 0, 0, 0, 0, 0, 0,
-	101, 0, 0, 0, 0, 0, 595, 594, 0, 0,
+	137, 0, 139, 0, 0, 213, 153, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 596, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 79, 0, 0, 0, 0, 0,
+	0, 0, 0, 100, 0, 0, 0, 0, 0, 73,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 265,
-	0, 0, 0, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 91, 138, 246, 186, 116, 235,
-	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 139, 0,
-	0, 211, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	80, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 0, 0, 0, 0, 74, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 119, 75,
+	76, 0, 72, 0, 0, 0, 77, 184, 0, 217,
+	122, 136, 96, 82, 92, 0, 121, 162, 191, 195,
+	0, 0, 0, 105, 0, 193, 172, 233, 0, 174,
+	192, 140, 223, 185, 232, 242, 243, 220, 240, 247,
+	210, 85, 219, 231, 101, 203, 87, 229, 216, 151,
+	131, 132, 86, 0, 189, 110, 117, 107, 164, 226,
+	227, 106, 249, 93, 239, 89, 94, 238, 158, 222,
+	230, 152, 145, 88, 228, 150, 144, 135, 114, 124,
+	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
+	214, 236, 250, 98, 0, 221, 245, 246, 0, 0,
+	99, 118, 113, 181, 157, 95, 127, 211, 134, 141,
+	188, 248, 171, 194, 102, 235, 212, 0, 74, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 81, 90, 138, 0,
+	186, 116, 205, 0, 104, 204, 237, 0, 0, 109,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 76, 77, 0, 73, 0,
-	0, 0, 78, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 0, 75, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 91, 138, 246, 186, 116, 235, 0,
-	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 83, 84, 91, 97, 103, 108, 112, 115,
+	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
+	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
+	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
+	196, 197, 198, 199, 200, 201, 202, 206, 207, 208,
+	209, 215, 218, 224, 225, 234, 241, 244, 52, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	165, 0, 0, 0, 929, 0, 0, 0, 0, 111,
+	165, 0, 0, 0, 0, 0, 0, 0, 0, 111,
 	0, 0, 0, 0, 0, 137, 0, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 931, 0, 0, 0, 0, 0, 0, 101, 0,
+	213, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 57, 0, 0, 79,
+	0, 0, 0, 0, 0, 0, 0, 0, 100, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 265, 0, 0,
-	0, 0, 184, 0, 215, 122, 136, 97, 83, 93,
+	0, 0, 0, 119, 0, 0, 0, 266, 0, 0,
+	0, 0, 184, 0, 217, 122, 136, 96, 82, 92,
 	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
+	193, 172, 233, 0, 174, 192, 140, 223, 185, 232,
+	242, 243, 220, 240, 247, 210, 85, 219, 231, 101,
+	203, 87, 229, 216, 151, 131, 132, 86, 0, 189,
+	110, 117, 107, 164, 226, 227, 106, 249, 93, 239,
+	89, 94, 238, 158, 222, 230, 152, 145, 88, 228,
 	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	154, 156, 0, 0, 0, 214, 236, 250, 98, 0,
+	221, 245, 246, 0, 0, 99, 118, 113, 181, 157,
+	95, 127, 211, 134, 141, 188, 248, 171, 194, 102,
+	235, 212, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 81, 90, 138, 53, 186, 116, 205, 0, 104,
+	204, 237, 0, 0, 109, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 24,
+	0, 0, 0, 0, 0, 0, 0, 83, 84, 91,
+	97, 103, 108, 112, 115, 120, 123, 126, 128, 129,
+	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
+	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
+	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
+	201, 202, 206, 207, 208, 209, 215, 218, 224, 225,
+	234, 241, 244, 52, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 165, 0, 0, 0, 0,
+	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
+	137, 0, 139, 0, 0, 213, 153, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 139, 0,
-	0, 211, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 54, 0, 0,
-	80, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 57, 0, 0, 264, 0, 0, 0, 0, 0,
+	0, 0, 0, 100, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
+	0, 0, 266, 0, 0, 0, 0, 184, 0, 217,
+	122, 136, 96, 82, 92, 0, 121, 162, 191, 195,
+	0, 0, 0, 105, 0, 193, 172, 233, 0, 174,
+	192, 140, 223, 185, 232, 242, 243, 220, 240, 247,
+	210, 85, 219, 231, 101, 203, 87, 229, 216, 151,
+	131, 132, 86, 0, 189, 110, 117, 107, 164, 226,
+	227, 106, 249, 93, 239, 89, 94, 238, 158, 222,
+	230, 152, 145, 88, 228, 150, 144, 135, 114, 124,
+	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
+	214, 236, 250, 98, 0, 221, 245, 246, 0, 0,
+	99, 118, 113, 181, 157, 95, 127, 211, 134, 141,
+	188, 248, 171, 194, 102, 235, 212, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 0, 0, 265, 0,
-	0, 0, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 81, 90, 138, 53,
+	186, 116, 205, 0, 104, 204, 237, 0, 0, 109,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 91, 138, 246, 186, 116, 235, 0,
-	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	24, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 165, 0, 0, 0, 0, 0, 0, 0,
-	0, 111, 0, 0, 0, 0, 0, 137, 0, 139,
-	0, 0, 211, 153, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 54, 0,
-	0, 263, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 83, 84, 91, 97, 103, 108, 112, 115,
+	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
+	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
+	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
+	196, 197, 198, 199, 200, 201, 202, 206, 207, 208,
+	209, 215, 218, 224, 225, 234, 241, 244, 165, 0,
+	0, 0, 928, 0, 0, 0, 0, 111, 0, 0,
+	0, 0, 0, 137, 0, 139, 0, 0, 213, 153,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 264, 0, 930,
+	0, 0, 0, 0, 0, 0, 100, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 119, 0, 0, 0, 265,
-	0, 0, 0, 0, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 0, 0, 0,
-	105, 0, 193, 172, 231, 0, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 0, 0, 212, 234, 249,
-	99, 0, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 91, 138, 246, 186, 116, 235,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 119, 0, 0, 0, 266, 0, 0, 0, 0,
+	184, 0, 217, 122, 136, 96, 82, 92, 0, 121,
+	162, 191, 195, 0, 0, 0, 105, 0, 193, 172,
+	233, 0, 174, 192, 140, 223, 185, 232, 242, 243,
+	220, 240, 247, 210, 85, 219, 231, 101, 203, 87,
+	229, 216, 151, 131, 132, 86, 0, 189, 110, 117,
+	107, 164, 226, 227, 106, 249, 93, 239, 89, 94,
+	238, 158, 222, 230, 152, 145, 88, 228, 150, 144,
+	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
+	0, 0, 0, 214, 236, 250, 98, 0, 221, 245,
+	246, 0, 0, 99, 118, 113, 181, 157, 95, 127,
+	211, 134, 141, 188, 248, 171, 194, 102, 235, 212,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 81,
+	90, 138, 0, 186, 116, 205, 0, 104, 204, 237,
 	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 84, 85, 92, 98, 104,
+	0, 0, 0, 0, 0, 83, 84, 91, 97, 103,
 	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
 	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
 	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
 	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 165, 0, 0, 0, 929, 0, 0, 0, 0,
+	206, 207, 208, 209, 215, 218, 224, 225, 234, 241,
+	244, 165, 0, 0, 0, 0, 0, 0, 0, 0,
 	111, 0, 0, 0, 0, 0, 137, 0, 139, 0,
-	0, 211, 153, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 931, 0, 0, 0, 0, 0, 0, 101,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 213, 153, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 0, 0, 265, 0,
-	0, 0, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 927, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 91, 138, 246, 186, 116, 235, 0,
-	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	165, 0, 0, 0, 0, 0, 0, 0, 0, 111,
-	0, 0, 0, 0, 0, 137, 0, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 80,
-	0, 0, 824, 0, 0, 825, 0, 0, 101, 0,
+	79, 0, 0, 1052, 0, 0, 1053, 0, 0, 100,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 0, 0, 265, 0, 0,
-	0, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 119, 0, 0, 0, 266, 0,
+	0, 0, 0, 184, 0, 217, 122, 136, 96, 82,
+	92, 0, 121, 162, 191, 195, 0, 0, 0, 105,
+	0, 193, 172, 233, 0, 174, 192, 140, 223, 185,
+	232, 242, 243, 220, 240, 247, 210, 85, 219, 231,
+	101, 203, 87, 229, 216, 151, 131, 132, 86, 0,
+	189, 110, 117, 107, 164, 226, 227, 106, 249, 93,
+	239, 89, 94, 238, 158, 222, 230, 152, 145, 88,
+	228, 150, 144, 135, 114, 124, 182, 142, 183, 125,
+	155, 154, 156, 0, 0, 0, 214, 236, 250, 98,
+	0, 221, 245, 246, 0, 0, 99, 118, 113, 181,
+	157, 95, 127, 211, 134, 141, 188, 248, 171, 194,
+	102, 235, 212, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 0, 0, 0, 0, 111, 0,
-	712, 0, 0, 0, 137, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 0,
-	711, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	0, 0, 81, 90, 138, 0, 186, 116, 205, 0,
+	104, 204, 237, 0, 0, 109, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 84,
+	91, 97, 103, 108, 112, 115, 120, 123, 126, 128,
+	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
+	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
+	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
+	200, 201, 202, 206, 207, 208, 209, 215, 218, 224,
+	225, 234, 241, 244, 165, 0, 0, 0, 928, 0,
+	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
+	0, 139, 0, 0, 213, 153, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 264, 0, 930, 0, 0, 0, 0,
+	0, 0, 100, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 265, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
+	0, 0, 0, 0, 0, 0, 0, 119, 0, 0,
+	0, 266, 0, 0, 0, 0, 184, 0, 217, 122,
+	136, 96, 82, 92, 0, 121, 162, 191, 195, 0,
+	0, 0, 105, 0, 193, 172, 233, 0, 926, 192,
+	140, 223, 185, 232, 242, 243, 220, 240, 247, 210,
+	85, 219, 231, 101, 203, 87, 229, 216, 151, 131,
+	132, 86, 0, 189, 110, 117, 107, 164, 226, 227,
+	106, 249, 93, 239, 89, 94, 238, 158, 222, 230,
+	152, 145, 88, 228, 150, 144, 135, 114, 124, 182,
+	142, 183, 125, 155, 154, 156, 0, 0, 0, 214,
+	236, 250, 98, 0, 221, 245, 246, 0, 0, 99,
+	118, 113, 181, 157, 95, 127, 211, 134, 141, 188,
+	248, 171, 194, 102, 235, 212, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 81, 90, 138, 0, 186,
+	116, 205, 0, 104, 204, 237, 0, 0, 109, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 84, 91, 97, 103, 108, 112, 115, 120,
+	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
+	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
+	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
+	197, 198, 199, 200, 201, 202, 206, 207, 208, 209,
+	215, 218, 224, 225, 234, 241, 244, 165, 0, 0,
+	0, 0, 0, 0, 0, 0, 111, 0, 712, 0,
+	0, 0, 137, 0, 139, 0, 0, 213, 153, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 711, 0,
+	0, 0, 0, 0, 0, 100, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 139, 0, 0, 211, 153,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 54, 0, 0, 263, 0, 0,
-	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	119, 0, 0, 0, 266, 0, 0, 0, 0, 184,
+	0, 217, 122, 136, 96, 82, 92, 0, 121, 162,
+	191, 195, 0, 0, 0, 105, 0, 193, 172, 233,
+	0, 174, 192, 140, 223, 185, 232, 242, 243, 220,
+	240, 247, 210, 85, 219, 231, 101, 203, 87, 229,
+	216, 151, 131, 132, 86, 0, 189, 110, 117, 107,
+	164, 226, 227, 106, 249, 93, 239, 89, 94, 238,
+	158, 222, 230, 152, 145, 88, 228, 150, 144, 135,
+	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
+	0, 0, 214, 236, 250, 98, 0, 221, 245, 246,
+	0, 0, 99, 118, 113, 181, 157, 95, 127, 211,
+	134, 141, 188, 248, 171, 194, 102, 235, 212, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 81, 90,
+	138, 0, 186, 116, 205, 0, 104, 204, 237, 0,
+	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 265, 0, 0, 0, 0,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
-	162, 191, 195, 0, 0, 0, 105, 0, 193, 172,
-	231, 0, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 229, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 95,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
-	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
-	0, 0, 0, 212, 234, 249, 99, 0, 219, 243,
-	244, 0, 0, 100, 118, 113, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
+	0, 0, 0, 0, 83, 84, 91, 97, 103, 108,
+	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
+	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
+	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
+	187, 190, 196, 197, 198, 199, 200, 201, 202, 206,
+	207, 208, 209, 215, 218, 224, 225, 234, 241, 244,
+	165, 0, 0, 0, 0, 0, 0, 0, 0, 111,
+	0, 0, 0, 0, 0, 137, 0, 139, 0, 0,
+	213, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 57, 0, 0, 264,
+	0, 0, 0, 0, 0, 0, 0, 0, 100, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 0, 0, 0, 0, 111, 0, 0, 0,
-	0, 0, 137, 0, 139, 0, 0, 211, 153, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 263, 0, 931, 0,
-	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 119, 0, 0, 0, 266, 0, 0,
+	0, 0, 184, 0, 217, 122, 136, 96, 82, 92,
+	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
+	193, 172, 233, 0, 174, 192, 140, 223, 185, 232,
+	242, 243, 220, 240, 247, 210, 85, 219, 231, 101,
+	203, 87, 229, 216, 151, 131, 132, 86, 0, 189,
+	110, 117, 107, 164, 226, 227, 106, 249, 93, 239,
+	89, 94, 238, 158, 222, 230, 152, 145, 88, 228,
+	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
+	154, 156, 0, 0, 0, 214, 236, 250, 98, 0,
+	221, 245, 246, 0, 0, 99, 118, 113, 181, 157,
+	95, 127, 211, 134, 141, 188, 248, 171, 194, 102,
+	235, 212, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 81, 90, 138, 0, 186, 116, 205, 0, 104,
+	204, 237, 0, 0, 109, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 83, 84, 91,
+	97, 103, 108, 112, 115, 120, 123, 126, 128, 129,
+	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
+	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
+	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
+	201, 202, 206, 207, 208, 209, 215, 218, 224, 225,
+	234, 241, 244, 165, 0, 0, 0, 0, 0, 0,
+	0, 0, 111, 0, 0, 0, 0, 0, 137, 0,
+	139, 0, 0, 213, 153, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 264, 0, 930, 0, 0, 0, 0, 0,
+	0, 100, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 0, 0, 265, 0, 0, 0, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 91,
-	138, 246, 186, 116, 235, 0, 0, 109, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
+	0, 0, 0, 0, 0, 0, 119, 0, 0, 0,
+	266, 0, 0, 0, 0, 184, 0, 217, 122, 136,
+	96, 82, 92, 0, 121, 162, 191, 195, 0, 0,
+	0, 105, 0, 193, 172, 233, 0, 174, 192, 140,
+	223, 185, 232, 242, 243, 220, 240, 247, 210, 85,
+	219, 231, 101, 203, 87, 229, 216, 151, 131, 132,
+	86, 0, 189, 110, 117, 107, 164, 226, 227, 106,
+	249, 93, 239, 89, 94, 238, 158, 222, 230, 152,
+	145, 88, 228, 150, 144, 135, 114, 124, 182, 142,
+	183, 125, 155, 154, 156, 0, 0, 0, 214, 236,
+	250, 98, 0, 221, 245, 246, 0, 0, 99, 118,
+	113, 181, 157, 95, 127, 211, 134, 141, 188, 248,
+	171, 194, 102, 235, 212, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 81, 90, 138, 0, 186, 116,
+	205, 0, 104, 204, 237, 0, 0, 109, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	83, 84, 91, 97, 103, 108, 112, 115, 120, 123,
 	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
 	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
 	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 165, 0, 0, 0,
+	198, 199, 200, 201, 202, 206, 207, 208, 209, 215,
+	218, 224, 225, 234, 241, 244, 165, 0, 0, 0,
 	0, 0, 0, 0, 0, 111, 0, 0, 0, 0,
-	0, 137, 0, 139, 0, 0, 211, 153, 0, 0,
+	0, 137, 0, 139, 0, 0, 213, 153, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 80, 0, 600, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 79, 0, 598, 0, 0,
+	0, 0, 0, 0, 100, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 265, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
+	0, 0, 0, 266, 0, 0, 0, 0, 184, 0,
+	217, 122, 136, 96, 82, 92, 0, 121, 162, 191,
+	195, 0, 0, 0, 105, 0, 193, 172, 233, 0,
+	174, 192, 140, 223, 185, 232, 242, 243, 220, 240,
+	247, 210, 85, 219, 231, 101, 203, 87, 229, 216,
+	151, 131, 132, 86, 0, 189, 110, 117, 107, 164,
+	226, 227, 106, 249, 93, 239, 89, 94, 238, 158,
+	222, 230, 152, 145, 88, 228, 150, 144, 135, 114,
 	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
+	0, 214, 236, 250, 98, 0, 221, 245, 246, 0,
+	0, 99, 118, 113, 181, 157, 95, 127, 211, 134,
+	141, 188, 248, 171, 194, 102, 235, 212, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 682, 111, 0, 0, 0, 0, 0,
-	137, 0, 139, 0, 0, 211, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 81, 90, 138,
+	0, 186, 116, 205, 0, 104, 204, 237, 0, 0,
+	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 263, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 83, 84, 91, 97, 103, 108, 112,
+	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
+	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
+	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
+	190, 196, 197, 198, 199, 200, 201, 202, 206, 207,
+	208, 209, 215, 218, 224, 225, 234, 241, 244, 165,
+	0, 0, 0, 0, 0, 0, 0, 682, 111, 0,
+	0, 0, 0, 0, 137, 0, 139, 0, 0, 213,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 264, 0,
+	0, 0, 0, 0, 0, 0, 0, 100, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	0, 0, 265, 0, 0, 0, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
+	0, 0, 119, 0, 0, 0, 266, 0, 0, 0,
+	0, 184, 0, 217, 122, 136, 96, 82, 92, 0,
+	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
+	172, 233, 0, 174, 192, 140, 223, 185, 232, 242,
+	243, 220, 240, 247, 210, 85, 219, 231, 101, 203,
+	87, 229, 216, 151, 131, 132, 86, 0, 189, 110,
+	117, 107, 164, 226, 227, 106, 249, 93, 239, 89,
+	94, 238, 158, 222, 230, 152, 145, 88, 228, 150,
+	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
+	156, 0, 0, 0, 214, 236, 250, 98, 0, 221,
+	245, 246, 0, 0, 99, 118, 113, 181, 157, 95,
+	127, 211, 134, 141, 188, 248, 171, 194, 102, 235,
+	212, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 379, 0, 0, 0, 0, 0,
-	0, 165, 0, 0, 0, 0, 0, 0, 0, 0,
-	111, 0, 0, 0, 0, 0, 137, 0, 139, 0,
-	0, 211, 153, 0, 0, 0, 0, 0, 0, 0,
+	81, 90, 138, 0, 186, 116, 205, 0, 104, 204,
+	237, 0, 0, 109, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	263, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 0, 0, 83, 84, 91, 97,
+	103, 108, 112, 115, 120, 123, 126, 128, 129, 130,
+	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
+	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
+	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
+	202, 206, 207, 208, 209, 215, 218, 224, 225, 234,
+	241, 244, 375, 0, 0, 0, 0, 0, 0, 165,
+	0, 0, 0, 0, 0, 0, 0, 0, 111, 0,
+	0, 0, 0, 0, 137, 0, 139, 0, 0, 213,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 264, 0,
+	0, 0, 0, 0, 0, 0, 0, 100, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 119, 0, 0, 0, 265, 0,
-	0, 0, 0, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 0, 0, 0, 105,
-	0, 193, 172, 231, 0, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 0, 0, 212, 234, 249, 99,
-	0, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 119, 0, 0, 0, 266, 0, 0, 0,
+	0, 184, 0, 217, 122, 136, 96, 82, 92, 0,
+	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
+	172, 233, 0, 174, 192, 140, 223, 185, 232, 242,
+	243, 220, 240, 247, 210, 85, 219, 231, 101, 203,
+	87, 229, 216, 151, 131, 132, 86, 0, 189, 110,
+	117, 107, 164, 226, 227, 106, 249, 93, 239, 89,
+	94, 238, 158, 222, 230, 152, 145, 88, 228, 150,
+	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
+	156, 0, 0, 0, 214, 236, 250, 98, 0, 221,
+	245, 246, 0, 0, 99, 118, 113, 181, 157, 95,
+	127, 211, 134, 141, 188, 248, 171, 194, 102, 235,
+	212, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 91, 138, 246, 186, 116, 235, 0,
-	0, 109, 0, 0, 0, 0, 0, 0, 0, 0,
+	81, 90, 138, 0, 186, 116, 205, 0, 104, 204,
+	237, 0, 0, 109, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	165, 0, 0, 0, 0, 0, 0, 0, 0, 111,
-	0, 0, 0, 0, 0, 137, 0, 139, 0, 0,
-	211, 153, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
-	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 0, 0, 0, 0, 0, 83, 84, 91, 97,
+	103, 108, 112, 115, 120, 123, 126, 128, 129, 130,
+	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
+	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
+	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
+	202, 206, 207, 208, 209, 215, 218, 224, 225, 234,
+	241, 244, 165, 0, 0, 0, 0, 0, 0, 0,
+	0, 111, 0, 0, 0, 0, 0, 137, 0, 139,
+	0, 0, 213, 153, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 264, 0, 0, 0, 0, 0, 0, 0, 0,
+	100, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 0, 260, 0, 265, 0, 0,
-	0, 0, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 0, 0, 0, 105, 0,
-	193, 172, 231, 0, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 0, 0, 212, 234, 249, 99, 0,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 0, 261, 0, 266,
+	0, 0, 0, 0, 184, 0, 217, 122, 136, 96,
+	82, 92, 0, 121, 162, 191, 195, 0, 0, 0,
+	105, 0, 193, 172, 233, 0, 174, 192, 140, 223,
+	185, 232, 242, 243, 220, 240, 247, 210, 85, 219,
+	231, 101, 203, 87, 229, 216, 151, 131, 132, 86,
+	0, 189, 110, 117, 107, 164, 226, 227, 106, 249,
+	93, 239, 89, 94, 238, 158, 222, 230, 152, 145,
+	88, 228, 150, 144, 135, 114, 124, 182, 142, 183,
+	125, 155, 154, 156, 0, 0, 0, 214, 236, 250,
+	98, 0, 221, 245, 246, 0, 0, 99, 118, 113,
+	181, 157, 95, 127, 211, 134, 141, 188, 248, 171,
+	194, 102, 235, 212, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 91, 138, 246, 186, 116, 235, 0, 0,
-	109, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 81, 90, 138, 0, 186, 116, 205,
+	0, 104, 204, 237, 0, 0, 109, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 165,
-	0, 0, 0, 0, 0, 0, 0, 0, 111, 0,
-	0, 0, 0, 0, 137, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 83,
+	84, 91, 97, 103, 108, 112, 115, 120, 123, 126,
+	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
+	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
+	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
+	199, 200, 201, 202, 206, 207, 208, 209, 215, 218,
+	224, 225, 234, 241, 244, 165, 0, 0, 0, 0,
+	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
+	137, 0, 139, 0, 0, 213, 153, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 79, 0, 0, 0, 0, 0,
+	0, 0, 0, 100, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 265, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
+	0, 0, 266, 0, 0, 0, 0, 184, 0, 217,
+	122, 136, 96, 82, 92, 0, 121, 162, 191, 195,
+	0, 0, 0, 105, 0, 193, 172, 233, 0, 174,
+	192, 140, 223, 185, 232, 242, 243, 220, 240, 247,
+	210, 85, 219, 231, 101, 203, 87, 229, 216, 151,
+	131, 132, 86, 0, 189, 110, 117, 107, 164, 226,
+	227, 106, 249, 93, 239, 89, 94, 238, 158, 222,
+	230, 152, 145, 88, 228, 150, 144, 135, 114, 124,
+	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
+	214, 236, 250, 98, 0, 221, 245, 246, 0, 0,
+	99, 118, 113, 181, 157, 95, 127, 211, 134, 141,
+	188, 248, 171, 194, 102, 235, 212, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
+	0, 0, 0, 0, 0, 0, 81, 90, 138, 0,
+	186, 116, 205, 0, 104, 204, 237, 0, 0, 109,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
+	0, 0, 83, 84, 91, 97, 103, 108, 112, 115,
 	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
 	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
 	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
+	196, 197, 198, 199, 200, 201, 202, 206, 207, 208,
+	209, 215, 218, 224, 225, 234, 241, 244, 165, 0,
 	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 139, 0, 0, 211, 153,
+	0, 0, 0, 137, 0, 139, 0, 0, 213, 153,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 263, 0, 0,
-	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 264, 0, 0,
+	0, 0, 0, 0, 0, 0, 100, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 119, 0, 0, 0, 265, 0, 0, 0, 0,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
+	0, 119, 0, 0, 0, 266, 0, 0, 0, 0,
+	184, 0, 217, 122, 136, 96, 82, 92, 0, 121,
 	162, 191, 195, 0, 0, 0, 105, 0, 193, 172,
-	231, 0, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 229, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 95,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
+	233, 0, 174, 192, 140, 223, 185, 232, 242, 243,
+	220, 240, 247, 210, 85, 219, 231, 101, 203, 87,
+	229, 216, 151, 131, 132, 86, 0, 189, 110, 117,
+	107, 164, 226, 227, 106, 249, 93, 239, 89, 94,
+	238, 158, 222, 230, 152, 145, 88, 228, 150, 144,
 	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
-	0, 0, 0, 212, 234, 249, 99, 0, 219, 243,
-	244, 0, 0, 100, 118, 113, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 82,
-	91, 138, 246, 186, 116, 235, 0, 0, 109, 0,
+	0, 0, 0, 214, 236, 250, 98, 0, 221, 245,
+	246, 0, 0, 99, 118, 113, 181, 157, 95, 127,
+	211, 134, 141, 188, 248, 171, 194, 102, 235, 212,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 81,
+	90, 138, 0, 186, 116, 205, 0, 104, 204, 237,
+	0, 0, 109, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 165, 0, 0,
-	0, 0, 0, 0, 0, 0, 111, 0, 0, 0,
-	0, 0, 137, 0, 139, 0, 0, 211, 153, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 320, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 83, 84, 91, 97, 103,
+	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
+	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
+	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
+	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
+	206, 207, 208, 209, 215, 218, 224, 225, 234, 241,
+	244, 165, 0, 0, 0, 0, 0, 0, 0, 0,
+	111, 0, 0, 0, 0, 0, 137, 0, 139, 0,
+	0, 213, 153, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	315, 0, 0, 0, 0, 0, 0, 0, 0, 100,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 0, 0, 265, 0, 0, 0, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 91,
-	138, 246, 186, 116, 235, 0, 0, 109, 0, 0,
+	0, 0, 0, 0, 119, 0, 0, 0, 266, 0,
+	0, 0, 0, 184, 0, 217, 122, 136, 96, 82,
+	92, 0, 121, 162, 191, 195, 0, 0, 0, 105,
+	0, 193, 172, 233, 0, 174, 192, 140, 223, 185,
+	232, 242, 243, 220, 240, 247, 210, 85, 219, 231,
+	101, 203, 87, 229, 216, 151, 131, 132, 86, 0,
+	189, 110, 117, 107, 164, 226, 227, 106, 249, 93,
+	239, 89, 94, 238, 158, 222, 230, 152, 145, 88,
+	228, 150, 144, 135, 114, 124, 182, 142, 183, 125,
+	155, 154, 156, 0, 0, 0, 214, 236, 250, 98,
+	0, 221, 245, 246, 0, 0, 99, 118, 113, 181,
+	157, 95, 127, 211, 134, 141, 188, 248, 171, 194,
+	102, 235, 212, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 81, 90, 138, 0, 186, 116, 205, 0,
+	104, 204, 237, 0, 0, 109, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 84,
+	91, 97, 103, 108, 112, 115, 120, 123, 126, 128,
+	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
+	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
+	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
+	200, 201, 202, 206, 207, 208, 209, 215, 218, 224,
+	225, 234, 241, 244,
 }
 var yyPact = [...]int{
 
-	1877, -1000, -267, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	148, -1000, -271, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 940, 995, -1000, -1000, -1000, -1000, -1000, -1000,
-	255, 11283, 36, 110, -5, 15262, 109, 1522, 15920, -1000,
-	13, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -80, -91,
-	-1000, 687, -1000, -1000, -1000, -1000, -1000, 914, 938, 778,
-	907, 822, -1000, 7981, 77, 77, 14933, 6665, -1000, -1000,
-	212, 15920, 101, 15920, -147, 80, 80, 80, -1000, -1000,
+	-1000, -1000, -1000, 778, -1000, -1000, -1000, -1000, -1000, 313,
+	11487, 57, 160, 44, 15514, 159, 1722, 16180, -1000, 28,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -55, -56, -1000,
+	909, 933, -1000, -194, -1000, -1000, 110, -1000, -1000, -1000,
+	-1000, 8490, -1000, 127, 127, 15181, 6813, -1000, -1000, 308,
+	16180, 150, 16180, -120, 112, 112, 112, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2561,21 +2599,22 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	107, 15920, 186, -1000, 15920, 75, 531, 75, 75, 75,
-	15920, -1000, 141, -1000, -1000, -1000, 15920, 525, 873, 3587,
-	55, 3587, -1000, 3587, 3587, -1000, 3587, 24, 3587, -83,
-	955, 25, -44, -1000, 3587, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 538, 885,
-	9309, 9309, 940, -1000, 687, -1000, -1000, -1000, 862, -1000,
-	-1000, 274, 972, -1000, 10954, 139, -1000, 9309, 1633, 634,
-	-1000, -1000, 634, -1000, -1000, 128, -1000, -1000, 10296, 10296,
-	10296, 10296, 10296, 10296, 10296, 10296, -1000, -1000, -1000, -1000,
+	-1000, 158, 16180, 243, -1000, 16180, 109, 614, 109, 109,
+	109, 16180, -1000, 216, -1000, -1000, -1000, 16180, 611, 842,
+	3699, 85, 3699, -1000, 3699, 3699, -1000, 3699, 36, 3699,
+	-37, 923, 38, 16, -1000, 3699, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 900,
+	907, 758, 895, 810, 16180, -1000, 595, 936, -1000, 11154,
+	215, -1000, 9489, 2140, 708, -1000, -1000, 708, -1000, -1000,
+	210, -1000, -1000, 10488, 10488, 10488, 10488, 10488, 10488, 10488,
+	10488, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 708, -1000, 7491, 708, 708,
+	708, 708, 708, 708, 708, 708, 9489, 708, 708, 708,
+	708, 708, 708, 708, 708, 708, 708, 708, 708, 708,
+	708, 708, 408, 14841, 13842, 16180, 629, 617, -1000, -1000,
+	207, 704, 6467, -80, -1000, -1000, -1000, 324, 13509, -1000,
+	-1000, -1000, 840, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	634, -1000, 8980, 634, 634, 634, 634, 634, 634, 634,
-	634, 9309, 634, 634, 634, 634, 634, 634, 634, 634,
-	634, 634, 634, 634, 634, 634, 634, 14597, 13610, 15920,
-	743, 702, -1000, -1000, 138, 689, 6323, -97, -1000, -1000,
-	-1000, 206, 13281, -1000, -1000, -1000, 870, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2586,234 +2625,244 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 610, 16180, -1000, 305, -1000, 603, 3699,
+	130, 602, 357, 601, 16180, 16180, 3699, 43, 80, 72,
+	16180, 706, 125, 16180, 882, 779, 16180, 600, 599, -1000,
+	6121, -1000, 3699, 3699, -1000, -1000, -1000, 3699, 3699, 3699,
+	16180, 3699, 3699, -1000, -1000, -1000, -1000, 3699, 3699, -1000,
+	930, 338, -1000, -1000, -1000, -1000, 9489, 262, -1000, 777,
+	-1000, -1000, -1000, 852, 9489, 9489, 909, -1000, 110, -1000,
+	-1000, -1000, 837, -1000, -1000, 705, -1000, 708, -1000, -1000,
+	16180, -1000, 9489, 9489, 390, -1000, 14508, -1000, -1000, 4737,
+	280, 202, 10488, 468, 303, 10488, 10488, 10488, 10488, 10488,
+	10488, 10488, 10488, 10488, 10488, 10488, 10488, 10488, 10488, 10488,
+	483, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 597,
+	-1000, 110, 530, 527, 227, 227, 227, 227, 227, 227,
+	227, 10821, 7824, 595, 594, 367, 7491, 8490, 8490, 9489,
+	9489, 9156, 8823, 8490, 888, 348, 367, 16513, -1000, -1000,
+	10155, -1000, -1000, -1000, -1000, -1000, 595, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 15847, 15847, 8490, 8490, 8490, 8490,
+	-1000, 78, 16180, -1000, 684, 774, -1000, -1000, -1000, 885,
+	12177, 13176, 78, 666, 13842, 16180, -1000, -1000, 13842, 16180,
+	4391, 5775, 704, -80, 680, -1000, -89, -75, 7146, 225,
+	-1000, -1000, -1000, -1000, 3353, 201, 632, 359, -38, -1000,
+	-1000, -1000, 714, -1000, 714, 714, 714, 714, 1, 1,
+	1, 1, -1000, -1000, -1000, -1000, -1000, 745, 744, -1000,
+	714, 714, 714, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 619, 15920, -1000, 2291, -1000,
-	516, 3587, 92, 490, 244, 487, 15920, 15920, 3587, 31,
-	64, 60, 15920, 693, 90, 15920, 901, 785, 15920, 477,
-	473, -1000, 5981, -1000, 3587, 3587, -1000, -1000, -1000, 3587,
-	3587, 3587, 15920, 3587, 3587, -1000, -1000, -1000, -1000, 3587,
-	3587, -1000, 966, 262, -1000, -1000, -1000, -1000, 9309, 176,
-	-1000, 784, -1000, -1000, -1000, -1000, -1000, -1000, 988, 172,
-	446, 136, 692, -1000, 384, 914, 538, 822, 12952, 787,
-	-1000, -1000, 15920, -1000, 9309, 9309, 494, -1000, 14268, -1000,
-	-1000, 4613, 179, 10296, 346, 272, 10296, 10296, 10296, 10296,
-	10296, 10296, 10296, 10296, 10296, 10296, 10296, 10296, 10296, 10296,
-	10296, 382, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	466, -1000, 687, 574, 574, 151, 151, 151, 151, 151,
-	151, 151, 10625, 7323, 538, 617, 322, 8980, 7981, 7981,
-	9309, 9309, 8639, 8310, 7981, 908, 215, 322, 16249, -1000,
-	-1000, 9967, -1000, -1000, -1000, -1000, -1000, 538, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 15591, 15591, 7981, 7981, 7981,
-	7981, 46, 15920, -1000, 652, 961, -1000, -1000, -1000, 903,
-	12294, 12623, 46, 655, 13610, 15920, -1000, -1000, 13610, 15920,
-	4271, 5639, 689, -97, 657, -1000, -126, -103, 6994, 147,
-	-1000, -1000, -1000, -1000, 3245, 253, 558, 293, -58, -1000,
-	-1000, -1000, 737, -1000, 737, 737, 737, 737, -27, -27,
-	-27, -27, -1000, -1000, -1000, -1000, -1000, 757, 755, -1000,
-	737, 737, 737, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 743, 743, 743, 731, 731, 749, -1000, 16180, 3699,
+	880, 3699, -1000, 95, -1000, 16180, 16180, 16180, 16180, 16180,
+	187, 16180, 16180, 703, -1000, 16180, 3699, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 754, 754, 754, 740, 740, 759, -1000, 15920, 3587,
-	898, 3587, -1000, 76, -1000, 15920, 15920, 15920, 15920, 15920,
-	117, 15920, 15920, 688, -1000, 15920, 3587, -1000, -1000, -1000,
+	-1000, -1000, 16180, 342, 16180, 16180, 367, -1000, 526, 16180,
+	-1000, 944, 252, 520, 702, -1000, 446, 900, 595, 810,
+	12843, 791, -1000, 16180, 884, 15847, -1000, 280, 335, -1000,
+	-1000, 460, -1000, -1000, -1000, -1000, 199, 708, -1000, 5429,
+	1574, -1000, -1000, -1000, -1000, 468, 10488, 10488, 10488, 649,
+	1574, 2187, 790, 609, 227, 480, 480, 234, 234, 234,
+	234, 234, 470, 470, -1000, -1000, -1000, 595, -1000, -1000,
+	-1000, 595, 8490, 700, -1000, -1000, 9489, -1000, 595, 588,
+	588, 363, 365, 298, 929, 588, 291, 928, 588, 588,
+	8490, 337, -1000, 9489, 595, -1000, 196, -1000, 1470, 699,
+	685, 588, 595, 588, 588, 111, 708, -1000, 16513, 13842,
+	13842, 13842, 13842, 13842, -1000, 807, 805, -1000, 794, 788,
+	800, 16180, -1000, 592, 12177, 195, 708, -1000, 14175, -1000,
+	-1000, 922, 13842, 673, -1000, 673, -1000, 191, -1000, -1000,
+	680, -80, -62, -1000, -1000, -1000, -1000, 367, -1000, 497,
+	677, 3007, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 740,
+	589, -1000, 872, 242, 244, 565, 871, -1000, -1000, -1000,
+	846, -1000, 399, -43, -1000, -1000, 487, 1, 1, -1000,
+	-1000, 225, 839, 225, 225, 225, 507, 507, -1000, -1000,
+	-1000, -1000, 479, -1000, -1000, -1000, 476, -1000, 773, 15847,
+	3699, -1000, -1000, -1000, -1000, 290, 290, 222, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 62,
+	747, -1000, -1000, -1000, -1000, 25, 42, 117, -1000, 3699,
+	-1000, 338, -1000, 505, 9489, -1000, -1000, -1000, -1000, -1000,
+	795, 9489, 9489, 9489, -1000, -1000, -1000, 852, -1000, 888,
+	908, -1000, 833, 828, 8490, -1000, -1000, 708, 548, -1000,
+	-1000, -1000, -1000, 4045, 8490, 182, -1000, 649, 1574, 1631,
+	-1000, 10488, 10488, -1000, -185, 588, 8490, 367, -1000, -1000,
+	-1000, 171, 483, 171, 10488, 10488, -1000, 10488, 10488, -1000,
+	-132, 679, 328, -1000, 9489, 444, -1000, 5429, -1000, 10488,
+	10488, -1000, -1000, -1000, -1000, 759, 16513, 708, -1000, 11832,
+	15847, 675, -1000, 306, 774, 739, 757, 572, -1000, -1000,
+	-1000, -1000, 804, -1000, 801, -1000, -1000, -1000, -1000, -1000,
+	137, 135, 133, 15847, -1000, 909, 9489, 673, -1000, -1000,
+	237, -1000, -1000, -103, -91, -1000, -1000, -1000, 3353, -1000,
+	3353, 15847, 93, -1000, 565, 565, -1000, -1000, -1000, 736,
+	756, 10488, -1000, -1000, -1000, 630, 225, 225, -1000, 272,
+	-1000, -1000, -1000, 583, -1000, 579, 669, 577, 16180, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 15920, 313, 15920, 15920, 322, -1000, 425, 15920,
-	-1000, 835, 9309, 9309, 5297, 9309, -1000, -1000, -1000, 885,
-	-1000, 908, 945, -1000, 860, 847, 7981, -1000, -1000, 179,
-	227, -1000, -1000, 329, -1000, -1000, -1000, -1000, 135, 634,
-	-1000, 2130, -1000, -1000, -1000, -1000, 346, 10296, 10296, 10296,
-	409, 2130, 2088, 789, 722, 151, 269, 269, 155, 155,
-	155, 155, 155, 393, 393, -1000, -1000, -1000, 538, -1000,
-	-1000, -1000, 538, 7981, 668, -1000, -1000, 9309, -1000, 538,
-	611, 611, 514, 459, 238, 960, 611, 229, 959, 611,
-	611, 7981, 254, -1000, 9309, 538, -1000, 134, -1000, 633,
-	664, 661, 611, 538, 611, 611, 684, 634, -1000, 16249,
-	13610, 13610, 13610, 13610, 13610, -1000, 814, 813, -1000, 804,
-	798, 805, 15920, -1000, 615, 12294, 163, 634, -1000, 13939,
-	-1000, -1000, 954, 13610, 632, -1000, 632, -1000, 133, -1000,
-	-1000, 657, -97, -125, -1000, -1000, -1000, -1000, 322, -1000,
-	402, 656, 2903, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	748, 463, -1000, 889, 199, 203, 458, 882, -1000, -1000,
-	-1000, 876, -1000, 260, -105, -1000, -1000, 391, -27, -27,
-	-1000, -1000, 147, 869, 147, 147, 147, 424, 424, -1000,
-	-1000, -1000, -1000, 363, -1000, -1000, -1000, 341, -1000, 783,
-	15591, 3587, -1000, -1000, -1000, -1000, 347, 347, 198, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	45, 576, -1000, -1000, -1000, -1000, -1, 30, 83, -1000,
-	3587, -1000, 262, -1000, 416, 9309, -1000, -1000, -1000, -1000,
-	827, 322, 322, 131, -1000, -1000, 15920, -1000, -1000, -1000,
-	-1000, 669, -1000, -1000, -1000, 3929, 7981, -1000, 409, 2130,
-	2055, -1000, 10296, 10296, -1000, -1000, 611, 7981, 322, -1000,
-	-1000, -1000, 98, 382, 98, 10296, 10296, -1000, 10296, 10296,
-	-1000, -165, 691, 210, -1000, 9309, 317, -1000, 5297, -1000,
-	10296, 10296, -1000, -1000, -1000, -1000, 782, 16249, 634, -1000,
-	11953, 15591, 643, -1000, 204, 961, 747, 776, 604, -1000,
-	-1000, -1000, -1000, 812, -1000, 796, -1000, -1000, -1000, -1000,
-	-1000, 100, 97, 94, 15591, -1000, 940, 9309, 632, -1000,
-	-1000, 164, -1000, -1000, -130, -108, -1000, -1000, -1000, 3245,
-	-1000, 3245, 15591, 61, -1000, 458, 458, -1000, -1000, -1000,
-	741, 761, 10296, -1000, -1000, -1000, 554, 147, 147, -1000,
-	200, -1000, -1000, -1000, 583, -1000, 571, 642, 545, 15920,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 15920, -1000, -1000,
-	-1000, -1000, -1000, 15591, -173, 457, 15591, 15591, 15591, 15920,
-	-1000, 313, -1000, 322, -1000, 4955, -1000, 954, 13610, -1000,
-	-1000, 538, -1000, 10296, 2130, 2130, -1000, -1000, 538, 737,
-	737, -1000, 737, 740, -1000, 737, 3, 737, 2, 538,
-	538, 2040, 1999, 1869, 1843, 634, -160, -1000, 322, 9309,
-	-1000, 1705, 1118, -1000, 892, 553, 596, -1000, -1000, 7652,
-	538, 541, 127, 536, -1000, 940, 16249, 9309, -1000, -1000,
-	9309, 739, -1000, 9309, -1000, -1000, -1000, 634, 634, 634,
-	536, 914, 322, -1000, -1000, -1000, -1000, 2903, -1000, 529,
-	-1000, 737, -1000, -1000, -1000, 15591, -51, 981, 2130, -1000,
-	-1000, -1000, -1000, -1000, -27, 415, -27, 326, -1000, 305,
-	3587, -1000, -1000, -1000, -1000, 894, -1000, 4955, -1000, -1000,
-	731, 744, -1000, -1000, -1000, 952, 631, -1000, 2130, -1000,
-	-1000, 118, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	10296, 10296, 10296, 10296, 10296, 538, 410, 322, 10296, 10296,
-	881, -1000, 634, -1000, -1000, 711, 15591, 15591, -1000, 15591,
-	914, -1000, 322, 322, 15591, 322, 15591, 15591, 15591, 11612,
-	-1000, 149, 15591, -1000, 523, -1000, 191, -1000, -75, 147,
-	-1000, 147, 546, 542, -1000, 634, 626, -1000, 194, 15591,
-	15920, 943, 916, -1000, -1000, 633, 633, 633, 633, 51,
-	-1000, -1000, 633, 633, 979, -1000, 634, -1000, 687, 123,
-	-1000, -1000, -1000, 521, 503, 503, 503, 163, 149, -1000,
-	441, 189, 404, -1000, 58, 15591, 271, 880, -1000, 879,
-	-1000, -1000, -1000, -1000, -1000, 43, 4955, 3245, 485, -1000,
-	-1000, 9309, 9309, -1000, -1000, -1000, -1000, 538, 44, -177,
-	-1000, -1000, 16249, 596, 538, 15591, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 298, -1000, -1000, 15920, -1000, -1000, 395,
-	-1000, -1000, 482, -1000, 15591, -1000, -1000, 576, 322, 575,
-	-1000, 826, -171, -181, 562, -1000, -1000, -1000, 704, -1000,
-	-1000, 43, 843, -173, -1000, 825, -1000, 15591, -1000, 37,
-	-1000, -175, 432, 35, -178, 749, 634, -182, 690, -1000,
-	984, 9638, -1000, -1000, 977, 177, 177, 633, 538, -1000,
-	-1000, -1000, 65, 345, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 16180, -1000, -1000, -1000,
+	-1000, -1000, 15847, -141, 553, 15847, 15847, 15847, 16180, -1000,
+	342, -1000, 367, 818, 367, 367, -1000, -1000, 16180, -1000,
+	-1000, -1000, -1000, 634, -1000, -1000, 15847, -1000, -1000, 595,
+	5083, -1000, 10488, 1574, 1574, -1000, 708, -185, -1000, 595,
+	714, 714, -1000, 714, 731, -1000, 714, 18, 714, 17,
+	595, 595, 2092, 2002, 1805, 945, 708, -127, -1000, 367,
+	9489, -1000, 1757, 1707, -1000, 853, 626, 638, -1000, -1000,
+	8157, 595, 575, 178, 571, -1000, 909, 16513, 9489, -1000,
+	-1000, 9489, 719, -1000, 9489, -1000, -1000, -1000, 708, 708,
+	708, 571, 900, 367, -1000, -1000, -1000, -1000, 3007, -1000,
+	557, -1000, 714, -1000, -1000, -1000, 15847, -23, 942, 1574,
+	-1000, -1000, -1000, -1000, -1000, 1, 504, 1, 464, -1000,
+	461, 3699, -1000, -1000, -1000, -1000, 875, -1000, 5083, -1000,
+	-1000, 713, 748, -1000, -1000, -1000, -1000, -1000, 922, 13842,
+	-1000, -1000, 1574, 55, -1000, -1000, -1000, 132, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 10488, 10488, 10488, 10488,
+	10488, 595, 503, 367, 10488, 10488, 868, -1000, 708, -1000,
+	-1000, 116, 15847, 15847, -1000, 15847, 900, -1000, 367, 367,
+	15847, 367, 15847, 15847, 15847, 12510, -1000, 186, 15847, -1000,
+	552, 271, -1000, -108, 225, -1000, 225, 621, 618, -1000,
+	708, 665, -1000, 300, 15847, 16180, 917, 643, 909, 905,
+	-1000, -1000, 1470, 1470, 1470, 1470, 124, -1000, -1000, 1470,
+	1470, 941, -1000, 708, -1000, 110, 176, -1000, -1000, -1000,
+	550, 548, 548, 548, 195, 186, -1000, 533, 295, 498,
+	-1000, 68, 406, 851, -1000, 848, -1000, -1000, -1000, -1000,
+	-1000, 54, 5083, 3353, 545, -1000, 914, 902, -151, 9489,
+	-1000, -1000, -1000, -1000, 595, 77, -144, -1000, -1000, 16513,
+	638, 595, 15847, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	421, -1000, -1000, 16180, -1000, 496, -1000, -1000, 543, -1000,
+	15847, -1000, -1000, 747, -1000, 9489, 9489, 595, 33, -1000,
+	-1000, 612, -1000, 817, -138, -153, 635, -1000, -1000, -1000,
+	712, -1000, -1000, 54, 826, -141, 367, 612, -1000, -1000,
+	22, -164, -215, -222, -1000, -1000, 10488, -1000, 813, -1000,
+	15847, -1000, 51, -1000, 368, -1000, -1000, -1000, -1000, -1000,
+	10821, -142, 540, 49, 22, -1000, -145, 755, 708, -1000,
+	-156, 752, -1000, 927, 9822, -1000, -1000, 939, 219, 219,
+	1470, 595, -1000, -1000, -1000, 97, 426, -1000, -1000, -1000,
+	-1000, -1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 1202, 55, 407, 1201, 1196, 1195, 1192, 1190, 1187,
-	1186, 1185, 1184, 1183, 1182, 1181, 1169, 1168, 1167, 1164,
-	1163, 1161, 1159, 1157, 1155, 1153, 96, 1151, 1150, 1149,
-	66, 1147, 81, 1138, 1137, 40, 918, 41, 45, 827,
-	1136, 26, 71, 46, 1135, 35, 1134, 1133, 88, 1131,
-	1130, 57, 1126, 1124, 92, 1122, 73, 1121, 11, 43,
-	1120, 1119, 1118, 1117, 70, 329, 1116, 1115, 16, 1114,
-	1108, 84, 1107, 50, 7, 14, 13, 22, 1106, 906,
-	25, 1105, 58, 1104, 1100, 1097, 1096, 27, 1095, 64,
-	1094, 18, 59, 1093, 9, 78, 33, 21, 5, 75,
-	63, 1089, 24, 60, 49, 1087, 1086, 460, 1085, 1084,
-	42, 1083, 1082, 28, 1081, 97, 388, 1080, 1079, 1077,
-	1076, 52, 0, 489, 137, 69, 1074, 1073, 1072, 1521,
-	38, 54, 17, 1071, 53, 20, 37, 1070, 1069, 34,
-	1068, 1067, 1061, 1060, 1058, 1057, 1055, 116, 1054, 1053,
-	1048, 19, 79, 1046, 1045, 51, 23, 1042, 1038, 1037,
-	47, 65, 1033, 1032, 48, 29, 1030, 1029, 1028, 1027,
-	1026, 31, 10, 1025, 12, 1024, 15, 1023, 30, 1021,
-	4, 1020, 8, 1019, 3, 1017, 6, 44, 1, 1016,
-	2, 1015, 1012, 61, 623, 76, 1008, 77,
+	0, 1245, 50, 902, 94, 1242, 1240, 1239, 1238, 60,
+	1236, 1235, 24, 1234, 1232, 3, 1229, 1226, 1224, 1222,
+	1221, 1220, 1218, 1217, 1216, 1215, 1214, 1213, 1212, 1210,
+	1205, 1204, 1202, 1201, 1200, 1198, 1184, 1183, 72, 1180,
+	1175, 1174, 74, 1171, 62, 1169, 1165, 33, 788, 47,
+	43, 86, 1164, 27, 68, 56, 1163, 39, 1162, 1158,
+	77, 1156, 1154, 55, 1153, 1150, 1398, 1148, 75, 1144,
+	13, 87, 1143, 1133, 1125, 1124, 1123, 987, 1122, 1119,
+	16, 1114, 1113, 102, 1109, 58, 6, 14, 19, 18,
+	1107, 32, 11, 1105, 57, 1104, 1103, 1102, 1100, 25,
+	1099, 49, 1098, 30, 46, 1097, 12, 70, 36, 28,
+	9, 78, 61, 1096, 20, 69, 54, 1091, 1074, 496,
+	1060, 1059, 63, 1058, 1057, 22, 1056, 131, 469, 1055,
+	1054, 1053, 1037, 37, 0, 588, 193, 76, 1034, 1031,
+	1029, 1621, 41, 53, 17, 1028, 45, 1485, 59, 1027,
+	1026, 42, 1025, 1024, 1022, 1020, 1018, 1015, 1014, 112,
+	1013, 1009, 1007, 83, 26, 1005, 1004, 64, 23, 1002,
+	999, 998, 48, 65, 994, 991, 52, 40, 989, 986,
+	985, 984, 983, 29, 21, 979, 15, 974, 10, 972,
+	34, 969, 5, 966, 8, 962, 4, 961, 7, 44,
+	1, 959, 2, 958, 955, 717, 287, 79, 954, 84,
 }
 var yyR1 = [...]int{
 
-	0, 191, 192, 192, 1, 1, 1, 1, 1, 1,
+	0, 203, 204, 204, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 6, 3, 4,
-	4, 5, 5, 7, 7, 29, 29, 8, 9, 9,
-	9, 9, 195, 195, 48, 48, 49, 49, 95, 95,
-	10, 10, 10, 10, 100, 100, 104, 104, 104, 105,
-	105, 105, 105, 137, 137, 11, 11, 11, 11, 11,
-	11, 11, 186, 186, 185, 184, 184, 183, 183, 182,
-	17, 167, 169, 169, 168, 168, 168, 168, 161, 140,
-	140, 140, 140, 143, 143, 141, 141, 141, 141, 141,
-	141, 141, 141, 141, 142, 142, 142, 142, 142, 144,
-	144, 144, 144, 144, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 146,
-	146, 146, 146, 146, 146, 146, 146, 160, 160, 147,
-	147, 155, 155, 156, 156, 156, 153, 153, 154, 154,
-	157, 157, 157, 149, 149, 150, 150, 158, 158, 151,
-	151, 151, 152, 152, 152, 159, 159, 159, 159, 159,
-	148, 148, 162, 162, 177, 177, 176, 176, 176, 166,
-	166, 173, 173, 173, 173, 173, 164, 164, 165, 165,
-	175, 175, 174, 163, 163, 178, 178, 178, 178, 189,
-	190, 188, 188, 188, 188, 188, 170, 170, 170, 171,
-	171, 171, 172, 172, 172, 12, 12, 12, 12, 12,
-	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 181, 179, 179, 180, 180, 13, 18, 18,
-	14, 14, 14, 14, 14, 15, 15, 19, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 111, 111, 109, 109,
-	112, 112, 110, 110, 110, 113, 113, 113, 114, 114,
-	138, 138, 138, 21, 21, 23, 23, 24, 25, 22,
-	22, 22, 22, 22, 22, 22, 16, 196, 26, 27,
-	27, 28, 28, 28, 32, 32, 32, 30, 30, 31,
-	31, 37, 37, 36, 36, 38, 38, 38, 38, 126,
-	126, 126, 125, 125, 40, 40, 41, 41, 42, 42,
-	43, 43, 43, 43, 57, 57, 94, 94, 96, 96,
-	44, 44, 44, 44, 45, 45, 46, 46, 47, 47,
-	133, 133, 132, 132, 132, 131, 131, 50, 50, 50,
-	52, 51, 51, 51, 51, 53, 53, 55, 55, 54,
-	54, 56, 58, 58, 58, 58, 59, 59, 39, 39,
-	39, 39, 39, 39, 39, 108, 108, 61, 61, 60,
-	60, 60, 60, 60, 60, 60, 60, 60, 60, 72,
-	72, 72, 72, 72, 72, 62, 62, 62, 62, 62,
-	62, 62, 35, 35, 73, 73, 73, 79, 74, 74,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 69, 69, 69, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 197, 197, 71, 70, 70, 70,
-	70, 70, 70, 33, 33, 33, 33, 33, 136, 136,
-	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
-	139, 139, 139, 83, 83, 34, 34, 81, 81, 82,
-	84, 84, 80, 80, 80, 64, 64, 64, 64, 64,
-	64, 64, 64, 66, 66, 66, 85, 85, 86, 86,
-	87, 87, 88, 88, 89, 90, 90, 90, 91, 91,
-	91, 91, 92, 92, 92, 63, 63, 63, 63, 63,
-	63, 93, 93, 93, 93, 97, 97, 75, 75, 77,
-	77, 76, 78, 98, 98, 102, 99, 99, 103, 103,
-	103, 103, 101, 101, 101, 128, 128, 128, 106, 106,
-	115, 115, 116, 116, 107, 107, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 118, 118, 118, 119,
-	119, 120, 120, 120, 127, 127, 123, 123, 124, 124,
-	129, 129, 130, 130, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	193, 194, 134, 135, 135, 135,
+	1, 1, 1, 1, 2, 2, 3, 3, 3, 7,
+	10, 10, 8, 8, 9, 11, 11, 18, 4, 5,
+	5, 6, 6, 19, 19, 41, 41, 20, 21, 21,
+	21, 21, 207, 207, 60, 60, 61, 61, 107, 107,
+	22, 22, 22, 22, 112, 112, 116, 116, 116, 117,
+	117, 117, 117, 149, 149, 23, 23, 23, 23, 23,
+	23, 23, 198, 198, 197, 196, 196, 195, 195, 194,
+	29, 179, 181, 181, 180, 180, 180, 180, 173, 152,
+	152, 152, 152, 155, 155, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 154, 154, 154, 154, 154, 156,
+	156, 156, 156, 156, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 157, 157, 158,
+	158, 158, 158, 158, 158, 158, 158, 172, 172, 159,
+	159, 167, 167, 168, 168, 168, 165, 165, 166, 166,
+	169, 169, 169, 161, 161, 162, 162, 170, 170, 163,
+	163, 163, 164, 164, 164, 171, 171, 171, 171, 171,
+	160, 160, 174, 174, 189, 189, 188, 188, 188, 178,
+	178, 185, 185, 185, 185, 185, 176, 176, 177, 177,
+	187, 187, 186, 175, 175, 190, 190, 190, 190, 201,
+	202, 200, 200, 200, 200, 200, 182, 182, 182, 183,
+	183, 183, 184, 184, 184, 24, 24, 24, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
+	199, 199, 193, 191, 191, 192, 192, 25, 30, 30,
+	26, 26, 26, 26, 26, 27, 27, 31, 32, 32,
+	32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 32, 32, 32, 123, 123, 121, 121,
+	124, 124, 122, 122, 122, 125, 125, 125, 126, 126,
+	150, 150, 150, 33, 33, 35, 35, 36, 37, 34,
+	34, 34, 34, 34, 34, 34, 28, 208, 38, 39,
+	39, 40, 40, 40, 44, 44, 44, 42, 42, 43,
+	43, 49, 49, 48, 48, 50, 50, 50, 50, 138,
+	138, 138, 137, 137, 52, 52, 53, 53, 54, 54,
+	55, 55, 55, 55, 69, 69, 106, 106, 108, 108,
+	56, 56, 56, 56, 57, 57, 58, 58, 59, 59,
+	145, 145, 144, 144, 144, 143, 143, 62, 62, 62,
+	64, 63, 63, 63, 63, 65, 65, 67, 67, 66,
+	66, 68, 70, 70, 70, 70, 71, 71, 51, 51,
+	51, 51, 51, 51, 51, 120, 120, 73, 73, 72,
+	72, 72, 72, 72, 72, 72, 72, 72, 72, 84,
+	84, 84, 84, 84, 84, 74, 74, 74, 74, 74,
+	74, 74, 47, 47, 85, 85, 85, 91, 86, 86,
+	77, 77, 77, 77, 77, 77, 77, 77, 77, 77,
+	77, 77, 77, 77, 77, 77, 77, 77, 77, 77,
+	77, 77, 77, 77, 77, 77, 77, 77, 77, 77,
+	77, 77, 81, 81, 81, 12, 12, 13, 13, 14,
+	14, 14, 16, 16, 15, 15, 15, 15, 15, 17,
+	17, 17, 79, 79, 79, 79, 79, 79, 79, 79,
+	79, 79, 79, 79, 79, 80, 80, 80, 80, 80,
+	80, 80, 80, 80, 80, 80, 80, 80, 80, 80,
+	80, 209, 209, 83, 82, 82, 82, 82, 82, 82,
+	45, 45, 45, 45, 45, 148, 148, 151, 151, 151,
+	151, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	95, 95, 46, 46, 93, 93, 94, 96, 96, 92,
+	92, 92, 76, 76, 76, 76, 76, 76, 76, 76,
+	78, 78, 78, 97, 97, 98, 98, 99, 99, 100,
+	100, 101, 102, 102, 102, 103, 103, 103, 103, 104,
+	104, 104, 75, 75, 75, 75, 75, 75, 105, 105,
+	105, 105, 109, 109, 87, 87, 89, 89, 88, 90,
+	110, 110, 114, 111, 111, 115, 115, 115, 115, 113,
+	113, 113, 140, 140, 140, 118, 118, 127, 127, 128,
+	128, 119, 119, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 130, 130, 130, 131, 131, 132, 132,
+	132, 139, 139, 135, 135, 136, 136, 141, 141, 142,
+	142, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 134, 134, 134, 134, 134, 134, 134,
+	134, 205, 206, 146, 147, 147, 147,
 }
 var yyR2 = [...]int{
 
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 4, 6, 7, 5, 10, 1,
+	1, 1, 1, 0, 1, 2, 4, 6, 7, 3,
+	0, 1, 1, 3, 4, 0, 3, 5, 10, 1,
 	3, 1, 3, 7, 8, 1, 1, 9, 8, 7,
 	6, 6, 1, 1, 1, 3, 1, 3, 0, 4,
 	3, 4, 5, 4, 1, 3, 3, 2, 2, 2,
@@ -2858,23 +2907,25 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	2, 2, 2, 2, 2, 2, 2, 3, 1, 1,
-	1, 1, 4, 5, 6, 4, 4, 6, 6, 6,
-	8, 8, 8, 8, 9, 7, 5, 4, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 8, 8, 0, 2, 3, 4, 4, 4,
-	4, 4, 4, 0, 3, 4, 7, 3, 1, 1,
-	2, 3, 3, 1, 2, 2, 1, 2, 1, 2,
-	2, 1, 2, 0, 1, 0, 2, 1, 2, 4,
-	0, 2, 1, 3, 5, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 0, 3, 0, 2,
-	0, 3, 1, 3, 2, 0, 1, 1, 0, 2,
-	4, 4, 0, 2, 4, 2, 1, 3, 5, 4,
-	6, 1, 3, 3, 5, 0, 5, 1, 3, 1,
-	2, 3, 1, 1, 3, 3, 1, 3, 3, 3,
-	3, 3, 1, 2, 1, 1, 1, 1, 1, 1,
-	0, 2, 0, 3, 0, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
-	1, 0, 1, 1, 0, 2, 1, 1, 1, 1,
+	1, 1, 5, 6, 6, 0, 6, 0, 3, 0,
+	2, 5, 1, 1, 2, 2, 2, 2, 2, 1,
+	1, 3, 4, 4, 6, 6, 6, 8, 8, 8,
+	8, 9, 7, 5, 4, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 8,
+	8, 0, 2, 3, 4, 4, 4, 4, 4, 4,
+	0, 3, 4, 7, 3, 1, 1, 2, 3, 3,
+	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
+	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
+	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 0, 3, 0, 2, 0, 3, 1,
+	3, 2, 0, 1, 1, 0, 2, 4, 4, 0,
+	2, 4, 2, 1, 3, 5, 4, 6, 1, 3,
+	3, 5, 0, 5, 1, 3, 1, 2, 3, 1,
+	1, 3, 3, 1, 3, 3, 3, 3, 3, 1,
+	2, 1, 1, 1, 1, 1, 1, 0, 2, 0,
+	3, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 0, 1, 1, 1, 1, 0, 1,
+	1, 0, 2, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2904,177 +2955,180 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 0, 1, 1,
+	1, 1, 1, 0, 0, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -191, -1, -2, -6, -7, -8, -9, -10, -11,
-	-12, -13, -14, -15, -19, -20, -21, -23, -24, -25,
-	-22, -16, -3, -4, 6, 7, -29, 9, 10, 30,
-	-17, 115, 116, 118, 117, 150, 119, 143, 50, 164,
-	165, 167, 168, 25, 144, 145, 148, 149, 31, 32,
-	121, -193, 8, 249, 54, -192, 347, -87, 15, -28,
-	5, -26, -196, -26, -26, -26, -26, -26, -167, -169,
-	54, 90, -120, 125, 72, 241, 122, 123, 129, -123,
-	57, -122, 259, 136, 291, 292, 164, 175, 169, 196,
-	188, 260, 293, 137, 186, 189, 228, 135, 294, 216,
-	223, 66, 167, 237, 295, 146, 184, 180, 296, 268,
-	178, 27, 297, 225, 201, 298, 264, 179, 224, 121,
-	299, 139, 133, 300, 202, 206, 301, 229, 302, 303,
-	304, 173, 174, 305, 231, 200, 134, 33, 261, 35,
-	154, 232, 204, 306, 199, 195, 307, 308, 309, 310,
-	198, 172, 194, 39, 208, 207, 209, 227, 191, 311,
-	312, 313, 140, 314, 181, 18, 315, 316, 317, 318,
-	319, 235, 149, 320, 152, 321, 322, 323, 324, 325,
-	326, 226, 203, 205, 130, 156, 263, 327, 233, 177,
-	328, 141, 153, 148, 236, 142, 329, 330, 331, 332,
-	333, 334, 335, 168, 336, 337, 338, 339, 163, 230,
-	239, 38, 213, 340, 171, 132, 341, 165, 160, 218,
-	192, 155, 342, 343, 182, 183, 197, 170, 193, 166,
-	157, 150, 344, 238, 214, 265, 190, 187, 161, 345,
-	158, 159, 346, 219, 220, 162, 262, 234, 185, 215,
-	-107, 125, 220, 127, 123, 123, 124, 125, 241, 122,
-	123, -54, -129, 57, -122, 125, 123, 108, 189, 228,
-	115, 217, 225, 124, 33, 226, 156, -138, 123, -109,
-	216, 219, 220, 162, 57, 230, 229, 221, -129, 166,
-	-134, -134, -134, -134, -134, 218, 218, -134, -2, -91,
-	17, 16, -5, -3, -193, 6, 20, 21, -32, 40,
-	41, -27, -38, 99, -39, -129, -60, 74, -65, 29,
-	57, -122, 23, -64, -61, -80, -78, -79, 108, 109,
-	110, 97, 98, 105, 75, 111, -69, -67, -68, -70,
-	59, 58, 67, 60, 61, 62, 63, 68, 69, 70,
-	-123, -76, -193, 44, 45, 250, 251, 252, 253, 258,
-	254, 77, 34, 240, 248, 247, 246, 244, 245, 242,
-	243, 256, 257, 128, 241, 103, 249, -107, -107, 11,
-	-48, -49, -54, -56, -129, -99, -137, 166, -103, 230,
-	229, -124, -101, -123, -121, 228, 189, 227, 120, 266,
-	73, 22, 24, 211, 76, 108, 16, 77, 107, 250,
-	115, 48, 267, 242, 243, 240, 252, 253, 241, 217,
-	29, 10, 269, 25, 144, 21, 101, 117, 80, 81,
-	147, 23, 145, 70, 272, 19, 51, 11, 13, 273,
-	274, 14, 128, 127, 92, 124, 46, 8, 111, 26,
-	89, 42, 275, 28, 276, 277, 278, 279, 44, 90,
-	17, 244, 245, 31, 280, 258, 151, 103, 49, 36,
-	74, 281, 282, 68, 283, 71, 52, 72, 15, 47,
-	284, 285, 286, 287, 91, 118, 249, 45, 288, 122,
-	6, 255, 30, 143, 43, 289, 123, 79, 256, 257,
-	126, 69, 5, 129, 32, 9, 50, 53, 246, 247,
-	248, 34, 78, 12, 290, -168, 90, -161, 57, -54,
-	124, -54, 249, -116, 128, -116, -116, 123, -54, 115,
-	117, 120, 52, -18, -54, -115, 128, 57, -115, -115,
-	-115, -54, 112, -54, 57, 30, -135, -193, -124, 241,
-	57, 156, 123, 157, 125, -135, -135, -135, -135, 160,
-	161, -135, -112, -111, 223, 224, 218, 222, 12, 161,
-	218, 159, -135, -134, -134, -194, 56, -92, 19, 31,
-	-39, -129, -88, -89, -39, -87, -2, -26, 36, -30,
-	21, 65, 11, -126, 73, 72, 89, -125, 22, -123,
-	59, 112, -39, -62, 92, 74, 90, 91, 76, 94,
-	93, 104, 97, 98, 99, 100, 101, 102, 103, 95,
-	96, 107, 82, 83, 84, 85, 86, 87, 88, -108,
-	-193, -79, -193, 113, 114, -65, -65, -65, -65, -65,
-	-65, -65, -65, -193, -2, -74, -39, -193, -193, -193,
-	-193, -193, -193, -193, -193, -193, -83, -39, -193, -197,
-	-71, -193, -197, -71, -197, -71, -197, -193, -197, -71,
-	-197, -71, -197, -197, -71, -193, -193, -193, -193, -193,
-	-193, -55, 26, -54, -41, -42, -43, -44, -57, -79,
-	-193, -54, -54, -48, -195, 55, 11, 53, -195, 55,
-	112, 55, -99, 166, -100, -104, 231, 233, 82, -128,
-	-123, 59, 29, 30, 56, 55, -54, -140, -143, -145,
-	-144, -146, -141, -142, 186, 187, 108, 190, 192, 193,
+	-1000, -203, -1, -2, -18, -19, -20, -21, -22, -23,
+	-24, -25, -26, -27, -31, -32, -33, -35, -36, -37,
+	-34, -28, -3, -7, 7, -41, 9, 10, 30, -29,
+	115, 116, 118, 117, 150, 119, 143, 50, 164, 165,
+	167, 168, 25, 144, 145, 148, 149, 31, 32, 121,
+	-4, -5, 6, 262, 8, 249, -205, 54, -204, 351,
+	-3, -38, -208, -38, -38, -38, -38, -179, -181, 54,
+	90, -132, 125, 72, 241, 122, 123, 129, -135, 57,
+	-134, 259, 136, 295, 296, 164, 175, 169, 196, 188,
+	260, 297, 137, 186, 189, 228, 135, 298, 216, 223,
+	66, 167, 237, 299, 267, 146, 184, 180, 300, 272,
+	178, 27, 301, 225, 201, 302, 264, 179, 224, 121,
+	303, 139, 133, 304, 202, 206, 305, 229, 306, 307,
+	308, 173, 174, 309, 231, 200, 134, 33, 261, 35,
+	154, 232, 204, 310, 199, 195, 311, 312, 313, 314,
+	198, 172, 194, 39, 208, 207, 209, 227, 191, 315,
+	316, 317, 140, 318, 181, 18, 319, 320, 321, 322,
+	323, 235, 149, 324, 152, 325, 326, 327, 328, 329,
+	330, 226, 203, 205, 130, 156, 263, 331, 233, 177,
+	332, 141, 153, 148, 236, 142, 333, 334, 335, 336,
+	337, 338, 339, 168, 268, 265, 340, 341, 342, 343,
+	163, 230, 239, 38, 213, 344, 171, 132, 345, 165,
+	160, 218, 192, 155, 346, 347, 182, 183, 197, 170,
+	193, 166, 157, 150, 348, 238, 214, 269, 190, 187,
+	161, 349, 158, 159, 350, 219, 220, 162, 234, 185,
+	215, -119, 125, 220, 127, 123, 123, 124, 125, 241,
+	122, 123, -66, -141, 57, -134, 125, 123, 108, 189,
+	228, 115, 217, 225, 124, 33, 226, 156, -150, 123,
+	-121, 216, 219, 220, 162, 57, 230, 229, 221, -141,
+	166, -146, -146, -146, -146, -146, 218, 218, -146, -99,
+	15, -40, 5, -38, -10, 291, -2, -50, 99, -51,
+	-141, -72, 74, -77, 29, 57, -134, 23, -76, -73,
+	-92, -90, -91, 108, 109, 110, 97, 98, 105, 75,
+	111, -81, -79, -80, -82, 59, 58, 67, 60, 61,
+	62, 63, 68, 69, 70, -135, -88, -205, 44, 45,
+	250, 251, 252, 253, 258, 254, 77, 34, 240, 248,
+	247, 246, 244, 245, 242, 243, 256, 257, 128, 241,
+	103, 249, -39, -119, -119, 11, -60, -61, -66, -68,
+	-141, -111, -149, 166, -115, 230, 229, -136, -113, -135,
+	-133, 228, 189, 227, 120, 270, 73, 22, 24, 211,
+	76, 108, 16, 77, 107, 250, 115, 48, 271, 242,
+	243, 240, 252, 253, 241, 217, 29, 10, 273, 25,
+	144, 21, 101, 117, 80, 81, 147, 23, 145, 70,
+	276, 19, 51, 11, 13, 277, 278, 14, 128, 127,
+	92, 124, 46, 8, 111, 26, 89, 42, 279, 28,
+	280, 281, 282, 283, 44, 90, 17, 244, 245, 31,
+	284, 258, 151, 103, 49, 36, 74, 285, 286, 68,
+	287, 71, 52, 72, 15, 47, 288, 289, 266, 290,
+	291, 91, 118, 249, 45, 292, 122, 6, 255, 30,
+	143, 43, 293, 123, 79, 256, 257, 126, 69, 5,
+	129, 32, 9, 50, 53, 246, 247, 248, 34, 78,
+	12, 294, 262, -180, 90, -173, 57, -66, 124, -66,
+	249, -128, 128, -128, -128, 123, -66, 115, 117, 120,
+	52, -30, -66, -127, 128, 57, -127, -127, -127, -66,
+	112, -66, 57, 30, -147, -205, -136, 241, 57, 156,
+	123, 157, 125, -147, -147, -147, -147, 160, 161, -147,
+	-124, -123, 223, 224, 218, 222, 12, 161, 218, 159,
+	-147, -146, -146, -103, 17, 16, -6, -4, -205, 6,
+	20, 21, -44, 40, 41, -8, -9, -141, -206, 56,
+	11, -138, 73, 72, 89, -137, 22, -135, 59, 112,
+	-51, -141, -74, 92, 74, 90, 91, 76, 94, 93,
+	104, 97, 98, 99, 100, 101, 102, 103, 95, 96,
+	107, 82, 83, 84, 85, 86, 87, 88, -120, -205,
+	-91, -205, 113, 114, -77, -77, -77, -77, -77, -77,
+	-77, -77, -205, -2, -86, -51, -205, -205, -205, -205,
+	-205, -205, -205, -205, -205, -95, -51, -205, -209, -83,
+	-205, -209, -83, -209, -83, -209, -205, -209, -83, -209,
+	-83, -209, -209, -83, -205, -205, -205, -205, -205, -205,
+	65, -67, 26, -66, -53, -54, -55, -56, -69, -91,
+	-205, -66, -66, -60, -207, 55, 11, 53, -207, 55,
+	112, 55, -111, 166, -112, -116, 231, 233, 82, -140,
+	-135, 59, 29, 30, 56, 55, -66, -152, -155, -157,
+	-156, -158, -153, -154, 186, 187, 108, 190, 192, 193,
 	194, 195, 196, 197, 198, 199, 200, 201, 30, 146,
 	182, 183, 184, 185, 202, 203, 204, 205, 206, 207,
 	208, 209, 169, 188, 260, 170, 171, 172, 173, 174,
-	175, 177, 178, 179, 180, 181, 57, -135, 125, 57,
-	74, 57, -54, -54, -135, 158, 158, 123, 123, 163,
-	-54, 55, 126, -48, 23, 52, -54, 57, 57, -130,
-	-129, -121, -135, -135, -135, -135, -135, -54, -135, -135,
-	-135, -135, 11, -110, 11, 92, -39, -114, 90, 52,
-	9, 92, 55, 18, 112, 55, -90, 24, 25, -91,
-	-194, -32, -66, -123, 60, 63, -31, 43, -54, -39,
-	-39, -72, 68, 74, 69, 70, -125, 99, -130, -124,
-	-121, -65, -73, -76, -79, 64, 92, 90, 91, 76,
-	-65, -65, -65, -65, -65, -65, -65, -65, -65, -65,
-	-65, -65, -65, -65, -65, -136, 57, 59, 57, -64,
-	-64, -123, -37, 21, -36, -38, -194, 55, -194, -2,
-	-36, -36, -39, -39, -80, 59, -36, -80, 59, -36,
-	-36, -30, -81, -82, 78, -80, -123, -129, -194, -65,
-	-123, -123, -36, -37, -36, -36, -95, 152, -54, 30,
-	55, -50, -52, -51, -53, 42, 46, 48, 43, 44,
-	45, 49, -133, 22, -41, -193, -132, 152, -131, 22,
-	-129, 59, -95, 53, -41, -54, -41, -56, -129, 99,
-	-103, -100, 55, 232, 234, 235, 52, 71, -39, -152,
-	107, -170, -171, -172, -124, 59, 60, -161, -162, -163,
-	-173, 138, -178, 130, 132, 129, -164, 139, 124, 28,
-	56, -157, 68, 74, -153, 214, -147, 54, -147, -147,
-	-147, -147, -151, 189, -151, -151, -151, 54, 54, -147,
-	-147, -147, -155, 54, -155, -155, -156, 54, -156, -127,
-	53, -54, -135, 23, -135, -117, 120, 117, 118, -181,
-	116, 211, 189, 66, 29, 15, 250, 152, 265, 57,
-	153, -54, -54, -54, -54, -54, 120, 117, -54, -54,
-	-54, -135, -54, -113, 90, 12, -129, -129, 59, -54,
-	38, -39, -39, -130, -89, -92, -106, 19, 11, 34,
-	34, -36, 68, 69, 70, 112, -193, -73, -65, -65,
-	-65, -35, 147, 73, -194, -194, -36, 55, -39, -194,
-	-194, -194, 55, 53, 22, 11, 11, -194, 11, 11,
-	-194, -194, -36, -84, -82, 80, -39, -194, 112, -194,
-	55, 55, -194, -194, -194, -194, -63, 30, 34, -2,
-	-193, -193, -98, -102, -80, -42, -43, -43, -42, -43,
-	42, 42, 42, 47, 42, 47, 42, -51, -129, -194,
-	-58, 50, 127, 51, -193, -131, -59, 12, -41, -59,
-	-59, 112, -104, -105, 236, 233, 239, 57, 59, 55,
-	-172, 82, 54, 57, 28, -164, -164, -165, 57, -165,
-	28, -149, 29, 68, -154, 215, 60, -151, -151, -152,
-	30, -152, -152, -152, -160, 59, -160, 60, 60, 52,
-	-123, -135, -134, -187, 135, 131, 138, 139, 133, 57,
-	124, 28, 130, 132, 152, 129, -187, -118, -119, 126,
-	22, 124, 28, 152, -186, 53, 158, 211, 158, 126,
-	-135, -110, 59, -39, 39, 112, -54, -40, 11, 99,
-	-124, -37, -35, 73, -65, -65, -194, -38, -139, 108,
-	186, 146, 184, 180, 200, 191, 213, 182, 214, -136,
-	-139, -65, -65, -65, -65, 259, -87, 81, -39, 79,
-	-124, -65, -65, -97, 52, -98, -75, -77, -76, -193,
-	-2, -93, -123, -96, -123, -59, 55, 82, -46, -45,
-	52, 53, -47, 52, -45, 42, 42, 124, 124, 124,
-	-96, -87, -39, -59, 233, 237, 238, -171, -172, -175,
-	-174, -123, -178, -165, -165, 54, -150, 52, -65, 56,
-	-152, -152, 57, 108, 56, 55, 56, 55, 56, 55,
-	-54, -134, -134, -54, -134, -123, -184, 262, -185, 57,
-	-123, -123, -123, -54, -113, -59, -41, -194, -65, -194,
-	-147, -147, -147, -156, -147, 174, -147, 174, -194, -194,
-	19, 19, 19, 19, -193, -34, 255, -39, 55, 55,
-	27, -97, 55, -194, -194, -194, 55, 112, -194, 55,
-	-87, -102, -39, -39, 54, -39, -193, -193, -193, -194,
-	-91, 56, 55, -147, -94, -123, -158, 211, 9, -151,
-	59, -151, 60, 60, -135, 26, -183, -182, -124, 54,
-	53, -85, 13, -151, 57, -65, -65, -65, -65, -65,
-	-194, 59, -65, -65, 28, -77, 34, -2, -193, -123,
-	-123, -123, -91, -94, -94, -94, -94, -132, -177, -176,
-	53, 134, 66, -174, 56, 55, -159, 130, 28, 129,
-	-68, -152, -152, 56, 56, -193, 55, 82, -94, -54,
-	-86, 14, 16, -194, -194, -194, -194, -33, 92, 262,
-	-194, -194, 9, -75, -2, 112, 56, -194, -194, -194,
-	-58, -176, 57, -166, 82, 59, 141, -123, -148, 66,
-	28, 28, -179, -180, 152, -182, -172, 56, -39, -74,
-	-194, 260, 49, 263, -98, -194, -123, 60, -54, 59,
-	-194, 55, -123, -186, 39, 261, 264, 54, -180, 34,
-	-184, 39, -94, 154, 262, 56, 155, 263, -189, -190,
-	52, -193, 264, -190, 52, 10, 9, -65, 151, -188,
-	142, 137, 140, 30, -188, -194, -194, 136, 29, 68,
+	175, 177, 178, 179, 180, 181, 57, -147, 125, 57,
+	74, 57, -66, -66, -147, 158, 158, 123, 123, 163,
+	-66, 55, 126, -60, 23, 52, -66, 57, 57, -142,
+	-141, -133, -147, -147, -147, -147, -147, -66, -147, -147,
+	-147, -147, 11, -122, 11, 92, -51, -126, 90, 52,
+	-104, 19, 31, -51, -100, -101, -51, -99, -2, -38,
+	36, -42, 21, 55, -11, -205, -66, -51, -51, -84,
+	68, 74, 69, 70, -137, 99, -142, -136, -133, 112,
+	-77, -85, -88, -91, 64, 92, 90, 91, 76, -77,
+	-77, -77, -77, -77, -77, -77, -77, -77, -77, -77,
+	-77, -77, -77, -77, -148, 57, 59, 57, 59, 59,
+	-135, -49, 21, -48, -50, -206, 55, -206, -2, -48,
+	-48, -51, -51, -92, 59, -48, -92, 59, -48, -48,
+	-42, -93, -94, 78, -92, -135, -141, -206, -77, -135,
+	-135, -48, -49, -48, -48, -107, 152, -66, 30, 55,
+	-62, -64, -63, -65, 42, 46, 48, 43, 44, 45,
+	49, -145, 22, -53, -205, -144, 152, -143, 22, -141,
+	59, -107, 53, -53, -66, -53, -68, -141, 99, -115,
+	-112, 55, 232, 234, 235, 52, 71, -51, -164, 107,
+	-182, -183, -184, -136, 59, 60, -173, -174, -175, -185,
+	138, -190, 130, 132, 129, -176, 139, 124, 28, 56,
+	-169, 68, 74, -165, 214, -159, 54, -159, -159, -159,
+	-159, -163, 189, -163, -163, -163, 54, 54, -159, -159,
+	-159, -167, 54, -167, -167, -168, 54, -168, -139, 53,
+	-66, -147, 23, -147, -129, 120, 117, 118, -193, 116,
+	211, 189, 66, 29, 15, 250, 152, 269, 57, 153,
+	-66, -66, -66, -66, -66, 120, 117, -66, -66, -66,
+	-147, -66, -125, 90, 12, -141, -141, 59, -66, 9,
+	92, 55, 18, 55, -102, 24, 25, -103, -206, -44,
+	-78, -135, 60, 63, -43, 43, -9, 22, -106, -135,
+	68, 69, 70, 112, -205, -142, -85, -77, -77, -77,
+	-47, 147, 73, -206, -206, -48, 55, -51, -206, -206,
+	-206, 55, 53, 22, 11, 11, -206, 11, 11, -206,
+	-206, -48, -96, -94, 80, -51, -206, 112, -206, 55,
+	55, -206, -206, -206, -206, -75, 30, 34, -2, -205,
+	-205, -110, -114, -92, -54, -55, -55, -54, -55, 42,
+	42, 42, 47, 42, 47, 42, -63, -141, -206, -70,
+	50, 127, 51, -205, -143, -71, 12, -53, -71, -71,
+	112, -116, -117, 236, 233, 239, 57, 59, 55, -184,
+	82, 54, 57, 28, -176, -176, -177, 57, -177, 28,
+	-161, 29, 68, -166, 215, 60, -163, -163, -164, 30,
+	-164, -164, -164, -172, 59, -172, 60, 60, 52, -135,
+	-147, -146, -199, 135, 131, 138, 139, 133, 57, 124,
+	28, 130, 132, 152, 129, -199, -130, -131, 126, 22,
+	124, 28, 152, -198, 53, 158, 211, 158, 126, -147,
+	-122, 59, -51, 38, -51, -51, -101, -104, -118, 19,
+	11, 34, 34, -48, -91, -206, 55, 99, -136, -49,
+	112, -47, 73, -77, -77, -12, 288, -206, -50, -151,
+	108, 186, 146, 184, 180, 200, 191, 213, 182, 214,
+	-148, -151, -77, -77, -77, -77, 259, -99, 81, -51,
+	79, -136, -77, -77, -109, 52, -110, -87, -89, -88,
+	-205, -2, -105, -135, -108, -135, -71, 55, 82, -58,
+	-57, 52, 53, -59, 52, -57, 42, 42, 124, 124,
+	124, -108, -99, -51, -71, 233, 237, 238, -183, -184,
+	-187, -186, -135, -190, -177, -177, 54, -162, 52, -77,
+	56, -164, -164, 57, 108, 56, 55, 56, 55, 56,
+	55, -66, -146, -146, -66, -146, -135, -196, 262, -197,
+	57, -135, -135, -135, -66, -125, 39, -66, -52, 11,
+	-135, -206, -77, -205, -12, -206, -159, -159, -159, -168,
+	-159, 174, -159, 174, -206, -206, 19, 19, 19, 19,
+	-205, -46, 255, -51, 55, 55, 27, -109, 55, -206,
+	-206, -206, 55, 112, -206, 55, -99, -114, -51, -51,
+	54, -51, -205, -205, -205, -206, -103, 56, 55, -159,
+	-106, -170, 211, 9, -163, 59, -163, 60, 60, -147,
+	26, -195, -194, -136, 54, 53, -71, -53, -13, 152,
+	-163, 57, -77, -77, -77, -77, -77, -206, 59, -77,
+	-77, 28, -89, 34, -2, -205, -135, -135, -135, -103,
+	-106, -106, -106, -106, -144, -189, -188, 53, 134, 66,
+	-186, 56, -171, 130, 28, 129, -80, -164, -164, 56,
+	56, -205, 55, 82, -106, -66, -97, 13, -99, 16,
+	-206, -206, -206, -206, -45, 92, 262, -206, -206, 9,
+	-87, -2, 112, 56, -206, -206, -206, -70, -188, 57,
+	-178, 82, 59, 141, -160, 66, 28, 28, -191, -192,
+	152, -194, -184, 56, -98, 14, 16, -14, -16, 265,
+	266, -86, -206, 260, 49, 263, -110, -206, -135, 60,
+	-66, 59, -206, 55, -135, -198, -51, -86, -206, -15,
+	76, 267, 348, -17, 60, 63, 111, 39, 261, 264,
+	54, -192, 34, -196, -15, 268, 328, 303, 328, 303,
+	-77, 39, -106, 154, 73, -135, 262, 56, 155, -15,
+	263, -201, -202, 52, -205, 264, -202, 52, 10, 9,
+	-77, 151, -200, 142, 137, 140, 30, -200, -206, -206,
+	136, 29, 68,
 }
 var yyDef = [...]int{
 
-	23, -2, 2, -2, 5, 6, 7, 8, 9, 10,
+	23, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 560, 0, 317, 317, 317, 317, 317, 317,
-	0, 631, 614, 0, 0, 0, 0, -2, 304, 305,
-	0, 307, 308, 932, 932, 932, 932, 932, 0, 0,
-	932, 0, 35, 36, 930, 1, 3, 568, 0, 0,
-	321, 324, 319, 0, 614, 614, 0, 0, 65, 66,
-	0, 0, 0, 919, 0, 612, 612, 612, 632, 633,
-	636, 637, 761, 762, 763, 764, 765, 766, 767, 768,
-	769, 770, 771, 772, 773, 774, 775, 776, 777, 778,
-	779, 780, 781, 782, 783, 784, 785, 786, 787, 788,
-	789, 790, 791, 792, 793, 794, 795, 796, 797, 798,
+	21, 22, -2, 0, 327, 327, 327, 327, 327, 0,
+	658, 641, 0, 0, 0, 0, -2, 314, 315, 0,
+	317, 318, 963, 963, 963, 963, 963, 0, 0, 963,
+	587, 0, 327, 30, 45, 46, 0, 961, 1, 3,
+	-2, 0, 329, 641, 641, 0, 0, 75, 76, 0,
+	0, 0, 951, 0, 639, 639, 639, 659, 660, 663,
+	664, 790, 791, 792, 793, 794, 795, 796, 797, 798,
 	799, 800, 801, 802, 803, 804, 805, 806, 807, 808,
 	809, 810, 811, 812, 813, 814, 815, 816, 817, 818,
 	819, 820, 821, 822, 823, 824, 825, 826, 827, 828,
@@ -3087,134 +3141,143 @@ var yyDef = [...]int{
 	889, 890, 891, 892, 893, 894, 895, 896, 897, 898,
 	899, 900, 901, 902, 903, 904, 905, 906, 907, 908,
 	909, 910, 911, 912, 913, 914, 915, 916, 917, 918,
-	920, 921, 922, 923, 924, 925, 926, 927, 928, 929,
-	0, 0, 0, 615, 0, 610, 0, 610, 610, 610,
-	0, 256, 389, 640, 641, 919, 0, 0, 0, 933,
-	0, 933, 268, 933, 933, 271, 933, 0, 933, 0,
-	278, 0, 0, 284, 933, 301, 302, 289, 303, 306,
-	309, 310, 311, 312, 313, 932, 932, 316, 29, 572,
-	0, 0, 560, 31, 0, 317, 322, 323, 327, 325,
-	326, 318, 0, 335, 339, 0, 398, 0, 403, 405,
-	-2, -2, 0, 440, 441, 442, 443, 444, 0, 0,
-	0, 0, 0, 0, 0, 0, 468, 469, 470, 471,
-	545, 546, 547, 548, 549, 550, 551, 552, 407, 408,
-	542, 592, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 533, 0, 504, 504, 504, 504, 504, 504, 504,
-	504, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 44, 46, 389, 50, 0, 908, 596, -2,
-	-2, 0, 0, 638, 639, -2, 774, -2, 644, 645,
-	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
-	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
-	666, 667, 668, 669, 670, 671, 672, 673, 674, 675,
-	676, 677, 678, 679, 680, 681, 682, 683, 684, 685,
-	686, 687, 688, 689, 690, 691, 692, 693, 694, 695,
-	696, 697, 698, 699, 700, 701, 702, 703, 704, 705,
-	706, 707, 708, 709, 710, 711, 712, 713, 714, 715,
-	716, 717, 718, 719, 720, 721, 722, 723, 724, 725,
-	726, 727, 728, 729, 730, 731, 732, 733, 734, 735,
-	736, 737, 738, 739, 740, 741, 742, 743, 744, 745,
-	746, 747, 748, 749, 750, 751, 752, 753, 754, 755,
-	756, 757, 758, 759, 760, 0, 0, 84, 0, 82,
-	0, 933, 0, 0, 0, 0, 0, 0, 933, 0,
-	0, 0, 0, 247, 0, 0, 0, 0, 0, 0,
-	0, 255, 0, 257, 933, 933, 260, 934, 935, 933,
-	933, 933, 0, 933, 933, 267, 269, 270, 272, 933,
-	933, 274, 0, 292, 290, 291, 286, 287, 0, 298,
-	281, 282, 285, 314, 315, 30, 931, 24, 0, 0,
-	569, 0, 561, 562, 565, 568, 29, 324, 0, 329,
-	328, 320, 0, 336, 0, 0, 0, 340, 0, 342,
-	343, 0, 401, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 425, 426, 427, 428, 429, 430, 431, 404,
-	0, 418, 0, 0, 0, 460, 461, 462, 463, 464,
-	465, 466, 0, 331, 29, 0, 438, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 0, 534, 0, 488,
-	496, 0, 489, 497, 490, 498, 491, 0, 492, 499,
-	493, 500, 494, 495, 501, 0, 0, 0, 331, 0,
-	0, 48, 0, 388, 0, 346, 348, 349, 350, -2,
-	0, 372, -2, 0, 0, 0, 42, 43, 0, 0,
-	0, 0, 51, 908, 53, 54, 0, 0, 0, 162,
-	605, 606, 607, 603, 206, 0, 0, 150, 146, 90,
-	91, 92, 139, 94, 139, 139, 139, 139, 159, 159,
-	159, 159, 122, 123, 124, 125, 126, 0, 0, 109,
-	139, 139, 139, 113, 129, 130, 131, 132, 133, 134,
-	135, 136, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 141, 141, 141, 143, 143, 634, 68, 0, 933,
-	0, 933, 80, 0, 220, 0, 0, 0, 0, 0,
-	0, 0, 0, 250, 611, 0, 933, 253, 254, 390,
-	642, 643, 258, 259, 261, 262, 263, 264, 265, 266,
-	273, 277, 0, 295, 0, 0, 279, 280, 0, 0,
-	573, 0, 0, 0, 0, 0, 564, 566, 567, 572,
-	32, 327, 0, 553, 0, 0, 0, 330, 27, 399,
-	400, 402, 419, 0, 421, 423, 341, 337, 0, 543,
-	-2, 409, 410, 434, 435, 436, 0, 0, 0, 0,
-	432, 414, 0, 445, 446, 447, 448, 449, 450, 451,
-	452, 453, 454, 455, 456, 459, 518, 519, 0, 457,
-	458, 467, 0, 0, 332, 333, 437, 0, 591, 29,
-	0, 0, 0, 0, 442, 545, 0, 442, 545, 0,
-	0, 0, 540, 537, 0, 0, 542, 0, 505, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 387, 0,
-	0, 0, 0, 0, 0, 377, 0, 0, 380, 0,
-	0, 0, 0, 371, 0, 0, 392, 853, 373, 0,
-	375, 376, 396, 0, 396, 45, 396, 47, 0, 391,
-	597, 52, 0, 0, 57, 58, 598, 599, 600, 601,
-	0, 81, 207, 209, 212, 213, 214, 85, 86, 87,
-	0, 0, 194, 0, 0, 188, 188, 0, 186, 187,
-	83, 153, 151, 0, 148, 147, 93, 0, 159, 159,
-	116, 117, 162, 0, 162, 162, 162, 0, 0, 110,
-	111, 112, 104, 0, 105, 106, 107, 0, 108, 0,
-	0, 933, 70, 613, 71, 932, 0, 0, 626, 221,
-	616, 617, 618, 619, 620, 621, 622, 623, 624, 625,
-	0, 72, 223, 225, 224, 228, 0, 0, 0, 248,
-	933, 252, 292, 276, 0, 0, 293, 294, 299, 283,
-	0, 570, 571, 0, 563, 25, 0, 608, 609, 554,
-	555, 344, 420, 422, 424, 0, 331, 411, 432, 415,
-	0, 412, 0, 0, 406, 472, 0, 0, 439, -2,
-	475, 476, 0, 0, 0, 0, 0, 511, 0, 0,
-	512, 0, 560, 0, 538, 0, 0, 487, 0, 506,
-	0, 0, 507, 508, 509, 510, 585, 0, 0, -2,
-	0, 0, 396, 593, 0, 347, 366, 368, 0, 363,
-	378, 379, 381, 0, 383, 0, 385, 386, 351, 353,
-	354, 0, 0, 0, 0, 374, 560, 0, 396, 40,
-	41, 0, 55, 56, 0, 0, 62, 163, 164, 0,
-	210, 0, 0, 0, 181, 188, 188, 184, 189, 185,
-	0, 155, 0, 152, 89, 149, 0, 162, 162, 118,
-	0, 119, 120, 121, 0, 137, 0, 0, 0, 0,
-	635, 69, 215, 932, 230, 231, 232, 233, 234, 235,
-	236, 237, 238, 239, 240, 241, 932, 0, 932, 627,
-	628, 629, 630, 0, 75, 0, 0, 0, 0, 0,
-	251, 295, 296, 297, 574, 0, 26, 396, 0, 338,
-	544, 0, 413, 0, 433, 416, 473, 334, 0, 139,
-	139, 523, 139, 143, 526, 139, 528, 139, 531, 0,
-	0, 0, 0, 0, 0, 0, 535, 486, 541, 0,
-	543, 0, 0, 33, 0, 585, 575, 587, 589, 0,
-	29, 0, 581, 0, 358, 560, 0, 0, 360, 367,
-	0, 0, 361, 0, 362, 382, 384, 0, 0, 0,
-	0, 568, 397, 39, 59, 60, 61, 208, 211, 0,
-	190, 139, 193, 182, 183, 0, 157, 0, 154, 140,
-	114, 115, 160, 161, 159, 0, 159, 0, 144, 0,
-	933, 216, 217, 218, 219, 0, 222, 0, 73, 74,
-	0, 0, 227, 249, 275, 556, 345, 474, 417, 477,
-	520, 159, 524, 525, 527, 529, 530, 532, 479, 478,
-	0, 0, 0, 0, 0, 0, 0, 539, 0, 0,
-	0, 34, 0, 590, -2, 0, 0, 0, 49, 0,
-	568, 594, 595, 364, 0, 369, 0, 0, 0, 372,
-	38, 173, 0, 192, 0, 356, 165, 158, 0, 162,
-	138, 162, 0, 0, 67, 0, 76, 77, 0, 0,
-	0, 558, 0, 521, 522, 0, 0, 0, 0, 513,
-	485, 536, 0, 0, 0, 588, 0, -2, 0, 583,
-	582, 359, 37, 0, 0, 0, 0, 392, 172, 174,
-	0, 179, 0, 191, 0, 0, 170, 0, 167, 169,
-	156, 127, 128, 142, 145, 0, 0, 0, 0, 229,
-	28, 0, 0, 480, 482, 481, 483, 0, 0, 0,
-	502, 503, 0, 578, 29, 0, 365, 393, 394, 395,
-	355, 175, 176, 0, 180, 178, 0, 357, 88, 0,
-	166, 168, 0, 243, 0, 78, 79, 72, 559, 557,
-	484, 0, 0, 0, 586, -2, 584, 177, 0, 171,
-	242, 0, 0, 75, 514, 0, 517, 0, 244, 0,
-	226, 515, 0, 0, 0, 195, 0, 0, 196, 197,
-	0, 0, 516, 198, 0, 0, 0, 0, 0, 199,
-	201, 202, 0, 0, 200, 245, 246, 203, 204, 205,
+	919, 920, 921, 922, 923, 924, 925, 926, 927, 928,
+	929, 930, 931, 932, 933, 934, 935, 936, 937, 938,
+	939, 940, 941, 942, 943, 944, 945, 946, 947, 948,
+	949, 950, 952, 953, 954, 955, 956, 957, 958, 959,
+	960, 0, 0, 0, 642, 0, 637, 0, 637, 637,
+	637, 0, 266, 399, 667, 668, 951, 0, 0, 0,
+	964, 0, 964, 278, 964, 964, 281, 964, 0, 964,
+	0, 288, 0, 0, 294, 964, 311, 312, 299, 313,
+	316, 319, 320, 321, 322, 323, 963, 963, 326, 595,
+	0, 0, 331, 334, 0, 31, 0, 0, 345, 349,
+	0, 408, 0, 413, 415, -2, -2, 0, 450, 451,
+	452, 453, 454, 0, 0, 0, 0, 0, 0, 0,
+	0, 478, 479, 480, 481, 572, 573, 574, 575, 576,
+	577, 578, 579, 417, 418, 569, 619, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 560, 0, 531, 531,
+	531, 531, 531, 531, 531, 531, 0, 0, 0, 0,
+	0, 0, 328, 0, 0, 0, 0, 0, 54, 56,
+	399, 60, 0, 940, 623, -2, -2, 0, 0, 665,
+	666, -2, 803, -2, 671, 672, 673, 674, 675, 676,
+	677, 678, 679, 680, 681, 682, 683, 684, 685, 686,
+	687, 688, 689, 690, 691, 692, 693, 694, 695, 696,
+	697, 698, 699, 700, 701, 702, 703, 704, 705, 706,
+	707, 708, 709, 710, 711, 712, 713, 714, 715, 716,
+	717, 718, 719, 720, 721, 722, 723, 724, 725, 726,
+	727, 728, 729, 730, 731, 732, 733, 734, 735, 736,
+	737, 738, 739, 740, 741, 742, 743, 744, 745, 746,
+	747, 748, 749, 750, 751, 752, 753, 754, 755, 756,
+	757, 758, 759, 760, 761, 762, 763, 764, 765, 766,
+	767, 768, 769, 770, 771, 772, 773, 774, 775, 776,
+	777, 778, 779, 780, 781, 782, 783, 784, 785, 786,
+	787, 788, 789, 0, 0, 94, 0, 92, 0, 964,
+	0, 0, 0, 0, 0, 0, 964, 0, 0, 0,
+	0, 257, 0, 0, 0, 0, 0, 0, 0, 265,
+	0, 267, 964, 964, 270, 965, 966, 964, 964, 964,
+	0, 964, 964, 277, 279, 280, 282, 964, 964, 284,
+	0, 302, 300, 301, 296, 297, 0, 308, 291, 292,
+	295, 324, 325, 599, 0, 0, 587, 41, 0, 327,
+	332, 333, 337, 335, 336, 29, 32, 35, 40, 962,
+	0, 346, 0, 0, 0, 350, 0, 352, 353, 0,
+	411, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 435, 436, 437, 438, 439, 440, 441, 414, 0,
+	428, 0, 0, 0, 470, 471, 472, 473, 474, 475,
+	476, 0, 341, 0, 0, 448, 0, 0, 0, 0,
+	0, 0, 0, 0, 337, 0, 561, 0, 515, 523,
+	0, 516, 524, 517, 525, 518, 0, 519, 526, 520,
+	527, 521, 522, 528, 0, 0, 0, 341, 0, 0,
+	330, 58, 0, 398, 0, 356, 358, 359, 360, -2,
+	0, 382, -2, 0, 0, 0, 52, 53, 0, 0,
+	0, 0, 61, 940, 63, 64, 0, 0, 0, 172,
+	632, 633, 634, 630, 216, 0, 0, 160, 156, 100,
+	101, 102, 149, 104, 149, 149, 149, 149, 169, 169,
+	169, 169, 132, 133, 134, 135, 136, 0, 0, 119,
+	149, 149, 149, 123, 139, 140, 141, 142, 143, 144,
+	145, 146, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 151, 151, 151, 153, 153, 661, 78, 0, 964,
+	0, 964, 90, 0, 230, 0, 0, 0, 0, 0,
+	0, 0, 0, 260, 638, 0, 964, 263, 264, 400,
+	669, 670, 268, 269, 271, 272, 273, 274, 275, 276,
+	283, 287, 0, 305, 0, 0, 289, 290, 0, 0,
+	26, 0, 0, 596, 588, 589, 592, 595, 0, 334,
+	0, 339, 338, 0, 0, 0, 37, 409, 410, 412,
+	429, 0, 431, 433, 351, 347, 0, 570, -2, 0,
+	419, 420, 444, 445, 446, 0, 0, 0, 0, 442,
+	424, 0, 455, 456, 457, 458, 459, 460, 461, 462,
+	463, 464, 465, 466, 469, 545, 546, 0, 467, 468,
+	477, 0, 0, 342, 343, 447, 0, 618, 0, 0,
+	0, 0, 0, 452, 572, 0, 452, 572, 0, 0,
+	0, 567, 564, 0, 0, 569, 0, 532, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 397, 0, 0,
+	0, 0, 0, 0, 387, 0, 0, 390, 0, 0,
+	0, 0, 381, 0, 0, 402, 883, 383, 0, 385,
+	386, 406, 0, 406, 55, 406, 57, 0, 401, 624,
+	62, 0, 0, 67, 68, 625, 626, 627, 628, 0,
+	91, 217, 219, 222, 223, 224, 95, 96, 97, 0,
+	0, 204, 0, 0, 198, 198, 0, 196, 197, 93,
+	163, 161, 0, 158, 157, 103, 0, 169, 169, 126,
+	127, 172, 0, 172, 172, 172, 0, 0, 120, 121,
+	122, 114, 0, 115, 116, 117, 0, 118, 0, 0,
+	964, 80, 640, 81, 963, 0, 0, 653, 231, 643,
+	644, 645, 646, 647, 648, 649, 650, 651, 652, 0,
+	82, 233, 235, 234, 238, 0, 0, 0, 258, 964,
+	262, 302, 286, 0, 0, 303, 304, 309, 293, 600,
+	0, 0, 0, 0, 591, 593, 594, 599, 42, 337,
+	0, 580, 0, 0, 0, 340, 33, 0, 0, 366,
+	430, 432, 434, 0, 341, 0, 421, 442, 425, 0,
+	422, 0, 0, 416, 485, 0, 0, 449, -2, 502,
+	503, 0, 0, 0, 0, 0, 538, 0, 0, 539,
+	0, 587, 0, 565, 0, 0, 514, 0, 533, 0,
+	0, 534, 535, 536, 537, 612, 0, 0, 603, 0,
+	0, 406, 620, 0, 357, 376, 378, 0, 373, 388,
+	389, 391, 0, 393, 0, 395, 396, 361, 363, 364,
+	0, 0, 0, 0, 384, 587, 0, 406, 50, 51,
+	0, 65, 66, 0, 0, 72, 173, 174, 0, 220,
+	0, 0, 0, 191, 198, 198, 194, 199, 195, 0,
+	165, 0, 162, 99, 159, 0, 172, 172, 128, 0,
+	129, 130, 131, 0, 147, 0, 0, 0, 0, 662,
+	79, 225, 963, 240, 241, 242, 243, 244, 245, 246,
+	247, 248, 249, 250, 251, 963, 0, 963, 654, 655,
+	656, 657, 0, 85, 0, 0, 0, 0, 0, 261,
+	305, 306, 307, 0, 597, 598, 590, 27, 0, 635,
+	636, 581, 582, 354, 34, 36, 0, 348, 571, 0,
+	0, 423, 0, 443, 426, 482, 0, 485, 344, 0,
+	149, 149, 550, 149, 153, 553, 149, 555, 149, 558,
+	0, 0, 0, 0, 0, 0, 0, 562, 513, 568,
+	0, 570, 0, 0, 43, 0, 612, 602, 614, 616,
+	0, 0, 0, 608, 0, 368, 587, 0, 0, 370,
+	377, 0, 0, 371, 0, 372, 392, 394, 0, 0,
+	0, 0, 595, 407, 49, 69, 70, 71, 218, 221,
+	0, 200, 149, 203, 192, 193, 0, 167, 0, 164,
+	150, 124, 125, 170, 171, 169, 0, 169, 0, 154,
+	0, 964, 226, 227, 228, 229, 0, 232, 0, 83,
+	84, 0, 0, 237, 259, 285, 601, 28, 406, 0,
+	367, 484, 427, 487, 483, 504, 547, 169, 551, 552,
+	554, 556, 557, 559, 506, 505, 0, 0, 0, 0,
+	0, 0, 0, 566, 0, 0, 0, 44, 0, 617,
+	-2, 0, 0, 0, 59, 0, 595, 621, 622, 374,
+	0, 379, 0, 0, 0, 382, 48, 183, 0, 202,
+	0, 175, 168, 0, 172, 148, 172, 0, 0, 77,
+	0, 86, 87, 0, 0, 0, 583, 355, 587, 0,
+	548, 549, 0, 0, 0, 0, 540, 512, 563, 0,
+	0, 0, 615, 0, 606, 0, 610, 609, 369, 47,
+	0, 0, 0, 0, 402, 182, 184, 0, 189, 0,
+	201, 0, 180, 0, 177, 179, 166, 137, 138, 152,
+	155, 0, 0, 0, 0, 239, 585, 0, 489, 0,
+	507, 509, 508, 510, 0, 0, 0, 529, 530, 0,
+	605, 0, 0, 375, 403, 404, 405, 365, 185, 186,
+	0, 190, 188, 0, 98, 0, 176, 178, 0, 253,
+	0, 88, 89, 82, 38, 0, 0, 0, 0, 492,
+	493, 488, 511, 0, 0, 0, 613, -2, 611, 187,
+	0, 181, 252, 0, 0, 85, 586, 584, 486, 490,
+	0, 0, 0, 0, 499, 500, 0, 541, 0, 544,
+	0, 254, 0, 236, 0, 494, 495, 496, 497, 498,
+	0, 542, 0, 0, 0, 501, 0, 205, 0, 491,
+	0, 206, 207, 0, 0, 543, 208, 0, 0, 0,
+	0, 0, 209, 211, 212, 0, 0, 210, 255, 256,
+	213, 214, 215,
 }
 var yyTok1 = [...]int{
 
@@ -3223,7 +3286,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 75, 3, 3, 3, 102, 94, 3,
 	54, 56, 99, 97, 55, 98, 112, 100, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 347,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 351,
 	83, 82, 84, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -3276,7 +3339,8 @@ var yyTok3 = [...]int{
 	57655, 330, 57656, 331, 57657, 332, 57658, 333, 57659, 334,
 	57660, 335, 57661, 336, 57662, 337, 57663, 338, 57664, 339,
 	57665, 340, 57666, 341, 57667, 342, 57668, 343, 57669, 344,
-	57670, 345, 57671, 346, 0,
+	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
+	57675, 350, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -3618,35 +3682,53 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:323
+//line sql.y:343
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:328
+//line sql.y:348
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:329
+//line sql.y:349
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:333
+//line sql.y:353
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:355
+//line sql.y:375
 		{
 			setParseTree(yylex, nil)
 		}
 	case 24:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:381
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 25:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:385
+		{
+			switch sel := yyDollar[2].selStmt.(type) {
+			case *Select:
+				sel.With = yyDollar[1].with
+			case *Union:
+				sel.With = yyDollar[1].with
+			}
+			yyVAL.selStmt = yyDollar[2].selStmt
+		}
+	case 26:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:361
+//line sql.y:399
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3654,57 +3736,105 @@ yydefault:
 			sel.Lock = yyDollar[4].str
 			yyVAL.selStmt = sel
 		}
-	case 25:
+	case 27:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:369
+//line sql.y:407
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 26:
+	case 28:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:373
+//line sql.y:411
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
-	case 27:
+	case 29:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:417
+		{
+			yyVAL.with = &With{Recursive: bool(yyDollar[2].boolVal), CTEs: yyDollar[3].ctes}
+		}
+	case 30:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:422
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 31:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:426
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:432
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 33:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:436
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 34:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:442
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[2].columns, Subquery: yyDollar[4].subquery}
+		}
+	case 35:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:447
+		{
+			yyVAL.columns = nil
+		}
+	case 36:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:451
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 37:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:379
+//line sql.y:457
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 28:
+	case 38:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:386
+//line sql.y:464
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
 		}
-	case 29:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:392
+//line sql.y:470
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 30:
+	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:396
+//line sql.y:474
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 31:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:402
+//line sql.y:480
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 32:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:406
+//line sql.y:484
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 33:
+	case 43:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:413
+//line sql.y:491
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3716,9 +3846,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 34:
+	case 44:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:425
+//line sql.y:503
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3728,334 +3858,334 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 35:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:437
+//line sql.y:515
 		{
 			yyVAL.str = InsertStr
 		}
-	case 36:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:441
+//line sql.y:519
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 37:
+	case 47:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:447
+//line sql.y:525
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 38:
+	case 48:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:453
+//line sql.y:531
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 39:
+	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:457
+//line sql.y:535
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 40:
+	case 50:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:461
+//line sql.y:539
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 41:
+	case 51:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:465
+//line sql.y:543
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 42:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:470
+//line sql.y:548
 		{
 		}
-	case 43:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:471
+//line sql.y:549
 		{
 		}
-	case 44:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:475
+//line sql.y:553
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 45:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:479
+//line sql.y:557
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 46:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:485
+//line sql.y:563
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 47:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:489
+//line sql.y:567
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 48:
+	case 58:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:494
+//line sql.y:572
 		{
 			yyVAL.partitions = nil
 		}
-	case 49:
+	case 59:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:498
+//line sql.y:576
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 50:
+	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:504
+//line sql.y:582
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 51:
+	case 61:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:508
+//line sql.y:586
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 52:
+	case 62:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:512
+//line sql.y:590
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 53:
+	case 63:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:516
+//line sql.y:594
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 54:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:522
+//line sql.y:600
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 55:
+	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:526
+//line sql.y:604
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 56:
+	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:532
+//line sql.y:610
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
-	case 57:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:536
+//line sql.y:614
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
-	case 58:
+	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:540
+//line sql.y:618
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
-	case 59:
+	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:546
+//line sql.y:624
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
-	case 60:
+	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:550
+//line sql.y:628
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
-	case 61:
+	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:554
+//line sql.y:632
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
-	case 62:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:558
+//line sql.y:636
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
-	case 63:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:564
+//line sql.y:642
 		{
 			yyVAL.str = SessionStr
 		}
-	case 64:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:568
+//line sql.y:646
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 65:
+	case 75:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:574
+//line sql.y:652
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 66:
+	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:579
+//line sql.y:657
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 67:
+	case 77:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:585
+//line sql.y:663
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 68:
+	case 78:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:590
+//line sql.y:668
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 69:
+	case 79:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:594
+//line sql.y:672
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 70:
+	case 80:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:598
+//line sql.y:676
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 71:
+	case 81:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:602
+//line sql.y:680
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 72:
+	case 82:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:607
+//line sql.y:685
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 73:
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:611
+//line sql.y:689
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 74:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:617
+//line sql.y:695
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 75:
+	case 85:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:622
+//line sql.y:700
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 76:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:627
+//line sql.y:705
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 77:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:633
+//line sql.y:711
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 78:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:638
+//line sql.y:716
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 79:
+	case 89:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:644
+//line sql.y:722
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 80:
+	case 90:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:650
+//line sql.y:728
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 81:
+	case 91:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:657
+//line sql.y:735
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 82:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:664
+//line sql.y:742
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 83:
+	case 93:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:668
+//line sql.y:746
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 84:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:674
+//line sql.y:752
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 85:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:679
+//line sql.y:757
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 86:
+	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:683
+//line sql.y:761
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 87:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:687
+//line sql.y:765
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 88:
+	case 98:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:693
+//line sql.y:771
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -4065,814 +4195,814 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 89:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:704
+//line sql.y:782
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 93:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:715
+//line sql.y:793
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 94:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:720
+//line sql.y:798
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 95:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:726
+//line sql.y:804
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 96:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:730
+//line sql.y:808
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 97:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:734
+//line sql.y:812
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 98:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:738
+//line sql.y:816
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 99:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:742
+//line sql.y:820
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 100:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:746
+//line sql.y:824
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:750
+//line sql.y:828
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 102:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:754
+//line sql.y:832
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 103:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:758
+//line sql.y:836
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 104:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:764
+//line sql.y:842
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 105:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:770
+//line sql.y:848
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 106:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:776
+//line sql.y:854
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 107:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:782
+//line sql.y:860
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 108:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:788
+//line sql.y:866
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 109:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:796
+//line sql.y:874
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 110:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:800
+//line sql.y:878
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 111:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:804
+//line sql.y:882
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 112:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:808
+//line sql.y:886
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 113:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:812
+//line sql.y:890
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 114:
+	case 124:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:818
+//line sql.y:896
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 115:
+	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:822
+//line sql.y:900
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 116:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:826
+//line sql.y:904
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 117:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:830
+//line sql.y:908
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 118:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:834
+//line sql.y:912
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 119:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:838
+//line sql.y:916
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 120:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:842
+//line sql.y:920
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 121:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:846
+//line sql.y:924
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 122:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:850
+//line sql.y:928
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 123:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:854
+//line sql.y:932
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 124:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:858
+//line sql.y:936
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 125:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:862
+//line sql.y:940
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 126:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:866
+//line sql.y:944
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 127:
+	case 137:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:870
+//line sql.y:948
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 128:
+	case 138:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:875
+//line sql.y:953
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 129:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:881
+//line sql.y:959
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:885
+//line sql.y:963
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 131:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:889
+//line sql.y:967
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:893
+//line sql.y:971
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 133:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:897
+//line sql.y:975
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 134:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:901
+//line sql.y:979
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:905
+//line sql.y:983
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 136:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:909
+//line sql.y:987
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 137:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:915
+//line sql.y:993
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 138:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:920
+//line sql.y:998
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 139:
+	case 149:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:925
+//line sql.y:1003
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 140:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:929
+//line sql.y:1007
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 141:
+	case 151:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:934
+//line sql.y:1012
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 142:
+	case 152:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:938
+//line sql.y:1016
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 143:
+	case 153:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:946
+//line sql.y:1024
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 144:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:950
+//line sql.y:1028
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 145:
+	case 155:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:956
+//line sql.y:1034
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 146:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:964
+//line sql.y:1042
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 147:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:968
+//line sql.y:1046
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 148:
+	case 158:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:973
+//line sql.y:1051
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 149:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:977
+//line sql.y:1055
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 150:
+	case 160:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:983
+//line sql.y:1061
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 151:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:987
+//line sql.y:1065
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 152:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:991
+//line sql.y:1069
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 153:
+	case 163:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:996
+//line sql.y:1074
 		{
 			yyVAL.optVal = nil
 		}
-	case 154:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1000
+//line sql.y:1078
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 155:
+	case 165:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1005
+//line sql.y:1083
 		{
 			yyVAL.optVal = nil
 		}
-	case 156:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1009
+//line sql.y:1087
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 157:
+	case 167:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1014
+//line sql.y:1092
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 158:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1018
+//line sql.y:1096
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 159:
+	case 169:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1023
+//line sql.y:1101
 		{
 			yyVAL.str = ""
 		}
-	case 160:
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1027
+//line sql.y:1105
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 161:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1031
+//line sql.y:1109
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 162:
+	case 172:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1036
+//line sql.y:1114
 		{
 			yyVAL.str = ""
 		}
-	case 163:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1040
+//line sql.y:1118
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 164:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1044
+//line sql.y:1122
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 165:
+	case 175:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1049
+//line sql.y:1127
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 166:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1053
+//line sql.y:1131
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 167:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1057
+//line sql.y:1135
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 168:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1061
+//line sql.y:1139
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 169:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1065
+//line sql.y:1143
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 170:
+	case 180:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1070
+//line sql.y:1148
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 171:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1074
+//line sql.y:1152
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 172:
+	case 182:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1080
+//line sql.y:1158
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 173:
+	case 183:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1084
+//line sql.y:1162
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 174:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1090
+//line sql.y:1168
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 175:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1094
+//line sql.y:1172
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 176:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1100
+//line sql.y:1178
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 177:
+	case 187:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1104
+//line sql.y:1182
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 178:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1109
+//line sql.y:1187
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 179:
+	case 189:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1115
+//line sql.y:1193
 		{
 			yyVAL.str = ""
 		}
-	case 180:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1119
+//line sql.y:1197
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 181:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1125
+//line sql.y:1203
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 182:
+	case 192:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1129
+//line sql.y:1207
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 183:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1133
+//line sql.y:1211
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 184:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1137
+//line sql.y:1215
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 185:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1141
+//line sql.y:1219
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 186:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1147
+//line sql.y:1225
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 187:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1151
+//line sql.y:1229
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 188:
+	case 198:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1156
+//line sql.y:1234
 		{
 			yyVAL.str = ""
 		}
-	case 189:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1160
+//line sql.y:1238
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 190:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1166
+//line sql.y:1244
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 191:
+	case 201:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1170
+//line sql.y:1248
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 192:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1176
+//line sql.y:1254
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 193:
+	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1182
+//line sql.y:1260
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
 		}
-	case 194:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1186
+//line sql.y:1264
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 195:
+	case 205:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1193
+//line sql.y:1271
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 196:
+	case 206:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1197
+//line sql.y:1275
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 197:
+	case 207:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1201
+//line sql.y:1279
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 198:
+	case 208:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1205
+//line sql.y:1283
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 199:
+	case 209:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1211
+//line sql.y:1289
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 200:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1217
+//line sql.y:1295
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 201:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1223
+//line sql.y:1301
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 202:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1227
+//line sql.y:1305
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 203:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1231
+//line sql.y:1309
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 204:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1235
+//line sql.y:1313
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 205:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1239
+//line sql.y:1317
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 206:
+	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1244
+//line sql.y:1322
 		{
 			yyVAL.str = ""
 		}
-	case 207:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1248
+//line sql.y:1326
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 208:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1252
+//line sql.y:1330
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 209:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1260
+//line sql.y:1338
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 210:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1264
+//line sql.y:1342
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 211:
+	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1268
+//line sql.y:1346
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 212:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1274
+//line sql.y:1352
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 213:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1278
+//line sql.y:1356
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 214:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1282
+//line sql.y:1360
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 215:
+	case 225:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1288
+//line sql.y:1366
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 216:
+	case 226:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1292
+//line sql.y:1370
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 217:
+	case 227:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1296
+//line sql.y:1374
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 218:
+	case 228:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1300
+//line sql.y:1378
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 219:
+	case 229:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1305
+//line sql.y:1383
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 220:
+	case 230:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1310
+//line sql.y:1388
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 221:
+	case 231:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1314
+//line sql.y:1392
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 222:
+	case 232:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1318
+//line sql.y:1396
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateVindexStr,
@@ -4884,9 +5014,9 @@ yydefault:
 				},
 			}
 		}
-	case 223:
+	case 233:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1330
+//line sql.y:1408
 		{
 			yyVAL.statement = &DDL{
 				Action: DropVindexStr,
@@ -4896,21 +5026,21 @@ yydefault:
 				},
 			}
 		}
-	case 224:
+	case 234:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1340
+//line sql.y:1418
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 225:
+	case 235:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1344
+//line sql.y:1422
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 226:
+	case 236:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1348
+//line sql.y:1426
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4923,9 +5053,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 227:
+	case 237:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1361
+//line sql.y:1439
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4935,15 +5065,15 @@ yydefault:
 				},
 			}
 		}
-	case 228:
+	case 238:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1371
+//line sql.y:1449
 		{
 			yyVAL.statement = &DDL{Action: AddSequenceStr, Table: yyDollar[5].tableName}
 		}
-	case 229:
+	case 239:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1375
+//line sql.y:1453
 		{
 			yyVAL.statement = &DDL{
 				Action: AddAutoIncStr,
@@ -4954,59 +5084,59 @@ yydefault:
 				},
 			}
 		}
-	case 242:
+	case 252:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1402
+//line sql.y:1480
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 243:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1408
+//line sql.y:1486
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 244:
+	case 254:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1412
+//line sql.y:1490
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 245:
+	case 255:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1418
+//line sql.y:1496
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 246:
+	case 256:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1422
+//line sql.y:1500
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 247:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1428
+//line sql.y:1506
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 248:
+	case 258:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1434
+//line sql.y:1512
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 249:
+	case 259:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1438
+//line sql.y:1516
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 250:
+	case 260:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1446
+//line sql.y:1524
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5014,16 +5144,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
 		}
-	case 251:
+	case 261:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1454
+//line sql.y:1532
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 252:
+	case 262:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1459
+//line sql.y:1537
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5031,148 +5161,148 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 253:
+	case 263:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1467
+//line sql.y:1545
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 254:
+	case 264:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1471
+//line sql.y:1549
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 255:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1477
+//line sql.y:1555
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 256:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1481
+//line sql.y:1559
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 257:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1486
+//line sql.y:1564
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
 		}
-	case 258:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1492
+//line sql.y:1570
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 259:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1497
+//line sql.y:1575
 		{
 			yyVAL.statement = &Show{Type: CharsetStr}
 		}
-	case 260:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1501
+//line sql.y:1579
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 261:
+	case 271:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1505
+//line sql.y:1583
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 262:
+	case 272:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1510
+//line sql.y:1588
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 263:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1514
+//line sql.y:1592
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 264:
+	case 274:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1518
+//line sql.y:1596
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 265:
+	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1522
+//line sql.y:1600
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 266:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1526
+//line sql.y:1604
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 267:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1530
+//line sql.y:1608
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 268:
+	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1534
+//line sql.y:1612
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 269:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1538
+//line sql.y:1616
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 270:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1542
+//line sql.y:1620
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 271:
+	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1546
+//line sql.y:1624
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 272:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1550
+//line sql.y:1628
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 273:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1554
+//line sql.y:1632
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 274:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1558
+//line sql.y:1636
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 275:
+	case 285:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1562
+//line sql.y:1640
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 276:
+	case 286:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1567
+//line sql.y:1645
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -5182,645 +5312,645 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 277:
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1577
+//line sql.y:1655
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 278:
+	case 288:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1581
+//line sql.y:1659
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 279:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1585
+//line sql.y:1663
 		{
 			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
 			showCollationFilterOpt := yyDollar[4].expr
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
 		}
-	case 280:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1591
+//line sql.y:1669
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Scope: string(yyDollar[2].bytes), Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 281:
+	case 291:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1596
+//line sql.y:1674
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 282:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1600
+//line sql.y:1678
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 283:
+	case 293:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1604
+//line sql.y:1682
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 284:
+	case 294:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1608
+//line sql.y:1686
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 285:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1622
+//line sql.y:1700
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 286:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1628
+//line sql.y:1706
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 287:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1632
+//line sql.y:1710
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 288:
+	case 298:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1638
+//line sql.y:1716
 		{
 			yyVAL.str = ""
 		}
-	case 289:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1642
+//line sql.y:1720
 		{
 			yyVAL.str = "full "
 		}
-	case 290:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1648
+//line sql.y:1726
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 291:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1652
+//line sql.y:1730
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 292:
+	case 302:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1658
+//line sql.y:1736
 		{
 			yyVAL.str = ""
 		}
-	case 293:
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1662
+//line sql.y:1740
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 294:
+	case 304:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1666
+//line sql.y:1744
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 295:
+	case 305:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1672
+//line sql.y:1750
 		{
 			yyVAL.showFilter = nil
 		}
-	case 296:
+	case 306:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1676
+//line sql.y:1754
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 297:
+	case 307:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1680
+//line sql.y:1758
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 298:
+	case 308:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1686
+//line sql.y:1764
 		{
 			yyVAL.showFilter = nil
 		}
-	case 299:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1690
+//line sql.y:1768
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 300:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1696
+//line sql.y:1774
 		{
 			yyVAL.str = ""
 		}
-	case 301:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1700
+//line sql.y:1778
 		{
 			yyVAL.str = SessionStr
 		}
-	case 302:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1704
+//line sql.y:1782
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 303:
+	case 313:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1710
+//line sql.y:1788
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 304:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1714
+//line sql.y:1792
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 305:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1720
+//line sql.y:1798
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 306:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1724
+//line sql.y:1802
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 307:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1730
+//line sql.y:1808
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 308:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1736
+//line sql.y:1814
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 309:
+	case 319:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1742
+//line sql.y:1820
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 310:
+	case 320:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1746
+//line sql.y:1824
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 311:
+	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1750
+//line sql.y:1828
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 312:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1754
+//line sql.y:1832
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 313:
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1758
+//line sql.y:1836
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 314:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1762
+//line sql.y:1840
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 315:
+	case 325:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1766
+//line sql.y:1844
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 316:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1772
+//line sql.y:1850
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 317:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1776
+//line sql.y:1854
 		{
 			setAllowComments(yylex, true)
 		}
-	case 318:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1780
+//line sql.y:1858
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 319:
+	case 329:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1786
+//line sql.y:1864
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 320:
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1790
+//line sql.y:1868
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 321:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1796
+//line sql.y:1874
 		{
 			yyVAL.str = UnionStr
 		}
-	case 322:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1800
+//line sql.y:1878
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 323:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1804
+//line sql.y:1882
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 324:
+	case 334:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1809
+//line sql.y:1887
 		{
 			yyVAL.str = ""
 		}
-	case 325:
+	case 335:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1813
+//line sql.y:1891
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 326:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1817
+//line sql.y:1895
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 327:
+	case 337:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1822
+//line sql.y:1900
 		{
 			yyVAL.str = ""
 		}
-	case 328:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1826
+//line sql.y:1904
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 329:
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1831
+//line sql.y:1909
 		{
 			yyVAL.str = ""
 		}
-	case 330:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1835
+//line sql.y:1913
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 331:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1840
+//line sql.y:1918
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 332:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1844
+//line sql.y:1922
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 333:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1850
+//line sql.y:1928
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 334:
+	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1854
+//line sql.y:1932
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 335:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1860
+//line sql.y:1938
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 336:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1864
+//line sql.y:1942
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 337:
+	case 347:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1868
+//line sql.y:1946
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 338:
+	case 348:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1872
+//line sql.y:1950
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 339:
+	case 349:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1877
+//line sql.y:1955
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 340:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1881
+//line sql.y:1959
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 341:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1885
+//line sql.y:1963
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 343:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1892
+//line sql.y:1970
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 344:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1897
+//line sql.y:1975
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 345:
+	case 355:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1901
+//line sql.y:1979
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 346:
+	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1907
+//line sql.y:1985
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 347:
+	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1911
+//line sql.y:1989
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 350:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1921
+//line sql.y:1999
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 351:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1925
+//line sql.y:2003
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 352:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1929
+//line sql.y:2007
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 353:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1935
+//line sql.y:2013
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 354:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1941
+//line sql.y:2019
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 355:
+	case 365:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1945
+//line sql.y:2023
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 356:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1951
+//line sql.y:2029
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 357:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1955
+//line sql.y:2033
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 358:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1961
+//line sql.y:2039
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 359:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1965
+//line sql.y:2043
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 360:
+	case 370:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1978
+//line sql.y:2056
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 361:
+	case 371:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1982
+//line sql.y:2060
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 362:
+	case 372:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1986
+//line sql.y:2064
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 363:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1990
+//line sql.y:2068
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 364:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1996
+//line sql.y:2074
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 365:
+	case 375:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1998
+//line sql.y:2076
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 366:
+	case 376:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2002
+//line sql.y:2080
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 367:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2004
+//line sql.y:2082
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 368:
+	case 378:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2008
+//line sql.y:2086
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 369:
+	case 379:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2010
+//line sql.y:2088
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 370:
+	case 380:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2013
+//line sql.y:2091
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 371:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2015
+//line sql.y:2093
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 372:
+	case 382:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2018
+//line sql.y:2096
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 373:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2022
+//line sql.y:2100
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 374:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2026
+//line sql.y:2104
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 376:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2033
+//line sql.y:2111
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 377:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2039
+//line sql.y:2117
 		{
 			yyVAL.str = JoinStr
 		}
-	case 378:
+	case 388:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2043
+//line sql.y:2121
 		{
 			yyVAL.str = JoinStr
 		}
-	case 379:
+	case 389:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2047
+//line sql.y:2125
 		{
 			yyVAL.str = JoinStr
 		}
-	case 380:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2053
+//line sql.y:2131
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 381:
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2059
+//line sql.y:2137
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 382:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2063
+//line sql.y:2141
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 383:
+	case 393:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2067
+//line sql.y:2145
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 384:
+	case 394:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2071
+//line sql.y:2149
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 385:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2077
+//line sql.y:2155
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 386:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2081
+//line sql.y:2159
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -5828,465 +5958,465 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 387:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2091
+//line sql.y:2169
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 388:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2095
+//line sql.y:2173
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 389:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2101
+//line sql.y:2179
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 390:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2105
+//line sql.y:2183
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 391:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2111
+//line sql.y:2189
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 392:
+	case 402:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2116
+//line sql.y:2194
 		{
 			yyVAL.indexHints = nil
 		}
-	case 393:
+	case 403:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2120
+//line sql.y:2198
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 394:
+	case 404:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2124
+//line sql.y:2202
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 395:
+	case 405:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2128
+//line sql.y:2206
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 396:
+	case 406:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2133
+//line sql.y:2211
 		{
 			yyVAL.expr = nil
 		}
-	case 397:
+	case 407:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2137
+//line sql.y:2215
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 398:
+	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2143
+//line sql.y:2221
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 399:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2147
+//line sql.y:2225
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 400:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2151
+//line sql.y:2229
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 401:
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2155
+//line sql.y:2233
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 402:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2159
+//line sql.y:2237
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 403:
+	case 413:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2163
+//line sql.y:2241
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 404:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2167
+//line sql.y:2245
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 405:
+	case 415:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2173
+//line sql.y:2251
 		{
 			yyVAL.str = ""
 		}
-	case 406:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2177
+//line sql.y:2255
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 407:
+	case 417:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2183
+//line sql.y:2261
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 408:
+	case 418:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2187
+//line sql.y:2265
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 409:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2193
+//line sql.y:2271
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 410:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2197
+//line sql.y:2275
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 411:
+	case 421:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2201
+//line sql.y:2279
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 412:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2205
+//line sql.y:2283
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 413:
+	case 423:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2209
+//line sql.y:2287
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 414:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2213
+//line sql.y:2291
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 415:
+	case 425:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2217
+//line sql.y:2295
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 416:
+	case 426:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2221
+//line sql.y:2299
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 417:
+	case 427:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2225
+//line sql.y:2303
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 418:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2229
+//line sql.y:2307
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 419:
+	case 429:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2235
+//line sql.y:2313
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 420:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2239
+//line sql.y:2317
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 421:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2243
+//line sql.y:2321
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 422:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2247
+//line sql.y:2325
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 423:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2251
+//line sql.y:2329
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 424:
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2255
+//line sql.y:2333
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 425:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2261
+//line sql.y:2339
 		{
 			yyVAL.str = EqualStr
 		}
-	case 426:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2265
+//line sql.y:2343
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 427:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2269
+//line sql.y:2347
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 428:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2273
+//line sql.y:2351
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 429:
+	case 439:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2277
+//line sql.y:2355
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 430:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2281
+//line sql.y:2359
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 431:
+	case 441:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2285
+//line sql.y:2363
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 432:
+	case 442:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2290
+//line sql.y:2368
 		{
 			yyVAL.expr = nil
 		}
-	case 433:
+	case 443:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2294
+//line sql.y:2372
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 434:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2300
+//line sql.y:2378
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 435:
+	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2304
+//line sql.y:2382
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 436:
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2308
+//line sql.y:2386
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 437:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2314
+//line sql.y:2392
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 438:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2320
+//line sql.y:2398
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 439:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2324
+//line sql.y:2402
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 440:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2330
+//line sql.y:2408
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 441:
+	case 451:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2334
+//line sql.y:2412
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 442:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2338
+//line sql.y:2416
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 443:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2342
+//line sql.y:2420
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 444:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2346
+//line sql.y:2424
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 445:
+	case 455:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2350
+//line sql.y:2428
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 446:
+	case 456:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2354
+//line sql.y:2432
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 447:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2358
+//line sql.y:2436
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 448:
+	case 458:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2362
+//line sql.y:2440
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 449:
+	case 459:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2366
+//line sql.y:2444
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 450:
+	case 460:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2370
+//line sql.y:2448
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 451:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2374
+//line sql.y:2452
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 452:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2378
+//line sql.y:2456
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 453:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2382
+//line sql.y:2460
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 454:
+	case 464:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2386
+//line sql.y:2464
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 455:
+	case 465:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2390
+//line sql.y:2468
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 456:
+	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2394
+//line sql.y:2472
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 457:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2398
+//line sql.y:2476
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: NewStrVal(yyDollar[3].bytes)}
 		}
-	case 458:
+	case 468:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2402
+//line sql.y:2480
 		{
-			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: NewStrVal(yyDollar[3].bytes)}
 		}
-	case 459:
+	case 469:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2406
+//line sql.y:2484
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 460:
+	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2410
+//line sql.y:2488
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 461:
+	case 471:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2414
+//line sql.y:2492
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 462:
+	case 472:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2418
+//line sql.y:2496
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 463:
+	case 473:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2422
+//line sql.y:2500
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6294,9 +6424,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 464:
+	case 474:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2430
+//line sql.y:2508
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6310,21 +6440,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 465:
+	case 475:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2444
+//line sql.y:2522
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 466:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2448
+//line sql.y:2526
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 467:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2452
+//line sql.y:2530
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6332,485 +6462,587 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 472:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2470
+	case 482:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2548
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
 		}
-	case 473:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2474
+	case 483:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2552
 		{
-			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs, Over: yyDollar[6].overClause}
 		}
-	case 474:
+	case 484:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2478
+//line sql.y:2556
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 475:
+	case 485:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2561
+		{
+			yyVAL.overClause = nil
+		}
+	case 486:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2565
+		{
+			yyVAL.overClause = &OverClause{PartitionBy: yyDollar[3].exprs, OrderBy: yyDollar[4].orderBy, Frame: yyDollar[5].frameClause}
+		}
+	case 487:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2570
+		{
+			yyVAL.exprs = nil
+		}
+	case 488:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2574
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 489:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2579
+		{
+			yyVAL.frameClause = nil
+		}
+	case 490:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2583
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[2].framePoint}
+		}
+	case 491:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2587
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].str, Start: yyDollar[3].framePoint, End: yyDollar[5].framePoint}
+		}
+	case 492:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2593
+		{
+			yyVAL.str = RowsStr
+		}
+	case 493:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2597
+		{
+			yyVAL.str = RangeStr
+		}
+	case 494:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2603
+		{
+			yyVAL.framePoint = &FramePoint{Type: CurrentRowStr}
+		}
+	case 495:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2607
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedPrecedingStr}
+		}
+	case 496:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2611
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedFollowingStr}
+		}
+	case 497:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2615
+		{
+			yyVAL.framePoint = &FramePoint{Type: PrecedingStr, Expr: yyDollar[1].expr}
+		}
+	case 498:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2619
+		{
+			yyVAL.framePoint = &FramePoint{Type: FollowingStr, Expr: yyDollar[1].expr}
+		}
+	case 499:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2627
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 500:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2631
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 501:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2635
+		{
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 502:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2488
+//line sql.y:2645
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 476:
+	case 503:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2492
+//line sql.y:2649
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 477:
+	case 504:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2496
+//line sql.y:2653
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 478:
+	case 505:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2500
+//line sql.y:2657
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 479:
+	case 506:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2504
+//line sql.y:2661
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 480:
+	case 507:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2508
+//line sql.y:2665
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 481:
+	case 508:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2512
+//line sql.y:2669
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 482:
+	case 509:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2516
+//line sql.y:2673
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 483:
+	case 510:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2520
+//line sql.y:2677
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 484:
+	case 511:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2524
+//line sql.y:2681
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 485:
+	case 512:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2528
+//line sql.y:2685
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 486:
+	case 513:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2532
+//line sql.y:2689
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 487:
+	case 514:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2536
+//line sql.y:2693
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 488:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2546
+//line sql.y:2703
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 489:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2550
+//line sql.y:2707
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 490:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2554
+//line sql.y:2711
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 491:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2559
+//line sql.y:2716
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 492:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2564
+//line sql.y:2721
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 493:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2569
+//line sql.y:2726
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 494:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2575
+//line sql.y:2732
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 495:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2580
+//line sql.y:2737
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 496:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2585
+//line sql.y:2742
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 497:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2589
+//line sql.y:2746
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 498:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2593
+//line sql.y:2750
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 499:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2598
+//line sql.y:2755
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 500:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2603
+//line sql.y:2760
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 501:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2608
+//line sql.y:2765
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 502:
+	case 529:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2612
+//line sql.y:2769
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 503:
+	case 530:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2616
+//line sql.y:2773
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 506:
+	case 533:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2626
+//line sql.y:2783
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 507:
+	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2636
+//line sql.y:2793
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 508:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2640
+//line sql.y:2797
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 509:
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2644
+//line sql.y:2801
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 510:
+	case 537:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2648
+//line sql.y:2805
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 511:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2652
+//line sql.y:2809
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 512:
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2656
+//line sql.y:2813
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 513:
+	case 540:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2662
+//line sql.y:2819
 		{
 			yyVAL.str = ""
 		}
-	case 514:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2666
+//line sql.y:2823
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 515:
+	case 542:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2670
+//line sql.y:2827
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 516:
+	case 543:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2674
+//line sql.y:2831
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 517:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2678
+//line sql.y:2835
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 518:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2684
+//line sql.y:2841
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 519:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2688
+//line sql.y:2845
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 520:
+	case 547:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2694
+//line sql.y:2851
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 521:
+	case 548:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2698
+//line sql.y:2855
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 522:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2702
+//line sql.y:2859
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 523:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2706
+//line sql.y:2863
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 524:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2710
+//line sql.y:2867
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 525:
+	case 552:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2714
+//line sql.y:2871
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 526:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2720
+//line sql.y:2877
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 527:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2724
+//line sql.y:2881
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 528:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2728
+//line sql.y:2885
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 529:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2732
+//line sql.y:2889
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 530:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2736
+//line sql.y:2893
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 531:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2740
+//line sql.y:2897
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 532:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2744
+//line sql.y:2901
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 533:
+	case 560:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2749
+//line sql.y:2906
 		{
 			yyVAL.expr = nil
 		}
-	case 534:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2753
+//line sql.y:2910
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 535:
+	case 562:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2758
+//line sql.y:2915
 		{
 			yyVAL.str = string("")
 		}
-	case 536:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2762
+//line sql.y:2919
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 537:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2768
+//line sql.y:2925
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 538:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2772
+//line sql.y:2929
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 539:
+	case 566:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2778
+//line sql.y:2935
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 540:
+	case 567:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2783
+//line sql.y:2940
 		{
 			yyVAL.expr = nil
 		}
-	case 541:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2787
+//line sql.y:2944
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 542:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2793
+//line sql.y:2950
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 543:
+	case 570:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2797
+//line sql.y:2954
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 544:
+	case 571:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2801
+//line sql.y:2958
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 545:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2807
+//line sql.y:2964
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 546:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2811
+//line sql.y:2968
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 547:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2815
+//line sql.y:2972
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 548:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2819
+//line sql.y:2976
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 549:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2823
+//line sql.y:2980
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 550:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2827
+//line sql.y:2984
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 551:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2831
+//line sql.y:2988
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 552:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2835
+//line sql.y:2992
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 553:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2841
+//line sql.y:2998
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6819,239 +7051,239 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 554:
+	case 581:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2850
+//line sql.y:3007
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 555:
+	case 582:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2854
+//line sql.y:3011
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 556:
+	case 583:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2859
+//line sql.y:3016
 		{
 			yyVAL.exprs = nil
 		}
-	case 557:
+	case 584:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2863
+//line sql.y:3020
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 558:
+	case 585:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2868
+//line sql.y:3025
 		{
 			yyVAL.expr = nil
 		}
-	case 559:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2872
+//line sql.y:3029
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 560:
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2877
+//line sql.y:3034
 		{
 			yyVAL.orderBy = nil
 		}
-	case 561:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2881
+//line sql.y:3038
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 562:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2887
+//line sql.y:3044
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 563:
+	case 590:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2891
+//line sql.y:3048
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 564:
+	case 591:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2897
+//line sql.y:3054
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 565:
+	case 592:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2902
+//line sql.y:3059
 		{
 			yyVAL.str = AscScr
 		}
-	case 566:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2906
+//line sql.y:3063
 		{
 			yyVAL.str = AscScr
 		}
-	case 567:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2910
+//line sql.y:3067
 		{
 			yyVAL.str = DescScr
 		}
-	case 568:
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2915
+//line sql.y:3072
 		{
 			yyVAL.limit = nil
 		}
-	case 569:
+	case 596:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2919
+//line sql.y:3076
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 570:
+	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2923
+//line sql.y:3080
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 571:
+	case 598:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2927
+//line sql.y:3084
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 572:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2932
+//line sql.y:3089
 		{
 			yyVAL.str = ""
 		}
-	case 573:
+	case 600:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2936
+//line sql.y:3093
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 574:
+	case 601:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2940
+//line sql.y:3097
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 575:
+	case 602:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2953
+//line sql.y:3110
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 576:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2957
+//line sql.y:3114
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 577:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2961
+//line sql.y:3118
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 578:
+	case 605:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2966
+//line sql.y:3123
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 579:
+	case 606:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2970
+//line sql.y:3127
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 580:
+	case 607:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2974
+//line sql.y:3131
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 581:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2981
+//line sql.y:3138
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 582:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2985
+//line sql.y:3142
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 583:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2989
+//line sql.y:3146
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 584:
+	case 611:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2993
+//line sql.y:3150
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 585:
+	case 612:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2998
+//line sql.y:3155
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 586:
+	case 613:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3002
+//line sql.y:3159
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 587:
+	case 614:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3008
+//line sql.y:3165
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 588:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3012
+//line sql.y:3169
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 589:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3018
+//line sql.y:3175
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 590:
+	case 617:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3022
+//line sql.y:3179
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 591:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3028
+//line sql.y:3185
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 592:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3034
+//line sql.y:3191
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -7059,312 +7291,312 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 593:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3044
+//line sql.y:3201
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 594:
+	case 621:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3048
+//line sql.y:3205
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 595:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3054
+//line sql.y:3211
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 596:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3060
+//line sql.y:3217
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 597:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3064
+//line sql.y:3221
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 598:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3070
+//line sql.y:3227
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 599:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3074
+//line sql.y:3231
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 600:
+	case 627:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3078
+//line sql.y:3235
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 601:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3082
+//line sql.y:3239
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 603:
+	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3089
+//line sql.y:3246
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 605:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3096
+//line sql.y:3253
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 606:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3100
+//line sql.y:3257
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 607:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3104
+//line sql.y:3261
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 610:
+	case 637:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3113
+//line sql.y:3270
 		{
 			yyVAL.byt = 0
 		}
-	case 611:
+	case 638:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3115
+//line sql.y:3272
 		{
 			yyVAL.byt = 1
 		}
-	case 612:
+	case 639:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3118
+//line sql.y:3275
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 613:
+	case 640:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3120
+//line sql.y:3277
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 614:
+	case 641:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3123
+//line sql.y:3280
 		{
 			yyVAL.str = ""
 		}
-	case 615:
+	case 642:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3125
+//line sql.y:3282
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 616:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3129
+//line sql.y:3286
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 617:
+	case 644:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3131
+//line sql.y:3288
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 618:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3133
+//line sql.y:3290
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 619:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3135
+//line sql.y:3292
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 620:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3137
+//line sql.y:3294
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 621:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3139
+//line sql.y:3296
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 622:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3141
+//line sql.y:3298
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 623:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3143
+//line sql.y:3300
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 624:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3145
+//line sql.y:3302
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 625:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3147
+//line sql.y:3304
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 626:
+	case 653:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3150
+//line sql.y:3307
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 627:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3152
+//line sql.y:3309
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 628:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3154
+//line sql.y:3311
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 629:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3158
+//line sql.y:3315
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 630:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3160
+//line sql.y:3317
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 631:
+	case 658:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3163
+//line sql.y:3320
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 632:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3165
+//line sql.y:3322
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 633:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3167
+//line sql.y:3324
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 634:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3170
+//line sql.y:3327
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 635:
+	case 662:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3172
+//line sql.y:3329
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 636:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3176
+//line sql.y:3333
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 637:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3180
+//line sql.y:3337
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 639:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3187
+//line sql.y:3344
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 640:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3193
+//line sql.y:3350
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 641:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3197
+//line sql.y:3354
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 643:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3204
+//line sql.y:3361
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 930:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3516
+//line sql.y:3677
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 931:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3525
+//line sql.y:3686
 		{
 			decNesting(yylex)
 		}
-	case 932:
+	case 963:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3530
+//line sql.y:3691
 		{
 			skipToEnd(yylex)
 		}
-	case 933:
+	case 964:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3535
+//line sql.y:3696
 		{
 			skipToEnd(yylex)
 		}
-	case 934:
+	case 965:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3539
+//line sql.y:3700
 		{
 			skipToEnd(yylex)
 		}
-	case 935:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3543
+//line sql.y:3704
 		{
 			skipToEnd(yylex)
 		}
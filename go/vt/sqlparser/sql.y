@@ -115,6 +115,12 @@ func skipToEnd(yylex interface{}) {
   vindexParams  []VindexParam
   showFilter    *ShowFilter
   optLike       *OptLike
+  with          *With
+  ctes          []*CommonTableExpr
+  cte           *CommonTableExpr
+  overClause    *OverClause
+  frameClause   *FrameClause
+  framePoint    *FramePoint
 }
 
 %token LEX_ERROR
@@ -200,6 +206,9 @@ func skipToEnd(yylex interface{}) {
 // Match
 %token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
+// Window functions
+%token <bytes> ROWS RANGE CURRENT ROW
+
 // MySQL reserved words that are unused by this grammar will map to this token.
 %token <bytes> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
 %token <bytes> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
@@ -210,7 +219,18 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
 
 %type <statement> command
-%type <selStmt> select_statement base_select union_lhs union_rhs
+%type <selStmt> select_statement select_no_with base_select union_lhs union_rhs
+%type <with> with_clause
+%type <ctes> cte_list
+%type <cte> cte
+%type <boolVal> recursive_opt
+%type <columns> cte_column_list_opt
+%type <overClause> over_opt
+%type <exprs> partition_by_opt
+%type <frameClause> frame_opt
+%type <framePoint> frame_point
+%type <str> frame_unit
+%type <expr> frame_expression
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement
 %type <ddl> create_table_prefix rename_list
@@ -357,6 +377,24 @@ command:
 }
 
 select_statement:
+  select_no_with
+  {
+    $$ = $1
+  }
+| with_clause select_no_with
+  {
+    switch sel := $2.(type) {
+    case *Select:
+      sel.With = $1
+    case *Union:
+      sel.With = $1
+    }
+    $$ = $2
+  }
+
+// select_no_with is a select statement without a WITH clause. A WITH clause
+// applies to the whole statement that follows it, unions included.
+select_no_with:
   base_select order_by_opt limit_opt lock_opt
   {
     sel := $1.(*Select)
@@ -374,6 +412,46 @@ select_statement:
     $$ = &Select{Comments: Comments($2), Cache: $3, SelectExprs: SelectExprs{Nextval{Expr: $5}}, From: TableExprs{&AliasedTableExpr{Expr: $7}}}
   }
 
+with_clause:
+  WITH recursive_opt cte_list
+  {
+    $$ = &With{Recursive: bool($2), CTEs: $3}
+  }
+
+recursive_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| RECURSIVE
+  {
+    $$ = BoolVal(true)
+  }
+
+cte_list:
+  cte
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| cte_list ',' cte
+  {
+    $$ = append($1, $3)
+  }
+
+cte:
+  table_id cte_column_list_opt AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $2, Subquery: $4}
+  }
+
+cte_column_list_opt:
+  {
+    $$ = nil
+  }
+| openb column_list closeb
+  {
+    $$ = $2
+  }
+
 stream_statement:
   STREAM comment_opt select_expression FROM table_name
   {
@@ -388,7 +466,7 @@ base_select:
   }
 
 union_lhs:
-  select_statement
+  select_no_with
   {
     $$ = $1
   }
@@ -2394,13 +2472,13 @@ value_expression:
   {
     $$ = &BinaryExpr{Left: $1, Operator: ShiftRightStr, Right: $3}
   }
-| column_name JSON_EXTRACT_OP value
+| column_name JSON_EXTRACT_OP STRING
   {
-    $$ = &BinaryExpr{Left: $1, Operator: JSONExtractOp, Right: $3}
+    $$ = &BinaryExpr{Left: $1, Operator: JSONExtractOp, Right: NewStrVal($3)}
   }
-| column_name JSON_UNQUOTE_EXTRACT_OP value
+| column_name JSON_UNQUOTE_EXTRACT_OP STRING
   {
-    $$ = &BinaryExpr{Left: $1, Operator: JSONUnquoteExtractOp, Right: $3}
+    $$ = &BinaryExpr{Left: $1, Operator: JSONUnquoteExtractOp, Right: NewStrVal($3)}
   }
 | value_expression COLLATE charset
   {
@@ -2466,19 +2544,98 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
-| sql_id openb DISTINCT select_expression_list closeb
+| sql_id openb DISTINCT select_expression_list closeb over_opt
   {
-    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
+    $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4, Over: $6}
   }
 | table_id '.' reserved_sql_id openb select_expression_list_opt closeb
   {
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
 
+over_opt:
+  {
+    $$ = nil
+  }
+| OVER openb partition_by_opt order_by_opt frame_opt closeb
+  {
+    $$ = &OverClause{PartitionBy: $3, OrderBy: $4, Frame: $5}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsStr
+  }
+| RANGE
+  {
+    $$ = RangeStr
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowStr}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingStr}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingStr}
+  }
+| frame_expression PRECEDING
+  {
+    $$ = &FramePoint{Type: PrecedingStr, Expr: $1}
+  }
+| frame_expression FOLLOWING
+  {
+    $$ = &FramePoint{Type: FollowingStr, Expr: $1}
+  }
+
+// frame_expression is the offset of a frame boundary. MySQL only accepts
+// non-negative integers, bind variables and intervals there.
+frame_expression:
+  INTEGRAL
+  {
+    $$ = NewIntVal($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewValArg($1)
+  }
+| INTERVAL value_expression sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
 /*
   Function calls using reserved keywords, with dedicated grammar rules
   as a result
@@ -3299,6 +3456,7 @@ reserved_keyword:
 | OUTER
 | OVER
 | PERCENT_RANK
+| RANGE
 | RANK
 | RECURSIVE
 | REGEXP
@@ -3332,6 +3490,7 @@ reserved_keyword:
 | WHEN
 | WHERE
 | WINDOW
+| WITH
 
 /*
   These are non-reserved Vitess, because they don't cause conflicts in the grammar.
@@ -3364,6 +3523,7 @@ non_reserved_keyword:
 | COMMIT
 | COMMITTED
 | COMPONENT
+| CURRENT
 | DATE
 | DATETIME
 | DECIMAL
@@ -3463,6 +3623,8 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROW
+| ROWS
 | SECONDARY
 | SECONDARY_ENGINE
 | SECONDARY_LOAD
@@ -3506,7 +3668,6 @@ non_reserved_keyword:
 | VITESS_METADATA
 | VSCHEMA
 | WARNINGS
-| WITH
 | WRITE
 | YEAR
 | ZEROFILL
@@ -49,11 +49,18 @@ var (
 	// once every srv_topo_cache_refresh interval.
 	srvTopoCacheTTL     = flag.Duration("srv_topo_cache_ttl", 1*time.Second, "how long to use cached entries for topology")
 	srvTopoCacheRefresh = flag.Duration("srv_topo_cache_refresh", 1*time.Second, "how frequently to refresh the topology for cached entries")
+
+	// srvTopoServeStale makes the cache keep serving the last known
+	// values while the topo is unreachable, instead of returning errors
+	// once srv_topo_cache_ttl elapses. Values that were deleted from the
+	// topo are still removed from the cache.
+	srvTopoServeStale = flag.Bool("srv_topo_serve_stale", false, "if set, the last known topology values are served for as long as the topo server is unreachable, instead of only for srv_topo_cache_ttl")
 )
 
 const (
 	queryCategory  = "query"
 	cachedCategory = "cached"
+	staleCategory  = "stale"
 	errorCategory  = "error"
 
 	// TopoTemplate is the HTML to use to display the
@@ -120,6 +127,7 @@ type ResilientServer struct {
 	topoServer   *topo.Server
 	cacheTTL     time.Duration
 	cacheRefresh time.Duration
+	serveStale   bool
 	counts       *stats.CountersWithSingleLabel
 
 	// mutex protects the cache map itself, not the individual
@@ -127,6 +135,11 @@ type ResilientServer struct {
 	mutex                 sync.RWMutex
 	srvKeyspaceNamesCache map[string]*srvKeyspaceNamesEntry
 	srvKeyspaceCache      map[string]*srvKeyspaceEntry
+
+	// srvVSchemaStaleSince records, per cell, when the SrvVSchema watch
+	// failed. It is zero while the watch is running.
+	srvVSchemaMutex      sync.Mutex
+	srvVSchemaStaleSince map[string]time.Time
 }
 
 type srvKeyspaceNamesEntry struct {
@@ -211,15 +224,39 @@ func NewResilientServer(base *topo.Server, counterPrefix string) *ResilientServe
 		log.Fatalf("srv_topo_cache_refresh must be less than or equal to srv_topo_cache_ttl")
 	}
 
-	return &ResilientServer{
+	server := &ResilientServer{
 		topoServer:   base,
 		cacheTTL:     *srvTopoCacheTTL,
 		cacheRefresh: *srvTopoCacheRefresh,
+		serveStale:   *srvTopoServeStale,
 		counts:       stats.NewCountersWithSingleLabel(counterPrefix+"Counts", "Resilient srvtopo server operations", "type"),
 
 		srvKeyspaceNamesCache: make(map[string]*srvKeyspaceNamesEntry),
 		srvKeyspaceCache:      make(map[string]*srvKeyspaceEntry),
+		srvVSchemaStaleSince:  make(map[string]time.Time),
 	}
+	stats.NewGaugesFuncWithMultiLabels(
+		counterPrefix+"SrvKeyspaceNamesStaleness",
+		"Seconds since the cached SrvKeyspace names were last refreshed, while the topo is failing",
+		[]string{"Cell"},
+		server.srvKeyspaceNamesStaleness)
+	stats.NewGaugesFuncWithMultiLabels(
+		counterPrefix+"SrvKeyspaceStaleness",
+		"Seconds since the cached SrvKeyspace was last known to be current, while its watch is failing",
+		[]string{"Cell", "Keyspace"},
+		server.srvKeyspaceStaleness)
+	stats.NewGaugesFuncWithMultiLabels(
+		counterPrefix+"SrvVSchemaStaleness",
+		"Seconds since the SrvVSchema was last known to be current, while its watch is failing",
+		[]string{"Cell"},
+		server.srvVSchemaStaleness)
+	return server
+}
+
+// cacheUsable returns true if a value that was last known to be current
+// at valueTime can still be served.
+func (server *ResilientServer) cacheUsable(valueTime time.Time) bool {
+	return server.serveStale || time.Since(valueTime) < server.cacheTTL
 }
 
 // GetTopoServer returns the topo.Server that backs the resilient server.
@@ -251,7 +288,10 @@ func (server *ResilientServer) GetSrvKeyspaceNames(ctx context.Context, cell str
 	entry.mutex.Lock()
 	defer entry.mutex.Unlock()
 
-	cacheValid := entry.value != nil && time.Since(entry.insertionTime) < server.cacheTTL
+	cacheValid := entry.value != nil && server.cacheUsable(entry.insertionTime)
+	if cacheValid && time.Since(entry.insertionTime) >= server.cacheTTL {
+		server.counts.Add(staleCategory, 1)
+	}
 	shouldRefresh := time.Since(entry.lastQueryTime) > server.cacheRefresh
 
 	// If it is not time to check again, then return either the cached
@@ -289,7 +329,7 @@ func (server *ResilientServer) GetSrvKeyspaceNames(ctx context.Context, cell str
 				if entry.insertionTime.IsZero() {
 					log.Errorf("GetSrvKeyspaceNames(%v, %v) failed: %v (no cached value, caching and returning error)", ctx, cell, err)
 
-				} else if entry.value != nil && time.Since(entry.insertionTime) < server.cacheTTL {
+				} else if entry.value != nil && server.cacheUsable(entry.insertionTime) {
 					server.counts.Add(cachedCategory, 1)
 					log.Warningf("GetSrvKeyspaceNames(%v, %v) failed: %v (keeping cached value: %v)", ctx, cell, err, entry.value)
 				} else {
@@ -395,9 +435,13 @@ func (server *ResilientServer) GetSrvKeyspace(ctx context.Context, cell, keyspac
 	// In the event that the topo service is slow or unresponsive either
 	// on the initial fetch or if the cache TTL expires, then several
 	// requests could be blocked waiting for the response to come back.
-	cacheValid := entry.value != nil && time.Since(entry.lastValueTime) < server.cacheTTL
+	cacheValid := entry.value != nil && server.cacheUsable(entry.lastValueTime)
 	if cacheValid {
-		server.counts.Add(cachedCategory, 1)
+		if time.Since(entry.lastValueTime) >= server.cacheTTL {
+			server.counts.Add(staleCategory, 1)
+		} else {
+			server.counts.Add(cachedCategory, 1)
+		}
 		return entry.value, nil
 	}
 
@@ -446,7 +490,7 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 		server.counts.Add(errorCategory, 1)
 		log.Errorf("Initial WatchSrvKeyspace failed for %v/%v: %v", cell, keyspace, current.Err)
 
-		if time.Since(entry.lastValueTime) > server.cacheTTL {
+		if !server.cacheUsable(entry.lastValueTime) {
 			log.Errorf("WatchSrvKeyspace clearing cached entry for %v/%v", cell, keyspace)
 			entry.value = nil
 		}
@@ -522,6 +566,7 @@ func (server *ResilientServer) WatchSrvVSchema(ctx context.Context, cell string,
 
 		for {
 			current, changes, _ := server.topoServer.WatchSrvVSchema(ctx, cell)
+			server.setSrvVSchemaWatchError(cell, current.Err)
 			callback(current.Value, current.Err)
 			if !foundFirstValue {
 				foundFirstValue = true
@@ -535,6 +580,7 @@ func (server *ResilientServer) WatchSrvVSchema(ctx context.Context, cell string,
 			} else {
 				for c := range changes {
 					// Note we forward topo.ErrNoNode as is.
+					server.setSrvVSchemaWatchError(cell, c.Err)
 					callback(c.Value, c.Err)
 					if c.Err != nil {
 						log.Warningf("Error while watching vschema for cell %s (will wait 5s before retrying): %v", cell, c.Err)
@@ -552,6 +598,69 @@ func (server *ResilientServer) WatchSrvVSchema(ctx context.Context, cell string,
 	wg.Wait()
 }
 
+// setSrvVSchemaWatchError records the state of the SrvVSchema watch of a
+// cell. A missing SrvVSchema is not stale, it's the current value.
+func (server *ResilientServer) setSrvVSchemaWatchError(cell string, err error) {
+	server.srvVSchemaMutex.Lock()
+	defer server.srvVSchemaMutex.Unlock()
+	if err == nil || topo.IsErrType(err, topo.NoNode) {
+		server.srvVSchemaStaleSince[cell] = time.Time{}
+		return
+	}
+	if server.srvVSchemaStaleSince[cell].IsZero() {
+		server.srvVSchemaStaleSince[cell] = time.Now()
+	}
+}
+
+// The next three methods export the staleness of the cached values,
+// in seconds. A value is stale when it can't be refreshed from the topo.
+
+func (server *ResilientServer) srvKeyspaceNamesStaleness() map[string]int64 {
+	result := make(map[string]int64)
+	server.mutex.RLock()
+	defer server.mutex.RUnlock()
+	for _, entry := range server.srvKeyspaceNamesCache {
+		entry.mutex.Lock()
+		staleness := int64(0)
+		if entry.lastError != nil && entry.value != nil {
+			staleness = int64(time.Since(entry.insertionTime).Seconds())
+		}
+		entry.mutex.Unlock()
+		result[entry.cell] = staleness
+	}
+	return result
+}
+
+func (server *ResilientServer) srvKeyspaceStaleness() map[string]int64 {
+	result := make(map[string]int64)
+	server.mutex.RLock()
+	defer server.mutex.RUnlock()
+	for _, entry := range server.srvKeyspaceCache {
+		entry.mutex.RLock()
+		staleness := int64(0)
+		if entry.watchState != watchStateRunning && entry.value != nil {
+			staleness = int64(time.Since(entry.lastValueTime).Seconds())
+		}
+		entry.mutex.RUnlock()
+		result[entry.cell+"."+entry.keyspace] = staleness
+	}
+	return result
+}
+
+func (server *ResilientServer) srvVSchemaStaleness() map[string]int64 {
+	result := make(map[string]int64)
+	server.srvVSchemaMutex.Lock()
+	defer server.srvVSchemaMutex.Unlock()
+	for cell, staleSince := range server.srvVSchemaStaleSince {
+		staleness := int64(0)
+		if !staleSince.IsZero() {
+			staleness = int64(time.Since(staleSince).Seconds())
+		}
+		result[cell] = staleness
+	}
+	return result
+}
+
 // The next few structures and methods are used to get a displayable
 // version of the cache in a status page.
 
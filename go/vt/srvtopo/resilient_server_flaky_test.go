@@ -360,6 +360,65 @@ func TestSrvKeyspaceCachedError(t *testing.T) {
 	}
 }
 
+// TestGetSrvKeyspaceServeStale will test the last known SrvKeyspace is
+// served past the TTL while the topo is failing.
+func TestGetSrvKeyspaceServeStale(t *testing.T) {
+	ts, factory := memorytopo.NewServerAndFactory("test_cell")
+	*srvTopoCacheTTL = 100 * time.Millisecond
+	*srvTopoCacheRefresh = 40 * time.Millisecond
+	*srvTopoServeStale = true
+	defer func() {
+		*srvTopoCacheTTL = 1 * time.Second
+		*srvTopoCacheRefresh = 1 * time.Second
+		*srvTopoServeStale = false
+	}()
+	rs := NewResilientServer(ts, "TestGetSrvKeyspaceServeStale")
+
+	ctx := context.Background()
+	want := &topodatapb.SrvKeyspace{
+		ShardingColumnName: "id",
+		ShardingColumnType: topodatapb.KeyspaceIdType_UINT64,
+	}
+	if err := ts.UpdateSrvKeyspace(ctx, "test_cell", "test_ks", want); err != nil {
+		t.Fatalf("UpdateSrvKeyspace failed: %v", err)
+	}
+	if got, err := rs.GetSrvKeyspace(ctx, "test_cell", "test_ks"); err != nil || !proto.Equal(want, got) {
+		t.Fatalf("GetSrvKeyspace() = %v, %v, want %v", got, err, want)
+	}
+	if staleness := rs.srvKeyspaceStaleness()["test_cell.test_ks"]; staleness != 0 {
+		t.Errorf("staleness while the watch is running: %v, want 0", staleness)
+	}
+
+	// The topo fails for several TTLs, the value is still served.
+	factory.SetError(fmt.Errorf("topo is down"))
+	staleBefore := rs.counts.Counts()[staleCategory]
+	expiry := time.Now().Add(1100 * time.Millisecond)
+	for time.Now().Before(expiry) {
+		got, err := rs.GetSrvKeyspace(ctx, "test_cell", "test_ks")
+		if err != nil || !proto.Equal(want, got) {
+			t.Fatalf("GetSrvKeyspace() during the outage = %v, %v, want %v", got, err, want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if rs.counts.Counts()[staleCategory] == staleBefore {
+		t.Errorf("no stale value was counted")
+	}
+	if staleness := rs.srvKeyspaceStaleness()["test_cell.test_ks"]; staleness < 1 {
+		t.Errorf("staleness during the outage: %v, want at least 1", staleness)
+	}
+
+	// Once the topo is back, the watch is restarted.
+	factory.SetError(nil)
+	expiry = time.Now().Add(5 * time.Second)
+	for rs.srvKeyspaceStaleness()["test_cell.test_ks"] != 0 {
+		if time.Now().After(expiry) {
+			t.Fatalf("timed out waiting for the watch to restart")
+		}
+		rs.GetSrvKeyspace(ctx, "test_cell", "test_ks")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 // TestGetSrvKeyspaceCreated will test we properly get the initial
 // value if the SrvKeyspace already exists.
 func TestGetSrvKeyspaceCreated(t *testing.T) {
@@ -21,7 +21,6 @@ import (
 
 	"golang.org/x/net/context"
 
-	"github.com/hashicorp/consul/api"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo"
 )
@@ -62,10 +61,7 @@ type consulMasterParticipation struct {
 func (mp *consulMasterParticipation) WaitForMastership() (context.Context, error) {
 
 	electionPath := path.Join(mp.s.root, electionsPath, mp.name)
-	l, err := mp.s.client.LockOpts(&api.LockOptions{
-		Key:   electionPath,
-		Value: []byte(mp.id),
-	})
+	l, err := mp.s.client.LockOpts(lockOptions(electionPath, mp.id))
 	if err != nil {
 		return nil, err
 	}
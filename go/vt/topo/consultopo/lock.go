@@ -50,10 +50,7 @@ func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockD
 	lockPath := path.Join(s.root, dirPath, locksFilename)
 
 	// Build the lock structure.
-	l, err := s.client.LockOpts(&api.LockOptions{
-		Key:   lockPath,
-		Value: []byte(contents),
-	})
+	l, err := s.client.LockOpts(lockOptions(lockPath, contents))
 	if err != nil {
 		return nil, err
 	}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/consul/api"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -35,6 +36,14 @@ import (
 
 var (
 	consulAuthClientStaticFile = flag.String("consul_auth_static_file", "", "JSON File to read the topos/tokens from.")
+
+	// The locks and the master elections are held through consul
+	// sessions. If the holder can't renew its session for the TTL,
+	// the session is invalidated and the lock released. The lock can't
+	// be acquired again for the lock delay, so the previous holder has
+	// time to notice it lost it.
+	consulLockSessionTTL = flag.Duration("consul_lock_session_ttl", 15*time.Second, "TTL of the consul sessions holding the locks and master elections")
+	consulLockDelay      = flag.Duration("consul_lock_delay", 15*time.Second, "how long a lock can't be acquired after its consul session was invalidated")
 )
 
 // ClientAuthCred credential to use for consul clusters
@@ -143,6 +152,24 @@ func (s *Server) Close() {
 	s.locks = nil
 }
 
+// lockOptions returns the consul options of a lock on key. The lock
+// key is deleted when its session is invalidated, so a dead holder
+// doesn't leave a lock file behind.
+func lockOptions(key, value string) *api.LockOptions {
+	return &api.LockOptions{
+		Key:   key,
+		Value: []byte(value),
+		// SessionTTL is also the renewal period of the session.
+		SessionTTL: consulLockSessionTTL.String(),
+		SessionOpts: &api.SessionEntry{
+			Name:      api.DefaultLockSessionName,
+			TTL:       consulLockSessionTTL.String(),
+			LockDelay: *consulLockDelay,
+			Behavior:  api.SessionBehaviorDelete,
+		},
+	}
+}
+
 func init() {
 	topo.RegisterFactory("consul", Factory{})
 }
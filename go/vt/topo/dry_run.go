@@ -0,0 +1,253 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+)
+
+// DryRunRecorder is called with each change a dry run Server would
+// make to the topology: action is one of "create", "update", "delete",
+// "lock" or "unlock", and filePath is relative to the root of cell.
+type DryRunRecorder func(cell, action, filePath string)
+
+// NewDryRunServer returns a Server that reads from ts, but keeps its
+// changes in memory instead of writing them, and reports them to
+// record. It dispatches no events, its locks are not taken, and its
+// watches don't see its changes. It is used to run the code of a
+// command without changing the topology, to list what the command
+// would do.
+func NewDryRunServer(ts *Server, record DryRunRecorder) *Server {
+	global := newDryRunConn(GlobalCell, ts.globalCell, record)
+	return &Server{
+		globalCell:         global,
+		globalReadOnlyCell: global,
+		factory: &dryRunFactory{
+			ts:     ts,
+			record: record,
+		},
+		cells:  make(map[string]Conn),
+		dryRun: true,
+	}
+}
+
+// dryRunFactory creates the dry run Conn of a cell, on top of the Conn
+// of the cell in the base Server.
+type dryRunFactory struct {
+	ts     *Server
+	record DryRunRecorder
+}
+
+// HasGlobalReadOnlyCell is part of the Factory interface.
+func (f *dryRunFactory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+// Create is part of the Factory interface.
+func (f *dryRunFactory) Create(cell, serverAddr, root string) (Conn, error) {
+	conn, err := f.ts.ConnForCell(context.Background(), cell)
+	if err != nil {
+		return nil, err
+	}
+	return newDryRunConn(cell, conn, f.record), nil
+}
+
+// dryRunVersion is the version of a file changed by a dry run.
+type dryRunVersion uint64
+
+// String is part of the Version interface.
+func (v dryRunVersion) String() string {
+	return fmt.Sprintf("dry-run-%d", uint64(v))
+}
+
+// dryRunFile is a file changed by a dry run. A nil contents means the
+// file was deleted.
+type dryRunFile struct {
+	contents []byte
+	version  dryRunVersion
+}
+
+// dryRunConn is a Conn that reads from conn, and keeps its changes in
+// memory.
+type dryRunConn struct {
+	cell   string
+	conn   Conn
+	record DryRunRecorder
+
+	mu      sync.Mutex
+	files   map[string]*dryRunFile
+	version dryRunVersion
+}
+
+func newDryRunConn(cell string, conn Conn, record DryRunRecorder) *dryRunConn {
+	return &dryRunConn{
+		cell:   cell,
+		conn:   conn,
+		record: record,
+		files:  make(map[string]*dryRunFile),
+	}
+}
+
+// set changes a file in memory, and records the change.
+func (c *dryRunConn) set(action, filePath string, contents []byte) Version {
+	c.version++
+	c.files[filePath] = &dryRunFile{
+		contents: contents,
+		version:  c.version,
+	}
+	c.record(c.cell, action, filePath)
+	return c.version
+}
+
+// ListDir is part of the Conn interface.
+func (c *dryRunConn) ListDir(ctx context.Context, dirPath string, full bool) ([]DirEntry, error) {
+	entries, err := c.conn.ListDir(ctx, dirPath, full)
+	if err != nil && !IsErrType(err, NoNode) {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	byName := make(map[string]DirEntry, len(entries))
+	for _, e := range entries {
+		byName[e.Name] = e
+	}
+	prefix := strings.TrimSuffix(dirPath, "/") + "/"
+	for filePath, f := range c.files {
+		if !strings.HasPrefix(filePath, prefix) {
+			continue
+		}
+		name := strings.TrimPrefix(filePath, prefix)
+		entry := DirEntry{Name: name, Type: TypeFile}
+		if i := strings.Index(name, "/"); i >= 0 {
+			entry = DirEntry{Name: name[:i], Type: TypeDirectory}
+		}
+		switch {
+		case entry.Type == TypeDirectory:
+			if f.contents != nil {
+				byName[entry.Name] = entry
+			}
+		case f.contents == nil:
+			delete(byName, entry.Name)
+		default:
+			byName[entry.Name] = entry
+		}
+	}
+	if len(byName) == 0 {
+		if err != nil {
+			return nil, err
+		}
+		return nil, NewError(NoNode, dirPath)
+	}
+	result := make([]DirEntry, 0, len(byName))
+	for _, e := range byName {
+		result = append(result, e)
+	}
+	DirEntriesSortByName(result)
+	return result, nil
+}
+
+// Create is part of the Conn interface.
+func (c *dryRunConn) Create(ctx context.Context, filePath string, contents []byte) (Version, error) {
+	if _, _, err := c.Get(ctx, filePath); err == nil {
+		return nil, NewError(NodeExists, filePath)
+	} else if !IsErrType(err, NoNode) {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.set("create", filePath, contents), nil
+}
+
+// Update is part of the Conn interface. The version is not checked, as
+// nothing else changes the files of a dry run.
+func (c *dryRunConn) Update(ctx context.Context, filePath string, contents []byte, version Version) (Version, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.set("update", filePath, contents), nil
+}
+
+// Get is part of the Conn interface.
+func (c *dryRunConn) Get(ctx context.Context, filePath string) ([]byte, Version, error) {
+	c.mu.Lock()
+	f, ok := c.files[filePath]
+	c.mu.Unlock()
+	if !ok {
+		return c.conn.Get(ctx, filePath)
+	}
+	if f.contents == nil {
+		return nil, nil, NewError(NoNode, filePath)
+	}
+	return f.contents, f.version, nil
+}
+
+// Delete is part of the Conn interface.
+func (c *dryRunConn) Delete(ctx context.Context, filePath string, version Version) error {
+	if _, _, err := c.Get(ctx, filePath); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set("delete", filePath, nil)
+	return nil
+}
+
+// Lock is part of the Conn interface. It only records the lock.
+func (c *dryRunConn) Lock(ctx context.Context, dirPath, contents string) (LockDescriptor, error) {
+	c.record(c.cell, "lock", dirPath)
+	return &dryRunLockDescriptor{
+		conn:    c,
+		dirPath: dirPath,
+	}, nil
+}
+
+// Watch is part of the Conn interface. The changes of the dry run are
+// not seen.
+func (c *dryRunConn) Watch(ctx context.Context, filePath string) (*WatchData, <-chan *WatchData, CancelFunc) {
+	return c.conn.Watch(ctx, filePath)
+}
+
+// NewMasterParticipation is part of the Conn interface.
+func (c *dryRunConn) NewMasterParticipation(name, id string) (MasterParticipation, error) {
+	return nil, fmt.Errorf("cannot run master election %v in a dry run", name)
+}
+
+// Close is part of the Conn interface. The Conn of the base Server is
+// left open.
+func (c *dryRunConn) Close() {
+}
+
+// dryRunLockDescriptor is the LockDescriptor of a dryRunConn.
+type dryRunLockDescriptor struct {
+	conn    *dryRunConn
+	dirPath string
+}
+
+// Check is part of the LockDescriptor interface.
+func (ld *dryRunLockDescriptor) Check(ctx context.Context) error {
+	return nil
+}
+
+// Unlock is part of the LockDescriptor interface.
+func (ld *dryRunLockDescriptor) Unlock(ctx context.Context) error {
+	ld.conn.record(ld.conn.cell, "unlock", ld.dirPath)
+	return nil
+}
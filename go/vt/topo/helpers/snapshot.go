@@ -0,0 +1,310 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// SnapshotVersion is the version of the Snapshot format. It changes
+// when a snapshot can't be imported by an older version any more.
+const SnapshotVersion = 1
+
+// Snapshot is a copy of the topology, saved as JSON. It can be
+// imported into a fresh topo server, to recover from the loss of the
+// topology, or to clone an environment.
+type Snapshot struct {
+	Version    int
+	CreateTime time.Time
+
+	// CellInfos and CellsAliases are indexed by name.
+	CellInfos    map[string]*topodatapb.CellInfo
+	CellsAliases map[string]*topodatapb.CellsAlias `json:",omitempty"`
+
+	Keyspaces    map[string]*KeyspaceSnapshot
+	RoutingRules *vschemapb.RoutingRules `json:",omitempty"`
+
+	// SrvKeyspaces is indexed by cell, then keyspace, and SrvVSchemas
+	// by cell.
+	SrvKeyspaces map[string]map[string]*topodatapb.SrvKeyspace
+	SrvVSchemas  map[string]*vschemapb.SrvVSchema
+
+	// Tablets are only exported on demand, as they register
+	// themselves when they start.
+	Tablets []*topodatapb.Tablet `json:",omitempty"`
+}
+
+// KeyspaceSnapshot has the global objects of a keyspace.
+type KeyspaceSnapshot struct {
+	Keyspace *topodatapb.Keyspace
+	VSchema  *vschemapb.Keyspace `json:",omitempty"`
+	TableACL *tableaclpb.Config  `json:",omitempty"`
+	Quota    *quotapb.Config     `json:",omitempty"`
+	// Shards is indexed by shard name.
+	Shards map[string]*topodatapb.Shard
+}
+
+// ExportSnapshot reads the topology into a Snapshot. The tablets are
+// included if includeTablets is set.
+func ExportSnapshot(ctx context.Context, ts *topo.Server, includeTablets bool) (*Snapshot, error) {
+	snapshot := &Snapshot{
+		Version:      SnapshotVersion,
+		CreateTime:   time.Now(),
+		CellInfos:    make(map[string]*topodatapb.CellInfo),
+		Keyspaces:    make(map[string]*KeyspaceSnapshot),
+		SrvKeyspaces: make(map[string]map[string]*topodatapb.SrvKeyspace),
+		SrvVSchemas:  make(map[string]*vschemapb.SrvVSchema),
+	}
+
+	cells, err := ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetCellInfoNames failed: %v", err)
+	}
+	for _, cell := range cells {
+		ci, err := ts.GetCellInfo(ctx, cell, true /* strongRead */)
+		if err != nil {
+			return nil, fmt.Errorf("GetCellInfo(%v) failed: %v", cell, err)
+		}
+		snapshot.CellInfos[cell] = ci
+	}
+	if snapshot.CellsAliases, err = ts.GetCellsAliases(ctx, true /* strongRead */); err != nil {
+		return nil, fmt.Errorf("GetCellsAliases failed: %v", err)
+	}
+
+	keyspaces, err := ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetKeyspaces failed: %v", err)
+	}
+	for _, keyspace := range keyspaces {
+		ks, err := exportKeyspace(ctx, ts, keyspace)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Keyspaces[keyspace] = ks
+	}
+
+	rr, err := ts.GetRoutingRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetRoutingRules failed: %v", err)
+	}
+	if len(rr.Rules) > 0 {
+		snapshot.RoutingRules = rr
+	}
+
+	for _, cell := range cells {
+		if err := exportCell(ctx, ts, cell, snapshot, includeTablets); err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+func exportKeyspace(ctx context.Context, ts *topo.Server, keyspace string) (*KeyspaceSnapshot, error) {
+	ki, err := ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("GetKeyspace(%v) failed: %v", keyspace, err)
+	}
+	ks := &KeyspaceSnapshot{
+		Keyspace: ki.Keyspace,
+		Shards:   make(map[string]*topodatapb.Shard),
+	}
+	ks.VSchema, err = ts.GetVSchema(ctx, keyspace)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return nil, fmt.Errorf("GetVSchema(%v) failed: %v", keyspace, err)
+	}
+	ks.TableACL, err = ts.GetTableACL(ctx, keyspace)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return nil, fmt.Errorf("GetTableACL(%v) failed: %v", keyspace, err)
+	}
+	ks.Quota, err = ts.GetQuota(ctx, keyspace)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return nil, fmt.Errorf("GetQuota(%v) failed: %v", keyspace, err)
+	}
+
+	shards, err := ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("GetShardNames(%v) failed: %v", keyspace, err)
+	}
+	for _, shard := range shards {
+		si, err := ts.GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shard, err)
+		}
+		ks.Shards[shard] = si.Shard
+	}
+	return ks, nil
+}
+
+func exportCell(ctx context.Context, ts *topo.Server, cell string, snapshot *Snapshot, includeTablets bool) error {
+	keyspaces, err := ts.GetSrvKeyspaceNames(ctx, cell)
+	if err != nil {
+		return fmt.Errorf("GetSrvKeyspaceNames(%v) failed: %v", cell, err)
+	}
+	for _, keyspace := range keyspaces {
+		srvKeyspace, err := ts.GetSrvKeyspace(ctx, cell, keyspace)
+		switch {
+		case err == nil:
+		case topo.IsErrType(err, topo.NoNode):
+			// The keyspace only has replication data in this cell.
+			continue
+		default:
+			return fmt.Errorf("GetSrvKeyspace(%v, %v) failed: %v", cell, keyspace, err)
+		}
+		if snapshot.SrvKeyspaces[cell] == nil {
+			snapshot.SrvKeyspaces[cell] = make(map[string]*topodatapb.SrvKeyspace)
+		}
+		snapshot.SrvKeyspaces[cell][keyspace] = srvKeyspace
+	}
+
+	srvVSchema, err := ts.GetSrvVSchema(ctx, cell)
+	switch {
+	case err == nil:
+		snapshot.SrvVSchemas[cell] = srvVSchema
+	case topo.IsErrType(err, topo.NoNode):
+		// Nothing to export.
+	default:
+		return fmt.Errorf("GetSrvVSchema(%v) failed: %v", cell, err)
+	}
+
+	if !includeTablets {
+		return nil
+	}
+	aliases, err := ts.GetTabletsByCell(ctx, cell)
+	if err != nil {
+		return fmt.Errorf("GetTabletsByCell(%v) failed: %v", cell, err)
+	}
+	sort.Slice(aliases, func(i, j int) bool {
+		return topoproto.TabletAliasString(aliases[i]) < topoproto.TabletAliasString(aliases[j])
+	})
+	for _, alias := range aliases {
+		ti, err := ts.GetTablet(ctx, alias)
+		if err != nil {
+			return fmt.Errorf("GetTablet(%v) failed: %v", topoproto.TabletAliasString(alias), err)
+		}
+		snapshot.Tablets = append(snapshot.Tablets, ti.Tablet)
+	}
+	return nil
+}
+
+// ImportSnapshot writes a Snapshot into a topology. The topology must
+// not have any of its keyspaces or tablets yet. The cells that already
+// exist are kept as they are. The tablets of the snapshot are imported
+// if includeTablets is set.
+func ImportSnapshot(ctx context.Context, ts *topo.Server, snapshot *Snapshot, includeTablets bool) error {
+	if snapshot.Version > SnapshotVersion {
+		return fmt.Errorf("snapshot version %v is not supported, the latest supported version is %v", snapshot.Version, SnapshotVersion)
+	}
+
+	// The cells come first, as their topo servers are needed to
+	// import the serving graph and the tablets.
+	for cell, ci := range snapshot.CellInfos {
+		if err := ts.CreateCellInfo(ctx, cell, ci); err != nil {
+			if !topo.IsErrType(err, topo.NodeExists) {
+				return fmt.Errorf("CreateCellInfo(%v) failed: %v", cell, err)
+			}
+			log.Warningf("cell %v already exists, keeping it", cell)
+		}
+	}
+	for alias, cellsAlias := range snapshot.CellsAliases {
+		if err := ts.CreateCellsAlias(ctx, alias, cellsAlias); err != nil {
+			if !topo.IsErrType(err, topo.NodeExists) {
+				return fmt.Errorf("CreateCellsAlias(%v) failed: %v", alias, err)
+			}
+			log.Warningf("cells alias %v already exists, keeping it", alias)
+		}
+	}
+
+	for keyspace, ks := range snapshot.Keyspaces {
+		if err := importKeyspace(ctx, ts, keyspace, ks); err != nil {
+			return err
+		}
+	}
+	if snapshot.RoutingRules != nil {
+		if err := ts.SaveRoutingRules(ctx, snapshot.RoutingRules); err != nil {
+			return fmt.Errorf("SaveRoutingRules failed: %v", err)
+		}
+	}
+
+	for cell, srvKeyspaces := range snapshot.SrvKeyspaces {
+		for keyspace, srvKeyspace := range srvKeyspaces {
+			if err := ts.UpdateSrvKeyspace(ctx, cell, keyspace, srvKeyspace); err != nil {
+				return fmt.Errorf("UpdateSrvKeyspace(%v, %v) failed: %v", cell, keyspace, err)
+			}
+		}
+	}
+	for cell, srvVSchema := range snapshot.SrvVSchemas {
+		if err := ts.UpdateSrvVSchema(ctx, cell, srvVSchema); err != nil {
+			return fmt.Errorf("UpdateSrvVSchema(%v) failed: %v", cell, err)
+		}
+	}
+
+	if !includeTablets {
+		return nil
+	}
+	for _, tablet := range snapshot.Tablets {
+		if err := ts.CreateTablet(ctx, tablet); err != nil {
+			return fmt.Errorf("CreateTablet(%v) failed: %v", topoproto.TabletAliasString(tablet.Alias), err)
+		}
+	}
+	return nil
+}
+
+func importKeyspace(ctx context.Context, ts *topo.Server, keyspace string, ks *KeyspaceSnapshot) error {
+	if err := ts.CreateKeyspace(ctx, keyspace, ks.Keyspace); err != nil {
+		return fmt.Errorf("CreateKeyspace(%v) failed: %v", keyspace, err)
+	}
+	if ks.VSchema != nil {
+		if err := ts.SaveVSchema(ctx, keyspace, ks.VSchema); err != nil {
+			return fmt.Errorf("SaveVSchema(%v) failed: %v", keyspace, err)
+		}
+	}
+	if ks.TableACL != nil {
+		if err := ts.SaveTableACL(ctx, keyspace, ks.TableACL); err != nil {
+			return fmt.Errorf("SaveTableACL(%v) failed: %v", keyspace, err)
+		}
+	}
+	if ks.Quota != nil {
+		if err := ts.SaveQuota(ctx, keyspace, ks.Quota); err != nil {
+			return fmt.Errorf("SaveQuota(%v) failed: %v", keyspace, err)
+		}
+	}
+	for shard, value := range ks.Shards {
+		if err := ts.CreateShard(ctx, keyspace, shard); err != nil {
+			return fmt.Errorf("CreateShard(%v, %v) failed: %v", keyspace, shard, err)
+		}
+		if _, err := ts.UpdateShardFields(ctx, keyspace, shard, func(si *topo.ShardInfo) error {
+			*si.Shard = *value
+			return nil
+		}); err != nil {
+			return fmt.Errorf("UpdateShardFields(%v, %v) failed: %v", keyspace, shard, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func marshalSnapshot(t *testing.T, snapshot *Snapshot) string {
+	// The creation times differ.
+	snapshot.CreateTime = time.Time{}
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestSnapshot(t *testing.T) {
+	ctx := context.Background()
+	fromTS, toTS := createSetup(ctx, t)
+	if err := fromTS.SaveVSchema(ctx, "test_keyspace", &vschemapb.Keyspace{Sharded: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := fromTS.SaveRoutingRules(ctx, &vschemapb.RoutingRules{
+		Rules: []*vschemapb.RoutingRule{{FromTable: "t1", ToTables: []string{"test_keyspace.t1"}}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := fromTS.RebuildSrvVSchema(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := fromTS.UpdateSrvKeyspace(ctx, "test_cell", "test_keyspace", &topodatapb.SrvKeyspace{
+		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{{
+			ServedType:      topodatapb.TabletType_MASTER,
+			ShardReferences: []*topodatapb.ShardReference{{Name: "0"}},
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := ExportSnapshot(ctx, fromTS, true /* includeTablets */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.Version != SnapshotVersion || len(snapshot.Keyspaces) != 1 || len(snapshot.Tablets) != 2 {
+		t.Fatalf("ExportSnapshot: %+v, want one keyspace and two tablets", snapshot)
+	}
+	want := marshalSnapshot(t, snapshot)
+
+	// The snapshot survives a JSON round trip.
+	imported := &Snapshot{}
+	if err := json.Unmarshal([]byte(want), imported); err != nil {
+		t.Fatal(err)
+	}
+	if err := ImportSnapshot(ctx, toTS, imported, true /* includeTablets */); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ExportSnapshot(ctx, toTS, true /* includeTablets */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if marshalSnapshot(t, got) != want {
+		t.Errorf("imported snapshot:\n%v\nwant:\n%v", marshalSnapshot(t, got), want)
+	}
+
+	// The keyspaces are only imported into a fresh topology.
+	if err := ImportSnapshot(ctx, toTS, imported, false /* includeTablets */); err == nil || !strings.Contains(err.Error(), "CreateKeyspace(test_keyspace)") {
+		t.Errorf("second ImportSnapshot: %v, want CreateKeyspace error", err)
+	}
+
+	// The snapshots of a newer version are rejected.
+	imported.Version = SnapshotVersion + 1
+	if err := ImportSnapshot(ctx, memorytopo.NewServer("test_cell"), imported, false /* includeTablets */); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("ImportSnapshot of a newer version: %v, want not supported", err)
+	}
+}
+
+func TestSnapshotWithoutTablets(t *testing.T) {
+	ctx := context.Background()
+	fromTS, toTS := createSetup(ctx, t)
+	snapshot, err := ExportSnapshot(ctx, fromTS, false /* includeTablets */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshot.Tablets) != 0 {
+		t.Errorf("ExportSnapshot without tablets: %v", snapshot.Tablets)
+	}
+	if err := ImportSnapshot(ctx, toTS, snapshot, false /* includeTablets */); err != nil {
+		t.Fatal(err)
+	}
+	if aliases, err := toTS.GetTabletsByCell(ctx, "test_cell"); err != nil || len(aliases) != 0 {
+		t.Errorf("GetTabletsByCell: %v, %v, want no tablets", aliases, err)
+	}
+	if _, err := toTS.GetShard(ctx, "test_keyspace", "0"); err != nil {
+		t.Errorf("GetShard: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8stopo
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// ListDir is part of the topo.Conn interface.
+func (s *Server) ListDir(ctx context.Context, dirPath string, full bool) ([]topo.DirEntry, error) {
+	dirPath = cleanPath(dirPath)
+	isRoot := dirPath == ""
+
+	// All the files under the directory have its label.
+	list := &topoNodeList{}
+	if err := s.do(ctx, http.MethodGet, s.resourceURL+"?labelSelector="+url.QueryEscape(s.dirLabel(dirPath)), nil, list); err != nil {
+		return nil, convertError(err, dirPath)
+	}
+	if len(list.Items) == 0 {
+		// No file under this directory, it doesn't exist.
+		return nil, topo.NewError(topo.NoNode, dirPath)
+	}
+
+	prefix := ""
+	if !isRoot {
+		prefix = dirPath + "/"
+	}
+	entries := make(map[string]topo.DirEntry)
+	for _, node := range list.Items {
+		if !strings.HasPrefix(node.Data.Key, prefix) {
+			// This is a hash collision of the label.
+			continue
+		}
+		p := node.Data.Key[len(prefix):]
+
+		// Keep only the part until the first '/'.
+		t := topo.TypeFile
+		if i := strings.Index(p, "/"); i >= 0 {
+			p = p[:i]
+			t = topo.TypeDirectory
+		}
+		if _, ok := entries[p]; ok {
+			continue
+		}
+		e := topo.DirEntry{
+			Name: p,
+		}
+		if full {
+			e.Type = t
+			if isRoot && p == electionsPath {
+				e.Ephemeral = true
+			}
+			if t == topo.TypeFile && node.Data.Ephemeral {
+				e.Ephemeral = true
+			}
+		}
+		entries[p] = e
+	}
+	if len(entries) == 0 {
+		return nil, topo.NewError(topo.NoNode, dirPath)
+	}
+
+	result := make([]topo.DirEntry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, e)
+	}
+	topo.DirEntriesSortByName(result)
+	return result, nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8stopo
+
+import (
+	"path"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// NewMasterParticipation is part of the topo.Server interface
+func (s *Server) NewMasterParticipation(name, id string) (topo.MasterParticipation, error) {
+	return &k8sMasterParticipation{
+		s:    s,
+		name: name,
+		id:   id,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}, nil
+}
+
+// k8sMasterParticipation implements topo.MasterParticipation.
+//
+// We use a lease with name elections/<name>, that contains the id.
+type k8sMasterParticipation struct {
+	// s is our parent Kubernetes topo Server
+	s *Server
+
+	// name is the name of this MasterParticipation
+	name string
+
+	// id is the process's current id.
+	id string
+
+	// stop is a channel closed when Stop is called.
+	stop chan struct{}
+
+	// done is a channel closed when we're done processing the Stop
+	done chan struct{}
+}
+
+// WaitForMastership is part of the topo.MasterParticipation interface.
+func (mp *k8sMasterParticipation) WaitForMastership() (context.Context, error) {
+	// If Stop was already called, mp.done is closed, so we are interrupted.
+	select {
+	case <-mp.done:
+		return nil, topo.NewError(topo.Interrupted, "mastership")
+	default:
+	}
+
+	// Try to get the lease until mp.stop is closed.
+	l, err := mp.s.acquireLease(context.Background(), path.Join(electionsPath, mp.name), []byte(mp.id), mp.stop)
+	if err != nil {
+		// We can't get it. See if it was because we got canceled.
+		select {
+		case <-mp.stop:
+			close(mp.done)
+		default:
+		}
+		return nil, err
+	}
+
+	// We have the lease, keep mastership until we lose it.
+	lockCtx, lockCancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-l.lost:
+			lockCancel()
+		case <-mp.stop:
+			// Stop was called. We stop the context first,
+			// so the running process is not thinking it
+			// is the master any more, then we release the lease.
+			lockCancel()
+			if err := l.release(context.Background()); err != nil {
+				log.Errorf("master election(%v) release failed: %v", mp.name, err)
+			}
+			close(mp.done)
+		}
+	}()
+
+	return lockCtx, nil
+}
+
+// Stop is part of the topo.MasterParticipation interface
+func (mp *k8sMasterParticipation) Stop() {
+	close(mp.stop)
+	<-mp.done
+}
+
+// GetCurrentMasterID is part of the topo.MasterParticipation interface
+func (mp *k8sMasterParticipation) GetCurrentMasterID(ctx context.Context) (string, error) {
+	contents, _, err := mp.s.Get(ctx, path.Join(electionsPath, mp.name))
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return "", nil
+		}
+		return "", err
+	}
+	return string(contents), nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8stopo
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// apiError is the Status object returned by the API server on failure.
+type apiError struct {
+	Code    int    `json:"code"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// Error is part of the error interface.
+func (e *apiError) Error() string {
+	return fmt.Sprintf("kubernetes API error %v (%v): %v", e.Code, e.Reason, e.Message)
+}
+
+// convertError converts API server and context errors into topo
+// errors. Other errors are returned as is.
+func convertError(err error, nodePath string) error {
+	// The http client wraps the context errors.
+	cause := err
+	if urlErr, ok := err.(*url.Error); ok {
+		cause = urlErr.Err
+	}
+	switch cause {
+	case context.Canceled:
+		return topo.NewError(topo.Interrupted, nodePath)
+	case context.DeadlineExceeded:
+		return topo.NewError(topo.Timeout, nodePath)
+	}
+	if apiErr, ok := err.(*apiError); ok {
+		switch apiErr.Code {
+		case http.StatusNotFound:
+			return topo.NewError(topo.NoNode, nodePath)
+		case http.StatusConflict:
+			// A create returns AlreadyExists, an update or delete
+			// with an old resource version returns Conflict.
+			if apiErr.Reason == "AlreadyExists" {
+				return topo.NewError(topo.NodeExists, nodePath)
+			}
+			return topo.NewError(topo.BadVersion, nodePath)
+		}
+	}
+	return err
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8stopo
+
+import (
+	"fmt"
+	"net/http"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// deleteOptions is the body of a conditional delete.
+type deleteOptions struct {
+	APIVersion    string        `json:"apiVersion"`
+	Kind          string        `json:"kind"`
+	Preconditions preconditions `json:"preconditions"`
+}
+
+type preconditions struct {
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// Create is part of the topo.Conn interface.
+func (s *Server) Create(ctx context.Context, filePath string, contents []byte) (topo.Version, error) {
+	filePath = cleanPath(filePath)
+	return s.create(ctx, s.newTopoNode(filePath, contents, ""))
+}
+
+// create creates the object of a file.
+func (s *Server) create(ctx context.Context, node *topoNode) (topo.Version, error) {
+	result := &topoNode{}
+	if err := s.do(ctx, http.MethodPost, s.resourceURL, node, result); err != nil {
+		return nil, convertError(err, node.Data.Key)
+	}
+	return KubernetesVersion(result.Metadata.ResourceVersion), nil
+}
+
+// Update is part of the topo.Conn interface.
+func (s *Server) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	filePath = cleanPath(filePath)
+	return s.update(ctx, s.newTopoNode(filePath, contents, versionString(version)))
+}
+
+// update replaces the object of a file. Without a resource version,
+// the object is created if it doesn't exist.
+func (s *Server) update(ctx context.Context, node *topoNode) (topo.Version, error) {
+	for {
+		result := &topoNode{}
+		err := s.do(ctx, http.MethodPut, s.resourceURL+"/"+node.Metadata.Name, node, result)
+		if err == nil {
+			return KubernetesVersion(result.Metadata.ResourceVersion), nil
+		}
+		err = convertError(err, node.Data.Key)
+		if node.Metadata.ResourceVersion != "" || !topo.IsErrType(err, topo.NoNode) {
+			return nil, err
+		}
+
+		// The unconditional update of a missing file is a create.
+		// If it was created concurrently, we update it again.
+		version, err := s.create(ctx, node)
+		if !topo.IsErrType(err, topo.NodeExists) {
+			return version, err
+		}
+	}
+}
+
+// Get is part of the topo.Conn interface.
+func (s *Server) Get(ctx context.Context, filePath string) ([]byte, topo.Version, error) {
+	node, err := s.get(ctx, cleanPath(filePath))
+	if err != nil {
+		return nil, nil, err
+	}
+	return node.Data.Value, KubernetesVersion(node.Metadata.ResourceVersion), nil
+}
+
+// get returns the object of a file.
+func (s *Server) get(ctx context.Context, filePath string) (*topoNode, error) {
+	node := &topoNode{}
+	if err := s.do(ctx, http.MethodGet, s.resourceURL+"/"+s.objectName(filePath), nil, node); err != nil {
+		return nil, convertError(err, filePath)
+	}
+	if node.Data.Key != filePath {
+		return nil, fmt.Errorf("object %v is for file %v, not %v", node.Metadata.Name, node.Data.Key, filePath)
+	}
+	return node, nil
+}
+
+// Delete is part of the topo.Conn interface.
+func (s *Server) Delete(ctx context.Context, filePath string, version topo.Version) error {
+	filePath = cleanPath(filePath)
+	return s.delete(ctx, filePath, versionString(version))
+}
+
+// delete deletes the object of a file, if its resource version is
+// still the provided one.
+func (s *Server) delete(ctx context.Context, filePath, version string) error {
+	var body interface{}
+	if version != "" {
+		body = &deleteOptions{
+			APIVersion:    "v1",
+			Kind:          "DeleteOptions",
+			Preconditions: preconditions{ResourceVersion: version},
+		}
+	}
+	if err := s.do(ctx, http.MethodDelete, s.resourceURL+"/"+s.objectName(filePath), body, nil); err != nil {
+		return convertError(err, filePath)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8stopo
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// leaseRetryInterval is how often a held lease is checked by the
+// processes waiting for it.
+const leaseRetryInterval = time.Second
+
+// lease is an ephemeral file, held as long as its owner renews it.
+// The locks and the master elections are leases.
+//
+// The API server has no sessions, so a lease that wasn't renewed for
+// -topo_k8s_lock_ttl is abandoned, and can be deleted by the processes
+// waiting for it. The waiters measure that time with their own clock,
+// from when they first saw the current version of the lease.
+type lease struct {
+	s        *Server
+	filePath string
+
+	// mu protects node and released.
+	mu       sync.Mutex
+	node     *topoNode
+	released bool
+
+	// stop is closed to stop the renewals, and done is closed when
+	// they are stopped.
+	stop chan struct{}
+	done chan struct{}
+
+	// lost is closed when the lease couldn't be renewed.
+	lost chan struct{}
+}
+
+// acquireLease creates the ephemeral file, waiting for its current
+// holder to release or abandon it. It gives up when ctx is done, or
+// when interrupt is closed.
+func (s *Server) acquireLease(ctx context.Context, filePath string, contents []byte, interrupt <-chan struct{}) (*lease, error) {
+	node := s.newTopoNode(filePath, contents, "")
+	node.Data.Ephemeral = true
+
+	// seenVersion is the version of the lease held by another
+	// process, and seenTime is when we first saw it.
+	var seenVersion string
+	var seenTime time.Time
+	for {
+		version, err := s.create(ctx, node)
+		if err == nil {
+			node.Metadata.ResourceVersion = string(version.(KubernetesVersion))
+			l := &lease{
+				s:        s,
+				filePath: filePath,
+				node:     node,
+				stop:     make(chan struct{}),
+				done:     make(chan struct{}),
+				lost:     make(chan struct{}),
+			}
+			go l.renew()
+			return l, nil
+		}
+		if !topo.IsErrType(err, topo.NodeExists) {
+			return nil, err
+		}
+
+		current, err := s.get(ctx, filePath)
+		switch {
+		case err == nil:
+			if current.Metadata.ResourceVersion != seenVersion {
+				seenVersion = current.Metadata.ResourceVersion
+				seenTime = time.Now()
+			} else if time.Since(seenTime) > *lockTTL {
+				log.Warningf("lease %v was not renewed for %v, deleting it", filePath, *lockTTL)
+				if err := s.delete(ctx, filePath, seenVersion); err != nil && !topo.IsErrType(err, topo.NoNode) && !topo.IsErrType(err, topo.BadVersion) {
+					return nil, err
+				}
+				continue
+			}
+		case topo.IsErrType(err, topo.NoNode):
+			// It was just released, try again.
+			continue
+		default:
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, convertError(ctx.Err(), filePath)
+		case <-interrupt:
+			return nil, topo.NewError(topo.Interrupted, filePath)
+		case <-time.After(leaseRetryInterval):
+		}
+	}
+}
+
+// renew updates the lease regularly, until stop is closed. If it
+// can't, the lease is lost.
+func (l *lease) renew() {
+	defer close(l.done)
+
+	interval := *lockTTL / 3
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	lastRenewal := time.Now()
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+		}
+
+		l.mu.Lock()
+		node := *l.node
+		l.mu.Unlock()
+		node.Data.Renewals++
+		ctx, cancel := context.WithTimeout(context.Background(), interval)
+		version, err := l.s.update(ctx, &node)
+		cancel()
+		if err == nil {
+			node.Metadata.ResourceVersion = string(version.(KubernetesVersion))
+			l.mu.Lock()
+			l.node = &node
+			l.mu.Unlock()
+			lastRenewal = time.Now()
+			continue
+		}
+
+		// The lease is lost if it was changed or deleted, or if we
+		// couldn't renew it before the waiters may consider it
+		// abandoned.
+		if topo.IsErrType(err, topo.BadVersion) || topo.IsErrType(err, topo.NoNode) || time.Since(lastRenewal)+interval > *lockTTL {
+			log.Errorf("lost lease %v: %v", l.filePath, err)
+			close(l.lost)
+			return
+		}
+		log.Warningf("failed to renew lease %v, will retry: %v", l.filePath, err)
+	}
+}
+
+// check returns an error if the lease was lost.
+func (l *lease) check() error {
+	select {
+	case <-l.lost:
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "lease %v was lost", l.filePath)
+	default:
+	}
+	return nil
+}
+
+// release stops the renewals, and deletes the lease if it still
+// holds it. A lease can only be released once.
+func (l *lease) release(ctx context.Context) error {
+	l.mu.Lock()
+	if l.released {
+		l.mu.Unlock()
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "lease %v was already released", l.filePath)
+	}
+	l.released = true
+	l.mu.Unlock()
+	close(l.stop)
+	<-l.done
+	if err := l.check(); err != nil {
+		return err
+	}
+	l.mu.Lock()
+	version := l.node.Metadata.ResourceVersion
+	l.mu.Unlock()
+	return l.s.delete(ctx, l.filePath, version)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8stopo
+
+import (
+	"path"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// k8sLockDescriptor implements topo.LockDescriptor.
+type k8sLockDescriptor struct {
+	l *lease
+}
+
+// Lock is part of the topo.Conn interface.
+func (s *Server) Lock(ctx context.Context, dirPath, contents string) (topo.LockDescriptor, error) {
+	dirPath = cleanPath(dirPath)
+
+	// We list the directory first to make sure it exists.
+	if _, err := s.ListDir(ctx, dirPath, false /*full*/); err != nil {
+		return nil, err
+	}
+
+	l, err := s.acquireLease(ctx, path.Join(dirPath, locksFilename), []byte(contents), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sLockDescriptor{l: l}, nil
+}
+
+// Check is part of the topo.LockDescriptor interface.
+func (ld *k8sLockDescriptor) Check(ctx context.Context) error {
+	return ld.l.check()
+}
+
+// Unlock is part of the topo.LockDescriptor interface.
+func (ld *k8sLockDescriptor) Unlock(ctx context.Context) error {
+	return ld.l.release(ctx)
+}
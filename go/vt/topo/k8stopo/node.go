@@ -0,0 +1,134 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8stopo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
+	"strings"
+)
+
+const (
+	// apiVersion is the group and version of the custom resource.
+	apiVersion = "topo.vitess.io/v1beta1"
+
+	// resourceKind and resourcePlural name the custom resource.
+	resourceKind   = "VitessTopoNode"
+	resourcePlural = "vitesstoponodes"
+
+	// dirLabelPrefix is the prefix of the labels that mark the
+	// directories containing a node, see dirLabel.
+	dirLabelPrefix = "topo.vitess.io/d-"
+
+	// Path components
+	locksFilename = "Lock"
+	electionsPath = "elections"
+)
+
+// topoNode is a VitessTopoNode object.
+type topoNode struct {
+	APIVersion string     `json:"apiVersion"`
+	Kind       string     `json:"kind"`
+	Metadata   objectMeta `json:"metadata"`
+	Data       nodeData   `json:"data"`
+}
+
+// objectMeta is the part of the Kubernetes object metadata we use.
+type objectMeta struct {
+	Name            string            `json:"name"`
+	ResourceVersion string            `json:"resourceVersion,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+}
+
+// nodeData is the content of a topo file.
+type nodeData struct {
+	// Key is the path of the file, relative to the root. The object
+	// name is a hash of it, so it is kept to list the directories.
+	Key string `json:"key"`
+	// Value is the file contents, encoded in base64 in JSON.
+	Value []byte `json:"value"`
+	// Ephemeral is set for the lock and master election files.
+	Ephemeral bool `json:"ephemeral,omitempty"`
+	// Renewals counts the renewals of an ephemeral file. The API
+	// server doesn't change the resource version of an unchanged
+	// object, so the renewals change it.
+	Renewals int64 `json:"renewals,omitempty"`
+}
+
+// topoNodeList is a list of VitessTopoNode objects.
+type topoNodeList struct {
+	Items []*topoNode `json:"items"`
+}
+
+// cleanPath returns a path relative to the root, without leading or
+// trailing slashes. The root directory is "".
+func cleanPath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+// hash returns a hex hash of the strings, for object names and labels
+// that are limited in length and character set.
+func hash(s ...string) string {
+	h := sha256.Sum256([]byte(strings.Join(s, "\x00")))
+	return hex.EncodeToString(h[:])
+}
+
+// objectName returns the name of the object of a file.
+func (s *Server) objectName(filePath string) string {
+	return "vt-" + hash(s.root, filePath)[:40]
+}
+
+// dirLabel returns the label that marks the files under a directory,
+// in any of its sub-directories.
+func (s *Server) dirLabel(dirPath string) string {
+	return dirLabelPrefix + hash(s.root, dirPath)[:16]
+}
+
+// ancestors returns the directories that contain a file, starting with
+// the root directory.
+func ancestors(filePath string) []string {
+	result := []string{""}
+	parts := strings.Split(filePath, "/")
+	for i := 1; i < len(parts); i++ {
+		result = append(result, strings.Join(parts[:i], "/"))
+	}
+	return result
+}
+
+// newTopoNode returns the object of a file. Every directory containing
+// it is marked with a label, so ListDir can select the files under a
+// directory.
+func (s *Server) newTopoNode(filePath string, contents []byte, version string) *topoNode {
+	labels := make(map[string]string)
+	for _, dir := range ancestors(filePath) {
+		labels[s.dirLabel(dir)] = ""
+	}
+	return &topoNode{
+		APIVersion: apiVersion,
+		Kind:       resourceKind,
+		Metadata: objectMeta{
+			Name:            s.objectName(filePath),
+			ResourceVersion: version,
+			Labels:          labels,
+		},
+		Data: nodeData{
+			Key:   filePath,
+			Value: contents,
+		},
+	}
+}
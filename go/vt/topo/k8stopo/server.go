@@ -0,0 +1,236 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package k8stopo implements topo.Server with Kubernetes custom resources
+as the backend.
+
+Every topo file is a VitessTopoNode object in the namespace of the cell.
+The custom resource definition must be created first:
+
+	apiVersion: apiextensions.k8s.io/v1beta1
+	kind: CustomResourceDefinition
+	metadata:
+	  name: vitesstoponodes.topo.vitess.io
+	spec:
+	  group: topo.vitess.io
+	  version: v1beta1
+	  scope: Namespaced
+	  names:
+	    kind: VitessTopoNode
+	    plural: vitesstoponodes
+	    singular: vitesstoponode
+
+The server address of a cell is the URL of the Kubernetes API server.
+If it is empty, the in-cluster address is used. The root of a cell
+scopes its files, so several cells can share a namespace.
+
+The objects are accessed with the service account token of the pod, if
+there is one. An address of a 'kubectl proxy' can be used without
+credentials.
+*/
+package k8stopo
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var (
+	namespace = flag.String("topo_k8s_namespace", "", "Kubernetes namespace of the topo objects, the namespace of the pod if empty")
+	tokenFile = flag.String("topo_k8s_token_file", "/var/run/secrets/kubernetes.io/serviceaccount/token", "file of the bearer token used to access the Kubernetes API server")
+	caFile    = flag.String("topo_k8s_ca_file", "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt", "file of the CA certificates of the Kubernetes API server")
+	lockTTL   = flag.Duration("topo_k8s_lock_ttl", 30*time.Second, "a lock or master election that wasn't renewed for that long is considered abandoned")
+)
+
+const (
+	// inClusterNamespaceFile has the namespace of the pod.
+	inClusterNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+	// tokenRefreshInterval is how often the token file is read again,
+	// as the service account tokens can be rotated.
+	tokenRefreshInterval = time.Minute
+)
+
+// Factory is the Kubernetes topo.Factory implementation.
+type Factory struct{}
+
+// HasGlobalReadOnlyCell is part of the topo.Factory interface.
+func (f Factory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+// Create is part of the topo.Factory interface.
+func (f Factory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	return NewServer(serverAddr, root)
+}
+
+// Server is the implementation of topo.Server for Kubernetes.
+type Server struct {
+	// client is the http client to the API server.
+	client *http.Client
+
+	// resourceURL is the URL of the VitessTopoNode collection
+	// of our namespace.
+	resourceURL string
+
+	// root is the root path for this client.
+	root string
+
+	// mu protects the following fields.
+	mu sync.Mutex
+	// token is the bearer token, read at tokenTime.
+	token     string
+	tokenTime time.Time
+}
+
+// NewServer returns a new k8stopo.Server.
+func NewServer(serverAddr, root string) (*Server, error) {
+	if serverAddr == "" {
+		host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+		if host == "" || port == "" {
+			return nil, fmt.Errorf("no server address, and not running in a Kubernetes cluster")
+		}
+		serverAddr = "https://" + net.JoinHostPort(host, port)
+	}
+	if !strings.Contains(serverAddr, "://") {
+		serverAddr = "https://" + serverAddr
+	}
+
+	ns := *namespace
+	if ns == "" {
+		ns = "default"
+		if data, err := ioutil.ReadFile(inClusterNamespaceFile); err == nil {
+			ns = strings.TrimSpace(string(data))
+		}
+	}
+
+	transport := &http.Transport{}
+	if strings.HasPrefix(serverAddr, "https://") {
+		tlsConfig := &tls.Config{}
+		if data, err := ioutil.ReadFile(*caFile); err == nil {
+			certPool := x509.NewCertPool()
+			if !certPool.AppendCertsFromPEM(data) {
+				return nil, fmt.Errorf("no certificate found in %v", *caFile)
+			}
+			tlsConfig.RootCAs = certPool
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return &Server{
+		client:      &http.Client{Transport: transport},
+		resourceURL: fmt.Sprintf("%v/apis/%v/namespaces/%v/%v", strings.TrimSuffix(serverAddr, "/"), apiVersion, ns, resourcePlural),
+		root:        cleanPath(root),
+	}, nil
+}
+
+// Close implements topo.Server.Close.
+// It will nil out the client, so any attempt to re-use this server
+// will panic.
+func (s *Server) Close() {
+	s.client = nil
+}
+
+// bearerToken returns the current token, or "" if there isn't one.
+func (s *Server) bearerToken() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if time.Since(s.tokenTime) > tokenRefreshInterval {
+		s.token = ""
+		if data, err := ioutil.ReadFile(*tokenFile); err == nil {
+			s.token = strings.TrimSpace(string(data))
+		}
+		s.tokenTime = time.Now()
+	}
+	return s.token
+}
+
+// request sends a request to the API server. body is marshaled to JSON
+// if it is not nil. The response body must be closed by the caller.
+// If the API server returns an error, it is an *apiError.
+func (s *Server) request(ctx context.Context, method, url string, body interface{}) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token := s.bearerToken(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	apiErr := &apiError{Code: resp.StatusCode}
+	data, _ := ioutil.ReadAll(resp.Body)
+	if err := json.Unmarshal(data, apiErr); err != nil || apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(data))
+	}
+	apiErr.Code = resp.StatusCode
+	return nil, apiErr
+}
+
+// do sends a request to the API server, and unmarshals the JSON
+// response into result, if it is not nil.
+func (s *Server) do(ctx context.Context, method, url string, body, result interface{}) error {
+	resp, err := s.request(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+func init() {
+	topo.RegisterFactory("k8s", Factory{})
+}
@@ -0,0 +1,465 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8stopo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/test"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeAPIServer serves the VitessTopoNode objects of a namespace,
+// and watches on them.
+type fakeAPIServer struct {
+	mu      sync.Mutex
+	version int
+	nodes   map[string]*topoNode
+	// events are all the changes of the objects, in order.
+	events []fakeEvent
+	// changed is closed and replaced when an event is added.
+	changed chan struct{}
+	// done is closed when the server stops, to end the watches.
+	done chan struct{}
+}
+
+// fakeEvent is a change of an object.
+type fakeEvent struct {
+	eventType string
+	version   int
+	node      *topoNode
+}
+
+// addEvent records a change of an object, and wakes up the watches.
+// f.mu must be held.
+func (f *fakeAPIServer) addEvent(eventType string, node *topoNode) {
+	f.events = append(f.events, fakeEvent{eventType: eventType, version: f.version, node: node})
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+// serveWatch streams the changes of the object selected by name after
+// the requested resource version, until the client goes away.
+func (f *fakeAPIServer) serveWatch(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Query().Get("fieldSelector"), "metadata.name=")
+	from, err := strconv.Atoi(r.URL.Query().Get("resourceVersion"))
+	if err != nil {
+		f.writeStatus(w, http.StatusBadRequest, "BadRequest")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	flusher := w.(http.Flusher)
+	flusher.Flush()
+	encoder := json.NewEncoder(w)
+	next := 0
+	for {
+		f.mu.Lock()
+		var pending []fakeEvent
+		for ; next < len(f.events); next++ {
+			if ev := f.events[next]; ev.node.Metadata.Name == name && ev.version > from {
+				pending = append(pending, ev)
+			}
+		}
+		changed := f.changed
+		f.mu.Unlock()
+
+		for _, ev := range pending {
+			object, err := json.Marshal(ev.node)
+			if err != nil {
+				return
+			}
+			if err := encoder.Encode(&watchEvent{Type: ev.eventType, Object: object}); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+		select {
+		case <-changed:
+		case <-r.Context().Done():
+			return
+		case <-f.done:
+			return
+		}
+	}
+}
+
+func (f *fakeAPIServer) writeStatus(w http.ResponseWriter, code int, reason string) {
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(&apiError{Code: code, Reason: reason, Message: reason})
+}
+
+func (f *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet && r.URL.Query().Get("watch") == "true" {
+		f.serveWatch(w, r)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// The name follows /apis/<group>/<version>/namespaces/<namespace>/<plural>.
+	collection := "/" + resourcePlural
+	i := strings.Index(r.URL.Path, collection)
+	if !strings.HasPrefix(r.URL.Path, "/apis/"+apiVersion+"/namespaces/") || i < 0 {
+		f.writeStatus(w, http.StatusNotFound, "NotFound")
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path[i+len(collection):], "/")
+	node := &topoNode{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		if err := json.NewDecoder(r.Body).Decode(node); err != nil {
+			f.writeStatus(w, http.StatusBadRequest, "BadRequest")
+			return
+		}
+	}
+
+	switch {
+	case r.Method == http.MethodGet && name == "":
+		list := &topoNodeList{}
+		for _, n := range f.nodes {
+			if _, ok := n.Metadata.Labels[r.URL.Query().Get("labelSelector")]; ok {
+				list.Items = append(list.Items, n)
+			}
+		}
+		json.NewEncoder(w).Encode(list)
+	case r.Method == http.MethodGet:
+		n, ok := f.nodes[name]
+		if !ok {
+			f.writeStatus(w, http.StatusNotFound, "NotFound")
+			return
+		}
+		json.NewEncoder(w).Encode(n)
+	case r.Method == http.MethodPost:
+		if _, ok := f.nodes[node.Metadata.Name]; ok {
+			f.writeStatus(w, http.StatusConflict, "AlreadyExists")
+			return
+		}
+		f.version++
+		node.Metadata.ResourceVersion = strconv.Itoa(f.version)
+		f.nodes[node.Metadata.Name] = node
+		f.addEvent("ADDED", node)
+		json.NewEncoder(w).Encode(node)
+	case r.Method == http.MethodPut:
+		n, ok := f.nodes[name]
+		if !ok {
+			f.writeStatus(w, http.StatusNotFound, "NotFound")
+			return
+		}
+		if node.Metadata.ResourceVersion != "" && node.Metadata.ResourceVersion != n.Metadata.ResourceVersion {
+			f.writeStatus(w, http.StatusConflict, "Conflict")
+			return
+		}
+		f.version++
+		node.Metadata.ResourceVersion = strconv.Itoa(f.version)
+		f.nodes[name] = node
+		f.addEvent("MODIFIED", node)
+		json.NewEncoder(w).Encode(node)
+	case r.Method == http.MethodDelete:
+		n, ok := f.nodes[name]
+		if !ok {
+			f.writeStatus(w, http.StatusNotFound, "NotFound")
+			return
+		}
+		options := &deleteOptions{}
+		if err := json.NewDecoder(r.Body).Decode(options); err == nil && options.Preconditions.ResourceVersion != "" && options.Preconditions.ResourceVersion != n.Metadata.ResourceVersion {
+			f.writeStatus(w, http.StatusConflict, "Conflict")
+			return
+		}
+		delete(f.nodes, name)
+		f.version++
+		f.addEvent("DELETED", n)
+		f.writeStatus(w, http.StatusOK, "Success")
+	default:
+		f.writeStatus(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func newTestServer(t *testing.T, root string) (*Server, *fakeAPIServer, func()) {
+	fake, serverAddr, closer := startFakeAPIServer()
+	s, err := NewServer(serverAddr, root)
+	if err != nil {
+		closer()
+		t.Fatal(err)
+	}
+	return s, fake, closer
+}
+
+// startFakeAPIServer starts a fakeAPIServer, and returns its address
+// and the function that stops it.
+func startFakeAPIServer() (*fakeAPIServer, string, func()) {
+	fake := &fakeAPIServer{
+		nodes:   make(map[string]*topoNode),
+		changed: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	httpServer := httptest.NewServer(fake)
+	return fake, httpServer.URL, func() {
+		close(fake.done)
+		httpServer.Close()
+	}
+}
+
+func TestK8sTopo(t *testing.T) {
+	_, serverAddr, closer := startFakeAPIServer()
+	defer closer()
+
+	// Run the TopoServerTestSuite tests.
+	testIndex := 0
+	test.TopoServerTestSuite(t, func() *topo.Server {
+		// Each test will use its own roots.
+		testRoot := fmt.Sprintf("test-%v", testIndex)
+		testIndex++
+
+		ts, err := topo.OpenServer("k8s", serverAddr, path.Join(testRoot, topo.GlobalCell))
+		if err != nil {
+			t.Fatalf("OpenServer() failed: %v", err)
+		}
+		if err := ts.CreateCellInfo(context.Background(), test.LocalCellName, &topodatapb.CellInfo{
+			ServerAddress: serverAddr,
+			Root:          path.Join(testRoot, test.LocalCellName),
+		}); err != nil {
+			t.Fatalf("CreateCellInfo() failed: %v", err)
+		}
+		return ts
+	})
+}
+
+func TestPaths(t *testing.T) {
+	for in, want := range map[string]string{
+		"":          "",
+		"/":         "",
+		"/a/b/":     "a/b",
+		"a//b/../c": "a/c",
+	} {
+		if got := cleanPath(in); got != want {
+			t.Errorf("cleanPath(%q): %q, want %q", in, got, want)
+		}
+	}
+	if got, want := ancestors("a/b/c"), []string{"", "a", "a/b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ancestors(a/b/c): %v, want %v", got, want)
+	}
+
+	s1 := &Server{root: "root1"}
+	s2 := &Server{root: "root2"}
+	if s1.objectName("a/b") == s2.objectName("a/b") || s1.dirLabel("a") == s2.dirLabel("a") {
+		t.Errorf("the roots share object names or labels")
+	}
+	if name := s1.objectName("a/b"); len(name) > 63 || name != strings.ToLower(name) {
+		t.Errorf("objectName(a/b): %v is not a valid object name", name)
+	}
+}
+
+func TestFiles(t *testing.T) {
+	ctx := context.Background()
+	s, _, closer := newTestServer(t, "/vitess/global")
+	defer closer()
+
+	version, err := s.Create(ctx, "keyspaces/ks/Keyspace", []byte("v1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Create(ctx, "keyspaces/ks/Keyspace", []byte("v1")); !topo.IsErrType(err, topo.NodeExists) {
+		t.Errorf("second Create: %v, want NodeExists", err)
+	}
+	contents, got, err := s.Get(ctx, "/keyspaces/ks/Keyspace")
+	if err != nil || string(contents) != "v1" || got != version {
+		t.Errorf("Get: %q, %v, %v, want v1, %v", contents, got, err, version)
+	}
+
+	newVersion, err := s.Update(ctx, "keyspaces/ks/Keyspace", []byte("v2"), version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Update(ctx, "keyspaces/ks/Keyspace", []byte("v3"), version); !topo.IsErrType(err, topo.BadVersion) {
+		t.Errorf("Update with an old version: %v, want BadVersion", err)
+	}
+	if err := s.Delete(ctx, "keyspaces/ks/Keyspace", version); !topo.IsErrType(err, topo.BadVersion) {
+		t.Errorf("Delete with an old version: %v, want BadVersion", err)
+	}
+	if err := s.Delete(ctx, "keyspaces/ks/Keyspace", newVersion); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := s.Get(ctx, "keyspaces/ks/Keyspace"); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("Get after Delete: %v, want NoNode", err)
+	}
+
+	// An unconditional update creates the file.
+	if _, err := s.Update(ctx, "keyspaces/ks/Keyspace", []byte("v4"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if contents, _, err := s.Get(ctx, "keyspaces/ks/Keyspace"); err != nil || string(contents) != "v4" {
+		t.Errorf("Get: %q, %v, want v4", contents, err)
+	}
+}
+
+func TestListDir(t *testing.T) {
+	ctx := context.Background()
+	s, fake, closer := newTestServer(t, "/vitess/global")
+	defer closer()
+
+	for _, filePath := range []string{"keyspaces/ks1/Keyspace", "keyspaces/ks2/shards/0/Shard", "cells/cell1/CellInfo"} {
+		if _, err := s.Create(ctx, filePath, []byte("data")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Another root in the same namespace.
+	other := &Server{client: s.client, resourceURL: s.resourceURL, root: "vitess/other"}
+	if _, err := other.Create(ctx, "keyspaces/ks3/Keyspace", []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.nodes) != 4 {
+		t.Fatalf("objects: %v, want 4", len(fake.nodes))
+	}
+
+	for _, tc := range []struct {
+		dirPath string
+		want    []topo.DirEntry
+	}{{
+		dirPath: "/",
+		want: []topo.DirEntry{
+			{Name: "cells", Type: topo.TypeDirectory},
+			{Name: "keyspaces", Type: topo.TypeDirectory},
+		},
+	}, {
+		dirPath: "keyspaces",
+		want: []topo.DirEntry{
+			{Name: "ks1", Type: topo.TypeDirectory},
+			{Name: "ks2", Type: topo.TypeDirectory},
+		},
+	}, {
+		dirPath: "keyspaces/ks1",
+		want: []topo.DirEntry{
+			{Name: "Keyspace", Type: topo.TypeFile},
+		},
+	}} {
+		got, err := s.ListDir(ctx, tc.dirPath, true /*full*/)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("ListDir(%v): %v, want %v", tc.dirPath, got, tc.want)
+		}
+	}
+	if _, err := s.ListDir(ctx, "keyspaces/ks3", false /*full*/); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("ListDir(keyspaces/ks3): %v, want NoNode", err)
+	}
+}
+
+func TestLock(t *testing.T) {
+	defer func(ttl time.Duration) {
+		*lockTTL = ttl
+	}(*lockTTL)
+	*lockTTL = 300 * time.Millisecond
+
+	ctx := context.Background()
+	s, _, closer := newTestServer(t, "/vitess/global")
+	defer closer()
+
+	if _, err := s.Lock(ctx, "keyspaces/ks", "missing"); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("Lock of a missing directory: %v, want NoNode", err)
+	}
+	if _, err := s.Create(ctx, "keyspaces/ks/Keyspace", []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	ld, err := s.Lock(ctx, "keyspaces/ks", "first")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The lock is renewed, so it isn't abandoned.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if _, err := s.Lock(timeoutCtx, "keyspaces/ks", "second"); !topo.IsErrType(err, topo.Timeout) {
+		t.Errorf("second Lock: %v, want Timeout", err)
+	}
+	if err := ld.Check(ctx); err != nil {
+		t.Errorf("Check: %v", err)
+	}
+	entries, err := s.ListDir(ctx, "keyspaces/ks", true /*full*/)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []topo.DirEntry{{Name: "Keyspace", Type: topo.TypeFile}, {Name: locksFilename, Type: topo.TypeFile, Ephemeral: true}}; !reflect.DeepEqual(entries, want) {
+		t.Errorf("ListDir: %v, want %v", entries, want)
+	}
+	if err := ld.Unlock(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// A lock that isn't renewed is deleted after the TTL.
+	node := s.newTopoNode("keyspaces/ks/"+locksFilename, []byte("dead"), "")
+	node.Data.Ephemeral = true
+	if _, err := s.create(ctx, node); err != nil {
+		t.Fatal(err)
+	}
+	ld, err = s.Lock(ctx, "keyspaces/ks", "third")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contents, _, err := s.Get(ctx, "keyspaces/ks/"+locksFilename); err != nil || string(contents) != "third" {
+		t.Errorf("lock contents: %q, %v, want third", contents, err)
+	}
+	if err := ld.Unlock(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMasterParticipation(t *testing.T) {
+	ctx := context.Background()
+	s, _, closer := newTestServer(t, "/vitess/global")
+	defer closer()
+
+	mp, err := s.NewMasterParticipation("vtctld", "host1:15000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	masterCtx, err := mp.WaitForMastership()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, err := mp.GetCurrentMasterID(ctx); err != nil || id != "host1:15000" {
+		t.Errorf("GetCurrentMasterID: %v, %v, want host1:15000", id, err)
+	}
+
+	mp.Stop()
+	select {
+	case <-masterCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatalf("the mastership context wasn't canceled")
+	}
+	if id, err := mp.GetCurrentMasterID(ctx); err != nil || id != "" {
+		t.Errorf("GetCurrentMasterID after Stop: %v, %v, want none", id, err)
+	}
+	if _, err := mp.WaitForMastership(); !topo.IsErrType(err, topo.Interrupted) {
+		t.Errorf("WaitForMastership after Stop: %v, want Interrupted", err)
+	}
+}
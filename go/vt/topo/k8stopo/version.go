@@ -0,0 +1,39 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8stopo
+
+import (
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// KubernetesVersion is the resource version of a Kubernetes object.
+// It implements topo.Version.
+type KubernetesVersion string
+
+// String is part of the topo.Version interface.
+func (v KubernetesVersion) String() string {
+	return string(v)
+}
+
+// versionString returns the resource version of a topo.Version, or ""
+// for an unconditional operation.
+func versionString(version topo.Version) string {
+	if version == nil {
+		return ""
+	}
+	return string(version.(KubernetesVersion))
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package k8stopo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// watchEvent is an event of a watch stream of the API server.
+type watchEvent struct {
+	Type   string          `json:"type"`
+	Object json.RawMessage `json:"object"`
+}
+
+// Watch is part of the topo.Conn interface.
+func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	filePath = cleanPath(filePath)
+
+	// Get the initial version of the file.
+	initial, err := s.get(ctx, filePath)
+	if err != nil {
+		return &topo.WatchData{Err: err}, nil, nil
+	}
+	wd := &topo.WatchData{
+		Contents: initial.Data.Value,
+		Version:  KubernetesVersion(initial.Metadata.ResourceVersion),
+	}
+
+	watchCtx, watchCancel := context.WithCancel(context.Background())
+	notifications := make(chan *topo.WatchData, 10)
+	go func() {
+		defer close(notifications)
+
+		version := initial.Metadata.ResourceVersion
+		for {
+			var err error
+			version, err = s.watchFrom(watchCtx, filePath, version, notifications)
+			if err != nil {
+				// Final notification.
+				notifications <- &topo.WatchData{Err: err}
+				return
+			}
+		}
+	}()
+
+	return wd, notifications, topo.CancelFunc(watchCancel)
+}
+
+// watchFrom sends the changes of a file after the provided resource
+// version, until the API server ends the watch stream. It returns the
+// last resource version to restart from, or the error that ends the
+// watch.
+func (s *Server) watchFrom(ctx context.Context, filePath, version string, notifications chan<- *topo.WatchData) (string, error) {
+	u := fmt.Sprintf("%v?watch=true&fieldSelector=%v&resourceVersion=%v", s.resourceURL, url.QueryEscape("metadata.name="+s.objectName(filePath)), url.QueryEscape(version))
+	resp, err := s.request(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		return "", convertError(err, filePath)
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		event := &watchEvent{}
+		if err := decoder.Decode(event); err != nil {
+			if ctx.Err() != nil {
+				return "", convertError(ctx.Err(), filePath)
+			}
+			if err == io.EOF {
+				// The API server times out the watches, we
+				// start a new one.
+				return version, nil
+			}
+			return "", err
+		}
+
+		switch event.Type {
+		case "ADDED", "MODIFIED":
+			node := &topoNode{}
+			if err := json.Unmarshal(event.Object, node); err != nil {
+				return "", err
+			}
+			if node.Data.Key != filePath {
+				continue
+			}
+			version = node.Metadata.ResourceVersion
+			notifications <- &topo.WatchData{
+				Contents: node.Data.Value,
+				Version:  KubernetesVersion(version),
+			}
+		case "DELETED":
+			// Node is gone, send a final notice.
+			return "", topo.NewError(topo.NoNode, filePath)
+		case "ERROR":
+			apiErr := &apiError{}
+			if err := json.Unmarshal(event.Object, apiErr); err != nil {
+				return "", err
+			}
+			if apiErr.Code != http.StatusGone {
+				return "", apiErr
+			}
+
+			// Our version is too old for the API server, we
+			// read the file again and start from there.
+			node, err := s.get(ctx, filePath)
+			if err != nil {
+				return "", err
+			}
+			version = node.Metadata.ResourceVersion
+			notifications <- &topo.WatchData{
+				Contents: node.Data.Value,
+				Version:  KubernetesVersion(version),
+			}
+			return version, nil
+		}
+	}
+}
@@ -24,7 +24,6 @@ import (
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 
-	"vitess.io/vitess/go/event"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo/events"
 
@@ -163,7 +162,7 @@ func (ts *Server) CreateKeyspace(ctx context.Context, keyspace string, value *to
 		return err
 	}
 
-	event.Dispatch(&events.KeyspaceChange{
+	ts.dispatch(&events.KeyspaceChange{
 		KeyspaceName: keyspace,
 		Keyspace:     value,
 		Status:       "created",
@@ -209,7 +208,7 @@ func (ts *Server) UpdateKeyspace(ctx context.Context, ki *KeyspaceInfo) error {
 	}
 	ki.version = version
 
-	event.Dispatch(&events.KeyspaceChange{
+	ts.dispatch(&events.KeyspaceChange{
 		KeyspaceName: ki.keyspace,
 		Keyspace:     ki.Keyspace,
 		Status:       "updated",
@@ -267,8 +266,11 @@ func (ts *Server) DeleteKeyspace(ctx context.Context, keyspace string) error {
 	if err := ts.DeleteVSchema(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
 		return err
 	}
+	if err := ts.DeleteTableACL(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
 
-	event.Dispatch(&events.KeyspaceChange{
+	ts.dispatch(&events.KeyspaceChange{
 		KeyspaceName: keyspace,
 		Keyspace:     nil,
 		Status:       "deleted",
@@ -22,7 +22,6 @@ import (
 
 	"vitess.io/vitess/go/vt/sqlparser"
 
-	"vitess.io/vitess/go/event"
 	"vitess.io/vitess/go/vt/topo/events"
 )
 
@@ -46,7 +45,7 @@ func (ts *Server) UpsertMetadata(ctx context.Context, key string, val string) er
 		return err
 	}
 
-	dispatchEvent(keyPath, status)
+	ts.dispatchEvent(keyPath, status)
 	return nil
 }
 
@@ -84,7 +83,7 @@ func (ts *Server) DeleteMetadata(ctx context.Context, key string) error {
 		return err
 	}
 
-	dispatchEvent(keyPath, "deleted")
+	ts.dispatchEvent(keyPath, "deleted")
 	return nil
 }
 
@@ -98,8 +97,8 @@ func (ts *Server) getMetadata(ctx context.Context, key string) (string, error) {
 	return string(contents), nil
 }
 
-func dispatchEvent(key string, status string) {
-	event.Dispatch(&events.MetadataChange{
+func (ts *Server) dispatchEvent(key string, status string) {
+	ts.dispatch(&events.MetadataChange{
 		Key:    key,
 		Status: status,
 	})
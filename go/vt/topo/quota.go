@@ -0,0 +1,122 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"path"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
+)
+
+// This file contains the utility methods to manage the query quotas
+// of a keyspace. They are stored in the global topo, next to the
+// Keyspace object, and copied into the SrvVSchema of the cells by
+// RebuildSrvVSchema, which the vtgates watch.
+
+// WatchQuotaData is returned / streamed by WatchQuota.
+// The WatchQuota API guarantees exactly one of Value or Err will be set.
+type WatchQuotaData struct {
+	Value *quotapb.Config
+	Err   error
+}
+
+// SaveQuota saves the query quotas of a keyspace.
+func (ts *Server) SaveQuota(ctx context.Context, keyspace string, config *quotapb.Config) error {
+	nodePath := path.Join(KeyspacesPath, keyspace, QuotaFile)
+	data, err := proto.Marshal(config)
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Update(ctx, nodePath, data, nil)
+	return err
+}
+
+// GetQuota returns the query quotas of a keyspace.
+func (ts *Server) GetQuota(ctx context.Context, keyspace string) (*quotapb.Config, error) {
+	nodePath := path.Join(KeyspacesPath, keyspace, QuotaFile)
+	data, _, err := ts.globalCell.Get(ctx, nodePath)
+	if err != nil {
+		return nil, err
+	}
+	config := &quotapb.Config{}
+	if err := proto.Unmarshal(data, config); err != nil {
+		return nil, vterrors.Wrapf(err, "bad quota data: %q", data)
+	}
+	return config, nil
+}
+
+// DeleteQuota deletes the query quotas of a keyspace.
+func (ts *Server) DeleteQuota(ctx context.Context, keyspace string) error {
+	nodePath := path.Join(KeyspacesPath, keyspace, QuotaFile)
+	return ts.globalCell.Delete(ctx, nodePath, nil)
+}
+
+// WatchQuota will set a watch on the query quotas of a keyspace.
+// It has the same contract as Conn.Watch, but it also unpacks the
+// contents into a quota Config object.
+func (ts *Server) WatchQuota(ctx context.Context, keyspace string) (*WatchQuotaData, <-chan *WatchQuotaData, CancelFunc) {
+	nodePath := path.Join(KeyspacesPath, keyspace, QuotaFile)
+	current, wdChannel, cancel := ts.globalCell.Watch(ctx, nodePath)
+	if current.Err != nil {
+		return &WatchQuotaData{Err: current.Err}, nil, nil
+	}
+	value := &quotapb.Config{}
+	if err := proto.Unmarshal(current.Contents, value); err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchQuotaData{Err: vterrors.Wrapf(err, "error unpacking initial quota object")}, nil, nil
+	}
+
+	changes := make(chan *WatchQuotaData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	// If cancel() is called, the underlying Watch() code will
+	// send an ErrInterrupted and then close the channel. We'll
+	// just propagate that back to our caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				changes <- &WatchQuotaData{Err: wd.Err}
+				return
+			}
+
+			value := &quotapb.Config{}
+			if err := proto.Unmarshal(wd.Contents, value); err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchQuotaData{Err: vterrors.Wrapf(err, "error unpacking quota object")}
+				return
+			}
+			changes <- &WatchQuotaData{Value: value}
+		}
+	}()
+
+	return &WatchQuotaData{Value: value}, changes, cancel
+}
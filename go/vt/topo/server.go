@@ -48,6 +48,7 @@ import (
 	"sync"
 
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/event"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	"vitess.io/vitess/go/vt/log"
@@ -75,6 +76,8 @@ const (
 	SrvVSchemaFile       = "SrvVSchema"
 	SrvKeyspaceFile      = "SrvKeyspace"
 	RoutingRulesFile     = "RoutingRules"
+	TableACLFile         = "TableACL"
+	QuotaFile            = "Quota"
 )
 
 // Path for all object types.
@@ -136,6 +139,10 @@ type Server struct {
 	// will read the list of addresses for that cell from the
 	// global cluster and create clients as needed.
 	cells map[string]Conn
+
+	// dryRun is set for the Servers of NewDryRunServer, which don't
+	// dispatch the events of their changes.
+	dryRun bool
 }
 
 type cellsToAliasesMap struct {
@@ -320,6 +327,15 @@ func (ts *Server) Close() {
 	ts.cells = make(map[string]Conn)
 }
 
+// dispatch dispatches the event of a change, unless ts is a dry run
+// Server that did not really make it.
+func (ts *Server) dispatch(ev interface{}) {
+	if ts.dryRun {
+		return
+	}
+	event.Dispatch(ev)
+}
+
 func (ts *Server) clearCellAliasesCache() {
 	cellsAliases.mu.Lock()
 	defer cellsAliases.mu.Unlock()
@@ -33,7 +33,6 @@ import (
 
 	"github.com/golang/protobuf/proto"
 
-	"vitess.io/vitess/go/event"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/key"
@@ -231,7 +230,7 @@ func (ts *Server) updateShard(ctx context.Context, si *ShardInfo) error {
 	}
 	si.version = newVersion
 
-	event.Dispatch(&events.ShardChange{
+	ts.dispatch(&events.ShardChange{
 		KeyspaceName: si.Keyspace(),
 		ShardName:    si.ShardName(),
 		Shard:        si.Shard,
@@ -321,7 +320,7 @@ func (ts *Server) CreateShard(ctx context.Context, keyspace, shard string) (err
 		return err
 	}
 
-	event.Dispatch(&events.ShardChange{
+	ts.dispatch(&events.ShardChange{
 		KeyspaceName: keyspace,
 		ShardName:    shard,
 		Shard:        value,
@@ -365,7 +364,7 @@ func (ts *Server) DeleteShard(ctx context.Context, keyspace, shard string) error
 	if err := ts.globalCell.Delete(ctx, shardPath, nil); err != nil {
 		return err
 	}
-	event.Dispatch(&events.ShardChange{
+	ts.dispatch(&events.ShardChange{
 		KeyspaceName: keyspace,
 		ShardName:    shard,
 		Shard:        nil,
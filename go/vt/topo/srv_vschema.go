@@ -25,6 +25,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 )
 
@@ -174,15 +175,28 @@ func (ts *Server) RebuildSrvVSchema(ctx context.Context, cells []string) error {
 				err = nil
 				k = &vschemapb.Keyspace{}
 			}
+			var q *quotapb.Config
+			if err == nil {
+				q, err = ts.GetQuota(ctx, keyspace)
+				if IsErrType(err, NoNode) {
+					err = nil
+				}
+			}
 
 			mu.Lock()
 			defer mu.Unlock()
 			if err != nil {
-				log.Errorf("%v: GetVSchema(%v) failed", err, keyspace)
+				log.Errorf("%v: GetVSchema(%v) or GetQuota(%v) failed", err, keyspace, keyspace)
 				finalErr = err
 				return
 			}
 			srvVSchema.Keyspaces[keyspace] = k
+			if q != nil {
+				if srvVSchema.Quotas == nil {
+					srvVSchema.Quotas = make(map[string]*quotapb.Config)
+				}
+				srvVSchema.Quotas[keyspace] = q
+			}
 		}(keyspace)
 	}
 	wg.Wait()
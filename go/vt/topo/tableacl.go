@@ -0,0 +1,121 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"path"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
+)
+
+// This file contains the utility methods to manage the table ACL
+// of a keyspace. It is stored in the global topo, next to the
+// Keyspace object, and watched by the vttablets.
+
+// WatchTableACLData is returned / streamed by WatchTableACL.
+// The WatchTableACL API guarantees exactly one of Value or Err will be set.
+type WatchTableACLData struct {
+	Value *tableaclpb.Config
+	Err   error
+}
+
+// SaveTableACL saves the table ACL of a keyspace.
+func (ts *Server) SaveTableACL(ctx context.Context, keyspace string, config *tableaclpb.Config) error {
+	nodePath := path.Join(KeyspacesPath, keyspace, TableACLFile)
+	data, err := proto.Marshal(config)
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Update(ctx, nodePath, data, nil)
+	return err
+}
+
+// GetTableACL returns the table ACL of a keyspace.
+func (ts *Server) GetTableACL(ctx context.Context, keyspace string) (*tableaclpb.Config, error) {
+	nodePath := path.Join(KeyspacesPath, keyspace, TableACLFile)
+	data, _, err := ts.globalCell.Get(ctx, nodePath)
+	if err != nil {
+		return nil, err
+	}
+	config := &tableaclpb.Config{}
+	if err := proto.Unmarshal(data, config); err != nil {
+		return nil, vterrors.Wrapf(err, "bad table acl data: %q", data)
+	}
+	return config, nil
+}
+
+// DeleteTableACL deletes the table ACL of a keyspace.
+func (ts *Server) DeleteTableACL(ctx context.Context, keyspace string) error {
+	nodePath := path.Join(KeyspacesPath, keyspace, TableACLFile)
+	return ts.globalCell.Delete(ctx, nodePath, nil)
+}
+
+// WatchTableACL will set a watch on the table ACL of a keyspace.
+// It has the same contract as Conn.Watch, but it also unpacks the
+// contents into a tableacl Config object.
+func (ts *Server) WatchTableACL(ctx context.Context, keyspace string) (*WatchTableACLData, <-chan *WatchTableACLData, CancelFunc) {
+	nodePath := path.Join(KeyspacesPath, keyspace, TableACLFile)
+	current, wdChannel, cancel := ts.globalCell.Watch(ctx, nodePath)
+	if current.Err != nil {
+		return &WatchTableACLData{Err: current.Err}, nil, nil
+	}
+	value := &tableaclpb.Config{}
+	if err := proto.Unmarshal(current.Contents, value); err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchTableACLData{Err: vterrors.Wrapf(err, "error unpacking initial table acl object")}, nil, nil
+	}
+
+	changes := make(chan *WatchTableACLData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	// If cancel() is called, the underlying Watch() code will
+	// send an ErrInterrupted and then close the channel. We'll
+	// just propagate that back to our caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				changes <- &WatchTableACLData{Err: wd.Err}
+				return
+			}
+
+			value := &tableaclpb.Config{}
+			if err := proto.Unmarshal(wd.Contents, value); err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchTableACLData{Err: vterrors.Wrapf(err, "error unpacking table acl object")}
+				return
+			}
+			changes <- &WatchTableACLData{Value: value}
+		}
+	}()
+
+	return &WatchTableACLData{Value: value}, changes, cancel
+}
@@ -27,7 +27,6 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 
 	"github.com/golang/protobuf/proto"
-	"vitess.io/vitess/go/event"
 	"vitess.io/vitess/go/netutil"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/log"
@@ -277,7 +276,7 @@ func (ts *Server) UpdateTablet(ctx context.Context, ti *TabletInfo) error {
 	}
 	ti.version = newVersion
 
-	event.Dispatch(&events.TabletChange{
+	ts.dispatch(&events.TabletChange{
 		Tablet: *ti.Tablet,
 		Status: "updated",
 	})
@@ -358,7 +357,7 @@ func (ts *Server) CreateTablet(ctx context.Context, tablet *topodatapb.Tablet) e
 	}
 
 	if err == nil {
-		event.Dispatch(&events.TabletChange{
+		ts.dispatch(&events.TabletChange{
 			Tablet: *tablet,
 			Status: "created",
 		})
@@ -385,7 +384,7 @@ func (ts *Server) DeleteTablet(ctx context.Context, tabletAlias *topodatapb.Tabl
 	// Only try to log if we have the required info.
 	if tErr == nil {
 		// Only copy the identity info for the tablet. The rest has been deleted.
-		event.Dispatch(&events.TabletChange{
+		ts.dispatch(&events.TabletChange{
 			Tablet: topodatapb.Tablet{
 				Alias:    tabletAlias,
 				Keyspace: ti.Tablet.Keyspace,
@@ -0,0 +1,118 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestDryRunServer(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.CreateShard(ctx, "ks", "-80"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.UpdateSrvKeyspace(ctx, "cell1", "ks", &topodatapb.SrvKeyspace{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	dryRun := topo.NewDryRunServer(ts, func(cell, action, filePath string) {
+		got = append(got, cell+" "+action+" "+filePath)
+	})
+
+	lockCtx, unlock, err := dryRun.LockKeyspace(ctx, "ks", "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dryRun.UpdateShardFields(lockCtx, "ks", "-80", func(si *topo.ShardInfo) error {
+		si.IsMasterServing = false
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dryRun.CreateKeyspace(lockCtx, "ks2", &topodatapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dryRun.DeleteSrvKeyspace(lockCtx, "cell1", "ks"); err != nil {
+		t.Fatal(err)
+	}
+	unlock(&err)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"global lock keyspaces/ks",
+		"global update keyspaces/ks/shards/-80/Shard",
+		"global create keyspaces/ks2/Keyspace",
+		"cell1 delete keyspaces/ks/SrvKeyspace",
+		"global unlock keyspaces/ks",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("recorded changes: got %v, want %v", got, want)
+	}
+
+	// The dry run server sees its changes.
+	si, err := dryRun.GetShard(ctx, "ks", "-80")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if si.IsMasterServing {
+		t.Errorf("dry run shard -80 is still master serving")
+	}
+	keyspaces, err := dryRun.GetKeyspaces(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"ks", "ks2"}; !reflect.DeepEqual(keyspaces, want) {
+		t.Errorf("dry run keyspaces: got %v, want %v", keyspaces, want)
+	}
+	if _, err := dryRun.GetSrvKeyspace(ctx, "cell1", "ks"); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("dry run GetSrvKeyspace: got %v, want NoNode", err)
+	}
+
+	// The base server doesn't.
+	si, err = ts.GetShard(ctx, "ks", "-80")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !si.IsMasterServing {
+		t.Errorf("shard -80 is not master serving anymore")
+	}
+	keyspaces, err = ts.GetKeyspaces(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"ks"}; !reflect.DeepEqual(keyspaces, want) {
+		t.Errorf("keyspaces: got %v, want %v", keyspaces, want)
+	}
+	if _, err := ts.GetSrvKeyspace(ctx, "cell1", "ks"); err != nil {
+		t.Errorf("GetSrvKeyspace: %v", err)
+	}
+}
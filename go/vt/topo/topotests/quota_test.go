@@ -0,0 +1,112 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/golang/protobuf/proto"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestQuota(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	keyspace := "ks1"
+
+	// No quota -> ErrNoNode
+	if _, err := ts.GetQuota(ctx, keyspace); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("GetQuota(not there): %v, want NoNode", err)
+	}
+	current, _, _ := ts.WatchQuota(ctx, keyspace)
+	if !topo.IsErrType(current.Err, topo.NoNode) {
+		t.Errorf("WatchQuota(not there): %v, want NoNode", current.Err)
+	}
+
+	config := &quotapb.Config{
+		Keyspace: &quotapb.Quota{Qps: 100},
+		Callers: []*quotapb.CallerQuota{{
+			Caller: "u1",
+			Quota:  &quotapb.Quota{MaxConcurrency: 2},
+		}},
+	}
+	if err := ts.SaveQuota(ctx, keyspace, config); err != nil {
+		t.Fatalf("SaveQuota failed: %v", err)
+	}
+	got, err := ts.GetQuota(ctx, keyspace)
+	if err != nil || !proto.Equal(got, config) {
+		t.Errorf("GetQuota: %v, %v, want %v", got, err, config)
+	}
+
+	// The watch returns the current value, then the changes.
+	current, changes, cancel := ts.WatchQuota(ctx, keyspace)
+	if current.Err != nil || !proto.Equal(current.Value, config) {
+		t.Fatalf("WatchQuota: %v, %v, want %v", current.Value, current.Err, config)
+	}
+	config.DefaultCaller = &quotapb.Quota{Qps: 10}
+	if err := ts.SaveQuota(ctx, keyspace, config); err != nil {
+		t.Fatalf("SaveQuota failed: %v", err)
+	}
+	wd, ok := <-changes
+	if !ok || wd.Err != nil || !proto.Equal(wd.Value, config) {
+		t.Errorf("WatchQuota change: %v, want %v", wd, config)
+	}
+	cancel()
+	for wd := range changes {
+		if !topo.IsErrType(wd.Err, topo.Interrupted) {
+			t.Errorf("WatchQuota after cancel: %v, want Interrupted", wd)
+		}
+	}
+
+	// RebuildSrvVSchema copies the quotas into the SrvVSchema.
+	if err := ts.CreateKeyspace(ctx, keyspace, &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	if err := ts.CreateKeyspace(ctx, "ks2", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	if err := ts.RebuildSrvVSchema(ctx, nil); err != nil {
+		t.Fatalf("RebuildSrvVSchema failed: %v", err)
+	}
+	srvVSchema, err := ts.GetSrvVSchema(ctx, "cell1")
+	if err != nil {
+		t.Fatalf("GetSrvVSchema failed: %v", err)
+	}
+	if got := srvVSchema.Quotas; len(got) != 1 || !proto.Equal(got[keyspace], config) {
+		t.Errorf("SrvVSchema quotas: %v, want only %v: %v", got, keyspace, config)
+	}
+
+	if err := ts.DeleteQuota(ctx, keyspace); err != nil {
+		t.Fatalf("DeleteQuota failed: %v", err)
+	}
+	if err := ts.RebuildSrvVSchema(ctx, nil); err != nil {
+		t.Fatalf("RebuildSrvVSchema failed: %v", err)
+	}
+	srvVSchema, err = ts.GetSrvVSchema(ctx, "cell1")
+	if err != nil || len(srvVSchema.Quotas) != 0 {
+		t.Errorf("SrvVSchema quotas after delete: %v, %v, want none", srvVSchema.GetQuotas(), err)
+	}
+	if _, err := ts.GetQuota(ctx, keyspace); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("GetQuota(deleted): %v, want NoNode", err)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/golang/protobuf/proto"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
+)
+
+func TestTableACL(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	keyspace := "ks1"
+
+	// No table ACL -> ErrNoNode
+	if _, err := ts.GetTableACL(ctx, keyspace); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("GetTableACL(not there): %v, want NoNode", err)
+	}
+	current, _, _ := ts.WatchTableACL(ctx, keyspace)
+	if !topo.IsErrType(current.Err, topo.NoNode) {
+		t.Errorf("WatchTableACL(not there): %v, want NoNode", current.Err)
+	}
+
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group1",
+			TableNamesOrPrefixes: []string{"t%"},
+			Readers:              []string{"u1"},
+		}},
+	}
+	if err := ts.SaveTableACL(ctx, keyspace, config); err != nil {
+		t.Fatalf("SaveTableACL failed: %v", err)
+	}
+	got, err := ts.GetTableACL(ctx, keyspace)
+	if err != nil || !proto.Equal(got, config) {
+		t.Errorf("GetTableACL: %v, %v, want %v", got, err, config)
+	}
+
+	// The watch returns the current value, then the changes.
+	current, changes, cancel := ts.WatchTableACL(ctx, keyspace)
+	if current.Err != nil || !proto.Equal(current.Value, config) {
+		t.Fatalf("WatchTableACL: %v, %v, want %v", current.Value, current.Err, config)
+	}
+	config.TableGroups[0].Writers = []string{"u2"}
+	if err := ts.SaveTableACL(ctx, keyspace, config); err != nil {
+		t.Fatalf("SaveTableACL failed: %v", err)
+	}
+	wd, ok := <-changes
+	if !ok || wd.Err != nil || !proto.Equal(wd.Value, config) {
+		t.Errorf("WatchTableACL change: %v, want %v", wd, config)
+	}
+	cancel()
+	for wd := range changes {
+		if !topo.IsErrType(wd.Err, topo.Interrupted) {
+			t.Errorf("WatchTableACL after cancel: %v, want Interrupted", wd)
+		}
+	}
+
+	if err := ts.DeleteTableACL(ctx, keyspace); err != nil {
+		t.Fatalf("DeleteTableACL failed: %v", err)
+	}
+	if _, err := ts.GetTableACL(ctx, keyspace); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("GetTableACL(deleted): %v, want NoNode", err)
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// Durabler describes the semi-sync requirements of a keyspace: how many
+// replicas have to acknowledge a transaction before the master commits it,
+// and which replicas are allowed to acknowledge.
+type Durabler interface {
+	// SemiSyncAckers returns the number of semi-sync acks the master
+	// has to wait for. 0 means semi-sync is disabled on the master.
+	SemiSyncAckers() int
+	// IsReplicaSemiSync returns true if the replica should send
+	// semi-sync acks to the provided master.
+	IsReplicaSemiSync(master *topodatapb.TabletAlias, replica *topodatapb.Tablet) bool
+}
+
+// DurabilityPolicyFactory creates a Durabler. ackers is the number of acks
+// that was specified in the policy string, or 0 if it was not specified.
+type DurabilityPolicyFactory func(ackers int) (Durabler, error)
+
+var (
+	durabilityPoliciesMu sync.Mutex
+	durabilityPolicies   = make(map[string]DurabilityPolicyFactory)
+)
+
+// RegisterDurabilityPolicy registers a durability policy under the provided
+// name. It panics if a policy with the same name is already registered.
+func RegisterDurabilityPolicy(name string, factory DurabilityPolicyFactory) {
+	durabilityPoliciesMu.Lock()
+	defer durabilityPoliciesMu.Unlock()
+	if _, ok := durabilityPolicies[name]; ok {
+		panic(fmt.Sprintf("durability policy %v already registered", name))
+	}
+	durabilityPolicies[name] = factory
+}
+
+// DurabilityPolicyNames returns the sorted names of the registered policies.
+func DurabilityPolicyNames() []string {
+	durabilityPoliciesMu.Lock()
+	defer durabilityPoliciesMu.Unlock()
+	names := make([]string, 0, len(durabilityPolicies))
+	for name := range durabilityPolicies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseDurabilityPolicy parses a durability policy, as stored in the
+// Keyspace record. The format is <name>[:<ackers>], for instance
+// 'semi_sync' or 'cross_cell:2'. An empty policy returns a nil Durabler,
+// which means the tablets use their command line flags.
+func ParseDurabilityPolicy(policy string) (Durabler, error) {
+	if policy == "" {
+		return nil, nil
+	}
+	name := policy
+	ackers := 0
+	if i := strings.IndexByte(policy, ':'); i != -1 {
+		name = policy[:i]
+		n, err := strconv.Atoi(policy[i+1:])
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid number of ackers in durability policy %v", policy)
+		}
+		ackers = n
+	}
+	durabilityPoliciesMu.Lock()
+	factory, ok := durabilityPolicies[name]
+	durabilityPoliciesMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown durability policy %v, expected one of %v", name, strings.Join(DurabilityPolicyNames(), ", "))
+	}
+	return factory(ackers)
+}
+
+// CheckDurability returns an error if the tablets in tabletMap cannot
+// provide the number of semi-sync acks the policy requires for the provided
+// master. The master itself is never counted. A nil Durabler always passes.
+func CheckDurability(durability Durabler, master *topodatapb.TabletAlias, tabletMap map[string]*topo.TabletInfo) error {
+	if durability == nil {
+		return nil
+	}
+	needed := durability.SemiSyncAckers()
+	if needed == 0 {
+		return nil
+	}
+	ackers := 0
+	for _, ti := range tabletMap {
+		if topoproto.TabletAliasEqual(ti.Alias, master) {
+			continue
+		}
+		if durability.IsReplicaSemiSync(master, ti.Tablet) {
+			ackers++
+		}
+	}
+	if ackers < needed {
+		return fmt.Errorf("durability policy requires %v semi-sync ackers for master %v, but only %v tablets can ack", needed, topoproto.TabletAliasString(master), ackers)
+	}
+	return nil
+}
+
+func init() {
+	RegisterDurabilityPolicy("none", func(ackers int) (Durabler, error) {
+		if ackers != 0 {
+			return nil, fmt.Errorf("durability policy none does not take a number of ackers")
+		}
+		return durabilityNone{}, nil
+	})
+	RegisterDurabilityPolicy("semi_sync", func(ackers int) (Durabler, error) {
+		return durabilitySemiSync{ackers: defaultAckers(ackers)}, nil
+	})
+	RegisterDurabilityPolicy("cross_cell", func(ackers int) (Durabler, error) {
+		return durabilityCrossCell{ackers: defaultAckers(ackers)}, nil
+	})
+}
+
+func defaultAckers(ackers int) int {
+	if ackers == 0 {
+		return 1
+	}
+	return ackers
+}
+
+// durabilityNone disables semi-sync.
+type durabilityNone struct{}
+
+func (durabilityNone) SemiSyncAckers() int {
+	return 0
+}
+
+func (durabilityNone) IsReplicaSemiSync(master *topodatapb.TabletAlias, replica *topodatapb.Tablet) bool {
+	return false
+}
+
+// durabilitySemiSync requires acks from any REPLICA tablet.
+type durabilitySemiSync struct {
+	ackers int
+}
+
+func (d durabilitySemiSync) SemiSyncAckers() int {
+	return d.ackers
+}
+
+func (d durabilitySemiSync) IsReplicaSemiSync(master *topodatapb.TabletAlias, replica *topodatapb.Tablet) bool {
+	return replica.Type == topodatapb.TabletType_REPLICA && !topoproto.TabletAliasEqual(master, replica.Alias)
+}
+
+// durabilityCrossCell requires acks from REPLICA tablets that are not in
+// the cell of the master.
+type durabilityCrossCell struct {
+	ackers int
+}
+
+func (d durabilityCrossCell) SemiSyncAckers() int {
+	return d.ackers
+}
+
+func (d durabilityCrossCell) IsReplicaSemiSync(master *topodatapb.TabletAlias, replica *topodatapb.Tablet) bool {
+	if master == nil {
+		return false
+	}
+	return replica.Type == topodatapb.TabletType_REPLICA && replica.Alias.Cell != master.Cell
+}
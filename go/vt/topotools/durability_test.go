@@ -0,0 +1,175 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestParseDurabilityPolicy(t *testing.T) {
+	testcases := []struct {
+		policy string
+		ackers int
+		err    string
+	}{{
+		policy: "",
+	}, {
+		policy: "none",
+	}, {
+		policy: "semi_sync",
+		ackers: 1,
+	}, {
+		policy: "semi_sync:2",
+		ackers: 2,
+	}, {
+		policy: "cross_cell",
+		ackers: 1,
+	}, {
+		policy: "cross_cell:3",
+		ackers: 3,
+	}, {
+		policy: "none:1",
+		err:    "durability policy none does not take a number of ackers",
+	}, {
+		policy: "semi_sync:0",
+		err:    "invalid number of ackers in durability policy semi_sync:0",
+	}, {
+		policy: "semi_sync:a",
+		err:    "invalid number of ackers in durability policy semi_sync:a",
+	}, {
+		policy: "quorum",
+		err:    "unknown durability policy quorum, expected one of cross_cell, none, semi_sync",
+	}}
+	for _, tcase := range testcases {
+		durability, err := ParseDurabilityPolicy(tcase.policy)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("ParseDurabilityPolicy(%v) err: %v, want %v", tcase.policy, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseDurabilityPolicy(%v) failed: %v", tcase.policy, err)
+			continue
+		}
+		if tcase.policy == "" {
+			if durability != nil {
+				t.Errorf("ParseDurabilityPolicy(\"\"): %v, want nil", durability)
+			}
+			continue
+		}
+		if got := durability.SemiSyncAckers(); got != tcase.ackers {
+			t.Errorf("ParseDurabilityPolicy(%v).SemiSyncAckers(): %v, want %v", tcase.policy, got, tcase.ackers)
+		}
+	}
+}
+
+func durabilityTablet(cell string, uid uint32, tabletType topodatapb.TabletType) *topo.TabletInfo {
+	return &topo.TabletInfo{
+		Tablet: &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{Cell: cell, Uid: uid},
+			Type:  tabletType,
+		},
+	}
+}
+
+func TestIsReplicaSemiSync(t *testing.T) {
+	master := &topodatapb.TabletAlias{Cell: "cell1", Uid: 1}
+	sameCell := durabilityTablet("cell1", 2, topodatapb.TabletType_REPLICA).Tablet
+	otherCell := durabilityTablet("cell2", 3, topodatapb.TabletType_REPLICA).Tablet
+	rdonly := durabilityTablet("cell2", 4, topodatapb.TabletType_RDONLY).Tablet
+	self := durabilityTablet("cell1", 1, topodatapb.TabletType_REPLICA).Tablet
+
+	testcases := []struct {
+		policy  string
+		replica *topodatapb.Tablet
+		want    bool
+	}{
+		{"none", sameCell, false},
+		{"semi_sync", sameCell, true},
+		{"semi_sync", otherCell, true},
+		{"semi_sync", rdonly, false},
+		{"semi_sync", self, false},
+		{"cross_cell", sameCell, false},
+		{"cross_cell", otherCell, true},
+		{"cross_cell", rdonly, false},
+	}
+	for _, tcase := range testcases {
+		durability, err := ParseDurabilityPolicy(tcase.policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := durability.IsReplicaSemiSync(master, tcase.replica); got != tcase.want {
+			t.Errorf("%v.IsReplicaSemiSync(%v): %v, want %v", tcase.policy, tcase.replica.Alias, got, tcase.want)
+		}
+	}
+
+	durability, _ := ParseDurabilityPolicy("cross_cell")
+	if durability.IsReplicaSemiSync(nil, otherCell) {
+		t.Errorf("cross_cell.IsReplicaSemiSync(nil): true, want false")
+	}
+}
+
+func TestCheckDurability(t *testing.T) {
+	master := &topodatapb.TabletAlias{Cell: "cell1", Uid: 1}
+	tabletMap := map[string]*topo.TabletInfo{
+		"cell1-0000000001": durabilityTablet("cell1", 1, topodatapb.TabletType_MASTER),
+		"cell1-0000000002": durabilityTablet("cell1", 2, topodatapb.TabletType_REPLICA),
+		"cell2-0000000003": durabilityTablet("cell2", 3, topodatapb.TabletType_REPLICA),
+		"cell2-0000000004": durabilityTablet("cell2", 4, topodatapb.TabletType_RDONLY),
+	}
+
+	testcases := []struct {
+		policy string
+		err    string
+	}{{
+		policy: "",
+	}, {
+		policy: "none",
+	}, {
+		policy: "semi_sync:2",
+	}, {
+		policy: "semi_sync:3",
+		err:    "requires 3 semi-sync ackers for master cell1-0000000001, but only 2 tablets can ack",
+	}, {
+		policy: "cross_cell",
+	}, {
+		policy: "cross_cell:2",
+		err:    "requires 2 semi-sync ackers for master cell1-0000000001, but only 1 tablets can ack",
+	}}
+	for _, tcase := range testcases {
+		durability, err := ParseDurabilityPolicy(tcase.policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = CheckDurability(durability, master, tabletMap)
+		if tcase.err == "" {
+			if err != nil {
+				t.Errorf("CheckDurability(%v) failed: %v", tcase.policy, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tcase.err) {
+			t.Errorf("CheckDurability(%v) err: %v, want %v", tcase.policy, err, tcase.err)
+		}
+	}
+}
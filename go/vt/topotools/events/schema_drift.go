@@ -0,0 +1,35 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// SchemaDrift is an event that describes a tablet whose schema differs
+// from the schema of the master of its shard.
+type SchemaDrift struct {
+	KeyspaceName string
+	ShardName    string
+	MasterAlias  *topodatapb.TabletAlias
+	TabletAlias  *topodatapb.TabletAlias
+
+	// Diffs are the differences between the two schemas, as returned
+	// by tmutils.DiffSchemaToArray. They are empty when the schema of
+	// the tablet matches the schema of the master again.
+	Diffs []string
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"log/syslog"
+	"strings"
+
+	"vitess.io/vitess/go/event/syslogger"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+)
+
+// Syslog writes a SchemaDrift event to syslog.
+func (ev *SchemaDrift) Syslog() (syslog.Priority, string) {
+	if len(ev.Diffs) == 0 {
+		return syslog.LOG_INFO, fmt.Sprintf("%s/%s [schema drift] schema of %v matches master %v again",
+			ev.KeyspaceName, ev.ShardName,
+			topoproto.TabletAliasString(ev.TabletAlias),
+			topoproto.TabletAliasString(ev.MasterAlias))
+	}
+	return syslog.LOG_WARNING, fmt.Sprintf("%s/%s [schema drift] schema of %v differs from master %v: %v",
+		ev.KeyspaceName, ev.ShardName,
+		topoproto.TabletAliasString(ev.TabletAlias),
+		topoproto.TabletAliasString(ev.MasterAlias),
+		strings.Join(ev.Diffs, "; "))
+}
+
+var _ syslogger.Syslogger = (*SchemaDrift)(nil) // compile-time interface check
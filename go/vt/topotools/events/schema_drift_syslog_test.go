@@ -0,0 +1,58 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"log/syslog"
+	"testing"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestSchemaDriftSyslog(t *testing.T) {
+	ev := &SchemaDrift{
+		KeyspaceName: "keyspace-123",
+		ShardName:    "shard-123",
+		MasterAlias: &topodatapb.TabletAlias{
+			Cell: "cell",
+			Uid:  12345,
+		},
+		TabletAlias: &topodatapb.TabletAlias{
+			Cell: "cell",
+			Uid:  54321,
+		},
+		Diffs: []string{"diff1", "diff2"},
+	}
+	wantSev, wantMsg := syslog.LOG_WARNING, "keyspace-123/shard-123 [schema drift] schema of cell-0000054321 differs from master cell-0000012345: diff1; diff2"
+	gotSev, gotMsg := ev.Syslog()
+	if gotSev != wantSev {
+		t.Errorf("wrong severity: got %v, want %v", gotSev, wantSev)
+	}
+	if gotMsg != wantMsg {
+		t.Errorf("wrong message: got %v, want %v", gotMsg, wantMsg)
+	}
+
+	ev.Diffs = nil
+	wantSev, wantMsg = syslog.LOG_INFO, "keyspace-123/shard-123 [schema drift] schema of cell-0000054321 matches master cell-0000012345 again"
+	gotSev, gotMsg = ev.Syslog()
+	if gotSev != wantSev {
+		t.Errorf("wrong severity: got %v, want %v", gotSev, wantSev)
+	}
+	if gotMsg != wantMsg {
+		t.Errorf("wrong message: got %v, want %v", gotMsg, wantMsg)
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// DefaultSequenceCache is the number of values that the tablets reserve
+// at a time for a provisioned sequence.
+const DefaultSequenceCache = 1000
+
+// SequenceTableDDL returns the statement that creates the backing table
+// of a sequence, if it doesn't exist.
+func SequenceTableDDL(table string) string {
+	return fmt.Sprintf("create table if not exists %s(id int, next_id bigint, cache bigint, primary key(id)) comment 'vitess_sequence'", sqlescape.EscapeID(table))
+}
+
+// SequenceInitQuery returns the statement that initializes a sequence
+// so that its next value is above maxID. It never lowers the next value
+// of a sequence that is already initialized.
+func SequenceInitQuery(table string, maxID, cache int64) string {
+	if cache <= 0 {
+		cache = DefaultSequenceCache
+	}
+	return fmt.Sprintf("insert into %s(id, next_id, cache) values (0, %d, %d) on duplicate key update next_id = greatest(next_id, values(next_id))", sqlescape.EscapeID(table), maxID+1, cache)
+}
+
+// MaxColumnQuery returns the query that reads the maximum value of a
+// column of a table.
+func MaxColumnQuery(table, column string) string {
+	return fmt.Sprintf("select max(%s) from %s", sqlescape.EscapeID(column), sqlescape.EscapeID(table))
+}
+
+// MaxValue returns the maximum value of the results of MaxColumnQuery
+// on multiple shards, or 0 if the tables are empty.
+func MaxValue(results ...*sqltypes.Result) (int64, error) {
+	var maxID int64
+	for _, qr := range results {
+		for _, row := range qr.Rows {
+			if len(row) == 0 || row[0].IsNull() {
+				continue
+			}
+			v, err := sqltypes.ToInt64(row[0])
+			if err != nil {
+				return 0, fmt.Errorf("invalid maximum value %v: %v", row[0], err)
+			}
+			if v > maxID {
+				maxID = v
+			}
+		}
+	}
+	return maxID, nil
+}
+
+// ResolveSequence returns the keyspace and the table of a sequence
+// reference of an auto-increment column. An unqualified sequence is
+// looked up in the vschemas, and defaults to defaultKeyspace if it's
+// not found. It returns an empty keyspace if the sequence cannot be
+// resolved.
+func ResolveSequence(sequence string, vschemas map[string]*vschemapb.Keyspace, defaultKeyspace string) (keyspace, table string) {
+	if i := strings.Index(sequence, "."); i >= 0 {
+		return sequence[:i], sequence[i+1:]
+	}
+	for ks, vschema := range vschemas {
+		if t, ok := vschema.GetTables()[sequence]; ok && t.Type == "sequence" {
+			return ks, sequence
+		}
+	}
+	return defaultKeyspace, sequence
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestSequenceQueries(t *testing.T) {
+	want := "create table if not exists `user_seq`(id int, next_id bigint, cache bigint, primary key(id)) comment 'vitess_sequence'"
+	if got := SequenceTableDDL("user_seq"); got != want {
+		t.Errorf("SequenceTableDDL: %q, want %q", got, want)
+	}
+	want = "insert into `user_seq`(id, next_id, cache) values (0, 42, 1000) on duplicate key update next_id = greatest(next_id, values(next_id))"
+	if got := SequenceInitQuery("user_seq", 41, 0); got != want {
+		t.Errorf("SequenceInitQuery: %q, want %q", got, want)
+	}
+	want = "select max(`order`) from `user`"
+	if got := MaxColumnQuery("user", "order"); got != want {
+		t.Errorf("MaxColumnQuery: %q, want %q", got, want)
+	}
+}
+
+func TestMaxValue(t *testing.T) {
+	fields := sqltypes.MakeTestFields("max(id)", "int64")
+	got, err := MaxValue(
+		sqltypes.MakeTestResult(fields, "12"),
+		sqltypes.MakeTestResult(fields, "null"),
+		sqltypes.MakeTestResult(fields, "41"),
+		sqltypes.MakeTestResult(fields),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 41 {
+		t.Errorf("MaxValue: %v, want 41", got)
+	}
+
+	if _, err := MaxValue(sqltypes.MakeTestResult(sqltypes.MakeTestFields("max(id)", "varchar"), "abc")); err == nil {
+		t.Errorf("MaxValue of a varchar column: nil, want error")
+	}
+}
+
+func TestResolveSequence(t *testing.T) {
+	vschemas := map[string]*vschemapb.Keyspace{
+		"user": {
+			Sharded: true,
+		},
+		"lookup": {
+			Tables: map[string]*vschemapb.Table{
+				"user_seq": {Type: "sequence"},
+				"music":    {},
+			},
+		},
+	}
+	testcases := []struct {
+		sequence, keyspace, table string
+	}{
+		{"ks.user_seq", "ks", "user_seq"},
+		{"user_seq", "lookup", "user_seq"},
+		{"music", "seqs", "music"},
+		{"other_seq", "seqs", "other_seq"},
+	}
+	for _, tc := range testcases {
+		keyspace, table := ResolveSequence(tc.sequence, vschemas, "seqs")
+		if keyspace != tc.keyspace || table != tc.table {
+			t.Errorf("ResolveSequence(%s): %s.%s, want %s.%s", tc.sequence, keyspace, table, tc.keyspace, tc.table)
+		}
+	}
+}
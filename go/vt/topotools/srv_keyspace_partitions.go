@@ -0,0 +1,253 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// CheckPartition checks that the shards of a SrvKeyspace partition
+// serve each keyspace id exactly once: their key ranges must cover the
+// whole keyspace, without gaps or overlaps. The shards of a custom
+// sharded keyspace have no key range, and are not checked. All the
+// problems found are part of the returned error.
+func CheckPartition(partition *topodatapb.SrvKeyspace_KeyspacePartition) error {
+	if len(partition.ShardReferences) == 0 {
+		return fmt.Errorf("no shard serves %v", topoproto.TabletTypeLString(partition.ServedType))
+	}
+
+	var problems []string
+	refs := make([]*topodatapb.ShardReference, len(partition.ShardReferences))
+	copy(refs, partition.ShardReferences)
+	names := make(map[string]bool)
+	withKeyRange := 0
+	for _, ref := range refs {
+		if names[ref.Name] {
+			problems = append(problems, fmt.Sprintf("shard %v is listed more than once", ref.Name))
+		}
+		names[ref.Name] = true
+		if ref.KeyRange != nil {
+			withKeyRange++
+		}
+	}
+	switch withKeyRange {
+	case 0:
+		// Custom sharding.
+		return partitionError(problems)
+	case len(refs):
+	default:
+		problems = append(problems, "some shards have a key range and some don't")
+		return partitionError(problems)
+	}
+
+	topoproto.ShardReferenceArray(refs).Sort()
+	var prev *topodatapb.ShardReference
+	// next is the first keyspace id not served yet.
+	var next []byte
+	for _, ref := range refs {
+		if prev != nil && len(next) == 0 {
+			problems = append(problems, fmt.Sprintf("shards %v and %v overlap", prev.Name, ref.Name))
+			continue
+		}
+		switch c := bytes.Compare(ref.KeyRange.Start, next); {
+		case c > 0:
+			problems = append(problems, fmt.Sprintf("no shard serves %v-%v", hex.EncodeToString(next), hex.EncodeToString(ref.KeyRange.Start)))
+		case c < 0:
+			problems = append(problems, fmt.Sprintf("shards %v and %v overlap", prev.Name, ref.Name))
+		}
+		if prev == nil || len(ref.KeyRange.End) == 0 || bytes.Compare(ref.KeyRange.End, next) > 0 {
+			next = ref.KeyRange.End
+		}
+		prev = ref
+	}
+	if len(next) != 0 {
+		problems = append(problems, fmt.Sprintf("no shard serves %v-", hex.EncodeToString(next)))
+	}
+	return partitionError(problems)
+}
+
+func partitionError(problems []string) error {
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%v", strings.Join(problems, ", "))
+}
+
+// PartitionView describes the shards serving a tablet type in a cell.
+type PartitionView struct {
+	Cell       string
+	ServedType string
+	// Shards are the shards, in key range order.
+	Shards []string
+	// Error is set if the shards don't serve each keyspace id exactly
+	// once, see CheckPartition.
+	Error string `json:",omitempty"`
+}
+
+// GetPartitionViews returns the partitions of the SrvKeyspace of a
+// keyspace in the cells, ordered by cell and tablet type. If cells is
+// empty, all the cells are used. The cells without SrvKeyspace are
+// skipped.
+func GetPartitionViews(ctx context.Context, ts *topo.Server, keyspace string, cells []string) ([]*PartitionView, error) {
+	if len(cells) == 0 {
+		var err error
+		if cells, err = ts.GetCellInfoNames(ctx); err != nil {
+			return nil, err
+		}
+	}
+	var views []*PartitionView
+	for _, cell := range cells {
+		srvKeyspace, err := ts.GetSrvKeyspace(ctx, cell, keyspace)
+		switch {
+		case err == nil:
+		case topo.IsErrType(err, topo.NoNode):
+			continue
+		default:
+			return nil, err
+		}
+		partitions := make([]*topodatapb.SrvKeyspace_KeyspacePartition, len(srvKeyspace.Partitions))
+		copy(partitions, srvKeyspace.Partitions)
+		sort.Slice(partitions, func(i, j int) bool {
+			return partitions[i].ServedType < partitions[j].ServedType
+		})
+		for _, partition := range partitions {
+			views = append(views, NewPartitionView(cell, partition))
+		}
+	}
+	return views, nil
+}
+
+// NewPartitionView returns the view of a partition of a SrvKeyspace.
+func NewPartitionView(cell string, partition *topodatapb.SrvKeyspace_KeyspacePartition) *PartitionView {
+	refs := make([]*topodatapb.ShardReference, len(partition.ShardReferences))
+	copy(refs, partition.ShardReferences)
+	topoproto.ShardReferenceArray(refs).Sort()
+	view := &PartitionView{
+		Cell:       cell,
+		ServedType: topoproto.TabletTypeLString(partition.ServedType),
+		Shards:     make([]string, 0, len(refs)),
+	}
+	for _, ref := range refs {
+		view.Shards = append(view.Shards, ref.Name)
+	}
+	if err := CheckPartition(partition); err != nil {
+		view.Error = err.Error()
+	}
+	return view
+}
+
+// FormatPartitionViews returns a table of the partitions, with one line
+// per cell and tablet type. The shards of a line are drawn as a bar
+// over the key range boundaries of all the lines, so the shards that
+// serve the same key ranges in different cells or for different tablet
+// types are aligned.
+func FormatPartitionViews(views []*PartitionView) string {
+	// The columns of the bars are the boundaries of the shards of all
+	// the partitions, in order.
+	boundaries := map[string]bool{"": true}
+	for _, view := range views {
+		for _, shard := range view.Shards {
+			if start, end, ok := shardBoundaries(shard); ok {
+				boundaries[start] = true
+				boundaries[end] = true
+			}
+		}
+	}
+	columns := make([]string, 0, len(boundaries))
+	for boundary := range boundaries {
+		columns = append(columns, boundary)
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		// The empty boundary is the min key and the max key, it is the
+		// first column.
+		return columns[i] < columns[j]
+	})
+	column := make(map[string]int, len(columns))
+	for i, boundary := range columns {
+		column[boundary] = i
+	}
+	// A column is wide enough for the name of any shard.
+	width := 0
+	for _, view := range views {
+		for _, shard := range view.Shards {
+			if len(shard)+2 > width {
+				width = len(shard) + 2
+			}
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	w := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "CELL\tTYPE\tSHARDS\t\n")
+	for _, view := range views {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\n", view.Cell, view.ServedType, partitionBar(view.Shards, len(columns), column, width), view.Error)
+	}
+	w.Flush()
+	lines := strings.Split(buf.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// partitionBar draws the shards as consecutive bracketed segments. A
+// segment spans the columns of the key range of its shard, so the
+// segments of different bars line up.
+func partitionBar(shards []string, columns int, column map[string]int, width int) string {
+	bar := &bytes.Buffer{}
+	for _, shard := range shards {
+		start, end, ok := shardBoundaries(shard)
+		if !ok {
+			// Custom sharding, there are no key ranges to line up.
+			fmt.Fprintf(bar, "[%v]", shard)
+			continue
+		}
+		last := columns
+		if end != "" {
+			last = column[end]
+		}
+		label := "[" + shard
+		if pad := (last-column[start])*width - len(label) - 1; pad > 0 {
+			label += strings.Repeat(" ", pad)
+		}
+		bar.WriteString(label + "]")
+	}
+	return bar.String()
+}
+
+// shardBoundaries returns the hex start and end of the key range of a
+// shard name, or false if the name is not a key range.
+func shardBoundaries(shard string) (string, string, bool) {
+	if !key.IsKeyRange(shard) {
+		return "", "", false
+	}
+	parts := strings.Split(shard, "-")
+	return strings.ToLower(parts[0]), strings.ToLower(parts[1]), true
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func partition(tabletType topodatapb.TabletType, shards ...string) *topodatapb.SrvKeyspace_KeyspacePartition {
+	p := &topodatapb.SrvKeyspace_KeyspacePartition{ServedType: tabletType}
+	for _, shard := range shards {
+		ref := &topodatapb.ShardReference{Name: shard}
+		if key.IsKeyRange(shard) {
+			parts := strings.Split(shard, "-")
+			kr, err := key.ParseKeyRangeParts(parts[0], parts[1])
+			if err != nil {
+				panic(err)
+			}
+			ref.KeyRange = kr
+		}
+		p.ShardReferences = append(p.ShardReferences, ref)
+	}
+	return p
+}
+
+func TestCheckPartition(t *testing.T) {
+	testcases := []struct {
+		shards []string
+		err    string
+	}{{
+		shards: []string{"-80", "80-"},
+	}, {
+		shards: []string{"80-c0", "-80", "c0-"},
+	}, {
+		shards: []string{"-"},
+	}, {
+		// Custom sharding.
+		shards: []string{"0", "1"},
+	}, {
+		shards: nil,
+		err:    "no shard serves master",
+	}, {
+		shards: []string{"-80", "c0-"},
+		err:    "no shard serves 80-c0",
+	}, {
+		shards: []string{"40-80", "80-"},
+		err:    "no shard serves -40",
+	}, {
+		shards: []string{"-40", "40-80"},
+		err:    "no shard serves 80-",
+	}, {
+		shards: []string{"-80", "40-c0", "c0-"},
+		err:    "shards -80 and 40-c0 overlap",
+	}, {
+		shards: []string{"-80", "-", "80-"},
+		err:    "shards - and -80 overlap, shards - and 80- overlap",
+	}, {
+		shards: []string{"-80", "80-", "80-"},
+		err:    "shard 80- is listed more than once, shards 80- and 80- overlap",
+	}, {
+		shards: []string{"0", "80-"},
+		err:    "some shards have a key range and some don't",
+	}, {
+		shards: []string{"-40", "80-c0"},
+		err:    "no shard serves 40-80, no shard serves c0-",
+	}}
+	for _, tcase := range testcases {
+		err := CheckPartition(partition(topodatapb.TabletType_MASTER, tcase.shards...))
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tcase.err {
+			t.Errorf("CheckPartition(%v): %v, want %v", tcase.shards, got, tcase.err)
+		}
+	}
+}
+
+func TestFormatPartitionViews(t *testing.T) {
+	views := []*PartitionView{
+		NewPartitionView("cell1", partition(topodatapb.TabletType_MASTER, "-80", "80-")),
+		NewPartitionView("cell1", partition(topodatapb.TabletType_REPLICA, "-40", "40-80", "80-")),
+		NewPartitionView("cell2", partition(topodatapb.TabletType_REPLICA, "-40", "80-")),
+	}
+	want := strings.Join([]string{
+		"CELL   TYPE     SHARDS",
+		"cell1  master   [-80         ][80-  ]",
+		"cell1  replica  [-40  ][40-80][80-  ]",
+		"cell2  replica  [-40  ][80-  ]         no shard serves 40-80",
+		"",
+	}, "\n")
+	if got := FormatPartitionViews(views); got != want {
+		t.Errorf("FormatPartitionViews:\n%v\nwant:\n%v", got, want)
+	}
+}
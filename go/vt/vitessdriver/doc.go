@@ -101,5 +101,27 @@ Vitess supports positional or named arguments. However, intermixing is not allow
 within a statement. If using named arguments, the ':' and '@' prefixes are optional.
 If they're specified, the driver will strip them off before sending the request over
 to VTGate.
+
+The values that VTGate can bind as they are keep their type: for example, a uint64
+above the int64 range, a sqltypes.Value, a *querypb.BindVariable, or a []interface{}
+for an IN clause. The other values are converted by the Go sql package.
+
+
+Transaction modes
+
+The transaction mode of the transactions (single, multi or twopc) can be set with
+the TransactionMode field of the Configuration. By default, the transaction_mode
+flag of vtgate is used.
+
+
+Execute options
+
+The ExecuteOptions of a query, like its workload, can be passed in its context.
+The fields they set override the ones of the session for this query only:
+
+  ctx = vitessdriver.WithExecuteOptions(ctx, &querypb.ExecuteOptions{
+    Workload: querypb.ExecuteOptions_OLAP,
+  })
+  rows, err := db.QueryContext(ctx, "select ...")
 */
 package vitessdriver
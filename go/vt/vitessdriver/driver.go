@@ -23,7 +23,11 @@ import (
 	"encoding/json"
 	"errors"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
 var (
@@ -33,10 +37,11 @@ var (
 
 // Type-check interfaces.
 var (
-	_ driver.QueryerContext   = &conn{}
-	_ driver.ExecerContext    = &conn{}
-	_ driver.StmtQueryContext = &stmt{}
-	_ driver.StmtExecContext  = &stmt{}
+	_ driver.QueryerContext    = &conn{}
+	_ driver.ExecerContext     = &conn{}
+	_ driver.NamedValueChecker = &conn{}
+	_ driver.StmtQueryContext  = &stmt{}
+	_ driver.StmtExecContext   = &stmt{}
 )
 
 func init() {
@@ -93,7 +98,7 @@ type drv struct {
 //
 // Example for a JSON string:
 //
-//   {"protocol": "grpc", "address": "localhost:1111", "target": "@master"}
+//	{"protocol": "grpc", "address": "localhost:1111", "target": "@master"}
 //
 // For a description of the available fields, see the Configuration struct.
 func (d drv) Open(name string) (driver.Conn, error) {
@@ -139,6 +144,11 @@ type Configuration struct {
 	// This setting has no effect if ConvertDatetime is not set.
 	// Default: UTC
 	DefaultLocation string
+
+	// TransactionMode is the transaction mode of the transactions of
+	// the connections. It overrides the transaction_mode flag of vtgate.
+	// Default: UNSPECIFIED, which uses the flag of vtgate.
+	TransactionMode vtgatepb.TransactionMode `json:",omitempty"`
 }
 
 // toJSON converts Configuration to the JSON string which is required by the
@@ -177,6 +187,9 @@ func (c *conn) dial() error {
 		return err
 	}
 	c.session = c.conn.Session(c.Target, nil)
+	if c.TransactionMode != vtgatepb.TransactionMode_UNSPECIFIED {
+		c.session.SetTransactionMode(c.TransactionMode)
+	}
 	return nil
 }
 
@@ -193,6 +206,22 @@ func (c *conn) Close() error {
 	return nil
 }
 
+// CheckNamedValue implements the database/sql/driver.NamedValueChecker
+// interface. The values that can be bound as they are, like uint64,
+// sqltypes.Value, *querypb.BindVariable or the slices of an IN clause,
+// are not converted by database/sql, so their type is preserved.
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	switch nv.Value.(type) {
+	case driver.Valuer:
+		return driver.ErrSkip
+	}
+	if _, err := c.convert.BuildBindVariable(nv.Value); err != nil {
+		// Let database/sql convert the other types, like int32.
+		return driver.ErrSkip
+	}
+	return nil
+}
+
 func (c *conn) Begin() (driver.Tx, error) {
 	if _, err := c.Exec("begin", nil); err != nil {
 		return nil, err
@@ -230,7 +259,7 @@ func (c *conn) Exec(query string, args []driver.Value) (driver.Result, error) {
 		return nil, err
 	}
 
-	qr, err := c.session.Execute(ctx, query, bindVars)
+	qr, err := c.execute(ctx, query, bindVars)
 	if err != nil {
 		return nil, err
 	}
@@ -246,7 +275,7 @@ func (c *conn) ExecContext(ctx context.Context, query string, args []driver.Name
 	if err != nil {
 		return nil, err
 	}
-	qr, err := c.session.Execute(ctx, query, bv)
+	qr, err := c.execute(ctx, query, bv)
 	if err != nil {
 		return nil, err
 	}
@@ -261,14 +290,14 @@ func (c *conn) Query(query string, args []driver.Value) (driver.Rows, error) {
 	}
 
 	if c.Streaming {
-		stream, err := c.session.StreamExecute(ctx, query, bindVars)
+		stream, err := c.streamExecute(ctx, query, bindVars)
 		if err != nil {
 			return nil, err
 		}
 		return newStreamingRows(stream, c.convert), nil
 	}
 
-	qr, err := c.session.Execute(ctx, query, bindVars)
+	qr, err := c.execute(ctx, query, bindVars)
 	if err != nil {
 		return nil, err
 	}
@@ -282,20 +311,36 @@ func (c *conn) QueryContext(ctx context.Context, query string, args []driver.Nam
 	}
 
 	if c.Streaming {
-		stream, err := c.session.StreamExecute(ctx, query, bv)
+		stream, err := c.streamExecute(ctx, query, bv)
 		if err != nil {
 			return nil, err
 		}
 		return newStreamingRows(stream, c.convert), nil
 	}
 
-	qr, err := c.session.Execute(ctx, query, bv)
+	qr, err := c.execute(ctx, query, bv)
 	if err != nil {
 		return nil, err
 	}
 	return newRows(qr, c.convert), nil
 }
 
+// execute runs query with the ExecuteOptions of ctx, if any.
+func (c *conn) execute(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	if options := executeOptionsFromContext(ctx); options != nil {
+		return c.session.ExecuteWithOptions(ctx, query, bindVars, options)
+	}
+	return c.session.Execute(ctx, query, bindVars)
+}
+
+// streamExecute streams query with the ExecuteOptions of ctx, if any.
+func (c *conn) streamExecute(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) (sqltypes.ResultStream, error) {
+	if options := executeOptionsFromContext(ctx); options != nil {
+		return c.session.StreamExecuteWithOptions(ctx, query, bindVars, options)
+	}
+	return c.session.StreamExecute(ctx, query, bindVars)
+}
+
 type stmt struct {
 	c     *conn
 	query string
@@ -21,6 +21,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
+	"math"
 	"net"
 	"os"
 	"reflect"
@@ -31,8 +32,10 @@ import (
 	"google.golang.org/grpc"
 
 	"vitess.io/vitess/go/sqltypes"
-	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/vtgate/grpcvtgateservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
 var (
@@ -430,6 +433,101 @@ func TestBindVars(t *testing.T) {
 	}
 }
 
+func TestCheckNamedValue(t *testing.T) {
+	c := &conn{convert: &converter{location: time.UTC}}
+	testcases := []struct {
+		in   interface{}
+		skip bool
+	}{{
+		in: uint64(math.MaxUint64),
+	}, {
+		in: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.5")),
+	}, {
+		in: sqltypes.StringBindVariable("abcd"),
+	}, {
+		in: []interface{}{int64(1), "abcd"},
+	}, {
+		in: []string{"a", "b"},
+	}, {
+		in: time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC),
+	}, {
+		in:   int32(1),
+		skip: true,
+	}, {
+		in:   sql.NullInt64{Int64: 1, Valid: true},
+		skip: true,
+	}}
+	for _, tc := range testcases {
+		err := c.CheckNamedValue(&driver.NamedValue{Value: tc.in})
+		if tc.skip {
+			if err != driver.ErrSkip {
+				t.Errorf("CheckNamedValue(%#v): %v, want ErrSkip", tc.in, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("CheckNamedValue(%#v): %v", tc.in, err)
+		}
+	}
+}
+
+func TestExecuteOptions(t *testing.T) {
+	ctx := WithExecuteOptions(context.Background(), &querypb.ExecuteOptions{
+		Workload: querypb.ExecuteOptions_OLAP,
+	})
+	for _, streaming := range []bool{false, true} {
+		db, err := OpenWithConfiguration(Configuration{
+			Address:   testAddress,
+			Target:    "@rdonly",
+			Streaming: streaming,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+
+		// A uint64 above the int64 range keeps its type.
+		rows, err := db.QueryContext(ctx, "requestOptions", uint64(math.MaxUint64))
+		if err != nil {
+			t.Fatalf("streaming: %v: %v", streaming, err)
+		}
+		count := 0
+		for rows.Next() {
+			count++
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatalf("streaming: %v: %v", streaming, err)
+		}
+		rows.Close()
+		if count != 2 {
+			t.Errorf("streaming: %v: got %d rows, want 2", streaming, count)
+		}
+
+		// The options of the session are restored.
+		rows, err = db.QueryContext(context.Background(), "request", int64(0))
+		if err != nil {
+			t.Fatalf("streaming: %v: %v", streaming, err)
+		}
+		rows.Close()
+	}
+}
+
+func TestTransactionMode(t *testing.T) {
+	db, err := OpenWithConfiguration(Configuration{
+		Address:         testAddress,
+		Target:          "@master",
+		TransactionMode: vtgatepb.TransactionMode_TWOPC,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("requestTwoPC"); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestDatetimeQuery(t *testing.T) {
 	var testcases = []struct {
 		desc        string
@@ -19,6 +19,7 @@ package vitessdriver
 import (
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 
 	"github.com/golang/protobuf/proto"
@@ -227,6 +228,7 @@ func (f *fakeVTGateService) SplitQuery(
 	splitCount int64,
 	numRowsPerQueryPart int64,
 	algorithm querypb.SplitQueryRequest_Algorithm,
+	cell string,
 ) ([]*vtgatepb.SplitQueryResponse_Part, error) {
 	return nil, nil
 }
@@ -240,6 +242,11 @@ func (f *fakeVTGateService) VStream(ctx context.Context, tabletType topodatapb.T
 	return nil
 }
 
+// SetRuntimeFlags is part of the VTGateService interface
+func (f *fakeVTGateService) SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*vtgatepb.RuntimeFlagChange, error) {
+	return nil, nil
+}
+
 // UpdateStream is part of the VTGateService interface
 func (f *fakeVTGateService) UpdateStream(ctx context.Context, keyspace string, shard string, keyRange *topodatapb.KeyRange, tabletType topodatapb.TabletType, timestamp int64, event *querypb.EventToken, callback func(*querypb.StreamEvent, int64) error) error {
 	return nil
@@ -291,6 +298,35 @@ var execMap = map[string]struct {
 		result:  &result2,
 		session: nil,
 	},
+	"requestOptions": {
+		execQuery: &queryExecute{
+			SQL: "requestOptions",
+			BindVariables: map[string]*querypb.BindVariable{
+				"v1": sqltypes.Uint64BindVariable(math.MaxUint64),
+			},
+			Session: &vtgatepb.Session{
+				TargetString: "@rdonly",
+				Autocommit:   true,
+				Options: &querypb.ExecuteOptions{
+					Workload: querypb.ExecuteOptions_OLAP,
+				},
+			},
+		},
+		result:  &result1,
+		session: nil,
+	},
+	"requestTwoPC": {
+		execQuery: &queryExecute{
+			SQL: "requestTwoPC",
+			Session: &vtgatepb.Session{
+				TargetString:    "@master",
+				Autocommit:      true,
+				TransactionMode: vtgatepb.TransactionMode_TWOPC,
+			},
+		},
+		result:  &sqltypes.Result{},
+		session: nil,
+	},
 	"txRequest": {
 		execQuery: &queryExecute{
 			SQL: "txRequest",
@@ -0,0 +1,46 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vitessdriver
+
+import (
+	"context"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// executeOptionsKey is the context key of the per-query ExecuteOptions.
+type executeOptionsKey struct{}
+
+// WithExecuteOptions returns a copy of ctx which makes the queries run
+// with it use options, instead of the ExecuteOptions of the connection.
+//
+// Example:
+//
+//	ctx := vitessdriver.WithExecuteOptions(ctx, &querypb.ExecuteOptions{
+//	  Workload: querypb.ExecuteOptions_OLAP,
+//	})
+//	rows, err := db.QueryContext(ctx, "select ...")
+func WithExecuteOptions(ctx context.Context, options *querypb.ExecuteOptions) context.Context {
+	return context.WithValue(ctx, executeOptionsKey{}, options)
+}
+
+// executeOptionsFromContext returns the ExecuteOptions set with
+// WithExecuteOptions, or nil.
+func executeOptionsFromContext(ctx context.Context) *querypb.ExecuteOptions {
+	options, _ := ctx.Value(executeOptionsKey{}).(*querypb.ExecuteOptions)
+	return options
+}
@@ -348,6 +348,12 @@ func (itc *internalTabletConn) Rollback(ctx context.Context, target *querypb.Tar
 	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
 }
 
+// Release is part of queryservice.QueryService
+func (itc *internalTabletConn) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	err := itc.tablet.qsc.QueryService().Release(ctx, target, reservedID)
+	return tabletconn.ErrorFromGRPC(vterrors.ToGRPC(err))
+}
+
 // Prepare is part of queryservice.QueryService
 func (itc *internalTabletConn) Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) error {
 	err := itc.tablet.qsc.QueryService().Prepare(ctx, target, transactionID, dtid)
@@ -574,6 +580,62 @@ func (itmc *internalTabletManagerClient) RefreshState(ctx context.Context, table
 	return t.agent.RefreshState(ctx)
 }
 
+func (itmc *internalTabletManagerClient) GetPools(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.PoolInfo, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetPools(ctx)
+}
+
+func (itmc *internalTabletManagerClient) ResizePool(ctx context.Context, tablet *topodatapb.Tablet, name string, capacity int, idleTimeout time.Duration, prefillParallelism int) (*tabletmanagerdatapb.PoolInfo, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.ResizePool(ctx, name, capacity, idleTimeout, prefillParallelism)
+}
+
+func (itmc *internalTabletManagerClient) GetLockDiagnostics(ctx context.Context, tablet *topodatapb.Tablet, lastAutomatic bool) (*tabletmanagerdatapb.LockDiagnostics, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetLockDiagnostics(ctx, lastAutomatic)
+}
+
+func (itmc *internalTabletManagerClient) GetQueryPlanStats(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryPlanStats, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetQueryPlanStats(ctx)
+}
+
+func (itmc *internalTabletManagerClient) GetQueryBlocklist(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.GetQueryBlocklist(ctx)
+}
+
+func (itmc *internalTabletManagerClient) UpdateQueryBlocklist(ctx context.Context, tablet *topodatapb.Tablet, action, fingerprint, reason string) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.UpdateQueryBlocklist(ctx, action, fingerprint, reason)
+}
+
+func (itmc *internalTabletManagerClient) SetRuntimeFlags(ctx context.Context, tablet *topodatapb.Tablet, flags map[string]string) ([]*tabletmanagerdatapb.RuntimeFlagChange, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.agent.SetRuntimeFlags(ctx, flags)
+}
+
 func (itmc *internalTabletManagerClient) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
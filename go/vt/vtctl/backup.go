@@ -17,13 +17,15 @@ limitations under the License.
 package vtctl
 
 import (
-	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -41,6 +43,21 @@ func init() {
 		commandBackupShard,
 		"[-allow_master=false] <keyspace/shard>",
 		"Chooses a tablet and creates a backup for a shard."})
+	addCommand("Keyspaces", command{
+		"BackupKeyspace",
+		commandBackupKeyspace,
+		"[-concurrency=4] [-wait_time=5m] <keyspace>",
+		"Creates a backup of every shard of a keyspace. The backups are consistent with each other: replication is stopped on one tablet per shard, the positions of all the masters are recorded at the same time, and each tablet catches up to the position of its master before its backup is taken. The backups and their positions are recorded in a keyspace backup, named after the time the positions were recorded."})
+	addCommand("Keyspaces", command{
+		"ListKeyspaceBackups",
+		commandListKeyspaceBackups,
+		"<keyspace>",
+		"Lists all the keyspace backups of a keyspace."})
+	addCommand("Keyspaces", command{
+		"RestoreKeyspace",
+		commandRestoreKeyspace,
+		"<keyspace> <keyspace backup name> <snapshot keyspace>",
+		"Creates a SNAPSHOT keyspace to restore a keyspace backup. Tablets started in the snapshot keyspace with -restore_from_backup restore the backup of their shard recorded in the keyspace backup, so that all the shards are restored to the same consistent point."})
 	addCommand("Shards", command{
 		"RemoveBackup",
 		commandRemoveBackup,
@@ -98,55 +115,89 @@ func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		return err
 	}
 
-	tablets, stats, err := wr.ShardReplicationStatuses(ctx, keyspace, shard)
-	if tablets == nil {
+	tabletForBackup, err := wr.ChooseBackupTablet(ctx, keyspace, shard, *allowMaster)
+	if err != nil {
 		return err
 	}
 
-	var tabletForBackup *topodatapb.Tablet
-	var secondsBehind uint32
+	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowMaster)
+}
 
-	for i := range tablets {
-		// find a replica, rdonly or spare tablet type to run the backup on
-		switch tablets[i].Type {
-		case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY, topodatapb.TabletType_SPARE:
-		default:
-			continue
-		}
-		// choose the first tablet as the baseline
-		if tabletForBackup == nil {
-			tabletForBackup = tablets[i].Tablet
-			secondsBehind = stats[i].SecondsBehindMaster
-			continue
-		}
+func commandBackupKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously on each tablet")
+	waitTime := subFlags.Duration("wait_time", 5*time.Minute, "Specifies the maximum time to wait for a tablet to catch up with the recorded position of its master")
 
-		// choose a new tablet if it is more up to date
-		if stats[i].SecondsBehindMaster < secondsBehind {
-			tabletForBackup = tablets[i].Tablet
-			secondsBehind = stats[i].SecondsBehindMaster
-		}
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("action BackupKeyspace requires <keyspace>")
 	}
 
-	// if no other tablet is available and allowMaster is set to true
-	if tabletForBackup == nil && *allowMaster {
-	ChooseMaster:
-		for i := range tablets {
-			switch tablets[i].Type {
-			case topodatapb.TabletType_MASTER:
-				tabletForBackup = tablets[i].Tablet
-				secondsBehind = 0
-				break ChooseMaster
-			default:
-				continue
-			}
-		}
+	_, _, err := wr.BackupKeyspace(ctx, subFlags.Arg(0), *concurrency, *waitTime)
+	return err
+}
+
+func commandListKeyspaceBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("action ListKeyspaceBackups requires <keyspace>")
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	names, err := mysqlctl.ListKeyspaceBackups(ctx, bs, subFlags.Arg(0))
+	if err != nil {
+		return err
 	}
+	for _, name := range names {
+		wr.Logger().Printf("%v\n", name)
+	}
+	return nil
+}
 
-	if tabletForBackup == nil {
-		return errors.New("no tablet available for backup")
+func commandRestoreKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 3 {
+		return fmt.Errorf("action RestoreKeyspace requires <keyspace> <keyspace backup name> <snapshot keyspace>")
 	}
+	keyspace := subFlags.Arg(0)
+	name := subFlags.Arg(1)
+	snapshotKeyspace := subFlags.Arg(2)
 
-	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowMaster)
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	manifest, err := mysqlctl.ReadKeyspaceBackupManifest(ctx, bs, keyspace, name)
+	if err != nil {
+		return fmt.Errorf("cannot read keyspace backup %v of %v: %v", name, keyspace, err)
+	}
+
+	// The tablets of the snapshot keyspace look for the keyspace
+	// backup taken at the snapshot time.
+	createArgs := []string{
+		"-keyspace_type=SNAPSHOT",
+		"-base_keyspace=" + keyspace,
+		"-snapshot_time=" + manifest.BackupTime,
+		snapshotKeyspace,
+	}
+	if err := commandCreateKeyspace(ctx, wr, flag.NewFlagSet("CreateKeyspace", flag.ContinueOnError), createArgs); err != nil {
+		return err
+	}
+	wr.Logger().Printf("Created keyspace %v. Start tablets in the following shards with -restore_from_backup:\n", snapshotKeyspace)
+	for _, sbm := range manifest.Shards {
+		wr.Logger().Printf("  %v: backup %v at %v\n", sbm.Shard, sbm.BackupName, mysql.EncodePosition(sbm.Position))
+	}
+	return nil
 }
 
 // execBackup is shared by Backup and BackupShard
@@ -19,6 +19,7 @@ package grpcvtctlclient
 
 import (
 	"flag"
+	"io"
 	"time"
 
 	"golang.org/x/net/context"
@@ -36,7 +37,7 @@ var (
 	cert = flag.String("vtctld_grpc_cert", "", "the cert to use to connect")
 	key  = flag.String("vtctld_grpc_key", "", "the key to use to connect")
 	ca   = flag.String("vtctld_grpc_ca", "", "the server ca to use to validate servers when connecting")
-	name = flag.String("vtctld_grpc_server_name", "", "the server name to use to validate server certificate")
+	name = flag.String("vtctld_grpc_server_name", "", "the server name to use to validate server certificate, or the SPIFFE ID or trust domain of the server (like spiffe://example.org/vttablet), which requires a ca")
 )
 
 type gRPCVtctlClient struct {
@@ -62,6 +63,21 @@ func gRPCVtctlClientFactory(addr string) (vtctlclient.VtctlClient, error) {
 	}, nil
 }
 
+// DialVtctld returns a client of the typed Vtctld API of the vtctld at
+// addr, using the same TLS options as the vtctl client. The returned
+// closer closes the connection.
+func DialVtctld(addr string) (vtctlservicepb.VtctldClient, io.Closer, error) {
+	opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *name)
+	if err != nil {
+		return nil, nil, err
+	}
+	cc, err := grpcclient.Dial(addr, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vtctlservicepb.NewVtctldClient(cc), cc, nil
+}
+
 type eventStreamAdapter struct {
 	stream vtctlservicepb.Vtctl_ExecuteVtctlCommandClient
 }
@@ -25,11 +25,19 @@ import (
 	"os"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctlserver"
 	"vitess.io/vitess/go/vt/vtctl/vtctlclienttest"
 
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
 )
 
@@ -60,6 +68,110 @@ func TestVtctlServer(t *testing.T) {
 	vtctlclienttest.TestSuite(t, ts, client)
 }
 
+// TestVtctldClient runs the typed Vtctld API against a memory topo.
+func TestVtctldClient(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("Cannot listen: %v", err)
+	}
+	server := grpc.NewServer()
+	grpcvtctldserver.StartServer(server, ts)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	client, closer, err := DialVtctld(fmt.Sprintf("localhost:%v", listener.Addr().(*net.TCPAddr).Port))
+	if err != nil {
+		t.Fatalf("DialVtctld failed: %v", err)
+	}
+	defer closer.Close()
+
+	if _, err := client.CreateKeyspace(ctx, &vtctldatapb.CreateKeyspaceRequest{Name: "ks"}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	_, err = client.CreateKeyspace(ctx, &vtctldatapb.CreateKeyspaceRequest{Name: "ks"})
+	if got := status.Code(err); got != codes.AlreadyExists {
+		t.Errorf("CreateKeyspace again: %v, want code %v", err, codes.AlreadyExists)
+	}
+	if _, err := client.CreateKeyspace(ctx, &vtctldatapb.CreateKeyspaceRequest{Name: "ks", Force: true}); err != nil {
+		t.Errorf("CreateKeyspace with force failed: %v", err)
+	}
+
+	keyspaces, err := client.GetKeyspaces(ctx, &vtctldatapb.GetKeyspacesRequest{})
+	if err != nil {
+		t.Fatalf("GetKeyspaces failed: %v", err)
+	}
+	if len(keyspaces.Keyspaces) != 1 || keyspaces.Keyspaces[0].Name != "ks" {
+		t.Errorf("GetKeyspaces: %v, want only ks", keyspaces)
+	}
+
+	_, err = client.GetShard(ctx, &vtctldatapb.GetShardRequest{Keyspace: "ks", ShardName: "0"})
+	if got := status.Code(err); got != codes.NotFound {
+		t.Errorf("GetShard before CreateShard: %v, want code %v", err, codes.NotFound)
+	}
+	if _, err := client.CreateShard(ctx, &vtctldatapb.CreateShardRequest{Keyspace: "ks", ShardName: "0"}); err != nil {
+		t.Fatalf("CreateShard failed: %v", err)
+	}
+	shard, err := client.GetShard(ctx, &vtctldatapb.GetShardRequest{Keyspace: "ks", ShardName: "0"})
+	if err != nil {
+		t.Fatalf("GetShard failed: %v", err)
+	}
+	if shard.Shard.Keyspace != "ks" || shard.Shard.Name != "0" {
+		t.Errorf("GetShard: %v, want ks/0", shard)
+	}
+
+	// CreateKeyspace saved an empty vschema.
+	vs, err := client.GetVSchema(ctx, &vtctldatapb.GetVSchemaRequest{Keyspace: "ks"})
+	if err != nil {
+		t.Fatalf("GetVSchema failed: %v", err)
+	}
+	if !proto.Equal(vs.VSchema, &vschemapb.Keyspace{}) {
+		t.Errorf("GetVSchema: %v, want empty", vs.VSchema)
+	}
+
+	want := &vschemapb.Keyspace{
+		Tables: map[string]*vschemapb.Table{"t1": {}},
+	}
+	if _, err := client.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{Keyspace: "ks", VSchema: want, DryRun: true}); err != nil {
+		t.Fatalf("ApplyVSchema with dry run failed: %v", err)
+	}
+	if vs, _ := client.GetVSchema(ctx, &vtctldatapb.GetVSchemaRequest{Keyspace: "ks"}); len(vs.VSchema.Tables) != 0 {
+		t.Errorf("ApplyVSchema with dry run saved the vschema: %v", vs.VSchema)
+	}
+	if _, err := client.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{Keyspace: "ks", VSchema: want}); err != nil {
+		t.Fatalf("ApplyVSchema failed: %v", err)
+	}
+	if vs, _ := client.GetVSchema(ctx, &vtctldatapb.GetVSchemaRequest{Keyspace: "ks"}); !proto.Equal(vs.VSchema, want) {
+		t.Errorf("GetVSchema: %v, want %v", vs.VSchema, want)
+	}
+	srvVSchema, err := ts.GetSrvVSchema(ctx, "cell1")
+	if err != nil {
+		t.Fatalf("GetSrvVSchema failed: %v", err)
+	}
+	if !proto.Equal(srvVSchema.Keyspaces["ks"], want) {
+		t.Errorf("SrvVSchema: %v, want %v", srvVSchema.Keyspaces["ks"], want)
+	}
+
+	// An invalid vschema is rejected.
+	bad := &vschemapb.Keyspace{
+		Vindexes: map[string]*vschemapb.Vindex{"v": {Type: "does_not_exist"}},
+	}
+	_, err = client.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{Keyspace: "ks", VSchema: bad})
+	if got := status.Code(err); got != codes.InvalidArgument {
+		t.Errorf("ApplyVSchema with an invalid vschema: %v, want code %v", err, codes.InvalidArgument)
+	}
+
+	if _, err := client.DeleteKeyspace(ctx, &vtctldatapb.DeleteKeyspaceRequest{Keyspace: "ks", Recursive: true}); err != nil {
+		t.Fatalf("DeleteKeyspace failed: %v", err)
+	}
+	_, err = client.GetKeyspace(ctx, &vtctldatapb.GetKeyspaceRequest{Keyspace: "ks"})
+	if got := status.Code(err); got != codes.NotFound {
+		t.Errorf("GetKeyspace after DeleteKeyspace: %v, want code %v", err, codes.NotFound)
+	}
+}
+
 // the test here creates a fake server implementation, a fake client with auth
 // implementation, and runs the test suite against the setup.
 func TestVtctlAuthClient(t *testing.T) {
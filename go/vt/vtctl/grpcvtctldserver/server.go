@@ -0,0 +1,426 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package grpcvtctldserver contains the gRPC implementation of the typed
+Vtctld API.
+*/
+package grpcvtctldserver
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// VtctldServer implements the Vtctld API on top of the topo server and
+// the wrangler.
+type VtctldServer struct {
+	ts  *topo.Server
+	tmc tmclient.TabletManagerClient
+}
+
+// NewVtctldServer returns a new VtctldServer for the topo server.
+func NewVtctldServer(ts *topo.Server) *VtctldServer {
+	return &VtctldServer{
+		ts:  ts,
+		tmc: tmclient.NewTabletManagerClient(),
+	}
+}
+
+// wrangler returns a wrangler that keeps the events it logs, and also
+// logs them to the console.
+func (s *VtctldServer) wrangler() (*wrangler.Wrangler, *logutil.MemoryLogger) {
+	logger := logutil.NewMemoryLogger()
+	return wrangler.New(logutil.NewTeeLogger(logger, logutil.NewConsoleLogger()), s.ts, s.tmc), logger
+}
+
+// GetKeyspaces is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetKeyspaces(ctx context.Context, req *vtctldatapb.GetKeyspacesRequest) (*vtctldatapb.GetKeyspacesResponse, error) {
+	names, err := s.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	resp := &vtctldatapb.GetKeyspacesResponse{}
+	for _, name := range names {
+		ki, err := s.ts.GetKeyspace(ctx, name)
+		if err != nil {
+			return nil, toGRPC(err)
+		}
+		resp.Keyspaces = append(resp.Keyspaces, &vtctldatapb.Keyspace{
+			Name:     name,
+			Keyspace: ki.Keyspace,
+		})
+	}
+	return resp, nil
+}
+
+// GetKeyspace is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetKeyspace(ctx context.Context, req *vtctldatapb.GetKeyspaceRequest) (*vtctldatapb.GetKeyspaceResponse, error) {
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return &vtctldatapb.GetKeyspaceResponse{
+		Keyspace: &vtctldatapb.Keyspace{
+			Name:     req.Keyspace,
+			Keyspace: ki.Keyspace,
+		},
+	}, nil
+}
+
+// CreateKeyspace is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) CreateKeyspace(ctx context.Context, req *vtctldatapb.CreateKeyspaceRequest) (*vtctldatapb.CreateKeyspaceResponse, error) {
+	if req.Name == "" {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "the keyspace name is required"))
+	}
+	err := s.ts.CreateKeyspace(ctx, req.Name, &topodatapb.Keyspace{
+		ShardingColumnName: req.ShardingColumnName,
+		ShardingColumnType: req.ShardingColumnType,
+	})
+	if req.Force && topo.IsErrType(err, topo.NodeExists) {
+		err = nil
+	}
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	if !req.AllowEmptyVSchema {
+		if err := s.ts.EnsureVSchema(ctx, req.Name); err != nil {
+			return nil, toGRPC(err)
+		}
+	}
+	resp, err := s.GetKeyspace(ctx, &vtctldatapb.GetKeyspaceRequest{Keyspace: req.Name})
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.CreateKeyspaceResponse{Keyspace: resp.Keyspace}, nil
+}
+
+// DeleteKeyspace is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DeleteKeyspace(ctx context.Context, req *vtctldatapb.DeleteKeyspaceRequest) (*vtctldatapb.DeleteKeyspaceResponse, error) {
+	wr, _ := s.wrangler()
+	if err := wr.DeleteKeyspace(ctx, req.Keyspace, req.Recursive); err != nil {
+		return nil, toGRPC(err)
+	}
+	return &vtctldatapb.DeleteKeyspaceResponse{}, nil
+}
+
+// GetShard is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetShard(ctx context.Context, req *vtctldatapb.GetShardRequest) (*vtctldatapb.GetShardResponse, error) {
+	si, err := s.ts.GetShard(ctx, req.Keyspace, req.ShardName)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return &vtctldatapb.GetShardResponse{
+		Shard: &vtctldatapb.Shard{
+			Keyspace: si.Keyspace(),
+			Name:     si.ShardName(),
+			Shard:    si.Shard,
+		},
+	}, nil
+}
+
+// CreateShard is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) CreateShard(ctx context.Context, req *vtctldatapb.CreateShardRequest) (*vtctldatapb.CreateShardResponse, error) {
+	if req.IncludeParent {
+		if err := s.ts.CreateKeyspace(ctx, req.Keyspace, &topodatapb.Keyspace{}); err != nil && !topo.IsErrType(err, topo.NodeExists) {
+			return nil, toGRPC(err)
+		}
+	}
+	err := s.ts.CreateShard(ctx, req.Keyspace, req.ShardName)
+	if req.Force && topo.IsErrType(err, topo.NodeExists) {
+		err = nil
+	}
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	resp, err := s.GetShard(ctx, &vtctldatapb.GetShardRequest{Keyspace: req.Keyspace, ShardName: req.ShardName})
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.CreateShardResponse{Shard: resp.Shard}, nil
+}
+
+// DeleteShard is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DeleteShard(ctx context.Context, req *vtctldatapb.DeleteShardRequest) (*vtctldatapb.DeleteShardResponse, error) {
+	wr, _ := s.wrangler()
+	if err := wr.DeleteShard(ctx, req.Keyspace, req.ShardName, req.Recursive, req.EvenIfServing); err != nil {
+		return nil, toGRPC(err)
+	}
+	return &vtctldatapb.DeleteShardResponse{}, nil
+}
+
+// GetTablet is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetTablet(ctx context.Context, req *vtctldatapb.GetTabletRequest) (*vtctldatapb.GetTabletResponse, error) {
+	if req.TabletAlias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "the tablet alias is required"))
+	}
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return &vtctldatapb.GetTabletResponse{Tablet: ti.Tablet}, nil
+}
+
+// GetTablets is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetTablets(ctx context.Context, req *vtctldatapb.GetTabletsRequest) (*vtctldatapb.GetTabletsResponse, error) {
+	shards := []string{req.Shard}
+	if req.Shard == "" {
+		var err error
+		if shards, err = s.ts.GetShardNames(ctx, req.Keyspace); err != nil {
+			return nil, toGRPC(err)
+		}
+	}
+	var aliases []*topodatapb.TabletAlias
+	for _, shard := range shards {
+		shardAliases, err := s.ts.FindAllTabletAliasesInShardByCell(ctx, req.Keyspace, shard, req.Cells)
+		if err != nil {
+			return nil, toGRPC(err)
+		}
+		aliases = append(aliases, shardAliases...)
+	}
+	tabletMap, err := s.ts.GetTabletMap(ctx, aliases)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	resp := &vtctldatapb.GetTabletsResponse{}
+	for _, ti := range tabletMap {
+		resp.Tablets = append(resp.Tablets, ti.Tablet)
+	}
+	sort.Slice(resp.Tablets, func(i, j int) bool {
+		return topoproto.TabletAliasString(resp.Tablets[i].Alias) < topoproto.TabletAliasString(resp.Tablets[j].Alias)
+	})
+	return resp, nil
+}
+
+// ChangeTabletType is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ChangeTabletType(ctx context.Context, req *vtctldatapb.ChangeTabletTypeRequest) (*vtctldatapb.ChangeTabletTypeResponse, error) {
+	before, err := s.GetTablet(ctx, &vtctldatapb.GetTabletRequest{TabletAlias: req.TabletAlias})
+	if err != nil {
+		return nil, err
+	}
+	wr, _ := s.wrangler()
+	if err := wr.ChangeSlaveType(ctx, req.TabletAlias, req.DbType); err != nil {
+		return nil, toGRPC(err)
+	}
+	after, err := s.GetTablet(ctx, &vtctldatapb.GetTabletRequest{TabletAlias: req.TabletAlias})
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.ChangeTabletTypeResponse{
+		BeforeTablet: before.Tablet,
+		AfterTablet:  after.Tablet,
+	}, nil
+}
+
+// DeleteTablet is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DeleteTablet(ctx context.Context, req *vtctldatapb.DeleteTabletRequest) (*vtctldatapb.DeleteTabletResponse, error) {
+	if req.TabletAlias == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "the tablet alias is required"))
+	}
+	wr, _ := s.wrangler()
+	if err := wr.DeleteTablet(ctx, req.TabletAlias, req.AllowMaster); err != nil {
+		return nil, toGRPC(err)
+	}
+	return &vtctldatapb.DeleteTabletResponse{}, nil
+}
+
+// PlannedReparentShard is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) PlannedReparentShard(ctx context.Context, req *vtctldatapb.PlannedReparentShardRequest) (*vtctldatapb.PlannedReparentShardResponse, error) {
+	if *mysqlctl.DisableActiveReparents {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "active reparent commands disabled (unset the -disable_active_reparents flag to enable)"))
+	}
+	wr, logger := s.wrangler()
+	err := wr.PlannedReparentShard(ctx, req.Keyspace, req.Shard, req.NewMaster, req.AvoidMaster, waitReplicasTimeout(req.WaitReplicasTimeoutMs), nil /* checks */)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	master, err := s.shardMaster(ctx, req.Keyspace, req.Shard)
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.PlannedReparentShardResponse{
+		PromotedMaster: master,
+		Events:         logger.Events,
+	}, nil
+}
+
+// EmergencyReparentShard is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldatapb.EmergencyReparentShardRequest) (*vtctldatapb.EmergencyReparentShardResponse, error) {
+	if *mysqlctl.DisableActiveReparents {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "active reparent commands disabled (unset the -disable_active_reparents flag to enable)"))
+	}
+	if req.NewMaster == nil {
+		return nil, vterrors.ToGRPC(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "the new master is required"))
+	}
+	wr, logger := s.wrangler()
+	if err := wr.EmergencyReparentShard(ctx, req.Keyspace, req.Shard, req.NewMaster, false /* force */, waitReplicasTimeout(req.WaitReplicasTimeoutMs)); err != nil {
+		return nil, toGRPC(err)
+	}
+	master, err := s.shardMaster(ctx, req.Keyspace, req.Shard)
+	if err != nil {
+		return nil, err
+	}
+	return &vtctldatapb.EmergencyReparentShardResponse{
+		PromotedMaster: master,
+		Events:         logger.Events,
+	}, nil
+}
+
+// waitReplicasTimeout returns the timeout to wait for the replicas
+// during a reparent, *topo.RemoteOperationTimeout by default.
+func waitReplicasTimeout(ms int64) time.Duration {
+	if ms <= 0 {
+		return *topo.RemoteOperationTimeout
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+func (s *VtctldServer) shardMaster(ctx context.Context, keyspace, shard string) (*topodatapb.TabletAlias, error) {
+	si, err := s.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return si.MasterAlias, nil
+}
+
+// Backup is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) Backup(ctx context.Context, req *vtctldatapb.BackupRequest) (*vtctldatapb.BackupResponse, error) {
+	tablet, err := s.GetTablet(ctx, &vtctldatapb.GetTabletRequest{TabletAlias: req.TabletAlias})
+	if err != nil {
+		return nil, err
+	}
+	concurrency := int(req.Concurrency)
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+	stream, err := s.tmc.Backup(ctx, tablet.Tablet, concurrency, req.AllowMaster)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	resp := &vtctldatapb.BackupResponse{}
+	for {
+		e, err := stream.Recv()
+		switch err {
+		case nil:
+			resp.Events = append(resp.Events, e)
+		case io.EOF:
+			return resp, nil
+		default:
+			return nil, toGRPC(err)
+		}
+	}
+}
+
+// GetBackups is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetBackups(ctx context.Context, req *vtctldatapb.GetBackupsRequest) (*vtctldatapb.GetBackupsResponse, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, fmt.Sprintf("%v/%v", req.Keyspace, req.Shard))
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	resp := &vtctldatapb.GetBackupsResponse{}
+	for _, bh := range bhs {
+		resp.Names = append(resp.Names, bh.Name())
+	}
+	return resp, nil
+}
+
+// WorkflowAction is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) WorkflowAction(ctx context.Context, req *vtctldatapb.WorkflowActionRequest) (*vtctldatapb.WorkflowActionResponse, error) {
+	wr, _ := s.wrangler()
+	if err := wr.WorkflowAction(ctx, req.Keyspace, req.Workflow, req.Action); err != nil {
+		return nil, toGRPC(err)
+	}
+	return &vtctldatapb.WorkflowActionResponse{}, nil
+}
+
+// GetVSchema is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetVSchema(ctx context.Context, req *vtctldatapb.GetVSchemaRequest) (*vtctldatapb.GetVSchemaResponse, error) {
+	vs, err := s.ts.GetVSchema(ctx, req.Keyspace)
+	if err != nil {
+		return nil, toGRPC(err)
+	}
+	return &vtctldatapb.GetVSchemaResponse{VSchema: vs}, nil
+}
+
+// ApplyVSchema is part of the vtctlservicepb.VtctldServer interface. The
+// vschema is validated before it's saved.
+func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyVSchemaRequest) (*vtctldatapb.ApplyVSchemaResponse, error) {
+	if _, err := s.ts.GetKeyspace(ctx, req.Keyspace); err != nil {
+		return nil, toGRPC(err)
+	}
+	vs := req.VSchema
+	if vs == nil {
+		vs = &vschemapb.Keyspace{}
+	}
+	if _, err := vindexes.BuildKeyspaceSchema(vs, req.Keyspace); err != nil {
+		return nil, vterrors.ToGRPC(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid vschema: %v", err))
+	}
+	if req.DryRun {
+		return &vtctldatapb.ApplyVSchemaResponse{VSchema: vs}, nil
+	}
+	if err := s.ts.SaveVSchema(ctx, req.Keyspace, vs); err != nil {
+		return nil, toGRPC(err)
+	}
+	if !req.SkipRebuild {
+		if err := s.ts.RebuildSrvVSchema(ctx, req.Cells); err != nil {
+			return nil, toGRPC(err)
+		}
+	}
+	return &vtctldatapb.ApplyVSchemaResponse{VSchema: vs}, nil
+}
+
+// toGRPC returns the gRPC error of err, with the code of the topo
+// errors.
+func toGRPC(err error) error {
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		err = vterrors.New(vtrpcpb.Code_NOT_FOUND, err.Error())
+	case topo.IsErrType(err, topo.NodeExists):
+		err = vterrors.New(vtrpcpb.Code_ALREADY_EXISTS, err.Error())
+	}
+	return vterrors.ToGRPC(err)
+}
+
+// StartServer registers the VtctldServer for RPCs.
+func StartServer(s *grpc.Server, ts *topo.Server) {
+	vtctlservicepb.RegisterVtctldServer(s, NewVtctldServer(ts))
+}
@@ -72,8 +72,13 @@ func init() {
 	addCommand(queriesGroupName, command{
 		"VtGateSplitQuery",
 		commandVtGateSplitQuery,
-		"-server <vtgate> -keyspace <keyspace> [-split_column <split_column>] -split_count <split_count> [-bind_variables <JSON map>] <sql>",
+		"-server <vtgate> -keyspace <keyspace> [-split_column <split_column>] -split_count <split_count> [-bind_variables <JSON map>] [-cell <cell>] <sql>",
 		"Executes the SplitQuery computation for the given SQL query with the provided bound variables against the vtgate server (this is the base query for Map-Reduce workloads, and is provided here for debug / test purposes)."})
+	addCommand(queriesGroupName, command{
+		"VtGateSetRuntimeFlags",
+		commandVtGateSetRuntimeFlags,
+		"-server <vtgate> <flag1=value1> [<flag2=value2> ...]",
+		"Changes flags of a vtgate server without restarting it, like the memory row limits, the query sample rate and the MySQL query timeout. The values are validated, and the flags are changed all together or not at all. Requires the admin ACL. Displays the old and new values of the flags, and the vtgate logs the changes."})
 
 	// VtTablet commands
 	addCommand(queriesGroupName, command{
@@ -205,6 +210,32 @@ func commandVtGateExecute(ctx context.Context, wr *wrangler.Wrangler, subFlags *
 	return nil
 }
 
+func commandVtGateSetRuntimeFlags(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	server := subFlags.String("server", "", "VtGate server to connect to")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 1 {
+		return fmt.Errorf("at least one <flag=value> argument is required for the VtGateSetRuntimeFlags command")
+	}
+	flags, err := parseRuntimeFlags(subFlags.Args())
+	if err != nil {
+		return err
+	}
+
+	vtgateConn, err := vtgateconn.Dial(ctx, *server)
+	if err != nil {
+		return fmt.Errorf("error connecting to vtgate '%v': %v", *server, err)
+	}
+	defer vtgateConn.Close()
+
+	changes, err := vtgateConn.SetRuntimeFlags(ctx, flags)
+	if err != nil {
+		return fmt.Errorf("SetRuntimeFlags failed: %v", err)
+	}
+	return printJSON(wr.Logger(), changes)
+}
+
 func commandVtGateExecuteShards(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if !*enableQueries {
 		return fmt.Errorf("query commands are disabled (set the -enable_queries flag to enable)")
@@ -338,6 +369,7 @@ func commandVtGateSplitQuery(ctx context.Context, wr *wrangler.Wrangler, subFlag
 	algorithmStr := subFlags.String("algorithm", "EQUAL_SPLITS", "The algorithm to"+
 		" use for splitting the query. Either 'FULL_SCAN' or 'EQUAL_SPLITS'")
 	keyspace := subFlags.String("keyspace", "", "keyspace to send query to")
+	cell := subFlags.String("cell", "", "If set, the splits are computed by the rdonly tablets of this cell only")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -384,6 +416,7 @@ func commandVtGateSplitQuery(ctx context.Context, wr *wrangler.Wrangler, subFlag
 		int64(*splitCount),
 		int64(*numRowsPerQueryPart),
 		algorithm,
+		*cell,
 	)
 	if err != nil {
 		return fmt.Errorf("SplitQuery failed: %v", err)
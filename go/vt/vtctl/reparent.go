@@ -45,13 +45,13 @@ func init() {
 	addCommand("Shards", command{
 		"PlannedReparentShard",
 		commandPlannedReparentShard,
-		"-keyspace_shard=<keyspace/shard> [-new_master=<tablet alias>] [-avoid_master=<tablet alias>] [-wait_slave_timeout=<duration>]",
-		"Reparents the shard to the new master, or away from old master. Both old and new master need to be up and running."})
+		"-keyspace_shard=<keyspace/shard> [-new_master=<tablet alias>] [-avoid_master=<tablet alias>] [-wait_slave_timeout=<duration>] [-preflight_checks=<check1,check2,...>] [-max_replication_lag=<duration>] [-min_semi_sync_acks=<count>] [-force] [-dry_run]",
+		"Reparents the shard to the new master, or away from old master. Both old and new master need to be up and running. The -preflight_checks are run first, and the reparent is aborted if one of them fails, unless -force is set. With -dry_run, only prints the actions the reparent would take."})
 	addCommand("Shards", command{
 		"EmergencyReparentShard",
 		commandEmergencyReparentShard,
-		"-keyspace_shard=<keyspace/shard> -new_master=<tablet alias>",
-		"Reparents the shard to the new master. Assumes the old master is dead and not responsding."})
+		"-keyspace_shard=<keyspace/shard> -new_master=<tablet alias> [-force] [-dry_run]",
+		"Reparents the shard to the new master. Assumes the old master is dead and not responsding. The reparent is aborted if the tablets cannot satisfy the durability policy of the keyspace, unless -force is set. With -dry_run, only prints the actions the reparent would take."})
 	addCommand("Shards", command{
 		"TabletExternallyReparented",
 		commandTabletExternallyReparented,
@@ -111,6 +111,11 @@ func commandPlannedReparentShard(ctx context.Context, wr *wrangler.Wrangler, sub
 	keyspaceShard := subFlags.String("keyspace_shard", "", "keyspace/shard of the shard that needs to be reparented")
 	newMaster := subFlags.String("new_master", "", "alias of a tablet that should be the new master")
 	avoidMaster := subFlags.String("avoid_master", "", "alias of a tablet that should not be the master, i.e. reparent to any other tablet if this one is the master")
+	preflightChecks := subFlags.String("preflight_checks", "", "comma separated list of the checks to run before reparenting: replication_lag, semi_sync, schema_migrations, workflows")
+	maxReplicationLag := subFlags.Duration("max_replication_lag", 30*time.Second, "maximum replication lag of the new master, for the replication_lag check")
+	minSemiSyncAcks := subFlags.Int("min_semi_sync_acks", 1, "minimum number of replicas able to send semi-sync acks to the new master, for the semi_sync check")
+	force := subFlags.Bool("force", false, "reparent even if the pre-flight checks fail")
+	dryRun := subFlags.Bool("dry_run", false, "only print the actions the reparent would take")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -142,7 +147,19 @@ func commandPlannedReparentShard(ctx context.Context, wr *wrangler.Wrangler, sub
 			return err
 		}
 	}
-	return wr.PlannedReparentShard(ctx, keyspace, shard, newMasterAlias, avoidMasterAlias, *waitSlaveTimeout)
+	checks, err := wrangler.ParseReparentChecks(*preflightChecks, *maxReplicationLag, *minSemiSyncAcks, *force)
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		plan, err := wr.PlannedReparentShardDryRun(ctx, keyspace, shard, newMasterAlias, avoidMasterAlias, *waitSlaveTimeout, checks)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("%v", plan)
+		return nil
+	}
+	return wr.PlannedReparentShard(ctx, keyspace, shard, newMasterAlias, avoidMasterAlias, *waitSlaveTimeout, checks)
 }
 
 func commandEmergencyReparentShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -153,6 +170,8 @@ func commandEmergencyReparentShard(ctx context.Context, wr *wrangler.Wrangler, s
 	waitSlaveTimeout := subFlags.Duration("wait_slave_timeout", 30*time.Second, "time to wait for slaves to catch up in reparenting")
 	keyspaceShard := subFlags.String("keyspace_shard", "", "keyspace/shard of the shard that needs to be reparented")
 	newMaster := subFlags.String("new_master", "", "alias of a tablet that should be the new master")
+	force := subFlags.Bool("force", false, "reparent even if the tablets cannot satisfy the durability policy of the keyspace")
+	dryRun := subFlags.Bool("dry_run", false, "only print the actions the reparent would take")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -175,7 +194,15 @@ func commandEmergencyReparentShard(ctx context.Context, wr *wrangler.Wrangler, s
 	if err != nil {
 		return err
 	}
-	return wr.EmergencyReparentShard(ctx, keyspace, shard, tabletAlias, *waitSlaveTimeout)
+	if *dryRun {
+		plan, err := wr.EmergencyReparentShardDryRun(ctx, keyspace, shard, tabletAlias, *force, *waitSlaveTimeout)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("%v", plan)
+		return nil
+	}
+	return wr.EmergencyReparentShard(ctx, keyspace, shard, tabletAlias, *force, *waitSlaveTimeout)
 }
 
 func commandTabletExternallyReparented(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -29,6 +29,7 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/helpers"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -53,6 +54,18 @@ func init() {
 		commandTopoCp,
 		"[-cell <cell>] [-to_topo] <src> <dst>",
 		"Copies a file from topo to local file structure, or the other way around"})
+
+	addCommand(topoGroupName, command{
+		"ExportTopology",
+		commandExportTopology,
+		"[-include_tablets]",
+		"Displays a JSON snapshot of the topology: the cells, keyspaces, shards, vschemas, routing rules and serving graph. The tablets are included with -include_tablets."})
+
+	addCommand(topoGroupName, command{
+		"ImportTopology",
+		commandImportTopology,
+		"{-snapshot=<snapshot> || -snapshot_file=<snapshot file>} [-include_tablets]",
+		"Restores a snapshot of ExportTopology into a topology without any keyspace. The existing cells are kept. The tablets of the snapshot are restored with -include_tablets."})
 }
 
 // DecodeContent uses the filename to imply a type, and proto-decodes
@@ -150,6 +163,57 @@ func commandTopoCp(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fl
 	return copyFileFromTopo(ctx, wr.TopoServer(), *cell, from, to)
 }
 
+func commandExportTopology(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	includeTablets := subFlags.Bool("include_tablets", false, "also export the tablets")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("ExportTopology doesn't take any argument")
+	}
+	snapshot, err := helpers.ExportSnapshot(ctx, wr.TopoServer(), *includeTablets)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cannot marshal the snapshot: %v", err)
+	}
+	wr.Logger().Printf("%v\n", string(data))
+	return nil
+}
+
+func commandImportTopology(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	snapshotJSON := subFlags.String("snapshot", "", "the JSON snapshot")
+	snapshotFile := subFlags.String("snapshot_file", "", "the file of the JSON snapshot")
+	includeTablets := subFlags.Bool("include_tablets", false, "also import the tablets")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("ImportTopology doesn't take any argument")
+	}
+	if (*snapshotJSON == "") == (*snapshotFile == "") {
+		return fmt.Errorf("exactly one of the snapshot or snapshot_file flags must be specified for ImportTopology")
+	}
+	data := []byte(*snapshotJSON)
+	if *snapshotFile != "" {
+		var err error
+		if data, err = ioutil.ReadFile(*snapshotFile); err != nil {
+			return fmt.Errorf("cannot read the snapshot file %v: %v", *snapshotFile, err)
+		}
+	}
+	snapshot := &helpers.Snapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return fmt.Errorf("cannot parse the snapshot: %v", err)
+	}
+	if err := helpers.ImportSnapshot(ctx, wr.TopoServer(), snapshot, *includeTablets); err != nil {
+		return err
+	}
+	wr.Logger().Printf("Imported %v keyspaces, created at %v.\n", len(snapshot.Keyspaces), snapshot.CreateTime)
+	return nil
+}
+
 func copyFileFromTopo(ctx context.Context, ts *topo.Server, cell, from, to string) error {
 	conn, err := ts.ConnForCell(ctx, cell)
 	if err != nil {
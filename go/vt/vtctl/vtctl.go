@@ -117,13 +117,17 @@ import (
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/schemamanager"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -202,6 +206,27 @@ var commands = []commandGroup{
 			{"RunHealthCheck", commandRunHealthCheck,
 				"<tablet alias>",
 				"Runs a health check on a remote tablet."},
+			{"GetPools", commandGetPools,
+				"<tablet alias>",
+				"Displays the settings and usage of the conn, stream and transaction pools of a tablet."},
+			{"ResizePool", commandResizePool,
+				"[-capacity <n>] [-idle_timeout <duration>] [-prefill_parallelism <n>] <tablet alias> <conn|stream|transaction>",
+				"Changes the settings of a connection pool of a tablet at runtime. Only the given settings are changed. The capacity can't exceed the max capacity of the pool, and the prefill parallelism is used the next time the pool is opened."},
+			{"GetLockDiagnostics", commandGetLockDiagnostics,
+				"[-last_automatic] <tablet alias>",
+				"Displays the lock waits of the MySQL server of a tablet, with the fingerprints of the waiting and blocking queries, and the latest deadlock. With -last_automatic, displays the last report captured when the lock errors spiked instead."},
+			{"GetQueryPlanStats", commandGetQueryPlanStats,
+				"[-sort_by=<time|count|errors|p99>] [-limit=<n>] <tablet alias>",
+				"Displays the query counts, row counts, error counts and p50/p95/p99 latencies of the queries of the plan cache of a tablet, with their fingerprints. The queries are sorted by decreasing total time by default."},
+			{"GetQueryBlocklist", commandGetQueryBlocklist,
+				"<tablet alias>",
+				"Displays the query fingerprints blocked by the query blocklist of a tablet, and the ones pending approval, with the load that got them detected."},
+			{"UpdateQueryBlocklist", commandUpdateQueryBlocklist,
+				"[-reason <reason>] <tablet alias> <approve|block|remove> <fingerprint>",
+				"Approves a pending query fingerprint, blocks a fingerprint, or removes a fingerprint from the query blocklist of a tablet. The fingerprints blocked this way don't expire. Displays the entries of the blocklist."},
+			{"SetRuntimeFlags", commandSetRuntimeFlags,
+				"<tablet alias> <flag1=value1> [<flag2=value2> ...]",
+				"Changes flags of a tablet without restarting it, like the pool sizes, the timeouts, the transaction throttler configuration and the table ACL file. The values are validated, and the flags are changed all together or not at all. Displays the old and new values of the flags, and the tablet logs the changes."},
 			{"IgnoreHealthError", commandIgnoreHealthError,
 				"<tablet alias> <ignore regexp>",
 				"Sets the regexp for health check errors to ignore on the specified tablet. The pattern has implicit ^$ anchors. Set to empty string or restart vttablet to stop ignoring anything."},
@@ -281,11 +306,14 @@ var commands = []commandGroup{
 	{
 		"Keyspaces", []command{
 			{"CreateKeyspace", commandCreateKeyspace,
-				"[-sharding_column_name=name] [-sharding_column_type=type] [-served_from=tablettype1:ks1,tablettype2:ks2,...] [-force] [-keyspace_type=type] [-base_keyspace=base_keyspace] [-snapshot_time=time] <keyspace name>",
-				"Creates the specified keyspace. keyspace_type can be NORMAL or SNAPSHOT. For a SNAPSHOT keyspace you must specify the name of a base_keyspace, and a snapshot_time in UTC, in RFC3339 time format, e.g. 2006-01-02T15:04:05+00:00"},
+				"[-sharding_column_name=name] [-sharding_column_type=type] [-served_from=tablettype1:ks1,tablettype2:ks2,...] [-force] [-keyspace_type=type] [-base_keyspace=base_keyspace] [-snapshot_time=time] [-durability_policy=policy] <keyspace name>",
+				"Creates the specified keyspace. keyspace_type can be NORMAL or SNAPSHOT. For a SNAPSHOT keyspace you must specify the name of a base_keyspace, and a snapshot_time in UTC, in RFC3339 time format, e.g. 2006-01-02T15:04:05+00:00. See SetKeyspaceDurabilityPolicy for the durability policies."},
 			{"DeleteKeyspace", commandDeleteKeyspace,
 				"[-recursive] <keyspace>",
 				"Deletes the specified keyspace. In recursive mode, it also recursively deletes all shards in the keyspace. Otherwise, there must be no shards left in the keyspace."},
+			{"TeardownKeyspace", commandTeardownKeyspace,
+				"[-dry_run] [-skip_backup] [-concurrency=4] [-wait_time=5m] <keyspace>",
+				"Entirely removes a keyspace that is not in use anymore. It fails if routing rules, served_from records, vschemas or vreplication streams still reference the keyspace, or if its tablets are still serving queries. Otherwise, it takes a final backup of the keyspace, removes it from the vschema, disables the query service of its tablets, and deletes its shards, tablets, serving graph and keyspace records. The vttablet and mysqld processes are not stopped."},
 			{"RemoveKeyspaceCell", commandRemoveKeyspaceCell,
 				"[-force] [-recursive] <keyspace> <cell>",
 				"Removes the cell from the Cells list for all shards in the keyspace, and the SrvKeyspace for that keyspace in that cell."},
@@ -298,36 +326,57 @@ var commands = []commandGroup{
 			{"SetKeyspaceShardingInfo", commandSetKeyspaceShardingInfo,
 				"[-force] <keyspace name> [<column name>] [<column type>]",
 				"Updates the sharding information for a keyspace."},
+			{"SetKeyspaceDurabilityPolicy", commandSetKeyspaceDurabilityPolicy,
+				"<keyspace name> <policy>",
+				"Sets the durability policy of a keyspace, which controls semi-sync on its tablets and is checked by the reparent commands and ChangeSlaveType. The policy is one of none, semi_sync (a REPLICA has to ack) or cross_cell (a REPLICA in another cell has to ack), optionally followed by :<number of acks>, e.g. cross_cell:2. An empty policy uses the -enable_semi_sync flag of the tablets. The tablets apply the new policy the next time they change type or are reparented."},
 			{"SetKeyspaceServedFrom", commandSetKeyspaceServedFrom,
 				"[-source=<source keyspace name>] [-remove] [-cells=c1,c2,...] <keyspace name> <tablet type>",
 				"Changes the ServedFromMap manually. This command is intended for emergency fixes. This field is automatically set when you call the *MigrateServedFrom* command. This command does not rebuild the serving graph."},
 			{"RebuildKeyspaceGraph", commandRebuildKeyspaceGraph,
 				"[-cells=c1,c2,...] <keyspace> ...",
 				"Rebuilds the serving data for the keyspace. This command may trigger an update to all connected clients."},
+			{"SetSrvKeyspacePartition", commandSetSrvKeyspacePartition,
+				"[-cells=c1,c2,...] [-dry_run] <keyspace> <tablet type> <shard>,<shard>,...",
+				"Sets the shards serving a tablet type in the SrvKeyspace of the cells, for instance to fix the serving graph during a migration. The shards must serve each keyspace id exactly once, without gaps or overlaps, or no cell is changed. This command does not change the shard records, so the next RebuildKeyspaceGraph overwrites its change. The cells are updated one at a time, and the cells updated before a failure are restored."},
+			{"ShowSrvKeyspacePartitions", commandShowSrvKeyspacePartitions,
+				"[-cells=c1,c2,...] <keyspace>",
+				"Outputs the shards serving each tablet type in the SrvKeyspace of each cell, with the shards aligned by key range, and the problems of each partition."},
+			{"ValidateSrvKeyspacePartitions", commandValidateSrvKeyspacePartitions,
+				"[-cells=c1,c2,...] <keyspace>",
+				"Validates that the shards serving each tablet type in the SrvKeyspace of each cell serve each keyspace id exactly once, and exist with the same key ranges."},
 			{"ValidateKeyspace", commandValidateKeyspace,
 				"[-ping-tablets] <keyspace name>",
 				"Validates that all nodes reachable from the specified keyspace are consistent."},
+			{"SuggestShardSplit", commandSuggestShardSplit,
+				"-table=<table> [-shards=2] [-sample_size=10000] <keyspace/shard>",
+				"Suggests how to split a shard into shards with about the same number of rows, by sampling the rows of a table and mapping them through its primary vindex. Example: SuggestShardSplit -table=customer ks/0"},
 			{"SplitClone", commandSplitClone,
 				"<keyspace> <from_shards> <to_shards>",
 				"Start the SplitClone process to perform horizontal resharding. Example: SplitClone ks '0' '-80,80-'"},
 			{"VerticalSplitClone", commandVerticalSplitClone,
 				"<from_keyspace> <to_keyspace> <tables>",
 				"Start the VerticalSplitClone process to perform vertical resharding. Example: SplitClone from_ks to_ks 'a,/b.*/'"},
+			{"MoveTables", commandMoveTables,
+				"<source keyspace> <target keyspace.workflow> <tables>",
+				"Start a workflow moving the comma-separated list of tables from the source keyspace to the target keyspace. The tables keep being served from the source keyspace until they are migrated with MigrateReads and MigrateWrites. Example: MoveTables commerce customer.commerce2customer 'customer,corder'"},
+			{"Workflow", commandWorkflow,
+				"<keyspace.workflow> <action>",
+				"Shows or controls a vreplication workflow. The action is one of: show (per-stream state and lag, per-table copy progress), stop, start, retry (restart the streams stopped on an error) or delete."},
 			{"VDiff", commandVDiff,
-				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
+				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] [-max_rows_per_second=0] <keyspace.workflow>",
 				"Perform a diff of all tables in the workflow"},
 			{"MigrateServedTypes", commandMigrateServedTypes,
-				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] <keyspace/shard> <served tablet type>",
-				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
+				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] [-dry_run] <keyspace/shard> <served tablet type>",
+				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration. With -dry_run, only prints the actions it would take."},
 			{"MigrateServedFrom", commandMigrateServedFrom,
 				"[-cells=c1,c2,...] [-reverse] <destination keyspace/shard> <served tablet type>",
 				"Makes the <destination keyspace/shard> serve the given type. This command also rebuilds the serving graph."},
 			{"MigrateReads", commandMigrateReads,
-				"[-cells=c1,c2,...] [-reverse] -tablet_type={replica|rdonly} <keyspace.workflow>",
-				"Migrate read traffic for the specified workflow."},
+				"[-cells=c1,c2,...] [-reverse] [-dry_run] -tablet_type={replica|rdonly} <keyspace.workflow>",
+				"Migrate read traffic for the specified workflow. With -dry_run, only prints the actions it would take."},
 			{"MigrateWrites", commandMigrateWrites,
-				"[-filtered_replication_wait_time=30s] [-cancel] [-reverse_replication=false] <keyspace.workflow>",
-				"Migrate write traffic for the specified workflow."},
+				"[-filtered_replication_wait_time=30s] [-cancel] [-reverse_replication=false] [-dry_run] <keyspace.workflow>",
+				"Migrate write traffic for the specified workflow. With -dry_run, only prints the actions it would take."},
 			{"CancelResharding", commandCancelResharding,
 				"<keyspace/shard>",
 				"Permanently cancels a resharding in progress. All resharding related metadata will be deleted."},
@@ -377,12 +426,15 @@ var commands = []commandGroup{
 			{"ValidateSchemaShard", commandValidateSchemaShard,
 				"[-exclude_tables=''] [-include-views] <keyspace/shard>",
 				"Validates that the master schema matches all of the slaves."},
+			{"ShowSchemaDrift", commandShowSchemaDrift,
+				"[-exclude_tables=''] [-include-views] <keyspace/shard>",
+				"Shows the differences between the schema of the master and the schema of every other tablet of the shard. Unlike ValidateSchemaShard, the tablets that can't be reached don't prevent the others from being compared."},
 			{"ValidateSchemaKeyspace", commandValidateSchemaKeyspace,
 				"[-exclude_tables=''] [-include-views] <keyspace name>",
 				"Validates that the master schema from shard 0 matches the schema on all of the other tablets in the keyspace."},
 			{"ApplySchema", commandApplySchema,
-				"[-allow_long_unavailability] [-wait_slave_timeout=10s] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to slaves via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected."},
+				"[-allow_long_unavailability] [-table_gc] [-wait_slave_timeout=10s] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
+				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to slaves via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. If -table_gc is set, the dropped tables are renamed into the table GC lifecycle instead, and the tablets purge and drop them in the background."},
 			{"CopySchemaShard", commandCopySchemaShard,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-wait_slave_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
@@ -407,6 +459,15 @@ var commands = []commandGroup{
 			{"GetVSchema", commandGetVSchema,
 				"<keyspace>",
 				"Displays the VTGate routing schema."},
+			{"ValidateVSchema", commandValidateVSchema,
+				"<keyspace>",
+				"Validates the VTGate routing schema of a keyspace against its schema: every table of a sharded keyspace has a primary vindex, the tables of the lookup vindexes and the sequence tables exist, and the types of the vindex columns are compatible with their vindexes."},
+			{"ProvisionSequences", commandProvisionSequences,
+				"[-sequence_keyspace=<keyspace>] [-cache=1000] <keyspace>",
+				"Creates the sequence tables of the auto-increment columns of the VTGate routing schema of a keyspace, initializes them above the maximum values of the columns, and adds them to the routing schema of their keyspace. Unqualified sequences that are not in any routing schema are created in the unsharded -sequence_keyspace."},
+			{"RepairLookupVindex", commandRepairLookupVindex,
+				"[-chunk_size=1000] [-concurrency=4] [-max_tps=0] [-recheck_delay=5s] [-dry_run] <keyspace> <vindex>",
+				"Scans the table of an owned lookup vindex and its owner table, deletes the lookup rows that have no owner row, and inserts the missing lookup rows. The fixes of a chunk are applied in one statement per shard, at most -max_tps per second if it is positive. An orphaned lookup row is checked again after -recheck_delay before it's deleted, as the lookup rows of an in-flight transaction are written first."},
 			{"ApplyVSchema", commandApplyVSchema,
 				"{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run] <keyspace>",
 				"Applies the VTGate routing schema to the provided keyspace. Shows the result after application."},
@@ -416,6 +477,27 @@ var commands = []commandGroup{
 			{"ApplyRoutingRules", commandApplyRoutingRules,
 				"{-rules=<rules> || -rules_file=<rules_file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run]",
 				"Applies the VSchema routing rules."},
+			{"SetRoutingRule", commandSetRoutingRule,
+				"[-tablet_types=<tablet types>] [-cells=c1,c2,...] [-skip_rebuild] <from table> [<to table>,...]",
+				"Routes the queries of a table to other tables, for the provided tablet types or for all of them. The tables can be qualified by their keyspace, like ks.t. Without target, the table is disabled. The rules are validated against the VSchemas."},
+			{"DeleteRoutingRule", commandDeleteRoutingRule,
+				"[-tablet_types=<tablet types>] [-cells=c1,c2,...] [-skip_rebuild] <from table>",
+				"Deletes the routing rules of a table, for the provided tablet types or for all of them."},
+			{"GetTableACL", commandGetTableACL,
+				"<keyspace>",
+				"Displays the table ACL of a keyspace."},
+			{"ApplyTableACL", commandApplyTableACL,
+				"{-acl=<acl> || -acl_file=<acl file>} <keyspace>",
+				"Applies the table ACL of a keyspace. The vttablets started with -table-acl-config-keyspace reload it when it changes."},
+			{"GetQuota", commandGetQuota,
+				"<keyspace>",
+				"Displays the query quotas of a keyspace."},
+			{"SetQuota", commandSetQuota,
+				"{-quota=<quota> || -quota_file=<quota file>} [-skip_rebuild] [-cells=c1,c2,...] <keyspace>",
+				"Sets the query quotas of a keyspace, as a JSON quota.Config object, and rebuilds the SrvVSchema. The vtgates started with -enable_quotas enforce them."},
+			{"DeleteQuota", commandDeleteQuota,
+				"[-skip_rebuild] [-cells=c1,c2,...] <keyspace>",
+				"Deletes the query quotas of a keyspace, and rebuilds the SrvVSchema."},
 			{"RebuildVSchemaGraph", commandRebuildVSchemaGraph,
 				"[-cells=c1,c2,...]",
 				"Rebuilds the cell-specific SrvVSchema from the global VSchema objects in the provided cells (or all cells if none provided)."},
@@ -935,6 +1017,207 @@ func commandRefreshState(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	return wr.TabletManagerClient().RefreshState(ctx, tabletInfo.Tablet)
 }
 
+func commandGetPools(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetPools command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	pools, err := wr.TabletManagerClient().GetPools(ctx, tabletInfo.Tablet)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), &tabletmanagerdatapb.GetPoolsResponse{Pools: pools})
+}
+
+func commandResizePool(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	capacity := subFlags.Int("capacity", 0, "The new capacity of the pool")
+	idleTimeout := subFlags.Duration("idle_timeout", 0, "The new idle timeout of the pool connections")
+	prefillParallelism := subFlags.Int("prefill_parallelism", 0, "How many connections are opened in parallel to fill the pool the next time it is opened")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <tablet alias> and <pool name> arguments are required for the ResizePool command")
+	}
+	if *capacity <= 0 && *idleTimeout <= 0 && *prefillParallelism <= 0 {
+		return fmt.Errorf("at least one of -capacity, -idle_timeout and -prefill_parallelism must be set for the ResizePool command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	pool, err := wr.TabletManagerClient().ResizePool(ctx, tabletInfo.Tablet, subFlags.Arg(1), *capacity, *idleTimeout, *prefillParallelism)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), pool)
+}
+
+func commandGetLockDiagnostics(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	lastAutomatic := subFlags.Bool("last_automatic", false, "Displays the last report captured automatically instead of capturing a new one")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetLockDiagnostics command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	diagnostics, err := wr.TabletManagerClient().GetLockDiagnostics(ctx, tabletInfo.Tablet, *lastAutomatic)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), diagnostics)
+}
+
+func commandGetQueryPlanStats(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	sortBy := subFlags.String("sort_by", "time", "Sorts the queries by decreasing total time, query count, error count or p99 latency")
+	limit := subFlags.Int("limit", 0, "Displays only this number of queries. 0 displays all of them")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetQueryPlanStats command")
+	}
+	var key func(*tabletmanagerdatapb.QueryPlanStats) int64
+	switch *sortBy {
+	case "time":
+		key = func(qps *tabletmanagerdatapb.QueryPlanStats) int64 { return qps.TimeNs }
+	case "count":
+		key = func(qps *tabletmanagerdatapb.QueryPlanStats) int64 { return qps.QueryCount }
+	case "errors":
+		key = func(qps *tabletmanagerdatapb.QueryPlanStats) int64 { return qps.ErrorCount }
+	case "p99":
+		key = func(qps *tabletmanagerdatapb.QueryPlanStats) int64 { return qps.P99LatencyNs }
+	default:
+		return fmt.Errorf("invalid -sort_by %v, it must be time, count, errors or p99", *sortBy)
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	stats, err := wr.TabletManagerClient().GetQueryPlanStats(ctx, tabletInfo.Tablet)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		return key(stats[i]) > key(stats[j])
+	})
+	if *limit > 0 && len(stats) > *limit {
+		stats = stats[:*limit]
+	}
+	return printJSON(wr.Logger(), stats)
+}
+
+func commandGetQueryBlocklist(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetQueryBlocklist command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	entries, err := wr.TabletManagerClient().GetQueryBlocklist(ctx, tabletInfo.Tablet)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), entries)
+}
+
+func commandUpdateQueryBlocklist(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	reason := subFlags.String("reason", "", "The reason a fingerprint is blocked, displayed in the errors of its queries")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 3 {
+		return fmt.Errorf("the <tablet alias>, <action> and <fingerprint> arguments are required for the UpdateQueryBlocklist command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	entries, err := wr.TabletManagerClient().UpdateQueryBlocklist(ctx, tabletInfo.Tablet, subFlags.Arg(1), subFlags.Arg(2), *reason)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), entries)
+}
+
+func commandSetRuntimeFlags(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 2 {
+		return fmt.Errorf("the <tablet alias> and at least one <flag=value> arguments are required for the SetRuntimeFlags command")
+	}
+	flags, err := parseRuntimeFlags(subFlags.Args()[1:])
+	if err != nil {
+		return err
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	changes, err := wr.TabletManagerClient().SetRuntimeFlags(ctx, tabletInfo.Tablet, flags)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), changes)
+}
+
+// parseRuntimeFlags parses the <flag=value> arguments of the
+// SetRuntimeFlags commands.
+func parseRuntimeFlags(args []string) (map[string]string, error) {
+	flags := make(map[string]string)
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid flag %q, it must be <flag=value>", arg)
+		}
+		flags[parts[0]] = parts[1]
+	}
+	return flags, nil
+}
+
 func commandRefreshStateByShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	cellsStr := subFlags.String("cells", "", "Specifies a comma-separated list of cells whose tablets are included. If empty, all cells are considered.")
 	if err := subFlags.Parse(args); err != nil {
@@ -1538,6 +1821,7 @@ func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	keyspaceType := subFlags.String("keyspace_type", "", "Specifies the type of the keyspace")
 	baseKeyspace := subFlags.String("base_keyspace", "", "Specifies the base keyspace for a snapshot keyspace")
 	timestampStr := subFlags.String("snapshot_time", "", "Specifies the snapshot time for this keyspace")
+	durabilityPolicy := subFlags.String("durability_policy", "", "Specifies the durability policy of the keyspace")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1578,12 +1862,16 @@ func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 		}
 		snapshotTime = logutil.TimeToProto(timeTime)
 	}
+	if _, err := topotools.ParseDurabilityPolicy(*durabilityPolicy); err != nil {
+		return err
+	}
 	ki := &topodatapb.Keyspace{
 		ShardingColumnName: *shardingColumnName,
 		ShardingColumnType: kit,
 		KeyspaceType:       ktype,
 		BaseKeyspace:       *baseKeyspace,
 		SnapshotTime:       snapshotTime,
+		DurabilityPolicy:   *durabilityPolicy,
 	}
 	if len(servedFrom) > 0 {
 		for name, value := range servedFrom {
@@ -1651,6 +1939,26 @@ func commandDeleteKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	return wr.DeleteKeyspace(ctx, subFlags.Arg(0), *recursive)
 }
 
+func commandTeardownKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	dryRun := subFlags.Bool("dry_run", false, "Only checks that the keyspace is not in use anymore")
+	skipBackup := subFlags.Bool("skip_backup", false, "Does not take a final backup of the keyspace")
+	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously on each tablet for the final backup")
+	waitTime := subFlags.Duration("wait_time", 5*time.Minute, "Specifies the maximum time to wait for a tablet to catch up with the recorded position of its master for the final backup")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("must specify the <keyspace> argument for TeardownKeyspace")
+	}
+
+	return wr.TeardownKeyspace(ctx, subFlags.Arg(0), &wrangler.TeardownKeyspaceOptions{
+		DryRun:            *dryRun,
+		SkipBackup:        *skipBackup,
+		BackupConcurrency: *concurrency,
+		BackupWaitTime:    *waitTime,
+	})
+}
+
 func commandRemoveKeyspaceCell(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	force := subFlags.Bool("force", false, "Proceeds even if the cell's topology server cannot be reached. The assumption is that you turned down the entire cell, and just need to update the global topo data.")
 	recursive := subFlags.Bool("recursive", false, "Also delete all tablets in that cell belonging to the specified keyspace.")
@@ -1722,6 +2030,16 @@ func commandSetKeyspaceShardingInfo(ctx context.Context, wr *wrangler.Wrangler,
 	return wr.SetKeyspaceShardingInfo(ctx, keyspace, columnName, kit, *force)
 }
 
+func commandSetKeyspaceDurabilityPolicy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace name> and <policy> arguments are required for the SetKeyspaceDurabilityPolicy command")
+	}
+	return wr.SetKeyspaceDurabilityPolicy(ctx, subFlags.Arg(0), subFlags.Arg(1))
+}
+
 func commandSetKeyspaceServedFrom(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	source := subFlags.String("source", "", "Specifies the source keyspace name")
 	remove := subFlags.Bool("remove", false, "Indicates whether to add (default) or remove the served from record")
@@ -1771,6 +2089,61 @@ func commandRebuildKeyspaceGraph(ctx context.Context, wr *wrangler.Wrangler, sub
 	return nil
 }
 
+func commandSetSrvKeyspacePartition(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cells := subFlags.String("cells", "", "Specifies a comma-separated list of cells to update")
+	dryRun := subFlags.Bool("dry_run", false, "Only checks and prints the new partitions")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 3 {
+		return fmt.Errorf("the <keyspace>, <tablet type> and <shards> arguments are required for the SetSrvKeyspacePartition command")
+	}
+	tabletType, err := parseServingTabletType3(subFlags.Arg(1))
+	if err != nil {
+		return err
+	}
+	var cellArray []string
+	if *cells != "" {
+		cellArray = strings.Split(*cells, ",")
+	}
+	return wr.SetSrvKeyspacePartition(ctx, subFlags.Arg(0), tabletType, strings.Split(subFlags.Arg(2), ","), cellArray, *dryRun)
+}
+
+func commandShowSrvKeyspacePartitions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cells := subFlags.String("cells", "", "Specifies a comma-separated list of cells to show")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ShowSrvKeyspacePartitions command")
+	}
+	var cellArray []string
+	if *cells != "" {
+		cellArray = strings.Split(*cells, ",")
+	}
+	views, err := topotools.GetPartitionViews(ctx, wr.TopoServer(), subFlags.Arg(0), cellArray)
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("%v", topotools.FormatPartitionViews(views))
+	return nil
+}
+
+func commandValidateSrvKeyspacePartitions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cells := subFlags.String("cells", "", "Specifies a comma-separated list of cells to validate")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ValidateSrvKeyspacePartitions command")
+	}
+	var cellArray []string
+	if *cells != "" {
+		cellArray = strings.Split(*cells, ",")
+	}
+	return wr.ValidateSrvKeyspacePartitions(ctx, subFlags.Arg(0), cellArray)
+}
+
 func commandValidateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	pingTablets := subFlags.Bool("ping-tablets", false, "Specifies whether all tablets will be pinged during the validation process")
 	if err := subFlags.Parse(args); err != nil {
@@ -1784,6 +2157,31 @@ func commandValidateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlag
 	return wr.ValidateKeyspace(ctx, keyspace, *pingTablets)
 }
 
+func commandSuggestShardSplit(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	table := subFlags.String("table", "", "Table whose rows are sampled, its primary vindex must be a unique functional vindex")
+	shards := subFlags.Int("shards", 2, "Number of shards to split the shard into")
+	sampleSize := subFlags.Int("sample_size", 10000, "Approximate number of rows to sample")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace/shard> argument is required for the SuggestShardSplit command")
+	}
+	if *table == "" {
+		return fmt.Errorf("the -table flag is required for the SuggestShardSplit command")
+	}
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	names, err := wr.SuggestSplitPoints(ctx, keyspace, shard, *table, *shards, *sampleSize)
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("%v\n", strings.Join(names, ","))
+	return nil
+}
+
 func commandSplitClone(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -1815,6 +2213,7 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	targetCell := subFlags.String("target_cell", "", "The target cell to compare with")
 	tabletTypes := subFlags.String("tablet_types", "", "Tablet types for source and target")
 	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "Specifies the maximum time to wait, in seconds, for filtered replication to catch up on master migrations. The migration will be aborted on timeout.")
+	maxRowsPerSecond := subFlags.Int64("max_rows_per_second", 0, "Throttles the comparison of each table to this many rows per second, to limit the load on the tablets. 0 means no throttling.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1827,9 +2226,49 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 		return err
 	}
 
-	_, err = wr.VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime,
-		*HealthCheckTopologyRefresh, *HealthcheckRetryDelay, *HealthCheckTimeout)
-	return err
+	diffReports, err := wr.VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime,
+		*HealthCheckTopologyRefresh, *HealthcheckRetryDelay, *HealthCheckTimeout, *maxRowsPerSecond)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), diffReports)
+}
+
+func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 3 {
+		return fmt.Errorf("three arguments are required: source_keyspace, target_keyspace.workflow, tables")
+	}
+	source := subFlags.Arg(0)
+	target, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(1))
+	if err != nil {
+		return err
+	}
+	return wr.MoveTables(ctx, workflow, source, target, subFlags.Arg(2))
+}
+
+func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace.workflow> and <action> arguments are required for the Workflow command")
+	}
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	action := strings.ToLower(subFlags.Arg(1))
+	if action != "show" {
+		return wr.WorkflowAction(ctx, keyspace, workflow, action)
+	}
+	ws, err := wr.ShowWorkflow(ctx, keyspace, workflow)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), ws)
 }
 
 func splitKeyspaceWorkflow(in string) (keyspace, workflow string, err error) {
@@ -1846,6 +2285,7 @@ func commandMigrateServedTypes(ctx context.Context, wr *wrangler.Wrangler, subFl
 	skipReFreshState := subFlags.Bool("skip-refresh-state", false, "Skips refreshing the state of the source tablets after the migration, meaning that the refresh will need to be done manually, replica and rdonly only)")
 	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "Specifies the maximum time to wait, in seconds, for filtered replication to catch up on master migrations. The migration will be aborted on timeout.")
 	reverseReplication := subFlags.Bool("reverse_replication", false, "For master migration, enabling this flag reverses replication which allows you to rollback")
+	dryRun := subFlags.Bool("dry_run", false, "Only prints the actions the migration would take")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1868,6 +2308,14 @@ func commandMigrateServedTypes(ctx context.Context, wr *wrangler.Wrangler, subFl
 	if *cellsStr != "" {
 		cells = strings.Split(*cellsStr, ",")
 	}
+	if *dryRun {
+		plan, err := wr.MigrateServedTypesDryRun(ctx, keyspace, shard, cells, servedType, *reverse, *skipReFreshState, *filteredReplicationWaitTime, *reverseReplication)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("%v", plan)
+		return nil
+	}
 	return wr.MigrateServedTypes(ctx, keyspace, shard, cells, servedType, *reverse, *skipReFreshState, *filteredReplicationWaitTime, *reverseReplication)
 }
 
@@ -1901,6 +2349,7 @@ func commandMigrateReads(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	reverse := subFlags.Bool("reverse", false, "Moves the served tablet type backward instead of forward.")
 	cellsStr := subFlags.String("cells", "", "Specifies a comma-separated list of cells to update")
 	tabletType := subFlags.String("tablet_type", "", "Tablet type (replica or rdonly)")
+	dryRun := subFlags.Bool("dry_run", false, "Only prints the actions the migration would take")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1928,6 +2377,14 @@ func commandMigrateReads(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		return err
 	}
 
+	if *dryRun {
+		plan, err := wr.MigrateReadsDryRun(ctx, keyspace, workflow, servedType, cells, direction)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("%v", plan)
+		return nil
+	}
 	return wr.MigrateReads(ctx, keyspace, workflow, servedType, cells, direction)
 }
 
@@ -1935,6 +2392,7 @@ func commandMigrateWrites(ctx context.Context, wr *wrangler.Wrangler, subFlags *
 	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "Specifies the maximum time to wait, in seconds, for filtered replication to catch up on master migrations. The migration will be aborted on timeout.")
 	reverseReplication := subFlags.Bool("reverse_replication", true, "Also reverse the replication")
 	cancelMigrate := subFlags.Bool("cancel", false, "Cancel the failed migration and serve from source")
+	dryRun := subFlags.Bool("dry_run", false, "Only prints the actions the migration would take")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1947,6 +2405,14 @@ func commandMigrateWrites(ctx context.Context, wr *wrangler.Wrangler, subFlags *
 		return err
 	}
 
+	if *dryRun {
+		plan, err := wr.MigrateWritesDryRun(ctx, keyspace, workflow, *filteredReplicationWaitTime, *cancelMigrate, *reverseReplication)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("%v", plan)
+		return nil
+	}
 	journalID, err := wr.MigrateWrites(ctx, keyspace, workflow, *filteredReplicationWaitTime, *cancelMigrate, *reverseReplication)
 	if err != nil {
 		return err
@@ -2160,6 +2626,40 @@ func commandValidateSchemaShard(ctx context.Context, wr *wrangler.Wrangler, subF
 	return wr.ValidateSchemaShard(ctx, keyspace, shard, excludeTableArray, *includeViews)
 }
 
+func commandShowSchemaDrift(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
+	includeViews := subFlags.Bool("include-views", false, "Includes views in the comparison")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace/shard> argument is required for the ShowSchemaDrift command")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	var excludeTableArray []string
+	if *excludeTables != "" {
+		excludeTableArray = strings.Split(*excludeTables, ",")
+	}
+	masterAlias, drifts, err := wr.SchemaDriftShard(ctx, keyspace, shard, excludeTableArray, *includeViews)
+	if masterAlias == nil {
+		return err
+	}
+	if len(drifts) == 0 {
+		wr.Logger().Printf("No schema drift from master %v in shard %v/%v\n", topoproto.TabletAliasString(masterAlias), keyspace, shard)
+	}
+	for _, drift := range drifts {
+		wr.Logger().Printf("Schema of %v differs from master %v:\n", topoproto.TabletAliasString(drift.Alias), topoproto.TabletAliasString(masterAlias))
+		for _, diff := range drift.Diffs {
+			wr.Logger().Printf("%v\n", diff)
+		}
+	}
+	return err
+}
+
 func commandValidateSchemaKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	excludeTables := subFlags.String("exclude_tables", "", "Specifies a comma-separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
 	includeViews := subFlags.Bool("include-views", false, "Includes views in the validation")
@@ -2178,8 +2678,56 @@ func commandValidateSchemaKeyspace(ctx context.Context, wr *wrangler.Wrangler, s
 	return wr.ValidateSchemaKeyspace(ctx, keyspace, excludeTableArray, *includeViews)
 }
 
+func commandValidateVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ValidateVSchema command")
+	}
+	return wr.ValidateVSchema(ctx, subFlags.Arg(0))
+}
+
+func commandProvisionSequences(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	sequenceKeyspace := subFlags.String("sequence_keyspace", "", "The unsharded keyspace of the unqualified sequences that are not in any routing schema")
+	cache := subFlags.Int64("cache", topotools.DefaultSequenceCache, "The number of values that the tablets reserve at a time for the created sequences")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ProvisionSequences command")
+	}
+	return wr.ProvisionSequences(ctx, subFlags.Arg(0), *sequenceKeyspace, *cache)
+}
+
+func commandRepairLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	chunkSize := subFlags.Int("chunk_size", 1000, "The number of rows read per query")
+	concurrency := subFlags.Int("concurrency", 4, "The number of shards scanned in parallel")
+	maxTPS := subFlags.Int64("max_tps", 0, "The maximum number of fix transactions per second, unlimited if 0")
+	recheckDelay := subFlags.Duration("recheck_delay", 5*time.Second, "How long to wait before an orphaned lookup row is checked again and deleted")
+	dryRun := subFlags.Bool("dry_run", false, "Only reports the rows that would be fixed")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace> and <vindex> arguments are required for the RepairLookupVindex command")
+	}
+	report, err := wr.RepairLookupVindex(ctx, subFlags.Arg(0), subFlags.Arg(1), wrangler.LookupRepairOptions{
+		ChunkSize:    *chunkSize,
+		Concurrency:  *concurrency,
+		MaxTPS:       *maxTPS,
+		RecheckDelay: *recheckDelay,
+		DryRun:       *dryRun,
+	})
+	if report != nil {
+		wr.Logger().Printf("%v\n", report)
+	}
+	return err
+}
+
 func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
+	tableGC := subFlags.Bool("table_gc", false, "Rename the dropped tables into the table GC lifecycle of the tablets, which purge and drop them in the background, instead of dropping them right away. The master tablets must run with -table_gc_enable, or the renamed tables are kept.")
 	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
 	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the SQL commands")
 	waitSlaveTimeout := subFlags.Duration("wait_slave_timeout", wrangler.DefaultWaitSlaveTimeout, "The amount of time to wait for slaves to receive the schema change via replication.")
@@ -2200,6 +2748,9 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	if *allowLongUnavailability {
 		executor.AllowBigSchemaChange()
 	}
+	if *tableGC {
+		executor.EnableTableGC()
+	}
 	return schemamanager.Run(
 		ctx,
 		schemamanager.NewPlainController(change, keyspace),
@@ -2350,6 +2901,186 @@ func commandGetRoutingRules(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	return nil
 }
 
+func commandGetTableACL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the GetTableACL command")
+	}
+	config, err := wr.TopoServer().GetTableACL(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	b, err := json2.MarshalIndentPB(config, "  ")
+	if err != nil {
+		wr.Logger().Printf("%v\n", err)
+		return err
+	}
+	wr.Logger().Printf("%s\n", b)
+	return nil
+}
+
+func commandApplyTableACL(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	acl := subFlags.String("acl", "", "Specify the table ACL as a string")
+	aclFile := subFlags.String("acl_file", "", "Specify the table ACL in a file")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ApplyTableACL command")
+	}
+	keyspace := subFlags.Arg(0)
+
+	var aclBytes []byte
+	if *aclFile != "" {
+		var err error
+		aclBytes, err = ioutil.ReadFile(*aclFile)
+		if err != nil {
+			return err
+		}
+	} else {
+		aclBytes = []byte(*acl)
+	}
+
+	config := &tableaclpb.Config{}
+	if err := json2.Unmarshal(aclBytes, config); err != nil {
+		return err
+	}
+	if err := tableacl.ValidateProto(config); err != nil {
+		return err
+	}
+
+	b, err := json2.MarshalIndentPB(config, "  ")
+	if err != nil {
+		wr.Logger().Errorf2(err, "Failed to marshal the table ACL for display")
+	} else {
+		wr.Logger().Printf("New table ACL object:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", b)
+	}
+
+	return wr.TopoServer().SaveTableACL(ctx, keyspace, config)
+}
+
+func commandGetQuota(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the GetQuota command")
+	}
+	config, err := wr.TopoServer().GetQuota(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	b, err := json2.MarshalIndentPB(config, "  ")
+	if err != nil {
+		wr.Logger().Printf("%v\n", err)
+		return err
+	}
+	wr.Logger().Printf("%s\n", b)
+	return nil
+}
+
+func commandSetQuota(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	quota := subFlags.String("quota", "", "Specify the quotas as a string")
+	quotaFile := subFlags.String("quota_file", "", "Specify the quotas in a file")
+	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	var cells flagutil.StringListValue
+	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the SetQuota command")
+	}
+	keyspace := subFlags.Arg(0)
+
+	var quotaBytes []byte
+	if *quotaFile != "" {
+		var err error
+		quotaBytes, err = ioutil.ReadFile(*quotaFile)
+		if err != nil {
+			return err
+		}
+	} else {
+		quotaBytes = []byte(*quota)
+	}
+
+	config := &quotapb.Config{}
+	if err := json2.Unmarshal(quotaBytes, config); err != nil {
+		return err
+	}
+	if err := validateQuota(config); err != nil {
+		return err
+	}
+
+	b, err := json2.MarshalIndentPB(config, "  ")
+	if err != nil {
+		wr.Logger().Errorf2(err, "Failed to marshal the quotas for display")
+	} else {
+		wr.Logger().Printf("New quota object:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", b)
+	}
+
+	if err := wr.TopoServer().SaveQuota(ctx, keyspace, config); err != nil {
+		return err
+	}
+	return rebuildQuotas(ctx, wr, *skipRebuild, cells)
+}
+
+// rebuildQuotas rebuilds the SrvVSchema objects the vtgates read the
+// quotas from, unless skipRebuild is set.
+func rebuildQuotas(ctx context.Context, wr *wrangler.Wrangler, skipRebuild bool, cells []string) error {
+	if skipRebuild {
+		wr.Logger().Warningf("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
+		return nil
+	}
+	return wr.TopoServer().RebuildSrvVSchema(ctx, cells)
+}
+
+// validateQuota checks that the limits of the quotas are not negative,
+// and that each caller has at most one quota.
+func validateQuota(config *quotapb.Config) error {
+	check := func(name string, quota *quotapb.Quota) error {
+		if quota.GetQps() < 0 || quota.GetMaxConcurrency() < 0 {
+			return fmt.Errorf("the %v quota has a negative limit: %v", name, quota)
+		}
+		return nil
+	}
+	if err := check("keyspace", config.Keyspace); err != nil {
+		return err
+	}
+	if err := check("default caller", config.DefaultCaller); err != nil {
+		return err
+	}
+	callers := make(map[string]bool)
+	for _, cq := range config.Callers {
+		if callers[cq.Caller] {
+			return fmt.Errorf("caller %v has more than one quota", cq.Caller)
+		}
+		callers[cq.Caller] = true
+		if err := check(fmt.Sprintf("caller %v", cq.Caller), cq.Quota); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func commandDeleteQuota(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	var cells flagutil.StringListValue
+	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the DeleteQuota command")
+	}
+	if err := wr.TopoServer().DeleteQuota(ctx, subFlags.Arg(0)); err != nil {
+		return err
+	}
+	return rebuildQuotas(ctx, wr, *skipRebuild, cells)
+}
+
 func commandRebuildVSchemaGraph(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	var cells flagutil.StringListValue
 	subFlags.Var(&cells, "cells", "Specifies a comma-separated list of cells to look for tablets")
@@ -2519,6 +3250,56 @@ func commandApplyRoutingRules(ctx context.Context, wr *wrangler.Wrangler, subFla
 	return wr.TopoServer().RebuildSrvVSchema(ctx, cells)
 }
 
+func commandSetRoutingRule(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	var tabletTypes topoproto.TabletTypeListValue
+	subFlags.Var(&tabletTypes, "tablet_types", "Comma-separated list of the tablet types routed by the rule. All of them if empty, which is overridden by the rules of specific tablet types.")
+	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	var cells flagutil.StringListValue
+	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 && subFlags.NArg() != 2 {
+		return fmt.Errorf("the <from table> argument is required for the SetRoutingRule command")
+	}
+	var toTables []string
+	if subFlags.NArg() == 2 {
+		toTables = strings.Split(subFlags.Arg(1), ",")
+	}
+	if err := wr.SetRoutingRule(ctx, subFlags.Arg(0), toTables, tabletTypes); err != nil {
+		return err
+	}
+	return rebuildAfterRoutingRules(ctx, wr, *skipRebuild, cells)
+}
+
+func commandDeleteRoutingRule(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	var tabletTypes topoproto.TabletTypeListValue
+	subFlags.Var(&tabletTypes, "tablet_types", "Comma-separated list of the tablet types of the rules to delete. The rule for all the tablet types if empty.")
+	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	var cells flagutil.StringListValue
+	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <from table> argument is required for the DeleteRoutingRule command")
+	}
+	if err := wr.DeleteRoutingRule(ctx, subFlags.Arg(0), tabletTypes); err != nil {
+		return err
+	}
+	return rebuildAfterRoutingRules(ctx, wr, *skipRebuild, cells)
+}
+
+func rebuildAfterRoutingRules(ctx context.Context, wr *wrangler.Wrangler, skipRebuild bool, cells []string) error {
+	if skipRebuild {
+		wr.Logger().Warningf("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
+		return nil
+	}
+	return wr.TopoServer().RebuildSrvVSchema(ctx, cells)
+}
+
 func commandGetSrvKeyspaceNames(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
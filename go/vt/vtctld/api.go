@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/schemamanager"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vtctl"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/workflow"
@@ -125,6 +127,8 @@ func unmarshalRequest(r *http.Request, v interface{}) error {
 func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, realtimeStats *realtimeStats) {
 	tabletHealthCache := newTabletHealthCache(ts)
 	tmClient := tmclient.NewTabletManagerClient()
+	workflowsCache := newVReplicationWorkflowsCache(ts, *vreplicationWorkflowsRefresh)
+	jobs := newJobManager(ctx, ts, tmClient)
 
 	// Cells
 	handleCollection("cells", func(r *http.Request) (interface{}, error) {
@@ -302,6 +306,38 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 
 	})
 
+	// SrvKeyspace partitions, as JSON or as the text of
+	// ShowSrvKeyspacePartitions with format=text.
+	handleAPI("srv_keyspace_partitions/", func(w http.ResponseWriter, r *http.Request) error {
+		keyspace := getItemPath(r.URL.Path)
+		if keyspace == "" {
+			return fmt.Errorf("invalid srv_keyspace_partitions path: %q  expected path: /srv_keyspace_partitions/<keyspace>", r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			return err
+		}
+		var cells []string
+		if c := r.FormValue("cells"); c != "" {
+			cells = strings.Split(c, ",")
+		}
+		views, err := topotools.GetPartitionViews(ctx, ts, keyspace, cells)
+		if err != nil {
+			return fmt.Errorf("can't get the partitions of keyspace %v: %v", keyspace, err)
+		}
+		if r.FormValue("format") == "text" {
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte(topotools.FormatPartitionViews(views)))
+			return nil
+		}
+		data, err := json.MarshalIndent(views, "", "  ")
+		if err != nil {
+			return fmt.Errorf("cannot marshal data: %v", err)
+		}
+		w.Header().Set("Content-Type", jsonContentType)
+		w.Write(data)
+		return nil
+	})
+
 	// Tablets
 	handleCollection("tablets", func(r *http.Request) (interface{}, error) {
 		tabletPath := getItemPath(r.URL.Path)
@@ -482,6 +518,100 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 		return nil, fmt.Errorf("invalid target path: %q  expected path: ?keyspace=<keyspace>&cell=<cell>", targetPath)
 	})
 
+	// VReplication workflows
+	handleCollection("vreplication_workflows", func(r *http.Request) (interface{}, error) {
+		// Valid requests: api/vreplication_workflows/ (all keyspaces, cached)
+		// Valid requests: api/vreplication_workflows/my_ks (all workflows of a keyspace)
+		// Valid requests: api/vreplication_workflows/my_ks/my_workflow (specific workflow)
+		itemPath := getItemPath(r.URL.Path)
+		parts := strings.SplitN(itemPath, "/", 2)
+		wr := wrangler.New(logutil.NewMemoryLogger(), ts, tmClient)
+		switch r.Method {
+		case "GET":
+			if len(parts) == 2 {
+				return wr.ShowWorkflow(ctx, parts[0], parts[1])
+			}
+			if parts[0] == "" {
+				return workflowsCache.get(ctx, wr)
+			}
+			return getVReplicationWorkflows(ctx, wr, []string{parts[0]}), nil
+			// Stop, start, retry or delete a workflow.
+		case "POST":
+			if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+				return nil, err
+			}
+			if len(parts) != 2 || parts[1] == "" {
+				return nil, errors.New("a POST request needs a keyspace and a workflow in the URL")
+			}
+			if err := r.ParseForm(); err != nil {
+				return nil, err
+			}
+			action := r.FormValue("action")
+			if action == "" {
+				return nil, errors.New("a POST request must specify action")
+			}
+			err := wr.WorkflowAction(ctx, parts[0], parts[1], action)
+			workflowsCache.invalidate()
+			if err != nil {
+				return nil, err
+			}
+			// Return the workflows of the keyspace, the workflow may
+			// be gone.
+			return getVReplicationWorkflows(ctx, wr, []string{parts[0]}), nil
+		default:
+			return nil, fmt.Errorf("unsupported HTTP method: %v", r.Method)
+		}
+	})
+
+	// Diff and backup jobs
+	handleCollection("jobs", func(r *http.Request) (interface{}, error) {
+		// Valid requests: api/jobs/ (all jobs)
+		// Valid requests: api/jobs/ with POST action=start, type=<diff|backup> and target
+		// Valid requests: api/jobs/<id> with POST action=<stop|retry|remove>
+		itemPath := getItemPath(r.URL.Path)
+		switch r.Method {
+		case "GET":
+			if itemPath != "" {
+				return nil, errors.New("jobs can only be listed, not retrieved")
+			}
+			return jobs.list(), nil
+		case "POST":
+			if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+				return nil, err
+			}
+			if err := r.ParseForm(); err != nil {
+				return nil, err
+			}
+			action := r.FormValue("action")
+			if itemPath == "" {
+				if action != "start" {
+					return nil, fmt.Errorf("invalid action %q on api/jobs/, must be start", action)
+				}
+				return jobs.start(r.FormValue("type"), r.FormValue("target"))
+			}
+			id, err := strconv.Atoi(itemPath)
+			if err != nil {
+				return nil, fmt.Errorf("invalid job id %q: %v", itemPath, err)
+			}
+			switch action {
+			case "stop":
+				err = jobs.stop(id)
+			case "retry":
+				_, err = jobs.retry(id)
+			case "remove":
+				err = jobs.remove(id)
+			default:
+				return nil, fmt.Errorf("invalid action %q on job %v, must be one of stop, retry or remove", action, id)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return jobs.list(), nil
+		default:
+			return nil, fmt.Errorf("unsupported HTTP method: %v", r.Method)
+		}
+	})
+
 	// Vtctl Command
 	handleAPI("vtctl/", func(w http.ResponseWriter, r *http.Request) error {
 		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
@@ -564,4 +694,7 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 		w.Write(data)
 		return nil
 	})
+
+	// Typed API
+	initAPIV2(ts)
 }
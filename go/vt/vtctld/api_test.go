@@ -27,7 +27,9 @@ import (
 
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -52,6 +54,7 @@ func TestAPI(t *testing.T) {
 	ts.CreateKeyspace(ctx, "ks1", &topodatapb.Keyspace{ShardingColumnName: "shardcol"})
 	ts.CreateShard(ctx, "ks1", "-80")
 	ts.CreateShard(ctx, "ks1", "80-")
+	topotools.RebuildKeyspace(ctx, logutil.NewMemoryLogger(), ts, "ks1", []string{"cell1"})
 
 	// SaveVSchema to test that creating a snapshot keyspace copies VSchema
 	vs := &vschemapb.Keyspace{
@@ -139,6 +142,18 @@ func TestAPI(t *testing.T) {
 		// Cells
 		{"GET", "cells", "", `["cell1","cell2"]`},
 
+		// SrvKeyspace partitions
+		{"GET", "srv_keyspace_partitions/ks1", "", `[
+				{"Cell": "cell1", "ServedType": "master", "Shards": ["-80", "80-"]},
+				{"Cell": "cell1", "ServedType": "replica", "Shards": ["-80", "80-"]},
+				{"Cell": "cell1", "ServedType": "rdonly", "Shards": ["-80", "80-"]}
+			]`},
+		{"GET", "srv_keyspace_partitions/ks1?cells=cell2", "", `null`},
+		{"GET", "srv_keyspace_partitions/ks1?format=text", "", "CELL   TYPE     SHARDS\n" +
+			"cell1  master   [-80][80-]\n" +
+			"cell1  replica  [-80][80-]\n" +
+			"cell1  rdonly   [-80][80-]"},
+
 		// Keyspaces
 		{"GET", "keyspaces", "", `["ks1", "ks3"]`},
 		{"GET", "keyspaces/ks1", "", `{
@@ -147,7 +162,8 @@ func TestAPI(t *testing.T) {
 				"served_froms": [],
                                 "keyspace_type":0,
                                 "base_keyspace":"",
-                                "snapshot_time":null
+                                "snapshot_time":null,
+                                "durability_policy":""
 			}`},
 		{"GET", "keyspaces/nonexistent", "", "404 page not found"},
 		{"POST", "keyspaces/ks1?action=TestKeyspaceAction", "", `{
@@ -286,11 +302,11 @@ func TestAPI(t *testing.T) {
 		// vtctl RunCommand
 		{"POST", "vtctl/", `["GetKeyspace","ks1"]`, `{
 		   "Error": "",
-		   "Output": "{\n  \"sharding_column_name\": \"shardcol\",\n  \"sharding_column_type\": 0,\n  \"served_froms\": [\n  ],\n  \"keyspace_type\": 0,\n  \"base_keyspace\": \"\",\n  \"snapshot_time\": null\n}\n\n"
+		   "Output": "{\n  \"sharding_column_name\": \"shardcol\",\n  \"sharding_column_type\": 0,\n  \"served_froms\": [\n  ],\n  \"keyspace_type\": 0,\n  \"base_keyspace\": \"\",\n  \"snapshot_time\": null,\n  \"durability_policy\": \"\"\n}\n\n"
 		}`},
 		{"POST", "vtctl/", `["GetKeyspace","ks3"]`, `{
 		   "Error": "",
-		   "Output": "{\n  \"sharding_column_name\": \"\",\n  \"sharding_column_type\": 0,\n  \"served_froms\": [\n  ],\n  \"keyspace_type\": 1,\n  \"base_keyspace\": \"ks1\",\n  \"snapshot_time\": {\n    \"seconds\": \"1136214245\",\n    \"nanoseconds\": 0\n  }\n}\n\n"
+		   "Output": "{\n  \"sharding_column_name\": \"\",\n  \"sharding_column_type\": 0,\n  \"served_froms\": [\n  ],\n  \"keyspace_type\": 1,\n  \"base_keyspace\": \"ks1\",\n  \"snapshot_time\": {\n    \"seconds\": \"1136214245\",\n    \"nanoseconds\": 0\n  },\n  \"durability_policy\": \"\"\n}\n\n"
 		}`},
 		{"POST", "vtctl/", `["GetVSchema","ks3"]`, `{
 		   "Error": "",
@@ -301,6 +317,64 @@ func TestAPI(t *testing.T) {
 		   "Output": ""
 		}`},
 		{"POST", "vtctl/", `["Panic"]`, `uncaught panic: this command panics on purpose`},
+
+		// VReplication workflows. The shards have no master, so
+		// there are no workflows.
+		{"GET", "vreplication_workflows/", "", `[]`},
+		{"GET", "vreplication_workflows/ks1", "", `[]`},
+		{"GET", "vreplication_workflows/ks1/move", "", "can't get vreplication_workflows: shard ks1/-80 has no master"},
+		{"POST", "vreplication_workflows/ks1", "", "can't get vreplication_workflows: a POST request needs a keyspace and a workflow in the URL"},
+		{"GET", "vreplication_workflows/does_not_exist", "", `[{
+		  "Keyspace": "does_not_exist",
+		  "Workflow": "",
+		  "Status": null,
+		  "Error": "node doesn't exist: keyspaces/does_not_exist/shards"
+		}]`},
+
+		// Diff and backup jobs
+		{"GET", "jobs/", "", `[]`},
+		{"GET", "jobs/1", "", "can't get jobs: jobs can only be listed, not retrieved"},
+		{"POST", "jobs/?action=start&type=restore&target=ks1/-80", "", "can't get jobs: invalid job type: restore, must be one of diff or backup"},
+		{"POST", "jobs/?action=start&type=diff&target=ks1", "", "can't get jobs: invalid format for <keyspace.workflow>: ks1"},
+		{"POST", "jobs/?action=stop", "", `can't get jobs: invalid action "stop" on api/jobs/, must be start`},
+		{"POST", "jobs/1?action=stop", "", "can't get jobs: no job 1"},
+
+		// Typed API
+		{"POST", "v2/GetKeyspace", `{"keyspace": "ks1"}`, `{
+		  "keyspace": {
+		    "name": "ks1",
+		    "keyspace": {
+		      "sharding_column_name": "shardcol",
+		      "sharding_column_type": "UNSET",
+		      "served_froms": [],
+		      "keyspace_type": "NORMAL",
+		      "base_keyspace": "",
+		      "snapshot_time": null,
+		      "durability_policy": ""
+		    }
+		  }
+		}`},
+		{"POST", "v2/GetKeyspace", `{"keyspace": "does_not_exist"}`, "node doesn't exist: keyspaces/does_not_exist/Keyspace"},
+		{"POST", "v2/GetKeyspace", `{"bad_field": "ks1"}`, `can't unmarshal request: unknown field "bad_field" in vtctldata.GetKeyspaceRequest`},
+		{"POST", "v2/CreateShard", `{"keyspace": "ks1", "shard_name": "-80"}`, `node already exists: Shard`},
+		{"POST", "v2/GetTablets", `{"keyspace": "ks1", "shard": "-80", "cells": ["cell2"]}`, `{
+		  "tablets": [{
+		    "alias": {"cell": "cell2", "uid": 200},
+		    "hostname": "",
+		    "port_map": {"vt": 200},
+		    "keyspace": "ks1",
+		    "shard": "-80",
+		    "key_range": {"start": null, "end": "gA=="},
+		    "type": "REPLICA",
+		    "db_name_override": "",
+		    "tags": {},
+		    "mysql_hostname": "",
+		    "mysql_port": 0,
+		    "master_term_start_time": null
+		  }]
+		}`},
+		{"GET", "v2/DeleteKeyspace", "", `405 Method Not Allowed`},
+		{"POST", "v2/DoesNotExist", "", `404 page not found`},
 	}
 	for _, in := range table {
 		t.Run(in.method+in.path, func(t *testing.T) {
@@ -0,0 +1,160 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/status"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file implements the JSON gateway of the typed Vtctld API. Each
+// method of the vtctlservice.Vtctld service is served as
+// POST /api/v2/<Method>, with the JSON encoding of its request proto as
+// body, and answers with the JSON encoding of its response proto.
+
+const apiV2Path = "v2/"
+
+var (
+	vtctldServerType = reflect.TypeOf((*vtctlservicepb.VtctldServer)(nil)).Elem()
+	contextType      = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType        = reflect.TypeOf((*error)(nil)).Elem()
+
+	apiV2Marshaler = jsonpb.Marshaler{EmitDefaults: true, Indent: "  ", OrigName: true}
+)
+
+// apiV2Method is a method of the Vtctld API.
+type apiV2Method struct {
+	fn reflect.Value
+	// request is the type of the request proto.
+	request reflect.Type
+	// readOnly methods only need the DEBUGGING role.
+	readOnly bool
+}
+
+// apiV2Methods returns the methods of the Vtctld API of server by name.
+func apiV2Methods(server vtctlservicepb.VtctldServer) map[string]*apiV2Method {
+	methods := make(map[string]*apiV2Method)
+	v := reflect.ValueOf(server)
+	for i := 0; i < vtctldServerType.NumMethod(); i++ {
+		m := vtctldServerType.Method(i)
+		t := m.Type
+		if t.NumIn() != 2 || t.In(0) != contextType || t.NumOut() != 2 || t.Out(1) != errorType {
+			panic(fmt.Sprintf("unexpected signature for Vtctld method %v: %v", m.Name, t))
+		}
+		methods[m.Name] = &apiV2Method{
+			fn:       v.MethodByName(m.Name),
+			request:  t.In(1).Elem(),
+			readOnly: strings.HasPrefix(m.Name, "Get"),
+		}
+	}
+	return methods
+}
+
+// call decodes the JSON request of the method from r, and returns the
+// response proto.
+func (m *apiV2Method) call(r *http.Request) (proto.Message, error) {
+	req := reflect.New(m.request)
+	if r.ContentLength != 0 {
+		if err := jsonpb.Unmarshal(r.Body, req.Interface().(proto.Message)); err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "can't unmarshal request: %v", err)
+		}
+	}
+	out := m.fn.Call([]reflect.Value{reflect.ValueOf(r.Context()), req})
+	if err, _ := out[1].Interface().(error); err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, vterrors.New(vtrpcpb.Code(s.Code()), s.Message())
+		}
+		return nil, err
+	}
+	return out[0].Interface().(proto.Message), nil
+}
+
+// apiV2Status returns the HTTP status of the error of a Vtctld method.
+func apiV2Status(err error) int {
+	switch vterrors.Code(err) {
+	case vtrpcpb.Code_INVALID_ARGUMENT:
+		return http.StatusBadRequest
+	case vtrpcpb.Code_NOT_FOUND:
+		return http.StatusNotFound
+	case vtrpcpb.Code_ALREADY_EXISTS:
+		return http.StatusConflict
+	case vtrpcpb.Code_FAILED_PRECONDITION:
+		return http.StatusPreconditionFailed
+	case vtrpcpb.Code_PERMISSION_DENIED:
+		return http.StatusForbidden
+	case vtrpcpb.Code_UNAVAILABLE:
+		return http.StatusServiceUnavailable
+	case vtrpcpb.Code_DEADLINE_EXCEEDED:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
+
+// initAPIV2 registers the JSON gateway of the Vtctld API.
+func initAPIV2(ts *topo.Server) {
+	methods := apiV2Methods(grpcvtctldserver.NewVtctldServer(ts))
+
+	handleAPI(apiV2Path, func(w http.ResponseWriter, r *http.Request) error {
+		name := strings.TrimPrefix(r.URL.Path, apiPrefix+apiV2Path)
+		m, ok := methods[name]
+		if !ok {
+			http.NotFound(w, r)
+			return nil
+		}
+		if r.Method != http.MethodPost && !(m.readOnly && r.Method == http.MethodGet) {
+			http.Error(w, "405 Method Not Allowed", http.StatusMethodNotAllowed)
+			return nil
+		}
+		role := acl.ADMIN
+		if m.readOnly {
+			role = acl.DEBUGGING
+		}
+		if err := acl.CheckAccessHTTP(r, role); err != nil {
+			http.Error(w, "403 Forbidden", http.StatusForbidden)
+			return nil
+		}
+
+		resp, err := m.call(r)
+		if err != nil {
+			http.Error(w, err.Error(), apiV2Status(err))
+			return nil
+		}
+		var b bytes.Buffer
+		if err := apiV2Marshaler.Marshal(&b, resp); err != nil {
+			return fmt.Errorf("jsonpb error: %v", err)
+		}
+		w.Header().Set("Content-Type", jsonContentType)
+		w.Write(b.Bytes())
+		return nil
+	})
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+)
+
+// This file implements the diff and backup jobs started from the vtctld
+// UI. They run in vtctld, which keeps their progress and result until
+// they are removed, or until vtctld restarts.
+
+const (
+	jobDiff   = "diff"
+	jobBackup = "backup"
+
+	jobRunning = "Running"
+	jobDone    = "Done"
+	jobError   = "Error"
+	jobStopped = "Stopped"
+
+	// maxFinishedJobs is the number of finished jobs kept, the oldest
+	// ones are removed first.
+	maxFinishedJobs = 50
+)
+
+// job is a diff or backup job, as returned by the jobs API.
+type job struct {
+	ID int
+	// Type is jobDiff or jobBackup.
+	Type string
+	// Target is keyspace.workflow for a diff, and keyspace/shard for a
+	// backup.
+	Target   string
+	State    string
+	Started  time.Time
+	Finished time.Time `json:",omitempty"`
+	// Progress is the last message logged by the job.
+	Progress string
+	Error    string `json:",omitempty"`
+	// Result is the report of a diff.
+	Result interface{} `json:",omitempty"`
+
+	cancel context.CancelFunc
+}
+
+// jobManager runs the diff and backup jobs.
+type jobManager struct {
+	ctx      context.Context
+	ts       *topo.Server
+	tmClient tmclient.TabletManagerClient
+
+	// mu protects the fields below and the fields of the jobs.
+	mu     sync.Mutex
+	nextID int
+	jobs   map[int]*job
+}
+
+func newJobManager(ctx context.Context, ts *topo.Server, tmClient tmclient.TabletManagerClient) *jobManager {
+	return &jobManager{
+		ctx:      ctx,
+		ts:       ts,
+		tmClient: tmClient,
+		nextID:   1,
+		jobs:     make(map[int]*job),
+	}
+}
+
+// list returns a copy of the jobs, the most recent first.
+func (jm *jobManager) list() []*job {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	jobs := make([]*job, 0, len(jm.jobs))
+	for _, j := range jm.jobs {
+		c := *j
+		jobs = append(jobs, &c)
+	}
+	sort.Slice(jobs, func(i, k int) bool { return jobs[i].ID > jobs[k].ID })
+	return jobs
+}
+
+// start validates the target of a job and starts it.
+func (jm *jobManager) start(jobType, target string) (*job, error) {
+	switch jobType {
+	case jobDiff:
+		if _, _, err := splitKeyspaceWorkflow(target); err != nil {
+			return nil, err
+		}
+	case jobBackup:
+		if _, _, err := topoproto.ParseKeyspaceShard(target); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid job type: %v, must be one of %v or %v", jobType, jobDiff, jobBackup)
+	}
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	for _, j := range jm.jobs {
+		if j.State == jobRunning && j.Type == jobType && j.Target == target {
+			return nil, fmt.Errorf("%v job %v is already running on %v", jobType, j.ID, target)
+		}
+	}
+	ctx, cancel := context.WithCancel(jm.ctx)
+	j := &job{
+		ID:      jm.nextID,
+		Type:    jobType,
+		Target:  target,
+		State:   jobRunning,
+		Started: time.Now(),
+		cancel:  cancel,
+	}
+	jm.nextID++
+	jm.jobs[j.ID] = j
+	jm.removeFinishedLocked()
+	go jm.run(ctx, j)
+	c := *j
+	return &c, nil
+}
+
+// stop cancels a running job.
+func (jm *jobManager) stop(id int) error {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	j, ok := jm.jobs[id]
+	if !ok {
+		return fmt.Errorf("no job %v", id)
+	}
+	if j.State != jobRunning {
+		return fmt.Errorf("job %v is not running", id)
+	}
+	j.cancel()
+	return nil
+}
+
+// retry starts a new job with the type and target of a finished job.
+func (jm *jobManager) retry(id int) (*job, error) {
+	jm.mu.Lock()
+	j, ok := jm.jobs[id]
+	if !ok {
+		jm.mu.Unlock()
+		return nil, fmt.Errorf("no job %v", id)
+	}
+	jobType, target, state := j.Type, j.Target, j.State
+	jm.mu.Unlock()
+	if state == jobRunning {
+		return nil, fmt.Errorf("job %v is still running", id)
+	}
+	return jm.start(jobType, target)
+}
+
+// remove forgets a finished job.
+func (jm *jobManager) remove(id int) error {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	j, ok := jm.jobs[id]
+	if !ok {
+		return fmt.Errorf("no job %v", id)
+	}
+	if j.State == jobRunning {
+		return fmt.Errorf("job %v is still running, stop it first", id)
+	}
+	delete(jm.jobs, id)
+	return nil
+}
+
+// removeFinishedLocked removes the oldest finished jobs above
+// maxFinishedJobs.
+func (jm *jobManager) removeFinishedLocked() {
+	var finished []int
+	for id, j := range jm.jobs {
+		if j.State != jobRunning {
+			finished = append(finished, id)
+		}
+	}
+	sort.Ints(finished)
+	for len(finished) > maxFinishedJobs {
+		delete(jm.jobs, finished[0])
+		finished = finished[1:]
+	}
+}
+
+func (jm *jobManager) run(ctx context.Context, j *job) {
+	// The messages of the job are its progress.
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		jm.mu.Lock()
+		j.Progress = e.Value
+		jm.mu.Unlock()
+	})
+	wr := wrangler.New(logger, jm.ts, jm.tmClient)
+
+	var result interface{}
+	var err error
+	switch j.Type {
+	case jobDiff:
+		result, err = jm.runDiff(ctx, wr, j.Target)
+	case jobBackup:
+		err = jm.runBackup(ctx, wr, j.Target)
+	}
+
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+	j.Finished = time.Now()
+	switch {
+	case ctx.Err() == context.Canceled:
+		j.State = jobStopped
+	case err != nil:
+		j.State = jobError
+		j.Error = err.Error()
+	default:
+		j.State = jobDone
+		j.Result = result
+	}
+	j.cancel()
+	log.Infof("%v job %v on %v finished: %v %v", j.Type, j.ID, j.Target, j.State, j.Error)
+}
+
+func (jm *jobManager) runDiff(ctx context.Context, wr *wrangler.Wrangler, target string) (interface{}, error) {
+	keyspace, workflow, err := splitKeyspaceWorkflow(target)
+	if err != nil {
+		return nil, err
+	}
+	wr.Logger().Infof("Comparing the tables of %v", target)
+	return wr.VDiff(ctx, keyspace, workflow, "", "", "", 30*time.Second,
+		*vtctl.HealthCheckTopologyRefresh, *vtctl.HealthcheckRetryDelay, *vtctl.HealthCheckTimeout, 0)
+}
+
+func (jm *jobManager) runBackup(ctx context.Context, wr *wrangler.Wrangler, target string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(target)
+	if err != nil {
+		return err
+	}
+	tablet, err := wr.ChooseBackupTablet(ctx, keyspace, shard, false /* allowMaster */)
+	if err != nil {
+		return err
+	}
+	wr.Logger().Infof("Taking a backup of %v on %v", target, topoproto.TabletAliasString(tablet.Alias))
+	stream, err := jm.tmClient.Backup(ctx, tablet, 4 /* concurrency */, false /* allowMaster */)
+	if err != nil {
+		return err
+	}
+	for {
+		e, err := stream.Recv()
+		switch err {
+		case nil:
+			logutil.LogEvent(wr.Logger(), e)
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
+
+// splitKeyspaceWorkflow splits a keyspace.workflow target.
+func splitKeyspaceWorkflow(in string) (keyspace, workflow string, err error) {
+	splits := strings.Split(in, ".")
+	if len(splits) != 2 || splits[0] == "" || splits[1] == "" {
+		return "", "", fmt.Errorf("invalid format for <keyspace.workflow>: %s", in)
+	}
+	return splits[0], splits[1], nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+)
+
+// waitForJob waits until a job is not running anymore.
+func waitForJob(t *testing.T, jm *jobManager, id int) *job {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		for _, j := range jm.list() {
+			if j.ID == id && j.State != jobRunning {
+				return j
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("job %v is still running", id)
+	return nil
+}
+
+func TestJobManager(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	jm := newJobManager(ctx, ts, tmclient.NewTabletManagerClient())
+
+	// The shard doesn't exist, so the backup fails.
+	j, err := jm.start(jobBackup, "ks/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if j.ID != 1 || j.State != jobRunning {
+		t.Errorf("start: %+v, want running job 1", j)
+	}
+	j = waitForJob(t, jm, 1)
+	if j.State != jobError || !strings.Contains(j.Error, "node doesn't exist") {
+		t.Errorf("backup job: %+v, want a failed job", j)
+	}
+	if j.Finished.IsZero() {
+		t.Errorf("backup job has no finish time")
+	}
+	if err := jm.stop(1); err == nil || err.Error() != "job 1 is not running" {
+		t.Errorf("stop: %v", err)
+	}
+
+	// Retrying starts a new job on the same target.
+	j, err = jm.retry(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if j.ID != 2 || j.Type != jobBackup || j.Target != "ks/0" {
+		t.Errorf("retry: %+v, want backup job 2 on ks/0", j)
+	}
+	waitForJob(t, jm, 2)
+
+	jobs := jm.list()
+	if len(jobs) != 2 || jobs[0].ID != 2 || jobs[1].ID != 1 {
+		t.Errorf("list: %+v, want jobs 2 and 1", jobs)
+	}
+	if err := jm.remove(1); err != nil {
+		t.Fatal(err)
+	}
+	if err := jm.remove(1); err == nil || err.Error() != "no job 1" {
+		t.Errorf("remove: %v", err)
+	}
+	if _, err := jm.start(jobDiff, "ks."); err == nil {
+		t.Errorf("start with an empty workflow succeeded")
+	}
+}
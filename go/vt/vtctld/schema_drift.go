@@ -0,0 +1,177 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"flag"
+	"reflect"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools/events"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var (
+	schemaDriftCheckInterval = flag.Duration("schema_drift_check_interval", 0, "If set, vtctld compares the schema of every tablet with the schema of the master of its shard at this interval. The number of drifted tablets of each shard is exported in SchemaDriftTablets, and a SchemaDrift event is dispatched when the schema of a tablet starts or stops differing. See the ShowSchemaDrift command for the differences.")
+
+	schemaDriftTablets     = stats.NewGaugesWithMultiLabels("SchemaDriftTablets", "Number of tablets whose schema differs from the schema of the master of their shard", []string{"Keyspace", "Shard"})
+	schemaDriftCheckErrors = stats.NewCountersWithMultiLabels("SchemaDriftCheckErrors", "Number of schema drift checks of a shard that failed", []string{"Keyspace", "Shard"})
+)
+
+// schemaDriftChecker periodically compares the schema of the tablets
+// of every shard with the schema of their master.
+type schemaDriftChecker struct {
+	ts *topo.Server
+	wr *wrangler.Wrangler
+
+	// drifts maps a keyspace/shard to the diffs of its drifted tablets,
+	// by alias. It is only accessed by check.
+	drifts map[string]map[string][]string
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newSchemaDriftChecker(ts *topo.Server, wr *wrangler.Wrangler) *schemaDriftChecker {
+	return &schemaDriftChecker{
+		ts:     ts,
+		wr:     wr,
+		drifts: make(map[string]map[string][]string),
+		done:   make(chan struct{}),
+	}
+}
+
+// initSchemaDriftChecker starts the schema drift checker if
+// -schema_drift_check_interval is set.
+func initSchemaDriftChecker(ts *topo.Server) {
+	if *schemaDriftCheckInterval == 0 {
+		return
+	}
+	sdc := newSchemaDriftChecker(ts, wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient()))
+	servenv.OnRun(func() {
+		sdc.start(*schemaDriftCheckInterval)
+	})
+	servenv.OnTermSync(sdc.stop)
+}
+
+func (sdc *schemaDriftChecker) start(interval time.Duration) {
+	sdc.wg.Add(1)
+	go func() {
+		defer sdc.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-sdc.done:
+				return
+			case <-ticker.C:
+			}
+			sdc.check(context.Background())
+		}
+	}()
+}
+
+func (sdc *schemaDriftChecker) stop() {
+	close(sdc.done)
+	sdc.wg.Wait()
+}
+
+// check compares the schemas of all the shards.
+func (sdc *schemaDriftChecker) check(ctx context.Context) {
+	keyspaces, err := sdc.ts.GetKeyspaces(ctx)
+	if err != nil {
+		log.Warningf("Schema drift check: GetKeyspaces failed: %v", err)
+		return
+	}
+	for _, keyspace := range keyspaces {
+		shards, err := sdc.ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			log.Warningf("Schema drift check: GetShardNames(%v) failed: %v", keyspace, err)
+			continue
+		}
+		for _, shard := range shards {
+			shardCtx, cancel := context.WithTimeout(ctx, *actionTimeout)
+			sdc.checkShard(shardCtx, keyspace, shard)
+			cancel()
+		}
+	}
+}
+
+// checkShard compares the schemas of a shard, updates the stats, and
+// dispatches the events of the tablets whose drift changed.
+func (sdc *schemaDriftChecker) checkShard(ctx context.Context, keyspace, shard string) {
+	labels := []string{keyspace, shard}
+	masterAlias, drifts, err := sdc.wr.SchemaDriftShard(ctx, keyspace, shard, nil, false)
+	if err != nil {
+		log.Warningf("Schema drift check of %v/%v failed: %v", keyspace, shard, err)
+		schemaDriftCheckErrors.Add(labels, 1)
+	}
+	if masterAlias == nil {
+		return
+	}
+	schemaDriftTablets.Set(labels, int64(len(drifts)))
+
+	key := topoproto.KeyspaceShardString(keyspace, shard)
+	previous := sdc.drifts[key]
+	current := make(map[string][]string, len(drifts))
+	for _, drift := range drifts {
+		alias := topoproto.TabletAliasString(drift.Alias)
+		current[alias] = drift.Diffs
+		if reflect.DeepEqual(previous[alias], drift.Diffs) {
+			continue
+		}
+		event.Dispatch(&events.SchemaDrift{
+			KeyspaceName: keyspace,
+			ShardName:    shard,
+			MasterAlias:  masterAlias,
+			TabletAlias:  drift.Alias,
+			Diffs:        drift.Diffs,
+		})
+	}
+	for alias, diffs := range previous {
+		if _, ok := current[alias]; ok {
+			continue
+		}
+		if err != nil {
+			// The tablet may be one that couldn't be compared.
+			current[alias] = diffs
+			continue
+		}
+		tabletAlias, perr := topoproto.ParseTabletAlias(alias)
+		if perr != nil {
+			continue
+		}
+		event.Dispatch(&events.SchemaDrift{
+			KeyspaceName: keyspace,
+			ShardName:    shard,
+			MasterAlias:  masterAlias,
+			TabletAlias:  tabletAlias,
+		})
+	}
+	sdc.drifts[key] = current
+}
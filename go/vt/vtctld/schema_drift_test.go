@@ -0,0 +1,108 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topotools/events"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+	"vitess.io/vitess/go/vt/wrangler/testlib"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestSchemaDriftChecker(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+
+	master := testlib.NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, nil,
+		testlib.TabletKeyspaceShard(t, "ks", "0"))
+	replica := testlib.NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, nil,
+		testlib.TabletKeyspaceShard(t, "ks", "0"))
+	for _, ft := range []*testlib.FakeTablet{master, replica} {
+		ft.StartActionLoop(t, wr)
+		defer ft.StopActionLoop(t)
+	}
+
+	schema := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "table1",
+			Schema: "CREATE TABLE `table1` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+			Type:   tmutils.TableBaseTable,
+		}},
+	}
+	drifted := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "table1",
+			Schema: "CREATE TABLE `table1` (\n  `id` bigint(20) NOT NULL,\n  `msg` varchar(64),\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+			Type:   tmutils.TableBaseTable,
+		}},
+	}
+	master.FakeMysqlDaemon.Schema = schema
+	replica.FakeMysqlDaemon.Schema = drifted
+
+	var got []*events.SchemaDrift
+	event.AddListener(func(ev *events.SchemaDrift) {
+		got = append(got, ev)
+	})
+
+	sdc := newSchemaDriftChecker(ts, wr)
+	labels := "ks.0"
+	sdc.check(ctx)
+	if len(got) != 1 || len(got[0].Diffs) == 0 {
+		t.Fatalf("got events %v, want one drift", got)
+	}
+	if n := schemaDriftTablets.Counts()[labels]; n != 1 {
+		t.Errorf("SchemaDriftTablets: got %v, want 1", n)
+	}
+
+	// The same drift is not dispatched again.
+	sdc.check(ctx)
+	if len(got) != 1 {
+		t.Errorf("got events %v, want one drift", got)
+	}
+
+	// A tablet that can't be compared keeps its drift.
+	replica.FakeMysqlDaemon.Schema = nil
+	sdc.check(ctx)
+	if len(got) != 1 {
+		t.Errorf("got events %v, want one drift", got)
+	}
+	if n := schemaDriftCheckErrors.Counts()[labels]; n != 1 {
+		t.Errorf("SchemaDriftCheckErrors: got %v, want 1", n)
+	}
+
+	// The drift is fixed.
+	replica.FakeMysqlDaemon.Schema = schema
+	sdc.check(ctx)
+	if len(got) != 2 || len(got[1].Diffs) != 0 {
+		t.Fatalf("got events %v, want the end of the drift", got)
+	}
+	if n := schemaDriftTablets.Counts()[labels]; n != 0 {
+		t.Errorf("SchemaDriftTablets: got %v, want 0", n)
+	}
+}
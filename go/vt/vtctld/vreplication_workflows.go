@@ -0,0 +1,109 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"flag"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var vreplicationWorkflowsRefresh = flag.Duration("vreplication_workflows_refresh", 10*time.Second, "how long the vtctld UI serves the status of all the vreplication workflows from its cache, before reading it again from the tablets")
+
+// vreplicationWorkflow is the status of a vreplication workflow, as
+// returned by the vreplication_workflows API.
+type vreplicationWorkflow struct {
+	Keyspace string
+	Workflow string
+	Status   *wrangler.WorkflowStatus
+	// Error is set when the status of the workflow can't be read.
+	Error string
+}
+
+// getVReplicationWorkflows returns the status of all the vreplication
+// workflows of the keyspaces. The errors are reported per workflow, or
+// per keyspace with an empty workflow.
+func getVReplicationWorkflows(ctx context.Context, wr *wrangler.Wrangler, keyspaces []string) []*vreplicationWorkflow {
+	result := []*vreplicationWorkflow{}
+	for _, keyspace := range keyspaces {
+		workflows, err := wr.ListWorkflows(ctx, keyspace)
+		if err != nil {
+			result = append(result, &vreplicationWorkflow{Keyspace: keyspace, Error: err.Error()})
+			continue
+		}
+		for _, workflow := range workflows {
+			vw := &vreplicationWorkflow{Keyspace: keyspace, Workflow: workflow}
+			if vw.Status, err = wr.ShowWorkflow(ctx, keyspace, workflow); err != nil {
+				vw.Error = err.Error()
+			}
+			result = append(result, vw)
+		}
+	}
+	return result
+}
+
+// vreplicationWorkflowsCache caches the status of the vreplication
+// workflows of all the keyspaces, which the UI polls. Reading it queries
+// the master of every shard, so the UIs open on vtctld share one read
+// per refresh period.
+type vreplicationWorkflowsCache struct {
+	ts      *topo.Server
+	refresh time.Duration
+
+	// mu protects the fields below, and is held while the status is
+	// read, so concurrent requests wait for the same read.
+	mu        sync.Mutex
+	workflows []*vreplicationWorkflow
+	readTime  time.Time
+}
+
+func newVReplicationWorkflowsCache(ts *topo.Server, refresh time.Duration) *vreplicationWorkflowsCache {
+	return &vreplicationWorkflowsCache{
+		ts:      ts,
+		refresh: refresh,
+	}
+}
+
+// get returns the status of the workflows of all the keyspaces, read at
+// most refresh ago.
+func (c *vreplicationWorkflowsCache) get(ctx context.Context, wr *wrangler.Wrangler) ([]*vreplicationWorkflow, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.workflows != nil && time.Since(c.readTime) < c.refresh {
+		return c.workflows, nil
+	}
+	keyspaces, err := c.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.workflows = getVReplicationWorkflows(ctx, wr, keyspaces)
+	c.readTime = time.Now()
+	return c.workflows, nil
+}
+
+// invalidate makes the next get read the status again, after an action
+// changed a workflow.
+func (c *vreplicationWorkflowsCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.workflows = nil
+}
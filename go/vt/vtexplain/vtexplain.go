@@ -58,6 +58,9 @@ type Options struct {
 	// NumShards indicates the number of shards in the topology
 	NumShards int
 
+	// KeyspaceShards overrides NumShards for the given sharded keyspaces
+	KeyspaceShards map[string]int
+
 	// ReplicationMode must be set to either "ROW" or "STATEMENT" before
 	// initialization
 	ReplicationMode string
@@ -178,6 +181,7 @@ func Stop() {
 		for _, conn := range explainTopo.TabletConns {
 			conn.db.Close()
 		}
+		explainTopo = nil
 	}
 }
 
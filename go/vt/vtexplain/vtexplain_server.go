@@ -0,0 +1,137 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// explainMu serializes the analyses, since the fake execution
+// environment is global.
+var explainMu sync.Mutex
+
+// Analyze sets up a fake execution environment for the vschema and the
+// schema, runs the explain analysis on the given queries, and cleans up
+// the environment. Unlike Init and Run, it can be called concurrently.
+func Analyze(vSchemaStr, sqlSchema, sql string, opts *Options) ([]*Explain, error) {
+	explainMu.Lock()
+	defer explainMu.Unlock()
+
+	defer Stop()
+	if err := Init(vSchemaStr, sqlSchema, opts); err != nil {
+		return nil, err
+	}
+	return Run(sql)
+}
+
+// Request is the body of an explain request to the http handler.
+type Request struct {
+	// SQL is a list of semicolon-delimited SQL commands to analyze
+	SQL string `json:"sql"`
+
+	// Schema is the SQL table schema
+	Schema string `json:"schema"`
+
+	// VSchema is the VTGate routing schema, as a map of keyspace name
+	// to keyspace vschema
+	VSchema json.RawMessage `json:"vschema"`
+
+	// Shards is the number of shards of the sharded keyspaces (default 2)
+	Shards int `json:"shards"`
+
+	// KeyspaceShards overrides Shards for the given keyspaces
+	KeyspaceShards map[string]int `json:"keyspace_shards"`
+
+	// ExecutionMode is multi (the default) or twopc
+	ExecutionMode string `json:"execution_mode"`
+
+	// ReplicationMode is ROW (the default) or STATEMENT
+	ReplicationMode string `json:"replication_mode"`
+
+	// Normalize controls whether or not vtgate does query normalization
+	Normalize bool `json:"normalize"`
+
+	// Target overrides the normal routing, like `USE <target>`
+	Target string `json:"target"`
+
+	// OutputMode is json (the default) or text
+	OutputMode string `json:"output_mode"`
+}
+
+func (r *Request) options() *Options {
+	opts := &Options{
+		NumShards:       r.Shards,
+		KeyspaceShards:  r.KeyspaceShards,
+		ExecutionMode:   r.ExecutionMode,
+		ReplicationMode: r.ReplicationMode,
+		Normalize:       r.Normalize,
+		Target:          r.Target,
+	}
+	if opts.NumShards == 0 {
+		opts.NumShards = 2
+	}
+	if opts.ExecutionMode == "" {
+		opts.ExecutionMode = ModeMulti
+	}
+	if opts.ReplicationMode == "" {
+		opts.ReplicationMode = "ROW"
+	}
+	return opts
+}
+
+// NewHandler returns an http handler that explains the queries of the
+// Request posted as json, without executing them: it returns the vtgate
+// plans and the queries sent to each shard. It lets the sharding of the
+// queries be checked, in CI for example, before deploying a schema or
+// vschema change.
+func NewHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		req := &Request{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			http.Error(w, fmt.Sprintf("cannot decode request: %v", err), http.StatusBadRequest)
+			return
+		}
+		if req.SQL == "" || len(req.VSchema) == 0 || string(req.VSchema) == "null" {
+			http.Error(w, "the request needs sql and vschema", http.StatusBadRequest)
+			return
+		}
+		if req.OutputMode != "" && req.OutputMode != "json" && req.OutputMode != "text" {
+			http.Error(w, fmt.Sprintf("invalid output mode %q", req.OutputMode), http.StatusBadRequest)
+			return
+		}
+		explains, err := Analyze(string(req.VSchema), req.Schema, req.SQL, req.options())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.OutputMode == "text" {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			io.WriteString(w, ExplainsAsText(explains))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		io.WriteString(w, ExplainsAsJSON(explains))
+	})
+}
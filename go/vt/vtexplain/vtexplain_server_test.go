@@ -0,0 +1,174 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const (
+	serverTestSchema = "create table t1 (id bigint, val varchar(16), primary key (id));"
+
+	serverTestVSchema = `{
+	"ks": {
+		"sharded": true,
+		"vindexes": {
+			"hash": {
+				"type": "hash"
+			}
+		},
+		"tables": {
+			"t1": {
+				"column_vindexes": [{
+					"column": "id",
+					"name": "hash"
+				}]
+			}
+		}
+	}
+}`
+)
+
+func tabletsOf(explains []*Explain) []string {
+	var tablets []string
+	for _, explain := range explains {
+		for tablet, actions := range explain.TabletActions {
+			if len(actions.TabletQueries) != 0 {
+				tablets = append(tablets, tablet)
+			}
+		}
+	}
+	sort.Strings(tablets)
+	return tablets
+}
+
+func TestAnalyzeKeyspaceShards(t *testing.T) {
+	opts := &Options{
+		NumShards:       2,
+		ReplicationMode: "ROW",
+		ExecutionMode:   ModeMulti,
+	}
+	explains, err := Analyze(serverTestVSchema, serverTestSchema, "select * from t1 where id = 1", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(tabletsOf(explains), ","), "ks/-80"; got != want {
+		t.Errorf("tablets with 2 shards: %v, want %v", got, want)
+	}
+
+	opts.KeyspaceShards = map[string]int{"ks": 8}
+	explains, err = Analyze(serverTestVSchema, serverTestSchema, "select * from t1 where id = 1", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := strings.Join(tabletsOf(explains), ","), "ks/-20"; got != want {
+		t.Errorf("tablets with 8 shards: %v, want %v", got, want)
+	}
+
+	opts.KeyspaceShards = map[string]int{"unknown": 8}
+	if _, err := Analyze(serverTestVSchema, serverTestSchema, "select * from t1", opts); err == nil || !strings.Contains(err.Error(), "unknown keyspace unknown") {
+		t.Errorf("Analyze with unknown keyspace: %v, want unknown keyspace", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	server := httptest.NewServer(NewHandler())
+	defer server.Close()
+
+	post := func(req *Request) (int, string) {
+		t.Helper()
+		body, err := json.Marshal(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.Post(server.URL, "application/json", bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var buf bytes.Buffer
+		buf.ReadFrom(resp.Body)
+		return resp.StatusCode, buf.String()
+	}
+
+	code, body := post(&Request{
+		SQL:            "select * from t1 where id = 1; select * from t1",
+		Schema:         serverTestSchema,
+		VSchema:        json.RawMessage(serverTestVSchema),
+		KeyspaceShards: map[string]int{"ks": 4},
+	})
+	if code != http.StatusOK {
+		t.Fatalf("status: %v, want %v: %s", code, http.StatusOK, body)
+	}
+	var explains []struct {
+		SQL           string
+		TabletActions map[string]json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(body), &explains); err != nil {
+		t.Fatalf("cannot decode %s: %v", body, err)
+	}
+	if len(explains) != 2 {
+		t.Fatalf("explains: %v, want 2", len(explains))
+	}
+	tablets := func(actions map[string]json.RawMessage) string {
+		var names []string
+		for name := range actions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return strings.Join(names, ",")
+	}
+	if got, want := tablets(explains[0].TabletActions), "ks/-40"; got != want {
+		t.Errorf("tablets of the point select: %v, want %v", got, want)
+	}
+	if got, want := tablets(explains[1].TabletActions), "ks/-40,ks/40-80,ks/80-c0,ks/c0-"; got != want {
+		t.Errorf("tablets of the scatter select: %v, want %v", got, want)
+	}
+
+	code, body = post(&Request{
+		SQL:        "select * from t1 where id = 1",
+		Schema:     serverTestSchema,
+		VSchema:    json.RawMessage(serverTestVSchema),
+		OutputMode: "text",
+	})
+	if code != http.StatusOK || !strings.Contains(body, "ks/-80: select * from t1 where id = 1") {
+		t.Errorf("text output: %v %s", code, body)
+	}
+
+	code, body = post(&Request{
+		Schema:  serverTestSchema,
+		VSchema: json.RawMessage(serverTestVSchema),
+	})
+	if code != http.StatusBadRequest || !strings.Contains(body, "needs sql and vschema") {
+		t.Errorf("request without sql: %v %s", code, body)
+	}
+
+	code, body = post(&Request{
+		SQL:     "select * from unknown_table where id = 1",
+		Schema:  serverTestSchema,
+		VSchema: json.RawMessage(serverTestVSchema),
+	})
+	if code != http.StatusBadRequest || !strings.Contains(body, "unknown_table") {
+		t.Errorf("query of an unknown table: %v %s", code, body)
+	}
+}
@@ -43,6 +43,18 @@ type ExplainTopo struct {
 
 	// Number of shards for sharded keyspaces
 	NumShards int
+
+	// Number of shards of the sharded keyspaces that don't have
+	// NumShards shards
+	KeyspaceShards map[string]int
+}
+
+// numShards returns the number of shards of a sharded keyspace.
+func (et *ExplainTopo) numShards(keyspace string) int {
+	if n, ok := et.KeyspaceShards[keyspace]; ok {
+		return n
+	}
+	return et.NumShards
 }
 
 func (et *ExplainTopo) getSrvVSchema() *vschemapb.SrvVSchema {
@@ -83,9 +95,10 @@ func (et *ExplainTopo) GetSrvKeyspace(ctx context.Context, cell, keyspace string
 
 	var srvKeyspace *topodatapb.SrvKeyspace
 	if vschema.Sharded {
-		shards := make([]*topodatapb.ShardReference, 0, et.NumShards)
-		for i := 0; i < et.NumShards; i++ {
-			kr, err := key.EvenShardsKeyRange(i, et.NumShards)
+		numShards := et.numShards(keyspace)
+		shards := make([]*topodatapb.ShardReference, 0, numShards)
+		for i := 0; i < numShards; i++ {
+			kr, err := key.EvenShardsKeyRange(i, numShards)
 			if err != nil {
 				return nil, err
 			}
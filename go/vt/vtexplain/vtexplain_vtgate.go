@@ -53,17 +53,25 @@ var (
 )
 
 func initVtgateExecutor(vSchemaStr string, opts *Options) error {
-	explainTopo = &ExplainTopo{NumShards: opts.NumShards}
+	explainTopo = &ExplainTopo{
+		NumShards:      opts.NumShards,
+		KeyspaceShards: opts.KeyspaceShards,
+	}
 	healthCheck = discovery.NewFakeHealthCheck()
 
 	resolver := newFakeResolver(opts, healthCheck, explainTopo, vtexplainCell)
 
-	err := buildTopology(opts, vSchemaStr, opts.NumShards)
+	err := buildTopology(opts, vSchemaStr)
 	if err != nil {
 		return err
 	}
 
-	vtgateSession.TargetString = opts.Target
+	// Start from a new session, in case a previous run left a
+	// transaction open.
+	vtgateSession = &vtgatepb.Session{
+		TargetString: opts.Target,
+		Autocommit:   true,
+	}
 
 	streamSize := 10
 	queryPlanCacheSize := int64(10)
@@ -87,7 +95,7 @@ func newFakeResolver(opts *Options, hc discovery.HealthCheck, serv srvtopo.Serve
 	return vtgate.NewResolver(srvResolver, serv, cell, sc)
 }
 
-func buildTopology(opts *Options, vschemaStr string, numShardsPerKeyspace int) error {
+func buildTopology(opts *Options, vschemaStr string) error {
 	explainTopo.Lock.Lock()
 	defer explainTopo.Lock.Unlock()
 
@@ -101,11 +109,24 @@ func buildTopology(opts *Options, vschemaStr string, numShardsPerKeyspace int) e
 	}
 	explainTopo.Keyspaces = srvVSchema.Keyspaces
 
+	for ks, n := range opts.KeyspaceShards {
+		vschema, ok := explainTopo.Keyspaces[ks]
+		if !ok {
+			return fmt.Errorf("shards specified for unknown keyspace %s", ks)
+		}
+		if !vschema.Sharded {
+			return fmt.Errorf("shards specified for unsharded keyspace %s", ks)
+		}
+		if n <= 0 {
+			return fmt.Errorf("invalid number of shards %d for keyspace %s", n, ks)
+		}
+	}
+
 	explainTopo.TabletConns = make(map[string]*explainTablet)
 	for ks, vschema := range explainTopo.Keyspaces {
 		numShards := 1
 		if vschema.Sharded {
-			numShards = numShardsPerKeyspace
+			numShards = explainTopo.numShards(ks)
 		}
 		for i := 0; i < numShards; i++ {
 			kr, err := key.EvenShardsKeyRange(i, numShards)
@@ -149,6 +149,14 @@ func (t *explainTablet) Rollback(ctx context.Context, target *querypb.Target, tr
 	return t.tsv.Rollback(ctx, target, transactionID)
 }
 
+// Release is part of the QueryService interface.
+func (t *explainTablet) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	t.mu.Lock()
+	t.currentTime = batchTime.Wait()
+	t.mu.Unlock()
+	return t.tsv.Release(ctx, target, reservedID)
+}
+
 // Execute is part of the QueryService interface.
 func (t *explainTablet) Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
 	t.mu.Lock()
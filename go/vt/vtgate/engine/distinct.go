@@ -0,0 +1,337 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*Distinct)(nil)
+
+// distinctSpillPartitions is the number of files the rows are spilled
+// to once the distinct rows don't fit in memory.
+const distinctSpillPartitions = 16
+
+// Distinct is a primitive that removes the duplicate rows returned by
+// its input. The rows are compared on the Keys, which are the input
+// columns or the weight_string of the text columns, so that the
+// collation of the columns is followed.
+// At most max_memory_rows distinct rows are kept in memory. Beyond
+// that, the rows that are not known to be duplicates are spilled to
+// temporary files, which are deduplicated once the input is done.
+type Distinct struct {
+	Keys []int
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	Input Primitive
+}
+
+// MarshalJSON serializes the Distinct into a JSON representation.
+// It's used for testing and diagnostics.
+func (d *Distinct) MarshalJSON() ([]byte, error) {
+	marshalDistinct := struct {
+		Opcode              string
+		Keys                []int
+		TruncateColumnCount int `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "Distinct",
+		Keys:                d.Keys,
+		TruncateColumnCount: d.TruncateColumnCount,
+		Input:               d.Input,
+	}
+	return json.Marshal(marshalDistinct)
+}
+
+// RouteType returns a description of the query routing type used by the primitive.
+func (d *Distinct) RouteType() string {
+	return d.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (d *Distinct) GetKeyspaceName() string {
+	return d.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (d *Distinct) GetTableName() string {
+	return d.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (d *Distinct) SetTruncateColumnCount(count int) {
+	d.TruncateColumnCount = count
+}
+
+// Execute satisfies the Primitive interface. The input is streamed,
+// so that only the distinct rows are held in memory, unless the
+// session is in a transaction: the streaming queries would not see
+// its changes.
+func (d *Distinct) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	if vcursor.InTransaction() {
+		return d.execute(vcursor, bindVars, wantfields)
+	}
+	out := &sqltypes.Result{}
+	err := d.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			out.Fields = qr.Fields
+		}
+		out.Rows = append(out.Rows, qr.Rows...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	out.RowsAffected = uint64(len(out.Rows))
+	return out, nil
+}
+
+func (d *Distinct) execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	// The fields are needed to check the keys.
+	result, err := d.Input.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.checkKeys(result.Fields); err != nil {
+		return nil, err
+	}
+	ds := newDistinctSet(d.Keys, vcursor.MaxMemoryRows(), vcursor.MemoryTracker())
+	defer ds.close()
+
+	out := &sqltypes.Result{
+		Fields: result.Fields,
+		Extras: result.Extras,
+	}
+	for _, row := range result.Rows {
+		unique, err := ds.add(row)
+		if err != nil {
+			return nil, err
+		}
+		if unique {
+			out.Rows = append(out.Rows, row)
+		}
+	}
+	err = ds.flush(func(rows [][]sqltypes.Value) error {
+		out.Rows = append(out.Rows, rows...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	out.RowsAffected = uint64(len(out.Rows))
+	return out.Truncate(d.TruncateColumnCount), nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (d *Distinct) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	ds := newDistinctSet(d.Keys, vcursor.MaxMemoryRows(), vcursor.MemoryTracker())
+	defer ds.close()
+
+	cb := func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(d.TruncateColumnCount))
+	}
+	// The fields are needed to check the keys.
+	err := d.Input.StreamExecute(vcursor, bindVars, true, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			if err := d.checkKeys(qr.Fields); err != nil {
+				return err
+			}
+			if err := cb(&sqltypes.Result{Fields: qr.Fields}); err != nil {
+				return err
+			}
+		}
+		var rows [][]sqltypes.Value
+		for _, row := range qr.Rows {
+			unique, err := ds.add(row)
+			if err != nil {
+				return err
+			}
+			if unique {
+				rows = append(rows, row)
+			}
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return cb(&sqltypes.Result{Rows: rows})
+	})
+	if err != nil {
+		return err
+	}
+	return ds.flush(func(rows [][]sqltypes.Value) error {
+		return cb(&sqltypes.Result{Rows: rows})
+	})
+}
+
+// checkKeys fails if one of the keys is a text column. The text
+// columns are compared on their weight_string, which is binary, and
+// the planner could not request it for this column: comparing the
+// values would not follow the collation of the column.
+func (d *Distinct) checkKeys(fields []*querypb.Field) error {
+	for _, key := range d.Keys {
+		if key < len(fields) && sqltypes.IsText(fields[key].Type) {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: distinct on the text column %s, whose collation is unknown", fields[key].Name)
+		}
+	}
+	return nil
+}
+
+// GetFields satisfies the Primitive interface.
+func (d *Distinct) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := d.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(d.TruncateColumnCount), nil
+}
+
+// distinctSet tracks the distinct rows. It keeps the keys of at most
+// maxRows rows in memory, within the memory budget of the query, and
+// spills the rows it can't check after that to files partitioned by
+// the hash of their keys, so that the duplicates of a row are all in
+// the same file.
+type distinctSet struct {
+	keys    []int
+	maxRows int
+	memory  *MemoryTracker
+	held    int64
+	seen    map[string]struct{}
+	spilled []*spillFile
+	buf     []byte
+}
+
+func newDistinctSet(keys []int, maxRows int, memory *MemoryTracker) *distinctSet {
+	return &distinctSet{
+		keys:    keys,
+		maxRows: maxRows,
+		memory:  memory,
+		seen:    make(map[string]struct{}),
+	}
+}
+
+// add returns true if the row is not a duplicate of the rows seen so
+// far. Once the set is full, the rows that are not already in the set
+// are spilled, and returned by flush if they're distinct.
+func (ds *distinctSet) add(row []sqltypes.Value) (bool, error) {
+	ds.buf = appendKey(ds.buf[:0], ds.keys, row)
+	if _, ok := ds.seen[string(ds.buf)]; ok {
+		return false, nil
+	}
+	if len(ds.seen) < ds.maxRows {
+		size := keyOverhead + int64(len(ds.buf))
+		if ds.memory.Grow(size) == nil {
+			ds.held += size
+			ds.seen[string(ds.buf)] = struct{}{}
+			return true, nil
+		}
+	}
+	if err := ds.spill(ds.buf, row); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+func (ds *distinctSet) spill(key []byte, row []sqltypes.Value) error {
+	if ds.spilled == nil {
+		ds.spilled = make([]*spillFile, distinctSpillPartitions)
+	}
+	return spillPartition(ds.spilled, "vtgate-distinct", key, row)
+}
+
+// flush returns the distinct spilled rows, one partition at a time.
+// Each partition is deduplicated in memory, within the same limit.
+func (ds *distinctSet) flush(callback func([][]sqltypes.Value) error) error {
+	// The keys in memory are not needed anymore: the spilled rows
+	// are not duplicates of them.
+	ds.seen = nil
+	for _, sf := range ds.spilled {
+		if sf == nil {
+			continue
+		}
+		seen := make(map[string]struct{})
+		var rows [][]sqltypes.Value
+		err := sf.readAll(func(row []sqltypes.Value) error {
+			ds.buf = appendKey(ds.buf[:0], ds.keys, row)
+			if _, ok := seen[string(ds.buf)]; ok {
+				return nil
+			}
+			if len(seen) >= ds.maxRows {
+				return fmt.Errorf("in-memory row count exceeded allowed limit of %d", ds.maxRows)
+			}
+			seen[string(ds.buf)] = struct{}{}
+			rows = append(rows, row)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		if err := callback(rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close removes the spill files.
+func (ds *distinctSet) close() {
+	for _, sf := range ds.spilled {
+		if sf != nil {
+			sf.close()
+		}
+	}
+	ds.spilled = nil
+	ds.memory.Shrink(ds.held)
+	ds.held = 0
+}
+
+// appendKey appends the key of a row to buf. Each value is prefixed
+// with its length, or -1 for NULL, so that the keys of different
+// rows never collide.
+func appendKey(buf []byte, keys []int, row []sqltypes.Value) []byte {
+	for _, key := range keys {
+		v := row[key]
+		if v.IsNull() {
+			buf = appendVarint(buf, -1)
+			continue
+		}
+		raw := v.Raw()
+		buf = appendVarint(buf, int64(len(raw)))
+		buf = append(buf, raw...)
+	}
+	return buf
+}
+
+func appendVarint(buf []byte, v int64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestDistinctExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2|weight_string(col2)",
+		"int64|varchar|varbinary",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a|A",
+			"1|A|A",
+			"2|a|A",
+			"null|b|B",
+			"1|a|A",
+			"null|B|B",
+		)},
+	}
+
+	d := &Distinct{
+		Keys:                []int{0, 2},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	result, err := d.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"1|a",
+		"2|a",
+		"null|b",
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("d.Execute:\n%v, want\n%v", result, wantResult)
+	}
+	// The input is streamed, and asked for the fields.
+	wantLog := []string{"StreamExecute  true"}
+	if !reflect.DeepEqual(fp.log, wantLog) {
+		t.Errorf("fp.log: %v, want %v", fp.log, wantLog)
+	}
+
+	// In a transaction, the input is executed.
+	fp.rewind()
+	result, err = d.Execute(&loggingVCursor{inTransaction: true}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("d.Execute(in transaction):\n%v, want\n%v", result, wantResult)
+	}
+	wantLog = []string{"Execute  true"}
+	if !reflect.DeepEqual(fp.log, wantLog) {
+		t.Errorf("fp.log: %v, want %v", fp.log, wantLog)
+	}
+
+	fp.rewind()
+	result, err = wrapStreamExecute(d, noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("d.StreamExecute:\n%v, want\n%v", result, wantResult)
+	}
+}
+
+func TestDistinctTextKey(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"int64|varchar",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a",
+			"1|A",
+		)},
+	}
+	d := &Distinct{
+		Keys:  []int{0, 1},
+		Input: fp,
+	}
+
+	want := "unsupported: distinct on the text column col2, whose collation is unknown"
+	_, err := d.Execute(noopVCursor{}, nil, false)
+	if err == nil || err.Error() != want {
+		t.Errorf("d.Execute err: %v, want %v", err, want)
+	}
+	fp.rewind()
+	_, err = d.Execute(&loggingVCursor{inTransaction: true}, nil, false)
+	if err == nil || err.Error() != want {
+		t.Errorf("d.Execute(in transaction) err: %v, want %v", err, want)
+	}
+}
+
+func TestDistinctSpill(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 3
+	defer func() { testMaxMemoryRows = save }()
+
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"int64|varbinary",
+	)
+	// Each distinct row is repeated, and spilled once the first
+	// three are in memory.
+	var rows, wantRows []string
+	for i := 0; i < 10; i++ {
+		wantRows = append(wantRows, fmt.Sprintf("%d|a%d", i, i))
+	}
+	wantRows = append(wantRows, "10|null")
+	rows = append(rows, wantRows...)
+	rows = append(rows, wantRows...)
+
+	for _, stream := range []bool{false, true} {
+		fp := &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows...)},
+		}
+		d := &Distinct{
+			Keys:  []int{0, 1},
+			Input: fp,
+		}
+		var result *sqltypes.Result
+		var err error
+		if stream {
+			result, err = wrapStreamExecute(d, noopVCursor{}, nil, false)
+		} else {
+			result, err = d.Execute(noopVCursor{}, nil, false)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The spilled rows come after the rows kept in memory,
+		// in no particular order.
+		sortRows(result.Rows)
+		wantResult := sqltypes.MakeTestResult(fields, wantRows...)
+		sortRows(wantResult.Rows)
+		if !reflect.DeepEqual(result, wantResult) {
+			t.Errorf("d.Execute(stream: %v):\n%v, want\n%v", stream, result, wantResult)
+		}
+	}
+}
+
+func TestDistinctSpillMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 1
+	defer func() { testMaxMemoryRows = save }()
+
+	fields := sqltypes.MakeTestFields(
+		"col1",
+		"int64",
+	)
+	var rows []string
+	for i := 0; i < 100; i++ {
+		rows = append(rows, fmt.Sprintf("%d", i))
+	}
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows...)},
+	}
+	d := &Distinct{
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	_, err := d.Execute(noopVCursor{}, nil, false)
+	want := "in-memory row count exceeded allowed limit of 1"
+	if err == nil || err.Error() != want {
+		t.Errorf("d.Execute err: %v, want %v", err, want)
+	}
+}
+
+func TestDistinctSpillMemoryBudget(t *testing.T) {
+	save := testMemoryTracker
+	// The budget holds the keys of a few rows only.
+	testMemoryTracker = NewMemoryPool(0).NewTracker(100)
+	defer func() { testMemoryTracker = save }()
+
+	fields := sqltypes.MakeTestFields(
+		"col1",
+		"int64",
+	)
+	var rows, wantRows []string
+	for i := 0; i < 20; i++ {
+		wantRows = append(wantRows, fmt.Sprintf("%d", i))
+	}
+	rows = append(rows, wantRows...)
+	rows = append(rows, wantRows...)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows...)},
+	}
+	d := &Distinct{
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	result, err := d.Execute(noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sortRows(result.Rows)
+	wantResult := sqltypes.MakeTestResult(fields, wantRows...)
+	sortRows(wantResult.Rows)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("d.Execute:\n%v, want\n%v", result, wantResult)
+	}
+	if used := testMemoryTracker.Used(); used != 0 {
+		t.Errorf("Used: %d, want 0", used)
+	}
+}
+
+func TestDistinctInputError(t *testing.T) {
+	fp := &fakePrimitive{
+		sendErr: errors.New("err"),
+	}
+	d := &Distinct{
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	_, err := d.Execute(noopVCursor{}, nil, false)
+	expectError(t, "d.Execute", err, "err")
+
+	_, err = wrapStreamExecute(d, noopVCursor{}, nil, false)
+	expectError(t, "d.StreamExecute", err, "err")
+}
+
+func sortRows(rows [][]sqltypes.Value) {
+	sort.Slice(rows, func(i, j int) bool {
+		return fmt.Sprint(rows[i]) < fmt.Sprint(rows[j])
+	})
+}
@@ -37,6 +37,10 @@ import (
 
 var testMaxMemoryRows = 100
 
+var testMemoryTracker *MemoryTracker
+
+var testSpillToDisk bool
+
 // noopVCursor is used to build other vcursors.
 type noopVCursor struct {
 }
@@ -49,6 +53,18 @@ func (t noopVCursor) MaxMemoryRows() int {
 	return testMaxMemoryRows
 }
 
+func (t noopVCursor) MemoryTracker() *MemoryTracker {
+	return testMemoryTracker
+}
+
+func (t noopVCursor) SpillToDisk() bool {
+	return testSpillToDisk
+}
+
+func (t noopVCursor) InTransaction() bool {
+	return false
+}
+
 func (t noopVCursor) SetContextTimeout(timeout time.Duration) context.CancelFunc {
 	return func() {}
 }
@@ -93,6 +109,8 @@ type loggingVCursor struct {
 	shardForKsid    []string
 	curShardForKsid int
 	shardErr        error
+	// keyspaceErrs are the errors of ResolveDestinations by keyspace.
+	keyspaceErrs map[string]error
 
 	results   []*sqltypes.Result
 	curResult int
@@ -105,12 +123,18 @@ type loggingVCursor struct {
 	multiShardErrs []error
 
 	log []string
+
+	inTransaction bool
 }
 
 func (f *loggingVCursor) Context() context.Context {
 	return context.Background()
 }
 
+func (f *loggingVCursor) InTransaction() bool {
+	return f.inTransaction
+}
+
 func (f *loggingVCursor) SetContextTimeout(timeout time.Duration) context.CancelFunc {
 	return func() {}
 }
@@ -168,6 +192,9 @@ func (f *loggingVCursor) ResolveDestinations(keyspace string, ids []*querypb.Val
 	if f.shardErr != nil {
 		return nil, nil, f.shardErr
 	}
+	if err := f.keyspaceErrs[keyspace]; err != nil {
+		return nil, nil, err
+	}
 
 	var rss []*srvtopo.ResolvedShard
 	var values [][]*querypb.Value
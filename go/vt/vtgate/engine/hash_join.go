@@ -0,0 +1,412 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// hashJoinSpillPartitions is the number of files each side of a hash
+// join is spilled to once the hash table doesn't fit in memory.
+const hashJoinSpillPartitions = 16
+
+// HashJoin is a primitive that joins the rows of its inputs on the
+// equality of their keys. Unlike Join, the inputs are executed once
+// each: the rows of Right are loaded in a hash table, which the rows
+// of Left are looked up in. The keys are the input columns or the
+// weight_string of the text columns, so that the collation of the
+// columns is followed. NULL keys never match.
+// At most max_memory_rows rows are kept in the hash table, within the
+// memory budget of the query. Beyond that, if the query can spill to
+// disk, the rows of both inputs are written to temporary files
+// partitioned by the hash of their keys, and the partitions are
+// joined one at a time once the inputs are done.
+type HashJoin struct {
+	Opcode JoinOpcode
+	// Left and Right are the LHS and RHS primitives
+	// of the HashJoin. They can be any primitive.
+	Left, Right Primitive
+
+	// LeftKeys and RightKeys are the columns of the left and right
+	// rows that are compared, in the same order.
+	LeftKeys, RightKeys []int
+
+	// Cols defines which columns from the left or right results
+	// are returned, as for Join.
+	Cols []int
+}
+
+// MarshalJSON serializes the HashJoin into a JSON representation.
+// It's used for testing and diagnostics.
+func (hj *HashJoin) MarshalJSON() ([]byte, error) {
+	marshalHashJoin := struct {
+		Opcode    string
+		JoinType  JoinOpcode
+		LeftKeys  []int
+		RightKeys []int
+		Cols      []int
+		Left      Primitive
+		Right     Primitive
+	}{
+		Opcode:    "HashJoin",
+		JoinType:  hj.Opcode,
+		LeftKeys:  hj.LeftKeys,
+		RightKeys: hj.RightKeys,
+		Cols:      hj.Cols,
+		Left:      hj.Left,
+		Right:     hj.Right,
+	}
+	return json.Marshal(marshalHashJoin)
+}
+
+// RouteType returns a description of the query routing type used by the primitive.
+func (hj *HashJoin) RouteType() string {
+	return "HashJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
+
+// Execute satisfies the Primitive interface.
+func (hj *HashJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rresult, err := hj.Right.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	lresult, err := hj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	ht := newHashTable(hj, vcursor)
+	defer ht.close()
+	if err := ht.build(rresult.Rows); err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{}
+	if wantfields {
+		result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+	}
+	add := func(rows [][]sqltypes.Value) error {
+		result.Rows = append(result.Rows, rows...)
+		if err := vcursor.MemoryTracker().Grow(rowsMemory(rows)); err != nil {
+			return err
+		}
+		if len(result.Rows) > vcursor.MaxMemoryRows() {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	}
+	if err := ht.probe(lresult.Rows, add); err != nil {
+		return nil, err
+	}
+	if err := ht.flush(add); err != nil {
+		return nil, err
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (hj *HashJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	ht := newHashTable(hj, vcursor)
+	defer ht.close()
+
+	var rfields []*querypb.Field
+	err := hj.Right.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			rfields = qr.Fields
+		}
+		return ht.build(qr.Rows)
+	})
+	if err != nil {
+		return err
+	}
+	send := func(rows [][]sqltypes.Value) error {
+		return callback(&sqltypes.Result{Rows: rows})
+	}
+	err = hj.Left.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		if wantfields && len(qr.Fields) != 0 {
+			if err := callback(&sqltypes.Result{Fields: joinFields(qr.Fields, rfields, hj.Cols)}); err != nil {
+				return err
+			}
+		}
+		return ht.probe(qr.Rows, send)
+	})
+	if err != nil {
+		return err
+	}
+	return ht.flush(send)
+}
+
+// GetFields satisfies the Primitive interface.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, hj.Cols)}, nil
+}
+
+// hashTable holds the right rows of a HashJoin by key. Once it's
+// full, it spills the right rows, and then the left rows, to files
+// partitioned by the hash of their keys, so that the rows that match
+// are in the same partition on both sides.
+type hashTable struct {
+	hj      *HashJoin
+	vcursor VCursor
+	memory  *MemoryTracker
+	held    int64
+	count   int
+	rows    map[string][][]sqltypes.Value
+	release func()
+	right   []*spillFile
+	left    []*spillFile
+	buf     []byte
+}
+
+func newHashTable(hj *HashJoin, vcursor VCursor) *hashTable {
+	return &hashTable{
+		hj:      hj,
+		vcursor: vcursor,
+		memory:  vcursor.MemoryTracker(),
+		rows:    make(map[string][][]sqltypes.Value),
+	}
+}
+
+// build adds right rows to the table. The rows with a NULL key are
+// dropped: they can't match.
+func (ht *hashTable) build(rows [][]sqltypes.Value) error {
+	for _, row := range rows {
+		if hasNullKey(ht.hj.RightKeys, row) {
+			continue
+		}
+		ht.buf = appendKey(ht.buf[:0], ht.hj.RightKeys, row)
+		if ht.right != nil {
+			if err := spillPartition(ht.right, "vtgate-hash-join", ht.buf, row); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := ht.add(row); err != nil {
+			if err := ht.spill(err); err != nil {
+				return err
+			}
+			if err := spillPartition(ht.right, "vtgate-hash-join", ht.buf, row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// add adds a right row of key ht.buf to the table in memory.
+func (ht *hashTable) add(row []sqltypes.Value) error {
+	if ht.count >= ht.vcursor.MaxMemoryRows() {
+		return fmt.Errorf("in-memory row count exceeded allowed limit of %d", ht.vcursor.MaxMemoryRows())
+	}
+	size := rowMemory(row)
+	if _, ok := ht.rows[string(ht.buf)]; !ok {
+		size += keyOverhead + int64(len(ht.buf))
+	}
+	if err := ht.memory.Grow(size); err != nil {
+		return err
+	}
+	ht.held += size
+	ht.count++
+	ht.rows[string(ht.buf)] = append(ht.rows[string(ht.buf)], row)
+	return nil
+}
+
+// spill moves the rows in memory to the right partitions, or returns
+// cause if the query can't spill to disk.
+func (ht *hashTable) spill(cause error) error {
+	if !ht.vcursor.SpillToDisk() {
+		return cause
+	}
+	release, err := acquireSpill()
+	if err != nil {
+		return err
+	}
+	ht.release = release
+	ht.right = make([]*spillFile, hashJoinSpillPartitions)
+	ht.left = make([]*spillFile, hashJoinSpillPartitions)
+	for key, rows := range ht.rows {
+		for _, row := range rows {
+			if err := spillPartition(ht.right, "vtgate-hash-join", []byte(key), row); err != nil {
+				return err
+			}
+		}
+	}
+	ht.clear()
+	return nil
+}
+
+// probe joins left rows with the table, and sends the joined rows to
+// callback. Once the table is spilled, the left rows are spilled too,
+// and joined by flush.
+func (ht *hashTable) probe(rows [][]sqltypes.Value, callback func([][]sqltypes.Value) error) error {
+	var out [][]sqltypes.Value
+	for _, lrow := range rows {
+		if hasNullKey(ht.hj.LeftKeys, lrow) {
+			if ht.hj.Opcode == LeftJoin {
+				out = append(out, joinRows(lrow, nil, ht.hj.Cols))
+			}
+			continue
+		}
+		ht.buf = appendKey(ht.buf[:0], ht.hj.LeftKeys, lrow)
+		if ht.left != nil {
+			if err := spillPartition(ht.left, "vtgate-hash-join", ht.buf, lrow); err != nil {
+				return err
+			}
+			continue
+		}
+		out = ht.join(out, lrow)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return callback(out)
+}
+
+// join appends the rows of lrow joined with the matching rows of key
+// ht.buf to out.
+func (ht *hashTable) join(out [][]sqltypes.Value, lrow []sqltypes.Value) [][]sqltypes.Value {
+	matches := ht.rows[string(ht.buf)]
+	for _, rrow := range matches {
+		out = append(out, joinRows(lrow, rrow, ht.hj.Cols))
+	}
+	if len(matches) == 0 && ht.hj.Opcode == LeftJoin {
+		out = append(out, joinRows(lrow, nil, ht.hj.Cols))
+	}
+	return out
+}
+
+// flush joins the spilled rows, one partition at a time. The right
+// rows of each partition are loaded in memory, within the same limits.
+func (ht *hashTable) flush(callback func([][]sqltypes.Value) error) error {
+	if ht.right == nil {
+		return nil
+	}
+	for i := range ht.right {
+		ht.clear()
+		if ht.right[i] != nil {
+			err := ht.right[i].readAll(func(row []sqltypes.Value) error {
+				ht.buf = appendKey(ht.buf[:0], ht.hj.RightKeys, row)
+				return ht.add(row)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if ht.left[i] == nil {
+			continue
+		}
+		var out [][]sqltypes.Value
+		err := ht.left[i].readAll(func(lrow []sqltypes.Value) error {
+			ht.buf = appendKey(ht.buf[:0], ht.hj.LeftKeys, lrow)
+			out = ht.join(out, lrow)
+			if len(out) < sortMergeBatchSize {
+				return nil
+			}
+			err := callback(out)
+			out = nil
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if len(out) != 0 {
+			if err := callback(out); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// clear empties the table in memory.
+func (ht *hashTable) clear() {
+	ht.rows = make(map[string][][]sqltypes.Value)
+	ht.count = 0
+	ht.memory.Shrink(ht.held)
+	ht.held = 0
+}
+
+// close removes the spill files, and releases the spill slot.
+func (ht *hashTable) close() {
+	for _, sf := range append(ht.right, ht.left...) {
+		if sf != nil {
+			sf.close()
+		}
+	}
+	ht.right, ht.left = nil, nil
+	if ht.release != nil {
+		ht.release()
+		ht.release = nil
+	}
+	ht.rows = nil
+	ht.memory.Shrink(ht.held)
+	ht.held = 0
+}
+
+// spillPartition writes a row to the partition of its key, and creates
+// the file of the partition if needed.
+func spillPartition(partitions []*spillFile, prefix string, key []byte, row []sqltypes.Value) error {
+	h := fnv.New32a()
+	h.Write(key)
+	partition := h.Sum32() % uint32(len(partitions))
+	if partitions[partition] == nil {
+		sf, err := newSpillFile(prefix)
+		if err != nil {
+			return err
+		}
+		partitions[partition] = sf
+	}
+	return partitions[partition].write(row)
+}
+
+// hasNullKey returns true if one of the keys of the row is NULL.
+func hasNullKey(keys []int, row []sqltypes.Value) bool {
+	for _, key := range keys {
+		if row[key].IsNull() {
+			return true
+		}
+	}
+	return false
+}
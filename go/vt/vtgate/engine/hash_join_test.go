@@ -0,0 +1,319 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+				"null|d",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+				"1|x",
+				"3|y",
+				"3|z",
+				"null|w",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		LeftKeys:  []int{0},
+		RightKeys: []int{0},
+		Cols:      []int{-1, -2, 2},
+	}
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The inputs are executed once each, with the same bind vars.
+	leftPrim.ExpectLog(t, []string{`Execute  true`})
+	rightPrim.ExpectLog(t, []string{`Execute  true`})
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4",
+			"int64|varchar|varchar",
+		),
+		"1|a|x",
+		"3|c|y",
+		"3|c|z",
+	)
+	if !reflect.DeepEqual(r, wantResult) {
+		t.Errorf("hj.Execute:\n%v, want\n%v", r, wantResult)
+	}
+
+	// Left Join.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	hj.Opcode = LeftJoin
+	r, err = hj.Execute(noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult = sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4",
+			"int64|varchar|varchar",
+		),
+		"1|a|x",
+		"2|b|null",
+		"3|c|y",
+		"3|c|z",
+		"null|d|null",
+	)
+	if !reflect.DeepEqual(r, wantResult) {
+		t.Errorf("hj.Execute:\n%v, want\n%v", r, wantResult)
+	}
+}
+
+func TestHashJoinStreamExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|weight_string(col2)",
+					"int64|varchar|varbinary",
+				),
+				"1|a|A",
+				"2|b|B",
+				"3|C|C",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4|weight_string(col4)",
+					"int64|varchar|varbinary",
+				),
+				"4|A|A",
+				"5|c|C",
+				"6|d|D",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:    LeftJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		LeftKeys:  []int{2},
+		RightKeys: []int{2},
+		Cols:      []int{-1, -2, 1},
+	}
+	r, err := wrapStreamExecute(hj, noopVCursor{}, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leftPrim.ExpectLog(t, []string{`StreamExecute  true`})
+	rightPrim.ExpectLog(t, []string{`StreamExecute  true`})
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col3",
+			"int64|varchar|int64",
+		),
+		"1|a|4",
+		"2|b|null",
+		"3|C|5",
+	)
+	if !reflect.DeepEqual(r, wantResult) {
+		t.Errorf("hj.StreamExecute:\n%v, want\n%v", r, wantResult)
+	}
+}
+
+func TestHashJoinGetFields(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+			),
+		},
+	}
+	hj := &HashJoin{
+		Opcode:    NormalJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		LeftKeys:  []int{0},
+		RightKeys: []int{0},
+		Cols:      []int{-1, -2, 1, 2},
+	}
+	r, err := hj.GetFields(noopVCursor{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col3|col4",
+			"int64|varchar|int64|varchar",
+		),
+	)
+	if !reflect.DeepEqual(r, wantResult) {
+		t.Errorf("hj.GetFields:\n%v, want\n%v", r, wantResult)
+	}
+}
+
+func TestHashJoinMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 3
+	defer func() { testMaxMemoryRows = save }()
+
+	fields := sqltypes.MakeTestFields(
+		"col1",
+		"int64",
+	)
+	hj := &HashJoin{
+		Opcode: NormalJoin,
+		Left: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1")},
+		},
+		Right: &fakePrimitive{
+			results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1", "2", "3", "4")},
+		},
+		LeftKeys:  []int{0},
+		RightKeys: []int{0},
+		Cols:      []int{-1, 1},
+	}
+	_, err := wrapStreamExecute(hj, noopVCursor{}, nil, false)
+	want := "in-memory row count exceeded allowed limit of 3"
+	if err == nil || err.Error() != want {
+		t.Errorf("StreamExecute err: %v, want %v", err, want)
+	}
+}
+
+func TestHashJoinSpillToDisk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hash_join_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	InitSpill(dir, 1)
+	defer InitSpill("", DefaultSpillConcurrency)
+
+	saveTracker, saveSpill := testMemoryTracker, testSpillToDisk
+	// The budget holds a few rows only.
+	testMemoryTracker = NewMemoryPool(0).NewTracker(1000)
+	testSpillToDisk = true
+	defer func() { testMemoryTracker, testSpillToDisk = saveTracker, saveSpill }()
+
+	lfields := sqltypes.MakeTestFields(
+		"id|l",
+		"int64|varchar",
+	)
+	rfields := sqltypes.MakeTestFields(
+		"id|r",
+		"int64|varchar",
+	)
+	var lrows, rrows, wantRows []string
+	for i := 0; i < 30; i++ {
+		lrows = append(lrows, fmt.Sprintf("%d|l%d", i, i))
+		if i%3 == 0 {
+			wantRows = append(wantRows, fmt.Sprintf("%d|l%d|", i, i))
+			continue
+		}
+		rrows = append(rrows, fmt.Sprintf("%d|r%d", i, i))
+		wantRows = append(wantRows, fmt.Sprintf("%d|l%d|r%d", i, i, i))
+	}
+	lrows = append(lrows, "null|lnull")
+	rrows = append(rrows, "null|rnull")
+	wantRows = append(wantRows, "|lnull|")
+	leftPrim := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(lfields, lrows...)}}
+	rightPrim := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(rfields, rrows...)}}
+	hj := &HashJoin{
+		Opcode:    LeftJoin,
+		Left:      leftPrim,
+		Right:     rightPrim,
+		LeftKeys:  []int{0},
+		RightKeys: []int{0},
+		Cols:      []int{-1, -2, 2},
+	}
+
+	result, err := wrapStreamExecute(hj, noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The partitions are joined in the order of the hash of their keys,
+	// and NULL is printed as an empty string.
+	var got []string
+	for _, row := range result.Rows {
+		got = append(got, fmt.Sprintf("%s|%s|%s", row[0].ToString(), row[1].ToString(), row[2].ToString()))
+	}
+	sort.Strings(got)
+	sort.Strings(wantRows)
+	if !reflect.DeepEqual(got, wantRows) {
+		t.Errorf("StreamExecute:\n%v, want\n%v", got, wantRows)
+	}
+
+	if used := testMemoryTracker.Used(); used != 0 {
+		t.Errorf("Used: %d, want 0", used)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("spill files were not removed: %d left", len(files))
+	}
+
+	// Without spilling, the hash table exceeds the budget.
+	testSpillToDisk = false
+	leftPrim.rewind()
+	rightPrim.rewind()
+	if _, err := wrapStreamExecute(hj, noopVCursor{}, nil, false); err == nil {
+		t.Error("StreamExecute: nil error, want over budget")
+	}
+}
@@ -79,6 +79,7 @@ func (jn *Join) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariab
 			wantfields = false
 			result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
 		}
+		joined := len(result.Rows)
 		for _, rrow := range rresult.Rows {
 			result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
 		}
@@ -88,6 +89,9 @@ func (jn *Join) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariab
 		} else {
 			result.RowsAffected += uint64(len(rresult.Rows))
 		}
+		if err := vcursor.MemoryTracker().Grow(rowsMemory(result.Rows[joined:])); err != nil {
+			return nil, err
+		}
 		if len(result.Rows) > vcursor.MaxMemoryRows() {
 			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
 		}
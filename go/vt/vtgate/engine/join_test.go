@@ -186,6 +186,56 @@ func TestJoinExecuteMaxMemoryRows(t *testing.T) {
 	}
 }
 
+func TestJoinExecuteMemoryBudget(t *testing.T) {
+	save := testMemoryTracker
+	testMemoryTracker = NewMemoryPool(0).NewTracker(300)
+	defer func() { testMemoryTracker = save }()
+
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|varchar",
+				),
+				"1|a|aa",
+				"2|b|bb",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"col4|col5|col6",
+		"int64|varchar|varchar",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				rightFields,
+				"4|d|dd",
+				"5|e|ee",
+			),
+			sqltypes.MakeTestResult(
+				rightFields,
+				"6|f|ff",
+			),
+		},
+	}
+	jn := &Join{
+		Opcode: NormalJoin,
+		Left:   leftPrim,
+		Right:  rightPrim,
+		Cols:   []int{-1, -2, 1, 2},
+		Vars: map[string]int{
+			"bv": 1,
+		},
+	}
+	_, err := jn.Execute(noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	want := "query memory budget exceeded: the query needs more than 300 bytes"
+	if err == nil || err.Error() != want {
+		t.Errorf("Execute(): %v, want %v", err, want)
+	}
+}
+
 func TestJoinExecuteNoResult(t *testing.T) {
 	leftPrim := &fakePrimitive{
 		results: []*sqltypes.Result{
@@ -0,0 +1,165 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"unsafe"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The estimated sizes of the structures that hold a row, added to the
+// bytes of its values.
+const (
+	valueOverhead = int64(unsafe.Sizeof(sqltypes.Value{}))
+	rowOverhead   = int64(unsafe.Sizeof([]sqltypes.Value{}))
+	keyOverhead   = int64(unsafe.Sizeof(""))
+)
+
+// MemoryPool is the memory budget shared by all the queries of a
+// vtgate. A limit of 0 means the memory is not limited.
+type MemoryPool struct {
+	limit sync2.AtomicInt64
+	used  sync2.AtomicInt64
+}
+
+// NewMemoryPool creates a MemoryPool of limit bytes.
+func NewMemoryPool(limit int64) *MemoryPool {
+	mp := &MemoryPool{}
+	mp.limit.Set(limit)
+	return mp
+}
+
+// SetLimit changes the limit of the pool. The queries that already
+// hold more than the new limit keep their memory, but can't grow.
+func (mp *MemoryPool) SetLimit(limit int64) {
+	mp.limit.Set(limit)
+}
+
+// Used returns the bytes held by the running queries.
+func (mp *MemoryPool) Used() int64 {
+	return mp.used.Get()
+}
+
+// NewTracker returns the MemoryTracker of a query, which can hold up
+// to limit bytes. A limit of 0 means the query is only limited by the
+// pool.
+func (mp *MemoryPool) NewTracker(limit int64) *MemoryTracker {
+	return &MemoryTracker{
+		pool:  mp,
+		limit: limit,
+	}
+}
+
+// MemoryTracker accounts for the bytes a query buffers at vtgate, like
+// the rows of the results, of the sorts and of the joins, against the
+// budget of the query and the budget of its pool. A nil MemoryTracker
+// doesn't account for anything.
+type MemoryTracker struct {
+	pool  *MemoryPool
+	limit int64
+	used  sync2.AtomicInt64
+}
+
+// Grow accounts for n more bytes held by the query. If the query or
+// the pool would exceed its budget, the bytes are not accounted for
+// and a RESOURCE_EXHAUSTED error is returned.
+func (mt *MemoryTracker) Grow(n int64) error {
+	if mt == nil || n <= 0 {
+		return nil
+	}
+	if used := mt.used.Add(n); mt.limit > 0 && used > mt.limit {
+		mt.used.Add(-n)
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query memory budget exceeded: the query needs more than %d bytes", mt.limit)
+	}
+	if mt.pool == nil {
+		return nil
+	}
+	if used, limit := mt.pool.used.Add(n), mt.pool.limit.Get(); limit > 0 && used > limit {
+		mt.pool.used.Add(-n)
+		mt.used.Add(-n)
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "vtgate memory budget exceeded: the running queries need more than %d bytes", limit)
+	}
+	return nil
+}
+
+// Shrink accounts for n bytes the query doesn't hold anymore.
+func (mt *MemoryTracker) Shrink(n int64) {
+	if mt == nil || n <= 0 {
+		return
+	}
+	mt.used.Add(-n)
+	if mt.pool != nil {
+		mt.pool.used.Add(-n)
+	}
+}
+
+// Used returns the bytes held by the query.
+func (mt *MemoryTracker) Used() int64 {
+	if mt == nil {
+		return 0
+	}
+	return mt.used.Get()
+}
+
+// Release returns all the bytes held by the query to the pool. It must
+// be called when the query is done.
+func (mt *MemoryTracker) Release() {
+	if mt == nil {
+		return
+	}
+	for {
+		used := mt.used.Get()
+		if mt.used.CompareAndSwap(used, 0) {
+			if mt.pool != nil {
+				mt.pool.used.Add(-used)
+			}
+			return
+		}
+	}
+}
+
+// rowMemory returns the estimated bytes held by a row.
+func rowMemory(row []sqltypes.Value) int64 {
+	size := rowOverhead
+	for _, v := range row {
+		size += valueOverhead + int64(len(v.Raw()))
+	}
+	return size
+}
+
+// rowsMemory returns the estimated bytes held by the rows.
+func rowsMemory(rows [][]sqltypes.Value) int64 {
+	var size int64
+	for _, row := range rows {
+		size += rowMemory(row)
+	}
+	return size
+}
+
+// ResultMemory returns the estimated bytes held by the rows of a
+// result.
+func ResultMemory(qr *sqltypes.Result) int64 {
+	if qr == nil {
+		return 0
+	}
+	return rowsMemory(qr.Rows)
+}
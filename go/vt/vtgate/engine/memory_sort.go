@@ -20,6 +20,7 @@ import (
 	"container/heap"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"sort"
 
@@ -91,6 +92,7 @@ func (ms *MemorySort) Execute(vcursor VCursor, bindVars map[string]*querypb.Bind
 	}
 	sh := &sortHeap{
 		rows:    result.Rows,
+		fields:  result.Fields,
 		orderBy: ms.OrderBy,
 	}
 	sort.Sort(sh)
@@ -122,20 +124,67 @@ func (ms *MemorySort) StreamExecute(vcursor VCursor, bindVars map[string]*queryp
 		orderBy: ms.OrderBy,
 		reverse: true,
 	}
+	// The rows of the heap are held until the sorted rows are sent.
+	memory := vcursor.MemoryTracker()
+	var held int64
+	defer func() { memory.Shrink(held) }()
+	// If the query can spill to disk, the rows of the heap are written
+	// to a sorted run instead of exceeding the memory budget, and the
+	// runs are merged at the end.
+	var runs *sortRuns
+	defer func() { runs.close() }()
+	spill := func(cause error) error {
+		if !vcursor.SpillToDisk() {
+			return cause
+		}
+		if runs == nil {
+			release, err := acquireSpill()
+			if err != nil {
+				return err
+			}
+			runs = &sortRuns{release: release}
+		}
+		sh.reverse = false
+		sort.Sort(sh)
+		sh.reverse = true
+		if sh.err != nil {
+			return sh.err
+		}
+		if err := runs.add(sh.rows); err != nil {
+			return err
+		}
+		sh.rows = nil
+		memory.Shrink(held)
+		held = 0
+		return nil
+	}
 	err = ms.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
+			sh.fields = qr.Fields
 			if err := cb(&sqltypes.Result{Fields: qr.Fields}); err != nil {
 				return err
 			}
 		}
 		for _, row := range qr.Rows {
+			size := rowMemory(row)
+			if err := memory.Grow(size); err != nil {
+				if err := spill(err); err != nil {
+					return err
+				}
+				if err := memory.Grow(size); err != nil {
+					return err
+				}
+			}
+			held += size
 			heap.Push(sh, row)
-		}
-		for len(sh.rows) > count {
-			_ = heap.Pop(sh)
+			for len(sh.rows) > count {
+				size := rowMemory(heap.Pop(sh).([]sqltypes.Value))
+				memory.Shrink(size)
+				held -= size
+			}
 		}
 		if len(sh.rows) > vcursor.MaxMemoryRows() {
-			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+			return spill(fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows()))
 		}
 		return nil
 	})
@@ -152,6 +201,9 @@ func (ms *MemorySort) StreamExecute(vcursor VCursor, bindVars map[string]*queryp
 		// Unreachable.
 		return sh.err
 	}
+	if runs != nil {
+		return runs.merge(sh, count, cb)
+	}
 	return cb(&sqltypes.Result{Rows: sh.rows})
 }
 
@@ -183,6 +235,7 @@ func (ms *MemorySort) fetchCount(bindVars map[string]*querypb.BindVariable) (int
 // Implementation is similar to scatterHeap
 type sortHeap struct {
 	rows    [][]sqltypes.Value
+	fields  []*querypb.Field
 	orderBy []OrderbyParams
 	reverse bool
 	err     error
@@ -199,7 +252,7 @@ func (sh *sortHeap) Less(i, j int) bool {
 		if sh.err != nil {
 			return true
 		}
-		cmp, err := sqltypes.NullsafeCompare(sh.rows[i][order.Col], sh.rows[j][order.Col])
+		cmp, err := compareColumn(sh.fields, sh.rows[i], sh.rows[j], order.Col)
 		if err != nil {
 			sh.err = err
 			return true
@@ -242,3 +295,152 @@ func (sh *sortHeap) Pop() interface{} {
 	sh.rows = sh.rows[:n-1]
 	return x
 }
+
+// sortMergeBatchSize is the number of rows sent at a time by the merge
+// of the sorted runs.
+const sortMergeBatchSize = 1000
+
+// sortRuns are the sorted runs of rows a sort spilled to disk.
+type sortRuns struct {
+	release func()
+	files   []*spillFile
+}
+
+// add writes the sorted rows to a new run.
+func (sr *sortRuns) add(rows [][]sqltypes.Value) error {
+	sf, err := newSpillFile("vtgate-sort")
+	if err != nil {
+		return err
+	}
+	sr.files = append(sr.files, sf)
+	for _, row := range rows {
+		if err := sf.write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// merge sends the first count rows of the runs and of the sorted rows
+// of the heap to the callback, in order.
+func (sr *sortRuns) merge(sh *sortHeap, count int, callback func(*sqltypes.Result) error) error {
+	mh := &mergeHeap{
+		sortHeap: sortHeap{
+			fields:  sh.fields,
+			orderBy: sh.orderBy,
+		},
+	}
+	sources := []rowSource{&rowsSource{rows: sh.rows}}
+	for _, sf := range sr.files {
+		r, err := sf.reader()
+		if err != nil {
+			return err
+		}
+		sources = append(sources, r)
+	}
+	for _, source := range sources {
+		row, err := source.next()
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		mh.rows = append(mh.rows, row)
+		mh.sources = append(mh.sources, source)
+	}
+	heap.Init(mh)
+
+	var rows [][]sqltypes.Value
+	for sent := 0; mh.Len() > 0 && sent < count; sent++ {
+		rows = append(rows, mh.rows[0])
+		row, err := mh.sources[0].next()
+		switch {
+		case err == io.EOF:
+			heap.Pop(mh)
+		case err != nil:
+			return err
+		default:
+			mh.rows[0] = row
+			heap.Fix(mh, 0)
+		}
+		if mh.err != nil {
+			return mh.err
+		}
+		if len(rows) == sortMergeBatchSize {
+			if err := callback(&sqltypes.Result{Rows: rows}); err != nil {
+				return err
+			}
+			rows = nil
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return callback(&sqltypes.Result{Rows: rows})
+}
+
+// close removes the runs, and releases the spill slot.
+func (sr *sortRuns) close() {
+	if sr == nil {
+		return
+	}
+	for _, sf := range sr.files {
+		sf.close()
+	}
+	sr.files = nil
+	sr.release()
+}
+
+// rowSource returns rows one at a time, and io.EOF after the last one.
+type rowSource interface {
+	next() ([]sqltypes.Value, error)
+}
+
+// rowsSource is a rowSource for rows in memory.
+type rowsSource struct {
+	rows [][]sqltypes.Value
+}
+
+func (rs *rowsSource) next() ([]sqltypes.Value, error) {
+	if len(rs.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := rs.rows[0]
+	rs.rows = rs.rows[1:]
+	return row, nil
+}
+
+// mergeHeap is a sortHeap of the next rows of the sources being merged.
+type mergeHeap struct {
+	sortHeap
+	sources []rowSource
+}
+
+// mergeEntry is the element pushed to and popped from a mergeHeap.
+type mergeEntry struct {
+	row    []sqltypes.Value
+	source rowSource
+}
+
+// Swap satisfies heap.Interface.
+func (mh *mergeHeap) Swap(i, j int) {
+	mh.sortHeap.Swap(i, j)
+	mh.sources[i], mh.sources[j] = mh.sources[j], mh.sources[i]
+}
+
+// Push satisfies heap.Interface.
+func (mh *mergeHeap) Push(x interface{}) {
+	entry := x.(mergeEntry)
+	mh.rows = append(mh.rows, entry.row)
+	mh.sources = append(mh.sources, entry.source)
+}
+
+// Pop satisfies heap.Interface.
+func (mh *mergeHeap) Pop() interface{} {
+	n := len(mh.rows)
+	entry := mergeEntry{row: mh.rows[n-1], source: mh.sources[n-1]}
+	mh.rows = mh.rows[:n-1]
+	mh.sources = mh.sources[:n-1]
+	return entry
+}
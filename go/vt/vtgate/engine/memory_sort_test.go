@@ -18,6 +18,9 @@ package engine
 
 import (
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
 	"reflect"
 	"testing"
 
@@ -375,6 +378,147 @@ func TestMemorySortMaxMemoryRows(t *testing.T) {
 	}
 }
 
+func TestMemorySortMemoryBudget(t *testing.T) {
+	save := testMemoryTracker
+	// The budget holds the rows of the limit and the row pushed before
+	// the highest one is dropped, but not all the rows.
+	testMemoryTracker = NewMemoryPool(0).NewTracker(300)
+	defer func() { testMemoryTracker = save }()
+
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"varbinary|decimal",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"b|2",
+			"a|1",
+			"c|4",
+			"c|3",
+		)},
+	}
+
+	ms := &MemorySort{
+		OrderBy: []OrderbyParams{{
+			Col: 1,
+		}},
+		Input:      fp,
+		UpperLimit: int64PlanValue(2),
+	}
+
+	result, err := wrapStreamExecute(ms, noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"a|1",
+		"a|1",
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("oa.Execute:\n%v, want\n%v", result, wantResult)
+	}
+	if used := testMemoryTracker.Used(); used != 0 {
+		t.Errorf("Used: %d, want 0", used)
+	}
+
+	fp.rewind()
+	ms.UpperLimit = sqltypes.PlanValue{}
+	_, err = wrapStreamExecute(ms, noopVCursor{}, nil, false)
+	want := "query memory budget exceeded: the query needs more than 300 bytes"
+	if err == nil || err.Error() != want {
+		t.Errorf("StreamExecute err: %v, want %v", err, want)
+	}
+	if used := testMemoryTracker.Used(); used != 0 {
+		t.Errorf("Used: %d, want 0", used)
+	}
+}
+
+func TestMemorySortSpillToDisk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "memory_sort_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	InitSpill(dir, 1)
+	defer InitSpill("", DefaultSpillConcurrency)
+
+	saveTracker, saveSpill := testMemoryTracker, testSpillToDisk
+	// The budget holds a few rows only.
+	testMemoryTracker = NewMemoryPool(0).NewTracker(300)
+	testSpillToDisk = true
+	defer func() { testMemoryTracker, testSpillToDisk = saveTracker, saveSpill }()
+
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"varchar|int64",
+	)
+	var rows, wantRows []string
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 5; j++ {
+			rows = append(rows, fmt.Sprintf("r%d|%d", j*5+i, 100-(j*5+i)))
+		}
+	}
+	for i := 24; i >= 0; i-- {
+		wantRows = append(wantRows, fmt.Sprintf("r%d|%d", i, 100-i))
+	}
+	fp := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows...)}}
+	ms := &MemorySort{
+		OrderBy: []OrderbyParams{{
+			Col: 1,
+		}},
+		Input: fp,
+	}
+
+	result, err := wrapStreamExecute(ms, noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(fields, wantRows...)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("StreamExecute:\n%v, want\n%v", result, wantResult)
+	}
+
+	// The upper limit applies to the merged runs.
+	fp.rewind()
+	ms.UpperLimit = int64PlanValue(7)
+	result, err = wrapStreamExecute(ms, noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult = sqltypes.MakeTestResult(fields, wantRows[:7]...)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("StreamExecute:\n%v, want\n%v", result, wantResult)
+	}
+
+	if used := testMemoryTracker.Used(); used != 0 {
+		t.Errorf("Used: %d, want 0", used)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("spill files were not removed: %d left", len(files))
+	}
+
+	// The slot of the only sort that can spill is taken.
+	release, err := acquireSpill()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+	fp.rewind()
+	ms.UpperLimit = sqltypes.PlanValue{}
+	_, err = wrapStreamExecute(ms, noopVCursor{}, nil, false)
+	want := "cannot spill to disk: too many queries are spilling at the same time"
+	if err == nil || err.Error() != want {
+		t.Errorf("StreamExecute err: %v, want %v", err, want)
+	}
+}
+
 func TestMemorySortExecuteNoVarChar(t *testing.T) {
 	fields := sqltypes.MakeTestFields(
 		"c1|c2",
@@ -412,3 +556,54 @@ func TestMemorySortExecuteNoVarChar(t *testing.T) {
 		t.Errorf("StreamExecute err: %v, want %v", err, want)
 	}
 }
+
+func TestMemorySortExecuteCollation(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"varchar|decimal",
+	)
+	fields[0].Charset = sqltypes.CollationUtf8mb4GeneralCI
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"b|1",
+			"C|2",
+			"A|3",
+			"a|4",
+		)},
+	}
+
+	ms := &MemorySort{
+		OrderBy: []OrderbyParams{{
+			Col: 0,
+		}, {
+			Col:  1,
+			Desc: true,
+		}},
+		Input: fp,
+	}
+
+	result, err := ms.Execute(nil, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"a|4",
+		"A|3",
+		"b|1",
+		"C|2",
+	)
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("oa.Execute:\n%v, want\n%v", result, wantResult)
+	}
+
+	fp.rewind()
+	result, err = wrapStreamExecute(ms, noopVCursor{}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("oa.StreamExecute:\n%v, want\n%v", result, wantResult)
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestMemoryTracker(t *testing.T) {
+	pool := NewMemoryPool(100)
+	mt1 := pool.NewTracker(60)
+	mt2 := pool.NewTracker(0)
+
+	if err := mt1.Grow(50); err != nil {
+		t.Fatal(err)
+	}
+	err := mt1.Grow(20)
+	want := "query memory budget exceeded: the query needs more than 60 bytes"
+	if err == nil || err.Error() != want {
+		t.Errorf("Grow: %v, want %v", err, want)
+	}
+	if err := mt2.Grow(50); err != nil {
+		t.Fatal(err)
+	}
+	err = mt2.Grow(1)
+	want = "vtgate memory budget exceeded: the running queries need more than 100 bytes"
+	if err == nil || err.Error() != want {
+		t.Errorf("Grow: %v, want %v", err, want)
+	}
+	if mt1.Used() != 50 || mt2.Used() != 50 || pool.Used() != 100 {
+		t.Errorf("Used: %d, %d, %d, want 50, 50, 100", mt1.Used(), mt2.Used(), pool.Used())
+	}
+
+	mt1.Shrink(30)
+	if err := mt2.Grow(30); err != nil {
+		t.Fatal(err)
+	}
+	mt1.Release()
+	mt2.Release()
+	if mt1.Used() != 0 || mt2.Used() != 0 || pool.Used() != 0 {
+		t.Errorf("Used: %d, %d, %d, want 0", mt1.Used(), mt2.Used(), pool.Used())
+	}
+
+	// A nil tracker doesn't account for anything.
+	var mt *MemoryTracker
+	if err := mt.Grow(1000); err != nil {
+		t.Error(err)
+	}
+	mt.Shrink(1000)
+	mt.Release()
+}
+
+func TestResultMemory(t *testing.T) {
+	qr := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"a|b",
+			"int64|varchar",
+		),
+		"1|abc",
+		"22|null",
+	)
+	want := 2*rowOverhead + 4*valueOverhead + 1 + 3 + 2
+	if got := ResultMemory(qr); got != want {
+		t.Errorf("ResultMemory: %d, want %d", got, want)
+	}
+	if got := ResultMemory(nil); got != 0 {
+		t.Errorf("ResultMemory(nil): %d, want 0", got)
+	}
+}
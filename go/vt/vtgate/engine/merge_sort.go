@@ -59,6 +59,7 @@ func MergeSort(vcursor VCursor, query string, orderBy []OrderbyParams, rss []*sr
 
 	sh := &scatterHeap{
 		rows:    make([]streamRow, 0, len(handles)),
+		fields:  fields,
 		orderBy: orderBy,
 	}
 
@@ -186,6 +187,7 @@ type streamRow struct {
 // after every heap operation.
 type scatterHeap struct {
 	rows    []streamRow
+	fields  []*querypb.Field
 	orderBy []OrderbyParams
 	err     error
 }
@@ -201,7 +203,7 @@ func (sh *scatterHeap) Less(i, j int) bool {
 		if sh.err != nil {
 			return true
 		}
-		cmp, err := sqltypes.NullsafeCompare(sh.rows[i].row[order.Col], sh.rows[j].row[order.Col])
+		cmp, err := compareColumn(sh.fields, sh.rows[i].row, sh.rows[j].row, order.Col)
 		if err != nil {
 			sh.err = err
 			return true
@@ -21,6 +21,7 @@ import (
 
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/sqltypes"
 
@@ -45,6 +46,16 @@ type OrderedAggregate struct {
 	// the aggregation key.
 	Keys []int
 
+	// Computed lists the columns that are computed from the
+	// aggregated row, like 'sum(a) / count(*)'. The input only
+	// returns a placeholder for them. Their type is inferred from
+	// the types of the aggregated columns.
+	Computed []ComputedColumn `json:",omitempty"`
+
+	// Having filters the aggregated rows. The rows for which it
+	// is not true are discarded.
+	Having evalengine.Expr `json:",omitempty"`
+
 	// TruncateColumnCount specifies the number of columns to return
 	// in the final result. Rest of the columns are truncated
 	// from the result received. If 0, no truncation happens.
@@ -63,6 +74,13 @@ type AggregateParams struct {
 	Alias string `json:",omitempty"`
 }
 
+// ComputedColumn is a column whose value is computed by vtgate
+// from the other columns of the aggregated row.
+type ComputedColumn struct {
+	Col  int
+	Expr evalengine.Expr
+}
+
 func (ap AggregateParams) isDistinct() bool {
 	return ap.Opcode == AggregateCountDistinct || ap.Opcode == AggregateSumDistinct
 }
@@ -167,7 +185,7 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 			continue
 		}
 
-		equal, err := oa.keysEqual(current, row)
+		equal, err := oa.keysEqual(result.Fields, current, row)
 		if err != nil {
 			return nil, err
 		}
@@ -179,7 +197,9 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 			}
 			continue
 		}
-		out.Rows = append(out.Rows, current)
+		if out.Rows, err = oa.appendRow(out.Rows, current, out.Fields, bindVars); err != nil {
+			return nil, err
+		}
 		current, curDistinct = oa.convertRow(row)
 	}
 
@@ -190,11 +210,15 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 		if err != nil {
 			return nil, err
 		}
-		out.Rows = append(out.Rows, row)
+		if out.Rows, err = oa.appendRow(out.Rows, row, out.Fields, bindVars); err != nil {
+			return nil, err
+		}
 	}
 
 	if current != nil {
-		out.Rows = append(out.Rows, current)
+		if out.Rows, err = oa.appendRow(out.Rows, current, out.Fields, bindVars); err != nil {
+			return nil, err
+		}
 	}
 	out.RowsAffected = uint64(len(out.Rows))
 	return out, nil
@@ -209,6 +233,14 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	cb := func(qr *sqltypes.Result) error {
 		return callback(qr.Truncate(oa.TruncateColumnCount))
 	}
+	// sendRow sends an aggregated row, unless it's filtered out.
+	sendRow := func(row []sqltypes.Value) error {
+		rows, err := oa.appendRow(nil, row, fields, bindVars)
+		if err != nil || len(rows) == 0 {
+			return err
+		}
+		return cb(&sqltypes.Result{Rows: rows})
+	}
 
 	err := oa.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
@@ -224,7 +256,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 				continue
 			}
 
-			equal, err := oa.keysEqual(current, row)
+			equal, err := oa.keysEqual(fields, current, row)
 			if err != nil {
 				return err
 			}
@@ -236,7 +268,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 				}
 				continue
 			}
-			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+			if err := sendRow(current); err != nil {
 				return err
 			}
 			current, curDistinct = oa.convertRow(row)
@@ -248,7 +280,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	}
 
 	if current != nil {
-		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+		if err := sendRow(current); err != nil {
 			return err
 		}
 	}
@@ -256,10 +288,9 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 }
 
 func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Field {
-	if !oa.HasDistinct {
-		return fields
+	if fields == nil {
+		return nil
 	}
-
 	for _, aggr := range oa.Aggregates {
 		if !aggr.isDistinct() {
 			continue
@@ -269,9 +300,56 @@ func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Fi
 			Type: opcodeType[aggr.Opcode],
 		}
 	}
+	for _, computed := range oa.Computed {
+		fields[computed.Col] = &querypb.Field{
+			Name: fields[computed.Col].Name,
+			Type: evalengine.Type(computed.Expr, fields),
+		}
+	}
 	return fields
 }
 
+// appendRow computes the columns of an aggregated row, and appends
+// it to rows if it satisfies the HAVING clause. The computed values
+// are cast to the types of the converted fields, if known.
+func (oa *OrderedAggregate) appendRow(rows [][]sqltypes.Value, row []sqltypes.Value, fields []*querypb.Field, bindVars map[string]*querypb.BindVariable) ([][]sqltypes.Value, error) {
+	if len(oa.Computed) == 0 && oa.Having == nil {
+		return append(rows, row), nil
+	}
+	// The row may be shared with the result of the input.
+	row = sqltypes.CopyRow(row)
+	env := evalengine.ExpressionEnv{
+		BindVars: bindVars,
+		Row:      row,
+	}
+	for _, computed := range oa.Computed {
+		v, err := computed.Expr.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		row[computed.Col] = v
+	}
+	if oa.Having != nil {
+		ok, err := evalengine.EvaluateBool(oa.Having, env)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return rows, nil
+		}
+	}
+	if fields != nil {
+		for _, computed := range oa.Computed {
+			v, err := sqltypes.Cast(row[computed.Col], fields[computed.Col].Type)
+			if err != nil {
+				return nil, err
+			}
+			row[computed.Col] = v
+		}
+	}
+	return append(rows, row), nil
+}
+
 func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, curDistinct sqltypes.Value) {
 	if !oa.HasDistinct {
 		return row, sqltypes.NULL
@@ -309,9 +387,9 @@ func (oa *OrderedAggregate) GetFields(vcursor VCursor, bindVars map[string]*quer
 	return qr.Truncate(oa.TruncateColumnCount), nil
 }
 
-func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error) {
+func (oa *OrderedAggregate) keysEqual(fields []*querypb.Field, row1, row2 []sqltypes.Value) (bool, error) {
 	for _, key := range oa.Keys {
-		cmp, err := sqltypes.NullsafeCompare(row1[key], row2[key])
+		cmp, err := compareColumn(fields, row1, row2, key)
 		if err != nil {
 			return false, err
 		}
@@ -362,13 +440,24 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 
 // creates the empty row for the case when we are missing grouping keys and have empty input table
 func (oa *OrderedAggregate) createEmptyRow() ([]sqltypes.Value, error) {
-	out := make([]sqltypes.Value, len(oa.Aggregates))
-	for i, aggr := range oa.Aggregates {
+	width := len(oa.Aggregates)
+	for _, aggr := range oa.Aggregates {
+		if aggr.Col >= width {
+			width = aggr.Col + 1
+		}
+	}
+	for _, computed := range oa.Computed {
+		if computed.Col >= width {
+			width = computed.Col + 1
+		}
+	}
+	out := make([]sqltypes.Value, width)
+	for _, aggr := range oa.Aggregates {
 		value, err := createEmptyValueFor(aggr.Opcode)
 		if err != nil {
 			return nil, err
 		}
-		out[i] = value
+		out[aggr.Col] = value
 	}
 	return out, nil
 }
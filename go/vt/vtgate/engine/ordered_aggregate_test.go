@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 func TestOrderedAggregateExecute(t *testing.T) {
@@ -657,7 +658,7 @@ func TestMerge(t *testing.T) {
 
 	merged, _, err := oa.merge(fields, r.Rows[0], r.Rows[1], sqltypes.NULL)
 	assert.NoError(err)
-	want := sqltypes.MakeTestResult(fields, "1|5|6|2|bc").Rows[0]
+	want := sqltypes.MakeTestResult(fields, "1|5|6.0|2|bc").Rows[0]
 	assert.Equal(want, merged)
 
 	// swap and retry
@@ -742,3 +743,122 @@ func TestNoInputAndNoGroupingKeys(outer *testing.T) {
 		})
 	}
 }
+
+func TestOrderedAggregateComputedAndHaving(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col|sum(a)|avg|count(*)",
+				"varbinary|decimal|null_type|int64",
+			),
+			"a|10|null|1",
+			"a|20|null|2",
+			"b|5|null|1",
+			"c|9|null|2",
+		)},
+	}
+
+	// select col, sum(a), sum(a) / count(*) as avg from t group by col having count(*) > 1
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateSum,
+			Col:    1,
+		}, {
+			Opcode: AggregateCount,
+			Col:    3,
+		}},
+		Keys: []int{0},
+		Computed: []ComputedColumn{{
+			Col: 2,
+			Expr: &evalengine.Arithmetic{
+				Op:    evalengine.OpDivide,
+				Left:  &evalengine.Column{Offset: 1},
+				Right: &evalengine.Column{Offset: 3},
+			},
+		}},
+		Having: &evalengine.Comparison{
+			Op:    evalengine.OpGreaterThan,
+			Left:  &evalengine.Column{Offset: 3},
+			Right: &evalengine.Literal{Val: sqltypes.NewInt64(1)},
+		},
+		TruncateColumnCount: 3,
+		Input:               fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	assert.NoError(err)
+
+	wantFields := sqltypes.MakeTestFields(
+		"col|sum(a)|avg",
+		"varbinary|decimal|decimal",
+	)
+	wantResult := sqltypes.MakeTestResult(
+		wantFields,
+		"a|30|10.0000",
+		"c|9|4.5000",
+	)
+	assert.Equal(wantResult, result)
+
+	fp.rewind()
+	var results []*sqltypes.Result
+	err = oa.StreamExecute(nil, nil, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	assert.NoError(err)
+
+	wantResults := sqltypes.MakeTestStreamingResults(
+		wantFields,
+		"a|30|10.0000",
+		"---",
+		"c|9|4.5000",
+	)
+	assert.Equal(wantResults, results)
+}
+
+func TestOrderedAggregateComputedNoInput(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"avg|sum(a)|count(*)",
+				"null_type|decimal|int64",
+			),
+			// Empty input table
+		)},
+	}
+
+	// select sum(a) / count(*) as avg from t
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateSum,
+			Col:    1,
+		}, {
+			Opcode: AggregateCount,
+			Col:    2,
+		}},
+		Computed: []ComputedColumn{{
+			Col: 0,
+			Expr: &evalengine.Arithmetic{
+				Op:    evalengine.OpDivide,
+				Left:  &evalengine.Column{Offset: 1},
+				Right: &evalengine.Column{Offset: 2},
+			},
+		}},
+		TruncateColumnCount: 1,
+		Input:               fp,
+	}
+
+	result, err := oa.Execute(nil, nil, false)
+	assert.NoError(err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"avg",
+			"decimal",
+		),
+		"null",
+	)
+	assert.Equal(wantResult, result)
+}
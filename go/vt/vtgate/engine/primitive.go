@@ -48,6 +48,17 @@ type VCursor interface {
 	// MaxMemoryRows returns the maxMemoryRows flag value.
 	MaxMemoryRows() int
 
+	// MemoryTracker returns the tracker of the memory held by the query.
+	MemoryTracker() *MemoryTracker
+
+	// SpillToDisk returns true if the sorts and hash joins of the query
+	// can spill to disk when they exceed its memory budget.
+	SpillToDisk() bool
+
+	// InTransaction returns true if the session is in a transaction.
+	// The streaming queries are not part of it.
+	InTransaction() bool
+
 	// SetContextTimeout updates the context and sets a timeout.
 	SetContextTimeout(timeout time.Duration) context.CancelFunc
 
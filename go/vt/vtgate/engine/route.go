@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"vitess.io/vitess/go/jsonutil"
@@ -33,6 +34,7 @@ import (
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 var _ Primitive = (*Route)(nil)
@@ -84,6 +86,16 @@ type Route struct {
 
 	// ScatterErrorsAsWarnings is true if results should be returned even if some shards have an error
 	ScatterErrorsAsWarnings bool
+
+	// SysTableSchema are the values compared to the schema names in
+	// a SelectDBA query, which were replaced with the BvSchemaName bind
+	// variable. If they name a keyspace, the query is routed to it.
+	SysTableSchema []sqltypes.PlanValue
+
+	// SysTableMerge is true if a SelectDBA query is sent to all the
+	// shards of the sharded keyspaces of SysTableSchema, and their rows
+	// are merged into the rows of a single schema.
+	SysTableMerge bool
 }
 
 // NewSimpleRoute creates a Route with the bare minimum of parameters.
@@ -111,6 +123,17 @@ type OrderbyParams struct {
 	Desc bool
 }
 
+// compareColumn compares the values of the column col of two rows.
+// Text values are compared with the collation of the column, if the
+// fields are known.
+func compareColumn(fields []*querypb.Field, row1, row2 []sqltypes.Value, col int) (int, error) {
+	var collation uint32
+	if col < len(fields) {
+		collation = fields[col].Charset
+	}
+	return sqltypes.NullsafeCompareCollated(row1[col], row2[col], collation)
+}
+
 // MarshalJSON serializes the Route into a JSON representation.
 // It's used for testing and diagnostics.
 func (route *Route) MarshalJSON() ([]byte, error) {
@@ -130,6 +153,8 @@ func (route *Route) MarshalJSON() ([]byte, error) {
 		QueryTimeout            int                  `json:",omitempty"`
 		ScatterErrorsAsWarnings bool                 `json:",omitempty"`
 		Table                   string               `json:",omitempty"`
+		SysTableSchema          []sqltypes.PlanValue `json:",omitempty"`
+		SysTableMerge           bool                 `json:",omitempty"`
 	}{
 		Opcode:                  route.Opcode,
 		Keyspace:                route.Keyspace,
@@ -142,6 +167,8 @@ func (route *Route) MarshalJSON() ([]byte, error) {
 		QueryTimeout:            route.QueryTimeout,
 		ScatterErrorsAsWarnings: route.ScatterErrorsAsWarnings,
 		Table:                   route.TableName,
+		SysTableSchema:          route.SysTableSchema,
+		SysTableMerge:           route.SysTableMerge,
 	}
 	return jsonutil.MarshalNoEscape(marshalRoute)
 }
@@ -239,8 +266,10 @@ func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 	var bvs []map[string]*querypb.BindVariable
 	var err error
 	switch route.Opcode {
-	case SelectUnsharded, SelectNext, SelectDBA, SelectReference:
+	case SelectUnsharded, SelectNext, SelectReference:
 		rss, bvs, err = route.paramsAnyShard(vcursor, bindVars)
+	case SelectDBA:
+		rss, bvs, err = route.paramsSystemQuery(vcursor, bindVars)
 	case SelectScatter:
 		rss, bvs, err = route.paramsAllShards(vcursor, bindVars)
 	case SelectEqual, SelectEqualUnique:
@@ -281,6 +310,9 @@ func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 			return nil, vterrors.Aggregate(errs)
 		}
 	}
+	if route.SysTableMerge && len(rss) > 1 {
+		return mergeSysTableRows(result), nil
+	}
 	if len(route.OrderBy) == 0 {
 		return result, nil
 	}
@@ -298,8 +330,10 @@ func (route *Route) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.
 		defer cancel()
 	}
 	switch route.Opcode {
-	case SelectUnsharded, SelectNext, SelectDBA, SelectReference:
+	case SelectUnsharded, SelectNext, SelectReference:
 		rss, bvs, err = route.paramsAnyShard(vcursor, bindVars)
+	case SelectDBA:
+		rss, bvs, err = route.paramsSystemQuery(vcursor, bindVars)
 	case SelectScatter:
 		rss, bvs, err = route.paramsAllShards(vcursor, bindVars)
 	case SelectEqual, SelectEqualUnique:
@@ -325,6 +359,15 @@ func (route *Route) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.
 		return nil
 	}
 
+	if route.SysTableMerge && len(rss) > 1 {
+		// The rows are merged once all the shards returned them.
+		result, errs := vcursor.ExecuteMultiShard(rss, getQueries(route.Query, bvs), false /* isDML */, false /* autocommit */)
+		if errs != nil {
+			return vterrors.Aggregate(errs)
+		}
+		return callback(mergeSysTableRows(result).Truncate(route.TruncateColumnCount))
+	}
+
 	if len(route.OrderBy) == 0 {
 		return vcursor.StreamExecuteMulti(route.Query, rss, bvs, func(qr *sqltypes.Result) error {
 			return callback(qr.Truncate(route.TruncateColumnCount))
@@ -338,7 +381,18 @@ func (route *Route) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.
 
 // GetFields fetches the field info.
 func (route *Route) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
+	var rss []*srvtopo.ResolvedShard
+	var err error
+	if route.Opcode == SelectDBA {
+		var bvs []map[string]*querypb.BindVariable
+		rss, bvs, err = route.paramsSystemQuery(vcursor, bindVars)
+		if err == nil && len(bvs) > 0 {
+			// The fields are the same for all the schemas.
+			rss, bindVars = rss[:1], bvs[0]
+		}
+	} else {
+		rss, _, err = vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -377,6 +431,112 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 	return rss, multiBindVars, nil
 }
 
+// paramsSystemQuery routes a query of the system tables. The schema
+// names that are keyspaces are routed to their keyspace, where the tablet
+// replaces them with the name of its database. The other schema names,
+// like mysql, are routed to the keyspace of the session.
+func (route *Route) paramsSystemQuery(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	if len(route.SysTableSchema) == 0 {
+		return route.paramsAnyShard(vcursor, bindVars)
+	}
+	schemaNames, err := route.sysTableSchemaNames(bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+	dest := key.Destination(key.DestinationAnyShard{})
+	if route.SysTableMerge {
+		dest = key.DestinationAllShards{}
+	}
+	var rss []*srvtopo.ResolvedShard
+	var multiBindVars []map[string]*querypb.BindVariable
+	var others []string
+	for _, schemaName := range schemaNames {
+		ksRss, _, err := vcursor.ResolveDestinations(schemaName, nil, []key.Destination{dest})
+		if err != nil {
+			// The schema is not a keyspace.
+			others = append(others, schemaName)
+			continue
+		}
+		ksBindVars := sysTableBindVars(bindVars, []string{schemaName}, true)
+		for range ksRss {
+			multiBindVars = append(multiBindVars, ksBindVars)
+		}
+		rss = append(rss, ksRss...)
+	}
+	if len(others) != 0 {
+		if route.Keyspace.Name == "" {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s is not a keyspace, and no keyspace was selected", strings.Join(others, ", "))
+		}
+		ksRss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
+		if err != nil {
+			return nil, nil, vterrors.Wrap(err, "paramsSystemQuery")
+		}
+		ksBindVars := sysTableBindVars(bindVars, others, false)
+		for range ksRss {
+			multiBindVars = append(multiBindVars, ksBindVars)
+		}
+		rss = append(rss, ksRss...)
+	}
+	return rss, multiBindVars, nil
+}
+
+// sysTableSchemaNames returns the distinct schema names of SysTableSchema.
+// The values of the equality comparisons must all be the same schema
+// name: the query can't be routed to several schemas when its schema
+// names are compared in different columns. The names of the IN lists are
+// routed to their schemas.
+func (route *Route) sysTableSchemaNames(bindVars map[string]*querypb.BindVariable) ([]string, error) {
+	var schemaNames []string
+	seen := make(map[string]bool)
+	equal := false
+	for _, pv := range route.SysTableSchema {
+		var values []sqltypes.Value
+		if pv.IsList() {
+			list, err := pv.ResolveList(bindVars)
+			if err != nil {
+				return nil, vterrors.Wrap(err, "paramsSystemQuery")
+			}
+			values = list
+		} else {
+			v, err := pv.ResolveValue(bindVars)
+			if err != nil {
+				return nil, vterrors.Wrap(err, "paramsSystemQuery")
+			}
+			values = []sqltypes.Value{v}
+			equal = true
+		}
+		for _, v := range values {
+			if name := v.ToString(); !seen[name] {
+				seen[name] = true
+				schemaNames = append(schemaNames, name)
+			}
+		}
+	}
+	if equal && len(schemaNames) > 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: different schema names in a system table query: %s", strings.Join(schemaNames, ", "))
+	}
+	return schemaNames, nil
+}
+
+// sysTableBindVars returns a copy of bindVars with the schema names of a
+// route of a system table query.
+func sysTableBindVars(bindVars map[string]*querypb.BindVariable, schemaNames []string, replace bool) map[string]*querypb.BindVariable {
+	newBindVars := make(map[string]*querypb.BindVariable, len(bindVars)+3)
+	for k, v := range bindVars {
+		newBindVars[k] = v
+	}
+	newBindVars[sqltypes.BvSchemaName] = sqltypes.StringBindVariable(schemaNames[0])
+	values := make([]*querypb.Value, 0, len(schemaNames))
+	for _, name := range schemaNames {
+		values = append(values, &querypb.Value{Type: querypb.Type_VARBINARY, Value: []byte(name)})
+	}
+	newBindVars[sqltypes.BvSchemaNames] = &querypb.BindVariable{Type: querypb.Type_TUPLE, Values: values}
+	if replace {
+		newBindVars[sqltypes.BvReplaceSchemaName] = sqltypes.Int64BindVariable(1)
+	}
+	return newBindVars
+}
+
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
 	key, err := route.Values[0].ResolveValue(bindVars)
 	if err != nil {
@@ -445,7 +605,7 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 				return true
 			}
 			var cmp int
-			cmp, err = sqltypes.NullsafeCompare(out.Rows[i][order.Col], out.Rows[j][order.Col])
+			cmp, err = compareColumn(out.Fields, out.Rows[i], out.Rows[j], order.Col)
 			if err != nil {
 				return true
 			}
@@ -493,6 +493,136 @@ func TestSelectDBA(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectDBASchemaName(t *testing.T) {
+	sel := NewRoute(
+		SelectDBA,
+		&vindexes.Keyspace{},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.SysTableSchema = []sqltypes.PlanValue{
+		{Value: sqltypes.NewVarChar("user")},
+		{Key: "ks"},
+	}
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.Execute(vc, map[string]*querypb.BindVariable{"ks": sqltypes.StringBindVariable("user")}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations user [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard user.-20: dummy_select {__replacevtschemaname: type:INT64 value:"1" __vtschemaname: type:VARCHAR value:"user" __vtschemanames: type:TUPLE values:<type:VARBINARY value:"user" > ks: type:VARCHAR value:"user" } false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	vc.Rewind()
+	_, err = sel.Execute(vc, map[string]*querypb.BindVariable{"ks": sqltypes.StringBindVariable("main")}, false)
+	want := "unsupported: different schema names in a system table query: user, main"
+	if err == nil || err.Error() != want {
+		t.Errorf("sel.Execute: %v, want %s", err, want)
+	}
+}
+
+func TestSelectDBASchemaNameList(t *testing.T) {
+	sel := NewRoute(
+		SelectDBA,
+		&vindexes.Keyspace{Name: "main"},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.SysTableSchema = []sqltypes.PlanValue{{
+		Values: []sqltypes.PlanValue{
+			{Value: sqltypes.NewVarChar("user")},
+			{Value: sqltypes.NewVarChar("mysql")},
+			{Value: sqltypes.NewVarChar("sys")},
+			{Value: sqltypes.NewVarChar("music")},
+		},
+	}}
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+		keyspaceErrs: map[string]error{
+			"mysql": errors.New("keyspace mysql not found"),
+			"sys":   errors.New("keyspace sys not found"),
+		},
+	}
+	bindVars := map[string]*querypb.BindVariable{}
+	if _, err := sel.Execute(vc, bindVars, false); err != nil {
+		t.Fatal(err)
+	}
+	// Each keyspace gets its schema name, and the other schema names
+	// go to the keyspace of the session.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations user [] Destinations:DestinationAnyShard()`,
+		`ResolveDestinations mysql [] Destinations:DestinationAnyShard()`,
+		`ResolveDestinations sys [] Destinations:DestinationAnyShard()`,
+		`ResolveDestinations music [] Destinations:DestinationAnyShard()`,
+		`ResolveDestinations main [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ` +
+			`user.-20: dummy_select {__replacevtschemaname: type:INT64 value:"1" __vtschemaname: type:VARCHAR value:"user" __vtschemanames: type:TUPLE values:<type:VARBINARY value:"user" > } ` +
+			`music.-20: dummy_select {__replacevtschemaname: type:INT64 value:"1" __vtschemaname: type:VARCHAR value:"music" __vtschemanames: type:TUPLE values:<type:VARBINARY value:"music" > } ` +
+			`main.-20: dummy_select {__vtschemaname: type:VARCHAR value:"mysql" __vtschemanames: type:TUPLE values:<type:VARBINARY value:"mysql" > values:<type:VARBINARY value:"sys" > } ` +
+			`false false`,
+	})
+	// The bind variables of the caller are not modified.
+	if len(bindVars) != 0 {
+		t.Errorf("bind variables: %v, want none", bindVars)
+	}
+}
+
+func TestSelectDBAMerge(t *testing.T) {
+	sel := NewRoute(
+		SelectDBA,
+		&vindexes.Keyspace{},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.SysTableSchema = []sqltypes.PlanValue{{Value: sqltypes.NewVarChar("user")}}
+	sel.SysTableMerge = true
+
+	fields := "table_schema|table_name|table_rows|data_length|auto_increment|create_time"
+	types := "varchar|varchar|uint64|uint64|uint64|datetime"
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(fields, types),
+			"user|t1|10|100|11|2019-01-01 00:00:00",
+			"user|t2|null|0|null|2019-01-01 00:00:00",
+			"user|t1|5|50|20|2018-01-01 00:00:00",
+			"user|t2|null|0|null|2019-01-02 00:00:00",
+		)},
+	}
+	result, err := sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations user [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ` +
+			`user.-20: dummy_select {__replacevtschemaname: type:INT64 value:"1" __vtschemaname: type:VARCHAR value:"user" __vtschemanames: type:TUPLE values:<type:VARBINARY value:"user" > } ` +
+			`user.20-: dummy_select {__replacevtschemaname: type:INT64 value:"1" __vtschemaname: type:VARCHAR value:"user" __vtschemanames: type:TUPLE values:<type:VARBINARY value:"user" > } ` +
+			`false false`,
+	})
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(fields, types),
+		"user|t1|15|150|20|2019-01-01 00:00:00",
+		"user|t2|null|0|null|2019-01-02 00:00:00",
+	)
+	expectResult(t, "sel.Execute", result, wantResult)
+
+	vc.Rewind()
+	result, err = wrapStreamExecute(sel, vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectResult(t, "sel.StreamExecute", result, wantResult)
+}
+
 func TestSelectReference(t *testing.T) {
 	sel := NewRoute(
 		SelectReference,
@@ -0,0 +1,109 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlannotation"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// SingleShardDML resolves the shard of a DML primitive that writes to one
+// shard with a single query, and returns that query without executing it,
+// so it can be sent along with other queries. ok is false if the primitive
+// writes to more than one shard, needs a lookup vindex, a sequence or a
+// timeout, or if its shard can't be resolved: it must then be executed on
+// its own. bindVars receives the bind variables of the query.
+func SingleShardDML(vcursor VCursor, primitive Primitive, bindVars map[string]*querypb.BindVariable) (rs *srvtopo.ResolvedShard, query string, ok bool) {
+	switch p := primitive.(type) {
+	case *Update:
+		if p.QueryTimeout != 0 || len(p.ChangedVindexValues) != 0 {
+			return nil, "", false
+		}
+		switch p.Opcode {
+		case UpdateUnsharded:
+			return resolveUnshardedDML(vcursor, p.Keyspace, p.Query)
+		case UpdateEqual:
+			return resolveEqualDML(vcursor, p.Keyspace, p.Vindex, p.Values, p.Query, bindVars)
+		}
+	case *Delete:
+		if p.QueryTimeout != 0 || p.OwnedVindexQuery != "" {
+			return nil, "", false
+		}
+		switch p.Opcode {
+		case DeleteUnsharded:
+			return resolveUnshardedDML(vcursor, p.Keyspace, p.Query)
+		case DeleteEqual:
+			return resolveEqualDML(vcursor, p.Keyspace, p.Vindex, p.Values, p.Query, bindVars)
+		}
+	case *Insert:
+		if p.QueryTimeout != 0 || p.Generate != nil {
+			return nil, "", false
+		}
+		switch p.Opcode {
+		case InsertUnsharded:
+			return resolveUnshardedDML(vcursor, p.Keyspace, p.Query)
+		case InsertSharded:
+			// The lookup vindexes would have to be created or
+			// verified before the insert.
+			for _, colVindex := range p.Table.ColumnVindexes {
+				if isLookup(colVindex.Vindex) {
+					return nil, "", false
+				}
+			}
+			rss, queries, err := p.getInsertShardedRoute(vcursor, bindVars)
+			if err != nil || len(rss) != 1 {
+				return nil, "", false
+			}
+			return rss[0], queries[0].Sql, true
+		}
+	}
+	return nil, "", false
+}
+
+func resolveUnshardedDML(vcursor VCursor, keyspace *vindexes.Keyspace, query string) (*srvtopo.ResolvedShard, string, bool) {
+	rss, _, err := vcursor.ResolveDestinations(keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	if err != nil || len(rss) != 1 {
+		return nil, "", false
+	}
+	return rss[0], query, true
+}
+
+func resolveEqualDML(vcursor VCursor, keyspace *vindexes.Keyspace, vindex vindexes.Vindex, values []sqltypes.PlanValue, query string, bindVars map[string]*querypb.BindVariable) (*srvtopo.ResolvedShard, string, bool) {
+	// A lookup could miss the rows written by the queries sent along.
+	if isLookup(vindex) {
+		return nil, "", false
+	}
+	value, err := values[0].ResolveValue(bindVars)
+	if err != nil {
+		return nil, "", false
+	}
+	rs, ksid, err := resolveSingleShard(vcursor, vindex, keyspace, value)
+	if err != nil || len(ksid) == 0 {
+		return nil, "", false
+	}
+	return rs, sqlannotation.AddKeyspaceIDs(query, [][]byte{ksid}, ""), true
+}
+
+func isLookup(vindex vindexes.Vindex) bool {
+	_, ok := vindex.(vindexes.Lookup)
+	return ok
+}
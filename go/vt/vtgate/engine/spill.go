@@ -0,0 +1,173 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// DefaultSpillConcurrency is the default number of sorts and hash
+// joins that can spill to disk at the same time.
+const DefaultSpillConcurrency = 4
+
+var (
+	spillMu sync.Mutex
+	// spillDir is the directory of the spill files. If it's empty,
+	// the default directory for temporary files is used.
+	spillDir string
+	// spillSlots bounds the number of sorts and hash joins that spill
+	// to disk at the same time.
+	spillSlots = sync2.NewSemaphore(DefaultSpillConcurrency, 0)
+)
+
+// InitSpill sets the directory of the spill files, and the number of
+// sorts and hash joins that can spill to disk at the same time. It
+// must be called before the queries are served.
+func InitSpill(dir string, concurrency int) {
+	spillMu.Lock()
+	defer spillMu.Unlock()
+	spillDir = dir
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	spillSlots = sync2.NewSemaphore(concurrency, 0)
+}
+
+// acquireSpill reserves a slot to spill to disk. The returned function
+// releases the slot. It fails if too many sorts and hash joins are
+// already spilling.
+func acquireSpill() (func(), error) {
+	spillMu.Lock()
+	slots := spillSlots
+	spillMu.Unlock()
+	if !slots.TryAcquire() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "cannot spill to disk: too many queries are spilling at the same time")
+	}
+	return slots.Release, nil
+}
+
+// spillFile is a temporary file the rows are written to. Each value
+// is encoded as its type, its length and its bytes.
+type spillFile struct {
+	file *os.File
+	w    *bufio.Writer
+}
+
+func newSpillFile(prefix string) (*spillFile, error) {
+	spillMu.Lock()
+	dir := spillDir
+	spillMu.Unlock()
+	file, err := ioutil.TempFile(dir, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create spill file: %v", err)
+	}
+	return &spillFile{
+		file: file,
+		w:    bufio.NewWriter(file),
+	}, nil
+}
+
+func (sf *spillFile) write(row []sqltypes.Value) error {
+	var buf []byte
+	buf = appendVarint(buf, int64(len(row)))
+	for _, v := range row {
+		buf = appendVarint(buf, int64(v.Type()))
+		buf = appendVarint(buf, int64(len(v.Raw())))
+		buf = append(buf, v.Raw()...)
+	}
+	_, err := sf.w.Write(buf)
+	return err
+}
+
+// reader returns a reader of the rows written to the file so far.
+func (sf *spillFile) reader() (*spillReader, error) {
+	if err := sf.w.Flush(); err != nil {
+		return nil, err
+	}
+	if _, err := sf.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return &spillReader{r: bufio.NewReader(sf.file)}, nil
+}
+
+// readAll calls the callback with each row of the file.
+func (sf *spillFile) readAll(callback func(row []sqltypes.Value) error) error {
+	sr, err := sf.reader()
+	if err != nil {
+		return err
+	}
+	for {
+		row, err := sr.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := callback(row); err != nil {
+			return err
+		}
+	}
+}
+
+func (sf *spillFile) close() {
+	sf.file.Close()
+	os.Remove(sf.file.Name())
+}
+
+// spillReader reads the rows of a spillFile.
+type spillReader struct {
+	r *bufio.Reader
+}
+
+// next returns the next row, or io.EOF after the last one.
+func (sr *spillReader) next() ([]sqltypes.Value, error) {
+	count, err := binary.ReadVarint(sr.r)
+	if err != nil {
+		return nil, err
+	}
+	row := make([]sqltypes.Value, count)
+	for i := range row {
+		typ, err := binary.ReadVarint(sr.r)
+		if err != nil {
+			return nil, err
+		}
+		length, err := binary.ReadVarint(sr.r)
+		if err != nil {
+			return nil, err
+		}
+		val := make([]byte, length)
+		if _, err := io.ReadFull(sr.r, val); err != nil {
+			return nil, err
+		}
+		row[i] = sqltypes.MakeTrusted(querypb.Type(typ), val)
+	}
+	return row, nil
+}
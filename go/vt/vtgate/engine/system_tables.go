@@ -0,0 +1,112 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// sysTableSumColumns are the columns of information_schema.tables that
+// are added up across the shards of a keyspace.
+var sysTableSumColumns = map[string]bool{
+	"table_rows":   true,
+	"data_length":  true,
+	"index_length": true,
+	"data_free":    true,
+}
+
+// sysTableMaxColumns are the columns of information_schema.tables of
+// which the largest value of the shards of a keyspace is kept.
+var sysTableMaxColumns = map[string]bool{
+	"auto_increment":  true,
+	"avg_row_length":  true,
+	"max_data_length": true,
+	"create_time":     true,
+	"update_time":     true,
+	"check_time":      true,
+}
+
+// mergeSysTableRows merges the rows of information_schema.tables that the
+// shards of a keyspace returned, so each table of the keyspace has a
+// single row. The rows that have the same values apart from their
+// statistics are merged: the row counts and lengths are added up, and
+// the largest values of the other statistics are kept. The rows are in
+// the order they were first returned.
+func mergeSysTableRows(result *sqltypes.Result) *sqltypes.Result {
+	merged := &sqltypes.Result{
+		Fields:       result.Fields,
+		RowsAffected: result.RowsAffected,
+		Extras:       result.Extras,
+	}
+	index := make(map[string]int)
+	for _, row := range result.Rows {
+		k := sysTableRowKey(result.Fields, row)
+		i, ok := index[k]
+		if !ok {
+			index[k] = len(merged.Rows)
+			merged.Rows = append(merged.Rows, append([]sqltypes.Value(nil), row...))
+			continue
+		}
+		mergedRow := merged.Rows[i]
+		for j, field := range result.Fields {
+			name := strings.ToLower(field.Name)
+			switch {
+			case sysTableSumColumns[name]:
+				// The statistics stay NULL if no shard has them.
+				switch {
+				case row[j].IsNull():
+				case mergedRow[j].IsNull():
+					mergedRow[j] = row[j]
+				default:
+					mergedRow[j] = sqltypes.NullsafeAdd(mergedRow[j], row[j], querypb.Type_UINT64)
+				}
+			case sysTableMaxColumns[name]:
+				if cmp, err := sqltypes.NullsafeCompare(mergedRow[j], row[j]); err == nil && cmp < 0 {
+					mergedRow[j] = row[j]
+				}
+			}
+		}
+	}
+	merged.RowsAffected = uint64(len(merged.Rows))
+	return merged
+}
+
+// sysTableRowKey returns the key of the row of a table: the values of its
+// columns that are not statistics.
+func sysTableRowKey(fields []*querypb.Field, row []sqltypes.Value) string {
+	var b strings.Builder
+	for i, field := range fields {
+		name := strings.ToLower(field.Name)
+		if sysTableSumColumns[name] || sysTableMaxColumns[name] {
+			continue
+		}
+		if row[i].IsNull() {
+			b.WriteString("-:")
+			continue
+		}
+		raw := row[i].Raw()
+		b.WriteString(strconv.Itoa(len(raw)))
+		b.WriteByte(':')
+		b.Write(raw)
+	}
+	return b.String()
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// ColumnLookup returns the offset of the column that holds the value
+// of an expression in the rows the expression is evaluated on, if any.
+type ColumnLookup func(expr sqlparser.Expr) (int, bool)
+
+// Convert converts a parsed expression to an expression vtgate can
+// evaluate. The lookup is tried first on every node, so that the
+// columns, and the aggregates or other expressions already computed by
+// the underlying primitive, are read from the row.
+func Convert(e sqlparser.Expr, lookup ColumnLookup) (Expr, error) {
+	if offset, ok := lookup(e); ok {
+		return &Column{Offset: offset}, nil
+	}
+	switch node := e.(type) {
+	case *sqlparser.SQLVal:
+		return convertSQLVal(node)
+	case *sqlparser.NullVal:
+		return &Literal{Val: sqltypes.NULL}, nil
+	case sqlparser.BoolVal:
+		return &Literal{Val: boolValue(bool(node))}, nil
+	case *sqlparser.ParenExpr:
+		return Convert(node.Expr, lookup)
+	case *sqlparser.AndExpr:
+		return convertLogical(OpAnd, node.Left, node.Right, lookup)
+	case *sqlparser.OrExpr:
+		return convertLogical(OpOr, node.Left, node.Right, lookup)
+	case *sqlparser.NotExpr:
+		expr, err := Convert(node.Expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+	case *sqlparser.ComparisonExpr:
+		return convertComparison(node, lookup)
+	case *sqlparser.RangeCond:
+		return convertRangeCond(node, lookup)
+	case *sqlparser.IsExpr:
+		return convertIsExpr(node, lookup)
+	case *sqlparser.BinaryExpr:
+		return convertBinaryExpr(node, lookup)
+	case *sqlparser.UnaryExpr:
+		return convertUnaryExpr(node, lookup)
+	case *sqlparser.FuncExpr:
+		return convertFuncExpr(node, lookup)
+	case *sqlparser.SubstrExpr:
+		return convertSubstrExpr(node, lookup)
+	case *sqlparser.CaseExpr:
+		return convertCaseExpr(node, lookup)
+	}
+	return nil, unsupported(e)
+}
+
+func unsupported(e sqlparser.Expr) error {
+	return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: expression %s cannot be evaluated by vtgate", sqlparser.String(e))
+}
+
+func convertSQLVal(node *sqlparser.SQLVal) (Expr, error) {
+	switch node.Type {
+	case sqlparser.ValArg:
+		return &BindVariable{Key: string(node.Val[1:])}, nil
+	case sqlparser.FloatVal:
+		f, err := strconv.ParseFloat(string(node.Val), 64)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+		}
+		return &Literal{Val: sqltypes.NewFloat64(f)}, nil
+	case sqlparser.IntVal, sqlparser.StrVal, sqlparser.HexVal:
+		pv, err := sqlparser.NewPlanValue(node)
+		if err != nil {
+			return nil, err
+		}
+		return &Literal{Val: pv.Value}, nil
+	}
+	return nil, unsupported(node)
+}
+
+func convertPair(left, right sqlparser.Expr, lookup ColumnLookup) (Expr, Expr, error) {
+	l, err := Convert(left, lookup)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := Convert(right, lookup)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l, r, nil
+}
+
+func convertLogical(op LogicalOp, left, right sqlparser.Expr, lookup ColumnLookup) (Expr, error) {
+	l, r, err := convertPair(left, right, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return &Logical{Op: op, Left: l, Right: r}, nil
+}
+
+var comparisonOps = map[string]ComparisonOp{
+	sqlparser.EqualStr:         OpEqual,
+	sqlparser.NotEqualStr:      OpNotEqual,
+	sqlparser.LessThanStr:      OpLessThan,
+	sqlparser.LessEqualStr:     OpLessEqual,
+	sqlparser.GreaterThanStr:   OpGreaterThan,
+	sqlparser.GreaterEqualStr:  OpGreaterEqual,
+	sqlparser.NullSafeEqualStr: OpNullSafeEqual,
+}
+
+func convertComparison(node *sqlparser.ComparisonExpr, lookup ColumnLookup) (Expr, error) {
+	op, ok := comparisonOps[node.Operator]
+	if !ok {
+		return nil, unsupported(node)
+	}
+	l, r, err := convertPair(node.Left, node.Right, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return &Comparison{Op: op, Left: l, Right: r}, nil
+}
+
+// convertRangeCond converts BETWEEN to a pair of comparisons.
+func convertRangeCond(node *sqlparser.RangeCond, lookup ColumnLookup) (Expr, error) {
+	left, err := Convert(node.Left, lookup)
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := convertPair(node.From, node.To, lookup)
+	if err != nil {
+		return nil, err
+	}
+	var expr Expr = &Logical{
+		Op:    OpAnd,
+		Left:  &Comparison{Op: OpGreaterEqual, Left: left, Right: from},
+		Right: &Comparison{Op: OpLessEqual, Left: left, Right: to},
+	}
+	if node.Operator == sqlparser.NotBetweenStr {
+		expr = &Not{Expr: expr}
+	}
+	return expr, nil
+}
+
+func convertIsExpr(node *sqlparser.IsExpr, lookup ColumnLookup) (Expr, error) {
+	expr, err := Convert(node.Expr, lookup)
+	if err != nil {
+		return nil, err
+	}
+	switch node.Operator {
+	case sqlparser.IsNullStr:
+		return &IsNull{Expr: expr}, nil
+	case sqlparser.IsNotNullStr:
+		return &IsNull{Expr: expr, Negated: true}, nil
+	case sqlparser.IsTrueStr:
+		return &Function{Name: "if", Args: []Expr{expr, &Literal{Val: boolTrue}, &Literal{Val: boolFalse}}, impl: builtins["if"]}, nil
+	case sqlparser.IsNotTrueStr:
+		return &Function{Name: "if", Args: []Expr{expr, &Literal{Val: boolFalse}, &Literal{Val: boolTrue}}, impl: builtins["if"]}, nil
+	case sqlparser.IsFalseStr:
+		return &Logical{Op: OpAnd, Left: &IsNull{Expr: expr, Negated: true}, Right: &Not{Expr: expr}}, nil
+	case sqlparser.IsNotFalseStr:
+		return &Logical{Op: OpOr, Left: &IsNull{Expr: expr}, Right: expr}, nil
+	}
+	return nil, unsupported(node)
+}
+
+var arithmeticOps = map[string]ArithmeticOp{
+	sqlparser.PlusStr:   OpAdd,
+	sqlparser.MinusStr:  OpSubtract,
+	sqlparser.MultStr:   OpMultiply,
+	sqlparser.DivStr:    OpDivide,
+	sqlparser.IntDivStr: OpIntDiv,
+	sqlparser.ModStr:    OpMod,
+}
+
+func convertBinaryExpr(node *sqlparser.BinaryExpr, lookup ColumnLookup) (Expr, error) {
+	op, ok := arithmeticOps[node.Operator]
+	if !ok {
+		return nil, unsupported(node)
+	}
+	l, r, err := convertPair(node.Left, node.Right, lookup)
+	if err != nil {
+		return nil, err
+	}
+	return &Arithmetic{Op: op, Left: l, Right: r}, nil
+}
+
+func convertUnaryExpr(node *sqlparser.UnaryExpr, lookup ColumnLookup) (Expr, error) {
+	expr, err := Convert(node.Expr, lookup)
+	if err != nil {
+		return nil, err
+	}
+	switch node.Operator {
+	case sqlparser.UPlusStr:
+		return expr, nil
+	case sqlparser.UMinusStr:
+		return &Negate{Expr: expr}, nil
+	case sqlparser.BangStr:
+		return &Not{Expr: expr}, nil
+	}
+	return nil, unsupported(node)
+}
+
+func convertFuncExpr(node *sqlparser.FuncExpr, lookup ColumnLookup) (Expr, error) {
+	if node.Distinct || !node.Qualifier.IsEmpty() || node.IsAggregate() {
+		return nil, unsupported(node)
+	}
+	args := make([]Expr, 0, len(node.Exprs))
+	for _, selectExpr := range node.Exprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, unsupported(node)
+		}
+		arg, err := Convert(aliased.Expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return NewFunction(node.Name.Lowered(), args)
+}
+
+func convertSubstrExpr(node *sqlparser.SubstrExpr, lookup ColumnLookup) (Expr, error) {
+	var str sqlparser.Expr = node.Name
+	if node.StrVal != nil {
+		str = node.StrVal
+	}
+	args := []sqlparser.Expr{str, node.From}
+	if node.To != nil {
+		args = append(args, node.To)
+	}
+	exprs := make([]Expr, 0, len(args))
+	for _, arg := range args {
+		expr, err := Convert(arg, lookup)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	return NewFunction("substring", exprs)
+}
+
+func convertCaseExpr(node *sqlparser.CaseExpr, lookup ColumnLookup) (Expr, error) {
+	c := &Case{}
+	var err error
+	if node.Expr != nil {
+		if c.Expr, err = Convert(node.Expr, lookup); err != nil {
+			return nil, err
+		}
+	}
+	for _, when := range node.Whens {
+		cond, val, err := convertPair(when.Cond, when.Val, lookup)
+		if err != nil {
+			return nil, err
+		}
+		c.Whens = append(c.Whens, When{Cond: cond, Val: val})
+	}
+	if node.Else != nil {
+		if c.Else, err = Convert(node.Else, lookup); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// testLookup resolves the columns a, b, c and count(*) to the first
+// four values of the row.
+func testLookup(expr sqlparser.Expr) (int, bool) {
+	switch node := expr.(type) {
+	case *sqlparser.ColName:
+		switch node.Name.Lowered() {
+		case "a":
+			return 0, true
+		case "b":
+			return 1, true
+		case "c":
+			return 2, true
+		}
+	case *sqlparser.FuncExpr:
+		if sqlparser.String(node) == "count(*)" {
+			return 3, true
+		}
+	}
+	return 0, false
+}
+
+func parseExpr(t *testing.T, sql string) sqlparser.Expr {
+	t.Helper()
+	stmt, err := sqlparser.Parse("select " + sql + " from t")
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", sql, err)
+	}
+	return stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+}
+
+func TestEvaluate(t *testing.T) {
+	env := ExpressionEnv{
+		BindVars: map[string]*querypb.BindVariable{
+			"v": sqltypes.Int64BindVariable(5),
+		},
+		Row: []sqltypes.Value{
+			sqltypes.NewInt64(10),
+			sqltypes.NewVarChar("Hello"),
+			sqltypes.NULL,
+			sqltypes.NewInt64(4),
+		},
+	}
+	testcases := []struct {
+		expr string
+		out  string
+	}{
+		// Arithmetic.
+		{"a + 1", "INT64(11)"},
+		{"a - :v", "INT64(5)"},
+		{"a * count(*)", "INT64(40)"},
+		{"a / count(*)", "FLOAT64(2.5)"},
+		{"a div count(*)", "INT64(2)"},
+		{"a % count(*)", "INT64(2)"},
+		{"a / 0", "NULL"},
+		{"a % 0", "NULL"},
+		{"a + c", "NULL"},
+		{"-a", "INT64(-10)"},
+		{"1.5 * 2", "FLOAT64(3)"},
+
+		// Comparisons and logic.
+		{"a = 10", "INT64(1)"},
+		{"a != 10", "INT64(0)"},
+		{"a > count(*)", "INT64(1)"},
+		{"a <= '9'", "INT64(0)"},
+		{"b = 'Hello'", "INT64(1)"},
+		{"b < 'Z'", "INT64(1)"},
+		{"c = 1", "NULL"},
+		{"c <=> null", "INT64(1)"},
+		{"a <=> c", "INT64(0)"},
+		{"a between 5 and 10", "INT64(1)"},
+		{"a not between 5 and 10", "INT64(0)"},
+		{"a > 1 and c = 1", "NULL"},
+		{"a < 1 and c = 1", "INT64(0)"},
+		{"a > 1 or c = 1", "INT64(1)"},
+		{"a < 1 or c = 1", "NULL"},
+		{"not a", "INT64(0)"},
+		{"not c", "NULL"},
+		{"c is null", "INT64(1)"},
+		{"a is not null", "INT64(1)"},
+		{"c is true", "INT64(0)"},
+		{"c is not false", "INT64(1)"},
+		{"a is false", "INT64(0)"},
+
+		// CASE.
+		{"case when a > 5 then 'big' else 'small' end", "VARBINARY(\"big\")"},
+		{"case count(*) when 1 then 'one' when 4 then 'four' end", "VARBINARY(\"four\")"},
+		{"case c when 1 then 'one' end", "NULL"},
+
+		// Functions.
+		{"concat(b, ' ', a)", "VARCHAR(\"Hello 10\")"},
+		{"concat(b, c)", "NULL"},
+		{"concat_ws('-', b, c, a)", "VARCHAR(\"Hello-10\")"},
+		{"lower(b)", "VARCHAR(\"hello\")"},
+		{"ucase(b)", "VARCHAR(\"HELLO\")"},
+		{"length(b)", "INT64(5)"},
+		{"char_length('été')", "INT64(3)"},
+		{"substr(b, 2, 3)", "VARCHAR(\"ell\")"},
+		{"substring(b, -2)", "VARCHAR(\"lo\")"},
+		{"substring(b from 2 for 2)", "VARCHAR(\"el\")"},
+		{"left(b, 2)", "VARCHAR(\"He\")"},
+		{"right(b, 10)", "VARCHAR(\"Hello\")"},
+		{"trim('  x  ')", "VARCHAR(\"x\")"},
+		{"rtrim('  x  ')", "VARCHAR(\"  x\")"},
+		{"replace(b, 'l', 'L')", "VARCHAR(\"HeLLo\")"},
+		{"reverse(b)", "VARCHAR(\"olleH\")"},
+		{"abs(-a)", "INT64(10)"},
+		{"ceil(2.1)", "INT64(3)"},
+		{"floor(-2.1)", "INT64(-3)"},
+		{"round(2.5)", "INT64(3)"},
+		{"round(a / 3, 2)", "FLOAT64(3.33)"},
+		{"greatest(a, count(*), 7)", "INT64(10)"},
+		{"least(a, count(*), 7)", "INT64(4)"},
+		{"ifnull(c, a)", "INT64(10)"},
+		{"coalesce(c, c, b)", "VARCHAR(\"Hello\")"},
+		{"nullif(a, 10)", "NULL"},
+		{"if(a > 5, 'yes', 'no')", "VARBINARY(\"yes\")"},
+		{"year('2019-07-04 10:11:12')", "INT64(2019)"},
+		{"month('2019-07-04')", "INT64(7)"},
+		{"dayofmonth('2019-07-04')", "INT64(4)"},
+		{"hour('2019-07-04 10:11:12')", "INT64(10)"},
+		{"minute('2019-07-04 10:11:12')", "INT64(11)"},
+		{"second('2019-07-04 10:11:12')", "INT64(12)"},
+		{"date('2019-07-04 10:11:12')", "DATE(\"2019-07-04\")"},
+		{"datediff('2019-07-04 23:00:00', '2019-06-30')", "INT64(4)"},
+		{"year('not a date')", "NULL"},
+	}
+	for _, tc := range testcases {
+		expr, err := Convert(parseExpr(t, tc.expr), testLookup)
+		if err != nil {
+			t.Errorf("Convert(%s): %v", tc.expr, err)
+			continue
+		}
+		got, err := expr.Evaluate(env)
+		if err != nil {
+			t.Errorf("Evaluate(%s): %v", tc.expr, err)
+			continue
+		}
+		if got.String() != tc.out {
+			t.Errorf("Evaluate(%s): %v, want %s", tc.expr, got, tc.out)
+		}
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	testcases := []struct {
+		expr string
+		code vtrpcpb.Code
+		err  string
+	}{{
+		expr: "d + 1",
+		code: vtrpcpb.Code_UNIMPLEMENTED,
+		err:  "unsupported: expression d cannot be evaluated by vtgate",
+	}, {
+		expr: "sum(a) + 1",
+		code: vtrpcpb.Code_UNIMPLEMENTED,
+		err:  "unsupported: expression sum(a) cannot be evaluated by vtgate",
+	}, {
+		expr: "b like 'H%'",
+		code: vtrpcpb.Code_UNIMPLEMENTED,
+		err:  "unsupported: expression b like 'H%' cannot be evaluated by vtgate",
+	}, {
+		expr: "md5(b)",
+		code: vtrpcpb.Code_UNIMPLEMENTED,
+		err:  "unsupported: function md5",
+	}, {
+		expr: "lower(a, b)",
+		code: vtrpcpb.Code_INVALID_ARGUMENT,
+		err:  "incorrect parameter count in the call to function lower",
+	}}
+	for _, tc := range testcases {
+		_, err := Convert(parseExpr(t, tc.expr), testLookup)
+		if err == nil || err.Error() != tc.err || vterrors.Code(err) != tc.code {
+			t.Errorf("Convert(%s): %v, want %v: %s", tc.expr, err, tc.code, tc.err)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	expr, err := Convert(parseExpr(t, "a + :missing"), testLookup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{sqltypes.NewInt64(1)}})
+	want := "missing bind var missing"
+	if err == nil || err.Error() != want {
+		t.Errorf("Evaluate: %v, want %s", err, want)
+	}
+
+	expr = &Column{Offset: 2}
+	_, err = expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{sqltypes.NewInt64(1)}})
+	if err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Errorf("Evaluate: %v, want out of range error", err)
+	}
+}
+
+func TestEvaluateBool(t *testing.T) {
+	env := ExpressionEnv{Row: []sqltypes.Value{sqltypes.NewInt64(0), sqltypes.NewVarChar("1abc"), sqltypes.NULL}}
+	testcases := []struct {
+		expr string
+		out  bool
+	}{
+		{"a", false},
+		{"b", true},
+		{"c", false},
+		{"not a", true},
+		{"a = 0 and b", true},
+	}
+	for _, tc := range testcases {
+		expr, err := Convert(parseExpr(t, tc.expr), testLookup)
+		if err != nil {
+			t.Fatalf("Convert(%s): %v", tc.expr, err)
+		}
+		got, err := EvaluateBool(expr, env)
+		if err != nil {
+			t.Fatalf("EvaluateBool(%s): %v", tc.expr, err)
+		}
+		if got != tc.out {
+			t.Errorf("EvaluateBool(%s): %v, want %v", tc.expr, got, tc.out)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	expr, err := Convert(parseExpr(t, "case when count(*) > 1 then concat(b, 'x') else -a / :v end"), testLookup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "case when ([COLUMN 3] > 1) then concat([COLUMN 1], 'x') else (-[COLUMN 0] / :v) end"
+	if got := expr.String(); got != want {
+		t.Errorf("String: %s, want %s", got, want)
+	}
+	b, err := json.Marshal(expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `"case when ([COLUMN 3] \u003e 1) then concat([COLUMN 1], 'x') else (-[COLUMN 0] / :v) end"`; got != want {
+		t.Errorf("MarshalJSON: %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,625 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package evalengine evaluates SQL expressions in vtgate. It is used
+// for the expressions that can't be pushed down to the shards, like
+// the ones computed from the merged results of aggregate functions.
+package evalengine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// ExpressionEnv is the environment an expression is evaluated in: the
+// bind variables of the query, and the row the columns refer to.
+type ExpressionEnv struct {
+	BindVars map[string]*querypb.BindVariable
+	Row      []sqltypes.Value
+}
+
+// Expr is an expression that can be evaluated by vtgate.
+type Expr interface {
+	// Evaluate returns the value of the expression. NULL values
+	// propagate like in MySQL.
+	Evaluate(env ExpressionEnv) (sqltypes.Value, error)
+	// String returns the expression in a format readable in plans.
+	String() string
+}
+
+var (
+	_ Expr = (*Literal)(nil)
+	_ Expr = (*BindVariable)(nil)
+	_ Expr = (*Column)(nil)
+	_ Expr = (*Arithmetic)(nil)
+	_ Expr = (*Comparison)(nil)
+	_ Expr = (*Logical)(nil)
+	_ Expr = (*Not)(nil)
+	_ Expr = (*IsNull)(nil)
+	_ Expr = (*Negate)(nil)
+	_ Expr = (*Case)(nil)
+	_ Expr = (*Function)(nil)
+)
+
+var (
+	boolTrue  = sqltypes.NewInt64(1)
+	boolFalse = sqltypes.NewInt64(0)
+)
+
+func boolValue(b bool) sqltypes.Value {
+	if b {
+		return boolTrue
+	}
+	return boolFalse
+}
+
+// marshalExpr marshals expressions as their string representation,
+// which is how they appear in the JSON plans.
+func marshalExpr(expr Expr) ([]byte, error) {
+	return json.Marshal(expr.String())
+}
+
+// Literal is a constant value.
+type Literal struct {
+	Val sqltypes.Value
+}
+
+// Evaluate implements the Expr interface.
+func (l *Literal) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	return l.Val, nil
+}
+
+func (l *Literal) String() string {
+	var buf bytes.Buffer
+	l.Val.EncodeSQL(&buf)
+	return buf.String()
+}
+
+// MarshalJSON marshals the literal as its string representation.
+func (l *Literal) MarshalJSON() ([]byte, error) {
+	return marshalExpr(l)
+}
+
+// BindVariable is a bind variable of the query.
+type BindVariable struct {
+	Key string
+}
+
+// Evaluate implements the Expr interface.
+func (b *BindVariable) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	bv, ok := env.BindVars[b.Key]
+	if !ok {
+		return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "missing bind var %s", b.Key)
+	}
+	if bv.Type == querypb.Type_TUPLE {
+		return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "TUPLE was supplied for single value bind var %s", b.Key)
+	}
+	return sqltypes.MakeTrusted(bv.Type, bv.Value), nil
+}
+
+func (b *BindVariable) String() string {
+	return ":" + b.Key
+}
+
+// MarshalJSON marshals the bind variable as its string representation.
+func (b *BindVariable) MarshalJSON() ([]byte, error) {
+	return marshalExpr(b)
+}
+
+// Column is a column of the row the expression is evaluated on.
+type Column struct {
+	Offset int
+}
+
+// Evaluate implements the Expr interface.
+func (c *Column) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	if c.Offset >= len(env.Row) {
+		return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "column %d is out of range of a row of %d values", c.Offset, len(env.Row))
+	}
+	return env.Row[c.Offset], nil
+}
+
+func (c *Column) String() string {
+	return fmt.Sprintf("[COLUMN %d]", c.Offset)
+}
+
+// MarshalJSON marshals the column as its string representation.
+func (c *Column) MarshalJSON() ([]byte, error) {
+	return marshalExpr(c)
+}
+
+// ArithmeticOp is an arithmetic operator.
+type ArithmeticOp string
+
+// The supported arithmetic operators.
+const (
+	OpAdd      = ArithmeticOp("+")
+	OpSubtract = ArithmeticOp("-")
+	OpMultiply = ArithmeticOp("*")
+	OpDivide   = ArithmeticOp("/")
+	OpIntDiv   = ArithmeticOp("div")
+	OpMod      = ArithmeticOp("%")
+)
+
+// Arithmetic is an arithmetic operation on two values. A division by
+// zero returns NULL, like in MySQL.
+type Arithmetic struct {
+	Op          ArithmeticOp
+	Left, Right Expr
+}
+
+// Evaluate implements the Expr interface.
+func (a *Arithmetic) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lv, err := a.Left.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	rv, err := a.Right.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if lv.IsNull() || rv.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	switch a.Op {
+	case OpAdd:
+		return sqltypes.Add(lv, rv)
+	case OpSubtract:
+		return sqltypes.Subtract(lv, rv)
+	case OpMultiply:
+		return sqltypes.Multiply(lv, rv)
+	case OpDivide:
+		return sqltypes.Divide(lv, rv)
+	case OpIntDiv:
+		return intDivide(lv, rv)
+	case OpMod:
+		return modulo(lv, rv)
+	}
+	return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected arithmetic operator %s", a.Op)
+}
+
+func (a *Arithmetic) String() string {
+	return fmt.Sprintf("(%s %s %s)", a.Left, a.Op, a.Right)
+}
+
+// MarshalJSON marshals the operation as its string representation.
+func (a *Arithmetic) MarshalJSON() ([]byte, error) {
+	return marshalExpr(a)
+}
+
+func intDivide(lv, rv sqltypes.Value) (sqltypes.Value, error) {
+	if lv.IsIntegral() && rv.IsIntegral() {
+		l, err := sqltypes.ToInt64(lv)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		r, err := sqltypes.ToInt64(rv)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if r == 0 {
+			return sqltypes.NULL, nil
+		}
+		return sqltypes.NewInt64(l / r), nil
+	}
+	l, r, err := toFloats(lv, rv)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if r == 0 {
+		return sqltypes.NULL, nil
+	}
+	return sqltypes.NewInt64(int64(l / r)), nil
+}
+
+func modulo(lv, rv sqltypes.Value) (sqltypes.Value, error) {
+	if lv.IsIntegral() && rv.IsIntegral() {
+		l, err := sqltypes.ToInt64(lv)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		r, err := sqltypes.ToInt64(rv)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if r == 0 {
+			return sqltypes.NULL, nil
+		}
+		return sqltypes.NewInt64(l % r), nil
+	}
+	l, r, err := toFloats(lv, rv)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if r == 0 {
+		return sqltypes.NULL, nil
+	}
+	return sqltypes.NewFloat64(math.Mod(l, r)), nil
+}
+
+// ComparisonOp is a comparison operator.
+type ComparisonOp string
+
+// The supported comparison operators.
+const (
+	OpEqual         = ComparisonOp("=")
+	OpNotEqual      = ComparisonOp("!=")
+	OpLessThan      = ComparisonOp("<")
+	OpLessEqual     = ComparisonOp("<=")
+	OpGreaterThan   = ComparisonOp(">")
+	OpGreaterEqual  = ComparisonOp(">=")
+	OpNullSafeEqual = ComparisonOp("<=>")
+)
+
+// Comparison compares two values. It returns 1 or 0, or NULL if one of
+// the values is NULL, except for the null-safe equality. The values
+// are compared as numbers if one of them is a number, and as bytes
+// otherwise.
+type Comparison struct {
+	Op          ComparisonOp
+	Left, Right Expr
+}
+
+// Evaluate implements the Expr interface.
+func (c *Comparison) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lv, err := c.Left.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	rv, err := c.Right.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if c.Op == OpNullSafeEqual {
+		if lv.IsNull() || rv.IsNull() {
+			return boolValue(lv.IsNull() && rv.IsNull()), nil
+		}
+	} else if lv.IsNull() || rv.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	cmp, err := compare(lv, rv)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch c.Op {
+	case OpEqual, OpNullSafeEqual:
+		return boolValue(cmp == 0), nil
+	case OpNotEqual:
+		return boolValue(cmp != 0), nil
+	case OpLessThan:
+		return boolValue(cmp < 0), nil
+	case OpLessEqual:
+		return boolValue(cmp <= 0), nil
+	case OpGreaterThan:
+		return boolValue(cmp > 0), nil
+	case OpGreaterEqual:
+		return boolValue(cmp >= 0), nil
+	}
+	return sqltypes.NULL, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected comparison operator %s", c.Op)
+}
+
+func (c *Comparison) String() string {
+	return fmt.Sprintf("(%s %s %s)", c.Left, c.Op, c.Right)
+}
+
+// MarshalJSON marshals the comparison as its string representation.
+func (c *Comparison) MarshalJSON() ([]byte, error) {
+	return marshalExpr(c)
+}
+
+// compare compares two non NULL values.
+func compare(v1, v2 sqltypes.Value) (int, error) {
+	if isNumber(v1) || isNumber(v2) {
+		return sqltypes.NullsafeCompare(v1, v2)
+	}
+	return bytes.Compare(v1.ToBytes(), v2.ToBytes()), nil
+}
+
+// LogicalOp is a logical operator.
+type LogicalOp string
+
+// The supported logical operators.
+const (
+	OpAnd = LogicalOp("and")
+	OpOr  = LogicalOp("or")
+)
+
+// Logical is a logical AND or OR, with the three-valued logic of SQL.
+type Logical struct {
+	Op          LogicalOp
+	Left, Right Expr
+}
+
+// Evaluate implements the Expr interface.
+func (l *Logical) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	left, err := evaluateBool(l.Left, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	// The right side is not evaluated if the left side decides.
+	switch {
+	case l.Op == OpAnd && left == boolFalseState:
+		return boolFalse, nil
+	case l.Op == OpOr && left == boolTrueState:
+		return boolTrue, nil
+	}
+	right, err := evaluateBool(l.Right, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if l.Op == OpAnd {
+		switch {
+		case right == boolFalseState:
+			return boolFalse, nil
+		case left == boolNullState || right == boolNullState:
+			return sqltypes.NULL, nil
+		}
+		return boolTrue, nil
+	}
+	switch {
+	case right == boolTrueState:
+		return boolTrue, nil
+	case left == boolNullState || right == boolNullState:
+		return sqltypes.NULL, nil
+	}
+	return boolFalse, nil
+}
+
+func (l *Logical) String() string {
+	return fmt.Sprintf("(%s %s %s)", l.Left, l.Op, l.Right)
+}
+
+// MarshalJSON marshals the operation as its string representation.
+func (l *Logical) MarshalJSON() ([]byte, error) {
+	return marshalExpr(l)
+}
+
+// Not is a logical NOT.
+type Not struct {
+	Expr Expr
+}
+
+// Evaluate implements the Expr interface.
+func (n *Not) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	b, err := evaluateBool(n.Expr, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch b {
+	case boolTrueState:
+		return boolFalse, nil
+	case boolFalseState:
+		return boolTrue, nil
+	}
+	return sqltypes.NULL, nil
+}
+
+func (n *Not) String() string {
+	return fmt.Sprintf("not %s", n.Expr)
+}
+
+// MarshalJSON marshals the operation as its string representation.
+func (n *Not) MarshalJSON() ([]byte, error) {
+	return marshalExpr(n)
+}
+
+// IsNull checks if a value is NULL, or is not if Negated is set.
+type IsNull struct {
+	Expr    Expr
+	Negated bool
+}
+
+// Evaluate implements the Expr interface.
+func (i *IsNull) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	v, err := i.Expr.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return boolValue(v.IsNull() != i.Negated), nil
+}
+
+func (i *IsNull) String() string {
+	if i.Negated {
+		return fmt.Sprintf("%s is not null", i.Expr)
+	}
+	return fmt.Sprintf("%s is null", i.Expr)
+}
+
+// MarshalJSON marshals the check as its string representation.
+func (i *IsNull) MarshalJSON() ([]byte, error) {
+	return marshalExpr(i)
+}
+
+// Negate is the unary minus.
+type Negate struct {
+	Expr Expr
+}
+
+// Evaluate implements the Expr interface.
+func (n *Negate) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	v, err := n.Expr.Evaluate(env)
+	if err != nil || v.IsNull() {
+		return sqltypes.NULL, err
+	}
+	return sqltypes.Subtract(sqltypes.NewInt64(0), v)
+}
+
+func (n *Negate) String() string {
+	return fmt.Sprintf("-%s", n.Expr)
+}
+
+// MarshalJSON marshals the operation as its string representation.
+func (n *Negate) MarshalJSON() ([]byte, error) {
+	return marshalExpr(n)
+}
+
+// When is a branch of a Case.
+type When struct {
+	Cond, Val Expr
+}
+
+// Case is a CASE expression. If Expr is set, it is compared to the
+// conditions of the branches. Otherwise the conditions are evaluated
+// as booleans.
+type Case struct {
+	Expr  Expr
+	Whens []When
+	Else  Expr
+}
+
+// Evaluate implements the Expr interface.
+func (c *Case) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	var val sqltypes.Value
+	if c.Expr != nil {
+		var err error
+		if val, err = c.Expr.Evaluate(env); err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+	for _, when := range c.Whens {
+		var match bool
+		if c.Expr != nil {
+			cond, err := when.Cond.Evaluate(env)
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+			if !val.IsNull() && !cond.IsNull() {
+				cmp, err := compare(val, cond)
+				if err != nil {
+					return sqltypes.NULL, err
+				}
+				match = cmp == 0
+			}
+		} else {
+			b, err := evaluateBool(when.Cond, env)
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+			match = b == boolTrueState
+		}
+		if match {
+			return when.Val.Evaluate(env)
+		}
+	}
+	if c.Else == nil {
+		return sqltypes.NULL, nil
+	}
+	return c.Else.Evaluate(env)
+}
+
+func (c *Case) String() string {
+	var buf strings.Builder
+	buf.WriteString("case ")
+	if c.Expr != nil {
+		fmt.Fprintf(&buf, "%s ", c.Expr)
+	}
+	for _, when := range c.Whens {
+		fmt.Fprintf(&buf, "when %s then %s ", when.Cond, when.Val)
+	}
+	if c.Else != nil {
+		fmt.Fprintf(&buf, "else %s ", c.Else)
+	}
+	buf.WriteString("end")
+	return buf.String()
+}
+
+// MarshalJSON marshals the case as its string representation.
+func (c *Case) MarshalJSON() ([]byte, error) {
+	return marshalExpr(c)
+}
+
+// boolState is the result of a condition in the three-valued logic
+// of SQL.
+type boolState int
+
+const (
+	boolFalseState = boolState(iota)
+	boolTrueState
+	boolNullState
+)
+
+// evaluateBool evaluates a condition. Numbers are true if they are not
+// zero, and strings are converted to numbers like in MySQL.
+func evaluateBool(expr Expr, env ExpressionEnv) (boolState, error) {
+	v, err := expr.Evaluate(env)
+	if err != nil {
+		return boolNullState, err
+	}
+	return toBool(v), nil
+}
+
+func toBool(v sqltypes.Value) boolState {
+	if v.IsNull() {
+		return boolNullState
+	}
+	if toFloat(v) != 0 {
+		return boolTrueState
+	}
+	return boolFalseState
+}
+
+// EvaluateBool evaluates a condition, and returns true only if it is
+// true: false and NULL conditions both return false, like in a WHERE
+// or HAVING clause.
+func EvaluateBool(expr Expr, env ExpressionEnv) (bool, error) {
+	b, err := evaluateBool(expr, env)
+	return b == boolTrueState, err
+}
+
+func isNumber(v sqltypes.Value) bool {
+	return v.IsIntegral() || v.IsFloat() || v.Type() == sqltypes.Decimal
+}
+
+// toFloat converts a value to a number like MySQL does: strings are
+// converted from their numeric prefix, if any.
+func toFloat(v sqltypes.Value) float64 {
+	if f, err := sqltypes.ToFloat64(v); err == nil && isNumber(v) {
+		return f
+	}
+	s := strings.TrimSpace(v.ToString())
+	end := 0
+	for end < len(s) && strings.IndexByte("+-.0123456789eE", s[end]) >= 0 {
+		end++
+	}
+	for ; end > 0; end-- {
+		if f, err := strconv.ParseFloat(s[:end], 64); err == nil {
+			return f
+		}
+	}
+	return 0
+}
+
+func toFloats(v1, v2 sqltypes.Value) (float64, float64, error) {
+	f1, err := sqltypes.ToFloat64(v1)
+	if err != nil {
+		return 0, 0, err
+	}
+	f2, err := sqltypes.ToFloat64(v2)
+	if err != nil {
+		return 0, 0, err
+	}
+	return f1, f2, nil
+}
@@ -0,0 +1,437 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// builtin is a function that can be evaluated by vtgate. Functions
+// with nullable set are called with NULL arguments, the others return
+// NULL if any of their arguments is NULL. typ returns the type of the
+// result from the types of the arguments.
+type builtin struct {
+	minArgs, maxArgs int
+	nullable         bool
+	call             func(args []sqltypes.Value) (sqltypes.Value, error)
+	typ              func(args []querypb.Type) querypb.Type
+}
+
+// variadic is the maxArgs of the functions that accept any number of
+// arguments.
+const variadic = -1
+
+var builtins map[string]*builtin
+
+func init() {
+	builtins = map[string]*builtin{
+		// String functions.
+		"concat":           {minArgs: 1, maxArgs: variadic, call: concat, typ: returns(sqltypes.VarChar)},
+		"concat_ws":        {minArgs: 2, maxArgs: variadic, nullable: true, call: concatWS, typ: returns(sqltypes.VarChar)},
+		"lower":            {minArgs: 1, maxArgs: 1, call: lower, typ: returns(sqltypes.VarChar)},
+		"lcase":            {minArgs: 1, maxArgs: 1, call: lower, typ: returns(sqltypes.VarChar)},
+		"upper":            {minArgs: 1, maxArgs: 1, call: upper, typ: returns(sqltypes.VarChar)},
+		"ucase":            {minArgs: 1, maxArgs: 1, call: upper, typ: returns(sqltypes.VarChar)},
+		"length":           {minArgs: 1, maxArgs: 1, call: length, typ: returns(sqltypes.Int64)},
+		"char_length":      {minArgs: 1, maxArgs: 1, call: charLength, typ: returns(sqltypes.Int64)},
+		"character_length": {minArgs: 1, maxArgs: 1, call: charLength, typ: returns(sqltypes.Int64)},
+		"substring":        {minArgs: 2, maxArgs: 3, call: substring, typ: returns(sqltypes.VarChar)},
+		"substr":           {minArgs: 2, maxArgs: 3, call: substring, typ: returns(sqltypes.VarChar)},
+		"left":             {minArgs: 2, maxArgs: 2, call: left, typ: returns(sqltypes.VarChar)},
+		"right":            {minArgs: 2, maxArgs: 2, call: right, typ: returns(sqltypes.VarChar)},
+		"trim":             {minArgs: 1, maxArgs: 1, call: trim(trimSpace), typ: returns(sqltypes.VarChar)},
+		"ltrim":            {minArgs: 1, maxArgs: 1, call: trim(trimLeftSpace), typ: returns(sqltypes.VarChar)},
+		"rtrim":            {minArgs: 1, maxArgs: 1, call: trim(trimRightSpace), typ: returns(sqltypes.VarChar)},
+		"replace":          {minArgs: 3, maxArgs: 3, call: replace, typ: returns(sqltypes.VarChar)},
+		"reverse":          {minArgs: 1, maxArgs: 1, call: reverse, typ: returns(sqltypes.VarChar)},
+
+		// Numeric functions.
+		"abs":      {minArgs: 1, maxArgs: 1, call: abs, typ: absType},
+		"ceil":     {minArgs: 1, maxArgs: 1, call: rounding(math.Ceil), typ: roundingType},
+		"ceiling":  {minArgs: 1, maxArgs: 1, call: rounding(math.Ceil), typ: roundingType},
+		"floor":    {minArgs: 1, maxArgs: 1, call: rounding(math.Floor), typ: roundingType},
+		"round":    {minArgs: 1, maxArgs: 2, call: round, typ: roundType},
+		"greatest": {minArgs: 2, maxArgs: variadic, call: extreme(1), typ: commonType},
+		"least":    {minArgs: 2, maxArgs: variadic, call: extreme(-1), typ: commonType},
+
+		// Control flow functions.
+		"ifnull":   {minArgs: 2, maxArgs: 2, nullable: true, call: coalesce, typ: commonType},
+		"coalesce": {minArgs: 1, maxArgs: variadic, nullable: true, call: coalesce, typ: commonType},
+		"nullif":   {minArgs: 2, maxArgs: 2, nullable: true, call: nullif, typ: nullifType},
+		"if":       {minArgs: 3, maxArgs: 3, nullable: true, call: ifFunc, typ: ifType},
+
+		// Date functions.
+		"year":       {minArgs: 1, maxArgs: 1, call: datePart(func(t time.Time) int { return t.Year() }), typ: returns(sqltypes.Int64)},
+		"month":      {minArgs: 1, maxArgs: 1, call: datePart(func(t time.Time) int { return int(t.Month()) }), typ: returns(sqltypes.Int64)},
+		"day":        {minArgs: 1, maxArgs: 1, call: datePart(func(t time.Time) int { return t.Day() }), typ: returns(sqltypes.Int64)},
+		"dayofmonth": {minArgs: 1, maxArgs: 1, call: datePart(func(t time.Time) int { return t.Day() }), typ: returns(sqltypes.Int64)},
+		"hour":       {minArgs: 1, maxArgs: 1, call: datePart(func(t time.Time) int { return t.Hour() }), typ: returns(sqltypes.Int64)},
+		"minute":     {minArgs: 1, maxArgs: 1, call: datePart(func(t time.Time) int { return t.Minute() }), typ: returns(sqltypes.Int64)},
+		"second":     {minArgs: 1, maxArgs: 1, call: datePart(func(t time.Time) int { return t.Second() }), typ: returns(sqltypes.Int64)},
+		"date":       {minArgs: 1, maxArgs: 1, call: date, typ: returns(sqltypes.Date)},
+		"datediff":   {minArgs: 2, maxArgs: 2, call: datediff, typ: returns(sqltypes.Int64)},
+	}
+}
+
+// IsSupportedFunction returns true if vtgate can evaluate the function.
+func IsSupportedFunction(name string) bool {
+	_, ok := builtins[strings.ToLower(name)]
+	return ok
+}
+
+// Function is a call to one of the functions vtgate can evaluate.
+type Function struct {
+	Name string
+	Args []Expr
+	impl *builtin
+}
+
+// NewFunction returns a call to a function. It fails if the function
+// is not supported or if the number of arguments is wrong.
+func NewFunction(name string, args []Expr) (*Function, error) {
+	name = strings.ToLower(name)
+	impl, ok := builtins[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: function %s", name)
+	}
+	if len(args) < impl.minArgs || (impl.maxArgs != variadic && len(args) > impl.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "incorrect parameter count in the call to function %s", name)
+	}
+	return &Function{Name: name, Args: args, impl: impl}, nil
+}
+
+// Evaluate implements the Expr interface.
+func (f *Function) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	args := make([]sqltypes.Value, 0, len(f.Args))
+	for _, arg := range f.Args {
+		v, err := arg.Evaluate(env)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if v.IsNull() && !f.impl.nullable {
+			return sqltypes.NULL, nil
+		}
+		args = append(args, v)
+	}
+	return f.impl.call(args)
+}
+
+func (f *Function) String() string {
+	args := make([]string, 0, len(f.Args))
+	for _, arg := range f.Args {
+		args = append(args, arg.String())
+	}
+	return fmt.Sprintf("%s(%s)", f.Name, strings.Join(args, ", "))
+}
+
+// MarshalJSON marshals the call as its string representation.
+func (f *Function) MarshalJSON() ([]byte, error) {
+	return marshalExpr(f)
+}
+
+func concat(args []sqltypes.Value) (sqltypes.Value, error) {
+	var buf strings.Builder
+	for _, arg := range args {
+		buf.Write(arg.ToBytes())
+	}
+	return sqltypes.NewVarChar(buf.String()), nil
+}
+
+func concatWS(args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	parts := make([]string, 0, len(args)-1)
+	for _, arg := range args[1:] {
+		if !arg.IsNull() {
+			parts = append(parts, arg.ToString())
+		}
+	}
+	return sqltypes.NewVarChar(strings.Join(parts, args[0].ToString())), nil
+}
+
+func lower(args []sqltypes.Value) (sqltypes.Value, error) {
+	return sqltypes.NewVarChar(strings.ToLower(args[0].ToString())), nil
+}
+
+func upper(args []sqltypes.Value) (sqltypes.Value, error) {
+	return sqltypes.NewVarChar(strings.ToUpper(args[0].ToString())), nil
+}
+
+func length(args []sqltypes.Value) (sqltypes.Value, error) {
+	return sqltypes.NewInt64(int64(len(args[0].ToBytes()))), nil
+}
+
+func charLength(args []sqltypes.Value) (sqltypes.Value, error) {
+	return sqltypes.NewInt64(int64(utf8.RuneCount(args[0].ToBytes()))), nil
+}
+
+// substring implements SUBSTRING(str, pos[, len]). The position starts
+// at 1, and a negative position counts from the end of the string.
+func substring(args []sqltypes.Value) (sqltypes.Value, error) {
+	s := []rune(args[0].ToString())
+	pos, err := toInt(args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch {
+	case pos == 0:
+		return sqltypes.NewVarChar(""), nil
+	case pos > 0:
+		pos--
+	default:
+		pos += int64(len(s))
+	}
+	if pos < 0 || pos >= int64(len(s)) {
+		return sqltypes.NewVarChar(""), nil
+	}
+	end := int64(len(s))
+	if len(args) == 3 {
+		n, err := toInt(args[2])
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if n <= 0 {
+			return sqltypes.NewVarChar(""), nil
+		}
+		if pos+n < end {
+			end = pos + n
+		}
+	}
+	return sqltypes.NewVarChar(string(s[pos:end])), nil
+}
+
+func left(args []sqltypes.Value) (sqltypes.Value, error) {
+	s := []rune(args[0].ToString())
+	n, err := toInt(args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch {
+	case n <= 0:
+		return sqltypes.NewVarChar(""), nil
+	case n < int64(len(s)):
+		s = s[:n]
+	}
+	return sqltypes.NewVarChar(string(s)), nil
+}
+
+func right(args []sqltypes.Value) (sqltypes.Value, error) {
+	s := []rune(args[0].ToString())
+	n, err := toInt(args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch {
+	case n <= 0:
+		return sqltypes.NewVarChar(""), nil
+	case n < int64(len(s)):
+		s = s[int64(len(s))-n:]
+	}
+	return sqltypes.NewVarChar(string(s)), nil
+}
+
+func trimSpace(s string) string {
+	return strings.Trim(s, " ")
+}
+
+func trimLeftSpace(s string) string {
+	return strings.TrimLeft(s, " ")
+}
+
+func trimRightSpace(s string) string {
+	return strings.TrimRight(s, " ")
+}
+
+func trim(f func(string) string) func(args []sqltypes.Value) (sqltypes.Value, error) {
+	return func(args []sqltypes.Value) (sqltypes.Value, error) {
+		return sqltypes.NewVarChar(f(args[0].ToString())), nil
+	}
+}
+
+func replace(args []sqltypes.Value) (sqltypes.Value, error) {
+	return sqltypes.NewVarChar(strings.Replace(args[0].ToString(), args[1].ToString(), args[2].ToString(), -1)), nil
+}
+
+func reverse(args []sqltypes.Value) (sqltypes.Value, error) {
+	s := []rune(args[0].ToString())
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+	return sqltypes.NewVarChar(string(s)), nil
+}
+
+func abs(args []sqltypes.Value) (sqltypes.Value, error) {
+	v := args[0]
+	switch {
+	case v.IsUnsigned():
+		return v, nil
+	case v.IsSigned():
+		i, err := sqltypes.ToInt64(v)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if i < 0 {
+			i = -i
+		}
+		return sqltypes.NewInt64(i), nil
+	}
+	return sqltypes.NewFloat64(math.Abs(toFloat(v))), nil
+}
+
+// rounding returns CEIL or FLOOR. Integers are returned unchanged.
+func rounding(f func(float64) float64) func(args []sqltypes.Value) (sqltypes.Value, error) {
+	return func(args []sqltypes.Value) (sqltypes.Value, error) {
+		if args[0].IsIntegral() {
+			return args[0], nil
+		}
+		return sqltypes.NewInt64(int64(f(toFloat(args[0])))), nil
+	}
+}
+
+// round implements ROUND(x[, d]). Halves are rounded away from zero.
+func round(args []sqltypes.Value) (sqltypes.Value, error) {
+	var d int64
+	if len(args) == 2 {
+		var err error
+		if d, err = toInt(args[1]); err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+	if args[0].IsIntegral() && d >= 0 {
+		return args[0], nil
+	}
+	f := toFloat(args[0])
+	if d == 0 {
+		return sqltypes.NewInt64(int64(math.Round(f))), nil
+	}
+	scale := math.Pow(10, float64(d))
+	return sqltypes.NewFloat64(math.Round(f*scale) / scale), nil
+}
+
+// extreme returns GREATEST if sign is 1, and LEAST if it is -1.
+func extreme(sign int) func(args []sqltypes.Value) (sqltypes.Value, error) {
+	return func(args []sqltypes.Value) (sqltypes.Value, error) {
+		result := args[0]
+		for _, arg := range args[1:] {
+			cmp, err := compare(arg, result)
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+			if cmp*sign > 0 {
+				result = arg
+			}
+		}
+		return result, nil
+	}
+}
+
+func coalesce(args []sqltypes.Value) (sqltypes.Value, error) {
+	for _, arg := range args {
+		if !arg.IsNull() {
+			return arg, nil
+		}
+	}
+	return sqltypes.NULL, nil
+}
+
+func nullif(args []sqltypes.Value) (sqltypes.Value, error) {
+	if args[0].IsNull() || args[1].IsNull() {
+		return args[0], nil
+	}
+	cmp, err := compare(args[0], args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if cmp == 0 {
+		return sqltypes.NULL, nil
+	}
+	return args[0], nil
+}
+
+func ifFunc(args []sqltypes.Value) (sqltypes.Value, error) {
+	if toBool(args[0]) == boolTrueState {
+		return args[1], nil
+	}
+	return args[2], nil
+}
+
+// The formats of the dates and datetimes the date functions accept.
+var dateFormats = []string{
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02",
+}
+
+// parseDate parses a date or a datetime. It returns false if the value
+// is not a valid date, in which case the functions return NULL.
+func parseDate(v sqltypes.Value) (time.Time, bool) {
+	s := strings.TrimSpace(v.ToString())
+	for _, format := range dateFormats {
+		if t, err := time.Parse(format, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func datePart(part func(time.Time) int) func(args []sqltypes.Value) (sqltypes.Value, error) {
+	return func(args []sqltypes.Value) (sqltypes.Value, error) {
+		t, ok := parseDate(args[0])
+		if !ok {
+			return sqltypes.NULL, nil
+		}
+		return sqltypes.NewInt64(int64(part(t))), nil
+	}
+}
+
+func date(args []sqltypes.Value) (sqltypes.Value, error) {
+	t, ok := parseDate(args[0])
+	if !ok {
+		return sqltypes.NULL, nil
+	}
+	return sqltypes.MakeTrusted(sqltypes.Date, []byte(t.Format("2006-01-02"))), nil
+}
+
+// datediff returns the number of days between two dates, ignoring
+// their time.
+func datediff(args []sqltypes.Value) (sqltypes.Value, error) {
+	t1, ok1 := parseDate(args[0])
+	t2, ok2 := parseDate(args[1])
+	if !ok1 || !ok2 {
+		return sqltypes.NULL, nil
+	}
+	d1 := t1.Truncate(24 * time.Hour)
+	d2 := t2.Truncate(24 * time.Hour)
+	return sqltypes.NewInt64(int64(d1.Sub(d2).Hours() / 24)), nil
+}
+
+func toInt(v sqltypes.Value) (int64, error) {
+	if v.IsIntegral() {
+		return sqltypes.ToInt64(v)
+	}
+	return int64(math.Round(toFloat(v))), nil
+}
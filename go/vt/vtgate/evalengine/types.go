@@ -0,0 +1,191 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Type returns the type of the values of an expression evaluated on
+// rows with the given fields. Every value the expression evaluates to
+// can be cast to that type. If the type cannot be inferred, for
+// example because it depends on a bind variable, it is VARBINARY.
+func Type(expr Expr, fields []*querypb.Field) querypb.Type {
+	switch expr := expr.(type) {
+	case *Literal:
+		return expr.Val.Type()
+	case *Column:
+		if expr.Offset < 0 || expr.Offset >= len(fields) || fields[expr.Offset] == nil {
+			return sqltypes.VarBinary
+		}
+		return fields[expr.Offset].Type
+	case *Arithmetic:
+		return arithmeticType(expr.Op, Type(expr.Left, fields), Type(expr.Right, fields))
+	case *Comparison, *Logical, *Not, *IsNull:
+		return sqltypes.Int64
+	case *Negate:
+		return arithmeticType(OpSubtract, sqltypes.Int64, Type(expr.Expr, fields))
+	case *Case:
+		types := make([]querypb.Type, 0, len(expr.Whens)+1)
+		for _, when := range expr.Whens {
+			types = append(types, Type(when.Val, fields))
+		}
+		if expr.Else != nil {
+			types = append(types, Type(expr.Else, fields))
+		}
+		return commonType(types)
+	case *Function:
+		if expr.impl == nil {
+			return sqltypes.VarBinary
+		}
+		types := make([]querypb.Type, 0, len(expr.Args))
+		for _, arg := range expr.Args {
+			types = append(types, Type(arg, fields))
+		}
+		return expr.impl.typ(types)
+	}
+	return sqltypes.VarBinary
+}
+
+// arithmeticType returns the type of an arithmetic operation. It
+// follows the rules of sqltypes: floats win over decimals, which win
+// over unsigned integers, which win over signed ones. The operands
+// that are not numbers are converted at run time to a number of any
+// type, so the result is then a float.
+func arithmeticType(op ArithmeticOp, left, right querypb.Type) querypb.Type {
+	if left == sqltypes.Null || right == sqltypes.Null {
+		return sqltypes.Null
+	}
+	switch op {
+	case OpIntDiv:
+		return sqltypes.Int64
+	case OpMod:
+		if sqltypes.IsIntegral(left) && sqltypes.IsIntegral(right) {
+			return sqltypes.Int64
+		}
+		return sqltypes.Float64
+	}
+	if !isNumberType(left) || !isNumberType(right) {
+		return sqltypes.Float64
+	}
+	typ := numberType([]querypb.Type{left, right})
+	switch {
+	case op == OpDivide && typ != sqltypes.Decimal:
+		return sqltypes.Float64
+	case typ == sqltypes.Decimal && left != sqltypes.Decimal && right != sqltypes.Decimal:
+		// Mixing signed and unsigned integers.
+		return sqltypes.Uint64
+	}
+	return typ
+}
+
+// commonType returns the type all the values of the given types can
+// be cast to. NULL values do not change the type.
+func commonType(types []querypb.Type) querypb.Type {
+	result := sqltypes.Null
+	numbers := true
+	for _, typ := range types {
+		switch {
+		case typ == sqltypes.Null:
+			continue
+		case result == sqltypes.Null:
+			result = typ
+		case typ != result:
+			result = sqltypes.VarBinary
+		}
+		numbers = numbers && isNumberType(typ)
+	}
+	if result == sqltypes.VarBinary && numbers {
+		return numberType(types)
+	}
+	return result
+}
+
+// numberType returns the type the numbers of the given types can be
+// cast to. Mixing signed and unsigned integers requires a decimal.
+func numberType(types []querypb.Type) querypb.Type {
+	var float, decimal, signed, unsigned bool
+	for _, typ := range types {
+		switch {
+		case sqltypes.IsFloat(typ):
+			float = true
+		case typ == sqltypes.Decimal:
+			decimal = true
+		case sqltypes.IsSigned(typ):
+			signed = true
+		case sqltypes.IsUnsigned(typ):
+			unsigned = true
+		}
+	}
+	switch {
+	case float:
+		return sqltypes.Float64
+	case decimal, signed && unsigned:
+		return sqltypes.Decimal
+	case unsigned:
+		return sqltypes.Uint64
+	}
+	return sqltypes.Int64
+}
+
+func isNumberType(typ querypb.Type) bool {
+	return sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) || typ == sqltypes.Decimal
+}
+
+// The typ functions of the builtins.
+
+func returns(typ querypb.Type) func([]querypb.Type) querypb.Type {
+	return func([]querypb.Type) querypb.Type {
+		return typ
+	}
+}
+
+func absType(args []querypb.Type) querypb.Type {
+	switch {
+	case sqltypes.IsUnsigned(args[0]):
+		return args[0]
+	case sqltypes.IsSigned(args[0]):
+		return sqltypes.Int64
+	}
+	return sqltypes.Float64
+}
+
+func roundingType(args []querypb.Type) querypb.Type {
+	if sqltypes.IsIntegral(args[0]) {
+		return args[0]
+	}
+	return sqltypes.Int64
+}
+
+// roundType is the type of ROUND. With a number of decimals, which
+// may be negative, integers can be returned as floats.
+func roundType(args []querypb.Type) querypb.Type {
+	if len(args) == 2 {
+		return sqltypes.Float64
+	}
+	return roundingType(args)
+}
+
+func nullifType(args []querypb.Type) querypb.Type {
+	return args[0]
+}
+
+func ifType(args []querypb.Type) querypb.Type {
+	return commonType(args[1:])
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestType(t *testing.T) {
+	fields := []*querypb.Field{
+		{Name: "a", Type: sqltypes.Int32},
+		{Name: "b", Type: sqltypes.VarChar},
+		{Name: "c", Type: sqltypes.Uint64},
+		{Name: "count(*)", Type: sqltypes.Decimal},
+	}
+	env := ExpressionEnv{
+		BindVars: map[string]*querypb.BindVariable{
+			"v": sqltypes.Int64BindVariable(5),
+		},
+		Row: []sqltypes.Value{
+			sqltypes.NewInt32(10),
+			sqltypes.NewVarChar("12"),
+			sqltypes.NewUint64(4),
+			sqltypes.MakeTrusted(sqltypes.Decimal, []byte("2.5")),
+		},
+	}
+	testcases := []struct {
+		expr string
+		typ  querypb.Type
+	}{
+		{"a", sqltypes.Int32},
+		{"1", sqltypes.Int64},
+		{"null", sqltypes.Null},
+		{":v", sqltypes.VarBinary},
+		{"a + 1", sqltypes.Int64},
+		{"a + c", sqltypes.Uint64},
+		{"a + count(*)", sqltypes.Decimal},
+		{"a + 1.5", sqltypes.Float64},
+		{"a + b", sqltypes.Float64},
+		{"a / 2", sqltypes.Float64},
+		{"count(*) / a", sqltypes.Decimal},
+		{"a div c", sqltypes.Int64},
+		{"a % c", sqltypes.Int64},
+		{"count(*) % a", sqltypes.Float64},
+		{"-a", sqltypes.Int64},
+		{"-count(*)", sqltypes.Decimal},
+		{"a > 1 and b is not null", sqltypes.Int64},
+		{"case when a > 1 then a else c end", sqltypes.Decimal},
+		{"case when a > 1 then b else null end", sqltypes.VarChar},
+		{"case when a > 1 then a else b end", sqltypes.VarBinary},
+		{"concat(a, b)", sqltypes.VarChar},
+		{"length(b)", sqltypes.Int64},
+		{"abs(a)", sqltypes.Int64},
+		{"abs(c)", sqltypes.Uint64},
+		{"abs(count(*))", sqltypes.Float64},
+		{"floor(a)", sqltypes.Int32},
+		{"floor(count(*))", sqltypes.Int64},
+		{"round(a, 1)", sqltypes.Float64},
+		{"greatest(a, c)", sqltypes.Decimal},
+		{"ifnull(count(*), 0)", sqltypes.Decimal},
+		{"if(a > 1, 1.5, a)", sqltypes.Float64},
+		{"nullif(b, 'x')", sqltypes.VarChar},
+		{"date(b)", sqltypes.Date},
+	}
+	for _, tc := range testcases {
+		expr, err := Convert(parseExpr(t, tc.expr), testLookup)
+		if err != nil {
+			t.Errorf("Convert(%s): %v", tc.expr, err)
+			continue
+		}
+		typ := Type(expr, fields)
+		if typ != tc.typ {
+			t.Errorf("Type(%s): %v, want %v", tc.expr, typ, tc.typ)
+		}
+		// The values of the expression can be cast to its type.
+		v, err := expr.Evaluate(env)
+		if err != nil {
+			t.Errorf("Evaluate(%s): %v", tc.expr, err)
+			continue
+		}
+		if _, err := sqltypes.Cast(v, typ); err != nil {
+			t.Errorf("Cast(%v, %v): %v", v, typ, err)
+		}
+	}
+}
+
+func TestTypeWithoutFields(t *testing.T) {
+	expr, err := Convert(parseExpr(t, "a"), testLookup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if typ := Type(expr, nil); typ != sqltypes.VarBinary {
+		t.Errorf("Type(a): %v, want VARBINARY", typ)
+	}
+}
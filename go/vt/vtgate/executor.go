@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -33,18 +34,22 @@ import (
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/authz"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlannotation"
+	"vitess.io/vitess/go/vt/sqlnormalizer"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/gateway"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
@@ -88,6 +93,21 @@ type Executor struct {
 	vschemaStats *VSchemaStats
 
 	vm VSchemaManager
+
+	// authz is nil if external authorization is disabled.
+	authz *authz.Checker
+
+	// mirror is nil if mirroring is disabled.
+	mirror *mirror
+
+	// quotas is nil if the quotas are disabled.
+	quotas *quotaManager
+
+	// tracker is nil if the schema tracking is disabled.
+	tracker *schemaTracker
+
+	// memory is the budget of the memory buffered by the queries.
+	memory *engine.MemoryPool
 }
 
 var executorOnce sync.Once
@@ -103,17 +123,23 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell, statsName strin
 		plans:       cache.NewLRUCache(queryPlanCacheSize),
 		normalize:   normalize,
 		streamSize:  streamSize,
+		mirror:      newMirror(),
+		quotas:      newQuotaManager(),
+		memory:      engine.NewMemoryPool(*maxMemoryBytes),
 	}
 
 	vschemaacl.Init()
+	e.tracker = newSchemaTracker(e)
 	e.vm = VSchemaManager{e: e}
 	e.vm.watchSrvVSchema(ctx, cell)
+	e.tracker.start(ctx, resolver.scatterConn.gateway)
 
 	executorOnce.Do(func() {
 		stats.NewGaugeFunc("QueryPlanCacheLength", "Query plan cache length", e.plans.Length)
 		stats.NewGaugeFunc("QueryPlanCacheSize", "Query plan cache size", e.plans.Size)
 		stats.NewGaugeFunc("QueryPlanCacheCapacity", "Query plan cache capacity", e.plans.Capacity)
 		stats.NewCounterFunc("QueryPlanCacheEvictions", "Query plan cache evictions", e.plans.Evictions)
+		stats.NewGaugeFunc("QueryMemoryBytes", "Bytes buffered in memory by the running queries", e.memory.Used)
 		stats.Publish("QueryPlanCacheOldest", stats.StringFunc(func() string {
 			return fmt.Sprintf("%v", e.plans.Oldest())
 		}))
@@ -129,11 +155,21 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 	span.Annotate("method", method)
 	trace.AnnotateSQL(span, sql)
 	defer span.Finish()
+	ctx = gateway.WithTargetCell(ctx, safeSession.GetOptions().GetTargetCell())
 
 	logStats := NewLogStats(ctx, method, sql, bindVars)
+	// The queries that are executed recursively, like the queries
+	// of lookup vindexes, are part of the trace of the outer query.
+	tracing := !safeSession.tracing && traceEnabled(safeSession.Session, sql)
+	if tracing {
+		defer safeSession.startTrace()()
+	}
 	result, err = e.execute(ctx, safeSession, sql, bindVars, logStats)
 	logStats.Error = err
-	if result != nil && len(result.Rows) > *warnMemoryRows {
+	if tracing && err == nil {
+		recordTrace(safeSession, logStats)
+	}
+	if result != nil && len(result.Rows) > int(warnMemoryRowsLimit.Get()) {
 		warnings.Add("ResultsExceeded", 1)
 	}
 
@@ -180,8 +216,31 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 		safeSession.ClearWarnings()
 	}
 
+	if err := e.checkExternalAuthorization(ctx, sql, stmtType, destKeyspace); err != nil {
+		return nil, err
+	}
+
+	switch stmtType {
+	case sqlparser.StmtSelect, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
+		restoreOptions, err := safeSession.startHints(sql)
+		if err != nil {
+			return nil, err
+		}
+		defer restoreOptions()
+	}
+	ctx = gateway.WithMaxStaleness(ctx, safeSession.GetOptions())
+
 	switch stmtType {
 	case sqlparser.StmtSelect:
+		if e.mirror.shouldMirror(safeSession, destKeyspace, stmtType) {
+			mirrorBindVars := copyBindVars(bindVars)
+			start := time.Now()
+			qr, err := e.handleExec(ctx, safeSession, sql, bindVars, destKeyspace, destTabletType, dest, logStats)
+			if err == nil {
+				e.mirror.send(ctx, e, sql, mirrorBindVars, time.Since(start))
+			}
+			return qr, err
+		}
 		return e.handleExec(ctx, safeSession, sql, bindVars, destKeyspace, destTabletType, dest, logStats)
 	case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
 		safeSession := safeSession
@@ -228,6 +287,8 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 		return e.handleCommit(ctx, safeSession, sql, bindVars, logStats)
 	case sqlparser.StmtRollback:
 		return e.handleRollback(ctx, safeSession, sql, bindVars, logStats)
+	case sqlparser.StmtSavepoint, sqlparser.StmtSRollback, sqlparser.StmtRelease:
+		return e.handleSavepoint(ctx, safeSession, sql, stmtType, logStats)
 	case sqlparser.StmtSet:
 		return e.handleSet(ctx, safeSession, sql, bindVars, logStats)
 	case sqlparser.StmtShow:
@@ -261,17 +322,18 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 
 		}
 
-		execStart := time.Now()
-		sql = sqlannotation.AnnotateIfDML(sql, nil)
-		if e.normalize {
-			query, comments := sqlparser.SplitMarginComments(sql)
-			stmt, err := sqlparser.Parse(query)
+		if _, mustAdmit := e.quotas.enter(ctx); mustAdmit {
+			release, err := e.quotas.admit(ctx, destKeyspace)
 			if err != nil {
 				return nil, err
 			}
-			sqlparser.Normalize(stmt, bindVars, "vtg")
-			normalized := sqlparser.String(stmt)
-			sql = comments.Leading + normalized + comments.Trailing
+			defer release()
+		}
+
+		execStart := time.Now()
+		sql, err := e.destinationQuery(sql, bindVars)
+		if err != nil {
+			return nil, err
 		}
 		logStats.PlanTime = execStart.Sub(logStats.StartTime)
 		logStats.SQL = sql
@@ -283,8 +345,10 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 	}
 
 	// V3 mode.
+	ctx, mustAdmit := e.quotas.enter(ctx)
 	query, comments := sqlparser.SplitMarginComments(sql)
 	vcursor := newVCursorImpl(ctx, safeSession, destKeyspace, destTabletType, comments, e, logStats)
+	defer vcursor.memory.Release()
 	plan, err := e.getPlan(
 		vcursor,
 		query,
@@ -301,6 +365,15 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 		return nil, err
 	}
 
+	if mustAdmit {
+		release, err := e.quotas.admit(ctx, plan.Instructions.GetKeyspaceName())
+		if err != nil {
+			logStats.Error = err
+			return nil, err
+		}
+		defer release()
+	}
+
 	qr, err := plan.Instructions.Execute(vcursor, bindVars, true)
 
 	logStats.ExecuteTime = time.Since(execStart)
@@ -326,6 +399,23 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 	return qr, err
 }
 
+// destinationQuery returns the query sent to the shards of a forced
+// shard or range target: it is annotated, and normalized if the executor
+// normalizes queries. bindVars receives the normalized values.
+func (e *Executor) destinationQuery(sql string, bindVars map[string]*querypb.BindVariable) (string, error) {
+	sql = sqlannotation.AnnotateIfDML(sql, nil)
+	if !e.normalize {
+		return sql, nil
+	}
+	query, comments := sqlparser.SplitMarginComments(sql)
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return "", err
+	}
+	normalized := sqlnormalizer.NormalizeStatement(stmt, bindVars)
+	return comments.Leading + normalized + comments.Trailing, nil
+}
+
 func (e *Executor) destinationExec(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, dest key.Destination, destKeyspace string, destTabletType topodatapb.TabletType, logStats *LogStats) (*sqltypes.Result, error) {
 	return e.resolver.Execute(ctx, sql, bindVars, destKeyspace, destTabletType, dest, safeSession.Session, false /* notInTransaction */, safeSession.Options, logStats)
 }
@@ -364,6 +454,11 @@ func (e *Executor) handleDDL(ctx context.Context, safeSession *SafeSession, sql
 		dest = key.DestinationAllShards{}
 	}
 
+	// A temporary table lives as long as its connection.
+	if *enableReservedConnections && sqlparser.IsTemporaryTableDDL(sql) {
+		safeSession.SetReserved()
+	}
+
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 	result, err := e.destinationExec(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
@@ -398,6 +493,18 @@ func (e *Executor) handleVSchemaDDL(ctx context.Context, safeSession *SafeSessio
 		return errNoKeyspace
 	}
 
+	if ddl.Action == sqlparser.AddAutoIncStr && *autoProvisionSequences {
+		seqKeyspace, err := e.provisionSequence(ctx, vschema, ksName, ddl, logStats)
+		if err != nil {
+			return err
+		}
+		if seqKeyspace != "" && seqKeyspace != ksName {
+			if err := e.vm.UpdateVSchema(ctx, seqKeyspace, vschema); err != nil {
+				return err
+			}
+		}
+	}
+
 	ks := vschema.Keyspaces[ksName]
 	ks, err := topotools.ApplyVSchemaDDL(ksName, ks, ddl)
 
@@ -445,6 +552,46 @@ func (e *Executor) handleRollback(ctx context.Context, safeSession *SafeSession,
 	return &sqltypes.Result{}, err
 }
 
+// handleSavepoint handles SAVEPOINT, ROLLBACK TO SAVEPOINT and RELEASE
+// SAVEPOINT. They're run in all the shard sessions of the transaction,
+// and the savepoints are recorded in the session, to create them on the
+// shards that join the transaction later.
+func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession, sql string, stmtType sqlparser.StatementType, logStats *LogStats) (*sqltypes.Result, error) {
+	name, err := sqlparser.ExtractSavepointName(sql)
+	if err != nil {
+		return nil, err
+	}
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	defer func() {
+		logStats.ExecuteTime = time.Since(execStart)
+	}()
+
+	if !safeSession.InTransaction() {
+		// Like in MySQL, a savepoint outside of a transaction
+		// is a no-op, and there is nothing to roll back to.
+		if stmtType == sqlparser.StmtSavepoint {
+			return &sqltypes.Result{}, nil
+		}
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", name)
+	}
+	switch stmtType {
+	case sqlparser.StmtSavepoint:
+		safeSession.AddSavepoint(name)
+	case sqlparser.StmtSRollback:
+		if !safeSession.RemoveSavepoints(name, false /* inclusive */) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", name)
+		}
+	case sqlparser.StmtRelease:
+		if !safeSession.RemoveSavepoints(name, true /* inclusive */) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", name)
+		}
+	}
+	logStats.ShardQueries = uint32(len(safeSession.PreSessions) + len(safeSession.ShardSessions) + len(safeSession.PostSessions))
+	e.updateQueryCounts("Savepoint", "", "", int64(logStats.ShardQueries))
+	return &sqltypes.Result{}, e.txConn.Savepoint(ctx, safeSession, sql)
+}
+
 func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (*sqltypes.Result, error) {
 	vals, scope, err := sqlparser.ExtractSetValues(sql)
 	execStart := time.Now()
@@ -461,6 +608,8 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 		return &sqltypes.Result{}, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported in set: global")
 	}
 
+	// reservedKeys are the variables set on the reserved connections.
+	reservedKeys := make(map[string]bool)
 	for k, v := range vals {
 		switch k.Scope {
 		case sqlparser.GlobalStr:
@@ -521,6 +670,22 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value for skip_query_plan_cache: %d", val)
 			}
+		case "vitess_trace":
+			val, ok := v.(int64)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value type for vitess_trace: %T", v)
+			}
+			if safeSession.Options == nil {
+				safeSession.Options = &querypb.ExecuteOptions{}
+			}
+			switch val {
+			case 0:
+				safeSession.Options.Trace = false
+			case 1:
+				safeSession.Options.Trace = true
+			default:
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value for vitess_trace: %d", val)
+			}
 		case "sql_safe_updates":
 			val, err := validateSetOnOff(v, k.Key)
 			if err != nil {
@@ -629,6 +794,10 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value type for wait_timeout: %T", v)
 			}
 		case "sql_mode", "net_write_timeout", "net_read_timeout", "lc_messages", "collation_connection", "foreign_key_checks":
+			if *enableReservedConnections {
+				reservedKeys[k.Key] = true
+				continue
+			}
 			log.Warningf("Ignored inapplicable SET %v = %v", k, v)
 			warnings.Add("IgnoredSet", 1)
 		case "charset", "names":
@@ -643,12 +812,50 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 				return nil, fmt.Errorf("unexpected value for charset/names: %v", val)
 			}
 		default:
+			if *enableReservedConnections {
+				reservedKeys[k.Key] = true
+				continue
+			}
 			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported construct: %s", sql)
 		}
 	}
+	if len(reservedKeys) != 0 {
+		if err := e.setReserved(ctx, safeSession, sql, reservedKeys); err != nil {
+			return nil, err
+		}
+	}
 	return &sqltypes.Result{}, nil
 }
 
+// setReserved sets the variables of keys on the reserved connections of
+// the session, and records the SET statement to run it on the connections
+// reserved later.
+func (e *Executor) setReserved(ctx context.Context, safeSession *SafeSession, sql string, keys map[string]bool) error {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return err
+	}
+	set, ok := stmt.(*sqlparser.Set)
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "ast did not yield *sqlparser.Set: %T", stmt)
+	}
+	reservedSet := &sqlparser.Set{Scope: set.Scope}
+	for _, expr := range set.Exprs {
+		key := expr.Name.Lowered()
+		key = strings.TrimPrefix(key, "@@session.")
+		key = strings.TrimPrefix(key, "@@")
+		if keys[strings.Trim(key, "`")] {
+			reservedSet.Exprs = append(reservedSet.Exprs, expr)
+		}
+	}
+	reservedSQL := sqlparser.String(reservedSet)
+	if err := e.txConn.ExecuteReserved(ctx, safeSession, reservedSQL); err != nil {
+		return err
+	}
+	safeSession.AddReservedStatement(reservedSQL)
+	return nil
+}
+
 func (e *Executor) handleSetVitessMetadata(ctx context.Context, session *SafeSession, k sqlparser.SetKey, v interface{}) (*sqltypes.Result, error) {
 	//TODO(kalfonso): move to its own acl check and consolidate into an acl component that can handle multiple operations (vschema, metadata)
 	allowed := vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(ctx))
@@ -833,11 +1040,9 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 				show.Table.Qualifier = sqlparser.NewTableIdent("")
 				sql = sqlparser.String(show)
 			} else {
-				// No keyspace was indicated. Try to find one using the vschema.
-				tbl, err := e.VSchema().FindTable("", show.Table.Name.String())
-				if err == nil {
-					destKeyspace = tbl.Keyspace.Name
-				}
+				// No keyspace was indicated. Try to find one using the vschema
+				// and the tracked schema.
+				destKeyspace = e.tableKeyspace(show.Table.Name.String())
 			}
 		}
 	case sqlparser.KeywordString(sqlparser.TABLES):
@@ -849,6 +1054,48 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			show.ShowTablesOpt.DbName = ""
 		}
 		sql = sqlparser.String(show)
+		qr, err := e.handleOther(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
+		if err != nil {
+			return nil, err
+		}
+		// The column is named after the database of the tablet, which
+		// is not the keyspace name the client knows.
+		if len(qr.Fields) > 0 && strings.HasPrefix(qr.Fields[0].Name, "Tables_in_") {
+			qr.Fields[0].Name = "Tables_in_" + destKeyspace
+		}
+		return qr, nil
+	case sqlparser.KeywordString(sqlparser.COLUMNS), sqlparser.KeywordString(sqlparser.FIELDS):
+		// The keyspace of "show columns from <table> from <keyspace>" or of
+		// "show columns from <keyspace>.<table>" takes precedence over the
+		// target of the session. Otherwise, the keyspace is found using the
+		// vschema and the tracked schema.
+		ksName := show.ShowTablesOpt.DbName
+		if ksName == "" {
+			ksName = show.OnTable.Qualifier.String()
+		}
+		if ksName != "" && ksName != destKeyspace {
+			destKeyspace, dest = ksName, nil
+		}
+		if destKeyspace == "" {
+			destKeyspace = e.tableKeyspace(show.OnTable.Name.String())
+		}
+		show.Type = strings.ToLower(show.Type)
+		show.ShowTablesOpt.DbName = ""
+		show.OnTable.Qualifier = sqlparser.NewTableIdent("")
+		sql = sqlparser.String(show)
+	case sqlparser.KeywordString(sqlparser.INDEX), sqlparser.KeywordString(sqlparser.KEYS), "indexes":
+		// The table name of SHOW INDEX is not parsed.
+		ksName, tableName, rest, ok := parseShowIndex(sql)
+		if !ok {
+			break
+		}
+		if ksName != "" && ksName != destKeyspace {
+			destKeyspace, dest = ksName, nil
+		}
+		if destKeyspace == "" {
+			destKeyspace = e.tableKeyspace(tableName)
+		}
+		sql = "show index from " + sqlescape.EscapeID(tableName) + rest
 	case sqlparser.KeywordString(sqlparser.DATABASES), "vitess_keyspaces", "keyspaces":
 		keyspaces, err := e.resolver.resolver.GetAllKeyspaces(ctx)
 		if err != nil {
@@ -1061,6 +1308,42 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 	return e.handleOther(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
 }
 
+// showIndexRegexp matches "show index from [<keyspace>.]<table>
+// [from <keyspace>] ...". INDEXES and KEYS are synonyms of INDEX, and
+// IN is a synonym of FROM.
+var showIndexRegexp = regexp.MustCompile("(?is)^\\s*show\\s+(?:index|indexes|keys)\\s+(?:from|in)\\s+(?:(`[^`]+`|\\w+)\\.)?(`[^`]+`|\\w+)(?:\\s+(?:from|in)\\s+(`[^`]+`|\\w+))?(.*)$")
+
+// parseShowIndex returns the keyspace and the table of a SHOW INDEX
+// statement, and the rest of the statement after them.
+func parseShowIndex(sql string) (ksName, tableName, rest string, ok bool) {
+	match := showIndexRegexp.FindStringSubmatch(sql)
+	if match == nil {
+		return "", "", "", false
+	}
+	unquote := func(id string) string {
+		return strings.Replace(strings.Trim(id, "`"), "``", "`", -1)
+	}
+	ksName = unquote(match[1])
+	if match[3] != "" {
+		ksName = unquote(match[3])
+	}
+	return ksName, unquote(match[2]), match[4], true
+}
+
+// tableKeyspace returns the keyspace of a table in the vschema, or in the
+// tracked schema if the table is in a single keyspace. It returns "" if
+// the keyspace is unknown.
+func (e *Executor) tableKeyspace(tableName string) string {
+	if tbl, err := e.VSchema().FindTable("", tableName); err == nil {
+		return tbl.Keyspace.Name
+	}
+	keyspaces := e.tracker.tableKeyspaces(tableName)
+	if len(keyspaces) != 1 {
+		return ""
+	}
+	return keyspaces[0]
+}
+
 func (e *Executor) handleUse(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
@@ -1123,8 +1406,33 @@ func (e *Executor) handleComment(sql string) (*sqltypes.Result, error) {
 	return &sqltypes.Result{}, nil
 }
 
+// checkExternalAuthorization asks the external authorization service,
+// if any, if sql can be executed. Only statements that access tables
+// are checked.
+func (e *Executor) checkExternalAuthorization(ctx context.Context, sql string, stmtType sqlparser.StatementType, keyspace string) error {
+	if e.authz == nil {
+		return nil
+	}
+	switch stmtType {
+	case sqlparser.StmtSelect, sqlparser.StmtStream, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete, sqlparser.StmtDDL:
+	default:
+		return nil
+	}
+	query, _ := sqlparser.SplitMarginComments(sql)
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return err
+	}
+	return e.authz.Check(ctx, authz.NewRequest(stmt, stmtType, keyspace))
+}
+
 // StreamExecute executes a streaming query.
 func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, target querypb.Target, callback func(*sqltypes.Result) error) (err error) {
+	span, ctx := trace.NewSpan(ctx, "executor.StreamExecute")
+	span.Annotate("method", method)
+	trace.AnnotateSQL(span, sql)
+	defer span.Finish()
+	ctx = gateway.WithTargetCell(ctx, safeSession.GetOptions().GetTargetCell())
 	logStats := NewLogStats(ctx, method, sql, bindVars)
 	logStats.StmtType = sqlparser.Preview(sql).String()
 	defer logStats.Send()
@@ -1132,8 +1440,20 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	if bindVars == nil {
 		bindVars = make(map[string]*querypb.BindVariable)
 	}
+	if err := e.checkExternalAuthorization(ctx, sql, sqlparser.Preview(sql), target.Keyspace); err != nil {
+		return err
+	}
+	restoreOptions, err := safeSession.startHints(sql)
+	if err != nil {
+		logStats.Error = err
+		return err
+	}
+	defer restoreOptions()
+	ctx = gateway.WithMaxStaleness(ctx, safeSession.GetOptions())
+	ctx, mustAdmit := e.quotas.enter(ctx)
 	query, comments := sqlparser.SplitMarginComments(sql)
 	vcursor := newVCursorImpl(ctx, safeSession, target.Keyspace, target.TabletType, comments, e, logStats)
+	defer vcursor.memory.Release()
 
 	// check if this is a stream statement for messaging
 	// TODO: support keyRange syntax
@@ -1154,6 +1474,15 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 		return err
 	}
 
+	if mustAdmit {
+		release, err := e.quotas.admit(ctx, plan.Instructions.GetKeyspaceName())
+		if err != nil {
+			logStats.Error = err
+			return err
+		}
+		defer release()
+	}
+
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 
@@ -1358,15 +1687,23 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	if e.VSchema() == nil {
 		return nil, errors.New("vschema not initialized")
 	}
+	span, _ := trace.NewSpan(vcursor.ctx, "Executor.getPlan")
+	defer span.Finish()
+	span.Annotate("plan_cached", false)
+
 	keyspace := vcursor.keyspace
 	planKey := keyspace + vindexes.TabletTypeSuffix[vcursor.tabletType] + ":" + sql
 	if result, ok := e.plans.Get(planKey); ok {
+		span.Annotate("plan_cached", true)
 		return result.(*engine.Plan), nil
 	}
 	stmt, err := sqlparser.Parse(sql)
 	if err != nil {
 		return nil, err
 	}
+	if err := rewriteAsOf(stmt); err != nil {
+		return nil, err
+	}
 	if !e.normalize {
 		plan, err := planbuilder.BuildFromStmt(sql, stmt, vcursor)
 		if err != nil {
@@ -1379,8 +1716,7 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	}
 
 	// Normalize and retry.
-	sqlparser.Normalize(stmt, bindVars, "vtg")
-	normalized := sqlparser.String(stmt)
+	normalized := sqlnormalizer.NormalizeStatement(stmt, bindVars)
 
 	if logStats != nil {
 		logStats.SQL = comments.Leading + normalized + comments.Trailing
@@ -1389,6 +1725,7 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 
 	planKey = keyspace + vindexes.TabletTypeSuffix[vcursor.tabletType] + ":" + normalized
 	if result, ok := e.plans.Get(planKey); ok {
+		span.Annotate("plan_cached", true)
 		return result.(*engine.Plan), nil
 	}
 	plan, err := planbuilder.BuildFromStmt(normalized, stmt, vcursor)
@@ -1538,7 +1875,7 @@ func (e *Executor) prepare(ctx context.Context, safeSession *SafeSession, sql st
 	case sqlparser.StmtSelect:
 		return e.handlePrepare(ctx, safeSession, sql, bindVars, destKeyspace, destTabletType, logStats)
 	case sqlparser.StmtDDL, sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtSet, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete,
-		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment:
+		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtSavepoint, sqlparser.StmtSRollback, sqlparser.StmtRelease:
 		return nil, nil
 	case sqlparser.StmtShow:
 		res, err := e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
@@ -1551,6 +1888,7 @@ func (e *Executor) handlePrepare(ctx context.Context, safeSession *SafeSession,
 	// V3 mode.
 	query, comments := sqlparser.SplitMarginComments(sql)
 	vcursor := newVCursorImpl(ctx, safeSession, destKeyspace, destTabletType, comments, e, logStats)
+	defer vcursor.memory.Release()
 	plan, err := e.getPlan(
 		vcursor,
 		query,
@@ -0,0 +1,293 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// batchSavepoint is the savepoint that precedes a run of queries executed
+// in the transaction of a session. If one of them fails, the run is
+// rolled back to it.
+const batchSavepoint = "vtgate_batch"
+
+// ExecuteBatch executes a list of queries in order, and returns a result
+// or an error for each of them. If stopOnError is set, the queries after
+// the first one that fails are not executed, and have no response.
+//
+// The consecutive DMLs that write to the same shard are sent to it as one
+// run, in a single round trip. If a query of a run fails, the run is
+// undone, and its queries are executed again one by one, so each of them
+// gets its own result or error.
+func (e *Executor) ExecuteBatch(ctx context.Context, method string, safeSession *SafeSession, sqls []string, bindVarsList []map[string]*querypb.BindVariable, stopOnError bool) []sqltypes.QueryResponse {
+	responses := make([]sqltypes.QueryResponse, 0, len(sqls))
+	bindVars := func(i int) map[string]*querypb.BindVariable {
+		if len(bindVarsList) == 0 {
+			return nil
+		}
+		return bindVarsList[i]
+	}
+
+	// The queries before sequential are executed one by one.
+	sequential := 0
+	for i := 0; i < len(sqls); {
+		if i >= sequential {
+			var runBindVars []map[string]*querypb.BindVariable
+			if len(bindVarsList) != 0 {
+				runBindVars = bindVarsList[i:]
+			}
+			if run := e.newBatchRun(ctx, safeSession, sqls[i:], runBindVars); len(run.queries) > 1 {
+				n := len(run.queries)
+				results, undone, err := e.executeBatchRun(ctx, method, safeSession, run)
+				switch {
+				case err == nil:
+					for j := range results {
+						responses = append(responses, sqltypes.QueryResponse{QueryResult: &results[j]})
+					}
+					i += n
+					continue
+				case !undone:
+					// The run couldn't be undone on its own, so
+					// its queries can't be executed again.
+					for j := 0; j < n; j++ {
+						responses = append(responses, sqltypes.QueryResponse{QueryError: err})
+						if stopOnError {
+							return responses
+						}
+					}
+					i += n
+					continue
+				}
+				sequential = i + n
+			}
+		}
+
+		qr, err := e.Execute(ctx, method, safeSession, sqls[i], bindVars(i))
+		responses = append(responses, sqltypes.QueryResponse{QueryResult: qr, QueryError: err})
+		if err != nil && stopOnError {
+			return responses
+		}
+		i++
+	}
+	return responses
+}
+
+// batchRun is a run of DMLs sent to one shard in a single round trip.
+type batchRun struct {
+	sqls    []string
+	rs      *srvtopo.ResolvedShard
+	queries []*querypb.BoundQuery
+	// plans are the plans of the queries if they were planned, which
+	// is when the session doesn't target a shard.
+	plans []*engine.Plan
+}
+
+// newBatchRun returns the run of the first queries that can be sent to
+// one shard as a single round trip. When the session targets a shard, the
+// consecutive DMLs are sent to it. Otherwise, they are planned, and the
+// run lasts while their plans write to the same shard with one query.
+func (e *Executor) newBatchRun(ctx context.Context, safeSession *SafeSession, sqls []string, bindVarsList []map[string]*querypb.BindVariable) *batchRun {
+	run := &batchRun{}
+	destKeyspace, destTabletType, dest, err := e.ParseDestinationTarget(safeSession.TargetString)
+	if err != nil || destTabletType != topodatapb.TabletType_MASTER {
+		return run
+	}
+	if dest != nil {
+		if _, ok := dest.(key.DestinationShard); !ok || destKeyspace == "" {
+			return run
+		}
+		rss, err := e.resolver.resolver.ResolveDestination(ctx, destKeyspace, destTabletType, dest)
+		if err != nil || len(rss) != 1 {
+			return run
+		}
+		run.rs = rss[0]
+	}
+
+	for i, sql := range sqls {
+		stmtType := sqlparser.Preview(sql)
+		switch stmtType {
+		case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
+		default:
+			return run
+		}
+		// The queries with comment directives or traced are
+		// executed on their own.
+		if strings.Contains(sql, "/*vt+") || traceEnabled(safeSession.Session, sql) {
+			return run
+		}
+		bindVars := make(map[string]*querypb.BindVariable)
+		if len(bindVarsList) != 0 {
+			for k, v := range bindVarsList[i] {
+				bindVars[k] = v
+			}
+		}
+
+		if dest != nil {
+			if err := e.checkExternalAuthorization(ctx, sql, stmtType, destKeyspace); err != nil {
+				return run
+			}
+			query, err := e.destinationQuery(sql, bindVars)
+			if err != nil {
+				return run
+			}
+			run.add(sql, query, bindVars, nil)
+			continue
+		}
+
+		query, comments := sqlparser.SplitMarginComments(sql)
+		vcursor := newVCursorImpl(ctx, safeSession, destKeyspace, destTabletType, comments, e, nil)
+		plan, err := e.getPlan(vcursor, query, comments, bindVars, skipQueryPlanCache(safeSession), nil)
+		if err != nil {
+			vcursor.memory.Release()
+			return run
+		}
+		if err := e.checkExternalAuthorization(ctx, sql, stmtType, plan.Instructions.GetKeyspaceName()); err != nil {
+			vcursor.memory.Release()
+			return run
+		}
+		rs, shardQuery, ok := engine.SingleShardDML(vcursor, plan.Instructions, bindVars)
+		vcursor.memory.Release()
+		if !ok {
+			return run
+		}
+		if run.rs == nil {
+			run.rs = rs
+		} else if rs.Target.Keyspace != run.rs.Target.Keyspace || rs.Target.Shard != run.rs.Target.Shard {
+			return run
+		}
+		run.add(sql, comments.Leading+shardQuery+comments.Trailing, bindVars, plan)
+	}
+	return run
+}
+
+func (run *batchRun) add(sql, query string, bindVars map[string]*querypb.BindVariable, plan *engine.Plan) {
+	run.sqls = append(run.sqls, sql)
+	run.queries = append(run.queries, &querypb.BoundQuery{Sql: query, BindVariables: bindVars})
+	if plan != nil {
+		run.plans = append(run.plans, plan)
+	}
+}
+
+// executeBatchRun executes a run of DMLs on its shard. If it fails,
+// undone tells if the run was rolled back, and its queries can be
+// executed again.
+func (e *Executor) executeBatchRun(ctx context.Context, method string, safeSession *SafeSession, run *batchRun) (results []sqltypes.Result, undone bool, err error) {
+	logStats := NewLogStats(ctx, method, strings.Join(run.sqls, "; "), nil)
+	logStats.StmtType = "BATCH"
+	defer func() {
+		logStats.Error = err
+		logStats.Send()
+	}()
+
+	queries := run.queries
+	rs := run.rs
+
+	safeSession.ClearWarnings()
+	// Start an implicit transaction if necessary.
+	if !safeSession.Autocommit && !safeSession.InTransaction() {
+		if err := e.txConn.Begin(ctx, safeSession); err != nil {
+			return nil, true, err
+		}
+	}
+	// In autocommit mode, the run is executed in its own transaction.
+	// Otherwise, it can be rolled back to the savepoint that precedes it.
+	mustCommit := !safeSession.InTransaction()
+	if mustCommit {
+		if err := e.txConn.Begin(ctx, safeSession); err != nil {
+			return nil, true, err
+		}
+		// The defer acts as a failsafe. If commit was successful,
+		// the rollback will be a no-op.
+		defer e.txConn.Rollback(ctx, safeSession)
+	} else {
+		queries = append([]*querypb.BoundQuery{{Sql: "savepoint " + batchSavepoint}}, queries...)
+	}
+
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	logStats.ShardQueries = 1
+	results, err = e.scatterConn.ExecuteBatchInTransaction(ctx, rs, queries, safeSession, safeSession.Options)
+	logStats.ExecuteTime = time.Since(execStart)
+	if err != nil {
+		if mustCommit {
+			return nil, e.txConn.Rollback(ctx, safeSession) == nil, err
+		}
+		if !e.undoBatchRun(ctx, safeSession, rs) {
+			return nil, false, vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction rolled back due to a failed batch: %v", err)
+		}
+		return nil, true, err
+	}
+	if len(results) != len(queries) {
+		return nil, false, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected: %d results for %d queries", len(results), len(queries))
+	}
+	results = results[len(results)-len(run.queries):]
+	for i, result := range results {
+		logStats.RowsAffected += result.RowsAffected
+		if len(run.plans) == 0 {
+			continue
+		}
+		plan := run.plans[i]
+		e.updateQueryCounts(plan.Instructions.RouteType(), plan.Instructions.GetKeyspaceName(), plan.Instructions.GetTableName(), 1)
+		plan.AddStats(1, time.Since(logStats.StartTime), 1, result.RowsAffected, 0)
+	}
+	if len(run.plans) == 0 {
+		e.updateQueryCounts("ShardDirect", "", "", int64(logStats.ShardQueries))
+	}
+
+	if mustCommit {
+		commitStart := time.Now()
+		if err := e.txConn.Commit(ctx, safeSession); err != nil {
+			return nil, false, err
+		}
+		logStats.CommitTime = time.Since(commitStart)
+	}
+	return results, false, nil
+}
+
+// undoBatchRun rolls back the transaction of the shard to the savepoint
+// of a failed run. If that is not possible, the transaction of the
+// session is rolled back, and it returns false.
+func (e *Executor) undoBatchRun(ctx context.Context, safeSession *SafeSession, rs *srvtopo.ResolvedShard) bool {
+	if !safeSession.InTransaction() {
+		// The error already rolled back the transaction.
+		return false
+	}
+	transactionID := safeSession.Find(rs.Target.Keyspace, rs.Target.Shard, rs.Target.TabletType)
+	if transactionID == 0 {
+		// The transaction couldn't be started on the shard.
+		return true
+	}
+	if _, err := rs.QueryService.Execute(ctx, rs.Target, "rollback to savepoint "+batchSavepoint, nil, transactionID, safeSession.Options); err != nil {
+		_ = e.txConn.Rollback(ctx, safeSession)
+		return false
+	}
+	return true
+}
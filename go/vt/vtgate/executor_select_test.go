@@ -27,6 +27,9 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlnormalizer"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
 	_ "vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
@@ -521,6 +524,36 @@ func TestSelectNormalize(t *testing.T) {
 	masterSession.TargetString = ""
 }
 
+func TestSelectNormalizeFingerprint(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	executor.normalize = true
+
+	for _, sql := range []string{
+		"/* leading */ select id from user where id = 1 /* trailing */",
+		"select id, 'abc' from user where id = 1 and name = 'def' and col between 2.5 and 3",
+	} {
+		sbc1.Queries = nil
+		if _, err := executorExec(executor, sql, nil); err != nil {
+			t.Fatal(err)
+		}
+		if len(sbc1.Queries) != 1 {
+			t.Fatalf("%s: sbc1.Queries: %+v, want one query", sql, sbc1.Queries)
+		}
+		sent, _ := sqlparser.SplitMarginComments(sbc1.Queries[0].Sql)
+
+		result, err := sqlnormalizer.Normalize(sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Query != sent {
+			t.Errorf("%s: normalized: %s, want %s", sql, result.Query, sent)
+		}
+		if want := sqlnormalizer.FingerprintNormalized(sent); result.Fingerprint != want {
+			t.Errorf("%s: fingerprint: %s, want %s", sql, result.Fingerprint, want)
+		}
+	}
+}
+
 func TestSelectCaseSensitivity(t *testing.T) {
 	executor, sbc1, sbc2, _ := createExecutorEnv()
 
@@ -849,6 +882,42 @@ func TestSelectScatterPartial(t *testing.T) {
 	testQueryLog(t, logChan, "TestExecute", "SELECT", "select /*vt+ SCATTER_ERRORS_AS_WARNINGS=1 */ id from user", 8)
 }
 
+func TestSelectScatterMemoryBudget(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+
+	save := maxQueryMemoryBytesLimit.Get()
+	maxQueryMemoryBytesLimit.Set(100)
+	defer maxQueryMemoryBytesLimit.Set(save)
+
+	_, err := executorExec(executor, "select id from user", nil)
+	want := "query memory budget exceeded: the query needs more than 100 bytes"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("executorExec: %v, want %v", err, want)
+	}
+	if used := executor.memory.Used(); used != 0 {
+		t.Errorf("memory.Used: %d, want 0", used)
+	}
+
+	maxQueryMemoryBytesLimit.Set(0)
+	executor.memory = engine.NewMemoryPool(100)
+	_, err = executorExec(executor, "select id from user", nil)
+	want = "vtgate memory budget exceeded: the running queries need more than 100 bytes"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("executorExec: %v, want %v", err, want)
+	}
+	if used := executor.memory.Used(); used != 0 {
+		t.Errorf("memory.Used: %d, want 0", used)
+	}
+
+	executor.memory = engine.NewMemoryPool(0)
+	if _, err := executorExec(executor, "select id from user", nil); err != nil {
+		t.Error(err)
+	}
+	if used := executor.memory.Used(); used != 0 {
+		t.Errorf("memory.Used: %d, want 0", used)
+	}
+}
+
 func TestStreamSelectScatter(t *testing.T) {
 	// Special setup: Don't use createExecutorEnv.
 	cell := "aa"
@@ -52,9 +52,9 @@ import (
 )
 
 func TestExecutorResultsExceeded(t *testing.T) {
-	save := *warnMemoryRows
-	*warnMemoryRows = 3
-	defer func() { *warnMemoryRows = save }()
+	save := warnMemoryRowsLimit.Get()
+	warnMemoryRowsLimit.Set(3)
+	defer warnMemoryRowsLimit.Set(save)
 
 	executor, _, _, sbclookup := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
@@ -193,6 +193,372 @@ func TestExecutorTransactionsNoAutoCommit(t *testing.T) {
 	}
 }
 
+func TestExecutorSavepoints(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	ctx := context.Background()
+
+	// Outside of a transaction, a savepoint is a no-op.
+	if _, err := executor.Execute(ctx, "TestExecute", session, "savepoint a", nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(session.Savepoints()) != 0 || sbc1.ExecCount.Get() != 0 {
+		t.Errorf("savepoint outside of a transaction: %v, %d executions", session.Savepoints(), sbc1.ExecCount.Get())
+	}
+
+	for _, sql := range []string{"begin", "select id from user where id = 1", "savepoint a", "savepoint b"} {
+		if _, err := executor.Execute(ctx, "TestExecute", session, sql, nil); err != nil {
+			t.Fatalf("%s: %v", sql, err)
+		}
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(session.Savepoints(), want) {
+		t.Errorf("savepoints: %v, want %v", session.Savepoints(), want)
+	}
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select id from user where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "savepoint a",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "savepoint b",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
+		t.Errorf("sbc1.Queries: %+v, want %+v", sbc1.Queries, wantQueries)
+	}
+
+	// The savepoints are created on the shards that join the transaction.
+	if _, err := executor.Execute(ctx, "TestExecute", session, "select id from user where id = 3", nil); err != nil {
+		t.Fatal(err)
+	}
+	wantQueries = []*querypb.BoundQuery{{
+		Sql:           "savepoint `a`",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "savepoint `b`",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select id from user where id = 3",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	if !reflect.DeepEqual(sbc2.Queries, wantQueries) {
+		t.Errorf("sbc2.Queries: %+v, want %+v", sbc2.Queries, wantQueries)
+	}
+	if beginCount := sbc2.BeginCount.Get(); beginCount != 1 {
+		t.Errorf("sbc2.BeginCount: %d, want 1", beginCount)
+	}
+
+	sbc1.Queries = nil
+	sbc2.Queries = nil
+	if _, err := executor.Execute(ctx, "TestExecute", session, "rollback to savepoint A", nil); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a"}; !reflect.DeepEqual(session.Savepoints(), want) {
+		t.Errorf("savepoints: %v, want %v", session.Savepoints(), want)
+	}
+	wantQueries = []*querypb.BoundQuery{{
+		Sql:           "rollback to savepoint A",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
+		t.Errorf("sbc1.Queries: %+v, want %+v", sbc1.Queries, wantQueries)
+	}
+	if !reflect.DeepEqual(sbc2.Queries, wantQueries) {
+		t.Errorf("sbc2.Queries: %+v, want %+v", sbc2.Queries, wantQueries)
+	}
+
+	if _, err := executor.Execute(ctx, "TestExecute", session, "release savepoint a", nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(session.Savepoints()) != 0 {
+		t.Errorf("savepoints: %v, want none", session.Savepoints())
+	}
+	_, err := executor.Execute(ctx, "TestExecute", session, "rollback to a", nil)
+	want := "SAVEPOINT a does not exist"
+	if err == nil || err.Error() != want {
+		t.Errorf("rollback to a: %v, want %s", err, want)
+	}
+
+	if _, err := executor.Execute(ctx, "TestExecute", session, "rollback", nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(session.Session.Savepoints) != 0 {
+		t.Errorf("savepoints after rollback: %v, want none", session.Session.Savepoints)
+	}
+}
+
+func TestExecutorReservedConnections(t *testing.T) {
+	*enableReservedConnections = true
+	defer func() { *enableReservedConnections = false }()
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	ctx := context.Background()
+
+	// The statement is recorded, and run once a connection is reserved.
+	if _, err := executor.Execute(ctx, "TestExecute", session, "set sql_mode = 'ANSI', autocommit = 1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"set sql_mode = 'ANSI'"}; !session.Reserved() || !reflect.DeepEqual(session.ReservedStatements(), want) {
+		t.Errorf("reserved statements: %v, want %v", session.ReservedStatements(), want)
+	}
+	if sbc1.ExecCount.Get() != 0 {
+		t.Errorf("sbc1.ExecCount: %d, want 0", sbc1.ExecCount.Get())
+	}
+	for _, sql := range []string{"select id from user where id = 1", "select id from user where id = 1"} {
+		if _, err := executor.Execute(ctx, "TestExecute", session, sql, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "set sql_mode = 'ANSI'",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select id from user where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "select id from user where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
+		t.Errorf("sbc1.Queries: %+v, want %+v", sbc1.Queries, wantQueries)
+	}
+	if beginCount := sbc1.BeginCount.Get(); beginCount != 1 {
+		t.Errorf("sbc1.BeginCount: %d, want 1", beginCount)
+	}
+	if len(session.ReservedSessions()) != 1 {
+		t.Fatalf("reserved sessions: %v, want 1", session.ReservedSessions())
+	}
+	reservedID := session.ReservedSessions()[0].TransactionId
+
+	// The transactions are begun on the reserved connection, which
+	// stays reserved.
+	for _, sql := range []string{"begin", "select id from user where id = 1", "commit"} {
+		if _, err := executor.Execute(ctx, "TestExecute", session, sql, nil); err != nil {
+			t.Fatalf("%s: %v", sql, err)
+		}
+	}
+	if beginCount := sbc1.BeginCount.Get(); beginCount != 2 {
+		t.Errorf("sbc1.BeginCount: %d, want 2", beginCount)
+	}
+	if commitCount := sbc1.CommitCount.Get(); commitCount != 1 {
+		t.Errorf("sbc1.CommitCount: %d, want 1", commitCount)
+	}
+	if reserved := session.ReservedSessions(); len(reserved) != 1 || reserved[0].TransactionId != reservedID {
+		t.Errorf("reserved sessions after commit: %v, want %d", reserved, reservedID)
+	}
+
+	// A new statement is run on the reserved connections right away.
+	sbc1.Queries = nil
+	if _, err := executor.Execute(ctx, "TestExecute", session, "set @@time_zone = '+00:00'", nil); err != nil {
+		t.Fatal(err)
+	}
+	wantQueries = []*querypb.BoundQuery{{
+		Sql:           "set @@time_zone = '+00:00'",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
+		t.Errorf("sbc1.Queries: %+v, want %+v", sbc1.Queries, wantQueries)
+	}
+	if sbc2.ExecCount.Get() != 0 {
+		t.Errorf("sbc2.ExecCount: %d, want 0", sbc2.ExecCount.Get())
+	}
+
+	// The streaming queries are streamed on the reserved connection.
+	err := executor.StreamExecute(ctx, "TestExecuteStream", session, "select id from user where id = 1", nil, querypb.Target{TabletType: topodatapb.TabletType_MASTER}, func(*sqltypes.Result) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{reservedID}; !reflect.DeepEqual(sbc1.StreamTransactionIDs, want) {
+		t.Errorf("sbc1.StreamTransactionIDs: %v, want %v", sbc1.StreamTransactionIDs, want)
+	}
+
+	if err := executor.txConn.Release(ctx, session); err != nil {
+		t.Fatal(err)
+	}
+	if releaseCount := sbc1.ReleaseCount.Get(); releaseCount != 1 {
+		t.Errorf("sbc1.ReleaseCount: %d, want 1", releaseCount)
+	}
+	if rollbackCount := sbc1.RollbackCount.Get(); rollbackCount != 0 {
+		t.Errorf("sbc1.RollbackCount: %d, want 0", rollbackCount)
+	}
+	if session.Reserved() || len(session.ReservedSessions()) != 0 {
+		t.Errorf("session still reserved: %v", session.Session)
+	}
+}
+
+func TestExecutorBatch(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor:-20@master", Autocommit: true})
+	ctx := context.Background()
+	unfriendly := "/* vtgate:: filtered_replication_unfriendly */"
+
+	// In autocommit mode, the consecutive DMLs are sent as one run,
+	// in their own transaction.
+	sqls := []string{"insert into t(a) values (1)", "update t set a = 2", "select a from t"}
+	qrl := executor.ExecuteBatch(ctx, "TestExecute", session, sqls, nil, true)
+	if len(qrl) != 3 {
+		t.Fatalf("ExecuteBatch: %d responses, want 3", len(qrl))
+	}
+	for i, qr := range qrl {
+		if qr.QueryError != nil || qr.QueryResult == nil {
+			t.Errorf("%s: %v", sqls[i], qr.QueryError)
+		}
+	}
+	wantBatches := [][]*querypb.BoundQuery{{{
+		Sql:           "insert into t(a) values (1)" + unfriendly,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "update t set a = 2" + unfriendly,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}}
+	if !reflect.DeepEqual(sbc1.BatchQueries, wantBatches) {
+		t.Errorf("sbc1.BatchQueries: %+v, want %+v", sbc1.BatchQueries, wantBatches)
+	}
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "select a from t",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
+		t.Errorf("sbc1.Queries: %+v, want %+v", sbc1.Queries, wantQueries)
+	}
+	if sbc1.BeginCount.Get() != 1 || sbc1.CommitCount.Get() != 1 {
+		t.Errorf("sbc1 begins, commits: %d, %d, want 1, 1", sbc1.BeginCount.Get(), sbc1.CommitCount.Get())
+	}
+
+	// In a transaction, a run starts with a savepoint. If it fails,
+	// the run is rolled back to it, and its queries are executed again
+	// one by one.
+	sbc1.BatchQueries = nil
+	sbc1.Queries = nil
+	for _, sql := range []string{"begin", "insert into t(a) values (0)"} {
+		if _, err := executor.Execute(ctx, "TestExecute", session, sql, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sbc1.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	sqls = []string{"insert into t(a) values (1)", "insert into t(a) values (2)"}
+	qrl = executor.ExecuteBatch(ctx, "TestExecute", session, sqls, nil, true)
+	if len(qrl) != 2 || qrl[0].QueryError != nil || qrl[1].QueryError != nil {
+		t.Fatalf("ExecuteBatch: %+v, want 2 results", qrl)
+	}
+	if len(sbc1.BatchQueries) != 0 {
+		t.Errorf("sbc1.BatchQueries: %+v, want none", sbc1.BatchQueries)
+	}
+	wantQueries = []*querypb.BoundQuery{{
+		Sql:           "insert into t(a) values (0)" + unfriendly,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "rollback to savepoint " + batchSavepoint,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "insert into t(a) values (1)" + unfriendly,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "insert into t(a) values (2)" + unfriendly,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	if !reflect.DeepEqual(sbc1.Queries, wantQueries) {
+		t.Errorf("sbc1.Queries: %+v, want %+v", sbc1.Queries, wantQueries)
+	}
+
+	// A run that succeeds in a transaction.
+	sbc1.Queries = nil
+	qrl = executor.ExecuteBatch(ctx, "TestExecute", session, sqls, nil, true)
+	if len(qrl) != 2 || qrl[0].QueryError != nil || qrl[1].QueryError != nil {
+		t.Fatalf("ExecuteBatch: %+v, want 2 results", qrl)
+	}
+	wantBatches = [][]*querypb.BoundQuery{{{
+		Sql: "savepoint " + batchSavepoint,
+	}, {
+		Sql:           "insert into t(a) values (1)" + unfriendly,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "insert into t(a) values (2)" + unfriendly,
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}}
+	if !reflect.DeepEqual(sbc1.BatchQueries, wantBatches) {
+		t.Errorf("sbc1.BatchQueries: %+v, want %+v", sbc1.BatchQueries, wantBatches)
+	}
+	if !session.InTransaction() || sbc1.CommitCount.Get() != 1 {
+		t.Errorf("the run must stay in the transaction of the session")
+	}
+
+	// If stopOnError is set, the queries after a failed one are not executed.
+	sbc1.Queries = nil
+	sbc1.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	qrl = executor.ExecuteBatch(ctx, "TestExecute", session, []string{"select a from t", "select b from t"}, nil, true)
+	if len(qrl) != 1 || qrl[0].QueryError == nil {
+		t.Errorf("ExecuteBatch: %+v, want one error", qrl)
+	}
+	if len(sbc1.Queries) != 1 {
+		t.Errorf("sbc1.Queries: %+v, want one query", sbc1.Queries)
+	}
+}
+
+func TestExecutorBatchV3(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	ctx := context.Background()
+
+	// The consecutive DMLs that write to the same shard are sent to it
+	// as one run. The run ends with the query for another shard.
+	sqls := []string{
+		"insert into user_extra(user_id) values (1)",
+		"update user_extra set extra = 'a' where user_id = 2",
+		"delete from user_extra where user_id = 3",
+	}
+	qrl := executor.ExecuteBatch(ctx, "TestExecute", session, sqls, nil, true)
+	if len(qrl) != 3 {
+		t.Fatalf("ExecuteBatch: %d responses, want 3", len(qrl))
+	}
+	for i, qr := range qrl {
+		if qr.QueryError != nil || qr.QueryResult == nil {
+			t.Errorf("%s: %v", sqls[i], qr.QueryError)
+		}
+	}
+	wantBatches := [][]*querypb.BoundQuery{{{
+		Sql: "insert into user_extra(user_id) values (:_user_id0) /* vtgate:: keyspace_id:166b40b44aba4bd6 */",
+		BindVariables: map[string]*querypb.BindVariable{
+			"_user_id0": sqltypes.Int64BindVariable(1),
+		},
+	}, {
+		Sql:           "update user_extra set extra = 'a' where user_id = 2 /* vtgate:: keyspace_id:06e7ea22ce92708f */",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}}
+	if !reflect.DeepEqual(sbc1.BatchQueries, wantBatches) {
+		t.Errorf("sbc1.BatchQueries: %+v, want %+v", sbc1.BatchQueries, wantBatches)
+	}
+	// The last query is autocommitted on its own.
+	wantBatches = [][]*querypb.BoundQuery{{{
+		Sql:           "delete from user_extra where user_id = 3 /* vtgate:: keyspace_id:4eb190c9a2fa169c */",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}}
+	if !reflect.DeepEqual(sbc2.BatchQueries, wantBatches) {
+		t.Errorf("sbc2.BatchQueries: %+v, want %+v", sbc2.BatchQueries, wantBatches)
+	}
+
+	// The DMLs that write to the lookup vindexes are executed on
+	// their own.
+	sbc1.BatchQueries = nil
+	sbc1.Queries = nil
+	sqls = []string{
+		"insert into user_extra(user_id) values (1)",
+		"update user set name = 'a' where id = 1",
+	}
+	qrl = executor.ExecuteBatch(ctx, "TestExecute", session, sqls, nil, true)
+	if len(qrl) != 2 || qrl[0].QueryError != nil || qrl[1].QueryError != nil {
+		t.Fatalf("ExecuteBatch: %+v, want 2 results", qrl)
+	}
+	for _, batch := range sbc1.BatchQueries {
+		if len(batch) != 1 {
+			t.Errorf("sbc1.BatchQueries: %+v, want autocommitted queries", sbc1.BatchQueries)
+		}
+	}
+}
+
 func TestExecutorTransactionsAutoCommit(t *testing.T) {
 	executor, _, _, sbclookup := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
@@ -446,6 +812,12 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set skip_query_plan_cache = 0",
 		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{}},
+	}, {
+		in:  "set vitess_trace = 1",
+		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{Trace: true}},
+	}, {
+		in:  "set vitess_trace = 2",
+		err: "unexpected value for vitess_trace: 2",
 	}, {
 		in:  "set sql_auto_is_null = 0",
 		out: &vtgatepb.Session{Autocommit: true}, // no effect
@@ -776,7 +1148,16 @@ func TestExecutorShow(t *testing.T) {
 		}
 	}
 
-	wantqr := showResults
+	// The column is named after the keyspace.
+	wantqr := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "Tables_in_TestUnsharded", Type: sqltypes.VarChar},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarChar("some_table"),
+		}},
+	}
 	if !reflect.DeepEqual(qr, wantqr) {
 		t.Errorf("%v:\n%+v, want\n%+v", query, qr, wantqr)
 	}
@@ -809,6 +1190,44 @@ func TestExecutorShow(t *testing.T) {
 		t.Errorf("Got: %v. Want: %v", lastQuery, wantQuery)
 	}
 
+	// SHOW CREATE table using the tracked schema to find keyspace.
+	executor.tracker = &schemaTracker{
+		e:       executor,
+		columns: map[string]map[string][]string{KsTestUnsharded: {"tracked": {"id"}}},
+	}
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "show create table tracked", nil)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	lastQuery = sbclookup.Queries[len(sbclookup.Queries)-1].Sql
+	wantQuery = "show create table tracked"
+	if lastQuery != wantQuery {
+		t.Errorf("Got: %v. Want: %v", lastQuery, wantQuery)
+	}
+	executor.tracker = nil
+
+	// SHOW COLUMNS and SHOW INDEX are sent to the keyspace of the table.
+	for query, wantQuery := range map[string]string{
+		"show columns from user_seq": "show columns from user_seq",
+		"show full FIELDS from unknown from " + KsTestUnsharded + " like 'id%'":        "show full fields from unknown like 'id%'",
+		"show columns from " + KsTestUnsharded + ".unknown":                            "show columns from unknown",
+		"show index from user_seq":                                                     "show index from `user_seq`",
+		"show keys in `unknown` in " + KsTestUnsharded:                                 "show index from `unknown`",
+		"show indexes from " + KsTestUnsharded + ".unknown where Key_name = 'PRIMARY'": "show index from `unknown` where Key_name = 'PRIMARY'",
+	} {
+		if _, err := executor.Execute(context.Background(), "TestExecute", session, query, nil); err != nil {
+			t.Errorf("%v: %v", query, err)
+			continue
+		}
+		if lastQuery := sbclookup.Queries[len(sbclookup.Queries)-1].Sql; lastQuery != wantQuery {
+			t.Errorf("%v: sent %v, want %v", query, lastQuery, wantQuery)
+		}
+	}
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "show columns from unknown_table", nil)
+	if err != errNoKeyspace {
+		t.Errorf("Got: %v. Want: %v", err, errNoKeyspace)
+	}
+
 	for _, query := range []string{"show charset", "show charset like '%foo'", "show character set", "show character set like '%foo'"} {
 		qr, err := executor.Execute(context.Background(), "TestExecute", session, query, nil)
 		if err != nil {
@@ -363,7 +363,8 @@ func (conn *FakeVTGateConn) SplitQuery(
 	splitColumns []string,
 	splitCount int64,
 	numRowsPerQueryPart int64,
-	algorithm querypb.SplitQueryRequest_Algorithm) ([]*vtgatepb.SplitQueryResponse_Part, error) {
+	algorithm querypb.SplitQueryRequest_Algorithm,
+	cell string) ([]*vtgatepb.SplitQueryResponse_Part, error) {
 
 	response, ok := conn.splitQueryMap[getSplitQueryKey(
 		keyspace, query, splitColumns, splitCount, numRowsPerQueryPart, algorithm)]
@@ -387,6 +388,11 @@ func (conn *FakeVTGateConn) GetSrvKeyspace(ctx context.Context, keyspace string)
 	return nil, fmt.Errorf("NYI")
 }
 
+// SetRuntimeFlags please see vtgateconn.Impl.SetRuntimeFlags
+func (conn *FakeVTGateConn) SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*vtgatepb.RuntimeFlagChange, error) {
+	return nil, fmt.Errorf("NYI")
+}
+
 // VStream streams binlog events.
 func (conn *FakeVTGateConn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter) (vtgateconn.VStreamReader, error) {
 	return nil, fmt.Errorf("NYI")
@@ -0,0 +1,262 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/vt/discovery"
+)
+
+// This file contains the Balancer interface, which picks the tablet a
+// query is sent to, and the balancing policies registry.
+
+const (
+	// BalancerCellLocal sends the queries to a random tablet of the
+	// local cell, or of the other cells if the local cell has none.
+	BalancerCellLocal = "cell_local"
+	// BalancerLeastInflight sends the queries to the tablet with the
+	// fewest queries in flight from this vtgate.
+	BalancerLeastInflight = "least_inflight"
+	// BalancerLatencyWeighted sends the queries to a random tablet,
+	// weighted by the inverse of its moving average latency.
+	BalancerLatencyWeighted = "latency_weighted"
+
+	// latencyEWMAWeight is the weight of the last query in the
+	// exponentially weighted moving average of the tablet latencies.
+	latencyEWMAWeight = 0.2
+)
+
+var (
+	balancerPolicy           = flag.String("gateway_balancer_policy", BalancerCellLocal, "The policy used to pick the tablet a query is sent to among the healthy tablets: cell_local, least_inflight or latency_weighted")
+	keyspaceBalancerPolicies flagutil.StringMapValue
+
+	balancerCreators = make(map[string]BalancerCreator)
+)
+
+func init() {
+	flag.Var(&keyspaceBalancerPolicies, "gateway_keyspace_balancer_policies", "Comma-separated list of keyspace:policy pairs overriding -gateway_balancer_policy for some keyspaces")
+	RegisterBalancer(BalancerCellLocal, func(*TabletLoads) Balancer { return cellLocalBalancer{} })
+	RegisterBalancer(BalancerLeastInflight, func(loads *TabletLoads) Balancer { return &leastInflightBalancer{loads: loads} })
+	RegisterBalancer(BalancerLatencyWeighted, func(loads *TabletLoads) Balancer { return &latencyWeightedBalancer{loads: loads} })
+}
+
+// A Balancer orders the healthy tablets of a target before a query is
+// sent. The query goes to the first tablet, and the next ones are used
+// for the retries.
+type Balancer interface {
+	// Sort orders the tablets in place. localCell is the cell of
+	// this vtgate.
+	Sort(localCell string, tablets []discovery.TabletStats)
+}
+
+// BalancerCreator is the factory method which can create the actual
+// Balancer object. The loads are shared by all the balancers of a gateway.
+type BalancerCreator func(loads *TabletLoads) Balancer
+
+// RegisterBalancer registers a balancing policy.
+func RegisterBalancer(name string, creator BalancerCreator) {
+	if _, ok := balancerCreators[name]; ok {
+		panic(fmt.Sprintf("balancer %s already registered", name))
+	}
+	balancerCreators[name] = creator
+}
+
+// balancers holds the balancers of a gateway, per keyspace.
+type balancers struct {
+	defaultBalancer Balancer
+	keyspaces       map[string]Balancer
+}
+
+// newBalancers creates the balancers configured by the flags.
+func newBalancers(loads *TabletLoads, policy string, keyspacePolicies map[string]string) (*balancers, error) {
+	create := func(policy string) (Balancer, error) {
+		creator, ok := balancerCreators[policy]
+		if !ok {
+			return nil, fmt.Errorf("unknown balancer policy %v", policy)
+		}
+		return creator(loads), nil
+	}
+	defaultBalancer, err := create(policy)
+	if err != nil {
+		return nil, err
+	}
+	b := &balancers{
+		defaultBalancer: defaultBalancer,
+		keyspaces:       make(map[string]Balancer),
+	}
+	for keyspace, policy := range keyspacePolicies {
+		if b.keyspaces[keyspace], err = create(policy); err != nil {
+			return nil, fmt.Errorf("keyspace %v: %v", keyspace, err)
+		}
+	}
+	return b, nil
+}
+
+func (b *balancers) forKeyspace(keyspace string) Balancer {
+	if balancer, ok := b.keyspaces[keyspace]; ok {
+		return balancer
+	}
+	return b.defaultBalancer
+}
+
+// TabletLoads tracks the queries in flight and the latency of the
+// tablets, by discovery.TabletStats key.
+type TabletLoads struct {
+	mu    sync.Mutex
+	loads map[string]*tabletLoad
+}
+
+type tabletLoad struct {
+	inflight int
+	// latency is the moving average of the query latency,
+	// 0 until the first query returns.
+	latency time.Duration
+}
+
+// NewTabletLoads returns an empty TabletLoads.
+func NewTabletLoads() *TabletLoads {
+	return &TabletLoads{
+		loads: make(map[string]*tabletLoad),
+	}
+}
+
+func (tl *TabletLoads) getLocked(key string) *tabletLoad {
+	load, ok := tl.loads[key]
+	if !ok {
+		load = &tabletLoad{}
+		tl.loads[key] = load
+	}
+	return load
+}
+
+// QueryStarted records a query sent to a tablet.
+func (tl *TabletLoads) QueryStarted(key string) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	tl.getLocked(key).inflight++
+}
+
+// QueryFinished records the end of a query sent to a tablet.
+func (tl *TabletLoads) QueryFinished(key string, elapsed time.Duration) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	load, ok := tl.loads[key]
+	if !ok {
+		// The tablet was forgotten while the query was in flight.
+		return
+	}
+	load.inflight--
+	if load.latency == 0 {
+		load.latency = elapsed
+	} else {
+		load.latency = time.Duration(latencyEWMAWeight*float64(elapsed) + (1-latencyEWMAWeight)*float64(load.latency))
+	}
+}
+
+// Inflight returns the number of queries in flight to a tablet.
+func (tl *TabletLoads) Inflight(key string) int {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	if load, ok := tl.loads[key]; ok {
+		return load.inflight
+	}
+	return 0
+}
+
+// Latency returns the moving average latency of a tablet,
+// or 0 if no query returned yet.
+func (tl *TabletLoads) Latency(key string) time.Duration {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	if load, ok := tl.loads[key]; ok {
+		return load.latency
+	}
+	return 0
+}
+
+// Forget removes a tablet, once it's gone.
+func (tl *TabletLoads) Forget(key string) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	delete(tl.loads, key)
+}
+
+// cellLocalBalancer is the historical behavior of the gateway.
+type cellLocalBalancer struct{}
+
+func (cellLocalBalancer) Sort(localCell string, tablets []discovery.TabletStats) {
+	shuffleTablets(localCell, tablets)
+}
+
+type leastInflightBalancer struct {
+	loads *TabletLoads
+}
+
+// Sort moves the tablets with the fewest queries in flight to the front.
+// The tablets with the same number of queries keep the order of
+// shuffleTablets, with the local cell first.
+func (b *leastInflightBalancer) Sort(localCell string, tablets []discovery.TabletStats) {
+	shuffleTablets(localCell, tablets)
+	inflight := make(map[string]int, len(tablets))
+	for _, ts := range tablets {
+		inflight[ts.Key] = b.loads.Inflight(ts.Key)
+	}
+	sort.SliceStable(tablets, func(i, j int) bool {
+		return inflight[tablets[i].Key] < inflight[tablets[j].Key]
+	})
+}
+
+type latencyWeightedBalancer struct {
+	loads *TabletLoads
+}
+
+// Sort orders the tablets randomly, the probability of a tablet to be
+// first being proportional to the inverse of its latency. The tablets
+// without a latency yet get the weight of the fastest tablet, so they
+// are tried soon.
+func (b *latencyWeightedBalancer) Sort(localCell string, tablets []discovery.TabletStats) {
+	weights := make(map[string]float64, len(tablets))
+	maxWeight := 0.0
+	for _, ts := range tablets {
+		if latency := b.loads.Latency(ts.Key); latency > 0 {
+			weights[ts.Key] = 1 / latency.Seconds()
+			maxWeight = math.Max(maxWeight, weights[ts.Key])
+		}
+	}
+	// This is a weighted random sampling without replacement: each
+	// tablet draws rand^(1/weight), and the highest draws go first.
+	// The weights are relative to the fastest tablet.
+	keys := make(map[string]float64, len(tablets))
+	for _, ts := range tablets {
+		relative := 1.0
+		if weight, ok := weights[ts.Key]; ok {
+			relative = weight / maxWeight
+		}
+		keys[ts.Key] = math.Pow(rand.Float64(), 1/relative)
+	}
+	sort.Slice(tablets, func(i, j int) bool {
+		return keys[tablets[i].Key] > keys[tablets[j].Key]
+	})
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func newBalancerTestTablets() []discovery.TabletStats {
+	var tablets []discovery.TabletStats
+	for _, tablet := range []struct{ key, cell string }{
+		{"t1", "cell1"},
+		{"t2", "cell1"},
+		{"t3", "cell2"},
+	} {
+		tablets = append(tablets, discovery.TabletStats{
+			Key:     tablet.key,
+			Tablet:  topo.NewTablet(10, tablet.cell, tablet.key),
+			Target:  &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA},
+			Up:      true,
+			Serving: true,
+			Stats:   &querypb.RealtimeStats{},
+		})
+	}
+	return tablets
+}
+
+func TestNewBalancers(t *testing.T) {
+	loads := NewTabletLoads()
+	b, err := newBalancers(loads, BalancerCellLocal, map[string]string{"ks1": BalancerLeastInflight})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := b.forKeyspace("ks1").(*leastInflightBalancer); !ok {
+		t.Errorf("forKeyspace(ks1): %T, want *leastInflightBalancer", b.forKeyspace("ks1"))
+	}
+	if _, ok := b.forKeyspace("ks2").(cellLocalBalancer); !ok {
+		t.Errorf("forKeyspace(ks2): %T, want cellLocalBalancer", b.forKeyspace("ks2"))
+	}
+
+	if _, err := newBalancers(loads, "round_robin", nil); err == nil || !strings.Contains(err.Error(), "unknown balancer policy round_robin") {
+		t.Errorf("newBalancers(round_robin): %v, want unknown balancer policy", err)
+	}
+	if _, err := newBalancers(loads, BalancerCellLocal, map[string]string{"ks1": "fastest"}); err == nil || !strings.Contains(err.Error(), "keyspace ks1") {
+		t.Errorf("newBalancers(ks1:fastest): %v, want keyspace ks1 error", err)
+	}
+}
+
+func TestTabletLoads(t *testing.T) {
+	loads := NewTabletLoads()
+	loads.QueryStarted("t1")
+	loads.QueryStarted("t1")
+	if got := loads.Inflight("t1"); got != 2 {
+		t.Errorf("Inflight(t1): %v, want 2", got)
+	}
+	loads.QueryFinished("t1", 10*time.Millisecond)
+	if got := loads.Latency("t1"); got != 10*time.Millisecond {
+		t.Errorf("Latency(t1): %v, want 10ms", got)
+	}
+	loads.QueryFinished("t1", 20*time.Millisecond)
+	// The moving average is 0.2*20ms + 0.8*10ms.
+	if got := loads.Latency("t1"); got < 11900*time.Microsecond || got > 12100*time.Microsecond {
+		t.Errorf("Latency(t1): %v, want 12ms", got)
+	}
+	if got := loads.Inflight("t1"); got != 0 {
+		t.Errorf("Inflight(t1): %v, want 0", got)
+	}
+
+	// A query finishing after the tablet is forgotten is ignored.
+	loads.QueryStarted("t2")
+	loads.Forget("t2")
+	loads.QueryFinished("t2", time.Millisecond)
+	if got, latency := loads.Inflight("t2"), loads.Latency("t2"); got != 0 || latency != 0 {
+		t.Errorf("Inflight(t2), Latency(t2): %v, %v, want 0, 0", got, latency)
+	}
+}
+
+func TestLeastInflightBalancer(t *testing.T) {
+	loads := NewTabletLoads()
+	b := &leastInflightBalancer{loads: loads}
+	loads.QueryStarted("t1")
+	loads.QueryStarted("t1")
+	loads.QueryStarted("t2")
+	for i := 0; i < 10; i++ {
+		tablets := newBalancerTestTablets()
+		b.Sort("cell1", tablets)
+		if tablets[0].Key != "t3" || tablets[1].Key != "t2" || tablets[2].Key != "t1" {
+			t.Fatalf("Sort(): %v %v %v, want t3 t2 t1", tablets[0].Key, tablets[1].Key, tablets[2].Key)
+		}
+	}
+
+	// With the same number of queries in flight, the local cell is first.
+	loads.QueryStarted("t3")
+	loads.QueryStarted("t3")
+	for i := 0; i < 10; i++ {
+		tablets := newBalancerTestTablets()
+		b.Sort("cell1", tablets)
+		if tablets[0].Key != "t2" || tablets[1].Key != "t1" || tablets[2].Key != "t3" {
+			t.Fatalf("Sort(): %v %v %v, want t2 t1 t3", tablets[0].Key, tablets[1].Key, tablets[2].Key)
+		}
+	}
+}
+
+func TestLatencyWeightedBalancer(t *testing.T) {
+	loads := NewTabletLoads()
+	b := &latencyWeightedBalancer{loads: loads}
+	for _, key := range []string{"t1", "t2"} {
+		loads.QueryStarted(key)
+	}
+	loads.QueryFinished("t1", 100*time.Millisecond)
+	loads.QueryFinished("t2", time.Millisecond)
+
+	// t2 is a hundred times faster than t1, and t3 has no latency
+	// yet, so it's weighted like t2.
+	firsts := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		tablets := newBalancerTestTablets()
+		b.Sort("cell1", tablets)
+		if len(tablets) != 3 {
+			t.Fatalf("Sort(): %v, want 3 tablets", tablets)
+		}
+		firsts[tablets[0].Key]++
+	}
+	if firsts["t1"] > 50 || firsts["t2"] < 300 || firsts["t3"] < 300 {
+		t.Errorf("first tablets: %v, want mostly t2 and t3", firsts)
+	}
+}
+
+func TestDiscoveryGatewayBalancer(t *testing.T) {
+	defer func(policies map[string]string) {
+		keyspaceBalancerPolicies = policies
+	}(keyspaceBalancerPolicies)
+	keyspaceBalancerPolicies = map[string]string{"ks": BalancerLeastInflight}
+
+	hc := discovery.NewFakeHealthCheck()
+	dg := createDiscoveryGateway(context.Background(), hc, nil, "cell", 2).(*discoveryGateway)
+	if _, ok := dg.balancers.forKeyspace("ks").(*leastInflightBalancer); !ok {
+		t.Fatalf("balancer of ks: %T, want *leastInflightBalancer", dg.balancers.forKeyspace("ks"))
+	}
+	sc := hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	key := []string{"ks", "0", "replica", topoproto.TabletAliasString(sc.Tablet().Alias)}
+	before := tabletQueries.Counts()[strings.Join(key, ".")]
+	target := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}
+	if _, err := dg.Execute(context.Background(), target, "query", nil, 0, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := tabletQueries.Counts()[strings.Join(key, ".")]; got != before+1 {
+		t.Errorf("GatewayTabletQueries[%v]: %v, want %v", key, got, before+1)
+	}
+	if got := dg.loads.Inflight(discovery.TabletToMapKey(sc.Tablet())); got != 0 {
+		t.Errorf("Inflight: %v, want 0", got)
+	}
+}
@@ -49,7 +49,10 @@ var (
 	refreshInterval     = flag.Duration("tablet_refresh_interval", 1*time.Minute, "tablet refresh interval")
 	refreshKnownTablets = flag.Bool("tablet_refresh_known_tablets", true, "tablet refresh reloads the tablet address/port map from topo in case it changes")
 	topoReadConcurrency = flag.Int("topo_read_concurrency", 32, "concurrent topo reads")
+	preferFreshest      = flag.Bool("gateway_prefer_freshest_replica", false, "If set, the queries to replica and rdonly tablets are sent to the healthy tablets with the lowest replication lag first. Among them, the tablets of the local cell are preferred.")
 	allowedTabletTypes  []topodatapb.TabletType
+
+	tabletQueries = stats.NewCountersWithMultiLabels("GatewayTabletQueries", "Queries sent by the gateway to each tablet, to monitor the balancing", []string{"Keyspace", "ShardName", "DbType", "Tablet"})
 )
 
 const (
@@ -82,6 +85,15 @@ type discoveryGateway struct {
 
 	// buffer, if enabled, buffers requests during a detected MASTER failover.
 	buffer *buffer.Buffer
+
+	// loads tracks the queries in flight and the latency of the tablets,
+	// for the balancers.
+	loads     *TabletLoads
+	balancers *balancers
+
+	// schemaMu protects schemaListeners.
+	schemaMu        sync.Mutex
+	schemaListeners []func(keyspace string, tables []string)
 }
 
 func createDiscoveryGateway(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, cell string, retryCount int) Gateway {
@@ -94,6 +106,12 @@ func createDiscoveryGateway(ctx context.Context, hc discovery.HealthCheck, serv
 		}
 	}
 
+	loads := NewTabletLoads()
+	balancers, err := newBalancers(loads, *balancerPolicy, keyspaceBalancerPolicies)
+	if err != nil {
+		log.Exitf("Unable to create new discoverygateway: %v", err)
+	}
+
 	dg := &discoveryGateway{
 		hc:                hc,
 		tsc:               discovery.NewTabletStatsCacheDoNotSetListener(topoServer, cell),
@@ -103,6 +121,8 @@ func createDiscoveryGateway(ctx context.Context, hc discovery.HealthCheck, serv
 		tabletsWatchers:   make([]*discovery.TopologyWatcher, 0, 1),
 		statusAggregators: make(map[string]*TabletStatusAggregator),
 		buffer:            buffer.New(),
+		loads:             loads,
+		balancers:         balancers,
 	}
 
 	// Set listener which will update TabletStatsCache and MasterBuffer.
@@ -176,12 +196,30 @@ func (dg *discoveryGateway) topologyWatcherChecksum() int64 {
 // It is part of the discovery.HealthCheckStatsListener interface.
 func (dg *discoveryGateway) StatsUpdate(ts *discovery.TabletStats) {
 	dg.tsc.StatsUpdate(ts)
+	if !ts.Up {
+		dg.loads.Forget(ts.Key)
+	}
 
 	if ts.Target.TabletType == topodatapb.TabletType_MASTER {
 		dg.buffer.StatsUpdate(ts)
+		if ts.Stats != nil && len(ts.Stats.TableSchemaChanged) != 0 {
+			dg.schemaMu.Lock()
+			listeners := dg.schemaListeners
+			dg.schemaMu.Unlock()
+			for _, f := range listeners {
+				f(ts.Target.Keyspace, ts.Stats.TableSchemaChanged)
+			}
+		}
 	}
 }
 
+// SubscribeSchemaChanges is part of the gateway.Gateway interface.
+func (dg *discoveryGateway) SubscribeSchemaChanges(f func(keyspace string, tables []string)) {
+	dg.schemaMu.Lock()
+	defer dg.schemaMu.Unlock()
+	dg.schemaListeners = append(dg.schemaListeners, f)
+}
+
 // WaitForTablets is part of the gateway.Gateway interface.
 func (dg *discoveryGateway) WaitForTablets(ctx context.Context, tabletTypesToWait []topodatapb.TabletType) error {
 	// Skip waiting for tablets if we are not told to do so.
@@ -243,17 +281,8 @@ func (dg *discoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 	var err error
 	invalidTablets := make(map[string]bool)
 
-	if len(allowedTabletTypes) > 0 {
-		var match bool
-		for _, allowed := range allowedTabletTypes {
-			if allowed == target.TabletType {
-				match = true
-				break
-			}
-		}
-		if !match {
-			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "requested tablet type %v is not part of the allowed tablet types for this vtgate: %+v", target.TabletType.String(), allowedTabletTypes)
-		}
+	if !isAllowedTabletType(target.TabletType) {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "requested tablet type %v is not part of the allowed tablet types for this vtgate: %+v", target.TabletType.String(), allowedTabletTypes)
 	}
 
 	bufferedOnce := false
@@ -285,12 +314,42 @@ func (dg *discoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 		}
 
 		tablets := dg.tsc.GetHealthyTabletStats(target.Keyspace, target.Shard, target.TabletType)
+		if cell := TargetCell(ctx); cell != "" {
+			tablets = filterByCell(cell, tablets)
+			if len(tablets) == 0 {
+				err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no valid tablet in cell %v", cell)
+				break
+			}
+		}
+		if maxLag, fallbackToMaster := MaxStaleness(ctx); maxLag > 0 && target.TabletType != topodatapb.TabletType_MASTER {
+			tablets = filterByStaleness(maxLag, tablets)
+			if len(tablets) == 0 {
+				if !fallbackToMaster || !isAllowedTabletType(topodatapb.TabletType_MASTER) {
+					staleReads.Add([]string{target.Keyspace, target.Shard, "Error"}, 1)
+					err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no %v tablet with a replication lag of at most %v", topoproto.TabletTypeLString(target.TabletType), maxLag)
+					break
+				}
+				// The read goes to the master, which is never stale,
+				// including for the next attempts.
+				staleReads.Add([]string{target.Keyspace, target.Shard, "Master"}, 1)
+				target = &querypb.Target{
+					Keyspace:   target.Keyspace,
+					Shard:      target.Shard,
+					TabletType: topodatapb.TabletType_MASTER,
+					Cell:       target.Cell,
+				}
+				tablets = dg.tsc.GetHealthyTabletStats(target.Keyspace, target.Shard, target.TabletType)
+			}
+		}
 		if len(tablets) == 0 {
 			// fail fast if there is no tablet
 			err = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "no valid tablet")
 			break
 		}
-		shuffleTablets(dg.localCell, tablets)
+		dg.balancers.forKeyspace(target.Keyspace).Sort(dg.localCell, tablets)
+		if *preferFreshest && target.TabletType != topodatapb.TabletType_MASTER {
+			sortByReplicationLag(tablets)
+		}
 
 		// skip tablets we tried before
 		var ts *discovery.TabletStats
@@ -319,7 +378,10 @@ func (dg *discoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 
 		startTime := time.Now()
 		var canRetry bool
+		dg.loads.QueryStarted(ts.Key)
 		canRetry, err = inner(ctx, ts.Target, conn)
+		dg.loads.QueryFinished(ts.Key, time.Since(startTime))
+		tabletQueries.Add([]string{target.Keyspace, target.Shard, topoproto.TabletTypeLString(target.TabletType), topoproto.TabletAliasString(ts.Tablet.Alias)}, 1)
 		dg.updateStats(target, startTime, err)
 		if canRetry {
 			invalidTablets[ts.Key] = true
@@ -368,6 +430,15 @@ func shuffleTablets(cell string, tablets []discovery.TabletStats) {
 	}
 }
 
+// sortByReplicationLag moves the tablets with the lowest replication lag
+// to the front. The sort is stable, so the tablets with the same lag keep
+// the order of shuffleTablets, with the local cell first.
+func sortByReplicationLag(tablets []discovery.TabletStats) {
+	sort.SliceStable(tablets, func(i, j int) bool {
+		return tablets[i].Stats.GetSecondsBehindMaster() < tablets[j].Stats.GetSecondsBehindMaster()
+	})
+}
+
 func nextTablet(cell string, tablets []discovery.TabletStats, offset, length int, sameCell bool) int {
 	for ; offset < length; offset++ {
 		if (tablets[offset].Tablet.Alias.Cell == cell) == sameCell {
@@ -18,8 +18,10 @@ package gateway
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -130,6 +132,40 @@ func TestDiscoveryGatewayGetTablets(t *testing.T) {
 	}
 }
 
+func TestDiscoveryGatewaySchemaChanges(t *testing.T) {
+	hc := discovery.NewFakeHealthCheck()
+	dg := createDiscoveryGateway(context.Background(), hc, nil, "local", 2).(*discoveryGateway)
+
+	var got []string
+	dg.SubscribeSchemaChanges(func(keyspace string, tables []string) {
+		for _, table := range tables {
+			got = append(got, keyspace+"."+table)
+		}
+	})
+	tablet := topo.NewTablet(1, "local", "1.1.1.1")
+	tablet.Keyspace = "ks"
+	tablet.Shard = "0"
+	update := func(tabletType topodatapb.TabletType, tables ...string) {
+		dg.StatsUpdate(&discovery.TabletStats{
+			Key:     discovery.TabletToMapKey(tablet),
+			Tablet:  tablet,
+			Target:  &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: tabletType},
+			Up:      true,
+			Serving: true,
+			Stats:   &querypb.RealtimeStats{TableSchemaChanged: tables},
+		})
+	}
+	update(topodatapb.TabletType_MASTER)
+	// The changes reported by the replicas are ignored: the masters
+	// report them first.
+	update(topodatapb.TabletType_REPLICA, "t1")
+	update(topodatapb.TabletType_MASTER, "t1", "t2")
+	want := []string{"ks.t1", "ks.t2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("schema changes: %v, want %v", got, want)
+	}
+}
+
 func TestShuffleTablets(t *testing.T) {
 	ts1 := discovery.TabletStats{
 		Key:     "t1",
@@ -203,6 +239,102 @@ func TestShuffleTablets(t *testing.T) {
 	}
 }
 
+func TestSortByReplicationLag(t *testing.T) {
+	newTabletStats := func(key, cell string, lag uint32) discovery.TabletStats {
+		return discovery.TabletStats{
+			Key:     key,
+			Tablet:  topo.NewTablet(10, cell, key),
+			Target:  &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA},
+			Up:      true,
+			Serving: true,
+			Stats:   &querypb.RealtimeStats{SecondsBehindMaster: lag},
+		}
+	}
+	tablets := []discovery.TabletStats{
+		newTabletStats("t1", "cell1", 5),
+		newTabletStats("t2", "cell1", 1),
+		newTabletStats("t3", "cell2", 0),
+		newTabletStats("t4", "cell2", 1),
+	}
+	tablets = append(tablets, discovery.TabletStats{Key: "t5", Tablet: topo.NewTablet(10, "cell2", "t5")})
+	for i := 0; i < 10; i++ {
+		shuffleTablets("cell1", tablets)
+		sortByReplicationLag(tablets)
+		var got []string
+		for _, ts := range tablets {
+			got = append(got, ts.Key)
+		}
+		// t5 has no stats, which is no lag. The same cell tablet t2
+		// goes before t4, which has the same lag.
+		if got[0] != "t3" && got[0] != "t5" || got[1] != "t3" && got[1] != "t5" {
+			t.Fatalf("the freshest tablets should be in the front, got %v", got)
+		}
+		if want := []string{"t2", "t4", "t1"}; !reflect.DeepEqual(got[2:], want) {
+			t.Fatalf("got %v, want %v after the freshest tablets", got, want)
+		}
+	}
+}
+
+func TestDiscoveryGatewayMaxStaleness(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck()
+	dg := createDiscoveryGateway(context.Background(), hc, nil, "cell", 2).(*discoveryGateway)
+	staleReads.ResetAll()
+
+	hc.Reset()
+	dg.tsc.ResetForTesting()
+	fresh := hc.AddTestTablet("cell", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	stale := hc.AddTestTablet("cell", "1.1.1.2", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	master := hc.AddTestTablet("cell", "1.1.1.3", 1001, keyspace, shard, topodatapb.TabletType_MASTER, true, 10, nil)
+	hc.SetReplicationLag(fresh.Tablet(), 2)
+	hc.SetReplicationLag(stale.Tablet(), 10)
+
+	// Only the fresh replica qualifies.
+	ctx := WithMaxStaleness(context.Background(), &querypb.ExecuteOptions{MaxStalenessMs: 5000})
+	if got, fallback := MaxStaleness(ctx); got != 5*time.Second || fallback {
+		t.Errorf("MaxStaleness: %v, %v, want 5s, false", got, fallback)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := dg.Execute(ctx, target, "query", nil, 0, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if fresh.ExecCount.Get() != 10 || stale.ExecCount.Get() != 0 {
+		t.Errorf("the reads went to the fresh replica %v times and to the stale one %v times, want 10 and 0", fresh.ExecCount.Get(), stale.ExecCount.Get())
+	}
+
+	// No replica qualifies.
+	ctx = WithMaxStaleness(context.Background(), &querypb.ExecuteOptions{MaxStalenessMs: 1000})
+	_, err := dg.Execute(ctx, target, "query", nil, 0, nil)
+	verifyShardErrors(t, err, []string{"target: ks.0.replica", "no replica tablet with a replication lag of at most 1s"}, vtrpcpb.Code_UNAVAILABLE)
+
+	// The read falls back to the master.
+	ctx = WithMaxStaleness(context.Background(), &querypb.ExecuteOptions{MaxStalenessMs: 1000, MaxStalenessFallbackToMaster: true})
+	if _, err := dg.Execute(ctx, target, "query", nil, 0, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := master.ExecCount.Get(); got != 1 {
+		t.Errorf("the read went to the master %v times, want 1", got)
+	}
+	if got, want := staleReads.Counts(), map[string]int64{"ks.0.Error": 1, "ks.0.Master": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GatewayMaxStalenessMisses: %v, want %v", got, want)
+	}
+
+	// The queries to the master are not restricted.
+	if _, err := dg.Execute(ctx, &querypb.Target{Keyspace: keyspace, Shard: shard, TabletType: topodatapb.TabletType_MASTER}, "query", nil, 0, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := master.ExecCount.Get(); got != 2 {
+		t.Errorf("the read went to the master %v times, want 2", got)
+	}
+}
+
 func TestDiscoveryGatewayGetAggregateStats(t *testing.T) {
 	keyspace := "ks"
 	shard := "0"
@@ -77,6 +77,12 @@ type Gateway interface {
 
 	// CacheStatus returns a list of TabletCacheStatus per shard / tablet type.
 	CacheStatus() TabletCacheStatusList
+
+	// SubscribeSchemaChanges registers a function that is called with
+	// the tables whose schema changed, as reported by the health
+	// stream of the masters of a keyspace. The function is called from
+	// the health check, and must not block.
+	SubscribeSchemaChanges(f func(keyspace string, tables []string))
 }
 
 // Creator is the factory method which can create the actual gateway object.
@@ -0,0 +1,89 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var staleReads = stats.NewCountersWithMultiLabels("GatewayMaxStalenessMisses", "Reads with a maximum staleness for which no replica qualified, by whether they were sent to the master or failed", []string{"Keyspace", "ShardName", "Action"})
+
+type maxStalenessKey struct{}
+
+// maxStaleness is the bound on the replication lag of the tablets a
+// read can go to.
+type maxStaleness struct {
+	lag              time.Duration
+	fallbackToMaster bool
+}
+
+// WithMaxStaleness returns a context that restricts the gateway to the
+// replica and rdonly tablets whose replication lag is at most the
+// max_staleness_ms of the options. If no tablet qualifies, the queries
+// fail, or go to the master if max_staleness_fallback_to_master is set.
+// The queries to the master are not restricted.
+func WithMaxStaleness(ctx context.Context, options *querypb.ExecuteOptions) context.Context {
+	if options.GetMaxStalenessMs() <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, maxStalenessKey{}, maxStaleness{
+		lag:              time.Duration(options.GetMaxStalenessMs()) * time.Millisecond,
+		fallbackToMaster: options.GetMaxStalenessFallbackToMaster(),
+	})
+}
+
+// MaxStaleness returns the maximum replication lag set by
+// WithMaxStaleness, and whether the reads fall back to the master.
+// The lag is 0 if there is no bound.
+func MaxStaleness(ctx context.Context) (time.Duration, bool) {
+	ms, _ := ctx.Value(maxStalenessKey{}).(maxStaleness)
+	return ms.lag, ms.fallbackToMaster
+}
+
+// filterByStaleness returns the tablets whose replication lag is at
+// most maxLag.
+func filterByStaleness(maxLag time.Duration, tablets []discovery.TabletStats) []discovery.TabletStats {
+	var filtered []discovery.TabletStats
+	for _, ts := range tablets {
+		if time.Duration(ts.Stats.GetSecondsBehindMaster())*time.Second <= maxLag {
+			filtered = append(filtered, ts)
+		}
+	}
+	return filtered
+}
+
+// isAllowedTabletType returns true if the vtgate can route queries to
+// the tablet type.
+func isAllowedTabletType(tabletType topodatapb.TabletType) bool {
+	if len(allowedTabletTypes) == 0 {
+		return true
+	}
+	for _, allowed := range allowedTabletTypes {
+		if allowed == tabletType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/discovery"
+)
+
+type targetCellKey struct{}
+
+// WithTargetCell returns a context that restricts the gateway to the
+// tablets of a cell. The queries fail if there is no healthy tablet in
+// that cell, instead of going to the other cells. An empty cell doesn't
+// restrict the tablets.
+func WithTargetCell(ctx context.Context, cell string) context.Context {
+	if cell == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, targetCellKey{}, cell)
+}
+
+// TargetCell returns the cell set by WithTargetCell, if any.
+func TargetCell(ctx context.Context) string {
+	cell, _ := ctx.Value(targetCellKey{}).(string)
+	return cell
+}
+
+// filterByCell returns the tablets of the cell.
+func filterByCell(cell string, tablets []discovery.TabletStats) []discovery.TabletStats {
+	var filtered []discovery.TabletStats
+	for _, ts := range tablets {
+		if ts.Tablet.Alias.Cell == cell {
+			filtered = append(filtered, ts)
+		}
+	}
+	return filtered
+}
@@ -41,7 +41,7 @@ var (
 	cert = flag.String("vtgate_grpc_cert", "", "the cert to use to connect")
 	key  = flag.String("vtgate_grpc_key", "", "the key to use to connect")
 	ca   = flag.String("vtgate_grpc_ca", "", "the server ca to use to validate servers when connecting")
-	name = flag.String("vtgate_grpc_server_name", "", "the server name to use to validate server certificate")
+	name = flag.String("vtgate_grpc_server_name", "", "the server name to use to validate server certificate, or the SPIFFE ID or trust domain of the server (like spiffe://example.org/vttablet), which requires a ca")
 )
 
 func init() {
@@ -475,7 +475,8 @@ func (conn *vtgateConn) SplitQuery(
 	splitColumns []string,
 	splitCount int64,
 	numRowsPerQueryPart int64,
-	algorithm querypb.SplitQueryRequest_Algorithm) ([]*vtgatepb.SplitQueryResponse_Part, error) {
+	algorithm querypb.SplitQueryRequest_Algorithm,
+	cell string) ([]*vtgatepb.SplitQueryResponse_Part, error) {
 
 	request := &vtgatepb.SplitQueryRequest{
 		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
@@ -488,6 +489,7 @@ func (conn *vtgateConn) SplitQuery(
 		SplitCount:          splitCount,
 		NumRowsPerQueryPart: numRowsPerQueryPart,
 		Algorithm:           algorithm,
+		Cell:                cell,
 	}
 	response, err := conn.c.SplitQuery(ctx, request)
 	if err != nil {
@@ -507,6 +509,23 @@ func (conn *vtgateConn) GetSrvKeyspace(ctx context.Context, keyspace string) (*t
 	return response.SrvKeyspace, nil
 }
 
+func (conn *vtgateConn) SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*vtgatepb.RuntimeFlagChange, error) {
+	request := &vtgatepb.SetRuntimeFlagsRequest{
+		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
+	}
+	for name, value := range flags {
+		request.Flags = append(request.Flags, &vtgatepb.RuntimeFlag{
+			Name:  name,
+			Value: value,
+		})
+	}
+	response, err := conn.c.SetRuntimeFlags(ctx, request)
+	if err != nil {
+		return nil, vterrors.FromGRPC(err)
+	}
+	return response.Changes, nil
+}
+
 type vstreamAdapter struct {
 	stream vtgateservicepb.Vitess_VStreamClient
 }
@@ -465,7 +465,8 @@ func (vtg *VTGate) SplitQuery(ctx context.Context, request *vtgatepb.SplitQueryR
 		request.SplitColumn,
 		request.SplitCount,
 		request.NumRowsPerQueryPart,
-		request.Algorithm)
+		request.Algorithm,
+		request.Cell)
 	if vtgErr != nil {
 		return nil, vterrors.ToGRPC(vtgErr)
 	}
@@ -524,6 +525,23 @@ func (vtg *VTGate) UpdateStream(request *vtgatepb.UpdateStreamRequest, stream vt
 	return vterrors.ToGRPC(vtgErr)
 }
 
+// SetRuntimeFlags is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) SetRuntimeFlags(ctx context.Context, request *vtgatepb.SetRuntimeFlagsRequest) (response *vtgatepb.SetRuntimeFlagsResponse, err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx = withCallerIDContext(ctx, request.CallerId)
+	flags := make(map[string]string, len(request.Flags))
+	for _, f := range request.Flags {
+		flags[f.Name] = f.Value
+	}
+	changes, vtgErr := vtg.server.SetRuntimeFlags(ctx, flags)
+	if vtgErr != nil {
+		return nil, vterrors.ToGRPC(vtgErr)
+	}
+	return &vtgatepb.SetRuntimeFlagsResponse{
+		Changes: changes,
+	}, nil
+}
+
 func init() {
 	vtgate.RegisterVTGates = append(vtgate.RegisterVTGates, func(vtGate vtgateservice.VTGateService) {
 		if servenv.GRPCCheckServiceMap("vtgateservice") {
@@ -69,6 +69,9 @@ func NewLogStats(ctx context.Context, methodName, sql string, bindVars map[strin
 func (stats *LogStats) Send() {
 	stats.EndTime = time.Now()
 	QueryLogger.Send(stats)
+	if querySamplerInstance != nil {
+		querySamplerInstance.sample(stats)
+	}
 }
 
 // Context returns the context used by LogStats.
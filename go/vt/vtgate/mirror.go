@@ -0,0 +1,145 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"math/rand"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+var (
+	mirrorSourceKeyspace = flag.String("mirror_source_keyspace", "", "keyspace whose selects are mirrored to -mirror_target")
+	mirrorTarget         = flag.String("mirror_target", "", "target of the mirrored selects, like keyspace, keyspace:shard or keyspace@replica. The results are discarded.")
+	mirrorPercent        = flag.Float64("mirror_percent", 0, "percentage of the selects of -mirror_source_keyspace that are mirrored")
+	mirrorMaxConcurrency = flag.Int("mirror_max_concurrency", 100, "maximum number of mirrored selects running at the same time, the others are dropped")
+	mirrorTimeout        = flag.Duration("mirror_timeout", 10*time.Second, "timeout of the mirrored selects")
+
+	mirrorQueries = stats.NewCountersWithMultiLabels("MirrorQueries", "Selects mirrored to the mirror target by source keyspace and result", []string{"Keyspace", "Result"})
+	// mirrorLatencyDelta is the latency of the mirror target minus the
+	// latency of the source, in milliseconds.
+	mirrorLatencyDelta = stats.NewHistogram("MirrorLatencyDelta", "Latency of the mirrored selects minus the latency of the original selects, in milliseconds", []int64{-1000, -100, -10, -1, 0, 1, 10, 100, 1000})
+)
+
+// mirror duplicates a percentage of the selects of a keyspace to another
+// target, to validate a migrated keyspace under production traffic before
+// it serves it. Only the successful selects that run outside of
+// transactions are mirrored, in the background: the mirrored results are
+// discarded, and their errors and their latency difference with the
+// original selects are recorded.
+type mirror struct {
+	sourceKeyspace string
+	target         string
+	fraction       float64
+	timeout        time.Duration
+
+	// sem limits the number of mirrored selects in flight.
+	sem chan struct{}
+
+	logErrors *logutil.ThrottledLogger
+}
+
+// newMirror returns the mirror configured by the flags, or nil if
+// mirroring is disabled.
+func newMirror() *mirror {
+	if *mirrorSourceKeyspace == "" || *mirrorTarget == "" || *mirrorPercent <= 0 {
+		return nil
+	}
+	keyspace, _, _, err := topoproto.ParseDestination(*mirrorTarget, defaultTabletType)
+	if err != nil {
+		log.Errorf("Invalid -mirror_target %v, mirroring is disabled: %v", *mirrorTarget, err)
+		return nil
+	}
+	if keyspace == *mirrorSourceKeyspace {
+		log.Errorf("-mirror_target %v is in -mirror_source_keyspace, mirroring is disabled", *mirrorTarget)
+		return nil
+	}
+	return &mirror{
+		sourceKeyspace: *mirrorSourceKeyspace,
+		target:         *mirrorTarget,
+		fraction:       *mirrorPercent / 100,
+		timeout:        *mirrorTimeout,
+		sem:            make(chan struct{}, *mirrorMaxConcurrency),
+		logErrors:      logutil.NewThrottledLogger("Mirror", 5*time.Second),
+	}
+}
+
+// shouldMirror returns true if a statement of the session is picked to
+// be mirrored.
+func (m *mirror) shouldMirror(safeSession *SafeSession, destKeyspace string, stmtType sqlparser.StatementType) bool {
+	if m == nil || stmtType != sqlparser.StmtSelect || destKeyspace != m.sourceKeyspace || safeSession.InTransaction() {
+		return false
+	}
+	return rand.Float64() < m.fraction
+}
+
+// send runs the select on the mirror target in the background. latency
+// is the time the original select took.
+func (m *mirror) send(ctx context.Context, e *Executor, sql string, bindVars map[string]*querypb.BindVariable, latency time.Duration) {
+	select {
+	case m.sem <- struct{}{}:
+	default:
+		mirrorQueries.Add([]string{m.sourceKeyspace, "Dropped"}, 1)
+		return
+	}
+
+	// The mirrored select must outlive the original request, but it
+	// runs as the same caller.
+	mirrorCtx := callerid.NewContext(context.Background(), callerid.EffectiveCallerIDFromContext(ctx), callerid.ImmediateCallerIDFromContext(ctx))
+	go func() {
+		defer func() { <-m.sem }()
+		ctx, cancel := context.WithTimeout(mirrorCtx, m.timeout)
+		defer cancel()
+
+		safeSession := NewSafeSession(&vtgatepb.Session{
+			TargetString: m.target,
+			Autocommit:   true,
+		})
+		logStats := NewLogStats(ctx, "Mirror", sql, bindVars)
+		start := time.Now()
+		_, err := e.execute(ctx, safeSession, sql, bindVars, logStats)
+		if err != nil {
+			mirrorQueries.Add([]string{m.sourceKeyspace, "Error"}, 1)
+			m.logErrors.Errorf("mirrored select to %v failed: %v, sql: %v", m.target, err, sql)
+			return
+		}
+		mirrorQueries.Add([]string{m.sourceKeyspace, "Success"}, 1)
+		mirrorLatencyDelta.Add((time.Since(start) - latency).Nanoseconds() / int64(time.Millisecond))
+	}()
+}
+
+// copyBindVars returns a copy of bindVars, which the original select
+// can modify while it's planned.
+func copyBindVars(bindVars map[string]*querypb.BindVariable) map[string]*querypb.BindVariable {
+	result := make(map[string]*querypb.BindVariable, len(bindVars))
+	for k, v := range bindVars {
+		result[k] = v
+	}
+	return result
+}
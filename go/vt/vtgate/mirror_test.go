@@ -0,0 +1,87 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestMirror(t *testing.T) {
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	executor.mirror = &mirror{
+		sourceKeyspace: KsTestUnsharded,
+		target:         "TestExecutor:-20",
+		fraction:       1,
+		timeout:        time.Second,
+		sem:            make(chan struct{}, 1),
+		logErrors:      logutil.NewThrottledLogger("Mirror", 5*time.Second),
+	}
+	initialSuccess := mirrorQueries.Counts()["TestUnsharded.Success"]
+	initialDropped := mirrorQueries.Counts()["TestUnsharded.Dropped"]
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded, Autocommit: true})
+	sql := "select id from music_user_map where id = 1"
+	if _, err := executor.Execute(context.Background(), "TestMirror", session, sql, nil); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; mirrorQueries.Counts()["TestUnsharded.Success"] != initialSuccess+1; i++ {
+		if i == 100 {
+			t.Fatalf("the select was not mirrored")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := sbclookup.ExecCount.Get(); got != 1 {
+		t.Errorf("sbclookup.ExecCount: %v, want 1", got)
+	}
+	if got := sbc1.ExecCount.Get(); got != 1 {
+		t.Errorf("sbc1.ExecCount: %v, want 1", got)
+	}
+	if got := sbc1.Queries[0].Sql; got != sql {
+		t.Errorf("mirrored sql: %v, want %v", got, sql)
+	}
+
+	// The selects of transactions are not mirrored.
+	if _, err := executor.Execute(context.Background(), "TestMirror", session, "begin", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := executor.Execute(context.Background(), "TestMirror", session, sql, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := executor.Execute(context.Background(), "TestMirror", session, "commit", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The selects are dropped when too many are in flight.
+	executor.mirror.sem <- struct{}{}
+	if _, err := executor.Execute(context.Background(), "TestMirror", session, sql, nil); err != nil {
+		t.Fatal(err)
+	}
+	<-executor.mirror.sem
+	if got, want := mirrorQueries.Counts()["TestUnsharded.Dropped"], initialDropped+1; got != want {
+		t.Errorf("dropped selects: %v, want %v", got, want)
+	}
+	if got := sbc1.ExecCount.Get(); got != 1 {
+		t.Errorf("sbc1.ExecCount: %v, want 1", got)
+	}
+}
@@ -235,7 +235,7 @@ func (rsb *resultsBuilder) SupplyWeightString(colNumber int) (weightcolNumber in
 	}
 	weightcolNumber, err = rsb.input.SupplyWeightString(colNumber)
 	if err != nil {
-		return 0, nil
+		return 0, err
 	}
 	rsb.weightStrings[rc] = weightcolNumber
 	if weightcolNumber < len(rsb.resultColumns) {
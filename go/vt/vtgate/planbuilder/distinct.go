@@ -0,0 +1,153 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*distinct)(nil)
+
+// distinct is the builder for engine.Distinct.
+// This gets built if the distinct clause cannot be pushed
+// down, like for a cross-shard join, or for an aggregation
+// performed by vtgate. For example:
+// 'select distinct u.col, e.col from user u join user_extra e on u.id = e.user_id'
+// will eliminate the duplicate rows returned by the join. The distinct
+// is also pushed into the routes of the join, which reduces the number
+// of rows vtgate has to deduplicate.
+// The rows are returned in the order of the input. If an order by is
+// requested, a memorySort is built on top of the distinct.
+type distinct struct {
+	resultsBuilder
+	edistinct *engine.Distinct
+}
+
+// newDistinct builds a new distinct. All the result columns
+// of the input are used as keys.
+func newDistinct(bldr builder) *distinct {
+	edistinct := &engine.Distinct{}
+	d := &distinct{
+		resultsBuilder: newResultsBuilder(bldr, edistinct),
+		edistinct:      edistinct,
+	}
+	for i := range d.resultColumns {
+		d.edistinct.Keys = append(d.edistinct.Keys, i)
+	}
+	return d
+}
+
+// Primitive satisfies the builder interface.
+func (d *distinct) Primitive() engine.Primitive {
+	d.edistinct.Input = d.input.Primitive()
+	return d.edistinct
+}
+
+// PushFilter satisfies the builder interface.
+// HAVING filters apply to the rows before the distinct,
+// and are pushed to the input.
+func (d *distinct) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	return d.input.PushFilter(pb, filter, whereType, origin)
+}
+
+// PushSelect satisfies the builder interface.
+func (d *distinct) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return nil, 0, errors.New("distinct.PushSelect: unreachable")
+}
+
+// MakeDistinct satisfies the builder interface.
+func (d *distinct) MakeDistinct() error {
+	return nil
+}
+
+// PushGroupBy satisfies the builder interface.
+func (d *distinct) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	return d.input.PushGroupBy(groupBy)
+}
+
+// PushOrderBy satisfies the builder interface.
+// The input may not return the rows in the requested order once the
+// distinct has to spill them. So, the input is only given an empty
+// order by, which still lets it request the order it needs, and the
+// rows are sorted by a memorySort after the distinct.
+func (d *distinct) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	bldr, err := d.input.PushOrderBy(nil)
+	if err != nil {
+		return nil, err
+	}
+	d.input = bldr
+
+	// Treat order by null as nil order by.
+	if len(orderBy) == 1 {
+		if _, ok := orderBy[0].Expr.(*sqlparser.NullVal); ok {
+			orderBy = nil
+		}
+	}
+	if len(orderBy) == 0 {
+		return d, nil
+	}
+	return newMemorySort(d, orderBy)
+}
+
+// SetUpperLimit satisfies the builder interface.
+// This is a no-op because the limit applies to the distinct rows.
+func (d *distinct) SetUpperLimit(_ *sqlparser.SQLVal) {
+}
+
+// PushMisc satisfies the builder interface.
+func (d *distinct) PushMisc(sel *sqlparser.Select) {
+	d.input.PushMisc(sel)
+}
+
+// Wireup satisfies the builder interface.
+// If text columns are detected in the keys, then the function modifies
+// the primitive to pull a corresponding weight_string from mysql and
+// compare those instead. This is because we currently don't have the
+// ability to mimic mysql's collation behavior.
+// The columns of unknown type that come from a route may be text
+// columns, so their weight_string is also requested if the route can
+// supply it. Otherwise, and for the values computed by vtgate,
+// engine.Distinct fails if they turn out to be text.
+func (d *distinct) Wireup(bldr builder, jt *jointab) error {
+	for i, colNumber := range d.edistinct.Keys {
+		rc := d.resultColumns[colNumber]
+		_, fromRoute := rc.column.Origin().(*route)
+		untyped := rc.column.typ == sqltypes.Null
+		if sqltypes.IsText(rc.column.typ) || (untyped && fromRoute) {
+			// If a weight string was previously requested, reuse it.
+			if weightcolNumber, ok := d.weightStrings[rc]; ok {
+				d.edistinct.Keys[i] = weightcolNumber
+				continue
+			}
+			weightcolNumber, err := d.input.SupplyWeightString(colNumber)
+			if err != nil {
+				if untyped {
+					continue
+				}
+				return err
+			}
+			d.weightStrings[rc] = weightcolNumber
+			d.edistinct.Keys[i] = weightcolNumber
+			d.edistinct.TruncateColumnCount = len(d.resultColumns)
+		}
+	}
+	return d.input.Wireup(bldr, jt)
+}
@@ -55,6 +55,8 @@ func (pb *primitiveBuilder) processTableExprs(tableExprs sqlparser.TableExprs) e
 		return err
 	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb.vindexHint = pb.vindexHint
+	rpb.hashJoin = pb.hashJoin
 	if err := rpb.processTableExprs(tableExprs[1:]); err != nil {
 		return err
 	}
@@ -182,10 +184,17 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 	if systemTable(tableName.Qualifier.String()) {
 		ks, err := pb.vschema.DefaultKeyspace()
 		if err != nil {
-			return err
+			if len(pb.sysTableSchema) == 0 {
+				return err
+			}
+			// The query is routed to the keyspace of its schema names.
+			ks = &vindexes.Keyspace{}
 		}
+		eroute := engine.NewSimpleRoute(engine.SelectDBA, ks)
+		eroute.SysTableSchema = pb.sysTableSchema
+		eroute.SysTableMerge = pb.sysTableMerge
 		rb, st := newRoute(sel)
-		rb.routeOptions = []*routeOption{newSimpleRouteOption(rb, engine.NewSimpleRoute(engine.SelectDBA, ks))}
+		rb.routeOptions = []*routeOption{newSimpleRouteOption(rb, eroute)}
 		pb.bldr, pb.st = rb, st
 		return nil
 	}
@@ -205,6 +214,9 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 	if err != nil {
 		return err
 	}
+	if pb.vindexHint != nil {
+		pb.vindexHint.apply(st.tables[alias], vschemaTables, vindexMaps)
+	}
 	for i, vst := range vschemaTables {
 		sub := &tableSubstitution{
 			oldExpr: tableExpr,
@@ -255,6 +267,24 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 	return nil
 }
 
+// apply restricts the vindexes of the vschema tables that have the
+// forced vindex to that vindex.
+func (vh *vindexHint) apply(t *table, vschemaTables []*vindexes.Table, vindexMaps []map[*column]vindexes.Vindex) {
+	for i, vst := range vschemaTables {
+		for _, cv := range vst.ColumnVindexes {
+			if cv.Name != vh.name {
+				continue
+			}
+			// Only the first column is used for vindex Map functions.
+			vindexMaps[i] = map[*column]vindexes.Vindex{
+				t.columns[cv.Columns[0].Lowered()]: cv.Vindex,
+			}
+			vh.used = true
+			break
+		}
+	}
+}
+
 // processJoin produces a builder subtree for the given Join.
 // If the left and right nodes can be part of the same route,
 // then it's a route. Otherwise, it's a join.
@@ -270,6 +300,8 @@ func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr) error {
 		return err
 	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb.vindexHint = pb.vindexHint
+	rpb.hashJoin = pb.hashJoin
 	if err := rpb.processTableExpr(ajoin.RightExpr); err != nil {
 		return err
 	}
@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"errors"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 )
@@ -64,6 +65,9 @@ type join struct {
 	Left, Right builder
 
 	ejoin *engine.Join
+	// hashJoin is set if the join is executed as a HashJoin. Its
+	// columns are then the ones of ejoin.
+	hashJoin *engine.HashJoin
 }
 
 // newJoin makes a new join using the two planBuilder. ajoin can be nil
@@ -76,6 +80,12 @@ func newJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
 	// external references, and the FROM clause doesn't allow duplicates,
 	// it's safe to perform this conversion and still expect the same behavior.
 
+	if lpb.hashJoin && ajoin != nil && ajoin.Condition.Using == nil {
+		if ok, err := newHashJoin(lpb, rpb, ajoin); ok || err != nil {
+			return err
+		}
+	}
+
 	opcode := engine.NormalJoin
 	if ajoin != nil {
 		switch {
@@ -114,6 +124,105 @@ func newJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
 	return lpb.pushFilter(ajoin.Condition.On, sqlparser.WhereStr)
 }
 
+// newHashJoin makes a new join executed as a HashJoin, if the ON clause
+// of ajoin only compares columns of the left side with columns of the
+// right side for equality. Otherwise it returns false, and the join is
+// built as a nested loop join.
+func newHashJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) (bool, error) {
+	opcode := engine.NormalJoin
+	if ajoin.Join == sqlparser.LeftJoinStr {
+		opcode = engine.LeftJoin
+	}
+	jb := &join{
+		weightStrings: make(map[*resultColumn]int),
+		Left:          lpb.bldr,
+		Right:         rpb.bldr,
+		ejoin: &engine.Join{
+			Opcode: opcode,
+			Vars:   make(map[string]int),
+		},
+	}
+	jb.Reorder(0)
+
+	var lcols, rcols, resolved []*sqlparser.ColName
+	isKey := func(expr sqlparser.Expr) (bool, error) {
+		cmp, ok := expr.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualStr {
+			return false, nil
+		}
+		lcol, lok := cmp.Left.(*sqlparser.ColName)
+		rcol, rok := cmp.Right.(*sqlparser.ColName)
+		if !lok || !rok {
+			return false, nil
+		}
+		for _, col := range []*sqlparser.ColName{lcol, rcol} {
+			if col.Metadata == nil {
+				resolved = append(resolved, col)
+			}
+		}
+		lorigin, lLocal, err := lpb.st.Find(lcol)
+		if err != nil {
+			return false, err
+		}
+		rorigin, rLocal, err := lpb.st.Find(rcol)
+		if err != nil {
+			return false, err
+		}
+		if !lLocal || !rLocal {
+			return false, nil
+		}
+		switch {
+		case jb.isOnLeft(lorigin.Order()) && !jb.isOnLeft(rorigin.Order()):
+		case !jb.isOnLeft(lorigin.Order()) && jb.isOnLeft(rorigin.Order()):
+			lcol, rcol = rcol, lcol
+		default:
+			return false, nil
+		}
+		lcols = append(lcols, lcol)
+		rcols = append(rcols, rcol)
+		return true, nil
+	}
+	for _, expr := range splitAndExpression(nil, ajoin.Condition.On) {
+		if ok, err := isKey(expr); !ok || err != nil {
+			// The nested loop join resolves the columns again.
+			for _, col := range resolved {
+				col.Metadata = nil
+			}
+			return false, err
+		}
+	}
+
+	jb.hashJoin = &engine.HashJoin{Opcode: opcode}
+	for i := range lcols {
+		lkey, rkey, err := jb.supplyKeys(lcols[i], rcols[i])
+		if err != nil {
+			return false, err
+		}
+		jb.hashJoin.LeftKeys = append(jb.hashJoin.LeftKeys, lkey)
+		jb.hashJoin.RightKeys = append(jb.hashJoin.RightKeys, rkey)
+	}
+	lpb.bldr = jb
+	return true, nil
+}
+
+// supplyKeys supplies the columns of a key of the hash join on both
+// sides. Like for memorySort, the weight_string of the text columns is
+// compared instead, to follow the collation of MySQL.
+func (jb *join) supplyKeys(lcol, rcol *sqlparser.ColName) (lkey, rkey int, err error) {
+	lrc, lkey := jb.Left.SupplyCol(lcol)
+	rrc, rkey := jb.Right.SupplyCol(rcol)
+	if !sqltypes.IsText(lrc.column.typ) && !sqltypes.IsText(rrc.column.typ) {
+		return lkey, rkey, nil
+	}
+	if lkey, err = jb.Left.SupplyWeightString(lkey); err != nil {
+		return 0, 0, err
+	}
+	if rkey, err = jb.Right.SupplyWeightString(rkey); err != nil {
+		return 0, 0, err
+	}
+	return lkey, rkey, nil
+}
+
 // Order satisfies the builder interface.
 func (jb *join) Order() int {
 	return jb.order
@@ -129,6 +238,12 @@ func (jb *join) Reorder(order int) {
 
 // Primitive satisfies the builder interface.
 func (jb *join) Primitive() engine.Primitive {
+	if jb.hashJoin != nil {
+		jb.hashJoin.Left = jb.Left.Primitive()
+		jb.hashJoin.Right = jb.Right.Primitive()
+		jb.hashJoin.Cols = jb.ejoin.Cols
+		return jb.hashJoin
+	}
 	jb.ejoin.Left = jb.Left.Primitive()
 	jb.ejoin.Right = jb.Right.Primitive()
 	return jb.ejoin
@@ -221,6 +336,12 @@ func (jb *join) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
 		return jb, nil
 	}
 
+	// The rows of a hash join are not returned in the order of the left
+	// side once it spills to disk.
+	if jb.hashJoin != nil {
+		return newMemorySort(jb, orderBy)
+	}
+
 	for _, order := range orderBy {
 		if node, ok := order.Expr.(*sqlparser.SQLVal); ok {
 			// This block handles constructs that use ordinals for 'ORDER BY'. For example:
@@ -287,6 +408,11 @@ func (jb *join) Wireup(bldr builder, jt *jointab) error {
 	if err != nil {
 		return err
 	}
+	// The right side of a hash join is executed once, so it can't
+	// use the values of the left rows.
+	if jb.hashJoin != nil && len(jb.ejoin.Vars) != 0 {
+		return errors.New("unsupported: hash join with a condition other than the equality of the columns of both sides")
+	}
 	return jb.Left.Wireup(bldr, jt)
 }
 
@@ -24,6 +24,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 var _ builder = (*orderedAggregate)(nil)
@@ -57,6 +58,25 @@ type orderedAggregate struct {
 	resultsBuilder
 	extraDistinct *sqlparser.ColName
 	eaggr         *engine.OrderedAggregate
+
+	// computed lists the complex aggregate expressions of the select
+	// list, like 'sum(a) / count(*)', and having lists the HAVING
+	// filters. They are evaluated by vtgate, and are converted during
+	// Wireup, once the select list is complete.
+	computed []computedExpr
+	having   []sqlparser.Expr
+
+	// aggregates maps the aggregates computed by oa to their column
+	// number, so that the evaluated expressions can reuse them.
+	aggregates map[string]int
+}
+
+// computedExpr is a select expression evaluated by vtgate, the
+// column of its placeholder in the input, and its result column.
+type computedExpr struct {
+	expr   sqlparser.Expr
+	col    int
+	column *column
 }
 
 // checkAggregates analyzes the select expression for aggregates. If it determines
@@ -70,25 +90,21 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 	}
 
 	// Check if we can allow aggregates.
-	hasAggregates := false
-	if sel.Distinct != "" {
-		hasAggregates = true
-	} else {
-		hasAggregates = nodeHasAggregates(sel.SelectExprs)
-	}
-	if len(sel.GroupBy) > 0 {
-		hasAggregates = true
-	}
-	if !hasAggregates {
+	hasAggregates := nodeHasAggregates(sel.SelectExprs) || len(sel.GroupBy) > 0
+	if !hasAggregates && sel.Distinct == "" {
 		return nil
 	}
 
 	// The query has aggregates. We can proceed only
 	// if the underlying primitive is a route because
 	// we need the ability to push down group by and
-	// order by clauses.
+	// order by clauses. A distinct without aggregates
+	// is otherwise performed by vtgate: see pushDistinct.
 	if !isRoute {
-		return errors.New("unsupported: cross-shard query with aggregates")
+		if hasAggregates {
+			return errors.New("unsupported: cross-shard query with aggregates")
+		}
+		return nil
 	}
 
 	// If there is a distinct clause, we can check the select list
@@ -139,6 +155,32 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 	return nil
 }
 
+// decomposeAvg replaces the avg aggregates of expr with the sum divided
+// by the count, which can be merged across shards.
+func decomposeAvg(expr sqlparser.Expr) sqlparser.Expr {
+	var avgs []*sqlparser.FuncExpr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.Name.EqualString("avg") && !node.Distinct && len(node.Exprs) == 1 {
+				avgs = append(avgs, node)
+				return false, nil
+			}
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	for _, avg := range avgs {
+		expr = sqlparser.ReplaceExpr(expr, avg, &sqlparser.BinaryExpr{
+			Left:     &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("sum"), Exprs: avg.Exprs},
+			Operator: sqlparser.DivStr,
+			Right:    &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("count"), Exprs: avg.Exprs},
+		})
+	}
+	return expr
+}
+
 func nodeHasAggregates(node sqlparser.SQLNode) bool {
 	hasAggregates := false
 	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
@@ -244,8 +286,14 @@ func (oa *orderedAggregate) Primitive() engine.Primitive {
 }
 
 // PushFilter satisfies the builder interface.
-func (oa *orderedAggregate) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
-	return errors.New("unsupported: filtering on results of aggregates")
+// Only HAVING filters can be handled by oa: they are evaluated by
+// vtgate on the aggregated rows.
+func (oa *orderedAggregate) PushFilter(_ *primitiveBuilder, filter sqlparser.Expr, whereType string, _ builder) error {
+	if whereType != sqlparser.HavingStr {
+		return errors.New("unsupported: filtering on results of aggregates")
+	}
+	oa.having = append(oa.having, filter)
+	return nil
 }
 
 // PushSelect satisfies the builder interface.
@@ -260,6 +308,9 @@ func (oa *orderedAggregate) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, wh
 // MAX sent to the route will not be added to symtab and will not be reachable by
 // others. This functionality depends on the PushOrderBy to request that
 // the rows be correctly ordered.
+// Expressions that contain aggregates, like 'sum(a) / count(*)', are
+// computed by vtgate from the aggregated rows. An avg is computed as
+// the sum divided by the count of its expression.
 func (oa *orderedAggregate) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
 	if inner, ok := expr.Expr.(*sqlparser.FuncExpr); ok {
 		if _, ok := engine.SupportedAggregates[inner.Name.Lowered()]; ok {
@@ -267,9 +318,8 @@ func (oa *orderedAggregate) PushSelect(pb *primitiveBuilder, expr *sqlparser.Ali
 		}
 	}
 
-	// Ensure that there are no aggregates in the expression.
 	if nodeHasAggregates(expr.Expr) {
-		return nil, 0, errors.New("unsupported: in scatter query: complex aggregate expression")
+		return oa.pushComputed(pb, expr, origin)
 	}
 
 	innerRC, _, _ := oa.input.PushSelect(pb, expr, origin)
@@ -324,6 +374,7 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 			Opcode: opcode,
 			Col:    innerCol,
 		})
+		oa.addAggregate(funcExpr, innerCol)
 	}
 
 	// Build a new rc with oa as origin because it's semantically different
@@ -333,6 +384,130 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 	return rc, len(oa.resultColumns) - 1, nil
 }
 
+// pushComputed pushes an expression that contains aggregates. A null
+// placeholder is pushed to the input in its place. The expression is
+// converted during Wireup, which also pushes the aggregates it needs.
+func (oa *orderedAggregate) pushComputed(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	// The placeholder has the name of the expression, so that the
+	// field names of the result are as expected.
+	name := expr.As
+	if name.IsEmpty() {
+		name = sqlparser.NewColIdent(sqlparser.String(expr.Expr))
+	}
+	placeholder := &sqlparser.AliasedExpr{
+		Expr: &sqlparser.NullVal{},
+		As:   name,
+	}
+	_, innerCol, _ := oa.input.PushSelect(pb, placeholder, origin)
+	rc = newResultColumn(expr, oa)
+	oa.resultColumns = append(oa.resultColumns, rc)
+	oa.computed = append(oa.computed, computedExpr{
+		expr:   expr.Expr,
+		col:    innerCol,
+		column: rc.column,
+	})
+	return rc, len(oa.resultColumns) - 1, nil
+}
+
+// isComputed returns true if the column is computed by oa.
+func (oa *orderedAggregate) isComputed(c *column) bool {
+	for _, computed := range oa.computed {
+		if computed.column == c {
+			return true
+		}
+	}
+	return false
+}
+
+func (oa *orderedAggregate) addAggregate(funcExpr *sqlparser.FuncExpr, colNumber int) {
+	if oa.aggregates == nil {
+		oa.aggregates = make(map[string]int)
+	}
+	oa.aggregates[sqlparser.String(funcExpr)] = colNumber
+}
+
+// lookupColumn implements evalengine.ColumnLookup for the expressions
+// evaluated by oa. The aggregates and the columns that are not in the
+// select list are pushed to the input, and truncated from the result.
+func (oa *orderedAggregate) lookupColumn(expr sqlparser.Expr) (int, bool) {
+	switch node := expr.(type) {
+	case *sqlparser.ColName:
+		c, ok := node.Metadata.(*column)
+		if !ok {
+			return 0, false
+		}
+		for i, rc := range oa.resultColumns {
+			if rc.column == c {
+				return i, true
+			}
+		}
+		if c.Origin() != oa.input {
+			return 0, false
+		}
+		_, colNumber := oa.input.SupplyCol(node)
+		oa.truncateHidden(colNumber)
+		return colNumber, true
+	case *sqlparser.FuncExpr:
+		opcode, ok := engine.SupportedAggregates[node.Name.Lowered()]
+		if !ok || node.Distinct || len(node.Exprs) != 1 {
+			return 0, false
+		}
+		key := sqlparser.String(node)
+		if colNumber, ok := oa.aggregates[key]; ok {
+			return colNumber, true
+		}
+		// It's ok to pass nil for pb and builder because the underlying
+		// route doesn't use them.
+		_, colNumber, _ := oa.input.PushSelect(nil, &sqlparser.AliasedExpr{Expr: node}, nil)
+		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
+			Opcode: opcode,
+			Col:    colNumber,
+		})
+		oa.addAggregate(node, colNumber)
+		oa.truncateHidden(colNumber)
+		return colNumber, true
+	}
+	return 0, false
+}
+
+// truncateHidden truncates the columns that were supplied by the input
+// only for the expressions evaluated by oa.
+func (oa *orderedAggregate) truncateHidden(colNumber int) {
+	if colNumber >= len(oa.resultColumns) {
+		oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+	}
+}
+
+// wireupExpressions converts the computed select expressions and the
+// HAVING filters to expressions evaluated by the primitive.
+func (oa *orderedAggregate) wireupExpressions() error {
+	for _, computed := range oa.computed {
+		expr, err := evalengine.Convert(decomposeAvg(computed.expr), oa.lookupColumn)
+		if err != nil {
+			return err
+		}
+		oa.eaggr.Computed = append(oa.eaggr.Computed, engine.ComputedColumn{
+			Col:  computed.col,
+			Expr: expr,
+		})
+	}
+	for _, filter := range oa.having {
+		expr, err := evalengine.Convert(decomposeAvg(filter), oa.lookupColumn)
+		if err != nil {
+			return err
+		}
+		if oa.eaggr.Having != nil {
+			expr = &evalengine.Logical{
+				Op:    evalengine.OpAnd,
+				Left:  oa.eaggr.Having,
+				Right: expr,
+			}
+		}
+		oa.eaggr.Having = expr
+	}
+	return nil
+}
+
 // needDistinctHandling returns true if oa needs to handle the distinct clause.
 // If true, it will also return the aliased expression that needs to be pushed
 // down into the underlying route.
@@ -362,6 +537,17 @@ func (oa *orderedAggregate) needDistinctHandling(pb *primitiveBuilder, funcExpr
 	return !success, innerAliased, nil
 }
 
+// hasAggregates returns true if the select list of oa has
+// aggregate functions supplied by oa.
+func (oa *orderedAggregate) hasAggregates() bool {
+	for _, rc := range oa.resultColumns {
+		if rc.column.Origin() == oa {
+			return true
+		}
+	}
+	return false
+}
+
 func (oa *orderedAggregate) MakeDistinct() error {
 	for i, rc := range oa.resultColumns {
 		// If the column origin is oa (and not the underlying route),
@@ -455,6 +641,11 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 		default:
 			return nil, fmt.Errorf("unsupported: in scatter query: complex order by expression: %v", sqlparser.String(expr))
 		}
+		// The input sorts the rows before they are aggregated, so
+		// it cannot sort by the values vtgate computes afterwards.
+		if oa.isComputed(orderByCol) {
+			return nil, fmt.Errorf("unsupported: in scatter query: order by expression computed by vtgate: %v", sqlparser.String(order.Expr))
+		}
 
 		// Match orderByCol against the group by columns.
 		found := false
@@ -521,7 +712,12 @@ func (oa *orderedAggregate) PushMisc(sel *sqlparser.Select) {
 // the primitive to pull a corresponding weight_string from mysql and
 // compare those instead. This is because we currently don't have the
 // ability to mimic mysql's collation behavior.
+// The expressions evaluated by oa are converted first, since they may
+// need more columns from the input.
 func (oa *orderedAggregate) Wireup(bldr builder, jt *jointab) error {
+	if err := oa.wireupExpressions(); err != nil {
+		return err
+	}
 	for i, colNumber := range oa.eaggr.Keys {
 		rc := oa.resultColumns[colNumber]
 		if sqltypes.IsText(rc.column.typ) {
@@ -27,7 +27,7 @@ import (
 // and ensures that there are no subqueries.
 func (pb *primitiveBuilder) pushGroupBy(sel *sqlparser.Select) error {
 	if sel.Distinct != "" {
-		if err := pb.bldr.MakeDistinct(); err != nil {
+		if err := pb.pushDistinct(); err != nil {
 			return err
 		}
 	}
@@ -38,6 +38,40 @@ func (pb *primitiveBuilder) pushGroupBy(sel *sqlparser.Select) error {
 	return pb.bldr.PushGroupBy(sel.GroupBy)
 }
 
+// pushDistinct makes the primitive return distinct rows. If the distinct
+// cannot be handled by the primitive, the rows are deduplicated by vtgate.
+// For a join, the distinct is still pushed into its routes, which
+// reduces the number of rows returned to vtgate.
+func (pb *primitiveBuilder) pushDistinct() error {
+	switch bldr := pb.bldr.(type) {
+	case *join:
+		pushDistinctToRoutes(bldr)
+	case *orderedAggregate:
+		if !bldr.hasAggregates() {
+			return bldr.MakeDistinct()
+		}
+	default:
+		return pb.bldr.MakeDistinct()
+	}
+	pb.bldr = newDistinct(pb.bldr)
+	pb.bldr.Reorder(0)
+	return nil
+}
+
+// pushDistinctToRoutes makes the routes of a join return distinct rows.
+// This is only an optimization: the join can still return duplicates.
+func pushDistinctToRoutes(bldr builder) {
+	switch bldr := bldr.(type) {
+	case *join:
+		pushDistinctToRoutes(bldr.Left)
+		pushDistinctToRoutes(bldr.Right)
+	case *route:
+		if _, ok := bldr.Select.(*sqlparser.Select); ok {
+			_ = bldr.MakeDistinct()
+		}
+	}
+}
+
 // pushOrderBy pushes the order by clause into the primitives.
 // It resolves all symbols and ensures that there are no subqueries.
 func (pb *primitiveBuilder) pushOrderBy(orderBy sqlparser.OrderBy) error {
@@ -16,6 +16,8 @@ limitations under the License.
 
 package planbuilder
 
+import "vitess.io/vitess/go/sqltypes"
+
 // primitiveBuilder is the top level type for building plans.
 // It contains the current builder tree, the symtab and
 // the jointab. It can create transient planBuilders due
@@ -25,6 +27,26 @@ type primitiveBuilder struct {
 	jt      *jointab
 	bldr    builder
 	st      *symtab
+
+	// vindexHint is set if the FORCE_VINDEX directive is set.
+	vindexHint *vindexHint
+	// hashJoin is set if the HASH_JOIN directive is set.
+	hashJoin bool
+
+	// sysTableSchema are the schema names of the system tables
+	// of the query, if it compares them to values.
+	sysTableSchema []sqltypes.PlanValue
+	// sysTableMerge is true if the rows of the shards of the keyspaces
+	// of sysTableSchema are merged.
+	sysTableMerge bool
+}
+
+// vindexHint is the vindex forced by the FORCE_VINDEX directive. The
+// tables that have it can only be routed with it.
+type vindexHint struct {
+	name string
+	// used is set if one of the tables has the vindex.
+	used bool
 }
 
 func newPrimitiveBuilder(vschema ContextVSchema, jt *jointab) *primitiveBuilder {
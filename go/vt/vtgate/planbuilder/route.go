@@ -423,11 +423,19 @@ func (rb *route) SupplyWeightString(colNumber int) (weightcolNumber int, err err
 	if weightcolNumber, ok := rb.weightStrings[rc]; ok {
 		return weightcolNumber, nil
 	}
+	sel, ok := rb.Select.(*sqlparser.Select)
+	if !ok {
+		return 0, fmt.Errorf("unsupported: weight_string of a union column")
+	}
+	aliased, ok := sel.SelectExprs[colNumber].(*sqlparser.AliasedExpr)
+	if !ok {
+		return 0, fmt.Errorf("unsupported: weight_string of %s", sqlparser.String(sel.SelectExprs[colNumber]))
+	}
 	expr := &sqlparser.AliasedExpr{
 		Expr: &sqlparser.FuncExpr{
 			Name: sqlparser.NewColIdent("weight_string"),
 			Exprs: []sqlparser.SelectExpr{
-				rb.Select.(*sqlparser.Select).SelectExprs[colNumber],
+				&sqlparser.AliasedExpr{Expr: aliased.Expr},
 			},
 		},
 	}
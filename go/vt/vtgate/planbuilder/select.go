@@ -72,14 +72,34 @@ func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (primitive e
 // pushed into a route, then a primitive is created on top of any
 // of the above trees to make it discard unwanted rows.
 func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab) error {
+	var ctes *cteVSchema
+	if sel.With != nil {
+		var err error
+		if ctes, err = pb.processWith(sel.With); err != nil {
+			return err
+		}
+	}
+	directives := sqlparser.ExtractCommentDirectives(sel.Comments)
+	if name, ok := directives.GetString(sqlparser.DirectiveForceVindex); ok {
+		pb.vindexHint = &vindexHint{name: name}
+	}
+	pb.hashJoin = directives.IsSet(sqlparser.DirectiveHashJoin)
+	sysTableSchema, err := rewriteSysTableSchema(sel)
+	if err != nil {
+		return err
+	}
+	pb.sysTableSchema = sysTableSchema
+	pb.sysTableMerge = len(sysTableSchema) != 0 && mergesSysTableShards(sel)
 	if err := pb.processTableExprs(sel.From); err != nil {
 		return err
 	}
+	if pb.vindexHint != nil && !pb.vindexHint.used {
+		return fmt.Errorf("vindex %s of the %s directive not found in the tables of the query", pb.vindexHint.name, sqlparser.DirectiveForceVindex)
+	}
 
 	if rb, ok := pb.bldr.(*route); ok {
 		// TODO(sougou): this can probably be improved.
 		for _, ro := range rb.routeOptions {
-			directives := sqlparser.ExtractCommentDirectives(sel.Comments)
 			ro.eroute.QueryTimeout = queryTimeout(directives)
 			if ro.eroute.TargetDestination != nil {
 				return errors.New("unsupported: SELECT with a target destination")
@@ -99,6 +119,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 			return err
 		}
 	}
+	if err := pb.checkWindowFuncs(sel); err != nil {
+		return err
+	}
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
@@ -117,9 +140,45 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 		return err
 	}
 	pb.bldr.PushMisc(sel)
+	if sel.With != nil {
+		return pb.pushWith(sel.With, ctes)
+	}
 	return nil
 }
 
+// checkWindowFuncs returns an error if the select has window functions
+// and can't be sent to a single shard, because a window function must
+// see all the rows of its partitions.
+func (pb *primitiveBuilder) checkWindowFuncs(sel *sqlparser.Select) error {
+	if !nodeHasWindowFuncs(sel.SelectExprs) && !nodeHasWindowFuncs(sel.OrderBy) {
+		return nil
+	}
+	if rb, isRoute := pb.bldr.(*route); isRoute && rb.removeMultishardOptions() {
+		return nil
+	}
+	return errors.New("unsupported: window functions in a cross-shard query")
+}
+
+// nodeHasWindowFuncs returns true if the node has window functions
+// outside of subqueries.
+func nodeHasWindowFuncs(node sqlparser.SQLNode) bool {
+	hasWindowFuncs := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.Over != nil {
+				hasWindowFuncs = true
+				return false, errors.New("unused error")
+			}
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	return hasWindowFuncs
+}
+
 // pushFilter identifies the target route for the specified bool expr,
 // pushes it down, and updates the route info if the new constraint improves
 // the primitive. This function can push to a WHERE or HAVING clause.
@@ -0,0 +1,142 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// rewriteSysTableSchema replaces the values that the WHERE clause of a
+// query of the system tables compares to the schema names with the
+// BvSchemaName bind variable, and the lists of its IN comparisons with
+// the BvSchemaNames bind variable, and returns them. The route of the
+// query resolves them at execution: the keyspaces have other database
+// names on the tablets.
+func rewriteSysTableSchema(sel *sqlparser.Select) ([]sqltypes.PlanValue, error) {
+	if sel.Where == nil || !hasSystemTable(sel.From) {
+		return nil, nil
+	}
+	var values []sqltypes.PlanValue
+	for _, filter := range splitAndExpression(nil, sel.Where.Expr) {
+		comparison, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok {
+			continue
+		}
+		switch comparison.Operator {
+		case sqlparser.EqualStr:
+			col, val := comparison.Left, &comparison.Right
+			if _, ok := col.(*sqlparser.ColName); !ok {
+				col, val = comparison.Right, &comparison.Left
+			}
+			if !isSchemaNameColumn(col) || !sqlparser.IsValue(*val) {
+				continue
+			}
+			pv, err := sqlparser.NewPlanValue(*val)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, pv)
+			*val = sqlparser.NewValArg([]byte(":" + sqltypes.BvSchemaName))
+		case sqlparser.InStr:
+			if !isSchemaNameColumn(comparison.Left) || !isValueList(comparison.Right) {
+				continue
+			}
+			pv, err := sqlparser.NewPlanValue(comparison.Right)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, pv)
+			comparison.Right = sqlparser.ListArg([]byte("::" + sqltypes.BvSchemaNames))
+		}
+	}
+	return values, nil
+}
+
+// isSchemaNameColumn returns true if expr is a column of the system
+// tables that contains schema names.
+func isSchemaNameColumn(expr sqlparser.Expr) bool {
+	colName, ok := expr.(*sqlparser.ColName)
+	return ok && sqltypes.SchemaNameColumns[colName.Name.Lowered()]
+}
+
+// isValueList returns true if expr is a list bind variable, or a tuple
+// of values.
+func isValueList(expr sqlparser.Expr) bool {
+	switch expr := expr.(type) {
+	case sqlparser.ListArg:
+		return true
+	case sqlparser.ValTuple:
+		for _, val := range expr {
+			if !sqlparser.IsValue(val) {
+				return false
+			}
+		}
+		return len(expr) != 0
+	}
+	return false
+}
+
+// mergesSysTableShards returns true if the rows that a query of the system
+// tables returns from the shards of a keyspace can be merged into the rows
+// of a single schema: the query reads the columns of
+// information_schema.tables, without aggregating or limiting them. The
+// table statistics are then added up across the shards, like a view of
+// the whole keyspace.
+func mergesSysTableShards(sel *sqlparser.Select) bool {
+	if len(sel.From) != 1 || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil {
+		return false
+	}
+	tableExpr, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return false
+	}
+	tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+	if !ok || !strings.EqualFold(tableName.Qualifier.String(), "information_schema") || !strings.EqualFold(tableName.Name.String(), "tables") {
+		return false
+	}
+	for _, expr := range sel.SelectExprs {
+		if nodeHasAggregates(expr) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasSystemTable returns true if the table expressions have a system
+// table.
+func hasSystemTable(tableExprs sqlparser.TableExprs) bool {
+	for _, tableExpr := range tableExprs {
+		switch tableExpr := tableExpr.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if tableName, ok := tableExpr.Expr.(sqlparser.TableName); ok && systemTable(tableName.Qualifier.String()) {
+				return true
+			}
+		case *sqlparser.JoinTableExpr:
+			if hasSystemTable(sqlparser.TableExprs{tableExpr.LeftExpr, tableExpr.RightExpr}) {
+				return true
+			}
+		case *sqlparser.ParenTableExpr:
+			if hasSystemTable(tableExpr.Exprs) {
+				return true
+			}
+		}
+	}
+	return false
+}
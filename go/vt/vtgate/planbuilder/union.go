@@ -37,6 +37,13 @@ func buildUnionPlan(union *sqlparser.Union, vschema ContextVSchema) (primitive e
 }
 
 func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab) error {
+	var ctes *cteVSchema
+	if union.With != nil {
+		var err error
+		if ctes, err = pb.processWith(union.With); err != nil {
+			return err
+		}
+	}
 	if err := pb.processPart(union.Left, outer); err != nil {
 		return err
 	}
@@ -53,7 +60,13 @@ func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab)
 	if err := pb.pushOrderBy(union.OrderBy); err != nil {
 		return err
 	}
-	return pb.pushLimit(union.Limit)
+	if err := pb.pushLimit(union.Limit); err != nil {
+		return err
+	}
+	if union.With != nil {
+		return pb.pushWith(union.With, ctes)
+	}
+	return nil
 }
 
 func (pb *primitiveBuilder) processPart(part sqlparser.SelectStatement, outer *symtab) error {
@@ -0,0 +1,156 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file has functions to analyze the WITH clause.
+//
+// Common table expressions are passed through to MySQL as is. This is
+// only possible if the whole query can be sent to a single unsharded
+// keyspace. The body of every common table expression is analyzed like
+// a subquery, and references to a common table expression are then
+// resolved as tables of the unsharded keyspace it was routed to. This
+// allows the rest of the query to be merged into the same route.
+
+var errWithCrossShard = errors.New("unsupported: WITH clause in a cross-shard or cross-keyspace query")
+
+// unsupportedWith returns the error for a WITH clause whose query, or
+// the body of one of its common table expressions, was built into bldr,
+// which can't be sent to a single unsharded keyspace.
+func unsupportedWith(bldr builder) error {
+	rb, ok := bldr.(*route)
+	if !ok {
+		return errWithCrossShard
+	}
+	return fmt.Errorf("unsupported: WITH clause on sharded keyspace %s", rb.routeOptions[0].eroute.Keyspace.Name)
+}
+
+// cteVSchema is a ContextVSchema that resolves the names of
+// common table expressions.
+type cteVSchema struct {
+	ContextVSchema
+	keyspace *vindexes.Keyspace
+	names    map[string]bool
+	// routes are the routes built for the bodies of the
+	// common table expressions.
+	routes []*route
+}
+
+// FindTablesOrVindex returns a table of the keyspace of the common
+// table expressions for unqualified references to them.
+func (vschema *cteVSchema) FindTablesOrVindex(tablename sqlparser.TableName) ([]*vindexes.Table, vindexes.Vindex, string, topodatapb.TabletType, key.Destination, error) {
+	if tablename.Qualifier.IsEmpty() && vschema.names[tablename.Name.String()] {
+		table := &vindexes.Table{
+			Name:     tablename.Name,
+			Keyspace: vschema.keyspace,
+		}
+		return []*vindexes.Table{table}, nil, "", topodatapb.TabletType_UNKNOWN, nil, nil
+	}
+	return vschema.ContextVSchema.FindTablesOrVindex(tablename)
+}
+
+// processWith analyzes the common table expressions of a WITH clause,
+// and makes them visible to the rest of the query.
+func (pb *primitiveBuilder) processWith(with *sqlparser.With) (*cteVSchema, error) {
+	vschema := &cteVSchema{
+		ContextVSchema: pb.vschema,
+		names:          make(map[string]bool),
+	}
+	for _, cte := range with.CTEs {
+		body := cte.Subquery.Select
+		if with.Recursive {
+			// A recursive common table expression can reference itself,
+			// but not in its first part. Use that part to find where the
+			// whole expression can be sent to.
+			if union, ok := body.(*sqlparser.Union); ok {
+				if err := vschema.addKeyspace(pb.cteKeyspace(vschema, union.Left)); err != nil {
+					return nil, err
+				}
+				vschema.names[cte.Name.String()] = true
+			}
+		}
+		if err := vschema.addKeyspace(pb.cteKeyspace(vschema, body)); err != nil {
+			return nil, err
+		}
+		vschema.names[cte.Name.String()] = true
+	}
+	pb.vschema = vschema
+	return vschema, nil
+}
+
+// cteKeyspace returns the keyspace that the body of a common
+// table expression can be sent to.
+func (pb *primitiveBuilder) cteKeyspace(vschema *cteVSchema, stmt sqlparser.SelectStatement) (*vindexes.Keyspace, error) {
+	spb := newPrimitiveBuilder(vschema, pb.jt)
+	if err := spb.processPart(stmt, nil); err != nil {
+		return nil, err
+	}
+	rb, ok := spb.bldr.(*route)
+	if !ok || !rb.removeShardedOptions() {
+		return nil, unsupportedWith(spb.bldr)
+	}
+	vschema.routes = append(vschema.routes, rb)
+	return rb.routeOptions[0].eroute.Keyspace, nil
+}
+
+// addKeyspace checks that all the common table expressions
+// are in the same keyspace.
+func (vschema *cteVSchema) addKeyspace(keyspace *vindexes.Keyspace, err error) error {
+	if err != nil {
+		return err
+	}
+	if vschema.keyspace != nil && vschema.keyspace.Name != keyspace.Name {
+		return fmt.Errorf("unsupported: WITH clause with common table expressions in different keyspaces %s and %s", vschema.keyspace.Name, keyspace.Name)
+	}
+	vschema.keyspace = keyspace
+	return nil
+}
+
+// pushWith pushes the WITH clause into the route that the query was
+// built into. The route must be sent to the keyspace of the common
+// table expressions.
+func (pb *primitiveBuilder) pushWith(with *sqlparser.With, ctes *cteVSchema) error {
+	rb, ok := pb.bldr.(*route)
+	if !ok || !rb.removeShardedOptions() {
+		return unsupportedWith(pb.bldr)
+	}
+	if !rb.removeOptionsWithUnmatchedKeyspace(ctes.keyspace.Name) {
+		return fmt.Errorf("unsupported: WITH clause of keyspace %s in a query of keyspace %s", ctes.keyspace.Name, rb.routeOptions[0].eroute.Keyspace.Name)
+	}
+	// The columns of the common table expressions are now
+	// local to the route, like those of a merged subquery.
+	for _, cte := range ctes.routes {
+		cte.Redirect = rb
+	}
+	switch stmt := rb.Select.(type) {
+	case *sqlparser.Select:
+		stmt.With = with
+	case *sqlparser.Union:
+		stmt.With = with
+	}
+	return nil
+}
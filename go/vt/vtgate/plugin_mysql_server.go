@@ -34,6 +34,7 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/log"
@@ -65,6 +66,10 @@ var (
 	mysqlConnWriteTimeout = flag.Duration("mysql_server_write_timeout", 0, "connection write timeout")
 	mysqlQueryTimeout     = flag.Duration("mysql_server_query_timeout", 0, "mysql query timeout")
 
+	// mysqlQueryTimeoutValue is the query timeout, which can be changed
+	// at runtime.
+	mysqlQueryTimeoutValue = sync2.NewAtomicDuration(*mysqlQueryTimeout)
+
 	busyConnections int32
 )
 
@@ -89,18 +94,20 @@ func (vh *vtgateHandler) ComResetConnection(c *mysql.Conn) {
 	if session.InTransaction {
 		defer atomic.AddInt32(&busyConnections, -1)
 	}
-	_, _, err := vh.vtg.Execute(ctx, session, "rollback", make(map[string]*querypb.BindVariable))
-	if err != nil {
+	// The reserved connections don't survive the reset of the
+	// session state.
+	if err := vh.vtg.CloseSession(ctx, session); err != nil {
 		log.Errorf("Error happened in transaction rollback: %v", err)
 	}
 }
 
 func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
-	// Rollback if there is an ongoing transaction. Ignore error.
+	// Rollback if there is an ongoing transaction, and release the
+	// reserved connections. Ignore error.
 	var ctx context.Context
 	var cancel context.CancelFunc
-	if *mysqlQueryTimeout != 0 {
-		ctx, cancel = context.WithTimeout(context.Background(), *mysqlQueryTimeout)
+	if queryTimeout := mysqlQueryTimeoutValue.Get(); queryTimeout != 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), queryTimeout)
 		defer cancel()
 	} else {
 		ctx = context.Background()
@@ -109,7 +116,7 @@ func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 	if session.InTransaction {
 		defer atomic.AddInt32(&busyConnections, -1)
 	}
-	_, _, _ = vh.vtg.Execute(ctx, session, "rollback", make(map[string]*querypb.BindVariable))
+	_ = vh.vtg.CloseSession(ctx, session)
 }
 
 // Regexp to extract parent span id over the sql query
@@ -148,8 +155,8 @@ func (vh *vtgateHandler) ComInitDB(c *mysql.Conn, schemaName string) {
 func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
 	ctx := context.Background()
 	var cancel context.CancelFunc
-	if *mysqlQueryTimeout != 0 {
-		ctx, cancel = context.WithTimeout(ctx, *mysqlQueryTimeout)
+	if queryTimeout := mysqlQueryTimeoutValue.Get(); queryTimeout != 0 {
+		ctx, cancel = context.WithTimeout(ctx, queryTimeout)
 		defer cancel()
 	}
 
@@ -192,15 +199,70 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 	if err != nil {
 		return err
 	}
-	return callback(result)
+	if !traceEnabled(session, query) {
+		return callback(result)
+	}
+	start := time.Now()
+	err = callback(result)
+	recordSerializationTrace(session, time.Since(start))
+	return err
+}
+
+// UseBatch is part of the mysql.BatchHandler interface. The statements
+// of an OLAP session are streamed one by one.
+func (vh *vtgateHandler) UseBatch(c *mysql.Conn) bool {
+	return vh.session(c).Options.Workload != querypb.ExecuteOptions_OLAP
+}
+
+// ComQueryBatch is part of the mysql.BatchHandler interface.
+func (vh *vtgateHandler) ComQueryBatch(c *mysql.Conn, queries []string) ([]*sqltypes.Result, error) {
+	ctx := context.Background()
+	var cancel context.CancelFunc
+	if queryTimeout := mysqlQueryTimeoutValue.Get(); queryTimeout != 0 {
+		ctx, cancel = context.WithTimeout(ctx, queryTimeout)
+		defer cancel()
+	}
+
+	span, ctx, err := startSpan(ctx, queries[0], "vtgateHandler.ComQueryBatch")
+	if err != nil {
+		return nil, vterrors.Wrap(err, "failed to extract span")
+	}
+	defer span.Finish()
+
+	ctx = callinfo.MysqlCallInfo(ctx, c)
+
+	// Fill in the ImmediateCallerID with the UserData returned by
+	// the AuthServer plugin for that user. If nothing was
+	// returned, use the User. This lets the plugin map a MySQL
+	// user used for authentication to a Vitess User used for
+	// Table ACLs and Vitess authentication in general.
+	im := c.UserData.Get()
+	ef := callerid.NewEffectiveCallerID(
+		c.User,                  /* principal: who */
+		c.RemoteAddr().String(), /* component: running client process */
+		"VTGate MySQL Connector" /* subcomponent: part of the client */)
+	ctx = callerid.NewContext(ctx, ef, im)
+
+	session := vh.session(c)
+	if !session.InTransaction {
+		atomic.AddInt32(&busyConnections, 1)
+	}
+	defer func() {
+		if !session.InTransaction {
+			atomic.AddInt32(&busyConnections, -1)
+		}
+	}()
+
+	session, results, err := vh.vtg.ExecuteMulti(ctx, session, queries)
+	return results, mysql.NewSQLErrorFromError(err)
 }
 
 // ComPrepare is the handler for command prepare.
 func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string) ([]*querypb.Field, error) {
 	var ctx context.Context
 	var cancel context.CancelFunc
-	if *mysqlQueryTimeout != 0 {
-		ctx, cancel = context.WithTimeout(context.Background(), *mysqlQueryTimeout)
+	if queryTimeout := mysqlQueryTimeoutValue.Get(); queryTimeout != 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), queryTimeout)
 		defer cancel()
 	} else {
 		ctx = context.Background()
@@ -230,6 +292,12 @@ func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string) ([]*querypb.Fie
 		}
 	}()
 
+	span, ctx, err := startSpan(ctx, query, "vtgateHandler.ComPrepare")
+	if err != nil {
+		return nil, vterrors.Wrap(err, "failed to extract span")
+	}
+	defer span.Finish()
+
 	session, fld, err := vh.vtg.Prepare(ctx, session, query, make(map[string]*querypb.BindVariable))
 	err = mysql.NewSQLErrorFromError(err)
 	if err != nil {
@@ -241,8 +309,8 @@ func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string) ([]*querypb.Fie
 func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
 	var ctx context.Context
 	var cancel context.CancelFunc
-	if *mysqlQueryTimeout != 0 {
-		ctx, cancel = context.WithTimeout(context.Background(), *mysqlQueryTimeout)
+	if queryTimeout := mysqlQueryTimeoutValue.Get(); queryTimeout != 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), queryTimeout)
 		defer cancel()
 	} else {
 		ctx = context.Background()
@@ -272,7 +340,17 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 		}
 	}()
 
-	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
+	span, ctx, err := startSpan(ctx, prepare.PrepareStmt, "vtgateHandler.ComStmtExecute")
+	if err != nil {
+		return vterrors.Wrap(err, "failed to extract span")
+	}
+	defer span.Finish()
+
+	// The selects executed with a cursor are streamed, unless they are
+	// part of a transaction, since the client fetches their rows in
+	// batches.
+	if session.Options.Workload == querypb.ExecuteOptions_OLAP ||
+		(prepare.Cursor && !session.InTransaction && sqlparser.Preview(prepare.PrepareStmt) == sqlparser.StmtSelect) {
 		err := vh.vtg.StreamExecute(ctx, session, prepare.PrepareStmt, prepare.BindVars, callback)
 		return mysql.NewSQLErrorFromError(err)
 	}
@@ -322,11 +400,23 @@ func initMySQLProtocol() {
 		return
 	}
 
+	// The connections that run a query or are in a transaction are
+	// the in-flight work drained in lameduck mode.
+	servenv.RegisterDrain("MySQL", func() int64 {
+		return int64(atomic.LoadInt32(&busyConnections))
+	})
+	mysqlQueryTimeoutValue.Set(*mysqlQueryTimeout)
+	servenv.RuntimeFlags.Register("mysql_server_query_timeout", servenv.DurationRuntimeFlag(func(val time.Duration) error {
+		mysqlQueryTimeoutValue.Set(val)
+		return nil
+	}))
+
 	// Initialize registered AuthServer implementations (or other plugins)
 	for _, initFn := range pluginInitializers {
 		initFn()
 	}
 	authServer := mysql.GetAuthServer(*mysqlAuthServerImpl)
+	authServer = mysql.InitAuthServerGroupMapping(authServer)
 
 	switch *mysqlTCPVersion {
 	case "tcp", "tcp4", "tcp6":
@@ -410,29 +500,21 @@ func newMysqlUnixSocket(address string, authServer mysql.AuthServer, handler mys
 	}
 }
 
+// shutdownMysqlProtocolAndDrain stops accepting new connections, makes
+// the pings of the open connections fail so that the clients reconnect
+// to another vtgate, and waits for the queries and the transactions in
+// flight to finish, for at most the drain grace period.
 func shutdownMysqlProtocolAndDrain() {
 	if mysqlListener != nil {
-		mysqlListener.Close()
+		mysqlListener.Shutdown()
 		mysqlListener = nil
 	}
 	if mysqlUnixListener != nil {
-		mysqlUnixListener.Close()
+		mysqlUnixListener.Shutdown()
 		mysqlUnixListener = nil
 	}
 
-	if atomic.LoadInt32(&busyConnections) > 0 {
-		log.Infof("Waiting for all client connections to be idle (%d active)...", atomic.LoadInt32(&busyConnections))
-		start := time.Now()
-		reported := start
-		for atomic.LoadInt32(&busyConnections) != 0 {
-			if time.Since(reported) > 2*time.Second {
-				log.Infof("Still waiting for client connections to be idle (%d active)...", atomic.LoadInt32(&busyConnections))
-				reported = time.Now()
-			}
-
-			time.Sleep(1 * time.Millisecond)
-		}
-	}
+	servenv.WaitForDrain("MySQL")
 }
 
 func init() {
@@ -0,0 +1,123 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Some comment directives of a query are carried to the tablets in the
+// execute options, for the query only:
+//
+//	select /*vt+ WORKLOAD=olap */ * from t1
+//	update /*vt+ SKIP_QUERY_PLAN_CACHE=1 */ t1 set a = 1 where id = 1
+//	select /*vt+ QUERY_TIMEOUT_MS=500 PRIORITY=low */ * from t1
+//	select /*vt+ WORKLOAD=olap SPILL_TO_DISK=1 */ * from t1 order by a
+//	select /*vt+ MAX_STALENESS_MS=5000 MAX_STALENESS_FALLBACK_TO_MASTER=1 */ * from t1
+//
+// QUERY_TIMEOUT_MS is also part of the vtgate plan. MAX_STALENESS_MS and
+// MAX_STALENESS_FALLBACK_TO_MASTER are only used by vtgate, to pick the
+// tablets. The other directives,
+// like SCATTER_ERRORS_AS_WARNINGS or FORCE_VINDEX, are only part of it.
+
+// hintOptions returns the execute options updated by the comment
+// directives of the query, or nil if the query doesn't have any.
+func hintOptions(options *querypb.ExecuteOptions, sql string) (*querypb.ExecuteOptions, error) {
+	// Avoid parsing the query if it cannot have directives.
+	if !strings.Contains(sql, "/*vt+") {
+		return nil, nil
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		// The planner returns the error.
+		return nil, nil
+	}
+	directives := sqlparser.StatementDirectives(stmt)
+	if directives == nil {
+		return nil, nil
+	}
+
+	var hinted *querypb.ExecuteOptions
+	update := func() *querypb.ExecuteOptions {
+		if hinted == nil {
+			hinted = &querypb.ExecuteOptions{}
+			if options != nil {
+				hinted = proto.Clone(options).(*querypb.ExecuteOptions)
+			}
+		}
+		return hinted
+	}
+	if directives.IsSet(sqlparser.DirectiveSkipQueryPlanCache) && !options.GetSkipQueryPlanCache() {
+		update().SkipQueryPlanCache = true
+	}
+	if directives.IsSet(sqlparser.DirectiveSpillToDisk) && !options.GetSpillToDisk() {
+		update().SpillToDisk = true
+	}
+	if val, ok := directives.GetString(sqlparser.DirectiveWorkload); ok {
+		workload, ok := querypb.ExecuteOptions_Workload_value[strings.ToUpper(val)]
+		if !ok || workload == int32(querypb.ExecuteOptions_UNSPECIFIED) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid %s directive: %s", sqlparser.DirectiveWorkload, val)
+		}
+		update().Workload = querypb.ExecuteOptions_Workload(workload)
+	}
+	// Like the vtgate plan, the timeouts that aren't numbers are ignored.
+	if timeout, ok := directives[sqlparser.DirectiveQueryTimeout].(int); ok && timeout > 0 {
+		update().TimeoutMs = int64(timeout)
+	}
+	// Like the timeouts, the maximum stalenesses that aren't numbers are
+	// ignored.
+	if staleness, ok := directives[sqlparser.DirectiveMaxStaleness].(int); ok && staleness > 0 {
+		update().MaxStalenessMs = int64(staleness)
+	}
+	if directives.IsSet(sqlparser.DirectiveMaxStalenessFallbackToMaster) && !options.GetMaxStalenessFallbackToMaster() {
+		update().MaxStalenessFallbackToMaster = true
+	}
+	if val, ok := directives.GetString(sqlparser.DirectivePriority); ok {
+		priority, ok := querypb.ExecuteOptions_Priority_value[strings.ToUpper(val)]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid %s directive: %s", sqlparser.DirectivePriority, val)
+		}
+		update().Priority = querypb.ExecuteOptions_Priority(priority)
+	}
+	return hinted, nil
+}
+
+// startHints sets the execute options of the session updated by the
+// comment directives of the query for the duration of the query, and
+// returns the function that restores them.
+func (session *SafeSession) startHints(sql string) (func(), error) {
+	options := session.Options
+	hinted, err := hintOptions(options, sql)
+	if err != nil {
+		return nil, err
+	}
+	if hinted == nil {
+		return func() {}, nil
+	}
+	session.Options = hinted
+	return func() {
+		session.Options = options
+	}, nil
+}
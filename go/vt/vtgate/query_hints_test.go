@@ -0,0 +1,205 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/discovery"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestHintOptions(t *testing.T) {
+	session := &querypb.ExecuteOptions{IncludedFields: querypb.ExecuteOptions_TYPE_ONLY}
+	testcases := []struct {
+		sql  string
+		want *querypb.ExecuteOptions
+		err  string
+	}{{
+		sql: "select * from user",
+	}, {
+		sql: "select /* comment */ * from user",
+	}, {
+		sql: "select /*vt+ QUERY_TIMEOUT_MS=10 */ * from user",
+		want: &querypb.ExecuteOptions{
+			IncludedFields: querypb.ExecuteOptions_TYPE_ONLY,
+			TimeoutMs:      10,
+		},
+	}, {
+		sql: "select /*vt+ QUERY_TIMEOUT_MS=soon */ * from user",
+	}, {
+		sql: "delete /*vt+ PRIORITY=low QUERY_TIMEOUT_MS=500 */ from user",
+		want: &querypb.ExecuteOptions{
+			IncludedFields: querypb.ExecuteOptions_TYPE_ONLY,
+			TimeoutMs:      500,
+			Priority:       querypb.ExecuteOptions_LOW,
+		},
+	}, {
+		sql: "select /*vt+ PRIORITY=HIGH */ * from user",
+		want: &querypb.ExecuteOptions{
+			IncludedFields: querypb.ExecuteOptions_TYPE_ONLY,
+			Priority:       querypb.ExecuteOptions_HIGH,
+		},
+	}, {
+		sql: "select /*vt+ PRIORITY=urgent */ * from user",
+		err: "invalid PRIORITY directive: urgent",
+	}, {
+		sql: "select /*vt+ WORKLOAD=olap */ * from user",
+		want: &querypb.ExecuteOptions{
+			IncludedFields: querypb.ExecuteOptions_TYPE_ONLY,
+			Workload:       querypb.ExecuteOptions_OLAP,
+		},
+	}, {
+		sql: "update /*vt+ WORKLOAD=DBA SKIP_QUERY_PLAN_CACHE=1 */ user set a = 1",
+		want: &querypb.ExecuteOptions{
+			IncludedFields:     querypb.ExecuteOptions_TYPE_ONLY,
+			Workload:           querypb.ExecuteOptions_DBA,
+			SkipQueryPlanCache: true,
+		},
+	}, {
+		sql: "select /*vt+ WORKLOAD=OLAP SPILL_TO_DISK=1 */ * from user order by a",
+		want: &querypb.ExecuteOptions{
+			IncludedFields: querypb.ExecuteOptions_TYPE_ONLY,
+			Workload:       querypb.ExecuteOptions_OLAP,
+			SpillToDisk:    true,
+		},
+	}, {
+		sql: "select /*vt+ MAX_STALENESS_MS=5000 */ * from user",
+		want: &querypb.ExecuteOptions{
+			IncludedFields: querypb.ExecuteOptions_TYPE_ONLY,
+			MaxStalenessMs: 5000,
+		},
+	}, {
+		sql: "select /*vt+ MAX_STALENESS_MS=5000 MAX_STALENESS_FALLBACK_TO_MASTER=1 */ * from user",
+		want: &querypb.ExecuteOptions{
+			IncludedFields:               querypb.ExecuteOptions_TYPE_ONLY,
+			MaxStalenessMs:               5000,
+			MaxStalenessFallbackToMaster: true,
+		},
+	}, {
+		sql: "select /*vt+ MAX_STALENESS_MS=recent */ * from user",
+	}, {
+		sql: "select /*vt+ WORKLOAD=unspecified */ * from user",
+		err: "invalid WORKLOAD directive: unspecified",
+	}, {
+		sql: "select /*vt+ WORKLOAD=fast */ * from user",
+		err: "invalid WORKLOAD directive: fast",
+	}}
+	for _, tc := range testcases {
+		got, err := hintOptions(session, tc.sql)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("hintOptions(%s): %v, want %s", tc.sql, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("hintOptions(%s): %v", tc.sql, err)
+			continue
+		}
+		if !proto.Equal(got, tc.want) {
+			t.Errorf("hintOptions(%s): %v, want %v", tc.sql, got, tc.want)
+		}
+	}
+	if session.Workload != querypb.ExecuteOptions_UNSPECIFIED || session.TimeoutMs != 0 || session.Priority != querypb.ExecuteOptions_NORMAL {
+		t.Errorf("the options of the session were modified: %v", session)
+	}
+}
+
+func TestExecutorHints(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+
+	if _, err := executor.Execute(context.Background(), "TestExecutorHints", session, "select /*vt+ WORKLOAD=olap */ id from user where id = 1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := sbc1.Options[0].GetWorkload(); got != querypb.ExecuteOptions_OLAP {
+		t.Errorf("tablet workload: %v, want OLAP", got)
+	}
+	// The directive only applies to the query.
+	if got := session.Options.GetWorkload(); got != querypb.ExecuteOptions_UNSPECIFIED {
+		t.Errorf("session workload: %v, want UNSPECIFIED", got)
+	}
+
+	if _, err := executor.Execute(context.Background(), "TestExecutorHints", session, "select id from user where id = 1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := sbc1.Options[1].GetWorkload(); got != querypb.ExecuteOptions_UNSPECIFIED {
+		t.Errorf("tablet workload: %v, want UNSPECIFIED", got)
+	}
+
+	_, err := executor.Execute(context.Background(), "TestExecutorHints", session, "select /*vt+ WORKLOAD=fast */ id from user where id = 1", nil)
+	if err == nil || !strings.Contains(err.Error(), "invalid WORKLOAD directive") {
+		t.Errorf("Execute with an invalid workload: %v, want invalid WORKLOAD directive", err)
+	}
+	if len(sbc1.Options) != 2 {
+		t.Errorf("tablet calls: %v, want 2", len(sbc1.Options))
+	}
+
+	// The vindex of FORCE_VINDEX must be a vindex of the tables.
+	if _, err := executor.Execute(context.Background(), "TestExecutorHints", session, "select /*vt+ FORCE_VINDEX=unknown_map */ id from user where id = 1", nil); err == nil || !strings.Contains(err.Error(), "vindex unknown_map") {
+		t.Errorf("Execute with an unknown vindex: %v, want vindex unknown_map not found", err)
+	}
+}
+
+func TestExecutorMaxStaleness(t *testing.T) {
+	cell := "aa"
+	hc := discovery.NewFakeHealthCheck()
+	createSandbox(KsTestUnsharded)
+	serv := newSandboxForCells([]string{cell})
+	resolver := newTestResolver(hc, serv, cell)
+	master := hc.AddTestTablet(cell, "0", 1, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+	replica := hc.AddTestTablet(cell, "0", 2, KsTestUnsharded, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	hc.SetReplicationLag(replica.Tablet(), 10)
+	executor := NewExecutor(context.Background(), serv, cell, "", resolver, false, testBufferSize, testCacheSize)
+	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded + "@replica", Autocommit: true})
+
+	if _, err := executor.Execute(context.Background(), "TestExecutorMaxStaleness", session, "select /*vt+ MAX_STALENESS_MS=20000 */ id from t1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := replica.ExecCount.Get(); got != 1 {
+		t.Errorf("replica queries: %v, want 1", got)
+	}
+
+	_, err := executor.Execute(context.Background(), "TestExecutorMaxStaleness", session, "select /*vt+ MAX_STALENESS_MS=5000 */ id from t1", nil)
+	if want := "no replica tablet with a replication lag of at most 5s"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Execute: %v, want %v", err, want)
+	}
+
+	// The session options apply to all the queries, and the hints
+	// override them.
+	session.Options = &querypb.ExecuteOptions{MaxStalenessMs: 5000, MaxStalenessFallbackToMaster: true}
+	if _, err := executor.Execute(context.Background(), "TestExecutorMaxStaleness", session, "select id from t1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := master.ExecCount.Get(); got != 1 {
+		t.Errorf("master queries: %v, want 1", got)
+	}
+	if _, err := executor.Execute(context.Background(), "TestExecutorMaxStaleness", session, "select /*vt+ MAX_STALENESS_MS=20000 */ id from t1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := replica.ExecCount.Get(); got != 2 {
+		t.Errorf("replica queries: %v, want 2", got)
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/querysample"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querysamplepb "vitess.io/vitess/go/vt/proto/querysample"
+)
+
+var (
+	querySampleSink          = flag.String("query_sample_sink", "", "the sink of the sampled queries, e.g. file or grpc. If empty, the queries are not sampled.")
+	querySampleRate          = flag.Float64("query_sample_rate", 0.01, "fraction of the queries recorded to -query_sample_sink, between 0 and 1. It can be changed at runtime.")
+	querySampleBufferSize    = flag.Int("query_sample_buffer_size", 10000, "maximum number of sampled queries waiting to be recorded, the others are dropped")
+	querySampleBatchSize     = flag.Int("query_sample_batch_size", 100, "maximum number of sampled queries recorded together")
+	querySampleFlushInterval = flag.Duration("query_sample_flush_interval", 1*time.Second, "maximum time a sampled query waits for its batch to be recorded")
+	querySampleTimeout       = flag.Duration("query_sample_timeout", 10*time.Second, "timeout of the recording of a batch of sampled queries")
+
+	querySamples = stats.NewCountersWithSingleLabel("QuerySamples", "Sampled queries by result", "Result", "Sampled", "Dropped", "Recorded", "Failed")
+
+	// querySamplerInstance is set by Init if the queries are sampled.
+	querySamplerInstance *querySampler
+)
+
+// querySampler records a random sample of the queries, with their
+// normalized SQL, their shard fan-out, their latency and their number
+// of rows, to a querysample.Sink. It captures the workload of vtgate
+// for capacity planning and migration sizing. The queries are sampled
+// when their LogStats are sent, and are normalized and recorded in
+// batches in the background: if the sink can't keep up, the samples
+// are dropped.
+type querySampler struct {
+	sink          querysample.Sink
+	batchSize     int
+	flushInterval time.Duration
+	timeout       time.Duration
+
+	// rate holds the bits of the float64 sampling rate.
+	rate uint64
+
+	samples chan *LogStats
+	stop    chan struct{}
+	done    chan struct{}
+
+	logErrors *logutil.ThrottledLogger
+}
+
+// initQuerySampler starts the query sampler configured by the flags.
+func initQuerySampler() error {
+	if *querySampleSink == "" {
+		return nil
+	}
+	if err := validSampleRate(*querySampleRate); err != nil {
+		return err
+	}
+	sink, err := querysample.NewSink(*querySampleSink)
+	if err != nil {
+		return err
+	}
+	qs := newQuerySampler(sink, *querySampleRate, *querySampleBufferSize, *querySampleBatchSize, *querySampleFlushInterval, *querySampleTimeout)
+	servenv.RuntimeFlags.Register("query_sample_rate", servenv.FloatRuntimeFlag(func(rate float64) error {
+		if err := validSampleRate(rate); err != nil {
+			return err
+		}
+		qs.setRate(rate)
+		return nil
+	}))
+	servenv.OnClose(qs.close)
+	querySamplerInstance = qs
+	log.Infof("Sampling %v of the queries to the %v query sample sink", *querySampleRate, *querySampleSink)
+	return nil
+}
+
+func validSampleRate(rate float64) error {
+	if rate < 0 || rate > 1 {
+		return fmt.Errorf("query_sample_rate must be between 0 and 1, got %v", rate)
+	}
+	return nil
+}
+
+func newQuerySampler(sink querysample.Sink, rate float64, bufferSize, batchSize int, flushInterval, timeout time.Duration) *querySampler {
+	qs := &querySampler{
+		sink:          sink,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		timeout:       timeout,
+		samples:       make(chan *LogStats, bufferSize),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+		logErrors:     logutil.NewThrottledLogger("QuerySampler", 5*time.Second),
+	}
+	qs.setRate(rate)
+	go qs.run()
+	return qs
+}
+
+func (qs *querySampler) setRate(rate float64) {
+	atomic.StoreUint64(&qs.rate, math.Float64bits(rate))
+}
+
+func (qs *querySampler) getRate() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&qs.rate))
+}
+
+// sample records the query of stats if it is part of the sample.
+// stats must not be changed after it is sampled.
+func (qs *querySampler) sample(stats *LogStats) {
+	if rate := qs.getRate(); rate <= 0 || rand.Float64() >= rate {
+		return
+	}
+	select {
+	case qs.samples <- stats:
+		querySamples.Add("Sampled", 1)
+	default:
+		querySamples.Add("Dropped", 1)
+	}
+}
+
+// run records the samples in batches, until close is called.
+func (qs *querySampler) run() {
+	defer close(qs.done)
+	ticker := time.NewTicker(qs.flushInterval)
+	defer ticker.Stop()
+
+	var batch []*querysamplepb.QuerySample
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), qs.timeout)
+		defer cancel()
+		if err := qs.sink.Record(ctx, batch); err != nil {
+			querySamples.Add("Failed", int64(len(batch)))
+			qs.logErrors.Errorf("Cannot record %v sampled queries: %v", len(batch), err)
+		} else {
+			querySamples.Add("Recorded", int64(len(batch)))
+		}
+		batch = nil
+	}
+	add := func(stats *LogStats) {
+		batch = append(batch, newQuerySample(stats))
+		if len(batch) >= qs.batchSize {
+			flush()
+		}
+	}
+
+	for {
+		select {
+		case stats := <-qs.samples:
+			add(stats)
+		case <-ticker.C:
+			flush()
+		case <-qs.stop:
+			// Record the samples that are already buffered.
+			for {
+				select {
+				case stats := <-qs.samples:
+					add(stats)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// close records the pending samples, and closes the sink.
+func (qs *querySampler) close() {
+	close(qs.stop)
+	<-qs.done
+	if err := qs.sink.Close(); err != nil {
+		log.Errorf("Cannot close the query sample sink: %v", err)
+	}
+}
+
+// newQuerySample returns the sample of the query of stats. The values
+// of the query are replaced by bind variables, so the samples of the
+// queries that only differ by their values have the same SQL.
+func newQuerySample(stats *LogStats) *querysamplepb.QuerySample {
+	normalized, err := sqlparser.RedactSQLQuery(stats.SQL)
+	if err != nil {
+		normalized = ""
+	}
+	return &querysamplepb.QuerySample{
+		Time:          stats.EndTime.UnixNano(),
+		Method:        stats.Method,
+		StatementType: stats.StmtType,
+		NormalizedSql: normalized,
+		Caller:        stats.EffectiveCaller(),
+		ShardQueries:  stats.ShardQueries,
+		TotalTime:     stats.TotalTime().Nanoseconds(),
+		PlanTime:      stats.PlanTime.Nanoseconds(),
+		ExecuteTime:   stats.ExecuteTime.Nanoseconds(),
+		CommitTime:    stats.CommitTime.Nanoseconds(),
+		Rows:          stats.RowsAffected,
+		Error:         stats.ErrorStr(),
+	}
+}
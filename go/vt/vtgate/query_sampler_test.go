@@ -0,0 +1,136 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	querysamplepb "vitess.io/vitess/go/vt/proto/querysample"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// fakeSampleSink keeps the batches it records.
+type fakeSampleSink struct {
+	mu      sync.Mutex
+	batches [][]*querysamplepb.QuerySample
+	closed  bool
+}
+
+func (fs *fakeSampleSink) Record(ctx context.Context, samples []*querysamplepb.QuerySample) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.batches = append(fs.batches, samples)
+	return nil
+}
+
+func (fs *fakeSampleSink) Close() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.closed = true
+	return nil
+}
+
+func TestQuerySampler(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	sink := &fakeSampleSink{}
+	qs := newQuerySampler(sink, 1, 10, 2, time.Hour, time.Second)
+	querySamplerInstance = qs
+	defer func() { querySamplerInstance = nil }()
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	for _, sql := range []string{
+		"select id from user where id = 1",
+		"select id from user",
+		"select id from user where id = 2",
+	} {
+		if _, err := executor.Execute(context.Background(), "TestQuerySampler", session, sql, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The queries are not sampled anymore.
+	qs.setRate(0)
+	if _, err := executor.Execute(context.Background(), "TestQuerySampler", session, "select id from user where id = 3", nil); err != nil {
+		t.Fatal(err)
+	}
+	qs.close()
+
+	if got := sbc1.ExecCount.Get() + sbc2.ExecCount.Get(); got != 5 {
+		t.Errorf("ExecCount: %v, want 5", got)
+	}
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	if !sink.closed {
+		t.Errorf("the sink is not closed")
+	}
+	// The batches have at most 2 samples, the last one is flushed by close.
+	if len(sink.batches) != 2 || len(sink.batches[0]) != 2 || len(sink.batches[1]) != 1 {
+		t.Fatalf("batches: %v, want 2 batches of 2 and 1 samples", sink.batches)
+	}
+	samples := append(sink.batches[0], sink.batches[1]...)
+	wantSQL := []string{
+		"select id from user where id = :redacted1",
+		"select id from user",
+		"select id from user where id = :redacted1",
+	}
+	wantShardQueries := []uint32{1, 8, 1}
+	for i, sample := range samples {
+		if sample.NormalizedSql != wantSQL[i] {
+			t.Errorf("sample %v: NormalizedSql: %v, want %v", i, sample.NormalizedSql, wantSQL[i])
+		}
+		if sample.ShardQueries != wantShardQueries[i] {
+			t.Errorf("sample %v: ShardQueries: %v, want %v", i, sample.ShardQueries, wantShardQueries[i])
+		}
+		if sample.Method != "TestQuerySampler" || sample.StatementType != "SELECT" {
+			t.Errorf("sample %v: Method: %v, StatementType: %v, want TestQuerySampler and SELECT", i, sample.Method, sample.StatementType)
+		}
+		if sample.Time == 0 || sample.TotalTime <= 0 {
+			t.Errorf("sample %v: Time: %v, TotalTime: %v, want them set", i, sample.Time, sample.TotalTime)
+		}
+	}
+}
+
+func TestQuerySamplerDrops(t *testing.T) {
+	// The samples are not consumed, so the buffer fills up.
+	qs := &querySampler{
+		samples: make(chan *LogStats, 1),
+	}
+	qs.setRate(1)
+	initialSampled := querySamples.Counts()["Sampled"]
+	initialDropped := querySamples.Counts()["Dropped"]
+	for i := 0; i < 3; i++ {
+		qs.sample(NewLogStats(context.Background(), "Execute", "select 1 from dual", nil))
+	}
+	if got := querySamples.Counts()["Sampled"] - initialSampled; got != 1 {
+		t.Errorf("Sampled: %v, want 1", got)
+	}
+	if got := querySamples.Counts()["Dropped"] - initialDropped; got != 2 {
+		t.Errorf("Dropped: %v, want 2", got)
+	}
+}
+
+func TestNewQuerySampleUnparsable(t *testing.T) {
+	stats := NewLogStats(context.Background(), "Execute", "not a query", nil)
+	stats.EndTime = stats.StartTime
+	sample := newQuerySample(stats)
+	if sample.NormalizedSql != "" {
+		t.Errorf("NormalizedSql: %v, want empty", sample.NormalizedSql)
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// A query is traced if the trace flag is set in the options of the
+// session, with 'set vitess_trace = 1', or if it has the TRACE comment
+// directive, like 'select /*vt+ TRACE=1 */ * from t1 where id = 1'.
+//
+// The timings of a traced query are returned as warnings, which a MySQL
+// client can read with SHOW WARNINGS right after the query:
+//
+//	vtgate: plan=120µs execute=2.3ms
+//	tablet zone1-0000000100 (ks/-80 master): plan_type=PASS_SELECT plan=40µs pool_wait=5µs mysql=1.9ms total=2.1ms
+//	vtgate: serialization=80µs
+//
+// The tablets return their timings in the trace of the result extras.
+// Streaming queries are not traced.
+
+// shardTrace is the trace of a query on a shard.
+type shardTrace struct {
+	target *querypb.Target
+	trace  *querypb.QueryTrace
+}
+
+// traceEnabled returns true if the query must be traced. Only the
+// statements that are sent to the tablets are traced, so that
+// SHOW WARNINGS doesn't overwrite the trace of the previous query.
+func traceEnabled(session *vtgatepb.Session, sql string) bool {
+	switch sqlparser.Preview(sql) {
+	case sqlparser.StmtSelect, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
+	default:
+		return false
+	}
+	if session.GetOptions().GetTrace() {
+		return true
+	}
+	// Avoid parsing the query if it cannot have the directive.
+	if !strings.Contains(sql, sqlparser.DirectiveTrace) {
+		return false
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return false
+	}
+	return sqlparser.TraceDirective(stmt)
+}
+
+// startTrace sets the trace flag in the options of the session for the
+// duration of a query, and returns the function that restores them.
+func (session *SafeSession) startTrace() func() {
+	options := session.Options
+	if !options.GetTrace() {
+		traceOptions := &querypb.ExecuteOptions{}
+		if options != nil {
+			traceOptions = proto.Clone(options).(*querypb.ExecuteOptions)
+		}
+		traceOptions.Trace = true
+		session.Options = traceOptions
+	}
+	session.tracing = true
+	return func() {
+		session.Options = options
+		session.tracing = false
+	}
+}
+
+// recordTrace stores the timings of a traced query as warnings in the
+// session.
+func recordTrace(safeSession *SafeSession, logStats *LogStats) {
+	safeSession.mu.Lock()
+	traces := safeSession.traces
+	safeSession.traces = nil
+	safeSession.mu.Unlock()
+
+	safeSession.RecordWarning(traceWarning("vtgate: plan=%v execute=%v", logStats.PlanTime, logStats.ExecuteTime))
+	sort.SliceStable(traces, func(i, j int) bool {
+		if traces[i].target.Keyspace != traces[j].target.Keyspace {
+			return traces[i].target.Keyspace < traces[j].target.Keyspace
+		}
+		return traces[i].target.Shard < traces[j].target.Shard
+	})
+	for _, st := range traces {
+		safeSession.RecordWarning(traceWarning(
+			"tablet %v (%v/%v %v): plan_type=%v plan=%v pool_wait=%v mysql=%v total=%v",
+			topoproto.TabletAliasString(st.trace.TabletAlias),
+			st.target.Keyspace,
+			st.target.Shard,
+			topoproto.TabletTypeLString(st.target.TabletType),
+			st.trace.PlanType,
+			time.Duration(st.trace.PlanNanos),
+			time.Duration(st.trace.PoolWaitNanos),
+			time.Duration(st.trace.MysqlNanos),
+			time.Duration(st.trace.TotalNanos),
+		))
+	}
+}
+
+// recordSerializationTrace stores the time spent sending the result
+// of a traced query to the client as a warning in the session.
+func recordSerializationTrace(session *vtgatepb.Session, serialization time.Duration) {
+	session.Warnings = append(session.Warnings, traceWarning("vtgate: serialization=%v", serialization))
+}
+
+func traceWarning(format string, args ...interface{}) *querypb.QueryWarning {
+	return &querypb.QueryWarning{
+		Code:    mysql.ERVitessQueryTrace,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestTraceEnabled(t *testing.T) {
+	traceSession := &vtgatepb.Session{Options: &querypb.ExecuteOptions{Trace: true}}
+	testcases := []struct {
+		session *vtgatepb.Session
+		sql     string
+		want    bool
+	}{{
+		session: &vtgatepb.Session{},
+		sql:     "select id from user",
+		want:    false,
+	}, {
+		session: &vtgatepb.Session{},
+		sql:     "select /*vt+ TRACE=1 */ id from user",
+		want:    true,
+	}, {
+		session: &vtgatepb.Session{},
+		sql:     "update /*vt+ TRACE=1 */ user set a = 1",
+		want:    true,
+	}, {
+		session: &vtgatepb.Session{},
+		sql:     "select /*vt+ TRACE=0 */ id from user",
+		want:    false,
+	}, {
+		session: traceSession,
+		sql:     "select id from user",
+		want:    true,
+	}, {
+		// SHOW WARNINGS must not overwrite the trace of the previous query.
+		session: traceSession,
+		sql:     "show warnings",
+		want:    false,
+	}, {
+		session: traceSession,
+		sql:     "set autocommit = 1",
+		want:    false,
+	}}
+	for _, tc := range testcases {
+		if got := traceEnabled(tc.session, tc.sql); got != tc.want {
+			t.Errorf("traceEnabled(%v, %q): %v, want %v", tc.session, tc.sql, got, tc.want)
+		}
+	}
+}
+
+func TestExecutorTrace(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	sbc1.SetResults([]*sqltypes.Result{{
+		Extras: &querypb.ResultExtras{
+			Trace: &querypb.QueryTrace{
+				TabletAlias:   &topodatapb.TabletAlias{Cell: "aa", Uid: 1},
+				PlanType:      "PASS_SELECT",
+				PlanNanos:     int64(time.Millisecond),
+				PoolWaitNanos: int64(2 * time.Millisecond),
+				MysqlNanos:    int64(3 * time.Millisecond),
+				TotalNanos:    int64(4 * time.Millisecond),
+			},
+		},
+	}})
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+	if _, err := executor.Execute(context.Background(), "TestExecutorTrace", session, "select /*vt+ TRACE=1 */ id from user where id = 1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if !sbc1.Options[0].GetTrace() {
+		t.Errorf("tablet options: %v, want trace", sbc1.Options[0])
+	}
+	// The directive only traces the query.
+	if session.Options.GetTrace() {
+		t.Errorf("session options: %v, want no trace", session.Options)
+	}
+	if got := len(session.Warnings); got != 2 {
+		t.Fatalf("warnings: %v, want 2 warnings", session.Warnings)
+	}
+	for _, warning := range session.Warnings {
+		if warning.Code != mysql.ERVitessQueryTrace {
+			t.Errorf("warning code: %v, want %v", warning.Code, mysql.ERVitessQueryTrace)
+		}
+	}
+	if got, want := session.Warnings[0].Message, "vtgate: plan="; !strings.HasPrefix(got, want) {
+		t.Errorf("vtgate trace: %q, want prefix %q", got, want)
+	}
+	wantTablet := "tablet aa-0000000001 (TestExecutor/-20 master): plan_type=PASS_SELECT plan=1ms pool_wait=2ms mysql=3ms total=4ms"
+	if got := session.Warnings[1].Message; got != wantTablet {
+		t.Errorf("tablet trace: %q, want %q", got, wantTablet)
+	}
+
+	// SHOW WARNINGS returns the trace.
+	qr, err := executor.Execute(context.Background(), "TestExecutorTrace", session, "show warnings", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qr.Rows) != 2 || qr.Rows[1][2].ToString() != wantTablet {
+		t.Errorf("show warnings: %v, want the trace", qr.Rows)
+	}
+
+	// The queries are not traced without the directive.
+	if _, err := executor.Execute(context.Background(), "TestExecutorTrace", session, "select id from user where id = 1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if sbc1.Options[1].GetTrace() {
+		t.Errorf("tablet options: %v, want no trace", sbc1.Options[1])
+	}
+	if len(session.Warnings) != 0 {
+		t.Errorf("warnings: %v, want none", session.Warnings)
+	}
+
+	// The session flag traces all the queries.
+	if _, err := executor.Execute(context.Background(), "TestExecutorTrace", session, "set vitess_trace = 1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := executor.Execute(context.Background(), "TestExecutorTrace", session, "select id from user where id = 1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if !sbc1.Options[2].GetTrace() {
+		t.Errorf("tablet options: %v, want trace", sbc1.Options[2])
+	}
+	if len(session.Warnings) != 1 {
+		t.Errorf("warnings: %v, want the vtgate trace", session.Warnings)
+	}
+}
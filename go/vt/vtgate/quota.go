@@ -0,0 +1,491 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	enableQuotas      = flag.Bool("enable_quotas", false, "If set, vtgate enforces the query quotas of the keyspaces stored in the topo (see the SetQuota vtctl command).")
+	quotaQueueTimeout = flag.Duration("quota_queue_timeout", time.Second, "How long a query can wait for its quotas before it's rejected.")
+	quotaMaxCallers   = flag.Int("quota_max_callers", 10000, "The maximum number of callers of a keyspace that get their own default caller quota. The other callers share one default caller quota.")
+
+	quotaAdmitted = stats.NewCountersWithMultiLabels("QuotaAdmitted", "Queries admitted by the quotas by keyspace and caller", []string{"Keyspace", "Caller"})
+	quotaRejected = stats.NewCountersWithMultiLabels("QuotaRejected", "Queries rejected by the quotas by keyspace, caller and exceeded limit", []string{"Keyspace", "Caller", "Limit"})
+	quotaInFlight = stats.NewGaugesWithSingleLabel("QuotaInFlight", "Queries admitted by the quotas that are running, by keyspace", "Keyspace")
+	quotaWaitTime = stats.NewTimings("QuotaWaitTime", "Time the admitted queries waited for their quotas, by keyspace", "Keyspace")
+)
+
+// quotaManager enforces the query quotas of the keyspaces. The quotas
+// are read from the SrvVSchema of the cell, which the VSchemaManager
+// watches. A query must get a slot from the quota of its caller, then
+// from the quota of the keyspace. While the concurrency of the keyspace
+// is exhausted, the slots that are released are handed over to the
+// waiting callers in turn, so a busy caller can't starve the others.
+type quotaManager struct {
+	queueTimeout time.Duration
+	maxCallers   int
+
+	mu sync.Mutex
+	// keyspaces has the quotas of the keyspaces of the SrvVSchema that
+	// have quotas.
+	keyspaces map[string]*keyspaceQuotas
+}
+
+// newQuotaManager returns the quotaManager if the quotas are enabled by
+// the flags, or nil.
+func newQuotaManager() *quotaManager {
+	if !*enableQuotas {
+		return nil
+	}
+	return &quotaManager{
+		queueTimeout: *quotaQueueTimeout,
+		maxCallers:   *quotaMaxCallers,
+		keyspaces:    make(map[string]*keyspaceQuotas),
+	}
+}
+
+// setQuotas updates the quotas from a new SrvVSchema. The keyspaces that
+// are not in quotas have no quotas anymore.
+func (qm *quotaManager) setQuotas(quotas map[string]*quotapb.Config) {
+	if qm == nil {
+		return
+	}
+	qm.mu.Lock()
+	defer qm.mu.Unlock()
+	for keyspace := range qm.keyspaces {
+		if quotas[keyspace] == nil {
+			delete(qm.keyspaces, keyspace)
+		}
+	}
+	for keyspace, config := range quotas {
+		if config == nil {
+			continue
+		}
+		kq, ok := qm.keyspaces[keyspace]
+		if !ok {
+			kq = &keyspaceQuotas{maxCallers: qm.maxCallers}
+			qm.keyspaces[keyspace] = kq
+		}
+		kq.setConfig(config)
+	}
+}
+
+// get returns the quotas of a keyspace, or nil if it has none.
+func (qm *quotaManager) get(keyspace string) *keyspaceQuotas {
+	qm.mu.Lock()
+	defer qm.mu.Unlock()
+	return qm.keyspaces[keyspace]
+}
+
+type quotaAdmittedKey struct{}
+
+// enter returns the context to run a query with, and true if the query
+// must be admitted by the quotas. The queries that a query runs
+// recursively, like the lookup vindex queries, are part of its
+// admission and are not admitted again.
+func (qm *quotaManager) enter(ctx context.Context) (context.Context, bool) {
+	if qm == nil || ctx.Value(quotaAdmittedKey{}) != nil {
+		return ctx, false
+	}
+	return context.WithValue(ctx, quotaAdmittedKey{}, true), true
+}
+
+// admit waits until the quotas of the caller and of the keyspace allow
+// the query to run. The returned function must be called when the query
+// is done.
+func (qm *quotaManager) admit(ctx context.Context, keyspace string) (func(), error) {
+	if qm == nil || keyspace == "" {
+		return func() {}, nil
+	}
+	kq := qm.get(keyspace)
+	if kq == nil {
+		return func() {}, nil
+	}
+	caller := quotaCaller(ctx)
+	callerLimiter, keyspaceLimiter := kq.limiters(caller)
+	if callerLimiter == nil && keyspaceLimiter == nil {
+		return func() {}, nil
+	}
+	rejected := func(limit string, keyspaceLimit bool, err error) error {
+		quotaRejected.Add([]string{keyspace, caller, limit}, 1)
+		if keyspaceLimit {
+			return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "%s quota of keyspace %v exceeded: %v", limit, keyspace, err)
+		}
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "%s quota of caller %v exceeded in keyspace %v: %v", limit, caller, keyspace, err)
+	}
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(ctx, qm.queueTimeout)
+	defer cancel()
+
+	// Both rates are checked before a token is taken from either, so a
+	// query rejected by one quota doesn't use up the rate of the other.
+	maxWait := qm.queueTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		maxWait = deadline.Sub(start)
+	}
+	wait, exceeded := reserveTokens(start, maxWait, callerLimiter.tokens(), keyspaceLimiter.tokens())
+	if exceeded >= 0 {
+		return nil, rejected("QPS", exceeded == 1, vterrors.New(vtrpcpb.Code_RESOURCE_EXHAUSTED, "rate limit reached"))
+	}
+	cancelTokens := func() {
+		callerLimiter.tokens().cancel()
+		keyspaceLimiter.tokens().cancel()
+	}
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			cancelTokens()
+			return nil, rejected("QPS", false, ctx.Err())
+		}
+	}
+	if err := callerLimiter.acquire(ctx, caller); err != nil {
+		cancelTokens()
+		return nil, rejected("Concurrency", false, err)
+	}
+	if err := keyspaceLimiter.acquire(ctx, caller); err != nil {
+		callerLimiter.release()
+		cancelTokens()
+		return nil, rejected("Concurrency", true, err)
+	}
+	quotaWaitTime.Record(keyspace, start)
+	quotaAdmitted.Add([]string{keyspace, caller}, 1)
+	quotaInFlight.Add(keyspace, 1)
+	return func() {
+		quotaInFlight.Add(keyspace, -1)
+		keyspaceLimiter.release()
+		callerLimiter.release()
+	}, nil
+}
+
+// quotaCaller returns the caller the quotas of a query apply to.
+func quotaCaller(ctx context.Context) string {
+	if principal := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)); principal != "" {
+		return principal
+	}
+	return callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+}
+
+// keyspaceQuotas are the limiters of the quotas of a keyspace.
+type keyspaceQuotas struct {
+	maxCallers int
+
+	mu       sync.Mutex
+	config   *quotapb.Config
+	keyspace *limiter
+	// callers has the limiters of the callers of the config, and of up
+	// to maxCallers other callers once they ran a query, if there is a
+	// default caller quota.
+	callers map[string]*limiter
+	// otherCallers is shared by the callers that don't fit in callers.
+	otherCallers *limiter
+}
+
+// setConfig replaces the limiters if the config changed. The queries
+// that run keep releasing the limiters they were admitted by.
+func (kq *keyspaceQuotas) setConfig(config *quotapb.Config) {
+	kq.mu.Lock()
+	defer kq.mu.Unlock()
+	if proto.Equal(config, kq.config) {
+		return
+	}
+	kq.config = config
+	kq.keyspace = newLimiter(config.GetKeyspace())
+	kq.callers = make(map[string]*limiter)
+	for _, cq := range config.GetCallers() {
+		kq.callers[cq.Caller] = newLimiter(cq.Quota)
+	}
+	kq.otherCallers = newLimiter(config.GetDefaultCaller())
+}
+
+// limiters returns the limiters of a caller and of the keyspace, which
+// are nil if there's no limit.
+func (kq *keyspaceQuotas) limiters(caller string) (*limiter, *limiter) {
+	kq.mu.Lock()
+	defer kq.mu.Unlock()
+	if kq.config == nil {
+		return nil, nil
+	}
+	callerLimiter, ok := kq.callers[caller]
+	if !ok && kq.config.DefaultCaller != nil {
+		if len(kq.callers) >= len(kq.config.Callers)+kq.maxCallers {
+			return kq.otherCallers, kq.keyspace
+		}
+		callerLimiter = newLimiter(kq.config.DefaultCaller)
+		kq.callers[caller] = callerLimiter
+	}
+	return callerLimiter, kq.keyspace
+}
+
+// limiter enforces one quota.
+type limiter struct {
+	// bucket is nil if there's no QPS limit.
+	bucket *tokenBucket
+	// sem is nil if there's no concurrency limit.
+	sem *fairSemaphore
+}
+
+// newLimiter returns the limiter of a quota, or nil if it has no limit.
+func newLimiter(quota *quotapb.Quota) *limiter {
+	l := &limiter{}
+	if quota.GetQps() > 0 {
+		l.bucket = newTokenBucket(quota.Qps)
+	}
+	if quota.GetMaxConcurrency() > 0 {
+		l.sem = newFairSemaphore(quota.MaxConcurrency)
+	}
+	if l.bucket == nil && l.sem == nil {
+		return nil
+	}
+	return l
+}
+
+// tokens returns the token bucket of the limiter, or nil if it has no
+// QPS limit.
+func (l *limiter) tokens() *tokenBucket {
+	if l == nil {
+		return nil
+	}
+	return l.bucket
+}
+
+// acquire waits until the concurrency limit allows the query of a
+// caller to run, or ctx is done.
+func (l *limiter) acquire(ctx context.Context, caller string) error {
+	if l == nil || l.sem == nil {
+		return nil
+	}
+	return l.sem.acquire(ctx, caller)
+}
+
+func (l *limiter) release() {
+	if l == nil || l.sem == nil {
+		return
+	}
+	l.sem.release()
+}
+
+// tokenBucket limits a rate. It holds up to one second of tokens.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(qps float64) *tokenBucket {
+	burst := qps
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   qps,
+		burst:  burst,
+		tokens: burst,
+	}
+}
+
+// refillLocked adds the tokens earned since the last refill.
+func (tb *tokenBucket) refillLocked(now time.Time) {
+	if !now.After(tb.last) {
+		return
+	}
+	if !tb.last.IsZero() {
+		tb.tokens += now.Sub(tb.last).Seconds() * tb.rate
+		if tb.tokens > tb.burst {
+			tb.tokens = tb.burst
+		}
+	}
+	tb.last = now
+}
+
+// waitLocked returns how long the caller of the next token must wait.
+func (tb *tokenBucket) waitLocked() time.Duration {
+	if tb.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - tb.tokens) / tb.rate * float64(time.Second))
+}
+
+// reserve takes a token, and returns how long the caller must wait
+// before using it. If the wait is longer than maxWait, the token is not
+// taken and it returns false.
+func (tb *tokenBucket) reserve(now time.Time, maxWait time.Duration) (time.Duration, bool) {
+	wait, exceeded := reserveTokens(now, maxWait, tb)
+	return wait, exceeded < 0
+}
+
+// cancel gives back a token that was taken by a query which didn't run.
+func (tb *tokenBucket) cancel() {
+	if tb == nil {
+		return
+	}
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	tb.tokens++
+	if tb.tokens > tb.burst {
+		tb.tokens = tb.burst
+	}
+}
+
+// reserveTokens takes a token from each of the buckets that is not nil,
+// and returns how long the caller must wait before using them. If the
+// wait of a bucket is longer than maxWait, no token is taken and it
+// returns the index of that bucket, or -1 otherwise. The buckets must
+// always be passed in the same order, since they're locked together.
+func reserveTokens(now time.Time, maxWait time.Duration, buckets ...*tokenBucket) (time.Duration, int) {
+	for _, tb := range buckets {
+		if tb != nil {
+			tb.mu.Lock()
+			defer tb.mu.Unlock()
+		}
+	}
+	var wait time.Duration
+	for i, tb := range buckets {
+		if tb == nil {
+			continue
+		}
+		tb.refillLocked(now)
+		w := tb.waitLocked()
+		if w > maxWait {
+			return w, i
+		}
+		if w > wait {
+			wait = w
+		}
+	}
+	for _, tb := range buckets {
+		if tb != nil {
+			tb.tokens--
+		}
+	}
+	return wait, -1
+}
+
+// fairSemaphore limits a concurrency. The waiters are queued by caller,
+// and the callers are served in turn.
+type fairSemaphore struct {
+	mu    sync.Mutex
+	max   int64
+	inUse int64
+	// waiters are the queues of the waiting callers.
+	waiters map[string][]chan struct{}
+	// turns is the order the waiting callers are served in.
+	turns []string
+}
+
+func newFairSemaphore(max int64) *fairSemaphore {
+	return &fairSemaphore{
+		max:     max,
+		waiters: make(map[string][]chan struct{}),
+	}
+}
+
+// acquire waits for a slot until ctx is done.
+func (fs *fairSemaphore) acquire(ctx context.Context, caller string) error {
+	fs.mu.Lock()
+	if fs.inUse < fs.max && len(fs.turns) == 0 {
+		fs.inUse++
+		fs.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	if len(fs.waiters[caller]) == 0 {
+		fs.turns = append(fs.turns, caller)
+	}
+	fs.waiters[caller] = append(fs.waiters[caller], ready)
+	fs.mu.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+	}
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	select {
+	case <-ready:
+		// The slot was handed over meanwhile: pass it on.
+		fs.releaseLocked()
+	default:
+		fs.removeLocked(caller, ready)
+	}
+	return ctx.Err()
+}
+
+// release frees a slot, or hands it over to the next waiting caller.
+func (fs *fairSemaphore) release() {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.releaseLocked()
+}
+
+func (fs *fairSemaphore) releaseLocked() {
+	if len(fs.turns) == 0 {
+		fs.inUse--
+		return
+	}
+	caller := fs.turns[0]
+	fs.turns = fs.turns[1:]
+	queue := fs.waiters[caller]
+	close(queue[0])
+	if len(queue) == 1 {
+		delete(fs.waiters, caller)
+	} else {
+		fs.waiters[caller] = queue[1:]
+		fs.turns = append(fs.turns, caller)
+	}
+}
+
+func (fs *fairSemaphore) removeLocked(caller string, ready chan struct{}) {
+	queue := fs.waiters[caller]
+	for i, c := range queue {
+		if c == ready {
+			queue = append(queue[:i:i], queue[i+1:]...)
+			break
+		}
+	}
+	if len(queue) > 0 {
+		fs.waiters[caller] = queue
+		return
+	}
+	delete(fs.waiters, caller)
+	for i, c := range fs.turns {
+		if c == caller {
+			fs.turns = append(fs.turns[:i:i], fs.turns[i+1:]...)
+			break
+		}
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	quotapb "vitess.io/vitess/go/vt/proto/quota"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestTokenBucket(t *testing.T) {
+	tb := newTokenBucket(2)
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		if wait, ok := tb.reserve(now, 0); !ok || wait != 0 {
+			t.Fatalf("reserve(%d): %v, %v, want 0, true", i, wait, ok)
+		}
+	}
+	// The bucket is empty: the next token is available in 500ms.
+	if wait, ok := tb.reserve(now, 100*time.Millisecond); ok {
+		t.Errorf("reserve(empty): %v, %v, want false", wait, ok)
+	}
+	if wait, ok := tb.reserve(now, time.Second); !ok || wait != 500*time.Millisecond {
+		t.Errorf("reserve(empty): %v, %v, want 500ms, true", wait, ok)
+	}
+	// The bucket holds at most one second of tokens.
+	now = now.Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		if wait, ok := tb.reserve(now, 0); !ok || wait != 0 {
+			t.Fatalf("reserve(%d) after an hour: %v, %v, want 0, true", i, wait, ok)
+		}
+	}
+	if _, ok := tb.reserve(now, 0); ok {
+		t.Errorf("reserve(empty) after an hour: true, want false")
+	}
+}
+
+// queueWaiter starts to acquire the semaphore for a caller in the
+// background, and returns once it's queued.
+func queueWaiter(t *testing.T, fs *fairSemaphore, caller string, served chan<- string) {
+	t.Helper()
+	fs.mu.Lock()
+	queued := len(fs.waiters[caller])
+	fs.mu.Unlock()
+	go func() {
+		if err := fs.acquire(context.Background(), caller); err != nil {
+			t.Errorf("acquire(%v): %v", caller, err)
+		}
+		served <- caller
+	}()
+	for i := 0; ; i++ {
+		fs.mu.Lock()
+		n := len(fs.waiters[caller])
+		fs.mu.Unlock()
+		if n > queued {
+			return
+		}
+		if i == 100 {
+			t.Fatalf("the waiter of %v was not queued", caller)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestFairSemaphore(t *testing.T) {
+	fs := newFairSemaphore(1)
+	if err := fs.acquire(context.Background(), "a"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The busy caller a queues first, but b is served before its second query.
+	served := make(chan string, 3)
+	queueWaiter(t, fs, "a", served)
+	queueWaiter(t, fs, "a", served)
+	queueWaiter(t, fs, "b", served)
+	for _, want := range []string{"a", "b", "a"} {
+		fs.release()
+		if got := <-served; got != want {
+			t.Errorf("served %v, want %v", got, want)
+		}
+	}
+	fs.release()
+	if fs.inUse != 0 || len(fs.turns) != 0 || len(fs.waiters) != 0 {
+		t.Errorf("semaphore after release: %+v, want no slot in use and no waiter", fs)
+	}
+}
+
+func TestFairSemaphoreTimeout(t *testing.T) {
+	fs := newFairSemaphore(1)
+	if err := fs.acquire(context.Background(), "a"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := fs.acquire(ctx, "b"); err != context.DeadlineExceeded {
+		t.Errorf("acquire(full): %v, want %v", err, context.DeadlineExceeded)
+	}
+	if len(fs.turns) != 0 || len(fs.waiters) != 0 {
+		t.Errorf("semaphore after timeout: %+v, want no waiter", fs)
+	}
+	fs.release()
+	if err := fs.acquire(context.Background(), "b"); err != nil {
+		t.Errorf("acquire(free): %v", err)
+	}
+}
+
+func TestReserveTokens(t *testing.T) {
+	caller, keyspace := newTokenBucket(1), newTokenBucket(2)
+	now := time.Now()
+	if wait, exceeded := reserveTokens(now, 0, caller, keyspace); exceeded != -1 || wait != 0 {
+		t.Fatalf("reserveTokens: %v, %v, want 0, -1", wait, exceeded)
+	}
+	// The caller has no token left: no token is taken from the keyspace.
+	if _, exceeded := reserveTokens(now, 0, caller, keyspace); exceeded != 0 {
+		t.Errorf("reserveTokens(caller empty): %v, want 0", exceeded)
+	}
+	if keyspace.tokens != 1 {
+		t.Errorf("keyspace tokens: %v, want 1", keyspace.tokens)
+	}
+	// A nil bucket has no limit.
+	if _, exceeded := reserveTokens(now, 0, nil, keyspace); exceeded != -1 {
+		t.Errorf("reserveTokens(nil, keyspace): %v, want -1", exceeded)
+	}
+	if _, exceeded := reserveTokens(now, 0, nil, keyspace); exceeded != 1 {
+		t.Errorf("reserveTokens(nil, keyspace empty): %v, want 1", exceeded)
+	}
+	keyspace.cancel()
+	if keyspace.tokens != 1 {
+		t.Errorf("keyspace tokens after cancel: %v, want 1", keyspace.tokens)
+	}
+}
+
+func TestKeyspaceQuotasMaxCallers(t *testing.T) {
+	kq := &keyspaceQuotas{maxCallers: 2}
+	kq.setConfig(&quotapb.Config{
+		DefaultCaller: &quotapb.Quota{MaxConcurrency: 1},
+		Callers: []*quotapb.CallerQuota{{
+			Caller: "admin",
+			Quota:  &quotapb.Quota{MaxConcurrency: 10},
+		}},
+	})
+	u1, _ := kq.limiters("u1")
+	u2, _ := kq.limiters("u2")
+	u3, _ := kq.limiters("u3")
+	u4, _ := kq.limiters("u4")
+	if u1 == u2 || u3 != kq.otherCallers || u4 != kq.otherCallers {
+		t.Errorf("limiters: %p %p %p %p, want u3 and u4 to share %p", u1, u2, u3, u4, kq.otherCallers)
+	}
+	if got, _ := kq.limiters("u1"); got != u1 {
+		t.Errorf("limiters(u1) again: %p, want %p", got, u1)
+	}
+	if got, _ := kq.limiters("admin"); got == kq.otherCallers || got.sem.max != 10 {
+		t.Errorf("limiters(admin): %+v, want its own quota", got)
+	}
+	if len(kq.callers) != 3 {
+		t.Errorf("callers: %v, want 3", len(kq.callers))
+	}
+}
+
+// waitForQuotas waits until the quotas of a keyspace are read from the
+// SrvVSchema, or deleted.
+func waitForQuotas(t *testing.T, qm *quotaManager, keyspace string, want bool) {
+	t.Helper()
+	for i := 0; ; i++ {
+		if got := qm.get(keyspace) != nil; got == want {
+			return
+		}
+		if i == 100 {
+			t.Fatalf("the quotas of %v were not updated", keyspace)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestQuotaManager(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	ts, err := executor.serv.GetTopoServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	qm := &quotaManager{
+		queueTimeout: 10 * time.Millisecond,
+		maxCallers:   10,
+		keyspaces:    make(map[string]*keyspaceQuotas),
+	}
+	executor.quotas = qm
+	srvVSchema := executor.vm.GetCurrentSrvVschema()
+	srvVSchema.Quotas = map[string]*quotapb.Config{
+		KsTestUnsharded: {
+			Keyspace:      &quotapb.Quota{MaxConcurrency: 2},
+			DefaultCaller: &quotapb.Quota{MaxConcurrency: 1},
+		},
+	}
+	if err := ts.UpdateSrvVSchema(ctx, "aa", srvVSchema); err != nil {
+		t.Fatal(err)
+	}
+	waitForQuotas(t, qm, KsTestUnsharded, true)
+	if kq := qm.get("TestExecutor"); kq != nil {
+		t.Errorf("quotas of TestExecutor: %v, want none", kq)
+	}
+
+	// The only slot of u1 is taken, but u2 can run a query.
+	u1 := callerid.NewContext(ctx, callerid.NewEffectiveCallerID("u1", "", ""), nil)
+	u2 := callerid.NewContext(ctx, callerid.NewEffectiveCallerID("u2", "", ""), nil)
+	release, err := qm.admit(u1, KsTestUnsharded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded, Autocommit: true})
+	sql := "select id from music_user_map where id = 1"
+	if _, err := executor.Execute(u1, "TestQuotaManager", session, sql, nil); vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Errorf("Execute(u1): %v, want RESOURCE_EXHAUSTED", err)
+	}
+	if _, err := executor.Execute(u2, "TestQuotaManager", session, sql, nil); err != nil {
+		t.Errorf("Execute(u2): %v", err)
+	}
+
+	// The queries run by an admitted query are not admitted again.
+	inner, mustAdmit := qm.enter(u1)
+	if !mustAdmit {
+		t.Errorf("enter(u1): false, want true")
+	}
+	if _, mustAdmit := qm.enter(inner); mustAdmit {
+		t.Errorf("enter(admitted): true, want false")
+	}
+
+	release()
+	if _, err := executor.Execute(u1, "TestQuotaManager", session, sql, nil); err != nil {
+		t.Errorf("Execute(u1) after release: %v", err)
+	}
+	if got, want := quotaRejected.Counts()[KsTestUnsharded+".u1.Concurrency"], int64(1); got < want {
+		t.Errorf("QuotaRejected: %v, want at least %v", got, want)
+	}
+
+	// Without quotas, the queries are not limited.
+	srvVSchema.Quotas = nil
+	if err := ts.UpdateSrvVSchema(ctx, "aa", srvVSchema); err != nil {
+		t.Fatal(err)
+	}
+	waitForQuotas(t, qm, KsTestUnsharded, false)
+	if release, err := qm.admit(u1, KsTestUnsharded); err != nil {
+		t.Errorf("admit(no quotas): %v", err)
+	} else {
+		release()
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// A client that knows the replication position of a write, like an
+// external system verifying it, can read after it: it sets the position
+// of each shard in the read_after_positions of the session, and the
+// tablets execute the next query only once they have applied it. The
+// positions are carried in the context, and added to the execute options
+// of the queries sent to their shard.
+
+type readAfterKey struct{}
+
+// withReadAfterPositions consumes the read-after positions of the
+// session, and returns a context that carries them for the query.
+func withReadAfterPositions(ctx context.Context, session *vtgatepb.Session) (context.Context, error) {
+	positions := session.GetReadAfterPositions()
+	if len(positions) == 0 {
+		return ctx, nil
+	}
+	session.ReadAfterPositions = nil
+
+	byShard := make(map[string]string, len(positions))
+	for _, position := range positions {
+		if _, err := mysql.DecodePosition(position.Gtid); err != nil {
+			return ctx, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid position for %s/%s: %v", position.Keyspace, position.Shard, err)
+		}
+		byShard[position.Keyspace+"/"+position.Shard] = position.Gtid
+	}
+	return context.WithValue(ctx, readAfterKey{}, byShard), nil
+}
+
+// shardOptions returns the execute options of a query sent to the
+// target: they wait for the read-after position of its shard, if any.
+func shardOptions(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions) *querypb.ExecuteOptions {
+	byShard, _ := ctx.Value(readAfterKey{}).(map[string]string)
+	position := byShard[target.Keyspace+"/"+target.Shard]
+	if position == "" {
+		return options
+	}
+	options = cloneOptions(options)
+	options.WaitForPosition = position
+	return options
+}
@@ -88,6 +88,8 @@ func (res *Resolver) Execute(
 	options *querypb.ExecuteOptions,
 	logStats *LogStats,
 ) (*sqltypes.Result, error) {
+	ctx = gateway.WithTargetCell(ctx, options.GetTargetCell())
+	ctx = gateway.WithMaxStaleness(ctx, options)
 	rss, err := res.resolver.ResolveDestination(ctx, keyspace, tabletType, destination)
 	if err != nil {
 		return nil, err
@@ -253,6 +255,8 @@ func (res *Resolver) StreamExecute(
 	options *querypb.ExecuteOptions,
 	callback func(*sqltypes.Result) error,
 ) error {
+	ctx = gateway.WithTargetCell(ctx, options.GetTargetCell())
+	ctx = gateway.WithMaxStaleness(ctx, options)
 	rss, err := res.resolver.ResolveDestination(ctx, keyspace, tabletType, destination)
 	if err != nil {
 		return err
@@ -17,6 +17,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -37,6 +38,8 @@ type SafeSession struct {
 	mustRollback    bool
 	autocommitState autocommitState
 	commitOrder     vtgatepb.CommitOrder
+	tracing         bool
+	traces          []*shardTrace
 	*vtgatepb.Session
 }
 
@@ -80,12 +83,15 @@ func NewAutocommitSession(sessn *vtgatepb.Session) *SafeSession {
 	newSession.ShardSessions = nil
 	newSession.PreSessions = nil
 	newSession.PostSessions = nil
+	newSession.ReservedStatements = nil
+	newSession.ReservedSessions = nil
+	newSession.Reserved = false
 	newSession.Autocommit = true
 	newSession.Warnings = nil
 	return NewSafeSession(newSession)
 }
 
-// Reset clears the session
+// Reset clears the session. The reserved connections are kept.
 func (session *SafeSession) Reset() {
 	session.mu.Lock()
 	defer session.mu.Unlock()
@@ -96,6 +102,7 @@ func (session *SafeSession) Reset() {
 	session.ShardSessions = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
+	session.Session.Savepoints = nil
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 }
 
@@ -129,7 +136,9 @@ func (session *SafeSession) AutocommitApproval() bool {
 		return false
 	}
 
-	if session.autocommitState == autocommittable {
+	// The queries on reserved connections are not autocommitted, so
+	// the session state still applies to them.
+	if session.autocommitState == autocommittable && !session.Session.Reserved {
 		session.autocommitState = autocommitted
 		return true
 	}
@@ -201,6 +210,121 @@ func (session *SafeSession) Append(shardSession *vtgatepb.Session_ShardSession,
 	return nil
 }
 
+// Savepoints returns the names of the savepoints of the transaction.
+func (session *SafeSession) Savepoints() []string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return append([]string(nil), session.Session.Savepoints...)
+}
+
+// AddSavepoint records a savepoint of the transaction. Like in MySQL,
+// a previous savepoint of the same name is replaced.
+func (session *SafeSession) AddSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Session.Savepoints = append(session.Session.Savepoints[:i], session.Session.Savepoints[i+1:]...)
+	}
+	session.Session.Savepoints = append(session.Session.Savepoints, name)
+}
+
+// RemoveSavepoints forgets the savepoints created after the named one,
+// and the named one too if inclusive is true. It returns false if there
+// is no such savepoint.
+func (session *SafeSession) RemoveSavepoints(name string, inclusive bool) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	i := session.findSavepoint(name)
+	if i == -1 {
+		return false
+	}
+	if !inclusive {
+		i++
+	}
+	session.Session.Savepoints = session.Session.Savepoints[:i]
+	return true
+}
+
+// findSavepoint returns the index of a savepoint, or -1. Savepoint names
+// are not case sensitive.
+func (session *SafeSession) findSavepoint(name string) int {
+	for i, savepoint := range session.Session.Savepoints {
+		if strings.EqualFold(savepoint, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Reserved returns true if the queries of the session are executed on
+// reserved connections.
+func (session *SafeSession) Reserved() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.Session.Reserved
+}
+
+// SetReserved makes the session use reserved connections.
+func (session *SafeSession) SetReserved() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Session.Reserved = true
+}
+
+// AddReservedStatement records a statement that changed the state of
+// the MySQL session, and makes the session use reserved connections.
+func (session *SafeSession) AddReservedStatement(sql string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Session.Reserved = true
+	session.Session.ReservedStatements = append(session.Session.ReservedStatements, sql)
+}
+
+// ReservedStatements returns the statements to run on a new reserved
+// connection.
+func (session *SafeSession) ReservedStatements() []string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return append([]string(nil), session.Session.ReservedStatements...)
+}
+
+// ReservedSessions returns the reserved connections.
+func (session *SafeSession) ReservedSessions() []*vtgatepb.Session_ShardSession {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return append([]*vtgatepb.Session_ShardSession(nil), session.Session.ReservedSessions...)
+}
+
+// FindReserved returns the id of the reserved connection of a target,
+// or 0.
+func (session *SafeSession) FindReserved(keyspace, shard string, tabletType topodatapb.TabletType) int64 {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for _, shardSession := range session.Session.ReservedSessions {
+		if keyspace == shardSession.Target.Keyspace && tabletType == shardSession.Target.TabletType && shard == shardSession.Target.Shard {
+			return shardSession.TransactionId
+		}
+	}
+	return 0
+}
+
+// AppendReserved adds a reserved connection.
+func (session *SafeSession) AppendReserved(shardSession *vtgatepb.Session_ShardSession) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Session.ReservedSessions = append(session.Session.ReservedSessions, shardSession)
+}
+
+// ClearReserved forgets the reserved connections and the statements
+// run on them. The session doesn't use reserved connections anymore.
+func (session *SafeSession) ClearReserved() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Session.Reserved = false
+	session.Session.ReservedStatements = nil
+	session.Session.ReservedSessions = nil
+}
+
 func (session *SafeSession) isSingleDB(txMode vtgatepb.TransactionMode) bool {
 	return session.SingleDb ||
 		session.TransactionMode == vtgatepb.TransactionMode_SINGLE ||
@@ -231,6 +355,14 @@ func (session *SafeSession) RecordWarning(warning *querypb.QueryWarning) {
 	session.Session.Warnings = append(session.Session.Warnings, warning)
 }
 
+// RecordTrace stores the timings of a query on a shard, which were
+// returned because the trace flag of the options is set.
+func (session *SafeSession) RecordTrace(target *querypb.Target, trace *querypb.QueryTrace) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.traces = append(session.traces, &shardTrace{target: target, trace: trace})
+}
+
 // ClearWarnings removes all the warnings from the session
 func (session *SafeSession) ClearWarnings() {
 	session.mu.Lock()
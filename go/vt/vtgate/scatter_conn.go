@@ -23,10 +23,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/key"
@@ -61,7 +64,7 @@ type ScatterConn struct {
 // return an error if any.  multiGo is capable of executing
 // multiple shardActionFunc actions in parallel and
 // consolidating the results and errors for the caller.
-type shardActionFunc func(rs *srvtopo.ResolvedShard, i int) error
+type shardActionFunc func(ctx context.Context, rs *srvtopo.ResolvedShard, i int) error
 
 // shardActionTransactionFunc defines the contract for a shard action
 // that may be in a transaction. Every such function executes the
@@ -70,7 +73,7 @@ type shardActionFunc func(rs *srvtopo.ResolvedShard, i int) error
 // multiGoTransaction is capable of executing multiple
 // shardActionTransactionFunc actions in parallel and consolidating
 // the results and errors for the caller.
-type shardActionTransactionFunc func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error)
+type shardActionTransactionFunc func(ctx context.Context, rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error)
 
 // NewScatterConn creates a new ScatterConn.
 func NewScatterConn(statsName string, txConn *TxConn, gw gateway.Gateway, hc discovery.HealthCheck) *ScatterConn {
@@ -139,17 +142,17 @@ func (stc *ScatterConn) Execute(
 		tabletType,
 		session,
 		notInTransaction,
-		func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
+		func(ctx context.Context, rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
 			var innerqr *sqltypes.Result
 			if shouldBegin {
 				var err error
-				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, query, bindVars, options)
+				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, query, bindVars, shardOptions(ctx, rs.Target, options))
 				if err != nil {
 					return transactionID, err
 				}
 			} else {
 				var err error
-				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, query, bindVars, transactionID, options)
+				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, query, bindVars, transactionID, shardOptions(ctx, rs.Target, options))
 				if err != nil {
 					return transactionID, err
 				}
@@ -158,15 +161,15 @@ func (stc *ScatterConn) Execute(
 			mu.Lock()
 			defer mu.Unlock()
 			// Don't append more rows if row count is exceeded.
-			if len(qr.Rows) <= *maxMemoryRows {
+			if len(qr.Rows) <= int(maxMemoryRowsLimit.Get()) {
 				qr.AppendResult(innerqr)
 			}
 			return transactionID, nil
 		},
 	)
 
-	if len(qr.Rows) > *maxMemoryRows {
-		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", *maxMemoryRows)
+	if len(qr.Rows) > int(maxMemoryRowsLimit.Get()) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", maxMemoryRowsLimit.Get())
 	}
 
 	return qr, allErrors.AggrError(vterrors.Aggregate)
@@ -199,7 +202,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 		tabletType,
 		session,
 		notInTransaction,
-		func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
+		func(ctx context.Context, rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
 			var (
 				innerqr *sqltypes.Result
 				err     error
@@ -208,6 +211,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			if session != nil && session.Session != nil {
 				opts = session.Session.Options
 			}
+			opts = shardOptions(ctx, rs.Target, opts)
 
 			switch {
 			case autocommit:
@@ -220,19 +224,22 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			if err != nil {
 				return transactionID, err
 			}
+			if trace := innerqr.Extras.GetTrace(); trace != nil && session != nil {
+				session.RecordTrace(rs.Target, trace)
+			}
 
 			mu.Lock()
 			defer mu.Unlock()
 			// Don't append more rows if row count is exceeded.
-			if len(qr.Rows) <= *maxMemoryRows {
+			if len(qr.Rows) <= int(maxMemoryRowsLimit.Get()) {
 				qr.AppendResult(innerqr)
 			}
 			return transactionID, nil
 		},
 	)
 
-	if len(qr.Rows) > *maxMemoryRows {
-		return nil, []error{vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", *maxMemoryRows)}
+	if len(qr.Rows) > int(maxMemoryRowsLimit.Get()) {
+		return nil, []error{vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "in-memory row count exceeded allowed limit of %d", maxMemoryRowsLimit.Get())}
 	}
 
 	return qr, allErrors.GetErrors()
@@ -275,7 +282,7 @@ func (stc *ScatterConn) ExecuteEntityIds(
 		tabletType,
 		session,
 		notInTransaction,
-		func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
+		func(ctx context.Context, rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
 			var innerqr *sqltypes.Result
 			var err error
 
@@ -437,6 +444,41 @@ func (stc *ScatterConn) ExecuteBatch(
 	return results, nil
 }
 
+// ExecuteBatchInTransaction executes a list of queries in order on one
+// shard, with a single round trip. Unlike ExecuteBatch, the queries are
+// executed in the transaction of the session, which must be in one, and
+// the reserved connection and savepoints of the session are honored.
+func (stc *ScatterConn) ExecuteBatchInTransaction(
+	ctx context.Context,
+	rs *srvtopo.ResolvedShard,
+	queries []*querypb.BoundQuery,
+	session *SafeSession,
+	options *querypb.ExecuteOptions) ([]sqltypes.Result, error) {
+
+	var results []sqltypes.Result
+	allErrors := stc.multiGoTransaction(
+		ctx,
+		"ExecuteBatch",
+		[]*srvtopo.ResolvedShard{rs},
+		rs.Target.TabletType,
+		session,
+		false, /* notInTransaction */
+		func(ctx context.Context, rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
+			var err error
+			if shouldBegin {
+				results, transactionID, err = rs.QueryService.BeginExecuteBatch(ctx, rs.Target, queries, false /* asTransaction */, options)
+			} else {
+				results, err = rs.QueryService.ExecuteBatch(ctx, rs.Target, queries, false /* asTransaction */, transactionID, options)
+			}
+			return transactionID, err
+		},
+	)
+	if allErrors.HasErrors() {
+		return nil, allErrors.AggrError(vterrors.Aggregate)
+	}
+	return results, nil
+}
+
 func (stc *ScatterConn) processOneStreamingResult(mu *sync.Mutex, fieldSent *bool, qr *sqltypes.Result, callback func(*sqltypes.Result) error) error {
 	mu.Lock()
 	defer mu.Unlock()
@@ -473,8 +515,8 @@ func (stc *ScatterConn) StreamExecute(
 	var mu sync.Mutex
 	fieldSent := false
 
-	allErrors := stc.multiGo(ctx, "StreamExecute", rss, tabletType, func(rs *srvtopo.ResolvedShard, i int) error {
-		return rs.QueryService.StreamExecute(ctx, rs.Target, query, bindVars, 0, options, func(qr *sqltypes.Result) error {
+	allErrors := stc.multiGo(ctx, "StreamExecute", rss, tabletType, func(ctx context.Context, rs *srvtopo.ResolvedShard, i int) error {
+		return rs.QueryService.StreamExecute(ctx, rs.Target, query, bindVars, 0, shardOptions(ctx, rs.Target, options), func(qr *sqltypes.Result) error {
 			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
 		})
 	})
@@ -483,7 +525,9 @@ func (stc *ScatterConn) StreamExecute(
 
 // StreamExecuteMulti is like StreamExecute,
 // but each shard gets its own bindVars. If len(shards) is not equal to
-// len(bindVars), the function panics.
+// len(bindVars), the function panics. If the session uses reserved
+// connections, the query is streamed on the reserved connection of
+// each shard.
 // Note we guarantee the callback will not be called concurrently
 // by multiple go routines, through processOneStreamingResult.
 func (stc *ScatterConn) StreamExecuteMulti(
@@ -492,15 +536,28 @@ func (stc *ScatterConn) StreamExecuteMulti(
 	rss []*srvtopo.ResolvedShard,
 	bindVars []map[string]*querypb.BindVariable,
 	tabletType topodatapb.TabletType,
-	options *querypb.ExecuteOptions,
+	session *SafeSession,
 	callback func(reply *sqltypes.Result) error,
 ) error {
 	// mu protects fieldSent, callback and replyErr
 	var mu sync.Mutex
 	fieldSent := false
 
-	allErrors := stc.multiGo(ctx, "StreamExecute", rss, tabletType, func(rs *srvtopo.ResolvedShard, i int) error {
-		return rs.QueryService.StreamExecute(ctx, rs.Target, query, bindVars[i], 0, options, func(qr *sqltypes.Result) error {
+	var options *querypb.ExecuteOptions
+	reserved := false
+	if session != nil && session.Session != nil {
+		options = session.Session.Options
+		reserved = session.Reserved()
+	}
+	allErrors := stc.multiGo(ctx, "StreamExecute", rss, tabletType, func(ctx context.Context, rs *srvtopo.ResolvedShard, i int) error {
+		var reservedID int64
+		if reserved {
+			var err error
+			if reservedID, err = stc.reserve(ctx, rs, session); err != nil {
+				return err
+			}
+		}
+		return rs.QueryService.StreamExecute(ctx, rs.Target, query, bindVars[i], reservedID, shardOptions(ctx, rs.Target, options), func(qr *sqltypes.Result) error {
 			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
 		})
 	})
@@ -553,7 +610,7 @@ func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.Resolv
 	var mu sync.Mutex
 	fieldSent := false
 	lastErrors := newTimeTracker()
-	allErrors := stc.multiGo(ctx, "MessageStream", rss, topodatapb.TabletType_MASTER, func(rs *srvtopo.ResolvedShard, i int) error {
+	allErrors := stc.multiGo(ctx, "MessageStream", rss, topodatapb.TabletType_MASTER, func(ctx context.Context, rs *srvtopo.ResolvedShard, i int) error {
 		// This loop handles the case where a reparent happens, which can cause
 		// an individual stream to end. If we don't succeed on the retries for
 		// messageStreamGracePeriod, we abort and return an error.
@@ -599,7 +656,7 @@ func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.Resolv
 func (stc *ScatterConn) MessageAck(ctx context.Context, rss []*srvtopo.ResolvedShard, values [][]*querypb.Value, name string) (int64, error) {
 	var mu sync.Mutex
 	var totalCount int64
-	allErrors := stc.multiGo(ctx, "MessageAck", rss, topodatapb.TabletType_MASTER, func(rs *srvtopo.ResolvedShard, i int) error {
+	allErrors := stc.multiGo(ctx, "MessageAck", rss, topodatapb.TabletType_MASTER, func(ctx context.Context, rs *srvtopo.ResolvedShard, i int) error {
 		count, err := rs.QueryService.MessageAck(ctx, rs.Target, name, values[i])
 		if err != nil {
 			return err
@@ -646,7 +703,7 @@ func (stc *ScatterConn) SplitQuery(
 		"SplitQuery",
 		rss,
 		tabletType,
-		func(rs *srvtopo.ResolvedShard, i int) error {
+		func(ctx context.Context, rs *srvtopo.ResolvedShard, i int) error {
 			// Get all splits from this shard
 			query := &querypb.BoundQuery{
 				Sql:           sql,
@@ -755,11 +812,13 @@ func (stc *ScatterConn) multiGo(
 
 	oneShard := func(rs *srvtopo.ResolvedShard, i int) {
 		var err error
+		span, ctx := startShardSpan(ctx, name, rs.Target)
+		defer finishShardSpan(span, &err)
 		startTime, statsKey := stc.startAction(name, rs.Target)
 		// Send a dummy session.
 		// TODO(sougou): plumb a real session through this call.
 		defer stc.endAction(startTime, allErrors, statsKey, &err, NewSafeSession(nil))
-		err = action(rs, i)
+		err = action(ctx, rs, i)
 	}
 
 	if len(rss) == 1 {
@@ -808,12 +867,31 @@ func (stc *ScatterConn) multiGoTransaction(
 	}
 	oneShard := func(rs *srvtopo.ResolvedShard, i int) {
 		var err error
+		span, ctx := startShardSpan(ctx, name, rs.Target)
+		defer finishShardSpan(span, &err)
 		startTime, statsKey := stc.startAction(name, rs.Target)
 		defer stc.endAction(startTime, allErrors, statsKey, &err, session)
 
 		shouldBegin, transactionID := transactionInfo(rs.Target, session, notInTransaction)
-		transactionID, err = action(rs, i, shouldBegin, transactionID)
-		if shouldBegin && transactionID != 0 {
+		span.Annotate("in_transaction", transactionID != 0 || shouldBegin)
+		var reservedID int64
+		if session.Reserved() {
+			reservedID, err = stc.reserve(ctx, rs, session)
+		}
+		began := false
+		if err == nil && shouldBegin && (reservedID != 0 || len(session.Savepoints()) != 0) {
+			transactionID, err = stc.beginSavepoints(ctx, rs, session, reservedID)
+			shouldBegin, began = false, true
+		}
+		if !shouldBegin && !began && transactionID == 0 {
+			// Outside of a transaction, the reserved connection
+			// runs the query in autocommit mode.
+			transactionID = reservedID
+		}
+		if err == nil {
+			transactionID, err = action(ctx, rs, i, shouldBegin, transactionID)
+		}
+		if (shouldBegin || began) && transactionID != 0 {
 			if appendErr := session.Append(&vtgatepb.Session_ShardSession{
 				Target:        rs.Target,
 				TransactionId: transactionID,
@@ -848,6 +926,83 @@ end:
 	return allErrors
 }
 
+// beginSavepoints begins a transaction on a shard that joins a
+// transaction with savepoints, and creates the savepoints in it. Rolling
+// back to one of them then also rolls back what was done on the shard.
+// The transaction ID is returned even if a savepoint fails, so the
+// transaction is rolled back with the session. If reservedID is not 0,
+// the transaction is begun on that reserved connection.
+func (stc *ScatterConn) beginSavepoints(ctx context.Context, rs *srvtopo.ResolvedShard, session *SafeSession, reservedID int64) (int64, error) {
+	options := session.Options
+	if reservedID != 0 {
+		options = cloneOptions(session.Options)
+		options.ReservedId = reservedID
+	}
+	transactionID, err := rs.QueryService.Begin(ctx, rs.Target, options)
+	if err != nil {
+		return 0, err
+	}
+	for _, name := range session.Savepoints() {
+		if _, err := rs.QueryService.Execute(ctx, rs.Target, "savepoint "+sqlescape.EscapeID(name), nil, transactionID, session.Options); err != nil {
+			return transactionID, err
+		}
+	}
+	return transactionID, nil
+}
+
+// reserve returns the reserved connection of the session on a shard. A
+// new connection is reserved if there is none, and the statements that
+// changed the state of the MySQL session are run on it.
+func (stc *ScatterConn) reserve(ctx context.Context, rs *srvtopo.ResolvedShard, session *SafeSession) (int64, error) {
+	if reservedID := session.FindReserved(rs.Target.Keyspace, rs.Target.Shard, rs.Target.TabletType); reservedID != 0 {
+		return reservedID, nil
+	}
+	options := cloneOptions(session.Options)
+	options.ReserveConnection = true
+	reservedID, err := rs.QueryService.Begin(ctx, rs.Target, options)
+	if err != nil {
+		return 0, err
+	}
+	for _, sql := range session.ReservedStatements() {
+		if _, err := rs.QueryService.Execute(ctx, rs.Target, sql, nil, reservedID, session.Options); err != nil {
+			_ = rs.QueryService.Release(ctx, rs.Target, reservedID)
+			return 0, err
+		}
+	}
+	session.AppendReserved(&vtgatepb.Session_ShardSession{
+		Target:        rs.Target,
+		TransactionId: reservedID,
+	})
+	return reservedID, nil
+}
+
+// cloneOptions returns a copy of the options that can be changed.
+func cloneOptions(options *querypb.ExecuteOptions) *querypb.ExecuteOptions {
+	if options == nil {
+		return &querypb.ExecuteOptions{}
+	}
+	return proto.Clone(options).(*querypb.ExecuteOptions)
+}
+
+// startShardSpan starts the span of an action on one shard, so the
+// latency of each shard of a scatter query can be told apart.
+func startShardSpan(ctx context.Context, name string, target *querypb.Target) (trace.Span, context.Context) {
+	span, ctx := trace.NewSpan(ctx, "ScatterConn."+name)
+	span.Annotate("keyspace", target.Keyspace)
+	span.Annotate("shard", target.Shard)
+	span.Annotate("tablet_type", topoproto.TabletTypeLString(target.TabletType))
+	return span, ctx
+}
+
+// finishShardSpan records the error of the action, if any,
+// and finishes its span.
+func finishShardSpan(span trace.Span, err *error) {
+	if *err != nil {
+		span.Annotate("error", (*err).Error())
+	}
+	span.Finish()
+}
+
 // transactionInfo looks at the current session, and returns:
 // - shouldBegin: if we should call 'Begin' to get a transactionID
 // - transactionID: the transactionID to use, or 0 if not in a transaction.
@@ -249,9 +249,9 @@ func testScatterConnGeneric(t *testing.T, name string, f func(sc *ScatterConn, s
 }
 
 func TestMaxMemoryRows(t *testing.T) {
-	save := *maxMemoryRows
-	*maxMemoryRows = 3
-	defer func() { *maxMemoryRows = save }()
+	save := maxMemoryRowsLimit.Get()
+	maxMemoryRowsLimit.Set(3)
+	defer maxMemoryRowsLimit.Set(save)
 
 	createSandbox("TestMaxMemoryRows")
 	hc := discovery.NewFakeHealthCheck()
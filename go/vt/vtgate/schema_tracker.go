@@ -0,0 +1,250 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vtgate/gateway"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+var (
+	enableSchemaTracking     = flag.Bool("enable_schema_tracking", false, "If set, vtgate reads the columns of the tables from the masters, and adds them to the vschema tables that are not authoritative, so the columns of a join can be resolved without qualifiers. The column lists stay non-authoritative: the SELECT * of these tables are not expanded. The columns of a keyspace are read again when its masters report a schema change.")
+	schemaTrackingRetryDelay = flag.Duration("schema_tracking_retry_delay", 10*time.Second, "How long -enable_schema_tracking waits before reading again the columns of a keyspace it failed to read.")
+
+	schemaTrackingErrors = stats.NewCountersWithSingleLabel("SchemaTrackingErrors", "Errors reading the columns of the tables of a keyspace for the schema tracking", "Keyspace")
+)
+
+// schemaTrackingQuery returns the columns of the tables of the database
+// of a tablet, in order.
+const schemaTrackingQuery = "select table_name, column_name from information_schema.columns where table_schema = database() order by table_name, ordinal_position"
+
+// schemaTracker tracks the columns of the tables of the keyspaces. It
+// reads them from any master of each keyspace when the keyspace appears
+// in the vschema, and again when its masters report a schema change in
+// their health stream. It rebuilds the vschema when they change.
+type schemaTracker struct {
+	e *Executor
+	// signal wakes up the refresh loop. It has a buffer of one, so
+	// the signals that arrive during a refresh are coalesced.
+	signal chan struct{}
+
+	mu sync.Mutex
+	// columns are the column names of the tables by keyspace.
+	columns map[string]map[string][]string
+	// pending are the keyspaces to read again.
+	pending map[string]bool
+}
+
+// newSchemaTracker returns a schemaTracker if it's enabled by the flags,
+// or nil.
+func newSchemaTracker(e *Executor) *schemaTracker {
+	if !*enableSchemaTracking {
+		return nil
+	}
+	return &schemaTracker{
+		e:       e,
+		signal:  make(chan struct{}, 1),
+		columns: make(map[string]map[string][]string),
+		pending: make(map[string]bool),
+	}
+}
+
+// start subscribes to the schema changes of the gateway, and reads the
+// columns of the keyspaces until ctx is done.
+func (st *schemaTracker) start(ctx context.Context, gw gateway.Gateway) {
+	if st == nil {
+		return
+	}
+	gw.SubscribeSchemaChanges(st.schemaChanged)
+	st.wake()
+	go func() {
+		var retry <-chan time.Time
+		for {
+			select {
+			case <-st.signal:
+			case <-retry:
+			case <-ctx.Done():
+				return
+			}
+			retry = nil
+			if !st.refresh(ctx) {
+				retry = time.After(*schemaTrackingRetryDelay)
+			}
+		}
+	}()
+}
+
+// wake signals the refresh loop, without blocking. It is called when the
+// SrvVSchema changes, so the new keyspaces are read.
+func (st *schemaTracker) wake() {
+	if st == nil {
+		return
+	}
+	select {
+	case st.signal <- struct{}{}:
+	default:
+	}
+}
+
+// schemaChanged is called by the gateway when the masters of a keyspace
+// report a schema change.
+func (st *schemaTracker) schemaChanged(keyspace string, tables []string) {
+	st.mu.Lock()
+	st.pending[keyspace] = true
+	st.mu.Unlock()
+	st.wake()
+}
+
+// refresh reads the columns of the pending keyspaces and of the keyspaces
+// of the vschema that were never read, and rebuilds the vschema if they
+// changed. The keyspaces that fail keep their previous columns and stay
+// pending. It returns false if any keyspace failed.
+func (st *schemaTracker) refresh(ctx context.Context) bool {
+	srvVSchema := st.e.vm.srvVSchema()
+	if srvVSchema == nil {
+		return true
+	}
+	st.mu.Lock()
+	var keyspaces []string
+	for ksName := range srvVSchema.Keyspaces {
+		if _, ok := st.columns[ksName]; !ok || st.pending[ksName] {
+			keyspaces = append(keyspaces, ksName)
+		}
+		delete(st.pending, ksName)
+	}
+	// Forget the keyspaces that left the vschema.
+	changed := false
+	for ksName := range st.columns {
+		if _, ok := srvVSchema.Keyspaces[ksName]; !ok {
+			delete(st.columns, ksName)
+			changed = true
+		}
+	}
+	st.mu.Unlock()
+
+	ok := true
+	for _, ksName := range keyspaces {
+		tables, err := st.readColumns(ctx, ksName)
+		st.mu.Lock()
+		if err != nil {
+			schemaTrackingErrors.Add(ksName, 1)
+			log.Warningf("Cannot read the columns of the tables of keyspace %v: %v", ksName, err)
+			st.pending[ksName] = true
+			ok = false
+		} else if !reflect.DeepEqual(st.columns[ksName], tables) {
+			st.columns[ksName] = tables
+			changed = true
+		}
+		st.mu.Unlock()
+	}
+	if changed {
+		st.e.vm.rebuildVSchema()
+	}
+	return ok
+}
+
+// readColumns returns the columns of the tables of a keyspace.
+func (st *schemaTracker) readColumns(ctx context.Context, ksName string) (map[string][]string, error) {
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: true})
+	logStats := NewLogStats(ctx, "SchemaTracking", schemaTrackingQuery, nil)
+	qr, err := st.e.destinationExec(ctx, session, schemaTrackingQuery, nil, key.DestinationAnyShard{}, ksName, topodatapb.TabletType_MASTER, logStats)
+	if err != nil {
+		return nil, err
+	}
+	tables := make(map[string][]string)
+	for _, row := range qr.Rows {
+		if len(row) != 2 {
+			continue
+		}
+		table := row[0].ToString()
+		tables[table] = append(tables[table], row[1].ToString())
+	}
+	return tables, nil
+}
+
+// tableKeyspaces returns the keyspaces that have a table, sorted.
+func (st *schemaTracker) tableKeyspaces(tableName string) []string {
+	if st == nil {
+		return nil
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	var keyspaces []string
+	for ksName, tables := range st.columns {
+		if _, ok := tables[tableName]; ok {
+			keyspaces = append(keyspaces, ksName)
+		}
+	}
+	sort.Strings(keyspaces)
+	return keyspaces
+}
+
+// apply returns a copy of srvVSchema where the tables that don't have
+// an authoritative column list have the tracked columns. The types of
+// the columns that are in the vschema are kept. The column lists stay
+// non-authoritative: the columns may change before the masters report
+// it, so vtgate must not expand a SELECT * from them. srvVSchema is
+// returned as is if there is nothing to apply.
+func (st *schemaTracker) apply(srvVSchema *vschemapb.SrvVSchema) *vschemapb.SrvVSchema {
+	if st == nil || srvVSchema == nil {
+		return srvVSchema
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if len(st.columns) == 0 {
+		return srvVSchema
+	}
+	srvVSchema = proto.Clone(srvVSchema).(*vschemapb.SrvVSchema)
+	for ksName, ks := range srvVSchema.Keyspaces {
+		tracked := st.columns[ksName]
+		for tname, table := range ks.Tables {
+			columns, ok := tracked[tname]
+			if !ok || table.ColumnListAuthoritative {
+				continue
+			}
+			types := make(map[string]*vschemapb.Column)
+			for _, col := range table.Columns {
+				types[strings.ToLower(col.Name)] = col
+			}
+			table.Columns = make([]*vschemapb.Column, 0, len(columns))
+			for _, name := range columns {
+				if col, ok := types[strings.ToLower(name)]; ok {
+					table.Columns = append(table.Columns, col)
+					continue
+				}
+				table.Columns = append(table.Columns, &vschemapb.Column{Name: name})
+			}
+		}
+	}
+	return srvVSchema
+}
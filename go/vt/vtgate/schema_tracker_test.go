@@ -0,0 +1,172 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestSchemaTracker(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+
+	// Without the tracked columns, the unqualified columns of a join
+	// can't be resolved.
+	_, err := executorExec(executor, "select name, extra_id from user join user_extra", nil)
+	want := "symbol extra_id not found"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("unqualified join without schema tracking: %v, want %v", err, want)
+	}
+
+	executor.tracker = newTestSchemaTracker(executor)
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name|column_name", "varchar|varchar"),
+		"user|id",
+		"user|name",
+		"user|textcol",
+		"user_extra|user_id",
+		"user_extra|extra_id",
+	)})
+	sbclookup.SetResults([]*sqltypes.Result{{}})
+	// The keyspaces without tablets fail, and stay pending.
+	if executor.tracker.refresh(context.Background()) {
+		t.Errorf("refresh succeeded, want failed keyspaces")
+	}
+	if !executor.tracker.pending["TestSharded"] || executor.tracker.pending["TestExecutor"] {
+		t.Errorf("pending keyspaces: %v", executor.tracker.pending)
+	}
+
+	user := executor.VSchema().Keyspaces["TestExecutor"].Tables["user"]
+	if user.ColumnListAuthoritative {
+		t.Errorf("user columns are authoritative")
+	}
+	var columns []string
+	for _, col := range user.Columns {
+		columns = append(columns, col.Name.String()+":"+col.Type.String())
+	}
+	// The type of textcol comes from the vschema.
+	wantColumns := []string{"id:NULL_TYPE", "name:NULL_TYPE", "textcol:VARCHAR"}
+	if !reflect.DeepEqual(columns, wantColumns) {
+		t.Errorf("user columns: %v, want %v", columns, wantColumns)
+	}
+	if !strings.Contains(sbc1.Queries[len(sbc1.Queries)-1].Sql, "information_schema.columns") {
+		t.Errorf("schema tracking query: %v", sbc1.Queries[len(sbc1.Queries)-1].Sql)
+	}
+
+	// The star expression is not expanded from the tracked columns.
+	_, err = executorExec(executor, "select * from user join user_extra", nil)
+	want = "unsupported: '*' expression in cross-shard query"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("star join with schema tracking: %v, want %v", err, want)
+	}
+
+	// Route each side of the join to a single shard.
+	sbc1.Queries = nil
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("name", "varchar"),
+		"a",
+	)})
+	sbc2.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("extra_id", "int64"),
+		"4",
+	)})
+	if _, err := executorExec(executor, "select name, extra_id from user join user_extra where user.id = 1 and user_extra.user_id = 3", nil); err != nil {
+		t.Fatal(err)
+	}
+	wantQuery := "select name from user where user.id = 1"
+	if len(sbc1.Queries) == 0 || sbc1.Queries[0].Sql != wantQuery {
+		t.Errorf("queries: %v, want first %v", sbc1.Queries, wantQuery)
+	}
+}
+
+func TestSchemaTrackerSchemaChanged(t *testing.T) {
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	executor.tracker = newTestSchemaTracker(executor)
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name|column_name", "varchar|varchar"),
+		"user|id",
+	)})
+	sbclookup.SetResults([]*sqltypes.Result{{}})
+	executor.tracker.refresh(context.Background())
+
+	// Nothing is read again without a schema change.
+	sbc1.Queries = nil
+	sbclookup.Queries = nil
+	executor.tracker.refresh(context.Background())
+	if len(sbc1.Queries) != 0 || len(sbclookup.Queries) != 0 {
+		t.Errorf("queries without a schema change: %v, %v", sbc1.Queries, sbclookup.Queries)
+	}
+
+	// Only the keyspace of the change is read again.
+	executor.tracker.schemaChanged("TestExecutor", []string{"user"})
+	select {
+	case <-executor.tracker.signal:
+	default:
+		t.Errorf("schemaChanged did not signal the refresh")
+	}
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name|column_name", "varchar|varchar"),
+		"user|id",
+		"user|name",
+	)})
+	executor.tracker.refresh(context.Background())
+	if len(sbc1.Queries) != 1 || len(sbclookup.Queries) != 0 {
+		t.Errorf("queries after a schema change: %v, %v", sbc1.Queries, sbclookup.Queries)
+	}
+	user := executor.VSchema().Keyspaces["TestExecutor"].Tables["user"]
+	if len(user.Columns) != 2 || user.Columns[1].Name.String() != "name" {
+		t.Errorf("user columns: %v, want id, name", user.Columns)
+	}
+}
+
+func TestSchemaTrackerApplyAuthoritative(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	tracker := newTestSchemaTracker(executor)
+	tracker.columns = map[string]map[string][]string{
+		"TestExecutor": {"user": {"id", "other"}},
+	}
+	srvVSchema := proto.Clone(executor.vm.srvVSchema()).(*vschemapb.SrvVSchema)
+	srvVSchema.Keyspaces["TestExecutor"].Tables["user"].ColumnListAuthoritative = true
+
+	vschema, err := vindexes.BuildVSchema(tracker.apply(srvVSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The authoritative column list of the vschema is kept.
+	user := vschema.Keyspaces["TestExecutor"].Tables["user"]
+	if len(user.Columns) != 1 || user.Columns[0].Name.String() != "textcol" {
+		t.Errorf("user columns: %v, want textcol", user.Columns)
+	}
+}
+
+func newTestSchemaTracker(executor *Executor) *schemaTracker {
+	return &schemaTracker{
+		e:       executor,
+		signal:  make(chan struct{}, 1),
+		columns: make(map[string]map[string][]string),
+		pending: make(map[string]bool),
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	autoProvisionSequences = flag.Bool("auto_provision_sequences", false, "If set, 'alter vschema on <table> add auto_increment <column> using <keyspace>.<sequence>' creates the sequence table if it's not in the vschema, and initializes it above the maximum value of the column.")
+	sequenceCache          = flag.Int64("auto_provision_sequence_cache", topotools.DefaultSequenceCache, "The cache of the sequences created by -auto_provision_sequences.")
+)
+
+// provisionSequence creates and initializes the sequence of an
+// 'add auto_increment' vschema DDL if it's not in the vschema yet,
+// and adds it to the vschema. It returns the keyspace of the sequence
+// if its vschema was changed. It runs before the DDL is applied, so
+// that the vschema is not changed if the provisioning fails.
+func (e *Executor) provisionSequence(ctx context.Context, vschema *vschemapb.SrvVSchema, ksName string, ddl *sqlparser.DDL, logStats *LogStats) (string, error) {
+	// Let ApplyVSchemaDDL report the errors of the DDL.
+	table := vschema.Keyspaces[ksName].GetTables()[ddl.Table.Name.String()]
+	if table == nil || table.AutoIncrement != nil {
+		return "", nil
+	}
+	sequence := ddl.AutoIncSpec.Sequence
+	sequenceName := sequence.Name.String()
+	if !sequence.Qualifier.IsEmpty() {
+		sequenceName = sequence.Qualifier.String() + "." + sequenceName
+	}
+	seqKeyspace, seqTable := topotools.ResolveSequence(sequenceName, vschema.Keyspaces, "")
+	if t, ok := vschema.Keyspaces[seqKeyspace].GetTables()[seqTable]; ok && t.Type == "sequence" {
+		// The sequence is already provisioned.
+		return "", nil
+	}
+	if seqKeyspace == "" {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot provision sequence %s: it must be qualified with its keyspace", sequenceName)
+	}
+	seqVSchema, ok := vschema.Keyspaces[seqKeyspace]
+	if !ok {
+		return "", vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "cannot provision sequence %s: keyspace %s not found in vschema", sequenceName, seqKeyspace)
+	}
+	if seqVSchema.Sharded {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot provision sequence %s: keyspace %s is sharded", sequenceName, seqKeyspace)
+	}
+
+	// The statements are executed outside of the transaction of the
+	// session, if any.
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: true})
+	qr, err := e.destinationExec(ctx, session, topotools.MaxColumnQuery(ddl.Table.Name.String(), ddl.AutoIncSpec.Column.String()), nil, key.DestinationAllShards{}, ksName, topodatapb.TabletType_MASTER, logStats)
+	if err != nil {
+		return "", err
+	}
+	maxID, err := topotools.MaxValue(qr)
+	if err != nil {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot provision sequence %s: %v", sequenceName, err)
+	}
+	if _, err := e.destinationExec(ctx, session, topotools.SequenceTableDDL(seqTable), nil, key.DestinationAllShards{}, seqKeyspace, topodatapb.TabletType_MASTER, logStats); err != nil {
+		return "", err
+	}
+	if _, err := e.destinationExec(ctx, session, topotools.SequenceInitQuery(seqTable, maxID, *sequenceCache), nil, key.DestinationAllShards{}, seqKeyspace, topodatapb.TabletType_MASTER, logStats); err != nil {
+		return "", err
+	}
+
+	if seqVSchema.Tables == nil {
+		seqVSchema.Tables = make(map[string]*vschemapb.Table)
+	}
+	seqVSchema.Tables[seqTable] = &vschemapb.Table{Type: "sequence"}
+	return seqKeyspace, nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestExecutorProvisionSequence(t *testing.T) {
+	*vschemaacl.AuthorizedDDLUsers = "%"
+	*autoProvisionSequences = true
+	defer func() {
+		*vschemaacl.AuthorizedDDLUsers = ""
+		*autoProvisionSequences = false
+	}()
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor"})
+
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema on test_table add vindex hash_index (id)", nil); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	// The maximum id is on sbc1, the other shards return 1.
+	sbc1.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("max(id)", "int64"), "41")})
+	if _, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema on test_table add auto_increment id using TestUnsharded.test_table_seq", nil); err != nil {
+		t.Fatal(err)
+	}
+	wantMax := []*querypb.BoundQuery{{
+		Sql:           "select max(`id`) from `test_table`",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	if !reflect.DeepEqual(sbc1.Queries, wantMax) {
+		t.Errorf("sbc1.Queries: %+v, want %+v", sbc1.Queries, wantMax)
+	}
+	wantSequence := []*querypb.BoundQuery{{
+		Sql:           "create table if not exists `test_table_seq`(id int, next_id bigint, cache bigint, primary key(id)) comment 'vitess_sequence'",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, {
+		Sql:           "insert into `test_table_seq`(id, next_id, cache) values (0, 42, 1000) on duplicate key update next_id = greatest(next_id, values(next_id))",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}}
+	if !reflect.DeepEqual(sbclookup.Queries, wantSequence) {
+		t.Errorf("sbclookup.Queries: %+v, want %+v", sbclookup.Queries, wantSequence)
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	vschema := executor.vm.GetCurrentSrvVschema()
+	if got := vschema.Keyspaces[KsTestUnsharded].Tables["test_table_seq"]; got == nil || got.Type != "sequence" {
+		t.Errorf("sequence vschema: %v, want a sequence", got)
+	}
+	wantAutoInc := &vschemapb.AutoIncrement{Column: "id", Sequence: "TestUnsharded.test_table_seq"}
+	if got := vschema.Keyspaces["TestExecutor"].Tables["test_table"].AutoIncrement; !reflect.DeepEqual(got, wantAutoInc) {
+		t.Errorf("auto increment: %v, want %v", got, wantAutoInc)
+	}
+
+	// Unqualified sequences that are not in the vschema cannot be
+	// provisioned.
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "alter vschema on user2 add auto_increment id using unknown_seq", nil)
+	if err == nil || !strings.Contains(err.Error(), "cannot provision sequence unknown_seq: it must be qualified with its keyspace") {
+		t.Errorf("provision unqualified sequence: %v, want it must be qualified", err)
+	}
+}
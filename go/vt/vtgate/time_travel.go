@@ -0,0 +1,183 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"time"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The columns that history tables maintained by VReplication add to
+// the rows of the source table.
+var (
+	historyValidFrom = sqlparser.NewColIdent("valid_from")
+	historyValidTo   = sqlparser.NewColIdent("valid_to")
+)
+
+// asOfLayouts are the accepted formats for the AS_OF directive.
+// Comment directives can't contain spaces, so the date and the
+// time are separated by a 'T'.
+var asOfLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+}
+
+// rewriteAsOf implements the equivalent of FOR SYSTEM_TIME AS OF
+// for history tables. If a select has the AS_OF comment directive,
+// for example:
+//
+//	select /*vt+ AS_OF=2019-10-01T12:00:00Z */ * from t1 where id = 1
+//
+// every table it reads is restricted to the versions of the rows that
+// were current at that time:
+//
+//	... where id = 1 and t1.valid_from <= '2019-10-01 12:00:00' and
+//	(t1.valid_to is null or t1.valid_to > '2019-10-01 12:00:00')
+//
+// The tables must be history tables, i.e. the targets of VReplication
+// rules with history set, and the query is typically sent to the
+// keyspace that contains them. History tables store UTC times, so
+// times without a time zone are UTC.
+func rewriteAsOf(stmt sqlparser.Statement) error {
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil
+	}
+	val, ok := sqlparser.ExtractCommentDirectives(sel.Comments)[sqlparser.DirectiveAsOf]
+	if !ok {
+		return nil
+	}
+	ts, err := parseAsOf(val)
+	if err != nil {
+		return err
+	}
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if sel, ok := node.(*sqlparser.Select); ok {
+			if expr := asOfTableExprs(sel.From, ts); expr != nil {
+				if sel.Where != nil {
+					sel.Where.Expr = parenOr(sel.Where.Expr)
+				}
+				sel.AddWhere(expr)
+			}
+		}
+		return true, nil
+	}, sel)
+}
+
+func parseAsOf(val interface{}) (*sqlparser.SQLVal, error) {
+	if s, ok := val.(string); ok {
+		for _, layout := range asOfLayouts {
+			t, err := time.Parse(layout, s)
+			if err == nil {
+				return sqlparser.NewStrVal([]byte(t.UTC().Format("2006-01-02 15:04:05.999999"))), nil
+			}
+		}
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid %s value: %v, expected a time like 2019-10-01T12:00:00Z", sqlparser.DirectiveAsOf, val)
+}
+
+// asOfTableExprs returns the conditions on the tables of exprs. The
+// conditions on the inner side of outer joins are added to the join
+// condition instead, so that the join still returns all the rows of
+// the outer side.
+func asOfTableExprs(exprs sqlparser.TableExprs, ts sqlparser.Expr) sqlparser.Expr {
+	var result sqlparser.Expr
+	for _, expr := range exprs {
+		result = andExpr(result, asOfTableExpr(expr, ts))
+	}
+	return result
+}
+
+func asOfTableExpr(expr sqlparser.TableExpr, ts sqlparser.Expr) sqlparser.Expr {
+	switch expr := expr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		tableName, ok := expr.Expr.(sqlparser.TableName)
+		if !ok {
+			// Derived tables are rewritten when their select is visited.
+			return nil
+		}
+		if !expr.As.IsEmpty() {
+			tableName = sqlparser.TableName{Name: expr.As}
+		}
+		return asOfCondition(tableName, ts)
+	case *sqlparser.ParenTableExpr:
+		return asOfTableExprs(expr.Exprs, ts)
+	case *sqlparser.JoinTableExpr:
+		left := asOfTableExpr(expr.LeftExpr, ts)
+		right := asOfTableExpr(expr.RightExpr, ts)
+		switch expr.Join {
+		case sqlparser.LeftJoinStr, sqlparser.NaturalLeftJoinStr:
+			expr.Condition.On = andExpr(expr.Condition.On, right)
+			return left
+		case sqlparser.RightJoinStr, sqlparser.NaturalRightJoinStr:
+			expr.Condition.On = andExpr(expr.Condition.On, left)
+			return right
+		}
+		return andExpr(left, right)
+	}
+	return nil
+}
+
+// asOfCondition returns:
+//
+//	table.valid_from <= ts and (table.valid_to is null or table.valid_to > ts)
+func asOfCondition(table sqlparser.TableName, ts sqlparser.Expr) sqlparser.Expr {
+	validTo := &sqlparser.ColName{Name: historyValidTo, Qualifier: table}
+	return &sqlparser.AndExpr{
+		Left: &sqlparser.ComparisonExpr{
+			Operator: sqlparser.LessEqualStr,
+			Left:     &sqlparser.ColName{Name: historyValidFrom, Qualifier: table},
+			Right:    ts,
+		},
+		Right: &sqlparser.ParenExpr{
+			Expr: &sqlparser.OrExpr{
+				Left: &sqlparser.IsExpr{
+					Operator: sqlparser.IsNullStr,
+					Expr:     validTo,
+				},
+				Right: &sqlparser.ComparisonExpr{
+					Operator: sqlparser.GreaterThanStr,
+					Left:     validTo,
+					Right:    ts,
+				},
+			},
+		},
+	}
+}
+
+// andExpr returns left and right. Either of them can be nil.
+func andExpr(left, right sqlparser.Expr) sqlparser.Expr {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	return &sqlparser.AndExpr{Left: parenOr(left), Right: parenOr(right)}
+}
+
+// parenOr parenthesizes OR expressions, which have a lower precedence than AND.
+func parenOr(expr sqlparser.Expr) sqlparser.Expr {
+	if _, ok := expr.(*sqlparser.OrExpr); ok {
+		return &sqlparser.ParenExpr{Expr: expr}
+	}
+	return expr
+}
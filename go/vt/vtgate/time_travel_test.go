@@ -0,0 +1,76 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestRewriteAsOf(t *testing.T) {
+	testcases := []struct {
+		in, out, err string
+	}{{
+		in:  "select * from t1 where id = 1",
+		out: "select * from t1 where id = 1",
+	}, {
+		in:  "insert /*vt+ AS_OF=2019-10-01T12:00:00Z */ into t1 values (1)",
+		out: "insert /*vt+ AS_OF=2019-10-01T12:00:00Z */ into t1 values (1)",
+	}, {
+		in:  "select /*vt+ AS_OF=2019-10-01T12:00:00Z */ * from t1",
+		out: "select /*vt+ AS_OF=2019-10-01T12:00:00Z */ * from t1 where t1.valid_from <= '2019-10-01 12:00:00' and (t1.valid_to is null or t1.valid_to > '2019-10-01 12:00:00')",
+	}, {
+		in:  "select /*vt+ AS_OF=2019-10-01T14:00:00.5+02:00 */ * from ks.t1 as a where id = 1 or id = 2",
+		out: "select /*vt+ AS_OF=2019-10-01T14:00:00.5+02:00 */ * from ks.t1 as a where (id = 1 or id = 2) and a.valid_from <= '2019-10-01 12:00:00.5' and (a.valid_to is null or a.valid_to > '2019-10-01 12:00:00.5')",
+	}, {
+		in:  "select /*vt+ AS_OF=2019-10-01T12:00:00 */ * from ks.t1 join t2 on t1.id = t2.id",
+		out: "select /*vt+ AS_OF=2019-10-01T12:00:00 */ * from ks.t1 join t2 on t1.id = t2.id where ks.t1.valid_from <= '2019-10-01 12:00:00' and (ks.t1.valid_to is null or ks.t1.valid_to > '2019-10-01 12:00:00') and t2.valid_from <= '2019-10-01 12:00:00' and (t2.valid_to is null or t2.valid_to > '2019-10-01 12:00:00')",
+	}, {
+		in:  "select /*vt+ AS_OF=2019-10-01T12:00:00Z */ * from t1 left join t2 on t1.id = t2.id",
+		out: "select /*vt+ AS_OF=2019-10-01T12:00:00Z */ * from t1 left join t2 on t1.id = t2.id and t2.valid_from <= '2019-10-01 12:00:00' and (t2.valid_to is null or t2.valid_to > '2019-10-01 12:00:00') where t1.valid_from <= '2019-10-01 12:00:00' and (t1.valid_to is null or t1.valid_to > '2019-10-01 12:00:00')",
+	}, {
+		in:  "select /*vt+ AS_OF=2019-10-01T12:00:00Z */ * from (select id from t1) as d",
+		out: "select /*vt+ AS_OF=2019-10-01T12:00:00Z */ * from (select id from t1 where t1.valid_from <= '2019-10-01 12:00:00' and (t1.valid_to is null or t1.valid_to > '2019-10-01 12:00:00')) as d",
+	}, {
+		in:  "select /*vt+ AS_OF=yesterday */ * from t1",
+		err: "invalid AS_OF value: yesterday, expected a time like 2019-10-01T12:00:00Z",
+	}, {
+		in:  "select /*vt+ AS_OF=2019 */ * from t1",
+		err: "invalid AS_OF value: 2019, expected a time like 2019-10-01T12:00:00Z",
+	}}
+	for _, tc := range testcases {
+		stmt, err := sqlparser.Parse(tc.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = rewriteAsOf(stmt)
+		if tc.err != "" {
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("rewriteAsOf(%s): %v, want %s", tc.in, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("rewriteAsOf(%s): %v", tc.in, err)
+			continue
+		}
+		if got := sqlparser.String(stmt); got != tc.out {
+			t.Errorf("rewriteAsOf(%s):\n%s, want\n%s", tc.in, got, tc.out)
+		}
+	}
+}
@@ -194,6 +194,48 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	})
 }
 
+// Release rolls back the current transaction, and releases the reserved
+// connections of the session.
+func (txc *TxConn) Release(ctx context.Context, session *SafeSession) error {
+	err := txc.Rollback(ctx, session)
+	reserved := session.ReservedSessions()
+	session.ClearReserved()
+	if releaseErr := txc.runSessions(reserved, func(s *vtgatepb.Session_ShardSession) error {
+		return txc.gateway.Release(ctx, s.Target, s.TransactionId)
+	}); err == nil {
+		err = releaseErr
+	}
+	return err
+}
+
+// ExecuteReserved runs a statement on all the reserved connections of
+// the session.
+func (txc *TxConn) ExecuteReserved(ctx context.Context, session *SafeSession, sql string) error {
+	return txc.runSessions(session.ReservedSessions(), func(s *vtgatepb.Session_ShardSession) error {
+		_, err := txc.gateway.Execute(ctx, s.Target, sql, nil, s.TransactionId, session.Options)
+		return err
+	})
+}
+
+// Savepoint runs a SAVEPOINT, ROLLBACK TO SAVEPOINT or RELEASE SAVEPOINT
+// statement in all the shard sessions of the transaction.
+func (txc *TxConn) Savepoint(ctx context.Context, session *SafeSession, sql string) error {
+	if !session.InTransaction() {
+		return nil
+	}
+
+	allsessions := append(session.PreSessions, session.ShardSessions...)
+	allsessions = append(allsessions, session.PostSessions...)
+
+	return txc.runSessions(allsessions, func(s *vtgatepb.Session_ShardSession) error {
+		if s.TransactionId == 0 {
+			return nil
+		}
+		_, err := txc.gateway.Execute(ctx, s.Target, sql, nil, s.TransactionId, session.Options)
+		return err
+	})
+}
+
 // Resolve resolves the specified 2PC transaction.
 func (txc *TxConn) Resolve(ctx context.Context, dtid string) error {
 	mmShard, err := dtids.ShardSession(dtid)
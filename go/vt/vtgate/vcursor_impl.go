@@ -48,6 +48,7 @@ type vcursorImpl struct {
 	marginComments sqlparser.MarginComments
 	executor       *Executor
 	logStats       *LogStats
+	memory         *engine.MemoryTracker
 	// hasPartialDML is set to true if any DML was successfully
 	// executed. If there was a subsequent failure, the transaction
 	// must be forced to rollback.
@@ -67,6 +68,7 @@ func newVCursorImpl(ctx context.Context, safeSession *SafeSession, keyspace stri
 		marginComments: marginComments,
 		executor:       executor,
 		logStats:       logStats,
+		memory:         executor.memory.NewTracker(maxQueryMemoryBytesLimit.Get()),
 	}
 }
 
@@ -77,7 +79,22 @@ func (vc *vcursorImpl) Context() context.Context {
 
 // MaxMemoryRows returns the maxMemoryRows flag value.
 func (vc *vcursorImpl) MaxMemoryRows() int {
-	return *maxMemoryRows
+	return int(maxMemoryRowsLimit.Get())
+}
+
+// MemoryTracker returns the tracker of the memory held by the query.
+func (vc *vcursorImpl) MemoryTracker() *engine.MemoryTracker {
+	return vc.memory
+}
+
+// SpillToDisk returns the spill_to_disk execute option of the session.
+func (vc *vcursorImpl) SpillToDisk() bool {
+	return vc.safeSession.GetOptions().GetSpillToDisk()
+}
+
+// InTransaction returns true if the session is in a transaction.
+func (vc *vcursorImpl) InTransaction() bool {
+	return vc.safeSession.InTransaction()
 }
 
 // SetContextTimeout updates context and sets a timeout.
@@ -170,6 +187,9 @@ func (vc *vcursorImpl) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries [
 	if errs == nil && isDML {
 		vc.hasPartialDML = true
 	}
+	if err := vc.memory.Grow(engine.ResultMemory(qr)); err != nil {
+		return nil, []error{err}
+	}
 	return qr, errs
 }
 
@@ -196,7 +216,7 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 // StreamExeculteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	atomic.AddUint32(&vc.logStats.ShardQueries, uint32(len(rss)))
-	return vc.executor.scatterConn.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.tabletType, vc.safeSession.Options, callback)
+	return vc.executor.scatterConn.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.tabletType, vc.safeSession, callback)
 }
 
 // ExecuteKeyspaceID is part of the engine.VCursor interface.
@@ -29,13 +29,20 @@ var (
 )
 
 // BinaryMD5 is a vindex that hashes binary bits to a keyspace id.
+// If the "collation" param is set, it hashes the weight strings of
+// the values in that collation instead.
 type BinaryMD5 struct {
-	name string
+	name      string
+	collation *sqltypes.Collation
 }
 
 // NewBinaryMD5 creates a new BinaryMD5.
-func NewBinaryMD5(name string, _ map[string]string) (Vindex, error) {
-	return &BinaryMD5{name: name}, nil
+func NewBinaryMD5(name string, m map[string]string) (Vindex, error) {
+	coll, err := newCollation(m)
+	if err != nil {
+		return nil, err
+	}
+	return &BinaryMD5{name: name, collation: coll}, nil
 }
 
 // String returns the name of the vindex.
@@ -57,7 +64,7 @@ func (vind *BinaryMD5) IsUnique() bool {
 func (vind *BinaryMD5) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
 	out := make([]bool, len(ids))
 	for i := range ids {
-		out[i] = bytes.Equal(binHash(ids[i].ToBytes()), ksids[i])
+		out[i] = bytes.Equal(binHash(collationKey(vind.collation, ids[i])), ksids[i])
 	}
 	return out, nil
 }
@@ -66,7 +73,7 @@ func (vind *BinaryMD5) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) (
 func (vind *BinaryMD5) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
 	out := make([]key.Destination, len(ids))
 	for i, id := range ids {
-		out[i] = key.DestinationKeyspaceID(binHash(id.ToBytes()))
+		out[i] = key.DestinationKeyspaceID(binHash(collationKey(vind.collation, id)))
 	}
 	return out, nil
 }
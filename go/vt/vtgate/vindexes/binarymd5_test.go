@@ -94,3 +94,33 @@ func TestSQLValue(t *testing.T) {
 		t.Errorf("Map(%#v): %#v, want %#v", val, out, want)
 	}
 }
+
+func TestBinaryMD5Collation(t *testing.T) {
+	vindex, err := CreateVindex("binary_md5", "binary_md5_ci", map[string]string{"collation": "utf8mb4_general_ci"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The values that are equal in the collation map to the same keyspace id.
+	ids := []sqltypes.Value{sqltypes.NewVarBinary("Test"), sqltypes.NewVarChar("TEST"), sqltypes.NewVarBinary("tést ")}
+	got, err := vindex.Map(nil, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ksid := got[0].(key.DestinationKeyspaceID)
+	for i, dest := range got {
+		if !reflect.DeepEqual(dest, ksid) {
+			t.Errorf("Map(%v): %v, want %v", ids[i], dest, ksid)
+		}
+	}
+	verified, err := vindex.Verify(nil, ids, [][]byte{ksid, ksid, ksid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []bool{true, true, true}; !reflect.DeepEqual(verified, want) {
+		t.Errorf("Verify(): %v, want %v", verified, want)
+	}
+
+	if _, err := CreateVindex("binary_md5", "binary_md5_ci", map[string]string{"collation": "latin1_swedish_ci"}); err == nil || err.Error() != "unsupported collation: latin1_swedish_ci" {
+		t.Errorf("CreateVindex(latin1_swedish_ci): %v, want unsupported collation", err)
+	}
+}
@@ -160,6 +160,9 @@ type clCommon struct {
 	keyspace     string
 	ownerTable   string
 	ownerColumns []string
+	// collation compares the old and new values of an update, if the
+	// "collation" param is set.
+	collation *sqltypes.Collation
 
 	lockLookupQuery   string
 	lockOwnerQuery    string
@@ -174,6 +177,11 @@ func newCLCommon(name string, m map[string]string) (*clCommon, error) {
 	if err := lu.lkp.Init(m, false /* autocommit */, false /* upsert */); err != nil {
 		return nil, err
 	}
+	coll, err := newCollation(m)
+	if err != nil {
+		return nil, err
+	}
+	lu.collation = coll
 	return lu, nil
 }
 
@@ -269,7 +277,7 @@ func (lu *clCommon) Delete(vcursor VCursor, rowsColValues [][]sqltypes.Value, ks
 func (lu *clCommon) Update(vcursor VCursor, oldValues []sqltypes.Value, ksid []byte, newValues []sqltypes.Value) error {
 	equal := true
 	for i := range oldValues {
-		result, err := sqltypes.NullsafeCompare(oldValues[i], newValues[i])
+		result, err := compareKeys(lu.collation, oldValues[i], newValues[i])
 		if err != nil {
 			return err
 		}
@@ -412,6 +412,28 @@ func TestConsistentLookupNoUpdate(t *testing.T) {
 	vc.verifyLog(t, []string{})
 }
 
+func TestConsistentLookupNoUpdateCollation(t *testing.T) {
+	l, err := CreateVindex("consistent_lookup", "consistent_lookup", map[string]string{
+		"table":     "t",
+		"from":      "fromc1",
+		"to":        "toc",
+		"collation": "utf8mb4_general_ci",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.(WantOwnerInfo).SetOwnerInfo("ks", "t1", []sqlparser.ColIdent{sqlparser.NewColIdent("fc1")}); err != nil {
+		t.Fatal(err)
+	}
+	vc := &loggingVCursor{}
+
+	// The values are equal in the collation: the lookup row doesn't change.
+	if err := l.(Lookup).Update(vc, []sqltypes.Value{sqltypes.NewVarChar("abc")}, []byte("test"), []sqltypes.Value{sqltypes.NewVarChar("ABC ")}); err != nil {
+		t.Error(err)
+	}
+	vc.verifyLog(t, []string{})
+}
+
 func createConsistentLookup(t *testing.T, name string) Vindex {
 	t.Helper()
 	l, err := CreateVindex(name, name, map[string]string{
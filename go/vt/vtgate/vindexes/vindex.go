@@ -163,3 +163,38 @@ func firstColsOnly(rowsColValues [][]sqltypes.Value) []sqltypes.Value {
 	}
 	return firstCols
 }
+
+// newCollation returns the collation of the "collation" param of a
+// vindex, or nil if the param is not set. A vindex that hashes the
+// values of a text column must hash them with the collation of the
+// column, so the values MySQL compares as equal map to the same
+// keyspace id.
+func newCollation(m map[string]string) (*sqltypes.Collation, error) {
+	name := m["collation"]
+	if name == "" {
+		return nil, nil
+	}
+	coll := sqltypes.CollationByName(name)
+	if coll == nil {
+		return nil, fmt.Errorf("unsupported collation: %v", name)
+	}
+	return coll, nil
+}
+
+// collationKey returns the bytes a vindex with the collation coll
+// hashes for a value.
+func collationKey(coll *sqltypes.Collation, id sqltypes.Value) []byte {
+	if coll == nil {
+		return id.ToBytes()
+	}
+	return coll.WeightString(nil, id.ToBytes())
+}
+
+// compareKeys compares two vindex values like NullsafeCompare, or in
+// the collation coll if it's not nil.
+func compareKeys(coll *sqltypes.Collation, v1, v2 sqltypes.Value) (int, error) {
+	if coll == nil || v1.IsNull() || v2.IsNull() {
+		return sqltypes.NullsafeCompare(v1, v2)
+	}
+	return coll.Compare(v1.ToBytes(), v2.ToBytes()), nil
+}
@@ -32,13 +32,20 @@ var (
 
 // XXHash defines vindex that hashes any sql types to a KeyspaceId
 // by using xxhash64. It's Unique and works on any platform giving identical result.
+// If the "collation" param is set, it hashes the weight strings of the values
+// in that collation instead.
 type XXHash struct {
-	name string
+	name      string
+	collation *sqltypes.Collation
 }
 
 // NewXXHash creates a new XXHash.
 func NewXXHash(name string, m map[string]string) (Vindex, error) {
-	return &XXHash{name: name}, nil
+	coll, err := newCollation(m)
+	if err != nil {
+		return nil, err
+	}
+	return &XXHash{name: name, collation: coll}, nil
 }
 
 // String returns the name of the vindex.
@@ -60,7 +67,7 @@ func (vind *XXHash) IsUnique() bool {
 func (vind *XXHash) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
 	out := make([]key.Destination, len(ids))
 	for i := range ids {
-		id := ids[i].ToBytes()
+		id := collationKey(vind.collation, ids[i])
 		out[i] = key.DestinationKeyspaceID(vXXHash(id))
 	}
 	return out, nil
@@ -70,7 +77,7 @@ func (vind *XXHash) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination
 func (vind *XXHash) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
 	out := make([]bool, len(ids))
 	for i := range ids {
-		id := ids[i].ToBytes()
+		id := collationKey(vind.collation, ids[i])
 		out[i] = bytes.Equal(vXXHash(id), ksids[i])
 	}
 	return out, nil
@@ -141,3 +141,17 @@ func benchmarkHashBytes(b *testing.B, input []byte) {
 		sink = xxhash.Sum64(input)
 	}
 }
+
+func TestXXHashCollation(t *testing.T) {
+	vindex, err := CreateVindex("xxhash", "xxhash_ci", map[string]string{"collation": "utf8mb4_unicode_ci"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar("straße"), sqltypes.NewVarChar("STRASSE")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got[0], got[1]) {
+		t.Errorf("Map(straße, STRASSE): %v, want the same keyspace id", got)
+	}
+}
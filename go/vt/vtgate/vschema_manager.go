@@ -33,7 +33,11 @@ import (
 // VSchemaManager is used to watch for updates to the vschema and to implement
 // the DDL commands to add / remove vindexes
 type VSchemaManager struct {
-	e                 *Executor
+	e *Executor
+	// buildMu serializes the builds of the vschema, from the topo
+	// watch and from the schema tracker, so an older SrvVSchema can't
+	// be saved after a newer one.
+	buildMu           sync.Mutex
 	mu                sync.Mutex
 	currentSrvVschema *vschemapb.SrvVSchema
 }
@@ -55,6 +59,9 @@ func (vm *VSchemaManager) GetCurrentSrvVschema() *vschemapb.SrvVSchema {
 // or triggered an error before returning.
 func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 	vm.e.serv.WatchSrvVSchema(ctx, cell, func(v *vschemapb.SrvVSchema, err error) {
+		vm.buildMu.Lock()
+		defer vm.buildMu.Unlock()
+
 		// Create a closure to save the vschema. If the value
 		// passed is nil, it means we encountered an error and
 		// we don't know the real value. In this case, we want
@@ -79,10 +86,15 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 		vm.currentSrvVschema = v
 		vm.mu.Unlock()
 
+		// Keep the quotas we had if the watch failed.
+		if v != nil || topo.IsErrType(err, topo.NoNode) {
+			vm.e.quotas.setQuotas(v.GetQuotas())
+		}
+
 		// Transform the provided SrvVSchema into a VSchema.
 		var vschema *vindexes.VSchema
 		if v != nil {
-			vschema, err = vindexes.BuildVSchema(v)
+			vschema, err = vindexes.BuildVSchema(vm.e.tracker.apply(v))
 			if err != nil {
 				log.Warningf("Error creating VSchema for cell %v (will try again next update): %v", cell, err)
 				err = fmt.Errorf("error creating VSchema for cell %v: %v", cell, err)
@@ -115,9 +127,37 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 		}
 
 		vm.e.SaveVSchema(vschema, stats)
+
+		// Read the columns of the new keyspaces.
+		vm.e.tracker.wake()
 	})
 }
 
+// srvVSchema returns the latest SrvVSchema from the topo watch, which
+// must not be modified.
+func (vm *VSchemaManager) srvVSchema() *vschemapb.SrvVSchema {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+	return vm.currentSrvVschema
+}
+
+// rebuildVSchema builds the vschema again from the latest SrvVSchema,
+// when the tracked schema changed.
+func (vm *VSchemaManager) rebuildVSchema() {
+	vm.buildMu.Lock()
+	defer vm.buildMu.Unlock()
+	v := vm.srvVSchema()
+	if v == nil {
+		return
+	}
+	vschema, err := vindexes.BuildVSchema(vm.e.tracker.apply(v))
+	if err != nil {
+		log.Warningf("Error creating VSchema with the tracked schema: %v", err)
+		return
+	}
+	vm.e.SaveVSchema(vschema, NewVSchemaStats(vschema, ""))
+}
+
 // UpdateVSchema propagates the updated vschema to the topo. The entry for
 // the given keyspace is updated in the global topo, and the full SrvVSchema
 // is updated in all known cells.
@@ -19,6 +19,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"math"
@@ -30,7 +31,11 @@ import (
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/tb"
+	"vitess.io/vitess/go/vt/authz"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
@@ -43,6 +48,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/gateway"
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
@@ -61,7 +67,19 @@ var (
 	queryPlanCacheSize  = flag.Int64("gate_query_cache_size", 10000, "gate server query cache size, maximum number of queries to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	disableLocalGateway = flag.Bool("disable_local_gateway", false, "if specified, this process will not route any queries to local tablets in the local cell")
 	maxMemoryRows       = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
+	maxMemoryBytes      = flag.Int64("max_memory_bytes", 0, "Maximum number of bytes that the running queries can buffer in memory for intermediate results as well as the final results. 0 means unlimited.")
+	maxQueryMemoryBytes = flag.Int64("max_query_memory_bytes", 0, "Maximum number of bytes that a query can buffer in memory for intermediate results as well as the final result. 0 means unlimited.")
+	spillDir            = flag.String("spill_dir", "", "Directory of the temporary files of the sorts and hash joins that spill to disk. The default directory for temporary files is used if it's empty.")
+	spillConcurrency    = flag.Int("spill_concurrency", engine.DefaultSpillConcurrency, "Maximum number of sorts and hash joins that can spill to disk at the same time.")
 	warnMemoryRows      = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
+
+	// The memory limits can be changed at runtime, so the queries read
+	// them from these atomics. The flags only set their initial values.
+	maxMemoryRowsLimit       = sync2.NewAtomicInt64(int64(*maxMemoryRows))
+	warnMemoryRowsLimit      = sync2.NewAtomicInt64(int64(*warnMemoryRows))
+	maxQueryMemoryBytesLimit = sync2.NewAtomicInt64(*maxQueryMemoryBytes)
+
+	enableReservedConnections = flag.Bool("enable_reserved_connections", false, "If set, the statements that change the state of the MySQL session, like SET sql_mode, SET time_zone or the temporary tables, make the session use reserved vttablet connections that keep that state. Otherwise, they are ignored or rejected.")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -146,6 +164,28 @@ type RegisterVTGate func(vtgateservice.VTGateService)
 // RegisterVTGates stores register funcs for VTGate server.
 var RegisterVTGates []RegisterVTGate
 
+// registerRuntimeFlags allows the memory limits of the queries to be
+// changed without restarting vtgate, in servenv.RuntimeFlags.
+func registerRuntimeFlags(executor *Executor) {
+	maxMemoryRowsLimit.Set(int64(*maxMemoryRows))
+	warnMemoryRowsLimit.Set(int64(*warnMemoryRows))
+	maxQueryMemoryBytesLimit.Set(*maxQueryMemoryBytes)
+
+	nonNegative := func(name string, apply func(int64)) func(string) error {
+		return servenv.Int64RuntimeFlag(func(val int64) error {
+			if val < 0 {
+				return fmt.Errorf("%v cannot be negative", name)
+			}
+			apply(val)
+			return nil
+		})
+	}
+	servenv.RuntimeFlags.Register("max_memory_rows", nonNegative("max_memory_rows", maxMemoryRowsLimit.Set))
+	servenv.RuntimeFlags.Register("warn_memory_rows", nonNegative("warn_memory_rows", warnMemoryRowsLimit.Set))
+	servenv.RuntimeFlags.Register("max_query_memory_bytes", nonNegative("max_query_memory_bytes", maxQueryMemoryBytesLimit.Set))
+	servenv.RuntimeFlags.Register("max_memory_bytes", nonNegative("max_memory_bytes", executor.memory.SetLimit))
+}
+
 // Init initializes VTGate server.
 func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, cell string, retryCount int, tabletTypesToWait []topodatapb.TabletType) *VTGate {
 	if rpcVTGate != nil {
@@ -190,8 +230,17 @@ func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, ce
 	srvResolver := srvtopo.NewResolver(serv, gw, cell)
 	resolver := NewResolver(srvResolver, serv, cell, sc)
 
+	engine.InitSpill(*spillDir, *spillConcurrency)
+	executor := NewExecutor(ctx, serv, cell, "VTGateExecutor", resolver, *normalizeQueries, *streamBufferSize, *queryPlanCacheSize)
+	authzChecker, err := authz.NewChecker("vtgate")
+	if err != nil {
+		log.Fatalf("Unable to create external authorization checker: %v", err)
+	}
+	executor.authz = authzChecker
+	registerRuntimeFlags(executor)
+
 	rpcVTGate = &VTGate{
-		executor: NewExecutor(ctx, serv, cell, "VTGateExecutor", resolver, *normalizeQueries, *streamBufferSize, *queryPlanCacheSize),
+		executor: executor,
 		resolver: resolver,
 		txConn:   tc,
 		gw:       gw,
@@ -238,10 +287,13 @@ func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, ce
 		}
 	})
 	rpcVTGate.registerDebugHealthHandler()
-	err := initQueryLogger(rpcVTGate)
+	err = initQueryLogger(rpcVTGate)
 	if err != nil {
 		log.Fatalf("error initializing query logger: %v", err)
 	}
+	if err := initQuerySampler(); err != nil {
+		log.Fatalf("error initializing query sampler: %v", err)
+	}
 
 	initAPI(ctx, hc)
 
@@ -266,6 +318,9 @@ func (vtg *VTGate) registerDebugHealthHandler() {
 // IsHealthy returns nil if server is healthy.
 // Otherwise, it returns an error indicating the reason.
 func (vtg *VTGate) IsHealthy() error {
+	if servenv.IsLameduck() {
+		return errors.New("vtgate is in lameduck mode")
+	}
 	return nil
 }
 
@@ -285,6 +340,9 @@ func (vtg *VTGate) Execute(ctx context.Context, session *vtgatepb.Session, sql s
 		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", bvErr)
 		goto handleError
 	}
+	if ctx, err = withReadAfterPositions(ctx, session); err != nil {
+		goto handleError
+	}
 
 	qr, err = vtg.executor.Execute(ctx, "Execute", NewSafeSession(session), sql, bindVariables)
 	if err == nil {
@@ -315,18 +373,56 @@ func (vtg *VTGate) ExecuteBatch(ctx context.Context, session *vtgatepb.Session,
 		}
 	}
 
-	qrl := make([]sqltypes.QueryResponse, len(sqlList))
-	for i, sql := range sqlList {
-		var bv map[string]*querypb.BindVariable
-		if len(bindVariablesList) != 0 {
-			bv = bindVariablesList[i]
+	ctx, err := withReadAfterPositions(ctx, session)
+	if err != nil {
+		return session, nil, err
+	}
+	qrl := vtg.executeBatch(ctx, "ExecuteBatch", session, sqlList, bindVariablesList, false /* stopOnError */, statsKey)
+	return session, qrl, nil
+}
+
+// ExecuteMulti executes the statements of a multi-statement query in
+// order, and stops at the first one that fails. It returns the results
+// of the statements before it, and its error.
+func (vtg *VTGate) ExecuteMulti(ctx context.Context, session *vtgatepb.Session, sqlList []string) (*vtgatepb.Session, []*sqltypes.Result, error) {
+	// In this context, we don't care if we can't fully parse destination
+	destKeyspace, destTabletType, _, _ := vtg.executor.ParseDestinationTarget(session.TargetString)
+	statsKey := []string{"ExecuteMulti", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
+	defer vtg.timings.Record(statsKey, time.Now())
+
+	qrl := vtg.executeBatch(ctx, "ExecuteMulti", session, sqlList, nil, true /* stopOnError */, statsKey)
+	results := make([]*sqltypes.Result, 0, len(qrl))
+	for _, qr := range qrl {
+		if qr.QueryError != nil {
+			return session, results, qr.QueryError
 		}
-		session, qrl[i].QueryResult, qrl[i].QueryError = vtg.Execute(ctx, session, sql, bv)
+		results = append(results, qr.QueryResult)
+	}
+	return session, results, nil
+}
+
+// executeBatch executes a list of queries with the executor, and records
+// the stats and errors of each of them.
+func (vtg *VTGate) executeBatch(ctx context.Context, method string, session *vtgatepb.Session, sqlList []string, bindVariablesList []map[string]*querypb.BindVariable, stopOnError bool, statsKey []string) []sqltypes.QueryResponse {
+	qrl := vtg.executor.ExecuteBatch(ctx, method, NewSafeSession(session), sqlList, bindVariablesList, stopOnError)
+	for i := range qrl {
 		if qr := qrl[i].QueryResult; qr != nil {
 			vtg.rowsReturned.Add(statsKey, int64(len(qr.Rows)))
 		}
+		if err := qrl[i].QueryError; err != nil {
+			var bv map[string]*querypb.BindVariable
+			if len(bindVariablesList) != 0 {
+				bv = bindVariablesList[i]
+			}
+			query := map[string]interface{}{
+				"Sql":           sqlList[i],
+				"BindVariables": bv,
+				"Session":       session,
+			}
+			qrl[i].QueryError = recordAndAnnotateError(err, statsKey, query, vtg.logExecute)
+		}
 	}
-	return session, qrl, nil
+	return qrl
 }
 
 // StreamExecute executes a streaming query. This is a V3 function.
@@ -344,6 +440,9 @@ func (vtg *VTGate) StreamExecute(ctx context.Context, session *vtgatepb.Session,
 		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", bvErr)
 		goto handleError
 	}
+	if ctx, err = withReadAfterPositions(ctx, session); err != nil {
+		goto handleError
+	}
 
 	// TODO: This could be simplified to have a StreamExecute that takes
 	// a destTarget without explicit destination.
@@ -828,6 +927,12 @@ func (vtg *VTGate) Rollback(ctx context.Context, session *vtgatepb.Session) erro
 	return formatError(vtg.txConn.Rollback(ctx, NewSafeSession(session)))
 }
 
+// CloseSession rolls back the transaction of the session, and releases
+// its reserved connections.
+func (vtg *VTGate) CloseSession(ctx context.Context, session *vtgatepb.Session) error {
+	return formatError(vtg.txConn.Release(ctx, NewSafeSession(session)))
+}
+
 // ResolveTransaction resolves the specified 2PC transaction.
 func (vtg *VTGate) ResolveTransaction(ctx context.Context, dtid string) error {
 	return formatError(vtg.txConn.Resolve(ctx, dtid))
@@ -886,6 +991,8 @@ func (vtg *VTGate) isKeyspaceRangeBasedSharded(keyspace string, srvKeyspace *top
 // supports multiple split-columns and multiple splitting algorithms.
 // See the documentation of SplitQueryRequest in "proto/vtgate.proto" for more
 // information.
+// If cell is set, the splits are computed by the rdonly tablets of that
+// cell only, and the query parts are targeted to that cell.
 func (vtg *VTGate) SplitQuery(
 	ctx context.Context,
 	keyspace string,
@@ -894,11 +1001,13 @@ func (vtg *VTGate) SplitQuery(
 	splitColumns []string,
 	splitCount int64,
 	numRowsPerQueryPart int64,
-	algorithm querypb.SplitQueryRequest_Algorithm) ([]*vtgatepb.SplitQueryResponse_Part, error) {
+	algorithm querypb.SplitQueryRequest_Algorithm,
+	cell string) ([]*vtgatepb.SplitQueryResponse_Part, error) {
 
 	if bvErr := sqltypes.ValidateBindVariables(bindVariables); bvErr != nil {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", bvErr)
 	}
+	ctx = gateway.WithTargetCell(ctx, cell)
 
 	// TODO(erez): Add validation of SplitQuery parameters.
 	rss, srvKeyspace, err := vtg.resolver.resolver.GetAllShards(ctx, keyspace, topodatapb.TabletType_RDONLY)
@@ -936,7 +1045,9 @@ func (vtg *VTGate) SplitQuery(
 					Keyspace:  keyspace,
 					KeyRanges: []*topodatapb.KeyRange{kr},
 				},
-				Size: querySplit.RowCount,
+				Size:       querySplit.RowCount,
+				TabletType: rs.Target.TabletType,
+				Cell:       cell,
 			}, nil
 		}
 	} else {
@@ -948,7 +1059,9 @@ func (vtg *VTGate) SplitQuery(
 					Keyspace: keyspace,
 					Shards:   []string{rs.Target.Shard},
 				},
-				Size: querySplit.RowCount,
+				Size:       querySplit.RowCount,
+				TabletType: rs.Target.TabletType,
+				Cell:       cell,
 			}, nil
 		}
 	}
@@ -1136,6 +1249,32 @@ func formatError(err error) error {
 	return vterrors.Wrapf(err, "vtgate: %s", servenv.ListeningURL.String())
 }
 
+// SetRuntimeFlags is part of the vtgate service API. It changes flags
+// of vtgate without restarting it, like the memory limits of the
+// queries. The immediate caller must have the ADMIN role.
+func (vtg *VTGate) SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*vtgatepb.RuntimeFlagChange, error) {
+	if err := acl.CheckAccessActor(callerid.ImmediateCallerIDFromContext(ctx).GetUsername(), acl.ADMIN); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "SetRuntimeFlags: %v", err)
+	}
+	caller := "unknown"
+	if ci, ok := callinfo.FromContext(ctx); ok {
+		caller = ci.Text()
+	}
+	changes, err := servenv.RuntimeFlags.Set(caller, flags)
+	if err != nil {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+	}
+	result := make([]*vtgatepb.RuntimeFlagChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, &vtgatepb.RuntimeFlagChange{
+			Name:     change.Name,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+	return result, nil
+}
+
 // HandlePanic recovers from panics, and logs / increment counters
 func (vtg *VTGate) HandlePanic(err *error) {
 	if x := recover(); x != nil {
@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
@@ -228,6 +229,52 @@ func TestVTGateRollbackNil(t *testing.T) {
 	}
 }
 
+func TestVTGateSetRuntimeFlags(t *testing.T) {
+	defer func() {
+		if _, err := rpcVTGate.SetRuntimeFlags(context.Background(), map[string]string{"max_memory_rows": fmt.Sprint(*maxMemoryRows)}); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	changes, err := rpcVTGate.SetRuntimeFlags(context.Background(), map[string]string{"max_memory_rows": "1000"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*vtgatepb.RuntimeFlagChange{{
+		Name:     "max_memory_rows",
+		OldValue: fmt.Sprint(*maxMemoryRows),
+		NewValue: "1000",
+	}}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("SetRuntimeFlags: %v, want %v", changes, want)
+	}
+	if got := maxMemoryRowsLimit.Get(); got != 1000 {
+		t.Errorf("maxMemoryRowsLimit: %v, want 1000", got)
+	}
+	// The flag variable itself is not changed.
+	if *maxMemoryRows == 1000 {
+		t.Errorf("max_memory_rows flag was changed")
+	}
+
+	_, err = rpcVTGate.SetRuntimeFlags(context.Background(), map[string]string{"warn_memory_rows": "10", "max_memory_rows": "-1"})
+	wantErr := "max_memory_rows cannot be negative"
+	if err == nil || !strings.Contains(err.Error(), wantErr) {
+		t.Errorf("SetRuntimeFlags: %v, want %s", err, wantErr)
+	}
+	if code := vterrors.Code(err); code != vtrpcpb.Code_INVALID_ARGUMENT {
+		t.Errorf("SetRuntimeFlags error code: %v, want INVALID_ARGUMENT", code)
+	}
+	if got := warnMemoryRowsLimit.Get(); got != int64(*warnMemoryRows) {
+		t.Errorf("warnMemoryRowsLimit: %v, want %v", got, *warnMemoryRows)
+	}
+
+	_, err = rpcVTGate.SetRuntimeFlags(context.Background(), map[string]string{"cells_to_watch": "aa"})
+	wantErr = "flag cells_to_watch cannot be changed at runtime"
+	if err == nil || !strings.Contains(err.Error(), wantErr) {
+		t.Errorf("SetRuntimeFlags: %v, want %s", err, wantErr)
+	}
+}
+
 func TestVTGateExecute(t *testing.T) {
 	createSandbox(KsTestUnsharded)
 	hcVTGateTest.Reset()
@@ -298,6 +345,54 @@ func TestVTGateExecute(t *testing.T) {
 	}
 }
 
+func TestVTGateExecuteReadAfterPositions(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	sbc := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	position := "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"
+	session := &vtgatepb.Session{
+		Autocommit:   true,
+		TargetString: "@replica",
+		ReadAfterPositions: []*binlogdatapb.ShardGtid{{
+			Keyspace: KsTestUnsharded,
+			Shard:    "0",
+			Gtid:     position,
+		}, {
+			Keyspace: KsTestUnsharded,
+			Shard:    "-80",
+			Gtid:     "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8",
+		}},
+	}
+	session, _, err := rpcVTGate.Execute(context.Background(), session, "select id from t1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sbc.Options[0].GetWaitForPosition(); got != position {
+		t.Errorf("WaitForPosition: %q, want %q", got, position)
+	}
+	if len(session.ReadAfterPositions) != 0 {
+		t.Errorf("ReadAfterPositions: %v, want none", session.ReadAfterPositions)
+	}
+
+	// The positions only apply to one request.
+	if _, _, err := rpcVTGate.Execute(context.Background(), session, "select id from t1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := sbc.Options[1].GetWaitForPosition(); got != "" {
+		t.Errorf("WaitForPosition: %q, want none", got)
+	}
+
+	session.ReadAfterPositions = []*binlogdatapb.ShardGtid{{
+		Keyspace: KsTestUnsharded,
+		Shard:    "0",
+		Gtid:     "invalid",
+	}}
+	_, _, err = rpcVTGate.Execute(context.Background(), session, "select id from t1", nil)
+	if code := vterrors.Code(err); code != vtrpcpb.Code_INVALID_ARGUMENT {
+		t.Errorf("Execute: %v, want INVALID_ARGUMENT", err)
+	}
+}
+
 func TestVTGateExecuteWithKeyspaceShard(t *testing.T) {
 	createSandbox(KsTestUnsharded)
 	hcVTGateTest.Reset()
@@ -1167,7 +1262,8 @@ func TestVTGateSplitQuerySharded(t *testing.T) {
 			splitColumns,
 			testCase.splitCount,
 			testCase.numRowsPerQueryPart,
-			algorithm)
+			algorithm,
+			"")
 		if err != nil {
 			t.Errorf("got %v, want: nil. testCase: %+v", err, testCase)
 		}
@@ -1513,7 +1609,8 @@ func TestVTGateSplitQueryUnsharded(t *testing.T) {
 			splitColumns,
 			testCase.splitCount,
 			testCase.numRowsPerQueryPart,
-			algorithm)
+			algorithm,
+			"")
 		if err != nil {
 			t.Errorf("got %v, want: nil. testCase: %+v", err, testCase)
 		}
@@ -1555,6 +1652,32 @@ func TestVTGateSplitQueryUnsharded(t *testing.T) {
 	}
 }
 
+func TestVTGateSplitQueryCell(t *testing.T) {
+	keyspace := KsTestUnsharded
+	createSandbox(keyspace)
+	hcVTGateTest.Reset()
+	hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, keyspace, "0", topodatapb.TabletType_RDONLY, true, 1, nil)
+	hcVTGateTest.AddTestTablet("bb", "1.1.1.2", 1001, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+
+	splits, err := rpcVTGate.SplitQuery(context.Background(), keyspace, "select col1 from table", nil, []string{"sc1"}, 10, 0, querypb.SplitQueryRequest_FULL_SCAN, "aa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(splits) != 1 {
+		t.Fatalf("got %d splits, want 1", len(splits))
+	}
+	if splits[0].TabletType != topodatapb.TabletType_RDONLY || splits[0].Cell != "aa" {
+		t.Errorf("got split tablet type %v, cell %q, want RDONLY, aa", splits[0].TabletType, splits[0].Cell)
+	}
+
+	// The rdonly tablets of the other cells are not used.
+	_, err = rpcVTGate.SplitQuery(context.Background(), keyspace, "select col1 from table", nil, []string{"sc1"}, 10, 0, querypb.SplitQueryRequest_FULL_SCAN, "bb")
+	want := "no valid tablet in cell bb"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("SplitQuery in cell bb: %v, must contain %s", err, want)
+	}
+}
+
 func TestVTGateBindVarError(t *testing.T) {
 	ks := KsTestUnsharded
 	createSandbox(ks)
@@ -1655,7 +1778,7 @@ func TestVTGateBindVarError(t *testing.T) {
 	}, {
 		name: "SplitQuery",
 		f: func() error {
-			_, err := rpcVTGate.SplitQuery(ctx, "", "", bindVars, []string{}, 0, 0, querypb.SplitQueryRequest_FULL_SCAN)
+			_, err := rpcVTGate.SplitQuery(ctx, "", "", bindVars, []string{}, 0, 0, querypb.SplitQueryRequest_FULL_SCAN, "")
 			return err
 		},
 	}}
@@ -2390,7 +2513,8 @@ func testErrorPropagation(t *testing.T, sbcs []*sandboxconn.SandboxConn, before
 		[]string{"sc1", "sc2"},
 		100,
 		0,
-		querypb.SplitQueryRequest_FULL_SCAN)
+		querypb.SplitQueryRequest_FULL_SCAN,
+		"")
 	if err == nil {
 		t.Errorf("error %v not propagated for SplitQuery", expected)
 	} else {
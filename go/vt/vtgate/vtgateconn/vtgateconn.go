@@ -20,6 +20,7 @@ import (
 	"flag"
 	"fmt"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/log"
@@ -163,8 +164,10 @@ func (conn *VTGateConn) Close() {
 // SplitQuery splits a query into smaller queries. It is mostly used by batch job frameworks
 // such as MapReduce. See the documentation for the vtgate.SplitQueryRequest protocol buffer message
 // in 'proto/vtgate.proto'.
-func (conn *VTGateConn) SplitQuery(ctx context.Context, keyspace string, query string, bindVars map[string]*querypb.BindVariable, splitColumns []string, splitCount int64, numRowsPerQueryPart int64, algorithm querypb.SplitQueryRequest_Algorithm) ([]*vtgatepb.SplitQueryResponse_Part, error) {
-	return conn.impl.SplitQuery(ctx, keyspace, query, bindVars, splitColumns, splitCount, numRowsPerQueryPart, algorithm)
+// If cell is set, the splits are computed in that cell, and the query
+// parts must be executed there with the target_cell of the ExecuteOptions.
+func (conn *VTGateConn) SplitQuery(ctx context.Context, keyspace string, query string, bindVars map[string]*querypb.BindVariable, splitColumns []string, splitCount int64, numRowsPerQueryPart int64, algorithm querypb.SplitQueryRequest_Algorithm, cell string) ([]*vtgatepb.SplitQueryResponse_Part, error) {
+	return conn.impl.SplitQuery(ctx, keyspace, query, bindVars, splitColumns, splitCount, numRowsPerQueryPart, algorithm, cell)
 }
 
 // GetSrvKeyspace returns a topo.SrvKeyspace object.
@@ -172,6 +175,12 @@ func (conn *VTGateConn) GetSrvKeyspace(ctx context.Context, keyspace string) (*t
 	return conn.impl.GetSrvKeyspace(ctx, keyspace)
 }
 
+// SetRuntimeFlags changes flags of vtgate without restarting it. The
+// flags are changed all together or not at all.
+func (conn *VTGateConn) SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*vtgatepb.RuntimeFlagChange, error) {
+	return conn.impl.SetRuntimeFlags(ctx, flags)
+}
+
 // VStreamReader is returned by VStream.
 type VStreamReader interface {
 	// Recv returns the next result on the stream.
@@ -217,6 +226,55 @@ func (sn *VTGateSession) Execute(ctx context.Context, query string, bindVars map
 	return res, err
 }
 
+// ExecuteAt performs a VTGate Execute that reads after the given
+// replication positions: the tablets execute the query only once they
+// have applied the position of their shard, or fail when the deadline
+// of ctx is reached. It lets an external system verify a write whose
+// positions it knows.
+func (sn *VTGateSession) ExecuteAt(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable, positions []*binlogdatapb.ShardGtid) (*sqltypes.Result, error) {
+	sn.session.ReadAfterPositions = positions
+	res, err := sn.Execute(ctx, query, bindVars)
+	if sn.session != nil {
+		sn.session.ReadAfterPositions = nil
+	}
+	return res, err
+}
+
+// ExecuteWithOptions performs a VTGate Execute with the options of the
+// session merged with the given ones, for this query only: the fields
+// set in options override the ones of the session.
+func (sn *VTGateSession) ExecuteWithOptions(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	saved := sn.session.Options
+	session, res, err := sn.impl.Execute(ctx, sn.sessionWithOptions(options), query, bindVars)
+	if session != nil {
+		session.Options = saved
+	}
+	sn.session = session
+	return res, err
+}
+
+// sessionWithOptions returns a shallow copy of the session with its
+// options merged with the given ones.
+func (sn *VTGateSession) sessionWithOptions(options *querypb.ExecuteOptions) *vtgatepb.Session {
+	session := *sn.session
+	if sn.session.Options != nil && options != nil {
+		merged := proto.Clone(sn.session.Options).(*querypb.ExecuteOptions)
+		proto.Merge(merged, options)
+		options = merged
+	} else if options == nil {
+		options = sn.session.Options
+	}
+	session.Options = options
+	return &session
+}
+
+// SetTransactionMode sets the transaction mode of the session, which
+// overrides the transaction_mode flag of vtgate for the transactions
+// of the session.
+func (sn *VTGateSession) SetTransactionMode(mode vtgatepb.TransactionMode) {
+	sn.session.TransactionMode = mode
+}
+
 // ExecuteBatch executes a list of queries on vtgate within the current transaction.
 func (sn *VTGateSession) ExecuteBatch(ctx context.Context, query []string, bindVars []map[string]*querypb.BindVariable) ([]sqltypes.QueryResponse, error) {
 	session, res, errs := sn.impl.ExecuteBatch(ctx, sn.session, query, bindVars)
@@ -235,6 +293,12 @@ func (sn *VTGateSession) StreamExecute(ctx context.Context, query string, bindVa
 	return sn.impl.StreamExecute(ctx, sn.session, query, bindVars)
 }
 
+// StreamExecuteWithOptions executes a streaming query on vtgate with
+// the options of the session merged with the given ones.
+func (sn *VTGateSession) StreamExecuteWithOptions(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error) {
+	return sn.impl.StreamExecute(ctx, sn.sessionWithOptions(options), query, bindVars)
+}
+
 // VTGateTx defines an ongoing transaction.
 // It should not be concurrently used across goroutines.
 type VTGateTx struct {
@@ -383,7 +447,7 @@ type Impl interface {
 	// SplitQuery splits a query into smaller queries. It is mostly used by batch job frameworks
 	// such as MapReduce. See the documentation for the vtgate.SplitQueryRequest protocol buffer
 	// message in 'proto/vtgate.proto'.
-	SplitQuery(ctx context.Context, keyspace string, query string, bindVars map[string]*querypb.BindVariable, splitColumns []string, splitCount int64, numRowsPerQueryPart int64, algorithm querypb.SplitQueryRequest_Algorithm) ([]*vtgatepb.SplitQueryResponse_Part, error)
+	SplitQuery(ctx context.Context, keyspace string, query string, bindVars map[string]*querypb.BindVariable, splitColumns []string, splitCount int64, numRowsPerQueryPart int64, algorithm querypb.SplitQueryRequest_Algorithm, cell string) ([]*vtgatepb.SplitQueryResponse_Part, error)
 
 	// GetSrvKeyspace returns a topo.SrvKeyspace.
 	GetSrvKeyspace(ctx context.Context, keyspace string) (*topodatapb.SrvKeyspace, error)
@@ -394,6 +458,9 @@ type Impl interface {
 	// UpdateStream asks for a stream of StreamEvent.
 	UpdateStream(ctx context.Context, keyspace string, shard string, keyRange *topodatapb.KeyRange, tabletType topodatapb.TabletType, timestamp int64, event *querypb.EventToken) (UpdateStreamReader, error)
 
+	// SetRuntimeFlags changes flags of vtgate without restarting it.
+	SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*vtgatepb.RuntimeFlagChange, error)
+
 	// Close must be called for releasing resources.
 	Close()
 }
@@ -19,7 +19,12 @@ package vtgateconn
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
 func TestRegisterDialer(t *testing.T) {
@@ -44,3 +49,64 @@ func TestGetDialerWithProtocol(t *testing.T) {
 		t.Fatalf("dialerFunc has been registered, should not get nil: %v %v", err, c)
 	}
 }
+
+// optionsImpl records the options of the sessions it receives.
+type optionsImpl struct {
+	Impl
+	options *querypb.ExecuteOptions
+}
+
+func (impl *optionsImpl) Execute(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (*vtgatepb.Session, *sqltypes.Result, error) {
+	impl.options = session.Options
+	return session, &sqltypes.Result{}, nil
+}
+
+func (impl *optionsImpl) StreamExecute(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (sqltypes.ResultStream, error) {
+	impl.options = session.Options
+	return nil, nil
+}
+
+func TestExecuteWithOptions(t *testing.T) {
+	impl := &optionsImpl{}
+	conn := &VTGateConn{impl: impl}
+	sessionOptions := &querypb.ExecuteOptions{
+		IncludedFields:       querypb.ExecuteOptions_TYPE_ONLY,
+		TransactionIsolation: querypb.ExecuteOptions_READ_COMMITTED,
+	}
+	sn := conn.Session("@rdonly", sessionOptions)
+	options := &querypb.ExecuteOptions{
+		Workload:             querypb.ExecuteOptions_OLAP,
+		TransactionIsolation: querypb.ExecuteOptions_SERIALIZABLE,
+	}
+	want := &querypb.ExecuteOptions{
+		IncludedFields:       querypb.ExecuteOptions_TYPE_ONLY,
+		Workload:             querypb.ExecuteOptions_OLAP,
+		TransactionIsolation: querypb.ExecuteOptions_SERIALIZABLE,
+	}
+
+	if _, err := sn.ExecuteWithOptions(context.Background(), "select 1", nil, options); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(impl.options, want) {
+		t.Errorf("ExecuteWithOptions: got options %v, want %v", impl.options, want)
+	}
+	if _, err := sn.Execute(context.Background(), "select 1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if impl.options != sessionOptions {
+		t.Errorf("Execute: got options %v, want %v", impl.options, sessionOptions)
+	}
+
+	if _, err := sn.StreamExecuteWithOptions(context.Background(), "select 1", nil, options); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(impl.options, want) {
+		t.Errorf("StreamExecuteWithOptions: got options %v, want %v", impl.options, want)
+	}
+	if !proto.Equal(sessionOptions, &querypb.ExecuteOptions{
+		IncludedFields:       querypb.ExecuteOptions_TYPE_ONLY,
+		TransactionIsolation: querypb.ExecuteOptions_READ_COMMITTED,
+	}) {
+		t.Errorf("the options of the session were modified: %v", sessionOptions)
+	}
+}
@@ -769,6 +769,21 @@ func (f *fakeVTGateService) ResolveTransaction(ctx context.Context, dtid string)
 	return nil
 }
 
+// SetRuntimeFlags is part of the VTGateService interface
+func (f *fakeVTGateService) SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*vtgatepb.RuntimeFlagChange, error) {
+	if f.hasError {
+		return nil, errTestVtGateError
+	}
+	if f.panics {
+		panic(fmt.Errorf("test forced panic"))
+	}
+	f.checkCallerID(ctx, "SetRuntimeFlags")
+	if !reflect.DeepEqual(flags, setRuntimeFlagsFlags) {
+		f.t.Errorf("SetRuntimeFlags has wrong input: got %v wanted %v", flags, setRuntimeFlagsFlags)
+	}
+	return setRuntimeFlagsResult, nil
+}
+
 func (f *fakeVTGateService) MessageStream(ctx context.Context, keyspace string, shard string, keyRange *topodatapb.KeyRange, name string, callback func(*sqltypes.Result) error) error {
 	if f.hasError {
 		return errTestVtGateError
@@ -827,6 +842,7 @@ type querySplitQuery struct {
 	SplitCount          int64
 	NumRowsPerQueryPart int64
 	Algorithm           querypb.SplitQueryRequest_Algorithm
+	Cell                string
 }
 
 func (q *querySplitQuery) equal(q2 *querySplitQuery) bool {
@@ -836,7 +852,8 @@ func (q *querySplitQuery) equal(q2 *querySplitQuery) bool {
 		reflect.DeepEqual(q.SplitColumns, q2.SplitColumns) &&
 		q.SplitCount == q2.SplitCount &&
 		q.NumRowsPerQueryPart == q2.NumRowsPerQueryPart &&
-		q.Algorithm == q2.Algorithm
+		q.Algorithm == q2.Algorithm &&
+		q.Cell == q2.Cell
 }
 
 // SplitQuery is part of the VTGateService interface
@@ -848,7 +865,8 @@ func (f *fakeVTGateService) SplitQuery(
 	splitColumns []string,
 	splitCount int64,
 	numRowsPerQueryPart int64,
-	algorithm querypb.SplitQueryRequest_Algorithm) ([]*vtgatepb.SplitQueryResponse_Part, error) {
+	algorithm querypb.SplitQueryRequest_Algorithm,
+	cell string) ([]*vtgatepb.SplitQueryResponse_Part, error) {
 	if f.hasError {
 		return nil, errTestVtGateError
 	}
@@ -864,6 +882,7 @@ func (f *fakeVTGateService) SplitQuery(
 		SplitCount:          splitCount,
 		NumRowsPerQueryPart: numRowsPerQueryPart,
 		Algorithm:           algorithm,
+		Cell:                cell,
 	}
 	if !query.equal(splitQueryRequest) {
 		f.t.Errorf("SplitQuery has wrong input: got %#v wanted %#v", query, splitQueryRequest)
@@ -1025,6 +1044,7 @@ func TestSuite(t *testing.T, impl vtgateconn.Impl, fakeServer vtgateservice.VTGa
 	testMessageAckKeyspaceIds(t, conn)
 	testSplitQuery(t, conn)
 	testGetSrvKeyspace(t, conn)
+	testSetRuntimeFlags(t, conn)
 	testUpdateStream(t, conn)
 
 	// force a panic at every call, then test that works
@@ -1050,6 +1070,7 @@ func TestSuite(t *testing.T, impl vtgateconn.Impl, fakeServer vtgateservice.VTGa
 	testMessageAckKeyspaceIdsPanic(t, conn)
 	testSplitQueryPanic(t, conn)
 	testGetSrvKeyspacePanic(t, conn)
+	testSetRuntimeFlagsPanic(t, conn)
 	testUpdateStreamPanic(t, conn)
 	fs.panics = false
 }
@@ -1087,6 +1108,7 @@ func TestErrorSuite(t *testing.T, fakeServer vtgateservice.VTGateService) {
 	testMessageAckKeyspaceIdsError(t, conn)
 	testSplitQueryError(t, conn)
 	testGetSrvKeyspaceError(t, conn)
+	testSetRuntimeFlagsError(t, conn)
 	testUpdateStreamError(t, conn, fs)
 	fs.hasError = false
 }
@@ -2051,6 +2073,7 @@ func testSplitQuery(t *testing.T, conn *vtgateconn.VTGateConn) {
 		splitQueryRequest.SplitCount,
 		splitQueryRequest.NumRowsPerQueryPart,
 		splitQueryRequest.Algorithm,
+		splitQueryRequest.Cell,
 	)
 	if err != nil {
 		t.Fatalf("SplitQuery failed: %v", err)
@@ -2070,6 +2093,7 @@ func testSplitQueryError(t *testing.T, conn *vtgateconn.VTGateConn) {
 		splitQueryRequest.SplitCount,
 		splitQueryRequest.NumRowsPerQueryPart,
 		splitQueryRequest.Algorithm,
+		splitQueryRequest.Cell,
 	)
 	verifyError(t, err, "SplitQuery")
 }
@@ -2084,6 +2108,7 @@ func testSplitQueryPanic(t *testing.T, conn *vtgateconn.VTGateConn) {
 		splitQueryRequest.SplitCount,
 		splitQueryRequest.NumRowsPerQueryPart,
 		splitQueryRequest.Algorithm,
+		splitQueryRequest.Cell,
 	)
 	expectPanic(t, err)
 }
@@ -2111,6 +2136,29 @@ func testGetSrvKeyspacePanic(t *testing.T, conn *vtgateconn.VTGateConn) {
 	expectPanic(t, err)
 }
 
+func testSetRuntimeFlags(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	changes, err := conn.SetRuntimeFlags(ctx, setRuntimeFlagsFlags)
+	if err != nil {
+		t.Fatalf("SetRuntimeFlags failed: %v", err)
+	}
+	if !proto.Equal(&vtgatepb.SetRuntimeFlagsResponse{Changes: changes}, &vtgatepb.SetRuntimeFlagsResponse{Changes: setRuntimeFlagsResult}) {
+		t.Errorf("SetRuntimeFlags returned wrong result: got %+v wanted %+v", changes, setRuntimeFlagsResult)
+	}
+}
+
+func testSetRuntimeFlagsError(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	_, err := conn.SetRuntimeFlags(ctx, setRuntimeFlagsFlags)
+	verifyErrorString(t, err, "SetRuntimeFlags")
+}
+
+func testSetRuntimeFlagsPanic(t *testing.T, conn *vtgateconn.VTGateConn) {
+	ctx := newContext()
+	_, err := conn.SetRuntimeFlags(ctx, setRuntimeFlagsFlags)
+	expectPanic(t, err)
+}
+
 func testUpdateStream(t *testing.T, conn *vtgateconn.VTGateConn) {
 	ctx := newContext()
 	execCase := execMap["request1"]
@@ -2653,6 +2701,7 @@ var splitQueryRequest = &querySplitQuery{
 	SplitCount:          145,
 	NumRowsPerQueryPart: 4000,
 	Algorithm:           querypb.SplitQueryRequest_FULL_SCAN,
+	Cell:                "cell2",
 }
 
 var splitQueryResult = []*vtgatepb.SplitQueryResponse_Part{
@@ -2678,6 +2727,16 @@ var splitQueryResult = []*vtgatepb.SplitQueryResponse_Part{
 
 var getSrvKeyspaceKeyspace = "test_keyspace"
 
+var setRuntimeFlagsFlags = map[string]string{
+	"max_memory_rows":  "1000",
+	"warn_memory_rows": "100",
+}
+
+var setRuntimeFlagsResult = []*vtgatepb.RuntimeFlagChange{
+	{Name: "max_memory_rows", OldValue: "300000", NewValue: "1000"},
+	{Name: "warn_memory_rows", OldValue: "30000", NewValue: "100"},
+}
+
 var getSrvKeyspaceResult = &topodatapb.SrvKeyspace{
 	Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{
 		{
@@ -280,16 +280,16 @@ func (mr *MockVTGateServiceMockRecorder) MessageAckKeyspaceIds(ctx, keyspace, na
 }
 
 // SplitQuery mocks base method
-func (m *MockVTGateService) SplitQuery(ctx context.Context, keyspace, sql string, bindVariables map[string]*query.BindVariable, splitColumns []string, splitCount, numRowsPerQueryPart int64, algorithm query.SplitQueryRequest_Algorithm) ([]*vtgate.SplitQueryResponse_Part, error) {
-	ret := m.ctrl.Call(m, "SplitQuery", ctx, keyspace, sql, bindVariables, splitColumns, splitCount, numRowsPerQueryPart, algorithm)
+func (m *MockVTGateService) SplitQuery(ctx context.Context, keyspace, sql string, bindVariables map[string]*query.BindVariable, splitColumns []string, splitCount, numRowsPerQueryPart int64, algorithm query.SplitQueryRequest_Algorithm, cell string) ([]*vtgate.SplitQueryResponse_Part, error) {
+	ret := m.ctrl.Call(m, "SplitQuery", ctx, keyspace, sql, bindVariables, splitColumns, splitCount, numRowsPerQueryPart, algorithm, cell)
 	ret0, _ := ret[0].([]*vtgate.SplitQueryResponse_Part)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SplitQuery indicates an expected call of SplitQuery
-func (mr *MockVTGateServiceMockRecorder) SplitQuery(ctx, keyspace, sql, bindVariables, splitColumns, splitCount, numRowsPerQueryPart, algorithm interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitQuery", reflect.TypeOf((*MockVTGateService)(nil).SplitQuery), ctx, keyspace, sql, bindVariables, splitColumns, splitCount, numRowsPerQueryPart, algorithm)
+func (mr *MockVTGateServiceMockRecorder) SplitQuery(ctx, keyspace, sql, bindVariables, splitColumns, splitCount, numRowsPerQueryPart, algorithm, cell interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitQuery", reflect.TypeOf((*MockVTGateService)(nil).SplitQuery), ctx, keyspace, sql, bindVariables, splitColumns, splitCount, numRowsPerQueryPart, algorithm, cell)
 }
 
 // GetSrvKeyspace mocks base method
@@ -305,6 +305,19 @@ func (mr *MockVTGateServiceMockRecorder) GetSrvKeyspace(ctx, keyspace interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSrvKeyspace", reflect.TypeOf((*MockVTGateService)(nil).GetSrvKeyspace), ctx, keyspace)
 }
 
+// SetRuntimeFlags mocks base method
+func (m *MockVTGateService) SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*vtgate.RuntimeFlagChange, error) {
+	ret := m.ctrl.Call(m, "SetRuntimeFlags", ctx, flags)
+	ret0, _ := ret[0].([]*vtgate.RuntimeFlagChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRuntimeFlags indicates an expected call of SetRuntimeFlags
+func (mr *MockVTGateServiceMockRecorder) SetRuntimeFlags(ctx, flags interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRuntimeFlags", reflect.TypeOf((*MockVTGateService)(nil).SetRuntimeFlags), ctx, flags)
+}
+
 // UpdateStream mocks base method
 func (m *MockVTGateService) UpdateStream(ctx context.Context, keyspace, shard string, keyRange *topodata.KeyRange, tabletType topodata.TabletType, timestamp int64, event *query.EventToken, callback func(*query.StreamEvent, int64) error) error {
 	ret := m.ctrl.Call(m, "UpdateStream", ctx, keyspace, shard, keyRange, tabletType, timestamp, event, callback)
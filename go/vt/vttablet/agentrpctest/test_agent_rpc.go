@@ -473,6 +473,215 @@ func agentRPCTestRefreshStatePanic(ctx context.Context, t *testing.T, client tmc
 	expectHandleRPCPanic(t, "RefreshState", true /*verbose*/, err)
 }
 
+var testPools = []*tabletmanagerdatapb.PoolInfo{
+	{
+		Name:          "conn",
+		Capacity:      16,
+		MaxCapacity:   32,
+		IdleTimeoutMs: 1800000,
+		Available:     12,
+		Active:        6,
+		InUse:         4,
+		WaitCount:     3,
+		WaitTimeMs:    150,
+	},
+	{
+		Name:        "transaction",
+		Capacity:    20,
+		MaxCapacity: 20,
+	},
+}
+
+func (fra *fakeRPCAgent) GetPools(ctx context.Context) ([]*tabletmanagerdatapb.PoolInfo, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testPools, nil
+}
+
+func agentRPCTestGetPools(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	pools, err := client.GetPools(ctx, tablet)
+	compareError(t, "GetPools", err, pools, testPools)
+}
+
+func agentRPCTestGetPoolsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetPools(ctx, tablet)
+	expectHandleRPCPanic(t, "GetPools", false /*verbose*/, err)
+}
+
+var (
+	testResizePoolName               = "conn"
+	testResizePoolCapacity           = 24
+	testResizePoolIdleTimeout        = 10 * time.Minute
+	testResizePoolPrefillParallelism = 4
+)
+
+func (fra *fakeRPCAgent) ResizePool(ctx context.Context, name string, capacity int, idleTimeout time.Duration, prefillParallelism int) (*tabletmanagerdatapb.PoolInfo, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ResizePool name", name, testResizePoolName)
+	compare(fra.t, "ResizePool capacity", capacity, testResizePoolCapacity)
+	compare(fra.t, "ResizePool idleTimeout", idleTimeout, testResizePoolIdleTimeout)
+	compare(fra.t, "ResizePool prefillParallelism", prefillParallelism, testResizePoolPrefillParallelism)
+	return testPools[0], nil
+}
+
+func agentRPCTestResizePool(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	pool, err := client.ResizePool(ctx, tablet, testResizePoolName, testResizePoolCapacity, testResizePoolIdleTimeout, testResizePoolPrefillParallelism)
+	compareError(t, "ResizePool", err, pool, testPools[0])
+}
+
+func agentRPCTestResizePoolPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ResizePool(ctx, tablet, testResizePoolName, testResizePoolCapacity, testResizePoolIdleTimeout, testResizePoolPrefillParallelism)
+	expectHandleRPCPanic(t, "ResizePool", true /*verbose*/, err)
+}
+
+var testLockDiagnostics = &tabletmanagerdatapb.LockDiagnostics{
+	CaptureTime: 1500000000,
+	Automatic:   true,
+	LockWaits: []*tabletmanagerdatapb.LockWait{{
+		WaitingThreadId:    12,
+		WaitingQuery:       "update t1 set c = 2 where id = 1",
+		WaitingFingerprint: "0123456789abcdef",
+		WaitTimeSeconds:    5,
+		BlockingThreadId:   10,
+		LockedTable:        "`vt_test_keyspace`.`t1`",
+		LockedIndex:        "PRIMARY",
+		LockMode:           "X",
+	}},
+	LatestDeadlock: "*** (1) TRANSACTION:",
+}
+
+func (fra *fakeRPCAgent) GetLockDiagnostics(ctx context.Context, lastAutomatic bool) (*tabletmanagerdatapb.LockDiagnostics, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "GetLockDiagnostics lastAutomatic", lastAutomatic, true)
+	return testLockDiagnostics, nil
+}
+
+func agentRPCTestGetLockDiagnostics(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	diagnostics, err := client.GetLockDiagnostics(ctx, tablet, true)
+	compareError(t, "GetLockDiagnostics", err, diagnostics, testLockDiagnostics)
+}
+
+func agentRPCTestGetLockDiagnosticsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetLockDiagnostics(ctx, tablet, true)
+	expectHandleRPCPanic(t, "GetLockDiagnostics", false /*verbose*/, err)
+}
+
+var testQueryPlanStats = []*tabletmanagerdatapb.QueryPlanStats{{
+	Query:        "select * from t1 where id = :vtg1",
+	Fingerprint:  "0123456789abcdef",
+	Table:        "t1",
+	Plan:         "PASS_SELECT",
+	QueryCount:   10,
+	RowCount:     8,
+	ErrorCount:   1,
+	TimeNs:       30000000,
+	MysqlTimeNs:  20000000,
+	P50LatencyNs: 2500000,
+	P95LatencyNs: 5000000,
+	P99LatencyNs: 10000000,
+}}
+
+func (fra *fakeRPCAgent) GetQueryPlanStats(ctx context.Context) ([]*tabletmanagerdatapb.QueryPlanStats, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testQueryPlanStats, nil
+}
+
+func agentRPCTestGetQueryPlanStats(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	stats, err := client.GetQueryPlanStats(ctx, tablet)
+	compareError(t, "GetQueryPlanStats", err, stats, testQueryPlanStats)
+}
+
+func agentRPCTestGetQueryPlanStatsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetQueryPlanStats(ctx, tablet)
+	expectHandleRPCPanic(t, "GetQueryPlanStats", false /*verbose*/, err)
+}
+
+var testQueryBlocklist = []*tabletmanagerdatapb.QueryBlocklistEntry{{
+	Fingerprint: "0123456789abcdef",
+	Query:       "select * from t1 where id = :vtg1",
+	State:       "blocked",
+	Manual:      true,
+	Reason:      "testing",
+	Since:       1500000000,
+}}
+
+func (fra *fakeRPCAgent) GetQueryBlocklist(ctx context.Context) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testQueryBlocklist, nil
+}
+
+func agentRPCTestGetQueryBlocklist(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	entries, err := client.GetQueryBlocklist(ctx, tablet)
+	compareError(t, "GetQueryBlocklist", err, entries, testQueryBlocklist)
+}
+
+func agentRPCTestGetQueryBlocklistPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetQueryBlocklist(ctx, tablet)
+	expectHandleRPCPanic(t, "GetQueryBlocklist", false /*verbose*/, err)
+}
+
+func (fra *fakeRPCAgent) UpdateQueryBlocklist(ctx context.Context, action, fingerprint, reason string) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "UpdateQueryBlocklist action", action, "block")
+	compare(fra.t, "UpdateQueryBlocklist fingerprint", fingerprint, testQueryBlocklist[0].Fingerprint)
+	compare(fra.t, "UpdateQueryBlocklist reason", reason, testQueryBlocklist[0].Reason)
+	return testQueryBlocklist, nil
+}
+
+func agentRPCTestUpdateQueryBlocklist(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	entries, err := client.UpdateQueryBlocklist(ctx, tablet, "block", testQueryBlocklist[0].Fingerprint, testQueryBlocklist[0].Reason)
+	compareError(t, "UpdateQueryBlocklist", err, entries, testQueryBlocklist)
+}
+
+func agentRPCTestUpdateQueryBlocklistPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.UpdateQueryBlocklist(ctx, tablet, "block", testQueryBlocklist[0].Fingerprint, testQueryBlocklist[0].Reason)
+	expectHandleRPCPanic(t, "UpdateQueryBlocklist", true /*verbose*/, err)
+}
+
+var testRuntimeFlags = map[string]string{
+	"queryserver-config-pool-size":     "20",
+	"queryserver-config-query-timeout": "15",
+}
+
+var testRuntimeFlagChanges = []*tabletmanagerdatapb.RuntimeFlagChange{{
+	Name:     "queryserver-config-pool-size",
+	OldValue: "16",
+	NewValue: "20",
+}, {
+	Name:     "queryserver-config-query-timeout",
+	OldValue: "30",
+	NewValue: "15",
+}}
+
+func (fra *fakeRPCAgent) SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*tabletmanagerdatapb.RuntimeFlagChange, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetRuntimeFlags flags", flags, testRuntimeFlags)
+	return testRuntimeFlagChanges, nil
+}
+
+func agentRPCTestSetRuntimeFlags(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	changes, err := client.SetRuntimeFlags(ctx, tablet, testRuntimeFlags)
+	compareError(t, "SetRuntimeFlags", err, changes, testRuntimeFlagChanges)
+}
+
+func agentRPCTestSetRuntimeFlagsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.SetRuntimeFlags(ctx, tablet, testRuntimeFlags)
+	expectHandleRPCPanic(t, "SetRuntimeFlags", true /*verbose*/, err)
+}
+
 func (fra *fakeRPCAgent) RunHealthCheck(ctx context.Context) {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
@@ -1264,6 +1473,13 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	agentRPCTestSleep(ctx, t, client, tablet)
 	agentRPCTestExecuteHook(ctx, t, client, tablet)
 	agentRPCTestRefreshState(ctx, t, client, tablet)
+	agentRPCTestGetPools(ctx, t, client, tablet)
+	agentRPCTestResizePool(ctx, t, client, tablet)
+	agentRPCTestGetLockDiagnostics(ctx, t, client, tablet)
+	agentRPCTestGetQueryPlanStats(ctx, t, client, tablet)
+	agentRPCTestGetQueryBlocklist(ctx, t, client, tablet)
+	agentRPCTestUpdateQueryBlocklist(ctx, t, client, tablet)
+	agentRPCTestSetRuntimeFlags(ctx, t, client, tablet)
 	agentRPCTestRunHealthCheck(ctx, t, client, tablet)
 	agentRPCTestIgnoreHealthError(ctx, t, client, tablet)
 	agentRPCTestReloadSchema(ctx, t, client, tablet)
@@ -1319,6 +1535,13 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	agentRPCTestSleepPanic(ctx, t, client, tablet)
 	agentRPCTestExecuteHookPanic(ctx, t, client, tablet)
 	agentRPCTestRefreshStatePanic(ctx, t, client, tablet)
+	agentRPCTestGetPoolsPanic(ctx, t, client, tablet)
+	agentRPCTestResizePoolPanic(ctx, t, client, tablet)
+	agentRPCTestGetLockDiagnosticsPanic(ctx, t, client, tablet)
+	agentRPCTestGetQueryPlanStatsPanic(ctx, t, client, tablet)
+	agentRPCTestGetQueryBlocklistPanic(ctx, t, client, tablet)
+	agentRPCTestUpdateQueryBlocklistPanic(ctx, t, client, tablet)
+	agentRPCTestSetRuntimeFlagsPanic(ctx, t, client, tablet)
 	agentRPCTestRunHealthCheckPanic(ctx, t, client, tablet)
 	agentRPCTestIgnoreHealthErrorPanic(ctx, t, client, tablet)
 	agentRPCTestReloadSchemaPanic(ctx, t, client, tablet)
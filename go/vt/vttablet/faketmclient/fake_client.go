@@ -127,6 +127,41 @@ func (client *FakeTabletManagerClient) RefreshState(ctx context.Context, tablet
 	return nil
 }
 
+// GetPools is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetPools(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.PoolInfo, error) {
+	return nil, nil
+}
+
+// ResizePool is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ResizePool(ctx context.Context, tablet *topodatapb.Tablet, name string, capacity int, idleTimeout time.Duration, prefillParallelism int) (*tabletmanagerdatapb.PoolInfo, error) {
+	return &tabletmanagerdatapb.PoolInfo{}, nil
+}
+
+// GetLockDiagnostics is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetLockDiagnostics(ctx context.Context, tablet *topodatapb.Tablet, lastAutomatic bool) (*tabletmanagerdatapb.LockDiagnostics, error) {
+	return &tabletmanagerdatapb.LockDiagnostics{}, nil
+}
+
+// GetQueryPlanStats is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetQueryPlanStats(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryPlanStats, error) {
+	return nil, nil
+}
+
+// GetQueryBlocklist is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetQueryBlocklist(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	return nil, nil
+}
+
+// UpdateQueryBlocklist is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) UpdateQueryBlocklist(ctx context.Context, tablet *topodatapb.Tablet, action, fingerprint, reason string) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	return nil, nil
+}
+
+// SetRuntimeFlags is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetRuntimeFlags(ctx context.Context, tablet *topodatapb.Tablet, flags map[string]string) ([]*tabletmanagerdatapb.RuntimeFlagChange, error) {
+	return nil, nil
+}
+
 // RunHealthCheck is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return nil
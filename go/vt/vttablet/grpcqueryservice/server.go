@@ -128,6 +128,20 @@ func (q *query) Rollback(ctx context.Context, request *querypb.RollbackRequest)
 	return &querypb.RollbackResponse{}, nil
 }
 
+// Release is part of the queryservice.QueryServer interface
+func (q *query) Release(ctx context.Context, request *querypb.ReleaseRequest) (response *querypb.ReleaseResponse, err error) {
+	defer q.server.HandlePanic(&err)
+	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
+		request.EffectiveCallerId,
+		request.ImmediateCallerId,
+	)
+	if err := q.server.Release(ctx, request.Target, request.ReservedId); err != nil {
+		return nil, vterrors.ToGRPC(err)
+	}
+
+	return &querypb.ReleaseResponse{}, nil
+}
+
 // Prepare is part of the queryservice.QueryServer interface
 func (q *query) Prepare(ctx context.Context, request *querypb.PrepareRequest) (response *querypb.PrepareResponse, err error) {
 	defer q.server.HandlePanic(&err)
@@ -42,7 +42,7 @@ var (
 	cert = flag.String("tablet_grpc_cert", "", "the cert to use to connect")
 	key  = flag.String("tablet_grpc_key", "", "the key to use to connect")
 	ca   = flag.String("tablet_grpc_ca", "", "the server ca to use to validate servers when connecting")
-	name = flag.String("tablet_grpc_server_name", "", "the server name to use to validate server certificate")
+	name = flag.String("tablet_grpc_server_name", "", "the server name to use to validate server certificate, or the SPIFFE ID or trust domain of the server (like spiffe://example.org/vttablet), which requires a ca")
 )
 
 func init() {
@@ -259,6 +259,27 @@ func (conn *gRPCQueryClient) Rollback(ctx context.Context, target *querypb.Targe
 	return nil
 }
 
+// Release releases the reserved connection.
+func (conn *gRPCQueryClient) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	conn.mu.RLock()
+	defer conn.mu.RUnlock()
+	if conn.cc == nil {
+		return tabletconn.ConnClosed
+	}
+
+	req := &querypb.ReleaseRequest{
+		Target:            target,
+		EffectiveCallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
+		ReservedId:        reservedID,
+	}
+	_, err := conn.c.Release(ctx, req)
+	if err != nil {
+		return tabletconn.ErrorFromGRPC(err)
+	}
+	return nil
+}
+
 // Prepare executes a Prepare on the ongoing transaction.
 func (conn *gRPCQueryClient) Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) error {
 	conn.mu.RLock()
@@ -46,7 +46,7 @@ var (
 	cert        = flag.String("tablet_manager_grpc_cert", "", "the cert to use to connect")
 	key         = flag.String("tablet_manager_grpc_key", "", "the key to use to connect")
 	ca          = flag.String("tablet_manager_grpc_ca", "", "the server ca to use to validate servers when connecting")
-	name        = flag.String("tablet_manager_grpc_server_name", "", "the server name to use to validate server certificate")
+	name        = flag.String("tablet_manager_grpc_server_name", "", "the server name to use to validate server certificate, or the SPIFFE ID or trust domain of the server (like spiffe://example.org/vttablet), which requires a ca")
 )
 
 func init() {
@@ -266,6 +266,122 @@ func (client *Client) RefreshState(ctx context.Context, tablet *topodatapb.Table
 	return err
 }
 
+// GetPools is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetPools(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.PoolInfo, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetPools(ctx, &tabletmanagerdatapb.GetPoolsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Pools, nil
+}
+
+// ResizePool is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ResizePool(ctx context.Context, tablet *topodatapb.Tablet, name string, capacity int, idleTimeout time.Duration, prefillParallelism int) (*tabletmanagerdatapb.PoolInfo, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.ResizePool(ctx, &tabletmanagerdatapb.ResizePoolRequest{
+		Name:               name,
+		Capacity:           int64(capacity),
+		IdleTimeoutMs:      int64(idleTimeout / time.Millisecond),
+		PrefillParallelism: int64(prefillParallelism),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Pool, nil
+}
+
+// GetLockDiagnostics is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetLockDiagnostics(ctx context.Context, tablet *topodatapb.Tablet, lastAutomatic bool) (*tabletmanagerdatapb.LockDiagnostics, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetLockDiagnostics(ctx, &tabletmanagerdatapb.GetLockDiagnosticsRequest{
+		LastAutomatic: lastAutomatic,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Diagnostics, nil
+}
+
+// GetQueryPlanStats is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetQueryPlanStats(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryPlanStats, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetQueryPlanStats(ctx, &tabletmanagerdatapb.GetQueryPlanStatsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Stats, nil
+}
+
+// GetQueryBlocklist is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetQueryBlocklist(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.GetQueryBlocklist(ctx, &tabletmanagerdatapb.GetQueryBlocklistRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Entries, nil
+}
+
+// UpdateQueryBlocklist is part of the tmclient.TabletManagerClient interface.
+func (client *Client) UpdateQueryBlocklist(ctx context.Context, tablet *topodatapb.Tablet, action, fingerprint, reason string) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.UpdateQueryBlocklist(ctx, &tabletmanagerdatapb.UpdateQueryBlocklistRequest{
+		Action:      action,
+		Fingerprint: fingerprint,
+		Reason:      reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Entries, nil
+}
+
+// SetRuntimeFlags is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetRuntimeFlags(ctx context.Context, tablet *topodatapb.Tablet, flags map[string]string) ([]*tabletmanagerdatapb.RuntimeFlagChange, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	request := &tabletmanagerdatapb.SetRuntimeFlagsRequest{}
+	for name, value := range flags {
+		request.Flags = append(request.Flags, &tabletmanagerdatapb.RuntimeFlag{
+			Name:  name,
+			Value: value,
+		})
+	}
+	response, err := c.SetRuntimeFlags(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return response.Changes, nil
+}
+
 // RunHealthCheck is part of the tmclient.TabletManagerClient interface.
 func (client *Client) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error {
 	cc, c, err := client.dial(tablet)
@@ -127,6 +127,87 @@ func (s *server) RefreshState(ctx context.Context, request *tabletmanagerdatapb.
 	return response, s.agent.RefreshState(ctx)
 }
 
+func (s *server) GetPools(ctx context.Context, request *tabletmanagerdatapb.GetPoolsRequest) (response *tabletmanagerdatapb.GetPoolsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetPools", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetPoolsResponse{}
+	pools, err := s.agent.GetPools(ctx)
+	if err == nil {
+		response.Pools = pools
+	}
+	return response, err
+}
+
+func (s *server) ResizePool(ctx context.Context, request *tabletmanagerdatapb.ResizePoolRequest) (response *tabletmanagerdatapb.ResizePoolResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "ResizePool", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ResizePoolResponse{}
+	pool, err := s.agent.ResizePool(ctx, request.Name, int(request.Capacity), time.Duration(request.IdleTimeoutMs)*time.Millisecond, int(request.PrefillParallelism))
+	if err == nil {
+		response.Pool = pool
+	}
+	return response, err
+}
+
+func (s *server) GetLockDiagnostics(ctx context.Context, request *tabletmanagerdatapb.GetLockDiagnosticsRequest) (response *tabletmanagerdatapb.GetLockDiagnosticsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetLockDiagnostics", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetLockDiagnosticsResponse{}
+	diagnostics, err := s.agent.GetLockDiagnostics(ctx, request.LastAutomatic)
+	if err == nil {
+		response.Diagnostics = diagnostics
+	}
+	return response, err
+}
+
+func (s *server) GetQueryPlanStats(ctx context.Context, request *tabletmanagerdatapb.GetQueryPlanStatsRequest) (response *tabletmanagerdatapb.GetQueryPlanStatsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetQueryPlanStats", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetQueryPlanStatsResponse{}
+	stats, err := s.agent.GetQueryPlanStats(ctx)
+	if err == nil {
+		response.Stats = stats
+	}
+	return response, err
+}
+
+func (s *server) GetQueryBlocklist(ctx context.Context, request *tabletmanagerdatapb.GetQueryBlocklistRequest) (response *tabletmanagerdatapb.GetQueryBlocklistResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "GetQueryBlocklist", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetQueryBlocklistResponse{}
+	entries, err := s.agent.GetQueryBlocklist(ctx)
+	if err == nil {
+		response.Entries = entries
+	}
+	return response, err
+}
+
+func (s *server) UpdateQueryBlocklist(ctx context.Context, request *tabletmanagerdatapb.UpdateQueryBlocklistRequest) (response *tabletmanagerdatapb.UpdateQueryBlocklistResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "UpdateQueryBlocklist", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.UpdateQueryBlocklistResponse{}
+	entries, err := s.agent.UpdateQueryBlocklist(ctx, request.Action, request.Fingerprint, request.Reason)
+	if err == nil {
+		response.Entries = entries
+	}
+	return response, err
+}
+
+func (s *server) SetRuntimeFlags(ctx context.Context, request *tabletmanagerdatapb.SetRuntimeFlagsRequest) (response *tabletmanagerdatapb.SetRuntimeFlagsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "SetRuntimeFlags", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetRuntimeFlagsResponse{}
+	flags := make(map[string]string, len(request.Flags))
+	for _, flag := range request.Flags {
+		flags[flag.Name] = flag.Value
+	}
+	changes, err := s.agent.SetRuntimeFlags(ctx, flags)
+	if err == nil {
+		response.Changes = changes
+	}
+	return response, err
+}
+
 func (s *server) RunHealthCheck(ctx context.Context, request *tabletmanagerdatapb.RunHealthCheckRequest) (response *tabletmanagerdatapb.RunHealthCheckResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "RunHealthCheck", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
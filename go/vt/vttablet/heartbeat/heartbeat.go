@@ -24,6 +24,8 @@ limitations under the License.
 //
 // The data collected by the heartbeat package is made available in /debug/vars in counters prefixed by Heartbeat*.
 // It's additionally used as a source for healthchecks and will impact the serving state of a tablet, if enabled.
+// It then replaces the Seconds_Behind_Master of SHOW SLAVE STATUS as the replication lag reported in the
+// health stream, which vtgate uses for routing and the throttlers use to throttle.
 // The heartbeat interval is purposefully kept distinct from the health check interval because lag measurement
 // requires more frequent polling that the healthcheck typically is configured for.
 package heartbeat
@@ -0,0 +1,260 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package innodbhealth watches the purge of the InnoDB undo logs on a
+// master, and throttles the new write transactions when it falls behind.
+//
+// The undo logs of the committed transactions are kept until no read
+// view needs them anymore, and then purged in the background. A long
+// running transaction or a heavy write load makes the history list of
+// the undo logs grow, and a long history list slows down every query,
+// which can make the purge fall further behind. The monitor reads the
+// history list length and the purge lag, which is the number of
+// transactions not purged yet, from the InnoDB status. Above their
+// threshold, a fraction of the write transactions is rejected, which
+// grows with the excess: all of them are rejected at twice the
+// threshold.
+package innodbhealth
+
+import (
+	"math/rand"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const sqlInnoDBStatus = "show engine innodb status"
+
+var (
+	// The lines of the TRANSACTIONS section of the InnoDB status, on
+	// MySQL and MariaDB.
+	trxIDCounterRE      = regexp.MustCompile(`(?m)^Trx id counter (\d+)`)
+	purgeDoneRE         = regexp.MustCompile(`(?m)^Purge done for trx's n:o < (\d+)`)
+	historyListLengthRE = regexp.MustCompile(`(?m)^History list length (\d+)`)
+)
+
+var (
+	historyListLength     = stats.NewGauge("InnoDBHistoryListLength", "The length of the InnoDB history list, in undo logs not purged yet")
+	purgeLag              = stats.NewGauge("InnoDBPurgeLag", "The number of InnoDB transactions whose undo logs are not purged yet")
+	throttlePercent       = stats.NewGauge("InnoDBThrottlePercent", "The percentage of the new write transactions rejected because the InnoDB purge is behind")
+	throttledTransactions = stats.NewCounter("InnoDBThrottledTransactions", "Count of the write transactions rejected because the InnoDB purge is behind")
+	healthErrors          = stats.NewCounter("InnoDBHealthErrors", "Count of errors encountered while reading the InnoDB status")
+)
+
+// Monitor periodically reads the history list length and the purge lag
+// of InnoDB, and decides which write transactions are throttled.
+type Monitor struct {
+	dbconfigs *dbconfigs.DBConfigs
+
+	enabled          bool
+	interval         time.Duration
+	maxHistoryLength int64
+	maxPurgeLag      int64
+	errorLog         *logutil.ThrottledLogger
+
+	mu     sync.Mutex
+	isOpen bool
+	pool   *connpool.Pool
+	ticks  *timer.Timer
+
+	// percent is the percentage of the write transactions throttled.
+	percent sync2.AtomicInt64
+}
+
+// NewMonitor creates a new Monitor. It's disabled if none of the
+// thresholds is set.
+func NewMonitor(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *Monitor {
+	if config.InnoDBMaxHistoryListLength <= 0 && config.InnoDBMaxPurgeLag <= 0 {
+		return &Monitor{}
+	}
+	return &Monitor{
+		enabled:          true,
+		interval:         config.InnoDBHealthCheckInterval,
+		maxHistoryLength: config.InnoDBMaxHistoryListLength,
+		maxPurgeLag:      config.InnoDBMaxPurgeLag,
+		errorLog:         logutil.NewThrottledLogger("InnoDBHealth", 60*time.Second),
+		pool:             connpool.New(config.PoolNamePrefix+"InnoDBHealthPool", 1, 0, time.Duration(config.IdleTimeout*1e9), checker),
+		ticks:            timer.NewTimer(config.InnoDBHealthCheckInterval),
+	}
+}
+
+// InitDBConfig must be called before Open.
+func (m *Monitor) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	m.dbconfigs = dbcfgs
+}
+
+// Open sets up the db connection of the Monitor and launches the ticker
+// that reads the InnoDB status. Open may be called multiple times, as
+// long as it was closed since last invocation.
+func (m *Monitor) Open() {
+	if !m.enabled {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.isOpen {
+		return
+	}
+	log.Info("Beginning InnoDB health checks")
+	// The InnoDB status needs the PROCESS privilege.
+	m.pool.Open(m.dbconfigs.DbaWithDB(), m.dbconfigs.DbaWithDB(), m.dbconfigs.AppDebugWithDB())
+	m.ticks.Start(func() { m.check() })
+	m.isOpen = true
+}
+
+// Close closes the db connection of the Monitor and stops its ticker.
+// The transactions are not throttled anymore. A Monitor can be
+// re-opened after closing.
+func (m *Monitor) Close() {
+	if !m.enabled {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.isOpen {
+		return
+	}
+	m.ticks.Stop()
+	m.pool.Close()
+	m.setPercent(0)
+	log.Info("Stopped InnoDB health checks")
+	m.isOpen = false
+}
+
+// Throttle returns true if a new write transaction must be rejected.
+func (m *Monitor) Throttle() bool {
+	if !m.enabled {
+		return false
+	}
+	percent := m.percent.Get()
+	if percent <= 0 || rand.Int63n(100) >= percent {
+		return false
+	}
+	throttledTransactions.Add(1)
+	return true
+}
+
+// check reads the InnoDB status, and updates the percentage of the
+// throttled transactions. If the status can't be read, the transactions
+// are not throttled.
+func (m *Monitor) check() {
+	defer tabletenv.LogError()
+	ctx, cancel := context.WithTimeout(context.Background(), m.interval)
+	defer cancel()
+
+	length, lag, err := m.readStatus(ctx)
+	if err != nil {
+		healthErrors.Add(1)
+		m.errorLog.Errorf("Cannot check the InnoDB health: %v", err)
+		m.setPercent(0)
+		return
+	}
+	historyListLength.Set(length)
+	purgeLag.Set(lag)
+
+	percent := excessPercent(length, m.maxHistoryLength)
+	if p := excessPercent(lag, m.maxPurgeLag); p > percent {
+		percent = p
+	}
+	if old := m.percent.Get(); percent > 0 && old == 0 {
+		log.Warningf("InnoDB purge is behind (history list length %v, purge lag %v): throttling %v%% of the write transactions", length, lag, percent)
+	} else if percent == 0 && old > 0 {
+		log.Infof("InnoDB purge caught up (history list length %v, purge lag %v): stopped throttling the write transactions", length, lag)
+	}
+	m.setPercent(percent)
+}
+
+func (m *Monitor) setPercent(percent int64) {
+	m.percent.Set(percent)
+	throttlePercent.Set(percent)
+}
+
+// readStatus returns the history list length and the purge lag.
+func (m *Monitor) readStatus(ctx context.Context) (int64, int64, error) {
+	conn, err := m.pool.Get(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer conn.Recycle()
+	qr, err := conn.Exec(ctx, sqlInnoDBStatus, 1, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	// The InnoDB status has the Type, Name and Status columns.
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 3 {
+		return 0, 0, vterrors.New(vtrpcpb.Code_INTERNAL, "unexpected result for the InnoDB status")
+	}
+	return parseStatus(qr.Rows[0][2].ToString())
+}
+
+// parseStatus returns the history list length and the purge lag of an
+// InnoDB status.
+func parseStatus(status string) (int64, int64, error) {
+	length, err := statusValue(status, historyListLengthRE)
+	if err != nil {
+		return 0, 0, err
+	}
+	counter, err := statusValue(status, trxIDCounterRE)
+	if err != nil {
+		return 0, 0, err
+	}
+	purged, err := statusValue(status, purgeDoneRE)
+	if err != nil {
+		return 0, 0, err
+	}
+	lag := counter - purged
+	if lag < 0 {
+		lag = 0
+	}
+	return length, lag, nil
+}
+
+func statusValue(status string, re *regexp.Regexp) (int64, error) {
+	match := re.FindStringSubmatch(status)
+	if match == nil {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "no match for %v in the InnoDB status", re)
+	}
+	return strconv.ParseInt(match[1], 10, 64)
+}
+
+// excessPercent returns the percentage of the transactions throttled
+// for a value and its threshold: 0 up to the threshold, and up to 100
+// at twice the threshold. A threshold of 0 is disabled.
+func excessPercent(value, max int64) int64 {
+	if max <= 0 || value <= max {
+		return 0
+	}
+	if value >= 2*max {
+		return 100
+	}
+	// Round up so that a value above the threshold is throttled.
+	return ((value-max)*100 + max - 1) / max
+}
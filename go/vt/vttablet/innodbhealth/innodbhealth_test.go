@@ -0,0 +1,172 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package innodbhealth
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// testStatus returns the TRANSACTIONS section of an InnoDB status.
+func testStatus(trxIDCounter, purgeDone, historyListLength int64) string {
+	return fmt.Sprintf(`------------
+TRANSACTIONS
+------------
+Trx id counter %d
+Purge done for trx's n:o < %d undo n:o < 0 state: running but idle
+History list length %d
+LIST OF TRANSACTIONS FOR EACH SESSION:
+---TRANSACTION 421309264727632, not started
+0 lock struct(s), heap size 1136, 0 row lock(s)
+`, trxIDCounter, purgeDone, historyListLength)
+}
+
+func TestParseStatus(t *testing.T) {
+	length, lag, err := parseStatus(testStatus(25000, 24000, 3000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if length != 3000 || lag != 1000 {
+		t.Errorf("parseStatus: %v, %v, want 3000, 1000", length, lag)
+	}
+
+	if _, _, err := parseStatus("no transactions section"); err == nil {
+		t.Errorf("parseStatus succeeded without a TRANSACTIONS section, want an error")
+	}
+}
+
+func TestExcessPercent(t *testing.T) {
+	testcases := []struct {
+		value, max, want int64
+	}{
+		{0, 0, 0},
+		{1000000, 0, 0},
+		{500, 1000, 0},
+		{1000, 1000, 0},
+		{1001, 1000, 1},
+		{1500, 1000, 50},
+		{1999, 1000, 100},
+		{2000, 1000, 100},
+		{1000000, 1000, 100},
+	}
+	for _, tc := range testcases {
+		if got := excessPercent(tc.value, tc.max); got != tc.want {
+			t.Errorf("excessPercent(%v, %v): %v, want %v", tc.value, tc.max, got, tc.want)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	m := newTestMonitor(db)
+	defer m.pool.Close()
+	healthErrors.Reset()
+
+	testcases := []struct {
+		trxIDCounter, purgeDone, historyListLength int64
+		want                                       int64
+	}{
+		// Healthy.
+		{10000, 9990, 500, 0},
+		// The history list is 50% too long.
+		{10000, 9990, 15000, 50},
+		// The purge lag is 20% too high.
+		{10000, 4000, 500, 20},
+		// Both are too high, the highest excess wins.
+		{10000, 4000, 15000, 50},
+		// Way behind.
+		{100000, 4000, 15000, 100},
+		// Caught up.
+		{100000, 99990, 100, 0},
+	}
+	for _, tc := range testcases {
+		db.AddQuery(sqlInnoDBStatus, sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Type|Name|Status", "varchar|varchar|varchar"),
+			"InnoDB||"+testStatus(tc.trxIDCounter, tc.purgeDone, tc.historyListLength),
+		))
+		m.check()
+		if got := m.percent.Get(); got != tc.want {
+			t.Errorf("check(%+v): throttling %v%%, want %v%%", tc, got, tc.want)
+		}
+	}
+	if got := healthErrors.Get(); got != 0 {
+		t.Errorf("errors: %v, want 0", got)
+	}
+	if got, want := historyListLength.Get(), int64(100); got != want {
+		t.Errorf("InnoDBHistoryListLength: %v, want %v", got, want)
+	}
+
+	// The transactions are not throttled when the status can't be read.
+	m.setPercent(100)
+	db.AddQuery(sqlInnoDBStatus, &sqltypes.Result{})
+	m.check()
+	if got := m.percent.Get(); got != 0 {
+		t.Errorf("throttling %v%% after an error, want 0%%", got)
+	}
+	if got := healthErrors.Get(); got != 1 {
+		t.Errorf("errors: %v, want 1", got)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	if (&Monitor{}).Throttle() {
+		t.Errorf("a disabled monitor throttled a transaction")
+	}
+
+	m := &Monitor{enabled: true}
+	throttledTransactions.Reset()
+	for i := 0; i < 100; i++ {
+		if m.Throttle() {
+			t.Fatalf("a transaction was throttled at 0%%")
+		}
+	}
+	m.setPercent(100)
+	for i := 0; i < 100; i++ {
+		if !m.Throttle() {
+			t.Fatalf("a transaction was not throttled at 100%%")
+		}
+	}
+	if got, want := throttledTransactions.Get(), int64(100); got != want {
+		t.Errorf("InnoDBThrottledTransactions: %v, want %v", got, want)
+	}
+}
+
+func newTestMonitor(db *fakesqldb.DB) *Monitor {
+	config := tabletenv.DefaultQsConfig
+	config.InnoDBMaxHistoryListLength = 10000
+	config.InnoDBMaxPurgeLag = 5000
+	config.PoolNamePrefix = fmt.Sprintf("Pool-%d-", rand.Int63())
+
+	dbc := dbconfigs.NewTestDBConfigs(*db.ConnParams(), *db.ConnParams(), "")
+	m := NewMonitor(&fakeMysqlChecker{}, config)
+	m.InitDBConfig(dbc)
+	m.interval = 10 * time.Second
+	m.pool.Open(dbc.DbaWithDB(), dbc.DbaWithDB(), dbc.AppDebugWithDB())
+	return m
+}
+
+type fakeMysqlChecker struct{}
+
+func (f fakeMysqlChecker) CheckMySQL() {}
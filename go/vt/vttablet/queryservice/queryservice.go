@@ -49,6 +49,10 @@ type QueryService interface {
 	// Rollback aborts the current transaction
 	Rollback(ctx context.Context, target *querypb.Target, transactionID int64) error
 
+	// Release rolls back the transaction of a reserved connection,
+	// if any, and releases the connection.
+	Release(ctx context.Context, target *querypb.Target, reservedID int64) error
+
 	// Prepare prepares the specified transaction.
 	Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error)
 
@@ -106,6 +106,13 @@ func (ws *wrappedService) Rollback(ctx context.Context, target *querypb.Target,
 	})
 }
 
+func (ws *wrappedService) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	return ws.wrapper(ctx, target, ws.impl, "Release", true, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
+		innerErr := conn.Release(ctx, target, reservedID)
+		return canRetry(ctx, innerErr), innerErr
+	})
+}
+
 func (ws *wrappedService) Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) error {
 	return ws.wrapper(ctx, target, ws.impl, "Prepare", true, func(ctx context.Context, target *querypb.Target, conn QueryService) (bool, error) {
 		innerErr := conn.Prepare(ctx, target, transactionID, dtid)
@@ -56,6 +56,7 @@ type SandboxConn struct {
 	BeginCount               sync2.AtomicInt64
 	CommitCount              sync2.AtomicInt64
 	RollbackCount            sync2.AtomicInt64
+	ReleaseCount             sync2.AtomicInt64
 	AsTransactionCount       sync2.AtomicInt64
 	PrepareCount             sync2.AtomicInt64
 	CommitPreparedCount      sync2.AtomicInt64
@@ -76,6 +77,10 @@ type SandboxConn struct {
 	// Options stores the options received by all calls.
 	Options []*querypb.ExecuteOptions
 
+	// StreamTransactionIDs stores the transaction ids received by
+	// StreamExecute.
+	StreamTransactionIDs []int64
+
 	// results specifies the results to be returned.
 	// They're consumed as results are returned. If there are
 	// no results left, SingleRowResult is returned.
@@ -167,6 +172,7 @@ func (sbc *SandboxConn) StreamExecute(ctx context.Context, target *querypb.Targe
 		BindVariables: bv,
 	})
 	sbc.Options = append(sbc.Options, options)
+	sbc.StreamTransactionIDs = append(sbc.StreamTransactionIDs, transactionID)
 	err := sbc.getError()
 	if err != nil {
 		return err
@@ -196,6 +202,12 @@ func (sbc *SandboxConn) Rollback(ctx context.Context, target *querypb.Target, tr
 	return sbc.getError()
 }
 
+// Release is part of the QueryService interface.
+func (sbc *SandboxConn) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	sbc.ReleaseCount.Add(1)
+	return sbc.getError()
+}
+
 // Prepare prepares the specified transaction.
 func (sbc *SandboxConn) Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
 	sbc.PrepareCount.Add(1)
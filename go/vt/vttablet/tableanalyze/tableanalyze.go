@@ -0,0 +1,336 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tableanalyze keeps the index statistics of the tables of a
+// tablet fresh. InnoDB counts the rows modified in each table since its
+// statistics were last computed; the tables whose counter reaches a
+// fraction of their rows are analyzed, during a low-traffic window if
+// one is configured. The counters include the rows changed by
+// replication, so every tablet analyzes its own tables, without writing
+// the statements to the binlog.
+//
+// The row estimates of the statistics are used by MySQL to plan the
+// queries, and by vttablet to split the queries and size the tables.
+package tableanalyze
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+const (
+	// The InnoDB table stats are in innodb_sys_tablestats in MySQL 5.7
+	// and MariaDB, and in innodb_tablestats in MySQL 8.0. The names
+	// are <database>/<table>.
+	sqlTableStats57 = "select name, num_rows, modified_counter from information_schema.innodb_sys_tablestats where name like concat(database(), '/%')"
+	sqlTableStats80 = "select name, num_rows, modified_counter from information_schema.innodb_tablestats where name like concat(database(), '/%')"
+	sqlAnalyzeTable = "analyze no_write_to_binlog table %s"
+)
+
+var (
+	// analyzedTables counts the tables analyzed, by table.
+	analyzedTables = stats.NewCountersWithSingleLabel("TableAnalyzeTables", "Count of ANALYZE TABLE statements run on the heavily modified tables, by table", "Table")
+	// analyzeErrors counts the errors encountered while analyzing the tables.
+	analyzeErrors = stats.NewCounter("TableAnalyzeErrors", "Count of errors encountered while analyzing the heavily modified tables")
+)
+
+// TableAnalyzer periodically analyzes the heavily modified tables.
+type TableAnalyzer struct {
+	dbconfigs *dbconfigs.DBConfigs
+
+	enabled          bool
+	interval         time.Duration
+	threshold        float64
+	minModifications int64
+	window           window
+	// tables are the thresholds of the tables with a specific one. A
+	// negative threshold means the table is never analyzed.
+	tables   map[string]float64
+	now      func() time.Time
+	errorLog *logutil.ThrottledLogger
+
+	mu     sync.Mutex
+	isOpen bool
+	pool   *connpool.Pool
+	ticks  *timer.Timer
+	// statsQuery is the query that reads the InnoDB table stats.
+	statsQuery string
+}
+
+// NewTableAnalyzer creates a new TableAnalyzer.
+func NewTableAnalyzer(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *TableAnalyzer {
+	if !config.TableAnalyzeEnable {
+		return &TableAnalyzer{}
+	}
+	w, err := parseWindow(config.TableAnalyzeWindow)
+	if err != nil {
+		log.Fatalf("Cannot parse -table_analyze_window: %v", err)
+	}
+	tables, err := parseTableThresholds(config.TableAnalyzeTables)
+	if err != nil {
+		log.Fatalf("Cannot parse -table_analyze_tables: %v", err)
+	}
+	return &TableAnalyzer{
+		enabled:          true,
+		interval:         config.TableAnalyzeInterval,
+		threshold:        config.TableAnalyzeThreshold,
+		minModifications: config.TableAnalyzeMinModifications,
+		window:           w,
+		tables:           tables,
+		now:              time.Now,
+		errorLog:         logutil.NewThrottledLogger("TableAnalyze", 60*time.Second),
+		pool:             connpool.New(config.PoolNamePrefix+"TableAnalyzePool", 1, 0, time.Duration(config.IdleTimeout*1e9), checker),
+		ticks:            timer.NewTimer(config.TableAnalyzeInterval),
+		statsQuery:       sqlTableStats57,
+	}
+}
+
+// InitDBConfig must be called before Open.
+func (ta *TableAnalyzer) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	ta.dbconfigs = dbcfgs
+}
+
+// Open sets up the db connection of the TableAnalyzer and launches the
+// ticker that checks the tables. Open may be called multiple times, as
+// long as it was closed since last invocation.
+func (ta *TableAnalyzer) Open() {
+	if !ta.enabled {
+		return
+	}
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	if ta.isOpen {
+		return
+	}
+	log.Info("Beginning table analysis")
+	// ANALYZE TABLE needs the INSERT and SELECT privileges, and
+	// the InnoDB table stats the PROCESS privilege.
+	ta.pool.Open(ta.dbconfigs.DbaWithDB(), ta.dbconfigs.DbaWithDB(), ta.dbconfigs.AppDebugWithDB())
+	ta.ticks.Start(func() { ta.checkTables() })
+	ta.isOpen = true
+}
+
+// Close closes the db connection of the TableAnalyzer and stops its
+// ticker. A TableAnalyzer can be re-opened after closing.
+func (ta *TableAnalyzer) Close() {
+	if !ta.enabled {
+		return
+	}
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	if !ta.isOpen {
+		return
+	}
+	ta.ticks.Stop()
+	ta.pool.Close()
+	log.Info("Stopped table analysis.")
+	ta.isOpen = false
+}
+
+// checkTables analyzes the tables whose modification counter reached
+// their threshold, while in the window.
+func (ta *TableAnalyzer) checkTables() {
+	defer tabletenv.LogError()
+	if !ta.window.contains(ta.now()) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ta.interval)
+	defer cancel()
+
+	conn, err := ta.pool.Get(ctx)
+	if err != nil {
+		ta.recordError(err)
+		return
+	}
+	defer conn.Recycle()
+	qr, err := ta.tableStats(ctx, conn)
+	if err != nil {
+		ta.recordError(err)
+		return
+	}
+	tables, err := parseTableStats(qr)
+	if err != nil {
+		ta.recordError(err)
+		return
+	}
+	for _, ts := range tables {
+		if !ta.shouldAnalyze(ts.name, ts.numRows, ts.modified) {
+			continue
+		}
+		if !ta.window.contains(ta.now()) {
+			return
+		}
+		start := time.Now()
+		if _, err := conn.Exec(ctx, fmt.Sprintf(sqlAnalyzeTable, sqlescape.EscapeID(ts.name)), 10, false); err != nil {
+			ta.recordError(err)
+			continue
+		}
+		log.Infof("Analyzed table %v in %v: %v of its %v rows were modified", ts.name, time.Since(start), ts.modified, ts.numRows)
+		analyzedTables.Add(ts.name, 1)
+	}
+}
+
+// tableStats are the InnoDB stats of a table.
+type tableStats struct {
+	name     string
+	numRows  int64
+	modified int64
+}
+
+// parseTableStats returns the stats of the tables, in order. The stats
+// of the partitions of a table, named like db/t#P#p0, are summed. The
+// tables whose name has special characters, which InnoDB encodes like
+// @0024, are skipped.
+func parseTableStats(qr *sqltypes.Result) ([]*tableStats, error) {
+	var tables []*tableStats
+	byName := make(map[string]*tableStats)
+	for _, row := range qr.Rows {
+		name := row[0].ToString()
+		name = name[strings.IndexByte(name, '/')+1:]
+		if i := strings.IndexByte(name, '#'); i >= 0 {
+			name = name[:i]
+		}
+		if strings.IndexByte(name, '@') >= 0 {
+			continue
+		}
+		numRows, err := sqltypes.ToInt64(row[1])
+		if err != nil {
+			return nil, err
+		}
+		modified, err := sqltypes.ToInt64(row[2])
+		if err != nil {
+			return nil, err
+		}
+		ts, ok := byName[name]
+		if !ok {
+			ts = &tableStats{name: name}
+			byName[name] = ts
+			tables = append(tables, ts)
+		}
+		ts.numRows += numRows
+		ts.modified += modified
+	}
+	return tables, nil
+}
+
+// tableStats reads the InnoDB table stats, from the table of the MySQL
+// version.
+func (ta *TableAnalyzer) tableStats(ctx context.Context, conn *connpool.DBConn) (*sqltypes.Result, error) {
+	qr, err := conn.Exec(ctx, ta.statsQuery, 100000, false)
+	if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERUnknownTable && ta.statsQuery == sqlTableStats57 {
+		ta.statsQuery = sqlTableStats80
+		return conn.Exec(ctx, ta.statsQuery, 100000, false)
+	}
+	return qr, err
+}
+
+// shouldAnalyze returns true if a table has enough modified rows.
+func (ta *TableAnalyzer) shouldAnalyze(name string, numRows, modified int64) bool {
+	threshold, ok := ta.tables[name]
+	if !ok {
+		threshold = ta.threshold
+	}
+	if threshold < 0 || modified < ta.minModifications {
+		return false
+	}
+	return float64(modified) >= threshold*float64(numRows)
+}
+
+func (ta *TableAnalyzer) recordError(err error) {
+	ta.errorLog.Errorf("%v", err)
+	analyzeErrors.Add(1)
+}
+
+// window is a daily time range, in UTC.
+type window struct {
+	// start and end are in minutes since midnight. If they are equal,
+	// the window is the whole day.
+	start, end int
+}
+
+// parseWindow parses a window as HH:MM-HH:MM. An empty string is the
+// whole day.
+func parseWindow(s string) (window, error) {
+	if s == "" {
+		return window{}, nil
+	}
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return window{}, fmt.Errorf("invalid window %q, want HH:MM-HH:MM", s)
+	}
+	var bounds [2]int
+	for i, part := range parts {
+		t, err := time.Parse("15:04", strings.TrimSpace(part))
+		if err != nil {
+			return window{}, fmt.Errorf("invalid window %q: %v", s, err)
+		}
+		bounds[i] = t.Hour()*60 + t.Minute()
+	}
+	return window{start: bounds[0], end: bounds[1]}, nil
+}
+
+// contains returns true if t is in the window. A window whose end is
+// before its start spans midnight.
+func (w window) contains(t time.Time) bool {
+	if w.start == w.end {
+		return true
+	}
+	t = t.UTC()
+	minute := t.Hour()*60 + t.Minute()
+	if w.start < w.end {
+		return minute >= w.start && minute < w.end
+	}
+	return minute >= w.start || minute < w.end
+}
+
+// parseTableThresholds parses the per-table settings, as
+// <table>:<threshold> or <table>:off.
+func parseTableThresholds(settings []string) (map[string]float64, error) {
+	tables := make(map[string]float64)
+	for _, setting := range settings {
+		i := strings.LastIndexByte(setting, ':')
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid table setting %q, want <table>:<threshold> or <table>:off", setting)
+		}
+		name, value := setting[:i], setting[i+1:]
+		if value == "off" {
+			tables[name] = -1
+			continue
+		}
+		threshold, err := strconv.ParseFloat(value, 64)
+		if err != nil || threshold < 0 {
+			return nil, fmt.Errorf("invalid threshold in table setting %q", setting)
+		}
+		tables[name] = threshold
+	}
+	return tables, nil
+}
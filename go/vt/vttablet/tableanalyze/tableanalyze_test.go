@@ -0,0 +1,175 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tableanalyze
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+var now = time.Date(2019, 10, 4, 3, 0, 0, 0, time.UTC)
+
+func TestWindow(t *testing.T) {
+	testcases := []struct {
+		window string
+		hour   int
+		want   bool
+	}{
+		{"", 12, true},
+		{"01:00-05:00", 3, true},
+		{"01:00-05:00", 5, false},
+		{"01:00-05:00", 0, false},
+		{"22:30-02:00", 23, true},
+		{"22:30-02:00", 1, true},
+		{"22:30-02:00", 22, false},
+		{"22:30-02:00", 12, false},
+	}
+	for _, tc := range testcases {
+		w, err := parseWindow(tc.window)
+		if err != nil {
+			t.Errorf("parseWindow(%q): %v", tc.window, err)
+			continue
+		}
+		at := time.Date(2019, 10, 4, tc.hour, 0, 0, 0, time.UTC)
+		if got := w.contains(at); got != tc.want {
+			t.Errorf("window %q contains %v: %v, want %v", tc.window, at, got, tc.want)
+		}
+	}
+	for _, invalid := range []string{"01:00", "1-5", "01:00-25:00"} {
+		if _, err := parseWindow(invalid); err == nil {
+			t.Errorf("parseWindow(%q) succeeded, want an error", invalid)
+		}
+	}
+}
+
+func TestParseTableThresholds(t *testing.T) {
+	got, err := parseTableThresholds([]string{"big:0.01", "log:off"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]float64{"big": 0.01, "log": -1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("parseTableThresholds: %v, want %v", got, want)
+	}
+	for _, invalid := range []string{"big", ":0.1", "big:x", "big:-1"} {
+		if _, err := parseTableThresholds([]string{invalid}); err == nil {
+			t.Errorf("parseTableThresholds(%q) succeeded, want an error", invalid)
+		}
+	}
+}
+
+func TestCheckTables(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ta := newTestTableAnalyzer(db, "big:0.01", "log:off")
+	defer ta.pool.Close()
+	analyzedTables.ResetAll()
+	analyzeErrors.Reset()
+
+	db.AddQuery(sqlTableStats57, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("name|num_rows|modified_counter", "varchar|int64|int64"),
+		// 10% of the rows modified.
+		"vt_ks/t1|1000000|100000",
+		// Not enough rows modified.
+		"vt_ks/t2|1000000|99999",
+		// 10% of the rows modified, but less than the minimum.
+		"vt_ks/small|10000|1000",
+		// 1% of the rows modified, with a per-table threshold.
+		"vt_ks/big|100000000|1000000",
+		"vt_ks/log|1000000|1000000",
+		// 10% of the rows of the partitions modified.
+		"vt_ks/part#P#p0|500000|90000",
+		"vt_ks/part#P#p1|500000|10000",
+		"vt_ks/special@0024|1000000|1000000",
+	))
+	for _, table := range []string{"t1", "big", "part"} {
+		db.AddQuery(fmt.Sprintf("analyze no_write_to_binlog table `%s`", table), &sqltypes.Result{})
+	}
+
+	ta.checkTables()
+	if got, want := analyzeErrors.Get(), int64(0); got != want {
+		t.Errorf("errors: %v, want %v", got, want)
+	}
+	if got, want := analyzedTables.Counts(), map[string]int64{"t1": 1, "big": 1, "part": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("analyzed tables: %v, want %v", got, want)
+	}
+}
+
+func TestCheckTablesMySQL80(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ta := newTestTableAnalyzer(db)
+	defer ta.pool.Close()
+	analyzedTables.ResetAll()
+	analyzeErrors.Reset()
+
+	db.AddRejectedQuery(sqlTableStats57, mysql.NewSQLError(mysql.ERUnknownTable, mysql.SSUnknownSQLState, "Unknown table 'INNODB_SYS_TABLESTATS' in information_schema"))
+	db.AddQuery(sqlTableStats80, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("name|num_rows|modified_counter", "varchar|int64|int64"),
+		"vt_ks/t1|1000000|100000",
+	))
+	db.AddQuery("analyze no_write_to_binlog table `t1`", &sqltypes.Result{})
+
+	ta.checkTables()
+	if got, want := analyzedTables.Counts(), map[string]int64{"t1": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("analyzed tables: %v, want %v", got, want)
+	}
+	if ta.statsQuery != sqlTableStats80 {
+		t.Errorf("statsQuery: %v, want %v", ta.statsQuery, sqlTableStats80)
+	}
+}
+
+func TestCheckTablesOutsideWindow(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	ta := newTestTableAnalyzer(db)
+	defer ta.pool.Close()
+	ta.window = window{start: 60, end: 120}
+	db.AddQuery(sqlTableStats57, &sqltypes.Result{})
+
+	ta.checkTables()
+	if got := db.GetQueryCalledNum(sqlTableStats57); got != 0 {
+		t.Errorf("table stats read %v times outside of the window, want 0", got)
+	}
+}
+
+func newTestTableAnalyzer(db *fakesqldb.DB, tables ...string) *TableAnalyzer {
+	config := tabletenv.DefaultQsConfig
+	config.TableAnalyzeEnable = true
+	config.TableAnalyzeWindow = "01:00-05:00"
+	config.TableAnalyzeTables = tables
+	config.PoolNamePrefix = fmt.Sprintf("Pool-%d-", rand.Int63())
+
+	dbc := dbconfigs.NewTestDBConfigs(*db.ConnParams(), *db.ConnParams(), "")
+	ta := NewTableAnalyzer(&fakeMysqlChecker{}, config)
+	ta.InitDBConfig(dbc)
+	ta.now = func() time.Time { return now }
+	ta.pool.Open(dbc.DbaWithDB(), dbc.DbaWithDB(), dbc.AppDebugWithDB())
+	return ta
+}
+
+type fakeMysqlChecker struct{}
+
+func (f fakeMysqlChecker) CheckMySQL() {}
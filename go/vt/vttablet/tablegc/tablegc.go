@@ -0,0 +1,277 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tablegc moves the dropped tables of a master through the
+// states of the table GC lifecycle: a table is first held as is, then
+// its rows are purged in small batches, then it is kept empty until its
+// pages are evicted from the buffer pool, and it is finally dropped.
+// Dropping a huge table at once can stall MySQL and replication, and
+// churns the disk; the lifecycle spreads that work over time.
+//
+// The tables enter the lifecycle when ApplySchema -table_gc renames them
+// instead of dropping them. The state of a table and the time it entered
+// that state are encoded in its name, see the go/vt/schema package.
+package tablegc
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+const (
+	sqlShowGCTables = `select table_name from information_schema.tables where table_schema = database() and table_name like '\_vt\_%'`
+	sqlRenameTable  = "rename table %s to %s"
+	sqlPurgeTable   = "delete from %s limit %d"
+	sqlDropTable    = "drop table if exists %s"
+)
+
+var (
+	// transitions counts the tables that entered each state. The
+	// dropped tables are counted as DROPPED.
+	transitions = stats.NewCountersWithSingleLabel("TableGCTransitions", "Count of tables that entered each table GC state", "State")
+	// purgedRows counts the rows deleted from the tables in the PURGE state.
+	purgedRows = stats.NewCounter("TableGCPurgedRows", "Count of rows purged from the tables in the PURGE state")
+	// gcErrors counts the errors encountered while collecting the tables.
+	gcErrors = stats.NewCounter("TableGCErrors", "Count of errors encountered while collecting the dropped tables")
+)
+
+// TableGC runs on master tablets and moves the tables of the table GC
+// lifecycle to their next state.
+type TableGC struct {
+	dbconfigs *dbconfigs.DBConfigs
+
+	enabled        bool
+	interval       time.Duration
+	holdPeriod     time.Duration
+	evacPeriod     time.Duration
+	purgeBatchSize int
+	now            func() time.Time
+	errorLog       *logutil.ThrottledLogger
+
+	mu         sync.Mutex
+	isOpen     bool
+	pool       *connpool.Pool
+	checkTicks *timer.Timer
+	purgeTicks *timer.Timer
+
+	// purgeMu protects purgeTables, the tables in the PURGE state
+	// found by the last checks.
+	purgeMu     sync.Mutex
+	purgeTables map[string]bool
+}
+
+// NewTableGC creates a new TableGC.
+func NewTableGC(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *TableGC {
+	if !config.TableGCEnable {
+		return &TableGC{}
+	}
+	return &TableGC{
+		enabled:        true,
+		interval:       config.TableGCInterval,
+		holdPeriod:     config.TableGCHoldPeriod,
+		evacPeriod:     config.TableGCEvacPeriod,
+		purgeBatchSize: config.TableGCPurgeBatchSize,
+		now:            time.Now,
+		errorLog:       logutil.NewThrottledLogger("TableGC", 60*time.Second),
+		pool:           connpool.New(config.PoolNamePrefix+"TableGCPool", 1, 0, time.Duration(config.IdleTimeout*1e9), checker),
+		checkTicks:     timer.NewTimer(config.TableGCInterval),
+		purgeTicks:     timer.NewTimer(config.TableGCPurgeInterval),
+		purgeTables:    make(map[string]bool),
+	}
+}
+
+// InitDBConfig must be called before Open.
+func (gc *TableGC) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	gc.dbconfigs = dbcfgs
+}
+
+// Open sets up the db connection of the TableGC and launches the
+// tickers that check and purge the tables. Open may be called multiple
+// times, as long as it was closed since last invocation.
+func (gc *TableGC) Open() {
+	if !gc.enabled {
+		return
+	}
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+	if gc.isOpen {
+		return
+	}
+	log.Info("Beginning table GC")
+	// The lifecycle renames and drops tables, so it runs as the dba user.
+	gc.pool.Open(gc.dbconfigs.DbaWithDB(), gc.dbconfigs.DbaWithDB(), gc.dbconfigs.AppDebugWithDB())
+	gc.checkTicks.Start(func() { gc.checkTables() })
+	gc.purgeTicks.Start(func() { gc.purge() })
+	gc.isOpen = true
+}
+
+// Close closes the db connection of the TableGC and stops its tickers.
+// A TableGC can be re-opened after closing.
+func (gc *TableGC) Close() {
+	if !gc.enabled {
+		return
+	}
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+	if !gc.isOpen {
+		return
+	}
+	gc.checkTicks.Stop()
+	gc.purgeTicks.Stop()
+	gc.pool.Close()
+	gc.purgeMu.Lock()
+	gc.purgeTables = make(map[string]bool)
+	gc.purgeMu.Unlock()
+	log.Info("Stopped table GC.")
+	gc.isOpen = false
+}
+
+// checkTables lists the tables of the lifecycle, and moves the ones
+// whose period has elapsed to their next state. The tables in the
+// PURGE state are handed over to purge.
+func (gc *TableGC) checkTables() {
+	defer tabletenv.LogError()
+	ctx, cancel := context.WithTimeout(context.Background(), gc.interval)
+	defer cancel()
+
+	conn, err := gc.pool.Get(ctx)
+	if err != nil {
+		gc.recordError(err)
+		return
+	}
+	defer conn.Recycle()
+	qr, err := conn.Exec(ctx, sqlShowGCTables, 10000, false)
+	if err != nil {
+		gc.recordError(err)
+		return
+	}
+	now := gc.now()
+	for _, row := range qr.Rows {
+		name := row[0].ToString()
+		state, t, ok := schema.AnalyzeGCTableName(name)
+		if !ok {
+			continue
+		}
+		switch state {
+		case schema.HoldTableGCState:
+			if now.Sub(t) >= gc.holdPeriod {
+				err = gc.transition(ctx, conn, name, schema.PurgeTableGCState)
+			}
+		case schema.PurgeTableGCState:
+			gc.purgeMu.Lock()
+			gc.purgeTables[name] = true
+			gc.purgeMu.Unlock()
+		case schema.EvacTableGCState:
+			if now.Sub(t) >= gc.evacPeriod {
+				err = gc.transition(ctx, conn, name, schema.DropTableGCState)
+			}
+		case schema.DropTableGCState:
+			if _, err = conn.Exec(ctx, fmt.Sprintf(sqlDropTable, sqlescape.EscapeID(name)), 0, false); err == nil {
+				log.Infof("Table GC dropped table %v", name)
+				transitions.Add("DROPPED", 1)
+			}
+		}
+		if err != nil {
+			gc.recordError(err)
+			err = nil
+		}
+	}
+}
+
+// purge deletes a batch of rows from one of the tables in the PURGE
+// state. The binlogs are kept on, so the replicas purge their rows at
+// the same pace. A table with no rows left moves to the EVAC state.
+func (gc *TableGC) purge() {
+	defer tabletenv.LogError()
+	gc.purgeMu.Lock()
+	name := ""
+	for table := range gc.purgeTables {
+		name = table
+		break
+	}
+	gc.purgeMu.Unlock()
+	if name == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), gc.interval)
+	defer cancel()
+	err := gc.purgeTable(ctx, name)
+	if err != nil {
+		// The table will be found again by the next check if it
+		// still exists.
+		gc.recordError(err)
+	}
+}
+
+func (gc *TableGC) purgeTable(ctx context.Context, name string) (err error) {
+	done := false
+	defer func() {
+		if done || err != nil {
+			gc.purgeMu.Lock()
+			delete(gc.purgeTables, name)
+			gc.purgeMu.Unlock()
+		}
+	}()
+
+	conn, err := gc.pool.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+	qr, err := conn.Exec(ctx, fmt.Sprintf(sqlPurgeTable, sqlescape.EscapeID(name), gc.purgeBatchSize), 0, false)
+	if err != nil {
+		return err
+	}
+	purgedRows.Add(int64(qr.RowsAffected))
+	if qr.RowsAffected > 0 {
+		return nil
+	}
+	done = true
+	return gc.transition(ctx, conn, name, schema.EvacTableGCState)
+}
+
+// transition renames a table into the given state.
+func (gc *TableGC) transition(ctx context.Context, conn *connpool.DBConn, name string, state schema.TableGCState) error {
+	newName, err := schema.RenameGCTableName(name, state, gc.now())
+	if err != nil {
+		return err
+	}
+	if _, err := conn.Exec(ctx, fmt.Sprintf(sqlRenameTable, sqlescape.EscapeID(name), sqlescape.EscapeID(newName)), 0, false); err != nil {
+		return err
+	}
+	log.Infof("Table GC renamed table %v to %v", name, newName)
+	transitions.Add(string(state), 1)
+	return nil
+}
+
+func (gc *TableGC) recordError(err error) {
+	gc.errorLog.Errorf("%v", err)
+	gcErrors.Add(1)
+}
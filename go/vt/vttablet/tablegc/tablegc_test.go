@@ -0,0 +1,131 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tablegc
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+var now = time.Date(2019, 10, 4, 13, 0, 0, 0, time.UTC)
+
+const uuid = "0123456789abcdef0123456789abcdef"
+
+func gcName(state string, t time.Time) string {
+	return fmt.Sprintf("_vt_%s_%s_%s", state, uuid, t.Format("20060102150405"))
+}
+
+func TestCheckTables(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	gc := newTestTableGC(db)
+	defer gc.pool.Close()
+	transitions.ResetAll()
+	gcErrors.Reset()
+
+	oldHold := "_vt_HOLD_11111111111111111111111111111111_20190930000000"
+	newHold := "_vt_HOLD_22222222222222222222222222222222_20191004120000"
+	purge := "_vt_PURGE_33333333333333333333333333333333_20191001000000"
+	oldEvac := "_vt_EVAC_44444444444444444444444444444444_20191003000000"
+	newEvac := "_vt_EVAC_55555555555555555555555555555555_20191004000000"
+	drop := gcName("DROP", now.Add(-time.Hour))
+	db.AddQuery(sqlShowGCTables, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_name", "varchar"),
+		oldHold, newHold, purge, oldEvac, newEvac, drop, "_vt_user_table",
+	))
+	db.AddQuery("rename table `"+oldHold+"` to `_vt_PURGE_11111111111111111111111111111111_20191004130000`", &sqltypes.Result{})
+	db.AddQuery("rename table `"+oldEvac+"` to `_vt_DROP_44444444444444444444444444444444_20191004130000`", &sqltypes.Result{})
+	db.AddQuery("drop table if exists `"+drop+"`", &sqltypes.Result{})
+
+	gc.checkTables()
+	if got, want := gcErrors.Get(), int64(0); got != want {
+		t.Errorf("errors: %v, want %v", got, want)
+	}
+	if got, want := transitions.Counts(), map[string]int64{"PURGE": 1, "DROP": 1, "DROPPED": 1}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("transitions: %v, want %v", got, want)
+	}
+	if !gc.purgeTables[purge] || len(gc.purgeTables) != 1 {
+		t.Errorf("purgeTables: %v, want %v", gc.purgeTables, purge)
+	}
+}
+
+func TestPurge(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	gc := newTestTableGC(db)
+	defer gc.pool.Close()
+	transitions.ResetAll()
+	purgedRows.Reset()
+	gcErrors.Reset()
+
+	purge := gcName("PURGE", now.Add(-time.Hour))
+	gc.purgeTables[purge] = true
+	db.AddQuery("delete from `"+purge+"` limit 10", &sqltypes.Result{RowsAffected: 10})
+	gc.purge()
+	if got, want := purgedRows.Get(), int64(10); got != want {
+		t.Errorf("purged rows: %v, want %v", got, want)
+	}
+	if !gc.purgeTables[purge] {
+		t.Errorf("purgeTables: %v, want %v", gc.purgeTables, purge)
+	}
+
+	db.AddQuery("delete from `"+purge+"` limit 10", &sqltypes.Result{})
+	db.AddQuery("rename table `"+purge+"` to `"+gcName("EVAC", now)+"`", &sqltypes.Result{})
+	gc.purge()
+	if got, want := transitions.Counts()["EVAC"], int64(1); got != want {
+		t.Errorf("EVAC transitions: %v, want %v", got, want)
+	}
+	if len(gc.purgeTables) != 0 {
+		t.Errorf("purgeTables: %v, want none", gc.purgeTables)
+	}
+
+	// A table that can't be purged is forgotten until the next check.
+	gc.purgeTables[purge] = true
+	db.AddRejectedQuery("delete from `"+purge+"` limit 10", fmt.Errorf("table doesn't exist"))
+	gc.purge()
+	if got, want := gcErrors.Get(), int64(1); got != want {
+		t.Errorf("errors: %v, want %v", got, want)
+	}
+	if len(gc.purgeTables) != 0 {
+		t.Errorf("purgeTables: %v, want none", gc.purgeTables)
+	}
+}
+
+func newTestTableGC(db *fakesqldb.DB) *TableGC {
+	config := tabletenv.DefaultQsConfig
+	config.TableGCEnable = true
+	config.TableGCPurgeBatchSize = 10
+	config.PoolNamePrefix = fmt.Sprintf("Pool-%d-", rand.Int63())
+
+	dbc := dbconfigs.NewTestDBConfigs(*db.ConnParams(), *db.ConnParams(), "")
+	gc := NewTableGC(&fakeMysqlChecker{}, config)
+	gc.InitDBConfig(dbc)
+	gc.now = func() time.Time { return now }
+	gc.pool.Open(dbc.DbaWithDB(), dbc.DbaWithDB(), dbc.AppDebugWithDB())
+	return gc
+}
+
+type fakeMysqlChecker struct{}
+
+func (f fakeMysqlChecker) CheckMySQL() {}
@@ -184,6 +184,24 @@ func (f *FakeQueryService) Rollback(ctx context.Context, target *querypb.Target,
 	return nil
 }
 
+// ReleaseReservedID is a test reserved id for Release.
+const ReleaseReservedID int64 = 999045
+
+// Release is part of the queryservice.QueryService interface
+func (f *FakeQueryService) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	if f.HasError {
+		return f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	f.checkTargetCallerID(ctx, "Release", target)
+	if reservedID != ReleaseReservedID {
+		f.t.Errorf("Release: invalid ReservedId: got %v expected %v", reservedID, ReleaseReservedID)
+	}
+	return nil
+}
+
 // Dtid is a test dtid
 const Dtid string = "aa"
 
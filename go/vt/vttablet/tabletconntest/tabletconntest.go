@@ -177,6 +177,32 @@ func testRollbackPanics(t *testing.T, conn queryservice.QueryService, f *FakeQue
 	})
 }
 
+func testRelease(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testRelease")
+	ctx := context.Background()
+	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
+	err := conn.Release(ctx, TestTarget, ReleaseReservedID)
+	if err != nil {
+		t.Fatalf("Release failed: %v", err)
+	}
+}
+
+func testReleaseError(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testReleaseError")
+	f.HasError = true
+	testErrorHelper(t, f, "Release", func(ctx context.Context) error {
+		return conn.Release(ctx, TestTarget, ReleaseReservedID)
+	})
+	f.HasError = false
+}
+
+func testReleasePanics(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
+	t.Log("testReleasePanics")
+	testPanicHelper(t, f, "Release", func(ctx context.Context) error {
+		return conn.Release(ctx, TestTarget, ReleaseReservedID)
+	})
+}
+
 func testPrepare(t *testing.T, conn queryservice.QueryService, f *FakeQueryService) {
 	t.Log("testPrepare")
 	ctx := context.Background()
@@ -928,6 +954,7 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testBegin,
 		testCommit,
 		testRollback,
+		testRelease,
 		testPrepare,
 		testCommitPrepared,
 		testRollbackPrepared,
@@ -950,6 +977,7 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testBeginError,
 		testCommitError,
 		testRollbackError,
+		testReleaseError,
 		testPrepareError,
 		testCommitPreparedError,
 		testRollbackPreparedError,
@@ -974,6 +1002,7 @@ func TestSuite(t *testing.T, protocol string, tablet *topodatapb.Tablet, fake *F
 		testBeginPanics,
 		testCommitPanics,
 		testRollbackPanics,
+		testReleasePanics,
 		testPreparePanics,
 		testCommitPreparedPanics,
 		testRollbackPreparedPanics,
@@ -214,6 +214,10 @@ type ActionAgent struct {
 	// replication delay the last time we got it
 	_replicationDelay time.Duration
 
+	// _readOnly is the read_only setting of MySQL the last time
+	// health check ran.
+	_readOnly bool
+
 	// _masterTermStartTime is the time at which our term as master began.
 	_masterTermStartTime time.Time
 
@@ -230,6 +234,10 @@ type ActionAgent struct {
 	_lockTablesTimer      *time.Timer
 	// _isBackupRunning tells us whether there is a backup that is currently running
 	_isBackupRunning bool
+
+	// _durabilityPolicy is the durability policy of our keyspace
+	// the last time we read it.
+	_durabilityPolicy string
 }
 
 // NewActionAgent creates a new ActionAgent and registers all the
@@ -269,6 +277,11 @@ func NewActionAgent(
 	if agent.Cnf == nil && *restoreFromBackup {
 		return nil, fmt.Errorf("you cannot enable -restore_from_backup without a my.cnf file")
 	}
+	if *unmanaged {
+		if err := agent.initUnmanaged(); err != nil {
+			return nil, err
+		}
+	}
 
 	agent.registerQueryRuleSources()
 
@@ -308,6 +321,14 @@ func NewActionAgent(
 		return nil, err
 	}
 
+	if *unmanaged {
+		// Problems with the external MySQL are not fatal: the tablet
+		// may still serve queries while they are being fixed.
+		if err := agent.verifyUnmanagedMysql(batchCtx); err != nil {
+			log.Warningf("The external MySQL cannot be fully used by vttablet: %v", err)
+		}
+	}
+
 	// The db name is set by the Start function called above
 	agent.VREngine = vreplication.NewEngine(ts, tabletAlias.Cell, mysqld, func() binlogplayer.DBClient {
 		return binlogplayer.NewDBClient(agent.DBConfigs.FilteredWithDB())
@@ -140,6 +140,7 @@ func ConfigHTML() template.HTML {
 func (agent *ActionAgent) initHealthCheck() {
 	registerReplicationReporter(agent)
 	registerHeartbeatReporter(agent.QueryServiceControl)
+	registerWarmupReporter(agent)
 
 	log.Infof("Starting periodic health check every %v", *healthCheckInterval)
 	t := timer.NewTimer(*healthCheckInterval)
@@ -308,11 +309,18 @@ func (agent *ActionAgent) runHealthCheckLocked() {
 		}
 	}
 
+	// MySQL being read-only is not a health error, but it's reported
+	// to our observers. If we can't read it, we keep the previous value.
+	readOnly, readOnlyErr := agent.MysqlDaemon.IsReadOnly()
+
 	// remember our health status
 	agent.mutex.Lock()
 	agent._healthy = healthErr
 	agent._healthyTime = time.Now()
 	agent._replicationDelay = replicationDelay
+	if readOnlyErr == nil {
+		agent._readOnly = readOnly
+	}
 	agent.mutex.Unlock()
 
 	// send it to our observers
@@ -331,6 +331,42 @@ func TestErrSlaveNotRunningIsHealthy(t *testing.T) {
 	}
 }
 
+// TestHealthCheckReportsReadOnly verifies that the read_only setting of
+// MySQL is broadcast with the health, without making the tablet unhealthy.
+func TestHealthCheckReportsReadOnly(t *testing.T) {
+	ctx := context.Background()
+	agent := createTestAgent(ctx, t, nil)
+
+	// Consume the first health broadcast triggered by ActionAgent.Start().
+	if _, err := expectBroadcastData(agent.QueryServiceControl, true, "healthcheck not run yet", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := expectStateChange(agent.QueryServiceControl, true, topodatapb.TabletType_REPLICA); err != nil {
+		t.Fatal(err)
+	}
+
+	agent.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon).ReadOnly = true
+	agent.HealthReporter.(*fakeHealthCheck).reportReplicationDelay = 3 * time.Second
+	agent.runHealthCheck()
+	bd, err := expectBroadcastData(agent.QueryServiceControl, true, "", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bd.RealtimeStats.ReadOnly {
+		t.Errorf("BroadcastData.ReadOnly: false, want true with bd: %+v", bd)
+	}
+
+	agent.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon).ReadOnly = false
+	agent.runHealthCheck()
+	bd, err = expectBroadcastData(agent.QueryServiceControl, true, "", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bd.RealtimeStats.ReadOnly {
+		t.Errorf("BroadcastData.ReadOnly: true, want false with bd: %+v", bd)
+	}
+}
+
 // TestQueryServiceNotStarting verifies that if a tablet cannot start the
 // query service, it should not go healthy.
 func TestQueryServiceNotStarting(t *testing.T) {
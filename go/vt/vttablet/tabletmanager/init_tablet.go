@@ -71,9 +71,15 @@ func (agent *ActionAgent) InitTablet(port, gRPCPort int32) error {
 		return vterrors.Wrapf(err, "invalid init_tablet_type %v", *initTabletType)
 	}
 	if tabletType == topodatapb.TabletType_MASTER {
-		// We disallow MASTER, so we don't have to change
-		// shard.MasterAlias, and deal with the corner cases.
-		return fmt.Errorf("init_tablet_type cannot be master, use replica instead")
+		if !*unmanaged {
+			// We disallow MASTER, so we don't have to change
+			// shard.MasterAlias, and deal with the corner cases.
+			return fmt.Errorf("init_tablet_type cannot be master, use replica instead")
+		}
+		// The external MySQL is writable, and there is no other way
+		// to make it master. The shard record is updated by the
+		// shard sync loop once we are running.
+		agent.setMasterTermStartTime(time.Now())
 	}
 
 	// parse and validate shard name
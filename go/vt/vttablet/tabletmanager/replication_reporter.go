@@ -31,10 +31,11 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
 var (
-	enableReplicationReporter = flag.Bool("enable_replication_reporter", false, "Register the health check module that monitors MySQL replication")
+	enableReplicationReporter = flag.Bool("enable_replication_reporter", false, "Register the health check module that monitors MySQL replication. With -heartbeat_enable, the replication lag is measured by the heartbeat reader instead of Seconds_Behind_Master.")
 )
 
 // replicationReporter implements health.Reporter
@@ -43,6 +44,11 @@ type replicationReporter struct {
 	agent *ActionAgent
 	now   func() time.Time
 
+	// useHeartbeat is true when the heartbeat reporter measures the
+	// replication lag. The replication reporter then only checks that
+	// replication is running, and ignores Seconds_Behind_Master.
+	useHeartbeat bool
+
 	// store the last time we successfully got the lag, so if we
 	// can't get the lag any more, we can extrapolate.
 	lastKnownValue time.Duration
@@ -90,6 +96,11 @@ func (r *replicationReporter) Report(isSlaveType, shouldQueryServiceBeRunning bo
 			// we can't.
 			return 0, health.ErrSlaveNotRunning
 		}
+		if r.useHeartbeat {
+			// The heartbeat lag keeps growing while
+			// replication is stopped.
+			return 0, nil
+		}
 
 		// we can extrapolate with the worst possible
 		// value (that is we made no replication
@@ -101,6 +112,9 @@ func (r *replicationReporter) Report(isSlaveType, shouldQueryServiceBeRunning bo
 	// we got a real value, save it.
 	r.lastKnownValue = time.Duration(status.SecondsBehindMaster) * time.Second
 	r.lastKnownTime = r.now()
+	if r.useHeartbeat {
+		return 0, nil
+	}
 	return r.lastKnownValue, nil
 }
 
@@ -160,8 +174,9 @@ func registerReplicationReporter(agent *ActionAgent) {
 	if *enableReplicationReporter {
 		health.DefaultAggregator.Register("replication_reporter",
 			&replicationReporter{
-				agent: agent,
-				now:   time.Now,
+				agent:        agent,
+				now:          time.Now,
+				useHeartbeat: tabletenv.Config.HeartbeatEnable,
 			})
 	}
 }
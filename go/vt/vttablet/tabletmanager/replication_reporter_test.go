@@ -110,3 +110,39 @@ func TestNoExtrapolatedMySQLReplicationLag(t *testing.T) {
 		t.Fatalf("wrong Report error: %v", err)
 	}
 }
+
+func TestHeartbeatReplicationLag(t *testing.T) {
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+	mysqld.Replicating = true
+	mysqld.SecondsBehindMaster = 10
+	slaveStopped := true
+
+	now := time.Now()
+	rep := &replicationReporter{
+		agent:        &ActionAgent{MysqlDaemon: mysqld, _slaveStopped: &slaveStopped},
+		now:          func() time.Time { return now },
+		useHeartbeat: true,
+	}
+
+	// Seconds_Behind_Master is ignored.
+	dur, err := rep.Report(true, true)
+	if err != nil || dur != 0 {
+		t.Fatalf("wrong Report result: %v %v", dur, err)
+	}
+
+	// replication is stopped: the lag is not extrapolated,
+	// as the heartbeat lag keeps growing.
+	now = now.Add(20 * time.Second)
+	mysqld.Replicating = false
+	dur, err = rep.Report(true, true)
+	if err != nil || dur != 0 {
+		t.Fatalf("wrong Report result: %v %v", dur, err)
+	}
+
+	// but errors are still reported.
+	mysqld.SlaveStatusError = errors.New("mysql is down")
+	_, err = rep.Report(true, true)
+	if err != mysqld.SlaveStatusError {
+		t.Fatalf("wrong Report error: %v", err)
+	}
+}
@@ -232,7 +232,7 @@ func (agent *ActionAgent) startReplication(ctx context.Context, pos mysql.Positi
 	}
 
 	// If using semi-sync, we need to enable it before connecting to master.
-	if err := agent.fixSemiSync(tabletType); err != nil {
+	if err := agent.fixSemiSync(ctx, tabletType, si.MasterAlias); err != nil {
 		return err
 	}
 
@@ -265,7 +265,10 @@ func (agent *ActionAgent) startReplication(ctx context.Context, pos mysql.Positi
 		return vterrors.Wrapf(err, "can't decode master replication position: %q", posStr)
 	}
 
-	if !pos.Equal(masterPos) {
+	// Wait only if the master has transactions we don't have yet. With
+	// MariaDB, the restored position can also contain domains the master
+	// doesn't report anymore, and replication won't move in that case.
+	if !pos.AtLeast(masterPos) {
 		for {
 			if err := ctx.Err(); err != nil {
 				return err
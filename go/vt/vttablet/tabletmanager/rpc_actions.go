@@ -26,6 +26,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl"
@@ -95,7 +96,7 @@ func (agent *ActionAgent) ChangeType(ctx context.Context, tabletType topodatapb.
 	}
 
 	// Let's see if we need to fix semi-sync acking.
-	if err := agent.fixSemiSyncAndReplication(agent.Tablet().Type); err != nil {
+	if err := agent.fixSemiSyncAndReplication(ctx, agent.Tablet().Type); err != nil {
 		return vterrors.Wrap(err, "fixSemiSyncAndReplication failed, may not ack correctly")
 	}
 
@@ -145,6 +146,73 @@ func (agent *ActionAgent) RefreshState(ctx context.Context) error {
 	return agent.refreshTablet(ctx, "RefreshState")
 }
 
+// GetPools returns the connection pools of the query service.
+func (agent *ActionAgent) GetPools(ctx context.Context) ([]*tabletmanagerdatapb.PoolInfo, error) {
+	return agent.QueryServiceControl.Pools(), nil
+}
+
+// ResizePool changes the settings of a connection pool of the query
+// service. It doesn't lock the agent, so that a pool can be resized
+// while other actions are running.
+func (agent *ActionAgent) ResizePool(ctx context.Context, name string, capacity int, idleTimeout time.Duration, prefillParallelism int) (*tabletmanagerdatapb.PoolInfo, error) {
+	return agent.QueryServiceControl.ResizePool(name, capacity, idleTimeout, prefillParallelism)
+}
+
+// GetLockDiagnostics returns the lock waits and the latest deadlock of
+// MySQL. It doesn't lock the agent, as it's mostly used while the
+// tablet is in trouble.
+func (agent *ActionAgent) GetLockDiagnostics(ctx context.Context, lastAutomatic bool) (*tabletmanagerdatapb.LockDiagnostics, error) {
+	return agent.QueryServiceControl.LockDiagnostics(lastAutomatic)
+}
+
+// GetQueryPlanStats returns the statistics of the queries of the plan
+// cache of the tablet server.
+func (agent *ActionAgent) GetQueryPlanStats(ctx context.Context) ([]*tabletmanagerdatapb.QueryPlanStats, error) {
+	return agent.QueryServiceControl.QueryPlanStats(), nil
+}
+
+// GetQueryBlocklist returns the entries of the query blocklist of the
+// tablet server.
+func (agent *ActionAgent) GetQueryBlocklist(ctx context.Context) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	return agent.QueryServiceControl.QueryBlocklist()
+}
+
+// UpdateQueryBlocklist approves, blocks or removes a fingerprint of the
+// query blocklist of the tablet server. The change is logged with the
+// caller of the RPC.
+func (agent *ActionAgent) UpdateQueryBlocklist(ctx context.Context, action, fingerprint, reason string) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	caller := "unknown"
+	if ci, ok := callinfo.FromContext(ctx); ok {
+		caller = ci.Text()
+	}
+	log.Infof("Query blocklist: %v %v by %v", action, fingerprint, caller)
+	return agent.QueryServiceControl.UpdateQueryBlocklist(action, fingerprint, reason)
+}
+
+// SetRuntimeFlags changes flags of the tablet without restarting it. The
+// changes are logged with the caller of the RPC. It doesn't lock the
+// agent, so that the flags can be changed while other actions are
+// running.
+func (agent *ActionAgent) SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*tabletmanagerdatapb.RuntimeFlagChange, error) {
+	caller := "unknown"
+	if ci, ok := callinfo.FromContext(ctx); ok {
+		caller = ci.Text()
+	}
+	changes, err := agent.QueryServiceControl.RuntimeFlags().Set(caller, flags)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*tabletmanagerdatapb.RuntimeFlagChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, &tabletmanagerdatapb.RuntimeFlagChange{
+			Name:     change.Name,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		})
+	}
+	return result, nil
+}
+
 // RunHealthCheck will manually run the health check on the tablet.
 func (agent *ActionAgent) RunHealthCheck(ctx context.Context) {
 	agent.runHealthCheck()
@@ -56,6 +56,20 @@ type RPCAgent interface {
 
 	RefreshState(ctx context.Context) error
 
+	GetPools(ctx context.Context) ([]*tabletmanagerdatapb.PoolInfo, error)
+
+	ResizePool(ctx context.Context, name string, capacity int, idleTimeout time.Duration, prefillParallelism int) (*tabletmanagerdatapb.PoolInfo, error)
+
+	GetLockDiagnostics(ctx context.Context, lastAutomatic bool) (*tabletmanagerdatapb.LockDiagnostics, error)
+
+	GetQueryPlanStats(ctx context.Context) ([]*tabletmanagerdatapb.QueryPlanStats, error)
+
+	GetQueryBlocklist(ctx context.Context) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error)
+
+	UpdateQueryBlocklist(ctx context.Context, action, fingerprint, reason string) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error)
+
+	SetRuntimeFlags(ctx context.Context, flags map[string]string) ([]*tabletmanagerdatapb.RuntimeFlagChange, error)
+
 	RunHealthCheck(ctx context.Context)
 
 	IgnoreHealthError(ctx context.Context, pattern string) error
@@ -144,6 +144,9 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 
 // RestoreFromBackup deletes all local data and restores anew from the latest backup.
 func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+	if err := checkManaged("RestoreFromBackup"); err != nil {
+		return err
+	}
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -149,7 +151,7 @@ func (agent *ActionAgent) StartSlave(ctx context.Context) error {
 		}
 	}()
 
-	if err := agent.fixSemiSync(agent.Tablet().Type); err != nil {
+	if err := agent.fixSemiSync(ctx, agent.Tablet().Type, nil); err != nil {
 		return err
 	}
 	return agent.MysqlDaemon.StartSlave(agent.hookExtraEnv())
@@ -182,6 +184,9 @@ func (agent *ActionAgent) GetSlaves(ctx context.Context) ([]string, error) {
 // ResetReplication completely resets the replication on the host.
 // All binary and relay logs are flushed. All replication positions are reset.
 func (agent *ActionAgent) ResetReplication(ctx context.Context) error {
+	if err := checkManaged("ResetReplication"); err != nil {
+		return err
+	}
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
@@ -193,6 +198,9 @@ func (agent *ActionAgent) ResetReplication(ctx context.Context) error {
 
 // InitMaster enables writes and returns the replication position.
 func (agent *ActionAgent) InitMaster(ctx context.Context) (string, error) {
+	if err := checkManaged("InitMaster"); err != nil {
+		return "", err
+	}
 	if err := agent.lock(ctx); err != nil {
 		return "", err
 	}
@@ -215,7 +223,7 @@ func (agent *ActionAgent) InitMaster(ctx context.Context) (string, error) {
 	}
 
 	// If using semi-sync, we need to enable it before going read-write.
-	if err := agent.fixSemiSync(topodatapb.TabletType_MASTER); err != nil {
+	if err := agent.fixSemiSync(ctx, topodatapb.TabletType_MASTER, agent.TabletAlias); err != nil {
 		return "", err
 	}
 
@@ -259,6 +267,9 @@ func (agent *ActionAgent) PopulateReparentJournal(ctx context.Context, timeCreat
 // InitSlave sets replication master and position, and waits for the
 // reparent_journal table entry up to context timeout
 func (agent *ActionAgent) InitSlave(ctx context.Context, parent *topodatapb.TabletAlias, position string, timeCreatedNS int64) error {
+	if err := checkManaged("InitSlave"); err != nil {
+		return err
+	}
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
@@ -282,7 +293,7 @@ func (agent *ActionAgent) InitSlave(ctx context.Context, parent *topodatapb.Tabl
 	if tt == topodatapb.TabletType_MASTER {
 		tt = topodatapb.TabletType_REPLICA
 	}
-	if err := agent.fixSemiSync(tt); err != nil {
+	if err := agent.fixSemiSync(ctx, tt, parent); err != nil {
 		return err
 	}
 
@@ -336,6 +347,9 @@ func (agent *ActionAgent) DemoteMaster(ctx context.Context) (string, error) {
 // If revertPartialFailure is true, and a step fails in the middle, it will try
 // to undo any changes it made.
 func (agent *ActionAgent) demoteMaster(ctx context.Context, revertPartialFailure bool) (replicationPosition string, finalErr error) {
+	if err := checkManaged("DemoteMaster"); err != nil {
+		return "", err
+	}
 	if err := agent.lock(ctx); err != nil {
 		return "", err
 	}
@@ -408,13 +422,13 @@ func (agent *ActionAgent) demoteMaster(ctx context.Context, revertPartialFailure
 	}()
 
 	// If using semi-sync, we need to disable master-side.
-	if err := agent.fixSemiSync(topodatapb.TabletType_REPLICA); err != nil {
+	if err := agent.fixSemiSync(ctx, topodatapb.TabletType_REPLICA, nil); err != nil {
 		return "", err
 	}
 	defer func() {
 		if finalErr != nil && revertPartialFailure && wasMaster {
 			// enable master-side semi-sync again
-			if err := agent.fixSemiSync(topodatapb.TabletType_MASTER); err != nil {
+			if err := agent.fixSemiSync(ctx, topodatapb.TabletType_MASTER, agent.TabletAlias); err != nil {
 				log.Warningf("fixSemiSync(MASTER) failed during revert: %v", err)
 			}
 		}
@@ -438,7 +452,7 @@ func (agent *ActionAgent) UndoDemoteMaster(ctx context.Context) error {
 	defer agent.unlock()
 
 	// If using semi-sync, we need to enable master-side.
-	if err := agent.fixSemiSync(topodatapb.TabletType_MASTER); err != nil {
+	if err := agent.fixSemiSync(ctx, topodatapb.TabletType_MASTER, agent.TabletAlias); err != nil {
 		return err
 	}
 
@@ -461,6 +475,9 @@ func (agent *ActionAgent) UndoDemoteMaster(ctx context.Context) error {
 // replication up to the provided point, and then makes the slave the
 // shard master.
 func (agent *ActionAgent) PromoteSlaveWhenCaughtUp(ctx context.Context, position string) (string, error) {
+	if err := checkManaged("PromoteSlaveWhenCaughtUp"); err != nil {
+		return "", err
+	}
 	if err := agent.lock(ctx); err != nil {
 		return "", err
 	}
@@ -481,7 +498,7 @@ func (agent *ActionAgent) PromoteSlaveWhenCaughtUp(ctx context.Context, position
 	}
 
 	// If using semi-sync, we need to enable it before going read-write.
-	if err := agent.fixSemiSync(topodatapb.TabletType_MASTER); err != nil {
+	if err := agent.fixSemiSync(ctx, topodatapb.TabletType_MASTER, agent.TabletAlias); err != nil {
 		return "", err
 	}
 
@@ -532,6 +549,9 @@ func (agent *ActionAgent) SlaveWasPromoted(ctx context.Context) error {
 // SetMaster sets replication master, and waits for the
 // reparent_journal table entry up to context timeout
 func (agent *ActionAgent) SetMaster(ctx context.Context, parentAlias *topodatapb.TabletAlias, timeCreatedNS int64, waitPosition string, forceStartSlave bool) error {
+	if err := checkManaged("SetMaster"); err != nil {
+		return err
+	}
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
@@ -631,7 +651,7 @@ func (agent *ActionAgent) setMasterLocked(ctx context.Context, parentAlias *topo
 	if tabletType == topodatapb.TabletType_MASTER {
 		tabletType = topodatapb.TabletType_REPLICA
 	}
-	if err := agent.fixSemiSync(tabletType); err != nil {
+	if err := agent.fixSemiSync(ctx, tabletType, parentAlias); err != nil {
 		return err
 	}
 
@@ -742,6 +762,9 @@ func (agent *ActionAgent) StopReplicationAndGetStatus(ctx context.Context) (*rep
 
 // PromoteSlave makes the current tablet the master
 func (agent *ActionAgent) PromoteSlave(ctx context.Context) (string, error) {
+	if err := checkManaged("PromoteSlave"); err != nil {
+		return "", err
+	}
 	if err := agent.lock(ctx); err != nil {
 		return "", err
 	}
@@ -753,7 +776,7 @@ func (agent *ActionAgent) PromoteSlave(ctx context.Context) (string, error) {
 	}
 
 	// If using semi-sync, we need to enable it before going read-write.
-	if err := agent.fixSemiSync(topodatapb.TabletType_MASTER); err != nil {
+	if err := agent.fixSemiSync(ctx, topodatapb.TabletType_MASTER, agent.TabletAlias); err != nil {
 		return "", err
 	}
 
@@ -787,29 +810,102 @@ func isMasterEligible(tabletType topodatapb.TabletType) bool {
 	return false
 }
 
-func (agent *ActionAgent) fixSemiSync(tabletType topodatapb.TabletType) error {
-	if !*enableSemiSync {
-		// Semi-sync handling is not enabled.
-		return nil
+func (agent *ActionAgent) fixSemiSync(ctx context.Context, tabletType topodatapb.TabletType, masterAlias *topodatapb.TabletAlias) error {
+	durability, err := agent.durabilityPolicy(ctx)
+	if err != nil {
+		return err
 	}
+	return agent.applySemiSync(ctx, durability, tabletType, masterAlias)
+}
 
-	// Only enable if we're eligible for becoming master (REPLICA type).
-	// Ineligible slaves (RDONLY) shouldn't ACK because we'll never promote them.
-	if !isMasterEligible(tabletType) {
-		return agent.MysqlDaemon.SetSemiSyncEnabled(false, false)
+// durabilityPolicy returns the durability policy of the keyspace of the
+// tablet, or nil if it doesn't have one. If the Keyspace record cannot be
+// read, the last known policy is used.
+func (agent *ActionAgent) durabilityPolicy(ctx context.Context) (topotools.Durabler, error) {
+	keyspace := agent.Tablet().Keyspace
+	ki, err := agent.TopoServer.GetKeyspace(ctx, keyspace)
+	agent.mutex.Lock()
+	if err != nil {
+		log.Warningf("Cannot read keyspace %v, using the last known durability policy: %v", keyspace, err)
+	} else {
+		agent._durabilityPolicy = ki.DurabilityPolicy
 	}
+	policy := agent._durabilityPolicy
+	agent.mutex.Unlock()
 
-	// Always enable slave-side since it doesn't hurt to keep it on for a master.
-	// The master-side needs to be off for a slave, or else it will get stuck.
-	return agent.MysqlDaemon.SetSemiSyncEnabled(tabletType == topodatapb.TabletType_MASTER, true)
+	durability, err := topotools.ParseDurabilityPolicy(policy)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "invalid durability policy for keyspace %v", keyspace)
+	}
+	return durability, nil
 }
 
-func (agent *ActionAgent) fixSemiSyncAndReplication(tabletType topodatapb.TabletType) error {
-	if !*enableSemiSync {
-		// Semi-sync handling is not enabled.
+// applySemiSync configures semi-sync for the provided tablet type. If the
+// keyspace has a durability policy, it decides what the tablet does, and the
+// -enable_semi_sync flag is ignored. masterAlias is the master this tablet
+// replicates from, it is read from the shard record if nil.
+// A semi-sync master always gets its number of ackers, so one left by a
+// previous policy doesn't stay in effect.
+func (agent *ActionAgent) applySemiSync(ctx context.Context, durability topotools.Durabler, tabletType topodatapb.TabletType, masterAlias *topodatapb.TabletAlias) error {
+	if durability == nil {
+		if !*enableSemiSync {
+			// Semi-sync handling is not enabled.
+			return nil
+		}
+
+		// Only enable if we're eligible for becoming master (REPLICA type).
+		// Ineligible slaves (RDONLY) shouldn't ACK because we'll never promote them.
+		if !isMasterEligible(tabletType) {
+			return agent.MysqlDaemon.SetSemiSyncEnabled(false, false)
+		}
+
+		// Always enable slave-side since it doesn't hurt to keep it on for a master.
+		// The master-side needs to be off for a slave, or else it will get stuck.
+		master := tabletType == topodatapb.TabletType_MASTER
+		if err := agent.MysqlDaemon.SetSemiSyncEnabled(master, true); err != nil {
+			return err
+		}
+		if master {
+			return agent.MysqlDaemon.SetSemiSyncAckers(1)
+		}
 		return nil
 	}
 
+	master, slave := agent.semiSyncSettings(ctx, durability, tabletType, masterAlias)
+	if m, s := agent.MysqlDaemon.SemiSyncEnabled(); m != master || s != slave {
+		if err := agent.MysqlDaemon.SetSemiSyncEnabled(master, slave); err != nil {
+			return err
+		}
+	}
+	if master {
+		if err := agent.MysqlDaemon.SetSemiSyncAckers(durability.SemiSyncAckers()); err != nil {
+			return vterrors.Wrap(err, "failed to set the number of semi-sync ackers")
+		}
+	}
+	return nil
+}
+
+// semiSyncSettings returns the master and slave semi-sync settings the
+// durability policy requires for the provided tablet type.
+func (agent *ActionAgent) semiSyncSettings(ctx context.Context, durability topotools.Durabler, tabletType topodatapb.TabletType, masterAlias *topodatapb.TabletAlias) (master, slave bool) {
+	if tabletType == topodatapb.TabletType_MASTER {
+		return durability.SemiSyncAckers() > 0, false
+	}
+	tablet := agent.Tablet()
+	if masterAlias == nil {
+		si, err := agent.TopoServer.GetShard(ctx, tablet.Keyspace, tablet.Shard)
+		if err != nil {
+			log.Warningf("Cannot read shard %v/%v to find the master, not acking semi-sync: %v", tablet.Keyspace, tablet.Shard, err)
+			return false, false
+		}
+		masterAlias = si.MasterAlias
+	}
+	tablet = proto.Clone(tablet).(*topodatapb.Tablet)
+	tablet.Type = tabletType
+	return false, durability.IsReplicaSemiSync(masterAlias, tablet)
+}
+
+func (agent *ActionAgent) fixSemiSyncAndReplication(ctx context.Context, tabletType topodatapb.TabletType) error {
 	if tabletType == topodatapb.TabletType_MASTER {
 		// Master is special. It is always handled at the
 		// right time by the reparent operations, it doesn't
@@ -817,7 +913,16 @@ func (agent *ActionAgent) fixSemiSyncAndReplication(tabletType topodatapb.Tablet
 		return nil
 	}
 
-	if err := agent.fixSemiSync(tabletType); err != nil {
+	durability, err := agent.durabilityPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if durability == nil && !*enableSemiSync {
+		// Semi-sync handling is not enabled.
+		return nil
+	}
+
+	if err := agent.applySemiSync(ctx, durability, tabletType, nil); err != nil {
 		return vterrors.Wrapf(err, "failed to fixSemiSync(%v)", tabletType)
 	}
 
@@ -834,7 +939,7 @@ func (agent *ActionAgent) fixSemiSyncAndReplication(tabletType topodatapb.Tablet
 		return nil
 	}
 
-	shouldAck := isMasterEligible(tabletType)
+	_, shouldAck := agent.MysqlDaemon.SemiSyncEnabled()
 	acking, err := agent.MysqlDaemon.SemiSyncSlaveStatus()
 	if err != nil {
 		return vterrors.Wrap(err, "failed to get SemiSyncSlaveStatus")
@@ -0,0 +1,146 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestFixSemiSyncDurabilityPolicy(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{DurabilityPolicy: "cross_cell:2"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatal(err)
+	}
+	masterAlias := &topodatapb.TabletAlias{Cell: "cell1", Uid: 1}
+	if _, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.MasterAlias = masterAlias
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := []struct {
+		name       string
+		alias      *topodatapb.TabletAlias
+		tabletType topodatapb.TabletType
+		master     *topodatapb.TabletAlias
+		wantAckers int
+		wantMaster bool
+		wantSlave  bool
+	}{{
+		name:       "master",
+		alias:      masterAlias,
+		tabletType: topodatapb.TabletType_MASTER,
+		master:     masterAlias,
+		wantAckers: 2,
+		wantMaster: true,
+	}, {
+		name:       "replica in another cell",
+		alias:      &topodatapb.TabletAlias{Cell: "cell2", Uid: 2},
+		tabletType: topodatapb.TabletType_REPLICA,
+		wantSlave:  true,
+	}, {
+		name:       "replica in the master cell",
+		alias:      &topodatapb.TabletAlias{Cell: "cell1", Uid: 3},
+		tabletType: topodatapb.TabletType_REPLICA,
+	}, {
+		name:       "replica of a master in another cell",
+		alias:      &topodatapb.TabletAlias{Cell: "cell1", Uid: 3},
+		tabletType: topodatapb.TabletType_REPLICA,
+		master:     &topodatapb.TabletAlias{Cell: "cell2", Uid: 2},
+		wantSlave:  true,
+	}, {
+		name:       "rdonly",
+		alias:      &topodatapb.TabletAlias{Cell: "cell2", Uid: 4},
+		tabletType: topodatapb.TabletType_RDONLY,
+	}}
+	for _, tcase := range testcases {
+		mysqlDaemon := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+		// Left by a previous policy.
+		mysqlDaemon.SemiSyncAckers = 3
+		agent := &ActionAgent{
+			TopoServer:  ts,
+			TabletAlias: tcase.alias,
+			MysqlDaemon: mysqlDaemon,
+			_tablet: &topodatapb.Tablet{
+				Alias:    tcase.alias,
+				Keyspace: "ks",
+				Shard:    "0",
+				Type:     tcase.tabletType,
+			},
+		}
+		if err := agent.fixSemiSync(ctx, tcase.tabletType, tcase.master); err != nil {
+			t.Errorf("%v: fixSemiSync failed: %v", tcase.name, err)
+			continue
+		}
+		if tcase.wantMaster && mysqlDaemon.SemiSyncAckers != tcase.wantAckers {
+			t.Errorf("%v: semi-sync ackers=%v, want %v", tcase.name, mysqlDaemon.SemiSyncAckers, tcase.wantAckers)
+		}
+		if mysqlDaemon.SemiSyncMasterEnabled != tcase.wantMaster || mysqlDaemon.SemiSyncSlaveEnabled != tcase.wantSlave {
+			t.Errorf("%v: semi-sync master=%v slave=%v, want master=%v slave=%v", tcase.name, mysqlDaemon.SemiSyncMasterEnabled, mysqlDaemon.SemiSyncSlaveEnabled, tcase.wantMaster, tcase.wantSlave)
+		}
+	}
+
+	// Without a policy, the -enable_semi_sync flag is used.
+	if err := ts.CreateKeyspace(ctx, "ks2", &topodatapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	mysqlDaemon := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+	agent := &ActionAgent{
+		TopoServer:  ts,
+		TabletAlias: masterAlias,
+		MysqlDaemon: mysqlDaemon,
+		_tablet: &topodatapb.Tablet{
+			Alias:    masterAlias,
+			Keyspace: "ks2",
+			Shard:    "0",
+		},
+		_durabilityPolicy: "cross_cell:2",
+	}
+	if err := agent.fixSemiSync(ctx, topodatapb.TabletType_MASTER, masterAlias); err != nil {
+		t.Fatal(err)
+	}
+	if mysqlDaemon.SemiSyncMasterEnabled || mysqlDaemon.SemiSyncSlaveEnabled {
+		t.Errorf("semi-sync is enabled without a durability policy")
+	}
+	if agent._durabilityPolicy != "" {
+		t.Errorf("_durabilityPolicy: %v, want empty", agent._durabilityPolicy)
+	}
+
+	// With -enable_semi_sync, the master waits for a single ack again.
+	*enableSemiSync = true
+	defer func() { *enableSemiSync = false }()
+	mysqlDaemon.SemiSyncAckers = 2
+	if err := agent.fixSemiSync(ctx, topodatapb.TabletType_MASTER, masterAlias); err != nil {
+		t.Fatal(err)
+	}
+	if !mysqlDaemon.SemiSyncMasterEnabled || mysqlDaemon.SemiSyncAckers != 1 {
+		t.Errorf("semi-sync master=%v ackers=%v, want master=true ackers=1", mysqlDaemon.SemiSyncMasterEnabled, mysqlDaemon.SemiSyncAckers)
+	}
+}
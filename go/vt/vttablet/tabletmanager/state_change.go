@@ -101,6 +101,7 @@ func (agent *ActionAgent) broadcastHealth() {
 	// get the replication delays
 	agent.mutex.Lock()
 	replicationDelay := agent._replicationDelay
+	readOnly := agent._readOnly
 	healthError := agent._healthy
 	terTime := agent._masterTermStartTime
 	healthyTime := agent._healthyTime
@@ -110,6 +111,7 @@ func (agent *ActionAgent) broadcastHealth() {
 	// FIXME(alainjobart,liguo) add CpuUsage
 	stats := &querypb.RealtimeStats{
 		SecondsBehindMaster: uint32(replicationDelay.Seconds()),
+		ReadOnly:            readOnly,
 	}
 	stats.SecondsBehindMasterFilteredReplication, stats.BinlogPlayersCount = vreplication.StatusSummary()
 	stats.Qps = tabletenv.QPSRates.TotalRate()
@@ -0,0 +1,103 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	unmanaged = flag.Bool("unmanaged", false, "The MySQL instance is not managed by Vitess, e.g. RDS, Aurora or an on-premises server. vttablet connects to it with -db_host and -db_port (or -db_socket), never restores, reparents or repairs its replication, and accepts -init_tablet_type master if the instance is the writable source. Use it to serve an external database and to move its tables into Vitess.")
+)
+
+// IsUnmanaged returns true if vttablet runs in front of a MySQL that
+// is not managed by Vitess.
+func IsUnmanaged() bool {
+	return *unmanaged
+}
+
+// initUnmanaged checks the flags of an unmanaged tablet and disables
+// the features that would change the state of the external MySQL.
+func (agent *ActionAgent) initUnmanaged() error {
+	if agent.Cnf != nil {
+		return fmt.Errorf("-unmanaged requires the connection parameters of the external MySQL: -db_host and -db_port, or -db_socket")
+	}
+	if *restoreFromBackup {
+		return fmt.Errorf("-restore_from_backup cannot be used with -unmanaged")
+	}
+	if !*mysqlctl.DisableActiveReparents {
+		log.Info("-unmanaged is set: disabling active reparents")
+		*mysqlctl.DisableActiveReparents = true
+	}
+	return nil
+}
+
+// checkManaged returns an error for the actions that cannot
+// be executed against an unmanaged MySQL.
+func checkManaged(action string) error {
+	if *unmanaged {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%v is not supported on an unmanaged tablet", action)
+	}
+	return nil
+}
+
+// verifyUnmanagedMysql checks that the external MySQL has the database
+// of the tablet, and the binlog settings that VReplication needs to
+// stream from it. It returns an error that lists all the problems found.
+func (agent *ActionAgent) verifyUnmanagedMysql(ctx context.Context) error {
+	var problems []string
+	dbName := agent.DBConfigs.DBName.Get()
+	qr, err := agent.MysqlDaemon.FetchSuperQuery(ctx, fmt.Sprintf("select schema_name from information_schema.schemata where schema_name = %s", sqlparser.String(sqlparser.NewStrVal([]byte(dbName)))))
+	if err != nil {
+		return vterrors.Wrap(err, "cannot connect to the external MySQL")
+	}
+	if len(qr.Rows) == 0 {
+		problems = append(problems, fmt.Sprintf("database %v does not exist, use -init_db_name_override to set the name of the database", dbName))
+	}
+
+	qr, err = agent.MysqlDaemon.FetchSuperQuery(ctx, "select @@global.gtid_mode, @@global.binlog_format, @@global.binlog_row_image")
+	if err != nil {
+		return vterrors.Wrap(err, "cannot read the binlog settings of the external MySQL")
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 3 {
+		return fmt.Errorf("unexpected result for the binlog settings of the external MySQL: %v", qr.Rows)
+	}
+	for i, want := range []struct{ name, value string }{
+		{"gtid_mode", "ON"},
+		{"binlog_format", "ROW"},
+		{"binlog_row_image", "FULL"},
+	} {
+		if got := qr.Rows[0][i].ToString(); !strings.EqualFold(got, want.value) {
+			problems = append(problems, fmt.Sprintf("%v is %v, VReplication needs %v", want.name, got, want.value))
+		}
+	}
+	if len(problems) != 0 {
+		return fmt.Errorf("%v", strings.Join(problems, "; "))
+	}
+	return nil
+}
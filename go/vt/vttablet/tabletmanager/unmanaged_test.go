@@ -0,0 +1,139 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/history"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestVerifyUnmanagedMysql(t *testing.T) {
+	schemataQuery := "select schema_name from information_schema.schemata where schema_name = 'vt_ks'"
+	settingsQuery := "select @@global.gtid_mode, @@global.binlog_format, @@global.binlog_row_image"
+	schemataFields := sqltypes.MakeTestFields("schema_name", "varchar")
+	settingsFields := sqltypes.MakeTestFields("gtid_mode|binlog_format|binlog_row_image", "varchar|varchar|varchar")
+
+	testcases := []struct {
+		schemata *sqltypes.Result
+		settings *sqltypes.Result
+		wantErr  string
+	}{{
+		schemata: sqltypes.MakeTestResult(schemataFields, "vt_ks"),
+		settings: sqltypes.MakeTestResult(settingsFields, "ON|ROW|FULL"),
+	}, {
+		schemata: sqltypes.MakeTestResult(schemataFields, "vt_ks"),
+		settings: sqltypes.MakeTestResult(settingsFields, "on|row|full"),
+	}, {
+		schemata: sqltypes.MakeTestResult(schemataFields),
+		settings: sqltypes.MakeTestResult(settingsFields, "OFF|MIXED|MINIMAL"),
+		wantErr:  "database vt_ks does not exist, use -init_db_name_override to set the name of the database; gtid_mode is OFF, VReplication needs ON; binlog_format is MIXED, VReplication needs ROW; binlog_row_image is MINIMAL, VReplication needs FULL",
+	}, {
+		wantErr: "cannot connect to the external MySQL",
+	}}
+	for _, tcase := range testcases {
+		mysqlDaemon := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+		mysqlDaemon.FetchSuperQueryMap = map[string]*sqltypes.Result{}
+		if tcase.schemata != nil {
+			mysqlDaemon.FetchSuperQueryMap[schemataQuery] = tcase.schemata
+		}
+		if tcase.settings != nil {
+			mysqlDaemon.FetchSuperQueryMap[settingsQuery] = tcase.settings
+		}
+		agent := &ActionAgent{
+			MysqlDaemon: mysqlDaemon,
+			DBConfigs:   &dbconfigs.DBConfigs{},
+		}
+		agent.DBConfigs.DBName.Set("vt_ks")
+
+		err := agent.verifyUnmanagedMysql(context.Background())
+		if tcase.wantErr == "" {
+			if err != nil {
+				t.Errorf("verifyUnmanagedMysql: %v", err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tcase.wantErr) {
+			t.Errorf("verifyUnmanagedMysql: %v, want %s", err, tcase.wantErr)
+		}
+	}
+}
+
+func TestUnmanagedTablet(t *testing.T) {
+	*unmanaged = true
+	defer func() { *unmanaged = false }()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	agent := &ActionAgent{
+		TopoServer: ts,
+		TabletAlias: &topodatapb.TabletAlias{
+			Cell: "cell1",
+			Uid:  1,
+		},
+		MysqlDaemon: fakemysqldaemon.NewFakeMysqlDaemon(nil),
+		DBConfigs:   &dbconfigs.DBConfigs{},
+		batchCtx:    ctx,
+		History:     history.New(historyLength),
+		_healthy:    fmt.Errorf("healthcheck not run yet"),
+	}
+
+	// An unmanaged tablet can be initialized as master.
+	*tabletHostname = "localhost"
+	*initKeyspace = "test_keyspace"
+	*initShard = "0"
+	*initTabletType = "master"
+	*initPopulateMetadata = false
+	defer func() {
+		*initKeyspace = ""
+		*initShard = ""
+		*initTabletType = ""
+	}()
+	if err := agent.InitTablet(1234, 3456); err != nil {
+		t.Fatalf("InitTablet failed: %v", err)
+	}
+	ti, err := ts.GetTablet(ctx, agent.TabletAlias)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Type != topodatapb.TabletType_MASTER {
+		t.Errorf("tablet type: %v, want MASTER", ti.Type)
+	}
+	if agent.masterTermStartTime().IsZero() {
+		t.Errorf("master term start time is not set")
+	}
+
+	// Actions that change the state of MySQL are refused.
+	want := "SetMaster is not supported on an unmanaged tablet"
+	if err := agent.SetMaster(ctx, nil, 0, "", false); err == nil || err.Error() != want {
+		t.Errorf("SetMaster: %v, want %s", err, want)
+	}
+	want = "PromoteSlave is not supported on an unmanaged tablet"
+	if _, err := agent.PromoteSlave(ctx); err == nil || err.Error() != want {
+		t.Errorf("PromoteSlave: %v, want %s", err, want)
+	}
+}
@@ -64,7 +64,7 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 		return &tplanv, nil
 	}
 	// select * construct was used. We need to use the field names.
-	tplan, err := rp.buildFromFields(prelim.TargetName, prelim.Lastpk, fieldEvent.Fields)
+	tplan, err := rp.buildFromFields(prelim.TargetName, prelim.Lastpk, prelim.History, fieldEvent.Fields)
 	if err != nil {
 		return nil, err
 	}
@@ -75,10 +75,11 @@ func (rp *ReplicatorPlan) buildExecutionPlan(fieldEvent *binlogdatapb.FieldEvent
 // buildFromFields builds a full TablePlan, but uses the field info as the
 // full column list. This happens when the query used was a 'select *', which
 // requires us to wait for the field info sent by the source.
-func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Result, fields []*querypb.Field) (*TablePlan, error) {
+func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Result, history bool, fields []*querypb.Field) (*TablePlan, error) {
 	tpb := &tablePlanBuilder{
-		name:   sqlparser.NewTableIdent(tableName),
-		lastpk: lastpk,
+		name:    sqlparser.NewTableIdent(tableName),
+		lastpk:  lastpk,
+		history: history,
 	}
 	for _, field := range fields {
 		colName := sqlparser.NewColIdent(field.Name)
@@ -93,6 +94,9 @@ func (rp *ReplicatorPlan) buildFromFields(tableName string, lastpk *sqltypes.Res
 		}
 		tpb.colExprs = append(tpb.colExprs, cexpr)
 	}
+	if err := tpb.analyzeHistory(); err != nil {
+		return nil, err
+	}
 	if err := tpb.analyzePK(rp.tableKeys); err != nil {
 		return nil, err
 	}
@@ -129,6 +133,12 @@ type TablePlan struct {
 	PKReferences []string
 	// Lastpk is used for delayed generation of replication queries.
 	Lastpk *sqltypes.Result
+	// History is set if the target table keeps all the versions of
+	// the rows. Changes insert a new version and set the valid_to
+	// column of the previous one, instead of updating or deleting it.
+	// The statements expect the time of the change in the "ts" bind
+	// variable, and Update is nil.
+	History bool
 	// BulkInsertFront, BulkInsertValues and BulkInsertOnDup are used
 	// by vcopier.
 	BulkInsertFront  *sqlparser.ParsedQuery
@@ -150,6 +160,7 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		TargetName   string
 		SendRule     string
 		PKReferences []string               `json:",omitempty"`
+		History      bool                   `json:",omitempty"`
 		InsertFront  *sqlparser.ParsedQuery `json:",omitempty"`
 		InsertValues *sqlparser.ParsedQuery `json:",omitempty"`
 		InsertOnDup  *sqlparser.ParsedQuery `json:",omitempty"`
@@ -160,6 +171,7 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		TargetName:   tp.TargetName,
 		SendRule:     tp.SendRule.Match,
 		PKReferences: tp.PKReferences,
+		History:      tp.History,
 		InsertFront:  tp.BulkInsertFront,
 		InsertValues: tp.BulkInsertValues,
 		InsertOnDup:  tp.BulkInsertOnDup,
@@ -170,8 +182,13 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&v)
 }
 
-func (tp *TablePlan) applyBulkInsert(rows *binlogdatapb.VStreamRowsResponse, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+// applyBulkInsert inserts the copied rows. ts is the copy time, used as
+// the start time of the rows of history tables.
+func (tp *TablePlan) applyBulkInsert(rows *binlogdatapb.VStreamRowsResponse, ts int64, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
+	if tp.History {
+		bindvars[historyTimestamp] = sqltypes.Int64BindVariable(ts)
+	}
 	var buf strings.Builder
 	if err := tp.BulkInsertFront.Append(&buf, nil, nil); err != nil {
 		return nil, err
@@ -193,10 +210,15 @@ func (tp *TablePlan) applyBulkInsert(rows *binlogdatapb.VStreamRowsResponse, exe
 	return executor(buf.String())
 }
 
-func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+// applyChange applies a row change. ts is the time of the change, used
+// as the start time of the new version for history tables.
+func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, ts int64, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	// MakeRowTrusted is needed here because Proto3ToResult is not convenient.
 	var before, after bool
 	bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
+	if tp.History {
+		bindvars[historyTimestamp] = sqltypes.Int64BindVariable(ts)
+	}
 	if rowChange.Before != nil {
 		before = true
 		vals := sqltypes.MakeRowTrusted(tp.Fields, rowChange.Before)
@@ -220,7 +242,7 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 		}
 		return execParsedQuery(tp.Delete, bindvars, executor)
 	case before && after:
-		if !tp.pkChanged(bindvars) {
+		if !tp.History && !tp.pkChanged(bindvars) {
 			return execParsedQuery(tp.Update, bindvars, executor)
 		}
 		if tp.Delete != nil {
@@ -36,6 +36,7 @@ type TestTablePlan struct {
 	TargetName   string
 	SendRule     string
 	PKReferences []string `json:",omitempty"`
+	History      bool     `json:",omitempty"`
 	InsertFront  string   `json:",omitempty"`
 	InsertValues string   `json:",omitempty"`
 	InsertOnDup  string   `json:",omitempty"`
@@ -213,6 +214,56 @@ func TestBuildPlayerPlan(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		// row filter
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, c2 from t2 where c3 = 'a' and c1 > 10",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2 from t2 where c3 = 'a' and c1 > 10",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,c2)",
+					InsertValues: "(:a_c1,:a_c2)",
+					Insert:       "insert into t1(c1,c2) values (:a_c1,:a_c2)",
+					Update:       "update t1 set c2=:a_c2 where c1=:b_c1",
+					Delete:       "delete from t1 where c1=:b_c1",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2, pk1, pk2 from t2 where c3 = 'a' and c1 > 10",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,c2)",
+					InsertValues: "(:a_c1,:a_c2)",
+					Insert:       "insert into t1(c1,c2) select :a_c1, :a_c2 from dual where (:a_pk1,:a_pk2) <= (1,'aaa')",
+					Update:       "update t1 set c2=:a_c2 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "delete from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+				},
+			},
+		},
 	}, {
 		// partial group by
 		input: &binlogdatapb.Filter{
@@ -715,3 +766,132 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+func TestBuildPlayerPlanHistory(t *testing.T) {
+	testcases := []struct {
+		input     *binlogdatapb.Rule
+		tableKeys []string
+		plan      *TestTablePlan
+		err       string
+	}{{
+		input: &binlogdatapb.Rule{
+			Match:   "t1",
+			Filter:  "select c1, c2 from t1",
+			History: true,
+		},
+		tableKeys: []string{"c1", "valid_from"},
+		plan: &TestTablePlan{
+			TargetName:   "t1",
+			SendRule:     "t1",
+			PKReferences: []string{"c1"},
+			History:      true,
+			InsertFront:  "insert into t1(c1,c2,valid_from,valid_to)",
+			InsertValues: "(:a_c1,:a_c2,from_unixtime(:ts),null)",
+			InsertOnDup:  "on duplicate key update c2=values(c2), valid_to=null",
+			Insert:       "insert into t1(c1,c2,valid_from,valid_to) values (:a_c1,:a_c2,from_unixtime(:ts),null) on duplicate key update c2=values(c2), valid_to=null",
+			Delete:       "update t1 set valid_to=from_unixtime(:ts) where c1=:b_c1 and valid_to is null",
+		},
+	}, {
+		input: &binlogdatapb.Rule{
+			Match:   "t1",
+			Filter:  "select c1, c2 from t1",
+			History: true,
+		},
+		tableKeys: []string{"c1"},
+		err:       "primary key of history table t1 must contain the primary key of the source table and valid_from",
+	}, {
+		input: &binlogdatapb.Rule{
+			Match:   "t1",
+			Filter:  "select c1, c2 as valid_to from t1",
+			History: true,
+		},
+		tableKeys: []string{"c1", "valid_from"},
+		err:       "column valid_to is reserved in history table t1",
+	}, {
+		input: &binlogdatapb.Rule{
+			Match:   "t1",
+			Filter:  "select c1, count(*) as c2 from t1 group by c1",
+			History: true,
+		},
+		tableKeys: []string{"c1", "valid_from"},
+		err:       "group by is not supported for history table t1",
+	}}
+	for _, tcase := range testcases {
+		input := &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{tcase.input},
+		}
+		plan, err := buildReplicatorPlan(input, map[string][]string{"t1": tcase.tableKeys}, nil)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("buildReplicatorPlan(%v) err: %v, want %s", tcase.input, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("buildReplicatorPlan(%v): %v", tcase.input, err)
+			continue
+		}
+		gotPlan, _ := json.Marshal(plan.TablePlans["t1"])
+		wantPlan, _ := json.Marshal(tcase.plan)
+		assert.Equal(t, string(wantPlan), string(gotPlan))
+	}
+}
+
+func TestApplyChangeHistory(t *testing.T) {
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:   "t1",
+			History: true,
+		}},
+	}
+	plan, err := buildReplicatorPlan(input, map[string][]string{"t1": {"c1", "valid_from"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tplan, err := plan.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "t1",
+		Fields:    sqltypes.MakeTestFields("c1|c2", "int64|varchar"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := []struct {
+		change *binlogdatapb.RowChange
+		want   []string
+	}{{
+		change: &binlogdatapb.RowChange{
+			After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")}),
+		},
+		want: []string{
+			"insert into t1(c1,c2,valid_from,valid_to) values (1,'a',from_unixtime(1570000000),null) on duplicate key update c2=values(c2), valid_to=null",
+		},
+	}, {
+		change: &binlogdatapb.RowChange{
+			Before: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("a")}),
+			After:  sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("b")}),
+		},
+		want: []string{
+			"update t1 set valid_to=from_unixtime(1570000000) where c1=1 and valid_to is null",
+			"insert into t1(c1,c2,valid_from,valid_to) values (1,'b',from_unixtime(1570000000),null) on duplicate key update c2=values(c2), valid_to=null",
+		},
+	}, {
+		change: &binlogdatapb.RowChange{
+			Before: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("b")}),
+		},
+		want: []string{
+			"update t1 set valid_to=from_unixtime(1570000000) where c1=1 and valid_to is null",
+		},
+	}}
+	for _, tcase := range testcases {
+		var got []string
+		_, err := tplan.applyChange(tcase.change, 1570000000, func(sql string) (*sqltypes.Result, error) {
+			got = append(got, sql)
+			return &sqltypes.Result{}, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, tcase.want, got)
+	}
+}
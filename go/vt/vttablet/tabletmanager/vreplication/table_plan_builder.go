@@ -31,6 +31,20 @@ import (
 // ExcludeStr is the filter value for excluding tables that match a rule.
 const ExcludeStr = "exclude"
 
+// The following columns are added to the rows of history tables.
+// They contain the time range during which a version of a row was
+// the current one. historyValidTo is null for the current version.
+// The time of a change is the timestamp of its binlog event, or
+// the copy time for the rows copied when the stream starts.
+const (
+	historyValidFrom = "valid_from"
+	historyValidTo   = "valid_to"
+)
+
+// historyTimestamp is the bind variable that contains the time of
+// a change, in seconds since the epoch.
+const historyTimestamp = "ts"
+
 type tablePlanBuilder struct {
 	name       sqlparser.TableIdent
 	sendSelect *sqlparser.Select
@@ -39,6 +53,7 @@ type tablePlanBuilder struct {
 	onInsert   insertType
 	pkCols     []*colExpr
 	lastpk     *sqltypes.Result
+	history    bool
 }
 
 // colExpr describes the processing to be performed to
@@ -105,7 +120,7 @@ func buildReplicatorPlan(filter *binlogdatapb.Filter, tableKeys map[string][]str
 		if rule == nil {
 			continue
 		}
-		tablePlan, err := buildTablePlan(tableName, rule.Filter, tableKeys, lastpk)
+		tablePlan, err := buildTablePlan(tableName, rule, tableKeys, lastpk)
 		if err != nil {
 			return nil, err
 		}
@@ -144,7 +159,8 @@ func MatchTable(tableName string, filter *binlogdatapb.Filter) (*binlogdatapb.Ru
 	return nil, nil
 }
 
-func buildTablePlan(tableName, filter string, tableKeys map[string][]string, lastpk *sqltypes.Result) (*TablePlan, error) {
+func buildTablePlan(tableName string, rule *binlogdatapb.Rule, tableKeys map[string][]string, lastpk *sqltypes.Result) (*TablePlan, error) {
+	filter := rule.Filter
 	query := filter
 	switch {
 	case filter == "":
@@ -178,6 +194,7 @@ func buildTablePlan(tableName, filter string, tableKeys map[string][]string, las
 			TargetName: tableName,
 			SendRule:   sendRule,
 			Lastpk:     lastpk,
+			History:    rule.History,
 		}
 		return tablePlan, nil
 	}
@@ -190,6 +207,7 @@ func buildTablePlan(tableName, filter string, tableKeys map[string][]string, las
 		},
 		selColumns: make(map[string]bool),
 		lastpk:     lastpk,
+		history:    rule.History,
 	}
 
 	if err := tpb.analyzeExprs(sel.SelectExprs); err != nil {
@@ -203,6 +221,9 @@ func buildTablePlan(tableName, filter string, tableKeys map[string][]string, las
 	if err := tpb.analyzeGroupBy(sel.GroupBy); err != nil {
 		return nil, err
 	}
+	if err := tpb.analyzeHistory(); err != nil {
+		return nil, err
+	}
 	if err := tpb.analyzePK(tableKeys); err != nil {
 		return nil, err
 	}
@@ -236,6 +257,7 @@ func (tpb *tablePlanBuilder) generate(tableKeys map[string][]string) *TablePlan
 	return &TablePlan{
 		TargetName:       tpb.name.String(),
 		Lastpk:           tpb.lastpk,
+		History:          tpb.history,
 		PKReferences:     pkrefs,
 		BulkInsertFront:  tpb.generateInsertPart(sqlparser.NewTrackedBuffer(bvf.formatter)),
 		BulkInsertValues: tpb.generateValuesPart(sqlparser.NewTrackedBuffer(bvf.formatter), bvf),
@@ -396,12 +418,38 @@ func (tpb *tablePlanBuilder) analyzeGroupBy(groupBy sqlparser.GroupBy) error {
 	return nil
 }
 
+// analyzeHistory verifies that the columns of a history table
+// can be computed for every version of a row.
+func (tpb *tablePlanBuilder) analyzeHistory() error {
+	if !tpb.history {
+		return nil
+	}
+	if tpb.onInsert != insertNormal {
+		return fmt.Errorf("group by is not supported for history table %s", tpb.name)
+	}
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.operation != opExpr {
+			return fmt.Errorf("aggregate expression is not supported for history table %s: %v", tpb.name, cexpr.colName)
+		}
+		if cexpr.colName.EqualString(historyValidFrom) || cexpr.colName.EqualString(historyValidTo) {
+			return fmt.Errorf("column %v is reserved in history table %s", cexpr.colName, tpb.name)
+		}
+	}
+	return nil
+}
+
 func (tpb *tablePlanBuilder) analyzePK(tableKeys map[string][]string) error {
 	pkcols, ok := tableKeys[tpb.name.String()]
 	if !ok {
 		return fmt.Errorf("table %s not found in schema", tpb.name)
 	}
+	hasValidFrom := false
 	for _, pkcol := range pkcols {
+		// The versions of a row are identified by their start time.
+		if tpb.history && strings.EqualFold(pkcol, historyValidFrom) {
+			hasValidFrom = true
+			continue
+		}
 		cexpr := tpb.findCol(sqlparser.NewColIdent(pkcol))
 		if cexpr == nil {
 			return fmt.Errorf("primary key column %s not found in select list", pkcol)
@@ -412,6 +460,9 @@ func (tpb *tablePlanBuilder) analyzePK(tableKeys map[string][]string) error {
 		cexpr.isPK = true
 		tpb.pkCols = append(tpb.pkCols, cexpr)
 	}
+	if tpb.history && (!hasValidFrom || len(tpb.pkCols) == 0) {
+		return fmt.Errorf("primary key of history table %s must contain the primary key of the source table and %s", tpb.name, historyValidFrom)
+	}
 	return nil
 }
 
@@ -451,6 +502,9 @@ func (tpb *tablePlanBuilder) generateInsertPart(buf *sqlparser.TrackedBuffer) *s
 		buf.Myprintf("%s%v", separator, cexpr.colName)
 		separator = ","
 	}
+	if tpb.history {
+		buf.Myprintf(",%v,%v", sqlparser.NewColIdent(historyValidFrom), sqlparser.NewColIdent(historyValidTo))
+	}
 	buf.Myprintf(")", tpb.name)
 	return buf.ParsedQuery()
 }
@@ -470,6 +524,10 @@ func (tpb *tablePlanBuilder) generateValuesPart(buf *sqlparser.TrackedBuffer, bv
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
 		}
 	}
+	if tpb.history {
+		buf.WriteString(",")
+		tpb.generateHistoryValues(buf)
+	}
 	buf.Myprintf(")")
 	return buf.ParsedQuery()
 }
@@ -490,12 +548,27 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
 		}
 	}
+	if tpb.history {
+		buf.WriteString(", ")
+		tpb.generateHistoryValues(buf)
+	}
 	buf.WriteString(" from dual where ")
 	tpb.generatePKConstraint(buf, bvf)
 	return buf.ParsedQuery()
 }
 
+// generateHistoryValues generates the values of the history columns
+// for a new version of a row.
+func (tpb *tablePlanBuilder) generateHistoryValues(buf *sqlparser.TrackedBuffer) {
+	buf.WriteString("from_unixtime(")
+	buf.WriteArg(":" + historyTimestamp)
+	buf.WriteString("),null")
+}
+
 func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
+	if tpb.history {
+		return tpb.generateHistoryOnDupPart(buf)
+	}
 	if tpb.onInsert != insertOnDup {
 		return nil
 	}
@@ -521,7 +594,26 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 	return buf.ParsedQuery()
 }
 
+// generateHistoryOnDupPart handles the versions of a row that start
+// at the same time, because the timestamps of binlog events only have
+// a precision of one second. The last one replaces the others.
+func (tpb *tablePlanBuilder) generateHistoryOnDupPart(buf *sqlparser.TrackedBuffer) *sqlparser.ParsedQuery {
+	buf.Myprintf(" on duplicate key update ")
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.isPK {
+			continue
+		}
+		buf.Myprintf("%v=values(%v), ", cexpr.colName, cexpr.colName)
+	}
+	buf.Myprintf("%v=null", sqlparser.NewColIdent(historyValidTo))
+	return buf.ParsedQuery()
+}
+
 func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
+	if tpb.history {
+		// The previous version is closed and a new one is inserted.
+		return nil
+	}
 	if tpb.onInsert == insertIgnore {
 		return tpb.generateInsertStatement()
 	}
@@ -556,6 +648,16 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 	bvf := &bindvarFormatter{}
 	buf := sqlparser.NewTrackedBuffer(bvf.formatter)
+	if tpb.history {
+		// Versions are never deleted: the current version is closed.
+		validTo := sqlparser.NewColIdent(historyValidTo)
+		buf.Myprintf("update %v set %v=from_unixtime(", tpb.name, validTo)
+		buf.WriteArg(":" + historyTimestamp)
+		buf.WriteString(")")
+		tpb.generateWhere(buf, bvf)
+		buf.Myprintf(" and %v is null", validTo)
+		return buf.ParsedQuery()
+	}
 	switch tpb.onInsert {
 	case insertNormal:
 		buf.Myprintf("delete from %v", tpb.name)
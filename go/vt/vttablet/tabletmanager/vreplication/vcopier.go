@@ -236,7 +236,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			return err
 		}
 
-		_, err = vc.tablePlan.applyBulkInsert(rows, func(sql string) (*sqltypes.Result, error) {
+		_, err = vc.tablePlan.applyBulkInsert(rows, time.Now().Unix(), func(sql string) (*sqltypes.Result, error) {
 			return vc.vr.dbClient.ExecuteWithRetry(ctx, sql)
 		})
 		if err != nil {
@@ -172,13 +172,13 @@ func (vp *vplayer) fetchAndApply(ctx context.Context) error {
 	}
 }
 
-func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.RowEvent) error {
+func (vp *vplayer) applyRowEvent(ctx context.Context, rowEvent *binlogdatapb.RowEvent, ts int64) error {
 	tplan := vp.tablePlans[rowEvent.TableName]
 	if tplan == nil {
 		return fmt.Errorf("unexpected event on table %s", rowEvent.TableName)
 	}
 	for _, change := range rowEvent.RowChanges {
-		_, err := tplan.applyChange(change, func(sql string) (*sqltypes.Result, error) {
+		_, err := tplan.applyChange(change, ts, func(sql string) (*sqltypes.Result, error) {
 			return vp.vr.dbClient.ExecuteWithRetry(ctx, sql)
 		})
 		if err != nil {
@@ -341,7 +341,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		if err := vp.vr.dbClient.Begin(); err != nil {
 			return err
 		}
-		if err := vp.applyRowEvent(ctx, event.RowEvent); err != nil {
+		if err := vp.applyRowEvent(ctx, event.RowEvent, event.Timestamp); err != nil {
 			return err
 		}
 	case binlogdatapb.VEventType_OTHER:
@@ -0,0 +1,297 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/health"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+)
+
+var (
+	warmupFromPeer     = flag.Bool("warmup_from_peer", false, "If set, a replica warms up the buffer pool of MySQL before it starts serving, after a restore or a restart: it reads the hottest queries from the /debug/query_stats page of another tablet of its shard, and replays them.")
+	warmupQueriesFile  = flag.String("warmup_queries_file", "", "If set, a replica warms up the buffer pool of MySQL with the queries of this file before it starts serving. The file has the format of the /debug/query_stats page of a tablet. It's used if -warmup_from_peer is not set, or if no peer returned its queries.")
+	warmupMaxQueries   = flag.Int("warmup_max_queries", 100, "Maximum number of queries replayed by the warm-up.")
+	warmupTimeout      = flag.Duration("warmup_timeout", 10*time.Minute, "How long the warm-up can run. The tablet starts serving when it times out.")
+	warmupQueryResults = stats.NewCountersWithSingleLabel("WarmupQueries", "Queries run to warm up the buffer pool before serving, by result", "Result")
+)
+
+// errWarmingUp is reported by the warm-up until it's done.
+var errWarmingUp = errors.New("warming up the buffer pool")
+
+// warmupQueryStats are the fields of the /debug/query_stats page the
+// warm-up uses.
+type warmupQueryStats struct {
+	Query string
+	Table string
+	// Time is the total time of the query.
+	Time time.Duration
+}
+
+// warmupReporter implements health.Reporter. It keeps a replica unhealthy,
+// hence not serving, until the buffer pool of MySQL is warmed up with the
+// hot queries of its shard. The warm-up runs once, the first time the
+// replica should serve.
+type warmupReporter struct {
+	agent *ActionAgent
+
+	mu      sync.Mutex
+	started bool
+	done    bool
+}
+
+func registerWarmupReporter(agent *ActionAgent) {
+	if !*warmupFromPeer && *warmupQueriesFile == "" {
+		return
+	}
+	health.DefaultAggregator.Register("warmup_reporter", &warmupReporter{agent: agent})
+}
+
+// Report is part of the health.Reporter interface.
+func (r *warmupReporter) Report(isSlaveType, shouldQueryServiceBeRunning bool) (time.Duration, error) {
+	if !isSlaveType || !shouldQueryServiceBeRunning {
+		return 0, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.done {
+		return 0, nil
+	}
+	if !r.started {
+		r.started = true
+		go r.run()
+	}
+	return 0, errWarmingUp
+}
+
+// HTMLName is part of the health.Reporter interface.
+func (r *warmupReporter) HTMLName() template.HTML {
+	return template.HTML("Warmup")
+}
+
+// run warms up the buffer pool, and then lets the tablet serve, even if
+// the warm-up failed.
+func (r *warmupReporter) run() {
+	defer func() {
+		r.mu.Lock()
+		r.done = true
+		r.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithTimeout(r.agent.batchCtx, *warmupTimeout)
+	defer cancel()
+	start := time.Now()
+	qstats, err := r.queryStats(ctx)
+	if err != nil {
+		log.Warningf("Cannot read the queries of the warm-up, serving without it: %v", err)
+		return
+	}
+	queries := warmupQueries(qstats, *warmupMaxQueries)
+	log.Infof("Warming up the buffer pool with %v queries", len(queries))
+	if err := r.warmup(ctx, queries); err != nil {
+		log.Warningf("Warm-up failed after %v, serving without it: %v", time.Since(start), err)
+		return
+	}
+	log.Infof("Warm-up done in %v", time.Since(start))
+}
+
+// queryStats returns the query stats of the first peer that has some, or
+// the ones of -warmup_queries_file.
+func (r *warmupReporter) queryStats(ctx context.Context) ([]warmupQueryStats, error) {
+	if *warmupFromPeer {
+		qstats, err := r.peerQueryStats(ctx)
+		if err == nil {
+			return qstats, nil
+		}
+		if *warmupQueriesFile == "" {
+			return nil, err
+		}
+		log.Warningf("Cannot read the queries of a peer, using %v: %v", *warmupQueriesFile, err)
+	}
+	data, err := ioutil.ReadFile(*warmupQueriesFile)
+	if err != nil {
+		return nil, err
+	}
+	var qstats []warmupQueryStats
+	if err := json.Unmarshal(data, &qstats); err != nil {
+		return nil, fmt.Errorf("cannot parse %v: %v", *warmupQueriesFile, err)
+	}
+	return qstats, nil
+}
+
+// peerQueryStats reads the query stats of the other tablets of the shard,
+// the ones of the same type as this tablet first.
+func (r *warmupReporter) peerQueryStats(ctx context.Context) ([]warmupQueryStats, error) {
+	tablet := r.agent.Tablet()
+	tablets, err := r.agent.TopoServer.GetTabletMapForShard(ctx, tablet.Keyspace, tablet.Shard)
+	if err != nil {
+		return nil, err
+	}
+	var aliases []string
+	for alias, ti := range tablets {
+		if !topoproto.TabletAliasEqual(ti.Alias, tablet.Alias) {
+			aliases = append(aliases, alias)
+		}
+	}
+	sort.Slice(aliases, func(i, j int) bool {
+		sameI, sameJ := tablets[aliases[i]].Type == tablet.Type, tablets[aliases[j]].Type == tablet.Type
+		if sameI != sameJ {
+			return sameI
+		}
+		return aliases[i] < aliases[j]
+	})
+	for _, alias := range aliases {
+		qstats, err := fetchQueryStats(ctx, "http://"+tablets[alias].Addr()+"/debug/query_stats")
+		if err != nil {
+			log.Warningf("Cannot read the query stats of %v: %v", alias, err)
+			continue
+		}
+		if len(qstats) > 0 {
+			log.Infof("Warming up with the queries of %v", alias)
+			return qstats, nil
+		}
+	}
+	return nil, fmt.Errorf("no tablet of shard %v/%v has query stats", tablet.Keyspace, tablet.Shard)
+}
+
+func fetchQueryStats(ctx context.Context, url string) ([]warmupQueryStats, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%v returned %v", url, resp.Status)
+	}
+	var qstats []warmupQueryStats
+	if err := json.NewDecoder(resp.Body).Decode(&qstats); err != nil {
+		return nil, err
+	}
+	return qstats, nil
+}
+
+// warmupQueries returns the queries that warm up the buffer pool for the
+// hottest queries, by total time. The selects without bind variables are
+// replayed as they are. The values of the other queries are not known, so
+// their tables are scanned instead.
+func warmupQueries(qstats []warmupQueryStats, maxQueries int) []string {
+	sort.SliceStable(qstats, func(i, j int) bool {
+		return qstats[i].Time > qstats[j].Time
+	})
+	var queries []string
+	seen := make(map[string]bool)
+	for _, qs := range qstats {
+		if len(queries) >= maxQueries {
+			break
+		}
+		query := ""
+		stmt, err := sqlparser.Parse(qs.Query)
+		switch stmt.(type) {
+		case *sqlparser.Select, *sqlparser.Union:
+			if err == nil && len(sqlparser.GetBindvars(stmt)) == 0 {
+				query = qs.Query
+			}
+		}
+		if query == "" && qs.Table != "" {
+			query = fmt.Sprintf("select count(*) from %s force index (primary)", sqlescape.EscapeID(qs.Table))
+		}
+		if query == "" || seen[query] {
+			continue
+		}
+		seen[query] = true
+		queries = append(queries, query)
+	}
+	return queries
+}
+
+// warmup runs the queries in the database of the tablet, until ctx is
+// done. Their results are discarded: MySQL reads all their rows anyway.
+// The queries that fail are skipped.
+func (r *warmupReporter) warmup(ctx context.Context, queries []string) error {
+	conn, err := r.agent.MysqlDaemon.GetDbaConnection()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// The query that runs when ctx is done is killed, so the warm-up
+	// doesn't delay serving past -warmup_timeout.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			r.killQuery(conn.ID())
+		case <-done:
+		}
+	}()
+	if _, err := conn.ExecuteFetch("use "+sqlescape.EscapeID(topoproto.TabletDbName(r.agent.Tablet())), 1, false); err != nil {
+		return err
+	}
+	for _, query := range queries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		_, err := conn.ExecuteFetch(query, 1, false)
+		if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERVitessMaxRowsExceeded {
+			err = nil
+		}
+		if err != nil {
+			warmupQueryResults.Add("Error", 1)
+			log.V(1).Infof("Warm-up query %v failed: %v", query, err)
+			if conn.IsClosed() {
+				return err
+			}
+			continue
+		}
+		warmupQueryResults.Add("Success", 1)
+	}
+	return nil
+}
+
+// killQuery kills the query that runs on a connection, if any.
+func (r *warmupReporter) killQuery(connID int64) {
+	conn, err := r.agent.MysqlDaemon.GetDbaConnection()
+	if err != nil {
+		log.Warningf("Cannot kill the warm-up query: %v", err)
+		return
+	}
+	defer conn.Close()
+	if _, err := conn.ExecuteFetch(fmt.Sprintf("kill query %d", connID), 1, false); err != nil {
+		log.Warningf("Cannot kill the warm-up query: %v", err)
+	}
+}
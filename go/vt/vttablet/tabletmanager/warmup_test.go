@@ -0,0 +1,200 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestWarmupQueries(t *testing.T) {
+	qstats := []warmupQueryStats{{
+		Query: "select * from a where id = :vtg1",
+		Table: "a",
+		Time:  time.Second,
+	}, {
+		Query: "select * from b where kind = 'x'",
+		Table: "b",
+		Time:  3 * time.Second,
+	}, {
+		Query: "select * from a where name = :vtg1",
+		Table: "a",
+		Time:  2 * time.Second,
+	}, {
+		Query: "insert into c values (1)",
+		Table: "c",
+		Time:  time.Millisecond,
+	}, {
+		Query: "select 1 from dual",
+	}}
+	want := []string{
+		"select * from b where kind = 'x'",
+		"select count(*) from `a` force index (primary)",
+		"select count(*) from `c` force index (primary)",
+		"select 1 from dual",
+	}
+	if got := warmupQueries(qstats, 10); !reflect.DeepEqual(got, want) {
+		t.Errorf("warmupQueries:\n%q, want\n%q", got, want)
+	}
+	if got := warmupQueries(qstats, 2); !reflect.DeepEqual(got, want[:2]) {
+		t.Errorf("warmupQueries(max 2):\n%q, want\n%q", got, want[:2])
+	}
+}
+
+func TestWarmupFromPeer(t *testing.T) {
+	defer func(old bool) { *warmupFromPeer = old }(*warmupFromPeer)
+	*warmupFromPeer = true
+
+	peer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/debug/query_stats" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `[{"Query": "select * from t1 where id = :vtg1", "Table": "t1", "Plan": "PASS_SELECT", "QueryCount": 10, "Time": 1000}]`)
+	}))
+	defer peer.Close()
+	host, portStr, err := net.SplitHostPort(peer.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 1},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_REPLICA,
+	}
+	peerTablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 2},
+		Hostname: host,
+		PortMap:  map[string]int32{"vt": int32(port)},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_REPLICA,
+	}
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatal(err)
+	}
+	for _, tablet := range []*topodatapb.Tablet{tablet, peerTablet} {
+		if err := ts.CreateTablet(ctx, tablet); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("use `vt_ks`", &sqltypes.Result{})
+	db.AddQuery("select count(*) from `t1` force index (primary)", sqltypes.MakeTestResult(sqltypes.MakeTestFields("count(*)", "int64"), "5"))
+	r := &warmupReporter{
+		agent: &ActionAgent{
+			TopoServer:  ts,
+			MysqlDaemon: fakemysqldaemon.NewFakeMysqlDaemon(db),
+			batchCtx:    ctx,
+			_tablet:     tablet,
+		},
+	}
+
+	// The warm-up only runs for the replicas that should serve.
+	if _, err := r.Report(false, true); err != nil {
+		t.Errorf("Report(master): %v, want nil", err)
+	}
+	if _, err := r.Report(true, false); err != nil {
+		t.Errorf("Report(not serving): %v, want nil", err)
+	}
+	if _, err := r.Report(true, true); err != errWarmingUp {
+		t.Errorf("Report(replica): %v, want %v", err, errWarmingUp)
+	}
+	for i := 0; ; i++ {
+		if _, err := r.Report(true, true); err == nil {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("the warm-up did not finish")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := db.GetQueryCalledNum("select count(*) from `t1` force index (primary)"); got != 1 {
+		t.Errorf("warm-up query run %v times, want 1", got)
+	}
+}
+
+func TestWarmupTimeout(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("use `vt_ks`", &sqltypes.Result{})
+	db.AddQuery("select count(*) from `t1` force index (primary)", &sqltypes.Result{})
+	db.AddQuery("select count(*) from `t2` force index (primary)", &sqltypes.Result{})
+	db.AddQueryPattern("kill query \\d+", &sqltypes.Result{})
+	// The first query runs past the timeout.
+	db.SetBeforeFunc("select count(*) from `t1` force index (primary)", func() {
+		time.Sleep(100 * time.Millisecond)
+	})
+	r := &warmupReporter{
+		agent: &ActionAgent{
+			MysqlDaemon: fakemysqldaemon.NewFakeMysqlDaemon(db),
+			_tablet: &topodatapb.Tablet{
+				Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 1},
+				Keyspace: "ks",
+				Shard:    "0",
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	queries := []string{"select count(*) from `t1` force index (primary)", "select count(*) from `t2` force index (primary)"}
+	if err := r.warmup(ctx, queries); err != context.DeadlineExceeded {
+		t.Errorf("warmup: %v, want %v", err, context.DeadlineExceeded)
+	}
+	// The running query is killed, and the next one doesn't run.
+	for i := 0; ; i++ {
+		if db.GetQueryCalledNum("kill query 1") == 1 {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("the warm-up query was not killed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := db.GetQueryCalledNum("select count(*) from `t2` force index (primary)"); got != 0 {
+		t.Errorf("warm-up query after the timeout run %v times, want 0", got)
+	}
+}
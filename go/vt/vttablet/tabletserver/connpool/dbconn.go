@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 // BinlogFormat is used for specifying the binlog format.
@@ -287,6 +288,39 @@ func (dbc *DBConn) VerifyMode(strictTransTables bool) (BinlogFormat, error) {
 	return 0, fmt.Errorf("unexpected binlog format for %s: %s", showBinlog, qr.Rows[0][1].ToString())
 }
 
+// WaitForPosition waits until mysqld has applied the replication
+// position, or the context is done.
+func (dbc *DBConn) WaitForPosition(ctx context.Context, pos mysql.Position) error {
+	// MasterPosition returns the GTID set mysqld has already applied
+	// locally, on a replica too: nothing to wait for if it contains pos.
+	current, err := dbc.conn.MasterPosition()
+	if err != nil {
+		return vterrors.Wrap(err, "could not read the replication position")
+	}
+	if current.AtLeast(pos) {
+		return nil
+	}
+
+	query, err := dbc.conn.WaitUntilPositionCommand(ctx, pos)
+	if err != nil {
+		return err
+	}
+	qr, err := dbc.Exec(ctx, query, 1, false)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return fmt.Errorf("unexpected result format for %s: %v", query, qr)
+	}
+	switch result := qr.Rows[0][0]; {
+	case result.IsNull():
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "replication is not running, position %v can't be reached", pos)
+	case result.ToString() == "-1":
+		return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "timed out waiting for position %v", pos)
+	}
+	return nil
+}
+
 // Close closes the DBConn.
 func (dbc *DBConn) Close() {
 	dbc.conn.Close()
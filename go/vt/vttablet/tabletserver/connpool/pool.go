@@ -45,6 +45,10 @@ var ErrConnPoolClosed = vterrors.New(vtrpcpb.Code_INTERNAL, "internal error: une
 // through non-test code.
 var usedNames = make(map[string]bool)
 
+// waitTimings tracks how long the callers waited for a connection,
+// by pool name.
+var waitTimings = stats.NewTimings("PoolWaitTimings", "Tablet server conn pool wait time", "Pool")
+
 // MySQLChecker defines the CheckMySQL interface that lower
 // level objects can use to call back into TabletServer.
 type MySQLChecker interface {
@@ -62,6 +66,7 @@ type Pool struct {
 	mu                 sync.Mutex
 	connections        *pools.ResourcePool
 	capacity           int
+	maxCapacity        int
 	prefillParallelism int
 	idleTimeout        time.Duration
 	dbaPool            *dbconnpool.ConnectionPool
@@ -122,7 +127,11 @@ func (cp *Pool) Open(appParams, dbaParams, appDebugParams *mysql.ConnParams) {
 	f := func() (pools.Resource, error) {
 		return NewDBConn(cp, appParams)
 	}
-	cp.connections = pools.NewResourcePool(f, cp.capacity, cp.capacity, cp.idleTimeout, cp.prefillParallelism)
+	maxCapacity := cp.capacity
+	if cp.maxCapacity > maxCapacity {
+		maxCapacity = cp.maxCapacity
+	}
+	cp.connections = pools.NewResourcePool(f, cp.capacity, maxCapacity, cp.idleTimeout, cp.prefillParallelism)
 	cp.appDebugParams = appDebugParams
 
 	cp.dbaPool.Open(dbaParams, tabletenv.MySQLStats)
@@ -162,7 +171,11 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 	span.Annotate("available", p.Available())
 	span.Annotate("active", p.Active())
 
+	start := time.Now()
 	r, err := p.Get(ctx)
+	if cp.name != "" {
+		waitTimings.Record(cp.name, start)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -196,6 +209,32 @@ func (cp *Pool) SetCapacity(capacity int) (err error) {
 	return nil
 }
 
+// SetMaxCapacity sets how large the capacity of the pool can grow
+// with SetCapacity. It takes effect the next time the pool is opened,
+// and the capacity is never limited below the initial capacity.
+func (cp *Pool) SetMaxCapacity(maxCapacity int) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.maxCapacity = maxCapacity
+}
+
+// SetPrefillParallelism sets how many connections are opened in
+// parallel to fill the pool. It takes effect the next time the pool
+// is opened.
+func (cp *Pool) SetPrefillParallelism(prefillParallelism int) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.prefillParallelism = prefillParallelism
+}
+
+// PrefillParallelism returns how many connections are opened in
+// parallel to fill the pool.
+func (cp *Pool) PrefillParallelism() int {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	return cp.prefillParallelism
+}
+
 // SetIdleTimeout sets the idleTimeout on the pool.
 func (cp *Pool) SetIdleTimeout(idleTimeout time.Duration) {
 	cp.mu.Lock()
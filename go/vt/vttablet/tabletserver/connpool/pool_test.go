@@ -129,6 +129,31 @@ func TestConnPoolSetCapacity(t *testing.T) {
 	}
 }
 
+func TestConnPoolSetMaxCapacity(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	connPool := newPool()
+	connPool.SetMaxCapacity(200)
+	connPool.SetPrefillParallelism(2)
+	if got := connPool.PrefillParallelism(); got != 2 {
+		t.Errorf("PrefillParallelism: %v, want 2", got)
+	}
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	if got := connPool.MaxCap(); got != 200 {
+		t.Errorf("MaxCap: %v, want 200", got)
+	}
+	if err := connPool.SetCapacity(150); err != nil {
+		t.Fatalf("set capacity should succeed up to the max capacity: %v", err)
+	}
+	if got := connPool.Capacity(); got != 150 {
+		t.Errorf("Capacity: %v, want 150", got)
+	}
+	if err := connPool.SetCapacity(201); err == nil {
+		t.Errorf("set capacity should fail above the max capacity")
+	}
+}
+
 func TestConnPoolStatJSON(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -20,6 +20,7 @@ import (
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
@@ -28,6 +29,7 @@ import (
 	"time"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -84,6 +86,30 @@ type Controller interface {
 
 	// TopoServer returns the topo server.
 	TopoServer() *topo.Server
+
+	// Pools returns the settings and usage of the connection pools.
+	Pools() []*tabletmanagerdatapb.PoolInfo
+
+	// ResizePool changes the settings of a connection pool.
+	ResizePool(name string, capacity int, idleTimeout time.Duration, prefillParallelism int) (*tabletmanagerdatapb.PoolInfo, error)
+
+	// LockDiagnostics captures the lock waits and the latest deadlock
+	// of MySQL, or returns the last report captured automatically.
+	LockDiagnostics(lastAutomatic bool) (*tabletmanagerdatapb.LockDiagnostics, error)
+
+	// QueryPlanStats returns the statistics of the queries of the
+	// plan cache.
+	QueryPlanStats() []*tabletmanagerdatapb.QueryPlanStats
+
+	// QueryBlocklist returns the entries of the query blocklist.
+	QueryBlocklist() ([]*tabletmanagerdatapb.QueryBlocklistEntry, error)
+
+	// UpdateQueryBlocklist approves, blocks or removes a fingerprint of
+	// the query blocklist, and returns its entries.
+	UpdateQueryBlocklist(action, fingerprint, reason string) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error)
+
+	// RuntimeFlags returns the flags that can be changed at runtime.
+	RuntimeFlags() *servenv.RuntimeFlagSet
 }
 
 // Ensure TabletServer satisfies Controller interface.
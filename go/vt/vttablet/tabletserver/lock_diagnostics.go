@@ -0,0 +1,252 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlnormalizer"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	innodbStatusQuery = "show engine innodb status"
+
+	// dataLockWaitsQuery lists the lock waits on MySQL 8.0, which moved
+	// them to the performance_schema.
+	dataLockWaitsQuery = "select r.trx_mysql_thread_id, r.trx_query, timestampdiff(second, r.trx_wait_started, now()), " +
+		"b.trx_mysql_thread_id, b.trx_query, concat(l.object_schema, '.', l.object_name), l.index_name, l.lock_mode " +
+		"from performance_schema.data_lock_waits w " +
+		"join information_schema.innodb_trx b on b.trx_id = w.blocking_engine_transaction_id " +
+		"join information_schema.innodb_trx r on r.trx_id = w.requesting_engine_transaction_id " +
+		"join performance_schema.data_locks l on l.engine_lock_id = w.requesting_engine_lock_id"
+
+	// innodbLockWaitsQuery lists the lock waits on the older versions
+	// of MySQL and on MariaDB.
+	innodbLockWaitsQuery = "select r.trx_mysql_thread_id, r.trx_query, timestampdiff(second, r.trx_wait_started, now()), " +
+		"b.trx_mysql_thread_id, b.trx_query, l.lock_table, l.lock_index, l.lock_mode " +
+		"from information_schema.innodb_lock_waits w " +
+		"join information_schema.innodb_trx b on b.trx_id = w.blocking_trx_id " +
+		"join information_schema.innodb_trx r on r.trx_id = w.requesting_trx_id " +
+		"join information_schema.innodb_locks l on l.lock_id = w.requested_lock_id"
+
+	// maxLockWaits is the maximum number of lock waits of a report.
+	maxLockWaits = 1000
+)
+
+var lockDiagnosticsCaptures = stats.NewCountersWithSingleLabel("LockDiagnosticsCaptures", "Lock diagnostics reports captured", "Trigger")
+
+// lockDiagnostics counts the lock wait timeouts and the deadlocks, to
+// capture the lock diagnostics automatically when they spike, and keeps
+// the last automatic report.
+type lockDiagnostics struct {
+	threshold int
+	window    time.Duration
+
+	mu          sync.Mutex
+	windowStart time.Time
+	errors      int
+	capturing   bool
+	lastCapture time.Time
+	last        *tabletmanagerdatapb.LockDiagnostics
+}
+
+func newLockDiagnostics(config tabletenv.LockDiagnosticsConfig) *lockDiagnostics {
+	return &lockDiagnostics{
+		threshold: config.LockDiagnosticsErrorThreshold,
+		window:    time.Duration(config.LockDiagnosticsWindow * 1e9),
+	}
+}
+
+// recordLockError counts a lock error, and returns true if the lock
+// diagnostics must be captured. The caller must then call
+// setAutomatic once the capture is done.
+func (ld *lockDiagnostics) recordLockError(now time.Time) bool {
+	if ld.threshold <= 0 {
+		return false
+	}
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	if now.Sub(ld.windowStart) > ld.window {
+		ld.windowStart = now
+		ld.errors = 0
+	}
+	ld.errors++
+	if ld.errors < ld.threshold || ld.capturing || now.Sub(ld.lastCapture) < ld.window {
+		return false
+	}
+	ld.capturing = true
+	return true
+}
+
+// setAutomatic records the result of an automatic capture.
+func (ld *lockDiagnostics) setAutomatic(report *tabletmanagerdatapb.LockDiagnostics, now time.Time) {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	ld.capturing = false
+	ld.lastCapture = now
+	if report != nil {
+		ld.last = report
+	}
+}
+
+func (ld *lockDiagnostics) lastAutomatic() *tabletmanagerdatapb.LockDiagnostics {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	return ld.last
+}
+
+// LockDiagnostics captures the InnoDB status and the lock waits of MySQL.
+// If lastAutomatic is set, it returns the last report captured when the
+// lock errors spiked instead.
+func (tsv *TabletServer) LockDiagnostics(lastAutomatic bool) (*tabletmanagerdatapb.LockDiagnostics, error) {
+	if lastAutomatic {
+		report := tsv.lockDiag.lastAutomatic()
+		if report == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "no lock diagnostics were captured automatically")
+		}
+		return report, nil
+	}
+	lockDiagnosticsCaptures.Add("Manual", 1)
+	return tsv.captureLockDiagnostics(false)
+}
+
+// recordLockError is called for every lock wait timeout or deadlock
+// returned by MySQL. It captures the lock diagnostics in the background
+// when they spike.
+func (tsv *TabletServer) recordLockError() {
+	if !tsv.lockDiag.recordLockError(time.Now()) {
+		return
+	}
+	lockDiagnosticsCaptures.Add("Automatic", 1)
+	go func() {
+		report, err := tsv.captureLockDiagnostics(true)
+		if err != nil {
+			log.Warningf("Cannot capture the lock diagnostics: %v", err)
+		} else {
+			log.Infof("Lock errors spiked, captured the lock diagnostics: %v lock waits", len(report.LockWaits))
+		}
+		tsv.lockDiag.setAutomatic(report, time.Now())
+	}()
+}
+
+func (tsv *TabletServer) captureLockDiagnostics(automatic bool) (*tabletmanagerdatapb.LockDiagnostics, error) {
+	if tsv.dbconfigs == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the tablet server is not initialized")
+	}
+	conn, err := dbconnpool.NewDBConnection(tsv.dbconfigs.DbaWithDB(), tabletenv.MySQLStats)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot connect to MySQL")
+	}
+	defer conn.Close()
+
+	report := &tabletmanagerdatapb.LockDiagnostics{
+		CaptureTime: time.Now().Unix(),
+		Automatic:   automatic,
+	}
+	status, err := conn.ExecuteFetch(innodbStatusQuery, 1, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot read the InnoDB status")
+	}
+	// The InnoDB status has the Type, Name and Status columns.
+	if len(status.Rows) == 1 && len(status.Rows[0]) == 3 {
+		report.InnodbStatus = status.Rows[0][2].ToString()
+		report.LatestDeadlock = latestDeadlock(report.InnodbStatus)
+	}
+
+	waits, err := conn.ExecuteFetch(dataLockWaitsQuery, maxLockWaits, false)
+	if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERNoSuchTable {
+		waits, err = conn.ExecuteFetch(innodbLockWaitsQuery, maxLockWaits, false)
+	}
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot read the lock waits")
+	}
+	for _, row := range waits.Rows {
+		if len(row) != 8 {
+			continue
+		}
+		waitingThreadID, _ := sqltypes.ToInt64(row[0])
+		waitTime, _ := sqltypes.ToInt64(row[2])
+		blockingThreadID, _ := sqltypes.ToInt64(row[3])
+		waitingQuery, blockingQuery := row[1].ToString(), row[4].ToString()
+		report.LockWaits = append(report.LockWaits, &tabletmanagerdatapb.LockWait{
+			WaitingThreadId:     waitingThreadID,
+			WaitingQuery:        waitingQuery,
+			WaitingFingerprint:  normalizedFingerprint(waitingQuery),
+			WaitTimeSeconds:     waitTime,
+			BlockingThreadId:    blockingThreadID,
+			BlockingQuery:       blockingQuery,
+			BlockingFingerprint: normalizedFingerprint(blockingQuery),
+			LockedTable:         row[5].ToString(),
+			LockedIndex:         row[6].ToString(),
+			LockMode:            row[7].ToString(),
+		})
+	}
+	return report, nil
+}
+
+// normalizedFingerprint returns the fingerprint of a query run by MySQL,
+// once normalized like vtgate does, so that it's the fingerprint of the
+// query sent by the application. It's empty if the query can't be parsed.
+func normalizedFingerprint(query string) string {
+	if query == "" {
+		return ""
+	}
+	fingerprint, err := sqlnormalizer.Fingerprint(query)
+	if err != nil {
+		return ""
+	}
+	return fingerprint
+}
+
+// latestDeadlock returns the LATEST DETECTED DEADLOCK section of the
+// InnoDB status, or "" if there was no deadlock. The sections of the
+// status have a title between two lines of dashes.
+func latestDeadlock(status string) string {
+	lines := strings.Split(status, "\n")
+	start := -1
+	for i := 1; i+1 < len(lines); i++ {
+		if !isDashes(lines[i-1]) || !isDashes(lines[i+1]) {
+			continue
+		}
+		if start >= 0 {
+			return strings.TrimSpace(strings.Join(lines[start:i-1], "\n"))
+		}
+		if lines[i] == "LATEST DETECTED DEADLOCK" {
+			start = i + 2
+		}
+	}
+	if start >= 0 && start < len(lines) {
+		return strings.TrimSpace(strings.Join(lines[start:], "\n"))
+	}
+	return ""
+}
+
+func isDashes(line string) bool {
+	return line != "" && strings.Trim(line, "-") == ""
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/queryblocklist"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+const testInnodbStatus = `
+=====================================
+2019-06-01 10:00:00 0x7f INNODB MONITOR OUTPUT
+=====================================
+------------------------
+LATEST DETECTED DEADLOCK
+------------------------
+2019-06-01 09:59:00 0x7f
+*** (1) TRANSACTION:
+update t1 set c = 2 where id = 1
+*** (2) TRANSACTION:
+update t1 set c = 3 where id = 2
+*** WE ROLL BACK TRANSACTION (2)
+------------
+TRANSACTIONS
+------------
+Trx id counter 1234
+`
+
+func TestLatestDeadlock(t *testing.T) {
+	got := latestDeadlock(testInnodbStatus)
+	if !strings.HasPrefix(got, "2019-06-01 09:59:00") || !strings.HasSuffix(got, "*** WE ROLL BACK TRANSACTION (2)") {
+		t.Errorf("latestDeadlock(): %q", got)
+	}
+	if got := latestDeadlock("------------\nTRANSACTIONS\n------------\nTrx id counter 1234\n"); got != "" {
+		t.Errorf("latestDeadlock() without deadlock: %q, want empty", got)
+	}
+}
+
+func TestNormalizedFingerprint(t *testing.T) {
+	want := queryblocklist.Fingerprint("select * from t1 where id = :vtg1")
+	if got := normalizedFingerprint("select * from t1 where id = 1"); got != want {
+		t.Errorf("normalizedFingerprint(): %v, want %v", got, want)
+	}
+	if got := normalizedFingerprint("select * from t1 where id = 2"); got != want {
+		t.Errorf("normalizedFingerprint() with another value: %v, want %v", got, want)
+	}
+	for _, query := range []string{"", "not a query"} {
+		if got := normalizedFingerprint(query); got != "" {
+			t.Errorf("normalizedFingerprint(%q): %v, want empty", query, got)
+		}
+	}
+}
+
+func TestLockDiagnosticsRecordLockError(t *testing.T) {
+	if newLockDiagnostics(tabletenv.LockDiagnosticsConfig{}).recordLockError(time.Now()) {
+		t.Error("recordLockError() without threshold: true, want false")
+	}
+
+	ld := newLockDiagnostics(tabletenv.LockDiagnosticsConfig{
+		LockDiagnosticsErrorThreshold: 3,
+		LockDiagnosticsWindow:         10,
+	})
+	now := time.Now()
+	var got []bool
+	for i := 0; i < 4; i++ {
+		got = append(got, ld.recordLockError(now))
+	}
+	if want := []bool{false, false, true, false}; !reflect.DeepEqual(got, want) {
+		t.Errorf("recordLockError(): %v, want %v", got, want)
+	}
+	ld.setAutomatic(nil, now)
+
+	// The errors of the next window don't trigger a capture
+	// until the window after the last capture is over.
+	now = now.Add(11 * time.Second)
+	for i := 0; i < 2; i++ {
+		ld.recordLockError(now)
+	}
+	if !ld.recordLockError(now) {
+		t.Error("recordLockError() after the window: false, want true")
+	}
+	report := &tabletmanagerdatapb.LockDiagnostics{Automatic: true}
+	ld.setAutomatic(report, now)
+	if got := ld.lastAutomatic(); got != report {
+		t.Errorf("lastAutomatic(): %v, want %v", got, report)
+	}
+}
+
+func TestTabletServerLockDiagnostics(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	db.AddQuery(innodbStatusQuery, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Type|Name|Status", "varchar|varchar|varchar"),
+		"InnoDB||"+testInnodbStatus,
+	))
+	db.AddRejectedQuery(dataLockWaitsQuery, mysql.NewSQLError(mysql.ERNoSuchTable, mysql.SSUnknownSQLState, "Table 'performance_schema.data_lock_waits' doesn't exist"))
+	db.AddQuery(innodbLockWaitsQuery, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"waiting_thread_id|waiting_query|wait_time|blocking_thread_id|blocking_query|lock_table|lock_index|lock_mode",
+			"int64|varchar|int64|int64|varchar|varchar|varchar|varchar",
+		),
+		"12|update t1 set c = 2 where id = 1|5|10||`vt_test_keyspace`.`t1`|PRIMARY|X",
+	))
+	tsv := newTestTabletServer(context.Background(), noFlags, db)
+	defer tsv.StopService()
+
+	if _, err := tsv.LockDiagnostics(true); err == nil || !strings.Contains(err.Error(), "no lock diagnostics") {
+		t.Errorf("LockDiagnostics(true): %v, want no lock diagnostics", err)
+	}
+	report, err := tsv.LockDiagnostics(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Automatic || !strings.Contains(report.LatestDeadlock, "WE ROLL BACK TRANSACTION (2)") {
+		t.Errorf("LockDiagnostics(false): %v", report)
+	}
+	if len(report.LockWaits) != 1 {
+		t.Fatalf("LockWaits: %v, want 1", report.LockWaits)
+	}
+	wait := report.LockWaits[0]
+	if wait.WaitingThreadId != 12 || wait.BlockingThreadId != 10 || wait.WaitTimeSeconds != 5 || wait.LockMode != "X" {
+		t.Errorf("LockWaits[0]: %v", wait)
+	}
+	if want := normalizedFingerprint("update t1 set c = 3 where id = 4"); wait.WaitingFingerprint != want {
+		t.Errorf("WaitingFingerprint: %v, want %v", wait.WaitingFingerprint, want)
+	}
+	if wait.BlockingFingerprint != "" {
+		t.Errorf("BlockingFingerprint of an idle transaction: %v, want empty", wait.BlockingFingerprint)
+	}
+}
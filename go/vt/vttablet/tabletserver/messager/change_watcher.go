@@ -0,0 +1,270 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messager
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	// changeWatcherRetryDelay is how long a changeWatcher waits before
+	// restarting a failed stream.
+	changeWatcherRetryDelay = 5 * time.Second
+
+	// ChangeStats tracks the row changes turned into messages.
+	ChangeStats = stats.NewCountersWithMultiLabels(
+		"MessageChanges",
+		"Row changes of watched tables, by message table and result",
+		[]string{"TableName", "Metric"})
+)
+
+// VStreamer defines the functions of the vstreamer engine
+// that the messager needs to watch tables for changes.
+type VStreamer interface {
+	Stream(ctx context.Context, startPos string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error
+}
+
+// changeWatcher adds a message to a message table for every row
+// change of the table it watches. The changes are read from the
+// binlogs, starting from the current position when the watcher
+// is opened. Changes are written at least once: if inserting
+// the messages fails, the stream restarts from the last
+// transaction that was successfully processed.
+//
+// The id of the message table must be an auto_increment column.
+// The message column receives a JSON document describing the
+// change, with the table, the type of change (insert, update or
+// delete) and the column values of the row before and after.
+type changeWatcher struct {
+	vs             VStreamer
+	conns          *connpool.Pool
+	masterPosition func(ctx context.Context) (string, error)
+
+	messageTable sqlparser.TableIdent
+	watchTable   string
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newChangeWatcher(vs VStreamer, conns *connpool.Pool, masterPosition func(ctx context.Context) (string, error), messageTable sqlparser.TableIdent, watchTable string) *changeWatcher {
+	return &changeWatcher{
+		vs:             vs,
+		conns:          conns,
+		masterPosition: masterPosition,
+		messageTable:   messageTable,
+		watchTable:     watchTable,
+	}
+}
+
+// Open starts watching the table.
+func (cw *changeWatcher) Open() {
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+	if cw.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(tabletenv.LocalContext())
+	cw.cancel = cancel
+	cw.wg.Add(1)
+	go cw.run(ctx)
+}
+
+// Close stops watching the table, and waits for the changes
+// being processed to be written.
+func (cw *changeWatcher) Close() {
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+	if cw.cancel == nil {
+		return
+	}
+	cw.cancel()
+	cw.cancel = nil
+	cw.wg.Wait()
+}
+
+func (cw *changeWatcher) run(ctx context.Context) {
+	defer cw.wg.Done()
+
+	name := cw.messageTable.String()
+	pos := ""
+	for {
+		var err error
+		if pos == "" {
+			pos, err = cw.masterPosition(ctx)
+		}
+		if err == nil {
+			pos, err = cw.stream(ctx, pos)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		ChangeStats.Add([]string{name, "StreamErrors"}, 1)
+		log.Errorf("Watching %s for changes to add to message table %s failed, retrying in %v: %v", cw.watchTable, name, changeWatcherRetryDelay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(changeWatcherRetryDelay):
+		}
+	}
+}
+
+// stream streams the changes of the watched table starting from
+// startPos. It returns the position of the last transaction whose
+// changes were all added to the message table.
+func (cw *changeWatcher) stream(ctx context.Context, startPos string) (string, error) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  cw.watchTable,
+			Filter: fmt.Sprintf("select * from %s", sqlparser.String(sqlparser.NewTableIdent(cw.watchTable))),
+		}},
+	}
+
+	name := cw.messageTable.String()
+	pos := startPos
+	curPos := ""
+	var fields []*querypb.Field
+	var messages [][]byte
+	err := cw.vs.Stream(ctx, startPos, filter, func(events []*binlogdatapb.VEvent) error {
+		for _, event := range events {
+			switch event.Type {
+			case binlogdatapb.VEventType_FIELD:
+				fields = event.FieldEvent.Fields
+			case binlogdatapb.VEventType_ROW:
+				for _, change := range event.RowEvent.RowChanges {
+					message, err := changeMessage(event.RowEvent.TableName, fields, change)
+					if err != nil {
+						return err
+					}
+					messages = append(messages, message)
+				}
+			case binlogdatapb.VEventType_GTID:
+				curPos = event.Gtid
+			case binlogdatapb.VEventType_COMMIT:
+				if len(messages) != 0 {
+					if err := cw.insertMessages(ctx, messages); err != nil {
+						return err
+					}
+					ChangeStats.Add([]string{name, "Messages"}, int64(len(messages)))
+					messages = nil
+				}
+				if curPos != "" {
+					pos = curPos
+				}
+			case binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER:
+				if curPos != "" {
+					pos = curPos
+				}
+			}
+		}
+		return nil
+	})
+	return pos, err
+}
+
+// insertMessages adds the messages of a transaction to the message table.
+func (cw *changeWatcher) insertMessages(ctx context.Context, messages [][]byte) error {
+	now := sqltypes.NewInt64(time.Now().UnixNano())
+	buf := bytes.Buffer{}
+	fmt.Fprintf(&buf, "insert into %s(time_scheduled, time_next, epoch, time_created, message) values ", sqlparser.String(cw.messageTable))
+	for i, message := range messages {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString("(")
+		now.EncodeSQL(&buf)
+		buf.WriteString(", ")
+		now.EncodeSQL(&buf)
+		buf.WriteString(", 0, ")
+		now.EncodeSQL(&buf)
+		buf.WriteString(", ")
+		sqltypes.NewVarBinary(string(message)).EncodeSQL(&buf)
+		buf.WriteString(")")
+	}
+
+	conn, err := cw.conns.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+	_, err = conn.Exec(ctx, buf.String(), 0, false)
+	return err
+}
+
+// rowChange is the JSON document stored in the message column for
+// a row change.
+type rowChange struct {
+	Table  string                 `json:"table"`
+	Type   string                 `json:"type"`
+	Before map[string]interface{} `json:"before,omitempty"`
+	After  map[string]interface{} `json:"after,omitempty"`
+}
+
+// changeMessage returns the message for a row change of table.
+func changeMessage(table string, fields []*querypb.Field, change *binlogdatapb.RowChange) ([]byte, error) {
+	rc := rowChange{
+		Table: table,
+	}
+	switch {
+	case change.Before == nil:
+		rc.Type = "insert"
+	case change.After == nil:
+		rc.Type = "delete"
+	default:
+		rc.Type = "update"
+	}
+	if change.Before != nil {
+		rc.Before = rowValues(fields, change.Before)
+	}
+	if change.After != nil {
+		rc.After = rowValues(fields, change.After)
+	}
+	return json.Marshal(rc)
+}
+
+func rowValues(fields []*querypb.Field, row *querypb.Row) map[string]interface{} {
+	values := sqltypes.MakeRowTrusted(fields, row)
+	result := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		if i >= len(fields) {
+			break
+		}
+		if value.IsNull() {
+			result[fields[i].Name] = nil
+			continue
+		}
+		result[fields[i].Name] = value.ToString()
+	}
+	return result
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messager
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var changeFields = []*querypb.Field{{
+	Name: "id",
+	Type: sqltypes.Int64,
+}, {
+	Name: "name",
+	Type: sqltypes.VarChar,
+}}
+
+// fakeVStreamer sends its events and then ends the stream.
+type fakeVStreamer struct {
+	startPos string
+	filter   *binlogdatapb.Filter
+	events   []*binlogdatapb.VEvent
+}
+
+func (fv *fakeVStreamer) Stream(ctx context.Context, startPos string, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
+	fv.startPos = startPos
+	fv.filter = filter
+	if err := send(fv.events); err != nil {
+		return err
+	}
+	return errors.New("stream ended")
+}
+
+func changeRow(values ...sqltypes.Value) *querypb.Row {
+	return sqltypes.RowToProto3(values)
+}
+
+func TestChangeMessage(t *testing.T) {
+	before := changeRow(sqltypes.NewInt64(1), sqltypes.NULL)
+	after := changeRow(sqltypes.NewInt64(1), sqltypes.NewVarChar("bob"))
+	testcases := []struct {
+		change *binlogdatapb.RowChange
+		want   string
+	}{{
+		change: &binlogdatapb.RowChange{After: after},
+		want:   `{"table":"t1","type":"insert","after":{"id":"1","name":"bob"}}`,
+	}, {
+		change: &binlogdatapb.RowChange{Before: before, After: after},
+		want:   `{"table":"t1","type":"update","before":{"id":"1","name":null},"after":{"id":"1","name":"bob"}}`,
+	}, {
+		change: &binlogdatapb.RowChange{Before: after},
+		want:   `{"table":"t1","type":"delete","before":{"id":"1","name":"bob"}}`,
+	}}
+	for _, tc := range testcases {
+		got, err := changeMessage("t1", changeFields, tc.change)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tc.want {
+			t.Errorf("changeMessage(%v): %s, want %s", tc.change, got, tc.want)
+		}
+	}
+}
+
+func TestChangeWatcherStream(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQueryPattern("insert into msg\\(time_scheduled, time_next, epoch, time_created, message\\) values \\(.*\\), \\(.*\\)", &sqltypes.Result{})
+
+	fv := &fakeVStreamer{
+		events: []*binlogdatapb.VEvent{{
+			Type: binlogdatapb.VEventType_BEGIN,
+		}, {
+			Type: binlogdatapb.VEventType_FIELD,
+			FieldEvent: &binlogdatapb.FieldEvent{
+				TableName: "t1",
+				Fields:    changeFields,
+			},
+		}, {
+			Type: binlogdatapb.VEventType_ROW,
+			RowEvent: &binlogdatapb.RowEvent{
+				TableName: "t1",
+				RowChanges: []*binlogdatapb.RowChange{{
+					After: changeRow(sqltypes.NewInt64(1), sqltypes.NewVarChar("bob")),
+				}, {
+					After: changeRow(sqltypes.NewInt64(2), sqltypes.NewVarChar("alice")),
+				}},
+			},
+		}, {
+			Type: binlogdatapb.VEventType_GTID,
+			Gtid: "pos2",
+		}, {
+			Type: binlogdatapb.VEventType_COMMIT,
+		}},
+	}
+	cw := newChangeWatcher(fv, newMMConnPool(db), nil, sqlparser.NewTableIdent("msg"), "t1")
+	pos, err := cw.stream(context.Background(), "pos1")
+	if err == nil || err.Error() != "stream ended" {
+		t.Errorf("stream: %v, want stream ended", err)
+	}
+	if pos != "pos2" {
+		t.Errorf("stream position: %s, want pos2", pos)
+	}
+	if fv.startPos != "pos1" {
+		t.Errorf("stream started at %s, want pos1", fv.startPos)
+	}
+	if got, want := fv.filter.Rules[0].Filter, "select * from t1"; got != want {
+		t.Errorf("filter: %s, want %s", got, want)
+	}
+
+	// If the messages can't be inserted, the position doesn't advance.
+	db2 := fakesqldb.New(t)
+	defer db2.Close()
+	cw = newChangeWatcher(fv, newMMConnPool(db2), nil, sqlparser.NewTableIdent("msg"), "t1")
+	pos, err = cw.stream(context.Background(), "pos1")
+	if err == nil || err.Error() == "stream ended" {
+		t.Errorf("stream: %v, want insert error", err)
+	}
+	if pos != "pos1" {
+		t.Errorf("stream position: %s, want pos1", pos)
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/dbconfigs"
@@ -52,18 +53,22 @@ type Engine struct {
 	mu       sync.Mutex
 	isOpen   bool
 	managers map[string]*messageManager
+	watchers map[string]*changeWatcher
 
 	tsv          TabletService
 	se           *schema.Engine
+	vs           VStreamer
 	conns        *connpool.Pool
 	postponeSema *sync2.Semaphore
 }
 
-// NewEngine creates a new Engine.
-func NewEngine(tsv TabletService, se *schema.Engine, config tabletenv.TabletConfig) *Engine {
+// NewEngine creates a new Engine. vs is used to watch tables for
+// changes. It can be nil if the feature is not needed.
+func NewEngine(tsv TabletService, se *schema.Engine, vs VStreamer, config tabletenv.TabletConfig) *Engine {
 	return &Engine{
 		tsv: tsv,
 		se:  se,
+		vs:  vs,
 		conns: connpool.New(
 			config.PoolNamePrefix+"MessagerPool",
 			config.MessagePoolSize,
@@ -73,6 +78,7 @@ func NewEngine(tsv TabletService, se *schema.Engine, config tabletenv.TabletConf
 		),
 		postponeSema: sync2.NewSemaphore(config.MessagePostponeCap, 0),
 		managers:     make(map[string]*messageManager),
+		watchers:     make(map[string]*changeWatcher),
 	}
 }
 
@@ -101,6 +107,10 @@ func (me *Engine) Close() {
 	}
 	me.isOpen = false
 	me.se.UnregisterNotifier("messages")
+	for _, cw := range me.watchers {
+		cw.Close()
+	}
+	me.watchers = make(map[string]*changeWatcher)
 	for _, mm := range me.managers {
 		mm.Close()
 	}
@@ -275,11 +285,21 @@ func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altere
 		mm := newMessageManager(me.tsv, t, me.conns, me.postponeSema)
 		me.managers[name] = mm
 		mm.Open()
+
+		if t.MessageInfo.WatchTable != "" && me.vs != nil {
+			cw := newChangeWatcher(me.vs, me.conns, me.masterPosition, t.Name, t.MessageInfo.WatchTable)
+			me.watchers[name] = cw
+			cw.Open()
+		}
 	}
 
 	// TODO(sougou): Update altered tables.
 
 	for _, name := range dropped {
+		if cw := me.watchers[name]; cw != nil {
+			cw.Close()
+			delete(me.watchers, name)
+		}
 		mm := me.managers[name]
 		if mm == nil {
 			continue
@@ -288,3 +308,18 @@ func (me *Engine) schemaChanged(tables map[string]*schema.Table, created, altere
 		delete(me.managers, name)
 	}
 }
+
+// masterPosition returns the current replication position of mysqld,
+// from which changes of watched tables are streamed.
+func (me *Engine) masterPosition(ctx context.Context) (string, error) {
+	conn, err := mysql.Connect(ctx, me.dbconfigs.DbaWithDB())
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	pos, err := conn.MasterPosition()
+	if err != nil {
+		return "", err
+	}
+	return mysql.EncodePosition(pos), nil
+}
@@ -260,7 +260,7 @@ func newTestEngine(db *fakesqldb.DB) *Engine {
 	config.PoolNamePrefix = fmt.Sprintf("Pool-%d-", randID)
 	tsv := newFakeTabletServer()
 	se := schema.NewEngine(tsv, config)
-	te := NewEngine(tsv, se, config)
+	te := NewEngine(tsv, se, nil, config)
 	te.InitDBConfig(dbconfigs.NewTestDBConfigs(*db.ConnParams(), *db.ConnParams(), ""))
 	te.Open()
 	return te
@@ -0,0 +1,285 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/queryblocklist"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// planLatencyCutoffs are the upper bounds of the buckets of the latency
+// histograms of the plans. The last bucket has no upper bound.
+var planLatencyCutoffs = []time.Duration{
+	100 * time.Microsecond,
+	250 * time.Microsecond,
+	500 * time.Microsecond,
+	1 * time.Millisecond,
+	2500 * time.Microsecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	1 * time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+	60 * time.Second,
+}
+
+// latencyHistogram counts the queries of a plan by latency, to estimate
+// the latency percentiles. It is protected by the mutex of the plan.
+type latencyHistogram struct {
+	buckets []int64
+	max     time.Duration
+}
+
+// add records count queries that took latency each.
+func (lh *latencyHistogram) add(latency time.Duration, count int64) {
+	if lh.buckets == nil {
+		lh.buckets = make([]int64, len(planLatencyCutoffs)+1)
+	}
+	i := sort.Search(len(planLatencyCutoffs), func(i int) bool {
+		return latency <= planLatencyCutoffs[i]
+	})
+	lh.buckets[i] += count
+	if latency > lh.max {
+		lh.max = latency
+	}
+}
+
+// percentile returns an estimate of the p-th percentile of the latencies:
+// the upper bound of the bucket it falls in, capped by the highest latency
+// recorded. It returns 0 if no query was recorded.
+func (lh *latencyHistogram) percentile(p float64) time.Duration {
+	var total int64
+	for _, count := range lh.buckets {
+		total += count
+	}
+	if total == 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(p / 100 * float64(total)))
+	var seen int64
+	for i, count := range lh.buckets {
+		seen += count
+		if seen < rank {
+			continue
+		}
+		if i == len(planLatencyCutoffs) || planLatencyCutoffs[i] > lh.max {
+			return lh.max
+		}
+		return planLatencyCutoffs[i]
+	}
+	return lh.max
+}
+
+// queryFingerprint returns the fingerprint of query, the query of the
+// plan. It is computed once per plan.
+func (ep *TabletPlan) queryFingerprint(query string) string {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	if ep.fingerprint == "" {
+		ep.fingerprint = queryblocklist.Fingerprint(query)
+	}
+	return ep.fingerprint
+}
+
+// LatencyPercentiles returns the estimated p50, p95 and p99 latencies of
+// the queries of the plan.
+func (ep *TabletPlan) LatencyPercentiles() (p50, p95, p99 time.Duration) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	return ep.latencies.percentile(50), ep.latencies.percentile(95), ep.latencies.percentile(99)
+}
+
+// QueryPlanStats returns the statistics of the queries of the plan cache
+// that were executed at least once.
+func (qe *QueryEngine) QueryPlanStats() []*tabletmanagerdatapb.QueryPlanStats {
+	keys := qe.plans.Keys()
+	result := make([]*tabletmanagerdatapb.QueryPlanStats, 0, len(keys))
+	for _, query := range keys {
+		plan := qe.peekQuery(query)
+		if plan == nil {
+			continue
+		}
+		queryCount, duration, mysqlTime, rowCount, errorCount := plan.Stats()
+		if queryCount == 0 {
+			continue
+		}
+		p50, p95, p99 := plan.LatencyPercentiles()
+		result = append(result, &tabletmanagerdatapb.QueryPlanStats{
+			Query:        query,
+			Fingerprint:  plan.queryFingerprint(query),
+			Table:        plan.TableName().String(),
+			Plan:         plan.PlanID.String(),
+			QueryCount:   queryCount,
+			RowCount:     rowCount,
+			ErrorCount:   errorCount,
+			TimeNs:       duration.Nanoseconds(),
+			MysqlTimeNs:  mysqlTime.Nanoseconds(),
+			P50LatencyNs: p50.Nanoseconds(),
+			P95LatencyNs: p95.Nanoseconds(),
+			P99LatencyNs: p99.Nanoseconds(),
+		})
+	}
+	return result
+}
+
+// fingerprintVarsTTL is how long the exported variables of the
+// fingerprints are reused, so the variables read by the same scrape
+// are computed once.
+const fingerprintVarsTTL = time.Second
+
+// fingerprintStats are the statistics of the queries per fingerprint.
+// Unlike the statistics of the plans, they are kept when the plans are
+// evicted from the cache, so they only grow and can be exported as
+// counters. The plans with the same fingerprint share their statistics.
+type fingerprintStats struct {
+	mu           sync.RWMutex
+	fingerprints map[string]*fingerprintCounts
+
+	// varsMu protects the following fields.
+	varsMu   sync.Mutex
+	vars     *fingerprintVars
+	varsTime time.Time
+}
+
+// fingerprintCounts are the statistics of the queries of a fingerprint.
+type fingerprintCounts struct {
+	mu         sync.Mutex
+	queryCount int64
+	rowCount   int64
+	errorCount int64
+	latencies  latencyHistogram
+}
+
+// fingerprintVars are the values of the exported variables.
+type fingerprintVars struct {
+	queryCounts map[string]int64
+	rowCounts   map[string]int64
+	errorCounts map[string]int64
+	latencies   map[string]int64
+}
+
+func newFingerprintStats() *fingerprintStats {
+	return &fingerprintStats{
+		fingerprints: make(map[string]*fingerprintCounts),
+	}
+}
+
+// add adds the stats of queries to their fingerprint.
+func (fs *fingerprintStats) add(fingerprint string, queryCount int64, duration time.Duration, rowCount, errorCount int64) {
+	fs.mu.RLock()
+	counts, ok := fs.fingerprints[fingerprint]
+	fs.mu.RUnlock()
+
+	if !ok {
+		// Check again with the write lock held and
+		// create a new record only if none exists
+		fs.mu.Lock()
+		if counts, ok = fs.fingerprints[fingerprint]; !ok {
+			counts = &fingerprintCounts{}
+			fs.fingerprints[fingerprint] = counts
+		}
+		fs.mu.Unlock()
+	}
+
+	counts.mu.Lock()
+	counts.queryCount += queryCount
+	counts.rowCount += rowCount
+	counts.errorCount += errorCount
+	if queryCount > 0 {
+		counts.latencies.add(duration/time.Duration(queryCount), queryCount)
+	}
+	counts.mu.Unlock()
+}
+
+// getVars returns the values of the exported variables. They are
+// computed at most once per fingerprintVarsTTL, and must not be modified.
+func (fs *fingerprintStats) getVars() *fingerprintVars {
+	fs.varsMu.Lock()
+	defer fs.varsMu.Unlock()
+	if fs.vars != nil && time.Since(fs.varsTime) < fingerprintVarsTTL {
+		return fs.vars
+	}
+
+	fs.mu.RLock()
+	vars := &fingerprintVars{
+		queryCounts: make(map[string]int64, len(fs.fingerprints)),
+		rowCounts:   make(map[string]int64, len(fs.fingerprints)),
+		errorCounts: make(map[string]int64, len(fs.fingerprints)),
+		latencies:   make(map[string]int64, 3*len(fs.fingerprints)),
+	}
+	for fingerprint, counts := range fs.fingerprints {
+		counts.mu.Lock()
+		vars.queryCounts[fingerprint] = counts.queryCount
+		vars.rowCounts[fingerprint] = counts.rowCount
+		vars.errorCounts[fingerprint] = counts.errorCount
+		vars.latencies[fingerprint+".p50"] = counts.latencies.percentile(50).Nanoseconds()
+		vars.latencies[fingerprint+".p95"] = counts.latencies.percentile(95).Nanoseconds()
+		vars.latencies[fingerprint+".p99"] = counts.latencies.percentile(99).Nanoseconds()
+		counts.mu.Unlock()
+	}
+	fs.mu.RUnlock()
+
+	fs.vars = vars
+	fs.varsTime = time.Now()
+	return vars
+}
+
+// addFingerprintStats adds the stats of queries of plan to those of its
+// fingerprint, if they are exported.
+func (qe *QueryEngine) addFingerprintStats(plan *TabletPlan, query string, queryCount int64, duration time.Duration, rowCount, errorCount int64) {
+	if qe.fingerprintStats == nil {
+		return
+	}
+	qe.fingerprintStats.add(plan.queryFingerprint(query), queryCount, duration, rowCount, errorCount)
+}
+
+// exportQueryPlanStats publishes the statistics of the queries, labeled
+// by fingerprint.
+func (qe *QueryEngine) exportQueryPlanStats() {
+	fs := qe.fingerprintStats
+	_ = stats.NewCountersFuncWithMultiLabels("QueryPlanCounts", "query counts per query fingerprint", []string{"Fingerprint"}, func() map[string]int64 {
+		return fs.getVars().queryCounts
+	})
+	_ = stats.NewCountersFuncWithMultiLabels("QueryPlanRowCounts", "query row counts per query fingerprint", []string{"Fingerprint"}, func() map[string]int64 {
+		return fs.getVars().rowCounts
+	})
+	_ = stats.NewCountersFuncWithMultiLabels("QueryPlanErrorCounts", "query error counts per query fingerprint", []string{"Fingerprint"}, func() map[string]int64 {
+		return fs.getVars().errorCounts
+	})
+	_ = stats.NewGaugesFuncWithMultiLabels("QueryPlanLatencyNs", "estimated query latency percentiles in ns per query fingerprint", []string{"Fingerprint", "Percentile"}, func() map[string]int64 {
+		return fs.getVars().latencies
+	})
+}
+
+// QueryPlanStats returns the statistics of the queries of the plan cache.
+func (tsv *TabletServer) QueryPlanStats() []*tabletmanagerdatapb.QueryPlanStats {
+	return tsv.qe.QueryPlanStats()
+}
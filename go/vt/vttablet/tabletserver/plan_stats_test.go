@@ -0,0 +1,174 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/queryblocklist"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestLatencyHistogram(t *testing.T) {
+	var lh latencyHistogram
+	if got := lh.percentile(50); got != 0 {
+		t.Errorf("empty percentile(50): %v, want 0", got)
+	}
+
+	// 90 fast queries, 9 slower ones and a very slow one.
+	lh.add(800*time.Microsecond, 90)
+	lh.add(20*time.Millisecond, 9)
+	lh.add(90*time.Second, 1)
+
+	testcases := []struct {
+		p    float64
+		want time.Duration
+	}{
+		{50, 1 * time.Millisecond},
+		{90, 1 * time.Millisecond},
+		{95, 25 * time.Millisecond},
+		{99, 25 * time.Millisecond},
+		{100, 90 * time.Second},
+	}
+	for _, tcase := range testcases {
+		if got := lh.percentile(tcase.p); got != tcase.want {
+			t.Errorf("percentile(%v): %v, want %v", tcase.p, got, tcase.want)
+		}
+	}
+
+	// The percentiles don't exceed the highest latency.
+	lh = latencyHistogram{}
+	lh.add(300*time.Microsecond, 1)
+	if got, want := lh.percentile(99), 300*time.Microsecond; got != want {
+		t.Errorf("percentile(99): %v, want %v", got, want)
+	}
+}
+
+func TestQueryPlanStats(t *testing.T) {
+	qe := newTestQueryEngine(10, 10*time.Second, true, &dbconfigs.DBConfigs{})
+
+	query := "select name from test_table where id = :vtg1"
+	plan := &TabletPlan{
+		Plan: &planbuilder.Plan{
+			Table:  &schema.Table{Name: sqlparser.NewTableIdent("test_table")},
+			PlanID: planbuilder.PlanPassSelect,
+		},
+	}
+	for i := 0; i < 98; i++ {
+		plan.AddStats(1, 2*time.Millisecond, time.Millisecond, 1, 0)
+	}
+	plan.AddStats(1, 40*time.Millisecond, 30*time.Millisecond, 0, 1)
+	plan.AddStats(1, 2*time.Second, time.Second, 1, 0)
+	qe.plans.Set(query, plan)
+
+	// Plans that were never executed are skipped.
+	qe.plans.Set("select 1 from dual", &TabletPlan{Plan: &planbuilder.Plan{PlanID: planbuilder.PlanPassSelect}})
+
+	want := []*tabletmanagerdatapb.QueryPlanStats{{
+		Query:        query,
+		Fingerprint:  queryblocklist.Fingerprint(query),
+		Table:        "test_table",
+		Plan:         "PASS_SELECT",
+		QueryCount:   100,
+		RowCount:     99,
+		ErrorCount:   1,
+		TimeNs:       (98*2*time.Millisecond + 40*time.Millisecond + 2*time.Second).Nanoseconds(),
+		MysqlTimeNs:  (98*time.Millisecond + 30*time.Millisecond + time.Second).Nanoseconds(),
+		P50LatencyNs: (2500 * time.Microsecond).Nanoseconds(),
+		P95LatencyNs: (2500 * time.Microsecond).Nanoseconds(),
+		P99LatencyNs: (50 * time.Millisecond).Nanoseconds(),
+	}}
+	got := qe.QueryPlanStats()
+	if len(got) != len(want) {
+		t.Fatalf("QueryPlanStats: %v, want %v", got, want)
+	}
+	for i := range want {
+		if !proto.Equal(got[i], want[i]) {
+			t.Errorf("QueryPlanStats[%d]:\n%v, want\n%v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFingerprintStats(t *testing.T) {
+	qe := newTestQueryEngine(10, 10*time.Second, true, &dbconfigs.DBConfigs{})
+	qe.fingerprintStats = newFingerprintStats()
+
+	query := "select name from test_table where id = :vtg1"
+	fingerprint := queryblocklist.Fingerprint(query)
+	newPlan := func() *TabletPlan {
+		return &TabletPlan{
+			Plan: &planbuilder.Plan{
+				Table:  &schema.Table{Name: sqlparser.NewTableIdent("test_table")},
+				PlanID: planbuilder.PlanPassSelect,
+			},
+		}
+	}
+	plan := newPlan()
+	for i := 0; i < 9; i++ {
+		qe.addFingerprintStats(plan, query, 1, 2*time.Millisecond, 1, 0)
+	}
+
+	// The plan is evicted, then built again: the stats of the
+	// fingerprint keep growing, and the latencies of both plans
+	// are merged.
+	plan = newPlan()
+	qe.addFingerprintStats(plan, query, 1, 40*time.Millisecond, 0, 1)
+	if got := plan.queryFingerprint(query); got != fingerprint {
+		t.Errorf("queryFingerprint: %v, want %v", got, fingerprint)
+	}
+
+	vars := qe.fingerprintStats.getVars()
+	wantCounts := map[string]int64{fingerprint: 10}
+	if !reflect.DeepEqual(vars.queryCounts, wantCounts) {
+		t.Errorf("queryCounts: %v, want %v", vars.queryCounts, wantCounts)
+	}
+	wantRows := map[string]int64{fingerprint: 9}
+	if !reflect.DeepEqual(vars.rowCounts, wantRows) {
+		t.Errorf("rowCounts: %v, want %v", vars.rowCounts, wantRows)
+	}
+	wantErrors := map[string]int64{fingerprint: 1}
+	if !reflect.DeepEqual(vars.errorCounts, wantErrors) {
+		t.Errorf("errorCounts: %v, want %v", vars.errorCounts, wantErrors)
+	}
+	wantLatencies := map[string]int64{
+		fingerprint + ".p50": (2500 * time.Microsecond).Nanoseconds(),
+		fingerprint + ".p95": (40 * time.Millisecond).Nanoseconds(),
+		fingerprint + ".p99": (40 * time.Millisecond).Nanoseconds(),
+	}
+	if !reflect.DeepEqual(vars.latencies, wantLatencies) {
+		t.Errorf("latencies: %v, want %v", vars.latencies, wantLatencies)
+	}
+
+	// The variables read by the same scrape share their values.
+	qe.addFingerprintStats(plan, query, 1, 2*time.Millisecond, 1, 0)
+	if got := qe.fingerprintStats.getVars(); got != vars {
+		t.Errorf("getVars computed the variables again")
+	}
+	qe.fingerprintStats.varsTime = time.Now().Add(-fingerprintVarsTTL)
+	if got := qe.fingerprintStats.getVars().queryCounts[fingerprint]; got != 11 {
+		t.Errorf("queryCounts after the TTL: %v, want 11", got)
+	}
+}
@@ -24,6 +24,8 @@ import (
 // DDLPlan provides a plan for DDLs.
 type DDLPlan struct {
 	Action string
+	// TableName is the table altered by an ALTER TABLE.
+	TableName string
 }
 
 // DDLParse parses a DDL and produces a DDLPlan.
@@ -36,9 +38,13 @@ func DDLParse(sql string) (plan *DDLPlan) {
 	if !ok {
 		return &DDLPlan{Action: ""}
 	}
-	return &DDLPlan{
+	plan = &DDLPlan{
 		Action: stmt.Action,
 	}
+	if stmt.Action == sqlparser.AlterStr {
+		plan.TableName = stmt.Table.Name.String()
+	}
+	return plan
 }
 
 func analyzeDDL(ddl *sqlparser.DDL, tables map[string]*schema.Table) *Plan {
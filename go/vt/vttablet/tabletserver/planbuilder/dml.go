@@ -54,6 +54,7 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 	if tableErr == nil && table.IsTopic() {
 		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "updates not allowed on topics")
 	}
+	plan.Unbounded = isUnbounded(upd.Where, upd.Limit, table)
 
 	// In passthrough dml mode, allow the operation even if the
 	// table is unknown in the schema.
@@ -98,6 +99,10 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 
 	plan.PlanID = PlanDMLSubquery
 	plan.Subquery = GenerateUpdateSubquery(upd, table, aliased)
+	// The chunks are ranges of the primary key, so it must not change.
+	if len(table.Indexes[0].Columns) == 1 && upd.OrderBy == nil && upd.Limit == nil && plan.SecondaryPKValues == nil {
+		plan.ChunkSubquery, plan.NextChunkSubquery = GenerateChunkSubqueries(table.Indexes[0].Columns[0], aliased, upd.Where)
+	}
 	return plan, nil
 }
 
@@ -127,6 +132,7 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 	if tableErr == nil && table.IsTopic() {
 		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "deletes not allowed on topics")
 	}
+	plan.Unbounded = isUnbounded(del.Where, del.Limit, table)
 
 	// In passthrough dml mode, allow the operation even if the
 	// table is unknown in the schema.
@@ -162,6 +168,9 @@ func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan
 
 	plan.PlanID = PlanDMLSubquery
 	plan.Subquery = GenerateDeleteSubquery(del, table, aliased)
+	if len(table.Indexes[0].Columns) == 1 && del.OrderBy == nil && del.Limit == nil {
+		plan.ChunkSubquery, plan.NextChunkSubquery = GenerateChunkSubqueries(table.Indexes[0].Columns[0], aliased, del.Where)
+	}
 	return plan, nil
 }
 
@@ -203,6 +212,12 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 		plan.PlanID = PlanSelectLock
 	}
 
+	// The tables of a select with common table expressions may not
+	// be in the schema. Such selects are passed through as is.
+	if sel.With != nil {
+		return plan, nil
+	}
+
 	tableName := analyzeFrom(sel.From)
 	if tableName.IsEmpty() {
 		return plan, nil
@@ -238,10 +253,60 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 		plan.PKValues = []sqltypes.PlanValue{v}
 		plan.FieldQuery = nil
 		plan.FullQuery = nil
+		return plan, nil
+	}
+
+	if node, values := analyzePartitionValues(sel, table); node != nil {
+		plan.PartitionValues = values
+		plan.FullQuery = GeneratePartitionLimitQuery(sel, node)
 	}
 	return plan, nil
 }
 
+// analyzePartitionValues returns the values of the partitioning column
+// of the table that the where clause of a select requires, if the
+// partitions of the table can be pruned. node is the table expression
+// that the partition selection must be added to. It is nil if the
+// partitions can't be pruned, or if they're selected by the query.
+func analyzePartitionValues(sel *sqlparser.Select, table *schema.Table) (node *sqlparser.AliasedTableExpr, values sqltypes.PlanValue) {
+	if !table.PartitionInfo.CanPrune() || sel.Where == nil {
+		return nil, values
+	}
+	node, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok || len(node.Partitions) != 0 {
+		return nil, values
+	}
+	qualifier := table.Name
+	if !node.As.IsEmpty() {
+		qualifier = node.As
+	}
+	for _, filter := range sqlparser.SplitAndExpression(nil, sel.Where.Expr) {
+		comp, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok {
+			continue
+		}
+		switch {
+		case comp.Operator == sqlparser.EqualStr && sqlparser.IsValue(comp.Right):
+		case comp.Operator == sqlparser.InStr && sqlparser.IsSimpleTuple(comp.Right):
+		default:
+			continue
+		}
+		col, ok := comp.Left.(*sqlparser.ColName)
+		if !ok || !col.Name.Equal(table.PartitionInfo.Column) {
+			continue
+		}
+		if !col.Qualifier.IsEmpty() && (!col.Qualifier.Qualifier.IsEmpty() || col.Qualifier.Name != qualifier) {
+			continue
+		}
+		pv, err := sqlparser.NewPlanValue(comp.Right)
+		if err != nil || pv.IsNull() {
+			continue
+		}
+		return node, pv
+	}
+	return nil, values
+}
+
 func analyzeFrom(tableExprs sqlparser.TableExprs) sqlparser.TableIdent {
 	if len(tableExprs) > 1 {
 		return sqlparser.NewTableIdent("")
@@ -253,6 +318,95 @@ func analyzeFrom(tableExprs sqlparser.TableExprs) sqlparser.TableIdent {
 	return sqlparser.GetTableName(node.Expr)
 }
 
+// isUnbounded returns true for the updates and deletes without a limit
+// whose where clause doesn't select their rows by values, or by a range
+// bounded on both sides, of the first column of an index of table: a
+// condition like id > 0 or id is not null can match all the rows. If
+// the table is unknown, only the statements without a where clause are
+// unbounded.
+func isUnbounded(where *sqlparser.Where, limit *sqlparser.Limit, table *schema.Table) bool {
+	if limit != nil {
+		return false
+	}
+	if where == nil {
+		return true
+	}
+	if table == nil {
+		return false
+	}
+	// lower and upper are the index columns that have a lower and an
+	// upper bound.
+	lower := make(map[string]bool)
+	upper := make(map[string]bool)
+	for _, expr := range sqlparser.SplitAndExpression(nil, where.Expr) {
+		switch expr := expr.(type) {
+		case *sqlparser.ComparisonExpr:
+			left, right, operator := expr.Left, expr.Right, expr.Operator
+			if _, ok := left.(*sqlparser.ColName); !ok {
+				left, right, operator = right, left, reversedOperators[operator]
+			}
+			col := indexColumn(left, table)
+			if col == "" {
+				continue
+			}
+			switch operator {
+			case sqlparser.EqualStr, sqlparser.NullSafeEqualStr:
+				if sqlparser.IsValue(right) {
+					return false
+				}
+			case sqlparser.InStr:
+				if sqlparser.IsSimpleTuple(right) {
+					return false
+				}
+			case sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+				lower[col] = lower[col] || sqlparser.IsValue(right)
+			case sqlparser.LessThanStr, sqlparser.LessEqualStr:
+				upper[col] = upper[col] || sqlparser.IsValue(right)
+			}
+		case *sqlparser.RangeCond:
+			if expr.Operator == sqlparser.BetweenStr && indexColumn(expr.Left, table) != "" && sqlparser.IsValue(expr.From) && sqlparser.IsValue(expr.To) {
+				return false
+			}
+		case *sqlparser.IsExpr:
+			if expr.Operator == sqlparser.IsNullStr && indexColumn(expr.Expr, table) != "" {
+				return false
+			}
+		}
+	}
+	for col := range lower {
+		if upper[col] {
+			return false
+		}
+	}
+	return true
+}
+
+// reversedOperators are the comparison operators to use when the
+// operands of a comparison are swapped, as in 5 = id.
+var reversedOperators = map[string]string{
+	sqlparser.EqualStr:         sqlparser.EqualStr,
+	sqlparser.NullSafeEqualStr: sqlparser.NullSafeEqualStr,
+	sqlparser.LessThanStr:      sqlparser.GreaterThanStr,
+	sqlparser.LessEqualStr:     sqlparser.GreaterEqualStr,
+	sqlparser.GreaterThanStr:   sqlparser.LessThanStr,
+	sqlparser.GreaterEqualStr:  sqlparser.LessEqualStr,
+}
+
+// indexColumn returns the lowered name of expr if it's the first column
+// of an index of table, "" otherwise.
+func indexColumn(expr sqlparser.Expr, table *schema.Table) string {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return ""
+	}
+	for _, index := range table.Indexes {
+		if len(index.Columns) > 0 && index.Columns[0].Equal(col.Name) {
+			return col.Name.Lowered()
+		}
+	}
+	return ""
+}
+
 func analyzeWhere(node *sqlparser.Where, pkIndex *schema.Index) []sqltypes.PlanValue {
 	if node == nil {
 		return nil
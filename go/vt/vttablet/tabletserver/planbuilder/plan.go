@@ -87,6 +87,12 @@ const (
 	PlanMessageStream
 	// PlanSelectImpossible is used for where or having clauses that can never be true.
 	PlanSelectImpossible
+	// PlanSavepoint is for SAVEPOINT, ROLLBACK TO SAVEPOINT and
+	// RELEASE SAVEPOINT statements.
+	PlanSavepoint
+	// PlanTemporaryTable is for CREATE and DROP TEMPORARY TABLE
+	// statements, which need a reserved connection.
+	PlanTemporaryTable
 	// NumPlans stores the total number of plans
 	NumPlans
 )
@@ -111,6 +117,8 @@ var planName = [NumPlans]string{
 	"OTHER_ADMIN",
 	"MESSAGE_STREAM",
 	"SELECT_IMPOSSIBLE",
+	"SAVEPOINT",
+	"TEMPORARY_TABLE",
 }
 
 func (pt PlanType) String() string {
@@ -229,6 +237,24 @@ type Plan struct {
 
 	// For PlanInsertSubquery: pk columns in the subquery result.
 	SubqueryPKColumns []int
+
+	// For PlanDMLSubquery on a table with a single-column primary key:
+	// the subqueries that select the chunks of rows of the chunked
+	// execution (see queryserver-config-chunked-dml-rows).
+	ChunkSubquery     *sqlparser.ParsedQuery
+	NextChunkSubquery *sqlparser.ParsedQuery
+
+	// Unbounded is set for the updates and deletes without a limit
+	// whose where clause doesn't select their rows by values or by a
+	// bounded range of a key of the table. They are
+	// rejected if queryserver-config-safe-dmls is set.
+	Unbounded bool
+
+	// PartitionValues is set for the selects that restrict the
+	// partitioning column of a partitioned table to some values.
+	// FullQuery then has a :#partitions placeholder for the selection
+	// of the partitions of these values.
+	PartitionValues sqltypes.PlanValue
 }
 
 // TableName returns the table name for the plan.
@@ -314,6 +340,9 @@ func BuildStreaming(sql string, tables map[string]*schema.Table) (*Plan, error)
 		if stmt.Lock != "" {
 			return nil, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "select with lock not allowed for streaming")
 		}
+		if stmt.With != nil {
+			break
+		}
 		if tableName := analyzeFrom(stmt.From); !tableName.IsEmpty() {
 			plan.setTable(tableName, tables)
 		}
@@ -26,6 +26,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -181,6 +182,9 @@ func TestDDLPlan(t *testing.T) {
 			t.Fatalf("Error marshalling %v", plan)
 		}
 		matchString(t, tcase.lineno, expected["Action"], plan.Action)
+		if tableName, ok := expected["TableName"]; ok && plan.Action == "alter" {
+			matchString(t, tcase.lineno, tableName, plan.TableName)
+		}
 	}
 }
 
@@ -221,6 +225,150 @@ func TestMessageStreamingPlan(t *testing.T) {
 	}
 }
 
+func TestPartitionPlan(t *testing.T) {
+	table := schema.NewTable("p")
+	table.AddColumn("id", sqltypes.Int64, sqltypes.NULL, "")
+	table.AddColumn("c", sqltypes.Int64, sqltypes.NULL, "")
+	table.PartitionInfo = schema.NewPartitionInfo("RANGE", "`id`",
+		&schema.Partition{Name: "p0", Description: "10"},
+		&schema.Partition{Name: "p1", Description: "MAXVALUE"},
+	)
+	tables := map[string]*schema.Table{"p": table}
+
+	testcases := []struct {
+		query     string
+		fullQuery string
+		values    sqltypes.PlanValue
+	}{{
+		query:     "select * from p where id = 5 and c = 1",
+		fullQuery: "select * from p:#partitions where id = 5 and c = 1 limit :#maxLimit",
+		values:    sqltypes.PlanValue{Value: sqltypes.NewInt64(5)},
+	}, {
+		query:     "select * from p as x use index (c) where c > 1 and x.id in (:a, 12)",
+		fullQuery: "select * from p:#partitions as x use index (c) where c > 1 and x.id in (:a, 12) limit :#maxLimit",
+		values:    sqltypes.PlanValue{Values: []sqltypes.PlanValue{{Key: "a"}, {Value: sqltypes.NewInt64(12)}}},
+	}, {
+		query:     "select * from p where p.id in ::list",
+		fullQuery: "select * from p:#partitions where p.id in ::list limit :#maxLimit",
+		values:    sqltypes.PlanValue{ListKey: "list"},
+	}, {
+		query:     "select * from p partition (p0) where id = 5",
+		fullQuery: "select * from p partition (p0) where id = 5 limit :#maxLimit",
+	}, {
+		query:     "select * from p as x where p.id = 5",
+		fullQuery: "select * from p as x where p.id = 5 limit :#maxLimit",
+	}, {
+		query:     "select * from p where id = 5 or id = 12",
+		fullQuery: "select * from p where id = 5 or id = 12 limit :#maxLimit",
+	}, {
+		query:     "select * from p where c = 5",
+		fullQuery: "select * from p where c = 5 limit :#maxLimit",
+	}}
+	for _, tcase := range testcases {
+		statement, err := sqlparser.Parse(tcase.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		plan, err := Build(statement, tables)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := plan.FullQuery.Query; got != tcase.fullQuery {
+			t.Errorf("Build(%s).FullQuery: %s, want %s", tcase.query, got, tcase.fullQuery)
+		}
+		if !reflect.DeepEqual(plan.PartitionValues, tcase.values) {
+			t.Errorf("Build(%s).PartitionValues: %+v, want %+v", tcase.query, plan.PartitionValues, tcase.values)
+		}
+	}
+}
+
+func TestSafeDMLPlan(t *testing.T) {
+	testSchema := loadSchema("schema_test.json")
+	testcases := []struct {
+		query     string
+		unbounded bool
+		chunk     string
+		nextChunk string
+	}{{
+		query:     "delete from d",
+		unbounded: true,
+		chunk:     "select name from d order by name asc limit :#chunk_size for update",
+		nextChunk: "select name from d where name > :#last_pk order by name asc limit :#chunk_size for update",
+	}, {
+		query:     "update d set foo = 1 where foo = 2 or bar = 3",
+		unbounded: true,
+		chunk:     "select name from d where foo = 2 or bar = 3 order by name asc limit :#chunk_size for update",
+		nextChunk: "select name from d where (foo = 2 or bar = 3) and name > :#last_pk order by name asc limit :#chunk_size for update",
+	}, {
+		query:     "delete from d where id = 5 and foo = 2",
+		chunk:     "select name from d where id = 5 and foo = 2 order by name asc limit :#chunk_size for update",
+		nextChunk: "select name from d where (id = 5 and foo = 2) and name > :#last_pk order by name asc limit :#chunk_size for update",
+	}, {
+		// A single-sided range can match all the rows.
+		query:     "delete from d where id > 0",
+		unbounded: true,
+		chunk:     "select name from d where id > 0 order by name asc limit :#chunk_size for update",
+		nextChunk: "select name from d where (id > 0) and name > :#last_pk order by name asc limit :#chunk_size for update",
+	}, {
+		query:     "delete from d where id is not null",
+		unbounded: true,
+		chunk:     "select name from d where id is not null order by name asc limit :#chunk_size for update",
+		nextChunk: "select name from d where (id is not null) and name > :#last_pk order by name asc limit :#chunk_size for update",
+	}, {
+		query:     "delete from d where 5 = id",
+		chunk:     "select name from d where 5 = id order by name asc limit :#chunk_size for update",
+		nextChunk: "select name from d where (5 = id) and name > :#last_pk order by name asc limit :#chunk_size for update",
+	}, {
+		query:     "delete from d where 5 < id and id <= 10",
+		chunk:     "select name from d where 5 < id and id <= 10 order by name asc limit :#chunk_size for update",
+		nextChunk: "select name from d where (5 < id and id <= 10) and name > :#last_pk order by name asc limit :#chunk_size for update",
+	}, {
+		query:     "delete from d where 5 < id and 10 < id",
+		unbounded: true,
+		chunk:     "select name from d where 5 < id and 10 < id order by name asc limit :#chunk_size for update",
+		nextChunk: "select name from d where (5 < id and 10 < id) and name > :#last_pk order by name asc limit :#chunk_size for update",
+	}, {
+		query:     "delete from d where id = foo",
+		unbounded: true,
+		chunk:     "select name from d where id = foo order by name asc limit :#chunk_size for update",
+		nextChunk: "select name from d where (id = foo) and name > :#last_pk order by name asc limit :#chunk_size for update",
+	}, {
+		query: "delete from d where bar between 1 and 2 limit 10",
+	}, {
+		// The primary key changes.
+		query: "update d set name = 'a' where id = 1",
+	}, {
+		// The primary key has two columns.
+		query: "delete from b where eid = 1",
+	}, {
+		query:     "update b set name = 'a' where name = 'b'",
+		unbounded: true,
+	}}
+	for _, tcase := range testcases {
+		statement, err := sqlparser.Parse(tcase.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		plan, err := Build(statement, testSchema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if plan.Unbounded != tcase.unbounded {
+			t.Errorf("Build(%s).Unbounded: %v, want %v", tcase.query, plan.Unbounded, tcase.unbounded)
+		}
+		var chunk, nextChunk string
+		if plan.ChunkSubquery != nil {
+			chunk, nextChunk = plan.ChunkSubquery.Query, plan.NextChunkSubquery.Query
+		}
+		if chunk != tcase.chunk {
+			t.Errorf("Build(%s).ChunkSubquery: %s, want %s", tcase.query, chunk, tcase.chunk)
+		}
+		if nextChunk != tcase.nextChunk {
+			t.Errorf("Build(%s).NextChunkSubquery: %s, want %s", tcase.query, nextChunk, tcase.nextChunk)
+		}
+	}
+}
+
 func matchString(t *testing.T, line int, expected interface{}, actual string) {
 	if expected != nil {
 		if expected.(string) != actual {
@@ -66,6 +66,33 @@ func GenerateLimitQuery(selStmt sqlparser.SelectStatement) *sqlparser.ParsedQuer
 	return buf.ParsedQuery()
 }
 
+// GeneratePartitionLimitQuery generates a select query with a limit clause,
+// like GenerateLimitQuery, and a :#partitions placeholder for the
+// partition selection of the table expression node.
+func GeneratePartitionLimitQuery(sel *sqlparser.Select, node *sqlparser.AliasedTableExpr) *sqlparser.ParsedQuery {
+	buf := sqlparser.NewTrackedBuffer(func(buf *sqlparser.TrackedBuffer, n sqlparser.SQLNode) {
+		if expr, ok := n.(*sqlparser.AliasedTableExpr); !ok || expr != node {
+			n.Format(buf)
+			return
+		}
+		buf.Myprintf("%v%a", node.Expr, ":#partitions")
+		if !node.As.IsEmpty() {
+			buf.Myprintf(" as %v", node.As)
+		}
+		if node.Hints != nil {
+			buf.Myprintf("%v", node.Hints)
+		}
+	})
+	if sel.Limit == nil {
+		sel.Limit = execLimit
+		defer func() {
+			sel.Limit = nil
+		}()
+	}
+	buf.Myprintf("%v", sel)
+	return buf.ParsedQuery()
+}
+
 // GenerateInsertOuterQuery generates the outer query for inserts.
 func GenerateInsertOuterQuery(ins *sqlparser.Insert) *sqlparser.ParsedQuery {
 	buf := sqlparser.NewTrackedBuffer(nil)
@@ -137,3 +164,22 @@ func GenerateSubquery(columns []sqlparser.ColIdent, table *sqlparser.AliasedTabl
 	}
 	return buf.ParsedQuery()
 }
+
+// GenerateChunkSubqueries generates the subqueries that select the
+// chunks of rows of a chunked update or delete, by ranges of the
+// single-column primary key pk: first selects the first chunk, and
+// next selects the chunk after the :#last_pk value.
+func GenerateChunkSubqueries(pk sqlparser.ColIdent, table *sqlparser.AliasedTableExpr, where *sqlparser.Where) (first, next *sqlparser.ParsedQuery) {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select %v from %v%v order by %v asc limit %a%s", pk, table, where, pk, ":#chunk_size", sqlparser.ForUpdateStr)
+	first = buf.ParsedQuery()
+
+	buf = sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select %v from %v where ", pk, table)
+	if where != nil {
+		buf.Myprintf("(%v) and ", where.Expr)
+	}
+	buf.Myprintf("%v > %a order by %v asc limit %a%s", pk, ":#last_pk", pk, ":#chunk_size", sqlparser.ForUpdateStr)
+	next = buf.ParsedQuery()
+	return first, next
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The names of the pools that can be inspected and resized at runtime.
+const (
+	connPoolName        = "conn"
+	streamConnPoolName  = "stream"
+	transactionPoolName = "transaction"
+)
+
+var poolNames = []string{connPoolName, streamConnPoolName, transactionPoolName}
+
+// connPool returns the pool with the given name.
+func (tsv *TabletServer) connPool(name string) (*connpool.Pool, error) {
+	switch name {
+	case connPoolName:
+		return tsv.qe.conns, nil
+	case streamConnPoolName:
+		return tsv.qe.streamConns, nil
+	case transactionPoolName:
+		return tsv.te.txPool.conns, nil
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown pool %q, valid pools are %v", name, poolNames)
+}
+
+// Pools returns the current settings and usage of the conn, stream
+// and transaction pools.
+func (tsv *TabletServer) Pools() []*tabletmanagerdatapb.PoolInfo {
+	infos := make([]*tabletmanagerdatapb.PoolInfo, 0, len(poolNames))
+	for _, name := range poolNames {
+		cp, _ := tsv.connPool(name)
+		infos = append(infos, poolInfo(name, cp))
+	}
+	return infos
+}
+
+// ResizePool changes the settings of a pool at runtime. Only the
+// positive values are applied. The capacity can't exceed the max
+// capacity of the pool, and the prefill parallelism is used the next
+// time the pool is opened.
+func (tsv *TabletServer) ResizePool(name string, capacity int, idleTimeout time.Duration, prefillParallelism int) (*tabletmanagerdatapb.PoolInfo, error) {
+	cp, err := tsv.connPool(name)
+	if err != nil {
+		return nil, err
+	}
+	if capacity > 0 {
+		if err := cp.SetCapacity(capacity); err != nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot resize pool %v: %v", name, err)
+		}
+	}
+	if idleTimeout > 0 {
+		cp.SetIdleTimeout(idleTimeout)
+	}
+	if prefillParallelism > 0 {
+		cp.SetPrefillParallelism(prefillParallelism)
+	}
+	return poolInfo(name, cp), nil
+}
+
+func poolInfo(name string, cp *connpool.Pool) *tabletmanagerdatapb.PoolInfo {
+	return &tabletmanagerdatapb.PoolInfo{
+		Name:               name,
+		Capacity:           cp.Capacity(),
+		MaxCapacity:        cp.MaxCap(),
+		IdleTimeoutMs:      int64(cp.IdleTimeout() / time.Millisecond),
+		PrefillParallelism: int64(cp.PrefillParallelism()),
+		Available:          cp.Available(),
+		Active:             cp.Active(),
+		InUse:              cp.InUse(),
+		WaitCount:          cp.WaitCount(),
+		WaitTimeMs:         int64(cp.WaitTime() / time.Millisecond),
+	}
+}
+
+func (tsv *TabletServer) registerPoolsHandler() {
+	http.HandleFunc("/debug/pools", func(w http.ResponseWriter, r *http.Request) {
+		poolsHandler(tsv, w, r)
+	})
+}
+
+// poolsHandler returns the pools as JSON. If a pool name is given
+// along with a capacity, idle_timeout or prefill_parallelism, the pool
+// is resized first, which requires the ADMIN role.
+func poolsHandler(tsv *TabletServer, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusBadRequest)
+		return
+	}
+	var result interface{}
+	if name := r.FormValue("name"); name != "" {
+		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		capacity, idleTimeout, prefillParallelism, err := parsePoolsForm(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		info, err := tsv.ResizePool(name, capacity, idleTimeout, prefillParallelism)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result = info
+	} else {
+		result = tsv.Pools()
+	}
+	b, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(b)
+}
+
+func parsePoolsForm(r *http.Request) (capacity int, idleTimeout time.Duration, prefillParallelism int, err error) {
+	if v := r.FormValue("capacity"); v != "" {
+		if capacity, err = strconv.Atoi(v); err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid capacity %q: %v", v, err)
+		}
+	}
+	if v := r.FormValue("idle_timeout"); v != "" {
+		if idleTimeout, err = time.ParseDuration(v); err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid idle_timeout %q: %v", v, err)
+		}
+	}
+	if v := r.FormValue("prefill_parallelism"); v != "" {
+		if prefillParallelism, err = strconv.Atoi(v); err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid prefill_parallelism %q: %v", v, err)
+		}
+	}
+	return capacity, idleTimeout, prefillParallelism, nil
+}
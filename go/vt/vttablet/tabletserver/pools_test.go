@@ -0,0 +1,140 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestTabletServerPools(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	config.PoolSize = 16
+	config.PoolMaxCapacityFactor = 2
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+
+	pools := tsv.Pools()
+	if len(pools) != 3 {
+		t.Fatalf("Pools: %v, want 3 pools", pools)
+	}
+	for i, name := range []string{"conn", "stream", "transaction"} {
+		if pools[i].Name != name {
+			t.Errorf("Pools[%d]: %v, want %v", i, pools[i].Name, name)
+		}
+	}
+	if pools[0].Capacity != 16 || pools[0].MaxCapacity != 32 {
+		t.Errorf("conn pool capacity: %v/%v, want 16/32", pools[0].Capacity, pools[0].MaxCapacity)
+	}
+
+	info, err := tsv.ResizePool("conn", 24, time.Minute, 2)
+	if err != nil {
+		t.Fatalf("ResizePool failed: %v", err)
+	}
+	want := &tabletmanagerdatapb.PoolInfo{
+		Capacity:           24,
+		MaxCapacity:        32,
+		IdleTimeoutMs:      60000,
+		PrefillParallelism: 2,
+	}
+	if info.Capacity != want.Capacity || info.MaxCapacity != want.MaxCapacity || info.IdleTimeoutMs != want.IdleTimeoutMs || info.PrefillParallelism != want.PrefillParallelism {
+		t.Errorf("ResizePool: %v, want %v", info, want)
+	}
+	if got := tsv.PoolSize(); got != 24 {
+		t.Errorf("PoolSize: %v, want 24", got)
+	}
+
+	// The settings that are not given are left unchanged.
+	info, err = tsv.ResizePool("conn", 0, 0, 0)
+	if err != nil {
+		t.Fatalf("ResizePool failed: %v", err)
+	}
+	if info.Capacity != 24 || info.IdleTimeoutMs != 60000 {
+		t.Errorf("ResizePool without changes: %v", info)
+	}
+
+	if _, err := tsv.ResizePool("conn", 33, 0, 0); vterrors.Code(err) != vtrpcpb.Code_INVALID_ARGUMENT {
+		t.Errorf("ResizePool above the max capacity: %v, want INVALID_ARGUMENT", err)
+	}
+	if _, err := tsv.ResizePool("unknown", 10, 0, 0); vterrors.Code(err) != vtrpcpb.Code_INVALID_ARGUMENT {
+		t.Errorf("ResizePool of an unknown pool: %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+func TestPoolsHandler(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/debug/pools", nil)
+	poolsHandler(tsv, resp, req)
+	var pools []*tabletmanagerdatapb.PoolInfo
+	if err := json.Unmarshal(resp.Body.Bytes(), &pools); err != nil {
+		t.Fatalf("cannot unmarshal %s: %v", resp.Body.String(), err)
+	}
+	if len(pools) != 3 {
+		t.Errorf("pools: %v, want 3 pools", pools)
+	}
+
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/debug/pools?name=stream&capacity=100", nil)
+	poolsHandler(tsv, resp, req)
+	var pool tabletmanagerdatapb.PoolInfo
+	if err := json.Unmarshal(resp.Body.Bytes(), &pool); err != nil {
+		t.Fatalf("cannot unmarshal %s: %v", resp.Body.String(), err)
+	}
+	if pool.Name != "stream" || pool.Capacity != 100 {
+		t.Errorf("resized pool: %v, want the stream pool with capacity 100", pool)
+	}
+	if got := tsv.StreamPoolSize(); got != 100 {
+		t.Errorf("StreamPoolSize: %v, want 100", got)
+	}
+
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/debug/pools?name=stream&capacity=many", nil)
+	poolsHandler(tsv, resp, req)
+	if resp.Code != http.StatusBadRequest {
+		t.Errorf("invalid capacity: got code %d, want %d", resp.Code, http.StatusBadRequest)
+	}
+}
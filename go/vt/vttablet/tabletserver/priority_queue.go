@@ -0,0 +1,143 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"container/heap"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/pools"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// priorityQueue orders the queries that wait for a connection of the
+// query pool by priority. The pool serves its waiters in arrival order,
+// so the queue lets one query at a time wait in the pool: the HIGH
+// priority queries first, then the NORMAL and the LOW ones, each in
+// arrival order. A query that arrives while a lower priority query is
+// already waiting in the pool gets the next connection after it.
+type priorityQueue struct {
+	mu sync.Mutex
+	// busy is true while a query waits in the pool.
+	busy    bool
+	seq     uint64
+	waiters priorityWaiters
+}
+
+type priorityWaiter struct {
+	rank  int
+	seq   uint64
+	index int
+	ready chan struct{}
+}
+
+// wait returns once the query is the next one to wait in the pool. The
+// returned function must be called once the query got its connection,
+// or gave up, to let the next query in. Like the pool, it returns
+// pools.ErrTimeout if the context is done first.
+func (pq *priorityQueue) wait(ctx context.Context, priority querypb.ExecuteOptions_Priority) (func(), error) {
+	pq.mu.Lock()
+	if !pq.busy {
+		pq.busy = true
+		pq.mu.Unlock()
+		return pq.next, nil
+	}
+	pq.seq++
+	w := &priorityWaiter{
+		rank:  priorityRank(priority),
+		seq:   pq.seq,
+		ready: make(chan struct{}),
+	}
+	heap.Push(&pq.waiters, w)
+	pq.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return pq.next, nil
+	case <-ctx.Done():
+		pq.mu.Lock()
+		if w.index >= 0 {
+			heap.Remove(&pq.waiters, w.index)
+			pq.mu.Unlock()
+			return nil, pools.ErrTimeout
+		}
+		pq.mu.Unlock()
+		// The turn was given to the query as it gave up: it's passed
+		// on to the next one.
+		pq.next()
+		return nil, pools.ErrTimeout
+	}
+}
+
+// next lets the next query wait in the pool.
+func (pq *priorityQueue) next() {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+	if len(pq.waiters) == 0 {
+		pq.busy = false
+		return
+	}
+	close(heap.Pop(&pq.waiters).(*priorityWaiter).ready)
+}
+
+// priorityRank returns the rank of a priority in the queue: the lower
+// ranks are served first.
+func priorityRank(priority querypb.ExecuteOptions_Priority) int {
+	switch priority {
+	case querypb.ExecuteOptions_HIGH:
+		return 0
+	case querypb.ExecuteOptions_LOW:
+		return 2
+	}
+	return 1
+}
+
+// priorityWaiters is a heap of the waiters, by rank and then arrival.
+type priorityWaiters []*priorityWaiter
+
+func (pw priorityWaiters) Len() int { return len(pw) }
+
+func (pw priorityWaiters) Less(i, j int) bool {
+	if pw[i].rank != pw[j].rank {
+		return pw[i].rank < pw[j].rank
+	}
+	return pw[i].seq < pw[j].seq
+}
+
+func (pw priorityWaiters) Swap(i, j int) {
+	pw[i], pw[j] = pw[j], pw[i]
+	pw[i].index = i
+	pw[j].index = j
+}
+
+func (pw *priorityWaiters) Push(x interface{}) {
+	w := x.(*priorityWaiter)
+	w.index = len(*pw)
+	*pw = append(*pw, w)
+}
+
+func (pw *priorityWaiters) Pop() interface{} {
+	old := *pw
+	w := old[len(old)-1]
+	old[len(old)-1] = nil
+	w.index = -1
+	*pw = old[:len(old)-1]
+	return w
+}
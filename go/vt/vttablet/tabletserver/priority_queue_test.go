@@ -0,0 +1,117 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/pools"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// queued waits until n queries wait in the queue.
+func queued(t *testing.T, pq *priorityQueue, n int) {
+	t.Helper()
+	for i := 0; i < 1000; i++ {
+		pq.mu.Lock()
+		l := len(pq.waiters)
+		pq.mu.Unlock()
+		if l == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("the queue doesn't have %d waiters", n)
+}
+
+func TestPriorityQueueOrder(t *testing.T) {
+	pq := &priorityQueue{}
+	ctx := context.Background()
+	done, err := pq.wait(ctx, querypb.ExecuteOptions_LOW)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type turn struct {
+		name string
+		done func()
+	}
+	turns := make(chan turn)
+	for i, w := range []struct {
+		name     string
+		priority querypb.ExecuteOptions_Priority
+	}{
+		{"low1", querypb.ExecuteOptions_LOW},
+		{"normal1", querypb.ExecuteOptions_NORMAL},
+		{"low2", querypb.ExecuteOptions_LOW},
+		{"high", querypb.ExecuteOptions_HIGH},
+		{"normal2", querypb.ExecuteOptions_NORMAL},
+	} {
+		go func(name string, priority querypb.ExecuteOptions_Priority) {
+			done, err := pq.wait(ctx, priority)
+			if err != nil {
+				t.Error(err)
+			}
+			turns <- turn{name, done}
+		}(w.name, w.priority)
+		queued(t, pq, i+1)
+	}
+
+	var got []string
+	for i := 0; i < 5; i++ {
+		done()
+		next := <-turns
+		got = append(got, next.name)
+		done = next.done
+	}
+	done()
+	want := []string{"high", "normal1", "normal2", "low1", "low2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("order: %v, want %v", got, want)
+	}
+	if pq.busy {
+		t.Error("busy: true, want false")
+	}
+}
+
+func TestPriorityQueueTimeout(t *testing.T) {
+	pq := &priorityQueue{}
+	done, err := pq.wait(context.Background(), querypb.ExecuteOptions_NORMAL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := pq.wait(ctx, querypb.ExecuteOptions_HIGH); err != pools.ErrTimeout {
+		t.Errorf("wait: %v, want %v", err, pools.ErrTimeout)
+	}
+	queued(t, pq, 0)
+
+	// The query that timed out doesn't hold the turn.
+	done()
+	done, err = pq.wait(context.Background(), querypb.ExecuteOptions_LOW)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done()
+}
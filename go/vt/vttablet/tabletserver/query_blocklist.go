@@ -0,0 +1,68 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/queryblocklist"
+)
+
+// QueryBlocklist returns the entries of the query blocklist.
+func (tsv *TabletServer) QueryBlocklist() ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	bl := tsv.qe.blocklist
+	if bl == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the query blocklist is disabled, see -enable_query_blocklist")
+	}
+	return queryBlocklistEntries(bl), nil
+}
+
+// UpdateQueryBlocklist approves, blocks or removes a fingerprint of the
+// query blocklist, and returns its entries.
+func (tsv *TabletServer) UpdateQueryBlocklist(action, fingerprint, reason string) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	bl := tsv.qe.blocklist
+	if bl == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "the query blocklist is disabled, see -enable_query_blocklist")
+	}
+	if err := bl.Apply(action, fingerprint, reason); err != nil {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+	}
+	return queryBlocklistEntries(bl), nil
+}
+
+func queryBlocklistEntries(bl *queryblocklist.Blocklist) []*tabletmanagerdatapb.QueryBlocklistEntry {
+	entries := bl.Entries()
+	result := make([]*tabletmanagerdatapb.QueryBlocklistEntry, 0, len(entries))
+	for _, entry := range entries {
+		e := &tabletmanagerdatapb.QueryBlocklistEntry{
+			Fingerprint: entry.Fingerprint,
+			Query:       entry.Query,
+			State:       entry.State,
+			Manual:      entry.Manual,
+			MysqlTimeNs: int64(entry.MysqlTime),
+			Rows:        entry.Rows,
+			Reason:      entry.Reason,
+			Since:       entry.Since.Unix(),
+		}
+		if !entry.Expires.IsZero() {
+			e.Expires = entry.Expires.Unix()
+		}
+		result = append(result, e)
+	}
+	return result
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/authz"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
@@ -43,8 +45,10 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/queryblocklist"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/splitquery"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
 
@@ -62,12 +66,20 @@ type TabletPlan struct {
 	Rules      *rules.Rules
 	Authorized []*tableacl.ACLResult
 
+	// hintedQueries are the versions of FullQuery with the
+	// optimizer hints of the query rules.
+	hintedQueries map[*rules.Hints]*sqlparser.ParsedQuery
+
 	mu         sync.Mutex
 	QueryCount int64
 	Time       time.Duration
 	MysqlTime  time.Duration
 	RowCount   int64
 	ErrorCount int64
+	latencies  latencyHistogram
+	// fingerprint is the fingerprint of the query of the plan, once
+	// computed by queryFingerprint.
+	fingerprint string
 }
 
 // Size allows TabletPlan to be in cache.LRUCache.
@@ -83,6 +95,9 @@ func (ep *TabletPlan) AddStats(queryCount int64, duration, mysqlTime time.Durati
 	ep.MysqlTime += mysqlTime
 	ep.RowCount += rowCount
 	ep.ErrorCount += errorCount
+	if queryCount > 0 {
+		ep.latencies.add(duration/time.Duration(queryCount), queryCount)
+	}
 	ep.mu.Unlock()
 }
 
@@ -124,9 +139,15 @@ type QueryEngine struct {
 	tables           map[string]*schema.Table
 	plans            *cache.LRUCache
 	queryRuleSources *rules.Map
+	// schemaChanges are the tables created, altered or dropped
+	// since the last call to takeSchemaChanges.
+	schemaChanges map[string]bool
 
 	queryStatsMu sync.RWMutex
 	queryStats   map[string]*QueryStats
+	// fingerprintStats is nil if the stats per fingerprint are not
+	// exported.
+	fingerprintStats *fingerprintStats
 
 	// Pools
 	conns       *connpool.Pool
@@ -153,7 +174,15 @@ type QueryEngine struct {
 	maxDMLRows         sync2.AtomicInt64
 	passthroughDMLs    sync2.AtomicBool
 	allowUnsafeDMLs    bool
+	safeDMLs           bool
+	chunkedDMLRows     int64
 	streamBufferSize   sync2.AtomicInt64
+	// priorityWaiters are the queries waiting for a connection of the
+	// query pool, for each priority.
+	priorityWaiters      [querypb.ExecuteOptions_LOW + 1]sync2.AtomicInt64
+	lowPriorityWaiterCap sync2.AtomicInt64
+	// connQueue orders the waiters of the query pool by priority.
+	connQueue priorityQueue
 	// tableaclExemptCount count the number of accesses allowed
 	// based on membership in the superuser ACL
 	tableaclExemptCount  sync2.AtomicInt64
@@ -161,11 +190,26 @@ type QueryEngine struct {
 	enableTableACLDryRun bool
 	// TODO(sougou) There are two acl packages. Need to rename.
 	exemptACL tacl.ACL
+	// authz is nil if external authorization is disabled.
+	authz *authz.Checker
 
 	strictTransTables bool
 
 	enableConsolidator bool
 
+	// retryPolicies are the retry policies of the plan types.
+	retryPolicies map[planbuilder.PlanType]*retryPolicy
+
+	// dmlCounts counts the rows changed in each table.
+	dmlCounts *tableDMLCounts
+	// splitMinMaxCache is nil if the split column bounds are not cached.
+	splitMinMaxCache *splitquery.MinMaxCache
+	// blocklist is nil if the query blocklist is disabled.
+	blocklist *queryblocklist.Blocklist
+	// blocklistRowsExamined is true if the rows examined by MySQL are
+	// counted by the blocklist, instead of the rows returned or affected.
+	blocklistRowsExamined bool
+
 	// Loggers
 	accessCheckerLogger *logutil.ThrottledLogger
 }
@@ -185,6 +229,10 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		queryRuleSources:   rules.NewMap(),
 		queryPoolWaiterCap: sync2.NewAtomicInt64(int64(config.QueryPoolWaiterCap)),
 		queryStats:         make(map[string]*QueryStats),
+		dmlCounts:          newTableDMLCounts(),
+	}
+	if config.SplitQueryMinMaxCacheThreshold > 0 {
+		qe.splitMinMaxCache = splitquery.NewMinMaxCache(int64(config.SplitQueryMinMaxCacheThreshold), qe.dmlCounts.Get)
 	}
 
 	qe.conns = connpool.New(
@@ -194,7 +242,9 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		time.Duration(config.IdleTimeout*1e9),
 		checker,
 	)
+	qe.conns.SetMaxCapacity(config.PoolSize * config.PoolMaxCapacityFactor)
 	qe.connTimeout.Set(time.Duration(config.QueryPoolTimeout * 1e9))
+	qe.lowPriorityWaiterCap.Set(int64(config.QueryPoolLowPriorityWaiterCap))
 
 	qe.streamConns = connpool.New(
 		config.PoolNamePrefix+"StreamConnPool",
@@ -203,6 +253,7 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		time.Duration(config.IdleTimeout*1e9),
 		checker,
 	)
+	qe.streamConns.SetMaxCapacity(config.StreamPoolSize * config.PoolMaxCapacityFactor)
 	qe.enableConsolidator = config.EnableConsolidator
 	qe.consolidator = sync2.NewConsolidator()
 	qe.txSerializer = txserializer.New(config.EnableHotRowProtectionDryRun,
@@ -210,6 +261,17 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		config.HotRowProtectionMaxGlobalQueueSize,
 		config.HotRowProtectionConcurrentTransactions)
 	qe.streamQList = NewQueryList()
+	if config.EnableQueryBlocklist {
+		qe.blocklist = queryblocklist.New(queryblocklist.Config{
+			Automatic:     config.QueryBlocklistAutomatic,
+			Window:        time.Duration(config.QueryBlocklistWindow * 1e9),
+			MaxMysqlTime:  time.Duration(config.QueryBlocklistMaxMysqlTime * 1e9),
+			MaxRows:       config.QueryBlocklistMaxRows,
+			MaxEntries:    config.QueryBlocklistMaxEntries,
+			BlockDuration: time.Duration(config.QueryBlocklistDuration * 1e9),
+		})
+		qe.blocklistRowsExamined = config.QueryBlocklistRowsExamined
+	}
 
 	qe.autoCommit.Set(config.EnableAutoCommit)
 	qe.strictTableACL = config.StrictTableACL
@@ -230,6 +292,18 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		}
 	}
 
+	authzChecker, err := authz.NewChecker("vttablet")
+	if err != nil {
+		log.Fatalf("Cannot create external authorization checker: %v", err)
+	}
+	qe.authz = authzChecker
+
+	retryPolicies, err := newRetryPolicies(config.QueryRetryPolicies)
+	if err != nil {
+		log.Fatalf("Cannot parse the retry policies: %v", err)
+	}
+	qe.retryPolicies = retryPolicies
+
 	qe.maxResultSize = sync2.NewAtomicInt64(int64(config.MaxResultSize))
 	qe.warnResultSize = sync2.NewAtomicInt64(int64(config.WarnResultSize))
 	qe.maxDMLRows = sync2.NewAtomicInt64(int64(config.MaxDMLRows))
@@ -237,10 +311,16 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 
 	qe.passthroughDMLs = sync2.NewAtomicBool(config.PassthroughDMLs)
 	qe.allowUnsafeDMLs = config.AllowUnsafeDMLs
+	qe.safeDMLs = config.SafeDMLs
+	qe.chunkedDMLRows = int64(config.ChunkedDMLRows)
 	planbuilder.PassthroughDMLs = config.PassthroughDMLs
 
 	qe.accessCheckerLogger = logutil.NewThrottledLogger("accessChecker", 1*time.Second)
 
+	if config.ExportQueryPlanStats {
+		qe.fingerprintStats = newFingerprintStats()
+	}
+
 	qeOnce.Do(func() {
 		stats.NewGaugeFunc("MaxResultSize", "Query engine max result size", qe.maxResultSize.Get)
 		stats.NewGaugeFunc("WarnResultSize", "Query engine warn result size", qe.warnResultSize.Get)
@@ -248,6 +328,7 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		stats.NewGaugeFunc("StreamBufferSize", "Query engine stream buffer size", qe.streamBufferSize.Get)
 		stats.NewCounterFunc("TableACLExemptCount", "Query engine table ACL exempt count", qe.tableaclExemptCount.Get)
 		stats.NewGaugeFunc("QueryPoolWaiters", "Query engine query pool waiters", qe.queryPoolWaiters.Get)
+		stats.NewGaugesFuncWithMultiLabels("QueryPoolPriorityWaiters", "Query engine query pool waiters per priority", []string{"Priority"}, qe.priorityWaiterCounts)
 
 		stats.NewGaugeFunc("QueryCacheLength", "Query engine query cache length", qe.plans.Length)
 		stats.NewGaugeFunc("QueryCacheSize", "Query engine query cache size", qe.plans.Size)
@@ -260,8 +341,14 @@ func NewQueryEngine(checker connpool.MySQLChecker, se *schema.Engine, config tab
 		_ = stats.NewCountersFuncWithMultiLabels("QueryTimesNs", "query times in ns", []string{"Table", "Plan"}, qe.getQueryTime)
 		_ = stats.NewCountersFuncWithMultiLabels("QueryRowCounts", "query row counts", []string{"Table", "Plan"}, qe.getQueryRowCount)
 		_ = stats.NewCountersFuncWithMultiLabels("QueryErrorCounts", "query error counts", []string{"Table", "Plan"}, qe.getQueryErrorCount)
+		if config.ExportQueryPlanStats {
+			qe.exportQueryPlanStats()
+		}
 
 		http.Handle("/debug/hotrows", qe.txSerializer)
+		if qe.blocklist != nil {
+			http.Handle("/debug/query_blocklist", qe.blocklist)
+		}
 
 		endpoints := []string{
 			"/debug/tablet_plans",
@@ -334,20 +421,47 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	// acceptable because those numbers are best effort.
 	qe.mu.RLock()
 	defer qe.mu.RUnlock()
-	statement, err := sqlparser.Parse(sql)
-	if err != nil {
-		return nil, err
-	}
-	splan, err := planbuilder.Build(statement, qe.tables)
-	if err != nil {
-		return nil, err
+	var (
+		statement sqlparser.Statement
+		splan     *planbuilder.Plan
+		err       error
+	)
+	switch stmtType := sqlparser.Preview(sql); {
+	case stmtType == sqlparser.StmtSavepoint, stmtType == sqlparser.StmtSRollback, stmtType == sqlparser.StmtRelease:
+		// The savepoint statements are not in the grammar.
+		if _, err := sqlparser.ExtractSavepointName(sql); err != nil {
+			return nil, err
+		}
+		splan = &planbuilder.Plan{PlanID: planbuilder.PlanSavepoint}
+	case stmtType == sqlparser.StmtDDL && sqlparser.IsTemporaryTableDDL(sql):
+		// Neither are the temporary table statements.
+		splan = &planbuilder.Plan{PlanID: planbuilder.PlanTemporaryTable}
+	default:
+		statement, err = sqlparser.Parse(sql)
+		if err != nil {
+			return nil, err
+		}
+		splan, err = planbuilder.Build(statement, qe.tables)
+		if err != nil {
+			return nil, err
+		}
 	}
 	plan := &TabletPlan{Plan: splan}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
+	err = plan.buildHintedQueries(sql, func(sql string) (*planbuilder.Plan, error) {
+		statement, err := sqlparser.Parse(sql)
+		if err != nil {
+			return nil, err
+		}
+		return planbuilder.Build(statement, qe.tables)
+	})
+	if err != nil {
+		return nil, err
+	}
 	if plan.PlanID.IsSelect() {
 		if plan.FieldQuery != nil {
-			conn, err := qe.getQueryConn(ctx)
+			conn, err := qe.getQueryConn(ctx, querypb.ExecuteOptions_NORMAL)
 			if err != nil {
 				return nil, err
 			}
@@ -372,28 +486,68 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 }
 
 // getQueryConn returns a connection from the query pool using either
-// the conn pool timeout if configured, or the original context query timeout
-func (qe *QueryEngine) getQueryConn(ctx context.Context) (*connpool.DBConn, error) {
-	waiterCount := qe.queryPoolWaiters.Add(1)
+// the conn pool timeout if configured, or the original context query timeout.
+// The queries are admitted in separate queues for each priority: the HIGH
+// priority queries are not limited by the waiters of the other queues, and
+// the LOW priority ones are rejected while higher priority queries wait.
+// The admitted queries get the connections by priority.
+func (qe *QueryEngine) getQueryConn(ctx context.Context, priority querypb.ExecuteOptions_Priority) (*connpool.DBConn, error) {
+	if _, ok := querypb.ExecuteOptions_Priority_name[int32(priority)]; !ok {
+		priority = querypb.ExecuteOptions_NORMAL
+	}
+	qe.queryPoolWaiters.Add(1)
 	defer qe.queryPoolWaiters.Add(-1)
+	waiterCount := qe.priorityWaiters[priority].Add(1)
+	defer qe.priorityWaiters[priority].Add(-1)
 
-	if waiterCount > qe.queryPoolWaiterCap.Get() {
-		return nil, vterrors.New(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query pool waiter count exceeded")
+	switch priority {
+	case querypb.ExecuteOptions_LOW:
+		if waiterCount > qe.lowPriorityWaiterCap.Get() {
+			return nil, vterrors.New(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query pool low priority waiter count exceeded")
+		}
+		if qe.priorityWaiters[querypb.ExecuteOptions_NORMAL].Get() > 0 || qe.priorityWaiters[querypb.ExecuteOptions_HIGH].Get() > 0 {
+			return nil, vterrors.New(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query pool busy with higher priority queries")
+		}
+	default:
+		if waiterCount > qe.queryPoolWaiterCap.Get() {
+			return nil, vterrors.New(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query pool waiter count exceeded")
+		}
 	}
 
 	timeout := qe.connTimeout.Get()
 	if timeout != 0 {
 		ctxTimeout, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
-		conn, err := qe.conns.Get(ctxTimeout)
+		conn, err := qe.getPriorityConn(ctxTimeout, priority)
 		if err != nil {
 			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query pool wait time exceeded")
 		}
 		return conn, err
 	}
+	return qe.getPriorityConn(ctx, priority)
+}
+
+// getPriorityConn waits for the turn of the query in the priority
+// queue, and then for a connection of the query pool.
+func (qe *QueryEngine) getPriorityConn(ctx context.Context, priority querypb.ExecuteOptions_Priority) (*connpool.DBConn, error) {
+	done, err := qe.connQueue.wait(ctx, priority)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
 	return qe.conns.Get(ctx)
 }
 
+// priorityWaiterCounts returns the number of queries waiting for a
+// connection of the query pool for each priority.
+func (qe *QueryEngine) priorityWaiterCounts() map[string]int64 {
+	counts := make(map[string]int64, len(qe.priorityWaiters))
+	for priority := range qe.priorityWaiters {
+		counts[querypb.ExecuteOptions_Priority(priority).String()] = qe.priorityWaiters[priority].Get()
+	}
+	return counts
+}
+
 // GetStreamPlan is similar to GetPlan, but doesn't use the cache
 // and doesn't enforce a limit. It just returns the parsed query.
 func (qe *QueryEngine) GetStreamPlan(sql string) (*TabletPlan, error) {
@@ -406,6 +560,12 @@ func (qe *QueryEngine) GetStreamPlan(sql string) (*TabletPlan, error) {
 	plan := &TabletPlan{Plan: splan}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
+	err = plan.buildHintedQueries(sql, func(sql string) (*planbuilder.Plan, error) {
+		return planbuilder.BuildStreaming(sql, qe.tables)
+	})
+	if err != nil {
+		return nil, err
+	}
 	return plan, nil
 }
 
@@ -449,6 +609,31 @@ func (qe *QueryEngine) schemaChanged(tables map[string]*schema.Table, created, a
 	if len(altered) != 0 || len(dropped) != 0 {
 		qe.plans.Clear()
 	}
+	if qe.schemaChanges == nil {
+		qe.schemaChanges = make(map[string]bool)
+	}
+	for _, list := range [][]string{created, altered, dropped} {
+		for _, name := range list {
+			qe.schemaChanges[name] = true
+		}
+	}
+}
+
+// takeSchemaChanges returns the sorted tables whose schema changed
+// since the previous call, and forgets them.
+func (qe *QueryEngine) takeSchemaChanges() []string {
+	qe.mu.Lock()
+	defer qe.mu.Unlock()
+	if len(qe.schemaChanges) == 0 {
+		return nil
+	}
+	tables := make([]string, 0, len(qe.schemaChanges))
+	for name := range qe.schemaChanges {
+		tables = append(tables, name)
+	}
+	qe.schemaChanges = nil
+	sort.Strings(tables)
+	return tables
 }
 
 // getQuery fetches the plan and makes it the most recent.
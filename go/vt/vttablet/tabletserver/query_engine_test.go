@@ -222,6 +222,37 @@ func TestNoQueryPlanCache(t *testing.T) {
 	qe.ClearQueryPlanCache()
 }
 
+func TestSchemaChanges(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+
+	testUtils := newTestUtils()
+	dbcfgs := testUtils.newDBConfigs(db)
+	qe := newTestQueryEngine(10, 10*time.Second, true, dbcfgs)
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	// The engine is notified of all the tables when it opens.
+	if got := qe.takeSchemaChanges(); len(got) == 0 {
+		t.Errorf("takeSchemaChanges after Open: empty, want the loaded tables")
+	}
+	if got := qe.takeSchemaChanges(); got != nil {
+		t.Errorf("takeSchemaChanges: %v, want nil", got)
+	}
+	qe.schemaChanged(qe.se.GetSchema(), []string{"t3"}, []string{"t1"}, []string{"t2", "t1"})
+	want := []string{"t1", "t2", "t3"}
+	if got := qe.takeSchemaChanges(); !reflect.DeepEqual(got, want) {
+		t.Errorf("takeSchemaChanges: %v, want %v", got, want)
+	}
+	if got := qe.takeSchemaChanges(); got != nil {
+		t.Errorf("takeSchemaChanges: %v, want nil", got)
+	}
+}
+
 func TestNoQueryPlanCacheDirective(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -363,3 +394,55 @@ func TestConsolidationsUIRedaction(t *testing.T) {
 		t.Fatalf("Response missing redacted consolidated query: %v %v", redactedSQL, redactedResponse.Body.String())
 	}
 }
+
+func TestQueryPoolPriority(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	testUtils := newTestUtils()
+	dbcfgs := testUtils.newDBConfigs(db)
+	qe := newTestQueryEngine(10, 10*time.Second, true, dbcfgs)
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	ctx := context.Background()
+	getConn := func(priority querypb.ExecuteOptions_Priority) error {
+		conn, err := qe.getQueryConn(ctx, priority)
+		if err != nil {
+			return err
+		}
+		conn.Recycle()
+		return nil
+	}
+	for _, priority := range []querypb.ExecuteOptions_Priority{querypb.ExecuteOptions_HIGH, querypb.ExecuteOptions_NORMAL, querypb.ExecuteOptions_LOW, 7} {
+		if err := getConn(priority); err != nil {
+			t.Errorf("getQueryConn(%v): %v", priority, err)
+		}
+	}
+
+	// A normal query is waiting, and the queue of the normal queries is full.
+	qe.queryPoolWaiterCap.Set(1)
+	qe.priorityWaiters[querypb.ExecuteOptions_NORMAL].Add(1)
+	defer qe.priorityWaiters[querypb.ExecuteOptions_NORMAL].Add(-1)
+	if err := getConn(querypb.ExecuteOptions_NORMAL); err == nil || !strings.Contains(err.Error(), "query pool waiter count exceeded") {
+		t.Errorf("getQueryConn(NORMAL): %v, want query pool waiter count exceeded", err)
+	}
+	if err := getConn(querypb.ExecuteOptions_HIGH); err != nil {
+		t.Errorf("getQueryConn(HIGH): %v", err)
+	}
+	if err := getConn(querypb.ExecuteOptions_LOW); err == nil || !strings.Contains(err.Error(), "query pool busy with higher priority queries") {
+		t.Errorf("getQueryConn(LOW): %v, want query pool busy with higher priority queries", err)
+	}
+	want := map[string]int64{"NORMAL": 1, "HIGH": 0, "LOW": 0}
+	if got := qe.priorityWaiterCounts(); !reflect.DeepEqual(got, want) {
+		t.Errorf("priorityWaiterCounts: %v, want %v", got, want)
+	}
+
+	qe.lowPriorityWaiterCap.Set(0)
+	if err := getConn(querypb.ExecuteOptions_LOW); err == nil || !strings.Contains(err.Error(), "query pool low priority waiter count exceeded") {
+		t.Errorf("getQueryConn(LOW): %v, want query pool low priority waiter count exceeded", err)
+	}
+}
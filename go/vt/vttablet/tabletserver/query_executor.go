@@ -36,10 +36,12 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/messager"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/queryblocklist"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
+	authzpb "vitess.io/vitess/go/vt/proto/authz"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -55,6 +57,14 @@ type QueryExecutor struct {
 	ctx            context.Context
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
+	// requestCtx is the context of the request without the query
+	// timeout of the tablet, which each chunk of a chunked dml gets
+	// anew. It's ctx if unset.
+	requestCtx context.Context
+	// rows is the number of rows of the query counted by the blocklist:
+	// the rows examined by MySQL if -query_blocklist_rows_examined is
+	// set, the rows returned or affected otherwise.
+	rows int64
 }
 
 var sequenceFields = []*querypb.Field{
@@ -69,6 +79,11 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	qre.logStats.TransactionID = qre.transactionID
 	planName := qre.plan.PlanID.String()
 	qre.logStats.PlanType = planName
+	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.Execute")
+	span.Annotate("plan_type", planName)
+	span.Annotate("table", qre.plan.TableName().String())
+	qre.ctx = ctx
+	defer span.Finish()
 	defer func(start time.Time) {
 		duration := time.Since(start)
 		tabletenv.QueryStats.Add(planName, duration)
@@ -80,13 +95,22 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			tableName = "Join"
 		}
 
+		if bl := qre.tsv.qe.blocklist; bl != nil {
+			bl.Record(queryblocklist.Fingerprint(qre.query), qre.query, mysqlTime, qre.rows)
+		}
+
 		if reply == nil {
 			qre.tsv.qe.AddStats(planName, tableName, 1, duration, mysqlTime, 0, 1)
 			qre.plan.AddStats(1, duration, mysqlTime, 0, 1)
+			qre.tsv.qe.addFingerprintStats(qre.plan, qre.query, 1, duration, 0, 1)
 			return
 		}
 		qre.tsv.qe.AddStats(planName, tableName, 1, duration, mysqlTime, int64(reply.RowsAffected), 0)
 		qre.plan.AddStats(1, duration, mysqlTime, int64(reply.RowsAffected), 0)
+		qre.tsv.qe.addFingerprintStats(qre.plan, qre.query, 1, duration, int64(reply.RowsAffected), 0)
+		if !qre.plan.PlanID.IsSelect() {
+			qre.tsv.qe.dmlCounts.Add(qre.plan.TableName().String(), int64(reply.RowsAffected))
+		}
 		qre.logStats.RowsAffected = int(reply.RowsAffected)
 		qre.logStats.Rows = reply.Rows
 		tabletenv.ResultStats.Add(int64(len(reply.Rows)))
@@ -95,6 +119,12 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	if err := qre.checkBlocklist(); err != nil {
+		return nil, err
+	}
+	if qre.plan.Unbounded && qre.tsv.qe.safeDMLs {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsafe dml rejected: the statement has no limit, and its where clause doesn't restrict a key of table %s (see -queryserver-config-safe-dmls)", qre.plan.TableName())
+	}
 
 	switch qre.plan.PlanID {
 	case planbuilder.PlanDDL:
@@ -138,12 +168,27 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return qre.execDMLSubquery(conn)
 		case planbuilder.PlanOtherRead, planbuilder.PlanOtherAdmin:
 			return qre.execSQL(conn, qre.query, true)
+		case planbuilder.PlanSavepoint:
+			// The savepoints are recorded, so that the redo log of a
+			// prepared transaction rolls back to them like the original.
+			qr, err := qre.execSQL(conn, qre.query, true)
+			if err != nil {
+				return nil, err
+			}
+			conn.RecordQuery(qre.query)
+			return qr, nil
 		case planbuilder.PlanUpsertPK:
 			return qre.execUpsertPK(conn)
 		case planbuilder.PlanSet:
 			return qre.txFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, "", true, true)
 		case planbuilder.PlanPassSelect, planbuilder.PlanSelectLock, planbuilder.PlanSelectImpossible:
 			return qre.execDirect(conn)
+		case planbuilder.PlanTemporaryTable:
+			// A temporary table lives as long as its connection.
+			if !conn.Reserved {
+				return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s requires a reserved connection", qre.plan.PlanID.String())
+			}
+			return qre.execSQL(conn, qre.query, true)
 		default:
 			// handled above:
 			// planbuilder.PlanNextval
@@ -155,11 +200,16 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "%s unexpected plan type", qre.plan.PlanID.String())
 		}
 	} else {
+		if qre.plan.ChunkSubquery != nil && qre.tsv.qe.chunkedDMLRows > 0 && qre.tsv.qe.autoCommit.Get() {
+			return qre.execChunkedDML()
+		}
 		switch qre.plan.PlanID {
 		case planbuilder.PlanPassSelect, planbuilder.PlanSelectImpossible:
-			return qre.execSelect()
-		case planbuilder.PlanSelectLock:
+			return qre.execWithRetries(qre.execSelect)
+		case planbuilder.PlanSelectLock, planbuilder.PlanSavepoint:
 			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s disallowed outside transaction", qre.plan.PlanID.String())
+		case planbuilder.PlanTemporaryTable:
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s requires a reserved connection", qre.plan.PlanID.String())
 		case planbuilder.PlanSet:
 			return qre.execSet()
 		case planbuilder.PlanOtherRead:
@@ -186,7 +236,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			if !qre.tsv.qe.autoCommit.Get() {
 				return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s disallowed outside transaction", qre.plan.PlanID.String())
 			}
-			return qre.execDmlAutoCommit()
+			return qre.execWithRetries(qre.execDmlAutoCommit)
 		default:
 			// handled above:
 			// planbuilder.PlanNextval
@@ -204,15 +254,26 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 	qre.logStats.OriginalSQL = qre.query
 	qre.logStats.PlanType = qre.plan.PlanID.String()
+	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.Stream")
+	span.Annotate("plan_type", qre.logStats.PlanType)
+	span.Annotate("table", qre.plan.TableName().String())
+	qre.ctx = ctx
+	defer span.Finish()
 
 	defer func(start time.Time) {
 		tabletenv.QueryStats.Record(qre.plan.PlanID.String(), start)
 		tabletenv.RecordUserQuery(qre.ctx, qre.plan.TableName(), "Stream", int64(time.Since(start)))
+		if bl := qre.tsv.qe.blocklist; bl != nil {
+			bl.Record(queryblocklist.Fingerprint(qre.query), qre.query, qre.logStats.MysqlResponseTime, qre.rows)
+		}
 	}(time.Now())
 
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	if err := qre.checkBlocklist(); err != nil {
+		return err
+	}
 
 	// if we have a transaction id, let's use the txPool for this query
 	var conn *connpool.DBConn
@@ -236,7 +297,7 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 	qre.tsv.qe.streamQList.Add(qd)
 	defer qre.tsv.qe.streamQList.Remove(qd)
 
-	return qre.streamFetch(conn, qre.plan.FullQuery, qre.bindVars, "", callback)
+	return qre.streamFetch(conn, qre.fullQuery(), qre.bindVars, "", callback)
 }
 
 // MessageStream streams messages from a message table.
@@ -269,6 +330,9 @@ func (qre *QueryExecutor) MessageStream(callback func(*sqltypes.Result) error) e
 }
 
 func (qre *QueryExecutor) execDmlAutoCommit() (reply *sqltypes.Result, err error) {
+	if err := qre.tsv.throttleWriteTransaction(qre.ctx, qre.options); err != nil {
+		return nil, err
+	}
 	return qre.execAsTransaction(func(conn *TxConnection) (reply *sqltypes.Result, err error) {
 		switch qre.plan.PlanID {
 		case planbuilder.PlanPassDML:
@@ -295,6 +359,70 @@ func (qre *QueryExecutor) execDmlAutoCommit() (reply *sqltypes.Result, err error
 	})
 }
 
+// execChunkedDML runs an update or delete outside of a transaction as
+// chunks of rows selected by ranges of the primary key, each committed
+// in its own transaction with its own query timeout, so that the
+// replicas don't apply a single giant transaction. The chunks committed
+// before a failure are not rolled back. Unlike the other autocommit
+// DMLs, it is not retried.
+func (qre *QueryExecutor) execChunkedDML() (*sqltypes.Result, error) {
+	tableName := qre.plan.TableName().String()
+	chunkSize := qre.tsv.qe.chunkedDMLRows
+	requestCtx := qre.requestCtx
+	if requestCtx == nil {
+		requestCtx = qre.ctx
+	}
+	bindVars := make(map[string]*querypb.BindVariable, len(qre.bindVars)+2)
+	for k, v := range qre.bindVars {
+		bindVars[k] = v
+	}
+	bindVars["#chunk_size"] = sqltypes.Int64BindVariable(chunkSize)
+	subquery := qre.plan.ChunkSubquery
+	result := &sqltypes.Result{}
+	for chunk := 1; ; chunk++ {
+		var pkRows [][]sqltypes.Value
+		reply, err := qre.execChunk(requestCtx, func(chunkQre *QueryExecutor) (*sqltypes.Result, error) {
+			if err := chunkQre.tsv.throttleWriteTransaction(chunkQre.ctx, chunkQre.options); err != nil {
+				return nil, err
+			}
+			return chunkQre.execAsTransaction(func(conn *TxConnection) (*sqltypes.Result, error) {
+				innerResult, err := chunkQre.txFetch(conn, subquery, bindVars, nil, "", true, false)
+				if err != nil {
+					return nil, err
+				}
+				pkRows = innerResult.Rows
+				return chunkQre.execDMLPKRows(conn, chunkQre.plan.OuterQuery, pkRows)
+			})
+		})
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "chunk %d of the chunked dml on table %s failed after %d rows were changed", chunk, tableName, result.RowsAffected)
+		}
+		if len(pkRows) == 0 {
+			break
+		}
+		result.InsertID = reply.InsertID
+		result.RowsAffected += reply.RowsAffected
+		tabletenv.ChunkedDMLChunks.Add(tableName, 1)
+		tabletenv.ChunkedDMLRows.Add(tableName, int64(reply.RowsAffected))
+		if int64(len(pkRows)) < chunkSize {
+			break
+		}
+		bindVars["#last_pk"] = sqltypes.ValueBindVariable(pkRows[len(pkRows)-1][0])
+		subquery = qre.plan.NextChunkSubquery
+	}
+	return result, nil
+}
+
+// execChunk runs f with a copy of qre whose context is requestCtx with
+// the query timeout of the tablet.
+func (qre *QueryExecutor) execChunk(requestCtx context.Context, f func(chunkQre *QueryExecutor) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	ctx, cancel := withTimeout(requestCtx, qre.tsv.QueryTimeout.Get(), qre.options)
+	defer cancel()
+	chunkQre := *qre
+	chunkQre.ctx = ctx
+	return f(&chunkQre)
+}
+
 func (qre *QueryExecutor) execAsTransaction(f func(conn *TxConnection) (*sqltypes.Result, error)) (reply *sqltypes.Result, err error) {
 	conn, beginSQL, err := qre.tsv.te.txPool.LocalBegin(qre.ctx, qre.options)
 	if err != nil {
@@ -326,6 +454,15 @@ func (qre *QueryExecutor) execAsTransaction(f func(conn *TxConnection) (*sqltype
 	return reply, nil
 }
 
+// checkBlocklist returns an error if the fingerprint of the query
+// is blocklisted. The local queries are never denied.
+func (qre *QueryExecutor) checkBlocklist() error {
+	if qre.tsv.qe.blocklist == nil || tabletenv.IsLocalContext(qre.ctx) {
+		return nil
+	}
+	return qre.tsv.qe.blocklist.Check(queryblocklist.Fingerprint(qre.query))
+}
+
 // checkPermissions returns an error if the query does not pass all checks
 // (query blacklisting, table ACL).
 func (qre *QueryExecutor) checkPermissions() error {
@@ -359,12 +496,14 @@ func (qre *QueryExecutor) checkPermissions() error {
 	// Necessary to whitelist e.g. direct vtworker access.
 	if qre.tsv.qe.exemptACL != nil && qre.tsv.qe.exemptACL.IsMember(&querypb.VTGateCallerID{Username: username}) {
 		qre.tsv.qe.tableaclExemptCount.Add(1)
+		qre.auditAdmin(&querypb.VTGateCallerID{Username: username}, tabletenv.TableACLExempt)
 		return nil
 	}
 
 	callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
 	if callerID == nil {
 		if qre.tsv.qe.strictTableACL {
+			qre.audit(&querypb.VTGateCallerID{}, "", "", "", tabletenv.TableACLDenied)
 			return vterrors.Errorf(vtrpcpb.Code_UNAUTHENTICATED, "missing caller id")
 		}
 		return nil
@@ -373,37 +512,116 @@ func (qre *QueryExecutor) checkPermissions() error {
 	// Skip the ACL check if the caller id is an exempted superuser.
 	if qre.tsv.qe.exemptACL != nil && qre.tsv.qe.exemptACL.IsMember(callerID) {
 		qre.tsv.qe.tableaclExemptCount.Add(1)
+		qre.auditAdmin(callerID, tabletenv.TableACLExempt)
 		return nil
 	}
 
 	for i, auth := range qre.plan.Authorized {
-		if err := qre.checkAccess(auth, qre.plan.Permissions[i].TableName, callerID); err != nil {
+		if err := qre.checkAccess(auth, qre.plan.Permissions[i], callerID); err != nil {
 			return err
 		}
 	}
+	// The admin statements that don't name any table, like REPAIR
+	// or OPTIMIZE, are audited too.
+	if len(qre.plan.Authorized) == 0 {
+		qre.auditAdmin(callerID, tabletenv.TableACLAllowed)
+	}
 
-	return nil
+	return qre.checkExternalAuthorization()
+}
+
+// checkExternalAuthorization asks the external authorization service,
+// if any, if the query can be executed. The literals of the query are
+// redacted: the service only sees its fingerprint.
+func (qre *QueryExecutor) checkExternalAuthorization() error {
+	if qre.tsv.qe.authz == nil {
+		return nil
+	}
+	fingerprint, err := sqlparser.RedactSQLQuery(qre.query)
+	if err != nil {
+		return vterrors.Wrap(err, "cannot redact the query for the external authorization")
+	}
+	tables := make([]string, 0, len(qre.plan.Permissions))
+	for _, p := range qre.plan.Permissions {
+		tables = append(tables, p.TableName)
+	}
+	return qre.tsv.qe.authz.Check(qre.ctx, &authzpb.AuthorizeRequest{
+		Fingerprint:   fingerprint,
+		StatementType: qre.plan.PlanID.String(),
+		Keyspace:      qre.tsv.target.Keyspace,
+		Tables:        tables,
+	})
 }
 
-func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName string, callerID *querypb.VTGateCallerID) error {
+func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, perm planbuilder.Permission, callerID *querypb.VTGateCallerID) error {
+	tableName := perm.TableName
 	statsKey := []string{tableName, authorized.GroupName, qre.plan.PlanID.String(), callerID.Username}
 	if !authorized.IsMember(callerID) {
 		if qre.tsv.qe.enableTableACLDryRun {
 			tabletenv.TableaclPseudoDenied.Add(statsKey, 1)
+			qre.audit(callerID, tableName, authorized.GroupName, perm.Role.Name(), tabletenv.TableACLPseudoDenied)
 			return nil
 		}
 		if qre.tsv.qe.strictTableACL {
 			errStr := fmt.Sprintf("table acl error: %q %v cannot run %v on table %q", callerID.Username, callerID.Groups, qre.plan.PlanID, tableName)
 			tabletenv.TableaclDenied.Add(statsKey, 1)
 			qre.tsv.qe.accessCheckerLogger.Infof("%s", errStr)
+			qre.audit(callerID, tableName, authorized.GroupName, perm.Role.Name(), tabletenv.TableACLDenied)
 			return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "%s", errStr)
 		}
+		if perm.Role == tableacl.ADMIN {
+			qre.audit(callerID, tableName, authorized.GroupName, perm.Role.Name(), tabletenv.TableACLPseudoDenied)
+		}
 		return nil
 	}
 	tabletenv.TableaclAllowed.Add(statsKey, 1)
+	if perm.Role == tableacl.ADMIN {
+		qre.audit(callerID, tableName, authorized.GroupName, perm.Role.Name(), tabletenv.TableACLAllowed)
+	}
 	return nil
 }
 
+// isAdminPlan returns true if the plan is a DDL or another admin
+// statement, which are always audited.
+func (qre *QueryExecutor) isAdminPlan() bool {
+	return qre.plan.PlanID == planbuilder.PlanDDL || qre.plan.PlanID == planbuilder.PlanOtherAdmin
+}
+
+// auditAdmin adds an entry to the table acl audit log if the
+// statement is an admin statement.
+func (qre *QueryExecutor) auditAdmin(callerID *querypb.VTGateCallerID, decision string) {
+	if !qre.isAdminPlan() {
+		return
+	}
+	if len(qre.plan.Permissions) == 0 {
+		qre.audit(callerID, "", "", tableacl.ADMIN.Name(), decision)
+		return
+	}
+	for _, perm := range qre.plan.Permissions {
+		qre.audit(callerID, perm.TableName, "", perm.Role.Name(), decision)
+	}
+}
+
+// audit adds an entry to the table acl audit log. The literals of the
+// query are redacted, so only its fingerprint is logged.
+func (qre *QueryExecutor) audit(callerID *querypb.VTGateCallerID, tableName, groupName, role, decision string) {
+	fingerprint, err := sqlparser.RedactSQLQuery(qre.query)
+	if err != nil {
+		fingerprint = "[REDACTED]"
+	}
+	tabletenv.TableACLAuditLogger.Send(&tabletenv.TableACLAuditEntry{
+		Time:        time.Now(),
+		Username:    callerID.Username,
+		Groups:      callerID.Groups,
+		Table:       tableName,
+		TableGroup:  groupName,
+		Role:        role,
+		PlanType:    qre.plan.PlanID.String(),
+		Fingerprint: fingerprint,
+		Decision:    decision,
+	})
+}
+
 func (qre *QueryExecutor) execDDL() (*sqltypes.Result, error) {
 	sql := qre.query
 	var err error
@@ -423,6 +641,13 @@ func (qre *QueryExecutor) execDDL() (*sqltypes.Result, error) {
 		if err != nil {
 			log.Errorf("failed to reload schema %v", err)
 		}
+		// An ALTER TABLE can change the partitions of the table
+		// without changing its creation time.
+		if ddlPlan.TableName != "" {
+			if err := qre.tsv.se.ReloadTable(qre.ctx, ddlPlan.TableName); err != nil {
+				log.Errorf("failed to reload table %s: %v", ddlPlan.TableName, err)
+			}
+		}
 	}()
 
 	if qre.transactionID != 0 {
@@ -435,6 +660,9 @@ func (qre *QueryExecutor) execDDL() (*sqltypes.Result, error) {
 		if err != nil {
 			return nil, err
 		}
+		if conn.Autocommit {
+			return result, nil
+		}
 		err = conn.BeginAgain(qre.ctx)
 		if err != nil {
 			return nil, err
@@ -522,21 +750,21 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 // execDirect is for reads inside transactions. Always send to MySQL.
 func (qre *QueryExecutor) execDirect(conn *TxConnection) (*sqltypes.Result, error) {
 	if qre.plan.Fields != nil {
-		result, err := qre.txFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, "", true, false)
+		result, err := qre.txFetch(conn, qre.fullQuery(), qre.bindVars, nil, "", true, false)
 		if err != nil {
 			return nil, err
 		}
 		result.Fields = qre.plan.Fields
 		return result, nil
 	}
-	return qre.txFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, "", true, false)
+	return qre.txFetch(conn, qre.fullQuery(), qre.bindVars, nil, "", true, false)
 }
 
 // execSelect sends a query to mysql only if another identical query is not running. Otherwise, it waits and
 // reuses the result. If the plan is missng field info, it sends the query to mysql requesting full info.
 func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 	if qre.plan.Fields != nil {
-		result, err := qre.qFetch(qre.logStats, qre.plan.FullQuery, qre.bindVars)
+		result, err := qre.qFetch(qre.logStats, qre.fullQuery(), qre.bindVars)
 		if err != nil {
 			return nil, err
 		}
@@ -550,7 +778,7 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 		return nil, err
 	}
 	defer conn.Recycle()
-	return qre.dbConnFetch(conn, qre.plan.FullQuery, qre.bindVars, "", true)
+	return qre.dbConnFetch(conn, qre.fullQuery(), qre.bindVars, "", true)
 }
 
 func (qre *QueryExecutor) execInsertPK(conn *TxConnection) (*sqltypes.Result, error) {
@@ -790,7 +1018,7 @@ func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
 	defer span.Finish()
 
 	start := time.Now()
-	conn, err := qre.tsv.qe.getQueryConn(ctx)
+	conn, err := qre.tsv.qe.getQueryConn(ctx, qre.options.GetPriority())
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
@@ -870,6 +1098,9 @@ func (qre *QueryExecutor) txFetch(conn *TxConnection, parsedQuery *sqlparser.Par
 	// Only record successful queries.
 	if record {
 		conn.RecordQuery(sql)
+		if err := conn.recordSize(sql, qr); err != nil {
+			return nil, err
+		}
 	}
 	return qr, nil
 }
@@ -894,6 +1125,9 @@ func (qre *QueryExecutor) streamFetch(conn *connpool.DBConn, parsedQuery *sqlpar
 
 func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable, extras map[string]sqlparser.Encodable, buildStreamComment string) (string, string, error) {
 	bindVars["#maxLimit"] = sqltypes.Int64BindVariable(qre.getLimit(parsedQuery))
+	if qre.plan != nil && !qre.plan.PartitionValues.IsNull() {
+		extras = qre.partitionExtras(bindVars, extras)
+	}
 
 	var buf strings.Builder
 	buf.WriteString(qre.marginComments.Leading)
@@ -902,7 +1136,7 @@ func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, b
 	if err != nil {
 		return "", "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", err)
 	}
-	buf.WriteString(query)
+	buf.WriteString(qre.withMaxExecutionTime(query))
 	if buildStreamComment != "" {
 		buf.WriteString(buildStreamComment)
 	}
@@ -912,6 +1146,80 @@ func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, b
 	return fullSQL, withoutComments, nil
 }
 
+// partitionSelection is the partition selection clause of a select.
+// An empty selection reads all the partitions.
+type partitionSelection []string
+
+// EncodeSQL is part of the sqlparser.Encodable interface.
+func (ps partitionSelection) EncodeSQL(buf *strings.Builder) {
+	if len(ps) == 0 {
+		return
+	}
+	partitions := make(sqlparser.Partitions, 0, len(ps))
+	for _, name := range ps {
+		partitions = append(partitions, sqlparser.NewColIdent(name))
+	}
+	buf.WriteString(sqlparser.String(partitions))
+}
+
+// partitionExtras returns extras with the partition selection of the
+// values of the partitioning column of the query. If the partitions
+// can't be computed, all of them are read.
+func (qre *QueryExecutor) partitionExtras(bindVars map[string]*querypb.BindVariable, extras map[string]sqlparser.Encodable) map[string]sqlparser.Encodable {
+	var values []sqltypes.Value
+	var err error
+	if qre.plan.PartitionValues.IsList() {
+		values, err = qre.plan.PartitionValues.ResolveList(bindVars)
+	} else {
+		var value sqltypes.Value
+		value, err = qre.plan.PartitionValues.ResolveValue(bindVars)
+		values = []sqltypes.Value{value}
+	}
+	var selection partitionSelection
+	if err == nil {
+		if names, ok := qre.plan.Table.PartitionInfo.PartitionsFor(values); ok {
+			selection = names
+			tabletenv.PartitionPrunings.Add(qre.plan.TableName().String(), 1)
+		}
+	}
+	newExtras := make(map[string]sqlparser.Encodable, len(extras)+1)
+	for k, v := range extras {
+		newExtras[k] = v
+	}
+	newExtras["#partitions"] = selection
+	return newExtras
+}
+
+// withMaxExecutionTime adds a MAX_EXECUTION_TIME optimizer hint with the
+// timeout of the execute options to the selects, so that MySQL itself
+// interrupts them. The other queries are only killed by the context timeout.
+func (qre *QueryExecutor) withMaxExecutionTime(query string) string {
+	if qre.options.GetTimeoutMs() <= 0 {
+		return query
+	}
+	// The timeout is clamped to the timeout of the tablet, like the
+	// one of the context.
+	timeout := int64(queryTimeout(qre.tsv.QueryTimeout.Get(), qre.options) / time.Millisecond)
+	// MySQL ignores the hint for the selects that lock rows.
+	switch qre.plan.PlanID {
+	case planbuilder.PlanPassSelect, planbuilder.PlanSelectStream:
+	default:
+		return query
+	}
+	if !strings.HasPrefix(query, "select ") {
+		return query
+	}
+	hint := fmt.Sprintf("MAX_EXECUTION_TIME(%d)", timeout)
+	rest := query[len("select "):]
+	// A query block has a single optimizer hints comment, like the
+	// ones of the query rules, so the hint is merged into it. MySQL
+	// uses the first of duplicated hints, so the timeout comes first.
+	if strings.HasPrefix(rest, "/*+ ") {
+		return "select /*+ " + hint + " " + rest[len("/*+ "):]
+	}
+	return "select /*+ " + hint + " */ " + rest
+}
+
 func (qre *QueryExecutor) getLimit(query *sqlparser.ParsedQuery) int64 {
 	maxRows := qre.tsv.qe.maxResultSize.Get()
 	sqlLimit := qre.options.GetSqlSelectLimit()
@@ -932,6 +1240,15 @@ func (qre *QueryExecutor) execSQL(conn poolConn, sql string, wantfields bool) (*
 
 	defer qre.logStats.AddRewrittenSQL(sql, time.Now())
 	res, err := conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	if err == nil && qre.tsv.qe.blocklist != nil {
+		if qre.tsv.qe.blocklistRowsExamined {
+			qre.addRowsExamined(ctx, conn)
+		} else if len(res.Rows) > 0 {
+			qre.rows += int64(len(res.Rows))
+		} else {
+			qre.rows += int64(res.RowsAffected)
+		}
+	}
 	warnThreshold := qre.tsv.qe.warnResultSize.Get()
 	if res != nil && warnThreshold > 0 && int64(len(res.Rows)) > warnThreshold {
 		callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
@@ -944,8 +1261,12 @@ func (qre *QueryExecutor) execSQL(conn poolConn, sql string, wantfields bool) (*
 func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callback func(*sqltypes.Result) error) error {
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.execStreamSQL")
 	trace.AnnotateSQL(span, sql)
+	countRows := qre.tsv.qe.blocklist != nil && !qre.tsv.qe.blocklistRowsExamined
 	callBackClosingSpan := func(result *sqltypes.Result) error {
 		defer span.Finish()
+		if countRows {
+			qre.rows += int64(len(result.Rows))
+		}
 		return callback(result)
 	}
 
@@ -956,5 +1277,32 @@ func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callb
 		// MySQL error that isn't due to a connection issue
 		return err
 	}
+	if qre.tsv.qe.blocklist != nil && qre.tsv.qe.blocklistRowsExamined {
+		qre.addRowsExamined(ctx, conn)
+	}
 	return nil
 }
+
+// rowsExaminedQuery returns the rows examined by the last statement
+// executed on the connection. The statement running is only in the
+// history once it is done, so it doesn't see itself.
+const rowsExaminedQuery = "select h.ROWS_EXAMINED from performance_schema.events_statements_history h join performance_schema.threads t on t.THREAD_ID = h.THREAD_ID where t.PROCESSLIST_ID = connection_id() order by h.EVENT_ID desc limit 1"
+
+// addRowsExamined adds the rows examined by the last statement executed
+// on conn to the rows counted by the blocklist. The count is best effort:
+// if the performance_schema can't be read, the statement counts no rows.
+func (qre *QueryExecutor) addRowsExamined(ctx context.Context, conn poolConn) {
+	qr, err := conn.Exec(ctx, rowsExaminedQuery, 1, false)
+	if err != nil {
+		tabletenv.InternalErrors.Add("RowsExamined", 1)
+		return
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return
+	}
+	n, err := sqltypes.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return
+	}
+	qre.rows += n
+}
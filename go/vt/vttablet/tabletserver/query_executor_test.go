@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/callinfo/fakecallinfo"
@@ -40,6 +41,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/messager"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/queryblocklist"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
@@ -1133,6 +1135,111 @@ func TestQueryExecutorPlanDmlSubQueryAutoCommit(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorSafeDMLs(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.safeDMLs = true
+
+	for _, query := range []string{
+		"delete from test_table",
+		"update test_table set addr = 3 where addr = 1",
+		"delete from test_table where addr = 1 or name = 1",
+	} {
+		qre := newTestQueryExecutor(ctx, tsv, query, 0)
+		_, err := qre.Execute()
+		want := "unsafe dml rejected"
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Execute(%v): %v, want %v", query, err, want)
+		}
+		if code := vterrors.Code(err); code != vtrpcpb.Code_INVALID_ARGUMENT {
+			t.Errorf("Execute(%v): error code %v, want INVALID_ARGUMENT", query, code)
+		}
+	}
+
+	// A condition on a key, or a limit, bound the statement.
+	want := &sqltypes.Result{}
+	for _, query := range []string{
+		"update test_table set addr = 3 where name = 1 and addr = 1",
+		"delete from test_table where addr = 1 limit 10",
+	} {
+		db.AddQueryPattern("select pk from test_table .*", want)
+		qre := newTestQueryExecutor(ctx, tsv, query, 0)
+		if _, err := qre.Execute(); err != nil {
+			t.Errorf("Execute(%v): %v", query, err)
+		}
+	}
+}
+
+func TestQueryExecutorChunkedDML(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "delete from test_table where name = 1"
+	pkRows := func(pks ...int32) *sqltypes.Result {
+		result := &sqltypes.Result{
+			Fields: []*querypb.Field{{Type: sqltypes.Int32}},
+		}
+		for _, pk := range pks {
+			result.Rows = append(result.Rows, []sqltypes.Value{sqltypes.NewInt32(pk)})
+		}
+		return result
+	}
+	db.AddQuery("select pk from test_table where name = 1 order by pk asc limit 2 for update", pkRows(1, 2))
+	db.AddQuery("delete from test_table where pk in (1, 2) /* _stream test_table (pk ) (1 ) (2 ); */", &sqltypes.Result{RowsAffected: 2})
+	db.AddQuery("select pk from test_table where (name = 1) and pk > 2 order by pk asc limit 2 for update", pkRows(5))
+	db.AddQuery("delete from test_table where pk in (5) /* _stream test_table (pk ) (5 ); */", &sqltypes.Result{RowsAffected: 1})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.chunkedDMLRows = 2
+
+	chunks := tabletenv.ChunkedDMLChunks.Counts()["test_table"]
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	checkPlanID(t, planbuilder.PlanDMLSubquery, qre.plan.PlanID)
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if got.RowsAffected != 3 {
+		t.Errorf("RowsAffected: %v, want 3", got.RowsAffected)
+	}
+	if n := tabletenv.ChunkedDMLChunks.Counts()["test_table"] - chunks; n != 2 {
+		t.Errorf("ChunkedDMLChunks: %v, want 2", n)
+	}
+	for _, name := range []string{"#chunk_size", "#last_pk"} {
+		if _, ok := qre.bindVars[name]; ok {
+			t.Errorf("bindVars: %v, want no %s", qre.bindVars, name)
+		}
+	}
+
+	// Each chunk gets its own query timeout from the context of the
+	// request, so the chunks run even once the timeout of the first
+	// one has expired.
+	expiredCtx, cancel := context.WithTimeout(ctx, 0)
+	cancel()
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.ctx = expiredCtx
+	qre.requestCtx = ctx
+	got, err = qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if got.RowsAffected != 3 {
+		t.Errorf("RowsAffected: %v, want 3", got.RowsAffected)
+	}
+
+	// A failed chunk reports the rows changed before it.
+	db.AddRejectedQuery("select pk from test_table where (name = 1) and pk > 2 order by pk asc limit 2 for update", errRejected)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	want := "chunk 2 of the chunked dml on table test_table failed after 2 rows were changed"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("qre.Execute() = %v, want %v", err, want)
+	}
+}
+
 func TestQueryExecutorPlanOtherWithinATransaction(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1245,6 +1352,244 @@ func TestQueryExecutorPlanPassSelect(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPartitionPruning(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery(mysql.BaseShowPartitions, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("table_name|partition_name|partition_ordinal_position|partition_method|partition_expression|partition_description", "varchar|varchar|int64|varchar|varchar|varchar"),
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarChar("test_table"),
+			sqltypes.NewVarChar("p0"),
+			sqltypes.NewInt64(1),
+			sqltypes.NewVarChar("RANGE"),
+			sqltypes.NewVarChar("`pk`"),
+			sqltypes.NewVarChar("10"),
+		}, {
+			sqltypes.NewVarChar("test_table"),
+			sqltypes.NewVarChar("p1"),
+			sqltypes.NewInt64(2),
+			sqltypes.NewVarChar("RANGE"),
+			sqltypes.NewVarChar("`pk`"),
+			sqltypes.NewVarChar("MAXVALUE"),
+		}},
+	})
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery("select * from test_table partition (p1) where pk = 12 limit 1000", want)
+	db.AddQuery("select * from test_table where pk = 'a' limit 1000", want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enablePartitionPruning, db)
+	defer tsv.StopService()
+	tabletenv.PartitionPrunings.ResetAll()
+
+	query := "select * from test_table where pk = :pk limit 1000"
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.bindVars["pk"] = sqltypes.Int64BindVariable(12)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	// The partitions of a value that isn't a number can't be computed.
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.bindVars["pk"] = sqltypes.StringBindVariable("a")
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if got, want := tabletenv.PartitionPrunings.Counts()["test_table"], int64(1); got != want {
+		t.Errorf("PartitionPrunings: %v, want %v", got, want)
+	}
+}
+
+func TestQueryExecutorBlocklist(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields:       getTestTableFields(),
+		RowsAffected: 2,
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(10), sqltypes.NewInt32(100)},
+			{sqltypes.NewInt32(2), sqltypes.NewInt32(20), sqltypes.NewInt32(200)},
+		},
+	})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.blocklist = queryblocklist.New(queryblocklist.Config{
+		Automatic:  true,
+		Window:     time.Minute,
+		MaxRows:    1,
+		MaxEntries: 1,
+	})
+
+	// The first execution returns more rows than allowed,
+	// the next ones are denied.
+	if _, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Fatalf("qre.Execute() = %v, want RESOURCE_EXHAUSTED", err)
+	}
+
+	// Other queries are not denied.
+	if _, err := newTestQueryExecutor(ctx, tsv, "select * from test_table where 1 != 1", 0).Execute(); err != nil {
+		t.Errorf("qre.Execute(other query) = %v, want nil", err)
+	}
+}
+
+func TestQueryExecutorBlocklistStream(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(10), sqltypes.NewInt32(100)},
+			{sqltypes.NewInt32(2), sqltypes.NewInt32(20), sqltypes.NewInt32(200)},
+		},
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.blocklist = queryblocklist.New(queryblocklist.Config{
+		Automatic:  true,
+		Window:     time.Minute,
+		MaxRows:    1,
+		MaxEntries: 1,
+	})
+
+	// The rows of the streamed queries are counted too.
+	callback := func(*sqltypes.Result) error { return nil }
+	if err := tsv.StreamExecute(ctx, &tsv.target, query, nil, 0, nil, callback); err != nil {
+		t.Fatalf("StreamExecute() = %v, want nil", err)
+	}
+	err := tsv.StreamExecute(ctx, &tsv.target, query, nil, 0, nil, callback)
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Fatalf("StreamExecute() = %v, want RESOURCE_EXHAUSTED", err)
+	}
+	entries := tsv.qe.blocklist.Entries()
+	if len(entries) != 1 || entries[0].Rows != 2 {
+		t.Errorf("blocklist entries: %+v, want one entry with 2 rows", entries)
+	}
+}
+
+func TestQueryExecutorBlocklistRowsExamined(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where name = 'a' limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields:       getTestTableFields(),
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewInt32(1), sqltypes.NewInt32(10), sqltypes.NewInt32(100)},
+		},
+	})
+	db.AddQuery(rowsExaminedQuery, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("ROWS_EXAMINED", "uint64"),
+		"5000",
+	))
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.blocklist = queryblocklist.New(queryblocklist.Config{
+		Automatic:  true,
+		Window:     time.Minute,
+		MaxRows:    1000,
+		MaxEntries: 1,
+	})
+	tsv.qe.blocklistRowsExamined = true
+
+	// The query returns one row, but it examined more rows than allowed.
+	if _, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	entries := tsv.qe.blocklist.Entries()
+	if len(entries) != 1 || entries[0].Rows != 5000 {
+		t.Errorf("blocklist entries: %+v, want one entry with 5000 rows", entries)
+	}
+}
+
+func TestQueryExecutorMaxExecutionTime(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery("select /*+ MAX_EXECUTION_TIME(500) */ * from test_table limit 1000", want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = &querypb.ExecuteOptions{TimeoutMs: 500}
+	defer tsv.StopService()
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+
+	testcases := []struct {
+		planID      planbuilder.PlanType
+		timeout     int64
+		tabletLimit time.Duration
+		in          string
+		out         string
+	}{{
+		planID:  planbuilder.PlanPassSelect,
+		timeout: 100,
+		in:      "select /* comment */ a from t1",
+		out:     "select /*+ MAX_EXECUTION_TIME(100) */ /* comment */ a from t1",
+	}, {
+		planID:  planbuilder.PlanSelectStream,
+		timeout: 100,
+		in:      "select /*+ MAX_EXECUTION_TIME(1000) BKA(t1) */ a from t1",
+		out:     "select /*+ MAX_EXECUTION_TIME(100) MAX_EXECUTION_TIME(1000) BKA(t1) */ a from t1",
+	}, {
+		// The timeout is clamped to the query timeout of the tablet.
+		planID:      planbuilder.PlanPassSelect,
+		timeout:     60000,
+		tabletLimit: 30 * time.Second,
+		in:          "select a from t1",
+		out:         "select /*+ MAX_EXECUTION_TIME(30000) */ a from t1",
+	}, {
+		planID: planbuilder.PlanPassSelect,
+		in:     "select a from t1",
+		out:    "select a from t1",
+	}, {
+		planID:  planbuilder.PlanSelectLock,
+		timeout: 100,
+		in:      "select a from t1 for update",
+		out:     "select a from t1 for update",
+	}, {
+		planID:  planbuilder.PlanPassDML,
+		timeout: 100,
+		in:      "update t1 set a = (select a from t2)",
+		out:     "update t1 set a = (select a from t2)",
+	}}
+	for _, tcase := range testcases {
+		qre := &QueryExecutor{
+			plan:    &TabletPlan{Plan: &planbuilder.Plan{PlanID: tcase.planID}},
+			options: &querypb.ExecuteOptions{TimeoutMs: tcase.timeout},
+			tsv:     &TabletServer{QueryTimeout: sync2.NewAtomicDuration(tcase.tabletLimit)},
+		}
+		if got := qre.withMaxExecutionTime(tcase.in); got != tcase.out {
+			t.Errorf("withMaxExecutionTime(%s): %s, want %s", tcase.in, got, tcase.out)
+		}
+	}
+}
+
 func TestQueryExecutorPlanSelectImpossible(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1270,6 +1615,74 @@ func TestQueryExecutorPlanSelectImpossible(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanSavepoint(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "savepoint a"
+	want := &sqltypes.Result{}
+	db.AddQuery(query, want)
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	checkPlanID(t, planbuilder.PlanSavepoint, qre.plan.PlanID)
+	_, err := qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Errorf("qre.Execute: %v, want %v", code, vtrpcpb.Code_FAILED_PRECONDITION)
+	}
+
+	txid := newTransaction(tsv, nil)
+	qre = newTestQueryExecutor(ctx, tsv, query, txid)
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	wantqueries := []string{query}
+	gotqueries := fetchRecordedQueries(qre)
+	if !reflect.DeepEqual(gotqueries, wantqueries) {
+		t.Errorf("queries: %v, want %v", gotqueries, wantqueries)
+	}
+	testCommitHelper(t, tsv, qre)
+}
+
+func TestQueryExecutorPlanTemporaryTable(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "create temporary table t(id int)"
+	want := &sqltypes.Result{}
+	db.AddQuery(query, want)
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// A temporary table needs a reserved connection.
+	txid := newTransaction(tsv, nil)
+	qre := newTestQueryExecutor(ctx, tsv, query, txid)
+	checkPlanID(t, planbuilder.PlanTemporaryTable, qre.plan.PlanID)
+	_, err := qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Errorf("qre.Execute: %v, want %v", code, vtrpcpb.Code_FAILED_PRECONDITION)
+	}
+	testCommitHelper(t, tsv, qre)
+
+	reservedID := newTransaction(tsv, &querypb.ExecuteOptions{ReserveConnection: true})
+	qre = newTestQueryExecutor(ctx, tsv, query, reservedID)
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	if err := tsv.Rollback(ctx, &tsv.target, reservedID); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestQueryExecutorPlanPassSelectSqlSelectLimit(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1734,6 +2147,66 @@ func TestQueryExecutorTableAclNoPermission(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorTableAclAudit(t *testing.T) {
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	callerID := &querypb.VTGateCallerID{
+		Username: "u2",
+		Groups:   []string{"g1"},
+	}
+	ctx := callerid.NewContext(context.Background(), nil, callerID)
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group02",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"superuser"},
+		}},
+	}
+	if err := tableacl.InitFromProto(config); err != nil {
+		t.Fatalf("unable to load tableacl config, error: %v", err)
+	}
+
+	auditCh := tabletenv.TableACLAuditLogger.Subscribe("test")
+	defer tabletenv.TableACLAuditLogger.Unsubscribe(auditCh)
+
+	tsv := newTestTabletServer(ctx, enableStrictTableACL, db)
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	defer tsv.StopService()
+	if _, err := qre.Execute(); err == nil {
+		t.Fatal("got: nil, want: error")
+	}
+
+	// The denial is audited, without the literals of the query.
+	select {
+	case msg := <-auditCh:
+		got := msg.(*tabletenv.TableACLAuditEntry)
+		got.Time = time.Time{}
+		want := &tabletenv.TableACLAuditEntry{
+			Username:    "u2",
+			Groups:      []string{"g1"},
+			Table:       "test_table",
+			TableGroup:  "group02",
+			Role:        "READER",
+			PlanType:    "PASS_SELECT",
+			Fingerprint: "select * from test_table limit :redacted1",
+			Decision:    tabletenv.TableACLDenied,
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("audit entry: %+v, want %+v", got, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no audit entry for the denied query")
+	}
+}
+
 func TestQueryExecutorTableAclDualTableExempt(t *testing.T) {
 	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
 	tableacl.Register(aclName, &simpleacl.Factory{})
@@ -2023,6 +2496,7 @@ const (
 	smallTxPool
 	noTwopc
 	shortTwopcAge
+	enablePartitionPruning
 )
 
 // newTestQueryExecutor uses a package level variable testTabletServer defined in tabletserver_test.go
@@ -2048,6 +2522,7 @@ func newTestTabletServer(ctx context.Context, flags executorFlags, db *fakesqldb
 		config.TwoPCEnable = true
 	}
 	config.TwoPCCoordinatorAddress = "fake"
+	config.EnablePartitionPruning = flags&enablePartitionPruning > 0
 	if flags&shortTwopcAge > 0 {
 		config.TwoPCAbandonAge = 0.5
 	} else {
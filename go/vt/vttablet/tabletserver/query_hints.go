@@ -0,0 +1,117 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"bytes"
+
+	"vitess.io/vitess/go/vt/callinfo"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// buildHintedQueries builds the queries of the plan with the optimizer
+// hints of its query rules. The hints are only added to select queries.
+// Since the plans are rebuilt when the query rules change, the hinted
+// queries are built along with the plan, and the rule that applies is
+// chosen when the query is executed.
+func (ep *TabletPlan) buildHintedQueries(sql string, build func(sql string) (*planbuilder.Plan, error)) error {
+	switch ep.PlanID {
+	case planbuilder.PlanPassSelect, planbuilder.PlanSelectLock, planbuilder.PlanSelectStream:
+	default:
+		return nil
+	}
+	for _, hints := range ep.Rules.AllHints() {
+		statement, err := sqlparser.Parse(sql)
+		if err != nil {
+			return err
+		}
+		sel, ok := statement.(*sqlparser.Select)
+		if !ok || !applyHints(sel, hints, ep.TableName()) {
+			continue
+		}
+		hinted, err := build(sqlparser.String(sel))
+		if err != nil {
+			return err
+		}
+		if ep.hintedQueries == nil {
+			ep.hintedQueries = make(map[*rules.Hints]*sqlparser.ParsedQuery)
+		}
+		ep.hintedQueries[hints] = hinted.FullQuery
+	}
+	return nil
+}
+
+// applyHints adds the hints to a select, and returns false if none of
+// them apply. The USE INDEX hint is added to the table of the plan.
+// The optimizer hints must be in the first comment after the select
+// keyword, so they're merged with the optimizer hints of the query,
+// if any.
+func applyHints(sel *sqlparser.Select, hints *rules.Hints, tableName sqlparser.TableIdent) bool {
+	applied := false
+	if optimizerHints := hints.OptimizerHints(); optimizerHints != "" {
+		if len(sel.Comments) > 0 && bytes.HasPrefix(sel.Comments[0], []byte("/*+")) {
+			existing := bytes.TrimSuffix(bytes.TrimSpace(sel.Comments[0]), []byte("*/"))
+			merged := string(bytes.TrimSpace(existing)) + " " + optimizerHints[len("/*+ "):]
+			sel.Comments[0] = []byte(merged)
+		} else {
+			sel.Comments = append(sqlparser.Comments{[]byte(optimizerHints)}, sel.Comments...)
+		}
+		applied = true
+	}
+	if len(hints.UseIndex) != 0 && !tableName.IsEmpty() {
+		indexHints := &sqlparser.IndexHints{Type: sqlparser.UseStr}
+		for _, index := range hints.UseIndex {
+			indexHints.Indexes = append(indexHints.Indexes, sqlparser.NewColIdent(index))
+		}
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			switch node := node.(type) {
+			case *sqlparser.Subquery:
+				return false, nil
+			case *sqlparser.AliasedTableExpr:
+				if name, ok := node.Expr.(sqlparser.TableName); ok && name.Name == tableName {
+					node.Hints = indexHints
+					applied = true
+				}
+			}
+			return true, nil
+		}, sel.From)
+	}
+	return applied
+}
+
+// fullQuery returns the query of the plan, with the optimizer hints of
+// the first matching query rule, if any.
+func (qre *QueryExecutor) fullQuery() *sqlparser.ParsedQuery {
+	if len(qre.plan.hintedQueries) == 0 {
+		return qre.plan.FullQuery
+	}
+	remoteAddr := ""
+	username := ""
+	if ci, ok := callinfo.FromContext(qre.ctx); ok {
+		remoteAddr = ci.RemoteAddr()
+		username = ci.Username()
+	}
+	hints := qre.plan.Rules.GetHints(remoteAddr, username, qre.bindVars)
+	if hinted, ok := qre.plan.hintedQueries[hints]; ok {
+		tabletenv.QueryHints.Add(qre.plan.TableName().String(), 1)
+		return hinted
+	}
+	return qre.plan.FullQuery
+}
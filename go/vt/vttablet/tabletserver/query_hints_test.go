@@ -0,0 +1,134 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callinfo"
+	"vitess.io/vitess/go/vt/callinfo/fakecallinfo"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func TestApplyHints(t *testing.T) {
+	testcases := []struct {
+		in    string
+		hints *rules.Hints
+		out   string
+	}{{
+		in:    "select a from t1 where b = 1",
+		hints: &rules.Hints{MaxExecutionTime: 1000},
+		out:   "select /*+ MAX_EXECUTION_TIME(1000) */ a from t1 where b = 1",
+	}, {
+		in:    "select /* comment */ a from t1",
+		hints: &rules.Hints{MaxExecutionTime: 1000, OptimizerSwitch: "index_merge=off"},
+		out:   "select /*+ MAX_EXECUTION_TIME(1000) SET_VAR(optimizer_switch='index_merge=off') */ /* comment */ a from t1",
+	}, {
+		in:    "select /*+ BKA(t1) */ a from t1",
+		hints: &rules.Hints{MaxExecutionTime: 1000},
+		out:   "select /*+ BKA(t1) MAX_EXECUTION_TIME(1000) */ a from t1",
+	}, {
+		in:    "select a from t1 join t2 on t1.id = t2.id where t1.b in (select b from t1)",
+		hints: &rules.Hints{UseIndex: []string{"idx_b", "idx_c"}},
+		out:   "select a from t1 use index (idx_b, idx_c) join t2 on t1.id = t2.id where t1.b in (select b from t1)",
+	}, {
+		in:    "select a from t2",
+		hints: &rules.Hints{UseIndex: []string{"idx_b"}},
+		out:   "",
+	}}
+	for _, tcase := range testcases {
+		statement, err := sqlparser.Parse(tcase.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sel := statement.(*sqlparser.Select)
+		applied := applyHints(sel, tcase.hints, sqlparser.NewTableIdent("t1"))
+		if tcase.out == "" {
+			if applied {
+				t.Errorf("applyHints(%s): %s, want not applied", tcase.in, sqlparser.String(sel))
+			}
+			continue
+		}
+		if got := sqlparser.String(sel); !applied || got != tcase.out {
+			t.Errorf("applyHints(%s): %s (applied: %v), want %s", tcase.in, got, applied, tcase.out)
+		}
+	}
+}
+
+func TestQueryExecutorHints(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where name = 1 limit 1000"
+	hintedQuery := "select /*+ MAX_EXECUTION_TIME(1000) */ * from test_table use index (idx_name) where name = 1 limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+	db.AddQuery(hintedQuery, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	hintRule := rules.NewQueryRule("hints for u1", "hints", rules.QRContinue)
+	hintRule.SetUserCond("u1")
+	hintRule.SetQueryCond("select .* from test_table .*")
+	hintRule.SetHints(&rules.Hints{
+		UseIndex:         []string{"idx_name"},
+		MaxExecutionTime: 1000,
+	})
+	qrs := rules.New()
+	qrs.Add(hintRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	rulesName := "hintRules"
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	if err := tsv.qe.queryRuleSources.SetRules(rulesName, qrs); err != nil {
+		t.Fatal(err)
+	}
+
+	// The hints apply to u1.
+	count := tabletenv.QueryHints.Counts()["test_table"]
+	ctx1 := callinfo.NewContext(ctx, &fakecallinfo.FakeCallInfo{User: "u1"})
+	qre := newTestQueryExecutor(ctx1, tsv, query, 0)
+	if got := qre.fullQuery().Query; got != hintedQuery {
+		t.Errorf("fullQuery: %s, want %s", got, hintedQuery)
+	}
+	if _, err := qre.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if got := tabletenv.QueryHints.Counts()["test_table"]; got <= count {
+		t.Errorf("QueryHints[test_table]: %v, want more than %v", got, count)
+	}
+
+	// They don't apply to u2.
+	ctx2 := callinfo.NewContext(ctx, &fakecallinfo.FakeCallInfo{User: "u2"})
+	qre = newTestQueryExecutor(ctx2, tsv, query, 0)
+	if got := qre.fullQuery().Query; got != query {
+		t.Errorf("fullQuery: %s, want %s", got, query)
+	}
+	if _, err := qre.Execute(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// retryPlans are the plan types that can have a retry policy. They are
+// the plans of the queries that run outside of the transactions of the
+// clients, in their own autocommit transaction if they are DMLs, so
+// that an attempt can be retried without the statements before it.
+var retryPlans = map[planbuilder.PlanType]bool{
+	planbuilder.PlanPassSelect:     true,
+	planbuilder.PlanPassDML:        true,
+	planbuilder.PlanDMLPK:          true,
+	planbuilder.PlanDMLSubquery:    true,
+	planbuilder.PlanInsertPK:       true,
+	planbuilder.PlanInsertSubquery: true,
+	planbuilder.PlanInsertMessage:  true,
+	planbuilder.PlanUpsertPK:       true,
+}
+
+// retryPolicy is the escalation ladder of a plan type: the attempts of a
+// query that fails with a transient error run with increasing timeouts.
+// The number of timeouts is the maximum number of attempts.
+type retryPolicy struct {
+	timeouts []time.Duration
+}
+
+// newRetryPolicies parses the retry policies of the config. A policy
+// is <plan type>:<timeout>[/<timeout>...].
+func newRetryPolicies(policies []string) (map[planbuilder.PlanType]*retryPolicy, error) {
+	result := make(map[planbuilder.PlanType]*retryPolicy)
+	for _, policy := range policies {
+		policy = strings.TrimSpace(policy)
+		if policy == "" {
+			continue
+		}
+		parts := strings.Split(policy, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid retry policy %q: want <plan type>:<timeout>[/<timeout>...]", policy)
+		}
+		planID, ok := planbuilder.PlanByName(parts[0])
+		if !ok {
+			return nil, fmt.Errorf("invalid retry policy %q: unknown plan type %v", policy, parts[0])
+		}
+		if !retryPlans[planID] {
+			return nil, fmt.Errorf("invalid retry policy %q: the queries of plan type %v cannot be retried", policy, parts[0])
+		}
+		if _, ok := result[planID]; ok {
+			return nil, fmt.Errorf("invalid retry policy %q: duplicate plan type %v", policy, parts[0])
+		}
+		rp := &retryPolicy{}
+		for _, t := range strings.Split(parts[1], "/") {
+			timeout, err := time.ParseDuration(t)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retry policy %q: %v", policy, err)
+			}
+			if timeout <= 0 {
+				return nil, fmt.Errorf("invalid retry policy %q: the timeouts must be positive", policy)
+			}
+			rp.timeouts = append(rp.timeouts, timeout)
+		}
+		result[planID] = rp
+	}
+	return result, nil
+}
+
+// retryReason returns the reason to retry a failed attempt, or "" if it
+// must not be retried. Lock wait timeouts and deadlocks roll back the
+// statement, or the whole autocommit transaction, so retrying it is
+// safe. An attempt that reached its own timeout is only retried for
+// reads, because a DML that times out may have been committed.
+func retryReason(err error, planID planbuilder.PlanType, attemptCtx, ctx context.Context) string {
+	if sqlErr, ok := err.(*mysql.SQLError); ok {
+		switch sqlErr.Number() {
+		case mysql.ERLockWaitTimeout:
+			return "LockWaitTimeout"
+		case mysql.ERLockDeadlock:
+			return "Deadlock"
+		}
+	}
+	if planID.IsSelect() && attemptCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+		return "Timeout"
+	}
+	return ""
+}
+
+// execWithRetries executes f with the retry policy of the plan, if any.
+// Each attempt runs with the timeout of its rung of the ladder, within
+// the deadline of the query.
+func (qre *QueryExecutor) execWithRetries(f func() (*sqltypes.Result, error)) (*sqltypes.Result, error) {
+	policy := qre.tsv.qe.retryPolicies[qre.plan.PlanID]
+	if policy == nil {
+		return f()
+	}
+	planName := qre.plan.PlanID.String()
+	ctx := qre.ctx
+	defer func() {
+		qre.ctx = ctx
+	}()
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, policy.timeouts[attempt])
+		qre.ctx = attemptCtx
+		qr, err := f()
+		cancel()
+		if err == nil {
+			if attempt == 0 {
+				tabletenv.QueryRetryResults.Add([]string{planName, "FirstTry"}, 1)
+			} else {
+				tabletenv.QueryRetryResults.Add([]string{planName, "Retried"}, 1)
+			}
+			return qr, nil
+		}
+		reason := retryReason(err, qre.plan.PlanID, attemptCtx, ctx)
+		if reason == "" {
+			return nil, err
+		}
+		if attempt+1 == len(policy.timeouts) {
+			tabletenv.QueryRetryResults.Add([]string{planName, "Exhausted"}, 1)
+			return nil, err
+		}
+		tabletenv.QueryRetries.Add([]string{planName, reason}, 1)
+	}
+}
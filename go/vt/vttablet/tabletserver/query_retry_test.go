@@ -0,0 +1,142 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func TestNewRetryPolicies(t *testing.T) {
+	policies, err := newRetryPolicies([]string{"PASS_SELECT:100ms/1s/5s", " DML_PK:2s "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[planbuilder.PlanType]*retryPolicy{
+		planbuilder.PlanPassSelect: {timeouts: []time.Duration{100 * time.Millisecond, time.Second, 5 * time.Second}},
+		planbuilder.PlanDMLPK:      {timeouts: []time.Duration{2 * time.Second}},
+	}
+	if !reflect.DeepEqual(policies, want) {
+		t.Errorf("newRetryPolicies: %v, want %v", policies, want)
+	}
+
+	testcases := []struct {
+		policy string
+		err    string
+	}{{
+		policy: "PASS_SELECT",
+		err:    "want <plan type>:<timeout>",
+	}, {
+		policy: "UNKNOWN:1s",
+		err:    "unknown plan type UNKNOWN",
+	}, {
+		policy: "DDL:1s",
+		err:    "the queries of plan type DDL cannot be retried",
+	}, {
+		policy: "PASS_SELECT:1s/abc",
+		err:    "invalid duration",
+	}, {
+		policy: "PASS_SELECT:0s",
+		err:    "the timeouts must be positive",
+	}}
+	for _, tc := range testcases {
+		_, err := newRetryPolicies([]string{tc.policy})
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("newRetryPolicies(%s): %v, want %s", tc.policy, err, tc.err)
+		}
+	}
+	if _, err := newRetryPolicies([]string{"PASS_SELECT:1s", "PASS_SELECT:2s"}); err == nil || !strings.Contains(err.Error(), "duplicate plan type PASS_SELECT") {
+		t.Errorf("newRetryPolicies with duplicates: %v, want duplicate plan type", err)
+	}
+}
+
+func TestQueryExecutorRetries(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noTwopc, db)
+	defer tsv.StopService()
+	tsv.qe.retryPolicies = map[planbuilder.PlanType]*retryPolicy{
+		planbuilder.PlanPassSelect: {timeouts: []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}},
+	}
+
+	// The deadlock is retried.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	db.OrderMatters()
+	db.AddExpectedQuery(query, mysql.NewSQLError(mysql.ERLockDeadlock, mysql.SSUnknownSQLState, "Deadlock found when trying to get lock"))
+	db.AddExpectedExecuteFetch(fakesqldb.ExpectedExecuteFetch{Query: query, QueryResult: want})
+	retries := tabletenv.QueryRetries.Counts()["PASS_SELECT.Deadlock"]
+	retried := tabletenv.QueryRetryResults.Counts()["PASS_SELECT.Retried"]
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+	db.VerifyAllExecutedOrFail()
+	if got := tabletenv.QueryRetries.Counts()["PASS_SELECT.Deadlock"]; got != retries+1 {
+		t.Errorf("QueryRetries[PASS_SELECT.Deadlock]: %v, want %v", got, retries+1)
+	}
+	if got := tabletenv.QueryRetryResults.Counts()["PASS_SELECT.Retried"]; got != retried+1 {
+		t.Errorf("QueryRetryResults[PASS_SELECT.Retried]: %v, want %v", got, retried+1)
+	}
+	if qre.ctx != ctx {
+		t.Errorf("the context of the query executor was not restored")
+	}
+
+	// The attempts are capped.
+	db.DeleteAllEntries()
+	db.AddExpectedQuery(query, mysql.NewSQLError(mysql.ERLockWaitTimeout, mysql.SSUnknownSQLState, "Lock wait timeout exceeded"))
+	db.AddExpectedQuery(query, mysql.NewSQLError(mysql.ERLockWaitTimeout, mysql.SSUnknownSQLState, "Lock wait timeout exceeded"))
+	db.AddExpectedQuery(query, mysql.NewSQLError(mysql.ERLockWaitTimeout, mysql.SSUnknownSQLState, "Lock wait timeout exceeded"))
+	exhausted := tabletenv.QueryRetryResults.Counts()["PASS_SELECT.Exhausted"]
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	if _, err := qre.Execute(); err == nil || !strings.Contains(err.Error(), "Lock wait timeout exceeded") {
+		t.Errorf("qre.Execute() = %v, want lock wait timeout", err)
+	}
+	db.VerifyAllExecutedOrFail()
+	if got := tabletenv.QueryRetryResults.Counts()["PASS_SELECT.Exhausted"]; got != exhausted+1 {
+		t.Errorf("QueryRetryResults[PASS_SELECT.Exhausted]: %v, want %v", got, exhausted+1)
+	}
+
+	// The other errors are not retried.
+	db.DeleteAllEntries()
+	db.AddExpectedQuery(query, mysql.NewSQLError(mysql.ERDupEntry, mysql.SSDupKey, "Duplicate entry"))
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	if _, err := qre.Execute(); err == nil || !strings.Contains(err.Error(), "Duplicate entry") {
+		t.Errorf("qre.Execute() = %v, want duplicate entry", err)
+	}
+	db.VerifyAllExecutedOrFail()
+}
@@ -0,0 +1,425 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package queryblocklist tracks the load generated by each query
+// fingerprint, and denies the fingerprints that are blocklisted.
+//
+// The MySQL time and the rows of the queries of each fingerprint are
+// summed over a sliding window. A fingerprint whose load goes above
+// the thresholds is a runaway pattern: it is either blocklisted right
+// away, or proposed to the operator who has to approve the block.
+// Operators can also block and unblock fingerprints manually.
+package queryblocklist
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlnormalizer"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	// numBuckets is the number of buckets of the sliding window.
+	numBuckets = 6
+	// maxTracked is the maximum number of fingerprints tracked at
+	// the same time, to bound the memory used by the blocklist.
+	maxTracked = 10000
+)
+
+// The states of a blocklist entry.
+const (
+	// StatePending means the fingerprint was detected as a runaway
+	// pattern, and waits for the approval of an operator.
+	StatePending = "PENDING"
+	// StateBlocked means the queries of the fingerprint are denied.
+	StateBlocked = "BLOCKED"
+)
+
+var (
+	denials = stats.NewCounter(
+		"QueryBlocklistDenials",
+		"Number of queries denied because their fingerprint is blocklisted")
+	detections = stats.NewCountersWithSingleLabel(
+		"QueryBlocklistDetections",
+		"Number of runaway query patterns detected, by the state they were put in",
+		"state")
+)
+
+// Config configures a Blocklist.
+type Config struct {
+	// Automatic blocks the runaway patterns right away. Otherwise,
+	// they are pending until an operator approves them.
+	Automatic bool
+	// Window is the duration over which the load is summed.
+	Window time.Duration
+	// MaxMysqlTime is the MySQL time a fingerprint can use during the
+	// window. 0 disables the threshold.
+	MaxMysqlTime time.Duration
+	// MaxRows is the number of rows the queries of a fingerprint can
+	// return or affect during the window. 0 disables the threshold.
+	MaxRows int64
+	// MaxEntries is the maximum number of runaway patterns that are
+	// blocked or pending at the same time. If more are detected, only
+	// the worst ones are kept.
+	MaxEntries int
+	// BlockDuration is how long the runaway patterns stay blocked.
+	// 0 means until an operator unblocks them.
+	BlockDuration time.Duration
+}
+
+// Entry is a fingerprint that is blocked, or pending approval.
+type Entry struct {
+	Fingerprint string
+	Query       string
+	State       string
+	// Manual is true if the entry was added by an operator.
+	Manual bool
+	// MysqlTime and Rows are the load of the fingerprint during the
+	// window when it was detected.
+	MysqlTime time.Duration
+	Rows      int64
+	Reason    string
+	Since     time.Time
+	// Expires is zero if the entry doesn't expire.
+	Expires time.Time
+}
+
+// Event is dispatched when the state of an entry changes. The state
+// is empty when the entry is removed.
+type Event struct {
+	Entry Entry
+}
+
+// bucket holds the load of a fingerprint during one interval of
+// the window.
+type bucket struct {
+	interval  int64
+	mysqlTime time.Duration
+	rows      int64
+}
+
+// usage is the load of a fingerprint during the window.
+type usage struct {
+	query   string
+	buckets [numBuckets]bucket
+}
+
+// Blocklist tracks the load of the query fingerprints, and denies the
+// blocklisted ones. It is safe for concurrent use.
+type Blocklist struct {
+	config   Config
+	interval time.Duration
+	// now is replaced in tests.
+	now func() time.Time
+
+	mu      sync.Mutex
+	usages  map[string]*usage
+	entries map[string]*Entry
+}
+
+// New returns a Blocklist.
+func New(config Config) *Blocklist {
+	bl := &Blocklist{
+		config:   config,
+		interval: config.Window / numBuckets,
+		now:      time.Now,
+		usages:   make(map[string]*usage),
+		entries:  make(map[string]*Entry),
+	}
+	if bl.interval <= 0 {
+		bl.interval = time.Second
+	}
+	return bl
+}
+
+// Fingerprint returns the fingerprint of a query. The queries are
+// expected to be normalized by vtgate, so the fingerprint is a hash
+// of the query text, as computed by sqlnormalizer.
+func Fingerprint(query string) string {
+	return sqlnormalizer.FingerprintNormalized(query)
+}
+
+// Check returns an error if the fingerprint is blocked.
+func (bl *Blocklist) Check(fingerprint string) error {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	entry, ok := bl.entries[fingerprint]
+	if !ok || entry.State != StateBlocked {
+		return nil
+	}
+	if bl.expired(entry, bl.now()) {
+		bl.removeLocked(fingerprint)
+		return nil
+	}
+	denials.Add(1)
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query fingerprint %v is blocklisted: %v", fingerprint, entry.Reason)
+}
+
+// Record adds the load of a query to its fingerprint, and detects if
+// the fingerprint became a runaway pattern.
+func (bl *Blocklist) Record(fingerprint, query string, mysqlTime time.Duration, rows int64) {
+	now := bl.now()
+	interval := now.UnixNano() / int64(bl.interval)
+
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+
+	u, ok := bl.usages[fingerprint]
+	if !ok {
+		if len(bl.usages) >= maxTracked {
+			bl.pruneLocked(interval)
+			if len(bl.usages) >= maxTracked {
+				return
+			}
+		}
+		u = &usage{query: query}
+		bl.usages[fingerprint] = u
+	}
+	b := &u.buckets[interval%numBuckets]
+	if b.interval != interval {
+		*b = bucket{interval: interval}
+	}
+	b.mysqlTime += mysqlTime
+	b.rows += rows
+
+	if entry, ok := bl.entries[fingerprint]; ok {
+		if !bl.expired(entry, now) {
+			return
+		}
+		bl.removeLocked(fingerprint)
+		return
+	}
+	totalTime, totalRows := u.total(interval)
+	var reason string
+	switch {
+	case bl.config.MaxMysqlTime > 0 && totalTime > bl.config.MaxMysqlTime:
+		reason = fmt.Sprintf("used %v of MySQL time in %v, more than %v", totalTime, bl.config.Window, bl.config.MaxMysqlTime)
+	case bl.config.MaxRows > 0 && totalRows > bl.config.MaxRows:
+		reason = fmt.Sprintf("read or changed %v rows in %v, more than %v", totalRows, bl.config.Window, bl.config.MaxRows)
+	default:
+		return
+	}
+
+	entry := &Entry{
+		Fingerprint: fingerprint,
+		Query:       u.query,
+		State:       StatePending,
+		MysqlTime:   totalTime,
+		Rows:        totalRows,
+		Reason:      reason,
+		Since:       now,
+	}
+	if bl.config.Automatic {
+		bl.block(entry, now)
+	}
+	if !bl.makeRoomLocked(entry, now) {
+		return
+	}
+	bl.entries[fingerprint] = entry
+	detections.Add(entry.State, 1)
+	log.Warningf("Query fingerprint %v is %v, it %v: %v", fingerprint, entry.State, reason, entry.Query)
+	event.Dispatch(&Event{Entry: *entry})
+}
+
+// Approve blocks a pending fingerprint.
+func (bl *Blocklist) Approve(fingerprint string) error {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	entry, ok := bl.entries[fingerprint]
+	if !ok {
+		return fmt.Errorf("query fingerprint %v is not in the blocklist", fingerprint)
+	}
+	if entry.State != StatePending {
+		return fmt.Errorf("query fingerprint %v is not pending, it is %v", fingerprint, entry.State)
+	}
+	bl.block(entry, bl.now())
+	log.Infof("Query fingerprint %v was approved, it is %v", fingerprint, entry.State)
+	event.Dispatch(&Event{Entry: *entry})
+	return nil
+}
+
+// Block manually blocks a fingerprint. The entry doesn't expire.
+func (bl *Blocklist) Block(fingerprint, reason string) {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	entry, ok := bl.entries[fingerprint]
+	if !ok {
+		entry = &Entry{
+			Fingerprint: fingerprint,
+			Since:       bl.now(),
+		}
+		if u, ok := bl.usages[fingerprint]; ok {
+			entry.Query = u.query
+		}
+		bl.entries[fingerprint] = entry
+	}
+	entry.State = StateBlocked
+	entry.Manual = true
+	entry.Reason = reason
+	entry.Expires = time.Time{}
+	log.Infof("Query fingerprint %v was blocked manually: %v", fingerprint, reason)
+	event.Dispatch(&Event{Entry: *entry})
+}
+
+// Remove removes a fingerprint from the blocklist, whatever its state.
+func (bl *Blocklist) Remove(fingerprint string) error {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	if _, ok := bl.entries[fingerprint]; !ok {
+		return fmt.Errorf("query fingerprint %v is not in the blocklist", fingerprint)
+	}
+	bl.removeLocked(fingerprint)
+	return nil
+}
+
+// The actions of Apply.
+const (
+	ActionApprove = "approve"
+	ActionBlock   = "block"
+	ActionRemove  = "remove"
+)
+
+// Apply changes the blocklist on behalf of an operator: it approves,
+// blocks or removes the fingerprint depending on the action. The reason
+// is only used to block, and defaults to "blocked by an operator".
+func (bl *Blocklist) Apply(action, fingerprint, reason string) error {
+	if fingerprint == "" {
+		return fmt.Errorf("missing fingerprint")
+	}
+	switch action {
+	case ActionApprove:
+		return bl.Approve(fingerprint)
+	case ActionBlock:
+		if reason == "" {
+			reason = "blocked by an operator"
+		}
+		bl.Block(fingerprint, reason)
+		return nil
+	case ActionRemove:
+		return bl.Remove(fingerprint)
+	}
+	return fmt.Errorf("unknown action %v", action)
+}
+
+// Entries returns a copy of the current entries, sorted by decreasing
+// MySQL time.
+func (bl *Blocklist) Entries() []Entry {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+	now := bl.now()
+	entries := make([]Entry, 0, len(bl.entries))
+	for fingerprint, entry := range bl.entries {
+		if bl.expired(entry, now) {
+			bl.removeLocked(fingerprint)
+			continue
+		}
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].MysqlTime != entries[j].MysqlTime {
+			return entries[i].MysqlTime > entries[j].MysqlTime
+		}
+		return entries[i].Fingerprint < entries[j].Fingerprint
+	})
+	return entries
+}
+
+func (bl *Blocklist) block(entry *Entry, now time.Time) {
+	entry.State = StateBlocked
+	if bl.config.BlockDuration > 0 {
+		entry.Expires = now.Add(bl.config.BlockDuration)
+	}
+}
+
+func (bl *Blocklist) expired(entry *Entry, now time.Time) bool {
+	return !entry.Expires.IsZero() && now.After(entry.Expires)
+}
+
+func (bl *Blocklist) removeLocked(fingerprint string) {
+	entry := bl.entries[fingerprint]
+	delete(bl.entries, fingerprint)
+	// The load that got the fingerprint blocked is forgotten,
+	// so it is not detected again right away.
+	delete(bl.usages, fingerprint)
+	log.Infof("Query fingerprint %v was removed from the blocklist", fingerprint)
+	removed := *entry
+	removed.State = ""
+	event.Dispatch(&Event{Entry: removed})
+}
+
+// makeRoomLocked makes sure there is room for a new automatic entry.
+// If the blocklist is full, the new entry replaces the automatic entry
+// with the least MySQL time, if it is worse than it. It returns false
+// if the new entry should not be added.
+func (bl *Blocklist) makeRoomLocked(entry *Entry, now time.Time) bool {
+	for fingerprint, e := range bl.entries {
+		if bl.expired(e, now) {
+			bl.removeLocked(fingerprint)
+		}
+	}
+	if len(bl.entries) < bl.config.MaxEntries {
+		return true
+	}
+	var least *Entry
+	for _, e := range bl.entries {
+		if e.Manual {
+			continue
+		}
+		if least == nil || e.MysqlTime < least.MysqlTime {
+			least = e
+		}
+	}
+	if least == nil || least.MysqlTime >= entry.MysqlTime {
+		return false
+	}
+	bl.removeLocked(least.Fingerprint)
+	return true
+}
+
+// pruneLocked forgets the fingerprints that had no query during
+// the window.
+func (bl *Blocklist) pruneLocked(interval int64) {
+	for fingerprint, u := range bl.usages {
+		if _, ok := bl.entries[fingerprint]; ok {
+			continue
+		}
+		if totalTime, totalRows := u.total(interval); totalTime == 0 && totalRows == 0 {
+			delete(bl.usages, fingerprint)
+		}
+	}
+}
+
+// total returns the load of the fingerprint during the window that
+// ends with the current interval.
+func (u *usage) total(interval int64) (time.Duration, int64) {
+	var mysqlTime time.Duration
+	var rows int64
+	for _, b := range u.buckets {
+		if b.interval > interval-numBuckets {
+			mysqlTime += b.mysqlTime
+			rows += b.rows
+		}
+	}
+	return mysqlTime, rows
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queryblocklist
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/streamlog"
+)
+
+// ServeHTTP lists the entries of the blocklist as JSON. With an
+// "action" parameter, it changes the blocklist first:
+// - approve&fingerprint=F blocks the pending fingerprint F,
+// - block&fingerprint=F&reason=R blocks the fingerprint F,
+// - remove&fingerprint=F removes the fingerprint F from the blocklist.
+// Listing requires the DEBUGGING role, and changing the blocklist
+// requires the ADMIN role.
+func (bl *Blocklist) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	if err := request.ParseForm(); err != nil {
+		http.Error(response, fmt.Sprintf("cannot parse form: %v", err), http.StatusBadRequest)
+		return
+	}
+	if action := request.FormValue("action"); action != "" {
+		if err := acl.CheckAccessHTTP(request, acl.ADMIN); err != nil {
+			acl.SendError(response, err)
+			return
+		}
+		if err := bl.Apply(action, request.FormValue("fingerprint"), request.FormValue("reason")); err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+
+	entries := bl.Entries()
+	if *streamlog.RedactDebugUIQueries {
+		for i := range entries {
+			entries[i].Query = ""
+		}
+	}
+	data, err := json.MarshalIndent(entries, "", " ")
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	response.Write(data)
+}
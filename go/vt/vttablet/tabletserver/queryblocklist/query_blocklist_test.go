@@ -0,0 +1,225 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queryblocklist
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (fc *fakeClock) Now() time.Time {
+	return fc.now
+}
+
+func newTestBlocklist(automatic bool) (*Blocklist, *fakeClock) {
+	bl := New(Config{
+		Automatic:     automatic,
+		Window:        60 * time.Second,
+		MaxMysqlTime:  10 * time.Second,
+		MaxRows:       1000,
+		MaxEntries:    2,
+		BlockDuration: 5 * time.Minute,
+	})
+	clock := &fakeClock{now: time.Unix(1000000, 0)}
+	bl.now = clock.Now
+	return bl, clock
+}
+
+func TestBlocklistAutomatic(t *testing.T) {
+	bl, clock := newTestBlocklist(true)
+	fp := Fingerprint("select * from t1")
+
+	bl.Record(fp, "select * from t1", 6*time.Second, 10)
+	if err := bl.Check(fp); err != nil {
+		t.Fatalf("Check(under threshold): %v", err)
+	}
+
+	// The load is summed over the window.
+	clock.now = clock.now.Add(20 * time.Second)
+	bl.Record(fp, "select * from t1", 6*time.Second, 10)
+	err := bl.Check(fp)
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Fatalf("Check(runaway): %v, want RESOURCE_EXHAUSTED", err)
+	}
+	entries := bl.Entries()
+	if len(entries) != 1 || entries[0].State != StateBlocked || entries[0].MysqlTime != 12*time.Second || entries[0].Query != "select * from t1" {
+		t.Errorf("Entries: %+v", entries)
+	}
+
+	// The automatic entries expire.
+	clock.now = clock.now.Add(6 * time.Minute)
+	if err := bl.Check(fp); err != nil {
+		t.Errorf("Check(expired): %v", err)
+	}
+	if entries := bl.Entries(); len(entries) != 0 {
+		t.Errorf("Entries(expired): %+v", entries)
+	}
+}
+
+func TestBlocklistWindow(t *testing.T) {
+	bl, clock := newTestBlocklist(true)
+	fp := Fingerprint("select * from t1")
+
+	// Loads that are further apart than the window are not summed.
+	for i := 0; i < 5; i++ {
+		bl.Record(fp, "select * from t1", 6*time.Second, 0)
+		clock.now = clock.now.Add(70 * time.Second)
+	}
+	if err := bl.Check(fp); err != nil {
+		t.Errorf("Check: %v", err)
+	}
+
+	// The rows threshold.
+	bl.Record(fp, "select * from t1", 0, 1001)
+	if err := bl.Check(fp); err == nil || !strings.Contains(err.Error(), "1001 rows") {
+		t.Errorf("Check(rows): %v, want rows error", err)
+	}
+}
+
+func TestBlocklistApproval(t *testing.T) {
+	bl, _ := newTestBlocklist(false)
+	fp := Fingerprint("select * from t1")
+
+	bl.Record(fp, "select * from t1", 11*time.Second, 0)
+	if err := bl.Check(fp); err != nil {
+		t.Fatalf("Check(pending): %v", err)
+	}
+	entries := bl.Entries()
+	if len(entries) != 1 || entries[0].State != StatePending {
+		t.Fatalf("Entries: %+v", entries)
+	}
+	if err := bl.Approve("unknown"); err == nil {
+		t.Errorf("Approve(unknown) succeeded")
+	}
+	if err := bl.Approve(fp); err != nil {
+		t.Fatal(err)
+	}
+	if err := bl.Check(fp); err == nil {
+		t.Errorf("Check(approved) succeeded")
+	}
+	if err := bl.Approve(fp); err == nil {
+		t.Errorf("Approve(blocked) succeeded")
+	}
+	if err := bl.Remove(fp); err != nil {
+		t.Fatal(err)
+	}
+	if err := bl.Check(fp); err != nil {
+		t.Errorf("Check(removed): %v", err)
+	}
+}
+
+func TestBlocklistMaxEntries(t *testing.T) {
+	bl, _ := newTestBlocklist(true)
+	bl.Block("manual", "too slow")
+
+	bl.Record("fp1", "q1", 11*time.Second, 0)
+	// The blocklist is full, fp2 replaces fp1, which is not as bad.
+	bl.Record("fp2", "q2", 20*time.Second, 0)
+	// fp3 is not as bad as fp2, it is not added.
+	bl.Record("fp3", "q3", 15*time.Second, 0)
+
+	var got []string
+	for _, entry := range bl.Entries() {
+		got = append(got, entry.Fingerprint)
+	}
+	if want := "fp2,manual"; strings.Join(got, ",") != want {
+		t.Errorf("Entries: %v, want %v", got, want)
+	}
+	if err := bl.Check("manual"); err == nil || !strings.Contains(err.Error(), "too slow") {
+		t.Errorf("Check(manual): %v", err)
+	}
+}
+
+func TestBlocklistHTTP(t *testing.T) {
+	bl, _ := newTestBlocklist(false)
+	fp := Fingerprint("select * from t1")
+	bl.Record(fp, "select * from t1", 11*time.Second, 0)
+
+	serve := func(url string) (int, []Entry) {
+		t.Helper()
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rr := httptest.NewRecorder()
+		bl.ServeHTTP(rr, req)
+		if rr.Code != http.StatusOK {
+			return rr.Code, nil
+		}
+		var entries []Entry
+		if err := json.Unmarshal(rr.Body.Bytes(), &entries); err != nil {
+			t.Fatalf("cannot parse %v: %v", rr.Body.String(), err)
+		}
+		return rr.Code, entries
+	}
+
+	_, entries := serve("/debug/query_blocklist")
+	if len(entries) != 1 || entries[0].Fingerprint != fp || entries[0].State != StatePending {
+		t.Errorf("list: %+v", entries)
+	}
+	_, entries = serve("/debug/query_blocklist?action=approve&fingerprint=" + fp)
+	if len(entries) != 1 || entries[0].State != StateBlocked {
+		t.Errorf("approve: %+v", entries)
+	}
+	if code, _ := serve("/debug/query_blocklist?action=unknown&fingerprint=" + fp); code != http.StatusBadRequest {
+		t.Errorf("unknown action: %v, want %v", code, http.StatusBadRequest)
+	}
+	_, entries = serve("/debug/query_blocklist?action=remove&fingerprint=" + fp)
+	if len(entries) != 0 {
+		t.Errorf("remove: %+v", entries)
+	}
+}
+
+func TestBlocklistApply(t *testing.T) {
+	bl, _ := newTestBlocklist(false)
+	fp := Fingerprint("select * from t1")
+
+	if err := bl.Apply(ActionApprove, fp, ""); err == nil {
+		t.Errorf("Apply(approve) of an unknown fingerprint: nil error")
+	}
+	if err := bl.Apply(ActionBlock, "", ""); err == nil {
+		t.Errorf("Apply(block) without fingerprint: nil error")
+	}
+	if err := bl.Apply("unknown", fp, ""); err == nil {
+		t.Errorf("Apply(unknown): nil error")
+	}
+	if err := bl.Apply(ActionBlock, fp, ""); err != nil {
+		t.Fatal(err)
+	}
+	entries := bl.Entries()
+	if len(entries) != 1 || entries[0].State != StateBlocked || !entries[0].Manual || entries[0].Reason != "blocked by an operator" {
+		t.Errorf("block: %+v", entries)
+	}
+	if err := bl.Apply(ActionRemove, fp, ""); err != nil {
+		t.Fatal(err)
+	}
+	if entries := bl.Entries(); len(entries) != 0 {
+		t.Errorf("remove: %+v", entries)
+	}
+}
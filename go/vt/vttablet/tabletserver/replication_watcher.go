@@ -48,6 +48,7 @@ type ReplicationWatcher struct {
 
 	watchReplication bool
 	se               *schema.Engine
+	dmlCounts        *tableDMLCounts
 
 	mu         sync.Mutex
 	eventToken *querypb.EventToken
@@ -56,10 +57,12 @@ type ReplicationWatcher struct {
 var replOnce sync.Once
 
 // NewReplicationWatcher creates a new ReplicationWatcher.
-func NewReplicationWatcher(se *schema.Engine, config tabletenv.TabletConfig) *ReplicationWatcher {
+// The DMLs of the replication stream are added to dmlCounts.
+func NewReplicationWatcher(se *schema.Engine, dmlCounts *tableDMLCounts, config tabletenv.TabletConfig) *ReplicationWatcher {
 	rpw := &ReplicationWatcher{
 		watchReplication: config.WatchReplication,
 		se:               se,
+		dmlCounts:        dmlCounts,
 	}
 	replOnce.Do(func() {
 		stats.Publish("EventTokenPosition", stats.StringFunc(func() string {
@@ -122,6 +125,8 @@ func (rpw *ReplicationWatcher) Process(ctx context.Context, dbconfigs *dbconfigs
 			rpw.eventToken = eventToken
 			rpw.mu.Unlock()
 
+			rpw.dmlCounts.AddStatements(statements)
+
 			// If it's a DDL, trigger a schema reload.
 			for _, statement := range statements {
 				if statement.Statement.Category != binlogdatapb.BinlogTransaction_Statement_BL_DDL {
@@ -0,0 +1,175 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Hints are MySQL optimizer hints that a Rule adds to the select
+// queries it matches. They allow fixing the plan of a query in
+// production without changing the application. For example:
+//
+//	{
+//	  "Name": "fix_orders_by_date",
+//	  "Query": "select .* from orders where created > :created.*",
+//	  "Hints": {
+//	    "UseIndex": ["created_idx"],
+//	    "MaxExecutionTime": 1000,
+//	    "OptimizerSwitch": "index_merge=off"
+//	  }
+//	}
+//
+// A Rule with hints and no Action doesn't fail the queries.
+type Hints struct {
+	// UseIndex are the indexes of the USE INDEX hint, which is
+	// added to the table of the query.
+	UseIndex []string `json:",omitempty"`
+	// MaxExecutionTime is the value of the MAX_EXECUTION_TIME hint,
+	// in milliseconds.
+	MaxExecutionTime int64 `json:",omitempty"`
+	// OptimizerSwitch is the value of optimizer_switch for the query,
+	// which is set with the SET_VAR hint.
+	OptimizerSwitch string `json:",omitempty"`
+}
+
+var optimizerSwitchRE = regexp.MustCompile(`^[a-z_]+=(on|off|default)(,[a-z_]+=(on|off|default))*$`)
+
+// Equal returns true if other is equal to these hints.
+func (h *Hints) Equal(other *Hints) bool {
+	return reflect.DeepEqual(h, other)
+}
+
+// OptimizerHints returns the optimizer hints comment, like
+// '/*+ MAX_EXECUTION_TIME(1000) */', or "" if there are no
+// optimizer hints.
+func (h *Hints) OptimizerHints() string {
+	var hints []string
+	if h.MaxExecutionTime > 0 {
+		hints = append(hints, fmt.Sprintf("MAX_EXECUTION_TIME(%d)", h.MaxExecutionTime))
+	}
+	if h.OptimizerSwitch != "" {
+		hints = append(hints, fmt.Sprintf("SET_VAR(optimizer_switch='%s')", h.OptimizerSwitch))
+	}
+	if len(hints) == 0 {
+		return ""
+	}
+	return "/*+ " + strings.Join(hints, " ") + " */"
+}
+
+// SetHints sets the optimizer hints of the Rule.
+func (qr *Rule) SetHints(hints *Hints) error {
+	if err := validateHints(hints); err != nil {
+		return err
+	}
+	qr.hints = hints
+	return nil
+}
+
+func validateHints(hints *Hints) error {
+	if hints == nil {
+		return nil
+	}
+	for _, index := range hints.UseIndex {
+		if index == "" {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "empty index name in UseIndex")
+		}
+	}
+	if hints.MaxExecutionTime < 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid MaxExecutionTime %d", hints.MaxExecutionTime)
+	}
+	if hints.OptimizerSwitch != "" && !optimizerSwitchRE.MatchString(hints.OptimizerSwitch) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid OptimizerSwitch %s", hints.OptimizerSwitch)
+	}
+	if len(hints.UseIndex) == 0 && hints.MaxExecutionTime == 0 && hints.OptimizerSwitch == "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "empty Hints")
+	}
+	return nil
+}
+
+// GetHints returns the hints of the first rule with hints that
+// matches the input, or nil.
+func (qrs *Rules) GetHints(ip, user string, bindVars map[string]*querypb.BindVariable) *Hints {
+	for _, qr := range qrs.rules {
+		if qr.hints != nil && qr.matches(ip, user, bindVars) {
+			return qr.hints
+		}
+	}
+	return nil
+}
+
+// AllHints returns the hints of all the rules.
+func (qrs *Rules) AllHints() []*Hints {
+	var hints []*Hints
+	for _, qr := range qrs.rules {
+		if qr.hints != nil {
+			hints = append(hints, qr.hints)
+		}
+	}
+	return hints
+}
+
+func buildHints(hintsInfo map[string]interface{}) (*Hints, error) {
+	hints := &Hints{}
+	for k, v := range hintsInfo {
+		switch k {
+		case "UseIndex":
+			lv, ok := v.([]interface{})
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want list for UseIndex")
+			}
+			for _, index := range lv {
+				sv, ok := index.(string)
+				if !ok {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for UseIndex")
+				}
+				hints.UseIndex = append(hints.UseIndex, sv)
+			}
+		case "MaxExecutionTime":
+			nv, ok := v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for MaxExecutionTime")
+			}
+			iv, err := nv.Int64()
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int64 for MaxExecutionTime: %s", string(nv))
+			}
+			hints.MaxExecutionTime = iv
+		case "OptimizerSwitch":
+			sv, ok := v.(string)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for OptimizerSwitch")
+			}
+			hints.OptimizerSwitch = sv
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized hint %s", k)
+		}
+	}
+	if err := validateHints(hints); err != nil {
+		return nil, err
+	}
+	return hints, nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rules
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+)
+
+func TestHintsJSON(t *testing.T) {
+	qrs := New()
+	err := qrs.UnmarshalJSON([]byte(`[{
+		"Name": "r1",
+		"Query": "select .* from t1 .*",
+		"Hints": {"UseIndex": ["idx_a"], "MaxExecutionTime": 1000, "OptimizerSwitch": "index_merge=off"}
+	}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Hints{
+		UseIndex:         []string{"idx_a"},
+		MaxExecutionTime: 1000,
+		OptimizerSwitch:  "index_merge=off",
+	}
+	qr := qrs.Find("r1")
+	if !reflect.DeepEqual(qr.hints, want) {
+		t.Errorf("hints: %+v, want %+v", qr.hints, want)
+	}
+	// A rule with only hints doesn't fail the queries.
+	if qr.act != QRContinue {
+		t.Errorf("action: %v, want QRContinue", qr.act)
+	}
+
+	// The hints survive a round trip.
+	data, err := json.Marshal(qrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	qrs2 := New()
+	if err := qrs2.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !qrs.Equal(qrs2) {
+		t.Errorf("round trip: %s, want %s", data, mustMarshal(t, qrs))
+	}
+
+	// The hints are kept by FilterByPlan.
+	filtered := qrs.FilterByPlan("select a from t1 where b = 1", planbuilder.PlanPassSelect, "t1")
+	if got := filtered.AllHints(); len(got) != 1 || got[0] != qr.hints {
+		t.Errorf("AllHints: %v, want %v", got, qr.hints)
+	}
+}
+
+func mustMarshal(t *testing.T, v interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestHintsInvalidJSON(t *testing.T) {
+	testcases := []struct {
+		input, err string
+	}{
+		{`[{"Hints": 1}]`, "want object for Hints"},
+		{`[{"Hints": {}}]`, "empty Hints"},
+		{`[{"Hints": {"Foo": 1}}]`, "unrecognized hint Foo"},
+		{`[{"Hints": {"UseIndex": "a"}}]`, "want list for UseIndex"},
+		{`[{"Hints": {"UseIndex": [1]}}]`, "want string for UseIndex"},
+		{`[{"Hints": {"UseIndex": [""]}}]`, "empty index name in UseIndex"},
+		{`[{"Hints": {"MaxExecutionTime": "1"}}]`, "want number for MaxExecutionTime"},
+		{`[{"Hints": {"MaxExecutionTime": 1.5}}]`, "want int64 for MaxExecutionTime: 1.5"},
+		{`[{"Hints": {"MaxExecutionTime": -1}}]`, "invalid MaxExecutionTime -1"},
+		{`[{"Hints": {"OptimizerSwitch": 1}}]`, "want string for OptimizerSwitch"},
+		{`[{"Hints": {"OptimizerSwitch": "a=on') */ drop table t /*"}}]`, "invalid OptimizerSwitch a=on') */ drop table t /*"},
+	}
+	for _, tcase := range testcases {
+		err := New().UnmarshalJSON([]byte(tcase.input))
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("UnmarshalJSON(%s): %v, want %s", tcase.input, err, tcase.err)
+		}
+	}
+}
+
+func TestGetHints(t *testing.T) {
+	hints1 := &Hints{MaxExecutionTime: 1000}
+	hints2 := &Hints{UseIndex: []string{"idx_a"}}
+
+	qr1 := NewQueryRule("hints for u1", "r1", QRContinue)
+	if err := qr1.SetUserCond("u1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := qr1.SetHints(hints1); err != nil {
+		t.Fatal(err)
+	}
+	qr2 := NewQueryRule("hints for all", "r2", QRContinue)
+	if err := qr2.SetHints(hints2); err != nil {
+		t.Fatal(err)
+	}
+	qrs := New()
+	qrs.Add(NewQueryRule("no hints", "r0", QRContinue))
+	qrs.Add(qr1)
+	qrs.Add(qr2)
+
+	if got := qrs.GetHints("", "u1", nil); got != hints1 {
+		t.Errorf("GetHints(u1): %+v, want %+v", got, hints1)
+	}
+	if got := qrs.GetHints("", "u2", nil); got != hints2 {
+		t.Errorf("GetHints(u2): %+v, want %+v", got, hints2)
+	}
+	if action, _ := qrs.GetAction("", "u1", nil); action != QRContinue {
+		t.Errorf("GetAction: %v, want QRContinue", action)
+	}
+
+	if got, want := hints1.OptimizerHints(), "/*+ MAX_EXECUTION_TIME(1000) */"; got != want {
+		t.Errorf("OptimizerHints: %s, want %s", got, want)
+	}
+	if got := hints2.OptimizerHints(); got != "" {
+		t.Errorf("OptimizerHints: %s, want empty", got)
+	}
+	if err := qr2.SetHints(&Hints{OptimizerSwitch: "a"}); err == nil {
+		t.Errorf("SetHints: nil, want invalid OptimizerSwitch")
+	}
+}
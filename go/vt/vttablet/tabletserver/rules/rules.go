@@ -193,6 +193,9 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// Optimizer hints added to the matched queries
+	hints *Hints
 }
 
 type namedRegexp struct {
@@ -232,7 +235,8 @@ func (qr *Rule) Equal(other *Rule) bool {
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.hints.Equal(other.hints))
 }
 
 // Copy performs a deep copy of a Rule.
@@ -244,6 +248,7 @@ func (qr *Rule) Copy() (newqr *Rule) {
 		user:        qr.user,
 		query:       qr.query,
 		act:         qr.act,
+		hints:       qr.hints,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -286,6 +291,9 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.hints != nil {
+		safeEncode(b, `,"Hints":`, qr.hints)
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
@@ -413,18 +421,26 @@ func (qr *Rule) FilterByPlan(query string, planid planbuilder.PlanType, tableNam
 
 // GetAction returns the action for a single rule.
 func (qr *Rule) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) Action {
-	if !reMatch(qr.requestIP.Regexp, ip) {
+	if !qr.matches(ip, user, bindVars) {
 		return QRContinue
 	}
+	return qr.act
+}
+
+// matches returns true if the runtime conditions of the rule match.
+func (qr *Rule) matches(ip, user string, bindVars map[string]*querypb.BindVariable) bool {
+	if !reMatch(qr.requestIP.Regexp, ip) {
+		return false
+	}
 	if !reMatch(qr.user.Regexp, user) {
-		return QRContinue
+		return false
 	}
 	for _, bvcond := range qr.bindVarConds {
 		if !bvMatch(bvcond, bindVars) {
-			return QRContinue
+			return false
 		}
 	}
-	return qr.act
+	return true
 }
 
 func reMatch(re *regexp.Regexp, val string) bool {
@@ -777,6 +793,7 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	for k, v := range ruleInfo {
 		var sv string
 		var lv []interface{}
+		var mv map[string]interface{}
 		var ok bool
 		switch k {
 		case "Name", "Description", "RequestIP", "User", "Query", "Action":
@@ -789,6 +806,11 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want list for %s", k)
 			}
+		case "Hints":
+			mv, ok = v.(map[string]interface{})
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want object for %s", k)
+			}
 		default:
 			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized tag %s", k)
 		}
@@ -852,8 +874,17 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+		case "Hints":
+			qr.hints, err = buildHints(mv)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
+	// A rule that only has hints doesn't fail the queries.
+	if _, ok := ruleInfo["Action"]; !ok && qr.hints != nil {
+		qr.act = QRContinue
+	}
 	return qr, nil
 }
 
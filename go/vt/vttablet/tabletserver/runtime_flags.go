@@ -0,0 +1,81 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/vt/servenv"
+)
+
+// registerRuntimeFlags allows the flags of the pools, of the timeouts,
+// of the limits and of the transaction throttler to be changed without
+// restarting the tablet server. The new values are applied through the
+// setters of the tablet server; tabletenv.Config keeps the values the
+// tablet server started with.
+func (tsv *TabletServer) registerRuntimeFlags() {
+	positive := func(name string, apply func(int)) func(string) error {
+		return servenv.IntRuntimeFlag(func(val int) error {
+			if val <= 0 {
+				return fmt.Errorf("%v must be positive", name)
+			}
+			apply(val)
+			return nil
+		})
+	}
+	anyInt := func(apply func(int)) func(string) error {
+		return servenv.IntRuntimeFlag(func(val int) error {
+			apply(val)
+			return nil
+		})
+	}
+	seconds := func(apply func(time.Duration)) func(string) error {
+		return servenv.FloatRuntimeFlag(func(val float64) error {
+			apply(time.Duration(val * 1e9))
+			return nil
+		})
+	}
+	waiterCap := func(apply func(int64)) func(string) error {
+		return anyInt(func(val int) { apply(int64(val)) })
+	}
+	for name, set := range map[string]func(string) error{
+		"queryserver-config-pool-size":                          positive("pool size", tsv.SetPoolSize),
+		"queryserver-config-stream-pool-size":                   positive("stream pool size", tsv.SetStreamPoolSize),
+		"queryserver-config-transaction-cap":                    positive("transaction cap", tsv.SetTxPoolSize),
+		"queryserver-config-transaction-timeout":                seconds(tsv.SetTxTimeout),
+		"queryserver-config-txpool-timeout":                     seconds(tsv.SetTxPoolTimeout),
+		"queryserver-config-query-timeout":                      seconds(tsv.QueryTimeout.Set),
+		"queryserver-config-query-pool-timeout":                 seconds(tsv.SetQueryPoolTimeout),
+		"queryserver-config-query-pool-waiter-cap":              waiterCap(tsv.SetQueryPoolWaiterCap),
+		"queryserver-config-query-pool-low-priority-waiter-cap": waiterCap(tsv.SetQueryPoolLowPriorityWaiterCap),
+		"queryserver-config-txpool-waiter-cap":                  waiterCap(tsv.SetTxPoolWaiterCap),
+		"queryserver-config-max-result-size":                    positive("max result size", tsv.SetMaxResultSize),
+		"queryserver-config-warn-result-size":                   anyInt(tsv.SetWarnResultSize),
+		"queryserver-config-max-dml-rows":                       anyInt(tsv.SetMaxDMLRows),
+		"queryserver-config-query-cache-size":                   positive("query cache size", tsv.SetQueryPlanCacheCap),
+		"tx-throttler-config":                                   tsv.txThrottler.UpdateConfiguration,
+	} {
+		tsv.runtimeFlags.Register(name, set)
+	}
+}
+
+// RuntimeFlags returns the flags of the tablet server that can be
+// changed at runtime.
+func (tsv *TabletServer) RuntimeFlags() *servenv.RuntimeFlagSet {
+	return tsv.runtimeFlags
+}
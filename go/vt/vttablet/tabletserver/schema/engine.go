@@ -41,7 +41,10 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
-const maxTableCount = 10000
+const (
+	maxTableCount     = 10000
+	maxPartitionCount = 100000
+)
 
 type notifier func(full map[string]*Table, created, altered, dropped []string)
 
@@ -58,6 +61,10 @@ type Engine struct {
 	reloadTime time.Duration
 	notifiers  map[string]notifier
 
+	// partitionPruning is set if the partitions of the tables are
+	// loaded, so the queries can prune them.
+	partitionPruning bool
+
 	// The following fields have their own synchronization
 	// and do not require locking mu.
 	conns *connpool.Pool
@@ -71,9 +78,10 @@ func NewEngine(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *En
 	reloadTime := time.Duration(config.SchemaReloadTime * 1e9)
 	idleTimeout := time.Duration(config.IdleTimeout * 1e9)
 	se := &Engine{
-		conns:      connpool.New("", 3, 0, idleTimeout, checker),
-		ticks:      timer.NewTimer(reloadTime),
-		reloadTime: reloadTime,
+		conns:            connpool.New("", 3, 0, idleTimeout, checker),
+		ticks:            timer.NewTimer(reloadTime),
+		reloadTime:       reloadTime,
+		partitionPruning: config.EnablePartitionPruning,
 	}
 	schemaOnce.Do(func() {
 		_ = stats.NewGaugeDurationFunc("SchemaReloadTime", "vttablet keeps table schemas in its own memory and periodically refreshes it from MySQL. This config controls the reload time.", se.ticks.Interval)
@@ -178,6 +186,8 @@ func (se *Engine) Open() error {
 	if len(tableData.Rows) != 0 && len(tables) == 1 { // len(tables) is always at least 1 because of the "dual" table
 		return vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "could not get schema for any tables")
 	}
+	se.loadPartitions(ctx, conn, mysql.BaseShowPartitions, tables)
+	se.loadViews(ctx, conn, mysql.BaseShowViews, tables)
 	se.tables = tables
 	se.lastChange = curTime
 
@@ -237,6 +247,7 @@ func (se *Engine) Reload(ctx context.Context) error {
 	}
 	defer tabletenv.LogError()
 
+	var definitions map[string]string
 	curTime, tableData, err := func() (int64, *sqltypes.Result, error) {
 		conn, err := se.conns.Get(ctx)
 		if err != nil {
@@ -251,6 +262,9 @@ func (se *Engine) Reload(ctx context.Context) error {
 		if err != nil {
 			return 0, nil, err
 		}
+		// The views have no creation time: they are reloaded when
+		// their definition changes.
+		definitions = se.viewDefinitions(ctx, conn)
 		return curTime, tableData, nil
 	}()
 	if err != nil {
@@ -268,7 +282,7 @@ func (se *Engine) Reload(ctx context.Context) error {
 		curTables[tableName] = true
 		createTime, _ := sqltypes.ToInt64(row[2])
 		// Check if we know about the table or it has been recreated.
-		if _, ok := se.tables[tableName]; !ok || createTime >= se.lastChange {
+		if _, ok := se.tables[tableName]; !ok || createTime >= se.lastChange || se.viewChanged(tableName, definitions) {
 			log.Infof("Reloading schema for table: %s", tableName)
 			wasCreated, err := se.tableWasCreatedOrAltered(ctx, tableName)
 			rec.RecordError(err)
@@ -306,6 +320,28 @@ func (se *Engine) Reload(ctx context.Context) error {
 	return rec.Error()
 }
 
+// ReloadTable reloads the schema info of a table, even if its creation
+// time didn't change. It's used after the DDLs that may change the
+// partitions of a table, which don't always change its creation time.
+// This is a no-op if the Engine is closed.
+func (se *Engine) ReloadTable(ctx context.Context, tableName string) error {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	if !se.isOpen {
+		return nil
+	}
+	wasCreated, err := se.tableWasCreatedOrAltered(ctx, tableName)
+	if err != nil {
+		return err
+	}
+	if wasCreated {
+		se.broadcast([]string{tableName}, nil, nil)
+	} else {
+		se.broadcast(nil, []string{tableName}, nil)
+	}
+	return nil
+}
+
 // LoadTableBasic loads a table with minimal info. This is used by vstreamer
 // to load _vt.resharding_journal.
 func (se *Engine) LoadTableBasic(ctx context.Context, tableName string) (*Table, error) {
@@ -367,6 +403,8 @@ func (se *Engine) tableWasCreatedOrAltered(ctx context.Context, tableName string
 
 	// table_rows, data_length, index_length, max_data_length
 	table.SetMysqlStats(row[4], row[5], row[6], row[7], row[8])
+	se.loadPartitions(ctx, conn, mysql.BaseShowPartitionsForTable(tableName), map[string]*Table{tableName: table})
+	se.loadViews(ctx, conn, mysql.BaseShowViewsForTable(tableName), map[string]*Table{tableName: table})
 
 	wasCreated := true
 	if _, ok := se.tables[tableName]; ok {
@@ -381,6 +419,77 @@ func (se *Engine) tableWasCreatedOrAltered(ctx context.Context, tableName string
 	return wasCreated, nil
 }
 
+// loadPartitions sets the partition info of the tables, which must not
+// be visible to the other goroutines yet. The partition info is only used
+// to prune the partitions read by the queries, so a failure is not fatal:
+// the tables are then left without partition info.
+func (se *Engine) loadPartitions(ctx context.Context, conn *connpool.DBConn, query string, tables map[string]*Table) {
+	if !se.partitionPruning {
+		return
+	}
+	qr, err := conn.Exec(ctx, query, maxPartitionCount, false)
+	if err != nil {
+		log.Warningf("Could not load the partitions of the tables: %v", err)
+		return
+	}
+	infos, err := buildPartitionInfos(qr.Rows)
+	if err != nil {
+		log.Warningf("Could not load the partitions of the tables: %v", err)
+		return
+	}
+	for name, info := range infos {
+		if table, ok := tables[name]; ok {
+			table.PartitionInfo = info
+		}
+	}
+}
+
+// loadViews sets the view info of the tables, which must not be visible
+// to the other goroutines yet. The view info is only used to split the
+// queries on views, so a failure is not fatal.
+func (se *Engine) loadViews(ctx context.Context, conn *connpool.DBConn, query string, tables map[string]*Table) {
+	qr, err := conn.Exec(ctx, query, maxTableCount, false)
+	if err != nil {
+		log.Warningf("Could not load the definitions of the views: %v", err)
+		return
+	}
+	for _, row := range qr.Rows {
+		if table, ok := tables[row[0].ToString()]; ok {
+			table.ViewInfo = &ViewInfo{Definition: row[1].ToString(), Database: row[2].ToString()}
+		}
+	}
+}
+
+// viewDefinitions returns the definitions of the views, by name. The
+// view info is only used to split the queries on views, so a failure
+// is not fatal.
+func (se *Engine) viewDefinitions(ctx context.Context, conn *connpool.DBConn) map[string]string {
+	qr, err := conn.Exec(ctx, mysql.BaseShowViews, maxTableCount, false)
+	if err != nil {
+		log.Warningf("Could not load the definitions of the views: %v", err)
+		return nil
+	}
+	definitions := make(map[string]string, len(qr.Rows))
+	for _, row := range qr.Rows {
+		definitions[row[0].ToString()] = row[1].ToString()
+	}
+	return definitions
+}
+
+// viewChanged returns true if tableName is a known view whose
+// definition is not the one in definitions.
+func (se *Engine) viewChanged(tableName string, definitions map[string]string) bool {
+	definition, ok := definitions[tableName]
+	if !ok {
+		return false
+	}
+	table, ok := se.tables[tableName]
+	if !ok {
+		return false
+	}
+	return table.ViewInfo == nil || table.ViewInfo.Definition != definition
+}
+
 // registerTopics optionally connects the vt_topic metadata on a message table
 // to a map of topic strings. A table can belong to only one topic.
 func (se *Engine) registerTopics() {
@@ -276,6 +276,150 @@ func TestCreateOrUpdateTable(t *testing.T) {
 	}
 }
 
+func TestOpenWithPartitions(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQuery(mysql.BaseShowPartitions, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("table_name|partition_name|partition_ordinal_position|partition_method|partition_expression|partition_description", "varchar|varchar|int64|varchar|varchar|varchar"),
+		Rows:   partitionRows("test_table_01", "RANGE", "`pk`", "100", "MAXVALUE"),
+	})
+	se := newEngine(10, 1*time.Second, 1*time.Second, false, db)
+	se.partitionPruning = true
+	if err := se.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer se.Close()
+
+	info := se.GetTable(sqlparser.NewTableIdent("test_table_01")).PartitionInfo
+	if !info.CanPrune() || len(info.Partitions) != 2 {
+		t.Fatalf("test_table_01 partitions: %+v, want 2 prunable partitions", info)
+	}
+	if got := se.GetTable(sqlparser.NewTableIdent("test_table_02")).PartitionInfo; got != nil {
+		t.Errorf("test_table_02 partitions: %+v, want nil", got)
+	}
+
+	// ReloadTable reloads the partitions, even if the creation time of
+	// the table didn't change.
+	db.AddQuery(mysql.BaseShowTablesForTable("test_table_01"), &sqltypes.Result{
+		Fields:       mysql.BaseShowTablesFields,
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			mysql.BaseShowTablesRow("test_table_01", false, ""),
+		},
+	})
+	db.AddQuery(mysql.BaseShowPartitionsForTable("test_table_01"), &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("table_name|partition_name|partition_ordinal_position|partition_method|partition_expression|partition_description", "varchar|varchar|int64|varchar|varchar|varchar"),
+		Rows:   partitionRows("test_table_01", "RANGE", "`pk`", "100", "200", "MAXVALUE"),
+	})
+	if err := se.ReloadTable(context.Background(), "test_table_01"); err != nil {
+		t.Fatal(err)
+	}
+	if info := se.GetTable(sqlparser.NewTableIdent("test_table_01")).PartitionInfo; len(info.Partitions) != 3 {
+		t.Errorf("test_table_01 partitions after ReloadTable: %+v, want 3", info)
+	}
+}
+
+func TestOpenWithoutPartitionPruning(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	db.AddQuery(mysql.BaseShowPartitions, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("table_name|partition_name|partition_ordinal_position|partition_method|partition_expression|partition_description", "varchar|varchar|int64|varchar|varchar|varchar"),
+		Rows:   partitionRows("test_table_01", "RANGE", "`pk`", "100", "MAXVALUE"),
+	})
+	se := newEngine(10, 1*time.Second, 1*time.Second, false, db)
+	if err := se.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer se.Close()
+
+	if info := se.GetTable(sqlparser.NewTableIdent("test_table_01")).PartitionInfo; info.CanPrune() {
+		t.Errorf("test_table_01 partitions: %+v, want none without partition pruning", info)
+	}
+}
+
+func TestOpenWithViews(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	definition := "select `vt_test`.`test_table_01`.`pk` AS `pk` from `vt_test`.`test_table_01`"
+	db.AddQuery(mysql.BaseShowViews, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields("table_name|view_definition|table_schema", "varchar|varchar|varchar"),
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarChar("test_table_03"),
+			sqltypes.NewVarChar(definition),
+			sqltypes.NewVarChar("vt_test"),
+		}},
+	})
+	se := newEngine(10, 1*time.Second, 1*time.Second, false, db)
+	if err := se.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer se.Close()
+
+	info := se.GetTable(sqlparser.NewTableIdent("test_table_03")).ViewInfo
+	if info == nil || info.Definition != definition || info.Database != "vt_test" {
+		t.Errorf("test_table_03 view: %+v, want %v in vt_test", info, definition)
+	}
+	if got := se.GetTable(sqlparser.NewTableIdent("test_table_01")).ViewInfo; got != nil {
+		t.Errorf("test_table_01 view: %+v, want nil", got)
+	}
+}
+
+func TestReloadView(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	viewResult := func(definition string) *sqltypes.Result {
+		return &sqltypes.Result{
+			Fields: sqltypes.MakeTestFields("table_name|view_definition|table_schema", "varchar|varchar|varchar"),
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewVarChar("test_table_03"),
+				sqltypes.NewVarChar(definition),
+				sqltypes.NewVarChar("vt_test"),
+			}},
+		}
+	}
+	db.AddQuery(mysql.BaseShowViews, viewResult("select pk from test_table_01"))
+	se := newEngine(10, 1*time.Second, 1*time.Second, false, db)
+	if err := se.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer se.Close()
+
+	// A view has no creation time, it's reloaded because its
+	// definition changed.
+	viewRow := mysql.BaseShowTablesRow("test_table_03", true, "")
+	viewRow[2] = sqltypes.NULL
+	db.AddQuery(mysql.BaseShowTables, &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows:   [][]sqltypes.Value{viewRow},
+	})
+	db.AddQuery(mysql.BaseShowTablesForTable("test_table_03"), &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+		Rows:   [][]sqltypes.Value{viewRow},
+	})
+	definition := "select pk, name from test_table_01"
+	db.AddQuery(mysql.BaseShowViews, viewResult(definition))
+	db.AddQuery(mysql.BaseShowViewsForTable("test_table_03"), viewResult(definition))
+	if err := se.Reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	info := se.GetTable(sqlparser.NewTableIdent("test_table_03")).ViewInfo
+	if info == nil || info.Definition != definition {
+		t.Errorf("test_table_03 view: %+v, want %v", info, definition)
+	}
+}
+
 func TestExportVars(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
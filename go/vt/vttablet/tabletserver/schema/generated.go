@@ -0,0 +1,79 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// resolveExpressions names the functional key parts of the indexes
+// after the generated columns that compute the same expression. Such a
+// column can then be used like any other indexed column.
+func (ta *Table) resolveExpressions() {
+	for _, idx := range ta.Indexes {
+		for i, expr := range idx.Expressions {
+			if expr == "" {
+				continue
+			}
+			if col := ta.findGenerated(expr); col != nil {
+				idx.Columns[i] = col.Name
+			}
+		}
+	}
+}
+
+// findGenerated returns the generated column computed by expr, or nil.
+func (ta *Table) findGenerated(expr string) *TableColumn {
+	want := normalizeExpression(expr)
+	for i := range ta.Columns {
+		col := &ta.Columns[i]
+		if col.Generated == NotGenerated || col.Expression == "" {
+			continue
+		}
+		if normalizeExpression(col.Expression) == want {
+			return col
+		}
+	}
+	return nil
+}
+
+// normalizeExpression formats expr the way sqlparser does, so that
+// differences in quoting, spacing and parentheses don't matter.
+// Expressions that don't parse are returned unchanged.
+func normalizeExpression(expr string) string {
+	stmt, err := sqlparser.Parse("select " + expr)
+	if err != nil {
+		return expr
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.SelectExprs) != 1 {
+		return expr
+	}
+	aliased, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return expr
+	}
+	e := aliased.Expr
+	for {
+		paren, ok := e.(*sqlparser.ParenExpr)
+		if !ok {
+			break
+		}
+		e = paren.Expr
+	}
+	return sqlparser.String(e)
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -103,14 +104,40 @@ func fetchColumns(ta *Table, conn *connpool.DBConn, sqlTableName string) error {
 		}
 		ta.AddColumn(name, columnType, row[4], row[5].ToString())
 	}
+	if !ta.HasGenerated() {
+		return nil
+	}
+	return fetchGenerationExpressions(ta, conn)
+}
+
+func fetchGenerationExpressions(ta *Table, conn *connpool.DBConn) error {
+	exprs, err := conn.Exec(tabletenv.LocalContext(), mysql.BaseShowGeneratedColumnsForTable(ta.Name.String()), 10000, false)
+	if err != nil {
+		return err
+	}
+	for _, row := range exprs.Rows {
+		i := ta.FindColumn(sqlparser.NewColIdent(row[0].ToString()))
+		if i == -1 {
+			continue
+		}
+		ta.Columns[i].Expression = row[1].ToString()
+	}
 	return nil
 }
 
 func fetchIndexes(ta *Table, conn *connpool.DBConn, sqlTableName string) error {
-	indexes, err := conn.Exec(tabletenv.LocalContext(), fmt.Sprintf("show index from %s", sqlTableName), 10000, false)
+	indexes, err := conn.Exec(tabletenv.LocalContext(), fmt.Sprintf("show index from %s", sqlTableName), 10000, true)
 	if err != nil {
 		return err
 	}
+	// Functional key parts have no column but an expression,
+	// which MySQL only reports since 8.0.13.
+	exprCol := -1
+	for i, field := range indexes.Fields {
+		if field.Name == "Expression" {
+			exprCol = i
+		}
+	}
 	var currentIndex *Index
 	currentName := ""
 	for _, row := range indexes.Rows {
@@ -126,6 +153,10 @@ func fetchIndexes(ta *Table, conn *connpool.DBConn, sqlTableName string) error {
 				log.Warningf("%s", err)
 			}
 		}
+		if row[4].IsNull() && exprCol != -1 && !row[exprCol].IsNull() {
+			currentIndex.AddExpression(row[exprCol].ToString(), cardinality)
+			continue
+		}
 		currentIndex.AddColumn(row[4].ToString(), cardinality)
 	}
 	ta.Done()
@@ -167,6 +198,7 @@ func loadMessageInfo(ta *Table, comment string) error {
 	}
 	var err error
 	ta.MessageInfo.Topic = getTopic(keyvals)
+	ta.MessageInfo.WatchTable = keyvals["vt_watch_table"]
 
 	if ta.MessageInfo.AckWaitDuration, err = getDuration(keyvals, "vt_ack_wait"); err != nil {
 		return err
@@ -198,6 +230,11 @@ func loadMessageInfo(ta *Table, comment string) error {
 		}
 	}
 
+	// Change notifications are stored in the message column.
+	if ta.MessageInfo.WatchTable != "" && ta.FindColumn(sqlparser.NewColIdent("message")) == -1 {
+		return fmt.Errorf("message column missing from message table %s, required by vt_watch_table", ta.Name.String())
+	}
+
 	// Store the position of the id column in the PK
 	// list. This is required to handle arbitrary updates.
 	// In such cases, we have to be able to identify the
@@ -277,6 +277,40 @@ func TestLoadTableMessageTopic(t *testing.T) {
 	}
 }
 
+func TestLoadTableMessageWatchTable(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range getMessageTableQueries() {
+		db.AddQuery(query, result)
+	}
+	table, err := newTestLoadTable("USER_TABLE", "vitess_message,vt_watch_table=orders,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := table.MessageInfo.WatchTable, "orders"; got != want {
+		t.Errorf("WatchTable: %s, want %s", got, want)
+	}
+
+	// The message column is required to store the changes.
+	queries := getMessageTableQueries()
+	fields := queries["select * from test_table where 1 != 1"].Fields
+	for i, field := range fields {
+		if field.Name == "message" {
+			fields = append(fields[:i], fields[i+1:]...)
+			break
+		}
+	}
+	queries["select * from test_table where 1 != 1"].Fields = fields
+	for query, result := range queries {
+		db.AddQuery(query, result)
+	}
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_watch_table=orders,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30", db)
+	wanterr := "message column missing from message table test_table, required by vt_watch_table"
+	if err == nil || err.Error() != wanterr {
+		t.Errorf("newTestLoadTable: %v, want %s", err, wanterr)
+	}
+}
+
 func TestLoadTableWithBitColumn(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -293,6 +327,41 @@ func TestLoadTableWithBitColumn(t *testing.T) {
 	}
 }
 
+func TestLoadTableWithGeneratedColumns(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range getTestLoadTableWithGeneratedColumnsQueries() {
+		db.AddQuery(query, result)
+	}
+	table, err := newTestLoadTable("USER_TABLE", "test table", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := table.Columns[2].Generated, VirtualGenerated; got != want {
+		t.Errorf("Generated: %v, want %v", got, want)
+	}
+	if got, want := table.Columns[2].Expression, "(`a` + `b`)"; got != want {
+		t.Errorf("Expression: %s, want %s", got, want)
+	}
+	if got, want := table.Columns[3].Generated, StoredGenerated; got != want {
+		t.Errorf("Generated: %v, want %v", got, want)
+	}
+	if got := table.Columns[1].Generated; got != NotGenerated {
+		t.Errorf("Generated: %v, want %v", got, NotGenerated)
+	}
+	// The functional key part computes the same expression as total.
+	if got, want := table.Indexes[1].KeyParts(), []string{"total"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyParts: %v, want %v", got, want)
+	}
+	if got, want := table.Indexes[1].Expressions, []string{"(`a`+`b`)"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expressions: %v, want %v", got, want)
+	}
+	// No column computes a * 2.
+	if got, want := table.Indexes[2].KeyParts(), []string{"b", "(`a` * 2)"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyParts: %v, want %v", got, want)
+	}
+}
+
 func newTestLoadTable(tableType string, comment string, db *fakesqldb.DB) (*Table, error) {
 	ctx := context.Background()
 	appParams := db.ConnParams()
@@ -432,3 +501,57 @@ func getTestLoadTableWithBitColumnQueries() map[string]*sqltypes.Result {
 		},
 	}
 }
+
+func getTestLoadTableWithGeneratedColumnsQueries() map[string]*sqltypes.Result {
+	describeRow := func(name, extra string) []sqltypes.Value {
+		row := mysql.DescribeTableRow(name, "int(11)", false, "", "")
+		row[5] = sqltypes.NewVarChar(extra)
+		return row
+	}
+	indexFields := append(mysql.ShowIndexFromTableFields, &querypb.Field{
+		Name: "Expression",
+		Type: sqltypes.Blob,
+	})
+	indexRow := func(keyName string, seqInIndex int, columnName, expr string) []sqltypes.Value {
+		row := mysql.ShowIndexFromTableRow("test_table", false, keyName, seqInIndex, columnName, false)
+		if expr == "" {
+			return append(row, sqltypes.NULL)
+		}
+		row[4] = sqltypes.NULL
+		return append(row, sqltypes.NewVarBinary(expr))
+	}
+	return map[string]*sqltypes.Result{
+		"select * from test_table where 1 != 1": {
+			Fields: sqltypes.MakeTestFields("pk|a|total|doubled|b", "int32|int32|int32|int32|int32"),
+		},
+		"describe test_table": {
+			Fields:       mysql.DescribeTableFields,
+			RowsAffected: 5,
+			Rows: [][]sqltypes.Value{
+				mysql.DescribeTableRow("pk", "int(11)", false, "PRI", "0"),
+				describeRow("a", ""),
+				describeRow("total", "VIRTUAL GENERATED"),
+				describeRow("doubled", "STORED GENERATED"),
+				describeRow("b", ""),
+			},
+		},
+		mysql.BaseShowGeneratedColumnsForTable("test_table"): {
+			Fields:       sqltypes.MakeTestFields("column_name|generation_expression", "varchar|blob"),
+			RowsAffected: 2,
+			Rows: [][]sqltypes.Value{
+				{sqltypes.NewVarChar("total"), sqltypes.NewVarBinary("(`a` + `b`)")},
+				{sqltypes.NewVarChar("doubled"), sqltypes.NewVarBinary("(`b` * 2)")},
+			},
+		},
+		"show index from test_table": {
+			Fields:       indexFields,
+			RowsAffected: 4,
+			Rows: [][]sqltypes.Value{
+				indexRow("PRIMARY", 1, "pk", ""),
+				indexRow("idx_total", 1, "", "(`a`+`b`)"),
+				indexRow("idx_b_a", 1, "b", ""),
+				indexRow("idx_b_a", 2, "", "(`a` * 2)"),
+			},
+		},
+	}
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// PartitionInfo contains the partitioning of a table.
+type PartitionInfo struct {
+	// Method is the partitioning method, like RANGE, LIST,
+	// RANGE COLUMNS or HASH.
+	Method string
+	// Expression is the partitioning expression.
+	Expression string
+	// Column is the partitioning column if Expression is a single
+	// column. It is empty otherwise.
+	Column     sqlparser.ColIdent
+	Partitions []*Partition
+
+	// prunable is true if the partitions of a row can be computed
+	// from the value of Column: the table is partitioned by RANGE
+	// or LIST on a single column, with integral bounds.
+	prunable bool
+}
+
+// Partition contains the info of one partition of a table.
+type Partition struct {
+	Name string
+	// Description is the upper bound of a RANGE partition, or the
+	// list of values of a LIST partition.
+	Description string
+
+	// The following fields are parsed from Description.
+	maxValue bool
+	lessThan sqltypes.Value
+	values   []sqltypes.Value
+}
+
+var partitionColumnRegexp = regexp.MustCompile("^`?([0-9A-Za-z_$]+)`?$")
+
+type partitionRow struct {
+	table     string
+	ordinal   int64
+	partition *Partition
+	method    string
+	expr      string
+}
+
+// buildPartitionInfos builds the partition infos of the tables from
+// the rows of a mysql.BaseShowPartitions query.
+func buildPartitionInfos(rows [][]sqltypes.Value) (map[string]*PartitionInfo, error) {
+	partitionRows := make([]partitionRow, 0, len(rows))
+	for _, row := range rows {
+		ordinal, err := sqltypes.ToInt64(row[2])
+		if err != nil {
+			return nil, err
+		}
+		partitionRows = append(partitionRows, partitionRow{
+			table:   row[0].ToString(),
+			ordinal: ordinal,
+			partition: &Partition{
+				Name:        row[1].ToString(),
+				Description: row[5].ToString(),
+			},
+			method: row[3].ToString(),
+			expr:   row[4].ToString(),
+		})
+	}
+	sort.SliceStable(partitionRows, func(i, j int) bool {
+		if partitionRows[i].table != partitionRows[j].table {
+			return partitionRows[i].table < partitionRows[j].table
+		}
+		return partitionRows[i].ordinal < partitionRows[j].ordinal
+	})
+
+	infos := make(map[string]*PartitionInfo)
+	for i := 0; i < len(partitionRows); {
+		pr := partitionRows[i]
+		var partitions []*Partition
+		for ; i < len(partitionRows) && partitionRows[i].table == pr.table; i++ {
+			partitions = append(partitions, partitionRows[i].partition)
+		}
+		infos[pr.table] = NewPartitionInfo(pr.method, pr.expr, partitions...)
+	}
+	return infos, nil
+}
+
+// NewPartitionInfo creates a PartitionInfo for the partitions of a table,
+// in partition order.
+func NewPartitionInfo(method, expression string, partitions ...*Partition) *PartitionInfo {
+	info := &PartitionInfo{
+		Method:     method,
+		Expression: expression,
+		Partitions: partitions,
+	}
+	if submatch := partitionColumnRegexp.FindStringSubmatch(strings.TrimSpace(expression)); submatch != nil {
+		info.Column = sqlparser.NewColIdent(submatch[1])
+	}
+	info.prunable = info.parseDescriptions() == nil
+	return info
+}
+
+// parseDescriptions parses the bounds of the partitions. It returns an
+// error if the partitions can't be pruned.
+func (pi *PartitionInfo) parseDescriptions() error {
+	if pi.Column.IsEmpty() {
+		return fmt.Errorf("partitioning expression is not a column: %s", pi.Expression)
+	}
+	for _, p := range pi.Partitions {
+		switch pi.Method {
+		case "RANGE", "RANGE COLUMNS":
+			if p.Description == "MAXVALUE" {
+				p.maxValue = true
+				continue
+			}
+			values, err := parsePartitionValues(p.Description)
+			if err != nil {
+				return err
+			}
+			if len(values) != 1 || values[0].IsNull() {
+				return fmt.Errorf("unexpected partition bound: %s", p.Description)
+			}
+			p.lessThan = values[0]
+		case "LIST", "LIST COLUMNS":
+			values, err := parsePartitionValues(p.Description)
+			if err != nil {
+				return err
+			}
+			p.values = values
+		default:
+			return fmt.Errorf("partitioning method can't be pruned: %s", pi.Method)
+		}
+	}
+	return nil
+}
+
+// parsePartitionValues parses a comma separated list of integral values.
+func parsePartitionValues(description string) ([]sqltypes.Value, error) {
+	stmt, err := sqlparser.Parse("select " + description)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected partition description: %s", description)
+	}
+	values := make([]sqltypes.Value, 0, len(sel.SelectExprs))
+	for _, expr := range sel.SelectExprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, fmt.Errorf("unexpected partition description: %s", description)
+		}
+		switch val := aliased.Expr.(type) {
+		case *sqlparser.NullVal:
+			values = append(values, sqltypes.NULL)
+		case *sqlparser.SQLVal:
+			if val.Type != sqlparser.IntVal {
+				return nil, fmt.Errorf("partition value is not integral: %s", description)
+			}
+			v, err := sqltypes.NewIntegral(string(val.Val))
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		default:
+			return nil, fmt.Errorf("unexpected partition description: %s", description)
+		}
+	}
+	return values, nil
+}
+
+// CanPrune returns true if the partitions of the rows can be computed
+// from the values of Column.
+func (pi *PartitionInfo) CanPrune() bool {
+	return pi != nil && pi.prunable
+}
+
+// PartitionsFor returns the names of the partitions that may contain
+// the rows whose partitioning column is one of values, in partition
+// order. ok is false if the partitions can't be computed, in which case
+// all the partitions must be read.
+func (pi *PartitionInfo) PartitionsFor(values []sqltypes.Value) (names []string, ok bool) {
+	if !pi.CanPrune() {
+		return nil, false
+	}
+	selected := make([]bool, len(pi.Partitions))
+	for _, v := range values {
+		if v.IsNull() {
+			return nil, false
+		}
+		i, err := pi.find(v)
+		if err != nil {
+			return nil, false
+		}
+		if i >= 0 {
+			selected[i] = true
+		}
+	}
+	for i, p := range pi.Partitions {
+		if selected[i] {
+			names = append(names, p.Name)
+		}
+	}
+	return names, len(names) != 0
+}
+
+// find returns the index of the partition of a value, or -1 if no
+// partition can contain it.
+func (pi *PartitionInfo) find(v sqltypes.Value) (int, error) {
+	for i, p := range pi.Partitions {
+		if p.maxValue {
+			return i, nil
+		}
+		if !p.lessThan.IsNull() {
+			cmp, err := compareBound(v, p.lessThan)
+			if err != nil {
+				return -1, err
+			}
+			if cmp < 0 {
+				return i, nil
+			}
+			continue
+		}
+		for _, pv := range p.values {
+			if pv.IsNull() {
+				continue
+			}
+			cmp, err := compareBound(v, pv)
+			if err != nil {
+				return -1, err
+			}
+			if cmp == 0 {
+				return i, nil
+			}
+		}
+	}
+	return -1, nil
+}
+
+// compareBound compares a value to a bound of a partition. Unlike MySQL,
+// which converts it to 0, a value that isn't a number can't be compared
+// to a numeric bound.
+func compareBound(v, bound sqltypes.Value) (int, error) {
+	if (bound.IsIntegral() || bound.IsFloat()) && !(v.IsIntegral() || v.IsFloat()) {
+		if _, err := strconv.ParseFloat(v.ToString(), 64); err != nil {
+			return 0, fmt.Errorf("%v is not a number", v)
+		}
+	}
+	return sqltypes.NullsafeCompare(v, bound)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func partitionRows(table, method, expr string, descriptions ...string) [][]sqltypes.Value {
+	var rows [][]sqltypes.Value
+	for i, desc := range descriptions {
+		rows = append(rows, []sqltypes.Value{
+			sqltypes.NewVarChar(table),
+			sqltypes.NewVarChar(fmt.Sprintf("p%d", i)),
+			sqltypes.NewInt64(int64(i + 1)),
+			sqltypes.NewVarChar(method),
+			sqltypes.NewVarChar(expr),
+			sqltypes.NewVarChar(desc),
+		})
+	}
+	return rows
+}
+
+func TestBuildPartitionInfos(t *testing.T) {
+	var rows [][]sqltypes.Value
+	rows = append(rows, partitionRows("t_range", "RANGE", "`id`", "10", "100", "MAXVALUE")...)
+	rows = append(rows, partitionRows("t_list", "LIST COLUMNS", "`region`", "1,2", "3,NULL")...)
+	rows = append(rows, partitionRows("t_year", "RANGE", "year(`created`)", "2018", "2019")...)
+	rows = append(rows, partitionRows("t_hash", "HASH", "`id`", "", "")...)
+	rows = append(rows, partitionRows("t_strings", "RANGE COLUMNS", "`name`", "'m'", "MAXVALUE")...)
+	// The partitions are sorted by table and ordinal position.
+	rows[0], rows[2] = rows[2], rows[0]
+
+	infos, err := buildPartitionInfos(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 5 {
+		t.Fatalf("buildPartitionInfos: %v, want 5 tables", infos)
+	}
+	testcases := []struct {
+		table    string
+		values   []sqltypes.Value
+		want     []string
+		wantOk   bool
+		canPrune bool
+	}{{
+		table:    "t_range",
+		values:   []sqltypes.Value{sqltypes.NewInt64(5)},
+		want:     []string{"p0"},
+		wantOk:   true,
+		canPrune: true,
+	}, {
+		table:    "t_range",
+		values:   []sqltypes.Value{sqltypes.NewInt64(1000), sqltypes.NewVarBinary("10"), sqltypes.NewInt64(-3)},
+		want:     []string{"p0", "p1", "p2"},
+		wantOk:   true,
+		canPrune: true,
+	}, {
+		table:    "t_range",
+		values:   []sqltypes.Value{sqltypes.NewVarBinary("abc")},
+		canPrune: true,
+	}, {
+		table:    "t_range",
+		values:   []sqltypes.Value{sqltypes.NULL},
+		canPrune: true,
+	}, {
+		table:    "t_list",
+		values:   []sqltypes.Value{sqltypes.NewInt64(3), sqltypes.NewInt64(7)},
+		want:     []string{"p1"},
+		wantOk:   true,
+		canPrune: true,
+	}, {
+		table:    "t_list",
+		values:   []sqltypes.Value{sqltypes.NewInt64(7)},
+		canPrune: true,
+	}, {
+		table:  "t_year",
+		values: []sqltypes.Value{sqltypes.NewInt64(5)},
+	}, {
+		table:  "t_hash",
+		values: []sqltypes.Value{sqltypes.NewInt64(5)},
+	}, {
+		table:  "t_strings",
+		values: []sqltypes.Value{sqltypes.NewVarBinary("a")},
+	}}
+	for _, tcase := range testcases {
+		info := infos[tcase.table]
+		if got := info.CanPrune(); got != tcase.canPrune {
+			t.Errorf("%s: CanPrune: %v, want %v", tcase.table, got, tcase.canPrune)
+		}
+		got, ok := info.PartitionsFor(tcase.values)
+		if !reflect.DeepEqual(got, tcase.want) || ok != tcase.wantOk {
+			t.Errorf("%s: PartitionsFor(%v): %v, %v, want %v, %v", tcase.table, tcase.values, got, ok, tcase.want, tcase.wantOk)
+		}
+	}
+	if got := infos["t_year"].Column; !got.IsEmpty() {
+		t.Errorf("t_year: Column: %v, want empty", got)
+	}
+	if got := infos["t_range"].Column.String(); got != "id" {
+		t.Errorf("t_range: Column: %v, want id", got)
+	}
+}
@@ -46,12 +46,23 @@ var TypeNames = []string{
 	"message",
 }
 
+// Generated column kinds
+const (
+	NotGenerated = iota
+	VirtualGenerated
+	StoredGenerated
+)
+
 // TableColumn contains info about a table's column.
 type TableColumn struct {
 	Name    sqlparser.ColIdent
 	Type    querypb.Type
 	IsAuto  bool
 	Default sqltypes.Value
+
+	// Generated is set for the columns MySQL computes from Expression.
+	Generated  int    `json:",omitempty"`
+	Expression string `json:",omitempty"`
 }
 
 // Table contains info about a table.
@@ -71,6 +82,12 @@ type Table struct {
 	// TopicInfo contains info for message topics.
 	TopicInfo *TopicInfo
 
+	// PartitionInfo contains the partitioning of partitioned tables.
+	PartitionInfo *PartitionInfo
+
+	// ViewInfo contains the definition of views.
+	ViewInfo *ViewInfo
+
 	// These vars can be accessed concurrently.
 	TableRows     sync2.AtomicInt64
 	DataLength    sync2.AtomicInt64
@@ -79,6 +96,16 @@ type Table struct {
 	MaxDataLength sync2.AtomicInt64
 }
 
+// ViewInfo contains info specific to views.
+type ViewInfo struct {
+	// Definition is the SELECT statement of the view, as stored
+	// by MySQL.
+	Definition string
+	// Database is the database of the view, which qualifies the
+	// tables of its definition.
+	Database string
+}
+
 // SequenceInfo contains info specific to sequence tabels.
 // It must be locked before accessing the values inside.
 // If CurVal==LastVal, we have to cache new values.
@@ -134,6 +161,11 @@ type MessageInfo struct {
 	// PollInterval specifies the polling frequency to
 	// look for messages to be sent.
 	PollInterval time.Duration
+
+	// Optional table to watch for changes. A message is
+	// added to this table for every row change of the
+	// watched table.
+	WatchTable string
 }
 
 // NewTable creates a new Table.
@@ -146,6 +178,7 @@ func NewTable(name string) *Table {
 // Done must be called after columns and indexes are added to
 // the table. It will build additional metadata like PKColumns.
 func (ta *Table) Done() {
+	ta.resolveExpressions()
 	if !ta.HasPrimary() {
 		return
 	}
@@ -161,10 +194,17 @@ func (ta *Table) AddColumn(name string, columnType querypb.Type, defval sqltypes
 	index := len(ta.Columns)
 	ta.Columns = append(ta.Columns, TableColumn{Name: sqlparser.NewColIdent(name)})
 	ta.Columns[index].Type = columnType
-	if extra == "auto_increment" {
+	switch extra {
+	case "auto_increment":
 		ta.Columns[index].IsAuto = true
 		// Ignore default value, if any
 		return
+	case "VIRTUAL GENERATED":
+		ta.Columns[index].Generated = VirtualGenerated
+		return
+	case "STORED GENERATED":
+		ta.Columns[index].Generated = StoredGenerated
+		return
 	}
 	if defval.IsNull() {
 		return
@@ -173,6 +213,16 @@ func (ta *Table) AddColumn(name string, columnType querypb.Type, defval sqltypes
 	ta.Columns[index].Default = sqltypes.MakeTrusted(ta.Columns[index].Type, defval.Raw())
 }
 
+// HasGenerated returns true if the table has generated columns.
+func (ta *Table) HasGenerated() bool {
+	for _, col := range ta.Columns {
+		if col.Generated != NotGenerated {
+			return true
+		}
+	}
+	return false
+}
+
 // FindColumn finds a column in the table. It returns the index if found.
 // Otherwise, it returns -1.
 func (ta *Table) FindColumn(name sqlparser.ColIdent) int {
@@ -237,6 +287,9 @@ type Index struct {
 	Unique bool
 	// Columns are the columns comprising the index.
 	Columns []sqlparser.ColIdent
+	// Expressions[i] is the expression of a functional key part.
+	// It's empty if Columns[i] is a plain column.
+	Expressions []string
 	// Cardinality[i] is the number of distinct values of Columns[i] in the
 	// table.
 	Cardinality []uint64
@@ -249,13 +302,38 @@ func NewIndex(name string, unique bool) *Index {
 
 // AddColumn adds a column to the index.
 func (idx *Index) AddColumn(name string, cardinality uint64) {
+	idx.add(name, "", cardinality)
+}
+
+// AddExpression adds a functional key part to the index. Its column
+// is unknown until Table.Done matches the expression with a generated
+// column.
+func (idx *Index) AddExpression(expr string, cardinality uint64) {
+	idx.add("", expr, cardinality)
+}
+
+func (idx *Index) add(name, expr string, cardinality uint64) {
 	idx.Columns = append(idx.Columns, sqlparser.NewColIdent(name))
+	idx.Expressions = append(idx.Expressions, expr)
 	if cardinality == 0 {
 		cardinality = uint64(len(idx.Cardinality) + 1)
 	}
 	idx.Cardinality = append(idx.Cardinality, cardinality)
 }
 
+// KeyParts returns the names of the index columns. Functional key
+// parts that don't match a generated column are named by their expression.
+func (idx *Index) KeyParts() []string {
+	parts := make([]string, len(idx.Columns))
+	for i, col := range idx.Columns {
+		parts[i] = col.String()
+		if col.IsEmpty() && i < len(idx.Expressions) {
+			parts[i] = idx.Expressions[i]
+		}
+	}
+	return parts
+}
+
 // FindColumn finds a column in the index. It returns the index if found.
 // Otherwise, it returns -1.
 func (idx *Index) FindColumn(name sqlparser.ColIdent) int {
@@ -78,6 +78,8 @@ func Queries() map[string]*sqltypes.Result {
 				sqltypes.NewVarBinary("STATEMENT"),
 			}},
 		},
+		mysql.BaseShowPartitions: {},
+		mysql.BaseShowViews:      {},
 		mysql.BaseShowTables: {
 			Fields:       mysql.BaseShowTablesFields,
 			RowsAffected: 3,
@@ -38,19 +38,21 @@ var (
 			<th>IndexLength</th>
 			<th>DataFree</th>
 			<th>MaxDataLength</th>
+			<th>Partitions</th>
 		</tr>
 	`)
 	schemazTmpl = template.Must(template.New("example").Parse(`
 	{{$top := .}}{{with .Table}}<tr class="low">
 			<td>{{.Name}}</td>
-			<td>{{range .Columns}}{{.Name}}: {{.Type}}, {{if .IsAuto}}autoinc{{end}}, {{.Default.ToString}}<br>{{end}}</td>
-			<td>{{range .Indexes}}{{.Name}}{{if .Unique}}(unique){{end}}: ({{range .Columns}}{{.}},{{end}}), ({{range .Cardinality}}{{.}},{{end}})<br>{{end}}</td>
+			<td>{{range .Columns}}{{.Name}}: {{.Type}}, {{if .IsAuto}}autoinc{{end}}, {{.Default.ToString}}{{if .Expression}}, as {{.Expression}}{{end}}<br>{{end}}</td>
+			<td>{{range .Indexes}}{{.Name}}{{if .Unique}}(unique){{end}}: ({{range .KeyParts}}{{.}},{{end}}), ({{range .Cardinality}}{{.}},{{end}})<br>{{end}}</td>
 			<td>{{index $top.Type .Type}}</td>
 			<td>{{.TableRows.Get}}</td>
 			<td>{{.DataLength.Get}}</td>
 			<td>{{.IndexLength.Get}}</td>
 			<td>{{.DataFree.Get}}</td>
 			<td>{{.MaxDataLength.Get}}</td>
+			<td>{{with .PartitionInfo}}{{.Method}}({{.Expression}}): {{range .Partitions}}{{.Name}} {{.Description}}<br>{{end}}{{end}}</td>
 		</tr>{{end}}
 	`))
 )
@@ -38,6 +38,10 @@ func TestSchamazHandler(t *testing.T) {
 	tableA.AddColumn("column1", sqltypes.Int64, sqltypes.NewInt32(0), "auto_increment")
 	tableA.AddIndex("index1", true).AddColumn("index_column", 1000)
 	tableA.Type = NoType
+	tableA.PartitionInfo = NewPartitionInfo("RANGE", "column1",
+		&Partition{Name: "p0", Description: "10"},
+		&Partition{Name: "p1", Description: "MAXVALUE"},
+	)
 
 	tableB.AddColumn("column2", sqltypes.VarChar, sqltypes.NewVarBinary("NULL"), "")
 	tableB.AddIndex("index2", false).AddColumn("index_column2", 200)
@@ -58,6 +62,12 @@ func TestSchamazHandler(t *testing.T) {
 		`<td>column1: INT64, autoinc, <br></td>`,
 		`<td>index1\(unique\): \(index_column,\), \(1000,\)<br></td>`,
 		`<td>none</td>`,
+		`<td>0</td>`,
+		`<td>0</td>`,
+		`<td>0</td>`,
+		`<td>0</td>`,
+		`<td>0</td>`,
+		`<td>RANGE\(column1\): p0 10<br>p1 MAXVALUE<br></td>`,
 	}
 	matched, err := regexp.Match(strings.Join(tableAPattern, `\s*`), body)
 	if err != nil {
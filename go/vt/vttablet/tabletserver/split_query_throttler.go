@@ -0,0 +1,114 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/splitquery"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// splitQueryThrottleInterval is how often a waiting query part checks
+// the replication lag again.
+var splitQueryThrottleInterval = 1 * time.Second
+
+// splitQueryThrottler delays the query parts generated by SplitQuery
+// while the replication lag is too high, so that the batch readers that
+// execute the parts one after the other pause between them. A query
+// part waits at most maxDelay, and fails if the lag is still too high.
+type splitQueryThrottler struct {
+	maxDelay time.Duration
+	interval time.Duration
+	// throttled returns true while the query parts must wait.
+	throttled func() bool
+}
+
+func newSplitQueryThrottler(config tabletenv.TabletConfig, throttled func() bool) *splitQueryThrottler {
+	return &splitQueryThrottler{
+		maxDelay:  time.Duration(config.SplitQueryThrottleMaxDelay * 1e9),
+		interval:  splitQueryThrottleInterval,
+		throttled: throttled,
+	}
+}
+
+// Wait returns once a query can be executed. The queries that are not
+// query parts, and the ones with the SKIP_THROTTLER directive, are
+// executed right away.
+func (t *splitQueryThrottler) Wait(ctx context.Context, sql string, bindVariables map[string]*querypb.BindVariable) error {
+	if t.maxDelay <= 0 || !splitquery.IsQueryPart(bindVariables) || !t.throttled() || skipThrottler(sql) {
+		return nil
+	}
+
+	start := time.Now()
+	timer := time.NewTimer(t.maxDelay)
+	defer timer.Stop()
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			tabletenv.SplitQueryThrottleDelays.Record("Canceled", start)
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "query part throttled until its deadline: replication lag too high")
+		case <-timer.C:
+			tabletenv.SplitQueryThrottleDelays.Record("Rejected", start)
+			return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query part throttled for %v: replication lag too high", t.maxDelay)
+		case <-ticker.C:
+			if !t.throttled() {
+				tabletenv.SplitQueryThrottleDelays.Record("Executed", start)
+				return nil
+			}
+		}
+	}
+}
+
+// skipThrottler returns true if the query has the SKIP_THROTTLER directive.
+func skipThrottler(sql string) bool {
+	statement, err := sqlparser.Parse(sql)
+	if err != nil {
+		return false
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return false
+	}
+	return sqlparser.ExtractCommentDirectives(sel.Comments).IsSet(sqlparser.DirectiveSkipThrottler)
+}
+
+// splitQueryThrottled returns true if the replication lag is too high
+// for the query parts. A master checks the lag of its replicas, as seen
+// by the transaction throttler, without throttling any transaction. The
+// other tablets check their own lag.
+func (tsv *TabletServer) splitQueryThrottled() bool {
+	tsv.mu.Lock()
+	tabletType := tsv.target.TabletType
+	tsv.mu.Unlock()
+	if tabletType == topodatapb.TabletType_MASTER {
+		lag, ok := tsv.txThrottler.ReplicationLag()
+		return ok && lag > tsv.splitQueryMaxReplicationLag
+	}
+	lag, err := tsv.HeartbeatLag()
+	return err == nil && lag > tsv.splitQueryMaxReplicationLag
+}
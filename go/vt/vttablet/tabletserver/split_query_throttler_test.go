@@ -0,0 +1,101 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestSplitQueryThrottler(t *testing.T) {
+	var throttledChecks sync2.AtomicInt64
+	throttledChecks.Set(3)
+	throttler := &splitQueryThrottler{
+		maxDelay: 10 * time.Second,
+		interval: 1 * time.Millisecond,
+		throttled: func() bool {
+			return throttledChecks.Add(-1) >= 0
+		},
+	}
+	queryPart := map[string]*querypb.BindVariable{
+		"_splitquery_start_id": sqltypes.Int64BindVariable(10),
+	}
+	sql := "select * from test_table where id >= :_splitquery_start_id"
+
+	// The queries that are not query parts are not throttled.
+	if err := throttler.Wait(context.Background(), sql, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := throttledChecks.Get(); got != 3 {
+		t.Errorf("throttled checks left: %v, want 3", got)
+	}
+
+	// The query part waits until the lag is back below the threshold.
+	executed := splitQueryThrottleCount("Executed")
+	if err := throttler.Wait(context.Background(), sql, queryPart); err != nil {
+		t.Fatal(err)
+	}
+	if got := throttledChecks.Get(); got != -1 {
+		t.Errorf("throttled checks left: %v, want -1", got)
+	}
+	if got, want := splitQueryThrottleCount("Executed"), executed+1; got != want {
+		t.Errorf("Executed delays: %v, want %v", got, want)
+	}
+
+	// The SKIP_THROTTLER directive opts out.
+	throttledChecks.Set(1000)
+	if err := throttler.Wait(context.Background(), "select /*vt+ SKIP_THROTTLER=1 */ * from test_table where id >= :_splitquery_start_id", queryPart); err != nil {
+		t.Fatal(err)
+	}
+
+	// The query part fails once it waited for maxDelay.
+	throttler.maxDelay = 10 * time.Millisecond
+	err := throttler.Wait(context.Background(), sql, queryPart)
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Errorf("Wait: %v, want RESOURCE_EXHAUSTED", err)
+	}
+
+	// And when the deadline of the query is exceeded.
+	throttler.maxDelay = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = throttler.Wait(ctx, sql, queryPart)
+	if err == nil || !strings.Contains(err.Error(), "query part throttled until its deadline") {
+		t.Errorf("Wait: %v, want deadline exceeded", err)
+	}
+
+	// A zero maxDelay disables the throttling.
+	throttler.maxDelay = 0
+	if err := throttler.Wait(context.Background(), sql, queryPart); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func splitQueryThrottleCount(result string) int64 {
+	return tabletenv.SplitQueryThrottleDelays.Counts()[result]
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/log"
@@ -34,7 +35,7 @@ import (
 // EqualSplitsAlgorithm implements the SplitAlgorithmInterface and represents the equal-splits
 // algorithm for generating the boundary tuples. If this algorithm is used then
 // SplitParams.split_columns must contain only one split_column. Additionally, the split_column
-// must have numeric type (integral or floating point).
+// must have numeric type (integral, decimal or floating point).
 //
 // The algorithm works by issuing a query to the database to find the minimum and maximum
 // elements of the split column in the table referenced by the given SQL query. Denote these
@@ -44,10 +45,12 @@ import (
 // where min=a_1 < a_2 < a_3 < ... < a_split_count < a_{split_count+1}=max.
 // The boundary points returned by this algorithm are then: a_2, a_3, ..., a_{split_count}
 // (an empty list of boundary points is returned if split_count <= 1). If the type of the
-// split column is integral, the boundary points are truncated to the integer part.
+// split column is integral, the boundary points are truncated to the integer part. If it is
+// decimal, they are rounded to the scale of min and max.
 type EqualSplitsAlgorithm struct {
 	splitParams *SplitParams
 	sqlExecuter SQLExecuter
+	minMaxCache *MinMaxCache
 
 	minMaxQuery string
 }
@@ -57,6 +60,15 @@ type EqualSplitsAlgorithm struct {
 // minimum and maximum elements in the table.
 func NewEqualSplitsAlgorithm(splitParams *SplitParams, sqlExecuter SQLExecuter) (
 	*EqualSplitsAlgorithm, error) {
+	return NewEqualSplitsAlgorithmWithCache(splitParams, sqlExecuter, nil /* minMaxCache */)
+}
+
+// NewEqualSplitsAlgorithmWithCache constructs a new equal splits algorithm that reads
+// the minimum and maximum elements in the table from minMaxCache, if they are cached,
+// and caches them otherwise. minMaxCache can be nil.
+func NewEqualSplitsAlgorithmWithCache(
+	splitParams *SplitParams, sqlExecuter SQLExecuter, minMaxCache *MinMaxCache) (
+	*EqualSplitsAlgorithm, error) {
 
 	if len(splitParams.splitColumns) == 0 {
 		panic(fmt.Sprintf("len(splitParams.splitColumns) == 0." +
@@ -67,10 +79,11 @@ func NewEqualSplitsAlgorithm(splitParams *SplitParams, sqlExecuter SQLExecuter)
 	// use-case is not to specify split columns at all, which will make them default to the table
 	// primary key columns, and there can be more than one primary key column for a table.
 	if !sqltypes.IsFloat(splitParams.splitColumns[0].Type) &&
-		!sqltypes.IsIntegral(splitParams.splitColumns[0].Type) {
+		!sqltypes.IsIntegral(splitParams.splitColumns[0].Type) &&
+		splitParams.splitColumns[0].Type != sqltypes.Decimal {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
 			"using the EQUAL_SPLITS algorithm in SplitQuery requires having"+
-				" a numeric (integral or float) split-column. Got type: %v", splitParams.splitColumns[0])
+				" a numeric (integral, decimal or float) split-column. Got type: %v", splitParams.splitColumns[0])
 	}
 	if splitParams.splitCount <= 0 {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
@@ -80,6 +93,7 @@ func NewEqualSplitsAlgorithm(splitParams *SplitParams, sqlExecuter SQLExecuter)
 	result := &EqualSplitsAlgorithm{
 		splitParams: splitParams,
 		sqlExecuter: sqlExecuter,
+		minMaxCache: minMaxCache,
 
 		minMaxQuery: buildMinMaxQuery(splitParams),
 	}
@@ -149,22 +163,42 @@ func (a *EqualSplitsAlgorithm) generateBoundaries() ([]tuple, error) {
 	subIntervalSize.Quo(maxMinDiff, new(big.Rat).SetInt64(a.splitParams.splitCount))
 	// If the split-column type is integral then it's wasteful to have a sub-intervale-size smaller
 	// than 1, as it'll result with some query-parts being trivially empty. We set the
-	// sub-interval size to 1 in this case.
-	one := new(big.Rat).SetInt64(1)
-	if sqltypes.IsIntegral(a.splitParams.splitColumns[0].Type) &&
-		subIntervalSize.Cmp(one) < 0 {
-		subIntervalSize = one
+	// sub-interval size to 1 in this case. Likewise, the sub-interval size of a decimal
+	// split-column is at least the smallest step of its scale.
+	scale := decimalScale(minValue, maxValue)
+	smallestStep := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil))
+	if (sqltypes.IsIntegral(a.splitParams.splitColumns[0].Type) ||
+		a.splitParams.splitColumns[0].Type == sqltypes.Decimal) &&
+		subIntervalSize.Cmp(smallestStep) < 0 {
+		subIntervalSize = smallestStep
 	}
 	boundary := new(big.Rat).Add(min, subIntervalSize)
 	result := []tuple{}
 	for ; boundary.Cmp(max) < 0; boundary.Add(boundary, subIntervalSize) {
-		boundaryValue := bigRatToValue(boundary, a.splitParams.splitColumns[0].Type)
+		boundaryValue := bigRatToValue(boundary, a.splitParams.splitColumns[0].Type, scale)
 		result = append(result, tuple{boundaryValue})
 	}
 	return result, nil
 }
 
 func (a *EqualSplitsAlgorithm) executeMinMaxQuery() (minValue, maxValue sqltypes.Value, err error) {
+	if a.minMaxCache == nil {
+		return a.queryMinMax()
+	}
+	table := a.splitParams.GetSplitTableName().String()
+	column := a.splitParams.splitColumns[0].Name.Lowered()
+	if minValue, maxValue, ok := a.minMaxCache.Get(table, column); ok {
+		return minValue, maxValue, nil
+	}
+	minValue, maxValue, err = a.queryMinMax()
+	if err != nil {
+		return sqltypes.Value{}, sqltypes.Value{}, err
+	}
+	a.minMaxCache.Set(table, column, minValue, maxValue)
+	return minValue, maxValue, nil
+}
+
+func (a *EqualSplitsAlgorithm) queryMinMax() (minValue, maxValue sqltypes.Value, err error) {
 	sqlResults, err := a.sqlExecuter.SQLExecute(a.minMaxQuery, nil /* Bind Variables */)
 	if err != nil {
 		return sqltypes.Value{}, sqltypes.Value{}, err
@@ -183,8 +217,19 @@ func (a *EqualSplitsAlgorithm) executeMinMaxQuery() (minValue, maxValue sqltypes
 // buildMinMaxQuery returns the query to execute to get the minimum and maximum of the splitColumn.
 // The query returned is:
 //   SELECT MIN(<splitColumn>), MAX(<splitColumn>) FROM <table>;
-// where <table> is the table referenced in the original query (held in splitParams.sql).
+// where <table> is the table referenced in the original query (held in splitParams.sql), or the
+// view or derived table it selects from.
 func buildMinMaxQuery(splitParams *SplitParams) string {
+	return fmt.Sprintf("select min(%v), max(%v) from %v",
+		sqlparser.String(splitParams.splitColumns[0].Name),
+		sqlparser.String(splitParams.splitColumns[0].Name),
+		buildMinMaxFromClause(splitParams))
+}
+
+func buildMinMaxFromClause(splitParams *SplitParams) string {
+	if splitParams.splitTableSchema.ViewInfo != nil {
+		return sqlparser.String(buildBoundaryFromClause(splitParams))
+	}
 	// The SplitParams constructor should have already checked that the FROM clause of the query
 	// is a simple table expression, so this type-assertion should succeed.
 	tableName := sqlparser.GetTableName(
@@ -192,18 +237,18 @@ func buildMinMaxQuery(splitParams *SplitParams) string {
 	if tableName.IsEmpty() {
 		panic(fmt.Sprintf("Can't get tableName from query %v", splitParams.sql))
 	}
-	return fmt.Sprintf("select min(%v), max(%v) from %v",
-		sqlparser.String(splitParams.splitColumns[0].Name),
-		sqlparser.String(splitParams.splitColumns[0].Name),
-		sqlparser.String(tableName))
+	return sqlparser.String(tableName)
 }
 
 // bigRatToValue converts 'number' to an SQL value with SQL type: valueType.
 // If valueType is integral it truncates 'number' to the integer part according to the
-// semantics of the big.Rat.Int method.
-func bigRatToValue(number *big.Rat, valueType querypb.Type) sqltypes.Value {
+// semantics of the big.Rat.Int method. If valueType is decimal it rounds 'number' to
+// 'scale' digits after the decimal point.
+func bigRatToValue(number *big.Rat, valueType querypb.Type, scale int) sqltypes.Value {
 	var numberAsBytes []byte
 	switch {
+	case valueType == sqltypes.Decimal:
+		numberAsBytes = []byte(number.FloatString(scale))
 	case sqltypes.IsIntegral(valueType):
 		// 'number.Num()' returns a reference to the numerator of 'number'.
 		// We copy it here to avoid changing 'number'.
@@ -262,11 +307,31 @@ func valueToBigRat(value sqltypes.Value, valueType querypb.Type) (*big.Rat, erro
 			return nil, err
 		}
 		return float64ToBigRat(nativeValue), nil
+	case valueType == sqltypes.Decimal:
+		// A decimal value is exact, unlike its float64 conversion.
+		result, ok := new(big.Rat).SetString(value.ToString())
+		if !ok {
+			return nil, fmt.Errorf("invalid decimal value: %v", value)
+		}
+		return result, nil
 	default:
 		panic(fmt.Sprintf("got value with a non numeric type: %v", value))
 	}
 }
 
+// decimalScale returns the largest number of digits after
+// the decimal point in the given values.
+func decimalScale(values ...sqltypes.Value) int {
+	scale := 0
+	for _, value := range values {
+		str := value.ToString()
+		if i := strings.IndexByte(str, '.'); i != -1 && len(str)-i-1 > scale {
+			scale = len(str) - i - 1
+		}
+	}
+	return scale
+}
+
 func int64ToBigRat(value int64) *big.Rat {
 	return new(big.Rat).SetInt64(value)
 }
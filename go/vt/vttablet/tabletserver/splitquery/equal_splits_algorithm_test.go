@@ -113,6 +113,28 @@ var equalSplitsAlgorithmTestCases = []equalSplitsAlgorithmTestCaseType{
 			{sqltypes.NewFloat64(37.625)},
 		},
 	},
+	{ // Split the interval [9007199254740993.10, 9007199254740993.50] into 4 parts.
+		// float64 can't represent these values.
+		SplitColumn: "decimal_col",
+		SplitCount:  4,
+		MinValue:    sqltypes.TestValue(sqltypes.Decimal, "9007199254740993.10"),
+		MaxValue:    sqltypes.TestValue(sqltypes.Decimal, "9007199254740993.50"),
+		ExpectedBoundaries: []tuple{
+			{sqltypes.TestValue(sqltypes.Decimal, "9007199254740993.20")},
+			{sqltypes.TestValue(sqltypes.Decimal, "9007199254740993.30")},
+			{sqltypes.TestValue(sqltypes.Decimal, "9007199254740993.40")},
+		},
+	},
+	{ // Split the interval [1.0, 1.2] into 4 parts.
+		// The boundaries can't be closer than the scale of the column.
+		SplitColumn: "decimal_col",
+		SplitCount:  4,
+		MinValue:    sqltypes.TestValue(sqltypes.Decimal, "1.0"),
+		MaxValue:    sqltypes.TestValue(sqltypes.Decimal, "1.2"),
+		ExpectedBoundaries: []tuple{
+			{sqltypes.TestValue(sqltypes.Decimal, "1.1")},
+		},
+	},
 	{ // Split the interval [-30, -30] into 4 parts.
 		// (should return an empty boundary list).
 		SplitColumn:        "int64_col",
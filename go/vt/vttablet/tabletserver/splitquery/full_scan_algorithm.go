@@ -147,7 +147,7 @@ func buildInitialQueryAST(splitParams *SplitParams) *sqlparser.Select {
 		// significant increase in running time.
 		// Note that we do not override the index for the actual query part since the list of
 		// columns selected there is different; so overriding it there may hurt performance.
-		From:    buildFromClause(splitParams.GetSplitTableName()),
+		From:    buildBoundaryFromClause(splitParams),
 		Limit:   buildLimitClause(splitParams.numRowsPerQueryPart, 1),
 		OrderBy: buildOrderByClause(splitParams.splitColumns),
 	}
@@ -0,0 +1,102 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package splitquery
+
+import (
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// MinMaxCache caches the minimum and maximum values of the split columns
+// computed by the EQUAL_SPLITS algorithm, so that the SplitQuery calls
+// that are retried don't query them again on huge tables.
+//
+// An entry is invalidated once the number of rows changed by DMLs in its
+// table since it was cached exceeds a threshold. Stale values only make
+// the query parts less even: the first and the last query parts are
+// open-ended, so they still cover all the rows of the table.
+type MinMaxCache struct {
+	threshold int64
+	// dmlCount returns the number of rows changed by DMLs in a table
+	// since the tablet started.
+	dmlCount func(table string) int64
+
+	mu      sync.Mutex
+	entries map[minMaxKey]*minMaxEntry
+}
+
+type minMaxKey struct {
+	table, column string
+}
+
+type minMaxEntry struct {
+	min, max sqltypes.Value
+	// dmlCount is the DML count of the table when the entry was cached.
+	dmlCount int64
+}
+
+// NewMinMaxCache returns a MinMaxCache whose entries are invalidated
+// once more than threshold rows are changed in their table, as counted
+// by dmlCount.
+func NewMinMaxCache(threshold int64, dmlCount func(table string) int64) *MinMaxCache {
+	return &MinMaxCache{
+		threshold: threshold,
+		dmlCount:  dmlCount,
+		entries:   make(map[minMaxKey]*minMaxEntry),
+	}
+}
+
+// Get returns the cached minimum and maximum values of a column, if
+// they are still valid.
+func (c *MinMaxCache) Get(table, column string) (min, max sqltypes.Value, ok bool) {
+	key := minMaxKey{table: table, column: column}
+	count := c.dmlCount(table)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return sqltypes.Value{}, sqltypes.Value{}, false
+	}
+	if count-entry.dmlCount > c.threshold {
+		delete(c.entries, key)
+		return sqltypes.Value{}, sqltypes.Value{}, false
+	}
+	return entry.min, entry.max, true
+}
+
+// Set caches the minimum and maximum values of a column.
+func (c *MinMaxCache) Set(table, column string, min, max sqltypes.Value) {
+	key := minMaxKey{table: table, column: column}
+	count := c.dmlCount(table)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = &minMaxEntry{
+		min:      min,
+		max:      max,
+		dmlCount: count,
+	}
+}
+
+// Len returns the number of cached entries.
+func (c *MinMaxCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
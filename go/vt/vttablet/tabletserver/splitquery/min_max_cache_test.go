@@ -0,0 +1,115 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package splitquery
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/splitquery/splitquery_testing"
+)
+
+func TestMinMaxCache(t *testing.T) {
+	counts := map[string]int64{}
+	cache := NewMinMaxCache(100, func(table string) int64 { return counts[table] })
+
+	if _, _, ok := cache.Get("t1", "id"); ok {
+		t.Errorf("Get on an empty cache: ok, want not found")
+	}
+	counts["t1"] = 50
+	cache.Set("t1", "id", sqltypes.NewInt64(1), sqltypes.NewInt64(1000))
+	cache.Set("t2", "id", sqltypes.NewInt64(5), sqltypes.NewInt64(10))
+
+	// The changes up to the threshold keep the entry.
+	counts["t1"] = 150
+	min, max, ok := cache.Get("t1", "id")
+	if !ok || min.ToString() != "1" || max.ToString() != "1000" {
+		t.Errorf("Get(t1, id): %v, %v, %v, want 1, 1000, true", min, max, ok)
+	}
+	if _, _, ok := cache.Get("t1", "val"); ok {
+		t.Errorf("Get(t1, val): ok, want not found")
+	}
+
+	// More changes invalidate it, but not the entries of the other tables.
+	counts["t1"] = 151
+	if _, _, ok := cache.Get("t1", "id"); ok {
+		t.Errorf("Get(t1, id) after 101 changes: ok, want invalidated")
+	}
+	if _, _, ok := cache.Get("t2", "id"); !ok {
+		t.Errorf("Get(t2, id): not found, want ok")
+	}
+	if got := cache.Len(); got != 1 {
+		t.Errorf("Len: %v, want 1", got)
+	}
+}
+
+func TestEqualSplitsAlgorithmWithCache(t *testing.T) {
+	splitParams, err := NewSplitParamsGivenSplitCount(
+		&querypb.BoundQuery{Sql: "select * from test_table where int_col > 5"},
+		[]sqlparser.ColIdent{sqlparser.NewColIdent("int64_col")},
+		3,
+		getTestSchema(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockSQLExecuter := splitquery_testing.NewMockSQLExecuter(mockCtrl)
+	// The bounds are queried once, and again when the table changed too much.
+	mockSQLExecuter.EXPECT().SQLExecute("select min(int64_col), max(int64_col) from test_table", nil).
+		Return(&sqltypes.Result{
+			Rows: [][]sqltypes.Value{{sqltypes.NewInt64(0), sqltypes.NewInt64(300)}},
+		}, nil)
+	mockSQLExecuter.EXPECT().SQLExecute("select min(int64_col), max(int64_col) from test_table", nil).
+		Return(&sqltypes.Result{
+			Rows: [][]sqltypes.Value{{sqltypes.NewInt64(0), sqltypes.NewInt64(600)}},
+		}, nil)
+
+	var count int64
+	cache := NewMinMaxCache(10, func(table string) int64 { return count })
+	generate := func() []tuple {
+		t.Helper()
+		algorithm, err := NewEqualSplitsAlgorithmWithCache(splitParams, mockSQLExecuter, cache)
+		if err != nil {
+			t.Fatal(err)
+		}
+		boundaries, err := algorithm.generateBoundaries()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return boundaries
+	}
+
+	want := []tuple{{sqltypes.NewInt64(100)}, {sqltypes.NewInt64(200)}}
+	for i := 0; i < 3; i++ {
+		if got := generate(); !reflect.DeepEqual(got, want) {
+			t.Errorf("attempt %d: boundaries %v, want %v", i, got, want)
+		}
+	}
+
+	count = 11
+	want = []tuple{{sqltypes.NewInt64(200)}, {sqltypes.NewInt64(400)}}
+	if got := generate(); !reflect.DeepEqual(got, want) {
+		t.Errorf("boundaries after the changes: %v, want %v", got, want)
+	}
+}
@@ -168,14 +168,9 @@ func newSplitParams(
 		return nil, vterrors.Errorf(
 			vtrpcpb.Code_INVALID_ARGUMENT, "unsupported FROM clause in query: %v", query.Sql)
 	}
-	tableName := sqlparser.GetTableName(aliasedTableExpr.Expr)
-	if tableName.IsEmpty() {
-		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported FROM clause in query"+
-			" (must be a simple table expression): %v", query.Sql)
-	}
-	tableSchema, ok := schemaMap[tableName.String()]
-	if !ok || tableSchema == nil {
-		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "can't find table in schema")
+	tableSchema, err := getSplitTableSchema(aliasedTableExpr, query.Sql, schemaMap)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get the schema.TableColumn representation of each splitColumnName.
@@ -186,7 +181,8 @@ func newSplitParams(
 			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
 				"no split columns where given and the queried table has"+
 					" no primary key columns (is the table a view? Running SplitQuery on a view"+
-					" is not supported). query: %v", query.Sql)
+					" requires the view to select the primary key columns of its base table)."+
+					" query: %v", query.Sql)
 		}
 	} else {
 		splitColumns, err = findSplitColumnsInSchema(splitColumnNames, tableSchema)
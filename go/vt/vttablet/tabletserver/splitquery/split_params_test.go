@@ -157,13 +157,13 @@ var splitParamsTestCases = []struct {
 		ExpectedErrorRegex: regexp.MustCompile("unsupported query"),
 	},
 	{ // Test NewSplitParamsGivenNumRowsPerQueryPart; unsupported select statement.
-		SQL:                 "select user_id from (select * from test_table) as t1",
+		SQL:                 "select m from (select max(id) as m from test_table) as t1",
 		BindVariables:       map[string]*querypb.BindVariable{"foo": sqltypes.StringBindVariable("123")},
-		SplitColumnNames:    []sqlparser.ColIdent{sqlparser.NewColIdent("id")},
+		SplitColumnNames:    []sqlparser.ColIdent{sqlparser.NewColIdent("m")},
 		NumRowsPerQueryPart: 100,
 		Schema:              getTestSchema(),
 
-		ExpectedErrorRegex: regexp.MustCompile("unsupported FROM clause"),
+		ExpectedErrorRegex: regexp.MustCompile("unsupported view t1"),
 	},
 	{ // Test NewSplitParamsGivenNumRowsPerQueryPart; unknown table.
 		SQL:                 "select user_id from missing_table",
@@ -193,6 +193,20 @@ var splitParamsTestCases = []struct {
 		ExpectedErrorRegex: regexp.MustCompile(
 			"split-columns must be a prefix of the columns composing an index"),
 	},
+	{ // Test NewSplitParamsGivenSplitCount; generated split column with a functional index.
+		SQL:              "select id from test_table",
+		BindVariables:    map[string]*querypb.BindVariable{"foo": sqltypes.StringBindVariable("123")},
+		SplitColumnNames: []sqlparser.ColIdent{sqlparser.NewColIdent("id_sum")},
+		SplitCount:       100,
+		Schema:           getTestSchema(),
+
+		ExpectedSplitParams: SplitParams{
+			splitCount:          100,
+			numRowsPerQueryPart: 10,
+			splitColumns:        []*schema.TableColumn{getTestSchemaColumn("test_table", "id_sum")},
+			splitTableSchema:    testSchema["test_table"],
+		},
+	},
 	{ // Test NewSplitParamsGivenNumRowsPerQueryPart; no split columns and no primary keys.
 		SQL:                 "select id from test_table",
 		BindVariables:       map[string]*querypb.BindVariable{"foo": sqltypes.StringBindVariable("123")},
@@ -18,6 +18,7 @@ package splitquery
 
 import (
 	"fmt"
+	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -257,3 +258,16 @@ const (
 	startBindVariablePrefix = "_splitquery_start_"
 	endBindVariablePrefix   = "_splitquery_end_"
 )
+
+// IsQueryPart returns true if the bind variables are the ones of a query
+// part generated by a Splitter, i.e. they contain a boundary of the part.
+// The only query part of a query that is not split has no boundary, and
+// is not recognized.
+func IsQueryPart(bindVariables map[string]*querypb.BindVariable) bool {
+	for name := range bindVariables {
+		if strings.HasPrefix(name, startBindVariablePrefix) || strings.HasPrefix(name, endBindVariablePrefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -538,6 +538,37 @@ func TestSplitWithEmptyBoundaryList(t *testing.T) {
 	verifyQueryPartsEqual(t, expected, queryParts)
 }
 
+func TestIsQueryPart(t *testing.T) {
+	splitParams, err := NewSplitParamsGivenSplitCount(
+		&querypb.BoundQuery{
+			Sql:           "select * from test_table where int_col > :foo",
+			BindVariables: map[string]*querypb.BindVariable{"foo": sqltypes.Int64BindVariable(100)},
+		},
+		[]sqlparser.ColIdent{sqlparser.NewColIdent("id")}, /* splitColumns */
+		2,
+		getTestSchema())
+	if err != nil {
+		t.Fatalf("SplitParams.Initialize() failed with: %v", err)
+	}
+	splitter := NewSplitter(splitParams,
+		&FakeSplitAlgorithm{
+			boundaries:   []tuple{{sqltypes.NewInt64(1)}},
+			splitColumns: splitParams.splitColumns,
+		})
+	queryParts, err := splitter.Split()
+	if err != nil {
+		t.Fatalf("Splitter.Split() failed with: %v", err)
+	}
+	for _, queryPart := range queryParts {
+		if !IsQueryPart(queryPart.Query.BindVariables) {
+			t.Errorf("IsQueryPart(%v): false, want true", queryPart.Query.BindVariables)
+		}
+	}
+	if IsQueryPart(splitParams.bindVariables) {
+		t.Errorf("IsQueryPart(%v): true, want false", splitParams.bindVariables)
+	}
+}
+
 func TestWithRealEqualSplits(t *testing.T) {
 	splitParams, err := NewSplitParamsGivenSplitCount(
 		&querypb.BoundQuery{
@@ -39,18 +39,24 @@ func getTestSchema() map[string]*schema.Table {
 	table.AddColumn("uint64_col", sqltypes.Uint64, zero, "")
 	table.AddColumn("float32_col", sqltypes.Float32, zero, "")
 	table.AddColumn("float64_col", sqltypes.Float64, zero, "")
+	table.AddColumn("decimal_col", sqltypes.Decimal, zero, "")
 	table.AddColumn("user_id", sqltypes.Int64, zero, "")
 	table.AddColumn("user_id2", sqltypes.Int64, zero, "")
 	table.AddColumn("id2", sqltypes.Int64, zero, "")
 	table.AddColumn("count", sqltypes.Int64, zero, "")
+	table.AddColumn("id_sum", sqltypes.Int64, zero, "VIRTUAL GENERATED")
+	table.Columns[len(table.Columns)-1].Expression = "(`id` + `id2`)"
 	table.PKColumns = []int{0, 7}
 	addIndexToTable(&table, "PRIMARY", true, "id", "user_id")
 	addIndexToTable(&table, "idx_id2", false, "id2")
 	addIndexToTable(&table, "idx_int64_col", false, "int64_col")
 	addIndexToTable(&table, "idx_uint64_col", false, "uint64_col")
 	addIndexToTable(&table, "idx_float64_col", false, "float64_col")
+	addIndexToTable(&table, "idx_decimal_col", false, "decimal_col")
 	addIndexToTable(&table, "idx_id_user_id", false, "id", "user_id")
 	addIndexToTable(&table, "idx_id_user_id_user_id_2", false, "id", "user_id", "user_id2")
+	table.AddIndex("idx_id_sum", false).AddExpression("(`id`+`id2`)", 12345)
+	table.Done()
 
 	table.SetMysqlStats(
 		sqltypes.NewInt64(1000), /* TableRows */
@@ -70,6 +76,44 @@ func getTestSchema() map[string]*schema.Table {
 	tableNoPK.PKColumns = []int{}
 	result["test_table_no_pk"] = &tableNoPK
 
+	// Views are loaded with their columns, without indexes.
+	view := schema.NewTable("test_view")
+	view.AddColumn("view_id", sqltypes.Int64, zero, "")
+	view.AddColumn("user_id", sqltypes.Int64, zero, "")
+	view.AddColumn("int64_col", sqltypes.Int64, zero, "")
+	view.AddColumn("total", sqltypes.Int64, zero, "")
+	view.ViewInfo = &schema.ViewInfo{
+		Definition: "select `vt_ks`.`test_table`.`id` AS `view_id`,`vt_ks`.`test_table`.`user_id` AS `user_id`," +
+			"`vt_ks`.`test_table`.`int64_col` AS `int64_col`,(`vt_ks`.`test_table`.`id` + `vt_ks`.`test_table`.`id2`) AS `total`" +
+			" from `vt_ks`.`test_table` where (`vt_ks`.`test_table`.`count` > 0)",
+		Database: "vt_ks",
+	}
+	result["test_view"] = view
+
+	viewNoPK := schema.NewTable("test_view_no_pk")
+	viewNoPK.AddColumn("user_id", sqltypes.Int64, zero, "")
+	viewNoPK.ViewInfo = &schema.ViewInfo{
+		Definition: "select `vt_ks`.`test_table`.`user_id` AS `user_id` from `vt_ks`.`test_table`",
+		Database:   "vt_ks",
+	}
+	result["test_view_no_pk"] = viewNoPK
+
+	viewAggregate := schema.NewTable("test_view_aggregate")
+	viewAggregate.AddColumn("id", sqltypes.Int64, zero, "")
+	viewAggregate.ViewInfo = &schema.ViewInfo{
+		Definition: "select max(`vt_ks`.`test_table`.`id`) AS `id` from `vt_ks`.`test_table`",
+		Database:   "vt_ks",
+	}
+	result["test_view_aggregate"] = viewAggregate
+
+	viewOtherDB := schema.NewTable("test_view_other_db")
+	viewOtherDB.AddColumn("id", sqltypes.Int64, zero, "")
+	viewOtherDB.ViewInfo = &schema.ViewInfo{
+		Definition: "select `other`.`test_table`.`id` AS `id` from `other`.`test_table`",
+		Database:   "vt_ks",
+	}
+	result["test_view_other_db"] = viewOtherDB
+
 	return result
 }
 
@@ -0,0 +1,205 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package splitquery
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Queries on a simple view, or on a simple derived table, are split on
+// the keys of the underlying base table. A simple view selects columns
+// from a single table of the database of the tablet, without
+// aggregation. The split-columns are the columns of the view that
+// select the key columns of the base table. The boundaries added to the
+// query are pushed down to the base table only if MySQL merges the view
+// into the query: a view with ALGORITHM=TEMPTABLE, or a derived table
+// when derived_merge is off in the optimizer_switch, is materialized in
+// full by each query part. The query parts are still correct, but they
+// each read the whole base table.
+
+// getSplitTableSchema returns the schema of the table in the FROM clause
+// of the query. For a view or a derived table, it is the schema of the
+// underlying base table, as seen through the view.
+func getSplitTableSchema(
+	aliasedTableExpr *sqlparser.AliasedTableExpr,
+	sql string,
+	schemaMap map[string]*schema.Table,
+) (*schema.Table, error) {
+	switch expr := aliasedTableExpr.Expr.(type) {
+	case *sqlparser.Subquery:
+		if aliasedTableExpr.As.IsEmpty() {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+				"unsupported FROM clause in query (derived table without alias): %v", sql)
+		}
+		return resolveView(aliasedTableExpr.As.String(), expr.Select, "", schemaMap)
+	}
+	tableName := sqlparser.GetTableName(aliasedTableExpr.Expr)
+	if tableName.IsEmpty() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported FROM clause in query"+
+			" (must be a simple table expression): %v", sql)
+	}
+	tableSchema, ok := schemaMap[tableName.String()]
+	if !ok || tableSchema == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "can't find table in schema")
+	}
+	if tableSchema.ViewInfo == nil {
+		return tableSchema, nil
+	}
+	statement, err := sqlparser.Parse(tableSchema.ViewInfo.Definition)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+			"failed parsing the definition of view %v: '%v', err: '%v'",
+			tableName, tableSchema.ViewInfo.Definition, err)
+	}
+	return resolveView(tableName.String(), statement, tableSchema.ViewInfo.Database, schemaMap)
+}
+
+// resolveView returns a schema.Table for the view (or derived table)
+// named 'name' and defined by 'statement'. The base table may only be
+// qualified by 'database', the database of the view, as MySQL stores
+// the definitions of the views: the tables of the other databases are
+// not in the schema of the tablet. Its columns are the columns of
+// the view that select a column of the base table, with their types.
+// Its indexes are the indexes of the base table whose columns are all
+// selected by the view, renamed to the columns of the view. Its number
+// of rows is the one of the base table.
+func resolveView(
+	name string,
+	statement sqlparser.Statement,
+	database string,
+	schemaMap map[string]*schema.Table,
+) (*schema.Table, error) {
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok || sel.Distinct != "" || sel.GroupBy != nil || sel.Having != nil ||
+		len(sel.From) != 1 || sel.Limit != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+			"unsupported view %v: it must select from a single table, without aggregation", name)
+	}
+	aliasedTableExpr, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+			"unsupported view %v: it must select from a single table", name)
+	}
+	baseName, ok := aliasedTableExpr.Expr.(sqlparser.TableName)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+			"unsupported view %v: it must select from a base table", name)
+	}
+	if !baseName.Qualifier.IsEmpty() && baseName.Qualifier.String() != database {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+			"unsupported view %v: its base table %v is not in the database of the tablet", name, sqlparser.String(baseName))
+	}
+	baseTable, ok := schemaMap[baseName.Name.String()]
+	if !ok || baseTable == nil || baseTable.ViewInfo != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+			"can't find the base table %v of view %v in schema", baseName.Name, name)
+	}
+	hasAggregates := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsAggregate() {
+				hasAggregates = true
+				return false, nil
+			}
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, sel.SelectExprs)
+	if hasAggregates {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT,
+			"unsupported view %v: it must select from a single table, without aggregation", name)
+	}
+
+	// viewColumns maps the columns of the base table to the columns
+	// of the view.
+	view := schema.NewTable(name)
+	viewColumns := make(map[string]sqlparser.ColIdent)
+	addColumn := func(baseColumn *schema.TableColumn, viewColumn sqlparser.ColIdent) {
+		if view.FindColumn(viewColumn) != -1 {
+			return
+		}
+		view.AddColumn(viewColumn.String(), baseColumn.Type, sqltypes.NULL, "")
+		if _, ok := viewColumns[baseColumn.Name.Lowered()]; !ok {
+			viewColumns[baseColumn.Name.Lowered()] = viewColumn
+		}
+	}
+	for _, selectExpr := range sel.SelectExprs {
+		switch selectExpr := selectExpr.(type) {
+		case *sqlparser.StarExpr:
+			for i := range baseTable.Columns {
+				addColumn(&baseTable.Columns[i], baseTable.Columns[i].Name)
+			}
+		case *sqlparser.AliasedExpr:
+			colName, ok := selectExpr.Expr.(*sqlparser.ColName)
+			if !ok {
+				continue
+			}
+			i := baseTable.FindColumn(colName.Name)
+			if i == -1 {
+				continue
+			}
+			viewColumn := selectExpr.As
+			if viewColumn.IsEmpty() {
+				viewColumn = colName.Name
+			}
+			addColumn(&baseTable.Columns[i], viewColumn)
+		}
+	}
+
+	for _, index := range baseTable.Indexes {
+		viewIndex := schema.NewIndex(index.Name.String(), index.Unique)
+		for i, column := range index.Columns {
+			viewColumn, ok := viewColumns[column.Lowered()]
+			if !ok {
+				viewIndex = nil
+				break
+			}
+			viewIndex.AddColumn(viewColumn.String(), index.Cardinality[i])
+		}
+		if viewIndex != nil {
+			view.Indexes = append(view.Indexes, viewIndex)
+		}
+	}
+	view.Done()
+	view.TableRows.Set(baseTable.TableRows.Get())
+	view.ViewInfo = &schema.ViewInfo{Definition: sqlparser.String(sel), Database: database}
+	return view, nil
+}
+
+// buildBoundaryFromClause returns the FROM clause of the queries that
+// compute the boundaries of the query parts. For a table, they read its
+// PRIMARY index. For a view or a derived table, which have no index, they
+// read the FROM clause of the query.
+func buildBoundaryFromClause(splitParams *SplitParams) sqlparser.TableExprs {
+	if splitParams.splitTableSchema.ViewInfo == nil {
+		return buildFromClause(splitParams.GetSplitTableName())
+	}
+	aliasedTableExpr := splitParams.selectAST.From[0].(*sqlparser.AliasedTableExpr)
+	return sqlparser.TableExprs{
+		&sqlparser.AliasedTableExpr{
+			Expr: aliasedTableExpr.Expr,
+			As:   aliasedTableExpr.As,
+		},
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package splitquery
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/splitquery/splitquery_testing"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestSplitParamsOnViews(t *testing.T) {
+	testcases := []struct {
+		sql          string
+		splitColumns []string
+		wantColumns  []string
+		wantType     querypb.Type
+		wantErr      string
+	}{{
+		// The primary key of the base table, renamed by the view.
+		sql:         "select * from test_view",
+		wantColumns: []string{"view_id", "user_id"},
+		wantType:    sqltypes.Int64,
+	}, {
+		sql:          "select user_id from test_view where int64_col > 5",
+		splitColumns: []string{"int64_col"},
+		wantColumns:  []string{"int64_col"},
+		wantType:     sqltypes.Int64,
+	}, {
+		sql:          "select * from test_view",
+		splitColumns: []string{"total"},
+		wantErr:      "can't find split column",
+	}, {
+		sql:     "select * from test_view_no_pk",
+		wantErr: "no split columns where given and the queried table has no primary key columns",
+	}, {
+		sql:     "select * from test_view_aggregate",
+		wantErr: "unsupported view test_view_aggregate",
+	}, {
+		sql:         "select * from (select id, user_id as uid from test_table where count > 0) as t",
+		wantColumns: []string{"id", "uid"},
+		wantType:    sqltypes.Int64,
+	}, {
+		sql:          "select * from (select * from test_table) as t",
+		splitColumns: []string{"decimal_col"},
+		wantColumns:  []string{"decimal_col"},
+		wantType:     sqltypes.Decimal,
+	}, {
+		sql:     "select * from (select t1.id from test_table as t1 join test_table as t2) as t",
+		wantErr: "unsupported view t",
+	}, {
+		// The base table of a view is in another database.
+		sql:     "select * from test_view_other_db",
+		wantErr: "unsupported view test_view_other_db: its base table other.test_table is not in the database of the tablet",
+	}, {
+		// Like the tables of the query, the base table of a derived
+		// table must not be qualified.
+		sql:     "select * from (select id from vt_ks.test_table) as t",
+		wantErr: "unsupported view t: its base table vt_ks.test_table is not in the database of the tablet",
+	}}
+	for _, tc := range testcases {
+		var splitColumns []sqlparser.ColIdent
+		for _, c := range tc.splitColumns {
+			splitColumns = append(splitColumns, sqlparser.NewColIdent(c))
+		}
+		splitParams, err := NewSplitParamsGivenNumRowsPerQueryPart(
+			&querypb.BoundQuery{Sql: tc.sql}, splitColumns, 100, getTestSchema())
+		if tc.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("%v: got error %v, want %v", tc.sql, err, tc.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: got error %v", tc.sql, err)
+			continue
+		}
+		var gotColumns []string
+		for _, c := range splitParams.splitColumns {
+			gotColumns = append(gotColumns, c.Name.String())
+		}
+		if !reflect.DeepEqual(gotColumns, tc.wantColumns) {
+			t.Errorf("%v: split columns: %v, want %v", tc.sql, gotColumns, tc.wantColumns)
+		}
+		if got := splitParams.splitColumns[0].Type; got != tc.wantType {
+			t.Errorf("%v: split column type: %v, want %v", tc.sql, got, tc.wantType)
+		}
+		// The number of rows comes from the base table.
+		if splitParams.splitCount != 10 {
+			t.Errorf("%v: split count: %v, want 10", tc.sql, splitParams.splitCount)
+		}
+	}
+}
+
+func TestFullScanOnView(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	splitParams, err := NewSplitParamsGivenNumRowsPerQueryPart(
+		&querypb.BoundQuery{Sql: "select * from test_view where int64_col > 5"},
+		nil, /* splitColumns */
+		1000,
+		getTestSchema(),
+	)
+	if err != nil {
+		t.Fatalf("NewSplitParamsGivenNumRowsPerQueryPart failed with: %v", err)
+	}
+	mockSQLExecuter := splitquery_testing.NewMockSQLExecuter(mockCtrl)
+	// Index hints can't be used on views.
+	mockSQLExecuter.EXPECT().SQLExecute(
+		"select view_id, user_id from test_view"+
+			" order by view_id asc, user_id asc"+
+			" limit 1000, 1",
+		map[string]*querypb.BindVariable{}).Return(
+		&sqltypes.Result{Rows: [][]sqltypes.Value{}}, nil)
+
+	algorithm, err := NewFullScanAlgorithm(splitParams, mockSQLExecuter)
+	if err != nil {
+		t.Fatalf("NewFullScanAlgorithm failed with: %v", err)
+	}
+	boundaries, err := algorithm.generateBoundaries()
+	if err != nil {
+		t.Fatalf("FullScanAlgorithm.generateBoundaries() failed with: %v", err)
+	}
+	if len(boundaries) != 0 {
+		t.Errorf("boundaries: %v, want none", boundaries)
+	}
+}
+
+func TestEqualSplitsOnDerivedTable(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	splitParams, err := NewSplitParamsGivenSplitCount(
+		&querypb.BoundQuery{Sql: "select * from (select id, user_id from test_table) as t"},
+		[]sqlparser.ColIdent{sqlparser.NewColIdent("id")},
+		2,
+		getTestSchema(),
+	)
+	if err != nil {
+		t.Fatalf("NewSplitParamsGivenSplitCount failed with: %v", err)
+	}
+	mockSQLExecuter := splitquery_testing.NewMockSQLExecuter(mockCtrl)
+	mockSQLExecuter.EXPECT().SQLExecute(
+		"select min(id), max(id) from (select id, user_id from test_table) as t",
+		nil /* Bind Variables */).Return(
+		&sqltypes.Result{
+			Rows: [][]sqltypes.Value{{sqltypes.NewInt64(10), sqltypes.NewInt64(60)}},
+		}, nil)
+
+	algorithm, err := NewEqualSplitsAlgorithm(splitParams, mockSQLExecuter)
+	if err != nil {
+		t.Fatalf("NewEqualSplitsAlgorithm failed with: %v", err)
+	}
+	boundaries, err := algorithm.generateBoundaries()
+	if err != nil {
+		t.Fatalf("EqualSplitsAlgorithm.generateBoundaries() failed with: %v", err)
+	}
+	want := []tuple{{sqltypes.NewInt64(35)}}
+	if !reflect.DeepEqual(boundaries, want) {
+		t.Errorf("boundaries: %v, want %v", boundaries, want)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"sync"
+
+	"vitess.io/vitess/go/vt/binlog"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// tableDMLCounts counts the rows changed by DMLs in each table since the
+// tablet started. The DMLs are counted when the tablet executes them,
+// and when the ReplicationWatcher sees them in the replication stream.
+// The writes of a master can be counted twice if the replication stream
+// is watched: the counts are only used to know how much a table changed.
+type tableDMLCounts struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+func newTableDMLCounts() *tableDMLCounts {
+	return &tableDMLCounts{
+		counts: make(map[string]int64),
+	}
+}
+
+// Add adds rows to the count of a table.
+func (tc *tableDMLCounts) Add(table string, rows int64) {
+	if table == "" || rows <= 0 {
+		return
+	}
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.counts[table] += rows
+}
+
+// Get returns the count of a table.
+func (tc *tableDMLCounts) Get(table string) int64 {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	return tc.counts[table]
+}
+
+// AddStatements counts the row changes of the statements of a binlog
+// transaction. Each row of a row-based binlog is a statement.
+func (tc *tableDMLCounts) AddStatements(statements []binlog.FullBinlogStatement) {
+	for _, statement := range statements {
+		switch statement.Statement.Category {
+		case binlogdatapb.BinlogTransaction_Statement_BL_INSERT,
+			binlogdatapb.BinlogTransaction_Statement_BL_UPDATE,
+			binlogdatapb.BinlogTransaction_Statement_BL_DELETE:
+			tc.Add(statement.Table, 1)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"testing"
+
+	"vitess.io/vitess/go/vt/binlog"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func TestTableDMLCounts(t *testing.T) {
+	tc := newTableDMLCounts()
+	tc.Add("t1", 5)
+	tc.Add("t1", 0)
+	tc.Add("", 3)
+	tc.AddStatements([]binlog.FullBinlogStatement{{
+		Statement: &binlogdatapb.BinlogTransaction_Statement{Category: binlogdatapb.BinlogTransaction_Statement_BL_INSERT},
+		Table:     "t1",
+	}, {
+		Statement: &binlogdatapb.BinlogTransaction_Statement{Category: binlogdatapb.BinlogTransaction_Statement_BL_UPDATE},
+		Table:     "t2",
+	}, {
+		Statement: &binlogdatapb.BinlogTransaction_Statement{Category: binlogdatapb.BinlogTransaction_Statement_BL_DELETE},
+		Table:     "t2",
+	}, {
+		Statement: &binlogdatapb.BinlogTransaction_Statement{Category: binlogdatapb.BinlogTransaction_Statement_BL_DDL},
+		Table:     "t2",
+	}})
+	want := map[string]int64{"t1": 6, "t2": 2, "t3": 0, "": 0}
+	for table, count := range want {
+		if got := tc.Get(table); got != count {
+			t.Errorf("Get(%q): %v, want %v", table, got, count)
+		}
+	}
+}
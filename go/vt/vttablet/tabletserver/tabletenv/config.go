@@ -52,6 +52,7 @@ func init() {
 	flag.IntVar(&Config.MessagePoolPrefillParallelism, "queryserver-config-message-conn-pool-prefill-parallelism", DefaultQsConfig.MessagePoolPrefillParallelism, "query server message pool prefill parallelism, a non-zero value will prefill the pool using the specified parallelism")
 	flag.IntVar(&Config.TransactionCap, "queryserver-config-transaction-cap", DefaultQsConfig.TransactionCap, "query server transaction cap is the maximum number of transactions allowed to happen at any given point of a time for a single vttablet. E.g. by setting transaction cap to 100, there are at most 100 transactions will be processed by a vttablet and the 101th transaction will be blocked (and fail if it cannot get connection within specified timeout)")
 	flag.IntVar(&Config.TxPoolPrefillParallelism, "queryserver-config-transaction-prefill-parallelism", DefaultQsConfig.TxPoolPrefillParallelism, "query server transaction prefill parallelism, a non-zero value will prefill the pool using the specified parallism.")
+	flag.IntVar(&Config.PoolMaxCapacityFactor, "queryserver-config-pool-max-capacity-factor", DefaultQsConfig.PoolMaxCapacityFactor, "query server pool max capacity factor, the conn, stream and transaction pools can be resized at runtime up to this multiple of their configured size")
 	flag.IntVar(&Config.MessagePostponeCap, "queryserver-config-message-postpone-cap", DefaultQsConfig.MessagePostponeCap, "query server message postpone cap is the maximum number of messages that can be postponed at any given time. Set this number to substantially lower than transaction cap, so that the transaction pool isn't exhausted by the message subsystem.")
 	flag.IntVar(&Config.FoundRowsPoolSize, "client-found-rows-pool-size", DefaultQsConfig.FoundRowsPoolSize, "size of a special pool that will be used if the client requests that statements be executed with the CLIENT_FOUND_ROWS option of MySQL.")
 	flag.Float64Var(&Config.TransactionTimeout, "queryserver-config-transaction-timeout", DefaultQsConfig.TransactionTimeout, "query server transaction timeout (in seconds), a transaction will be killed if it takes longer than this value")
@@ -61,15 +62,21 @@ func init() {
 	flag.IntVar(&Config.MaxDMLRows, "queryserver-config-max-dml-rows", DefaultQsConfig.MaxDMLRows, "query server max dml rows per statement, maximum number of rows allowed to return at a time for an update or delete with either 1) an equality where clauses on primary keys, or 2) a subselect statement. For update and delete statements in above two categories, vttablet will split the original query into multiple small queries based on this configuration value. ")
 	flag.BoolVar(&Config.PassthroughDMLs, "queryserver-config-passthrough-dmls", DefaultQsConfig.PassthroughDMLs, "query server pass through all dml statements without rewriting")
 	flag.BoolVar(&Config.AllowUnsafeDMLs, "queryserver-config-allowunsafe-dmls", DefaultQsConfig.AllowUnsafeDMLs, "query server allow unsafe dml statements")
+	flag.BoolVar(&Config.SafeDMLs, "queryserver-config-safe-dmls", DefaultQsConfig.SafeDMLs, "If true, the update and delete statements without a limit whose where clause doesn't select their rows by values, or by a range bounded on both sides, of the first column of an index of the table are rejected.")
+	flag.IntVar(&Config.ChunkedDMLRows, "queryserver-config-chunked-dml-rows", DefaultQsConfig.ChunkedDMLRows, "If non-zero, the update and delete statements run outside of a transaction on a table with a single-column primary key, which don't select their rows by primary key values, are run as chunks of at most this many rows, ordered by primary key, each committed in its own transaction. This keeps the replicas from applying a single giant transaction, but a statement that fails leaves the chunks committed before the failure. The chunks are counted in the ChunkedDMLChunks and ChunkedDMLRows stats.")
 
 	flag.IntVar(&Config.StreamBufferSize, "queryserver-config-stream-buffer-size", DefaultQsConfig.StreamBufferSize, "query server stream buffer size, the maximum number of bytes sent from vttablet for each stream call. It's recommended to keep this value in sync with vtgate's stream_buffer_size.")
 	flag.IntVar(&Config.QueryPlanCacheSize, "queryserver-config-query-cache-size", DefaultQsConfig.QueryPlanCacheSize, "query server query cache size, maximum number of queries to be cached. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
+	flag.BoolVar(&Config.ExportQueryPlanStats, "queryserver-config-export-query-plan-stats", DefaultQsConfig.ExportQueryPlanStats, "If true, the query counts, row counts, error counts and p50/p95/p99 latencies of the queries are exported to the stats backend, labeled by query fingerprint. They are kept when the plans are evicted from the cache, and there is one set of metrics per fingerprint ever executed, so this can be expensive with many distinct queries. The statistics of the plan cache are always available with the GetQueryPlanStats RPC.")
 	flag.Float64Var(&Config.SchemaReloadTime, "queryserver-config-schema-reload-time", DefaultQsConfig.SchemaReloadTime, "query server schema reload time, how often vttablet reloads schemas from underlying MySQL instance in seconds. vttablet keeps table schemas in its own memory and periodically refreshes it from MySQL. This config controls the reload time.")
+	flag.BoolVar(&Config.EnablePartitionPruning, "enable_partition_pruning", DefaultQsConfig.EnablePartitionPruning, "If true, the selects that restrict the partitioning column of a RANGE or LIST partitioned table to some values only read the partitions of these values. The partitions are read from MySQL with the schema, and reloaded after the DDLs executed by vttablet: a table repartitioned directly in MySQL can return wrong results until the next schema reload.")
 	flag.Float64Var(&Config.QueryTimeout, "queryserver-config-query-timeout", DefaultQsConfig.QueryTimeout, "query server query timeout (in seconds), this is the query timeout in vttablet side. If a query takes more than this timeout, it will be killed.")
 	flag.Float64Var(&Config.QueryPoolTimeout, "queryserver-config-query-pool-timeout", DefaultQsConfig.QueryPoolTimeout, "query server query pool timeout (in seconds), it is how long vttablet waits for a connection from the query pool. If set to 0 (default) then the overall query timeout is used instead.")
 	flag.Float64Var(&Config.TxPoolTimeout, "queryserver-config-txpool-timeout", DefaultQsConfig.TxPoolTimeout, "query server transaction pool timeout, it is how long vttablet waits if tx pool is full")
 	flag.Float64Var(&Config.IdleTimeout, "queryserver-config-idle-timeout", DefaultQsConfig.IdleTimeout, "query server idle timeout (in seconds), vttablet manages various mysql connection pools. This config means if a connection has not been used in given idle timeout, this connection will be removed from pool. This effectively manages number of connection objects and optimize the pool performance.")
+	flag.Float64Var(&Config.ReservedConnIdleTimeout, "queryserver-config-reserved-conn-idle-timeout", DefaultQsConfig.ReservedConnIdleTimeout, "query server reserved connection idle timeout (in seconds), the reserved connections that are not in a transaction and were not used for longer than this value are released. 0 disables the timeout.")
 	flag.IntVar(&Config.QueryPoolWaiterCap, "queryserver-config-query-pool-waiter-cap", DefaultQsConfig.QueryPoolWaiterCap, "query server query pool waiter limit, this is the maximum number of queries that can be queued waiting to get a connection")
+	flag.IntVar(&Config.QueryPoolLowPriorityWaiterCap, "queryserver-config-query-pool-low-priority-waiter-cap", DefaultQsConfig.QueryPoolLowPriorityWaiterCap, "query server query pool low priority waiter limit, this is the maximum number of LOW priority queries that can be queued waiting to get a connection")
 	flag.IntVar(&Config.TxPoolWaiterCap, "queryserver-config-txpool-waiter-cap", DefaultQsConfig.TxPoolWaiterCap, "query server transaction pool waiter limit, this is the maximum number of transactions that can be queued waiting to get a connection")
 	// tableacl related configurations.
 	flag.BoolVar(&Config.StrictTableACL, "queryserver-config-strict-table-acl", DefaultQsConfig.StrictTableACL, "only allow queries that pass table acl checks")
@@ -100,9 +107,54 @@ func init() {
 	flag.BoolVar(&Config.TransactionLimitByComponent, "transaction_limit_by_component", DefaultQsConfig.TransactionLimitByComponent, "Include CallerID.component when considering who the user is for the purpose of transaction limit.")
 	flag.BoolVar(&Config.TransactionLimitBySubcomponent, "transaction_limit_by_subcomponent", DefaultQsConfig.TransactionLimitBySubcomponent, "Include CallerID.subcomponent when considering who the user is for the purpose of transaction limit.")
 
-	flag.BoolVar(&Config.HeartbeatEnable, "heartbeat_enable", DefaultQsConfig.HeartbeatEnable, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks.")
+	flag.IntVar(&Config.TransactionMaxRows, "queryserver-config-transaction-max-rows", DefaultQsConfig.TransactionMaxRows, "query server transaction max rows, a transaction is rolled back if its statements modify more rows than this value. 0 means no limit.")
+	flag.IntVar(&Config.TransactionWarnRows, "queryserver-config-transaction-warn-rows", DefaultQsConfig.TransactionWarnRows, "query server transaction warn rows, a warning is logged if the statements of a transaction modify more rows than this value. 0 means no warning.")
+	flag.IntVar(&Config.TransactionMaxBytes, "queryserver-config-transaction-max-bytes", DefaultQsConfig.TransactionMaxBytes, "query server transaction max bytes, a transaction is rolled back if the total size of its statements exceeds this value. 0 means no limit.")
+	flag.IntVar(&Config.TransactionWarnBytes, "queryserver-config-transaction-warn-bytes", DefaultQsConfig.TransactionWarnBytes, "query server transaction warn bytes, a warning is logged if the total size of the statements of a transaction exceeds this value. 0 means no warning.")
+	flagutil.StringListVar(&Config.TransactionSizeExemptUsers, "queryserver-config-transaction-size-exempt-users", DefaultQsConfig.TransactionSizeExemptUsers, "A comma-separated list of users whose transactions are not subject to the transaction size limits. A user is either the VTGateCallerID.username or the CallerID.principal.")
+
+	flag.Float64Var(&Config.TransactionIdleThreshold, "queryserver-config-transaction-idle-threshold", DefaultQsConfig.TransactionIdleThreshold, "query server transaction idle threshold (in seconds), the transactions that hold their connection without running any statement for longer than this value are logged with their caller and their statements, and counted by caller in IdleTransactions. 0 disables the transaction watcher.")
+	flag.BoolVar(&Config.TransactionIdleKill, "queryserver-config-transaction-idle-kill", DefaultQsConfig.TransactionIdleKill, "If true, the transactions idle for longer than -queryserver-config-transaction-idle-threshold are rolled back, and their connection is released.")
+
+	flag.IntVar(&Config.LockDiagnosticsErrorThreshold, "lock_diagnostics_error_threshold", DefaultQsConfig.LockDiagnosticsErrorThreshold, "If positive, the lock diagnostics (the InnoDB status and the lock waits of MySQL) are captured automatically when this number of lock wait timeouts and deadlocks happen during -lock_diagnostics_window. The last automatic report is returned by GetLockDiagnostics -last_automatic. 0 disables the automatic capture.")
+	flag.Float64Var(&Config.LockDiagnosticsWindow, "lock_diagnostics_window", DefaultQsConfig.LockDiagnosticsWindow, "The duration in seconds of the window over which the lock errors are counted, and the minimum time between two automatic captures of the lock diagnostics.")
+
+	flag.Int64Var(&Config.InnoDBMaxHistoryListLength, "innodb_health_max_history_list_length", DefaultQsConfig.InnoDBMaxHistoryListLength, "If positive, a master vttablet throttles the new write transactions when the InnoDB history list, the undo logs not purged yet, is longer than this value. A growing fraction of the transactions is rejected above this value, up to all of them at twice this value. 0 disables the threshold.")
+	flag.Int64Var(&Config.InnoDBMaxPurgeLag, "innodb_health_max_purge_lag", DefaultQsConfig.InnoDBMaxPurgeLag, "If positive, a master vttablet throttles the new write transactions when the number of InnoDB transactions whose undo logs are not purged yet is higher than this value. A growing fraction of the transactions is rejected above this value, up to all of them at twice this value. 0 disables the threshold.")
+	flag.DurationVar(&Config.InnoDBHealthCheckInterval, "innodb_health_check_interval", DefaultQsConfig.InnoDBHealthCheckInterval, "How frequently the InnoDB history list length and purge lag are read, if -innodb_health_max_history_list_length or -innodb_health_max_purge_lag is set.")
+
+	flag.BoolVar(&Config.EnableQueryBlocklist, "enable_query_blocklist", DefaultQsConfig.EnableQueryBlocklist, "If true, the MySQL time and the rows of the queries are tracked per query fingerprint, and the fingerprints that use more than -query_blocklist_max_mysql_time or -query_blocklist_max_rows during -query_blocklist_window are blocklisted: their queries are denied. The blocklist is listed and managed at /debug/query_blocklist, and with the GetQueryBlocklist and UpdateQueryBlocklist vtctl commands.")
+	flag.BoolVar(&Config.QueryBlocklistAutomatic, "query_blocklist_automatic", DefaultQsConfig.QueryBlocklistAutomatic, "If true, the runaway query fingerprints are blocklisted as soon as they are detected. Otherwise, they are pending until an operator approves them at /debug/query_blocklist or with UpdateQueryBlocklist.")
+	flag.Float64Var(&Config.QueryBlocklistWindow, "query_blocklist_window", DefaultQsConfig.QueryBlocklistWindow, "The duration in seconds of the sliding window over which the load of each query fingerprint is summed.")
+	flag.Float64Var(&Config.QueryBlocklistMaxMysqlTime, "query_blocklist_max_mysql_time", DefaultQsConfig.QueryBlocklistMaxMysqlTime, "The MySQL time in seconds a query fingerprint can use during the window before it is detected as a runaway pattern. 0 disables the threshold.")
+	flag.Int64Var(&Config.QueryBlocklistMaxRows, "query_blocklist_max_rows", DefaultQsConfig.QueryBlocklistMaxRows, "The number of rows the queries of a fingerprint can return or affect during the window before it is detected as a runaway pattern. If -query_blocklist_rows_examined is set, the rows examined by MySQL are counted instead. 0 disables the threshold.")
+	flag.BoolVar(&Config.QueryBlocklistRowsExamined, "query_blocklist_rows_examined", DefaultQsConfig.QueryBlocklistRowsExamined, "If true, the rows examined by MySQL for each query are read from performance_schema.events_statements_history and counted against -query_blocklist_max_rows, instead of the rows returned or affected. This costs one more round trip to MySQL per query, and needs the events_statements_history consumer of the performance_schema.")
+	flag.IntVar(&Config.QueryBlocklistMaxEntries, "query_blocklist_max_entries", DefaultQsConfig.QueryBlocklistMaxEntries, "The maximum number of runaway query fingerprints blocked or pending at the same time. If more are detected, only the ones using the most MySQL time are kept.")
+	flag.Float64Var(&Config.QueryBlocklistDuration, "query_blocklist_duration", DefaultQsConfig.QueryBlocklistDuration, "The time in seconds the runaway query fingerprints stay blocked. 0 means until an operator removes them. The fingerprints blocked by an operator don't expire.")
+
+	flag.IntVar(&Config.SplitQueryMinMaxCacheThreshold, "queryserver-config-split-query-minmax-cache-threshold", DefaultQsConfig.SplitQueryMinMaxCacheThreshold, "If positive, the minimum and maximum values of the split columns computed by SplitQuery with the EQUAL_SPLITS algorithm are cached until more than this number of rows are changed in their table. The rows changed by replication are only counted if -watch_replication_stream is set. 0 disables the cache.")
+	flag.Float64Var(&Config.SplitQueryThrottleMaxDelay, "queryserver-config-split-query-throttle-max-delay", DefaultQsConfig.SplitQueryThrottleMaxDelay, "The maximum time in seconds a query part generated by SplitQuery waits while the replication lag is too high, before it fails. On a master, the lag is checked by the transaction throttler (see -enable-tx-throttler). On the other tablets, it is the lag of the tablet measured by the heartbeat reader (see -heartbeat_enable). The queries with the SKIP_THROTTLER directive are not throttled. 0 disables the throttling.")
+	flag.Float64Var(&Config.SplitQueryMaxReplicationLag, "queryserver-config-split-query-max-replication-lag", DefaultQsConfig.SplitQueryMaxReplicationLag, "The replication lag in seconds above which the query parts generated by SplitQuery wait. A master checks the lag of the replicas watched by the transaction throttler, the other tablets their own lag.")
+
+	flagutil.StringListVar(&Config.QueryRetryPolicies, "queryserver-config-retry-policies", DefaultQsConfig.QueryRetryPolicies, "A comma-separated list of retry policies, each as <plan type>:<timeout>[/<timeout>...], like PASS_SELECT:1s/5s/20s. The autocommit queries of the plan type that fail with a lock wait timeout or a deadlock are retried, each attempt with the next timeout of the list, so the number of timeouts is the maximum number of attempts. The reads that time out are retried too.")
+
+	flag.BoolVar(&Config.HeartbeatEnable, "heartbeat_enable", DefaultQsConfig.HeartbeatEnable, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks, and replaces the Seconds_Behind_Master of MySQL as the replication lag reported in the health stream (see -enable_replication_reporter).")
 	flag.DurationVar(&Config.HeartbeatInterval, "heartbeat_interval", DefaultQsConfig.HeartbeatInterval, "How frequently to read and write replication heartbeat.")
 
+	flag.BoolVar(&Config.TableGCEnable, "table_gc_enable", DefaultQsConfig.TableGCEnable, "If true, a master vttablet moves the tables renamed into the table GC lifecycle (see ApplySchema -table_gc) through their HOLD, PURGE, EVAC and DROP states, and finally drops them.")
+	flag.DurationVar(&Config.TableGCInterval, "table_gc_interval", DefaultQsConfig.TableGCInterval, "How frequently the tables of the table GC lifecycle are checked for a state transition.")
+	flag.DurationVar(&Config.TableGCHoldPeriod, "table_gc_hold_period", DefaultQsConfig.TableGCHoldPeriod, "How long a dropped table is held as is, and can be renamed back, before its rows are purged.")
+	flag.DurationVar(&Config.TableGCEvacPeriod, "table_gc_evac_period", DefaultQsConfig.TableGCEvacPeriod, "How long a purged table is kept before it is dropped, so its pages are evicted from the buffer pool first.")
+	flag.IntVar(&Config.TableGCPurgeBatchSize, "table_gc_purge_batch_size", DefaultQsConfig.TableGCPurgeBatchSize, "The number of rows deleted by each purge statement of a table in the PURGE state.")
+	flag.DurationVar(&Config.TableGCPurgeInterval, "table_gc_purge_interval", DefaultQsConfig.TableGCPurgeInterval, "How frequently a batch of rows is purged from a table in the PURGE state.")
+
+	flag.BoolVar(&Config.TableAnalyzeEnable, "table_analyze_enable", DefaultQsConfig.TableAnalyzeEnable, "If true, vttablet runs ANALYZE TABLE on the tables whose rows were heavily modified since their index statistics were last computed, according to the InnoDB modification counters. Each tablet analyzes its own MySQL: the statements are not written to the binlog.")
+	flag.DurationVar(&Config.TableAnalyzeInterval, "table_analyze_interval", DefaultQsConfig.TableAnalyzeInterval, "How frequently the modification counters of the tables are checked.")
+	flag.Float64Var(&Config.TableAnalyzeThreshold, "table_analyze_threshold", DefaultQsConfig.TableAnalyzeThreshold, "The fraction of the rows of a table that must be modified for the table to be analyzed.")
+	flag.Int64Var(&Config.TableAnalyzeMinModifications, "table_analyze_min_modifications", DefaultQsConfig.TableAnalyzeMinModifications, "The minimum number of modified rows for a table to be analyzed, so the small tables are not analyzed over and over.")
+	flag.StringVar(&Config.TableAnalyzeWindow, "table_analyze_window", DefaultQsConfig.TableAnalyzeWindow, "The daily low-traffic window the tables are analyzed in, as HH:MM-HH:MM in UTC, like 01:00-05:00. If empty, the tables are analyzed at any time.")
+	flagutil.StringListVar(&Config.TableAnalyzeTables, "table_analyze_tables", DefaultQsConfig.TableAnalyzeTables, "A comma-separated list of per-table settings, each as <table>:<threshold> or <table>:off, like big_table:0.01,log_table:off. They override -table_analyze_threshold.")
+
 	flag.BoolVar(&Config.EnforceStrictTransTables, "enforce_strict_trans_tables", DefaultQsConfig.EnforceStrictTransTables, "If true, vttablet requires MySQL to run with STRICT_TRANS_TABLES or STRICT_ALL_TABLES on. It is recommended to not turn this flag off. Otherwise MySQL may alter your supplied values before saving them to the database.")
 	flag.BoolVar(&Config.EnableConsolidator, "enable-consolidator", DefaultQsConfig.EnableConsolidator, "This option enables the query consolidator.")
 }
@@ -123,6 +175,9 @@ func Init() {
 	if *txLogHandler != "" {
 		TxLogger.ServeLogs(*txLogHandler, streamlog.GetFormatter(TxLogger))
 	}
+
+	initTableACLAuditLog()
+	initSlowQueryLog()
 }
 
 // TabletConfig contains all the configuration for query service
@@ -137,6 +192,7 @@ type TabletConfig struct {
 	MessagePostponeCap            int
 	FoundRowsPoolSize             int
 	TxPoolPrefillParallelism      int
+	PoolMaxCapacityFactor         int
 	TransactionTimeout            float64
 	TxShutDownGracePeriod         float64
 	MaxResultSize                 int
@@ -144,14 +200,19 @@ type TabletConfig struct {
 	MaxDMLRows                    int
 	PassthroughDMLs               bool
 	AllowUnsafeDMLs               bool
+	SafeDMLs                      bool
+	ChunkedDMLRows                int
 	StreamBufferSize              int
 	QueryPlanCacheSize            int
 	SchemaReloadTime              float64
+	EnablePartitionPruning        bool
 	QueryTimeout                  float64
 	QueryPoolTimeout              float64
 	TxPoolTimeout                 float64
 	IdleTimeout                   float64
+	ReservedConnIdleTimeout       float64
 	QueryPoolWaiterCap            int
+	QueryPoolLowPriorityWaiterCap int
 	TxPoolWaiterCap               int
 	StrictTableACL                bool
 	TerseErrors                   bool
@@ -176,9 +237,50 @@ type TabletConfig struct {
 
 	TransactionLimitConfig
 
+	TransactionSizeConfig
+
+	TransactionWatcherConfig
+
+	LockDiagnosticsConfig
+
+	InnoDBHealthConfig
+
+	QueryBlocklistConfig
+
+	// QueryRetryPolicies are the retry policies of the plan types.
+	QueryRetryPolicies []string
+
+	// ExportQueryPlanStats exports the statistics of the queries,
+	// labeled by fingerprint.
+	ExportQueryPlanStats bool
+
+	// SplitQueryMinMaxCacheThreshold is the number of rows changed in a
+	// table that invalidate its cached split column bounds.
+	SplitQueryMinMaxCacheThreshold int
+	// SplitQueryThrottleMaxDelay is the maximum time in seconds a
+	// query part waits for the replication lag to go down.
+	SplitQueryThrottleMaxDelay float64
+	// SplitQueryMaxReplicationLag is the replication lag in seconds
+	// above which the query parts wait.
+	SplitQueryMaxReplicationLag float64
+
 	HeartbeatEnable   bool
 	HeartbeatInterval time.Duration
 
+	TableGCEnable         bool
+	TableGCInterval       time.Duration
+	TableGCHoldPeriod     time.Duration
+	TableGCEvacPeriod     time.Duration
+	TableGCPurgeBatchSize int
+	TableGCPurgeInterval  time.Duration
+
+	TableAnalyzeEnable           bool
+	TableAnalyzeInterval         time.Duration
+	TableAnalyzeThreshold        float64
+	TableAnalyzeMinModifications int64
+	TableAnalyzeWindow           string
+	TableAnalyzeTables           []string
+
 	EnforceStrictTransTables bool
 	EnableConsolidator       bool
 }
@@ -195,6 +297,54 @@ type TransactionLimitConfig struct {
 	TransactionLimitBySubcomponent bool
 }
 
+// QueryBlocklistConfig captures the configuration of the detection and
+// blocklisting of the runaway query fingerprints. The durations are in
+// seconds.
+type QueryBlocklistConfig struct {
+	EnableQueryBlocklist       bool
+	QueryBlocklistAutomatic    bool
+	QueryBlocklistWindow       float64
+	QueryBlocklistMaxMysqlTime float64
+	QueryBlocklistMaxRows      int64
+	QueryBlocklistRowsExamined bool
+	QueryBlocklistMaxEntries   int
+	QueryBlocklistDuration     float64
+}
+
+// TransactionSizeConfig captures the limits on the size of the
+// transactions, which is the number of rows modified by their statements
+// and the total size of their statements.
+type TransactionSizeConfig struct {
+	TransactionMaxRows         int
+	TransactionWarnRows        int
+	TransactionMaxBytes        int
+	TransactionWarnBytes       int
+	TransactionSizeExemptUsers []string
+}
+
+// TransactionWatcherConfig captures the configuration of the watcher
+// of the idle transactions. The threshold is in seconds.
+type TransactionWatcherConfig struct {
+	TransactionIdleThreshold float64
+	TransactionIdleKill      bool
+}
+
+// LockDiagnosticsConfig captures the configuration of the automatic
+// capture of the lock diagnostics. The window is in seconds.
+type LockDiagnosticsConfig struct {
+	LockDiagnosticsErrorThreshold int
+	LockDiagnosticsWindow         float64
+}
+
+// InnoDBHealthConfig captures the thresholds of the InnoDB purge above
+// which the write transactions are throttled. A threshold of 0 is
+// disabled.
+type InnoDBHealthConfig struct {
+	InnoDBMaxHistoryListLength int64
+	InnoDBMaxPurgeLag          int64
+	InnoDBHealthCheckInterval  time.Duration
+}
+
 // DefaultQsConfig is the default value for the query service config.
 // The value for StreamBufferSize was chosen after trying out a few of
 // them. Too small buffers force too many packets to be sent. Too big
@@ -213,6 +363,7 @@ var DefaultQsConfig = TabletConfig{
 	MessagePostponeCap:            4,
 	FoundRowsPoolSize:             20,
 	TxPoolPrefillParallelism:      0,
+	PoolMaxCapacityFactor:         1,
 	TransactionTimeout:            30,
 	TxShutDownGracePeriod:         0,
 	MaxResultSize:                 10000,
@@ -220,13 +371,18 @@ var DefaultQsConfig = TabletConfig{
 	MaxDMLRows:                    500,
 	PassthroughDMLs:               false,
 	AllowUnsafeDMLs:               false,
+	SafeDMLs:                      false,
+	ChunkedDMLRows:                0,
 	QueryPlanCacheSize:            5000,
 	SchemaReloadTime:              30 * 60,
+	EnablePartitionPruning:        false,
 	QueryTimeout:                  30,
 	QueryPoolTimeout:              0,
 	TxPoolTimeout:                 1,
 	IdleTimeout:                   30 * 60,
+	ReservedConnIdleTimeout:       30 * 60,
 	QueryPoolWaiterCap:            50000,
+	QueryPoolLowPriorityWaiterCap: 5000,
 	TxPoolWaiterCap:               50000,
 	StreamBufferSize:              32 * 1024,
 	StrictTableACL:                false,
@@ -255,9 +411,47 @@ var DefaultQsConfig = TabletConfig{
 
 	TransactionLimitConfig: defaultTransactionLimitConfig(),
 
+	TransactionSizeConfig: TransactionSizeConfig{
+		TransactionSizeExemptUsers: []string{},
+	},
+
+	LockDiagnosticsConfig: LockDiagnosticsConfig{
+		LockDiagnosticsWindow: 60,
+	},
+
+	InnoDBHealthConfig: InnoDBHealthConfig{
+		InnoDBHealthCheckInterval: 5 * time.Second,
+	},
+
+	QueryBlocklistConfig: QueryBlocklistConfig{
+		QueryBlocklistWindow:       60,
+		QueryBlocklistMaxMysqlTime: 60,
+		QueryBlocklistMaxEntries:   10,
+		QueryBlocklistDuration:     600,
+	},
+
+	QueryRetryPolicies: []string{},
+
+	SplitQueryThrottleMaxDelay:  60,
+	SplitQueryMaxReplicationLag: 30,
+
 	HeartbeatEnable:   false,
 	HeartbeatInterval: 1 * time.Second,
 
+	TableGCEnable:         false,
+	TableGCInterval:       1 * time.Hour,
+	TableGCHoldPeriod:     72 * time.Hour,
+	TableGCEvacPeriod:     24 * time.Hour,
+	TableGCPurgeBatchSize: 1000,
+	TableGCPurgeInterval:  1 * time.Second,
+
+	TableAnalyzeEnable:           false,
+	TableAnalyzeInterval:         10 * time.Minute,
+	TableAnalyzeThreshold:        0.1,
+	TableAnalyzeMinModifications: 10000,
+	TableAnalyzeWindow:           "",
+	TableAnalyzeTables:           []string{},
+
 	EnforceStrictTransTables: true,
 	EnableConsolidator:       true,
 }
@@ -321,6 +515,20 @@ func (c *TabletConfig) verifyTransactionLimitConfig() error {
 	return nil
 }
 
+// verifyTransactionSizeConfig checks TransactionSizeConfig for sanity
+func (c *TabletConfig) verifyTransactionSizeConfig() error {
+	if c.TransactionMaxRows < 0 || c.TransactionWarnRows < 0 || c.TransactionMaxBytes < 0 || c.TransactionWarnBytes < 0 {
+		return errors.New("the transaction size limits must be >= 0")
+	}
+	if c.TransactionMaxRows != 0 && c.TransactionWarnRows > c.TransactionMaxRows {
+		return fmt.Errorf("-queryserver-config-transaction-warn-rows (%v) must be lower than -queryserver-config-transaction-max-rows (%v)", c.TransactionWarnRows, c.TransactionMaxRows)
+	}
+	if c.TransactionMaxBytes != 0 && c.TransactionWarnBytes > c.TransactionMaxBytes {
+		return fmt.Errorf("-queryserver-config-transaction-warn-bytes (%v) must be lower than -queryserver-config-transaction-max-bytes (%v)", c.TransactionWarnBytes, c.TransactionMaxBytes)
+	}
+	return nil
+}
+
 // Config contains all the current config values. It's read-only,
 // except for tests.
 var Config TabletConfig
@@ -330,6 +538,18 @@ func VerifyConfig() error {
 	if err := Config.verifyTransactionLimitConfig(); err != nil {
 		return err
 	}
+	if err := Config.verifyTransactionSizeConfig(); err != nil {
+		return err
+	}
+	if Config.TransactionIdleThreshold < 0 {
+		return errors.New("-queryserver-config-transaction-idle-threshold must be >= 0")
+	}
+	if Config.ReservedConnIdleTimeout < 0 {
+		return errors.New("-queryserver-config-reserved-conn-idle-timeout must be >= 0")
+	}
+	if Config.LockDiagnosticsErrorThreshold > 0 && Config.LockDiagnosticsWindow <= 0 {
+		return errors.New("-lock_diagnostics_window must be > 0")
+	}
 	if actual, dryRun := Config.EnableHotRowProtection, Config.EnableHotRowProtectionDryRun; actual && dryRun {
 		return errors.New("only one of two flags allowed: -enable_hot_row_protection or -enable_hot_row_protection_dry_run")
 	}
@@ -345,5 +565,16 @@ func VerifyConfig() error {
 	if v := Config.HotRowProtectionConcurrentTransactions; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if (Config.InnoDBMaxHistoryListLength > 0 || Config.InnoDBMaxPurgeLag > 0) && Config.InnoDBHealthCheckInterval <= 0 {
+		return errors.New("-innodb_health_check_interval must be > 0")
+	}
+	if Config.EnableQueryBlocklist {
+		if v := Config.QueryBlocklistWindow; v <= 0 {
+			return fmt.Errorf("-query_blocklist_window must be > 0 (specified value: %v)", v)
+		}
+		if v := Config.QueryBlocklistMaxEntries; v <= 0 {
+			return fmt.Errorf("-query_blocklist_max_entries must be > 0 (specified value: %v)", v)
+		}
+	}
 	return nil
 }
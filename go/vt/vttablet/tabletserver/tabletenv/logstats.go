@@ -76,6 +76,7 @@ func NewLogStats(ctx context.Context, methodName string) *LogStats {
 func (stats *LogStats) Send() {
 	stats.EndTime = time.Now()
 	StatsLogger.Send(stats)
+	logSlowQuery(stats)
 }
 
 // Context returns the context used by LogStats.
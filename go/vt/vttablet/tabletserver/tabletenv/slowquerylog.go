@@ -0,0 +1,216 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletenv
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/log"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// The redaction policies of the bind variables of the slow query log.
+const (
+	// SlowQueryBindVarsFull logs the values of the bind variables.
+	SlowQueryBindVarsFull = "full"
+	// SlowQueryBindVarsTruncated logs the numeric values, and only the
+	// length of the other values.
+	SlowQueryBindVarsTruncated = "truncated"
+	// SlowQueryBindVarsRedacted logs the names and the types of the
+	// bind variables, but not their values.
+	SlowQueryBindVarsRedacted = "redacted"
+	// SlowQueryBindVarsNone doesn't log the bind variables.
+	SlowQueryBindVarsNone = "none"
+
+	redactedValue = "[REDACTED]"
+)
+
+var (
+	slowQueryLogThreshold     = flag.Duration("slow-query-log-threshold", 0, "The queries that take longer than this duration are logged to the slow query log. 0 disables the slow query log.")
+	slowQueryLogHandler       = flag.String("slow-query-log-stream-handler", "/debug/slowquerylog", "URL handler for streaming the slow query log")
+	slowQueryLogFile          = flag.String("slow-query-log-file", "", "If set, the slow query log is also written to this file, as JSON lines")
+	slowQueryLogBindVariables = flag.String("slow-query-log-bind-variables", SlowQueryBindVarsRedacted, "How the bind variables are logged in the slow query log: full, truncated (only the numeric values), redacted (only the names and types) or none")
+	slowQueryLogRedactedNames flagutil.StringListValue
+
+	// SlowQueryLogger logs the queries that take longer than
+	// -slow-query-log-threshold.
+	SlowQueryLogger = streamlog.New("SlowQueryLog", 50)
+)
+
+func init() {
+	flag.Var(&slowQueryLogRedactedNames, "slow-query-log-redacted-bind-variables", "A comma-separated list of bind variable names whose values are always redacted from the slow query log, whatever -slow-query-log-bind-variables is")
+}
+
+// SlowQueryBindVariable is a bind variable as logged in the slow query log.
+// The value is omitted or redacted according to the redaction policy.
+type SlowQueryBindVariable struct {
+	Type  string
+	Value string `json:",omitempty"`
+}
+
+// SlowQueryEntry is an entry of the slow query log.
+type SlowQueryEntry struct {
+	Time            time.Time
+	Method          string
+	Username        string
+	ImmediateCaller string
+	EffectiveCaller string
+	Keyspace        string `json:",omitempty"`
+	Shard           string `json:",omitempty"`
+	TabletType      string `json:",omitempty"`
+	PlanType        string
+	OriginalSQL     string
+	BindVariables   map[string]SlowQueryBindVariable `json:",omitempty"`
+	TotalTime       float64
+	MysqlTime       float64
+	ConnWaitTime    float64
+	RowsAffected    int
+	RowsReturned    int
+	TransactionID   int64  `json:",omitempty"`
+	Error           string `json:",omitempty"`
+}
+
+// Logf writes the entry to the given writer as a line of JSON.
+// The slow query log is always formatted as JSON.
+func (e *SlowQueryEntry) Logf(w io.Writer, params url.Values) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+// newSlowQueryEntry builds the slow query log entry of the query.
+// The bind variables are redacted here, so the entry never holds
+// more than the policy allows.
+func newSlowQueryEntry(stats *LogStats, policy string, redactedNames []string) *SlowQueryEntry {
+	_, username := stats.CallInfo()
+	e := &SlowQueryEntry{
+		Time:            stats.StartTime,
+		Method:          stats.Method,
+		Username:        username,
+		ImmediateCaller: stats.ImmediateCaller(),
+		EffectiveCaller: stats.EffectiveCaller(),
+		PlanType:        stats.PlanType,
+		OriginalSQL:     stats.OriginalSQL,
+		BindVariables:   redactBindVariables(stats.BindVariables, policy, redactedNames),
+		TotalTime:       stats.TotalTime().Seconds(),
+		MysqlTime:       stats.MysqlResponseTime.Seconds(),
+		ConnWaitTime:    stats.WaitingForConnection.Seconds(),
+		RowsAffected:    stats.RowsAffected,
+		RowsReturned:    len(stats.Rows),
+		TransactionID:   stats.TransactionID,
+		Error:           stats.ErrorStr(),
+	}
+	if stats.Target != nil {
+		e.Keyspace = stats.Target.Keyspace
+		e.Shard = stats.Target.Shard
+		e.TabletType = stats.Target.TabletType.String()
+	}
+	return e
+}
+
+// redactBindVariables applies the redaction policy to the bind variables.
+// The bind variables named in redactedNames are always redacted.
+func redactBindVariables(bindVariables map[string]*querypb.BindVariable, policy string, redactedNames []string) map[string]SlowQueryBindVariable {
+	if policy == SlowQueryBindVarsNone || len(bindVariables) == 0 {
+		return nil
+	}
+	out := make(map[string]SlowQueryBindVariable, len(bindVariables))
+	for name, bv := range bindVariables {
+		out[name] = redactBindVariable(bv, policy)
+	}
+	for _, name := range redactedNames {
+		if bv, ok := out[name]; ok {
+			bv.Value = redactedValue
+			out[name] = bv
+		}
+	}
+	return out
+}
+
+func redactBindVariable(bv *querypb.BindVariable, policy string) SlowQueryBindVariable {
+	sbv := SlowQueryBindVariable{Type: bv.Type.String()}
+	switch policy {
+	case SlowQueryBindVarsFull:
+		if bv.Type == querypb.Type_TUPLE {
+			values := make([]string, len(bv.Values))
+			for i, v := range bv.Values {
+				values[i] = string(v.Value)
+			}
+			data, _ := json.Marshal(values)
+			sbv.Value = string(data)
+		} else {
+			sbv.Value = string(bv.Value)
+		}
+	case SlowQueryBindVarsTruncated:
+		switch {
+		case sqltypes.IsIntegral(bv.Type) || sqltypes.IsFloat(bv.Type):
+			sbv.Value = string(bv.Value)
+		case bv.Type == querypb.Type_TUPLE:
+			sbv.Value = fmt.Sprintf("%v items", len(bv.Values))
+		default:
+			sbv.Value = fmt.Sprintf("%v bytes", len(bv.Value))
+		}
+	default:
+		sbv.Value = redactedValue
+	}
+	return sbv
+}
+
+// logSlowQuery sends the query to the slow query log if it took
+// longer than the threshold.
+func logSlowQuery(stats *LogStats) {
+	if *slowQueryLogThreshold <= 0 || stats.TotalTime() < *slowQueryLogThreshold {
+		return
+	}
+	if !streamlog.ShouldEmitLog(stats.OriginalSQL) {
+		return
+	}
+	SlowQueryLogger.Send(newSlowQueryEntry(stats, *slowQueryLogBindVariables, slowQueryLogRedactedNames))
+}
+
+// initSlowQueryLog serves the slow query log, and writes it to a file
+// if requested.
+func initSlowQueryLog() {
+	switch *slowQueryLogBindVariables {
+	case SlowQueryBindVarsFull, SlowQueryBindVarsTruncated, SlowQueryBindVarsRedacted, SlowQueryBindVarsNone:
+	default:
+		log.Exitf("Invalid slow-query-log-bind-variables value %v: must be one of full, truncated, redacted or none", *slowQueryLogBindVariables)
+	}
+	if *slowQueryLogThreshold <= 0 {
+		return
+	}
+	if *slowQueryLogHandler != "" {
+		SlowQueryLogger.ServeLogs(*slowQueryLogHandler, streamlog.GetFormatter(SlowQueryLogger))
+	}
+	if *slowQueryLogFile != "" {
+		if _, err := SlowQueryLogger.LogToFile(*slowQueryLogFile, streamlog.GetFormatter(SlowQueryLogger)); err != nil {
+			log.Exitf("Cannot log the slow query log to %v: %v", *slowQueryLogFile, err)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletenv
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestRedactBindVariables(t *testing.T) {
+	bindVars := map[string]*querypb.BindVariable{
+		"id":       sqltypes.Int64BindVariable(1),
+		"name":     sqltypes.StringBindVariable("alice"),
+		"password": sqltypes.StringBindVariable("secret"),
+		"ids":      sqltypes.TestBindVariable([]interface{}{1, 2}),
+	}
+	testcases := []struct {
+		policy string
+		want   map[string]SlowQueryBindVariable
+	}{{
+		policy: SlowQueryBindVarsFull,
+		want: map[string]SlowQueryBindVariable{
+			"id":       {Type: "INT64", Value: "1"},
+			"name":     {Type: "VARCHAR", Value: "alice"},
+			"password": {Type: "VARCHAR", Value: redactedValue},
+			"ids":      {Type: "TUPLE", Value: `["1","2"]`},
+		},
+	}, {
+		policy: SlowQueryBindVarsTruncated,
+		want: map[string]SlowQueryBindVariable{
+			"id":       {Type: "INT64", Value: "1"},
+			"name":     {Type: "VARCHAR", Value: "5 bytes"},
+			"password": {Type: "VARCHAR", Value: redactedValue},
+			"ids":      {Type: "TUPLE", Value: "2 items"},
+		},
+	}, {
+		policy: SlowQueryBindVarsRedacted,
+		want: map[string]SlowQueryBindVariable{
+			"id":       {Type: "INT64", Value: redactedValue},
+			"name":     {Type: "VARCHAR", Value: redactedValue},
+			"password": {Type: "VARCHAR", Value: redactedValue},
+			"ids":      {Type: "TUPLE", Value: redactedValue},
+		},
+	}, {
+		policy: SlowQueryBindVarsNone,
+		want:   nil,
+	}}
+	for _, tc := range testcases {
+		got := redactBindVariables(bindVars, tc.policy, []string{"password", "unknown"})
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("redactBindVariables(%v): %v, want %v", tc.policy, got, tc.want)
+		}
+	}
+}
+
+func TestSlowQueryLog(t *testing.T) {
+	defer func(threshold time.Duration, policy string) {
+		*slowQueryLogThreshold = threshold
+		*slowQueryLogBindVariables = policy
+	}(*slowQueryLogThreshold, *slowQueryLogBindVariables)
+	*slowQueryLogThreshold = time.Second
+	*slowQueryLogBindVariables = SlowQueryBindVarsRedacted
+
+	ch := SlowQueryLogger.Subscribe("test")
+	defer SlowQueryLogger.Unsubscribe(ch)
+
+	logStats := NewLogStats(context.Background(), "Execute")
+	logStats.Target = &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_REPLICA}
+	logStats.PlanType = "PASS_SELECT"
+	logStats.OriginalSQL = "select * from t1 where name = :name"
+	logStats.BindVariables = map[string]*querypb.BindVariable{"name": sqltypes.StringBindVariable("alice")}
+	logStats.Rows = [][]sqltypes.Value{{sqltypes.NewVarBinary("a")}, {sqltypes.NewVarBinary("b")}}
+
+	// A fast query is not logged.
+	logStats.Send()
+	select {
+	case got := <-ch:
+		t.Fatalf("fast query was logged: %v", got)
+	default:
+	}
+
+	logStats.StartTime = time.Now().Add(-2 * time.Second)
+	logStats.Send()
+	var got interface{}
+	select {
+	case got = <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow query was not logged")
+	}
+	entry := got.(*SlowQueryEntry)
+	var b bytes.Buffer
+	if err := entry.Logf(&b, nil); err != nil {
+		t.Fatal(err)
+	}
+	var logged map[string]interface{}
+	if err := json.Unmarshal(b.Bytes(), &logged); err != nil {
+		t.Fatalf("cannot parse %v: %v", b.String(), err)
+	}
+	for key, want := range map[string]interface{}{
+		"Method":       "Execute",
+		"Keyspace":     "ks",
+		"Shard":        "-80",
+		"TabletType":   "REPLICA",
+		"PlanType":     "PASS_SELECT",
+		"OriginalSQL":  "select * from t1 where name = :name",
+		"RowsReturned": float64(2),
+	} {
+		if logged[key] != want {
+			t.Errorf("%v: %v, want %v", key, logged[key], want)
+		}
+	}
+	if bytes.Contains(b.Bytes(), []byte("alice")) {
+		t.Errorf("the bind variable value was not redacted: %v", b.String())
+	}
+	if logged["TotalTime"].(float64) < 2 {
+		t.Errorf("TotalTime: %v, want at least 2", logged["TotalTime"])
+	}
+}
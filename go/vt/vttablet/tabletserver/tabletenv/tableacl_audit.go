@@ -0,0 +1,104 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletenv
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	tableACLAuditLogHandler = flag.String("table-acl-audit-log-stream-handler", "/debug/tableacl_audit", "URL handler for streaming the table acl audit log")
+	tableACLAuditLogFile    = flag.String("table-acl-audit-log-file", "", "If set, the table acl audit log is also written to this file")
+
+	// TableACLAuditLogger logs the table acl denials, and the
+	// decisions made for the admin statements.
+	TableACLAuditLogger = streamlog.New("TableACLAudit", 50)
+)
+
+// The decisions logged in the table acl audit log.
+const (
+	// TableACLAllowed means the statement was allowed.
+	TableACLAllowed = "ALLOWED"
+	// TableACLDenied means the statement was rejected.
+	TableACLDenied = "DENIED"
+	// TableACLPseudoDenied means the statement would have been rejected,
+	// but the table acl is only checked in dry run mode, or not strict.
+	TableACLPseudoDenied = "PSEUDO_DENIED"
+	// TableACLExempt means the statement was allowed because
+	// the caller is exempt from the table acl checks.
+	TableACLExempt = "EXEMPT"
+)
+
+// TableACLAuditEntry is an entry of the table acl audit log.
+type TableACLAuditEntry struct {
+	Time        time.Time
+	Username    string
+	Groups      []string
+	Table       string
+	TableGroup  string
+	Role        string
+	PlanType    string
+	Fingerprint string
+	Decision    string
+}
+
+// Logf formats the entry to the given writer, either as a tab-separated
+// list of fields or as JSON.
+func (e *TableACLAuditEntry) Logf(w io.Writer, params url.Values) error {
+	var fmtString string
+	switch *streamlog.QueryLogFormat {
+	case streamlog.QueryLogFormatText:
+		fmtString = "%v\t%q\t%q\t%q\t%q\t%v\t%v\t%q\t%v\n"
+	case streamlog.QueryLogFormatJSON:
+		fmtString = "{\"Time\": \"%v\", \"Username\": %q, \"Groups\": %q, \"Table\": %q, \"TableGroup\": %q, \"Role\": %q, \"PlanType\": %q, \"Fingerprint\": %q, \"Decision\": %q}\n"
+	}
+	_, err := fmt.Fprintf(
+		w,
+		fmtString,
+		e.Time.Format("2006-01-02 15:04:05.000000"),
+		e.Username,
+		strings.Join(e.Groups, ","),
+		e.Table,
+		e.TableGroup,
+		e.Role,
+		e.PlanType,
+		e.Fingerprint,
+		e.Decision,
+	)
+	return err
+}
+
+// initTableACLAuditLog serves the table acl audit log, and writes it
+// to a file if requested.
+func initTableACLAuditLog() {
+	if *tableACLAuditLogHandler != "" {
+		TableACLAuditLogger.ServeLogs(*tableACLAuditLogHandler, streamlog.GetFormatter(TableACLAuditLogger))
+	}
+	if *tableACLAuditLogFile != "" {
+		if _, err := TableACLAuditLogger.LogToFile(*tableACLAuditLogFile, streamlog.GetFormatter(TableACLAuditLogger)); err != nil {
+			log.Exitf("Cannot log the table acl audit log to %v: %v", *tableACLAuditLogFile, err)
+		}
+	}
+}
@@ -92,6 +92,55 @@ var (
 		"UserTransactionTimesNs",
 		"Total transaction latency for each CallerID",
 		[]string{"CallerID", "Conclusion"})
+	// LargeTransactions counts the transactions that exceeded the
+	// transaction size thresholds for each CallerID.
+	LargeTransactions = stats.NewCountersWithMultiLabels(
+		"LargeTransactions",
+		"Transactions exceeding the transaction size thresholds for each CallerID",
+		[]string{"CallerID", "Action"})
+	// IdleTransactions counts the transactions that were idle for longer
+	// than the transaction watcher threshold for each CallerID.
+	IdleTransactions = stats.NewCountersWithMultiLabels(
+		"IdleTransactions",
+		"Transactions idle for longer than the transaction watcher threshold for each CallerID",
+		[]string{"CallerID", "Action"})
+	// CurrentIdleTransactions is the number of transactions currently
+	// idle for longer than the transaction watcher threshold for each
+	// CallerID.
+	CurrentIdleTransactions = stats.NewGaugesWithSingleLabel(
+		"CurrentIdleTransactions",
+		"Transactions currently idle for longer than the transaction watcher threshold for each CallerID",
+		"CallerID")
+	// QueryHints counts the queries that were executed with the
+	// optimizer hints of a query rule, for each table.
+	QueryHints = stats.NewCountersWithSingleLabel("QueryHints", "Queries executed with the optimizer hints of a query rule", "table")
+	// PartitionPrunings counts the queries that only read the
+	// partitions of the values of their partitioning column, for
+	// each table.
+	PartitionPrunings = stats.NewCountersWithSingleLabel("PartitionPrunings", "Queries that only read some partitions of their table", "table")
+	// ChunkedDMLChunks counts the chunks committed by the chunked
+	// updates and deletes, for each table.
+	ChunkedDMLChunks = stats.NewCountersWithSingleLabel("ChunkedDMLChunks", "Chunks committed by the chunked updates and deletes", "table")
+	// ChunkedDMLRows counts the rows changed by the chunked updates
+	// and deletes, for each table.
+	ChunkedDMLRows = stats.NewCountersWithSingleLabel("ChunkedDMLRows", "Rows changed by the chunked updates and deletes", "table")
+	// QueryRetries counts the retries of the queries that failed
+	// with a transient error, for each plan type and error.
+	QueryRetries = stats.NewCountersWithMultiLabels(
+		"QueryRetries",
+		"Retries of the queries that failed with a transient error",
+		[]string{"Plan", "Reason"})
+	// QueryRetryResults counts the outcomes of the queries that have a
+	// retry policy, to distinguish the queries that succeeded on the
+	// first try from the ones that needed retries.
+	QueryRetryResults = stats.NewCountersWithMultiLabels(
+		"QueryRetryResults",
+		"Outcomes of the queries that have a retry policy",
+		[]string{"Plan", "Result"})
+	// SplitQueryThrottleDelays records how long the query parts generated
+	// by SplitQuery waited for the replication lag to go down, for each
+	// outcome of the wait.
+	SplitQueryThrottleDelays = stats.NewTimings("SplitQueryThrottleDelays", "Delays of the SplitQuery query parts waiting for the replication lag", "Result")
 	// ResultStats shows the histogram of number of rows returned.
 	ResultStats = stats.NewHistogram("Results",
 		"Distribution of rows returned",
@@ -29,6 +29,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/history"
@@ -39,6 +40,7 @@ import (
 	"vitess.io/vitess/go/tb"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/binlog"
+	"vitess.io/vitess/go/vt/binlog/consumerthrottler"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/dbconnpool"
@@ -46,15 +48,20 @@ import (
 	"vitess.io/vitess/go/vt/logutil"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tableaclpb "vitess.io/vitess/go/vt/proto/tableacl"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/heartbeat"
+	"vitess.io/vitess/go/vt/vttablet/innodbhealth"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tableanalyze"
+	"vitess.io/vitess/go/vt/vttablet/tablegc"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/messager"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
@@ -92,6 +99,10 @@ var logPoolFull = logutil.NewThrottledLogger("PoolFull", 1*time.Minute)
 
 var logComputeRowSerializerKey = logutil.NewThrottledLogger("ComputeRowSerializerKey", 1*time.Minute)
 
+// tableACLWatchRetryDelay is how long to wait before watching the
+// table ACL of the keyspace again after the watch failed.
+var tableACLWatchRetryDelay = 30 * time.Second
+
 // stateName names every state. The number of elements must
 // match the number of states. Names can overlap.
 var stateName = []string{
@@ -169,6 +180,8 @@ type TabletServer struct {
 	hw               *heartbeat.Writer
 	hr               *heartbeat.Reader
 	messager         *messager.Engine
+	tableGC          *tablegc.TableGC
+	tableAnalyzer    *tableanalyze.TableAnalyzer
 	watcher          *ReplicationWatcher
 	vstreamer        *vstreamer.Engine
 	updateStreamList *binlog.StreamList
@@ -181,6 +194,31 @@ type TabletServer struct {
 	txThrottler *txthrottler.TxThrottler
 	topoServer  *topo.Server
 
+	// innodbHealth throttles the write transactions while the InnoDB
+	// purge is behind.
+	innodbHealth *innodbhealth.Monitor
+
+	// splitQueryThrottler delays the SplitQuery query parts while the
+	// replication lag is higher than splitQueryMaxReplicationLag.
+	splitQueryThrottler         *splitQueryThrottler
+	splitQueryMaxReplicationLag time.Duration
+
+	// lockDiag captures the lock diagnostics when the lock errors spike.
+	lockDiag *lockDiagnostics
+
+	// tableACLConfigFile is the file of the table ACL, reloaded on SIGHUP.
+	tableACLConfigFile sync2.AtomicString
+
+	// runtimeFlags are the flags of the tablet server that can be
+	// changed at runtime.
+	runtimeFlags *servenv.RuntimeFlagSet
+
+	// draining is set when the process enters lameduck mode. The
+	// tablet is then reported as not serving and rejects the new
+	// transactions, while the open ones drain. Unlike lameduck, it's
+	// not cleared by SetServingType.
+	draining sync2.AtomicBool
+
 	// streamHealthMutex protects all the following fields
 	streamHealthMutex          sync.Mutex
 	streamHealthIndex          int
@@ -248,6 +286,9 @@ type TxPoolController interface {
 
 	// Rollback rolls back the specified transaction.
 	Rollback(ctx context.Context, transactionID int64) error
+
+	// Release releases the specified reserved connection.
+	Release(ctx context.Context, reservedID int64) error
 }
 
 var tsOnce sync.Once
@@ -278,9 +319,14 @@ func NewTabletServer(config tabletenv.TabletConfig, topoServer *topo.Server, ali
 	tsv.teCtrl = tsv.te
 	tsv.hw = heartbeat.NewWriter(tsv, alias, config)
 	tsv.hr = heartbeat.NewReader(tsv, config)
+	tsv.tableGC = tablegc.NewTableGC(tsv, config)
+	tsv.tableAnalyzer = tableanalyze.NewTableAnalyzer(tsv, config)
 	tsv.txThrottler = txthrottler.CreateTxThrottlerFromTabletConfig(topoServer)
-	tsv.messager = messager.NewEngine(tsv, tsv.se, config)
-	tsv.watcher = NewReplicationWatcher(tsv.se, config)
+	tsv.innodbHealth = innodbhealth.NewMonitor(tsv, config)
+	tsv.splitQueryThrottler = newSplitQueryThrottler(config, tsv.splitQueryThrottled)
+	tsv.splitQueryMaxReplicationLag = time.Duration(config.SplitQueryMaxReplicationLag * 1e9)
+	tsv.lockDiag = newLockDiagnostics(config.LockDiagnosticsConfig)
+	tsv.watcher = NewReplicationWatcher(tsv.se, tsv.qe.dmlCounts, config)
 	tsv.updateStreamList = &binlog.StreamList{}
 	// FIXME(alainjobart) could we move this to the Register method below?
 	// So that vtcombo doesn't even call it once, on the first tablet.
@@ -305,6 +351,9 @@ func NewTabletServer(config tabletenv.TabletConfig, topoServer *topo.Server, ali
 	})
 	// TODO(sougou): move this up once the stats naming problem is fixed.
 	tsv.vstreamer = vstreamer.NewEngine(srvTopoServer, tsv.se)
+	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer, config)
+	tsv.runtimeFlags = servenv.NewRuntimeFlagSet()
+	tsv.registerRuntimeFlags()
 	return tsv
 }
 
@@ -318,6 +367,29 @@ func (tsv *TabletServer) Register() {
 	tsv.registerQueryzHandler()
 	tsv.registerStreamQueryzHandlers()
 	tsv.registerTwopczHandler()
+	tsv.registerPoolsHandler()
+	servenv.HandleRuntimeFlags(tsv.runtimeFlags)
+
+	servenv.OnTerm(tsv.drain)
+	servenv.RegisterSessionDrain("transactions", tsv.te.txPool.activePool.Size)
+}
+
+// drain is called when the process enters lameduck mode. It reports
+// the tablet as not serving to the health streams right away, so the
+// vtgates stop sending it new queries, and rejects the new
+// transactions. The open transactions can still finish until the
+// process shuts down.
+func (tsv *TabletServer) drain() {
+	tsv.draining.Set(true)
+
+	tsv.streamHealthMutex.Lock()
+	defer tsv.streamHealthMutex.Unlock()
+	if tsv.lastStreamHealthResponse == nil {
+		return
+	}
+	shr := proto.Clone(tsv.lastStreamHealthResponse).(*querypb.StreamHealthResponse)
+	shr.Serving = false
+	tsv.sendHealthLocked(shr)
 }
 
 // RegisterQueryRuleSource registers ruleSource for setting query rules.
@@ -391,6 +463,9 @@ func (tsv *TabletServer) InitDBConfig(target querypb.Target, dbcfgs *dbconfigs.D
 	tsv.teCtrl.InitDBConfig(tsv.dbconfigs)
 	tsv.hw.InitDBConfig(tsv.dbconfigs)
 	tsv.hr.InitDBConfig(tsv.dbconfigs)
+	tsv.tableGC.InitDBConfig(tsv.dbconfigs)
+	tsv.tableAnalyzer.InitDBConfig(tsv.dbconfigs)
+	tsv.innodbHealth.InitDBConfig(tsv.dbconfigs)
 	tsv.messager.InitDBConfig(tsv.dbconfigs)
 	tsv.watcher.InitDBConfig(tsv.dbconfigs)
 	tsv.vstreamer.InitDBConfig(tsv.dbconfigs)
@@ -415,17 +490,86 @@ func (tsv *TabletServer) initACL(tableACLConfigFile string, enforceTableACLConfi
 
 // InitACL loads the table ACL and sets up a SIGHUP handler for reloading it.
 func (tsv *TabletServer) InitACL(tableACLConfigFile string, enforceTableACLConfig bool) {
+	tsv.tableACLConfigFile.Set(tableACLConfigFile)
 	tsv.initACL(tableACLConfigFile, enforceTableACLConfig)
 
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGHUP)
 	go func() {
 		for range sigChan {
-			tsv.initACL(tableACLConfigFile, enforceTableACLConfig)
+			tsv.initACL(tsv.tableACLConfigFile.Get(), enforceTableACLConfig)
 		}
 	}()
 }
 
+// ReloadACL loads the table ACL from a new file. The file is then
+// reloaded on SIGHUP. The current table ACL is kept if the file can't
+// be loaded.
+func (tsv *TabletServer) ReloadACL(tableACLConfigFile string) error {
+	if err := tableacl.Init(tableACLConfigFile, tsv.ClearQueryPlanCache); err != nil {
+		return err
+	}
+	tsv.tableACLConfigFile.Set(tableACLConfigFile)
+	return nil
+}
+
+// InitACLFromTopo loads the table ACL of a keyspace from the topo, and
+// watches it to reload it every time it changes.
+func (tsv *TabletServer) InitACLFromTopo(keyspace string, enforceTableACLConfig bool) {
+	if err := tableacl.Init("", tsv.ClearQueryPlanCache); err != nil {
+		log.Errorf("Fail to initialize Table ACL: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), *topo.RemoteOperationTimeout)
+	config, err := tsv.topoServer.GetTableACL(ctx, keyspace)
+	cancel()
+	if err == nil {
+		err = tableacl.InitFromProto(config)
+	}
+	if err != nil {
+		log.Errorf("Fail to load Table ACL of keyspace %v from topo: %v", keyspace, err)
+		if enforceTableACLConfig {
+			log.Exit("Need a valid initial Table ACL when enforce-tableacl-config is set, exiting.")
+		}
+	}
+
+	go tsv.watchTableACL(keyspace)
+}
+
+// watchTableACL reloads the table ACL of a keyspace when it changes,
+// and sets the watch again if it fails.
+func (tsv *TabletServer) watchTableACL(keyspace string) {
+	ctx := tabletenv.LocalContext()
+	for {
+		current, changes, _ := tsv.topoServer.WatchTableACL(ctx, keyspace)
+		if current.Err != nil {
+			log.Warningf("Cannot watch Table ACL of keyspace %v: %v", keyspace, current.Err)
+			time.Sleep(tableACLWatchRetryDelay)
+			continue
+		}
+		tsv.setTableACL(keyspace, current.Value)
+		for wd := range changes {
+			if wd.Err != nil {
+				log.Warningf("Table ACL watch of keyspace %v failed: %v", keyspace, wd.Err)
+				break
+			}
+			tsv.setTableACL(keyspace, wd.Value)
+		}
+		time.Sleep(tableACLWatchRetryDelay)
+	}
+}
+
+func (tsv *TabletServer) setTableACL(keyspace string, config *tableaclpb.Config) {
+	if proto.Equal(config, tableacl.GetCurrentConfig()) {
+		return
+	}
+	if err := tableacl.InitFromProto(config); err != nil {
+		log.Errorf("Invalid Table ACL of keyspace %v, keeping the current one: %v", keyspace, err)
+		return
+	}
+	log.Infof("Reloaded Table ACL of keyspace %v from topo", keyspace)
+}
+
 // StartService is a convenience function for InitDBConfig->SetServingType
 // with serving=true.
 func (tsv *TabletServer) StartService(target querypb.Target, dbcfgs *dbconfigs.DBConfigs) (err error) {
@@ -576,16 +720,21 @@ func (tsv *TabletServer) serveNewType() (err error) {
 		tsv.messager.Open()
 		tsv.hr.Close()
 		tsv.hw.Open()
+		tsv.tableGC.Open()
+		tsv.innodbHealth.Open()
 	} else {
 		tsv.teCtrl.AcceptReadOnly()
 		tsv.messager.Close()
 		tsv.hr.Open()
 		tsv.hw.Close()
+		tsv.tableGC.Close()
+		tsv.innodbHealth.Close()
 		tsv.watcher.Open()
 
 		// Reset the sequences.
 		tsv.se.MakeNonMaster()
 	}
+	tsv.tableAnalyzer.Open()
 	tsv.transition(StateServing)
 	return nil
 }
@@ -621,6 +770,9 @@ func (tsv *TabletServer) StopService() {
 	tsv.se.Close()
 	tsv.hw.Close()
 	tsv.hr.Close()
+	tsv.tableGC.Close()
+	tsv.tableAnalyzer.Close()
+	tsv.innodbHealth.Close()
 	log.Infof("Shutdown complete.")
 	tsv.transition(StateNotConnected)
 }
@@ -632,12 +784,15 @@ func (tsv *TabletServer) waitForShutdown() {
 	// will be allowed. They will enable the conclusion of outstanding
 	// transactions.
 	tsv.messager.Close()
+	tsv.tableGC.Close()
+	tsv.tableAnalyzer.Close()
 	tsv.teCtrl.StopGently()
 	tsv.qe.streamQList.TerminateAll()
 	tsv.updateStreamList.Stop()
 	tsv.watcher.Close()
 	tsv.requests.Wait()
 	tsv.txThrottler.Close()
+	tsv.innodbHealth.Close()
 }
 
 // closeAll is called if TabletServer fails to start.
@@ -646,12 +801,15 @@ func (tsv *TabletServer) closeAll() {
 	tsv.messager.Close()
 	tsv.hr.Close()
 	tsv.hw.Close()
+	tsv.tableGC.Close()
+	tsv.tableAnalyzer.Close()
 	tsv.teCtrl.StopGently()
 	tsv.watcher.Close()
 	tsv.updateStreamList.Stop()
 	tsv.qe.Close()
 	tsv.se.Close()
 	tsv.txThrottler.Close()
+	tsv.innodbHealth.Close()
 	tsv.transition(StateNotConnected)
 }
 
@@ -774,10 +932,16 @@ func (tsv *TabletServer) Begin(ctx context.Context, target *querypb.Target, opti
 		target, options, true /* isBegin */, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
 			startTime := time.Now()
+			if tsv.draining.Get() {
+				return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "tablet is in lameduck mode")
+			}
 			if tsv.txThrottler.Throttle() {
 				// TODO(erez): I think this should be RESOURCE_EXHAUSTED.
 				return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "Transaction throttled")
 			}
+			if err := tsv.throttleWriteTransaction(ctx, options); err != nil {
+				return err
+			}
 			var beginSQL string
 			transactionID, beginSQL, err = tsv.teCtrl.Begin(ctx, options)
 			logStats.TransactionID = transactionID
@@ -798,6 +962,20 @@ func (tsv *TabletServer) Begin(ctx context.Context, target *querypb.Target, opti
 	return transactionID, err
 }
 
+// throttleWriteTransaction returns an error if a new write transaction
+// must be rejected because the InnoDB purge is behind. The read-only
+// transactions, the reserved connections and the local requests are
+// never throttled.
+func (tsv *TabletServer) throttleWriteTransaction(ctx context.Context, options *querypb.ExecuteOptions) error {
+	if options.GetTransactionIsolation() == querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY || options.GetReserveConnection() || tabletenv.IsLocalContext(ctx) {
+		return nil
+	}
+	if tsv.innodbHealth.Throttle() {
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "write transaction throttled: the InnoDB purge is behind")
+	}
+	return nil
+}
+
 // Commit commits the specified transaction.
 func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (err error) {
 	return tsv.execRequest(
@@ -838,6 +1016,21 @@ func (tsv *TabletServer) Rollback(ctx context.Context, target *querypb.Target, t
 	)
 }
 
+// Release rolls back the transaction of the specified reserved
+// connection, if any, and releases the connection.
+func (tsv *TabletServer) Release(ctx context.Context, target *querypb.Target, reservedID int64) (err error) {
+	return tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"Release", "release", nil,
+		target, nil, false /* isBegin */, true, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			defer tabletenv.QueryStats.Record("RELEASE", time.Now())
+			logStats.TransactionID = reservedID
+			return tsv.teCtrl.Release(ctx, reservedID)
+		},
+	)
+}
+
 // Prepare prepares the specified transaction.
 func (tsv *TabletServer) Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
 	return tsv.execRequest(
@@ -994,23 +1187,40 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 	defer span.Finish()
 
 	allowOnShutdown := (transactionID != 0)
+	requestCtx := ctx
 	err = tsv.execRequest(
 		ctx, tsv.QueryTimeout.Get(),
 		"Execute", sql, bindVariables,
 		target, options, false /* isBegin */, allowOnShutdown,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			if err := tsv.waitForPosition(ctx, options); err != nil {
+				return err
+			}
 			if bindVariables == nil {
 				bindVariables = make(map[string]*querypb.BindVariable)
 			}
+			var renamer *schemaRenamer
+			bindVariables, renamer = tsv.setSchemaName(bindVariables)
+			if err := tsv.splitQueryThrottler.Wait(ctx, sql, bindVariables); err != nil {
+				return err
+			}
 			query, comments := sqlparser.SplitMarginComments(sql)
+			planStart := time.Now()
 			plan, err := tsv.qe.GetPlan(ctx, logStats, query, skipQueryPlanCache(options))
 			if err != nil {
 				return err
 			}
+			planTime := time.Since(planStart)
 			if plan.PlanID == planbuilder.PlanInsertTopic {
 				result, err = tsv.topicExecute(ctx, query, comments, bindVariables, transactionID, options, plan, logStats)
 			} else {
-				result, err = tsv.qreExecute(ctx, query, comments, bindVariables, transactionID, options, plan, logStats)
+				result, err = tsv.qreExecute(ctx, requestCtx, query, comments, bindVariables, transactionID, options, plan, logStats)
+			}
+			if err == nil && renamer != nil {
+				result = renamer.rename(result)
+			}
+			if err == nil && options.GetTrace() {
+				result = withTrace(result, tsv.queryTrace(plan, planTime, logStats))
 			}
 
 			return err
@@ -1019,6 +1229,95 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 	return result, err
 }
 
+// waitForPosition waits until mysqld has applied the replication
+// position the query must be executed after, if any.
+func (tsv *TabletServer) waitForPosition(ctx context.Context, options *querypb.ExecuteOptions) error {
+	position := options.GetWaitForPosition()
+	if position == "" {
+		return nil
+	}
+	pos, err := mysql.DecodePosition(position)
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid position %v: %v", position, err)
+	}
+	defer tabletenv.WaitStats.Record("Position", time.Now())
+	conn, err := tsv.qe.conns.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+	return conn.WaitForPosition(ctx, pos)
+}
+
+// setSchemaName sets the schema names of the system table queries that
+// vtgate routed to the keyspace they compare their schema names to: the
+// tablet's database is the keyspace. It returns a copy of bindVariables
+// with the name of the database, and the renamer of the results. It
+// returns bindVariables as is, and a nil renamer, for the other queries.
+func (tsv *TabletServer) setSchemaName(bindVariables map[string]*querypb.BindVariable) (map[string]*querypb.BindVariable, *schemaRenamer) {
+	if _, ok := bindVariables[sqltypes.BvReplaceSchemaName]; !ok {
+		return bindVariables, nil
+	}
+	keyspace := string(bindVariables[sqltypes.BvSchemaName].GetValue())
+	dbName := tsv.dbconfigs.AppWithDB().DbName
+	newBindVariables := make(map[string]*querypb.BindVariable, len(bindVariables))
+	for k, v := range bindVariables {
+		newBindVariables[k] = v
+	}
+	newBindVariables[sqltypes.BvSchemaName] = sqltypes.StringBindVariable(dbName)
+	newBindVariables[sqltypes.BvSchemaNames] = &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: []*querypb.Value{{Type: querypb.Type_VARBINARY, Value: []byte(dbName)}},
+	}
+	return newBindVariables, &schemaRenamer{dbName: dbName, keyspace: keyspace}
+}
+
+// schemaRenamer replaces the name of the tablet's database with the
+// keyspace name in the schema name columns of the results of a system
+// table query, so the clients see the schema names they queried. The
+// columns are found by their name, so the aliased ones are not renamed.
+type schemaRenamer struct {
+	dbName   string
+	keyspace string
+	// columns are the schema name columns of the fields of the
+	// results. The streamed results only have fields in the first one.
+	columns []int
+}
+
+// rename returns a copy of result with the keyspace name. The result
+// itself may be shared by the waiters of a consolidated query, so it's
+// not modified.
+func (sr *schemaRenamer) rename(result *sqltypes.Result) *sqltypes.Result {
+	if result.Fields != nil {
+		sr.columns = nil
+		for i, field := range result.Fields {
+			if sqltypes.SchemaNameColumns[strings.ToLower(field.Name)] || sqltypes.SchemaNameColumns[strings.ToLower(field.OrgName)] {
+				sr.columns = append(sr.columns, i)
+			}
+		}
+	}
+	if len(sr.columns) == 0 || len(result.Rows) == 0 || sr.dbName == sr.keyspace {
+		return result
+	}
+	renamed := *result
+	renamed.Rows = make([][]sqltypes.Value, len(result.Rows))
+	for i, row := range result.Rows {
+		renamed.Rows[i] = row
+		copied := false
+		for _, col := range sr.columns {
+			if col >= len(row) || row[col].ToString() != sr.dbName {
+				continue
+			}
+			if !copied {
+				renamed.Rows[i] = append([]sqltypes.Value(nil), row...)
+				copied = true
+			}
+			renamed.Rows[i][col] = sqltypes.MakeTrusted(row[col].Type(), []byte(sr.keyspace))
+		}
+	}
+	return &renamed
+}
+
 func (tsv *TabletServer) topicExecute(ctx context.Context, query string, comments sqlparser.MarginComments, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions, plan *TabletPlan, logStats *tabletenv.LogStats) (result *sqltypes.Result, err error) {
 	for _, subscriber := range plan.Table.TopicInfo.Subscribers {
 		// replace the topic name with the subscribed message table name
@@ -1031,12 +1330,12 @@ func (tsv *TabletServer) topicExecute(ctx context.Context, query string, comment
 
 		// because there isn't an option to return multiple results, only the last
 		// message table result is returned
-		result, err = tsv.qreExecute(ctx, newQuery, comments, bindVariables, transactionID, options, newPlan, logStats)
+		result, err = tsv.qreExecute(ctx, ctx, newQuery, comments, bindVariables, transactionID, options, newPlan, logStats)
 	}
 	return result, err
 }
 
-func (tsv *TabletServer) qreExecute(ctx context.Context, query string, comments sqlparser.MarginComments, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions, plan *TabletPlan, logStats *tabletenv.LogStats) (result *sqltypes.Result, err error) {
+func (tsv *TabletServer) qreExecute(ctx, requestCtx context.Context, query string, comments sqlparser.MarginComments, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions, plan *TabletPlan, logStats *tabletenv.LogStats) (result *sqltypes.Result, err error) {
 	qre := &QueryExecutor{
 		query:          query,
 		marginComments: comments,
@@ -1045,6 +1344,7 @@ func (tsv *TabletServer) qreExecute(ctx context.Context, query string, comments
 		options:        options,
 		plan:           plan,
 		ctx:            ctx,
+		requestCtx:     requestCtx,
 		logStats:       logStats,
 		tsv:            tsv,
 	}
@@ -1059,6 +1359,34 @@ func (tsv *TabletServer) qreExecute(ctx context.Context, query string, comments
 	return result, nil
 }
 
+// withTrace returns a copy of result with the trace in its extras. The
+// result itself may be shared by the waiters of a consolidated query,
+// so it's not modified. The rows are not copied.
+func withTrace(result *sqltypes.Result, trace *querypb.QueryTrace) *sqltypes.Result {
+	traced := *result
+	if result.Extras != nil {
+		traced.Extras = proto.Clone(result.Extras).(*querypb.ResultExtras)
+	} else {
+		traced.Extras = &querypb.ResultExtras{}
+	}
+	traced.Extras.Trace = trace
+	return &traced
+}
+
+// queryTrace returns the timings of a query, which are returned to
+// the client when it sets the trace flag in the ExecuteOptions.
+func (tsv *TabletServer) queryTrace(plan *TabletPlan, planTime time.Duration, logStats *tabletenv.LogStats) *querypb.QueryTrace {
+	alias := tsv.alias
+	return &querypb.QueryTrace{
+		TabletAlias:   &alias,
+		PlanType:      plan.PlanID.String(),
+		PlanNanos:     planTime.Nanoseconds(),
+		PoolWaitNanos: logStats.WaitingForConnection.Nanoseconds(),
+		MysqlNanos:    logStats.MysqlResponseTime.Nanoseconds(),
+		TotalNanos:    time.Since(logStats.StartTime).Nanoseconds(),
+	}
+}
+
 // StreamExecute executes the query and streams the result.
 // The first QueryResult will have Fields set (and Rows nil).
 // The subsequent QueryResult will have Rows set (and Fields nil).
@@ -1068,9 +1396,17 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 		"StreamExecute", sql, bindVariables,
 		target, options, false /* isBegin */, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			if err := tsv.waitForPosition(ctx, options); err != nil {
+				return err
+			}
 			if bindVariables == nil {
 				bindVariables = make(map[string]*querypb.BindVariable)
 			}
+			var renamer *schemaRenamer
+			bindVariables, renamer = tsv.setSchemaName(bindVariables)
+			if err := tsv.splitQueryThrottler.Wait(ctx, sql, bindVariables); err != nil {
+				return err
+			}
 			query, comments := sqlparser.SplitMarginComments(sql)
 			plan, err := tsv.qe.GetStreamPlan(query)
 			if err != nil {
@@ -1087,6 +1423,11 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 				logStats:       logStats,
 				tsv:            tsv,
 			}
+			if renamer != nil {
+				return qre.Stream(func(result *sqltypes.Result) error {
+					return callback(renamer.rename(result))
+				})
+			}
 			return qre.Stream(callback)
 		},
 	)
@@ -1463,7 +1804,7 @@ func (tsv *TabletServer) SplitQuery(
 				return err
 			}
 			defer sqlExecuter.done()
-			algorithmObject, err := createSplitQueryAlgorithmObject(algorithm, splitParams, sqlExecuter)
+			algorithmObject, err := createSplitQueryAlgorithmObject(algorithm, splitParams, sqlExecuter, tsv.qe.splitMinMaxCache)
 			if err != nil {
 				return err
 			}
@@ -1615,6 +1956,9 @@ func (tsv *TabletServer) convertAndLogError(ctx context.Context, sql string, bin
 	if ok {
 		sqlState := sqlErr.SQLState()
 		errnum := sqlErr.Number()
+		if errnum == mysql.ERLockWaitTimeout || errnum == mysql.ERLockDeadlock {
+			tsv.recordLockError()
+		}
 		if tsv.TerseErrors && len(bindVariables) != 0 && errCode != vtrpcpb.Code_FAILED_PRECONDITION {
 			err = vterrors.Errorf(errCode, "(errno %d) (sqlstate %s)%s: %s", errnum, sqlState, callerID, queryAsString(sql, nil))
 			if logMethod != nil {
@@ -1885,13 +2229,14 @@ func (se *splitQuerySQLExecuter) SQLExecute(
 func createSplitQueryAlgorithmObject(
 	algorithm querypb.SplitQueryRequest_Algorithm,
 	splitParams *splitquery.SplitParams,
-	sqlExecuter splitquery.SQLExecuter) (splitquery.SplitAlgorithmInterface, error) {
+	sqlExecuter splitquery.SQLExecuter,
+	minMaxCache *splitquery.MinMaxCache) (splitquery.SplitAlgorithmInterface, error) {
 
 	switch algorithm {
 	case querypb.SplitQueryRequest_FULL_SCAN:
 		return splitquery.NewFullScanAlgorithm(splitParams, sqlExecuter)
 	case querypb.SplitQueryRequest_EQUAL_SPLITS:
-		return splitquery.NewEqualSplitsAlgorithm(splitParams, sqlExecuter)
+		return splitquery.NewEqualSplitsAlgorithmWithCache(splitParams, sqlExecuter, minMaxCache)
 	default:
 		panic(fmt.Errorf("unknown algorithm enum: %+v", algorithm))
 	}
@@ -1956,16 +2301,32 @@ func (tsv *TabletServer) BroadcastHealth(terTimestamp int64, stats *querypb.Real
 	tsv.mu.Lock()
 	target := tsv.target
 	tsv.mu.Unlock()
+	if stats != nil {
+		// A throttled transaction is reported for as long as the
+		// health may be cached.
+		stats.TxThrottled = tsv.txThrottler.Throttled(maxCache)
+		// The binlog consumers back off while the tablet lags.
+		consumerthrottler.SetReplicationLag(time.Duration(stats.SecondsBehindMaster) * time.Second)
+		// The vtgates refresh their tracked schema on these.
+		stats.TableSchemaChanged = tsv.qe.takeSchemaChanges()
+	}
 	shr := &querypb.StreamHealthResponse{
 		Target:                              &target,
 		TabletAlias:                         &tsv.alias,
-		Serving:                             tsv.IsServing(),
+		Serving:                             tsv.IsServing() && !tsv.draining.Get(),
 		TabletExternallyReparentedTimestamp: terTimestamp,
 		RealtimeStats:                       stats,
 	}
 
 	tsv.streamHealthMutex.Lock()
 	defer tsv.streamHealthMutex.Unlock()
+	tsv.sendHealthLocked(shr)
+	tsv.lastStreamHealthExpiration = time.Now().Add(maxCache)
+}
+
+// sendHealthLocked sends the health to all the listeners and keeps it
+// for the new ones. It must be called with streamHealthMutex held.
+func (tsv *TabletServer) sendHealthLocked(shr *querypb.StreamHealthResponse) {
 	for _, c := range tsv.streamHealthMap {
 		// Do not block on any write.
 		select {
@@ -1974,7 +2335,6 @@ func (tsv *TabletServer) BroadcastHealth(terTimestamp int64, stats *querypb.Real
 		}
 	}
 	tsv.lastStreamHealthResponse = shr
-	tsv.lastStreamHealthExpiration = time.Now().Add(maxCache)
 }
 
 // HeartbeatLag returns the current lag as calculated by the heartbeat
@@ -2287,6 +2647,20 @@ func (tsv *TabletServer) GetQueryPoolWaiterCap() int64 {
 	return tsv.qe.queryPoolWaiterCap.Get()
 }
 
+// SetQueryPoolLowPriorityWaiterCap changes the limit on the number of LOW
+// priority queries that can be waiting for a connection from the pool
+// This function should only be used for testing.
+func (tsv *TabletServer) SetQueryPoolLowPriorityWaiterCap(val int64) {
+	tsv.qe.lowPriorityWaiterCap.Set(val)
+}
+
+// GetQueryPoolLowPriorityWaiterCap returns the limit on the number of LOW
+// priority queries that can be waiting for a connection from the pool
+// This function should only be used for testing.
+func (tsv *TabletServer) GetQueryPoolLowPriorityWaiterCap() int64 {
+	return tsv.qe.lowPriorityWaiterCap.Get()
+}
+
 // SetTxPoolWaiterCap changes the limit on the number of queries that can be
 // waiting for a connection from the pool
 // This function should only be used for testing.
@@ -2328,15 +2702,35 @@ func queryAsString(sql string, bindVariables map[string]*querypb.BindVariable) s
 }
 
 // withTimeout returns a context based on the specified timeout.
-// If the context is local or if timeout is 0, the
+// If the context is local or if there's no timeout, the
 // original context is returned as is.
 func withTimeout(ctx context.Context, timeout time.Duration, options *querypb.ExecuteOptions) (context.Context, context.CancelFunc) {
-	if timeout == 0 || options.GetWorkload() == querypb.ExecuteOptions_DBA || tabletenv.IsLocalContext(ctx) {
+	if tabletenv.IsLocalContext(ctx) {
+		return ctx, func() {}
+	}
+	timeout = queryTimeout(timeout, options)
+	if timeout == 0 {
 		return ctx, func() {}
 	}
 	return context.WithTimeout(ctx, timeout)
 }
 
+// queryTimeout returns the timeout of a query, 0 if it has none. The
+// timeout of the execute options replaces the timeout of the tablet,
+// but can't exceed it. The DBA workload is not limited by the timeout
+// of the tablet, and has no timeout unless it sets one.
+func queryTimeout(timeout time.Duration, options *querypb.ExecuteOptions) time.Duration {
+	if options.GetWorkload() == querypb.ExecuteOptions_DBA {
+		timeout = 0
+	}
+	if timeoutMs := options.GetTimeoutMs(); timeoutMs > 0 {
+		if optionsTimeout := time.Duration(timeoutMs) * time.Millisecond; timeout == 0 || optionsTimeout < timeout {
+			return optionsTimeout
+		}
+	}
+	return timeout
+}
+
 // skipQueryPlanCache returns true if the query plan should be cached
 func skipQueryPlanCache(options *querypb.ExecuteOptions) bool {
 	if options == nil {
@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
@@ -968,6 +969,114 @@ func TestTabletServerBeginFail(t *testing.T) {
 	}
 }
 
+func TestTabletServerBeginInnoDBThrottled(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	// The history list is twice as long as the threshold, so all the
+	// write transactions are throttled.
+	db.AddQuery("show engine innodb status", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Type|Name|Status", "varchar|varchar|varchar"),
+		"InnoDB||Trx id counter 1000\nPurge done for trx's n:o < 990 undo n:o < 0 state: running\nHistory list length 2000\n",
+	))
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	config.InnoDBMaxHistoryListLength = 1000
+	config.InnoDBHealthCheckInterval = 10 * time.Millisecond
+	config.EnableAutoCommit = true
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+
+	want := "write transaction throttled: the InnoDB purge is behind"
+	for start := time.Now(); ; time.Sleep(10 * time.Millisecond) {
+		transactionID, err := tsv.Begin(ctx, &target, nil)
+		if err != nil {
+			if err.Error() != want || vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+				t.Fatalf("Begin: %v, want %v", err, want)
+			}
+			break
+		}
+		tsv.Rollback(ctx, &target, transactionID)
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("the write transactions were not throttled")
+		}
+	}
+	if _, err := tsv.Execute(ctx, &target, "update test_table set name_string = 'tx1' where pk = 1", nil, 0, nil); err == nil || err.Error() != want {
+		t.Errorf("Execute: %v, want %v", err, want)
+	}
+
+	// The read-only transactions are not throttled.
+	db.AddQuery("set transaction isolation level REPEATABLE READ", &sqltypes.Result{})
+	db.AddQuery("start transaction with consistent snapshot, read only", &sqltypes.Result{})
+	options := &querypb.ExecuteOptions{TransactionIsolation: querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY}
+	transactionID, err := tsv.Begin(ctx, &target, options)
+	if err != nil {
+		t.Fatalf("Begin read-only: %v", err)
+	}
+	tsv.Rollback(ctx, &target, transactionID)
+}
+
+func TestTabletServerLameduck(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+
+	transactionID, err := tsv.Begin(ctx, &target, nil)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	tsv.BroadcastHealth(0, &querypb.RealtimeStats{}, time.Minute)
+
+	ch := make(chan *querypb.StreamHealthResponse, 10)
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go tsv.StreamHealth(streamCtx, func(shr *querypb.StreamHealthResponse) error {
+		ch <- shr
+		return nil
+	})
+	if shr := <-ch; !shr.Serving {
+		t.Fatalf("StreamHealth: %v, want serving", shr)
+	}
+
+	tsv.drain()
+	if shr := <-ch; shr.Serving {
+		t.Errorf("StreamHealth in lameduck: %v, want not serving", shr)
+	}
+	tsv.BroadcastHealth(0, &querypb.RealtimeStats{}, time.Minute)
+	if shr := <-ch; shr.Serving {
+		t.Errorf("StreamHealth in lameduck: %v, want not serving", shr)
+	}
+
+	// The new transactions are rejected, the open ones can finish.
+	want := "tablet is in lameduck mode"
+	if _, err := tsv.Begin(ctx, &target, nil); err == nil || err.Error() != want || vterrors.Code(err) != vtrpcpb.Code_UNAVAILABLE {
+		t.Errorf("Begin in lameduck: %v, want %v", err, want)
+	}
+	if got := tsv.te.txPool.activePool.Size(); got != 1 {
+		t.Errorf("open transactions: %d, want 1", got)
+	}
+	if err := tsv.Commit(ctx, &target, transactionID); err != nil {
+		t.Errorf("Commit in lameduck: %v", err)
+	}
+	if got := tsv.te.txPool.activePool.Size(); got != 0 {
+		t.Errorf("open transactions: %d, want 0", got)
+	}
+}
+
 func TestTabletServerCommitTransaction(t *testing.T) {
 	db := setUpTabletServerTest(t)
 	defer db.Close()
@@ -1168,6 +1277,182 @@ func TestTabletServerStreamExecute(t *testing.T) {
 	}
 }
 
+func TestTabletServerSystemSchemaName(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	executeSQL := "select table_schema, table_name from information_schema.`tables` where table_schema = :__vtschemaname"
+	result := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_schema|table_name", "varchar|varchar"),
+		"vt_ks|t1",
+		"vt_ks|t2",
+	)
+	db.AddQuery("select table_schema, table_name from information_schema.`tables` where table_schema = 'vt_ks' limit 10001", result)
+	db.AddQuery("select table_schema, table_name from information_schema.`tables` where table_schema = 'vt_ks'", result)
+	db.AddQuery("select table_schema, table_name from information_schema.`tables` where 1 != 1", &sqltypes.Result{})
+
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := dbconfigs.NewTestDBConfigs(*db.ConnParams(), *db.ConnParams(), "vt_ks")
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+
+	bindVariables := map[string]*querypb.BindVariable{
+		sqltypes.BvSchemaName:        sqltypes.StringBindVariable("ks"),
+		sqltypes.BvReplaceSchemaName: sqltypes.Int64BindVariable(1),
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("table_schema|table_name", "varchar|varchar"),
+		"ks|t1",
+		"ks|t2",
+	)
+	got, err := tsv.Execute(context.Background(), &target, executeSQL, bindVariables, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Rows, want.Rows) {
+		t.Errorf("Execute: %v, want %v", got.Rows, want.Rows)
+	}
+	// The bind variables of the caller are not modified.
+	if v := string(bindVariables[sqltypes.BvSchemaName].Value); v != "ks" {
+		t.Errorf("bind variable %s: %s, want ks", sqltypes.BvSchemaName, v)
+	}
+
+	var streamed [][]sqltypes.Value
+	err = tsv.StreamExecute(context.Background(), &target, executeSQL, bindVariables, 0, nil, func(qr *sqltypes.Result) error {
+		streamed = append(streamed, qr.Rows...)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(streamed, want.Rows) {
+		t.Errorf("StreamExecute: %v, want %v", streamed, want.Rows)
+	}
+}
+
+func TestTabletServerExecuteWaitForPosition(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	executeSQL := "select * from test_table limit 1000"
+	db.AddQuery(executeSQL, &sqltypes.Result{})
+	db.AddQuery("SELECT @@GLOBAL.gtid_executed", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("gtid_executed", "varchar"),
+		"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5",
+	))
+	db.AddQueryPattern("SELECT WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS\\('3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10', .*\\)", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("result", "int64"),
+		"-1",
+	))
+
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+
+	// A position that was applied doesn't wait.
+	options := &querypb.ExecuteOptions{WaitForPosition: "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3"}
+	if _, err := tsv.Execute(ctx, &target, executeSQL, nil, 0, options); err != nil {
+		t.Fatal(err)
+	}
+
+	// The query fails if the position isn't reached in time.
+	options.WaitForPosition = "MySQL56/3e11fa47-71ca-11e1-9e33-c80aa9429562:1-10"
+	_, err := tsv.Execute(ctx, &target, executeSQL, nil, 0, options)
+	if code := vterrors.Code(err); code != vtrpcpb.Code_DEADLINE_EXCEEDED {
+		t.Errorf("Execute: %v, want DEADLINE_EXCEEDED", err)
+	}
+	callback := func(*sqltypes.Result) error { return nil }
+	err = tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, options, callback)
+	if code := vterrors.Code(err); code != vtrpcpb.Code_DEADLINE_EXCEEDED {
+		t.Errorf("StreamExecute: %v, want DEADLINE_EXCEEDED", err)
+	}
+
+	options.WaitForPosition = "invalid"
+	_, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, options)
+	if code := vterrors.Code(err); code != vtrpcpb.Code_INVALID_ARGUMENT {
+		t.Errorf("Execute: %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+func TestTabletServerExecuteTrace(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	executeSQL := "select * from test_table limit 1000"
+	db.AddQuery(executeSQL, &sqltypes.Result{})
+
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	err := tsv.StartService(target, dbcfgs)
+	if err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+
+	qr, err := tsv.Execute(ctx, &target, executeSQL, nil, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trace := qr.Extras.GetTrace(); trace != nil {
+		t.Errorf("trace: %v, want nil", trace)
+	}
+
+	qr, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, &querypb.ExecuteOptions{Trace: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	trace := qr.Extras.GetTrace()
+	if trace == nil {
+		t.Fatalf("trace: nil, want the timings of the query")
+	}
+	if trace.PlanType != "PASS_SELECT" {
+		t.Errorf("trace.PlanType: %v, want PASS_SELECT", trace.PlanType)
+	}
+	if trace.TabletAlias == nil {
+		t.Errorf("trace.TabletAlias: nil, want the alias of the tablet")
+	}
+	if trace.TotalNanos < trace.MysqlNanos || trace.TotalNanos < trace.PlanNanos {
+		t.Errorf("trace: %v, the total time must include the other timings", trace)
+	}
+}
+
+func TestWithTrace(t *testing.T) {
+	// A consolidated result is shared by all the waiters.
+	shared := &sqltypes.Result{
+		Rows:   [][]sqltypes.Value{{sqltypes.NewInt64(1)}},
+		Extras: &querypb.ResultExtras{Fresher: true},
+	}
+	trace := &querypb.QueryTrace{PlanType: "PASS_SELECT"}
+	got := withTrace(shared, trace)
+	if shared.Extras.Trace != nil {
+		t.Errorf("shared.Extras.Trace: %v, want nil", shared.Extras.Trace)
+	}
+	if got.Extras.Trace != trace || !got.Extras.Fresher {
+		t.Errorf("withTrace: %v, want the extras with the trace", got.Extras)
+	}
+	if !reflect.DeepEqual(got.Rows, shared.Rows) {
+		t.Errorf("withTrace rows: %v, want %v", got.Rows, shared.Rows)
+	}
+
+	got = withTrace(&sqltypes.Result{}, trace)
+	if got.Extras.Trace != trace {
+		t.Errorf("withTrace without extras: %v, want the trace", got.Extras)
+	}
+}
+
 func TestTabletServerExecuteBatch(t *testing.T) {
 	db := setUpTabletServerTest(t)
 	defer db.Close()
@@ -2482,7 +2767,7 @@ func TestTabletServerSplitQueryEqualSplitsOnStringColumn(t *testing.T) {
 		querypb.SplitQueryRequest_EQUAL_SPLITS)
 	want :=
 		"using the EQUAL_SPLITS algorithm in SplitQuery" +
-			" requires having a numeric (integral or float) split-column." +
+			" requires having a numeric (integral, decimal or float) split-column." +
 			" Got type: {Name: 'name_string', Type: VARCHAR}"
 	if err.Error() != want {
 		t.Fatalf("got: %v, want: %v", err, want)
@@ -2777,6 +3062,71 @@ func TestACLHUP(t *testing.T) {
 	}
 }
 
+func TestWithTimeout(t *testing.T) {
+	testcases := []struct {
+		ctx     context.Context
+		timeout time.Duration
+		options *querypb.ExecuteOptions
+		want    time.Duration
+	}{{
+		ctx:     context.Background(),
+		timeout: 10 * time.Second,
+		want:    10 * time.Second,
+	}, {
+		ctx:     context.Background(),
+		timeout: 10 * time.Second,
+		options: &querypb.ExecuteOptions{TimeoutMs: 500},
+		want:    500 * time.Millisecond,
+	}, {
+		// The timeout of the query can't exceed the one of the tablet.
+		ctx:     context.Background(),
+		timeout: 10 * time.Second,
+		options: &querypb.ExecuteOptions{TimeoutMs: 60000},
+		want:    10 * time.Second,
+	}, {
+		ctx:     context.Background(),
+		options: &querypb.ExecuteOptions{TimeoutMs: 500},
+		want:    500 * time.Millisecond,
+	}, {
+		ctx:     context.Background(),
+		timeout: 10 * time.Second,
+		options: &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_DBA},
+	}, {
+		ctx:     context.Background(),
+		timeout: 10 * time.Second,
+		options: &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_DBA, TimeoutMs: 500},
+		want:    500 * time.Millisecond,
+	}, {
+		ctx:     context.Background(),
+		timeout: 10 * time.Second,
+		options: &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_DBA, TimeoutMs: 60000},
+		want:    60 * time.Second,
+	}, {
+		ctx:     tabletenv.LocalContext(),
+		timeout: 10 * time.Second,
+		options: &querypb.ExecuteOptions{TimeoutMs: 500},
+	}}
+	for _, tcase := range testcases {
+		start := time.Now()
+		ctx, cancel := withTimeout(tcase.ctx, tcase.timeout, tcase.options)
+		deadline, ok := ctx.Deadline()
+		cancel()
+		if tcase.want == 0 {
+			if ok {
+				t.Errorf("withTimeout(%v, %v): deadline %v, want none", tcase.timeout, tcase.options, deadline)
+			}
+			continue
+		}
+		if !ok {
+			t.Errorf("withTimeout(%v, %v): no deadline, want %v", tcase.timeout, tcase.options, tcase.want)
+			continue
+		}
+		if got := deadline.Sub(start); got < tcase.want || got > tcase.want+time.Second {
+			t.Errorf("withTimeout(%v, %v): timeout %v, want %v", tcase.timeout, tcase.options, got, tcase.want)
+		}
+	}
+}
+
 func TestConfigChanges(t *testing.T) {
 	db := setUpTabletServerTest(t)
 	defer db.Close()
@@ -2864,6 +3214,68 @@ func TestConfigChanges(t *testing.T) {
 	}
 }
 
+func TestRuntimeFlagChanges(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbcfgs); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+
+	// The other tablet servers of the process, like in vtcombo, keep
+	// their flags.
+	other := NewTabletServerWithNilTopoServer(config)
+
+	changes, err := tsv.RuntimeFlags().Set("test", map[string]string{
+		"queryserver-config-pool-size":          "7",
+		"queryserver-config-transaction-cap":    "8",
+		"queryserver-config-query-pool-timeout": "0.5",
+		"queryserver-config-max-result-size":    "9",
+	})
+	if err != nil {
+		t.Fatalf("SetRuntimeFlags: %v", err)
+	}
+	if len(changes) != 4 {
+		t.Errorf("SetRuntimeFlags: %v changes, want 4", len(changes))
+	}
+	if val := tsv.PoolSize(); val != 7 {
+		t.Errorf("PoolSize: %d, want 7", val)
+	}
+	if val := tsv.TxPoolSize(); val != 8 {
+		t.Errorf("TxPoolSize: %d, want 8", val)
+	}
+	if val := tsv.GetQueryPoolTimeout(); val != 500*time.Millisecond {
+		t.Errorf("GetQueryPoolTimeout: %v, want 500ms", val)
+	}
+	if val := tsv.MaxResultSize(); val != 9 {
+		t.Errorf("MaxResultSize: %d, want 9", val)
+	}
+
+	if got := other.RuntimeFlags().Values()["queryserver-config-pool-size"]; got == "7" {
+		t.Errorf("queryserver-config-pool-size of the other tablet server: %v, want unchanged", got)
+	}
+
+	// A pool size of 0 is rejected, and the other flags are not changed.
+	_, err = tsv.RuntimeFlags().Set("test", map[string]string{
+		"queryserver-config-pool-size":       "0",
+		"queryserver-config-max-result-size": "10",
+	})
+	if err == nil {
+		t.Errorf("SetRuntimeFlags with a pool size of 0 succeeded, want error")
+	}
+	if val := tsv.PoolSize(); val != 7 {
+		t.Errorf("PoolSize: %d, want 7", val)
+	}
+	if val := tsv.MaxResultSize(); val != 9 {
+		t.Errorf("MaxResultSize: %d, want 9", val)
+	}
+}
+
 func setUpTabletServerTest(t *testing.T) *fakesqldb.DB {
 	db := fakesqldb.New(t)
 	for query, result := range getSupportedQueries() {
@@ -124,6 +124,10 @@ func NewTxEngine(checker connpool.MySQLChecker, config tabletenv.TabletConfig) *
 		checker,
 		limiter,
 	)
+	te.txPool.conns.SetMaxCapacity(config.TransactionCap * config.PoolMaxCapacityFactor)
+	te.txPool.sizeLimits = newTxSizeLimits(config.TransactionSizeConfig)
+	te.txPool.watcher = newTxWatcher(config.TransactionWatcherConfig)
+	te.txPool.reservedIdleTimeout.Set(time.Duration(config.ReservedConnIdleTimeout * 1e9))
 	te.twopcEnabled = config.TwoPCEnable
 	if te.twopcEnabled {
 		if config.TwoPCCoordinatorAddress == "" {
@@ -283,7 +287,8 @@ func (te *TxEngine) Begin(ctx context.Context, options *querypb.ExecuteOptions)
 		return 0, "", vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can't accept new transactions in state %v", te.state)
 	}
 
-	isWriteTransaction := options == nil || options.TransactionIsolation != querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
+	// Reserving a connection doesn't begin a transaction.
+	isWriteTransaction := (options == nil || options.TransactionIsolation != querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY) && !options.GetReserveConnection()
 	if te.state == AcceptingReadOnly && isWriteTransaction {
 		te.stateLock.Unlock()
 		return 0, "", vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can only accept read-only transactions in current state")
@@ -313,6 +318,15 @@ func (te *TxEngine) Rollback(ctx context.Context, transactionID int64) error {
 	return te.txPool.Rollback(ctx, transactionID)
 }
 
+// Release releases the specified reserved connection, rolling back its
+// transaction if any.
+func (te *TxEngine) Release(ctx context.Context, reservedID int64) error {
+	span, ctx := trace.NewSpan(ctx, "TxEngine.Release")
+	defer span.Finish()
+
+	return te.txPool.Release(ctx, reservedID)
+}
+
 func (te *TxEngine) unknownStateError() error {
 	return vterrors.Errorf(vtrpc.Code_INTERNAL, "unknown state %v", te.state)
 }
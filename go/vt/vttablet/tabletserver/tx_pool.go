@@ -96,11 +96,24 @@ type TxPool struct {
 	ticks                  *timer.Timer
 	checker                connpool.MySQLChecker
 	limiter                txlimiter.TxLimiter
+	// sizeLimits are the limits on the size of the transactions.
+	// It's nil if there are none.
+	sizeLimits *txSizeLimits
+	// watcher watches the idle transactions. It's nil if it's disabled.
+	watcher *txWatcher
 	// Tracking culprits that cause tx pool full errors.
 	logMu     sync.Mutex
 	lastLog   time.Time
 	waiters   sync2.AtomicInt64
 	waiterCap sync2.AtomicInt64
+	// draining is set while the pool waits for the transactions to
+	// complete. The reserved connections are released as soon as they
+	// are not in a transaction anymore.
+	draining sync2.AtomicBool
+	// reservedIdleTimeout is how long a reserved connection can stay
+	// unused outside of a transaction before it's released. 0 disables
+	// the timeout.
+	reservedIdleTimeout sync2.AtomicDuration
 }
 
 // NewTxPool creates a new TxPool. It's not operational until it's Open'd.
@@ -142,16 +155,23 @@ func NewTxPool(
 // that will kill long-running transactions.
 func (axp *TxPool) Open(appParams, dbaParams, appDebugParams *mysql.ConnParams) {
 	log.Infof("Starting transaction id: %d", axp.lastID)
+	axp.draining.Set(false)
 	axp.conns.Open(appParams, dbaParams, appDebugParams)
 	foundRowsParam := *appParams
 	foundRowsParam.EnableClientFoundRows()
 	axp.foundRowsPool.Open(&foundRowsParam, dbaParams, appDebugParams)
 	axp.ticks.Start(func() { axp.transactionKiller() })
+	if axp.watcher != nil {
+		axp.watcher.ticks.Start(func() { axp.watchIdleTransactions() })
+	}
 }
 
 // Close closes the TxPool. A closed pool can be reopened.
 func (axp *TxPool) Close() {
 	axp.ticks.Stop()
+	if axp.watcher != nil {
+		axp.watcher.ticks.Stop()
+	}
 	for _, v := range axp.activePool.GetOutdated(time.Duration(0), "for closing") {
 		conn := v.(*TxConnection)
 		log.Warningf("killing transaction for shutdown: %s", conn.Format(nil))
@@ -159,6 +179,16 @@ func (axp *TxPool) Close() {
 		conn.Close()
 		conn.conclude(TxClose, "pool closed")
 	}
+	// The reserved connections are not returned by GetOutdated.
+	for _, v := range axp.activePool.GetIdle(time.Duration(0), "for closing") {
+		conn := v.(*TxConnection)
+		if !conn.Reserved {
+			axp.activePool.PutIdle(conn.TransactionID)
+			continue
+		}
+		conn.Close()
+		conn.conclude(TxClose, "pool closed")
+	}
 	axp.conns.Close()
 	axp.foundRowsPool.Close()
 }
@@ -191,10 +221,41 @@ func (axp *TxPool) transactionKiller() {
 		conn.Close()
 		conn.conclude(TxKill, fmt.Sprintf("exceeded timeout: %v", axp.Timeout()))
 	}
+	axp.releaseIdleReserved()
+}
+
+// releaseIdleReserved releases the reserved connections that are not in
+// a transaction and were not used for longer than the reserved
+// connection idle timeout. The reserved connections are not subject to
+// the transaction timeout, so nothing else would release the ones
+// abandoned by their session.
+func (axp *TxPool) releaseIdleReserved() {
+	timeout := axp.reservedIdleTimeout.Get()
+	if timeout <= 0 {
+		return
+	}
+	for _, v := range axp.activePool.GetIdle(timeout, "for reserved connection release") {
+		conn := v.(*TxConnection)
+		if !conn.Reserved || !conn.Autocommit {
+			axp.activePool.PutIdle(conn.TransactionID)
+			continue
+		}
+		log.Infof("releasing reserved connection (idle for more than %v): %s", timeout, conn.Format(nil))
+		tabletenv.KillStats.Add("ReservedConnections", 1)
+		conn.Close()
+		conn.conclude(TxClose, fmt.Sprintf("reserved connection idle for more than %v", timeout))
+	}
 }
 
 // WaitForEmpty waits until all active transactions are completed.
+// The reserved connections are released once they are not in a
+// transaction, as nothing else would end them.
 func (axp *TxPool) WaitForEmpty() {
+	axp.draining.Set(true)
+	for _, v := range axp.activePool.GetIdle(time.Duration(0), "for draining") {
+		conn := v.(*TxConnection)
+		conn.Recycle()
+	}
 	axp.activePool.WaitForEmpty()
 }
 
@@ -203,9 +264,18 @@ func (axp *TxPool) WaitForEmpty() {
 // mode the statement will be "".
 //
 // Subsequent statements can access the connection through the transaction id.
+//
+// If options.ReserveConnection is set, the connection is reserved instead:
+// no transaction is begun, and the connection is kept until it's released
+// by a Rollback outside of a transaction. It's not subject to the
+// transaction timeout. If options.ReservedId is set, the transaction is
+// begun on that reserved connection, and its id is returned.
 func (axp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions) (int64, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.Begin")
 	defer span.Finish()
+	if reservedID := options.GetReservedId(); reservedID != 0 {
+		return axp.beginReserved(ctx, reservedID, options)
+	}
 	var conn *connpool.DBConn
 	var err error
 	immediateCaller := callerid.ImmediateCallerIDFromContext(ctx)
@@ -255,44 +325,82 @@ func (axp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions) (
 		return 0, "", err
 	}
 
-	autocommitTransaction := false
+	reserved := options.GetReserveConnection()
+	autocommitTransaction := true
 	beginQueries := ""
-	if queries, ok := txIsolations[options.GetTransactionIsolation()]; ok {
-		if queries.setIsolationLevel != "" {
-			if _, err := conn.Exec(ctx, "set transaction isolation level "+queries.setIsolationLevel, 1, false); err != nil {
-				return 0, "", err
-			}
-
-			beginQueries = queries.setIsolationLevel + "; "
-		}
-
-		if _, err := conn.Exec(ctx, queries.openTransaction, 1, false); err != nil {
+	if !reserved {
+		autocommitTransaction, beginQueries, err = openTransaction(ctx, conn, options)
+		if err != nil {
 			return 0, "", err
 		}
-		beginQueries = beginQueries + queries.openTransaction
-	} else if options.GetTransactionIsolation() == querypb.ExecuteOptions_AUTOCOMMIT {
-		autocommitTransaction = true
-	} else {
-		return 0, "", fmt.Errorf("don't know how to open a transaction of this type: %v", options.GetTransactionIsolation())
 	}
 
 	beginSucceeded = true
 	transactionID := axp.lastID.Add(1)
+	txc := newTxConnection(
+		conn,
+		transactionID,
+		axp,
+		immediateCaller,
+		effectiveCaller,
+		autocommitTransaction,
+	)
+	txc.Reserved = reserved
 	axp.activePool.Register(
 		transactionID,
-		newTxConnection(
-			conn,
-			transactionID,
-			axp,
-			immediateCaller,
-			effectiveCaller,
-			autocommitTransaction,
-		),
-		options.GetWorkload() != querypb.ExecuteOptions_DBA,
+		txc,
+		options.GetWorkload() != querypb.ExecuteOptions_DBA && !reserved,
 	)
 	return transactionID, beginQueries, nil
 }
 
+// beginReserved begins a transaction on a reserved connection.
+func (axp *TxPool) beginReserved(ctx context.Context, reservedID int64, options *querypb.ExecuteOptions) (int64, string, error) {
+	conn, err := axp.Get(reservedID, "for begin")
+	if err != nil {
+		return 0, "", err
+	}
+	defer conn.Recycle()
+	if !conn.Reserved {
+		return 0, "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "connection %d is not reserved", reservedID)
+	}
+	if !conn.Autocommit {
+		return 0, "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "reserved connection %d is already in a transaction", reservedID)
+	}
+	autocommit, beginQueries, err := openTransaction(ctx, conn.DBConn, options)
+	if err != nil {
+		return 0, "", err
+	}
+	conn.Autocommit = autocommit
+	return reservedID, beginQueries, nil
+}
+
+// openTransaction opens a transaction of the isolation of the options
+// on conn. It returns true if the isolation is autocommit, which opens
+// no transaction, and the statements it executed.
+func openTransaction(ctx context.Context, conn *connpool.DBConn, options *querypb.ExecuteOptions) (bool, string, error) {
+	queries, ok := txIsolations[options.GetTransactionIsolation()]
+	if !ok {
+		if options.GetTransactionIsolation() == querypb.ExecuteOptions_AUTOCOMMIT {
+			return true, "", nil
+		}
+		return false, "", fmt.Errorf("don't know how to open a transaction of this type: %v", options.GetTransactionIsolation())
+	}
+	beginQueries := ""
+	if queries.setIsolationLevel != "" {
+		if _, err := conn.Exec(ctx, "set transaction isolation level "+queries.setIsolationLevel, 1, false); err != nil {
+			return false, "", err
+		}
+
+		beginQueries = queries.setIsolationLevel + "; "
+	}
+
+	if _, err := conn.Exec(ctx, queries.openTransaction, 1, false); err != nil {
+		return false, "", err
+	}
+	return false, beginQueries + queries.openTransaction, nil
+}
+
 // Commit commits the specified transaction.
 func (axp *TxPool) Commit(ctx context.Context, transactionID int64, mc messageCommitter) (string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.Commit")
@@ -313,6 +421,9 @@ func (axp *TxPool) Rollback(ctx context.Context, transactionID int64) error {
 	if err != nil {
 		return err
 	}
+	if conn.Reserved {
+		return axp.reservedRollback(ctx, conn)
+	}
 	return axp.localRollback(ctx, conn)
 }
 
@@ -345,6 +456,9 @@ func (axp *TxPool) LocalBegin(ctx context.Context, options *querypb.ExecuteOptio
 func (axp *TxPool) LocalCommit(ctx context.Context, conn *TxConnection, mc messageCommitter) (string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.LocalCommit")
 	defer span.Finish()
+	if conn.Reserved {
+		return axp.reservedCommit(ctx, conn, mc)
+	}
 	defer conn.conclude(TxCommit, "transaction committed")
 	defer mc.LockDB(conn.NewMessages, conn.ChangedMessages)()
 
@@ -361,12 +475,73 @@ func (axp *TxPool) LocalCommit(ctx context.Context, conn *TxConnection, mc messa
 	return "commit", nil
 }
 
+// reservedCommit commits the transaction of a reserved connection, if
+// it's in one. The connection stays reserved.
+func (axp *TxPool) reservedCommit(ctx context.Context, conn *TxConnection, mc messageCommitter) (string, error) {
+	defer conn.Recycle()
+	defer mc.LockDB(conn.NewMessages, conn.ChangedMessages)()
+
+	commitSQL := ""
+	if !conn.Autocommit {
+		if _, err := conn.Exec(ctx, "commit", 1, false); err != nil {
+			conn.Close()
+			return "", err
+		}
+		commitSQL = "commit"
+	}
+	mc.UpdateCaches(conn.NewMessages, conn.ChangedMessages)
+	conn.endTransaction()
+	return commitSQL, nil
+}
+
+// reservedRollback rolls back the transaction of a reserved connection,
+// if it's in one. The connection stays reserved until it's released.
+func (axp *TxPool) reservedRollback(ctx context.Context, conn *TxConnection) error {
+	defer conn.Recycle()
+	if conn.Autocommit {
+		return nil
+	}
+	if _, err := conn.Exec(ctx, "rollback", 1, false); err != nil {
+		conn.Close()
+		return err
+	}
+	conn.endTransaction()
+	return nil
+}
+
+// Release rolls back the transaction of a reserved connection, if it's
+// in one, and releases the connection. The connection is closed, since
+// its session state must not leak to other transactions.
+func (axp *TxPool) Release(ctx context.Context, reservedID int64) error {
+	span, _ := trace.NewSpan(ctx, "TxPool.Release")
+	defer span.Finish()
+
+	conn, err := axp.Get(reservedID, "for release")
+	if err != nil {
+		return err
+	}
+	if !conn.Reserved {
+		conn.Recycle()
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "connection %d is not reserved", reservedID)
+	}
+	// Closing the connection rolls back its transaction.
+	conn.Close()
+	conn.conclude(TxClose, "reserved connection released")
+	return nil
+}
+
 // LocalConclude concludes a transaction started by LocalBegin.
 // If the transaction was not previously concluded, it's rolled back.
 func (axp *TxPool) LocalConclude(ctx context.Context, conn *TxConnection) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.LocalConclude")
 	defer span.Finish()
-	if conn.DBConn != nil {
+	switch {
+	case conn.DBConn == nil:
+	case conn.IsClosed():
+		// The transaction was already rolled back by closing
+		// the connection, for example because it was too large.
+		conn.conclude(TxClose, "closed")
+	default:
 		_ = axp.localRollback(ctx, conn)
 	}
 }
@@ -433,6 +608,17 @@ type TxConnection struct {
 	ImmediateCallerID *querypb.VTGateCallerID
 	EffectiveCallerID *vtrpcpb.CallerID
 	Autocommit        bool
+	// Reserved is set if the connection was reserved by Begin. It's in
+	// a transaction only if Autocommit is false.
+	Reserved bool
+	// RowsModified and BytesModified are the number of rows modified
+	// by the statements of the transaction and their total size.
+	RowsModified  int64
+	BytesModified int64
+	sizeWarned    bool
+	// idleWarned is set once the transaction watcher logged the
+	// transaction as idle.
+	idleWarned bool
 }
 
 func newTxConnection(conn *connpool.DBConn, transactionID int64, pool *TxPool, immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID, autocommit bool) *TxConnection {
@@ -467,6 +653,17 @@ func (txc *TxConnection) Exec(ctx context.Context, query string, maxrows int, wa
 	return r, nil
 }
 
+// endTransaction resets the transaction state of a reserved
+// connection after a commit or a rollback.
+func (txc *TxConnection) endTransaction() {
+	txc.Autocommit = true
+	txc.NewMessages = make(map[string][]*messager.MessageRow)
+	txc.ChangedMessages = make(map[string][]string)
+	txc.RowsModified = 0
+	txc.BytesModified = 0
+	txc.sizeWarned = false
+}
+
 // BeginAgain commits the existing transaction and begins a new one
 func (txc *TxConnection) BeginAgain(ctx context.Context) error {
 	if _, err := txc.DBConn.Exec(ctx, "commit", 1, false); err != nil {
@@ -481,9 +678,13 @@ func (txc *TxConnection) BeginAgain(ctx context.Context) error {
 // Recycle returns the connection to the pool. The transaction remains
 // active.
 func (txc *TxConnection) Recycle() {
-	if txc.IsClosed() {
+	switch {
+	case txc.IsClosed():
 		txc.conclude(TxClose, "closed")
-	} else {
+	case txc.Reserved && txc.Autocommit && txc.pool.draining.Get():
+		txc.Close()
+		txc.conclude(TxClose, "reserved connection released for shutdown")
+	default:
 		txc.pool.activePool.Put(txc.TransactionID)
 	}
 }
@@ -505,10 +706,7 @@ func (txc *TxConnection) log(conclusion string) {
 	txc.Conclusion = conclusion
 	txc.EndTime = time.Now()
 
-	username := callerid.GetPrincipal(txc.EffectiveCallerID)
-	if username == "" {
-		username = callerid.GetUsername(txc.ImmediateCallerID)
-	}
+	username := txc.username()
 	duration := txc.EndTime.Sub(txc.StartTime)
 	tabletenv.UserTransactionCount.Add([]string{username, conclusion}, 1)
 	tabletenv.UserTransactionTimesNs.Add([]string{username, conclusion}, int64(duration))
@@ -519,6 +717,15 @@ func (txc *TxConnection) log(conclusion string) {
 	tabletenv.TxLogger.Send(txc)
 }
 
+// username returns the user of the transaction for the stats.
+func (txc *TxConnection) username() string {
+	username := callerid.GetPrincipal(txc.EffectiveCallerID)
+	if username == "" {
+		username = callerid.GetUsername(txc.ImmediateCallerID)
+	}
+	return username
+}
+
 // EventTime returns the time the event was created.
 func (txc *TxConnection) EventTime() time.Time {
 	return txc.EndTime
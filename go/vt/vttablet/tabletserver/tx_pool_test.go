@@ -344,6 +344,146 @@ func TestTxPoolAutocommit(t *testing.T) {
 	}
 }
 
+func TestTxPoolReservedConnection(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("commit", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	ctx := context.Background()
+
+	// Reserving a connection doesn't begin a transaction.
+	reservedID, beginSQL, err := txPool.Begin(ctx, &querypb.ExecuteOptions{ReserveConnection: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if beginSQL != "" {
+		t.Errorf("beginSQL got %q want ''", beginSQL)
+	}
+
+	// The transactions are begun on the reserved connection, which
+	// stays reserved after them.
+	for _, commit := range []bool{true, false} {
+		txid, beginSQL, err := txPool.Begin(ctx, &querypb.ExecuteOptions{ReservedId: reservedID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if txid != reservedID || beginSQL != "begin" {
+			t.Errorf("Begin: %d, %q, want %d, begin", txid, beginSQL, reservedID)
+		}
+		if _, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{ReservedId: reservedID}); err == nil {
+			t.Error("Begin in a transaction: nil, want error")
+		}
+		if commit {
+			commitSQL, err := txPool.Commit(ctx, txid, &fakeMessageCommitter{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if commitSQL != "commit" {
+				t.Errorf("commitSQL got %q want commit", commitSQL)
+			}
+		} else if err := txPool.Rollback(ctx, txid); err != nil {
+			t.Fatal(err)
+		}
+		if got := txPool.activePool.Size(); got != 1 {
+			t.Errorf("active pool size: %d, want 1", got)
+		}
+	}
+
+	// The tx killer ignores the reserved connections.
+	txPool.SetTimeout(time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	txPool.transactionKiller()
+	if got := txPool.activePool.Size(); got != 1 {
+		t.Errorf("active pool size after the tx killer: %d, want 1", got)
+	}
+
+	// A rollback outside of a transaction keeps the connection.
+	if err := txPool.Rollback(ctx, reservedID); err != nil {
+		t.Fatal(err)
+	}
+	if got := txPool.activePool.Size(); got != 1 {
+		t.Errorf("active pool size after the rollback: %d, want 1", got)
+	}
+
+	// Only the reserved connections can be released.
+	txid, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := txPool.Release(ctx, txid); err == nil {
+		t.Error("Release of a transaction: nil, want error")
+	}
+	if err := txPool.Rollback(ctx, txid); err != nil {
+		t.Fatal(err)
+	}
+
+	// Release releases the connection, even in a transaction.
+	if _, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{ReservedId: reservedID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := txPool.Release(ctx, reservedID); err != nil {
+		t.Fatal(err)
+	}
+	if got := txPool.activePool.Size(); got != 0 {
+		t.Errorf("active pool size after the release: %d, want 0", got)
+	}
+	if err := txPool.Release(ctx, reservedID); err == nil {
+		t.Error("second Release: nil, want error")
+	}
+}
+
+func TestTxPoolReservedConnectionIdleTimeout(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	ctx := context.Background()
+
+	idleID, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{ReserveConnection: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	inTxID, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{ReserveConnection: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{ReservedId: inTxID}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The timeout is disabled by default.
+	time.Sleep(10 * time.Millisecond)
+	txPool.releaseIdleReserved()
+	if got := txPool.activePool.Size(); got != 2 {
+		t.Errorf("active pool size without timeout: %d, want 2", got)
+	}
+
+	// Only the idle reserved connection outside of a transaction is
+	// released.
+	killed := tabletenv.KillStats.Counts()["ReservedConnections"]
+	txPool.reservedIdleTimeout.Set(time.Millisecond)
+	txPool.releaseIdleReserved()
+	if got := txPool.activePool.Size(); got != 1 {
+		t.Errorf("active pool size after the timeout: %d, want 1", got)
+	}
+	if _, err := txPool.Get(idleID, "for test"); err == nil {
+		t.Error("Get of the idle reserved connection: nil, want error")
+	}
+	if got := tabletenv.KillStats.Counts()["ReservedConnections"]; got != killed+1 {
+		t.Errorf("ReservedConnections kills: %d, want %d", got, killed+1)
+	}
+	if err := txPool.Release(ctx, inTxID); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // TestTxPoolBeginWithPoolConnectionError_TransientErrno2006 tests the case
 // where we see a transient errno 2006 e.g. because MySQL killed the
 // db connection. DBConn.Exec() is going to reconnect and retry automatically
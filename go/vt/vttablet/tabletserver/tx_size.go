@@ -0,0 +1,102 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// txSizeLimits are the limits on the size of the transactions. Large
+// transactions bloat the binlogs and stall the replicas while they apply
+// them, so they are rejected before they are committed. A limit of 0
+// means no limit.
+type txSizeLimits struct {
+	maxRows   int64
+	warnRows  int64
+	maxBytes  int64
+	warnBytes int64
+	// exemptUsers are the users whose transactions have no limits.
+	exemptUsers map[string]bool
+}
+
+// newTxSizeLimits returns the transaction size limits of the config,
+// or nil if there are none.
+func newTxSizeLimits(config tabletenv.TransactionSizeConfig) *txSizeLimits {
+	if config.TransactionMaxRows == 0 && config.TransactionWarnRows == 0 && config.TransactionMaxBytes == 0 && config.TransactionWarnBytes == 0 {
+		return nil
+	}
+	limits := &txSizeLimits{
+		maxRows:     int64(config.TransactionMaxRows),
+		warnRows:    int64(config.TransactionWarnRows),
+		maxBytes:    int64(config.TransactionMaxBytes),
+		warnBytes:   int64(config.TransactionWarnBytes),
+		exemptUsers: make(map[string]bool),
+	}
+	for _, user := range config.TransactionSizeExemptUsers {
+		limits.exemptUsers[user] = true
+	}
+	return limits
+}
+
+func exceeds(value, limit int64) bool {
+	return limit > 0 && value > limit
+}
+
+// exempt returns true if the transaction belongs to an exempt user.
+func (limits *txSizeLimits) exempt(txc *TxConnection) bool {
+	if len(limits.exemptUsers) == 0 {
+		return false
+	}
+	if username := callerid.GetUsername(txc.ImmediateCallerID); username != "" && limits.exemptUsers[username] {
+		return true
+	}
+	principal := callerid.GetPrincipal(txc.EffectiveCallerID)
+	return principal != "" && limits.exemptUsers[principal]
+}
+
+// recordSize adds the rows modified by a statement of the transaction
+// and the size of the statement to the size of the transaction. If the
+// transaction exceeds the limits, it's rolled back and an error is
+// returned.
+func (txc *TxConnection) recordSize(query string, qr *sqltypes.Result) error {
+	txc.RowsModified += int64(qr.RowsAffected)
+	txc.BytesModified += int64(len(query))
+
+	limits := txc.pool.sizeLimits
+	if limits == nil || limits.exempt(txc) {
+		return nil
+	}
+	if exceeds(txc.RowsModified, limits.maxRows) || exceeds(txc.BytesModified, limits.maxBytes) {
+		tabletenv.LargeTransactions.Add([]string{txc.username(), "Rejected"}, 1)
+		// Closing the connection rolls back the transaction, and
+		// the connection is concluded when it's recycled.
+		txc.Close()
+		return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction too large: %d rows and %d bytes modified, the limits are %d rows and %d bytes: transaction rolled back", txc.RowsModified, txc.BytesModified, limits.maxRows, limits.maxBytes)
+	}
+	if !txc.sizeWarned && (exceeds(txc.RowsModified, limits.warnRows) || exceeds(txc.BytesModified, limits.warnBytes)) {
+		txc.sizeWarned = true
+		tabletenv.LargeTransactions.Add([]string{txc.username(), "Warned"}, 1)
+		log.Warningf("Large transaction %v of %v: %d rows and %d bytes modified, the warning thresholds are %d rows and %d bytes", txc.TransactionID, txc.username(), txc.RowsModified, txc.BytesModified, limits.warnRows, limits.warnBytes)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestNewTxSizeLimits(t *testing.T) {
+	if limits := newTxSizeLimits(tabletenv.TransactionSizeConfig{}); limits != nil {
+		t.Errorf("newTxSizeLimits(): %v, want nil", limits)
+	}
+	limits := newTxSizeLimits(tabletenv.TransactionSizeConfig{
+		TransactionMaxRows:         10,
+		TransactionSizeExemptUsers: []string{"batch"},
+	})
+	if limits == nil || limits.maxRows != 10 || !limits.exemptUsers["batch"] {
+		t.Errorf("newTxSizeLimits(): %+v, want max 10 rows and batch exempt", limits)
+	}
+}
+
+func TestTxSizeLimits(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+
+	txPool := newTxPool()
+	txPool.sizeLimits = newTxSizeLimits(tabletenv.TransactionSizeConfig{
+		TransactionMaxRows:         10,
+		TransactionWarnRows:        5,
+		TransactionMaxBytes:        1000,
+		TransactionSizeExemptUsers: []string{"batch"},
+	})
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+
+	begin := func(ctx context.Context) *TxConnection {
+		t.Helper()
+		transactionID, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		txc, err := txPool.Get(transactionID, "for query")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return txc
+	}
+	ctx := callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("app"))
+
+	// The warning threshold doesn't reject the transaction.
+	txc := begin(ctx)
+	warned := tabletenv.LargeTransactions.Counts()["app.Warned"]
+	if err := txc.recordSize("update t set a = 1", &sqltypes.Result{RowsAffected: 6}); err != nil {
+		t.Fatal(err)
+	}
+	if got := tabletenv.LargeTransactions.Counts()["app.Warned"]; got != warned+1 {
+		t.Errorf("LargeTransactions[app.Warned]: %v, want %v", got, warned+1)
+	}
+	if txc.RowsModified != 6 || txc.BytesModified != int64(len("update t set a = 1")) {
+		t.Errorf("size: %v rows and %v bytes, want 6 rows and %v bytes", txc.RowsModified, txc.BytesModified, len("update t set a = 1"))
+	}
+
+	// The rows of all the statements are counted.
+	err := txc.recordSize("update t set a = 2", &sqltypes.Result{RowsAffected: 6})
+	if code := vterrors.Code(err); code != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Errorf("recordSize: %v, want code %v", err, vtrpcpb.Code_RESOURCE_EXHAUSTED)
+	}
+	if err == nil || !strings.Contains(err.Error(), "transaction too large") {
+		t.Errorf("recordSize: %v, want transaction too large", err)
+	}
+	if !txc.IsClosed() {
+		t.Errorf("the connection of a rejected transaction must be closed")
+	}
+	txc.Recycle()
+	if _, err := txPool.Get(txc.TransactionID, "for query"); err == nil {
+		t.Errorf("the rejected transaction is still active")
+	}
+
+	// The bytes are limited too.
+	txc = begin(ctx)
+	if err := txc.recordSize(strings.Repeat("x", 1001), &sqltypes.Result{}); err == nil {
+		t.Errorf("recordSize: nil, want transaction too large")
+	}
+	txc.Recycle()
+
+	// The transactions of the exempt users are not limited.
+	txc = begin(callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("batch")))
+	if err := txc.recordSize("update t set a = 1", &sqltypes.Result{RowsAffected: 100}); err != nil {
+		t.Errorf("recordSize for an exempt user: %v", err)
+	}
+	txc.Recycle()
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// txWatcher watches the transactions that hold their connection without
+// running any statement, which is usually an application that forgot to
+// commit, or leaks its connections. The idle transactions are logged with
+// their caller and their statements, and rolled back if kill is set.
+type txWatcher struct {
+	threshold time.Duration
+	kill      bool
+	ticks     *timer.Timer
+}
+
+// newTxWatcher returns the transaction watcher of the config,
+// or nil if it's disabled.
+func newTxWatcher(config tabletenv.TransactionWatcherConfig) *txWatcher {
+	if config.TransactionIdleThreshold <= 0 {
+		return nil
+	}
+	threshold := time.Duration(config.TransactionIdleThreshold * 1e9)
+	return &txWatcher{
+		threshold: threshold,
+		kill:      config.TransactionIdleKill,
+		ticks:     timer.NewTimer(threshold / 10),
+	}
+}
+
+// watchIdleTransactions logs, counts and optionally kills the
+// transactions idle for longer than the threshold.
+func (axp *TxPool) watchIdleTransactions() {
+	defer tabletenv.LogError()
+	w := axp.watcher
+	idle := make(map[string]int64)
+	for _, v := range axp.activePool.GetIdle(w.threshold, "for idle transaction watcher") {
+		conn := v.(*TxConnection)
+		if conn.Reserved && conn.Autocommit {
+			// A reserved connection outside of a transaction
+			// holds no locks.
+			axp.activePool.PutIdle(conn.TransactionID)
+			continue
+		}
+		username := conn.username()
+		if w.kill {
+			log.Warningf("killing idle transaction (idle for more than %v): %s", w.threshold, conn.Format(nil))
+			tabletenv.IdleTransactions.Add([]string{username, "Killed"}, 1)
+			tabletenv.KillStats.Add("Transactions", 1)
+			conn.Close()
+			conn.conclude(TxKill, fmt.Sprintf("idle for more than %v", w.threshold))
+			continue
+		}
+		idle[username]++
+		if !conn.idleWarned {
+			// The transaction is logged once, but counted as long
+			// as it's idle.
+			log.Warningf("idle transaction (idle for more than %v): %s", w.threshold, conn.Format(nil))
+			tabletenv.IdleTransactions.Add([]string{username, "Warned"}, 1)
+			conn.idleWarned = true
+		}
+		axp.activePool.PutIdle(conn.TransactionID)
+	}
+	tabletenv.CurrentIdleTransactions.ZeroAll()
+	for username, count := range idle {
+		tabletenv.CurrentIdleTransactions.Set(username, count)
+	}
+}
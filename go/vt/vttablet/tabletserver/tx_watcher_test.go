@@ -0,0 +1,116 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestNewTxWatcher(t *testing.T) {
+	if w := newTxWatcher(tabletenv.TransactionWatcherConfig{}); w != nil {
+		t.Errorf("newTxWatcher(): %v, want nil", w)
+	}
+	w := newTxWatcher(tabletenv.TransactionWatcherConfig{
+		TransactionIdleThreshold: 2,
+		TransactionIdleKill:      true,
+	})
+	if w == nil || w.threshold != 2*time.Second || !w.kill {
+		t.Errorf("newTxWatcher(): %+v, want a 2s threshold and kill", w)
+	}
+}
+
+func TestTxWatcher(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+	db.AddQuery("update t set a = 1", &sqltypes.Result{})
+
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	// The watcher is set after Open to run it by hand.
+	txPool.watcher = newTxWatcher(tabletenv.TransactionWatcherConfig{
+		TransactionIdleThreshold: 0.05,
+	})
+
+	ctx := callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("leaky"))
+	transactionID, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	txc, err := txPool.Get(transactionID, "for query")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := txc.Exec(ctx, "update t set a = 1", 1, false); err != nil {
+		t.Fatal(err)
+	}
+	txc.RecordQuery("update t set a = 1")
+	txc.Recycle()
+
+	// The transaction is not idle yet.
+	warned := tabletenv.IdleTransactions.Counts()["leaky.Warned"]
+	txPool.watchIdleTransactions()
+	if got := tabletenv.IdleTransactions.Counts()["leaky.Warned"]; got != warned {
+		t.Errorf("IdleTransactions[leaky.Warned]: %v, want %v", got, warned)
+	}
+
+	// The idle transaction is logged once, and stays current.
+	time.Sleep(100 * time.Millisecond)
+	txPool.watchIdleTransactions()
+	txPool.watchIdleTransactions()
+	if got := tabletenv.IdleTransactions.Counts()["leaky.Warned"]; got != warned+1 {
+		t.Errorf("IdleTransactions[leaky.Warned]: %v, want %v", got, warned+1)
+	}
+	if got := tabletenv.CurrentIdleTransactions.Counts()["leaky"]; got != 1 {
+		t.Errorf("CurrentIdleTransactions[leaky]: %v, want 1", got)
+	}
+	// The transaction can still be used.
+	txc, err = txPool.Get(transactionID, "for query")
+	if err != nil {
+		t.Fatalf("Get after the watcher: %v", err)
+	}
+	txc.Recycle()
+
+	// With kill, the idle transaction is rolled back.
+	txPool.watcher.kill = true
+	time.Sleep(100 * time.Millisecond)
+	killed := tabletenv.IdleTransactions.Counts()["leaky.Killed"]
+	txPool.watchIdleTransactions()
+	if got := tabletenv.IdleTransactions.Counts()["leaky.Killed"]; got != killed+1 {
+		t.Errorf("IdleTransactions[leaky.Killed]: %v, want %v", got, killed+1)
+	}
+	if got := tabletenv.CurrentIdleTransactions.Counts()["leaky"]; got != 0 {
+		t.Errorf("CurrentIdleTransactions[leaky]: %v, want 0", got)
+	}
+	_, err = txPool.Get(transactionID, "for query")
+	if err == nil || !strings.Contains(err.Error(), "idle for more than") {
+		t.Errorf("Get after kill: %v, want idle for more than", err)
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"golang.org/x/net/context"
 
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/throttler"
@@ -69,6 +70,14 @@ type TxThrottler struct {
 	// state holds an open transaction throttler state. It is nil
 	// if the TransactionThrottler is closed.
 	state *txThrottlerState
+
+	// lastThrottled is the time, in nanoseconds since the epoch,
+	// of the last throttled transaction. It's 0 if no transaction
+	// was throttled since the throttler was opened. Only Throttle sets
+	// it: the lag checks of the SplitQuery query parts go through
+	// ReplicationLag, and don't make the tablet report throttled
+	// transactions.
+	lastThrottled sync2.AtomicInt64
 }
 
 // CreateTxThrottlerFromTabletConfig tries to construct a TxThrottler from the
@@ -160,6 +169,11 @@ type txThrottlerState struct {
 
 	healthCheck      discovery.HealthCheck
 	topologyWatchers []TopologyWatcherInterface
+
+	// lagMu guards lags.
+	lagMu sync.Mutex
+	// lags is the replication lag of the healthy replicas, by key.
+	lags map[string]time.Duration
 }
 
 // These vars store the functions used to create the topo server, healthcheck,
@@ -235,6 +249,31 @@ func (t *TxThrottler) Close() {
 	log.Infof("Shutting down transaction throttler.")
 	t.state.deallocateResources()
 	t.state = nil
+	t.lastThrottled.Set(0)
+}
+
+// UpdateConfiguration changes the configuration of an enabled
+// transaction throttler to config, a text formatted
+// throttlerdata.Configuration protocol buffer message. It can be called
+// while transactions are throttled.
+func (t *TxThrottler) UpdateConfiguration(config string) error {
+	if !t.config.enabled {
+		return fmt.Errorf("transaction throttler is disabled")
+	}
+	var throttlerConfig throttlerdatapb.Configuration
+	if err := proto.UnmarshalText(config, &throttlerConfig); err != nil {
+		return err
+	}
+	if err := (throttler.MaxReplicationLagModuleConfig{Configuration: throttlerConfig}).Verify(); err != nil {
+		return err
+	}
+	if t.state != nil {
+		if err := t.state.throttler.UpdateConfiguration(&throttlerConfig, true /* copyZeroValues */); err != nil {
+			return err
+		}
+	}
+	t.config.throttlerConfig = &throttlerConfig
+	return nil
 }
 
 // Throttle should be called before a new transaction is started.
@@ -248,7 +287,29 @@ func (t *TxThrottler) Throttle() (result bool) {
 	if t.state == nil {
 		panic("BUG: Throttle() called on a closed TxThrottler")
 	}
-	return t.state.throttle()
+	if !t.state.throttle() {
+		return false
+	}
+	t.lastThrottled.Set(time.Now().UnixNano())
+	return true
+}
+
+// ReplicationLag returns the highest replication lag of the healthy
+// replicas watched by the throttler. Unlike Throttle, it doesn't change
+// the state of the throttler. ok is false if the throttler is disabled
+// or closed, or if it didn't see a healthy replica yet.
+func (t *TxThrottler) ReplicationLag() (lag time.Duration, ok bool) {
+	if !t.config.enabled || t.state == nil {
+		return 0, false
+	}
+	return t.state.replicationLag()
+}
+
+// Throttled returns true if a transaction was throttled during the
+// given interval. It's reported in the health of the tablet.
+func (t *TxThrottler) Throttled(interval time.Duration) bool {
+	last := t.lastThrottled.Get()
+	return last != 0 && time.Since(time.Unix(0, last)) < interval
 }
 
 func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
@@ -268,6 +329,7 @@ func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
 	}
 	result := &txThrottlerState{
 		throttler: t,
+		lags:      make(map[string]time.Duration),
 	}
 	result.healthCheck = healthCheckFactory()
 	result.healthCheck.SetListener(result, false /* sendDownEvents */)
@@ -328,4 +390,24 @@ func (ts *txThrottlerState) StatsUpdate(tabletStats *discovery.TabletStats) {
 		return
 	}
 	ts.throttler.RecordReplicationLag(time.Now(), tabletStats)
+
+	ts.lagMu.Lock()
+	defer ts.lagMu.Unlock()
+	if tabletStats.Up && tabletStats.Serving && tabletStats.LastError == nil && tabletStats.Stats != nil {
+		ts.lags[tabletStats.Key] = time.Duration(tabletStats.Stats.SecondsBehindMaster) * time.Second
+	} else {
+		delete(ts.lags, tabletStats.Key)
+	}
+}
+
+func (ts *txThrottlerState) replicationLag() (time.Duration, bool) {
+	ts.lagMu.Lock()
+	defer ts.lagMu.Unlock()
+	var max time.Duration
+	for _, lag := range ts.lags {
+		if lag > max {
+			max = lag
+		}
+	}
+	return max, len(ts.lags) != 0
 }
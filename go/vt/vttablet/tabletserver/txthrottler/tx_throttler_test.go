@@ -26,12 +26,14 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	throttlerdatapb "vitess.io/vitess/go/vt/proto/throttlerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -46,6 +48,9 @@ func TestDisabledThrottler(t *testing.T) {
 	if result := throttler.Throttle(); result != false {
 		t.Errorf("want: false, got: %v", result)
 	}
+	if _, ok := throttler.ReplicationLag(); ok {
+		t.Errorf("ReplicationLag(): ok, want no lag for a disabled throttler")
+	}
 	throttler.Close()
 }
 
@@ -97,9 +102,13 @@ func TestEnabledThrottler(t *testing.T) {
 	call1 := mockThrottler.EXPECT().Throttle(0)
 	call1.Return(0 * time.Second)
 	tabletStats := &discovery.TabletStats{
+		Key: "replica1",
 		Target: &querypb.Target{
 			TabletType: topodatapb.TabletType_REPLICA,
 		},
+		Up:      true,
+		Serving: true,
+		Stats:   &querypb.RealtimeStats{SecondsBehindMaster: 3},
 	}
 	call2 := mockThrottler.EXPECT().RecordReplicationLag(gomock.Any(), tabletStats)
 	call3 := mockThrottler.EXPECT().Throttle(0)
@@ -125,7 +134,19 @@ func TestEnabledThrottler(t *testing.T) {
 	if result := throttler.Throttle(); result != false {
 		t.Errorf("want: false, got: %v", result)
 	}
+	if throttler.Throttled(time.Minute) {
+		t.Errorf("Throttled(): true, want false before the first throttled transaction")
+	}
+	if _, ok := throttler.ReplicationLag(); ok {
+		t.Errorf("ReplicationLag(): ok, want no lag before the first replica")
+	}
 	hcListener.StatsUpdate(tabletStats)
+	if lag, ok := throttler.ReplicationLag(); !ok || lag != 3*time.Second {
+		t.Errorf("ReplicationLag(): %v, %v, want 3s, true", lag, ok)
+	}
+	if throttler.Throttled(time.Minute) {
+		t.Errorf("Throttled(): true after ReplicationLag, want false before the first throttled transaction")
+	}
 	rdonlyTabletStats := &discovery.TabletStats{
 		Target: &querypb.Target{
 			TabletType: topodatapb.TabletType_RDONLY,
@@ -137,5 +158,55 @@ func TestEnabledThrottler(t *testing.T) {
 	if result := throttler.Throttle(); result != true {
 		t.Errorf("want: true, got: %v", result)
 	}
+	if !throttler.Throttled(time.Minute) {
+		t.Errorf("Throttled(): false, want true after a throttled transaction")
+	}
 	throttler.Close()
+	if _, ok := throttler.ReplicationLag(); ok {
+		t.Errorf("ReplicationLag(): ok, want no lag for a closed throttler")
+	}
+	if throttler.Throttled(time.Minute) {
+		t.Errorf("Throttled(): true, want false for a closed throttler")
+	}
+}
+
+func TestUpdateConfiguration(t *testing.T) {
+	oldConfig := tabletenv.Config
+	defer func() { tabletenv.Config = oldConfig }()
+	tabletenv.Config.EnableTxThrottler = false
+	throttler := CreateTxThrottlerFromTabletConfig(nil)
+	if err := throttler.UpdateConfiguration(tabletenv.Config.TxThrottlerConfig); err == nil {
+		t.Errorf("UpdateConfiguration of a disabled throttler succeeded, want error")
+	}
+
+	tabletenv.Config.EnableTxThrottler = true
+	tabletenv.Config.TxThrottlerHealthCheckCells = []string{"cell1"}
+	throttler, err := tryCreateTxThrottler(nil)
+	if err != nil {
+		t.Fatalf("want: nil, got: %v", err)
+	}
+	config := func(targetLag, maxLag int64) string {
+		var c throttlerdatapb.Configuration
+		if err := proto.UnmarshalText(tabletenv.Config.TxThrottlerConfig, &c); err != nil {
+			t.Fatal(err)
+		}
+		c.TargetReplicationLagSec = targetLag
+		c.MaxReplicationLagSec = maxLag
+		return proto.MarshalTextString(&c)
+	}
+	if err := throttler.UpdateConfiguration(config(5, 2)); err == nil {
+		t.Errorf("UpdateConfiguration with a target lag higher than the max lag succeeded, want error")
+	}
+	if err := throttler.UpdateConfiguration("not a configuration"); err == nil {
+		t.Errorf("UpdateConfiguration with an invalid configuration succeeded, want error")
+	}
+	if got, want := throttler.config.throttlerConfig.MaxReplicationLagSec, int64(10); got != want {
+		t.Errorf("max replication lag after the invalid configurations: %v, want %v", got, want)
+	}
+	if err := throttler.UpdateConfiguration(config(2, 20)); err != nil {
+		t.Fatalf("UpdateConfiguration: %v", err)
+	}
+	if got, want := throttler.config.throttlerConfig.MaxReplicationLagSec, int64(20); got != want {
+		t.Errorf("max replication lag: %v, want %v", got, want)
+	}
 }
@@ -24,10 +24,13 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/golang/protobuf/proto"
+
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/binlog/consumerthrottler"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/srvtopo"
@@ -153,6 +156,9 @@ func (vse *Engine) Stream(ctx context.Context, startPos string, filter *binlogda
 	// because this overhead should be incurred only if someone uses this feature.
 	vse.watcherOnce.Do(vse.setWatch)
 
+	throttler := consumerthrottler.Open(consumerthrottler.ConsumerName(ctx, "VStream"))
+	defer throttler.Close()
+
 	// Create stream and add it to the map.
 	streamer, idx, err := func() (*vstreamer, int, error) {
 		vse.mu.Lock()
@@ -161,6 +167,7 @@ func (vse *Engine) Stream(ctx context.Context, startPos string, filter *binlogda
 			return nil, 0, errors.New("VStreamer is not open")
 		}
 		streamer := newVStreamer(ctx, vse.cp, vse.se, startPos, filter, vse.kschema, send)
+		streamer.send = throttleVEvents(streamer.ctx, throttler, send)
 		idx := vse.streamIdx
 		vse.streamers[idx] = streamer
 		vse.streamIdx++
@@ -193,6 +200,9 @@ func (vse *Engine) StreamRows(ctx context.Context, query string, lastpk []sqltyp
 	vse.watcherOnce.Do(vse.setWatch)
 	log.Infof("Streaming rows for query %s, lastpk: %s", query, lastpk)
 
+	throttler := consumerthrottler.Open(consumerthrottler.ConsumerName(ctx, "VStreamRows"))
+	defer throttler.Close()
+
 	// Create stream and add it to the map.
 	rowStreamer, idx, err := func() (*rowStreamer, int, error) {
 		vse.mu.Lock()
@@ -201,6 +211,7 @@ func (vse *Engine) StreamRows(ctx context.Context, query string, lastpk []sqltyp
 			return nil, 0, errors.New("VStreamer is not open")
 		}
 		rowStreamer := newRowStreamer(ctx, vse.cp, vse.se, query, lastpk, vse.kschema, send)
+		rowStreamer.send = throttleRows(rowStreamer.ctx, throttler, send)
 		idx := vse.streamIdx
 		vse.rowStreamers[idx] = rowStreamer
 		vse.streamIdx++
@@ -227,6 +238,9 @@ func (vse *Engine) StreamRows(ctx context.Context, query string, lastpk []sqltyp
 
 // StreamResults streams results of the query with the gtid.
 func (vse *Engine) StreamResults(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+	throttler := consumerthrottler.Open(consumerthrottler.ConsumerName(ctx, "VStreamResults"))
+	defer throttler.Close()
+
 	// Create stream and add it to the map.
 	resultStreamer, idx, err := func() (*resultStreamer, int, error) {
 		vse.mu.Lock()
@@ -235,6 +249,7 @@ func (vse *Engine) StreamResults(ctx context.Context, query string, send func(*b
 			return nil, 0, errors.New("VStreamer is not open")
 		}
 		resultStreamer := newResultStreamer(ctx, vse.cp, query, send)
+		resultStreamer.send = throttleResults(resultStreamer.ctx, throttler, send)
 		idx := vse.streamIdx
 		vse.resultStreamers[idx] = resultStreamer
 		vse.streamIdx++
@@ -259,6 +274,42 @@ func (vse *Engine) StreamResults(ctx context.Context, query string, send func(*b
 	return resultStreamer.Stream()
 }
 
+// throttleVEvents returns send, throttled by the throttler of the
+// consumer. The context is the one of the stream, so that closing the
+// engine interrupts the throttled streams.
+func throttleVEvents(ctx context.Context, throttler *consumerthrottler.Throttler, send func([]*binlogdatapb.VEvent) error) func([]*binlogdatapb.VEvent) error {
+	return func(events []*binlogdatapb.VEvent) error {
+		size := 0
+		for _, event := range events {
+			size += proto.Size(event)
+		}
+		if err := throttler.Wait(ctx, len(events), size); err != nil {
+			return err
+		}
+		return send(events)
+	}
+}
+
+// throttleRows is like throttleVEvents, for the row streams.
+func throttleRows(ctx context.Context, throttler *consumerthrottler.Throttler, send func(*binlogdatapb.VStreamRowsResponse) error) func(*binlogdatapb.VStreamRowsResponse) error {
+	return func(resp *binlogdatapb.VStreamRowsResponse) error {
+		if err := throttler.Wait(ctx, len(resp.Rows), proto.Size(resp)); err != nil {
+			return err
+		}
+		return send(resp)
+	}
+}
+
+// throttleResults is like throttleVEvents, for the result streams.
+func throttleResults(ctx context.Context, throttler *consumerthrottler.Throttler, send func(*binlogdatapb.VStreamResultsResponse) error) func(*binlogdatapb.VStreamResultsResponse) error {
+	return func(resp *binlogdatapb.VStreamResultsResponse) error {
+		if err := throttler.Wait(ctx, len(resp.Rows), proto.Size(resp)); err != nil {
+			return err
+		}
+		return send(resp)
+	}
+}
+
 // ServeHTTP shows the current VSchema.
 func (vse *Engine) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package vstreamer
 
 import (
+	"bytes"
 	"fmt"
 	"regexp"
 	"strings"
@@ -40,6 +41,58 @@ type Plan struct {
 	VindexColumn int
 	Vindex       vindexes.Vindex
 	KeyRange     *topodatapb.KeyRange
+
+	// Filters are the row conditions of the where clause. A row is
+	// sent only if it satisfies all of them.
+	Filters []Filter
+}
+
+// Opcode enumerates the operators supported by a Filter.
+type Opcode int
+
+// The list of Opcode values.
+const (
+	Equal = Opcode(iota)
+	NotEqual
+	LessThan
+	LessThanEqual
+	GreaterThan
+	GreaterThanEqual
+	In
+	NotIn
+	IsNull
+	IsNotNull
+)
+
+var comparisonOpcodes = map[string]Opcode{
+	sqlparser.EqualStr:        Equal,
+	sqlparser.NotEqualStr:     NotEqual,
+	sqlparser.LessThanStr:     LessThan,
+	sqlparser.LessEqualStr:    LessThanEqual,
+	sqlparser.GreaterThanStr:  GreaterThan,
+	sqlparser.GreaterEqualStr: GreaterThanEqual,
+	sqlparser.InStr:           In,
+	sqlparser.NotInStr:        NotIn,
+}
+
+// reversedOpcodes is used when the literal is on the left side
+// of a comparison: 5 < id is the same as id > 5.
+var reversedOpcodes = map[Opcode]Opcode{
+	Equal:            Equal,
+	NotEqual:         NotEqual,
+	LessThan:         GreaterThan,
+	LessThanEqual:    GreaterThanEqual,
+	GreaterThan:      LessThan,
+	GreaterThanEqual: LessThanEqual,
+}
+
+// Filter represents a condition on a column of the source row.
+// ColNum refers to the column of the table, which need not be
+// in the select list.
+type Filter struct {
+	Opcode Opcode
+	ColNum int
+	Values []sqltypes.Value
 }
 
 // ColExpr represents a column expression.
@@ -70,6 +123,18 @@ func (plan *Plan) fields() []*querypb.Field {
 // filter filters the row against the plan. It returns false if the row did not match.
 // If the row matched, it returns the columns to be sent.
 func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error) {
+	for _, filter := range plan.Filters {
+		if filter.ColNum >= len(values) {
+			return false, nil, fmt.Errorf("index out of range, filter.ColNum: %d, len(values): %d", filter.ColNum, len(values))
+		}
+		match, err := filter.matches(values[filter.ColNum])
+		if err != nil {
+			return false, nil, err
+		}
+		if !match {
+			return false, nil, nil
+		}
+	}
 	result := make([]sqltypes.Value, len(plan.ColExprs))
 	for i, colExpr := range plan.ColExprs {
 		if colExpr.ColNum >= len(values) {
@@ -99,6 +164,66 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 	return true, result, nil
 }
 
+// matches returns true if the value satisfies the filter.
+// Like in SQL, a NULL value never satisfies a comparison.
+func (filter *Filter) matches(value sqltypes.Value) (bool, error) {
+	switch filter.Opcode {
+	case IsNull:
+		return value.IsNull(), nil
+	case IsNotNull:
+		return !value.IsNull(), nil
+	}
+	if value.IsNull() {
+		return false, nil
+	}
+	switch filter.Opcode {
+	case In, NotIn:
+		for _, fv := range filter.Values {
+			cmp, err := compareValues(value, fv)
+			if err != nil {
+				return false, err
+			}
+			if cmp == 0 {
+				return filter.Opcode == In, nil
+			}
+		}
+		return filter.Opcode == NotIn, nil
+	}
+	cmp, err := compareValues(value, filter.Values[0])
+	if err != nil {
+		return false, err
+	}
+	switch filter.Opcode {
+	case Equal:
+		return cmp == 0, nil
+	case NotEqual:
+		return cmp != 0, nil
+	case LessThan:
+		return cmp < 0, nil
+	case LessThanEqual:
+		return cmp <= 0, nil
+	case GreaterThan:
+		return cmp > 0, nil
+	case GreaterThanEqual:
+		return cmp >= 0, nil
+	}
+	return false, fmt.Errorf("unexpected filter opcode: %d", filter.Opcode)
+}
+
+// compareValues compares numerically if either value is a number.
+// Otherwise, the values are compared as bytes, which allows a text
+// column to be compared against a string literal.
+func compareValues(v1, v2 sqltypes.Value) (int, error) {
+	if isNumeric(v1) || isNumeric(v2) {
+		return sqltypes.NullsafeCompare(v1, v2)
+	}
+	return bytes.Compare(v1.ToBytes(), v2.ToBytes()), nil
+}
+
+func isNumeric(v sqltypes.Value) bool {
+	return v.IsIntegral() || v.IsFloat() || v.Type() == sqltypes.Decimal
+}
+
 func mustSendDDL(query mysql.Query, dbname string, filter *binlogdatapb.Filter) bool {
 	if query.Database != "" && query.Database != dbname {
 		return false
@@ -241,14 +366,7 @@ func buildTablePlan(ti *Table, kschema *vindexes.KeyspaceSchema, query string) (
 		return plan, nil
 	}
 
-	funcExpr, ok := sel.Where.Expr.(*sqlparser.FuncExpr)
-	if !ok {
-		return nil, fmt.Errorf("unsupported where clause: %v", sqlparser.String(sel.Where))
-	}
-	if !funcExpr.Name.EqualString("in_keyrange") {
-		return nil, fmt.Errorf("unsupported where clause: %v", sqlparser.String(sel.Where))
-	}
-	if err := plan.analyzeInKeyRange(kschema, funcExpr.Exprs); err != nil {
+	if err := plan.analyzeWhere(kschema, sel.Where.Expr); err != nil {
 		return nil, err
 	}
 	return plan, nil
@@ -323,6 +441,107 @@ func (plan *Plan) analyzeExpr(selExpr sqlparser.SelectExpr) (cExpr ColExpr, err
 	return ColExpr{ColNum: colnum, Alias: as, Type: plan.Table.Columns[colnum].Type}, nil
 }
 
+// analyzeWhere builds the filters for a where clause. The clause
+// must be a conjunction of in_keyrange and comparisons of columns
+// against constants.
+func (plan *Plan) analyzeWhere(kschema *vindexes.KeyspaceSchema, where sqlparser.Expr) error {
+	for _, expr := range sqlparser.SplitAndExpression(nil, where) {
+		switch expr := expr.(type) {
+		case *sqlparser.FuncExpr:
+			if !expr.Name.EqualString("in_keyrange") {
+				return fmt.Errorf("unsupported where clause: %v", sqlparser.String(expr))
+			}
+			if plan.Vindex != nil {
+				return fmt.Errorf("unsupported: more than one in_keyrange: %v", sqlparser.String(where))
+			}
+			if err := plan.analyzeInKeyRange(kschema, expr.Exprs); err != nil {
+				return err
+			}
+		case *sqlparser.ComparisonExpr:
+			filter, err := plan.analyzeComparison(expr)
+			if err != nil {
+				return err
+			}
+			plan.Filters = append(plan.Filters, filter)
+		case *sqlparser.IsExpr:
+			colnum, err := plan.filterColumn(expr.Expr)
+			if err != nil {
+				return err
+			}
+			switch expr.Operator {
+			case sqlparser.IsNullStr:
+				plan.Filters = append(plan.Filters, Filter{Opcode: IsNull, ColNum: colnum})
+			case sqlparser.IsNotNullStr:
+				plan.Filters = append(plan.Filters, Filter{Opcode: IsNotNull, ColNum: colnum})
+			default:
+				return fmt.Errorf("unsupported where clause: %v", sqlparser.String(expr))
+			}
+		default:
+			return fmt.Errorf("unsupported where clause: %v", sqlparser.String(expr))
+		}
+	}
+	return nil
+}
+
+func (plan *Plan) analyzeComparison(expr *sqlparser.ComparisonExpr) (Filter, error) {
+	opcode, ok := comparisonOpcodes[expr.Operator]
+	if !ok {
+		return Filter{}, fmt.Errorf("unsupported where clause: %v", sqlparser.String(expr))
+	}
+	colExpr, valExpr := expr.Left, expr.Right
+	if _, ok := colExpr.(*sqlparser.ColName); !ok {
+		reversed, ok := reversedOpcodes[opcode]
+		if !ok {
+			return Filter{}, fmt.Errorf("unsupported where clause: %v", sqlparser.String(expr))
+		}
+		opcode = reversed
+		colExpr, valExpr = valExpr, colExpr
+	}
+	colnum, err := plan.filterColumn(colExpr)
+	if err != nil {
+		return Filter{}, err
+	}
+	pv, err := sqlparser.NewPlanValue(valExpr)
+	if err != nil || pv.IsNull() || pv.Key != "" || pv.ListKey != "" {
+		return Filter{}, fmt.Errorf("unsupported where clause: %v", sqlparser.String(expr))
+	}
+	var values []sqltypes.Value
+	if opcode == In || opcode == NotIn {
+		if pv.Values == nil {
+			return Filter{}, fmt.Errorf("unsupported where clause: %v", sqlparser.String(expr))
+		}
+		values, err = pv.ResolveList(nil)
+	} else {
+		if pv.Values != nil {
+			return Filter{}, fmt.Errorf("unsupported where clause: %v", sqlparser.String(expr))
+		}
+		var value sqltypes.Value
+		value, err = pv.ResolveValue(nil)
+		values = []sqltypes.Value{value}
+	}
+	if err != nil {
+		return Filter{}, err
+	}
+	for _, value := range values {
+		if value.IsNull() {
+			return Filter{}, fmt.Errorf("unsupported: null value in where clause: %v", sqlparser.String(expr))
+		}
+	}
+	return Filter{Opcode: opcode, ColNum: colnum, Values: values}, nil
+}
+
+// filterColumn returns the table column referenced by expr.
+func (plan *Plan) filterColumn(expr sqlparser.Expr) (int, error) {
+	colname, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return 0, fmt.Errorf("unsupported: %v", sqlparser.String(expr))
+	}
+	if !colname.Qualifier.IsEmpty() {
+		return 0, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(colname))
+	}
+	return findColumn(plan.Table, colname.Name)
+}
+
 func (plan *Plan) analyzeInKeyRange(kschema *vindexes.KeyspaceSchema, exprs sqlparser.SelectExprs) error {
 	var colname sqlparser.ColIdent
 	var krExpr sqlparser.SelectExpr
@@ -284,6 +284,47 @@ func TestPlanbuilder(t *testing.T) {
 			}},
 			VindexColumn: 1,
 		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select val from t1 where id = 1 and val != 'a'"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 1,
+				Alias:  sqlparser.NewColIdent("val"),
+				Type:   sqltypes.VarBinary,
+			}},
+			Filters: []Filter{{
+				Opcode: Equal,
+				ColNum: 0,
+				Values: []sqltypes.Value{sqltypes.NewInt64(1)},
+			}, {
+				Opcode: NotEqual,
+				ColNum: 1,
+				Values: []sqltypes.Value{sqltypes.NewVarBinary("a")},
+			}},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id from t1 where (10 > id and val in ('a', 'b')) and val is not null and in_keyrange('-80')"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Alias:  sqlparser.NewColIdent("id"),
+				Type:   sqltypes.Int64,
+			}},
+			Filters: []Filter{{
+				Opcode: LessThan,
+				ColNum: 0,
+				Values: []sqltypes.Value{sqltypes.NewInt64(10)},
+			}, {
+				Opcode: In,
+				ColNum: 1,
+				Values: []sqltypes.Value{sqltypes.NewVarBinary("a"), sqltypes.NewVarBinary("b")},
+			}, {
+				Opcode: IsNotNull,
+				ColNum: 1,
+			}},
+		},
 	}, {
 		inTable: t2,
 		inRule:  &binlogdatapb.Rule{Match: "/t1/"},
@@ -337,12 +378,52 @@ func TestPlanbuilder(t *testing.T) {
 		outErr:  `unsupported: *, id`,
 	}, {
 		inTable: t1,
-		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id=1"},
-		outErr:  `unsupported where clause:  where id = 1`,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where max(id)"},
+		outErr:  `unsupported where clause: max(id)`,
 	}, {
 		inTable: t1,
-		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where max(id)"},
-		outErr:  `unsupported where clause:  where max(id)`,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id = 1 or val = 'a'"},
+		outErr:  `unsupported where clause: id = 1 or val = 'a'`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id like 'a%'"},
+		outErr:  `unsupported where clause: id like 'a%'`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id = val"},
+		outErr:  `unsupported where clause: id = val`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id = :id"},
+		outErr:  `unsupported where clause: id = :id`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id = null"},
+		outErr:  `unsupported where clause: id = null`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id in (1, null)"},
+		outErr:  `unsupported: null value in where clause: id in (1, null)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where 1 in (id)"},
+		outErr:  `unsupported where clause: 1 in (id)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where none = 1"},
+		outErr:  `column none not found in table t1`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where t1.id = 1"},
+		outErr:  `unsupported qualifier for column: t1.id`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id is true"},
+		outErr:  `unsupported where clause: id is true`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange('-80') and in_keyrange('80-')"},
+		outErr:  `unsupported: more than one in_keyrange: in_keyrange('-80') and in_keyrange('80-')`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange(id)"},
@@ -427,3 +508,86 @@ func TestPlanbuilder(t *testing.T) {
 
 	}
 }
+
+func TestPlanFilter(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Columns: []schema.TableColumn{{
+			Name: sqlparser.NewColIdent("id"),
+			Type: sqltypes.Int64,
+		}, {
+			Name: sqlparser.NewColIdent("val"),
+			Type: sqltypes.VarChar,
+		}},
+	}
+	row := func(id sqltypes.Value, val sqltypes.Value) []sqltypes.Value {
+		return []sqltypes.Value{id, val}
+	}
+	testcases := []struct {
+		where string
+		row   []sqltypes.Value
+		want  bool
+	}{{
+		where: "id = 1",
+		row:   row(sqltypes.NewInt64(1), sqltypes.NewVarChar("a")),
+		want:  true,
+	}, {
+		where: "id = 1",
+		row:   row(sqltypes.NewInt64(2), sqltypes.NewVarChar("a")),
+		want:  false,
+	}, {
+		where: "id = '1'",
+		row:   row(sqltypes.NewInt64(1), sqltypes.NewVarChar("a")),
+		want:  true,
+	}, {
+		where: "id >= 10",
+		row:   row(sqltypes.NewInt64(9), sqltypes.NewVarChar("a")),
+		want:  false,
+	}, {
+		where: "id <= 10",
+		row:   row(sqltypes.NewInt64(9), sqltypes.NewVarChar("a")),
+		want:  true,
+	}, {
+		where: "id != 1",
+		row:   row(sqltypes.NULL, sqltypes.NewVarChar("a")),
+		want:  false,
+	}, {
+		where: "id is null",
+		row:   row(sqltypes.NULL, sqltypes.NewVarChar("a")),
+		want:  true,
+	}, {
+		where: "val > 'a'",
+		row:   row(sqltypes.NewInt64(1), sqltypes.NewVarChar("b")),
+		want:  true,
+	}, {
+		where: "val in ('a', 'b')",
+		row:   row(sqltypes.NewInt64(1), sqltypes.NewVarChar("b")),
+		want:  true,
+	}, {
+		where: "val not in ('a', 'b')",
+		row:   row(sqltypes.NewInt64(1), sqltypes.NewVarChar("b")),
+		want:  false,
+	}, {
+		where: "id = 1 and val = 'b'",
+		row:   row(sqltypes.NewInt64(1), sqltypes.NewVarChar("a")),
+		want:  false,
+	}}
+	for _, tcase := range testcases {
+		plan, err := buildPlan(t1, testKSChema, &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select val from t1 where " + tcase.where}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, values, err := plan.filter(tcase.row)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tcase.want {
+			t.Errorf("filter(%s, %v): %v, want %v", tcase.where, tcase.row, got, tcase.want)
+		}
+		if got && !reflect.DeepEqual(values, tcase.row[1:]) {
+			t.Errorf("filter(%s, %v): %v, want %v", tcase.where, tcase.row, values, tcase.row[1:])
+		}
+	}
+}
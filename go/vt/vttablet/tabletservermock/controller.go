@@ -25,12 +25,14 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -208,6 +210,41 @@ func (tqsc *Controller) TopoServer() *topo.Server {
 	return tqsc.TS
 }
 
+// Pools is part of the tabletserver.Controller interface.
+func (tqsc *Controller) Pools() []*tabletmanagerdatapb.PoolInfo {
+	return nil
+}
+
+// ResizePool is part of the tabletserver.Controller interface.
+func (tqsc *Controller) ResizePool(name string, capacity int, idleTimeout time.Duration, prefillParallelism int) (*tabletmanagerdatapb.PoolInfo, error) {
+	return nil, nil
+}
+
+// LockDiagnostics is part of the tabletserver.Controller interface.
+func (tqsc *Controller) LockDiagnostics(lastAutomatic bool) (*tabletmanagerdatapb.LockDiagnostics, error) {
+	return nil, nil
+}
+
+// QueryPlanStats is part of the tabletserver.Controller interface.
+func (tqsc *Controller) QueryPlanStats() []*tabletmanagerdatapb.QueryPlanStats {
+	return nil
+}
+
+// QueryBlocklist is part of the tabletserver.Controller interface.
+func (tqsc *Controller) QueryBlocklist() ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	return nil, nil
+}
+
+// UpdateQueryBlocklist is part of the tabletserver.Controller interface.
+func (tqsc *Controller) UpdateQueryBlocklist(action, fingerprint, reason string) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	return nil, nil
+}
+
+// RuntimeFlags is part of the tabletserver.Controller interface.
+func (tqsc *Controller) RuntimeFlags() *servenv.RuntimeFlagSet {
+	return servenv.NewRuntimeFlagSet()
+}
+
 // EnterLameduck implements tabletserver.Controller.
 func (tqsc *Controller) EnterLameduck() {
 	tqsc.mu.Lock()
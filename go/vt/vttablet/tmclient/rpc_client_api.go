@@ -74,6 +74,38 @@ type TabletManagerClient interface {
 	// RefreshState asks the remote tablet to reload its tablet record
 	RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error
 
+	// GetPools returns the connection pools of the remote tablet
+	GetPools(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.PoolInfo, error)
+
+	// ResizePool changes the capacity, idle timeout or prefill
+	// parallelism of a connection pool of the remote tablet. Only the
+	// positive values are applied.
+	ResizePool(ctx context.Context, tablet *topodatapb.Tablet, name string, capacity int, idleTimeout time.Duration, prefillParallelism int) (*tabletmanagerdatapb.PoolInfo, error)
+
+	// GetLockDiagnostics returns the lock waits and the latest deadlock
+	// of the MySQL server of the remote tablet. If lastAutomatic is set,
+	// it returns the last report captured when the lock errors spiked.
+	GetLockDiagnostics(ctx context.Context, tablet *topodatapb.Tablet, lastAutomatic bool) (*tabletmanagerdatapb.LockDiagnostics, error)
+
+	// GetQueryPlanStats returns the query counts, row counts, error
+	// counts and latency percentiles of the queries of the plan cache
+	// of the remote tablet.
+	GetQueryPlanStats(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryPlanStats, error)
+
+	// GetQueryBlocklist returns the query fingerprints blocked by the
+	// query blocklist of the remote tablet, or pending approval.
+	GetQueryBlocklist(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error)
+
+	// UpdateQueryBlocklist approves, blocks or removes a fingerprint of
+	// the query blocklist of the remote tablet. The action is "approve",
+	// "block" or "remove". It returns the entries of the blocklist.
+	UpdateQueryBlocklist(ctx context.Context, tablet *topodatapb.Tablet, action, fingerprint, reason string) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error)
+
+	// SetRuntimeFlags changes flags of the remote tablet, like its pool
+	// sizes and its timeouts, without restarting it. The flags are
+	// changed all together or not at all.
+	SetRuntimeFlags(ctx context.Context, tablet *topodatapb.Tablet, flags map[string]string) ([]*tabletmanagerdatapb.RuntimeFlagChange, error)
+
 	// RunHealthCheck asks the remote tablet to run a health check cycle
 	RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error
 
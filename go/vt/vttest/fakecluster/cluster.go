@@ -0,0 +1,202 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fakecluster provides an in-memory Vitess cluster for unit
+// tests: a topo server, fake tablets that record the queries they
+// receive and return canned results, and the vtgate executor that
+// routes the queries to them. Unlike vttest, it doesn't need MySQL or
+// any Vitess binary.
+//
+//	cluster, err := fakecluster.New(ctx, "zone1")
+//	err = cluster.AddKeyspace(ctx, "user", fakecluster.ShardedVSchema().
+//		Vindex("hash", "hash", nil).
+//		Table("user", "id", "hash").
+//		Build(), fakecluster.Shards(2)...)
+//	executor := cluster.NewExecutor(ctx)
+//	cluster.Tablet("user", "-80").SetResults(...)
+//
+// It also builds the schema queries of a fakesqldb.DB, to run a real
+// tabletserver against a fake MySQL.
+package fakecluster
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vtgate"
+	"vitess.io/vitess/go/vt/vtgate/gateway"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// Cluster is an in-memory Vitess cluster with a single cell. Each shard
+// has a master tablet, which is a sandboxconn.SandboxConn.
+type Cluster struct {
+	// Cell is the cell of the cluster.
+	Cell string
+	// TopoServer is the topo server of the cluster.
+	TopoServer *topo.Server
+	// SrvTopo is the serving topology of the cluster.
+	SrvTopo srvtopo.Server
+	// HealthCheck has the tablets of the cluster.
+	HealthCheck *discovery.FakeHealthCheck
+	// Resolver routes the queries to the tablets.
+	Resolver *vtgate.Resolver
+
+	mu      sync.Mutex
+	tablets map[string]*sandboxconn.SandboxConn
+}
+
+// New returns an empty cluster.
+func New(ctx context.Context, cell string) (*Cluster, error) {
+	ts := memorytopo.NewServer(cell)
+	// The vtgates watch the serving vschema, which must exist.
+	if err := ts.RebuildSrvVSchema(ctx, []string{cell}); err != nil {
+		return nil, err
+	}
+	c := &Cluster{
+		Cell:        cell,
+		TopoServer:  ts,
+		SrvTopo:     &srvTopo{ts: ts},
+		HealthCheck: discovery.NewFakeHealthCheck(),
+		tablets:     make(map[string]*sandboxconn.SandboxConn),
+	}
+	// The gateway must listen to the health check before the
+	// tablets are added.
+	gw := gateway.GetCreator()(ctx, c.HealthCheck, c.SrvTopo, cell, 3)
+	tc := vtgate.NewTxConn(gw, vtgatepb.TransactionMode_MULTI)
+	sc := vtgate.NewScatterConn("", tc, gw, c.HealthCheck)
+	c.Resolver = vtgate.NewResolver(srvtopo.NewResolver(c.SrvTopo, gw, cell), c.SrvTopo, cell, sc)
+	return c, nil
+}
+
+// Shards returns the names of n shards that evenly split the keyrange,
+// like -80 and 80- for 2.
+func Shards(n int) []string {
+	shards := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		kr, err := key.EvenShardsKeyRange(i, n)
+		if err != nil {
+			panic(err)
+		}
+		shards = append(shards, key.KeyRangeString(kr))
+	}
+	return shards
+}
+
+// AddKeyspace adds a keyspace with its vschema and shards, and a master
+// tablet for each shard. An unsharded keyspace has the shard 0 if no
+// shard is specified.
+func (c *Cluster) AddKeyspace(ctx context.Context, keyspace string, vschema *vschemapb.Keyspace, shards ...string) error {
+	if len(shards) == 0 {
+		if vschema.Sharded {
+			return fmt.Errorf("sharded keyspace %v needs shards", keyspace)
+		}
+		shards = []string{"0"}
+	}
+	if err := c.TopoServer.CreateKeyspace(ctx, keyspace, &topodatapb.Keyspace{}); err != nil {
+		return err
+	}
+	for _, shard := range shards {
+		if err := c.TopoServer.CreateShard(ctx, keyspace, shard); err != nil {
+			return err
+		}
+	}
+	if err := c.TopoServer.SaveVSchema(ctx, keyspace, vschema); err != nil {
+		return err
+	}
+	if err := topotools.RebuildKeyspace(ctx, logutil.NewMemoryLogger(), c.TopoServer, keyspace, []string{c.Cell}); err != nil {
+		return err
+	}
+	if err := c.TopoServer.RebuildSrvVSchema(ctx, []string{c.Cell}); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, shard := range shards {
+		host := fmt.Sprintf("%s-%s", keyspace, shard)
+		c.tablets[keyspace+"/"+shard] = c.HealthCheck.AddTestTablet(c.Cell, host, 1, keyspace, shard, topodatapb.TabletType_MASTER, true, 1, nil)
+	}
+	return nil
+}
+
+// Tablet returns the master tablet of a shard, or nil if the shard
+// doesn't exist. Its results can be set with SetResults, and it
+// records the queries it receives.
+func (c *Cluster) Tablet(keyspace, shard string) *sandboxconn.SandboxConn {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.tablets[keyspace+"/"+shard]
+}
+
+// NewExecutor returns a vtgate executor that routes the queries to
+// the tablets of the cluster. It watches the vschema until ctx is done.
+func (c *Cluster) NewExecutor(ctx context.Context) *vtgate.Executor {
+	return vtgate.NewExecutor(ctx, c.SrvTopo, c.Cell, "", c.Resolver, false /* normalize */, 10 /* streamSize */, 100 /* queryPlanCacheSize */)
+}
+
+// srvTopo is a srvtopo.Server that reads the topo server directly,
+// without the cache of srvtopo.ResilientServer, so that the changes are
+// seen immediately.
+type srvTopo struct {
+	ts *topo.Server
+}
+
+// GetTopoServer is part of the srvtopo.Server interface.
+func (st *srvTopo) GetTopoServer() (*topo.Server, error) {
+	return st.ts, nil
+}
+
+// GetSrvKeyspaceNames is part of the srvtopo.Server interface.
+func (st *srvTopo) GetSrvKeyspaceNames(ctx context.Context, cell string) ([]string, error) {
+	return st.ts.GetSrvKeyspaceNames(ctx, cell)
+}
+
+// GetSrvKeyspace is part of the srvtopo.Server interface.
+func (st *srvTopo) GetSrvKeyspace(ctx context.Context, cell, keyspace string) (*topodatapb.SrvKeyspace, error) {
+	return st.ts.GetSrvKeyspace(ctx, cell, keyspace)
+}
+
+// WatchSrvVSchema is part of the srvtopo.Server interface.
+func (st *srvTopo) WatchSrvVSchema(ctx context.Context, cell string, callback func(*vschemapb.SrvVSchema, error)) {
+	current, changes, cancel := st.ts.WatchSrvVSchema(ctx, cell)
+	callback(current.Value, current.Err)
+	if changes == nil {
+		return
+	}
+	go func() {
+		defer cancel()
+		for c := range changes {
+			callback(c.Value, c.Err)
+			if c.Err != nil {
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakecluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestShards(t *testing.T) {
+	if got, want := Shards(1), []string{"-"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Shards(1): %v, want %v", got, want)
+	}
+	if got, want := Shards(4), []string{"-40", "40-80", "80-c0", "c0-"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Shards(4): %v, want %v", got, want)
+	}
+}
+
+func TestVSchemaBuilder(t *testing.T) {
+	b := ShardedVSchema().
+		Vindex("hash", "hash", nil).
+		LookupVindex("name_idx", "lookup_hash", "lookup.name_idx", "name", "user_id", "user").
+		Table("user", "id", "hash", "name", "name_idx").
+		AutoIncrement("user", "id", "lookup.user_seq")
+	want := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"name_idx": {
+				Type:   "lookup_hash",
+				Params: map[string]string{"table": "lookup.name_idx", "from": "name", "to": "user_id"},
+				Owner:  "user",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"user": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}, {Column: "name", Name: "name_idx"}},
+				AutoIncrement:  &vschemapb.AutoIncrement{Column: "id", Sequence: "lookup.user_seq"},
+			},
+		},
+	}
+	got := b.Build()
+	if !proto.Equal(got, want) {
+		t.Errorf("Build: %v, want %v", got, want)
+	}
+	// The built vschemas are independent of the builder.
+	b.Table("music", "user_id", "hash")
+	if _, ok := got.Tables["music"]; ok {
+		t.Errorf("the built vschema was changed by the builder")
+	}
+
+	got = UnshardedVSchema().Sequence("user_seq").Reference("zip").Build()
+	want = &vschemapb.Keyspace{
+		Vindexes: map[string]*vschemapb.Vindex{},
+		Tables: map[string]*vschemapb.Table{
+			"user_seq": {Type: "sequence"},
+			"zip":      {Type: "reference"},
+		},
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("Build: %v, want %v", got, want)
+	}
+}
+
+func TestCluster(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cluster, err := New(ctx, "zone1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cluster.AddKeyspace(ctx, "user", ShardedVSchema().
+		Vindex("hash", "hash", nil).
+		Table("user", "id", "hash").
+		Build(), Shards(2)...); err != nil {
+		t.Fatal(err)
+	}
+	if err := cluster.AddKeyspace(ctx, "lookup", UnshardedVSchema().Table("t").Build()); err != nil {
+		t.Fatal(err)
+	}
+	if err := cluster.AddKeyspace(ctx, "bad", ShardedVSchema().Build()); err == nil {
+		t.Errorf("AddKeyspace without shards: nil, want error")
+	}
+	if cluster.Tablet("user", "-80") == nil || cluster.Tablet("user", "80-") == nil || cluster.Tablet("lookup", "0") == nil {
+		t.Fatalf("missing tablets")
+	}
+	if cluster.Tablet("user", "0") != nil {
+		t.Errorf("Tablet(user, 0): not nil, want nil")
+	}
+
+	executor := cluster.NewExecutor(ctx)
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+	// id 1 hashes to the -80 shard.
+	cluster.Tablet("user", "-80").SetResults([]*sqltypes.Result{want})
+	session := vtgate.NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+	got, err := executor.Execute(ctx, "TestCluster", session, "select id from user where id = 1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Execute: %v, want %v", got, want)
+	}
+	if n := len(cluster.Tablet("user", "-80").Queries); n != 1 {
+		t.Errorf("queries of user/-80: %d, want 1", n)
+	}
+	if n := len(cluster.Tablet("user", "80-").Queries); n != 0 {
+		t.Errorf("queries of user/80-: %d, want 0", n)
+	}
+
+	if _, err := executor.Execute(ctx, "TestCluster", session, "select * from t", nil); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(cluster.Tablet("lookup", "0").Queries); n != 1 {
+		t.Errorf("queries of lookup/0: %d, want 1", n)
+	}
+}
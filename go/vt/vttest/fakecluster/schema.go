@@ -0,0 +1,197 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakecluster
+
+import (
+	"fmt"
+	"regexp"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Schema is the schema of a fake MySQL. It builds the results of the
+// queries that the schema engine of a tabletserver runs to load its
+// tables, so that a tabletserver can run against a fakesqldb.DB.
+type Schema struct {
+	tables []*table
+}
+
+type table struct {
+	name    string
+	comment string
+	ddl     *sqlparser.DDL
+}
+
+var commentRegexp = regexp.MustCompile(`(?i)comment\s*=?\s*'([^']*)'`)
+
+// NewSchema returns the schema of the tables of the create table
+// statements.
+func NewSchema(ddls ...string) (*Schema, error) {
+	s := &Schema{}
+	for _, ddl := range ddls {
+		if err := s.AddTable(ddl); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// AddTable adds the table of a create table statement to the schema.
+// The comment of the table is its type for vttablet, like
+// "comment 'vitess_sequence'".
+func (s *Schema) AddTable(ddl string) error {
+	stmt, err := sqlparser.Parse(ddl)
+	if err != nil {
+		return fmt.Errorf("cannot parse %q: %v", ddl, err)
+	}
+	create, ok := stmt.(*sqlparser.DDL)
+	if !ok || create.Action != sqlparser.CreateStr || create.TableSpec == nil {
+		return fmt.Errorf("not a create table statement: %q", ddl)
+	}
+	name := create.Table.Name.String()
+	for _, t := range s.tables {
+		if t.name == name {
+			return fmt.Errorf("duplicate table %v", name)
+		}
+	}
+	t := &table{
+		name: name,
+		ddl:  create,
+	}
+	if match := commentRegexp.FindStringSubmatch(create.TableSpec.Options); match != nil {
+		t.comment = match[1]
+	}
+	s.tables = append(s.tables, t)
+	return nil
+}
+
+// Queries returns the results of the queries of the schema engine.
+func (s *Schema) Queries() map[string]*sqltypes.Result {
+	queries := map[string]*sqltypes.Result{
+		"select unix_timestamp()":       singleValue(sqltypes.Uint64, sqltypes.NewInt32(1427325875)),
+		"select @@global.sql_mode":      singleValue(sqltypes.VarChar, sqltypes.NewVarBinary("STRICT_TRANS_TABLES")),
+		"select @@autocommit":           singleValue(sqltypes.Uint64, sqltypes.NewVarBinary("1")),
+		"select @@sql_auto_is_null":     singleValue(sqltypes.Uint64, sqltypes.NewVarBinary("0")),
+		"set @@session.sql_log_bin = 0": {},
+		"show variables like 'binlog_format'": {
+			Fields: []*querypb.Field{{
+				Type: sqltypes.VarChar,
+			}, {
+				Type: sqltypes.VarChar,
+			}},
+			RowsAffected: 1,
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewVarBinary("binlog_format"),
+				sqltypes.NewVarBinary("ROW"),
+			}},
+		},
+		"begin":    {},
+		"commit":   {},
+		"rollback": {},
+	}
+
+	showTables := &sqltypes.Result{
+		Fields: mysql.BaseShowTablesFields,
+	}
+	for _, t := range s.tables {
+		row := mysql.BaseShowTablesRow(t.name, false, t.comment)
+		showTables.Rows = append(showTables.Rows, row)
+		queries[mysql.BaseShowTablesForTable(t.name)] = &sqltypes.Result{
+			Fields:       mysql.BaseShowTablesFields,
+			RowsAffected: 1,
+			Rows:         [][]sqltypes.Value{row},
+		}
+		t.addQueries(queries)
+	}
+	showTables.RowsAffected = uint64(len(showTables.Rows))
+	queries[mysql.BaseShowTables] = showTables
+	return queries
+}
+
+// AddQueries adds the results of the queries of the schema engine to
+// a fake MySQL.
+func (s *Schema) AddQueries(db *fakesqldb.DB) {
+	for query, result := range s.Queries() {
+		db.AddQuery(query, result)
+	}
+}
+
+// addQueries adds the results of the queries that load the columns and
+// the indexes of the table.
+func (t *table) addQueries(queries map[string]*sqltypes.Result) {
+	pkColumns := make(map[string]bool)
+	indexes := &sqltypes.Result{
+		Fields: mysql.ShowIndexFromTableFields,
+	}
+	for _, idx := range t.ddl.TableSpec.Indexes {
+		for i, col := range idx.Columns {
+			indexes.Rows = append(indexes.Rows, mysql.ShowIndexFromTableRow(t.name, idx.Info.Unique, idx.Info.Name.String(), i+1, col.Column.String(), false))
+			if idx.Info.Primary {
+				pkColumns[col.Column.Lowered()] = true
+			}
+		}
+	}
+	indexes.RowsAffected = uint64(len(indexes.Rows))
+
+	describe := &sqltypes.Result{
+		Fields: mysql.DescribeTableFields,
+	}
+	fields := &sqltypes.Result{}
+	for _, col := range t.ddl.TableSpec.Columns {
+		name := col.Name.Lowered()
+		defaultValue := ""
+		if col.Type.Default != nil {
+			defaultValue = sqlparser.String(col.Type.Default)
+		}
+		key := ""
+		if pkColumns[name] {
+			key = "PRI"
+		}
+		describe.Rows = append(describe.Rows, mysql.DescribeTableRow(name, col.Type.DescribeType(), !bool(col.Type.NotNull), key, defaultValue))
+		fields.Fields = append(fields.Fields, &querypb.Field{
+			Name: name,
+			Type: col.Type.SQLType(),
+		})
+	}
+	describe.RowsAffected = uint64(len(describe.Rows))
+
+	queries["show index from "+t.name] = indexes
+	queries["describe "+t.name] = describe
+	queries["select * from "+t.name+" where 1 != 1"] = fields
+}
+
+func singleValue(typ querypb.Type, value sqltypes.Value) *sqltypes.Result {
+	return &sqltypes.Result{
+		Fields:       []*querypb.Field{{Type: typ}},
+		RowsAffected: 1,
+		Rows:         [][]sqltypes.Value{{value}},
+	}
+}
+
+// TableNames returns the names of the tables of the schema.
+func (s *Schema) TableNames() []string {
+	names := make([]string, 0, len(s.tables))
+	for _, t := range s.tables {
+		names = append(names, t.name)
+	}
+	return names
+}
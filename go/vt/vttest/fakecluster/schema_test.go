@@ -0,0 +1,93 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakecluster
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+type noopChecker struct{}
+
+func (noopChecker) CheckMySQL() {}
+
+func TestSchema(t *testing.T) {
+	s, err := NewSchema(
+		"create table user(id bigint not null, name varchar(64), primary key(id))",
+		"create table user_seq(id int, next_id bigint, cache bigint, primary key(id)) comment 'vitess_sequence'",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.TableNames(), []string{"user", "user_seq"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TableNames: %v, want %v", got, want)
+	}
+
+	db := fakesqldb.New(t)
+	defer db.Close()
+	s.AddQueries(db)
+	se := schema.NewEngine(noopChecker{}, tabletenv.DefaultQsConfig)
+	se.InitDBConfig(dbconfigs.NewTestDBConfigs(*db.ConnParams(), *db.ConnParams(), ""))
+	if err := se.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer se.Close()
+
+	user := se.GetTable(sqlparser.NewTableIdent("user"))
+	if user == nil {
+		t.Fatalf("table user not loaded")
+	}
+	if len(user.Columns) != 2 || user.Columns[0].Type != sqltypes.Int64 || user.Columns[1].Type != sqltypes.VarChar {
+		t.Errorf("columns of user: %v, want a bigint and a varchar", user.Columns)
+	}
+	if !reflect.DeepEqual(user.PKColumns, []int{0}) {
+		t.Errorf("primary key of user: %v, want [0]", user.PKColumns)
+	}
+	seq := se.GetTable(sqlparser.NewTableIdent("user_seq"))
+	if seq == nil || seq.Type != schema.Sequence {
+		t.Errorf("table user_seq: %v, want a sequence", seq)
+	}
+}
+
+func TestSchemaErrors(t *testing.T) {
+	testcases := []struct {
+		ddls []string
+		err  string
+	}{{
+		ddls: []string{"create table t("},
+		err:  "cannot parse",
+	}, {
+		ddls: []string{"select * from t"},
+		err:  "not a create table statement",
+	}, {
+		ddls: []string{"create table t(id int)", "create table t(id int)"},
+		err:  "duplicate table t",
+	}}
+	for _, tc := range testcases {
+		if _, err := NewSchema(tc.ddls...); err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("NewSchema(%v): %v, want %s", tc.ddls, err, tc.err)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakecluster
+
+import (
+	"github.com/golang/protobuf/proto"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// VSchemaBuilder builds the vschema of a keyspace:
+//
+//	vschema := fakecluster.ShardedVSchema().
+//		Vindex("hash", "hash", nil).
+//		Table("user", "id", "hash").
+//		AutoIncrement("user", "id", "lookup.user_seq").
+//		Build()
+type VSchemaBuilder struct {
+	keyspace *vschemapb.Keyspace
+}
+
+// ShardedVSchema returns the builder of the vschema of a sharded
+// keyspace.
+func ShardedVSchema() *VSchemaBuilder {
+	return &VSchemaBuilder{
+		keyspace: &vschemapb.Keyspace{
+			Sharded:  true,
+			Vindexes: make(map[string]*vschemapb.Vindex),
+			Tables:   make(map[string]*vschemapb.Table),
+		},
+	}
+}
+
+// UnshardedVSchema returns the builder of the vschema of an unsharded
+// keyspace.
+func UnshardedVSchema() *VSchemaBuilder {
+	return &VSchemaBuilder{
+		keyspace: &vschemapb.Keyspace{
+			Vindexes: make(map[string]*vschemapb.Vindex),
+			Tables:   make(map[string]*vschemapb.Table),
+		},
+	}
+}
+
+// Vindex adds a vindex.
+func (b *VSchemaBuilder) Vindex(name, vindexType string, params map[string]string) *VSchemaBuilder {
+	b.keyspace.Vindexes[name] = &vschemapb.Vindex{
+		Type:   vindexType,
+		Params: params,
+	}
+	return b
+}
+
+// LookupVindex adds a lookup vindex, of the type lookup_hash or
+// lookup_unique_hash for example, owned by owner.
+func (b *VSchemaBuilder) LookupVindex(name, vindexType, table, from, to, owner string) *VSchemaBuilder {
+	b.keyspace.Vindexes[name] = &vschemapb.Vindex{
+		Type: vindexType,
+		Params: map[string]string{
+			"table": table,
+			"from":  from,
+			"to":    to,
+		},
+		Owner: owner,
+	}
+	return b
+}
+
+// Table adds a table, and the vindexes of its columns as pairs of
+// column and vindex names. The first vindex is the primary vindex.
+func (b *VSchemaBuilder) Table(name string, columnVindexes ...string) *VSchemaBuilder {
+	if len(columnVindexes)%2 != 0 {
+		panic("fakecluster: the column vindexes must be pairs of column and vindex names")
+	}
+	table := b.table(name)
+	for i := 0; i < len(columnVindexes); i += 2 {
+		table.ColumnVindexes = append(table.ColumnVindexes, &vschemapb.ColumnVindex{
+			Column: columnVindexes[i],
+			Name:   columnVindexes[i+1],
+		})
+	}
+	return b
+}
+
+// AutoIncrement sets the auto-increment column of a table, and its
+// sequence, which can be qualified with its keyspace.
+func (b *VSchemaBuilder) AutoIncrement(tableName, column, sequence string) *VSchemaBuilder {
+	b.table(tableName).AutoIncrement = &vschemapb.AutoIncrement{
+		Column:   column,
+		Sequence: sequence,
+	}
+	return b
+}
+
+// Sequence adds a sequence table.
+func (b *VSchemaBuilder) Sequence(name string) *VSchemaBuilder {
+	b.table(name).Type = "sequence"
+	return b
+}
+
+// Reference adds a reference table.
+func (b *VSchemaBuilder) Reference(name string) *VSchemaBuilder {
+	b.table(name).Type = "reference"
+	return b
+}
+
+// Build returns the vschema. The builder can keep building on a copy.
+func (b *VSchemaBuilder) Build() *vschemapb.Keyspace {
+	return proto.Clone(b.keyspace).(*vschemapb.Keyspace)
+}
+
+func (b *VSchemaBuilder) table(name string) *vschemapb.Table {
+	table, ok := b.keyspace.Tables[name]
+	if !ok {
+		table = &vschemapb.Table{}
+		b.keyspace.Tables[name] = table
+	}
+	return table
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vttls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// The certificates, keys and CAs are loaded once per file, and shared
+// by the configs that use them. Reload loads them again, so the
+// certificates can be renewed without restarting the servers: servenv
+// calls it on SIGHUP, and every -tls_reload_interval. If a file can't
+// be loaded, the previous certificate or CA is kept.
+
+var (
+	tlsReloads = stats.NewCountersWithSingleLabel("TLSReloads", "Reloads of the TLS certificates, keys and CAs by result", "Result", "Success", "Error")
+
+	filesMu   sync.Mutex
+	keyPairs  = make(map[string]*keyPair)
+	certPools = make(map[string]*certPool)
+)
+
+// keyPair is a certificate and its key, loaded from files.
+type keyPair struct {
+	cert, key string
+
+	mu          sync.Mutex
+	certificate *tls.Certificate
+	// modTime is the latest modification time of the files.
+	modTime time.Time
+}
+
+func (kp *keyPair) get() *tls.Certificate {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	return kp.certificate
+}
+
+// reload loads the files again, if they changed since the last load
+// or if force is set.
+func (kp *keyPair) reload(force bool) error {
+	modTime, err := latestModTime(kp.cert, kp.key)
+	if err != nil {
+		return err
+	}
+	kp.mu.Lock()
+	changed := modTime.After(kp.modTime)
+	kp.mu.Unlock()
+	if !changed && !force {
+		return nil
+	}
+
+	crt, err := tls.LoadX509KeyPair(kp.cert, kp.key)
+	if err != nil {
+		return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to load tls certificate, cert %s, key: %s", kp.cert, kp.key)
+	}
+	kp.mu.Lock()
+	kp.certificate = &crt
+	kp.modTime = modTime
+	kp.mu.Unlock()
+	return nil
+}
+
+// certPool is a pool of CAs, loaded from a file.
+type certPool struct {
+	ca string
+
+	mu      sync.Mutex
+	pool    *x509.CertPool
+	modTime time.Time
+}
+
+func (cp *certPool) get() *x509.CertPool {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	return cp.pool
+}
+
+// reload loads the file again, if it changed since the last load or
+// if force is set.
+func (cp *certPool) reload(force bool) error {
+	modTime, err := latestModTime(cp.ca)
+	if err != nil {
+		return err
+	}
+	cp.mu.Lock()
+	changed := modTime.After(cp.modTime)
+	cp.mu.Unlock()
+	if !changed && !force {
+		return nil
+	}
+
+	b, err := ioutil.ReadFile(cp.ca)
+	if err != nil {
+		return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to read ca file: %s", cp.ca)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return vterrors.Errorf(vtrpc.Code_UNKNOWN, "failed to append certificates")
+	}
+	cp.mu.Lock()
+	cp.pool = pool
+	cp.modTime = modTime
+	cp.mu.Unlock()
+	return nil
+}
+
+func latestModTime(files ...string) (time.Time, error) {
+	var latest time.Time
+	for _, file := range files {
+		fi, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "cannot stat %s: %v", file, err)
+		}
+		if fi.ModTime().After(latest) {
+			latest = fi.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// loadKeyPair returns the keyPair of the files, loading it the first
+// time.
+func loadKeyPair(cert, key string) (*keyPair, error) {
+	filesMu.Lock()
+	defer filesMu.Unlock()
+	id := certificatesIdentifier(cert, key)
+	if kp, ok := keyPairs[id]; ok {
+		return kp, nil
+	}
+	kp := &keyPair{cert: cert, key: key}
+	if err := kp.reload(true); err != nil {
+		return nil, err
+	}
+	keyPairs[id] = kp
+	return kp, nil
+}
+
+// loadCertPool returns the certPool of the file, loading it the first
+// time.
+func loadCertPool(ca string) (*certPool, error) {
+	filesMu.Lock()
+	defer filesMu.Unlock()
+	if cp, ok := certPools[ca]; ok {
+		return cp, nil
+	}
+	cp := &certPool{ca: ca}
+	if err := cp.reload(true); err != nil {
+		return nil, err
+	}
+	certPools[ca] = cp
+	return cp, nil
+}
+
+// Reload loads again all the certificates, keys and CAs in use. With
+// force, they are loaded even if their files didn't change. The
+// certificates and CAs that can't be loaded keep their previous value,
+// and their errors are returned.
+func Reload(force bool) error {
+	filesMu.Lock()
+	kps := make([]*keyPair, 0, len(keyPairs))
+	for _, kp := range keyPairs {
+		kps = append(kps, kp)
+	}
+	cps := make([]*certPool, 0, len(certPools))
+	for _, cp := range certPools {
+		cps = append(cps, cp)
+	}
+	filesMu.Unlock()
+
+	rec := concurrency.AllErrorRecorder{}
+	record := func(err error) {
+		if err != nil {
+			tlsReloads.Add("Error", 1)
+			rec.RecordError(err)
+			return
+		}
+		tlsReloads.Add("Success", 1)
+	}
+	for _, kp := range kps {
+		record(kp.reload(force))
+	}
+	for _, cp := range cps {
+		record(cp.reload(force))
+	}
+	return rec.Error()
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vttls
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// A SPIFFE ID (https://spiffe.io) identifies a workload by an URI in
+// its certificate, like spiffe://example.org/vtgate, rather than by a
+// host name. A trust domain alone, like spiffe://example.org, allows
+// all the workloads of the domain.
+
+const spiffeScheme = "spiffe://"
+
+func isSPIFFEID(s string) bool {
+	return strings.HasPrefix(s, spiffeScheme)
+}
+
+// spiffeIDAllowed returns true if the URI matches one of the allowed
+// SPIFFE IDs or trust domains.
+func spiffeIDAllowed(uri *url.URL, allowed []string) bool {
+	if uri.Scheme != "spiffe" {
+		return false
+	}
+	id := uri.String()
+	for _, a := range allowed {
+		a = strings.TrimSuffix(a, "/")
+		if strings.Contains(strings.TrimPrefix(a, spiffeScheme), "/") {
+			if id == a {
+				return true
+			}
+			continue
+		}
+		if uri.Host == strings.TrimPrefix(a, spiffeScheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSPIFFEID returns an error if the certificate has no allowed
+// SPIFFE ID.
+func checkSPIFFEID(cert *x509.Certificate, allowed []string) error {
+	var ids []string
+	for _, uri := range cert.URIs {
+		if spiffeIDAllowed(uri, allowed) {
+			return nil
+		}
+		ids = append(ids, uri.String())
+	}
+	return fmt.Errorf("peer certificate SPIFFE IDs %v don't match %v", ids, allowed)
+}
+
+// verifyServer verifies the certificate chain of a server against the
+// current CAs of the pool, and either its host name or, if allowed is
+// set, its SPIFFE ID. It is used with InsecureSkipVerify, which skips
+// the usual verification against the CAs of the config.
+func verifyServer(cp *certPool, host string, allowed []string) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return fmt.Errorf("no server certificate")
+		}
+		certs := make([]*x509.Certificate, len(rawCerts))
+		for i, raw := range rawCerts {
+			cert, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return fmt.Errorf("cannot parse server certificate: %v", err)
+			}
+			certs[i] = cert
+		}
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		if _, err := certs[0].Verify(x509.VerifyOptions{
+			DNSName:       host,
+			Roots:         cp.get(),
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}); err != nil {
+			return err
+		}
+		if allowed == nil {
+			return nil
+		}
+		return checkSPIFFEID(certs[0], allowed)
+	}
+}
+
+// VerifyPeerSPIFFEID returns a tls.Config VerifyPeerCertificate
+// function that requires the verified client certificates to have one
+// of the allowed SPIFFE IDs or trust domains.
+func VerifyPeerSPIFFEID(allowed []string) func([][]byte, [][]*x509.Certificate) error {
+	return func(_ [][]byte, verifiedChains [][]*x509.Certificate) error {
+		if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+			return fmt.Errorf("no verified client certificate")
+		}
+		return checkSPIFFEID(verifiedChains[0][0], allowed)
+	}
+}
@@ -18,13 +18,8 @@ package vttls
 
 import (
 	"crypto/tls"
-	"crypto/x509"
-	"io/ioutil"
+	"fmt"
 	"strings"
-	"sync"
-
-	"vitess.io/vitess/go/vt/proto/vtrpc"
-	"vitess.io/vitess/go/vt/vterrors"
 )
 
 // Updated list of acceptable cipher suits to address
@@ -55,151 +50,112 @@ func newTLSConfig() *tls.Config {
 	}
 }
 
-var onceByKeys = sync.Map{}
-
 // ClientConfig returns the TLS config to use for a client to
-// connect to a server with the provided parameters.
+// connect to a server with the provided parameters. If name is a SPIFFE
+// ID or a SPIFFE trust domain, like spiffe://example.org/vttablet or
+// spiffe://example.org, the server is verified by its SPIFFE ID instead
+// of its host name, which requires a ca.
+//
+// The client certificate and the ca are reloaded when their files
+// change (see Reload): each handshake verifies the server against the
+// current ca. If name is empty, the host name is only known to the
+// handshake, which verifies the server against the ca read when the
+// config is created.
 func ClientConfig(cert, key, ca, name string) (*tls.Config, error) {
 	config := newTLSConfig()
 
 	// Load the client-side cert & key if any.
 	if cert != "" && key != "" {
-		certificates, err := loadTLSCertificate(cert, key)
-
+		kp, err := loadKeyPair(cert, key)
 		if err != nil {
 			return nil, err
 		}
 
-		config.Certificates = *certificates
+		config.Certificates = []tls.Certificate{*kp.get()}
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return kp.get(), nil
+		}
 	}
 
 	// Load the server CA if any.
+	var pool *certPool
 	if ca != "" {
-		certificatePool, err := loadx509CertPool(ca)
-
+		var err error
+		pool, err = loadCertPool(ca)
 		if err != nil {
 			return nil, err
 		}
 
-		config.RootCAs = certificatePool
+		config.RootCAs = pool.get()
 	}
 
 	// Set the server name if any.
-	if name != "" {
+	switch {
+	case isSPIFFEID(name):
+		if pool == nil {
+			return nil, fmt.Errorf("a ca is required to verify the SPIFFE ID %v", name)
+		}
+		// The chain is verified by VerifyPeerCertificate, without
+		// checking the host name.
+		config.InsecureSkipVerify = true
+		config.VerifyPeerCertificate = verifyServer(pool, "", []string{name})
+	case name != "":
 		config.ServerName = name
+		if pool != nil {
+			// The chain and the host name are verified by
+			// VerifyPeerCertificate, against the current ca.
+			config.InsecureSkipVerify = true
+			config.VerifyPeerCertificate = verifyServer(pool, name, nil)
+		}
 	}
 
 	return config, nil
 }
 
 // ServerConfig returns the TLS config to use for a server to
-// accept client connections.
+// accept client connections. The certificate and the ca are reloaded
+// when their files change (see Reload): the new connections use the
+// new ones.
 func ServerConfig(cert, key, ca string) (*tls.Config, error) {
 	config := newTLSConfig()
 
-	certificates, err := loadTLSCertificate(cert, key)
-
+	kp, err := loadKeyPair(cert, key)
 	if err != nil {
 		return nil, err
 	}
 
-	config.Certificates = *certificates
+	config.Certificates = []tls.Certificate{*kp.get()}
 
 	// if specified, load ca to validate client,
 	// and enforce clients present valid certs.
+	var pool *certPool
 	if ca != "" {
-		certificatePool, err := loadx509CertPool(ca)
-
+		pool, err = loadCertPool(ca)
 		if err != nil {
 			return nil, err
 		}
 
-		config.ClientCAs = certificatePool
+		config.ClientCAs = pool.get()
 		config.ClientAuth = tls.RequireAndVerifyClientCert
 	}
 
-	return config, nil
-}
-
-var certPools = sync.Map{}
-
-func loadx509CertPool(ca string) (*x509.CertPool, error) {
-	once, _ := onceByKeys.LoadOrStore(ca, &sync.Once{})
-
-	var err error
-	once.(*sync.Once).Do(func() {
-		err = doLoadx509CertPool(ca)
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	result, ok := certPools.Load(ca)
-
-	if !ok {
-		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "Cannot find loaded x509 cert pool for ca: %s", ca)
-	}
-
-	return result.(*x509.CertPool), nil
-}
-
-func doLoadx509CertPool(ca string) error {
-	b, err := ioutil.ReadFile(ca)
-	if err != nil {
-		return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to read ca file: %s", ca)
-	}
-
-	cp := x509.NewCertPool()
-	if !cp.AppendCertsFromPEM(b) {
-		return vterrors.Errorf(vtrpc.Code_UNKNOWN, "failed to append certificates")
+	// Each handshake uses the current certificate and ca. The fields
+	// set on config after it is returned, like VerifyPeerCertificate,
+	// are used too.
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		c := config.Clone()
+		c.GetConfigForClient = nil
+		c.Certificates = []tls.Certificate{*kp.get()}
+		if pool != nil {
+			c.ClientCAs = pool.get()
+		}
+		return c, nil
 	}
 
-	certPools.Store(ca, cp)
-
-	return nil
+	return config, nil
 }
 
-var tlsCertificates = sync.Map{}
-
-func tlsCertificatesIdentifier(cert, key string) string {
+// certificatesIdentifier returns the key of a certificate and key pair.
+func certificatesIdentifier(cert, key string) string {
 	return strings.Join([]string{cert, key}, ";")
 }
-
-func loadTLSCertificate(cert, key string) (*[]tls.Certificate, error) {
-	tlsIdentifier := tlsCertificatesIdentifier(cert, key)
-	once, _ := onceByKeys.LoadOrStore(tlsIdentifier, &sync.Once{})
-
-	var err error
-	once.(*sync.Once).Do(func() {
-		err = doLoadTLSCertificate(cert, key)
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	result, ok := tlsCertificates.Load(tlsIdentifier)
-
-	if !ok {
-		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "Cannot find loaded tls certificate with cert: %s, key%s", cert, key)
-	}
-
-	return result.(*[]tls.Certificate), nil
-}
-
-func doLoadTLSCertificate(cert, key string) error {
-	tlsIdentifier := tlsCertificatesIdentifier(cert, key)
-
-	var certificate []tls.Certificate
-	// Load the server cert and key.
-	crt, err := tls.LoadX509KeyPair(cert, key)
-	if err != nil {
-		return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to load tls certificate, cert %s, key: %s", cert, key)
-	}
-
-	certificate = []tls.Certificate{crt}
-
-	tlsCertificates.Store(tlsIdentifier, &certificate)
-
-	return nil
-}
@@ -0,0 +1,356 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vttls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCA is a certificate authority signing the test certificates.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string
+}
+
+var serial int64
+
+func newTestCA(t *testing.T, dir, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial++
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := path.Join(dir, name+"-ca.pem")
+	writePEM(t, file, "CERTIFICATE", der)
+	return &testCA{cert: cert, key: key, file: file}
+}
+
+// issue writes a certificate signed by the CA, for the host name and
+// the URIs, and its key. It returns the files.
+func (ca *testCA) issue(t *testing.T, dir, name string, uris ...string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{name},
+	}
+	for _, uri := range uris {
+		u, err := url.Parse(uri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		template.URIs = append(template.URIs, u)
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile := path.Join(dir, name+"-cert.pem")
+	keyFile := path.Join(dir, name+"-key.pem")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+	return certFile, keyFile
+}
+
+func writePEM(t *testing.T, file, typ string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der})
+	if err := ioutil.WriteFile(file, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// touch moves the modification time of the files forward, so the
+// reload doesn't depend on the file system time granularity.
+func touch(t *testing.T, files ...string) {
+	mtime := time.Now().Add(time.Minute)
+	for _, file := range files {
+		if err := os.Chtimes(file, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// handshake connects a client with the config to a server with the
+// config, and returns the errors of both sides.
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) (serverErr, clientErr error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	serverDone := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverDone <- err
+			return
+		}
+		defer conn.Close()
+		serverDone <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+	if err == nil {
+		// With TLS 1.3, the client may finish its handshake before
+		// the server checks its certificate: read to get its answer,
+		// which is EOF when the server closes the connection.
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		_, err = conn.Read(make([]byte, 1))
+		if err == io.EOF {
+			err = nil
+		} else if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			err = nil
+		}
+		conn.Close()
+	}
+	return <-serverDone, err
+}
+
+// resetFiles forgets the files loaded by the previous tests, which
+// removed them.
+func resetFiles() {
+	filesMu.Lock()
+	defer filesMu.Unlock()
+	keyPairs = make(map[string]*keyPair)
+	certPools = make(map[string]*certPool)
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "vttls_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestReload(t *testing.T) {
+	resetFiles()
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	ca1 := newTestCA(t, dir, "ca1")
+	ca2 := newTestCA(t, dir, "ca2")
+	serverCert, serverKey := ca1.issue(t, dir, "server")
+	caFile := path.Join(dir, "ca.pem")
+	data, err := ioutil.ReadFile(ca1.file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(caFile, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	serverConfig, err := ServerConfig(serverCert, serverKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientConfig, err := ClientConfig("", "", caFile, "server")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := handshake(t, serverConfig, clientConfig); err != nil {
+		t.Fatalf("handshake failed: %v", err)
+	}
+	otherConfig, err := ClientConfig("", "", caFile, "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := handshake(t, serverConfig, otherConfig); err == nil || !strings.Contains(err.Error(), "not other") {
+		t.Errorf("handshake with another host name: %v, want not other", err)
+	}
+
+	// The server certificate is renewed by the other CA: the client
+	// doesn't trust it until its CA is reloaded.
+	ca2.issue(t, dir, "server")
+	touch(t, serverCert, serverKey)
+	if err := Reload(false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := handshake(t, serverConfig, clientConfig); err == nil {
+		t.Errorf("handshake with an unknown CA succeeded")
+	}
+
+	// A broken ca file keeps the previous CA.
+	if err := ioutil.WriteFile(caFile, []byte("garbage"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	touch(t, caFile)
+	if err := Reload(false); err == nil || !strings.Contains(err.Error(), "failed to append certificates") {
+		t.Errorf("Reload() = %v, want failed to append certificates", err)
+	}
+
+	data, err = ioutil.ReadFile(ca2.file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(caFile, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	touch(t, caFile)
+	if err := Reload(false); err != nil {
+		t.Fatal(err)
+	}
+	// The client config verifies the server against the reloaded CA.
+	if _, err := handshake(t, serverConfig, clientConfig); err != nil {
+		t.Errorf("handshake after reload failed: %v", err)
+	}
+}
+
+func TestSPIFFEIDAllowed(t *testing.T) {
+	allowed := []string{"spiffe://example.org/vtgate", "spiffe://other.org/"}
+	testcases := []struct {
+		uri  string
+		want bool
+	}{{
+		uri:  "spiffe://example.org/vtgate",
+		want: true,
+	}, {
+		uri:  "spiffe://example.org/vttablet",
+		want: false,
+	}, {
+		uri:  "spiffe://example.org",
+		want: false,
+	}, {
+		uri:  "spiffe://other.org/vttablet",
+		want: true,
+	}, {
+		uri:  "https://other.org/vttablet",
+		want: false,
+	}}
+	for _, tc := range testcases {
+		u, err := url.Parse(tc.uri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := spiffeIDAllowed(u, allowed); got != tc.want {
+			t.Errorf("spiffeIDAllowed(%v) = %v, want %v", tc.uri, got, tc.want)
+		}
+	}
+}
+
+func TestSPIFFEID(t *testing.T) {
+	resetFiles()
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	ca := newTestCA(t, dir, "ca")
+	serverCert, serverKey := ca.issue(t, dir, "server", "spiffe://example.org/vttablet")
+	clientCert, clientKey := ca.issue(t, dir, "client", "spiffe://example.org/vtgate")
+
+	if _, err := ClientConfig(clientCert, clientKey, "", "spiffe://example.org/vttablet"); err == nil {
+		t.Errorf("ClientConfig with a SPIFFE ID and no ca succeeded")
+	}
+
+	testcases := []struct {
+		name      string
+		serverIDs []string
+		clientID  string
+		wantErr   string
+	}{{
+		name:      "match",
+		serverIDs: []string{"spiffe://example.org/vtgate"},
+		clientID:  "spiffe://example.org/vttablet",
+	}, {
+		name:      "trust domain",
+		serverIDs: []string{"spiffe://example.org"},
+		clientID:  "spiffe://example.org",
+	}, {
+		name:     "wrong server",
+		clientID: "spiffe://example.org/vtctld",
+		wantErr:  "client",
+	}, {
+		name:      "wrong client",
+		serverIDs: []string{"spiffe://example.org/vtctld"},
+		clientID:  "spiffe://example.org/vttablet",
+		wantErr:   "server",
+	}}
+	for _, tc := range testcases {
+		serverConfig, err := ServerConfig(serverCert, serverKey, ca.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tc.serverIDs != nil {
+			serverConfig.VerifyPeerCertificate = VerifyPeerSPIFFEID(tc.serverIDs)
+		}
+		clientConfig, err := ClientConfig(clientCert, clientKey, ca.file, tc.clientID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		serverErr, clientErr := handshake(t, serverConfig, clientConfig)
+		switch tc.wantErr {
+		case "":
+			if serverErr != nil || clientErr != nil {
+				t.Errorf("%v: handshake failed: server %v, client %v", tc.name, serverErr, clientErr)
+			}
+		case "client":
+			if clientErr == nil || !strings.Contains(clientErr.Error(), "don't match") {
+				t.Errorf("%v: client error %v, want a SPIFFE ID mismatch", tc.name, clientErr)
+			}
+		case "server":
+			if serverErr == nil || !strings.Contains(serverErr.Error(), "don't match") {
+				t.Errorf("%v: server error %v, want a SPIFFE ID mismatch", tc.name, serverErr)
+			}
+		}
+	}
+}
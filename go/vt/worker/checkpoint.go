@@ -0,0 +1,471 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"path"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var checkpointInterval = flag.Duration("checkpoint_interval", 30*time.Second, "how often the clones and diffs save their progress in the global topology, so that a job run again with -resume continues where the previous one stopped. 0 disables the checkpoints.")
+
+// CheckpointsPath is the directory of the global topology where the jobs
+// save their progress, as vtworker_checkpoints/<keyspace>/<shard>/<job>.
+const CheckpointsPath = "vtworker_checkpoints"
+
+// The names of the phases in the checkpoints.
+const (
+	checkpointPhaseOnline  = "online"
+	checkpointPhaseOffline = "offline"
+	checkpointPhaseDiff    = "diff"
+)
+
+// Checkpoint is the progress of a job, stored as JSON in the global
+// topology. It's deleted when the job succeeds.
+type Checkpoint struct {
+	Job        string
+	Keyspace   string
+	Shard      string
+	UpdateTime time.Time
+	// Phases has the progress of each phase of the job, by name.
+	Phases map[string]*PhaseCheckpoint
+}
+
+// PhaseCheckpoint is the progress of a phase of a job.
+type PhaseCheckpoint struct {
+	// Done is true if the phase completed.
+	Done bool `json:",omitempty"`
+	// SourcePositions are the replication positions of the source
+	// shards, by keyspace/shard, when the phase read a stable view of
+	// them. Its progress is only valid at these positions.
+	SourcePositions map[string]string `json:",omitempty"`
+	// Tables has the chunks of each table, by name.
+	Tables map[string]*TableCheckpoint `json:",omitempty"`
+	// DoneTables are the tables which were processed entirely.
+	DoneTables []string `json:",omitempty"`
+}
+
+// TableCheckpoint has all the chunks of a table, in order.
+type TableCheckpoint struct {
+	Chunks []*ChunkCheckpoint
+}
+
+// ChunkCheckpoint is a chunk of a table. A nil Start or End is unbounded.
+type ChunkCheckpoint struct {
+	Start *querypb.Value `json:",omitempty"`
+	End   *querypb.Value `json:",omitempty"`
+	Done  bool           `json:",omitempty"`
+}
+
+// checkpointPath returns the topology path of the checkpoint of a job.
+func checkpointPath(keyspace, shard, job string) string {
+	return path.Join(CheckpointsPath, keyspace, shard, job)
+}
+
+// checkpointer keeps the checkpoint of a job in memory, and saves it in
+// the global topology. All its methods can be called on a nil
+// checkpointer, which is used when the checkpoints are disabled.
+type checkpointer struct {
+	wr   *wrangler.Wrangler
+	path string
+
+	// saveMu serializes the saves, so that an older version of the
+	// checkpoint doesn't overwrite a newer one.
+	saveMu sync.Mutex
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	cp *Checkpoint
+	// pending are the chunks which were processed since the last
+	// commit(), by phase and table.
+	pending map[string]map[string][]chunk
+}
+
+// newCheckpointer returns the checkpointer of a job. If resume is set,
+// the checkpoint saved by a previous run of the job is loaded, if there
+// is one. Otherwise, the job starts over and its previous checkpoint is
+// overwritten. It returns nil if the checkpoints are disabled.
+func newCheckpointer(ctx context.Context, wr *wrangler.Wrangler, job, keyspace, shard string, resume bool) (*checkpointer, error) {
+	if *checkpointInterval == 0 {
+		if resume {
+			return nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "-resume requires the checkpoints, but -checkpoint_interval is 0")
+		}
+		return nil, nil
+	}
+	c := &checkpointer{
+		wr:   wr,
+		path: checkpointPath(keyspace, shard, job),
+		cp: &Checkpoint{
+			Job:      job,
+			Keyspace: keyspace,
+			Shard:    shard,
+			Phases:   make(map[string]*PhaseCheckpoint),
+		},
+		pending: make(map[string]map[string][]chunk),
+	}
+	if !resume {
+		return c, nil
+	}
+
+	conn, err := wr.TopoServer().ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := conn.Get(ctx, c.path)
+	if topo.IsErrType(err, topo.NoNode) {
+		wr.Logger().Infof("No checkpoint found for %v on %v/%v, starting from the beginning.", job, keyspace, shard)
+		return c, nil
+	}
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot read the checkpoint %v", c.path)
+	}
+	cp := &Checkpoint{}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, vterrors.Wrapf(err, "bad checkpoint %v", c.path)
+	}
+	if cp.Phases == nil {
+		cp.Phases = make(map[string]*PhaseCheckpoint)
+	}
+	c.cp = cp
+	wr.Logger().Infof("Resuming %v on %v/%v from the checkpoint saved at %v.", job, keyspace, shard, cp.UpdateTime)
+	return c, nil
+}
+
+// phaseLocked returns the progress of a phase, creating it if needed.
+func (c *checkpointer) phaseLocked(phase string) *PhaseCheckpoint {
+	p, ok := c.cp.Phases[phase]
+	if !ok {
+		p = &PhaseCheckpoint{}
+		c.cp.Phases[phase] = p
+	}
+	return p
+}
+
+// startPhase is called when a phase starts reading the sources at the
+// given positions (nil if they are not stable). The progress of the
+// phase is discarded if it was made at other positions. It returns true
+// if progress was kept.
+func (c *checkpointer) startPhase(phase string, positions map[string]string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p := c.phaseLocked(phase)
+	hasProgress := p.Done || len(p.Tables) > 0 || len(p.DoneTables) > 0
+	if hasProgress && !samePositions(p.SourcePositions, positions) {
+		c.wr.Logger().Infof("The source positions of the %v phase changed from %v to %v, its progress in the checkpoint is discarded.", phase, p.SourcePositions, positions)
+		p = &PhaseCheckpoint{}
+		c.cp.Phases[phase] = p
+		hasProgress = false
+	}
+	p.SourcePositions = positions
+	delete(c.pending, phase)
+	return hasProgress
+}
+
+// isPhaseDone returns true if a previous run completed the phase.
+func (c *checkpointer) isPhaseDone(phase string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, ok := c.cp.Phases[phase]
+	return ok && p.Done
+}
+
+// phaseDone records that the phase completed.
+func (c *checkpointer) phaseDone(phase string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.phaseLocked(phase).Done = true
+}
+
+// tableChunks returns the chunks of a table saved in the checkpoint, and
+// which of them were processed. It returns nil if there are none.
+func (c *checkpointer) tableChunks(phase, table string) ([]chunk, []bool) {
+	if c == nil {
+		return nil, nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, ok := c.cp.Phases[phase]
+	if !ok {
+		return nil, nil
+	}
+	t, ok := p.Tables[table]
+	if !ok || len(t.Chunks) == 0 {
+		return nil, nil
+	}
+	chunks := make([]chunk, len(t.Chunks))
+	done := make([]bool, len(t.Chunks))
+	for i, cc := range t.Chunks {
+		chunks[i] = chunk{
+			start:  boundToValue(cc.Start),
+			end:    boundToValue(cc.End),
+			number: i + 1,
+			total:  len(t.Chunks),
+		}
+		done[i] = cc.Done
+	}
+	return chunks, done
+}
+
+// setTableChunks records the chunks of a table, none of them processed.
+func (c *checkpointer) setTableChunks(phase, table string, chunks []chunk) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p := c.phaseLocked(phase)
+	if p.Tables == nil {
+		p.Tables = make(map[string]*TableCheckpoint)
+	}
+	t := &TableCheckpoint{Chunks: make([]*ChunkCheckpoint, len(chunks))}
+	for i, ch := range chunks {
+		t.Chunks[i] = &ChunkCheckpoint{
+			Start: valueToBound(ch.start),
+			End:   valueToBound(ch.end),
+		}
+	}
+	p.Tables[table] = t
+}
+
+// splitChunk replaces a chunk of a table by the two chunks on each side
+// of splitPoint.
+func (c *checkpointer) splitChunk(phase, table string, ch chunk, splitPoint sqltypes.Value) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p := c.phaseLocked(phase)
+	t, ok := p.Tables[table]
+	if !ok {
+		return
+	}
+	i := t.findLocked(ch)
+	if i < 0 {
+		return
+	}
+	bound := valueToBound(splitPoint)
+	rest := &ChunkCheckpoint{Start: bound, End: t.Chunks[i].End}
+	t.Chunks[i] = &ChunkCheckpoint{Start: t.Chunks[i].Start, End: bound}
+	t.Chunks = append(t.Chunks[:i+1], append([]*ChunkCheckpoint{rest}, t.Chunks[i+1:]...)...)
+}
+
+// chunkProcessed records that a chunk was processed. It's only marked
+// as done by the next commit().
+func (c *checkpointer) chunkProcessed(phase, table string, ch chunk) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tables, ok := c.pending[phase]
+	if !ok {
+		tables = make(map[string][]chunk)
+		c.pending[phase] = tables
+	}
+	tables[table] = append(tables[table], ch)
+}
+
+// isTableDone returns true if a previous run processed the table entirely.
+func (c *checkpointer) isTableDone(phase, table string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, ok := c.cp.Phases[phase]
+	if !ok {
+		return false
+	}
+	for _, t := range p.DoneTables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
+
+// tableDone records that a table was processed entirely.
+func (c *checkpointer) tableDone(phase, table string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p := c.phaseLocked(phase)
+	p.DoneTables = append(p.DoneTables, table)
+}
+
+// commit marks the chunks processed so far as done, and saves the
+// checkpoint. The chunks are only marked after flush returned, which
+// must make sure that the writes of the chunks are durable.
+func (c *checkpointer) commit(ctx context.Context, flush func(context.Context) error) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = make(map[string]map[string][]chunk)
+	c.mu.Unlock()
+
+	if flush != nil {
+		if err := flush(ctx); err != nil {
+			return vterrors.Wrap(err, "cannot flush the writes before the checkpoint")
+		}
+	}
+
+	c.mu.Lock()
+	for phase, tables := range pending {
+		p := c.phaseLocked(phase)
+		for table, chunks := range tables {
+			t, ok := p.Tables[table]
+			if !ok {
+				continue
+			}
+			for _, ch := range chunks {
+				if i := t.findLocked(ch); i >= 0 {
+					t.Chunks[i].Done = true
+				}
+			}
+		}
+	}
+	c.mu.Unlock()
+	return c.save(ctx)
+}
+
+// save writes the checkpoint to the global topology.
+func (c *checkpointer) save(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+	c.saveMu.Lock()
+	defer c.saveMu.Unlock()
+
+	c.mu.Lock()
+	c.cp.UpdateTime = time.Now()
+	data, err := json.MarshalIndent(c.cp, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	conn, err := c.wr.TopoServer().ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return err
+	}
+	if _, err := conn.Update(ctx, c.path, data, nil); err != nil {
+		return vterrors.Wrapf(err, "cannot save the checkpoint %v", c.path)
+	}
+	return nil
+}
+
+// delete removes the checkpoint from the global topology, once the job
+// succeeded.
+func (c *checkpointer) delete(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+	conn, err := c.wr.TopoServer().ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return err
+	}
+	if err := conn.Delete(ctx, c.path, nil); err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return vterrors.Wrapf(err, "cannot delete the checkpoint %v", c.path)
+	}
+	return nil
+}
+
+// findLocked returns the index of the chunk with the same range, or -1.
+func (t *TableCheckpoint) findLocked(ch chunk) int {
+	start, end := valueToBound(ch.start), valueToBound(ch.end)
+	for i, cc := range t.Chunks {
+		if sameBound(cc.Start, start) && sameBound(cc.End, end) {
+			return i
+		}
+	}
+	return -1
+}
+
+// valueToBound converts a chunk boundary, nil if it's unbounded.
+func valueToBound(v sqltypes.Value) *querypb.Value {
+	if v.IsNull() {
+		return nil
+	}
+	return sqltypes.ValueToProto(v)
+}
+
+// boundToValue converts a saved chunk boundary.
+func boundToValue(b *querypb.Value) sqltypes.Value {
+	if b == nil {
+		return sqltypes.NULL
+	}
+	return sqltypes.ProtoToValue(b)
+}
+
+func sameBound(a, b *querypb.Value) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Type == b.Type && bytes.Equal(a.Value, b.Value)
+}
+
+func samePositions(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
+
+// sourcePositionsMap returns the positions of the source shards, by
+// keyspace/shard.
+func sourcePositionsMap(shards []*topo.ShardInfo, positions []string) map[string]string {
+	result := make(map[string]string)
+	for i, si := range shards {
+		if i < len(positions) && positions[i] != "" {
+			result[topoproto.KeyspaceShardString(si.Keyspace(), si.ShardName())] = positions[i]
+		}
+	}
+	return result
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+func TestCheckpointer(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, nil)
+
+	c, err := newCheckpointer(ctx, wr, "SplitClone", "ks", "0", false /* resume */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	positions := map[string]string{"ks/0": "MariaDB/0-1-10"}
+	if c.startPhase(checkpointPhaseOffline, positions) {
+		t.Errorf("startPhase() of a new checkpoint: true, want false")
+	}
+	chunks := []chunk{
+		{sqltypes.NULL, sqltypes.NewInt64(100), 1, 2},
+		{sqltypes.NewInt64(100), sqltypes.NULL, 2, 2},
+	}
+	c.setTableChunks(checkpointPhaseOffline, "t1", chunks)
+	c.splitChunk(checkpointPhaseOffline, "t1", chunks[1], sqltypes.NewInt64(200))
+	c.chunkProcessed(checkpointPhaseOffline, "t1", chunks[0])
+	c.chunkProcessed(checkpointPhaseOffline, "t1", chunk{sqltypes.NewInt64(200), sqltypes.NULL, 0, 0})
+	flushed := false
+	if err := c.commit(ctx, func(context.Context) error {
+		flushed = true
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !flushed {
+		t.Errorf("commit() didn't flush the writes")
+	}
+	c.tableDone(checkpointPhaseDiff, "t2")
+	if err := c.save(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// A resumed job gets the chunks back.
+	c, err = newCheckpointer(ctx, wr, "SplitClone", "ks", "0", true /* resume */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.startPhase(checkpointPhaseOffline, positions) {
+		t.Errorf("startPhase() at the same positions: false, want true")
+	}
+	gotChunks, gotDone := c.tableChunks(checkpointPhaseOffline, "t1")
+	wantChunks := []chunk{
+		{sqltypes.NULL, sqltypes.NewInt64(100), 1, 3},
+		{sqltypes.NewInt64(100), sqltypes.NewInt64(200), 2, 3},
+		{sqltypes.NewInt64(200), sqltypes.NULL, 3, 3},
+	}
+	if !reflect.DeepEqual(gotChunks, wantChunks) {
+		t.Errorf("tableChunks() = %v, want %v", gotChunks, wantChunks)
+	}
+	if want := []bool{true, false, true}; !reflect.DeepEqual(gotDone, want) {
+		t.Errorf("tableChunks() done = %v, want %v", gotDone, want)
+	}
+	if !c.isTableDone(checkpointPhaseDiff, "t2") || c.isTableDone(checkpointPhaseDiff, "t1") {
+		t.Errorf("isTableDone() doesn't match the checkpoint")
+	}
+
+	// The progress is discarded if the sources moved.
+	if c.startPhase(checkpointPhaseOffline, map[string]string{"ks/0": "MariaDB/0-1-20"}) {
+		t.Errorf("startPhase() at other positions: true, want false")
+	}
+	if gotChunks, _ := c.tableChunks(checkpointPhaseOffline, "t1"); gotChunks != nil {
+		t.Errorf("tableChunks() after the positions changed = %v, want nil", gotChunks)
+	}
+
+	// The checkpoint is gone after the job succeeded.
+	if err := c.delete(ctx); err != nil {
+		t.Fatal(err)
+	}
+	c, err = newCheckpointer(ctx, wr, "SplitClone", "ks", "0", true /* resume */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.isTableDone(checkpointPhaseDiff, "t2") {
+		t.Errorf("isTableDone() after delete() = true, want false")
+	}
+
+	// A nil checkpointer does nothing.
+	var disabled *checkpointer
+	disabled.setTableChunks(checkpointPhaseOnline, "t1", chunks)
+	if gotChunks, _ := disabled.tableChunks(checkpointPhaseOnline, "t1"); gotChunks != nil {
+		t.Errorf("tableChunks() of a nil checkpointer = %v, want nil", gotChunks)
+	}
+	if err := disabled.commit(ctx, nil); err != nil {
+		t.Errorf("commit() of a nil checkpointer: %v", err)
+	}
+}
+
+func TestInsertFlusher(t *testing.T) {
+	ctx := context.Background()
+	channels := []chan string{make(chan string, 10), make(chan string, 10)}
+	flusher := newInsertFlusher(channels, 2)
+
+	// The executors record the inserts they executed.
+	mu := sync.Mutex{}
+	executed := make(map[string]bool)
+	wg := sync.WaitGroup{}
+	for _, c := range channels {
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func(c chan string) {
+				defer wg.Done()
+				for cmd := range c {
+					if strings.HasPrefix(cmd, flushMarkerPrefix) {
+						flusher.arrive(ctx, cmd)
+						continue
+					}
+					mu.Lock()
+					executed[cmd] = true
+					mu.Unlock()
+				}
+			}(c)
+		}
+	}
+
+	for i, c := range channels {
+		for j := 0; j < 5; j++ {
+			c <- strings.Repeat("x", i*5+j+1)
+		}
+	}
+	if err := flusher.flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	if len(executed) != 10 {
+		t.Errorf("executed %v inserts before the end of the flush, want 10", len(executed))
+	}
+	mu.Unlock()
+
+	for _, c := range channels {
+		close(c)
+	}
+	wg.Wait()
+
+	// A flush gives up when the context is canceled, here because
+	// nobody reads the channel.
+	stuck := newInsertFlusher([]chan string{make(chan string)}, 1)
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := stuck.flush(cancelCtx); err == nil {
+		t.Errorf("flush() with a canceled context succeeded, want error")
+	}
+}
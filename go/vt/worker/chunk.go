@@ -17,7 +17,11 @@ limitations under the License.
 package worker
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -26,9 +30,13 @@ import (
 
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
@@ -41,7 +49,7 @@ var (
 // chunk holds the information which subset of the table should be worked on.
 // The subset is the range of rows in the range [start, end) where start and end
 // both refer to the first column of the primary key.
-// If the column is not numeric, both start and end will be sqltypes.NULL.
+// sqltypes.NULL means that the range is not bounded on that side.
 type chunk struct {
 	start sqltypes.Value
 	end   sqltypes.Value
@@ -72,26 +80,21 @@ func digits(i int) int {
 }
 
 // generateChunks returns an array of chunks to use for splitting up a table
-// into multiple data chunks. It only works for tables with a primary key
-// whose first column is a numeric type.
-func generateChunks(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, td *tabletmanagerdatapb.TableDefinition, chunkCount, minRowsPerChunk int) ([]chunk, error) {
-	if len(td.PrimaryKeyColumns) == 0 {
-		// No explicit primary key. Cannot chunk the rows then.
-		wr.Logger().Infof("table=%v: Not splitting the table into multiple chunks because it has no primary key columns. This will reduce the performance of the clone.", td.Name)
-		return singleCompleteChunk, nil
-	}
-	if td.RowCount < 2*uint64(minRowsPerChunk) {
-		// The automatic adjustment of "chunkCount" based on "minRowsPerChunk"
-		// below would set "chunkCount" to less than 2 i.e. 1 or 0 chunks.
-		// In practice in this case there should be exactly one chunk.
-		// Return early in this case and notice the user about this.
-		wr.Logger().Infof("table=%v: Not splitting the table into multiple chunks because it has only %d rows.", td.Name, td.RowCount)
-		return singleCompleteChunk, nil
-	}
+// into multiple data chunks.
+// If sampleSize is set, the chunks have about the same number of rows,
+// see generateSampledChunks. Otherwise, the range of the first primary key
+// column is split into intervals of the same size, which only works for
+// a numeric column.
+func generateChunks(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, td *tabletmanagerdatapb.TableDefinition, chunkCount, minRowsPerChunk, sampleSize int) ([]chunk, error) {
+	chunkCount = chunkCountForTable(wr.Logger(), td, chunkCount, minRowsPerChunk)
 	if chunkCount == 1 {
 		return singleCompleteChunk, nil
 	}
 
+	if sampleSize > 0 {
+		return generateSampledChunks(ctx, wr, tablet, td, chunkCount, sampleSize)
+	}
+
 	// Get the MIN and MAX of the leading column of the primary key.
 	query := fmt.Sprintf("SELECT MIN(%v), MAX(%v) FROM %v.%v", sqlescape.EscapeID(td.PrimaryKeyColumns[0]), sqlescape.EscapeID(td.PrimaryKeyColumns[0]), sqlescape.EscapeID(topoproto.TabletDbName(tablet)), sqlescape.EscapeID(td.Name))
 	shortCtx, cancel := context.WithTimeout(ctx, *remoteActionsTimeout)
@@ -113,15 +116,6 @@ func generateChunks(ctx context.Context, wr *wrangler.Wrangler, tablet *topodata
 		return singleCompleteChunk, nil
 	}
 
-	// Determine the average number of rows per chunk for the given chunkCount.
-	avgRowsPerChunk := td.RowCount / uint64(chunkCount)
-	if avgRowsPerChunk < uint64(minRowsPerChunk) {
-		// Reduce the chunkCount to fulfill minRowsPerChunk.
-		newChunkCount := td.RowCount / uint64(minRowsPerChunk)
-		wr.Logger().Infof("table=%v: Reducing the number of chunks from the default %d to %d to make sure that each chunk has at least %d rows.", td.Name, chunkCount, newChunkCount, minRowsPerChunk)
-		chunkCount = int(newChunkCount)
-	}
-
 	// TODO(mberlin): Write a unit test for this part of the function.
 	var interval interface{}
 	chunks := make([]chunk, chunkCount)
@@ -172,6 +166,126 @@ func generateChunks(ctx context.Context, wr *wrangler.Wrangler, tablet *topodata
 	return chunks, nil
 }
 
+// chunkCountForTable returns the number of chunks of a table. It's
+// reduced so that every chunk has at least minRowsPerChunk rows, and
+// it's 1 if the table can't be split.
+func chunkCountForTable(logger logutil.Logger, td *tabletmanagerdatapb.TableDefinition, chunkCount, minRowsPerChunk int) int {
+	if len(td.PrimaryKeyColumns) == 0 {
+		// No explicit primary key. Cannot chunk the rows then.
+		logger.Infof("table=%v: Not splitting the table into multiple chunks because it has no primary key columns. This will reduce the performance of the clone.", td.Name)
+		return 1
+	}
+	if td.RowCount < 2*uint64(minRowsPerChunk) {
+		// The automatic adjustment of "chunkCount" based on "minRowsPerChunk"
+		// below would set "chunkCount" to less than 2 i.e. 1 or 0 chunks.
+		// In practice in this case there should be exactly one chunk.
+		// Return early in this case and notice the user about this.
+		logger.Infof("table=%v: Not splitting the table into multiple chunks because it has only %d rows.", td.Name, td.RowCount)
+		return 1
+	}
+	if chunkCount <= 1 {
+		return 1
+	}
+
+	// Determine the average number of rows per chunk for the given chunkCount.
+	avgRowsPerChunk := td.RowCount / uint64(chunkCount)
+	if avgRowsPerChunk < uint64(minRowsPerChunk) {
+		// Reduce the chunkCount to fulfill minRowsPerChunk.
+		newChunkCount := td.RowCount / uint64(minRowsPerChunk)
+		logger.Infof("table=%v: Reducing the number of chunks from the default %d to %d to make sure that each chunk has at least %d rows.", td.Name, chunkCount, newChunkCount, minRowsPerChunk)
+		chunkCount = int(newChunkCount)
+	}
+	return chunkCount
+}
+
+// generateSampledChunks returns chunks with about the same number of rows.
+// It reads a random sample of about sampleSize values of the first primary
+// key column, and uses the quantiles of the sample as chunk boundaries.
+// Unlike intervals of the same size, this balances the chunks of tables
+// with gaps or hot ranges in their primary key, and it also works for binary
+// and temporal columns.
+func generateSampledChunks(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, td *tabletmanagerdatapb.TableDefinition, chunkCount, sampleSize int) ([]chunk, error) {
+	if chunkCount <= 1 {
+		return singleCompleteChunk, nil
+	}
+	// The sample must have enough values to have a boundary per chunk.
+	if sampleSize < chunkCount {
+		sampleSize = chunkCount
+	}
+	rate := float64(sampleSize) / float64(td.RowCount)
+	if rate > 1 {
+		rate = 1
+	}
+	// RowCount is an estimate, leave some room in case it's too low.
+	maxRows := 2 * sampleSize
+	query := fmt.Sprintf("SELECT %v FROM %v.%v WHERE RAND() < %v LIMIT %v", sqlescape.EscapeID(td.PrimaryKeyColumns[0]), sqlescape.EscapeID(topoproto.TabletDbName(tablet)), sqlescape.EscapeID(td.Name), strconv.FormatFloat(rate, 'f', -1, 64), maxRows)
+	shortCtx, cancel := context.WithTimeout(ctx, *remoteActionsTimeout)
+	qr, err := wr.TabletManagerClient().ExecuteFetchAsApp(shortCtx, tablet, true, []byte(query), maxRows)
+	cancel()
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "tablet: %v, table: %v: cannot sample the first primary key column. ExecuteFetchAsApp", topoproto.TabletAliasString(tablet.Alias), td.Name)
+	}
+	result := sqltypes.Proto3ToResult(qr)
+	return sampledChunks(td.Name, result.Rows, chunkCount, wr.Logger())
+}
+
+// sampledChunks returns the chunks whose boundaries are the quantiles
+// of a sample of values.
+func sampledChunks(table string, rows [][]sqltypes.Value, chunkCount int, logger logutil.Logger) ([]chunk, error) {
+	values := make([]sqltypes.Value, 0, len(rows))
+	for _, row := range rows {
+		if !row[0].IsNull() {
+			values = append(values, row[0])
+		}
+	}
+	var sortErr error
+	sort.Slice(values, func(i, j int) bool {
+		cmp, err := sqltypes.NullsafeCompare(values[i], values[j])
+		if err != nil {
+			sortErr = err
+		}
+		return cmp < 0
+	})
+	if sortErr != nil {
+		// Text columns are compared with their collation by MySQL,
+		// boundaries sorted differently would make chunks overlap.
+		logger.Infof("table=%v: Not splitting the table into multiple chunks, the first primary key column cannot be sorted: %v", table, sortErr)
+		return singleCompleteChunk, nil
+	}
+
+	var boundaries []sqltypes.Value
+	for i := 1; i < chunkCount; i++ {
+		index := i * len(values) / chunkCount
+		if index >= len(values) {
+			break
+		}
+		// Skip duplicate values, chunks must not be empty.
+		if len(boundaries) > 0 {
+			if cmp, _ := sqltypes.NullsafeCompare(values[index], boundaries[len(boundaries)-1]); cmp == 0 {
+				continue
+			}
+		}
+		boundaries = append(boundaries, values[index])
+	}
+	if len(boundaries) == 0 {
+		logger.Infof("table=%v: Not splitting the table into multiple chunks, the sample of %d values has no boundaries.", table, len(values))
+		return singleCompleteChunk, nil
+	}
+
+	total := len(boundaries) + 1
+	if total < chunkCount {
+		logger.Infof("table=%v: Reducing the number of chunks from %d to %d because the sample of %d values has too few distinct values.", table, chunkCount, total, len(values))
+	}
+	chunks := make([]chunk, total)
+	start := sqltypes.NULL
+	for i, end := range boundaries {
+		chunks[i] = chunk{start, end, i + 1, total}
+		start = end
+	}
+	chunks[total-1] = chunk{start, sqltypes.NULL, total, total}
+	return chunks, nil
+}
+
 func add(start, interval interface{}) interface{} {
 	switch start := start.(type) {
 	case int64:
@@ -196,3 +310,117 @@ func toChunk(start, end interface{}, number, total int) (chunk, error) {
 	}
 	return chunk{startValue, endValue, number, total}, nil
 }
+
+// splitQueryEndBindVariablePrefix is the prefix of the bind variables
+// which have the end of the query parts returned by SplitQuery.
+const splitQueryEndBindVariablePrefix = "_splitquery_end_"
+
+// generateSplitQueryChunks returns the chunks computed by the SplitQuery
+// algorithm of the tablet on the first primary key column. EQUAL_SPLITS
+// splits its range into intervals of the same size, from its cached MIN
+// and MAX. FULL_SCAN reads the column and returns chunks with the same
+// number of rows. The tablet must be a RDONLY tablet.
+func generateSplitQueryChunks(ctx context.Context, wr *wrangler.Wrangler, queryService queryservice.QueryService, tablet *topodatapb.Tablet, td *tabletmanagerdatapb.TableDefinition, chunkCount, minRowsPerChunk int, algorithm querypb.SplitQueryRequest_Algorithm) ([]chunk, error) {
+	chunkCount = chunkCountForTable(wr.Logger(), td, chunkCount, minRowsPerChunk)
+	if chunkCount == 1 {
+		return singleCompleteChunk, nil
+	}
+
+	target := &querypb.Target{
+		Keyspace:   tablet.Keyspace,
+		Shard:      tablet.Shard,
+		TabletType: tablet.Type,
+	}
+	query := &querypb.BoundQuery{
+		Sql: fmt.Sprintf("SELECT %v FROM %v", sqlescape.EscapeID(td.PrimaryKeyColumns[0]), sqlescape.EscapeID(td.Name)),
+	}
+	shortCtx, cancel := context.WithTimeout(ctx, *remoteActionsTimeout)
+	splits, err := queryService.SplitQuery(shortCtx, target, query, []string{td.PrimaryKeyColumns[0]}, int64(chunkCount), 0 /* numRowsPerQueryPart */, algorithm)
+	cancel()
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "tablet: %v, table: %v: SplitQuery failed", topoproto.TabletAliasString(tablet.Alias), td.Name)
+	}
+	return splitQueryChunks(td.Name, td.PrimaryKeyColumns[0], splits, wr.Logger())
+}
+
+// splitQueryChunks returns the chunks of the query parts of SplitQuery.
+// The end of each part but the last one is a chunk boundary.
+func splitQueryChunks(table, column string, splits []*querypb.QuerySplit, logger logutil.Logger) ([]chunk, error) {
+	name := splitQueryEndBindVariablePrefix + sqlparser.NewColIdent(column).CompliantName()
+	var boundaries []sqltypes.Value
+	for _, split := range splits {
+		bv, ok := split.Query.BindVariables[name]
+		if !ok {
+			// The last query part has no end.
+			continue
+		}
+		end, err := sqltypes.BindVariableToValue(bv)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "table: %v: invalid end of SplitQuery query part", table)
+		}
+		boundaries = append(boundaries, end)
+	}
+	if len(boundaries) == 0 {
+		logger.Infof("table=%v: Not splitting the table into multiple chunks, SplitQuery returned %d query parts.", table, len(splits))
+		return singleCompleteChunk, nil
+	}
+
+	total := len(boundaries) + 1
+	chunks := make([]chunk, total)
+	start := sqltypes.NULL
+	for i, end := range boundaries {
+		chunks[i] = chunk{start, end, i + 1, total}
+		start = end
+	}
+	chunks[total-1] = chunk{start, sqltypes.NULL, total, total}
+	return chunks, nil
+}
+
+// chunkSplitPoint returns the value of the first primary key column
+// after maxRows rows of the chunk, to split the chunks that are much
+// larger than estimated. It returns NULL if the chunk has at most maxRows
+// rows, or if the value is the start of the chunk, which happens when
+// the first primary key column has many duplicate values.
+func chunkSplitPoint(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, td *tabletmanagerdatapb.TableDefinition, c chunk, maxRows int) (sqltypes.Value, error) {
+	column := sqlescape.EscapeID(td.PrimaryKeyColumns[0])
+	// MySQL compares the value to the start of the chunk, with the
+	// collation of the column.
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "SELECT %v, ", column)
+	if c.start.IsNull() {
+		b.WriteString("1")
+	} else {
+		fmt.Fprintf(&b, "%v>", column)
+		c.start.EncodeSQL(&b)
+	}
+	fmt.Fprintf(&b, " FROM %v.%v", sqlescape.EscapeID(topoproto.TabletDbName(tablet)), sqlescape.EscapeID(td.Name))
+	var clauses []string
+	if !c.start.IsNull() {
+		var clause bytes.Buffer
+		fmt.Fprintf(&clause, "%v>=", column)
+		c.start.EncodeSQL(&clause)
+		clauses = append(clauses, clause.String())
+	}
+	if !c.end.IsNull() {
+		var clause bytes.Buffer
+		fmt.Fprintf(&clause, "%v<", column)
+		c.end.EncodeSQL(&clause)
+		clauses = append(clauses, clause.String())
+	}
+	if len(clauses) > 0 {
+		fmt.Fprintf(&b, " WHERE %v", strings.Join(clauses, " AND "))
+	}
+	fmt.Fprintf(&b, " ORDER BY %v LIMIT 1 OFFSET %d", column, maxRows)
+
+	shortCtx, cancel := context.WithTimeout(ctx, *remoteActionsTimeout)
+	qr, err := wr.TabletManagerClient().ExecuteFetchAsApp(shortCtx, tablet, true, b.Bytes(), 1)
+	cancel()
+	if err != nil {
+		return sqltypes.NULL, vterrors.Wrapf(err, "tablet: %v, table: %v: cannot find the split point of chunk %v. ExecuteFetchAsApp", topoproto.TabletAliasString(tablet.Alias), td.Name, c)
+	}
+	result := sqltypes.Proto3ToResult(qr)
+	if len(result.Rows) == 0 || result.Rows[0][1].ToString() != "1" {
+		return sqltypes.NULL, nil
+	}
+	return result.Rows[0][0], nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"reflect"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestSampledChunks(t *testing.T) {
+	// Most of the rows are in a hot range, the intervals of the same
+	// size would put them all in the same chunk.
+	fields := sqltypes.MakeTestFields("id", "int64")
+	sample := sqltypes.MakeTestResult(fields, "1000000", "3", "1", "4", "2", "5", "6", "7")
+
+	testcases := []struct {
+		rows       [][]sqltypes.Value
+		chunkCount int
+		want       []chunk
+	}{{
+		rows:       sample.Rows,
+		chunkCount: 4,
+		want: []chunk{
+			{sqltypes.NULL, sqltypes.NewInt64(3), 1, 4},
+			{sqltypes.NewInt64(3), sqltypes.NewInt64(5), 2, 4},
+			{sqltypes.NewInt64(5), sqltypes.NewInt64(7), 3, 4},
+			{sqltypes.NewInt64(7), sqltypes.NULL, 4, 4},
+		},
+	}, {
+		// Duplicate boundaries are skipped.
+		rows:       sqltypes.MakeTestResult(fields, "1", "1", "1", "1", "1", "1", "2", "2").Rows,
+		chunkCount: 4,
+		want: []chunk{
+			{sqltypes.NULL, sqltypes.NewInt64(1), 1, 3},
+			{sqltypes.NewInt64(1), sqltypes.NewInt64(2), 2, 3},
+			{sqltypes.NewInt64(2), sqltypes.NULL, 3, 3},
+		},
+	}, {
+		// An empty sample has no boundaries.
+		rows:       nil,
+		chunkCount: 4,
+		want:       singleCompleteChunk,
+	}}
+	for _, tc := range testcases {
+		got, err := sampledChunks("t1", tc.rows, tc.chunkCount, logutil.NewMemoryLogger())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("sampledChunks(%v, %v):\n%v, want\n%v", tc.rows, tc.chunkCount, got, tc.want)
+		}
+	}
+
+	// Binary columns are supported.
+	rows := sqltypes.MakeTestResult(sqltypes.MakeTestFields("name", "varbinary"), "d", "a", "c", "b").Rows
+	got, err := sampledChunks("t1", rows, 2, logutil.NewMemoryLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []chunk{
+		{sqltypes.NULL, sqltypes.NewVarBinary("c"), 1, 2},
+		{sqltypes.NewVarBinary("c"), sqltypes.NULL, 2, 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sampledChunks:\n%v, want\n%v", got, want)
+	}
+
+	// Text columns are sorted by MySQL with their collation.
+	rows = sqltypes.MakeTestResult(sqltypes.MakeTestFields("name", "varchar"), "d", "a", "c", "b").Rows
+	got, err = sampledChunks("t1", rows, 2, logutil.NewMemoryLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, singleCompleteChunk) {
+		t.Errorf("sampledChunks:\n%v, want\n%v", got, singleCompleteChunk)
+	}
+}
+
+func TestSplitQueryChunks(t *testing.T) {
+	part := func(start, end *querypb.BindVariable) *querypb.QuerySplit {
+		bindVars := make(map[string]*querypb.BindVariable)
+		if start != nil {
+			bindVars["_splitquery_start_id"] = start
+		}
+		if end != nil {
+			bindVars["_splitquery_end_id"] = end
+		}
+		return &querypb.QuerySplit{Query: &querypb.BoundQuery{BindVariables: bindVars}}
+	}
+	splits := []*querypb.QuerySplit{
+		part(nil, sqltypes.Int64BindVariable(10)),
+		part(sqltypes.Int64BindVariable(10), sqltypes.Int64BindVariable(20)),
+		part(sqltypes.Int64BindVariable(20), nil),
+	}
+	got, err := splitQueryChunks("t1", "id", splits, logutil.NewMemoryLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []chunk{
+		{sqltypes.NULL, sqltypes.NewInt64(10), 1, 3},
+		{sqltypes.NewInt64(10), sqltypes.NewInt64(20), 2, 3},
+		{sqltypes.NewInt64(20), sqltypes.NULL, 3, 3},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("splitQueryChunks:\n%v, want\n%v", got, want)
+	}
+
+	// A single query part is a single chunk.
+	got, err = splitQueryChunks("t1", "id", []*querypb.QuerySplit{part(nil, nil)}, logutil.NewMemoryLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, singleCompleteChunk) {
+		t.Errorf("splitQueryChunks:\n%v, want\n%v", got, singleCompleteChunk)
+	}
+}
@@ -28,7 +28,14 @@ const (
 	// defaultMinRowsPerChunk is the minimum number of rows a chunk should have
 	// on average. If this is not guaranteed, --chunk_count will be reduced
 	// automatically.
-	defaultMinRowsPerChunk   = 10 * 1000
+	defaultMinRowsPerChunk = 10 * 1000
+	// defaultChunkSampleSize disables the row count balanced chunks by
+	// default. Sampling reads the whole first primary key column.
+	defaultChunkSampleSize = 0
+	// defaultChunkAlgorithm doesn't use SplitQuery to compute the chunks.
+	defaultChunkAlgorithm = ""
+	// defaultMaxRowsPerChunk disables the split of the large chunks.
+	defaultMaxRowsPerChunk   = 0
 	defaultSourceReaderCount = 10
 	// defaultWriteQueryMaxRows aggregates up to 100 rows per INSERT or DELETE
 	// query. Higher values are not recommended to avoid overloading MySQL.
@@ -18,6 +18,8 @@ package worker
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"vitess.io/vitess/go/vt/vterrors"
@@ -49,6 +51,8 @@ type executor struct {
 	// statsKey is the cached metric key which we need when we increment the stats
 	// variable when we get throttled.
 	statsKey []string
+	// flusher receives the flush markers of the insert channel, if set.
+	flusher *insertFlusher
 }
 
 func newExecutor(wr *wrangler.Wrangler, tsc *discovery.TabletStatsCache, throttler *throttler.Throttler, keyspace, shard string, threadID int) *executor {
@@ -73,6 +77,12 @@ func (e *executor) fetchLoop(ctx context.Context, insertChannel chan string) err
 				// no more to read, we're done
 				return nil
 			}
+			if strings.HasPrefix(cmd, flushMarkerPrefix) {
+				if e.flusher != nil {
+					e.flusher.arrive(ctx, cmd)
+				}
+				continue
+			}
 			if err := e.fetchWithRetries(ctx, func(ctx context.Context, tablet *topodatapb.Tablet) error {
 				_, err := e.wr.TabletManagerClient().ExecuteFetchAsApp(ctx, tablet, true, []byte(cmd), 0)
 				return err
@@ -246,3 +256,106 @@ func (e *executor) checkError(ctx context.Context, err error, isRetry bool, mast
 	}
 	return false, nil
 }
+
+// flushMarkerPrefix starts the markers which an insertFlusher sends to
+// the executors. It can't start a query.
+const flushMarkerPrefix = "\x00flush "
+
+// insertFlusher waits until the executors of the insert channels have
+// executed the inserts which were sent to them before.
+type insertFlusher struct {
+	channels    []chan string
+	writerCount int
+
+	// flushMu serializes the flushes.
+	flushMu sync.Mutex
+
+	// mu protects the fields below.
+	mu       sync.Mutex
+	seq      int
+	barriers map[string]*flushBarrier
+}
+
+// flushBarrier is where the executors wait for each other during a flush.
+type flushBarrier struct {
+	remaining int
+	// arrived is closed when all the executors got their marker.
+	arrived chan struct{}
+	// release is closed when the flush is over.
+	release chan struct{}
+}
+
+// newInsertFlusher returns an insertFlusher for the channels, which are
+// each read by writerCount executors.
+func newInsertFlusher(channels []chan string, writerCount int) *insertFlusher {
+	return &insertFlusher{
+		channels:    channels,
+		writerCount: writerCount,
+		barriers:    make(map[string]*flushBarrier),
+	}
+}
+
+// flush sends a marker to every executor, and returns when all of them
+// received theirs. As an executor runs its inserts one at a time, and
+// waits at its marker until the others got theirs, all the inserts which
+// were sent before are executed then.
+// The channels must not be closed during a flush.
+func (f *insertFlusher) flush(ctx context.Context) error {
+	f.flushMu.Lock()
+	defer f.flushMu.Unlock()
+
+	f.mu.Lock()
+	f.seq++
+	marker := fmt.Sprintf("%v%d", flushMarkerPrefix, f.seq)
+	b := &flushBarrier{
+		remaining: len(f.channels) * f.writerCount,
+		arrived:   make(chan struct{}),
+		release:   make(chan struct{}),
+	}
+	f.barriers[marker] = b
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		delete(f.barriers, marker)
+		f.mu.Unlock()
+		close(b.release)
+	}()
+
+	for _, c := range f.channels {
+		for i := 0; i < f.writerCount; i++ {
+			select {
+			case c <- marker:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	select {
+	case <-b.arrived:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// arrive is called by an executor which received a marker. It returns
+// when the flush is over.
+func (f *insertFlusher) arrive(ctx context.Context, marker string) {
+	f.mu.Lock()
+	b, ok := f.barriers[marker]
+	if ok {
+		b.remaining--
+		if b.remaining == 0 {
+			close(b.arrived)
+		}
+	}
+	f.mu.Unlock()
+	if !ok {
+		// The flush gave up already.
+		return
+	}
+	select {
+	case <-b.release:
+	case <-ctx.Done():
+	}
+}
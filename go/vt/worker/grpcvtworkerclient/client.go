@@ -38,7 +38,7 @@ var (
 	cert = flag.String("vtworker_client_grpc_cert", "", "the cert to use to connect")
 	key  = flag.String("vtworker_client_grpc_key", "", "the key to use to connect")
 	ca   = flag.String("vtworker_client_grpc_ca", "", "the server ca to use to validate servers when connecting")
-	name = flag.String("vtworker_client_grpc_server_name", "", "the server name to use to validate server certificate")
+	name = flag.String("vtworker_client_grpc_server_name", "", "the server name to use to validate server certificate, or the SPIFFE ID or trust domain of the server (like spiffe://example.org/vttablet), which requires a ca")
 )
 
 type gRPCVtworkerClient struct {
@@ -553,7 +553,7 @@ func (scw *LegacySplitCloneWorker) copy(ctx context.Context) error {
 			}
 			rowSplitter := NewRowSplitter(scw.destinationShards, keyResolver)
 
-			chunks, err := generateChunks(ctx, scw.wr, scw.sourceTablets[shardIndex], td, scw.sourceReaderCount, defaultMinRowsPerChunk)
+			chunks, err := generateChunks(ctx, scw.wr, scw.sourceTablets[shardIndex], td, scw.sourceReaderCount, defaultMinRowsPerChunk, 0 /* sampleSize */)
 			if err != nil {
 				return err
 			}
@@ -42,6 +42,7 @@ import (
 	"vitess.io/vitess/go/vt/wrangler"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
@@ -77,6 +78,9 @@ type SplitCloneWorker struct {
 	excludeTables          []string
 	chunkCount             int
 	minRowsPerChunk        int
+	chunkSampleSize        int
+	chunkAlgorithm         string // SplitQuery algorithm of the chunks, if not empty
+	maxRowsPerChunk        int    // larger chunks are split when they're cloned, if not 0
 	sourceReaderCount      int
 	writeQueryMaxRows      int
 	writeQueryMaxSize      int
@@ -85,10 +89,12 @@ type SplitCloneWorker struct {
 	tabletType             topodatapb.TabletType
 	maxTPS                 int64
 	maxReplicationLag      int64
+	resume                 bool // continue from the checkpoint of a previous run
 	cleaner                *wrangler.Cleaner
 	tabletTracker          *TabletTracker
 
 	// populated during WorkerStateInit, read-only after that
+	checkpoint              *checkpointer
 	destinationKeyspaceInfo *topo.KeyspaceInfo
 	sourceShards            []*topo.ShardInfo
 	destinationShards       []*topo.ShardInfo
@@ -144,20 +150,20 @@ type SplitCloneWorker struct {
 }
 
 // newSplitCloneWorker returns a new worker object for the SplitClone command.
-func newSplitCloneWorker(wr *wrangler.Wrangler, cell, keyspace, shard string, online, offline bool, excludeTables []string, chunkCount, minRowsPerChunk, sourceReaderCount, writeQueryMaxRows, writeQueryMaxSize, destinationWriterCount, minHealthyTablets int, tabletType topodatapb.TabletType, maxTPS, maxReplicationLag int64, useConsistentSnapshot bool) (Worker, error) {
-	return newCloneWorker(wr, horizontalResharding, cell, keyspace, shard, online, offline, nil /* tables */, excludeTables, chunkCount, minRowsPerChunk, sourceReaderCount, writeQueryMaxRows, writeQueryMaxSize, destinationWriterCount, minHealthyTablets, tabletType, maxTPS, maxReplicationLag, useConsistentSnapshot)
+func newSplitCloneWorker(wr *wrangler.Wrangler, cell, keyspace, shard string, online, offline bool, excludeTables []string, chunkCount, minRowsPerChunk, chunkSampleSize int, chunkAlgorithm string, maxRowsPerChunk, sourceReaderCount, writeQueryMaxRows, writeQueryMaxSize, destinationWriterCount, minHealthyTablets int, tabletType topodatapb.TabletType, maxTPS, maxReplicationLag int64, useConsistentSnapshot, resume bool) (Worker, error) {
+	return newCloneWorker(wr, horizontalResharding, cell, keyspace, shard, online, offline, nil /* tables */, excludeTables, chunkCount, minRowsPerChunk, chunkSampleSize, chunkAlgorithm, maxRowsPerChunk, sourceReaderCount, writeQueryMaxRows, writeQueryMaxSize, destinationWriterCount, minHealthyTablets, tabletType, maxTPS, maxReplicationLag, useConsistentSnapshot, resume)
 }
 
 // newVerticalSplitCloneWorker returns a new worker object for the
 // VerticalSplitClone command.
-func newVerticalSplitCloneWorker(wr *wrangler.Wrangler, cell, keyspace, shard string, online, offline bool, tables []string, chunkCount, minRowsPerChunk, sourceReaderCount, writeQueryMaxRows, writeQueryMaxSize, destinationWriterCount, minHealthyTablets int, tabletType topodatapb.TabletType, maxTPS, maxReplicationLag int64, useConsistentSnapshot bool) (Worker, error) {
-	return newCloneWorker(wr, verticalSplit, cell, keyspace, shard, online, offline, tables, nil /* excludeTables */, chunkCount, minRowsPerChunk, sourceReaderCount, writeQueryMaxRows, writeQueryMaxSize, destinationWriterCount, minHealthyTablets, tabletType, maxTPS, maxReplicationLag, useConsistentSnapshot)
+func newVerticalSplitCloneWorker(wr *wrangler.Wrangler, cell, keyspace, shard string, online, offline bool, tables []string, chunkCount, minRowsPerChunk, sourceReaderCount, writeQueryMaxRows, writeQueryMaxSize, destinationWriterCount, minHealthyTablets int, tabletType topodatapb.TabletType, maxTPS, maxReplicationLag int64, useConsistentSnapshot, resume bool) (Worker, error) {
+	return newCloneWorker(wr, verticalSplit, cell, keyspace, shard, online, offline, tables, nil /* excludeTables */, chunkCount, minRowsPerChunk, 0 /* chunkSampleSize */, "" /* chunkAlgorithm */, 0 /* maxRowsPerChunk */, sourceReaderCount, writeQueryMaxRows, writeQueryMaxSize, destinationWriterCount, minHealthyTablets, tabletType, maxTPS, maxReplicationLag, useConsistentSnapshot, resume)
 }
 
 // newCloneWorker returns a new SplitCloneWorker object which is used both by
 // the SplitClone and VerticalSplitClone command.
 // TODO(mberlin): Rename SplitCloneWorker to cloneWorker.
-func newCloneWorker(wr *wrangler.Wrangler, cloneType cloneType, cell, keyspace, shard string, online, offline bool, tables, excludeTables []string, chunkCount, minRowsPerChunk, sourceReaderCount, writeQueryMaxRows, writeQueryMaxSize, destinationWriterCount, minHealthyTablets int, tabletType topodatapb.TabletType, maxTPS, maxReplicationLag int64, useConsistentSnapshot bool) (Worker, error) {
+func newCloneWorker(wr *wrangler.Wrangler, cloneType cloneType, cell, keyspace, shard string, online, offline bool, tables, excludeTables []string, chunkCount, minRowsPerChunk, chunkSampleSize int, chunkAlgorithm string, maxRowsPerChunk, sourceReaderCount, writeQueryMaxRows, writeQueryMaxSize, destinationWriterCount, minHealthyTablets int, tabletType topodatapb.TabletType, maxTPS, maxReplicationLag int64, useConsistentSnapshot, resume bool) (Worker, error) {
 	if cloneType != horizontalResharding && cloneType != verticalSplit {
 		return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "unknown cloneType: %v This is a bug. Please report", cloneType)
 	}
@@ -175,6 +181,20 @@ func newCloneWorker(wr *wrangler.Wrangler, cloneType cloneType, cell, keyspace,
 	if minRowsPerChunk <= 0 {
 		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "min_rows_per_chunk must be > 0: %v", minRowsPerChunk)
 	}
+	if chunkSampleSize < 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "chunk_sample_size must be >= 0: %v", chunkSampleSize)
+	}
+	if chunkAlgorithm != "" {
+		if _, ok := querypb.SplitQueryRequest_Algorithm_value[chunkAlgorithm]; !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "chunk_algorithm must be EQUAL_SPLITS or FULL_SCAN: %v", chunkAlgorithm)
+		}
+		if chunkSampleSize > 0 {
+			return nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "chunk_algorithm and chunk_sample_size cannot be both set")
+		}
+	}
+	if maxRowsPerChunk < 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "max_rows_per_chunk must be >= 0: %v", maxRowsPerChunk)
+	}
 	if sourceReaderCount <= 0 {
 		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "source_reader_count must be > 0: %v", sourceReaderCount)
 	}
@@ -216,6 +236,9 @@ func newCloneWorker(wr *wrangler.Wrangler, cloneType cloneType, cell, keyspace,
 		excludeTables:          excludeTables,
 		chunkCount:             chunkCount,
 		minRowsPerChunk:        minRowsPerChunk,
+		chunkSampleSize:        chunkSampleSize,
+		chunkAlgorithm:         chunkAlgorithm,
+		maxRowsPerChunk:        maxRowsPerChunk,
 		sourceReaderCount:      sourceReaderCount,
 		writeQueryMaxRows:      writeQueryMaxRows,
 		writeQueryMaxSize:      writeQueryMaxSize,
@@ -229,6 +252,7 @@ func newCloneWorker(wr *wrangler.Wrangler, cloneType cloneType, cell, keyspace,
 		tableStatusListOnline:  &tableStatusList{},
 		tableStatusListOffline: &tableStatusList{},
 		useConsistentSnapshot:  useConsistentSnapshot,
+		resume:                 resume,
 
 		throttlers:         make(map[string]*throttler.Throttler),
 		destinationDbNames: make(map[string]string),
@@ -428,6 +452,10 @@ func (scw *SplitCloneWorker) Run(ctx context.Context) error {
 		scw.setErrorState(err)
 		return err
 	}
+	// The job won't be resumed.
+	if err := scw.checkpoint.delete(ctx); err != nil {
+		scw.wr.Logger().Warningf("%v", err)
+	}
 	scw.setState(WorkerStateDone)
 	return nil
 }
@@ -440,6 +468,15 @@ func (scw *SplitCloneWorker) run(ctx context.Context) error {
 	if err := checkDone(ctx); err != nil {
 		return err
 	}
+	job := "SplitClone"
+	if scw.cloneType == verticalSplit {
+		job = "VerticalSplitClone"
+	}
+	checkpoint, err := newCheckpointer(ctx, scw.wr, job, scw.destinationKeyspace, scw.shard, scw.resume)
+	if err != nil {
+		return err
+	}
+	scw.checkpoint = checkpoint
 
 	// Phase 2: Find destination master tablets.
 	if err := scw.findDestinationMasters(ctx); err != nil {
@@ -450,8 +487,11 @@ func (scw *SplitCloneWorker) run(ctx context.Context) error {
 	}
 
 	// Phase 3: (optional) online clone.
-	if scw.online {
+	if scw.online && scw.checkpoint.isPhaseDone(checkpointPhaseOnline) {
+		scw.wr.Logger().Infof("Online clone skipped because it was completed by a previous run.")
+	} else if scw.online {
 		scw.wr.Logger().Infof("Online clone will be run now.")
+		scw.checkpoint.startPhase(checkpointPhaseOnline, nil /* positions */)
 		// 3a: Wait for minimum number of source tablets (required for the diff).
 		if err := scw.waitForTablets(ctx, scw.sourceShards, *waitForHealthyTabletsTimeout); err != nil {
 			return vterrors.Wrap(err, "waitForTablets(sourceShards) failed")
@@ -461,6 +501,10 @@ func (scw *SplitCloneWorker) run(ctx context.Context) error {
 		if err := scw.clone(ctx, WorkerStateCloneOnline); err != nil {
 			return vterrors.Wrap(err, "online clone() failed")
 		}
+		scw.checkpoint.phaseDone(checkpointPhaseOnline)
+		if err := scw.checkpoint.save(ctx); err != nil {
+			scw.wr.Logger().Warningf("%v", err)
+		}
 		d := time.Since(start)
 		if err := checkDone(ctx); err != nil {
 			return err
@@ -473,7 +517,10 @@ func (scw *SplitCloneWorker) run(ctx context.Context) error {
 	}
 
 	// Phase 4: offline clone.
-	if scw.offline {
+	if scw.offline && scw.checkpoint.isPhaseDone(checkpointPhaseOffline) {
+		// The replication was set up as well.
+		scw.wr.Logger().Infof("Offline clone skipped because it was completed by a previous run.")
+	} else if scw.offline {
 		scw.wr.Logger().Infof("Offline clone will be run now.")
 		if scw.online {
 			// Wait until the inserts from the online clone were propagated
@@ -498,7 +545,17 @@ func (scw *SplitCloneWorker) run(ctx context.Context) error {
 			return err
 		}
 
-		// 4b: Clone the data.
+		// 4b: The progress of a previous offline clone is only valid
+		// if the sources are still at the same positions.
+		positions, err := scw.getSourcePositions(ctx)
+		if err != nil {
+			return vterrors.Wrap(err, "cannot get the source positions")
+		}
+		if scw.checkpoint.startPhase(checkpointPhaseOffline, sourcePositionsMap(scw.sourceShards, positions)) {
+			scw.wr.Logger().Infof("Resuming the offline clone at the source positions %v.", positions)
+		}
+
+		// 4c: Clone the data.
 		start := time.Now()
 		if err := scw.clone(ctx, WorkerStateCloneOffline); err != nil {
 			return vterrors.Wrap(err, "offline clone() failed")
@@ -506,6 +563,10 @@ func (scw *SplitCloneWorker) run(ctx context.Context) error {
 		if err := scw.setUpVReplication(ctx); err != nil {
 			return vterrors.Wrap(err, "failed to set up replication")
 		}
+		scw.checkpoint.phaseDone(checkpointPhaseOffline)
+		if err := scw.checkpoint.save(ctx); err != nil {
+			scw.wr.Logger().Warningf("%v", err)
+		}
 
 		d := time.Since(start)
 		if err := checkDone(ctx); err != nil {
@@ -921,12 +982,13 @@ func (scw *SplitCloneWorker) getCounters(state StatusWorkerState) ([]*stats.Coun
 	}
 }
 
-func (scw *SplitCloneWorker) startExecutor(ctx context.Context, wg *sync.WaitGroup, keyspace, shard string, insertChannel chan string, threadID int, processError func(string, ...interface{})) {
+func (scw *SplitCloneWorker) startExecutor(ctx context.Context, wg *sync.WaitGroup, keyspace, shard string, insertChannel chan string, threadID int, flusher *insertFlusher, processError func(string, ...interface{})) {
 	defer wg.Done()
 	t := scw.getThrottler(keyspace, shard)
 	//defer t.ThreadFinished(threadID)
 
 	executor := newExecutor(scw.wr, scw.tsc, t, keyspace, shard, threadID)
+	executor.flusher = flusher
 	if err := executor.fetchLoop(ctx, insertChannel); err != nil {
 		processError("executer.FetchLoop failed: %v", err)
 	}
@@ -1020,14 +1082,16 @@ func (scw *SplitCloneWorker) getDestinationResultReader(ctx context.Context, td
 	return resultReader, err
 }
 
-func (scw *SplitCloneWorker) cloneAChunk(ctx context.Context, td *tabletmanagerdatapb.TableDefinition, tableIndex int, chunk chunk, processError func(string, ...interface{}), state StatusWorkerState, tableStatusList *tableStatusList, keyResolver keyspaceIDResolver, start time.Time, insertChannels []chan string, txID int64, statsCounters []*stats.CountersWithSingleLabel) {
+// cloneAChunk reconciles a chunk of a table. It returns true if all the
+// inserts of the chunk were sent to the executors.
+func (scw *SplitCloneWorker) cloneAChunk(ctx context.Context, td *tabletmanagerdatapb.TableDefinition, tableIndex int, chunk chunk, processError func(string, ...interface{}), state StatusWorkerState, tableStatusList *tableStatusList, keyResolver keyspaceIDResolver, start time.Time, insertChannels []chan string, txID int64, statsCounters []*stats.CountersWithSingleLabel) bool {
 	errPrefix := fmt.Sprintf("table=%v chunk=%v", td.Name, chunk)
 
 	var err error
 
 	if err := checkDone(ctx); err != nil {
 		processError("%v: Context expired while this thread was waiting for its turn. Context error: %v", errPrefix, err)
-		return
+		return false
 	}
 
 	tableStatusList.threadStarted(tableIndex)
@@ -1038,7 +1102,7 @@ func (scw *SplitCloneWorker) cloneAChunk(ctx context.Context, td *tabletmanagerd
 		// and their replication lag might have increased since we started.)
 		if err := scw.waitForTablets(ctx, scw.sourceShards, *retryDuration); err != nil {
 			processError("%v: No healthy source tablets found (gave up after %v): %v", errPrefix, time.Since(start), err)
-			return
+			return false
 		}
 	}
 
@@ -1047,13 +1111,13 @@ func (scw *SplitCloneWorker) cloneAChunk(ctx context.Context, td *tabletmanagerd
 	sourceReader, err := scw.getSourceResultReader(ctx, td, state, chunk, txID)
 	if err != nil {
 		processError("%v NewResultMerger for source tablets failed: %v", errPrefix, err)
-		return
+		return false
 	}
 	defer sourceReader.Close(ctx)
 	destReader, err := scw.getDestinationResultReader(ctx, td, state, chunk)
 	if err != nil {
 		processError("%v NewResultMerger for destinations tablets failed: %v", errPrefix, err)
-		return
+		return false
 	}
 	defer destReader.Close(ctx)
 	dbNames := make([]string, len(scw.destinationShards))
@@ -1067,14 +1131,15 @@ func (scw *SplitCloneWorker) cloneAChunk(ctx context.Context, td *tabletmanagerd
 		insertChannels, ctx.Done(), dbNames, scw.writeQueryMaxRows, scw.writeQueryMaxSize, statsCounters)
 	if err != nil {
 		processError("%v: NewRowDiffer2 failed: %v", errPrefix, err)
-		return
+		return false
 	}
 	// Ignore the diff report because all diffs should get reconciled.
 	_ /* DiffReport */, err = differ.Diff()
 	if err != nil {
 		processError("%v: RowDiffer2 failed: %v", errPrefix, err)
-		return
+		return false
 	}
+	return true
 }
 
 type workUnit struct {
@@ -1088,7 +1153,7 @@ func (scw *SplitCloneWorker) startCloningData(ctx context.Context, state StatusW
 	processError func(string, ...interface{}), firstSourceTablet *topodatapb.Tablet, tableStatusList *tableStatusList,
 	start time.Time, statsCounters []*stats.CountersWithSingleLabel, insertChannels []chan string, wg *sync.WaitGroup) error {
 
-	workPipeline := make(chan workUnit, 10) // We'll use a small buffer so producers do not run too far ahead of consumers
+	workQueue := newWorkQueue(10) // We'll use a small buffer so producers do not run too far ahead of consumers
 	queryService, err := tabletconn.GetDialer()(firstSourceTablet, true)
 	if err != nil {
 		return vterrors.Wrap(err, "failed to create queryService")
@@ -1107,11 +1172,23 @@ func (scw *SplitCloneWorker) startCloningData(ctx context.Context, state StatusW
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			for work := range workPipeline {
-				scw.cloneAChunk(ctx, work.td, work.threadID, work.chunk, processError, state, tableStatusList, work.resolver, start, insertChannels, txID, statsCounters)
+			for {
+				work, ok := workQueue.get()
+				if !ok {
+					return
+				}
+				if !scw.splitChunk(ctx, state, workQueue, work, firstSourceTablet, tableStatusList, processError) {
+					if scw.cloneAChunk(ctx, work.td, work.threadID, work.chunk, processError, state, tableStatusList, work.resolver, start, insertChannels, txID, statsCounters) {
+						scw.checkpoint.chunkProcessed(checkpointPhase(state), work.td.Name, work.chunk)
+					}
+				}
+				workQueue.done()
 			}
 		}()
 	}
+	// The consumers stop when the queue is closed, even if an error
+	// is returned.
+	defer workQueue.close()
 
 	// And now let's start producing work units
 	for tableIndex, td := range sourceSchemaDefinition.TableDefinitions {
@@ -1122,24 +1199,79 @@ func (scw *SplitCloneWorker) startCloningData(ctx context.Context, state StatusW
 			return vterrors.Wrapf(err, "cannot resolve sharding keys for keyspace %v", scw.destinationKeyspace)
 		}
 
+		// A resumed job reuses the chunks of the previous run, and skips
+		// the ones which were copied.
+		chunks, done := scw.checkpoint.tableChunks(checkpointPhase(state), td.Name)
+		if chunks != nil {
+			tableStatusList.setThreadCount(tableIndex, len(chunks))
+			for i, c := range chunks {
+				if done[i] {
+					tableStatusList.threadStarted(tableIndex)
+					tableStatusList.threadDone(tableIndex)
+					continue
+				}
+				workQueue.add(workUnit{td: td, chunk: c, threadID: tableIndex, resolver: keyResolver})
+			}
+			continue
+		}
+
 		// TODO(mberlin): We're going to chunk *all* source shards based on the MIN
-		// and MAX values of the *first* source shard. Is this going to be a problem?
-		chunks, err := generateChunks(ctx, scw.wr, firstSourceTablet, td, scw.chunkCount, scw.minRowsPerChunk)
+		// and MAX values (or the sample) of the *first* source shard. Is this going
+		// to be a problem?
+		if scw.chunkAlgorithm != "" {
+			algorithm := querypb.SplitQueryRequest_Algorithm(querypb.SplitQueryRequest_Algorithm_value[scw.chunkAlgorithm])
+			chunks, err = generateSplitQueryChunks(ctx, scw.wr, queryService, firstSourceTablet, td, scw.chunkCount, scw.minRowsPerChunk, algorithm)
+		} else {
+			chunks, err = generateChunks(ctx, scw.wr, firstSourceTablet, td, scw.chunkCount, scw.minRowsPerChunk, scw.chunkSampleSize)
+		}
 		if err != nil {
 			return vterrors.Wrap(err, "failed to split table into chunks")
 		}
+		scw.checkpoint.setTableChunks(checkpointPhase(state), td.Name, chunks)
 		tableStatusList.setThreadCount(tableIndex, len(chunks))
 
 		for _, c := range chunks {
-			workPipeline <- workUnit{td: td, chunk: c, threadID: tableIndex, resolver: keyResolver}
+			workQueue.add(workUnit{td: td, chunk: c, threadID: tableIndex, resolver: keyResolver})
 		}
 	}
 
-	close(workPipeline)
-
 	return nil
 }
 
+// splitChunk splits a chunk which has more than maxRowsPerChunk rows in
+// the first source tablet: the first maxRowsPerChunk rows are a new
+// chunk, and the other rows are another one, which can be split again.
+// The new chunks are added to the start of the queue, so that all the
+// threads stay busy with chunks of a bounded size. It returns true if
+// the chunk was split, or if the split failed.
+func (scw *SplitCloneWorker) splitChunk(ctx context.Context, state StatusWorkerState, workQueue *workQueue, work workUnit, firstSourceTablet *topodatapb.Tablet, tableStatusList *tableStatusList, processError func(string, ...interface{})) bool {
+	if scw.maxRowsPerChunk == 0 || len(work.td.PrimaryKeyColumns) == 0 {
+		return false
+	}
+	if err := checkDone(ctx); err != nil {
+		// cloneAChunk reports the error.
+		return false
+	}
+	splitPoint, err := chunkSplitPoint(ctx, scw.wr, firstSourceTablet, work.td, work.chunk, scw.maxRowsPerChunk)
+	if err != nil {
+		processError("table=%v chunk=%v: %v", work.td.Name, work.chunk, err)
+		return true
+	}
+	if splitPoint.IsNull() {
+		return false
+	}
+	scw.wr.Logger().Infof("table=%v chunk=%v: Splitting the chunk at %v because it has more than %d rows.", work.td.Name, work.chunk, splitPoint, scw.maxRowsPerChunk)
+	tableStatusList.addThreadCount(work.threadID, 1)
+	scw.checkpoint.splitChunk(checkpointPhase(state), work.td.Name, work.chunk, splitPoint)
+	rest := work
+	rest.chunk.start = splitPoint
+	first := work
+	first.chunk.end = splitPoint
+	workQueue.push(rest)
+	workQueue.push(first)
+	return true
+}
+
 // copy phase:
 //	- copy the data from source tablets to destination masters (with replication on)
 // Assumes that the schema has already been created on each destination tablet
@@ -1203,6 +1335,7 @@ func (scw *SplitCloneWorker) clone(ctx context.Context, state StatusWorkerState)
 
 	// In parallel, setup the channels to send SQL data chunks to for each destination tablet:
 	insertChannels := make([]chan string, len(scw.destinationShards))
+	flusher := newInsertFlusher(insertChannels, scw.destinationWriterCount)
 	destinationWaitGroup := sync.WaitGroup{}
 	for shardIndex, si := range scw.destinationShards {
 		// We create one channel per destination tablet. It is sized to have a
@@ -1213,20 +1346,40 @@ func (scw *SplitCloneWorker) clone(ctx context.Context, state StatusWorkerState)
 
 		for j := 0; j < scw.destinationWriterCount; j++ {
 			destinationWaitGroup.Add(1)
-			go scw.startExecutor(ctx, &destinationWaitGroup, si.Keyspace(), si.ShardName(), insertChannels[shardIndex], j, processError)
+			go scw.startExecutor(ctx, &destinationWaitGroup, si.Keyspace(), si.ShardName(), insertChannels[shardIndex], j, flusher, processError)
 		}
 	}
 
+	// Save the progress periodically, while the chunks are copied.
+	checkpointsDone := make(chan struct{})
+	checkpointsWaitGroup := sync.WaitGroup{}
+	if scw.checkpoint != nil {
+		checkpointsWaitGroup.Add(1)
+		go func() {
+			defer checkpointsWaitGroup.Done()
+			scw.saveCheckpoints(ctx, flusher, checkpointsDone)
+		}()
+	}
+
 	// Now for each table, read data chunks and send them to all
 	// insertChannels
 	readers := sync.WaitGroup{}
 
 	err = scw.startCloningData(ctx, state, sourceSchemaDefinition, processError, firstSourceTablet, tableStatusList, start, statsCounters, insertChannels, &readers)
 	if err != nil {
-		return vterrors.Wrap(err, "failed to startCloningData")
+		processError("failed to startCloningData: %v", err)
 	}
 	readers.Wait()
 
+	// The flushes must be over before the channels are closed.
+	close(checkpointsDone)
+	checkpointsWaitGroup.Wait()
+	if firstError == nil {
+		if err := scw.checkpoint.commit(ctx, flusher.flush); err != nil {
+			scw.wr.Logger().Warningf("%v", err)
+		}
+	}
+
 	for shardIndex := range scw.destinationShards {
 		close(insertChannels[shardIndex])
 	}
@@ -1235,24 +1388,60 @@ func (scw *SplitCloneWorker) clone(ctx context.Context, state StatusWorkerState)
 	return firstError
 }
 
-func (scw *SplitCloneWorker) setUpVReplication(ctx context.Context) error {
-	wg := sync.WaitGroup{}
+// saveCheckpoints commits the checkpoint every checkpoint_interval,
+// until done is closed.
+func (scw *SplitCloneWorker) saveCheckpoints(ctx context.Context, flusher *insertFlusher, done chan struct{}) {
+	ticker := time.NewTicker(*checkpointInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := scw.checkpoint.commit(ctx, flusher.flush); err != nil {
+				scw.wr.Logger().Warningf("%v", err)
+			}
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
 
-	// get the current position from the sources
-	sourcePositions := make([]string, len(scw.sourceShards))
+// checkpointPhase returns the name of a clone phase in the checkpoint.
+func checkpointPhase(state StatusWorkerState) string {
+	if state == WorkerStateCloneOnline {
+		return checkpointPhaseOnline
+	}
+	return checkpointPhaseOffline
+}
 
+// getSourcePositions returns the replication positions of the source
+// tablets of the offline clone, in the order of sourceShards.
+func (scw *SplitCloneWorker) getSourcePositions(ctx context.Context) ([]string, error) {
+	sourcePositions := make([]string, len(scw.sourceShards))
 	if scw.useConsistentSnapshot {
 		sourcePositions[0] = scw.lastPos
-	} else {
-		for shardIndex := range scw.sourceShards {
-			shortCtx, cancel := context.WithTimeout(ctx, *remoteActionsTimeout)
-			status, err := scw.wr.TabletManagerClient().SlaveStatus(shortCtx, scw.sourceTablets[shardIndex])
-			cancel()
-			if err != nil {
-				return err
-			}
-			sourcePositions[shardIndex] = status.Position
+		return sourcePositions, nil
+	}
+	for shardIndex := range scw.sourceShards {
+		shortCtx, cancel := context.WithTimeout(ctx, *remoteActionsTimeout)
+		status, err := scw.wr.TabletManagerClient().SlaveStatus(shortCtx, scw.sourceTablets[shardIndex])
+		cancel()
+		if err != nil {
+			return nil, err
 		}
+		sourcePositions[shardIndex] = status.Position
+	}
+	return sourcePositions, nil
+}
+
+func (scw *SplitCloneWorker) setUpVReplication(ctx context.Context) error {
+	wg := sync.WaitGroup{}
+
+	// get the current position from the sources
+	sourcePositions, err := scw.getSourcePositions(ctx)
+	if err != nil {
+		return err
 	}
 	cancelableCtx, cancel := context.WithCancel(ctx)
 	rec := concurrency.AllErrorRecorder{}
@@ -76,6 +76,17 @@ const splitCloneHTML2 = `
         <INPUT type="text" id="chunkCount" name="chunkCount" value="{{.DefaultChunkCount}}"></BR>
       <LABEL for="minRowsPerChunk">Minimum Number of Rows per Chunk (may reduce the Chunk Count): </LABEL>
         <INPUT type="text" id="minRowsPerChunk" name="minRowsPerChunk" value="{{.DefaultMinRowsPerChunk}}"></BR>
+      <LABEL for="chunkSampleSize">Chunk Sample Size (if non-zero, chunks are balanced by row count using a random sample of the primary key): </LABEL>
+        <INPUT type="text" id="chunkSampleSize" name="chunkSampleSize" value="{{.DefaultChunkSampleSize}}"></BR>
+      <LABEL for="chunkAlgorithm">Chunk Algorithm (if set, the chunks are computed by the SplitQuery algorithm of the first source tablet): </LABEL>
+			<SELECT id="chunkAlgorithm" name="chunkAlgorithm">
+  			<OPTION selected value="">none</OPTION>
+  			<OPTION value="EQUAL_SPLITS">EQUAL_SPLITS</OPTION>
+  			<OPTION value="FULL_SCAN">FULL_SCAN</OPTION>
+			</SELECT>
+			</BR>
+      <LABEL for="maxRowsPerChunk">Maximum Number of Rows per Chunk (if non-zero, larger chunks are split while they're cloned): </LABEL>
+        <INPUT type="text" id="maxRowsPerChunk" name="maxRowsPerChunk" value="{{.DefaultMaxRowsPerChunk}}"></BR>
       <LABEL for="sourceReaderCount">Source Reader Count: </LABEL>
         <INPUT type="text" id="sourceReaderCount" name="sourceReaderCount" value="{{.DefaultSourceReaderCount}}"></BR>
       <LABEL for="writeQueryMaxRows">Maximum Number of Rows per Write Query: </LABEL>
@@ -100,6 +111,8 @@ const splitCloneHTML2 = `
       <INPUT type="hidden" name="shard" value="{{.Shard}}"/>
       <LABEL for="useConsistentSnapshot">Use consistent snapshot during the offline cloning:</LABEL>
         <INPUT type="checkbox" id="useConsistentSnapshot" name="useConsistentSnapshot" value="true"><a href="https://dev.mysql.com/doc/refman/5.7/en/glossary.html#glos_consistent_read" target="_blank">?</a></BR>
+      <LABEL for="resume">Resume from the checkpoint of a previous run:</LABEL>
+        <INPUT type="checkbox" id="resume" name="resume" value="true"></BR>
       <INPUT type="submit" value="Clone"/>
     </form>
   </body>
@@ -114,6 +127,9 @@ func commandSplitClone(wi *Instance, wr *wrangler.Wrangler, subFlags *flag.FlagS
 	excludeTables := subFlags.String("exclude_tables", "", "comma separated list of tables to exclude. Each is either an exact match, or a regular expression of the form /regexp/")
 	chunkCount := subFlags.Int("chunk_count", defaultChunkCount, "number of chunks per table")
 	minRowsPerChunk := subFlags.Int("min_rows_per_chunk", defaultMinRowsPerChunk, "minimum number of rows per chunk (may reduce --chunk_count)")
+	chunkSampleSize := subFlags.Int("chunk_sample_size", defaultChunkSampleSize, "if set, the chunk boundaries are the quantiles of a random sample of this many values of the first primary key column, so that all chunks have about the same number of rows. Otherwise, the range of the first primary key column is split into intervals of the same size.")
+	chunkAlgorithm := subFlags.String("chunk_algorithm", defaultChunkAlgorithm, "if set, the chunks are computed by this SplitQuery algorithm of the first source tablet, which must be a RDONLY tablet: EQUAL_SPLITS splits the range of the first primary key column into intervals of the same size, FULL_SCAN scans the column to make chunks with the same number of rows")
+	maxRowsPerChunk := subFlags.Int("max_rows_per_chunk", defaultMaxRowsPerChunk, "if set, a chunk which has more rows than this in the first source tablet is split when it's cloned, so that all the threads stay busy and the chunks have a bounded size")
 	sourceReaderCount := subFlags.Int("source_reader_count", defaultSourceReaderCount, "number of concurrent streaming queries to use on the source")
 	writeQueryMaxRows := subFlags.Int("write_query_max_rows", defaultWriteQueryMaxRows, "maximum number of rows per write query")
 	writeQueryMaxSize := subFlags.Int("write_query_max_size", defaultWriteQueryMaxSize, "maximum size (in bytes) per write query")
@@ -121,6 +137,7 @@ func commandSplitClone(wi *Instance, wr *wrangler.Wrangler, subFlags *flag.FlagS
 	tabletTypeStr := subFlags.String("tablet_type", "RDONLY", "tablet type to use (RDONLY or REPLICA)")
 	minHealthyTablets := subFlags.Int("min_healthy_rdonly_tablets", defaultMinHealthyTablets, "minimum number of healthy tablets in the source and destination shard at start")
 	useConsistentSnapshot := subFlags.Bool("use_consistent_snapshot", defaultUseConsistentSnapshot, "Instead of pausing replication on the source, uses transactions with consistent snapshot to have a stable view of the data.")
+	resume := subFlags.Bool("resume", false, "continue from the checkpoint saved in the topology by a previous run, instead of starting over. The chunks of the offline clone are only skipped if the source tablets are at the same positions as before.")
 	maxTPS := subFlags.Int64("max_tps", defaultMaxTPS, "rate limit of maximum number of (write) transactions/second on the destination (unlimited by default)")
 	maxReplicationLag := subFlags.Int64("max_replication_lag", defaultMaxReplicationLag, "if set, the adapative throttler will be enabled and automatically adjust the write rate to keep the lag below the set value in seconds (disabled by default)")
 	if err := subFlags.Parse(args); err != nil {
@@ -143,7 +160,7 @@ func commandSplitClone(wi *Instance, wr *wrangler.Wrangler, subFlags *flag.FlagS
 	if !ok {
 		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "command SplitClone invalid tablet_type: %v", tabletType)
 	}
-	worker, err := newSplitCloneWorker(wr, wi.cell, keyspace, shard, *online, *offline, excludeTableArray, *chunkCount, *minRowsPerChunk, *sourceReaderCount, *writeQueryMaxRows, *writeQueryMaxSize, *destinationWriterCount, *minHealthyTablets, topodata.TabletType(tabletType), *maxTPS, *maxReplicationLag, *useConsistentSnapshot)
+	worker, err := newSplitCloneWorker(wr, wi.cell, keyspace, shard, *online, *offline, excludeTableArray, *chunkCount, *minRowsPerChunk, *chunkSampleSize, *chunkAlgorithm, *maxRowsPerChunk, *sourceReaderCount, *writeQueryMaxRows, *writeQueryMaxSize, *destinationWriterCount, *minHealthyTablets, topodata.TabletType(tabletType), *maxTPS, *maxReplicationLag, *useConsistentSnapshot, *resume)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "cannot create split clone worker")
 	}
@@ -224,6 +241,8 @@ func interactiveSplitClone(ctx context.Context, wi *Instance, wr *wrangler.Wrang
 		result["DefaultOffline"] = defaultOffline
 		result["DefaultChunkCount"] = fmt.Sprintf("%v", defaultChunkCount)
 		result["DefaultMinRowsPerChunk"] = fmt.Sprintf("%v", defaultMinRowsPerChunk)
+		result["DefaultChunkSampleSize"] = fmt.Sprintf("%v", defaultChunkSampleSize)
+		result["DefaultMaxRowsPerChunk"] = fmt.Sprintf("%v", defaultMaxRowsPerChunk)
 		result["DefaultSourceReaderCount"] = fmt.Sprintf("%v", defaultSourceReaderCount)
 		result["DefaultWriteQueryMaxRows"] = fmt.Sprintf("%v", defaultWriteQueryMaxRows)
 		result["DefaultWriteQueryMaxSize"] = fmt.Sprintf("%v", defaultWriteQueryMaxSize)
@@ -255,6 +274,17 @@ func interactiveSplitClone(ctx context.Context, wi *Instance, wr *wrangler.Wrang
 	if err != nil {
 		return nil, nil, nil, vterrors.Wrap(err, "cannot parse minRowsPerChunk")
 	}
+	chunkSampleSizeStr := r.FormValue("chunkSampleSize")
+	chunkSampleSize, err := strconv.ParseInt(chunkSampleSizeStr, 0, 64)
+	if err != nil {
+		return nil, nil, nil, vterrors.Wrap(err, "cannot parse chunkSampleSize")
+	}
+	chunkAlgorithm := r.FormValue("chunkAlgorithm")
+	maxRowsPerChunkStr := r.FormValue("maxRowsPerChunk")
+	maxRowsPerChunk, err := strconv.ParseInt(maxRowsPerChunkStr, 0, 64)
+	if err != nil {
+		return nil, nil, nil, vterrors.Wrap(err, "cannot parse maxRowsPerChunk")
+	}
 	sourceReaderCount, err := strconv.ParseInt(sourceReaderCountStr, 0, 64)
 	if err != nil {
 		return nil, nil, nil, vterrors.Wrap(err, "cannot parse sourceReaderCount")
@@ -297,9 +327,10 @@ func interactiveSplitClone(ctx context.Context, wi *Instance, wr *wrangler.Wrang
 
 	useConsistentSnapshotStr := r.FormValue("useConsistentSnapshot")
 	useConsistentSnapshot := useConsistentSnapshotStr == "true"
+	resume := r.FormValue("resume") == "true"
 
 	// start the clone job
-	wrk, err := newSplitCloneWorker(wr, wi.cell, keyspace, shard, online, offline, excludeTableArray, int(chunkCount), int(minRowsPerChunk), int(sourceReaderCount), int(writeQueryMaxRows), int(writeQueryMaxSize), int(destinationWriterCount), int(minHealthyTablets), topodata.TabletType(tabletType), maxTPS, maxReplicationLag, useConsistentSnapshot)
+	wrk, err := newSplitCloneWorker(wr, wi.cell, keyspace, shard, online, offline, excludeTableArray, int(chunkCount), int(minRowsPerChunk), int(chunkSampleSize), chunkAlgorithm, int(maxRowsPerChunk), int(sourceReaderCount), int(writeQueryMaxRows), int(writeQueryMaxSize), int(destinationWriterCount), int(minHealthyTablets), topodata.TabletType(tabletType), maxTPS, maxReplicationLag, useConsistentSnapshot, resume)
 	if err != nil {
 		return nil, nil, nil, vterrors.Wrap(err, "cannot create worker")
 	}
@@ -55,9 +55,11 @@ type SplitDiffWorker struct {
 	minHealthyRdonlyTablets int
 	destinationTabletType   topodatapb.TabletType
 	parallelDiffsCount      int
+	resume                  bool // skip the tables which checked out in a previous run
 	cleaner                 *wrangler.Cleaner
 
 	// populated during WorkerStateInit, read-only after that
+	checkpoint   *checkpointer
 	keyspaceInfo *topo.KeyspaceInfo
 	shardInfo    *topo.ShardInfo
 
@@ -71,7 +73,7 @@ type SplitDiffWorker struct {
 }
 
 // NewSplitDiffWorker returns a new SplitDiffWorker object.
-func NewSplitDiffWorker(wr *wrangler.Wrangler, cell, keyspace, shard string, sourceUID uint32, excludeTables []string, minHealthyRdonlyTablets, parallelDiffsCount int, tabletType topodatapb.TabletType) Worker {
+func NewSplitDiffWorker(wr *wrangler.Wrangler, cell, keyspace, shard string, sourceUID uint32, excludeTables []string, minHealthyRdonlyTablets, parallelDiffsCount int, tabletType topodatapb.TabletType, resume bool) Worker {
 	return &SplitDiffWorker{
 		StatusWorker:            NewStatusWorker(),
 		wr:                      wr,
@@ -83,6 +85,7 @@ func NewSplitDiffWorker(wr *wrangler.Wrangler, cell, keyspace, shard string, sou
 		minHealthyRdonlyTablets: minHealthyRdonlyTablets,
 		destinationTabletType:   tabletType,
 		parallelDiffsCount:      parallelDiffsCount,
+		resume:                  resume,
 		cleaner:                 &wrangler.Cleaner{},
 	}
 }
@@ -141,6 +144,10 @@ func (sdw *SplitDiffWorker) Run(ctx context.Context) error {
 		sdw.SetState(WorkerStateError)
 		return err
 	}
+	// The diff won't be resumed.
+	if err := sdw.checkpoint.delete(ctx); err != nil {
+		sdw.wr.Logger().Warningf("%v", err)
+	}
 	sdw.SetState(WorkerStateDone)
 	return nil
 }
@@ -153,6 +160,11 @@ func (sdw *SplitDiffWorker) run(ctx context.Context) error {
 	if err := checkDone(ctx); err != nil {
 		return err
 	}
+	checkpoint, err := newCheckpointer(ctx, sdw.wr, "SplitDiff", sdw.keyspace, sdw.shard, sdw.resume)
+	if err != nil {
+		return err
+	}
+	sdw.checkpoint = checkpoint
 
 	// second state: find targets
 	if err := sdw.findTargets(ctx); err != nil {
@@ -467,7 +479,14 @@ func (sdw *SplitDiffWorker) diff(ctx context.Context) error {
 	// run the diffs, 8 at a time
 	sdw.wr.Logger().Infof("Running the diffs...")
 	sem := sync2.NewSemaphore(sdw.parallelDiffsCount, 0)
-	tableDefinitions := sdw.destinationSchemaDefinition.TableDefinitions
+	var tableDefinitions []*tabletmanagerdatapb.TableDefinition
+	for _, tableDefinition := range sdw.destinationSchemaDefinition.TableDefinitions {
+		if sdw.checkpoint.isTableDone(checkpointPhaseDiff, tableDefinition.Name) {
+			sdw.wr.Logger().Infof("Table %v skipped because it checked out in a previous run", tableDefinition.Name)
+			continue
+		}
+		tableDefinitions = append(tableDefinitions, tableDefinition)
+	}
 
 	// sort tables by size
 	// if there are large deltas between table sizes then it's more efficient to start working on the large tables first
@@ -547,6 +566,10 @@ func (sdw *SplitDiffWorker) diff(ctx context.Context) error {
 					sdw.wr.Logger().Warningf(err.Error())
 				} else {
 					sdw.wr.Logger().Infof("Table %v checks out (%v rows processed, %v qps)", tableDefinition.Name, report.processedRows, report.processingQPS)
+					sdw.checkpoint.tableDone(checkpointPhaseDiff, tableDefinition.Name)
+					if err := sdw.checkpoint.save(ctx); err != nil {
+						sdw.wr.Logger().Warningf("%v", err)
+					}
 				}
 			}
 		}()
@@ -76,6 +76,15 @@ func (t *tableStatusList) setThreadCount(tableIndex, threadCount int) {
 	t.tableStatuses[tableIndex].setThreadCount(threadCount)
 }
 
+// addThreadCount is called when a chunk of the table is split.
+func (t *tableStatusList) addThreadCount(tableIndex, threadCount int) {
+	if !t.isInitialized() {
+		panic("addThreadCount() requires an initialized tableStatusList")
+	}
+
+	t.tableStatuses[tableIndex].addThreadCount(threadCount)
+}
+
 func (t *tableStatusList) threadStarted(tableIndex int) {
 	if !t.isInitialized() {
 		panic("threadStarted() requires an initialized tableStatusList")
@@ -170,6 +179,12 @@ func (ts *tableStatus) setThreadCount(threadCount int) {
 	ts.mu.Unlock()
 }
 
+func (ts *tableStatus) addThreadCount(threadCount int) {
+	ts.mu.Lock()
+	ts.threadCount += threadCount
+	ts.mu.Unlock()
+}
+
 func (ts *tableStatus) threadStarted() {
 	ts.mu.Lock()
 	ts.threadsStarted++
@@ -96,6 +96,8 @@ const verticalSplitCloneHTML2 = `
       <INPUT type="hidden" name="keyspace" value="{{.Keyspace}}"/>
       <LABEL for="useConsistentSnapshot">Use consistent snapshot during the offline cloning:</LABEL>
         <INPUT type="checkbox" id="useConsistentSnapshot" name="useConsistentSnapshot" value="true"><a href="https://dev.mysql.com/doc/refman/5.7/en/glossary.html#glos_consistent_read" target="_blank">?</a></BR>
+      <LABEL for="resume">Resume from the checkpoint of a previous run:</LABEL>
+        <INPUT type="checkbox" id="resume" name="resume" value="true"></BR>
       <INPUT type="submit" value="Clone"/>
     </form>
   </body>
@@ -116,6 +118,7 @@ func commandVerticalSplitClone(wi *Instance, wr *wrangler.Wrangler, subFlags *fl
 	destinationWriterCount := subFlags.Int("destination_writer_count", defaultDestinationWriterCount, "number of concurrent RPCs to execute on the destination")
 	minHealthyTablets := subFlags.Int("min_healthy_tablets", defaultMinHealthyTablets, "minimum number of healthy tablets before taking out one")
 	useConsistentSnapshot := subFlags.Bool("use_consistent_snapshot", defaultUseConsistentSnapshot, "Instead of pausing replication on the source, uses transactions with consistent snapshot to have a stable view of the data.")
+	resume := subFlags.Bool("resume", false, "continue from the checkpoint saved in the topology by a previous run, instead of starting over. The chunks of the offline clone are only skipped if the source tablets are at the same positions as before.")
 	tabletTypeStr := subFlags.String("tablet_type", "RDONLY", "tablet type to use (RDONLY or REPLICA)")
 	maxTPS := subFlags.Int64("max_tps", defaultMaxTPS, "if non-zero, limit copy to maximum number of (write) transactions/second on the destination (unlimited by default)")
 	maxReplicationLag := subFlags.Int64("max_replication_lag", defaultMaxReplicationLag, "if set, the adapative throttler will be enabled and automatically adjust the write rate to keep the lag below the set value in seconds (disabled by default)")
@@ -142,7 +145,7 @@ func commandVerticalSplitClone(wi *Instance, wr *wrangler.Wrangler, subFlags *fl
 		return nil, fmt.Errorf("command SplitClone invalid tablet_type: %v", tabletType)
 	}
 
-	worker, err := newVerticalSplitCloneWorker(wr, wi.cell, keyspace, shard, *online, *offline, tableArray, *chunkCount, *minRowsPerChunk, *sourceReaderCount, *writeQueryMaxRows, *writeQueryMaxSize, *destinationWriterCount, *minHealthyTablets, topodata.TabletType(tabletType), *maxTPS, *maxReplicationLag, *useConsistentSnapshot)
+	worker, err := newVerticalSplitCloneWorker(wr, wi.cell, keyspace, shard, *online, *offline, tableArray, *chunkCount, *minRowsPerChunk, *sourceReaderCount, *writeQueryMaxRows, *writeQueryMaxSize, *destinationWriterCount, *minHealthyTablets, topodata.TabletType(tabletType), *maxTPS, *maxReplicationLag, *useConsistentSnapshot, *resume)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "cannot create worker")
 	}
@@ -304,12 +307,13 @@ func interactiveVerticalSplitClone(ctx context.Context, wi *Instance, wr *wrangl
 
 	useConsistentSnapshotStr := r.FormValue("useConsistentSnapshot")
 	useConsistentSnapshot := useConsistentSnapshotStr == "true"
+	resume := r.FormValue("resume") == "true"
 
 	// start the clone job
 	wrk, err := newVerticalSplitCloneWorker(wr, wi.cell, keyspace, shard, online, offline, tableArray, int(chunkCount),
 		int(minRowsPerChunk), int(sourceReaderCount), int(writeQueryMaxRows), int(writeQueryMaxSize),
 		int(destinationWriterCount), int(minHealthyTablets), topodata.TabletType(tabletType), maxTPS,
-		maxReplicationLag, useConsistentSnapshot)
+		maxReplicationLag, useConsistentSnapshot, resume)
 	if err != nil {
 		return nil, nil, nil, vterrors.Wrap(err, "cannot create worker")
 	}
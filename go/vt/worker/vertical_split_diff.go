@@ -49,9 +49,11 @@ type VerticalSplitDiffWorker struct {
 	shard                   string
 	minHealthyRdonlyTablets int
 	parallelDiffsCount      int
+	resume                  bool // skip the tables which checked out in a previous run
 	cleaner                 *wrangler.Cleaner
 
 	// populated during WorkerStateInit, read-only after that
+	checkpoint   *checkpointer
 	keyspaceInfo *topo.KeyspaceInfo
 	shardInfo    *topo.ShardInfo
 
@@ -66,7 +68,7 @@ type VerticalSplitDiffWorker struct {
 }
 
 // NewVerticalSplitDiffWorker returns a new VerticalSplitDiffWorker object.
-func NewVerticalSplitDiffWorker(wr *wrangler.Wrangler, cell, keyspace, shard string, minHealthyRdonlyTablets, parallelDiffsCount int, destintationTabletType topodatapb.TabletType) Worker {
+func NewVerticalSplitDiffWorker(wr *wrangler.Wrangler, cell, keyspace, shard string, minHealthyRdonlyTablets, parallelDiffsCount int, destintationTabletType topodatapb.TabletType, resume bool) Worker {
 	return &VerticalSplitDiffWorker{
 		StatusWorker:            NewStatusWorker(),
 		wr:                      wr,
@@ -76,6 +78,7 @@ func NewVerticalSplitDiffWorker(wr *wrangler.Wrangler, cell, keyspace, shard str
 		minHealthyRdonlyTablets: minHealthyRdonlyTablets,
 		destinationTabletType:   destintationTabletType,
 		parallelDiffsCount:      parallelDiffsCount,
+		resume:                  resume,
 		cleaner:                 &wrangler.Cleaner{},
 	}
 }
@@ -133,6 +136,10 @@ func (vsdw *VerticalSplitDiffWorker) Run(ctx context.Context) error {
 		vsdw.SetState(WorkerStateError)
 		return err
 	}
+	// The diff won't be resumed.
+	if err := vsdw.checkpoint.delete(ctx); err != nil {
+		vsdw.wr.Logger().Warningf("%v", err)
+	}
 	vsdw.SetState(WorkerStateDone)
 	return nil
 }
@@ -145,6 +152,11 @@ func (vsdw *VerticalSplitDiffWorker) run(ctx context.Context) error {
 	if err := checkDone(ctx); err != nil {
 		return err
 	}
+	checkpoint, err := newCheckpointer(ctx, vsdw.wr, "VerticalSplitDiff", vsdw.keyspace, vsdw.shard, vsdw.resume)
+	if err != nil {
+		return err
+	}
+	vsdw.checkpoint = checkpoint
 
 	// second state: find targets
 	if err := vsdw.findTargets(ctx); err != nil {
@@ -407,6 +419,10 @@ func (vsdw *VerticalSplitDiffWorker) diff(ctx context.Context) error {
 	vsdw.wr.Logger().Infof("Running the diffs...")
 	sem := sync2.NewSemaphore(vsdw.parallelDiffsCount, 0)
 	for _, tableDefinition := range vsdw.destinationSchemaDefinition.TableDefinitions {
+		if vsdw.checkpoint.isTableDone(checkpointPhaseDiff, tableDefinition.Name) {
+			vsdw.wr.Logger().Infof("Table %v skipped because it checked out in a previous run", tableDefinition.Name)
+			continue
+		}
 		wg.Add(1)
 		go func(tableDefinition *tabletmanagerdatapb.TableDefinition) {
 			defer wg.Done()
@@ -450,6 +466,10 @@ func (vsdw *VerticalSplitDiffWorker) diff(ctx context.Context) error {
 					vsdw.wr.Logger().Error(err)
 				} else {
 					vsdw.wr.Logger().Infof("Table %v checks out (%v rows processed, %v qps)", tableDefinition.Name, report.processedRows, report.processingQPS)
+					vsdw.checkpoint.tableDone(checkpointPhaseDiff, tableDefinition.Name)
+					if err := vsdw.checkpoint.save(ctx); err != nil {
+						vsdw.wr.Logger().Warningf("%v", err)
+					}
 				}
 			}
 		}(tableDefinition)
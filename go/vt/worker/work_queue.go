@@ -0,0 +1,101 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import "sync"
+
+// workQueue is the queue of the chunks to clone. Unlike a channel, the
+// consumers can add work units: the parts of the chunks they split.
+type workQueue struct {
+	// maxPending is the number of pending units after which the
+	// producer waits, so that it doesn't run too far ahead of the
+	// consumers.
+	maxPending int
+
+	// mu guards all fields in the group below.
+	mu   sync.Mutex
+	cond *sync.Cond
+	// units are the pending work units.
+	units []workUnit
+	// closed is true when the producer added all its units.
+	closed bool
+	// running is the number of units returned by get() which are
+	// not done.
+	running int
+}
+
+func newWorkQueue(maxPending int) *workQueue {
+	q := &workQueue{maxPending: maxPending}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+// add is called by the producer to add a unit at the end of the queue.
+// It waits while the queue has maxPending units.
+func (q *workQueue) add(unit workUnit) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for len(q.units) >= q.maxPending {
+		q.cond.Wait()
+	}
+	q.units = append(q.units, unit)
+	q.cond.Broadcast()
+}
+
+// push is called by a consumer, while it processes a unit, to add a unit
+// at the start of the queue. It doesn't wait.
+func (q *workQueue) push(unit workUnit) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.units = append([]workUnit{unit}, q.units...)
+	q.cond.Broadcast()
+}
+
+// close is called by the producer after it added all its units.
+func (q *workQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.cond.Broadcast()
+}
+
+// get returns the next unit, and waits for one if there is none. It
+// returns false when all the units are done, and no unit can be added
+// anymore. done() must be called after the returned unit is processed.
+func (q *workQueue) get() (workUnit, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for len(q.units) == 0 && !(q.closed && q.running == 0) {
+		q.cond.Wait()
+	}
+	if len(q.units) == 0 {
+		return workUnit{}, false
+	}
+	unit := q.units[0]
+	q.units = q.units[1:]
+	q.running++
+	q.cond.Broadcast()
+	return unit, true
+}
+
+// done is called after a unit returned by get() is processed.
+func (q *workQueue) done() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.running--
+	q.cond.Broadcast()
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestWorkQueue(t *testing.T) {
+	q := newWorkQueue(2)
+	go func() {
+		for i := 1; i <= 5; i++ {
+			q.add(workUnit{chunk: chunk{number: i, total: 5}})
+		}
+		q.close()
+	}()
+
+	// The consumers split the even chunks in two.
+	var mu sync.Mutex
+	processed := 0
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				unit, ok := q.get()
+				if !ok {
+					return
+				}
+				if unit.chunk.number%2 == 0 && unit.chunk.total == 5 {
+					for j := 0; j < 2; j++ {
+						q.push(workUnit{chunk: chunk{number: unit.chunk.number, total: 0}})
+					}
+				} else {
+					mu.Lock()
+					processed++
+					mu.Unlock()
+				}
+				q.done()
+			}
+		}()
+	}
+	wg.Wait()
+	// 3 odd chunks, and the 2 parts of the 2 even chunks.
+	if processed != 7 {
+		t.Errorf("processed units: %v, want 7", processed)
+	}
+}
@@ -0,0 +1,412 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archival
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// shardExecutor executes queries on the master tablet of a shard.
+type shardExecutor interface {
+	ExecuteFetch(ctx context.Context, keyspace, shard, query string, maxRows int) (*sqltypes.Result, error)
+}
+
+// tmExecutor implements shardExecutor with the tablet manager RPCs.
+type tmExecutor struct {
+	ts  *topo.Server
+	tmc tmclient.TabletManagerClient
+}
+
+// ExecuteFetch is part of the shardExecutor interface.
+func (e *tmExecutor) ExecuteFetch(ctx context.Context, keyspace, shard, query string, maxRows int) (*sqltypes.Result, error) {
+	si, err := e.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	if si.MasterAlias == nil {
+		return nil, fmt.Errorf("shard %v/%v has no master", keyspace, shard)
+	}
+	ti, err := e.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := e.tmc.ExecuteFetchAsApp(ctx, ti.Tablet, true, []byte(query), maxRows)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.Proto3ToResult(qr), nil
+}
+
+// router finds the shard of the archive keyspace each row belongs to.
+type router struct {
+	// shards are the shards of the archive keyspace.
+	shards []*topo.ShardInfo
+
+	// column and vindex are the primary vindex of the archive table.
+	// vindex is nil if the archive keyspace is unsharded.
+	column sqlparser.ColIdent
+	vindex vindexes.Vindex
+}
+
+// newRouter returns a router for the rows of table. The primary vindex
+// of the table must be functional, so that the rows can be routed
+// without a vtgate.
+func newRouter(ksvschema *vschemapb.Keyspace, keyspace, table string, shards []*topo.ShardInfo) (*router, error) {
+	if !ksvschema.Sharded {
+		if len(shards) != 1 {
+			return nil, fmt.Errorf("unsharded keyspace %v must have exactly one shard, found %v", keyspace, len(shards))
+		}
+		return &router{shards: shards}, nil
+	}
+	kschema, err := vindexes.BuildKeyspaceSchema(ksvschema, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	t, ok := kschema.Tables[table]
+	if !ok {
+		return nil, fmt.Errorf("table %v not found in the vschema of keyspace %v", table, keyspace)
+	}
+	if len(t.ColumnVindexes) == 0 {
+		return nil, fmt.Errorf("table %v has no primary vindex in keyspace %v", table, keyspace)
+	}
+	cv := t.ColumnVindexes[0]
+	if len(cv.Columns) != 1 || !cv.Vindex.IsUnique() || cv.Vindex.Cost() > 1 {
+		return nil, fmt.Errorf("the primary vindex %v of table %v must be a unique functional vindex on a single column", cv.Name, table)
+	}
+	return &router{
+		shards: shards,
+		column: cv.Columns[0],
+		vindex: cv.Vindex,
+	}, nil
+}
+
+// route returns the rows by shard name.
+func (r *router) route(fields []*querypb.Field, rows [][]sqltypes.Value) (map[string][][]sqltypes.Value, error) {
+	result := make(map[string][][]sqltypes.Value)
+	if r.vindex == nil {
+		result[r.shards[0].ShardName()] = rows
+		return result, nil
+	}
+	col := -1
+	for i, field := range fields {
+		if r.column.EqualString(field.Name) {
+			col = i
+			break
+		}
+	}
+	if col == -1 {
+		return nil, fmt.Errorf("primary vindex column %v not found in the rows", r.column)
+	}
+	ids := make([]sqltypes.Value, len(rows))
+	for i, row := range rows {
+		ids[i] = row[col]
+	}
+	destinations, err := r.vindex.Map(nil, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i, destination := range destinations {
+		ksid, ok := destination.(key.DestinationKeyspaceID)
+		if !ok {
+			return nil, fmt.Errorf("cannot map %v to a keyspace id: %v", ids[i], destination)
+		}
+		shard := ""
+		for _, si := range r.shards {
+			if key.KeyRangeContains(si.KeyRange, ksid) {
+				shard = si.ShardName()
+				break
+			}
+		}
+		if shard == "" {
+			return nil, fmt.Errorf("no shard contains keyspace id %v", ksid)
+		}
+		result[shard] = append(result[shard], rows[i])
+	}
+	return result, nil
+}
+
+// archiver moves the rows of a table that are older than a threshold
+// from the source keyspace to the archive keyspace, one batch at a
+// time. A batch is first inserted in the archive keyspace, then read
+// back and compared to the source rows, and finally deleted from the
+// source keyspace. Inserts ignore rows that already exist, so a batch
+// that failed half-way can be archived again.
+//
+// The column that defines the age of the rows is expected not to
+// change once a row is older than the threshold. Rows whose age
+// changed since they were copied are not deleted.
+type archiver struct {
+	exec            shardExecutor
+	router          *router
+	sourceKeyspace  string
+	archiveKeyspace string
+	table           sqlparser.TableIdent
+	column          sqlparser.ColIdent
+	threshold       time.Duration
+	batchSize       int
+
+	// pkColumns is the primary key of the table in the source
+	// keyspace. It's loaded by the first batch.
+	pkColumns []sqlparser.ColIdent
+}
+
+// archiveBatch archives up to batchSize rows of a shard of the source
+// keyspace. throttle is called before deleting the rows from the
+// source. It returns the number of rows archived.
+func (a *archiver) archiveBatch(ctx context.Context, shard string, throttle func(ctx context.Context) error) (int, error) {
+	if err := a.loadPrimaryKey(ctx, shard); err != nil {
+		return 0, err
+	}
+
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select * from %v where ", a.table)
+	a.writeOldCondition(buf)
+	buf.Myprintf(" order by ")
+	for i, pk := range a.pkColumns {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", pk)
+	}
+	fmt.Fprintf(buf, " limit %d", a.batchSize)
+	qr, err := a.exec.ExecuteFetch(ctx, a.sourceKeyspace, shard, buf.String(), a.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("cannot read rows to archive from %v/%v: %v", a.sourceKeyspace, shard, err)
+	}
+	if len(qr.Rows) == 0 {
+		return 0, nil
+	}
+	pkIndexes, err := a.pkIndexes(qr.Fields)
+	if err != nil {
+		return 0, err
+	}
+
+	byShard, err := a.router.route(qr.Fields, qr.Rows)
+	if err != nil {
+		return 0, err
+	}
+	archiveShards := make([]string, 0, len(byShard))
+	for archiveShard := range byShard {
+		archiveShards = append(archiveShards, archiveShard)
+	}
+	sort.Strings(archiveShards)
+	for _, archiveShard := range archiveShards {
+		if err := a.insert(ctx, archiveShard, qr.Fields, byShard[archiveShard]); err != nil {
+			return 0, err
+		}
+		if err := a.verify(ctx, archiveShard, qr.Fields, pkIndexes, byShard[archiveShard]); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := throttle(ctx); err != nil {
+		return 0, err
+	}
+	buf = sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("delete from %v where ", a.table)
+	a.writePKIn(buf, pkIndexes, qr.Rows)
+	buf.WriteString(" and ")
+	a.writeOldCondition(buf)
+	if _, err := a.exec.ExecuteFetch(ctx, a.sourceKeyspace, shard, buf.String(), 0); err != nil {
+		return 0, fmt.Errorf("cannot delete archived rows from %v/%v: %v", a.sourceKeyspace, shard, err)
+	}
+	return len(qr.Rows), nil
+}
+
+// loadPrimaryKey reads the primary key of the table from a source shard.
+func (a *archiver) loadPrimaryKey(ctx context.Context, shard string) error {
+	if a.pkColumns != nil {
+		return nil
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select column_name from information_schema.key_column_usage where table_schema = database() and table_name = %v and constraint_name = 'PRIMARY' order by ordinal_position", sqlparser.NewStrVal([]byte(a.table.String())))
+	qr, err := a.exec.ExecuteFetch(ctx, a.sourceKeyspace, shard, buf.String(), 100)
+	if err != nil {
+		return fmt.Errorf("cannot read primary key of table %v from %v/%v: %v", a.table, a.sourceKeyspace, shard, err)
+	}
+	if len(qr.Rows) == 0 {
+		return fmt.Errorf("table %v has no primary key in %v/%v", a.table, a.sourceKeyspace, shard)
+	}
+	for _, row := range qr.Rows {
+		a.pkColumns = append(a.pkColumns, sqlparser.NewColIdent(row[0].ToString()))
+	}
+	return nil
+}
+
+func (a *archiver) pkIndexes(fields []*querypb.Field) ([]int, error) {
+	indexes := make([]int, len(a.pkColumns))
+	for i, pk := range a.pkColumns {
+		indexes[i] = -1
+		for j, field := range fields {
+			if pk.EqualString(field.Name) {
+				indexes[i] = j
+				break
+			}
+		}
+		if indexes[i] == -1 {
+			return nil, fmt.Errorf("primary key column %v not found in the rows of table %v", pk, a.table)
+		}
+	}
+	return indexes, nil
+}
+
+// insert copies rows to a shard of the archive keyspace.
+func (a *archiver) insert(ctx context.Context, shard string, fields []*querypb.Field, rows [][]sqltypes.Value) error {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("insert ignore into %v(", a.table)
+	a.writeColumns(buf, fields)
+	buf.WriteString(") values ")
+	for i, row := range rows {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		writeTuple(buf, row)
+	}
+	if _, err := a.exec.ExecuteFetch(ctx, a.archiveKeyspace, shard, buf.String(), 0); err != nil {
+		return fmt.Errorf("cannot insert rows into %v/%v: %v", a.archiveKeyspace, shard, err)
+	}
+	return nil
+}
+
+// verify checks that the rows are identical in a shard of the archive
+// keyspace.
+func (a *archiver) verify(ctx context.Context, shard string, fields []*querypb.Field, pkIndexes []int, rows [][]sqltypes.Value) error {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.WriteString("select ")
+	a.writeColumns(buf, fields)
+	buf.Myprintf(" from %v where ", a.table)
+	a.writePKIn(buf, pkIndexes, rows)
+	qr, err := a.exec.ExecuteFetch(ctx, a.archiveKeyspace, shard, buf.String(), len(rows))
+	if err != nil {
+		return fmt.Errorf("cannot read archived rows from %v/%v: %v", a.archiveKeyspace, shard, err)
+	}
+	archived := make(map[string]string, len(qr.Rows))
+	for _, row := range qr.Rows {
+		archived[rowKey(row, pkIndexes)] = encodeRow(row)
+	}
+	for _, row := range rows {
+		got, ok := archived[rowKey(row, pkIndexes)]
+		if !ok {
+			return fmt.Errorf("row %v is missing in %v/%v after archiving", encodeRow(row), a.archiveKeyspace, shard)
+		}
+		if want := encodeRow(row); got != want {
+			return fmt.Errorf("row %v differs in %v/%v: %v", want, a.archiveKeyspace, shard, got)
+		}
+	}
+	return nil
+}
+
+// writeOldCondition writes the condition that selects the rows to archive.
+func (a *archiver) writeOldCondition(buf *sqlparser.TrackedBuffer) {
+	buf.Myprintf("%v < date_sub(now(), interval ", a.column)
+	fmt.Fprintf(buf, "%d second)", int64(a.threshold/time.Second))
+}
+
+func (a *archiver) writeColumns(buf *sqlparser.TrackedBuffer, fields []*querypb.Field) {
+	for i, field := range fields {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(field.Name))
+	}
+}
+
+// writePKIn writes the condition that selects rows by primary key.
+func (a *archiver) writePKIn(buf *sqlparser.TrackedBuffer, pkIndexes []int, rows [][]sqltypes.Value) {
+	if len(pkIndexes) == 1 {
+		buf.Myprintf("%v in (", a.pkColumns[0])
+		for i, row := range rows {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			row[pkIndexes[0]].EncodeSQL(buf)
+		}
+		buf.WriteString(")")
+		return
+	}
+	buf.WriteString("(")
+	for i, pk := range a.pkColumns {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("%v", pk)
+	}
+	buf.WriteString(") in (")
+	for i, row := range rows {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		pk := make([]sqltypes.Value, len(pkIndexes))
+		for j, index := range pkIndexes {
+			pk[j] = row[index]
+		}
+		writeTuple(buf, pk)
+	}
+	buf.WriteString(")")
+}
+
+func writeTuple(buf *sqlparser.TrackedBuffer, values []sqltypes.Value) {
+	buf.WriteString("(")
+	for i, value := range values {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		value.EncodeSQL(buf)
+	}
+	buf.WriteString(")")
+}
+
+func rowKey(row []sqltypes.Value, pkIndexes []int) string {
+	pk := make([]sqltypes.Value, len(pkIndexes))
+	for i, index := range pkIndexes {
+		pk[i] = row[index]
+	}
+	return encodeRow(pk)
+}
+
+// encodeRow returns the values of a row as a SQL tuple. Values are
+// compared as strings, so that rows can be compared even if the
+// column types are not exactly the same in both keyspaces.
+func encodeRow(row []sqltypes.Value) string {
+	values := make([]string, len(row))
+	for i, value := range row {
+		if value.IsNull() {
+			values[i] = "null"
+			continue
+		}
+		buf := &bytes.Buffer{}
+		sqltypes.NewVarBinary(value.ToString()).EncodeSQL(buf)
+		values[i] = buf.String()
+	}
+	return "(" + strings.Join(values, ", ") + ")"
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archival
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	workflowpb "vitess.io/vitess/go/vt/proto/workflow"
+)
+
+// fakeExecutor returns the results registered for each query, and
+// records the queries it executed.
+type fakeExecutor struct {
+	results  map[string]*sqltypes.Result
+	executed []string
+}
+
+func (fe *fakeExecutor) add(keyspace, shard, query string, result *sqltypes.Result) {
+	if fe.results == nil {
+		fe.results = make(map[string]*sqltypes.Result)
+	}
+	fe.results[keyspace+"/"+shard+": "+query] = result
+}
+
+// ExecuteFetch is part of the shardExecutor interface.
+func (fe *fakeExecutor) ExecuteFetch(ctx context.Context, keyspace, shard, query string, maxRows int) (*sqltypes.Result, error) {
+	key := keyspace + "/" + shard + ": " + query
+	fe.executed = append(fe.executed, key)
+	result, ok := fe.results[key]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query %v", key)
+	}
+	return result, nil
+}
+
+const (
+	pkQuery     = "select column_name from information_schema.key_column_usage where table_schema = database() and table_name = 't1' and constraint_name = 'PRIMARY' order by ordinal_position"
+	selectQuery = "select * from t1 where created < date_sub(now(), interval 172800 second) order by id limit 10"
+	insertQuery = "insert ignore into t1(id, created, name) values (1, '2019-01-01 00:00:00', 'a'), (2, '2019-01-02 00:00:00', 'b')"
+	verifyQuery = "select id, created, name from t1 where id in (1, 2)"
+	deleteQuery = "delete from t1 where id in (1, 2) and created < date_sub(now(), interval 172800 second)"
+)
+
+var rowFields = sqltypes.MakeTestFields("id|created|name", "int64|datetime|varchar")
+
+func newTestArchiver(fe *fakeExecutor) *archiver {
+	return &archiver{
+		exec: fe,
+		router: &router{
+			shards: []*topo.ShardInfo{topo.NewShardInfo("ks_archive", "0", &topodatapb.Shard{}, nil)},
+		},
+		sourceKeyspace:  "ks",
+		archiveKeyspace: "ks_archive",
+		table:           sqlparser.NewTableIdent("t1"),
+		column:          sqlparser.NewColIdent("created"),
+		threshold:       48 * time.Hour,
+		batchSize:       10,
+	}
+}
+
+func noThrottle(ctx context.Context) error {
+	return nil
+}
+
+func TestArchiveBatch(t *testing.T) {
+	fe := &fakeExecutor{}
+	fe.add("ks", "-80", pkQuery, sqltypes.MakeTestResult(sqltypes.MakeTestFields("column_name", "varchar"), "id"))
+	rows := sqltypes.MakeTestResult(rowFields, "1|2019-01-01 00:00:00|a", "2|2019-01-02 00:00:00|b")
+	fe.add("ks", "-80", selectQuery, rows)
+	fe.add("ks_archive", "0", insertQuery, &sqltypes.Result{})
+	fe.add("ks_archive", "0", verifyQuery, rows)
+	fe.add("ks", "-80", deleteQuery, &sqltypes.Result{})
+
+	a := newTestArchiver(fe)
+	n, err := a.archiveBatch(context.Background(), "-80", noThrottle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("archiveBatch: %v, want 2", n)
+	}
+	want := []string{
+		"ks/-80: " + pkQuery,
+		"ks/-80: " + selectQuery,
+		"ks_archive/0: " + insertQuery,
+		"ks_archive/0: " + verifyQuery,
+		"ks/-80: " + deleteQuery,
+	}
+	if !reflect.DeepEqual(fe.executed, want) {
+		t.Errorf("executed:\n%v, want\n%v", fe.executed, want)
+	}
+
+	// Nothing left to archive.
+	fe.add("ks", "-80", selectQuery, &sqltypes.Result{Fields: rowFields})
+	fe.executed = nil
+	n, err = a.archiveBatch(context.Background(), "-80", noThrottle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("archiveBatch: %v, want 0", n)
+	}
+	want = []string{"ks/-80: " + selectQuery}
+	if !reflect.DeepEqual(fe.executed, want) {
+		t.Errorf("executed:\n%v, want\n%v", fe.executed, want)
+	}
+}
+
+func TestArchiveBatchVerifyFailure(t *testing.T) {
+	fe := &fakeExecutor{}
+	fe.add("ks", "-80", pkQuery, sqltypes.MakeTestResult(sqltypes.MakeTestFields("column_name", "varchar"), "id"))
+	fe.add("ks", "-80", selectQuery, sqltypes.MakeTestResult(rowFields, "1|2019-01-01 00:00:00|a", "2|2019-01-02 00:00:00|b"))
+	fe.add("ks_archive", "0", insertQuery, &sqltypes.Result{})
+
+	testcases := []struct {
+		archived *sqltypes.Result
+		err      string
+	}{{
+		archived: sqltypes.MakeTestResult(rowFields, "1|2019-01-01 00:00:00|a", "2|2019-01-02 00:00:00|c"),
+		err:      "row ('2', '2019-01-02 00:00:00', 'b') differs in ks_archive/0: ('2', '2019-01-02 00:00:00', 'c')",
+	}, {
+		archived: sqltypes.MakeTestResult(rowFields, "1|2019-01-01 00:00:00|a"),
+		err:      "row ('2', '2019-01-02 00:00:00', 'b') is missing in ks_archive/0 after archiving",
+	}}
+	for _, tc := range testcases {
+		fe.add("ks_archive", "0", verifyQuery, tc.archived)
+		fe.executed = nil
+		a := newTestArchiver(fe)
+		_, err := a.archiveBatch(context.Background(), "-80", noThrottle)
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("archiveBatch: %v, want %v", err, tc.err)
+		}
+		for _, query := range fe.executed {
+			if query == "ks/-80: "+deleteQuery {
+				t.Errorf("rows were deleted from the source after a verification failure")
+			}
+		}
+	}
+}
+
+func TestRouter(t *testing.T) {
+	shards := []*topo.ShardInfo{
+		topo.NewShardInfo("ks_archive", "-80", &topodatapb.Shard{KeyRange: &topodatapb.KeyRange{End: []byte{0x80}}}, nil),
+		topo.NewShardInfo("ks_archive", "80-", &topodatapb.Shard{KeyRange: &topodatapb.KeyRange{Start: []byte{0x80}}}, nil),
+	}
+	ksvschema := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+			},
+		},
+	}
+	r, err := newRouter(ksvschema, "ks_archive", "t1", shards)
+	if err != nil {
+		t.Fatal(err)
+	}
+	qr := sqltypes.MakeTestResult(rowFields, "1|2019-01-01 00:00:00|a", "4|2019-01-02 00:00:00|b")
+	got, err := r.route(qr.Fields, qr.Rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][][]sqltypes.Value{
+		"-80": {qr.Rows[0]},
+		"80-": {qr.Rows[1]},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("route: %v, want %v", got, want)
+	}
+
+	if _, err := newRouter(ksvschema, "ks_archive", "t2", shards); err == nil {
+		t.Errorf("newRouter for a table missing from the vschema succeeded")
+	}
+	wantErr := "unsharded keyspace ks_archive must have exactly one shard, found 2"
+	if _, err := newRouter(&vschemapb.Keyspace{}, "ks_archive", "t1", shards); err == nil || err.Error() != wantErr {
+		t.Errorf("newRouter: %v, want %v", err, wantErr)
+	}
+}
+
+func TestFactoryInit(t *testing.T) {
+	f := &Factory{}
+	w := &workflowpb.Workflow{}
+	err := f.Init(nil, w, []string{"-source_keyspace=ks", "-archive_keyspace=ks", "-table=t1", "-column=created", "-older_than=48h"})
+	if err == nil {
+		t.Errorf("Init with the same source and archive keyspaces succeeded")
+	}
+
+	err = f.Init(nil, w, []string{"-source_keyspace=ks", "-archive_keyspace=ks_archive", "-table=t1", "-column=created", "-older_than=48h"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := &Data{}
+	if err := json.Unmarshal(w.Data, data); err != nil {
+		t.Fatal(err)
+	}
+	want := &Data{
+		SourceKeyspace:  "ks",
+		ArchiveKeyspace: "ks_archive",
+		Table:           "t1",
+		Column:          "created",
+		Threshold:       48 * time.Hour,
+		BatchSize:       1000,
+		Interval:        time.Minute,
+		Archived:        map[string]int64{},
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("Init data: %+v, want %+v", data, want)
+	}
+}
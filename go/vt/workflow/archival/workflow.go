@@ -0,0 +1,407 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package archival contains a workflow that continuously moves the
+// old rows of a table from a keyspace to an archive keyspace.
+//
+// The workflow is managed with the vtctl workflow commands:
+//
+//	WorkflowCreate archival -source_keyspace=ks -archive_keyspace=ks_archive -table=t -column=created_at -older_than=2160h
+//	WorkflowAction /<uuid> Pause
+//	WorkflowAction /<uuid> Resume
+//	WorkflowStop <uuid>
+//	WorkflowStart <uuid>
+//	WorkflowDelete <uuid>
+//
+// It runs until it's stopped.
+package archival
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/throttler"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/workflow"
+
+	workflowpb "vitess.io/vitess/go/vt/proto/workflow"
+)
+
+const (
+	archivalFactoryName = "archival"
+	pauseAction         = "Pause"
+	resumeAction        = "Resume"
+)
+
+// Register registers the archival workflow factory.
+func Register() {
+	workflow.Register(archivalFactoryName, &Factory{})
+}
+
+// Data is the data structure serialized as JSON in Workflow.Data.
+type Data struct {
+	SourceKeyspace  string
+	ArchiveKeyspace string
+	Table           string
+
+	// Column is the date or time column that defines the age of the rows.
+	Column string
+
+	// Threshold is the age after which rows are archived.
+	Threshold time.Duration
+
+	// BatchSize is the number of rows archived in each batch.
+	BatchSize int
+
+	// MaxTPS is the maximum number of batches deleted per second
+	// from each source shard. 0 means no limit.
+	MaxTPS int64
+
+	// Interval is how long the workflow waits after all the old rows
+	// have been archived before looking for new ones.
+	Interval time.Duration
+
+	// Paused is true if no rows should be archived.
+	Paused bool
+
+	// Archived is the number of rows archived, by source shard.
+	Archived map[string]int64
+}
+
+// Workflow implements the workflow.Workflow interface.
+type Workflow struct {
+	// mu protects the data access.
+	// We need it as both Run and Action can be called at the same time.
+	mu sync.Mutex
+
+	// data is the current state.
+	data *Data
+
+	// manager is the current Manager.
+	manager *workflow.Manager
+
+	// wi is the topo.WorkflowInfo.
+	wi *topo.WorkflowInfo
+
+	// node is the UI node.
+	node *workflow.Node
+
+	// logger is the logger we export UI logs from.
+	logger *logutil.MemoryLogger
+}
+
+// Run is part of the workflow.Workflow interface.
+func (w *Workflow) Run(ctx context.Context, manager *workflow.Manager, wi *topo.WorkflowInfo) error {
+	w.mu.Lock()
+	w.manager = manager
+	w.wi = wi
+	w.node.Listener = w
+	w.node.Display = workflow.NodeDisplayIndeterminate
+	w.node.Actions = []*workflow.Action{
+		{
+			Name:  pauseAction,
+			State: workflow.ActionStateEnabled,
+			Style: workflow.ActionStyleNormal,
+		},
+		{
+			Name:  resumeAction,
+			State: workflow.ActionStateDisabled,
+			Style: workflow.ActionStyleNormal,
+		},
+	}
+	w.uiUpdateLocked()
+	w.node.BroadcastChanges(false /* updateChildren */)
+	data := *w.data
+	w.mu.Unlock()
+
+	tmc := tmclient.NewTabletManagerClient()
+	defer tmc.Close()
+	exec := &tmExecutor{
+		ts:  manager.TopoServer(),
+		tmc: tmc,
+	}
+
+	maxTPS := data.MaxTPS
+	if maxTPS == 0 {
+		maxTPS = throttler.MaxRateModuleDisabled
+	}
+	t, err := throttler.NewThrottler(fmt.Sprintf("archival-%v", wi.Uuid), "transactions", 1, maxTPS, throttler.ReplicationLagModuleDisabled)
+	if err != nil {
+		return err
+	}
+	defer t.Close()
+	defer t.ThreadFinished(0)
+
+	var a *archiver
+	for {
+		if !w.isPaused() {
+			if a == nil {
+				a, err = newArchiver(ctx, manager.TopoServer(), exec, &data)
+			}
+			if a != nil {
+				err = w.archive(ctx, a, func(ctx context.Context) error {
+					return waitForThrottler(ctx, t)
+				})
+			}
+			if err != nil && ctx.Err() == nil {
+				log.Errorf("Archival workflow %v failed, retrying in %v: %v", wi.Uuid, data.Interval, err)
+				w.logger.Errorf("Archiving failed, retrying in %v: %v", data.Interval, err)
+				w.uiUpdate()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(data.Interval):
+		}
+	}
+}
+
+// newArchiver returns the archiver for the workflow, after validating
+// the archive keyspace.
+func newArchiver(ctx context.Context, ts *topo.Server, exec shardExecutor, data *Data) (*archiver, error) {
+	ksvschema, err := ts.GetVSchema(ctx, data.ArchiveKeyspace)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the vschema of keyspace %v: %v", data.ArchiveKeyspace, err)
+	}
+	shardMap, err := ts.FindAllShardsInKeyspace(ctx, data.ArchiveKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	shards := make([]*topo.ShardInfo, 0, len(shardMap))
+	for _, si := range shardMap {
+		shards = append(shards, si)
+	}
+	r, err := newRouter(ksvschema, data.ArchiveKeyspace, data.Table, shards)
+	if err != nil {
+		return nil, err
+	}
+	return &archiver{
+		exec:            exec,
+		router:          r,
+		sourceKeyspace:  data.SourceKeyspace,
+		archiveKeyspace: data.ArchiveKeyspace,
+		table:           sqlparser.NewTableIdent(data.Table),
+		column:          sqlparser.NewColIdent(data.Column),
+		threshold:       data.Threshold,
+		batchSize:       data.BatchSize,
+	}, nil
+}
+
+// archive archives all the old rows of the source shards, until the
+// workflow is paused.
+func (w *Workflow) archive(ctx context.Context, a *archiver, throttle func(ctx context.Context) error) error {
+	shardNames, err := w.manager.TopoServer().GetShardNames(ctx, a.sourceKeyspace)
+	if err != nil {
+		return err
+	}
+	sort.Strings(shardNames)
+	for _, shard := range shardNames {
+		for !w.isPaused() {
+			n, err := a.archiveBatch(ctx, shard, throttle)
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				break
+			}
+			w.mu.Lock()
+			w.data.Archived[shard] += int64(n)
+			w.uiUpdateLocked()
+			w.node.BroadcastChanges(false /* updateChildren */)
+			err = w.checkpointLocked(ctx)
+			w.mu.Unlock()
+			if err != nil {
+				return err
+			}
+			if n < a.batchSize {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// waitForThrottler blocks until the throttler allows the next transaction.
+func waitForThrottler(ctx context.Context, t *throttler.Throttler) error {
+	for {
+		backoff := t.Throttle(0)
+		if backoff == throttler.NotThrottled {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func (w *Workflow) isPaused() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.data.Paused
+}
+
+// Action is part of the workflow.ActionListener interface.
+func (w *Workflow) Action(ctx context.Context, path, name string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	paused := w.data.Paused
+	switch name {
+	case pauseAction:
+		w.data.Paused = true
+		w.logger.Infof("Paused")
+	case resumeAction:
+		w.data.Paused = false
+		w.logger.Infof("Resumed")
+	default:
+		w.logger.Errorf("Unknown action %v called", name)
+		return fmt.Errorf("unknown action %v", name)
+	}
+	if paused == w.data.Paused {
+		// Nothing changed.
+		return nil
+	}
+
+	w.uiUpdateLocked()
+	w.node.BroadcastChanges(false /* updateChildren */)
+	return w.checkpointLocked(ctx)
+}
+
+func (w *Workflow) uiUpdate() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.uiUpdateLocked()
+	w.node.BroadcastChanges(false /* updateChildren */)
+}
+
+// uiUpdateLocked updates the computed parts of the Node, based on the
+// current state. Needs to be called with the lock.
+func (w *Workflow) uiUpdateLocked() {
+	total := int64(0)
+	for _, n := range w.data.Archived {
+		total += n
+	}
+	w.node.ProgressMessage = fmt.Sprintf("%v rows archived", total)
+	w.node.Log = w.logger.String()
+	if w.data.Paused {
+		w.node.Actions[0].State = workflow.ActionStateDisabled
+		w.node.Actions[1].State = workflow.ActionStateEnabled
+		w.node.ProgressMessage += " (paused)"
+	} else {
+		w.node.Actions[0].State = workflow.ActionStateEnabled
+		w.node.Actions[1].State = workflow.ActionStateDisabled
+	}
+}
+
+// checkpointLocked saves a checkpoint in topo server.
+// Needs to be called with the lock.
+func (w *Workflow) checkpointLocked(ctx context.Context) error {
+	var err error
+	w.wi.Data, err = json.Marshal(w.data)
+	if err != nil {
+		return err
+	}
+	return w.manager.TopoServer().SaveWorkflow(ctx, w.wi)
+}
+
+// Factory is the factory to create archival workflows.
+type Factory struct{}
+
+// Init is part of the workflow.Factory interface.
+func (*Factory) Init(_ *workflow.Manager, w *workflowpb.Workflow, args []string) error {
+	subFlags := flag.NewFlagSet(archivalFactoryName, flag.ContinueOnError)
+	sourceKeyspace := subFlags.String("source_keyspace", "", "Keyspace to archive rows from")
+	archiveKeyspace := subFlags.String("archive_keyspace", "", "Keyspace to archive rows to. The table must exist in it, and its primary vindex must be functional.")
+	table := subFlags.String("table", "", "Table to archive")
+	column := subFlags.String("column", "", "Date or time column of the table that defines the age of the rows")
+	olderThan := subFlags.Duration("older_than", 0, "Rows are archived when the value of -column is older than this")
+	batchSize := subFlags.Int("batch_size", 1000, "Number of rows archived in each batch")
+	maxTPS := subFlags.Int64("max_tps", 0, "Maximum number of batches deleted per second from the source keyspace. 0 means no limit.")
+	interval := subFlags.Duration("interval", time.Minute, "How long to wait before looking for new rows to archive once all the old rows have been archived")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if *sourceKeyspace == "" || *archiveKeyspace == "" || *table == "" || *column == "" {
+		return fmt.Errorf("the -source_keyspace, -archive_keyspace, -table and -column flags are required")
+	}
+	if *sourceKeyspace == *archiveKeyspace {
+		return fmt.Errorf("the source and archive keyspaces must be different")
+	}
+	if *olderThan < time.Second {
+		return fmt.Errorf("-older_than must be at least 1s")
+	}
+	if *batchSize <= 0 {
+		return fmt.Errorf("-batch_size must be positive")
+	}
+	if *maxTPS < 0 {
+		return fmt.Errorf("-max_tps cannot be negative")
+	}
+	if *interval <= 0 {
+		return fmt.Errorf("-interval must be positive")
+	}
+
+	w.Name = fmt.Sprintf("Archive %v.%v rows older than %v to %v", *sourceKeyspace, *table, *olderThan, *archiveKeyspace)
+	data := &Data{
+		SourceKeyspace:  *sourceKeyspace,
+		ArchiveKeyspace: *archiveKeyspace,
+		Table:           *table,
+		Column:          *column,
+		Threshold:       *olderThan,
+		BatchSize:       *batchSize,
+		MaxTPS:          *maxTPS,
+		Interval:        *interval,
+		Archived:        make(map[string]int64),
+	}
+	var err error
+	w.Data, err = json.Marshal(data)
+	return err
+}
+
+// Instantiate is part of the workflow.Factory interface.
+func (*Factory) Instantiate(_ *workflow.Manager, w *workflowpb.Workflow, rootNode *workflow.Node) (workflow.Workflow, error) {
+	rootNode.Message = "This workflow continuously moves the old rows of a table to an archive keyspace. Use the Pause and Resume actions to control it."
+
+	data := &Data{}
+	if err := json.Unmarshal(w.Data, data); err != nil {
+		return nil, err
+	}
+	if data.Archived == nil {
+		data.Archived = make(map[string]int64)
+	}
+	return &Workflow{
+		data:   data,
+		node:   rootNode,
+		logger: logutil.NewMemoryLogger(),
+	}, nil
+}
+
+// Compile time interface check.
+var _ workflow.Factory = (*Factory)(nil)
+var _ workflow.Workflow = (*Workflow)(nil)
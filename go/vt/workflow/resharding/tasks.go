@@ -80,6 +80,7 @@ func (hw *horizontalReshardingWorkflow) runSplitClone(ctx context.Context, t *wo
 	minHealthyRdonlyTablets := t.Attributes["min_healthy_rdonly_tablets"]
 	splitCmd := t.Attributes["split_cmd"]
 	useConsistentSnapshot := t.Attributes["use_consistent_snapshot"]
+	chunkSampleSize := t.Attributes["chunk_sample_size"]
 
 	sourceKeyspaceShard := topoproto.KeyspaceShardString(keyspace, sourceShard)
 	excludeTables := t.Attributes["exclude_tables"]
@@ -93,6 +94,10 @@ func (hw *horizontalReshardingWorkflow) runSplitClone(ctx context.Context, t *wo
 	if useConsistentSnapshot != "" {
 		args = append(args, "--use_consistent_snapshot")
 	}
+	// Workflows created before chunk_sample_size was added don't have it.
+	if chunkSampleSize != "" && chunkSampleSize != "0" {
+		args = append(args, "--chunk_sample_size="+chunkSampleSize)
+	}
 
 	if excludeTables != "" {
 		args = append(args, fmt.Sprintf("--exclude_tables=%s", excludeTables))
@@ -79,6 +79,7 @@ func (*Factory) Init(m *workflow.Manager, w *workflowpb.Workflow, args []string)
 	phaseEnaableApprovalsDesc := fmt.Sprintf("Comma separated phases that require explicit approval in the UI to execute. Phase names are: %v", strings.Join(WorkflowPhases(), ","))
 	phaseEnableApprovalsStr := subFlags.String("phase_enable_approvals", strings.Join(WorkflowPhases(), ","), phaseEnaableApprovalsDesc)
 	useConsistentSnapshot := subFlags.Bool("use_consistent_snapshot", false, "Instead of pausing replication on the source, uses transactions with consistent snapshot to have a stable view of the data.")
+	chunkSampleSize := subFlags.Int("chunk_sample_size", 0, "If > 0, SplitClone samples that many primary key values per table to make chunks with about the same number of rows")
 
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -107,6 +108,12 @@ func (*Factory) Init(m *workflow.Manager, w *workflowpb.Workflow, args []string)
 	if *useConsistentSnapshot {
 		useConsistentSnapshotArg = "true"
 	}
+	if *chunkSampleSize < 0 {
+		return fmt.Errorf("chunk_sample_size must be >= 0: %v", *chunkSampleSize)
+	}
+	if *chunkSampleSize > 0 && *splitCmd != "SplitClone" {
+		return fmt.Errorf("chunk_sample_size is only supported by SplitClone, not by %v", *splitCmd)
+	}
 
 	err := validateWorkflow(m, *keyspace, vtworkers, sourceShards, destinationShards, *minHealthyRdonlyTablets, *skipSplitRatioCheck)
 	if err != nil {
@@ -114,7 +121,7 @@ func (*Factory) Init(m *workflow.Manager, w *workflowpb.Workflow, args []string)
 	}
 
 	w.Name = fmt.Sprintf("Reshard shards %v into shards %v of keyspace %v.", *keyspace, *sourceShardsStr, *destinationShardsStr)
-	checkpoint, err := initCheckpoint(*keyspace, vtworkers, excludeTables, sourceShards, destinationShards, *minHealthyRdonlyTablets, *splitCmd, *splitDiffCmd, *splitDiffDestTabletType, useConsistentSnapshotArg)
+	checkpoint, err := initCheckpoint(*keyspace, vtworkers, excludeTables, sourceShards, destinationShards, *minHealthyRdonlyTablets, *splitCmd, *splitDiffCmd, *splitDiffDestTabletType, useConsistentSnapshotArg, strconv.Itoa(*chunkSampleSize))
 	if err != nil {
 		return err
 	}
@@ -285,7 +292,7 @@ func validateWorkflow(m *workflow.Manager, keyspace string, vtworkers, sourceSha
 }
 
 // initCheckpoint initialize the checkpoint for the horizontal workflow.
-func initCheckpoint(keyspace string, vtworkers, excludeTables, sourceShards, destinationShards []string, minHealthyRdonlyTablets, splitCmd, splitDiffCmd, splitDiffDestTabletType string, useConsistentSnapshot string) (*workflowpb.WorkflowCheckpoint, error) {
+func initCheckpoint(keyspace string, vtworkers, excludeTables, sourceShards, destinationShards []string, minHealthyRdonlyTablets, splitCmd, splitDiffCmd, splitDiffDestTabletType string, useConsistentSnapshot, chunkSampleSize string) (*workflowpb.WorkflowCheckpoint, error) {
 	tasks := make(map[string]*workflowpb.Task)
 	initTasks(tasks, phaseCopySchema, destinationShards, func(i int, shard string) map[string]string {
 		return map[string]string{
@@ -303,6 +310,7 @@ func initCheckpoint(keyspace string, vtworkers, excludeTables, sourceShards, des
 			"split_cmd":                  splitCmd,
 			"vtworker":                   vtworkers[i],
 			"use_consistent_snapshot":    useConsistentSnapshot,
+			"chunk_sample_size":          chunkSampleSize,
 			"exclude_tables":             strings.Join(excludeTables, ","),
 		}
 	})
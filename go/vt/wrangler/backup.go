@@ -0,0 +1,278 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+/*
+This file handles the backups of whole keyspaces.
+*/
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// ShardBackup describes the backup of one shard taken by BackupKeyspace.
+type ShardBackup struct {
+	Shard      string
+	Tablet     *topodatapb.Tablet
+	Position   string
+	BackupName string
+}
+
+// ChooseBackupTablet returns the tablet of a shard that is the best
+// candidate to take a backup: the replica, rdonly or spare tablet with
+// the least replication lag. If there is no such tablet and allowMaster
+// is set, the master is returned.
+func (wr *Wrangler) ChooseBackupTablet(ctx context.Context, keyspace, shard string, allowMaster bool) (*topodatapb.Tablet, error) {
+	tablets, stats, err := wr.ShardReplicationStatuses(ctx, keyspace, shard)
+	if tablets == nil {
+		return nil, err
+	}
+
+	var tabletForBackup *topodatapb.Tablet
+	var secondsBehind uint32
+	for i := range tablets {
+		// find a replica, rdonly or spare tablet type to run the backup on
+		switch tablets[i].Type {
+		case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY, topodatapb.TabletType_SPARE:
+		default:
+			continue
+		}
+		// the status is missing if the tablet could not be reached
+		if stats[i] == nil {
+			continue
+		}
+		// choose the first tablet as the baseline, and then
+		// choose a new tablet if it is more up to date
+		if tabletForBackup == nil || stats[i].SecondsBehindMaster < secondsBehind {
+			tabletForBackup = tablets[i].Tablet
+			secondsBehind = stats[i].SecondsBehindMaster
+		}
+	}
+
+	// if no other tablet is available and allowMaster is set to true
+	if tabletForBackup == nil && allowMaster {
+		for i := range tablets {
+			if tablets[i].Type == topodatapb.TabletType_MASTER {
+				return tablets[i].Tablet, nil
+			}
+		}
+	}
+
+	if tabletForBackup == nil {
+		return nil, fmt.Errorf("no tablet available for backup in %v/%v", keyspace, shard)
+	}
+	return tabletForBackup, nil
+}
+
+// BackupKeyspace takes a backup of every shard of a keyspace, such that
+// restoring all the backups yields a consistent state of the keyspace.
+// It records the backups in a keyspace backup, and returns its name.
+// A keyspace backup is restored by creating a SNAPSHOT keyspace whose
+// snapshot time is the time of the keyspace backup.
+//
+// Replication is stopped on one tablet per shard, and the positions of
+// all the masters are then recorded at the same time. Each tablet
+// replicates up to the position of its master, and the backups are
+// taken while replication is stopped. This way, the backups contain
+// all the transactions committed before the recorded positions, and
+// none of the transactions committed after them. waitTime is the
+// maximum time a tablet can take to catch up with its master.
+//
+// Replication is restarted on all the tablets when BackupKeyspace
+// returns, even if it failed.
+func (wr *Wrangler) BackupKeyspace(ctx context.Context, keyspace string, concurrency int, waitTime time.Duration) (string, []*ShardBackup, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(shards) == 0 {
+		return "", nil, fmt.Errorf("keyspace %v has no shards", keyspace)
+	}
+	sort.Strings(shards)
+
+	// Choose the tablets and the masters first, so that nothing
+	// is stopped if a shard cannot be backed up.
+	backups := make([]*ShardBackup, len(shards))
+	masters := make([]*topodatapb.Tablet, len(shards))
+	for i, shard := range shards {
+		si, err := wr.ts.GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return "", nil, err
+		}
+		if !si.HasMaster() {
+			return "", nil, fmt.Errorf("shard %v/%v has no master", keyspace, shard)
+		}
+		master, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+		if err != nil {
+			return "", nil, err
+		}
+		tablet, err := wr.ChooseBackupTablet(ctx, keyspace, shard, false /* allowMaster */)
+		if err != nil {
+			return "", nil, err
+		}
+		backups[i] = &ShardBackup{
+			Shard:  shard,
+			Tablet: tablet,
+		}
+		masters[i] = master.Tablet
+	}
+
+	// From now on, replication must be restarted on the
+	// tablets no matter what happens.
+	defer func() {
+		for _, sb := range backups {
+			if err := wr.tmc.StartSlave(context.Background(), sb.Tablet); err != nil {
+				wr.Logger().Errorf("StartSlave(%v) failed, replication must be restarted manually: %v", topoproto.TabletAliasString(sb.Tablet.Alias), err)
+			}
+		}
+	}()
+
+	wr.Logger().Infof("Stopping replication on %v tablets", len(backups))
+	if err := wr.forEachShardBackup(backups, func(i int, sb *ShardBackup) error {
+		return wr.tmc.StopSlave(ctx, sb.Tablet)
+	}); err != nil {
+		return "", nil, err
+	}
+
+	// The masters keep serving, so their positions are read at
+	// the same time to get a consistent boundary.
+	boundary := time.Now()
+	if err := wr.forEachShardBackup(backups, func(i int, sb *ShardBackup) error {
+		pos, err := wr.tmc.MasterPosition(ctx, masters[i])
+		if err != nil {
+			return err
+		}
+		sb.Position = pos
+		return nil
+	}); err != nil {
+		return "", nil, err
+	}
+	wr.Logger().Infof("Recorded the master positions of keyspace %v at %v", keyspace, boundary.UTC().Format(time.RFC3339Nano))
+
+	if err := wr.forEachShardBackup(backups, func(i int, sb *ShardBackup) error {
+		waitCtx, cancel := context.WithTimeout(ctx, waitTime)
+		defer cancel()
+		if err := wr.tmc.StartSlaveUntilAfter(waitCtx, sb.Tablet, sb.Position, waitTime); err != nil {
+			return err
+		}
+		return wr.tmc.WaitForPosition(waitCtx, sb.Tablet, sb.Position)
+	}); err != nil {
+		return "", nil, err
+	}
+
+	wr.Logger().Infof("Taking backups of %v shards", len(backups))
+	if err := wr.forEachShardBackup(backups, func(i int, sb *ShardBackup) error {
+		return wr.backupTablet(ctx, sb.Tablet, concurrency)
+	}); err != nil {
+		return "", nil, err
+	}
+	name, err := wr.writeKeyspaceBackup(ctx, keyspace, boundary, backups)
+	if err != nil {
+		return "", nil, err
+	}
+	wr.Logger().Printf("Keyspace backup %v of %v:\n", name, keyspace)
+	for _, sb := range backups {
+		wr.Logger().Printf("  %v: backup %v at %v\n", sb.Shard, sb.BackupName, sb.Position)
+	}
+	return name, backups, nil
+}
+
+// writeKeyspaceBackup finds the backups taken by BackupKeyspace, and
+// records them in a keyspace backup.
+func (wr *Wrangler) writeKeyspaceBackup(ctx context.Context, keyspace string, boundary time.Time, backups []*ShardBackup) (string, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return "", err
+	}
+	defer bs.Close()
+
+	manifest := &mysqlctl.KeyspaceBackupManifest{
+		Keyspace:   keyspace,
+		BackupTime: boundary.UTC().Format(time.RFC3339),
+	}
+	for _, sb := range backups {
+		pos, err := mysql.DecodePosition(sb.Position)
+		if err != nil {
+			return "", err
+		}
+		alias := topoproto.TabletAliasString(sb.Tablet.Alias)
+		bh, err := mysqlctl.FindShardBackup(ctx, bs, keyspace, sb.Shard, alias, pos)
+		if err != nil {
+			return "", err
+		}
+		sb.BackupName = bh.Name()
+		manifest.Shards = append(manifest.Shards, &mysqlctl.ShardBackupManifest{
+			Shard:       sb.Shard,
+			TabletAlias: alias,
+			BackupName:  sb.BackupName,
+			Position:    pos,
+		})
+	}
+	return mysqlctl.WriteKeyspaceBackupManifest(ctx, bs, manifest)
+}
+
+// forEachShardBackup calls f for all the backups in parallel, and
+// returns the errors annotated with the tablet they happened on.
+func (wr *Wrangler) forEachShardBackup(backups []*ShardBackup, f func(i int, sb *ShardBackup) error) error {
+	wg := sync.WaitGroup{}
+	rec := concurrency.AllErrorRecorder{}
+	for i, sb := range backups {
+		wg.Add(1)
+		go func(i int, sb *ShardBackup) {
+			defer wg.Done()
+			if err := f(i, sb); err != nil {
+				rec.RecordError(fmt.Errorf("%v/%v (%v): %v", sb.Tablet.Keyspace, sb.Shard, topoproto.TabletAliasString(sb.Tablet.Alias), err))
+			}
+		}(i, sb)
+	}
+	wg.Wait()
+	return rec.Error()
+}
+
+// backupTablet takes a backup on a tablet and logs its progress.
+func (wr *Wrangler) backupTablet(ctx context.Context, tablet *topodatapb.Tablet, concurrency int) error {
+	stream, err := wr.tmc.Backup(ctx, tablet, concurrency, false /* allowMaster */)
+	if err != nil {
+		return err
+	}
+	for {
+		e, err := stream.Recv()
+		switch err {
+		case nil:
+			logutil.LogEvent(wr.Logger(), e)
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// testBackupTMClient records the replication and backup actions.
+type testBackupTMClient struct {
+	tmclient.TabletManagerClient
+
+	mu        sync.Mutex
+	pos       map[uint32]string
+	lag       map[uint32]uint32
+	backupErr map[uint32]error
+	actions   []string
+	// stopPos is the position replication stopped at on each tablet.
+	stopPos map[uint32]string
+}
+
+func (tmc *testBackupTMClient) record(tablet *topodatapb.Tablet, action string) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.actions = append(tmc.actions, fmt.Sprintf("%v: %v", tablet.Alias.Uid, action))
+}
+
+func (tmc *testBackupTMClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return tmc.pos[tablet.Alias.Uid], nil
+}
+
+func (tmc *testBackupTMClient) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	return &replicationdatapb.Status{SecondsBehindMaster: tmc.lag[tablet.Alias.Uid]}, nil
+}
+
+func (tmc *testBackupTMClient) StopSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
+	tmc.record(tablet, "StopSlave")
+	return nil
+}
+
+func (tmc *testBackupTMClient) StartSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
+	tmc.record(tablet, "StartSlave")
+	return nil
+}
+
+func (tmc *testBackupTMClient) StartSlaveUntilAfter(ctx context.Context, tablet *topodatapb.Tablet, position string, duration time.Duration) error {
+	tmc.record(tablet, "StartSlaveUntilAfter "+position)
+	return nil
+}
+
+func (tmc *testBackupTMClient) WaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, pos string) error {
+	tmc.record(tablet, "WaitForPosition "+pos)
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.stopPos[tablet.Alias.Uid] = pos
+	return nil
+}
+
+func (tmc *testBackupTMClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error) {
+	tmc.record(tablet, "Backup")
+	if err := tmc.backupErr[tablet.Alias.Uid]; err != nil {
+		return nil, err
+	}
+
+	// Store a backup that only contains a MANIFEST.
+	tmc.mu.Lock()
+	pos, err := mysql.DecodePosition(tmc.stopPos[tablet.Alias.Uid])
+	tmc.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(&mysqlctl.BackupManifest{Position: pos})
+	if err != nil {
+		return nil, err
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+	bh, err := bs.StartBackup(ctx, mysqlctl.GetBackupDir(tablet.Keyspace, tablet.Shard), "2019-10-01.120001."+topoproto.TabletAliasString(tablet.Alias))
+	if err != nil {
+		return nil, err
+	}
+	wc, err := bh.AddFile(ctx, "MANIFEST", int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := wc.Write(data); err != nil {
+		return nil, err
+	}
+	if err := wc.Close(); err != nil {
+		return nil, err
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		return nil, err
+	}
+	return &eofEventStream{}, nil
+}
+
+type eofEventStream struct{}
+
+func (eofEventStream) Recv() (*logutilpb.Event, error) {
+	return nil, io.EOF
+}
+
+func newTestBackupEnv(t *testing.T, tmc *testBackupTMClient) (*Wrangler, func()) {
+	ctx := context.Background()
+	root, err := ioutil.TempDir("", "backupkeyspacetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	*filebackupstorage.FileBackupStorageRoot = root
+	*backupstorage.BackupStorageImplementation = "file"
+	tmc.stopPos = make(map[uint32]string)
+
+	ts := memorytopo.NewServer("cell1")
+	wr := New(logutil.NewConsoleLogger(), ts, tmc)
+	for i, shard := range []string{"-80", "80-"} {
+		for j, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY} {
+			tablet := &topodatapb.Tablet{
+				Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: uint32(100*(i+1) + j)},
+				Keyspace: "ks",
+				Shard:    shard,
+				Type:     tabletType,
+			}
+			if err := wr.InitTablet(ctx, tablet, false /* allowMasterOverride */, true /* createShardAndKeyspace */, false /* allowUpdate */); err != nil {
+				t.Fatal(err)
+			}
+			if tabletType != topodatapb.TabletType_MASTER {
+				continue
+			}
+			if _, err := ts.UpdateShardFields(ctx, "ks", shard, func(si *topo.ShardInfo) error {
+				si.MasterAlias = tablet.Alias
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return wr, func() { os.RemoveAll(root) }
+}
+
+func TestBackupKeyspace(t *testing.T) {
+	tmc := &testBackupTMClient{
+		pos: map[uint32]string{
+			100: "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10",
+			200: "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20",
+		},
+		// The rdonly of the first shard and the
+		// replica of the second shard are chosen.
+		lag: map[uint32]uint32{101: 10, 102: 1, 201: 0, 202: 5},
+	}
+	wr, cleanup := newTestBackupEnv(t, tmc)
+	defer cleanup()
+
+	name, backups, err := wr.BackupKeyspace(context.Background(), "ks", 4, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, sb := range backups {
+		got = append(got, fmt.Sprintf("%v %v %v %v", sb.Shard, sb.Tablet.Alias.Uid, sb.Position, sb.BackupName))
+	}
+	want := []string{
+		"-80 102 MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10 2019-10-01.120001.cell1-0000000102",
+		"80- 201 MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20 2019-10-01.120001.cell1-0000000201",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BackupKeyspace: %v, want %v", got, want)
+	}
+
+	// The actions on different tablets run in parallel, so only
+	// the order of the actions of each tablet is checked.
+	actions := make(map[string][]string)
+	for _, action := range tmc.actions {
+		parts := strings.SplitN(action, ": ", 2)
+		actions[parts[0]] = append(actions[parts[0]], parts[1])
+	}
+	wantActions := map[string][]string{
+		"102": {
+			"StopSlave",
+			"StartSlaveUntilAfter MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10",
+			"WaitForPosition MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10",
+			"Backup",
+			"StartSlave",
+		},
+		"201": {
+			"StopSlave",
+			"StartSlaveUntilAfter MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20",
+			"WaitForPosition MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20",
+			"Backup",
+			"StartSlave",
+		},
+	}
+	if !reflect.DeepEqual(actions, wantActions) {
+		t.Errorf("actions:\n%v, want\n%v", actions, wantActions)
+	}
+
+	// The keyspace backup refers to the backup of each shard.
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bs.Close()
+	manifest, err := mysqlctl.ReadKeyspaceBackupManifest(context.Background(), bs, "ks", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotShards []string
+	for _, sbm := range manifest.Shards {
+		gotShards = append(gotShards, fmt.Sprintf("%v %v %v %v", sbm.Shard, sbm.TabletAlias, mysql.EncodePosition(sbm.Position), sbm.BackupName))
+	}
+	wantShards := []string{
+		"-80 cell1-0000000102 MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10 2019-10-01.120001.cell1-0000000102",
+		"80- cell1-0000000201 MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20 2019-10-01.120001.cell1-0000000201",
+	}
+	if manifest.Keyspace != "ks" || !reflect.DeepEqual(gotShards, wantShards) {
+		t.Errorf("keyspace backup %v: %v %v, want ks %v", name, manifest.Keyspace, gotShards, wantShards)
+	}
+}
+
+func TestBackupKeyspaceFailure(t *testing.T) {
+	tmc := &testBackupTMClient{
+		lag:       map[uint32]uint32{101: 0, 102: 1, 201: 0, 202: 1},
+		backupErr: map[uint32]error{201: fmt.Errorf("disk full")},
+	}
+	wr, cleanup := newTestBackupEnv(t, tmc)
+	defer cleanup()
+
+	_, _, err := wr.BackupKeyspace(context.Background(), "ks", 4, time.Minute)
+	want := "ks/80- (cell1-0000000201): disk full"
+	if err == nil || err.Error() != want {
+		t.Errorf("BackupKeyspace: %v, want %v", err, want)
+	}
+
+	// Replication is restarted on both tablets.
+	var restarted []string
+	for _, action := range tmc.actions {
+		if strings.HasSuffix(action, ": StartSlave") {
+			restarted = append(restarted, action)
+		}
+	}
+	sort.Strings(restarted)
+	wantRestarted := []string{"101: StartSlave", "201: StartSlave"}
+	if !reflect.DeepEqual(restarted, wantRestarted) {
+		t.Errorf("restarted: %v, want %v", restarted, wantRestarted)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// DryRunPlan is the list of actions a command would take. The dry run
+// of a command runs the code of the command with a topo.Server and a
+// TabletManagerClient that record the changes instead of making them,
+// so they can be reviewed before the command is run for real. The
+// actions a command runs on several tablets at once are listed in the
+// order they were sent.
+type DryRunPlan struct {
+	mu    sync.Mutex
+	steps []string
+}
+
+// addf appends an action to the plan.
+func (p *DryRunPlan) addf(format string, args ...interface{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.steps = append(p.steps, fmt.Sprintf(format, args...))
+}
+
+// addTopoChange is the topo.DryRunRecorder of the plan.
+func (p *DryRunPlan) addTopoChange(cell, action, filePath string) {
+	where := "in cell " + cell
+	if cell == topo.GlobalCell {
+		where = "in the global topo"
+	}
+	p.addf("%v%v %v %v", strings.ToUpper(action[:1]), action[1:], filePath, where)
+}
+
+// Steps returns the actions of the plan, in the order they would run.
+func (p *DryRunPlan) Steps() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.steps
+}
+
+// String returns the numbered actions of the plan, one per line.
+func (p *DryRunPlan) String() string {
+	buf := &bytes.Buffer{}
+	for i, step := range p.Steps() {
+		fmt.Fprintf(buf, "%d. %v\n", i+1, step)
+	}
+	return buf.String()
+}
+
+// dryRun returns a copy of wr that runs the code of a command against
+// the same topology and tablets, but only adds the changes it would
+// make to plan.
+func (wr *Wrangler) dryRun(plan *DryRunPlan) *Wrangler {
+	ts := topo.NewDryRunServer(wr.ts, plan.addTopoChange)
+	return &Wrangler{
+		logger: wr.logger,
+		ts:     ts,
+		tmc:    newDryRunTabletManagerClient(wr.tmc, ts, plan),
+		plan:   plan,
+	}
+}
+
+// planf adds a step that is not a change to the topology or a tablet,
+// like a check or a wait, to the plan of a dry run.
+func (wr *Wrangler) planf(format string, args ...interface{}) {
+	if wr.plan != nil {
+		wr.plan.addf(format, args...)
+	}
+}
+
+// dispatchUpdate dispatches the update of ev, unless wr is a dry run.
+func (wr *Wrangler) dispatchUpdate(ev event.Updater, update interface{}) {
+	if wr.plan != nil {
+		return
+	}
+	event.DispatchUpdate(ev, update)
+}
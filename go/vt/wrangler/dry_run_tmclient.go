@@ -0,0 +1,480 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// The MySQL statements run by the tablet manager RPCs of a reparent,
+// as they are listed by a dry run.
+const (
+	demoteMasterStatements   = "stop serving writes, SET GLOBAL read_only = ON (super_read_only with -use_super_read_only)"
+	promoteSlaveStatements   = "STOP SLAVE, RESET SLAVE ALL, FLUSH BINARY LOGS"
+	stopReplicationStatement = "STOP SLAVE, read the replication position"
+	reparentJournalStatement = "INSERT INTO _vt.reparent_journal the row the replicas wait for"
+)
+
+// dryRunTabletManagerClient is the TabletManagerClient of a dry run. It
+// sends the read-only RPCs to the tablets, and adds the others to the
+// plan instead, returning what the tablets would: the RPCs that return
+// a replication position return the current one, and the streams
+// VReplicationExec creates get made up ids. The RPCs whose results
+// cannot be guessed are refused.
+type dryRunTabletManagerClient struct {
+	tmc  tmclient.TabletManagerClient
+	ts   *topo.Server
+	plan *DryRunPlan
+
+	mu        sync.Mutex
+	insertIDs map[string]uint64
+}
+
+func newDryRunTabletManagerClient(tmc tmclient.TabletManagerClient, ts *topo.Server, plan *DryRunPlan) *dryRunTabletManagerClient {
+	return &dryRunTabletManagerClient{
+		tmc:       tmc,
+		ts:        ts,
+		plan:      plan,
+		insertIDs: make(map[string]uint64),
+	}
+}
+
+// addf adds an RPC to the plan.
+func (c *dryRunTabletManagerClient) addf(tablet *topodatapb.Tablet, rpc, format string, args ...interface{}) {
+	c.plan.addf("%v: %v: %v", topoproto.TabletAliasString(tablet.Alias), rpc, fmt.Sprintf(format, args...))
+}
+
+// unsupported returns the error of the RPCs a dry run refuses.
+func unsupported(rpc string) error {
+	return fmt.Errorf("%v is not supported in a dry run", rpc)
+}
+
+// isReadOnlyQuery returns true for the queries a dry run sends to the
+// tablets.
+func isReadOnlyQuery(query string) bool {
+	switch sqlparser.Preview(query) {
+	case sqlparser.StmtSelect, sqlparser.StmtShow:
+		return true
+	}
+	return false
+}
+
+// semiSyncStatements describes the semi-sync settings a tablet applies
+// when it becomes tabletType and replicates from masterAlias, as the
+// durability policy of its keyspace requires. A nil masterAlias is the
+// master of the shard.
+func (c *dryRunTabletManagerClient) semiSyncStatements(ctx context.Context, tablet *topodatapb.Tablet, tabletType topodatapb.TabletType, masterAlias *topodatapb.TabletAlias) string {
+	ki, err := c.ts.GetKeyspace(ctx, tablet.Keyspace)
+	if err != nil {
+		return fmt.Sprintf("unknown semi-sync settings: %v", err)
+	}
+	durability, err := topotools.ParseDurabilityPolicy(ki.DurabilityPolicy)
+	if err != nil {
+		return fmt.Sprintf("unknown semi-sync settings: invalid durability policy %q: %v", ki.DurabilityPolicy, err)
+	}
+	if durability == nil {
+		if tabletType == topodatapb.TabletType_MASTER {
+			return "with -enable_semi_sync: SET GLOBAL rpl_semi_sync_master_enabled = 1, rpl_semi_sync_slave_enabled = 1, rpl_semi_sync_master_wait_for_slave_count = 1"
+		}
+		return "with -enable_semi_sync: SET GLOBAL rpl_semi_sync_master_enabled = 0, rpl_semi_sync_slave_enabled = 1 on REPLICA tablets"
+	}
+
+	policy := fmt.Sprintf("durability policy %v", ki.DurabilityPolicy)
+	if tabletType == topodatapb.TabletType_MASTER {
+		if ackers := durability.SemiSyncAckers(); ackers > 0 {
+			return fmt.Sprintf("SET GLOBAL rpl_semi_sync_master_enabled = 1, rpl_semi_sync_slave_enabled = 0, rpl_semi_sync_master_wait_for_slave_count = %v (%v)", ackers, policy)
+		}
+		return fmt.Sprintf("SET GLOBAL rpl_semi_sync_master_enabled = 0, rpl_semi_sync_slave_enabled = 0 (%v)", policy)
+	}
+	if masterAlias == nil {
+		si, err := c.ts.GetShard(ctx, tablet.Keyspace, tablet.Shard)
+		if err != nil {
+			return fmt.Sprintf("unknown semi-sync settings: %v", err)
+		}
+		masterAlias = si.MasterAlias
+	}
+	replica := proto.Clone(tablet).(*topodatapb.Tablet)
+	replica.Type = tabletType
+	slave := 0
+	if durability.IsReplicaSemiSync(masterAlias, replica) {
+		slave = 1
+	}
+	return fmt.Sprintf("SET GLOBAL rpl_semi_sync_master_enabled = 0, rpl_semi_sync_slave_enabled = %v (%v)", slave, policy)
+}
+
+// promoteStatements describes what a tablet does to become the master.
+func (c *dryRunTabletManagerClient) promoteStatements(ctx context.Context, tablet *topodatapb.Tablet) string {
+	return fmt.Sprintf("%v, %v, SET GLOBAL read_only = OFF, change the tablet type to MASTER", promoteSlaveStatements, c.semiSyncStatements(ctx, tablet, topodatapb.TabletType_MASTER, nil))
+}
+
+//
+// Read-only RPCs, sent to the tablets.
+//
+
+// Ping is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) Ping(ctx context.Context, tablet *topodatapb.Tablet) error {
+	return c.tmc.Ping(ctx, tablet)
+}
+
+// GetSchema is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	return c.tmc.GetSchema(ctx, tablet, tables, excludeTables, includeViews)
+}
+
+// GetPermissions is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) GetPermissions(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.Permissions, error) {
+	return c.tmc.GetPermissions(ctx, tablet)
+}
+
+// GetPools is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) GetPools(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.PoolInfo, error) {
+	return c.tmc.GetPools(ctx, tablet)
+}
+
+// GetLockDiagnostics is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) GetLockDiagnostics(ctx context.Context, tablet *topodatapb.Tablet, lastAutomatic bool) (*tabletmanagerdatapb.LockDiagnostics, error) {
+	return c.tmc.GetLockDiagnostics(ctx, tablet, lastAutomatic)
+}
+
+// GetQueryPlanStats is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) GetQueryPlanStats(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryPlanStats, error) {
+	return c.tmc.GetQueryPlanStats(ctx, tablet)
+}
+
+// GetQueryBlocklist is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) GetQueryBlocklist(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	return c.tmc.GetQueryBlocklist(ctx, tablet)
+}
+
+// SlaveStatus is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	return c.tmc.SlaveStatus(ctx, tablet)
+}
+
+// MasterPosition is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	return c.tmc.MasterPosition(ctx, tablet)
+}
+
+// GetSlaves is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) GetSlaves(ctx context.Context, tablet *topodatapb.Tablet) ([]string, error) {
+	return c.tmc.GetSlaves(ctx, tablet)
+}
+
+//
+// RPCs that change the tablets, added to the plan.
+//
+
+// SetReadOnly is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) SetReadOnly(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.addf(tablet, "SetReadOnly", "SET GLOBAL read_only = ON")
+	return nil
+}
+
+// SetReadWrite is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) SetReadWrite(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.addf(tablet, "SetReadWrite", "SET GLOBAL read_only = OFF")
+	return nil
+}
+
+// ChangeType is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType) error {
+	c.addf(tablet, "ChangeType", "change the tablet type to %v", dbType)
+	return nil
+}
+
+// Sleep is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) Sleep(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) error {
+	c.addf(tablet, "Sleep", "hold the action lock for %v", duration)
+	return nil
+}
+
+// ExecuteHook is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (*hook.HookResult, error) {
+	return nil, unsupported("ExecuteHook")
+}
+
+// RefreshState is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.addf(tablet, "RefreshState", "re-read the tablet record and the shard")
+	return nil
+}
+
+// ResizePool is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) ResizePool(ctx context.Context, tablet *topodatapb.Tablet, name string, capacity int, idleTimeout time.Duration, prefillParallelism int) (*tabletmanagerdatapb.PoolInfo, error) {
+	return nil, unsupported("ResizePool")
+}
+
+// UpdateQueryBlocklist is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) UpdateQueryBlocklist(ctx context.Context, tablet *topodatapb.Tablet, action, fingerprint, reason string) ([]*tabletmanagerdatapb.QueryBlocklistEntry, error) {
+	return nil, unsupported("UpdateQueryBlocklist")
+}
+
+// SetRuntimeFlags is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) SetRuntimeFlags(ctx context.Context, tablet *topodatapb.Tablet, flags map[string]string) ([]*tabletmanagerdatapb.RuntimeFlagChange, error) {
+	return nil, unsupported("SetRuntimeFlags")
+}
+
+// RunHealthCheck is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) RunHealthCheck(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.addf(tablet, "RunHealthCheck", "run a health check")
+	return nil
+}
+
+// IgnoreHealthError is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) IgnoreHealthError(ctx context.Context, tablet *topodatapb.Tablet, pattern string) error {
+	c.addf(tablet, "IgnoreHealthError", "ignore the health errors matching %q", pattern)
+	return nil
+}
+
+// ReloadSchema is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) ReloadSchema(ctx context.Context, tablet *topodatapb.Tablet, waitPosition string) error {
+	c.addf(tablet, "ReloadSchema", "reload the schema")
+	return nil
+}
+
+// PreflightSchema is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) PreflightSchema(ctx context.Context, tablet *topodatapb.Tablet, changes []string) ([]*tabletmanagerdatapb.SchemaChangeResult, error) {
+	return nil, unsupported("PreflightSchema")
+}
+
+// ApplySchema is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) ApplySchema(ctx context.Context, tablet *topodatapb.Tablet, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error) {
+	return nil, unsupported("ApplySchema")
+}
+
+// LockTables is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) LockTables(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.addf(tablet, "LockTables", "FLUSH TABLES WITH READ LOCK")
+	return nil
+}
+
+// UnlockTables is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) UnlockTables(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.addf(tablet, "UnlockTables", "UNLOCK TABLES")
+	return nil
+}
+
+// ExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	if isReadOnlyQuery(string(query)) {
+		return c.tmc.ExecuteFetchAsDba(ctx, tablet, usePool, query, maxRows, disableBinlogs, reloadSchema)
+	}
+	c.addf(tablet, "ExecuteFetchAsDba", "%v", sqlparser.TruncateForLog(string(query)))
+	return &querypb.QueryResult{}, nil
+}
+
+// ExecuteFetchAsAllPrivs is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) ExecuteFetchAsAllPrivs(ctx context.Context, tablet *topodatapb.Tablet, query []byte, maxRows int, reloadSchema bool) (*querypb.QueryResult, error) {
+	if isReadOnlyQuery(string(query)) {
+		return c.tmc.ExecuteFetchAsAllPrivs(ctx, tablet, query, maxRows, reloadSchema)
+	}
+	c.addf(tablet, "ExecuteFetchAsAllPrivs", "%v", sqlparser.TruncateForLog(string(query)))
+	return &querypb.QueryResult{}, nil
+}
+
+// ExecuteFetchAsApp is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int) (*querypb.QueryResult, error) {
+	if isReadOnlyQuery(string(query)) {
+		return c.tmc.ExecuteFetchAsApp(ctx, tablet, usePool, query, maxRows)
+	}
+	c.addf(tablet, "ExecuteFetchAsApp", "%v", sqlparser.TruncateForLog(string(query)))
+	return &querypb.QueryResult{}, nil
+}
+
+// WaitForPosition is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) WaitForPosition(ctx context.Context, tablet *topodatapb.Tablet, pos string) error {
+	c.addf(tablet, "WaitForPosition", "wait to reach position %v", pos)
+	return nil
+}
+
+// StopSlave is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) StopSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.addf(tablet, "StopSlave", "STOP SLAVE")
+	return nil
+}
+
+// StopSlaveMinimum is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) StopSlaveMinimum(ctx context.Context, tablet *topodatapb.Tablet, stopPos string, waitTime time.Duration) (string, error) {
+	c.addf(tablet, "StopSlaveMinimum", "wait up to %v to reach position %v, STOP SLAVE", waitTime, stopPos)
+	return stopPos, nil
+}
+
+// StartSlave is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) StartSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.addf(tablet, "StartSlave", "START SLAVE")
+	return nil
+}
+
+// StartSlaveUntilAfter is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) StartSlaveUntilAfter(ctx context.Context, tablet *topodatapb.Tablet, position string, duration time.Duration) error {
+	c.addf(tablet, "StartSlaveUntilAfter", "START SLAVE UNTIL position %v, wait up to %v", position, duration)
+	return nil
+}
+
+// TabletExternallyReparented is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string) error {
+	c.addf(tablet, "TabletExternallyReparented", "change the tablet type to MASTER, and make the tablet the master of the shard")
+	return nil
+}
+
+// VReplicationExec is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	if isReadOnlyQuery(query) {
+		return c.tmc.VReplicationExec(ctx, tablet, query)
+	}
+	c.addf(tablet, "VReplicationExec", "%v", sqlparser.TruncateForLog(query))
+	qr := &querypb.QueryResult{}
+	if sqlparser.Preview(query) == sqlparser.StmtInsert {
+		alias := topoproto.TabletAliasString(tablet.Alias)
+		c.mu.Lock()
+		c.insertIDs[alias]++
+		qr.InsertId = c.insertIDs[alias]
+		c.mu.Unlock()
+	}
+	return qr, nil
+}
+
+// VReplicationWaitForPos is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error {
+	c.addf(tablet, "VReplicationWaitForPos", "wait for stream %v to reach position %v", id, pos)
+	return nil
+}
+
+// ResetReplication is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) ResetReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.addf(tablet, "ResetReplication", "STOP SLAVE, RESET SLAVE ALL, RESET MASTER")
+	return nil
+}
+
+// InitMaster is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) InitMaster(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	c.addf(tablet, "InitMaster", "%v", c.promoteStatements(ctx, tablet))
+	return c.tmc.MasterPosition(ctx, tablet)
+}
+
+// PopulateReparentJournal is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) PopulateReparentJournal(ctx context.Context, tablet *topodatapb.Tablet, timeCreatedNS int64, actionName string, masterAlias *topodatapb.TabletAlias, pos string) error {
+	c.addf(tablet, "PopulateReparentJournal", "%v", reparentJournalStatement)
+	return nil
+}
+
+// InitSlave is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) InitSlave(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, replicationPosition string, timeCreatedNS int64) error {
+	c.addf(tablet, "InitSlave", "%v, start replicating from position %v", c.setMasterStatements(ctx, tablet, parent), replicationPosition)
+	return nil
+}
+
+// DemoteMaster is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) DemoteMaster(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	c.addf(tablet, "DemoteMaster", "%v, %v", demoteMasterStatements, c.semiSyncStatements(ctx, tablet, topodatapb.TabletType_REPLICA, nil))
+	return c.tmc.MasterPosition(ctx, tablet)
+}
+
+// UndoDemoteMaster is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) UndoDemoteMaster(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.addf(tablet, "UndoDemoteMaster", "%v, SET GLOBAL read_only = OFF, serve writes again", c.semiSyncStatements(ctx, tablet, topodatapb.TabletType_MASTER, nil))
+	return nil
+}
+
+// PromoteSlaveWhenCaughtUp is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) PromoteSlaveWhenCaughtUp(ctx context.Context, tablet *topodatapb.Tablet, pos string) (string, error) {
+	c.addf(tablet, "PromoteSlaveWhenCaughtUp", "wait to reach position %v, %v", pos, c.promoteStatements(ctx, tablet))
+	return pos, nil
+}
+
+// SlaveWasPromoted is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) SlaveWasPromoted(ctx context.Context, tablet *topodatapb.Tablet) error {
+	c.addf(tablet, "SlaveWasPromoted", "change the tablet type to MASTER")
+	return nil
+}
+
+// setMasterStatements describes what tablet does to replicate from
+// parent.
+func (c *dryRunTabletManagerClient) setMasterStatements(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias) string {
+	master := topoproto.TabletAliasString(parent)
+	if ti, err := c.ts.GetTablet(ctx, parent); err == nil {
+		master = fmt.Sprintf("MASTER_HOST='%v', MASTER_PORT=%v", topoproto.MysqlHostname(ti.Tablet), topoproto.MysqlPort(ti.Tablet))
+	}
+	tabletType := tablet.Type
+	if tabletType == topodatapb.TabletType_MASTER {
+		tabletType = topodatapb.TabletType_REPLICA
+	}
+	return fmt.Sprintf("STOP SLAVE, CHANGE MASTER TO %v, %v, START SLAVE", master, c.semiSyncStatements(ctx, tablet, tabletType, parent))
+}
+
+// SetMaster is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, timeCreatedNS int64, waitPosition string, forceStartSlave bool) error {
+	statements := c.setMasterStatements(ctx, tablet, parent)
+	if waitPosition != "" {
+		statements += fmt.Sprintf(", wait to reach position %v", waitPosition)
+	}
+	c.addf(tablet, "SetMaster", "%v", statements)
+	return nil
+}
+
+// SlaveWasRestarted is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) SlaveWasRestarted(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias) error {
+	c.addf(tablet, "SlaveWasRestarted", "replicate from %v", topoproto.TabletAliasString(parent))
+	return nil
+}
+
+// StopReplicationAndGetStatus is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) StopReplicationAndGetStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	c.addf(tablet, "StopReplicationAndGetStatus", "%v", stopReplicationStatement)
+	return c.tmc.SlaveStatus(ctx, tablet)
+}
+
+// PromoteSlave is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) PromoteSlave(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	c.addf(tablet, "PromoteSlave", "%v", c.promoteStatements(ctx, tablet))
+	return c.tmc.MasterPosition(ctx, tablet)
+}
+
+// Backup is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error) {
+	return nil, unsupported("Backup")
+}
+
+// RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
+func (c *dryRunTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+	return nil, unsupported("RestoreFromBackup")
+}
+
+// Close is part of the tmclient.TabletManagerClient interface. The
+// client of the tablets is left open.
+func (c *dryRunTabletManagerClient) Close() {
+}
@@ -25,7 +25,6 @@ import (
 	"time"
 
 	"golang.org/x/net/context"
-	"vitess.io/vitess/go/event"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -90,6 +89,29 @@ func (wr *Wrangler) SetKeyspaceShardingInfo(ctx context.Context, keyspace, shard
 	return wr.ts.UpdateKeyspace(ctx, ki)
 }
 
+// SetKeyspaceDurabilityPolicy sets the durability policy of a keyspace.
+// The tablets apply it the next time they change type or are reparented.
+func (wr *Wrangler) SetKeyspaceDurabilityPolicy(ctx context.Context, keyspace, policy string) (err error) {
+	if _, err := topotools.ParseDurabilityPolicy(policy); err != nil {
+		return err
+	}
+
+	// Lock the keyspace
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, keyspace, "SetKeyspaceDurabilityPolicy")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	// and change it
+	ki, err := wr.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	ki.DurabilityPolicy = policy
+	return wr.ts.UpdateKeyspace(ctx, ki)
+}
+
 // SplitClone initiates a SplitClone workflow.
 func (wr *Wrangler) SplitClone(ctx context.Context, keyspace string, from, to []string) error {
 	var fromShards, toShards []*topo.ShardInfo
@@ -337,20 +359,8 @@ func (wr *Wrangler) cancelHorizontalResharding(ctx context.Context, keyspace, sh
 // MigrateServedTypes is used during horizontal splits to migrate a
 // served type from a list of shards to another.
 func (wr *Wrangler) MigrateServedTypes(ctx context.Context, keyspace, shard string, cells []string, servedType topodatapb.TabletType, reverse, skipReFreshState bool, filteredReplicationWaitTime time.Duration, reverseReplication bool) (err error) {
-	// check input parameters
-	if servedType == topodatapb.TabletType_MASTER {
-		// we cannot migrate a master back, since when master migration
-		// is done, the source shards are dead
-		if reverse {
-			return fmt.Errorf("cannot migrate master back to %v/%v", keyspace, shard)
-		}
-		// we cannot skip refresh state for a master
-		if skipReFreshState {
-			return fmt.Errorf("cannot skip refresh state for master migration on %v/%v", keyspace, shard)
-		}
-		if cells != nil {
-			return fmt.Errorf("cannot specify cells for master migration on %v/%v", keyspace, shard)
-		}
+	if err := checkMigrateServedTypesArgs(keyspace, shard, cells, servedType, reverse, skipReFreshState); err != nil {
+		return err
 	}
 
 	// lock the keyspace
@@ -360,20 +370,7 @@ func (wr *Wrangler) MigrateServedTypes(ctx context.Context, keyspace, shard stri
 	}
 	defer unlock(&err)
 
-	// find overlapping shards in this keyspace
-	wr.Logger().Infof("Finding the overlapping shards in keyspace %v", keyspace)
-	osList, err := topotools.FindOverlappingShards(ctx, wr.ts, keyspace)
-	if err != nil {
-		return fmt.Errorf("FindOverlappingShards failed: %v", err)
-	}
-
-	// find our shard in there
-	os := topotools.OverlappingShardsForShard(osList, shard)
-	if os == nil {
-		return fmt.Errorf("shard %v is not involved in any overlapping shards", shard)
-	}
-
-	sourceShards, destinationShards, err := wr.findSourceDest(ctx, os)
+	sourceShards, destinationShards, err := wr.findMigrateServedTypesShards(ctx, keyspace, shard)
 	if err != nil {
 		return err
 	}
@@ -402,9 +399,13 @@ func (wr *Wrangler) MigrateServedTypes(ctx context.Context, keyspace, shard stri
 	if servedType == topodatapb.TabletType_REPLICA {
 		waitForDrainSleep = *waitForDrainSleepReplica
 	}
-	wr.Logger().Infof("WaitForDrain: Sleeping for %.0f seconds before shutting down query service on old tablets...", waitForDrainSleep.Seconds())
-	time.Sleep(waitForDrainSleep)
-	wr.Logger().Infof("WaitForDrain: Sleeping finished. Shutting down queryservice on old tablets now.")
+	if wr.plan != nil {
+		wr.planf("Wait %v for the queries to drain", waitForDrainSleep)
+	} else {
+		wr.Logger().Infof("WaitForDrain: Sleeping for %.0f seconds before shutting down query service on old tablets...", waitForDrainSleep.Seconds())
+		time.Sleep(waitForDrainSleep)
+		wr.Logger().Infof("WaitForDrain: Sleeping finished. Shutting down queryservice on old tablets now.")
+	}
 
 	rec := concurrency.AllErrorRecorder{}
 	refreshShards := sourceShards
@@ -418,6 +419,45 @@ func (wr *Wrangler) MigrateServedTypes(ctx context.Context, keyspace, shard stri
 	return rec.Error()
 }
 
+// checkMigrateServedTypesArgs checks the arguments of MigrateServedTypes.
+func checkMigrateServedTypesArgs(keyspace, shard string, cells []string, servedType topodatapb.TabletType, reverse, skipReFreshState bool) error {
+	if servedType != topodatapb.TabletType_MASTER {
+		return nil
+	}
+	// we cannot migrate a master back, since when master migration
+	// is done, the source shards are dead
+	if reverse {
+		return fmt.Errorf("cannot migrate master back to %v/%v", keyspace, shard)
+	}
+	// we cannot skip refresh state for a master
+	if skipReFreshState {
+		return fmt.Errorf("cannot skip refresh state for master migration on %v/%v", keyspace, shard)
+	}
+	if cells != nil {
+		return fmt.Errorf("cannot specify cells for master migration on %v/%v", keyspace, shard)
+	}
+	return nil
+}
+
+// findMigrateServedTypesShards returns the source and destination
+// shards of the split shard belongs to.
+func (wr *Wrangler) findMigrateServedTypesShards(ctx context.Context, keyspace, shard string) (sourceShards, destinationShards []*topo.ShardInfo, err error) {
+	// find overlapping shards in this keyspace
+	wr.Logger().Infof("Finding the overlapping shards in keyspace %v", keyspace)
+	osList, err := topotools.FindOverlappingShards(ctx, wr.ts, keyspace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("FindOverlappingShards failed: %v", err)
+	}
+
+	// find our shard in there
+	os := topotools.OverlappingShardsForShard(osList, shard)
+	if os == nil {
+		return nil, nil, fmt.Errorf("shard %v is not involved in any overlapping shards", shard)
+	}
+
+	return wr.findSourceDest(ctx, os)
+}
+
 // findSourceDest derives the source and destination from the overlapping shards.
 // Whichever side has SourceShards is a destination.
 func (wr *Wrangler) findSourceDest(ctx context.Context, os *topotools.OverlappingShards) (sourceShards, destinationShards []*topo.ShardInfo, err error) {
@@ -567,10 +607,10 @@ func (wr *Wrangler) replicaMigrateServedType(ctx context.Context, keyspace strin
 		ServedType:        servedType,
 		Reverse:           reverse,
 	}
-	event.DispatchUpdate(ev, "start")
+	wr.dispatchUpdate(ev, "start")
 	defer func() {
 		if err != nil {
-			event.DispatchUpdate(ev, "failed: "+err.Error())
+			wr.dispatchUpdate(ev, "failed: "+err.Error())
 		}
 	}()
 
@@ -581,13 +621,13 @@ func (wr *Wrangler) replicaMigrateServedType(ctx context.Context, keyspace strin
 
 	// Check and update all source shard records.
 	// Enable query service if needed
-	event.DispatchUpdate(ev, "updating shards to migrate from")
+	wr.dispatchUpdate(ev, "updating shards to migrate from")
 	if err = wr.updateShardRecords(ctx, keyspace, fromShards, cells, servedType, true /* isFrom */, false /* clearSourceShards */); err != nil {
 		return err
 	}
 
 	// Do the same for destination shards
-	event.DispatchUpdate(ev, "updating shards to migrate to")
+	wr.dispatchUpdate(ev, "updating shards to migrate to")
 	if err = wr.updateShardRecords(ctx, keyspace, toShards, cells, servedType, false, false); err != nil {
 		return err
 	}
@@ -598,45 +638,26 @@ func (wr *Wrangler) replicaMigrateServedType(ctx context.Context, keyspace strin
 		return err
 	}
 
-	event.DispatchUpdate(ev, "finished")
+	wr.dispatchUpdate(ev, "finished")
 	return nil
 }
 
 // masterMigrateServedType operates with the keyspace locked
 func (wr *Wrangler) masterMigrateServedType(ctx context.Context, keyspace string, sourceShards, destinationShards []*topo.ShardInfo, filteredReplicationWaitTime time.Duration, reverseReplication bool) (err error) {
-	// Ensure other served types have migrated.
-	srvKeyspaces, err := wr.ts.GetSrvKeyspaceAllCells(ctx, keyspace)
-	if err != nil {
+	if err := wr.checkServedTypesMigrated(ctx, keyspace, sourceShards[0]); err != nil {
 		return err
 	}
 
-	si := sourceShards[0]
-	for _, srvKeyspace := range srvKeyspaces {
-		var shardServedTypes []string
-		for _, partition := range srvKeyspace.GetPartitions() {
-			if partition.GetServedType() != topodatapb.TabletType_MASTER {
-				for _, shardReference := range partition.GetShardReferences() {
-					if key.KeyRangeEqual(shardReference.GetKeyRange(), si.GetKeyRange()) {
-						shardServedTypes = append(shardServedTypes, partition.GetServedType().String())
-					}
-				}
-			}
-		}
-		if len(shardServedTypes) > 0 {
-			return fmt.Errorf("cannot migrate MASTER away from %v/%v until everything else is migrated. Make sure that the following types are migrated first: %v", si.Keyspace(), si.ShardName(), strings.Join(shardServedTypes, ", "))
-		}
-	}
-
 	ev := &events.MigrateServedTypes{
 		KeyspaceName:      keyspace,
 		SourceShards:      sourceShards,
 		DestinationShards: destinationShards,
 		ServedType:        topodatapb.TabletType_MASTER,
 	}
-	event.DispatchUpdate(ev, "start")
+	wr.dispatchUpdate(ev, "start")
 	defer func() {
 		if err != nil {
-			event.DispatchUpdate(ev, "failed: "+err.Error())
+			wr.dispatchUpdate(ev, "failed: "+err.Error())
 		}
 	}()
 
@@ -646,7 +667,7 @@ func (wr *Wrangler) masterMigrateServedType(ctx context.Context, keyspace string
 	// - gather all replication points
 	// - wait for filtered replication to catch up
 	// - mark source shards as frozen
-	event.DispatchUpdate(ev, "disabling query service on all source masters")
+	wr.dispatchUpdate(ev, "disabling query service on all source masters")
 	// making sure the refreshMaster on both source and target are working before turning off query service on source
 	if err := wr.refreshMasters(ctx, sourceShards); err != nil {
 		wr.cancelMasterMigrateServedTypes(ctx, keyspace, sourceShards)
@@ -666,14 +687,14 @@ func (wr *Wrangler) masterMigrateServedType(ctx context.Context, keyspace string
 		return err
 	}
 
-	event.DispatchUpdate(ev, "getting positions of source masters")
+	wr.dispatchUpdate(ev, "getting positions of source masters")
 	masterPositions, err := wr.getMastersPosition(ctx, sourceShards)
 	if err != nil {
 		wr.cancelMasterMigrateServedTypes(ctx, keyspace, sourceShards)
 		return err
 	}
 
-	event.DispatchUpdate(ev, "waiting for destination masters to catch up")
+	wr.dispatchUpdate(ev, "waiting for destination masters to catch up")
 	if err := wr.waitForFilteredReplication(ctx, masterPositions, destinationShards, filteredReplicationWaitTime); err != nil {
 		wr.cancelMasterMigrateServedTypes(ctx, keyspace, sourceShards)
 		return err
@@ -700,7 +721,7 @@ func (wr *Wrangler) masterMigrateServedType(ctx context.Context, keyspace string
 	}
 
 	// Destination shards need different handling than what updateShardRecords does.
-	event.DispatchUpdate(ev, "updating destination shards")
+	wr.dispatchUpdate(ev, "updating destination shards")
 
 	// Enable query service
 	err = wr.ts.UpdateDisableQueryService(ctx, keyspace, destinationShards, topodatapb.TabletType_MASTER, nil, false)
@@ -730,7 +751,7 @@ func (wr *Wrangler) masterMigrateServedType(ctx context.Context, keyspace string
 		}
 	}
 
-	event.DispatchUpdate(ev, "setting destination masters read-write")
+	wr.dispatchUpdate(ev, "setting destination masters read-write")
 	if err := wr.refreshMasters(ctx, destinationShards); err != nil {
 		return err
 	}
@@ -761,7 +782,33 @@ func (wr *Wrangler) masterMigrateServedType(ctx context.Context, keyspace string
 		}
 	}
 
-	event.DispatchUpdate(ev, "finished")
+	wr.dispatchUpdate(ev, "finished")
+	return nil
+}
+
+// checkServedTypesMigrated returns an error if si still serves other
+// types than MASTER, which must be migrated before the master.
+func (wr *Wrangler) checkServedTypesMigrated(ctx context.Context, keyspace string, si *topo.ShardInfo) error {
+	srvKeyspaces, err := wr.ts.GetSrvKeyspaceAllCells(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+
+	for _, srvKeyspace := range srvKeyspaces {
+		var shardServedTypes []string
+		for _, partition := range srvKeyspace.GetPartitions() {
+			if partition.GetServedType() != topodatapb.TabletType_MASTER {
+				for _, shardReference := range partition.GetShardReferences() {
+					if key.KeyRangeEqual(shardReference.GetKeyRange(), si.GetKeyRange()) {
+						shardServedTypes = append(shardServedTypes, partition.GetServedType().String())
+					}
+				}
+			}
+		}
+		if len(shardServedTypes) > 0 {
+			return fmt.Errorf("cannot migrate MASTER away from %v/%v until everything else is migrated. Make sure that the following types are migrated first: %v", si.Keyspace(), si.ShardName(), strings.Join(shardServedTypes, ", "))
+		}
+	}
 	return nil
 }
 
@@ -1181,10 +1228,10 @@ func (wr *Wrangler) migrateServedFromLocked(ctx context.Context, ki *topo.Keyspa
 		ServedType:       servedType,
 		Reverse:          reverse,
 	}
-	event.DispatchUpdate(ev, "start")
+	wr.dispatchUpdate(ev, "start")
 	defer func() {
 		if err != nil {
-			event.DispatchUpdate(ev, "failed: "+err.Error())
+			wr.dispatchUpdate(ev, "failed: "+err.Error())
 		}
 	}()
 
@@ -1193,20 +1240,20 @@ func (wr *Wrangler) migrateServedFromLocked(ctx context.Context, ki *topo.Keyspa
 	} else {
 		err = wr.replicaMigrateServedFrom(ctx, ki, sourceShard, destinationShard, servedType, cells, reverse, tables, ev)
 	}
-	event.DispatchUpdate(ev, "finished")
+	wr.dispatchUpdate(ev, "finished")
 	return
 }
 
 // replicaMigrateServedFrom handles the slave (replica, rdonly) migration.
 func (wr *Wrangler) replicaMigrateServedFrom(ctx context.Context, ki *topo.KeyspaceInfo, sourceShard *topo.ShardInfo, destinationShard *topo.ShardInfo, servedType topodatapb.TabletType, cells []string, reverse bool, tables []string, ev *events.MigrateServedFrom) error {
 	// Save the destination keyspace (its ServedFrom has been changed)
-	event.DispatchUpdate(ev, "updating keyspace")
+	wr.dispatchUpdate(ev, "updating keyspace")
 	if err := wr.ts.UpdateKeyspace(ctx, ki); err != nil {
 		return err
 	}
 
 	// Save the source shard (its blacklisted tables field has changed)
-	event.DispatchUpdate(ev, "updating source shard")
+	wr.dispatchUpdate(ev, "updating source shard")
 	if _, err := wr.ts.UpdateShardFields(ctx, sourceShard.Keyspace(), sourceShard.ShardName(), func(si *topo.ShardInfo) error {
 		return si.UpdateSourceBlacklistedTables(ctx, servedType, cells, reverse, tables)
 	}); err != nil {
@@ -1215,7 +1262,7 @@ func (wr *Wrangler) replicaMigrateServedFrom(ctx context.Context, ki *topo.Keysp
 
 	// Now refresh the source servers so they reload their
 	// blacklisted table list
-	event.DispatchUpdate(ev, "refreshing sources tablets state so they update their blacklisted tables")
+	wr.dispatchUpdate(ev, "refreshing sources tablets state so they update their blacklisted tables")
 	return wr.RefreshTabletsByShard(ctx, sourceShard, []topodatapb.TabletType{servedType}, cells)
 }
 
@@ -1243,7 +1290,7 @@ func (wr *Wrangler) masterMigrateServedFrom(ctx context.Context, ki *topo.Keyspa
 	}
 
 	// Update source shard (more blacklisted tables)
-	event.DispatchUpdate(ev, "updating source shard")
+	wr.dispatchUpdate(ev, "updating source shard")
 	if _, err := wr.ts.UpdateShardFields(ctx, sourceShard.Keyspace(), sourceShard.ShardName(), func(si *topo.ShardInfo) error {
 		return si.UpdateSourceBlacklistedTables(ctx, topodatapb.TabletType_MASTER, nil, false, tables)
 	}); err != nil {
@@ -1251,39 +1298,39 @@ func (wr *Wrangler) masterMigrateServedFrom(ctx context.Context, ki *topo.Keyspa
 	}
 
 	// Now refresh the blacklisted table list on the source master
-	event.DispatchUpdate(ev, "refreshing source master so it updates its blacklisted tables")
+	wr.dispatchUpdate(ev, "refreshing source master so it updates its blacklisted tables")
 	if err := wr.tmc.RefreshState(ctx, sourceMasterTabletInfo.Tablet); err != nil {
 		return err
 	}
 
 	// get the position
-	event.DispatchUpdate(ev, "getting master position")
+	wr.dispatchUpdate(ev, "getting master position")
 	masterPosition, err := wr.tmc.MasterPosition(ctx, sourceMasterTabletInfo.Tablet)
 	if err != nil {
 		return err
 	}
 
 	// wait for it
-	event.DispatchUpdate(ev, "waiting for destination master to catch up to source master")
+	wr.dispatchUpdate(ev, "waiting for destination master to catch up to source master")
 	uid := destinationShard.SourceShards[0].Uid
 	if err := wr.tmc.VReplicationWaitForPos(ctx, destinationMasterTabletInfo.Tablet, int(uid), masterPosition); err != nil {
 		return err
 	}
 
 	// Stop the VReplication stream.
-	event.DispatchUpdate(ev, "stopping vreplication")
+	wr.dispatchUpdate(ev, "stopping vreplication")
 	if _, err := wr.tmc.VReplicationExec(ctx, destinationMasterTabletInfo.Tablet, binlogplayer.DeleteVReplication(uid)); err != nil {
 		return err
 	}
 
 	// Update the destination keyspace (its ServedFrom has changed)
-	event.DispatchUpdate(ev, "updating keyspace")
+	wr.dispatchUpdate(ev, "updating keyspace")
 	if err = wr.ts.UpdateKeyspace(ctx, ki); err != nil {
 		return err
 	}
 
 	// Update the destination shard (no more source shard)
-	event.DispatchUpdate(ev, "updating destination shard")
+	wr.dispatchUpdate(ev, "updating destination shard")
 	destinationShard, err = wr.ts.UpdateShardFields(ctx, destinationShard.Keyspace(), destinationShard.ShardName(), func(si *topo.ShardInfo) error {
 		if len(si.SourceShards) != 1 {
 			return fmt.Errorf("unexpected concurrent access for destination shard %v/%v SourceShards array", si.Keyspace(), si.ShardName())
@@ -1298,7 +1345,7 @@ func (wr *Wrangler) masterMigrateServedFrom(ctx context.Context, ki *topo.Keyspa
 	// Tell the new shards masters they can now be read-write.
 	// Invoking a remote action will also make the tablet stop filtered
 	// replication.
-	event.DispatchUpdate(ev, "setting destination shard masters read-write")
+	wr.dispatchUpdate(ev, "setting destination shard masters read-write")
 	return wr.refreshMasters(ctx, []*topo.ShardInfo{destinationShard})
 }
 
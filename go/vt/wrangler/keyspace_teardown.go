@@ -0,0 +1,406 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+/*
+This file contains the workflow to tear down a keyspace.
+*/
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// TeardownKeyspaceOptions configures TeardownKeyspace.
+type TeardownKeyspaceOptions struct {
+	// DryRun only runs the safety checks, and logs what would be done.
+	DryRun bool
+	// SkipBackup skips the final backup of the keyspace.
+	SkipBackup bool
+	// BackupConcurrency is the concurrency of the final backups.
+	BackupConcurrency int
+	// BackupWaitTime is the maximum time a backup tablet can take
+	// to catch up with its master before the final backup.
+	BackupWaitTime time.Duration
+}
+
+// teardownDrainedTypes are the tablet types whose query service is
+// disabled when a keyspace is drained.
+var teardownDrainedTypes = []topodatapb.TabletType{
+	topodatapb.TabletType_MASTER,
+	topodatapb.TabletType_REPLICA,
+	topodatapb.TabletType_RDONLY,
+}
+
+// TeardownKeyspace entirely removes a keyspace, after making sure
+// nothing uses it anymore. It refuses to proceed if routing rules,
+// served_from records, vschemas or vreplication streams still reference
+// the keyspace, or if its tablets are still serving queries. It then:
+// - takes a final backup of all the shards, which is kept in the backup
+//   storage,
+// - removes the keyspace from the vschema, so vtgate stops routing to it,
+// - disables the query service of all its tablets,
+// - deletes the shards, the tablets, the serving graph and the keyspace
+//   records, in this order.
+// The vttablet and mysqld processes are not stopped.
+func (wr *Wrangler) TeardownKeyspace(ctx context.Context, keyspace string, options *TeardownKeyspaceOptions) error {
+	if _, err := wr.ts.GetKeyspace(ctx, keyspace); err != nil {
+		return err
+	}
+
+	references, err := wr.keyspaceReferences(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	if len(references) > 0 {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "keyspace %v is still in use, cannot tear it down: %v", keyspace, strings.Join(references, "; "))
+	}
+	wr.Logger().Infof("Keyspace %v is not in use anymore", keyspace)
+
+	if options.DryRun {
+		if !options.SkipBackup {
+			wr.Logger().Printf("Would take a final backup of keyspace %v\n", keyspace)
+		}
+		wr.Logger().Printf("Would remove the vschema of keyspace %v, disable the query service of its tablets, and delete its topology records\n", keyspace)
+		return nil
+	}
+
+	if !options.SkipBackup {
+		wr.Logger().Infof("Taking a final backup of keyspace %v", keyspace)
+		name, _, err := wr.BackupKeyspace(ctx, keyspace, options.BackupConcurrency, options.BackupWaitTime)
+		if err != nil {
+			return fmt.Errorf("final backup of keyspace %v failed: %v", keyspace, err)
+		}
+		wr.Logger().Printf("Final backup of keyspace %v: %v\n", keyspace, name)
+	}
+
+	// Remove the keyspace from the vschema first, so vtgate stops
+	// sending queries before the tablets stop serving.
+	wr.Logger().Infof("Removing keyspace %v from the vschema", keyspace)
+	if err := wr.ts.DeleteVSchema(ctx, keyspace); err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return err
+	}
+	if err := wr.ts.RebuildSrvVSchema(ctx, nil); err != nil {
+		return err
+	}
+
+	if err := wr.drainKeyspace(ctx, keyspace); err != nil {
+		return err
+	}
+
+	if err := wr.DeleteKeyspace(ctx, keyspace, true /* recursive */); err != nil {
+		return err
+	}
+	// The keyspace is only gone from the serving vschema
+	// once its record is deleted.
+	return wr.ts.RebuildSrvVSchema(ctx, nil)
+}
+
+// drainKeyspace disables the query service of all the tablets of
+// a keyspace.
+func (wr *Wrangler) drainKeyspace(ctx context.Context, keyspace string) (err error) {
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, keyspace, "TeardownKeyspace")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	shards, err := wr.ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	shardInfos := make([]*topo.ShardInfo, 0, len(shards))
+	for _, si := range shards {
+		shardInfos = append(shardInfos, si)
+	}
+
+	wr.Logger().Infof("Disabling the query service of all the tablets of keyspace %v", keyspace)
+	for _, tabletType := range teardownDrainedTypes {
+		if err := wr.ts.UpdateDisableQueryService(ctx, keyspace, shardInfos, tabletType, nil, true /* disable */); err != nil {
+			return err
+		}
+	}
+	for _, si := range shardInfos {
+		if err := wr.RefreshTabletsByShard(ctx, si, nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keyspaceReferences returns a description of everything that still
+// uses a keyspace.
+func (wr *Wrangler) keyspaceReferences(ctx context.Context, keyspace string) ([]string, error) {
+	var references []string
+
+	rules, err := wr.ts.GetRoutingRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetRoutingRules failed: %v", err)
+	}
+	references = append(references, routingRulesReferences(rules, keyspace)...)
+
+	keyspaces, err := wr.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetKeyspaces failed: %v", err)
+	}
+	sequences, err := wr.keyspaceSequences(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	for _, ks := range keyspaces {
+		if ks == keyspace {
+			continue
+		}
+		ki, err := wr.ts.GetKeyspace(ctx, ks)
+		if err != nil {
+			return nil, err
+		}
+		for _, sf := range ki.ServedFroms {
+			if sf.Keyspace == keyspace {
+				references = append(references, fmt.Sprintf("keyspace %v serves %v from it", ks, sf.TabletType))
+			}
+		}
+		vschema, err := wr.ts.GetVSchema(ctx, ks)
+		switch {
+		case err == nil:
+			references = append(references, vschemaReferences(vschema, ks, keyspace, sequences)...)
+		case topo.IsErrType(err, topo.NoNode):
+		default:
+			return nil, fmt.Errorf("GetVSchema(%v) failed: %v", ks, err)
+		}
+	}
+
+	streams, err := wr.vreplicationReferences(ctx, keyspaces, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	references = append(references, streams...)
+
+	traffic, err := wr.trafficReferences(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	references = append(references, traffic...)
+	return references, nil
+}
+
+// routingRulesReferences returns the routing rules that read from,
+// or send queries to, a keyspace.
+func routingRulesReferences(rules *vschemapb.RoutingRules, keyspace string) []string {
+	var references []string
+	prefix := keyspace + "."
+	for _, rule := range rules.Rules {
+		used := strings.HasPrefix(rule.FromTable, prefix)
+		for _, to := range rule.ToTables {
+			if to == keyspace || strings.HasPrefix(to, prefix) {
+				used = true
+			}
+		}
+		if used {
+			references = append(references, fmt.Sprintf("routing rule %v: %v", rule.FromTable, strings.Join(rule.ToTables, ", ")))
+		}
+	}
+	return references
+}
+
+// keyspaceSequences returns the sequence tables of a keyspace.
+func (wr *Wrangler) keyspaceSequences(ctx context.Context, keyspace string) (map[string]bool, error) {
+	vschema, err := wr.ts.GetVSchema(ctx, keyspace)
+	switch {
+	case err == nil:
+	case topo.IsErrType(err, topo.NoNode):
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("GetVSchema(%v) failed: %v", keyspace, err)
+	}
+	sequences := make(map[string]bool)
+	for name, table := range vschema.Tables {
+		if table.Type == "sequence" {
+			sequences[name] = true
+		}
+	}
+	return sequences, nil
+}
+
+// vschemaReferences returns the sequences and lookup vindexes of the
+// vschema of ks that use tables of keyspace. sequences are the
+// sequence tables of keyspace, which can be referenced unqualified.
+func vschemaReferences(vschema *vschemapb.Keyspace, ks, keyspace string, sequences map[string]bool) []string {
+	var references []string
+	prefix := keyspace + "."
+
+	var tables []string
+	for name := range vschema.Tables {
+		tables = append(tables, name)
+	}
+	sort.Strings(tables)
+	for _, name := range tables {
+		ai := vschema.Tables[name].AutoIncrement
+		if ai == nil {
+			continue
+		}
+		if strings.HasPrefix(ai.Sequence, prefix) || sequences[ai.Sequence] {
+			references = append(references, fmt.Sprintf("table %v.%v uses sequence %v", ks, name, ai.Sequence))
+		}
+	}
+
+	var vindexes []string
+	for name := range vschema.Vindexes {
+		vindexes = append(vindexes, name)
+	}
+	sort.Strings(vindexes)
+	for _, name := range vindexes {
+		if table := vschema.Vindexes[name].Params["table"]; strings.HasPrefix(table, prefix) {
+			references = append(references, fmt.Sprintf("vindex %v.%v uses table %v", ks, name, table))
+		}
+	}
+	return references
+}
+
+// vreplicationReferences returns the vreplication streams that run
+// on the masters of keyspace, or that read from keyspace.
+func (wr *Wrangler) vreplicationReferences(ctx context.Context, keyspaces []string, keyspace string) ([]string, error) {
+	var references []string
+	for _, ks := range keyspaces {
+		shards, err := wr.ts.FindAllShardsInKeyspace(ctx, ks)
+		if err != nil {
+			return nil, err
+		}
+		for _, si := range shards {
+			if !si.HasMaster() {
+				continue
+			}
+			master, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+			if err != nil {
+				return nil, err
+			}
+			query := fmt.Sprintf("select id, workflow, source from _vt.vreplication where db_name=%s", encodeString(master.DbName()))
+			p3qr, err := wr.tmc.VReplicationExec(ctx, master.Tablet, query)
+			if err != nil {
+				return nil, fmt.Errorf("cannot list the vreplication streams of master %v: %v", master.AliasString(), err)
+			}
+			qr := sqltypes.Proto3ToResult(p3qr)
+			for _, row := range qr.Rows {
+				if ks != keyspace {
+					var bls binlogdatapb.BinlogSource
+					if err := proto.UnmarshalText(row[2].ToString(), &bls); err != nil {
+						return nil, err
+					}
+					if bls.Keyspace != keyspace {
+						continue
+					}
+				}
+				references = append(references, fmt.Sprintf("vreplication stream %v of workflow %v on %v/%v", row[0].ToString(), row[1].ToString(), ks, si.ShardName()))
+			}
+		}
+	}
+	return references, nil
+}
+
+// trafficReferences returns the tablets of keyspace that are still
+// serving queries, according to their health stream. Tablets that
+// cannot be reached are not serving, and are skipped.
+func (wr *Wrangler) trafficReferences(ctx context.Context, keyspace string) ([]string, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	var tablets []*topo.TabletInfo
+	for _, shard := range shards {
+		tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
+		if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+			return nil, err
+		}
+		for _, ti := range tabletMap {
+			tablets = append(tablets, ti)
+		}
+	}
+	sort.Slice(tablets, func(i, j int) bool {
+		return tablets[i].AliasString() < tablets[j].AliasString()
+	})
+
+	references := make([]string, len(tablets))
+	wg := sync.WaitGroup{}
+	for i, ti := range tablets {
+		wg.Add(1)
+		go func(i int, ti *topo.TabletInfo) {
+			defer wg.Done()
+			qps, err := wr.tabletQPS(ctx, ti.Tablet)
+			if err != nil {
+				wr.Logger().Warningf("Cannot get the health of tablet %v, assuming it is not serving: %v", ti.AliasString(), err)
+				return
+			}
+			if qps > 0 {
+				references[i] = fmt.Sprintf("tablet %v is serving %.1f qps", ti.AliasString(), qps)
+			}
+		}(i, ti)
+	}
+	wg.Wait()
+
+	var serving []string
+	for _, reference := range references {
+		if reference != "" {
+			serving = append(serving, reference)
+		}
+	}
+	return serving, nil
+}
+
+// tabletQPS returns the rate of queries served by a tablet, as
+// reported by its health stream.
+func (wr *Wrangler) tabletQPS(ctx context.Context, tablet *topodatapb.Tablet) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(true))
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close(ctx)
+
+	var qps float64
+	err = conn.StreamHealth(ctx, func(shr *querypb.StreamHealthResponse) error {
+		if shr.RealtimeStats == nil {
+			return fmt.Errorf("health record does not include RealtimeStats message: %v", shr)
+		}
+		qps = shr.RealtimeStats.Qps
+		return io.EOF
+	})
+	if err != nil && err != io.EOF {
+		return 0, err
+	}
+	return qps, nil
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/queryservice/fakes"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// teardownQPS is the qps reported by the tablets of the teardown test,
+// by uid. It has to be a global for RegisterDialer to work.
+var (
+	teardownMu  sync.Mutex
+	teardownQPS = make(map[uint32]float64)
+)
+
+func init() {
+	tabletconn.RegisterDialer("TeardownTest", func(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
+		return &teardownTestTablet{
+			QueryService: fakes.ErrorQueryService,
+			tablet:       tablet,
+		}, nil
+	})
+}
+
+type teardownTestTablet struct {
+	queryservice.QueryService
+	tablet *topodatapb.Tablet
+}
+
+func (tt *teardownTestTablet) StreamHealth(ctx context.Context, callback func(*querypb.StreamHealthResponse) error) error {
+	teardownMu.Lock()
+	qps := teardownQPS[tt.tablet.Alias.Uid]
+	teardownMu.Unlock()
+	return callback(&querypb.StreamHealthResponse{
+		Serving: true,
+		Target: &querypb.Target{
+			Keyspace:   tt.tablet.Keyspace,
+			Shard:      tt.tablet.Shard,
+			TabletType: tt.tablet.Type,
+		},
+		RealtimeStats: &querypb.RealtimeStats{Qps: qps},
+	})
+}
+
+func (tt *teardownTestTablet) Close(ctx context.Context) error {
+	return nil
+}
+
+type teardownTestTMClient struct {
+	tmclient.TabletManagerClient
+	streams map[uint32]*sqltypes.Result
+}
+
+func (tmc *teardownTestTMClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	want := fmt.Sprintf("select id, workflow, source from _vt.vreplication where db_name='vt_%s'", tablet.Keyspace)
+	if query != want {
+		return nil, fmt.Errorf("unexpected query %q, want %q", query, want)
+	}
+	result, ok := tmc.streams[tablet.Alias.Uid]
+	if !ok {
+		result = &sqltypes.Result{}
+	}
+	return sqltypes.ResultToProto3(result), nil
+}
+
+func addTeardownTestTablet(t *testing.T, wr *Wrangler, uid uint32, keyspace string, tabletType topodatapb.TabletType) *topodatapb.Tablet {
+	t.Helper()
+	ctx := context.Background()
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: uid},
+		Keyspace: keyspace,
+		Shard:    "0",
+		KeyRange: &topodatapb.KeyRange{},
+		Type:     tabletType,
+	}
+	if err := wr.InitTablet(ctx, tablet, false /* allowMasterOverride */, true /* createShardAndKeyspace */, false /* allowUpdate */); err != nil {
+		t.Fatal(err)
+	}
+	if tabletType == topodatapb.TabletType_MASTER {
+		if _, err := wr.ts.UpdateShardFields(ctx, keyspace, "0", func(si *topo.ShardInfo) error {
+			si.MasterAlias = tablet.Alias
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return tablet
+}
+
+func TestTeardownKeyspace(t *testing.T) {
+	ctx := context.Background()
+	flag.Set("tablet_protocol", "TeardownTest")
+	ts := memorytopo.NewServer("cell1")
+	tmc := &teardownTestTMClient{streams: make(map[uint32]*sqltypes.Result)}
+	wr := New(logutil.NewConsoleLogger(), ts, tmc)
+
+	addTeardownTestTablet(t, wr, 100, "ks", topodatapb.TabletType_MASTER)
+	addTeardownTestTablet(t, wr, 101, "ks", topodatapb.TabletType_REPLICA)
+	addTeardownTestTablet(t, wr, 200, "other", topodatapb.TabletType_MASTER)
+
+	if err := ts.SaveVSchema(ctx, "ks", &vschemapb.Keyspace{
+		Tables: map[string]*vschemapb.Table{
+			"t1_seq": {Type: "sequence"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.SaveVSchema(ctx, "other", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"lookup": {
+				Type:   "lookup_unique",
+				Params: map[string]string{"table": "ks.lookup", "from": "c1", "to": "keyspace_id"},
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+				AutoIncrement:  &vschemapb.AutoIncrement{Column: "id", Sequence: "t1_seq"},
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.SaveRoutingRules(ctx, &vschemapb.RoutingRules{
+		Rules: []*vschemapb.RoutingRule{{
+			FromTable: "t2",
+			ToTables:  []string{"ks.t2"},
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	bls := &binlogdatapb.BinlogSource{Keyspace: "ks", Shard: "0"}
+	tmc.streams[200] = sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"id|workflow|source",
+		"int64|varbinary|varbinary"),
+		fmt.Sprintf("1|wf1|%v", bls),
+	)
+	teardownMu.Lock()
+	teardownQPS[101] = 2.5
+	teardownMu.Unlock()
+
+	err := wr.TeardownKeyspace(ctx, "ks", &TeardownKeyspaceOptions{SkipBackup: true})
+	if err == nil {
+		t.Fatalf("TeardownKeyspace(in use) succeeded")
+	}
+	for _, want := range []string{
+		"routing rule t2: ks.t2",
+		"table other.t1 uses sequence t1_seq",
+		"vindex other.lookup uses table ks.lookup",
+		"vreplication stream 1 of workflow wf1 on other/0",
+		"tablet cell1-0000000101 is serving 2.5 qps",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("TeardownKeyspace(in use): %v, must contain %q", err, want)
+		}
+	}
+
+	// Remove all the references.
+	if err := ts.SaveRoutingRules(ctx, &vschemapb.RoutingRules{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.SaveVSchema(ctx, "other", &vschemapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	delete(tmc.streams, 200)
+	teardownMu.Lock()
+	delete(teardownQPS, 101)
+	teardownMu.Unlock()
+
+	// A dry run doesn't change anything.
+	if err := wr.TeardownKeyspace(ctx, "ks", &TeardownKeyspaceOptions{DryRun: true}); err != nil {
+		t.Fatalf("TeardownKeyspace(dry run) failed: %v", err)
+	}
+	if _, err := ts.GetVSchema(ctx, "ks"); err != nil {
+		t.Errorf("GetVSchema after dry run failed: %v", err)
+	}
+
+	if err := wr.TeardownKeyspace(ctx, "ks", &TeardownKeyspaceOptions{SkipBackup: true}); err != nil {
+		t.Fatalf("TeardownKeyspace failed: %v", err)
+	}
+	if _, err := ts.GetKeyspace(ctx, "ks"); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("GetKeyspace(ks): %v, want NoNode", err)
+	}
+	if _, err := ts.GetTablet(ctx, &topodatapb.TabletAlias{Cell: "cell1", Uid: 101}); !topo.IsErrType(err, topo.NoNode) {
+		t.Errorf("GetTablet(101): %v, want NoNode", err)
+	}
+	srvVSchema, err := ts.GetSrvVSchema(ctx, "cell1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := srvVSchema.Keyspaces["ks"]; ok {
+		t.Errorf("SrvVSchema still has keyspace ks: %v", srvVSchema)
+	}
+	if _, err := ts.GetKeyspace(ctx, "other"); err != nil {
+		t.Errorf("GetKeyspace(other) failed: %v", err)
+	}
+}
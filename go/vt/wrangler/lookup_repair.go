@@ -0,0 +1,755 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/throttler"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// lookupRepairMaxRows is the maximum number of rows returned by a query
+// of the repair. A chunk of a non-unique lookup vindex can match more
+// rows than the chunk size.
+const lookupRepairMaxRows = 100000
+
+// LookupRepairOptions are the options of RepairLookupVindex.
+type LookupRepairOptions struct {
+	// ChunkSize is the number of rows read per query.
+	ChunkSize int
+	// Concurrency is the number of shards scanned in parallel.
+	Concurrency int
+	// MaxTPS limits the number of fix transactions per second, if positive.
+	MaxTPS int64
+	// RecheckDelay is how long the repair waits before it checks an
+	// orphaned lookup row again, and deletes it. The lookup rows are
+	// written before the owner rows, so an in-flight transaction looks
+	// like an orphan.
+	RecheckDelay time.Duration
+	// DryRun only reports the rows which would be fixed.
+	DryRun bool
+}
+
+// LookupRepairReport counts the rows of a repair.
+type LookupRepairReport struct {
+	// OwnerRows and LookupRows are the numbers of rows scanned.
+	OwnerRows  int64
+	LookupRows int64
+	// Orphaned lookup rows have no owner row, and are deleted.
+	Orphaned int64
+	// Missing lookup rows are inserted for their owner row.
+	Missing int64
+	// Skipped rows were fine when they were checked again, or were
+	// written concurrently.
+	Skipped int64
+}
+
+// String is part of the fmt.Stringer interface.
+func (r *LookupRepairReport) String() string {
+	return fmt.Sprintf("%v owner rows and %v lookup rows scanned, %v orphaned lookup rows, %v missing lookup rows, %v skipped", r.OwnerRows, r.LookupRows, r.Orphaned, r.Missing, r.Skipped)
+}
+
+// RepairLookupVindex scans the lookup table of an owned lookup vindex
+// and its owner table, and fixes the lookup rows which don't match the
+// owner rows. It's meant to recover from the failed writes of the lookup
+// vindexes which aren't transactional. First, the orphaned lookup rows
+// are deleted. Then, the missing lookup rows are inserted. The fixes of
+// a chunk are a single statement on each shard, so they are applied
+// atomically. The keyspace ids of the owner rows are computed with the
+// primary vindex of the owner table, which must be functional.
+func (wr *Wrangler) RepairLookupVindex(ctx context.Context, keyspace, vindexName string, opts LookupRepairOptions) (*LookupRepairReport, error) {
+	if opts.ChunkSize <= 0 {
+		return nil, fmt.Errorf("chunk size must be positive: %v", opts.ChunkSize)
+	}
+	if opts.Concurrency <= 0 {
+		return nil, fmt.Errorf("concurrency must be positive: %v", opts.Concurrency)
+	}
+	r, err := wr.newLookupRepair(ctx, keyspace, vindexName, opts)
+	if err != nil {
+		return nil, err
+	}
+	maxTPS := opts.MaxTPS
+	if maxTPS <= 0 {
+		maxTPS = throttler.MaxRateModuleDisabled
+	}
+	r.throttler, err = throttler.NewThrottler("RepairLookupVindex/"+keyspace+"."+vindexName, "transactions", opts.Concurrency, maxTPS, throttler.ReplicationLagModuleDisabled)
+	if err != nil {
+		return nil, err
+	}
+	defer r.throttler.Close()
+
+	if err := r.forEachShard(ctx, r.lookupShards, r.repairOrphans); err != nil {
+		return &r.report, err
+	}
+	if err := r.forEachShard(ctx, r.ownerShards, r.repairMissing); err != nil {
+		return &r.report, err
+	}
+	return &r.report, nil
+}
+
+// lookupRepair is a run of RepairLookupVindex.
+type lookupRepair struct {
+	wr        *Wrangler
+	opts      LookupRepairOptions
+	throttler *throttler.Throttler
+
+	ownerKeyspace string
+	ownerTable    string
+	ownerShards   []*topo.ShardInfo
+	// ownerColumns are the columns of the owner table which are
+	// mapped to fromColumns.
+	ownerColumns []string
+	primary      *vindexes.ColumnVindex
+	// ownerSelect are the columns read from the owner table: its
+	// primary key, the ownerColumns, and the columns of the primary
+	// vindex. The other fields are their indexes in ownerSelect.
+	ownerSelect    []string
+	ownerPKLen     int
+	ownerFromIdx   []int
+	ownerVindexIdx []int
+
+	lookupKeyspace string
+	lookupTable    string
+	lookupShards   []*topo.ShardInfo
+	fromColumns    []string
+	toColumn       string
+	// lookupRoute routes the rows of the lookup table if its keyspace
+	// is sharded. lookupRouteIdx are the indexes of its columns in
+	// fromColumns + toColumn.
+	lookupRoute    *vindexes.ColumnVindex
+	lookupRouteIdx []int
+	// lookupSelect are the columns read from the lookup table: its
+	// primary key, the fromColumns and the toColumn.
+	lookupSelect  []string
+	lookupPKLen   int
+	lookupFromIdx []int
+	lookupToIdx   int
+
+	mu     sync.Mutex
+	report LookupRepairReport
+}
+
+// lookupEntry is a row of a lookup table.
+type lookupEntry struct {
+	from []sqltypes.Value
+	ksid []byte
+}
+
+// key identifies the entry, regardless of the types of the values.
+func (e lookupEntry) key() string {
+	parts := make([]string, 0, len(e.from)+1)
+	for _, v := range e.from {
+		parts = append(parts, hex.EncodeToString(v.ToBytes()))
+	}
+	parts = append(parts, hex.EncodeToString(e.ksid))
+	return strings.Join(parts, ":")
+}
+
+// fromKey identifies the from values of the entry.
+func (e lookupEntry) fromKey() string {
+	return lookupEntry{from: e.from}.key()
+}
+
+func (wr *Wrangler) newLookupRepair(ctx context.Context, keyspace, vindexName string, opts LookupRepairOptions) (*lookupRepair, error) {
+	vschema, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("GetVSchema(%v) failed: %v", keyspace, err)
+	}
+	vindexDef, ok := vschema.Vindexes[vindexName]
+	if !ok {
+		return nil, fmt.Errorf("no vindex %v in keyspace %v", vindexName, keyspace)
+	}
+	kschema, err := vindexes.BuildKeyspaceSchema(vschema, keyspace)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vschema of keyspace %v: %v", keyspace, err)
+	}
+	if _, ok := kschema.Vindexes[vindexName].(vindexes.Lookup); !ok {
+		return nil, fmt.Errorf("vindex %v of type %v is not a lookup vindex", vindexName, vindexDef.Type)
+	}
+	if vindexDef.Owner == "" {
+		return nil, fmt.Errorf("lookup vindex %v has no owner table", vindexName)
+	}
+
+	r := &lookupRepair{
+		wr:            wr,
+		opts:          opts,
+		ownerKeyspace: keyspace,
+		ownerTable:    vindexDef.Owner,
+		toColumn:      strings.TrimSpace(vindexDef.Params["to"]),
+	}
+
+	// The owner table and its vindexes.
+	owner, ok := kschema.Tables[r.ownerTable]
+	if !ok || len(owner.ColumnVindexes) == 0 {
+		return nil, fmt.Errorf("owner table %v of vindex %v has no vindex", r.ownerTable, vindexName)
+	}
+	for _, cv := range owner.Owned {
+		if cv.Name == vindexName {
+			for _, col := range cv.Columns {
+				r.ownerColumns = append(r.ownerColumns, col.String())
+			}
+		}
+	}
+	if len(r.ownerColumns) == 0 {
+		return nil, fmt.Errorf("owner table %v doesn't use vindex %v", r.ownerTable, vindexName)
+	}
+	r.primary = owner.ColumnVindexes[0]
+	if _, ok := r.primary.Vindex.(vindexes.Lookup); ok {
+		return nil, fmt.Errorf("the primary vindex %v of table %v must be functional", r.primary.Name, r.ownerTable)
+	}
+
+	// The lookup table.
+	for _, from := range strings.Split(vindexDef.Params["from"], ",") {
+		r.fromColumns = append(r.fromColumns, strings.TrimSpace(from))
+	}
+	if len(r.fromColumns) != len(r.ownerColumns) || r.toColumn == "" {
+		return nil, fmt.Errorf("the from and to columns of vindex %v don't match the columns of table %v", vindexName, r.ownerTable)
+	}
+	r.lookupKeyspace, r.lookupTable = keyspace, vindexDef.Params["table"]
+	if i := strings.Index(r.lookupTable, "."); i >= 0 {
+		r.lookupKeyspace, r.lookupTable = r.lookupTable[:i], r.lookupTable[i+1:]
+	}
+	lookupVSchema := vschema
+	if r.lookupKeyspace != keyspace {
+		if lookupVSchema, err = wr.ts.GetVSchema(ctx, r.lookupKeyspace); err != nil {
+			return nil, fmt.Errorf("GetVSchema(%v) failed: %v", r.lookupKeyspace, err)
+		}
+	}
+	if lookupVSchema.Sharded {
+		if err := r.initLookupRoute(lookupVSchema); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.ownerShards, err = r.shards(ctx, r.ownerKeyspace); err != nil {
+		return nil, err
+	}
+	if r.lookupShards, err = r.shards(ctx, r.lookupKeyspace); err != nil {
+		return nil, err
+	}
+
+	// The columns which are read.
+	ownerPK, err := r.primaryKey(ctx, r.ownerShards[0], r.ownerTable)
+	if err != nil {
+		return nil, err
+	}
+	var primaryColumns []string
+	for _, col := range r.primary.Columns {
+		primaryColumns = append(primaryColumns, col.String())
+	}
+	r.ownerSelect, r.ownerPKLen = ownerPK, len(ownerPK)
+	r.ownerFromIdx = addColumns(&r.ownerSelect, r.ownerColumns)
+	r.ownerVindexIdx = addColumns(&r.ownerSelect, primaryColumns)
+
+	lookupPK, err := r.primaryKey(ctx, r.lookupShards[0], r.lookupTable)
+	if err != nil {
+		return nil, err
+	}
+	r.lookupSelect, r.lookupPKLen = lookupPK, len(lookupPK)
+	r.lookupFromIdx = addColumns(&r.lookupSelect, r.fromColumns)
+	r.lookupToIdx = addColumns(&r.lookupSelect, []string{r.toColumn})[0]
+	return r, nil
+}
+
+// initLookupRoute finds the primary vindex of the lookup table in its
+// sharded keyspace.
+func (r *lookupRepair) initLookupRoute(vschema *vschemapb.Keyspace) error {
+	kschema, err := vindexes.BuildKeyspaceSchema(vschema, r.lookupKeyspace)
+	if err != nil {
+		return fmt.Errorf("invalid vschema of keyspace %v: %v", r.lookupKeyspace, err)
+	}
+	table, ok := kschema.Tables[r.lookupTable]
+	if !ok || len(table.ColumnVindexes) == 0 {
+		return fmt.Errorf("lookup table %v has no vindex in the sharded keyspace %v", r.lookupTable, r.lookupKeyspace)
+	}
+	r.lookupRoute = table.ColumnVindexes[0]
+	if _, ok := r.lookupRoute.Vindex.(vindexes.Lookup); ok {
+		return fmt.Errorf("the primary vindex %v of lookup table %v must be functional", r.lookupRoute.Name, r.lookupTable)
+	}
+	columns := append(append([]string(nil), r.fromColumns...), r.toColumn)
+	for _, col := range r.lookupRoute.Columns {
+		idx := -1
+		for i, c := range columns {
+			if col.EqualString(c) {
+				idx = i
+			}
+		}
+		if idx < 0 {
+			return fmt.Errorf("the primary vindex %v of lookup table %v must be on its from or to columns", r.lookupRoute.Name, r.lookupTable)
+		}
+		r.lookupRouteIdx = append(r.lookupRouteIdx, idx)
+	}
+	return nil
+}
+
+// shards returns the shards of a keyspace, which must all have a master.
+func (r *lookupRepair) shards(ctx context.Context, keyspace string) ([]*topo.ShardInfo, error) {
+	shardMap, err := r.wr.ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	var shards []*topo.ShardInfo
+	for _, si := range shardMap {
+		if !si.HasMaster() {
+			return nil, fmt.Errorf("no master in shard %v/%v", keyspace, si.ShardName())
+		}
+		shards = append(shards, si)
+	}
+	if len(shards) == 0 {
+		return nil, fmt.Errorf("keyspace %v has no shards", keyspace)
+	}
+	return shards, nil
+}
+
+// primaryKey returns the primary key columns of a table.
+func (r *lookupRepair) primaryKey(ctx context.Context, si *topo.ShardInfo, table string) ([]string, error) {
+	sd, err := r.wr.GetSchema(ctx, si.MasterAlias, []string{table}, nil, false /* includeViews */)
+	if err != nil {
+		return nil, err
+	}
+	if len(sd.TableDefinitions) != 1 || len(sd.TableDefinitions[0].PrimaryKeyColumns) == 0 {
+		return nil, fmt.Errorf("table %v has no primary key in shard %v/%v", table, si.Keyspace(), si.ShardName())
+	}
+	return sd.TableDefinitions[0].PrimaryKeyColumns, nil
+}
+
+// addColumns adds the columns which are not in the list yet, and returns
+// the indexes of all of them in the list.
+func addColumns(list *[]string, columns []string) []int {
+	var idx []int
+	for _, col := range columns {
+		found := -1
+		for i, c := range *list {
+			if strings.EqualFold(c, col) {
+				found = i
+			}
+		}
+		if found < 0 {
+			found = len(*list)
+			*list = append(*list, col)
+		}
+		idx = append(idx, found)
+	}
+	return idx
+}
+
+// forEachShard runs f for the shards, opts.Concurrency at a time. Each
+// concurrent run has its own throttler thread.
+func (r *lookupRepair) forEachShard(ctx context.Context, shards []*topo.ShardInfo, f func(ctx context.Context, threadID int, si *topo.ShardInfo) error) error {
+	shardChan := make(chan *topo.ShardInfo, len(shards))
+	for _, si := range shards {
+		shardChan <- si
+	}
+	close(shardChan)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	rec := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for threadID := 0; threadID < r.opts.Concurrency; threadID++ {
+		wg.Add(1)
+		go func(threadID int) {
+			defer wg.Done()
+			for si := range shardChan {
+				if err := f(ctx, threadID, si); err != nil {
+					rec.RecordError(fmt.Errorf("shard %v/%v: %v", si.Keyspace(), si.ShardName(), err))
+					cancel()
+					return
+				}
+			}
+		}(threadID)
+	}
+	wg.Wait()
+	return rec.Error()
+}
+
+// scanChunks reads a table in chunks of its primary key, which must be
+// the first pkLen columns, and calls f for each chunk.
+func (r *lookupRepair) scanChunks(ctx context.Context, si *topo.ShardInfo, table string, columns []string, pkLen int, f func(rows [][]sqltypes.Value) error) error {
+	var last []sqltypes.Value
+	for {
+		buf := &bytes.Buffer{}
+		fmt.Fprintf(buf, "select %v from %v", columnList(columns), sqlescape.EscapeID(table))
+		if last != nil {
+			fmt.Fprintf(buf, " where (%v) > %v", columnList(columns[:pkLen]), tuple(last))
+		}
+		fmt.Fprintf(buf, " order by %v limit %v", columnList(columns[:pkLen]), r.opts.ChunkSize)
+		qr, err := r.query(ctx, si, buf.String())
+		if err != nil {
+			return err
+		}
+		if len(qr.Rows) > 0 {
+			if err := f(qr.Rows); err != nil {
+				return err
+			}
+			last = qr.Rows[len(qr.Rows)-1][:pkLen]
+		}
+		if len(qr.Rows) < r.opts.ChunkSize {
+			return nil
+		}
+	}
+}
+
+// repairOrphans deletes the rows of a shard of the lookup table which
+// have no owner row.
+func (r *lookupRepair) repairOrphans(ctx context.Context, threadID int, si *topo.ShardInfo) error {
+	return r.scanChunks(ctx, si, r.lookupTable, r.lookupSelect, r.lookupPKLen, func(rows [][]sqltypes.Value) error {
+		r.count(&r.report.LookupRows, len(rows))
+		entries := make([]lookupEntry, 0, len(rows))
+		for _, row := range rows {
+			entries = append(entries, r.lookupRowEntry(row))
+		}
+		candidates, err := r.orphans(ctx, entries)
+		if err != nil || len(candidates) == 0 {
+			return err
+		}
+
+		// The owner rows of in-flight transactions are written after
+		// their lookup rows.
+		select {
+		case <-time.After(r.opts.RecheckDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		rechecked, err := r.orphans(ctx, candidates)
+		if err != nil {
+			return err
+		}
+		stillThere, err := r.lookupEntries(ctx, si, rechecked, true /* matchKsid */)
+		if err != nil {
+			return err
+		}
+		var orphans []lookupEntry
+		for _, e := range rechecked {
+			if stillThere[e.key()] {
+				orphans = append(orphans, e)
+			}
+		}
+		r.count(&r.report.Skipped, len(candidates)-len(orphans))
+		if len(orphans) == 0 {
+			return nil
+		}
+		for _, e := range orphans {
+			r.wr.Logger().Infof("Orphaned lookup row in %v/%v: %v", si.Keyspace(), si.ShardName(), r.describe(e))
+		}
+		r.count(&r.report.Orphaned, len(orphans))
+		if r.opts.DryRun {
+			return nil
+		}
+		query := fmt.Sprintf("delete from %v where (%v) in (%v)", sqlescape.EscapeID(r.lookupTable), columnList(r.lookupColumns()), entryTuples(orphans, true /* withKsid */))
+		_, err = r.write(ctx, threadID, si, query)
+		return err
+	})
+}
+
+// orphans returns the entries which match no owner row.
+func (r *lookupRepair) orphans(ctx context.Context, entries []lookupEntry) ([]lookupEntry, error) {
+	// The owner rows are in the shard of the keyspace id.
+	byShard := make(map[*topo.ShardInfo][]lookupEntry)
+	var orphans []lookupEntry
+	for _, e := range entries {
+		si := shardForKeyspaceID(r.ownerShards, e.ksid)
+		if si == nil {
+			orphans = append(orphans, e)
+			continue
+		}
+		byShard[si] = append(byShard[si], e)
+	}
+	for si, shardEntries := range byShard {
+		query := fmt.Sprintf("select %v from %v where (%v) in (%v)", columnList(r.ownerSelect), sqlescape.EscapeID(r.ownerTable), columnList(r.ownerColumns), entryTuples(shardEntries, false /* withKsid */))
+		qr, err := r.query(ctx, si, query)
+		if err != nil {
+			return nil, err
+		}
+		existing, err := r.ownerEntries(qr.Rows)
+		if err != nil {
+			return nil, err
+		}
+		found := make(map[string]bool)
+		for _, e := range existing {
+			found[e.key()] = true
+		}
+		for _, e := range shardEntries {
+			if !found[e.key()] {
+				orphans = append(orphans, e)
+			}
+		}
+	}
+	return orphans, nil
+}
+
+// repairMissing inserts the lookup rows which are missing for the rows
+// of a shard of the owner table.
+func (r *lookupRepair) repairMissing(ctx context.Context, threadID int, si *topo.ShardInfo) error {
+	return r.scanChunks(ctx, si, r.ownerTable, r.ownerSelect, r.ownerPKLen, func(rows [][]sqltypes.Value) error {
+		r.count(&r.report.OwnerRows, len(rows))
+		entries, err := r.ownerEntries(rows)
+		if err != nil {
+			return err
+		}
+		byShard := make(map[*topo.ShardInfo][]lookupEntry)
+		for _, e := range entries {
+			lookupShard, err := r.lookupShard(e)
+			if err != nil {
+				return err
+			}
+			byShard[lookupShard] = append(byShard[lookupShard], e)
+		}
+		for lookupShard, shardEntries := range byShard {
+			existing, err := r.lookupEntries(ctx, lookupShard, shardEntries, false /* matchKsid */)
+			if err != nil {
+				return err
+			}
+			var missing []lookupEntry
+			for _, e := range shardEntries {
+				if !existing[e.key()] {
+					missing = append(missing, e)
+				}
+			}
+			if len(missing) == 0 {
+				continue
+			}
+			for _, e := range missing {
+				r.wr.Logger().Infof("Missing lookup row in %v/%v: %v", lookupShard.Keyspace(), lookupShard.ShardName(), r.describe(e))
+			}
+			r.count(&r.report.Missing, len(missing))
+			if r.opts.DryRun {
+				continue
+			}
+			// A unique lookup row which points to another keyspace id
+			// was written concurrently, or is an orphan which wasn't
+			// deleted: it's ignored.
+			query := fmt.Sprintf("insert ignore into %v(%v) values %v", sqlescape.EscapeID(r.lookupTable), columnList(r.lookupColumns()), entryTuples(missing, true /* withKsid */))
+			inserted, err := r.write(ctx, threadID, lookupShard, query)
+			if err != nil {
+				return err
+			}
+			r.count(&r.report.Skipped, len(missing)-int(inserted))
+		}
+		return nil
+	})
+}
+
+// lookupEntries returns the keys of the entries which are in the
+// lookup table. If matchKsid is false, all the rows of the from values
+// of the entries are returned.
+func (r *lookupRepair) lookupEntries(ctx context.Context, si *topo.ShardInfo, entries []lookupEntry, matchKsid bool) (map[string]bool, error) {
+	columns := r.fromColumns
+	if matchKsid {
+		columns = r.lookupColumns()
+	}
+	query := fmt.Sprintf("select %v from %v where (%v) in (%v)", columnList(r.lookupSelect), sqlescape.EscapeID(r.lookupTable), columnList(columns), entryTuples(entries, matchKsid))
+	qr, err := r.query(ctx, si, query)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]bool)
+	for _, row := range qr.Rows {
+		existing[r.lookupRowEntry(row).key()] = true
+	}
+	return existing, nil
+}
+
+// ownerEntries returns the lookup entries of rows of the owner table.
+// The rows with a NULL value in the lookup columns have none.
+func (r *lookupRepair) ownerEntries(rows [][]sqltypes.Value) ([]lookupEntry, error) {
+	var entries []lookupEntry
+	var vindexValues [][]sqltypes.Value
+	for _, row := range rows {
+		e := lookupEntry{}
+		hasNull := false
+		for _, i := range r.ownerFromIdx {
+			hasNull = hasNull || row[i].IsNull()
+			e.from = append(e.from, row[i])
+		}
+		if hasNull {
+			continue
+		}
+		var values []sqltypes.Value
+		for _, i := range r.ownerVindexIdx {
+			values = append(values, row[i])
+		}
+		entries = append(entries, e)
+		vindexValues = append(vindexValues, values)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	destinations, err := vindexes.Map(r.primary.Vindex, nil, vindexValues)
+	if err != nil {
+		return nil, fmt.Errorf("cannot map the rows of %v with vindex %v: %v", r.ownerTable, r.primary.Name, err)
+	}
+	for i, d := range destinations {
+		ksid, ok := d.(key.DestinationKeyspaceID)
+		if !ok {
+			return nil, fmt.Errorf("vindex %v mapped %v to %v, not a keyspace id", r.primary.Name, vindexValues[i], d)
+		}
+		entries[i].ksid = ksid
+	}
+	return entries, nil
+}
+
+// lookupRowEntry returns the entry of a row of the lookup table.
+func (r *lookupRepair) lookupRowEntry(row []sqltypes.Value) lookupEntry {
+	e := lookupEntry{ksid: row[r.lookupToIdx].ToBytes()}
+	for _, i := range r.lookupFromIdx {
+		e.from = append(e.from, row[i])
+	}
+	return e
+}
+
+// lookupShard returns the shard of the lookup table for an entry.
+func (r *lookupRepair) lookupShard(e lookupEntry) (*topo.ShardInfo, error) {
+	if r.lookupRoute == nil {
+		return r.lookupShards[0], nil
+	}
+	values := append(append([]sqltypes.Value(nil), e.from...), ksidValue(e.ksid))
+	var routeValues []sqltypes.Value
+	for _, i := range r.lookupRouteIdx {
+		routeValues = append(routeValues, values[i])
+	}
+	destinations, err := vindexes.Map(r.lookupRoute.Vindex, nil, [][]sqltypes.Value{routeValues})
+	if err != nil {
+		return nil, fmt.Errorf("cannot map %v with vindex %v: %v", routeValues, r.lookupRoute.Name, err)
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return nil, fmt.Errorf("vindex %v mapped %v to %v, not a keyspace id", r.lookupRoute.Name, routeValues, destinations[0])
+	}
+	si := shardForKeyspaceID(r.lookupShards, ksid)
+	if si == nil {
+		return nil, fmt.Errorf("no shard of keyspace %v for keyspace id %v", r.lookupKeyspace, ksid)
+	}
+	return si, nil
+}
+
+// lookupColumns returns the from columns and the to column.
+func (r *lookupRepair) lookupColumns() []string {
+	return append(append([]string(nil), r.fromColumns...), r.toColumn)
+}
+
+// describe formats an entry for the logs.
+func (r *lookupRepair) describe(e lookupEntry) string {
+	return fmt.Sprintf("%v %v -> %v", r.fromColumns, e.from, key.DestinationKeyspaceID(e.ksid))
+}
+
+// query runs a read on the master of a shard.
+func (r *lookupRepair) query(ctx context.Context, si *topo.ShardInfo, query string) (*sqltypes.Result, error) {
+	qr, err := r.wr.ExecuteFetchAsDba(ctx, si.MasterAlias, query, lookupRepairMaxRows, false /* disableBinlogs */, false /* reloadSchema */)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.Proto3ToResult(qr), nil
+}
+
+// write runs a fix on the master of a shard, within the rate limit, and
+// returns the number of rows affected.
+func (r *lookupRepair) write(ctx context.Context, threadID int, si *topo.ShardInfo, query string) (uint64, error) {
+	for {
+		backoff := r.throttler.Throttle(threadID)
+		if backoff == throttler.NotThrottled {
+			break
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+	qr, err := r.wr.ExecuteFetchAsDba(ctx, si.MasterAlias, query, 0, false /* disableBinlogs */, false /* reloadSchema */)
+	if err != nil {
+		return 0, err
+	}
+	return qr.RowsAffected, nil
+}
+
+func (r *lookupRepair) count(counter *int64, n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	*counter += int64(n)
+}
+
+// shardForKeyspaceID returns the shard which contains a keyspace id, or
+// nil.
+func shardForKeyspaceID(shards []*topo.ShardInfo, ksid []byte) *topo.ShardInfo {
+	for _, si := range shards {
+		if key.KeyRangeContains(si.KeyRange, ksid) {
+			return si
+		}
+	}
+	return nil
+}
+
+func columnList(columns []string) string {
+	escaped := make([]string, len(columns))
+	for i, col := range columns {
+		escaped[i] = sqlescape.EscapeID(col)
+	}
+	return strings.Join(escaped, ", ")
+}
+
+func tuple(values []sqltypes.Value) string {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('(')
+	for i, v := range values {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		// The keyspace ids are binary, they are written in hex
+		// to keep the queries readable.
+		if v.IsBinary() {
+			fmt.Fprintf(buf, "X'%x'", v.ToBytes())
+			continue
+		}
+		v.EncodeSQL(buf)
+	}
+	buf.WriteByte(')')
+	return buf.String()
+}
+
+// entryTuples formats the from values of the entries, followed by their
+// keyspace ids if withKsid is set.
+func entryTuples(entries []lookupEntry, withKsid bool) string {
+	tuples := make([]string, len(entries))
+	for i, e := range entries {
+		values := e.from
+		if withKsid {
+			values = append(append([]sqltypes.Value(nil), e.from...), ksidValue(e.ksid))
+		}
+		tuples[i] = tuple(values)
+	}
+	return strings.Join(tuples, ", ")
+}
+
+func ksidValue(ksid []byte) sqltypes.Value {
+	return sqltypes.MakeTrusted(sqltypes.VarBinary, ksid)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// newTestLookupRepairEnv adds the lookup vindex corder_lookup of
+// targetks.corder, with its table in sourceks, to the materializer env.
+// The hash keyspace ids of the customers 1 and 2 are in -80, the one of
+// the customer 4 is in 80-.
+func newTestLookupRepairEnv(t *testing.T, tmc *testMaterializerTMClient) *Wrangler {
+	ctx := context.Background()
+	wr := newTestMaterializerEnv(t, tmc)
+	if err := wr.ts.SaveVSchema(ctx, "targetks", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"corder_lookup": {
+				Type: "lookup_unique",
+				Params: map[string]string{
+					"table": "sourceks.corder_lookup",
+					"from":  "id",
+					"to":    "keyspace_id",
+				},
+				Owner: "corder",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"corder": {ColumnVindexes: []*vschemapb.ColumnVindex{
+				{Column: "customer_id", Name: "hash"},
+				{Column: "id", Name: "corder_lookup"},
+			}},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := wr.ts.SaveVSchema(ctx, "sourceks", &vschemapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	corder := &tabletmanagerdatapb.TableDefinition{Name: "corder", PrimaryKeyColumns: []string{"id"}}
+	tmc.schemas[200] = []*tabletmanagerdatapb.TableDefinition{corder}
+	tmc.schemas[300] = []*tabletmanagerdatapb.TableDefinition{corder}
+	tmc.schemas[100] = []*tabletmanagerdatapb.TableDefinition{{Name: "corder_lookup", PrimaryKeyColumns: []string{"id"}}}
+
+	ksid1 := sqltypes.MakeTrusted(sqltypes.VarBinary, []byte("\x16k@\xb4J\xbaK\xd6"))
+	ksid2 := sqltypes.MakeTrusted(sqltypes.VarBinary, []byte("\x06\xe7\xea\"Βp\x8f"))
+	result := func(rows ...[]sqltypes.Value) *querypb.QueryResult {
+		qr := &sqltypes.Result{Rows: rows, RowsAffected: uint64(len(rows))}
+		if len(rows) > 0 {
+			for _, v := range rows[0] {
+				qr.Fields = append(qr.Fields, &querypb.Field{Type: v.Type()})
+			}
+		}
+		return sqltypes.ResultToProto3(qr)
+	}
+	// The order 10 is fine, the lookup row of the order 30 is orphaned,
+	// and the one of the order 20 is missing.
+	tmc.results[100] = map[string]*querypb.QueryResult{
+		"select `id`, `keyspace_id` from `corder_lookup` order by `id` limit 10": result(
+			[]sqltypes.Value{sqltypes.NewInt64(10), ksid1},
+			[]sqltypes.Value{sqltypes.NewInt64(30), ksid2},
+		),
+		"select `id`, `keyspace_id` from `corder_lookup` where (`id`, `keyspace_id`) in ((30, X'06e7ea22ce92708f'))": result(
+			[]sqltypes.Value{sqltypes.NewInt64(30), ksid2},
+		),
+		"select `id`, `keyspace_id` from `corder_lookup` where (`id`) in ((10))": result(
+			[]sqltypes.Value{sqltypes.NewInt64(10), ksid1},
+		),
+		"insert ignore into `corder_lookup`(`id`, `keyspace_id`) values (20, X'd2fd8867d50d2dfe')": {RowsAffected: 1},
+	}
+	tmc.results[200] = map[string]*querypb.QueryResult{
+		"select `id`, `customer_id` from `corder` order by `id` limit 10": result(
+			[]sqltypes.Value{sqltypes.NewInt64(10), sqltypes.NewInt64(1)},
+		),
+		"select `id`, `customer_id` from `corder` where (`id`) in ((10), (30))": result(
+			[]sqltypes.Value{sqltypes.NewInt64(10), sqltypes.NewInt64(1)},
+		),
+	}
+	tmc.results[300] = map[string]*querypb.QueryResult{
+		"select `id`, `customer_id` from `corder` order by `id` limit 10": result(
+			[]sqltypes.Value{sqltypes.NewInt64(20), sqltypes.NewInt64(4)},
+		),
+	}
+	return wr
+}
+
+// writes returns the deletes and the inserts which were run.
+func (tmc *testMaterializerTMClient) writes() map[uint32][]string {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	writes := make(map[uint32][]string)
+	for uid, queries := range tmc.queries {
+		for _, query := range queries {
+			if strings.HasPrefix(query, "delete") || strings.HasPrefix(query, "insert") {
+				writes[uid] = append(writes[uid], query)
+			}
+		}
+	}
+	return writes
+}
+
+func TestRepairLookupVindex(t *testing.T) {
+	tmc := newTestMaterializerTMClient()
+	wr := newTestLookupRepairEnv(t, tmc)
+
+	report, err := wr.RepairLookupVindex(context.Background(), "targetks", "corder_lookup", LookupRepairOptions{
+		ChunkSize:   10,
+		Concurrency: 2,
+		MaxTPS:      100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &LookupRepairReport{OwnerRows: 2, LookupRows: 2, Orphaned: 1, Missing: 1}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("report: %v, want %v", report, want)
+	}
+	wantWrites := map[uint32][]string{
+		100: {
+			"delete from `corder_lookup` where (`id`, `keyspace_id`) in ((30, X'06e7ea22ce92708f'))",
+			"insert ignore into `corder_lookup`(`id`, `keyspace_id`) values (20, X'd2fd8867d50d2dfe')",
+		},
+	}
+	if writes := tmc.writes(); !reflect.DeepEqual(writes, wantWrites) {
+		t.Errorf("writes:\n%v, want\n%v", writes, wantWrites)
+	}
+}
+
+func TestRepairLookupVindexDryRun(t *testing.T) {
+	tmc := newTestMaterializerTMClient()
+	wr := newTestLookupRepairEnv(t, tmc)
+
+	report, err := wr.RepairLookupVindex(context.Background(), "targetks", "corder_lookup", LookupRepairOptions{
+		ChunkSize:   10,
+		Concurrency: 1,
+		DryRun:      true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Orphaned != 1 || report.Missing != 1 {
+		t.Errorf("report: %v, want 1 orphaned and 1 missing row", report)
+	}
+	if writes := tmc.writes(); len(writes) != 0 {
+		t.Errorf("writes of a dry run: %v, want none", writes)
+	}
+}
+
+func TestRepairLookupVindexErrors(t *testing.T) {
+	tmc := newTestMaterializerTMClient()
+	wr := newTestLookupRepairEnv(t, tmc)
+	opts := LookupRepairOptions{ChunkSize: 10, Concurrency: 1}
+
+	testcases := []struct {
+		vindex string
+		want   string
+	}{{
+		vindex: "hash",
+		want:   "vindex hash of type hash is not a lookup vindex",
+	}, {
+		vindex: "nonexistent",
+		want:   "no vindex nonexistent in keyspace targetks",
+	}}
+	for _, tc := range testcases {
+		_, err := wr.RepairLookupVindex(context.Background(), "targetks", tc.vindex, opts)
+		if err == nil || err.Error() != tc.want {
+			t.Errorf("RepairLookupVindex(%v): %v, want %v", tc.vindex, err, tc.want)
+		}
+	}
+}
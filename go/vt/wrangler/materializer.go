@@ -0,0 +1,330 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// moveTablesTarget is a target shard of MoveTables.
+type moveTablesTarget struct {
+	si      *topo.ShardInfo
+	master  *topo.TabletInfo
+	sources []*binlogdatapb.BinlogSource
+}
+
+// MoveTables starts a vreplication workflow that moves tables from
+// sourceKeyspace to targetKeyspace. tableSpecs is a comma-separated
+// list of tables.
+//
+// The tables are created in the target keyspace if they don't exist,
+// and routing rules are added to keep serving them from the source
+// keyspace. Each target shard then copies the rows of the tables from
+// the source shards that overlap with it, and keeps up with the changes
+// through filtered replication. Once the workflow has caught up, the
+// traffic is moved with MigrateReads and MigrateWrites, which can also
+// reverse it.
+func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs string) (err error) {
+	if sourceKeyspace == targetKeyspace {
+		return fmt.Errorf("source and target keyspaces must be different: %v", sourceKeyspace)
+	}
+	var tables []string
+	for _, table := range strings.Split(tableSpecs, ",") {
+		if table = strings.TrimSpace(table); table != "" {
+			tables = append(tables, table)
+		}
+	}
+	if len(tables) == 0 {
+		return fmt.Errorf("no tables to move")
+	}
+
+	rules, err := wr.getRoutingRules(ctx)
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if rr, ok := rules[table]; ok {
+			return fmt.Errorf("table %v already has a routing rule to %v, it may already be moving", table, rr)
+		}
+	}
+
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, targetKeyspace, "MoveTables")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	inKeyRange, err := wr.moveTablesVSchema(ctx, targetKeyspace, tables)
+	if err != nil {
+		return err
+	}
+	sourceShards, err := wr.ts.FindAllShardsInKeyspace(ctx, sourceKeyspace)
+	if err != nil {
+		return err
+	}
+	targetShards, err := wr.ts.FindAllShardsInKeyspace(ctx, targetKeyspace)
+	if err != nil {
+		return err
+	}
+
+	var sourceMaster *topo.TabletInfo
+	for _, si := range sourceShards {
+		if !si.HasMaster() {
+			return fmt.Errorf("source shard %v has no master", si.ShardName())
+		}
+		if sourceMaster == nil {
+			if sourceMaster, err = wr.ts.GetTablet(ctx, si.MasterAlias); err != nil {
+				return err
+			}
+		}
+	}
+	var targets []*moveTablesTarget
+	for _, targetsi := range targetShards {
+		if !targetsi.HasMaster() {
+			return fmt.Errorf("target shard %v has no master", targetsi.ShardName())
+		}
+		master, err := wr.ts.GetTablet(ctx, targetsi.MasterAlias)
+		if err != nil {
+			return err
+		}
+		target := &moveTablesTarget{
+			si:     targetsi,
+			master: master,
+		}
+		for _, sourcesi := range sourceShards {
+			if !key.KeyRangesIntersect(sourcesi.KeyRange, targetsi.KeyRange) {
+				continue
+			}
+			bls := &binlogdatapb.BinlogSource{
+				Keyspace: sourceKeyspace,
+				Shard:    sourcesi.ShardName(),
+				Filter:   &binlogdatapb.Filter{},
+			}
+			for _, table := range tables {
+				buf := sqlparser.NewTrackedBuffer(nil)
+				buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table))
+				if f, ok := inKeyRange[table]; ok {
+					buf.Myprintf(" where %s", f(key.KeyRangeString(targetsi.KeyRange)))
+				}
+				bls.Filter.Rules = append(bls.Filter.Rules, &binlogdatapb.Rule{
+					Match:  table,
+					Filter: buf.String(),
+				})
+			}
+			target.sources = append(target.sources, bls)
+		}
+		targets = append(targets, target)
+	}
+
+	if err := wr.forAllMoveTablesTargets(targets, func(target *moveTablesTarget) error {
+		query := fmt.Sprintf("select 1 from _vt.vreplication where db_name=%v and workflow=%v", encodeString(target.master.DbName()), encodeString(workflow))
+		p3qr, err := wr.tmc.VReplicationExec(ctx, target.master.Tablet, query)
+		if err != nil {
+			return err
+		}
+		if len(p3qr.Rows) != 0 {
+			return fmt.Errorf("workflow %v already exists", workflow)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := wr.deployMoveTablesSchema(ctx, sourceMaster, tables, targets); err != nil {
+		return err
+	}
+
+	// The tables keep being served from the source keyspace
+	// until MigrateReads and MigrateWrites are called.
+	for _, table := range tables {
+		rules[table] = []string{sourceKeyspace + "." + table}
+		rules[targetKeyspace+"."+table] = []string{sourceKeyspace + "." + table}
+	}
+	if err := wr.saveRoutingRules(ctx, rules); err != nil {
+		return err
+	}
+	if err := wr.ts.RebuildSrvVSchema(ctx, nil); err != nil {
+		return err
+	}
+
+	// The streams are created stopped, and only started once
+	// they all exist.
+	ids := make(map[*moveTablesTarget][]uint64)
+	var mu sync.Mutex
+	if err := wr.forAllMoveTablesTargets(targets, func(target *moveTablesTarget) error {
+		for _, bls := range target.sources {
+			query := binlogplayer.CreateVReplicationState(workflow, bls, "", binlogplayer.BlpStopped, target.master.DbName())
+			p3qr, err := wr.tmc.VReplicationExec(ctx, target.master.Tablet, query)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			ids[target] = append(ids[target], p3qr.InsertId)
+			mu.Unlock()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := wr.forAllMoveTablesTargets(targets, func(target *moveTablesTarget) error {
+		for _, id := range ids[target] {
+			if _, err := wr.tmc.VReplicationExec(ctx, target.master.Tablet, binlogplayer.StartVReplication(uint32(id))); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	wr.Logger().Infof("Started workflow %v moving %v from %v to %v", workflow, strings.Join(tables, ","), sourceKeyspace, targetKeyspace)
+	return nil
+}
+
+// moveTablesVSchema adds the tables to the vschema of an unsharded
+// target keyspace. For a sharded target keyspace, the tables must
+// already have a primary vindex, and it returns the functions
+// generating the in_keyrange filters of the tables.
+func (wr *Wrangler) moveTablesVSchema(ctx context.Context, targetKeyspace string, tables []string) (map[string]func(shard string) string, error) {
+	vs, err := wr.ts.GetVSchema(ctx, targetKeyspace)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		vs = &vschemapb.Keyspace{}
+	case err != nil:
+		return nil, err
+	}
+	if vs.Tables == nil {
+		vs.Tables = make(map[string]*vschemapb.Table)
+	}
+	if !vs.Sharded {
+		changed := false
+		for _, table := range tables {
+			if _, ok := vs.Tables[table]; !ok {
+				vs.Tables[table] = &vschemapb.Table{}
+				changed = true
+			}
+		}
+		if !changed {
+			return nil, nil
+		}
+		if err := wr.ts.SaveVSchema(ctx, targetKeyspace, vs); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	kschema, err := vindexes.BuildKeyspaceSchema(vs, targetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	inKeyRange := make(map[string]func(shard string) string)
+	for _, table := range tables {
+		t, ok := kschema.Tables[table]
+		if !ok || len(t.ColumnVindexes) == 0 {
+			return nil, fmt.Errorf("table %v must have a primary vindex in the vschema of the sharded keyspace %v", table, targetKeyspace)
+		}
+		cv := t.ColumnVindexes[0]
+		// The source tablets instantiate the vindex from its type
+		// alone, so it cannot have any parameters.
+		if len(cv.Columns) != 1 || !cv.Vindex.IsUnique() || cv.Vindex.Cost() > 1 || len(vs.Vindexes[cv.Name].Params) != 0 {
+			return nil, fmt.Errorf("the primary vindex %v of table %v must be a unique functional vindex on a single column without parameters", cv.Name, table)
+		}
+		column, vindexType := cv.Columns[0], vs.Vindexes[cv.Name].Type
+		inKeyRange[table] = func(shard string) string {
+			buf := sqlparser.NewTrackedBuffer(nil)
+			buf.Myprintf("in_keyrange(%v, %v, %v)", column, sqlparser.NewStrVal([]byte(vindexType)), sqlparser.NewStrVal([]byte(shard)))
+			return buf.String()
+		}
+	}
+	return inKeyRange, nil
+}
+
+// deployMoveTablesSchema creates the tables that don't exist yet on
+// the target masters, using their definition on a source master.
+func (wr *Wrangler) deployMoveTablesSchema(ctx context.Context, sourceMaster *topo.TabletInfo, tables []string, targets []*moveTablesTarget) error {
+	sourceSd, err := wr.GetSchema(ctx, sourceMaster.Alias, tables, nil, false /* includeViews */)
+	if err != nil {
+		return err
+	}
+	sourceDDLs := make(map[string]string)
+	for _, td := range sourceSd.TableDefinitions {
+		sourceDDLs[td.Name] = td.Schema
+	}
+	for _, table := range tables {
+		if _, ok := sourceDDLs[table]; !ok {
+			return fmt.Errorf("table %v not found in source keyspace %v", table, sourceMaster.Keyspace)
+		}
+	}
+
+	return wr.forAllMoveTablesTargets(targets, func(target *moveTablesTarget) error {
+		targetSd, err := wr.GetSchema(ctx, target.master.Alias, tables, nil, false /* includeViews */)
+		if err != nil {
+			return err
+		}
+		exists := make(map[string]bool)
+		for _, td := range targetSd.TableDefinitions {
+			exists[td.Name] = true
+		}
+		var ddls []string
+		for _, table := range tables {
+			if !exists[table] {
+				ddls = append(ddls, sourceDDLs[table])
+			}
+		}
+		if len(ddls) == 0 {
+			return nil
+		}
+		_, err = wr.tmc.ApplySchema(ctx, target.master.Tablet, &tmutils.SchemaChange{
+			SQL:              strings.Join(ddls, ";\n"),
+			Force:            true,
+			AllowReplication: true,
+		})
+		return err
+	})
+}
+
+// forAllMoveTablesTargets calls f for all the targets in parallel.
+func (wr *Wrangler) forAllMoveTablesTargets(targets []*moveTablesTarget, f func(*moveTablesTarget) error) error {
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, target := range targets {
+		wg.Add(1)
+		go func(target *moveTablesTarget) {
+			defer wg.Done()
+			if err := f(target); err != nil {
+				allErrors.RecordError(fmt.Errorf("%v: %v", target.si.ShardName(), err))
+			}
+		}(target)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
@@ -0,0 +1,402 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// testMaterializerTMClient fakes the schema and the vreplication
+// streams of the masters.
+type testMaterializerTMClient struct {
+	tmclient.TabletManagerClient
+
+	mu      sync.Mutex
+	schemas map[uint32][]*tabletmanagerdatapb.TableDefinition
+	results map[uint32]map[string]*querypb.QueryResult
+	applied map[uint32][]string
+	queries map[uint32][]string
+	lastID  map[uint32]uint64
+	// sources contains the sources of the inserted streams.
+	sources map[uint32][]*binlogdatapb.BinlogSource
+}
+
+func newTestMaterializerTMClient() *testMaterializerTMClient {
+	return &testMaterializerTMClient{
+		schemas: make(map[uint32][]*tabletmanagerdatapb.TableDefinition),
+		results: make(map[uint32]map[string]*querypb.QueryResult),
+		applied: make(map[uint32][]string),
+		queries: make(map[uint32][]string),
+		lastID:  make(map[uint32]uint64),
+		sources: make(map[uint32][]*binlogdatapb.BinlogSource),
+	}
+}
+
+func (tmc *testMaterializerTMClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	sd := &tabletmanagerdatapb.SchemaDefinition{}
+	for _, td := range tmc.schemas[tablet.Alias.Uid] {
+		for _, table := range tables {
+			if td.Name == table {
+				sd.TableDefinitions = append(sd.TableDefinitions, td)
+			}
+		}
+	}
+	return sd, nil
+}
+
+func (tmc *testMaterializerTMClient) ApplySchema(ctx context.Context, tablet *topodatapb.Tablet, change *tmutils.SchemaChange) (*tabletmanagerdatapb.SchemaChangeResult, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.applied[tablet.Alias.Uid] = append(tmc.applied[tablet.Alias.Uid], change.SQL)
+	return &tabletmanagerdatapb.SchemaChangeResult{}, nil
+}
+
+func (tmc *testMaterializerTMClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	uid := tablet.Alias.Uid
+	tmc.queries[uid] = append(tmc.queries[uid], query)
+	if strings.HasPrefix(query, "insert into _vt.vreplication") {
+		stmt, err := sqlparser.Parse(query)
+		if err != nil {
+			return nil, err
+		}
+		// The source is the second column.
+		source := stmt.(*sqlparser.Insert).Rows.(sqlparser.Values)[0][1].(*sqlparser.SQLVal).Val
+		bls := &binlogdatapb.BinlogSource{}
+		if err := proto.UnmarshalText(string(source), bls); err != nil {
+			return nil, err
+		}
+		tmc.sources[uid] = append(tmc.sources[uid], bls)
+		tmc.lastID[uid]++
+		return &querypb.QueryResult{InsertId: tmc.lastID[uid], RowsAffected: 1}, nil
+	}
+	if qr, ok := tmc.results[uid][query]; ok {
+		return qr, nil
+	}
+	return &querypb.QueryResult{}, nil
+}
+
+func (tmc *testMaterializerTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	uid := tablet.Alias.Uid
+	tmc.queries[uid] = append(tmc.queries[uid], string(query))
+	if qr, ok := tmc.results[uid][string(query)]; ok {
+		return qr, nil
+	}
+	return &querypb.QueryResult{}, nil
+}
+
+// newTestMaterializerEnv creates the unsharded keyspace sourceks, with
+// its master 100, and the sharded keyspace targetks, with the masters
+// 200 (-80) and 300 (80-).
+func newTestMaterializerEnv(t *testing.T, tmc *testMaterializerTMClient) *Wrangler {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	wr := New(logutil.NewConsoleLogger(), ts, tmc)
+	for _, tablet := range []*topodatapb.Tablet{{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "sourceks",
+		Shard:    "0",
+	}, {
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 200},
+		Keyspace: "targetks",
+		Shard:    "-80",
+	}, {
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 300},
+		Keyspace: "targetks",
+		Shard:    "80-",
+	}} {
+		tablet.Type = topodatapb.TabletType_MASTER
+		if err := wr.InitTablet(ctx, tablet, false /* allowMasterOverride */, true /* createShardAndKeyspace */, false /* allowUpdate */); err != nil {
+			t.Fatal(err)
+		}
+		alias := tablet.Alias
+		if _, err := ts.UpdateShardFields(ctx, tablet.Keyspace, tablet.Shard, func(si *topo.ShardInfo) error {
+			si.MasterAlias = alias
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ts.SaveVSchema(ctx, "targetks", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"customer": {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}}},
+			"corder":   {ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "customer_id", Name: "hash"}}},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return wr
+}
+
+func TestMoveTables(t *testing.T) {
+	ctx := context.Background()
+	tmc := newTestMaterializerTMClient()
+	tmc.schemas[100] = []*tabletmanagerdatapb.TableDefinition{
+		{Name: "customer", Schema: "create table customer(id bigint, primary key(id))"},
+		{Name: "corder", Schema: "create table corder(id bigint, customer_id bigint, primary key(id))"},
+	}
+	// corder already exists on -80.
+	tmc.schemas[200] = []*tabletmanagerdatapb.TableDefinition{
+		{Name: "corder", Schema: "create table corder(id bigint, customer_id bigint, primary key(id))"},
+	}
+	wr := newTestMaterializerEnv(t, tmc)
+
+	if err := wr.MoveTables(ctx, "move", "sourceks", "targetks", "customer, corder"); err != nil {
+		t.Fatal(err)
+	}
+
+	wantApplied := map[uint32][]string{
+		200: {"create table customer(id bigint, primary key(id))"},
+		300: {"create table customer(id bigint, primary key(id));\ncreate table corder(id bigint, customer_id bigint, primary key(id))"},
+	}
+	if !reflect.DeepEqual(tmc.applied, wantApplied) {
+		t.Errorf("applied schema:\n%v, want\n%v", tmc.applied, wantApplied)
+	}
+
+	rules, err := wr.getRoutingRules(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRules := map[string][]string{
+		"customer":          {"sourceks.customer"},
+		"targetks.customer": {"sourceks.customer"},
+		"corder":            {"sourceks.corder"},
+		"targetks.corder":   {"sourceks.corder"},
+	}
+	if !reflect.DeepEqual(rules, wantRules) {
+		t.Errorf("routing rules:\n%v, want\n%v", rules, wantRules)
+	}
+
+	// Each target shard copies the rows of its key range.
+	for uid, shard := range map[uint32]string{200: "-80", 300: "80-"} {
+		queries := tmc.queries[uid]
+		if len(queries) != 3 {
+			t.Fatalf("queries on %v: %v, want 3 queries", uid, queries)
+		}
+		if want := "select 1 from _vt.vreplication where db_name='vt_targetks' and workflow='move'"; queries[0] != want {
+			t.Errorf("queries[0] on %v: %v, want %v", uid, queries[0], want)
+		}
+		for _, want := range []string{"'move'", "'Stopped'", "'vt_targetks'"} {
+			if !strings.Contains(queries[1], want) {
+				t.Errorf("queries[1] on %v: %v, must contain %v", uid, queries[1], want)
+			}
+		}
+		wantSource := &binlogdatapb.BinlogSource{
+			Keyspace: "sourceks",
+			Shard:    "0",
+			Filter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "customer",
+					Filter: fmt.Sprintf("select * from customer where in_keyrange(id, 'hash', '%v')", shard),
+				}, {
+					Match:  "corder",
+					Filter: fmt.Sprintf("select * from corder where in_keyrange(customer_id, 'hash', '%v')", shard),
+				}},
+			},
+		}
+		if got := tmc.sources[uid]; len(got) != 1 || !proto.Equal(got[0], wantSource) {
+			t.Errorf("sources on %v: %v, want %v", uid, got, wantSource)
+		}
+		if want := "update _vt.vreplication set state='Running', stop_pos=NULL where id=1"; queries[2] != want {
+			t.Errorf("queries[2] on %v: %v, want %v", uid, queries[2], want)
+		}
+	}
+
+	// The tables cannot be moved twice.
+	err = wr.MoveTables(ctx, "move2", "sourceks", "targetks", "customer")
+	if err == nil || !strings.Contains(err.Error(), "already has a routing rule") {
+		t.Errorf("MoveTables: %v, must contain 'already has a routing rule'", err)
+	}
+}
+
+func TestMoveTablesInvalidVindex(t *testing.T) {
+	tmc := newTestMaterializerTMClient()
+	wr := newTestMaterializerEnv(t, tmc)
+	err := wr.MoveTables(context.Background(), "move", "sourceks", "targetks", "product")
+	if err == nil || !strings.Contains(err.Error(), "table product must have a primary vindex") {
+		t.Errorf("MoveTables: %v, must contain 'table product must have a primary vindex'", err)
+	}
+}
+
+func TestWorkflow(t *testing.T) {
+	ctx := context.Background()
+	tmc := newTestMaterializerTMClient()
+	tmc.schemas[100] = []*tabletmanagerdatapb.TableDefinition{
+		{Name: "customer", RowCount: 1000},
+	}
+	tmc.schemas[200] = []*tabletmanagerdatapb.TableDefinition{
+		{Name: "customer", RowCount: 300},
+	}
+	tmc.schemas[300] = []*tabletmanagerdatapb.TableDefinition{
+		{Name: "customer", RowCount: 500},
+	}
+	wr := newTestMaterializerEnv(t, tmc)
+	if err := wr.saveRoutingRules(ctx, map[string][]string{
+		"customer":          {"sourceks.customer"},
+		"targetks.customer": {"sourceks.customer"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: "sourceks",
+		Shard:    "0",
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "customer"}},
+		},
+	}
+	fields := sqltypes.MakeTestFields("id|source|pos|state|message|time_updated|transaction_timestamp", "int64|varchar|varchar|varchar|varchar|int64|int64")
+	streamsQuery := "select id, source, pos, state, message, time_updated, transaction_timestamp from _vt.vreplication where workflow='move' and db_name='vt_targetks'"
+	// -80 is still copying, 80- is replicating.
+	tmc.results[200] = map[string]*querypb.QueryResult{
+		streamsQuery: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields, fmt.Sprintf("1|%v||Running||1570000000|0", proto.CompactTextString(bls)))),
+		"select vrepl_id, table_name from _vt.copy_state where vrepl_id in (1)": sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("vrepl_id|table_name", "int64|varchar"), "1|customer")),
+	}
+	tmc.results[300] = map[string]*querypb.QueryResult{
+		streamsQuery: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields, fmt.Sprintf("1|%v|MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10|Running||1570000010|1570000007", proto.CompactTextString(bls)))),
+	}
+
+	ws, err := wr.ShowWorkflow(ctx, "targetks", "move")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &WorkflowStatus{
+		Workflow:       "move",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		Streams: []*StreamStatus{{
+			Shard:         "-80",
+			ID:            1,
+			Source:        "sourceks/0",
+			State:         "Running",
+			TimeUpdated:   time.Unix(1570000000, 0),
+			CopyingTables: []string{"customer"},
+		}, {
+			Shard:       "80-",
+			ID:          1,
+			Source:      "sourceks/0",
+			State:       "Running",
+			Position:    "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10",
+			TimeUpdated: time.Unix(1570000010, 0),
+			Lag:         3 * time.Second,
+		}},
+		Tables: []*TableCopyStatus{{
+			Table:      "customer",
+			SourceRows: 1000,
+			TargetRows: 800,
+			Copying:    true,
+		}},
+	}
+	if !reflect.DeepEqual(ws, want) {
+		t.Errorf("ShowWorkflow:\n%+v, want\n%+v", ws, want)
+	}
+
+	if err := wr.WorkflowAction(ctx, "targetks", "move", "stop"); err != nil {
+		t.Fatal(err)
+	}
+	wantQuery := "update _vt.vreplication set state='Stopped', message='stopped by WorkflowAction' where workflow='move' and db_name='vt_targetks'"
+	if got := tmc.queries[300][len(tmc.queries[300])-1]; got != wantQuery {
+		t.Errorf("stop: %v, want %v", got, wantQuery)
+	}
+	if err := wr.WorkflowAction(ctx, "targetks", "move", "retry"); err != nil {
+		t.Fatal(err)
+	}
+	wantQuery = "update _vt.vreplication set state='Running', message='' where workflow='move' and state='Error' and db_name='vt_targetks'"
+	if got := tmc.queries[300][len(tmc.queries[300])-1]; got != wantQuery {
+		t.Errorf("retry: %v, want %v", got, wantQuery)
+	}
+	if err := wr.WorkflowAction(ctx, "targetks", "move", "resume"); err == nil {
+		t.Errorf("WorkflowAction(resume) succeeded")
+	}
+
+	// Deleting the workflow removes its routing rules.
+	if err := wr.WorkflowAction(ctx, "targetks", "move", "delete"); err != nil {
+		t.Fatal(err)
+	}
+	wantQuery = "delete from _vt.vreplication where workflow='move' and db_name='vt_targetks'"
+	if got := tmc.queries[200][len(tmc.queries[200])-1]; got != wantQuery {
+		t.Errorf("delete: %v, want %v", got, wantQuery)
+	}
+	rules, err := wr.getRoutingRules(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 0 {
+		t.Errorf("routing rules: %v, want none", rules)
+	}
+}
+
+func TestListWorkflows(t *testing.T) {
+	ctx := context.Background()
+	tmc := newTestMaterializerTMClient()
+	wr := newTestMaterializerEnv(t, tmc)
+
+	fields := sqltypes.MakeTestFields("workflow", "varbinary")
+	query := "select distinct workflow from _vt.vreplication where db_name='vt_targetks'"
+	tmc.results[200] = map[string]*querypb.QueryResult{
+		query: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields, "move", "reshard")),
+	}
+	tmc.results[300] = map[string]*querypb.QueryResult{
+		query: sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields, "move")),
+	}
+	got, err := wr.ListWorkflows(ctx, "targetks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"move", "reshard"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListWorkflows: %v, want %v", got, want)
+	}
+
+	got, err = wr.ListWorkflows(ctx, "sourceks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("ListWorkflows(sourceks): %v, want none", got)
+	}
+}
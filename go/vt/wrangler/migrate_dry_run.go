@@ -0,0 +1,58 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// MigrateServedTypesDryRun returns the actions MigrateServedTypes would
+// take with the same arguments. It runs MigrateServedTypes with a dry
+// run wrangler, which does not wait for the queries to drain.
+func (wr *Wrangler) MigrateServedTypesDryRun(ctx context.Context, keyspace, shard string, cells []string, servedType topodatapb.TabletType, reverse, skipReFreshState bool, filteredReplicationWaitTime time.Duration, reverseReplication bool) (*DryRunPlan, error) {
+	plan := &DryRunPlan{}
+	if err := wr.dryRun(plan).MigrateServedTypes(ctx, keyspace, shard, cells, servedType, reverse, skipReFreshState, filteredReplicationWaitTime, reverseReplication); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// MigrateReadsDryRun returns the actions MigrateReads would take with
+// the same arguments, running MigrateReads with a dry run wrangler.
+func (wr *Wrangler) MigrateReadsDryRun(ctx context.Context, targetKeyspace, workflow string, servedType topodatapb.TabletType, cells []string, direction MigrateDirection) (*DryRunPlan, error) {
+	plan := &DryRunPlan{}
+	if err := wr.dryRun(plan).MigrateReads(ctx, targetKeyspace, workflow, servedType, cells, direction); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// MigrateWritesDryRun returns the actions MigrateWrites would take with
+// the same arguments. It runs MigrateWrites with a dry run wrangler, in
+// which the streams that are stopped for the cutover are assumed to
+// reach the positions they are synchronized to.
+func (wr *Wrangler) MigrateWritesDryRun(ctx context.Context, targetKeyspace, workflow string, filteredReplicationWaitTime time.Duration, cancelMigrate, reverseReplication bool) (*DryRunPlan, error) {
+	plan := &DryRunPlan{}
+	if _, err := wr.dryRun(plan).MigrateWrites(ctx, targetKeyspace, workflow, filteredReplicationWaitTime, cancelMigrate, reverseReplication); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
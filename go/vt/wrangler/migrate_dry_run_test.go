@@ -0,0 +1,219 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// checkDryRunPlan checks the steps of plan start with want. Each group
+// of want lists the steps that are run concurrently, in any order.
+func checkDryRunPlan(t *testing.T, plan *DryRunPlan, want [][]string) {
+	t.Helper()
+	steps := plan.Steps()
+	count := 0
+	for _, group := range want {
+		count += len(group)
+	}
+	if len(steps) != count {
+		t.Fatalf("got %v steps, want %v:\n%v", len(steps), count, plan)
+	}
+	for _, group := range want {
+		groupSteps := steps[:len(group)]
+		steps = steps[len(group):]
+		for _, prefix := range group {
+			found := false
+			for i, step := range groupSteps {
+				if strings.HasPrefix(step, prefix) {
+					groupSteps = append(groupSteps[:i:i], groupSteps[i+1:]...)
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Errorf("no step starts with %q:\n%v", prefix, plan)
+			}
+		}
+	}
+}
+
+func TestMigrateReadsDryRun(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+
+	plan, err := tme.wr.MigrateReadsDryRun(ctx, tme.targetKeyspace, "test", topodatapb.TabletType_RDONLY, []string{"cell1"}, DirectionForward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDryRunPlan(t, plan, [][]string{
+		{"Lock keyspaces/ks1 in the global topo"},
+		{"Update RoutingRules in the global topo"},
+		{"Update SrvVSchema in cell cell1"},
+		{"Unlock keyspaces/ks1 in the global topo"},
+	})
+	checkCellRouting(t, tme.wr, "cell1", map[string][]string{
+		"t1":     {"ks1.t1"},
+		"ks2.t1": {"ks1.t1"},
+		"t2":     {"ks1.t2"},
+		"ks2.t2": {"ks1.t2"},
+	})
+
+	// MigrateWrites is refused before the reads are migrated.
+	if _, err := tme.wr.MigrateWritesDryRun(ctx, tme.targetKeyspace, "test", 1*time.Second, false, true); err == nil {
+		t.Errorf("MigrateWritesDryRun succeeded before MigrateReads")
+	}
+}
+
+func TestShardMigrateReadsDryRun(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestShardMigrater(ctx, t, []string{"-40", "40-"}, []string{"-80", "80-"})
+	defer tme.stopTablets(t)
+
+	plan, err := tme.wr.MigrateReadsDryRun(ctx, tme.targetKeyspace, "test", topodatapb.TabletType_REPLICA, nil, DirectionForward)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srvKeyspaces := []string{
+		"Update keyspaces/ks/SrvKeyspace in cell cell1",
+		"Update keyspaces/ks/SrvKeyspace in cell cell2",
+	}
+	checkDryRunPlan(t, plan, [][]string{
+		{"Lock keyspaces/ks in the global topo"},
+		srvKeyspaces,
+		{
+			"Update keyspaces/ks/shards/-40/Shard in the global topo",
+			"Update keyspaces/ks/shards/40-/Shard in the global topo",
+		},
+		srvKeyspaces,
+		{
+			"Update keyspaces/ks/shards/-80/Shard in the global topo",
+			"Update keyspaces/ks/shards/80-/Shard in the global topo",
+		},
+		srvKeyspaces,
+		{"Unlock keyspaces/ks in the global topo"},
+	})
+	checkServedTypes(t, tme.ts, "ks:-40", 3)
+	checkServedTypes(t, tme.ts, "ks:-80", 0)
+}
+
+func TestMigrateWritesDryRun(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+
+	for _, servedType := range []topodatapb.TabletType{topodatapb.TabletType_RDONLY, topodatapb.TabletType_REPLICA} {
+		if err := tme.wr.MigrateReads(ctx, tme.targetKeyspace, "test", servedType, nil, DirectionForward); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tme.dbSourceClients[0].addQuery("select val from _vt.resharding_journal where id=7672494164556733923", &sqltypes.Result{}, nil)
+	tme.dbSourceClients[1].addQuery("select val from _vt.resharding_journal where id=7672494164556733923", &sqltypes.Result{}, nil)
+
+	plan, err := tme.wr.MigrateWritesDryRun(ctx, tme.targetKeyspace, "test", 1*time.Second, false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDryRunPlan(t, plan, [][]string{
+		{"Lock keyspaces/ks1 in the global topo"},
+		{"Lock keyspaces/ks2 in the global topo"},
+		{
+			"Update keyspaces/ks1/shards/-40/Shard in the global topo",
+			"Update keyspaces/ks1/shards/40-/Shard in the global topo",
+			"cell1-0000000010: RefreshState: re-read the tablet record and the shard",
+			"cell1-0000000020: RefreshState: re-read the tablet record and the shard",
+		},
+		{
+			"cell1-0000000030: VReplicationWaitForPos: wait for stream 1 to reach position MariaDB/5-456-892",
+			"cell1-0000000030: VReplicationExec: update _vt.vreplication set state='Stopped', message='stopped for cutover' where id=1",
+			"cell1-0000000030: VReplicationWaitForPos: wait for stream 2 to reach position MariaDB/5-456-892",
+			"cell1-0000000030: VReplicationExec: update _vt.vreplication set state='Stopped', message='stopped for cutover' where id=2",
+			"cell1-0000000040: VReplicationWaitForPos: wait for stream 1 to reach position MariaDB/5-456-892",
+			"cell1-0000000040: VReplicationExec: update _vt.vreplication set state='Stopped', message='stopped for cutover' where id=1",
+			"cell1-0000000040: VReplicationWaitForPos: wait for stream 2 to reach position MariaDB/5-456-892",
+			"cell1-0000000040: VReplicationExec: update _vt.vreplication set state='Stopped', message='stopped for cutover' where id=2",
+		},
+		{
+			"cell1-0000000010: VReplicationExec: delete from _vt.vreplication where db_name='vt_ks1' and workflow='test_reverse'",
+			"cell1-0000000010: VReplicationExec: insert into _vt.vreplication (workflow, source, pos,",
+			"cell1-0000000010: VReplicationExec: insert into _vt.vreplication (workflow, source, pos,",
+			"cell1-0000000020: VReplicationExec: delete from _vt.vreplication where db_name='vt_ks1' and workflow='test_reverse'",
+			"cell1-0000000020: VReplicationExec: insert into _vt.vreplication (workflow, source, pos,",
+			"cell1-0000000020: VReplicationExec: insert into _vt.vreplication (workflow, source, pos,",
+		},
+		{
+			"cell1-0000000010: VReplicationExec: insert into _vt.resharding_journal (id, db_name, val) values (7672494164556733923, 'vt_ks1',",
+			"cell1-0000000020: VReplicationExec: insert into _vt.resharding_journal (id, db_name, val) values (7672494164556733923, 'vt_ks1',",
+		},
+		{
+			"Update keyspaces/ks2/shards/-80/Shard in the global topo",
+			"Update keyspaces/ks2/shards/80-/Shard in the global topo",
+			"cell1-0000000030: RefreshState: re-read the tablet record and the shard",
+			"cell1-0000000040: RefreshState: re-read the tablet record and the shard",
+		},
+		{"Update RoutingRules in the global topo"},
+		{
+			"Update SrvVSchema in cell cell1",
+			"Update SrvVSchema in cell cell2",
+		},
+		{
+			"cell1-0000000010: VReplicationExec: update _vt.vreplication set state='Running', message='' where db_name='vt_ks1'",
+			"cell1-0000000020: VReplicationExec: update _vt.vreplication set state='Running', message='' where db_name='vt_ks1'",
+		},
+		{
+			"cell1-0000000030: VReplicationExec: update _vt.vreplication set message = 'FROZEN' where db_name='vt_ks2' and workflow='test'",
+			"cell1-0000000040: VReplicationExec: update _vt.vreplication set message = 'FROZEN' where db_name='vt_ks2' and workflow='test'",
+		},
+		{
+			"cell1-0000000030: VReplicationExec: delete from _vt.vreplication where db_name='vt_ks2' and workflow='test'",
+			"cell1-0000000040: VReplicationExec: delete from _vt.vreplication where db_name='vt_ks2' and workflow='test'",
+		},
+		{"Unlock keyspaces/ks2 in the global topo"},
+		{"Unlock keyspaces/ks1 in the global topo"},
+	})
+
+	// Only the journals were read.
+	verifyQueries(t, tme.allDBClients)
+	checkBlacklist(t, tme.ts, "ks1:-40", nil)
+	checkBlacklist(t, tme.ts, "ks2:-80", nil)
+	checkRouting(t, tme.wr, map[string][]string{
+		"t1":             {"ks1.t1"},
+		"ks2.t1":         {"ks1.t1"},
+		"t2":             {"ks1.t2"},
+		"ks2.t2":         {"ks1.t2"},
+		"t1@replica":     {"ks2.t1"},
+		"ks2.t1@replica": {"ks2.t1"},
+		"ks1.t1@replica": {"ks2.t1"},
+		"t2@replica":     {"ks2.t2"},
+		"ks2.t2@replica": {"ks2.t2"},
+		"ks1.t2@replica": {"ks2.t2"},
+		"t1@rdonly":      {"ks2.t1"},
+		"ks2.t1@rdonly":  {"ks2.t1"},
+		"ks1.t1@rdonly":  {"ks2.t1"},
+		"t2@rdonly":      {"ks2.t2"},
+		"ks2.t2@rdonly":  {"ks2.t2"},
+		"ks1.t2@rdonly":  {"ks2.t2"},
+	})
+}
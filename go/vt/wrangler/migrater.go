@@ -99,19 +99,8 @@ type miSource struct {
 
 // MigrateReads is a generic way of migrating read traffic for a resharding workflow.
 func (wr *Wrangler) MigrateReads(ctx context.Context, targetKeyspace, workflow string, servedType topodatapb.TabletType, cells []string, direction MigrateDirection) error {
-	if servedType != topodatapb.TabletType_REPLICA && servedType != topodatapb.TabletType_RDONLY {
-		return fmt.Errorf("tablet type must be REPLICA or RDONLY: %v", servedType)
-	}
-	mi, err := wr.buildMigrater(ctx, targetKeyspace, workflow)
+	mi, err := wr.buildReadsMigrater(ctx, targetKeyspace, workflow, servedType)
 	if err != nil {
-		wr.Logger().Errorf("buildMigrater failed: %v", err)
-		return err
-	}
-	if mi.frozen {
-		return fmt.Errorf("cannot migrate reads while MigrateWrites is in progress")
-	}
-	if err := mi.validate(ctx, false /* isWrite */); err != nil {
-		mi.wr.Logger().Errorf("validate failed: %v", err)
 		return err
 	}
 
@@ -137,11 +126,31 @@ func (wr *Wrangler) MigrateReads(ctx context.Context, targetKeyspace, workflow s
 	return nil
 }
 
-// MigrateWrites is a generic way of migrating write traffic for a resharding workflow.
-func (wr *Wrangler) MigrateWrites(ctx context.Context, targetKeyspace, workflow string, filteredReplicationWaitTime time.Duration, cancelMigrate, reverseReplication bool) (journalID int64, err error) {
+// buildReadsMigrater returns the migrater of MigrateReads, after
+// validating the migration.
+func (wr *Wrangler) buildReadsMigrater(ctx context.Context, targetKeyspace, workflow string, servedType topodatapb.TabletType) (*migrater, error) {
+	if servedType != topodatapb.TabletType_REPLICA && servedType != topodatapb.TabletType_RDONLY {
+		return nil, fmt.Errorf("tablet type must be REPLICA or RDONLY: %v", servedType)
+	}
 	mi, err := wr.buildMigrater(ctx, targetKeyspace, workflow)
 	if err != nil {
 		wr.Logger().Errorf("buildMigrater failed: %v", err)
+		return nil, err
+	}
+	if mi.frozen {
+		return nil, fmt.Errorf("cannot migrate reads while MigrateWrites is in progress")
+	}
+	if err := mi.validate(ctx, false /* isWrite */); err != nil {
+		mi.wr.Logger().Errorf("validate failed: %v", err)
+		return nil, err
+	}
+	return mi, nil
+}
+
+// MigrateWrites is a generic way of migrating write traffic for a resharding workflow.
+func (wr *Wrangler) MigrateWrites(ctx context.Context, targetKeyspace, workflow string, filteredReplicationWaitTime time.Duration, cancelMigrate, reverseReplication bool) (journalID int64, err error) {
+	mi, err := wr.buildWritesMigrater(ctx, targetKeyspace, workflow)
+	if err != nil {
 		return 0, err
 	}
 	if mi.frozen {
@@ -153,12 +162,6 @@ func (wr *Wrangler) MigrateWrites(ctx context.Context, targetKeyspace, workflow
 		return 0, nil
 	}
 
-	mi.wr.Logger().Infof("Built migration metadata: %+v", mi)
-	if err := mi.validate(ctx, true /* isWrite */); err != nil {
-		mi.wr.Logger().Errorf("validate failed: %v", err)
-		return 0, err
-	}
-
 	// Need to lock both source and target keyspaces.
 	ctx, sourceUnlock, lockErr := wr.ts.LockKeyspace(ctx, mi.sourceKeyspace, "MigrateWrites")
 	if lockErr != nil {
@@ -264,6 +267,26 @@ func (wr *Wrangler) MigrateWrites(ctx context.Context, targetKeyspace, workflow
 	return mi.id, nil
 }
 
+// buildWritesMigrater returns the migrater of MigrateWrites. Unless the
+// migration is frozen, it also validates the migration.
+func (wr *Wrangler) buildWritesMigrater(ctx context.Context, targetKeyspace, workflow string) (*migrater, error) {
+	mi, err := wr.buildMigrater(ctx, targetKeyspace, workflow)
+	if err != nil {
+		wr.Logger().Errorf("buildMigrater failed: %v", err)
+		return nil, err
+	}
+	if mi.frozen {
+		return mi, nil
+	}
+
+	mi.wr.Logger().Infof("Built migration metadata: %+v", mi)
+	if err := mi.validate(ctx, true /* isWrite */); err != nil {
+		mi.wr.Logger().Errorf("validate failed: %v", err)
+		return nil, err
+	}
+	return mi, nil
+}
+
 func (wr *Wrangler) buildMigrater(ctx context.Context, targetKeyspace, workflow string) (*migrater, error) {
 	targets, frozen, err := wr.buildMigrationTargets(ctx, targetKeyspace, workflow)
 	if err != nil {
@@ -26,7 +26,8 @@ import (
 	"sync"
 	"time"
 
-	"vitess.io/vitess/go/event"
+	"github.com/golang/protobuf/proto"
+
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -148,9 +149,9 @@ func (wr *Wrangler) InitShardMaster(ctx context.Context, keyspace, shard string,
 	// do the work
 	err = wr.initShardMasterLocked(ctx, ev, keyspace, shard, masterElectTabletAlias, force, waitReplicasTimeout)
 	if err != nil {
-		event.DispatchUpdate(ev, "failed InitShardMaster: "+err.Error())
+		wr.dispatchUpdate(ev, "failed InitShardMaster: "+err.Error())
 	} else {
-		event.DispatchUpdate(ev, "finished InitShardMaster")
+		wr.dispatchUpdate(ev, "finished InitShardMaster")
 	}
 	return err
 }
@@ -162,7 +163,7 @@ func (wr *Wrangler) initShardMasterLocked(ctx context.Context, ev *events.Repare
 	}
 	ev.ShardInfo = *shardInfo
 
-	event.DispatchUpdate(ev, "reading tablet map")
+	wr.dispatchUpdate(ev, "reading tablet map")
 	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
 	if err != nil {
 		return err
@@ -203,6 +204,10 @@ func (wr *Wrangler) initShardMasterLocked(ctx context.Context, ev *events.Repare
 		wr.logger.Warningf("master-elect tablet %v is not the only master in the shard, proceeding anyway as -force was used", topoproto.TabletAliasString(masterElectTabletAlias))
 	}
 
+	if err := wr.checkDurability(ctx, keyspace, masterElectTabletAlias, tabletMap, nil); err != nil {
+		return err
+	}
+
 	// First phase: reset replication on all tablets. If anyone fails,
 	// we stop. It is probably because it is unreachable, and may leave
 	// an unstable database process in the mix, with a database daemon
@@ -212,7 +217,7 @@ func (wr *Wrangler) initShardMasterLocked(ctx context.Context, ev *events.Repare
 	resetCtx, resetCancel := context.WithTimeout(ctx, waitReplicasTimeout)
 	defer resetCancel()
 
-	event.DispatchUpdate(ev, "resetting replication on all tablets")
+	wr.dispatchUpdate(ev, "resetting replication on all tablets")
 	wg := sync.WaitGroup{}
 	rec := concurrency.AllErrorRecorder{}
 	for alias, tabletInfo := range tabletMap {
@@ -239,7 +244,7 @@ func (wr *Wrangler) initShardMasterLocked(ctx context.Context, ev *events.Repare
 	// Tell the new master to break its slaves, return its replication
 	// position
 	wr.logger.Infof("initializing master on %v", topoproto.TabletAliasString(masterElectTabletAlias))
-	event.DispatchUpdate(ev, "initializing master")
+	wr.dispatchUpdate(ev, "initializing master")
 	rp, err := wr.tmc.InitMaster(ctx, masterElectTabletInfo.Tablet)
 	if err != nil {
 		return err
@@ -261,7 +266,7 @@ func (wr *Wrangler) initShardMasterLocked(ctx context.Context, ev *events.Repare
 	// We start all these in parallel, to handle the semi-sync
 	// case: for the master to be able to commit its row in the
 	// reparent_journal table, it needs connected slaves.
-	event.DispatchUpdate(ev, "reparenting all tablets")
+	wr.dispatchUpdate(ev, "reparenting all tablets")
 	now := time.Now().UnixNano()
 	wgMaster := sync.WaitGroup{}
 	wgSlaves := sync.WaitGroup{}
@@ -331,7 +336,9 @@ func (wr *Wrangler) initShardMasterLocked(ctx context.Context, ev *events.Repare
 
 // PlannedReparentShard will make the provided tablet the master for the shard,
 // when both the current and new master are reachable and in good shape.
-func (wr *Wrangler) PlannedReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias, avoidMasterAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) (err error) {
+// If checks is not nil, the reparent is aborted when one of its checks fails,
+// unless it is forced.
+func (wr *Wrangler) PlannedReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias, avoidMasterAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration, checks *ReparentChecks) (err error) {
 	// lock the shard
 	lockAction := fmt.Sprintf(
 		"PlannedReparentShard(%v, avoid_master=%v)",
@@ -346,72 +353,117 @@ func (wr *Wrangler) PlannedReparentShard(ctx context.Context, keyspace, shard st
 	// Create reusable Reparent event with available info
 	ev := &events.Reparent{}
 
-	// Attempt to set avoidMasterAlias if not provided by parameters
-	if masterElectTabletAlias == nil && avoidMasterAlias == nil {
-		shardInfo, err := wr.ts.GetShard(ctx, keyspace, shard)
-		if err != nil {
-			return err
-		}
-		avoidMasterAlias = shardInfo.MasterAlias
-	}
-
 	// do the work
-	err = wr.plannedReparentShardLocked(ctx, ev, keyspace, shard, masterElectTabletAlias, avoidMasterAlias, waitReplicasTimeout)
+	err = wr.plannedReparentShardLocked(ctx, ev, keyspace, shard, masterElectTabletAlias, avoidMasterAlias, waitReplicasTimeout, checks)
 	if err != nil {
-		event.DispatchUpdate(ev, "failed PlannedReparentShard: "+err.Error())
+		wr.dispatchUpdate(ev, "failed PlannedReparentShard: "+err.Error())
 	} else {
-		event.DispatchUpdate(ev, "finished PlannedReparentShard")
+		wr.dispatchUpdate(ev, "finished PlannedReparentShard")
 	}
 	return err
 }
 
-func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.Reparent, keyspace, shard string, masterElectTabletAlias, avoidMasterTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) error {
+// plannedReparent is the state of a shard a planned reparent acts on,
+// once its inputs are validated.
+type plannedReparent struct {
+	shardInfo     *topo.ShardInfo
+	tabletMap     map[string]*topo.TabletInfo
+	masterElect   *topo.TabletInfo
+	currentMaster *topo.TabletInfo
+}
+
+// validatePlannedReparent reads the shard, chooses the master-elect if
+// it is not provided, and runs the checks of a planned reparent. It
+// returns nil if there is nothing to do.
+func (wr *Wrangler) validatePlannedReparent(ctx context.Context, ev *events.Reparent, keyspace, shard string, masterElectTabletAlias, avoidMasterTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration, checks *ReparentChecks) (*plannedReparent, error) {
 	shardInfo, err := wr.ts.GetShard(ctx, keyspace, shard)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	ev.ShardInfo = *shardInfo
 
-	event.DispatchUpdate(ev, "reading tablet map")
+	// Attempt to set avoidMasterAlias if not provided by parameters
+	if masterElectTabletAlias == nil && avoidMasterTabletAlias == nil {
+		avoidMasterTabletAlias = shardInfo.MasterAlias
+	}
+
+	wr.dispatchUpdate(ev, "reading tablet map")
 	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Check invariants we're going to depend on.
 	if topoproto.TabletAliasEqual(masterElectTabletAlias, avoidMasterTabletAlias) {
-		return fmt.Errorf("master-elect tablet %v is the same as the tablet to avoid", topoproto.TabletAliasString(masterElectTabletAlias))
+		return nil, fmt.Errorf("master-elect tablet %v is the same as the tablet to avoid", topoproto.TabletAliasString(masterElectTabletAlias))
 	}
 	if masterElectTabletAlias == nil {
 		if !topoproto.TabletAliasEqual(avoidMasterTabletAlias, shardInfo.MasterAlias) {
-			event.DispatchUpdate(ev, "current master is different than -avoid_master, nothing to do")
-			return nil
+			wr.dispatchUpdate(ev, "current master is different than -avoid_master, nothing to do")
+			wr.planf("Nothing to do: %v is not the master of %v/%v", topoproto.TabletAliasString(avoidMasterTabletAlias), keyspace, shard)
+			return nil, nil
 		}
-		event.DispatchUpdate(ev, "searching for master candidate")
+		wr.dispatchUpdate(ev, "searching for master candidate")
 		masterElectTabletAlias, err = wr.chooseNewMaster(ctx, shardInfo, tabletMap, avoidMasterTabletAlias, waitReplicasTimeout)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if masterElectTabletAlias == nil {
-			return fmt.Errorf("cannot find a tablet to reparent to")
+			return nil, fmt.Errorf("cannot find a tablet to reparent to")
 		}
 		wr.logger.Infof("elected new master candidate %v", topoproto.TabletAliasString(masterElectTabletAlias))
-		event.DispatchUpdate(ev, "elected new master candidate")
+		wr.dispatchUpdate(ev, "elected new master candidate")
 	}
 	masterElectTabletAliasStr := topoproto.TabletAliasString(masterElectTabletAlias)
 	masterElectTabletInfo, ok := tabletMap[masterElectTabletAliasStr]
 	if !ok {
-		return fmt.Errorf("master-elect tablet %v is not in the shard", masterElectTabletAliasStr)
+		return nil, fmt.Errorf("master-elect tablet %v is not in the shard", masterElectTabletAliasStr)
 	}
+	wr.planf("Use %v as the master-elect", masterElectTabletAliasStr)
 	ev.NewMaster = *masterElectTabletInfo.Tablet
 	if topoproto.TabletAliasIsZero(shardInfo.MasterAlias) {
-		return fmt.Errorf("the shard has no master, use EmergencyReparentShard")
+		return nil, fmt.Errorf("the shard has no master, use EmergencyReparentShard")
 	}
 
 	// Find the current master (if any) based on the tablet states. We no longer
 	// trust the shard record for this, because it is updated asynchronously.
 	currentMaster := wr.findCurrentMaster(tabletMap)
 
+	// The current master replicates from the master-elect once demoted.
+	if err := wr.checkDurability(ctx, keyspace, masterElectTabletAlias, tabletMap, currentMaster); err != nil {
+		return nil, err
+	}
+
+	// Run the pre-flight checks before changing anything.
+	if checks != nil {
+		wr.dispatchUpdate(ev, "running pre-flight checks")
+		report := wr.checkReparent(ctx, checks, shardInfo, tabletMap, masterElectTabletInfo, currentMaster)
+		wr.logger.Printf("Pre-flight checks for %v/%v:\n%v", keyspace, shard, report)
+		if err := report.Error(); err != nil {
+			if !checks.Force {
+				return nil, err
+			}
+			wr.logger.Warningf("Reparenting anyway because of -force: %v", err)
+		}
+	}
+
+	return &plannedReparent{
+		shardInfo:     shardInfo,
+		tabletMap:     tabletMap,
+		masterElect:   masterElectTabletInfo,
+		currentMaster: currentMaster,
+	}, nil
+}
+
+func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.Reparent, keyspace, shard string, masterElectTabletAlias, avoidMasterTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration, checks *ReparentChecks) error {
+	pr, err := wr.validatePlannedReparent(ctx, ev, keyspace, shard, masterElectTabletAlias, avoidMasterTabletAlias, waitReplicasTimeout, checks)
+	if err != nil || pr == nil {
+		return err
+	}
+	shardInfo, tabletMap, masterElectTabletInfo, currentMaster := pr.shardInfo, pr.tabletMap, pr.masterElect, pr.currentMaster
+	masterElectTabletAlias = masterElectTabletInfo.Alias
+	masterElectTabletAliasStr := masterElectTabletInfo.AliasString()
+
 	var reparentJournalPos string
 
 	if currentMaster == nil {
@@ -584,7 +636,7 @@ func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.R
 		// Demote the old master and get its replication position. It's fine if
 		// the old master was already demoted, since DemoteMaster is idempotent.
 		wr.logger.Infof("demote current master %v", oldMasterTabletInfo.Alias)
-		event.DispatchUpdate(ev, "demoting old master")
+		wr.dispatchUpdate(ev, "demoting old master")
 
 		demoteCtx, demoteCancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
 		defer demoteCancel()
@@ -597,7 +649,7 @@ func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.R
 		// Wait on the master-elect tablet until it reaches that position,
 		// then promote it.
 		wr.logger.Infof("promote replica %v", masterElectTabletAliasStr)
-		event.DispatchUpdate(ev, "promoting replica")
+		wr.dispatchUpdate(ev, "promoting replica")
 
 		promoteCtx, promoteCancel := context.WithTimeout(ctx, waitReplicasTimeout)
 		defer promoteCancel()
@@ -629,7 +681,7 @@ func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.R
 	// Go through all the tablets:
 	// - new master: populate the reparent journal
 	// - everybody else: reparent to new master, wait for row
-	event.DispatchUpdate(ev, "reparenting all tablets")
+	wr.dispatchUpdate(ev, "reparenting all tablets")
 
 	// We add a (hopefully) unique record to the reparent journal table on the
 	// new master so we can check if replicas got it through replication.
@@ -686,6 +738,43 @@ func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.R
 	return nil
 }
 
+// checkDurability returns an error if the tablets of tabletMap cannot
+// provide the semi-sync acks the durability policy of the keyspace requires,
+// once masterElect is promoted. If demotedMaster is not nil, it is counted
+// as a REPLICA.
+func (wr *Wrangler) checkDurability(ctx context.Context, keyspace string, masterElect *topodatapb.TabletAlias, tabletMap map[string]*topo.TabletInfo, demotedMaster *topo.TabletInfo) error {
+	ki, err := wr.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	durability, err := topotools.ParseDurabilityPolicy(ki.DurabilityPolicy)
+	if err != nil {
+		return vterrors.Wrapf(err, "invalid durability policy for keyspace %v", keyspace)
+	}
+	if durability == nil {
+		return nil
+	}
+	if demotedMaster != nil && !topoproto.TabletAliasEqual(demotedMaster.Alias, masterElect) {
+		replica := proto.Clone(demotedMaster.Tablet).(*topodatapb.Tablet)
+		replica.Type = topodatapb.TabletType_REPLICA
+		tabletMap = copyTabletMap(tabletMap)
+		tabletMap[topoproto.TabletAliasString(replica.Alias)] = &topo.TabletInfo{Tablet: replica}
+	}
+	if err := topotools.CheckDurability(durability, masterElect, tabletMap); err != nil {
+		return vterrors.Wrapf(err, "cannot reparent keyspace %v", keyspace)
+	}
+	wr.planf("Check that the tablets can send the %v semi-sync acks the durability policy %v of keyspace %v requires", durability.SemiSyncAckers(), ki.DurabilityPolicy, keyspace)
+	return nil
+}
+
+func copyTabletMap(tabletMap map[string]*topo.TabletInfo) map[string]*topo.TabletInfo {
+	result := make(map[string]*topo.TabletInfo, len(tabletMap))
+	for alias, ti := range tabletMap {
+		result[alias] = ti
+	}
+	return result
+}
+
 // findCurrentMaster returns the current master of a shard, if any.
 //
 // The tabletMap must be a complete map (not a partial result) for the shard.
@@ -765,11 +854,18 @@ func (maxPosSearch *maxReplPosSearch) processTablet(tablet *topodatapb.Tablet) {
 	}
 
 	maxPosSearch.maxPosLock.Lock()
-	if maxPosSearch.maxPosTablet == nil || !maxPosSearch.maxPos.AtLeast(replPos) {
+	defer maxPosSearch.maxPosLock.Unlock()
+	switch {
+	case maxPosSearch.maxPosTablet == nil, !maxPosSearch.maxPos.AtLeast(replPos) && replPos.AtLeast(maxPosSearch.maxPos):
 		maxPosSearch.maxPos = replPos
 		maxPosSearch.maxPosTablet = tablet
+	case !maxPosSearch.maxPos.AtLeast(replPos):
+		// The positions diverged, like MariaDB positions ahead in
+		// different domains. None of the tablets has all the
+		// transactions, keep the first one: the master elect catches
+		// up with the master before it's promoted.
+		maxPosSearch.wrangler.logger.Warningf("replication positions of %v (%v) and %v (%v) diverged", topoproto.TabletAliasString(maxPosSearch.maxPosTablet.Alias), maxPosSearch.maxPos, topoproto.TabletAliasString(tablet.Alias), replPos)
 	}
-	maxPosSearch.maxPosLock.Unlock()
 }
 
 // chooseNewMaster finds a tablet that is going to become master after reparent. The criteria
@@ -819,8 +915,10 @@ func (wr *Wrangler) chooseNewMaster(
 }
 
 // EmergencyReparentShard will make the provided tablet the master for
-// the shard, when the old master is completely unreachable.
-func (wr *Wrangler) EmergencyReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) (err error) {
+// the shard, when the old master is completely unreachable. With force,
+// it proceeds even if the tablets cannot satisfy the durability policy
+// of the keyspace.
+func (wr *Wrangler) EmergencyReparentShard(ctx context.Context, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, force bool, waitReplicasTimeout time.Duration) (err error) {
 	// lock the shard
 	ctx, unlock, lockErr := wr.ts.LockShard(ctx, keyspace, shard, fmt.Sprintf("EmergencyReparentShard(%v)", topoproto.TabletAliasString(masterElectTabletAlias)))
 	if lockErr != nil {
@@ -832,38 +930,56 @@ func (wr *Wrangler) EmergencyReparentShard(ctx context.Context, keyspace, shard
 	ev := &events.Reparent{}
 
 	// do the work
-	err = wr.emergencyReparentShardLocked(ctx, ev, keyspace, shard, masterElectTabletAlias, waitReplicasTimeout)
+	err = wr.emergencyReparentShardLocked(ctx, ev, keyspace, shard, masterElectTabletAlias, force, waitReplicasTimeout)
 	if err != nil {
-		event.DispatchUpdate(ev, "failed EmergencyReparentShard: "+err.Error())
+		wr.dispatchUpdate(ev, "failed EmergencyReparentShard: "+err.Error())
 	} else {
-		event.DispatchUpdate(ev, "finished EmergencyReparentShard")
+		wr.dispatchUpdate(ev, "finished EmergencyReparentShard")
 	}
 	return err
 }
 
-func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events.Reparent, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) error {
+// validateEmergencyReparent reads the shard and its tablets, and checks
+// masterElectTabletAlias can be promoted by an emergency reparent.
+func (wr *Wrangler) validateEmergencyReparent(ctx context.Context, ev *events.Reparent, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, force bool) (*topo.ShardInfo, map[string]*topo.TabletInfo, *topo.TabletInfo, error) {
 	shardInfo, err := wr.ts.GetShard(ctx, keyspace, shard)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 	ev.ShardInfo = *shardInfo
 
-	event.DispatchUpdate(ev, "reading all tablets")
+	wr.dispatchUpdate(ev, "reading all tablets")
 	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
 	// Check invariants we're going to depend on.
 	masterElectTabletAliasStr := topoproto.TabletAliasString(masterElectTabletAlias)
 	masterElectTabletInfo, ok := tabletMap[masterElectTabletAliasStr]
 	if !ok {
-		return fmt.Errorf("master-elect tablet %v is not in the shard", masterElectTabletAliasStr)
+		return nil, nil, nil, fmt.Errorf("master-elect tablet %v is not in the shard", masterElectTabletAliasStr)
 	}
 	ev.NewMaster = *masterElectTabletInfo.Tablet
 	if topoproto.TabletAliasEqual(shardInfo.MasterAlias, masterElectTabletAlias) {
-		return fmt.Errorf("master-elect tablet %v is already the master", topoproto.TabletAliasString(masterElectTabletAlias))
+		return nil, nil, nil, fmt.Errorf("master-elect tablet %v is already the master", topoproto.TabletAliasString(masterElectTabletAlias))
+	}
+	if err := wr.checkDurability(ctx, keyspace, masterElectTabletAlias, tabletMap, nil); err != nil {
+		if !force {
+			return nil, nil, nil, fmt.Errorf("%v, use -force to proceed anyway", err)
+		}
+		wr.logger.Warningf("%v, proceeding anyway as -force was used", err)
+		wr.planf("Ignore the durability check as -force was used: %v", err)
 	}
+	return shardInfo, tabletMap, masterElectTabletInfo, nil
+}
+
+func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events.Reparent, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, force bool, waitReplicasTimeout time.Duration) error {
+	shardInfo, tabletMap, masterElectTabletInfo, err := wr.validateEmergencyReparent(ctx, ev, keyspace, shard, masterElectTabletAlias, force)
+	if err != nil {
+		return err
+	}
+	masterElectTabletAliasStr := masterElectTabletInfo.AliasString()
 
 	// Deal with the old master: try to remote-scrap it, if it's
 	// truly dead we force-scrap it. Remove it from our map in any case.
@@ -896,7 +1012,7 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 
 	// Stop replication on all slaves, get their current
 	// replication position
-	event.DispatchUpdate(ev, "stop replication on all slaves")
+	wr.dispatchUpdate(ev, "stop replication on all slaves")
 	wg := sync.WaitGroup{}
 	mu := sync.Mutex{}
 	statusMap := make(map[string]*replicationdatapb.Status)
@@ -942,13 +1058,20 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 			return fmt.Errorf("cannot decode slave %v position %v: %v", alias, status.Position, err)
 		}
 		if !masterElectPos.AtLeast(pos) {
-			return fmt.Errorf("tablet %v is more advanced than master elect tablet %v: %v > %v", alias, masterElectTabletAliasStr, status.Position, masterElectStatus)
+			if pos.AtLeast(masterElectPos) {
+				return fmt.Errorf("tablet %v is more advanced than master elect tablet %v: %v > %v", alias, masterElectTabletAliasStr, status.Position, masterElectStatus.Position)
+			}
+			// With MariaDB, the positions have one GTID per domain,
+			// and a tablet can be ahead in a domain and behind in
+			// another one.
+			return fmt.Errorf("tablet %v has transactions that master elect tablet %v doesn't have, their positions diverged: %v and %v", alias, masterElectTabletAliasStr, status.Position, masterElectStatus.Position)
 		}
 	}
+	wr.planf("Check that no tablet has transactions the master-elect %v does not have", masterElectTabletAliasStr)
 
 	// Promote the masterElect
 	wr.logger.Infof("promote slave %v", topoproto.TabletAliasString(masterElectTabletAlias))
-	event.DispatchUpdate(ev, "promoting slave")
+	wr.dispatchUpdate(ev, "promoting slave")
 	rp, err := wr.tmc.PromoteSlave(ctx, masterElectTabletInfo.Tablet)
 	if err != nil {
 		return fmt.Errorf("master-elect tablet %v failed to be upgraded to master: %v", topoproto.TabletAliasString(masterElectTabletAlias), err)
@@ -969,7 +1092,7 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 	// Go through all the tablets:
 	// - new master: populate the reparent journal
 	// - everybody else: reparent to new master, wait for row
-	event.DispatchUpdate(ev, "reparenting all tablets")
+	wr.dispatchUpdate(ev, "reparenting all tablets")
 	now := time.Now().UnixNano()
 	wgMaster := sync.WaitGroup{}
 	wgSlaves := sync.WaitGroup{}
@@ -1054,16 +1177,16 @@ func (wr *Wrangler) TabletExternallyReparented(ctx context.Context, newMasterAli
 		}
 		defer func() {
 			if err != nil {
-				event.DispatchUpdate(ev, "failed: "+err.Error())
+				wr.dispatchUpdate(ev, "failed: "+err.Error())
 			}
 		}()
-		event.DispatchUpdate(ev, "starting external reparent")
+		wr.dispatchUpdate(ev, "starting external reparent")
 
 		if err := wr.tmc.ChangeType(ctx, tablet, topodatapb.TabletType_MASTER); err != nil {
 			log.Warningf("Error calling ChangeType on new master %v: %v", topoproto.TabletAliasString(newMasterAlias), err)
 			return err
 		}
-		event.DispatchUpdate(ev, "finished")
+		wr.dispatchUpdate(ev, "finished")
 	}
 	return nil
 }
@@ -0,0 +1,295 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+/*
+This file contains the safety checks run before a planned reparent.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// The names of the reparent checks.
+const (
+	ReparentCheckReplicationLag   = "replication_lag"
+	ReparentCheckSemiSync         = "semi_sync"
+	ReparentCheckSchemaMigrations = "schema_migrations"
+	ReparentCheckWorkflows        = "workflows"
+)
+
+// ReparentChecks configures the safety checks run before a planned
+// reparent. If any of the enabled checks fails, the reparent is
+// aborted, unless Force is set.
+type ReparentChecks struct {
+	// MaxReplicationLag is the maximum replication lag of the
+	// master-elect. 0 disables the check.
+	MaxReplicationLag time.Duration
+	// MinSemiSyncAcks is the minimum number of replicas that must be
+	// replicating, and will be able to send semi-sync acks to the new
+	// master. 0 disables the check.
+	MinSemiSyncAcks int
+	// SchemaMigrations checks that no schema change is running on
+	// the current master.
+	SchemaMigrations bool
+	// Workflows checks that no resharding or vertical split of the
+	// shard is in the middle of its cutover.
+	Workflows bool
+	// Force makes the reparent proceed even if checks fail.
+	Force bool
+}
+
+// ParseReparentChecks returns the ReparentChecks for a comma separated
+// list of check names, with the provided bounds.
+func ParseReparentChecks(names string, maxReplicationLag time.Duration, minSemiSyncAcks int, force bool) (*ReparentChecks, error) {
+	checks := &ReparentChecks{Force: force}
+	for _, name := range strings.Split(names, ",") {
+		switch strings.TrimSpace(name) {
+		case "":
+		case ReparentCheckReplicationLag:
+			checks.MaxReplicationLag = maxReplicationLag
+		case ReparentCheckSemiSync:
+			checks.MinSemiSyncAcks = minSemiSyncAcks
+		case ReparentCheckSchemaMigrations:
+			checks.SchemaMigrations = true
+		case ReparentCheckWorkflows:
+			checks.Workflows = true
+		default:
+			return nil, fmt.Errorf("unknown reparent check: %v", name)
+		}
+	}
+	return checks, nil
+}
+
+// ReparentCheckResult is the result of one reparent check.
+type ReparentCheckResult struct {
+	Name    string
+	Passed  bool
+	Message string
+}
+
+// ReparentCheckReport is the result of the reparent checks of a shard.
+type ReparentCheckReport struct {
+	Keyspace string
+	Shard    string
+	Results  []*ReparentCheckResult
+}
+
+func (r *ReparentCheckReport) add(name string, passed bool, format string, args ...interface{}) {
+	r.Results = append(r.Results, &ReparentCheckResult{
+		Name:    name,
+		Passed:  passed,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Failed returns the checks that failed.
+func (r *ReparentCheckReport) Failed() []*ReparentCheckResult {
+	var failed []*ReparentCheckResult
+	for _, result := range r.Results {
+		if !result.Passed {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// String returns one line per check.
+func (r *ReparentCheckReport) String() string {
+	var b strings.Builder
+	for _, result := range r.Results {
+		status := "PASSED"
+		if !result.Passed {
+			status = "FAILED"
+		}
+		fmt.Fprintf(&b, "%v %v: %v\n", status, result.Name, result.Message)
+	}
+	return b.String()
+}
+
+// Error returns an error describing the failed checks, or nil if
+// they all passed.
+func (r *ReparentCheckReport) Error() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, len(failed))
+	for _, result := range failed {
+		msgs = append(msgs, fmt.Sprintf("%v: %v", result.Name, result.Message))
+	}
+	return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "pre-flight checks failed for %v/%v (use -force to reparent anyway): %v", r.Keyspace, r.Shard, strings.Join(msgs, "; "))
+}
+
+// checkReparent runs the enabled checks for a planned reparent to
+// masterElect. currentMaster may be nil if it's not known.
+func (wr *Wrangler) checkReparent(ctx context.Context, checks *ReparentChecks, shardInfo *topo.ShardInfo, tabletMap map[string]*topo.TabletInfo, masterElect, currentMaster *topo.TabletInfo) *ReparentCheckReport {
+	report := &ReparentCheckReport{
+		Keyspace: shardInfo.Keyspace(),
+		Shard:    shardInfo.ShardName(),
+	}
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	if checks.MaxReplicationLag > 0 {
+		wr.checkReplicationLag(ctx, report, checks.MaxReplicationLag, masterElect, currentMaster)
+	}
+	if checks.MinSemiSyncAcks > 0 {
+		wr.checkSemiSyncAcks(ctx, report, checks.MinSemiSyncAcks, tabletMap, masterElect, currentMaster)
+	}
+	if checks.SchemaMigrations {
+		wr.checkSchemaMigrations(ctx, report, currentMaster)
+	}
+	if checks.Workflows {
+		wr.checkWorkflows(ctx, report, shardInfo, currentMaster)
+	}
+	return report
+}
+
+// checkReplicationLag checks that the master-elect is replicating,
+// and is not lagging more than maxLag.
+func (wr *Wrangler) checkReplicationLag(ctx context.Context, report *ReparentCheckReport, maxLag time.Duration, masterElect, currentMaster *topo.TabletInfo) {
+	if currentMaster != nil && topoproto.TabletAliasEqual(currentMaster.Alias, masterElect.Alias) {
+		report.add(ReparentCheckReplicationLag, true, "master-elect %v is already the master", masterElect.AliasString())
+		return
+	}
+	status, err := wr.tmc.SlaveStatus(ctx, masterElect.Tablet)
+	if err != nil {
+		report.add(ReparentCheckReplicationLag, false, "cannot get replication status of master-elect %v: %v", masterElect.AliasString(), err)
+		return
+	}
+	if !status.SlaveIoRunning || !status.SlaveSqlRunning {
+		report.add(ReparentCheckReplicationLag, false, "master-elect %v is not replicating", masterElect.AliasString())
+		return
+	}
+	lag := time.Duration(status.SecondsBehindMaster) * time.Second
+	if lag > maxLag {
+		report.add(ReparentCheckReplicationLag, false, "master-elect %v is lagging by %v, more than %v", masterElect.AliasString(), lag, maxLag)
+		return
+	}
+	report.add(ReparentCheckReplicationLag, true, "master-elect %v is lagging by %v", masterElect.AliasString(), lag)
+}
+
+// checkSemiSyncAcks checks that enough replicas will be able to ack
+// the transactions of the new master. Those are the replicas that are
+// currently replicating, and the current master, which will be
+// demoted to a replica.
+func (wr *Wrangler) checkSemiSyncAcks(ctx context.Context, report *ReparentCheckReport, minAcks int, tabletMap map[string]*topo.TabletInfo, masterElect, currentMaster *topo.TabletInfo) {
+	acks := 0
+	if currentMaster != nil && !topoproto.TabletAliasEqual(currentMaster.Alias, masterElect.Alias) {
+		acks++
+	}
+
+	var mu sync.Mutex
+	wg := sync.WaitGroup{}
+	for _, ti := range tabletMap {
+		if ti.Type != topodatapb.TabletType_REPLICA || topoproto.TabletAliasEqual(ti.Alias, masterElect.Alias) {
+			continue
+		}
+		wg.Add(1)
+		go func(ti *topo.TabletInfo) {
+			defer wg.Done()
+			status, err := wr.tmc.SlaveStatus(ctx, ti.Tablet)
+			if err != nil || !status.SlaveIoRunning {
+				return
+			}
+			mu.Lock()
+			acks++
+			mu.Unlock()
+		}(ti)
+	}
+	wg.Wait()
+
+	if acks < minAcks {
+		report.add(ReparentCheckSemiSync, false, "only %v replicas can send semi-sync acks to the new master, need %v", acks, minAcks)
+		return
+	}
+	report.add(ReparentCheckSemiSync, true, "%v replicas can send semi-sync acks to the new master", acks)
+}
+
+// schemaMigrationsQuery lists the schema changes running on a tablet.
+const schemaMigrationsQuery = "select id, info from information_schema.processlist where command = 'Query' and (lower(info) like 'alter %' or lower(info) like 'create index %' or lower(info) like 'drop index %')"
+
+// checkSchemaMigrations checks that no schema change is running on the
+// current master. They would be lost, or would block the replication
+// on the new master.
+func (wr *Wrangler) checkSchemaMigrations(ctx context.Context, report *ReparentCheckReport, currentMaster *topo.TabletInfo) {
+	if currentMaster == nil {
+		report.add(ReparentCheckSchemaMigrations, false, "the current master is not known")
+		return
+	}
+	p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, currentMaster.Tablet, false, []byte(schemaMigrationsQuery), 100, false, false)
+	if err != nil {
+		report.add(ReparentCheckSchemaMigrations, false, "cannot list the running queries of master %v: %v", currentMaster.AliasString(), err)
+		return
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) != 0 {
+		var queries []string
+		for _, row := range qr.Rows {
+			queries = append(queries, fmt.Sprintf("%v (connection %v)", row[1].ToString(), row[0].ToString()))
+		}
+		report.add(ReparentCheckSchemaMigrations, false, "schema changes are running on master %v: %v", currentMaster.AliasString(), strings.Join(queries, ", "))
+		return
+	}
+	report.add(ReparentCheckSchemaMigrations, true, "no schema change is running on master %v", currentMaster.AliasString())
+}
+
+// checkWorkflows checks that no migration of the shard is in the
+// middle of its cutover: the tablet controls of the shard are not
+// frozen by MigrateServedTypes, and the vreplication streams of the
+// master are not frozen by MigrateWrites.
+func (wr *Wrangler) checkWorkflows(ctx context.Context, report *ReparentCheckReport, shardInfo *topo.ShardInfo, currentMaster *topo.TabletInfo) {
+	for _, tc := range shardInfo.TabletControls {
+		if tc.Frozen {
+			report.add(ReparentCheckWorkflows, false, "the %v tablet control of the shard is frozen by a migration of the served types", tc.TabletType)
+			return
+		}
+	}
+	if currentMaster == nil {
+		report.add(ReparentCheckWorkflows, false, "the current master is not known")
+		return
+	}
+	query := fmt.Sprintf("select workflow from _vt.vreplication where db_name=%s and message=%s", encodeString(currentMaster.DbName()), encodeString(frozenStr))
+	p3qr, err := wr.tmc.VReplicationExec(ctx, currentMaster.Tablet, query)
+	if err != nil {
+		report.add(ReparentCheckWorkflows, false, "cannot list the vreplication streams of master %v: %v", currentMaster.AliasString(), err)
+		return
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) != 0 {
+		var workflows []string
+		for _, row := range qr.Rows {
+			workflows = append(workflows, row[0].ToString())
+		}
+		report.add(ReparentCheckWorkflows, false, "workflows are in the middle of their cutover on master %v: %v", currentMaster.AliasString(), strings.Join(workflows, ", "))
+		return
+	}
+	report.add(ReparentCheckWorkflows, true, "no workflow is in the middle of its cutover")
+}
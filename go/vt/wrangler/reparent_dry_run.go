@@ -0,0 +1,48 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"time"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// PlannedReparentShardDryRun returns the actions PlannedReparentShard
+// would take with the same arguments. It runs PlannedReparentShard with
+// a dry run wrangler: the checks and the read-only RPCs are run, and
+// the changes are only listed.
+func (wr *Wrangler) PlannedReparentShardDryRun(ctx context.Context, keyspace, shard string, masterElectTabletAlias, avoidMasterAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration, checks *ReparentChecks) (*DryRunPlan, error) {
+	plan := &DryRunPlan{}
+	if err := wr.dryRun(plan).PlannedReparentShard(ctx, keyspace, shard, masterElectTabletAlias, avoidMasterAlias, waitReplicasTimeout, checks); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// EmergencyReparentShardDryRun returns the actions EmergencyReparentShard
+// would take with the same arguments. It runs EmergencyReparentShard
+// with a dry run wrangler, which reads the replication positions of the
+// tablets without stopping replication.
+func (wr *Wrangler) EmergencyReparentShardDryRun(ctx context.Context, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, force bool, waitReplicasTimeout time.Duration) (*DryRunPlan, error) {
+	plan := &DryRunPlan{}
+	if err := wr.dryRun(plan).EmergencyReparentShard(ctx, keyspace, shard, masterElectTabletAlias, force, waitReplicasTimeout); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// SetRoutingRule makes vtgate send the queries of fromTable to
+// toTables, for the provided tablet types, or for all of them if none
+// is provided. fromTable can be qualified by its keyspace, toTables
+// must be. An empty toTables disables the table. A table can be moved
+// one tablet type at a time, starting with rdonly and ending with
+// master, and moved back the same way.
+// The rules are validated against the vschemas before they are saved.
+// The SrvVSchema objects must be rebuilt for the rules to take effect.
+func (wr *Wrangler) SetRoutingRule(ctx context.Context, fromTable string, toTables []string, tabletTypes []topodatapb.TabletType) error {
+	return wr.updateRoutingRules(ctx, func(rules map[string][]string) error {
+		for _, name := range routingRuleNames(fromTable, tabletTypes) {
+			rules[name] = toTables
+		}
+		return nil
+	})
+}
+
+// DeleteRoutingRule deletes the routing rules of fromTable for the
+// provided tablet types, or its rule for all the tablet types if none
+// is provided.
+func (wr *Wrangler) DeleteRoutingRule(ctx context.Context, fromTable string, tabletTypes []topodatapb.TabletType) error {
+	return wr.updateRoutingRules(ctx, func(rules map[string][]string) error {
+		for _, name := range routingRuleNames(fromTable, tabletTypes) {
+			if _, ok := rules[name]; !ok {
+				return fmt.Errorf("no routing rule for %v", name)
+			}
+			delete(rules, name)
+		}
+		return nil
+	})
+}
+
+// routingRuleNames returns the routing rule names of a table for the
+// tablet types, like ks.t@replica.
+func routingRuleNames(fromTable string, tabletTypes []topodatapb.TabletType) []string {
+	if len(tabletTypes) == 0 {
+		return []string{fromTable}
+	}
+	names := make([]string, 0, len(tabletTypes))
+	for _, tabletType := range tabletTypes {
+		names = append(names, fromTable+vindexes.TabletTypeSuffix[tabletType])
+	}
+	return names
+}
+
+func (wr *Wrangler) updateRoutingRules(ctx context.Context, update func(rules map[string][]string) error) error {
+	rules, err := wr.getRoutingRules(ctx)
+	if err != nil {
+		return err
+	}
+	if err := update(rules); err != nil {
+		return err
+	}
+	if err := wr.validateRoutingRules(ctx, rules); err != nil {
+		return err
+	}
+	return wr.saveRoutingRules(ctx, rules)
+}
+
+// validateRoutingRules builds the vschema of vtgate with the rules,
+// and returns the first rule that can't be used.
+func (wr *Wrangler) validateRoutingRules(ctx context.Context, rules map[string][]string) error {
+	keyspaces, err := wr.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return fmt.Errorf("GetKeyspaces failed: %v", err)
+	}
+	srvVSchema := &vschemapb.SrvVSchema{
+		Keyspaces:    make(map[string]*vschemapb.Keyspace),
+		RoutingRules: &vschemapb.RoutingRules{},
+	}
+	for _, keyspace := range keyspaces {
+		ks, err := wr.ts.GetVSchema(ctx, keyspace)
+		if err != nil {
+			if !topo.IsErrType(err, topo.NoNode) {
+				return fmt.Errorf("GetVSchema(%v) failed: %v", keyspace, err)
+			}
+			ks = &vschemapb.Keyspace{}
+		}
+		srvVSchema.Keyspaces[keyspace] = ks
+	}
+	for from, to := range rules {
+		srvVSchema.RoutingRules.Rules = append(srvVSchema.RoutingRules.Rules, &vschemapb.RoutingRule{
+			FromTable: from,
+			ToTables:  to,
+		})
+	}
+	vschema, err := vindexes.BuildVSchema(srvVSchema)
+	if err != nil {
+		return err
+	}
+	for from := range rules {
+		if rr := vschema.RoutingRules[from]; rr != nil && rr.Error != nil {
+			return fmt.Errorf("invalid routing rule for %v: %v", from, rr.Error)
+		}
+	}
+	return nil
+}
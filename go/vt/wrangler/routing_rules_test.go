@@ -0,0 +1,75 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestRoutingRules(t *testing.T) {
+	ctx := context.Background()
+	wr := newTestMaterializerEnv(t, newTestMaterializerTMClient())
+
+	// The reads are shifted first.
+	if err := wr.SetRoutingRule(ctx, "customer", []string{"targetks.customer"}, []topodatapb.TabletType{topodatapb.TabletType_RDONLY, topodatapb.TabletType_REPLICA}); err != nil {
+		t.Fatal(err)
+	}
+	if err := wr.SetRoutingRule(ctx, "customer", []string{"sourceks.customer"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	rules, err := wr.getRoutingRules(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]string{
+		"customer":         {"sourceks.customer"},
+		"customer@rdonly":  {"targetks.customer"},
+		"customer@replica": {"targetks.customer"},
+	}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("rules: %v, want %v", rules, want)
+	}
+
+	// Invalid rules are not saved.
+	err = wr.SetRoutingRule(ctx, "customer", []string{"customer"}, []topodatapb.TabletType{topodatapb.TabletType_MASTER})
+	if err == nil || !strings.Contains(err.Error(), "must be qualified") {
+		t.Errorf("SetRoutingRule(unqualified): %v, want must be qualified", err)
+	}
+
+	if err := wr.DeleteRoutingRule(ctx, "customer", []topodatapb.TabletType{topodatapb.TabletType_REPLICA}); err != nil {
+		t.Fatal(err)
+	}
+	rules, err = wr.getRoutingRules(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	delete(want, "customer@replica")
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("rules: %v, want %v", rules, want)
+	}
+
+	err = wr.DeleteRoutingRule(ctx, "customer", []topodatapb.TabletType{topodatapb.TabletType_MASTER})
+	if err == nil || err.Error() != "no routing rule for customer@master" {
+		t.Errorf("DeleteRoutingRule(missing): %v, want no routing rule for customer@master", err)
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// TabletSchemaDrift lists the differences between the schema of a
+// tablet and the schema of the master of its shard.
+type TabletSchemaDrift struct {
+	Alias *topodatapb.TabletAlias
+	Diffs []string
+}
+
+// SchemaDriftShard compares the schema of all the tablets of a shard
+// with the schema of its master, and returns the master alias, and the
+// tablets whose schema differs sorted by alias.
+// Unlike ValidateSchemaShard, a tablet whose schema can't be read
+// doesn't prevent the others from being compared: the drifts found are
+// returned along with the error.
+func (wr *Wrangler) SchemaDriftShard(ctx context.Context, keyspace, shard string, excludeTables []string, includeViews bool) (*topodatapb.TabletAlias, []*TabletSchemaDrift, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, nil, fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shard, err)
+	}
+	if !si.HasMaster() {
+		return nil, nil, fmt.Errorf("no master in shard %v/%v", keyspace, shard)
+	}
+	masterSchema, err := wr.GetSchema(ctx, si.MasterAlias, nil, excludeTables, includeViews)
+	if err != nil {
+		return nil, nil, fmt.Errorf("GetSchema(%v, nil, %v, %v) failed: %v", si.MasterAlias, excludeTables, includeViews, err)
+	}
+	aliases, err := wr.ts.FindAllTabletAliasesInShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, nil, fmt.Errorf("FindAllTabletAliasesInShard(%v, %v) failed: %v", keyspace, shard, err)
+	}
+
+	masterName := topoproto.TabletAliasString(si.MasterAlias)
+	var drifts []*TabletSchemaDrift
+	mu := sync.Mutex{}
+	er := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for _, alias := range aliases {
+		if topoproto.TabletAliasEqual(alias, si.MasterAlias) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(alias *topodatapb.TabletAlias) {
+			defer wg.Done()
+			schema, err := wr.GetSchema(ctx, alias, nil, excludeTables, includeViews)
+			if err != nil {
+				er.RecordError(fmt.Errorf("GetSchema(%v, nil, %v, %v) failed: %v", alias, excludeTables, includeViews, err))
+				return
+			}
+			diffs := tmutils.DiffSchemaToArray(masterName, masterSchema, topoproto.TabletAliasString(alias), schema)
+			if len(diffs) == 0 {
+				return
+			}
+			mu.Lock()
+			drifts = append(drifts, &TabletSchemaDrift{
+				Alias: alias,
+				Diffs: diffs,
+			})
+			mu.Unlock()
+		}(alias)
+	}
+	wg.Wait()
+	sort.Slice(drifts, func(i, j int) bool {
+		return topoproto.TabletAliasString(drifts[i].Alias) < topoproto.TabletAliasString(drifts[j].Alias)
+	})
+	return si.MasterAlias, drifts, er.Error()
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topotools"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// ProvisionSequences creates and initializes the sequence tables of the
+// auto-increment columns of the tables of a keyspace. The next value of
+// each sequence is set above the maximum value of its column across all
+// the shards, and the sequence tables are added to the vschema of their
+// keyspace. Unqualified sequences that are not in any vschema are
+// created in sequenceKeyspace, which must be unsharded.
+// It can be run again after more tables get an auto-increment column:
+// it never lowers the next value of an existing sequence.
+func (wr *Wrangler) ProvisionSequences(ctx context.Context, keyspace, sequenceKeyspace string, cache int64) error {
+	keyspaces, err := wr.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return fmt.Errorf("GetKeyspaces failed: %v", err)
+	}
+	vschemas := make(map[string]*vschemapb.Keyspace)
+	for _, ks := range keyspaces {
+		vschema, err := wr.ts.GetVSchema(ctx, ks)
+		if err != nil {
+			if topo.IsErrType(err, topo.NoNode) {
+				continue
+			}
+			return fmt.Errorf("GetVSchema(%v) failed: %v", ks, err)
+		}
+		vschemas[ks] = vschema
+	}
+	vschema, ok := vschemas[keyspace]
+	if !ok {
+		return fmt.Errorf("no vschema for keyspace %v", keyspace)
+	}
+
+	var tables []string
+	for name, table := range vschema.Tables {
+		if table.AutoIncrement != nil {
+			tables = append(tables, name)
+		}
+	}
+	if len(tables) == 0 {
+		wr.Logger().Printf("No auto-increment column in keyspace %v.\n", keyspace)
+		return nil
+	}
+	sort.Strings(tables)
+
+	changedVSchemas := make(map[string]bool)
+	for _, name := range tables {
+		autoInc := vschema.Tables[name].AutoIncrement
+		seqKeyspace, seqTable := topotools.ResolveSequence(autoInc.Sequence, vschemas, sequenceKeyspace)
+		if seqKeyspace == "" {
+			return fmt.Errorf("table %v: sequence %v is not in any vschema, and no sequence keyspace was specified", name, autoInc.Sequence)
+		}
+		seqVSchema, ok := vschemas[seqKeyspace]
+		if !ok {
+			seqVSchema = &vschemapb.Keyspace{}
+			vschemas[seqKeyspace] = seqVSchema
+		}
+		if seqVSchema.Sharded {
+			return fmt.Errorf("table %v: sequence %v must be in an unsharded keyspace, but %v is sharded", name, autoInc.Sequence, seqKeyspace)
+		}
+
+		maxID, err := wr.maxColumnValue(ctx, keyspace, name, autoInc.Column)
+		if err != nil {
+			return fmt.Errorf("cannot read the maximum value of %v.%v: %v", name, autoInc.Column, err)
+		}
+		if err := wr.initSequence(ctx, seqKeyspace, seqTable, maxID, cache); err != nil {
+			return fmt.Errorf("cannot initialize sequence %v.%v: %v", seqKeyspace, seqTable, err)
+		}
+		wr.Logger().Printf("Sequence %v.%v of %v.%v starts after %v.\n", seqKeyspace, seqTable, name, autoInc.Column, maxID)
+
+		if seqVSchema.Tables == nil {
+			seqVSchema.Tables = make(map[string]*vschemapb.Table)
+		}
+		if t, ok := seqVSchema.Tables[seqTable]; !ok || t.Type != "sequence" {
+			seqVSchema.Tables[seqTable] = &vschemapb.Table{Type: "sequence"}
+			changedVSchemas[seqKeyspace] = true
+		}
+	}
+
+	if len(changedVSchemas) == 0 {
+		return nil
+	}
+	for ks := range changedVSchemas {
+		if err := wr.ts.SaveVSchema(ctx, ks, vschemas[ks]); err != nil {
+			return fmt.Errorf("SaveVSchema(%v) failed: %v", ks, err)
+		}
+	}
+	return wr.ts.RebuildSrvVSchema(ctx, nil)
+}
+
+// maxColumnValue returns the maximum value of a column of a table on
+// the masters of all the shards of a keyspace.
+func (wr *Wrangler) maxColumnValue(ctx context.Context, keyspace, table, column string) (int64, error) {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return 0, err
+	}
+	query := topotools.MaxColumnQuery(table, column)
+	var mu sync.Mutex
+	var results []*sqltypes.Result
+	wg := sync.WaitGroup{}
+	rec := concurrency.AllErrorRecorder{}
+	for _, shard := range shards {
+		wg.Add(1)
+		go func(shard string) {
+			defer wg.Done()
+			si, err := wr.ts.GetShard(ctx, keyspace, shard)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+			if !si.HasMaster() {
+				rec.RecordError(fmt.Errorf("no master in shard %v/%v", keyspace, shard))
+				return
+			}
+			qr, err := wr.ExecuteFetchAsDba(ctx, si.MasterAlias, query, 1, false, false)
+			if err != nil {
+				rec.RecordError(err)
+				return
+			}
+			mu.Lock()
+			results = append(results, sqltypes.Proto3ToResult(qr))
+			mu.Unlock()
+		}(shard)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return 0, rec.Error()
+	}
+	return topotools.MaxValue(results...)
+}
+
+// initSequence creates the table of a sequence on the master of its
+// unsharded keyspace, and sets its next value above maxID.
+func (wr *Wrangler) initSequence(ctx context.Context, keyspace, table string, maxID, cache int64) error {
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	if len(shards) != 1 {
+		return fmt.Errorf("keyspace %v has %v shards, want 1", keyspace, len(shards))
+	}
+	si, err := wr.ts.GetShard(ctx, keyspace, shards[0])
+	if err != nil {
+		return err
+	}
+	if !si.HasMaster() {
+		return fmt.Errorf("no master in shard %v/%v", keyspace, shards[0])
+	}
+	if _, err := wr.ExecuteFetchAsDba(ctx, si.MasterAlias, topotools.SequenceTableDDL(table), 0, false, true /* reloadSchema */); err != nil {
+		return err
+	}
+	_, err = wr.ExecuteFetchAsDba(ctx, si.MasterAlias, topotools.SequenceInitQuery(table, maxID, cache), 0, false, false)
+	return err
+}
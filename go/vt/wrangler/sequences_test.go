@@ -0,0 +1,97 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestProvisionSequences(t *testing.T) {
+	ctx := context.Background()
+	tmc := newTestMaterializerTMClient()
+	wr := newTestMaterializerEnv(t, tmc)
+
+	vschema, err := wr.ts.GetVSchema(ctx, "targetks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vschema.Tables["customer"].AutoIncrement = &vschemapb.AutoIncrement{Column: "id", Sequence: "customer_seq"}
+	vschema.Tables["corder"].AutoIncrement = &vschemapb.AutoIncrement{Column: "order_id", Sequence: "sourceks.corder_seq"}
+	if err := wr.ts.SaveVSchema(ctx, "targetks", vschema); err != nil {
+		t.Fatal(err)
+	}
+
+	// An unqualified sequence that is not in any vschema needs the
+	// sequence keyspace.
+	err = wr.ProvisionSequences(ctx, "targetks", "", 0)
+	if err == nil || !strings.Contains(err.Error(), "no sequence keyspace was specified") {
+		t.Errorf("ProvisionSequences: %v, want no sequence keyspace", err)
+	}
+	tmc.queries = make(map[uint32][]string)
+
+	fields := sqltypes.MakeTestFields("max", "int64")
+	maxResult := func(value string) *querypb.QueryResult {
+		return sqltypes.ResultToProto3(sqltypes.MakeTestResult(fields, value))
+	}
+	tmc.results[200] = map[string]*querypb.QueryResult{
+		"select max(`id`) from `customer`":     maxResult("10"),
+		"select max(`order_id`) from `corder`": maxResult("null"),
+	}
+	tmc.results[300] = map[string]*querypb.QueryResult{
+		"select max(`id`) from `customer`":     maxResult("25"),
+		"select max(`order_id`) from `corder`": maxResult("7"),
+	}
+	if err := wr.ProvisionSequences(ctx, "targetks", "sourceks", 100); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"create table if not exists `corder_seq`(id int, next_id bigint, cache bigint, primary key(id)) comment 'vitess_sequence'",
+		"insert into `corder_seq`(id, next_id, cache) values (0, 8, 100) on duplicate key update next_id = greatest(next_id, values(next_id))",
+		"create table if not exists `customer_seq`(id int, next_id bigint, cache bigint, primary key(id)) comment 'vitess_sequence'",
+		"insert into `customer_seq`(id, next_id, cache) values (0, 26, 100) on duplicate key update next_id = greatest(next_id, values(next_id))",
+	}
+	if got := tmc.queries[100]; !reflect.DeepEqual(got, want) {
+		t.Errorf("sequence queries:\n%v, want\n%v", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	seqVSchema, err := wr.ts.GetVSchema(ctx, "sourceks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"corder_seq", "customer_seq"} {
+		if table := seqVSchema.Tables[name]; table == nil || table.Type != "sequence" {
+			t.Errorf("vschema of %v: %v, want a sequence", name, table)
+		}
+	}
+	srvVSchema, err := wr.ts.GetSrvVSchema(ctx, "cell1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table := srvVSchema.Keyspaces["sourceks"].GetTables()["customer_seq"]; table == nil || table.Type != "sequence" {
+		t.Errorf("srv vschema of customer_seq: %v, want a sequence", table)
+	}
+}
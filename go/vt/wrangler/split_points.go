@@ -0,0 +1,218 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+/*
+This file suggests the shards to split a shard into.
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// maxSplitPointLength is the maximum length, in bytes, of the
+// boundaries of the suggested shards.
+const maxSplitPointLength = 8
+
+// SuggestSplitPoints suggests how to split a shard into count shards
+// with about the same number of rows. It reads a random sample of about
+// sampleSize rows of a table, maps them to keyspace ids with the primary
+// vindex of the table, and uses the quantiles of the keyspace ids as
+// shard boundaries. It returns the names of the suggested shards.
+func (wr *Wrangler) SuggestSplitPoints(ctx context.Context, keyspace, shard, table string, count, sampleSize int) ([]string, error) {
+	if count < 2 {
+		return nil, fmt.Errorf("a shard must be split into at least 2 shards, got %v", count)
+	}
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+
+	column, vindex, err := wr.primaryVindex(ctx, keyspace, table)
+	if err != nil {
+		return nil, err
+	}
+	tablet, err := wr.sampleTablet(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sample the rows, using the estimated row count of the table.
+	sd, err := wr.tmc.GetSchema(ctx, tablet, []string{table}, nil, false /* includeViews */)
+	if err != nil {
+		return nil, err
+	}
+	if len(sd.TableDefinitions) != 1 {
+		return nil, fmt.Errorf("table %v not found on %v", table, topoproto.TabletAliasString(tablet.Alias))
+	}
+	rate := 1.0
+	if rowCount := sd.TableDefinitions[0].RowCount; rowCount > uint64(sampleSize) {
+		rate = float64(sampleSize) / float64(rowCount)
+	}
+	// The row count is an estimate, leave some room in case it's too low.
+	maxRows := 2 * sampleSize
+	query := fmt.Sprintf("select %v from %v.%v where rand() < %v limit %v", sqlescape.EscapeID(column), sqlescape.EscapeID(topoproto.TabletDbName(tablet)), sqlescape.EscapeID(table), strconv.FormatFloat(rate, 'f', -1, 64), maxRows)
+	qr, err := wr.tmc.ExecuteFetchAsApp(ctx, tablet, true, []byte(query), maxRows)
+	if err != nil {
+		return nil, err
+	}
+	result := sqltypes.Proto3ToResult(qr)
+	ids := make([]sqltypes.Value, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		ids = append(ids, row[0])
+	}
+	destinations, err := vindex.Map(nil, ids)
+	if err != nil {
+		return nil, err
+	}
+	ksids := make([][]byte, 0, len(destinations))
+	for i, destination := range destinations {
+		ksid, ok := destination.(key.DestinationKeyspaceID)
+		if !ok {
+			return nil, fmt.Errorf("cannot map %v to a keyspace id: %v", ids[i], destination)
+		}
+		ksids = append(ksids, ksid)
+	}
+	wr.Logger().Infof("Sampled %v rows of %v in %v/%v", len(ksids), table, keyspace, shard)
+
+	keyRanges, err := splitKeyRange(si.KeyRange, ksids, count)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(keyRanges))
+	for _, kr := range keyRanges {
+		names = append(names, key.KeyRangeString(kr))
+	}
+	return names, nil
+}
+
+// primaryVindex returns the column and the primary vindex of a table,
+// which must be a unique functional vindex on a single column.
+func (wr *Wrangler) primaryVindex(ctx context.Context, keyspace, table string) (string, vindexes.Vindex, error) {
+	vs, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return "", nil, err
+	}
+	if !vs.Sharded {
+		return "", nil, fmt.Errorf("keyspace %v is not sharded", keyspace)
+	}
+	kschema, err := vindexes.BuildKeyspaceSchema(vs, keyspace)
+	if err != nil {
+		return "", nil, err
+	}
+	t, ok := kschema.Tables[table]
+	if !ok {
+		return "", nil, fmt.Errorf("table %v not found in the vschema of keyspace %v", table, keyspace)
+	}
+	if len(t.ColumnVindexes) == 0 {
+		return "", nil, fmt.Errorf("table %v has no primary vindex in keyspace %v", table, keyspace)
+	}
+	cv := t.ColumnVindexes[0]
+	if len(cv.Columns) != 1 || !cv.Vindex.IsUnique() || cv.Vindex.Cost() > 1 {
+		return "", nil, fmt.Errorf("the primary vindex %v of table %v must be a unique functional vindex on a single column", cv.Name, table)
+	}
+	return cv.Columns[0].String(), cv.Vindex, nil
+}
+
+// sampleTablet returns the tablet of a shard to read the sample from.
+// It prefers rdonly tablets, then replica tablets, to spare the master.
+func (wr *Wrangler) sampleTablet(ctx context.Context, keyspace, shard string) (*topodatapb.Tablet, error) {
+	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+		return nil, err
+	}
+	for _, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_RDONLY, topodatapb.TabletType_REPLICA, topodatapb.TabletType_MASTER} {
+		// Sort the aliases to be deterministic.
+		var aliases []string
+		for alias, ti := range tabletMap {
+			if ti.Type == tabletType {
+				aliases = append(aliases, alias)
+			}
+		}
+		if len(aliases) > 0 {
+			sort.Strings(aliases)
+			return tabletMap[aliases[0]].Tablet, nil
+		}
+	}
+	return nil, fmt.Errorf("no tablet found in %v/%v", keyspace, shard)
+}
+
+// splitKeyRange returns count key ranges that split kr such that each
+// of them contains about the same number of the keyspace ids. The
+// boundaries are as short as possible, so that the names of the shards
+// stay short.
+func splitKeyRange(kr *topodatapb.KeyRange, ksids [][]byte, count int) ([]*topodatapb.KeyRange, error) {
+	if len(ksids) < count {
+		return nil, fmt.Errorf("the sample has %v rows, at least %v rows are needed to split into %v shards", len(ksids), count, count)
+	}
+	sort.Slice(ksids, func(i, j int) bool {
+		return bytes.Compare(ksids[i], ksids[j]) < 0
+	})
+	var start, end []byte
+	if kr != nil {
+		start, end = kr.Start, kr.End
+	}
+
+	for length := 1; length <= maxSplitPointLength; length++ {
+		boundaries := make([][]byte, 0, count-1)
+		for i := 1; i < count; i++ {
+			ksid := ksids[i*len(ksids)/count]
+			if len(ksid) > length {
+				ksid = ksid[:length]
+			}
+			boundaries = append(boundaries, ksid)
+		}
+		if !validBoundaries(start, end, boundaries) {
+			continue
+		}
+		keyRanges := make([]*topodatapb.KeyRange, 0, count)
+		for _, boundary := range boundaries {
+			keyRanges = append(keyRanges, &topodatapb.KeyRange{Start: start, End: boundary})
+			start = boundary
+		}
+		keyRanges = append(keyRanges, &topodatapb.KeyRange{Start: start, End: end})
+		return keyRanges, nil
+	}
+	return nil, fmt.Errorf("cannot find %v distinct split points in the sample, too many rows have the same keyspace id", count-1)
+}
+
+// validBoundaries returns true if the boundaries are strictly increasing
+// and strictly within [start, end).
+func validBoundaries(start, end []byte, boundaries [][]byte) bool {
+	previous := start
+	for _, boundary := range boundaries {
+		if bytes.Compare(boundary, previous) <= 0 {
+			return false
+		}
+		previous = boundary
+	}
+	return len(end) == 0 || bytes.Compare(previous, end) < 0
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestSplitKeyRange(t *testing.T) {
+	testcases := []struct {
+		keyRange string
+		ksids    []string
+		count    int
+		want     []string
+		err      string
+	}{{
+		// Uniform distribution.
+		keyRange: "-",
+		ksids:    []string{"10", "30", "50", "70", "90", "b0", "d0", "f0"},
+		count:    2,
+		want:     []string{"-90", "90-"},
+	}, {
+		// Most of the rows are at the start of the key range.
+		keyRange: "-",
+		ksids:    []string{"01", "02", "03", "04", "05", "06", "07", "f0"},
+		count:    2,
+		want:     []string{"-05", "05-"},
+	}, {
+		// The boundaries are extended until they are distinct.
+		keyRange: "-",
+		ksids:    []string{"4010", "4020", "4030", "4040", "4050", "4060"},
+		count:    3,
+		want:     []string{"-4030", "4030-4050", "4050-"},
+	}, {
+		// The boundaries are within the key range of the shard.
+		keyRange: "40-80",
+		ksids:    []string{"4010", "4020", "4030", "7000"},
+		count:    2,
+		want:     []string{"40-4030", "4030-80"},
+	}, {
+		keyRange: "40-80",
+		ksids:    []string{"40", "50", "60"},
+		count:    4,
+		err:      "the sample has 3 rows",
+	}, {
+		keyRange: "-",
+		ksids:    []string{"1010101010101010", "1010101010101010", "1010101010101010"},
+		count:    3,
+		err:      "cannot find 2 distinct split points",
+	}}
+	for _, tc := range testcases {
+		_, kr, err := topo.ValidateShardName(tc.keyRange)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ksids [][]byte
+		for _, ksid := range tc.ksids {
+			b, err := hex.DecodeString(ksid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ksids = append(ksids, b)
+		}
+		krs, err := splitKeyRange(kr, ksids, tc.count)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("splitKeyRange(%v, %v, %v): %v, want %v", tc.keyRange, tc.ksids, tc.count, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := keyRangeStrings(krs)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("splitKeyRange(%v, %v, %v): %v, want %v", tc.keyRange, tc.ksids, tc.count, got, tc.want)
+		}
+	}
+}
+
+func keyRangeStrings(krs []*topodatapb.KeyRange) []string {
+	var names []string
+	for _, kr := range krs {
+		names = append(names, key.KeyRangeString(kr))
+	}
+	return names
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// SetSrvKeyspacePartition sets the shards serving a tablet type in the
+// SrvKeyspace of the cells, or of all the cells if cells is empty. It
+// is meant to fix the serving graph during migrations, without editing
+// the topo by hand. The shards must serve each keyspace id exactly
+// once in all the cells, or no cell is changed. With dryRun, the new
+// partitions are only checked and logged.
+//
+// The change is transient: the shard records are not changed, so
+// RebuildKeyspaceGraph overwrites it with the partitions of the serving
+// shards. The topo can't update several cells atomically: the cells are
+// updated one at a time, and if one fails, the cells updated before it
+// are restored.
+func (wr *Wrangler) SetSrvKeyspacePartition(ctx context.Context, keyspace string, tabletType topodatapb.TabletType, shards []string, cells []string, dryRun bool) (err error) {
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, keyspace, fmt.Sprintf("SetSrvKeyspacePartition(%v,%v)", topoproto.TabletTypeLString(tabletType), strings.Join(shards, ",")))
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	refs := make([]*topodatapb.ShardReference, 0, len(shards))
+	for _, shard := range shards {
+		si, err := wr.ts.GetShard(ctx, keyspace, shard)
+		if err != nil {
+			return err
+		}
+		refs = append(refs, &topodatapb.ShardReference{
+			Name:     si.ShardName(),
+			KeyRange: si.KeyRange,
+		})
+	}
+	newPartition := &topodatapb.SrvKeyspace_KeyspacePartition{
+		ServedType:      tabletType,
+		ShardReferences: refs,
+	}
+	if err := topotools.CheckPartition(newPartition); err != nil {
+		return fmt.Errorf("invalid partition for %v: %v", topoproto.TabletTypeLString(tabletType), err)
+	}
+
+	if len(cells) == 0 {
+		if cells, err = wr.ts.GetCellInfoNames(ctx); err != nil {
+			return err
+		}
+	}
+	srvKeyspaces := make(map[string]*topodatapb.SrvKeyspace)
+	originals := make(map[string]*topodatapb.SrvKeyspace)
+	for _, cell := range cells {
+		srvKeyspace, err := wr.ts.GetSrvKeyspace(ctx, cell, keyspace)
+		switch {
+		case err == nil:
+		case topo.IsErrType(err, topo.NoNode):
+			// The keyspace is not served in this cell.
+			continue
+		default:
+			return err
+		}
+
+		originals[cell] = proto.Clone(srvKeyspace).(*topodatapb.SrvKeyspace)
+		partition := proto.Clone(newPartition).(*topodatapb.SrvKeyspace_KeyspacePartition)
+		var before []string
+		if old := topoproto.SrvKeyspaceGetPartition(srvKeyspace, tabletType); old != nil {
+			before = topotools.NewPartitionView(cell, old).Shards
+			old.ShardReferences = partition.ShardReferences
+		} else {
+			srvKeyspace.Partitions = append(srvKeyspace.Partitions, partition)
+		}
+		wr.Logger().Printf("Cell %v: %v served by %v instead of %v\n", cell, topoproto.TabletTypeLString(tabletType), topotools.NewPartitionView(cell, partition).Shards, before)
+		srvKeyspaces[cell] = srvKeyspace
+	}
+	if len(srvKeyspaces) == 0 {
+		return fmt.Errorf("keyspace %v has no SrvKeyspace in cells %v, run RebuildKeyspaceGraph first", keyspace, cells)
+	}
+	if dryRun {
+		return nil
+	}
+
+	updatedCells := make([]string, 0, len(srvKeyspaces))
+	for cell := range srvKeyspaces {
+		updatedCells = append(updatedCells, cell)
+	}
+	sort.Strings(updatedCells)
+	for i, cell := range updatedCells {
+		if err := wr.ts.UpdateSrvKeyspace(ctx, cell, keyspace, srvKeyspaces[cell]); err != nil {
+			for _, restored := range updatedCells[:i] {
+				if err := wr.ts.UpdateSrvKeyspace(ctx, restored, keyspace, originals[restored]); err != nil {
+					wr.Logger().Errorf("cannot restore the SrvKeyspace of cell %v: %v", restored, err)
+				}
+			}
+			return fmt.Errorf("cannot update the SrvKeyspace of cell %v: %v", cell, err)
+		}
+	}
+	return nil
+}
+
+// ValidateSrvKeyspacePartitions checks the SrvKeyspace of a keyspace in
+// the cells, or in all the cells if cells is empty. The shards of each
+// partition must serve each keyspace id exactly once, and must exist
+// with the same key range as in the SrvKeyspace.
+func (wr *Wrangler) ValidateSrvKeyspacePartitions(ctx context.Context, keyspace string, cells []string) error {
+	shards, err := wr.ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	if len(cells) == 0 {
+		if cells, err = wr.ts.GetCellInfoNames(ctx); err != nil {
+			return err
+		}
+	}
+
+	var problems []string
+	for _, cell := range cells {
+		srvKeyspace, err := wr.ts.GetSrvKeyspace(ctx, cell, keyspace)
+		switch {
+		case err == nil:
+		case topo.IsErrType(err, topo.NoNode):
+			continue
+		default:
+			return err
+		}
+		for _, partition := range srvKeyspace.Partitions {
+			tabletType := topoproto.TabletTypeLString(partition.ServedType)
+			if err := topotools.CheckPartition(partition); err != nil {
+				problems = append(problems, fmt.Sprintf("cell %v, %v: %v", cell, tabletType, err))
+			}
+			for _, ref := range partition.ShardReferences {
+				si, ok := shards[ref.Name]
+				switch {
+				case !ok:
+					problems = append(problems, fmt.Sprintf("cell %v, %v: shard %v does not exist", cell, tabletType, ref.Name))
+				case !key.KeyRangeEqual(si.KeyRange, ref.KeyRange):
+					problems = append(problems, fmt.Sprintf("cell %v, %v: shard %v has key range %v, not %v", cell, tabletType, ref.Name, key.KeyRangeString(si.KeyRange), key.KeyRangeString(ref.KeyRange)))
+				}
+			}
+		}
+	}
+	if len(problems) != 0 {
+		return fmt.Errorf("invalid SrvKeyspace partitions for keyspace %v:\n%v", keyspace, strings.Join(problems, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestSetSrvKeyspacePartition(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := New(logutil.NewMemoryLogger(), ts, nil)
+
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, shard := range []string{"0", "-80", "80-"} {
+		if err := ts.CreateShard(ctx, "ks", shard); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The shards are all serving, only 0 is in the SrvKeyspace.
+	for _, cell := range []string{"cell1", "cell2"} {
+		if err := ts.UpdateSrvKeyspace(ctx, cell, "ks", &topodatapb.SrvKeyspace{
+			Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{{
+				ServedType:      topodatapb.TabletType_MASTER,
+				ShardReferences: []*topodatapb.ShardReference{{Name: "0"}},
+			}, {
+				ServedType:      topodatapb.TabletType_REPLICA,
+				ShardReferences: []*topodatapb.ShardReference{{Name: "0"}},
+			}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := wr.ValidateSrvKeyspacePartitions(ctx, "ks", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	servedBy := func(cell string, tabletType topodatapb.TabletType) []string {
+		t.Helper()
+		srvKeyspace, err := ts.GetSrvKeyspace(ctx, cell, "ks")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return topotools.NewPartitionView(cell, topoproto.SrvKeyspaceGetPartition(srvKeyspace, tabletType)).Shards
+	}
+
+	// A partial partition is rejected.
+	err := wr.SetSrvKeyspacePartition(ctx, "ks", topodatapb.TabletType_REPLICA, []string{"-80"}, nil, false)
+	if err == nil || err.Error() != "invalid partition for replica: no shard serves 80-" {
+		t.Errorf("SetSrvKeyspacePartition(-80): %v", err)
+	}
+	// An overlapping partition is rejected.
+	err = wr.SetSrvKeyspacePartition(ctx, "ks", topodatapb.TabletType_REPLICA, []string{"-80", "80-", "0"}, nil, false)
+	if err == nil || err.Error() != "invalid partition for replica: some shards have a key range and some don't" {
+		t.Errorf("SetSrvKeyspacePartition(-80,80-,0): %v", err)
+	}
+
+	// A dry run doesn't change the SrvKeyspace.
+	if err := wr.SetSrvKeyspacePartition(ctx, "ks", topodatapb.TabletType_REPLICA, []string{"80-", "-80"}, nil, true); err != nil {
+		t.Fatal(err)
+	}
+	if got := servedBy("cell1", topodatapb.TabletType_REPLICA); strings.Join(got, ",") != "0" {
+		t.Errorf("replica shards after the dry run: %v, want 0", got)
+	}
+
+	// Only cell2 is changed.
+	if err := wr.SetSrvKeyspacePartition(ctx, "ks", topodatapb.TabletType_REPLICA, []string{"80-", "-80"}, []string{"cell2"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if got := servedBy("cell1", topodatapb.TabletType_REPLICA); strings.Join(got, ",") != "0" {
+		t.Errorf("replica shards of cell1: %v, want 0", got)
+	}
+	if got := servedBy("cell2", topodatapb.TabletType_REPLICA); strings.Join(got, ",") != "-80,80-" {
+		t.Errorf("replica shards of cell2: %v, want -80,80-", got)
+	}
+	if got := servedBy("cell2", topodatapb.TabletType_MASTER); strings.Join(got, ",") != "0" {
+		t.Errorf("master shards of cell2: %v, want 0", got)
+	}
+
+	// A new partition is added.
+	if err := wr.SetSrvKeyspacePartition(ctx, "ks", topodatapb.TabletType_RDONLY, []string{"0"}, nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if got := servedBy("cell1", topodatapb.TabletType_RDONLY); strings.Join(got, ",") != "0" {
+		t.Errorf("rdonly shards of cell1: %v, want 0", got)
+	}
+	if err := wr.ValidateSrvKeyspacePartitions(ctx, "ks", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The SrvKeyspace of cell1 references an unknown shard and a wrong
+	// key range.
+	if err := ts.UpdateSrvKeyspace(ctx, "cell1", "ks", &topodatapb.SrvKeyspace{
+		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{{
+			ServedType: topodatapb.TabletType_MASTER,
+			ShardReferences: []*topodatapb.ShardReference{{
+				Name:     "-80",
+				KeyRange: &topodatapb.KeyRange{End: []byte{0x40}},
+			}, {
+				Name:     "40-",
+				KeyRange: &topodatapb.KeyRange{Start: []byte{0x40}},
+			}},
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	want := "invalid SrvKeyspace partitions for keyspace ks:\n" +
+		"cell cell1, master: shard -80 has key range -80, not -40\n" +
+		"cell cell1, master: shard 40- does not exist"
+	if err := wr.ValidateSrvKeyspacePartitions(ctx, "ks", nil); err == nil || err.Error() != want {
+		t.Errorf("ValidateSrvKeyspacePartitions: %v, want %v", err, want)
+	}
+	if err := wr.ValidateSrvKeyspacePartitions(ctx, "ks", []string{"cell2"}); err != nil {
+		t.Errorf("ValidateSrvKeyspacePartitions(cell2): %v", err)
+	}
+}
+
+// readOnlyCellFactory is a topo factory whose connection to one cell
+// fails the updates.
+type readOnlyCellFactory struct {
+	topo.Factory
+	cell string
+}
+
+func (f *readOnlyCellFactory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	conn, err := f.Factory.Create(cell, serverAddr, root)
+	if err != nil || cell != f.cell {
+		return conn, err
+	}
+	return &readOnlyConn{Conn: conn}, nil
+}
+
+type readOnlyConn struct {
+	topo.Conn
+}
+
+func (c *readOnlyConn) Update(ctx context.Context, filePath string, contents []byte, version topo.Version) (topo.Version, error) {
+	return nil, errors.New("read-only cell")
+}
+
+func TestSetSrvKeyspacePartitionRestore(t *testing.T) {
+	ctx := context.Background()
+	base, factory := memorytopo.NewServerAndFactory("cell1", "cell2")
+	ts, err := topo.NewWithFactory(&readOnlyCellFactory{Factory: factory, cell: "cell2"}, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wr := New(logutil.NewMemoryLogger(), ts, nil)
+
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, shard := range []string{"0", "-80", "80-"} {
+		if err := ts.CreateShard(ctx, "ks", shard); err != nil {
+			t.Fatal(err)
+		}
+	}
+	srvKeyspace := &topodatapb.SrvKeyspace{
+		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{{
+			ServedType:      topodatapb.TabletType_REPLICA,
+			ShardReferences: []*topodatapb.ShardReference{{Name: "0"}},
+		}},
+	}
+	for _, cell := range []string{"cell1", "cell2"} {
+		if err := base.UpdateSrvKeyspace(ctx, cell, "ks", srvKeyspace); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// cell1 is updated first, then restored when cell2 fails.
+	err = wr.SetSrvKeyspacePartition(ctx, "ks", topodatapb.TabletType_REPLICA, []string{"-80", "80-"}, nil, false)
+	want := "cannot update the SrvKeyspace of cell cell2: read-only cell"
+	if err == nil || err.Error() != want {
+		t.Errorf("SetSrvKeyspacePartition: %v, want %v", err, want)
+	}
+	got, err := ts.GetSrvKeyspace(ctx, "cell1", "ks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shards := topotools.NewPartitionView("cell1", topoproto.SrvKeyspaceGetPartition(got, topodatapb.TabletType_REPLICA)).Shards; strings.Join(shards, ",") != "0" {
+		t.Errorf("replica shards of cell1: %v, want 0", shards)
+	}
+}
@@ -332,6 +332,10 @@ func (sm *streamMigrater) verifyStreamPositions(ctx context.Context, stopPositio
 		for _, vrs := range tabletStreams {
 			key := fmt.Sprintf("%s:%s", vrs.bls.Keyspace, vrs.bls.Shard)
 			pos := stopPositions[key]
+			if sm.mi.wr.plan != nil {
+				// The streams of a dry run were not really synced.
+				vrs.pos = pos
+			}
 			if !vrs.pos.Equal(pos) {
 				allErrors.RecordError(fmt.Errorf("%s: stream %d position: %s does not match %s", key, vrs.id, mysql.EncodePosition(vrs.pos), mysql.EncodePosition(pos)))
 			}
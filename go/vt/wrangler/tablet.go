@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -158,11 +159,48 @@ func (wr *Wrangler) ChangeSlaveType(ctx context.Context, tabletAlias *topodatapb
 	if !topo.IsTrivialTypeChange(ti.Type, tabletType) {
 		return fmt.Errorf("tablet %v type change %v -> %v is not an allowed transition for ChangeSlaveType", tabletAlias, ti.Type, tabletType)
 	}
+	if ti.Type == topodatapb.TabletType_REPLICA && tabletType != topodatapb.TabletType_REPLICA {
+		if err := wr.checkReplicaRemoval(ctx, ti); err != nil {
+			return err
+		}
+	}
 
 	// and ask the tablet to make the change
 	return wr.tmc.ChangeType(ctx, ti.Tablet, tabletType)
 }
 
+// checkReplicaRemoval returns an error if the master of the shard of the
+// provided REPLICA tablet would not get enough semi-sync acks without it.
+func (wr *Wrangler) checkReplicaRemoval(ctx context.Context, ti *topo.TabletInfo) error {
+	ki, err := wr.ts.GetKeyspace(ctx, ti.Keyspace)
+	if err != nil {
+		return err
+	}
+	durability, err := topotools.ParseDurabilityPolicy(ki.DurabilityPolicy)
+	if err != nil {
+		return vterrors.Wrapf(err, "invalid durability policy for keyspace %v", ti.Keyspace)
+	}
+	if durability == nil {
+		return nil
+	}
+	si, err := wr.ts.GetShard(ctx, ti.Keyspace, ti.Shard)
+	if err != nil {
+		return err
+	}
+	if !si.HasMaster() || !durability.IsReplicaSemiSync(si.MasterAlias, ti.Tablet) {
+		return nil
+	}
+	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, ti.Keyspace, ti.Shard)
+	if err != nil {
+		return err
+	}
+	delete(tabletMap, topoproto.TabletAliasString(ti.Alias))
+	if err := topotools.CheckDurability(durability, si.MasterAlias, tabletMap); err != nil {
+		return vterrors.Wrapf(err, "cannot change the type of tablet %v", topoproto.TabletAliasString(ti.Alias))
+	}
+	return nil
+}
+
 // RefreshTabletState refreshes tablet state
 func (wr *Wrangler) RefreshTabletState(ctx context.Context, tabletAlias *topodatapb.TabletAlias) error {
 	// Load tablet to find endpoint, and keyspace and shard assignment.
@@ -116,6 +116,9 @@ func TestBackupRestore(t *testing.T) {
 
 	// create a single tablet, set it up so we can do backups
 	// set its position same as that of master so that backup doesn't wait for catchup
+	// it also has the transactions of another MariaDB domain, that the
+	// master doesn't have in its binlogs anymore: the restored tablet
+	// doesn't need to wait for them.
 	sourceTablet := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
 	sourceTablet.FakeMysqlDaemon.ReadOnly = true
 	sourceTablet.FakeMysqlDaemon.Replicating = true
@@ -126,6 +129,11 @@ func TestBackupRestore(t *testing.T) {
 				Server:   123,
 				Sequence: 457,
 			},
+			mysql.MariadbGTID{
+				Domain:   3,
+				Server:   124,
+				Sequence: 10,
+			},
 		},
 	}
 	sourceTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
@@ -161,15 +169,7 @@ func TestBackupRestore(t *testing.T) {
 	destTablet := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, db)
 	destTablet.FakeMysqlDaemon.ReadOnly = true
 	destTablet.FakeMysqlDaemon.Replicating = true
-	destTablet.FakeMysqlDaemon.CurrentMasterPosition = mysql.Position{
-		GTIDSet: mysql.MariadbGTIDSet{
-			mysql.MariadbGTID{
-				Domain:   2,
-				Server:   123,
-				Sequence: 457,
-			},
-		},
-	}
+	destTablet.FakeMysqlDaemon.CurrentMasterPosition = sourceTablet.FakeMysqlDaemon.CurrentMasterPosition
 	destTablet.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
 		"STOP SLAVE",
 		"RESET SLAVE ALL",
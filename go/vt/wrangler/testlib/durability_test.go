@@ -0,0 +1,116 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testlib
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestDurabilityPolicy(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	master := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, nil)
+	replica1 := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, nil)
+	replica2 := NewFakeTablet(t, wr, "cell2", 2, topodatapb.TabletType_REPLICA, nil)
+	rdonly := NewFakeTablet(t, wr, "cell2", 3, topodatapb.TabletType_RDONLY, nil)
+	for _, tablet := range []*FakeTablet{master, replica1, replica2, rdonly} {
+		tablet.StartActionLoop(t, wr)
+		defer tablet.StopActionLoop(t)
+	}
+	keyspace := master.Tablet.Keyspace
+	keyspaceShard := keyspace + "/" + master.Tablet.Shard
+	if _, err := ts.UpdateShardFields(ctx, keyspace, master.Tablet.Shard, func(si *topo.ShardInfo) error {
+		si.MasterAlias = master.Tablet.Alias
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateShardFields failed: %v", err)
+	}
+
+	// Invalid policies are rejected.
+	err := vp.Run([]string{"SetKeyspaceDurabilityPolicy", keyspace, "quorum"})
+	if err == nil || !strings.Contains(err.Error(), "unknown durability policy quorum") {
+		t.Errorf("SetKeyspaceDurabilityPolicy(quorum): %v", err)
+	}
+
+	if err := vp.Run([]string{"SetKeyspaceDurabilityPolicy", keyspace, "cross_cell:2"}); err != nil {
+		t.Fatal(err)
+	}
+	ki, err := ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ki.DurabilityPolicy != "cross_cell:2" {
+		t.Errorf("DurabilityPolicy: %v, want cross_cell:2", ki.DurabilityPolicy)
+	}
+
+	// Only replica2 can ack for replica1, the reparents are refused
+	// before changing anything.
+	want := "durability policy requires 2 semi-sync ackers for master cell1-0000000001, but only 1 tablets can ack"
+	err = vp.Run([]string{"PlannedReparentShard", "-wait_slave_timeout", "10s", "-keyspace_shard", keyspaceShard, "-new_master", topoproto.TabletAliasString(replica1.Tablet.Alias)})
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("PlannedReparentShard: %v, want %v", err, want)
+	}
+	err = vp.Run([]string{"EmergencyReparentShard", "-wait_slave_timeout", "10s", "-keyspace_shard", keyspaceShard, "-new_master", topoproto.TabletAliasString(replica1.Tablet.Alias)})
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("EmergencyReparentShard: %v, want %v", err, want)
+	}
+	if _, err := ts.GetTablet(ctx, master.Tablet.Alias); err != nil {
+		t.Errorf("the old master was deleted by EmergencyReparentShard: %v", err)
+	}
+	// -force overrides the policy.
+	if err := vp.Run([]string{"EmergencyReparentShard", "-force", "-dry_run", "-wait_slave_timeout", "10s", "-keyspace_shard", keyspaceShard, "-new_master", topoproto.TabletAliasString(replica1.Tablet.Alias)}); err != nil {
+		t.Errorf("EmergencyReparentShard -force: %v", err)
+	}
+
+	// replica2 is the only tablet that can ack for the master.
+	if err := vp.Run([]string{"SetKeyspaceDurabilityPolicy", keyspace, "cross_cell"}); err != nil {
+		t.Fatal(err)
+	}
+	want = "cannot change the type of tablet cell2-0000000002"
+	err = vp.Run([]string{"ChangeSlaveType", topoproto.TabletAliasString(replica2.Tablet.Alias), "rdonly"})
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("ChangeSlaveType: %v, want %v", err, want)
+	}
+
+	// replica1 doesn't ack, it can be changed.
+	if err := vp.Run([]string{"ChangeSlaveType", topoproto.TabletAliasString(replica1.Tablet.Alias), "rdonly"}); err != nil {
+		t.Fatalf("ChangeSlaveType failed: %v", err)
+	}
+	ti, err := ts.GetTablet(ctx, replica1.Tablet.Alias)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Type != topodatapb.TabletType_RDONLY {
+		t.Errorf("replica1 type: %v, want RDONLY", ti.Type)
+	}
+}
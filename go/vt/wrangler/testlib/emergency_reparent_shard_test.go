@@ -209,7 +209,78 @@ func TestEmergencyReparentShardMasterElectNotBest(t *testing.T) {
 	defer moreAdvancedSlave.StopActionLoop(t)
 
 	// run EmergencyReparentShard
-	if err := wr.EmergencyReparentShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, newMaster.Tablet.Alias, 10*time.Second); err == nil || !strings.Contains(err.Error(), "is more advanced than master elect tablet") {
+	if err := wr.EmergencyReparentShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, newMaster.Tablet.Alias, false /* force */, 10*time.Second); err == nil || !strings.Contains(err.Error(), "is more advanced than master elect tablet") {
+		t.Fatalf("EmergencyReparentShard returned the wrong error: %v", err)
+	}
+
+	// check what was run
+	if err := newMaster.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Fatalf("newMaster.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
+	}
+	if err := oldMaster.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Fatalf("oldMaster.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
+	}
+	if err := moreAdvancedSlave.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Fatalf("moreAdvancedSlave.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
+	}
+}
+
+func TestEmergencyReparentShardDivergedPositions(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+
+	// Create a master, and two slaves that received different
+	// transactions in different MariaDB domains.
+	oldMaster := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, nil)
+	newMaster := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, nil)
+	moreAdvancedSlave := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, nil)
+
+	// new master
+	newMaster.FakeMysqlDaemon.Replicating = true
+	newMaster.FakeMysqlDaemon.CurrentMasterPosition = mysql.Position{
+		GTIDSet: mysql.MariadbGTIDSet{
+			mysql.MariadbGTID{
+				Domain:   2,
+				Server:   123,
+				Sequence: 456,
+			},
+		},
+	}
+	newMaster.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE",
+	}
+	newMaster.StartActionLoop(t, wr)
+	defer newMaster.StopActionLoop(t)
+
+	// old master, will be scrapped
+	oldMaster.StartActionLoop(t, wr)
+	defer oldMaster.StopActionLoop(t)
+
+	// slave behind in domain 2, but ahead in domain 3
+	moreAdvancedSlave.FakeMysqlDaemon.Replicating = true
+	moreAdvancedSlave.FakeMysqlDaemon.CurrentMasterPosition = mysql.Position{
+		GTIDSet: mysql.MariadbGTIDSet{
+			mysql.MariadbGTID{
+				Domain:   2,
+				Server:   123,
+				Sequence: 455,
+			},
+			mysql.MariadbGTID{
+				Domain:   3,
+				Server:   124,
+				Sequence: 10,
+			},
+		},
+	}
+	moreAdvancedSlave.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE",
+	}
+	moreAdvancedSlave.StartActionLoop(t, wr)
+	defer moreAdvancedSlave.StopActionLoop(t)
+
+	// run EmergencyReparentShard
+	if err := wr.EmergencyReparentShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, newMaster.Tablet.Alias, false /* force */, 10*time.Second); err == nil || !strings.Contains(err.Error(), "has transactions that master elect tablet cell1-0000000001 doesn't have, their positions diverged: MariaDB/2-123-455,3-124-10 and MariaDB/2-123-456") {
 		t.Fatalf("EmergencyReparentShard returned the wrong error: %v", err)
 	}
 
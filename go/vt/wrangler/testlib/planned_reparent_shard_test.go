@@ -494,3 +494,72 @@ func TestPlannedReparentShardPromoteSlaveTimeout(t *testing.T) {
 		t.Errorf("oldMaster.FakeMysqlDaemon.ReadOnly set")
 	}
 }
+
+func TestPlannedReparentShardPreflightChecks(t *testing.T) {
+	ts := memorytopo.NewServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	// Create a master, a lagging new master and a slave that is not replicating.
+	oldMaster := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, nil)
+	newMaster := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, nil)
+	goodSlave1 := NewFakeTablet(t, wr, "cell2", 2, topodatapb.TabletType_REPLICA, nil)
+
+	// new master is lagging
+	newMaster.FakeMysqlDaemon.ReadOnly = true
+	newMaster.FakeMysqlDaemon.Replicating = true
+	newMaster.FakeMysqlDaemon.SecondsBehindMaster = 100
+	newMaster.StartActionLoop(t, wr)
+	defer newMaster.StopActionLoop(t)
+
+	// old master
+	oldMaster.FakeMysqlDaemon.ReadOnly = false
+	oldMaster.FakeMysqlDaemon.Replicating = false
+	oldMaster.FakeMysqlDaemon.SlaveStatusError = mysql.ErrNotSlave
+	oldMaster.StartActionLoop(t, wr)
+	defer oldMaster.StopActionLoop(t)
+	oldMaster.Agent.QueryServiceControl.(*tabletservermock.Controller).SetQueryServiceEnabledForTests(true)
+
+	// good slave 1 is not replicating
+	goodSlave1.FakeMysqlDaemon.ReadOnly = true
+	goodSlave1.FakeMysqlDaemon.Replicating = false
+	goodSlave1.StartActionLoop(t, wr)
+	defer goodSlave1.StopActionLoop(t)
+
+	// run PlannedReparentShard, both checks fail
+	err := vp.Run([]string{"PlannedReparentShard", "-wait_slave_timeout", "10s", "-keyspace_shard", newMaster.Tablet.Keyspace + "/" + newMaster.Tablet.Shard, "-new_master", topoproto.TabletAliasString(newMaster.Tablet.Alias),
+		"-preflight_checks", "replication_lag,semi_sync", "-max_replication_lag", "10s", "-min_semi_sync_acks", "2"})
+	if err == nil {
+		t.Fatalf("PlannedReparentShard succeeded, want pre-flight checks to fail")
+	}
+	for _, want := range []string{
+		"pre-flight checks failed",
+		"replication_lag: master-elect cell1-0000000001 is lagging by 1m40s, more than 10s",
+		"semi_sync: only 1 replicas can send semi-sync acks to the new master, need 2",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("PlannedReparentShard failed with %v, want it to contain %v", err, want)
+		}
+	}
+
+	// nothing was changed
+	if err := newMaster.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("newMaster.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
+	}
+	if err := oldMaster.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("oldMaster.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
+	}
+	if !newMaster.FakeMysqlDaemon.ReadOnly {
+		t.Errorf("newMaster.FakeMysqlDaemon.ReadOnly not set")
+	}
+	if oldMaster.FakeMysqlDaemon.ReadOnly {
+		t.Errorf("oldMaster.FakeMysqlDaemon.ReadOnly set")
+	}
+
+	// unknown checks are rejected
+	err = vp.Run([]string{"PlannedReparentShard", "-keyspace_shard", newMaster.Tablet.Keyspace + "/" + newMaster.Tablet.Shard, "-preflight_checks", "unknown"})
+	if err == nil || !strings.Contains(err.Error(), "unknown reparent check: unknown") {
+		t.Errorf("PlannedReparentShard failed with %v, want unknown reparent check", err)
+	}
+}
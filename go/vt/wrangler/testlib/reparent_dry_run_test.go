@@ -0,0 +1,209 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testlib
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// checkPlan checks the steps of plan start with want. Each group of want
+// lists the steps that are run concurrently, which can be in any order.
+func checkPlan(t *testing.T, plan *wrangler.DryRunPlan, want [][]string) {
+	t.Helper()
+	steps := plan.Steps()
+	count := 0
+	for _, group := range want {
+		count += len(group)
+	}
+	if len(steps) != count {
+		t.Fatalf("got %v steps, want %v:\n%v", len(steps), count, plan)
+	}
+	for _, group := range want {
+		groupSteps := steps[:len(group)]
+		steps = steps[len(group):]
+		for _, prefix := range group {
+			found := false
+			for i, step := range groupSteps {
+				if strings.HasPrefix(step, prefix) {
+					groupSteps = append(groupSteps[:i:i], groupSteps[i+1:]...)
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Errorf("no step starts with %q:\n%v", prefix, plan)
+			}
+		}
+	}
+}
+
+// checkTabletType checks the type of the tablet record of ft.
+func checkTabletType(t *testing.T, ts *topo.Server, ft *FakeTablet, want topodatapb.TabletType) {
+	t.Helper()
+	ti, err := ts.GetTablet(context.Background(), ft.Tablet.Alias)
+	if err != nil {
+		t.Fatalf("GetTablet(%v) failed: %v", ft.Tablet.Alias, err)
+	}
+	if ti.Type != want {
+		t.Errorf("tablet %v has type %v, want %v", ti.AliasString(), ti.Type, want)
+	}
+}
+
+func TestPlannedReparentShardDryRun(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+
+	oldMaster := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, nil)
+	newMaster := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, nil)
+	replica := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, nil)
+	oldMaster.FakeMysqlDaemon.ReadOnly = false
+	newMaster.FakeMysqlDaemon.ReadOnly = true
+	replica.FakeMysqlDaemon.ReadOnly = true
+	for _, ft := range []*FakeTablet{oldMaster, newMaster, replica} {
+		ft.StartActionLoop(t, wr)
+		defer ft.StopActionLoop(t)
+	}
+
+	if err := wr.SetKeyspaceDurabilityPolicy(ctx, newMaster.Tablet.Keyspace, "semi_sync:2"); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := wr.PlannedReparentShardDryRun(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, newMaster.Tablet.Alias, nil, 10*time.Second, nil)
+	if err != nil {
+		t.Fatalf("PlannedReparentShardDryRun failed: %v", err)
+	}
+	checkPlan(t, plan, [][]string{
+		{"Lock keyspaces/test_keyspace/shards/0 in the global topo"},
+		{"Use cell1-0000000001 as the master-elect"},
+		{"Check that the tablets can send the 2 semi-sync acks the durability policy semi_sync:2 of keyspace test_keyspace requires"},
+		{"cell1-0000000001: SetMaster: STOP SLAVE, CHANGE MASTER TO MASTER_HOST='localhost', MASTER_PORT=3300, SET GLOBAL rpl_semi_sync_master_enabled = 0, rpl_semi_sync_slave_enabled = 1 (durability policy semi_sync:2), START SLAVE"},
+		{"cell1-0000000000: DemoteMaster: stop serving writes, SET GLOBAL read_only = ON (super_read_only with -use_super_read_only), SET GLOBAL rpl_semi_sync_master_enabled = 0, rpl_semi_sync_slave_enabled = 0 (durability policy semi_sync:2)"},
+		{"cell1-0000000001: PromoteSlaveWhenCaughtUp: wait to reach position , STOP SLAVE, RESET SLAVE ALL, FLUSH BINARY LOGS, SET GLOBAL rpl_semi_sync_master_enabled = 1, rpl_semi_sync_slave_enabled = 0, rpl_semi_sync_master_wait_for_slave_count = 2 (durability policy semi_sync:2), SET GLOBAL read_only = OFF, change the tablet type to MASTER"},
+		{
+			"cell1-0000000000: SetMaster: STOP SLAVE, CHANGE MASTER TO MASTER_HOST='localhost', MASTER_PORT=3301,",
+			"cell1-0000000002: SetMaster: STOP SLAVE, CHANGE MASTER TO MASTER_HOST='localhost', MASTER_PORT=3301,",
+			"cell1-0000000001: PopulateReparentJournal:",
+		},
+		{"Unlock keyspaces/test_keyspace/shards/0 in the global topo"},
+	})
+
+	// Nothing was changed.
+	checkTabletType(t, ts, oldMaster, topodatapb.TabletType_MASTER)
+	checkTabletType(t, ts, newMaster, topodatapb.TabletType_REPLICA)
+	if oldMaster.FakeMysqlDaemon.ReadOnly {
+		t.Errorf("oldMaster.FakeMysqlDaemon.ReadOnly set")
+	}
+	if !newMaster.FakeMysqlDaemon.ReadOnly {
+		t.Errorf("newMaster.FakeMysqlDaemon.ReadOnly not set")
+	}
+	for _, ft := range []*FakeTablet{oldMaster, newMaster, replica} {
+		if err := ft.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+			t.Errorf("%v: CheckSuperQueryList failed: %v", ft.Tablet.Alias, err)
+		}
+	}
+
+	// The checks of PlannedReparentShard are run.
+	if _, err := wr.PlannedReparentShardDryRun(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, newMaster.Tablet.Alias, newMaster.Tablet.Alias, 10*time.Second, nil); err == nil || !strings.Contains(err.Error(), "is the same as the tablet to avoid") {
+		t.Errorf("PlannedReparentShardDryRun with the same master-elect and tablet to avoid: got %v", err)
+	}
+}
+
+func TestEmergencyReparentShardDryRun(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+
+	// The old master is not running.
+	oldMaster := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, nil)
+	newMaster := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, nil)
+	replica := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, nil)
+	newMaster.FakeMysqlDaemon.ReadOnly = true
+	newMaster.FakeMysqlDaemon.Replicating = true
+	newMaster.FakeMysqlDaemon.CurrentMasterPosition = mysql.Position{
+		GTIDSet: mysql.MariadbGTIDSet{
+			mysql.MariadbGTID{Domain: 2, Server: 123, Sequence: 456},
+		},
+	}
+	replica.FakeMysqlDaemon.ReadOnly = true
+	replica.FakeMysqlDaemon.Replicating = true
+	replica.FakeMysqlDaemon.CurrentMasterPosition = mysql.Position{
+		GTIDSet: mysql.MariadbGTIDSet{
+			mysql.MariadbGTID{Domain: 2, Server: 123, Sequence: 455},
+		},
+	}
+	for _, ft := range []*FakeTablet{newMaster, replica} {
+		ft.StartActionLoop(t, wr)
+		defer ft.StopActionLoop(t)
+	}
+	if _, err := ts.UpdateShardFields(ctx, oldMaster.Tablet.Keyspace, oldMaster.Tablet.Shard, func(si *topo.ShardInfo) error {
+		si.MasterAlias = oldMaster.Tablet.Alias
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateShardFields failed: %v", err)
+	}
+
+	plan, err := wr.EmergencyReparentShardDryRun(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, newMaster.Tablet.Alias, false /* force */, 10*time.Second)
+	if err != nil {
+		t.Fatalf("EmergencyReparentShardDryRun failed: %v", err)
+	}
+	checkPlan(t, plan, [][]string{
+		{"Lock keyspaces/test_keyspace/shards/0 in the global topo"},
+		{"Update keyspaces/test_keyspace/shards/0/ShardReplication in cell cell1"},
+		{"Delete tablets/cell1-0000000000/Tablet in cell cell1"},
+		{
+			"cell1-0000000001: StopReplicationAndGetStatus: STOP SLAVE, read the replication position",
+			"cell1-0000000002: StopReplicationAndGetStatus: STOP SLAVE, read the replication position",
+		},
+		{"Check that no tablet has transactions the master-elect cell1-0000000001 does not have"},
+		{"cell1-0000000001: PromoteSlave: STOP SLAVE, RESET SLAVE ALL, FLUSH BINARY LOGS,"},
+		{
+			"cell1-0000000002: SetMaster: STOP SLAVE, CHANGE MASTER TO MASTER_HOST='localhost', MASTER_PORT=3301,",
+			"cell1-0000000001: PopulateReparentJournal: INSERT INTO _vt.reparent_journal",
+		},
+		{"Unlock keyspaces/test_keyspace/shards/0 in the global topo"},
+	})
+
+	// Nothing was changed.
+	checkTabletType(t, ts, oldMaster, topodatapb.TabletType_MASTER)
+	checkTabletType(t, ts, newMaster, topodatapb.TabletType_REPLICA)
+	if !newMaster.FakeMysqlDaemon.Replicating || !replica.FakeMysqlDaemon.Replicating {
+		t.Errorf("replication was stopped")
+	}
+
+	// A replica more advanced than the master-elect fails the dry run.
+	replica.FakeMysqlDaemon.CurrentMasterPosition = mysql.Position{
+		GTIDSet: mysql.MariadbGTIDSet{
+			mysql.MariadbGTID{Domain: 2, Server: 123, Sequence: 457},
+		},
+	}
+	if _, err := wr.EmergencyReparentShardDryRun(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, newMaster.Tablet.Alias, false /* force */, 10*time.Second); err == nil || !strings.Contains(err.Error(), "is more advanced than master elect tablet") {
+		t.Errorf("EmergencyReparentShardDryRun with a more advanced replica: got %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testlib
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestSchemaDriftShard(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	master := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, nil)
+	replica := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, nil)
+	rdonly := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_RDONLY, nil)
+	for _, ft := range []*FakeTablet{master, replica, rdonly} {
+		ft.StartActionLoop(t, wr)
+		defer ft.StopActionLoop(t)
+	}
+
+	schema := &tabletmanagerdatapb.SchemaDefinition{
+		DatabaseSchema: "CREATE DATABASE `{{.DatabaseName}}` /*!40100 DEFAULT CHARACTER SET utf8 */",
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			{
+				Name:   "table1",
+				Schema: "CREATE TABLE `table1` (\n  `id` bigint(20) NOT NULL,\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB",
+				Type:   tmutils.TableBaseTable,
+			},
+		},
+	}
+	master.FakeMysqlDaemon.Schema = schema
+	replica.FakeMysqlDaemon.Schema = schema
+	rdonly.FakeMysqlDaemon.Schema = schema
+
+	masterAlias, drifts, err := wr.SchemaDriftShard(ctx, master.Tablet.Keyspace, master.Tablet.Shard, nil, false)
+	if err != nil {
+		t.Fatalf("SchemaDriftShard failed: %v", err)
+	}
+	if !proto.Equal(masterAlias, master.Tablet.Alias) {
+		t.Errorf("SchemaDriftShard master: got %v, want %v", masterAlias, master.Tablet.Alias)
+	}
+	if len(drifts) != 0 {
+		t.Errorf("SchemaDriftShard: got %v, want no drift", drifts)
+	}
+
+	// The rdonly drifts.
+	drifted := proto.Clone(schema).(*tabletmanagerdatapb.SchemaDefinition)
+	drifted.TableDefinitions[0].Schema = "CREATE TABLE `table1` (\n  `id` bigint(20) NOT NULL,\n  `msg` varchar(64),\n  PRIMARY KEY (`id`)\n) ENGINE=InnoDB"
+	rdonly.FakeMysqlDaemon.Schema = drifted
+	_, drifts, err = wr.SchemaDriftShard(ctx, master.Tablet.Keyspace, master.Tablet.Shard, nil, false)
+	if err != nil {
+		t.Fatalf("SchemaDriftShard failed: %v", err)
+	}
+	if len(drifts) != 1 || !proto.Equal(drifts[0].Alias, rdonly.Tablet.Alias) {
+		t.Fatalf("SchemaDriftShard: got %v, want a drift of %v", drifts, topoproto.TabletAliasString(rdonly.Tablet.Alias))
+	}
+	if len(drifts[0].Diffs) != 1 || !strings.Contains(drifts[0].Diffs[0], "schemas differ on table table1") {
+		t.Errorf("SchemaDriftShard diffs: got %v", drifts[0].Diffs)
+	}
+
+	// A tablet that can't be read doesn't hide the drift of the others.
+	replica.FakeMysqlDaemon.Schema = nil
+	_, drifts, err = wr.SchemaDriftShard(ctx, master.Tablet.Keyspace, master.Tablet.Shard, nil, false)
+	if err == nil {
+		t.Errorf("SchemaDriftShard with an unreachable tablet succeeded")
+	}
+	if len(drifts) != 1 {
+		t.Errorf("SchemaDriftShard: got %v, want a drift of %v", drifts, topoproto.TabletAliasString(rdonly.Tablet.Alias))
+	}
+}
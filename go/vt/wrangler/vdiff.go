@@ -44,6 +44,10 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 )
 
+// maxVDiffSampleRows is the maximum number of rows of each kind of
+// difference that are reported as samples.
+const maxVDiffSampleRows = 10
+
 // DiffReport is the summary of differences for one table.
 type DiffReport struct {
 	ProcessedRows   int
@@ -51,6 +55,22 @@ type DiffReport struct {
 	MismatchedRows  int
 	ExtraRowsSource int
 	ExtraRowsTarget int
+
+	// Samples of the differences, with up to maxVDiffSampleRows
+	// rows of each kind.
+	MismatchedRowsSample  []*MismatchedRow `json:",omitempty"`
+	ExtraRowsSourceSample []RowSample      `json:",omitempty"`
+	ExtraRowsTargetSample []RowSample      `json:",omitempty"`
+}
+
+// RowSample is a row of a table, keyed by column name.
+type RowSample map[string]sqltypes.Value
+
+// MismatchedRow is a row that has the same primary key on the source
+// and the target, but different values.
+type MismatchedRow struct {
+	Source RowSample
+	Target RowSample
 }
 
 type vdiff struct {
@@ -58,9 +78,12 @@ type vdiff struct {
 	sourceCell     string
 	targetCell     string
 	tabletTypesStr string
-	differs        map[string]*tableDiffer
-	sources        map[string]*dfParams
-	targets        map[string]*dfParams
+	// maxRowsPerSecond throttles the comparison of each table,
+	// to limit the load on the source and target tablets.
+	maxRowsPerSecond int64
+	differs          map[string]*tableDiffer
+	sources          map[string]*dfParams
+	targets          map[string]*dfParams
 }
 
 type tableDiffer struct {
@@ -71,6 +94,9 @@ type tableDiffer struct {
 	comparePKs       []int
 	sourcePrimitive  engine.Primitive
 	targetPrimitive  engine.Primitive
+	// columns are the names of the compared columns, which are
+	// the first columns of the rows.
+	columns []string
 }
 
 type dfParams struct {
@@ -83,8 +109,10 @@ type dfParams struct {
 }
 
 // VDiff reports differences between the sources and targets of a vreplication workflow.
+// If maxRowsPerSecond is not 0, the comparison of each table is throttled to
+// that many rows per second, so that it can run online with less impact.
 func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceCell, targetCell, tabletTypesStr string,
-	filteredReplicationWaitTime, healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout time.Duration) (map[string]*DiffReport, error) {
+	filteredReplicationWaitTime, healthcheckTopologyRefresh, healthcheckRetryDelay, healthcheckTimeout time.Duration, maxRowsPerSecond int64) (map[string]*DiffReport, error) {
 	if sourceCell == "" && targetCell == "" {
 		cells, err := wr.ts.GetCellInfoNames(ctx)
 		if err != nil {
@@ -113,12 +141,13 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 		return nil, err
 	}
 	df := &vdiff{
-		mi:             mi,
-		sourceCell:     sourceCell,
-		targetCell:     targetCell,
-		tabletTypesStr: tabletTypesStr,
-		sources:        make(map[string]*dfParams),
-		targets:        make(map[string]*dfParams),
+		mi:               mi,
+		sourceCell:       sourceCell,
+		targetCell:       targetCell,
+		tabletTypesStr:   tabletTypesStr,
+		maxRowsPerSecond: maxRowsPerSecond,
+		sources:          make(map[string]*dfParams),
+		targets:          make(map[string]*dfParams),
 	}
 	for shard, source := range mi.sources {
 		df.sources[shard] = &dfParams{
@@ -175,7 +204,7 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 		if err := df.restartTargets(ctx); err != nil {
 			return nil, vterrors.Wrap(err, "restartTargets")
 		}
-		dr, err := td.diff(ctx, df.mi.wr, sourceReader, targetReader)
+		dr, err := td.diff(ctx, df.mi.wr, sourceReader, targetReader, newRowThrottler(df.maxRowsPerSecond))
 		if err != nil {
 			return nil, vterrors.Wrap(err, "diff")
 		}
@@ -268,6 +297,7 @@ func buildDifferPlan(table *tabletmanagerdatapb.TableDefinition, query string) (
 	td.compareCols = make([]int, len(sourceSelect.SelectExprs))
 	for i := range td.compareCols {
 		colname := targetSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name.Lowered()
+		td.columns = append(td.columns, colname)
 		typ, ok := fields[colname]
 		if !ok {
 			return nil, fmt.Errorf("column %v not found in table %v", colname, table.Name)
@@ -612,7 +642,8 @@ func (pe *primitiveExecutor) next() ([]sqltypes.Value, error) {
 	return row, nil
 }
 
-func (pe *primitiveExecutor) drain(ctx context.Context) (int, error) {
+// drain reads the remaining rows, and calls f for each of them.
+func (pe *primitiveExecutor) drain(ctx context.Context, f func([]sqltypes.Value) error) (int, error) {
 	count := 0
 	for {
 		row, err := pe.next()
@@ -622,6 +653,9 @@ func (pe *primitiveExecutor) drain(ctx context.Context) (int, error) {
 		if row == nil {
 			return count, nil
 		}
+		if err := f(row); err != nil {
+			return 0, err
+		}
 		count++
 	}
 }
@@ -698,7 +732,7 @@ func (rr *resultReader) StreamExecuteMulti(query string, rss []*srvtopo.Resolved
 //-----------------------------------------------------------------
 // tableDiffer
 
-func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, sourceReader, targetReader *resultReader) (*DiffReport, error) {
+func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, sourceReader, targetReader *resultReader, throttler *rowThrottler) (*DiffReport, error) {
 	sourceExecutor := newPrimitiveExecutor(ctx, sourceReader, td.sourcePrimitive)
 	targetExecutor := newPrimitiveExecutor(ctx, targetReader, td.targetPrimitive)
 	dr := &DiffReport{}
@@ -723,6 +757,9 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, sourceReader, tar
 		if sourceRow == nil && targetRow == nil {
 			return dr, nil
 		}
+		if err := throttler.wait(ctx); err != nil {
+			return nil, err
+		}
 
 		advanceSource = true
 		advanceTarget = true
@@ -730,7 +767,11 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, sourceReader, tar
 		if sourceRow == nil {
 			// drain target, update count
 			wr.Logger().Errorf("Draining extra row(s) found on the target starting with: %v", targetRow)
-			count, err := targetExecutor.drain(ctx)
+			td.sampleExtraRow(&dr.ExtraRowsTargetSample, targetRow)
+			count, err := targetExecutor.drain(ctx, func(row []sqltypes.Value) error {
+				td.sampleExtraRow(&dr.ExtraRowsTargetSample, row)
+				return throttler.wait(ctx)
+			})
 			if err != nil {
 				return nil, err
 			}
@@ -742,7 +783,11 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, sourceReader, tar
 			// no more rows from the target
 			// we know we have rows from source, drain, update count
 			wr.Logger().Errorf("Draining extra row(s) found on the source starting with: %v", sourceRow)
-			count, err := sourceExecutor.drain(ctx)
+			td.sampleExtraRow(&dr.ExtraRowsSourceSample, sourceRow)
+			count, err := sourceExecutor.drain(ctx, func(row []sqltypes.Value) error {
+				td.sampleExtraRow(&dr.ExtraRowsSourceSample, row)
+				return throttler.wait(ctx)
+			})
 			if err != nil {
 				return nil, err
 			}
@@ -762,6 +807,7 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, sourceReader, tar
 			if dr.ExtraRowsSource < 10 {
 				wr.Logger().Errorf("[table=%v] Extra row %v on source: %v", td.targetTable, dr.ExtraRowsSource, sourceRow)
 			}
+			td.sampleExtraRow(&dr.ExtraRowsSourceSample, sourceRow)
 			dr.ExtraRowsSource++
 			advanceTarget = false
 			continue
@@ -769,6 +815,7 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, sourceReader, tar
 			if dr.ExtraRowsTarget < 10 {
 				wr.Logger().Errorf("[table=%v] Extra row %v on target: %v", td.targetTable, dr.ExtraRowsTarget, targetRow)
 			}
+			td.sampleExtraRow(&dr.ExtraRowsTargetSample, targetRow)
 			dr.ExtraRowsTarget++
 			advanceSource = false
 			continue
@@ -784,6 +831,12 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, sourceReader, tar
 			if dr.MismatchedRows < 10 {
 				wr.Logger().Errorf("[table=%v] Different content %v in same PK: %v != %v", td.targetTable, dr.MismatchedRows, sourceRow, targetRow)
 			}
+			if len(dr.MismatchedRowsSample) < maxVDiffSampleRows {
+				dr.MismatchedRowsSample = append(dr.MismatchedRowsSample, &MismatchedRow{
+					Source: td.rowSample(sourceRow),
+					Target: td.rowSample(targetRow),
+				})
+			}
 			dr.MismatchedRows++
 		default:
 			dr.MatchingRows++
@@ -791,6 +844,25 @@ func (td *tableDiffer) diff(ctx context.Context, wr *Wrangler, sourceReader, tar
 	}
 }
 
+// sampleExtraRow adds an extra row to the sample, unless it's full.
+func (td *tableDiffer) sampleExtraRow(sample *[]RowSample, row []sqltypes.Value) {
+	if len(*sample) < maxVDiffSampleRows {
+		*sample = append(*sample, td.rowSample(row))
+	}
+}
+
+// rowSample returns the compared columns of a row, without the
+// weight strings that were added for the comparison.
+func (td *tableDiffer) rowSample(row []sqltypes.Value) RowSample {
+	sample := make(RowSample, len(td.columns))
+	for i, column := range td.columns {
+		if i < len(row) {
+			sample[column] = row[i]
+		}
+	}
+	return sample
+}
+
 func (td *tableDiffer) compare(sourceRow, targetRow []sqltypes.Value, cols []int) (int, error) {
 	for _, col := range cols {
 		if col == -1 {
@@ -856,3 +928,46 @@ func wrapWeightString(expr sqlparser.SelectExpr) *sqlparser.AliasedExpr {
 		},
 	}
 }
+
+//-----------------------------------------------------------------
+// rowThrottler
+
+// rowThrottlerGranularity is the minimum time the rowThrottler sleeps,
+// to not sleep for every row.
+const rowThrottlerGranularity = 50 * time.Millisecond
+
+// rowThrottler limits the rate at which the rows are compared. A
+// diff reads the rows of the source and target tablets as it compares
+// them, so this also throttles the reads on the tablets.
+type rowThrottler struct {
+	maxRowsPerSecond int64
+	start            time.Time
+	rows             int64
+}
+
+func newRowThrottler(maxRowsPerSecond int64) *rowThrottler {
+	return &rowThrottler{
+		maxRowsPerSecond: maxRowsPerSecond,
+		start:            time.Now(),
+	}
+}
+
+// wait counts a row, and waits until the rate is below the maximum.
+func (rt *rowThrottler) wait(ctx context.Context) error {
+	if rt.maxRowsPerSecond <= 0 {
+		return nil
+	}
+	rt.rows++
+	ahead := time.Duration(rt.rows*int64(time.Second)/rt.maxRowsPerSecond) - time.Since(rt.start)
+	if ahead < rowThrottlerGranularity {
+		return nil
+	}
+	timer := time.NewTimer(ahead)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return vterrors.Wrap(ctx.Err(), "throttled vdiff")
+	}
+}
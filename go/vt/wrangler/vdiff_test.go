@@ -17,6 +17,7 @@ limitations under the License.
 package wrangler
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -73,6 +74,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, c2 from t1 order by c1 asc",
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
+			columns:          []string{"c1", "c2"},
 			comparePKs:       []int{0},
 			sourcePrimitive:  newMergeSorter([]int{0}),
 			targetPrimitive:  newMergeSorter([]int{0}),
@@ -88,6 +90,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, c2 from t1 order by c1 asc",
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
+			columns:          []string{"c1", "c2"},
 			comparePKs:       []int{0},
 			sourcePrimitive:  newMergeSorter([]int{0}),
 			targetPrimitive:  newMergeSorter([]int{0}),
@@ -103,6 +106,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, c2 from t1 order by c1 asc",
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
+			columns:          []string{"c1", "c2"},
 			comparePKs:       []int{0},
 			sourcePrimitive:  newMergeSorter([]int{0}),
 			targetPrimitive:  newMergeSorter([]int{0}),
@@ -118,6 +122,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c2, c1 from t1 order by c1 asc",
 			targetExpression: "select c2, c1 from t1 order by c1 asc",
 			compareCols:      []int{0, -1},
+			columns:          []string{"c2", "c1"},
 			comparePKs:       []int{1},
 			sourcePrimitive:  newMergeSorter([]int{1}),
 			targetPrimitive:  newMergeSorter([]int{1}),
@@ -133,6 +138,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c0 as c1, c2 from t2 order by c1 asc",
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
+			columns:          []string{"c1", "c2"},
 			comparePKs:       []int{0},
 			sourcePrimitive:  newMergeSorter([]int{0}),
 			targetPrimitive:  newMergeSorter([]int{0}),
@@ -149,6 +155,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, textcol, weight_string(textcol) from nonpktext order by c1 asc",
 			targetExpression: "select c1, textcol, weight_string(textcol) from nonpktext order by c1 asc",
 			compareCols:      []int{-1, 2},
+			columns:          []string{"c1", "textcol"},
 			comparePKs:       []int{0},
 			sourcePrimitive:  newMergeSorter([]int{0}),
 			targetPrimitive:  newMergeSorter([]int{0}),
@@ -165,6 +172,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select textcol, c1, weight_string(textcol) from nonpktext order by c1 asc",
 			targetExpression: "select textcol, c1, weight_string(textcol) from nonpktext order by c1 asc",
 			compareCols:      []int{2, -1},
+			columns:          []string{"textcol", "c1"},
 			comparePKs:       []int{1},
 			sourcePrimitive:  newMergeSorter([]int{1}),
 			targetPrimitive:  newMergeSorter([]int{1}),
@@ -181,6 +189,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select textcol, c2, weight_string(textcol) from pktext order by textcol asc",
 			targetExpression: "select textcol, c2, weight_string(textcol) from pktext order by textcol asc",
 			compareCols:      []int{-1, 1},
+			columns:          []string{"textcol", "c2"},
 			comparePKs:       []int{2},
 			sourcePrimitive:  newMergeSorter([]int{2}),
 			targetPrimitive:  newMergeSorter([]int{2}),
@@ -197,6 +206,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c2, textcol, weight_string(textcol) from pktext order by textcol asc",
 			targetExpression: "select c2, textcol, weight_string(textcol) from pktext order by textcol asc",
 			compareCols:      []int{0, -1},
+			columns:          []string{"c2", "textcol"},
 			comparePKs:       []int{2},
 			sourcePrimitive:  newMergeSorter([]int{2}),
 			targetPrimitive:  newMergeSorter([]int{2}),
@@ -213,6 +223,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c2, a + b as textcol, weight_string(a + b) from pktext order by textcol asc",
 			targetExpression: "select c2, textcol, weight_string(textcol) from pktext order by textcol asc",
 			compareCols:      []int{0, -1},
+			columns:          []string{"c2", "textcol"},
 			comparePKs:       []int{2},
 			sourcePrimitive:  newMergeSorter([]int{2}),
 			targetPrimitive:  newMergeSorter([]int{2}),
@@ -227,6 +238,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, c2 from multipk order by c1 asc, c2 asc",
 			targetExpression: "select c1, c2 from multipk order by c1 asc, c2 asc",
 			compareCols:      []int{-1, -1},
+			columns:          []string{"c1", "c2"},
 			comparePKs:       []int{0, 1},
 			sourcePrimitive:  newMergeSorter([]int{0, 1}),
 			targetPrimitive:  newMergeSorter([]int{0, 1}),
@@ -243,6 +255,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, c2 from t1 order by c1 asc",
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
+			columns:          []string{"c1", "c2"},
 			comparePKs:       []int{0},
 			sourcePrimitive:  newMergeSorter([]int{0}),
 			targetPrimitive:  newMergeSorter([]int{0}),
@@ -260,6 +273,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, c2 from t1 where c2 = 2 order by c1 asc",
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
+			columns:          []string{"c1", "c2"},
 			comparePKs:       []int{0},
 			sourcePrimitive:  newMergeSorter([]int{0}),
 			targetPrimitive:  newMergeSorter([]int{0}),
@@ -277,6 +291,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, c2 from t1 where c2 = 2 order by c1 asc",
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
+			columns:          []string{"c1", "c2"},
 			comparePKs:       []int{0},
 			sourcePrimitive:  newMergeSorter([]int{0}),
 			targetPrimitive:  newMergeSorter([]int{0}),
@@ -294,6 +309,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, c2 from t1 where c2 = 2 and c1 = 1 order by c1 asc",
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
+			columns:          []string{"c1", "c2"},
 			comparePKs:       []int{0},
 			sourcePrimitive:  newMergeSorter([]int{0}),
 			targetPrimitive:  newMergeSorter([]int{0}),
@@ -311,6 +327,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, c2 from t1 where (c2 = 2) order by c1 asc",
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
+			columns:          []string{"c1", "c2"},
 			comparePKs:       []int{0},
 			sourcePrimitive:  newMergeSorter([]int{0}),
 			targetPrimitive:  newMergeSorter([]int{0}),
@@ -327,6 +344,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, c2 from t1 group by c1 order by c1 asc",
 			targetExpression: "select c1, c2 from t1 order by c1 asc",
 			compareCols:      []int{-1, 1},
+			columns:          []string{"c1", "c2"},
 			comparePKs:       []int{0},
 			sourcePrimitive:  newMergeSorter([]int{0}),
 			targetPrimitive:  newMergeSorter([]int{0}),
@@ -343,6 +361,7 @@ func TestVDiffPlanSuccess(t *testing.T) {
 			sourceExpression: "select c1, c2, count(*) as c3, sum(c4) as c4 from t1 group by c1 order by c1 asc",
 			targetExpression: "select c1, c2, c3, c4 from aggr order by c1 asc",
 			compareCols:      []int{-1, 1, 2, 3},
+			columns:          []string{"c1", "c2", "c3", "c4"},
 			comparePKs:       []int{0},
 			sourcePrimitive: &engine.OrderedAggregate{
 				Aggregates: []engine.AggregateParams{{
@@ -475,6 +494,10 @@ func TestVDiffUnsharded(t *testing.T) {
 			ProcessedRows:   3,
 			MatchingRows:    1,
 			ExtraRowsTarget: 2,
+			ExtraRowsTargetSample: []RowSample{
+				RowSample{"c1": sqltypes.NewInt64(2), "c2": sqltypes.NewInt64(4)},
+				RowSample{"c1": sqltypes.NewInt64(3), "c2": sqltypes.NewInt64(1)},
+			},
 		},
 	}, {
 		id: "3",
@@ -491,6 +514,10 @@ func TestVDiffUnsharded(t *testing.T) {
 			ProcessedRows:   3,
 			MatchingRows:    1,
 			ExtraRowsSource: 2,
+			ExtraRowsSourceSample: []RowSample{
+				RowSample{"c1": sqltypes.NewInt64(2), "c2": sqltypes.NewInt64(4)},
+				RowSample{"c1": sqltypes.NewInt64(3), "c2": sqltypes.NewInt64(1)},
+			},
 		},
 	}, {
 		id: "4",
@@ -509,6 +536,9 @@ func TestVDiffUnsharded(t *testing.T) {
 			ProcessedRows:   3,
 			MatchingRows:    2,
 			ExtraRowsSource: 1,
+			ExtraRowsSourceSample: []RowSample{
+				RowSample{"c1": sqltypes.NewInt64(2), "c2": sqltypes.NewInt64(4)},
+			},
 		},
 	}, {
 		id: "5",
@@ -527,6 +557,9 @@ func TestVDiffUnsharded(t *testing.T) {
 			ProcessedRows:   3,
 			MatchingRows:    2,
 			ExtraRowsTarget: 1,
+			ExtraRowsTargetSample: []RowSample{
+				RowSample{"c1": sqltypes.NewInt64(2), "c2": sqltypes.NewInt64(4)},
+			},
 		},
 	}, {
 		id: "6",
@@ -546,6 +579,10 @@ func TestVDiffUnsharded(t *testing.T) {
 			ProcessedRows:  3,
 			MatchingRows:   2,
 			MismatchedRows: 1,
+			MismatchedRowsSample: []*MismatchedRow{{
+				Source: RowSample{"c1": sqltypes.NewInt64(2), "c2": sqltypes.NewInt64(3)},
+				Target: RowSample{"c1": sqltypes.NewInt64(2), "c2": sqltypes.NewInt64(4)},
+			}},
 		},
 	}}
 
@@ -553,7 +590,7 @@ func TestVDiffUnsharded(t *testing.T) {
 		env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, tcase.source)
 		env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, tcase.target)
 
-		dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute)
+		dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, 0)
 		require.NoError(t, err)
 		assert.Equal(t, tcase.dr, dr["t1"], tcase.id)
 	}
@@ -615,7 +652,7 @@ func TestVDiffSharded(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute)
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, 0)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 3,
@@ -681,7 +718,7 @@ func TestVDiffAggregates(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute)
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, 0)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 5,
@@ -745,7 +782,7 @@ func TestVDiffPKWeightString(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute)
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, 0)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 4,
@@ -809,7 +846,7 @@ func TestVDiffNoPKWeightString(t *testing.T) {
 		),
 	)
 
-	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute)
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, 0)
 	require.NoError(t, err)
 	wantdr := &DiffReport{
 		ProcessedRows: 4,
@@ -847,11 +884,11 @@ func TestVDiffDefaults(t *testing.T) {
 	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, source)
 	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, target)
 
-	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, "", "", "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute)
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, "", "", "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, 0)
 	require.NoError(t, err)
-	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, "", env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute)
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, "", env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, 0)
 	require.NoError(t, err)
-	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute)
+	_, err = env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, "", "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, 0)
 	require.NoError(t, err)
 }
 
@@ -884,6 +921,62 @@ func TestVDiffReplicationWait(t *testing.T) {
 	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, source)
 	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, target)
 
-	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 0*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute)
+	_, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 0*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, 0)
 	require.EqualError(t, err, "startQueryStreams(sources): WaitForPosition for tablet cell-0000000101: context deadline exceeded")
 }
+
+func TestVDiffSampleLimit(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+	defer env.close()
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	env.tmc.schema = schm
+
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"int64|int64",
+	)
+	var rows []string
+	for i := 1; i <= 2*maxVDiffSampleRows; i++ {
+		rows = append(rows, fmt.Sprintf("%d|%d", i, i))
+	}
+	env.tablets[101].setResults("select c1, c2 from t1 order by c1 asc", vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, rows...))
+	env.tablets[201].setResults("select c1, c2 from t1 order by c1 asc", vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields))
+
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, 1*time.Second, 1*time.Second, 1*time.Minute, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2*maxVDiffSampleRows, dr["t1"].ExtraRowsSource)
+	require.Len(t, dr["t1"].ExtraRowsSourceSample, maxVDiffSampleRows)
+	assert.Equal(t, RowSample{"c1": sqltypes.NewInt64(1), "c2": sqltypes.NewInt64(1)}, dr["t1"].ExtraRowsSourceSample[0])
+}
+
+func TestRowThrottler(t *testing.T) {
+	// No throttling.
+	rt := newRowThrottler(0)
+	start := time.Now()
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, rt.wait(context.Background()))
+	}
+	assert.True(t, time.Since(start) < time.Second, "unthrottled rows took %v", time.Since(start))
+
+	// 20 rows at 100 rows per second take about 200ms.
+	rt = newRowThrottler(100)
+	start = time.Now()
+	for i := 0; i < 20; i++ {
+		require.NoError(t, rt.wait(context.Background()))
+	}
+	assert.True(t, time.Since(start) >= 150*time.Millisecond, "throttled rows took %v", time.Since(start))
+
+	// The wait is interrupted by the context.
+	rt = newRowThrottler(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Error(t, rt.wait(ctx))
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// ValidateVSchema checks the schema of a keyspace against its vschema,
+// and returns an error that lists all the problems it found:
+// - every table of a sharded keyspace has a primary vindex,
+// - the tables and the columns of the vschema exist,
+// - the tables of the lookup vindexes exist,
+// - the sequence tables of the auto-increment columns exist,
+// - the types of the vindex columns are compatible with their vindexes.
+// The schemas are read from the master of the first shard of the keyspaces.
+func (wr *Wrangler) ValidateVSchema(ctx context.Context, keyspace string) error {
+	keyspaces, err := wr.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return fmt.Errorf("GetKeyspaces failed: %v", err)
+	}
+	vschemas := make(map[string]*vschemapb.Keyspace)
+	for _, ks := range keyspaces {
+		vschema, err := wr.ts.GetVSchema(ctx, ks)
+		if err != nil && !topo.IsErrType(err, topo.NoNode) {
+			return fmt.Errorf("GetVSchema(%v) failed: %v", ks, err)
+		}
+		vschemas[ks] = vschema
+	}
+	if _, ok := vschemas[keyspace]; !ok {
+		return fmt.Errorf("keyspace %v not found", keyspace)
+	}
+
+	schemas := make(map[string]*tabletmanagerdatapb.SchemaDefinition)
+	getSchema := func(ks string) (*tabletmanagerdatapb.SchemaDefinition, error) {
+		if sd, ok := schemas[ks]; ok {
+			return sd, nil
+		}
+		shards, err := wr.ts.GetShardNames(ctx, ks)
+		if err != nil {
+			return nil, fmt.Errorf("GetShardNames(%v) failed: %v", ks, err)
+		}
+		if len(shards) == 0 {
+			return nil, fmt.Errorf("no shards in keyspace %v", ks)
+		}
+		sort.Strings(shards)
+		si, err := wr.ts.GetShard(ctx, ks, shards[0])
+		if err != nil {
+			return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", ks, shards[0], err)
+		}
+		if !si.HasMaster() {
+			return nil, fmt.Errorf("no master in shard %v/%v", ks, shards[0])
+		}
+		sd, err := wr.GetSchema(ctx, si.MasterAlias, nil, nil, false)
+		if err != nil {
+			return nil, err
+		}
+		schemas[ks] = sd
+		return sd, nil
+	}
+
+	problems := validateVSchema(keyspace, vschemas, getSchema)
+	if len(problems) != 0 {
+		return fmt.Errorf("vschema of keyspace %v is not valid:\n%v", keyspace, strings.Join(problems, "\n"))
+	}
+	wr.Logger().Printf("The vschema of keyspace %v is valid.\n", keyspace)
+	return nil
+}
+
+// vindexColumnTypes are the types of columns that the vindexes support,
+// for the vindexes that don't support all of them.
+var vindexColumnTypes = map[string]func(querypb.Type) bool{
+	"hash":                               sqltypes.IsIntegral,
+	"numeric":                            sqltypes.IsIntegral,
+	"numeric_static_map":                 sqltypes.IsIntegral,
+	"reverse_bits":                       sqltypes.IsIntegral,
+	"lookup_hash":                        sqltypes.IsIntegral,
+	"lookup_hash_unique":                 sqltypes.IsIntegral,
+	"unicode_loose_md5":                  sqltypes.IsText,
+	"lookup_unicodeloosemd5_hash":        sqltypes.IsText,
+	"lookup_unicodeloosemd5_hash_unique": sqltypes.IsText,
+	"consistent_lookup_unicodeloosemd5":  sqltypes.IsText,
+}
+
+// typeClass returns the class of a column type that matters for the
+// comparison of the values of vindex columns.
+func typeClass(typ querypb.Type) string {
+	switch {
+	case sqltypes.IsIntegral(typ):
+		return "integral"
+	case sqltypes.IsText(typ):
+		return "text"
+	case sqltypes.IsBinary(typ):
+		return "binary"
+	}
+	return strings.ToLower(typ.String())
+}
+
+// vschemaValidator validates the vschema of a keyspace against the
+// schemas of the keyspaces.
+type vschemaValidator struct {
+	keyspace  string
+	vschemas  map[string]*vschemapb.Keyspace
+	getSchema func(keyspace string) (*tabletmanagerdatapb.SchemaDefinition, error)
+	problems  []string
+	// lookupTables are the definitions of the tables of the lookup
+	// vindexes that were validated, which are nil if not found.
+	lookupTables map[string]*tabletmanagerdatapb.TableDefinition
+}
+
+func validateVSchema(keyspace string, vschemas map[string]*vschemapb.Keyspace, getSchema func(keyspace string) (*tabletmanagerdatapb.SchemaDefinition, error)) []string {
+	vv := &vschemaValidator{
+		keyspace:     keyspace,
+		vschemas:     vschemas,
+		getSchema:    getSchema,
+		lookupTables: make(map[string]*tabletmanagerdatapb.TableDefinition),
+	}
+	vv.validate()
+	return vv.problems
+}
+
+func (vv *vschemaValidator) addProblem(format string, args ...interface{}) {
+	vv.problems = append(vv.problems, fmt.Sprintf(format, args...))
+}
+
+func (vv *vschemaValidator) validate() {
+	vschema := vv.vschemas[vv.keyspace]
+	if vschema == nil {
+		vschema = &vschemapb.Keyspace{}
+	}
+	if _, err := vindexes.BuildKeyspaceSchema(vschema, vv.keyspace); err != nil {
+		vv.addProblem("vschema: %v", err)
+	}
+	sd, err := vv.getSchema(vv.keyspace)
+	if err != nil {
+		vv.addProblem("cannot read the schema of keyspace %v: %v", vv.keyspace, err)
+		return
+	}
+	tables := tableDefinitions(sd)
+
+	// Every table of a sharded keyspace needs a primary vindex.
+	if vschema.Sharded {
+		for _, td := range sd.TableDefinitions {
+			if td.Type != tmutils.TableBaseTable {
+				continue
+			}
+			// The tables of the vschema without primary vindex
+			// are reported by BuildKeyspaceSchema.
+			if _, ok := vschema.Tables[td.Name]; !ok {
+				vv.addProblem("table %v: no primary vindex in the vschema of sharded keyspace %v", td.Name, vv.keyspace)
+			}
+		}
+	}
+
+	// vindexColumns are the types of the columns of each vindex, to
+	// check that they're consistent.
+	vindexColumns := make(map[string]map[string][]string)
+	for _, tname := range sortedTableNames(vschema.Tables) {
+		vtable := vschema.Tables[tname]
+		if vtable.Type == vindexes.TypeSequence || vtable.Type == vindexes.TypeReference {
+			if _, ok := tables[tname]; !ok {
+				vv.addProblem("table %v: not found in the schema of keyspace %v", tname, vv.keyspace)
+			}
+			continue
+		}
+		td, ok := tables[tname]
+		if !ok {
+			vv.addProblem("table %v: not found in the schema of keyspace %v", tname, vv.keyspace)
+			continue
+		}
+		for _, cv := range vtable.ColumnVindexes {
+			columns := cv.Columns
+			if cv.Column != "" {
+				columns = []string{cv.Column}
+			}
+			vindex := vschema.Vindexes[cv.Name]
+			for _, column := range columns {
+				typ, ok := columnType(td, column)
+				if !ok {
+					vv.addProblem("table %v: column %v of vindex %v not found", tname, column, cv.Name)
+					continue
+				}
+				if vindex == nil || typ == querypb.Type_NULL_TYPE {
+					continue
+				}
+				if supports, ok := vindexColumnTypes[vindex.Type]; ok && !supports(typ) {
+					vv.addProblem("table %v: column %v has type %v, which is not supported by vindex %v of type %v", tname, column, strings.ToLower(typ.String()), cv.Name, vindex.Type)
+				}
+				if vindexColumns[cv.Name] == nil {
+					vindexColumns[cv.Name] = make(map[string][]string)
+				}
+				class := typeClass(typ)
+				vindexColumns[cv.Name][class] = append(vindexColumns[cv.Name][class], tname+"."+column)
+				if vindex.Params["table"] != "" && column == columns[0] {
+					vv.validateLookupColumn(tname, column, typ, cv.Name, vindex)
+				}
+			}
+		}
+		if vtable.AutoIncrement != nil {
+			vv.validateAutoIncrement(tname, td, vtable.AutoIncrement)
+		}
+	}
+	var vindexNames []string
+	for name := range vschema.Vindexes {
+		vindexNames = append(vindexNames, name)
+	}
+	sort.Strings(vindexNames)
+	for _, name := range vindexNames {
+		classes := vindexColumns[name]
+		if len(classes) < 2 {
+			continue
+		}
+		var uses []string
+		for class, columns := range classes {
+			uses = append(uses, fmt.Sprintf("%v (%v)", strings.Join(columns, ", "), class))
+		}
+		sort.Strings(uses)
+		vv.addProblem("vindex %v: used by columns of incompatible types: %v", name, strings.Join(uses, ", "))
+	}
+
+	// Lookup vindexes that are not used by any table must still
+	// have their tables.
+	for _, name := range vindexNames {
+		vindex := vschema.Vindexes[name]
+		if vindex.Params["table"] != "" && vindexColumns[name] == nil {
+			vv.validateLookupTable(name, vindex)
+		}
+	}
+}
+
+// validateLookupColumn checks that the table of a lookup vindex exists,
+// and that the type of its from column matches the vindex column.
+func (vv *vschemaValidator) validateLookupColumn(tname, column string, typ querypb.Type, name string, vindex *vschemapb.Vindex) {
+	td := vv.validateLookupTable(name, vindex)
+	if td == nil {
+		return
+	}
+	from := strings.TrimSpace(strings.Split(vindex.Params["from"], ",")[0])
+	if fromType, ok := columnType(td, from); ok && fromType != querypb.Type_NULL_TYPE && typeClass(fromType) != typeClass(typ) {
+		vv.addProblem("table %v: column %v has type %v, but column %v of lookup table %v has type %v", tname, column, strings.ToLower(typ.String()), from, vindex.Params["table"], strings.ToLower(fromType.String()))
+	}
+}
+
+// validateLookupTable checks that the table of a lookup vindex and its
+// columns exist, and returns its definition.
+func (vv *vschemaValidator) validateLookupTable(name string, vindex *vschemapb.Vindex) *tabletmanagerdatapb.TableDefinition {
+	if td, ok := vv.lookupTables[name]; ok {
+		return td
+	}
+	lookupTable := vindex.Params["table"]
+	td, err := vv.findTable(lookupTable)
+	vv.lookupTables[name] = td
+	if err != nil {
+		vv.addProblem("vindex %v: lookup table %v: %v", name, lookupTable, err)
+		return nil
+	}
+	var columns []string
+	for _, from := range strings.Split(vindex.Params["from"], ",") {
+		columns = append(columns, strings.TrimSpace(from))
+	}
+	columns = append(columns, vindex.Params["to"])
+	for _, column := range columns {
+		if _, ok := columnType(td, column); !ok {
+			vv.addProblem("vindex %v: column %v not found in lookup table %v", name, column, lookupTable)
+		}
+	}
+	return td
+}
+
+// validateAutoIncrement checks that the auto-increment column and its
+// sequence table exist.
+func (vv *vschemaValidator) validateAutoIncrement(tname string, td *tabletmanagerdatapb.TableDefinition, autoInc *vschemapb.AutoIncrement) {
+	if _, ok := columnType(td, autoInc.Column); !ok {
+		vv.addProblem("table %v: auto-increment column %v not found", tname, autoInc.Column)
+	}
+	ks, seq := vv.resolveTable(autoInc.Sequence)
+	if ks == "" {
+		vv.addProblem("table %v: sequence table %v not found in any vschema", tname, autoInc.Sequence)
+		return
+	}
+	if vtable := vv.vschemas[ks].GetTables()[seq]; vtable == nil || vtable.Type != vindexes.TypeSequence {
+		vv.addProblem("table %v: table %v of keyspace %v is not a sequence", tname, seq, ks)
+		return
+	}
+	if _, err := vv.findTable(ks + "." + seq); err != nil {
+		vv.addProblem("table %v: sequence table %v: %v", tname, autoInc.Sequence, err)
+	}
+}
+
+// resolveTable returns the keyspace and the name of a table reference,
+// which is either qualified, or unique across the vschemas. It returns
+// an empty keyspace if the table is not found.
+func (vv *vschemaValidator) resolveTable(name string) (keyspace, table string) {
+	if i := strings.Index(name, "."); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	var found []string
+	for ks, vschema := range vv.vschemas {
+		if _, ok := vschema.GetTables()[name]; ok {
+			found = append(found, ks)
+		}
+	}
+	switch {
+	case len(found) == 1:
+		return found[0], name
+	case len(found) == 0 && !vv.vschemas[vv.keyspace].GetSharded():
+		// The tables of an unsharded keyspace don't have to be
+		// in its vschema.
+		return vv.keyspace, name
+	}
+	return "", name
+}
+
+// findTable returns the definition of a table reference.
+func (vv *vschemaValidator) findTable(name string) (*tabletmanagerdatapb.TableDefinition, error) {
+	ks, table := vv.resolveTable(name)
+	if ks == "" {
+		return nil, fmt.Errorf("not found in any vschema")
+	}
+	if _, ok := vv.vschemas[ks]; !ok {
+		return nil, fmt.Errorf("keyspace %v not found", ks)
+	}
+	sd, err := vv.getSchema(ks)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the schema of keyspace %v: %v", ks, err)
+	}
+	td, ok := tableDefinitions(sd)[table]
+	if !ok {
+		return nil, fmt.Errorf("not found in the schema of keyspace %v", ks)
+	}
+	return td, nil
+}
+
+func tableDefinitions(sd *tabletmanagerdatapb.SchemaDefinition) map[string]*tabletmanagerdatapb.TableDefinition {
+	tables := make(map[string]*tabletmanagerdatapb.TableDefinition)
+	for _, td := range sd.TableDefinitions {
+		tables[td.Name] = td
+	}
+	return tables
+}
+
+// columnType returns the type of a column of a table. Column names
+// are case insensitive.
+func columnType(td *tabletmanagerdatapb.TableDefinition, column string) (querypb.Type, bool) {
+	for _, field := range td.Fields {
+		if strings.EqualFold(field.Name, column) {
+			return field.Type, true
+		}
+	}
+	for _, name := range td.Columns {
+		if strings.EqualFold(name, column) {
+			return querypb.Type_NULL_TYPE, true
+		}
+	}
+	return querypb.Type_NULL_TYPE, false
+}
+
+func sortedTableNames(tables map[string]*vschemapb.Table) []string {
+	var names []string
+	for name := range tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func testTableDefinition(name, columns, types string) *tabletmanagerdatapb.TableDefinition {
+	return &tabletmanagerdatapb.TableDefinition{
+		Name:    name,
+		Type:    tmutils.TableBaseTable,
+		Columns: strings.Split(columns, "|"),
+		Fields:  sqltypes.MakeTestFields(columns, types),
+	}
+}
+
+func testVSchemas() map[string]*vschemapb.Keyspace {
+	return map[string]*vschemapb.Keyspace{
+		"ks": {
+			Sharded: true,
+			Vindexes: map[string]*vschemapb.Vindex{
+				"hash": {Type: "hash"},
+				"name_lkp": {
+					Type:   "lookup_unique",
+					Params: map[string]string{"table": "lookup.name_idx", "from": "name", "to": "keyspace_id"},
+					Owner:  "user",
+				},
+			},
+			Tables: map[string]*vschemapb.Table{
+				"user": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{
+						{Column: "id", Name: "hash"},
+						{Column: "name", Name: "name_lkp"},
+					},
+					AutoIncrement: &vschemapb.AutoIncrement{Column: "id", Sequence: "user_seq"},
+				},
+				"orders": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{
+						{Column: "user_id", Name: "hash"},
+					},
+				},
+			},
+		},
+		"lookup": {
+			Tables: map[string]*vschemapb.Table{
+				"name_idx": {},
+				"user_seq": {Type: "sequence"},
+			},
+		},
+	}
+}
+
+func testSchemas() map[string]*tabletmanagerdatapb.SchemaDefinition {
+	return map[string]*tabletmanagerdatapb.SchemaDefinition{
+		"ks": {
+			TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+				testTableDefinition("orders", "user_id", "int64"),
+				testTableDefinition("user", "id|name", "int64|varchar"),
+			},
+		},
+		"lookup": {
+			TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+				testTableDefinition("name_idx", "name|keyspace_id", "varchar|varbinary"),
+				testTableDefinition("user_seq", "id|next_id|cache", "int64|int64|int64"),
+			},
+		},
+	}
+}
+
+func validateTestVSchema(vschemas map[string]*vschemapb.Keyspace, schemas map[string]*tabletmanagerdatapb.SchemaDefinition) []string {
+	return validateVSchema("ks", vschemas, func(keyspace string) (*tabletmanagerdatapb.SchemaDefinition, error) {
+		sd, ok := schemas[keyspace]
+		if !ok {
+			return nil, fmt.Errorf("no schema")
+		}
+		return sd, nil
+	})
+}
+
+func TestValidateVSchema(t *testing.T) {
+	if problems := validateTestVSchema(testVSchemas(), testSchemas()); len(problems) != 0 {
+		t.Errorf("validateVSchema: %v, want no problems", problems)
+	}
+
+	schemas := testSchemas()
+	schemas["ks"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			testTableDefinition("orders", "user_id", "varchar"),
+			testTableDefinition("orphan", "id", "int64"),
+			testTableDefinition("user", "id|name", "int64|varchar"),
+		},
+	}
+	schemas["lookup"] = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+			testTableDefinition("name_idx", "name", "varchar"),
+		},
+	}
+	want := []string{
+		"table orphan: no primary vindex in the vschema of sharded keyspace ks",
+		"table orders: column user_id has type varchar, which is not supported by vindex hash of type hash",
+		"vindex name_lkp: column keyspace_id not found in lookup table lookup.name_idx",
+		"table user: sequence table user_seq: not found in the schema of keyspace lookup",
+		"vindex hash: used by columns of incompatible types: orders.user_id (text), user.id (integral)",
+	}
+	if got := validateTestVSchema(testVSchemas(), schemas); !reflect.DeepEqual(got, want) {
+		t.Errorf("validateVSchema:\n%v\nwant:\n%v", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestValidateVSchemaReferences(t *testing.T) {
+	vschemas := testVSchemas()
+	vschemas["ks"].Vindexes["name_lkp"].Params["table"] = "name_idx2"
+	vschemas["ks"].Tables["user"].AutoIncrement.Sequence = "lookup.name_idx"
+	vschemas["ks"].Tables["user"].ColumnVindexes = append(vschemas["ks"].Tables["user"].ColumnVindexes, &vschemapb.ColumnVindex{Column: "email", Name: "hash"})
+	want := []string{
+		"vindex name_lkp: lookup table name_idx2: not found in any vschema",
+		"table user: column email of vindex hash not found",
+		"table user: table name_idx of keyspace lookup is not a sequence",
+	}
+	if got := validateTestVSchema(vschemas, testSchemas()); !reflect.DeepEqual(got, want) {
+		t.Errorf("validateVSchema:\n%v\nwant:\n%v", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	// A sharded table without primary vindex is reported by the
+	// vschema builder.
+	vschemas = testVSchemas()
+	vschemas["ks"].Tables["orders"].ColumnVindexes = nil
+	got := validateTestVSchema(vschemas, testSchemas())
+	if len(got) != 1 || !strings.Contains(got[0], "missing primary col vindex for table: orders") {
+		t.Errorf("validateVSchema: %v, want missing primary col vindex", got)
+	}
+}
@@ -0,0 +1,465 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+/*
+This file handles the vreplication workflows of a keyspace: reporting
+their progress, and stopping, restarting or deleting them.
+*/
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// WorkflowStatus is the status of a vreplication workflow.
+type WorkflowStatus struct {
+	Workflow       string
+	SourceKeyspace string
+	TargetKeyspace string
+
+	// Streams contains the streams of the workflow, sorted by
+	// target shard and id.
+	Streams []*StreamStatus
+
+	// Tables contains the copy progress of the tables, sorted
+	// by name.
+	Tables []*TableCopyStatus
+}
+
+// StreamStatus is the status of a vreplication stream.
+type StreamStatus struct {
+	Shard    string
+	ID       uint32
+	Source   string
+	State    string
+	Position string
+	Message  string
+
+	// TimeUpdated is the last time the stream saved its position.
+	TimeUpdated time.Time
+
+	// Lag is the replication lag of the stream when it applied
+	// its last transaction.
+	Lag time.Duration
+
+	// CopyingTables contains the tables the stream is still copying.
+	CopyingTables []string
+}
+
+// TableCopyStatus is the copy progress of a table. The row counts are
+// estimated by MySQL.
+type TableCopyStatus struct {
+	Table      string
+	SourceRows uint64
+	TargetRows uint64
+
+	// Copying is true while a stream is copying the table.
+	Copying bool
+}
+
+// workflowTarget is a target shard with streams of a workflow.
+type workflowTarget struct {
+	si      *topo.ShardInfo
+	master  *topo.TabletInfo
+	streams []*StreamStatus
+	sources map[uint32]*binlogdatapb.BinlogSource
+}
+
+// ShowWorkflow returns the status of a vreplication workflow of a
+// target keyspace, including the copy progress of its tables.
+func (wr *Wrangler) ShowWorkflow(ctx context.Context, targetKeyspace, workflow string) (*WorkflowStatus, error) {
+	targets, err := wr.workflowTargets(ctx, targetKeyspace, workflow)
+	if err != nil {
+		return nil, err
+	}
+	ws := &WorkflowStatus{
+		Workflow:       workflow,
+		TargetKeyspace: targetKeyspace,
+	}
+
+	// Read the copy state of the streams.
+	copying := make(map[string]bool)
+	var mu sync.Mutex
+	if err := wr.forAllWorkflowTargets(targets, func(target *workflowTarget) error {
+		if len(target.streams) == 0 {
+			return nil
+		}
+		var ids []string
+		for _, ss := range target.streams {
+			ids = append(ids, fmt.Sprintf("%d", ss.ID))
+		}
+		query := fmt.Sprintf("select vrepl_id, table_name from _vt.copy_state where vrepl_id in (%s)", strings.Join(ids, ", "))
+		p3qr, err := wr.tmc.VReplicationExec(ctx, target.master.Tablet, query)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+			id, err := sqltypes.ToInt64(row[0])
+			if err != nil {
+				return err
+			}
+			table := row[1].ToString()
+			copying[table] = true
+			for _, ss := range target.streams {
+				if ss.ID == uint32(id) {
+					ss.CopyingTables = append(ss.CopyingTables, table)
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Gather the tables and the source shards.
+	tables := make(map[string]*TableCopyStatus)
+	sourceShards := make(map[string]bool)
+	for _, target := range targets {
+		for _, ss := range target.streams {
+			ws.Streams = append(ws.Streams, ss)
+			sort.Strings(ss.CopyingTables)
+		}
+		for _, bls := range target.sources {
+			if ws.SourceKeyspace == "" {
+				ws.SourceKeyspace = bls.Keyspace
+			}
+			sourceShards[bls.Shard] = true
+			if bls.Filter == nil {
+				continue
+			}
+			for _, rule := range bls.Filter.Rules {
+				// Wildcard tables cannot be reported.
+				if strings.HasPrefix(rule.Match, "/") {
+					continue
+				}
+				tables[rule.Match] = &TableCopyStatus{
+					Table:   rule.Match,
+					Copying: copying[rule.Match],
+				}
+			}
+		}
+	}
+	sort.Slice(ws.Streams, func(i, j int) bool {
+		if ws.Streams[i].Shard != ws.Streams[j].Shard {
+			return ws.Streams[i].Shard < ws.Streams[j].Shard
+		}
+		return ws.Streams[i].ID < ws.Streams[j].ID
+	})
+	if len(tables) == 0 {
+		return ws, nil
+	}
+	var tableNames []string
+	for table := range tables {
+		tableNames = append(tableNames, table)
+	}
+	sort.Strings(tableNames)
+
+	// Estimate the row counts on the source and target masters.
+	for shard := range sourceShards {
+		si, err := wr.ts.GetShard(ctx, ws.SourceKeyspace, shard)
+		if err != nil {
+			return nil, err
+		}
+		rowCounts, err := wr.rowCounts(ctx, si, tableNames)
+		if err != nil {
+			return nil, err
+		}
+		for table, rows := range rowCounts {
+			tables[table].SourceRows += rows
+		}
+	}
+	for _, target := range targets {
+		rowCounts, err := wr.rowCounts(ctx, target.si, tableNames)
+		if err != nil {
+			return nil, err
+		}
+		for table, rows := range rowCounts {
+			tables[table].TargetRows += rows
+		}
+	}
+	for _, table := range tableNames {
+		ws.Tables = append(ws.Tables, tables[table])
+	}
+	return ws, nil
+}
+
+// rowCounts returns the estimated row counts of the tables on the
+// master of a shard.
+func (wr *Wrangler) rowCounts(ctx context.Context, si *topo.ShardInfo, tableNames []string) (map[string]uint64, error) {
+	if !si.HasMaster() {
+		return nil, fmt.Errorf("shard %v/%v has no master", si.Keyspace(), si.ShardName())
+	}
+	sd, err := wr.GetSchema(ctx, si.MasterAlias, tableNames, nil, false /* includeViews */)
+	if err != nil {
+		return nil, err
+	}
+	rowCounts := make(map[string]uint64)
+	for _, td := range sd.TableDefinitions {
+		rowCounts[td.Name] = td.RowCount
+	}
+	return rowCounts, nil
+}
+
+// ListWorkflows returns the sorted names of the vreplication workflows
+// of a target keyspace.
+func (wr *Wrangler) ListWorkflows(ctx context.Context, targetKeyspace string) ([]string, error) {
+	shards, err := wr.ts.GetShardNames(ctx, targetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	var mu sync.Mutex
+	workflows := make(map[string]bool)
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, shard := range shards {
+		wg.Add(1)
+		go func(shard string) {
+			defer wg.Done()
+			si, err := wr.ts.GetShard(ctx, targetKeyspace, shard)
+			if err != nil {
+				allErrors.RecordError(err)
+				return
+			}
+			// Shards without master can't have running streams.
+			if !si.HasMaster() {
+				return
+			}
+			master, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+			if err != nil {
+				allErrors.RecordError(err)
+				return
+			}
+			query := fmt.Sprintf("select distinct workflow from _vt.vreplication where db_name=%v", encodeString(master.DbName()))
+			p3qr, err := wr.tmc.VReplicationExec(ctx, master.Tablet, query)
+			if err != nil {
+				allErrors.RecordError(fmt.Errorf("%v: %v", shard, err))
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+				workflows[row[0].ToString()] = true
+			}
+		}(shard)
+	}
+	wg.Wait()
+	if allErrors.HasErrors() {
+		return nil, allErrors.AggrError(vterrors.Aggregate)
+	}
+	names := make([]string, 0, len(workflows))
+	for workflow := range workflows {
+		names = append(names, workflow)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// WorkflowAction stops, starts, retries or deletes the streams of a
+// vreplication workflow of a target keyspace. Retrying only restarts
+// the streams that stopped on an error. Deleting a MoveTables workflow before
+// its writes are migrated also removes the routing rules it created.
+func (wr *Wrangler) WorkflowAction(ctx context.Context, targetKeyspace, workflow, action string) error {
+	targets, err := wr.workflowTargets(ctx, targetKeyspace, workflow)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		for _, ss := range target.streams {
+			// MigrateWrites freezes the workflow once the writes are
+			// migrated, it must not be restarted.
+			if ss.Message == frozenStr && (action == "start" || action == "retry") {
+				return fmt.Errorf("cannot start workflow %v: its writes were migrated", workflow)
+			}
+		}
+	}
+	var query string
+	switch action {
+	case "stop":
+		query = fmt.Sprintf("update _vt.vreplication set state='%v', message='stopped by WorkflowAction' where workflow=%v", binlogplayer.BlpStopped, encodeString(workflow))
+	case "start":
+		query = fmt.Sprintf("update _vt.vreplication set state='%v', message='' where workflow=%v", binlogplayer.BlpRunning, encodeString(workflow))
+	case "retry":
+		query = fmt.Sprintf("update _vt.vreplication set state='%v', message='' where workflow=%v and state='%v'", binlogplayer.BlpRunning, encodeString(workflow), binlogplayer.BlpError)
+	case "delete":
+		query = fmt.Sprintf("delete from _vt.vreplication where workflow=%v", encodeString(workflow))
+	default:
+		return fmt.Errorf("invalid workflow action: %v, must be one of stop, start, retry or delete", action)
+	}
+	if err := wr.forAllWorkflowTargets(targets, func(target *workflowTarget) error {
+		_, err := wr.tmc.VReplicationExec(ctx, target.master.Tablet, query+" and db_name="+encodeString(target.master.DbName()))
+		return err
+	}); err != nil {
+		return err
+	}
+	if action == "delete" {
+		return wr.deleteMoveTablesRoutingRules(ctx, targetKeyspace, targets)
+	}
+	return nil
+}
+
+// deleteMoveTablesRoutingRules deletes the routing rules created by
+// MoveTables, as long as they still route the tables to the source
+// keyspace.
+func (wr *Wrangler) deleteMoveTablesRoutingRules(ctx context.Context, targetKeyspace string, targets []*workflowTarget) error {
+	rules, err := wr.getRoutingRules(ctx)
+	if err != nil {
+		return err
+	}
+	changed := false
+	for _, target := range targets {
+		for _, bls := range target.sources {
+			if bls.Keyspace == targetKeyspace || bls.Filter == nil {
+				continue
+			}
+			for _, rule := range bls.Filter.Rules {
+				to := bls.Keyspace + "." + rule.Match
+				for _, from := range []string{rule.Match, targetKeyspace + "." + rule.Match} {
+					if rr, ok := rules[from]; ok && len(rr) == 1 && rr[0] == to {
+						delete(rules, from)
+						changed = true
+					}
+				}
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if err := wr.saveRoutingRules(ctx, rules); err != nil {
+		return err
+	}
+	return wr.ts.RebuildSrvVSchema(ctx, nil)
+}
+
+// workflowTargets returns the target shards that have streams of a
+// workflow, with the status of the streams.
+func (wr *Wrangler) workflowTargets(ctx context.Context, targetKeyspace, workflow string) ([]*workflowTarget, error) {
+	shards, err := wr.ts.GetShardNames(ctx, targetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(shards)
+	var targets []*workflowTarget
+	for _, shard := range shards {
+		si, err := wr.ts.GetShard(ctx, targetKeyspace, shard)
+		if err != nil {
+			return nil, err
+		}
+		if !si.HasMaster() {
+			return nil, fmt.Errorf("shard %v/%v has no master", targetKeyspace, shard)
+		}
+		master, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+		if err != nil {
+			return nil, err
+		}
+		query := fmt.Sprintf("select id, source, pos, state, message, time_updated, transaction_timestamp from _vt.vreplication where workflow=%v and db_name=%v", encodeString(workflow), encodeString(master.DbName()))
+		p3qr, err := wr.tmc.VReplicationExec(ctx, master.Tablet, query)
+		if err != nil {
+			return nil, err
+		}
+		qr := sqltypes.Proto3ToResult(p3qr)
+		// Not all the shards may have streams.
+		if len(qr.Rows) == 0 {
+			continue
+		}
+		target := &workflowTarget{
+			si:      si,
+			master:  master,
+			sources: make(map[uint32]*binlogdatapb.BinlogSource),
+		}
+		for _, row := range qr.Rows {
+			ss, bls, err := parseStreamStatus(shard, row)
+			if err != nil {
+				return nil, err
+			}
+			target.streams = append(target.streams, ss)
+			target.sources[ss.ID] = bls
+		}
+		targets = append(targets, target)
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no streams found in keyspace %s for: %s", targetKeyspace, workflow)
+	}
+	return targets, nil
+}
+
+// parseStreamStatus parses a row of the workflowTargets query.
+func parseStreamStatus(shard string, row []sqltypes.Value) (*StreamStatus, *binlogdatapb.BinlogSource, error) {
+	id, err := sqltypes.ToInt64(row[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	bls := &binlogdatapb.BinlogSource{}
+	if err := proto.UnmarshalText(row[1].ToString(), bls); err != nil {
+		return nil, nil, err
+	}
+	timeUpdated, err := sqltypes.ToInt64(row[5])
+	if err != nil {
+		return nil, nil, err
+	}
+	transactionTimestamp, err := sqltypes.ToInt64(row[6])
+	if err != nil {
+		return nil, nil, err
+	}
+	ss := &StreamStatus{
+		Shard:       shard,
+		ID:          uint32(id),
+		Source:      bls.Keyspace + "/" + bls.Shard,
+		Position:    row[2].ToString(),
+		State:       row[3].ToString(),
+		Message:     row[4].ToString(),
+		TimeUpdated: time.Unix(timeUpdated, 0),
+	}
+	if transactionTimestamp != 0 && timeUpdated > transactionTimestamp {
+		ss.Lag = time.Duration(timeUpdated-transactionTimestamp) * time.Second
+	}
+	return ss, bls, nil
+}
+
+// forAllWorkflowTargets calls f for all the targets in parallel.
+func (wr *Wrangler) forAllWorkflowTargets(targets []*workflowTarget, f func(*workflowTarget) error) error {
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, target := range targets {
+		wg.Add(1)
+		go func(target *workflowTarget) {
+			defer wg.Done()
+			if err := f(target); err != nil {
+				allErrors.RecordError(fmt.Errorf("%v: %v", target.si.ShardName(), err))
+			}
+		}(target)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
@@ -42,6 +42,9 @@ type Wrangler struct {
 	logger logutil.Logger
 	ts     *topo.Server
 	tmc    tmclient.TabletManagerClient
+
+	// plan is set for the wranglers of dry runs, see dryRun.
+	plan *DryRunPlan
 }
 
 // New creates a new Wrangler object.
@@ -0,0 +1,99 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtreplay
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// startLayout is the format of the start times of the query log.
+const startLayout = "2006-01-02 15:04:05.000000"
+
+// Entry is a query of the vtgate query log, in its JSON format.
+type Entry struct {
+	Method       string
+	Start        time.Time
+	TotalTime    time.Duration
+	StmtType     string
+	SQL          string
+	BindVars     map[string]*querypb.BindVariable
+	RowsAffected uint64
+	Error        string
+}
+
+// logEntry has the fields of the JSON query log that are replayed.
+type logEntry struct {
+	Method    string
+	Start     string
+	TotalTime float64
+	StmtType  string
+	SQL       string
+	BindVars  map[string]struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	RowsAffected uint64
+	Error        string
+}
+
+// ParseEntry parses a line of the vtgate query log. The log must be in
+// the JSON format (-querylog-format=json), and contain the full bind
+// variables: the values that are not numbers are otherwise replaced
+// by their size.
+func ParseEntry(line []byte) (*Entry, error) {
+	le := &logEntry{}
+	if err := json.Unmarshal(line, le); err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(startLayout, le.Start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Start: %v", err)
+	}
+	entry := &Entry{
+		Method:       le.Method,
+		Start:        start,
+		TotalTime:    time.Duration(le.TotalTime * float64(time.Second)),
+		StmtType:     le.StmtType,
+		SQL:          le.SQL,
+		BindVars:     make(map[string]*querypb.BindVariable, len(le.BindVars)),
+		RowsAffected: le.RowsAffected,
+		Error:        le.Error,
+	}
+	for name, bv := range le.BindVars {
+		typ, ok := querypb.Type_value[bv.Type]
+		if !ok {
+			return nil, fmt.Errorf("invalid type %v of bind variable %v", bv.Type, name)
+		}
+		// The numbers are logged as JSON numbers, the other
+		// values as JSON strings.
+		value := string(bv.Value)
+		if len(bv.Value) > 0 && bv.Value[0] == '"' {
+			if err := json.Unmarshal(bv.Value, &value); err != nil {
+				return nil, fmt.Errorf("invalid value of bind variable %v: %v", name, err)
+			}
+		}
+		entry.BindVars[name] = &querypb.BindVariable{
+			Type:  querypb.Type(typ),
+			Value: []byte(value),
+		}
+	}
+	return entry, nil
+}
@@ -0,0 +1,365 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package vtreplay replays the queries of a vtgate query log against a
+vtgate, to find the regressions of a new version or of a new vschema
+before they reach production.
+
+The queries are replayed with the time intervals of the log, divided by
+a speed multiplier. Their results and their latencies are compared to
+the ones of a baseline vtgate, or to the ones of the log without one.
+The log only has the number of rows of the results.
+
+The sessions are not replayed: every query runs in autocommit mode,
+and the transaction statements are skipped. The selects are replayed,
+and the DMLs too if enabled.
+*/
+package vtreplay
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// maxLineSize is the maximum size of a line of the query log.
+const maxLineSize = 64 * 1024 * 1024
+
+// Executor executes the replayed queries. It's called concurrently.
+type Executor interface {
+	Execute(ctx context.Context, sql string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error)
+}
+
+// Options control a replay.
+type Options struct {
+	// Speed divides the time intervals between the queries of the
+	// log. The queries are replayed as fast as possible if it's 0.
+	Speed float64
+	// Concurrency is the maximum number of queries in flight.
+	Concurrency int
+	// IncludeDML replays the DMLs as well as the selects.
+	IncludeDML bool
+	// Timeout is the timeout of each query.
+	Timeout time.Duration
+	// SlowdownFactor is how many times slower than its reference a
+	// query must be to be reported.
+	SlowdownFactor float64
+	// MaxDiffs is the maximum number of differences in the report.
+	MaxDiffs int
+}
+
+// Diff is a difference between a replayed query and its reference.
+type Diff struct {
+	SQL      string
+	BindVars map[string]*querypb.BindVariable
+	Reason   string
+}
+
+// Report is the result of a replay.
+type Report struct {
+	// Entries is the number of entries read from the log.
+	Entries int
+	// InvalidEntries is the number of entries that couldn't be parsed.
+	InvalidEntries int
+	// Skipped is the number of entries that were not replayed.
+	Skipped int
+	// Replayed is the number of replayed queries.
+	Replayed int
+
+	// ErrorDiffs counts the queries that failed when their reference
+	// succeeded, or the other way around.
+	ErrorDiffs int
+	// ResultDiffs counts the queries whose result differs.
+	ResultDiffs int
+	// Slowdowns counts the queries that were SlowdownFactor times
+	// slower than their reference.
+	Slowdowns int
+
+	// ReferenceTime and ReplayTime are the total latencies of the
+	// queries that succeeded, in the reference and in the replay.
+	ReferenceTime time.Duration
+	ReplayTime    time.Duration
+
+	// Diffs are the first differences.
+	Diffs []Diff
+}
+
+// Replayer replays query logs.
+type Replayer struct {
+	target   Executor
+	baseline Executor
+	opts     Options
+
+	mu     sync.Mutex
+	report *Report
+}
+
+// NewReplayer returns a Replayer that replays the queries against
+// target. If baseline isn't nil, the queries are also executed by
+// baseline, which is the reference. Otherwise, the log is.
+func NewReplayer(target, baseline Executor, opts Options) *Replayer {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+	return &Replayer{
+		target:   target,
+		baseline: baseline,
+		opts:     opts,
+	}
+}
+
+// Run replays the query log read from r, and returns the report.
+// It returns an error if the log can't be read or ctx is done, with
+// the report of the queries replayed so far.
+func (rp *Replayer) Run(ctx context.Context, r io.Reader) (*Report, error) {
+	rp.report = &Report{}
+	sem := make(chan struct{}, rp.opts.Concurrency)
+	var wg sync.WaitGroup
+
+	var first time.Time
+	wallStart := time.Now()
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLineSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		entry, err := ParseEntry(line)
+		replay := err == nil && rp.shouldReplay(entry)
+		rp.mu.Lock()
+		rp.report.Entries++
+		switch {
+		case err != nil:
+			rp.report.InvalidEntries++
+		case !replay:
+			rp.report.Skipped++
+		}
+		rp.mu.Unlock()
+		if !replay {
+			continue
+		}
+
+		if first.IsZero() {
+			first = entry.Start
+		}
+		if rp.opts.Speed > 0 {
+			due := wallStart.Add(time.Duration(float64(entry.Start.Sub(first)) / rp.opts.Speed))
+			if wait := time.Until(due); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+					wg.Wait()
+					return rp.snapshot(), ctx.Err()
+				}
+			}
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return rp.snapshot(), ctx.Err()
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			rp.replay(ctx, entry)
+		}()
+	}
+	wg.Wait()
+	return rp.snapshot(), scanner.Err()
+}
+
+func (rp *Replayer) shouldReplay(entry *Entry) bool {
+	switch sqlparser.Preview(entry.SQL) {
+	case sqlparser.StmtSelect:
+		return true
+	case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
+		return rp.opts.IncludeDML
+	}
+	return false
+}
+
+// snapshot returns a copy of the report.
+func (rp *Replayer) snapshot() *Report {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	report := *rp.report
+	report.Diffs = append([]Diff(nil), rp.report.Diffs...)
+	return &report
+}
+
+// execute runs a query with the timeout of the options.
+func (rp *Replayer) execute(ctx context.Context, executor Executor, entry *Entry) (*sqltypes.Result, time.Duration, error) {
+	if rp.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, rp.opts.Timeout)
+		defer cancel()
+	}
+	// The executors can modify the bind variables.
+	bindVars := make(map[string]*querypb.BindVariable, len(entry.BindVars))
+	for k, v := range entry.BindVars {
+		bindVars[k] = v
+	}
+	start := time.Now()
+	qr, err := executor.Execute(ctx, entry.SQL, bindVars)
+	return qr, time.Since(start), err
+}
+
+// replay executes an entry, and records its differences.
+func (rp *Replayer) replay(ctx context.Context, entry *Entry) {
+	qr, latency, err := rp.execute(ctx, rp.target, entry)
+
+	// The reference is the baseline, or the log.
+	var refErr, resultDiff string
+	refLatency := entry.TotalTime
+	if rp.baseline != nil {
+		var refQr *sqltypes.Result
+		var e error
+		refQr, refLatency, e = rp.execute(ctx, rp.baseline, entry)
+		if e != nil {
+			refErr = e.Error()
+		}
+		if err == nil && e == nil {
+			resultDiff = compareResults(entry.SQL, qr, refQr)
+		}
+	} else {
+		refErr = entry.Error
+		if err == nil && refErr == "" {
+			resultDiff = compareRowCount(entry, qr)
+		}
+	}
+
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	rp.report.Replayed++
+	switch {
+	case err != nil && refErr == "":
+		rp.report.ErrorDiffs++
+		rp.addDiff(entry, fmt.Sprintf("error: %v, the reference succeeded", err))
+		return
+	case err == nil && refErr != "":
+		rp.report.ErrorDiffs++
+		rp.addDiff(entry, fmt.Sprintf("succeeded, the reference failed: %v", refErr))
+		return
+	case err != nil:
+		return
+	}
+	if resultDiff != "" {
+		rp.report.ResultDiffs++
+		rp.addDiff(entry, resultDiff)
+	}
+	rp.report.ReferenceTime += refLatency
+	rp.report.ReplayTime += latency
+	if rp.opts.SlowdownFactor > 0 && float64(latency) > float64(refLatency)*rp.opts.SlowdownFactor {
+		rp.report.Slowdowns++
+		rp.addDiff(entry, fmt.Sprintf("latency: %v, the reference took %v", latency, refLatency))
+	}
+}
+
+// addDiff must be called with mu held.
+func (rp *Replayer) addDiff(entry *Entry, reason string) {
+	if len(rp.report.Diffs) >= rp.opts.MaxDiffs {
+		return
+	}
+	rp.report.Diffs = append(rp.report.Diffs, Diff{
+		SQL:      entry.SQL,
+		BindVars: entry.BindVars,
+		Reason:   reason,
+	})
+}
+
+// compareRowCount compares a result to the number of rows of the log.
+func compareRowCount(entry *Entry, qr *sqltypes.Result) string {
+	got := qr.RowsAffected
+	if sqlparser.Preview(entry.SQL) == sqlparser.StmtSelect {
+		got = uint64(len(qr.Rows))
+	}
+	if got != entry.RowsAffected {
+		return fmt.Sprintf("%v rows, the reference had %v", got, entry.RowsAffected)
+	}
+	return ""
+}
+
+// compareResults compares a result to its reference. The order of the
+// rows is ignored unless the query has an ORDER BY.
+func compareResults(sql string, qr, ref *sqltypes.Result) string {
+	if got, want := fieldNames(qr), fieldNames(ref); !reflect.DeepEqual(got, want) {
+		return fmt.Sprintf("columns: %v, the reference had %v", got, want)
+	}
+	if qr.RowsAffected != ref.RowsAffected {
+		return fmt.Sprintf("%v rows affected, the reference had %v", qr.RowsAffected, ref.RowsAffected)
+	}
+	ordered := strings.Contains(strings.ToLower(sql), "order by")
+	got, want := rowStrings(qr, ordered), rowStrings(ref, ordered)
+	if len(got) != len(want) {
+		return fmt.Sprintf("%v rows, the reference had %v", len(got), len(want))
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return fmt.Sprintf("row %v, the reference had %v", got[i], want[i])
+		}
+	}
+	return ""
+}
+
+func fieldNames(qr *sqltypes.Result) []string {
+	names := make([]string, 0, len(qr.Fields))
+	for _, field := range qr.Fields {
+		names = append(names, field.Name)
+	}
+	return names
+}
+
+func rowStrings(qr *sqltypes.Result, ordered bool) []string {
+	rows := make([]string, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		rows = append(rows, fmt.Sprintf("%v", row))
+	}
+	if !ordered {
+		sort.Strings(rows)
+	}
+	return rows
+}
+
+// Print writes a human-readable report.
+func (r *Report) Print(w io.Writer) {
+	fmt.Fprintf(w, "Entries: %v (%v invalid, %v skipped)\n", r.Entries, r.InvalidEntries, r.Skipped)
+	fmt.Fprintf(w, "Replayed: %v\n", r.Replayed)
+	fmt.Fprintf(w, "Error differences: %v\n", r.ErrorDiffs)
+	fmt.Fprintf(w, "Result differences: %v\n", r.ResultDiffs)
+	fmt.Fprintf(w, "Slowdowns: %v\n", r.Slowdowns)
+	fmt.Fprintf(w, "Total latency: %v, the reference took %v\n", r.ReplayTime, r.ReferenceTime)
+	for _, diff := range r.Diffs {
+		fmt.Fprintf(w, "\n%v\n  bind variables: %v\n  %v\n", diff.SQL, sqltypes.FormatBindVariables(diff.BindVars, true, false), diff.Reason)
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2019 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtreplay
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// fakeExecutor returns the results of the queries, keyed by the query
+// and its id bind variable.
+type fakeExecutor struct {
+	mu       sync.Mutex
+	results  map[string]*sqltypes.Result
+	executed []string
+}
+
+func (f *fakeExecutor) Execute(ctx context.Context, sql string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := sql
+	if bv, ok := bindVars["id"]; ok {
+		key += ":" + string(bv.Value)
+	}
+	f.executed = append(f.executed, key)
+	qr, ok := f.results[key]
+	if !ok {
+		return nil, errors.New("unknown query")
+	}
+	return qr, nil
+}
+
+const testLog = `{"Method": "Execute", "RemoteAddr": "127.0.0.1:1234", "Username": "user", "ImmediateCaller": "", "Effective Caller": "", "Start": "2019-10-01 12:00:00.000000", "End": "2019-10-01 12:00:00.001000", "TotalTime": 0.001000, "PlanTime": 0, "ExecuteTime": 0.001, "CommitTime": 0, "StmtType": "SELECT", "SQL": "select name from user where id = :id", "BindVars": {"id": {"type": "INT64", "value": 1}}, "ShardQueries": 1, "RowsAffected": 1, "Error": ""}
+{"Method": "Execute", "RemoteAddr": "127.0.0.1:1234", "Username": "user", "ImmediateCaller": "", "Effective Caller": "", "Start": "2019-10-01 12:00:00.010000", "End": "2019-10-01 12:00:00.011000", "TotalTime": 0.001000, "PlanTime": 0, "ExecuteTime": 0.001, "CommitTime": 0, "StmtType": "SELECT", "SQL": "select name from user where id = :id", "BindVars": {"id": {"type": "INT64", "value": 2}}, "ShardQueries": 1, "RowsAffected": 1, "Error": ""}
+{"Method": "Execute", "RemoteAddr": "127.0.0.1:1234", "Username": "user", "ImmediateCaller": "", "Effective Caller": "", "Start": "2019-10-01 12:00:00.020000", "End": "2019-10-01 12:00:00.021000", "TotalTime": 0.001000, "PlanTime": 0, "ExecuteTime": 0.001, "CommitTime": 0, "StmtType": "UPDATE", "SQL": "update user set name = :name where id = :id", "BindVars": {"id": {"type": "INT64", "value": 1}, "name": {"type": "VARCHAR", "value": "bob"}}, "ShardQueries": 1, "RowsAffected": 1, "Error": ""}
+{"Method": "Execute", "RemoteAddr": "127.0.0.1:1234", "Username": "user", "ImmediateCaller": "", "Effective Caller": "", "Start": "2019-10-01 12:00:00.030000", "End": "2019-10-01 12:00:00.030000", "TotalTime": 0.000100, "PlanTime": 0, "ExecuteTime": 0, "CommitTime": 0, "StmtType": "BEGIN", "SQL": "begin", "BindVars": {}, "ShardQueries": 0, "RowsAffected": 0, "Error": ""}
+not json
+`
+
+func TestParseEntry(t *testing.T) {
+	line := strings.Split(testLog, "\n")[2]
+	entry, err := ParseEntry([]byte(line))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Entry{
+		Method:    "Execute",
+		Start:     time.Date(2019, 10, 1, 12, 0, 0, 20000000, time.UTC),
+		TotalTime: time.Millisecond,
+		StmtType:  "UPDATE",
+		SQL:       "update user set name = :name where id = :id",
+		BindVars: map[string]*querypb.BindVariable{
+			"id":   sqltypes.Int64BindVariable(1),
+			"name": {Type: querypb.Type_VARCHAR, Value: []byte("bob")},
+		},
+		RowsAffected: 1,
+	}
+	if !reflect.DeepEqual(entry, want) {
+		t.Errorf("ParseEntry(): %+v, want %+v", entry, want)
+	}
+
+	if _, err := ParseEntry([]byte(`{"Start": "2019-10-01 12:00:00.000000", "BindVars": {"id": {"type": "BAD", "value": 1}}}`)); err == nil || !strings.Contains(err.Error(), "invalid type BAD") {
+		t.Errorf("ParseEntry(bad type): %v, want invalid type BAD", err)
+	}
+}
+
+func TestReplayAgainstLog(t *testing.T) {
+	target := &fakeExecutor{
+		results: map[string]*sqltypes.Result{
+			"select name from user where id = :id:1": sqltypes.MakeTestResult(sqltypes.MakeTestFields("name", "varchar"), "alice"),
+			// The second row of id 2 is missing.
+			"select name from user where id = :id:2": sqltypes.MakeTestResult(sqltypes.MakeTestFields("name", "varchar")),
+		},
+	}
+	rp := NewReplayer(target, nil, Options{Speed: 10, Concurrency: 2, MaxDiffs: 10})
+	start := time.Now()
+	report, err := rp.Run(context.Background(), strings.NewReader(testLog))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The log lasts 10ms between the two selects.
+	if elapsed := time.Since(start); elapsed < time.Millisecond {
+		t.Errorf("replay took %v, want at least 1ms", elapsed)
+	}
+	if report.Entries != 5 || report.InvalidEntries != 1 || report.Skipped != 2 || report.Replayed != 2 {
+		t.Errorf("report: %+v", report)
+	}
+	if report.ResultDiffs != 1 || report.ErrorDiffs != 0 {
+		t.Errorf("report: %+v, want one result difference", report)
+	}
+	if len(report.Diffs) != 1 || report.Diffs[0].Reason != "0 rows, the reference had 1" {
+		t.Errorf("diffs: %+v", report.Diffs)
+	}
+}
+
+func TestReplayAgainstBaseline(t *testing.T) {
+	user1 := sqltypes.MakeTestResult(sqltypes.MakeTestFields("name", "varchar"), "alice")
+	target := &fakeExecutor{
+		results: map[string]*sqltypes.Result{
+			"select name from user where id = :id:1":        user1,
+			"update user set name = :name where id = :id:1": {RowsAffected: 1},
+		},
+	}
+	baseline := &fakeExecutor{
+		results: map[string]*sqltypes.Result{
+			"select name from user where id = :id:1":        user1,
+			"select name from user where id = :id:2":        sqltypes.MakeTestResult(sqltypes.MakeTestFields("name", "varchar"), "bob"),
+			"update user set name = :name where id = :id:1": {RowsAffected: 1},
+		},
+	}
+	rp := NewReplayer(target, baseline, Options{IncludeDML: true, MaxDiffs: 10})
+	report, err := rp.Run(context.Background(), strings.NewReader(testLog))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Replayed != 3 || report.Skipped != 1 || report.ErrorDiffs != 1 || report.ResultDiffs != 0 {
+		t.Errorf("report: %+v", report)
+	}
+	if len(report.Diffs) != 1 || report.Diffs[0].Reason != "error: unknown query, the reference succeeded" {
+		t.Errorf("diffs: %+v", report.Diffs)
+	}
+	if len(baseline.executed) != 3 {
+		t.Errorf("baseline executed: %v, want 3 queries", baseline.executed)
+	}
+}
+
+func TestCompareResults(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id", "int64")
+	a := sqltypes.MakeTestResult(fields, "1", "2")
+	b := sqltypes.MakeTestResult(fields, "2", "1")
+	if diff := compareResults("select id from t", a, b); diff != "" {
+		t.Errorf("unordered: %v, want no difference", diff)
+	}
+	if diff := compareResults("select id from t order by id", a, b); diff != "row [INT64(1)], the reference had [INT64(2)]" {
+		t.Errorf("ordered: %v", diff)
+	}
+	c := sqltypes.MakeTestResult(sqltypes.MakeTestFields("name", "int64"), "1", "2")
+	if diff := compareResults("select id from t", a, c); diff != "columns: [id], the reference had [name]" {
+		t.Errorf("columns: %v", diff)
+	}
+}
@@ -43,7 +43,7 @@ e.registerMethod("setText",[l.RenderStoreObject,l.RenderStoreObject,l.PRIMITIVE]
 encapsulation:s.ViewEncapsulation.None,changeDetection:s.ChangeDetectionStrategy.OnPush}),o("design:paramtypes",[s.ElementRef,s.Renderer])],MdButton)}();t.MdButton=u;var p=function(e){function MdAnchor(t,n){e.call(this,t,n),this._disabled=null}return r(MdAnchor,e),Object.defineProperty(MdAnchor.prototype,"tabIndex",{get:function(){return this.disabled?-1:0},enumerable:!0,configurable:!0}),Object.defineProperty(MdAnchor.prototype,"isAriaDisabled",{get:function(){return this.disabled?"true":"false"},enumerable:!0,configurable:!0}),Object.defineProperty(MdAnchor.prototype,"disabled",{get:function(){return this._disabled},set:function(e){this._disabled=null!=e&&0!=e||null},enumerable:!0,configurable:!0}),MdAnchor.prototype._haltDisabledEvents=function(e){this.disabled&&(e.preventDefault(),e.stopImmediatePropagation())},i([s.HostBinding("tabIndex"),o("design:type",Number)],MdAnchor.prototype,"tabIndex",null),i([s.HostBinding("attr.aria-disabled"),o("design:type",String)],MdAnchor.prototype,"isAriaDisabled",null),i([s.HostBinding("attr.disabled"),s.Input("disabled"),o("design:type",Object)],MdAnchor.prototype,"disabled",null),MdAnchor=i([s.Component({selector:"a[md-button], a[md-raised-button], a[md-icon-button], a[md-fab], a[md-mini-fab]",inputs:["color"],host:{"[class.md-button-focus]":"_isKeyboardFocused","(mousedown)":"_setMousedown()","(focus)":"_setKeyboardFocus()","(blur)":"_removeKeyboardFocus()","(click)":"_haltDisabledEvents($event)"},template:'<span class="md-button-wrapper"><ng-content></ng-content></span> <div md-ripple *ngIf="isRippleEnabled()" class="md-button-ripple" [class.md-button-ripple-round]="isRoundButton()" [md-ripple-trigger]="getHostElement()" [md-ripple-color]="isRoundButton() ? \'rgba(255, 255, 255, 0.2)\' : \'\'" md-ripple-background-color="rgba(0, 0, 0, 0)"></div> ',styles:['/** * A collection of mixins and CSS classes that can be used to apply elevation to a material * element. * See: https://www.google.com/design/spec/what-is-material/elevation-shadows.html * Examples: * * * .md-foo { *   @include $md-elevation(2); * *   &:active { *     @include $md-elevation(8); *   } * } * * <div id="external-card" class="md-elevation-z2"><p>Some content</p></div> * * For an explanation of the design behind how elevation is implemented, see the design doc at * https://goo.gl/Kq0k9Z. */ /** * The css property used for elevation. In most cases this should not be changed. It is exposed * as a variable for abstraction / easy use when needing to reference the property directly, for * example in a will-change rule. */ /** The default duration value for elevation transitions. */ /** The default easing value for elevation transitions. */ /** * Applies the correct css rules to an element to give it the elevation specified by $zValue. * The $zValue must be between 0 and 24. */ /** * Returns a string that can be used as the value for a transition property for elevation. * Calling this function directly is useful in situations where a component needs to transition * more than one property. * * .foo { *   transition: md-elevation-transition-property-value(), opacity 100ms ease; *   will-change: $md-elevation-property, opacity; * } */ /** * Applies the correct css rules needed to have an element transition between elevations. * This mixin should be applied to elements whose elevation values will change depending on their * context (e.g. when active or disabled). */ /** * This mixin overrides default button styles like the gray background, * the border, and the outline. */ /** Applies a property to an md-button element for each of the supported palettes. */ /** Applies a focus style to an md-button element for each of the supported palettes. */ [md-raised-button], [md-fab], [md-mini-fab], [md-button], [md-icon-button] { box-sizing: border-box; position: relative; background: transparent; cursor: pointer; -webkit-user-select: none; -moz-user-select: none; -ms-user-select: none; user-select: none; outline: none; border: none; display: inline-block; white-space: nowrap; text-decoration: none; vertical-align: baseline; font-size: 14px; font-family: Roboto, "Helvetica Neue", sans-serif; font-weight: 500; color: currentColor; text-align: center; margin: 0; min-width: 88px; line-height: 36px; padding: 0 16px; border-radius: 3px; } .md-primary[md-raised-button], .md-primary[md-fab], .md-primary[md-mini-fab], .md-primary[md-button], .md-primary[md-icon-button] { color: #009688; } .md-accent[md-raised-button], .md-accent[md-fab], .md-accent[md-mini-fab], .md-accent[md-button], .md-accent[md-icon-button] { color: #9c27b0; } .md-warn[md-raised-button], .md-warn[md-fab], .md-warn[md-mini-fab], .md-warn[md-button], .md-warn[md-icon-button] { color: #f44336; } .md-primary[disabled][md-raised-button], .md-primary[disabled][md-fab], .md-primary[disabled][md-mini-fab], .md-primary[disabled][md-button], .md-primary[disabled][md-icon-button], .md-accent[disabled][md-raised-button], .md-accent[disabled][md-fab], .md-accent[disabled][md-mini-fab], .md-accent[disabled][md-button], .md-accent[disabled][md-icon-button], .md-warn[disabled][md-raised-button], .md-warn[disabled][md-fab], .md-warn[disabled][md-mini-fab], .md-warn[disabled][md-button], .md-warn[disabled][md-icon-button], [disabled][disabled][md-raised-button], [disabled][disabled][md-fab], [disabled][disabled][md-mini-fab], [disabled][disabled][md-button], [disabled][disabled][md-icon-button] { color: rgba(0, 0, 0, 0.38); } [disabled][md-raised-button], [disabled][md-fab], [disabled][md-mini-fab], [disabled][md-button], [disabled][md-icon-button] { cursor: default; } .md-button-focus[md-raised-button]::after, .md-button-focus[md-fab]::after, .md-button-focus[md-mini-fab]::after, .md-button-focus[md-button]::after, .md-button-focus[md-icon-button]::after { position: absolute; top: 0; left: 0; bottom: 0; right: 0; content: \'\'; background-color: rgba(0, 0, 0, 0.12); border-radius: inherit; pointer-events: none; } .md-button-focus.md-primary[md-raised-button]::after, .md-button-focus.md-primary[md-fab]::after, .md-button-focus.md-primary[md-mini-fab]::after, .md-button-focus.md-primary[md-button]::after, .md-button-focus.md-primary[md-icon-button]::after { background-color: rgba(0, 150, 136, 0.12); } .md-button-focus.md-accent[md-raised-button]::after, .md-button-focus.md-accent[md-fab]::after, .md-button-focus.md-accent[md-mini-fab]::after, .md-button-focus.md-accent[md-button]::after, .md-button-focus.md-accent[md-icon-button]::after { background-color: rgba(156, 39, 176, 0.12); } .md-button-focus.md-warn[md-raised-button]::after, .md-button-focus.md-warn[md-fab]::after, .md-button-focus.md-warn[md-mini-fab]::after, .md-button-focus.md-warn[md-button]::after, .md-button-focus.md-warn[md-icon-button]::after { background-color: rgba(244, 67, 54, 0.12); } [md-raised-button], [md-fab], [md-mini-fab] { box-shadow: 0px 3px 1px -2px rgba(0, 0, 0, 0.2), 0px 2px 2px 0px rgba(0, 0, 0, 0.14), 0px 1px 5px 0px rgba(0, 0, 0, 0.12); background-color: #fafafa; -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); -webkit-transition: background 400ms cubic-bezier(0.25, 0.8, 0.25, 1), box-shadow 280ms cubic-bezier(0.4, 0, 0.2, 1); transition: background 400ms cubic-bezier(0.25, 0.8, 0.25, 1), box-shadow 280ms cubic-bezier(0.4, 0, 0.2, 1); } .md-primary[md-raised-button], .md-primary[md-fab], .md-primary[md-mini-fab] { color: white; } .md-accent[md-raised-button], .md-accent[md-fab], .md-accent[md-mini-fab] { color: rgba(255, 255, 255, 0.870588); } .md-warn[md-raised-button], .md-warn[md-fab], .md-warn[md-mini-fab] { color: white; } .md-primary[disabled][md-raised-button], .md-primary[disabled][md-fab], .md-primary[disabled][md-mini-fab], .md-accent[disabled][md-raised-button], .md-accent[disabled][md-fab], .md-accent[disabled][md-mini-fab], .md-warn[disabled][md-raised-button], .md-warn[disabled][md-fab], .md-warn[disabled][md-mini-fab], [disabled][disabled][md-raised-button], [disabled][disabled][md-fab], [disabled][disabled][md-mini-fab] { color: rgba(0, 0, 0, 0.38); } .md-primary[md-raised-button], .md-primary[md-fab], .md-primary[md-mini-fab] { background-color: #009688; } .md-accent[md-raised-button], .md-accent[md-fab], .md-accent[md-mini-fab] { background-color: #9c27b0; } .md-warn[md-raised-button], .md-warn[md-fab], .md-warn[md-mini-fab] { background-color: #f44336; } .md-primary[disabled][md-raised-button], .md-primary[disabled][md-fab], .md-primary[disabled][md-mini-fab], .md-accent[disabled][md-raised-button], .md-accent[disabled][md-fab], .md-accent[disabled][md-mini-fab], .md-warn[disabled][md-raised-button], .md-warn[disabled][md-fab], .md-warn[disabled][md-mini-fab], [disabled][disabled][md-raised-button], [disabled][disabled][md-fab], [disabled][disabled][md-mini-fab] { background-color: rgba(0, 0, 0, 0.12); } [md-raised-button]:active, [md-fab]:active, [md-mini-fab]:active { box-shadow: 0px 5px 5px -3px rgba(0, 0, 0, 0.2), 0px 8px 10px 1px rgba(0, 0, 0, 0.14), 0px 3px 14px 2px rgba(0, 0, 0, 0.12); } [disabled][md-raised-button], [disabled][md-fab], [disabled][md-mini-fab] { box-shadow: none; } [md-button][disabled]:hover.md-primary, [md-button][disabled]:hover.md-accent, [md-button][disabled]:hover.md-warn, [md-button][disabled]:hover:hover { background-color: transparent; } [md-fab] { min-width: 0; border-radius: 50%; background-color: #9c27b0; color: rgba(255, 255, 255, 0.870588); width: 56px; height: 56px; padding: 0; } [md-fab] i, [md-fab] md-icon { padding: 16px 0; } [md-mini-fab] { min-width: 0; border-radius: 50%; background-color: #9c27b0; color: rgba(255, 255, 255, 0.870588); width: 40px; height: 40px; padding: 0; } [md-mini-fab] i, [md-mini-fab] md-icon { padding: 8px 0; } [md-icon-button] { min-width: 0; padding: 0; width: 40px; height: 40px; line-height: 24px; border-radius: 50%; } [md-icon-button] .md-button-wrapper > * { vertical-align: middle; } .md-button-ripple { position: absolute; top: 0; left: 0; bottom: 0; right: 0; } .md-button-ripple-round { border-radius: 50%; z-index: 1; } [md-button]:hover::after, [md-icon-button]:hover::after { position: absolute; top: 0; left: 0; bottom: 0; right: 0; content: \'\'; background-color: rgba(0, 0, 0, 0.12); border-radius: inherit; pointer-events: none; } [md-button]:hover.md-primary::after, [md-icon-button]:hover.md-primary::after { background-color: rgba(0, 150, 136, 0.12); } [md-button]:hover.md-accent::after, [md-icon-button]:hover.md-accent::after { background-color: rgba(156, 39, 176, 0.12); } [md-button]:hover.md-warn::after, [md-icon-button]:hover.md-warn::after { background-color: rgba(244, 67, 54, 0.12); } @media screen and (-ms-high-contrast: active) { .md-raised-button, .md-fab, .md-mini-fab { border: 1px solid #fff; } } '],encapsulation:s.ViewEncapsulation.None}),o("design:paramtypes",[s.ElementRef,s.Renderer])],MdAnchor)}(u);t.MdAnchor=p,t.MD_BUTTON_DIRECTIVES=[u,p];var d=function(){function MdButtonModule(){}return MdButtonModule=i([s.NgModule({imports:[a.CommonModule,c.MdRippleModule],exports:t.MD_BUTTON_DIRECTIVES,declarations:t.MD_BUTTON_DIRECTIVES}),o("design:paramtypes",[])],MdButtonModule)}();t.MdButtonModule=d},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=function(){function MdCard(){}return MdCard=r([o.Component({selector:"md-card",template:'<div class="md-card"> <ng-content></ng-content> </div> ',styles:['/** * A collection of mixins and CSS classes that can be used to apply elevation to a material * element. * See: https://www.google.com/design/spec/what-is-material/elevation-shadows.html * Examples: * * * .md-foo { *   @include $md-elevation(2); * *   &:active { *     @include $md-elevation(8); *   } * } * * <div id="external-card" class="md-elevation-z2"><p>Some content</p></div> * * For an explanation of the design behind how elevation is implemented, see the design doc at * https://goo.gl/Kq0k9Z. */ /** * The css property used for elevation. In most cases this should not be changed. It is exposed * as a variable for abstraction / easy use when needing to reference the property directly, for * example in a will-change rule. */ /** The default duration value for elevation transitions. */ /** The default easing value for elevation transitions. */ /** * Applies the correct css rules to an element to give it the elevation specified by $zValue. * The $zValue must be between 0 and 24. */ /** * Returns a string that can be used as the value for a transition property for elevation. * Calling this function directly is useful in situations where a component needs to transition * more than one property. * * .foo { *   transition: md-elevation-transition-property-value(), opacity 100ms ease; *   will-change: $md-elevation-property, opacity; * } */ /** * Applies the correct css rules needed to have an element transition between elevations. * This mixin should be applied to elements whose elevation values will change depending on their * context (e.g. when active or disabled). */ md-card { box-shadow: 0px 2px 1px -1px rgba(0, 0, 0, 0.2), 0px 1px 1px 0px rgba(0, 0, 0, 0.14), 0px 1px 3px 0px rgba(0, 0, 0, 0.12); -webkit-transition: box-shadow 280ms cubic-bezier(0.4, 0, 0.2, 1); transition: box-shadow 280ms cubic-bezier(0.4, 0, 0.2, 1); will-change: box-shadow; display: block; position: relative; padding: 24px; border-radius: 2px; font-family: Roboto, "Helvetica Neue", sans-serif; background: white; color: black; } md-card:hover { box-shadow: 0px 3px 1px -2px rgba(0, 0, 0, 0.2), 0px 2px 2px 0px rgba(0, 0, 0, 0.14), 0px 1px 5px 0px rgba(0, 0, 0, 0.12); } .md-card-flat { box-shadow: none; } md-card-title, md-card-subtitle, md-card-content, md-card-actions { display: block; margin-bottom: 16px; } md-card-title { font-size: 24px; font-weight: 400; } md-card-subtitle { font-size: 14px; color: rgba(0, 0, 0, 0.54); } md-card-content { font-size: 14px; } md-card-actions { margin-left: -16px; margin-right: -16px; padding: 8px 0; } md-card-actions[align=\'end\'] { display: -webkit-box; display: -ms-flexbox; display: flex; -webkit-box-pack: end; -ms-flex-pack: end; justify-content: flex-end; } [md-card-image] { width: calc(100% + 48px); margin: 0 -24px 16px -24px; } [md-card-xl-image] { width: 240px; height: 240px; margin: -8px; } md-card-footer { position: absolute; bottom: 0; } md-card-actions [md-button], md-card-actions [md-raised-button] { margin: 0 4px; } /* HEADER STYLES */ md-card-header { display: -webkit-box; display: -ms-flexbox; display: flex; -webkit-box-orient: horizontal; -webkit-box-direction: normal; -ms-flex-direction: row; flex-direction: row; height: 40px; margin: -8px 0 16px 0; } .md-card-header-text { height: 40px; margin: 0 8px; } [md-card-avatar] { height: 40px; width: 40px; border-radius: 50%; } md-card-header md-card-title { font-size: 14px; } /* TITLE-GROUP STYLES */ [md-card-sm-image], [md-card-md-image], [md-card-lg-image] { margin: -8px 0; } md-card-title-group { display: -webkit-box; display: -ms-flexbox; display: flex; -webkit-box-pack: justify; -ms-flex-pack: justify; justify-content: space-between; margin: 0 -8px; } [md-card-sm-image] { width: 80px; height: 80px; } [md-card-md-image] { width: 112px; height: 112px; } [md-card-lg-image] { width: 152px; height: 152px; } /* MEDIA QUERIES */ @media (max-width: 600px) { md-card { padding: 24px 16px; } [md-card-image] { width: calc(100% + 32px); margin: 16px -16px; } md-card-title-group { margin: 0; } [md-card-xl-image] { margin-left: 0; margin-right: 0; } md-card-header { margin: -8px 0 0 0; } } /* FIRST/LAST CHILD ADJUSTMENTS */ .md-card > :first-child, md-card-content > :first-child { margin-top: 0; } .md-card > :last-child, md-card-content > :last-child { margin-bottom: 0; } [md-card-image]:first-child { margin-top: -24px; } .md-card > md-card-actions:last-child { margin-bottom: -16px; padding-bottom: 0; } md-card-actions [md-button]:first-child, md-card-actions [md-raised-button]:first-child { margin-left: 0; margin-right: 0; } md-card-title:not(:first-child), md-card-subtitle:not(:first-child) { margin-top: -4px; } md-card-header md-card-subtitle:not(:first-child) { margin-top: -8px; } .md-card > [md-card-xl-image]:first-child { margin-top: -8px; } .md-card > [md-card-xl-image]:last-child { margin-bottom: -8px; } '],encapsulation:o.ViewEncapsulation.None,changeDetection:o.ChangeDetectionStrategy.OnPush}),i("design:paramtypes",[])],MdCard)}();t.MdCard=s;var a=function(){function MdCardHeader(){}return MdCardHeader=r([o.Component({selector:"md-card-header",template:'<ng-content select="[md-card-avatar]"></ng-content> <div class="md-card-header-text"> <ng-content select="md-card-title, md-card-subtitle"></ng-content> </div> <ng-content></ng-content> ',encapsulation:o.ViewEncapsulation.None,changeDetection:o.ChangeDetectionStrategy.OnPush}),i("design:paramtypes",[])],MdCardHeader)}();t.MdCardHeader=a;var l=function(){function MdCardTitleGroup(){}return MdCardTitleGroup=r([o.Component({selector:"md-card-title-group",template:'<div> <ng-content select="md-card-title, md-card-subtitle"></ng-content> </div> <ng-content select="img"></ng-content> <ng-content></ng-content> ',encapsulation:o.ViewEncapsulation.None,changeDetection:o.ChangeDetectionStrategy.OnPush}),i("design:paramtypes",[])],MdCardTitleGroup)}();t.MdCardTitleGroup=l,t.MD_CARD_DIRECTIVES=[s,a,l];var c=function(){function MdCardModule(){}return MdCardModule=r([o.NgModule({exports:t.MD_CARD_DIRECTIVES,declarations:t.MD_CARD_DIRECTIVES}),i("design:paramtypes",[])],MdCardModule)}();t.MdCardModule=c},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(22),a=0;t.MD_CHECKBOX_CONTROL_VALUE_ACCESSOR={provide:s.NG_VALUE_ACCESSOR,useExisting:o.forwardRef(function(){return u}),multi:!0};var l;!function(e){e[e.Init=0]="Init",e[e.Checked=1]="Checked",e[e.Unchecked=2]="Unchecked",e[e.Indeterminate=3]="Indeterminate"}(l||(l={}));var c=function(){function MdCheckboxChange(){}return MdCheckboxChange}();t.MdCheckboxChange=c;var u=function(){function MdCheckbox(e,t){this._renderer=e,this._elementRef=t,this.ariaLabel="",this.ariaLabelledby=null,this.id="md-checkbox-"+ ++a,this.align="start",this.disabled=!1,this.tabindex=0,this.name=null,this.change=new o.EventEmitter,this.onTouched=function(){},this._currentAnimationClass="",this._currentCheckState=l.Init,this._checked=!1,this._indeterminate=!1,this._controlValueAccessorChangeFn=function(e){},this.hasFocus=!1}return Object.defineProperty(MdCheckbox.prototype,"inputId",{get:function(){return"input-"+this.id},enumerable:!0,configurable:!0}),Object.defineProperty(MdCheckbox.prototype,"checked",{get:function(){return this._checked},set:function(e){e!=this.checked&&(this._indeterminate=!1,this._checked=e,this._transitionCheckState(this._checked?l.Checked:l.Unchecked))},enumerable:!0,configurable:!0}),Object.defineProperty(MdCheckbox.prototype,"indeterminate",{get:function(){return this._indeterminate},set:function(e){this._indeterminate=e,this._indeterminate?this._transitionCheckState(l.Indeterminate):this._transitionCheckState(this.checked?l.Checked:l.Unchecked)},enumerable:!0,configurable:!0}),MdCheckbox.prototype.writeValue=function(e){this.checked=!!e},MdCheckbox.prototype.registerOnChange=function(e){this._controlValueAccessorChangeFn=e},MdCheckbox.prototype.registerOnTouched=function(e){this.onTouched=e},MdCheckbox.prototype._transitionCheckState=function(e){var t=this._currentCheckState,n=this._renderer,r=this._elementRef;t!==e&&(this._currentAnimationClass.length>0&&n.setElementClass(r.nativeElement,this._currentAnimationClass,!1),this._currentAnimationClass=this._getAnimationClassForCheckStateTransition(t,e),this._currentCheckState=e,this._currentAnimationClass.length>0&&n.setElementClass(r.nativeElement,this._currentAnimationClass,!0))},MdCheckbox.prototype._emitChangeEvent=function(){var e=new c;e.source=this,e.checked=this.checked,this._controlValueAccessorChangeFn(this.checked),this.change.emit(e)},MdCheckbox.prototype._onInputFocus=function(){this.hasFocus=!0},MdCheckbox.prototype._onInputBlur=function(){this.hasFocus=!1,this.onTouched()},MdCheckbox.prototype.toggle=function(){this.checked=!this.checked},MdCheckbox.prototype._onInteractionEvent=function(e){e.stopPropagation(),this.disabled||(this.toggle(),this._emitChangeEvent())},MdCheckbox.prototype._onInputClick=function(e){e.stopPropagation()},MdCheckbox.prototype._getAnimationClassForCheckStateTransition=function(e,t){var n;switch(e){case l.Init:if(t!==l.Checked)return"";n="unchecked-checked";break;case l.Unchecked:n=t===l.Checked?"unchecked-checked":"unchecked-indeterminate";break;case l.Checked:n=t===l.Unchecked?"checked-unchecked":"checked-indeterminate";break;case l.Indeterminate:n=t===l.Checked?"indeterminate-checked":"indeterminate-unchecked"}return"md-checkbox-anim-"+n},r([o.Input("aria-label"),i("design:type",String)],MdCheckbox.prototype,"ariaLabel",void 0),r([o.Input("aria-labelledby"),i("design:type",String)],MdCheckbox.prototype,"ariaLabelledby",void 0),r([o.Input(),i("design:type",String)],MdCheckbox.prototype,"id",void 0),r([o.Input(),i("design:type",Object)],MdCheckbox.prototype,"align",void 0),r([o.Input(),i("design:type",Boolean)],MdCheckbox.prototype,"disabled",void 0),r([o.Input(),i("design:type",Number)],MdCheckbox.prototype,"tabindex",void 0),r([o.Input(),i("design:type",String)],MdCheckbox.prototype,"name",void 0),r([o.Output(),i("design:type",o.EventEmitter)],MdCheckbox.prototype,"change",void 0),r([o.Input(),i("design:type",Object)],MdCheckbox.prototype,"checked",null),r([o.Input(),i("design:type",Object)],MdCheckbox.prototype,"indeterminate",null),MdCheckbox=r([o.Component({selector:"md-checkbox",template:'<label class="md-checkbox-layout"> <div class="md-checkbox-inner-container"> <input class="md-checkbox-input" type="checkbox" [id]="inputId" [checked]="checked" [disabled]="disabled" [name]="name" [tabIndex]="tabindex" [indeterminate]="indeterminate" [attr.aria-label]="ariaLabel" [attr.aria-labelledby]="ariaLabelledby" (focus)="_onInputFocus()" (blur)="_onInputBlur()" (change)="_onInteractionEvent($event)" (click)="_onInputClick($event)"> <div class="md-ink-ripple"></div> <div class="md-checkbox-frame"></div> <div class="md-checkbox-background"> <svg version="1.1" class="md-checkbox-checkmark" xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24" xml:space="preserve"> <path class="md-checkbox-checkmark-path" fill="none" stroke="white" d="M4.1,12.7 9,17.6 20.3,6.3"/> </svg> <!-- Element for rendering the indeterminate state checkbox. --> <div class="md-checkbox-mixedmark"></div> </div> </div> <span class="md-checkbox-label"> <ng-content></ng-content> </span> </label> ',styles:['/** * Mixin that creates a new stacking context. * see https://developer.mozilla.org/en-US/docs/Web/CSS/CSS_Positioning/Understanding_z_index/The_stacking_context */ /** * This mixin hides an element visually. * That means it\'s still accessible for screen-readers but not visible in view. */ /** * Forces an element to grow to fit floated contents; used as as an alternative to * `overflow: hidden;` because it doesn\'t cut off contents. */ /** * A mixin, which generates temporary ink ripple on a given component. * When $bindToParent is set to true, it will check for the focused class on the same selector as you included * that mixin. * It is also possible to specify the color palette of the temporary ripple. By default it uses the * accent palette for its background. */ /** The width/height of the checkbox element. */ /** The width of the line used to draw the checkmark / mixedmark. */ /** The width of the checkbox border shown when the checkbox is unchecked. */ /** The color of the checkbox border. */ /** The color of the checkbox\'s checkmark / mixedmark. */ /** The color that is used as the checkbox background when it is checked. */ /** The base duration used for the majority of transitions for the checkbox. */ /** The amount of spacing between the checkbox and its label. */ /** * Fades in the background of the checkbox when it goes from unchecked -> {checked,indeterminate}. */ @-webkit-keyframes md-checkbox-fade-in-background { 0% { opacity: 0; } 50% { opacity: 1; } } @keyframes md-checkbox-fade-in-background { 0% { opacity: 0; } 50% { opacity: 1; } } /** * Fades out the background of the checkbox when it goes from {checked,indeterminate} -> unchecked. */ @-webkit-keyframes md-checkbox-fade-out-background { 0%, 50% { opacity: 1; } 100% { opacity: 0; } } @keyframes md-checkbox-fade-out-background { 0%, 50% { opacity: 1; } 100% { opacity: 0; } } /** * "Draws" in the checkmark when the checkbox goes from unchecked -> checked. */ @-webkit-keyframes md-checkbox-unchecked-checked-checkmark-path { 0%, 50% { stroke-dashoffset: 22.91026; } 50% { -webkit-animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); } 100% { stroke-dashoffset: 0; } } @keyframes md-checkbox-unchecked-checked-checkmark-path { 0%, 50% { stroke-dashoffset: 22.91026; } 50% { -webkit-animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); } 100% { stroke-dashoffset: 0; } } /** * Horizontally expands the mixedmark when the checkbox goes from unchecked -> indeterminate. */ @-webkit-keyframes md-checkbox-unchecked-indeterminate-mixedmark { 0%, 68.2% { -webkit-transform: scaleX(0); transform: scaleX(0); } 68.2% { -webkit-animation-timing-function: cubic-bezier(0, 0, 0, 1); animation-timing-function: cubic-bezier(0, 0, 0, 1); } 100% { -webkit-transform: scaleX(1); transform: scaleX(1); } } @keyframes md-checkbox-unchecked-indeterminate-mixedmark { 0%, 68.2% { -webkit-transform: scaleX(0); transform: scaleX(0); } 68.2% { -webkit-animation-timing-function: cubic-bezier(0, 0, 0, 1); animation-timing-function: cubic-bezier(0, 0, 0, 1); } 100% { -webkit-transform: scaleX(1); transform: scaleX(1); } } /** * "Erases" the checkmark when the checkbox goes from checked -> unchecked. */ @-webkit-keyframes md-checkbox-checked-unchecked-checkmark-path { from { -webkit-animation-timing-function: cubic-bezier(0.4, 0, 1, 1); animation-timing-function: cubic-bezier(0.4, 0, 1, 1); stroke-dashoffset: 0; } to { stroke-dashoffset: -22.91026; } } @keyframes md-checkbox-checked-unchecked-checkmark-path { from { -webkit-animation-timing-function: cubic-bezier(0.4, 0, 1, 1); animation-timing-function: cubic-bezier(0.4, 0, 1, 1); stroke-dashoffset: 0; } to { stroke-dashoffset: -22.91026; } } /** * Rotates and fades out the checkmark when the checkbox goes from checked -> indeterminate. This * animation helps provide the illusion of the checkmark "morphing" into the mixedmark. */ @-webkit-keyframes md-checkbox-checked-indeterminate-checkmark { from { -webkit-animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); opacity: 1; -webkit-transform: rotate(0deg); transform: rotate(0deg); } to { opacity: 0; -webkit-transform: rotate(45deg); transform: rotate(45deg); } } @keyframes md-checkbox-checked-indeterminate-checkmark { from { -webkit-animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); opacity: 1; -webkit-transform: rotate(0deg); transform: rotate(0deg); } to { opacity: 0; -webkit-transform: rotate(45deg); transform: rotate(45deg); } } /** * Rotates and fades the checkmark back into position when the checkbox goes from indeterminate -> * checked. This animation helps provide the illusion that the mixedmark is "morphing" into the * checkmark. */ @-webkit-keyframes md-checkbox-indeterminate-checked-checkmark { from { -webkit-animation-timing-function: cubic-bezier(0.14, 0, 0, 1); animation-timing-function: cubic-bezier(0.14, 0, 0, 1); opacity: 0; -webkit-transform: rotate(45deg); transform: rotate(45deg); } to { opacity: 1; -webkit-transform: rotate(360deg); transform: rotate(360deg); } } @keyframes md-checkbox-indeterminate-checked-checkmark { from { -webkit-animation-timing-function: cubic-bezier(0.14, 0, 0, 1); animation-timing-function: cubic-bezier(0.14, 0, 0, 1); opacity: 0; -webkit-transform: rotate(45deg); transform: rotate(45deg); } to { opacity: 1; -webkit-transform: rotate(360deg); transform: rotate(360deg); } } /** * Rotates and fades in the mixedmark when the checkbox goes from checked -> indeterminate. This * animation, similar to md-checkbox-checked-indeterminate-checkmark, helps provide an illusion * of "morphing" from checkmark -> mixedmark. */ @-webkit-keyframes md-checkbox-checked-indeterminate-mixedmark { from { -webkit-animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); opacity: 0; -webkit-transform: rotate(-45deg); transform: rotate(-45deg); } to { opacity: 1; -webkit-transform: rotate(0deg); transform: rotate(0deg); } } @keyframes md-checkbox-checked-indeterminate-mixedmark { from { -webkit-animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); animation-timing-function: cubic-bezier(0, 0, 0.2, 0.1); opacity: 0; -webkit-transform: rotate(-45deg); transform: rotate(-45deg); } to { opacity: 1; -webkit-transform: rotate(0deg); transform: rotate(0deg); } } /** * Rotates and fades out the mixedmark when the checkbox goes from indeterminate -> checked. This * animation, similar to md-checkbox-indeterminate-checked-checkmark, helps provide an illusion * of "morphing" from mixedmark -> checkmark. */ @-webkit-keyframes md-checkbox-indeterminate-checked-mixedmark { from { -webkit-animation-timing-function: cubic-bezier(0.14, 0, 0, 1); animation-timing-function: cubic-bezier(0.14, 0, 0, 1); opacity: 1; -webkit-transform: rotate(0deg); transform: rotate(0deg); } to { opacity: 0; -webkit-transform: rotate(315deg); transform: rotate(315deg); } } @keyframes md-checkbox-indeterminate-checked-mixedmark { from { -webkit-animation-timing-function: cubic-bezier(0.14, 0, 0, 1); animation-timing-function: cubic-bezier(0.14, 0, 0, 1); opacity: 1; -webkit-transform: rotate(0deg); transform: rotate(0deg); } to { opacity: 0; -webkit-transform: rotate(315deg); transform: rotate(315deg); } } /** * Horizontally collapses and fades out the mixedmark when the checkbox goes from indeterminate -> * unchecked. */ @-webkit-keyframes md-checkbox-indeterminate-unchecked-mixedmark { 0% { -webkit-animation-timing-function: linear; animation-timing-function: linear; opacity: 1; -webkit-transform: scaleX(1); transform: scaleX(1); } 32.8%, 100% { opacity: 0; -webkit-transform: scaleX(0); transform: scaleX(0); } } @keyframes md-checkbox-indeterminate-unchecked-mixedmark { 0% { -webkit-animation-timing-function: linear; animation-timing-function: linear; opacity: 1; -webkit-transform: scaleX(1); transform: scaleX(1); } 32.8%, 100% { opacity: 0; -webkit-transform: scaleX(0); transform: scaleX(0); } } /** * Applied to elements that cover the checkbox\'s entire inner container. */ .md-checkbox-frame, .md-checkbox-background, .md-checkbox-checkmark { bottom: 0; left: 0; position: absolute; right: 0; top: 0; } /** * Applied to elements that are considered "marks" within the checkbox, e.g. the checkmark and * the mixedmark. */ .md-checkbox-checkmark, .md-checkbox-mixedmark { width: calc(100% - 4px); } /** * Applied to elements that appear to make up the outer box of the checkmark, such as the frame * that contains the border and the actual background element that contains the marks. */ .md-checkbox-frame, .md-checkbox-background { border-radius: 2px; box-sizing: border-box; pointer-events: none; } md-checkbox { cursor: pointer; } .md-checkbox-layout { cursor: inherit; -webkit-box-align: baseline; -ms-flex-align: baseline; align-items: baseline; display: -webkit-inline-box; display: -ms-inline-flexbox; display: inline-flex; } .md-checkbox-inner-container { display: inline-block; height: 20px; line-height: 0; margin: auto; margin-right: 8px; -webkit-box-ordinal-group: 1; -ms-flex-order: 0; order: 0; position: relative; vertical-align: middle; white-space: nowrap; width: 20px; } [dir=\'rtl\'] .md-checkbox-inner-container { margin-left: 8px; margin-right: auto; } .md-checkbox-layout .md-checkbox-label { line-height: 24px; } .md-checkbox-frame { background-color: transparent; border: 2px solid rgba(0, 0, 0, 0.54); -webkit-transition: border-color 90ms cubic-bezier(0, 0, 0.2, 0.1); transition: border-color 90ms cubic-bezier(0, 0, 0.2, 0.1); will-change: border-color; } .md-checkbox-background { -webkit-box-align: center; -ms-flex-align: center; align-items: center; display: -webkit-inline-box; display: -ms-inline-flexbox; display: inline-flex; -webkit-box-pack: center; -ms-flex-pack: center; justify-content: center; -webkit-transition: background-color 90ms cubic-bezier(0, 0, 0.2, 0.1), opacity 90ms cubic-bezier(0, 0, 0.2, 0.1); transition: background-color 90ms cubic-bezier(0, 0, 0.2, 0.1), opacity 90ms cubic-bezier(0, 0, 0.2, 0.1); will-change: background-color, opacity; } .md-checkbox-checkmark { fill: #fafafa; width: 100%; } .md-checkbox-checkmark-path { stroke: #fafafa !important; stroke-dashoffset: 22.91026; stroke-dasharray: 22.91026; stroke-width: 2.66667px; } .md-checkbox-mixedmark { background-color: #fafafa; height: 2px; opacity: 0; -webkit-transform: scaleX(0) rotate(0deg); transform: scaleX(0) rotate(0deg); } .md-checkbox-align-end .md-checkbox-inner-container { -webkit-box-ordinal-group: 2; -ms-flex-order: 1; order: 1; margin-left: 8px; margin-right: auto; } [dir=\'rtl\'] .md-checkbox-align-end .md-checkbox-inner-container { margin-left: auto; margin-right: 8px; } .md-checkbox-checked .md-checkbox-checkmark { opacity: 1; } .md-checkbox-checked .md-checkbox-checkmark-path { stroke-dashoffset: 0; } .md-checkbox-checked .md-checkbox-mixedmark { -webkit-transform: scaleX(1) rotate(-45deg); transform: scaleX(1) rotate(-45deg); } .md-checkbox-checked .md-checkbox-background { background-color: #9c27b0; } .md-checkbox-indeterminate .md-checkbox-background { background-color: #9c27b0; } .md-checkbox-indeterminate .md-checkbox-checkmark { opacity: 0; -webkit-transform: rotate(45deg); transform: rotate(45deg); } .md-checkbox-indeterminate .md-checkbox-checkmark-path { stroke-dashoffset: 0; } .md-checkbox-indeterminate .md-checkbox-mixedmark { opacity: 1; -webkit-transform: scaleX(1) rotate(0deg); transform: scaleX(1) rotate(0deg); } .md-checkbox-unchecked .md-checkbox-background { background-color: transparent; } .md-checkbox-disabled { cursor: default; } .md-checkbox-disabled.md-checkbox-checked .md-checkbox-background, .md-checkbox-disabled.md-checkbox-indeterminate .md-checkbox-background { background-color: #b0b0b0; } .md-checkbox-disabled:not(.md-checkbox-checked) .md-checkbox-frame { border-color: #b0b0b0; } .md-checkbox-anim-unchecked-checked .md-checkbox-background { -webkit-animation: 180ms linear 0ms md-checkbox-fade-in-background; animation: 180ms linear 0ms md-checkbox-fade-in-background; } .md-checkbox-anim-unchecked-checked .md-checkbox-checkmark-path { -webkit-animation: 180ms linear 0ms md-checkbox-unchecked-checked-checkmark-path; animation: 180ms linear 0ms md-checkbox-unchecked-checked-checkmark-path; } .md-checkbox-anim-unchecked-indeterminate .md-checkbox-background { -webkit-animation: 180ms linear 0ms md-checkbox-fade-in-background; animation: 180ms linear 0ms md-checkbox-fade-in-background; } .md-checkbox-anim-unchecked-indeterminate .md-checkbox-mixedmark { -webkit-animation: 90ms linear 0ms md-checkbox-unchecked-indeterminate-mixedmark; animation: 90ms linear 0ms md-checkbox-unchecked-indeterminate-mixedmark; } .md-checkbox-anim-checked-unchecked .md-checkbox-background { -webkit-animation: 180ms linear 0ms md-checkbox-fade-out-background; animation: 180ms linear 0ms md-checkbox-fade-out-background; } .md-checkbox-anim-checked-unchecked .md-checkbox-checkmark-path { -webkit-animation: 90ms linear 0ms md-checkbox-checked-unchecked-checkmark-path; animation: 90ms linear 0ms md-checkbox-checked-unchecked-checkmark-path; } .md-checkbox-anim-checked-indeterminate .md-checkbox-checkmark { -webkit-animation: 90ms linear 0ms md-checkbox-checked-indeterminate-checkmark; animation: 90ms linear 0ms md-checkbox-checked-indeterminate-checkmark; } .md-checkbox-anim-checked-indeterminate .md-checkbox-mixedmark { -webkit-animation: 90ms linear 0ms md-checkbox-checked-indeterminate-mixedmark; animation: 90ms linear 0ms md-checkbox-checked-indeterminate-mixedmark; } .md-checkbox-anim-indeterminate-checked .md-checkbox-checkmark { -webkit-animation: 500ms linear 0ms md-checkbox-indeterminate-checked-checkmark; animation: 500ms linear 0ms md-checkbox-indeterminate-checked-checkmark; } .md-checkbox-anim-indeterminate-checked .md-checkbox-mixedmark { -webkit-animation: 500ms linear 0ms md-checkbox-indeterminate-checked-mixedmark; animation: 500ms linear 0ms md-checkbox-indeterminate-checked-mixedmark; } .md-checkbox-anim-indeterminate-unchecked .md-checkbox-background { -webkit-animation: 180ms linear 0ms md-checkbox-fade-out-background; animation: 180ms linear 0ms md-checkbox-fade-out-background; } .md-checkbox-anim-indeterminate-unchecked .md-checkbox-mixedmark { -webkit-animation: 300ms linear 0ms md-checkbox-indeterminate-unchecked-mixedmark; animation: 300ms linear 0ms md-checkbox-indeterminate-unchecked-mixedmark; } .md-checkbox-input { border: 0; clip: rect(0 0 0 0); height: 1px; margin: -1px; overflow: hidden; padding: 0; position: absolute; text-transform: none; width: 1px; } .md-ink-ripple { border-radius: 50%; opacity: 0; height: 48px; left: 50%; overflow: hidden; pointer-events: none; position: absolute; top: 50%; -webkit-transform: translate(-50%, -50%); transform: translate(-50%, -50%); -webkit-transition: opacity ease 280ms, background-color ease 280ms; transition: opacity ease 280ms, background-color ease 280ms; width: 48px; } .md-checkbox-focused .md-ink-ripple { opacity: 1; background-color: rgba(156, 39, 176, 0.26); } .md-checkbox-disabled .md-ink-ripple { background-color: #000; } '],
 host:{"[class.md-checkbox-indeterminate]":"indeterminate","[class.md-checkbox-checked]":"checked","[class.md-checkbox-disabled]":"disabled","[class.md-checkbox-align-end]":'align == "end"',"[class.md-checkbox-focused]":"hasFocus"},providers:[t.MD_CHECKBOX_CONTROL_VALUE_ACCESSOR],encapsulation:o.ViewEncapsulation.None,changeDetection:o.ChangeDetectionStrategy.OnPush}),i("design:paramtypes",[o.Renderer,o.ElementRef])],MdCheckbox)}();t.MdCheckbox=u,t.MD_CHECKBOX_DIRECTIVES=[u];var p=function(){function MdCheckboxModule(){}return MdCheckboxModule=r([o.NgModule({exports:t.MD_CHECKBOX_DIRECTIVES,declarations:t.MD_CHECKBOX_DIRECTIVES}),i("design:paramtypes",[])],MdCheckboxModule)}();t.MdCheckboxModule=p},function(e,t){"use strict";var n=function(){function PromiseCompleter(){var e=this;this.promise=new Promise(function(t,n){e.resolve=t,e.reject=n})}return PromiseCompleter}();t.PromiseCompleter=n},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=function(){function MdLine(){}return MdLine=r([o.Directive({selector:"[md-line]"}),i("design:paramtypes",[])],MdLine)}();t.MdLine=s;var a=function(){function MdLineSetter(e,t,n){var r=this;this._lines=e,this._renderer=t,this._element=n,this._setLineClass(this._lines.length),this._lines.changes.subscribe(function(){r._setLineClass(r._lines.length)})}return MdLineSetter.prototype._setLineClass=function(e){this._resetClasses(),2!==e&&3!==e||this._setClass("md-"+e+"-line",!0)},MdLineSetter.prototype._resetClasses=function(){this._setClass("md-2-line",!1),this._setClass("md-3-line",!1)},MdLineSetter.prototype._setClass=function(e,t){this._renderer.setElementClass(this._element.nativeElement,e,t)},MdLineSetter}();t.MdLineSetter=a;var l=function(){function MdLineModule(){}return MdLineModule=r([o.NgModule({exports:[s],declarations:[s]}),i("design:paramtypes",[])],MdLineModule)}();t.MdLineModule=l},function(e,t){"use strict";!function(e){e[e.NEW=0]="NEW",e[e.EXPANDING=1]="EXPANDING",e[e.FADING_OUT=2]="FADING_OUT"}(t.ForegroundRippleState||(t.ForegroundRippleState={}));var n=t.ForegroundRippleState,r=function(){function ForegroundRipple(e){this.rippleElement=e,this.state=n.NEW}return ForegroundRipple}();t.ForegroundRipple=r;var i=1e3,o=.1,s=.3,a=function(e,t,n){var r=Math.max(Math.abs(e-n.left),Math.abs(e-n.right)),i=Math.max(Math.abs(t-n.top),Math.abs(t-n.bottom));return Math.sqrt(r*r+i*i)},l=function(){function RippleRenderer(e,t){this._eventHandlers=t,this._rippleElement=e.nativeElement,this._backgroundDiv=document.createElement("div"),this._backgroundDiv.classList.add("md-ripple-background"),this._rippleElement.appendChild(this._backgroundDiv)}return RippleRenderer.prototype.setTriggerElement=function(e){var t=this;this._triggerElement!==e&&(this._triggerElement&&this._eventHandlers.forEach(function(e,n){t._triggerElement.removeEventListener(n,e)}),this._triggerElement=e,this._triggerElement&&this._eventHandlers.forEach(function(e,n){t._triggerElement.addEventListener(n,e)}))},RippleRenderer.prototype.setTriggerElementToHost=function(){this.setTriggerElement(this._rippleElement)},RippleRenderer.prototype.clearTriggerElement=function(){this.setTriggerElement(null)},RippleRenderer.prototype.createForegroundRipple=function(e,t,l,c,u,p,d){var h=this._rippleElement.getBoundingClientRect(),f=c?h.left+h.width/2:e,m=c?h.top+h.height/2:t,g=f-h.left,y=m-h.top,v=u>0?u:a(f,m,h),b=document.createElement("div");this._rippleElement.appendChild(b),b.classList.add("md-ripple-foreground"),b.style.left=g-v+"px",b.style.top=y-v+"px",b.style.width=2*v+"px",b.style.height=b.style.width,b.style.backgroundColor=l,b.style.transform="scale(0.001)";var _=1/(p||1)*Math.max(o,Math.min(s,v/i));b.style.transitionDuration=_+"s",window.getComputedStyle(b).opacity,b.classList.add("md-ripple-fade-in"),b.style.transform="";var w=new r(b);w.state=n.EXPANDING,b.addEventListener("transitionend",function(e){return d(w,e)})},RippleRenderer.prototype.fadeOutForegroundRipple=function(e){e.classList.remove("md-ripple-fade-in"),e.classList.add("md-ripple-fade-out")},RippleRenderer.prototype.removeRippleFromDom=function(e){e.parentElement.removeChild(e)},RippleRenderer.prototype.fadeInRippleBackground=function(e){this._backgroundDiv.classList.add("md-ripple-active"),this._backgroundDiv.style.backgroundColor=e},RippleRenderer.prototype.fadeOutRippleBackground=function(){this._backgroundDiv&&this._backgroundDiv.classList.remove("md-ripple-active")},RippleRenderer}();t.RippleRenderer=l},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=function(){function Dir(){this._dir="ltr",this.dirChange=new o.EventEmitter}return Object.defineProperty(Dir.prototype,"dir",{get:function(){return this._dir},set:function(e){var t=this._dir;this._dir=e,t!=this._dir&&this.dirChange.emit(null)},enumerable:!0,configurable:!0}),Object.defineProperty(Dir.prototype,"value",{get:function(){return this.dir},set:function(e){this.dir=e},enumerable:!0,configurable:!0}),r([o.Input("dir"),i("design:type",String)],Dir.prototype,"_dir",void 0),r([o.Output(),i("design:type",Object)],Dir.prototype,"dirChange",void 0),r([o.HostBinding("attr.dir"),i("design:type",String)],Dir.prototype,"dir",null),Dir=r([o.Directive({selector:"[dir]",exportAs:"$implicit"}),i("design:paramtypes",[])],Dir)}();t.Dir=s;var a=function(){function RtlModule(){}return RtlModule=r([o.NgModule({exports:[s],declarations:[s]}),i("design:paramtypes",[])],RtlModule)}();t.RtlModule=a},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},o=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},s=n(0),a=n(43),l=n(188),c=n(427),u=n(427);t.MdIconRegistry=u.MdIconRegistry;var p=function(e){function MdIconInvalidNameError(t){e.call(this,'Invalid icon name: "'+t+'"')}return r(MdIconInvalidNameError,e),MdIconInvalidNameError}(l.MdError);t.MdIconInvalidNameError=p;var d=function(){function MdIcon(e,t,n){this._element=e,this._renderer=t,this._mdIconRegistry=n,this.hostAriaLabel=""}return MdIcon.prototype._splitIconName=function(e){if(!e)return["",""];var t=e.split(":");switch(t.length){case 1:return["",t[0]];case 2:return t;default:throw new p(e)}},MdIcon.prototype.ngOnChanges=function(e){var t=this,n=Object.keys(e);if(n.indexOf("svgIcon")!=-1||n.indexOf("svgSrc")!=-1)if(this.svgIcon){var r=this._splitIconName(this.svgIcon),i=r[0],o=r[1];this._mdIconRegistry.getNamedSvgIcon(o,i).subscribe(function(e){return t._setSvgElement(e)},function(e){return console.log("Error retrieving icon: "+e)})}else this.svgSrc&&this._mdIconRegistry.getSvgIconFromUrl(this.svgSrc).subscribe(function(e){return t._setSvgElement(e)},function(e){return console.log("Error retrieving icon: "+e)});this._usingFontIcon()&&this._updateFontIconClasses(),this._updateAriaLabel()},MdIcon.prototype.ngOnInit=function(){this._usingFontIcon()&&this._updateFontIconClasses()},MdIcon.prototype.ngAfterViewChecked=function(){this._updateAriaLabel()},MdIcon.prototype._updateAriaLabel=function(){var e=this._getAriaLabel();e&&this._renderer.setElementAttribute(this._element.nativeElement,"aria-label",e)},MdIcon.prototype._getAriaLabel=function(){var e=this.hostAriaLabel||this.alt||this.fontIcon||this._splitIconName(this.svgIcon)[1];if(e)return e;if(this._usingFontIcon()){var t=this._element.nativeElement.textContent;if(t)return t}return null},MdIcon.prototype._usingFontIcon=function(){return!(this.svgIcon||this.svgSrc)},MdIcon.prototype._setSvgElement=function(e){var t=this._element.nativeElement;t.innerHTML="",this._renderer.projectNodes(t,[e])},MdIcon.prototype._updateFontIconClasses=function(){if(this._usingFontIcon()){var e=this._element.nativeElement,t=this.fontSet?this._mdIconRegistry.classNameForFontAlias(this.fontSet):this._mdIconRegistry.getDefaultFontSetClass();t!=this._previousFontSetClass&&(this._previousFontSetClass&&this._renderer.setElementClass(e,this._previousFontSetClass,!1),t&&this._renderer.setElementClass(e,t,!0),this._previousFontSetClass=t),this.fontIcon!=this._previousFontIconClass&&(this._previousFontIconClass&&this._renderer.setElementClass(e,this._previousFontIconClass,!1),this.fontIcon&&this._renderer.setElementClass(e,this.fontIcon,!0),this._previousFontIconClass=this.fontIcon)}},i([s.Input(),o("design:type",String)],MdIcon.prototype,"svgSrc",void 0),i([s.Input(),o("design:type",String)],MdIcon.prototype,"svgIcon",void 0),i([s.Input(),o("design:type",String)],MdIcon.prototype,"fontSet",void 0),i([s.Input(),o("design:type",String)],MdIcon.prototype,"fontIcon",void 0),i([s.Input(),o("design:type",String)],MdIcon.prototype,"alt",void 0),i([s.Input("aria-label"),o("design:type",String)],MdIcon.prototype,"hostAriaLabel",void 0),MdIcon=i([s.Component({template:"<ng-content></ng-content>",selector:"md-icon",styles:['/** The width/height of the icon element. */ /** This works because we\'re using ViewEncapsulation.None. If we used the default encapsulation, the selector would need to be ":host". */ md-icon { background-repeat: no-repeat; display: inline-block; fill: currentColor; height: 24px; width: 24px; } '],host:{role:"img"},encapsulation:s.ViewEncapsulation.None,changeDetection:s.ChangeDetectionStrategy.OnPush}),o("design:paramtypes",[s.ElementRef,s.Renderer,c.MdIconRegistry])],MdIcon)}();t.MdIcon=d,t.MD_ICON_DIRECTIVES=[d];var h=function(){function MdIconModule(){}return MdIconModule=i([s.NgModule({imports:[a.HttpModule],exports:t.MD_ICON_DIRECTIVES,declarations:t.MD_ICON_DIRECTIVES,providers:[c.MdIconRegistry]}),o("design:paramtypes",[])],MdIconModule)}();t.MdIconModule=h},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},o=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},s=n(0),a=n(22),l=n(3),c=n(282),u=n(188),p=n(1),d=function(){};t.MD_INPUT_CONTROL_VALUE_ACCESSOR={provide:a.NG_VALUE_ACCESSOR,useExisting:s.forwardRef(function(){return _}),multi:!0};var h=["file","radio","checkbox"],f=0,m=function(e){function MdInputPlaceholderConflictError(){e.call(this,"Placeholder attribute and child element were both specified.")}return r(MdInputPlaceholderConflictError,e),MdInputPlaceholderConflictError}(u.MdError);t.MdInputPlaceholderConflictError=m;var g=function(e){function MdInputUnsupportedTypeError(t){e.call(this,'Input type "'+t+"\" isn't supported by md-input.")}return r(MdInputUnsupportedTypeError,e),MdInputUnsupportedTypeError}(u.MdError);t.MdInputUnsupportedTypeError=g;var y=function(e){function MdInputDuplicatedHintError(t){e.call(this,"A hint was already declared for 'align=\""+t+"\"'.")}return r(MdInputDuplicatedHintError,e),MdInputDuplicatedHintError}(u.MdError);t.MdInputDuplicatedHintError=y;var v=function(){function MdPlaceholder(){}return MdPlaceholder=i([s.Directive({selector:"md-placeholder"}),o("design:paramtypes",[])],MdPlaceholder)}();t.MdPlaceholder=v;var b=function(){function MdHint(){this.align="start"}return i([s.Input(),o("design:type",Object)],MdHint.prototype,"align",void 0),MdHint=i([s.Directive({selector:"md-hint",host:{"[class.md-right]":'align == "end"',"[class.md-hint]":"true"}}),o("design:paramtypes",[])],MdHint)}();t.MdHint=b;var _=function(){function MdInput(){this._focused=!1,this._value="",this._onTouchedCallback=d,this._onChangeCallback=d,this.align="start",this.dividerColor="primary",this.floatingPlaceholder=!0,this.hintLabel="",this.autoFocus=!1,this.disabled=!1,this.id="md-input-"+f++,this.list=null,this.max=null,this.maxLength=null,this.min=null,this.minLength=null,this.placeholder=null,this.readOnly=!1,this.required=!1,this.spellCheck=!1,this.step=null,this.tabIndex=null,this.type="text",this.name=null,this._blurEmitter=new s.EventEmitter,this._focusEmitter=new s.EventEmitter}return Object.defineProperty(MdInput.prototype,"focused",{get:function(){return this._focused},enumerable:!0,configurable:!0}),Object.defineProperty(MdInput.prototype,"empty",{get:function(){return null==this._value||""===this._value},enumerable:!0,configurable:!0}),Object.defineProperty(MdInput.prototype,"characterCount",{get:function(){return this.empty?0:(""+this._value).length},enumerable:!0,configurable:!0}),Object.defineProperty(MdInput.prototype,"inputId",{get:function(){return this.id+"-input"},enumerable:!0,configurable:!0}),Object.defineProperty(MdInput.prototype,"onBlur",{get:function(){return this._blurEmitter.asObservable()},enumerable:!0,configurable:!0}),Object.defineProperty(MdInput.prototype,"onFocus",{get:function(){return this._focusEmitter.asObservable()},enumerable:!0,configurable:!0}),Object.defineProperty(MdInput.prototype,"value",{get:function(){return this._value},set:function(e){e=this._convertValueForInputType(e),e!==this._value&&(this._value=e,this._onChangeCallback(e))},enumerable:!0,configurable:!0}),Object.defineProperty(MdInput.prototype,"_align",{get:function(){return null},enumerable:!0,configurable:!0}),MdInput.prototype.focus=function(){this._inputElement.nativeElement.focus()},MdInput.prototype._handleFocus=function(e){this._focused=!0,this._focusEmitter.emit(e)},MdInput.prototype._handleBlur=function(e){this._focused=!1,this._onTouchedCallback(),this._blurEmitter.emit(e)},MdInput.prototype._handleChange=function(e){this.value=e.target.value,this._onTouchedCallback()},MdInput.prototype._hasPlaceholder=function(){return!!this.placeholder||null!=this._placeholderChild},MdInput.prototype.writeValue=function(e){this._value=e},MdInput.prototype.registerOnChange=function(e){this._onChangeCallback=e},MdInput.prototype.registerOnTouched=function(e){this._onTouchedCallback=e},MdInput.prototype.ngAfterContentInit=function(){var e=this;this._validateConstraints(),this._hintChildren.changes.subscribe(function(){e._validateConstraints()})},MdInput.prototype.ngOnChanges=function(e){this._validateConstraints()},MdInput.prototype._convertValueForInputType=function(e){switch(this.type){case"number":return parseFloat(e);default:return e}},MdInput.prototype._validateConstraints=function(){var e=this;if(""!=this.placeholder&&null!=this.placeholder&&null!=this._placeholderChild)throw new m;if(h.indexOf(this.type)!=-1)throw new g(this.type);if(this._hintChildren){var t=null,n=null;this._hintChildren.forEach(function(r){if("start"==r.align){if(t||e.hintLabel)throw new y("start");t=r}else if("end"==r.align){if(n)throw new y("end");n=r}})}},i([s.Input("aria-label"),o("design:type",String)],MdInput.prototype,"ariaLabel",void 0),i([s.Input("aria-labelledby"),o("design:type",String)],MdInput.prototype,"ariaLabelledBy",void 0),i([s.Input("aria-disabled"),c.BooleanFieldValue(),o("design:type",Boolean)],MdInput.prototype,"ariaDisabled",void 0),i([s.Input("aria-required"),c.BooleanFieldValue(),o("design:type",Boolean)],MdInput.prototype,"ariaRequired",void 0),i([s.Input("aria-invalid"),c.BooleanFieldValue(),o("design:type",Boolean)],MdInput.prototype,"ariaInvalid",void 0),i([s.ContentChild(v),o("design:type",v)],MdInput.prototype,"_placeholderChild",void 0),i([s.ContentChildren(b),o("design:type",s.QueryList)],MdInput.prototype,"_hintChildren",void 0),i([s.Input(),o("design:type",Object)],MdInput.prototype,"align",void 0),i([s.Input(),o("design:type",Object)],MdInput.prototype,"dividerColor",void 0),i([s.Input(),c.BooleanFieldValue(),o("design:type",Boolean)],MdInput.prototype,"floatingPlaceholder",void 0),i([s.Input(),o("design:type",String)],MdInput.prototype,"hintLabel",void 0),i([s.Input(),o("design:type",String)],MdInput.prototype,"autoComplete",void 0),i([s.Input(),o("design:type",String)],MdInput.prototype,"autoCorrect",void 0),i([s.Input(),o("design:type",String)],MdInput.prototype,"autoCapitalize",void 0),i([s.Input(),c.BooleanFieldValue(),o("design:type",Boolean)],MdInput.prototype,"autoFocus",void 0),i([s.Input(),c.BooleanFieldValue(),o("design:type",Boolean)],MdInput.prototype,"disabled",void 0),i([s.Input(),o("design:type",String)],MdInput.prototype,"id",void 0),i([s.Input(),o("design:type",String)],MdInput.prototype,"list",void 0),i([s.Input(),o("design:type",Object)],MdInput.prototype,"max",void 0),i([s.Input(),o("design:type",Number)],MdInput.prototype,"maxLength",void 0),i([s.Input(),o("design:type",Object)],MdInput.prototype,"min",void 0),i([s.Input(),o("design:type",Number)],MdInput.prototype,"minLength",void 0),i([s.Input(),o("design:type",String)],MdInput.prototype,"placeholder",void 0),i([s.Input(),c.BooleanFieldValue(),o("design:type",Boolean)],MdInput.prototype,"readOnly",void 0),i([s.Input(),c.BooleanFieldValue(),o("design:type",Boolean)],MdInput.prototype,"required",void 0),i([s.Input(),c.BooleanFieldValue(),o("design:type",Boolean)],MdInput.prototype,"spellCheck",void 0),i([s.Input(),o("design:type",Number)],MdInput.prototype,"step",void 0),i([s.Input(),o("design:type",Number)],MdInput.prototype,"tabIndex",void 0),i([s.Input(),o("design:type",String)],MdInput.prototype,"type",void 0),i([s.Input(),o("design:type",String)],MdInput.prototype,"name",void 0),i([s.Output("blur"),o("design:type",p.Observable)],MdInput.prototype,"onBlur",null),i([s.Output("focus"),o("design:type",p.Observable)],MdInput.prototype,"onFocus",null),i([s.Input(),o("design:type",Object)],MdInput.prototype,"value",null),i([s.HostBinding("attr.align"),o("design:type",Object)],MdInput.prototype,"_align",null),i([s.ViewChild("input"),o("design:type",s.ElementRef)],MdInput.prototype,"_inputElement",void 0),MdInput=i([s.Component({selector:"md-input",template:'<div class="md-input-wrapper"> <div class="md-input-table"> <div class="md-input-prefix"><ng-content select="[md-prefix]"></ng-content></div> <div class="md-input-infix"> <input #input aria-target class="md-input-element" [class.md-end]="align == \'end\'" [attr.aria-label]="ariaLabel" [attr.aria-labelledby]="ariaLabelledBy" [attr.aria-disabled]="ariaDisabled" [attr.aria-required]="ariaRequired" [attr.aria-invalid]="ariaInvalid" [attr.autocomplete]="autoComplete" [attr.autocorrect]="autoCorrect" [attr.autocapitalize]="autoCapitalize" [autofocus]="autoFocus" [disabled]="disabled" [id]="inputId" [attr.list]="list" [attr.max]="max" [attr.maxlength]="maxLength" [attr.min]="min" [attr.minlength]="minLength" [readonly]="readOnly" [required]="required" [spellcheck]="spellCheck" [attr.step]="step" [attr.tabindex]="tabIndex" [type]="type" [attr.name]="name" (focus)="_handleFocus($event)" (blur)="_handleBlur($event)" [(ngModel)]="value" (change)="_handleChange($event)"> <label class="md-input-placeholder" [attr.for]="inputId" [class.md-empty]="empty" [class.md-focused]="focused" [class.md-float]="floatingPlaceholder" [class.md-accent]="dividerColor == \'accent\'" [class.md-warn]="dividerColor == \'warn\'" *ngIf="_hasPlaceholder()"> <ng-content select="md-placeholder"></ng-content> {{placeholder}} <span class="md-placeholder-required" *ngIf="required">*</span> </label> </div> <div class="md-input-suffix"><ng-content select="[md-suffix]"></ng-content></div> </div> <div class="md-input-underline" [class.md-disabled]="disabled"> <span class="md-input-ripple" [class.md-focused]="focused" [class.md-accent]="dividerColor == \'accent\'" [class.md-warn]="dividerColor == \'warn\'"></span> </div> <div *ngIf="hintLabel != \'\'" class="md-hint">{{hintLabel}}</div> <ng-content select="md-hint"></ng-content> </div> ',styles:["/** * Mixin that creates a new stacking context. * see https://developer.mozilla.org/en-US/docs/Web/CSS/CSS_Positioning/Understanding_z_index/The_stacking_context */ /** * This mixin hides an element visually. * That means it's still accessible for screen-readers but not visible in view. */ /** * Forces an element to grow to fit floated contents; used as as an alternative to * `overflow: hidden;` because it doesn't cut off contents. */ /** * A mixin, which generates temporary ink ripple on a given component. * When $bindToParent is set to true, it will check for the focused class on the same selector as you included * that mixin. * It is also possible to specify the color palette of the temporary ripple. By default it uses the * accent palette for its background. */ /** * Undo the red box-shadow glow added by Firefox on invalid inputs. * See https://developer.mozilla.org/en-US/docs/Web/CSS/:-moz-ui-invalid */ :-moz-ui-invalid { box-shadow: none; } /** * Applies a floating placeholder above the input itself. */ :host { display: inline-block; position: relative; font-family: Roboto, \"Helvetica Neue\", sans-serif; text-align: left; } :host .md-input-wrapper { margin: 16px 0; } :host .md-input-table { display: inline-table; -ms-flex-flow: column; flex-flow: column; vertical-align: bottom; width: 100%; } :host .md-input-table > * { display: table-cell; } :host .md-input-element { font: inherit; background: transparent; border: none; outline: none; padding: 0; width: 100%; } :host .md-input-element.md-end { text-align: right; } :host .md-input-infix { position: relative; } :host .md-input-placeholder { position: absolute; left: 0; top: 0; font-size: 100%; pointer-events: none; color: rgba(0, 0, 0, 0.38); z-index: 1; width: 100%; display: none; white-space: nowrap; text-overflow: ellipsis; overflow-x: hidden; -webkit-transform: translateY(0); transform: translateY(0); -webkit-transform-origin: bottom left; transform-origin: bottom left; -webkit-transition: scale 400ms cubic-bezier(0.25, 0.8, 0.25, 1), color 400ms cubic-bezier(0.25, 0.8, 0.25, 1), width 400ms cubic-bezier(0.25, 0.8, 0.25, 1), -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: scale 400ms cubic-bezier(0.25, 0.8, 0.25, 1), color 400ms cubic-bezier(0.25, 0.8, 0.25, 1), width 400ms cubic-bezier(0.25, 0.8, 0.25, 1), -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1), scale 400ms cubic-bezier(0.25, 0.8, 0.25, 1), color 400ms cubic-bezier(0.25, 0.8, 0.25, 1), width 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1), scale 400ms cubic-bezier(0.25, 0.8, 0.25, 1), color 400ms cubic-bezier(0.25, 0.8, 0.25, 1), width 400ms cubic-bezier(0.25, 0.8, 0.25, 1), -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); } :host .md-input-placeholder.md-empty { display: block; cursor: text; } :host .md-input-placeholder.md-float:not(.md-empty), :host .md-input-placeholder.md-float.md-focused { display: block; padding-bottom: 5px; -webkit-transform: translateY(-100%) scale(0.75); transform: translateY(-100%) scale(0.75); width: 133.33333%; } :host .md-input-placeholder.md-float:not(.md-empty) .md-placeholder-required, :host .md-input-placeholder.md-float.md-focused .md-placeholder-required { color: #9c27b0; } :host .md-input-placeholder.md-focused { color: #009688; } :host .md-input-placeholder.md-focused.md-accent { color: #9c27b0; } :host .md-input-placeholder.md-focused.md-warn { color: #f44336; } :host input:-webkit-autofill + .md-input-placeholder { display: block; padding-bottom: 5px; -webkit-transform: translateY(-100%) scale(0.75); transform: translateY(-100%) scale(0.75); width: 133.33333%; } :host input:-webkit-autofill + .md-input-placeholder .md-placeholder-required { color: #9c27b0; } :host .md-input-underline { position: absolute; height: 1px; width: 100%; margin-top: 4px; border-top: 1px solid rgba(0, 0, 0, 0.38); } :host .md-input-underline.md-disabled { border-top: 0; background-image: -webkit-linear-gradient(left, rgba(0, 0, 0, 0.26) 0%, rgba(0, 0, 0, 0.26) 33%, transparent 0%); background-image: linear-gradient(to right, rgba(0, 0, 0, 0.26) 0%, rgba(0, 0, 0, 0.26) 33%, transparent 0%); background-position: 0; background-size: 4px 1px; background-repeat: repeat-x; } :host .md-input-underline .md-input-ripple { position: absolute; height: 2px; z-index: 1; background-color: #009688; top: -1px; width: 100%; -webkit-transform-origin: top; transform-origin: top; opacity: 0; -webkit-transform: scaleY(0); transform: scaleY(0); -webkit-transition: opacity 400ms cubic-bezier(0.25, 0.8, 0.25, 1), -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: opacity 400ms cubic-bezier(0.25, 0.8, 0.25, 1), -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1), opacity 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1), opacity 400ms cubic-bezier(0.25, 0.8, 0.25, 1), -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); } :host .md-input-underline .md-input-ripple.md-accent { background-color: #9c27b0; } :host .md-input-underline .md-input-ripple.md-warn { background-color: #f44336; } :host .md-input-underline .md-input-ripple.md-focused { opacity: 1; -webkit-transform: scaleY(1); transform: scaleY(1); } :host .md-hint { position: absolute; font-size: 75%; bottom: -0.5em; } :host .md-hint.md-right { right: 0; } :host-context([dir='rtl']) { text-align: right; } :host-context([dir='rtl']) .md-input-placeholder { -webkit-transform-origin: bottom right; transform-origin: bottom right; } :host-context([dir='rtl']) .md-input-element.md-end { text-align: left; } :host-context([dir='rtl']) .md-hint { right: 0; left: auto; } :host-context([dir='rtl']) .md-hint.md-right { right: auto; left: 0; } "],providers:[t.MD_INPUT_CONTROL_VALUE_ACCESSOR],host:{"(click)":"focus()"}}),o("design:paramtypes",[])],MdInput)}();t.MdInput=_,t.MD_INPUT_DIRECTIVES=[v,_,b];var w=function(){function MdInputModule(){}return MdInputModule=i([s.NgModule({declarations:t.MD_INPUT_DIRECTIVES,imports:[l.CommonModule,a.FormsModule],exports:t.MD_INPUT_DIRECTIVES}),o("design:paramtypes",[])],MdInputModule)}();t.MdInputModule=w},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(635),a=function(){function MdList(){}return MdList=r([o.Component({selector:"md-list, md-nav-list",host:{role:"list"},template:"<ng-content></ng-content>",styles:['/** * This mixin will ensure that lines that overflow the container will * hide the overflow and truncate neatly with an ellipsis. */ /** * This mixin provides all md-line styles, changing secondary font size * based on whether the list is in dense mode. */ /** * This mixin provides base styles for the wrapper around md-line * elements in a list. */ /** * This mixin normalizes default element styles, e.g. font weight for heading text. */ /*  Normal list variables */ /* Dense list variables */ /* This mixin provides all list-item styles, changing font size and height based on whether the list is in dense mode. */ /* This mixin provides all subheader styles, adjusting heights and padding based on whether the list is in dense mode. */ md-list, md-nav-list { padding-top: 8px; display: block; } md-list [md-subheader], md-nav-list [md-subheader] { display: block; box-sizing: border-box; height: 48px; padding: 16px; margin: 0; font-size: 14px; font-weight: 500; color: rgba(0, 0, 0, 0.54); } md-list [md-subheader]:first-child, md-nav-list [md-subheader]:first-child { margin-top: -8px; } md-list md-list-item .md-list-item, md-list a[md-list-item] .md-list-item, md-nav-list md-list-item .md-list-item, md-nav-list a[md-list-item] .md-list-item { display: -webkit-box; display: -ms-flexbox; display: flex; -webkit-box-orient: horizontal; -webkit-box-direction: normal; -ms-flex-direction: row; flex-direction: row; -webkit-box-align: center; -ms-flex-align: center; align-items: center; font-family: Roboto, "Helvetica Neue", sans-serif; box-sizing: border-box; font-size: 16px; height: 48px; padding: 0 16px; color: black; } md-list md-list-item.md-list-avatar .md-list-item, md-list a[md-list-item].md-list-avatar .md-list-item, md-nav-list md-list-item.md-list-avatar .md-list-item, md-nav-list a[md-list-item].md-list-avatar .md-list-item { height: 56px; } md-list md-list-item.md-2-line .md-list-item, md-list a[md-list-item].md-2-line .md-list-item, md-nav-list md-list-item.md-2-line .md-list-item, md-nav-list a[md-list-item].md-2-line .md-list-item { height: 72px; } md-list md-list-item.md-3-line .md-list-item, md-list a[md-list-item].md-3-line .md-list-item, md-nav-list md-list-item.md-3-line .md-list-item, md-nav-list a[md-list-item].md-3-line .md-list-item { height: 88px; } md-list md-list-item .md-list-text, md-list a[md-list-item] .md-list-text, md-nav-list md-list-item .md-list-text, md-nav-list a[md-list-item] .md-list-text { display: -webkit-box; display: -ms-flexbox; display: flex; -webkit-box-orient: vertical; -webkit-box-direction: normal; -ms-flex-direction: column; flex-direction: column; width: 100%; box-sizing: border-box; overflow: hidden; padding: 0 16px; } md-list md-list-item .md-list-text > *, md-list a[md-list-item] .md-list-text > *, md-nav-list md-list-item .md-list-text > *, md-nav-list a[md-list-item] .md-list-text > * { margin: 0; padding: 0; font-weight: normal; font-size: inherit; } md-list md-list-item .md-list-text:empty, md-list a[md-list-item] .md-list-text:empty, md-nav-list md-list-item .md-list-text:empty, md-nav-list a[md-list-item] .md-list-text:empty { display: none; } md-list md-list-item .md-list-text:first-child, md-list a[md-list-item] .md-list-text:first-child, md-nav-list md-list-item .md-list-text:first-child, md-nav-list a[md-list-item] .md-list-text:first-child { padding: 0; } md-list md-list-item [md-list-avatar], md-list a[md-list-item] [md-list-avatar], md-nav-list md-list-item [md-list-avatar], md-nav-list a[md-list-item] [md-list-avatar] { width: 40px; height: 40px; border-radius: 50%; } md-list md-list-item [md-list-icon], md-list a[md-list-item] [md-list-icon], md-nav-list md-list-item [md-list-icon], md-nav-list a[md-list-item] [md-list-icon] { width: 24px; height: 24px; border-radius: 50%; padding: 4px; } md-list md-list-item [md-line], md-list a[md-list-item] [md-line], md-nav-list md-list-item [md-line], md-nav-list a[md-list-item] [md-line] { white-space: nowrap; overflow-x: hidden; text-overflow: ellipsis; display: block; box-sizing: border-box; } md-list md-list-item [md-line]:nth-child(n+2), md-list a[md-list-item] [md-line]:nth-child(n+2), md-nav-list md-list-item [md-line]:nth-child(n+2), md-nav-list a[md-list-item] [md-line]:nth-child(n+2) { font-size: 14px; } md-list[dense], md-nav-list[dense] { padding-top: 4px; display: block; } md-list[dense] [md-subheader], md-nav-list[dense] [md-subheader] { display: block; box-sizing: border-box; height: 40px; padding: 16px; margin: 0; font-size: 13px; font-weight: 500; color: rgba(0, 0, 0, 0.54); } md-list[dense] [md-subheader]:first-child, md-nav-list[dense] [md-subheader]:first-child { margin-top: -4px; } md-list[dense] md-list-item .md-list-item, md-list[dense] a[md-list-item] .md-list-item, md-nav-list[dense] md-list-item .md-list-item, md-nav-list[dense] a[md-list-item] .md-list-item { display: -webkit-box; display: -ms-flexbox; display: flex; -webkit-box-orient: horizontal; -webkit-box-direction: normal; -ms-flex-direction: row; flex-direction: row; -webkit-box-align: center; -ms-flex-align: center; align-items: center; font-family: Roboto, "Helvetica Neue", sans-serif; box-sizing: border-box; font-size: 13px; height: 40px; padding: 0 16px; color: black; } md-list[dense] md-list-item.md-list-avatar .md-list-item, md-list[dense] a[md-list-item].md-list-avatar .md-list-item, md-nav-list[dense] md-list-item.md-list-avatar .md-list-item, md-nav-list[dense] a[md-list-item].md-list-avatar .md-list-item { height: 48px; } md-list[dense] md-list-item.md-2-line .md-list-item, md-list[dense] a[md-list-item].md-2-line .md-list-item, md-nav-list[dense] md-list-item.md-2-line .md-list-item, md-nav-list[dense] a[md-list-item].md-2-line .md-list-item { height: 60px; } md-list[dense] md-list-item.md-3-line .md-list-item, md-list[dense] a[md-list-item].md-3-line .md-list-item, md-nav-list[dense] md-list-item.md-3-line .md-list-item, md-nav-list[dense] a[md-list-item].md-3-line .md-list-item { height: 76px; } md-list[dense] md-list-item .md-list-text, md-list[dense] a[md-list-item] .md-list-text, md-nav-list[dense] md-list-item .md-list-text, md-nav-list[dense] a[md-list-item] .md-list-text { display: -webkit-box; display: -ms-flexbox; display: flex; -webkit-box-orient: vertical; -webkit-box-direction: normal; -ms-flex-direction: column; flex-direction: column; width: 100%; box-sizing: border-box; overflow: hidden; padding: 0 16px; } md-list[dense] md-list-item .md-list-text > *, md-list[dense] a[md-list-item] .md-list-text > *, md-nav-list[dense] md-list-item .md-list-text > *, md-nav-list[dense] a[md-list-item] .md-list-text > * { margin: 0; padding: 0; font-weight: normal; font-size: inherit; } md-list[dense] md-list-item .md-list-text:empty, md-list[dense] a[md-list-item] .md-list-text:empty, md-nav-list[dense] md-list-item .md-list-text:empty, md-nav-list[dense] a[md-list-item] .md-list-text:empty { display: none; } md-list[dense] md-list-item .md-list-text:first-child, md-list[dense] a[md-list-item] .md-list-text:first-child, md-nav-list[dense] md-list-item .md-list-text:first-child, md-nav-list[dense] a[md-list-item] .md-list-text:first-child { padding: 0; } md-list[dense] md-list-item [md-list-avatar], md-list[dense] a[md-list-item] [md-list-avatar], md-nav-list[dense] md-list-item [md-list-avatar], md-nav-list[dense] a[md-list-item] [md-list-avatar] { width: 40px; height: 40px; border-radius: 50%; } md-list[dense] md-list-item [md-list-icon], md-list[dense] a[md-list-item] [md-list-icon], md-nav-list[dense] md-list-item [md-list-icon], md-nav-list[dense] a[md-list-item] [md-list-icon] { width: 24px; height: 24px; border-radius: 50%; padding: 4px; } md-list[dense] md-list-item [md-line], md-list[dense] a[md-list-item] [md-line], md-nav-list[dense] md-list-item [md-line], md-nav-list[dense] a[md-list-item] [md-line] { white-space: nowrap; overflow-x: hidden; text-overflow: ellipsis; display: block; box-sizing: border-box; } md-list[dense] md-list-item [md-line]:nth-child(n+2), md-list[dense] a[md-list-item] [md-line]:nth-child(n+2), md-nav-list[dense] md-list-item [md-line]:nth-child(n+2), md-nav-list[dense] a[md-list-item] [md-line]:nth-child(n+2) { font-size: 13px; } md-divider { display: block; border-top: 1px solid rgba(0, 0, 0, 0.12); margin: 0; } md-nav-list a { text-decoration: none; color: inherit; } md-nav-list .md-list-item { cursor: pointer; } md-nav-list .md-list-item:hover, md-nav-list .md-list-item.md-list-item-focus { outline: none; background: rgba(0, 0, 0, 0.04); } '],
 encapsulation:o.ViewEncapsulation.None}),i("design:paramtypes",[])],MdList)}();t.MdList=a;var l=function(){function MdListAvatar(){}return MdListAvatar=r([o.Directive({selector:"[md-list-avatar]"}),i("design:paramtypes",[])],MdListAvatar)}();t.MdListAvatar=l;var c=function(){function MdListItem(e,t){this._renderer=e,this._element=t,this._hasFocus=!1}return Object.defineProperty(MdListItem.prototype,"_hasAvatar",{set:function(e){this._renderer.setElementClass(this._element.nativeElement,"md-list-avatar",null!=e)},enumerable:!0,configurable:!0}),MdListItem.prototype.ngAfterContentInit=function(){this._lineSetter=new s.MdLineSetter(this._lines,this._renderer,this._element)},MdListItem.prototype._handleFocus=function(){this._hasFocus=!0},MdListItem.prototype._handleBlur=function(){this._hasFocus=!1},r([o.ContentChildren(s.MdLine),i("design:type",o.QueryList)],MdListItem.prototype,"_lines",void 0),r([o.ContentChild(l),i("design:type",l),i("design:paramtypes",[l])],MdListItem.prototype,"_hasAvatar",null),MdListItem=r([o.Component({selector:"md-list-item, a[md-list-item]",host:{role:"listitem","(focus)":"_handleFocus()","(blur)":"_handleBlur()"},template:'<div class="md-list-item" [class.md-list-item-focus]="_hasFocus"> <ng-content select="[md-list-avatar],[md-list-icon]"></ng-content> <div class="md-list-text"><ng-content select="[md-line]"></ng-content></div> <ng-content></ng-content> </div> ',encapsulation:o.ViewEncapsulation.None}),i("design:paramtypes",[o.Renderer,o.ElementRef])],MdListItem)}();t.MdListItem=c,t.MD_LIST_DIRECTIVES=[a,c,l];var u=function(){function MdListModule(){}return MdListModule=r([o.NgModule({imports:[s.MdLineModule],exports:[t.MD_LIST_DIRECTIVES,s.MdLineModule],declarations:t.MD_LIST_DIRECTIVES}),i("design:paramtypes",[])],MdListModule)}();t.MdListModule=u},function(e,t,n){"use strict";function clamp(e,t,n){return void 0===t&&(t=0),void 0===n&&(n=100),Math.max(t,Math.min(n,e))}var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=function(){function MdProgressBar(){this._value=0,this._bufferValue=0,this.mode="determinate"}return Object.defineProperty(MdProgressBar.prototype,"value",{get:function(){return this._value},set:function(e){this._value=clamp(e||0)},enumerable:!0,configurable:!0}),Object.defineProperty(MdProgressBar.prototype,"bufferValue",{get:function(){return this._bufferValue},set:function(e){this._bufferValue=clamp(e||0)},enumerable:!0,configurable:!0}),MdProgressBar.prototype._primaryTransform=function(){var e=this.value/100;return{transform:"scaleX("+e+")"}},MdProgressBar.prototype._bufferTransform=function(){if("buffer"==this.mode){var e=this.bufferValue/100;return{transform:"scaleX("+e+")"}}},r([o.Input(),o.HostBinding("attr.aria-valuenow"),i("design:type",Object)],MdProgressBar.prototype,"value",null),r([o.Input(),i("design:type",Object)],MdProgressBar.prototype,"bufferValue",null),r([o.Input(),o.HostBinding("attr.mode"),i("design:type",Object)],MdProgressBar.prototype,"mode",void 0),MdProgressBar=r([o.Component({selector:"md-progress-bar",host:{role:"progressbar","aria-valuemin":"0","aria-valuemax":"100"},template:'<!-- The background div is named as such because it appears below the other divs and is not sized based on values. --> <div class="md-progress-bar-background"></div> <div class="md-progress-bar-buffer" [ngStyle]="_bufferTransform()"></div> <div class="md-progress-bar-primary md-progress-bar-fill" [ngStyle]="_primaryTransform()"></div> <div class="md-progress-bar-secondary md-progress-bar-fill"></div> ',styles:["/** In buffer mode a repeated SVG object is used as a background.  Each of the following defines the SVG object for each of the class defined colors. Each string is a URL encoded version of: <svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" version=\"1.1\" x=\"0px\" y=\"0px\" enable-background=\"new 0 0 5 2\" xml:space=\"preserve\" viewBox=\"0 0 5 2\" preserveAspectRatio=\"none slice\"> <circle cx=\"1\" cy=\"1\" r=\"1\" fill=\"{INJECTED_COLOR}\"/> </svg> */ :host { display: block; height: 5px; overflow: hidden; position: relative; -webkit-transform: translateZ(0); transform: translateZ(0); -webkit-transition: opacity 250ms linear; transition: opacity 250ms linear; width: 100%; /** * The progress bar buffer is the bar indicator showing the buffer value and is only visible beyond the current value * of the primary progress bar. */ /** * The secondary progress bar is only used in the indeterminate animation, because of this it is hidden in other uses. */ /** * The progress bar fill fills the progress bar with the indicator color. */ /** * A pseudo element is created for each progress bar bar that fills with the indicator color. */ } :host .md-progress-bar-background { background: url(\"data:image/svg+xml;charset=UTF-8,%3Csvg%20version%3D%271.1%27%20xmlns%3D%27http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%27%20xmlns%3Axlink%3D%27http%3A%2F%2Fwww.w3.org%2F1999%2Fxlink%27%20x%3D%270px%27%20y%3D%270px%27%20enable-background%3D%27new%200%200%205%202%27%20xml%3Aspace%3D%27preserve%27%20viewBox%3D%270%200%205%202%27%20preserveAspectRatio%3D%27none%20slice%27%3E%3Ccircle%20cx%3D%271%27%20cy%3D%271%27%20r%3D%271%27%20fill%3D%27#b2dfdb%27%2F%3E%3C%2Fsvg%3E\"); background-repeat: repeat-x; background-size: 10px 4px; height: 100%; position: absolute; visibility: hidden; width: 100%; } :host .md-progress-bar-buffer { background-color: #b2dfdb; height: 100%; position: absolute; -webkit-transform-origin: top left; transform-origin: top left; -webkit-transition: -webkit-transform 250ms ease; transition: -webkit-transform 250ms ease; transition: transform 250ms ease; transition: transform 250ms ease, -webkit-transform 250ms ease; width: 100%; } :host .md-progress-bar-secondary { visibility: hidden; } :host .md-progress-bar-fill { -webkit-animation: none; animation: none; height: 100%; position: absolute; -webkit-transform-origin: top left; transform-origin: top left; -webkit-transition: -webkit-transform 250ms ease; transition: -webkit-transform 250ms ease; transition: transform 250ms ease; transition: transform 250ms ease, -webkit-transform 250ms ease; width: 100%; } :host .md-progress-bar-fill::after { -webkit-animation: none; animation: none; background-color: #00897b; content: ''; display: inline-block; height: 100%; position: absolute; width: 100%; } :host[color='accent'] .md-progress-bar-background { background: url(\"data:image/svg+xml;charset=UTF-8,%3Csvg%20version%3D%271.1%27%20xmlns%3D%27http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%27%20xmlns%3Axlink%3D%27http%3A%2F%2Fwww.w3.org%2F1999%2Fxlink%27%20x%3D%270px%27%20y%3D%270px%27%20enable-background%3D%27new%200%200%205%202%27%20xml%3Aspace%3D%27preserve%27%20viewBox%3D%270%200%205%202%27%20preserveAspectRatio%3D%27none%20slice%27%3E%3Ccircle%20cx%3D%271%27%20cy%3D%271%27%20r%3D%271%27%20fill%3D%27#e1bee7%27%2F%3E%3C%2Fsvg%3E\"); background-repeat: repeat-x; background-size: 10px 4px; } :host[color='accent'] .md-progress-bar-buffer { background-color: #e1bee7; } :host[color='accent'] .md-progress-bar-fill::after { background-color: #8e24aa; } :host[color='warn'] .md-progress-bar-background { background: url(\"data:image/svg+xml;charset=UTF-8,%3Csvg%20version%3D%271.1%27%20xmlns%3D%27http%3A%2F%2Fwww.w3.org%2F2000%2Fsvg%27%20xmlns%3Axlink%3D%27http%3A%2F%2Fwww.w3.org%2F1999%2Fxlink%27%20x%3D%270px%27%20y%3D%270px%27%20enable-background%3D%27new%200%200%205%202%27%20xml%3Aspace%3D%27preserve%27%20viewBox%3D%270%200%205%202%27%20preserveAspectRatio%3D%27none%20slice%27%3E%3Ccircle%20cx%3D%271%27%20cy%3D%271%27%20r%3D%271%27%20fill%3D%27#ffcdd2%27%2F%3E%3C%2Fsvg%3E\"); background-repeat: repeat-x; background-size: 10px 4px; } :host[color='warn'] .md-progress-bar-buffer { background-color: #ffcdd2; } :host[color='warn'] .md-progress-bar-fill::after { background-color: #e53935; } :host[mode='query'] { -webkit-transform: rotateZ(180deg); transform: rotateZ(180deg); } :host[mode='indeterminate'] .md-progress-bar-fill, :host[mode='query'] .md-progress-bar-fill { -webkit-transition: none; transition: none; } :host[mode='indeterminate'] .md-progress-bar-primary, :host[mode='query'] .md-progress-bar-primary { -webkit-animation: md-progress-bar-primary-indeterminate-translate 2000ms infinite linear; animation: md-progress-bar-primary-indeterminate-translate 2000ms infinite linear; left: -145.166611%; } :host[mode='indeterminate'] .md-progress-bar-primary.md-progress-bar-fill::after, :host[mode='query'] .md-progress-bar-primary.md-progress-bar-fill::after { -webkit-animation: md-progress-bar-primary-indeterminate-scale 2000ms infinite linear; animation: md-progress-bar-primary-indeterminate-scale 2000ms infinite linear; } :host[mode='indeterminate'] .md-progress-bar-secondary, :host[mode='query'] .md-progress-bar-secondary { -webkit-animation: md-progress-bar-secondary-indeterminate-translate 2000ms infinite linear; animation: md-progress-bar-secondary-indeterminate-translate 2000ms infinite linear; left: -54.888891%; visibility: visible; } :host[mode='indeterminate'] .md-progress-bar-secondary.md-progress-bar-fill::after, :host[mode='query'] .md-progress-bar-secondary.md-progress-bar-fill::after { -webkit-animation: md-progress-bar-secondary-indeterminate-scale 2000ms infinite linear; animation: md-progress-bar-secondary-indeterminate-scale 2000ms infinite linear; } :host[mode='buffer'] .md-progress-bar-background { -webkit-animation: md-progress-bar-background-scroll 250ms infinite linear; animation: md-progress-bar-background-scroll 250ms infinite linear; visibility: visible; } :host-context([dir='rtl']) { -webkit-transform: rotateY(180deg); transform: rotateY(180deg); } /** The values used for animations in md-progress-bar, both timing and transformation, can be considered magic values. They are sourced from the Material Design example spec and duplicate the values of the original designers definitions. The indeterminate state is essentially made up of two progress bars, one primary (the one that is shown in both the determinate and indeterminate states) and one secondary, which essentially mirrors the primary progress bar in appearance but is only shown to assist with the indeterminate animations. KEYFRAME BLOCK\t                    DESCRIPTION primary-indeterminate-translate     Translation of the primary progressbar across the screen primary-indeterminate-scale         Scaling of the primary progressbar as it's being translated across the screen secondary-indeterminate-translate   Translation of the secondary progressbar across the screen secondary-indeterminate-scale       Scaling of the secondary progressbar as it's being translated across the screen Because two different transform animations need to be applied at once, the translation is applied to the outer element and the scaling is applied to the inner element, which provides the illusion necessary to make the animation work. */ /** Animations for indeterminate and query mode. */ @-webkit-keyframes md-progress-bar-primary-indeterminate-translate { 0% { -webkit-transform: translateX(0); transform: translateX(0); } 20% { -webkit-animation-timing-function: cubic-bezier(0.5, 0, 0.70173, 0.49582); animation-timing-function: cubic-bezier(0.5, 0, 0.70173, 0.49582); -webkit-transform: translateX(0); transform: translateX(0); } 59.15% { -webkit-animation-timing-function: cubic-bezier(0.30244, 0.38135, 0.55, 0.95635); animation-timing-function: cubic-bezier(0.30244, 0.38135, 0.55, 0.95635); -webkit-transform: translateX(83.67142%); transform: translateX(83.67142%); } 100% { -webkit-transform: translateX(200.61106%); transform: translateX(200.61106%); } } @keyframes md-progress-bar-primary-indeterminate-translate { 0% { -webkit-transform: translateX(0); transform: translateX(0); } 20% { -webkit-animation-timing-function: cubic-bezier(0.5, 0, 0.70173, 0.49582); animation-timing-function: cubic-bezier(0.5, 0, 0.70173, 0.49582); -webkit-transform: translateX(0); transform: translateX(0); } 59.15% { -webkit-animation-timing-function: cubic-bezier(0.30244, 0.38135, 0.55, 0.95635); animation-timing-function: cubic-bezier(0.30244, 0.38135, 0.55, 0.95635); -webkit-transform: translateX(83.67142%); transform: translateX(83.67142%); } 100% { -webkit-transform: translateX(200.61106%); transform: translateX(200.61106%); } } @-webkit-keyframes md-progress-bar-primary-indeterminate-scale { 0% { -webkit-transform: scaleX(0.08); transform: scaleX(0.08); } 36.65% { -webkit-animation-timing-function: cubic-bezier(0.33473, 0.12482, 0.78584, 1); animation-timing-function: cubic-bezier(0.33473, 0.12482, 0.78584, 1); -webkit-transform: scaleX(0.08); transform: scaleX(0.08); } 69.15% { -webkit-animation-timing-function: cubic-bezier(0.06, 0.11, 0.6, 1); animation-timing-function: cubic-bezier(0.06, 0.11, 0.6, 1); -webkit-transform: scaleX(0.66148); transform: scaleX(0.66148); } 100% { -webkit-transform: scaleX(0.08); transform: scaleX(0.08); } } @keyframes md-progress-bar-primary-indeterminate-scale { 0% { -webkit-transform: scaleX(0.08); transform: scaleX(0.08); } 36.65% { -webkit-animation-timing-function: cubic-bezier(0.33473, 0.12482, 0.78584, 1); animation-timing-function: cubic-bezier(0.33473, 0.12482, 0.78584, 1); -webkit-transform: scaleX(0.08); transform: scaleX(0.08); } 69.15% { -webkit-animation-timing-function: cubic-bezier(0.06, 0.11, 0.6, 1); animation-timing-function: cubic-bezier(0.06, 0.11, 0.6, 1); -webkit-transform: scaleX(0.66148); transform: scaleX(0.66148); } 100% { -webkit-transform: scaleX(0.08); transform: scaleX(0.08); } } @-webkit-keyframes md-progress-bar-secondary-indeterminate-translate { 0% { -webkit-animation-timing-function: cubic-bezier(0.15, 0, 0.51506, 0.40969); animation-timing-function: cubic-bezier(0.15, 0, 0.51506, 0.40969); -webkit-transform: translateX(0); transform: translateX(0); } 25% { -webkit-animation-timing-function: cubic-bezier(0.31033, 0.28406, 0.8, 0.73371); animation-timing-function: cubic-bezier(0.31033, 0.28406, 0.8, 0.73371); -webkit-transform: translateX(37.65191%); transform: translateX(37.65191%); } 48.35% { -webkit-animation-timing-function: cubic-bezier(0.4, 0.62704, 0.6, 0.90203); animation-timing-function: cubic-bezier(0.4, 0.62704, 0.6, 0.90203); -webkit-transform: translateX(84.38617%); transform: translateX(84.38617%); } 100% { -webkit-transform: translateX(160.27778%); transform: translateX(160.27778%); } } @keyframes md-progress-bar-secondary-indeterminate-translate { 0% { -webkit-animation-timing-function: cubic-bezier(0.15, 0, 0.51506, 0.40969); animation-timing-function: cubic-bezier(0.15, 0, 0.51506, 0.40969); -webkit-transform: translateX(0); transform: translateX(0); } 25% { -webkit-animation-timing-function: cubic-bezier(0.31033, 0.28406, 0.8, 0.73371); animation-timing-function: cubic-bezier(0.31033, 0.28406, 0.8, 0.73371); -webkit-transform: translateX(37.65191%); transform: translateX(37.65191%); } 48.35% { -webkit-animation-timing-function: cubic-bezier(0.4, 0.62704, 0.6, 0.90203); animation-timing-function: cubic-bezier(0.4, 0.62704, 0.6, 0.90203); -webkit-transform: translateX(84.38617%); transform: translateX(84.38617%); } 100% { -webkit-transform: translateX(160.27778%); transform: translateX(160.27778%); } } @-webkit-keyframes md-progress-bar-secondary-indeterminate-scale { 0% { -webkit-animation-timing-function: cubic-bezier(0.15, 0, 0.51506, 0.40969); animation-timing-function: cubic-bezier(0.15, 0, 0.51506, 0.40969); -webkit-transform: scaleX(0.08); transform: scaleX(0.08); } 19.15% { -webkit-animation-timing-function: cubic-bezier(0.31033, 0.28406, 0.8, 0.73371); animation-timing-function: cubic-bezier(0.31033, 0.28406, 0.8, 0.73371); -webkit-transform: scaleX(0.4571); transform: scaleX(0.4571); } 44.15% { -webkit-animation-timing-function: cubic-bezier(0.4, 0.62704, 0.6, 0.90203); animation-timing-function: cubic-bezier(0.4, 0.62704, 0.6, 0.90203); -webkit-transform: scaleX(0.72796); transform: scaleX(0.72796); } 100% { -webkit-transform: scaleX(0.08); transform: scaleX(0.08); } } @keyframes md-progress-bar-secondary-indeterminate-scale { 0% { -webkit-animation-timing-function: cubic-bezier(0.15, 0, 0.51506, 0.40969); animation-timing-function: cubic-bezier(0.15, 0, 0.51506, 0.40969); -webkit-transform: scaleX(0.08); transform: scaleX(0.08); } 19.15% { -webkit-animation-timing-function: cubic-bezier(0.31033, 0.28406, 0.8, 0.73371); animation-timing-function: cubic-bezier(0.31033, 0.28406, 0.8, 0.73371); -webkit-transform: scaleX(0.4571); transform: scaleX(0.4571); } 44.15% { -webkit-animation-timing-function: cubic-bezier(0.4, 0.62704, 0.6, 0.90203); animation-timing-function: cubic-bezier(0.4, 0.62704, 0.6, 0.90203); -webkit-transform: scaleX(0.72796); transform: scaleX(0.72796); } 100% { -webkit-transform: scaleX(0.08); transform: scaleX(0.08); } } /** Animation for buffer mode. */ @-webkit-keyframes md-progress-bar-background-scroll { to { -webkit-transform: translateX(-10px); transform: translateX(-10px); } } @keyframes md-progress-bar-background-scroll { to { -webkit-transform: translateX(-10px); transform: translateX(-10px); } } "],changeDetection:o.ChangeDetectionStrategy.OnPush}),i("design:paramtypes",[])],MdProgressBar)}();t.MdProgressBar=a,t.MD_PROGRESS_BAR_DIRECTIVES=[a];var l=function(){function MdProgressBarModule(){}return MdProgressBarModule=r([o.NgModule({imports:[s.CommonModule],exports:t.MD_PROGRESS_BAR_DIRECTIVES,declarations:t.MD_PROGRESS_BAR_DIRECTIVES}),i("design:paramtypes",[])],MdProgressBarModule)}();t.MdProgressBarModule=l},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},o=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},s=this&&this.__param||function(e,t){return function(n,r){t(n,r,e)}},a=n(0),l=n(3),c=n(637),u=n(634),p=n(188),d=n(282),h=function(e){function MdDuplicatedSidenavError(t){e.call(this,"A sidenav was already declared for 'align=\""+t+"\"'")}return r(MdDuplicatedSidenavError,e),MdDuplicatedSidenavError}(p.MdError);t.MdDuplicatedSidenavError=h;var f=function(){function MdSidenav(e){this._elementRef=e,this.align="start",this.mode="over",this._opened=!1,this.onOpenStart=new a.EventEmitter,this.onOpen=new a.EventEmitter,this.onCloseStart=new a.EventEmitter,this.onClose=new a.EventEmitter,this._transition=!1}return Object.defineProperty(MdSidenav.prototype,"opened",{get:function(){return this._opened},set:function(e){this.toggle(e)},enumerable:!0,configurable:!0}),MdSidenav.prototype.open=function(){return this.toggle(!0)},MdSidenav.prototype.close=function(){return this.toggle(!1)},MdSidenav.prototype.toggle=function(e){if(void 0===e&&(e=!this.opened),e===this.opened)return this._transition?e?this._openPromise:this._closePromise:Promise.resolve(null);if(this._opened=e,this._transition=!0,e?this.onOpenStart.emit(null):this.onCloseStart.emit(null),e){if(null==this._openPromise){var t=new u.PromiseCompleter;this._openPromise=t.promise,this._openPromiseReject=t.reject,this._openPromiseResolve=t.resolve}return this._openPromise}if(null==this._closePromise){var t=new u.PromiseCompleter;this._closePromise=t.promise,this._closePromiseReject=t.reject,this._closePromiseResolve=t.resolve}return this._closePromise},MdSidenav.prototype._onTransitionEnd=function(e){e.target==this._elementRef.nativeElement&&e.propertyName.endsWith("transform")&&(this._transition=!1,this._opened?(null!=this._openPromise&&this._openPromiseResolve(),null!=this._closePromise&&this._closePromiseReject(),this.onOpen.emit(null)):(null!=this._closePromise&&this._closePromiseResolve(),null!=this._openPromise&&this._openPromiseReject(),this.onClose.emit(null)),this._openPromise=null,this._closePromise=null)},Object.defineProperty(MdSidenav.prototype,"_isClosing",{get:function(){return!this._opened&&this._transition},enumerable:!0,configurable:!0}),Object.defineProperty(MdSidenav.prototype,"_isOpening",{get:function(){return this._opened&&this._transition},enumerable:!0,configurable:!0}),Object.defineProperty(MdSidenav.prototype,"_isClosed",{get:function(){return!this._opened&&!this._transition},enumerable:!0,configurable:!0}),Object.defineProperty(MdSidenav.prototype,"_isOpened",{get:function(){return this._opened&&!this._transition},enumerable:!0,configurable:!0}),Object.defineProperty(MdSidenav.prototype,"_isEnd",{get:function(){return"end"==this.align},enumerable:!0,configurable:!0}),Object.defineProperty(MdSidenav.prototype,"_modeSide",{get:function(){return"side"==this.mode},enumerable:!0,configurable:!0}),Object.defineProperty(MdSidenav.prototype,"_modeOver",{get:function(){return"over"==this.mode},enumerable:!0,configurable:!0}),Object.defineProperty(MdSidenav.prototype,"_modePush",{get:function(){return"push"==this.mode},enumerable:!0,configurable:!0}),Object.defineProperty(MdSidenav.prototype,"_width",{get:function(){return this._elementRef.nativeElement?this._elementRef.nativeElement.offsetWidth:0},enumerable:!0,configurable:!0}),i([a.Input(),o("design:type",Object)],MdSidenav.prototype,"align",void 0),i([a.Input(),o("design:type",Object)],MdSidenav.prototype,"mode",void 0),i([a.Input("opened"),d.BooleanFieldValue(),o("design:type",Boolean)],MdSidenav.prototype,"_opened",void 0),i([a.Output("open-start"),o("design:type",Object)],MdSidenav.prototype,"onOpenStart",void 0),i([a.Output("open"),o("design:type",Object)],MdSidenav.prototype,"onOpen",void 0),i([a.Output("close-start"),o("design:type",Object)],MdSidenav.prototype,"onCloseStart",void 0),i([a.Output("close"),o("design:type",Object)],MdSidenav.prototype,"onClose",void 0),i([a.HostBinding("class.md-sidenav-closing"),o("design:type",Object)],MdSidenav.prototype,"_isClosing",null),i([a.HostBinding("class.md-sidenav-opening"),o("design:type",Object)],MdSidenav.prototype,"_isOpening",null),i([a.HostBinding("class.md-sidenav-closed"),o("design:type",Object)],MdSidenav.prototype,"_isClosed",null),i([a.HostBinding("class.md-sidenav-opened"),o("design:type",Object)],MdSidenav.prototype,"_isOpened",null),i([a.HostBinding("class.md-sidenav-end"),o("design:type",Object)],MdSidenav.prototype,"_isEnd",null),i([a.HostBinding("class.md-sidenav-side"),o("design:type",Object)],MdSidenav.prototype,"_modeSide",null),i([a.HostBinding("class.md-sidenav-over"),o("design:type",Object)],MdSidenav.prototype,"_modeOver",null),i([a.HostBinding("class.md-sidenav-push"),o("design:type",Object)],MdSidenav.prototype,"_modePush",null),MdSidenav=i([a.Component({selector:"md-sidenav",template:"<ng-content></ng-content>",host:{"(transitionend)":"_onTransitionEnd($event)"},changeDetection:a.ChangeDetectionStrategy.OnPush}),o("design:paramtypes",[a.ElementRef])],MdSidenav)}();t.MdSidenav=f;var m=function(){function MdSidenavLayout(e,t,n){var r=this;this._dir=e,this._element=t,this._renderer=n,null!=e&&e.dirChange.subscribe(function(){return r._validateDrawers()})}return Object.defineProperty(MdSidenavLayout.prototype,"start",{get:function(){return this._start},enumerable:!0,configurable:!0}),Object.defineProperty(MdSidenavLayout.prototype,"end",{get:function(){return this._end},enumerable:!0,configurable:!0}),MdSidenavLayout.prototype.ngAfterContentInit=function(){var e=this;this._sidenavs.changes.subscribe(function(){return e._validateDrawers()}),this._sidenavs.forEach(function(t){return e._watchSidenavToggle(t)}),this._validateDrawers()},MdSidenavLayout.prototype._watchSidenavToggle=function(e){var t=this;e&&"side"!==e.mode&&(e.onOpen.subscribe(function(){return t._setLayoutClass(e,!0)}),e.onClose.subscribe(function(){return t._setLayoutClass(e,!1)}))},MdSidenavLayout.prototype._setLayoutClass=function(e,t){this._renderer.setElementClass(this._element.nativeElement,"md-sidenav-opened",t)},MdSidenavLayout.prototype._validateDrawers=function(){var e=this;this._start=this._end=null,this._sidenavs.forEach(function(t){if("end"==t.align){if(null!=e._end)throw new h("end");e._end=t}else{if(null!=e._start)throw new h("start");e._start=t}}),this._right=this._left=null,null==this._dir||"ltr"==this._dir.value?(this._left=this._start,this._right=this._end):(this._left=this._end,this._right=this._start)},MdSidenavLayout.prototype._closeModalSidenav=function(){null!=this._start&&"side"!=this._start.mode&&this._start.close(),null!=this._end&&"side"!=this._end.mode&&this._end.close()},MdSidenavLayout.prototype._isShowingBackdrop=function(){return this._isSidenavOpen(this._start)&&"side"!=this._start.mode||this._isSidenavOpen(this._end)&&"side"!=this._end.mode},MdSidenavLayout.prototype._isSidenavOpen=function(e){return null!=e&&e.opened},MdSidenavLayout.prototype._getSidenavEffectiveWidth=function(e,t){return this._isSidenavOpen(e)&&e.mode==t?e._width:0},MdSidenavLayout.prototype._getMarginLeft=function(){return this._getSidenavEffectiveWidth(this._left,"side")},MdSidenavLayout.prototype._getMarginRight=function(){return this._getSidenavEffectiveWidth(this._right,"side")},MdSidenavLayout.prototype._getPositionLeft=function(){return this._getSidenavEffectiveWidth(this._left,"push")},MdSidenavLayout.prototype._getPositionRight=function(){return this._getSidenavEffectiveWidth(this._right,"push")},MdSidenavLayout.prototype._getPositionOffset=function(){return this._getPositionLeft()-this._getPositionRight()},MdSidenavLayout.prototype._getStyles=function(){return{marginLeft:this._getMarginLeft()+"px",marginRight:this._getMarginRight()+"px",transform:"translate3d("+this._getPositionOffset()+"px, 0, 0)"}},i([a.ContentChildren(f),o("design:type",a.QueryList)],MdSidenavLayout.prototype,"_sidenavs",void 0),MdSidenavLayout=i([a.Component({selector:"md-sidenav-layout",template:'<div class="md-sidenav-backdrop" (click)="_closeModalSidenav()" [class.md-sidenav-shown]="_isShowingBackdrop()"></div> <ng-content select="md-sidenav"></ng-content> <md-content [ngStyle]="_getStyles()"> <ng-content></ng-content> </md-content> ',styles:["/** * Mixin that creates a new stacking context. * see https://developer.mozilla.org/en-US/docs/Web/CSS/CSS_Positioning/Understanding_z_index/The_stacking_context */ /** * This mixin hides an element visually. * That means it's still accessible for screen-readers but not visible in view. */ /** * Forces an element to grow to fit floated contents; used as as an alternative to * `overflow: hidden;` because it doesn't cut off contents. */ /** * A mixin, which generates temporary ink ripple on a given component. * When $bindToParent is set to true, it will check for the focused class on the same selector as you included * that mixin. * It is also possible to specify the color palette of the temporary ripple. By default it uses the * accent palette for its background. */ /** * A collection of mixins and CSS classes that can be used to apply elevation to a material * element. * See: https://www.google.com/design/spec/what-is-material/elevation-shadows.html * Examples: * * * .md-foo { *   @include $md-elevation(2); * *   &:active { *     @include $md-elevation(8); *   } * } * * <div id=\"external-card\" class=\"md-elevation-z2\"><p>Some content</p></div> * * For an explanation of the design behind how elevation is implemented, see the design doc at * https://goo.gl/Kq0k9Z. */ /** * The css property used for elevation. In most cases this should not be changed. It is exposed * as a variable for abstraction / easy use when needing to reference the property directly, for * example in a will-change rule. */ /** The default duration value for elevation transitions. */ /** The default easing value for elevation transitions. */ /** * Applies the correct css rules to an element to give it the elevation specified by $zValue. * The $zValue must be between 0 and 24. */ /** * Returns a string that can be used as the value for a transition property for elevation. * Calling this function directly is useful in situations where a component needs to transition * more than one property. * * .foo { *   transition: md-elevation-transition-property-value(), opacity 100ms ease; *   will-change: $md-elevation-property, opacity; * } */ /** * Applies the correct css rules needed to have an element transition between elevations. * This mixin should be applied to elements whose elevation values will change depending on their * context (e.g. when active or disabled). */ /* This mixin ensures an element spans the whole viewport.*/ /** * Mixin to help with defining LTR/RTL 'transform: translate3d()' values. * @param $open The translation value when the sidenav is opened. * @param $close The translation value when the sidenav is closed. */ :host { position: relative; -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); box-sizing: border-box; -webkit-overflow-scrolling: touch; display: block; overflow: hidden; } :host[fullscreen] { position: fixed; top: 0; left: 0; right: 0; bottom: 0; } :host[fullscreen].md-sidenav-opened { overflow: hidden; } :host > .md-sidenav-backdrop { position: fixed; top: 0; left: 0; right: 0; bottom: 0; display: block; z-index: 2; visibility: hidden; } :host > .md-sidenav-backdrop.md-sidenav-shown { visibility: visible; background-color: rgba(0, 0, 0, 0.6); } :host > md-content { position: relative; -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); display: block; height: 100%; overflow: auto; } :host > md-sidenav { position: relative; -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); display: block; position: absolute; top: 0; bottom: 0; z-index: 3; min-width: 5%; overflow-y: auto; background-color: white; -webkit-transform: translate3d(-100%, 0, 0); transform: translate3d(-100%, 0, 0); } :host > md-sidenav.md-sidenav-closed { visibility: hidden; } :host > md-sidenav.md-sidenav-closing { -webkit-transform: translate3d(-100%, 0, 0); transform: translate3d(-100%, 0, 0); will-change: transform; } :host > md-sidenav.md-sidenav-opening { box-shadow: 0px 2px 1px -1px rgba(0, 0, 0, 0.2), 0px 1px 1px 0px rgba(0, 0, 0, 0.14), 0px 1px 3px 0px rgba(0, 0, 0, 0.12); visibility: visible; -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); will-change: transform; } :host > md-sidenav.md-sidenav-opened { box-shadow: 0px 2px 1px -1px rgba(0, 0, 0, 0.2), 0px 1px 1px 0px rgba(0, 0, 0, 0.14), 0px 1px 3px 0px rgba(0, 0, 0, 0.12); -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); } :host > md-sidenav.md-sidenav-push { background-color: white; } :host > md-sidenav.md-sidenav-side { z-index: 1; } :host > md-sidenav.md-sidenav-end { right: 0; -webkit-transform: translate3d(100%, 0, 0); transform: translate3d(100%, 0, 0); } :host > md-sidenav.md-sidenav-end.md-sidenav-closed { visibility: hidden; } :host > md-sidenav.md-sidenav-end.md-sidenav-closing { -webkit-transform: translate3d(100%, 0, 0); transform: translate3d(100%, 0, 0); will-change: transform; } :host > md-sidenav.md-sidenav-end.md-sidenav-opening { box-shadow: 0px 2px 1px -1px rgba(0, 0, 0, 0.2), 0px 1px 1px 0px rgba(0, 0, 0, 0.14), 0px 1px 3px 0px rgba(0, 0, 0, 0.12); visibility: visible; -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); will-change: transform; } :host > md-sidenav.md-sidenav-end.md-sidenav-opened { box-shadow: 0px 2px 1px -1px rgba(0, 0, 0, 0.2), 0px 1px 1px 0px rgba(0, 0, 0, 0.14), 0px 1px 3px 0px rgba(0, 0, 0, 0.12); -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); } :host-context([dir='rtl']) > md-sidenav { -webkit-transform: translate3d(100%, 0, 0); transform: translate3d(100%, 0, 0); } :host-context([dir='rtl']) > md-sidenav.md-sidenav-closed { visibility: hidden; } :host-context([dir='rtl']) > md-sidenav.md-sidenav-closing { -webkit-transform: translate3d(100%, 0, 0); transform: translate3d(100%, 0, 0); will-change: transform; } :host-context([dir='rtl']) > md-sidenav.md-sidenav-opening { box-shadow: 0px 2px 1px -1px rgba(0, 0, 0, 0.2), 0px 1px 1px 0px rgba(0, 0, 0, 0.14), 0px 1px 3px 0px rgba(0, 0, 0, 0.12); visibility: visible; -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); will-change: transform; } :host-context([dir='rtl']) > md-sidenav.md-sidenav-opened { box-shadow: 0px 2px 1px -1px rgba(0, 0, 0, 0.2), 0px 1px 1px 0px rgba(0, 0, 0, 0.14), 0px 1px 3px 0px rgba(0, 0, 0, 0.12); -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); } :host-context([dir='rtl']) > md-sidenav.md-sidenav-end { left: 0; right: auto; -webkit-transform: translate3d(-100%, 0, 0); transform: translate3d(-100%, 0, 0); } :host-context([dir='rtl']) > md-sidenav.md-sidenav-end.md-sidenav-closed { visibility: hidden; } :host-context([dir='rtl']) > md-sidenav.md-sidenav-end.md-sidenav-closing { -webkit-transform: translate3d(-100%, 0, 0); transform: translate3d(-100%, 0, 0); will-change: transform; } :host-context([dir='rtl']) > md-sidenav.md-sidenav-end.md-sidenav-opening { box-shadow: 0px 2px 1px -1px rgba(0, 0, 0, 0.2), 0px 1px 1px 0px rgba(0, 0, 0, 0.14), 0px 1px 3px 0px rgba(0, 0, 0, 0.12); visibility: visible; -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); will-change: transform; } :host-context([dir='rtl']) > md-sidenav.md-sidenav-end.md-sidenav-opened { box-shadow: 0px 2px 1px -1px rgba(0, 0, 0, 0.2), 0px 1px 1px 0px rgba(0, 0, 0, 0.14), 0px 1px 3px 0px rgba(0, 0, 0, 0.12); -webkit-transform: translate3d(0, 0, 0); transform: translate3d(0, 0, 0); } ","/** * We separate transitions to be able to disable them in unit tests, by simply not loading this file. */ :host > .md-sidenav-backdrop.md-sidenav-shown { -webkit-transition: background-color 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: background-color 400ms cubic-bezier(0.25, 0.8, 0.25, 1); } :host > md-content { -webkit-transition: -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1), -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); } :host > md-sidenav { -webkit-transition: -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); transition: transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1), -webkit-transform 400ms cubic-bezier(0.25, 0.8, 0.25, 1); } "]
-}),s(0,a.Optional()),o("design:paramtypes",[c.Dir,a.ElementRef,a.Renderer])],MdSidenavLayout)}();t.MdSidenavLayout=m,t.MD_SIDENAV_DIRECTIVES=[m,f];var g=function(){function MdSidenavModule(){}return MdSidenavModule=i([a.NgModule({imports:[l.CommonModule],exports:t.MD_SIDENAV_DIRECTIVES,declarations:t.MD_SIDENAV_DIRECTIVES}),o("design:paramtypes",[])],MdSidenavModule)}();t.MdSidenavModule=g},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(0),a=n(0),l=function(){function MdToolbar(e,t){this.elementRef=e,this.renderer=t}return Object.defineProperty(MdToolbar.prototype,"color",{get:function(){return this._color},set:function(e){this._updateColor(e)},enumerable:!0,configurable:!0}),MdToolbar.prototype._updateColor=function(e){this._setElementColor(this._color,!1),this._setElementColor(e,!0),this._color=e},MdToolbar.prototype._setElementColor=function(e,t){null!=e&&""!=e&&this.renderer.setElementClass(this.elementRef.nativeElement,"md-"+e,t)},r([o.Input(),i("design:type",String)],MdToolbar.prototype,"color",null),MdToolbar=r([o.Component({selector:"md-toolbar",template:'<div class="md-toolbar-layout"> <md-toolbar-row> <ng-content></ng-content> </md-toolbar-row> <ng-content select="md-toolbar-row"></ng-content> </div>',styles:["/** * Mixin that creates a new stacking context. * see https://developer.mozilla.org/en-US/docs/Web/CSS/CSS_Positioning/Understanding_z_index/The_stacking_context */ /** * This mixin hides an element visually. * That means it's still accessible for screen-readers but not visible in view. */ /** * Forces an element to grow to fit floated contents; used as as an alternative to * `overflow: hidden;` because it doesn't cut off contents. */ /** * A mixin, which generates temporary ink ripple on a given component. * When $bindToParent is set to true, it will check for the focused class on the same selector as you included * that mixin. * It is also possible to specify the color palette of the temporary ripple. By default it uses the * accent palette for its background. */ md-toolbar { display: -webkit-box; display: -ms-flexbox; display: flex; box-sizing: border-box; width: 100%; min-height: 64px; font-size: 20px; font-weight: 400; font-family: Roboto, \"Helvetica Neue\", sans-serif; padding: 0 16px; -webkit-box-orient: vertical; -webkit-box-direction: normal; -ms-flex-direction: column; flex-direction: column; background: whitesmoke; color: rgba(0, 0, 0, 0.87); } md-toolbar.md-primary { background: #009688; color: white; } md-toolbar.md-accent { background: #9c27b0; color: rgba(255, 255, 255, 0.870588); } md-toolbar.md-warn { background: #f44336; color: white; } md-toolbar md-toolbar-row { display: -webkit-box; display: -ms-flexbox; display: flex; box-sizing: border-box; width: 100%; height: 64px; -webkit-box-orient: horizontal; -webkit-box-direction: normal; -ms-flex-direction: row; flex-direction: row; -webkit-box-align: center; -ms-flex-align: center; align-items: center; } "],changeDetection:o.ChangeDetectionStrategy.OnPush,encapsulation:o.ViewEncapsulation.None}),i("design:paramtypes",[a.ElementRef,s.Renderer])],MdToolbar)}();t.MdToolbar=l,t.MD_TOOLBAR_DIRECTIVES=[l];var c=function(){function MdToolbarModule(){}return MdToolbarModule=r([o.NgModule({exports:t.MD_TOOLBAR_DIRECTIVES,declarations:t.MD_TOOLBAR_DIRECTIVES}),i("design:paramtypes",[])],MdToolbarModule)}();t.MdToolbarModule=c},function(e,t,n){"use strict";n.d(t,"a",function(){return r});var r=function(){function Keyspace(e,t,n){void 0===t&&(t=[]),void 0===n&&(n=[]),this.name=e,this.servingShards=t,this.nonservingShards=n,this.allShards={},this.shardingColumnName="",this.shardingColumnType=""}return Keyspace.prototype.addServingShard=function(e){this.servingShards.push(e),this.allShards[e]=!0},Keyspace.prototype.addNonservingShard=function(e){this.nonservingShards.push(e),this.allShards[e]=!0},Keyspace.prototype.contains=function(e){return e in this.allShards},Keyspace}()},function(e,t,n){"use strict";var r=n(0),i=(n.n(r),n(43)),o=(n.n(i),n(484));n.n(o);n.d(t,"a",function(){return l});var s=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},a=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},l=function(){function WorkflowService(e){this.http=e,this.useWebSocket=!1,this.subject=new o.ReplaySubject(100),this.stopped=!1,this.ws=null,this.id=0,this.useWebSocket?this.connectWebSocket():this.connectHttp()}return WorkflowService.prototype.connectWebSocket=function(){if(!this.stopped){var e=this,t=new WebSocket("ws://"+window.location.hostname+":"+window.location.port+"/api/workflow");t.onopen=function(n){e.ws=t},t.onmessage=function(t){return e.subject.next(JSON.parse(t.data))},t.onclose=function(t){console.log("Disconnected from server, trying again in 3s."),e.ws=null,setTimeout(function(){e.connectWebSocket()},3e3)}}},WorkflowService.prototype.connectHttp=function(){if(!this.stopped){var e=this;this.http.get("../api/workflow/create").subscribe(function(t){var n=t.json();e.subject.next(n),"index"in n?(e.id=n.index,e.pollHttp()):setTimeout(function(){e.connectHttp()},3e3)},function(t){console.log("Workflow service create error, will try again in 3s: %s",t),setTimeout(function(){e.connectHttp()},3e3)})}},WorkflowService.prototype.pollHttp=function(){if(!this.stopped){var e=this;this.http.get("../api/workflow/poll/"+this.id).subscribe(function(t){e.subject.next(t.json()),e.pollHttp()},function(t){console.log("Workflow service poll error, will try again in 3s: %s",t),e.id=0,setTimeout(function(){e.connectHttp()},3e3)})}},WorkflowService.prototype.updates=function(){return this.subject},WorkflowService.prototype.sendAction=function(e,t){var n={path:e,name:t},r=JSON.stringify(n);this.useWebSocket?this.sendActionWebSocket(r):this.sendActionHttp(r)},WorkflowService.prototype.sendActionWebSocket=function(e){return null===this.ws?void alert("Not connected to vtctld via WebSocket, cannot send command."):void this.ws.send(e)},WorkflowService.prototype.sendActionHttp=function(e){if(0===this.id)return void alert("Not connected to vtctld via HTTP, cannot send command.");var t=new i.Headers({"Content-Type":"application/json; charset=utf-8"}),n=new i.RequestOptions({headers:t});this.http.post("../api/workflow/action/"+this.id,e,n).subscribe(function(e){},function(e){alert("Error posting action to server: "+e)})},WorkflowService.prototype.stop=function(){this.stopped=!0,null!==this.ws&&this.ws.close()},WorkflowService=s([n.i(r.Injectable)(),a("design:paramtypes",["function"==typeof(e="undefined"!=typeof i.Http&&i.Http)&&e||Object])],WorkflowService);var e}()},function(e,t,n){"use strict";var r=n(89),i=(n.n(r),n(22)),o=(n.n(i),n(43)),s=(n.n(o),n(0)),a=(n.n(s),n(631)),l=(n.n(a),n(632)),c=(n.n(l),n(633)),u=(n.n(c),n(638)),p=(n.n(u),n(639)),d=(n.n(p),n(640)),h=(n.n(d),n(641)),f=(n.n(h),n(426)),m=(n.n(f),n(642)),g=(n.n(m),n(643)),y=(n.n(g),n(303)),v=(n.n(y),n(647)),b=n(430),_=n(650),w=n(431),S=n(652),C=n(440),E=n(432),R=n(435),T=n(433),P=n(441),x=n(442),M=n(434),k=n(653),I=n(285),A=n(130),O=n(131),D=n(283),N=n(190),V=n(189),L=n(428),F=n(429),j=n(92);n.d(t,"a",function(){return H});var B=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},W=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},H=function(){function AppModule(){}return AppModule=B([n.i(s.NgModule)({imports:[r.BrowserModule,y.DataTableModule,y.DialogModule,y.DropdownModule,i.FormsModule,o.HttpModule,a.MdButtonModule,l.MdCardModule,c.MdCheckboxModule,u.MdIconModule,p.MdInputModule,d.MdListModule,h.MdProgressBarModule,f.MdRippleModule,m.MdSidenavModule,g.MdToolbarModule,y.MenuModule,v.a,y.SharedModule,y.AccordionModule],declarations:[b.a,_.a,w.a,S.a,C.a,E.a,R.a,T.a,P.a,x.a,M.a,k.a,I.a],providers:[v.b,A.a,O.a,D.a,N.a,V.a,L.a,F.a,j.a],entryComponents:[b.a],bootstrap:[b.a],schemas:[s.CUSTOM_ELEMENTS_SCHEMA]}),W("design:paramtypes",[])],AppModule)}()},function(e,t,n){"use strict";var r=n(29),i=(n.n(r),n(651)),o=n(431),s=n(432),a=n(435),l=n(433),c=n(441),u=n(434),p=n(442),d=n(285);n.d(t,"a",function(){return f}),n.d(t,"b",function(){return m});var h=[{path:"",component:o.a},{path:"dashboard",component:o.a,canDeactivate:[i.a]},{path:"status",component:c.a},{path:"schema",component:a.a},{path:"tablet",component:u.a},{path:"workflows",component:d.a},{path:"topo",component:p.a},{path:"keyspace",component:s.a},{path:"shard",component:l.a}],f=r.RouterModule.forRoot(h),m=[i.a]},function(e,t,n){"use strict";var r=(n(430),n(646));n.d(t,"a",function(){return r.a})},function(e,t,n){"use strict";var r=n(488),i=(n.n(r),n(209)),o=(n.n(i),n(490)),s=(n.n(o),n(491)),a=(n.n(s),n(140)),l=(n.n(a),n(306)),c=(n.n(l),n(307)),u=(n.n(c),n(489)),p=(n.n(u),n(498)),d=(n.n(p),n(305));n.n(d)},function(e,t,n){"use strict";var r=n(0);n.n(r);n.d(t,"a",function(){return s});var i=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},o=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},s=(function(){function Breadcrumb(){}return Breadcrumb}(),function(){function BreadcrumbsComponent(){this.icon="label",this.separator="|"}return i([n.i(r.Input)(),o("design:type",Object)],BreadcrumbsComponent.prototype,"icon",void 0),i([n.i(r.Input)(),o("design:type",Array)],BreadcrumbsComponent.prototype,"route",void 0),i([n.i(r.Input)(),o("design:type",Array)],BreadcrumbsComponent.prototype,"crumbs",void 0),i([n.i(r.Input)(),o("design:type",Object)],BreadcrumbsComponent.prototype,"separator",void 0),BreadcrumbsComponent=i([n.i(r.Component)({selector:"vt-breadcrumbs",template:n(888),styles:[n(875)]}),o("design:paramtypes",[])],BreadcrumbsComponent)}())},function(e,t,n){"use strict";n.d(t,"a",function(){return r});var r=function(){function CanDeactivateGuard(){}return CanDeactivateGuard.prototype.canDeactivate=function(e){return!e.canDeactivate||e.canDeactivate()},CanDeactivateGuard}()},function(e,t,n){"use strict";var r=n(0),i=(n.n(r),n(93)),o=n(94),s=n(92);n.d(t,"a",function(){return c});var a=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},l=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},c=function(){function DialogComponent(e){this.vtctlService=e,this.keyspaces=[],this.close=new r.EventEmitter}return DialogComponent.prototype.cancelDialog=function(){this.dialogSettings.toggleModal(),this.close.emit({})},DialogComponent.prototype.closeDialog=function(){this.dialogSettings.onCloseFunction&&this.dialogSettings.onCloseFunction(this.dialogContent),this.dialogSettings.toggleModal(),this.close.emit({})},DialogComponent.prototype.sendAction=function(){var e=this.dialogContent.prepare();e.success?this.runCommand():this.dialogSettings.setMessage("There was a problem preparing "+this.dialogContent.getName()+": "+e.message),this.dialogSettings.dialogForm=!1,this.dialogSettings.dialogLog=!0},DialogComponent.prototype.runCommand=function(){var e=this;this.dialogSettings.startPending(),this.vtctlService.runCommand(this.dialogContent.getPostBody()).subscribe(function(t){t.Error&&e.dialogSettings.setMessage(e.dialogSettings.errMsg+" "+t.Error),e.dialogSettings.setLog(t.Output),e.dialogSettings.endPending()})},DialogComponent.prototype.getCmd=function(){var e=this.dialogContent.prepare(!1).flags,t=this.dialogContent.getFlags(e);return this.dialogContent.getPostBody(t)},a([n.i(r.Input)(),l("design:type","function"==typeof(e="undefined"!=typeof i.a&&i.a)&&e||Object)],DialogComponent.prototype,"dialogContent",void 0),a([n.i(r.Input)(),l("design:type","function"==typeof(t="undefined"!=typeof o.a&&o.a)&&t||Object)],DialogComponent.prototype,"dialogSettings",void 0),a([n.i(r.Output)(),l("design:type",Object)],DialogComponent.prototype,"close",void 0),DialogComponent=a([n.i(r.Component)({selector:"vt-dialog",template:n(889),styles:[n(876),n(78)]}),l("design:paramtypes",["function"==typeof(c="undefined"!=typeof s.a&&s.a)&&c||Object])],DialogComponent);var e,t,c}()},function(e,t,n){"use strict";var r=n(0),i=(n.n(r),n(89)),o=(n.n(i),n(189));n.d(t,"a",function(){return l});var s=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},a=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},l=function(){function TabletPopupComponent(e,t,n){this.tabletService=e,this.zone=t,this.sanitizer=n,this.dataToDisplay=[],this.aggregated=!1,this.unaggregated=!1,this.defaultPopup=!1,this.hoverPopup=!1,this.clickPopup=!1,this.missingPopup=!1,this.healthDataReady=!1}return TabletPopupComponent.prototype.ngOnInit=function(){var e=this;return null==this.data?void this.zone.run(function(){e.defaultPopup=!0}):(null==this.alias&&this.data===-1&&this.zone.run(function(){e.missingPopup=!0}),null!=this.alias&&(this.title=this.alias.cell+" - "+this.alias.uid),void(this.clicked&&(null!=this.alias?(this.parseUnaggregatedData(),this.zone.run(function(){e.unaggregated=!0})):this.zone.run(function(){e.aggregated=!0}))))},TabletPopupComponent.prototype.typeToString=function(e){return 1===e?"MASTER":2===e?"REPLICA":3===e?"RDONLY":void 0},TabletPopupComponent.prototype.parseUnaggregatedData=function(){var e=this;this.tabletService.getTabletHealth(this.alias.cell,this.alias.uid).subscribe(function(t){e.keyspace=t.Target.keyspace,e.shard=t.Target.shard,e.tabletType=e.typeToString(t.Target.tablet_type),e.hostname=t.Tablet.hostname,e.tabletUrl=e.sanitizer.bypassSecurityTrustResourceUrl("http://"+t.Tablet.hostname+":"+t.Tablet.port_map.vt),e.lag="undefined"==typeof t.Stats.seconds_behind_master?0:t.Stats.seconds_behind_master,e.qps="undefined"==typeof t.Stats.qps?0:t.Stats.qps,e.serving="undefined"==typeof t.Serving||t.Serving,e.error="undefined"==typeof t.Stats.health_error?"None":t.Stats.health_error,e.lastError=null==t.LastError?"None":t.LastError,e.zone.run(function(){e.healthDataReady=!0})})},s([n.i(r.Input)(),a("design:type",Number)],TabletPopupComponent.prototype,"data",void 0),s([n.i(r.Input)(),a("design:type",Object)],TabletPopupComponent.prototype,"alias",void 0),s([n.i(r.Input)(),a("design:type",String)],TabletPopupComponent.prototype,"keyspace",void 0),s([n.i(r.Input)(),a("design:type",String)],TabletPopupComponent.prototype,"shard",void 0),s([n.i(r.Input)(),a("design:type",String)],TabletPopupComponent.prototype,"cell",void 0),s([n.i(r.Input)(),a("design:type",String)],TabletPopupComponent.prototype,"tabletType",void 0),s([n.i(r.Input)(),a("design:type",Boolean)],TabletPopupComponent.prototype,"clicked",void 0),TabletPopupComponent=s([n.i(r.Component)({selector:"vt-tablet-popup",template:n(892),styles:[n(879)]}),a("design:paramtypes",["function"==typeof(e="undefined"!=typeof o.a&&o.a)&&e||Object,"function"==typeof(t="undefined"!=typeof r.NgZone&&r.NgZone)&&t||Object,"function"==typeof(l="undefined"!=typeof i.DomSanitizationService&&i.DomSanitizationService)&&l||Object])],TabletPopupComponent);var e,t,l}()},function(e,t,n){"use strict";var r=n(0),i=(n.n(r),n(443)),o=n(303),s=(n.n(o),n(93)),a=n(94),l=n(439),c=n(285);n.d(t,"a",function(){return d});var u=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},p=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},d=function(){function WorkflowComponent(){}return WorkflowComponent.prototype.getChildrenIds=function(){return Object.keys(this.workflow.children)},WorkflowComponent.prototype.getTime=function(){if(this.workflow.lastChanged){var e=new Date(1e3*this.workflow.lastChanged);return e.toString()}},WorkflowComponent.prototype.getState=function(){switch(this.workflow.state){case 0:return"vt-workflow-not-started";case 1:return"vt-workflow-running";case 2:return"vt-workflow-done";default:return""}},WorkflowComponent.prototype.getActionClass=function(e){switch(e){case i.a.NORMAL:return"vt-action-normal";case i.a.TRIGGERED:return"vt-action-triggered";case i.a.WAITING:return"vt-action-waiting";case i.a.WARNING:return"vt-action-warning";default:return""}},WorkflowComponent.prototype.actionClicked=function(e){this.workflowListComponent.sendAction(this.workflow.path,e)},WorkflowComponent.prototype.startClicked=function(e){e.stopPropagation(),this.workflowListComponent.dialogSettings=new a.a("Start","Start "+this.workflow.name,"Are you sure you want to start "+this.workflow.name+"?","There was a problem starting "+this.workflow.name+":"),this.workflowListComponent.dialogSettings.setMessage("Workflow started.");var t=new l.a(this.workflow.getId()).flags;this.workflowListComponent.dialogContent=new s.a("workflow_uuid",t,{},(void 0),"WorkflowStart"),this.workflowListComponent.dialogSettings.toggleModal()},WorkflowComponent.prototype.stopClicked=function(e){e.stopPropagation(),this.workflowListComponent.dialogSettings=new a.a("Stop","Stop "+this.workflow.name,"Are you sure you want to stop "+this.workflow.name+"?","There was a problem stopping "+this.workflow.name+":"),this.workflowListComponent.dialogSettings.setMessage("Workflow stopped.");var t=new l.a(this.workflow.getId()).flags;this.workflowListComponent.dialogContent=new s.a("workflow_uuid",t,{},(void 0),"WorkflowStop"),this.workflowListComponent.dialogSettings.toggleModal()},WorkflowComponent.prototype.deleteClicked=function(e){e.stopPropagation(),this.workflowListComponent.dialogSettings=new a.a("Delete","Delete "+this.workflow.name,"Are you sure you want to delete "+this.workflow.name+"?","There was a problem deleting "+this.workflow.name+":"),this.workflowListComponent.dialogSettings.setMessage("Workflow deleted.");var t=new l.a(this.workflow.getId()).flags;this.workflowListComponent.dialogContent=new s.a("workflow_uuid",t,{},(void 0),"WorkflowDelete"),this.workflowListComponent.dialogSettings.toggleModal()},u([n.i(r.Input)(),p("design:type","function"==typeof(e="undefined"!=typeof i.b&&i.b)&&e||Object)],WorkflowComponent.prototype,"workflow",void 0),u([n.i(r.Input)(),p("design:type","function"==typeof(t="undefined"!=typeof c.a&&c.a)&&t||Object)],WorkflowComponent.prototype,"workflowListComponent",void 0),WorkflowComponent=u([n.i(r.Component)({selector:"vt-workflow",template:n(895),styles:[n(78),n(482)],directives:[o.Accordion,o.AccordionTab,o.Header]}),p("design:paramtypes",[])],WorkflowComponent);var e,t}()},function(e,t,n){"use strict";n.d(t,"a",function(){return r});var r={production:!0}},function(e,t,n){"use strict";var r=n(670),i=(n.n(r),n(663)),o=(n.n(i),n(659)),s=(n.n(o),n(665)),a=(n.n(s),n(664)),l=(n.n(a),n(662)),c=(n.n(l),n(661)),u=(n.n(c),n(669)),p=(n.n(u),n(658)),d=(n.n(p),n(657)),h=(n.n(d),n(667)),f=(n.n(h),n(660)),m=(n.n(f),n(668)),g=(n.n(m),n(666)),y=(n.n(g),n(671)),v=(n.n(y),n(1101));n.n(v)},function(e,t,n){n(202),n(695),n(693),n(699),n(696),n(702),n(704),n(692),n(698),n(689),n(703),n(687),n(701),n(700),n(694),n(697),n(686),n(688),n(691),n(690),n(705),n(471),e.exports=n(24).Array},function(e,t,n){n(706),n(708),n(707),n(710),n(709),e.exports=Date},function(e,t,n){n(711),n(713),n(712),e.exports=n(24).Function},function(e,t,n){n(201),n(202),n(480),n(472),e.exports=n(24).Map},function(e,t,n){n(714),n(715),n(716),n(717),n(718),n(719),n(720),n(721),n(722),n(723),n(724),n(725),n(726),n(727),n(728),n(729),n(730),e.exports=n(24).Math},function(e,t,n){n(731),n(741),n(742),n(732),n(733),n(734),n(735),n(736),n(737),n(738),n(739),n(740),e.exports=n(24).Number},function(e,t,n){n(479),n(744),n(746),n(745),n(748),n(750),n(755),n(749),n(747),n(757),n(756),n(752),n(753),n(751),n(743),n(754),n(758),n(201),e.exports=n(24).Object},function(e,t,n){n(759),e.exports=n(24).parseFloat},function(e,t,n){n(760),e.exports=n(24).parseInt},function(e,t,n){n(761),n(762),n(763),n(764),n(765),n(768),n(766),n(767),n(769),n(770),n(771),n(772),n(774),n(773),e.exports=n(24).Reflect},function(e,t,n){n(775),n(776),n(473),n(474),n(475),n(476),n(477),e.exports=n(24).RegExp},function(e,t,n){n(201),n(202),n(480),n(478),e.exports=n(24).Set},function(e,t,n){n(786),n(790),n(797),n(202),n(781),n(782),n(787),n(791),n(793),n(777),n(778),n(779),n(780),n(783),n(784),n(785),n(788),n(789),n(792),n(794),n(795),n(796),n(474),n(475),n(476),n(477),e.exports=n(24).String},function(e,t,n){n(479),n(201),e.exports=n(24).Symbol},function(e,t,n){n(799),n(800),n(802),n(801),n(804),n(803),n(805),n(806),n(807),e.exports=n(24).Reflect},function(e,t,n){"use strict";var r=n(50),i=n(136),o=n(44);e.exports=[].copyWithin||function(e,t){var n=r(this),s=o(n.length),a=i(e,s),l=i(t,s),c=arguments.length>2?arguments[2]:void 0,u=Math.min((void 0===c?s:i(c,s))-l,s-a),p=1;for(l<a&&a<l+u&&(p=-1,l+=u-1,a+=u-1);u-- >0;)l in n?n[a]=n[l]:delete n[a],a+=p,l+=p;return n}},function(e,t,n){"use strict";var r=n(50),i=n(136),o=n(44);e.exports=function(e){for(var t=r(this),n=o(t.length),s=arguments.length,a=i(s>1?arguments[1]:void 0,n),l=s>2?arguments[2]:void 0,c=void 0===l?n:i(l,n);c>a;)t[a++]=e;return t}},function(e,t,n){var r=n(194);e.exports=function(e,t){var n=[];return r(e,!1,n.push,n,t),n}},function(e,t,n){var r=n(15),i=n(292),o=n(25)("species");e.exports=function(e){var t;return i(e)&&(t=e.constructor,"function"!=typeof t||t!==Array&&!i(t.prototype)||(t=void 0),r(t)&&(t=t[o],null===t&&(t=void 0))),void 0===t?Array:t}},function(e,t,n){var r=n(675);e.exports=function(e,t){return new(r(e))(t)}},function(e,t,n){"use strict";var r=n(297),i=n(76).getWeak,o=n(8),s=n(15),a=n(286),l=n(194),c=n(64),u=n(48),p=n(138),d=c(5),h=c(6),f=0,m=function(e){return e._l||(e._l=new g)},g=function(){this.a=[]},y=function(e,t){return d(e.a,function(e){return e[0]===t})};g.prototype={get:function(e){var t=y(this,e);if(t)return t[1]},has:function(e){return!!y(this,e)},set:function(e,t){var n=y(this,e);n?n[1]=t:this.a.push([e,t])},"delete":function(e){var t=h(this.a,function(t){return t[0]===e});return~t&&this.a.splice(t,1),!!~t}},e.exports={getConstructor:function(e,t,n,o){var c=e(function(e,r){a(e,c,t,"_i"),e._t=t,e._i=f++,e._l=void 0,void 0!=r&&l(r,n,e[o],e)});return r(c.prototype,{"delete":function(e){if(!s(e))return!1;var n=i(e);return n===!0?m(p(this,t)).delete(e):n&&u(n,this._i)&&delete n[this._i]},has:function(e){if(!s(e))return!1;var n=i(e);return n===!0?m(p(this,t)).has(e):n&&u(n,this._i)}}),c},def:function(e,t,n){var r=i(o(t),!0);return r===!0?m(e).set(t,n):r[e._i]=n,e},ufstore:m}},function(e,t,n){"use strict";var r=n(13),i=Date.prototype.getTime,o=Date.prototype.toISOString,s=function(e){return e>9?e:"0"+e};e.exports=r(function(){return"0385-07-25T07:06:39.999Z"!=o.call(new Date(-5e13-1))})||!r(function(){o.call(new Date(NaN))})?function(){if(!isFinite(i.call(this)))throw RangeError("Invalid time value");var e=this,t=e.getUTCFullYear(),n=e.getUTCMilliseconds(),r=t<0?"-":t>9999?"+":"";return r+("00000"+Math.abs(t)).slice(r?-6:-4)+"-"+s(e.getUTCMonth()+1)+"-"+s(e.getUTCDate())+"T"+s(e.getUTCHours())+":"+s(e.getUTCMinutes())+":"+s(e.getUTCSeconds())+"."+(n>99?n:"0"+s(n))+"Z"}:o},function(e,t,n){"use strict";var r=n(8),i=n(97),o="number";e.exports=function(e){if("string"!==e&&e!==o&&"default"!==e)throw TypeError("Incorrect hint");return i(r(this),e!=o)}},function(e,t,n){var r=n(108),i=n(196),o=n(197);e.exports=function(e){var t=r(e),n=i.f;if(n)for(var s,a=n(e),l=o.f,c=0;a.length>c;)l.call(e,s=a[c++])&&t.push(s);return t}},function(e,t){e.exports=function(e,t,n){var r=void 0===n;switch(t.length){case 0:return r?e():e.call(n);case 1:return r?e(t[0]):e.call(n,t[0]);case 2:return r?e(t[0],t[1]):e.call(n,t[0],t[1]);case 3:return r?e(t[0],t[1],t[2]):e.call(n,t[0],t[1],t[2]);case 4:return r?e(t[0],t[1],t[2],t[3]):e.call(n,t[0],t[1],t[2],t[3])}return e.apply(n,t)}},function(e,t,n){var r=n(296),i=Math.pow,o=i(2,-52),s=i(2,-23),a=i(2,127)*(2-s),l=i(2,-126),c=function(e){return e+1/o-1/o};e.exports=Math.fround||function(e){var t,n,i=Math.abs(e),u=r(e);return i<l?u*c(i/l/s)*l*s:(t=(1+s/o)*i,n=t-(t-i),n>a||n!=n?u*(1/0):u*n)}},function(e,t,n){var r=n(135),i=n(196),o=n(8),s=n(26).Reflect;e.exports=s&&s.ownKeys||function(e){var t=r.f(o(e)),n=i.f;return n?t.concat(n(e)):t}},function(e,t){e.exports=Object.is||function(e,t){return e===t?0!==e||1/e===1/t:e!=e&&t!=t}},function(e,t,n){var r=n(26),i=n(24),o=n(195),s=n(469),a=n(30).f;e.exports=function(e){var t=i.Symbol||(i.Symbol=o?{}:r.Symbol||{});"_"==e.charAt(0)||e in t||a(t,e,{value:s.f(e)})}},function(e,t,n){var r=n(2);r(r.P,"Array",{copyWithin:n(672)}),n(132)("copyWithin")},function(e,t,n){"use strict";var r=n(2),i=n(64)(4);r(r.P+r.F*!n(49)([].every,!0),"Array",{every:function(e){return i(this,e,arguments[1])}})},function(e,t,n){var r=n(2);r(r.P,"Array",{fill:n(673)}),n(132)("fill")},function(e,t,n){"use strict";var r=n(2),i=n(64)(2);r(r.P+r.F*!n(49)([].filter,!0),"Array",{filter:function(e){return i(this,e,arguments[1])}})},function(e,t,n){"use strict";var r=n(2),i=n(64)(6),o="findIndex",s=!0;o in[]&&Array(1)[o](function(){s=!1}),r(r.P+r.F*s,"Array",{findIndex:function(e){return i(this,e,arguments.length>1?arguments[1]:void 0)}}),n(132)(o)},function(e,t,n){"use strict";var r=n(2),i=n(64)(5),o="find",s=!0;o in[]&&Array(1)[o](function(){s=!1}),r(r.P+r.F*s,"Array",{find:function(e){return i(this,e,arguments.length>1?arguments[1]:void 0)}}),n(132)(o)},function(e,t,n){"use strict";var r=n(2),i=n(64)(0),o=n(49)([].forEach,!0);r(r.P+r.F*!o,"Array",{forEach:function(e){return i(this,e,arguments[1])}})},function(e,t,n){"use strict";var r=n(106),i=n(2),o=n(50),s=n(456),a=n(454),l=n(44),c=n(450),u=n(470);i(i.S+i.F*!n(458)(function(e){Array.from(e)}),"Array",{from:function(e){var t,n,i,p,d=o(e),h="function"==typeof this?this:Array,f=arguments.length,m=f>1?arguments[1]:void 0,g=void 0!==m,y=0,v=u(d);if(g&&(m=r(m,f>2?arguments[2]:void 0,2)),void 0==v||h==Array&&a(v))for(t=l(d.length),n=new h(t);t>y;y++)c(n,y,g?m(d[y],y):d[y]);else for(p=v.call(d),n=new h;!(i=p.next()).done;y++)c(n,y,g?s(p,m,[i.value,y],!0):i.value);return n.length=y,n}})},function(e,t,n){"use strict";var r=n(2),i=n(445)(!1),o=[].indexOf,s=!!o&&1/[1].indexOf(1,-0)<0;r(r.P+r.F*(s||!n(49)(o)),"Array",{indexOf:function(e){return s?o.apply(this,arguments)||0:i(this,e,arguments[1])}})},function(e,t,n){var r=n(2);r(r.S,"Array",{isArray:n(292)})},function(e,t,n){"use strict";var r=n(2),i=n(57),o=[].join;r(r.P+r.F*(n(133)!=Object||!n(49)(o)),"Array",{join:function(e){return o.call(i(this),void 0===e?",":e)}})},function(e,t,n){"use strict";var r=n(2),i=n(57),o=n(110),s=n(44),a=[].lastIndexOf,l=!!a&&1/[1].lastIndexOf(1,-0)<0;r(r.P+r.F*(l||!n(49)(a)),"Array",{lastIndexOf:function(e){if(l)return a.apply(this,arguments)||0;var t=i(this),n=s(t.length),r=n-1;for(arguments.length>1&&(r=Math.min(r,o(arguments[1]))),r<0&&(r=n+r);r>=0;r--)if(r in t&&t[r]===e)return r||0;return-1}})},function(e,t,n){"use strict";var r=n(2),i=n(64)(1);r(r.P+r.F*!n(49)([].map,!0),"Array",{map:function(e){return i(this,e,arguments[1])}})},function(e,t,n){"use strict";var r=n(2),i=n(450);r(r.S+r.F*n(13)(function(){function F(){}return!(Array.of.call(F)instanceof F)}),"Array",{of:function(){for(var e=0,t=arguments.length,n=new("function"==typeof this?this:Array)(t);t>e;)i(n,e,arguments[e++]);return n.length=t,n}})},function(e,t,n){"use strict";var r=n(2),i=n(446);r(r.P+r.F*!n(49)([].reduceRight,!0),"Array",{reduceRight:function(e){return i(this,e,arguments.length,arguments[1],!0)}})},function(e,t,n){"use strict";var r=n(2),i=n(446);r(r.P+r.F*!n(49)([].reduce,!0),"Array",{reduce:function(e){return i(this,e,arguments.length,arguments[1],!1)}})},function(e,t,n){"use strict";var r=n(2),i=n(452),o=n(96),s=n(136),a=n(44),l=[].slice;r(r.P+r.F*n(13)(function(){i&&l.call(i)}),"Array",{slice:function(e,t){var n=a(this.length),r=o(this);if(t=void 0===t?n:t,"Array"==r)return l.call(this,e,t);for(var i=s(e,n),c=s(t,n),u=a(c-i),p=new Array(u),d=0;d<u;d++)p[d]="String"==r?this.charAt(i+d):this[i+d];return p}})},function(e,t,n){"use strict";var r=n(2),i=n(64)(3);r(r.P+r.F*!n(49)([].some,!0),"Array",{some:function(e){return i(this,e,arguments[1])}})},function(e,t,n){"use strict";var r=n(2),i=n(95),o=n(50),s=n(13),a=[].sort,l=[1,2,3];r(r.P+r.F*(s(function(){l.sort(void 0)})||!s(function(){l.sort(null)})||!n(49)(a)),"Array",{sort:function(e){return void 0===e?a.call(o(this)):a.call(o(this),i(e))}})},function(e,t,n){n(299)("Array")},function(e,t,n){var r=n(2);r(r.S,"Date",{now:function(){return(new Date).getTime()}})},function(e,t,n){var r=n(2),i=n(678);r(r.P+r.F*(Date.prototype.toISOString!==i),"Date",{toISOString:i})},function(e,t,n){"use strict";var r=n(2),i=n(50),o=n(97);r(r.P+r.F*n(13)(function(){return null!==new Date(NaN).toJSON()||1!==Date.prototype.toJSON.call({toISOString:function(){return 1}})}),"Date",{toJSON:function(e){var t=i(this),n=o(t);return"number"!=typeof n||isFinite(n)?t.toISOString():null}})},function(e,t,n){var r=n(25)("toPrimitive"),i=Date.prototype;r in i||n(65)(i,r,n(679))},function(e,t,n){var r=Date.prototype,i="Invalid Date",o="toString",s=r[o],a=r.getTime;new Date(NaN)+""!=i&&n(39)(r,o,function(){var e=a.call(this);return e===e?s.call(this):i})},function(e,t,n){var r=n(2);r(r.P,"Function",{bind:n(447)})},function(e,t,n){"use strict";var r=n(15),i=n(67),o=n(25)("hasInstance"),s=Function.prototype;o in s||n(30).f(s,o,{value:function(e){if("function"!=typeof this||!r(e))return!1;if(!r(this.prototype))return e instanceof this;for(;e=i(e);)if(this.prototype===e)return!0;return!1}})},function(e,t,n){var r=n(30).f,i=Function.prototype,o=/^\s*function ([^ (]*)/,s="name";s in i||n(35)&&r(i,s,{configurable:!0,get:function(){try{return(""+this).match(o)[1]}catch(e){return""}}})},function(e,t,n){var r=n(2),i=n(460),o=Math.sqrt,s=Math.acosh;r(r.S+r.F*!(s&&710==Math.floor(s(Number.MAX_VALUE))&&s(1/0)==1/0),"Math",{acosh:function(e){return(e=+e)<1?NaN:e>94906265.62425156?Math.log(e)+Math.LN2:i(e-1+o(e-1)*o(e+1))}})},function(e,t,n){function asinh(e){return isFinite(e=+e)&&0!=e?e<0?-asinh(-e):Math.log(e+Math.sqrt(e*e+1)):e;
+}),s(0,a.Optional()),o("design:paramtypes",[c.Dir,a.ElementRef,a.Renderer])],MdSidenavLayout)}();t.MdSidenavLayout=m,t.MD_SIDENAV_DIRECTIVES=[m,f];var g=function(){function MdSidenavModule(){}return MdSidenavModule=i([a.NgModule({imports:[l.CommonModule],exports:t.MD_SIDENAV_DIRECTIVES,declarations:t.MD_SIDENAV_DIRECTIVES}),o("design:paramtypes",[])],MdSidenavModule)}();t.MdSidenavModule=g},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(0),a=n(0),l=function(){function MdToolbar(e,t){this.elementRef=e,this.renderer=t}return Object.defineProperty(MdToolbar.prototype,"color",{get:function(){return this._color},set:function(e){this._updateColor(e)},enumerable:!0,configurable:!0}),MdToolbar.prototype._updateColor=function(e){this._setElementColor(this._color,!1),this._setElementColor(e,!0),this._color=e},MdToolbar.prototype._setElementColor=function(e,t){null!=e&&""!=e&&this.renderer.setElementClass(this.elementRef.nativeElement,"md-"+e,t)},r([o.Input(),i("design:type",String)],MdToolbar.prototype,"color",null),MdToolbar=r([o.Component({selector:"md-toolbar",template:'<div class="md-toolbar-layout"> <md-toolbar-row> <ng-content></ng-content> </md-toolbar-row> <ng-content select="md-toolbar-row"></ng-content> </div>',styles:["/** * Mixin that creates a new stacking context. * see https://developer.mozilla.org/en-US/docs/Web/CSS/CSS_Positioning/Understanding_z_index/The_stacking_context */ /** * This mixin hides an element visually. * That means it's still accessible for screen-readers but not visible in view. */ /** * Forces an element to grow to fit floated contents; used as as an alternative to * `overflow: hidden;` because it doesn't cut off contents. */ /** * A mixin, which generates temporary ink ripple on a given component. * When $bindToParent is set to true, it will check for the focused class on the same selector as you included * that mixin. * It is also possible to specify the color palette of the temporary ripple. By default it uses the * accent palette for its background. */ md-toolbar { display: -webkit-box; display: -ms-flexbox; display: flex; box-sizing: border-box; width: 100%; min-height: 64px; font-size: 20px; font-weight: 400; font-family: Roboto, \"Helvetica Neue\", sans-serif; padding: 0 16px; -webkit-box-orient: vertical; -webkit-box-direction: normal; -ms-flex-direction: column; flex-direction: column; background: whitesmoke; color: rgba(0, 0, 0, 0.87); } md-toolbar.md-primary { background: #009688; color: white; } md-toolbar.md-accent { background: #9c27b0; color: rgba(255, 255, 255, 0.870588); } md-toolbar.md-warn { background: #f44336; color: white; } md-toolbar md-toolbar-row { display: -webkit-box; display: -ms-flexbox; display: flex; box-sizing: border-box; width: 100%; height: 64px; -webkit-box-orient: horizontal; -webkit-box-direction: normal; -ms-flex-direction: row; flex-direction: row; -webkit-box-align: center; -ms-flex-align: center; align-items: center; } "],changeDetection:o.ChangeDetectionStrategy.OnPush,encapsulation:o.ViewEncapsulation.None}),i("design:paramtypes",[a.ElementRef,s.Renderer])],MdToolbar)}();t.MdToolbar=l,t.MD_TOOLBAR_DIRECTIVES=[l];var c=function(){function MdToolbarModule(){}return MdToolbarModule=r([o.NgModule({exports:t.MD_TOOLBAR_DIRECTIVES,declarations:t.MD_TOOLBAR_DIRECTIVES}),i("design:paramtypes",[])],MdToolbarModule)}();t.MdToolbarModule=c},function(e,t,n){"use strict";n.d(t,"a",function(){return r});var r=function(){function Keyspace(e,t,n){void 0===t&&(t=[]),void 0===n&&(n=[]),this.name=e,this.servingShards=t,this.nonservingShards=n,this.allShards={},this.shardingColumnName="",this.shardingColumnType=""}return Keyspace.prototype.addServingShard=function(e){this.servingShards.push(e),this.allShards[e]=!0},Keyspace.prototype.addNonservingShard=function(e){this.nonservingShards.push(e),this.allShards[e]=!0},Keyspace.prototype.contains=function(e){return e in this.allShards},Keyspace}()},function(e,t,n){"use strict";var r=n(0),i=(n.n(r),n(43)),o=(n.n(i),n(484));n.n(o);n.d(t,"a",function(){return l});var s=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},a=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},l=function(){function WorkflowService(e){this.http=e,this.useWebSocket=!1,this.subject=new o.ReplaySubject(100),this.stopped=!1,this.ws=null,this.id=0,this.useWebSocket?this.connectWebSocket():this.connectHttp()}return WorkflowService.prototype.connectWebSocket=function(){if(!this.stopped){var e=this,t=new WebSocket("ws://"+window.location.hostname+":"+window.location.port+"/api/workflow");t.onopen=function(n){e.ws=t},t.onmessage=function(t){return e.subject.next(JSON.parse(t.data))},t.onclose=function(t){console.log("Disconnected from server, trying again in 3s."),e.ws=null,setTimeout(function(){e.connectWebSocket()},3e3)}}},WorkflowService.prototype.connectHttp=function(){if(!this.stopped){var e=this;this.http.get("../api/workflow/create").subscribe(function(t){var n=t.json();e.subject.next(n),"index"in n?(e.id=n.index,e.pollHttp()):setTimeout(function(){e.connectHttp()},3e3)},function(t){console.log("Workflow service create error, will try again in 3s: %s",t),setTimeout(function(){e.connectHttp()},3e3)})}},WorkflowService.prototype.pollHttp=function(){if(!this.stopped){var e=this;this.http.get("../api/workflow/poll/"+this.id).subscribe(function(t){e.subject.next(t.json()),e.pollHttp()},function(t){console.log("Workflow service poll error, will try again in 3s: %s",t),e.id=0,setTimeout(function(){e.connectHttp()},3e3)})}},WorkflowService.prototype.updates=function(){return this.subject},WorkflowService.prototype.sendAction=function(e,t){var n={path:e,name:t},r=JSON.stringify(n);this.useWebSocket?this.sendActionWebSocket(r):this.sendActionHttp(r)},WorkflowService.prototype.sendActionWebSocket=function(e){return null===this.ws?void alert("Not connected to vtctld via WebSocket, cannot send command."):void this.ws.send(e)},WorkflowService.prototype.sendActionHttp=function(e){if(0===this.id)return void alert("Not connected to vtctld via HTTP, cannot send command.");var t=new i.Headers({"Content-Type":"application/json; charset=utf-8"}),n=new i.RequestOptions({headers:t});this.http.post("../api/workflow/action/"+this.id,e,n).subscribe(function(e){},function(e){alert("Error posting action to server: "+e)})},WorkflowService.prototype.stop=function(){this.stopped=!0,null!==this.ws&&this.ws.close()},WorkflowService=s([n.i(r.Injectable)(),a("design:paramtypes",["function"==typeof(e="undefined"!=typeof i.Http&&i.Http)&&e||Object])],WorkflowService);var e}()},function(e,t,n){"use strict";var r=n(89),i=(n.n(r),n(22)),o=(n.n(i),n(43)),s=(n.n(o),n(0)),a=(n.n(s),n(631)),l=(n.n(a),n(632)),c=(n.n(l),n(633)),u=(n.n(c),n(638)),p=(n.n(u),n(639)),d=(n.n(p),n(640)),h=(n.n(d),n(641)),f=(n.n(h),n(426)),m=(n.n(f),n(642)),g=(n.n(m),n(643)),y=(n.n(g),n(303)),v=(n.n(y),n(647)),b=n(430),_=n(650),w=n(431),S=n(652),C=n(440),E=n(432),R=n(435),T=n(433),P=n(441),x=n(442),M=n(434),k=n(653),U=n(1110),I=n(285),A=n(130),O=n(131),D=n(283),N=n(190),V=n(189),L=n(428),F=n(429),j=n(92);n.d(t,"a",function(){return H});var B=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},W=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},H=function(){function AppModule(){}return AppModule=B([n.i(s.NgModule)({imports:[r.BrowserModule,y.DataTableModule,y.DialogModule,y.DropdownModule,i.FormsModule,o.HttpModule,a.MdButtonModule,l.MdCardModule,c.MdCheckboxModule,u.MdIconModule,p.MdInputModule,d.MdListModule,h.MdProgressBarModule,f.MdRippleModule,m.MdSidenavModule,g.MdToolbarModule,y.MenuModule,v.a,y.SharedModule,y.AccordionModule],declarations:[b.a,_.a,w.a,S.a,C.a,E.a,R.a,T.a,P.a,x.a,M.a,k.a,U.a,I.a],providers:[v.b,A.a,O.a,D.a,N.a,V.a,L.a,F.a,j.a],entryComponents:[b.a],bootstrap:[b.a],schemas:[s.CUSTOM_ELEMENTS_SCHEMA]}),W("design:paramtypes",[])],AppModule)}()},function(e,t,n){"use strict";var r=n(29),i=(n.n(r),n(651)),o=n(431),s=n(432),a=n(435),l=n(433),c=n(441),u=n(434),p=n(442),d=n(1110),y=n(285);n.d(t,"a",function(){return f}),n.d(t,"b",function(){return m});var h=[{path:"",component:o.a},{path:"dashboard",component:o.a,canDeactivate:[i.a]},{path:"status",component:c.a},{path:"schema",component:a.a},{path:"tablet",component:u.a},{path:"workflows",component:y.a},{path:"vreplication",component:d.a},{path:"topo",component:p.a},{path:"keyspace",component:s.a},{path:"shard",component:l.a}],f=r.RouterModule.forRoot(h),m=[i.a]},function(e,t,n){"use strict";var r=(n(430),n(646));n.d(t,"a",function(){return r.a})},function(e,t,n){"use strict";var r=n(488),i=(n.n(r),n(209)),o=(n.n(i),n(490)),s=(n.n(o),n(491)),a=(n.n(s),n(140)),l=(n.n(a),n(306)),c=(n.n(l),n(307)),u=(n.n(c),n(489)),p=(n.n(u),n(498)),d=(n.n(p),n(305));n.n(d)},function(e,t,n){"use strict";var r=n(0);n.n(r);n.d(t,"a",function(){return s});var i=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},o=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},s=(function(){function Breadcrumb(){}return Breadcrumb}(),function(){function BreadcrumbsComponent(){this.icon="label",this.separator="|"}return i([n.i(r.Input)(),o("design:type",Object)],BreadcrumbsComponent.prototype,"icon",void 0),i([n.i(r.Input)(),o("design:type",Array)],BreadcrumbsComponent.prototype,"route",void 0),i([n.i(r.Input)(),o("design:type",Array)],BreadcrumbsComponent.prototype,"crumbs",void 0),i([n.i(r.Input)(),o("design:type",Object)],BreadcrumbsComponent.prototype,"separator",void 0),BreadcrumbsComponent=i([n.i(r.Component)({selector:"vt-breadcrumbs",template:n(888),styles:[n(875)]}),o("design:paramtypes",[])],BreadcrumbsComponent)}())},function(e,t,n){"use strict";n.d(t,"a",function(){return r});var r=function(){function CanDeactivateGuard(){}return CanDeactivateGuard.prototype.canDeactivate=function(e){return!e.canDeactivate||e.canDeactivate()},CanDeactivateGuard}()},function(e,t,n){"use strict";var r=n(0),i=(n.n(r),n(93)),o=n(94),s=n(92);n.d(t,"a",function(){return c});var a=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},l=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},c=function(){function DialogComponent(e){this.vtctlService=e,this.keyspaces=[],this.close=new r.EventEmitter}return DialogComponent.prototype.cancelDialog=function(){this.dialogSettings.toggleModal(),this.close.emit({})},DialogComponent.prototype.closeDialog=function(){this.dialogSettings.onCloseFunction&&this.dialogSettings.onCloseFunction(this.dialogContent),this.dialogSettings.toggleModal(),this.close.emit({})},DialogComponent.prototype.sendAction=function(){var e=this.dialogContent.prepare();e.success?this.runCommand():this.dialogSettings.setMessage("There was a problem preparing "+this.dialogContent.getName()+": "+e.message),this.dialogSettings.dialogForm=!1,this.dialogSettings.dialogLog=!0},DialogComponent.prototype.runCommand=function(){var e=this;this.dialogSettings.startPending(),this.vtctlService.runCommand(this.dialogContent.getPostBody()).subscribe(function(t){t.Error&&e.dialogSettings.setMessage(e.dialogSettings.errMsg+" "+t.Error),e.dialogSettings.setLog(t.Output),e.dialogSettings.endPending()})},DialogComponent.prototype.getCmd=function(){var e=this.dialogContent.prepare(!1).flags,t=this.dialogContent.getFlags(e);return this.dialogContent.getPostBody(t)},a([n.i(r.Input)(),l("design:type","function"==typeof(e="undefined"!=typeof i.a&&i.a)&&e||Object)],DialogComponent.prototype,"dialogContent",void 0),a([n.i(r.Input)(),l("design:type","function"==typeof(t="undefined"!=typeof o.a&&o.a)&&t||Object)],DialogComponent.prototype,"dialogSettings",void 0),a([n.i(r.Output)(),l("design:type",Object)],DialogComponent.prototype,"close",void 0),DialogComponent=a([n.i(r.Component)({selector:"vt-dialog",template:n(889),styles:[n(876),n(78)]}),l("design:paramtypes",["function"==typeof(c="undefined"!=typeof s.a&&s.a)&&c||Object])],DialogComponent);var e,t,c}()},function(e,t,n){"use strict";var r=n(0),i=(n.n(r),n(89)),o=(n.n(i),n(189));n.d(t,"a",function(){return l});var s=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},a=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},l=function(){function TabletPopupComponent(e,t,n){this.tabletService=e,this.zone=t,this.sanitizer=n,this.dataToDisplay=[],this.aggregated=!1,this.unaggregated=!1,this.defaultPopup=!1,this.hoverPopup=!1,this.clickPopup=!1,this.missingPopup=!1,this.healthDataReady=!1}return TabletPopupComponent.prototype.ngOnInit=function(){var e=this;return null==this.data?void this.zone.run(function(){e.defaultPopup=!0}):(null==this.alias&&this.data===-1&&this.zone.run(function(){e.missingPopup=!0}),null!=this.alias&&(this.title=this.alias.cell+" - "+this.alias.uid),void(this.clicked&&(null!=this.alias?(this.parseUnaggregatedData(),this.zone.run(function(){e.unaggregated=!0})):this.zone.run(function(){e.aggregated=!0}))))},TabletPopupComponent.prototype.typeToString=function(e){return 1===e?"MASTER":2===e?"REPLICA":3===e?"RDONLY":void 0},TabletPopupComponent.prototype.parseUnaggregatedData=function(){var e=this;this.tabletService.getTabletHealth(this.alias.cell,this.alias.uid).subscribe(function(t){e.keyspace=t.Target.keyspace,e.shard=t.Target.shard,e.tabletType=e.typeToString(t.Target.tablet_type),e.hostname=t.Tablet.hostname,e.tabletUrl=e.sanitizer.bypassSecurityTrustResourceUrl("http://"+t.Tablet.hostname+":"+t.Tablet.port_map.vt),e.lag="undefined"==typeof t.Stats.seconds_behind_master?0:t.Stats.seconds_behind_master,e.qps="undefined"==typeof t.Stats.qps?0:t.Stats.qps,e.serving="undefined"==typeof t.Serving||t.Serving,e.error="undefined"==typeof t.Stats.health_error?"None":t.Stats.health_error,e.lastError=null==t.LastError?"None":t.LastError,e.zone.run(function(){e.healthDataReady=!0})})},s([n.i(r.Input)(),a("design:type",Number)],TabletPopupComponent.prototype,"data",void 0),s([n.i(r.Input)(),a("design:type",Object)],TabletPopupComponent.prototype,"alias",void 0),s([n.i(r.Input)(),a("design:type",String)],TabletPopupComponent.prototype,"keyspace",void 0),s([n.i(r.Input)(),a("design:type",String)],TabletPopupComponent.prototype,"shard",void 0),s([n.i(r.Input)(),a("design:type",String)],TabletPopupComponent.prototype,"cell",void 0),s([n.i(r.Input)(),a("design:type",String)],TabletPopupComponent.prototype,"tabletType",void 0),s([n.i(r.Input)(),a("design:type",Boolean)],TabletPopupComponent.prototype,"clicked",void 0),TabletPopupComponent=s([n.i(r.Component)({selector:"vt-tablet-popup",template:n(892),styles:[n(879)]}),a("design:paramtypes",["function"==typeof(e="undefined"!=typeof o.a&&o.a)&&e||Object,"function"==typeof(t="undefined"!=typeof r.NgZone&&r.NgZone)&&t||Object,"function"==typeof(l="undefined"!=typeof i.DomSanitizationService&&i.DomSanitizationService)&&l||Object])],TabletPopupComponent);var e,t,l}()},function(e,t,n){"use strict";var r=n(0),i=(n.n(r),n(443)),o=n(303),s=(n.n(o),n(93)),a=n(94),l=n(439),c=n(285);n.d(t,"a",function(){return d});var u=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},p=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},d=function(){function WorkflowComponent(){}return WorkflowComponent.prototype.getChildrenIds=function(){return Object.keys(this.workflow.children)},WorkflowComponent.prototype.getTime=function(){if(this.workflow.lastChanged){var e=new Date(1e3*this.workflow.lastChanged);return e.toString()}},WorkflowComponent.prototype.getState=function(){switch(this.workflow.state){case 0:return"vt-workflow-not-started";case 1:return"vt-workflow-running";case 2:return"vt-workflow-done";default:return""}},WorkflowComponent.prototype.getActionClass=function(e){switch(e){case i.a.NORMAL:return"vt-action-normal";case i.a.TRIGGERED:return"vt-action-triggered";case i.a.WAITING:return"vt-action-waiting";case i.a.WARNING:return"vt-action-warning";default:return""}},WorkflowComponent.prototype.actionClicked=function(e){this.workflowListComponent.sendAction(this.workflow.path,e)},WorkflowComponent.prototype.startClicked=function(e){e.stopPropagation(),this.workflowListComponent.dialogSettings=new a.a("Start","Start "+this.workflow.name,"Are you sure you want to start "+this.workflow.name+"?","There was a problem starting "+this.workflow.name+":"),this.workflowListComponent.dialogSettings.setMessage("Workflow started.");var t=new l.a(this.workflow.getId()).flags;this.workflowListComponent.dialogContent=new s.a("workflow_uuid",t,{},(void 0),"WorkflowStart"),this.workflowListComponent.dialogSettings.toggleModal()},WorkflowComponent.prototype.stopClicked=function(e){e.stopPropagation(),this.workflowListComponent.dialogSettings=new a.a("Stop","Stop "+this.workflow.name,"Are you sure you want to stop "+this.workflow.name+"?","There was a problem stopping "+this.workflow.name+":"),this.workflowListComponent.dialogSettings.setMessage("Workflow stopped.");var t=new l.a(this.workflow.getId()).flags;this.workflowListComponent.dialogContent=new s.a("workflow_uuid",t,{},(void 0),"WorkflowStop"),this.workflowListComponent.dialogSettings.toggleModal()},WorkflowComponent.prototype.deleteClicked=function(e){e.stopPropagation(),this.workflowListComponent.dialogSettings=new a.a("Delete","Delete "+this.workflow.name,"Are you sure you want to delete "+this.workflow.name+"?","There was a problem deleting "+this.workflow.name+":"),this.workflowListComponent.dialogSettings.setMessage("Workflow deleted.");var t=new l.a(this.workflow.getId()).flags;this.workflowListComponent.dialogContent=new s.a("workflow_uuid",t,{},(void 0),"WorkflowDelete"),this.workflowListComponent.dialogSettings.toggleModal()},u([n.i(r.Input)(),p("design:type","function"==typeof(e="undefined"!=typeof i.b&&i.b)&&e||Object)],WorkflowComponent.prototype,"workflow",void 0),u([n.i(r.Input)(),p("design:type","function"==typeof(t="undefined"!=typeof c.a&&c.a)&&t||Object)],WorkflowComponent.prototype,"workflowListComponent",void 0),WorkflowComponent=u([n.i(r.Component)({selector:"vt-workflow",template:n(895),styles:[n(78),n(482)],directives:[o.Accordion,o.AccordionTab,o.Header]}),p("design:paramtypes",[])],WorkflowComponent);var e,t}()},function(e,t,n){"use strict";n.d(t,"a",function(){return r});var r={production:!0}},function(e,t,n){"use strict";var r=n(670),i=(n.n(r),n(663)),o=(n.n(i),n(659)),s=(n.n(o),n(665)),a=(n.n(s),n(664)),l=(n.n(a),n(662)),c=(n.n(l),n(661)),u=(n.n(c),n(669)),p=(n.n(u),n(658)),d=(n.n(p),n(657)),h=(n.n(d),n(667)),f=(n.n(h),n(660)),m=(n.n(f),n(668)),g=(n.n(m),n(666)),y=(n.n(g),n(671)),v=(n.n(y),n(1101));n.n(v)},function(e,t,n){n(202),n(695),n(693),n(699),n(696),n(702),n(704),n(692),n(698),n(689),n(703),n(687),n(701),n(700),n(694),n(697),n(686),n(688),n(691),n(690),n(705),n(471),e.exports=n(24).Array},function(e,t,n){n(706),n(708),n(707),n(710),n(709),e.exports=Date},function(e,t,n){n(711),n(713),n(712),e.exports=n(24).Function},function(e,t,n){n(201),n(202),n(480),n(472),e.exports=n(24).Map},function(e,t,n){n(714),n(715),n(716),n(717),n(718),n(719),n(720),n(721),n(722),n(723),n(724),n(725),n(726),n(727),n(728),n(729),n(730),e.exports=n(24).Math},function(e,t,n){n(731),n(741),n(742),n(732),n(733),n(734),n(735),n(736),n(737),n(738),n(739),n(740),e.exports=n(24).Number},function(e,t,n){n(479),n(744),n(746),n(745),n(748),n(750),n(755),n(749),n(747),n(757),n(756),n(752),n(753),n(751),n(743),n(754),n(758),n(201),e.exports=n(24).Object},function(e,t,n){n(759),e.exports=n(24).parseFloat},function(e,t,n){n(760),e.exports=n(24).parseInt},function(e,t,n){n(761),n(762),n(763),n(764),n(765),n(768),n(766),n(767),n(769),n(770),n(771),n(772),n(774),n(773),e.exports=n(24).Reflect},function(e,t,n){n(775),n(776),n(473),n(474),n(475),n(476),n(477),e.exports=n(24).RegExp},function(e,t,n){n(201),n(202),n(480),n(478),e.exports=n(24).Set},function(e,t,n){n(786),n(790),n(797),n(202),n(781),n(782),n(787),n(791),n(793),n(777),n(778),n(779),n(780),n(783),n(784),n(785),n(788),n(789),n(792),n(794),n(795),n(796),n(474),n(475),n(476),n(477),e.exports=n(24).String},function(e,t,n){n(479),n(201),e.exports=n(24).Symbol},function(e,t,n){n(799),n(800),n(802),n(801),n(804),n(803),n(805),n(806),n(807),e.exports=n(24).Reflect},function(e,t,n){"use strict";var r=n(50),i=n(136),o=n(44);e.exports=[].copyWithin||function(e,t){var n=r(this),s=o(n.length),a=i(e,s),l=i(t,s),c=arguments.length>2?arguments[2]:void 0,u=Math.min((void 0===c?s:i(c,s))-l,s-a),p=1;for(l<a&&a<l+u&&(p=-1,l+=u-1,a+=u-1);u-- >0;)l in n?n[a]=n[l]:delete n[a],a+=p,l+=p;return n}},function(e,t,n){"use strict";var r=n(50),i=n(136),o=n(44);e.exports=function(e){for(var t=r(this),n=o(t.length),s=arguments.length,a=i(s>1?arguments[1]:void 0,n),l=s>2?arguments[2]:void 0,c=void 0===l?n:i(l,n);c>a;)t[a++]=e;return t}},function(e,t,n){var r=n(194);e.exports=function(e,t){var n=[];return r(e,!1,n.push,n,t),n}},function(e,t,n){var r=n(15),i=n(292),o=n(25)("species");e.exports=function(e){var t;return i(e)&&(t=e.constructor,"function"!=typeof t||t!==Array&&!i(t.prototype)||(t=void 0),r(t)&&(t=t[o],null===t&&(t=void 0))),void 0===t?Array:t}},function(e,t,n){var r=n(675);e.exports=function(e,t){return new(r(e))(t)}},function(e,t,n){"use strict";var r=n(297),i=n(76).getWeak,o=n(8),s=n(15),a=n(286),l=n(194),c=n(64),u=n(48),p=n(138),d=c(5),h=c(6),f=0,m=function(e){return e._l||(e._l=new g)},g=function(){this.a=[]},y=function(e,t){return d(e.a,function(e){return e[0]===t})};g.prototype={get:function(e){var t=y(this,e);if(t)return t[1]},has:function(e){return!!y(this,e)},set:function(e,t){var n=y(this,e);n?n[1]=t:this.a.push([e,t])},"delete":function(e){var t=h(this.a,function(t){return t[0]===e});return~t&&this.a.splice(t,1),!!~t}},e.exports={getConstructor:function(e,t,n,o){var c=e(function(e,r){a(e,c,t,"_i"),e._t=t,e._i=f++,e._l=void 0,void 0!=r&&l(r,n,e[o],e)});return r(c.prototype,{"delete":function(e){if(!s(e))return!1;var n=i(e);return n===!0?m(p(this,t)).delete(e):n&&u(n,this._i)&&delete n[this._i]},has:function(e){if(!s(e))return!1;var n=i(e);return n===!0?m(p(this,t)).has(e):n&&u(n,this._i)}}),c},def:function(e,t,n){var r=i(o(t),!0);return r===!0?m(e).set(t,n):r[e._i]=n,e},ufstore:m}},function(e,t,n){"use strict";var r=n(13),i=Date.prototype.getTime,o=Date.prototype.toISOString,s=function(e){return e>9?e:"0"+e};e.exports=r(function(){return"0385-07-25T07:06:39.999Z"!=o.call(new Date(-5e13-1))})||!r(function(){o.call(new Date(NaN))})?function(){if(!isFinite(i.call(this)))throw RangeError("Invalid time value");var e=this,t=e.getUTCFullYear(),n=e.getUTCMilliseconds(),r=t<0?"-":t>9999?"+":"";return r+("00000"+Math.abs(t)).slice(r?-6:-4)+"-"+s(e.getUTCMonth()+1)+"-"+s(e.getUTCDate())+"T"+s(e.getUTCHours())+":"+s(e.getUTCMinutes())+":"+s(e.getUTCSeconds())+"."+(n>99?n:"0"+s(n))+"Z"}:o},function(e,t,n){"use strict";var r=n(8),i=n(97),o="number";e.exports=function(e){if("string"!==e&&e!==o&&"default"!==e)throw TypeError("Incorrect hint");return i(r(this),e!=o)}},function(e,t,n){var r=n(108),i=n(196),o=n(197);e.exports=function(e){var t=r(e),n=i.f;if(n)for(var s,a=n(e),l=o.f,c=0;a.length>c;)l.call(e,s=a[c++])&&t.push(s);return t}},function(e,t){e.exports=function(e,t,n){var r=void 0===n;switch(t.length){case 0:return r?e():e.call(n);case 1:return r?e(t[0]):e.call(n,t[0]);case 2:return r?e(t[0],t[1]):e.call(n,t[0],t[1]);case 3:return r?e(t[0],t[1],t[2]):e.call(n,t[0],t[1],t[2]);case 4:return r?e(t[0],t[1],t[2],t[3]):e.call(n,t[0],t[1],t[2],t[3])}return e.apply(n,t)}},function(e,t,n){var r=n(296),i=Math.pow,o=i(2,-52),s=i(2,-23),a=i(2,127)*(2-s),l=i(2,-126),c=function(e){return e+1/o-1/o};e.exports=Math.fround||function(e){var t,n,i=Math.abs(e),u=r(e);return i<l?u*c(i/l/s)*l*s:(t=(1+s/o)*i,n=t-(t-i),n>a||n!=n?u*(1/0):u*n)}},function(e,t,n){var r=n(135),i=n(196),o=n(8),s=n(26).Reflect;e.exports=s&&s.ownKeys||function(e){var t=r.f(o(e)),n=i.f;return n?t.concat(n(e)):t}},function(e,t){e.exports=Object.is||function(e,t){return e===t?0!==e||1/e===1/t:e!=e&&t!=t}},function(e,t,n){var r=n(26),i=n(24),o=n(195),s=n(469),a=n(30).f;e.exports=function(e){var t=i.Symbol||(i.Symbol=o?{}:r.Symbol||{});"_"==e.charAt(0)||e in t||a(t,e,{value:s.f(e)})}},function(e,t,n){var r=n(2);r(r.P,"Array",{copyWithin:n(672)}),n(132)("copyWithin")},function(e,t,n){"use strict";var r=n(2),i=n(64)(4);r(r.P+r.F*!n(49)([].every,!0),"Array",{every:function(e){return i(this,e,arguments[1])}})},function(e,t,n){var r=n(2);r(r.P,"Array",{fill:n(673)}),n(132)("fill")},function(e,t,n){"use strict";var r=n(2),i=n(64)(2);r(r.P+r.F*!n(49)([].filter,!0),"Array",{filter:function(e){return i(this,e,arguments[1])}})},function(e,t,n){"use strict";var r=n(2),i=n(64)(6),o="findIndex",s=!0;o in[]&&Array(1)[o](function(){s=!1}),r(r.P+r.F*s,"Array",{findIndex:function(e){return i(this,e,arguments.length>1?arguments[1]:void 0)}}),n(132)(o)},function(e,t,n){"use strict";var r=n(2),i=n(64)(5),o="find",s=!0;o in[]&&Array(1)[o](function(){s=!1}),r(r.P+r.F*s,"Array",{find:function(e){return i(this,e,arguments.length>1?arguments[1]:void 0)}}),n(132)(o)},function(e,t,n){"use strict";var r=n(2),i=n(64)(0),o=n(49)([].forEach,!0);r(r.P+r.F*!o,"Array",{forEach:function(e){return i(this,e,arguments[1])}})},function(e,t,n){"use strict";var r=n(106),i=n(2),o=n(50),s=n(456),a=n(454),l=n(44),c=n(450),u=n(470);i(i.S+i.F*!n(458)(function(e){Array.from(e)}),"Array",{from:function(e){var t,n,i,p,d=o(e),h="function"==typeof this?this:Array,f=arguments.length,m=f>1?arguments[1]:void 0,g=void 0!==m,y=0,v=u(d);if(g&&(m=r(m,f>2?arguments[2]:void 0,2)),void 0==v||h==Array&&a(v))for(t=l(d.length),n=new h(t);t>y;y++)c(n,y,g?m(d[y],y):d[y]);else for(p=v.call(d),n=new h;!(i=p.next()).done;y++)c(n,y,g?s(p,m,[i.value,y],!0):i.value);return n.length=y,n}})},function(e,t,n){"use strict";var r=n(2),i=n(445)(!1),o=[].indexOf,s=!!o&&1/[1].indexOf(1,-0)<0;r(r.P+r.F*(s||!n(49)(o)),"Array",{indexOf:function(e){return s?o.apply(this,arguments)||0:i(this,e,arguments[1])}})},function(e,t,n){var r=n(2);r(r.S,"Array",{isArray:n(292)})},function(e,t,n){"use strict";var r=n(2),i=n(57),o=[].join;r(r.P+r.F*(n(133)!=Object||!n(49)(o)),"Array",{join:function(e){return o.call(i(this),void 0===e?",":e)}})},function(e,t,n){"use strict";var r=n(2),i=n(57),o=n(110),s=n(44),a=[].lastIndexOf,l=!!a&&1/[1].lastIndexOf(1,-0)<0;r(r.P+r.F*(l||!n(49)(a)),"Array",{lastIndexOf:function(e){if(l)return a.apply(this,arguments)||0;var t=i(this),n=s(t.length),r=n-1;for(arguments.length>1&&(r=Math.min(r,o(arguments[1]))),r<0&&(r=n+r);r>=0;r--)if(r in t&&t[r]===e)return r||0;return-1}})},function(e,t,n){"use strict";var r=n(2),i=n(64)(1);r(r.P+r.F*!n(49)([].map,!0),"Array",{map:function(e){return i(this,e,arguments[1])}})},function(e,t,n){"use strict";var r=n(2),i=n(450);r(r.S+r.F*n(13)(function(){function F(){}return!(Array.of.call(F)instanceof F)}),"Array",{of:function(){for(var e=0,t=arguments.length,n=new("function"==typeof this?this:Array)(t);t>e;)i(n,e,arguments[e++]);return n.length=t,n}})},function(e,t,n){"use strict";var r=n(2),i=n(446);r(r.P+r.F*!n(49)([].reduceRight,!0),"Array",{reduceRight:function(e){return i(this,e,arguments.length,arguments[1],!0)}})},function(e,t,n){"use strict";var r=n(2),i=n(446);r(r.P+r.F*!n(49)([].reduce,!0),"Array",{reduce:function(e){return i(this,e,arguments.length,arguments[1],!1)}})},function(e,t,n){"use strict";var r=n(2),i=n(452),o=n(96),s=n(136),a=n(44),l=[].slice;r(r.P+r.F*n(13)(function(){i&&l.call(i)}),"Array",{slice:function(e,t){var n=a(this.length),r=o(this);if(t=void 0===t?n:t,"Array"==r)return l.call(this,e,t);for(var i=s(e,n),c=s(t,n),u=a(c-i),p=new Array(u),d=0;d<u;d++)p[d]="String"==r?this.charAt(i+d):this[i+d];return p}})},function(e,t,n){"use strict";var r=n(2),i=n(64)(3);r(r.P+r.F*!n(49)([].some,!0),"Array",{some:function(e){return i(this,e,arguments[1])}})},function(e,t,n){"use strict";var r=n(2),i=n(95),o=n(50),s=n(13),a=[].sort,l=[1,2,3];r(r.P+r.F*(s(function(){l.sort(void 0)})||!s(function(){l.sort(null)})||!n(49)(a)),"Array",{sort:function(e){return void 0===e?a.call(o(this)):a.call(o(this),i(e))}})},function(e,t,n){n(299)("Array")},function(e,t,n){var r=n(2);r(r.S,"Date",{now:function(){return(new Date).getTime()}})},function(e,t,n){var r=n(2),i=n(678);r(r.P+r.F*(Date.prototype.toISOString!==i),"Date",{toISOString:i})},function(e,t,n){"use strict";var r=n(2),i=n(50),o=n(97);r(r.P+r.F*n(13)(function(){return null!==new Date(NaN).toJSON()||1!==Date.prototype.toJSON.call({toISOString:function(){return 1}})}),"Date",{toJSON:function(e){var t=i(this),n=o(t);return"number"!=typeof n||isFinite(n)?t.toISOString():null}})},function(e,t,n){var r=n(25)("toPrimitive"),i=Date.prototype;r in i||n(65)(i,r,n(679))},function(e,t,n){var r=Date.prototype,i="Invalid Date",o="toString",s=r[o],a=r.getTime;new Date(NaN)+""!=i&&n(39)(r,o,function(){var e=a.call(this);return e===e?s.call(this):i})},function(e,t,n){var r=n(2);r(r.P,"Function",{bind:n(447)})},function(e,t,n){"use strict";var r=n(15),i=n(67),o=n(25)("hasInstance"),s=Function.prototype;o in s||n(30).f(s,o,{value:function(e){if("function"!=typeof this||!r(e))return!1;if(!r(this.prototype))return e instanceof this;for(;e=i(e);)if(this.prototype===e)return!0;return!1}})},function(e,t,n){var r=n(30).f,i=Function.prototype,o=/^\s*function ([^ (]*)/,s="name";s in i||n(35)&&r(i,s,{configurable:!0,get:function(){try{return(""+this).match(o)[1]}catch(e){return""}}})},function(e,t,n){var r=n(2),i=n(460),o=Math.sqrt,s=Math.acosh;r(r.S+r.F*!(s&&710==Math.floor(s(Number.MAX_VALUE))&&s(1/0)==1/0),"Math",{acosh:function(e){return(e=+e)<1?NaN:e>94906265.62425156?Math.log(e)+Math.LN2:i(e-1+o(e-1)*o(e+1))}})},function(e,t,n){function asinh(e){return isFinite(e=+e)&&0!=e?e<0?-asinh(-e):Math.log(e+Math.sqrt(e*e+1)):e;
 }var r=n(2),i=Math.asinh;r(r.S+r.F*!(i&&1/i(0)>0),"Math",{asinh:asinh})},function(e,t,n){var r=n(2),i=Math.atanh;r(r.S+r.F*!(i&&1/i(-0)<0),"Math",{atanh:function(e){return 0==(e=+e)?e:Math.log((1+e)/(1-e))/2}})},function(e,t,n){var r=n(2),i=n(296);r(r.S,"Math",{cbrt:function(e){return i(e=+e)*Math.pow(Math.abs(e),1/3)}})},function(e,t,n){var r=n(2);r(r.S,"Math",{clz32:function(e){return(e>>>=0)?31-Math.floor(Math.log(e+.5)*Math.LOG2E):32}})},function(e,t,n){var r=n(2),i=Math.exp;r(r.S,"Math",{cosh:function(e){return(i(e=+e)+i(-e))/2}})},function(e,t,n){var r=n(2),i=n(295);r(r.S+r.F*(i!=Math.expm1),"Math",{expm1:i})},function(e,t,n){var r=n(2);r(r.S,"Math",{fround:n(682)})},function(e,t,n){var r=n(2),i=Math.abs;r(r.S,"Math",{hypot:function(e,t){for(var n,r,o=0,s=0,a=arguments.length,l=0;s<a;)n=i(arguments[s++]),l<n?(r=l/n,o=o*r*r+1,l=n):n>0?(r=n/l,o+=r*r):o+=n;return l===1/0?1/0:l*Math.sqrt(o)}})},function(e,t,n){var r=n(2),i=Math.imul;r(r.S+r.F*n(13)(function(){return i(4294967295,5)!=-5||2!=i.length}),"Math",{imul:function(e,t){var n=65535,r=+e,i=+t,o=n&r,s=n&i;return 0|o*s+((n&r>>>16)*s+o*(n&i>>>16)<<16>>>0)}})},function(e,t,n){var r=n(2);r(r.S,"Math",{log10:function(e){return Math.log(e)*Math.LOG10E}})},function(e,t,n){var r=n(2);r(r.S,"Math",{log1p:n(460)})},function(e,t,n){var r=n(2);r(r.S,"Math",{log2:function(e){return Math.log(e)/Math.LN2}})},function(e,t,n){var r=n(2);r(r.S,"Math",{sign:n(296)})},function(e,t,n){var r=n(2),i=n(295),o=Math.exp;r(r.S+r.F*n(13)(function(){return!Math.sinh(-2e-17)!=-2e-17}),"Math",{sinh:function(e){return Math.abs(e=+e)<1?(i(e)-i(-e))/2:(o(e-1)-o(-e-1))*(Math.E/2)}})},function(e,t,n){var r=n(2),i=n(295),o=Math.exp;r(r.S,"Math",{tanh:function(e){var t=i(e=+e),n=i(-e);return t==1/0?1:n==1/0?-1:(t-n)/(o(e)+o(-e))}})},function(e,t,n){var r=n(2);r(r.S,"Math",{trunc:function(e){return(e>0?Math.floor:Math.ceil)(e)}})},function(e,t,n){"use strict";var r=n(26),i=n(48),o=n(96),s=n(291),a=n(97),l=n(13),c=n(135).f,u=n(77).f,p=n(30).f,d=n(200).trim,h="Number",f=r[h],m=f,g=f.prototype,y=o(n(107)(g))==h,v="trim"in String.prototype,b=function(e){var t=a(e,!1);if("string"==typeof t&&t.length>2){t=v?t.trim():d(t,3);var n,r,i,o=t.charCodeAt(0);if(43===o||45===o){if(n=t.charCodeAt(2),88===n||120===n)return NaN}else if(48===o){switch(t.charCodeAt(1)){case 66:case 98:r=2,i=49;break;case 79:case 111:r=8,i=55;break;default:return+t}for(var s,l=t.slice(2),c=0,u=l.length;c<u;c++)if(s=l.charCodeAt(c),s<48||s>i)return NaN;return parseInt(l,r)}}return+t};if(!f(" 0o1")||!f("0b1")||f("+0x1")){f=function(e){var t=arguments.length<1?0:e,n=this;return n instanceof f&&(y?l(function(){g.valueOf.call(n)}):o(n)!=h)?s(new m(b(t)),n,f):b(t)};for(var _,w=n(35)?c(m):"MAX_VALUE,MIN_VALUE,NaN,NEGATIVE_INFINITY,POSITIVE_INFINITY,EPSILON,isFinite,isInteger,isNaN,isSafeInteger,MAX_SAFE_INTEGER,MIN_SAFE_INTEGER,parseFloat,parseInt,isInteger".split(","),S=0;w.length>S;S++)i(m,_=w[S])&&!i(f,_)&&p(f,_,u(m,_));f.prototype=g,g.constructor=f,n(39)(r,h,f)}},function(e,t,n){var r=n(2);r(r.S,"Number",{EPSILON:Math.pow(2,-52)})},function(e,t,n){var r=n(2),i=n(26).isFinite;r(r.S,"Number",{isFinite:function(e){return"number"==typeof e&&i(e)}})},function(e,t,n){var r=n(2);r(r.S,"Number",{isInteger:n(455)})},function(e,t,n){var r=n(2);r(r.S,"Number",{isNaN:function(e){return e!=e}})},function(e,t,n){var r=n(2),i=n(455),o=Math.abs;r(r.S,"Number",{isSafeInteger:function(e){return i(e)&&o(e)<=9007199254740991}})},function(e,t,n){var r=n(2);r(r.S,"Number",{MAX_SAFE_INTEGER:9007199254740991})},function(e,t,n){var r=n(2);r(r.S,"Number",{MIN_SAFE_INTEGER:-9007199254740991})},function(e,t,n){var r=n(2),i=n(465);r(r.S+r.F*(Number.parseFloat!=i),"Number",{parseFloat:i})},function(e,t,n){var r=n(2),i=n(466);r(r.S+r.F*(Number.parseInt!=i),"Number",{parseInt:i})},function(e,t,n){"use strict";var r=n(2),i=n(110),o=n(444),s=n(468),a=1..toFixed,l=Math.floor,c=[0,0,0,0,0,0],u="Number.toFixed: incorrect invocation!",p="0",d=function(e,t){for(var n=-1,r=t;++n<6;)r+=e*c[n],c[n]=r%1e7,r=l(r/1e7)},h=function(e){for(var t=6,n=0;--t>=0;)n+=c[t],c[t]=l(n/e),n=n%e*1e7},f=function(){for(var e=6,t="";--e>=0;)if(""!==t||0===e||0!==c[e]){var n=String(c[e]);t=""===t?n:t+s.call(p,7-n.length)+n}return t},m=function(e,t,n){return 0===t?n:t%2===1?m(e,t-1,n*e):m(e*e,t/2,n)},g=function(e){for(var t=0,n=e;n>=4096;)t+=12,n/=4096;for(;n>=2;)t+=1,n/=2;return t};r(r.P+r.F*(!!a&&("0.000"!==8e-5.toFixed(3)||"1"!==.9.toFixed(0)||"1.25"!==1.255.toFixed(2)||"1000000000000000128"!==(0xde0b6b3a7640080).toFixed(0))||!n(13)(function(){a.call({})})),"Number",{toFixed:function(e){var t,n,r,a,l=o(this,u),c=i(e),y="",v=p;if(c<0||c>20)throw RangeError(u);if(l!=l)return"NaN";if(l<=-1e21||l>=1e21)return String(l);if(l<0&&(y="-",l=-l),l>1e-21)if(t=g(l*m(2,69,1))-69,n=t<0?l*m(2,-t,1):l/m(2,t,1),n*=4503599627370496,t=52-t,t>0){for(d(0,n),r=c;r>=7;)d(1e7,0),r-=7;for(d(m(10,r,1),0),r=t-1;r>=23;)h(1<<23),r-=23;h(1<<r),d(1,1),h(2),v=f()}else d(0,n),d(1<<-t,0),v=f()+s.call(p,c);return c>0?(a=v.length,v=y+(a<=c?"0."+s.call(p,c-a)+v:v.slice(0,a-c)+"."+v.slice(a-c))):v=y+v,v}})},function(e,t,n){"use strict";var r=n(2),i=n(13),o=n(444),s=1..toPrecision;r(r.P+r.F*(i(function(){return"1"!==s.call(1,void 0)})||!i(function(){s.call({})})),"Number",{toPrecision:function(e){var t=o(this,"Number#toPrecision: incorrect invocation!");return void 0===e?s.call(t):s.call(t,e)}})},function(e,t,n){var r=n(2);r(r.S+r.F,"Object",{assign:n(461)})},function(e,t,n){var r=n(2);r(r.S,"Object",{create:n(107)})},function(e,t,n){var r=n(2);r(r.S+r.F*!n(35),"Object",{defineProperties:n(462)})},function(e,t,n){var r=n(2);r(r.S+r.F*!n(35),"Object",{defineProperty:n(30).f})},function(e,t,n){var r=n(15),i=n(76).onFreeze;n(56)("freeze",function(e){return function(t){return e&&r(t)?e(i(t)):t}})},function(e,t,n){var r=n(57),i=n(77).f;n(56)("getOwnPropertyDescriptor",function(){return function(e,t){return i(r(e),t)}})},function(e,t,n){n(56)("getOwnPropertyNames",function(){return n(463).f})},function(e,t,n){var r=n(50),i=n(67);n(56)("getPrototypeOf",function(){return function(e){return i(r(e))}})},function(e,t,n){var r=n(15);n(56)("isExtensible",function(e){return function(t){return!!r(t)&&(!e||e(t))}})},function(e,t,n){var r=n(15);n(56)("isFrozen",function(e){return function(t){return!r(t)||!!e&&e(t)}})},function(e,t,n){var r=n(15);n(56)("isSealed",function(e){return function(t){return!r(t)||!!e&&e(t)}})},function(e,t,n){var r=n(2);r(r.S,"Object",{is:n(684)})},function(e,t,n){var r=n(50),i=n(108);n(56)("keys",function(){return function(e){return i(r(e))}})},function(e,t,n){var r=n(15),i=n(76).onFreeze;n(56)("preventExtensions",function(e){return function(t){return e&&r(t)?e(i(t)):t}})},function(e,t,n){var r=n(15),i=n(76).onFreeze;n(56)("seal",function(e){return function(t){return e&&r(t)?e(i(t)):t}})},function(e,t,n){var r=n(2);r(r.S,"Object",{setPrototypeOf:n(298).set})},function(e,t,n){var r=n(2),i=n(465);r(r.G+r.F*(parseFloat!=i),{parseFloat:i})},function(e,t,n){var r=n(2),i=n(466);r(r.G+r.F*(parseInt!=i),{parseInt:i})},function(e,t,n){var r=n(2),i=n(95),o=n(8),s=(n(26).Reflect||{}).apply,a=Function.apply;r(r.S+r.F*!n(13)(function(){s(function(){})}),"Reflect",{apply:function(e,t,n){var r=i(e),l=o(n);return s?s(r,t,l):a.call(r,t,l)}})},function(e,t,n){var r=n(2),i=n(107),o=n(95),s=n(8),a=n(15),l=n(13),c=n(447),u=(n(26).Reflect||{}).construct,p=l(function(){function F(){}return!(u(function(){},[],F)instanceof F)}),d=!l(function(){u(function(){})});r(r.S+r.F*(p||d),"Reflect",{construct:function(e,t){o(e),s(t);var n=arguments.length<3?e:o(arguments[2]);if(d&&!p)return u(e,t,n);if(e==n){switch(t.length){case 0:return new e;case 1:return new e(t[0]);case 2:return new e(t[0],t[1]);case 3:return new e(t[0],t[1],t[2]);case 4:return new e(t[0],t[1],t[2],t[3])}var r=[null];return r.push.apply(r,t),new(c.apply(e,r))}var l=n.prototype,h=i(a(l)?l:Object.prototype),f=Function.apply.call(e,h,t);return a(f)?f:h}})},function(e,t,n){var r=n(30),i=n(2),o=n(8),s=n(97);i(i.S+i.F*n(13)(function(){Reflect.defineProperty(r.f({},1,{value:1}),1,{value:2})}),"Reflect",{defineProperty:function(e,t,n){o(e),t=s(t,!0),o(n);try{return r.f(e,t,n),!0}catch(i){return!1}}})},function(e,t,n){var r=n(2),i=n(77).f,o=n(8);r(r.S,"Reflect",{deleteProperty:function(e,t){var n=i(o(e),t);return!(n&&!n.configurable)&&delete e[t]}})},function(e,t,n){"use strict";var r=n(2),i=n(8),o=function(e){this._t=i(e),this._i=0;var t,n=this._k=[];for(t in e)n.push(t)};n(457)(o,"Object",function(){var e,t=this,n=t._k;do if(t._i>=n.length)return{value:void 0,done:!0};while(!((e=n[t._i++])in t._t));return{value:e,done:!1}}),r(r.S,"Reflect",{enumerate:function(e){return new o(e)}})},function(e,t,n){var r=n(77),i=n(2),o=n(8);i(i.S,"Reflect",{getOwnPropertyDescriptor:function(e,t){return r.f(o(e),t)}})},function(e,t,n){var r=n(2),i=n(67),o=n(8);r(r.S,"Reflect",{getPrototypeOf:function(e){return i(o(e))}})},function(e,t,n){function get(e,t){var n,s,c=arguments.length<3?e:arguments[2];return l(e)===c?e[t]:(n=r.f(e,t))?o(n,"value")?n.value:void 0!==n.get?n.get.call(c):void 0:a(s=i(e))?get(s,t,c):void 0}var r=n(77),i=n(67),o=n(48),s=n(2),a=n(15),l=n(8);s(s.S,"Reflect",{get:get})},function(e,t,n){var r=n(2);r(r.S,"Reflect",{has:function(e,t){return t in e}})},function(e,t,n){var r=n(2),i=n(8),o=Object.isExtensible;r(r.S,"Reflect",{isExtensible:function(e){return i(e),!o||o(e)}})},function(e,t,n){var r=n(2);r(r.S,"Reflect",{ownKeys:n(683)})},function(e,t,n){var r=n(2),i=n(8),o=Object.preventExtensions;r(r.S,"Reflect",{preventExtensions:function(e){i(e);try{return o&&o(e),!0}catch(t){return!1}}})},function(e,t,n){var r=n(2),i=n(298);i&&r(r.S,"Reflect",{setPrototypeOf:function(e,t){i.check(e,t);try{return i.set(e,t),!0}catch(n){return!1}}})},function(e,t,n){function set(e,t,n){var a,p,d=arguments.length<4?e:arguments[3],h=i.f(c(e),t);if(!h){if(u(p=o(e)))return set(p,t,n,d);h=l(0)}if(s(h,"value")){if(h.writable===!1||!u(d))return!1;if(a=i.f(d,t)){if(a.get||a.set||a.writable===!1)return!1;a.value=n,r.f(d,t,a)}else r.f(d,t,l(0,n));return!0}return void 0!==h.set&&(h.set.call(d,n),!0)}var r=n(30),i=n(77),o=n(67),s=n(48),a=n(2),l=n(109),c=n(8),u=n(15);a(a.S,"Reflect",{set:set})},function(e,t,n){var r=n(26),i=n(291),o=n(30).f,s=n(135).f,a=n(293),l=n(290),c=r.RegExp,u=c,p=c.prototype,d=/a/g,h=/a/g,f=new c(d)!==d;if(n(35)&&(!f||n(13)(function(){return h[n(25)("match")]=!1,c(d)!=d||c(h)==h||"/a/i"!=c(d,"i")}))){c=function(e,t){var n=this instanceof c,r=a(e),o=void 0===t;return!n&&r&&e.constructor===c&&o?e:i(f?new u(r&&!o?e.source:e,t):u((r=e instanceof c)?e.source:e,r&&o?l.call(e):t),n?this:p,c)};for(var m=(function(e){e in c||o(c,e,{configurable:!0,get:function(){return u[e]},set:function(t){u[e]=t}})}),g=s(u),y=0;g.length>y;)m(g[y++]);p.constructor=c,c.prototype=p,n(39)(r,"RegExp",c)}n(299)("RegExp")},function(e,t,n){"use strict";n(473);var r=n(8),i=n(290),o=n(35),s="toString",a=/./[s],l=function(e){n(39)(RegExp.prototype,s,e,!0)};n(13)(function(){return"/a/b"!=a.call({source:"a",flags:"b"})})?l(function(){var e=r(this);return"/".concat(e.source,"/","flags"in e?e.flags:!o&&e instanceof RegExp?i.call(e):void 0)}):a.name!=s&&l(function(){return a.call(this)})},function(e,t,n){"use strict";n(40)("anchor",function(e){return function(t){return e(this,"a","name",t)}})},function(e,t,n){"use strict";n(40)("big",function(e){return function(){return e(this,"big","","")}})},function(e,t,n){"use strict";n(40)("blink",function(e){return function(){return e(this,"blink","","")}})},function(e,t,n){"use strict";n(40)("bold",function(e){return function(){return e(this,"b","","")}})},function(e,t,n){"use strict";var r=n(2),i=n(467)(!1);r(r.P,"String",{codePointAt:function(e){return i(this,e)}})},function(e,t,n){"use strict";var r=n(2),i=n(44),o=n(301),s="endsWith",a=""[s];r(r.P+r.F*n(289)(s),"String",{endsWith:function(e){var t=o(this,e,s),n=arguments.length>1?arguments[1]:void 0,r=i(t.length),l=void 0===n?r:Math.min(i(n),r),c=String(e);return a?a.call(t,c,l):t.slice(l-c.length,l)===c}})},function(e,t,n){"use strict";n(40)("fixed",function(e){return function(){return e(this,"tt","","")}})},function(e,t,n){"use strict";n(40)("fontcolor",function(e){return function(t){return e(this,"font","color",t)}})},function(e,t,n){"use strict";n(40)("fontsize",function(e){return function(t){return e(this,"font","size",t)}})},function(e,t,n){var r=n(2),i=n(136),o=String.fromCharCode,s=String.fromCodePoint;r(r.S+r.F*(!!s&&1!=s.length),"String",{fromCodePoint:function(e){for(var t,n=[],r=arguments.length,s=0;r>s;){if(t=+arguments[s++],i(t,1114111)!==t)throw RangeError(t+" is not a valid code point");n.push(t<65536?o(t):o(((t-=65536)>>10)+55296,t%1024+56320))}return n.join("")}})},function(e,t,n){"use strict";var r=n(2),i=n(301),o="includes";r(r.P+r.F*n(289)(o),"String",{includes:function(e){return!!~i(this,e,o).indexOf(e,arguments.length>1?arguments[1]:void 0)}})},function(e,t,n){"use strict";n(40)("italics",function(e){return function(){return e(this,"i","","")}})},function(e,t,n){"use strict";n(40)("link",function(e){return function(t){return e(this,"a","href",t)}})},function(e,t,n){var r=n(2),i=n(57),o=n(44);r(r.S,"String",{raw:function(e){for(var t=i(e.raw),n=o(t.length),r=arguments.length,s=[],a=0;n>a;)s.push(String(t[a++])),a<r&&s.push(String(arguments[a]));return s.join("")}})},function(e,t,n){var r=n(2);r(r.P,"String",{repeat:n(468)})},function(e,t,n){"use strict";n(40)("small",function(e){return function(){return e(this,"small","","")}})},function(e,t,n){"use strict";var r=n(2),i=n(44),o=n(301),s="startsWith",a=""[s];r(r.P+r.F*n(289)(s),"String",{startsWith:function(e){var t=o(this,e,s),n=i(Math.min(arguments.length>1?arguments[1]:void 0,t.length)),r=String(e);return a?a.call(t,r,n):t.slice(n,n+r.length)===r}})},function(e,t,n){"use strict";n(40)("strike",function(e){return function(){return e(this,"strike","","")}})},function(e,t,n){"use strict";n(40)("sub",function(e){return function(){return e(this,"sub","","")}})},function(e,t,n){"use strict";n(40)("sup",function(e){return function(){return e(this,"sup","","")}})},function(e,t,n){"use strict";n(200)("trim",function(e){return function(){return e(this,3)}})},function(e,t,n){"use strict";var r,i=n(64)(0),o=n(39),s=n(76),a=n(461),l=n(677),c=n(15),u=n(13),p=n(138),d="WeakMap",h=s.getWeak,f=Object.isExtensible,m=l.ufstore,g={},y=function(e){return function(){return e(this,arguments.length>0?arguments[0]:void 0)}},v={get:function(e){if(c(e)){var t=h(e);return t===!0?m(p(this,d)).get(e):t?t[this._i]:void 0}},set:function(e,t){return l.def(p(this,d),e,t)}},b=e.exports=n(287)(d,y,v,l,!0,!0);u(function(){return 7!=(new b).set((Object.freeze||Object)(g),7).get(g)})&&(r=l.getConstructor(y,d),a(r.prototype,v),s.NEED=!0,i(["delete","has","get","set"],function(e){var t=b.prototype,n=t[e];o(t,e,function(t,i){if(c(t)&&!f(t)){this._f||(this._f=new r);var o=this._f[e](t,i);return"set"==e?this:o}return n.call(this,t,i)})}))},function(e,t,n){var r=n(66),i=n(8),o=r.key,s=r.set;r.exp({defineMetadata:function(e,t,n,r){s(e,t,i(n),o(r))}})},function(e,t,n){var r=n(66),i=n(8),o=r.key,s=r.map,a=r.store;r.exp({deleteMetadata:function(e,t){var n=arguments.length<3?void 0:o(arguments[2]),r=s(i(t),n,!1);if(void 0===r||!r.delete(e))return!1;if(r.size)return!0;var l=a.get(t);return l.delete(n),!!l.size||a.delete(t)}})},function(e,t,n){var r=n(478),i=n(674),o=n(66),s=n(8),a=n(67),l=o.keys,c=o.key,u=function(e,t){var n=l(e,t),o=a(e);if(null===o)return n;var s=u(o,t);return s.length?n.length?i(new r(n.concat(s))):s:n};o.exp({getMetadataKeys:function(e){return u(s(e),arguments.length<2?void 0:c(arguments[1]))}})},function(e,t,n){var r=n(66),i=n(8),o=n(67),s=r.has,a=r.get,l=r.key,c=function(e,t,n){var r=s(e,t,n);if(r)return a(e,t,n);var i=o(t);return null!==i?c(e,i,n):void 0};r.exp({getMetadata:function(e,t){return c(e,i(t),arguments.length<3?void 0:l(arguments[2]))}})},function(e,t,n){var r=n(66),i=n(8),o=r.keys,s=r.key;r.exp({getOwnMetadataKeys:function(e){return o(i(e),arguments.length<2?void 0:s(arguments[1]))}})},function(e,t,n){var r=n(66),i=n(8),o=r.get,s=r.key;r.exp({getOwnMetadata:function(e,t){return o(e,i(t),arguments.length<3?void 0:s(arguments[2]))}})},function(e,t,n){var r=n(66),i=n(8),o=n(67),s=r.has,a=r.key,l=function(e,t,n){var r=s(e,t,n);if(r)return!0;var i=o(t);return null!==i&&l(e,i,n)};r.exp({hasMetadata:function(e,t){return l(e,i(t),arguments.length<3?void 0:a(arguments[2]))}})},function(e,t,n){var r=n(66),i=n(8),o=r.has,s=r.key;r.exp({hasOwnMetadata:function(e,t){return o(e,i(t),arguments.length<3?void 0:s(arguments[2]))}})},function(e,t,n){var r=n(66),i=n(8),o=n(95),s=r.key,a=r.set;r.exp({metadata:function(e,t){return function(n,r){a(e,t,(void 0!==r?i:o)(n),s(r))}}})},,,,,,,function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(19),l=function(){function Accordion(e){this.el=e,this.onClose=new o.EventEmitter,this.onOpen=new o.EventEmitter,this.tabs=[]}return Accordion.prototype.addTab=function(e){this.tabs.push(e)},r([o.Input(),i("design:type",Boolean)],Accordion.prototype,"multiple",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Accordion.prototype,"onClose",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Accordion.prototype,"onOpen",void 0),r([o.Input(),i("design:type",Object)],Accordion.prototype,"style",void 0),r([o.Input(),i("design:type",String)],Accordion.prototype,"styleClass",void 0),Accordion=r([o.Component({selector:"p-accordion",template:'\n        <div [ngClass]="\'ui-accordion ui-widget ui-helper-reset\'" [ngStyle]="style" [class]="styleClass">\n            <ng-content></ng-content>\n        </div>\n    '}),i("design:paramtypes",[o.ElementRef])],Accordion)}();t.Accordion=l;var c=function(){function AccordionTab(e){this.accordion=e,this.accordion.addTab(this)}return AccordionTab.prototype.toggle=function(e){if(this.disabled)return void e.preventDefault();var t=this.findTabIndex();if(this.selected)this.selected=!this.selected,this.accordion.onClose.emit({originalEvent:e,index:t});else{if(!this.accordion.multiple)for(var n=0;n<this.accordion.tabs.length;n++)this.accordion.tabs[n].selected=!1;this.selected=!0,this.accordion.onOpen.emit({originalEvent:e,index:t})}e.preventDefault()},AccordionTab.prototype.findTabIndex=function(){for(var e=-1,t=0;t<this.accordion.tabs.length;t++)if(this.accordion.tabs[t]==this){e=t;break}return e},r([o.Input(),i("design:type",String)],AccordionTab.prototype,"header",void 0),r([o.Input(),i("design:type",Boolean)],AccordionTab.prototype,"selected",void 0),r([o.Input(),i("design:type",Boolean)],AccordionTab.prototype,"disabled",void 0),r([o.ContentChild(a.Header),i("design:type",Object)],AccordionTab.prototype,"headerFacet",void 0),AccordionTab=r([o.Component({selector:"p-accordionTab",template:'\n        <div class="ui-accordion-header ui-helper-reset ui-state-default" [ngClass]="{\'ui-state-active\': selected,\'ui-state-hover\':hover&&!disabled,\'ui-state-disabled\':disabled}"\n            (click)="toggle($event)" (mouseenter)="hover = true" (mouseleave)="hover=false">\n            <span class="fa fa-fw" [ngClass]="{\'fa-caret-down\': selected, \'fa-caret-right\': !selected}"></span>\n            <a href="#" *ngIf="!headerFacet">{{header}}</a>\n            <a href="#" *ngIf="headerFacet">\n                <ng-content select="header"></ng-content>\n            </a>\n        </div>\n        <div class="ui-accordion-content ui-helper-reset ui-widget-content" [style.display]="selected ? \'block\' : \'none\'">\n            <ng-content></ng-content>\n        </div>\n    '}),i("design:paramtypes",[l])],AccordionTab)}();t.AccordionTab=c;var u=function(){function AccordionModule(){}return AccordionModule=r([o.NgModule({imports:[s.CommonModule],exports:[l,c],declarations:[l,c]}),i("design:paramtypes",[])],AccordionModule)}();t.AccordionModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(204),l=n(139),c=n(19),u=n(9),p=n(22),d=new o.Provider(p.NG_VALUE_ACCESSOR,{useExisting:o.forwardRef(function(){return h}),multi:!0}),h=function(){function AutoComplete(e,t,n,r){this.el=e,this.domHandler=t,this.renderer=r,this.minLength=3,this.delay=300,this.completeMethod=new o.EventEmitter,this.onSelect=new o.EventEmitter,this.onUnselect=new o.EventEmitter,this.onDropdownClick=new o.EventEmitter,this.scrollHeight="200px",this.onModelChange=function(){},this.onModelTouched=function(){},this.panelVisible=!1,this.differ=n.find([]).create(null)}return AutoComplete.prototype.ngDoCheck=function(){var e=this.differ.diff(this.suggestions);e&&this.panel&&(this.suggestions&&this.suggestions.length?(this.show(),this.suggestionsUpdated=!0):this.hide())},AutoComplete.prototype.ngAfterViewInit=function(){var e=this;this.input=this.domHandler.findSingle(this.el.nativeElement,"input"),this.panel=this.domHandler.findSingle(this.el.nativeElement,"div.ui-autocomplete-panel"),this.multiple&&(this.multipleContainer=this.domHandler.findSingle(this.el.nativeElement,"ul.ui-autocomplete-multiple")),this.documentClickListener=this.renderer.listenGlobal("body","click",function(){e.hide()})},AutoComplete.prototype.ngAfterViewChecked=function(){if(this.suggestionsUpdated&&(this.align(),this.suggestionsUpdated=!1),this.highlightOptionChanged){var e=this.domHandler.findSingle(this.panel,"li.ui-state-highlight");e&&this.domHandler.scrollInView(this.panel,e),this.highlightOptionChanged=!1}},AutoComplete.prototype.writeValue=function(e){this.value=e},AutoComplete.prototype.registerOnChange=function(e){this.onModelChange=e},AutoComplete.prototype.registerOnTouched=function(e){this.onModelTouched=e},AutoComplete.prototype.onInput=function(e){var t=this,n=e.target.value;this.multiple||(this.value=n,this.onModelChange(n)),0===n.length&&this.hide(),n.length>=this.minLength?(this.timeout&&clearTimeout(this.timeout),this.timeout=setTimeout(function(){t.search(e,n)},this.delay)):this.suggestions=null},AutoComplete.prototype.search=function(e,t){void 0!==t&&null!==t&&this.completeMethod.emit({originalEvent:e,query:t})},AutoComplete.prototype.selectItem=function(e){this.multiple?(this.input.value="",this.value=this.value||[],this.isSelected(e)||(this.value.push(e),this.onModelChange(this.value))):(this.input.value=this.field?this.resolveFieldData(e):e,this.value=e,this.onModelChange(this.value)),this.onSelect.emit(e),this.input.focus()},AutoComplete.prototype.show=function(){this.panelVisible||(this.panelVisible=!0,this.panel.style.zIndex=++u.DomHandler.zindex,this.domHandler.fadeIn(this.panel,200))},AutoComplete.prototype.align=function(){this.multiple?this.domHandler.relativePosition(this.panel,this.multipleContainer):this.domHandler.relativePosition(this.panel,this.input)},AutoComplete.prototype.hide=function(){this.panelVisible=!1},AutoComplete.prototype.handleDropdownClick=function(e){this.onDropdownClick.emit({originalEvent:e,query:this.input.value})},AutoComplete.prototype.removeItem=function(e){var t=this.domHandler.index(e),n=this.value.splice(t,1)[0];this.onUnselect.emit(n),this.onModelChange(this.value)},AutoComplete.prototype.resolveFieldData=function(e){if(e&&this.field){if(this.field.indexOf(".")==-1)return e[this.field];for(var t=this.field.split("."),n=e,r=0,i=t.length;r<i;++r)n=n[t[r]];return n}return null},AutoComplete.prototype.onKeydown=function(e){if(this.panelVisible){var t=this.findOptionIndex(this.highlightOption);switch(e.which){case 40:if(t!=-1){var n=t+1;n!=this.suggestions.length&&(this.highlightOption=this.suggestions[n],this.highlightOptionChanged=!0)}else this.highlightOption=this.suggestions[0];e.preventDefault();break;case 38:if(t>0){var r=t-1;this.highlightOption=this.suggestions[r],this.highlightOptionChanged=!0}e.preventDefault();break;case 13:this.highlightOption&&(this.selectItem(this.highlightOption),this.hide()),e.preventDefault();break;case 27:this.hide(),e.preventDefault();break;case 9:this.highlightOption&&this.selectItem(this.highlightOption),this.hide()}}if(this.multiple)switch(e.which){case 8:if(this.value&&this.value.length&&!this.input.value){var i=this.value.pop();this.onUnselect.emit(i),this.onModelChange(this.value)}}},AutoComplete.prototype.isSelected=function(e){var t=!1;if(this.value&&this.value.length)for(var n=0;n<this.value.length;n++)if(this.domHandler.equals(this.value[n],e)){t=!0;break}return t},AutoComplete.prototype.findOptionIndex=function(e){var t=-1;if(this.suggestions)for(var n=0;n<this.suggestions.length;n++)if(this.domHandler.equals(e,this.suggestions[n])){t=n;break}return t},AutoComplete.prototype.ngOnDestroy=function(){this.documentClickListener&&this.documentClickListener()},r([o.Input(),i("design:type",Number)],AutoComplete.prototype,"minLength",void 0),r([o.Input(),i("design:type",Number)],AutoComplete.prototype,"delay",void 0),r([o.Input(),i("design:type",Object)],AutoComplete.prototype,"style",void 0),r([o.Input(),i("design:type",String)],AutoComplete.prototype,"styleClass",void 0),r([o.Input(),i("design:type",Object)],AutoComplete.prototype,"inputStyle",void 0),r([o.Input(),i("design:type",String)],AutoComplete.prototype,"inputStyleClass",void 0),r([o.Input(),i("design:type",String)],AutoComplete.prototype,"placeholder",void 0),r([o.Input(),i("design:type",Number)],AutoComplete.prototype,"readonly",void 0),r([o.Input(),i("design:type",Boolean)],AutoComplete.prototype,"disabled",void 0),r([o.Input(),i("design:type",Number)],AutoComplete.prototype,"maxlength",void 0),r([o.Input(),i("design:type",Number)],AutoComplete.prototype,"size",void 0),r([o.Input(),i("design:type",Array)],AutoComplete.prototype,"suggestions",void 0),r([o.Output(),i("design:type",o.EventEmitter)],AutoComplete.prototype,"completeMethod",void 0),r([o.Output(),i("design:type",o.EventEmitter)],AutoComplete.prototype,"onSelect",void 0),r([o.Output(),i("design:type",o.EventEmitter)],AutoComplete.prototype,"onUnselect",void 0),r([o.Output(),i("design:type",o.EventEmitter)],AutoComplete.prototype,"onDropdownClick",void 0),r([o.Input(),i("design:type",String)],AutoComplete.prototype,"field",void 0),r([o.Input(),i("design:type",String)],AutoComplete.prototype,"scrollHeight",void 0),r([o.Input(),i("design:type",Boolean)],AutoComplete.prototype,"dropdown",void 0),r([o.Input(),i("design:type",Boolean)],AutoComplete.prototype,"multiple",void 0),r([o.ContentChild(o.TemplateRef),i("design:type",o.TemplateRef)],AutoComplete.prototype,"itemTemplate",void 0),AutoComplete=r([o.Component({selector:"p-autoComplete",template:'\n        <span [ngClass]="{\'ui-autocomplete ui-widget\':true,\'ui-autocomplete-dd\':dropdown}" [ngStyle]="style" [class]="styleClass">\n            <input *ngIf="!multiple" #in pInputText type="text" [ngStyle]="inputStyle" [class]="inputStyleClass" \n            [value]="value ? (field ? resolveFieldData(value)||value : value) : null" (input)="onInput($event)" (keydown)="onKeydown($event)" (blur)="onModelTouched()"\n            [attr.placeholder]="placeholder" [attr.size]="size" [attr.maxlength]="maxlength" [attr.readonly]="readonly" [disabled]="disabled" \n            ><ul *ngIf="multiple" class="ui-autocomplete-multiple ui-widget ui-inputtext ui-state-default ui-corner-all" (click)="multiIn.focus()">\n                <li #token *ngFor="let val of value" class="ui-autocomplete-token ui-state-highlight ui-corner-all">\n                    <span class="ui-autocomplete-token-icon fa fa-fw fa-close" (click)="removeItem(token)"></span>\n                    <span class="ui-autocomplete-token-label">{{field ? val[field] : val}}</span>\n                </li>\n                <li class="ui-autocomplete-input-token">\n                    <input #multiIn type="text" pInputText (input)="onInput($event)" (keydown)="onKeydown($event)" (blur)="onModelTouched()">\n                </li>\n            </ul\n            ><button type="button" pButton icon="fa-fw fa-caret-down" class="ui-autocomplete-dropdown" [disabled]="disabled"\n                (click)="handleDropdownClick($event)" *ngIf="dropdown"></button>\n            <div class="ui-autocomplete-panel ui-widget-content ui-corner-all ui-shadow" [style.display]="panelVisible ? \'block\' : \'none\'" [style.width]="\'100%\'" [style.max-height]="scrollHeight">\n                <ul class="ui-autocomplete-items ui-autocomplete-list ui-widget-content ui-widget ui-corner-all ui-helper-reset">\n                    <li *ngFor="let option of suggestions" [ngClass]="{\'ui-autocomplete-list-item ui-corner-all\':true,\'ui-state-highlight\':(highlightOption==option)}"\n                        (mouseenter)="highlightOption=option" (mouseleave)="highlightOption=null" (click)="selectItem(option)">\n                        <span *ngIf="!itemTemplate">{{field ? option[field] : option}}</span>\n                        <template *ngIf="itemTemplate" [pTemplateWrapper]="itemTemplate" [item]="option"></template>\n                    </li>\n                </ul>\n            </div>\n        </span>\n    ',providers:[u.DomHandler,d]}),i("design:paramtypes",[o.ElementRef,u.DomHandler,o.IterableDiffers,o.Renderer])],AutoComplete)}();t.AutoComplete=h;var f=function(){function AutoCompleteModule(){}return AutoCompleteModule=r([o.NgModule({imports:[s.CommonModule,a.InputTextModule,l.ButtonModule,c.SharedModule],exports:[h,c.SharedModule],declarations:[h]}),i("design:paramtypes",[])],AutoCompleteModule)}();t.AutoCompleteModule=f},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(29),l=function(){function Breadcrumb(e){this.router=e}return Breadcrumb.prototype.itemClick=function(e,t){t.url&&!t.routerLink||e.preventDefault(),t.command&&(t.eventEmitter||(t.eventEmitter=new o.EventEmitter,t.eventEmitter.subscribe(t.command)),t.eventEmitter.emit(e)),t.routerLink&&this.router.navigate(t.routerLink)},Breadcrumb.prototype.ngOnDestroy=function(){if(this.model)for(var e=0,t=this.model;e<t.length;e++){var n=t[e];n.eventEmitter&&n.eventEmitter.unsubscribe()}},r([o.Input(),i("design:type",Array)],Breadcrumb.prototype,"model",void 0),r([o.Input(),i("design:type",Object)],Breadcrumb.prototype,"style",void 0),r([o.Input(),i("design:type",String)],Breadcrumb.prototype,"styleClass",void 0),Breadcrumb=r([o.Component({selector:"p-breadcrumb",template:'\n        <div [class]="styleClass" [ngStyle]="style" [ngClass]="\'ui-breadcrumb ui-widget ui-widget-header ui-helper-clearfix ui-corner-all\'">\n            <ul>\n                <li class="fa fa-home"></li>\n                <template ngFor let-item let-end="last" [ngForOf]="model">\n                    <li role="menuitem">\n                        <a [href]="item.url||\'#\'" class="ui-menuitem-link" (click)="itemClick($event, item)">\n                            <span class="ui-menuitem-text">{{item.label}}</span>\n                        </a>\n                    </li>\n                    <li class="ui-breadcrumb-chevron fa fa-chevron-right" *ngIf="!end"></li>\n                </template>\n            </ul>\n        </div>\n    '
 }),i("design:paramtypes",[a.Router])],Breadcrumb)}();t.Breadcrumb=l;var c=function(){function BreadcrumbModule(){}return BreadcrumbModule=r([o.NgModule({imports:[s.CommonModule],exports:[l],declarations:[l]}),i("design:paramtypes",[])],BreadcrumbModule)}();t.BreadcrumbModule=c},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(139),l=n(22),c=new o.Provider(l.NG_VALUE_ACCESSOR,{useExisting:o.forwardRef(function(){return u}),multi:!0}),u=function(){function Calendar(e,t){this.el=e,this.zone=t,this.inline=!1,this.stepHour=1,this.stepMinute=1,this.stepSecond=1,this.hourMin=0,this.hourMax=23,this.minuteMin=0,this.minuteMax=59,this.secondMin=0,this.secondMax=59,this.hourGrid=0,this.minuteGrid=0,this.secondGrid=0,this.icon="fa-calendar",this.onBlur=new o.EventEmitter,this.onSelect=new o.EventEmitter,this.onModelChange=function(){},this.onModelTouched=function(){},this.initialized=!1}return Calendar.prototype.ngAfterViewInit=function(){var e=this;this.calendarElement=this.inline?jQuery(this.el.nativeElement.children[0]):jQuery(this.el.nativeElement.children[0].children[0]);var t={showAnim:this.showAnim,dateFormat:this.dateFormat,showButtonPanel:this.showButtonPanel,changeMonth:this.monthNavigator,changeYear:this.yearNavigator,numberOfMonths:this.numberOfMonths,showWeek:this.showWeek,showOtherMonths:this.showOtherMonths,selectOtherMonths:this.selectOtherMonths,defaultDate:this.defaultDate,minDate:this.minDate,maxDate:this.maxDate,yearRange:this.yearRange,onSelect:function(t){e.zone.run(function(){e.value=t,e.onModelChange(e.value),e.onSelect.emit(e.value)})}};if(this.locale)for(var n in this.locale)t[n]=this.locale[n];this.timeFormat||this.timeOnly?(t.timeFormat=this.timeFormat,t.timeOnly=this.timeOnly,t.stepHour=this.stepHour,t.stepMinute=this.stepMinute,t.stepSecond=this.stepSecond,t.hourMin=this.hourMin,t.hourMax=this.hourMax,t.minuteMin=this.minuteMin,t.minuteMax=this.minuteMax,t.secondMin=this.secondMin,t.secondMax=this.secondMax,t.hourGrid=this.hourGrid,t.minuteGrid=this.minuteGrid,t.secondGrid=this.secondGrid,t.controlType=this.timeControlType,t.oneLine=this.horizontalTimeControls,t.minTime=this.minTime,t.maxTime=this.maxTime,t.timezoneList=this.timezoneList,this.calendarElement.datetimepicker(t)):this.calendarElement.datepicker(t),this.initialized=!0},Calendar.prototype.onInput=function(e){this.onModelChange(e.target.value)},Calendar.prototype.handleBlur=function(e){this.value=e.target.value,this.onModelTouched(),this.focused=!1,this.onBlur.emit(e)},Calendar.prototype.writeValue=function(e){this.value=e},Calendar.prototype.registerOnChange=function(e){this.onModelChange=e},Calendar.prototype.registerOnTouched=function(e){this.onModelTouched=e},Calendar.prototype.ngOnChanges=function(e){if(this.initialized)for(var t in e)this.calendarElement.datepicker("option",t,e[t].currentValue)},Calendar.prototype.ngOnDestroy=function(){this.calendarElement.datepicker("destroy"),this.calendarElement=null,this.initialized=!1},Calendar.prototype.onButtonClick=function(e,t){t.focus()},r([o.Input(),i("design:type",Boolean)],Calendar.prototype,"readonlyInput",void 0),r([o.Input(),i("design:type",Object)],Calendar.prototype,"style",void 0),r([o.Input(),i("design:type",String)],Calendar.prototype,"styleClass",void 0),r([o.Input(),i("design:type",Object)],Calendar.prototype,"inputStyle",void 0),r([o.Input(),i("design:type",String)],Calendar.prototype,"inputStyleClass",void 0),r([o.Input(),i("design:type",String)],Calendar.prototype,"placeholder",void 0),r([o.Input(),i("design:type",Boolean)],Calendar.prototype,"inline",void 0),r([o.Input(),i("design:type",String)],Calendar.prototype,"showAnim",void 0),r([o.Input(),i("design:type",String)],Calendar.prototype,"dateFormat",void 0),r([o.Input(),i("design:type",Boolean)],Calendar.prototype,"showButtonPanel",void 0),r([o.Input(),i("design:type",Boolean)],Calendar.prototype,"monthNavigator",void 0),r([o.Input(),i("design:type",Boolean)],Calendar.prototype,"yearNavigator",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"numberOfMonths",void 0),r([o.Input(),i("design:type",Boolean)],Calendar.prototype,"showWeek",void 0),r([o.Input(),i("design:type",Boolean)],Calendar.prototype,"showOtherMonths",void 0),r([o.Input(),i("design:type",Boolean)],Calendar.prototype,"selectOtherMonths",void 0),r([o.Input(),i("design:type",Object)],Calendar.prototype,"defaultDate",void 0),r([o.Input(),i("design:type",Object)],Calendar.prototype,"minDate",void 0),r([o.Input(),i("design:type",Object)],Calendar.prototype,"maxDate",void 0),r([o.Input(),i("design:type",Object)],Calendar.prototype,"disabled",void 0),r([o.Input(),i("design:type",Boolean)],Calendar.prototype,"showIcon",void 0),r([o.Input(),i("design:type",String)],Calendar.prototype,"timeFormat",void 0),r([o.Input(),i("design:type",Boolean)],Calendar.prototype,"timeOnly",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"stepHour",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"stepMinute",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"stepSecond",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"hourMin",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"hourMax",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"minuteMin",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"minuteMax",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"secondMin",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"secondMax",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"hourGrid",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"minuteGrid",void 0),r([o.Input(),i("design:type",Number)],Calendar.prototype,"secondGrid",void 0),r([o.Input(),i("design:type",String)],Calendar.prototype,"timeControlType",void 0),r([o.Input(),i("design:type",Boolean)],Calendar.prototype,"horizontalTimeControls",void 0),r([o.Input(),i("design:type",String)],Calendar.prototype,"minTime",void 0),r([o.Input(),i("design:type",String)],Calendar.prototype,"maxTime",void 0),r([o.Input(),i("design:type",Array)],Calendar.prototype,"timezoneList",void 0),r([o.Input(),i("design:type",Object)],Calendar.prototype,"locale",void 0),r([o.Input(),i("design:type",String)],Calendar.prototype,"icon",void 0),r([o.Input(),i("design:type",String)],Calendar.prototype,"yearRange",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Calendar.prototype,"onBlur",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Calendar.prototype,"onSelect",void 0),Calendar=r([o.Component({selector:"p-calendar",template:'\n        <span [ngStyle]="style" [class]="styleClass" [ngClass]="\'ui-calendar\'" *ngIf="!inline">\n        <input #in type="text" [attr.placeholder]="placeholder" [ngStyle]="inputStyle" [class]="inputStyleClass"\n                [value]="value||\'\'" (input)="onInput($event)" [readonly]="readonlyInput"\n                [disabled]="disabled" (mouseenter)="hovered=true" (mouseleave)="hovered=false" (focus)="focused=true" (blur)="handleBlur($event)"\n                [ngClass]="{\'ui-inputtext ui-widget ui-state-default\': true, \'ui-corner-all\': !showIcon, \'ui-corner-left\': showIcon,\n                    \'ui-state-hover\':hovered,\'ui-state-focus\':focused,\'ui-state-disabled\':disabled}"\n        ><button type="button" [icon]="icon" pButton *ngIf="showIcon" (click)="onButtonClick($event,in)" \n                [ngClass]="{\'ui-datepicker-trigger\':true,\'ui-state-disabled\':disabled}" [disabled]="disabled"></button></span>\n        <div *ngIf="inline"></div>\n    ',providers:[c]}),i("design:paramtypes",[o.ElementRef,o.NgZone])],Calendar)}();t.Calendar=u;var p=function(){function CalendarModule(){}return CalendarModule=r([o.NgModule({imports:[s.CommonModule,a.ButtonModule],exports:[u],declarations:[u]}),i("design:paramtypes",[])],CalendarModule)}();t.CalendarModule=p},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(9),a=n(19),l=n(3),c=function(){function Carousel(e,t,n,r){this.el=e,this.domHandler=t,this.renderer=r,this.numVisible=3,this.firstVisible=0,this.circular=!1,this.breakpoint=560,this.responsive=!0,this.autoplayInterval=0,this.effectDuration="1s",this.easing="ease-out",this.pageLinks=3,this.left=0,this.differ=n.find([]).create(null)}return Carousel.prototype.ngDoCheck=function(){var e=this.differ.diff(this.value);e&&(this.value&&this.value.length?this.value.length&&this.firstVisible>=this.value.length&&this.setPage(this.totalPages-1):this.setPage(0),this.valuesChanged=!0,this.autoplayInterval&&this.stopAutoplay(),this.updateMobileDropdown(),this.updateLinks(),this.updateDropdown())},Carousel.prototype.ngAfterViewChecked=function(){this.valuesChanged&&(this.render(),this.valuesChanged=!1)},Carousel.prototype.ngOnInit=function(){window.innerWidth<=this.breakpoint?(this.shrinked=!0,this.columns=1):(this.shrinked=!1,this.columns=this.numVisible),this.page=Math.floor(this.firstVisible/this.columns)},Carousel.prototype.ngAfterViewInit=function(){var e=this;this.container=this.el.nativeElement.children[0],this.viewport=this.domHandler.findSingle(this.el.nativeElement,"div.ui-carousel-viewport"),this.itemsContainer=this.domHandler.findSingle(this.el.nativeElement,"ul.ui-carousel-items"),this.responsive&&(this.documentResponsiveListener=this.renderer.listenGlobal("window","resize",function(t){e.updateState()})),this.value&&this.value.length&&this.render()},Carousel.prototype.updateLinks=function(){this.anchorPageLinks=[];for(var e=0;e<this.totalPages;e++)this.anchorPageLinks.push(e)},Carousel.prototype.updateDropdown=function(){this.selectDropdownOptions=[];for(var e=0;e<this.totalPages;e++)this.selectDropdownOptions.push(e)},Carousel.prototype.updateMobileDropdown=function(){this.mobileDropdownOptions=[];for(var e=0;e<this.value.length;e++)this.mobileDropdownOptions.push(e)},Carousel.prototype.render=function(){this.items=this.domHandler.find(this.itemsContainer,"li"),this.calculateItemWidths(),this.responsive||(this.container.style.width=this.domHandler.width(this.container)+"px"),this.autoplayInterval&&(this.circular=!0,this.startAutoplay())},Carousel.prototype.calculateItemWidths=function(){var e=this.items&&this.items.length?this.items[0]:null;if(e)for(var t=0;t<this.items.length;t++)this.items[t].style.width=(this.domHandler.innerWidth(this.viewport)-this.domHandler.getHorizontalMargin(e)*this.columns)/this.columns+"px"},Carousel.prototype.onNextNav=function(){var e=this.page===this.totalPages-1;e?this.circular&&this.setPage(0):this.setPage(this.page+1)},Carousel.prototype.onPrevNav=function(){0!==this.page?this.setPage(this.page-1):this.circular&&this.setPage(this.totalPages-1)},Carousel.prototype.setPageWithLink=function(e,t){this.setPage(t),e.preventDefault()},Carousel.prototype.setPage=function(e,t){(e!==this.page||t)&&(this.page=e,this.left=-1*(this.domHandler.innerWidth(this.viewport)*this.page),this.firstVisible=this.page*this.columns)},Carousel.prototype.onDropdownChange=function(e){this.setPage(parseInt(e))},Object.defineProperty(Carousel.prototype,"displayPageLinks",{get:function(){return this.totalPages<=this.pageLinks&&!this.shrinked},enumerable:!0,configurable:!0}),Object.defineProperty(Carousel.prototype,"displayPageDropdown",{get:function(){return this.totalPages>this.pageLinks&&!this.shrinked},enumerable:!0,configurable:!0}),Object.defineProperty(Carousel.prototype,"totalPages",{get:function(){return this.value&&this.value.length?Math.ceil(this.value.length/this.columns):0},enumerable:!0,configurable:!0}),Carousel.prototype.routerDisplay=function(){var e=window;return e.innerWidth<=this.breakpoint},Carousel.prototype.updateState=function(){var e=window;e.innerWidth<=this.breakpoint?(this.shrinked=!0,this.columns=1):this.shrinked&&(this.shrinked=!1,this.columns=this.numVisible,this.updateLinks(),this.updateDropdown()),this.calculateItemWidths(),this.setPage(Math.floor(this.firstVisible/this.columns),!0)},Carousel.prototype.startAutoplay=function(){var e=this;this.interval=setInterval(function(){e.page===e.totalPages-1?e.setPage(0):e.setPage(e.page+1)},this.autoplayInterval)},Carousel.prototype.stopAutoplay=function(){clearInterval(this.interval)},Carousel.prototype.ngOnDestroy=function(){this.responsive&&this.documentResponsiveListener(),this.autoplayInterval&&this.stopAutoplay()},r([o.Input(),i("design:type",Array)],Carousel.prototype,"value",void 0),r([o.Input(),i("design:type",Number)],Carousel.prototype,"numVisible",void 0),r([o.Input(),i("design:type",Number)],Carousel.prototype,"firstVisible",void 0),r([o.Input(),i("design:type",String)],Carousel.prototype,"headerText",void 0),r([o.Input(),i("design:type",Boolean)],Carousel.prototype,"circular",void 0),r([o.Input(),i("design:type",Number)],Carousel.prototype,"breakpoint",void 0),r([o.Input(),i("design:type",Boolean)],Carousel.prototype,"responsive",void 0),r([o.Input(),i("design:type",Number)],Carousel.prototype,"autoplayInterval",void 0),r([o.Input(),i("design:type",Object)],Carousel.prototype,"effectDuration",void 0),r([o.Input(),i("design:type",String)],Carousel.prototype,"easing",void 0),r([o.Input(),i("design:type",Number)],Carousel.prototype,"pageLinks",void 0),r([o.Input(),i("design:type",Object)],Carousel.prototype,"style",void 0),r([o.Input(),i("design:type",String)],Carousel.prototype,"styleClass",void 0),r([o.ContentChild(o.TemplateRef),i("design:type",o.TemplateRef)],Carousel.prototype,"itemTemplate",void 0),Carousel=r([o.Component({selector:"p-carousel",template:'\n        <div [ngClass]="{\'ui-carousel ui-widget ui-widget-content ui-corner-all\':true}" [ngStyle]="style" [class]="styleClass">\n            <div class="ui-carousel-header ui-widget-header">\n                <div class="ui-carousel-header-title">{{headerText}}</div>\n                <span class="ui-carousel-button ui-carousel-next-button fa fa-arrow-circle-right" (click)="onNextNav()" \n                        [ngClass]="{\'ui-state-disabled\':(page === (totalPages-1)) && !circular}"></span>\n                <span class="ui-carousel-button ui-carousel-prev-button fa fa-arrow-circle-left" (click)="onPrevNav()" \n                        [ngClass]="{\'ui-state-disabled\':(page === 0 && !circular)}"></span>\n                <div *ngIf="displayPageLinks" class="ui-carousel-page-links">\n                    <a href="#" (click)="setPageWithLink($event,i)" class="ui-carousel-page-link fa fa-circle-o" *ngFor="let links of anchorPageLinks;let i=index" [ngClass]="{\'fa-dot-circle-o\':page===i}"></a>\n                </div>\n                <select *ngIf="displayPageDropdown" class="ui-carousel-dropdown ui-widget ui-state-default ui-corner-left" [value]="page" (change)="onDropdownChange($event.target.value)">\n                    <option *ngFor="let option of selectDropdownOptions" [value]="option" [selected]="value == option">{{option+1}}</option>\n                </select>\n                <select *ngIf="responsive" class="ui-carousel-mobiledropdown ui-widget ui-state-default ui-corner-left" [value]="page" (change)="onDropdownChange($event.target.value)"\n                    [style.display]="shrinked ? \'block\' : \'none\'">\n                    <option *ngFor="let option of mobileDropdownOptions" [value]="option" [selected]="value == option">{{option+1}}</option>\n                </select>\n            </div>\n            <div class="ui-carousel-viewport">\n                <ul class="ui-carousel-items" [style.left.px]="left" [style.transitionProperty]="\'left\'" \n                            [style.transitionDuration]="effectDuration" [style.transitionTimingFunction]="easing">\n                    <li *ngFor="let item of value" class="ui-carousel-item ui-widget-content ui-corner-all">\n                        <template [pTemplateWrapper]="itemTemplate" [item]="item"></template>\n                    </li>\n                </ul>\n            </div>\n        </div>\n    ',providers:[s.DomHandler]}),i("design:paramtypes",[o.ElementRef,s.DomHandler,o.IterableDiffers,o.Renderer])],Carousel)}();t.Carousel=c;var u=function(){function CarouselModule(){}return CarouselModule=r([o.NgModule({imports:[l.CommonModule,a.SharedModule],exports:[c,a.SharedModule],declarations:[c]}),i("design:paramtypes",[])],CarouselModule)}();t.CarouselModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=function(){function UIChart(e,t){this.el=e,this.onDataSelect=new o.EventEmitter,this.differ=t.find([]).create(null)}return UIChart.prototype.ngAfterViewInit=function(){this.initChart(),this.initialized=!0},UIChart.prototype.ngDoCheck=function(){var e=this.differ.diff(this.data.datasets);e&&this.initialized&&(this.chart&&this.chart.destroy(),this.initChart())},UIChart.prototype.onCanvasClick=function(e){if(this.chart){var t=this.chart.getElementAtEvent(e),n=this.chart.getDatasetAtEvent(e);t&&t[0]&&n&&this.onDataSelect.emit({originalEvent:e,element:t[0],dataset:n})}},UIChart.prototype.initChart=function(){this.chart=new Chart(this.el.nativeElement.children[0].children[0],{type:this.type,data:this.data,options:this.options})},UIChart.prototype.getCanvas=function(){return this.el.nativeElement.children[0].children[0]},UIChart.prototype.getBase64Image=function(){return this.chart.toBase64Image()},UIChart.prototype.ngOnDestroy=function(){this.chart&&(this.chart.destroy(),this.initialized=!1,this.chart=null)},r([o.Input(),i("design:type",String)],UIChart.prototype,"type",void 0),r([o.Input(),i("design:type",Object)],UIChart.prototype,"data",void 0),r([o.Input(),i("design:type",Object)],UIChart.prototype,"options",void 0),r([o.Input(),i("design:type",String)],UIChart.prototype,"width",void 0),r([o.Input(),i("design:type",String)],UIChart.prototype,"height",void 0),r([o.Output(),i("design:type",o.EventEmitter)],UIChart.prototype,"onDataSelect",void 0),UIChart=r([o.Component({selector:"p-chart",template:'\n        <div>\n            <canvas [attr.width]="width" [attr.height]="height" (click)="onCanvasClick($event)"></canvas>\n        </div>\n    '}),i("design:paramtypes",[o.ElementRef,o.IterableDiffers])],UIChart)}();t.UIChart=a;var l=function(){function ChartModule(){}return ChartModule=r([o.NgModule({imports:[s.CommonModule],exports:[a],declarations:[a]}),i("design:paramtypes",[])],ChartModule)}();t.ChartModule=l},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(22),l=new o.Provider(a.NG_VALUE_ACCESSOR,{useExisting:o.forwardRef(function(){return c}),multi:!0}),c=function(){function Checkbox(){this.onChange=new o.EventEmitter,this.onModelChange=function(){},this.onModelTouched=function(){},this.focused=!1,this.checked=!1}return Checkbox.prototype.onClick=function(e,t,n){e.preventDefault(),this.disabled||(this.checked=!this.checked,this.binary?this.onModelChange(this.checked):(this.checked?this.addValue(this.value):this.removeValue(this.value),this.onModelChange(this.model)),this.onChange.emit(this.checked),n&&t.focus())},Checkbox.prototype.isChecked=function(){return this.binary?this.model:this.findValueIndex(this.value)!==-1},Checkbox.prototype.removeValue=function(e){var t=this.findValueIndex(e);t>=0&&this.model.splice(t,1)},Checkbox.prototype.addValue=function(e){this.model.push(e)},Checkbox.prototype.onFocus=function(e){this.focused=!0},Checkbox.prototype.onBlur=function(e){this.focused=!1,this.onModelTouched()},Checkbox.prototype.findValueIndex=function(e){var t=-1;if(this.model)for(var n=0;n<this.model.length;n++)if(this.model[n]==e){t=n;break}return t},Checkbox.prototype.writeValue=function(e){this.model=e,this.checked=this.isChecked()},Checkbox.prototype.registerOnChange=function(e){this.onModelChange=e},Checkbox.prototype.registerOnTouched=function(e){this.onModelTouched=e},r([o.Input(),i("design:type",Object)],Checkbox.prototype,"value",void 0),r([o.Input(),i("design:type",String)],Checkbox.prototype,"name",void 0),r([o.Input(),i("design:type",Boolean)],Checkbox.prototype,"disabled",void 0),r([o.Input(),i("design:type",String)],Checkbox.prototype,"binary",void 0),r([o.Input(),i("design:type",String)],Checkbox.prototype,"label",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Checkbox.prototype,"onChange",void 0),Checkbox=r([o.Component({selector:"p-checkbox",template:'\n        <div class="ui-chkbox ui-widget">\n            <div class="ui-helper-hidden-accessible">\n                <input #cb type="checkbox" name="{{name}}" value="{{value}}" [checked]="checked" (focus)="onFocus($event)" (blur)="onBlur($event)"\n                [ngClass]="{\'ui-state-focus\':focused}" (keydown.space)="onClick($event,cb,false)">\n            </div>\n            <div class="ui-chkbox-box ui-widget ui-corner-all ui-state-default" (click)="onClick($event,cb,true)"\n                        (mouseover)="hover=true" (mouseout)="hover=false" \n                        [ngClass]="{\'ui-state-hover\':hover&&!disabled,\'ui-state-active\':checked,\'ui-state-disabled\':disabled,\'ui-state-focus\':focused}">\n                <span class="ui-chkbox-icon ui-c" [ngClass]="{\'fa fa-fw fa-check\':checked}"></span>\n            </div>\n        </div>\n        <label class="ui-chkbox-label" (click)="onClick($event,cb,true)" *ngIf="label">{{label}}</label>\n    ',providers:[l]}),i("design:paramtypes",[])],Checkbox)}();t.Checkbox=c;var u=function(){function CheckboxModule(){}return CheckboxModule=r([o.NgModule({imports:[s.CommonModule],exports:[c],declarations:[c]}),i("design:paramtypes",[])],CheckboxModule)}();t.CheckboxModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=function(){function CodeHighlighter(e){this.el=e}return CodeHighlighter.prototype.ngOnInit=function(){Prism.highlightElement(this.el.nativeElement)},CodeHighlighter=r([o.Directive({selector:"[pCode]"}),i("design:paramtypes",[o.ElementRef])],CodeHighlighter)}();t.CodeHighlighter=a;var l=function(){function CodeHighlighterModule(){}return CodeHighlighterModule=r([o.NgModule({imports:[s.CommonModule],exports:[a],declarations:[a]}),i("design:paramtypes",[])],CodeHighlighterModule)}();t.CodeHighlighterModule=l},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(9),l=n(29),c=function(){function ContextMenuSub(e,t){this.domHandler=e,this.router=t}return ContextMenuSub.prototype.onItemMouseEnter=function(e,t){this.activeItem=t,this.activeLink=t.children[0];var n=t.children[0].nextElementSibling;if(n){var r=n.children[0];r.style.zIndex=++a.DomHandler.zindex,r.style.top="0px",r.style.left=this.domHandler.getOuterWidth(t.children[0])+"px"}},ContextMenuSub.prototype.onItemMouseLeave=function(e,t){this.activeItem=null,this.activeLink=null},ContextMenuSub.prototype.itemClick=function(e,t){t.url&&!t.routerLink||e.preventDefault(),t.command&&(t.eventEmitter||(t.eventEmitter=new o.EventEmitter,t.eventEmitter.subscribe(t.command)),t.eventEmitter.emit(e)),t.routerLink&&this.router.navigate(t.routerLink)},ContextMenuSub.prototype.listClick=function(e){this.activeItem=null,this.activeLink=null},r([o.Input(),i("design:type",Object)],ContextMenuSub.prototype,"item",void 0),r([o.Input(),i("design:type",Boolean)],ContextMenuSub.prototype,"root",void 0),ContextMenuSub=r([o.Component({selector:"p-contextMenuSub",template:'\n        <ul [ngClass]="{\'ui-helper-reset\':root, \'ui-widget-content ui-corner-all ui-helper-clearfix ui-menu-child ui-shadow\':!root}" class="ui-menu-list"\n            (click)="listClick($event)">\n            <template ngFor let-child [ngForOf]="(root ? item : item.items)">\n                <li #item [ngClass]="{\'ui-menuitem ui-widget ui-corner-all\':true,\'ui-menu-parent\':child.items,\'ui-menuitem-active\':item==activeItem}"\n                    (mouseenter)="onItemMouseEnter($event, item)" (mouseleave)="onItemMouseLeave($event, item)">\n                    <a #link [href]="child.url||\'#\'" class="ui-menuitem-link ui-corner-all" [ngClass]="{\'ui-state-hover\':link==activeLink}" (click)="itemClick($event, child)">\n                        <span class="ui-submenu-icon fa fa-fw fa-caret-right" *ngIf="child.items"></span>\n                        <span class="ui-menuitem-icon fa fa-fw" *ngIf="child.icon" [ngClass]="child.icon"></span>\n                        <span class="ui-menuitem-text">{{child.label}}</span>\n                    </a>\n                    <p-contextMenuSub class="ui-submenu" [item]="child" *ngIf="child.items"></p-contextMenuSub>\n                </li>\n            </template>\n        </ul>\n    ',providers:[a.DomHandler]}),i("design:paramtypes",[a.DomHandler,l.Router])],ContextMenuSub)}();t.ContextMenuSub=c;var u=function(){function ContextMenu(e,t,n){this.el=e,this.domHandler=t,this.renderer=n}return ContextMenu.prototype.ngAfterViewInit=function(){var e=this;this.container=this.el.nativeElement.children[0],this.documentClickListener=this.renderer.listenGlobal("body","click",function(){e.hide()}),this.global&&(this.documentRightClickListener=this.renderer.listenGlobal("body","contextmenu",function(t){e.show(t),t.preventDefault()}))},ContextMenu.prototype.toggle=function(e){this.container.offsetParent?this.hide():this.show(e)},ContextMenu.prototype.show=function(e){this.left=e.pageX,this.top=e.pageY,this.visible=!0,this.domHandler.fadeIn(this.container,250),e.preventDefault()},ContextMenu.prototype.hide=function(){this.visible=!1},ContextMenu.prototype.unsubscribe=function(e){if(e.eventEmitter&&e.eventEmitter.unsubscribe(),e.items)for(var t=0,n=e.items;t<n.length;t++){var r=n[t];this.unsubscribe(r)}},ContextMenu.prototype.ngOnDestroy=function(){if(this.documentClickListener(),this.global&&this.documentRightClickListener(),this.model)for(var e=0,t=this.model;e<t.length;e++){var n=t[e];this.unsubscribe(n)}},r([o.Input(),i("design:type",Array)],ContextMenu.prototype,"model",void 0),r([o.Input(),i("design:type",Boolean)],ContextMenu.prototype,"global",void 0),r([o.Input(),i("design:type",Object)],ContextMenu.prototype,"style",void 0),r([o.Input(),i("design:type",String)],ContextMenu.prototype,"styleClass",void 0),ContextMenu=r([o.Component({selector:"p-contextMenu",template:'\n        <div [ngClass]="\'ui-contextmenu ui-menu ui-widget ui-widget-content ui-corner-all ui-helper-clearfix ui-menu-dynamic ui-shadow\'" \n            [class]="styleClass" [ngStyle]="style" [style.display]="visible ? \'block\' : \'none\'" [style.left.px]="left" [style.top.px]="top">\n            <p-contextMenuSub [item]="model" root="root"></p-contextMenuSub>\n        </div>\n    ',providers:[a.DomHandler]}),i("design:paramtypes",[o.ElementRef,a.DomHandler,o.Renderer])],ContextMenu)}();t.ContextMenu=u;var p=function(){function ContextMenuModule(){}return ContextMenuModule=r([o.NgModule({imports:[s.CommonModule],exports:[u],declarations:[u,c]}),i("design:paramtypes",[])],ContextMenuModule)}();t.ContextMenuModule=p},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(19),l=n(19),c=n(205),u=function(){function DataGrid(e,t){this.el=e,this.columns=3,this.pageLinks=5,this.onLazyLoad=new o.EventEmitter,this.paginatorPosition="bottom",this.first=0,this.page=0,this.differ=t.find([]).create(null)}return DataGrid.prototype.ngAfterViewInit=function(){this.lazy&&this.onLazyLoad.emit({first:this.first,rows:this.rows})},DataGrid.prototype.ngDoCheck=function(){var e=this.differ.diff(this.value);e&&(this.paginator&&this.updatePaginator(),this.updateDataToRender(this.value))},DataGrid.prototype.updatePaginator=function(){if(this.totalRecords=this.lazy?this.totalRecords:this.value?this.value.length:0,this.totalRecords&&this.first>=this.totalRecords){var e=Math.ceil(this.totalRecords/this.rows);this.first=Math.max((e-1)*this.rows,0)}},DataGrid.prototype.paginate=function(e){this.first=e.first,this.rows=e.rows,this.lazy?this.onLazyLoad.emit(this.createLazyLoadMetadata()):this.updateDataToRender(this.value)},DataGrid.prototype.updateDataToRender=function(e){if(this.paginator&&e){this.dataToRender=[];for(var t=this.lazy?0:this.first,n=t;n<t+this.rows&&!(n>=e.length);n++)this.dataToRender.push(e[n])}else this.dataToRender=e},DataGrid.prototype.isEmpty=function(){return!this.dataToRender||0==this.dataToRender.length},DataGrid.prototype.createLazyLoadMetadata=function(){return{first:this.first,rows:this.rows}},r([o.Input(),i("design:type",Array)],DataGrid.prototype,"value",void 0),r([o.Input(),i("design:type",Boolean)],DataGrid.prototype,"paginator",void 0),r([o.Input(),i("design:type",Number)],DataGrid.prototype,"rows",void 0),r([o.Input(),i("design:type",Number)],DataGrid.prototype,"columns",void 0),r([o.Input(),i("design:type",Number)],DataGrid.prototype,"totalRecords",void 0),r([o.Input(),i("design:type",Number)],DataGrid.prototype,"pageLinks",void 0),r([o.Input(),i("design:type",Array)],DataGrid.prototype,"rowsPerPageOptions",void 0),r([o.Input(),i("design:type",Boolean)],DataGrid.prototype,"lazy",void 0),
 r([o.Output(),i("design:type",o.EventEmitter)],DataGrid.prototype,"onLazyLoad",void 0),r([o.Input(),i("design:type",Object)],DataGrid.prototype,"style",void 0),r([o.Input(),i("design:type",String)],DataGrid.prototype,"styleClass",void 0),r([o.Input(),i("design:type",String)],DataGrid.prototype,"paginatorPosition",void 0),r([o.ContentChild(a.Header),i("design:type",Object)],DataGrid.prototype,"header",void 0),r([o.ContentChild(l.Footer),i("design:type",Object)],DataGrid.prototype,"footer",void 0),r([o.ContentChild(o.TemplateRef),i("design:type",o.TemplateRef)],DataGrid.prototype,"itemTemplate",void 0),DataGrid=r([o.Component({selector:"p-dataGrid",template:'\n        <div [ngClass]="\'ui-datagrid ui-widget\'" [ngStyle]="style" [class]="styleClass">\n            <div class="ui-datagrid-header ui-widget-header ui-corner-top" *ngIf="header">\n                <ng-content select="header"></ng-content>\n            </div>\n            <p-paginator [rows]="rows" [first]="first" [totalRecords]="totalRecords" [pageLinkSize]="pageLinks" \n                (onPageChange)="paginate($event)" styleClass="ui-paginator-bottom" [rowsPerPageOptions]="rowsPerPageOptions" *ngIf="paginator && paginatorPosition!=\'bottom\' || paginatorPosition ==\'both\'"></p-paginator>\n            <div class="ui-datagrid-content ui-widget-content" [ngClass]="\'ui-datagrid-col-\' + columns">\n                <template ngFor [ngForOf]="dataToRender" [ngForTemplate]="itemTemplate"></template>\n            </div>\n            <p-paginator [rows]="rows" [first]="first" [totalRecords]="totalRecords" [pageLinkSize]="pageLinks" \n                (onPageChange)="paginate($event)" styleClass="ui-paginator-bottom" [rowsPerPageOptions]="rowsPerPageOptions" *ngIf="paginator && paginatorPosition!=\'top\' || paginatorPosition ==\'both\'"></p-paginator>\n            <div class="ui-datagrid-footer ui-widget-header ui-corner-top" *ngIf="footer">\n                <ng-content select="footer"></ng-content>\n            </div>\n        </div>\n    '}),i("design:paramtypes",[o.ElementRef,o.IterableDiffers])],DataGrid)}();t.DataGrid=u;var p=function(){function DataGridModule(){}return DataGridModule=r([o.NgModule({imports:[s.CommonModule,c.PaginatorModule],exports:[u],declarations:[u]}),i("design:paramtypes",[])],DataGridModule)}();t.DataGridModule=p},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(19),l=n(19),c=n(19),u=n(205),p=function(){function DataList(e,t){this.el=e,this.pageLinks=5,this.onLazyLoad=new o.EventEmitter,this.paginatorPosition="bottom",this.first=0,this.page=0,this.differ=t.find([]).create(null)}return DataList.prototype.ngAfterViewInit=function(){this.lazy&&this.onLazyLoad.emit({first:this.first,rows:this.rows})},DataList.prototype.ngDoCheck=function(){var e=this.differ.diff(this.value);e&&(this.paginator&&this.updatePaginator(),this.updateDataToRender(this.value))},DataList.prototype.updatePaginator=function(){if(this.totalRecords=this.lazy?this.totalRecords:this.value?this.value.length:0,this.totalRecords&&this.first>=this.totalRecords){var e=Math.ceil(this.totalRecords/this.rows);this.first=Math.max((e-1)*this.rows,0)}},DataList.prototype.paginate=function(e){this.first=e.first,this.rows=e.rows,this.lazy?this.onLazyLoad.emit(this.createLazyLoadMetadata()):this.updateDataToRender(this.value)},DataList.prototype.updateDataToRender=function(e){if(this.paginator&&e){this.dataToRender=[];for(var t=this.lazy?0:this.first,n=t;n<t+this.rows&&!(n>=e.length);n++)this.dataToRender.push(e[n])}else this.dataToRender=e},DataList.prototype.isEmpty=function(){return!this.dataToRender||0==this.dataToRender.length},DataList.prototype.createLazyLoadMetadata=function(){return{first:this.first,rows:this.rows}},r([o.Input(),i("design:type",Array)],DataList.prototype,"value",void 0),r([o.Input(),i("design:type",Boolean)],DataList.prototype,"paginator",void 0),r([o.Input(),i("design:type",Number)],DataList.prototype,"rows",void 0),r([o.Input(),i("design:type",Number)],DataList.prototype,"totalRecords",void 0),r([o.Input(),i("design:type",Number)],DataList.prototype,"pageLinks",void 0),r([o.Input(),i("design:type",Array)],DataList.prototype,"rowsPerPageOptions",void 0),r([o.Input(),i("design:type",Boolean)],DataList.prototype,"lazy",void 0),r([o.Output(),i("design:type",o.EventEmitter)],DataList.prototype,"onLazyLoad",void 0),r([o.Input(),i("design:type",Object)],DataList.prototype,"style",void 0),r([o.Input(),i("design:type",String)],DataList.prototype,"styleClass",void 0),r([o.Input(),i("design:type",String)],DataList.prototype,"paginatorPosition",void 0),r([o.ContentChild(a.Header),i("design:type",Object)],DataList.prototype,"header",void 0),r([o.ContentChild(l.Footer),i("design:type",Object)],DataList.prototype,"footer",void 0),r([o.ContentChild(o.TemplateRef),i("design:type",o.TemplateRef)],DataList.prototype,"itemTemplate",void 0),DataList=r([o.Component({selector:"p-dataList",template:'\n        <div [ngClass]="\'ui-datalist ui-widget\'" [ngStyle]="style" [class]="styleClass">\n            <div class="ui-datalist-header ui-widget-header ui-corner-top" *ngIf="header">\n                <ng-content select="header"></ng-content>\n            </div>\n            <p-paginator [rows]="rows" [first]="first" [totalRecords]="totalRecords" [pageLinkSize]="pageLinks" \n            (onPageChange)="paginate($event)" styleClass="ui-paginator-bottom" [rowsPerPageOptions]="rowsPerPageOptions" *ngIf="paginator  && paginatorPosition!=\'bottom\' || paginatorPosition ==\'both\'"></p-paginator>\n            <div class="ui-datalist-content ui-widget-content">\n                <ul class="ui-datalist-data">\n                    <li *ngFor="let item of dataToRender">\n                        <template [pTemplateWrapper]="itemTemplate" [item]="item"></template>\n                    </li>\n                </ul>\n            </div>\n            <p-paginator [rows]="rows" [first]="first" [totalRecords]="totalRecords" [pageLinkSize]="pageLinks" \n            (onPageChange)="paginate($event)" styleClass="ui-paginator-bottom" [rowsPerPageOptions]="rowsPerPageOptions" *ngIf="paginator  && paginatorPosition!=\'top\' || paginatorPosition ==\'both\'"></p-paginator>\n            <div class="ui-datalist-footer ui-widget-header ui-corner-bottom" *ngIf="footer">\n                <ng-content select="footer"></ng-content>\n            </div>\n        </div>\n    '}),i("design:paramtypes",[o.ElementRef,o.IterableDiffers])],DataList)}();t.DataList=p;var d=function(){function DataListModule(){}return DataListModule=r([o.NgModule({imports:[s.CommonModule,c.SharedModule,u.PaginatorModule],exports:[p,c.SharedModule],declarations:[p]}),i("design:paramtypes",[])],DataListModule)}();t.DataListModule=d},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(19),l=n(19),c=n(19),u=n(9),p=function(){function DataScroller(e,t,n,r){this.el=e,this.renderer=n,this.domHandler=r,this.onLazyLoad=new o.EventEmitter,this.buffer=.9,this.dataToRender=[],this.first=0,this.differ=t.find([]).create(null)}return DataScroller.prototype.ngAfterViewInit=function(){var e=this;this.lazy&&this.load(),this.loader?this.scrollFunction=this.renderer.listen(this.loader,"click",function(){e.load()}):this.bindScrollListener()},DataScroller.prototype.ngDoCheck=function(){var e=this.differ.diff(this.value);e&&(this.lazy?this.dataToRender=this.value:this.load())},DataScroller.prototype.load=function(){if(this.lazy)this.onLazyLoad.emit({first:this.first,rows:this.rows});else for(var e=this.first;e<this.first+this.rows&&!(e>=this.value.length);e++)this.dataToRender.push(this.value[e]);this.first=this.first+this.rows},DataScroller.prototype.isEmpty=function(){return!this.dataToRender||0==this.dataToRender.length},DataScroller.prototype.createLazyLoadMetadata=function(){return{first:this.first,rows:this.rows}},DataScroller.prototype.bindScrollListener=function(){var e=this;this.inline?(this.contentElement=this.domHandler.findSingle(this.el.nativeElement,"div.ui-datascroller-content"),this.scrollFunction=this.renderer.listen(this.contentElement,"scroll",function(){var t=e.contentElement.scrollTop,n=e.contentElement.scrollHeight,r=e.contentElement.clientHeight;t>=n*e.buffer-r&&e.load()})):this.scrollFunction=this.renderer.listenGlobal("window","scroll",function(){var t=document.body,n=document.documentElement,r=window.pageYOffset||document.documentElement.scrollTop,i=n.clientHeight,o=Math.max(t.scrollHeight,t.offsetHeight,i,n.scrollHeight,n.offsetHeight);r>=o*e.buffer-i&&e.load()})},DataScroller.prototype.ngOnDestroy=function(){this.scrollFunction&&(this.scrollFunction(),this.contentElement=null)},r([o.Input(),i("design:type",Array)],DataScroller.prototype,"value",void 0),r([o.Input(),i("design:type",Number)],DataScroller.prototype,"rows",void 0),r([o.Input(),i("design:type",Boolean)],DataScroller.prototype,"lazy",void 0),r([o.Output(),i("design:type",o.EventEmitter)],DataScroller.prototype,"onLazyLoad",void 0),r([o.Input(),i("design:type",Object)],DataScroller.prototype,"style",void 0),r([o.Input(),i("design:type",String)],DataScroller.prototype,"styleClass",void 0),r([o.Input(),i("design:type",Number)],DataScroller.prototype,"buffer",void 0),r([o.Input(),i("design:type",Boolean)],DataScroller.prototype,"inline",void 0),r([o.Input(),i("design:type",Object)],DataScroller.prototype,"scrollHeight",void 0),r([o.ContentChild(a.Header),i("design:type",Object)],DataScroller.prototype,"header",void 0),r([o.ContentChild(l.Footer),i("design:type",Object)],DataScroller.prototype,"footer",void 0),r([o.ContentChild(o.TemplateRef),i("design:type",o.TemplateRef)],DataScroller.prototype,"itemTemplate",void 0),r([o.Input(),i("design:type",Object)],DataScroller.prototype,"loader",void 0),DataScroller=r([o.Component({selector:"p-dataScroller",template:'\n    <div [ngClass]="{\'ui-datascroller ui-widget\': true, \'ui-datascroller-inline\': inline}" [ngStyle]="style" [class]="styleClass">\n        <div class="ui-datascroller-header ui-widget-header ui-corner-top" *ngIf="header">\n            <ng-content select="header"></ng-content>\n        </div>\n        <div class="ui-datascroller-content ui-widget-content" [ngStyle]="{\'max-height\': scrollHeight}">\n            <ul class="ui-datascroller-list">\n                <li *ngFor="let item of dataToRender">\n                    <template [pTemplateWrapper]="itemTemplate" [item]="item"></template>\n                </li>\n            </ul>\n        </div>\n        <div class="ui-datascroller-footer ui-widget-header ui-corner-bottom" *ngIf="footer">\n            <ng-content select="footer"></ng-content>\n        </div>\n    </div>\n    ',providers:[u.DomHandler]}),i("design:paramtypes",[o.ElementRef,o.IterableDiffers,o.Renderer,u.DomHandler])],DataScroller)}();t.DataScroller=p;var d=function(){function DataScrollerModule(){}return DataScrollerModule=r([o.NgModule({imports:[s.CommonModule,c.SharedModule],exports:[p,c.SharedModule],declarations:[p]}),i("design:paramtypes",[])],DataScrollerModule)}();t.DataScrollerModule=d},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=this&&this.__param||function(e,t){return function(n,r){t(n,r,e)}},s=n(0),a=n(3),l=n(22),c=n(19),u=n(205),p=n(19),d=n(9),h=function(){function DTRadioButton(){this.onClick=new s.EventEmitter}return DTRadioButton.prototype.handleClick=function(e){this.onClick.emit(e)},r([s.Input(),i("design:type",Boolean)],DTRadioButton.prototype,"checked",void 0),r([s.Output(),i("design:type",s.EventEmitter)],DTRadioButton.prototype,"onClick",void 0),DTRadioButton=r([s.Component({selector:"p-dtRadioButton",template:'\n        <div class="ui-radiobutton ui-widget">\n            <div class="ui-helper-hidden-accessible">\n                <input type="radio" [checked]="checked">\n            </div>\n            <div class="ui-radiobutton-box ui-widget ui-radiobutton-relative ui-state-default" (click)="handleClick($event)"\n                        (mouseenter)="hover=true" (mouseleave)="hover=false"\n                        [ngClass]="{\'ui-state-hover\':hover,\'ui-state-active\':checked}">\n                <span class="ui-radiobutton-icon" [ngClass]="{\'fa fa-fw fa-circle\':checked}"></span>\n            </div>\n        </div>\n    '}),i("design:paramtypes",[])],DTRadioButton)}();t.DTRadioButton=h;var f=function(){function DTCheckbox(){this.onChange=new s.EventEmitter}return DTCheckbox.prototype.handleClick=function(e){this.disabled||this.onChange.emit({originalEvent:e,checked:!this.checked})},r([s.Input(),i("design:type",Boolean)],DTCheckbox.prototype,"checked",void 0),r([s.Input(),i("design:type",Boolean)],DTCheckbox.prototype,"disabled",void 0),r([s.Output(),i("design:type",s.EventEmitter)],DTCheckbox.prototype,"onChange",void 0),DTCheckbox=r([s.Component({selector:"p-dtCheckbox",template:'\n        <div class="ui-chkbox ui-widget">\n            <div class="ui-helper-hidden-accessible">\n                <input type="checkbox" [checked]="checked">\n            </div>\n            <div class="ui-chkbox-box ui-widget ui-corner-all ui-state-default" (click)="handleClick($event)"\n                        (mouseover)="hover=true" (mouseout)="hover=false" \n                        [ngClass]="{\'ui-state-hover\':hover&&!disabled,\'ui-state-active\':checked&&!disabled,\'ui-state-disabled\':disabled}">\n                <span class="ui-chkbox-icon ui-c" [ngClass]="{\'fa fa-fw fa-check\':checked}"></span>\n            </div>\n        </div>\n    '}),i("design:paramtypes",[])],DTCheckbox)}();t.DTCheckbox=f;var m=function(){function RowExpansionLoader(e){this.viewContainer=e}return RowExpansionLoader.prototype.ngOnInit=function(){this.viewContainer.createEmbeddedView(this.template,{$implicit:this.rowData})},r([s.Input(),i("design:type",s.TemplateRef)],RowExpansionLoader.prototype,"template",void 0),r([s.Input(),i("design:type",Object)],RowExpansionLoader.prototype,"rowData",void 0),RowExpansionLoader=r([s.Component({selector:"p-rowExpansionLoader",template:""}),i("design:paramtypes",[s.ViewContainerRef])],RowExpansionLoader)}();t.RowExpansionLoader=m;var g=function(){function DataTable(e,t,n,r,i,o){var a=this;this.el=e,this.domHandler=t,this.renderer=i,this.pageLinks=5,this.selectionChange=new s.EventEmitter,this.onRowClick=new s.EventEmitter,this.onRowSelect=new s.EventEmitter,this.onRowUnselect=new s.EventEmitter,this.onRowDblclick=new s.EventEmitter,this.onHeaderCheckboxToggle=new s.EventEmitter,this.onContextMenuSelect=new s.EventEmitter,this.filterDelay=300,this.onLazyLoad=new s.EventEmitter,this.columnResizeMode="fit",this.onColResize=new s.EventEmitter,this.onColReorder=new s.EventEmitter,this.sortMode="single",this.sortOrder=1,this.csvSeparator=",",this.emptyMessage="No records found",this.paginatorPosition="bottom",this.onEditInit=new s.EventEmitter,this.onEditComplete=new s.EventEmitter,this.onEdit=new s.EventEmitter,this.onEditCancel=new s.EventEmitter,this.onPage=new s.EventEmitter,this.onSort=new s.EventEmitter,this.onFilter=new s.EventEmitter,this.onRowExpand=new s.EventEmitter,this.onRowCollapse=new s.EventEmitter,this.first=0,this.page=0,this.filters={},this.columnsUpdated=!1,this.filterConstraints={startsWith:function(e,t){if(void 0===t||null===t||""===t.trim())return!0;if(void 0===e||null===e)return!1;var n=t.toLowerCase();return e.toString().toLowerCase().slice(0,n.length)===n},contains:function(e,t){return void 0===t||null===t||""===t.trim()||void 0!==e&&null!==e&&e.toString().toLowerCase().indexOf(t.toLowerCase())!==-1},endsWith:function(e,t){if(void 0===t||null===t||""===t.trim())return!0;if(void 0===e||null===e)return!1;var n=t.toLowerCase();return e.indexOf(n,e.length-n.length)!==-1}},this.differ=n.find([]).create(null),this.columnsSubscription=r.changes.subscribe(function(e){a.columns=r.toArray(),a.columnsUpdated=!0,o.markForCheck()})}return DataTable.prototype.ngOnInit=function(){this.lazy&&this.onLazyLoad.emit({first:this.first,rows:this.rows,sortField:this.sortField,sortOrder:this.sortOrder,filters:null,multiSortMeta:this.multiSortMeta})},DataTable.prototype.ngAfterViewChecked=function(){this.columnsUpdated&&(this.resizableColumns&&this.initResizableColumns(),this.reorderableColumns&&this.initColumnReordering(),this.scrollable&&this.initScrolling(),this.columnsUpdated=!1)},DataTable.prototype.ngAfterViewInit=function(){var e=this;this.globalFilter&&(this.globalFilterFunction=this.renderer.listen(this.globalFilter,"keyup",function(){e.filterTimeout=setTimeout(function(){e.filter(),e.filterTimeout=null},e.filterDelay)}))},DataTable.prototype.ngDoCheck=function(){var e=this.differ.diff(this.value);e&&(this.paginator&&this.updatePaginator(),this.stopSortPropagation?this.stopSortPropagation=!1:this.lazy||!this.sortField&&!this.multiSortMeta||("single"==this.sortMode?this.sortSingle():"multiple"==this.sortMode&&this.sortMultiple()),this.updateDataToRender(this.filteredValue||this.value))},DataTable.prototype.resolveFieldData=function(e,t){if(e&&t){if(t.indexOf(".")==-1)return e[t];for(var n=t.split("."),r=e,i=0,o=n.length;i<o;++i)r=r[n[i]];return r}return null},DataTable.prototype.updatePaginator=function(){if(this.totalRecords=this.lazy?this.totalRecords:this.value?this.value.length:0,this.totalRecords&&this.first>=this.totalRecords){var e=Math.ceil(this.totalRecords/this.rows);this.first=Math.max((e-1)*this.rows,0)}},DataTable.prototype.paginate=function(e){this.first=e.first,this.rows=e.rows,this.lazy?this.onLazyLoad.emit(this.createLazyLoadMetadata()):this.updateDataToRender(this.filteredValue||this.value),this.onPage.emit({first:this.first,rows:this.rows})},DataTable.prototype.updateDataToRender=function(e){if(this.paginator&&e){this.dataToRender=[];for(var t=this.lazy?0:this.first,n=t;n<t+this.rows&&!(n>=e.length);n++)this.dataToRender.push(e[n])}else this.dataToRender=e},DataTable.prototype.sort=function(e,t){if(t.sortable){this.sortOrder=this.sortField===t.field?this.sortOrder*-1:1,this.sortField=t.field,this.sortColumn=t;var n=e.metaKey||e.ctrlKey;this.lazy?this.onLazyLoad.emit(this.createLazyLoadMetadata()):"multiple"==this.sortMode?(this.multiSortMeta&&n||(this.multiSortMeta=[]),this.addSortMeta({field:this.sortField,order:this.sortOrder}),this.sortMultiple()):this.sortSingle(),this.onSort.emit({field:this.sortField,order:this.sortOrder,multisortmeta:this.multiSortMeta})}},DataTable.prototype.sortSingle=function(){var e=this;this.value&&(this.sortColumn&&"custom"===this.sortColumn.sortable?this.sortColumn.sortFunction.emit({field:this.sortField,order:this.sortOrder}):this.value.sort(function(t,n){var r=e.resolveFieldData(t,e.sortField),i=e.resolveFieldData(n,e.sortField),o=null;return o=r instanceof String&&i instanceof String?r.localeCompare(i):r<i?-1:r>i?1:0,e.sortOrder*o}),this.first=0,this.hasFilter()&&this.filter()),this.stopSortPropagation=!0},DataTable.prototype.sortMultiple=function(){var e=this;this.value&&(this.value.sort(function(t,n){return e.multisortField(t,n,e.multiSortMeta,0)}),this.hasFilter()&&this.filter()),this.stopSortPropagation=!0},DataTable.prototype.multisortField=function(e,t,n,r){var i=this.resolveFieldData(e,n[r].field),o=this.resolveFieldData(t,n[r].field),s=null;if("string"==typeof i||i instanceof String){if(i.localeCompare&&i!=o)return n[r].order*i.localeCompare(o)}else s=i<o?-1:1;return i==o?n.length-1>r?this.multisortField(e,t,n,r+1):0:n[r].order*s},DataTable.prototype.addSortMeta=function(e){for(var t=-1,n=0;n<this.multiSortMeta.length;n++)if(this.multiSortMeta[n].field===e.field){t=n;break}t>=0?this.multiSortMeta[t]=e:this.multiSortMeta.push(e)},DataTable.prototype.isSorted=function(e){if("single"===this.sortMode)return this.sortField&&e.field===this.sortField;if("multiple"===this.sortMode){var t=!1;if(this.multiSortMeta)for(var n=0;n<this.multiSortMeta.length;n++)if(this.multiSortMeta[n].field==e.field){t=!0;break}return t}},DataTable.prototype.getSortOrder=function(e){var t=0;if("single"===this.sortMode)this.sortField&&e.field===this.sortField&&(t=this.sortOrder);else if("multiple"===this.sortMode&&this.multiSortMeta)for(var n=0;n<this.multiSortMeta.length;n++)if(this.multiSortMeta[n].field==e.field){t=this.multiSortMeta[n].order;break}return t},DataTable.prototype.handleRowClick=function(e,t){if(this.onRowClick.next({originalEvent:e,data:t}),this.selectionMode){var n=e.target.nodeName;if("INPUT"!=n&&"BUTTON"!=n&&"A"!=n&&!this.domHandler.hasClass(e.target,"ui-c")){var r=this.findIndexInSelection(t),i=r!=-1;i?(this.isSingleSelectionMode()?(this.selection=null,this.selectionChange.emit(null)):(this.selection.splice(r,1),this.selectionChange.emit(this.selection)),this.onRowUnselect.emit({originalEvent:e,data:t,type:"row"})):(this.isSingleSelectionMode()?(this.selection=t,this.selectionChange.emit(t)):this.isMultipleSelectionMode()&&(this.selection=this.selection||[],this.selection.push(t),this.selectionChange.emit(this.selection)),this.onRowSelect.emit({originalEvent:e,data:t,type:"row"}))}}},DataTable.prototype.selectRowWithRadio=function(e){this.selection!=e&&(this.selection=e,this.selectionChange.emit(this.selection),this.onRowSelect.emit({originalEvent:event,data:e,type:"radiobutton"}))},DataTable.prototype.toggleRowWithCheckbox=function(e,t){var n=this.findIndexInSelection(t);this.selection=this.selection||[],n!=-1?(this.selection.splice(n,1),this.onRowUnselect.emit({originalEvent:e,data:t,type:"checkbox"})):(this.selection.push(t),this.onRowSelect.emit({originalEvent:e,data:t,type:"checkbox"})),this.selectionChange.emit(this.selection)},DataTable.prototype.toggleRowsWithCheckbox=function(e){e.checked?this.selection=this.dataToRender.slice(0):this.selection=[],this.selectionChange.emit(this.selection),this.onHeaderCheckboxToggle.emit({originalEvent:e,checked:e.checked})},DataTable.prototype.onRowRightClick=function(e,t){if(this.contextMenu){var n=this.findIndexInSelection(t),r=n!=-1;r||(this.isSingleSelectionMode()?(this.selection=t,this.selectionChange.emit(t)):this.isMultipleSelectionMode()&&(this.selection=[],this.selection.push(t),this.selectionChange.emit(this.selection))),this.contextMenu.show(e),this.onContextMenuSelect.emit({originalEvent:e,data:t})}},DataTable.prototype.rowDblclick=function(e,t){this.onRowDblclick.emit({originalEvent:e,data:t})},DataTable.prototype.isSingleSelectionMode=function(){return"single"===this.selectionMode},DataTable.prototype.isMultipleSelectionMode=function(){return"multiple"===this.selectionMode},DataTable.prototype.findIndexInSelection=function(e){var t=-1;if(this.selection)for(var n=0;n<this.selection.length;n++)if(this.domHandler.equals(e,this.selection[n])){t=n;break}return t},DataTable.prototype.isSelected=function(e){return e&&this.domHandler.equals(e,this.selection)||this.findIndexInSelection(e)!=-1},Object.defineProperty(DataTable.prototype,"allSelected",{get:function(){var e=!0;if(this.dataToRender&&this.selection&&this.dataToRender.length==this.selection.length)for(var t=0,n=this.dataToRender;t<n.length;t++){var r=n[t];if(!this.isSelected(r)){e=!1;break}}else e=!1;return e},enumerable:!0,configurable:!0}),DataTable.prototype.onFilterKeyup=function(e,t,n){var r=this;this.filterTimeout&&clearTimeout(this.filterTimeout),this.filterTimeout=setTimeout(function(){r.filters[t]={value:e,matchMode:n},r.filter(),r.filterTimeout=null},this.filterDelay)},DataTable.prototype.filter=function(){if(this.first=0,this.lazy)this.onLazyLoad.emit(this.createLazyLoadMetadata());else{this.filteredValue=[];for(var e=0;e<this.value.length;e++){for(var t=!0,n=!1,r=0;r<this.columns.length;r++){var i=this.columns[r],o=this.filters[i.field];if(o){var s=o.value,a=i.field,l=o.matchMode||"startsWith",c=this.resolveFieldData(this.value[e],a),u=this.filterConstraints[l];if(u(c,s)||(t=!1),!t)break}this.globalFilter&&!n&&(n=this.filterConstraints.contains(this.resolveFieldData(this.value[e],i.field),this.globalFilter.value))}var p=t;this.globalFilter&&(p=t&&n),p&&this.filteredValue.push(this.value[e])}this.filteredValue.length===this.value.length&&(this.filteredValue=null),this.paginator&&(this.totalRecords=this.filteredValue?this.filteredValue.length:this.value?this.value.length:0),this.updateDataToRender(this.filteredValue||this.value)}this.onFilter.emit({filters:this.filters})},DataTable.prototype.hasFilter=function(){var e=!0;for(var t in this.filters)if(this.filters.hasOwnProperty(t)){e=!1;break}return!e},DataTable.prototype.onFilterInputClick=function(e){e.stopPropagation()},DataTable.prototype.switchCellToEditMode=function(e,t,n){if(!this.selectionMode&&this.editable&&t.editable){this.onEditInit.emit({column:t,data:n});var r=this.findCell(e);if(!this.domHandler.hasClass(r,"ui-cell-editing")){this.domHandler.addClass(r,"ui-cell-editing"),this.domHandler.addClass(r,"ui-state-highlight");r.querySelector(".ui-cell-editor").focus()}}},DataTable.prototype.switchCellToViewMode=function(e,t,n,r){if(this.editable)if(this.preventBlurOnEdit)this.preventBlurOnEdit=!1;else{r?this.onEditComplete.emit({column:t,data:n}):this.onEditCancel.emit({column:t,data:n});var i=this.findCell(e);this.domHandler.removeClass(i,"ui-cell-editing"),this.domHandler.removeClass(i,"ui-state-highlight")}},DataTable.prototype.onCellEditorKeydown=function(e,t,n){this.editable&&(this.onEdit.emit({originalEvent:e,column:t,data:n}),13==e.keyCode&&(this.switchCellToViewMode(e.target,t,n,!0),this.preventBlurOnEdit=!0),27==e.keyCode&&(this.switchCellToViewMode(e.target,t,n,!1),this.preventBlurOnEdit=!0))},DataTable.prototype.findCell=function(e){for(var t=e;"TD"!=t.tagName;)t=t.parentElement;return t},DataTable.prototype.initResizableColumns=function(){var e=this;this.tbody=this.domHandler.findSingle(this.el.nativeElement,"tbody.ui-datatable-data"),this.resizerHelper=this.domHandler.findSingle(this.el.nativeElement,"div.ui-column-resizer-helper"),this.fixColumnWidths(),this.documentColumnResizeListener=this.renderer.listenGlobal("body","mousemove",function(t){e.columnResizing&&e.onColumnResize(t)}),this.documentColumnResizeEndListener=this.renderer.listenGlobal("body","mouseup",function(t){e.columnResizing&&(e.columnResizing=!1,e.onColumnResizeEnd(t))})},DataTable.prototype.initColumnResize=function(e){this.resizeColumn=e.target.parentElement,this.columnResizing=!0,this.lastPageX=e.pageX},DataTable.prototype.onColumnResize=function(e){var t=this.el.nativeElement.children[0];this.domHandler.addClass(t,"ui-unselectable-text"),this.resizerHelper.style.height=t.offsetHeight-4+"px",this.resizerHelper.style.top=t.offsetTop+"px",e.pageX>t.offsetLeft&&e.pageX<t.offsetLeft+t.offsetWidth&&(this.resizerHelper.style.left=e.pageX+"px"),this.resizerHelper.style.display="block"},DataTable.prototype.onColumnResizeEnd=function(e){var t=this.resizerHelper.offsetLeft-this.lastPageX,n=this.resizeColumn.offsetWidth,r=n+t,i=this.resizeColumn.style.minWidth||15;if(n+t>parseInt(i)){if("fit"===this.columnResizeMode){var o=this.resizeColumn.nextElementSibling,s=o.offsetWidth-t;r>15&&s>15&&(this.resizeColumn.style.width=r+"px",o&&(o.style.width=s+"px"))}else"expand"===this.columnResizeMode&&(this.tbody.parentElement.style.width=this.tbody.parentElement.offsetWidth+t+"px",this.resizeColumn.style.width=r+"px");this.onColResize.emit({element:this.resizeColumn,delta:t})}this.resizerHelper.style.display="none",this.resizeColumn=null,this.domHandler.removeClass(this.el.nativeElement.children[0],"ui-unselectable-text")},DataTable.prototype.fixColumnWidths=function(){for(var e=this.domHandler.find(this.el.nativeElement,"th.ui-resizable-column"),t=0,n=e;t<n.length;t++){var r=n[t];r.style.width="auto"}for(var i=0,o=e;i<o.length;i++){var r=o[i];r.style.width=r.offsetWidth+"px"}},DataTable.prototype.onColumnDragStart=function(e){this.draggedColumn=this.findParentHeader(e.target)},DataTable.prototype.onColumnDragover=function(e){if(this.reorderableColumns&&this.draggedColumn){e.preventDefault();var t=this.findParentHeader(e.target);if(this.draggedColumn!=t){var n=t.getBoundingClientRect(),r=n.left+document.body.scrollLeft,i=n.top+document.body.scrollTop,o=r+t.offsetWidth/2;this.reorderIndicatorUp.style.top=i-16+"px",this.reorderIndicatorDown.style.top=i+t.offsetHeight+"px",e.pageX>o?(this.reorderIndicatorUp.style.left=r+t.offsetWidth-8+"px",this.reorderIndicatorDown.style.left=r+t.offsetWidth-8+"px"):(this.reorderIndicatorUp.style.left=r-8+"px",this.reorderIndicatorDown.style.left=r-8+"px"),this.reorderIndicatorUp.style.display="block",this.reorderIndicatorDown.style.display="block"}else e.dataTransfer.dropEffect="none"}},DataTable.prototype.onColumnDragleave=function(e){this.reorderableColumns&&this.draggedColumn&&(e.preventDefault(),this.reorderIndicatorUp.style.display="none",this.reorderIndicatorDown.style.display="none")},DataTable.prototype.onColumnDrop=function(e){e.preventDefault();var t=this.domHandler.index(this.draggedColumn),n=this.domHandler.index(this.findParentHeader(e.target));t!=n&&(this.columns.splice(n,0,this.columns.splice(t,1)[0]),this.onColReorder.emit({dragIndex:t,dropIndex:n,columns:this.columns})),this.reorderIndicatorUp.style.display="none",this.reorderIndicatorDown.style.display="none",this.draggedColumn=null},DataTable.prototype.initColumnReordering=function(){this.reorderIndicatorUp=this.domHandler.findSingle(this.el.nativeElement.children[0],"span.ui-datatable-reorder-indicator-up"),this.reorderIndicatorDown=this.domHandler.findSingle(this.el.nativeElement.children[0],"span.ui-datatable-reorder-indicator-down")},DataTable.prototype.findParentHeader=function(e){if("TH"==e.nodeName)return e;for(var t=e.parentElement;"TH"!=t.nodeName;)t=t.parentElement;return t},DataTable.prototype.initScrolling=function(){var e=this;this.scrollHeader=this.domHandler.findSingle(this.el.nativeElement,".ui-datatable-scrollable-header"),this.scrollHeaderBox=this.domHandler.findSingle(this.el.nativeElement,".ui-datatable-scrollable-header-box"),this.scrollBody=this.domHandler.findSingle(this.el.nativeElement,".ui-datatable-scrollable-body"),this.percentageScrollHeight=this.scrollHeight&&this.scrollHeight.indexOf("%")!==-1,this.scrollHeight&&(this.percentageScrollHeight?this.scrollBody.style.maxHeight=this.domHandler.getOuterHeight(this.el.nativeElement.parentElement)*(parseInt(this.scrollHeight)/100)+"px":this.scrollBody.style.maxHeight=this.scrollHeight,this.scrollHeaderBox.style.marginRight=this.calculateScrollbarWidth()+"px"),this.bodyScrollListener=this.renderer.listen(this.scrollBody,"scroll",function(){e.scrollHeaderBox.style.marginLeft=-1*e.scrollBody.scrollLeft+"px"}),this.headerScrollListener=this.renderer.listen(this.scrollHeader,"scroll",function(){e.scrollHeader.scrollLeft=0}),this.percentageScrollHeight&&(this.resizeScrollListener=this.renderer.listenGlobal("window","resize",function(){
@@ -54,7 +54,7 @@ providers:[a.DomHandler]}),i("design:paramtypes",[o.ElementRef,a.DomHandler,o.Re
 this.onAfterToggle=new o.EventEmitter}return Panel.prototype.toggle=function(e){this.onBeforeToggle.emit({originalEvent:e,collapsed:this.collapsed}),this.toggleable&&(this.collapsed?this.expand(e):this.collapse(e)),this.onAfterToggle.emit({originalEvent:e,collapsed:this.collapsed}),e.preventDefault()},Panel.prototype.expand=function(e){this.collapsed=!1},Panel.prototype.collapse=function(e){this.collapsed=!0},r([o.Input(),i("design:type",Boolean)],Panel.prototype,"toggleable",void 0),r([o.Input(),i("design:type",String)],Panel.prototype,"header",void 0),r([o.Input(),i("design:type",Boolean)],Panel.prototype,"collapsed",void 0),r([o.Input(),i("design:type",Object)],Panel.prototype,"style",void 0),r([o.Input(),i("design:type",String)],Panel.prototype,"styleClass",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Panel.prototype,"onBeforeToggle",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Panel.prototype,"onAfterToggle",void 0),Panel=r([o.Component({selector:"p-panel",template:'\n        <div [ngClass]="\'ui-panel ui-widget ui-widget-content ui-corner-all\'" [ngStyle]="style" [class]="styleClass">\n            <div class="ui-panel-titlebar ui-widget-header ui-helper-clearfix ui-corner-all">\n                <span class="ui-panel-title" *ngIf="header">{{header}}</span>\n                <ng-content select="header"></ng-content>\n                <a *ngIf="toggleable" class="ui-panel-titlebar-icon ui-panel-titlebar-toggler ui-corner-all ui-state-default" href="#"\n                    [ngClass]="{\'ui-state-hover\':hoverToggler}" (mouseenter)="hoverToggler=true" (mouseleave)="hoverToggler=false" (click)="toggle($event)">\n                    <span class="fa fa-fw" [ngClass]="{\'fa-minus\': !collapsed,\'fa-plus\':collapsed}"></span>\n                </a>\n            </div>\n            <div class="ui-panel-content ui-widget-content" [style.display]="collapsed ? \'none\' : \'block\'">\n                <ng-content></ng-content>\n            </div>\n        </div>\n    '}),i("design:paramtypes",[])],Panel)}();t.Panel=a;var l=function(){function PanelModule(){}return PanelModule=r([o.NgModule({imports:[s.CommonModule],exports:[a],declarations:[a]}),i("design:paramtypes",[])],PanelModule)}();t.PanelModule=l},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(29),l=function(){function PanelMenuSub(e){this.router=e}return PanelMenuSub.prototype.onClick=function(e,t){t.items?(t.expanded=!t.expanded,e.preventDefault()):(t.url&&!t.routerLink||e.preventDefault(),t.command&&(t.eventEmitter||(t.eventEmitter=new o.EventEmitter,t.eventEmitter.subscribe(t.command)),t.eventEmitter.emit(e)),t.routerLink&&this.router.navigate(t.routerLink))},r([o.Input(),i("design:type",Object)],PanelMenuSub.prototype,"item",void 0),r([o.Input(),i("design:type",Boolean)],PanelMenuSub.prototype,"expanded",void 0),PanelMenuSub=r([o.Component({selector:"p-panelMenuSub",template:'\n        <ul class="ui-menu-list ui-helper-reset" [style.display]="expanded ? \'block\' : \'none\'">\n            <li *ngFor="let child of item.items" class="ui-menuitem ui-corner-all" [ngClass]="{\'ui-menu-parent\':child.items}">\n                <a #link [href]="child.url||\'#\'" class="ui-menuitem-link ui-corner-all" \n                    [ngClass]="{\'ui-menuitem-link-hasicon\':child.icon&&child.items,\'ui-state-hover\':(hoveredLink==link)}" (click)="onClick($event,child)"\n                    (mouseenter)="hoveredLink=link" (mouseleave)="hoveredLink=null">\n                    <span class="ui-panelmenu-icon fa fa-fw" [ngClass]="{\'fa-caret-right\':!child.expanded,\'fa-caret-down\':child.expanded}" *ngIf="child.items"></span>\n                    <span class="ui-menuitem-icon fa fa-fw" [ngClass]="child.icon" *ngIf="child.icon"></span>\n                    <span class="ui-menuitem-text">{{child.label}}</span>\n                </a>\n                <p-panelMenuSub [item]="child" [expanded]="child.expanded" *ngIf="child.items"></p-panelMenuSub>\n            </li>\n        </ul>\n    '}),i("design:paramtypes",[a.Router])],PanelMenuSub)}();t.PanelMenuSub=l;var c=function(){function PanelMenu(){}return PanelMenu.prototype.headerClick=function(e,t){t.expanded=!t.expanded,e.preventDefault()},PanelMenu.prototype.unsubscribe=function(e){if(e.eventEmitter&&e.eventEmitter.unsubscribe(),e.items)for(var t=0,n=e.items;t<n.length;t++){var r=n[t];this.unsubscribe(r)}},PanelMenu.prototype.ngOnDestroy=function(){if(this.model)for(var e=0,t=this.model;e<t.length;e++){var n=t[e];this.unsubscribe(n)}},r([o.Input(),i("design:type",Array)],PanelMenu.prototype,"model",void 0),r([o.Input(),i("design:type",Object)],PanelMenu.prototype,"style",void 0),r([o.Input(),i("design:type",String)],PanelMenu.prototype,"styleClass",void 0),PanelMenu=r([o.Component({selector:"p-panelMenu",template:'\n        <div [class]="styleClass" [ngStyle]="style" [ngClass]="\'ui-panelmenu ui-widget\'">\n            <div *ngFor="let item of model" class="ui-panelmenu-panel">\n                <div tabindex="0" [ngClass]="{\'ui-widget ui-panelmenu-header ui-state-default\':true,\'ui-corner-all\':!item.expanded,\n                    \'ui-state-active ui-corner-top\':item.expanded,\'ui-state-hover\':(item == hoveredItem)}" (click)="headerClick($event,item)">\n                    <span class="ui-panelmenu-icon fa fa-fw" [ngClass]="{\'fa-caret-right\':!item.expanded,\'fa-caret-down\':item.expanded}"></span>\n                    <a [href]="item.url||\'#\'" [ngClass]="{\'ui-panelmenu-headerlink-hasicon\':item.icon}"\n                        (mouseenter)="hoveredItem=item" (mouseleave)="hoveredItem=null">\n                        <span class="ui-menuitem-icon fa fa-fw" [ngClass]="item.icon" *ngIf="item.icon"></span>\n                        <span>{{item.label}}</span>\n                    </a>\n                </div>\n                <div class="ui-panelmenu-content ui-widget-content" [style.display]="item.expanded ? \'block\' : \'none\'">\n                    <p-panelMenuSub [item]="item" [expanded]="true"></p-panelMenuSub>\n                </div>\n            </div>\n        </div>\n    '}),i("design:paramtypes",[])],PanelMenu)}();t.PanelMenu=c;var u=function(){function PanelMenuModule(){}return PanelMenuModule=r([o.NgModule({imports:[s.CommonModule],exports:[c],declarations:[c,l]}),i("design:paramtypes",[])],PanelMenuModule)}();t.PanelMenuModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(9),l=function(){function Password(e,t){this.el=e,this.domHandler=t,this.promptLabel="Please enter a password",this.weakLabel="Weak",this.mediumLabel="Medium",this.strongLabel="Strong"}return Password.prototype.ngAfterViewInit=function(){this.panel=document.createElement("div"),this.panel.className="ui-password-panel ui-widget ui-state-highlight ui-corner-all ui-helper-hidden ui-password-panel-overlay",this.meter=document.createElement("div"),this.meter.className="ui-password-meter",this.info=document.createElement("div"),this.info.className="ui-password-info",this.info.textContent=this.promptLabel,this.panel.appendChild(this.meter),this.panel.appendChild(this.info),document.body.appendChild(this.panel)},Password.prototype.onMouseover=function(e){this.hover=!0},Password.prototype.onMouseout=function(e){this.hover=!1},Password.prototype.onFocus=function(e){this.focus=!0,this.domHandler.removeClass(this.panel,"ui-helper-hidden"),this.domHandler.absolutePosition(this.panel,this.el.nativeElement),this.domHandler.fadeIn(this.panel,250)},Password.prototype.onBlur=function(e){this.focus=!1,this.domHandler.addClass(this.panel,"ui-helper-hidden")},Password.prototype.onKeyup=function(e){var t=e.target.value,n=null,r=null;if(0===t.length)n=this.promptLabel,r="0px 0px";else{var i=this.testStrength(t);i<30?(n=this.weakLabel,r="0px -10px"):i>=30&&i<80?(n=this.mediumLabel,r="0px -20px"):i>=80&&(n=this.strongLabel,r="0px -30px")}this.meter.style.backgroundPosition=r,this.info.textContent=n},Password.prototype.testStrength=function(e){var t,n=0;return t=e.match("[0-9]"),n+=25*this.normalize(t?t.length:.25,1),t=e.match("[a-zA-Z]"),n+=10*this.normalize(t?t.length:.5,3),t=e.match("[!@#$%^&*?_~.,;=]"),n+=35*this.normalize(t?t.length:1/6,1),t=e.match("[A-Z]"),n+=30*this.normalize(t?t.length:1/6,1),n*=e.length/8,n>100?100:n},Password.prototype.normalize=function(e,t){var n=e-t;return n<=0?e/t:1+.5*(e/(e+t/4))},Password.prototype.isDisabled=function(){return this.el.nativeElement.disabled},Password.prototype.ngOnDestroy=function(){this.panel.removeChild(this.meter),this.panel.removeChild(this.info),document.body.removeChild(this.panel),this.panel=null,this.meter=null,this.info=null},r([o.Input(),i("design:type",String)],Password.prototype,"promptLabel",void 0),r([o.Input(),i("design:type",String)],Password.prototype,"weakLabel",void 0),r([o.Input(),i("design:type",String)],Password.prototype,"mediumLabel",void 0),r([o.Input(),i("design:type",String)],Password.prototype,"strongLabel",void 0),r([o.HostListener("mouseover",["$event"]),i("design:type",Function),i("design:paramtypes",[Object]),i("design:returntype",void 0)],Password.prototype,"onMouseover",null),r([o.HostListener("mouseout",["$event"]),i("design:type",Function),i("design:paramtypes",[Object]),i("design:returntype",void 0)],Password.prototype,"onMouseout",null),r([o.HostListener("focus",["$event"]),i("design:type",Function),i("design:paramtypes",[Object]),i("design:returntype",void 0)],Password.prototype,"onFocus",null),r([o.HostListener("blur",["$event"]),i("design:type",Function),i("design:paramtypes",[Object]),i("design:returntype",void 0)],Password.prototype,"onBlur",null),r([o.HostListener("keyup",["$event"]),i("design:type",Function),i("design:paramtypes",[Object]),i("design:returntype",void 0)],Password.prototype,"onKeyup",null),Password=r([o.Directive({selector:"[pPassword]",host:{"[class.ui-inputtext]":"true","[class.ui-corner-all]":"true","[class.ui-state-default]":"true","[class.ui-widget]":"true","[class.ui-state-hover]":"hover","[class.ui-state-focus]":"focus","[class.ui-state-disabled]":"isDisabled()"},providers:[a.DomHandler]}),i("design:paramtypes",[o.ElementRef,a.DomHandler])],Password)}();t.Password=l;var c=function(){function PasswordModule(){}return PasswordModule=r([o.NgModule({imports:[s.CommonModule],exports:[l],declarations:[l]}),i("design:paramtypes",[])],PasswordModule)}();t.PasswordModule=c},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(139),l=n(19),c=n(9),u=function(){function PickList(e,t){this.el=e,this.domHandler=t}return PickList.prototype.ngAfterViewChecked=function(){if(this.movedUp||this.movedDown){var e=this.domHandler.find(this.reorderedListElement,"li.ui-state-highlight"),t=void 0;t=this.movedUp?e[0]:e[e.length-1],this.domHandler.scrollInView(this.reorderedListElement,t),this.movedUp=!1,this.movedDown=!1,this.reorderedListElement=null}},PickList.prototype.selectItem=function(e,t){var n=e.metaKey||e.ctrlKey,r=this.findIndexInSelection(t),i=r!=-1;i&&n?this.selectedItems.splice(r,1):(this.selectedItems=n?this.selectedItems||[]:[],this.selectedItems.push(t))},PickList.prototype.moveUp=function(e,t){if(this.selectedItems){for(var n=0;n<this.selectedItems.length;n++){var r=this.selectedItems[n],i=this.findIndexInList(r,t);if(0==i)break;var o=t[i],s=t[i-1];t[i-1]=o,t[i]=s}this.movedUp=!0,this.reorderedListElement=e}},PickList.prototype.moveTop=function(e,t){if(this.selectedItems){for(var n=0;n<this.selectedItems.length;n++){var r=this.selectedItems[n],i=this.findIndexInList(r,t);if(0==i)break;var o=t.splice(i,1)[0];t.unshift(o)}e.scrollTop=0}},PickList.prototype.moveDown=function(e,t){if(this.selectedItems){for(var n=this.selectedItems.length-1;n>=0;n--){var r=this.selectedItems[n],i=this.findIndexInList(r,t);if(i==t.length-1)break;var o=t[i],s=t[i+1];t[i+1]=o,t[i]=s}this.movedDown=!0,this.reorderedListElement=e}},PickList.prototype.moveBottom=function(e,t){if(this.selectedItems){for(var n=this.selectedItems.length-1;n>=0;n--){var r=this.selectedItems[n],i=this.findIndexInList(r,t);if(i==t.length-1)break;var o=t.splice(i,1)[0];t.push(o)}e.scrollTop=e.scrollHeight}},PickList.prototype.moveRight=function(e){if(this.selectedItems){for(var t=0;t<this.selectedItems.length;t++){var n=this.selectedItems[t];this.findIndexInList(n,this.target)==-1&&this.target.push(this.source.splice(this.findIndexInList(n,this.source),1)[0])}this.selectedItems=[]}},PickList.prototype.moveAllRight=function(){if(this.selectedItems){for(var e=0;e<this.source.length;e++)this.target.push(this.source[e]);this.source.splice(0,this.source.length),this.selectedItems=[]}},PickList.prototype.moveLeft=function(e){if(this.selectedItems){for(var t=0;t<this.selectedItems.length;t++){var n=this.selectedItems[t];this.findIndexInList(n,this.source)==-1&&this.source.push(this.target.splice(this.findIndexInList(n,this.target),1)[0])}this.selectedItems=[]}},PickList.prototype.moveAllLeft=function(){if(this.selectedItems){for(var e=0;e<this.target.length;e++)this.source.push(this.target[e]);this.target.splice(0,this.target.length),this.selectedItems=[]}},PickList.prototype.isSelected=function(e){return this.findIndexInSelection(e)!=-1},PickList.prototype.findIndexInSelection=function(e){return this.findIndexInList(e,this.selectedItems)},PickList.prototype.findIndexInList=function(e,t){var n=-1;if(t)for(var r=0;r<t.length;r++)if(t[r]==e){n=r;break}return n},PickList.prototype.ngOnDestroy=function(){},r([o.Input(),i("design:type",Array)],PickList.prototype,"source",void 0),r([o.Input(),i("design:type",Array)],PickList.prototype,"target",void 0),r([o.Input(),i("design:type",String)],PickList.prototype,"sourceHeader",void 0),r([o.Input(),i("design:type",String)],PickList.prototype,"targetHeader",void 0),r([o.Input(),i("design:type",Boolean)],PickList.prototype,"responsive",void 0),r([o.Input(),i("design:type",Object)],PickList.prototype,"style",void 0),r([o.Input(),i("design:type",String)],PickList.prototype,"styleClass",void 0),r([o.Input(),i("design:type",Object)],PickList.prototype,"sourceStyle",void 0),r([o.Input(),i("design:type",Object)],PickList.prototype,"targetStyle",void 0),r([o.ContentChild(o.TemplateRef),i("design:type",o.TemplateRef)],PickList.prototype,"itemTemplate",void 0),PickList=r([o.Component({selector:"p-pickList",template:'\n        <div [class]="styleClass" [ngStyle]="style" [ngClass]="{\'ui-picklist ui-widget ui-helper-clearfix\': true, \'ui-picklist-responsive\': responsive}">\n            <div class="ui-picklist-source-controls ui-picklist-buttons">\n                <div class="ui-picklist-buttons-cell">\n                    <button type="button" pButton icon="fa-angle-up" (click)="moveUp(sourcelist,source)"></button>\n                    <button type="button" pButton icon="fa-angle-double-up" (click)="moveTop(sourcelist,source)"></button>\n                    <button type="button" pButton icon="fa-angle-down" (click)="moveDown(sourcelist,source)"></button>\n                    <button type="button" pButton icon="fa-angle-double-down" (click)="moveBottom(sourcelist,source)"></button>\n                </div>\n            </div>\n            <div class="ui-picklist-listwrapper ui-picklist-source-wrapper">\n                <div class="ui-picklist-caption ui-widget-header ui-corner-tl ui-corner-tr" *ngIf="sourceHeader">{{sourceHeader}}</div>\n                <ul #sourcelist class="ui-widget-content ui-picklist-list ui-picklist-source ui-corner-bottom" [ngStyle]="sourceStyle">\n                    <li *ngFor="let item of source" [ngClass]="{\'ui-picklist-item\':true,\'ui-state-hover\':(hoveredItem==item),\'ui-state-highlight\':isSelected(item)}"\n                        (mouseenter)="hoveredItem=item" (mouseleave)="hoveredItem=null" (click)="selectItem($event,item)">\n                        <template [pTemplateWrapper]="itemTemplate" [item]="item"></template>\n                    </li>\n                </ul>\n            </div>\n            <div class="ui-picklist-buttons">\n                <div class="ui-picklist-buttons-cell">\n                    <button type="button" pButton icon="fa-angle-right" (click)="moveRight(targetlist)"></button>\n                    <button type="button" pButton icon="fa-angle-double-right" (click)="moveAllRight()"></button>\n                    <button type="button" pButton icon="fa-angle-left" (click)="moveLeft(sourcelist)"></button>\n                    <button type="button" pButton icon="fa-angle-double-left" (click)="moveAllLeft()"></button>\n                </div>\n            </div>\n            <div class="ui-picklist-listwrapper ui-picklist-target-wrapper">\n                <div class="ui-picklist-caption ui-widget-header ui-corner-tl ui-corner-tr" *ngIf="targetHeader">{{targetHeader}}</div>\n                <ul #targetlist class="ui-widget-content ui-picklist-list ui-picklist-target ui-corner-bottom" [ngStyle]="targetStyle">\n                    <li *ngFor="let item of target" [ngClass]="{\'ui-picklist-item\':true,\'ui-state-hover\':(hoveredItem==item),\'ui-state-highlight\':isSelected(item)}"\n                        (mouseenter)="hoveredItem=item" (mouseleave)="hoveredItem=null" (click)="selectItem($event,item)">\n                        <template [pTemplateWrapper]="itemTemplate" [item]="item"></template>\n                    </li>\n                </ul>\n            </div>\n            <div class="ui-picklist-target-controls ui-picklist-buttons">\n                <div class="ui-picklist-buttons-cell">\n                    <button type="button" pButton icon="fa-angle-up" (click)="moveUp(targetlist,target)"></button>\n                    <button type="button" pButton icon="fa-angle-double-up" (click)="moveTop(targetlist,target)"></button>\n                    <button type="button" pButton icon="fa-angle-down" (click)="moveDown(targetlist,target)"></button>\n                    <button type="button" pButton icon="fa-angle-double-down" (click)="moveBottom(targetlist,target)"></button>\n                </div>\n            </div>\n        </div>\n    ',providers:[c.DomHandler]}),i("design:paramtypes",[o.ElementRef,c.DomHandler])],PickList)}();t.PickList=u;var p=function(){function PickListModule(){}return PickListModule=r([o.NgModule({imports:[s.CommonModule,a.ButtonModule,l.SharedModule],exports:[u,l.SharedModule],declarations:[u]}),i("design:paramtypes",[])],PickListModule)}();t.PickListModule=p},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=function(){function ProgressBar(){}return r([o.Input(),i("design:type",Object)],ProgressBar.prototype,"value",void 0),ProgressBar=r([o.Component({selector:"p-progressBar",template:'\n        <div class="ui-progressbar ui-widget ui-widget-content ui-corner-all" role="progressbar" aria-valuemin="0" [attr.aria-valuenow]="value" aria-valuemax="100">\n            <div class="ui-progressbar-value ui-progressbar-value-animate ui-widget-header ui-corner-all" [style.width]="value + \'%\'" style="display:block"></div>\n            <div class="ui-progressbar-label" [style.display]="value ? \'block\' : \'none\'">{{value}}%</div>\n        </div>\n    '}),i("design:paramtypes",[])],ProgressBar)}();t.ProgressBar=a;var l=function(){function ProgressBarModule(){}return ProgressBarModule=r([o.NgModule({imports:[s.CommonModule],exports:[a],declarations:[a]}),i("design:paramtypes",[])],ProgressBarModule)}();t.ProgressBarModule=l},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(22),l=new o.Provider(a.NG_VALUE_ACCESSOR,{useExisting:o.forwardRef(function(){return c}),multi:!0}),c=function(){function RadioButton(){this.click=new o.EventEmitter,this.onModelChange=function(){},this.onModelTouched=function(){}}return RadioButton.prototype.onclick=function(){this.disabled||(this.click.emit(null),this.checked=!0,this.onModelChange(this.value))},RadioButton.prototype.onMouseEnter=function(){this.hover=!0},RadioButton.prototype.onMouseLeave=function(){this.hover=!1},RadioButton.prototype.writeValue=function(e){this.model=e,this.checked=this.model==this.value},RadioButton.prototype.registerOnChange=function(e){this.onModelChange=e},RadioButton.prototype.registerOnTouched=function(e){this.onModelTouched=e},r([o.Input(),i("design:type",Object)],RadioButton.prototype,"value",void 0),r([o.Input(),i("design:type",String)],RadioButton.prototype,"name",void 0),r([o.Input(),i("design:type",Boolean)],RadioButton.prototype,"disabled",void 0),r([o.Input(),i("design:type",String)],RadioButton.prototype,"label",void 0),r([o.Output(),i("design:type",o.EventEmitter)],RadioButton.prototype,"click",void 0),RadioButton=r([o.Component({selector:"p-radioButton",template:'\n        <div class="ui-radiobutton ui-widget">\n            <div class="ui-helper-hidden-accessible">\n                <input type="radio" [attr.name]="name" [attr.value]="value" [checked]="checked" (blur)="onModelTouched()">\n            </div>\n            <div class="ui-radiobutton-box ui-widget ui-radiobutton-relative ui-state-default" (click)="onclick()"\n                        (mouseenter)="onMouseEnter()" (mouseleave)="onMouseLeave()" [ngClass]="{\'ui-state-hover\':hover&&!disabled,\'ui-state-active\':checked,\'ui-state-disabled\':disabled}">\n                <span class="ui-radiobutton-icon" [ngClass]="{\'fa fa-fw fa-circle\':checked}"></span>\n            </div>\n        </div>\n        <label class="ui-radiobutton-label" (click)="onclick()" *ngIf="label">{{label}}</label>\n    ',providers:[l]}),i("design:paramtypes",[])],RadioButton)}();t.RadioButton=c;var u=function(){function RadioButtonModule(){}return RadioButtonModule=r([o.NgModule({imports:[s.CommonModule],exports:[c],declarations:[c]}),i("design:paramtypes",[])],RadioButtonModule)}();t.RadioButtonModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(22),l=new o.Provider(a.NG_VALUE_ACCESSOR,{useExisting:o.forwardRef(function(){return c}),multi:!0}),c=function(){function Rating(){this.stars=5,this.cancel=!0,this.onRate=new o.EventEmitter,this.onCancel=new o.EventEmitter,this.onModelChange=function(){},this.onModelTouched=function(){}}return Rating.prototype.ngOnInit=function(){this.starsArray=[];for(var e=0;e<this.stars;e++)this.starsArray[e]=e},Rating.prototype.rate=function(e,t){this.readonly||this.disabled||(this.value=t+1,this.onModelChange(this.value),this.onModelTouched(),this.onRate.emit({originalEvent:e,value:t+1}))},Rating.prototype.clear=function(e){this.readonly||this.disabled||(this.value=null,this.onModelChange(this.value),this.onModelTouched(),this.onCancel.emit(e))},Rating.prototype.writeValue=function(e){this.value=e},Rating.prototype.registerOnChange=function(e){this.onModelChange=e},Rating.prototype.registerOnTouched=function(e){this.onModelTouched=e},r([o.Input(),i("design:type",Boolean)],Rating.prototype,"disabled",void 0),r([o.Input(),i("design:type",Boolean)],Rating.prototype,"readonly",void 0),r([o.Input(),i("design:type",Number)],Rating.prototype,"stars",void 0),r([o.Input(),i("design:type",Boolean)],Rating.prototype,"cancel",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Rating.prototype,"onRate",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Rating.prototype,"onCancel",void 0),Rating=r([o.Component({selector:"p-rating",template:'\n        <div class="ui-rating" [ngClass]="{\'ui-state-disabled\': disabled}">\n            <div class="ui-rating-cancel" *ngIf="cancel" (click)="clear($event)" [ngClass]="{\'ui-rating-cancel-hover\':hoverCancel}"\n             (mouseenter)="hoverCancel=true" (mouseleave)="hoverCancel=false"><a></a></div>\n            <div class="ui-rating-star" *ngFor="let star of starsArray;let i=index" (click)="rate($event,i)"\n             [ngClass]="{\'ui-rating-star-on\':(i < value)}"><a></a></div>\n        </div>\n    ',providers:[l]}),i("design:paramtypes",[])],Rating)}();t.Rating=c;var u=function(){function RatingModule(){}return RatingModule=r([o.NgModule({imports:[s.CommonModule],exports:[c],declarations:[c]}),i("design:paramtypes",[])],RatingModule)}();t.RatingModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=function(){function Schedule(e,t){this.el=e,this.aspectRatio=1.35,this.defaultView="month",this.allDaySlot=!0,this.allDayText="all-day",this.slotDuration="00:30:00",this.scrollTime="06:00:00",this.minTime="00:00:00",this.maxTime="24:00:00",this.slotEventOverlap=!0,this.dragRevertDuration=500,this.dragOpacity=.75,this.dragScroll=!0,this.onDayClick=new o.EventEmitter,this.onEventClick=new o.EventEmitter,this.onEventMouseover=new o.EventEmitter,this.onEventMouseout=new o.EventEmitter,this.onEventDragStart=new o.EventEmitter,this.onEventDragStop=new o.EventEmitter,this.onEventDrop=new o.EventEmitter,this.onEventResizeStart=new o.EventEmitter,this.onEventResizeStop=new o.EventEmitter,this.onEventResize=new o.EventEmitter,this.viewRender=new o.EventEmitter,this.differ=t.find([]).create(null),this.initialized=!1}return Schedule.prototype.ngAfterViewInit=function(){var e=this;this.schedule=jQuery(this.el.nativeElement.children[0]);var t={theme:!0,header:this.header,isRTL:this.rtl,weekends:this.weekends,hiddenDays:this.hiddenDays,fixedWeekCount:this.fixedWeekCount,weekNumbers:this.weekNumbers,businessHours:this.businessHours,height:this.height,contentHeight:this.contentHeight,aspectRatio:this.aspectRatio,eventLimit:this.eventLimit,defaultDate:this.defaultDate,editable:this.editable,eventStartEditable:this.eventStartEditable,eventDurationEditable:this.eventDurationEditable,defaultView:this.defaultView,allDaySlot:this.allDaySlot,allDayText:this.allDayText,slotDuration:this.slotDuration,slotLabelInterval:this.slotLabelInterval,snapDuration:this.snapDuration,scrollTime:this.scrollTime,minTime:this.minTime,maxTime:this.maxTime,slotEventOverlap:this.slotEventOverlap,nowIndicator:this.nowIndicator,dragRevertDuration:this.dragRevertDuration,dragOpacity:this.dragOpacity,dragScroll:this.dragScroll,eventOverlap:this.eventOverlap,eventConstraint:this.eventConstraint,events:function(t,n,r,i){i(e.events)},dayClick:function(t,n,r){e.onDayClick.emit({date:t,jsEvent:n,view:r})},eventClick:function(t,n,r){e.onEventClick.emit({calEvent:t,jsEvent:n,view:r})},eventMouseover:function(t,n,r){e.onEventMouseover.emit({calEvent:t,jsEvent:n,view:r})},eventMouseout:function(t,n,r){e.onEventMouseout.emit({calEvent:t,jsEvent:n,view:r})},eventDragStart:function(t,n,r,i){e.onEventDragStart.emit({event:t,jsEvent:n,view:i})},eventDragStop:function(t,n,r,i){e.onEventDragStop.emit({event:t,jsEvent:n,view:i})},eventDrop:function(t,n,r,i,o,s){e.onEventDrop.emit({event:t,delta:n,revertFunc:r,jsEvent:i,view:s})},eventResizeStart:function(t,n,r,i){e.onEventResizeStart.emit({event:t,jsEvent:n,view:i})},eventResizeStop:function(t,n,r,i){e.onEventResizeStop.emit({event:t,jsEvent:n,view:i})},eventResize:function(t,n,r,i,o,s){e.onEventResize.emit({event:t,delta:n,revertFunc:r,jsEvent:i,view:s})},viewRender:function(t,n){e.viewRender.emit({view:t,element:n})}};if(this.locale)for(var n in this.locale)t[n]=this.locale[n];this.schedule.fullCalendar(t),this.initialized=!0},Schedule.prototype.ngDoCheck=function(){var e=this.differ.diff(this.events);this.schedule&&e&&this.schedule.fullCalendar("refetchEvents")},Schedule.prototype.ngOnDestroy=function(){jQuery(this.el.nativeElement.children[0]).fullCalendar("destroy"),this.initialized=!1,this.schedule=null},Schedule.prototype.gotoDate=function(e){this.schedule.fullCalendar("gotoDate",e)},Schedule.prototype.prev=function(){this.schedule.fullCalendar("prev")},Schedule.prototype.next=function(){this.schedule.fullCalendar("next")},Schedule.prototype.prevYear=function(){this.schedule.fullCalendar("prevYear")},Schedule.prototype.nextYear=function(){this.schedule.fullCalendar("nextYear")},Schedule.prototype.today=function(){this.schedule.fullCalendar("today")},Schedule.prototype.incrementDate=function(e){this.schedule.fullCalendar("incrementDate",e)},Schedule.prototype.getDate=function(){return this.schedule.fullCalendar("getDate")},r([o.Input(),i("design:type",Array)],Schedule.prototype,"events",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"header",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"style",void 0),r([o.Input(),i("design:type",String)],Schedule.prototype,"styleClass",void 0),r([o.Input(),i("design:type",Boolean)],Schedule.prototype,"rtl",void 0),r([o.Input(),i("design:type",Boolean)],Schedule.prototype,"weekends",void 0),r([o.Input(),i("design:type",Array)],Schedule.prototype,"hiddenDays",void 0),r([o.Input(),i("design:type",Boolean)],Schedule.prototype,"fixedWeekCount",void 0),r([o.Input(),i("design:type",Boolean)],Schedule.prototype,"weekNumbers",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"businessHours",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"height",void 0),
 r([o.Input(),i("design:type",Object)],Schedule.prototype,"contentHeight",void 0),r([o.Input(),i("design:type",Number)],Schedule.prototype,"aspectRatio",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"eventLimit",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"defaultDate",void 0),r([o.Input(),i("design:type",Boolean)],Schedule.prototype,"editable",void 0),r([o.Input(),i("design:type",Boolean)],Schedule.prototype,"eventStartEditable",void 0),r([o.Input(),i("design:type",Boolean)],Schedule.prototype,"eventDurationEditable",void 0),r([o.Input(),i("design:type",String)],Schedule.prototype,"defaultView",void 0),r([o.Input(),i("design:type",Boolean)],Schedule.prototype,"allDaySlot",void 0),r([o.Input(),i("design:type",String)],Schedule.prototype,"allDayText",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"slotDuration",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"slotLabelInterval",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"snapDuration",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"scrollTime",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"minTime",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"maxTime",void 0),r([o.Input(),i("design:type",Boolean)],Schedule.prototype,"slotEventOverlap",void 0),r([o.Input(),i("design:type",Boolean)],Schedule.prototype,"nowIndicator",void 0),r([o.Input(),i("design:type",Number)],Schedule.prototype,"dragRevertDuration",void 0),r([o.Input(),i("design:type",Number)],Schedule.prototype,"dragOpacity",void 0),r([o.Input(),i("design:type",Boolean)],Schedule.prototype,"dragScroll",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"eventOverlap",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"eventConstraint",void 0),r([o.Input(),i("design:type",Object)],Schedule.prototype,"locale",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Schedule.prototype,"onDayClick",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Schedule.prototype,"onEventClick",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Schedule.prototype,"onEventMouseover",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Schedule.prototype,"onEventMouseout",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Schedule.prototype,"onEventDragStart",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Schedule.prototype,"onEventDragStop",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Schedule.prototype,"onEventDrop",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Schedule.prototype,"onEventResizeStart",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Schedule.prototype,"onEventResizeStop",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Schedule.prototype,"onEventResize",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Schedule.prototype,"viewRender",void 0),Schedule=r([o.Component({selector:"p-schedule",template:'\n        <div [ngStyle]="style" [class]="styleClass"></div>\n    '}),i("design:paramtypes",[o.ElementRef,o.IterableDiffers])],Schedule)}();t.Schedule=a;var l=function(){function ScheduleModule(){}return ScheduleModule=r([o.NgModule({imports:[s.CommonModule],exports:[a],declarations:[a]}),i("design:paramtypes",[])],ScheduleModule)}();t.ScheduleModule=l},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(22),l=new o.Provider(a.NG_VALUE_ACCESSOR,{useExisting:o.forwardRef(function(){return c}),multi:!0}),c=function(){function SelectButton(){this.onChange=new o.EventEmitter,this.onModelChange=function(){},this.onModelTouched=function(){}}return SelectButton.prototype.writeValue=function(e){this.value=e},SelectButton.prototype.registerOnChange=function(e){this.onModelChange=e},SelectButton.prototype.registerOnTouched=function(e){this.onModelTouched=e},SelectButton.prototype.onItemClick=function(e,t){if(this.multiple){var n=this.findItemIndex(t);n!=-1?this.value.splice(n,1):this.value.push(t.value)}else this.value=t.value;this.onModelChange(this.value),this.onChange.emit({originalEvent:e,value:this.value})},SelectButton.prototype.isSelected=function(e){return this.multiple?this.findItemIndex(e)!=-1:e.value==this.value},SelectButton.prototype.findItemIndex=function(e){var t=-1;if(this.value)for(var n=0;n<this.value.length;n++)if(this.value[n]==e.value){t=n;break}return t},r([o.Input(),i("design:type",Array)],SelectButton.prototype,"options",void 0),r([o.Input(),i("design:type",Number)],SelectButton.prototype,"tabindex",void 0),r([o.Input(),i("design:type",Boolean)],SelectButton.prototype,"multiple",void 0),r([o.Input(),i("design:type",Object)],SelectButton.prototype,"style",void 0),r([o.Input(),i("design:type",String)],SelectButton.prototype,"styleClass",void 0),r([o.Output(),i("design:type",o.EventEmitter)],SelectButton.prototype,"onChange",void 0),SelectButton=r([o.Component({selector:"p-selectButton",template:'\n        <div [ngClass]="\'ui-selectbutton ui-buttonset ui-widget ui-corner-all ui-buttonset-\' + options.length" (mouseleave)="hoveredItem=null" [ngStyle]="style" [class]="styleClass">\n            <div *ngFor="let option of options;" class="ui-button ui-widget ui-state-default ui-button-text-only"\n                [ngClass]="{\'ui-state-hover\': hoveredItem == option,\'ui-state-active\':isSelected(option)}"\n                (mouseenter)="hoveredItem=option" (click)="onItemClick($event,option)">\n                <span class="ui-button-text ui-c">{{option.label}}</span>\n            </div>\n        </div>\n    ',providers:[l]}),i("design:paramtypes",[])],SelectButton)}();t.SelectButton=c;var u=function(){function SelectButtonModule(){}return SelectButtonModule=r([o.NgModule({imports:[s.CommonModule],exports:[c],declarations:[c]}),i("design:paramtypes",[])],SelectButtonModule)}();t.SelectButtonModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=this&&this.__param||function(e,t){return function(n,r){t(n,r,e)}},s=n(0),a=n(3),l=n(9),c=n(29),u=function(){function SlideMenuSub(e,t){this.slideMenu=e,this.router=t,this.backLabel="Back",this.effectDuration="1s",this.easing="ease-out"}return SlideMenuSub.prototype.itemClick=function(e,t,n){t.url&&!t.routerLink||e.preventDefault(),this.activeItem=n,t.command&&(!t.eventEmitter&&t.command&&(t.eventEmitter=new s.EventEmitter,t.eventEmitter.subscribe(t.command)),t.eventEmitter.emit(e)),t.items&&(this.slideMenu.left-=this.slideMenu.menuWidth),t.routerLink&&this.router.navigate(t.routerLink)},SlideMenuSub.prototype.ngOnDestroy=function(){this.hoveredLink=null,this.activeItem=null},r([s.Input(),i("design:type",Object)],SlideMenuSub.prototype,"item",void 0),r([s.Input(),i("design:type",Boolean)],SlideMenuSub.prototype,"root",void 0),r([s.Input(),i("design:type",String)],SlideMenuSub.prototype,"backLabel",void 0),r([s.Input(),i("design:type",String)],SlideMenuSub.prototype,"menuWidth",void 0),r([s.Input(),i("design:type",Object)],SlideMenuSub.prototype,"effectDuration",void 0),r([s.Input(),i("design:type",String)],SlideMenuSub.prototype,"easing",void 0),SlideMenuSub=r([s.Component({selector:"p-slideMenuSub",template:'\n        <ul [ngClass]="{\'ui-helper-reset ui-menu-rootlist\':root, \'ui-widget-content ui-corner-all ui-helper-clearfix ui-menu-child\':!root}" class="ui-menu-list"\n            [style.width.px]="menuWidth" [style.left.px]="root ? slideMenu.left : slideMenu.menuWidth" \n            [style.transitionProperty]="root ? \'left\' : \'none\'" [style.transitionDuration]="effectDuration" [style.transitionTimingFunction]="easing">\n            <template ngFor let-child [ngForOf]="(root ? item : item.items)">\n                <li #listitem [ngClass]="{\'ui-menuitem ui-widget ui-corner-all\':true,\'ui-menu-parent\':child.items,\'ui-menuitem-active\':listitem==activeItem}">\n                    <a #link [href]="child.url||\'#\'" class="ui-menuitem-link ui-corner-all" [ngClass]="{\'ui-state-hover\':link==hoveredLink,\'ui-menuitem-link-parent\':child.items}" \n                        (click)="itemClick($event, child, listitem)" (mouseenter)="hoveredLink=link" (mouseleave)="hoveredLink=null">\n                        <span class="ui-submenu-icon fa fa-fw fa-caret-right" *ngIf="child.items"></span>\n                        <span class="ui-menuitem-icon fa fa-fw" *ngIf="child.icon" [ngClass]="child.icon"></span>\n                        <span class="ui-menuitem-text">{{child.label}}</span>\n                    </a>\n                    <p-slideMenuSub class="ui-submenu" [item]="child" [menuWidth]="menuWidth" *ngIf="child.items"></p-slideMenuSub>\n                </li>\n            </template>\n        </ul>\n    '}),o(0,s.Inject(s.forwardRef(function(){return p}))),i("design:paramtypes",[p,c.Router])],SlideMenuSub)}();t.SlideMenuSub=u;var p=function(){function SlideMenu(e,t,n){this.el=e,this.domHandler=t,this.renderer=n,this.menuWidth=180,this.viewportHeight=175,this.effectDuration="500ms",this.easing="ease-out",this.backLabel="Back",this.left=0}return SlideMenu.prototype.ngAfterViewInit=function(){var e=this;this.container=this.el.nativeElement.children[0],this.popup&&(this.documentClickListener=this.renderer.listenGlobal("body","click",function(){e.preventDocumentDefault||e.hide(),e.preventDocumentDefault=!1}))},SlideMenu.prototype.toggle=function(e){this.container.offsetParent?this.hide():this.show(e),this.preventDocumentDefault=!0},SlideMenu.prototype.show=function(e){this.container.style.display="block",this.domHandler.absolutePosition(this.container,e.target),this.domHandler.fadeIn(this.container,250)},SlideMenu.prototype.hide=function(){this.container.style.display="none"},SlideMenu.prototype.unsubscribe=function(e){if(e.eventEmitter&&e.eventEmitter.unsubscribe(),e.items)for(var t=0,n=e.items;t<n.length;t++){var r=n[t];this.unsubscribe(r)}},SlideMenu.prototype.onClick=function(e){this.preventDocumentDefault=!0},SlideMenu.prototype.goBack=function(){this.left+=this.menuWidth},SlideMenu.prototype.ngOnDestroy=function(){if(this.popup&&this.documentClickListener(),this.model)for(var e=0,t=this.model;e<t.length;e++){var n=t[e];this.unsubscribe(n)}},r([s.Input(),i("design:type",Array)],SlideMenu.prototype,"model",void 0),r([s.Input(),i("design:type",Boolean)],SlideMenu.prototype,"popup",void 0),r([s.Input(),i("design:type",Object)],SlideMenu.prototype,"style",void 0),r([s.Input(),i("design:type",String)],SlideMenu.prototype,"styleClass",void 0),r([s.Input(),i("design:type",Number)],SlideMenu.prototype,"menuWidth",void 0),r([s.Input(),i("design:type",Number)],SlideMenu.prototype,"viewportHeight",void 0),r([s.Input(),i("design:type",Object)],SlideMenu.prototype,"effectDuration",void 0),r([s.Input(),i("design:type",String)],SlideMenu.prototype,"easing",void 0),r([s.Input(),i("design:type",String)],SlideMenu.prototype,"backLabel",void 0),SlideMenu=r([s.Component({selector:"p-slideMenu",template:'\n        <div [ngClass]="{\'ui-menu ui-slidemenu ui-widget ui-widget-content ui-corner-all\':true,\'ui-menu-dynamic ui-shadow\':popup}" \n            [class]="styleClass" [ngStyle]="style" (click)="onClick($event)">\n            <div class="ui-slidemenu-wrapper" [style.height.px]="viewportHeight">\n                <div class="ui-slidemenu-content" [style.height.px]="viewportHeight - 30">\n                    <p-slideMenuSub [item]="model" root="root" [menuWidth]="menuWidth" [effectDuration]="effectDuration" [easing]="easing"></p-slideMenuSub>\n                </div>\n                <div class="ui-slidemenu-backward ui-widget-header ui-corner-all" [style.display]="left ? \'block\' : \'none\'" (click)="goBack()">\n                    <span class="fa fa-fw fa-caret-left"></span>{{backLabel}}\n                </div>\n            </div>\n        </div>\n    ',providers:[l.DomHandler]}),i("design:paramtypes",[s.ElementRef,l.DomHandler,s.Renderer])],SlideMenu)}();t.SlideMenu=p;var d=function(){function SlideMenuModule(){}return SlideMenuModule=r([s.NgModule({imports:[a.CommonModule],exports:[p],declarations:[p,u]}),i("design:paramtypes",[])],SlideMenuModule)}();t.SlideMenuModule=d},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(22),l=new o.Provider(a.NG_VALUE_ACCESSOR,{useExisting:o.forwardRef(function(){return c}),multi:!0}),c=function(){function Slider(e){this.el=e,this.onChange=new o.EventEmitter,this.onSlideEnd=new o.EventEmitter,this.onModelChange=function(){},this.onModelTouched=function(){},this.initialized=!1}return Slider.prototype.ngAfterViewInit=function(){var e=this;jQuery(this.el.nativeElement.children[0]).slider({animate:this.animate,disabled:this.disabled,max:this.max,min:this.min,orientation:this.orientation,range:this.range,step:this.step,value:this.value,values:this.value,slide:function(t,n){e.range?(e.onModelChange(n.values),e.onChange.emit({originalEvent:t,values:n.values})):(e.onModelChange(n.value),e.onChange.emit({originalEvent:t,value:n.value}))},stop:function(t,n){e.onSlideEnd.emit({originalEvent:t,value:n.value})}}),this.initialized=!0},Slider.prototype.writeValue=function(e){if(this.value=e,this.initialized){var t=this.range?"values":"value";jQuery(this.el.nativeElement.children[0]).slider("option",t,this.value)}},Slider.prototype.registerOnChange=function(e){this.onModelChange=e},Slider.prototype.registerOnTouched=function(e){this.onModelTouched=e},Slider.prototype.ngOnChanges=function(e){if(this.initialized)for(var t in e)jQuery(this.el.nativeElement.children[0]).slider("option",t,e[t].currentValue)},Slider.prototype.ngOnDestroy=function(){jQuery(this.el.nativeElement.children[0]).slider("destroy"),this.initialized=!1},r([o.Input(),i("design:type",Boolean)],Slider.prototype,"animate",void 0),r([o.Input(),i("design:type",Boolean)],Slider.prototype,"disabled",void 0),r([o.Input(),i("design:type",Number)],Slider.prototype,"min",void 0),r([o.Input(),i("design:type",Number)],Slider.prototype,"max",void 0),r([o.Input(),i("design:type",String)],Slider.prototype,"orientation",void 0),r([o.Input(),i("design:type",Number)],Slider.prototype,"step",void 0),r([o.Input(),i("design:type",Boolean)],Slider.prototype,"range",void 0),r([o.Input(),i("design:type",Object)],Slider.prototype,"style",void 0),r([o.Input(),i("design:type",String)],Slider.prototype,"styleClass",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Slider.prototype,"onChange",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Slider.prototype,"onSlideEnd",void 0),Slider=r([o.Component({selector:"p-slider",template:'\n        <div [ngStyle]="style" [class]="styleClass"></div>\n    ',providers:[l]}),i("design:paramtypes",[o.ElementRef])],Slider)}();t.Slider=c;var u=function(){function SliderModule(){}return SliderModule=r([o.NgModule({imports:[s.CommonModule],exports:[c],declarations:[c]}),i("design:paramtypes",[])],SliderModule)}();t.SliderModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(204),l=n(9),c=n(22),u=new o.Provider(c.NG_VALUE_ACCESSOR,{useExisting:o.forwardRef(function(){return p}),multi:!0}),p=function(){function Spinner(e,t){this.el=e,this.domHandler=t,this.onChange=new o.EventEmitter,this.step=1,this.onModelChange=function(){},this.onModelTouched=function(){}}return Spinner.prototype.ngAfterViewInit=function(){0===Math.floor(this.step)&&(this.precision=this.step.toString().split(/[,]|[.]/)[1].length),this.inputtext=this.domHandler.findSingle(this.el.nativeElement,"input"),null!==this.value&&void 0!==this.value&&(this.inputtext.value=this.value)},Spinner.prototype.repeat=function(e,t,n){var r=this,i=e||500;this.clearTimer(),this.timer=setTimeout(function(){r.repeat(40,t,n)},i),this.spin(t,n)},Spinner.prototype.spin=function(e,t){var n=this.step*e,r=this.value||0;this.precision?this.value=parseFloat(this.toFixed(r+n,this.precision)):this.value=r+n,void 0!==this.max&&this.value.toString().length>this.maxlength&&(this.value=r),void 0!==this.min&&this.value<this.min&&(this.value=this.min),void 0!==this.max&&this.value>this.max&&(this.value=this.max),t.value=this.value,this.onModelChange(this.value)},Spinner.prototype.toFixed=function(e,t){var n=Math.pow(10,t||0);return String(Math.round(e*n)/n)},Spinner.prototype.onUpButtonMousedown=function(e,t){this.disabled||(t.focus(),this.activeUp=!0,this.repeat(null,1,t),e.preventDefault())},Spinner.prototype.onUpButtonMouseup=function(e){this.disabled||(this.activeUp=!1,this.clearTimer())},Spinner.prototype.onUpButtonMouseenter=function(e){this.disabled||(this.hoverUp=!0)},Spinner.prototype.onUpButtonMouseleave=function(e){this.disabled||(this.hoverUp=!1,this.activeUp=!1,this.clearTimer())},Spinner.prototype.onDownButtonMousedown=function(e,t){this.disabled||(t.focus(),this.activeDown=!0,this.repeat(null,-1,t),e.preventDefault())},Spinner.prototype.onDownButtonMouseup=function(e){this.disabled||(this.activeDown=!1,this.clearTimer())},Spinner.prototype.onDownButtonMouseenter=function(e){this.disabled||(this.hoverDown=!0)},Spinner.prototype.onDownButtonMouseleave=function(e){this.disabled||(this.hoverDown=!1,this.activeDown=!1,this.clearTimer())},Spinner.prototype.onInputKeydown=function(e,t){38==e.which?(this.spin(1,t),e.preventDefault()):40==e.which&&(this.spin(-1,t),e.preventDefault())},Spinner.prototype.onInput=function(e){this.value=this.parseValue(e.target.value),this.onModelChange(this.value)},Spinner.prototype.onBlur=function(e){void 0!==this.value&&null!==this.value&&(e.value=this.value),this.onModelTouched()},Spinner.prototype.parseValue=function(e){var t;return""===e.trim()?t=void 0!==this.min?this.min:null:(t=this.precision?parseFloat(e):parseInt(e),isNaN(t)?t=null:(void 0!==this.max&&t>this.max&&(t=this.max),void 0!==this.min&&t<this.min&&(t=this.min))),t},Spinner.prototype.handleChange=function(e){this.onChange.emit(e)},Spinner.prototype.clearTimer=function(){this.timer&&clearInterval(this.timer)},Spinner.prototype.writeValue=function(e){this.value=e,this.inputtext&&null!==this.value&&void 0!==this.value&&(this.inputtext.value=this.value)},Spinner.prototype.registerOnChange=function(e){this.onModelChange=e},Spinner.prototype.registerOnTouched=function(e){this.onModelTouched=e},r([o.Output(),i("design:type",o.EventEmitter)],Spinner.prototype,"onChange",void 0),r([o.Input(),i("design:type",Number)],Spinner.prototype,"step",void 0),r([o.Input(),i("design:type",Number)],Spinner.prototype,"min",void 0),r([o.Input(),i("design:type",Number)],Spinner.prototype,"max",void 0),r([o.Input(),i("design:type",Number)],Spinner.prototype,"maxlength",void 0),r([o.Input(),i("design:type",Number)],Spinner.prototype,"size",void 0),r([o.Input(),i("design:type",Boolean)],Spinner.prototype,"disabled",void 0),Spinner=r([o.Component({selector:"p-spinner",template:'\n        <span class="ui-spinner ui-widget ui-corner-all">\n            <input #in pInputText type="text" class="ui-spinner-input"\n            [attr.size]="size" [attr.maxlength]="maxlength" [attr.readonly]="readonly" [attr.disabled]="disabled"\n            (keydown)="onInputKeydown($event,in)" (input)="onInput($event)" (blur)="onBlur(in)" (change)="handleChange($event)">\n            <a class="ui-spinner-button ui-spinner-up ui-corner-tr ui-button ui-widget ui-state-default ui-button-text-only"\n                [ngClass]="{\'ui-state-hover\':hoverUp,\'ui-state-active\':activeUp,\'ui-state-disabled\':disabled}"\n                (mouseenter)="onUpButtonMouseenter($event)" (mouseleave)="onUpButtonMouseleave($event)" (mousedown)="onUpButtonMousedown($event,in)" (mouseup)="onUpButtonMouseup($event)">\n                <span class="ui-button-text">\n                    <span class="fa fa-fw fa-caret-up"></span>\n                </span>\n            </a>\n            <a class="ui-spinner-button ui-spinner-down ui-corner-br ui-button ui-widget ui-state-default ui-button-text-only"\n                [ngClass]="{\'ui-state-hover\':hoverDown,\'ui-state-active\':activeDown,\'ui-state-disabled\':disabled}"\n                (mouseenter)="onDownButtonMouseenter($event)" (mouseleave)="onDownButtonMouseleave($event)" (mousedown)="onDownButtonMousedown($event,in)" (mouseup)="onDownButtonMouseup($event)">\n                <span class="ui-button-text">\n                    <span class="fa fa-fw fa-caret-down"></span>\n                </span>\n            </a>\n        </span>\n    ',providers:[l.DomHandler,u]}),i("design:paramtypes",[o.ElementRef,l.DomHandler])],Spinner)}();t.Spinner=p;var d=function(){function SpinnerModule(){}return SpinnerModule=r([o.NgModule({imports:[s.CommonModule,a.InputTextModule],exports:[p],declarations:[p]}),i("design:paramtypes",[])],SpinnerModule)}();t.SpinnerModule=d},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(9),l=n(29),c=function(){function SplitButton(e,t,n,r){this.el=e,this.domHandler=t,this.renderer=n,this.router=r,this.iconPos="left",this.onClick=new o.EventEmitter,this.menuVisible=!1}return SplitButton.prototype.ngOnInit=function(){var e=this;this.documentClickListener=this.renderer.listenGlobal("body","click",function(){e.menuVisible=!1})},SplitButton.prototype.onDefaultButtonClick=function(e){this.onClick.emit(e)},SplitButton.prototype.itemClick=function(e,t){t.url&&!t.routerLink||e.preventDefault(),t.command&&(t.eventEmitter||(t.eventEmitter=new o.EventEmitter,t.eventEmitter.subscribe(t.command)),t.eventEmitter.emit(e)),this.menuVisible=!1,t.routerLink&&this.router.navigate(t.routerLink)},SplitButton.prototype.onDropdownClick=function(e,t,n){this.menuVisible=!this.menuVisible,this.domHandler.relativePosition(t,n),this.domHandler.fadeIn(t,25),e.stopPropagation()},SplitButton.prototype.ngOnDestroy=function(){this.documentClickListener()},r([o.Input(),i("design:type",Array)],SplitButton.prototype,"model",void 0),r([o.Input(),i("design:type",String)],SplitButton.prototype,"icon",void 0),r([o.Input(),i("design:type",String)],SplitButton.prototype,"iconPos",void 0),r([o.Input(),i("design:type",String)],SplitButton.prototype,"label",void 0),r([o.Output(),i("design:type",o.EventEmitter)],SplitButton.prototype,"onClick",void 0),r([o.Input(),i("design:type",Object)],SplitButton.prototype,"style",void 0),r([o.Input(),i("design:type",String)],SplitButton.prototype,"styleClass",void 0),r([o.Input(),i("design:type",Object)],SplitButton.prototype,"menuStyle",void 0),r([o.Input(),i("design:type",String)],SplitButton.prototype,"menuStyleClass",void 0),SplitButton=r([o.Component({selector:"p-splitButton",template:'\n        <div #container [ngClass]="\'ui-splitbutton ui-buttonset ui-widget\'" [ngStyle]="style" [class]="styleClass">\n            <button #defaultbtn type="button" class="ui-button ui-widget ui-state-default ui-corner-left"\n                [ngClass]="{\'ui-button-text-only\':(!icon&&label),\'ui-button-icon-only\':(icon&&!label),\n                \'ui-button-text-icon-left\':(icon&&label&&iconPos==\'left\'),\'ui-button-text-icon-right\':(icon&&label&&iconPos==\'right\'),\n                \'ui-state-hover\':hoverDefaultBtn,\'ui-state-focus\':focusDefaultBtn,\'ui-state-active\':activeDefaultBtn}"\n                (mouseenter)="hoverDefaultBtn=true" (mouseleave)="hoverDefaultBtn=false"  (focus)="focusDefaultBtn=true" (blur)="focusDefaultBtn=false"\n                (mousedown)="activeDefaultBtn=true" (mouseup)="activeDefaultBtn=false" (click)="onDefaultButtonClick($event)">\n                <span [ngClass]="\'ui-button-icon-left ui-c fa fa-fw\'" [class]="icon"></span>\n                <span class="ui-button-text ui-c">{{label}}</span>\n            </button>\n            <button class="ui-splitbutton-menubutton ui-button ui-widget ui-state-default ui-button-icon-only ui-corner-right" type="button"\n                [ngClass]="{\'ui-state-hover\':hoverDropdown,\'ui-state-focus\':focusDropdown,\'ui-state-active\':activeDropdown}"\n                (mouseenter)="hoverDropdown=true" (mouseleave)="hoverDropdown=false" (focus)="focusDropdown=true" (blur)="focusDropdown=false"\n                (mousedown)="activeDropdown=true" (mouseup)="activeDropdown=false" (click)="onDropdownClick($event,menu,container)">\n                <span class="ui-button-icon-left ui-c fa fa-fw fa-caret-down"></span>\n                <span class="ui-button-text ui-c">ui-button</span>\n            </button>\n            <div #menu [ngClass]="\'ui-menu ui-menu-dynamic ui-widget ui-widget-content ui-corner-all ui-helper-clearfix ui-shadow\'" [style.display]="menuVisible ? \'block\' : \'none\'"\n                    [ngStyle]="menuStyle" [class]="menuStyleClass">\n                <ul class="ui-menu-list ui-helper-reset">\n                    <li class="ui-menuitem ui-widget ui-corner-all" role="menuitem" *ngFor="let item of model"\n                        (mouseenter)="hoveredItem=item" (mouseleave)="hoveredItem=null">\n                        <a [href]="item.url||\'#\'" class="ui-menuitem-link ui-corner-all" (click)="itemClick($event,item)" [ngClass]="{\'ui-state-hover\':(hoveredItem==item)}">\n                            <span [ngClass]="\'ui-menuitem-icon fa fa-fw\'" [class]="item.icon" *ngIf="item.icon"></span>\n                            <span class="ui-menuitem-text">{{item.label}}</span>\n                        </a>\n                    </li>\n                </ul>\n            </div>\n        </div>\n    ',providers:[a.DomHandler]}),i("design:paramtypes",[o.ElementRef,a.DomHandler,o.Renderer,l.Router])],SplitButton)}();t.SplitButton=c;var u=function(){function SplitButtonModule(){}return SplitButtonModule=r([o.NgModule({imports:[s.CommonModule],exports:[c],declarations:[c]}),i("design:paramtypes",[])],SplitButtonModule)}();t.SplitButtonModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(9),l=n(29),c=function(){function TabMenu(e){this.router=e}return TabMenu.prototype.ngOnInit=function(){!this.activeItem&&this.model&&this.model.length&&(this.activeItem=this.model[0])},TabMenu.prototype.itemClick=function(e,t){t.url&&!t.routerLink||e.preventDefault(),t.command&&(t.eventEmitter||(t.eventEmitter=new o.EventEmitter,t.eventEmitter.subscribe(t.command)),t.eventEmitter.emit(e)),t.routerLink&&this.router.navigate(t.routerLink),this.activeItem=t},TabMenu.prototype.ngOnDestroy=function(){if(this.model)for(var e=0,t=this.model;e<t.length;e++){var n=t[e];this.unsubscribe(n)}},TabMenu.prototype.unsubscribe=function(e){if(e.eventEmitter&&e.eventEmitter.unsubscribe(),e.items)for(var t=0,n=e.items;t<n.length;t++){var r=n[t];this.unsubscribe(r)}},r([o.Input(),i("design:type",Array)],TabMenu.prototype,"model",void 0),r([o.Input(),i("design:type",Object)],TabMenu.prototype,"activeItem",void 0),r([o.Input(),i("design:type",Boolean)],TabMenu.prototype,"popup",void 0),r([o.Input(),i("design:type",Object)],TabMenu.prototype,"style",void 0),r([o.Input(),i("design:type",String)],TabMenu.prototype,"styleClass",void 0),TabMenu=r([o.Component({selector:"p-tabMenu",template:'\n        <div [ngClass]="\'ui-tabmenu ui-widget ui-widget-content ui-corner-all\'" [ngStyle]="style" [class]="styleClass">\n            <ul class="ui-tabmenu-nav ui-helper-reset ui-helper-clearfix ui-widget-header ui-corner-all" role="tablist">\n                <li *ngFor="let item of model" \n                    [ngClass]="{\'ui-tabmenuitem ui-state-default ui-corner-top\':true,\n                        \'ui-tabmenuitem-hasicon\':item.icon,\'ui-state-hover\':hoveredItem==item,\'ui-state-active\':activeItem==item}"\n                    (mouseenter)="hoveredItem=item" (mouseleave)="hoveredItem=null">\n                    <a [href]="item.url||\'#\'" class="ui-menuitem-link ui-corner-all" (click)="itemClick($event,item)">\n                        <span class="ui-menuitem-icon fa" [ngClass]="item.icon"></span>\n                        <span class="ui-menuitem-text">{{item.label}}</span>\n                    </a>\n                </li>\n            </ul>\n        </div>\n    ',providers:[a.DomHandler]}),i("design:paramtypes",[l.Router])],TabMenu)}();t.TabMenu=c;var u=function(){function TabMenuModule(){}return TabMenuModule=r([o.NgModule({imports:[s.CommonModule],exports:[c],declarations:[c]}),i("design:paramtypes",[])],TabMenuModule)}();t.TabMenuModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=this&&this.__param||function(e,t){return function(n,r){t(n,r,e)}},s=n(0),a=n(3),l=function(){function TabPanel(){}return r([s.Input(),i("design:type",String)],TabPanel.prototype,"header",void 0),r([s.Input(),i("design:type",Boolean)],TabPanel.prototype,"selected",void 0),r([s.Input(),i("design:type",Boolean)],TabPanel.prototype,"disabled",void 0),r([s.Input(),i("design:type",Boolean)],TabPanel.prototype,"closable",void 0),r([s.Input(),i("design:type",Object)],TabPanel.prototype,"headerStyle",void 0),r([s.Input(),i("design:type",String)],TabPanel.prototype,"headerStyleClass",void 0),r([s.Input(),i("design:type",String)],TabPanel.prototype,"leftIcon",void 0),
 r([s.Input(),i("design:type",String)],TabPanel.prototype,"rightIcon",void 0),TabPanel=r([s.Component({selector:"p-tabPanel",template:'\n        <div class="ui-tabview-panel ui-widget-content" [style.display]="selected ? \'block\' : \'none\'" *ngIf="!closed">\n            <ng-content></ng-content>\n        </div>\n    '}),i("design:paramtypes",[])],TabPanel)}();t.TabPanel=l;var c=function(){function TabView(e,t){var n=this;this.el=e,this.orientation="top",this.onChange=new s.EventEmitter,this.onClose=new s.EventEmitter,t.changes.subscribe(function(e){n.tabs=t.toArray();var r=n.findSelectedTab();!r&&n.tabs.length&&(n.tabs[0].selected=!0)})}return TabView.prototype.open=function(e,t){if(t.disabled)return void e.preventDefault();if(!t.selected){var n=this.findSelectedTab();n&&(n.selected=!1),t.selected=!0,this.onChange.emit({originalEvent:e,index:this.findTabIndex(t)})}e.preventDefault()},TabView.prototype.close=function(e,t){if(t.selected){t.selected=!1;for(var n=0;n<this.tabs.length;n++){var r=this.tabs[n];if(!r.closed&&!t.disabled){r.selected=!0;break}}}t.closed=!0,this.onClose.emit({originalEvent:e,index:this.findTabIndex(t)}),e.stopPropagation()},TabView.prototype.findSelectedTab=function(){for(var e=0;e<this.tabs.length;e++)if(this.tabs[e].selected)return this.tabs[e];return null},TabView.prototype.findTabIndex=function(e){for(var t=-1,n=0;n<this.tabs.length;n++)if(this.tabs[n]==e){t=n;break}return t},TabView.prototype.getDefaultHeaderClass=function(e){var t="ui-state-default ui-corner-"+this.orientation;return e.headerStyleClass&&(t=t+" "+e.headerStyleClass),t},r([s.Input(),i("design:type",String)],TabView.prototype,"orientation",void 0),r([s.Input(),i("design:type",Object)],TabView.prototype,"style",void 0),r([s.Input(),i("design:type",String)],TabView.prototype,"styleClass",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TabView.prototype,"onChange",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TabView.prototype,"onClose",void 0),TabView=r([s.Component({selector:"p-tabView",template:'\n        <div [ngClass]="\'ui-tabview ui-widget ui-widget-content ui-corner-all ui-tabview-\' + orientation" [ngStyle]="style" [class]="styleClass">\n            <ul class="ui-tabview-nav ui-helper-reset ui-helper-clearfix ui-widget-header ui-corner-all">\n                <template ngFor let-tab [ngForOf]="tabs">\n                    <li [class]="getDefaultHeaderClass(tab)" [ngStyle]="tab.headerStyle"\n                        [ngClass]="{\'ui-tabview-selected ui-state-active\': tab.selected, \'ui-state-hover\': tab.hoverHeader&&!tab.disabled, \'ui-state-disabled\': tab.disabled}"\n                        (mouseenter)="tab.hoverHeader=true" (mouseleave)="tab.hoverHeader=false" (click)="open($event,tab)" *ngIf="!tab.closed">\n                        <a href="#">\n                            <span class="ui-tabview-left-icon fa" [ngClass]="tab.leftIcon" *ngIf="tab.leftIcon"></span>\n                            {{tab.header}}\n                            <span class="ui-tabview-right-icon fa" [ngClass]="tab.rightIcon" *ngIf="tab.rightIcon"></span>\n                        </a>\n                        <span *ngIf="tab.closable" class="ui-tabview-close fa fa-close" (click)="close($event,tab)"></span>\n                    </li>\n                </template>\n            </ul>\n            <div class="ui-tabview-panels">\n                <ng-content></ng-content>\n            </div>\n        </div>\n    '}),o(1,s.Query(l)),i("design:paramtypes",[s.ElementRef,s.QueryList])],TabView)}();t.TabView=c;var u=function(){function TabViewModule(){}return TabViewModule=r([s.NgModule({imports:[a.CommonModule],exports:[c,l],declarations:[c,l]}),i("design:paramtypes",[])],TabViewModule)}();t.TabViewModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(22),a=n(3),l=n(9),c=function(){function Terminal(e,t){this.el=e,this.domHandler=t,this.responseChange=new o.EventEmitter,this.handler=new o.EventEmitter,this.commands=[]}return Terminal.prototype.ngAfterViewInit=function(){this.container=this.domHandler.find(this.el.nativeElement,".ui-terminal")[0]},Terminal.prototype.ngAfterViewChecked=function(){this.commandProcessed&&(this.container.scrollTop=this.container.scrollHeight,this.commandProcessed=!1)},Object.defineProperty(Terminal.prototype,"response",{set:function(e){e&&(this.commands.push({text:this.command,response:e}),this.command=null,this.commandProcessed=!0,this.responseChange.emit(null))},enumerable:!0,configurable:!0}),Terminal.prototype.handleCommand=function(e,t){13==e.keyCode&&this.handler.emit({originalEvent:e,command:this.command})},Terminal.prototype.focus=function(e){e.focus()},r([o.Input(),i("design:type",String)],Terminal.prototype,"welcomeMessage",void 0),r([o.Input(),i("design:type",String)],Terminal.prototype,"prompt",void 0),r([o.Input(),i("design:type",Object)],Terminal.prototype,"style",void 0),r([o.Input(),i("design:type",String)],Terminal.prototype,"styleClass",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Terminal.prototype,"responseChange",void 0),r([o.Output(),i("design:type",o.EventEmitter)],Terminal.prototype,"handler",void 0),r([o.Input(),i("design:type",String),i("design:paramtypes",[String])],Terminal.prototype,"response",null),Terminal=r([o.Component({selector:"p-terminal",template:'\n        <div [ngClass]="\'ui-terminal ui-widget ui-widget-content ui-corner-all\'" [ngStyle]="style" [class]="styleClass" (click)="focus(in)">\n            <div *ngIf="welcomeMessage">{{welcomeMessage}}</div>\n            <div class="ui-terminal-content">\n                <div *ngFor="let command of commands">\n                    <span>{{prompt}}</span>\n                    <span class="ui-terminal-command">{{command.text}}</span>\n                    <div>{{command.response}}</div>\n                </div>\n            </div>\n            <div>\n                <span class="ui-terminal-content-prompt">{{prompt}}</span>\n                <input #in type="text" [(ngModel)]="command" class="ui-terminal-input" autocomplete="off" (keydown)="handleCommand($event,container)" autofocus>\n            </div>\n        </div>\n    ',providers:[l.DomHandler]}),i("design:paramtypes",[o.ElementRef,l.DomHandler])],Terminal)}();t.Terminal=c;var u=function(){function TerminalModule(){}return TerminalModule=r([o.NgModule({imports:[a.CommonModule,s.FormsModule],exports:[c],declarations:[c]}),i("design:paramtypes",[])],TerminalModule)}();t.TerminalModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(9),l=n(3),c=n(29),u=function(){function TieredMenuSub(e,t,n){this.domHandler=e,this.router=t,this.location=n}return TieredMenuSub.prototype.onItemMouseEnter=function(e,t){this.activeItem=t,this.activeLink=t.children[0];var n=t.children[0].nextElementSibling;if(n){var r=n.children[0];r.style.zIndex=++a.DomHandler.zindex,r.style.top="0px",r.style.left=this.domHandler.getOuterWidth(t.children[0])+"px"}},TieredMenuSub.prototype.onItemMouseLeave=function(e,t){this.activeItem=null,this.activeLink=null},TieredMenuSub.prototype.itemClick=function(e,t){t.url&&!t.routerLink||e.preventDefault(),t.command&&(t.eventEmitter||(t.eventEmitter=new o.EventEmitter,t.eventEmitter.subscribe(t.command)),t.eventEmitter.emit(e)),t.routerLink&&this.router.navigate(t.routerLink)},TieredMenuSub.prototype.listClick=function(e){this.activeItem=null,this.activeLink=null},r([o.Input(),i("design:type",Object)],TieredMenuSub.prototype,"item",void 0),r([o.Input(),i("design:type",Boolean)],TieredMenuSub.prototype,"root",void 0),TieredMenuSub=r([o.Component({selector:"p-tieredMenuSub",template:'\n        <ul [ngClass]="{\'ui-helper-reset\':root, \'ui-widget-content ui-corner-all ui-helper-clearfix ui-menu-child ui-shadow\':!root}" class="ui-menu-list"\n            (click)="listClick($event)">\n            <template ngFor let-child [ngForOf]="(root ? item : item.items)">\n                <li #item [ngClass]="{\'ui-menuitem ui-widget ui-corner-all\':true,\'ui-menu-parent\':child.items,\'ui-menuitem-active\':item==activeItem}"\n                    (mouseenter)="onItemMouseEnter($event, item)" (mouseleave)="onItemMouseLeave($event, item)">\n                    <a #link [href]="child.url||\'#\'" class="ui-menuitem-link ui-corner-all" [ngClass]="{\'ui-state-hover\':link==activeLink}" (click)="itemClick($event, child)">\n                        <span class="ui-submenu-icon fa fa-fw fa-caret-right" *ngIf="child.items"></span>\n                        <span class="ui-menuitem-icon fa fa-fw" *ngIf="child.icon" [ngClass]="child.icon"></span>\n                        <span class="ui-menuitem-text">{{child.label}}</span>\n                    </a>\n                    <p-tieredMenuSub class="ui-submenu" [item]="child" *ngIf="child.items"></p-tieredMenuSub>\n                </li>\n            </template>\n        </ul>\n    ',providers:[a.DomHandler]}),i("design:paramtypes",[a.DomHandler,c.Router,l.Location])],TieredMenuSub)}();t.TieredMenuSub=u;var p=function(){function TieredMenu(e,t,n){this.el=e,this.domHandler=t,this.renderer=n}return TieredMenu.prototype.ngAfterViewInit=function(){var e=this;this.container=this.el.nativeElement.children[0],this.popup&&(this.documentClickListener=this.renderer.listenGlobal("body","click",function(){e.preventDocumentDefault||e.hide(),e.preventDocumentDefault=!1}))},TieredMenu.prototype.toggle=function(e){this.container.offsetParent?this.hide():this.show(e),this.preventDocumentDefault=!0},TieredMenu.prototype.show=function(e){this.container.style.display="block",this.domHandler.absolutePosition(this.container,e.target),this.domHandler.fadeIn(this.container,250)},TieredMenu.prototype.hide=function(){this.container.style.display="none"},TieredMenu.prototype.unsubscribe=function(e){if(e.eventEmitter&&e.eventEmitter.unsubscribe(),e.items)for(var t=0,n=e.items;t<n.length;t++){var r=n[t];this.unsubscribe(r)}},TieredMenu.prototype.ngOnDestroy=function(){if(this.popup&&this.documentClickListener(),this.model)for(var e=0,t=this.model;e<t.length;e++){var n=t[e];this.unsubscribe(n)}},r([o.Input(),i("design:type",Array)],TieredMenu.prototype,"model",void 0),r([o.Input(),i("design:type",Boolean)],TieredMenu.prototype,"popup",void 0),r([o.Input(),i("design:type",Object)],TieredMenu.prototype,"style",void 0),r([o.Input(),i("design:type",String)],TieredMenu.prototype,"styleClass",void 0),TieredMenu=r([o.Component({selector:"p-tieredMenu",template:'\n        <div [ngClass]="{\'ui-tieredmenu ui-menu ui-widget ui-widget-content ui-corner-all ui-helper-clearfix\':true,\'ui-menu-dynamic ui-shadow\':popup}" \n            [class]="styleClass" [ngStyle]="style">\n            <p-tieredMenuSub [item]="model" root="root"></p-tieredMenuSub>\n        </div>\n    ',providers:[a.DomHandler]}),i("design:paramtypes",[o.ElementRef,a.DomHandler,o.Renderer])],TieredMenu)}();t.TieredMenu=p;var d=function(){function TieredMenuModule(){}return TieredMenuModule=r([o.NgModule({imports:[s.CommonModule],exports:[p],declarations:[p,u]}),i("design:paramtypes",[])],TieredMenuModule)}();t.TieredMenuModule=d},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(22),l=new o.Provider(a.NG_VALUE_ACCESSOR,{useExisting:o.forwardRef(function(){return c}),multi:!0}),c=function(){function ToggleButton(){this.onLabel="Yes",this.offLabel="No",this.onChange=new o.EventEmitter,this.checked=!1,this.onModelChange=function(){},this.onModelTouched=function(){}}return ToggleButton.prototype.getIconClass=function(){var e="ui-button-icon-left fa fa-fw";return e+" "+(this.checked?this.onIcon:this.offIcon)},ToggleButton.prototype.toggle=function(e){this.disabled||(this.checked=!this.checked,this.onModelChange(this.checked),this.onModelTouched(),this.onChange.emit({originalEvent:e,checked:this.checked}))},ToggleButton.prototype.writeValue=function(e){this.checked=e},ToggleButton.prototype.registerOnChange=function(e){this.onModelChange=e},ToggleButton.prototype.registerOnTouched=function(e){this.onModelTouched=e},r([o.Input(),i("design:type",String)],ToggleButton.prototype,"onLabel",void 0),r([o.Input(),i("design:type",String)],ToggleButton.prototype,"offLabel",void 0),r([o.Input(),i("design:type",String)],ToggleButton.prototype,"onIcon",void 0),r([o.Input(),i("design:type",String)],ToggleButton.prototype,"offIcon",void 0),r([o.Input(),i("design:type",Boolean)],ToggleButton.prototype,"disabled",void 0),r([o.Input(),i("design:type",Object)],ToggleButton.prototype,"style",void 0),r([o.Input(),i("design:type",String)],ToggleButton.prototype,"styleClass",void 0),r([o.Output(),i("design:type",o.EventEmitter)],ToggleButton.prototype,"onChange",void 0),ToggleButton=r([o.Component({selector:"p-toggleButton",template:'\n        <div [ngClass]="{\'ui-button ui-togglebutton ui-widget ui-state-default ui-corner-all\': true, \'ui-button-text-only\': (!onIcon&&!offIcon), \'ui-button-text-icon-left\': (onIcon&&offIcon),\n                \'ui-state-active\': checked, \'ui-state-hover\': hover&&!disabled, \'ui-state-disabled\': disabled}" [ngStyle]="style" [class]="styleClass" \n                (click)="toggle($event)" (mouseenter)="hover=true" (mouseleave)="hover=false">\n            <span *ngIf="onIcon||offIcon" [class]="getIconClass()"></span>\n            <span class="ui-button-text ui-unselectable-text">{{checked ? onLabel : offLabel}}</span>\n        </div>\n    ',providers:[l]}),i("design:paramtypes",[])],ToggleButton)}();t.ToggleButton=c;var u=function(){function ToggleButtonModule(){}return ToggleButtonModule=r([o.NgModule({imports:[s.CommonModule],exports:[c],declarations:[c]}),i("design:paramtypes",[])],ToggleButtonModule)}();t.ToggleButtonModule=u},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=function(){function Toolbar(){}return r([o.Input(),i("design:type",Object)],Toolbar.prototype,"style",void 0),r([o.Input(),i("design:type",String)],Toolbar.prototype,"styleClass",void 0),Toolbar=r([o.Component({selector:"p-toolbar",template:'\n        <div [ngClass]="\'ui-toolbar ui-widget ui-widget-header ui-corner-all ui-helper-clearfix\'" [ngStyle]="style" [class]="styleClass">\n            <ng-content></ng-content>\n        </div>\n    '}),i("design:paramtypes",[])],Toolbar)}();t.Toolbar=a;var l=function(){function ToolbarModule(){}return ToolbarModule=r([o.NgModule({imports:[s.CommonModule],exports:[a],declarations:[a]}),i("design:paramtypes",[])],ToolbarModule)}();t.ToolbarModule=l},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=n(0),s=n(3),a=n(9),l=function(){function Tooltip(e,t){this.el=e,this.domHandler=t,this.tooltipPosition="right",this.tooltipEvent="hover"}return Tooltip.prototype.onMouseEnter=function(e){"hover"===this.tooltipEvent&&this.show()},Tooltip.prototype.onMouseLeave=function(e){"hover"===this.tooltipEvent&&this.hide()},Tooltip.prototype.onFocus=function(e){"focus"===this.tooltipEvent&&this.show()},Tooltip.prototype.onBlur=function(e){"focus"===this.tooltipEvent&&this.hide()},Tooltip.prototype.show=function(){this.create();var e,t,n=this.el.nativeElement.getBoundingClientRect(),r=n.top+document.body.scrollTop,i=n.left+document.body.scrollLeft;switch(this.container.style.display="block",this.tooltipPosition){case"right":e=i+this.domHandler.getOuterWidth(this.el.nativeElement),t=r+(this.domHandler.getOuterHeight(this.el.nativeElement)-this.domHandler.getOuterHeight(this.container))/2;break;case"left":e=i-this.domHandler.getOuterWidth(this.container),t=r+(this.domHandler.getOuterHeight(this.el.nativeElement)-this.domHandler.getOuterHeight(this.container))/2;break;case"top":e=i+(this.domHandler.getOuterWidth(this.el.nativeElement)-this.domHandler.getOuterWidth(this.container))/2,t=r-this.domHandler.getOuterHeight(this.container);break;case"bottom":e=i+(this.domHandler.getOuterWidth(this.el.nativeElement)-this.domHandler.getOuterWidth(this.container))/2,t=r+this.domHandler.getOuterHeight(this.el.nativeElement)}this.container.style.left=e+"px",this.container.style.top=t+"px",this.domHandler.fadeIn(this.container,250),this.container.style.zIndex=++a.DomHandler.zindex},Tooltip.prototype.hide=function(){this.container.style.display="none",document.body.removeChild(this.container),this.container=null},Tooltip.prototype.create=function(){this.container=document.createElement("div"),this.container.className="ui-widget ui-tooltip ui-tooltip-"+this.tooltipPosition;var e=document.createElement("div");e.className="ui-tooltip-arrow",this.container.appendChild(e);var t=document.createElement("div");t.className="ui-tooltip-text ui-shadow ui-corner-all",t.innerHTML=this.text,this.container.appendChild(t),document.body.appendChild(this.container)},Tooltip.prototype.ngOnDestroy=function(){this.container&&this.container.parentElement&&document.body.removeChild(this.container),this.container=null},r([o.Input("pTooltip"),i("design:type",String)],Tooltip.prototype,"text",void 0),r([o.Input(),i("design:type",String)],Tooltip.prototype,"tooltipPosition",void 0),r([o.Input(),i("design:type",String)],Tooltip.prototype,"tooltipEvent",void 0),r([o.HostListener("mouseenter",["$event"]),i("design:type",Function),i("design:paramtypes",[Object]),i("design:returntype",void 0)],Tooltip.prototype,"onMouseEnter",null),r([o.HostListener("mouseleave",["$event"]),i("design:type",Function),i("design:paramtypes",[Object]),i("design:returntype",void 0)],Tooltip.prototype,"onMouseLeave",null),r([o.HostListener("focus",["$event"]),i("design:type",Function),i("design:paramtypes",[Object]),i("design:returntype",void 0)],Tooltip.prototype,"onFocus",null),r([o.HostListener("blur",["$event"]),i("design:type",Function),i("design:paramtypes",[Object]),i("design:returntype",void 0)],Tooltip.prototype,"onBlur",null),Tooltip=r([o.Directive({selector:"[pTooltip]",host:{},providers:[a.DomHandler]}),i("design:paramtypes",[o.ElementRef,a.DomHandler])],Tooltip)}();t.Tooltip=l;var c=function(){function TooltipModule(){}return TooltipModule=r([o.NgModule({imports:[s.CommonModule],exports:[l],declarations:[l]}),i("design:paramtypes",[])],TooltipModule)}();t.TooltipModule=c},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=this&&this.__param||function(e,t){return function(n,r){t(n,r,e)}},s=n(0),a=n(3),l=function(){function TreeNodeTemplateLoader(e){this.viewContainer=e}return TreeNodeTemplateLoader.prototype.ngOnInit=function(){this.viewContainer.createEmbeddedView(this.template,{$implicit:this.node})},r([s.Input(),i("design:type",Object)],TreeNodeTemplateLoader.prototype,"node",void 0),r([s.Input(),i("design:type",s.TemplateRef)],TreeNodeTemplateLoader.prototype,"template",void 0),TreeNodeTemplateLoader=r([s.Component({selector:"p-treeNodeTemplateLoader",template:""}),i("design:paramtypes",[s.ViewContainerRef])],TreeNodeTemplateLoader)}();t.TreeNodeTemplateLoader=l;var c=function(){function UITreeNode(e){this.tree=e,this.hover=!1,this.expanded=!1}return UITreeNode.prototype.getIcon=function(){var e;return e=this.node.icon?this.node.icon:this.expanded?this.node.expandedIcon:this.node.collapsedIcon,UITreeNode.ICON_CLASS+" "+e},UITreeNode.prototype.isLeaf=function(){return 0!=this.node.leaf&&!(this.node.children&&this.node.children.length)},UITreeNode.prototype.toggle=function(e){this.expanded?this.tree.onNodeCollapse.emit({originalEvent:e,node:this.node}):this.tree.onNodeExpand.emit({originalEvent:e,node:this.node}),this.expanded=!this.expanded},UITreeNode.prototype.onNodeClick=function(e){this.tree.onNodeClick(e,this.node)},UITreeNode.prototype.onNodeRightClick=function(e){this.tree.onNodeRightClick(e,this.node)},UITreeNode.prototype.isSelected=function(){return this.tree.isSelected(this.node)},UITreeNode.ICON_CLASS="ui-treenode-icon fa fa-fw",r([s.Input(),i("design:type",Object)],UITreeNode.prototype,"node",void 0),UITreeNode=r([s.Component({selector:"p-treeNode",template:'\n        <li class="ui-treenode" *ngIf="node">\n            <div class="ui-treenode-content" [ngClass]="{\'ui-treenode-selectable\': tree.selectionMode}" \n                (mouseenter)="hover=true" (mouseleave)="hover=false" (click)="onNodeClick($event)" (contextmenu)="onNodeRightClick($event)">\n                <span class="ui-tree-toggler fa fa-fw" [ngClass]="{\'fa-caret-right\':!expanded,\'fa-caret-down\':expanded}" *ngIf="!isLeaf()"\n                        (click)="toggle($event)"></span\n                ><span class="ui-treenode-leaf-icon" *ngIf="isLeaf()"></span\n                ><span [class]="getIcon()" *ngIf="node.icon||node.expandedIcon||node.collapsedIcon"></span\n                ><span class="ui-treenode-label ui-corner-all" \n                    [ngClass]="{\'ui-state-hover\':hover&&tree.selectionMode,\'ui-state-highlight\':isSelected()}">\n                        <span *ngIf="!tree.template">{{node.label}}</span>\n                        <p-treeNodeTemplateLoader [node]="node" [template]="tree.template" *ngIf="tree.template"></p-treeNodeTemplateLoader>\n                    </span>\n            </div>\n            <ul class="ui-treenode-children" style="display: none;" *ngIf="node.children" [style.display]="expanded ? \'block\' : \'none\'">\n                <p-treeNode *ngFor="let childNode of node.children" [node]="childNode"></p-treeNode>\n            </ul>\n        </li>\n    '}),o(0,s.Inject(s.forwardRef(function(){return u}))),i("design:paramtypes",[u])],UITreeNode)}();t.UITreeNode=c;var u=function(){function Tree(){this.selectionChange=new s.EventEmitter,this.onNodeSelect=new s.EventEmitter,this.onNodeUnselect=new s.EventEmitter,this.onNodeExpand=new s.EventEmitter,this.onNodeCollapse=new s.EventEmitter,this.onNodeContextMenuSelect=new s.EventEmitter}return Tree.prototype.onNodeClick=function(e,t){if(!e.target.className||0!==e.target.className.indexOf("ui-tree-toggler")){var n=e.metaKey||e.ctrlKey,r=this.findIndexInSelection(t),i=r>=0;i&&n?(this.isSingleSelectionMode()?this.selectionChange.emit(null):(this.selection.splice(r,1),this.selectionChange.emit(this.selection)),this.onNodeUnselect.emit({originalEvent:e,node:t})):(this.isSingleSelectionMode()?this.selectionChange.emit(t):this.isMultipleSelectionMode()&&(this.selection=e.metaKey?this.selection||[]:[],this.selection.push(t),this.selectionChange.emit(this.selection)),this.onNodeSelect.emit({originalEvent:e,node:t}))}},Tree.prototype.onNodeRightClick=function(e,t){if(this.contextMenu){if(e.target.className&&0===e.target.className.indexOf("ui-tree-toggler"))return;var n=this.findIndexInSelection(t),r=n>=0;r||(this.isSingleSelectionMode()?this.selectionChange.emit(t):this.selectionChange.emit([t])),this.contextMenu.show(e),this.onNodeContextMenuSelect.emit({originalEvent:e,node:t})}},Tree.prototype.findIndexInSelection=function(e){var t=-1;if(this.selectionMode&&this.selection)if(this.isSingleSelectionMode())t=this.selection==e?0:-1;else if(this.isMultipleSelectionMode())for(var n=0;n<this.selection.length;n++)if(this.selection[n]==e){t=n;break}return t},Tree.prototype.isSelected=function(e){return this.findIndexInSelection(e)!=-1},Tree.prototype.isSingleSelectionMode=function(){return this.selectionMode&&"single"==this.selectionMode},Tree.prototype.isMultipleSelectionMode=function(){return this.selectionMode&&"multiple"==this.selectionMode},r([s.Input(),i("design:type",Array)],Tree.prototype,"value",void 0),r([s.Input(),i("design:type",String)],Tree.prototype,"selectionMode",void 0),r([s.Input(),i("design:type",Object)],Tree.prototype,"selection",void 0),r([s.Output(),i("design:type",s.EventEmitter)],Tree.prototype,"selectionChange",void 0),r([s.Output(),i("design:type",s.EventEmitter)],Tree.prototype,"onNodeSelect",void 0),r([s.Output(),i("design:type",s.EventEmitter)],Tree.prototype,"onNodeUnselect",void 0),r([s.Output(),i("design:type",s.EventEmitter)],Tree.prototype,"onNodeExpand",void 0),r([s.Output(),i("design:type",s.EventEmitter)],Tree.prototype,"onNodeCollapse",void 0),r([s.Output(),i("design:type",s.EventEmitter)],Tree.prototype,"onNodeContextMenuSelect",void 0),r([s.Input(),i("design:type",Object)],Tree.prototype,"style",void 0),r([s.Input(),i("design:type",String)],Tree.prototype,"styleClass",void 0),r([s.Input(),i("design:type",Object)],Tree.prototype,"contextMenu",void 0),r([s.ContentChild(s.TemplateRef),i("design:type",s.TemplateRef)],Tree.prototype,"template",void 0),Tree=r([s.Component({selector:"p-tree",template:'\n        <div [ngClass]="\'ui-tree ui-widget ui-widget-content ui-corner-all\'" [ngStyle]="style" [class]="styleClass">\n            <ul class="ui-tree-container">\n                <p-treeNode *ngFor="let node of value" [node]="node"></p-treeNode>\n            </ul>\n        </div>\n    '}),i("design:paramtypes",[])],Tree)}();t.Tree=u;var p=function(){function TreeModule(){}return TreeModule=r([s.NgModule({imports:[a.CommonModule],exports:[u],declarations:[u,c,l]}),i("design:paramtypes",[])],TreeModule)}();t.TreeModule=p},function(e,t,n){"use strict";var r=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},i=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},o=this&&this.__param||function(e,t){return function(n,r){t(n,r,e)}},s=n(0),a=n(3),l=n(19),c=n(19),u=function(){function UITreeRow(e){this.treeTable=e,this.level=0,this.expanded=!1}return UITreeRow.prototype.toggle=function(e){this.expanded?this.treeTable.onNodeCollapse.emit({originalEvent:e,node:this.node}):this.treeTable.onNodeExpand.emit({originalEvent:e,node:this.node}),this.expanded=!this.expanded},UITreeRow.prototype.isLeaf=function(){return 0!=this.node.leaf&&!(this.node.children&&this.node.children.length)},UITreeRow.prototype.isSelected=function(){return this.treeTable.isSelected(this.node)},UITreeRow.prototype.onRowClick=function(e){this.treeTable.onRowClick(e,this.node)},r([s.Input(),i("design:type",Object)],UITreeRow.prototype,"node",void 0),r([s.Input(),i("design:type",Number)],UITreeRow.prototype,"level",void 0),UITreeRow=r([s.Component({selector:"[pTreeRow]",template:'\n        <div class="ui-treetable-row" [ngClass]="{\'ui-state-hover\':hover&&treeTable.selectionMode,\'ui-state-highlight\':isSelected(node)}">\n            <td *ngFor="let col of treeTable.columns; let i=index" [ngStyle]="col.style" [class]="col.styleClass"\n                (mouseenter)="hover=true" (mouseleave)="hover=false" (click)="onRowClick($event)">\n                <span *ngIf="i==0" class="ui-treetable-toggler fa fa-fw ui-c" [ngClass]="{\'fa-caret-down\':expanded,\'fa-caret-right\':!expanded}"\n                    [ngStyle]="{\'margin-left\':level*16 + \'px\',\'visibility\': isLeaf() ? \'hidden\' : \'visible\'}"\n                    (click)="toggle($event)"></span>\n                <span *ngIf="!col.template">{{node.data[col.field]}}</span>\n                <p-columnTemplateLoader [column]="col" [rowData]="node" *ngIf="col.template"></p-columnTemplateLoader>\n            </td>\n        </div>\n        <div *ngIf="node.children" class="ui-treetable-row" [style.display]="expanded ? \'table-row\' : \'none\'">\n            <td [attr.colspan]="treeTable.columns.length" class="ui-treetable-child-table-container">\n                <table>\n                    <tbody pTreeRow *ngFor="let childNode of node.children" [node]="childNode" [level]="level+1"></tbody>\n                </table>\n            </td>\n        </div>\n    '}),o(0,s.Inject(s.forwardRef(function(){return p}))),i("design:paramtypes",[p])],UITreeRow)}();t.UITreeRow=u;var p=function(){function TreeTable(){this.selectionChange=new s.EventEmitter,this.onNodeSelect=new s.EventEmitter,this.onNodeUnselect=new s.EventEmitter,this.onNodeExpand=new s.EventEmitter,this.onNodeCollapse=new s.EventEmitter}return TreeTable.prototype.onRowClick=function(e,t){if(!e.target.className||0!==e.target.className.indexOf("ui-treetable-toggler")){var n=e.metaKey||e.ctrlKey,r=this.findIndexInSelection(t),i=r>=0;i&&n?(this.isSingleSelectionMode()?this.selectionChange.emit(null):(this.selection.splice(r,1),this.selectionChange.emit(this.selection)),this.onNodeUnselect.emit({originalEvent:e,node:t})):(this.isSingleSelectionMode()?this.selectionChange.emit(t):this.isMultipleSelectionMode()&&(this.selection=e.metaKey?this.selection||[]:[],this.selection.push(t),this.selectionChange.emit(this.selection)),this.onNodeSelect.emit({originalEvent:e,node:t}))}},TreeTable.prototype.findIndexInSelection=function(e){var t=-1;if(this.selectionMode&&this.selection)if(this.isSingleSelectionMode())t=this.selection==e?0:-1;else if(this.isMultipleSelectionMode())for(var n=0;n<this.selection.length;n++)if(this.selection[n]==e){t=n;break}return t},TreeTable.prototype.isSelected=function(e){return this.findIndexInSelection(e)!=-1},TreeTable.prototype.isSingleSelectionMode=function(){return this.selectionMode&&"single"==this.selectionMode},TreeTable.prototype.isMultipleSelectionMode=function(){return this.selectionMode&&"multiple"==this.selectionMode},TreeTable.prototype.hasFooter=function(){if(this.columns)for(var e=this.columns.toArray(),t=0;t<e.length;t++)if(e[t].footer)return!0;
-return!1},r([s.Input(),i("design:type",Array)],TreeTable.prototype,"value",void 0),r([s.Input(),i("design:type",String)],TreeTable.prototype,"selectionMode",void 0),r([s.Input(),i("design:type",Object)],TreeTable.prototype,"selection",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TreeTable.prototype,"selectionChange",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TreeTable.prototype,"onNodeSelect",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TreeTable.prototype,"onNodeUnselect",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TreeTable.prototype,"onNodeExpand",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TreeTable.prototype,"onNodeCollapse",void 0),r([s.Input(),i("design:type",Object)],TreeTable.prototype,"style",void 0),r([s.Input(),i("design:type",String)],TreeTable.prototype,"styleClass",void 0),r([s.ContentChild(l.Header),i("design:type",Object)],TreeTable.prototype,"header",void 0),r([s.ContentChild(l.Footer),i("design:type",Object)],TreeTable.prototype,"footer",void 0),r([s.ContentChildren(l.Column),i("design:type",s.QueryList)],TreeTable.prototype,"columns",void 0),TreeTable=r([s.Component({selector:"p-treeTable",template:'\n        <div [ngClass]="\'ui-treetable ui-widget\'" [ngStyle]="style" [class]="styleClass">\n            <div class="ui-treetable-header ui-widget-header" *ngIf="header">\n                <ng-content select="header"></ng-content>\n            </div>\n            <div class="ui-treetable-tablewrapper">\n                <table class="ui-widget-content" style="border:0 0 1px 0px">\n                    <thead>\n                        <tr class="ui-state-default">\n                            <th #headerCell *ngFor="let col of columns" [ngStyle]="col.style" [class]="col.styleClass" \n                                [ngClass]="\'ui-state-default ui-unselectable-text\'">\n                                <span class="ui-column-title">{{col.header}}</span>\n                            </th>\n                        </tr>\n                    </thead>\n                    <tfoot *ngIf="hasFooter()">\n                        <tr>\n                            <td *ngFor="let col of columns" [ngStyle]="col.style" [class]="col.styleClass" [ngClass]="{\'ui-state-default\':true}">{{col.footer}}</td>\n                        </tr>\n                    </tfoot>\n                    <tbody pTreeRow *ngFor="let node of value" [node]="node" [level]="0"></tbody>\n                </table>\n            </div>\n            <div class="ui-treetable-footer ui-widget-header" *ngIf="footer">\n                <ng-content select="footer"></ng-content>\n            </div>\n        </div>\n    '}),i("design:paramtypes",[])],TreeTable)}();t.TreeTable=p;var d=function(){function TreeTableModule(){}return TreeTableModule=r([s.NgModule({imports:[a.CommonModule,c.SharedModule],exports:[p,c.SharedModule],declarations:[p,u]}),i("design:paramtypes",[])],TreeTableModule)}();t.TreeTableModule=d},function(e,t){function defaultSetTimout(){throw new Error("setTimeout has not been defined")}function defaultClearTimeout(){throw new Error("clearTimeout has not been defined")}function runTimeout(e){if(n===setTimeout)return setTimeout(e,0);if((n===defaultSetTimout||!n)&&setTimeout)return n=setTimeout,setTimeout(e,0);try{return n(e,0)}catch(t){try{return n.call(null,e,0)}catch(t){return n.call(this,e,0)}}}function runClearTimeout(e){if(r===clearTimeout)return clearTimeout(e);if((r===defaultClearTimeout||!r)&&clearTimeout)return r=clearTimeout,clearTimeout(e);try{return r(e)}catch(t){try{return r.call(null,e)}catch(t){return r.call(this,e)}}}function cleanUpNextTick(){a&&o&&(a=!1,o.length?s=o.concat(s):l=-1,s.length&&drainQueue())}function drainQueue(){if(!a){var e=runTimeout(cleanUpNextTick);a=!0;for(var t=s.length;t;){for(o=s,s=[];++l<t;)o&&o[l].run();l=-1,t=s.length}o=null,a=!1,runClearTimeout(e)}}function Item(e,t){this.fun=e,this.array=t}function noop(){}var n,r,i=e.exports={};!function(){try{n="function"==typeof setTimeout?setTimeout:defaultSetTimout}catch(e){n=defaultSetTimout}try{r="function"==typeof clearTimeout?clearTimeout:defaultClearTimeout}catch(e){r=defaultClearTimeout}}();var o,s=[],a=!1,l=-1;i.nextTick=function(e){var t=new Array(arguments.length-1);if(arguments.length>1)for(var n=1;n<arguments.length;n++)t[n-1]=arguments[n];s.push(new Item(e,t)),1!==s.length||a||runTimeout(drainQueue)},Item.prototype.run=function(){this.fun.apply(null,this.array)},i.title="browser",i.browser=!0,i.env={},i.argv=[],i.version="",i.versions={},i.on=noop,i.addListener=noop,i.once=noop,i.off=noop,i.removeListener=noop,i.removeAllListeners=noop,i.emit=noop,i.prependListener=noop,i.prependOnceListener=noop,i.listeners=function(e){return[]},i.binding=function(e){throw new Error("process.binding is not supported")},i.cwd=function(){return"/"},i.chdir=function(e){throw new Error("process.chdir is not supported")},i.umask=function(){return 0}},function(e,t){e.exports=">>> md-sidenav-layout > md-content {\n  flex-grow: 1;\n}\n\n>>> md-content {\n  position: absolute;\n  top: 0;\n  right: 0;\n  left: 0;\n  bottom: 0;\n}\n"},function(e,t){e.exports=""},function(e,t){e.exports=">>> vt-shard-view p-dataTable th {\n  text-align: left !important;\n}\n\n>>> vt-shard-view p-dataTable th:nth-child(1) {\n    white-space: nowrap;\n    width: 75px;\n    text-align: center;\n}\n\n>>> vt-shard-view p-dataTable th:nth-child(2) {\n    white-space: nowrap;\n    width: 75px;\n    text-align: center;\n}\n\n>>> vt-shard-view p-dataTable th:nth-child(3) {\n    white-space: nowrap;\n    width: 100px;\n    text-align: center;\n}\n\n>>> vt-shard-view p-dataTable th:nth-child(4) {\n    white-space: nowrap;\n    width: 100px;\n    text-align: center;\n}\n\n>>> vt-shard-view p-dataTable th:nth-child(7) {\n    white-space: nowrap;\n    width: 100px;\n    text-align: center;\n}\n"},function(e,t){e.exports="iframe {\n  width: 100%;\n  height: 100%;\n  min-height: 80vh;\n}"},function(e,t){e.exports=".vt-padding {\n  padding-left: 25px;\n}\n.vt-options {\n  padding-bottom: 20px;\n}\n\n>>> .ui-datatable{\n  padding-bottom: 20px;\n}\n\n>>> .vt-schema-popup-container p-dataTable th:nth-child(1) {\n    white-space: nowrap;\n    width: 44px;\n    text-align: center;\n}\n\n>>> .vt-schema-popup-container p-dataTable td:nth-child(1) {\n    width: 44px;\n    text-align: center;\n}\n\n>>> .vt-schema-popup-container p-dataTable th:nth-child(3) {\n    white-space: nowrap;\n    width: 52px;\n    text-align: center;\n}\n\n>>> .vt-schema-popup-container p-dataTable td:nth-child(3) {\n    width: 52px;\n    text-align: center;\n}\n\n>>> vt-schema .ui-dropdown {\n  width: auto !important;\n  min-width: 50px;\n}"},function(e,t){e.exports=".breadcrumbs {\n  padding: 10px;\n  background-color: #eee;\n}\n\n.breadcrumb-separator {\n  font-size: 24px;\n}\n"},function(e,t){e.exports=".vt-form-member{\n  width: 100%;\n  padding-top: 12px;\n  padding-bottom: 4px;\n  border-bottom: 1px solid #d3d3d3;\n}\n.vt-cell-left { \n  display: inline-block;\n  vertical-align: top;\n  width: 59% !important;\n}\n\n.vt-cell-right { \n  display: inline-block;\n  padding-bottom: 1%;\n  width: 39% !important;\n}\n\n.checkbox-wrapper {\n  width: 100%;\n  text-align: center;\n  vertical-align: middle;\n}\n\n.vt-form{\n  padding: 0;\n  margin: 0;\n  width: 100%;\n  display: table; \n  border-collapse:separate;\n  border-spacing:1em 0.5em;\n}\n\n.vt-sheet {\n  display: inline-block;\n  margin: 5px 10px 5px 0px;\n  padding: 5px 15px 5px 15px;\n  border-radius: 5px;\n  background-color: #EEEEEE;\n}\n\n.vt-log-frame {\n  max-height: 200px;\n  overflow:auto;\n  width: 100%;\n}\n\n>>> md-input {\n  width: 100%;\n}\n\n.vt-action-container {\n  margin-top: 20px;\n  float: right;\n}"},function(e,t){e.exports=".bordered {\n    border: 1px solid black;\n    border-spacing: 0;\n    margin: 0;\n    padding: 0;\n    text-align: center;\n    vertical-align: middle;\n}\n\n.collapsed-border {\n    border-collapse: collapse;\n    border-spacing: 0;\n    width: 70%;\n    float: left;\n    margin: 0 0;\n    border: 1px solid black;\n}\n\n.no-padding {\n  padding: 0;\n}\n\n.centered {\n  margin: 0 auto;\n}\n\n.popup {\n  position: absolute;\n  right: 5px;\n  width: 20%;\n  height: auto;\n  border: 1px solid black;\n  background-color: #FFFFFF;\n  float: right;\n  text-align: center;\n}\n"},function(e,t){e.exports=">>> .ui-dropdown {\n  width: auto !important;\n}\n\n.heatmaps {\n  overflow: auto;\n}\n"},function(e,t){e.exports="table, td {\n  border: 1px solid black\n}\n\np, h3 {\n  margin: 0px;\n  font-size: 12px;\n}\n\n.centered {\n  padding: 0px;\n  text-align: center;\n}\n\n.collapsed-border {\n    border-collapse: collapse;\n    border-spacing: 0;\n    margin: 0 auto;\n}\n\n.smaller { \n  font-size: 10px;\n}\n"},871,871,function(e,t){e.exports='<md-toolbar color="primary">\n  <span>{{title}}</span>\n  <a href="/app" title="Old UI"><md-icon style="position:fixed; right:25px; top:25px; color: #ffffff">trending_down</md-icon></a>\n</md-toolbar>\n\n<md-sidenav-layout class="flex-column flex-grow">\n  <md-sidenav #sidenav mode="side" opened="true">\n    <md-nav-list>\n      <a md-list-item [routerLink]="[\'/dashboard\']"><md-icon>dashboard</md-icon>Dashboard</a>\n      <a *ngIf="featuresService.showStatus" md-list-item [routerLink]="[\'/status\']" [queryParams]="{ keyspace: \'all\', cell: \'all\', type: \'all\', metric: \'health\'}"><md-icon>timeline</md-icon>Status</a>\n      <a md-list-item [routerLink]="[\'/schema\']"><md-icon>storage</md-icon>Schema</a>\n      <a md-list-item [routerLink]="[\'/topo\']"><md-icon>folder</md-icon>Topology</a>\n      <a *ngIf="featuresService.showWorkflows" md-list-item [routerLink]="[\'/workflows\']"><md-icon>list</md-icon>Workflows</a>\n    </md-nav-list>\n  </md-sidenav>\n  <router-outlet></router-outlet>\n</md-sidenav-layout>\n'},function(e,t){e.exports='<div class="vt-toolbar vt-padding">\n  <md-icon *ngIf="featuresService.showTopologyCRUD" class="vt-menu" (click)="menuCrud.toggle($event)">menu</md-icon>\n  <p-menu #menuCrud popup="popup" [model]="actionsCrud"></p-menu>\n  <md-icon *ngIf="!featuresService.showTopologyCRUD" class="vt-menu" (click)="menu.toggle($event)">menu</md-icon>\n  <p-menu #menu popup="popup" [model]="actions"></p-menu>\n  <h1 class="vt-title">Keyspaces</h1>\n  <md-icon class="vt-right-menu" (click)="getKeyspaces()" [disabled]="inFlightQueries > 0">refresh</md-icon>\n</div>\n<div>\n  <div *ngFor="let keyspace of keyspaces" class="vt-card">\n    <md-card>\n      <div>\n        <div class="vt-card-toolbar">\n          <md-icon *ngIf="featuresService.showTopologyCRUD" class="vt-menu" (click)="setSelectedKeyspace(keyspace);cardMenu.toggle($event)">menu</md-icon>\n          <p-menu #cardMenu popup="popup" [model]="keyspaceActions"></p-menu>\n          <h2 id="{{keyspace.name}}" class="vt-title vt-keyspace-card">{{keyspace.name}}</h2>\n        </div>\n      </div>\n      <a class="vt-dark-link" [routerLink]="[\'/keyspace\']" [queryParams]="{keyspace: keyspace.name}">\n        <md-card-content >\n          <div class="stats-container">\n            <md-list>\n              <md-list-item>\n                <span class="vt-shard-stats">\n                    {{keyspace.servingShards.length}} Shards\n                  <div style="color: red" *ngIf="keyspace.nonservingShards.length > 0">\n                    (+{{keyspace.nonservingShards.length}} Not serving)\n                  </div>\n                </span>\n              </md-list-item>\n            </md-list>\n          </div>\n        </md-card-content>\n      </a>\n    </md-card>\n  </div>\n</div>\n<p-dialog [(header)]="dialogSettings.dialogTitle" [(visible)]="dialogSettings.open" draggable="" resizable="" width="800">\n  <vt-dialog [(dialogContent)]="dialogContent" [(dialogSettings)]="dialogSettings"></vt-dialog>\n</p-dialog>\n<div *ngIf="dialogSettings.open" class="vt-popUpContainer" >\n</div>\n'},function(e,t){e.exports='<div class="vt-toolbar vt-padding">\n  <md-icon class="vt-menu" (click)="menu.toggle($event)">menu</md-icon>\n  <p-menu #menu popup="popup" [model]="actions"></p-menu>\n  <h1 class="vt-title">{{keyspaceName}}</h1>\n  <md-icon class="vt-right-menu" (click)="refreshKeyspaceView()" [disabled]="inFlightQueries > 0">refresh</md-icon>\n</div>\n\n<h2 *ngIf="noShards()">There are no shards in this keyspace</h2>\n\n<div *ngIf="keyspace.servingShards != undefined && keyspace.servingShards.length > 0">\n  <h2 class="vt-padding">Serving Shards</h2>\n  <div *ngFor="let shard of keyspace.servingShards" class="vt-card">\n    <a class="vt-dark-link" [routerLink]="[\'/shard\']" [queryParams]="{keyspace: keyspaceName, shard: shard}">\n      <md-card >\n        <md-card-title class="vt-serving-shard">{{shard}}</md-card-title>\n      </md-card>\n    </a>\n  </div>\n</div>\n\n<div *ngIf="keyspace.nonservingShards != undefined && keyspace.nonservingShards.length > 0">\n  <h2 class="vt-padding">Nonserving Shards</h2>\n  <div *ngFor="let shard of keyspace.nonservingShards" class="vt-card">\n    <a class="vt-dark-link" [routerLink]="[\'/shard\']" [queryParams]="{keyspace: keyspaceName, shard: shard}">\n      <md-card>\n        <md-card-title class="vt-nonserving-shard">{{shard}}</md-card-title>\n      </md-card>\n    </a>\n  </div>\n</div>\n\n<p-dialog [(header)]="dialogSettings.dialogTitle" [(visible)]="dialogSettings.open" draggable="" resizable="" width="800">\n  <vt-dialog [(dialogContent)]="dialogContent" [(dialogSettings)]="dialogSettings" ></vt-dialog>\n</p-dialog>\n<div *ngIf="dialogSettings.open" class="vt-popUpContainer" >\n</div>\n'},function(e,t){e.exports='<div class="vt-toolbar vt-padding">\n  <md-icon class="vt-menu" (click)="menu.toggle($event)">menu</md-icon>\n  <p-menu #menu popup="popup" [model]="actions"></p-menu>\n  <h1 class="vt-title">{{keyspaceName}}/{{shardName}}</h1>\n  <md-icon class="vt-right-menu" (click)="refreshShardView()" [disabled]="inFlightQueries > 0">refresh</md-icon>\n</div>\n<div class="vt-tablet-container">\n  <p-dataTable [value]="tablets" emptyMessage="">\n    <p-column header="Actions">\n      <template let-tab="rowData" let-i="rowIndex">\n        <md-icon id="{{tab.uid}}" class="vt-menu" (click)="selectedTablet=tab; rowMenu.toggle($event)">menu</md-icon>\n        <p-menu #rowMenu popup="popup" [model]="tab.type === \'master\' ? tabletActionsMaster : tabletActionsSlave"></p-menu>\n      </template>\n    </p-column>\n    <p-column field="type" header="Type" sortable="custom" (sortFunction)="sortByType($event)">\n      <template let-tab="rowData">\n        <span *ngIf="tab.type === \'master\'"><strong>master</strong></span>\n        <span *ngIf="tab.type === \'replica\' || tab.type === \'rdonly\'"><em>{{tab.type}}</em></span>\n        <span *ngIf="tab.type !== \'master\' && tab.type !== \'replica\' && tab.type !== \'rdonly\'">{{tab.type}}</span>\n      </template>\n    </p-column>\n    <p-column field="cell" header="Cell" sortable="custom" (sortFunction)="sortByCell($event)"></p-column>\n    <p-column field="uid" header="UID" sortable="true"></p-column>\n    <p-column field="hostname" header="Host"></p-column>\n    <p-column header="Ports">\n      <template let-tab="rowData">\n        <span *ngFor="let portName of portNames(tab)">\n          <strong>{{portName}}:</strong> {{tab.port_map[portName]}},\n        </span>\n      </template>\n    </p-column>\n    <p-column header="Status">\n      <template let-tab="rowData">\n        <a href="{{getUrl(tab)}}">\n          <button md-button>Status</button>\n        </a>\n      </template>\n    </p-column>\n  </p-dataTable>\n  <h3 class="vt-padding" *ngIf="noTablets">There are no tablets in this shard.</h3>\n</div>\n\n<p-dialog [(header)]="dialogSettings.dialogTitle" [(visible)]="dialogSettings.open" draggable="" resizable="" width="800">\n  <vt-dialog [(dialogContent)]="dialogContent" [(dialogSettings)]="dialogSettings"></vt-dialog>\n</p-dialog>\n<div *ngIf="dialogSettings.open" class="vt-popUpContainer" >\n</div>\n'},function(e,t){e.exports='<h3 *ngIf="!tabletReady">Loading...</h3>\n<div *ngIf="tabletReady">\n  <h1>{{tablet.label}}</h1>\n  <div class="vt-action-center">\n    <p-accordion>\n      <p-accordionTab header="Actions">\n        <button md-raised-button (click)="openDeleteTabletDialog();">Delete</button>\n        <button md-raised-button (click)="openPingTabletDialog();">Ping</button>\n        <button md-raised-button (click)="openRefreshTabletDialog();">Refresh State</button>\n        <button md-raised-button (click)="openSetReadOnlyDialog();">SetReadOnly</button>\n        <button md-raised-button (click)="openSetReadWriteDialog();">SetReadWrite</button>\n        <button md-raised-button (click)="openStartSlaveDialog();">StartSlave</button>\n        <button md-raised-button (click)="openStopSlaveDialog();">StopSlave</button>\n        <button md-raised-button (click)="openRunHealthCheckDialog();">RunHealthCheck</button>\n        <button md-raised-button (click)="openIgnoreHealthErrorDialog();">IgnoreHealthError</button>\n        <button md-raised-button (click)="openReparentTabletDialog();">ReparentTablet</button>\n      </p-accordionTab>\n    </p-accordion>\n  </div>\n  <a [href]="tabletUrl"><button md-raised-button>Go to Tablet</button></a>\n  <p-dialog [(header)]="dialogSettings.dialogTitle" [(visible)]="dialogSettings.open" draggable="" resizable="" width="800">\n    <vt-dialog [(dialogContent)]="dialogContent" [(dialogSettings)]="dialogSettings"></vt-dialog>\n  </p-dialog>\n  <div *ngIf="dialogSettings.open" class="vt-popUpContainer" >\n  </div>\n<div>\n'},function(e,t){e.exports='<div class="vt-padding">\n  <h1>Schema</h1>\n  <div class ="vt-options">\n    <p-dropdown [options]="keyspaces" [(ngModel)]="selectedKeyspace" (onChange)="getShards(selectedKeyspace)" [filter]="true"></p-dropdown>\n    <p-dropdown [options]="shards" [(ngModel)]="selectedShard" (onChange)="getTablets(selectedKeyspace, selectedShard)" [filter]="true"></p-dropdown>\n    <p-dropdown [options]="tablets" [(ngModel)]="selectedTablet" (onChange)="fetchSchema(selectedKeyspace, selectedTablet)" [filter]="true"></p-dropdown>\n  </div>\n  <div class="vt-schema-left-container">\n    <p-dataTable [value]="schemas" selectionMode="single" emptyMessage="No Schemas for this tablet" [(selection)]="selectedSchema" (onRowSelect)="dialog=true;">\n      <header>Tables</header>\n      <p-column field="name" header="Name" sortable="true"></p-column>\n      <p-column field="primary_key_columns" header="PKs" sortable="true"></p-column>\n      <p-column field="data_length" header="Data Length" sortable="true"></p-column>\n      <p-column field="row_count" header="# Rows" sortable="true"></p-column>\n    </p-dataTable>\n\n    <p-dataTable [value]="vSchemas" emptyMessage="No VSchemas for this keyspace" [(selection)]="selectedVSchema">\n      <header>Vindexes</header>\n      <p-column field="name" header="Name" sortable="true"></p-column>\n      <p-column field="type" header="Type" sortable="true"></p-column>\n      <p-column field="params" header="Params" sortable="true"></p-column>\n      <p-column field="owner" header="Owner" sortable="true"></p-column>\n    </p-dataTable>\n  </div>\n  <div *ngIf="selectedSchema" class="vt-schema-popup-container">\n    <p-dialog [(header)]="selectedSchema.name" [(visible)]="dialog" draggable="" resizable="" width="800">\n      <table>\n        <tr>\n          <td>\n            <strong>Data Length:</strong>\n          </td>\n          <td>\n            {{selectedSchema.data_length}}\n          </td>\n        </tr>\n        <tr>\n          <td>\n            <strong>Row Count:</strong>\n          </td>\n          <td>\n            {{selectedSchema.row_count}}\n          </td>\n        </tr>\n      </table>\n      <p-dataTable [value]="selectedSchema.columns" emptyMessage="No Columns for this schema">\n        <header>Columns</header>\n        <p-column field="index" header="#" sortable="true"></p-column>\n        <p-column field="name" header="Name"></p-column>\n        <p-column field="pk" header="PK" sortable="true"></p-column>\n        <p-column field="vindex" header="Vindex"></p-column>\n        <p-column field="sequence" header="Sequence"></p-column>\n      </p-dataTable>\n      <md-card>\n        <h3>Schema:</h3>\n        <pre>{{selectedSchema.schema}}</pre>\n      </md-card>\n    </p-dialog>\n  </div>\n</div>\n'},function(e,t){e.exports='<md-card class="breadcrumbs">\n  <a md-button [routerLink]="route" [queryParams]="{}"><md-icon>{{icon}}</md-icon></a>\n  <span *ngFor="let crumb of crumbs">\n    <span class="breadcrumb-separator">{{separator}}</span>\n    <a md-button [routerLink]="route" [queryParams]="crumb.queryParams">{{crumb.name | uppercase}}</a>\n  </span>\n</md-card>\n'},function(e,t){e.exports='<div *ngIf="dialogSettings.dialogForm">\n  <h4 class="vt-padding" *ngIf="dialogSettings.dialogSubtitle">{{dialogSettings.dialogSubtitle}}</h4>\n  <span #vtFormWrapper>\n    <div class="vt-padding">\n      <div *ngFor="let flag of dialogContent.getFlags()">\n        <div  *ngIf="dialogContent.canDisplay(flag.id)" class="vt-form-member">\n          <div class="vt-cell-left">\n            <div>\n              <strong>{{flag.name}}<span *ngIf="dialogContent.isRequired(flag.id)"> (Required)</span>:</strong>\n            </div>\n            <div>\n              {{flag.description}}\n            </div>\n          </div>\n          <div class="vt-cell-right">\n            <md-input *ngIf="flag.type==\'input\'" [(ngModel)]="flag.value" [required]="dialogContent.isRequired(flag.id)" placeholder="{{flag.name}}"></md-input>\n            <div  *ngIf="flag.type == \'checkBox\'" class="checkbox-wrapper">\n              <md-checkbox class="md-primary" [(ngModel)]="flag.value" ></md-checkbox>\n            </div>\n            <p-dropdown *ngIf="flag.type == \'dropDown\'" [options]="flag.getOptions()" [(ngModel)]="flag.value"></p-dropdown>\n          </div>\n        </div>\n      </div>\n    </div>\n  </span>\n  <div *ngIf="dialogContent.canSubmit()" class="vt-padding">\n    <h3>Command:</h3>\n    <div class="vt-sheet" *ngFor="let cmd of getCmd()">\n      {{cmd}}\n    </div>\n  </div>\n  <div class="vt-padding vt-action-container">\n    <button md-button disableRipple="true" id="vt-action" [disabled]="!dialogContent.canSubmit()" (click)="sendAction();">{{dialogSettings.actionWord}}</button>\n    <button md-button disableRipple="true" id="vt-cancel" (click)="cancelDialog();">Cancel</button>\n  </div>\n</div>\n<div class="vt-padding" *ngIf="dialogSettings.dialogLog">\n  <div *ngIf="!dialogSettings.pending">\n    <div class="vt-resp">\n      {{dialogContent.interpolateMessage(dialogSettings.respText)}}\n    </div>\n    <h4 *ngIf="dialogSettings.logText">Log:</h4>\n    <div class="vt-sheet vt-log-frame" *ngIf="dialogSettings.logText">\n      <pre>{{dialogSettings.logText}}</pre>\n    </div>\n  </div>\n  <div *ngIf="dialogSettings.pending">\n    Loading Response...\n    <md-progress-bar mode="indeterminate"></md-progress-bar>\n  </div>\n  <div class="buttons">\n    <button md-button disableRipple="true" id="vt-dismiss" [disabled]="dialogSettings.pending" (click)="closeDialog()">Dismiss</button>\n  </div>\n</div>\n'},function(e,t){e.exports='<h2 id="keyspaceName"> {{keyspace.Name}} </h2>\n\n<table id="heatmapTable" class="collapsed-border">\n  <!-- The first row with an offset to match the heatmap labels. -->\n  <tr [ngStyle]="{\'height\': (getXLabelsRowHeight())+\'px\'}">\n    <td class="no-padding"></td>\n    <td class="no-padding"></td>\n    <td class="no-padding" [attr.rowspan]="(getTotalRows()+1)">\n      <div id="{{name}}" [ngStyle]="{\'height\': heatmapHeight+\'px\'}">\n        <!-- Heatmap gets drawn in this div-->\n      </div>\n    </td>\n  </tr>\n\n<!-- The cell and type labels of the map are drawn -->\n<template ngFor let-label [ngForOf]="yLabels" let-firstKeyspace="first">\n  <template [ngIf]="label.TypeLabels == null">\n  <tr [ngStyle]="{\'height\': getRowHeight()+\'px\'}">\n    <td [attr.rowspan]="label.CellLabel.Rowspan" class="bordered">{{label.CellLabel.Name}}</td>\n  </tr>\n\n  <!-- We add empty rows here because the rowspan value of the heatmap\'s cell labels expects them. -->\n  <tr *ngFor="let num of getRemainingRows(label.CellLabel.Rowspan)" [ngStyle]="{\'height\': getRowHeight()+\'px\'}"></tr>\n  </template>\n\n  <template [ngIf]="label.TypeLabels != null">\n    <template ngFor let-typeLabel [ngForOf]="label.TypeLabels" let-firstCell="first">\n    <tr [ngStyle]="{\'height\': getRowHeight()+\'px\'}">\n      <td *ngIf="firstCell" [attr.rowspan]="label.CellLabel.Rowspan" class="bordered">{{label.CellLabel.Name}}</td>\n      <td class="bordered" [attr.rowspan]="typeLabel.Rowspan">{{typeLabel.Name}}</td>\n    </tr>\n\n    <!-- We add empty rows here because the rowspan value of the heatmap\'s cell and type labels expects them. -->\n    <tr *ngFor="let num of getRemainingRows(typeLabel.Rowspan)" [ngStyle]="{\'height\': getRowHeight()+\'px\'}"></tr>\n    </template>\n  </template>\n</template>\n</table>\n\n<div *ngIf="showPopup" class="popup">\n  <vt-tablet-popup [data]="popupData"\n                   [alias]="popupAlias"\n                   [keyspace]="popupKeyspace"\n                   [shard]="popupShard"\n                   [cell]="popupCell"\n                   [tabletType]="popupType"\n                   [clicked]="popupClickState"\n                   >\n  </vt-tablet-popup>\n <button md-button (click)="closePopup()" class="centered">Dismiss</button>\n</div>\n'},function(e,t){e.exports='<!-- Keyspace selector -->\n<p-dropdown id="keyspace" (onChange)="handleKeyspaceChange($event)" [options]="keyspaces" [(ngModel)]="selectedKeyspace"></p-dropdown>\n\n<!-- Cell selector -->\n<p-dropdown id="cell" (onChange)="handleCellChange($event)" [options]="cells" [(ngModel)]="selectedCell"></p-dropdown>\n\n<!-- Type selector -->\n<p-dropdown id="type" (onChange)="handleTypeChange($event)" [options]="tabletTypes" [(ngModel)]="selectedType"></p-dropdown>\n\n<!-- Metric selector -->\n<p-dropdown id="metric" (onChange)="handleMetricChange($event)" [options]="metrics" [(ngModel)]="selectedMetric"></p-dropdown>\n\n<!-- Wait until the heatmap data has been obtained from the service -->\n<div *ngFor="let keyspace of listOfKeyspaces" class="heatmaps">\n  <vt-heatmap *ngIf="heatmapDataReady"\n    [heatmap]="mapOfKeyspaces[keyspace]"\n    [name]="keyspace"\n    [metric]="selectedMetric"\n  >\n  </vt-heatmap>\n</div>\n'},function(e,t){e.exports='<!-- Default div when no hovering or clicking -->\n<div *ngIf="defaultPopup" class="centered">\n  <h3> Nothing selected. Hover over a point to show details. </h3>\n</div>\n\n<!-- Popup displayed when tablet does not exist -->\n<div *ngIf="missingPopup" class="centered">\n  <h3> Tablet does not exist. </h3>\n</div>\n\n<!-- Popup of details of tablet when hovering/clicked -->\n<div *ngIf="!defaultPopup && !missingPopup" class="centered">\n  <b *ngIf="!title">{{title}}</b>\n  <p>Keyspace: {{keyspace}}</p>\n  <p>Shard: {{shard}}</p>\n  <p>Cell: {{cell}}</p>\n  <p>Tablet type: {{tabletType}}</p>\n  <div *ngIf="!clicked">\n     <h3>Click on tablet for more details</h3>\n  </div>\n  <!-- Additional tablet details when clicked -->\n  <div *ngIf="clicked && aggregated" class="centered">\n    <b>Aggregated tablets of all types</b>\n    <br/>\n    <p>Value: {{data}}</p>\n  </div>\n  <div *ngIf="clicked && unaggregated && healthDataReady" class="centered">\n   <p class="smaller">{{hostname}}</p>\n    <a [href]="tabletUrl">Go to tablet</a>\n    <table class="collapsed-border" >\n      <tr>\n        <td>Replication Lag\n        <td>{{lag}}\n      </tr>\n      <tr>\n        <td>Qps\n        <td>{{qps}}\n      </tr>\n      <tr>\n        <td>Serving\n        <td>{{serving}}\n      </tr>\n      <tr>\n        <td>Health Error\n        <td>{{error}}\n      </tr>\n      <tr>\n        <td>Last Error\n        <td>{{lastError}}\n      </tr>\n    </table>\n  </div>\n</div>\n'},function(e,t){e.exports='<div class="content">\n\n<h1>{{title}}</h1>\n\n<vt-breadcrumbs [route]="[\'/topo\']" [crumbs]="breadcrumbs"></vt-breadcrumbs>\n\n<md-card class="frame">\n  <md-nav-list *ngIf="node.Children" class="frame">\n    <a *ngFor="let child of node.Children" md-list-item\n      [routerLink]="[\'/topo\']" [queryParams]="childLink(child)">\n      <md-icon>folder</md-icon> {{child}}</a>\n  </md-nav-list>\n</md-card>\n\n<md-card *ngIf="node.Data">\n  <md-card-title>Node Data</md-card-title>\n  <md-card-content><pre>{{node.Data}}</pre></md-card-content>\n</md-card>\n\n<md-card *ngIf="node.Error">\n  <md-card-title>Error</md-card-title>\n  <md-card-content><pre>{{node.Error}}</pre></md-card-content>\n</md-card>\n\n</div>\n'},function(e,t){e.exports='<div *ngIf="isMaster()" class="vt-toolbar vt-padding">\n  <h1 class="vt-title">{{title}}</h1>\n  <md-icon class="vt-right-menu" (click)="openNewDialog()">add</md-icon>\n</div>\n<div *ngIf="!isMaster()" class="vt-toolbar vt-padding">\n  <h1 class="vt-title">Go to <a href="{{redirect}}">Master</a></h1>\n</div>\n<div>\n  <span *ngFor="let workflow of workflows; let i = index">\n    <vt-workflow [workflow]="workflows[i]" [workflowListComponent]="this"></vt-workflow>\n  </span>\n</div>\n<p-dialog [(header)]="dialogSettings.dialogTitle" [(visible)]="dialogSettings.open" draggable="" resizable="" width="800">\n  <vt-dialog [(dialogContent)]="dialogContent" [(dialogSettings)]="dialogSettings"></vt-dialog>\n</p-dialog>\n<div *ngIf="dialogSettings.open" class="vt-popUpContainer" >\n</div>\n'},function(e,t){e.exports='<div class="vt-workflow-padding" *ngIf="workflow">\n  <p-accordion >\n    <span >\n      <p-accordionTab class="{{getState(workflow.state)}}">\n        <header class="vt-pad-header">\n          <div class="vt-accordion-name-wrapper">\n            <span class="vt-accordion-name">\n              <md-icon *ngIf="workflow.isNotStarted()">remove</md-icon>\n              <md-icon *ngIf="workflow.isRunning()">forward</md-icon>\n              <md-icon *ngIf="workflow.isDone()">check</md-icon>\n              {{workflow.name}}\n            </span>\n          </div>\n          <div class="vt-progress-bar-wrapper">\n            <md-progress-bar class="vt-progress-bar" *ngIf="workflow.isDeterminate()" mode="determinate" [value]="workflow.progress" color="primary"></md-progress-bar>\n            <md-progress-bar class="vt-progress-bar" *ngIf="workflow.isIndeterminate()" mode="indeterminate" color="primary"></md-progress-bar>\n          </div>\n          <div class="vt-progress-msg-wrapper" *ngIf="workflow.isDeterminate() || workflow.isIndeterminate()" >\n            <span class="vt-progress-msg">\n              {{workflow.progressMsg}}\n            </span>\n          </div>\n          <div class="vt-workflow-action-wrapper" *ngIf="workflow.isRoot()">\n            <span class="vt-workflow-action">\n              <button md-raised-button *ngIf="workflow.isNotStarted()" (click)="startClicked($event); false">Start</button>\n              <button md-raised-button *ngIf="workflow.isRunning()" (click)="stopClicked($event); false">Stop</button>\n              <button md-raised-button *ngIf="!workflow.isRunning()" (click)="deleteClicked($event); false">Delete</button>\n            </span>\n          </div>\n        </header>\n        <div class="vt-workflow-content">\n          <div *ngIf="getTime()" class="vt-workflow-time">\n            Last Change: {{getTime()}}\n          </div>\n          <div class="vt-msg">\n            {{workflow.message}}\n          </div>\n          <div class="vt-log vt-workflow-padding">\n            <p-accordion>\n              <p-accordionTab [disabled]="workflow.log.length === 0">\n              <header class="vt-pad-header">\n                <span class="vt-accordion-name">\n                  Log\n                </span>\n              </header>\n              <div class="vt-log-text vt-workflow-padding">{{workflow.log}}</div>\n              </p-accordionTab>\n            </p-accordion>\n          </div>\n          <div class="vt-actions">\n            <span *ngFor="let action of workflow.actions">\n              <button md-raised-button disableRipple=true [disabled]="action.isDisabled()" (click)="actionClicked(action.name);" class="{{getActionClass(action.style)}}">{{action.name}}</button>\n            </span>\n          </div>\n        </div>\n        <vt-workflow *ngFor="let child of workflow.children; let i = index" [workflow]="workflow.children[i]" [workflowListComponent]="workflowListComponent"></vt-workflow>\n      </p-accordionTab>\n    </span>\n  </p-accordion>\n</div>\n';
+return!1},r([s.Input(),i("design:type",Array)],TreeTable.prototype,"value",void 0),r([s.Input(),i("design:type",String)],TreeTable.prototype,"selectionMode",void 0),r([s.Input(),i("design:type",Object)],TreeTable.prototype,"selection",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TreeTable.prototype,"selectionChange",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TreeTable.prototype,"onNodeSelect",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TreeTable.prototype,"onNodeUnselect",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TreeTable.prototype,"onNodeExpand",void 0),r([s.Output(),i("design:type",s.EventEmitter)],TreeTable.prototype,"onNodeCollapse",void 0),r([s.Input(),i("design:type",Object)],TreeTable.prototype,"style",void 0),r([s.Input(),i("design:type",String)],TreeTable.prototype,"styleClass",void 0),r([s.ContentChild(l.Header),i("design:type",Object)],TreeTable.prototype,"header",void 0),r([s.ContentChild(l.Footer),i("design:type",Object)],TreeTable.prototype,"footer",void 0),r([s.ContentChildren(l.Column),i("design:type",s.QueryList)],TreeTable.prototype,"columns",void 0),TreeTable=r([s.Component({selector:"p-treeTable",template:'\n        <div [ngClass]="\'ui-treetable ui-widget\'" [ngStyle]="style" [class]="styleClass">\n            <div class="ui-treetable-header ui-widget-header" *ngIf="header">\n                <ng-content select="header"></ng-content>\n            </div>\n            <div class="ui-treetable-tablewrapper">\n                <table class="ui-widget-content" style="border:0 0 1px 0px">\n                    <thead>\n                        <tr class="ui-state-default">\n                            <th #headerCell *ngFor="let col of columns" [ngStyle]="col.style" [class]="col.styleClass" \n                                [ngClass]="\'ui-state-default ui-unselectable-text\'">\n                                <span class="ui-column-title">{{col.header}}</span>\n                            </th>\n                        </tr>\n                    </thead>\n                    <tfoot *ngIf="hasFooter()">\n                        <tr>\n                            <td *ngFor="let col of columns" [ngStyle]="col.style" [class]="col.styleClass" [ngClass]="{\'ui-state-default\':true}">{{col.footer}}</td>\n                        </tr>\n                    </tfoot>\n                    <tbody pTreeRow *ngFor="let node of value" [node]="node" [level]="0"></tbody>\n                </table>\n            </div>\n            <div class="ui-treetable-footer ui-widget-header" *ngIf="footer">\n                <ng-content select="footer"></ng-content>\n            </div>\n        </div>\n    '}),i("design:paramtypes",[])],TreeTable)}();t.TreeTable=p;var d=function(){function TreeTableModule(){}return TreeTableModule=r([s.NgModule({imports:[a.CommonModule,c.SharedModule],exports:[p,c.SharedModule],declarations:[p,u]}),i("design:paramtypes",[])],TreeTableModule)}();t.TreeTableModule=d},function(e,t){function defaultSetTimout(){throw new Error("setTimeout has not been defined")}function defaultClearTimeout(){throw new Error("clearTimeout has not been defined")}function runTimeout(e){if(n===setTimeout)return setTimeout(e,0);if((n===defaultSetTimout||!n)&&setTimeout)return n=setTimeout,setTimeout(e,0);try{return n(e,0)}catch(t){try{return n.call(null,e,0)}catch(t){return n.call(this,e,0)}}}function runClearTimeout(e){if(r===clearTimeout)return clearTimeout(e);if((r===defaultClearTimeout||!r)&&clearTimeout)return r=clearTimeout,clearTimeout(e);try{return r(e)}catch(t){try{return r.call(null,e)}catch(t){return r.call(this,e)}}}function cleanUpNextTick(){a&&o&&(a=!1,o.length?s=o.concat(s):l=-1,s.length&&drainQueue())}function drainQueue(){if(!a){var e=runTimeout(cleanUpNextTick);a=!0;for(var t=s.length;t;){for(o=s,s=[];++l<t;)o&&o[l].run();l=-1,t=s.length}o=null,a=!1,runClearTimeout(e)}}function Item(e,t){this.fun=e,this.array=t}function noop(){}var n,r,i=e.exports={};!function(){try{n="function"==typeof setTimeout?setTimeout:defaultSetTimout}catch(e){n=defaultSetTimout}try{r="function"==typeof clearTimeout?clearTimeout:defaultClearTimeout}catch(e){r=defaultClearTimeout}}();var o,s=[],a=!1,l=-1;i.nextTick=function(e){var t=new Array(arguments.length-1);if(arguments.length>1)for(var n=1;n<arguments.length;n++)t[n-1]=arguments[n];s.push(new Item(e,t)),1!==s.length||a||runTimeout(drainQueue)},Item.prototype.run=function(){this.fun.apply(null,this.array)},i.title="browser",i.browser=!0,i.env={},i.argv=[],i.version="",i.versions={},i.on=noop,i.addListener=noop,i.once=noop,i.off=noop,i.removeListener=noop,i.removeAllListeners=noop,i.emit=noop,i.prependListener=noop,i.prependOnceListener=noop,i.listeners=function(e){return[]},i.binding=function(e){throw new Error("process.binding is not supported")},i.cwd=function(){return"/"},i.chdir=function(e){throw new Error("process.chdir is not supported")},i.umask=function(){return 0}},function(e,t){e.exports=">>> md-sidenav-layout > md-content {\n  flex-grow: 1;\n}\n\n>>> md-content {\n  position: absolute;\n  top: 0;\n  right: 0;\n  left: 0;\n  bottom: 0;\n}\n"},function(e,t){e.exports=""},function(e,t){e.exports=">>> vt-shard-view p-dataTable th {\n  text-align: left !important;\n}\n\n>>> vt-shard-view p-dataTable th:nth-child(1) {\n    white-space: nowrap;\n    width: 75px;\n    text-align: center;\n}\n\n>>> vt-shard-view p-dataTable th:nth-child(2) {\n    white-space: nowrap;\n    width: 75px;\n    text-align: center;\n}\n\n>>> vt-shard-view p-dataTable th:nth-child(3) {\n    white-space: nowrap;\n    width: 100px;\n    text-align: center;\n}\n\n>>> vt-shard-view p-dataTable th:nth-child(4) {\n    white-space: nowrap;\n    width: 100px;\n    text-align: center;\n}\n\n>>> vt-shard-view p-dataTable th:nth-child(7) {\n    white-space: nowrap;\n    width: 100px;\n    text-align: center;\n}\n"},function(e,t){e.exports="iframe {\n  width: 100%;\n  height: 100%;\n  min-height: 80vh;\n}"},function(e,t){e.exports=".vt-padding {\n  padding-left: 25px;\n}\n.vt-options {\n  padding-bottom: 20px;\n}\n\n>>> .ui-datatable{\n  padding-bottom: 20px;\n}\n\n>>> .vt-schema-popup-container p-dataTable th:nth-child(1) {\n    white-space: nowrap;\n    width: 44px;\n    text-align: center;\n}\n\n>>> .vt-schema-popup-container p-dataTable td:nth-child(1) {\n    width: 44px;\n    text-align: center;\n}\n\n>>> .vt-schema-popup-container p-dataTable th:nth-child(3) {\n    white-space: nowrap;\n    width: 52px;\n    text-align: center;\n}\n\n>>> .vt-schema-popup-container p-dataTable td:nth-child(3) {\n    width: 52px;\n    text-align: center;\n}\n\n>>> vt-schema .ui-dropdown {\n  width: auto !important;\n  min-width: 50px;\n}"},function(e,t){e.exports=".breadcrumbs {\n  padding: 10px;\n  background-color: #eee;\n}\n\n.breadcrumb-separator {\n  font-size: 24px;\n}\n"},function(e,t){e.exports=".vt-form-member{\n  width: 100%;\n  padding-top: 12px;\n  padding-bottom: 4px;\n  border-bottom: 1px solid #d3d3d3;\n}\n.vt-cell-left { \n  display: inline-block;\n  vertical-align: top;\n  width: 59% !important;\n}\n\n.vt-cell-right { \n  display: inline-block;\n  padding-bottom: 1%;\n  width: 39% !important;\n}\n\n.checkbox-wrapper {\n  width: 100%;\n  text-align: center;\n  vertical-align: middle;\n}\n\n.vt-form{\n  padding: 0;\n  margin: 0;\n  width: 100%;\n  display: table; \n  border-collapse:separate;\n  border-spacing:1em 0.5em;\n}\n\n.vt-sheet {\n  display: inline-block;\n  margin: 5px 10px 5px 0px;\n  padding: 5px 15px 5px 15px;\n  border-radius: 5px;\n  background-color: #EEEEEE;\n}\n\n.vt-log-frame {\n  max-height: 200px;\n  overflow:auto;\n  width: 100%;\n}\n\n>>> md-input {\n  width: 100%;\n}\n\n.vt-action-container {\n  margin-top: 20px;\n  float: right;\n}"},function(e,t){e.exports=".bordered {\n    border: 1px solid black;\n    border-spacing: 0;\n    margin: 0;\n    padding: 0;\n    text-align: center;\n    vertical-align: middle;\n}\n\n.collapsed-border {\n    border-collapse: collapse;\n    border-spacing: 0;\n    width: 70%;\n    float: left;\n    margin: 0 0;\n    border: 1px solid black;\n}\n\n.no-padding {\n  padding: 0;\n}\n\n.centered {\n  margin: 0 auto;\n}\n\n.popup {\n  position: absolute;\n  right: 5px;\n  width: 20%;\n  height: auto;\n  border: 1px solid black;\n  background-color: #FFFFFF;\n  float: right;\n  text-align: center;\n}\n"},function(e,t){e.exports=">>> .ui-dropdown {\n  width: auto !important;\n}\n\n.heatmaps {\n  overflow: auto;\n}\n"},function(e,t){e.exports="table, td {\n  border: 1px solid black\n}\n\np, h3 {\n  margin: 0px;\n  font-size: 12px;\n}\n\n.centered {\n  padding: 0px;\n  text-align: center;\n}\n\n.collapsed-border {\n    border-collapse: collapse;\n    border-spacing: 0;\n    margin: 0 auto;\n}\n\n.smaller { \n  font-size: 10px;\n}\n"},871,871,function(e,t){e.exports='<md-toolbar color="primary">\n  <span>{{title}}</span>\n  <a href="/app" title="Old UI"><md-icon style="position:fixed; right:25px; top:25px; color: #ffffff">trending_down</md-icon></a>\n</md-toolbar>\n\n<md-sidenav-layout class="flex-column flex-grow">\n  <md-sidenav #sidenav mode="side" opened="true">\n    <md-nav-list>\n      <a md-list-item [routerLink]="[\'/dashboard\']"><md-icon>dashboard</md-icon>Dashboard</a>\n      <a *ngIf="featuresService.showStatus" md-list-item [routerLink]="[\'/status\']" [queryParams]="{ keyspace: \'all\', cell: \'all\', type: \'all\', metric: \'health\'}"><md-icon>timeline</md-icon>Status</a>\n      <a md-list-item [routerLink]="[\'/schema\']"><md-icon>storage</md-icon>Schema</a>\n      <a md-list-item [routerLink]="[\'/topo\']"><md-icon>folder</md-icon>Topology</a>\n      <a *ngIf="featuresService.showWorkflows" md-list-item [routerLink]="[\'/workflows\']"><md-icon>list</md-icon>Workflows</a>\n      <a md-list-item [routerLink]="[\'/vreplication\']"><md-icon>sync</md-icon>VReplication</a>\n    </md-nav-list>\n  </md-sidenav>\n  <router-outlet></router-outlet>\n</md-sidenav-layout>\n'},function(e,t){e.exports='<div class="vt-toolbar vt-padding">\n  <md-icon *ngIf="featuresService.showTopologyCRUD" class="vt-menu" (click)="menuCrud.toggle($event)">menu</md-icon>\n  <p-menu #menuCrud popup="popup" [model]="actionsCrud"></p-menu>\n  <md-icon *ngIf="!featuresService.showTopologyCRUD" class="vt-menu" (click)="menu.toggle($event)">menu</md-icon>\n  <p-menu #menu popup="popup" [model]="actions"></p-menu>\n  <h1 class="vt-title">Keyspaces</h1>\n  <md-icon class="vt-right-menu" (click)="getKeyspaces()" [disabled]="inFlightQueries > 0">refresh</md-icon>\n</div>\n<div>\n  <div *ngFor="let keyspace of keyspaces" class="vt-card">\n    <md-card>\n      <div>\n        <div class="vt-card-toolbar">\n          <md-icon *ngIf="featuresService.showTopologyCRUD" class="vt-menu" (click)="setSelectedKeyspace(keyspace);cardMenu.toggle($event)">menu</md-icon>\n          <p-menu #cardMenu popup="popup" [model]="keyspaceActions"></p-menu>\n          <h2 id="{{keyspace.name}}" class="vt-title vt-keyspace-card">{{keyspace.name}}</h2>\n        </div>\n      </div>\n      <a class="vt-dark-link" [routerLink]="[\'/keyspace\']" [queryParams]="{keyspace: keyspace.name}">\n        <md-card-content >\n          <div class="stats-container">\n            <md-list>\n              <md-list-item>\n                <span class="vt-shard-stats">\n                    {{keyspace.servingShards.length}} Shards\n                  <div style="color: red" *ngIf="keyspace.nonservingShards.length > 0">\n                    (+{{keyspace.nonservingShards.length}} Not serving)\n                  </div>\n                </span>\n              </md-list-item>\n            </md-list>\n          </div>\n        </md-card-content>\n      </a>\n    </md-card>\n  </div>\n</div>\n<p-dialog [(header)]="dialogSettings.dialogTitle" [(visible)]="dialogSettings.open" draggable="" resizable="" width="800">\n  <vt-dialog [(dialogContent)]="dialogContent" [(dialogSettings)]="dialogSettings"></vt-dialog>\n</p-dialog>\n<div *ngIf="dialogSettings.open" class="vt-popUpContainer" >\n</div>\n'},function(e,t){e.exports='<div class="vt-toolbar vt-padding">\n  <md-icon class="vt-menu" (click)="menu.toggle($event)">menu</md-icon>\n  <p-menu #menu popup="popup" [model]="actions"></p-menu>\n  <h1 class="vt-title">{{keyspaceName}}</h1>\n  <md-icon class="vt-right-menu" (click)="refreshKeyspaceView()" [disabled]="inFlightQueries > 0">refresh</md-icon>\n</div>\n\n<h2 *ngIf="noShards()">There are no shards in this keyspace</h2>\n\n<div *ngIf="keyspace.servingShards != undefined && keyspace.servingShards.length > 0">\n  <h2 class="vt-padding">Serving Shards</h2>\n  <div *ngFor="let shard of keyspace.servingShards" class="vt-card">\n    <a class="vt-dark-link" [routerLink]="[\'/shard\']" [queryParams]="{keyspace: keyspaceName, shard: shard}">\n      <md-card >\n        <md-card-title class="vt-serving-shard">{{shard}}</md-card-title>\n      </md-card>\n    </a>\n  </div>\n</div>\n\n<div *ngIf="keyspace.nonservingShards != undefined && keyspace.nonservingShards.length > 0">\n  <h2 class="vt-padding">Nonserving Shards</h2>\n  <div *ngFor="let shard of keyspace.nonservingShards" class="vt-card">\n    <a class="vt-dark-link" [routerLink]="[\'/shard\']" [queryParams]="{keyspace: keyspaceName, shard: shard}">\n      <md-card>\n        <md-card-title class="vt-nonserving-shard">{{shard}}</md-card-title>\n      </md-card>\n    </a>\n  </div>\n</div>\n\n<p-dialog [(header)]="dialogSettings.dialogTitle" [(visible)]="dialogSettings.open" draggable="" resizable="" width="800">\n  <vt-dialog [(dialogContent)]="dialogContent" [(dialogSettings)]="dialogSettings" ></vt-dialog>\n</p-dialog>\n<div *ngIf="dialogSettings.open" class="vt-popUpContainer" >\n</div>\n'},function(e,t){e.exports='<div class="vt-toolbar vt-padding">\n  <md-icon class="vt-menu" (click)="menu.toggle($event)">menu</md-icon>\n  <p-menu #menu popup="popup" [model]="actions"></p-menu>\n  <h1 class="vt-title">{{keyspaceName}}/{{shardName}}</h1>\n  <md-icon class="vt-right-menu" (click)="refreshShardView()" [disabled]="inFlightQueries > 0">refresh</md-icon>\n</div>\n<div class="vt-tablet-container">\n  <p-dataTable [value]="tablets" emptyMessage="">\n    <p-column header="Actions">\n      <template let-tab="rowData" let-i="rowIndex">\n        <md-icon id="{{tab.uid}}" class="vt-menu" (click)="selectedTablet=tab; rowMenu.toggle($event)">menu</md-icon>\n        <p-menu #rowMenu popup="popup" [model]="tab.type === \'master\' ? tabletActionsMaster : tabletActionsSlave"></p-menu>\n      </template>\n    </p-column>\n    <p-column field="type" header="Type" sortable="custom" (sortFunction)="sortByType($event)">\n      <template let-tab="rowData">\n        <span *ngIf="tab.type === \'master\'"><strong>master</strong></span>\n        <span *ngIf="tab.type === \'replica\' || tab.type === \'rdonly\'"><em>{{tab.type}}</em></span>\n        <span *ngIf="tab.type !== \'master\' && tab.type !== \'replica\' && tab.type !== \'rdonly\'">{{tab.type}}</span>\n      </template>\n    </p-column>\n    <p-column field="cell" header="Cell" sortable="custom" (sortFunction)="sortByCell($event)"></p-column>\n    <p-column field="uid" header="UID" sortable="true"></p-column>\n    <p-column field="hostname" header="Host"></p-column>\n    <p-column header="Ports">\n      <template let-tab="rowData">\n        <span *ngFor="let portName of portNames(tab)">\n          <strong>{{portName}}:</strong> {{tab.port_map[portName]}},\n        </span>\n      </template>\n    </p-column>\n    <p-column header="Status">\n      <template let-tab="rowData">\n        <a href="{{getUrl(tab)}}">\n          <button md-button>Status</button>\n        </a>\n      </template>\n    </p-column>\n  </p-dataTable>\n  <h3 class="vt-padding" *ngIf="noTablets">There are no tablets in this shard.</h3>\n</div>\n\n<p-dialog [(header)]="dialogSettings.dialogTitle" [(visible)]="dialogSettings.open" draggable="" resizable="" width="800">\n  <vt-dialog [(dialogContent)]="dialogContent" [(dialogSettings)]="dialogSettings"></vt-dialog>\n</p-dialog>\n<div *ngIf="dialogSettings.open" class="vt-popUpContainer" >\n</div>\n'},function(e,t){e.exports='<h3 *ngIf="!tabletReady">Loading...</h3>\n<div *ngIf="tabletReady">\n  <h1>{{tablet.label}}</h1>\n  <div class="vt-action-center">\n    <p-accordion>\n      <p-accordionTab header="Actions">\n        <button md-raised-button (click)="openDeleteTabletDialog();">Delete</button>\n        <button md-raised-button (click)="openPingTabletDialog();">Ping</button>\n        <button md-raised-button (click)="openRefreshTabletDialog();">Refresh State</button>\n        <button md-raised-button (click)="openSetReadOnlyDialog();">SetReadOnly</button>\n        <button md-raised-button (click)="openSetReadWriteDialog();">SetReadWrite</button>\n        <button md-raised-button (click)="openStartSlaveDialog();">StartSlave</button>\n        <button md-raised-button (click)="openStopSlaveDialog();">StopSlave</button>\n        <button md-raised-button (click)="openRunHealthCheckDialog();">RunHealthCheck</button>\n        <button md-raised-button (click)="openIgnoreHealthErrorDialog();">IgnoreHealthError</button>\n        <button md-raised-button (click)="openReparentTabletDialog();">ReparentTablet</button>\n      </p-accordionTab>\n    </p-accordion>\n  </div>\n  <a [href]="tabletUrl"><button md-raised-button>Go to Tablet</button></a>\n  <p-dialog [(header)]="dialogSettings.dialogTitle" [(visible)]="dialogSettings.open" draggable="" resizable="" width="800">\n    <vt-dialog [(dialogContent)]="dialogContent" [(dialogSettings)]="dialogSettings"></vt-dialog>\n  </p-dialog>\n  <div *ngIf="dialogSettings.open" class="vt-popUpContainer" >\n  </div>\n<div>\n'},function(e,t){e.exports='<div class="vt-padding">\n  <h1>Schema</h1>\n  <div class ="vt-options">\n    <p-dropdown [options]="keyspaces" [(ngModel)]="selectedKeyspace" (onChange)="getShards(selectedKeyspace)" [filter]="true"></p-dropdown>\n    <p-dropdown [options]="shards" [(ngModel)]="selectedShard" (onChange)="getTablets(selectedKeyspace, selectedShard)" [filter]="true"></p-dropdown>\n    <p-dropdown [options]="tablets" [(ngModel)]="selectedTablet" (onChange)="fetchSchema(selectedKeyspace, selectedTablet)" [filter]="true"></p-dropdown>\n  </div>\n  <div class="vt-schema-left-container">\n    <p-dataTable [value]="schemas" selectionMode="single" emptyMessage="No Schemas for this tablet" [(selection)]="selectedSchema" (onRowSelect)="dialog=true;">\n      <header>Tables</header>\n      <p-column field="name" header="Name" sortable="true"></p-column>\n      <p-column field="primary_key_columns" header="PKs" sortable="true"></p-column>\n      <p-column field="data_length" header="Data Length" sortable="true"></p-column>\n      <p-column field="row_count" header="# Rows" sortable="true"></p-column>\n    </p-dataTable>\n\n    <p-dataTable [value]="vSchemas" emptyMessage="No VSchemas for this keyspace" [(selection)]="selectedVSchema">\n      <header>Vindexes</header>\n      <p-column field="name" header="Name" sortable="true"></p-column>\n      <p-column field="type" header="Type" sortable="true"></p-column>\n      <p-column field="params" header="Params" sortable="true"></p-column>\n      <p-column field="owner" header="Owner" sortable="true"></p-column>\n    </p-dataTable>\n  </div>\n  <div *ngIf="selectedSchema" class="vt-schema-popup-container">\n    <p-dialog [(header)]="selectedSchema.name" [(visible)]="dialog" draggable="" resizable="" width="800">\n      <table>\n        <tr>\n          <td>\n            <strong>Data Length:</strong>\n          </td>\n          <td>\n            {{selectedSchema.data_length}}\n          </td>\n        </tr>\n        <tr>\n          <td>\n            <strong>Row Count:</strong>\n          </td>\n          <td>\n            {{selectedSchema.row_count}}\n          </td>\n        </tr>\n      </table>\n      <p-dataTable [value]="selectedSchema.columns" emptyMessage="No Columns for this schema">\n        <header>Columns</header>\n        <p-column field="index" header="#" sortable="true"></p-column>\n        <p-column field="name" header="Name"></p-column>\n        <p-column field="pk" header="PK" sortable="true"></p-column>\n        <p-column field="vindex" header="Vindex"></p-column>\n        <p-column field="sequence" header="Sequence"></p-column>\n      </p-dataTable>\n      <md-card>\n        <h3>Schema:</h3>\n        <pre>{{selectedSchema.schema}}</pre>\n      </md-card>\n    </p-dialog>\n  </div>\n</div>\n'},function(e,t){e.exports='<md-card class="breadcrumbs">\n  <a md-button [routerLink]="route" [queryParams]="{}"><md-icon>{{icon}}</md-icon></a>\n  <span *ngFor="let crumb of crumbs">\n    <span class="breadcrumb-separator">{{separator}}</span>\n    <a md-button [routerLink]="route" [queryParams]="crumb.queryParams">{{crumb.name | uppercase}}</a>\n  </span>\n</md-card>\n'},function(e,t){e.exports='<div *ngIf="dialogSettings.dialogForm">\n  <h4 class="vt-padding" *ngIf="dialogSettings.dialogSubtitle">{{dialogSettings.dialogSubtitle}}</h4>\n  <span #vtFormWrapper>\n    <div class="vt-padding">\n      <div *ngFor="let flag of dialogContent.getFlags()">\n        <div  *ngIf="dialogContent.canDisplay(flag.id)" class="vt-form-member">\n          <div class="vt-cell-left">\n            <div>\n              <strong>{{flag.name}}<span *ngIf="dialogContent.isRequired(flag.id)"> (Required)</span>:</strong>\n            </div>\n            <div>\n              {{flag.description}}\n            </div>\n          </div>\n          <div class="vt-cell-right">\n            <md-input *ngIf="flag.type==\'input\'" [(ngModel)]="flag.value" [required]="dialogContent.isRequired(flag.id)" placeholder="{{flag.name}}"></md-input>\n            <div  *ngIf="flag.type == \'checkBox\'" class="checkbox-wrapper">\n              <md-checkbox class="md-primary" [(ngModel)]="flag.value" ></md-checkbox>\n            </div>\n            <p-dropdown *ngIf="flag.type == \'dropDown\'" [options]="flag.getOptions()" [(ngModel)]="flag.value"></p-dropdown>\n          </div>\n        </div>\n      </div>\n    </div>\n  </span>\n  <div *ngIf="dialogContent.canSubmit()" class="vt-padding">\n    <h3>Command:</h3>\n    <div class="vt-sheet" *ngFor="let cmd of getCmd()">\n      {{cmd}}\n    </div>\n  </div>\n  <div class="vt-padding vt-action-container">\n    <button md-button disableRipple="true" id="vt-action" [disabled]="!dialogContent.canSubmit()" (click)="sendAction();">{{dialogSettings.actionWord}}</button>\n    <button md-button disableRipple="true" id="vt-cancel" (click)="cancelDialog();">Cancel</button>\n  </div>\n</div>\n<div class="vt-padding" *ngIf="dialogSettings.dialogLog">\n  <div *ngIf="!dialogSettings.pending">\n    <div class="vt-resp">\n      {{dialogContent.interpolateMessage(dialogSettings.respText)}}\n    </div>\n    <h4 *ngIf="dialogSettings.logText">Log:</h4>\n    <div class="vt-sheet vt-log-frame" *ngIf="dialogSettings.logText">\n      <pre>{{dialogSettings.logText}}</pre>\n    </div>\n  </div>\n  <div *ngIf="dialogSettings.pending">\n    Loading Response...\n    <md-progress-bar mode="indeterminate"></md-progress-bar>\n  </div>\n  <div class="buttons">\n    <button md-button disableRipple="true" id="vt-dismiss" [disabled]="dialogSettings.pending" (click)="closeDialog()">Dismiss</button>\n  </div>\n</div>\n'},function(e,t){e.exports='<h2 id="keyspaceName"> {{keyspace.Name}} </h2>\n\n<table id="heatmapTable" class="collapsed-border">\n  <!-- The first row with an offset to match the heatmap labels. -->\n  <tr [ngStyle]="{\'height\': (getXLabelsRowHeight())+\'px\'}">\n    <td class="no-padding"></td>\n    <td class="no-padding"></td>\n    <td class="no-padding" [attr.rowspan]="(getTotalRows()+1)">\n      <div id="{{name}}" [ngStyle]="{\'height\': heatmapHeight+\'px\'}">\n        <!-- Heatmap gets drawn in this div-->\n      </div>\n    </td>\n  </tr>\n\n<!-- The cell and type labels of the map are drawn -->\n<template ngFor let-label [ngForOf]="yLabels" let-firstKeyspace="first">\n  <template [ngIf]="label.TypeLabels == null">\n  <tr [ngStyle]="{\'height\': getRowHeight()+\'px\'}">\n    <td [attr.rowspan]="label.CellLabel.Rowspan" class="bordered">{{label.CellLabel.Name}}</td>\n  </tr>\n\n  <!-- We add empty rows here because the rowspan value of the heatmap\'s cell labels expects them. -->\n  <tr *ngFor="let num of getRemainingRows(label.CellLabel.Rowspan)" [ngStyle]="{\'height\': getRowHeight()+\'px\'}"></tr>\n  </template>\n\n  <template [ngIf]="label.TypeLabels != null">\n    <template ngFor let-typeLabel [ngForOf]="label.TypeLabels" let-firstCell="first">\n    <tr [ngStyle]="{\'height\': getRowHeight()+\'px\'}">\n      <td *ngIf="firstCell" [attr.rowspan]="label.CellLabel.Rowspan" class="bordered">{{label.CellLabel.Name}}</td>\n      <td class="bordered" [attr.rowspan]="typeLabel.Rowspan">{{typeLabel.Name}}</td>\n    </tr>\n\n    <!-- We add empty rows here because the rowspan value of the heatmap\'s cell and type labels expects them. -->\n    <tr *ngFor="let num of getRemainingRows(typeLabel.Rowspan)" [ngStyle]="{\'height\': getRowHeight()+\'px\'}"></tr>\n    </template>\n  </template>\n</template>\n</table>\n\n<div *ngIf="showPopup" class="popup">\n  <vt-tablet-popup [data]="popupData"\n                   [alias]="popupAlias"\n                   [keyspace]="popupKeyspace"\n                   [shard]="popupShard"\n                   [cell]="popupCell"\n                   [tabletType]="popupType"\n                   [clicked]="popupClickState"\n                   >\n  </vt-tablet-popup>\n <button md-button (click)="closePopup()" class="centered">Dismiss</button>\n</div>\n'},function(e,t){e.exports='<!-- Keyspace selector -->\n<p-dropdown id="keyspace" (onChange)="handleKeyspaceChange($event)" [options]="keyspaces" [(ngModel)]="selectedKeyspace"></p-dropdown>\n\n<!-- Cell selector -->\n<p-dropdown id="cell" (onChange)="handleCellChange($event)" [options]="cells" [(ngModel)]="selectedCell"></p-dropdown>\n\n<!-- Type selector -->\n<p-dropdown id="type" (onChange)="handleTypeChange($event)" [options]="tabletTypes" [(ngModel)]="selectedType"></p-dropdown>\n\n<!-- Metric selector -->\n<p-dropdown id="metric" (onChange)="handleMetricChange($event)" [options]="metrics" [(ngModel)]="selectedMetric"></p-dropdown>\n\n<!-- Wait until the heatmap data has been obtained from the service -->\n<div *ngFor="let keyspace of listOfKeyspaces" class="heatmaps">\n  <vt-heatmap *ngIf="heatmapDataReady"\n    [heatmap]="mapOfKeyspaces[keyspace]"\n    [name]="keyspace"\n    [metric]="selectedMetric"\n  >\n  </vt-heatmap>\n</div>\n'},function(e,t){e.exports='<!-- Default div when no hovering or clicking -->\n<div *ngIf="defaultPopup" class="centered">\n  <h3> Nothing selected. Hover over a point to show details. </h3>\n</div>\n\n<!-- Popup displayed when tablet does not exist -->\n<div *ngIf="missingPopup" class="centered">\n  <h3> Tablet does not exist. </h3>\n</div>\n\n<!-- Popup of details of tablet when hovering/clicked -->\n<div *ngIf="!defaultPopup && !missingPopup" class="centered">\n  <b *ngIf="!title">{{title}}</b>\n  <p>Keyspace: {{keyspace}}</p>\n  <p>Shard: {{shard}}</p>\n  <p>Cell: {{cell}}</p>\n  <p>Tablet type: {{tabletType}}</p>\n  <div *ngIf="!clicked">\n     <h3>Click on tablet for more details</h3>\n  </div>\n  <!-- Additional tablet details when clicked -->\n  <div *ngIf="clicked && aggregated" class="centered">\n    <b>Aggregated tablets of all types</b>\n    <br/>\n    <p>Value: {{data}}</p>\n  </div>\n  <div *ngIf="clicked && unaggregated && healthDataReady" class="centered">\n   <p class="smaller">{{hostname}}</p>\n    <a [href]="tabletUrl">Go to tablet</a>\n    <table class="collapsed-border" >\n      <tr>\n        <td>Replication Lag\n        <td>{{lag}}\n      </tr>\n      <tr>\n        <td>Qps\n        <td>{{qps}}\n      </tr>\n      <tr>\n        <td>Serving\n        <td>{{serving}}\n      </tr>\n      <tr>\n        <td>Health Error\n        <td>{{error}}\n      </tr>\n      <tr>\n        <td>Last Error\n        <td>{{lastError}}\n      </tr>\n    </table>\n  </div>\n</div>\n'},function(e,t){e.exports='<div class="content">\n\n<h1>{{title}}</h1>\n\n<vt-breadcrumbs [route]="[\'/topo\']" [crumbs]="breadcrumbs"></vt-breadcrumbs>\n\n<md-card class="frame">\n  <md-nav-list *ngIf="node.Children" class="frame">\n    <a *ngFor="let child of node.Children" md-list-item\n      [routerLink]="[\'/topo\']" [queryParams]="childLink(child)">\n      <md-icon>folder</md-icon> {{child}}</a>\n  </md-nav-list>\n</md-card>\n\n<md-card *ngIf="node.Data">\n  <md-card-title>Node Data</md-card-title>\n  <md-card-content><pre>{{node.Data}}</pre></md-card-content>\n</md-card>\n\n<md-card *ngIf="node.Error">\n  <md-card-title>Error</md-card-title>\n  <md-card-content><pre>{{node.Error}}</pre></md-card-content>\n</md-card>\n\n</div>\n'},function(e,t){e.exports='<div *ngIf="isMaster()" class="vt-toolbar vt-padding">\n  <h1 class="vt-title">{{title}}</h1>\n  <md-icon class="vt-right-menu" (click)="openNewDialog()">add</md-icon>\n</div>\n<div *ngIf="!isMaster()" class="vt-toolbar vt-padding">\n  <h1 class="vt-title">Go to <a href="{{redirect}}">Master</a></h1>\n</div>\n<div>\n  <span *ngFor="let workflow of workflows; let i = index">\n    <vt-workflow [workflow]="workflows[i]" [workflowListComponent]="this"></vt-workflow>\n  </span>\n</div>\n<p-dialog [(header)]="dialogSettings.dialogTitle" [(visible)]="dialogSettings.open" draggable="" resizable="" width="800">\n  <vt-dialog [(dialogContent)]="dialogContent" [(dialogSettings)]="dialogSettings"></vt-dialog>\n</p-dialog>\n<div *ngIf="dialogSettings.open" class="vt-popUpContainer" >\n</div>\n'},function(e,t){e.exports='<div class="vt-workflow-padding" *ngIf="workflow">\n  <p-accordion >\n    <span >\n      <p-accordionTab class="{{getState(workflow.state)}}">\n        <header class="vt-pad-header">\n          <div class="vt-accordion-name-wrapper">\n            <span class="vt-accordion-name">\n              <md-icon *ngIf="workflow.isNotStarted()">remove</md-icon>\n              <md-icon *ngIf="workflow.isRunning()">forward</md-icon>\n              <md-icon *ngIf="workflow.isDone()">check</md-icon>\n              {{workflow.name}}\n            </span>\n          </div>\n          <div class="vt-progress-bar-wrapper">\n            <md-progress-bar class="vt-progress-bar" *ngIf="workflow.isDeterminate()" mode="determinate" [value]="workflow.progress" color="primary"></md-progress-bar>\n            <md-progress-bar class="vt-progress-bar" *ngIf="workflow.isIndeterminate()" mode="indeterminate" color="primary"></md-progress-bar>\n          </div>\n          <div class="vt-progress-msg-wrapper" *ngIf="workflow.isDeterminate() || workflow.isIndeterminate()" >\n            <span class="vt-progress-msg">\n              {{workflow.progressMsg}}\n            </span>\n          </div>\n          <div class="vt-workflow-action-wrapper" *ngIf="workflow.isRoot()">\n            <span class="vt-workflow-action">\n              <button md-raised-button *ngIf="workflow.isNotStarted()" (click)="startClicked($event); false">Start</button>\n              <button md-raised-button *ngIf="workflow.isRunning()" (click)="stopClicked($event); false">Stop</button>\n              <button md-raised-button *ngIf="!workflow.isRunning()" (click)="deleteClicked($event); false">Delete</button>\n            </span>\n          </div>\n        </header>\n        <div class="vt-workflow-content">\n          <div *ngIf="getTime()" class="vt-workflow-time">\n            Last Change: {{getTime()}}\n          </div>\n          <div class="vt-msg">\n            {{workflow.message}}\n          </div>\n          <div class="vt-log vt-workflow-padding">\n            <p-accordion>\n              <p-accordionTab [disabled]="workflow.log.length === 0">\n              <header class="vt-pad-header">\n                <span class="vt-accordion-name">\n                  Log\n                </span>\n              </header>\n              <div class="vt-log-text vt-workflow-padding">{{workflow.log}}</div>\n              </p-accordionTab>\n            </p-accordion>\n          </div>\n          <div class="vt-actions">\n            <span *ngFor="let action of workflow.actions">\n              <button md-raised-button disableRipple=true [disabled]="action.isDisabled()" (click)="actionClicked(action.name);" class="{{getActionClass(action.style)}}">{{action.name}}</button>\n            </span>\n          </div>\n        </div>\n        <vt-workflow *ngFor="let child of workflow.children; let i = index" [workflow]="workflow.children[i]" [workflowListComponent]="workflowListComponent"></vt-workflow>\n      </p-accordionTab>\n    </span>\n  </p-accordion>\n</div>\n';
 },function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=function(e){function InnerSubscriber(t,n,r){e.call(this),this.parent=t,this.outerValue=n,this.outerIndex=r,this.index=0}return r(InnerSubscriber,e),InnerSubscriber.prototype._next=function(e){this.parent.notifyNext(this.outerValue,e,this.outerIndex,this.index++,this)},InnerSubscriber.prototype._error=function(e){this.parent.notifyError(e,this),this.unsubscribe()},InnerSubscriber.prototype._complete=function(){this.parent.notifyComplete(this),this.unsubscribe()},InnerSubscriber}(i.Subscriber);t.InnerSubscriber=o},function(e,t){"use strict";t.empty={isUnsubscribed:!0,next:function(e){},error:function(e){throw e},complete:function(){}}},function(e,t,n){"use strict";var r=n(6),i=function(){function Operator(){}return Operator.prototype.call=function(e,t){return t._subscribe(new r.Subscriber(e))},Operator}();t.Operator=i},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(41),o=function(e){function SubjectSubscription(t,n){e.call(this),this.subject=t,this.observer=n,this.isUnsubscribed=!1}return r(SubjectSubscription,e),SubjectSubscription.prototype.unsubscribe=function(){if(!this.isUnsubscribed){this.isUnsubscribed=!0;var e=this.subject,t=e.observers;if(this.subject=null,t&&0!==t.length&&!e.isUnsubscribed){var n=t.indexOf(this.observer);n!==-1&&t.splice(n,1)}}},SubjectSubscription}(i.Subscription);t.SubjectSubscription=o},function(e,t,n){"use strict";var r=n(1),i=n(996);r.Observable.bindCallback=i.bindCallback},function(e,t,n){"use strict";var r=n(1),i=n(997);r.Observable.bindNodeCallback=i.bindNodeCallback},function(e,t,n){"use strict";var r=n(1),i=n(310);r.Observable.combineLatest=i.combineLatestStatic},function(e,t,n){"use strict";var r=n(1),i=n(998);r.Observable.concat=i.concat},function(e,t,n){"use strict";var r=n(1),i=n(999);r.Observable.defer=i.defer},function(e,t,n){"use strict";var r=n(1),i=n(1e3);r.Observable.empty=i.empty},function(e,t,n){"use strict";var r=n(1),i=n(309);r.Observable.from=i.from},function(e,t,n){"use strict";var r=n(1),i=n(1001);r.Observable.fromEvent=i.fromEvent},function(e,t,n){"use strict";var r=n(1),i=n(1002);r.Observable.fromEventPattern=i.fromEventPattern},function(e,t,n){"use strict";var r=n(1),i=n(211);r.Observable.fromPromise=i.fromPromise},function(e,t,n){"use strict";var r=n(1),i=n(1004);r.Observable.merge=i.merge},function(e,t,n){"use strict";var r=n(1),i=n(1005);r.Observable.never=i.never},function(e,t,n){"use strict";var r=n(1),i=n(510);r.Observable.race=i.raceStatic},function(e,t,n){"use strict";var r=n(1),i=n(1006);r.Observable.range=i.range},function(e,t,n){"use strict";var r=n(1),i=n(1008);r.Observable.timer=i.timer},function(e,t,n){"use strict";var r=n(1),i=n(1009);r.Observable.zip=i.zip},function(e,t,n){"use strict";var r=n(1),i=n(1010);r.Observable.prototype.audit=i.audit},function(e,t,n){"use strict";var r=n(1),i=n(1011);r.Observable.prototype.auditTime=i.auditTime},function(e,t,n){"use strict";var r=n(1),i=n(1012);r.Observable.prototype.buffer=i.buffer},function(e,t,n){"use strict";var r=n(1),i=n(1013);r.Observable.prototype.bufferCount=i.bufferCount},function(e,t,n){"use strict";var r=n(1),i=n(1014);r.Observable.prototype.bufferTime=i.bufferTime},function(e,t,n){"use strict";var r=n(1),i=n(1015);r.Observable.prototype.bufferToggle=i.bufferToggle},function(e,t,n){"use strict";var r=n(1),i=n(1016);r.Observable.prototype.bufferWhen=i.bufferWhen},function(e,t,n){"use strict";var r=n(1),i=n(1017);r.Observable.prototype.cache=i.cache},function(e,t,n){"use strict";var r=n(1),i=n(1019);r.Observable.prototype.combineAll=i.combineAll},function(e,t,n){"use strict";var r=n(1),i=n(311);r.Observable.prototype.concat=i.concat},function(e,t,n){"use strict";var r=n(1),i=n(1021);r.Observable.prototype.concatMap=i.concatMap},function(e,t,n){"use strict";var r=n(1),i=n(1022);r.Observable.prototype.concatMapTo=i.concatMapTo},function(e,t,n){"use strict";var r=n(1),i=n(1023);r.Observable.prototype.count=i.count},function(e,t,n){"use strict";var r=n(1),i=n(1024);r.Observable.prototype.debounce=i.debounce},function(e,t,n){"use strict";var r=n(1),i=n(1026);r.Observable.prototype.defaultIfEmpty=i.defaultIfEmpty},function(e,t,n){"use strict";var r=n(1),i=n(1027);r.Observable.prototype.delay=i.delay},function(e,t,n){"use strict";var r=n(1),i=n(1028);r.Observable.prototype.delayWhen=i.delayWhen},function(e,t,n){"use strict";var r=n(1),i=n(1029);r.Observable.prototype.dematerialize=i.dematerialize},function(e,t,n){"use strict";var r=n(1),i=n(1033);r.Observable.prototype.expand=i.expand},function(e,t,n){"use strict";var r=n(1),i=n(1036);r.Observable.prototype.groupBy=i.groupBy},function(e,t,n){"use strict";var r=n(1),i=n(1037);r.Observable.prototype.ignoreElements=i.ignoreElements},function(e,t,n){"use strict";var r=n(1),i=n(1039);r.Observable.prototype.let=i.letProto,r.Observable.prototype.letBind=i.letProto},function(e,t,n){"use strict";var r=n(1),i=n(1040);r.Observable.prototype.mapTo=i.mapTo},function(e,t,n){"use strict";var r=n(1),i=n(1041);r.Observable.prototype.materialize=i.materialize},function(e,t,n){"use strict";var r=n(1),i=n(508);r.Observable.prototype.flatMapTo=i.mergeMapTo,r.Observable.prototype.mergeMapTo=i.mergeMapTo},function(e,t,n){"use strict";var r=n(1),i=n(111);r.Observable.prototype.multicast=i.multicast},function(e,t,n){"use strict";var r=n(1),i=n(312);r.Observable.prototype.observeOn=i.observeOn},function(e,t,n){"use strict";var r=n(1),i=n(1042);r.Observable.prototype.partition=i.partition},function(e,t,n){"use strict";var r=n(1),i=n(1043);r.Observable.prototype.pluck=i.pluck},function(e,t,n){"use strict";var r=n(1),i=n(1044);r.Observable.prototype.publish=i.publish},function(e,t,n){"use strict";var r=n(1),i=n(1045);r.Observable.prototype.publishBehavior=i.publishBehavior},function(e,t,n){"use strict";var r=n(1),i=n(1046);r.Observable.prototype.publishLast=i.publishLast},function(e,t,n){"use strict";var r=n(1),i=n(509);r.Observable.prototype.publishReplay=i.publishReplay},function(e,t,n){"use strict";var r=n(1),i=n(510);r.Observable.prototype.race=i.race},function(e,t,n){"use strict";var r=n(1),i=n(1048);r.Observable.prototype.repeat=i.repeat},function(e,t,n){"use strict";var r=n(1),i=n(1049);r.Observable.prototype.retry=i.retry},function(e,t,n){"use strict";var r=n(1),i=n(1050);r.Observable.prototype.retryWhen=i.retryWhen},function(e,t,n){"use strict";var r=n(1),i=n(1051);r.Observable.prototype.sample=i.sample},function(e,t,n){"use strict";var r=n(1),i=n(1052);r.Observable.prototype.sampleTime=i.sampleTime},function(e,t,n){"use strict";var r=n(1),i=n(1053);r.Observable.prototype.scan=i.scan},function(e,t,n){"use strict";var r=n(1),i=n(1055);r.Observable.prototype.single=i.single},function(e,t,n){"use strict";var r=n(1),i=n(1056);r.Observable.prototype.skip=i.skip},function(e,t,n){"use strict";var r=n(1),i=n(1057);r.Observable.prototype.skipUntil=i.skipUntil},function(e,t,n){"use strict";var r=n(1),i=n(1058);r.Observable.prototype.skipWhile=i.skipWhile},function(e,t,n){"use strict";var r=n(1),i=n(1059);r.Observable.prototype.startWith=i.startWith},function(e,t,n){"use strict";var r=n(1),i=n(1060);r.Observable.prototype.subscribeOn=i.subscribeOn},function(e,t,n){"use strict";var r=n(1),i=n(1061);r.Observable.prototype.switch=i._switch},function(e,t,n){"use strict";var r=n(1),i=n(1063);r.Observable.prototype.switchMapTo=i.switchMapTo},function(e,t,n){"use strict";var r=n(1),i=n(1064);r.Observable.prototype.take=i.take},function(e,t,n){"use strict";var r=n(1),i=n(1065);r.Observable.prototype.takeLast=i.takeLast},function(e,t,n){"use strict";var r=n(1),i=n(1066);r.Observable.prototype.takeUntil=i.takeUntil},function(e,t,n){"use strict";var r=n(1),i=n(1067);r.Observable.prototype.takeWhile=i.takeWhile},function(e,t,n){"use strict";var r=n(1),i=n(1068);r.Observable.prototype.throttle=i.throttle},function(e,t,n){"use strict";var r=n(1),i=n(1069);r.Observable.prototype.throttleTime=i.throttleTime},function(e,t,n){"use strict";var r=n(1),i=n(1070);r.Observable.prototype.timeout=i.timeout},function(e,t,n){"use strict";var r=n(1),i=n(1071);r.Observable.prototype.timeoutWith=i.timeoutWith},function(e,t,n){"use strict";var r=n(1),i=n(1072);r.Observable.prototype.toArray=i.toArray},function(e,t,n){"use strict";var r=n(1),i=n(1073);r.Observable.prototype.window=i.window},function(e,t,n){"use strict";var r=n(1),i=n(1074);r.Observable.prototype.windowCount=i.windowCount},function(e,t,n){"use strict";var r=n(1),i=n(1075);r.Observable.prototype.windowTime=i.windowTime},function(e,t,n){"use strict";var r=n(1),i=n(1076);r.Observable.prototype.windowToggle=i.windowToggle},function(e,t,n){"use strict";var r=n(1),i=n(1077);r.Observable.prototype.windowWhen=i.windowWhen},function(e,t,n){"use strict";var r=n(1),i=n(1078);r.Observable.prototype.withLatestFrom=i.withLatestFrom},function(e,t,n){"use strict";var r=n(1),i=n(314);r.Observable.prototype.zip=i.zipProto},function(e,t,n){"use strict";var r=n(1),i=n(1079);r.Observable.prototype.zipAll=i.zipAll},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(1),o=n(308),s=n(80),a=function(e){function ArrayLikeObservable(t,n,r,i){e.call(this),this.arrayLike=t,this.scheduler=i,n||i||1!==t.length||(this._isScalar=!0,this.value=t[0]),n&&(this.mapFn=n.bind(r))}return r(ArrayLikeObservable,e),ArrayLikeObservable.create=function(e,t,n,r){var i=e.length;return 0===i?new s.EmptyObservable:1!==i||t?new ArrayLikeObservable(e,t,n,r):new o.ScalarObservable(e[0],r)},ArrayLikeObservable.dispatch=function(e){var t=e.arrayLike,n=e.index,r=e.length,i=e.mapFn,o=e.subscriber;if(!o.isUnsubscribed){if(n>=r)return void o.complete();var s=i?i(t[n],n):t[n];o.next(s),e.index=n+1,this.schedule(e)}},ArrayLikeObservable.prototype._subscribe=function(e){var t=0,n=this,r=n.arrayLike,i=n.mapFn,o=n.scheduler,s=r.length;if(o)return o.schedule(ArrayLikeObservable.dispatch,0,{arrayLike:r,index:t,length:s,mapFn:i,subscriber:e});for(var a=0;a<s&&!e.isUnsubscribed;a++){var l=i?i(r[a],a):r[a];e.next(l)}e.complete()},ArrayLikeObservable}(i.Observable);t.ArrayLikeObservable=a},function(e,t,n){"use strict";function dispatch(e){var t=this,n=e.source,r=e.subscriber,i=n.callbackFunc,l=n.args,c=n.scheduler,u=n.subject;if(!u){u=n.subject=new a.AsyncSubject;var p=function handlerFn(){for(var e=[],n=0;n<arguments.length;n++)e[n-0]=arguments[n];var r=handlerFn.source,i=r.selector,a=r.subject;if(i){var l=o.tryCatch(i).apply(this,e);l===s.errorObject?t.add(c.schedule(dispatchError,0,{err:s.errorObject.e,subject:a})):t.add(c.schedule(dispatchNext,0,{value:l,subject:a}))}else{var u=1===e.length?e[0]:e;t.add(c.schedule(dispatchNext,0,{value:u,subject:a}))}};p.source=n;var d=o.tryCatch(i).apply(this,l.concat(p));d===s.errorObject&&u.error(s.errorObject.e)}t.add(u.subscribe(r))}function dispatchNext(e){var t=e.value,n=e.subject;n.next(t),n.complete()}function dispatchError(e){var t=e.err,n=e.subject;n.error(t)}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(1),o=n(42),s=n(38),a=n(206),l=function(e){function BoundCallbackObservable(t,n,r,i){e.call(this),this.callbackFunc=t,this.selector=n,this.args=r,this.scheduler=i}return r(BoundCallbackObservable,e),BoundCallbackObservable.create=function(e,t,n){return void 0===t&&(t=void 0),function(){for(var r=[],i=0;i<arguments.length;i++)r[i-0]=arguments[i];return new BoundCallbackObservable(e,t,r,n)}},BoundCallbackObservable.prototype._subscribe=function(e){var t=this.callbackFunc,n=this.args,r=this.scheduler,i=this.subject;if(r)return r.schedule(dispatch,0,{source:this,subscriber:e});if(!i){i=this.subject=new a.AsyncSubject;var l=function handlerFn(){for(var e=[],t=0;t<arguments.length;t++)e[t-0]=arguments[t];var n=handlerFn.source,r=n.selector,i=n.subject;if(r){var a=o.tryCatch(r).apply(this,e);a===s.errorObject?i.error(s.errorObject.e):(i.next(a),i.complete())}else i.next(1===e.length?e[0]:e),i.complete()};l.source=this;var c=o.tryCatch(t).apply(this,n.concat(l));c===s.errorObject&&i.error(s.errorObject.e)}return i.subscribe(e)},BoundCallbackObservable}(i.Observable);t.BoundCallbackObservable=l},function(e,t,n){"use strict";function dispatch(e){var t=this,n=e.source,r=e.subscriber,i=n.callbackFunc,l=n.args,c=n.scheduler,u=n.subject;if(!u){u=n.subject=new a.AsyncSubject;var p=function handlerFn(){for(var e=[],n=0;n<arguments.length;n++)e[n-0]=arguments[n];var r=handlerFn.source,i=r.selector,a=r.subject,l=e.shift();if(l)a.error(l);else if(i){var u=o.tryCatch(i).apply(this,e);u===s.errorObject?t.add(c.schedule(dispatchError,0,{err:s.errorObject.e,subject:a})):t.add(c.schedule(dispatchNext,0,{value:u,subject:a}))}else{var p=1===e.length?e[0]:e;t.add(c.schedule(dispatchNext,0,{value:p,subject:a}))}};p.source=n;var d=o.tryCatch(i).apply(this,l.concat(p));d===s.errorObject&&u.error(s.errorObject.e)}t.add(u.subscribe(r))}function dispatchNext(e){var t=e.value,n=e.subject;n.next(t),n.complete()}function dispatchError(e){var t=e.err,n=e.subject;n.error(t)}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(1),o=n(42),s=n(38),a=n(206),l=function(e){function BoundNodeCallbackObservable(t,n,r,i){e.call(this),this.callbackFunc=t,this.selector=n,this.args=r,this.scheduler=i}return r(BoundNodeCallbackObservable,e),BoundNodeCallbackObservable.create=function(e,t,n){return void 0===t&&(t=void 0),function(){for(var r=[],i=0;i<arguments.length;i++)r[i-0]=arguments[i];return new BoundNodeCallbackObservable(e,t,r,n)}},BoundNodeCallbackObservable.prototype._subscribe=function(e){var t=this.callbackFunc,n=this.args,r=this.scheduler,i=this.subject;if(r)return r.schedule(dispatch,0,{source:this,subscriber:e});if(!i){i=this.subject=new a.AsyncSubject;var l=function handlerFn(){for(var e=[],t=0;t<arguments.length;t++)e[t-0]=arguments[t];var n=handlerFn.source,r=n.selector,i=n.subject,a=e.shift();if(a)i.error(a);else if(r){var l=o.tryCatch(r).apply(this,e);l===s.errorObject?i.error(s.errorObject.e):(i.next(l),i.complete())}else i.next(1===e.length?e[0]:e),i.complete()};l.source=this;var c=o.tryCatch(t).apply(this,n.concat(l));c===s.errorObject&&i.error(s.errorObject.e)}return i.subscribe(e)},BoundNodeCallbackObservable}(i.Observable);t.BoundNodeCallbackObservable=l},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(1),o=n(12),s=n(11),a=function(e){function DeferObservable(t){e.call(this),this.observableFactory=t}return r(DeferObservable,e),DeferObservable.create=function(e){return new DeferObservable(e)},DeferObservable.prototype._subscribe=function(e){return new l(e,this.observableFactory)},DeferObservable}(i.Observable);t.DeferObservable=a;var l=function(e){function DeferSubscriber(t,n){e.call(this,t),this.factory=n,this.tryDefer()}return r(DeferSubscriber,e),DeferSubscriber.prototype.tryDefer=function(){try{this._callFactory()}catch(e){this._error(e)}},DeferSubscriber.prototype._callFactory=function(){var e=this.factory();e&&this.add(o.subscribeToResult(this,e))},DeferSubscriber}(s.OuterSubscriber)},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(1),o=function(e){function ErrorObservable(t,n){e.call(this),this.error=t,this.scheduler=n}return r(ErrorObservable,e),ErrorObservable.create=function(e,t){return new ErrorObservable(e,t)},ErrorObservable.dispatch=function(e){var t=e.error,n=e.subscriber;n.error(t)},ErrorObservable.prototype._subscribe=function(e){var t=this.error,n=this.scheduler;return n?n.schedule(ErrorObservable.dispatch,0,{error:t,subscriber:e}):void e.error(t)},ErrorObservable}(i.Observable);t.ErrorObservable=o},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(1),o=n(80),s=n(81),a=n(12),l=n(11),c=function(e){function ForkJoinObservable(t,n){e.call(this),this.sources=t,this.resultSelector=n}return r(ForkJoinObservable,e),ForkJoinObservable.create=function(){for(var e=[],t=0;t<arguments.length;t++)e[t-0]=arguments[t];if(null===e||0===arguments.length)return new o.EmptyObservable;var n=null;return"function"==typeof e[e.length-1]&&(n=e.pop()),1===e.length&&s.isArray(e[0])&&(e=e[0]),0===e.length?new o.EmptyObservable:new ForkJoinObservable(e,n)},ForkJoinObservable.prototype._subscribe=function(e){return new u(e,this.sources,this.resultSelector)},ForkJoinObservable}(i.Observable);t.ForkJoinObservable=c;var u=function(e){function ForkJoinSubscriber(t,n,r){e.call(this,t),this.sources=n,this.resultSelector=r,this.completed=0,this.haveValues=0;var i=n.length;this.total=i,this.values=new Array(i);for(var o=0;o<i;o++){var s=n[o],l=a.subscribeToResult(this,s,null,o);l&&(l.outerIndex=o,this.add(l))}}return r(ForkJoinSubscriber,e),ForkJoinSubscriber.prototype.notifyNext=function(e,t,n,r,i){this.values[n]=t,i._hasValue||(i._hasValue=!0,this.haveValues++)},ForkJoinSubscriber.prototype.notifyComplete=function(e){var t=this.destination,n=this,r=n.haveValues,i=n.resultSelector,o=n.values,s=o.length;if(!e._hasValue)return void t.complete();if(this.completed++,this.completed===s){if(r===s){var a=i?i.apply(this,o):o;t.next(a)}t.complete()}},ForkJoinSubscriber}(l.OuterSubscriber)},function(e,t,n){"use strict";function isNodeStyleEventEmmitter(e){return!!e&&"function"==typeof e.addListener&&"function"==typeof e.removeListener}function isJQueryStyleEventEmitter(e){return!!e&&"function"==typeof e.on&&"function"==typeof e.off}function isNodeList(e){return!!e&&"[object NodeList]"===e.toString()}function isHTMLCollection(e){return!!e&&"[object HTMLCollection]"===e.toString()}function isEventTarget(e){return!!e&&"function"==typeof e.addEventListener&&"function"==typeof e.removeEventListener}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(1),o=n(42),s=n(38),a=n(41),l=function(e){function FromEventObservable(t,n,r){e.call(this),this.sourceObj=t,this.eventName=n,this.selector=r}return r(FromEventObservable,e),FromEventObservable.create=function(e,t,n){return new FromEventObservable(e,t,n)},FromEventObservable.setupSubscription=function(e,t,n,r){var i;if(isNodeList(e)||isHTMLCollection(e))for(var o=0,s=e.length;o<s;o++)FromEventObservable.setupSubscription(e[o],t,n,r);else isEventTarget(e)?(e.addEventListener(t,n),i=function(){return e.removeEventListener(t,n)}):isJQueryStyleEventEmitter(e)?(e.on(t,n),i=function(){return e.off(t,n)}):isNodeStyleEventEmmitter(e)&&(e.addListener(t,n),i=function(){return e.removeListener(t,n)});r.add(new a.Subscription(i))},FromEventObservable.prototype._subscribe=function(e){var t=this.sourceObj,n=this.eventName,r=this.selector,i=r?function(){for(var t=[],n=0;n<arguments.length;n++)t[n-0]=arguments[n];var i=o.tryCatch(r).apply(void 0,t);i===s.errorObject?e.error(s.errorObject.e):e.next(i)}:function(t){return e.next(t)};FromEventObservable.setupSubscription(t,n,i,e)},FromEventObservable}(i.Observable);t.FromEventObservable=l},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(1),o=n(41),s=n(42),a=n(38),l=function(e){function FromEventPatternObservable(t,n,r){e.call(this),this.addHandler=t,this.removeHandler=n,this.selector=r}return r(FromEventPatternObservable,e),FromEventPatternObservable.create=function(e,t,n){return new FromEventPatternObservable(e,t,n)},FromEventPatternObservable.prototype._subscribe=function(e){var t=this.addHandler,n=this.removeHandler,r=this.selector,i=r?function(t){var n=s.tryCatch(r).apply(null,arguments);n===a.errorObject?e.error(n.e):e.next(n)}:function(t){e.next(t)},l=s.tryCatch(t)(i);l===a.errorObject&&e.error(l.e),e.add(new o.Subscription(function(){n(i)}))},FromEventPatternObservable}(i.Observable);t.FromEventPatternObservable=l},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(81),o=n(217),s=n(515),a=n(98),l=n(210),c=n(991),u=n(79),p=n(981),d=n(214),h=n(142),f=n(1),m=n(312),g=function(e){return e&&"number"==typeof e.length},y=function(e){function FromObservable(t,n){e.call(this,null),this.ish=t,this.scheduler=n}return r(FromObservable,e),FromObservable.create=function(e,t,n,r){var m=null,y=null;if(o.isFunction(t)?(m=r||null,y=t):a.isScheduler(m)&&(m=t),null!=e){if("function"==typeof e[d.$$observable])return e instanceof f.Observable&&!m?e:new FromObservable(e,m);if(i.isArray(e))return new u.ArrayObservable(e,m);if(s.isPromise(e))return new l.PromiseObservable(e,m);if("function"==typeof e[h.$$iterator]||"string"==typeof e)return new c.IteratorObservable(e,null,null,m);if(g(e))return new p.ArrayLikeObservable(e,y,n,m)}throw new TypeError((null!==e&&typeof e||e)+" is not observable")},FromObservable.prototype._subscribe=function(e){var t=this.ish,n=this.scheduler;return null==n?t[d.$$observable]().subscribe(e):t[d.$$observable]().subscribe(new m.ObserveOnSubscriber(e,n,0))},FromObservable}(f.Observable);t.FromObservable=y},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(318),o=n(1),s=n(45),a=function(e){function IntervalObservable(t,n){void 0===t&&(t=0),void 0===n&&(n=s.async),e.call(this),this.period=t,this.scheduler=n,(!i.isNumeric(t)||t<0)&&(this.period=0),n&&"function"==typeof n.schedule||(this.scheduler=s.async)}return r(IntervalObservable,e),IntervalObservable.create=function(e,t){return void 0===e&&(e=0),void 0===t&&(t=s.async),new IntervalObservable(e,t)},IntervalObservable.dispatch=function(e){var t=e.index,n=e.subscriber,r=e.period;n.next(t),n.isUnsubscribed||(e.index+=1,this.schedule(e,r))},IntervalObservable.prototype._subscribe=function(e){var t=0,n=this.period,r=this.scheduler;e.add(r.schedule(IntervalObservable.dispatch,n,{index:t,subscriber:e,period:n}))},IntervalObservable}(o.Observable);t.IntervalObservable=a},function(e,t,n){"use strict";function getIterator(e){var t=e[c.$$iterator];if(!t&&"string"==typeof e)return new d(e);if(!t&&void 0!==e.length)return new h(e);if(!t)throw new TypeError("Object is not iterable");return e[c.$$iterator]()}function toLength(e){var t=+e.length;return isNaN(t)?0:0!==t&&numberIsFinite(t)?(t=sign(t)*Math.floor(Math.abs(t)),t<=0?0:t>f?f:t):t}function numberIsFinite(e){return"number"==typeof e&&i.root.isFinite(e)}function sign(e){var t=+e;return 0===t?t:isNaN(t)?t:t<0?-1:1}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(51),o=n(514),s=n(42),a=n(1),l=n(217),c=n(142),u=n(38),p=function(e){function IteratorObservable(t,n,r,i){if(e.call(this),null==t)throw new Error("iterator cannot be null.");if(o.isObject(n))this.thisArg=n,this.scheduler=r;else if(l.isFunction(n))this.project=n,this.thisArg=r,this.scheduler=i;else if(null!=n)throw new Error("When provided, `project` must be a function.");this.iterator=getIterator(t)}return r(IteratorObservable,e),IteratorObservable.create=function(e,t,n,r){return new IteratorObservable(e,t,n,r)},IteratorObservable.dispatch=function(e){var t=e.index,n=e.hasError,r=e.thisArg,i=e.project,o=e.iterator,a=e.subscriber;if(n)return void a.error(e.error);var l=o.next();return l.done?void a.complete():(i?(l=s.tryCatch(i).call(r,l.value,t),l===u.errorObject?(e.error=u.errorObject.e,e.hasError=!0):(a.next(l),e.index=t+1)):(a.next(l.value),e.index=t+1),void(a.isUnsubscribed||this.schedule(e)))},IteratorObservable.prototype._subscribe=function(e){var t=0,n=this,r=n.iterator,i=n.project,o=n.thisArg,a=n.scheduler;if(a)return a.schedule(IteratorObservable.dispatch,0,{index:t,thisArg:o,project:i,iterator:r,subscriber:e});for(;;){var l=r.next();if(l.done){e.complete();break}if(i){if(l=s.tryCatch(i).call(o,l.value,t++),l===u.errorObject){e.error(u.errorObject.e);break}e.next(l)}else e.next(l.value);if(e.isUnsubscribed)break}},IteratorObservable}(a.Observable);t.IteratorObservable=p;var d=function(){function StringIterator(e,t,n){void 0===t&&(t=0),void 0===n&&(n=e.length),this.str=e,this.idx=t,this.len=n}return StringIterator.prototype[c.$$iterator]=function(){return this},StringIterator.prototype.next=function(){return this.idx<this.len?{done:!1,value:this.str.charAt(this.idx++)}:{done:!0,value:void 0}},StringIterator}(),h=function(){function ArrayIterator(e,t,n){void 0===t&&(t=0),void 0===n&&(n=toLength(e)),this.arr=e,this.idx=t,this.len=n}return ArrayIterator.prototype[c.$$iterator]=function(){return this},ArrayIterator.prototype.next=function(){return this.idx<this.len?{done:!1,value:this.arr[this.idx++]}:{done:!0,value:void 0}},ArrayIterator}(),f=Math.pow(2,53)-1},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(1),o=n(516),s=function(e){function NeverObservable(){e.call(this)}return r(NeverObservable,e),NeverObservable.create=function(){return new NeverObservable},NeverObservable.prototype._subscribe=function(e){o.noop()},NeverObservable}(i.Observable);t.NeverObservable=s},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(1),o=function(e){function RangeObservable(t,n,r){e.call(this),this.start=t,this._count=n,this.scheduler=r}return r(RangeObservable,e),RangeObservable.create=function(e,t,n){return void 0===e&&(e=0),void 0===t&&(t=0),new RangeObservable(e,t,n)},RangeObservable.dispatch=function(e){var t=e.start,n=e.index,r=e.count,i=e.subscriber;return n>=r?void i.complete():(i.next(t),void(i.isUnsubscribed||(e.index=n+1,e.start=t+1,this.schedule(e))))},RangeObservable.prototype._subscribe=function(e){var t=0,n=this.start,r=this._count,i=this.scheduler;if(i)return i.schedule(RangeObservable.dispatch,0,{index:t,count:r,start:n,subscriber:e});for(;;){if(t++>=r){e.complete();break}if(e.next(n++),e.isUnsubscribed)break}},RangeObservable}(i.Observable);t.RangeObservable=o},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(1),o=n(511),s=n(318),a=function(e){function SubscribeOnObservable(t,n,r){void 0===n&&(n=0),void 0===r&&(r=o.asap),e.call(this),this.source=t,this.delayTime=n,this.scheduler=r,(!s.isNumeric(n)||n<0)&&(this.delayTime=0),r&&"function"==typeof r.schedule||(this.scheduler=o.asap)}return r(SubscribeOnObservable,e),SubscribeOnObservable.create=function(e,t,n){return void 0===t&&(t=0),void 0===n&&(n=o.asap),new SubscribeOnObservable(e,t,n)},SubscribeOnObservable.dispatch=function(e){var t=e.source,n=e.subscriber;return t.subscribe(n)},SubscribeOnObservable.prototype._subscribe=function(e){var t=this.delayTime,n=this.source,r=this.scheduler;return r.schedule(SubscribeOnObservable.dispatch,t,{source:n,subscriber:e})},SubscribeOnObservable}(i.Observable);t.SubscribeOnObservable=a},function(e,t,n){"use strict";var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(318),o=n(1),s=n(45),a=n(98),l=n(216),c=function(e){function TimerObservable(t,n,r){void 0===t&&(t=0),e.call(this),this.period=-1,this.dueTime=0,i.isNumeric(n)?this.period=Number(n)<1&&1||Number(n):a.isScheduler(n)&&(r=n),a.isScheduler(r)||(r=s.async),this.scheduler=r,this.dueTime=l.isDate(t)?+t-this.scheduler.now():t}return r(TimerObservable,e),TimerObservable.create=function(e,t,n){return void 0===e&&(e=0),new TimerObservable(e,t,n)},TimerObservable.dispatch=function(e){var t=e.index,n=e.period,r=e.subscriber,i=this;if(r.next(t),!r.isUnsubscribed){if(n===-1)return r.complete();e.index=t+1,i.schedule(e,n)}},TimerObservable.prototype._subscribe=function(e){var t=0,n=this,r=n.period,i=n.dueTime,o=n.scheduler;return o.schedule(TimerObservable.dispatch,i,{index:t,period:r,subscriber:e})},TimerObservable}(o.Observable);t.TimerObservable=c},function(e,t,n){"use strict";var r=n(982);t.bindCallback=r.BoundCallbackObservable.create},function(e,t,n){"use strict";var r=n(983);t.bindNodeCallback=r.BoundNodeCallbackObservable.create},function(e,t,n){"use strict";var r=n(311);t.concat=r.concatStatic},function(e,t,n){"use strict";var r=n(984);t.defer=r.DeferObservable.create},function(e,t,n){"use strict";var r=n(80);t.empty=r.EmptyObservable.create},function(e,t,n){"use strict";var r=n(987);t.fromEvent=r.FromEventObservable.create},function(e,t,n){"use strict";var r=n(988);t.fromEventPattern=r.FromEventPatternObservable.create},function(e,t,n){"use strict";var r=n(990);t.interval=r.IntervalObservable.create},function(e,t,n){"use strict";var r=n(506);t.merge=r.mergeStatic},function(e,t,n){"use strict";var r=n(992);t.never=r.NeverObservable.create},function(e,t,n){"use strict";var r=n(993);t.range=r.RangeObservable.create},function(e,t,n){"use strict";var r=n(985);t._throw=r.ErrorObservable.create},function(e,t,n){"use strict";var r=n(995);t.timer=r.TimerObservable.create},function(e,t,n){"use strict";var r=n(314);t.zip=r.zipStatic},function(e,t,n){"use strict";function audit(e){return this.lift(new l(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(42),o=n(38),s=n(11),a=n(12);t.audit=audit;var l=function(){function AuditOperator(e){this.durationSelector=e}return AuditOperator.prototype.call=function(e,t){return t._subscribe(new c(e,this.durationSelector))},AuditOperator}(),c=function(e){function AuditSubscriber(t,n){e.call(this,t),this.durationSelector=n,this.hasValue=!1}return r(AuditSubscriber,e),AuditSubscriber.prototype._next=function(e){if(this.value=e,this.hasValue=!0,!this.throttled){var t=i.tryCatch(this.durationSelector)(e);t===o.errorObject?this.destination.error(o.errorObject.e):this.add(this.throttled=a.subscribeToResult(this,t))}},AuditSubscriber.prototype.clearThrottle=function(){var e=this,t=e.value,n=e.hasValue,r=e.throttled;r&&(this.remove(r),this.throttled=null,r.unsubscribe()),n&&(this.value=null,this.hasValue=!1,this.destination.next(t))},AuditSubscriber.prototype.notifyNext=function(e,t,n,r){this.clearThrottle()},AuditSubscriber.prototype.notifyComplete=function(){
 this.clearThrottle()},AuditSubscriber}(s.OuterSubscriber)},function(e,t,n){"use strict";function auditTime(e,t){return void 0===t&&(t=i.async),this.lift(new s(e,t))}function dispatchNext(e){e.clearThrottle()}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(45),o=n(6);t.auditTime=auditTime;var s=function(){function AuditTimeOperator(e,t){this.delay=e,this.scheduler=t}return AuditTimeOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.delay,this.scheduler))},AuditTimeOperator}(),a=function(e){function AuditTimeSubscriber(t,n,r){e.call(this,t),this.delay=n,this.scheduler=r,this.hasValue=!1}return r(AuditTimeSubscriber,e),AuditTimeSubscriber.prototype._next=function(e){this.value=e,this.hasValue=!0,this.throttled||this.add(this.throttled=this.scheduler.schedule(dispatchNext,this.delay,this))},AuditTimeSubscriber.prototype.clearThrottle=function(){var e=this,t=e.value,n=e.hasValue,r=e.throttled;r&&(this.remove(r),this.throttled=null,r.unsubscribe()),n&&(this.value=null,this.hasValue=!1,this.destination.next(t))},AuditTimeSubscriber}(o.Subscriber)},function(e,t,n){"use strict";function buffer(e){return this.lift(new s(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(11),o=n(12);t.buffer=buffer;var s=function(){function BufferOperator(e){this.closingNotifier=e}return BufferOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.closingNotifier))},BufferOperator}(),a=function(e){function BufferSubscriber(t,n){e.call(this,t),this.buffer=[],this.add(o.subscribeToResult(this,n))}return r(BufferSubscriber,e),BufferSubscriber.prototype._next=function(e){this.buffer.push(e)},BufferSubscriber.prototype.notifyNext=function(e,t,n,r,i){var o=this.buffer;this.buffer=[],this.destination.next(o)},BufferSubscriber}(i.OuterSubscriber)},function(e,t,n){"use strict";function bufferCount(e,t){return void 0===t&&(t=null),this.lift(new o(e,t))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.bufferCount=bufferCount;var o=function(){function BufferCountOperator(e,t){this.bufferSize=e,this.startBufferEvery=t}return BufferCountOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.bufferSize,this.startBufferEvery))},BufferCountOperator}(),s=function(e){function BufferCountSubscriber(t,n,r){e.call(this,t),this.bufferSize=n,this.startBufferEvery=r,this.buffers=[[]],this.count=0}return r(BufferCountSubscriber,e),BufferCountSubscriber.prototype._next=function(e){var t=this.count+=1,n=this.destination,r=this.bufferSize,i=null==this.startBufferEvery?r:this.startBufferEvery,o=this.buffers,s=o.length,a=-1;t%i===0&&o.push([]);for(var l=0;l<s;l++){var c=o[l];c.push(e),c.length===r&&(a=l,n.next(c))}a!==-1&&o.splice(a,1)},BufferCountSubscriber.prototype._complete=function(){for(var t=this.destination,n=this.buffers;n.length>0;){var r=n.shift();r.length>0&&t.next(r)}e.prototype._complete.call(this)},BufferCountSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function bufferTime(e,t,n){return void 0===t&&(t=null),void 0===n&&(n=o.async),this.lift(new s(e,t,n))}function dispatchBufferTimeSpanOnly(e){var t=e.subscriber,n=e.buffer;n&&t.closeBuffer(n),e.buffer=t.openBuffer(),t.isUnsubscribed||this.schedule(e,e.bufferTimeSpan)}function dispatchBufferCreation(e){var t=e.bufferCreationInterval,n=e.bufferTimeSpan,r=e.subscriber,i=e.scheduler,o=r.openBuffer(),s=this;r.isUnsubscribed||(s.add(i.schedule(dispatchBufferClose,n,{subscriber:r,buffer:o})),s.schedule(e,t))}function dispatchBufferClose(e){var t=e.subscriber,n=e.buffer;t.closeBuffer(n)}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(45);t.bufferTime=bufferTime;var s=function(){function BufferTimeOperator(e,t,n){this.bufferTimeSpan=e,this.bufferCreationInterval=t,this.scheduler=n}return BufferTimeOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.bufferTimeSpan,this.bufferCreationInterval,this.scheduler))},BufferTimeOperator}(),a=function(e){function BufferTimeSubscriber(t,n,r,i){e.call(this,t),this.bufferTimeSpan=n,this.bufferCreationInterval=r,this.scheduler=i,this.buffers=[];var o=this.openBuffer();if(null!==r&&r>=0){var s={subscriber:this,buffer:o},a={bufferTimeSpan:n,bufferCreationInterval:r,subscriber:this,scheduler:i};this.add(i.schedule(dispatchBufferClose,n,s)),this.add(i.schedule(dispatchBufferCreation,r,a))}else{var l={subscriber:this,buffer:o,bufferTimeSpan:n};this.add(i.schedule(dispatchBufferTimeSpanOnly,n,l))}}return r(BufferTimeSubscriber,e),BufferTimeSubscriber.prototype._next=function(e){for(var t=this.buffers,n=t.length,r=0;r<n;r++)t[r].push(e)},BufferTimeSubscriber.prototype._error=function(t){this.buffers.length=0,e.prototype._error.call(this,t)},BufferTimeSubscriber.prototype._complete=function(){for(var t=this,n=t.buffers,r=t.destination;n.length>0;)r.next(n.shift());e.prototype._complete.call(this)},BufferTimeSubscriber.prototype._unsubscribe=function(){this.buffers=null},BufferTimeSubscriber.prototype.openBuffer=function(){var e=[];return this.buffers.push(e),e},BufferTimeSubscriber.prototype.closeBuffer=function(e){this.destination.next(e);var t=this.buffers;t.splice(t.indexOf(e),1)},BufferTimeSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function bufferToggle(e,t){return this.lift(new a(e,t))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(41),o=n(12),s=n(11);t.bufferToggle=bufferToggle;var a=function(){function BufferToggleOperator(e,t){this.openings=e,this.closingSelector=t}return BufferToggleOperator.prototype.call=function(e,t){return t._subscribe(new l(e,this.openings,this.closingSelector))},BufferToggleOperator}(),l=function(e){function BufferToggleSubscriber(t,n,r){e.call(this,t),this.openings=n,this.closingSelector=r,this.contexts=[],this.add(o.subscribeToResult(this,n))}return r(BufferToggleSubscriber,e),BufferToggleSubscriber.prototype._next=function(e){for(var t=this.contexts,n=t.length,r=0;r<n;r++)t[r].buffer.push(e)},BufferToggleSubscriber.prototype._error=function(t){for(var n=this.contexts;n.length>0;){var r=n.shift();r.subscription.unsubscribe(),r.buffer=null,r.subscription=null}this.contexts=null,e.prototype._error.call(this,t)},BufferToggleSubscriber.prototype._complete=function(){for(var t=this.contexts;t.length>0;){var n=t.shift();this.destination.next(n.buffer),n.subscription.unsubscribe(),n.buffer=null,n.subscription=null}this.contexts=null,e.prototype._complete.call(this)},BufferToggleSubscriber.prototype.notifyNext=function(e,t,n,r,i){e?this.closeBuffer(e):this.openBuffer(t)},BufferToggleSubscriber.prototype.notifyComplete=function(e){this.closeBuffer(e.context)},BufferToggleSubscriber.prototype.openBuffer=function(e){try{var t=this.closingSelector,n=t.call(this,e);n&&this.trySubscribe(n)}catch(r){this._error(r)}},BufferToggleSubscriber.prototype.closeBuffer=function(e){var t=this.contexts;if(t&&e){var n=e.buffer,r=e.subscription;this.destination.next(n),t.splice(t.indexOf(e),1),this.remove(r),r.unsubscribe()}},BufferToggleSubscriber.prototype.trySubscribe=function(e){var t=this.contexts,n=[],r=new i.Subscription,s={buffer:n,subscription:r};t.push(s);var a=o.subscribeToResult(this,e,s);!a||a.isUnsubscribed?this.closeBuffer(s):(a.context=s,this.add(a),r.add(a))},BufferToggleSubscriber}(s.OuterSubscriber)},function(e,t,n){"use strict";function bufferWhen(e){return this.lift(new c(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(41),o=n(42),s=n(38),a=n(11),l=n(12);t.bufferWhen=bufferWhen;var c=function(){function BufferWhenOperator(e){this.closingSelector=e}return BufferWhenOperator.prototype.call=function(e,t){return t._subscribe(new u(e,this.closingSelector))},BufferWhenOperator}(),u=function(e){function BufferWhenSubscriber(t,n){e.call(this,t),this.closingSelector=n,this.subscribing=!1,this.openBuffer()}return r(BufferWhenSubscriber,e),BufferWhenSubscriber.prototype._next=function(e){this.buffer.push(e)},BufferWhenSubscriber.prototype._complete=function(){var t=this.buffer;t&&this.destination.next(t),e.prototype._complete.call(this)},BufferWhenSubscriber.prototype._unsubscribe=function(){this.buffer=null,this.subscribing=!1},BufferWhenSubscriber.prototype.notifyNext=function(e,t,n,r,i){this.openBuffer()},BufferWhenSubscriber.prototype.notifyComplete=function(){this.subscribing?this.complete():this.openBuffer()},BufferWhenSubscriber.prototype.openBuffer=function(){var e=this.closingSubscription;e&&(this.remove(e),e.unsubscribe());var t=this.buffer;this.buffer&&this.destination.next(t),this.buffer=[];var n=o.tryCatch(this.closingSelector)();n===s.errorObject?this.error(s.errorObject.e):(e=new i.Subscription,this.closingSubscription=e,this.add(e),this.subscribing=!0,e.add(l.subscribeToResult(this,n)),this.subscribing=!1)},BufferWhenSubscriber}(a.OuterSubscriber)},function(e,t,n){"use strict";function cache(e,t,n){return void 0===e&&(e=Number.POSITIVE_INFINITY),void 0===t&&(t=Number.POSITIVE_INFINITY),r.publishReplay.call(this,e,t,n).refCount()}var r=n(509);t.cache=cache},function(e,t,n){"use strict";function _catch(e){var t=new o(e),n=this.lift(t);return t.caught=n}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t._catch=_catch;var o=function(){function CatchOperator(e){this.selector=e}return CatchOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.selector,this.caught))},CatchOperator}(),s=function(e){function CatchSubscriber(t,n,r){e.call(this,t),this.selector=n,this.caught=r}return r(CatchSubscriber,e),CatchSubscriber.prototype.error=function(e){if(!this.isStopped){var t=void 0;try{t=this.selector(e,this.caught)}catch(e){return void this.destination.error(e)}this._innerSub(t)}},CatchSubscriber.prototype._innerSub=function(e){this.unsubscribe(),this.destination.remove(this),e.subscribe(this.destination)},CatchSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function combineAll(e){return this.lift(new r.CombineLatestOperator(e))}var r=n(310);t.combineAll=combineAll},function(e,t,n){"use strict";function concatAll(){return this.lift(new r.MergeAllOperator(1))}var r=n(212);t.concatAll=concatAll},function(e,t,n){"use strict";function concatMap(e,t){return this.lift(new r.MergeMapOperator(e,t,1))}var r=n(507);t.concatMap=concatMap},function(e,t,n){"use strict";function concatMapTo(e,t){return this.lift(new r.MergeMapToOperator(e,t,1))}var r=n(508);t.concatMapTo=concatMapTo},function(e,t,n){"use strict";function count(e){return this.lift(new o(e,this))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.count=count;var o=function(){function CountOperator(e,t){this.predicate=e,this.source=t}return CountOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.predicate,this.source))},CountOperator}(),s=function(e){function CountSubscriber(t,n,r){e.call(this,t),this.predicate=n,this.source=r,this.count=0,this.index=0}return r(CountSubscriber,e),CountSubscriber.prototype._next=function(e){this.predicate?this._tryPredicate(e):this.count++},CountSubscriber.prototype._tryPredicate=function(e){var t;try{t=this.predicate(e,this.index++,this.source)}catch(n){return void this.destination.error(n)}t&&this.count++},CountSubscriber.prototype._complete=function(){this.destination.next(this.count),this.destination.complete()},CountSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function debounce(e){return this.lift(new s(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(11),o=n(12);t.debounce=debounce;var s=function(){function DebounceOperator(e){this.durationSelector=e}return DebounceOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.durationSelector))},DebounceOperator}(),a=function(e){function DebounceSubscriber(t,n){e.call(this,t),this.durationSelector=n,this.hasValue=!1,this.durationSubscription=null}return r(DebounceSubscriber,e),DebounceSubscriber.prototype._next=function(e){try{var t=this.durationSelector.call(this,e);t&&this._tryNext(e,t)}catch(n){this.destination.error(n)}},DebounceSubscriber.prototype._complete=function(){this.emitValue(),this.destination.complete()},DebounceSubscriber.prototype._tryNext=function(e,t){var n=this.durationSubscription;this.value=e,this.hasValue=!0,n&&(n.unsubscribe(),this.remove(n)),n=o.subscribeToResult(this,t),n.isUnsubscribed||this.add(this.durationSubscription=n)},DebounceSubscriber.prototype.notifyNext=function(e,t,n,r,i){this.emitValue()},DebounceSubscriber.prototype.notifyComplete=function(){this.emitValue()},DebounceSubscriber.prototype.emitValue=function(){if(this.hasValue){var t=this.value,n=this.durationSubscription;n&&(this.durationSubscription=null,n.unsubscribe(),this.remove(n)),this.value=null,this.hasValue=!1,e.prototype._next.call(this,t)}},DebounceSubscriber}(i.OuterSubscriber)},function(e,t,n){"use strict";function debounceTime(e,t){return void 0===t&&(t=o.async),this.lift(new s(e,t))}function dispatchNext(e){e.debouncedNext()}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(45);t.debounceTime=debounceTime;var s=function(){function DebounceTimeOperator(e,t){this.dueTime=e,this.scheduler=t}return DebounceTimeOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.dueTime,this.scheduler))},DebounceTimeOperator}(),a=function(e){function DebounceTimeSubscriber(t,n,r){e.call(this,t),this.dueTime=n,this.scheduler=r,this.debouncedSubscription=null,this.lastValue=null,this.hasValue=!1}return r(DebounceTimeSubscriber,e),DebounceTimeSubscriber.prototype._next=function(e){this.clearDebounce(),this.lastValue=e,this.hasValue=!0,this.add(this.debouncedSubscription=this.scheduler.schedule(dispatchNext,this.dueTime,this))},DebounceTimeSubscriber.prototype._complete=function(){this.debouncedNext(),this.destination.complete()},DebounceTimeSubscriber.prototype.debouncedNext=function(){this.clearDebounce(),this.hasValue&&(this.destination.next(this.lastValue),this.lastValue=null,this.hasValue=!1)},DebounceTimeSubscriber.prototype.clearDebounce=function(){var e=this.debouncedSubscription;null!==e&&(this.remove(e),e.unsubscribe(),this.debouncedSubscription=null)},DebounceTimeSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function defaultIfEmpty(e){return void 0===e&&(e=null),this.lift(new o(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.defaultIfEmpty=defaultIfEmpty;var o=function(){function DefaultIfEmptyOperator(e){this.defaultValue=e}return DefaultIfEmptyOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.defaultValue))},DefaultIfEmptyOperator}(),s=function(e){function DefaultIfEmptySubscriber(t,n){e.call(this,t),this.defaultValue=n,this.isEmpty=!0}return r(DefaultIfEmptySubscriber,e),DefaultIfEmptySubscriber.prototype._next=function(e){this.isEmpty=!1,this.destination.next(e)},DefaultIfEmptySubscriber.prototype._complete=function(){this.isEmpty&&this.destination.next(this.defaultValue),this.destination.complete()},DefaultIfEmptySubscriber}(i.Subscriber)},function(e,t,n){"use strict";function delay(e,t){void 0===t&&(t=i.async);var n=o.isDate(e),r=n?+e-t.now():Math.abs(e);return this.lift(new l(r,t))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(45),o=n(216),s=n(6),a=n(208);t.delay=delay;var l=function(){function DelayOperator(e,t){this.delay=e,this.scheduler=t}return DelayOperator.prototype.call=function(e,t){return t._subscribe(new c(e,this.delay,this.scheduler))},DelayOperator}(),c=function(e){function DelaySubscriber(t,n,r){e.call(this,t),this.delay=n,this.scheduler=r,this.queue=[],this.active=!1,this.errored=!1}return r(DelaySubscriber,e),DelaySubscriber.dispatch=function(e){for(var t=e.source,n=t.queue,r=e.scheduler,i=e.destination;n.length>0&&n[0].time-r.now()<=0;)n.shift().notification.observe(i);if(n.length>0){var o=Math.max(0,n[0].time-r.now());this.schedule(e,o)}else t.active=!1},DelaySubscriber.prototype._schedule=function(e){this.active=!0,this.add(e.schedule(DelaySubscriber.dispatch,this.delay,{source:this,destination:this.destination,scheduler:e}))},DelaySubscriber.prototype.scheduleNotification=function(e){if(this.errored!==!0){var t=this.scheduler,n=new u(t.now()+this.delay,e);this.queue.push(n),this.active===!1&&this._schedule(t)}},DelaySubscriber.prototype._next=function(e){this.scheduleNotification(a.Notification.createNext(e))},DelaySubscriber.prototype._error=function(e){this.errored=!0,this.queue=[],this.destination.error(e)},DelaySubscriber.prototype._complete=function(){this.scheduleNotification(a.Notification.createComplete())},DelaySubscriber}(s.Subscriber),u=function(){function DelayMessage(e,t){this.time=e,this.notification=t}return DelayMessage}()},function(e,t,n){"use strict";function delayWhen(e,t){return t?new u(this,t).lift(new l(e)):this.lift(new l(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(1),s=n(11),a=n(12);t.delayWhen=delayWhen;var l=function(){function DelayWhenOperator(e){this.delayDurationSelector=e}return DelayWhenOperator.prototype.call=function(e,t){return t._subscribe(new c(e,this.delayDurationSelector))},DelayWhenOperator}(),c=function(e){function DelayWhenSubscriber(t,n){e.call(this,t),this.delayDurationSelector=n,this.completed=!1,this.delayNotifierSubscriptions=[],this.values=[]}return r(DelayWhenSubscriber,e),DelayWhenSubscriber.prototype.notifyNext=function(e,t,n,r,i){this.destination.next(e),this.removeSubscription(i),this.tryComplete()},DelayWhenSubscriber.prototype.notifyError=function(e,t){this._error(e)},DelayWhenSubscriber.prototype.notifyComplete=function(e){var t=this.removeSubscription(e);t&&this.destination.next(t),this.tryComplete()},DelayWhenSubscriber.prototype._next=function(e){try{var t=this.delayDurationSelector(e);t&&this.tryDelay(t,e)}catch(n){this.destination.error(n)}},DelayWhenSubscriber.prototype._complete=function(){this.completed=!0,this.tryComplete()},DelayWhenSubscriber.prototype.removeSubscription=function(e){e.unsubscribe();var t=this.delayNotifierSubscriptions.indexOf(e),n=null;return t!==-1&&(n=this.values[t],this.delayNotifierSubscriptions.splice(t,1),this.values.splice(t,1)),n},DelayWhenSubscriber.prototype.tryDelay=function(e,t){var n=a.subscribeToResult(this,e,t);this.add(n),this.delayNotifierSubscriptions.push(n),this.values.push(t)},DelayWhenSubscriber.prototype.tryComplete=function(){this.completed&&0===this.delayNotifierSubscriptions.length&&this.destination.complete()},DelayWhenSubscriber}(s.OuterSubscriber),u=function(e){function SubscriptionDelayObservable(t,n){e.call(this),this.source=t,this.subscriptionDelay=n}return r(SubscriptionDelayObservable,e),SubscriptionDelayObservable.prototype._subscribe=function(e){this.subscriptionDelay.subscribe(new p(e,this.source))},SubscriptionDelayObservable}(o.Observable),p=function(e){function SubscriptionDelaySubscriber(t,n){e.call(this),this.parent=t,this.source=n,this.sourceSubscribed=!1}return r(SubscriptionDelaySubscriber,e),SubscriptionDelaySubscriber.prototype._next=function(e){this.subscribeToSource()},SubscriptionDelaySubscriber.prototype._error=function(e){this.unsubscribe(),this.parent.error(e)},SubscriptionDelaySubscriber.prototype._complete=function(){this.subscribeToSource()},SubscriptionDelaySubscriber.prototype.subscribeToSource=function(){this.sourceSubscribed||(this.sourceSubscribed=!0,this.unsubscribe(),this.source.subscribe(this.parent))},SubscriptionDelaySubscriber}(i.Subscriber)},function(e,t,n){"use strict";function dematerialize(){return this.lift(new o)}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.dematerialize=dematerialize;var o=function(){function DeMaterializeOperator(){}return DeMaterializeOperator.prototype.call=function(e,t){return t._subscribe(new s(e))},DeMaterializeOperator}(),s=function(e){function DeMaterializeSubscriber(t){e.call(this,t)}return r(DeMaterializeSubscriber,e),DeMaterializeSubscriber.prototype._next=function(e){e.observe(this.destination)},DeMaterializeSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function distinctUntilChanged(e,t){return this.lift(new a(e,t))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(42),s=n(38);t.distinctUntilChanged=distinctUntilChanged;var a=function(){function DistinctUntilChangedOperator(e,t){this.compare=e,this.keySelector=t}return DistinctUntilChangedOperator.prototype.call=function(e,t){return t._subscribe(new l(e,this.compare,this.keySelector))},DistinctUntilChangedOperator}(),l=function(e){function DistinctUntilChangedSubscriber(t,n,r){e.call(this,t),this.keySelector=r,this.hasKey=!1,"function"==typeof n&&(this.compare=n)}return r(DistinctUntilChangedSubscriber,e),DistinctUntilChangedSubscriber.prototype.compare=function(e,t){return e===t},DistinctUntilChangedSubscriber.prototype._next=function(e){var t=this.keySelector,n=e;if(t&&(n=o.tryCatch(this.keySelector)(e),n===s.errorObject))return this.destination.error(s.errorObject.e);var r=!1;if(this.hasKey){if(r=o.tryCatch(this.compare)(this.key,n),r===s.errorObject)return this.destination.error(s.errorObject.e)}else this.hasKey=!0;Boolean(r)===!1&&(this.key=n,this.destination.next(e))},DistinctUntilChangedSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function _do(e,t,n){return this.lift(new o(e,t,n))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t._do=_do;var o=function(){function DoOperator(e,t,n){this.nextOrObserver=e,this.error=t,this.complete=n}return DoOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.nextOrObserver,this.error,this.complete))},DoOperator}(),s=function(e){function DoSubscriber(t,n,r,o){e.call(this,t);var s=new i.Subscriber(n,r,o);s.syncErrorThrowable=!0,this.add(s),this.safeSubscriber=s}return r(DoSubscriber,e),DoSubscriber.prototype._next=function(e){var t=this.safeSubscriber;t.next(e),t.syncErrorThrown?this.destination.error(t.syncErrorValue):this.destination.next(e)},DoSubscriber.prototype._error=function(e){var t=this.safeSubscriber;t.error(e),t.syncErrorThrown?this.destination.error(t.syncErrorValue):this.destination.error(e)},DoSubscriber.prototype._complete=function(){var e=this.safeSubscriber;e.complete(),e.syncErrorThrown?this.destination.error(e.syncErrorValue):this.destination.complete()},DoSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function every(e,t){var n=this;return n.lift(new o(e,t,n))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.every=every;var o=function(){function EveryOperator(e,t,n){this.predicate=e,this.thisArg=t,this.source=n}return EveryOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.predicate,this.thisArg,this.source))},EveryOperator}(),s=function(e){function EverySubscriber(t,n,r,i){e.call(this,t),this.predicate=n,this.thisArg=r,this.source=i,this.index=0,this.thisArg=r||this}return r(EverySubscriber,e),EverySubscriber.prototype.notifyComplete=function(e){this.destination.next(e),this.destination.complete()},EverySubscriber.prototype._next=function(e){var t=!1;try{t=this.predicate.call(this.thisArg,e,this.index++,this.source)}catch(n){return void this.destination.error(n)}t||this.notifyComplete(!1)},EverySubscriber.prototype._complete=function(){this.notifyComplete(!0)},EverySubscriber}(i.Subscriber)},function(e,t,n){"use strict";function expand(e,t,n){return void 0===t&&(t=Number.POSITIVE_INFINITY),void 0===n&&(n=void 0),t=(t||0)<1?Number.POSITIVE_INFINITY:t,this.lift(new l(e,t,n))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(42),o=n(38),s=n(11),a=n(12);t.expand=expand;var l=function(){function ExpandOperator(e,t,n){this.project=e,this.concurrent=t,this.scheduler=n}return ExpandOperator.prototype.call=function(e,t){return t._subscribe(new c(e,this.project,this.concurrent,this.scheduler))},ExpandOperator}();t.ExpandOperator=l;var c=function(e){function ExpandSubscriber(t,n,r,i){e.call(this,t),this.project=n,this.concurrent=r,this.scheduler=i,this.index=0,this.active=0,this.hasCompleted=!1,r<Number.POSITIVE_INFINITY&&(this.buffer=[])}return r(ExpandSubscriber,e),ExpandSubscriber.dispatch=function(e){var t=e.subscriber,n=e.result,r=e.value,i=e.index;t.subscribeToProjection(n,r,i)},ExpandSubscriber.prototype._next=function(e){var t=this.destination;if(t.isUnsubscribed)return void this._complete();var n=this.index++;if(this.active<this.concurrent){t.next(e);var r=i.tryCatch(this.project)(e,n);if(r===o.errorObject)t.error(o.errorObject.e);else if(this.scheduler){var s={subscriber:this,result:r,value:e,index:n};this.add(this.scheduler.schedule(ExpandSubscriber.dispatch,0,s))}else this.subscribeToProjection(r,e,n)}else this.buffer.push(e)},ExpandSubscriber.prototype.subscribeToProjection=function(e,t,n){this.active++,this.add(a.subscribeToResult(this,e,t,n))},ExpandSubscriber.prototype._complete=function(){this.hasCompleted=!0,this.hasCompleted&&0===this.active&&this.destination.complete()},ExpandSubscriber.prototype.notifyNext=function(e,t,n,r,i){this._next(t)},ExpandSubscriber.prototype.notifyComplete=function(e){var t=this.buffer;this.remove(e),this.active--,t&&t.length>0&&this._next(t.shift()),this.hasCompleted&&0===this.active&&this.destination.complete()},ExpandSubscriber}(s.OuterSubscriber);t.ExpandSubscriber=c},function(e,t,n){"use strict";function _finally(e){return this.lift(new s(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(41);t._finally=_finally;var s=function(){function FinallyOperator(e){this.finallySelector=e}return FinallyOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.finallySelector))},FinallyOperator}(),a=function(e){function FinallySubscriber(t,n){e.call(this,t),this.add(new o.Subscription(n))}return r(FinallySubscriber,e),FinallySubscriber}(i.Subscriber)},function(e,t,n){"use strict";function first(e,t,n){return this.lift(new s(e,t,n,this))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(143);t.first=first;var s=function(){function FirstOperator(e,t,n,r){this.predicate=e,this.resultSelector=t,this.defaultValue=n,this.source=r}return FirstOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.predicate,this.resultSelector,this.defaultValue,this.source))},FirstOperator}(),a=function(e){function FirstSubscriber(t,n,r,i,o){e.call(this,t),this.predicate=n,this.resultSelector=r,this.defaultValue=i,this.source=o,this.index=0,this.hasCompleted=!1}return r(FirstSubscriber,e),FirstSubscriber.prototype._next=function(e){var t=this.index++;this.predicate?this._tryPredicate(e,t):this._emit(e,t)},FirstSubscriber.prototype._tryPredicate=function(e,t){var n;try{n=this.predicate(e,t,this.source)}catch(r){return void this.destination.error(r)}n&&this._emit(e,t)},FirstSubscriber.prototype._emit=function(e,t){return this.resultSelector?void this._tryResultSelector(e,t):void this._emitFinal(e)},FirstSubscriber.prototype._tryResultSelector=function(e,t){var n;try{n=this.resultSelector(e,t)}catch(r){return void this.destination.error(r)}this._emitFinal(n)},FirstSubscriber.prototype._emitFinal=function(e){var t=this.destination;t.next(e),t.complete(),this.hasCompleted=!0},FirstSubscriber.prototype._complete=function(){var e=this.destination;this.hasCompleted||"undefined"==typeof this.defaultValue?this.hasCompleted||e.error(new o.EmptyError):(e.next(this.defaultValue),e.complete())},FirstSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function groupBy(e,t,n){return this.lift(new u(this,e,t,n))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(41),s=n(1),a=n(20),l=n(1086),c=n(1084);t.groupBy=groupBy;var u=function(){function GroupByOperator(e,t,n,r){this.source=e,this.keySelector=t,this.elementSelector=n,this.durationSelector=r}return GroupByOperator.prototype.call=function(e,t){return t._subscribe(new p(e,this.keySelector,this.elementSelector,this.durationSelector))},GroupByOperator}(),p=function(e){function GroupBySubscriber(t,n,r,i){e.call(this),this.keySelector=n,this.elementSelector=r,this.durationSelector=i,this.groups=null,this.attemptedToUnsubscribe=!1,this.count=0,this.destination=t,this.add(t)}return r(GroupBySubscriber,e),GroupBySubscriber.prototype._next=function(e){var t;try{t=this.keySelector(e)}catch(n){return void this.error(n)}this._group(e,t)},GroupBySubscriber.prototype._group=function(e,t){var n=this.groups;n||(n=this.groups="string"==typeof t?new c.FastMap:new l.Map);var r=n.get(t);if(!r){n.set(t,r=new a.Subject);var i=new h(t,r,this);this.durationSelector&&this._selectDuration(t,r),this.destination.next(i)}this.elementSelector?this._selectElement(e,r):this.tryGroupNext(e,r)},GroupBySubscriber.prototype._selectElement=function(e,t){var n;try{n=this.elementSelector(e)}catch(r){return void this.error(r)}this.tryGroupNext(n,t)},GroupBySubscriber.prototype._selectDuration=function(e,t){var n;try{n=this.durationSelector(new h(e,t))}catch(r){return void this.error(r)}this.add(n.subscribe(new d(e,t,this)))},GroupBySubscriber.prototype.tryGroupNext=function(e,t){t.isUnsubscribed||t.next(e)},GroupBySubscriber.prototype._error=function(e){var t=this.groups;t&&(t.forEach(function(t,n){t.error(e)}),t.clear()),this.destination.error(e)},GroupBySubscriber.prototype._complete=function(){var e=this.groups;e&&(e.forEach(function(e,t){e.complete()}),e.clear()),
 this.destination.complete()},GroupBySubscriber.prototype.removeGroup=function(e){this.groups.delete(e)},GroupBySubscriber.prototype.unsubscribe=function(){this.isUnsubscribed||this.attemptedToUnsubscribe||(this.attemptedToUnsubscribe=!0,0===this.count&&e.prototype.unsubscribe.call(this))},GroupBySubscriber}(i.Subscriber),d=function(e){function GroupDurationSubscriber(t,n,r){e.call(this),this.key=t,this.group=n,this.parent=r}return r(GroupDurationSubscriber,e),GroupDurationSubscriber.prototype._next=function(e){this.tryComplete()},GroupDurationSubscriber.prototype._error=function(e){this.tryError(e)},GroupDurationSubscriber.prototype._complete=function(){this.tryComplete()},GroupDurationSubscriber.prototype.tryError=function(e){var t=this.group;t.isUnsubscribed||t.error(e),this.parent.removeGroup(this.key)},GroupDurationSubscriber.prototype.tryComplete=function(){var e=this.group;e.isUnsubscribed||e.complete(),this.parent.removeGroup(this.key)},GroupDurationSubscriber}(i.Subscriber),h=function(e){function GroupedObservable(t,n,r){e.call(this),this.key=t,this.groupSubject=n,this.refCountSubscription=r}return r(GroupedObservable,e),GroupedObservable.prototype._subscribe=function(e){var t=new o.Subscription,n=this,r=n.refCountSubscription,i=n.groupSubject;return r&&!r.isUnsubscribed&&t.add(new f(r)),t.add(i.subscribe(e)),t},GroupedObservable}(s.Observable);t.GroupedObservable=h;var f=function(e){function InnerRefCountSubscription(t){e.call(this),this.parent=t,t.count++}return r(InnerRefCountSubscription,e),InnerRefCountSubscription.prototype.unsubscribe=function(){var t=this.parent;t.isUnsubscribed||this.isUnsubscribed||(e.prototype.unsubscribe.call(this),t.count-=1,0===t.count&&t.attemptedToUnsubscribe&&t.unsubscribe())},InnerRefCountSubscription}(o.Subscription)},function(e,t,n){"use strict";function ignoreElements(){return this.lift(new s)}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(516);t.ignoreElements=ignoreElements;var s=function(){function IgnoreElementsOperator(){}return IgnoreElementsOperator.prototype.call=function(e,t){return t._subscribe(new a(e))},IgnoreElementsOperator}(),a=function(e){function IgnoreElementsSubscriber(){e.apply(this,arguments)}return r(IgnoreElementsSubscriber,e),IgnoreElementsSubscriber.prototype._next=function(e){o.noop()},IgnoreElementsSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function last(e,t,n){return this.lift(new s(e,t,n,this))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(143);t.last=last;var s=function(){function LastOperator(e,t,n,r){this.predicate=e,this.resultSelector=t,this.defaultValue=n,this.source=r}return LastOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.predicate,this.resultSelector,this.defaultValue,this.source))},LastOperator}(),a=function(e){function LastSubscriber(t,n,r,i,o){e.call(this,t),this.predicate=n,this.resultSelector=r,this.defaultValue=i,this.source=o,this.hasValue=!1,this.index=0,"undefined"!=typeof i&&(this.lastValue=i,this.hasValue=!0)}return r(LastSubscriber,e),LastSubscriber.prototype._next=function(e){var t=this.index++;if(this.predicate)this._tryPredicate(e,t);else{if(this.resultSelector)return void this._tryResultSelector(e,t);this.lastValue=e,this.hasValue=!0}},LastSubscriber.prototype._tryPredicate=function(e,t){var n;try{n=this.predicate(e,t,this.source)}catch(r){return void this.destination.error(r)}if(n){if(this.resultSelector)return void this._tryResultSelector(e,t);this.lastValue=e,this.hasValue=!0}},LastSubscriber.prototype._tryResultSelector=function(e,t){var n;try{n=this.resultSelector(e,t)}catch(r){return void this.destination.error(r)}this.lastValue=n,this.hasValue=!0},LastSubscriber.prototype._complete=function(){var e=this.destination;this.hasValue?(e.next(this.lastValue),e.complete()):e.error(new o.EmptyError)},LastSubscriber}(i.Subscriber)},function(e,t){"use strict";function letProto(e){return e(this)}t.letProto=letProto},function(e,t,n){"use strict";function mapTo(e){return this.lift(new o(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.mapTo=mapTo;var o=function(){function MapToOperator(e){this.value=e}return MapToOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.value))},MapToOperator}(),s=function(e){function MapToSubscriber(t,n){e.call(this,t),this.value=n}return r(MapToSubscriber,e),MapToSubscriber.prototype._next=function(e){this.destination.next(this.value)},MapToSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function materialize(){return this.lift(new s)}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(208);t.materialize=materialize;var s=function(){function MaterializeOperator(){}return MaterializeOperator.prototype.call=function(e,t){return t._subscribe(new a(e))},MaterializeOperator}(),a=function(e){function MaterializeSubscriber(t){e.call(this,t)}return r(MaterializeSubscriber,e),MaterializeSubscriber.prototype._next=function(e){this.destination.next(o.Notification.createNext(e))},MaterializeSubscriber.prototype._error=function(e){var t=this.destination;t.next(o.Notification.createError(e)),t.complete()},MaterializeSubscriber.prototype._complete=function(){var e=this.destination;e.next(o.Notification.createComplete()),e.complete()},MaterializeSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function partition(e,t){return[i.filter.call(this,e),i.filter.call(this,r.not(e,t))]}var r=n(1088),i=n(504);t.partition=partition},function(e,t,n){"use strict";function pluck(){for(var e=[],t=0;t<arguments.length;t++)e[t-0]=arguments[t];var n=e.length;if(0===n)throw new Error("List of properties cannot be empty.");return r.map.call(this,plucker(e,n))}function plucker(e,t){var n=function(n){for(var r=n,i=0;i<t;i++){var o=r[e[i]];if("undefined"==typeof o)return;r=o}return r};return n}var r=n(505);t.pluck=pluck},function(e,t,n){"use strict";function publish(){return i.multicast.call(this,new r.Subject)}var r=n(20),i=n(111);t.publish=publish},function(e,t,n){"use strict";function publishBehavior(e){return i.multicast.call(this,new r.BehaviorSubject(e))}var r=n(207),i=n(111);t.publishBehavior=publishBehavior},function(e,t,n){"use strict";function publishLast(){return i.multicast.call(this,new r.AsyncSubject)}var r=n(206),i=n(111);t.publishLast=publishLast},function(e,t,n){"use strict";function reduce(e,t){return this.lift(new o(e,t))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.reduce=reduce;var o=function(){function ReduceOperator(e,t){this.project=e,this.seed=t}return ReduceOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.project,this.seed))},ReduceOperator}();t.ReduceOperator=o;var s=function(e){function ReduceSubscriber(t,n,r){e.call(this,t),this.hasValue=!1,this.acc=r,this.project=n,this.hasSeed="undefined"!=typeof r}return r(ReduceSubscriber,e),ReduceSubscriber.prototype._next=function(e){this.hasValue||(this.hasValue=this.hasSeed)?this._tryReduce(e):(this.acc=e,this.hasValue=!0)},ReduceSubscriber.prototype._tryReduce=function(e){var t;try{t=this.project(this.acc,e)}catch(n){return void this.destination.error(n)}this.acc=t},ReduceSubscriber.prototype._complete=function(){(this.hasValue||this.hasSeed)&&this.destination.next(this.acc),this.destination.complete()},ReduceSubscriber}(i.Subscriber);t.ReduceSubscriber=s},function(e,t,n){"use strict";function repeat(e){return void 0===e&&(e=-1),0===e?new o.EmptyObservable:e<0?this.lift(new s((-1),this)):this.lift(new s(e-1,this))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(80);t.repeat=repeat;var s=function(){function RepeatOperator(e,t){this.count=e,this.source=t}return RepeatOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.count,this.source))},RepeatOperator}(),a=function(e){function RepeatSubscriber(t,n,r){e.call(this,t),this.count=n,this.source=r}return r(RepeatSubscriber,e),RepeatSubscriber.prototype.complete=function(){if(!this.isStopped){var t=this,n=t.source,r=t.count;if(0===r)return e.prototype.complete.call(this);r>-1&&(this.count=r-1),this.unsubscribe(),this.isStopped=!1,this.isUnsubscribed=!1,n.subscribe(this)}},RepeatSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function retry(e){return void 0===e&&(e=-1),this.lift(new o(e,this))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.retry=retry;var o=function(){function RetryOperator(e,t){this.count=e,this.source=t}return RetryOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.count,this.source))},RetryOperator}(),s=function(e){function RetrySubscriber(t,n,r){e.call(this,t),this.count=n,this.source=r}return r(RetrySubscriber,e),RetrySubscriber.prototype.error=function(t){if(!this.isStopped){var n=this,r=n.source,i=n.count;if(0===i)return e.prototype.error.call(this,t);i>-1&&(this.count=i-1),this.unsubscribe(),this.isStopped=!1,this.isUnsubscribed=!1,r.subscribe(this)}},RetrySubscriber}(i.Subscriber)},function(e,t,n){"use strict";function retryWhen(e){return this.lift(new c(e,this))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(20),o=n(42),s=n(38),a=n(11),l=n(12);t.retryWhen=retryWhen;var c=function(){function RetryWhenOperator(e,t){this.notifier=e,this.source=t}return RetryWhenOperator.prototype.call=function(e,t){return t._subscribe(new u(e,this.notifier,this.source))},RetryWhenOperator}(),u=function(e){function RetryWhenSubscriber(t,n,r){e.call(this,t),this.notifier=n,this.source=r}return r(RetryWhenSubscriber,e),RetryWhenSubscriber.prototype.error=function(t){if(!this.isStopped){var n=this.errors,r=this.retries,a=this.retriesSubscription;if(r)this.errors=null,this.retriesSubscription=null;else{if(n=new i.Subject,r=o.tryCatch(this.notifier)(n),r===s.errorObject)return e.prototype.error.call(this,s.errorObject.e);a=l.subscribeToResult(this,r)}this.unsubscribe(),this.isUnsubscribed=!1,this.errors=n,this.retries=r,this.retriesSubscription=a,n.next(t)}},RetryWhenSubscriber.prototype._unsubscribe=function(){var e=this,t=e.errors,n=e.retriesSubscription;t&&(t.unsubscribe(),this.errors=null),n&&(n.unsubscribe(),this.retriesSubscription=null),this.retries=null},RetryWhenSubscriber.prototype.notifyNext=function(e,t,n,r,i){var o=this,s=o.errors,a=o.retries,l=o.retriesSubscription;this.errors=null,this.retries=null,this.retriesSubscription=null,this.unsubscribe(),this.isStopped=!1,this.isUnsubscribed=!1,this.errors=s,this.retries=a,this.retriesSubscription=l,this.source.subscribe(this)},RetryWhenSubscriber}(a.OuterSubscriber)},function(e,t,n){"use strict";function sample(e){return this.lift(new s(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(11),o=n(12);t.sample=sample;var s=function(){function SampleOperator(e){this.notifier=e}return SampleOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.notifier))},SampleOperator}(),a=function(e){function SampleSubscriber(t,n){e.call(this,t),this.hasValue=!1,this.add(o.subscribeToResult(this,n))}return r(SampleSubscriber,e),SampleSubscriber.prototype._next=function(e){this.value=e,this.hasValue=!0},SampleSubscriber.prototype.notifyNext=function(e,t,n,r,i){this.emitValue()},SampleSubscriber.prototype.notifyComplete=function(){this.emitValue()},SampleSubscriber.prototype.emitValue=function(){this.hasValue&&(this.hasValue=!1,this.destination.next(this.value))},SampleSubscriber}(i.OuterSubscriber)},function(e,t,n){"use strict";function sampleTime(e,t){return void 0===t&&(t=o.async),this.lift(new s(e,t))}function dispatchNotification(e){var t=e.subscriber,n=e.delay;t.notifyNext(),this.schedule(e,n)}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(45);t.sampleTime=sampleTime;var s=function(){function SampleTimeOperator(e,t){this.delay=e,this.scheduler=t}return SampleTimeOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.delay,this.scheduler))},SampleTimeOperator}(),a=function(e){function SampleTimeSubscriber(t,n,r){e.call(this,t),this.delay=n,this.scheduler=r,this.hasValue=!1,this.add(r.schedule(dispatchNotification,n,{subscriber:this,delay:n}))}return r(SampleTimeSubscriber,e),SampleTimeSubscriber.prototype._next=function(e){this.lastValue=e,this.hasValue=!0},SampleTimeSubscriber.prototype.notifyNext=function(){this.hasValue&&(this.hasValue=!1,this.destination.next(this.lastValue))},SampleTimeSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function scan(e,t){return this.lift(new o(e,t))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.scan=scan;var o=function(){function ScanOperator(e,t){this.accumulator=e,this.seed=t}return ScanOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.accumulator,this.seed))},ScanOperator}(),s=function(e){function ScanSubscriber(t,n,r){e.call(this,t),this.accumulator=n,this.accumulatorSet=!1,this.seed=r,this.accumulator=n,this.accumulatorSet="undefined"!=typeof r}return r(ScanSubscriber,e),Object.defineProperty(ScanSubscriber.prototype,"seed",{get:function(){return this._seed},set:function(e){this.accumulatorSet=!0,this._seed=e},enumerable:!0,configurable:!0}),ScanSubscriber.prototype._next=function(e){return this.accumulatorSet?this._tryNext(e):(this.seed=e,void this.destination.next(e))},ScanSubscriber.prototype._tryNext=function(e){var t;try{t=this.accumulator(this.seed,e)}catch(n){this.destination.error(n)}this.seed=t,this.destination.next(t)},ScanSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function shareSubjectFactory(){return new i.Subject}function share(){return r.multicast.call(this,shareSubjectFactory).refCount()}var r=n(111),i=n(20);t.share=share},function(e,t,n){"use strict";function single(e){return this.lift(new s(e,this))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(143);t.single=single;var s=function(){function SingleOperator(e,t){this.predicate=e,this.source=t}return SingleOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.predicate,this.source))},SingleOperator}(),a=function(e){function SingleSubscriber(t,n,r){e.call(this,t),this.predicate=n,this.source=r,this.seenValue=!1,this.index=0}return r(SingleSubscriber,e),SingleSubscriber.prototype.applySingleValue=function(e){this.seenValue?this.destination.error("Sequence contains more than one element"):(this.seenValue=!0,this.singleValue=e)},SingleSubscriber.prototype._next=function(e){var t=this.predicate;this.index++,t?this.tryNext(e):this.applySingleValue(e)},SingleSubscriber.prototype.tryNext=function(e){try{var t=this.predicate(e,this.index,this.source);t&&this.applySingleValue(e)}catch(n){this.destination.error(n)}},SingleSubscriber.prototype._complete=function(){var e=this.destination;this.index>0?(e.next(this.seenValue?this.singleValue:void 0),e.complete()):e.error(new o.EmptyError)},SingleSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function skip(e){return this.lift(new o(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.skip=skip;var o=function(){function SkipOperator(e){this.total=e}return SkipOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.total))},SkipOperator}(),s=function(e){function SkipSubscriber(t,n){e.call(this,t),this.total=n,this.count=0}return r(SkipSubscriber,e),SkipSubscriber.prototype._next=function(e){++this.count>this.total&&this.destination.next(e)},SkipSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function skipUntil(e){return this.lift(new s(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(11),o=n(12);t.skipUntil=skipUntil;var s=function(){function SkipUntilOperator(e){this.notifier=e}return SkipUntilOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.notifier))},SkipUntilOperator}(),a=function(e){function SkipUntilSubscriber(t,n){e.call(this,t),this.hasValue=!1,this.isInnerStopped=!1,this.add(o.subscribeToResult(this,n))}return r(SkipUntilSubscriber,e),SkipUntilSubscriber.prototype._next=function(t){this.hasValue&&e.prototype._next.call(this,t)},SkipUntilSubscriber.prototype._complete=function(){this.isInnerStopped?e.prototype._complete.call(this):this.unsubscribe()},SkipUntilSubscriber.prototype.notifyNext=function(e,t,n,r,i){this.hasValue=!0},SkipUntilSubscriber.prototype.notifyComplete=function(){this.isInnerStopped=!0,this.isStopped&&e.prototype._complete.call(this)},SkipUntilSubscriber}(i.OuterSubscriber)},function(e,t,n){"use strict";function skipWhile(e){return this.lift(new o(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.skipWhile=skipWhile;var o=function(){function SkipWhileOperator(e){this.predicate=e}return SkipWhileOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.predicate))},SkipWhileOperator}(),s=function(e){function SkipWhileSubscriber(t,n){e.call(this,t),this.predicate=n,this.skipping=!0,this.index=0}return r(SkipWhileSubscriber,e),SkipWhileSubscriber.prototype._next=function(e){var t=this.destination;this.skipping&&this.tryCallPredicate(e),this.skipping||t.next(e)},SkipWhileSubscriber.prototype.tryCallPredicate=function(e){try{var t=this.predicate(e,this.index++);this.skipping=Boolean(t)}catch(n){this.destination.error(n)}},SkipWhileSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function startWith(){for(var e=[],t=0;t<arguments.length;t++)e[t-0]=arguments[t];var n=e[e.length-1];a.isScheduler(n)?e.pop():n=null;var l=e.length;return 1===l?s.concatStatic(new i.ScalarObservable(e[0],n),this):l>1?s.concatStatic(new r.ArrayObservable(e,n),this):s.concatStatic(new o.EmptyObservable(n),this)}var r=n(79),i=n(308),o=n(80),s=n(311),a=n(98);t.startWith=startWith},function(e,t,n){"use strict";function subscribeOn(e,t){return void 0===t&&(t=0),new r.SubscribeOnObservable(this,t,e)}var r=n(994);t.subscribeOn=subscribeOn},function(e,t,n){"use strict";function _switch(){return this.lift(new s)}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(11),o=n(12);t._switch=_switch;var s=function(){function SwitchOperator(){}return SwitchOperator.prototype.call=function(e,t){return t._subscribe(new a(e))},SwitchOperator}(),a=function(e){function SwitchSubscriber(t){e.call(this,t),this.active=0,this.hasCompleted=!1}return r(SwitchSubscriber,e),SwitchSubscriber.prototype._next=function(e){this.unsubscribeInner(),this.active++,this.add(this.innerSubscription=o.subscribeToResult(this,e))},SwitchSubscriber.prototype._complete=function(){this.hasCompleted=!0,0===this.active&&this.destination.complete()},SwitchSubscriber.prototype.unsubscribeInner=function(){this.active=this.active>0?this.active-1:0;var e=this.innerSubscription;e&&(e.unsubscribe(),this.remove(e))},SwitchSubscriber.prototype.notifyNext=function(e,t,n,r,i){this.destination.next(t)},SwitchSubscriber.prototype.notifyError=function(e){this.destination.error(e)},SwitchSubscriber.prototype.notifyComplete=function(){this.unsubscribeInner(),this.hasCompleted&&0===this.active&&this.destination.complete()},SwitchSubscriber}(i.OuterSubscriber)},function(e,t,n){"use strict";function switchMap(e,t){return this.lift(new s(e,t))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(11),o=n(12);t.switchMap=switchMap;var s=function(){function SwitchMapOperator(e,t){this.project=e,this.resultSelector=t}return SwitchMapOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.project,this.resultSelector))},SwitchMapOperator}(),a=function(e){function SwitchMapSubscriber(t,n,r){e.call(this,t),this.project=n,this.resultSelector=r,this.index=0}return r(SwitchMapSubscriber,e),SwitchMapSubscriber.prototype._next=function(e){var t,n=this.index++;try{t=this.project(e,n)}catch(r){return void this.destination.error(r)}this._innerSub(t,e,n)},SwitchMapSubscriber.prototype._innerSub=function(e,t,n){var r=this.innerSubscription;r&&r.unsubscribe(),this.add(this.innerSubscription=o.subscribeToResult(this,e,t,n))},SwitchMapSubscriber.prototype._complete=function(){var t=this.innerSubscription;t&&!t.isUnsubscribed||e.prototype._complete.call(this)},SwitchMapSubscriber.prototype._unsubscribe=function(){this.innerSubscription=null},SwitchMapSubscriber.prototype.notifyComplete=function(t){this.remove(t),this.innerSubscription=null,this.isStopped&&e.prototype._complete.call(this)},SwitchMapSubscriber.prototype.notifyNext=function(e,t,n,r,i){this.resultSelector?this._tryNotifyNext(e,t,n,r):this.destination.next(t)},SwitchMapSubscriber.prototype._tryNotifyNext=function(e,t,n,r){var i;try{i=this.resultSelector(e,t,n,r)}catch(o){return void this.destination.error(o)}this.destination.next(i)},SwitchMapSubscriber}(i.OuterSubscriber)},function(e,t,n){"use strict";function switchMapTo(e,t){return this.lift(new s(e,t))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(11),o=n(12);t.switchMapTo=switchMapTo;var s=function(){function SwitchMapToOperator(e,t){this.observable=e,this.resultSelector=t}return SwitchMapToOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.observable,this.resultSelector))},SwitchMapToOperator}(),a=function(e){function SwitchMapToSubscriber(t,n,r){e.call(this,t),this.inner=n,this.resultSelector=r,this.index=0}return r(SwitchMapToSubscriber,e),SwitchMapToSubscriber.prototype._next=function(e){var t=this.innerSubscription;t&&t.unsubscribe(),this.add(this.innerSubscription=o.subscribeToResult(this,this.inner,e,this.index++))},SwitchMapToSubscriber.prototype._complete=function(){var t=this.innerSubscription;t&&!t.isUnsubscribed||e.prototype._complete.call(this)},SwitchMapToSubscriber.prototype._unsubscribe=function(){this.innerSubscription=null},SwitchMapToSubscriber.prototype.notifyComplete=function(t){this.remove(t),this.innerSubscription=null,this.isStopped&&e.prototype._complete.call(this)},SwitchMapToSubscriber.prototype.notifyNext=function(e,t,n,r,i){var o=this,s=o.resultSelector,a=o.destination;s?this.tryResultSelector(e,t,n,r):a.next(t)},SwitchMapToSubscriber.prototype.tryResultSelector=function(e,t,n,r){var i,o=this,s=o.resultSelector,a=o.destination;try{i=s(e,t,n,r)}catch(l){return void a.error(l)}a.next(i)},SwitchMapToSubscriber}(i.OuterSubscriber)},function(e,t,n){"use strict";function take(e){return 0===e?new s.EmptyObservable:this.lift(new a(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(316),s=n(80);t.take=take;var a=function(){function TakeOperator(e){if(this.total=e,this.total<0)throw new o.ArgumentOutOfRangeError}return TakeOperator.prototype.call=function(e,t){return t._subscribe(new l(e,this.total))},TakeOperator}(),l=function(e){function TakeSubscriber(t,n){e.call(this,t),this.total=n,this.count=0}return r(TakeSubscriber,e),TakeSubscriber.prototype._next=function(e){var t=this.total;++this.count<=t&&(this.destination.next(e),this.count===t&&(this.destination.complete(),this.unsubscribe()))},TakeSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function takeLast(e){return 0===e?new s.EmptyObservable:this.lift(new a(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(316),s=n(80);t.takeLast=takeLast;var a=function(){function TakeLastOperator(e){if(this.total=e,this.total<0)throw new o.ArgumentOutOfRangeError}return TakeLastOperator.prototype.call=function(e,t){return t._subscribe(new l(e,this.total))},TakeLastOperator}(),l=function(e){function TakeLastSubscriber(t,n){e.call(this,t),this.total=n,this.ring=new Array,this.count=0}return r(TakeLastSubscriber,e),TakeLastSubscriber.prototype._next=function(e){var t=this.ring,n=this.total,r=this.count++;if(t.length<n)t.push(e);else{var i=r%n;t[i]=e}},TakeLastSubscriber.prototype._complete=function(){var e=this.destination,t=this.count;if(t>0)for(var n=this.count>=this.total?this.total:this.count,r=this.ring,i=0;i<n;i++){var o=t++%n;e.next(r[o])}e.complete()},TakeLastSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function takeUntil(e){return this.lift(new s(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(11),o=n(12);t.takeUntil=takeUntil;var s=function(){function TakeUntilOperator(e){this.notifier=e}return TakeUntilOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.notifier))},TakeUntilOperator}(),a=function(e){function TakeUntilSubscriber(t,n){e.call(this,t),this.notifier=n,this.add(o.subscribeToResult(this,n))}return r(TakeUntilSubscriber,e),TakeUntilSubscriber.prototype.notifyNext=function(e,t,n,r,i){this.complete()},TakeUntilSubscriber.prototype.notifyComplete=function(){},TakeUntilSubscriber}(i.OuterSubscriber)},function(e,t,n){"use strict";function takeWhile(e){return this.lift(new o(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6);t.takeWhile=takeWhile;var o=function(){function TakeWhileOperator(e){this.predicate=e}return TakeWhileOperator.prototype.call=function(e,t){return t._subscribe(new s(e,this.predicate))},TakeWhileOperator}(),s=function(e){function TakeWhileSubscriber(t,n){e.call(this,t),this.predicate=n,this.index=0}return r(TakeWhileSubscriber,e),TakeWhileSubscriber.prototype._next=function(e){var t,n=this.destination;try{t=this.predicate(e,this.index++)}catch(r){return void n.error(r)}this.nextOrComplete(e,t)},TakeWhileSubscriber.prototype.nextOrComplete=function(e,t){var n=this.destination;Boolean(t)?n.next(e):n.complete()},TakeWhileSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function throttle(e){return this.lift(new s(e))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(11),o=n(12);t.throttle=throttle;var s=function(){function ThrottleOperator(e){this.durationSelector=e}return ThrottleOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.durationSelector))},ThrottleOperator}(),a=function(e){function ThrottleSubscriber(t,n){e.call(this,t),this.destination=t,this.durationSelector=n}return r(ThrottleSubscriber,e),ThrottleSubscriber.prototype._next=function(e){this.throttled||this.tryDurationSelector(e)},ThrottleSubscriber.prototype.tryDurationSelector=function(e){var t=null;try{t=this.durationSelector(e)}catch(n){return void this.destination.error(n)}this.emitAndThrottle(e,t)},ThrottleSubscriber.prototype.emitAndThrottle=function(e,t){this.add(this.throttled=o.subscribeToResult(this,t)),this.destination.next(e)},ThrottleSubscriber.prototype._unsubscribe=function(){var e=this.throttled;e&&(this.remove(e),this.throttled=null,e.unsubscribe())},ThrottleSubscriber.prototype.notifyNext=function(e,t,n,r,i){this._unsubscribe()},ThrottleSubscriber.prototype.notifyComplete=function(){this._unsubscribe()},ThrottleSubscriber}(i.OuterSubscriber)},function(e,t,n){"use strict";function throttleTime(e,t){return void 0===t&&(t=o.async),this.lift(new s(e,t))}function dispatchNext(e){var t=e.subscriber;t.clearThrottle()}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(6),o=n(45);t.throttleTime=throttleTime;var s=function(){function ThrottleTimeOperator(e,t){this.delay=e,this.scheduler=t}return ThrottleTimeOperator.prototype.call=function(e,t){return t._subscribe(new a(e,this.delay,this.scheduler))},ThrottleTimeOperator}(),a=function(e){function ThrottleTimeSubscriber(t,n,r){e.call(this,t),this.delay=n,this.scheduler=r}return r(ThrottleTimeSubscriber,e),ThrottleTimeSubscriber.prototype._next=function(e){this.throttled||(this.add(this.throttled=this.scheduler.schedule(dispatchNext,this.delay,{subscriber:this})),this.destination.next(e))},ThrottleTimeSubscriber.prototype.clearThrottle=function(){var e=this.throttled;e&&(e.unsubscribe(),this.remove(e),this.throttled=null)},ThrottleTimeSubscriber}(i.Subscriber)},function(e,t,n){"use strict";function timeout(e,t,n){void 0===t&&(t=null),void 0===n&&(n=i.async);var r=o.isDate(e),s=r?+e-n.now():Math.abs(e);return this.lift(new a(s,r,t,n))}var r=this&&this.__extends||function(e,t){function __(){this.constructor=e}for(var n in t)t.hasOwnProperty(n)&&(e[n]=t[n]);e.prototype=null===t?Object.create(t):(__.prototype=t.prototype,new __)},i=n(45),o=n(216),s=n(6);t.timeout=timeout;var a=function(){function TimeoutOperator(e,t,n,r){this.waitFor=e,this.absoluteTimeout=t,this.errorToSend=n,this.scheduler=r}return TimeoutOperator.prototype.call=function(e,t){return t._subscribe(new l(e,this.absoluteTimeout,this.waitFor,this.errorToSend,this.scheduler))},TimeoutOperator}(),l=function(e){function TimeoutSubscriber(t,n,r,i,o){e.call(this,t),this.absoluteTimeout=n,this.waitFor=r,this.errorToSend=i,this.scheduler=o,this.index=0,this._previousIndex=0,this._hasCompleted=!1,this.scheduleTimeout()}return r(TimeoutSubscriber,e),Object.defineProperty(TimeoutSubscriber.prototype,"previousIndex",{get:function(){return this._previousIndex},enumerable:!0,configurable:!0}),Object.defineProperty(TimeoutSubscriber.prototype,"hasCompleted",{get:function(){return this._hasCompleted},enumerable:!0,configurable:!0}),TimeoutSubscriber.dispatchTimeout=function(e){
@@ -94,4 +94,4 @@ return!this._handleErrorZS||this._handleErrorZS.onHandleError(this._handleErrorD
  * Use of this source code is governed by an MIT-style license that can be
  * found in the LICENSE file at https://angular.io/license
  */
-"use strict";var i=n(r);t.Math=i.global.Math,t.NaN=typeof t.NaN}],[1102]);
\ No newline at end of file
+"use strict";var i=n(r);t.Math=i.global.Math,t.NaN=typeof t.NaN},function(e,t,n){"use strict";var r=n(0),i=(n.n(r),n(43));n.n(i);n.d(t,"a",function(){return l});var s=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},a=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},l=function(){function VReplicationService(e){this.http=e,this.workflowsUrl="../api/vreplication_workflows/",this.jobsUrl="../api/jobs/"}return VReplicationService.prototype.getWorkflows=function(){return this.http.get(this.workflowsUrl).map(function(e){return e.json()})},VReplicationService.prototype.workflowAction=function(e,t,n){return this.post(this.workflowsUrl+e+"/"+t,{action:n})},VReplicationService.prototype.getJobs=function(){return this.http.get(this.jobsUrl).map(function(e){return e.json()})},VReplicationService.prototype.startJob=function(e,t){return this.post(this.jobsUrl,{action:"start",type:e,target:t})},VReplicationService.prototype.jobAction=function(e,t){return this.post(this.jobsUrl+e,{action:t})},VReplicationService.prototype.post=function(e,t){for(var n=new i.Headers({"Content-Type":"application/x-www-form-urlencoded"}),r=new i.RequestOptions({headers:n}),o=new i.URLSearchParams,s=0,a=Object.keys(t);s<a.length;s++){var l=a[s];o.set(l,t[l])}return this.http.post(e,o.toString(),r).map(function(e){return e.json()})},VReplicationService=s([n.i(r.Injectable)(),a("design:paramtypes",["function"==typeof(e="undefined"!=typeof i.Http&&i.Http)&&e||Object])],VReplicationService);var e}()},function(e,t,n){"use strict";var r=n(0),i=(n.n(r),n(1109));n.d(t,"a",function(){return c});var o=this&&this.__decorate||function(e,t,n,r){var i,o=arguments.length,s=o<3?t:null===r?r=Object.getOwnPropertyDescriptor(t,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)s=Reflect.decorate(e,t,n,r);else for(var a=e.length-1;a>=0;a--)(i=e[a])&&(s=(o<3?i(s):o>3?i(t,n,s):i(t,n))||s);return o>3&&s&&Object.defineProperty(t,n,s),s},s=this&&this.__metadata||function(e,t){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(e,t)},a=5e3,l=6e4,c=function(){function VReplicationListComponent(e){this.vreplicationService=e,this.title="VReplication",this.workflows=[],this.jobs=[],this.backupTarget="",this.actionError="",this.pollError="",this.pollMs=a,this.lastStatus="",this.timer=null,this.destroyed=!1}return VReplicationListComponent.prototype.ngOnInit=function(){this.poll()},VReplicationListComponent.prototype.ngOnDestroy=function(){this.destroyed=!0,clearTimeout(this.timer)},VReplicationListComponent.prototype.poll=function(){var e=this;this.timer=null,this.vreplicationService.getWorkflows().subscribe(function(t){e.vreplicationService.getJobs().subscribe(function(n){e.pollError="";var r=JSON.stringify([t,n]);e.schedule(r!==e.lastStatus),e.lastStatus=r,e.workflows=t,e.jobs=n},function(t){return e.pollFailed(t)})},function(t){return e.pollFailed(t)})},VReplicationListComponent.prototype.pollFailed=function(e){this.pollError="Cannot read the status from vtctld: "+e.text(),this.schedule(!1)},VReplicationListComponent.prototype.schedule=function(e){var t=this;this.destroyed||(this.pollMs=e?a:Math.min(2*this.pollMs,l),this.timer=setTimeout(function(){return t.poll()},this.pollMs))},VReplicationListComponent.prototype.refresh=function(){var e=this;this.destroyed||null===this.timer||(clearTimeout(this.timer),this.pollMs=a,this.timer=setTimeout(function(){return e.poll()},this.pollMs))},VReplicationListComponent.prototype.lagSeconds=function(e){return Math.round(e.Lag/1e9)},VReplicationListComponent.prototype.maxLagSeconds=function(e){for(var t=0,n=0,r=e.Status.Streams||[];n<r.length;n++){var i=r[n];t=Math.max(t,this.lagSeconds(i))}return t},VReplicationListComponent.prototype.copyProgress=function(e){return e.Copying?0===e.SourceRows?0:Math.min(100,Math.round(100*e.TargetRows/e.SourceRows)):100},VReplicationListComponent.prototype.hasState=function(e,t){for(var n=0,r=e.Status.Streams||[];n<r.length;n++){var i=r[n];if(i.State===t)return!0}return!1},VReplicationListComponent.prototype.action=function(e,t){var n=this;("delete"!==t||confirm("Delete the streams of "+e.Keyspace+"."+e.Workflow+"?"))&&(this.actionError="",this.vreplicationService.workflowAction(e.Keyspace,e.Workflow,t).subscribe(function(t){var r=n.workflows.filter(function(t){return t.Keyspace!==e.Keyspace});n.workflows=r.concat(t),n.refresh()},function(r){n.actionError=t+" "+e.Keyspace+"."+e.Workflow+" failed: "+r.text()}))},VReplicationListComponent.prototype.startJob=function(e,t){var n=this;this.actionError="",this.vreplicationService.startJob(e,t).subscribe(function(e){n.jobs=[e].concat(n.jobs),n.refresh()},function(r){n.actionError=e+" "+t+" failed: "+r.text()})},VReplicationListComponent.prototype.jobAction=function(e,t){var n=this;this.actionError="",this.vreplicationService.jobAction(e.ID,t).subscribe(function(e){n.jobs=e,n.refresh()},function(r){n.actionError=t+" job "+e.ID+" failed: "+r.text()})},VReplicationListComponent.prototype.diffSummary=function(e){if(!e.Result)return"";for(var t=[],n=0,r=Object.keys(e.Result).sort();n<r.length;n++){var i=r[n],o=e.Result[i],s=o.MismatchedRows+o.ExtraRowsSource+o.ExtraRowsTarget;t.push(i+": "+o.ProcessedRows+" rows, "+s+" differences")}return t.join("; ")},VReplicationListComponent=o([n.i(r.Component)({selector:"vt-vreplication",template:n(1111),styles:[n(78),n(1112)],providers:[i.a]}),s("design:paramtypes",["function"==typeof(e="undefined"!=typeof i.a&&i.a)&&e||Object])],VReplicationListComponent);var e}()},function(e,t){e.exports='<div class="vt-toolbar vt-padding">\n  <h1 class="vt-title">{{title}}</h1>\n</div>\n<div class="vt-padding">\n  <div *ngIf="pollError" class="vt-vrepl-error">{{pollError}}</div>\n  <div *ngIf="actionError" class="vt-vrepl-error">{{actionError}}</div>\n  <div *ngIf="workflows.length === 0">No vreplication workflows.</div>\n  <div *ngFor="let workflow of workflows" class="vt-card">\n    <md-card>\n      <div class="vt-card-toolbar">\n        <h2 class="vt-title">{{workflow.Keyspace}}<span *ngIf="workflow.Workflow">.{{workflow.Workflow}}</span></h2>\n      </div>\n      <md-card-content>\n        <div *ngIf="workflow.Error" class="vt-vrepl-error">{{workflow.Error}}</div>\n        <div *ngIf="workflow.Status">\n          <div class="vt-vrepl-summary">\n            From {{workflow.Status.SourceKeyspace}}, max lag {{maxLagSeconds(workflow)}}s\n          </div>\n          <p-dataTable [value]="workflow.Status.Streams">\n            <header>Streams</header>\n            <p-column field="Shard" header="Shard"></p-column>\n            <p-column field="ID" header="Id"></p-column>\n            <p-column field="Source" header="Source"></p-column>\n            <p-column header="State">\n              <template let-stream="rowData">\n                <span [class.vt-vrepl-error]="stream.State === \'Error\'">{{stream.State}}</span>\n              </template>\n            </p-column>\n            <p-column header="Lag">\n              <template let-stream="rowData">{{lagSeconds(stream)}}s</template>\n            </p-column>\n            <p-column field="CopyingTables" header="Copying"></p-column>\n            <p-column field="Message" header="Message"></p-column>\n          </p-dataTable>\n          <p-dataTable *ngIf="workflow.Status.Tables" [value]="workflow.Status.Tables">\n            <header>Tables</header>\n            <p-column field="Table" header="Table"></p-column>\n            <p-column field="SourceRows" header="Source rows"></p-column>\n            <p-column field="TargetRows" header="Target rows"></p-column>\n            <p-column header="Copy progress">\n              <template let-table="rowData">\n                <md-progress-bar class="vt-vrepl-progress" mode="determinate" [value]="copyProgress(table)" color="primary"></md-progress-bar>\n                {{copyProgress(table)}}%\n              </template>\n            </p-column>\n          </p-dataTable>\n          <div class="vt-vrepl-actions">\n            <button md-raised-button *ngIf="!hasState(workflow, \'Stopped\')" (click)="action(workflow, \'stop\')">Stop</button>\n            <button md-raised-button *ngIf="hasState(workflow, \'Stopped\')" (click)="action(workflow, \'start\')">Start</button>\n            <button md-raised-button *ngIf="hasState(workflow, \'Error\')" (click)="action(workflow, \'retry\')">Retry</button>\n            <button md-raised-button (click)="action(workflow, \'delete\')">Delete</button>\n            <button md-raised-button (click)="startJob(\'diff\', workflow.Keyspace + \'.\' + workflow.Workflow)">Diff</button>\n          </div>\n        </div>\n      </md-card-content>\n    </md-card>\n  </div>\n  <div class="vt-card">\n    <md-card>\n      <div class="vt-card-toolbar">\n        <h2 class="vt-title">Diff and backup jobs</h2>\n      </div>\n      <md-card-content>\n        <div class="vt-vrepl-summary">\n          <md-input [(ngModel)]="backupTarget" placeholder="keyspace/shard"></md-input>\n          <button md-raised-button [disabled]="!backupTarget" (click)="startJob(\'backup\', backupTarget)">Backup</button>\n        </div>\n        <div *ngIf="jobs.length === 0">No diff or backup jobs.</div>\n        <p-dataTable *ngIf="jobs.length > 0" [value]="jobs">\n          <p-column field="ID" header="Id"></p-column>\n          <p-column field="Type" header="Type"></p-column>\n          <p-column field="Target" header="Target"></p-column>\n          <p-column header="State">\n            <template let-job="rowData">\n              <span [class.vt-vrepl-error]="job.State === \'Error\'">{{job.State}}</span>\n            </template>\n          </p-column>\n          <p-column header="Progress">\n            <template let-job="rowData">\n              <span *ngIf="job.Error" class="vt-vrepl-error">{{job.Error}}</span>\n              <span *ngIf="!job.Error && job.Result">{{diffSummary(job)}}</span>\n              <span *ngIf="!job.Error && !job.Result">{{job.Progress}}</span>\n            </template>\n          </p-column>\n          <p-column header="">\n            <template let-job="rowData">\n              <button md-raised-button *ngIf="job.State === \'Running\'" (click)="jobAction(job, \'stop\')">Stop</button>\n              <button md-raised-button *ngIf="job.State !== \'Running\'" (click)="jobAction(job, \'retry\')">Retry</button>\n              <button md-raised-button *ngIf="job.State !== \'Running\'" (click)="jobAction(job, \'remove\')">Remove</button>\n            </template>\n          </p-column>\n        </p-dataTable>\n      </md-card-content>\n    </md-card>\n  </div>\n</div>\n'},function(e,t){e.exports=".vt-vrepl-summary {\n  padding-bottom: 10px;\n}\n\n.vt-vrepl-progress {\n  display: inline-block;\n  width: 70%;\n}\n\n.vt-vrepl-actions {\n  padding-top: 10px;\n}\n\n.vt-vrepl-error {\n  color: red;\n}\n"}],[1102]);
\ No newline at end of file
@@ -0,0 +1,55 @@
+import { Headers, Http, RequestOptions, URLSearchParams } from '@angular/http';
+import { Injectable } from '@angular/core';
+import { Observable } from 'rxjs/Observable';
+
+// VReplicationService reads the status of the vreplication workflows
+// (MoveTables, resharding, ...) and of the diff and backup jobs from
+// vtctld, and controls them.
+@Injectable()
+export class VReplicationService {
+  private workflowsUrl = '../api/vreplication_workflows/';
+  private jobsUrl = '../api/jobs/';
+  constructor(private http: Http) {}
+
+  // getWorkflows returns the status of the workflows of all the
+  // keyspaces. vtctld caches it for a few seconds.
+  getWorkflows(): Observable<any> {
+    return this.http.get(this.workflowsUrl)
+      .map(resp => resp.json());
+  }
+
+  // workflowAction runs one of stop, start, retry or delete on a workflow,
+  // and returns the workflows of its keyspace.
+  workflowAction(keyspace: string, workflow: string, action: string): Observable<any> {
+    return this.post(this.workflowsUrl + keyspace + '/' + workflow, {action: action});
+  }
+
+  // getJobs returns the diff and backup jobs, the most recent first.
+  getJobs(): Observable<any> {
+    return this.http.get(this.jobsUrl)
+      .map(resp => resp.json());
+  }
+
+  // startJob starts a diff of a keyspace.workflow, or a backup of a
+  // keyspace/shard, and returns the job.
+  startJob(type: string, target: string): Observable<any> {
+    return this.post(this.jobsUrl, {action: 'start', type: type, target: target});
+  }
+
+  // jobAction runs one of stop, retry or remove on a job, and returns
+  // the jobs.
+  jobAction(id: number, action: string): Observable<any> {
+    return this.post(this.jobsUrl + id, {action: action});
+  }
+
+  private post(url: string, values: any): Observable<any> {
+    let headers = new Headers({ 'Content-Type': 'application/x-www-form-urlencoded' });
+    let options = new RequestOptions({ headers: headers });
+    let params = new URLSearchParams();
+    for (let key of Object.keys(values)) {
+      params.set(key, values[key]);
+    }
+    return this.http.post(url, params.toString(), options)
+      .map(resp => resp.json());
+  }
+}
@@ -29,6 +29,7 @@ import { StatusComponent } from './status/status.component';
 import { TopoBrowserComponent } from './topo/topo-browser.component';
 import { TabletComponent } from './dashboard/tablet.component';
 import { TabletPopupComponent } from './status/tablet-popup.component';
+import { VReplicationListComponent } from './workflows/vreplication-list.component';
 import { WorkflowListComponent } from './workflows/workflow-list.component';
 
 import { FeaturesService } from './api/features.service';
@@ -76,6 +77,7 @@ import { VtctlService } from './api/vtctl.service';
     TopoBrowserComponent,
     TabletComponent,
     TabletPopupComponent,
+    VReplicationListComponent,
     WorkflowListComponent,
   ],
   providers: [
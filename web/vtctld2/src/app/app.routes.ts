@@ -8,6 +8,7 @@ import { ShardComponent } from './dashboard/shard.component';
 import { StatusComponent } from './status/status.component';
 import { TabletComponent } from './dashboard/tablet.component';
 import { TopoBrowserComponent } from './topo/topo-browser.component';
+import { VReplicationListComponent } from './workflows/vreplication-list.component';
 import { WorkflowListComponent } from './workflows/workflow-list.component';
 
 export const routes: Routes = [
@@ -17,6 +18,7 @@ export const routes: Routes = [
   { path: 'schema', component: SchemaComponent},
   { path: 'tablet', component: TabletComponent},
   { path: 'workflows', component: WorkflowListComponent},
+  { path: 'vreplication', component: VReplicationListComponent},
   { path: 'topo', component: TopoBrowserComponent },
   { path: 'keyspace', component: KeyspaceComponent},
   { path: 'shard', component: ShardComponent},
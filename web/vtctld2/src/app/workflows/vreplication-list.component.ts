@@ -0,0 +1,174 @@
+import { Component, OnDestroy, OnInit } from '@angular/core';
+
+import { VReplicationService } from '../api/vreplication.service';
+
+// The status is polled every minPollMs while it changes. While it
+// doesn't, or while vtctld fails, the period doubles up to maxPollMs.
+const minPollMs = 5000;
+const maxPollMs = 60000;
+
+// VReplicationListComponent is the dashboard of the vreplication
+// workflows and of the diff and backup jobs: the state and lag of the
+// streams, the copy progress of the tables, the progress of the jobs,
+// and the controls to stop, start, retry or delete them.
+@Component({
+  selector: 'vt-vreplication',
+  templateUrl: './vreplication-list.component.html',
+  styleUrls: ['../styles/vt.style.css', './vreplication-list.component.css'],
+  providers: [VReplicationService],
+})
+
+export class VReplicationListComponent implements OnDestroy, OnInit {
+  title = 'VReplication';
+  workflows = [];
+  jobs = [];
+  // backupTarget is the keyspace/shard of the next backup job.
+  backupTarget = '';
+  // actionError is the error of the last action, if it failed.
+  actionError = '';
+  // pollError is the error of the last poll, if it failed.
+  pollError = '';
+  private pollMs = minPollMs;
+  private lastStatus = '';
+  // timer is the next poll, it's null while a poll is running.
+  private timer: any = null;
+  private destroyed = false;
+
+  constructor(private vreplicationService: VReplicationService) {}
+
+  ngOnInit() {
+    this.poll();
+  }
+
+  ngOnDestroy() {
+    this.destroyed = true;
+    clearTimeout(this.timer);
+  }
+
+  // poll reads the workflows and the jobs, and schedules the next poll.
+  poll() {
+    this.timer = null;
+    this.vreplicationService.getWorkflows().subscribe(workflows => {
+      this.vreplicationService.getJobs().subscribe(jobs => {
+        this.pollError = '';
+        let status = JSON.stringify([workflows, jobs]);
+        this.schedule(status !== this.lastStatus);
+        this.lastStatus = status;
+        this.workflows = workflows;
+        this.jobs = jobs;
+      }, err => this.pollFailed(err));
+    }, err => this.pollFailed(err));
+  }
+
+  pollFailed(err) {
+    this.pollError = 'Cannot read the status from vtctld: ' + err.text();
+    this.schedule(false);
+  }
+
+  // schedule schedules the next poll, sooner if the status changed. It
+  // does nothing once the component is destroyed.
+  schedule(changed: boolean) {
+    if (this.destroyed) {
+      return;
+    }
+    this.pollMs = changed ? minPollMs : Math.min(2 * this.pollMs, maxPollMs);
+    this.timer = setTimeout(() => this.poll(), this.pollMs);
+  }
+
+  // refresh polls again soon, after an action changed the status. A
+  // running poll schedules the next one itself.
+  refresh() {
+    if (this.destroyed || this.timer === null) {
+      return;
+    }
+    clearTimeout(this.timer);
+    this.pollMs = minPollMs;
+    this.timer = setTimeout(() => this.poll(), this.pollMs);
+  }
+
+  // lagSeconds returns the lag of a stream in seconds, the API reports
+  // it in nanoseconds.
+  lagSeconds(stream): number {
+    return Math.round(stream.Lag / 1e9);
+  }
+
+  // maxLagSeconds returns the highest lag of the streams of a workflow.
+  maxLagSeconds(workflow): number {
+    let lag = 0;
+    for (let stream of workflow.Status.Streams || []) {
+      lag = Math.max(lag, this.lagSeconds(stream));
+    }
+    return lag;
+  }
+
+  // copyProgress returns the estimated copy progress of a table, in
+  // percent. The row counts are estimates, so it's capped at 100.
+  copyProgress(table): number {
+    if (!table.Copying) {
+      return 100;
+    }
+    if (table.SourceRows === 0) {
+      return 0;
+    }
+    return Math.min(100, Math.round(100 * table.TargetRows / table.SourceRows));
+  }
+
+  hasState(workflow, state: string): boolean {
+    for (let stream of workflow.Status.Streams || []) {
+      if (stream.State === state) {
+        return true;
+      }
+    }
+    return false;
+  }
+
+  action(workflow, action: string) {
+    if (action === 'delete' && !confirm('Delete the streams of ' + workflow.Keyspace + '.' + workflow.Workflow + '?')) {
+      return;
+    }
+    this.actionError = '';
+    this.vreplicationService.workflowAction(workflow.Keyspace, workflow.Workflow, action).subscribe(keyspaceWorkflows => {
+      // Replace the workflows of the keyspace.
+      let others = this.workflows.filter(w => w.Keyspace !== workflow.Keyspace);
+      this.workflows = others.concat(keyspaceWorkflows);
+      this.refresh();
+    }, err => {
+      this.actionError = action + ' ' + workflow.Keyspace + '.' + workflow.Workflow + ' failed: ' + err.text();
+    });
+  }
+
+  // startJob starts a diff of a workflow, or a backup of a shard.
+  startJob(type: string, target: string) {
+    this.actionError = '';
+    this.vreplicationService.startJob(type, target).subscribe(job => {
+      this.jobs = [job].concat(this.jobs);
+      this.refresh();
+    }, err => {
+      this.actionError = type + ' ' + target + ' failed: ' + err.text();
+    });
+  }
+
+  jobAction(job, action: string) {
+    this.actionError = '';
+    this.vreplicationService.jobAction(job.ID, action).subscribe(jobs => {
+      this.jobs = jobs;
+      this.refresh();
+    }, err => {
+      this.actionError = action + ' job ' + job.ID + ' failed: ' + err.text();
+    });
+  }
+
+  // diffSummary summarizes the report of a diff job, per table.
+  diffSummary(job): string {
+    if (!job.Result) {
+      return '';
+    }
+    let tables = [];
+    for (let table of Object.keys(job.Result).sort()) {
+      let r = job.Result[table];
+      let differences = r.MismatchedRows + r.ExtraRowsSource + r.ExtraRowsTarget;
+      tables.push(table + ': ' + r.ProcessedRows + ' rows, ' + differences + ' differences');
+    }
+    return tables.join('; ');
+  }
+}